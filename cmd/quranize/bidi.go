@@ -0,0 +1,46 @@
+package main
+
+import (
+	"unicode"
+)
+
+// reorder returns s in visual order for terminals without bidirectional text support:
+// every run of arabic words is reversed, keeping harakat after their letters.
+func reorder(s string) string {
+	runes := []rune(s)
+	out := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); {
+		if !isArabic(runes[i]) {
+			out = append(out, runes[i])
+			i++
+			continue
+		}
+		end := i
+		for j := i; j < len(runes) && (isArabic(runes[j]) || runes[j] == ' ' || unicode.Is(unicode.Mn, runes[j])); j++ {
+			if isArabic(runes[j]) || unicode.Is(unicode.Mn, runes[j]) {
+				end = j + 1
+			}
+		}
+		out = append(out, reverseClusters(runes[i:end])...)
+		i = end
+	}
+	return string(out)
+}
+
+// reverseClusters reverses runes, keeping every letter followed by its combining marks.
+func reverseClusters(runes []rune) []rune {
+	out := make([]rune, 0, len(runes))
+	for end := len(runes); end > 0; {
+		start := end - 1
+		for start > 0 && unicode.Is(unicode.Mn, runes[start]) {
+			start--
+		}
+		out = append(out, runes[start:end]...)
+		end = start
+	}
+	return out
+}
+
+func isArabic(r rune) bool {
+	return unicode.Is(unicode.Arabic, r) && !unicode.Is(unicode.Mn, r)
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReorder(t *testing.T) {
+	testCases := map[string]string{
+		"":                        "",
+		"bismillah":               "bismillah",
+		"بسم الله":                "هللا مسب",
+		"1. بسم الله (3)":         "1. هللا مسب (3)",
+		"بِسْمِ":                  "مِسْبِ",
+		"1:1  بسم  2:2 الحمد لله": "1:1  مسب  2:2 هلل دمحلا",
+	}
+	for input, expected := range testCases {
+		assert.Equal(t, expected, reorder(input), input)
+	}
+}
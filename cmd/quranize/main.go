@@ -0,0 +1,311 @@
+// Command quranize transforms alphabet into arabic, locates arabic in Alquran,
+// shows ayas, and searches translations, either once or in an interactive session (repl).
+//
+// Usage:
+//  quranize encode [-format text|json|tsv] [-limit n] <alphabet>
+//  quranize locate [-format text|json|tsv] [-normalize] <arabic>
+//  quranize show [-format text|json|tsv] [-edition clean|enhanced] [-translation none|<id>|<file>] <reference>
+//  quranize search [-format text|json|tsv] [-limit n] [-translation <id>|<file>] <query>
+//  quranize repl [-bidi] [-translation none|<id>|<file>]
+//
+// Examples:
+//  quranize encode alhamdulillah hirobbil 'alamin
+//  quranize show 2:255-257
+//  quranize search -format json "maha penyayang"
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alpancs/quranize"
+	"github.com/alpancs/quranize/reference"
+)
+
+const usage = `usage: quranize <command> [flags] <input>
+
+commands:
+  encode  transform alphabet into arabic along with locations
+  locate  locate arabic in Alquran
+  show    show ayas of a reference (e.g. 2:255-257) with translation
+  search  search translation text
+  repl    encode interactively, keeping Alquran loaded
+
+Run "quranize <command> -h" for flags of a command.
+`
+
+var errUsage = errors.New("invalid usage")
+
+func init() {
+	quranize.RegisterArabicEditions()
+	quranize.RegisterTranslations()
+}
+
+func main() {
+	err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr)
+	if err == errUsage {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "quranize:", err)
+		os.Exit(1)
+	}
+}
+
+// run executes command of args, writing results to stdout and usage to stderr.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return errUsage
+	}
+	if args[0] == "repl" {
+		return runREPL(args[1:], stdin, stdout, stderr)
+	}
+
+	commands := map[string]func(*command) (table, error){
+		"encode": encode,
+		"locate": locate,
+		"show":   show,
+		"search": search,
+	}
+	execute, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n\n%s", args[0], usage)
+		return errUsage
+	}
+
+	c := newCommand(args[0], stderr)
+	if err := c.flags.Parse(args[1:]); err != nil {
+		return errUsage
+	}
+	c.input = strings.Join(c.flags.Args(), " ")
+	if c.input == "" {
+		c.flags.Usage()
+		return errUsage
+	}
+
+	t, err := execute(c)
+	if err != nil {
+		return err
+	}
+	return t.write(stdout, *c.format)
+}
+
+type command struct {
+	flags       *flag.FlagSet
+	format      *string
+	limit       *int
+	normalize   *bool
+	edition     *string
+	translation *string
+	input       string
+}
+
+func newCommand(name string, stderr io.Writer) *command {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	c := &command{flags: flags, format: flags.String("format", "text", "output format: text, json, or tsv")}
+	switch name {
+	case "encode":
+		c.limit = flags.Int("limit", 0, "maximum number of encodings, 0 means no limit")
+	case "locate":
+		c.normalize = flags.Bool("normalize", false, "ignore harakat, tatweel, and letter variants of pasted arabic")
+	case "show":
+		c.edition = flags.String("edition", "enhanced", "arabic edition: clean or enhanced")
+		c.translation = flags.String("translation", "id.indonesian", "translation: none, edition ID (e.g. id.muntakhab), or Tanzil XML or text file")
+	case "search":
+		c.limit = flags.Int("limit", 10, "maximum number of ayas")
+		c.translation = flags.String("translation", "id.indonesian", "translation: edition ID (e.g. id.muntakhab), or Tanzil XML or text file")
+	}
+	return c
+}
+
+type location struct {
+	Sura int `json:"sura"`
+	Aya  int `json:"aya"`
+	Word int `json:"word"`
+}
+
+func newLocations(locations []quranize.Location) []location {
+	ls := []location{}
+	for _, l := range locations {
+		ls = append(ls, location{l.GetSura(), l.GetAya(), l.GetWordIndex()})
+	}
+	return ls
+}
+
+func (l location) String() string {
+	return fmt.Sprintf("%d:%d:%d", l.Sura, l.Aya, l.Word)
+}
+
+func encode(c *command) (table, error) {
+	type encoding struct {
+		Text      string     `json:"text"`
+		Score     float64    `json:"score"`
+		Locations []location `json:"locations"`
+	}
+
+	q := quranize.NewDefaultQuranize()
+	results := q.EncodeRanked(c.input)
+	if *c.limit > 0 && len(results) > *c.limit {
+		results = results[:*c.limit]
+	}
+
+	encodings := []encoding{}
+	t := table{header: []string{"text", "score", "locations"}}
+	for _, result := range results {
+		e := encoding{result.Text, result.Score, newLocations(q.Locate(result.Text))}
+		locations := make([]string, len(e.Locations))
+		for i, l := range e.Locations {
+			locations[i] = l.String()
+		}
+		encodings = append(encodings, e)
+		t.rows = append(t.rows, []string{e.Text, formatScore(e.Score), strings.Join(locations, " ")})
+	}
+	t.value = encodings
+	return t, nil
+}
+
+func locate(c *command) (table, error) {
+	q := quranize.NewDefaultQuranize()
+	found := q.Locate
+	if *c.normalize {
+		found = q.LocateNormalized
+	}
+	locations := newLocations(found(c.input))
+	t := table{header: []string{"sura", "aya", "word"}, value: locations}
+	for _, l := range locations {
+		t.rows = append(t.rows, []string{strconv.Itoa(l.Sura), strconv.Itoa(l.Aya), strconv.Itoa(l.Word)})
+	}
+	return t, nil
+}
+
+func show(c *command) (table, error) {
+	type aya struct {
+		Sura        int    `json:"sura"`
+		Aya         int    `json:"aya"`
+		Text        string `json:"text"`
+		Translation string `json:"translation,omitempty"`
+	}
+
+	r, err := reference.Parse(c.input)
+	if err != nil {
+		return table{}, err
+	}
+	newEdition, ok := map[string]func() quranize.Quran{
+		"clean":    quranize.NewQuranSimpleClean,
+		"enhanced": quranize.NewQuranSimpleEnhanced,
+	}[*c.edition]
+	if !ok {
+		return table{}, fmt.Errorf("unknown edition %q", *c.edition)
+	}
+	translation := quranize.Quran{}
+	if *c.translation != "none" {
+		if translation, err = newTranslation(*c.translation); err != nil {
+			return table{}, err
+		}
+	}
+
+	verses, err := newEdition().GetAyas(r)
+	if err != nil {
+		return table{}, err
+	}
+	ayas := []aya{}
+	t := table{header: []string{"sura", "aya", "text", "translation"}}
+	for _, v := range verses {
+		a := aya{Sura: v.Location.GetSura(), Aya: v.Location.GetAya(), Text: v.Text}
+		a.Translation, _ = translation.GetAya(a.Sura, a.Aya)
+		ayas = append(ayas, a)
+		t.rows = append(t.rows, []string{strconv.Itoa(a.Sura), strconv.Itoa(a.Aya), a.Text, a.Translation})
+	}
+	t.value = ayas
+	return t, nil
+}
+
+func search(c *command) (table, error) {
+	type hit struct {
+		Sura  int     `json:"sura"`
+		Aya   int     `json:"aya"`
+		Score float64 `json:"score"`
+		Text  string  `json:"text"`
+	}
+
+	translation, err := newTranslation(*c.translation)
+	if err != nil {
+		return table{}, err
+	}
+	hits := []hit{}
+	t := table{header: []string{"sura", "aya", "score", "text"}}
+	for _, result := range quranize.NewTextIndex(translation).Search(c.input, *c.limit) {
+		h := hit{Sura: result.Location.GetSura(), Aya: result.Location.GetAya(), Score: result.Score}
+		h.Text, _ = translation.GetAya(h.Sura, h.Aya)
+		hits = append(hits, h)
+		t.rows = append(t.rows, []string{strconv.Itoa(h.Sura), strconv.Itoa(h.Aya), formatScore(h.Score), h.Text})
+	}
+	t.value = hits
+	return t, nil
+}
+
+// newTranslation returns translation of a registered edition ID (e.g. id.indonesian),
+// or of a Tanzil XML or text file if name is a path to an existing file.
+func newTranslation(name string) (quranize.Quran, error) {
+	e, err := quranize.LookupEdition(name)
+	if err == quranize.ErrUnknownEdition {
+		if _, statErr := os.Stat(name); statErr != nil {
+			return quranize.Quran{}, fmt.Errorf("unknown translation %q", name)
+		}
+		e, err = quranize.LoadEditionFile(name)
+	}
+	return e.Quran, err
+}
+
+func formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', 2, 64)
+}
+
+// table is output of a command, written as aligned text, JSON of value, or TSV of header and rows.
+type table struct {
+	header []string
+	rows   [][]string
+	value  interface{}
+}
+
+var tsvEscaper = strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\n", "\\n", "\r", "\\r")
+
+func (t table) write(w io.Writer, format string) error {
+	switch format {
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, row := range t.rows {
+			for len(row) > 0 && row[len(row)-1] == "" {
+				row = row[:len(row)-1]
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(t.value)
+	case "tsv":
+		for _, row := range append([][]string{t.header}, t.rows...) {
+			fields := make([]string, len(row))
+			for i, field := range row {
+				fields[i] = tsvEscaper.Replace(field)
+			}
+			if _, err := fmt.Fprintln(w, strings.Join(fields, "\t")); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func runTest(args ...string) (string, string, error) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	err := run(args, &bytes.Buffer{}, stdout, stderr)
+	return stdout.String(), stderr.String(), err
+}
+
+func TestRunUsage(t *testing.T) {
+	for _, args := range [][]string{{}, {"unknown", "x"}, {"encode"}, {"encode", "-unknown", "x"}} {
+		_, stderr, err := runTest(args...)
+		assert.Equal(t, errUsage, err)
+		assert.NotEmpty(t, stderr)
+	}
+}
+
+func TestRunEncode(t *testing.T) {
+	stdout, _, err := runTest("encode", "-format", "tsv", "alhamdulillah", "hirobbil", "'alamin")
+	assert.NoError(t, err)
+	assert.Equal(t, "text\tscore\tlocations\nالحمد لله رب العالمين\t1.32\t1:2:0 10:10:10 39:75:13 40:65:10\n", stdout)
+}
+
+func TestRunLocate(t *testing.T) {
+	stdout, _, err := runTest("locate", "-format", "json", "بسم الله")
+	assert.NoError(t, err)
+	var locations []location
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &locations))
+	assert.Equal(t, []location{{1, 1, 0}, {11, 41, 3}, {27, 30, 4}}, locations)
+
+	stdout, _, err = runTest("locate", "-normalize", "بِسْمِ ٱللَّهِ ٱلرَّحْمَٰنِ")
+	assert.NoError(t, err)
+	assert.Equal(t, "1   1   0\n27  30  4\n", stdout)
+}
+
+func TestRunShow(t *testing.T) {
+	stdout, _, err := runTest("show", "-edition", "clean", "-translation", "none", "1:1-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "1  1  بسم الله الرحمن الرحيم\n1  2  الحمد لله رب العالمين\n", stdout)
+
+	dir, err := ioutil.TempDir("", "quranize")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "en.sahih.txt")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("1|1|In the name of Allah\n1|2|[All] praise is [due] to Allah\n"), 0644))
+	stdout, _, err = runTest("show", "-format", "tsv", "-edition", "clean", "-translation", path, "1:2")
+	assert.NoError(t, err)
+	assert.Equal(t, "sura\taya\ttext\ttranslation\n1\t2\tالحمد لله رب العالمين\t[All] praise is [due] to Allah\n", stdout)
+
+	_, _, err = runTest("show", "-translation", "en.sahih", "1:1")
+	assert.EqualError(t, err, `unknown translation "en.sahih"`)
+	_, _, err = runTest("show", "2:300")
+	assert.EqualError(t, err, `invalid reference "2:300"`)
+	_, _, err = runTest("show", "-edition", "unknown", "2:255")
+	assert.Error(t, err)
+}
+
+func TestRunSearch(t *testing.T) {
+	stdout, _, err := runTest("search", "-limit", "1", "-format", "tsv", "maha penyayang")
+	assert.NoError(t, err)
+	assert.Equal(t, "sura\taya\tscore\ttext\n1\t3\t9.40\tMaha Pemurah lagi Maha Penyayang.\n", stdout)
+
+	_, _, err = runTest("search", "-format", "xml", "maha penyayang")
+	assert.EqualError(t, err, `unknown format "xml"`)
+}
+
+func TestTableWriteTSV(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	tb := table{header: []string{"a", "b"}, rows: [][]string{{"x\ty", "line\nbreak"}}}
+	assert.NoError(t, tb.write(buffer, "tsv"))
+	assert.Equal(t, "a\tb\nx\\ty\tline\\nbreak\n", buffer.String())
+}
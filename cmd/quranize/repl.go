@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/alpancs/quranize"
+)
+
+// maxShownLocations is the maximum number of locations shown for every encoding.
+const maxShownLocations = 5
+
+const replHelp = `Type alphabet to encode it, or a command:
+  :translation <name>  show translation (none, edition ID, or Tanzil file)
+  :trace               toggle ranking details of encodings
+  :sura <number>       only show locations in sura number (0 shows all)
+  :bidi                toggle reordering arabic for terminals without bidi support
+  :help                show this help
+  :quit                quit
+`
+
+// repl is an interactive session keeping Quranize and Qurans loaded between inputs.
+type repl struct {
+	q           quranize.Quranize
+	edition     quranize.Quran
+	translation quranize.Quran
+	trace       bool
+	sura        int
+	bidi        bool
+	out         io.Writer
+}
+
+// runREPL reads inputs from stdin until EOF or ":quit", writing results to stdout.
+func runREPL(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("repl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	bidi := flags.Bool("bidi", false, "reorder arabic for terminals without bidi support")
+	translation := flags.String("translation", "none", "translation: none, edition ID (e.g. id.muntakhab), or Tanzil XML or text file")
+	if err := flags.Parse(args); err != nil {
+		return errUsage
+	}
+
+	r := &repl{q: quranize.NewDefaultQuranize(), edition: quranize.NewQuranSimpleEnhanced(), bidi: *bidi, out: stdout}
+	if err := r.setTranslation(*translation); err != nil {
+		return err
+	}
+
+	fmt.Fprint(stdout, replHelp)
+	scanner := bufio.NewScanner(stdin)
+	for fmt.Fprint(stdout, "> "); scanner.Scan(); fmt.Fprint(stdout, "> ") {
+		line := strings.TrimSpace(scanner.Text())
+		if line == ":quit" {
+			break
+		}
+		if err := r.eval(line); err != nil {
+			fmt.Fprintln(stdout, "error:", err)
+		}
+	}
+	fmt.Fprintln(stdout)
+	return scanner.Err()
+}
+
+func (r *repl) eval(line string) error {
+	if !strings.HasPrefix(line, ":") {
+		r.encode(line)
+		return nil
+	}
+
+	fields := strings.Fields(line)
+	arg := strings.Join(fields[1:], " ")
+	switch fields[0] {
+	case ":translation":
+		if err := r.setTranslation(arg); err != nil {
+			return err
+		}
+		fmt.Fprintf(r.out, "translation: %s\n", arg)
+	case ":trace":
+		r.trace = !r.trace
+		fmt.Fprintf(r.out, "trace: %t\n", r.trace)
+	case ":sura":
+		sura, err := strconv.Atoi(arg)
+		if err != nil || sura < 0 || sura > len(r.edition.Suras) {
+			return fmt.Errorf("invalid sura number %q", arg)
+		}
+		r.sura = sura
+		fmt.Fprintf(r.out, "sura: %d\n", sura)
+	case ":bidi":
+		r.bidi = !r.bidi
+		fmt.Fprintf(r.out, "bidi: %t\n", r.bidi)
+	case ":help":
+		fmt.Fprint(r.out, replHelp)
+	default:
+		return fmt.Errorf("unknown command %q", fields[0])
+	}
+	return nil
+}
+
+func (r *repl) setTranslation(name string) error {
+	if name == "none" {
+		r.translation = quranize.Quran{}
+		return nil
+	}
+	translation, err := newTranslation(name)
+	if err != nil {
+		return err
+	}
+	r.translation = translation
+	return nil
+}
+
+func (r *repl) encode(input string) {
+	if input == "" {
+		return
+	}
+
+	shown := 0
+	for _, result := range r.q.EncodeRanked(input) {
+		locations := []quranize.Location{}
+		for _, l := range r.q.Locate(result.Text) {
+			if r.sura == 0 || l.GetSura() == r.sura {
+				locations = append(locations, l)
+			}
+		}
+		if len(locations) == 0 {
+			continue
+		}
+
+		shown++
+		r.println(fmt.Sprintf("%d. %s  (%d locations)", shown, result.Text, len(locations)))
+		if r.trace {
+			r.println(fmt.Sprintf("   score=%.2f cost=%d variant=%d insertions=%d occurrences=%d",
+				result.Score, result.Cost, result.Variant, result.Insertions, result.Occurrences))
+		}
+		for i, l := range locations {
+			if i == maxShownLocations {
+				r.println(fmt.Sprintf("   ... and %d more", len(locations)-i))
+				break
+			}
+			text, _ := r.edition.GetAya(l.GetSura(), l.GetAya())
+			r.println(fmt.Sprintf("   %d:%d:%d  %s", l.GetSura(), l.GetAya(), l.GetWordIndex(), text))
+			if translation, err := r.translation.GetAya(l.GetSura(), l.GetAya()); err == nil {
+				r.println("          " + translation)
+			}
+		}
+	}
+	if shown == 0 {
+		r.println("no encodings found")
+	}
+}
+
+func (r *repl) println(s string) {
+	if r.bidi {
+		s = reorder(s)
+	}
+	fmt.Fprintln(r.out, s)
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func runREPLTest(t *testing.T, args []string, lines ...string) string {
+	stdout := &bytes.Buffer{}
+	err := runREPL(args, strings.NewReader(strings.Join(lines, "\n")), stdout, &bytes.Buffer{})
+	assert.NoError(t, err)
+	return stdout.String()
+}
+
+func TestREPLEncode(t *testing.T) {
+	out := runREPLTest(t, nil, "alhamdulillah hirobbil 'alamin")
+	assert.Contains(t, out, "1. الحمد لله رب العالمين  (4 locations)\n")
+	assert.Contains(t, out, "   1:2:0  الْحَمْدُ")
+	assert.NotContains(t, out, "score=")
+}
+
+func TestREPLCommands(t *testing.T) {
+	out := runREPLTest(t, nil, ":translation id.indonesian", ":trace", ":sura 10", "alhamdulillah hirobbil 'alamin", ":quit", "bismillah")
+	assert.Contains(t, out, "translation: id.indonesian\n")
+	assert.Contains(t, out, "trace: true\n")
+	assert.Contains(t, out, "sura: 10\n")
+	assert.Contains(t, out, "1. الحمد لله رب العالمين  (1 locations)\n   score=")
+	assert.Contains(t, out, "   10:10:10  ")
+	assert.Contains(t, out, "Dan penutup doa mereka ialah")
+	assert.NotContains(t, out, "1:2:0")
+	assert.NotContains(t, out, "بسم")
+}
+
+func TestREPLErrors(t *testing.T) {
+	out := runREPLTest(t, nil, ":translation en", ":sura 115", ":unknown", "xyzzy")
+	assert.Contains(t, out, `error: unknown translation "en"`)
+	assert.Contains(t, out, `error: invalid sura number "115"`)
+	assert.Contains(t, out, `error: unknown command ":unknown"`)
+	assert.Contains(t, out, "no encodings found")
+}
+
+func TestREPLBidi(t *testing.T) {
+	out := runREPLTest(t, []string{"-bidi"}, "bismillah")
+	assert.Contains(t, out, "1. هللا مسب  (3 locations)\n")
+	out = runREPLTest(t, []string{"-bidi"}, ":bidi", "bismillah")
+	assert.Contains(t, out, "1. بسم الله  (3 locations)\n")
+}
+
+func TestRunREPL(t *testing.T) {
+	stdout := &bytes.Buffer{}
+	assert.NoError(t, run([]string{"repl"}, strings.NewReader(":help\n"), stdout, &bytes.Buffer{}))
+	assert.Contains(t, stdout.String(), ":translation <name>")
+}
@@ -0,0 +1,149 @@
+package quranize
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+)
+
+// Completion is an arabic continuation of partial alphabet input.
+type Completion struct {
+	Text      string
+	Score     float64 // ranking score, the higher the better, like Score of EncodeResult
+	Locations []Location
+}
+
+// completionItem is a node of the trie to be expanded by Complete,
+// or a phrase ending at the node if result is true.
+type completionItem struct {
+	n       int32
+	text    []rune
+	spaces  int
+	penalty float64 // penalty of the encoded prefix, see EncodeResult.score
+	count   uint32  // number of locations of the phrase, or the maximum of the node and its descendants
+	result  bool
+}
+
+// score returns score of the phrase, or the maximum score of phrases under the node.
+func (it completionItem) score() float64 {
+	return math.Log2(1+float64(it.count)) - it.penalty
+}
+
+// completionQueue is a max-heap of completionItem by score, phrases before nodes.
+type completionQueue []completionItem
+
+func (h completionQueue) Len() int { return len(h) }
+func (h completionQueue) Less(i, j int) bool {
+	if a, b := h[i].score(), h[j].score(); a != b {
+		return a > b
+	}
+	return h[i].result && !h[j].result
+}
+func (h completionQueue) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *completionQueue) Push(x interface{}) { *h = append(*h, x.(completionItem)) }
+func (h *completionQueue) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// Complete returns at most limit arabic phrases continuing partial alphabet s, sorted best-first.
+// A phrase either completes the last (partially typed) word or extends it with the next word.
+//
+// Score of a phrase is derived from number of its locations and transliteration cost of the typed prefix,
+// as in EncodeRanked. Nodes are expanded best-first by the maximum score of phrases under them,
+// so only nodes which may hold one of the best phrases are visited.
+func (q Quranize) Complete(s string, limit int) []Completion {
+	s = normalizeInput(s)
+	completions := []Completion{}
+	if s == "" || limit <= 0 || q.index == nil {
+		return completions
+	}
+
+	e := newEncoder(q)
+	queue := &completionQueue{}
+	// inputs of variant 0 and 2 of encode
+	for variant, input := range []string{s, removeConsecutiveChars(s)} {
+		for _, prefix := range e.quranize(input) {
+			if prefix.node >= 0 {
+				penalty := prefix.penalty() + variantPenalty*float64(2*variant)
+				heap.Push(queue, completionItem{n: prefix.node, text: []rune(prefix.text), penalty: penalty, count: q.index.bestCount(prefix.node)})
+			}
+		}
+	}
+
+	// phrases are popped by descending score, keep popping ties of the last one
+	seen := make(map[string]bool)
+	for queue.Len() > 0 {
+		it := heap.Pop(queue).(completionItem)
+		if len(completions) >= limit && it.score() < completions[limit-1].Score {
+			break
+		}
+		if it.result {
+			if text := string(it.text); !seen[text] {
+				seen[text] = true
+				completions = append(completions, Completion{text, it.score(), q.index.locations(it.n)})
+			}
+			continue
+		}
+		q.index.expand(queue, it)
+	}
+
+	sort.Slice(completions, func(i, j int) bool {
+		a, b := completions[i], completions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Locations) != len(b.Locations) {
+			return len(a.Locations) > len(b.Locations)
+		}
+		if len(a.Text) != len(b.Text) {
+			return len(a.Text) < len(b.Text)
+		}
+		return a.Text < b.Text
+	})
+	if len(completions) > limit {
+		completions = completions[:limit]
+	}
+	return completions
+}
+
+// expand pushes the phrase ending at node of it, if any, and its children crossing at most one space.
+func (t *trie) expand(queue *completionQueue, it completionItem) {
+	if locations := t.locations(it.n); len(locations) > 0 {
+		heap.Push(queue, completionItem{it.n, it.text, it.spaces, it.penalty, uint32(len(locations)), true})
+	}
+	keys, targets := t.edges(it.n)
+	for i, key := range keys {
+		spaces := it.spaces
+		if key == ' ' {
+			if spaces == 1 {
+				continue
+			}
+			spaces++
+		}
+		text := append(it.text[:len(it.text):len(it.text)], key)
+		heap.Push(queue, completionItem{targets[i], text, spaces, it.penalty, t.bestCount(targets[i]), false})
+	}
+}
+
+// bestCount returns the maximum number of locations of node n and its descendants.
+// It is computed for every node on first call.
+func (t *trie) bestCount(n int32) uint32 {
+	t.bestOnce.Do(func() {
+		t.best = make([]uint32, t.size())
+		// descendants are numbered after their ancestors
+		for m := t.size() - 1; m >= 0; m-- {
+			best := uint32(len(t.locations(int32(m))))
+			_, targets := t.edges(int32(m))
+			for _, target := range targets {
+				if t.best[target] > best {
+					best = t.best[target]
+				}
+			}
+			t.best[m] = best
+		}
+	})
+	return t.best[n]
+}
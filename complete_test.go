@@ -0,0 +1,103 @@
+package quranize
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompleteEmptyString(t *testing.T) {
+	input := ""
+	expected := []Completion{}
+	actual := quranizeTest.Complete(input, 5)
+	assert.Equal(t, expected, actual)
+}
+
+func TestCompleteZeroLimit(t *testing.T) {
+	input := "bismil"
+	expected := []Completion{}
+	actual := quranizeTest.Complete(input, 0)
+	assert.Equal(t, expected, actual)
+}
+
+func TestCompleteAlquran(t *testing.T) {
+	testCases := map[string][]string{
+		"bismil":     {"بسم الله", "بسم الله الرحمن"},
+		"ya aiyuhal": {"يا أيها الذين", "يا أيها الذين آمنوا", "يا أيها الناس"},
+	}
+	for input, expected := range testCases {
+		actual := []string{}
+		for _, completion := range quranizeTest.Complete(input, len(expected)) {
+			actual = append(actual, completion.Text)
+			assert.Equal(t, quranizeTest.Locate(completion.Text), completion.Locations)
+		}
+		assert.Equalf(t, expected, actual, "input = %#v", input)
+	}
+}
+
+func TestCompleteCost(t *testing.T) {
+	completions := quranizeTest.Complete("innal", 5)
+	texts := []string{}
+	for _, completion := range completions {
+		texts = append(texts, completion.Text)
+	}
+	if assert.NotEmpty(t, texts) {
+		assert.Equal(t, "إن الله", texts[0])
+	}
+	assert.NotContains(t, texts, "يعلمون")
+	assert.NotContains(t, texts, "العالمين")
+}
+
+func TestCompleteBest(t *testing.T) {
+	for _, input := range []string{"a", "al", "ya", "innal"} {
+		e := newEncoder(quranizeTest)
+		scores := map[string]float64{}
+		var walk func(n int32, text []rune, spaces int, penalty float64)
+		walk = func(n int32, text []rune, spaces int, penalty float64) {
+			if locations := quranizeTest.index.locations(n); len(locations) > 0 {
+				score := math.Log2(1+float64(len(locations))) - penalty
+				if best, ok := scores[string(text)]; !ok || score > best {
+					scores[string(text)] = score
+				}
+			}
+			keys, targets := quranizeTest.index.edges(n)
+			for i, key := range keys {
+				if key == ' ' && spaces == 1 {
+					continue
+				}
+				nextSpaces := spaces
+				if key == ' ' {
+					nextSpaces++
+				}
+				walk(targets[i], append(text[:len(text):len(text)], key), nextSpaces, penalty)
+			}
+		}
+		for _, prefix := range e.quranize(input) {
+			if prefix.node >= 0 {
+				walk(prefix.node, []rune(prefix.text), 0, prefix.penalty())
+			}
+		}
+		for _, prefix := range e.quranize(removeConsecutiveChars(input)) {
+			if prefix.node >= 0 {
+				walk(prefix.node, []rune(prefix.text), 0, prefix.penalty()+2*variantPenalty)
+			}
+		}
+		max := math.Inf(-1)
+		for _, score := range scores {
+			max = math.Max(max, score)
+		}
+
+		completions := quranizeTest.Complete(input, 10)
+		assert.Len(t, completions, 10, input)
+		assert.Equal(t, max, completions[0].Score, input)
+		for i := 1; i < len(completions); i++ {
+			assert.True(t, completions[i-1].Score >= completions[i].Score, input)
+		}
+		for text, score := range scores {
+			if score > completions[9].Score {
+				assert.Contains(t, completions, Completion{text, score, quranizeTest.Locate(text)}, input)
+			}
+		}
+	}
+}
@@ -1,5 +1,9 @@
 // Package corpus provides corpus in go string.
 // Original source of Alquran is taken from http://tanzil.net in XML format.
 //
+// Alquran texts, translations, and metadata are large, so each of them is a sub-package storing it gzip-compressed
+// and decompressing it on first use: quransimpleclean, quransimpleenhanced, idindonesian, idmuntakhab, and qurandata.
+// A binary only contains sub-packages it uses.
+//
 // See http://tanzil.net/download/ and http://tanzil.net/trans/.
 package corpus
@@ -0,0 +1,70 @@
+// +build ignore
+
+// gen.go generates a corpus sub-package from a Tanzil XML file, storing it gzip-compressed.
+//
+// Usage (from corpus directory):
+//  go run gen.go <package> <tanzil xml file>
+// e.g.
+//  go run gen.go idindonesian id.indonesian.xml
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: go run gen.go <package> <tanzil xml file>")
+	}
+	pkg, path := os.Args[1], os.Args[2]
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	compressed := &bytes.Buffer{}
+	w, _ := gzip.NewWriterLevel(compressed, gzip.BestCompression)
+	w.Write(raw)
+	if err := w.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	source := &bytes.Buffer{}
+	fmt.Fprintf(source, "// Code generated by gen.go from %q; DO NOT EDIT.\n\n", filepath.Base(path))
+	fmt.Fprintf(source, "package %s\n\n", pkg)
+	fmt.Fprintf(source, "// compressed is gzip-compressed %q (%d bytes).\n", filepath.Base(path), len(raw))
+	fmt.Fprintf(source, "const compressed = \"%s\"\n", escape(compressed.Bytes()))
+	if err := os.MkdirAll(pkg, 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(pkg, "compressed.go"), source.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// escape returns content of a go interpreted string literal of data,
+// keeping printable ASCII bytes as they are to keep the source small.
+func escape(data []byte) string {
+	s := &bytes.Buffer{}
+	for i, b := range data {
+		switch {
+		case b == '"' || b == '\\':
+			s.WriteByte('\\')
+			s.WriteByte(b)
+		case b >= 0x20 && b < 0x7f:
+			s.WriteByte(b)
+		default:
+			fmt.Fprintf(s, "\\x%02x", b)
+		}
+		if i%4096 == 4095 {
+			s.WriteString("\" +\n\t\"")
+		}
+	}
+	return s.String()
+}
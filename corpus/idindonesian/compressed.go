@@ -0,0 +1,85 @@
+// Code generated by gen.go from "id.indonesian.xml"; DO NOT EDIT.
+
+package idindonesian
+
+// compressed is gzip-compressed "id.indonesian.xml" (1352084 bytes).
+const compressed = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\xfd[\x8f$\xd7\x96&\x88\xbd\xd7\xaf\xb0:\x03\x88\xee\x80{vF\xde\xf3d\xd5\x10\xc9a7\x0e\x99\x95\xa7\xa8\"\x1b\x8d\xd3\xc0<,O\xb3t\xdba\x97\xf0cf[\xd9\xc17\x09=\xf3?\xa4\x07\x95F\x80 \x0c\xf4\xa4\x7fr\xea\xdf\x08{\xdd\xf6\xda\xdb.\x1e\x91d7\xc4\x87\x88d\xb8\xb9\x99\xed\xfb\xba|\xeb\xfb\xfe\xee\x1f\xbe\xfd/][\xfc\x9f\xaaatw\xfd?\xfe\xe1\xe6\xc9\xd3?\x14U\xff\xe9\xaet\xfd\xf9\x1f\xff\xe0\xa7\xcf\xc77\x7f(\xbe\xfd\x1f\xff\xee\x1f\xfe\xfex\xfc\xbb\xbf\xfb\x1f\xfe\xf1\xf7\xf8\xef\xef\xfe\x87\xbf\xfb\x1f\x8a\xe2\xff\xe8\x07\xe8\x8b_\x06\xe8\xc7\x16&w\xd7\x87?\xfe\x19\xba\xea\x8f\xc5wP\xc3\x08\xc5\x0f}y\xd7W\xa3\x83\xf0\x89\\x7\xfc1~\xd0\x17\x1f]\xef\xc6i\xb8/\xee>\x17\xffR\xb5\xee\xec\xee\xfcX\xbc\xff\xfc\x19\xdc0\x86\xef\xfd\x13\xf4g\x0f\xe7\xca~+\xfc\xfd\x87\xef\xffX\xb8\xf2\x89K\xfe\xf8O0N\xc5\x7f\xbc\x940U\x7f,~\xf4}U\xbc8\x14\xcf\x9e\xde<\x0d\x1f\xfe|\xe7\x87O\xd5\x1f\x8b_\xa0\xff\xd5\xb5O\xfaj\x0a\x0d\xf9}z\xe4\xef\x8e\xc7\xff\xf1\xef\xfe\xe1\xaf\xa1K\xfe\xc7\xbf+\x8a\xa2\xf8\x87\xd1\x0fP\xb8\xbe\xac\xfe\xcb?\xfe\xe1\xe6\x0fE\x0f]\xf5\x8f\x7f\xf8\xdb\xbf\xfe\xdb\x7f\xfd\xb7\xff\xf3\xdf\xfe\xf5o\xff\xcf\xbf\xfd\xbf\xfe\xf6\xbf\xfd\x81.\xc5\xcb\xe1\xde^=U\xffe\xfa\xc7?|_\xf5g\xe8\x8b\xae\xea\xef\xab\x93\x9f\xc2=\xa0x\xdf\xb6P\x17\x7f\x81\xfe\\|\x84\x1a\x8a\x9f\xaa\xce\x0fP\x17-\x9c\x9d\xfc\xa5\xbf\x87{\xe8\xcfO\xfe\xf0\xef\x96\x9f\xf0L\x9e\xf0su\x86\x16\x8a\x8b\xbfu\xc5)\xdc\x00\xef~(~\xf15\xf4\xc5Xu\xd58A\x01-t\xab\xf7z.\xf7\xfa\xea\xb7y!w\xc0FuU\x18\xef\x11\\Q\xba\xe2O0\xb8p\xcf\x13\xb40B\xbfz\x8b\x97r\x8b?A\x7f\x0f\xc5\xbf\xef\xcf\x0d\xf8\xd0O\xe1\xc1E\x03\x9d\x0b\x8d9\x85\xa6\x95\xd0\x175^\xd5T\x17(\xed\xc5x]Wu\xae\x9f\xa0\xb8T\xc3t\xd7\xde\xf5\xe7\x8d\xc7\xbe\x92\xc7\xfe\xe2\xfb[\xdf8\xbd\xc9-\xb4\xd0\xd3\xc3[?\xf8\xf1\xb0v\x87\xd7r\x87\xdd=\xb8\xc9\xef\x8b\x1f\xf1\x9bw\x03\xf4\xe7#\xfe\xa4\xbbLU\xb87\xbdjq\xaa\x06W\xf4\xae\xe9`\x92Ft\xd5P5\xf0\xae8\xf9\x06\xfab\x87\xcf\xdf\xf3_\xe9\x0e\xa5\xeb\xfc\xd0\x84n\x85^.\xbb\xf8\x16\x8a\x85k\xc7j\x84I[\xfd\x0f\xff.\xcc\xe5\x85i\xfd\xccN\xeb\xbf\xfd?\xfe\xed\xff\xf2\xb7\xff\xfd\xfa\xa4~\xdf\xba\xcfE\x0b\xd0\x15\x9ds\xdd\xf5)\xfa\xc1Mp*v\xef[\xdap\xf6\x85\xeb]1\xb9\x12\x9a\"4\xbc\xa9\x068{\xe8\x8b\xd0\x0d\xfd=\xbc+.\xd5\x84\xa3A\x13\xda6\xebT\x0d\x134_\xe0pu.\xcbhd\xdfv\x1d\xf4\xd2\xe3\xf8\xb7s\x0d\xeet\xa0\x7fwU_\xba\xc15a\xe1\xd4\xd0\xc2Ds\xad\xabz\xf8\xdc@\x8d\x7f\xafNP\xc3\xd9A_\x0c\xd5\xafU\xe3\xe8\x9b\x1f\xa0s\x05\xf4\xfe\\\x0dt]2\xa8\xd7\xd7\x0d=f\xf5Mg=hfT\xe9&?\xf8>>\xb4\xf3\xf8\xd6\xf8\x9dc\x13~\xae\\>V\xa7\xaa\xf5]\xe7\x0f\xc5\x18\xfa5\xbeA\xe3\xfa\x02\xc2%4\"\xc5\xae\xa9jW\xfaKx44\xb5\x1b`Zm\x93.\xe4\x8ft77\xc5e<U\x13\\\xb0\x9b\xe1\x02S\x1c\xe7\x12\x06\xc7;\x16\xbd\x83t|\xf8w\xf8\xf6l5\x9d\xaa\xc1\xf7\x93\xef\xcf\xd7W\xf6\xcf\xd5\xe8\xfb\xf3\xd9\xd7\xa1%\xf6F\x0d|v\xc3\xa1\x18\xa1\x83b\x84[\xb0\xd3\xedP4\xa1#q\x9d^\xaa\xc1\xf5g\x98\xa0/`\x02\xcfSw\xe9\xf3\x03\x7f\x9b/\xb9\xf5g\xa0~\xe4\xf1|ru\x13\xa1\xb3\x81\x86\x0a\xf7\xd1\xfe\x93;v0\xb9\xa2\x0e?B\xaf\\\xaa\xbe\xac\xfa3\x0cYo]\xaa\xfe\xdc\xba\x1a&\xfd;\x0e\xf6\xe4/O\x8a\xef\xa1\xb7\xad+F\xd7\x8c<\xd5\xa0\x83)\xbc\xdf\xc6\x90\xbe\xd1\xe3\xcc\x15\xd0O0@\xd1A\xefG\x07\x85\xae\xa3\xf0\xb20\x85\xc6\xfe\xb1\xf8?\xfc\xd5\xdfM\xefpMd\x13\x99\x9a\x17\xde\x16\xcf\x85\xa6\xea|\xe9\xa0?\xd07p\x07(jh\xe55\xdd\xe4\x8b\xd1\x0e\x1f\xedzK\xb3a\xb3{\xdffs\xb2\x0e3\xb0\x09\xef\xec.\xde\xbc\xd4\xda\x8d\x0f\xf8f\xe6\xc5\xe8\xf8\xe1\xef\x87=#\xfc\xefH\xdbG1V%\xf5\x88\x99\x0a#\x940\xac\xbe\xdf\xcdS\xedah\xa1\xa3\xb1\xe6\xefC\x09ah\xc3\xa2\x9c\x0eE\x0bmx\xe0\x04\xe1(\xe47\x97Oq\xef,\xd7G\xfaR\x95.\x1c\x9f.lb\xa7&N\x93S5\x94~\x9c\xd6\xf7\xa9\x9bh\xd0\x84\xdb\xbb\x16\x8a\xd254\xdc\xe9V'\x83\xff#\xf4g\xe8\xf94\xf5\xe1H>y<\xeb\x06?\xe2\xb7JWt><\xdbw\x8e\xbe\xf2\xa4\xe0\xd1\xe9\xaa\xfe\x16\xbe\xc0I\xee\x95,`<\x9cg\xe3\x84\xb3\xaf\xc4\x1b_\xaa\xe1\x04\xae\x81\x9e\xef\xba\xda&=\x9f~\x083\x17\x8d\xa6d\xaeu\xc9\xfe\xb5\xf0\xc8\xacI\x07\xda\xde\xdc\xa3\x06^\xcf\xab\xf7\x17xH\xbf~G\x13R;6\x0c\xe5\x19\xc2\x9f\xd2\xbd\xad\x05\xd7\xf36\xc2s\xf8J'\xbf7\xdbT#F\xd0\xda\xddim\xdc\x95w5.\xd1\xe49\xdf\xd2\xfd\x8a\xcd^]\xde\xd3\xc3\x0d\xdf-\xf6\xe2\x04\xb5_\xef\xc4\x17\xb3\xd9\x19'\xf6\xad\xef.P\x94d\x87\xaf\xaf\xefN{ei\x17[\xec\xc8\xfca\x0d\x1a\xb9N\xc6m\x0c\x06\x08\xf4G\xfe\xad[\xf5\xea\x93\xe6\xd3|\xac.\xb4\xa5@/-\x08\x83~\xa0Oq\x0f\xe2\xf7\xbak\xef\x9ac\xf8qm\xd2\xbfL\x0f\x1a\x9ch\xbb\x8e\xcd\xf3}\xa1\xf71g\x08\xfe\xb3;9\x18\xcc_\xa7j\xb8\xebN\xa17\xa1;\xb9\xfe\\\x94\xb8q5\x15\x1a\x9ez\xdd\xfa\x8b\xbcZ6\x13\xb2\x05V\xb5\xce\xdc\x93\xfb@\xcc\x86C\xd1\x81\xcc\x10\xee\x07\xb2\x09\xc2\x1e\xd0;8C\xda\x0a\xbd0\x0eAj\x87\xac\xbf\xad\x9e\xcf?UC\x98P\x9d\xb95\x948\x0aa\xb0\x86\xc9\xa5-\xc0\x11j\xa0/\xe0\xe2\xf8u\xc7\x8afS\x98\xe3a\xf1tU_\x85\xaf\x84\xe1n\xaa\xd6\xb5\xae?\x87\x19@\x03T\xbb\x16\xfas\xb8\xc3'\xa8\xc3\x1b\xed\xf4\xce\xae\x87\xc1\xed3\x83)\x1f'\x19\x96s\xd5\xc2\x05\xf7(\\O\xd4\xee\xaeB{a\xbd\xd9o\xb2A\x9a|\xeb\x0e\xc5\xc9\x8d\x9e}\x90\x09\xf2A\x90N\xa1\x1d\x8cV\xc4\xae\xa9\xac?u\xaaz\x18\xf6\xab&\xfc\x8d\x9e\xd6hmI\xbf\xeef\x8b\xb7t\x93\xeb.\xb0/j\x7f\x0b}\xd1V'\x98\xc8\x94\x0c}\xe6&<\xe9\x86\x09\\\x81\xad/\xce\xbeu\xe1\x85\xcf~\xf0t\xde7\xae\x85\xe9\x9d\x99\x0f\xf7\xbe\x0b7\x99\xaa0\x0ax\xc6\xf3\x9c\x83>\xd8s\x80\x07}X\x7fC\xd5CX8l\x8a\x15\xa3\x87\x01\xf6a&\xa1I\x19\x0e\xd8b\x82\xc6O\xd4\x13\x1dL\x8ev\x0c\x1a\xd6\xd0\xf3\x17?-\x9cdh\x94\xae\xfbTO\xa3\x9b\xdc]\xdcp\xac\xf1\x175D\xa6\xd3=t'\x18\x16\xec\xc1'\xc5\xcf\xd5\xe4\xe0R4\xb8O%\xdf\xc1\xd9\x94oRa\xff\xc4q+]q\x82/a\x96\x87\xeb\xc2\x97h\xc2\xe1\xfeG\xbd\xdbU}\x18\x8d\x85[\xd4U\x1fZ\xff\xa3k\xa0\x05\xaf=\xd0\x9f\xc9\x0cs\x87\xa2w\xe3\xa7\xd0\xca\xef]\xf8~\x1b\x9api2Sw\xd9\xc4}R$\xdb&\xdd\xfbT\x0d\x8d\x87\x11\x0a\x98`,F\x8a\x90\x84S\x08\xa6\xf5S\xe4\xd9M\xecZ'\xf6\xed\x81\x83\x0e\xe1\xae\xe8\x9ft\xde:S]\xd5\x7fr\x17\xdc\xc5\xd9\xeb\x9a\x8d\xa7q\xb2 L\x14q\x1b\xb6\xdd\xd8g\xcf\xa2\xc9\x0d\xea>\xe1\x99]\xa2\x7f\x1a\xcc&9\x9e\x8b0\x09``\xc3\x8f_\x84\x97\x80\\\x02\x13\\h\xc8\xa8\x8f{q\x02\xc1\x0d\xc5\x0e\x17\xd0\xde\xae\x1d\xb64\xf9\xe2s\x0d\xa3k\x9b\xb8\x05\xe3\x17\xd8<\xc7\xde=y\xa8\x8f\xe1\x07\xf4\xfaLv\x90\xc3\x9e\xdct\xfe\x9d\xac\x9a\xf0\xad\xdb\xdc<T\xf3m\xac\x1a?\xf9#\xfdJ\"Zb\x82\xeb7\xaa\x09j\xef\xd6\x07\xf4\xb95\x0bn]\x03\xf4\xd5\x1d\x1a\x18{\xdd\x1a9\xfa0U\xfd\x14:Y\x9cl\xe3\xd8\x7f\x81\xfa\xde\x1b\x93\xac\x0e\x0b\x8c>\xda}\xf45t\x1d\x94\xfbC\xe8\x83\x09O\x02\x08\xfd\xe2\x07\x98\x8a]\xf0+\xf72\x13:7B\x1b\x1f\x10:\"\x8c\x08\xdc\xd2\xd6y\xa9z\x0cW\x1d\xe5\x1fh\xdf\xa1%\xc7]\x10\x1b\xb1`\xc9\xf4\x1bv\xe6\xb3\x171\xc6\xd7\x80\xb9Oz\x1c\xa0E\xbb\xeb\xefa_\x1c\x8f\xb4\xe2`\x9c\x9c\xbd\x94\x8c\xf7\xd9\xf5\x87\xe2\x82\x87\xc9\x00\x14d\x18h\x1e\x0e\xae\xe8\xab\x014\xac\x81\xf3\xe3\x04\x0d\x0ch\x0b\xb2\x13I>K\xd8Sx_\x1f\xab\xd2\x91\xdf\x0cg7\xf7\xd9\xd7\x9b\xf9\xd2\x0e\xf9\x18\x96\x85k\xa0\x17\xe3m\x82\xe2\x1cN\xc8\x01R\xe3:\x8d\xb9\x94d\x08\x87\xa6\x15'p\xcd!\xbc\xf6\x97$:`_q\xf4\xc3\x19\x8e\xf8\xd3\xf8\"\xad\x0bgB\x7f\x06w\xa4_E\xe9h\xc6\xf5\xf7q\x1f\xd6\xbb\x85g\xcbz\xb1K\x09\xbf\x91<\x02\xb7\xdeU\x1b\xf2\x87\xde\xe9n\x11\x8c \xa8\xf9\xe6f\xf6\xa2\xf1XB\xed[\x9f\xf9\x04\xfc\x1e\xf6\x05x\xe6\x0e\xfeB\xc3\x84\x8b9\xbe\xb76\xa9\x08wv\xe3T\x0d\xeeH\xbf\xf8\xab\xfe\x933\xb1\x9c\xa2\xa9\x1ah\x93\xbeX\x1d\xcb\xe5\x00\x0e\x07H\x1c\x9a\xd8\xd5\x99\x8d\x1e\x8fV\\4\xcdN\xf4\xc6\xe1(\xf4\x0dEm\xf0u\xda\xea\xe4\xeab\xa8\xfa\x12j\x9a\x9en\xf2O\x8a\xf7%\\\xfc\xa6{\x00M\x16\x1e\xa39a\x9f\x19\x963\xae\xc2\xa5pV\xea\xd4\xc4\x93~a\x0c\xdf_\xa0\x81:<r\xf4e<-e\xe7w=o\xa9\xf6\xe1\xdf\xaaS\"&r\xf6^\xd0\xdfCS$\xc6\x13Z\xd5\x0d\xe8\xe6RZ\x0b;\xfd:\x86\x99\xf7\x8bw\x09]t\xfc\xf3=D#\x1a\xcd\x9c\x18\xde]|Z\xd1T\x9f<\xb4\x0b\xf6\xcfg\x18]\xb3~.\xce\xa2\xed\x0b~y8\xc1\xceh\x00\x0d\xb7\xd0\xdf:}h0\x01J\xa8\xed\x07\xe4\xbe\x9e}\xad\x06\xb4\x9f\xfcH\x16\xbb\xbe;\x06\xf8&\x0a\xec\xd2\x9dv\xc9\xfe\xb1\xd7e\xd1\x9fk\x7f\xf2h\xb0\xa3\xf1\x08\xfd<N\x90\x84>\x9e\x14\x0b\xfe\x8fm\xce\xe0\xcf\x1bG\x9c1\xd0\xfbsxc\xdc\xa9if\xd9\x90[vzNP:\xda\x80'\xc7'\xbd\xb1\xcaB\xa4\xb7\x89\xbe&\xc7\xe8\xe8\x9b\xa5\x0b\xdfix\x9b,\x9d\\\x8cS\x80\xed}\xfb\x1d|\x87\xf0\xe1Q\x0f\xfb\xd2\xf1u\x0d\xf4\xdf\xae\xb6\xecmf\x00q3r;\x88\xcd\x0f\xfcshm\xe9\xc84\xa2\x01i$\x18\x1e\xec\x98`\x15V\x1c\xf9\xdb\x19\xcbm\x9f\x9a<\xa5\xe3{c\x93&\x7f\xebk\xbe\x80fv\xb8\x15\xa6\xc5>\xaa\x09\xf2P\x13\xf3\xf9\xd3<\xe4T4U\xe8M\xb5-O\xd5\xf0\xd9\x0d&`z\x81!<\xae\x05\xd7\xc0\xb4\x18)x\xdfx\x13\xcf\x8c\xfd\xc2\xe7e\x0d\xad\xfb\x0cu2\xe9\xb2m?t\x0cL\x1ab\x92\xa9\xc4\xa9\xaa\xf9\xbdwr\xd3\xbdvwXFf\xd2B\xb38\xf19\xc1#I\x95\xb0\xc5\xd44\x95\x86l\x8a\x86.\xed\xa1\x9f\x1c\x8c@\xd6\xf2\x186\xeeR\xd2\xa9]Hw\xf2\x0b\xf2\xdd\xc2I\x13nF\x7f\x95(\x14\xed\xc2\xda\xad\xab=\x18\xed\xc9\xb8\xf0M\x9c\xbf\xa1\xcf\xaeDW\x9e'aR\xb1\x9d\xe1\x96<rY\x8f%tE\x0f\x1d\x1c\xc3\x8fbw\x0a\xfd{\xc4\x9f\xfb`\xee\x05\xe7\x94\x9cLYD\xf8na\xf0xWY\x9a\x1a4w\x17\xday\xf2\x13[@\xbc\x16?x|za\x9eK&\xb9\xda\x84\x1dtjQ\xae\x18\x9a\x7fO\xb7_\xed\x88gY\xe0 \x8f6\xe2\x02\xfa9\xd8\x064\\\x87\xfc\xc0\xc09\xd0\xe8\xf2B#\x18\x8f\xd58:6\x9f\x9a\xf5q\xf8\xf6\xbb4\xee\x18s\xc41\xf1nVp\xccv\x7f\xe7n\xa1\x19\xa1\x87k\x83\xfd<\x0d\xa5\xcd\xfa>\xb8\x91a\xb0\x0fl{\xd6>\x19\x08\xb1\x05\xe2T\xe0\xb1\xe8\xe3\xfa\xb4\xf1\xa2\xd2\x99\xdb\x98i\xb0v\x9b`'\xae\xbc\xd9w\xe1\x16M8\x10\xf18\xfc\xe0\x8fi\xcc9l\xfbd\xdf\x8c\x1b\xebd\x80\xe0\x15\x82\x06[\xc4+-\xa1\xb7\x97\xe9\x80\xe1\xdcj\xa1v\x83\x1c\x1f\xe9G\xc1\xd3\xf6\xfd=\x1e\x0bW\xa6W\x12\xf0\xdd9\xdeL\xf7\xb2\x9bJ\xe6ia+\xed\xf2\xad\xd4\xdf\xfaR\x8f&\xb3D%%E1;\xb9J\xadX\xb2^~8\xb5n|W8(\xaa\xfe|\xe6V\x85\x9e<\xf9\x01\xff\xcd\xb1A\x87\x01\xd3\x0eF\xdf\x14g\x86$<6\xf4\xf3<\xf1m\xd2&\xce\xa7\\\xe9\xa0CW\xe0\xae\xad\xeax\x0e\x92i\xde\xf9\x82\x9d\x89\xde\xb1\xdd\x03<7\xf1\x1f\xd0\x1f\xf9w\x18v\xf6\xdc\xd0\xf4\xc3er\x02\xd7\xa0!\xd0s\xde\xd4\x8c\xa1o\x80o\x99\xb9\xf8e\xd5\xc0\xe4\x8a\xcb]}\xd7\xd3s5\x90)\xd9(\xbcN\xfbi\xd6=\xbfB\xbb\x816x\xae\xee\xc2?A\x1bF\xb2\xf4t\xd6\xb8s\xd5\xba\xfe\x13\xcd:\xec\x0d\x89\xc8\xe3~\xa2^\x15\x1b4M\xd5z\xe0\x19\x8a\x19J(\x81\x804\xbe\xa5\x93k\xb9\xeb\x7f\xf1\x83\xd7\xd6\xfe=\xc5@\xd4j\xe2\xc3\xb3\xe8\xfc\xe8kr$\xef%Ks\x88);\xbc\x16J(\xa6\xaa\xbb\xe0\xc9\x19\x86\x91\xc3o\xbe\xe3\x8em*<\x1b1\x0c\x13\xac/\xf6s\x8b/\xd0L^#\xa3U?\xf9\xebI\xb0\xe7jO\x7f\x90\xd3\x06\xcf'\x0cJ;\xdcLN\xd5@\x86e\xeb:\x89\xaf\xe2\xa9\x8a\x87\x14.\x0f\xb5\x1d\xe9;\x13\xf8\x13L\xec\xe7\xce\\7\x81\x14\xd9k\x1f\x056z\xaeF\xef\x03F\x02\x07\x8e\x8d\x0e;\xda\x7f_h\x83\xf1\xe0+a\"\x9f\x99\x9c\x98\xe3\x07\xd9\x0c:\xcf\x8d<\x01N\xc5\xd1\xe9\xae\x1569\xd7\xf8\xc9\xd9\xaf\xc5`\xa6E\xb845|\x81\xc9\x0d\x04!\x18\x93\xa0=]\xc7.V\x8c\x9a\x86\x00C\x8d\x99\xe0kc\xa8\xa6\xf2\x9a\x1bK\xfe\x00\xef\xcb\x98\xe9m\xa0/\xe0\x1e\xa6c\xf8\x813\xfa`s\xee\x17tdz\x09\xde\xbc{\xbc\xf7\xfe\xe2\xa9\x8d\xa6~\x07\xbd+~\x18\x07p\xed\xa1\x90\xed\x9a\xacD\x02A\x85\xfe6\xa7z8i\xe6h\x9a\xce+\xd0\xc1\xd7\xb8\xb9\xa1\x17\xa7\xfbv\xd2\xfb\xe1\x16t!]eG\xf4\xdd\x0cBv\xfc\xe0\xa3\x83R\xc3\xe0G\x8e\xde\xef&\xdf\x97\xbe\xd9\xaf74M\x8dg\xe9Y\xba{n\xb4\x84wS\x14N\x8e\xea\xc1\xb4WO[\x90~\x8f\xce\xaf\x12\xc3\x97\xbf\x80\x1f`\xda/\xee\xb2\xb2\xd1\x98\xc1\x0f\xd9\x13\xe8 q\x0aq\xe9.\x7f\xdd70$\xb3#t\x9cD}A\x83\\\x14BY\xc0\xe2\xbdo|\xd6\x91\x12\xec^\xef\xc2gI\xa06}\xa5O\xd0]\xfcP@\xe9\x9bF\xe2Q54\xf2J|8M\xb5k\x97\x1adl\x8a\xf8U4\x8d\x18\xa4\xf1\xd0`\xf2\x8b$\x98\xcc(\xb1\xf0\x18\x01\x8aM\xbe\xd7X\xe3\xaf\xd0\x00\x19D\x83o\xfc7d\x83\x11\xc4j\xc1\xbfo\xfc7\xeb\x8f}\xb1\xe8\xe0\x8f\x98\xc12\x99\xfe\x1d6a\xb8%\x7f\xb6\xa9N\xd0L\x0e\xfa\xbc\x99\xad\xbfp\x04b\xc0\xac\xdc\x17\xb8u'\xe8\xf7\xd8S\x98i\x9e\x85\xd9\xbb\x13|\x0a[\xb7`\xd0x\xf6}K\xc6\xa9\xa6\xfd$\xadqq\x8d\x1bV\xbd\xfa\x17j\xc3\xfcH\xbe$\xf6 \x9c\x80v'\xeaK\xcd\x1f\xc408y\xde\x891J\xe7\\\xd5\xb9F\xc28\xfc!a\x99\x0ej\xa3\xcdB\xc8\xf8\xcd\xa6\xf6\xe3\xbd\xfff5\xd0\xf4\xe2\xd5\x03\x02M\x18\x09tb+\xdb\xccg8\x0b;o\x0fJ:\xe4\xf3\xebR\xcc@\x888\xacO\x85\xd7\xff-v\xd4\xa2\xb4\x16t!q\xbe\xfa\x0b\x8c 6\xbf\xe4\xba0x\xaa\x86\x9a\xcd\xad\xf9n#\x8b\xfc\xe2M\xba\xbc\xcf\xb3\x14\xc1\x0e~\x85\xd3\xbe\xa8\xf1\xdf\x8d\x83.\x8c\x1fm^\x94n\xa18mp\x8f%\xae\x91\xe7-\xaa\x16\xccz8\x14_\xa0\xc5\xdcq\xe9\x1a7]|O[\xfe\xeeT\x9d\xf1|\xc3f\xf2=\xdcD\xa6\xc8x\x0f\x9f\xe1\x1b^\xb8Su\xf2\xa3\x18\x81\xc9\x96\xb9\x98\xe9.\x1d!\x8d\xd7;\xe1\xed\x03\\\x96\xe0\xe7v0i\x17S\xe7\xfc\x077|\x03\xbe" +
+	"\xdf\xcb\xd9\x876\xc7Q\xfe\x81\xa0\xaf\x98\x0fp\xdd%q\xe4\x08\xff\x15\x03\xf9\xb88\x8e\xf8\x93\xec7\xfd\xe6=\xf6\xa2\xab\x0b\xe8\xa19\x86\x1f\x92\xebl\xa1q\xc7\xf0#\xc7\x18\x90\xf99\xbb\xfeR\x0dUw\xf1\xd0\xd3\xb2\x8dX\xdbd\xb9N\xd5@\xc3\xf7\xe9\xee\x04\xd0\x1f\xe9\x97\xc4\x18\xc6$\x8c\xde\xad\xc7\xd4^>]\xee\xd8C\xe6\x0c\x86\x11k\xc1O\x92\x8e\xe4h\xdfJ\xbf\xb3\x95?\x05\xb7.|\x88\x07\xf5\xd5\x81Hv[\xdeP\xb1k\xa1\x19]\xb3\x05~\xbfyX#(l-G\xedG?\x02\x81w8\xc1C{\xf3A\xe2\xdc\xfb\x82\xdc\x88\x8bo}\x8d\x9eo\xc7\xad\xb6\xb6B#\xe8\x866\x9c\x95\xc5\x8e\x12\xde\xe1\x18A\xf8\x91\xeb\xcfgh%j'\x1e\xcab\xa8z\xdbA{\xf9l\xe6j\xf0{\xe2t\xc0\x16v\x00\x9fi\xf6\x8e\xe1\x11\xd0\xcf\xd2\xe6\xe3\xbdo\xfc\xba\x93\xfc\xf2\xf9\x03;\xd2&\xc4\xb0\x1fg'\x1c\xb5\xb7\x9a\x10\x9c#\x13\x13;\x9b\x12\xd5\x0c\x84\x8d11\xfc\x02\xfe/\xbe\xbc}\xf1\x87#\x8d^\xbe\xb8\xd2\x00|Y\x8e\xd8\xc6p\x97\x0f\xc6\xb8\x8d\x02\x84?5>\xc3\xde\xb1\x83-\x81~\xe8\x1d\xdc\x83\xec\xc42_9K\x9f^\xbb5Q:\xbf\x17\x17\xa9\x1a\xc8\x9f3Q.\\\xbf\xb3\xb0\xbd.\xb3\x93\xef}\x1d\xcf\x83'\xc5\x9f\xa0\xc5\xf9\xc0\x93\x8c\x12v\x18q`p\x03\xdeut\xa3\xdb\xb8\xf5;\xeb\x97\xea\x81l\x1c\xce\xceg\xbe)g\x19lQ\xceU\x0f\xf5\x8a_\xf6\xf2\xe5\x95\xa1\x949m\x83\xefa\xec\xc2\x103\xc6\xcf\xe4\xdcS$u\xe7\x05Y\"5/\x88\x8b\xe1&\xb3qLs\xf7`\x81\x17\xd4\xf1n$\x88P\x0d\x887\x9b`\x98\x19\xc4\xb2g\xf5\xf7\xd7\x02\xa1/M\xa2\x96&\x8c\xae\xe7\x13\xf4\xe7\xc6\xc5\x8dU\x16<=\x04\x93O\xa3\xbf\xc0=<x}\xbf\x9e\x05\xc1z\xf0\xbd\xc4N\xb8\xdd\xf0\x058\xac\x82W\xcc\xeb#\xa8E\x1d\xf4\x12\xe5\xc5\x8b\xe9\x9f#\xb4_\xc0F`\xd1H\xc5\xb3\x88\x83b\xe2t\xb8\xe6\x18~X3ka{\x11ws\x01}(+\xf0\x03\x86\xabq\x94\x93<\xb1\x05\xfe\xd8\xd5*\xb7\xe0%\xbb\xde[o\x1e\xb8\x1b\xa6\x01\x94\x8f0\xfa\xc6\xb8\xb0E_\x9d\xab\xc1\x15\xaew\xc5\xee;p\x93o\x8b\x8f\xf0\xd7\xd2\x8d\xfb,z\x88\xbd\x84X!\x8cW\xa1\x851\x0b\x1fV=h\xf8p1r\x88\xf1?\xf4\xef/\xae\x9f|q\xae\x860\x91\xf0\x80\x85\xee\x14n^\x0d\x18\x92=\xf0\xa14\xf1\x0bD\x94\xf4\x09\xc6F\xfd@G/V\xde\x8d<\xb01L\x80=\x10\\\xcc\xde\xc5S\xe7\x98\x9c?\xf8\x88\xaa\x85\x86/\xe6\xed\x84\x10\xf8\xbb\x0b\x1d\xbf<\xd7\xf6\xb2\xed-A\x09\x14QrmA\xbdMB\x99\xcb\xe7,\xce\x893\xf4\x13\xd7\x9fLq\xd9\xa7\xce\xa0Ie\xa5\x99\xec\xb4D\xa9\xf8\x191\x8b\xbat'\xb1$\xd1\xce_y\x07\x0a\x9b\x1a\xd0\x18\xef41\x8a\x85m\xc6\x9c\xfez\xa1\xce\xd3m\xd3\x18O\xbc\xae\xea0r\x0cn\xd0\xdc.\x1ez\xd6\x8e\x9b\xcd\xe4\x9f|\xe3)\x05\x02\x93\xa7\xb7\xe5\x9d\xf1\xae?7\xe1\x18\x90\x85\x8e=\xddU\x1d\xf4\x9f`\x90\xa9\xac\xf9J\x0fhA\x8eE\x07\x13\x84wxR\xfc\xcc\xde\xe6\xe4\xe0r\x0c?\xc2\x0c\xb6\xa7+\xa7?8\x94\xdb\xb9\x1e_\xb7\xd8u0\xba\xfe|\xa4_{\xdebh\xde\x87k\xc2\xd7\x19\x08\xb43\x10\x0b\x1cJ\x03\xd4\xc8\xe2\x1c\x98\xdbn\x1d\x01%M\xa6W\xda+#\xa1\xb9\xd8\xf5\xd1\xb8\xf9\xbdN\xad\x93\x1b\x81\x9d\xfb\xddD\x16%\xbf\x0d\x02\xe4:\xf8\x04\x9dn\xa9!\x8f`\xe7 \x8e6\xaf`\xcd\xe5\xbb\xc4\xa8P\xdbP\x12\xad\x1a\xb9\x97`\xba\xcbR\x86\xa5\x9b|w\xf2\xb5\x00(\xc3\xe6\x14\x1e6\xc2\xbd\x1f\x8e]\xf8\xc9\x99\xd7\xc9u\x9e\xfc\xf6\x00{\x0d>\x8d\x9f\x1c\xa7e\xe1\x13G\xe4F\xe3\xd8\x7f\xe1\x8a\x1f\xa8\xe3\x81\x99\xd8jqs\xf5M\x02{\xc4\x0d\xcd\xc4\xd4l\xf8\x83\x17\xb8\x81.\x85\xed\xe3\xdb\xe2\xa7j8;\xbbC#\xee\xa0h\xee&8X\xc8^Wu\x97P\x1cP\xd5Y\xae\x0d\x0bd\x93\xb9\xcf`\xeaz9G\xe9\xc6\x89\x1d\x80\xaav=Pd\xc9\x94\x0c\xaa\x81\xdbTX\xa3*\x9e3\xce\xd8h\xd4\xed\xa6\xb0-\x95n\x9fm\x14\xc1\x01k\xa9?\\X\x98a\xd04:\x1d\xcb\xb3\x10Q\xd0\xfb\x9aRy\x7f\x86\x93S\xab\xdcnr\x12>\x0dh(\xe3p^y\xb8,\x91\xd2\x0f5h\xddC\x0b\xdd\x05\xbc\x0b\x1b\x08\x8c\xeb\x8b\xe6\xd9\xd5b\xc3\xce7\x9d\xeb\x0f\xc9\xdf\xfe\x02\xb5/]\xfa\xb7?\xc38@\xeff`\xe2\x9fk8\xb9p\x03J@\x84\xf5R\x94N\xaa\xf1\x12\x18c\x0f\xc3\x11\x7f.V\xdf\x1d(\x9e\xa2\xe8\x02\x86;B\x07m1B[\xd5\x87Y$+\xdc\xa4\xb8@\x0d-,b\xdb\x96\x13\x1d\xc9\x14z`\xaac\xbd\x87\x1f\xe4\xd8\xc5\x05E\x8e2\xbel\xea\xd2\x03n\xfc\xa1eg\xdf\xfb\xfe\\\xec~\xa9\xfd0\xba\x1e\x10\xcd\x12\x86\xb9C\xe7fP\xf3@\x8f\x95\xbd\x9e+\xd5\x19\xfa3Uj\x9e}}\xc4\x9fq\x89-Z\x81\xf8\x0a\xf2\xde2\xe9\x92\x00\x7f\xe9$\xad\xb2\x84\x15\xbfb4\xbcz1s\xaf\xe5\xeb\x17h\x1d\x06\x7f\xe8p\xda\xf1\xa9\x16Qp{F\x95\x02n\xef\xad\x96\xb8\xe2\x90\xc2\xe0{g+7\x07\xa8C|1\xa0\xa3\xa8\xb7\xa25%\x19\\\xcaq\x17\x0f\x85\xb1\xbdJa\xbbv\x09\xd1+[\x0c\xce\x16\xe2O\x16\x84\xb8\x898\xd3\x7f\x86\xd3\xe4\x17\xcd\x84\xb5\xd2\xc5\xd2\xee\xad\xe2\xe3\xd7\xee*$\xe4\xd5\xab\x04e\xfd\x81x\x04\xca\x98}H\x02`\xa6\xacx\x16\xa3.]\x07#\x98:\x8b\xbc\x10\x9e\xf3\x95Z@\xab\xdb1\xe5\x7f.U\x0b\xb70,\xdd:\xa9\xa3_o\xca\xeb\xdf\x1e\x87XH\xfeb\x89\xcd\xe0\xe3\xf9\xa7Q\xc7\xb1\xaa\x9a\xbb\xa1\x18\xe1\x12\x06h\x8a\x9d\xbd\x82Rc\x94,\xdeg\x0eQ\xc3\xc3\xff\xe4\xa1.\xaa\xdb*\x02J\xc4\x96\xccK\x1fq\x99\xb4\xae/}\x7f\x96\xc6\xd0\x0b\xe3:\xa4\xd5 };r\xb9\x17\x8f\x14\x0c\x0b\xfd{\x0b\xb5k\xaf\xcd\x967\xd7@R\xc6\x06J\x0d\x1fc\x12\xa56\x10:\xfcs T\xec\xd4\x02\xa8\xdf\xdc\xe4\x13+e\xb3\xe66\x83\x11\x096\xc8\xdc\xd5Dl\xec\x9f\x8d\xef1yS\x84Wt\xbeD\x9e\x87a\xaa\xfa3\xd41\x9c\xe6&\x09\xde4\xec\xc1P\xad\xdc\"\x16W\xb6\xd6k\x1d\x9d\x97\x7f\xe7&\xd9C\xfa\xf9j7\xe3K~\x81\xa1\x87\xdc\x02\xfco\xd8\xb7\x8d\xef]\x88\xf0\x98\xff\xc1\x9e\x96\xf78\x90\xcb\x8d\x0b\xad\xe7w^\xaad\x86\xbe$\x0f\xfbJW\xbe~\xfa\xdf\xa5+c\xd5q\x0d\x8dk`\xca;D=\xcd\xe4\xb0\x18\xa5\xa6\x12\x9d\xacz_\x8c\xd0\xc1\x90\xb8\x03\xfd,\"\xea\x0a\xd7\x8f\xf7y\xb00#\xa8\xd8\x09\xc0[\xediy\xd6\xfeZ\x8f\xa9?\xb5\xe4\x0d\xfc\x8eS\x81\x82\x81Z\xf4\x11\x96\xb9S\\zw\x82[h\x8a\x09z\xa8\xcd2\x0c\x86X\x11\xb1\xeb\xe0\x06\x87\xd7t\xb1R\xf4T\x0d\x9f\xe0\x13L\xf2\xffP\x92/lg\xcb\xca\x16\xfds\xd5\x10f\xe6\x04\x83\xb7\x18\x03\x1d\xf3\xa5\xd1\xd5DU\x8fEB\xf2\x88\x0f\x11\x0d\x9bg\xac$9\xc1\xb5\x90h\x1a'\xd5\xe3]\xd5\x12\xa4\x93\xf9\x01(Z\xe26\x90\xda\xaf\x9f=\xc4\x0bV\x7fD\x0e\x03)h\x8aI\x96\x91l\xaf\xc9\x97\x1eO?\xfc-\xa5f\xe1\x0dLQh<\xc6\xee\xd1\x0b\xba$\x10\x10JR\x15\xaew3h\xc3z3\x9e'\x91\xa4+A\x92\x0e\xdd-\x13%1d7\xd0\x9f\xcfw\xd3l62\x0eX\x1d-E\xeag\x95\x04\x9c\xe6^!E\xa2H0\xe1\xce\xc2\x02\xc3P\xb6\x00\xc6\xb1\\\xa1/\xe1\x88?\x8b\xa6\xc2\xd2N\x86\xe7')\x96s5lx\x10\xaf_,\xe4\x9fb\xbc\xba\x86\xc9\xd1m\xf0\x90o\xaa\x01\xd3\xdbT{L\xc5i':u\xc8\x11\xa7\x0b\xc2\xee\xfa\xa4\xf8\x89!\x13\xea\x8f\x85\xeb\x8f\x1as\xe2%\x9eq\xa5,T\x88\x99\\3\xa3\x11\xe9~\xe1\xffg7\x99\xaaA\xb2\x9aa\xb2a\xf0\x83;\x13\x0ap\xc3#o\xd7U\xad\xef?\xf9\xa1\xb8\x85\xc9\xd7\xba\xbf\x12\x0a\xca\x9aDv\xc6\x8eU\x03\xad;\xd2/^l-\x9e\xe8Y\xe4El\xe9\xdb\xcd\xd8\xd3\xeb\x97\x86\x0d#FI\xc2^N\xf3\x09\x06Z\x14\xd6A\xbd\x84\x0dJ\xf1G\xc1\x1b\x11\x04\xcbX)\xd6\xb6\x8c\xc5\xcdE,\xda\xe6}\x96\xddb\x0eA\xc85g\x7f\x02:U*\x09\xea\xf1G\x1d\xd4\xc0\xc1\xed\x94\xea%\x86\xfd\xbe]o\xe3+\xbb\xb3\x00\xb3~|-_E\xb6\xe3\x86\x05~\xf1\x19\xf1\x87 \x999\xb50\x7f$\x95\xd2\x85\xb3R\xfe\x18\xb6\xd0\xb4C6\xac\xefPJ\x83\xc8\xf4YP\xb9\xd8\xcd# \xfb\x1c\x0c\x87\xf8\x0b>\x0f\xa46LF\x92\x93C\xdc\x1b\xea=\xf1\xdd\x05\xfd\xd1#\xa2\xa4\xa1\xb2\xe4[\xa81\xc3U\xd4\x10>\xef\xc5\xfexG\xd3\xd3\xbe5\xee\x17W\xa0\xe6\xaf\xd5\x0f\xfaE\xbe>\x1bl>\xa8\xe3\xbe\x97V\x05\xdd\x9b\x19b6m6Ff\x97\xf4\xf7\x94\xceX\x05Q\xbd~\x93B\xd1\xd2\x18\x90.\xe8\x93\x9f\xa0\xa8\xfd\xe0?\x1f\xa2\x03#\xaf6\xcbvG\xbcTy\xd7\x9f9h\x1c\x8c94,b<,e?*\xfd\x19\x8e\xe1\xc7\xfat\x7f\x9b\xb8\xc4M\xf5\x09\xef\x06}q\xff^\x80\x1e8W\x17\xdd\xd4p`\xb6n\x8c\xaf+\xe1{J\xc9\xa7i0\xad\xdf\xe2|\x10\"c\xb4,\xd6\xc4#%\x07\xb4\xe3\xbbQ\xa5e,\xe2S\x1b\xaf\xa9<\xf2\x89D\xf8\x0c\xc2\x89L\x95\xe4\xc9\xa3\xff\x8e\x07\xf9\xac\x810k\xa0\xc4\xc2\xa0q'\x98\xd2\xc0th'>u\xa5q\x14}\xfd\x9a\xbb+x\xf7\xca\xf6\xfb&I\xc4\xac\xef0\xb2\xed\x9bM\x9fAPc\xd5O\x9ek\x0e\xe0\"\xf0\xe18\xb7\xd8\x86QP9\xc6g\xc2f\xa3V\xde,\x95\xf7\xb3/\xa1n\x12\xe3\xd1u`\xe3{r\x14\xd5\x08U\xe1\xff5\xaf\xd5U\x9d\xef\xcf\x8d\x1b\x04\x09\xfcg\x0c\xb0M&\x10\xaf\x13\x9aKq\xc3\xe1\x8a\xfb\x07\xdfm\xa3$\xec\xca\xfe\xf1F\xcd\xff\x1dV\xdd\xe8&\xb6?\x98p\xed\x1f\x19]N\xb1]\x8dD\xdf\x8d\xb4\xec\x1c\xe8^)\xb4!\xa1\x87\xc3\xe7\x16\xc4\xc5%\xa4\x19x\xfb\xf0x\xf0\xf6\x9b\xc4\xfa];\x848\xe4\xb4\x1c;NP\xe4\x88\xba\xff\x0a\x10\xf9\x9b\xaf\x0f\xfa\x1a|d\xc1\x08\xce?\x163R2t!\xa0\xceX\x16\xca$avRX/\x9fm\xee\xe4\x8b\x13\\\xc2\xb4\x06O\x04\x12\xa7\xe0\x1f)\x0e\xae\xb8\x87\xc9u\x87Y\x08\xbfsc\xe34T\xe7?\xc1En\x0c\x13`\x09VD\x16\xe8A*<$3\xc81\xde~\x86:6\x8e\x92!m\xe8\xaa\xce@\xe1\xd9\x81\xd65\xa9F~S}B\x185e>\xb9\xfe\xb7\x8c\xe0\x0d\xc9\x93P@y}\xd8^\xfc\xb6X\xbd\x8eWN;1\xab\x19\x0d\x17\xab\x13\x86\x9d\xbc7/\x9c~\xf3\xecG7\x08\xd8i7\x82/)P\x1c\xda\xdf\x9fG\xd8\xeb\x1b\\<\x02\xc5[\xe8(\xc9\xd8\xe4\x01u\xd7\x0c!\xa9)\xbb\x0bv-\x92f\xa4\x18\x9ap\x8e\x8c\x11F\xb3\xde_/\x97C\xf7;3\x89\xf7\xd1\xd9\xdcn\x82ikf\xff\xea\x98.6\x94s\x9b\xa1\x81\xd0O\x8c\xfc\xee'\x1f\xb7B\xb1\xba\xb4\x067\xdd\xa4.\xd5\x80\xe5M\xd0\xab\xa9\x89\x955\xf25\x09XG\xf4\x12\x9c\xc1\x15SH\x9dB\xcf\xcfF\xd8\xad\x1ed\x91t\x91[d6\x97\xdd\xad?\xc3>\xbc]+A\x863\x02\xc8\xaci\x9a\xe5\xbf\xccT_\x86\xfbQ\xf216\xd9|A\xeb\xb5\xbe-~q\x1c\x83aNcc\xbc\xa4X\x0f5X\xa5\xfb\xb1\x98\x08\xf7\x1a\xb2\x951\xaf\xaa4\x1fJ\xb2Z\x94\xbew\xc2\xea\xa9y\x0c\x02Fk\x7f\x9a*zm_\xe4z\x1c!\xac:\xe6\xf5L\xce\xc4u\xff\x8c\xed\x99\xf5y\xaa\xae\xcb\x0f\x1b\x9c\x88D\xd9\x96\xb4D\x81)s\x1aYK\x1fVhmB\xb8:\xfc\x9b\x1a\x94\x10\xd1\x0dU~\xf9\x15\xe4\xf5\x9b\xd7\xab\xc9%\xc3\xed\x87!\xbfI\xdd\x90|r\x18\xa4\xa8\x05\x96U\x9a\xd3\x18}\xeb\x10\xde\x112+~\xf0\xd3\x11\x7f\xee\x13\x8c+w\xc3\x00\xa3o\x8f\xf8\xf3\xc0`\xf3\x19v\xec\xe4\x9b\xc9\x1d\xf1g8\x83\xaa\x1e\x86\xd0\x81\x9don\xdd\xaf\xe6\x8d~\x18\xa1\xb8\xf8\xa9\xc2R\xf0\xe1\x1e\xba\xf8v\xb4\x015\x1e\x91\xde\xf2\xec\x7f\xf1\xb5\x0f\xf5F\xa5\x1fu\xa9\x8cDF3_\xa14\xb2\xf8\xa2\xb4\x1f|Q\x8e\x83b\xa7)\xa6\x04b\x84\x1f+\xec\xa4\xa9\\\x7fv=\xc6\x7f\x13\xe0\xf1\xfd]w\xba\xeb\xcf\xef\x047\xca\xf6 =p\x97\xf94{\xfa\x9a\x16\xae\xd1\xf9\x9c~E\x97'_\x8c[\xe5\xaa\x0b\xf5\xe6\xcduK\xf7O0q\xd4x\x0aC:\xf9\x8b\x18\xa9\xbf\xd0\xee\x16c\x94\xc5\x8c\xb5\xd7\x90\xe4\x08\x9e\xb6\xa2\xcdE\x9f\xf7N\xe8\x00\xc9\xa7\xe0\x18\xca\x02!\xd1\xfa\xcc~\x9b\xcel\xb6\x11\xed0\xca\xfd\x94s\xcaX\xcc\xd7\xab\xcdf\x1b1CN\x0d;\xea\xc9\x81Ag\xed\x9a\x8a\x1e\x0f=\xc23\xa2O\x15\xe1!R\xbb:\xc3\x951\x1dsB\x92\xb8\xd8\x98,\x80\xa0f%\xda\xe3j\x81\xe2|\xe3\x0d]\xab\xde\xd8Kk\xa1\xe9\x05Y\x82u\x7f\xcb 7\xfe\xf6V\xa0\xf8\xed\xd3\xc8\x02\x80\xbbG]\x9c\xfc\xe0\x1b\xdc\x0b\x08\x09\xa9nbJF\x1e\"\x8d\x1e\x0d\xe1\x94=X\x97\x0e\xf6G\xdc\xdc\xe7a\x13\x81\xb5\xb2\xd9\xca\x13-|\xbf\x99\xc5$\xe4ZN\xe73]\x0b\x9f\x1a\xc6\x0f\x15\x86\x14\xf4\x92bp\x01\x19\xcf\x8e\xf43\x14)\x15\x1f\"\xa48\x87\x00!\x00H\xf7\xbd\x9d\xfc}\x1f\xb1A\x14B\xa4\xa91\x9b\x01i\xc5\x0a\x85\x91\xfbM\xbf\xf5\xed\xcdRH\xed\x11D\xba\x12\xdd\xb4\xc4o\xb3\xfe\xa5\xafm\xb9\xc5\xe4Ef6G\xe2\xe3'8d\xdc\\,\x99\xacn\x18\x09{\xcf\xcaKq\xff\xda(dB,\xc7&\xca\x0e\x0f\xb2\xbd\x16?\xbe{\xf8\xb2_\xf2\xc6\x93*D\xe2\x10\x8b\x86i\x0f'w\x0c?x\xda\xa1\xf9\x87\x8fY\xe5\xac\xb3\x8c\xc1\xab\xc3\xbb\x0c\xae\xc2t\xda\xc2.!U\x8b\x81\xb1\xed\xca!\x9a\x9b\xf6I\x95\x03f:vb\xa6\xda\xaa\x18\x9e\xd8MH\x0e\x9c\x83+\xaf8@s$\xfc\x86J\x99\xb7\xbf/\xd6)t\x83\x9b\xfe\xfb\xe2\x9c(\xc0NsGrD+(\x07i\x0c}E\\\x07\x8dXN\x00S\xb2Jd\xfb\x1b\xaa\x118\xb9\xc4\xf6\xb3eMG\x17!\xf8\xb3\xfd\x04\"\xc0\x12Fpo\x06WOf\xdei\xfb\xfb\xc59\xff\xbe\x83\xa9\xb8\x85\x1a\xa6\xb8\x95\xa7;\xb1\xc5$8t\x1aco\xf0\"\x98|\x9b\xba$\xc2 \xc6\x16\xe6\xfad\x88i\xab\xd9\x9b\xfd\x189-q6\xf4\xe73\\h\xe7\xdf\xab\x7f\xc7\x86v\xb1\xc3(\x0cB\xbd\xb0\xfa\xd5\x8fR\x0aW\x94\x8e\x0aj\xf8 !\x92}\xb3=sM%\x9e\xcch\xd0\x11>\xaa\xea`r\xd0\xef\xb0\x00\xe8\xd6fE\xaf\x13R\xbe\xcd _1\xa087\xf1\x19\x16\x8a\x99O~&S\x8f\xb6\xd0\xc11\xfc\xb0\xac\xbc\x0b0\xfe\xd9p\x85Q\\\x8a\xb6\xee\xf8\x14\xe60\x82a\xac\xb0l_N\x0dO\xbb\xa8\xa9@c\xbd\xc5I\xe2G\xf2o\xb4MX\xd0\x81!\xe1\xe5l2W\xc2b\"\xb9\xbd;E\xb8\x95zY\xbb\xd2\x91\xa7\xb5\xd7,\xe5\x8e\xd2\x94x}\x0bg\xb7\x9f#\x95:?\xde\x0f\xae\x096\x91\x81\xa4\xeb\x0a\x0a=N\x09V\xe6\x85\xf4\x9d\x1f\x8a\xb1\x1a\xdc\xc9\x17\xa1'\x8c,\x02~t\x81\xfe\x96\xd3\xdak!b\\\xfc\x08\xc0\x16J\x8e\xe8\xb8>Y\xe9\xeeG\xc7\xb1\xdf\xbe^_2\xdf-\xb1x\x18n\x96\x1f\xddip-\xcfu\xfa\x1fCq\x1f\x0d)\xb4\xef\"sC\xb2\x07u&\x81\xee~\x95\xb8\xe2\xbb\xd9\xa1\xbbk\xa2D\xcb>a\x0b6$i\x0c\xe7c\x1c\x8a\x86\\-\x97\xe9*\xc4o[\x13C}\xa0k}\xc2\xdb\x82P'\x1d\xf5\x1f\x18H7\xde,\xfd\x81{\xadDi\xb0\x06\xb47\xc79\xd6\x85\xcfGz\xcc\xc3i^\xdf\xbe}\xa0K?+\xfc\x8a\xa8r\x82\xe9U-\x8c\xef\x84\xb5)\xe6\xcer\xb7\xc1Fm\x96\x19+7\xa4=\xd4C\xf8\x09&?5" +
+	"d\x13\xcb\x12\x8b\x8f\xc9\x11\xef{1)\"c\xb7\xc9<\x86\xfe\xa7\x83\xff`\xe3|zE[u\x17\x9ci\xfd=|\x1b\xd4\xdcD\xbc\x08\x83Y\xa6\xbe:\xd9n\xaf\xcd\x99\x9b\xa77\x0fv9%|\xf0/af\xd0\xb3\xe2!\xb3l\x85\xee\xecR\x989\xa1&\x18\xb7\x0c\xba\xe4m\xaaIC7\xa6/\xf8#\xc92sf3\x84\x0f.8\x83\xfa\xf3\x9e\xed\xe9\xbb\xe0\x18\xde\x19\xefr\x96A\xdd\x91\x83eLms\xf3\xfdF\x0f>[\x08=D&!\xe3. \xf1\x86%\xacR)\x09\xea\x16\x18q/\x84[\x80\xbe\xf8\xd9\xb7@vE\x99\xde\x96} z[\xbd\x8aY\xdf\xa5J\xac\x18]\xed\x86}\xdc\xcf\xf5BIx\x05\x97dg:!\xfb\x1e\xb9>\xf9{\xb6Q\xbe\x0d\xbf?\xff\xa6\x95#Q\xa2D\xfc(K\x80\xa4|qs_\xaa\xf4\x12\x0a\x92\xdd\xa9(\x9d\x14.~\x07Tc\x13Z\xfd'\x18<\xe5N>\x86\x7f\xc5\xbaW!\x0c\x8b\x8d\x94\xf7\xd9q\xcck\x1f\xe3\xc8\xf8\xa8\x8bW\x0d\xad\x07\xc9yP'1\xcf\xc1\x8e\xe2\xd5{\x11\x0bX`E\xc7^K\xb8\x00u\\;\x8e\xbe\x89\xd9\x1f^>\xb6<\xdc+\xf6\x15\x1fE\xd8\xa9\x96=Z\xe1\x13\x9f\xaa\x01\x9c)i\x97\x95\xbb\x1b=\x964\xf2\x1d\x88;\x0e\x8dd3u\xd1\xd0\x86\xbau<\xa21\xe3\x84\x8b\xb1\xf3e\x0d\x03L\xc9k\x18\xba\xa0\xd8\x95\x06\x81\xc0\xcf\xd3\xa33y\xa0m<\x0f\x8c\x0d@\xce\x1e\xac\x9b\xb8\x85\x1f\xcbE\xd0\x7f\x06\x98\xa8PhY\xfeG\xce\x12\xe2zQ*\xf0\x98\xadU\xa0\x02\x93\x9a\xef\xec>\xb0\xd0\xfbS\xcc\x1e\x9c\x1d\xf5\x84\xa2\x0cJ\x17\xe3\xe28\xe1\xd5\xf5\xa0\xc4\xaex\x1fq}'!$\xfb\xb0\x03Wr\xcc\xd0*\x1b[\xd3\xf3EG;\xb9OF\x92N*\x06\xc5N\x0a@\xb2\xca!F\xd1B\x0d-\xe8yfnmk\x8a\xcc\xe10\xab\xfd\xff\x9a\xc6\xbc\xb0t9\xeb@\xaa\xd9\x9a\xa3\xfdr\xa7\x11\x7f\xa6\xf7\x97e\xfd/\x00\xdfhq\x82\xf2z\xcfK\x90\xffc\xffk\xed\x87xa,0'GWq(F\x9cm\x85\xb8-\x09\x88\xa1\x88\xd7F\xb3\xd5\xaf\xfa\xe7Y`\x8db\xbfa\xa92\x98\x06\xfa%\x7f\x80fh\xf4\xba8\xcbb\x0c_Yv\x92\x1c\xb7\xbe\xbf\xa1lI\xf4Nz&$\xdc\x0a72\xca\x9a\xce\xf1\xbd-4\xda5U\x0f\xa7\x10kyg\x1c\xb3\xb0\x15\xf8\xfe\x1e\x9cV)ET\xccF\x17\xa9#\xf6\xfe\x1e\xa6\"\xa3\xb1dN\x81\x11\x9a\x1a5\xbd`\x02\x9fV\xf2\xec\xf8H\xda\x17\xad\xbf\x805\x12\xf1\xb2\x98F\xca\xca6\x93\x92\xe2HI\x8f\xc9\xdb\xd2\xe9N\x8d\x1b\xec/s\xac\x9a\x85\x9a\x8d+\xb4\x8a\x1f\xd6TO\xbe]\xef\x0d\x03p\x83\x12\xd6\x9f\xa9\xa6F\xce\xf7*\xe6O\x85c!\x83\xf3-\xd3\xc0#^\x1c\x8f\xba\x04e\xa1\x87\xcc\xa5\x92\xba\x9b\x0e<\x9d\xa8\xf2A\xbf\x9d\xd8\xbby\xfaf\x11.\x1a\x85e\"\xa2\xcb\xf5\xb1J\x89\x0cR\x864\x10\xbe\xd7\xa2E\xb2\xab1\xcc\x88\xff\xba%*N\x0cz~\xcb\x8bv\xf9\x18(h\x8b\xcc\x02\xf9\xe2\x06\xd1\xc8\xb1\xb5\x12}K$\xc8\xa7\x05\x94k\xcfnt\xc1\xdb\xb8e\xabuL\xd6\xbdY\xe8\xc9RF\x8f~\x86\xa04\xb9d\xc3[\xa8\xef\xae\xa6\xbe\x8da\xe5)\x07|\xe1\xdd\xe4\xba\x93o9\xdc\xb0\xc0Vq\xd0{!\xd21)m\xd3 \xad\xd8;\xc4\x07$\x95\x87\xcc;\x15\xcd\xf2\x83p\x9f\xea.\x13W\x9f\x04\xe5(_\xf1\xf3cW\xcc\x86\x16\xe2\xd3M*\xc05\\\xce\xf78\x17x\xc3\xd4\x12\xde\x98}\xf7#\xd4Q\xf3\x84@\x1e\x07,\x15\xf0\x0bQ\"9:\xe9\x14\x15F\x1c\xb6\x96\xb2\xc6\xc6\x98\x0aq\xc4\xc0\x05\x8e\x8fDf\xdf\xa4\x0a\x90\x12-\xa3\x02fl1\xd7-\xef\x97\x8a@\x96\x02A0\xfa\x86\xe9X\x97\xb4 vrJ\xc3\x19:\xd8s\xa94m\x9b\xfc(=>\x93\"o4\xb2\xf7\x05\x9e\xea\xc7$\xb6G\xcd\xbd\x1b#\xa6u)\xdaK:\xd0\xf4\xb7\"K\x1dt\x96%\xdd\xc6\xf6\x0d\x94\xef\x9a\x00_\x94\x9d\xdc\xe1&\x1f\x01\x81\x12\xb9[\xdaU\xee\x85\xb7\x0f\x17TZ\xce\xcd\x0e\x8c\x83\x04\xb4\x16^\xb4W\xeaZ\xb24y\xced\x0cJ\xa9i<+\xe3\x9e\xe1\x7f~S\x1d\xf7\xcd\xcd\xf35l!\x0fq6\x7f\xfey\xa16\x1e7M1\xe4\xd9\x02 \x8b\xe4\x82\x09\x0b\xe8\xad\xd5\xb5T]\xbf\xf1\x10~\x8d\xd9\xfd\xab\xf4\x09V\xb2\xd6\x08\xc3\xeeF\xe8\xe08\xe2\x9c\xcd\xc93\xcdT\x0d=\xb7P\x0d\x93\x05\x19\x0e\xd6\x83\x97\xb3*\xe0\x06\x13\xf7\x8b\xf7\xca\xb4~\xed\x0c\xa5k\xdd\x0c\x1d\x1e\xd1D\x1f\x98fQj\x91\x92M\xc1\x0ei\xebFWK\x1d\xc9\xc6\xb8&\xa0?\x9c\xbd\x0d\xd4\xd6\x06\x12\x02#\x09\xfaf\xf2\xadaj\xcaO\xb7\xa8\x98O\x11\xd6\xae\x1ao]y\xe4_\x7fV\x0a\x0ed6\xa9c9\xdbpw\xba\xab%\x00\x16EV\x14\xa7r\x81\x89H\xfbh+\x92\x08.q\xb4\xe0\xcd5\x0e\x979\xa6\x03\x8cR\x18\xb3\xb3Kqo\x84\x94\x18\xf8d\x80\x0f\xc4\x9bQ\x94\x89\x9b\x17/0\xd8\xadm1\xe6\x9b\x9b$u\x92\x99]G\x02zu\xcc?\x7f\x82\x88\xb1j\xaa\x0ez\xb8\xf8\xde\xd8I\xb8\xb01\xfdC\x88\xae/p\x0b\xf5\xca1\xa2\xe7\xc8?y\x18\x8b]\xb4\xce\xf7\x86\x1c\xed\xe3\x03\\\xb3\x99\xf6\xe7n\x16\xfa\x9d\x1d#\xb9\xc1\x0f=414\"b\x12\x92\xc0\xa2\xbd2#W\xb0\x86\xab\xe8\x96,\xdb\xae\xef\x885\xbc \xee\xe7\x87\x90\xb3\xde\xdcd\x0a\xde?\xb1\xbeLn-\x1b\xd9\xc4\x99&\x8d\xcc\\\xa3L\xc3\xbb\x0e\x8f\xe0\xee\x93o\xfc%\xcc4\xfc\xf2\x0c\xb5\xae\xaeHFe \x19X,\x02\xbc\xa5\xbf\x15n\xab5o6\x01\xe0\xb3`\xe8\x8a\x82\x8c\x85\x1d\xeeBG\x84\xf9\x84C{r\x9f`\x00#j\xcbG;G\x92\xd7\x0b\xfe\x0ct\xe2\xdb\xac\xf6peS\x8d\x11\xba$\x9es}_}\x97$\x94I\x03-[\x14i\xb6\x01\xb3\x09\x0d\xf4\xcb\xafO\x17\x1b\xa6\x04z=\x8c+m\x8c\xc4\xdb\xc5\x98L\xfa\xe0\xb3\x9f<f\xf35Z\x11\x9d\x0f6]\xde\x19Z!\x06G\xa4I$\x82\xf0v3A\xfb%4u\xe9\xc8E\xda\x85\x9e\x03\x8a\x9c#'\xd5\x97\x10\xbd\x97\x13E\xe0\xff\xc7\xac aC\x91\xfb\xe9R\xf0bnd\xdaw!-\x043/|\xc1\x85 \xba\xcbqd\xfd\x1c\x0b\xdc\x16\x0bM\x92\xc0\x94$\xdch\x0ake\x05\xffugtlm\x14'\xf1\xcbmF\\^\xa2\xa9:\xf0\xb6\xb8\x87\xc6\xf0\"K\x09\x0f\x88\x04\xcc\x92H,P\xbb\xa5\xa4_\x98>\xd8\x7f\xa6\x11$\xa7Y\xd0\xd0\xeb]}\xb3\xd4\xd5kL\x86\x0a\x88M2b\x12\x8e\x0dF\x8e\x09?\x86\xff\xb5\x84\xc3\x04\x88I*CR\x8c\x94\x86\x94g\x96\xefB\x1e\xce\x84\xc1\xbfJ2\xed\xe6\xd93\x1b\x18|,\x8f\xf6\x07\x7f\\\xa5\xd1N\xb8\xb2\xe1\xab\xb8\xb2oR\xd1R42r1\x01\xb2Ckr\xafY\xf5c\x8d\x18\x9b\x19\xc4\x180V\x19\xc6\xf8H\x8dm\x8d\x7f\x8e\xf51!6>\xc82a\xcf#\xe9\xaa\xf4e\xc2\xe9j++\xa3\xf6R\x0c>\xf5\x1e\x1e\x86\xe5\xbeyv\xad\xb4\xe4\x87\xd3\x00\xb5\xeb\x8a\xd2\xf9[\xeb\xdf\xf0\xd4\xcc\xd5Lv\x91;\x11\xfa\x82*\x09\xb06\x0b\xd1\xa3r\xb3\xae\xea\xd9\x9f\xc7\xa9\xba\xa2\xaa4\xd3\x14\x8b\x88\x07\x0c\x1cv\xbep\x1dt\xe4\xdf\xb3\xd0\x97\xa4\xb7d\x13\x91'f\x07\xea\x0e7\x17\x0c\xb0#\xce\x96j\x1f8\xf5\x83\x91Y\xe8\x1b%tYy\xbf\x1fE\x06c\xe7\x82\x9fn\x0e\xf1\x1e\\\x91\xa3\xd3\xae\xf9\xb1\xcf^>\x10\xa4\xc6\xad/\x06\xdf\xd1N*\x1c\xa2\xe1j\xa1G\x0c\xed\xf5\xdd\xc5\xb7\x1c\x0e2I?\xbe\x02\xdf\x0c:\x01\x8f\xde\x1a\xfd\x80\x18\xf9\xea\xe0\xaf\xd0i'\xf27),c\xc1c\xf8j\x0bT\x98:y\xa0/~\x18;p\xadA\x8b\x8eN\xb8\xf1\xb0%\xa1#\xe7\x08V\xda)\x82\xf3\xfc\x99\xeb\xfb\xdc7\xa17>\x1f\x8c\xda\x83!\x8a\x0e$\xa6W{\xfa\xd5\x03\xe7\xfc\xa9\x1a\xca;\x9d3\x7fa\xea\x95\xc6\x1floE.\xd7\x83\xfc[\xbb6\x1c\xa6\xd3\xdd\x18=*\xf9\x12\x13R\xe2\x84\xb3\xea\xb0\xdco\x97\xaa/}I\xa0\xe7TJ7s?\xad\xb7\x84\xcfH\xa8\xe8Vg\xee\xf7\xf1Qyr$\xec_\xcc\xdf\xe4\xac2\xd2X!vp\xb0:z\xe1\xba\x0b4#\x14\x8ea\x88-\xf4\x8e=/\xb2M\xf0\xa5\xf8\\\x19+Dr\x1f\xf1g\x94d\xc2@\xe9\xd51{\xfd\xc01\xeb\x88a\x9d\x13\x0b\xc1\x16C\x80&\x8c0\x1c\xf1g\xa1\x8b\x05\xddq\xe8\x80\xa7\xa6b7i\xd8\xf7\xf9\xb8k\xed\x82\xeb\xc0\x12\x9a\xd2\xdfw\x10\xfeJ\x17\xed\x0f\x0f\x92\xcac\x80\xe6\x92\xafw\xb5;\xd4\x9fH\xde.\x99\x98\x0d\x1b\x1c\xa5OF\x99}\xb0\xe0\xad+R\xdb\x08?\xee\xe2\x1d\xf6q\xbaA\x0fM\xf1\xc9\x7f\xf2t\xd7p\xe4>\xe8n\x93\x0d\xfe\x19W\xa3\x08\xde\xca1\xfc0[\xd2\x91~\x15\xee\x04%LE\x0d\xb7\x8e[E\xd7H\xc73\x81x\xf8(s\x1f\x96:\xfa\xb7(c\xdd<{\xbb\xd2\xcf\xc1A\x88T\xaaj}\x8e3\x88N\x83\xf1\x17C\xee\x98\xeay\xc2\xa7\x05\x96\x87\x08\\\x121G)C\xccd\x19c1\x0a\x97WE\x00\x1d%\xf5\x8e\x7frM\x07u\xb1{?\x1e\x7f\xf6}\x1f\x06U\x89\xedD\xe0sQ\xf8?v\xe5\xbdv%\xc5\xf6\x17\x94\x1d\xd7;\xf0y\x12\xdb\xcf4)O\xc13\x97\xb6\x90;\xc1\xcbx\x86\x0fS|S\xc8\xd9\xdf\x95wu^\xe6\xc1\xc9pj@\xea\xceuN8\x8d$\x8a4\xcb\x9b\x97\x0el\x10\xc9R~\xae\xeb\xe7a\x81\xf6F\xd3ob\x15j\xd4\xa4\xe5\x8a\x86\x84/\xd1\x04\x18~1\xeb\xc9\x04\x1a\xcc\xee6c\xda[Z\x82\xf8(\xdc\xb3\xc7\x09\x0ah\xe1\xaa\xf9\xf1<\x81Q\xc5\x13\x9f\xba\xf0\x0b\x8c\x8e\x18\x84\xd8\xabG\xd03\x0f\x9c\x94i\x13j?\x09\x1e\xfc\x05\xbe\xf9\xab?=)v\x99\x1d\xb6\xb7D\xc7z\x83\xc6\xff\xfdR\x8c,\x19G\x9e'\x04\x1d9;\xf5\xe7r\xe1-\x98\\\x0c3r\x9c\xb3\xabZ\xdf\xc8D\x1b\x1f\xd2)\xcf\x8d\x12\x9c!7(\x11N\x85\x83J-,L\x9d\xd6\xceD+\xf6E\x07~\xd2C\xca\xcd\x18\x1em\xe7\x19.\x98L\xc5\x13j\xab\x1d\xd2\xa8\x18\xefV\x05\x004\x16\xa6\xaf\xacq\xa50\xb8\x14}\xd5WM\xd1\xdd\x85G\x85n\xd4\xa5\xc7\xc7!\x19m5\xfc\xf5 \x05\xe4\xfc\xc5\xb8\xb7\xfe\xfbQUL\x04\x8d\xb50\x1bC\x9c\xe9jO\xbf0\xa5\xb0\x12E\x8c\xc7L\x0b\xad\x7f\xa7i\x97Y\xe5\x96\xa4\xfa\xc4!\xa2\x99\x11\xaf3\"\x09r\xe9v f!\x0ec\x03\xfb\x8b\xd5r\xd72~\xa9\xde\xe8J\x8d$F.g\xeas\x17\xf4\xb6\xfc\xc4sw!w\x97Q\xc6\xce\x08\xf7\xd6\xf9@0if\xf7\xdb]#\xd50\x18b\xd9\xa7;\xb3\xcd\xa2S\x8cATxK\x07\xba\xce\xd9\x97J\xa5Y\x05\x0fsu.\xbc\x9a\xc3\xcdC\x1e\xd2-\xf0?\xef\x939\xbfD\xcf|\x0d\xe8\x18-\x8c\x8d\x8b\x92\xb5\xf1M\x08m\xf0\xca\xe0\xe5_B\x1fm$\xad^27\\R\xc8\xa1\x05\x06| \xaf_\x1c\xcb\xc0\x12\xad\xd0'|\xcaE\x00\x1e\xcaO\x97`\xe4\xca1\x1a\x91\xf9\x0c\xbf\xd3\x82}\x9d\xf0\x10Yf\x82\xb5\x82=\xa3\x803\x8bW\x1d\xf4\xe8\xce\xc3\xca\xe9<&\xb4R\xf64*\xe5X\x86\x1b2\x1d\x17\x1d\x01\x91RA\xb9\x8a\xc2K\xed\x972~$\x003X\x86\x071\x94X\x8c\xfc1\x06\xfdFG\xaa)\xffs\xedN\xe7:\xa6\x8b\xd6\xf2~\x88}\x1a\xf9bUb\x8d3\x9e\xb04\x89\x1a\xe7\x9f\xef\xe1\xa8>\x81\x1e\x0a\x1b/\xf5v\xbd\xe2#\xc5\x06\x05\x83\xac\xacNd\x1c\xd8\xf4\x83l\x97\x9cB\x92\xb4n\xc8\xb3\xf0\xf6n\xbc\xaax*\x91\xdaPd\x185n\xd5L\xd8W?\xf2s\xfd\xd1?\x0bH\x986\xb2\x9a3\x095LnU\xa3\xf0&\x8a\xac&lF\xb3\xbdg\x09\xa61\xc3\x86?~\xcf\xe0~\xb9\xbe\xdb\xcb\xf9\xbf=84[\xacr87\xca\xd0\xf2,\xcd/\x92\"\x89\x93*\xcd+\xdf\x1b\xc6\xb5\xf1\x1e\xea0\xae\xb3*x\xa95\x08\xb9b\xc9*\xe5$\x87\x8c`\x09\x97\xfe\x06\x8a\xc3\x9b\x177\x9b\x96\xc3\xa4\x94\x8e\x0b\xf6\xc3u\xcb!\xb1\x19\xde\xfdw\xb7\x19^<[\xcd*4~`O\xb2\xedme\xb9\x84\xfaD\xe3j\x81eP|)\xdc6\x1b\x03?\xc2nC\xcbX\x82\xa1\xee\xd4\x12\xddE\xa6S\xc4\xfd'\x05\xcbf\xcb\x0e\x8f\xc4o\xc5\xcd}}\xba~\xb8\x9el\x7f\xc7\xc4\xc6\x92I\xe3\x8c\xd1\xf5Z\xfb\xa6\x9a\xe1\xfe\xae\x1dj\x99(\xad\xb82\x1cY\xcf\x92\x93Vy\xcd\xf6\xdc\xc1\xcc>(\xd9\x90\xbe\x04\xdfE\xc0\x82\x89m\x87\x84F\x94\xd0\xd8Yf\x03[\xa6\x11\xbeD1\x05\x93\x91\xbcr\x83\xf0\x10:C\x123\xa1\xaf&\xae\xf2\xa5AJ\xca\xd3\xe8oT\xc6LT\xc3{c\x18\xe2kh\xa9\xb4\x94\xed0\xa5$b\x0f\xf7\xcbj\xde\x12\x0e\xe9\xd3\x8f\xbbS\xd5\xdeq\x1cYN\xff \xff\xe4\xfa\x92\x82\xa1\xf86{\x11\xb7\x84\x11\x08\xbf\xff =Zq\x0d\xd2Y\x83\xd5?\x0cI\x88\xb0\xe7\xb4\x9e\xf2\xde\xc11\xfc\x88\xc5\xc7\x9b\xb2\xebgdS]\x88*e\x057\x1b\xb3N}\x9f\x9fm\xf8\"\x84#\x1d\x8f\x00\xc2\x0c;/\x13'<\xb5\xa4\x92+\x11\x8aJ\xa0\xb0\xd6\x01\x8c\xab\x9c7\xd4d\xe4\xa3TX`\xdd\xe5+q\x86{\xb9\x1c\x06lm\x00\xf0\xb86\x94\xfb@Gc\xc6\x92c\x08\xbb\x14\x18+\x94\xc8n\xb2z#MM\x8ek\xba.\x8b\x18H[\x8e\x96\x93\x09\x91\x1d\xdd\xdf2\xa7\x97L=A|q\x81\x89X\x89\xe1E\xd2\xc6\xd8\x923\xd1\x9d4f\xb6\x9d(\xf3\"\xdc\xc5\x93\xeb\xe1\xdb\xfb\xbaNG\x92\xec\x8e \xdc\x055\xb4\xdf\xd2a\x07\x06\xe6`\xb0q\x17\xc59\xf7\x87\xb5\xb2q\xca\xba\xf3>\x11\x9dh$\xc9]\xbf4\x11\xad\xb1\xf9%\xfc\xf3\xb5//\xb1\x86=\x18\x1f\xc0\x84I9B\x80\xba\xb4p\xad\xe1\x16XR\xf7<\x1e\xb9T\x86\x95\x8e\x8f\xc7\x18\x11L\xdd\xdb\x87\xf3Q\xdc\xbcx\xb5t\xac\xaf\x0fd\x86 \xb8\xb2\x040\x01\xd9j\xa5\x8d\x02rl\xb1O\xb8\xc0\xc6\x9eT\xfap\xde\xad\xa6\xf7sW23\x07\x15\x1cs\xc8Q\x9c\x0f)0\x8az\xdc\x1f\xe4>vi\xdaJ\x98y\x8d_\xb24E5g%n;\xc0y\x03\xc8\x91\xaak\xe3\xe1\x12%\xe9\xf0d\x87\x12\xaca$\x0c\x07ts\x96N# `N\x93t\xaa\x86\xf6.P\xfe\xe0O\x0ckhl2\x9c\xdb{\x05\xb4?)\xbew\xc5\xe2\xf6\xcard\x19:\x15s\xbdF\x164\xf4\x03Z.\x19\xdb\xdd\xfewE\xee\xa7\"\xdc\xe4#\xa7\xefL4\xea\xc5\x8e\x96\x9b\xe8\xda&\x07\x04B$W\x8f\x9a|MV\x08\xe0\xe7\xa9a\xe3\xf4I\xe9b\x0d\xcdj\xe9\xd4\x037\xf1\x07\xba\x1f\xa9\\\xf6R\x17\xec\xa8\x0f\xbe\xa2\xed+\xc7\xecN\x86w\xaf'\xee\xd5;G\x0d\xac\x98\x83!r\xef\x14 \x80\xc3O<\xa5KU\x04qs\xcb\xf7\x02\x9e\xe1Y\x18>a\xbb\xb7xw\xc5\xe1\xe0G\x08\xa2\x08\x0d\xdcS\x93\xc9\xd2\xf4\xc7\xb1\xea.~\xe83\xf4\x90\x88dQ\xc2\xe7\xb2\x1e\xf4\xdc\x18\xb5\x1b[\xe6#\xda(\xbb\x8c\x84p\xf6t\x0b9\xec\xfc~\x095\xa8\x9f\x8a\xd5+\xdd\x84\xaf\xa8\x96/\xe7\xfdb\xa6/\x053rD\xc3$\xe9\xec\xdf\xb24`\xe7\xe3\x81\x90\xa4\xfd\x8c\x88U\xf6\x15\xba\x9d\xceu\xd5=V\x16\xe6\x8d\xae\x8b\xb2\xe3JYf\x90^\x06X\x15\xc6J\xa2\xd2!Q\x85\xd7\x883\x15\xcf\\\x86F\x90Pq2'\x16\x959\xad\xa6\xe1\x8e\x86e\x7f\xfc\xb0\xb1IE\x09\xf3k\x85\xab\x11\x01\x03'\x96\x1f\xe7\x12$#!\x89g\xfe\xccV\x10\xa0\x05u\xf8B\xaa\xf0\xb4UA\x99\x8a\x94/48g\xd1\x9e=\xe0\xec\xcf~(J)u\xe3\x08\x0fs*Dx\xdf\x1e\x13c\xef\x94\xd3&\xc2\x09\xf7\xe6\xaa\x02ipL-\xae\xe1=\xee\xef!lr\x9b\xa5\x0b\xa9P7\xf7\x12\x9dU\x09\x0a\x92+\xf7\xcdT\x10\x96\x17\xa2" +
+	"p\x0cs\xb1\xf1\x88\x9am\xaa\x16.dZ4\x15\x85>\x08\xed2A\xa0J\xfa\xc2\xd1\x9b\x08\xa5\xe1\xe4D\x84\xdcd\xf0\xdc5Y\xe1k#\xa5\x96\x9b\xe4\xc4fw\x88|y(\x00Zt~t'\xa8\x0f\x89\x14\x06qU\xffQ\xe9\xf4{(Z\xd7\xb6\x00\xb5+\xbe\x84\xc7\xf5\x00\x85k\xc1\xd5\xae\x18\x00n\xdd7\xde\xf7\xd7\xc2\x17Q\xcc\xfbc\x02\xe8\x14w\\*'8*\xa4\x90R\xda\xe6\x8c\x16\xe1\\\x94\xd2\x92\xc5\xbaU\xb2\xda\x87\xef\xbfo\x16Q\xd8?\xd7\xf0\x19@\xc8$\xbeh\xa48g*\x19\xef\xdd70H\x9c\x9cL\xab\x1c\xea\x1a\xc6\xfb\x84^2\x01I*\x03\xfc\x81\x09\x0d\xfa\xe1\x1b\xdf\x0d82\x862\xb7\x04\xa2`\x96`a\xc2\xb1\x01\xdf8!u\x10~\x8b'\xab\x95\xab\xf1kZ\xdc\x8dEd\x11K>T-HPz\x95\xd3G2\x09\xb4A\xbaX\xf3\xf8\xb8<\xc3\xcb\xb7W%U\x97B\xbcY\xe42\xd5\x7f?!\x86\xbf\x88~\xe31\xfe\x931\xdd\x88\xe1\xdf3\x96\x9a\xa6E,X\x95`\x92\xe8\x95\xc4\xf8\xbd\x89\x7f\xb5\xd0\xe9\xf9\x96\x80\x9eKG\xb4\xa3\xce$\xfa\xe2\xdf\xf8\x9c!\xbe\x17trw\x1d\xd4\xado4vI\x88^\xbe~=)\x10\xe5\xb4\xc5\"\xb2\xf5\x8f\xd4\x10\xc6\x16\xe9\xf9\\\x8a\x92\x0e\x0f\x15\x7f \xed\x0c6!\xbb8b\x16\xe6U\x81\xbc\xc8\xc2\xb9\xd9\xa5\x08&\x09U\xbfo\xe6\x90\xb2\xafG:\xbd\xba\xb9:=\x84\xf4 \xd2e\x84~\x16\xaam(q/g\xce\xd98J\xba+X\x8e\xd8\x03)\x0e\x7fTj\x19\xe8u\xc4\x19\xc9\xbby\xc2\xbcz\x96\xedt\xa9\x06\x82<\x0b\x8bN6\xa9\xb0-e\xd3\x1a\x94\x9a\xc3(\x14\xdc\xdfH\x9a\xa5\x92\xba\x02\xb8H\x92]\x09t\xe2\x9d\xd9rd\xaf\x95Xk\x88y[\x0c[\xe7\x07\xa8\x1f9\xa2/\x16GT2\xa1T\x145\xa7\x1f8\x14#\xe2lN\xd5\x80P\xf7\xf0\x9d\x0e\xbf\xc3\x01\xdc\x90i=A=\xa9\x90k\xf0j\xe1\x1e\x06*\x09\xf3\x93\xd2\x85\xda\xbd\xf8\xec{H\\\x8d,E\xce\x91X\xd9Y\x8c\x08\xbe\xec2\x10&\x16)\xd2\xd0\xfe\x09\xc4\x00\x83}\xa5\xcah\xa1\x09J)\x8a\x13t\xd7P\x1c\xf5(\x0b\xe7{GA\x8d\xa11\x85k\xc4\xb1\x87n\xefm\xd5\xbb\xb1\xa8\xab/\x82\x12\x09\x0997\xc2@\xb2m\x8e\x963|\x89$\xb2M\x98\xe3\xad\xa5\xfc\xc9:\xf5]\x0cpO\xd5\xc0\xf4\xbd\xb6\xaai\xd7T\xd5(\xe5\x8cas\xa0\xc71\xe9\x0d\xe7<\xa5\xc4\xa9\xc3\xc4\xc4\xb0\xe9\x97\xa6\x12\xc0\xf3\xecP\xb9\xbe\xf5\xe3~\x86\x0c\x1a\xd0\x1f\xf5_\x09\xdb\xc4;c\xcc %\xa8\x93H\x91\xf8R\xf9\xe7rX\xbf/1\xdc\xb7f\x86S\x84\x9f\xc9\xf6\xf1\x9b\xe6L0yq\x8c\xf5\x8dU\xe8;\xb7x\x8cI\xe969\xabJ\xcfEI\x0b\x86c\xe9;R|\x9dv\x84y\xc8\xe4\xa0\x84\x1d\x8d\xaa\x13\xe5\x15\x8et\xc4\x18\xfd\xfaH\x16\x1ds\x16\xf4\x04.\xbc\xcb)~\xb0\xf3Gh7\xa8\xce\xa2\x1c\xf2\xee/d|rC\x16\x98\xdb(\xfc\xc9\xb5Amu\x81\xb1 6\xecE\xa67\x0d\x98j\x0b\x96\xfa\x86B\xe1;z\xe8^\x96K\xedO\x94\xf2Lc\x83S5\\\xd0!F\x885\x85,6\x9a\x96\xe0\xbb\xd9@]\xb32\xe9Uc\x99\x88\xce\x02\xc6\x12\x18\\yx[e\xf2T!\x7f\xa7\xd9\xd1\xbcg,7^6\x95\x17\xaeN\x08\xa7\x17\x14\x1b\x13\xed\xc5\x0c\xe3\xdb\x81a2gC\x93\xf2ya\x16\xa4\x12\xd5\xef8n\xbeE\xea\xca\x916\x0eb]\xaf\x0d|\xf5\xc6\xba\xc5\x1a1\xd4\xdd\xb9\x13\xe4\x15\x07\xd4Zh\xe9\xfcQ\xea\x1dk\x1b\xd2\x9e&\xbb\xe9\xba\xe3\x8e\xb3\x92\x89\xdd\x8f\xfc[h\xee\xde-k\xd0\xd2\x876\x14L\xf4\x96\xf8\xe4Hy\xb2U\x9c\xf7j\xd9\xf6\xb5\xb7\xd6\xda_#\xa7-{\x08q\x15\xd3\xce|\xeb\x0c\x1a\xfb\xb1\xdaV\xebo\xf8\xfa\xe9WP\xae\xff\x10z3}\xe42\x95}J\xb5\x9e\xc3E\x897co\xfd}\x17\xfb#\xe7QT\xda\x1a^i\xbc\x14l\xea\xd9\"K\x935\xc2\xcfc\x18B\xc6m\xc6\x0f\xc2R\xacC\xf1\x05Z\xa2/Jn\xd6\xe5\xb4\x03V\x1d\x10\xbd,\xb8\x00r\xdfYn\xba\xcc-\xfd\xf6\x9a\x1f\xfd:\xe1f\xb9T\x83\xef.\xd0!\xc9\xe0\xf2\x999dq\x00\xb2\x94\xd5y\xa5\xf4K\xb0\"\x88\x16\x08\x8cL\xf4\xf9\xec\xda\xe2\xe4zJG\xa5\xd5\xe0\x8c'\xe3\x83\xf7B\x17\xab\xd0\xe1\xe0\xc3/\xb8\x05eI\x98|\x1b\x12\x9en\xf4lxL \x85\xee\xec\x06q\xd2d\xbf\xc0\xc2\xb9%\xf5z\xf3\xfa\xd9\xc3bh\xbai\x94\xea's\xa9\x93\x0aq\x1d\xc3\x8fkt\xe7\x0b\xf1@q\x1c\"\x09>\x07\xfeS\xc0\x97\x7f\x08\xbc\xed\xf521\x1f>\xc1\xcc\xfc\x1a\x06\xe8\x9a\x88\x0a:\xe1~\x85\x1b\xc0@\x00\xaa\xb3\xc3V\x9d\x94\x86\xc0\x8e#\x1f\x94(\xe3G\xc2\xca{\xfc\xf7\xc9O\xc5\xaeGF\x13kS\xa9\x0e\x8a\x8d%\xa4\xdeU8S\x01\x8d\x940w\xc8aV\x91\xac\xd2\xd9\xf1\x14z\xaa\x95\x1a\xd1\x16\xba\x0b\xf8\xf0\xac\x09\xc6\xcd\x00\xc8\x15\xf0C\x17\xd6\xe8\xa3\xfc\x92\xd7/~\x8f@\xc4l\x8fc:\xd2$\x12\xce|\x8e\xa6`\xb5\x86\xe1\x0c\xa9\xf8\xe4\x0e}\xab}\xe2\xb4\xc6\xb8\xa8viG4\x86\xf2\xbf}\xd5&<\xd5a\x97 \xf6\x93\x88\x97\xb9\xb8\xc32\xca$\x126\xa4FAnz\xa6\xbbXZ\xaf\x13\xc1\x90\xfc\xff\x86\xf0\x04\xbf{\x85\xd80j\x03\x7f\xbc\x16\xd6\x13\x0d*dT\x8b\xf1+\xd9O\xd91\x0cO\xe7OpV(\xe9\x18\xa8\x90K5@\xef\xfa\xc4\xe6U\x80\x9cX,\x7f\xbf\xfe\xc6\xafb1V\x98\xef\x96\xa6\x8a\xf7Z6 2\xf9\xa08QR\xd5S\xf1S\x0d\xa9C\xb9\x05M\x91\xbdN8|\x04\xddg\xa29\xf1\x19\x98\x16\xb1\xfb\x94:\xde\xf7\xae\xbb@\x14A\xbd\x05\x8fy\xe0\xc1\x10cm8\x01Q@\xf7;\xaf\xdcm\x9cp\x0e\x8d\xccS\x8a\x19\x9c\x8e\xa6w\x1a\x90<\x14l\xc7\xb0\x0cS\x92o\xe5k\xf0{\x0e\xe6\xe0mY~I\xe5\xe9\x02\xb9\xf9\xa10\xec\xec\x87\x04\xd4\xae\x05\xef\x8d\xc4\xf4\xc5\x8b\x89\xbe%?\x8c\xc5!)\x9d\x99\xebC.iCv~$\xaed\x99\xcb\xd5\xd0z\x0e\xceM\x8c\xdf\xd8\xcfh\xad\xe4\xe2\xf0\xf8#\xfed\xdf\x07oPV\x0d\xb1\xc9\xa0~O1B\x0d\xc4\xc7\xd03y\x1d\xa7\x8d\xd4R\xe4bwb\xae{\xb7\xf6\xb4\xbeBs\x0aI\xc8\x19\xa6\x8aV U\x0d\xe1\x9f\x0f\xcb\xdf\x95|\x15\x9d\x15!\xa0\xef0kr\xa9\xfa\x12\xd3\x1e\xc2\x93\xc4S\xf0\xc21\xda'\xc5\x95\xb5O3w\xe7H\xcf\x90\xb9B\xbbk\xdf!\x16\xdb\x8d)\xfc\xe6a\xd6D\xe9\xbe\xc0\xad;5\"n\x85\xc7\xfb_\xddX\x03\x8c8\x0d\x9b\x8a(\xa0V\xc4\xbdK\x87::\xef\xe8\x93\x82G.\xb9\\\xfex\xe0\xb1\xd4c\xa2;\xb1#\xfc\x05z7\xe9\x07\xf4\x7fk9\x87H>\xc5\xd4f\x1d\xc0gMZ\x90\xbc$N\xddZ\xeb|t^\x123\xe3\xdeX\xc2\xfcH<'\xd4|\xa2\x97\xca\xbf\xcf!\xcap\x13\xa6I\xbb\x0f\xe3V:\xa3l\x177s\xber\xf9\x01l#<)V7Y\xd9=(\x96*\xcd\x8b\xf0}N\xcc\x87\x8b(\x9d\xf4\xa4\xf8n\xdeS\x89\x05bU\xfd2\x8e\xbd\xb9\x06\xe3\xb5\x83-\x03\xa5\x84)\xaf\x93\x86\x9b\x11\xca\x9c;\xd7\x93\xdb\xcc<J\xb8\xa0C\x18Q\x0b\x0b\xeb\x95\xd9\x09\x0d\xb4\x87\x04zp}\xce\x1b-\xe8\x85Y}\xd0\xc5.\x9c\"\xd1\x80f\x07r\xb3\xbe\xf0\x96\xab\x0b;\xad\x11Lw\xd2S\x98vM8\x8f\x06*\xe5\xe4\xc2ew\xf2\xc7\x13\\\xa0a \xdf\xe0Nf\x8b-\xc6\xea\x13\x05\x0d\xbfA\xd5\xf3\x9d\xeb\x85\xe1+\xcc*l\xc6\x92\xf0\xdc\x03\xb7\x81(\xe5\xbc\xba\x9a\xce\xfe\x84X\x16|e\x9c\x1b\x92:\xa2\xc6\x92\x87\x14\x89l\xd2\xa2^\x92s.\x0c=\xf8r8)<d\xd3\xd2\xfd\xda\xda\x9e\xa8\xfb\xbc{\x1fO\xd8}\xd2Ta\x86\xcc\x92\xfb\xda\x89\xb6\xf1\x18i'r\x06\x02%T6\x9b\xa9\xf2\xb1\x1c&\xe7.\x82.!\xa3\x8fb8b\xf2\xe3=\xfe[[\xfdo\x9e\xff\x86=?\xa0w=\x8ci\x849\xbf\xd2\xdeS8\xca\xf0\xdb\x11s\xaf\x04\xeb\xeb\xaf\xf9\"\xcf\xef\xd3\x06\x92*\xacK\xd0\x0b\xb1h\x0b\x87A\x0a\xfa\xd1\xcdw\x84\xc6M\xc2\xcb\xc6N\x03\xe25\xc2\xaa\x96!\xc3\x81l@\xf2\x82;l\xa4\xa5\xb7\x97\xce\xd8co\x84um^\xaatS\xdc\x00P3\\\\\x8ac\xbc(\xe2g\xf1\xe6\xebz7Dy\x84\xaf(\x12=\xb6\xf4N5N\xf8}\xf4\xe0\xf9\xec\xca{\xc4\x9f\x88\xf0t<vLq\"\xdbh\x0b\xa7\xc3R\x82<\xc9\xa8\xe7\x84\xac\x16\xe8`\x8a\xe4t2sP\x99&QvABB\xfb\x10\xa0j\x94\x97\xde}7\x9f\x16\xa5\x9b\x84\x99]M\xe6}q\xf2m\x10\x8b\x85\x0e\xca:\xbc5\xfd?\x7f!\x12e\x1a\xafv\x87\xc5\x8a-\xb0SAB\x84\x11\x87\xc4\xae\xd7\x8c\xdd\xe7\xc2\xb4y\xd0\x1f\xe3?#+\x91~1\xbc\x9bR\xd4\x85\x03\x91g\xac\x81O\xf6rvL\xb5k\xf7V\xa2\xf2\x90\xcd\xf6\xe4\xa8\xa2\xf2\xf8]T\x0baZ\x0f\x9e\x98\x18\xb2(\x1d\xf7@<\xef\xa3I\xe3\xe2\x1c\xa7\xe9k.-3\xac\xc5\xef\xbc\xa4\x0e\xd7\xd6T\xb8\x1a_d}])u\xb6\xb2\xa6\xa3W\x04\xb5\x06\x92\xb2\xe8\xa4\xb9n\xf4\xa4h+\xda\xdb\xdf'\xb6\x9e\xccPd\xadl\x98\xf6\x92)\xee\x8br\xf1\xd23\x9c\xbdh0\xab.\xabL\x02\x8c\x99-\xd6\x1cw~f\xdfP<ncU\xbcZ\x8a`[\x9d\xd3\x0f\xbc\x01Gw\xae\xf3\xeaC\xbfo\x94\x94\x13\x83\xd3D\xee\x83y4:\xc5\x91\x00\x8c\xa1\xe0\x15\x12\x8036\xe2\x0c'\x82>\x87\x05\x13\xd8\xbcR\xce\x10b\xf3\xb1\xce\x94\x88\xeb\x1a\x9ca6\x03\x13\xf8\x02+\xfd\xef8\xdd\xa54\xe8\x1f\xfc>\xeb\xf4\xc5\xfag\xbc\xbf\xe5\x88\x8f\xb2\xff\xb109\xf2\xb4\xaf\xf7o\xcc\x909\xcc\xc64I1,\x83f\xc2\xcdjb\x95jC\x97\xe0\":U\xc3\xa7pd\x0f\xa9\xf2\xcc\x91~q\xc9-\xbf\x9d\xd4\xa0B\x03.\x9b\xb0R\xe7\xb1p\x89\x82\x80\xe3\xe4\xb7Z\x074\x82\x06G\xa8tz\xb8*z\xf8<\xfa\xf0\x94Fw\x18s\xa3\xf0\xe6\xbd\xb3\x00X\xe3\xc2\xc8<\xe2CX\xca\xd6\x0aj\xaf3\xa3\x12\xbe\xea\xd6\x13&R\x16G\xcfe!Gv\xe0)C\xe1z\xdf\x85\xcf\x98\x0d\x93pN\x1a\x1a&\xd6\xcc\x8b\x9f\x1c\x03\xc9\xf9/\xb5\x9b\xa0\x93\xe0\xe4g\xb8\x85\xe1I\xf1A\x08\xb4\x0c\xbe\x19{\x14G+\\\xc8,\xfc\x02\x9b\xa7\x81=\x14;1 \xb3\xd4\x1a\xb7\xd6\xcc\xdb(\xc3\xc4\xcb\x97i\xd3\x12\xce\xe7'\x05\xeb\xce\x0bO_T\xa6[`T\xa9z\\w|\xa0.d;#\xbcIa\xcd\x96bV3\x8b\xbc\xb1\xc4\xd5r\xcdOx\xb3\x8c\x8e5\xa8@\xd5\xc9\x8c~\xcfR\xd1P~T\xf1r0e\xa2t\xd8\x09\x13\x16?i\xaf\xf7\xc7p\xe1\xce\x0f~\xa4\xf0\xcf0\xd1xq\x13kh\\\xa7\x0d\xa4G\xf0L\x17\xcb'\x012\xf2\x97H\x82\xb0\xd7Rk|U\xa3o\xbf\xa3\x17d\x13\x7f\xcf6\xbe\x94\xd8<\xdcny\x9bE|\xd7wc\xda=F8\xb9i\x893\xe4\xbb\xf8\xb1\x0d\x0cX@\x0b\xd1h\xce\xcc\x13T\xe9b\x86\xaf=\"3\xdf\xa5,\"1\xe0\x18\xfal\xf4\x8dcz@\xfc)K\x8bd\xe6(\x0ahB\x97K\xd1\xca\xf8\xb7%\xe7\x14\x1f\x8e\xcf\xa1k\x93\x87\xe1\x10\x84\x07^\\?\xf9#\xfe\xd4\x8a@\xb9E\x9e\xa1J\xdd\x0dR\xa2Z\x1f\x93\xb7O\xb3T#\xf4g\xc7\x094\x03\xe5^\x0cN\xd2\xc5\x1c?X\xdb\x1af\xa9\x9e\xa5\x1c\xbbMP`\x0a\xbc\x81EW9\xb9\xd5F\xa3\x92\xfc)\xc6\xe0\xecF<\xab\x90\xb9\xf5\xb8\xdb\xd9\x1aA?\xba\xc1~\x07\xa2\x1d9\xe3\x9d\x0e\xd7re\xcc\xc7\xaa\x81\x9a\xc3\x94\x9f\xdd\xd4\xf3 \xb2\xc5\x8f2.'\x8c\xd6F\xda\x0c\x16\xdb\x16\xac\xd7\xac\xfb\xa4\x9b\xe3\xdb\xe7\x05J\x02\x0b\xbdM0Evx\x8aR\xdf\x1e\xf5\x05~\\\xbfr\x97\\*&k\xd6\x87\xbc\xff\xd2{\x9e\x80l\xfc\x99\x17\xb7\xad\xf9y\x13e\xc0\xf5<\xcaxU\xea\xaa\x9f\x1c\xa7&\xba\x0aQ\x17\xf4\x96\xfb+h\xe5\xaf\x8c\x11\xbc}\xbe\xb2\x18\xd2sM\x0e`MW\xf2H\xe3\xb3J*k\xa0k\x10 \x05\x90b<\xc6\xaa\x83\x10\xd5\x87I\xb4\x0d8\xfd\xfa\xe3#[\x1f_\xc0\xf2\xca\xa06p\x9c\x14\xeb%\x1bWjD\xa3N5\xed\xb6\x98\x8cV\xde\xdd\xf8'\x86(\x92\xf6\x9f)\x81C9\x86\xa2t\xf5\xdd\xd0!\xbe\\\x82G\xb5o|\x8c\x88>)\xfe9\x81\x06\xac\xaa\xa5\xb0-\xa1#?\xb0\x909\x9e\xe8\x95\xebNV\x1b\xb1\x1a\xd4/\x91\x0e\x086\xdawk{&ak\xf0\xfc\x12\xc1\x81/W\x8bj\xae[\x0e\xa9(v86\xfa[C(\xa5\xe7n\xa8\xe5\xcakg\x96\x11L\xb70y\xd5\x8d\xc1JK\xcc\xf9\xc4<pS\x9d\\\x0f#\xc8~\"q\xb9\x13\xb8\x06\xef\xbb\xbe\xff\xae\xef\xbc\xf6&\x1b\xadM\x05\xb1S\xdb\xd2\xd6B\x84\xf7\xa2\xd2\x87$a\xca+@\xaag\x11\xe1xF\xc0*\x89\x88\x10\x96\x1e':y\xdc\xd2\x14h\xdc\xa4\xce\xb5`\x1d\xc8n\xba\x1bNbZ\xa1\x0f\\\x94\x0e-\"\xdb\xbf\x89_Kx\x8e\x96\xab\xb19\x8aGwa\xa38n\x90\x17B\x08TH\xad\x81f)6\x80\xd5)\xc8\xd0\x93\xa2;\x13(\x08\x9f\xa3\xb6\xbb\xc7q\xe4\x07bt\xcbD\x0c\xf0e\xa4U\x1a1\x08\x07\x9fR8\x96\xc7{\x05\x1e\xfc1\xc6,$\x1e;Ve\xd5\x98\xf8,5\xe3I\xf1\x9e]Q\xc3\x87\x15\x04\x8c1\x84\x06]\"2T\xa4\xdc\xed\xaeO\xa2`<\x86\xd2M8\xb6;\xc5\xc0\xcb\x1eq\x1b\xb6\xa3\x18\xf3pZ\xb6M\x88\x94\xd5!R<\x8a$\x17\"\xf4\xa1\xf3\x18\xa1R\xb8\x0b\xdf\x03\x1b\xcaW\x85\xed?\xe9\xbd\xd8?\x93;s\xc0\x8c\x9d\x91\xf0G\x9d\x98\x93\xbf\xf55}L{i\x01\xf3\xfe\xbax\x9c\x90\x0c\xf2T?f\xac.\xbe\xf5\xb4\xb6\xdd>-o\xcau\xadb\xfe\"\x0b[\xee\xd7\xb4#\x11`y6\x9a\xb6\xec\xc2\xef\x10\xb4\xd9\xb8\x09\x86}f\x1c,\x00\xc6N\x9c}&\x86\xe7\xa2\xb9\x9b\xa0 \xb3E\xc3\x94\x0f\xd9#\x93-\x92\x13cM\x98D\x16d\xbc\xb1Wh4a\xf7\xd1\x8f\xae#[<\xd2`pX3\x09Sv\xd0\xb4\x08\xee\\:\"\xc4\x7f\xee\x9dT\xa8\xc49\x18\xfa[Q~2y\xc3\xf3\x92\x83\xf4\x84\xdb\xcb\x00\x9fa\x1a\x0f\xba\xe5\xa1\x18\xb8\xee\xa4\x80\x91\x17\xfaE\x8b\xd7L\xa1\xfc\xee\xd4\x9f\xcb\x05\xdd\xb1t\x89*t\"\x7fb\x1e\xc1\xc0}\xe5\xbbj8U\x0d\x18\xde\xac\x9c!A\x80mxYt\x88\x1a\xa9L\x9c\x8dj\x08\x87m%\x187\xc6\xeeMT\x98\x9c!\xb6:_D\xd9\x1b\x18\xa2\x92\xe7\x8e\x81x5\x8c\x0e\x91\xc7\xbd\x833\x10\xf8\xc1\x16\xca\x13dg\xbe\xe4N\x88\x97\x90\xba\xfao\xde\xe3@\xe18\x95\xbf\xba\xc6\x0d\xf3\xf9\x8a\x96\xf2\xfd70\xb8\xb6\x8e\xe5\xec\xc9\x17v\x8a\xc4!u\xaa\xbdLh\x93\xe7QJF\xa6\x9261\x85\x10\xb7\x9a\x8d\x063 \xd0\x9e\x98\xb3\x04l\xb0\xf9V\xe3\x96\x8e\xc4\xdb\xb73kYzD\xed\x03\xeb\xa5\xc8\x879\x86\x88\xa3\xcb;\xea\xc0\x9aB\x88\x18\x8f\xa4Z\x01\x94\x932\xdd\xf8\xeew6\xb2\x9fE\xf9\xfc\x85s\x08\xc7\xa1\xadF ':\xda\x0cj\xf8%\xc3\x97\x8d^\x14\xd0\x8b\xa3\x17!\x99'?\x1d\xe9\x17\x11\xb3\x87\x13\x18\x8e\xf4\xab\xc9a\xc3\xe1yx\x9c\xec\xa8Ty\x9f>XR8\xd8\x97\xd8\xedQ\xb7-\xc6xf\xc5.\xb2\xc0\xfa\x05Z\x7f\xa6\x18\x0c\xa12\x058\xefd{\xd8+w\xb4\x15\xc2\x9b)Okpg\x17-\xe7\x9ebb{\xc3/\xbd187i\xd5NVS1k\xc8\xf0\xc0\x86H;R\x0al\xfbW~5\xce\x09\x11\xf5\xa5~\x9d\xf5\xa4#\xad\xff\x15\x04\xf5\xb3\xa7\xcf\x1e\x0c.d\xc4\xcc<.f\x01\xdf)\x03_r\xe8}\xaa\xc8\x14\x1cjG\xa2\xdf\x9b\x07\xdf\xb3\xa7\xcf\xb3B\x93\xeb\x9b\xd1j" +
+	"\x06\x18a\x9cmXm\xf3\x14&Yk\xf4r'\x0e\x8cN\xecM~DM\x02\x81\xbc\x94\x9e\xee\xa9\x87\xe1<\x17\xbf\xa6\xe5C\xf6 \xd5%jY)?K\xb2B\x83\xd3'\xd8\x88B\x06\xf6\x0d\x06\x95<\xef`\x0c\xa0\xa5p\xd9\x9a\x85rXq\xe3\xa2\xd8j\xe9\x9aHTs]\x04\xee\xd9\xd3\x17+\xcbaya\x13799\x9d=\x8b>!\xa2\x07z\x9e\xf5\xa1\xb7\x06\xd7`BY\xc2\xfb\xa5\xbbT\x03\x92\xe8\xa5\x15\xc84\xf8;B!D\x80\xa7\x1b\x1d~\x1d;Jb\xb0\x91\xd4\xf4\x12\xc6#b\xc0\x95tl\xd8\x08\x98={\xfar)w\xe6,m\xd9N\x0a\x8e\xc2\x08\x0a\"\xb05\x05\x8cz\xeaK\xf5q\x83\x02\x8e\x0d\x07\x07laW\xf8s1A\x0f\xdd\x11\x7f\x8a\xfc\xbc\x98\xf3S5\xf4\xd0\xcc\xb1\xcb\xea\xa0F'w\xa3M\xaf\x1eR\xd1bh\xcf\xd7\x82\x03\xb2\xe3\"\xfa\xde1k\xccx\xd7\x9d\xee$\xc4\x107\xdb\x13\x9c\x1a\xe5\xd8W<\x0c\x1f\x0c\"\xf6W\xec8d\x86\xa9h\xda\xd0\x8a\x1f\xa1\x86\xbe\x17#\x85O\xdc\xfcC\xa6\"\xb4\xe9l\x95\xe5\x8a\xd2&\xfd\xe6\x94~\xfd\xa8)\x1d\xc6\x13\x9d+-_\xbe\x07\xf1\xbb\xd5\xc2\xab\x06W\xd6Fl1\x8e[4\x0a\xfa)\x1a\x166)\xbb\xbd\xfe\xde<\xb4\xecC\x02\xe8dc\xf3\x96\x89|\x98a\xb0\xa8\xa2{%\xc4:\xf9\xc1Ok5a\x19\x0e\xc9Vl=\xb6\x0a\xecY\xd4\xf6\xb6\x8e\xad\x1a(\x84\xca\xe6\x85fBAf\xa7N\x95\xe4H\xcd\xf8\x98i\x1a\xab8h\xb6\x0dr\x16\xdb\x0e\xcb\xd0<VO\xe3\xd9\xcd\xd3D]>9${\xe8'w\xecE\x9f,\x16\"\xd0{\xc7\xc7SR\x93K\xef\xe7\xf5\xae<z=pY\xa7\xd6A\x97\x0e+:%[\x19\xde\x7f\x88\x14\x18\xf5\xacT7\xac\xf6\xa8\x83.rm\x98<\xdbh\xa1\x9a@\xbf\x84\x1b\xa6\xda1F]\xcel\x1b\xe8\xa9\xe2\xc5\xb8\xf7\xa7e\xd5\xbaq\xc7\x99rX\xd5\xe5K\xca1\xac\x10\xe1\xaa2=\x13C\x89\xdb\x92\x90\x0b\xf2g1A\xb8\x02T\\s\xe2\xb7\x17\xe7\x8d\x89\xdf\xa7\xa7\\\xe9\x84\x9c\x16\xa9T\x05\x19\x13zd\x06\x94f\xc2\x86\xa4\xe4\xb7\xa3+\x8b\xda\xf5\xb0\xba\xea\x03_(\x1d~\xab\x81Y\x93{\xe9|\xeb\x8cTqL\xa6\x98\x89gI\xda$\xc9\xcfN\xeb\x1a[O\xce\xf9;A\x7f\x81+\xe9\xa9gQ1\xfb#o\xban\x899z\x84\xe0R\xecdLI\x86\xbf\xb8h\xf9G\xe4\xee\x88!\x83\xb0<\x88\xda\xa2\xc7\xa20\xab\x11\xba$\x04\xaa\xdf4\x8c*c\x94\xd5\xe4R\x92\x08\xc5?\xe81O\xf7k*\\\x91\x8b'I\x94\x0d\xc5u\x9al\x15\xb1\x15\xc85\xf8\x8b\xc0@\x17\x8a[\xb4\x9aeY\x92\x87'\xbc\x9bQ\x94\xda\x16\x08\xfe![\x1f\xe9\x95\xcb,2v\xd5\xf2\xb9\x17\xcc\xf4&w\x8d\x94\xbf\xf2\xa3\x1d\x91\x8c4zm.\xc7\xea\x12\xde-\xb4\xf5\xb5\x9c\xf0K\x1d\x97$\xe9e\"\xe2\xa2M\xe8\x061\xa8\xbc\xfc.k\xcc\xd5\xf869{\xf5\xc6\x8c~a|\xfa&)\xb8\x1e`f\x85S\x00d\xf4\xc3\xd9X\xb0\x14.\xc9O\xb8\x1dQt\xed\x13\x97\xbe\xa6\x8a:\xdb\x99X\x00\x8e\\\xe0\x16y\xfbm\x94\xef&*,J @\x0b\x97j\x12q8\xd4\x98;\x8f0`\xca\x84R=\xa5;\xdf\xf5\x9f\x84+G\xb5&\x87\x0e>Aw\xc4\x9fE|3\xce\x07\x1a\xd2\x80H8\xbd:\xe2\xbc\xd0\xac\xfd\xe9Z\xec\xbbxZ\x9a\x12!VV\x109&f\xbb\xcbH\xe0f\xd7\xd3\xc6\x82\x85|\x08Y[\x1f\xc0\x97k\xd0\x8c\x19\x83\xbc\x1e\xf6\x9f\x1b\xa0\xd5\xfb#\xe3\xe5\xac~\x10&\xd7M\x01\x00\x8e\xbe~\xc7`\xd6fj#Z\x12p \xc6\x11.\x07xP\xb9\xd5Ju\x12\xd7\xa7f\xf8L{\xbc\x82\xbc\xb2\x86$\xe2[\x073\xfe:;\x96\x89\xd6\xae\xf7\xf2\xab\xad\x1a\x0ct\xb6p\xdb\xb1\xab\x82\xff\x94\xd4\xbf\xd84+\x7f\xb3\xff\xe4\x9e\x14\xdf\xe1\xfc&&u\x01\x0c\x85O\xa2\xea\xb8\xf5\x17;N\xe8%\x18\xbbS\xbc\x85\x10%\x8a}\x8a\x9e\xd7\xfa\x9d\x82\xe7\xc1\xb7z7\x0bb\xa7p\xb0\xbct~\xa3\xc7^?\x1c2\xa4]e0\xbbLc\xba\x80 \xd2\xabg\x89\x02\xead\x0a\x80T#\x0c\xef\x04\xddCe\x8e-\xf4gW\xec\xf8\x94\xe3\xe8u\x92\xb9\xa5\xea\xfb42\xb1\xb3_\xc6\x0d0\xa6j8\xb3\x0e}D\x93X\x01N|\x00\xe7w\xd0\x80\xb3h\x92\x1d\x17\x99\xecI\xf4\x7ft\x96\xa4Fs\x18\x8cOX\xfe^\xb4\x89\x08\x89\x12\xcb\xf9q\x98\x10\x86p\xc4\x9fT9\x9b\xc0E8'*\x02\x0e\xcc,\x84C\xab\x86w\x8cM\xc3\x19:\xa6\xa4\xe5\xf3\x0e\xfb\x0a-\x19C\xa6#G*\x06\x96.K\xd5[~\x98\xa0\x9c\xa1\xe9\xf4\x11\xd4M\xd0\xfa\xa2t\x19M\x18?pQx\x9av\x0b\x07\xc7\x91fu\xcb1,\x1b\xb3\x8c\xa1\xca\xc3B1b\xa6\x8c~\x88\xb6\x85\xa5\x07\xdb\xde$\xde<\xac<]\x9d\xdf\xa5Ojw;\xb0\xa3\x15\x025\xae\xa6\xdeJ\xa6i\xca\x91v\xaea\xa0\x0c\x1aS0\x9a\x90\xdao\x0f\xfa\xdf<\x1c\xfa\xd7\xd4\xd01\xf9\xe9\xad/\xdd\xd2\xe2\xfd\x09\xca\xc8\x7fh\xc8_\xeeF\xe5\xfb\x1a\xf9\x16\x1a5\x15)\xeb\x94\x02\x8cW6~Soh\xd7\x89\x10*\x87\xef\xf6\xf7\x89K\xd6\xad\xb5d\xe3\xa8\x9c\xb7\xe5/\xb1d\x84K\xb0/\xd5\xd0zs<=\x1a\xe9\xda\xf9\x04\x01\x8aP\x81\xc6\x0d\xabEG\xcf\xa2L\xbf\x0cB\x9c\xef\x922\xd8n\xb2|\xcf\x1e\xcf\x14h3M\x0d\xa7d\xb0#\xd9\x05\x8f\x963\x16\xf9\x11^H\x13\x1aRi\x1ac$H\x08\x17\xcc\xaa*\xd13\xb5\xebX\x0eH\xaat\x95\xec\\~\x18\xe5* \x9e\xbc^\x0eV\x96\x1a^_bR\x8c\xec_-\xf8y\xa9EL\xe3~\x8f\xfd\xc7(s\xeb\x9c\x8c\xbeu\x93)t\xd8\xaat\xfb\x8a\x00\xcd\xb3\x9bm*_\xd7\x10\xc5k\xef&8\xd2/\xd1\xc5\x8b\x80\x97\xb4\x90 {C\xfe\xd2\xc9\x97\xd0\x14\xf7Q\x0a\xcf\xd65a'\xe6wWJ\x9dr\x16\x09\x7fR\xac\xbfm\x93\xd9t|?\xb5\xcb\xf3\xb6\xa0,\xdf\xc3\x9ar\xbd\x0d\xc9#\xaf4!\xaaa\x9e\xe1\x16\x9a\xa2\xa9\xf4\x18`\xeb'\xce\x17\xb9\x00\xbd Zh\xc4Y!\xd3\xdb\xfd\xea\xfa\xdc\x95[_\xf8*{\x7f\xd4\x7f\xfc\xf9^Y\xae#\xb5e\x82|\xc7\xf7\xe8N\x98\x8do\xe1v\xb3\xfc\xe3\xd9\xb3g\x0f\xde\xc1kpe\xbd\xb4\xd7\xfd)|\xb0P\xc9}7\x85n\x96\x0d/\xc3\x03\xd6\x0b\x92\x94\x11\x02\x97\xcc\xb4\xd21\xec\x1b\xdf\xe0\xdd\x0a\x84\x8e\xaa\x08,\xd3\x9a\x9d(\x81\xe4$B\xa4\x12\xaco\xf8\x88\xf7\x9byy\x07\x11\xc2&\xe2\xf6\xa5\x93\xc1\x88\x85\x1d\x9b\xab~\x13\x80\x97R\xbc\xaeB\xa4\x95\xa5\x05\xa3\x11\xeb\xc3\xa91\xa8\x1fl-N\xe4\x0b\xdd1m\x14\xd2DB-\x0d\x93\x9d\xf8\xd3\xdd\xa7;\xce\xe1p\x97\xd0\x1e\xe7\x18\x87\x1f\xbf!\x17S\xaa\xa7\xf3\x0c\xf2\x14g\xdeJ5\xf0&JS^\x902\xf82\xd0I\x1c$,\xcd=G\xa1\x08\x03y\xd8\x86\xc3/\xc2\x96\xf8\x81\xad\x91|\xa9:\xef\xe2\x82\x8b\xd9\xeb\x13\x0cJ\xda\xbd\x1a\x8b\\\xefg\x8d\x8c\xfc\x08\xfd\x19j\x9d\x88\x12!e\"&\x9b\xe9\x1d}w\x81\x9a@$\xc2\xfa\xce\xbe\x037\\\x0c{S\x86*\xed\xcf9\x80\xddX\xa7|X\"\x07e\xf6\x95\xdfRc\xfe\xec\x99\x86\x0e\xb2T\xdd\x99\x80\xbe\x8c?\x1f97\x16[g\xb8\xc6\x10\xdb5\xfa\xb2\xd8i\xfb\xe8\xb2H\x0ag\x8e\xda\xc5\xdb\xee\xe4\xbe\xaa=4\xd26;\xbf'\x85\x81\xec\xc1\xf3\x10\x03\xb7\x9f\xfc\xd68G\xcd\xd8\x95Pqx\xf5\xa3k\x01\xbe\xe1\xf27r-,\xb5o\xc1+\x06}\xd1]K\x8e\xcc\xde\xd4kY|\xbc!\x98\xc4\x07\xeaM\xff\xdb\x80\xe3\x9f=K\x12\x87\x19R\x1f~\x85\xae\xd8\x859\x18F\x0b\xfb\x96\xa6j\xd8>Zh\xae\xbc\xd2W\xcf=\xf5\x95\xfeSb\x04\x08d+<\xba\xa8!\xd6G\xf6\x0c\xdb\x0b\xe0N\xe4\xc59\x9f\xfd\x9e\x00\xa1\x0d\xb4\xae\xf8\xab\x1f\xfc7\x1c\x8f\xe6X\xc8\xb2\xd4\x90Q\xfdC\x1e\xe3\xb8\xc3\xd3\x1aF\xe9MtC7\x94i\xe3\x06\x85Y\x88\xc4\xd4\x1e=t\xee\x88?9\x9d\\\xe3\xb2\x1a\xfc\xado\\D8\x0a\xf2\x10z\xa2[M\x1f\xb8\xbb\xc0\x00t\xaf}R\xeaK\xdb\x02=\x0a\xaf\x11\xf3)\xa0U\xfb{pE-\x96Q\x0e\xaeW\xe0*\xd3\xd4a\xfa4r\xb8\x10YG\xa8\x8e\x8duQ\xf1-\x0e\xe6\x09# \x8d\"\xa3E\xc2v\x1c\x8c/\x0b\xc1\xd19\xbd\xb0L\xbf\xc66\x8e\x09X\x9c\x19;C\xc4^:\xea\xd9}Qz\x9a\x0c\xe1\x88\xa6\x83\x1fO,B\x87\x86k\xe8\xa13\xf2\x1aj8\xa1\xc6Bj\xbc\x1a\xc0\xb2\xed\xa7,72\xc7\x88\xd74\x96\xd1F\x9bL\xd6\xb7D\xfbJC\xfc\xa0\xa7\xc8b\xde0\x16\x7f\x90\x97\xa2\x8em\xa4\xfa0\x94\xe4\xe2\xa3\x08\xcb\x02\xd5g\x1c\xf1\xe7\x1c\x97\xaf\xb7\xe0sTn\xbd\xc3\xd1\xe5\x01\xdb\xe7U\xb6\xeb\xf7^F\x031\xe6E\x1d|\x8e\xf0\xc1=\x0c\x11\x0e,m\xc7\x81\xa1\x07G\x10Ju\xf2\xa3\xe0\x15\x14\x9e\xbd\xf6\xf4\x85\x92\xb5\xfeL\xbc.+\xd4A\xf8\xf1\xfc~\xd7\xa8\xa9\xb6Kn\x9e=\x7f\xba\\\x115:Z<\xa1a\xb8\xa7Q\x8f3*\x00\xff\"\x10\xf1\xd2\xeb\xfe\x7f\xa9\x86\xb0\xd48\xacI=\x8c37\xce\xb9p\x1f\xce^\x94\x0e\x8a\x06\xbe8\x9d\xaf\xf4D\xc3+\x90\xbdS\xfa\xb1\x84\x91d\xd6\xc7L\xf3\x02\xab\xa3\x996\xa7\xaa\x81\x91o\x16\xecM\xe8\xb8R\x9a'\x12\x0d(\xbd\x98\xac\x08|\xbe\xde\x02\xe3\xbe\x0c\xe2{\xf8\x8c^\x9f\x11\xa5\x9b\xc4\xc9\xb2\xc9\xb0\xc8~\xbe\xeb\xc0\xef\x1f\x14\xbd~~\xb3\x08u\x95QL\xcb\xe0;\xcf!\xcbx\xe0\xb0\xa7\x82\xe7B\xe1\xca\x12\xea\xd8\xad\xb4[\xd9\x12\xc5\xe5\xfd\x88a\xac2.\xd7\xbf\xa0\xfc[?\xa6\xab\x82\x1e(_\xce\xd3\xc0\x9d/k\x18(\xb1l\xd2\xa4V\xc56\xeeo\xbd\x8b^h\xba\xc0\x145\xc5_\xcatE\x9d\x11\xf95\xec\xeaZ\xe3\xc6\x0b>&c\xb36\x88\xd1=_\xb8\xa1\x82\x0e\\/yq\x95\x93J\xe0\x15V1\xea\x0a\x1dh\xf4\x8a\x17hA\xdf\xb7\x05\x09d\x15\xbb\xf7c\xf1\xb3\xef{\xac\xbe\xc8\x93\xc6$\x9a\x95P\xd3R\x97\x1a\xe3C\x9e\x8aS\x151\xc8\x9b5\x1c\x94\xde\xcc\xdd\xa1\x194\xe8\xa3\x89\x95=L\x8a\xef\xd9\xf3g_3\xd7k8\xb9\x91l\x98l~g{\xf2\x8e\x8d\x94V\xec\x18I\xa5\xb01\x8c\x1b\x84=\x97O\xd0@[\x88\xfd\x14\xf9\xcdh\xb84f\xac\x14?3\xac\xf3\xca\xf2\xd0\xad\x83\x07\xa0\x87\xb1J\x09\xd6WS\xbe3J\xb5%#P\x18,\xf19\x0bTA\xe1B\xfa+G*~\xa7L\xdbs\x0d\x08\xfc\x04\x03fa\x13b\x91\xfe\xde\x8f\xbe\x81>fai\x9d\xb5\xb8a{(\xc2\xed\xb1\xac\xc8+\x15nx\xe3\x0c*l%\xed.tS\x89\xa9\xc6z(\xb87\xcb\xa0\x03\xd1\xaf\x11\xbe\x0f\xee\xb7\x8b\xbc\xa6\x1d(\x19\xa3\x9f+\xe1x\x12\x07\xf3T\x9d\xa0w\x06C\"\x96k\x03%\x0cESq\xb6I\x0a\xf9\x0c\xcb\x03\xdd(<\xaa\x13>\xcb\x045 \x1b\x9e\xf4Ji(\xa6\xb0-\xe9\xe7B\xeb8\xb818aH#)M\x97}\xcf\x14\xe9\xc99\x17\xfb\x10.\xae.v\x12\xb7\xd2\xce\xdf\xcfh\xab\xf4\xbb%\xb3\xd3\xf8\xf1\x1e\xbe\xc86}\xd5\xf0\x8a\xb1n\x9aL\xf4\x06\xd0CC\xae7\xcf\x08\xb4\xbf\"q\xc4\xfa\xb1O\xa9\x11\xbb7(\xe9\xea\x85K\xe0\xcc\xb8\xc4\"\xe2\xacnwq\xed\xac\x14\xa6\xf1nF\x1a\x12\x8f\x94\xd6|\xf6\xfc\xc5\xaa\xf8\xbd\xf2\x1c*\xe2-\x96{F\x14\xbfaB\xb3\xbb_\xb1\x8b+\x8bf1\xfd\x99\x89\xd1\x0d\x9e^\xce\xb3\xe0Y\x7f\x83\x1b\xe4>\x89\x11H\xbda\x0d\x833\x06Z\xba\xd7\xd1\x1e\xfbM\xb6\xbff0\xff\xd9\x16\xdb\x9d\x1c\x09\xd4D\xd7\x15O\xdc\xe4\xac5\xb6\xcal\xccfY\x97\xb4\xfb\x99\xae\x7f\xa3\xf7\x13L\xfa\xa2\x15\xc9\x93\xc8\x85\x1e\xcb\xd2\x00\x06\x185\xba`\x0cHy\xa8\xa5h\x17g~gU\x9a\xfbs8K\xa4i\x02F\x95h\xd12\xbf\x90\x057e\xa5F\x91T\x820\x90%\x18\xd5\xe0\x94)\x8b\x02v\xc8\xb3\x9b\x9a\xe2i\xa2l\x80\x1aFg\xbc\xbc\xb1j\xaa\x12\x06+\xec\xa7Q!i\xc5\x85\xc0IZh\xcb\xfb\xe4B\xd6e9\x9a\xb3\x8f\x91G\xf8+\x94\x05ff\x0e\xa6\xf47\x99c\xb2\xa3\xaf\x9b\x19K\xf3\"\xd2QQo\xbf\xe3\x99\x9ai\xb5\xfeY\xb6\xd0\xad5\xff\x95a\xbc\xe7\xaf\xe6\xa5\x8d\x0b\x85\xba\xbb\x0ej\x18\xf6\x96?5\xc1r\xab\xef?'\xbaJ\xa9!g\xccX\x86,*\xb9\x90\x98\xcaq\xbf\xc5gk'g\xe9\x11\xd9P)f\xd0\xf9\xe9\x9b`e2\xfc\x93\x08\xe3\xcd\xbcxR\xfc\xb3\xd9\xd3\xc2\x8b\xc4c\xb1\xc2\xff\x8f\xdcnv\xf7#\xb0\xd5\xe2\xa5\xe4=\x88\x09\xa0\x0f^\xdc\"\xe6\x0c\xbf\x1dV\xc5\x921%B\xcb\xab\xec\x90i\x08|cP5r\xf9\xe3\xf5Az\xe0\x08E\x00\xd4B\xed'i\x7f\xcdGL\x0b\xeb\xefaPe\x8e\xd2\x8b\x0a\\\xb8j\x16\xe1\xb1d\x8e\x19\xd5K\xfe\xde\xd4\x81L\xe4\xcc\x9c\x84N\xfe\xd7\x9c\xd2\xca\xdfs\x0e\xffp\x14}\xe3\x05\xcd\x94\x8cD\x1bM\xa7\xbe\x9a\xa1\xe8\x8e\xca\x042\xf5X\xf3\xf0\x89\x8e\xa1\x9f\xa0\x83\x99\xe9\xbbEt\xcbrO\xe4u<\xfa\xc4\xd6x\xf0O\xa6\x84\x90\xd4\x17\x89\x12}\x87\xf5K%\xf1[\xea%{\xfe\xb4\xf8\xe2\xc7\xa9\x06*\xb8\x0e\x07\x9cr\xb1I5\x16gh\xf0jd\xed`\x93\xab\xf6\xe3\xbd\xfff\xe3\xcd\xde\xce\xe7`\xa6\xad\x11\xb69,7\x05\x13\xc3\xc7\x07a#(*\xa8eWJ\xeaP\xf5%\x0c\x00\xfd\xc2\xf9o\x0ak\x0d\xaf\x03\x0a\x80D4\xc9N\x9f\xb1O+g3\x11\x83\xdfM\xc1\xf9\xd9\x8b\x84sj\xb6\xb2\xa1\xb1\xd6Sn`%B\xd4\xb9\x81e\x89\xcdgT\xd3v\xb5\xe0bT\x86]J\xc2\x10LG\xe2acE\x1e\x0dga\x0ccf\xb0|\x07_\x17\x17*n\xa0z\x1d$\xec\xa2l\x8d\x0d|\xdbX\xbc\\/z\xfd\x9b\x86\xf2.\xd8a4\xc8\xe8)\xa4\xb0\x14j\xf7\x96\x99f\x03:K&\xdb\xef\x13P\x7fq\x93e\xbd\xbe,\xa5at\xa3\xdd-\xc1~I\x19\x88\xfd\xf4\xbd\x1cY\xc9q!\x91,\x9e\x9dJ\x00/\xe7\xf2\xea\x09\xb1M\xcf\xf3\xec\x85\xc6,\xb6AV\x11\xd9\x95\x82.L\x18\x89\xe0\x16\xa9\x1a|\x075\xb5j\xe3\x0d\x9e/\x01\xe7U\xf2%T\x15\xc3\xe4\x9a\xa5e\xd2TE\x14\\k\xd0\xd6!\x99\xb4\xce\x1cR\x1c\x0b\x88\x93#\xa0\xab\xc3\x8fbw\x8bl\x92\xd8\xe7\xec\x9f\xd2\x0eD\x02\xdd\xa6\xae\x04\xf1cC\xb7&\x02\xf6\x11&\xa9\xf3\xf6R*)A\x0c\x9b\xb5\x15\x81L\x99\x7f\x8b\xf8\x07I\x0d\x09i\x84N\xddXT\xa2D{T\x9c\x15?\x11J\x94+D\xb1\xcf^\xbc\xc8*\xb0/\xca%\xc5\x06\x1aK\xd1-12\x19\x9b\xf3\xf7Nf\xbeP\x87\xe7g\x074\x1bx\xf6\x9b\xea\x0d\xd7\xdf\xc2\\\xfbD\xfflT\x14\xba\xca\xc0\xed\x81\xb1:i\x93\xd2\xfa!\xdewQ2\xf0\x02Sq\x86^\xc0o\xea\x9ck\x19\x99\x1c|\xe6R\xcb\xf4\x9f\xa1\x96\xeeQ\x11$\x0a\x11\xb7p\x91\xe2\x0a*\xb0\xda\x17\xa5\xde=,\xa5\xa3\x8e\x86-\xe1\xdb\xe8\xbbW\x0f]G\x17$\x0f:\xd2/[\xcf\xa7U\x96\x7f\x86\x93+>\xfa\x11\xc4\x88M\xd5:\xb9\xccCF@\x02=\xf8\xadte\xbc\x0fM\x9c\xa2\x09\xd1@\xe7\xf4\xfa\x01n\xc1\xec\x17.ND\xe4\xf39\xc1\x17\x08GLwq=\x17\x0a\xa7c'h)\xf3\xdc\x99\xb0\xdeG\xdf\x9f\x1b'\xc2\x8d\xc6E\xa1l\xb1!\xb07\xa5\x07\xa6\xe7 \xf9H\x9e\xf8q\xe5aU\x7f\x86\x0b7\x86{\x1eLQ\xc3l9\xad\x19\xd0NC\xea\x08\x85\xc7\xfd-\xd6~\x84\x0b\xbe\xd5W!\xffpj\x98\x18Y\x0a%rj~\xc5\x8a\xe2o\x8e\xbaQa{\xb4\xa9\x15*\x8c\x90\xa4<\x1e\xbc\x84\x97I\xf1\xa4\x8f\xcd\xf6\xbdP\xb7$\x1b\xc3\xf3\x96\xe6\xe2\xc2\xb6iL4\x9cq\xc5/5\xb4~RA\xcf0" +
+	"\xd5:\x7fe\xf8\xbeS\xbbO\xbf\xdd1z\x8d\x09=\x0c\xdf\xabS\x8c4\xa3\x12\xfasT \xbe\xe8\x17M*\xbf\xbf\x8f\xa7Q\xe9p\x08bd\x16\xc3\xde\x0d\xdckx\x02+\xe5y+\xe1\xc1\xa6\x89\xbe\x93x\x06\xaf\xc2\x07\xf4J\x87j\xd2\xd4\x07\x11\x1c\xd5\xfbs5@\x8d\xd15\xd7\x0a\x1a\xa9\xf502\x09\xd8Ij\xbb/d\x18I\xf7%I\x9aYseS0R\x02im\xddl\x1a\xfd\x93GBtv\x93\xc3%\x8f=6\x12v\xe2\xaf\x9eJT\xaf\xec\xa2\x1c\xa6N\x9d\x83\x12\xd9\xe2>L\x17\x9f\xfcd\xa8\xda\xad\x84\x80I\xadL\x18\xcbL\x04zx\x0cF7*\xff)[\xb3*!{\x06\xdc}\x8b\xd2\xfe\xe5O0\xf8\xfe\x1d?\x98\x96x\xd8\"\xb5\x8c<\xb3'\"\x81K\x12^\xd0w\xa3\xd6J\xe1S\xdc\x18\x1f\x03\xed{\xf16\x91\x8f\x91m\x88\x97\x0f\xbd\xb9r6\x077^\xc4\x97\x1c<`\xfa\xca0\x9c\xfd\xadK\xc8\xa2\xc9\xf8\x0d\xd7\x83T\x9b.\xca ``\xd4w\x05\xb8\x019\x83\x95\xe1\xd8\x01\x09\x8a7~j\xfc\xbc\xba\x9c\x02\xc3\xfcm|_\xd9 1fR\xfa&\x04\"\xf17\x09\x02\x09\xfe3\xe1 \xe1\xdb\xcb\xaaQ\xd74\xd6x\xf3\xed\xe7\xdbo\xae?$\xfb\xefR/?\xa4\xc4\x12\xdf\xcc\xd0K\x9d\xb8~\x89\xba\x90\xe2*\xb3{\xf0\xf5\xd8\x83\xd9`\xfd\xa2qAM\x19\xd1\x9e\x18\x89\x0c\\\x1f\xa1(-|\x81\xbe\xf8Q_7\xce\x04\x05<\xcf\xb3\x0b\xf7\xd0\x84{PX3\xd3\xa9\xad:\xef\xe4\x0c\xcd^-\xe1\"\x08\xb3\x1d\x17\xf1YjB\x13\xd9I\x85I\x9c\xa1%+1\xbd\x8eob\xe0\xe8\xb6\xb4\xec\x0a5*\x0a\xc2]#iz\x19\xb9$xT\x97z\x89\x87\xa2\x87\xee\x02Ma y\xf68\xdf\xfdR/|u\xbf\xcdP5y2B\x99\xd1\x05p\x15\x90\xc1@\x9c\xaa J\xd2\xcd]sW+\xed\x04\xba\xef2\xe8\xf8D\xec8e\xabZ\xa4\xdc\xc5\xba\xb3\xab=r\x93\x81\xecw\xdc\x18\x9e\xef\xfbd\xbc\xe43\xee\xb5|\xa0(\xca\x95K\xeeQ\x96\xe9{\xf0\xa5\x16\xfc\xd1\x0d\x16\xbc\xb6.\x85\x97ax7|s\x9f\x9f\xf1}Q3\x9eA\x8ch\x0dR\xf4\xf7\xf1\xe5\xf5\xf4M\"I,5\xb8r\\\x1au\xf5\x0c_\x8cd\x80\xbb\xa6:C\x0f\xa3\xd4\x883\x89\x97\xef`\x8a\xbc\xef\xbcqV\xa7\x8c\x8e_\x14\xd9\xb1\xf4\x07\xe7\xb3\xefp\xf1*\xf5\xe8\x9a_*\xaaw\x9f\xfc\xc0\xf4p\xac\"\xd7U\xe3\x04\x05\xb4\xb0a\xf8\xbd\xd4\xb0\xc3\x0f\xb1\x14BC\x0bt,\xf2\xfa&r\x10\xf8\x04\xcd\x02\x0c\xa4\x0e\xedG\xc2\x80\xfd\x0a\x0b\xa1e\x1e\x1c\xc1f\xb3\xe3\xf6\x1a\x850\xb9M\x81\xd0a\xe3\xe55b\x81E\xee\xc7!\xfc\xc4\xc3\xf5\x83\xda\x85\x8d\xedm\x8c\x8f\xa9\xe03wS>\x12O\x8a\xef\x97\xb8\xe6\xeec\xd1\x0c\xeft\xc7\xf0\xa3\xd8\x89f\x9etEI\x95\xb8\xf1\xb1I\xa6)\xccD\xa7\xbcL|\xcc\x94\xd5\x00\xb7\x82\xb6]\"a\xf9\x01i\xc9\xa6j\x80\xe2#\x0c\xf7\x96\x82\xad\xf3\xcd\xad\xfb\x15\xe3\xd4\xc3\x04\xf4\xed`'\"t)\xce\xb8\x7f\xf1\xb5\x0f\xcaI\xa5\x1f\xe91W\xab\xc6&C\x84\x81k\xf3\x88?\xb3S\x8eg\x1fks\xc8\x92\x1b\xd2\xf18,\xd3\x06E7V\xda\x82l\x06\x91\xf1\"U4\xd0\xab\x85v\x82O\xfb\x84\xe3X\xaeJ\xd1.\xd0\xe3U\\F\xbe|1Q\xc4\xcf\x17y\xd29\xda)6\xc6i\xfa&\x0d\xb9\xeat\xc18\xe8\xea\xc6\xb2>\xc1_<\x8cs*c\xf3.g\x9cM\xa7\xc8'\x98\x88\x90\xaf\xd2\xfe\x98\xd4\x13\x0fY\xa4\xfa3\xb6\x85\x829\xa1d_\xe1\xd6\x13\x81\x863Qj\xfdp\xbc\x87\xcf\xf0\x8dL\xf4\xd9\x91\xf4\xb5X\xd5\x97i\xd9\xc7\xc1<\x96\xce\xd8\x9dtWM1s\x94D\xdf\x1b\x00\xce\xf7\x0e(\x03\xf8'\x14\xbd\xa4\x12i|\xe5)\x14cR\x04\x96~S\xd9\xe8\xae\x83\xa6nI6j\xff\xce`\x9b\x80\xaa\x81\xb4\xed\x93+=\xca\xdc\xe0\xae\xcd^\x95\x99\x09\x05b\xc7&\xa9\xe7\x0e\xbb~\xc0cG\x9fA4S\xc2\xf8h\x07\xa6\x15\xf6L\xf5#ez\xdf.\xe6\xb5\x8f\xf6\xa1h\x13\xa4)\xde2j\x89$\xea\x13su\xfc\xf4\x968\xa7\xd0m\x95\xa7F\xcd\xef\xb5\x83\xf4\x83\x1f\xc6x\xa0\xb5\xee\xe2\xa7\xa4#\xa8\x17\xbe\x9fQ\xee\x0d\xac\xe0D=\xc293\xc5\x08\xcd\x0a\xc4\x7f\xc1\xcd\xd6\xa6\x11\xaa\x116\xa2\xb1/\x172\xee\xa81\x0f=\x9b\xd0;\x11b\xd9\x17p\x86\x0e\x8a\x1d\xf2\xba\xed3fZZV(8\x97\x08\xea\xb0\xf65W\x14\x98O\x88p\xd7\xa8\xca-R\\6\xaa\xf0\x1f\xcc\x98s\xcdF\xe6\xb2\x06\xf5\xbc\xc8\xc5\"V/\x94p\xe5\xaf\x9c\xf0p\x98\xa0uF3\xbd\xd1\xdc\x8d\x89\xc0!\xc5\xd2\xefV\xaf\xf5\xf2u\xb2p\x8b\x90)\xed\xcbp\x96\xae\xedu\xefp\xa9\xe24l}\x92m\xe2\x8a\xf53\x05t\x831&|\x0e\xd2\xcaOP\x87\x87\xa3\x82\xf3~\xbe\x05\xdd\x1b\x0a\xb0\x8b\xbc\xc8Q\xffE=\xd8F:\xbeC\xac\xa5Zz\x17\xfbH\xa5\x98Xx;\xab;m\x9dVC\xdd\xf0\xee+\xa8\x1b^\xbeyT6G\xdbRV'\x98\x8a\x1fNX4\xa4\xe5\x0e\xb8\x8d\xa2\xcd\xcdG\xca\xa2\xb8|f\xa3\xf3\x9d\x19{\x11m\xf4\xd0x\x0f#`\xe3?P@[\x1e\x18\xe3D\xea\xe2\x86G7\xa2`\xf4\x17\xe9r\xcd\xe1\xb0\x0c\x1a5\xe6@\xdf1O\xce\xa3\x1bpoj\xe1W\xee\"\xce\xb0\xbc\xd4\xf5\x08\x09\x9e\x0f'J+t\x10\x9a)\xe5\xc0(9/\xe9U\xb9\x86B\xca<gO0\xc0$/\x8eH\xe4\x10\x1ar\xd0\xe9Q\x18O\xc7w\x0b<\xa4\x19\x97\xd6\x1a\xf2\xf7\xda\x19\xaa\x11\xa4\xf7\x13\xf8\x82\x93>\xbb\xb5Y\xb3/21\xa4\xd6K.\x94\x95.\xf1\x93\xddTu\xa7\xbb\x06\xfd_\x9a%\xc3\xdd\xe9\x8eR\xaa\x93\xbf\xb8\x02&\xb8\x10~h\x1e\x89\xfa.K\xff'\x03&\x05\x18\xfc4\xd7;eT\xab\xa1\xff\xe4\x07\x89\xd5\xa6\x1ch\xc9\x9c'\x1a\xb6\x01&?\x12l\xd5\xf8\x9d\xf6a\x14\x1aby\x05\xb5\xe9\xa6\x8c\xb0\x95\xbbL\x92\xf4\xdcs\x0c\x1c\x08\xc7u\xef\x943k\x1e\xf3\xfe\x99L\xee\xe4\xf2b\xacp\"A\x18\x91\xd0\xba\xfe\x0c\x04\xb0L#\xc0\x9a\x15\x8d3\x14\x8f\x9a\xf8\x1a\xcc\xda\x97\xdf\xdd4]\xde\xfb]\x81\xa8\x17\x83\xd6\xef\xa0aes\x16\xfe\xc3\xc8\xa9AZ\xe0\x9e\x7f\xaa\xfcI\xf8V\xf3;4U[\x95 \xa2N6\xa6\xc5\xa1\xdd\xc9\xb7r\xbb\xf0\x8f\xfd;2Im\xf8W\xc9{(\\\xaa[\x88\xb8\xa5\x91Ie\xf9\x1d\xb2G\xe8;1\x94\x89G\xfd\x03W6\x050\xb8\x1d\xf6\xf9%\xe1\xcf~2\xd9\xc7\x12\xce\xce$\xa6l\x80\x90\x1aK\xc4\xac&|q\xda\x9a\xdf\xb6\x97\x10\xf3\xc8\x99\x8a\xa5\xfd\x0c#ts\xdc\xe1\x07Q`\x19\xb3\xca\x89k\xa1\x9fW\x09(f'\x05({\xc9>\xae\xec\x8b\x12\xd2\x0a\xea\xa5\x98\xb9\xa5Z\x848\x08\x8d-\xb6\xff\xf7\xfd\xb9\x01\x9f6:\xa1\xd6\x80\xe9\xda4\xff\x0e\xa7\x1f\xb6^N8]a\xf1<I\x96Y\xd0I\x95\xb9\xc7_\xecg\xd2y\x80\x05u09\xbcx\xa2\x94\x7f\xf8%4\x1f\xa1\x05\x8d\xdf\xaf\xbd\xd7\xee\x03\xb4\xe05\xdc\xbf/\x10/\x15.%\xc0t\xd5\xdc\x0dHR\x16R~\xb8\xe3\x7frH\xf1\xa7\x081dYj\xab:\xe0\xd3v\xd9S\xf6\xf2\x98\x7f\x0a\xdfl\xab\x09\x1a\xc2g\xe3HO\x0e.\xc7\xf0\x83JZO\xbeq\x13\xff\xd3\xf8\x80\xf4\xef#\xff)]\x03\x17\x08V\xb3)!\x8c!\x015oRfD\x0dj\x9d\xab!\xa1%\xdaF\xc5~\x05\xc8\xe7\xd5M\x04\xd5\x0d\xbe\xbb@\x07\xc4[\xf0\xb9\x89e0\x8d\x9ac\xa1\x0b\xf7\x8b\xe5\xef\xd7\xe0\x08\x91\xdf\x0e\xa5Qb\xd8\xcec\xc1i\xd5\xbbZ\xef\xe5\xbb\x13A\xe4I\xae\xe7\xe4[4!q\xd7\xd1\xd1\xc0\xbf\xea\x96{r\xb7\xa6^&\xc38\xec\xce\xd0\xdf\x12Oo\xae{\x14N\xc9\x8c\x9b\"\xcf\xdf\xed8.H(\xa0\xc7\xd9\xe3\xaf\x9emU\x19\\\xeb\xb3\xe4\xf4\xcc\x9cF7\xb8\xfe\xec\xacK\xa8\xb7c\x19\xe9\\@C@\xeb\x9a\x8f\x14\xe4\x1b_\xa7\xf7\x0e\xcbxrH;C'\xc2\x88\x99<\xf4\x17\xf6\x07\x93\xe5\xb9TC\x98\x10\xc5\x05jhA]h\x0a\x11Hf\xc7b\xad\xa5\xc08<M\xc1?\xd1c\xa67\xe0\x98\x92\x0dN\x95\x8e(\x1d6:\xfa\xb9\x99\xc7\x16\x0c\xcfl?\xbdE+\x03|\x9eq\x01\x89\x9e\x16w&w\xafNSC\xca(=$:\xea\xf3>z\x92\x1c\x1ap\x0f\x8f\xc3\xa9\xbfz`\x90j\xa1\xcc\xa0v\xad`ohP\xf6\xd2\xb0nm>\xc8,\xb8>\xf0L\x1aS\\\x9b\xc4\xec\xca\x0cn&\xcf\xcbq\x11\x83\xe7\xda`L\x88\xc5r\x1f\xb9\xe2Y\xf7'km\xd2;\x9d\xc2\xf0\xb4\xee\x93\x8b\xba\xf7\xa2\xa6\x86\xdb\x06\xf4\xc9r\xc2\xcb)\xd3L\xe4\xb1\xb5\xbf\xa5\xda;\x98\xb4R\x17\xcd$\xf1,\xc2<\x0c\x95Y\xfa\xe6x\xcb}\xc6\xa7\x88\xe1,\x18#\x97&\xd2I\xc1`\x16\xea\x86\xe8\xc8#\x1d\x90m\x09\xceg\xaf^f\x82\x97i\xf7ez\xaf1\xe29\x1b\xc8\x15)\x80\xa2\xd4(NSM\xd5\x19W\xfd\xad\xfb\x02\x06\xa0H\x83\x13vy\x12\xcb\xf5j\x87\x0dx\xce\x92\xeb=\x11+2\x05\x97x\xf8F7@\xc7d.vl\x98\xff>\xdc)\x92\x1b\x8e\x8e\x04\xcb=\xa0\x0b)$\x0f\x04`c\x86\x03s\x17\xb3\xd5\x85\xa7\xb8\x88\xde\xa7\x8b\x82\x03\xd4\xb91\xecD\xe4\xc4\x96\xe0\xf6\x0b1\x9a\xa5\xca\xb3\xab\xa5O\xafr4\x1b\x94\xb0\x96\xbf\x11\xb7@\xca\x035S\x85\xedo\xfc\xc0\x05\xf5\x98\xaa\x1eb\xf8\x04\xc2\xd1(@3\xac\xe5\xc4t\xf8\x91~\xbdc\x823\xb9\x9bjKr\x9f\xb2mK\xb9\x83\xd0\xa7\xc7\xf0\x03\xac3G\x06\x0b\xd9\xd2#\x14\x93gT\x86]\x98\x02\x07J\xde\x1bK\x9ac\xa1RXRM\xf5\xc9\xb5G\xfc\xc9K=\xbeJX\x9e\xfe\x12\xda\xe7z&\xaf\x8f\x8d\xa4\xc0\x16\\\xdcA\xbd\xfc\x1340P\x1e\xfb\xf1\x1a\xe2MN\xae\xd8\xe1=\x06:R7F\xf4\xf5\xc3\xf6k\xdd*\xd3\x9c\x02,\xe5\x14pN\xd3&\xa1\xbe\xa1\xca\xc0\xd9\\X\xb6\xbb| A&\xb1\xd7J\x18\x12\x05\x17*\xd0X\xac\xeb (\x15=)\xca\x8dP\xcf\xf2%\xda\x80\x14\xf9\x95h\x85\x8bfi\x04\x09*\xeb\x09Q\xca\xa8n\x84\x9cF\x9d\xeb?\xb9\xfe,\x91\x1e\xb5\x0c\x16J\xcd\x0e\x0b>Yz\xbc\xfeR\x0d\x17\x7f\xbbe(D\x06V\x96\xfb\xc0\x8d\xbe'\x15o\x04\xd66\xe1l\x0c?\xdd>\x01\xe74\x15\xd5Fit\xab\xbf\xc7Z\x01)%\xe2\x92\xa5[\xa8!\xdcw\xd7\x84\xc9\xb3\x7f'KAg\xa1x\xdf8$\x9d\x8f\xe4\x80\xdc\xa98'\xc3\x13\xd8\xf0|\xbcY\x0a\xd7\xcc\xd2\xb7i\x98\xd8\xa2\xe6\x1a\x9c\x92\x9a\xf3o\xaa\xcfPG\xf81\xed\x15\x12\xc5|\x1f\x12\xa0\x03\xf7Gd;x(Rn\x16\x92/\x9d\xbc\x85\xe3\x17`@\x95\xd5\xd8\xe3\x13Y/\xe4~\x98#\x94I3dM\x021\x96\xf9G\xacL\xca\x97\xc8\xd5\x1f\x8c\x8f\xa78\xedz\x9f\xbe\xb6\xd2w\x043]\"G\x07\x0e\x06\xce\xaf\xf8\x15\x97\xec:\x99V\xa6\x1a\xf9\xcfkEsL\x9b\xa1f\xb7\x9c*\x97\x80\xaa\xb8\xeb\xcf\x18\xf4`\xf1\xaf\xf5\xd6\xdc,\x96\xd5!N\x87\x10\x00hU\xee\xa2`\xb5\x89\xb6\xe3&E\xb8\xe4\xbc\xcc<-\xce\xedu\xa2\xcf\xe9\x8e\xec\xb8}\x86@q+l\xb0\xf6\x0eV\x81=\x12)\xd8\x15\xa3X\xbb\x1a.(/\xa3E\x15>n\xa2M\x85'0\xaaC\xf0\xbfV\x09f\x1f[\xb7\xf6\xfaY\xd4\xda\xd6\xe8\x0dW\xb7t\xden\x08\x86\x1c\x06Hw\x8e,\xc04\x98\x82/t\xd4\xe9;3\x17w\xf2\x97\x09\xfcg\xf7\xd7\xfd\xd5e\x08\x0b\x94\xfe\xd8\x99K\xf37?\xb7xT\xc8\xdb\x10\xcf3\x1e6\x91\xade\xf9\xd01f\xa78Y\xf1\x88\xd8\xb6?\x1f\xff\xf21\xeebU\xe3\xa8\xf7\xf4\xfd\x85q\x02Q\xca\x96n\x83\xb1u\x11\xdd\xcc\xdfg\xc6\x9b]\xe9\x06\x8fH\x94\xadMB\xdd\xd4\xddw\xd5\xe0\xfa\xcf\xf0\xd7\xd6l\x98\xb39\xaf\xd62\xa9)\x11\x7f\xbf\xab\xa1\xcc+\x06\xde\xa5\x11\x02\x9a?\xa1\xca\x1bM\x88\xbdd\xc2\xdf%\xea*xi\x98\xd4\xb8\xa8\xd04\x92\xdb\xd0e\x0dX/@\xf3\xc3\x91\x9b\xb5s\xfd\x04G\xfc\x19\x92\xad\xb8\x1ezh3\x9a\x95N\x0a?\xddg\x98\x8e\xf8\x93L\xee\xd4u\xc2\xdb$]!U\xf1\x98F\x1b\xa1y\xfc\x90\xcf\xb0\x1b\x0f\x10{\xb8vz\xbe~\xf1\xe8\xa0N\x87\xc7&\xa3\x02F\xa7\xf0\x0fn\xdf\x14\x82\x1c\xb4\xa71\xca\x11o\x1cQ;\x96\x07;n\x0f\x0ba\x97\xfe\xfe\xbf[\xc8\xe5\xf5\xcb\xf5n\x001\xa6\xe8L\xdd\x17\x83;\xa5s\xf3D5\xb8f\xb5\xab+O\x9f\xe8\xd9\xcd\xc3J8\x81p\x1d\xdbm-\xf9H}\xc8\x895\xd0C\xbf/.\x1c\xc3(\xce\xae\x0d\x13\x92\xabo-(\xc9B\xc6\x0f\xaa\x07\x11\xa9F\xb3B\xa0\x9da \xdbg\x8a,\x11\x99\x83\x0e\x0ft:\xe1Ck\xa3]\x9c\x17r\xd4\xd0R]k\x0a\xed\xa1\x8f\x06\xe8\x1a\xbe\xc3\xc2\x19O7a]\x86\x98\xf9ha\xc8\xb1\xf8Q%\x81\x007\x01\xabS\xf5!\xbaC\x0bW\x86\x06\x9f\xb47\xaa\xf0\x09bS\xad-\xb1\xdfY\x88g\x97a\x99\xe4\x05\xf6tf\x12\x07<\xe6dpj\x0ff\x8f\xe3\xbd\xfb\x9f\x93\xa1%\xb2\xd3\xe5\x97\xba\xcb%R$\x99\x7f\xfc\x1d\xb2\xfa\xaf_\xe5\x96p\xe7\xc7\x9e\x160\xceY\xb5\xf4O~0\x86\xcf\x12\x98FUT0>\x9d\x8c\xda\x04\x97\xb9H\x05\xb9q8F\x89\xa0\xe4\xfa\xcb\xbe~\xa4z\x84\x15-\x87\x0e\xdab\x84\xb6Bu\x08<\x94\xb1=T\x1b\xcf\xcd\xf4=\x15\xf3\xfe\x0a\x0d\x06[\xfe\xffl\xc7y\xf3P\xf4\xde\x06]\xf4\x14\xeb\xca\xb1P\x05Gyg\xb2\xaf\xa5\x0b\xd5\xb6\x01\xd2\x9c\x95\x8e<\x8a\x1b\xfauZ@\x12oep_<2\xbb\xa4\xd8]_j\xbf&\xf6h\x1aC0\xdd\xe0/\xb2\x99\xcb\xfa-\xea\xe5[\x81\x05&\x19\xdfIi\x0e\xad5\xe8\x93\xa7q\x89\xfa\xe5\xae\xb9k\xe8\x08\x0b\x86p\xf6\xe6l\xf2\xcc\x86S\x8c\xa1\xf5ny\xf3tF4\xbcK\xbb\xb5\xf6\x13\xaf\xf7\xbd.\x99\xb0\xf1G]\x17\xa1\xef\x16be\xde\x0e9J\xdbp\x01\xaaP\x8f\xa1\xbf\x80k\x16\xfbZM~\xc6\x00t\x1e\x0aOX[<\x11f\xbeD\xc6\xfer5\xe5\xf3\xe6&\x0d\xbbF\xaa\x0ab4\xa7=z\x07\xbf\x8a\xf6\x9eTr\\\xa0\x84}\x8e\x0a%\xca{,T\x85N\xde7Q\xbeL\xb7\xd5X\x8b\x03\xa3\xeb\xcfG\xfa\xc5\x16\x1b\x99\xba\xacO+89\xe2l\x8bKu\xb6\xf7\x8b\x87c\xab\xfc\x94\xb4(3\x89\x1fg\x0d\xbfy\xf6\xb0\xf5\x9cP^\x9c\xaa\xa1\xf3\xdf@\x07q\xeb\x15+\xca\xf7 \x81.\xea7\xe1Qd\x9a\x95\xc3\x82\xde\x80o\xdd\xc8\x01\xbe\x8cr\xc7\xf8\xcd\xe1\xa2y\x09\x96\xf9\xae\x12\x13V=\x0c\xb9\xaf#7\xa8\xc2\x1a\xef\xb3/\xceI9L\x11\x05[\xc9\x0d\x98\xd7r w\xa0\x13$~\xb2\xb6\x8e\xf0\x8e\xaek\xc9s\xdf\xe9\x00\xb3\xff2\xe1\xcb\x91zwzC\x07\xba\x95\xa6\xfbh\xb40R\x15\x06z\xb7\xb3\xe7\x1a\xac8;\x14\xbfGoE,|\xce\xa0\xca\xcd\xdb\x9af\xb4UG\x1e<\xda\x1e\xb8b\xe9O;&VA\x1c\x14\xfe\xbdt0\x8b=v\x97\xb4\xed\x12\xe4\xd7\x06~\xe1\x92G{\x91\x0c\xe5\xad\xbf\xf5<\x94F\xc2\x1b\x97\x04]Pz\xf5R\xc2\x87FR\x84'q[\xb5\xd0\xb8b\xa7\x13\xa7\xf3{n\xf6$\xac \xe1\x1e\xf1Z~\xc1\xdd\x89\xf2\xec2\x03\xf9Fe\xf2\xd0\xc8\x00\x8c\x0f\xc6w8\xe2O\xe3\x07\xa1\xc3\xec\x0e\x12\xd5&r_\xb9\xab\xbf\x00=\x8fw\x02\xfa3\xf6E\x7f\x86\xa9\x99\xb3%\x9ag\x84\x91\xe2\x09\xbd\x8bL\xb1\xe2\xb1\xecu\xcd\xca\xc9\xef\x18\x05\xb1(\xdcq[\xc5\x05U\xc4\xa9p\xa0}\x18S\x03EG\xda,\xa4\xdc\xc4[>j\xb1\xf2Z\x17\xfe.|\xe7\xbf\xccM}\xda\xd8\xa1tm\x86\xf5\x8e\xa4\x9b8w\xb1\xe1:\xe4\xd0\x9b\xcfS\x8e$:\xf8\xc2\xd9\x1d\xb4[\x1b\xc2\xc5\x0ep\xf6" +
+	"\xd0#\xca\xe4\x97\xd0\x1a\\\xd0\xb2]\x11\xe1\xd2>cz\xd2\x8f\x19\xd89\x94p\x06\xca\xc8\xe3\x86\x16GS9\x8f\xd3\xadh\x8b\x8b\x19?\xde\x85\xe7\xec\xb3C<\xbc\x9d\xeez\xd9\x0c\xcf7\\uT\xf2\xd1\xd3\xbd\x11z^\x05#)\xca\xa3\x10\x12\xd9\xc4-\xa6\xca-\xcfx\x0b\x0dzr\xbb\xc4#[t\xcdkh\x174e\xaa\xcf0:\x11\x9f\xe7su\x9b\x1c\xf7]\xb6\xb9\xa6\xd1\xbd\xaf\xe5\xc5}\xf3|\x8d\xf8)*Q\x06{\xab\xbfvj\xed\x17\x89]\x15`\x91\x1dE\xb3}\x0cJ\xe0`:\x1bE\xb1\xd2\xb3t\x8cL\xa7\xe0\x91\xec\xb7\x17GF\xcf\x02\xa3Q\x0c\x8ar\x8c\xeeR\x0d!h\xe6\x16\x8b\xec\xb27\xd1g\xf2W\xf8\xf8\x11\xbf\x02:\xe81\xf8\x13\xf8u\xf8$\xd8?\xf6\xd4Y\xdcBX\x0c!\xcc\xc1}\x1e#\x8e\x8by]o;\x8dJ\xaf\xa0\xfdy\x16\xa6\x07-\xae\xb6\x1a&\xa7\xaf\xb6<\xa9\x1e\x1b7~\xf3\xc2\xf0/Q\xad\x8b\x89\xfd\xe6$j\xb4\x10l\xcdG\xfeQ\xac\x01\xb1\x06m\x0b5f8g\x97[\xb2\xc6Uv\xcd\x18QM\x82\xed$\xbb\x16\xc0\xcdn\xa2\xb9()4+%\xc5\xa9r\x8c\x82#\xbb\xf5\xc7T\xef\x1a\xf3bn#|\xad\xce\xb9k\xc6\xadl\xd3lTV\x81\x9c\x1b\xa3\xf12)\x8d\x8c\x85\x1f)\x82\x85\xf3a\x19\x81\xb7\xa5\xd6I#4zF\x05\x07j\xdd\xcd,~\x16\x10\xe3Zm\x0a1%\x1c\xf5\x1fH\xa4\xd9\xb8\x09NG\xfa)\xfde*\x09)\x05\xb0\xfb\x983J( \xf2\x83\xa1{\x09g|\x09\xc7\xf0#\xb4\x88\xcf\x9fQ\xb8\x98\xb1P\x9bGY\xb7\x07\x16P\xcd\x1e)DR\x89,|^\xa5\x80\x8f\xe6\x1a\x98R\xea\xb1'\x80I\xe0\x90\xbb(UV\xde\x81\xbe\xf2{\x9c4Z\xae}o\xab\xc2\x0d\x0f\x11\xa3e[#~E.\xd55(\xef\x9bW\xcb\x8aH\x1dsPk\x87$\x91\xcd\xd1\x83\x8bi\xe4\x94\x8d\xfa\x87y\x80e\xc7\xb57\xcc~\xa9\xaaG\x02\x1a\x92\xbc\x993_EQ\x7f\xfd&'\xa1\xf7q=\xa8\x13\xb7\xdauY\x05}\xdc`\xa9\xaf\x0a\xd5f\x8alC\x8e\xacH\xd9\x1e\xb0\xba\x0f\xf3gO\x8akw\xc3\xfeJ)\x07\x1d\xfdQ\xa7>Lt\xe4\xa4\x00\xe7\xfc\x8bv\xd1\x18vOw\xfd\x15.\xae\xf1\xad\xb9\x13\xbeB\xf4\x7f\xa1\x11\x95Z\xfa\x04\xe1 \xbe%\xe1\x10\xa2/\x87\xcf2\xd3\xde\x15\x90\xcc\xbcwE\xa9r\xab\xfa\xc7'f\xda\xfd\xc4\xe9X~92\xe1V\xb8\x06\xa2>\xc5\x12\xd3\xc0?\xfc\xbb\xd1\x0f\xc0\xff\x0e\xff\x94\xc9\xfa\xfc\x0fE\x0f]\xf5\x8f\x7f\xf8\xdb\xff\xed\xdf\xfek\xf1\xb7\xff\xef\xbf\xfd/\x7f\xfb\xdf\xff\xf6\xaf\xff\xf6\xbf\xfeaynGA\x8b\xd6}.Z\x80\xae\xe8\xdcV\x89\xcb\xefP$\xca\xa6z\xfd\xc0*QS$\xba\xfaZ\xcf\xa3\x0e\xb7\x90W\xb3t\x03\xab4\xecd\x9b\xde/ \xae\xa5\xce\xe3\x1d\xad\xe9\x9e\xc9\x0c\xf0\x8b\xcb\x92\x10<\xe7\x0c\x8eR>\xf9\x05\xfc\xc0\xb1\xd5\x1f\xfa[\xd7\xae\x8a\xb4\xeaI\xcf\xf72\xafx\xd0\x9a\x0eM\xf0\xa6*\xb7%ud\xd6\xd2\xff\xe0\x87\xbf\xc2L\x12s\x19B\x18\xe7Y\xe4)H\x8fs\xb5Cq\x8f\x89\xcb\xf3\xdd\\A\xd8`\xd0#\xeeKBN;\xbc\xc1zD\xe8\xe5b|\x1b\xdbkw[B\xcc\x11\xb8RBh1\x7f\xc6\x80\xa7\x85\xe0$[G\xab\x8f7\x0a\xee\x90\xe6\xd7c>\xdb(\x80%\x9b\xd3,\xb3\xfe\xcb\xa3\xd7\xc2\x81\xd6\xc2W\x83\xf9_/\xbf\xfe\x03\x16\x80\xe0\xc2\xd4\xad\xdc\xb9\xd1\xed\x05<\x1bg\x05\xdd\xd1\xd7\x0dt\x00\xd3A\x85\xb1C\xb0\xf8\x88?\x0b\xf2\xab\x83\xd6\xda7\xec;\xab5P\xec\xf4NH\x05\x0a\xe3=\xc0\xc9\xd5\x00\x81Q\xbe\xc4j\x9c\xd9\x0c\x8dfhx\x9dOw}y\x17\xcbl\x9b\x0a\xeb|\x172\xa4HQ\xe4,k\xc8\xac\x15\xf6\xf9\x16\xd0\x16u\xa6\xc4\xb1\x17\x8du\xfd \xc0\x11\x8e\x9f\xb0\x1a\x11\xbe\xf9\x82\x99\xb1\x98\xee\x8bs4\x96\xb3\x92\x07\xa0\x17\x9bq7P\x86\xa5\xb2\x0aj\xbek;\xdf\xdfkV2\xb1\x912\x83p\xb3\x99\x87X\x0c\xe3Du\xcc@\xf6\x1b\xe8\x9c\xad6\xc9\x84\xbe\x96\xf1Q\xdckv.\xafA\xaeVg\xae\xe6u\xbe\xd22Q\xe9\x1f\x98\x88\xd9gu\xa2(o\x86\x1a\x13\x0bHk\xc3\x0dD\xd8\xb2\xa4\xe8\x8a\x1e@'\xbb\xe9B\xba\xe1;\x81K$\xae#}v4\xdb$\x05\xcc\x04\xc3\xb7\xbfbuj.i\xb1;\x16\x1eE'fw\xa1\xe9\xcbgE,\xb9'H?q\x7f\xd2\xce\x9c'\x1f&M\xe7QD\xab\xe0a\x96\xe9\xb1\xc4\xc9\x17\xebGZ\xa8\x1di\x1d\xac6\xe9\xe6\xe9\xc3r\x99\\?NP\x8fSX\x10\x8cx\x16\xceH\xc3\x86\x9b\xe5\"d\xde\x12\x97\x10\x1d=\x1a\xf3\xcb\xc8\x92\xbbY\xc9x\xd88Hih(\xe0\xa2\xa2\xff\xab\xedQ\xebIb\xd3*\xcf\xa0\x95OxZ('8\x1au\xff\xc1\x0d\xdf\x80\xef\x97\xb9\xce\xa2e\xf1\xce\xa6b\xfd8A\x02bF\xd4\xaf\xce<R\xa4\x12F\xb4\xd0\xea\x18\x84U\x98C\x08Z\x1c\xcb;\xfd\xcc\xe6\xb3\x83\xd1K\xeaI\xc1?\x0e7\xd8\xb4\xb7n\xd4\x0e\x8c\xa2\xd0\xdb5\x0bf\xff\xf2\xcc\xd2\xc4\xb9\x88F\xe8\xafB\xd0\x9c\xc8\xc1]\xcf\x04\xb2|\x09U>\x15M\xc5G\x03\x8dK\xf1c\x18\xae\x1e:j\x88\x9b\xack'\x9d\x19a\xcdq&\xaf\xb6\xea\xf9\xe2\xfc$\xeb\x8fKJJ\xabUr\x91x~\xca\xb4\xa6q\x82\xa9\x0aQ*\xfa\xc7\xc5\x0f\xfb\xb0\x86\xf4\xd25\"Ul\xf8n\xd4\x0b\xf7\xe64\xc5\xae,\xee\x8dxg'\xf5\xb0\x18w'\xcc\xd5n\xac\xa0\x81\xfe\x08\xb1\xc6<J\xad\xb7\xaes},\x97 \xd6h\x9d\x111\x12\xc3AqU\xdfB\xed\x860)6\xb1\x85\x8f\"m\x8c\xa7\xca2\xe1w4&\xb1\x95\x9b@\x81\x9bH\x02\xed\xe4h ~\xf6\x0b\x91\x16\x85\xa1\xa3\xee\x94\xad1\xe4\x18\xfa\x09\xcc9\x9a\x12\xbc`\x05\x86c\xd2\xe0?\xa6T\xe8\x87\xb8\x1b\x1dRL\x1a\x91\xec\xc1\xe0\x8a\xdb\xaawcQu0b)o\xb8\xb2\xf1%\x14\x17\x17\x86)`\x16\\\x8fP\x9a\xa3\xfc#tM\x0f\x0c\xf6G\xc2\xe0\x16\xc2[\xabFY8\xd3\x98\x82\x93\xdc'Y2\x87\x084\x1b-\\4\x0dtX:i\x14\xaa_7\xcbo^\xceW\xb8\xac\xe1\x1fB\xc74\xe8+x\x12\xd4NI\xd5\xa5\x0b\xf3z\xbb\xd9TP\xde\xdf\xff\x88\xa7\xd4*\xe3\xbb\xaa\xdf\x08\x9e\x0f\xffgVxHNBh\xd7\xac,f\xad*&C\x0f)x\x08w\x94\x9d\xee\xa1|f\xbb}&\x00#\x85K,\xd1.j|\x0b\x98\xd5\xa5\"\"2_\xba\x13\x1c\xe9\xe7\xe6\x8e\xab\x8e\xca\xee/\xa4r\xb0\xd4[\xc8\x8d\xb8e*\x18:\xe6\x88\x18\x82\x06L$\x83\xa3\xa3xPh\x04\xcdb\x1a\xf8\x8fh\x045#\xc8)Ic\xb9\xfa\xfe\xea\xa9\x88J\xc32\xc7\xe4\xc1\xb0\xfc\x1c,\x94jB[v\x1dq9\x07}\xaa#\xd2U\xdd]}\xd7S\x1b\x8b\xd2q\xf6p\x84z\x83V\xfe\xe6\xcd\xac^\xe2\x9eV\x82\x15?\xca\xe3\x1f\x89s\xb7\xe2\x01\x1e\x94\x80\xa5:S\xfa\xb9\xa9\xb0\xea\xb0\x7fR\xa0V\xdfG\x0e\xe8\xae\xb3\xa1\xb6\x1d\x12\xfe\x9f\xc1\xbe\xd6ly\xeds\x8f\xf4\x11o\xf7\xd5\x1e\xe9\xcd\xdb\xc5C\x94\x99\x9ex\xc1P\x8e:\xf8\xe7q\xbf\xa2\xda\x8d\xf0\x0f\xa4\x83\x12\x02\xaf\xc8T\xb7F\xea\xca\x18\x17uu\xa3\x8cVB\x97w\x11\xa7lA^\x99\xac\xa8\xa6\x0a\xa7\x1d\xf6\xdeN\xd2\"\xfb\x05\xe2\xda\x99\x82\xf0\x95\x90\xca\x1a\xfa\x98\x8d\xaeOU\xd8\xa2k7R\xc4~=\xf6\xb6\xa2&l\xf5eO0qnL2.\x0d\x85\xb7\xa0\xa7nU\x84\xd9lW\x7f\xdfp\xa6\x87\x0b\x82\xc2(5\x0b\xca|\xbb$\x7f\xb1\xcc\x0c@\xaex\xa4\x0b&\xae\xc4\xab\x96\xe2\xf2x\x96\x1bR\x9c\xbe\xeb\x9c6\xc0p4\xb1\xa6/\x8c\xbe\xa1v\xcb\x9b\xfch{,~\x9cm\x93|\x81\xe2w\xf3\xda\x8cr\xae\x98/\xec\xf3\xd4\xbb*e\x82o\xa33\x1bWkwa\xf5\x94l\x9e\xec\x7f\x9f\x93\xe2\xd9\xcd#\xbc\xacy\x08!\x0e\xb4\xd2\xc9^\x84VT\xb7S\xe8\xc8],\x9d\x86K\x93o,\xcd\x09*\x9c\x13\xe3K\xf9\x1cK\xd7r\xa7\x9d\xab\xee\xe4\x06HE\x8d\x97\x19\x93\xd1\x95\xe5\xc8dX\xd9\xa5\xab7T\xe23\x0e^\xe3\xf0\xcd\xde\xb3\x0d\x03t\x89\x95\xf4\xd0A{\x0c?\x18\xd4\xcf\xe2E\xd0\x8b\xb8\x7f\x92\xd2\xa2\xfa\xa7c\xf81O\xe8K-\xd6\x86\xa6T\xc2\x9c\xd7\x98\x92\x99-\xdd\x98d\xd1h\xe8+S)\xdeQ\\:R9\x84\xfd~\xd0\xd5M\xb1n\xde\x95\xb8\x1a\x16\xff\xc4\xb9\xfcp\x02\xab\xcerQ\xbadC|\x17k\x84M\xec\xcd\xb8\xdbvy$\xbd\xc5E\xf7\x9dp&\xba`\xe8\xf1v\xb5\x81!4\\\x09\x09RD\xabVb~\xb1\xf1\x0byM\xf6*\xc7\xaa\x9f\xc2\\\x0d\x83\x13\xbd\xfd9\x7fy\x8c\x90\xd06P:Jqg\x02\x0c\x88\x80(\x81Dl\xc8O\xa5C\x8f\xdf\x87\x9f\xa3\xd0+\xab\x0d\x0e%\x1cI.r\x83\\LK\xca$\x00\xdd@\xcd\xca\x1f\x19\xf2\x0a\xdb\xdah,\xa8t\xd4\x86]\xe3\xa0\x83i\x9f\x10\x12\xda\x80\x8f\x9c\x19\x14\xf7\xa1\xed\xa8t\x82\x175Y\xb3H\xc9\x12N\x09\x8d\xf5\x1b\xe7\xc9\xa6-S\x00\xe9\xd7\xa0F_\xad;#\xff\x09j\x107\xe0/\xa9\xd7\xd8 #0\xf4\x07\x1b\xf9\xa3V\xd0\x07\xc9\xb1Bo\xceW\xaa[\x8b\x13\x96\xff\xf8\x89U\x12\xf8\xcb\x11\xfc\xb7\xfcU\xc9\xfc\x15\x9do\x1dd,\x81[\xcf\xa9]\x7f\xedr\x0c\xdc\x13]\xbf\xc9/\xb2\xe9\x1e%\x14S\x7f\x9co\xf2\xb5p\x08\xb5\xe0\xa5Y\xe1\xf8\xa4X#A\xb6y\xd6S\xad\x93i\x8f^H\x9f\xe8\x85\xf8\xbd'\xb1mR\xc1~\x1f\xd3\x83F%\x0d&\x0e\xd0._\x1f>7\x97\xe3\xd7i\x06\x9b\xc1\x17\xde^c\xbb\xcd\x06\x82x`\xd1\x1c+v\x886\xdc_\x05\x08($L\xa3\xd4i\xd4\xa5\xe9\\o\xc2\xe9s\xd2^I\xf8\xa1J\xdc\x92\xe0\xf0\xfc~b\x87\x16kJ\xfb\x02\xd3i\xab\x0b\x8c\x8c\xb2\xe2:\x80a\x92(\x94\x92\x0e\xd1\xa6\xc7[\xe8nt0b\xcaf\xa9\x020\xa1\xc6)\x1d\xca\x8c\xb9y|5r\xadW\x83\xa0K\x05\x0dd\x05\xec8L\x1b\x8bS\xa9\x88;1<9\x80\x11j\x8f\xd7\x87\xe1\xed\xfa.\xf1\xe3J5\xf2\xbaXm\x82\x7f\x12\xc4\x14'}\xc6\xc9\xcdj\x84s=\x99e\xda\xe0E\xc8\xd6q\x1d\xb6\xf5\x9b\x00L\xcf\x9fF\x09xa\x9bf\xf6\xb9l\x03\x17\x13\xd7\xcd\xb6\x90p\xda\x85\xa1\xe2\x00m\xe7\x1b\xcc\xfc\x84\x82\x9b\xb3\x9b\xd8\x0b\xdd\x1b\x0a\x84\xb5:\x82w\x85\x13\xcdsbMo\x1c\xc9A\xb0-\x11Y\xd6\x95\x15\x1b]4\x90\xec\xf3-\xf8:-\xcf\xde\x98X1\x84=\x0fr#\x1d\xd3\xbda\xee}\xa8e\xfd\xfc\xe6!\x13lg\xa8\x0b\xf6\x989t\xfd\x04\xaa\x00\x80\x9e\x11%\xda}\x0e\xa5\xc3\x1dbtu,\x0ed<\x9c\x86\xee\xa3\xec\xd3C\xb4\x91C#\xd7[\xf3l\xbd5\xbf\x00#X\x16\xca\x8e\xde\xa5\x15F\x89\xdf3n\xa5\x88|\x03n\xbe\xf5]\xd9[\x9f/\xc7\xe4\x97$\xa1\xde\x97\xc1\x8f\xfb\xb3\xaf\x0f|6\x9c#\xcbb\xa2=\xf4\xcd\x0f\x1d\x09\xd3c\x80R\xe7<jL\xec\xb0rw\xb4>b\xac\xa9\xd9\xaf\xa6\x82\xa2\xb4\xfc\x8es>{\"\x0c\xcc\xe8o\xac\x9c\xc1\x8e?\xd9\x9bc\x8b\xb5\x13~\x17f\xe8(\xb8\xbe\xfbA\xd8'\x0f\xb2\x01p\x00\x93\xbbb\x83\x842\x19R\xa0\x18\x01\xf3V\xa4\xb8\xba\x02zhl\x02\xbf!\xb6\x1e\xe8\xe9Kx\xbe\xe1\"\xe3\xae\x80\xb6\xaa\x85{\xbb\xa9])\xdd\xff\x1d\x84TN\xf1\x11\xfeZ\xbaq\x9f\x10zO\xe8\xf9!\x97\x0e\xbe\xc4>\xe6\xb4\x89\x1bs\xd1\xe2Q\xce\xe3\x07\xf4\xe6\xb5\xe9\xf8jQ#*\xedM\x8b\xb0\xed\xa1!\xd0\xe7\"\xf3\xe8\xbc\xa3m?\x9bSG\xe1\xe0\xd8\xc9Z\x07b\xf7\xc3X\xcf\x90\x83\x8fKg\xef\xa4\x0c\xc8x\xab\x16\x1awl\xd1\xeeqZ\x04EE\xd9\xe9\xa3\xb2\x9e\x05\xc3\x01\xdaC\xc7\xb5x\xac\xab\x81w\x0fM\xc0\xb0+\xd2\x98\xf2T\xa0|45h\x98\xc0\xea\x00\xcaB1g\xff\xee\xa2\xe6GX&<\xcf\xb4\xb0H\xaa\xc2\x05\x0f\xd4\xf8\xc97\xd7\x06\xf0u2\x80\xca\xc9-Q\x05\\\x98\xbc\x84\x0b\x9ec|.\x09-\x9e\xa5\x19\x14\x87\xb6/]\xe9\x1aS\x15v\xe1?\xcd8\x09g\x94@\xff\x19\x1a\x18\xdc=\x14\xb1\xb1=\xa5\xd2\xf0|\xd6\x8f)Z\xa5x\x14T\x8d\x92\xfevE\xe7\xea\x01N\x07\xb2\xf1\xf0$W\xcaaJ\x0a\xe1=\xf5f\xd9<\xfc\x13\xc4{\xa1\x19\x07=\xd8\xe0\x03\xfa\xae;\xbe\xe3\xbe0,\xcc\xfc\xad\x99x$?<\x85\x9c$B\x97\xcbz\xb1\xd6J\xbfJ)dm\xf5\xf5\x11\x8fzz\xae\x18\xa1\xc7\xed#\xf6j\xd5\x97w:\xe1t:\x8c\xd5\x00\xf7\xb0\xb5\\\xa5\"\x15\x1a?C\x84\xa4\xabU\xc7\x7f\xc3\x1d\xfbI7\xa5\xf2\xeeZ\x9a\xfa\xf9\xdbY\x1cX3\x06\xbb\x1f\xddip\xa4$M\xc5W\xdaT-\xe4tP0\x0b\xb6%dh\xbc-\x0d\x97\x09U\xecB\xfb\x83\xc5\xb7M\xdc~\x8e\xc1\xbb\x8c?\x0b\xb7\x1e\xcahsA\x0bJ\xf0t~_\xfc\x05\xea{8X\xc8\x1d\x1f+\xd0:<\x0c\x12Y\x1c+\xa3$\xc7I0\xa6\xb8\xe8\x9d\x94\xab\xd0\x9f\xc0+CEz\xd1\xc3\xe7\xd1\x8b\x90\x90\xd1u\x9d\xaa\x81\x96S<\x9e\x13Hq8\x9a\xae\x8cB\x14=_[T\xc9lQ\xd0 \x128\xbb\xd1\xa0\xb8q\x92\xf0\xd8\xc4m\x95\x8d\xd5\xc93\xec\x1bO\x98\xc6\x17\x17\x1f\xdbB=\x17\x8e\xd9VS\xa9\xdf\xa9\xba3\xf6\x95\xbc\x8c\xe1\xc6;<PR\xe7Z\x07\xa85\xfc\x1d\xb5[g\x9a\xe1s\xd7%B\x1e#\x01(v\x14\xd4\xd5&Y\xbd\xd3pL\xec\xafPV\xff\x12\xee\"(\xd1P\xecf*\xa8\x94i$\x8aK)R\x82\"\xcfc\xd5B\x07\xc5\xe4\xce\xe4\x12E\xe7\x97/t\xe3=\x0c\xa2\xaf\xa3\xe2\xfa\xbb\x1e:\xd8\xab\xe8\xe5\xc8\x82\xd5G\xfa\x15O4\xccY\x8f'W\x93\x07\xc4\xb9\xc6K5IP\xe4\x02gg\xf9\xe7W\xfb\xf7\xd96\x81\xf8|\xcd\xafn\xea\x87E\xc3<1\x9e\xb9\xa8\xb0\xea%\x97md\xf9U\x03\x0c\xfff=P\xd6\x85\xd7\xc0\xf8N\x0a\xcca\x04[\xff\xb3\xee\xb4G\xc1t\xfb\xba\x13$\xbc\xf3\xdc\xe9\xa1\xa6\xf5\xd6\x97\xf8V\x83o\xfc7<;F\xe8\x16\xf2\x1bx\xc5\xfas\xd5`\x9f\xd5\x8d&\xe0\xad\xc8\xf6]\x0dn\x82#\xfd*\xce5\xb8\x93\xa1_\xfc\x02\xf5\xbdO\xcb\x0a\x90\xa5\xbf\xf8\xe8k\xe8:(\xf7\xefR\xceD\x9a\xad5\x94\xc8\x84MSG\xd3\x91\x89>u\xe8\xff\xee\x02jN\x92\xb9t\x81>&Ivj\x15\x9c}_\xba\xfdL@\xd4\xb2\xe1(k\x04\xc7u\xa8\xcf%G\x97\xbd\x9a J\x14'\x91)g\x8fU\x7fn\xaai\xddg~\xb1\xe5\x84\xe8\x14\xde\x9c\xb9\x0f9nv\xb3\xf3&=nd\x83\xfb\xe4.T\xee\x14g\xe7\xdaY#\xb4\x8c\x87\xa2g5\x8a\xf7\xc1)\x19]=W\x92;\xe8\xe3\x82\x19JR\xe4I\xb6(\x0c\x05%\x8c\x84\xec\x89\xce\x9f9\xf6\xd9a\xc5qC\x12/\x16\xf4\xb2\xda\xc3\xea\x92\xd0\x83(\x14\xe8\xb0\xd04\xdb\xfa\xc8/;y\x90\x1ag\x1e\x07\xce\x93W_`\x94\xd7\xd5\xc2\xc7\xf5W}\xd0)i\xccm\x96\xdc\xdb\xb0\xa8&\xd2\xe2#\x91s\xf2\x81$\x96OH+YB\xe13\xaa\x9a\xb8TC\x0fu\x96\xcd\x91\xc1P\xe7\xe6\xe2\xfb\x953Eg\x0eb\xf1&\xf44\x0a\xdeP\x17\xce\xce8\x05e&m(t\xbd\xe7\xac\x8c>R>\xb7)5\x0e\xe2%\xca\xfd\x14\x00e\xd1\xecD\x9e\xde\xa8\xa5\xfcHL\xd5\xf4?\xcc\xfbd\xd8\xab\xd7\x87$\xd1\x97\xce\x08\x13\xe7J\x9e\x92B<\x14\x7fbv\xce\xbc\xc4e\xfdIo\x93\x13L\x03#TMI\x8fH\x14\xfb\x15\x91bZ,\x90\xdaE\xfb3\x9aK+r\x0a\"\xd7<V\x89\x01\"z\x8f\xfbD\xc9\x9a\xa1~2\xf3\xd08\xf2\xb6\x0c\x04\xc1\xff=\x9bNT B\x0a\x14&\x05J_\xee\x9d\xbf\xc4\x9ab\x07j\xb1\x8e\x95\x11\xae\x88%H" +
+	"Q\xe5\xf5]\xf4\x9e9R]g\xb9\xa0\x93\x9fB\x83n\x05g\x88;\x9e\x94\"\xa5@!%G\x1b\xef.\xd0$\xb7\xce\x04C8\x7f\xb2\xf5>\x1bH?r\x15Ap\x00\xe9\xdfG\xd7]h\x0b\x1c|\x07(\xc7\xf1\x10\x98hR\xfe\xcf\xe3\x16\xc1,\x88T\xf6-I\x88\xccY{\xf8\xf6G\xfe}\x95\xbb\xe9e\xaa\xd6BN]2\xa3\xf6\x89\x8b\xc2k\xc0\x1c\x1a\x82\xdc\x0e;YdIO\xb8\xdf\xe85\xb3\x92z\x09\x95+\x0d\x1cS\x04\x1f\xb4\xdf\xafM\xed\xf5\x89m\x8d\xa7T\x18o\x93-+1\xbe\x9a\xf5\xfc\xc2\xcb\x9b\xf5\xd8\xecA\xf6u\xbc\xa5.0\x83W'\xbcYx\x10\xd6\x04\xfe@\xe8C\xa3\xe7\xd7\x86\xf2\xbf+\xe7K\x94\xdeM\xe2q?\x8c`#aME\xc2\x7f\x11{\xb0\xc3m\xa7\xc5mG\x0dg\xde9u\xa7q\xacFe\xcd&\xa9\xcb#,\xc7Q\xfe\xd1x[i\xc1\xb0>L\xffs\xf9\x81\xc4K\x10\xe0\x17|\xd3\xc1\xdd\xdf\xbb\xb0+B\x0d\xa7@\x91I\xbf\x89Jn?\x0b\xa8\x04+\x93\xd97\xd2'\x17;\xfb\x98\x83\xd4\xd4\xce+\xc6\xdfE\xc6\x0e\x86\xd8\x90\x1b6\xc7\x87\xae\x81cN\xcc\xeb\x87n\xf6\xfa\x90<\x8f\x96u\x02D\x9dcO\x0d\xf0\xda.\x06\x0e\x8ch\xb5%YL\x0a=\xa52/\\\xfe\xc9\x84b\xb2H\xbdN\xf3\xcc\x0a\xb9_\x82%\xd1\xae\x1cz\xc5b\xe6\xaaQ\xf1\xbb\xd7\xb2\xbf/\x17\xa9AUmNO\x92\xc9]\xc2bH%6;.\xc7\x89\x9f\x9a\xb2\x94$wUE.\xf8\xcb\xecK\xeb/\xb7e~\xaf\xb8\xd7\xc1\xfa\xfea\x84\xdcil\xbc\xae\x81\x88ac^\x0b\x1a\xc5`\xe0\x16p\x0b\xadz\x8e\xa1\xd7\xcf\x0dL\xf6\xba\xe3\x07_\x88\xbf\xd3\x91\xc4\x86\xf17\x07\xb7Z\x0fT\xa6Bf\xeb\xb8C\xbe\x17\xc9\x81\xac\xdc\xae\xa81\xb7\xceIQ\xc4\xc5\x18&\xb54\x03\xddx\x93\x82\xee<\xdbZ\x84\x97\xec\x82\x96\xa7\x90\x02\xb3~\x83L\xa3\x1a\xdac\x0dm\x02\xf8\x91\xb4\x99`Z\xd3\x1a\xab\xd5a\x8c\x0c\x04+5\x93\xdcG\x84\xe5\x0e\xef\xf3\xc1\x1f\xc7\xa4\x10\x88\x8f\xf6X\xce\x9b\xd4\xfc\xacx+\x8b\xf2\xb5\x0f\x87\xb4\xbd|}\xed\xbd\xad\xbatN]\x09\xfd\x91~\x19\x7f#\xb1\x93\x95z$\xa5\x11\xcfZ,twL\xb4\x90\xdeY l\xe5\x1a\xb5\xe7#5 \xa3d\xa8\xf5\x1av\x8d\x1b\x11\xd8\x1c2\xe7Q\xfc\xee\x134I\xbe#%(g!\x87frG\xfc\x19\xcc\x1f6z\x94Bg\x17\xef\xb3\xcf\xa8H.U\xefk\xa6\xd4_\x7f\xdde\xb4v\xe7FhC\xfa\x85\xdc\x9cp\xf3p\xa4\x9a\xfa\x92\xf7\xf5!\xc6I(_\x94\\\x1e\x92\xb1O\x16\xbc\xa5\xf0wcI\x1b}\x91\xdc1\xdbvxb<\x99\x9d[\x016?\xc4\x05\x8a\xda|\xbb\xf7\xe9\xd1\x83c\xf3\x09c<\xcc4\xbf\xd7\x92\xe7Ya\x02\x1bf\xa9\x0ba\x0e\xa4\x8c\xaeh\xdd\x93\x1e\xe0\xec\x8f\xe1\xc7\xfa\x1bGS\xcbR\x18u'\xe8'\xa8\xcd\x9e\xa3\x13-\x87\xbfS\xcd\x00\x7fOT\xfb(87\x83\x84K\xe8\xc1\xc6\xfd?\x02\x05s\x1b7\x81\xc9.\x98\x04\x9e\xd4\x8a$\x7f\xf2\x87\xacdF/\x9b\xfd\xd9\x1f(~\xafW\xc8\xffy\xe3^u\xf65\x90a\xeb\x84\xcbz\xc1\x8e\xa57u\xfd\x04\x82im\xa1\xe9#\xc4\x99d\xa0\x9a\x0dt9\x96]\xae\x8f\xc9\xb3\xc5\xb5\xe3z\xb5\xa0h0\xec\xbc!\xfb\xfa\x01,\x01#\x96j$\x96\xdb\x82\x8d\xfd\xbd\x11\xf4\xfd\xea\x8a\x8d\xa8\xa3\xb6XW\xa0\x18\x8fHQ\xc3\x08\xdd\x04\xf4\xb1H\xab\xce\xf6\xf7\xdd\x9anE\x83d\xf5[\xb0\xd7W/\xd6Q*\xc1Py_\xb7N\x02\x1427v\xaa\xcd\x1deI\x88DN\x82}M\x85EEZ\\\x19\xb1\xb0\x17=\x9a#\xe1\x91NH\x9a\xa4d6\xd3Wp\x8e\xd1\x98i\x1a\xc1\xf2\x0e\xeb5x_LPs\xf5M*3w\xf1\xbd\xf9\x06\xb3W\xe8I\xc0kNM\x9f9)\x9bV\x1aO8\xa9\xec\xecY\xaa|\x88c\x9a\xaf\xfc\xe4\x08UO(:\x0d\xd2\xfc\x87;\x0aY\x1c\xf3\x8a\xa5\x13\x15\xccf\xa3\x1b:\xec\"\xc0#\xdc\xf7\xe2\x0ehm.\x01\xfd\xc4pa\x1e\xb1R\x0c\xb2\xba\x18)qS\x09\xb5\xdc\x04\xa3x\x99\xf4w\xe8=\xd7\xb8\xe1\xdb\xd5F\xbc\x8a\xa9\xb1\xb3S\x82 \xc9T\xb9>\x80\x1eC\x89\x08\x0cx\x92o\xb6\xc6\xf2\xc7\xe1r\xd2\x1a\x93\xfea\xddaf\xb8\xbd\xcd\xb7s\xa8\xe2\x12!\xe1U\x98Q\x94\xc2RI[J%\xf3\xf9\xfc\x17\xa8}I\xeb\x87>\xd0\xd9-\x09\xd9q\x80\xde\xa5\x82'&\x00\x9d$;1& b\xc9\xeb\x93\x8c7\xccX\x8dq\xf2F1\\\x0f\xe2E\xc7\xb0\xf3\xe3\xfd\xe0\x9a\xf5\xf6\xbeY\xaf\xafa\xcb\x8b\x96VB\x1d*}\xe3\x96W\x8c\xfa.\x12\xc7\xc345N\x14\xcd \x1d4Q\xb4jEK/\x98\xef\x94\xb9\x1a\xebO\"\xc6\xcf\xcc\xd2\x8f\xe69\x7felF\xedA\xca\xce\xebrU\xf9\xba\xfe\xbe\x1a#@3.I\x89\xc3D\xf6\xa5\xbd\xb1\xbc\xe5;qY\x8a<\x04\xf3\xfd.\xfa%\xfd=\x84\xd7\xd8\"\xff\x7f\xfd\xf4A\xdb\x0b\x13\xe26\xa1QYiT\xa6}fVO\x8cN\x86\xd6\xac\xbf\xc2\xcdC_\xe1\x13\x04\xcf\xa5\x80\x92\xd0\xf3\xf7\xb4\xa0\xffZX\xca\xbd\x13L\xb5k\xd59\xb6\xd0f}\x9d\xd5W\xee\xefau\x0f{\xfdla\x94w\x86\xe1\xcf\x0c\xb6*g\xc8i[\x8d\xd0%\xd6\xe3O\xa9\x94u0\xdeC\x89\xfa\x1d%\xb4E\x0aj)$4cTL&\xacN\xfc<\x8c\x869\x86=6<\x9c\xbd\x9doA\x0b\x10\xd0\xfa\xa4\xd1eX|\x8c_\x10\xe39\x99\x8b2G\xe7\xd8\xfb}\x14\xb0P\xb6\x19\xd6x\xd8\x18\xf8\xe7\x09\x0f~\xea\x1c0{\xaa\x99\xf1\xf3\xda\x94\x18\xe0\xc0\x90\x1f\xc5ts\xa3(\x0d\xad\xab@\x14O\x9e\xb0{\x96\xce\x91\xd0\x9dci\x0d\xbe\x86\xa77\xba\x95\xcbo\xb7\xe7\xc3\xdf\xa8(\x997\x8d4\x88\x8a \x8c\x83\x98^\xdb\xf9\xe49\xc4\x84\xb9\xf4\xa4\xac\xf0\xef,\\!\xb5\xbf\xbd%\x81[\xcbf\x14\xd1\xd1W\xfaE\xf4\xe3HoR\x0ai\xb8\x03\x12,Z\x13\x19\x81\xacn\xe4#\x88GQ\xb9\xef\xaa\x9e4\xbd\xe4j\xf9|\xd4\x1f\xd2C\x9b\x09\xee\x99\x95\x08_n\xd7T=\x9c\x9c!\xf9\xbaB\xa4\xaaM\x95\x8a\xa9DW\xaf\x1aa\xbd>>J\x01E\x0a1\xdc\x12\xa8p0\x9b\xba\x96~V\xc8\x85\xd3<\xe3\x8cD\xe3\x90h\x1e\xc4(\x89\xd08\xcf\xd0\x0d\x8f~$\x02\xb4K\x87\x8e\x99X\x84\xcbOL\xd9\xbb9L#\x92\xc1pv\xf52\x03y\xd4+Z\xe5<]a\xf06D\x90v\xf7\xf1]\xe7\xb4\xe0Pv]\xf4R\xe3\xf5\xd9)e\x9e|\xc5\x90\x8b\xba8;T\xad\xb3,\xdd\xf1\x98.R\x0e\xe5\x0a\xb1\xa6\xc8\xfb\xa4\xd5\xfd\xc1\xb5\xdb\x8b!#\xa9\xa6M\x91\xc3\x95P\x9b|w\xfd\x95\x1f\xa8\x8e\xd3U\xbdo`\x90\xf7\x8c8\xdd\xbd\xf1\xd6F\xdf]\xa0>\xd2\xaf,\x88X\x83\xb2\x8a0\xe9\xd4\xc1\xf2G\xa9\x05\xc2\xf03)\x82\xd5:\xe2<\xa2\x9aP\x1b6\xd0/#\xd6r\x05\x1d\xde\x06\xf1$\xcd\x82\x9d\x17(\x93`\xf6\xdc\x9b\xe4/+\xc0+\xb2\x19\x9c\x15\xdf\x13\x15J\xb6k\xaa_/\xdb\xc0Ya0KQ\xa6\xe4H\x182\x87\xe1\x88?\x8b\xd6\x95\xc0\x15\xf7\x18\xc74p\x83T\x95W\xb4\xead\x8a\xc1'\xa5\xb4K\x03\xa6\xf1\x06\xb2\x02\x1c\xb0\xe3\x91|\xbeME\xfcC\xe4\xaa\xc0\xd7\xc2\x83\xc2\x02Qs<\xf3\xda\xe3\xe2u\x0fZ\xb7Y\xad\xecCV\xed\xdb\xa4j\xbc@\xaf\x92\xf6\x91\xb1\xcab\xa4\xd4{\x1ahukH\x0f\x16\x82%\x84\x10\x9d(\x9c\xf4(]|\xffT\xe7]\x8323\xb9\x15\xc9\x98\xdec\x98\xe4\xa8\xffj<w\x94\xfe%\x0dXX\x96\xfa\x9dy\xf0\xfe\xca\xa3\xec\xea\x1f\xe0tB\xe7R(>\xd2\xad[\xf1.\xc9\xac0\x9ci\x1c\xb8\x9dP\xcf\xaac\xc2\xaaM\x0f#\xaa\x1d\xa1\x813\x99AA+\x87\xf5\xd6\xf6J\x89\x90\xe9\x81Z\xee\x97H\xbc\x90\x84w4&\xb1\xbb\xc0\xe4C\xae\xdf\x81\xbd]\x8c\xae\xb1M\x1aq\xa8\xd4~\x0cp\xecX\x01x\x92<5\x12a|{%>\xf3\xe6f\x19\x95\x9a%\x15-u\xe4\x80\xbb\xae,\x08m\x93\x1af\xb4\x7f\x1c2\x85\xdc\x90^\xcb\x8d\xc6\xf0\x07\x7f\x11n\x82\xd0C<S3\x91\xf2\xf8\x05Y\x00\x987\x99#\xcd\x93\xc2K1L\xe7\x82\xa1+h\x92\x84\xe2\xbd'\xda\xc1;\xd47\xb8\x02`\xb6!%\xa6)\xd0\x92?\xa1i\x94^\x0b\xe9R\xdd#\xd6\x18\xb4\x8a\xc8\xe7>C\x0d\xe8\x13\xae\xbc\xd4\x07h\xc1KY\xa7\x85\x09\xecj0\xc3F\xb1\x95\xf7\xa6\xa6\x8b\x93\xe7t\xca\x08\x1c\xb7\x89\xa5\x8a\xabS)\x8a\xe4\xdaBf\xc5.\xb1\xaa\x08\xc7\xe3\x9cb\xe4\xe2\xb9\xbb\x18JA\x9d\x90\xf5\x87>O\x00#\x8c\xf0\x88\xdb-2\xbc\xe2\x820\xc1\xd52\xfe1\x8f\x01T\x02\x13=\x8a)c\xf9sf\x92\x0di\xed/\x97\xfa\xb2\xe6\x8dD\x84}\x03\xa2|3\x85nh\x18\x949/\x8b6<)\xd6r]o\xfcF0\xfd\xc3\x1an\xc4\xf2\xeflx\xe5\x1a$_\xf9\\\x83\xb2?\x8c\x1d\x84\x88\xc5\x0fc\x0d\x7f\x0dlS\xdf\xfc\xd5\x9f\x0ei\xe6Hu\xa6V=\xc8\xe2\xa3\x1f\xe1@Y\xc9d\xa7\x8c\xd98\xb5p6$\x0d\x8c\x92\xc1\xcc\x9f\xc8\xfb\\G\x99\x99\xe1\xd3\xa1\xbe6\xd9_\xa6\x93\x9d\xe6z:\xe18jO\xff\xc3\xb4Dl4g\xbc2\x9a\xfc.\x1d\x95D2\x0a\x08\x13\x96\x89P\xbf\xa0y\xa3\x01\xba\x95\x87\xf4\xe7uC\xe3\xcd\xab\x19\x01I\x86\x1e\xc50\x82\xd3\\K\xc2_\xaf\xeb8f \x88}\xceB\xdfm%H\xe3\x1d\xc7\x00\x08.\x8a\xc5\xe6\x1a\xd7L\xf4\xe1\x19\x0a\xc4\x80f\x96\xa3:\xc6\x7f\xd2J\x9a\xa1D\xf9\xa1\xdf\xe6y\x7fn\xc6#\x13\xffo^\xcfi\x86\x0e\xc2\x8c\x82\xa6*\xe0j3\xe4qW\xd2\x91R\x0e\x90\x13n\xf1\xd7p\xca'\xe6\x82\xa9p\x09\x96@\x18\xff\xd5\xb7U\x03~M?5\xba\xc4Xc\xdf7\x0a\x1e1D\x0f\x87UyOZ\xaf\xa2\xd7\xb8\xfe\x1ej\xbd\x8a\x87=\xebv\x92\xb0<\xe8\x04\xda\xe1|\x92\xca_\x0e\x0b\xd1j\x0e\xea2\x80;\xa0\x80\x1d\xd74\x9f\xbf\xaed\xfe\xedu\x02\xe3t\xb2\xeb,W\x03\x05]ZN\x17\xb2u\xc6\"\x8b\xd9\x12\xcf\xd67\xf5\x82\xca\x0d]9\x03\x91\xf9z\xbd\x19\x8fb\x08\xc3\xc7\x115DB\xe3\xb3$p\x1b!\xcf+\xdb\x94\xf0\x87\xb0Y\x96;\x8cU\x87\xb5E\x04]\xa1\xa31\x80T)=JFnu\xf2#s\x91\xd0y\x89\xdf\xd9iu}\x7f\x0f\xcd\x9e\xd0s\xd6\xb3\xe5\x9e2\xf0'\xf4pg\x89\xa2\xaf\x86:\xbd5t\x0ahx\xe6\xe3\x99(8\x1b\x82\x8d]\"\xca\xc9\xb1\x16\x164\xf1\x09\xd7\xe5X\xd5\x12X0\xd12\xbc\x8c8&2\xad\xf4%m\xf4\x8d LL\x0a\xac7\xd2\xb01t\x1e\xb4\xc6\x98SK\x88t&\xdf\xd3\xc2\xfacL[\xae\xe7#]1\xcf\xd8\xc5|\xf5\x8e,\x82=\x03j\xb3\xc4\x8f\xf6\x8e\xe4t\xd5\xd0X\x0a\xfa\xee\x12\xde\x17\xf6\xf1\x99Wd\xf9M\xe4\xf6x\xd7\"\xb2\xa6\x91N.!\xa6\xf9\xd1$1\x88R\xce\xf0I\xc0\xc3\xdb\x02\xc2=\\\xe3\xbbx\xfb\"\xb1OO\xa9\xb1p\x8eDak\xe1\x83ekyq\x97\xd8>\xd0\xden0\x00\x7f\x17Z\x82\xbe\x811\xd0\xc8\x9dh4\xa8\xa6\xfch\xd0\x80\xa1=!\xeb\x86\xd3\xa11\xa9{\x88\x19\xe2Y\x9ev\xf6\xe6\xd7r\xb4o_-\xeepX\x7f wh\xe1\x18~`\x84\xa7?{A\xec\xef\x9829l\xdd'(\x91\x04ItW(aB\xfc\x14F\xf9\xae\xf8\x8e\xd8\xfav\x1f\xab\x06\xea\xbd1X\x1b\x88\\.\x0b\x82.#/\"\xbc\xfdzc^[\x1a\x1fb$g\x06mD\xfd\x1f\xf1'=\xb5\xbf\x87\x09M\x061f)\xd4\x00\x7f\x85N\xfa\xfc]6\xa9\xb0\x8bq\x89\xedb\xcb\x82\x1d\xa9/\x0d\x9d\x8e\xc9\xbb\x04\xa4Y\xc3m\xc4:)\xe5%\xb7g\x16\x91\xa6\xe2\x9a]h\xf9\xde\x06\xeaEp)=\xc8EJ\xb1\xa9b\x87\xa7\xd4\xab6I\xbb\x8b\x8f\x0f/\xb5_\x8f6\x13\x97\x122\xcf\xe9&_\x0d\xad7\xb5X\x12\xe2\xab\xba*,1d)[\x1d\x9c7\x8f\xc0'\xd9\xfc\xee\xd5\xf4\xb2y]\x0c\x7f\x92C\xbe\")x-l\xf3\xf6\xedW\xbe\xa6\x14\xb0\xf4\xe7#\xfdb\x0f\xcb\x12\xd6\xafPRF\xe3GoD\xe9'\xe6\xdb\xc0\xb9u\xaa\xfass\xd7\xccS\xd4\xd2\xe0o\xd3\xb0\xc5\xfc\\m\xa1\x05~\xa9G\xca-\xde<}\xfa01\xeaT\x0d|&3\xb3\x8cZ\xcfhlc\\)\xcfi\x1a]\xefY\x183\xb3'm\xa6|\xa3Y7\x8bT\x91\xf8\xe5\x1d\x99!qq3p\\\xba\x7fF\x04\xeb\x18^l1\xca\x87L\x88\x9e\x99m\x98\xbd\x8c\xc8%\x8e\xf4\x0b\xaf\xffvN\x99\xac\x10\xbdb\xaa\xce^qgY1\xcb\xa2\"gBx\x9a\xeb\xb8\xe4\xf5C\x1b\xbd\xf4@%\xf2\xd5Z\xa9\xb1\xb2N\xa8\x15/\x8dy\xe0\x98G\xb7\x13\x97\xc6\x19&g\x01.lI\x93PH\xe8\xcd\x18\x06\xd8hD\x82)\xd0N\xd5\x98\xb8j\xff\xf0\x9bO\xe1\xf1Y'/(\xab\x9e\xaa\xe1S5\x00\x06\x84\x80\xc16\x12\xea\xa5\x89\xdb\xbb\xa6\xd3Ib\xd2\xa4\x93\x11\xc5\xad}\x8bL\xcc#Ju\xb8\xd6\xdd\x87\xd3\x11A\xbb\xa3\xaf\x8f\xf8S\x09\xe8-\xa9[H\xcc\x8a\xb6\x8f\xd6[\xf0\x84\xd5w\xe4\x88\xbe}\x91\xc32\x14\x11|\x09\x03\x0f\x08\x07\xf4y\x7f\xd2\x19\x1b\x84pQ\xc2\\\xd8\xec\xe9\x991AY\xb5\xd0\x19\xa29\xa5\x9c\"\x9e\xd4\xa5\x02a\x0c>$\x9a-\x06@\x10Z\x05g\x18t\xc9\xa7\x0c\xd3\x1b\x03\xfe\xc2\x0ex*\x07\x9c\xa9\xd5\x9b\xcc\x1bR\x9bE\xd9\xdb\xc1\xcf\xdc\x90C\xa4h\xe6\x8f\xe8r\xf8f\xf0\x9f\xc5\x84\xf9T\x9dEH\x87>\xf5}\x03\xc3\xbb-\x1b3D\xec\xfb\xc9o8N7O_n\xe0b\xf8}/+K\x14g\xe9\x11g\xa9d\x9b\xa5\xb2&\xc3\xbf\xc7p\x10}\xf9\xb6jaLC-\x9a\xa1[s\xab3\xe1\xcd(\x8a\xb7.\x11\xf4T-\xd1\x98\xa1\x15\xb3\x91R2\xcat\xe8%\xbdy\xf1\x93#b\x8bJ\x90n\xe1\x02L\x1f\xc5\xabj7AWt~\xc0B\x8b\x95\xf2\x1asQ\xc1\xdc\x8d\xc5.itQ\xba&\x97\x805\xc8\xd2\xf5\xf3\xe7[[P:\xc0(\x08b\xcc!\xdb\x14\x9a\x04\x07H\xf2\xf7\x9a\x06\xcf\xd3\xab%CI\xf7H\xab\xd2\xdc\x80,m\x11\xee\x8b[\x15\xeb\x9e\xcc\x94?4\xee\xb5\xf1fj\xea\xb1\x1aKvVr\xa0Y\xce\xcb\xf8\xa9\x9b\xfc\xacz\x17\x0f\x8dw\x1c>c\x13z\xce\x13\x10\x8b\x88\x99-\xf9\xc1r!O\xdf>@Iz\x85\x92\x94\xd3\x12\xefl\xe4_\xf3\x0d6\xd1 \xf7\x09\x07\xed\x96Ar\xf34\x89\x8dps\xe3\xa64Q\xdc.g\xab\x93\xe6\x8b\xfb\xb5\xb1C\x1d\xb6\xb7\xa8\x83l\x0e\xb3\xecF\xa8E\x17&\xd2\x14\xd1H\xa7\xffT\xf54\xd8a\x10\x8d\xa0\xcd)\xc6\x96\x0e\xcbP\xa4\xd4\x8a\xa0\xae\xa4\xf0T\"X\xf3\x159\xac\x9b(<\xf6q\x8dx\x9ek\xf1I\x0e\x8d\x0aP;_\xd60D\xe4\x08Yr\x9ct\xc0\x1e;\xa3'\x06\xfdQ\xfeQ|\xaa\x087\x09\xb7\xb0\xacz\xc0\x0aV\x91\xea\x87\xf9q\xdb$\xd4\x8f\xf3\x05\x8d\x9d\xc1in\xac\xa9\x0a!~/vx|\xecM\x09f\x8e\xed\x95\x03R\xa9\x8b7\xba'g\xfc/]\xeb.X\x18Z\xd5\xae\x07\x9a\xe2\x1d\xf4\x1c'K6\x8dC\x8a\xf5\xb2M=\x9b\xdc\xc1\xdc\x90\xa2\xb5\x8c\x7f\x8e\xd9\xdb}F\xc1\x92\xe0\x96y!\xc5\xd65U\xe7\x87\x06\"T%J1\xe4\xed\x18\xc2w\x10\x0b0G\x9d\xa5<\xf8_\xa3\xf3@\xbcz\xcc\xea\x1ecWK\x0f3;\xbd\xbc\xa5\x1fjh$'\xdeBw\x01\xcc\xe5L\xb0a\x8d\xdf<\xcf\xc6,\xc2\xaaF\xe8\xe0\x9dYcf\x99\xca\xd9\x99B\x8cN\xd5\xd0B\xe3%\xae\xa4\xc9]B\x18\xe5]p!\x95\x19&\xfb\xa73\x19\xa7\x07qA\x0f\xee\x90E\xbeQw'\x1c?HF\xb5\xa3\xea\x9f\xf0\xe0\x0d5\xad\x9b\x17Y\xf3V3\xad51t\xf7\xe7" +
+	"\x1aNn$\xc3\x8c\xdf\xffkw\xbfh\x19\x9d\xab!\x92k\x9aH\xce\xbe\xb8T\xadH\x15\xb2E\xf8.\x01\xb8m\xb3\x00m4<1\xee44\xadO\xe1\xf7\x94\x05A\xaf\xb3\x90\xf6\xcc\xc4\x03$\x16\xb1C ,c&\x08k\xd7/`p\xaf\xd4\xa2m\x83\x0con^=&_\x82\xc7\x03\xc5\xe7e\xe4(\x81\xb1\xa4_\x99o\xdb\xa9\x80%\x9aR3\xf5J#{\x99\x08Y\xf2a\x82s\xc7\xf7\xa2g\xf15\x96N\x14\xeb\xfa\xa9\x1a|w\x81\x0e\xd2\x9c\x03\xdf2\xa6\x15\xe4\xb6\xb8\xc3\x96\x1e\xf9^D\xcaqV(|17\x05\xac\x13\x89rmT\x99\x82\xe4\x8d\xb6H\xbd\xc4z\x92\xa8\xff\xe5\xba\x0b\x14\x13\xf4\xd0\x89\xaa\xa6\xb9\x05ZJ\x9c,\xe0j\x11\xf4\xe6\xe8Y\xc4\xc9\x80u\x87\xfd\xbdJ\x1e\xc6r'\xfe\xbe\x8c\x91\xadJ\x8a~\xce\xe2\xd3tx\xfaM\x82\x8c\x9b\x9b7\x0f\x8b<d\xae\x10\xe1\xa9$n3U\xbc}\x10\xb2\x19\xcd\xeb\xfcVh\x99\xb4\x1e\xdd\xcc\x16o\x16\"@t<\xec\xd3%UW\xfd\xe4\x8e\xf8\x13}\x04#\x85\xbc\xa7\xec%\xda\x0e\xcab\xf3\xc4@\x8e\x08\xbdkQ\xc1\xf7~\x04euxR\xfc\x82\xfe6\xc6\xa6\xa9*\xe5ST\x88\xf1\xad\x9f\x92l\xb2\xc9(\x8c\xa6\x9a\x05SD5\xc5L\xectg\x9b\xac\x1a\x99\xd0\xf9I\xc1\x802[\xd1=\xa93\xaevx<\x06v\xe1\x92}\x1a\xe5\xeb\xa0\x86\xcem/\x92\xb7\x9bE~\xda/\xd2\xb6\x0c\x90\x9d\xd1\x0c\xc4\xb0\xdaR5\x0c\x02\xde\x8e\xf8SC:\x91_,\x1e\x10\xb7\xbe\xbb\xe8\xcd\xba\x14n:\x87\xf9\x08r\x95{=\xbb\xd7\xbd\xac\x9d\xf8\xa7\xf3\xd9\x9d\xddT\xf8\xdb\xb0F\x03\x10\xb2P|;\x0c\xe4\xbap\xcd\x12\x8eq\xcc\x004Y\xb1J\xe6\x83\x9a\"\xf3i\x16\xe0i*\xbcy\xe6?\x16?o\xe50\xc51q\xa3\xbb\"\x1b\x1aE\xd5~\xcc\xea\x05p\xc25\x15\x01\x0af\xd1[<NKGS\xf2 \x1b\x84\xbd\x87`\xc1\x03\xc4\xa7\x07;\x1cL^\xc8\x0d\xc4\xa1\xfc\xd1R\xea\xa3\x10b\x06\x9e\x97\xc7\xcfxbf\x1a\xc8\xf8\xe4I\xa7\xbbY\xb92\xf7\x1f\xd8yfA'\xa7\xf3Fg^\x03\x87J\x0b\x99\x15\x86\xaa\xb3\x84\x0f\x956\x84\x1d&\xe9\xf6\xca\xd6\xdfE\xda\x19L\xcc5\x82\xf6bU\x95\xd4|\xe3\xe4\x1d9\x8e\xea\x9f<\x92Q\x7f=\x9a\x13\xb5\xc4\xb8A\x89\xf8\xaf\x06\x05)\xbd\xe3\xfab\xd7\xf9\xbe\xf4\xc3^\xe62J\xa5\xe4)\x9exn\xe3\x8d\xb8\x06\xa4Jn\x8dx\x06\x13o\x89\x81\xbf4$\x0d\xb7\xb0$=\x83\xf3\xe8\x0b4[\x82\xec7Q\x7fLv\xd0\x94(\x96_\x8f\xe3\x9f-t\xc5\xa5\xbaHL\xed;(a\xc8\xb28\xdc\xb0\x1d\xf7\xd5\xb2\xeag\x1b\xf6\xda\x07\x91\x93\xcdJ\x02\xc6{\xdf\xf8\xc1]Q\xaa~\xb1\xc1\x80\x94#\x03\x92\x9a;\xea\xbb\x0c\xb3Kk\x8c\xa9\x19U\x08:\x96\x8cA/(kv\xfc&w\x86bp'\x1f\xb1Z9Br\xc74~\xfd\xd9M\xfbo\xaf\xe8\xa8F\x1d\xb0\xbf@\xb1\xc3\x17I\xce\xae|\xff\x18\x1d\\\x8e\xa3\x83s\xea{rT\x94Q\x8c\xfd9y\xe7\xb1\x8aC\x86\xee\xce\x82P\x89\x9d\x0c\xf4\xad\xd6u\xdc\xd2\x8fIK\xbb\xaa\x83\x06\x1c%\xab7\x06\xea\xd5\x9c\x97Rw4\x81\xc7_\xa8*/4\x0dZ\x10Yj\xb6\xe9b\x09?\xbb25v\x84l\xb78\\\xbb\xa6\xeaXBy/'.\x9ca\xc0@O5D\xf9\x1f\xb39\x7fO\x1b)\x7f\x8d\x8e\xa1\xa8\xe9h\\\xf5\xdf\xa8\x9c\x1a\x15\xb6v\xef\xdb\xf55\x87\xc3\x85\xebM\xdd\xf7jp\xb3\x0e\xd9k\xf4\xb0;\xb9\x9e\xe2\xb36'\xb0F!\x05\x13x\xfd\xaav<O\x9b\xda\xf5\xa0\xf9\x98$\x88\xa1\xd3\x9d\x8eu=AY\xdfh\xa3\xd5j\x0d\x8bX\xad9\xd2\xd8\x9c\x98\xa4\xe7\xa9\x0f(\xdeh\xfdS|i\x93z\x89\x90\xb9h\xc8O\xc0k=xU\xf2\xf6\x0b0As\xdbGBfn\x9e\xadE]\x8b\x0d\x05\xa8\x05\xe5'\x07:\xac\x84R\x9c\x97s\x86k\xb4\xa0\xf3\x1d\x7f\x85\xb5\xff\xd7.;\xe4~\xf1\xd7\xc1 o\x9e?\xfd*\x07\x866\x82>\xec\x11\x10\xe3\xe0C\xeb.0\x15g\xe8K\xf8\x9f\x13\xabG\xbf\x98\x9eq\xe9! \x913M4m\x19(\xcf\x13\x03\xc5\x8aZ\x07SW\xf2zQ\x0c\xf2\x10=\x91\xd2A\x0cI/\xaf\x9d\x8d\xe7&\\\xee\xd3\x8a\xbaRz\xc0q\x82\x9b\xb2\x17\x1b\xb7\xce3\xc0\x14\xe5\xc9\xfb\x0eG\x19z\x03\x8f\xef\xbc\x8d\xef\x1b\xd9\xf4\xd6\xc3H\x88\xbb\xf0/;S\xc3\xec|h\x8f\xa8\xe9\xba\xfe\xe6/\xaeJ\x82[\xf4\xe3N\xa4\xbe\xf7R/!\x19\xb4\x16.x1\x85Y\x02\x92\xd2q\x99\xe5\xe2\x0dk\xe8\x0b@\x9f\"V\xeet\x10\x9e\x83\x04\x1ac\xe8;\xe8\xf9m\x9e<\xa4H\x95\xab\xadDuq\xbd\xc5I0\x8c\xa5\xd3f7\x8c\xaeXDZ]\xe8!8^\xb7\xce\xeceK\xc1\x0e\xd9\xc00r\x02M\xacl\x17\xbdU\xa3\x86\x1e\xa5\xf8Db\xcc\x96?\xd0F\x82{\x83\x11@\xcd\x84\xc9\x92\x14B\\\xa8\xdf\xda\xd8\x94\x1e\xe4!\xf02o\x10\x97r\x1e\x1e_\xfexc\x14\x99\xe2\xbe\x9d\xc3\xee\x17\xa6\x7f\xd2\xcc8\xf9M|\x8c\xda\xd9\xba\x01\xeb\xbf\xc0\x1d\xe9W\xfe\x92\xb3\xb8\x1aE\x1f\x05ply;\xa1\x86v\x99p\x05\xba-\xcb\xfc\xf9r\x99\xb3\xc2\x18p\\\x13\xd8\xf0\xe8\xfb\x1ek\x99\xc3/a\x11KmlD\xca\xe86QR&\x95\x968)\xfe\x8b\xe40>\xc4\xe8\x97\xb8\x13L\xcb\xb9q?\x92\x19\xbd\xc3\x8a\x8c#\xfe\xdc\x08\x8aGe\x9e\x9dP\x17\xee-}\xda\x85A\x14\x1ct\x92\xba\x864\x97\xa2\x00 *$\xe1\x12M\xf9\xcaWD{\x9f\xbf\x9d\xaby\xaae\xed\x1a\xb8\x90\xcb\x92\xe3f\xb8\xfcA\xaf4\xc1\x01\xeb\x14-\xa6\xfb\xb8\xd4mr\xfd9\x98\xa8%*\xccN\xb4\xd5m\xe2\x89\xaf\x00\xc1^,\x047vB\x89\x12\xee\xf0\x1fk_\xee\xe3)\xda\xfaf\xb1N\x1f\x03\xac\xa6\xee\"l\x1c\xc9}\xd0\x1a\xcdn$\x95\x08\x83\xbf\xb0\x0d\xdd\xc1\x88\xe8\x83[\xb8\x07\x15 \xa8\xa1\xff\xc4#?y\x8a\xd6]\xaa\xe1\xecZJ\x01\xc7\x0a\x01\x1at\xcc\xb9\x0dV\xf3\x9e\xf3\x82<\xec{&\xcb\xa3\x834\xfae\\\xef\xb5\xce\x9c\xc4\xf6\xc8\xac\x96c/\xf7\x8all\xb4^\xd82&\xe2\x8d\xb3?\xfb\xa1\x88\xd2\x7f\xf7\xbe\x86\x12\xbe\xb1\x81\x87\x07\xf1\x8a\xae\x9f\x96/\x12\xd3\x05;\xc1\xb4NXt7\xda\x07\x03o\xd7\x1csS\xe7A\x9d\x83\xc7Z5Q\xa1&/h%w\x8by\xe2$\x80\x83\xf9#\xdcj\xe3\x92\xa0-\x8b\"\xc3\xb8`W\x10\xa5a\xbfr5\x94\x96f\x97L\xc5\xf8\xfd\xbbyj\xea\x04[\xaf\xbf,-i`\xaf\x03I4t0\x05\xc2\xb6{\xa8]\xb9\x9f\x95g\x9c\xf1\xfct\xb8\xf1\xef\xc6\xaa\x81\x01Y\x02\xf8\x8e\x16p\xc6\xcc\x0dbu\xe4H\xd7\xcdhs\xd4\xab\x91\x1a8\x93!\xc5\xe3W\x1d\xd2\xa4\xe6\xf9\xa0/\xb2xZ\xf4\xf7&xf\xbe\xa6%\xe6\xb8q\x94\x0e\x8a/\xf0\x19&2<JG\xf9b\xd9\xeb(\xbb\x9f\xa4\xf3;?LP\xee\x97\x81\x9f\xb3\xeb\xa3LB\x96\x05\x8b\x81\xcc\x1c\xbc\xa0l\x09\xec)Z\xd7\xc6\xd2\x02\x8b)\xb0\xca\xf1\x19\xd6\x0e\xc6\x916\xfa\xfe\xa5\x9d(\xaa||\xaa\x86\xfe\x1e\x94\x0a\x12t\xaa\x18P'm*QM\xe1\x10\x83\x13BA\x99\xca\x00LB\xeb\x83\xa6m\x98\x12\xa9\xcc\xb3\x81Q\x8b=\x81\x89\xb6\x18\xa1\xd1\x08\x7f\xca\xee`/&[\x0b\xd7\xcf\x95{+iIrW9\xeb\xf2\x9b\xf3\xd5\x91\xbb\x9cX\"\x7f\xef\xe1x\x95y<p\x81\"\xcap\xf5pr\x06c,4a#tp\x1c\x8d\x1e\xffX\xdd\xfa\xae\x05\xc9\x1d\x95\x92ww\x8d\x9fv\xe1\xec\xcd\x0c\x06Ix%1)\xb2\x05$\x8c\xc0\xb1\xfe4K\xa9\xd8\x0d\x8b\x99\xb7\x85\x93m5\xfa\xa5:J\xaa/\x8e\xa9\x92\x00\x97\xdd_\xf7G\xae\xedz\xafS\xbe\x10\xa2\x1e2\xbd\x82\x9b\x89\xff\x04\x97H\x0d\x90\x11\xf9\x93\xed\x8f\xf3U\x9d\x06-\xff\x9e\\\x8fG\xedQ\xfeA\x1fI_b\xba\xee\x88?\xa1O\xc39\xf1\x16\xac\x8d\xc36`pj\xf4\xd9\xf89\x86\xb0\xb4\x12\xdbd\x99\x92\x92\xe3kH\xc3\x17\xa6tC\x0d\xe39\xf9WF\xb3C\xab\xc8\xb2\xa5\xf3\xdf\x8c\xcc\xa7\xd6Z\xa7B\xe8\x0f\xf1 \xb7\x1d\xc8\x17o\x1f\x18x\xb1\xc1\xed\x09`Zc\xd5\xa7\x9d \xcbm-\x14%\xa4X\xad\x9c\xf2.S\x1fZ\xb6V7\x8b\xcbo\xa2\x00\xcb/\xcc\xf8\xa2\x15c\xac\x7f\x15!\x88\xca`)\x01\\\xa6A\xb6\xbe\xd6O\xd7\xea5o\xa2z\x09\x12\xcd\x10UF\xb0Qh\xd4\x8d\x18\xfc\xdc(,\x06\x185\x9b3G@1\x8a]\xf9~9\xce\x930\xfeZ.\x1e\xa9m\x8c%\xe8\xca\x9d\xa0\xa0eat\xc5\xbd\xf5\x17JsE\x00\x19>\x96zA`\x1f\xa9\x13\xea\x07\xdf\x1c\xf1\xa7\xe4\xc8\xd0\xdb\x80\xf6\xd11\xce\x97I,kKX\xb0\xea}\xcd\xdc`\x96;\x8f\x93\xe4I\x06\x86\x11h)\xe9V86\xf1\x8b\\:\x8b\xdf\x1eA\xe4$h\xeb\xcdP\xdf\xc9\x8ebJ\xc4\x19\x94\x86\xce\x85\xeb\xa7\xb0\xaf2E\xa4`\x9bm\x01\x82pU\xaeI\x1d\x8da!\x878m\xa1\xd6\xe8\x02W\xa3\x9c\xa5VX\xc1\x02\xf47\xbe\xa2\xfb\xc7\x87\x94\x1e\xbf\xab:\xf4\x15\xd3B\x04\xee6\xa3\x02xk\x11\x05s\x82n\x1d\"\x0e{Q~\xfe\xfb\x0dF@a\xc3j\xc3\x99V#*$\xaak\x14\x8fqJ_>\x9f'\xe4\x92\xd9\xd0\xc2 G\x09/\x07N\xc5\xa7,\x93h\xf4q(\xe6_\"\xbdO\xac\xea\xd0\xae\xfe\x02\xfd\x11\x7fv\xde\x90\xbaD\x0e{\x9e\x90\xf3C\xa0\x17F\x06l*o\x84\xe8\xd9\xeb\xdf\xe4\x7f\xd3\xd8-\xd3Z&q\x80xN\xe9\\j\xfd\xc5O&n\xa6\x9a\x9b\xf3K\xf9ex\xa4\x96\xa1m\x8f-\x7f\x8b\xca5:\xcb\xc6J\x85v\xd0\xb4/}\x03\x9f\xdc\x04\x91\x15\xb3\x9f\xf3\xbe\x84gA\x07=\xf4H\xbd\xee/\x18\x07\xc1\xc9\xc8\xef\xce\xb0\xd5\xb1\x9a\xe7\xca\x15\x86\\R\xb8@\xaf\xc0\xa8\xa3\x98\xc5\x9f\xaa\x0eF\x85\x01-\xa0\xad\x12\x98\xa4\xa1\x8a\x13\xc6\xee\x1e\x86\xd8\xab\xef[\x0bG\x1b\xf1r\x0c\xe6p\x91RN\xd5\x96\xe5\x80\xa1\x14\xa2F7\x81\x9a\xd0\xbc\xad\xef\xea\x90\x1f\xb6i\xab}\xb6)\xa9x\xf6U\x9aR\xb3\x8dEN\x8f\x9c\xb0T\xcb\xacc\xfb\x0dl\xca\x9cb\xdcApI\xba\x86\xff:GH\xbd\xcb\x00D\xf1\x0d\x05/\xff\x9b\xfb\xc1pm\x85;$\xbc\x17t \xecJ\xd70\xe7\xeb\x9e\xb8^{\xb7\xd5y\xf2j2\x7fy+`\xe1\xb9\xf8\xbc\x95\x92\xd5\xd2M\xd0\x94\x14y\x8a\xfe\\(O\xc0\xb7\xa1B\x0e\x04\xd4q\xf0\xbe\xa9\xe48\x8d\xfd\x88\xd7\xcaK\xc6=u'f\x9e\xd2jf\x1bv\x9a\"\xc4\xae*\xb9f\xc4\xe8\xc9\x99`\xcf\xc2\x17(y\xbd\xb6A\\\x87C\xbd|\xf90\xa8k\xe4\xe7*\xcd\x19\x18K\x9c\x82\xd7T\x857\xf7P\\H\x97\x8bL\xcd\x9a\x99Wo\x8dct\xaeZ\xd7\x7fr\x83\xaeo\x16\xf6OL\xab\x18~\x93\x0c\x8d\x1d81\xcf\xb9\x8bC\xfd{G\xf9\xf7\xee\x02\xaa\x00\xben\xaa\xa0o\x1aN\xc3\xbc\"\xec\xe7\xc7\x12\xc8\xf4\x93\xdf\xdar_}U\xe6T\xb6\xaa\xb9!\x9a\x91S\xf7\xf0\xd9\x11\xfdI\xc4\xcaf\xe0\x16.\x87<\xf2o\xb3-d\x00\xc0\x85b\xfc$K\xc0I\xa8\xb4\x14$e\x93K\xb8b\x12'\x9c\x16\xbc\x14\xd7\xa4Y\"\x98\xec\xf9\xcf[Ad\x85\xc4\xfc\xc3\x8e\xfdu\xdc\x9a4\x98\x8c\xba6j\x87\xef\xe7|y\x07{\xb6\x13\xbc\x95l\xf9\x0b\xb1\xce\x1b\xb1)\xc6\x1d\xcfwP\xe3\x82G\x11MI#b\xc6$5\xa5\xd0\x98|\xfc\xe1\xfc:1\xb35\x9a\x18\xba\x15oA!\xe74\xac c\xd2\x93mo\xca\x97$\x01\x16S\xce\x867\xda\xd45\xed\x08\xde\xcb\x14\x08\x04\xf9\x1e\xa0\xbb\xc4J\x10I{\xf9\xee\xe2\xdb\xed&\xbcYjB\xe2\x9b\xd2{\xd2kc\x83\xcc+\xcf\x91m\x1cy\x7f\xc8\xa3\xdf&4*f\x1f\xa1f\x1b\xd4\xce\xd1\xda;T7\x82\x8eE\x1bN\xd1)\x1a\x0dqG\xc8\x0f\x19\xca\x0a\x91\x8e\x9aHS\xe0|i`\x04\xdb\"\x03\xd4\x05\x1fI\x02\x99m\xa2\xa9Z\x17\xb6\xd4L\xab\x93m\xf4\xf8\xfdC\x81\x09\\\xfe\x1bmBi9\x1a\x99\x9e\x81$\x05\xbe\xc0@U\xdb9\xa7p\xea&\x19GC6\x82$p\x1d\xd6\x0ayDS\xd5@)\x848\x11V\x98\x0f\xd8\x1a\xc6t\x8b\xe9\x99\xeedj\xe8\xd7\xc7\xf7U\x9a\xd9Z\x80K\xf1+\xb2\xd8\x92&\x15fy\xec6\x02\xd5\xdf\x15\xb7\xf6f\xdd\xc9\xb1\xde-\xe6\xcd\x0c\x81 \xed<R\x88\xa6d#\xa2\x17zN\x0a\x8dMI\x87\xbc[\xc8\x13X%\x85\x9c\xba\xe7\xdb\xff\xd6P\xcfW7i\x10P4\xb7%u\x801\xd4`\xf7\xd5\x0ez\x98\xd2\xe9\x92m\x0a\x02\xb1]\x8a\xf4/~?\xfb\xa6\xe1*\x9a\x91X;\xd1\x1a\x10\xb4\"I\xdeZN{z\x02\xf3\xb5\xe3\xad\x94kmrp9\x86\x1f\xb4\xcc\x8cj\x81Jh\x9a\xc0\x8a\xde\xd4E\xa8\xb3\xac\x9a]\xbc~\x8f\x0a\xad\xdd\x05ZuZ\xb2\xda$\xc2dlt~\x9e6[\xd6\xb0\xac\x06W\xd6\x11@\x86\xa7\xa6MR\xd2\x17b\x08\x88\xba&\x96\x10\xee\"\x89\xff>\xaf'$\x9b\x95m\xf8\xf0\xc7\x1f\xa1\x86\xbe\x87\x8e@\x1b[a#~\xe0F\xf3\xa2\x7f\xff\xa1*}\xe9\xd1v7\xa0\xba0C1\x841\x1d\xf9\xb7\x15\x16\x94\xd8xb\xc1fG\xe8\x83\x01\xe9\xaf^lC\x9ai-K\x8cc#'\xc1\xc5\x18\x19\xc3q\xd0\xfe\x9cq\xd8\xa5\x94\xc3\xd8\xf1\xea\xd3\xe6>k\x94\xf1\xfb4g~\x9cS\x03%\xd6\xff\xee\xd6}\xd1*\x9eC\xa4BL\x14\xdc\xe5V\x09\xbf\xf5\xfb\x96\xb5\xdc\xc9NI\xecb\xc9n\xee\x9a\x8a\x16\x1dk\x11\xb1M\x99\xd6\xdeX\xfe\x13)\xffB\xeaA\xd9\xfb01\xbb1<\x09\x1eJy\x87,\x89\x08\xb1b\x86\xe4\x87;A\xad\xa8\x03\xc5\x9d#\x82!\xc3\x9d\xcb\xe8\xf6\x91P\xb3a\x97\xa1\xf4P4\xd0\xba\x82@\x876\xb5B|$\x9d\x9f?\x83\xd0\x0d\x07=\xef\xad7\xfc=\x0c\x84\x8b\xa1\xdeb~\x85F0d\xd1\xd9_pW\x7f\x988~gQg\x0cD\xe4)\xb2Q\xedBDV\x1eF\xa0P\x14Wm\xb0\xff\xbd\xd1\xe9\xaf\xf2\x8a\xccY\x80i\xee\xc8\x85\xc7\x1a_\x8e\xf7\xeb\xa4\xa5Q\xb2\xddf=w\xe4P'd3\x09x!V\x9d8\xb8\xac/\xbe\x8d\xf6d\x86r\x02\xfc\xb8vw\xf6\x98\x82\xf9\xb3\xc8\x89\x91\xab_\x9a\x92\xf7\x05\xc3;\x18\x05P\x83\x98f;\x1a\xcb}\x16\xa1Y\x8d\xa7\x08\xaf\xb0\xbc3\x97\x1e\x0e\xcc\x1c'\xf3\xd1\x9a\xc8\xf2\x8d\xa8{\x9c=,\x8ed$0H\x84\xc9\x0cI|\x0c\xc9U8\xa7{\x1bT\x12/R\xec\xc8P\xb7\x17^:\x0b'M\xa1qT\xc4\x19\x1d'E\xd1S_\xd1[\x98\x86>\xfc\xca\xfc\x080\x15\x82\x1b3\xe4\xcd\x92B\xf7\xc3\xdc\xe3\x08\x115g|\xa8\xdc\x98\x08\xa64\xf7|\xe3x\x1aW\x9a\xc7\x07#\xa9\xcb^o\x1e8\x9ao\x17\xbf\xdc\xb5b\x07v0i\xfd=F\xcbq\xa2\x1d~+\xf9\xe4\xcd\xab5\xec[\x0a\xea\x99\xdd|\xd1\x17%\xe7er\xef\xc2\xb7\x9a\x14\xaf\x8f~sp\xcbT\xbb)j\xb2DT\xd7P\xfdZ5\x1b\xa6FTs\xfbh\xdd\x1a!\xd1\x92\xc2\x8fD\xcf\x81\x8e\xfa\xf7\x96\xbf\x91S\xbd6G\x95\x9e\xaaZ\xb8\xe7\xb0\xc9i4\x7f\xbe\xa7@HDIz\xadt1s\xca\x03\x11\xceW*\x92\xf5\xad>\xc9\xaa\x8a\xf2\xba\xac\xe1\x0bL\xe1\x91\xb9\x13\xba\xca\xba\x9c$\x1c6\xfa\xedf\xceF`\xdb\xc6\xe3\xc0\xd4Z\xa5\xe9\xb7{\xd3\x0cEM\x98\xad\x9dZ\x91\x03\xde\x1c\x1c\xc3\x8f&&\xcb\x1f\xbf\xcdG=\xba\xdd_\xd6\xd1\xe2\x94\xeb\xd6\x1c_\x06\xb0\xa7dbJD\x9eb\x16w\xb3\xc2640\x98K\xf8j\xe6~f\x0c\x96\xd0\xa70\x12V\x9a0\x88\x81M\x95\xad\x9b\xd7\xcf\xb7\x9a\xbdK\xdb\x9d5w/.BbT\x96\xcb\xfaH\x99\x00N*\x07\xae\x8c\x9ej\xf9r\x04F\x83\xbb\x1a\xca6\xe5dIN\x0d\x93" +
+	"\xe53\x85Y)A&\xf7O\xa3yT\xcf\xd5\x0bQ6\x9dF\xb6O\xbbe\xbd\x8b\xff)\x94\xc3%,lxv\xfed\x9co7W\xc4Lq\x03\x8c-\xb8\xb6U\xbeN\x89z\x97\xab\xa1\x16V\xd0\x8a[vX!\xbc\x11H\x11\x97Q\x1d\x16\x0e\x95\xd4Q\xdcN\xe3>h\xca-\xe7\x00frW\x19\xea\x90b\xf6:\x9f\xc2p\x1f\xf1g\xb0o\x01C\x8a\xc2\x12\xa4\x09Y4\x97\x17\xc4W\x11\x0c\xee\xc6\xfb\xfd\xa6\x9ez\xb8y\xbeas!v6\xdb\x8e\x1f|Z#\x19\xfd\x96G\xedA\xafV\x8eG*\x87\xa95\x81\xb1@\xc8~\xae\x06HI7\xdf-\x96\x9dQ\xdfZf\x90\xc8\xe2D|g\xdb@\xc8'\x0b4b\x89z\x18\xddF\xd2tQ\xaa\x8c\xcd?\xd2+\xcb)\xe2\xc8\x84L$\xccN\x8b\xbaa\xd7\xe6\xd6#\x05\xdb\xc2x\xe8\xbc\x11K\xf5\xb0\xc1\x0c\xf3\xa8\xbez\xb7\xd1\x90m\x01\xb4\x9b\xd7o\x96I\x0b\xed\x9b\xcd\xb35\x9a\x9c\x96\xf3>\xd6\xb3\xb2R\x02\xe1\x90f[\xb6a\xba\xc8\xd8\xc72\xdf\xf0\x03y\x04\x9dU_\xc8n\x17\x97,\xf9J*Ip\xa4_\x16%\xbe\xd5C\x94\xff\xe8\xb7#0Q\x9el\x8d\xbfW\xa7%G[W\xc3/\xa9q\xd7\x08\xcb>^\x88\xc9\xe4\xb1\xaa\xd1\xe8\x8a\xc5\x90\x1c*\xa1\xdb`\x14k\xa79\xb2\xc8\x92D\x89\x0fVv\xb5\xfb\xe7\xfa\xcb\xce1B\xf4B5\xb4G\xd5\xdc>\xd7\xe0N)\x83\x8c\xee\xcb\xaeu\xf5\xa6:\xa7\xe2WL-M\xf8$/\x95w]\"\xdcn\xf3;\xc9\xf7\xdeE\xfa\xb6\x84adA\xa3\x91R@\x8f\xb1R\xde\x18a\x8c\xd8eqU\xcc\xc74l%\xadQW\x9c6\xc4\xe9\xe2\xc1\x1f\x83u\xd8I\xb3\xe5\xd4Ttc6!\x96\xd6\x8a\xeaXf\xd7JH\x0bgI\xf2\x9d?\xcdx\x8f\xe8\x8b\x8d|\x93\x0c\xbf\x06Z/\x08\x8a\x16\x03\xc1E[\xd5\x88\x11\xd7\x0d\x94\xc2\xdaRQ\xbe\xca\xcb\xf8\x05\x067j\x08\x17J\x12\x90\x9c34f\xf0\xd2\xaf\xc5\x01\xbdY\xd6\x03\xc9\xd8\x1f\x980\x83\x8d\xb4\xfe\xe1F\xa4\xbd[\xe7\xc6\xc6\xf5R\xfe\xe0\x8a\x06\xa2X\xda\x07\x03\x16\xef?\xc1\x04\x93<,\xf5\x1d\xcb\xa4|\xd0\xb0\xbb!F\xa3\x87\x93;n\x91\xd8\xd1\xe9\x95<\x8c_{\x8d\x95\xe6_\"\xb9i8\xdd;\x9fl\x82]\x98\x0e\xd7]\xeb\xa8v\xb6{\xff+\x9c\x16r\xd3\x1a>\x8b\xeejl\xa6V\xc0'\x02\xe8FLhm\xc7\xfa\x1a\xf2\xd07\xdbN\x07)\xfa\xef\x1f\xe4:\xe4\x90Pr\xcc\xd2m\xd3Y\xf0\x9c3\xe09K\x81\x14Ec\xa4\xae\x85#\xd5\xac\x0bc9o\xf4\xc6Es7\x9c\xa2\xca\x07\x95\x9f\xc3\xc5=\x10\x7f\xb5$\x1a\xc5\x9b\xe7\xbcdF^\xa8\xf1&\x19\xb3\xa0Fe\"\xe3Z\x82\x95$\xb5\xf8d;\xf9)FZ3N\xfe\x14.\x1b7\xf55>\xd1\x07\x05\x7f\xde\xbcHr\xaa\xc6?\x96\xbaN\x93WMLWs\xd4$\xe5QFx\xcb\xc5\xe2P\x8cX\xa4\xb4\x8c_\xa0\xe8|s\xeb~\x85\xe9(\xffH\x04-\xfe3\x9c<\xd1]\x10\x09VTr\xa4\xaa\x0ddsV\xec\x06k\xe9l4\xf5e\xcc\x7fJ\x8e0\xd6\x99}Qr~\xa65\xc60\xd6B\xb2d\x96\xaf\x1c\xe1V\x970\xbdC\x8cyt>\xcd\x91\x96NA\x00\xe1\x0e\x04\xdb\xe6\xdd}\x8eC\xe7\xba9\xea{z\x83\xc8\x85\x1fI\xb6\x8b\x0f9\xff\xde\x8a\xbb\x16\x125=$\xb2\xbc\x97j(I#{\xa3\xe3^\xa5*G\x990&\x1f\x85\x01\xcd\xa6!+<\xdc\x19\xc4FqJ\xeaIA\xd0\xad\xde'\x9e9\x9b\x02\x0b\xc2\x8a\x16\xa7\x1d=j\xf1;\x8b\xc8\xfc\x83\x12\xdfZ\xfd\xf1Hf\x07\x8d\x9b\x84\x8e\xfe\x01T]\xf3\xf51\xdf\xea\x95O\x93s\xe2x\x05\xaa\xb9\x86\xee\x9b\xa0\xbb2\x0c\xaf\x1f\xa9\xc2J\xc2\xd7\xcb}\x92\xe8)Po2!\xc3\xba\xd0\xae\xa2E\xdd(\xdf\x89,\xd7i\x816o\xe7\x91\xcd]#\xf6\xfd=\x1c\xe8\x01\x09\xa3LW\xb5Uw!g\x80\xde\x9bn\x1d\xe3\xa8\x17\xec\xdah\x8f\xa5\x11!\xdf\x90\x81\xb7*\xdd\xfd\xa4x\xdf\xc1\xa4\xd6^\xb86\xcc\x86!C8\x91d\xd9\xc6 \xbc\x99G\x03\xe6*\x0f\xd1D\xadWb\xe7\x12\xa5\xa6\xd7\x8d\xf9\x14\x0bl\x8c\x80\x84\xd8T\x14\x0a\xe5\x83\xb3t\x97d\xd1E\xc3!\x899\xa7\xf7Z\xa0\xda\xc7Wec:vC[]`\x14=\xebf\x84y\x00 \xd7U\xdb\xe85\xa3\xf877\x80\x1b,}\x87>g\x1dY`\xae\x19\x9aG\xa7>W\xf4\xfb$\xe6\xdb\x7fr\x97i\xf5\xe1#\xbal!R\x0eL\x92I\x14\xc1\xf4\x19\x8d\xd8\x82,\xd3*\x0d\x014\xd0\xae\x97{G\x8d\xbe-r\x943\xae\xfc\x88\x10\x09K=\xa0\xf6\xdd\xc0\xf0L\xc4_\xf0?\xa3\xfb\x8c\xbb\xd5\x09\x06\xc4\xef&6\xb4k\x08\x86+\xa0\x98\xf5N\x09\x18\xa6\x81\xc3\x07\x89*wV,\xa8\xec\xf6\xff>L-\x9cex\xc7\x86 \xe0Z\x15\xe5\xe0\x88[\x06\x8e\xee\xcf\xfe\x93\xe3/hl8r\xf5p\x14Wf#\x1f\x9d\x1b\xc3\xfe\xccP\xa6\xd9wK6\xe9S\x8e_\x92\x17\x9e\x9d\xc5\xc2\x0d\x94\x1c\xc6\xb4\\\xf9;\x1c\x16)\\\xa2\x90\x09\xab\xd3\x01K\xae\x149\"L\x80\x97-$\xf1\xdb\xe7\x0fi\x94$\x88\xf9 \x0dc\xe6\xa1\xdf/j\x81\x10\xf28\xdb\xfa\xbf3\x11\x06C'\xc4\x0cBI\xf8><\x8b\x95\x800\x8cZ\xac\x96\x8d\x0aq\x9e[* \xad\xe1\x82\x141\xca\xad\xd6PD\x8c\x11\x12G\xfd\x97\xa9\x08\xc5\xe2\xf4F_\xd3\x85xE5,q\x02\xf0\xd9\x8e\xf3\xbf\xd9\xca\x91E!\xbe\xac\x19\xa1y\xfa\x9cl\xb7\xe6\xe1\xc7Sk\xa6\x8dL\x13\x1d\x13K\x13\x0c\x90\x86h\xf8\xabd\x1a\xc5\x8d9\x9bOt\xa3,\x98\x90\xece\xfc\x85\x98{\x0b}\xc5Ex\xd7\x1c\x80\xb7/\x93\x94FB\x93C\x86SS\xf5\xd0\xb3c\x8a T\x0e\xe1G2/\x12\x01\xdc/\xfb\x82\x8d_N\x0aB\x07\xed\xf2\x1e\xd9Q\x06\xd5T\xcd1\xf9S\x0b\x8d;\x86\x1f\x8a\xbc\x08\x067N`\xe17N\x09;x\x17B\xd2E1\x0f\xf5\x0a\xad\x0ac\xb5\xc2\xa5w\xa9\xdd\xed@2vh{\xfa\xd1\x0d:?/DZ\xddBGV\x8d\\4B\xe3&\x06U`j\x1c\x13\x11Yq\xba\x11\xcdF\xb7\xce((`\xef|\xf0GY\x13\x8b\xcb\xc0\x18A\xfa\xc5\xf7\x8d\xd9\xb8\xf4\xdc\xb7\xbeD<C\xe6\x14G\x18_\x82/P\xb34-1\x16\xc4\xda\xe7\x88\xd5\xb3\x11(f\xa8\x1b)\x80\x99WE_\x9d|\xaf\x1e`MM\xd5\xc0^J!\xf4\xbc\xd5\x09\xc6%\xde\x16<\xa9\xab\x81\x02q\xb2o\x9f\xab-^\xee(\xb4\xf8\xc3\xe4\x17]\xa5\xb1\xc2\\\xeb\x00\x16e\x9a\x96\xd3&\xb5\xb8\x02\xab\xa4\xe0\xab\xfc\x9f\x0d\xf6\xf0\xb7E\xbe6\xe2-7\xd9@\xde\xbeIj\x979\xb8\xbcJv\x94\xec\xda8\xa6\x89\xed\xf6\x90\xe9\x10e\xa1\xaf\xb0_\xe9t\xc9\xfae\x07\xbd\x0f\xe3Q\x8b\xbab6\x87\xacD~\xfcl-\xf7\xb1\x98\x89\xbf\xb2\xa1\xbf]\xad[\xd6\xac=\xd4\xad\xb83Yq\xeeoS\xc6\xf7[\xca\xf8\x91\xc8\xd9\x86\x98Y\xe1\x83\x00\x19\xb3\xa7\xe6\xc9b\xdf\x90\xbf4\xd3\xf7X\xf7\x81>\xda\x8d\x9d4\x8e\xd3\x05.\xb3e\x11}\x08\x1dL\xc5\xa7\x8a5YjG\xcc\x8b\x9ba\xc5g\x0f\x95[\x14\xbf:\xa6W\xa1/\x1a\x1fO\xf8\x0a?V\x0aC\x9c\xfe\x1c\x07\x09\xf6[1:8\x03\x16\x81\x05?\x08&\xdc!h\xf5w\\\x0c\xf60\x92\xe1\xb9z\xd9?\xfc\xbb\xd1\x0f\xc0\xff\x0e\xff\x94\xd6\xbd\xf8C\xd1CW\xfd\xe3\x1f\xfe\xf6\xaf\xff\xf6_\xff\xed\x7f\xfd\xdb\xff\xe7o\xff\xfa\xb7\xff\xeb\x1fV\xe6\xa2\xed\x07\xda\xe4\xacD\xcc\xc2\xbb\xe1X\x1c;o\x8d\x0dkI\xc7Z\xf0(\xad-Ab\xabU\x17\x03\x03\xfaU7\x06GWy\xeb,\x0a\xb0\xf4\xc9w\xc8\x02\xe8N8X\x0e\xf0l\x89\xe70\x87\xe5\xe9\x18\xb6\xc6\x16\xad\xef\xb5\x0e\x97\xe0\x08\xe1\xec\xe9!g\xdf\x93*I\x0f\x1dez(JD\x15\x87\xc1M\xe9'(F\x98<\xc1\xe2[pL\x00\xb43~\xc2\xbe\xa8\xfd\xc9\xb3w\xd1\xc2\xe4\x07\xd4\xbf]\x9c\xcec\x155\x06\xcf\x10\xc1\xd4_`\xa4\xa3g}Vgd4\xaeI\xf2qQ\xf4\xe3\x1e&\xd7\x89\xda7\xa9\xc3C\xeb\xce\xfbD+\xe4\x90\x87K\xc2\xc2\xb6\xd4\"\x95\x91\xb9\xc1T\xd5,\xc4\xc2\xca\xbc\xf1\x9eRx'\x91\xb8\xac\x03\xe6\x84-;y\xae\x14\xb55\x0c\xf0U%\x0f\xb4\xd8\x1f\x90\x18L\xb8Ic\x98\x9a\x90\x1b3\xa1*\xa9\xbf\x82\xd2\xb51\x94\x11\x0a\x89\x8f54\xfb|vq\x87\x9e\x1c\x9a]\x96\xcc\xfb\x8b\xeb\x1d\xb1\xe7\xb1c\xf2\x85\xf5\x19\xbe@\xef&8\xd2\xafbG586\xe8\xdeC\x7fv\x7f,J\x0f\x07b\xee\x86\x09|\x81'\x99)\x91zTK\x14+-o\xb1\xd75J\xb2Z\xf8\x88\x93/!\x9c\xfc\xa5\x04\x1e\xa95\xaeu\x8d[\x92]J\xce\xc5\x04\xd0+\x15\xef\x84L\xbbR\x8c\xf2\"jX\xe8\xcc\xc5J\xfb/\xc8^\x0f5\x0c\x0a\x06\x91^\x8b\xaf\xd7\xbb\x06j\xb7\x8f\x16\xa2\xa2\x19\xd4\xd9A\x9e\xb0j\xa8Z\x80>\xefA\xab51\xe4I!\x1f\xads\xe2\xb8\x90\xb7\x0a\xadW*\x95^0\x8b\x92'Q\x81\x09\x0c\x86`o\xc7\xb7\x0a_\xdd\xc9\xcb\xe2\xa5\xea\x0b\x87\xd3\xf7\xc2\xd5{\xe0\x1a\xa6\xb9\xdc2\xc2\xb6T+\xc7\xac=\x0bg]\xeb;MT\x14\xd0@\x8b\xc6\x15\xad\xdb\x1d\xf7LV\xdc\xddT\x8d\x87\x11\xa0\x0f\x87\x18\x1b\x13\xc2\xcf\xad\x92\xb44\x10w\xcd]\x13\xb5x\x9e\x14\xdf\xb1\xd3\xaa;B\x0b\xfd\xad\xf8\x0c\x0dD\x00P\x0d\xa3k\xf95\xdc\xc4\x87e`\x83J\xb75\xbeO\x03\x13\x1c\xc3\x8f\xb8E\xad\xf6WRv\xe0o\xf1u\xcc\xc6\xe8&/</|s\"\xd9\xf7\x9d\x1f\x18]\x88\xe3?\x9fD=\xa3\xb1\x091\xd7\x99\xaa\xe3\xf0\x88O\xd5P\xc2X\xec.\xd0\x97x\xef\x8eO\x08jdT\x0a\xa7\x11\x9b\xb7\x11/;\xe2OE\xa7\xe7\x91S\xb3\xe7\x9a&\xf1\xe2\xc42\x0af\x0b\xc1\x10?\x87qw\xe9]\xf6a\xcb;W#\x1c\xc3\x0f<\x0ay\x98(T\x1e\x99\x07\x95%'\xe4~3~\xb8\xa8\xfc^\\bSq$\xbb\xe0\xa7rscQ!eO\xa98\xc6\x85Z\x14Zm\xd5J\x9btN\xcc\xc2e\x94\xda\xe7\xfb\x9f\x82A)\xb77wb`'\x8eX\xccy\xacU\x9f\xda\x9d\x81\xbe\x9f\xa1\xfd\xb2\xb6\x88\xa3\x1f\xae\x1f\xa1\x19\xdd\x11\x7f\x16;\x13\xce\xc4\x1b\x0aC~\x82\xf3\x08\x03\xfb)\x05\x90\xcaz\xfa\x89m\x81b\x87\xe3\x18RH\xe1\xc6|\x9b\xd5)\xff\xda\xe8p\xb3\xc1k\x03\x17%\x145\x90C#\xbb\x1c\xb5\xf2\"\x85\xd1\xe1\x01'\x7f<\xc1\x05\xd8\xd9\x18\x80\xb6%\x13\x82\xa7\xfb\xf2\xee\x9c\xdd\x94\xe1\xe1\x8f\xbd7n\x81\xec)\xf0\xf9Dq3\x19;\x85\x0df\xe4\x85D\xdc\xb6\xda!o\xb2\xd2#\x1c\xea\xb1\"\x1a\xef\xb0O\xd3MI_\xa1t\x83\xbc\xd4\xc1NA%\xf6\xce\xa6\\\xba\xc5\x99&\xf3\xb6\xdfT%&\x86\xae\xeci\x11sr}O{\xbb,\xc6\x9c SW\x18M\xc7\x0a7$\xe6\x99\xa6!\x91\x02UNs\xf1\x0bY\x13R\x04S\x0ei!$W\x0a\x18\xa3)\x04-n\xa1\x9e\xaa\x01b\xf9\xe5\x93\xe2\x9f\x91^\xa3:q\xbe.\xber4\x15\x93\x88\x81\xd1%\xcc/E\x188u\xe2\xac\xcfP,r]\x9b\xf6\xa1\x18\xd0\x95=h\xac>\xc1\x00L\x98+2\xec\x19D\xb9\xab\xfa\xaa\xc5I\xe6\x90=\x03\xf5-\xab\xc1OYA\x8f\xa1\xa6\xd5\xf8X\xf8NL\xfe\xb6!N\x09-\x14;\x8a\xfbo\xa9<\xa6XG\x94\xa8\x81\xc1}C8A\x86\xd5\xc9^\xb4\x8b\xf3\xfd\xe2G\x10\xfe\xae}\x1c\xef`\xaa#\xfe\xe6\x8fq\xbd\x89\xe9\x18>/\xda\x0a7\x12[\x1a|\x823\xd4\x8e+\x1d\xcd\xa5j;\x1b\x1c ~\x80\xe7\xae\xa8\xc4\xebU\xf6\xecBcX\x81\x81\xba\xbe<^\x8eF\xb2Yk\x12\xe3\xd0\x09\xfd\xce<+\xde\x9e\x9ej\xad`a\x805\xf1\x88\xb1\xba\xc0pG7\xa6\xed\x19{\xc84M60\x09h\xc1\xe8\xfa\xf3\x91~Q,*<\xb2\x87n\xf3\x15\x19\x1a.CN\x7f\xe7Y$\x00z\xe8\xa1\xe1\xa6\xa4)8\xbeZ\xd9\x01\xcc\xf5\xd8\xd3\x0e\x8a\xd2!\xa0\xcfQ\xccR\xde\x19\x03\xe7\xa1\xedb\x82\x84\xe8\xef\xbd)Ha\xd2\x973\xbc{\xc0\xfb)\"\x89\xeb\xd6\xb6\xee\x19:\xe4I\xb1\xfbI\xe6\xdf1\xce\xc4\xa9\x1a\x10yT\x94\xae\x80\x09\xc6H\x8fW\xba\x0b\xf1\xf9\x05\x97X\"\x96\x0e\x0a\xf22&\xf0\xc1r\xe8\xed\xf5'\xb8\x87\xa1\xa8=\xd7\xc5>)v\xbf\xd0\xd4\xe7\\f1y\x09\xee\x98)\xcfe\xb6\x11\xbc\x96\x14p\xce\x94\x96yK\x8c^\xd0\x8eN\xaa`\xee\xf4\x9f\x01\xcf3\x95,\xfc!r\xcbG\xfa\xddX\x83\xb1^fk8\x94\x1e\xa7\xe8\x93\x04\x07\xf0-\x82\x029t!\xb4\x0a\x1d\xbaN!\xa0\xed\xb7\xd7\x10O\x1c\x0c\xd4\x1c\xe9\x97V\xfbe\x91@;\xff\x18\xab\x92}oab\xc7\x04\x9e\xcf\xa7\x0a\xc6p\xb7\xde\xad\xbf\x87\xed9\"'\xcbl\x9e\x94~y\x9a\xfc\x04\x83\xbct\xb6\x1b\xc8\xfb\x98W\xe1\xc9\xa2=e\\\xf3\xf5\x1eQ\x95\xbfY\x17\\6\x9e]Vm\x9c0\x1b\xaf\xb0\xd9\x1bx\xf1\xc3\xd6\xcc\x91~u\x9e\xdf:\" ;tx\xb3<\x18\xcb\x9fdA\x12]G\xf1\xfd\xe0^X \x16>\xc3\xaeP\xb9S\xe9\xa1\xb8U\xe3\xe6b\x9e\xba\x1b+w\xf2\xb8\x87\xef\xa9I\xf9\xb5\xf1\x85\xec\xb5\xc9\x89b\xb7m\xce\xa9\x85\xd9Q\xdcV\xbd\x1b\xf5Ftf\xc8\x86\xce\xa7\x02\xb3\xc5\xe2\xb0\xe7\xccV\xf48S\xf7l\xe2\xa3\x8a\xab\x8cu\x93\x01\x13\xc6\x16\x00[hr\xbe\xb14\xc9\xc6\xb0\x86\xd4\x1dL\xb8N\xfb{\x90\x8eX\xd9\x09\xa3V\x96e\x94\xd1b\x15A!c2\x02O\x8e\xfd\x93\"*\x82\xb1\x8d\xbd\x00*3\xf2\x03du\x18kLY\x1a\x86u\x96\x8d\x85=\xee\x01\x94f\x11+\xfc'\xdf\xf8\xeeX\x87\x9fz\x90$\xc4\x00a\xd3\xed'\xcfpX\xfcW\xb2\xfdZx\xe4\x04y\xddNR\xc69W\xa4\x93t'a\xfb\xd73\x9d\xa5\x8b\xa9\xaa\xaf\xd7u\x89zp\x0f*\x90x\x91\x1e\x04\xb9\xebl\xf8i\x17\xda\xaaJ\xeaa\x99\x0eK\xbd\xf8\x90\x1e1\xc6-cM\xb9\xbdn\xb5\xadaub?Et\xd7\x83\x8a~R\x99#qJ\xf6\xb4\xbb\xb2\xa3\xaaw[\x968:\x18\x87\x03J\xa0\x10l!;Kp\xeaS.\xdd\x9dAk\x8a\x9a\xc2~!\xae\x90\xc4\x86\x0c\x99\x13\xfb\xf3r\x12\x86\x14\xdd\xd98;\xbb4r\xccQ\x90\xd0\x9fH-\x99E\xae\xc2*\xed\xbc+\xe0\x96\xc3z\xb4\x1f\xd05\x09\xc38\x17\xcc\xb7\xe0\x0cW\xffF}\xbe\xed\xd8(\xdd\xe43\xa3\xb4\x85\xc6\xcf\xbbt\x06\xa4H\xdcf\\\xb6&\x02\xcb\xc9\x1f\x93\xe8\xa6\xa3\x95\xffN\x9e\"\x0a\xec\x95Z\x12\x15N$\xc7\x99'\xba9\xd5t\xc5\x8e\xdc$TLd\xfb\x1e%H\xb7\xa2\x8f\xc4\xef\x97$\x945\xad\xcf\x9f.\xa7\x93\xed\xc4l\x82#\x8d\xbd\xd8\x8a\xdc1\xfe\xc9\xb5 \x8a\xe4\xdaG\xd6\x8d\xa6\x87K\xa4\xfa\\\xa9Q\x1e\xddTCB0Q\xeb\xe9%\xe9\xcbW\x94\xec\x1fe\x89&\x91\x17<x\xe2s(\xa4\x9e\xbe\xc1\x1a|z\xa9[p\xed\xed\x0b.\xbe\x93\x95\xc6e\x13a\x0d\xcck8r;\xfe\x10\xec\xf6\xc1c\xbc\xde\xc1\xb5\xa2\x92\x11\x92\x09(U\x80\x96s\xd5\xb0#\xc4\xa6\xd1\xf5KPJ\x98\x9cl\x87\xb1\xe6U\xa3\xeeTv\xba@\x02@Pn\x95\x19WM\xbc\x87\xc3a\x1fH\xe8O\xcd\xa9\xa1\x85\xd6\xc8\xce\xa2\x816B\x03NvU\x9en\xb4\xab\\ \xbcE\xb9\x8c\xf4\x15\x85\xf6T\x08\x936]\x03\x1c\x97\x82\xf64\x19\x93\xc1\xde\xb4\xa00\x91\x1c9 \x19L\xf5\xc9\x87\xef\xa7\xdc\xa2q\x83B0\xb2lP\x863Jr\xc6g\xf0\xed\x9c\xbe\x90#@y\xf0\xd8\xf0\x16\xa6bK:\xc9d\xcb\x13|\x81*3\x0b\x03\xde\xd2\xb7\x19W\x9c\xeb6[YZjq\xe4\x81\xb0\xe9\xefu<\xc4rv\x94\xa4\xa3\xc3\x10 \xd2\xb8P\xc7\x8d\xfb\x80\xfe?b\xd6\xd4p\xc9\x18\x1b\x93\xf1\xd8" +
+	"X{\xd6\x97\xa1W\xe6}j>k\xb2I\x91 \x0b\x94\xff\xd9T\xa2\x1bY\xa8Xk\x17nA\x9d\x95p%\xd0\x9c\x9d|\xe9\x95\xdb\x17k\x86\x08\x95\xa0\xf8\x80\x1e\x08\xfb\xbf7Ih\x9c2\xdf\xae\xf6\xf3\x8d\x09\xc3\xb3\x8a\xdd\xf6+\xc5\x91N1\x84Zm\x13f%\x12:\x13\xc1\xf5>\xc9\xcb\xe3a.\x99\x03r\xf6i\xc8\x9eXE\xc4]\xe6\x96\xef\x0d\xbb\x06\xf5\xb2\x80\x0b=1\xf0\xf6\xb7\x1aoo\xfc\x86\"\xe8\xb3g\x1b\x89IJBgy\xf0$\x86\xcfW\xa0\xdb\x0b\xf7\xc4\x9am\xd61\xd7w\x8c\xc9\xb7\x88e9;\xdb\xa3\xf1\x81\x1e@\x07\x13[!\x1c\xe4\xee?Yc7\xe5\x1a\xa4\xb9\xb0\xd3\xa3\xb1\xbb\x04\xe9\x9b\xd5\x16G\x98\x01\xa6\xbb\xba\x94\xb2\x7f\xa7\x98\x80=\x06\xdf\xdc\xc9\x07\xaeO\x13s\x92\x9dZC\xa4\x99'9\xbb\xe2\x90_Q\x9c\xe0\xf2\x90;\x15\xf8\xf0\xd9U\xfa.\xf1\x8f\xec\xad.\xbf\x88\xfa\xdf\x8f\xff\xaay\xaavF\x8cv\xfb\xd1;\xe6B\x9d\xfb\xef\xe8\x10\x8f~\xb4_\x8e\xbbSHZO\x1e\xf6\xf6\x95\xf4C\x1aI\xae\xb3\x90\xb4$\x03\xb8\x06\x97li\xe6H\xa1\xd5\xe54 a\xab\xd7[\xdf\xf1\xe7\xe9\xbe\xc8\x01\xae\x1dN*\xf4\xbf\xe9V\xd5\x80;\xb3\x04\x1e\x14%!\xe0\xf3\x14:\xf2\x8enP\xc6\xf9$\xbc\xc8\xc9\xb2\xc5\xa6\x16Lx\xc6S\x0d\xfa\xc9\xb3\x0a!9K!\x88\xc22\xef\xd4\xfe\x01\xd7\x18\x92\xfdy\x98a\xa6\xaa\x81{\xfeP\xc8\xa23\xfd\xa2tp\xba\x0ei\xf1\xbd[\xa27\xff\x0d\x1a\xcfQ6\x1fS\xd5\xa6#B\xd9^\xd6a\xfb\xc4\xa9\x0b-\xf0\x08\x95\xd7\xc3\x7f\x03\xdc\"\x91\x0d\xdd\xfd$\xbeAq\x04\xf4\xb4f\x9ai\xe8\x15\xeb\x0a\xa7}5\xbcb\x0b\xad\x8c\x95\x001p7Nc%RQ\x83e\xe6\x83\xcb\x86\xd4r\x12t\xa2\x84\xae\xfb\xe2\xc9\xb0\x1d\x9d\xaa\xe1W\xd7\x03c\xc6\xd3\xbb\xe4S\x99\x98\x80\\\xb1\xe39\xbd_0\x81\xe7\xe81\xfa\xa4@\x1c\x8e\x05\xda\x0bpd\x7f\xb0\x07\x0dV\xc9}\x81[w\x924\x8f\x16\xdfH\xfdp\xec\x19\xf5\x1e\x13=\"s\xcc)\xdan\xa0|$\x83\x7f\x85<\xab\x17\xa1:|9\xe2\x90\xfe\x9d\xc3\xe7\xcf^\xaeEM2\xdf\x9f\xa3\xa2\xd5PF\xd6wZ\xe0\x84!\x09G\x11A)\xb0\x19Fw\xc1\x1e\x87r\x03\xe5\xf0&\xd3\xdb1\x9aa~}j\xa2\x13\xcec\x13\xc65cj\x10j+\xdco+M\xf2\xd9\x82\x05-T\xa8\xb3R\x85\x84\x1a)B\xdcR\x09\xa5\xb1B\x16N\x8c\xb4e\xf4\xe0.\xc1z\x99\xf2\xc9\x14\x9b\x91\x85\xc5\xc2>\xb2`=\x18X\x0d\xb9\xff'\xb6\xef\xc3\x02\xa1'z\xa3\x1c\x98\xc7\x80\xc8\xe0\x89Q\xe5\xc4\x84\xba8<+\xd4\x1b^\x09\xe50r\xd3\xc5%\x8c-;\xcc\x9c\xf3\xa5\xf8\x08{\xf1\xb7\xae\xd8\x857\x96S\x02\xb7\x18\xf59(\x1b\xc9q\x12\xca\x02\xf0\xff\xa4=\"\xf3(\xd9\x0d\x9f\x14\xbb\x0f\x15N$\xe8\xcdT\xc2\xa9\x92\x82+\x97#\x12\x09\xa6\xa0\xa9:\x18\xef\xa1a\x06\x0c\xd3t\xd5E\xe0\x86akf\x91\x9f\x92KIpM\x99\x08\xb9\x02\xecS9\xa8\xdfr\x8e\xbcJs\xe2\xd1\xc1\xd4\x12\xe2\x1d\xed\xf4\x12\xb4\x0e{\xfb^Uh\x94\xd8\x18E\x9e]\xac@\xa3r\x1a\xfcY$\xb0\x0aS\x07\xbe\xc3\x80c\x0f'\xb2:\xc7\x1aZW\x13\xedzx,\xbe\xca\x9e\xde%\x86\x07\x16\x9a\xfe\xb5\xfbWR\xa5=o\xbf}\xa6.\xb3\xe52O\xa4Q\x0b(\x8f\xa2\x87\xcf\xa3\xe7\x00\\\x07\xcd\xe8\xcb\x1c\xb1\xce\xc2,cu\x0b\xbe>\x86\x1fttP^\x05\xf3\x00\xd0oX\xd3oVF\xccx\x94\x83\x0b\x0b\x0c\xfa|\x98\x98'0\xc2\x12\xc3\xecwA8\x16Q+\xeb\xcf|\xfbu\xb2(\x0a\x087@\x91\xb1\x1a\xa1\x83\xe8.\xdfFQ\x8f\x13L\xae\x8dfIr\xc1\xa5\x1az\x07g\x83a!\x08/_D\xc5\xd6(_\xe2\x1b\xc8\x16\xd42(PX:\x88\xf4`\xd1@\xe3\x15\x9f\xae%\xed\xd4uX\xf5\xd3\xb5\x831\xd7\x18\x8aq\x13\xc9\x1e\xd4\x8cK `0\xefsL:\xd6\x1a\x86\xab\xa5\xdc\x01\x17\xda\xae\xc3\x91;\xc6\xb5\x8b\x90\xf3z\x0bn\xe6\xca\xe4,\x8ba\x8bB\x99dT;;~\"rt0\xc4S6\x06'\xfb\xfb\\\x10\x17+\xe8\x96\xca\xe7\xc26\xa1\xb7\x15O\xa5\xa9>\xb9v\x1f\x89\x82b\x0d0\x8ba\xda\xb2\xad\xce\xb7\x0eBZ\x7f8o\x80\x83\x9eo\xb9\xe5npk\x1aq\xa5c\xc6\xab\x88\xf2\xd5\x88Nj0\xc8\x16\x8e@\xbdU\xb3!\x9cC\\ yZC%\xea\xb7b1HN\xbc\xecG\xa8\xa1\x81\xde\xe0\x10mvgw\xf1\xfd~\xe1^\xdb\xb7\xc1\x92\xd2\xb9\xd2E\x12z4\xfc_\x0f28\x1f\xc8\x0d\xf0\xfcy\x82\xd5\x8c\xd2\x11s\xd4\xe4&R#\xb8\xc4\x18\x0b\x10\xd2Ow\x8a \xc6\x0fDo\xc41\xc3\x0as\xbbG\xfe\xad\xd8\xe2\x1d\xe1\xa5J\xd8/i\xb6\xda(\xd4\xe8\xbb\x0b\xf6\xcf\xe4 \x0d\x90f\xb9\x9d\xd4\x9b\x90\x11Y+\xed2I\xb4\x07\xf7^T\x12\x04\xdf\x99\xd9d\xf6\x86K\xd5\xb9\xee\xe2\xd4;\xf3\x1dO\x96\x03\xd7r\x925\x9bz\x838\xa7E\xfe\x8b'\x92\x04\xd0\xf4){a\x81\x98\xcfvI\xdc\xed\x0f2\x1e\xe1\x19\x0bw\x90\x07\xf6\xf0\xb9\x81:\x11\x1c3\xe3mh\xe4\x18A\x89\xfdl\xcd\xd9\x11\xda\xaa>p\xfd'[ny&\xbb%\x1c]b2\x0bs\x0b\x1a\x8bT\x97\xc3\x9c\x01[\xfd\xa3\xb7\xe0\x92\x81\xfd\x93\xe2?\xcd\xads\xda\xb5\x18$\x1a\xda\xd6\xfb\xf1\xde\xff\xda\xeb\xfe\xdf\xc3X\x85\xfd'\xc1\xcf\xf6\xc5\xc5\x8d\x0a\x8a\x97?\x9b\xc2\xce\xd2\x0f\x89Sq\xf1\x8dom\xaeo\xad\xdc#\xf4\x88\xd3\x0c\xe4<\xcc\x1c\xbc\xf2c\xf8\xc1gs$\x17\xe6\xacE\xbf\xb5\xf8\x7f\x09\xab1\xb1\xd4\xb6k\x94^.\x87\xe1m\x7f\x91 \xc40V\xfd\xb9\xa9&\x80^\x0e\xa4\x98&\xc5\xa64\xe1\xa8\xb7\x1a\xfc54\x02>$\xe1\xc03d%s[WjhHpKUL\xf5\xd2\xf5L\x02\xc9\x86`*\xdaF\xa9\x88\x05X\x00s\x83\xfa\xcf\xae\xd1\xb3\xc4\x84\xa9\xbf\xc2\x97\xc7?\xf6\x1b\x8aC\xcf_\xc5\x0cmw\"\x19\xe7\x18\xfc\x9d[N\x96\xa4\x89 \x10\x89x2f\x178C\x84\xf6Nh\xab\xd9\xe9\x96\x10\xa2\xb8\x17\x1f\x13@\xb9)\xcc;$\x9b\xad\x00\xcb\xa7\x0a)Te\xa3\xaf\x1aNw\xa7\x7f\x0fV\xcb\x81\xff\xde\xe1K\x06R\xea\xe9P\xb8S\xef\x8b\x11(\x98\xdf\x13\xff^1B\x0d\xf7\xf32\xbc\x9c3}E\xdc\xfd\xae;\xdd)k\x0b\x96b\x9e\xe1H\xbfDOl\x95T\xe6\xf9\xeb\xab\x9c2\x8dk\xdc\xa0>\xd7\xbd\x1f|\xad\x16\x82\x8b\xdd\x9d]f\xf4F\x17\x08\xf6-\xbf\xd4\"\xcd>\x0d\xe5\x07\xd2r\x97\x13HR\xa9\xb4\xf6\xe7\xe5\xfd\x8f\xc4\xa4<O\x12\xe0\xcc=\xb6\xe4k\xe9\xfeo\xea~\xb2$\xe9\xe0L\x0b,\xd5\xd5\xec~\x1ae\xde\xac\x1d'6\x0f\xde+\x17\x84\xb4b\x84DH\xb6\x19\xe1\x8e\x91`\x9csq\x0b\xb2\x97D\x8e\x81H\x8d\xf7@\x8a\x81\xe7o3\x91*|?\x04\xa8\xe1\xd3R\xc5\xb9\xc6\xaa=\xae67i\xa7L\x9e\xe4\xbc\xe5\xe3\x9bt\x1f\x16gOj\x02\xd3\x13I\xb4\x8a\x1b\xbb\xbcU\x09k0O\xb8\xd5r\xc8\xa7[L\xa89\x9ffL\xa9\x86\xb2\xe6\xb0)\x85F\xa0\xcb\xf2+\x0c\x83`\xec\xc4\x9e\xc3\xec\xf0-\x9bU\xe9e\xe9\x16\xcd.\x18I\x14\x9d\x81\xaarc\xdd\x82\xfa\xe1\xca\xa30'\xdc\xd8<\xf1^\xdc$</'\xc9\x8a\x86a\xde\xd5\x08Y*\xecR\xeb\xa1\x9f\xdc\xfe\xa0\x81\xb7\x0fB*\xa4\x94\x9b\xb1'\xb8\xe0j \xfd\x01|\xc3hK{!\xc3\x9f\xdf\x81\xe25\x1f}\x0d]\x07e\x0c\xf9\xd3\xfdl\x1c.)\xe2\x0cw\xec6*\xb0^D\xaf\xcbE\x19\x9c\xf9nR\xae\x10\xda*,\x8fIG)7\xcf\x01\x175\x89F\xe8pa\x18}\x9c\x09z\x19\xffE\x82\xf2d\xd3\xdcE\x10\xe4^\xe3\xf1M\x15\x967\xf4[,P/\x9e\x7f]\xdc$\xcc\x94)\xc5\x0d\xa4\xec\xda\x1d\x9c|c\x18\xb5\x8d\x83=\xe5\x04\xc3\\\x04t\x90\x82\xc6\x02A75t\x17\x92\xe9\x1eo]\xb9\xdfx\xd6\xad\xef\xfd)I2s\xad\x16\xc5`Z\xcf\x15\"\xc9\xab@_r>=Zl\xc8\xb6#\xd8\xdb2f\x1c;?\xe2 \xe3'4\xe5xf\x92%{\xf2\xe1/ W\xa4\x1ab\xf7U?\xf9:\x9ab&\x9e\x9cbEhd\xc1\x0d\xbc\x11#>)\x1c\xf4\x9d\xf6\xbb\x9d\x1b\x96z|\xf4\x9f\xdc>d\\/\xa4\x0b\xe4\xa3\x10<\xd3\xfen\xe1\xe6P\xb68\x89\xc9R\x9cv}\xd6\xbc\xc8\xf7v\xdb\x10\xd2\xda\xdbf\x89L\x9dz\x15\x97\xdb\xfd\x02~\x80i\xff\xad!\x179U\xad\xc3bV\xd7:\x14\xfa\x17\x81\xb8\x9d\xd6\x88O\x18\xdb\xddgb;\x0b\xc1M\x04\x15\x8f0\x85\xfb\xf5\xf7\xae\xbb \xee\xac\x8c\x8e\xc2.\x02\x9e7\xf6\x84\x97\xf3\xb0\xecLhj\x97\x0a\xd4\xef\xb5l+\xd1\x8a[,\x1a\x8d\xaa#,%\xa2\xa2\xbaW.g\x91\x12\xbdz\xb5\x01\xaf\"5Y\xbe\x9d\x117s\"\x13\xe2OP\x9b\x0a=3\xf3\x8f\xaa\x03\xb9&N\xf6!\xe1\x8f\xa3\xbfi:\xbe\x09\x1f\xf2\xb4\x01\xad\xeb\x8d\x1a^\xbbn\xa6\x1e\x86\xe9\x1f\xd5\xce\xc3\x9b\xaa\x84}\xb2E\x98\xaa\xbe\xb8\xc2\xf0z\x91BY{\x86\xa1\xed\x86o\\\xaf\x123\x1a \xe9\xfc\x04\xc3\x11\x7f\x16\xad+\xa1\x8eGk\xff\xa9j\xa1\x803t\x89\xd0\xef\xec\x19\xcb\xdd#7\x09\xbdp\x88\xb0\xa5A\xd1\xf6\x17\xd2\x056Du\xb1GY\x13l!\x17c=r\xfah\xaa.\xe8\xc2,\x08\x0b\xa0(ec\x0c3\xb4X\xa3\xc8\x9bX\xdc\x1f\xed\xa1\x14-6\xda\x7f]\x97\xc9`O\xee\xe2\xc6\xf5\xf9\xf8z\xf3\x14Jv\x0e\xd9+\x0e\x89v\x81a\x16\xcc\xa0\x85\xe4\x15\x08c\xd2\xee}\x8b\xd20\x91\xc1\xb0\xc3i\x12>\xfb\x10\x89\xa1\xd1]\xb7<\x12\x98\x12\x92\xc1\xa2\x05\xd1\xf9\x06v\x9d\xdf\x1f\x88{\x16?\xbc\xf8\x89\xeee\xc9f\xf1L\xc0\x8f\xd1\x1fu1\x1f\xa8\x86S\xe6\xbb\x9c\xe9\xba\xb9P\x93\xb8O\x1d4\xa3\x83io\x08\xa4\x7f\x86\xd3\xe4E\x18@J\xd3\x84Wm\x9c\x9c\xe4#\xd6\x07\xe1\xcdU\xd3\xd5\xe0\xdd.\xbe\xa78{1\xde\xbb\xc154?\xbfw`?\xe7\xe0_\x8c\xb73\x9a\x824+\xe9\x8b\x9c\xba\xc4y\xba.g\xb1\xe8\xd4,21\xaf\x12\\SZ\xe3\x81\xbc3/\xden\x9dp]\\\x87\xb9\xf6.:\xd2\xa4\x17\xcc9\xb6\xd1\xd5\xdf&\xba\x116\x8c\xc2\xfa\"[:\x1e\xb9\x01\xa8\xbe\x83\xc2$W\x09F\xd4\x19\xf9\xc9\xee\x1ba\xe5L\xac4\x9d\x8a\xf5\x1f9\xf6C\xb0IM%\xe0\x0b\x7f\x9b\x1e=)8O\x8e\xa0\x0cQI'Q!\x91\xc5\xd5\xf7\xbcyTg/Px\xcf\xcc\x09\xa4`S+\xe2\x12p\x96\xd1\xe5\xbeu'\x8e\xc2\xd4p\xaee\xab\x9d+9\xda\xa7}@\x03p'\xf2R\x1f\xab\x06\xd9\xb2\x13\xa2c\xb3\xf5\x86\xb1&\x93\x82\xce\x86\xc1=^\xb1\xee\xe5\xb3Ld\x8f\xf1\xfeI\xf3yKY\xa8\x7fZ\xb8 \xfa\x89*~\xb3\xaaNC\x84nbRp!\xcf\xfa\xbb\xaa\x1f\xf1~\x02\xdfp\xcd\x8d=|\xd2!R\x9a\xe6\x9dr\xd2\xec\xbf->\xa0C\x87\x1c\xb0\x18\xb6N\xe7}\x93:\xafq\x01\x14;u\x8c\xf7YXe\xfd\x85\xd5\x84\x05~a\x83{i\\v\x1b\xebX\xc6\x1a\x0e+\x89f\xa3\xea3 \xfb\xb7\x0b\x12K3\x98u\x94W&m\xe5\x08\xd5\xe0`\xee\x0f'\xa4\x1f3\xf2#+PmJ\xb9r\x07?`\xb3{\x99\xd2\xb6\xce\xe0\xdd\xbb\xf9\xb2\xa3N\x0a!\xc0CqE\xf2Z\xc1\xfc\xac;\x93\xde{UM\xb0\x06\xe4\x8b.v<\x04l\xa6\xcf\xee\x9b\x99\xc4\xc9\x8e\x13\x093\x17\xd8\x18\xe0\xb2=\xa3_=\x8cd\x82\xdc\x7f\x1e\xab\xc8\x9e\xf8\x81\xf1\x8b\x9a\x11O\xd3\xc09\xa3\xaa$\xc6\x7f\xae0\xce\x81K\xb2\xf1\xad\x9b\x8c\xe6\xd6\xd9\x8f\x9c\x01$X\x7f\xf2\xb9 \x96\xf0o\x16\xd9\x9fD\x1a\xa2 E\x10\xa0\xd9\xf2\x09\x89\x1c\xfd1`\x95\x97\x09XeC\x84\xabO\xaa\x80\xa0C\x1c\x0e\xfd\xa2K\xc7\x9aR`\x0f\xee=S\xa5i\x89D\x97\xc9H\xdfq\xb1bV\xa6\x83\xe8\xb0\xf9\xdfL\x0d\xf3\x02>3\xf8\xdd\x87\x85,\x7f|A\x9b\xe7\x9f\xaa\xd2s\xee\xae\xbf\x87\xcd\xad\x7f\xcb\x16\xd3\xb8z$\xdd\xef.T5\x12n\x1as\x85\xd2\xef\xb5\xc1\x0b\xe9J\xdc%\xb7\xd9\x1bP\\\x06\xa05K\x967C\xebQ\x9b\xcby\x0aB\xe9\xda\x95\xb4\xb0\xaa\xad\xa0\xc8\xbc\x09)\x8bzf\xaf\x87\xf4J\xc4\xc2\xe2]>\xaa\xdbd\xf3H\x18}X\xef\xd8\x87\x96K\x01'3c\xf0Y\xff\x84e\xb5\xc5\xee\xcf\xf7\x92\x14\xf6\xadk\x0b\xe8\x067C\xf5,\x10\xfe\xa1\x19\xeez\xe2\xb6c\xb6\x7f\x8e\x0ch\x99\x10\xa5\x02\xa9\x94\x84}=\x971\xea\x18GFK}C\x1c\xa8\xef\xd1\x1d\xdd\xaf\xa9U>,\xb6\xbe\x84\xd0!\xcb\xc6O\xd0\x81F\x18\x8cK\xf9@\xd2\xbbWO\xbf\xda\xd6\xc3\xe5\xdcx\xb5\xad\xe3a\xdb=\x84FWh`\xd7\xaf\xb3\xd0?\xb5\x1d\x19\xbdF\xcb\xc8u\xf2}5\x1d\xa5\xd0'\x01\x93\x96N\x15\x84Yd\xa1\xc1\xa0\x09}\x89\xf2\xa3H\"\xcc\xb9\x96$\x03{_\x8d\xc4\xf7#\xc7/-\xac=\x91\x8f\x8d\x11n\x9a\x02\xf4\xd6\xbb\xdc\x9a\xd7TY)\xea\xf4i\x1a$\xd7\xaa\xa7\x90\xfa\xe4\xfb2\x04\xd7\xf8R\xda\x14f6\x8fv\xa2MK\xe1\xa585%L\x91\xd8\x9f\xf3@!k+\xae\x19\x00\x92\xc2m<L\xc7\xf0C\x8d\xeb\x1d\xc6P\x1a\x98\xdc~\x9bm\xf5\xd5\xb3\xf5\xd4\x05g.2x\xf0n\xe1\x05\xf7a\xda\xb8\xee\xa2\xc0\x96\xa2\xf3\xa3;mk\xafE\xbf\x9f\x05\xd8r\\q\xa2\x13\x86\xe1h\xd1\xe3 \xa0N\x0c{uj\xd07T6\xba$\xda\xc6^\xa48\xdd8{\xdaj\x04g\x99\xc9$\xacTB\x17?\x90\xea\x80+\x94\xeb\xaf\x9e\xcf\x1d\x94U\x05\xb1u\x91\xc1\xd2\xea\xf7G\xe8G\"RI\x90\xd4\x18\x07\x87A\xae\xcc\xe0\xe8\xe2\xf0\xb5t\xc2\xd0\xa7\x8d\xaa\xb4]\xa1j\xab\x86S\xd5\xc0\xc8|\xfbN]\xbb\xf5.Hx\x19>\x98x&Eq\xc6\\v\xae\xe8p4bb\xbatx\xb0dx\xfb%:\xa1[\x97dI\x19\xeec\xd2\x89\xb21f\xf3\xe8 \xe2H\x88\x8e#\x15\x8d\x8c\xb2Z\x8f\x90\xf0\x11_H\xf6\xc4\xc5\xcb\x8bF\x81d\x8e4f\xfa\xe7n\xb1\x18\xff\x97\xc7\x17\xe3\xbf\xca\xdc\x91\xaas\"\x17\xa2.\xe1\x8e\x03_MX\xf3\xe1\xbc\xcb\x02\x91\x9c\xea\xb1*\xdf\xa5\xd34!\xed\xe4V\xeb\xa8\x81!\xc5\x15bh\xa9e\xfd\xd7\xf9R\x95A\x1e`\x84\xf9\xec5)\xb8S5\xd0\x16\xa0\x0f\x0b\xb3B&\x9c\xad{>d*T\xd4\x7f:-\x903fs\x9b\x7f\xb5JOO\xf3\x86\"\x94s\x01\xc5t\xf3\xff\xce\xf7\xbenAP\xc7\x9c\xd1B\x1f4]~\xacZ\xd1\xf9|o_\xf2\xda\xb9`\x02_FstR\x19\x1b\xb0\xb2vI?)VZ`\xeb/F`m<Y\xea6 4k\x9d\x99\xb6\xaa\xae\xbb`\xddl\x84\xccqA\xd3\xb9\xbc\xc3)\xc6>\xe6*X\xe6\xd5k[\x00G\x0d\x90G\xb2B\x07\x0d\xc9\xe2\x0b\xcf\xb3\xf11[O\xce\xe5\xeas\xdf\xd8\xe7\x9a\xe7P\x96\xcc\xc5\xfd#\x96A\xd0cZ?\xf8\xf5 \xfd\xab\xb7\xd7(h&\x88\xbb\x80\xee(l'\x9b\x10\x19\x08\xae\x1f\xc1\xf0\x86zp!\xba'\x9a\x0fN\x94\xf01,\xc5\xbb\xd6=Q\x1b\xfeY\x04[\x0f\x84 \x1ekW\x96\xce\xfd\xd5\x05\xffw\x85\xa9\xe1\x1ejW\xce17\x88\xbdL\xaa\xac9\xe6\x96\"`\x8c\xbb\xb2N\xdd\xfa4\xe6\xd8V\xc0\xee\x12@Z\xe6T\xa2\xca\xb3x`\xae?\xe9\xe6\xe1\xaa\x08*n k\x99\x9e\xd8\xc1-6s\xd7TEW\xb1I\x80U\xd3\xe8a\xa0\x14|{\xd7]\xee\x9a\xa3\xfc\x839i\xe4\x9bv<\xff~\xf5M\x9fm(hX\xcf)\x17\xcf\xe0,\x12\xfaN\xdd\x09\xa6#\xfdZy_\xae\xa7\x8c\x0e\x90\x18kb\xa4\xb9Y\x9a29h\xf1\xb0\xb1\xd5\xf4<\x09\x1b\xe8e\x1a\x0a\xea\x91lY\xb4T\xf0\xdf\xb4\xe9" +
+	"\xb9\xc6OF\x9f\x87\xfb\xe6\x9a-\xf1:!\xbb\xe7\xf7I=G\xc1\xf1\xe2\xb4\xd9E\x0a\xa9}2\x87t\xab+\x13&\x94b\xac\xeeZ\xa8\x8fx\x13\xf2t.\xd5\xd0s\xacV\xa5\x0e\x1a\x18]\x8d\xad\xe9\xcf\xc9\x98\xf0*-\x9d\xf6\xda\x7f\x82\x1a\\!I\xce\xf0\x15\xbcU\xb2\xb6\x93\xfd\x97\xaeQ\xac\xc3\"\x07\x16\x97\xf8]\xb1=_\x1b\xc0\xb8\x1a\x8a\x91\xf1i\xc9y\xf4\x0d\x0c\x91\xd2\x9c\xe5M\xb9Q\xf1\xcf\xd0\xd4n\x00;|\xa5\x80\x03\xd6\x82\xeck\x97\xb2\xe1u\xf6g\xcf\xc8\x10\x13Z7mgw?\x0dv\xcdC\xba\x0f\xc7g\xbd\x8e\x16\x94\x95\xfd\x8d\x99\xf6\xb57\x96\xc8\x10\x12\x87/\xc0\x1b\xb1\x08+\xe3\x0d<\xe4\xc5\x8b\x04d\xcbH\x87\x95!\x16+\xe6aE\xeb\x8f\xec\x0c\xab\xc3\xc6\xd2\xb6g\x84\x15\xf7\xae\xd8Q\xe6\xc5\xaa\xde\x858\x8a/I\x86\xd3\xfa\x02\x0d\xd9;\x82\xa1\x88\xc7\xa7\xe8\x02.\\\xcc\xe9\x8e\xfc\xda\xbf\xbf6\x1b\xd5\xf6\xfa\xe7\xb5\xb8\xe7\xfa$Y\x84\x8e\xa9\x04U\xfe%\x0d<D\x12f\xba\x84\x9b\xf1\x05\xfa#\xfe\xb4@N\xcd\xe3\x8f\xa9p\xc7\xc5\x17eh\xc6\x02\xe6s\xbb\xde\xee\xf5\xeb\xa8\x7f\\B\xa3\x81\x9c\xed\\\xddf\xd4\xe1\x17\xa8i\xdcU\xa9|'\x11\x7fj`\x88\xb69-p\x1f\x11\x06~/\xfag\x93\xefA>\xfa\x15\x1a\x98x\xc8BD\x9dC2X\xd8>{|\xbc\xff\xa1\x98\xdc\x09\x8e\xe1\x87\xc5\x92\x1a\xc3\xb4\xd8\x9dq~@\x1f\x03\x01IY\xad\xa6\x8b\x10\xde\x83\xc5\xf5\x17\x04\xbc\xe1U1\xba)C\x7f\xa2C\x85,L>\xe4J\x01\x19\xcaw\xd6\xa05\xd9\xda\x91\x0a}\x9a\xb1\x85\xb66\xce!#&\xf8m!;\x03m\x0a\xfc\xa5\x09\xb9qj\x96\xb2\xc7[\xd8;\xec\xed-\x84\x8e\x8e\xff\xa4\xfc\xc1\xc4\xc5\xde\xc2\xd9}\xcbC0\x17I\xff\x10\x15\xd1J\xc7;\xb1\xeb\x1dq\xab\x11tgb8\x8c\xec\xc7\x99\x91>Gu'\x82e\xe2\xf7\xeb\xae\xc7\xe0\xf6\x87'\xcf_\xbf1\xc0\xcf\x0e\xf0\x80\xbf\x8d'1\xe6'\x9b\xaa\x83\xc9\xe1KB\xaf\xc7Z\x14:ob>\x93\xed\x10\xf6\x15C\xfb\xaa\x88\xf1\x8e\xe5&\xcd]sgQ\xbf\xddLcK\x18\xa2\x0e\xeb\xa0\"\xc3R\x9c)\xa5)\x94I=\x12\xcdx\xa4\xe1\xac\xc0\xae\x03=l?cG\x81\x06f\xc4\x97\x07e@\xdc-\xa5|>\x12\xf8>\xfb\xe5\xb7ekN\xa6w\xce\x9e\xb6\xcb\x02s\x04\x1b=\xd2\xaf\x18X\x86\x9a6\xf8\xee\xe4>\x91vf\x9c\x03\xab\xc4O\xaf-\x0a\x84\xc6\x9f\xed\xbf\xe8\xbd\xcb\xb8\xd8\x0e7\x1b;\xc8\x17\xa5G\x95\x84\xbb\xbbD\x0eE\x8aajI\xe9^\x9co\x0e\x15>1\xd0\xa3\xc9\x8f]\x04\\P\xdc_\xeb9\xcfU\x0f\x17\xcd}o\x81\x05\x11\x16\xbd.\xb3\xf04\x163\xae\xf9y\xf4he\xb6\xd5S\xc5\x19F\x05\xe3\x11>)\x16\xdd\xc6Xn.\xf5\xe5\xe1;\xac\x8ba\x8b\x8b\xd3\xb0\x9a\xf2\xaaH{\x8cV\x89\xd5\xe4Xm\xe0\x8d\xb5\xb57#\xbd\xf3\xb9\xbf\xfb\xa0[cC\xa5\xc1\xc4\x08\xb9\xc7\x1c\x91\xccZ&\x16^$\x9b\xb8T\xc3\xd9\xc5\xe9N\xd5\xfb\x8b'\x0e=\xdd\x0f\xc5\xe8`$.\xca\x8e\xc3M\x1c\xf6VJ5\x8e,\xed\x8b\x88\xb1Z\xd0\x01\xa7\xa6\x14\x13\x94\x96O\xc4\xb7n\x94G\xd80\x18>:}\xa6\x9b\x0c\xf3\xe7z\\\x96sf_\xa0\x816\xaf\xc8}R\xfcOW\x10\xaf\xeb\x03\x97\xc6\xefS\x18\xd3R\x1aI\xf2d\xdf\x16\x1fp\xc3\x13oE\xfeN\x11f\x9f\x94C\xcc\x1c\xa9,\xd4I\xdef?Y\xd7\x85\xab\xa6c\xe6x\xbd\x09\xcf\x97DL\x92\xa0mT\xd6\xa4\xcdxp\x88\xc7\xea\xf9\x12\xe8\xa0\x0f_\x9d\xc8\xd4\x0ek\xa6\xf1\x939\x13D\x8b\xee\x9e\xe8S\x15&\x91\x85\xd1T\x1c\xc7X&\xb4\xac\xc3\xe0\xfd\xc7\xd6\xb5\xc5\xfb4\xad9\x8b\xa6\xcdN\xe0H>\xa8\x953\xcc\x18\x81[}\xd48\xdb\x9b\x8b4\x8f#+q\xe1-\x02\x19/\xbe\xbe\x92\x8f\x0a6U\xc3(}1@\xdd\xa9v\xa5\xd1\x023o\xac\x95\x08\xe8\xa5\xb3\xe5{H\xc2\x92\xb5\x0dL\xe2\xee\x1dU\x91\xb6p\xd5o^\xa4\xc9%\xb1\x9b\xf4\xb1&\xe2&S\xccY\xe9\xa1\x10\xbd<A\xefL\x96@\x1dT\xb3\xe5\xd8\x83\xe1\xee\xa4\x0e\xd3XudBb\x18\xac\xf3M\xe7\xfab\xa7TSl\xc3=)>\xfa\x12\x02\x02\xbd\x84Z^$t\xc8]\x0bM1\xe2E\xeb\x1e\x09\xa6.\x8d\x82'\xb9\xec\x08\xe2\x12Y*\xfc{S\x0d0R\x8d\x1d\xf4\x18\x03\\\xef\xb5\x97\x1b\x87M\x84{\xdd\xc3g\x88\x94\xe4\xe0\x9a\x18qv0C\xae\xe9 \xee\xc8m\xde'\\\x93\xf3\xc3\x88\x9f\x94?\xc6\x0f~\xf99\xae\xf1\xadyH\x00>\xe6\x8f0\xe9\x90\x0f\x1eF\x90\"\xf3\x07\x15\xc4\xbfy\x95'mu\x820\x84\xa2\xbe\x1b:\x98\xe2\x0c\xe1;&\x9f\xc9F\x0d-\x8ct\xee\x98\xef\x19\x1d<#\x82\xc1I\xc1\xd8\x10\xd1\x17\\\xbd\xc7\xce\xded\xac\x06\x7f\x09U\xe4\xcb\x00\x10#\xf5\xfa\x08v\x807\xaf\x13F\x99\x83\x91\xc3'\x0fhg\xa1.\xa5#\xdfp/\x99\xcf\xef]^\xec\xfd}\x1c\xc9\xfe\xec\xbb\x8bo\xa3\x06j\"\x8e~\xb0e\x98\x84\xc6\x1b\xe0\xec)\xa1\x13\x8e,\x9dL\xa3\xe3\xb3(\x09\x97D0\xa8\xa6\x1aw\xd1^\x8e'\xe2\xaa\x09\xfa\xe6M\xb2\xa5t6\x9e\xb3C5\xebO\xa1\xa5\x0a\xc0\xf5P\xa8\x8fJ\xbe\xc6\x8e\x92\xe9%\\\xdc~)\xd9\x9e\xb3\xd2FP!\xb4.E31\x1e\x91K\x01\x0e6\xd9\x8d<\x1cY\x86\xfb\xdb\x84\xbf\xd5b\x1c\x04\x08D)\x89U\x91C\xf1\xdcGADP_-\xc1\\\xd3K\x0e\x0b\xca\xdfI\x99U\xa3\xb4=;\xb1#\xd3w\xda?\x80M\xfd\xcd\xdb<\xffm\x8b\xfb\"%M\xe9\xa4\xe4\xd8 \xfe\xe7\xe4cr\x19\x87\x0d\x93\xef\x8f\x88\xbaI(:4\xcc\x9d\x96\xe6I\xa23?\xb35\xb8u\x94\x7fP\xf1B\x9a)U\x99\xfa\xd9Qe\x83\xea\xf1b\xb2\xff\x0eh\xe8\xf5L\xd3\xc6\xf9\xc3\x88\xa5\xcb\xbcg&\xb7W$Z\xd6\x00.T\xa6\xa4\xf9\xc5/\xb4$\xda\xfcl-\xda\xfb\x08C\\\xbc\x88Z\xbb\x9a/{\xab\xbe\x8e\x98\x00KBb\xaeG\x12\x97\x81\x9f\x98\xd0\"S\xaa\x17|\xc7\xbbE\x124\x87\x9e\xa8J\"\xd37sA\x08!\xef\xae\x84\x0e\x1ck\x9f\xcc\x1e\x9d\x9a\x85|\xf6\x97\"Z\x9a\xe2:M\xbaY'5\x85\x06\xe3\xbb\xd8?y4S\xd9\xaa\x8a\xf8\x0c\xc6\x8dH\xc8\x9ej:hu(X;\xb3S5\xbb\xddD\xbc\x01f\x1e\x13\xe3\x99\x9f\xdc\xf9'\x09\x1fk\xfc\x9c\xd9\xfeMbJ-z\xdb\x8e\xb1\xa2\x92\xfa\xfe\\u\xbe\x11\xb6\x0aA\xb0(|\x06\x8f\xbe\xb4\xf2\xdaJ&\x88\x9a\x92\xfa\x91:\x85\x85xk\x7f\xcd\x8b|\xab^\xe4\x07\x8c\xdeGRhFDh\xbc\xf0(\xff\xd8[p\xae\x9cZi\xa5\"\xf7\x07t\xa9\x98\xb6\x8c tq\x92\xe3\xc7:\x90\x0c\x1e\xd6\x85\x07\xb7\xe1\x9dh#\x97\x01\xfb\xec\xa6\x1ej-\xbe9\x18V\xc6p\xa2\xa17\xa1\x1e\x8c\x05\xe1,jE\xd9\x01\xa3\xdc\x01~\xb4\x97r\xbe\xcd\x01:\xf0@\xeawXE4EK\xa9\x9c\xa8L\x1e%\xe0\x0d\xe3\x95\xf1\xcd\xcc6\x1f\xbd\xcf\xa2\xaf\xb2\x90h\x09\x03\xd8\x82\x024\xb8\x8c\xe5\xb7\xcc\x91g3\xe1\x84\xa2\x85{V\xd8,T\x91\x89\xacu\xb9m\xfe\xf7\x9d\xce\x9a}tU\xd8\xff\x99\xaa\x01\xe3F\xdc\x91\xc5H\xf8\xda\xfda&\xb3\xbav\xf7\xd9\x8d4\x91\xc6b\x0aLH\xd9$t3\x91\x8b$\xcdt\xc8\xf2\xecH\xb5\xa84\x1aG\xb9\xac\xb1\xd46\x90\x94\x1d\xb2\x97\xd1\x0bR\xf4G\x1aj\xe7\xdeN\xbe\xa3\x17\xefI})\xbc`-\x8ae\xe9\xed\xccB)vx\xc6\xeeM\x9d\xdf\xc2V\x9c\x1c7\xe2\x81\xc5p]\xec\x9fb\xa4\xa0\xa2L\x85\xdf\xd0\xe6}\xec\xb8\xd8\xdb\xab\xbd\xbc\x90\x86\xb4\xd1\x0f\xd6\x91Z\x12\xeb\xdd%\xaf\x1c\xceptNJ\x0f\xc5\xc9\xb7,\xb4\x1f*P\x8f\xf8\x93\x8f\x92\x0b\x03\x92p}\xb2jKb\xc1>\xb9\xce\xa1\xf1\x98j\x82\xb7\xcf\xaf\xa0M\x96gr\xd4\xf5\x0eK\xc0x?\x14~ R-\xa9\x0a9p%\x80K\x11\xff\x11\x82\xd1\xf9A\xed^5W\xb4>\x93R\x15|\x14)\xddL(\xac\xb0\xd9\xeck\x95So_<\x0c\xbd\x91\xe8\x1aS\xfcpg\x123yR\x91\xb6\xc8\xaaut\x06/kx,\xd5\xbbE\xac\xb5h\xb3R@s\x0c\xddC\xff\xd4\xad\xd2T1K\x0c-\x1d\x0f\xbe~g\x0dZ\x1a8\xc2\xa8\x8bA\xab\xd8\xe7O\x91*\xed\x14&\xad\xcd\xd7s\xa2Hs\x99\xa9,\x0f\x17\xc2\xa6:\x14A\xb7\xfc\x8c\xa7\x97g\xc8\x0bSF\xf0\x81U\xfbV\xcc\x95\xf7\xed:\xdc#e\x0b\xcf;\xf7ATW)\xe3E\xc3\xd5/\xeb\xb3\xe2e\x92_mI!\x0atg\xa2\xd9\xce\x1a\x17\xa5o\x8a\x9d\xd9\x02R\xf8\xc9>\xdf\x1d\xa8\x9a\xe5\x1b\xffkd\xbe_\x9av\xb7\xae\x86r\x86\x0f0\xdc\xe6\xf6\x90\x0dXY\x13\xf40\xbc{\x1b\xb7\xb6\xf727\xa1\x9e\x9e}\x8f\xda9\x02F-\x06\xb8\x05\x14n\x11\x1a{\xa3\xff\xc7\xafe\"\xcb\xfd-\x8b\xac\x18\x00\x05\xf3f\x0cg\x96s~\xc4\x8b\xc7\xf7\xb3o\xc6\xcd\x99\xa14\xd6\x1d\x8fW9\x9b\x96\xe6P\xb9\x81q\x8f%}6D\\\xea\x09K\xb1\xce\xb5}\xf7\xeb\xd8\x96\xdf\xbe~X\xd5[\xc8\xa6\x7f\xe6*\x05h\xc1\x11\xafYB\x00\x93\xe2\x92#\xea}\x97\xb8\x0e\xfb\xf8}\xcc\xdd\xf6\xf7\x9a\xdf\xfe>\xb9\xdf)\x95^q\xbd\xa4\x97\x9fD\xae\x90>\xd0\xa6\x9d\xe4\xfb\xef\x05e\xd7-r\x0a\x0c\x93\xebK\x18\x8bR\xf1%\x8c-\x91\xdb\xa2\x8c\xa8};\x9b|\xff\xce7\xd07P\x17'/`|JQ{\x18s\xda\x1b\xca\x19D\xd7\xbat\xf4-7ym\xc3?\xcfD\xa1\x98XC\xb4\xf6\xe2\xa1UB\xaf\xff'\xb2\x8dF\xfa\x84\xbe\xa9J4\xab#\xfd&w{\xad n\xec\x9eL$\x14\xbdT\x91\x8a\x9f\x16\xa1>\xbc\xd3\x84\xc9\x17\x1a\x8d\x00\x13\xf2l\x12\xcdP\xd9\x19\x930\x0c\xf5\xd0:\xa8\xf6\xad\x86\\\">\xf3Pt\x8b\x01\xed\x0e\xe0\xb3M~,\xad\x91GS\xdf\xdc<]L\x8d&\x83\x9b\x1c\xc4\x19*:\xa2\xe5KWt\xbe\x01\x9e\x0a\xbd\x92\x09\xf1}\x18\x91\x0b#\xe55\x0c\xa1\x99\x1em\xe6\xf9dT\xd2~\x81\x82\x82F%\x94O\xd7\xf8\x8a[\xa2\x98\x91\x9bH\xb0\x0d\x9c\xab&s\x95+\xa4\x14\xfa\x91\xd47\x96n\xf2\xb7\xea\x9f\xf1\xf6\xa1\xb2#\x13\\xs\xec\xef\xb3\xc3\xfb\xf7\xdd\xc3n\x9e\xde,%\xd88\x08\x89\xa6\x13\xf4I\xf7[I\x17+v!v\xd2\xf1\xaf0\xd60\x18\xcc\x11\xc5\xce\x8cV0\xa2\x80\xc8\xd8\xcf8\xd8\xd1\xdf\xc8l\x84\xd9\xe7)\x07\xf6\xe8k\xeb~2\x9f\xfez{\x9fm\xb4w\x80\x92IW\xfb3\xd4G\xfa\xa5\xce\xd4\x085 }\xfc\xde\x04\x1d#\xc5<\xe3\xad\x98\xefk\x19\xd8\x99y\x18ce\xe2\xcf1\xa3\x176\x02\x94\x17\xa0[\x85\xb3\x0eO1\x0dK\xf5\xf7\xd0\x97\x94A\xe8oa\xb2S1/\xc8\xd2\"a\x98\xe2m\xf6\xc5\xe8o}\x19\x82\xe2J\xbcEUL\x0d\x9d\x98\xd0\x00L\xfb\xd9\xfb\xf2M/\xae/\x05\xbe!\xcc-It\x88C\xe84:d2\xc4\x9bp\x94N[\xce\x1c\xe0\xa5WC \xac\x1atSe\x06\xb1\xd9\x99\xfcm&\xbf\xd1\xf9\xff\xf9\x90=*\xf6g\x04s\xaftaz\xaa\xf0<\x9b\x85\xa8[\x9a\x11F\xce\x05\xbf\xcc\x03clqSId\xf2\xc7'\x9f\xa0\x83)\xee~\xf6\xa3UQ\xc4)x\xc7\x190\\Rm5AC\x03\x83O;\xeaS\xed\xaa2R\xe2RO3b\xa2AC\x16\xb5\xbf\xe5D\xb8\xfc\x85\xbf\xd81\x97\xa0\x9bH\xf3c\x8e|_\xa6O\x9d\xd1\x87F\x7f\xce\xb0\x84\xce%6bvt}\x99>\xcf\xa1\x0bq\x9d\x9a\xe7b\x89^\\t\xb4\xd3\xb8\xfe\x0c\x93\xa9Gv\x0c\xb3\xe3eu\x88\x7fac\x14\xfa\xf8\xa7P\xd6\x06\x83\xeb\xcf\x0br\xb6\xc9\xd31\x88\x0c\x9d\xe6\x0d-\xdc\x92\x96\x9b\xe5N\xec\xa0\x87\xe2\xe4`\x9c\xa5\xfb\xcc\xc5\xbc\xa7}\x86\xa1\xac\xbd\x1e\x13\"\xfd\x83\xef\xb7n\xf2_cH\xb9y\xaa\xce\xf3\x8f\xa9s{\"\x00\x08\x03\x96\x99\xc5\xaf\xea\xcf\xd5-\x0c\xba\x8f0\x07\xdb>\x15u\xefK\x82[4\x15N\x1f\xed\x8c\x04\xe4\x14\xa3\xa6\x0b_\xe0\xe8\xec!\xc9\xf7\xa4wg-\xa42\xd1\x1e\xa8a\x80K\x1eW)f\xf1\x1d\x91\\D\xef\xfcw\x0e\xbb\xdc<}\xb9\xe8\x00\xa4\xfcTZX\x8d\x94%\xb1\xbcZ\x09\xd2N\xf0\x05\"\xf0\xe3\x90\xa7\xc4\xceP\xbavF+\xc0_\xcf\xf8\xbb\xa8M_\xa0\xbe\xf7\xcdL/d\x1e\xd2\x90\x9c\x0f\x10Vf\xb7\xc4\xa5\x8bA\xce\xbd\xc6\x116\xf0\xeeM\xed\xa0\x87\xe9\xb0\xde]\xafl\xb1\x95\xca\x0f\xcck,7u\x8b\xbf\xca\xc8y\xbd!\x07\x11\xb6\x85\x0a\x0bdLv\xb3]!,>s+\x9d\x14\x90~\x1d\xb1t%\xe7\x19\xdfNU\xb0\xceU\xebp;\x0eG\xc0FW\xbe\xc9L{>\x1a}\x7f\xcfP9\xa5J\xe6\xecQ\x97Q\xd0\xa5W\xb3\xfd\x9d&\xb7\xd9\\\x88\xf4vTD+\x14\xe6\x93g\xa4[<\xe2\x84\xddB\xf1u\xc5\x005\x8c\x19\xef\x0f\xbe\xc2P\x95-\xb8\xf5\x15\xd9\xba\x8b\x0fI!\xd7v\x9eD\x8a\xe6j\x1d\x9ak\xbf\x12 \xbay\xaa\x9eP\x08q\xf5.\x16t\x19n\xa7\xf8\"K{+M\x11\x9a!q\x11hT\x87\xbc\xef\xb4\\\xc6\xf5\x8e\x13\xc1\x8aw\xc0\xbb)\x00\x1aoI\xad^\xb9\xb1a\xacv\xd0\xc1\xf4m\xf1\x1e\x09W\x93\xfb\xcdR\xbc\xbaiG;\xc55#O\xce\xfd*|\xe2\xe6\xe6\xe9\xb5\xaa\xc5\x05=\xear\x1e\xbfH\x95\xcc\x1d\x14\xeb\xc5\xd4\x06-\xb4V\x1b\xfd5\x0b\xfe\xe6f\x0b\x93\xab\xad\xc0E\xb6pb9\xc8\xf4md|,/\xf7^\xda\x1a\x01f\xbf\x8f\x92\xd5\xcd\xcd\xb3G\x8c\x03\xc3\xa0\x99o\x17\x1b\xa4]\x1f\x9c\xcc\xd2g\xa0\xc5\xd5]~\xa5'RR@\xad\xd5\x0eCz\xe6\xf1\xcf\xa8\xec6Z\xf6<\x9e\x98\x02.\xa5Xx\x86O\x8c\xfe6E\xee\x0c\x8f}\x02P\\\xf7\xa0\x9a\x0a\xcdw\xe8\x19^\x17e-\x18\xfd\xd2y\xc5\x1dg\xa9Z\xcb*\xa2\xd0\xc2t\xac7\xc8\xa6\x83\x83\x02\xf7\x80O\xb0\xc4k\x91\xabGI\xf1\xb9\xd5\xfb\xdc\xa0\x16\x9b!\xd2\x9c\xd5\x96\xe6L\x13\xfe\x95:\xe0\xb7B\xa5I\x9f\x17pI\xbc)\x0e\xa4Sm\xac\xdds\xd3\xfe\xd6\xd2\xd1\x11\x06\xf6?6\xc6\xf2E\x12vW\xd2u\xd5\xe8\xc6\xff\xeb\xef\xc9\x07pc\xf8\xfb\x91\x7f\x9b3E\x14\xcb\xd3\xcf#\x0b\xa0\x91\xcb\x1d|m\x18N\x14\x85H\xd9L\x81\xde)\xeb\xe77\x83\xff\xcc\x7fL\xa5:$\xb7>#kZ\x87\xdd\xa72_*lC)\x98\xa6\x1a\\YC\x96O\xa2\x1aE\xaeE\x10D\xc8\xe3\xcb\x13on^^\xdb\x0c\x18\xeaL0`\xd1\x0c\xbd\xadZ\x18\xa3Y)\xa9\xb5\x98\x96#\x95;\xab\xd7\xe6#\xd0+\x85\xbe5\x9d\xeb\x99MM@\x0c\x0e\x8a\xb6j1\x19\x1a\xf1,\xca6\x9dj\\\x87\xab\x1cn&\x93_\xe0\xffr\x86\x9c\xec+\x03\xb7\x1b\xbd\xf7\xeaa\x1a\x03\x86\xa35\xe7J\xdd\xb1C\xad\x9c:\xdf;%\xe8\x83\xd9\xb7-u+\xe1F~\x07\xba\xd6\xd9+\xd8\x89\xb6\xbc_+{\xf7\x03\x89\x8fnn^'5\xf8\x8aN\x0c\xdb\x994HC\xf7\x07\x05g\xb8^K:\xc8\x91l\x853M=\x1cd\xdf\x8f\x1f\x17,\x07l\xb6\xce\xf46\xf1\x1bRj\xc9\x99\xa3\xa1\x86f\xc3(\xbeQ\xa3X\xf5\xea\x9a>eY\xc8$=\xb2Z\x95\x9f\xe1\x1e\x12\x02\xb0T\xb3^\xb4\xa9\xba\x13\x1c\xf1\xa7T\x02\xa6JX\xb4\xfe\x8c\xe3\xce~\xc5\x08\xf7\x1b\x0c\x1877o\xadw\x04\x8d_|\x93\x8c\xef\xea\xc0e\x7fQ\xa0\xa6q\xf81\xba8G\xfcY4w\xe3\x1d\x0a\xcc\x96\x90b}xKU\x17\xbf\xea\xee\xa6;Z\xb9\xae?\xc3\x91\x7f\x17'\xd7S\xccn\xaa\x86\x1e\x9a}\x1a\xda\xb2\xaf)\xb7\xe8\xef\xed\xab\x85\xc6\x8c\xd9\xa3\x98\x18\xfa\x93\xbbL\xbas\xee5\xceg\xef\xc8\xceE\xb8\xbc\xbf\x17\x9a\xa5\xa55\xa3\xbc52\xcas\xc3\xd8\xce\xe3u\xc3Oc\x14\x83" +
+	"\x8f\xc3z\xc5\xb2y\x16\xc5L\x929\xa6\x98|\xcc\xac\x1e\xf1g\x06\x08,\x1f9z\xd1O\xb3\xd3Y73M\xe1\xa6O\xb1t\xd2\xa1\xcc\x12z\x8e\xddn\xb4\xe9f\x81\xb3*&\xdbt{.\xe7\\?Z[@\x97\x17mxl\x02\xf9_\x7f\xea\xb3\xab\xf5\xe1\xcb\xbc\x98\xa2\x06\xb7J\x85\xb9\xc4\x81\xb9\xcc{i1.\x87\xe8g6\xd0\xdaO\xb0O;8\x86\x1f&\xa1\x1fA\xe1\xd1`\xa6q\x8f\x82\x09\x19\x0e~\x1d\x01\xaf\xd5>\x0f\xc0\xbf\xdf<S\xf3z\xf7\x13Y\x18V\xebD\x8b\xb7\xa2\x959\xd9\xa9\xa6z\x9c4\xe3b\x022\xa2\x84\xf3\xaf\x14\xef\xeb\xd6\x91\x99\xba\xbc$\xe7\x0ec\xf4\xf9\x80\xecj\x86\x92w\x0c=\x8aE<\xfc\x055\x1b\x1c\xe4\xfa\x1f\x96\xb0 {\xd9\x9c\x8d9\x99\xff\xdbr\xa97Q\x8e\x7f\xb3I\xd0A\x8b\\\xac2\xf1\xb2\xf4/\x93;p\x02\x98\x03\x98.q\xc0\x14\xb1\xd4A\x93Pc\xe3\x9c\xcd'\xec\xdc\xe5\x90\x1am\x94DzH\xa5\xf6M*\xf3\xbe8\x01C+\xe0Ls\xda\xcc=\xf3\xda\xae\xa9[\x18mQ\x9c\xfaG\xa9{\xcf\x8d.\x9d\x84\x7f\xcd|\x90\xd2l\x1e\xdah\x93\xe2\xb3\x85\xc1\xd9\xb0-}k\xfc\xebx(\xcbuZ\x16P\x11\x1d\x0bi\x9fmt\xc4\xab\x88GF\x98\x8f\xdc\xfb\x98\x04\x92KW \xb5\xe2\xa4\x15\xca\xf2gJ\x86\x96\xd1\x97\xda]\xc4\xdf\x87~\xbf\x18\xcdz`\x09\xcfM\xaae-G\xa0\xeb'(>\xc3\xf4%z\xc2Z\xe7h\xe4`\x97\xca\xc7SR\xd9\x95{$&El\xe8\x09>A\xc6\x0c\x1a\xa6\xa4\xd6\x84\x92'\xdbU\x1d\xde\x16y\xc5\x17\xde\x8a\x84\xff\xac\xb0\xaa\x9b\x09]\xdb\xe3\xca\xbc\xc0$a\xc5\x94[\xcff\x04b!%\x09\xbd\xdb\xb2Z~\x97\x0cx\xd1\xc1\xe8\x90{\x94\xd3\x80D\xe1A\xae\xf9\x02u\xf0~\x16\x96\x0f32\xd75,\xc6*\x14\xcc3\xad\xef\xf7<\xcd\xe94\xd6\xe2\xf69\xb2m\xc9\x12Y\xa4\xf0\x8d\x15\xa0\x1bs\xe7\xcdLa3\x0a\xa7\xe1H\x88\xd6f\x01Q\x9d\x94\x85\x9c\\\x03\x17\x1e\x19\xf8\xe4\x85\x12\x81ERm\xe6_\xd3\xfe\xa4/\xcb\xc2\x9c+\x8e\xb6j\xc5\xabY\xa7\xf6\xa1\xb9*#\xadH\xc9\xc9U\xf3M\x12 l\xc1\xe3\x814\xc1\xc9\x91I\x82R\x89\xb9RU\x08\xab\x83o\xe5`!N\xea\xce\xcb`)\xb3g\xa6\x11\xab\xa4*\xdcC\x94\x19\xc5\x0e\xe2\xee\xd9?v\xe0\x1e\x8bl\xbcy\x96\xf0\xc8m\x09\x11(n\xaa\x85\xc6\xe3\x044\xf1\x8d\x84\x8d\x9b\x92\xa4\xda\xa1tS\x0a\xfc\xd02\xca\xe3\x1d\x9a\xfcq\x93\xcf\x93'\x13\x8b\x86}B\xab\x19\xe5\x97,\xa16^\xf3\xc9\xf5\x13\xb8}\x8e\xf4\x92PB\x94\x0f\x9e\xd0\xe8\xf1\xfd\xf9H\xbf\xf2\x81\\R\\]\x1a9\xa5G\xf8\xe4\xa9@wc\xa0~K\x949*\xc3G\xc1X\xa6m\xfaT\x0d P\x99DgP\"AH5av=\x8b\xca\xd4s\xb7u\xddE2\xf8Q\x87{9e\xf2O\x1e\xc6bg.\xdc?.\xe8\xfc<\x01\x025\x0f?\x13\xe7\xe7!wr\xa6\xbb0'\xd3\\S(\xa1T\xa9e\x98.J9h,\xad\x8e\x7f\xa7\x1c6s\xd6\x84_L\xd5\x14\xad6D\x1dPM\x02\x87B\xc9\xe7Lf\xc5\x03\x0f\xfe\x18\xad\xc8z\xca\xe0c\xa9\x9aY\xb0|\xf8\x7f\xbfT\xc3\xc5\xdfn\xc4\xabR\xe1\xfa\xe6\xeb-\x93\x19m\x84\x888\xfe\xa4ke\xe3-\x9e'\x13{\xa1\xdcMlx,x\xf3c\xcf\xe3\x1a\xfa\xf9\x0b\xd4\xe0R\xa9\xd6pUT\x9f\xf4J\x0aC\xa2\xe1\xf2f\xc1|B\xb5\x06\x15o\x9b\xcfr*\x0e\xcfw\xa8\x8d\x96l\x9b\xf2\xdc\x1e\x89\xc7*\xbf\x12\xf1\x18\xe0\\qh\x92\xf8\xb3\xdbo\x80\xe9\xe5\xfb\xca\xad\xc0LLK)\xa1G\xf3\xf0\xdfD\xd1l\"\x11\xdc\x92\x9e,#\x1byrA\x8c\xa8\\\xaa\xbe:CS4\x15^\xdc\x1f\xd4t&\xf9\xcfD\xfa<l\xd2\\\xefFA\xde\x94l\x87\xcc\x06\x91\xe1\x8f\x05\x9c,\xfc\x1c\x92\x1dRc\xd4\xc0}D\xc1\x8a\xe4\xb3\xd9\x1fYl\x0dj_4U\x87\xe5\xf3\xac~\xbb\\\xbb\x1b\xfd\x85`\xce\x14=|\x1e\x15\xd7\xa4\xe6 \xcb\x16\x8a\x8e\x0e\x07\xc3\xe7\xe7\x0a\xe9\xd3i\x11\xf7\xae\x81\x09\xc2!\x04\\jJ33\xed\xa8G\x1f\xfel\xfd?\xd6\x06x\xfe\xea\xa1\xa3\x8f\x86\xf2\x02\xcf\xff\x02x\xd5R\xcf7Q\xcb\x8d\xae\xcc\xc4\x00\xcc\xb7\x08\x10\xb0\xfe\x05\xde\xb3\xfb\xc5\xa8v\xa2\xfe\xa2!8\x82\x8c\x1f\xf5\x1f\x84\xac\x0dO8\xd2O\xfc\x03\xf2\x81\x1f\x87\x88\xbd\x9d\xc90,\x0d\x87\x85\x88\xd3\x1e\xf8\x98\x88\xf8\xf3\x07\xe2\xfbco\xabT)V\xa6\xeb\xff\xcb\x05\x02uj\x80?\xc0\x0bq'H\xaf\x9e\x00\xc3\xdf\xca\x1e`\x04\xa7\x8d\xf5\xb7\xa06G\xd6\x85\x94>d\xac\xce\x8b\x01\x9d\xdfDs|\x13\x85\xbe?@\xe4VY8\xdeE\xac \xd1\x00K\x98\xe0\x84\xff\x84\x9e{\xa9JW\xaf\x07\xcb\x9f\xbf\xcd\xcbA\x96\x1500B0\x87\x1e&j\xdeG\xfci\xf8,5o\x81\xc6j\xdb,\xe6\xc3\x9e\x08_CD\xbfp2P\xd8]\xf8\x90\xc8 \x8f\xdf2\"$\x81\x00V\x9d\x87\xf8\xcd\xec\xd4_\xef\xfd\x17O\x97HpWr\xc9\xf6\xf6\xb1F\xda\xe5\xde<\x0dP\x82x4b\xa0*\xd9$\xd0JQ\xeb\xa0t\x18\x06}\xef\x9ac\xf8\x81\xfb(\x86\x80g\xfd/\xe6x\xb6\xa3\x13\x1a3G\x1d\xa9\xd3\xa0\x1d\x8d\xb1\xb1\x94\x9fN\xad\x09-\xc3N:xG\\N\xe2\x0d&\xb6\xc3>\xa7;\"V\x96\"\xe1\x8fX\xd6\xd7\x99\x91\xa2\xd0@.\xcd\xfb\x9c\xb7\x94\xe6\x83v\xbf\xe6=\xd7\xc7\xfa\xe6!3\x1e_\xf1H\xbfB<jp\xe3\xe4\xbe\xc0\xde\x00\x8f\x98\x8b\x85bz\xe2\xe7\xe6\xe7\x0a\xcd\xf1\x84\x1cZ\xbe\xc7T\x00\x86\x927\x86T-\xfcb\xa9\xfe\xa7\x81\xce\x15;*\x9555w2\xe4\xa8%C_\x89'\xf4\xe2\xea\x15J\xe4S5\xf8\x9e(rR\x8a\xe7\x87\xbc\x08\xbdG\xc7\xdfjH3AU\xdfe\xf6\xcf\xf4\x08\xa9o\xe4=?\xaezx\x02\x81\xcb\xd8\xbaS\xce\x1c\xe3.\xac+\x0c\x1a\"\x86\x85\xad\x95:E\xb1\x8bj\x8c?\x1e!\x1c\xf5\xdbW\x0f<\x99\xcf\x1c~q\x17?'c\xd7\xd7\x86\x16F\xc95Y\xb5\x82\xacFA\xca\x1d\xa8\x09\x8c\x87\xce>\x93\xd5\x18ni9^\xb9^gp\xf7\x91\xf2E\xaa&\xb0\xa7\xc3L\xe9S\x80\x87)]\x89\xd8\xfc\x93\x8fLhBn\x86\xd1t\x1e\x97\x8d^\xcb\x95]\xd2\xda\xbe\x01\xce\xfe\x18~\xc8,H\xf5\x14H\x1f\x81A\xf9ao\xfc\xa3\xd6\x84\x8d\x91\xedGANH\xebl\x07\x84\x07u??\xddg_\x9d|\xfa\xd5d/\xde\x16\xb9\xe4\x05w\x85\x05\xe8Z\xf9\xf6\xcd\x8b\x17_\xa5\xa0\xff\x90\xa3\xfcKx\xe7\x87\x1e\xdd?\xf4g\xd77P\xcf\xc2Js:\x8b\xd0&\x91\x11\x8b\x102\xd7\x8c!(\xbc\x9e\x89{\xf1\xf2QKiW:J\x94b\xf8\x1c\xc7m\xc2\xa3U\xd1\x0fL\xfc\x19\xc0\xe2\xb5\x10}\x93\x0c\xe2\xb5\xc8\xa0)\x0c\xa1\x07\x8b\xa5s\xf1\xfd\x83\xf8?o^\x98T\xc9\x0a\xeb\x8f\xf2\x1c\xf4\xebQ:\xdc\xf1\xcfxuu\xf6\xcc\x9a\xb4\xc3l\xcf\xdej\xc7\xf9\xd6\x8f\x92b\xda\x99\x9c\xd1\x9e3Cb\xac\x1a?Uyosi\xa5\xa4\x0ak%\x93L\xf9\xe2\xf9\x1e\xbe\x15\xa9\x92\xef?\x02\xc8\x15\x85\xbb\x85N:y\xa4L\xaaT\x8cn\x18\xef}\xe3\x07\xe9\xbf\xf0L\x9b\x06\xcb<M\xba\xd8%\xb1\x85\xab\x92\x187Q\xccz\x11\xbd\x1e\xa8\x0d\xc2\xd3\x89\xdeo\xc7A&&<PD\xd2T\x0d~\x0c?\xc3\xc7J\x0e\xa5\xe6_\xd4(q\x103\xe5\xd7\x15\x0a\x1f\xf0\xf6o\xd3 ,\xad\xe8\x16j74\x86\xe9O\x91\x89\x02\x08\xbcg\x00\xbc\xf9\x1b\x17\xc1\x9a\xef\x08\xb8\x96\xf6M\xe1\x94\xb9\x12K\xce\xead1d\xb5\xfe\xfe/\x9f^\xdd)V\xbcg\x86\xc7.8\xc6\x09}\\X\x84\x15oot\x02\xed\x9a\xca\x11+*\xddn\xbfy?\xddS\x97\xe4\xf8\xb3H\x83\x8a\xbe(;/\xd3\xfe\xd2\xdb\x9b\xba9\xbd\xc4\xb0\xf5\x08\xe36Y\x83\xb1\x11r\xb0G\x052\x86\x90\xc9\xb1\xc0\xe7\x12\xd5k\xee\x8d\xc5u\xe5\xac]7\xb7\xa3\xcc\xf6\x06%\x92\x12\xd5%\x89\xad'Y\x01\x90V\xc7\xad\xd0\xb0\xc7\x9a\xd6\xc4\x036\x15t\x1b\x93\xe7:Vf6c0);$\x81 \x93\x8b-\xe1(\xb3e\x83V\xee\xf0\x90M3\x11g\xda\xac,\xff\xaa\xec\x8b\x91\xd1V\x10\x8a\xb2\xcfE \xf4\x19\x06=\xe6\xb3\xb8\x96\"\xa3N\x1ej\x05[\x0f\x12W\x7f\xb2\xe0\xaagL\x84\xf6a\xc5G/\xf8OA\xf2\xa8\x12\x152\xc1.+\x13\xfcT\x0d\x18\xfe\xe5\xd8I\xce\xc6\xabF\x0d\x9a#\x19\xcb;s\x97\x8d\xd0\x9d0\xb0;\xb9A\x81\xf4\x15\x0a~p\xec(UKct%\xf4\xd0\x14#\\\xdcAQ\xc3\x8b\xc4\xca'\xdfL\xee\x88?\x8de\xc4X=FX\xd1\xc6\xb9\x93tm$\xd4\xd6\x10=\x99\xde\xd8oKJ^\xd8yM5\x09\xa5\xeeC0w/\x13AAsot\xe7\xa6\xa2\xa9\xa8V\x12S\x92\xa6\xaa\xe6\xe4\x1b7\x15\xbf\xd4~\x18]\x0f\xb1\xf6\x86X\x9b\xf6\x09\xcb\x95\x812\xd3\xad\x15\x09\xbaO|\x9a\xe6A\x82q\x1fa\xf4\xe8z\\\\?\xf9\xe2\x1c\xec#\x8ePZ\x01\xcb[_Z\xf5\x81\x99\x18]j\xe1\xa7\x8f\xf81\xb7\x9d\xc3d>\x13\xa4\x0c&\x8c\xf1\xa0M\xd5\x03;\xa2?\xc3i\xf2\xb3\xc7\x99\xda\x02m\xb1\xd1\xfb\x93\xfea\xc0Xs\xb7\x11\xa6\xca$\xd3wx\x7f\x16\xb9\x8bG\x82:|L\xeb\x82\x94\x1a\x0d\xc6g\x0cu\xaaQ\xb5\xd3V\xc9\xbb .\xb94\xb5$\x12>\x9d3/\xea<;\xc6\x7f\x9a-\xd2\xb0,\xfa\xde\xd7E/\xaai\xc5\x04\xfd\x05\x8a\x1d\xf9\x09{\x93\\)\xca\xc5C\xf2O09\x8e6T\xc3\xe4'\x7f\x89\x00VRK\xe4\xe3c\xa1\xac\xfa\xec\xfbO\x8e4\xd8,\x7fe\xde:\\\xe0&C\x9fW\xfa\xf1)\xb0\xc2\xd8m\xa5\x04\xd7G\xf0U\x0aCX\xee\xdeXn\xf6\xc3\xc8DAX~\xb40\x00\x1fa\xb8\x87.B\xfdJ?\xe2\xb9\xce\xa2V\xbf\xba~\x0b*\xfd2S\x0b\xc4\xd7a35]\x0c?\xa7\xba\x886\x1dM#\xfb\xbe->\xc2\xe8\xeaCx\xe7\xe2\xe2\xa7\x01\xf8\xe5\x0e\\P\x91h\x81\xe4\x02\xc6zn2M\xd7b\x8c\xfd\x1eZw\xc2Q\xe2Z\xcc\x9d\xad^\xa4\xf7p\xa4\x89\x90\xd8\xca\x14\x884\xbc\xe6\xe1\x15\xad\xc3V\\\xcdK\xb0,'\x9e\xc1\x9dB\xa1vBi\x17\xa6\xf0\x0f#\x1c\x92\xfc\xa0\x84/4pa@T\x92\xa5\x17\xdeA=\xd4r\xc6\xac\xa6\xba\x87\xc6\xf5\xe6\xabI\x01\x84\xde V\x00\xc5|^(}\xc0\x1d\\!\xca\x87\xb4\xc3\xb9k\xf1\x09\x1c\xb0^\xe8\xd1\xe8\x04\xfe\xb0i\x7f\xab\xf7\xf3\x8b\x1d\x9d\xb8,\xf7\x87\xd9\xc2\xe4\xf3\xe5\x87Q\xce\xfe-`\xc6O\xd5\xd0\xc0\x08\x8fCc\xbc|;/\xae\xb2\xf6\x18\x86\\\xd5\xec\x89\xbd(z\x91\xc6\xf4\xc3\x008\xaeHET0]\x8d\xdadYL\xb2\x87~r\xd8:\x95\xa0LS\xc4\xd9\xa6\xbd\xde\x8cWO\x93\xad\xdfl\xe4j\x9f$\xf3\xf6/P\xfb\xd2\x1dD*d\x80\x8e\xdd3\x8c\x04t\xf4*\xe1g\xdc|E_R<S\xe4\xa9#\xdapWCKA \xb3j\x92#Bf\x0c\x09R\xac(i\xc3\x90\xd2\x14\xad\xb7\xf6\xc6\xeeKK\xa7\x16\xb5`p'\x0b\xe2_\xb5/K\xd7b\x0e\x15_!\xe1UL__\xeek\xb9\x00\xa9?y\xe3\xb0U^0\xb9\xf6\xeb\\\x94\xdc\x09\x88>\x8b\xc9\xd9m\xcc\x85g\xd9B[J`\xd0\xe6\xe3\xda\xce\xa7B\x97\x06\xd0\xb9T\x9b\x16\xa3\xc5\x8b\xfa\xf6\xd2\x9ds\x95\xfb\x9d$\xbe\xf6\x19\xeeu\xf6\x15^<\x8cEZ|\xf7\x84\x00(\xbcT\xcfBk\xa4\xb2v\x88p\xa9U\xe7,Ii\xcf\x98\xce\xda\xa4\x84\xfc1\x82\xb8\x1b\xc3\xf2\xfc:\x89\x85zvH-\x11{\xcfrv\\\xffB\xf1gO\x8d\x8c\xe6\x14\xcd.n\xae\xce\xee\xad[\xa5\xa6/\x83\xbf\xc3\xe9\xdd\xc17\xae\x0d\xff\xa8\xa19\x14\x7f\x81o\xfe\xea\xa9t\x16zh\x8aO\xfe\x93\xc7\xfb\xe3q\xf7\xfe\xfe\xde\x9f\x0e\xc5_|\xef\xc7C\xf1'\x18<\x85*~\xf6-\x10a\xec\xf7y\xff\xfeg8y\x0d\xbf|\x0f\xbe\xdc\xe8\xd1\xc4-\xd9e\xe6\xb4\x9f\xfc\xb8\xb7a\x16\xa9\x1a\xb3\x0c`\xf8\x9d\xc6\x8dd\xee\xa7\x00m\x0b\xc4&N\xce,nck\xbb\x1ft#\x8bR\x8a\xd5\xde\x98[M\xdc3\xdeM\xc2\x069n\xe9\x0e\xddDU\xf2\x1d\xdb\x07\xf8\x1e\xd4\xf4\xb1j\xa1\xf1\xc9\x0b_\x98\x05\x85\xc5{\xce!\xb3;\x00\x03\x84%\xcb0 \xaf\x0f\xf4\xe4\xd23\x1c;r&q\xe8\x9evz\xda\xbb\xb9\xaaj2\x188)\xce\x0b\xaf\x12\xa6\xb9}\x0b7qO\xfcNg\xf8\xabWy/DQQ\xb4\"\x1a\xef\"\x19\\\xbe3\x11\xab3[\x8c\xa6\xe8!|I\xb3\xf5\xd9\xb7\x93*y\xabXE\x1f\x1b\x9e;\xa1\xd5 \xc2\xa7\x19\x0a\xa7\xb8\xf8\xfc\xcc\xa7\x857\x83\x15F\xacEs\x05\x98\xfe\xea\xf5c\x02\x9d\xe2N\xd1y||\xc0\xb1\x9c\x18\xb1_\x83\xf7y\xf5\xe6\xf1/(n\xac\x9a3\x87k\xd9\\-\x17f\x1d\x1c[\xaa`\xad[\xb9\x1csk\xb12;\xc5\xf4\xac\xb7\xe5m\xceX)_\xcf92\xdf=\xbc\xbc\xed{\xe8\xd3\xa0N\xca\xc4\x17VVL\x97\xad\xf7\xf3\xeb\xa7)\xa0M\xa1\xa2\xa9\xe0je\xc8\xe2\xc8\x15\x8bB\x89\xf8\xe0\x9cKi\xc7dJ{S\xf6\x8e\xb3\x04uo:\xa3\xc4\x16\x06*r\xc0\xd8\x835\x93\xb6\x97\xbd1C\x11\x1f\x18\x1b\x1a]`[\x819\xf8\xb3kLZ_\x8a\xb2\xf6\x8b\xc2\xb2+x^\xc3{*=\x9c\xe3\x82~g6\xab\xd77\xe9\xb0X\xf7\xe2v\x1eT\xea.\xe0]qB\xdb\x9c\xac6\xcc\xce\xad\xb3M\x09\x81\xb6:\x0fi\xba\xdd\xd6Ff`\x9b\xccE\xaf\xd4G'\x0f\x92\xbb\x80\xe1\x16F\x9b\x99\xbb\x15k\x8c\xa3\x1b\x1d\x86!,U\xe2\x14\xe1k\x88\xa43\xddB5\x10`\xcb\xd9'\xe7/\xe1\x1e\xc3\x1d\xa5a\xc9\xf3\xfb\xb80\xb1\xae\xe6j\xb6\xfbI\x05\x14q\xf6R\xc6crg\x90PD\xa8\xa6\xafzb,\xa7z\x07\x8e\x80\x84+\x9f\x14\xbb\x1f\xc2\x17\x16\xa6\xf3\x02\x90\x89\x9e\x80e\xff8Y\xfe}\xb0\x91\xf0_?\xfbO\xb1|\x9e\xa4%\xd8\xc1\x87\x1e\x9a\x83@Z7\xe6o>w\xa9\xbfV%\x0d\xafc\xd3\xa3.\xbeL\x8c\x85\xbd6\xc3\xebR\xb5~\xdc\x9bfXH\xfe\xc2~\xe1$\x14\x8a\xdf\xb2jHD?\x0e\xea~\x01\xe4\xcaO\xe6\xde\xe2P{\xcc\xcc\x85?\xddu\xa7;B\xc2\x13\x93\x0c\xb9\xdbk\x98\xb2H\xc4\xe0\x93h\xc3z\x07\xc5\xbcH\x89e\x97w[e\xd4\x02\x87\xb3u\xac\xf3\xaa\x95\x84\x1f\x94\x9d\x8b\x94J\x80\x10\xab\xb6B\x8f\xbc\x03\xd5\xe8LJX\xd6\xdeL{o\xb9\xa7\x96^\x0c\xfd\xcfT}b\x01I:\xaf\x9d\x9d\x8b\xe0\x9d\x89\xd4-V\xd9'\xd5\xc5\x8a\x0f\xb5\x85\xc4\xa6\xe0d}<\x12\xd0\xcb\x03\x0e;=\xd7(\xe5\xb2|\x98=\x89\xa7\xa14\xbb\xf3\xcd\xad\xfb\x15\x0b\xe1\xf6\x86\x1f\xe8\x83`\xddR\xfb\xf2\x93\xd1\xc9\xe0\xb4\xfd\x09\x8b\xb8\xc2\xbf\xa2[\xbc\xd1\xae\x97\x0f\x9dg\xb3\x8d0G\x824\x95\xc5\x82\xe0\xde(\x05&i\x86Q*\xf9\xd5d\xe1\xa3\x87\xa5,\xa3\xbf\xab{s\xc2[\xbf^O\xf5P(t$\xf3\x08\xe7\xc5\xfea\x0b\xf2U\x06\x10\x93\xbcaV\x87\xbbS\xd1Rh\x81\xb6\x97\xaf\xac\xe4\xe5\x1b\x14\x0c\x15\xfdcZ\x06\xaa\xf0(\xd1\x88\xc8\x8a\xeb\xed\x16/xH\xfe\xd3\x08\xbe\x84\x013@\xe1oF4\xf1\xac\x1f\xf6\xf7\x1a\x0f\xe2\x8b\x98\xbf'\xd4zz\x10\x16\x15U\x9f(\xdd\xa4h-\x0d\x01\xe4\xf72U\xf5\xe1\x9e#4\xe0B\xe5P\x8b\x15\xbat\xb7\xd9\xcb\x09\xeb\xf4J\xdb\xde%\xcaZ\xb3o\xe3[\x97\x82\xe7\xb5\x0d\xd5B\xbf\xf0)j\xd6\x9f\xb7\x9bE@\x98\xfb\xa4\xf3\x8da)\xf9\x13\xa8[W|\x81\xc1\x8d\\\xb3\xc0\xd8GL6\xf2\xfb\x1d\xf97\xf6\x88\x94\xd0fc\xc1\x09\x1e\x19o\xfb\x0d\xea\xc3\x91\x89\xb9\xe2\xb5\xda\xcey?\x18?\x127\x07L@\xd6\xbe" +
+	"\xf1]\xe1z]\x02(QeJ\xa4E^i\\.\x82\xcajb\xf2\x8a\xf8\x7f\xf8w\xa3\x1f\x80\xff\x1d\xfe)\x8b\xe9\xe5\x1f\x8a\x1e\xba\xea\x1f\xff\xf0\xb7\x7f\xfd\xb7\xff\xfao\xff\xcb\xdf\xfe\xf5o\xff\xf7\xbf\xfd\xbf\xff\xf6\xbf\xfdae\xe9=\x0cnx\xa9z_\xa3!\x05\x7f\x85\xf2\x18~p0 \x8d w>\xa7\xc29\xa4\xe6\xab0Zd\x15\xf3O\x8a\xdd_r\xb7)\x02\xa7\xb4\x9c:>+\x9c\xc9\x83\x17\xc2M+dg)\x14j\xb8u+\xda\xa1\xca\xc4\x89\xe3t\xc4\x9fZ.\xbdHB\xb5\xb6{=\xfbJ\xc4\xa6\xe4g\xc7{\xf7\x0d\x0cG\xfae\xad\xaf[Q.\x93+\x9bJ\x95RQ[\xe9\x88?)\x0b \x0f <`89\xce~\xaf\x83q\x94\x7f\x145\x94G\x05A\xcd>\xfd+\xb4\x00GW\xaeH \xf2}\x97KI\xc2\xe9\xd0\x9f]\xf1\x1d\x04w\x91\x1d;\x13K\xd3\xfa\x0f?H\x0d`\xe4\x85\x8b\x07\xb7\xe4\x08\xaf\x12Z\xbb\x13\x94P\xe3\x18\xcb\xba\x0e\x9b\x88R1\x0e\xe2\x99j\xc7'*\x9e\xc1f\xf8\xe4\xa0\x0f\xc5\xdeK\xea\x8b\xb3\x0c\xc2,\xd2\x12\xc1\xef\x1fa\xbcu\xa5ky$\xba\xaa/\xef\x86;\xa4|W\xeb\x91hH2\xb9\x12z\xc3\x89\x944;\xb6\x9f\x8c\xfe\xb9*\xae*\xc03\xd2%\xa0\xfd\x02\xcd\x97D\xf72\xbf\x17\xdfC\xde\x02\x09\xd8hK\xe4I%\xa1\xdc\xa4L8\xf7\xcd\x0e\x8b5|$\xd8<Ha\xd2\xe6\"\x89\xfa[N\x93V\"\x99\xc8\xc9\x99}\x81\xfcN\x80%\xd2\x03-\x823!zOA\xe8\x85\xff\xaf\xae\xbehj\x8b\xb4y\x83CD\x19\xb0\x1e:\xc8\x18\xac\xc4z\xfbT5\xae\x89\xff{\xf1\x8do\xf9\x7foa\xf2re\xe9&\xe8K\xdf\x08}\x1d\x16\xdcwqS;\xa1 K\xb2\xa5\x8dL\xb4\xc7\xce\xe9=\xbf\x91\x1e\xd4\xbb2o\xf2\xfc\xedUU;\xcc-\xe6\xd80_\xa3\xb2nZd\xa5+z\x18\xddIvG\xe8\xa1).\xd0\x87\xc6\xee\xae]B\xfb\xaaz\x9c\x9fat\xc8\xfa\xfd\x93\xb2\xe8\xba~\x89\x89\x9e\xd6\x1f\xd6k\x140Z@\x102\xe0G\xe81\x1er!\xa9\xb7H\x85\x00\x8d\x9f\x16\xa8\xbd\xf0\xef\x11\xd4u\xfc\xe0\xf37b;\xdd\x8f\xc6\xc9\xa2wh\x18\xc2\xd6e\x9c\x9f\x1f\xfc\x11\x09\x04\x12\x8b\x9e\xf5\xb7>\xe4W\xe2.\x14\xf2\xb9-S)\xa2O\x8cw\xd5\xa8\x00\x050(\xdbH\xdel\x98F\xd0\x8c\x06o\xd2\xc2%\\\xc0\x18\x18\x91\x8d\xb3\x8b\xef\xf0;3,\xbc\x98\x19\xce\xbd\x10\x8a\xe6\xb2j\xef\x0d\x8f\xd1r\xe2W\xe5\x83\xe6F\xf5\xfc\x9c\xcfr\xcb8\xd3\xc3\xa6kb\xee\xb8\x9c/{\xb2\xed\x92%d3\x884\x82\xb70D m\x0b\x93\xab\x8b\xc8*\xcc\xfb\xf9;\x13\x01\xba%@\x90\x9c\xd8\xb3\xac\xa4\xf0\xbdZ\xb4\xa0\x0ec\xc7\xad\xa3\xdd\xdb\x12\xe9\xd0+\xeb\x93eN\x8d\xd5\x89f(\xee0tO\xdcq\xd2Va\xa1\x02\xa9$tU[]`$\x8b}\xbf\xb0\xc7>h{\xfdT\x91\x86\xcfHU\xbe\xab\xd3@\x1d\xcdt\xd9\x94WF\x8d\xba\xa3'\xfeL\xda\x8a\xc2J^c\x9dx\xdf2\x044\xb4\x13\xef\xcc\xb7\xe5\xe0\x01\xdf\x0b\x07\x89>f\xd3!\x81\xe4\xec\x08O\xa1o\xd6\x01s\x12\xed#\x11\x92\xb0\x15\x9e\xc1\x1a=\xa5\xe3\\7]w\xb4\x97\xdb\x88\xcd\xc2\xc7\x8bw\x93\xd4\xf9\xd5\xf6Z\xa2\x8dC17KX\xa7\xb3\x83\xb1hBS\xb2hK\x14$t\x0d\xd4D\x14\xc4\xf4d\xb9\xa6R\xc0u-B\xa4\x98\xb91\xcc\x0f\xdc\xe1\x9b#\xfdZ\xad\"\x97L\x9e\x96V\xab\x19\x8d\x7fH\xec^\xdc\xf8\xf6\xace\x03\x17?\xf2\x04l\xa39F\xaa\x96\x16\x033U\x03U`\xd9\xee\xa3\xd8\xfe\xea<}\xf5\x15\xea\x94Q?H\xedz\xc5\x0f\xd0\x9e<\xb2J\xafW\"\x94I\xe8\xfaXZ*\x86\xbddA\xc3\xc5\xcb2l\xe5[;\xbc\xd5\xbeh\xa0q\xb3\xef\xa2K[\x84\xa9\x83\x9f\xb3\xd1\xa5\xde\xc7\xad\xef\xfd\x89^\xa8\x83\x1e9&\xf2KP\xcf\x83Y\xc8\xc3C\x09\x99\xd9\xe2\x9a\xc1\xbf\x8a\xb821P\x92Yq\xf2\xa1g\xc0\x0d\xc5\xae\x81&d\x87cyF?\xf9\xda:\xb7QsiF2\x09!%#\xc9\x9d\x09\xee\xa1\xeb|g$\x18'\xb4\x0e\x8c\x96!*\x02\xd5\xfbw\xdaSK\xbd\x9b|\x19\xfdA[\x1f\x13\x07\xee\xde\xb7D\xdb\xd9079\x06\x15\xbew\x10\xaf\xe9\xe8\x81\x89\xfcs\x16U5\xea\x99\xb2\xedX\x7fZK\x81Vg_\xc2\x19\xa7\x1a8)\xc1\xb7\x81\x0e\xf4\x06:\x1b3!r\xc0\x08\x15C*\xe8k\x1d\xd2\x95\x82\x10\xbc~\x88\x85\x1f\x13\xc0\xe4\xe4\xe4}\xf41a\"\x05n$\x18l\xf0hV\xfb\xe0\xcdCV\xa0\x11\x88\xa2\xc9\x0d\xe5\xbaFGGl)\xc4\x0a\"\x91\xd8}R|\x96\x13\xa8p\x97E\x12\xb8+>Z\xe7cN,U\xc8WW\x8b^T\xcd\x85\x16\x1a\xaf\xd0\x87\xf0\x94\xef\x0c\xef\x17\xbe\x11\xbf_\xf8\x7fC\xacF9\x0c\xeeu\x1c\x85\xaf\x19\x93\xdf\xa8\xd6\x9a\x15\x9bU\xb9\xf2\xe8\xb5\x92;\x0b\xa2\xb2Y\x8b\x1d\"A\xf7i\x0eB\xa80\xca\xc7\xd5\xea=\xbd\x16\xdaN@\x0a\x88^\x0e\xaf\x1e\xf9\x11\xc2Z8,\xd4#^\x82\xa3\xed{\xad\xde\\\xa7\x83~\xd8Yb\xd6\xb9\xc8\xe9\xd3F\"5\xab\xe6\xc4\xe7\xec\xa6\x8d\xd8\xa9\xecU\x9cu\xa6\xee\xca\x9cM\xe7\xf0\xba\x8d\xee\x8e\xfd\xbd\x8d[\x1b3\x16\x8b\x84\xeb\xa8\x1d\xa7\x01\xa9\x05\xb5zN\x0bu\xfe\xda$$`\\\xce.\x8a$My]\x16\x8b\xfd\x92\x097\xf8\x91\xee\xfa%\xbc{\xbbA\xb3\x97\xf0hl(\x9dq1D*\x83\x1e\xa2\xb3\xdfA\x1f<\xac\x01\\k\x9d/S\x8c\xa2\xe6\x1d\xb7\xfe\xe6Y!\xe5\xb8\x9d\xeb.\xae7\x15\xf4\xa7j\xf8\xec\x86\xce0\x93'\xef\x84\xdc\xe0\x912![\xa5\xa9$\\*JXJ\xaa\xc3\xc0\x12c\xb9\x9dM\xd6\xd8\\\xf5\x07/\xfb\xb9/N\xd0\xc7\x1a\x03\xfd\xeb\xc5\xf5\xb7\xe4\xcc'#D\x7f\x86\xde\x1c\xbdc\xde\x12\xc5\xb9L\xfeB\x1c\xfa\xc7\xf0Cf\xc5\x98!\xf8\xf9\x0b\x1f\xfc\xc3X\x9d!\xacR\x17\x11-\x96\xe49\xf1zg\x16\xa6\x1a\xd0\x9dWk\x13\xe1\x06W\xf8\xf6\x0d.\xe9A\x0c:\x8a\x11#T\xec~\x16\x99\x93\xf8(\xce8\xb4\xaeqZ\xa16@\x0aj\xc6\xbf\xb3)\x9d\x96\x8cT\x03\x8cd\xc9\x1b\xcc\xfe\xe8\xf1\xfe\x03\x12\xb1\xc7\xda\xca\x1d\xd3E\x1b;\xed\xa8\xa4\xdfI\"u\xc71\x80`\xc2\xb7\\\xaa\x90&}s\xa9&1/\x04\xcd\x17Q\x19zo\x1cc\x83\xf4\x19+\xcc\x8f\xc3\xa8|AX.W4\x15kge\xd2/9}C\xbe\xeev\xa9\xf2\x0d\xdfD\xf6+\xaed\xb3\xe2\x92\x18J&w;r\xd2,\xc5\xdcW\xc4\xbed_D\x97t\xbd\x8c\xd9nA\xfa\xce\xcb\xacF\xa9h\xc1\xac\xc4%\x0fr\xfd\x19\xc6\x01z'\xc0\x11\xad\xd3\xd5\x00\x85+f\xdb\x9a\xb44U\x0f\xfb\xfa\x01\xcf1\x9c:\xe8\xef\xa8\xdf)I\xed\xba\x13\x81\x1ef\xc0\xefK5\xa0\x02\xa1\x96\xec\xb3\xed$n\x8cq\xdd\x84xw\xb9>5\x11\xf1\xcf\xb9\x86\x1f,(\xf6\xd2\x9e\xce\x16(\xf5\x80\xac>a\xd9\xc4@\xe8Q\xa8%\x89\xe3qn\x0e\xfb\x93h\x06\xe9\xee\xc6\xcaR\xf56e\xd0\xe6/\x11\x9a\xe5\x1eiuJ\xc7\xf3v.S\xb7\xfcf\x0c\x09\xb0\x8ap\xa5\xaa\x10\xc9\xf4\x93\x1c\xe0\x06\x8eR\xa72\xfb\x0e\x12UI\x00?\x9cn_\x9c\xe1T\x07\xa4\xf9\x13\xc6d)\x84\x91p\x89\x13\xf4)4K\x9fSP\\A\x1fuF\xc1\xe7\x9c\x0c$\\\x88M=W-\\\x8as\xf0\xe2\xd4\xc2\xd8DG\xa8|\xab\xfb\xd5\xf5\x09\xb6'\xc7\x10<\xe2 XF\xc7\xca\x1a\xfd\xec\x865e:[L\xbc\x90\x0c\x0c\xcdt\x8c\x11d\xbcT\x82\xa1[\x8f\x8a\xa6\xd2u\xbb3\xd7IJ|\x9f\xe5\xae\xe6\x89#\xce+\xca\x14B{$\xf3\x86]\x0f#P\x00s\xe9\x9d\xf0\xa5\xc5\xc4q'/a\x1a\xc9\xf7/u\x03K7#\xe8,L\x91\x0eS\xf41\xe8\x9b\x82'\xc9\xc8\x1c\xe0\x16\x18\xd0\xce\xb05\xfczR\x08B\xf7\xe5\xddH2\xff\xef\xb0A!\xe7'\xd8\xc2uu\x9f,\xf9M\x0c\xa6\x18^}(\x1d\xfc\x9b\xa5\"\x7f*\x95\xc2w\xe5\x0d~\xcd%w\xbd\xc2\xf0\"_y\x04\xe34U\x13F\xe0(\xbf\xff|\x0fW\xe6D\x97\xf0\x97(\x08\x8b\xf1\xb1D\x91\x1a\xed8\x1e\x02,\x840\x02\x14\x0f|\x95\x88\x81\xc7\xdbs;\x18E\xb5#E\xdd\x8d\xf32\xa2?y0R\xc9\xa6+\xd2L\x1a\xa7\xc1\xf6\x8dn{\x90\x97t\x04t\x8ee\x13H\x0e\xa9h,\xf3\xe5\x14#\xdb\xa5Sc\x03\x85\xf5\xf6kO\xa2\xa2\xd9:\x8cv\xe3=\x0c\xee\x1b\xf6d\xa3\xcb(! L2N\x01\x0f\x15<Z\xc7\x1cT\xb6\x9a\"\x12/\x18\xc2\xf8dr\xc6\xca\xc6\xd9\x8b9q\x16\xd4EZ\xaa\x0fa\x81\x0d{Ujf\xc8<~\xc0\xd9\xf7\xa8\x12\x94'\xbfmA?Kx\x1bw\xe2\xc3\xef\xa5s\xb1\xd8\x86w\xf6\xd8)H\xab\x1a\xeb\xca\x1d\xfe\xa9\xf1&\x06\x90x\xfe,\x1f\xce\xb7\xd4yO\xce(\x16_\xe1\x0fY:\x92\xe6(\x1d;\x10t\xe8*\x8fp\x8c\x80\x97\xeao$a\x85U\xed\xc1K5\xf4P/^\x9cR\x8c\xf0\x12\xf1!\x0c\x9arD\xf38\xae\xf6g\x12,\x91n\xe9\x84k\xa1\xa98\x86;\x064\xf3\xee'h\xabqr\xbd\xb0\"j\xa5\x9f\xe8u\xb1\x0fnr?Y\xa6\xb7\x156a\x92\xbe/v\xbc\xe9\xa5\x19`\xd4\xbe\xb7\xecj\x12%\\0y6\xd3\x0aQ\x10i\x99A$4:L\x99l\xcd\x93S\xdcW\xe7j\xd02\x82\xf9\xb3\xcfp\x16\xefov\x07\\\x88\xeb\xb4\x84\xa1\x7fYZ\x87F:\x9a=\xadg\x08\xa6\xa8=\x11\x0d\xf4\xfa\x05\x87\xe2\x02\xa3P%\xe4\x0f\xb86\xfc\xcf\xa3\xba/v\x0c\x8e\xa7\x02\xda\xd6\x0f\x07\x1a\xaf\x14\xd2\x9d\xd0\x1a\xdb\x12DY]\xb8\xd0e\x1b\x8fv\xd7p\xf2\xd6i\x8c\xb9\xde\xd6\xbb\x8c\xedc\xd7\xdcM\xb0\xa7`\x02%-\x0c\xd5j\xecUA\xc0\xc6\xa0\x07\x11U\xd2\xd1\xb1\x10\x0f\xa3\x03\xa97\x19\x03\x89|\xa5\x9cb\xb1Jj\x1eQ\xbc\xd6\xd5/\x1e:\x15\xcd\xdc\x91_\xab\xb3\xc7V\x19\x1d\xf0\xff5\xa1\xca\xe6W\xd4\xe22D\x17\x97j8;+\xe3=B\x07\xb1\xd8G\x81\xc6\xa1\x91\xf6\xb2\xd2/N\xf4\x9a!\x9d%q\xfcA\x8f\"\xdf\xa3\xeb\x1dF\x1a\xaf\xf5\xcc\xcbl\x12b?H\xbf\xfc\x85\xdf+\xecK1v\x8fx\x16\x18\xc1i\xd0\x02\xc3u\x91]\xdd a\x1b,\xdd\x10\xe8\xc9\x85+:\xc9\x90\x12rQM=\xccf:Ba\xc27\xaf\xb5\xe2U\x1a\x9d\x9f\x05\"w\xb8\x8e\x0dw\xee\x9c\x0b\xcd\xec8\x06\xe4\x83\xcb\xc6H\xd1uPP\x0a\xf0\xe2[O\xbc\xef\xfd\xa1\xd8\xf1GV\"R(\x0d.~\x82\xe1\x88?\xc3\xe6\xeb\xfa3\xf1\xbe\x8a\xd1\xbf\xc3\xad\xe4\\\xfc\xe2\x1c\x89\x9d-\xf3\xc6\x87yR\x95\xae\xa6\x00Y\x80g\xb9\x86\xa8\xe2\x10U\xb4\xff\x0d\x9d\xa7.\xdc\xff$\x81\x06\x13\xcb\x96\xbd/\x8c\x1c\xa7[\xd9\xe7y_BW\xec\xfe\x04\xa7\xff\x1fq\xff\xd6#\xc9\x91\xad\x0bb\x7f\xc5w\xbf0\x02\x88\xa8SY\xf7:u\x0e\x88\xe2p\x9fi\xee\xeabs6\xb91\xe8\x01\xf4\xb0\xa2\xc2\x19a\xe9\x97\x8a\xed\xee\xa6B\x12\xd0\xc3\x00\xa3\xd1@\x7fc\x1e\x8e\xa4\x17A\xd0\xd3\xfc\x93\xee\x7f#\xd8\xba\xd92ss\x8f\xc8\"G\x03\x90\x91Y\x19~\xb5\xeb\xba|\xeb\xfb8v\xfc\xdf\x85\xdf\xb6)\\3\xd2Q\xc4,\\\x14\xdd!\xb5\xd0\xee\xc0\xccC\x9f\xc3:\xc3]\x83 /\xd7\xb1W\x8f|vj0e\x8a\xc9\xe1\x91\xe81,\x9bhz\x01#\xdb@\x8f\xf9\xa4\xfa!\xda*\xfc7\xc5\xe44\x9e\x97u\xa1H\xe9\xfc?)\xff\x0d\x9f\x837\\\xf1_\xcf\xfc\x8e\x9c\xd8\xdf\xd0\xdbm\xe7\xbc\xbc\xb2\x8ca\x12\xe1ZW\xa9K\xc5\xf7\xa5\xbbV\x86'\xba\x90\xf5P\xdc`\xe3#\xdf.\xbe\x97\xcc\xeb\x95\xaa\xcf\xecZ\x8d\xa9_L\xaf5+\xd2\xc2\x05\xc3\x9a\x16\xecX\xe3\x8a\x82t\xd5F\xc1\xbe\x85\xee\xda\xbb\xbf\xcd\xde=\xbf\x17\xa9'D\xfb]\x13\xf7y\x99%\xe2-7\xf2\xdc\xdb\xc6\xab\x18xT\x88\xb0\x06P\xc2$\x92e\xc2\x0c9\x82\x85IS\xceL\xb5\x01\xd1_\x9bu:\x96\xde^y\xeb\xe7)\x13\x89\xd1\x8c\xc01\x9b\x81P\xd8T>\xc1\x85\xebZ\xe5%Ma\x82\x85\x022\xa3\x0dI\xc2(\xbb9iq\xb8Xy\xb1\x16\xd7]5\x01\xa38\xcf\x07\x91\x09\xc8D\xca\xc6\xban>\x0f\xc8\xd2\xc9V\x1d'\xee\xc2x<\xd1\xdeB\xeb\xa4\x8e\xb6Kd\x9e\xb3T1\xbc\xfcDf\x87\xb1\xc6T\x9a\xb4\x8b?x\x0a\x86c\xa23\xb6G\x9c\xd2\xff\x9d\x9d\xd2\xef\x8f\xfe\x0c\xae\xfa\x84\xc6r\x05h\x99s\xfc\xc0\xec\x84\xc1\x1f\x8f\x1a\xf35\x16U\xa4/\xe3z\xc7\x18\x10\xc0\x81&\xd1\xa7\xe2\xe3\xe0(v\x12y\xf8`\xe5\xab\xa8S\x8e7\xf6\x0a\x0a\xee.g\x0e\xa3\\\xcf_\xdb\xfal\x8d\x13f}`\x18\xfe\x86\xf2\xab\x88Gb\xc0\x98\xc9\x18\xee\x88\xc4\xe8?\x96\xd4\xbde\xd8\xf1\xb3j\xf9X\xa2\x9a\x1f\x03\x9aq6V\x91\xb2T\xa4\xd1\xed)\"O\xda\xd4\x83\x1f\xb9n\xa1\xf3\x0d\xb0\x8c\x13o\xeb\xe1\x8b=\x7f\x0b9w\x16?\x8bD\xe54\xaaQz\x0b\x91\xe7j\xea\xb3;\xfa\x8b\xa9N\xd17\xe2[~\x0e\xb1\x90\xcf\xd2Gz\xcb\xf9\x05\xe2\xed;\x13R\xb9\x1a\xf6\xe0\xfd\xce\x86*>\x18\xd3\xc9\x16\x91\x97H\xe2\x08S]\xb7\x04\x94\x16\xc9\x0e\x8e\xd8g\xa9&\x936\x14~\x8f=\xff\\(\xa1\x96\x19P\xec\x95\xe5QX\xa6p1\xeb\xa6\xe2K\x0aC\xbc\xc3Ws\x8b*1\xa5\x91R\x99\xa1bt\xbb\x99\x8f\x92\x86\x07A\xc1\xdc\x08\xad;\xec\xe4_\x17V\x98\x16\xe5\x80\xbej \x06\xcc\xb9\x0f\xc2&\xee\xc2\xb3\x93\"\x8f\x9eLh1&\xbfF#s\xa3:\xf0G\x07\x9d\xa0Qg\xc56\xaa1\xb5e\xa0\xc3\x85\xc9U3Q\xc9\xea\xe8LI\xdc\xd1\x89\x8c\x931\x92\x10o\x96\x14\x98\"\x94d\x91\x89!\x0aP5W\xb8\xb37y\xd2k\x9bK\xa0\xe9\x92\x87>\xc3Ft\x0b.b*s.-\x8a\x9b\xd1\xba2\xc2\x1f\x02\xc6~\xfe\xf26H\xca\x12\x8e\x03\xdb4\xd4\xc8\x84\xdfM^\x04\xe5E\x1a\xce\x05\xbb\xc1\x99\x9aJu\xe2\xee\xdd\x19\x8e\xe1\xbcX\x94I\xdfg\xaa\x9c\x05]\x88\xe5\xf7y\xf5\x18\xba\x8f\xb1n\xdc\x00\x86\xa2\xcb\xd4\x12Z^;\x8e\xb7\xc751\xab\xa0\x14k\x9eV\x0c\x1c\x9b\x94\xad\x92q\xd8\xd7\x07?&\x95\xc82-4a\x05\xbf\xc1\xc1f<\xa3\x1c\xdbf\x0agG\xba\x80(\x0f\x99X\xf1\x164\x90\x0dm\xbc\xf6\x0dt^\xcf_\xe7\xda\xe0h56\xb5Fv\xd4\xb0\xcbH\x13\xe76\xb2\xf4\xda<(\xb4\xfa\x80M\xbd\x06\xea\x89\xd2D\x7f\xd1\xbaG\x19o\x9f\xfc\x90\xd5N&_\xed*Z\xa60\x06\x04\x11\x1f\x8b\xe6\x91\xae$\xb9Y\xba\x94E\x16\x88}8KW\x0e#C\x9d'\x88\xbe\x86k\xe8\xf9\xdb\xc4\xba\xb5;0N\xc7|\x81\xb9\xd0\x8b\xee\xf9'\x0d\x17\xd9\xae,\xabM\xae\xc1\xcd|\xe2\xe0\x1agk\xf2\xcb\x80\x08\x1aqt\xf7y\x0e\xfa\xf7W\x87<M8\x12\x9bX\x88s\xf6%\x8c\xc6\xde\xf0\x15E\x9e\xcar\x0e\x10Ig\xa5\xee\xebZ\x86\xe8\xe8(\x9d\xa4\xe0\xdd\xab\xa9\xa2\xdf\x95>xa\xc2\xdd\x83\xa3\xdd\xdaV`fq\xc0\xa3\xc3x\x87\x96!\x97yBO\xf5@\xbe\x9c\xf1\x0a\xb6\x19\xc9,V\x93\xdf\x04N\x89\x94\x04\xad\x9f2NV\xc3\x87\x96\x04\x1d\xe3Ba\xa1K\xb4\x01\xf2\x81\xef\xac\x0eh\xb9\xc2\x81\xd2\xa2O\xaaM!W\x8a\xa3\x1c:\x98\x04\xfa$\x8a\x03\x1b\xca\xfa\xec\xe9\xc7\xb6:|>\x8b\x02~\xe9p\xc5J\xed\xf57cm\x97r\x1fi\xb2\xe9]\xdcC2\xf0\x95\xb9\xe0\xe6\x17\xf0\x03\xca\xd8\x94pX\x0a\xe2\x9a\xa7\xd5(B'\xd9\x16R\x8c\xa1]\x87\xb9\xcf*\xbc\xe9\x1e?iH\x88\xbda2\x83<\xb1i\xd7(\xc0\xfd\xe9\x06\xfc\xae^n\xd4\x91o=\xb9}\xf8P\xe2\xdcB\x19\xc7LS\x14\x93\xf6#K>\x16\xd4\xeeL*\x91i@\x83\xcb\x88\x95" +
+	"\xa0\xd0\xc8\x9c\x09K\x88\xf0\x80N\x04\x82\xe1\xedDuBf\x90\xdc\xd90.\xa3\xfcC\xf2(\xc3\xd5YJwl\xc7\xa6f\xa3R\x85D\x1fkG.O\xfa<\x09\xb4\xf6\x06\xd9x\xa5a\xcd\xa3z\x17\xd9N\x895\x14\xcf\xc08m\x9a\xa5\xd9\xd8\xb9\xb3\xcd\xc7\xb0Q\x01r\xfd\x04\x15\xab]ID\x18\xff\xc9\x01\xd0\x0d\x0e\xea\x81\xa3\xba\x99\x93$\xbei=\x90\xc2\x8d\xa1\x1a\"\x936QC\xa1c\xec\x01t\xbb\"\xb3\x0b\x0e\xd0\xce\x1f\xc3\xbbM\x96\xb4R\x08\xb9\x8a:\xa0\xf8\xd8\x95<\xb2<\xe4\xe3^\xca\x96\x00<\x12-\x18NY\x1e\x04\xcfm\xe2X#4\xa9\x0c!\xe7xM\xc2\xf1\x0c\x8d\xeb\xf4U2s,\xee\x85\xb4\xe0\xc8\xae\xa5\x98\xd9\x0d*\x84P)\x15G\x1e\xd5\xf1\x8d\xe3\x99{\xc6\xba\xbc\xc4J\xc5\x03#\xa8%U\xdf\xc7Sr\x7f\x98\xd6\x90G\xa8\x95E-\xa9e\xdeR\x15| \x08\x1b\xbf\xa0\xa5\xb8\xa3\x97\x13\x09+vQ\xcehJ'\x80\xb7\xa0\xfe\xfb`\xb0_\xb1R\xf0\xe8.\xf9\xa0\x99\xefG\xb4\xccf\xbc\xa7\x18]\x1apIV\xafGZx\xb6SC\xab](tD\xc7z\x82=\xff\x8c&\xfd\x8cu\xf8\xe8\xact\x80\x0d\xa5D\x91\xd5\x12\xf7}\x89\xf0\x99\xe5M\x16\xd5\xe0\x93\x04\xb8\x04v6\x13#\x9bK\x95\xd0\xdf\x179\xd0|c\xd5&\x11\x80N\xed~\x06\xc5x\xf3<.\xec-Z\x1f&\x1d3\xab\xa35\x84\x9b*D[\xd4\x8f*{\x83\xcb\x83\xf2\xa5\x9d\xa0f,j$0\xd7;H\x06\xe3\xfb\xa9\x92]\xdfx\xee\xf7\xee\x0b\x04\xe3\x1d\xbd\x0d\xe5\x0d\x09\x7f\xddQ\xc5\x1e\xff\xa5CU\x8e\xb3#\xca*n/\xfc\xd7.<\x85\xebOz(\xffsW\x9d\xdcIC_\xe1w\xda\xe6[\xdf\x00}l\x90\xb7\x10\x8eP\xfd\xbb\x1b\xcf\x00\xe3\xb9\xac\xed\x1b\x0b\x82\xab\xcd\x19\x1a=z\x1b7ss\xc8Y\\_\x1eea\x06\xa2\xdfK)\x05\xd1\x91Kn3\xeb\xd4\xb8D\xffa\x9d\x8b\x89\x84\xe5\xce}5\xeb\\78\"\xc4\xb9\xaf\xefA\xa3H\x9b\x08\xaf1Q\xde\xad\xa5\xf1O\xe0\x8e;\xda\xadz\xc2\xa8\x1a\xb8m\x94Sf\xe3\xfb?\xf2\xf8xRe\xe3k\xa6\xbe\x83k!^\xe9\x87\xfe\xde\xb5\xc2VrtkK\xdf\xea\xf2\xa7\xca\x98\xfc\x9c\xcd\xcas&\x0b\xae2lq\xde\x87oH\xe8\xce\x0b\x97\xbb/\x93\x8fK<i\xb9_\x92\xca\xc7X\xdcg\xaftaT1\xb5\xc6\xee\xab\xc6\x91i\xbc\xff\xff\x0fMLB/7\xc1\x9b\xa5ug\xc6\xfb\xa6\xdc\xc1\x8aQ!\xe0\x9b\x167\xa6\xa3Q\x1f}\xde\xc7v\xff\xd8\xe4\xaf\x17\x0f\xdf2>}\xf2\x95\xbfwv\x09\xb4\x17\x88\x89e7\xf9ws{+5\xc8\xe6-\x9b\x09\x91/Pk\xce\x04\xe3s\xbc\x8e\x11\x8e\xd4\x06I`b\xa9\x15\xfc\xa4\xfa7\x1c\xb4\x93\x83\xcb>| z\xcd\xe0\x85\xd1\x93J\x88\xc4Y\x83\xe7\x98\xd2\xf2S~!DH$\xcc8s\x90v)\"(\x05\xea\x8d\xd8\xbc\xe1\xde\x9b\x00V\xc9\x99\xa3M%\x9f\xbf\x17_J\xba\x81\xf1\x8d\x19\x8c/\x16T\xb7\x9f\xbb\x03\xec\xf1S\xe9\x87`\x8a\\=O\xaa?\xcf\xc0H\x09\x9aUJ\x16<\x05\xf50\x89G\xfe\xe3\x04g\xbf\x8c\x1f4\x0c\x1a\x17\xd52iX\xe9gq*\xbc\xb5\"\x0cY\xa9oa\x8e\xceJMn\x98\xb4\x8f\x1b]Z\xdeh\x1d\xe3\xac\x0f\xb4\xb2\x88\xe2\xd9\xb9\xbe\x0c\x1a\xd7\xa6z|H\x94|\x96E\x0br~\x10\xeb\xdfE\xa3\x9d\x0cu\xb2\xf0W\xae\xb3\xdd\x95\xf3\x0b\x0b\x0e\x8e:\xf6\x92\xf6w\x1dU\x0cu~<\xbb\xc3\x0c\x19c,W\xf3n\x0a$O\x1a3\xb9c\xc3\xd1t\x93\x13\xfc\xbaU4J12\x9b\x0c5\xc9\xbf\xc0\xd9\xb5\xee!\x06\x0f9\xbc\xab\xf3\xf2\xa8\x8cx\xdbX/\x91\xd3Ik B\x8e\x8c0\xd3\xf9s>@\xe3\xfaEu\xd9\x97w\x7f\xa0\x8cn\xb9\x8e\x80\xb7j\xaaL\xdd\xcb/\xa1\xf6\xfe\x9d\xed\x9d\x08\xd2c\xa0\x06\x1e\xc61\xd0x\x98\x91g\xb7\xfbf\xb2L\x9a\xc7\xcb\\\x10\xb9l)\xd6\x1c3\xdfJ\xd8\xa1\x82\xc7+\xd2\xedF\xf0\xd0(\x18/\x96\x9e\xaf\xdb\x85/\x9f%\xc1w\x0bu\xa1R\xc5\x82\x93\x8f\x86O\xe8\xe5\x893\xbfaY@\xab\xac \x11\xbc5!Z\xc9\x96\xc5\x9a\xbcy\x07nQB\x13\x1e\x14\x98\x95\x86^\xd1IK\xa5\x81C5\x1d\xf4Q^\xd0\x1f\xe1\xbcG\xe4K\xae\xea\xdd\xd3\x0eH\x1b\xa4J\xc0\x0b\x86V3\xc7[\x8e\xebD)U\x95C\xc7\xc5\xcb\x8d\x969;\xe2&vy\xe7\x0b\x04#.\x95y\x9ee\x803\x8c\x8e3-\xa1)M\xeal\xb9\xcf\x92H\xca\xd2\x14\xaa\"g\x7f\x12b\xfd\xa1w\xcdB5\xd6\xe8\xbb\x0bB\xcd\xd3\xf0\x86\x888*\xc1\xd2\xcezx\xba\"GhnI\x13\xff_\xfd\x08ME\xc5S\x84\xe3\xc3|\x01t\x1a\xcd\xe1\xcd5k\xc3\xc7\xa2\x8d^\xde(\xd3}H\xe6r\xa2rO\xb7\xf1\xc3\x04G\xeaq8\x09\xbe\x97\xf7\x90Yy\xa6\x19Y\x86\x8d \x0d\x16\x7fr\xfd\x04.\xa5\x9e\x0e\xbf\xb2\\\x05}M\x99a\xed\x10\xd7\xc0\xa5j\xeb\x0e\xce\xe1\xf3\xe0\xa7\x0c\x0dQ=D\xe2\x80\xfc4*\x9c.\xa2'X\x08@\x8e\xc7\x0c5\x1aemj'\x98\x88D\x12\x1e\x09\x80\xa84\xe2%Q\xdb\xf0\xcd\x93\xea\x87\xc9\xcf\xf8[v\x0bU\x14\x8b\xd9&+\xcb\x8f\xd9\xa6\xbfx\x18\xabMb\xf1mw\x8fS\\~\xf9r\x01m\xc2\x9c\x8cL\x90\xc5\xa0\x10~\xee\x7fM\x05\x8a\x97\xc7TYL\xa9H\\\xa0\xab\x9cXK\xbe?\xfa&\xa7h_|\x8dWi,5\x0e\xe5\xb8\x11\xe5\x0f\xbf\xfa\xec\x16\xd9\x88-a\xf3\x18iC\x91+\x9aJ\x9f[\x15\x0a\x01\xcd\xf6k\xe9\xcf\x97\xafo\x9b\xa5\x8bV@\xb2\xb9\x06\x83\xbf\x04\x0eBS\x9f\x19\x08\xe9\x0c\x14\xcb\xad\xefkq\xb3Bi6\xb8>\xdc\x8a\xf9\xa6\xd7\xc8\xc7\x92\x82\xf0\xc6\xf2\x8f\x09f\x7f\x01\x80\x91m\x8c\xe3\xc3\xe0\x9a+\xccsI\xee`\xf2\xed\x1e?\x17\x9bj\xb9\xa5\x13\xef:\xa1\xef\xa2\x18\xae\x8f\xca\xb7\x86;RYM\x09\x9a\xfd@,\xd2\xc9\xd2\xccp\xd3\xc5&-a\x99\x84\xdf2U\xb03[G\\7\xad\xdePH\xec\x9e<qM\xc1\x1ap\"J\x0f\xce\xcb?\xf3\"C\xb6u\xb5v\xa5?R\x87u\x0c5gtX\xd5\xd2p`N\xa8\x12/\xfcn&\xda6\x13E\xa2\x93\x8f\xd0\xaf\x1dj\xc3\x14\x1c\xf32\xfeA\xb6K\xcdjaf\xbe\xc2\xa2\x0d\xfe\xea\xe9r+\xb1\xf7\xc0M\xed\xab\x9cw\xc00\xb5\xcf\xee\xca\x1e\x83\x1f|c\x10&\xbd\x94\xe5'\xb3\x00\x9fp\xcbY\x88\x91\xa8\x02\x98\x166\xfc\xadP\x0c\xcb\xa4!G\x06\x16\x0e\x0d\xb8\xb8\xb3d~0\xc5\x07\xf9D\x1e\xac\xb8!r<\xe7@\xd7\xd9\xc4\x1bl\x8d\xb8\xf4t\x86\xd3\xd9OZ\x7fm\xb2\x96\xf6\x155\xa1\x8d\xd7\xa2o\xbe\x92J\xe5\xd5]i\x96\xdaF\x10S\x998\xe6\xc4\xb6\xceB:\xbb$\x956\xaf\xa6^E.\xa8a\x92\\2\x92\xecE4\x85\xcd\xb5\x84\x99\xe9\xc8\x0b\xd7\xfc\xfb\xb6x\x12a\xc5\xde\x99]\x9d\x9a\xac+\xd0qE\xe1\x10\xa4\x8aX\xa3lx\xf5,\xd5\xf7\xcd\x1d\x18;\x83L\xeau3\xf7#SW\xa53\xcc\xb0\x19\x83G)\xfb\x9cV\" \x013\x0e\x922\xa9\x88\xe2\xab\xdc\x0aV&\xaa\x1b~d\xd0\xf9BRm\xb7\x90QSW\x91\xa58\xcd\xe0\x08z;\x1aI\"\xf9\xe2\x88\x1b\x99\xbf\xdf\xb7\xd7\xdfOb\xf9)~l\xf5\xfd^\xacp\x03d\xde\xdf/V\xe6z\xaa\x87C\xdd\xd6\xe1qd\x10\x1b%\xea\x84\xa8\xab\x8d\xbc\xbarJu,}\xdbB\xd3\xc3d\x039\x0bo\x06\x93\xbeX\xb5\xf9\x85\xb9B\xb5\xa4L\xea\xfd\xeb\xa3\x87\xfd\xd1\xeb\xd3\xc4\x07\xf7Md`\x80_\x1b\xcalZ1\xca\xf0\x9d\x1a\xc2\x02\xb9*k\xe6\x99Yj\xe4Uf\x0e\x9c\x16`v\x07\xae\xdf\x1a\xce\xd0\x80\xf2\xd1\xf0$\x15\x81\x88\xc2\x8ec\xe3W6B\xaf\xa47\x8b\x1c7\xf95\x8a\xa47?\xd7\x18\x7f\x8e\xe3\xf3\x01Zf\xa6p\xd5\xa5\xbe\xa4*\xe7ax\x1e\xa1kfk\xd1*\x02\xdd\xac;2-\x96p\x0c3\xd4\xf8\xf2\x10~\x99\xb2\xafi\x00\\\xed\x8c\\\xb0\x88y\xe1\xb1`\xdb(\xdcL\xfe\x12\x92\x8f\x97@#\xda\xd4h\x7f v\x9f\x7fK8\xb9\x93S\xe7\xb22\x86Ploh\xc5P(\xa2jj*\x05^{\xa7W\xe5wZ\x00?\xa05\xd8B\xdf\x98@\xa2\x80\x15\xa0\xa7\x9c\x15m\xb6\xaa\xcb\xb3d#Yn=Q\x1c\x88\x99\x03[R\xa9\xc1\x1fa6\xc6s\xb8\x18\x81\xff\x152Cg\x0b\xd1G\x06\x8dd,\x86\x9bj\xc8\x8d[i\x98\xea\xfe\x14\xda\x9cF{x\xb5S\x03\xbc\xe7g\xa2w\xba\xcc1\x82*\xc6r\xd7#8\xaf\x12\xf7\x87A\x90*\xe3\x16\xfav\xd9\x92\xcc&\xbb\xc1\x01\xd1\xb0N\x98,\xab\x8d\xb9\x90\x85R`\xb8\xeaP\x0f0LH\xdbf\xa9.\x1e\xe4A*\xe8\xa0'.\xd5(\xa4\x12!\x18\xfa(\x1b\x12\x9f \x0d2\x0cb\xff\x11\x81\xcbu\xb4\xc2\xab7\xcb6ln\xe9\x9b\xd7;\xba\x0b\x1b\xfa\xe1\xe5O\xa4J \x98\xaa\xea\x8c\x194\xf5\x8e\x84<\x95r\xbc{\xfa\xc1\x83{'\xd9\xd8\xe3\xa3\xd7\xe7\"\xb3\xc7j\x87\x1bJ9{\xa1\x9b\x17v\xf1A\x8a\xa6\xd0;)\xf9[)5^\xae\xc1\xc1[\xaco\xf3o\xaf\xd6*H\x00\xab$\xa7\xfe\xf3\x19\x0e\x8e\x19&\x0b\xccp;\x8e \x85\xcca\x00\x9adU(Q\x99\x91]%+\x13\x17\x05x\x9b\xba\x09\xa9\xaf\x09[k\x06s\x99\x93~\xcbv\x13\x9bh\x99\xda\xf8\xe9\x0dX\xaf97'vPRg\x97Qs\"\x83\xcdb]\xd8\x93\x8a\x85\xcaG\xdaZ\xd9\xaa\x97\xc25<&;;\xcb\xa9\xeb\x804\xde\xf8\xd1a\xa5\x04C\xc2f\xc9B\x96\x16\xddf\xbcz\x99N\xb1\xdeO\x98g\x8f\x96\x89/\xe6\xd2\xa5\x95}\xef\x97K9^'\x8e\x931p\xdd\x00\x9c\xe13\xc0\xca\xa9\x1e\x08\x18\x861ZN\x1c \xeev\xd6\xe7\xb11\xc2\xfd\x0d\xfc\x8d\xb4\x8dq\xfclb\xec\x7f\x9b\xc7\xad\x0f~\x12\xb0[\x03\x8d\x81\x1d\x16+\x0c\xb4\x01\xf5\xb0\x05\xb7E/;\xf9\xd6U\x9b\x16Nn[\xd0\xa8\"\xd7\xe7\xd1\xdc\x80\xaf\x9f\x15\xc7\xea\x1f@\xe4v\x95\xc4-\xfa\xa1z\xd4\x86\xcb\xb0\xb7%\xa6\x8edjP\x90*R\xf41_\x056\xd4\xda\xa2\x9b\xda|\x98\xa7\xc7(\x03\xc9.\xd0(\x11\xe1U\x8by\xa1\x18\xa7\xc9\x16\x0bSD\xc4/\xa1\xe5%\x93V\xe2\x04\xd4\x0cR[\x84\x033\xfc\x01\x8e0\xcf\xa5b\xde\x8e\xd5\xe7\x14\x07\x84\xb6\xca\xc5\xaf\xf4`\xb9\x9c2\xed\xbb\xa7\xab\xa4\x9c\xdf\xe5uw\x05\xe2\x05^\x13\xa2\xa2\xabt^\x81D\xe0\x08Z\xed\x1f\xc5'\x8dl\xeb?\x8f\x19\xc5\x8e\xf2\x9b\xa2Fl\x99\x9b\xd3\xb8aB\xbf\xb3\xc4s\x9d\xdb|\\R\xe6\xba\x0b\xa4 \xf6\xf5\xa2\xb1(\x85\x99\xf0\xc8\xe5\xcf\xcc\xf3yV<\xd8\xd5]p\xb0\x89\xd4\xdb\x94\x09~[\xa8\xa1\xfa\x9a\x82\"\xa3h\xb9LK\xa8\x99\x0d\xe9\xcc\x7f5\"\xfe\xf3\x19\x8fl\xed\xad7q\xc9\xeaP\x07\xbb\xe9\xc2{\x0d\x8do&9\x94k\xd2\xd5 \x0c+\xdayw\xd1\x1d\xc6K8@DD\xc7\xa8\x074\xe0\x9f\x84\xd2\xb1\xb0\x8f6\x16\x1c\xce.\xce\x8ch4U\x1d\xc4H\xfc\xb6\x9a\xa0?\xc2\x1e?\xab\xa6n<`\xcf\x06\xe6>\xb75\x0b\xebE\xef\xc3\x10\x06\xbe\xd5\x02\xb2\x84\xd6\x05y\xb2\x94$\x9d\xe4\x8a\x17{\xe4\xd5\xb2\x85*\x01\x1d+M\x05g;Cr\"{\x98|\xb2\x13sA\xedR\xa1@\xc1f\xe1C\xa1\xff\x15@b\x9bq\xec!\xe8)\xca(\x7f\xd4\x02\x8cG\xe5\xd7^\xbf\xbe\xdd*\x9f\x1b\x9c\x18\x0f\xdc\xe3'U\xb3'e\xe6\x1aU\xfc\x04C\x9cp=\x0c\xa9\x84\xf7\x12,{\x8epZ\xc8\xefH\xfc\xf6\xec[/U\x93T\xc9\\\x93\x15\x05}e\xed\xf1>\x0d\xedq\xd2\x7fj\xd2M\\\xc5\xffS9\xdf\xd5p\xf1\x15Z\x90\xd7\xea\x02\xfd\xc2\x89)\x0ab\x95d\xc2rsR\xda\xb2u#\xf4\xd5\xf7\xe0\x8f\xd5\xb1\x04\xef-$q\x8a8}r<\x84\xe8\x94\xf2\xf8I\xef-\xbf\xc5\xdb\xac8\x08\xe1\x80#t@f _.\x0beN\xae?2 \xabo`H\xf6\x86\x0b\x85\x81\x16c\xb2\x89\x7f\xadOkO]\xf5g\xde\x98\xec\x09\x0e\xad\xd58\xf3L\xf5o\x81\xe5\x8a\x13\x8519x\xcb\xc3k\x98\xfc\xe8\x8c\xf0Z\"\x04\xcdp\xd7\x1a\x13&\x19\xaaN\x1d@3\"9\xd5\xd3@+\xcc\xf4\xb4E.7\xc7]\xb48\xb2P\xd1j\xae\xecG8\xb8\xe0\xd8\x8e\x0c\xb2\xbd\x9aB\xc3\xb9\x18\xce\xda\xcebB\x19]\xd1\x1c(\xc6\xcd\xca\xea\x0ay\xb2{/\xbf\xec*\x8e\xde\x16\xbb\xf2\xeb\x80yo\x9e-XdB\x8bP\xb0\\x\x07\"\x04G\x0c\xad\xd2\xce\xbc\xe4\x89Kk\xbb\xd9S\x1a\x94U\xa1Q\x96\xb4\x0a\xf8\xe1\xec\x03\x91\x06K\xb0\x93\xe1\x0c\x07\xaew\\\x1c\xd2\xcb\x0ft\xd5kH\xb9\xe0S\x16\xf8R^\xd9\xacHB\xe2\x9b\xc7\x9d\xc3a\x9bB\x00a\x1b\x9a\x14\xdfu\x96E\x09\x0d6\xc0\xd9\x1d\xf6\xf8\xb9\x93\xea]\xbeE\xd2dY\xc6\xa5\x7fH\xe4\xdf\x97G\xc7\xf3R\xe2/\xfa\x92\xb4\x09g$\xcd\xd9\xc4`+.\x06\x898\xe2E\xebR\x07\x93\xbd\xe0'\xff\x898\x87\xc0\x0d\xf4\x9dm;\x85\x8d\xe7\xc1\xda,\xbbC\xe9:\xb2\x90,\x12^\x17$r\xdc\xde-`\xf9\x84R\x10\xfb\x19\x9f6\xcbM\xb2\x9f\xf5\x09&U\x88q\xca\x92X\xaa\xdd6\xf5^\x1bf\xb9\x94W\x89E\x03\xf8\x86=\x1c\x9c\xd9\xc1\xab\xf1I\x05O\xaa/OV\x84\x99^\xe4y8z\xe0\xb8\xe8,\x8a\xc5\xc7\xc0\x9b\x85\xe3\xcf)\x83\xa3\x03\xc5\xf8\x03\xb4\x9e\x0d\x99Yl.+%\x8f\xffV\x0d\x11\x8dd\xcfkj\xa1\xad\xcf\xdf^1'\xde\xbcL\x1c\x9cT\xac]\xf6\xc7\xf0\x8c`\xe1\xf7\x92>\xb4\xfb\x11'\x17#\xa6\xa6\xa4n\x92\xc8\x99\xd8R\xb2]\xa6N\xcc\x9bQ<\x80\x16,\xc6\x1c\x09\x8d\xc5&8\xd0\xdbe\x05\x8b\xb0\xa6\xa3\x09O\xd5\x1e\xae9\xb78JB\xbf1\xf7\xceb\xc3\xbcZ\xc2[\x0a\xbd\xca0\xc1\x8dZJ\x8f\xd1Qz\xf3u \xa9H[y\x81}\xe43\x06\xd7\xd8\xa9,\x04\x85Vk\xb4\x81\x05\xd2\xe0\xdc\x96[\x8b\xee/'\xd5n\xb3\x07\xdf$\x80%\xd5\x03\x95D\x0f\xe7\xa4[h\xc93Q|\xfa\xac\x9c\x06_s\xa8\x7f\xab\x1bg\x83\xe9\xbb$\x137\x83]E\x9d\x8atR\xaf\x8a|\xbe\xc9\x85\xc1\xa2.:\xa2\xf0\x95\xcbBj\x04\x10h\xbb\xa7\x1f]\xea\xd89\x16\xb0\x8a\xcaTt\xd86Q\xe7\xbb\xf1\xda\x89\xec\x06\x16\xab)\xa7\xe1\xaf\xbf\xa2\xc7\xb8Q\x91\x9e-\x9fJ6\xbe\x83d\xfa\xb3\\\x0a\x11\x9e\xb2\xf9\xe0\xc6\x86P\xa7J\xee\x93t\x12\xf9\xf9\xda\x94\x16\x0b\x85l9'\xec\x0c\xd6L\xa4\xdb\\\xa0\x017\xf3\xf2\xe5\x10\xa2\xefn\x0c\xff\xda\xc1\x1fAu6+[\xd0\x16V\xd0\x93\xbf\x18>\x98\x84\x9e1k\x05\x846\xfa\xf0\xb8\xcc\xe1:\xb9\x13\x89\xb5\xae\x82\xd8\xe8\xe4YoF\x84\x9dv^\xd8*\x19\x9c\"\xad-~*\xca4Wr\xf2\x93\xea{\xbeU\"8\"\xc2%\xd1\xc17\"\xa1ah\x1a\xcaKU[\x144\xe9\xfe\xc7\xb5\xd5\xed\xed\xd3\xdbV\x99\xc4\xdaA\xea\xd9>\xa4z\x9b3t0`.\xf1\x1e\xf35\x81\x8f\x84xN\xc9\x1d\xd9\x8ax\xf6nA&\x9b\x15\xb2\xb9U\xb5\x14\x82]\xb1\xd0\xdba\x84A\xaf\x94\xb8\xfe\xec\xb8\xaa\x8e\x0e\xd8\xc7C\xb1{\xb4%\x1eI\xaa\xf5\xf6\xaeh\xa9\xf3\xedY\xd7c\xae/\xf7\x18\x0c\x84\x8c\x80\x89\x8c\xac\xac\x15\x951\x8c\x09_T\x9a\xe54\x17\xb6\x0f\x7fE\xf2|cmD\xac\xe6;-\x80\xc3\x18\xaa.\xe4\x1b=W\x12\xc4\x97\x1a\xb3\x02L\xbb\xb5\xdc6\x09\xcaj\x02#\xdc73zJ\xdf\x12\x0cy\xf3\xa3\x96T\xa6\x15e\x1c\x00N\xa92n*\xd9j\xea/p\xef\x0e\xc2\xfc\xc7\x1b\xae\xe1\xf53\xe9\xec\x0d\xe5\xb3\x85-]\xeb\xc9\x87\xb5\xc0\xea\xdb\xe7\x09S\x13\x91zK\xa5\xf7\xba\xd2\xa3mg\xd2\xec5\xe6\x98!\xb3\xb4\x81\xd0\x82\xb9\xdd\xb1'z\xf6\xad\xdf\xe5>" +
+	"\x82ld\xa9\x12\xden\xe1\xfe\xfb\xd9c\xcc\x091p\x97\x89\xd7=F\xfbv~,\xd1#\xb0\x0a~~J^i\xf9}\xca\xfc\xba*8\x16O[\xec\x97\x17_\xb1n\xa5E\x1b\xb1\x9eTU\x918\xe7\x83\xf8\x91( \x1e\xb5\xd3;\xe6!\xa2u\x05\xd3\xa0\x86\x0fr\x0au\xa6M\xe7\xa5\x14\xd2\xa88\x91\xc74#\xf0\xb7|\x81\x07\x07\xc3\xc5\xf7\x95\x83,\xc8\x8b\x8e\x1c\xa10\x92\xadN\xed*\xdcQ\xc8\xaeJ\xf5\xfeh\x15 J\x82\x9b\xe9\xf1\xde\xbe\xfcJp>\xe7J\xb3\x86K\xa5w\xd9\xb6wgDM~\xb7\\\x85#\x973\x11\x86\xd4\x849\xd6=\x05\x85\xc4T\x09\xad\x80\x09#:Z\x1fc\xaa\x87\x1e\x9aj\xac]w04(\xa9$\xbe\xdck\xa75\xb4Z\xf4\xe5\xa1\xcaHn\xf2g\x15\x86\xbc3\x1c\xf7\x0fp\xd4k\xc2\x17\xc5\xbb5u\xf5\x01\xbe9\x00s\x8bn\xe4\xe9\xb7Q\xa4\\\x0d\x0a\x93|\x8e\xc6W\x12\xb9A\xd3+\xb2\x0f\xb1\xe9\x92\xbe_\xb2\xa0\x1c]\xa3Bb\xa4\x04I#\xf4\x88\xb6\xe4\xc0\x8aV\xd0\xb8\x83\";\x89\x01G\xb6\xd6>b\x80\x94\xb5\x81\xf4\x06s\xa0d\x0b\xad_ \xd3\x95\xa2j\xb30%\x10\xaftz\xa2r\x91\xb9\xaf\xa1\x9cc\x95\x05a\xff\xd9\xc4|\x0f\xdb\x1cr\xfa\xf2 \x8fN\xddL\x8a?\x9f\xf9-\xf8)\xd1\xd1\xdf\xc8D\x80\x11Z\xc6\xe4\xe0A2\x10\x92\xb6o\xeb\xdf`\xe2k\xb3\xf7Q\xdc:r\xe9\xf1w\x8cuOt\x08:\x9f\x90\xa7\xe6\x8fz\x84\x81\x8a\x8b\xd0\x8ces!\\\x96'\xdcj\xc9\xc9\xdfbT\"\xac6\xfbT\x9b\xf7\xe8\x1a\xdf]\xd0\xd4Yn\xd5\xd7\x8b\x0a\xc9\x84\xc1\xa79\xb0\xab\x06\xdf\x89\xa8\x0d\xa5\xb8\xa9\xdd.~\x0c>\xc9\xa5\x1e\xdc\x01\x8e\xac\x8f\xd9W!\x19\x12~\xf5\xbd\x03\xa6\xa0V\x86\x1eV\xd1c\xfb\x9c\x92]\x07\xdfB_\xfd9\xb4\xdb\x8e'\xe5\xae\xfawh\xc1\x1d\x9f\xb0F\xa8}(\x96\x1cLl\xfc\xa8\x00\x85\x8c\x8c\xab\xc5\xe29z\x9d\xe5\xdc\x0c-c\xfe\x95\x02R\x17\xaf\x9b+U\xdf\xc8\xaf\xf86\xc2\xe2\xd4lZyz\xca+\xd4\xe8\xc5(c\xe4\xf5\xe7\xfa\xba\x14\xf5\xdbX\x9c\x93\x19m\xb4\xdda\xba\xa7h\xb9\xd9R\xc0nI\xbd\xbb\x853ia$\x0d\x9eHN\xaeJ?>]\xa9\x8a\xf9\x85\x9d\xca\x8e\xaf\xca+d\x1d\x81\xa3\x07pM\xd8;\xc6\xc6\x85v\xa1\x04B\xff\x90\x1c\xcf\xb9\x07\x18\\\x83\x981KTQ\x9e\x91\xe7l\x03>`\xc4\xa8\xdd]ur\xae\xc4\xfa\xee\x9e~u\x19\xbe&4M\x1e'\x94|\x9c\xa1\xdd\x9f\x81\xc1\x04\xf7N\xe4;r\xbfU\x17w?\xb2\xe2\x87\xa4\xab\x13\xedg\x03A'\x91o\x8d\xe2R\xb8_B\xe0q\xef\x88T\xc1\xd9-w\x066N\x1b\xd6\x86\x8aT\xa4\x8eI\x9e\x1c!\xfd\xb7\x8c\xf2\xd0\xc6+M\xbb\x06\xa2\"\xe10\x8a\xd4\x8e\x96\x07\xde\x90e\x9b\xd7O\x1au\xac\x07\x7f\xe1D\x86i|\xc1:\x16,y\x1c\xb5\x97z\xc0\x06\xd2,\xda\xca\xa3?O\x97\xef9\x9a\x87IA\xbb\xba\x17\x91\xbd\xf0\x98t\xdd\xb0h87 b\x01\xc0\xe1:\xff\x05\xc6\xb3s\xe2\x19\x9e!\xecA\xef\x09\x8a\x87\xb1\xacy4\x95\xc1\xf7{v\x030\xa6jA\x99\xa6<y\x06\xb86\x81\xb7zX\xc1d\xde=}a\xd82\xd0\x9d::\x81\x14%\xc1\xa7\xa8\x1dI\xdc\xdf\x11H\xb0B\xb5\x04\xbd9\x0eW\xb8\xac\x92\x0c\xf7\x9e/p\x90\xab\xff7\xbe\xf1\x97p*\xc5\xfcHH\xcc\xac`N\x92q\x07\xb8@\xb3\xc7OI\x06XKJn\xc3\xb9$\xc3\xad\x88{Z\x93<X_\xf7uSu\x9fm\xe4>,AHDZ\xfa2\\\xc34\xb0\xae\xc1\x18d.\x00Nz\xc9\xaa\x11\xd0\xfb\xdb\x95\xee\xb8\xd9\xe3\xa0h\xd9\xd1\x0d\xae\xf3\xef\xaa\xc9\xd9\x9c\xac\xe2\x98`\xb2\xef\xbb\x04\x91Ija\xd5PI\x9f\xb9D\x0f\x94\x0a\xcbDj \\\xc9ClV\xda\xb9\xb0\xf8\x15\xd8\x81\xae\x0b6?}u[\xeb\xc8\x0b\xa5x=\xc5\xbf\xe0\xf4q\xe1\xc1ar\xe4\x193\xadZ\xd4\xa9\xfa\x02\xa3\x83\x89\xdf%\xf2\x0fm\xe8\xef\xc2\x17\x8ay6-{\xb8\xee\x1f\x89\x08Bz \xa2\xcb\\\x0f=!w\xb4f\x10O\x88\x9bAn\x15'5=\x88\xdd\xa0\xc3\x18\xd1w \x0a8y\xcb\xc0\xf8HF\x9c\x10\xa1s\x96\x90\xacNr\x95c\xdc\xac\x14u\xb7\xf8\x10\xa5R\xd7\x03J\xec\x18\xf1\xe1\x078\xf9}\xf8P\x0d\xb2\x10\xde\x95\x00T\x9eF\xc4\xb1\x1a\xc5\x8a\xf8\x06\xaew\x05\xeaHy\xd2\xa6\xbe\xd4\xfd\xe4$|A\x9d\xbe\xdd\xc5\x99\x1c\xba\xb7\x81\xc1\x1d\xc2H\x83C\xe8\xdd\xd9\\\x95\xa5\xe4!\xdaI\x94\xe5oF\x85,\xbf+\xc8\xce\x91\xe4\x94\x1a\xceZ\x99\xa4A\xdc\xd2`\x0d\xc1\xb3\xab\xe6\x89\xfa\x11\xcc\x08-)g\xb2K\xa9#7\xda\x93\xdb\xac^\x93\xe2\x03\xe9p#d\x9d\x8eKD\x0e~\x81\xc1\x8d\xfa`ghl\x1d3\x81\x1dlu\x8af0\x89h\x0d\xe3\xb7p\xefq;\x0b\xbd1Ao\xb4\x10(\x1a!..\x8d\xd4\x1b\x86O\x11\x0fa:\x03\x1b\xbe\x85\xf0\x84-<\xe4\"g\x178\xa6G\xa7C\x9e\xf7\xcd8\xee\xb2\xe8\xd1\xee\xab;\xd9dw{G\x91\x85\xc1\x09\x10\xe0jw\xab\xd7\xf2\xc3\xe4\x93\xe6\x8f\x94\x04\xe2\xac]`\xe0\x17\xc2=\xe6Tw\xbe\x81t\xc0\xb2\xdaS\xca\x84fU\xe8\xd0g\x9c\xdf\xc6\xdcE\xeaG(6by\x87\x8e\x8e\x97\xfe\x98l\xc3\x8c\x08\x0f\x948\xae\xac(\x81\xd6\x8b\xe0\xf1W\xdc\xf0\xa3\x10\x1c\x0e\x86\xaf\x19+\xb7(\x93\xf3u\xe5T\xeb(\xa5\xbb\xa7\xea\x9cm~\x10M\xdf\x1dUi\x1a\x1b\\L \x09\x04PoPi\x07\x8ep\x95^\x9c\xc0\xb6\x0a7\xa7!\x19\xa8\xd0\x00\xc2\xc1\xe8;\xcb&\xb7\x09f.\xf4[\xa5\x0ezR\xfd\x14o\x92[N1&\x7f\x11\x9bD\x06\xf8F\xec\xfb\xb0@d\xab\xd7?\xf7\xa7\x06\xbc\x15UP\x83F\xb8\xf5\xf0\xef\xa73\xb8\xc3\xb5\xd1{\xf7\xb4\xd4t\x1c\xf4\x8cm\x96\xa2\xec\xc3V\xcehK\xc3\xa5\x9a\xc9!\x07\xf2\xe0\x04\xd2K\xff\xa8\xdc\xc1w\xde\xf4KC;\xbcg\xd4\xa9\x89h\x0f\xfe\xec\xdb\xea\xdf\xfd\xd1\x8f\xbc\x15\x0ai\xd6pp\x08\xa4\xd5h!y\"z\xd1\x0e1\xe4,\xed\xe4\xc1i\xa1\x0e\x8d\xe9c\x1d\x8a\x04X\xd4 \xaa@\xcf\x9e\x09\xee\x8d\xa3\xea[7\xee\xaasx\xb7\xf3\x8e\x0b\xe9b\x95\xe8.\xbd\x18\xc7r\x8e\x8e.\xa8A\xe1\x9a@\x8e08\x96F\xdeh9\x0f\x86\xfed9\xf7\xc1\x1a \x156~Gw\xef\xfa\xfd\x07o\xabl\xf8\xc1\x9c\xbfD\xff\x88\x17k\xbe\x91\x05\x0c\xf2\xe56\xd9\x82\xb2\x8d\x01j\xf7\x1b\xde\x82fx\xa9],\xe2\xdb\x9fs\xdep?A5\"\x1d/5|\x03\xfd\xd1\xd3\xb3\x1f|u\xcc\x8e\xae\x07%b\x1b?_\xa0\x99=\xc6\xae:^y\x8c\x93F\x85\xe9\xc2\x1dH\xc5E\xe3\x0f!\x85,\xaf\x8e\xcae\xdbG\xdcA\x06\x80\xa4.-\xf8\x98\x81c5\x02\x9cl\xdd\x16g\x10\x98D\x82\xf6\x1e\xf3\xac\xb2\xd4g\xb2\xa4\xcbbg\xfd\x03L\xd2\x9f\x05PtV\x1a\x92\xa1\xd4~\xe8\x9d\x8dLu\x08\xce\xc7U\xdf\x9d\xdd`npu\x81\xb8+k\xa6\xc7E\xa2\xf1\x95k\xcf\xb6\x86\xc8\xb0\xfe\x8e\xba\x83MN\x1f\xee;\xb2\xfa\xb3<+\xb2\x8d\xc7kP\xe5\xdd\x07\xbf\xe8~\xceYAh\x92\xb3\x81\xcf\xb6\xff\x19\xb8\x88o[\xcc\xc0\x86k,ac/0\xf9\xb3n\x03\xb4\xb4w~{\xb5\xc5\x9e\xad,\xa9\xd22\xfb\xa4\x89\x0a5b\x05\xb2j\xc6\x854R$\xc6\xeb\x12\xfb\xefgw\x94d\xde\xa0\xf1[\x03\xd3\xd3\x1d\xe9\x87q\xee\xc4\x7f\xf7\x15LN\x8f\xd1\xaf\xbe\xbb{\xbe.`\xfd\x81@\xb3'\x17\x19<\xf4\x85D\x03\x89\xd3>ak\xac\x07\xa6n\x8c\xdcDQ\x91\xcc\x11\x8d\xa7\xd8\xdb\xd1E\xe6{r\xe5E\x02a<F\x84\xe2\"w_\xff \xbe\xa3}\xb4\xabo\xae\xe1\x9aY\x97\x92GQF\x96*\xa52v\x09c\xd3\xcd#s\x1dh\xb1\xd77B~\xc2W\xc1e>\x11\x81\xc5\xef\x08\xdd\xca\xe09\xb7D^$\xc8Umh\x0b\x03\x95\x1d56\xa2\xdc\x81j\x99\xe8\xea\x9a\xe0\"\xd3\xe5\x1d\x0e\x9aj\x08\xe8\xb669\x99\xbe\x17\xedY<\x081p\x94\xdd\xf9\x89\x80\xdc\xff6Aw}\xd9zyEP;E\x0161\xc24\x9bOT\x83 \xf1\xd8y^\x90\x97cv\xce\xba\xe8\x9ao\xb0\xf1\x93\x0b\x15\x85\xbb\xed\xdd%o\xa8\x9b\x95-\xf2K\xc2\x98\xe1\xacI\xa8}u\x8d\xc2\x8e#\xd7\x99\x1f\x88\xd8\xa9\xd9D\xba\xdal\xaf\xca\xab}j\x11\xce\x1asa\xc1\x82\xa3e)\x13+2\xd3\xa9P\xf9$\xf2^(\x89\xea\xa58\xaf\xb1\xa1\x97\xc9\x96b\xe2\xa3\xac.j\x18\xf4\xfe9\xa4\xe9h\\\x99\xaa\xd5\xb0\xb4SZ\xb3I\x1e-BN=\xeb%4\x94\xb3\xe4\x03H\xe7\x12\xfd\xfa\xf0\x901\xa2,\x17`\xf1&v3\xe9\xbe\x8by6l\x06\x96\x9e?2gY)HY8\x83/\x9d\xa1Y\xf9~y\xfem\xee\x16\xecos\x0ebFT\x9fl\xfe\xca\xb9ACj\x9b\xfa\xd8\xfcL\x96\xc0C\xc5\xbcM\xcbn)\xdf\x84Z\x0b2C\x93\xc2\xe8\xddre4-\x1e\xbc\x8dC\xe3\x17DT\"\xd97e\x98\x99\"\x8e\x9a4g\x0b\xfaH\xd3\x946\x0e~\x87/\xf0+\xe7\x0c\x1a\xbf+\xfaa\x10\x82\x8d\x09\xe3\x10\x9f\xca\x0f'=\xa3;\xc9c\xf4\xe7\xee\xee\xde$\x91\xa58\xbap\xd5H5\x93J\x05\x1e\xfc\x10g\x08\xbc\xf2\xf8\xa93C\x93Xf\xc8\x92\xb8\xde\xcae\xe3r\x1d\x8b/\xbfFE\xf1\xee\xee\xed\x95\xd5:\x98\xb3\xfc\xf4\xbc\xefi%7\xc2\x1a\xb1.t\x11\xd5F\xbb=\xfe4\xf4\xd4\xdf\x11\x0e\x83\x89\x814FI\xd0\x97\xb3\xaa\x91\xcf\xf0\xfe\xef\x16\xc1\xfd<\xb0\xf6-Q\xee\xbe\xe3\xe5rp\xc7s\xac; ,\x94\xb0\xcc\xda\xbcgR\xae\x84\xaad\xd7&\xe8\xb3\x98\xf6\xad\x11N\x92T\xc4.i,\x16\xb3\xfa\xf2\x0e\xe4\x15\x87T\xc0\x8d*\x89\xff\xe9?\x8c~\x00\xfe=\xfc\xaa\xdc)\x7f\xaaz\xe8\xea\xff\xfc\xa7\xbf\xff\xd7\x7f\xfcO\x7f\xff_\xff\xf1?\xff\xfd\x7f\xfb\xfb\x7f\xfd\xc7\xff\xf5O\x0b\xef\x12\x13\x8fx\x9b\x8b\xbfw\xd4\xa1\x06\xdf\xa1\x99\x8eO\xeeB\x8bO\xe9\xd5\xb0\xdb\xa8\xc2\xf2T\xb7p\xc1?\x92o\xb5\x0b\x8f\xe4\x17K\x18\x97\x88\x14\xd4w$\xfb\xec\x0aCR\x84\x9b:\xd0G\xb7\x0f\x1d\x91\xb0\x13\xe1\x87\xadz\xd7\xd1\xe1\xf6A\x9bM\x05\xf7!V+\x19\x81\xceou\xad\xa3\x19F\xb3\x01\x8fJ7\x09a\xfafc\xf0{\xa7\x95M)\x10\xa1\x7fH\xb2\xaf\xb4Wc\xf8D\xe3\xff1\x1a\x15\"/\xd0\x9f\x1a7\xad#n\x93\xc2\xb0\xef]$$V\xbd\x12J[lw\\\x03\xa1\xa6k'\x81\x7f\xecN%\x9b+\xa3&\x12\x06\xf2~\x0dRa.\xc1i\x83\xf4`?\xc2y5\x91\xf5\xc2\xbeQ$\x87\xe0\xe6\x7f\x00\xa4`\xc2.\x8d\xf53\xcc\x1c!\x18:\xb3K\xee\x8cC\xaeeg\x1c\xb8I\xb4\xf6\xb4v\xd4T\xe8\xac\xd6\xfb\x949\xa2\xf3\"o\xb9\x94T\xa4\xfc{\x00\x16\xed\xb9fn\x82\xa9\xc1\x89^~\xf0\xc8\"\x8eW(\x1d\x15\x06,\x06\x17\xd8/\xd9V\x89\xce\xe7B\xf1\xf2\xe7\xf6s\xb3\x0f\x1f\xab\xa4u\x99RD.\xd8a\xf8\x0e\x98\xec\x81U\x17O\x98\xd3\x1c\x9d\xcda\x92>\x8c\xeb\x19A(@\x86\\\xb0o\xa3\xe7\x92\xbas<w\xaaO\x9e-\x9a\xa3?\xfaD\xfc\xcdJ\xb5K!3\x1e\x0f%\xa5\xd2D\xaa&-\xf7\xc1\xaf>\xf9\x81l\xa7\xb3\xbf\x8f\xd8\xbc\x03L\xb46\x1b\xb6'<\\R\x01\xc9\xc6\x15\xf7\xb3\xa30\xcc\xcd\xa8{\xb2&\xe1\xebJ]j\x94\x03\x91\xc5\xc4>\xa2,oY\xea myT\xa3X\xac\xa7O\xe9P[\xf0\xdc\xce3V\xb1\xc9S\xf5\xff\x91\x99\xf3\x9az\xc0\x14\x90\x1dS*\xdc\xfe\x10\x16T\x0b\xc6Mh6\xe3\xab\xa8\xdd>\x0f\xb7q\x01\xc5J\x88-\xf2\x93<.\xc2\xf6\xa6@\x02\x95\xddIJ7\xa5\xca\xaaT\\\x1eY\x1d:h\xc15\x91&\xe3\xb8\xd0\x96\x1b=;\x9ec\xda`\xac\xdbz\x04'\xf8EL\xc0\x95A:L*>ax\x0a\xa9\x8f\x84\x94n\xa5&c\xa1\xa7e\xe0\x1a\xc6\xad\xf9\xa3%G\x1e\x9d\xfa\xbdU\xab\x8a\xe3\x18\xd9-\\\xb7t\xf06\xbftW\xeb\xde\xa76:\xbf\xf1\\\x04#?\xd0\x0a\xc8\xf3\xc8Z\xc9\\\xd9f\xe0\x05[\xa9\xda\xb351\xe3\xafIpWB\x0a\x17\xfa\xd6\xc4\xf0JA\xacOu\xf7\xf9\xf3\xb9D\x91\x1a\xebQ\x03\xda~[\xe9\xcd\xaf\x9b<ww\xcb\xf8\xc3\xef\x18\xfe@/e\x97\xc5\xab\xbc6\x0d\xad$\xa5\xb2`\xbb\x8b\xdd\x10\x85{\xb6\xfc|\xd1tV\x0d\xa1\x154,[&|\xbfj\xedrh\xf1(\xac\xc9\x99\xfdWD)q\xc0|\xef\x06\xb2\xd4\x1a4\xbbF\x02\xa2\xe2\x099\x09\xee\xe9\xec\x88\x82\x09\xa5\xb9\xc2\xc3\x19*Z\x1b#\x0a_58\x8aC\xeb2t\xae\xfa\xeb\xbc\x11e\xe8\x86aj,\x85\x08\x97\xba\xc6\xd1\x7f\x97\xd8zM\xc1\x0da\xbf!5O;R\x8c\xa1F\xc7\xd7\x8d\x96\xe2\xeff\xf2\x89!\xd7\xeb\x1c\xf1\x9a\x9a\xaf[\x87\xf9\xaa\x84\xea\xcb\xd4\xd5\x9a\x04\xfbL\xb3^,\x05\xb2Vm\xe5C\x84\xab$\x7f\x97\xb5y\xfe|\x89\xdd\x16\x1e0r\x9fJ\x88\xdbF8l\xba\"l\x81\x1dT\x0dW)\x18\x8d\xdbT\x9a\xc3\x96I\xcf\xa5\xbe31\xa7\x84n\xe3\xda,{\xb9\xdc\xee\xb3\xf72\xb0\x84\xb0\xdc$\x1e\xfd\x08C\xb51C{\xcb\xb8$~sf\xecq\x12\x11\xba\xf6Xj4\xce+\x90\x8e.\xd4d\xeaC$Fw\x9c`\xb1\x1eIfdVM\"\x96\xdb\x00\xe7N\x817y\xb9\x7f\xc1\xd9G\x03a\xf9\xc1\x13{\xe8>I\xc9K\xe47\x0a=\x09J01 3\xaeP\x0dT\x9d\x1dq\x033\xdeD\x9c\x11\xe3&\x1ar^v\xc2\xac\x02\x15\x13\x12d7*\x85\x0d\xa2D\xe3\xd5\xf8\xd6\x9b\x82\xdb\xa8t+6\x10\x11F+\xf46\x90EQ\x95\xe2\"\xa2\xf1\xa7G.\"+r\x1c?\x17\xc4\xe6\x1a\x0fS\x8a\xe3Y\x9e\xe4\xb3\x9d!\x0dX\xf2\xae,a\xf0F\x99\xc1\"\x90\xbcw\x08.8?\xf8$\xa0*\x95Qd\xe6\x1e\x9dL\x19\xc1\xd9\x0c\x18\xd1O\xc9}\xfbEk\x81};q\x0d\xad\xc5\xf8\xa4\xe2\x85t\xce\xbd\x83^F\xa3x\xb7pe\x0c\xdc\xef\xf1S\xb1l\xe1\xe2N\x18\x0fV\x9a*\x89\x89\x87\xeb.o\xbd\xc9\x1a\xf3}\x94#4\x84\x8d\xd8\xfb\xff<\x82\xe2A\x925\x09y\xdc.b\xc4\xa5\xcc\x0cQ\x0eS\xa3C\xdc\xcc\xb4\xaa^Y\x84b\xa8\xee\xafe\xea\xb6\xd4\x03t\x13\x1c,\xaa\xc3\xb0\xc3\xd6=\xb4\x99\xc9?\xd6a\xf1w\xf9Q\x15\xf4\xd0\xec\xc3G\xff`\xe6\xf5_\xcb\xb2d\xc6\x0c\xd8-\xda\x01\xb7\xaa<=K`\x03%qF\x81\xda\x09\xf0o\xceaO0zY\xde\x96\xc0\xf4\xcc\xb0P 0A\x8a\xcaj\x91\xb8\x19o\xc5O\x91\xc0\xc3\x1fSw\xff\xec\xd9\x12<\"\xeeg\x0a+\x09w\x11VH1\xe5\xa2Z\x88\xe7\x14o\xe2\x89s\xc6\xba0CyK\x96\xb1\xff\xbd\xab:\xe8yJ\x86\xd8\x1a\xf4{\xf9\xc5H\xc3\x1d\xbd\x80\x8e\xa5d`C\x03zO?\xb6x\xdfkt;\xcf\x9e\xc7\xb83?\xae\xc2\xd9\x7fuS\x9fD\x16(\x1f4G\x91E4\xf1.aT\xd2+\x09cP2\xe9\xec\xd2\xb9\xf8xj\xff\xfd\xc5\x9daJ}\x8a$\x16\x16\x12\xf0~\x8c\x0d\\p\xde\xa8\xf8\x03\xb7\xcbp\x86\x8e\xd7\xd8qYcS;c\xc5\xbbI\xbc\xec)\"\xbd\xfc\xcc\x89\x1a\xc3\xcc5\x9b\xcd\x90\xc8\xae\xe5\xaa\xcd\x01>\x01\xc2\x0e\xa3)\x9apt\xb7\xf5Dl\xf0(\xd6`\xc2&g+h9\xd6\xcc!o\xbd{\x84'\xc3\x19:'\x82\x15\xc8JZ\xc9%\x91y\xe5@\xd5\x8eN\xf4\xed\xd5\xf2\x09\xe6\x83\x19\xe5\xc27\xc8.\x01a\x046\x9a\x9a\xd9\xee\xd2:\xfe(\xa3\x95\xf2\xd9\xe0\xe5\x7f\x96\xa7\xcdH\x05r\x0d \x81\xc7v\x07\xe8'8\xe7zk\x8b\xe1\x1d\xdd\xf5\xdcB\x9c\xe7\xf8\xb9?\xd1\x06`\xafG\x1d\x7fm\x80\xbe*2\x7f3?\xe4F\xe2\x13\xae\xdf" +
+	"&=\x1dw\xe2\xe3,zE&\x84?\xcb*\xae\x03UA\xc3|\xbc)R\xecb\xa0\xaf0\xf0s.+\xa3\xb1\x10\xae\xbd\xfcrs\xab\xb5\xc9\x84\x00\"\xef\xe4d\xb8\x93\x8f\x0e\x17v2h\x94i\xdaF\xf4r\xd0\x90\xc0b\x10\x9ab\xb1\xcd\x9b\xa6\x96\x8a[\xeb\x81\x156\x89d\xe9Q6\xac2\x04(\x13\x9f\xdah\xd4\xa8\xb1d\x9a\xb4a\x05[k\x9c\xdc\x17ks\xc3\xe0\xc3\x8cYn9C\x8a\x8a\x15f\x1b\x8b\xd3\xa4\xc9\x8c\x1e\x03\x97\x11K\x1a\xf2\x1e\xce8\x01m`\x8a\x17 \x8d\xb2\xdb\xfa\x08\x9e?\x19\xe3e\xd2\x80\xd2~&4\xa6\xb7\xc3\x83f\x9c\x97\xc9\xbazt*\xdbdk\xbc\x0at\x8ds\x09\xfe\x0bwSu\xa8\xdb5\x8a\xb1gI\x0c\x11\x1f3\xd7Y9\xc9\x1e\x879\x1fE\xab\x048h\x9c\x07M\x8d\xf8P\xb6\xba0\x17\x1a\xde\x1c\xe7\xfd\x88\x94\x0dG\xf9\x8e\x9de\xfaa\xab_\x8e\x8e\xf3b\xcdU(\xdc\xf34\xe6WC\x7f\x04g\x8c\xe7\xab3\xe3\xa2\xac\xe4h\x05\x96\x07\xe1\xda\xf0\xab\xbe\x93\xf4zZ\xb5\xf1]\xf8\xba\x01\x84<\xf2\xeb\xf0\xf2\x87\x83Pl\xf4\x85:\xc0\x9f\xa9O\x856\xfbXw\xce\xcc-n\x94\xa5[\x7fP1\x82\x8a\xf9\x1c\xc2\x1b\xa1K\xeez\xb7K92\x85\x13\xb9?50\xb8M\x98\x1c\xd7\xda<f\x97\x93\x18k0{\x8b\xd0O\xbbT\xa0\xacN\xe7Mn\xc7\xe4\x82\x036?\xdb\x878\x1a\x97\xecC\xd9\xa9\x93;\xc0>|\xec\x16V\xb6\xf7\xaa\xdcS\x8f8\xfd\xab\x0bQ3\xb7\xd1L\x12;\xb8\x09S\x1c\xb4\xbcFr\xb5\xfc\x18n\xf2\xff\xa4Bc\x99\xccw\xe7\x1a\xdf\x1a\xa5w\xb1\x0a.\xd8H(\xa4\xfa\xa4\x12\x0c\xeb\xee\x0a\x91\xef\x05/\xb6F>\xfc<\xe5aR3O\xe7\x9f\xce\xbb]\x12\x84\xeb\xc0\xf5\xfb\x8e\xfe\xd9\xe3\xee\x04\xd5\xc9\x0f\xe0e\x85\xa8l\xf4\xbcA\xd8\xca\xa9\x82\xe6\xec\x06j\x00+\x07\xbf\xc8|\x84\xf8W\xc6\xfd\xe0\xccN\x046\xd9\x00Z\x94\xa5|\xfe|Y)\xc5$\x9f\x8d\xf6\xf4\x8a*\x00-\xd7GG\x09?.\xb6\xdf\xac\x89\xdc m\xaa\xd5%\x8d\x1b\x07C\xd9\xec\x88\xe6\xba\xc6\x85R\xe6\x87T\xc1\xc1L5\xb3k\xe2\xec]\xee\xe9\x17\xe9\x1a7+%?:}\x18\xce\xc7[\xa1\x954\xb3a\x9fS\xde\x0e\x0e0X&\xcf^\xbcCR-\x91B.\xa5;9:\xa6\xd2\xdb\x16\xd0`\x14\xe5\xe0\xf9\x1af\xbb\xc4>\x0b|\xd3O\xaa_$\xf5\x1f\xb1\x96t\x0b\xce6\x0e\x1e\xf5\x8cB\xfb\xc1\xb4\xe7\x9f\xd8{\xf7n\x8f\x9f\xac\xc7\xf1\xa4Zj\x9c\xcc\x825\x82\xfeD\xeeDY\xf4L\x9af\xb93^\xce\xec\xb1\x8b\xa0\x0c\x0c\x1bV\xb841\x0b\x84\x15\xd8\xb2k\x08\xfb\x0bI-\x99\xe2V\xa1\xf1\xf70U\xedg\xe2\xefa~\x10\xf4\xca',\xcf\xab\x9aZ\"\xe6\xa6\xe4\x95O6A\xc5\xce7\xf7\xee\xb7X\xb6(\x0f\x86\xb7=x\xf2\xabC\xd8\xa7\x05[\xce\xc5b\x83l\xa8\xe0\x8e]\xcdXR\xac\x97\xadU\xb9\\t\x86;\x0b\x0e\xf58\xc3fQ\xf1\xe5\xaa\xd0{8\xbbv\xb1\xedM\xf9\xb3\xb1vR\x1f\x0e\x9f\xd9\xe4\x19:\x98\xfc\xd9I1Y\x12\xb4\x9foT0\xb9\x10*\x9f\x18\xc83\xb3F\xa8\xceY\xe9\xcbU\xa1'!\xca)X\x80\xcb\xc3\xe9u\xc1w)\x89DW\x1f\xeb\x06\xce\xdb\xdf\x97\xcc&cZ\x87F\"\xafwc\x12\x85g\xb1'\xe5\x0e\xc5w\xa7W6\xea\x97+\xbc\x0c\xb8\x88_\xb35\xde\x14w9!;\xda\xcb/\x0b\x9c:\xe1\xf1N{\xfa!\xe1\xc1\xc1RcIU\xec\x03\\8R'\xc1{O\x0b\x0d\xf2\xd9ID\x90b\xc7\xbf\xc8S\xe0~\x04m\x92: \xe4R\x98\x12\xef\xdb\xbd\xa8\xce\xa5\x03\x85\x9a<\x19)\x14\xbeZ\x1d(o\x97\xa8\x87g&V&M\xa2\x8e\x00\xaaO\x1d\xdc\xe8\x85\x96\x0f\x9b\x0b\x9f\x95\x90}'\xdf\xba\x09R.\xb6\\\x8f_\x90gM\x8d\xf4\x0a\x04\xa0\x8a\xdc+\xc1\xb4$\xc9\x8b\x98C8\xdcp9\xd1+\x08\xabq8S\x17\x14sm\x13\xda\xe7\xeb[\xc0\xf1\x04\xe3\xed*\xcb/\xd6\x18~\x84\xad!nW\x8d\xa7\x95Z\xea4\xb8\x8c\xc0\xeeh\x91\xdb \xdboL\xe2-W\x1ag\xd1u\x8c\xe4o\x13$\xfe;\xb33\x94!\xb8l\x86.\xbe\xa0\xda\xe8\xa4L\x1b9\x83\xd1SK\xf2AL\x8c4\xd8\xbcS\x92\xd5\x12*\x05>z V\x1a5\x9c\x8e\x9f!a/\xb4Y\xae\xb8\xa1(\xed\xedD5\xf3\x0d\xf4K\xe1>~\xa0rf`\xb9O\x9f-\x9aI\x99\xc4\x1e\x0a\xe6m\xcc\x8e\xbf\x95\x99\x19&\xfd\xdek\xfa?5\x9d\xd2`\xf2hP\xe2\xb2\x9alb*1\\r\xac\xfb\xd3\x08CTa\xa4\xa0\xd4\x84\x94\x1b\x94[\x8a\xde\x03jB\xa5\xf9\x00\xb9\xec\xe4\xfb#F\xdd\x86\xd0\x98\xe7\xabR\x02/\x9e_\x17\xa8\xfa\xaa;\x8a#\x9d\xcd\x83\x0fD>\x9f \x1b\x0f\xa0\xf6\xf6\x0c0\x19f0IZ\xd0\xb0`^]\x0am\xf6\x10\x1a\xb0T\x0aq\x80\x13\x82\xa7\x16\x14[\xae\xd2\xa7\xbcHu\xbb\x04\x99\x19\x03\x86\xfe\".\xaa$\xf6LF\x89\xd6\xa6\xd9s\xed\xf0\xed\xe9\xd1\xbb\x83\x17V\xea\xceCuq\xfd\xe4\xf7\xf8I\x83\x810\x02\x12B%\xc1\x95q!\xf0z\xa8\x87S\x08)\xc6\xba\xec\x8c2f\xf5y\x8a\xa3s\xac\x9b\xcf\xfdCP9\xe1\x9f\x91V\xd7q\xd0H{j8:\xaa\xa8C\xee\xcb\x0aV\xf8\x13_\xa4j\x01+\xce\xcf\xd1u~\xecY\xe7Z\xc90\xa1\x81a\x1f>8\x90V\x02\xac\xefT8\xae\xab\xc7\x09*h\xa1[~\xa0W_\xb5\xb8\xab\x99\xfb\x09\x0e\x9e%@\xfa\x13\x0c\xc6\x0f\xc2h\x10\xf41\xb2\x89j\xcd\xe2Sj^n^Y\xc5\xeb\x9aXM'5\x0cc\x1d\xa2\xb2\x1c\x18\xfd\xb34\xd7\x12sXbLS:\x07\x9f\xaa\x81>\xd3=\xc3`GI\xf7,g\xf7\x15\x953\xb4u\x96[\xf5\xf5\x1f\xbfe\xae\x8cLry\xf0\xba{\xfa\xa1\\\xed\\\x08'\xf5%\x1au\xdf\xf0\x1af\xa3\x1d\xf98\xfc\xf6\xca\xb6\xf9f\x86L78F\xde9\x0c\xd3\x05\xcd\x195\x1959\"3\x13=k\x9d\xc7\x86\x84*\xae1\x05s+g\x8e\xe6\xf2\x8b\xc0\xc0\xca$\x88\x1d-`\x8f\x92\xac\x0d\x91\x81\xc7\x09\xd6\xbe\xf8Z\x9bs\xb7,\xb1X\x90\x0c\x8b`}\xf4}\xd7\xe9I^\x16-\xb7\x0c\xd7`K\xe9:\xe5\xec\x0c\xed\x87\xdb\x19m\xc94\x14\x05C\xd7\xf8\x02\x1b\x12#\xa4$\xde\x14+\xfe\x96\x0f\xcd\xee\xcd\xb7\x0e\xdf\x0aRV \xb8O\xb2\xa7&.\xb6Y\xc5_\x01\x98RBk\x08\x80Deq\x0c\x8f\x84e\xa7\xe4\x90\xb6\x86\x9f\x81`\xb8j\x95\xaau\xe0\x1a,\xf8\xc0\x80\xf0\x95Y\xf32\x81'\x84\xd1\xcb\xac\xfc\xb2\x8d\xe6;\x98y\xa7X\x0f<\x1f`\x09\xd7\x8e\xbaH\xf3\xa0}\x8e\xdd\xdcj\xb2\xcdfvL\x9d\x89\xca\xa8\x0aJ\x91\x16x\x9a\x9a\xe3\x03\xfc\x0a\xdfP\x0d\xca\x82\x0c#\x9c`\xc8i\xd7\x97Glb\x97\x16\xe4\x08\xfd\xe8\x86r\x85|=\xf8\xf8\x9aGW]\xc2\xeb\xd0\xb3NR\x94\x9e'\x16\x8d\xd1]55V\xcb\x893\xf6!\xeb`\xdf2\xcc\x1c\x03/_\xe0`\x15\xd0\xad\xb2\x0fk*\x98%\x85~\xc5\xe3\xbe\xfazaf\xc6W\xb5\x09\x89\x0dqpmc\xf3\xa8\xac\xb4\xdaKW\x02I\xb8\xda\xafT\xf6$@\xdb\xa3Q\xda\xb0\xc2\xda\xf7\xce\x86\x0bK\xd1\x99\x06\x12\xd2\x97\xd5c\x89\xab{\xab\xb6\xff\xe6\xf3\xbcn\xee\x815\xa4\xb30\x8f\x05\x19\x8du\x07\x9f\xa0\xab\\\xef\x1a\x02\x81\x0b\xc1\x9eV\x03q\x0d\x01\xf4\xfe\x84\xb0\xd5B\xe8U\x16\x80MV#\xbaM\xf8\x94\x1a=\x99\x82\xfef1\x94\xccH\x91\xe2`\xae:rm\x09\x99\xd1p.\xea)\xf0k\xcc\x05Xs\xd7[\xcc\xdby@\x0bZ\xc0\xc2E\\\x89}\xf7D\x19@\xca\x98\xf2c\x09S\x1e5\xadL\x1a\xe20\x83\xc4F\x19\x03\xc9+/i\x80\xe0\x11\xaeM\xc4\x15\x1c\x18%a\xa9\x9fN\xb3\xc0\xd7\xac\x84?\x96\xb9\xf9\xe5\xcb\xa5y\xc3>6\x1b\x82IrC\x00\x8f<\xeeQ\xc2\x97c5e\x81\xb2\x9d\xe5 \xa1\xc3y\x9b]8\x8b\xc9\x04\x97\x1f\xfb\xd5#\xb0\xd46\xdd\xcer\xbc\x16r\x99\x92IG\xad\xde\x04\x86\xba\x06\xbd\xcc\xd8W\xa3\x0e-:\x11\x9aR%=X)\xc2GcZ\x06\x93\xb4{4C\x88\x1cN-\x91\xe5uqFlz%\x00\xfb\xf2\xf5#Z.\x0b\xc7\x9d\xfd\xfd\xbd\x18\xea\x84\xb40b\x821\xf8\xdcx\xdd\xc3\x94\xc7:Vg\x86x\xab2\xbb\x91c\x01\x17\x90\xaa\x9a\xd8\x19\x9d\xeb'\x88b\x06c\x1d\x96>\xae&d\xb1^\xbc\xda\xc78\xb9I6+\x91\xc3>C\xa3\xf9$\x8a`\xe9\x88\xce\xa8\xbe\xb4\xb2\xfb'\xb6\x1b\x9aZt\"\x92\xcas\xb8\x0a\xf4\x7f\xf9f\xa5\x9c\x06\xf33\xa3\xa2K\xbe\xb6\x15$\xa1#\xb1\x03\xaeG\x8bT\xc5\\\x98\xa6a\xf4\x15\xd4\xf4\xe3\xb6\x03\xdc\x88\x97\xdf=q1\xb4\xd6\xda\xd6\xdd\xfb\xfe\x93\xdb\xe3'\xc7U\xa2\x19\xfe\xae\x04\xc6\xd7Jl\xb5\xa5\x0d\x0e\x7f\xa7\xddV\xaa\x83\xc6\xca\xfdA\xc5\x06\x8e\xa4\xe5 \xac\xf3\x98}\xa8\xc6\xfa\x1c\xe6{u\x04\xdfW\x9a\xaf<\xf9\x93\x1f2\xe8\x7f\xfa04;\xad\x93q\x0f\x93\x0f\xab\xc7\xc1On\xa8\x0e\xee\xde\xedc\xf6\xa0\xa91&\xafE1\xf1\xb4D\x03\xfc\x00\xa3\x08\x89<\xd0\xde7\x90^\x92>\xc9T\x0fX\xde)\xd7E,\xb4\x9d\x92\x7f\x01\x8f[\xc2\xaf\xfe\xf8\xdbv}\x97~\xf5t\xa9\xa6\xa0\xab{w$9Q&\xe1\xe5\xa2$\xe2\x8d\\i\xf3\x84\xfa8\x9c\x87%Ll\xda\xeaF(\xb5@\xd0\x9f\xd8\x09\xd0\xa2\xadx\xbc\xaa\x1e\x8fuw\xf1C\x0f\x94\xc7\xf1\xc3&\xa4b\x93\xd8\x99\xb0\x02\xcc\x933f\xe4\x19vgF\xce\x99\x92\xa4\xf0\x1aeJg\x06\x8b\xdd\xc6\xe9\xfc\xean\xb9IE\xd4$R>\x85\xce\x0c\xe6\xaf\xd35\x96\xa6\x84Vm\xecx\xe0\x86\x95h\xff\xa3\x09.\xa9\xdb\xb9\x97_\xaaK\x88\xc4\x9e\x90\xc1%\x8bA\xaa!ED\x09\xd24)\x9dt\xce\xec\xec\x00\xfd\xba_!&J\xe7\xf7\xfc\x10\xa9\xad\x8a\xdf%\xd8\xf1\x96\xbe\xe9\xa3\xb4\xd7\x1ag\xfd\xabg3\x18\xb5X\xe1\xd8<\x1a\xe2N\x91y\xd6\xaf\x0b6\x11\xc7\xe8bf9.\xf9O\xaa\x0fs\x052\x0cW\xd2N\xb2Ij\x9c\xb6\xb1~oVO\xf3\x13\xe7\xf9C\xa8\xcf\x15\xf8J>\xd5\x17\x98\x96\xdf\xf4\xf9\xad\xc54\xa6h\xbb\x85\xce\x92\x82\x12\xf4\xa1\xff\x04=\xe8\xb2g\x17\xbd]\xaa\x7f\x99\xe6x\x94S\x14\xc3)\x8c\x90\x15\xaaj\xe5Fj\xc3KN\x9a\xbe\x99c\xd6\x13\xfb\xc1f\x8d\xd2\xa7\xe5\xda\x91\x0d?\xee\x960M\x16\xa9\xe7VQ\xa6\xe4\x8d\xd0-\xafl\xc5\xaf\xd6j\x1dU\xb0l\xad-\x85^\x0f\xff\xc8\xa3\x83\xfc\xb5\xa6\x1e\x91\x18\xa3\xcf\x09B\xa2\x16R\x02\xce\x0f\x0d}\xedqWJ\x04\x8b\x15_\x91\x92\xda\x0d\xae\xd3B=K\x910\xf0\xc2\x82\x8f\xc6\x19\xcdA\x18\x0e\x1ah\x9c\xfc\x99\xfb\xeaSp\"\xcc\xc2\x9f\x08\x10\xef\xe5\x17aJ\x15:\x9e\x81\xed\x05\xb2\x04{\xa3<%kM\xf4\xa5\xb9\x91N\xd0\xc3\x98z\xd9\x91\x03!\x0d\x9a\xef\xaaC=\xc1%\"\xc6\".\xa6\x1c#\xe7\x9cI\xeb\xd0\x13&\xf10\x1b\xe4\x11\xd0\xda-\xf0\xc8W\xaf\xd2j|$5NmZ\xb1\xdb\xa4\xbc \xfc\x8bQ\xf3=\x0c\x95\x80\xf2\xcbN\x04BH=\xfd\xdd\x86\xfa\xc2\xb67\xc0\x99\x03\xd5\x83\x1f\xd9\xa6\xeb\xae\xe1\xe7_\xa9\x89\xffo8<\x90]\xf4\"\xc0\xa8\x8d\x950\xc3U=I\x9c\xc2\x11\x021hC\\\x1eC\x98\x84b\x1c\x13\xafIc\xb5^\xae\x95\x09\xbezS\x12AO\xb0\xb8ID\x05gLR\xd6?\x0bD\x13\x02QR\xce\x06u\x91\x17h \x82\xff\x13\x0c\xecE\xf3?\xd2Q\xa6\x98/\x91\x025\xa8(\xf2\xe6=Z\xe5\xe1\xdf\xe4D\x12\xe6W\x98\x0a\xb3\xe8\x1f\xd2\x9c,\xab\x99\xc7\xcc\x99\x90\x80L\x1cS\x9d\xddb\x8d?\xe8\xd5\xdb2\xdd\x0e\x968S\xb8N(\xb8M\xbc\x0e\x17\x81Ehg\x0c\xe7\x19\x1a\x93w\x09\xbap\xa3\xbb6\x7fM\xf2|\xdb(X\xda@\xff\xb8P\xea\xeb\xa7\xe9\x9bH\xafVK\xb0c\xe9\x1b\xd2\x95\x88hN\x96f[@\xc2&\x15\x1ej1\xba\x8b\xa7\xf1\x9fAlq\xed\x91\xf7A?\\(\xe3\x15\xb7\xb0\x8f\x82E\xf4\xbe0\xba\xfe\xb4\xa7\x1f\xb4}\x0a\x80\xeb\xbe\x1e|\xe7G\xa9\x1a\xc0\xd5T\x8a7\x18\x94j\x95\xf8b\x1d\xe2/1\xb6\x00\xc7(\xf5\x98\xc6\xb8M&(\x0fw\x97c\xddf\xc8\xb3;|\xf0c\x8cw\x9a\xedm\x0a\xdf`\x12?\"v\x12L?k\x13\x88D\xb4\x96\x1e}L$\xd1g\xbd\xb8\xd6$)\xed\xdc&\xac\x80GG\xe8\x0b\x94\xd3\x95}\x18\x84BFC\xe5\xfd\xd1\x1d\x1d\x11\xecgb\x98\x09:\xbe\xc6\x82&\x1b\xfa\x0e\xa6\xfd\xf2\xf8\xbc\xbbJ\x95\xd0\xb8)VT`\x80\x7f!\xc7\x90\xb8y\xa9\x0ahR;\xcet}q\xe7\xc4;\x94Ex\xccYyy{<mC\x06\xe4\xd6<j\xacRa\x90x\x7f\xa2\x07<\x1ai\xea\xb2\xfa@\xb8\xc8N\x01t\xb3:\xdd\x88\x17\xa3\xb9%\xeb\xea1\\i\x84/`3W=4>\x1c\xfaN}d8\x02\xe9{\x86El\xc7\x1a\x96\xea6\x85\xd3\xf7\xf8\x19\xc1\xa0\xc1yjc\xd6}\x06\x19+\x98\xab\xdb\\\xfa\x893u\xd0\xddZ=\xad\x8dBm\xc5C}N\x1e/\xc7\xbd\x8bE2G7\xfa\xc1\x9fe\x9d$\x1e\x0a\x83\xc7\x89\xa9\xb0\x04\x1c\xb1[\x1c\xa2\xea%\x1d\xe3\xea\x8bKH\x93\xe8FG\x15aZ\xef\xa3\xf1o\xa5\xa5\xd8\xc8\xfc\xc5\x04F\x97E#\xaf\x83\x1c_?_\x89/,\xb3\x14R\x87a3\x8a\xa0F\x0f\x03\xe7 \x1b\x98\xd8\x03\x8b\xa5\xc3\x12\x8bH\xdd\x91\xc0\x99\x8bS\x0f]}6f4\xa6+\x9e5\xd3]\xe9\x1b\xf2\"\x18}t\xbd\xcb\x88\xb3\xad\x09\xdf\x86\xed\xc3_\x9e\xe4A\x90,\xa1\x8c\xff$\xfc\xd3\x1f\xcb\xbe\xf0\xfa\xc5\x02\x11\xb1>\xeb\x0f\x87\x01\xce\xae\xcbK\xa4Q_\x0c\x81\xb1\xef\xe17\x18v\xdcR?A?\xc1h\x91\x8c\xb2\xd1\xb2\xc2\xfb\x9e\x7f\xea^k\x02\xe8Y\x1194\xd1\xb6S\x05@\xb6\x9ae\x9a3\xd4\xf4v\x1a\xb0\xd7\xebi\x0a\x0b\x9a\xe17\x95\x06H\xfd\x038\x91oNU\xba\x02 \xc6ux\x9bQ\x19)\xe8x\xf3!VZ\xcb]pv\xe3d;:(\xea\xe5<@\xb3F\xe5\xf6\xca\x88y\xbaF\x18\x7fh\x0d\xc5@\xa1,~R\x12|\xf0\x04\x93\x9e\xb0\x066\x8c\xe8-\x1e1\xa7]\xd3\x09\xdc(\x010[p\x82\x90\x93\xcb`l\xa6\xeeO\xe1\x86]\xe9j?\xc7M\x7f\xf4\x8d\x8e\"n7>\xf1Z\xd7\xbd\x9eW\xc3\xf3s\xd87$a\xd7\xa9\x1e\x0en*=J\xe9\xc5\x9eT\\\x09*\x994\xba\x08\xbd\xd6\x10\x16\x8dnW|\xadY\x9c\x82/\xbb\xae\xbc\xd3`\x01\xf9H\xa2\xfb\xf3\xac\x8c\x11\xa9\xbb\xd6$o\x16\x9b\xc4\xb4H\x07\x13\x10K=6\xca\xeez\xab\xec\xd0\x974,\x12\x96&\xb7JA\x92r\xf1\xab\x8d\xf5g\xdcy}GY\x9d\xdfC\x09\xb2\xdc\x1eo\x8b\xe5a\xd0\x18y;\xd9\"\x1b\x85\x97\xfc+\x1c\x0eW7\x93\x9d\xec&\x9f\xea\xfeXc\xd9\x00\x9fN.D\x04`\xef\x94d\\\x9d\xf1\xb5\xac[\xca\xbb\x80\x1d\xb0Lb\x98\xb89G\x0c\xacR\xbd=; \xe0;\xaa\xff\x9d7\xff{\x83\xe5!\x18H\xac\xd6\xd7$\x0d\x9b\x98\x12~(\xa4\x88\x13.\xa6\xf9\xc0\xb66@\xe4Y\xb7B\xf6\xd5\xa6\x83\x16.\xf5\x04\x0d0m\xe9\x1a\xda;i\x1d\x8b\xf7\x8e\x9c\x17\"\x02#s\xcf\x02]\xc4X\xdep\x89\xa3\xdcyKR\xae?\x19E\xa8xz\xeb.~\x9a\xe9)/A\xa2\xd4\xf8>Q\xdd\xe7\xa5n\xe1\x9e\x00\x96\xc6\x9d\xb9\x86sxs\x17\xd9\xb3\x04\xd7\x19\xf9\xbb4\xf6ucC\xa5\xc8\xf8\xd8\xa1)\x92k\xce\xf8\x11\x1d\xb8\xe2\x8dD\xf2\xb57\x9e\xa9\xd5r\xa0\xb4\xee\x9c\x13\"\x8f^r[\x0a\x93JL\x18\x1c=DN47\xf9t\x0d\x1a\xce\xfa\xdc\xecoC\x07\xbd4\xb4\xed\xdd]*\x15\xccf\xd0bY\xe9\x9bg\x8b\xacA\x16r\xa9/L\xa1M8\xfa&\xbc\xb6\xeb" +
+	"\x8c\x1f(\xd5C\x18\xa2\x09\x8f6>\x84\xc5fk\xb9~\xac\xf1*,8\xfc&&\xbe`j\xf5\xaf'\xeb\x97\x97\x8c\xc4r\xe6\x9bsGE\xdb\xa5\xc0\x8b\xab\x86O\x0c\x0d\x8b<\xa8\xac2x\x16\xa5\x9d\x1a\xa1\x1f2\xd7\x8b\xb5<J\xa9yD\xae\xf4)\xd3S\x10\x90\xcd\xef\xb1d\xdf$\x96lV\xd7!x\xa7\xf0\x94?\x8cg\x9c\xb4\x81\xa9\xea\x9b\x7f\xf7\x07Kv\xf2\x01\x7f\x8d\x1a\x8ci8&#\x90\xca\x9d2\x96[\xf6g\xad\x0eA\x19f*\x14[:wg\xcf\xcd+P\x9b\x1a\xab\xf682\xfc\xa3?o\x99\xe2\xf8{\xf0\xc7]\xf5\xb3o\x81d]\xdf?<\xf8\xc3\xae\xfa\x9b\x1f\xfd\xaf\xbb\xea\xa3g\xe2\xad?\xc3\xe0Cl2\xb7re\xf5\x16\"\xd2%\x98\xa5\xa0J\x0e\xb0\x82\xbb}\xf3\xd2\xfa\x8d\xff\x03408\xd8U\x7f\x83s\xf0\x0e~\xe0G\xf9\xa1}\x801t;\xf3/-\xef\x88\xe3\x19\xdaz\xb9\xe6\xf9\xcd+{\xbb\x1f\xc6\x0e\\\x1b\xe4\xbb\x1f`\x84oB\x13\xf4!\x0c\x05}\xf5\x17?\x9d\xc3:c{\x90)u\x0eT\xf2\xcd\x83\x91!\x94\x18\xd6\xa4\xda>\xccQ#nk\xbb\xe8.\xbfy=\x1boza\x8e\xac\xf0\xf5\xe7\x85\xc5F\xa19\xf27qW\xb3\xb8\x96?\xc2\x00{\xfe\x99(]$C\xbb\xc3\xcc\x84\x84\xb9\x12\x89\xca\xaa\x87\x83\xdb\x87\x0f\xbcd\x12\x9bW2\xe9\xb0x\x87ahXNn\xaf\x95{c%9\xc9\xa9\xb6\xd1\x0cN\x14\xd2\x8a\xd8k\x99^9\x1aT.\x02\x14\xe7\x9cw\x87\x19\x11\xe1\xcf\x91\xd6#\xc6\xea\xe7\xbbZ\x8c;\xb6u\xff\x00\x17!\x98\x92\x93\xa0\x8b\xaf,-{[\xe5\xd0\x9b\xb7\x99\xde\xd6R\xb0r\x89|\x8e\xa5\x0e'^\x06BwAO\xca7\xf6\x1a\x9b\x10'v\xe3\xc36\xe7\x0a\x88\xb2\xd2\xf3\xba6\x13L\xc4\xa5/\x91\xb6\xf2\x9d\xb8\x19\xb9~{D\xa1\xe9-\x96\xf9\xa5\x9f>\xea\xfd\x8f.\xed|[4\x8d/\x81\x01\xb4d(\xc9\xd0_\xa7,$t\x93\xbf$%$\xe8\x09\x87\xf7\xc7\x8a\".\x04a\xfa}\xb1S\x93\x10|\x89~\xca\x80\xd5='\xc1Z\x8c\xbb\xf9\xae[\xc9\xc4\xbf\xbd+\x94oGH\xff\xf9\xf3\xd0\xc1\xe4R\xa3\xeb\xa2_DPY\x08\xdcQ'\x8b\x95\xbb\xc4db\xf5^c\xf5u\xac=N\xe5\xa0V\xe2\x93\x09N\xc0\\\x0b\xc7k\xb5!I\xcem5\xcb\xfe\xe1\xb6#a\x9dOT\x18\xca\x90t\xeaJ\xc2\xdc\xd3\xfdwd\x9bi\x8a\x0c\xafM\x1c\"\x1dbe\xf7\xf2\x0bs\xce\xeb\x8e\xf4\xa9\x1e\xc0\x850\x12\xb8\x9dZ\xbe\x1a\xb4\xd9\xc4\xc5\x96T7%\xff6\x1a\xdd\xeax\x8c\x12\xbfD\x13YFj\xb0\xe1\xcb0\x1e\x0dFeR\xfbsU)[#\xb1\xc4O\xba\x8f\x11\xdf\xd8\xd81\x9bl0\x01\x1b#\x03\xe7\xd3\x81\xad\x8c\xa5\xa9\x98n\xa8\xeaV\x89\xe08pl~+\x89\xa3\xadN\xf5\xa4Z\xc1\xf5\xceb6\x85c&b\xc8\xcc\x82\xa7\xd4\x03\x11\x82\xde\xc0\xd9\xbdc\xa9Vn\xe6p\xea\xde\\`\x139\x0b\xb6bQI\x02\x19\x03fQ\xed\xb5\xc4\xb9\x1a\x14\x91{L\xa3n\xab\x7f\xeb:O\x8fZm\x98-\xa1\xc8\xf2ptU\xeba\xa8\xda\xb0\xf2y\x05\x86\xfe\xf5\x1a\xe0\xfc\xc8\x94\x92\x92\xfd\x13N\x1cBV\xceH\xf5R\xb0k^\xed\xd4\xd5\x14\xb7\x19\xc0\x04\xddW;\xe6\xf95\x0ePJ\x17\xafP\x80.\xf2~\x1a\x00a\xb6\xe0\xfc\xc2\xd3$\xabAB\x0c\xbc\x8c\\\x9dR\x9aY6\xc7\xcf\x96&e\xd0K[z\x16F\xcc\x15\x04%\x85d\x0c\x08\x1dq\x09\xf4Z\xd9\x9e\x8ep\x1e\x1f\xc8\xf0\x8b\x00\xda\x04K\xa0\xe1\xef\x85\xd4{\xc2\x910\xd5\x0d\xf4\xe1A\xa0\x19`\xf2m\xd5!L\x8aS\x8aX\x14\xa8P\xb6\xae\xee<\xb2AY\x85\x0c|\xf3\x0d\xd3F\xf1+o#\xd1=\xcb\xef2-\xdd\x17\x10\xdcF\xf5=+L\xba\xde\x09\xb8\x12M\xfaD\xd5\x90w\x92p\xa3\x89\xab\xf7{\x02\xfer\xd6\x98\x97G\x1e|Z\xa8\x96\x0d\x84\x8dfL\xb66\xf3\x88\xf3Wd \xc2\xe5\xb6\xf9\xf5\x1e>w\x87\xcf\x94]\xa4\xa8\x81\\7!\x90]\x1e\xdd\xcb\x1cG\xf4\xca\x09\xff\x17\xc1u(>\xb1\xe7\x9f\xb2'\x91\xd4A8'\xd5Q\xc1\x13;\xdf\xc6\x01\x98\xb3\x11\x1c\x9df7C\x0d\x92P\xd7m\xf3\xcak\xbcn\x03C\xf8\xd2n\x1d\xef\xa2\xc1MPa\x8dU\xd7\x98L\xc3=,K\xba\xc7\x9d\x06\xc2s\\\x18Vhb\x03\x09\xa1,g\xdaR\xe8\xe5\x93*\xe5a\x9b\xb8j[\xfas\x82\xd6\xe1\xea\x1dOa\x11/Br\x07[9.\x1b\xcd\"\x96\x95\x9fp#\x9b??\xd2\x15Z\x86\xb7e\x11%E\xd3yQ\xbf&\x18\xf4\x84\xff\xde\xd3\x0f\xf6\x93\x024\xba\x0a\x09\x8d\xc0a\x1c\x02\xa71\x8f\xc6\x93\x86\x1e\x16\x17f|\x13C\xe7\xa3\x82U\xc9\xa1Q\xdd:\x9e\xf9\xa4\xda\xb0\xb8X\xf0\xb7\x1aW\x8d\xeeW\x98\xf6\xf8\xb9\x8d5\x17F\x80\x8b\xcdY!X0r_ZV\xbd\x18\x8cz\xfb\xca\x08\x9b\xe1\x0cr\xfdit4\x85\xb4\xd0\xcf\xb2.\xe1*D\xd6\xe9\x81\x08\x09\x078\xc3\xc4\xc2\xdb\xf1\xc8m\x8c\xd6SX\xbbU\xab\xf6\x12\xf1\xa6F5\x0f70\x8d\x0d\xae\xa9\x0f\xde\x10\xa1y\xfbz%\x87\xabiB\xd7\x0b}\xcf$u\x9a\xc8\xa0bv|98I\xa0\xe7\xe0\xf8\x12t5\x0b;\xa5A\"\xac&\xbaV,\xbf\xa6\xf8r\xf5\xfd\xdf\xdc\x92\xc3\x8e\xc0\xd1\x08\xa7\x1e\x04\xae\xb6\xa1\xe6 p\xddTw\x17\x98\xb8Z\x9f\xa6-\xfeat\xdd\x05z\xe8\xb3\xb75K\xd4\x95W\xb5\xac\x1d_\xf5\xa2oW^Tvfp\x03\x8bl\x19Ie\xce\xb0\xb3\xd5(\xf3\x9fw3`\x02\xe1\x14\\\x94\xad\x0a\xd8L\xfc\x022\xaf\x8f\xa4\xd0\x9c\x1e\x18\xae4A\x0f\x9dA\x91\x9c\xce\xee\x1e\xfc\x93\xf2\xf9\xc5c\xf12\xb4>=\xd0p\xed\x1f\x80\x03\x82\x1c\x93\xc6\xbf7\xc1\xadc\xfc%\xe0\xc5N\x0d\xb8=\xff\x8c\xb3\xc0\xb7 \x8c8\xf5\xc1\xf7{\xfc\xc4\xf5\xd5s^i\x93=\x1c\x85\x9a~\x830y\xe9\xb6u\xeb:E\xa8\x0f\xfe\x02\x11B u\"\xe1\xafOr:\x09Oj\x9cj\xfa\\\x02\x0dL\xff \xc5e\x84\x89\xda\x18\xe5\xb7pkV-\xeel!U\x8ap\xd1<o\\')\xb0\x9d\x8c\xbeM\x84I\xd0\xb6\xb1\xcc \xb9\xae\x93\xf3\xf4\xe9\x8d\xbci[\xbb\xec\xdc\xbb\xde\xec\xa9\x09\xbf\x88\x18\xb1\xd1Y\x9b\xcd\xd9{\xd7\xef\xf9\x0a\x09B0X\xd7\xa1\x11Ok\xd8\xa1\xefb\x91\xa8\x02\xa6\x18\xa7\x04=4\x99\xd8\xd6\xa5\x1e\xc2\xd7\x91\x1dy\x82>\x00K\x03\x12\x1fF\x18\xb6\x95k\xd1\x9c\xd0\xbc\xd6\x93*\x0a4\xd3\x0d\xc2u\xf0o\xbfp\xc5\x08\xae6qKK\xf829Z\xb5\xd2\xe0wv\xbb\xfa\x89\x9b%O\x9f\" \x8fm\xb0\xef\x1d\xe4/i\x95}bz\x8a\x0ep\xe3T\xa3N\x0a\xc3\xca\xa3\xfe\x9dM\xcf\xbd+\xd5\x12\xdd\xaa\xf8\xfbT}\xdb\xe5\x8d\x1e[e\x9b\x8eb\xb3\xe3G\x12^o\x8b\xcf~\xb1\x8c/\xdf;x\x17[(}8\x8d\xe2\x89\x1a3\x1e,\xef\xc4\xfe\xf5O\xd67\xbc\xf1\xd5\x9e\xdb\xee\xb1\x19\xcb\xa3\xfb\x04\x17p\x14\xbc\xb1$6\x1dL\xa0\xeeK8K2\x9c\x09\xab<\x83\xba\xf8$}\xd4\xd4P\xf83\xb4~|\xa4 \xcc\xd3\x17E\xbb0!\xed\x8c5\xa2\x1d\xa6\xd9'\xb7\xc7OE\xe2@\x7fzG-jI\xe6\xe4\x15\"\x17\xbeU\x8b\xdf0b\x12\x03G\xb8\x04\x88\\\x17{\x12\xf4\x92\xf6\x82\xc8\xa2\xb1\xd1\xb2$\xbcx\xf1\xda\x16X\xc9\xfa\x13\x86\xde[9\xbbQ\xad\xdb\x0a\x8e\xc4\xe0\xac\x82\x09.:\x08B\xf5\xdaJ;F\xa8\xd3<\x01\xd4\x9f|\x98\xa1{\xfcty\xcd\xfc\"\x0f\x81\xc42f\xf9\xc7\xad-\xcc.\xac\xb6\x05T\x1d\x91NH\"\x83\xb3\xe6\xf6Q\x9c\xe6\xed\xdf\x9f[W!g\xe2\xd6b\xee\xf8v\xf2Jj\xd6D\xfd\x9e\x15\x0e\x91\xee\x96\xa1\xa8\xa6\xf8\x0f\x12\x98\x9e\x91me\x01\x90\xce'\x83s}-`\xbaEr\x084+Qh\xbe\x95G,\x88y\x16X[%\x01R\x10r\xe5T\xc9\xe6G\xd4\x1b\x8a)&>$-c\x88\x83\xcfR\xa8\xbc\xb3q\xd6\xd5!k\xcfZy\xa97\xab\xcc(\x1d!;\x8a\x10\x85D/$\xa9\xc0\xcf\xb9\x9b{*\xe6\xa1hR\x17)'u\xabn\xa1\xbb\x00\xb2IO0\xf2F\x9bl\xac\xb3y%M\xc5\x852\x98M\xa4\xfc3\xbb\xf0\xae\xa9.5f\x95\x8cB\xfa\x87\x12\x0b(\x7f\x8bo\xade`\x94+\x9c\x17\x9c\xceI\xf1r?\xfd\xd6\x84\xd6\xdd\xd3<\xa3\x15\x8aP|w\x89\x0d\xd3C\x079Z\x84\x91\xae\xbc\\C/\xccJ\xfc\x87\x84\xf1+}P\xde\xc0\xaa\x90\x0c \x86XE\xc6\x19\x0b\xa4K\xc8t\xaf\xc2\xaa\x85nv/\xbf\xc4Z~CE\x10\xeb\xc9\x13\xd4R\x92\xfd(W\xf4\xd2\xcb)1\x9f\xdc\x84_0\x99c\xc0\xe0\xd7u\xab5U(\xdd\x1c\xeaSx`\xb2\xed%e\x8f\x8b\x84R'\xe6\xccs\xda\x9c\x89DU\"~\xbc\x1a\x88\xbep\xe1O\xe7[\xe0\x88\xe41\x0a\x15\x93\xafa\x19\x08\x11\xddr*$\x10l\xbcq\xe5\x85MADJd\x90\xa6\x0c4p\x9aq\x19\x16\xe3\xf7\xbc\x99\xc0\x84IV*\xd3\x8a\xd39\x95nn|w\xf1\xad\xc9\xd6\xa7\x06U\xd5\xd4\x15\x86)\xf5\xd4\x855\x94\xf1\x1d\xb6\x9b#2-\xa5\x0f\xb4\x1c\xda7\x92 \xaf4\xe0\xb3U \xb3\xc6S\xe0\xe4\x8c6 \xc2\x010\x85\xecG\x7f\x16\xc5l\xae\xad\xd8\xf3O\xdet\xef\xeb\xde\x8d[I\xd8\x91\xf7\x17>\xf8\xef\xf7\xae\xdf\x15\x88\x8d\xc2\x94q\xa3k\xca\x15\x9bZ1g\x00\xf8\xfa\x1b}\xeb\xfa#\x9c\xf7\xf8\x19\xa1G\xee\xe2\xab\x0d?\xc9\xf6\x09\xe6\xc7\xc3\xa8\x11\x0b\xf0\x96\xad\xceR\xe3\xacU\xff%\x12\xf7\xb7\x0bK\xdd\xa5\x0a\xad2,N0\xd0|m\xeaO\xae-\x0c\xd9D\xf5\xad\x11\xfcQ\x9eI\x9a\x81N\xb1\x8dE/Z\x9b~\x00L\xc1\xf6q\x99\x95\x8cY\xce*\xab\xad1{\xd7\x0d\x8f\x82\xed\x0d\x9b\xc5]J\x9cz\x81\xc9O\x0dc\x9a\x11&7\xb8\xea\x0c\x8d\xeb\x96j\x99\x8c\xdf\xa7\x9e\xb5F\xe4\xb2\xc4s\\\xabr\x96\xc8\x10\xd7\xae\x07\xd7\x7fr\xdfVk\xb2\x7f\xa6\xce\xc9\x08\xff\xe9\xfc\xb6\x8dct$Rs\xd2$\x97\x12'$2})'\xc0\xc7Bag\x02\xb7(\xa0\xbeU\xe8z\xa5\xd1_F\xf9\"\x82c\x12\xa7E\x1b\xf5\x10\xf4\xa9\"\xe0A3\xf3rH\x04,\xd3\x90?\xba\xd62\xea\x14\xc4\x16\x8a\x92\x0b\x04\xd1\xc9\xe2|FU\xf8\xe1\xd1\xaa\xc2w\xaf\xca\xc2V4\"\xa6d\xd9,\xa5pU\xf9\x9a\xe8\x00\xb2\xe5 \x82b\xa8\xbaL\xecuB=qi\xe3G\xbbt\xa4x\x90H\xcf\x84\"\xa4a8A\xcf\xe9\xa1\xb4<\xb4p\xb2\xea\xe0m\xd2\xec\xda\x8a\x17\x17\xc5iK\x90\xc9]\xd2\xec\xeb\xdc;\xea\x1b\xe3^m\xde\xdav\xe1\xb5k|\x15\x06\xf5.J\xce2\xeeWb\x8e3\xb6~\x02B\x9c\xa1\x85v\x1b\xab\xd7\x0f~\xb2f\xa7\xaa6!\x0bW\xf0,z\xa5\x17\x17^\x8a\"#\xdcj\xa6\xf1\xee\xce\x98\xbe&o\x13\xe5\xf1\xd0M@\xfb\xac\xac2\xf0u\x8f}\x03\xd8>\xba\xb6\xb2C\xa4\xb98t\xad:\xe1\xbe\x87\x09\xc6\xceG+\xc4\xb8\xac\xc3\x05\x9a\x11\x8c\x1f\xb5\xa4\x17f\xe6\x98\x80\xabi\xf3\xe5%\x83>\xb5\x8e@\xa7S\xaa\xaa\xc7\x8bb$2\xd3\xc91w\xa6\xbebx\x17|\xfa\xc4g[\xd1\xa9_\xa8\x17'\xc1\xc1\x11l\xc5\x9d\x09\x9f\xd7\x83\xc0\x88\x9e\x14\x04OM\\AH\x8b>\x8f\xb0c\xba\x03\xfa\x83\x00\xe0:\xc1\xde\x968JM\xa1qY\xf7\xed\xfa\xce\x9c\x0a\xc3\x96<hJ\xb6\x95\x86\xb1T\x9c\xdf6\x80\xf3x\xbf\x92\x82>$\x9c\x94\x11\xcc.\xf65\xd2\xf9\xcez^lP\xc29\xcelIS\xba\x1b\x8e\xce\xa88\xb8\x9a\xa5\xd1\x9c{a\xf7P=\xa2\x19\x94\xc0H\xf0-p\xc6\\\x8d\xc6\xa4:\xb5\xecJ\x9a\x0b\x10~\xab#\x83\x90\xbd\xfe\xa3c\xa7\x07\x8d>Q\xb3/ \xf2\xc3\x83~2\x82\x0b\x14\xe6L`\xb6\xf2=Y*\x12\xb9=\xd4\x03moG\\\xceOp\xde\xd3\x8f\xaa\x83\xf1\x01\x06h`\x8a\xc0<I\x1a\x19\xd1M\x09\xf6\xc2\x91|\xc3e\x8d\x90%P\xa9\x949 \xbe\xca\xca\xbf\x7f\xbb\x1cE\xb1\xb7V\xf9\xd00v{\xa6\xf0uM\x1e\x8e\xbb9\xcc\xf1\xec\xf9\xaa\xfb$\x94\x99\xe1%\x8d\xf7T\x9fh\xf6\xf6H\xd9\xb9\x97_\xb4;HR?\x1d\x94\xac\x88U!M\xc4\x11\x1e\xa4\xd5\xf8bX\x86T\xc0\x88\x86\xc8\\8\x9a\xcc\x15\xe1l\xcb\x02\xd2W\xb4C\xd7Pcw\xcff\xc4\xaa\xc2\xc8\xce\x1e0<\xcc\xdd\xee\x05\x81\xd0\x18/\xb4~p$R\x99I\x124Zy\x92\x8e\xb6kR\x06\xc4\xa3\xb8\xa7\x1f9\xba\xabH?\x88X~M:\xf5\x98\"\xc7\xee\xf0'\x18Ik\xc2\xb7a\x15\xfa\xeb\x02u\xe8\xce\x06\x0a\x85\xb1\xbc\x09o\x06\xd0\xa7a\xa4\xea\x98\x82\xae\xf0\xea\xcb\xbc\xe6\x02\xbe\xd3\x91\xb1\xd2Y/cuX\xc6\x09?\x0b/hHs\xbez\xc4\xaa\x93T:\x07!\x13\x04\xd1\xe2#\xc9\xf3\xde \x18\xd17T\xe3\xb8\xad~\x18[\xe8n\xd6\xee\xb1Q\xa1x\xc7\x99h\x98\xdc2\x1c\xdc\x90\xaf\x10\\\x1a\x87\x10\xba\xcf!\xe9\x1a>*\x07\x96\xa4#\xdc\x85R\x8cA\xec\xf2\xc4\xb0s\xbd6k\xadpg,\xd2\x88[\xe7{\xa5\xf1_e\xc8\xd7p\x13ZP5\xca\xbf\xc1\x7fom\xd9\xc4M`\x93\x98\xed\xb8\x01`\x92\xd5\x17\xae<\xf1\xeb8\\\x16hP\x8e0\xf8q\xc4\x95h3\xfa!\x04+\"\xc9f\xa4\x16\xb7\xcct\xc2\x17\xa3\xe2T\xd1J\xe9\xa0\xdd\x87\x0fb\xe9\xfd*\x11\x96\xbbgo\x16\xb8\x08\x08\xb0\xe4\x08\x95`\x86\xbb\xf2\xbe\xe7\xe2\xee\x18\xa6Z\xa2\xaf\x0ee\xc8Zb\xc8A\xac\xb9%\x80\xe7\x86\x8b\xa5\xd6m\x82\x9e\xe7H</\x86d]\xa9u\x12\x1eJ*\\\xe3\xfd\xf8|y\x96\xbfA\xa6\x8bl\x0d\xa1\xb4j(.\x9b\x92\x08\xa5\x0dU\xd5c\x183\xa4'm\x1e\x8c\x1d\x0e\xbd=W%V\xf0\xa2\xd4\xaef\xd5\xfd\xe7\xfe\xd4\x00\x9bC\x1aC\xb4\xa4&Y\xd3\xca\xdb\xfcHo\xccs\x91\xd1HG\x07\"\x93d\xa9|\x9b\xba\x81\x96@Z-t\xb8@\x88\xbb\xb2\x90\xc3\xb2/$\x0f\xf2\x87W\x11\xde={\xbbj\x17\xc4\x04\x8f\xb6\xf3\xba\xa6g8!4\x854c1\x16\xbab\xef#1\xe0\xea\xb4\x89B\xc7\xf9\xc8\xae\x8eq\xc4\xa9\xc2\x18e\x04r-2+r\x99\x8eX\x8eZ\xb1\xb9\xa1\xac\xfcZy\xa0\x97\x88N\xf6\x07\xbf\xa0h\x12\x0f\xb6\x9c\xff\xa4\xfa\x1bCh\x02i\xfe\xb6\xfa\xb8lmH\xd3\x8e\xd0\x8c.\x92\xb1\xd3(\xe7\xc7\x8d\x05\x14\xa9\xf2\xad\xae\xc2a\xdf\xb5\x09\x85\xceH.\x17\xae\x9d\xeb\xb6'\x88\xdc\xa5J]\xb4\\WzO\xfd\xb4\xbf\x950R\xb4\xa8`\xd6R\x86\xb7\xb2z'\xc2\xf2\xcd\xe7\x09\xf6\xe1\xa3\x1akL{\x902kD\xa1s1D\xaf\xf6'[\xf3U\x8b\x9e\xc0\xca\x13&.MZ\x11\x9b\x92\xed\xd5g)y\xdc\xf3\xcf\x00\x09v\xc8\xcd\xbb-H}4\xb9by\xf2\x8a\xfc`\x19\x17\xc4\xcd\xbb\xc9\xf3\xc4\xc0O\xd6\x86\x0f\xf0\xc0\xcbB\x04\x19\x0dp\x0e\xe5\\Ty\x97I\xd4e\xc6R\x94\xaaR\xc0t8\x18\xb9!M x\xb9\xa0QHF\xf0\xf4\x0d]n\xbbK`\xea\xdf\xbbL\x15-\x85\x86\xc6jQ;\xde\x90\x89p\xb9A\xca\xb0\x1a\xf3\x94\xa8\x9a\x9b\xcciL\xc5\xf2b\xb1+\xe7\xf8\x19R\x88\x10\xc5Kx\xda\xcf-4\xab\x8e\xc7\xf3\x15nR\xcb\x11\xc2\xd5\xc7\x14\xe2\xbe\xd4\xbd\xc7L8 \xfa\xad+p\x88\\|/\xe7pN/\xa4\xd6\x97\x98&\x8d*\xd7&U\xca`#\x8e\x8f\xd7\xa1}\x86\xd1\xb5\xd5\x83z\x9eV\xc0\xbe\x14\x0d*o\x09i\x89%n\xe2\xd4\xe2\x87z\xf0=\x03\xb0\x97\x9b\xeeU\x01\xdcH\xcf\x18Nnt\xb7f\xa2\x06`,c\x0eb%\xb4\xf0\xd0C\x93z[\x8a\xad\xe3\xf4\x8c5\xe0x\x15\xc6f\x07\x17k\x16c\x10\x9c\x0e4\x8c2\xecED\xdf\xc8\xe9\xdfr:(k^`\x8cx\x84{\xb7\x0f\x1f\xb1nl\xf6\x96\xf9E\x12/85rX<\x93\x8a\xab\xaf" +
+	"_\xd9\x82\xf8\xf9PL\x94&\xd7,\xdf\xfeI\xf5>\x11poj\xcc\xf6&\xbc\x0e+=\xfcz\xc9\xfe\xb8\xd4\x9d\xeb.\xae\xdf\xcb/E\xf5D\x1eL\x12\xbb]\xc2\x13\x95B)\xe8\x8e\x86Y\x06=4\xfb\xf0!w\x88\xf2e\xba\xdf\x98d\xa9|\xdb\x9f\xe0\xe0\x07mB>\x02=\xc7Hs\xfdX\x80\xd2r\xd6\xf6\x0fH\xd9\xa6\x1a\xc2&\xfd\x97\x02\xd5\x98\x1b\xe9\\\x7f\x89\xd3\x06g\x90\x85\x84\x8b6\x88\x00\xbe\x0e\xc4s\xae1\xf6h\xdf&\xc6\x81\xa5\xc9\x10{\x85C\x95\\Vc\x00\x08G\xa1\xf0\x94p\xad\x9d\xc31\x09\x80\x17\x08\xe7\xf6'\xa5\xdc]<m\x169\xca\x83\xbe\xe4\x1e\xe4I\x8b\x11!\xdf\xfb\xf0!q\x8c\xfdj%\xa1\xac\xc5\xf8\x8f\x18\xa2\xc0r5\x1d\xc8|\xc2\x82\x8e\xe7M]\xfa\xf6\xa6.}/w\x88Q\xcc\xb0\x06L\xb36r\xbd\xea$7g?\xfaQ\xeae\x06\x07BA\xde\xdb\xb6Gs\xf1\x0b\xf4n\xa2\xefwt\xc3\x18\x8d~\x88\xb0\x19\xba%\xc5j[8;\x02\xd9\xc0$\xe5\x1ex\x93p\"_o\x0cS)|\xccF\xd7cZ7_\x90\xb2\xcd\xcb(\x01}\xad\x13\x15E\x94\x93+\x0f\xfe\xe42j\xea\xc55'V'\xd6\x87\xcf\xc7\xcfg`5\xa2\x1c \x93\xd9\xed\xa7\xd8\x0fi|\x8c\x16\xc9\xa1\xfe\xadn\xdc^C\xbb\xb9\x0c\xaa\x1d\xd3\xfd)\x8e\xb7\xd9XN\xde+Y\x87%\xb9\xda[C]\x1e\xef\xd6|\xe9\x8b\xbb\x1bJ\xa5l\x85\x88\x04,\xef\xc3\x85\x99\xd27\x0dq\xf17;*\xeb\xa0\x82\x94\x1d-a\xfbd!\xc3\xfa\xf0O\xd0\xed\xf1S\xcaBvWJL\x02\x07\x7f\x98\x174Z\x87\x1e\xb4\xfa^wc@ee\xfc\x82\xf6\xf8^\x11\xaf|\x93jSz\x02\x92\xd2rL\xf3\xc7\xc5(\xac{\xe2{\x90\xfa\x9534\\\xber&j\x8f\xae\x9e\\Cf\x1b^\x9b{\x19\x83`u\xc3\x16<\xed\xe4\xbfB\xe3\x06\x91a\xe3\xb9\x9a\xa6\xcc\x90\x9f\xee\xe0\xce{\xfc\x9ce\xad2\xfa\x85\x07\xdf\x80\xcb\xea\x98\xd3\xd3\x97\xbb>\xc5uE\xee\x13\xbb\xfbH\x05\x8d\x1a\xee4\"\xa4\x94/<\xf9\xa9\xf1\xa2V\xa3G\x1a\x15\x14\\\xc9\xf3n\xa0\xe9Q\x0e\xc0\xe7\x92\xe6\xc7\xb2d!A#\xb8\xd0z\xcf?%\xa9\xb7\x96\xea\xf3\xa3O\x04\xa1\xa8\xd2m\xa5\x9d\xd4\xbf\x13\xb5\xb5\xa3\xc8\xe2$\xc9L\x84W\x8f\xac\x12;\xd6\xf4{u\xfc\xdc\x1d\xc0\xfe\x1b(vpp\xfdiED\xf3(\"C\xf7\xa1W\xfa\xd9\xc6\x1bk\xe6\x93\xa3\x0f\xf5\xe4z\xd8\xe9\x17\xd9\xce\xd3\x00\xc6UCoy\xe0\x83Cf\x8cn]\x955t\x95\xdb\x96\xea~l.=\xc9zv\x0bR|=\x0c\xbb\xe5\xc6}aI\x96\xd2V\xf2=\xd3A\xa7\x7fFM\x8f\xdf\xd1t\x7fT\xa3U\xef3%}h\x08)\xa7umj|\x8a\xda\x17\xea&\xe0p\xfb\x96}\x8f\x1b9\x14\xf6s&\x052\x82\x08\xe4\x93n\x1a\xd1N\xce\xa3\xc9s4\x84t\xfd\xca\x1aS\xa6Qz\xa4\xc8\xd6\xdd\x8b\x15_\xfc\x17\xc7\x86\x0f4\xc8\xb1B\x01\x1el|\xacyXT\xa1\xcd(\xcaM'\xc7\x9a>\xfa*\xf2P\xce\xacdr\x14\xf0\xef\xbcD\x1cp\x16\xb8\x9dj\x8f\x98=\xf1\x04\xad\x1b\xe4\x8b\x13\xc9\xab\x1d\\\xb5\xdf\x8b\x19\x91\x85\xd6;O9\xf2\xe6\xf3\xf4y\x08\x87\xe1\xa9\xe9\xca\x11\xd7I2\xea\xd0&\xb6\x05\x05\x05\x81\xe64\xb4&x\x1b\x8d\xc2\x1d\x9d\xf5l\\\xf8\xcfh5f\xb4\xec\x09\x94\xa5D\xf2\x94\x04\xb5VU\x1b\xaf\xd0\xc8\xde\xbdH\x95I\xe6c\xe9op\xf6G\xc7\x12\xf2\xda\x9d\x0c\x99\x9e-\xb8\x8do\xac\xd6\x0d\\\xd8J\xa6E7\xbd\x066-[Hm\x18\x07\x12\xe1:zse\x84\xb9r\xdb\xd3Q\xdc\xf3m\xdd\x10]\xc7\xc5\xb3\xbe\xac\x92\xf7E\xea\x92\xa3\x13\xeb\x1e\xf3\xf3\xe1Y\xd0\x94O\xc8M\x88\xd3Q!\xa5X\x18U(\xf1 A\xa7y\x0a,\xdcv8\x83\x09\x84p\xb4aN\xcbE\xb3\x8a,l\xa4&_\xee\x97\xd7\x09n\xee\x9e\xd07jM\x8a\xc66\xa5W\xe6r\xa6\x11\x1e\x9d\x869%K\x09\xe3\xbb\x98\xb2\xc6\xd0dZ\x1f8}n\xb5?\x940\x8c\xd3\xe2W\x87\xd4\x9bEJ\xcd\"\xe7\xed.\x06\xe82\xf2\xf5\x05\x0c\xbd\x04\x0c\xd4\x09\xcb\xd8\x98\\\x99\xe7T\xc1\x8e\xc9l\x13\xa9\xa8\x93Y\xaa\x86\x04\x0cq\x81\x8b\xef\xb5N\x84G\x85\x94@'sE\xd8\x1f\xf3\xf0\x8cv\xd7&\xea\xc4o%\xa4\x94@\xb85s\xcd8\xa0\xe2\xb6z\xb4\xdb\x9c\xf4\xaf<\xae\xb5\x06\"\x0a\x03\xbb5r\xa2v\xbe\x81\x94\x16.\xdc,\xd2Uu\xcb\"\xe4K\xd8W\x03\x98,#_W\x06\xcc\xdb\xeb\x0a[\xf2\x9aV?\x94T`q\xd1k<L\\\xf5y_\x88\xe3si\xd1*\x0f\xa1p\x85\xe1\x12rm\x8c\x97%\xe7\xa5\xac\xfb\x8b\x14o\xd1\"\xd8\x8cnO\x19%\xbcG\x82\xaf\xc6\x11*\x86\xca!/\x08\xd7\x11\x14G\xe7F6F\xa5\x89\xd9\xc6%\xd5\xf5\x1cJ\xa2lF\xca\x8c\xc8w)\xcb\x0e\x85N\xc61\x9d\xe5\xc0D\x88\xc8.l\x8b\xc6\xbfA{\x16yke\x1a\xe4\x82L\xe5\x92\x9e\x9b\xea#\xe6R\xe7\x85\x15\xe6j\xd5\xe1\xcb\x15\x91\x16Q\xf8h\xfc\x01>\xa5u\x13y\xf0\x07\x9b\x03\x8d%Y\x7f\xd1A\xf9\x8f\xf3\x16K\x1fR\xe6.\xef@\xdf;\xd8%\x0c\xac4\x144\x88s\xf4\xbcEW\xee\xe0q\xf1[p\xcaf\x11\x16\xfc;\xdbEB/M\xbeo\x18\x17\x96:\x92\xe6\x08\xbb\x85\xa6\xbe\xb5/\x95c\xcd\x07\xc4\xb1n\x80\x80\xf2\x04\x09\xddg\xe0\xd0\xa6\xbe\xc74\xaakR\xbc\xad8\xbdD\xc1\xeb/~\x8e\xbf]\x7f\xd7{\xf7\x05\x16\x9c\xb3\x8d\x1c\xd4?\xb0\x95\xc5\xc8:\x89\x011_8n\xec[\xe3-\xcd\xd6~\x17\x0d\\LqO6\xa4\xd0y)\xb9\x91'\x8b2q+#\xf0\xd9\x0aj\x97[\xf3\x0c\xc3\x04\xd8\x9b\xd5\x03L\xae3<\xe8\x02\x01\x1d\xc0z.\x18N\xc1\xc2\xf6]\xc5;\x01o:\x0e\xaac\x1d\xd6\x1a\x01\x80\xab8*\x8e5hB\x1f\xf0f\x89Y\xdc\xd8NT.\x92\x14\x0a\xa3\xce?\xc1\xff\x0e\xb6\xf8k\x94,\xb14\xf5X7\xf5\x11\x86\xaa\xa99mhR\xc0\x89\xb2\x1b\xa7\x9cx\xad\xa2U<\x89\xc8@\xe3\xf1IB\x1b\xf4G\x98\\\x18*\x91.\xa1\xa9\x078\xc0\x14\xca\xe4wR0\xe9\xcf\x0c\xdf\xea\xef\x9d\x98\xf1\xf3\xd4{\xda\xa5i\xec\xc3p\x11\xb9\xf5\"\xc7\x97\xcf\xad\x1b\x8d\xeb:\x87\xb8DlDo\xe1z\xa7,\x8aT\x94\xf1\xc1\x1b0YN\x8fztp%\x04C\x17\xc1O\x8b\x9c\xd1\xb8\xaa\xfd\x9e\xb1*\xc8\xacY!\xb3f\x9an\xd6*\x91[\x9b*\xb6\xd0U\xe5\xb6\xbb(\xf0\xafu\xd0)\xc5q\x8c@U\x1fR\x0aR\x1ebH<j\xbck\x1d\xc5\xd5\xa6'r\xdd9\xb4.\xd7\xe0\x17e\xfc$\xa1\xa5(\xbd\xac6\x94\xecz*\xa72\x04T=\x9b\xd7\xbb\\\xbb\x8e\x88\xe0\xb1\xff\xb7y\x1dR\xddyw\xeb\xfe\x94h\xd7\xa4\xe2q3\xfe\xcde\xe6MM/\xd8\xd1$T\x04\xd8Yi\x17\xe2i\x8a\x90X~:\xf5!7\xe9\xbd\xc1<\xe8\xd6\\\x97h3\xb6\x05\x93\x1f)q\x0c',\x15n\x9b\xca;\xee\xc9D-`\x84{P\xdb\xbbQ\x8b\"\x07\xf7\xa5n\x81P\x19\xe5\xd9\xc3\xb0\xc9\xaf\xbc\xaa\xbae\xef'\xf07\xbd\xd2L)\xa6\x05/o\xd8\xbb\xc2\xbb\xd1\x1b\xa4Z\xb6\x0anN\xf3\x08\x96\xcb\xba\x88\x8cK\xa8S\xac\xa1\xdb\xd4TC\x90((%u\x8d\xbb\xd2\x00\x7f\xf2\xb80\xd9\x9a\xe9\x17\xb1\x01\xca\x83aE\x00\xbf\xe5\xbc\xd6\xdc(\xb9\x81\xbb\xde\\.36\x0b\x04\x99\x98\xaf\xdf-\x83\xb5\xf5z+\xc3\xe2M\x82\xe5\xb24\x13{\xfc,zFM}d\xf2\xac\x85bw\xc3\xeb\xfe\x0909\xfb\x00\x8a;\xdbR\x10\xc3\\d\x83/\xb5\x95\x0e\x9c}\xafZ\x0c\xd8\x1a|\xd4\x93\xea'-C\xa2#\xfb\x07>\"\x1d\x0d\x9a\xd4\x8aV\x05\xf9^\x0c\xf8\x17\xd2:\x99\xa1i\xbd\x82tM\x98\xa2\xb1H@\xc5\x12$\xb4\xe70\x9f\xd4\xfa\x8ef\x92` u\x99\x16jo\x18\x01\xef\xbb\xa0\x89\xfb\x0b\xce\x80\x96\xc5q:?\x8f\xc5\\\xbc&\xcb=\x87\x02\xae\xfa|e\x89\xa1R<\xb8\xfe\x84\xed\xc4\xabi\x07\x1az\xc8p\x86\x87z\x90Ulg\xe8b\x8c\xe4\xaa\xa8\xb1V(\xc7\xda\xf9t\x80d\xb3\x9d\xc4}\xe5&:\xce\xd0x\x1e\xd4\x8e\x89\":\xbc\xe9f\xa9\xe3\x19\xed\xc5\x8cY$\xa9\xb8a\xcb~\xb9\xdd^=-U0\x84;\x85\xc1\x0c\x1d\x18l\xd5N\x98\x9b\x08\xbf\xb0\xb9\xc0\x19\x88\xa2\xe1\xe2[O%\xc8U\xeb\xc2\x1a\x18N\xec\x1f`\xce\xcc\xa4Ay\xf8\xa2O\x87\xf6\x12)b5`\x82\xb1\xf3\xaa<k\xac\x92\xe9\x1b\x95]\xf0\x12\x1c\xadN\xddN\xdbc|a\x82a\x06\x88\xd9\xe0O\xc8\xc1\xb9\xd2D+\xfe\xe7L\xcfJ\xd5gY\xbf!:\x9cQ\xd8*Wr\xd8U\x92+\x9bkV\xd1_D\xf3\xc5\x9e\x83:\x1b\xfcw\xa2;\\S\xb5b\x80\xd1\xb5i\xf4\xeaY\xe9]\xf3(=\xb3_\xa3 \xd9\x01\x8e0\x85\xdf\xa8L\x8e\x81\x970\xb9\xc6\xc7An\x00g\xbb\x82\xf8\xe5\xca\xf3\xa8\xa9\x8ei\x0fK\x04\xb8\xffQ\xc6\x97\xb5{5#_\xf2\xfe\xf8\xe9\xa0I\x15|DLv\x98\xc2j0QD%\x13\xee\xdc\xd8\xe9yu5Z\x95\xa5\xa7\xcd\x19\x1f\x82\x8d\xcdO\xba\x96g\xccH\x96\x7f\x8f\x9f\x98\x0ec\xd0z*\x84\xf5\xbdE7\xc8\x8a/\xd5L,\xfb,\xf3g\x9d\xf8\xac\xcc\xb06\xd6\xa6\xb98\xde\x9c\"'\xd1\xd7\xa4[\x19\xeck\x99I\xa9\xf3\xea\x1e\xf1\xcdE\xa1M\x0br\xb1\xe9py^`\xfb\xc7@M\xebF\x17:\xeaj\xa7\xbc\xbc\x05\xbd\x01L\xd3\xe8a\x84\xbd\xfc\x82T\xd3\xa2\x17\xc9\xe1\xcb\xa8\xaf\x94)\xdfc\xb4i\xa1\xe2#W\\\xda\x19\x98\x9e\xbfw\\\xed\x1a\x8e7q,\xf6\xb4\x92\x86X(\xb2\x80\x0eYI\x88o\x16#\xd5\xba\xbb%\x938\xbc\xc3\xf5\xfc\x94\xb6\xe5\x7f\xfa\x0f\xa3\x1f\x80\x7f\x0f\xbf\xaa\xb2\xdf\x9f\xaa\x1e\xba\xfa?\xff\xe9\xef\xff\xf5\x1f\xff\xd3\xdf\xff\xd7\xbf\xffo\x7f\xff\xff\xfc\xfd\xbf\xfe\xe3\x7f\xfc\xd3B\x17\xa8}\xde\xba_\xab\x16\xa0\xab:\xd7U\xe3\x19\xe0\xb8\xd8o\xba\"\xfd\x101_,\x97i\xfc\xaa\x99\xa5\xde\xf9Y,\x95b\x94T\xc4&T\xc4(\x8c\x89\x87\xb3\x07N\xfbG\x1a#\xca\xeb%d\xcfQOh\x93\xf8\xb0X[\xb0\xddY>h\xa3>\xf7u\x04\xaa\xcf\x97\x19\xef\x0a\xaf\x9eq\xa0\xac\x05$\xe6\xf8UZ\x82(\xae\x80\x98Y\xde?\x93\x93W5\xf5\x16\xdfB\x97\xc5\xef\x10\xea\xc6\xa5\\\xfd\x03H\xd9\xedL\x94G\x90\xad\xdc\x99d\x04\xe3\x100\x89\x98j\xc3\xb5\x85ZO\xb1M(s\xa3\xcbOk\x1bSs\x9fa\xd0\xb4\xf5\xfcP\xe5\xeb\x8e\xe5\xd9x\xc6\xe2\xcb\xbdL\x92\x82j0\x923\xd1\x9aPB\xbc[\x99z\xcd\xbcY\x8c\"^\xf1]\x1b\x93\xc2,\xa3\x0d\xae,\x8f\xaf\x92\xa7_V-\x02\xcc\xf4\xf8\x0e\xa6\xbd\xefb\x8d5Y=~\xf2cR\xb4\x94\xbe\xd9B\xe25\xb92g\xfe\xecE\xa8\x04sY![\x9e|\x9e\x8a\xc7K\xe3M\x1a8X\xdd\x18\xf5\xe1\xe0\x02\xfbU\xd3y\xab\xd6$=\x86\x059n\x04Q\xa0\xba.J\x110\xaf\xc5\xb8\x07\x7f\x16\xd2\x0e:|Y\xbe*\x1a=\x12\xdf\x8dt\x14\x91\xe062\x9a\xe2\x83\xb8P\xe9\xba\x9b\xb3\xab\x86a?\x99P\xb1\xb8k\x11\xba\xdd\xad\x8bDi\x0d\xc42\xb5\xf8L\xea\x84\xce\xc4\x15\xb3_\xbd\xf7\xd2M\xbb\x9a-\xf3yq\xfdJ\xe5\xb6\xaace\x91\x85\x15b\xc5\xa2\xdf\x98\x96\x05ku\xba)\xb3\x81>%5\xd2\x9egh*!\x97\xd2c6\xa3\x0f{\xc9\x96$\xa5(W\xb6\xb0\xc6\x86\xd4\xde\x83o\xfc\x0a\x0a\xe1\xee\x86'O\xf9\xeb7\xef\x8f\xd0m-\x9b;\xc3C'\x7f\xf0g\xe2\xa6\xb1\xb1^\x86.\xc8\x9cI$S4(X\x0f\xa3\xbf\xf7Gc\xcc\x91\xa1|\x14\xe5\xe8d\x90q5\x10\x9e\xa2k\x9b;\xb4n|bX\xa6\xd5\x85\xa1\x93t\x18\xe2i+U\xa2\xd1\xbe(V\xc2\xbeO\x15\xb4Ng\xc0Zu\x95D\xd5\x07\xdb\x98\x970\xa2\xeb\xef\x89?\xed\xc1\x0f\xa1\xb5$#\xff1l\xf1_\xe0@\xafa%q8\xd1\x03\xae\xb1\xc1\xb3\xff(\x95\xbc\xda9#<\x80\x14\xd49\x0bJ\xca\x0f\xd4j\xa1\xf35c\xf7\xf9\x95\xa6\xf8\xc5\x0f\xde\xe4\xb0\xc2u\xb1\xdc<\xcc\xc7w\x99\x04\x0d\xf2\xc7\x11\xa2z&\x1b\x93\x94\x0a3\xa5r\xebQ\xcc\xbf\\\xc1\xbd\xa4/yv\xfdu\xf0\x8a\x1aA\xd8\xd2\x1d7\xbc\x19\x8a\x8ec2g\xaa\xb3z\xd0\x9cZ\xb2\xbb\xa3:\xf3\xa9q\xd3\x0d^\xc3\xcb+\x0d\xf9\xf3\xf2;\xda\xc1\xcb\xb1\x0c~\xba\xab\xae\xca\xfa\x8b~\xa0\xee\xd1\x82p\xc5! \x08\x0a_[\xf8\xc4v\xf4O\xdc(\xf6\xf8I\x9b\xe2&\x8e\xff=\xfdp\xdb\xb8M+\xf1\x1a\xdf\xc2\xc4\x1d\x16\x9fY\xb7a]CF+\x0bE\xa6\x8eK\xee\x81+\xa3\x02\xd2D\xcb\xc7d\xaf\x07W5\xaa\xbfK\xff\x8c\x15\xbf\xe4\xf0J#\xcc\x0b\xf8JD\x9e\xba\xacV\x9b\x09`Z\x89\xfa\xbc\xb9\xd2\xef\x1fd\x98\x97f\x90\xa6\xa1h\x80O\xf5\x10\x867\x97J\xd4\x83\x1f\xdd\x909pv\xc3\x8e\xe8rSA\xc1@\x1f\xf4klo\xbe7q\x84\x93\x1b]\xd5S\xb9\xff\xbf\xc0\x19\xfa\x1e:uV\x1e\x83\xa0\xd1\xfd|\xf3\xfd\x15\xca\x86\xb0B\x86\xafD\xf5$\x16\x81i\x15.\x09\x05t\xb8\x17R\x0b\xa1\xbaR\xa4\x9b\xe300b\x91B\x889\x0a\x07m\x95'\x06\xd3H\xd1\xf1\x1f}\x03e\xb0\x01_\xc5\xc0\x1c\xb0\xb0\x029\x07\xc5H\xb8Gc)=aye\xba\xc5z~\xf645\x9f\x19Bo\x99\xb2.\xaeq\x83\x86:\x9bL\xd2X\xc3\x00\x88\xb5\xb0\x09'9\xc2\xd2\xc5M~\x12\xe1$]d\xc2\xb0\xc3\x04\xacr\x8b\xf2C\xe4\x0aq*\x88\xa0)7*\x91\x8bE\xd3\xf3\x963\x81W\xe3\x1aK\xd3YJ\xf2\x98-A\xc7*\xfdn\xd6\xb6\xc47\xb1!?\x9c\xf8N\xae\xb5\xf4]\xae\xb5\x1f\x99Q#'v\xd6xOJ\x8b5-OG\x98\xc7L\x13\x96\xe9\xaa\x87\xb1\xb6]\x16\xdf\x9c\xed\x9b\xc5G}\x96z&fPx\xc2\xb6q\xd7j&\x89\xf9\xdf<\x9c+\xae\x9d`\xfbY\x98\x88\xaa_`j\x88\xd4\x9b\xcfU\x9f\x05F\xa0\x8a\x9d\xaa\x81\x07Oy\x1b\x1aM8{\xe1\xe4\xe2I8N\xf62Z8R\xe1[p\x9c\xf9\xa2\xa3\xba\xba\x0f\xe3\x8c\x8a\x15kf3\xf1\xfd>|Pg\x99\xd0\x9e\xcd\x95s\xf8\xd4g\xa5\xc7\xdf\xf9\x06\xfa\x06\xce\xb8f\xc9s\xd3\xd8K\xc6\x13\xf1\x90\xe1\xe0\x93W\xc1'\x0c\xa7\xa5&i\xe7\x8b\xdb\xb0\xad`\xe1\x0e.\x16\xb2\xd8\xbb\xb25\xb7\xd8\x97\xcf#\x1c\x82\x9b'\x9bW\x19iL\x93K\x9d\xbbp\xb5\x19\x19\xc6.V &;Y'q9\x17\x91\xb4\x0a\xfc\"\xb4p\x92\x91\x16\xd8\xad\xb2\xb4\xf3\xfd\x97\x165r\xaf\x96g\xd9\x8bG\xd9\x8f\xe2\x12\xedf\xc1P]\x15\xb0\xfd\x17\xb9V\xa4\x0a9A\xcd\xf2\x9e\xd2\xd4G\xa7\xe5\xf1\x86Yg\xc3\xdfK\x00]\xe1\x87\xdb\xd4\xebJ,\xc0$\\!T:\xd7\x96\x9ck6\xe0\xf7\x1c\x1d\x0e\xe3\x0d\xdfA\x84\xed\xfa\xea\x98\x7fEu\xa4\xd1\xe8\x91/#\xe2\xd9k\x08\\\x8d\xd4\xe5G{eYf\xa0\x87\x06w\xe4]!\xcc2\xe7o\x8et\x12\x0d8\x0b\xb0\xc1\xdd\x05W\x07\xde\x13\xe4\x08K\xff\x8d\xd2t0\x02\xf7\x9d\x1c\x82H\x95$\x13\xc3\xf9~T\xab\xaf\x16\xe9Tr5\xf6T\x03-\x11\xa1\xdaU\x9d?\xc2y\x8f\x9f\xb3 \xc0:\xf8\xeb\xd9\xeb\x85\x06\x8bf\x84\x0d\xdd\xb0\x11\xc3\xb8\xfb\xb0\x0ccBO\x16\x18\xcb\x0db\xa9A\xa2x!.\xa6\x0a\x05M\x0c\xc5]E\x89\x04\x88\x82\x96ZbA\x12]\xd8\xa2\xd6:\xe8\xac\xa6\xf2\xcc@\xe0\xe5<\xb3,\x1d\xa8(\x81k\xfc\xb4\x97_h\x85\xc7\xabE[\x8d\x80T<\xb1\xa2\xb9\xc5p_7\xc6S\x8a9\xee\x8cMMX\x992\x1e{\x87H\xf84\xec\xdc-\xc4\xc5o\xe3\x82K9\xcaT\x00\"\xe7z\x8c\xf9f\x82\xb8v\xabDF\xecD\xf4u_7U\xf792\x03X" +
+	"*\xd9\x99\x84\x1a\xcdm-\x8c\xc0X\xc1\xec\xb4h\x82_\x93\xcc\x98U\xb0\x86\xcbm\xcc\xa5\xb6U\x01\xbd\xab\x94\x1d\x89\xd0\xe5Z\xed\xb41/\xf1^\x82C\x82\xb3w\x8b\x92\x98\xcfVj\x03$\xe7\xad\x0f\x8d\x03\xa2\x05^z(\\i\x05>6\xb1PF=\x8c\xbf\x04\x97\x93\xfeF\x0bzH\xd8\xbb-\xfb\x12\xa4\xe6\x12\xf3\xd1\x1c_\x16\xa5\xaeL6&\x8c\xfa\xb3\xd25O\x10ZL\xc3U\x94\xed\xf8y\x89\xea'\x0d\xa3\xa5\x9a\x1c\xd5F\xbb_\xcaN\xe2\"\x9e\xa6U\xc3]\xae\xd9\xb7\xcfM@2\xae\x88\x18=\x08\xa7\xa7\xb8/\xb3h\xb2o\x19nO\xe5\x14J\xf3\x98h\xfb\x14K\xe4W\xe6\xeaE\xe9\x057\x82lJS\xd4Y\xa1\xbf\x1b %\xe0\xba\xbd\xbc\xfe\xf9\xdd\xc2\xd2\x8c\x0b!\xbd\x18\xae\x88R\xa6A\xbbQ\x1c\x09\x01C\x0e\xa3o0\x921\xde\xbb#E\xa4\x98p\xcd\xf5\xbe\xd3'\x8e\xab\xfdWW\x90\xef\xbf\xa6\x8e\xfc\xf9\xb3\x15,I\xae\xf0\x1f\xebJt\xaf\xa5-\x82\x1e*1eTyr\xff\xa3\xee\x17g\xe8\x0e\xb0\xa7O\xc9\x02o(\xc4\x10Fj\xf1V\xdb\xa4\xda<\xec\xd9\xcb:\xf9?\x0b\xb1#)\x82\x19\x16\xcb\xd5\x1c\xa7Vc&\xf4o;!\xf2Pg\x886u\xb8\x87\xad\xb2\x07\x10\x15w\x0e\xf9\xb7\xfai\x05\x06O'\xca\x91_\xa35\x16\xf9\xca\x96\x97\xb8\xb3xKI\x87\xddPQ\x01\xd3Z\x15E\xbc\x06\xf1\xdd\x86}\xac?\x91rI\x93\x15)3>\xca\"\x876i\xb7\xe6e-\x99R\x94)\x0c\xce\x86\xbc\x98\x8b\\\xdcE\xbd#.\xd9\xec&_\xb9\xc5\\[\x14_\x18\x18\x10\xb3>\xb3\x8a\x978\x0b\x07fxiB\xfc\x1a\xfdm1\x03\x12\x98-\x1e\xd0\xe7\x84FR\xf0\xd5\x9f|\x7f\xf4C\xc3l\xdaZk\x08\x80\xe8\xb1X\x9fH'hMp\x07\xf7\x9eNZ~\x85\x97\xf9\xea\xb6\xb7\xd6\xe7L\x88\xab\xc0\xfe\x18\xc3\x0f*u\x9a\xc9\x8ce|\x8f\x85d\xa0LD2\xd3\x85$\x8f\xfb+\x8c8\x06\xdc+\x8e\x08\xc8\xfe\x0e\xd5c\x93\x1bl\xa7\x9a\xa4nV\"\xad\xa1\xd6\xfa\xe8\xce\xd5\x19\xa6\x95\xf9\x95\x948?\xa20M\x9e}I\xc7\x9e\x12\x12Q\x96\x1dSn\xbew{\xf9\x85\x03\xa5\xef\xe4\xa0\x19\xf5\xe9\xf23\xa7\xe5\xbf\x8f\xc5[_#$\x80\x09|\xf9\xc5\xc3*\x9eJ\xe9\x847\xcb\x19\xf1R\xef:\xf7ui\x02G\xbeI\xae\xdd\xf8\x0bx\xac}\xfe\xd5\xffv\xde\xbe\x93\x82$\xcb?\x9e&\xceS\xc6o\x06ap\xe4ok\xb9\xc7\x10'\x82\xd0h\xec\x91\x8d&\xd30\xc0e\xcf\xc7Q\x89\xf91\xf5\xaa\xd1\x12\xdbd\x84nR\xfd\xe5`\x8c\x85\xa1\xb9\xaa\xa0\xecZ\x7f]$Y\x9b\xe5\x8e\xec=8\x88\xdf\xd9\x18\x9b\x11\xde\x9fQH\x19\x0b\x08\x1a\x1fGa\xc2P\xfa\x07\x10\x93>\xbf\x96\x96\xf8\x08\xa3ofq\x99\xaa\xa9\x95\xde~\x00\x9e\x9c\xd0\x81\xc1qd\xc4\xb4v\xf0L\xf5\xc0r\x85\xa6\x8e\x03\x11hd\x80\xe3\xd6A\xcbq\xb8{\xb5\xc9\xee\x86\xd2\x19\xd48a\xf3\xa9T&bcX7\x02n\xe8],\x8a\xce}8\xbc\xb0v\x88\xe1v\x9e/\x19x\xa8\x91q\xc8\x92)\x8bL\xdex\x9e\xbe\xebBX/\xaf\x1e\x97\xc7\xe7\xaf\x11\x98\xc0\xbb\xe3\x94\xd3\xc3\xe4\x00\x1f5\x1d\x112}\x82\xfe\xc8\xb1Oj\xb6+\xb4\xce\x1f\x13\xcaW5\xb37|\xf5m\xe9\xf2\\\xcd/:wi)\xf9m;q\x02\xf5\xe0~\xb8\xda\x98\x8f\xed\x05\xe9\xbdT\x936\x13\xa0nj\xb6\xb6-\xc0[J}\xa5\x98\x8a\xeb\xf6-b\x8c\x93\xdb\x99\x89f\xb8_9\\p\xa5%\x16\xd8\xd4\xfe\xe8-l\x0e$\x92\xe8 \xd1\x04X\xef\xae\xba\xb8~\xf2{\xfc\xb4J\xdf\xa5\xed\x99g\x14\x05\xa2x\xd2\xf9~\x02\xed\x81\xaa\xf5\x07\"\x06\x1a|W4\xb6\xbb\x1c\x9e\xbfR\xf4\xc3\xf5|\x0c\xd2_n\xd4\xbbL\xe64v\xf7\xe4\x1a\x18\xc2\xab\xf8!b%zas\xa7\x89nHK\x08-\xde\xba\xceO\xd5&\x1e\xba]y\x93k\xaf\x81\x9b\xfab\xb6'2\x84\x15+\xe6\xa3\xf3\xd3\xa7:\x7f\xca\xd1\x8f\x87!Q\xffn\xa1\x82\xb7\xa9\xbf\xc0\xbd3U\xbc8\\bu\xcf\xb5Z\xde]\x86\xf4\x9a\xdbD8\x1e\xbe\xc2$z\x91\xd0:\xe3\xd3SQ\x14c\xd4\x892\xeeX\xf7G\x88|%\xf4\x06\x06\x84\xabD\x06J\x1dt\x0c\xbd\xf2%\x82\xf2\xc2D\x03\xb7\xa7\x1fv\xe7\xcdbx?\xd3m/\xfe\xdeY\xe6\xddL\xc8\x90J4\x98\xed\x13\x1bT4\x17\x90<\x98S\x12ni\x0e\xce+\xfe,\x8dj\xca\x08\xd5Pe1\x87@V\xea\x00\xad\xd1\x9fn<T3\xa3\xa0?\x1b;\x0b\xbe\xf70/\x0a\x92\xd6h\xa9\xbb\xf1\xdd\"=\xd4\xe0\xc6\x14.]\xe2\xbb\xe7\xf5\x9b}5\x1a\xb3\xd7\x16\xc6\x17v,,\x8c1r\x0f\x06\x85\x8b\xfd\xb4`\x9e+?`\xc4\xbfn\x97\x01\xb03\xb5G+dN\xd6\xb1\xbeYl\\2\x06\x89d\xdb\xb0\xae4H\xf1\xf2\x91]Ieu\x99]q~\xd3p\x8f\x0d\xfc\xa6D\x05\xbf\xc4\x94{v\xab\x8d\xb4\xfe6\xa2\xc9\x08\x98*HfY\xb7\x0a\x16\xeb\xe4[\x8d\"+\xe6\x86\x97\x82\x0bg`\x8dQ\x8e\xf6W\xe7\xbb&\xb1\x8a(A\xa1\xe5\xba\xc8\x88\xc1\x9bn\x1a) \x89\x9d\xe6\xeb\xd7\xc7\x9793`1\x1e\x93b\x92\xa2\xba\xecL\x0eRWR\xe1\xeb\xa2\x82\xdf\xa4t\x9e\xeb\xf8\xfaS\xf3\xb9IB\x95he/\xf2\x96\\\x1b\xe0\xaf\xca\\\x901\x9f\x9f\x8d\xf5pc\xe9\xc6pC\x8cU\xbcKv\xad\xf7\xdf\x0c\x00\xbf*\x83d\xb1i\xea\x1e\xda\x8c\xe9\x1f\x1b%\xef@\x85\x0d\x98\x14\x9a\x85LeIz\x1di\xf8\xb0:\xb9\xa0\x05\xe8|_}\x83\x03\xc8w1\xa7`$\xac\x85\xae\x1f\xc3\xae\x85\xd2;\xec\x9b\xb0\x09\xd4\x03\xc4\xf8\xecz\x8d\xc0\xebR.\xe7B\x94\xd8\x16C\x0f\xad\xe3\xda\xae\x0a\x06\xb3\x9bqS/\xa5w2\x83>F\x82\x15Ng\xd0\xbe\xca\xb1C\xfc\xbe\xcb\x0c\xf2\xd7\x86\xcc\x9b\xd50G6\x0a\x88\x1e\xf2\xe4\xdaX/D\x07o.u\xe7\x1b\xd8\xd3\x8f\xa4\xdc$\xa9\x93\x97\xd1b\x90\x1bf0\x98\xbf\xce\xab\x0a\xfe\x0c\xc3d\x91VQ\x90;\xa1\xf3\xe6d+\x1e2F\xfb\x15\x13`\x89\x14FM\"\x95X\x91,\x8b\xdd\xb5\xc6\x8a\xf03\xeb\xbeg\x8d$\xe1\x02E'\xa7\xdd\xaf\xbb\xc4\x0f\x93o\xca\x8evT\x14R\xdcP\xe2\xa2\x97\xb6z\xc6^\xd8\x18\xc3\x93j\xf3\xc1\xac\x88U\xe7\x9b\xce\xf1DtM\xb6gE\xff\\|d6\xc1c\x81q1\xda\xc6\xf9\xd9\x9c\x1d\xcd\xa7a\xb6+\x0d\xfb\xf2iig\x96\x9d\xd6\xac\x07f\xf1\xd2T\\X\xfd\xcf\xa9?\xcb\xc6\x11:_\x15\x08\xd3c\xc2\x81%\xe1'\xca*\xccY[\xb2U%[9\xe7\xbb\xde\xcf+j\xac1\xfc\xae+1\xae\xa7a\xd0\xd8\xee\xc7\x09\xb3\xb8O\xbd\xbc\xbbe\x9f\x92|\x19\xd5\xe7*\x02\x81P\x9f\x1d\xb8~\xdf\x01GT\xc60v\xaa\x93\x1f\x80\x93\xc37\x08\xdf$\x9a\x0b\xb1\xf6c\xc3Z\xa4\x04\xcac\xe7\xa5\xf5\x17Hj!b\x123f\xc0\xf9\x18\xd5\xf5\xc98\xb6E\xdcg\xc7I\xa2x\x8d\xed\xef\xaf\xb1H\xe9\x93V\x90)Q\x08\x9c\x0b\xfc>\x94%\xc6\x13duY\xc1\x8d\x99&\xc7jN\xc3\x1bNxg\x0a\xf3f<\x1a_Y\xa2\xf72\xadUN)\xc6\xf1\x95\xf7\xf4C\xeb\x1e\x82\xdctKT\xee,\xeeK\xa6\xfd6\xd16/\x93@\xe8\xc1\xe8\x80c\x1e\x00\xfa\xe4\xbc\xddz\x98L\xc6\x04\x8b\x9a\x09\xd9Cq\xa2\xdd\xe0\xa1\xa8\x83\x82W?\x83\xb0\x05\x00\x11\"\xaaX\x99<n5>\xc0\xaf\xf0\x8d\xc0L\x12\x12\x11\xfdJ\xcfRvY\xdc\x9a\x19Pqt\x9c^o\xa8\x04\x8a\xa6\xd36\x06\x11\xe9(\xd1Z\x8dN\xb6j\xdek\xe5w/\xd7\x0c\x075\xca\xf4\xfb\xa4\xfa\x99\x1a!\x8f\xfb\xd9\xda\xa4L\x80\x8a\xd6i\x131V\x1eo\xb9Fh\xb3=~>V\x1a\xe3\xe5\x8be\xd5q\xda\x0e\xa82\xac\xe4\xed*\x86\xc1\x04\x86\xb8r\xa9\x85\xae\xaa{\xa6\xee`\x98\xf4\xf7\x8e}\xb4\x0e\x1e\xa0\xd3\xcd\xf7\x9b\xf7\xc3\xf8\xf0Dj\xa0\xfdD\xca\xb8\x1dgtY\xaa0\xb1Z\x11\xb8nL\x0e\xacN\xe6u&J\xdb\x10\xb6\x81\x02T0\x01\x95f\x1e|\xcbv\xc7\xc1\xf5\xa2\x88<\xa1!\x94\x98\xc1\xdbj\xf2\xfd1\xb2EJ\xe5?\xa1:~`\xc5\xc2]\xd2\x0a\x0c\xa8\xe4##U\xc1Y\xe4\x1a\x9eP1\xf4\xcf\xfe\x93\xfb\x0a\xe6\x82H\x0a\xf5](\x94\x07\xb3kjY\xaeR\x85\xd7\x0cd\xe0\xc13zC\x15\xd7\x1d\xfc\xf4U\x98\x84[E\xb7_\xbeZ\x97\xa4\xe6\xc8\xdd\xe0\x89\x00\xd5B+\x09f\x18\x9e}C\xbc\x08\xec\x16\x1f\xc05*qr\xc8\xde\x1f{\x9a\xdf}\x80Q\xb8\x0e7Ip\x13+\x06X\xa9\xe0\x0c\x03\\\xc2h\xe1\xef\x1a8\xa0A\xba\xcd\x9a\xc5\x9afT\x08\x8f8\xf6\xabQI\x04&.\xb6\xce\xeb\x15\xd2\x00G\xa3\x1f\xd8\xc9\x11\xe61Z\x07iM\xaeNuwpC\\b\x0d\xe5\x0f=0\xb6\xc7\xe6\xec\xef\xa1\x8f\x897q{\xe8\xd2\xa8P\xa5\xbck\xe1\xe2,e\x89\xc8\x1c\xa5nn\xc1WM]\x09\x19Z\xadL\xdc\x13\x84\x10\xb4\xad\xfb\x9bLV_J\xe1\xf1x\xdc7p\xe1\xfe@a1\x012\xc6(\x07\x1c\xf8,J\xab\xb6b\xf3\x88,\x05\xd7n\x84\xde\x09\xe6\x86\x93h\xa3\xc6Z\x15\xd6:\xef\x13~K\"CK@\x9eK\x95\xe7\xcb=\x97\xb8\\X4g\xa9lX\\CD6p\x97\xf3\xdd\xc1\x9f\xab\xd1\x1f\"\xe1\xf4X\xbb\xdf$\xa7\xf8\xae:\xa6Wb\x0d\x8dp|\xf1\x82g0\x97\xed\xea\x01z(\xa3VN\x9e\xa2\x1d)\xe8\x15i\xb1\xc3\xc0\xa7\xc2\xe3E\xab\xe4J\xa1\xe8\x025Rj7\x9d\xb0t\xfbGol{\xdf\x85\xc3p\xcc\xb8\x99\x17\xfd\xdf\xc3Y5\xe1r\xcc]\x96\xb4`\xb7&\x12\x97`bR8\x06\x8a\xcch\x1b&bK\x12T\x0fx\x17\xba\xc5vGl;\xb8tX\xcc4\xe9@\x87\xd8\x1b\x19L\xdcF#\x0cj</\x97\xc0\xab{\xf4S\xe2f+\xd3v\x01\xf5?\x8fAF\xf9.\xf1\xcf\x8c:\x82b\xf2b%\xc2\xb5\xba|uG~\xf4\xe7\x99;d\x85\xf9\xa4\xa1\x99\xe9&\xde\xcb\xf2\x1fQ\xe6\xcf0\xe0p\x06\xfeh)h\xe2\xfev\xed\xe1\xd4\xb2\xa7\x03\xdf7\"\xc6\x96bR:\xe8\x83\xa7\x80?\x94\x0dI\xa8x\xcau6!\xc1\x1c\x8f0\xbaB\x8a\x7f\xfbz8\xd1\xab\xe7Y\x88I\x83\xbb\xbc\x0b]\x02\xef\xbc(\xe5\x9c\xebAiB\x0bd}\x96\x17$a\xddP%>\x0a\x0f\x9a\xe0l\x0b\x8d\xdb\x87\x8fT\xd4U8L\x8f\x09\x11\xf6\\\xa5\x95\xaauf\x8bc\x82\xeaI\x19L\x92\xe0\xc5\"\x9c7\xb2\x16}\x842\xa3\xfd\x8f\xfe\x9c\xd7\x88\x87\x99\xdcQ\xbcL\xf8*\x8bkT\xe8|\x05\x9d\x1c\xe0<\xd5\x03\x18\xbe\x84\xa9\xeeO\xa7p\xa9\xe6q0\xa02\xa0U%Z\x95\x1d\xdfO!\xfe\x08\x13`\x9cd5\x00\x99\xf2\x04m\x0a\xeb\xe4\xd6.\x92\xd57\xef\xe1X\x8d\xe0\x8f1\xbd\xbd\xab\xfe\xec\x8fO\xaa\x1ff\xcb\x85\x9d\xaf\xeb\xab\xa6\x86\x83\x0a\xeb&\x0e\x1b2n\xb9\xa7\x0c\xcc[\x11\xf34\x18\xa2\xd1u\xa5\xc2\xe9\xd5\xab\xf2\xea\x17Q)\x8f\\\x08\xa9>\x95>\xb3E1\xd5\xe3h\xfc\xc0.`\xbb\xc0\x0d\x8a/\x88\xb2{\xa52\xf2\x8c:\x16G\xca\xb5\x15 \x96\x81\xf8cu\xa6W)\xad\xa9\xda\x0d\xba\xaa\xd2\xd3\xe2\xca\x04\xadY[wvq5\xfat\xeb\x0b\xec\xf2\x13F\xa4\x0f\xa3\xe8u]-/\xa7\xc9\xf2\x00\x96}\xed\x92-\xb0\x027\xe5U\x8e\xc7\xd6\xb5\x16S+\xe2\xfd\xd7\xae\x97+\x8b\xa5\xd4\xc8\x07\xdb\x96*l\xe6K\xa5\xe4Y\x94\xaeae\x8d\xfc\x16\x13\x1d\xa2UO\x97\x9c\x91wd\xbaC\x09\x05X\xb4\xe6YBx\xaf\xbfE94\xacJ\xda\xaa\x13D\xee>\x0dZ\xdf\xd1by\xd4\xd2H-x\xac\x0f\x92\xb7h\x08\x7f\x82\xec\x1b\x02\xf9\x85/\xd0`\x93l\x0c\xe4\x93\xae\x86\x909\x9e\xf2\xfajD\xa5\xbc\xa7\x1f\xfc2\xc5\xc5\xff6-\xdb\xd7O3\x00F6\xcdm\xe7\xcf6C\xb7S\xd2]\x17Q\xd1\xd6n\xa3:n\xce\xdc9-|\x8a\xc8TGlk\xb46V\x8cgL\x05D\xbe\xb5TP\x1c\x90F\x83/\x86\xf5\xa1\xff$\x81`}6#\xbf\xb5\\\x16i)\xe5\x17\x9bH-\xb2\xf9\xfa.\xe1\"\x1a\x10\\\x88Q6O)\x12\xdc\xc1\x80;\xb8\x9d\x0d\x0a\xfc2m\xad\xe3\x96\xb5\x99\x82\xf3\x0a\x18\xb9`;\x83\xc8'):\xd2C\x07\xfb\x1e\xa5\xfdR\xcc$\xdb\x09T\x7fo\x0b\x9bh\xa0@\xd4{\x12\xe6A\xee\xb5\xd9\xce\xb4\x02\x0cg\xb5\xaeIi^1\x15\x8e\x83w\xae\x0e]\xdd\xfb\x13\xcc\xcb\xaf\xbbS$\x80\x15\xacbs=s\xf4\xfaYb\xc14\x99\x81\x12\xd6zy\xfbU#\xa56\xfe\xb9u!\xb0\x97\xa2,\x06\xbb\xd2\xdd%K0\xdc\xa4\xa91eA\xe2G\xc7\x9b_?\x7f\x9c\x9d\xf2\xcb\x08\x9d\xcf-\x95\xea\xe73\xb4\xf5\xf9\x7f?[E*\xab\x96\xa2\xc9\x07\xdfL\xce\x16f\x179\xec\x9eT\xff\xd6O\xa2'\xc7\x83\xd2\xf5N\xa3\xf8\xe42\xd3\x8d\x05\xf5\xee0p!D\xea\\\x09\xc4\x15\xb7\xa6x\xa9\xc0\x8cw\"^\xdf8\x12\xe8\x0f\xb4P\xe1V\xbf\xe1\xe9\xc4\x8c\x81\xdb\xc2$OP\x9e\x97\xfa\xe8\xae\xb1\xbe\xbcNp3\xc9\xde\xd5\x19\x86:)\xb4\xe7\xfd\xaa\x8b\x94\x95\xb7\xeeS\xd1j5U\xe5\x84\x0b\xc5\xba\xcfH]\x15\xda\xea\x09I\x0e\x1d\x1d\x1d\xe3\xc6\x09z\xd8\xd3\x8f\xea\xc8tD\x18\xf5\xc0\xce\xa5\x9d\x1c&\x16\xf4\xa2\x87\x833L\xd5\xc9\x87\x09\xbd\xa7\x1f\xb1\xaa5\xead\x0e\xbe\x0bA\x96\xee\xea\xfeV\xec\xb6\x01\xee\xa1\xad[H\x8b\xbfg\x01\xcc\xe5\xe6\x7f\xb9b\xff\x96\x99\x8f\x04\xf7\x91Z\xc4\x8b\x01\xc7\xa3c\x1b\xb6\xad\xbb4<\xaf\xe0\xc0\x0c&\xab\x95Fp\xf6\xba\x15\x90eE\xf36\x9c\x11f:B\xa9q&\xb0~U\xe4\x1d\x8e\x92\x95\x9ae]M\xaa\x0a\xea\xc1*\xfb\xa0\x96\xe0\x8e\x9eXo\x8c\xf7\x8d\xfa\x0eb\x9e^\xa7\x97y\xfdjE\x83l\xd6\xceW\xfd\x8c9\x95ob\x94\xca;\xa4\xcc\xb1\xe1\xed\xdc\x0d\xc0\x8d\xd7\xafg2\x18\x9a\xeea\x15BoV&-\x00\x8e\xd8\x13RC\xe9O\x8d7:A\x92\xa1\xa0.J`9\x85U\x98\x9a|\x97\x99<\xc9\xfb\xa8\xc5\xe3&\x9fZdh\xf6l\x0e\xf5\x14\x86\xc5\x15\xf3\x87;us\x13\xcb\xf1k\xf5P\x98\x8f\xcaY\x86-Z\x04OaEQ\"Vb\xdd\xe1C:\xdc\x14\xf1S\xf7\xbbS\xdd\x86\xd3X\x10\xb8JI\x85\xae\x09q\xbc~\x9bl\xfe<N\x13;S\xbbn\x80\x87\x95\xfdnn\xa5D\xbc\xffRtK\xfc\xb0\x9d\xfa_\x89R\xca,\xba\xb5+\xa3\xf0\x17\x939\xc9U\xae\xf4\xcc\x9b\xa7s\xcb\x80\xed\xac\xd4<\xf8\x8b\x9f\xceJv\xc7\xcb\xd8\xf6I\xb5\x91\x94\xd9\xb6\x9a\x98o\xe6\xe8f\xeb[\xbaD\xcdJ\xcd\x05\xe0\x1cq\xf6\xbf\x02\x9c\xdd\xf8 Y\x06#:\xc0\x19\xd8\xa3\x13\x88i\xe2\x04^<\xa6\x0a\x19-\x81\x92<\x9cMQ\x12\xbe\xc5\x96(\x93$F\xcf\x88H\xc9\xc2\xbe\xd18]\xcc\xda\xfaBE\xa4(\x97\xd6E~_\xe5\xf9<X\xb0-\xc9\xc0\xef0\x0b\xcak\xb4\xd5\xa7\xd7 \xd4\xad\x19\xb97j\xc5\xfeKX\xa5u\x7f\xc9e*\x0a\xe8\xad\x7f\x1b\xdd`\xe6\xd8\x06;x\x89\xfe\x811\x95\xcd\xe7\x89\x1f\xf9]:\xf0\xafT*\x05\xe9\x0d?\xc0>|\x84G\x19\xfd'\xcd\x89_\x1b\xa0\xcf\xcb\xe2B\x85`b\xe9\xc1#q$R\x8b\x91\xd0\x80[\xe3\xf0\x9a\xeaAV\x91\xcd\xd1)\xab\xf0\x0a\x05\xeb\x8b\x19\xa0=\x97\x82\xe1\xe6\xa1\\\xd8\xe6\x00\x93\xdf\xb2\xf1\x12\x15l\x98z\x89\xe18\x0d\x19`\x0b!R\xa4M\x0fx\x8f\xc5g\xba=0\xa9\x98\xce\x8fp\xdc?@?\x8bO\xfe\xfc\xe0\xbf\x01w\xf8?\xce\xee\xd7\xe5s\xe6\x00\xa4v?\xd3\x11\xdf\"\x816\xb7\x0a)V\x17\xabd\xb8\xb6\x8d\x0ab\xf7\xf4\xa3|5\xa5\xc5zTB\\Ml1\xd2m6\xbcD~c\x18B\xb9\xf5p\xbb\xc6\xddz\x8f\x9ft\xdb%\xe7\xf0\xda<[\xcb\xed\xd3\x00\x09\xdb\xac\xd6T\x13x" +
+	":bC!L<\xfc\xd4b\xa5\x1c\xa0],qI\x91\xda\xbbU\xa86;ss\xc0\xf6\x934\x86gH\xb3\xcf\xbe\xf5\xbd!\xa1\xbbGf\x07\x89\xc5r\x9e;\x8a\x93\xd6\x03\xd1@V|\x1cU/~\x0f\xfdWN\xd4\x1b\x1d\x8a7j:\xa2\xde'\x15$)N;-\xe8\xce\xecm\xb5\xed\xa0\xf1\xab\x966\xb6l8\x83!\xa1\xe6\x06s\xfa\x1cq\x8c\xeba\x84\x03S\xb42\xde`&\xbe\x8eT\xa2x\x83\xe8\xe6\xb8\x89\x057\x8c\x1c\xc5\x9f\xa1\x11]\x92\x11\xb9\x9d\xf7\xe1c\xadT\xe8\xcd\x9b\xa5,kO{%/ME\x87`NgX\xf0\x07\x84\x0d\xd3\x8f\x8e\x05\xc0\xce\xe0\xf4\xb2\xab\xd5Y\x1c\x04\xea|\xdc\x1a-\xfe\xcc\xaaUh_\x9d88%\x9b^\xf5\x1d=\xa1\xdcQ\xeb\x99c\xaeq\x13\xc3\x05\xf6A\xdd6QLLd\xd1\xc82\xec\xaf\xa6q\xde\xc4l?\xb5\x8b\xc9\xa0Df\x86\xa6>|>\x9bqBY\x9a\xb4\x10}\xa7\x11SW|\xe7\xceG\xfc\x8f\x8c\x1f\xb5\x9c\xf0,lCf=\xce\xe4I\x90S\xb8t\xedDm\xfe^E\xa6wY\xbd\x8e\x88\x07\x93r'\x96\x0f\x09\xbe39\xb0u\x17?\xcd\xa5R>\x185\x17\x8cI+e\x1e&\xce\xbe@\xd3@\x1b\x16\xec\xa4V \x8c\x12\x062qVG\xa6\x86Ts\xe2\x00\xb6\xa5Hgh\xaa\x0d\x1c]K\xb9\x11\xaa1\x08\xd7\xf8I\x0a\xc3\xf4Z\x8f\x99Do\x17\xa0\x0a\xf3\x09D\x99;1\xdd7Q\xfe\x93b\x9d\xdbE%\xbc\\(\x82\xaf\xca\xe2w\xf4-oV\xb4\"\xea\xc6&\xb1\x88\xed\x12\xed\xe0\x95]\xeb\xed\xdd\x92\xcf\xfd\xc7\xb8\x95\x94}\xc4h\xd3\x1e?\xc5*Z|\xa0g\xb7@\xc25\xda+\xed?\xd6\x9f[8\xef?\x9b\xa7K\xdc\x9d`\xf39\xc2V\xe2B\x83\xd4\xdb7]\xf9\x0aa\xff\x15r\xc7\xb7\xcfSGY/\xfaxOy\xf75\xae\xf2\x12\x10d\xddafS0n\xd2\xaa\xa0\x94\x94@\xc4E\xacL\xadpe\xf9|k\x94\x9bd\xf5M R\xb1\xda\xb6\x87\x03-\xd3:\xa5<L\x9e\x95Lv\xd5\x06\xad\x101\xc4\xd9]\xd3\x9e\x0c'sJ$V\xed\xf2-\xd32;{\x05\xab^C\xbe\xd1Q\x90\xdf\x9c\xf3S\x8c1\xe2a\xd5\x92#\x88\xa9xg\xcb#\xe3e\xd9/\xa3xr\xb0\x8aF\xb4\x8aL\x91\x99!\xe4\x14\xd4u\x8d\x9e\x12?#\xe9\"\xc7\xd8\xd6\x04\x98\xd9#\x8bl\xb7Z\xc2k\x06\xd6\x8c\x84\x8f\xc0L\x96\xfa\xd66F\xca\x14\x9a\xc8\x10\xa4m,Az[9\xae\xc8\xbf\xe6s\xff\xf0\xb9?\xed\xf9gV\xda\x167f\x08\xfb\xdc\xeaj\xfd\xcaZ\x82-`:\xcf\x91\x90\xb6\xa9\xf3\x0c\xe3fO?\x92rq\xc1r\xec\"\xbb\xab\x15Ni\xa52'sL\xb1=\x19z\x9ea\xbe5\xf8T\xc0\xf8l\xd2d\xd56\x87\x84b\x93\xc5\x05YK\x865\xce\xb3\xda\x12)u\x0e\x9bC\x0bm\xc0\xf1D\x18\xa1\x82\xce\xd0c\x0a~6\x91\x00J\xdf=\xd1\xf8\x99\xa9\xfbpG\"\x97\xc0\"\x1a\xea\xed\x9bTM\xf6\x7f\xc7G\xe5\x87c\xdc{5\xd6\x94\xc3iq\xae\xf5\xe0\x9a0\xa7\\\x03\xd9\xf3\xa3\xd6\xa7\xeb\x97\xdf\xe0m\xa9\xb1\xb5\xcb\xb3\xa7\xc5$-\x8b\xb1\x1b\xdfa\xaa\x87\xa3?\xc1>|l\xbf\xadH\x86Ov\x19s\x85\xde^@\xec\xb7\xc7\xeeLwO\x9f\x16\x90y\xb4ibe\xcf\"\x91[wAo\xccUv\x11\x8e\xf0t\x82fl\xedb\xba\xe3D\x0aM\xc8\x0f\x99]\xc9V\x0e\xfe\x19_L\x0b\x871\xd4\x1db5\xfb\xf0\xa1\"\x97\xd4\xb3\xbe\xff\xe4BG:\xda\x8b\xb4\xbfxi,\x93\x8e\xe1\x823T\x1b\xc54cY\xed\xf6\xdb\xe5V\x8a\x98\xd0d\x14n\x16\xe5\xb3x\x16\xe3\x1f#i\x18\x82\\\\\xd4_\x08\x7f\xdf\xd3\x8f>\xd1\x9a\xc3\xba-v'J\xaa\xc5\xfc^\xb62(]L\x05\xb7\x8e\xc1\x9e}\x1e\xf2Ie\x886!T\xbdM]\xd7\x99c\x1c\x83\x009#\x8f,d\xe4s\x18\xb0\xb5\xfa\xb8'\xe9%\x02\x03\xcek\x03W\xc6\xe7\xb3yXP\xf6\x81E\xd1\x89\xae\xeeP\xe0\x9e\xd4\xedK\x94\xb9kg\xcf\x06\xfb\xaf0\xae\x14,\xdc=]\x88\xad\xa2\xf5\x82\x9a\xec2+lw\x99\x1d]\xfa*\xdd\x07\xa4\x07\xfe\x8b\x1b\xbe\x01/\xf6G\xee{\xe0\xbc\xe2:\xc1\xc8\x09:+\x17\xc4\xaa\xb6\x8fk\xa1Rh\xdc\x01\xa6r\"\xe4\xb6\xf0\xcb\xdd\xd3$\x84\x8bo^\xb0c\xf9}\x0a\x86\xac\xb3z\x83\xf4\x08\xebX\xbeem\x94\xa7jW!\x81\x0b\x9a\x1bMB\xf5.\x13R\x08\x98SG\\\xd6S\xa9\xad\xcb\xc6\x10\x09\x9b\xa6a\xd5\xd2\xc4[V\x13\xc7\xf9'\xce{x\xe3\xef\xa0w\xd5\x0f\xe3\x00\xae\x0d\x0b\xd3pr[\x05\xe2@s-kz\xf7T-\x1e\x19/y\xce\xf9_\xc8u\xecE\xa3]\x1eT\x9a\x00\x1fxW\x80z\xe1\x178^\x1f\x93\xd9\xbc\x05\xd8u\xf74\xb5Np\xc8\xe0sO\x1e\xed\xab\x09\xc3P\xd3>\x8e\xf2\xb1\xe6\x9d\x19\x9f\x07SltL\x12\xd0\xf4-\x0c\xea\xde3I\xc8\xcaS$\x85+L\x9c\xa6u8S\x0cO\xb3\xdcRv\x7fP\x1a\x08\xb9}\x88\x80\xa0\xb7\xf9\x09\xce@\xa4#\xc8\xe1\x1d\xb6\x05\xcc\xb3\x95j\xc7\xc2\x01\xeb\x8f\xf9v%\x04!\xbd\xbef\xd3c\xf3\x9aI\x06\xe7\xd6U\xa3;\xbbAx\xe3\xfb#\xb8\xe5I\x15\xa5\xdb\x1e\xd4\x1ajF\x84\xf3\xf62\xaf\xb4\xd9\xa2H\x0f\xed\x95\x11e\xb7\xc9\x9e\xd5\x94\xeaG\xa3\xc9&\xda\xef\xfd\x10+D\x97\x9f\xee\xae\xdc<\x0b\xe4\x83\x89p\x95\xe4\xbe8iC\x15\xba\xc3\x04U\xe3\x06G!\xec\x1aC\x06\xfb\xf0\x91\x93D\xd8z\xda\xfe\xa4\xf4\x0d\x9b\xd0\xbe\xfb\xd8\xc8\xdb\x95\x96\xd5].\xf5*#\x07\x11/2H\xc8\xb7\xd0q\xd7&\xdb\xdd\xf3\x94I\x8e\xdf\xc1\\\xcc\xcd\x142\xcdb\x92%>7\x04\x96\xdc\x16\xc0\xe3)\x81\x83\xbf$\x91M[\xce\x07\xfd\xe9j\xaf\xbe\xb8\xb6\xae\xfd\x8d\xf3F\xe3,\xdf<\xd3\xe0Z\xc1a\xd4\x0dL\x12\xack\xfcU\xbdi\xa3R\x96vri\xc7\x0b\xf3\x0e\xf5I\xbc\x8el\xf1$\xeb\xeeB\xd3\x85\xf2RddQ\xec[\xab\xae\x8b'\\m\xb7(/\xaa\x8b\xaai\xb4\xbf\xc8uZ2\xd6\xe3\xcd\xb7\xff$\xbcH\xd0\x80\xa2\x11t<\xea\x03\xec\xe2\xdf\xfa\x07\xdf\xc2\x85\xec;j\xd3\xa3\x05\xccq\xf4\x9f#\x10T[\x09\x8d\x9fv<\xc7\xe2u\x0c\x1dA\x1c\xdc\\K\x16\x86\xcb7\xf7\xfe\xb0.\x1d\x7f\xf7zf.\"\x94\xca8\xeb\xa1=\x8a\xcd\xc0\x1bI\xe7\xff)!\x83\x98\x87%\xb2\x1d\xa7n-\xa0Zy\xd3Z\xcc\xeb\xac<j\x09\xcd\x83\xa6\x82N\x11\x1a\xba\xa4\xe8?A,1Wf\x08\xbc\x95@8Vn\xf5\xb6Tg\xd4\x00g\xf9\x18\xf5#\x14\xd3Y|\xb7(\x1c\xb8\"\x09\x99:\x94\xd9\xec \x96W\xeeu\xfc\xec\x1d\x0cp\x91@\x99\xa9\xd1\xbe&=y\xb7\x8e\x9b\xffP@\xd5=\xc6\x82|\x96U\xdaI \x9a\xae\x81\x93*4\xd6\x9fa\xf0W%\x0d\x9f=\xcf\x17\xb1\x15\x90\x7f\xfa\xcc\x96,\xc2\xd6\xeea\x91\xac\x16_d\xc6\xf4&B\x80\x10\xbc#F5\xday\x9d\x1f\xd1\xd6\x98\xb1p\x0eu\xff\x09zI\xb1s\xc5\x10\x86\xc9{ge\xd3u\x8b\xb7\x81R\x9byz\xa7\xba\x94R%\xa8\x9b\xa3\xe8\xf8\xb2\xb7\xa1\xcfIp\x98\xed\xf2z\x16\xf5\x91\x8eu\xe7\xb2\xf75\xd2\xfe\xdd\xe7\xe93\x15`\x0bB\xa1\x81\xc6%\xc5\xff\xa3ki\x12}\x82\x81\"\xa4.\xe4\xc3*$\xb60\xd5v|\x83=\xff\xb4w\xe9\x1f\xa0u\x87\xc7I\xfc=[\xdc2J\x16J\x9ae5\xc3W\xf3f\x9c\xc5[\xb9a\x82E\xc8Poa@4\x86\xebP\"\xe2Q\x88\xd4ePY~\x88\xe8S\x9a\xb4\x1f\xdb\xc4\x89\xbfY\xa8VQ\x0b0N\xd9\xe3\xe7h\xffe\xc9\xbf6%@\xc2\x14zVa\x12\xfa\xf7\x0b\xfc\x0aSc\x1a&\xadu#\xdaCa{\xe0\x1d~\xff\xf1\xfa\x16\x1f\x85\x8b\xbe\x8b\xac.X\xd95\xc2\xb0\x97_b\x89\x9e\xdaJ\x9b\xd4v\xda\x96&y\x98\xc6\x04\x97\x8fK\x89\xa0\xceb\xad\xd5\x0c\xf7\"\xc1\xcf\xde\x856\x1c\xdd\xb0\x9d\x97\xf6\xf0\xa0\x1c\xa6\x84\xf8D\x81{O\xaa%C\xea}\xc3\x12\xfb\xd5\xc1\xf7\xfel(\xf3\x19\xadg\xf8\xe7\xe90~\x03\x12\xdf\xba\xd4C\x08\x0aB\xbf\xd7\xdf\x94^tn\x9f\xe1\xf9\x0c\x94\x0f\x0b\x89?g|\xb2W\xbb\xe7Mb\xe0dPInK\xf5,B\xee\x9d\xfao\x98\x04\xaf\xd1\xd8\x944\x87\xfd\x05\xa7\x91\x01\xf3HL\x1a\xd7\xd2\xa6Fz\\\x88\x14\x05\x8e\"\xa1\xc2\xa9\x12IY4t\xe68\xc6\x89\xdf\xe3\x80\xc9\x94B\x98\xfaTA0J\x9b K\xf7\"\x15\x01\xe6)\xae\xb6\x95\x91\x0d\xf2]12c\xb0mG7\xb9\xfe\x08c\xb5A_U\x86q\xc2E^\x98\xdc\xda\xc9\xc7\xb4\\\xedIU\xb4@?&\xf5\xd4\x8a\x1d\xd2\x00*\x09\xd8E\x89\xcc\xa4X\xb09C\xeb~\xc5g\xc5\xbe\xd9\x04\x9d\x1f\xf6\xcf\xe9R\x9a\xaa9\xc3d\xa2\\f\xc3Y\x91r~z#}\x16\xeb\xffnL\x88n\xab\xd3\x987\x9c\x8d\xb5k\xb7\xe1\xad\\\xc7eh^\xbd\xb7{1\x9aM\xb5\xaba\xf6\xd8U\xb9[x;+\xc7\xdd\xf3yr_\xc8N\x8a1\xe5\xf0lM\x17\x9eb\x89\xd0\xf1\x87(\"\xc7\xdb\xc5\x06\xd3\x94\xdbd\x91\xff^d\x163(\x81&5\xf5\xf2\xd1\x0b\"\xb2\x93\xa6\x1e\x9d\x92\x8a\x1a\xab}\x09I421a@\x99\xa0}Q\xd0\xc2\x96K\xc6D\xbe\xbeB\x8d\xa9\xb9D\xea'\xa3m\x9f\x85\x88s\x0e\xf7\x95\xd6\x7f\xb6n\xa6~'C\x93\xf1G>\xf5\x83\xc2\xc3\x0d`\x97+\x9cj\x11\x9a\x86\x06\x84\xc5\xbf\x983b\x1eq\x8dT9\xdd\xdf\xafV\xfc\xdd=O\xc1\x0c\x14\xaav\x83\xeb\xe6\x09\xd1\x09\xfc\xaf\xa1\x9f\x0fu\x1b\x1a\xff\xb4\xab\x1a\x1f\x9e)4@#\x92\x8cp\xd6\xda_\x133\xc5\xe4S\x9e5\xc5\x7f\x15K\x83b\xd7\xcc\x99\x08\x08\xe8\xbb\xf2BI\xd8:M\xfc%\x05\xa4\x9bL\xdfC\xa0\xae\xe8\x17$j\xfb\xe5\x08@\x17v!\xb6T\xa8\x07-\x13v0}\xba^\x0d\xd5\x8d\xe1\xb1\x08\xbbN\x0f\x07\x95\x189:\xa6\xdd\x90\xc5\x8e\xcb\xe5\xab\xd1\x8d\x8e\x84\"\x8a\xd8\xa2\xa8\xbasv-?\xbc\x94\x8a\xb2\xec\xa4V\xb1h)\xad+\x0f\x13<\x1e\"k+\xdb\x026\x8c\x8dQ\xec\x88\xf0\xbb:\xb0^\xa6*/\xb5\xc9\xab\x15\x9f72\xbc\x89zJ\x94$J\x9dq\x12$5x\xb7\xc9\x1d`\x1f>\x0ai\x93\xd5 \xec\xf3W\xb3\xd54f2q7\x10\xc0\xb7\x01?X\xaa\x0d\x1dXU\x0b~\xb2\xf9\xfc\x024\xa0(_\xb0Z#\xd0\xe1Ts\x85\x93\xd7\x80\xeew\xcf\xe7q\x13\xb5j\x92\x9a\xd6t\x0a\x90\x9d\x80\xebL\x86\xa6\x10I\x9c\xc9u~\x10\xda\xbb>\xfe\xfd\x00,\x9c=\xcb\xa0\xd6R\x82v\xce\x90\x8c5%\x86\x08\x98\xce\xc6\\\x03\x13\x08\x02\xb1\xf3\xc6n\x12FMJ\x01\x8a@\x8e\x1d\x9d\xa6\xe5\xa3saY\x9b?pQ\xfd'\x0c?[9pz{4\xcfmvNw\xfe\xe2\xd1\xd0\x9f|\x7f\xad\xa4\xea\xee\xf9\x9bYG\x8c\x18\xa9\xa5\xd1o_\xa0\xa9\xf5+\x1cK\xc9\x8a/\xb3\xa74\x038\x10`\xfbS\xd6Ub\x01\x10M\x1e\x19\xcax\xd7\x96\xee\xba\xb0\xba=\xa9Bsd+\x1b\x93\x8a\xa2\x03b*\xe3\x8b\x84\xa9*+&q\xe9\xec,\x8d\xc9\x95\x8cI]\xef\xf6\x1a\xfc-\x16\x04\xe5\xfbv\x10o\xf9\x15\xa6=~\xd2(\xba\xea\x17>/\xb3{Y\xc3\x82\xf6\xa58t\x9a\x9aK#\xe3\x02\x0e\xbdW\xddxZ_a\x82\xd6\xd2=\xff\xf0\xa8\x10_\x14G)6\xd0O0\xf9\xa9\x81\xb3\xb0;}\x8a\x09U\xed/\x1f\x83\x8b\xc2\xd6\x19\x1d\xa4HP`)\xf9\xe6\x14\x1b\xe12\xb8\x0a3\x00\xd8w+\xda\xc0w/\x12m\xf9\x8d\x96\x1e\x9c\xc1\xd9\xa1\xbe\xdd\xc9\xb6,k\xedC\xac[\x8a\x89\xa6\x8d\xba*f6\xee\"1&n\x1d\x8d\xc9\xd5F\x12\x8b\x11\xc2\xad+TK\xd9\xb1\xb6\xbf\xc9\xc1\x14\xfda\xf5M\xba\xd4\x11\xa6\xea\xb4=\xfd\xd0\xc2\x07E\xf1$\xe5(\x9f>\x1f\x8c0\x91\xc6\x13R^\x8a\xe5\xe6K\xc0\x11f\x11\x15\xc5\x83\xc4z\xde\x98\xc2\xf3_\xc0\x0f0\xc5\x0au,\x9bm=\xef\x9d\x93;!7\xa8?\x13\xa8\xcb\x90/\x98\xc2 \xa9\xee\xc0C,\x9ea\xac\xe9\xdc\x0d}\x83\xd8\x1a]\x96\xe2\xf2\xbd\xa2\x0f\xae\x85\xd4\x15\x85\xa6\xcd\xb3Ps\x8a\xbb\x8f/&\xabZL\xdeQ\x07blVf\xc0\x7f\x0b\xbd\xa2\x1e(}\x1f\x9em\xd3\x91\x1ar\xbfU|\xed1\xea\xf2\xb95\xce\x02\x02iSI\xcdu\xd4\xc4\xd5\xaeL\x92s\x92va\xaf\x07\xbd4\x9a\xa7\x9b\xce\xf7p\x8f\xe0\xef\xfe\xc4\x9d\xb2\xad\xb8*2kO1>\xa8Isb\x1c\xd5\xb7\xaa6-\xf4\xa7\xb0\x94m\xad\x89d\xb8\x8cm\xcaD\x82t\xa1\xa9HUS\xb2Hh\x89\x13\xde^.\xd40\xc1\x1a58\x19\x9f,\x80\x8d\xdf\xf3\xe1\xb2\xb6\xd3\xe3\xcd\x84\xb7>D>\x16\xeb\xbd\xb0\xd0\x8e\\\x12\xe5\x17\xd1[\xc0\x7fr\xd2\xf6\xe0\x1b7\x99}\x11\x8da'\xae\x84&?0j.&\x03\xcb\xaa\x86\xadJT\xfe\x13\xf3Yn\xa7\x8f\xcd\xbd\xa5\x83\xb6\xab\xb5e\xc8?\xe1\xb7%&\x06z\x1aea\xe8\x1f\xec\x17lpT-\x8e\xf8\xa3\xc4\x07/>\x8c\xc1\xc9\x9f\xab\x8b\xebO\xa8\x01\x9f\x18\xc9x\xd0\x08\xa4@\x84q\xe1\x9d\xad \x8e\x19v!\xd8\xa5\x86'RG\x0c#\xf9C\xde+\x0a\x137\xe3:\xc4\xcf\xa0\x83}\xf8\xb8\xb1$\xee.\xca\xd3,\x08\xabE\x0f)\xf1\xd9\x89\x86\xabs\xad\x0bK\x89\xee1\xdb(\x9e\x9eH\xe7\xe1\xae\xb5A\x1c\xea\x08\x9d\xdf\x9a8*|\x81jpc\x18\xcb\xfb\x0ft*}\x19*)\x1c\xa6\x01d\x0a\xf0\xbc\xc2\xc1\x14\xb5\xe5B\xe5m}\xa2\x99\xc5\x90\x81\x1c\x1f\xf7\x9e\x1a2\x85\xdf\x1f\x95?\xa8\x8dj6\xcb\x88\x19&\x16\xbd\xda\xa0/\x17\x96\xfd\xc9\xb7$1DoG#'<e\xeb\xcf{\\\x91\xe3\xbao\xcbs\x0c\xf9\x96\x00\"\x19\xed\x8en\xb8Ts\xa6'q\x96g#V;\xf5\xe9\xd6@,\xa8\xc9bcYq\x8c\xd0\x8c\xcc\x9b8p\x83\x82\xef:\xdb\xd4\xa2\x10\xa5\xe4\xd2\xf2\x0bURGz\xdcX\xbe\x13\x96\xa4~r\xd8\x19F\xa53S\xf0\xef\x14\xae\xfaX\xa8_\x14\xa2\xb1w\x80\xd6\x91u\xfe\xf9:\xbb\x06W\xf8\xc5\x02\xd6\x059a\xb2\x03RzZ\x0c\x96\xe3\xd8Tb\x11\x0e\xb0\xe1\x0b\x9a\xb2Rx\x80i\xf3\x81j\x1dL\xc0j\x96Z\x9cG\xe9\xe4Z\xb4\xaf\xe9^\x16A(\xca\xdf\x9f]\xbb#\x0d\xd5\xb0\xbf\x9f\xc9\xc5\xa6\xd0\xcd\xf2\xc5\x95/5^)\xec\x96\x84\xde\x94\xeb\x94jx\xd3\xd8\xe3\xa3\xdcf\xce\x9c\xa3\x8f\x9cV\xcb-\xf7\xf9\xeb\xdf)\x98\x1b\xbf\xe6\xe4a\xddy'\xcaG\xbbjt\xb0\x1f\x9d8\xb4\x9c\xb6\x15?\xf4\xa3mb\x12\xa5W\xd5<k\xa8g\xee\xe6\xedn\xc3\x9b4G\xc8\xd9\x81\x9dn,\xe8\xba\xa0\xc7\xce\xa6\x96\xecU\xbf\x9c\xfd\xa0f\x0e\xb5\xa4h\xa6\xef\xf5\xb7jSw0\xaa\xa2\x05\x1b\xcf\xdd\xc1\xc7\xfc\x85R\xf1a\xca\x1f\x06P\x1a\xb6\x85X+\xa3\xc8\xcd\xb5\x10Qb\x10\xdeqU7\xe58\x89pr\xa6&M\xc2th\xd2\xf2\xc84Q\xcco\x13\x12\x1f\xdd\xb2\x8d\xb5\x10|f\xe8\xb7\xb7\x84gP\xbeh\xa5;\xde\xa6\x99\x87\xcc\x87G\x1f\x855P[\x97\x14\x80D\x99C\xdbH\x09T\x1bg\xdbn9P)\xe5\xab8cMr7\xd5\xd5c\x8e\xb6<\xf1\x13{\x09\x82'\xefl\x14Q3\xb3\xdc\x82_Y\xa5x\xf7\xf2\xe9\xa2\x8d,\x835)\x9a\xcd21\xc8\xfc\xc7Y*-^3V\xee\xd1E<\x06E\x1b\xcf\xd5\xc1\x0f\x84o\xc8\xf5\xe5\xad<_\xccz\xc9di\xfc?%d\x82\x11 I +\xf6\xd0\xc4\xcf#\xf2\xcc\xf0\"\x17\x9f\xc1\xc2\xf2\x8d[\"\xb5a\x91\xc4]r3@w\xf0\x13Y\xdcm\xe2\x05m\xd0\x01\xdaV#\xe5\x8a\xba\xba\x87\xc1qY[\x15\x1a\x03\xd7i<\xa8\x14\xd6\xc1\x19\xe6\x0e~Vt\x88\xcb\x84\xebm\"\x8cx\xb6\x1a\xcfL[T\x87\xd6]\xdc\xb0\xa7\x1f\xb93\xdd$\xe6\xd5\xdc\xbb\xe2\x89F" +
+	"I\xc0=~*\xb1?\xef\"\xe2\xab\xe5'\xc3HS\x99\xc8c\xd9\xcf\xd3\"\xfd\xf2\x8c\xbc\x02\xa6\x8b\x0aE\x92:=~.:D4\xf6\xa3\x8b\xa9\x88R\xfe\x97<\x9c\x01q\xf0\x03\xf2\xb4\x12\xb3<\x96.\xcbR\x82\xa6\xfbOu\xff\x00*`F\xa5\xd0\x17\xfc\x90/\xae\xce\xa1g7\x8b\xe8J\x1f\x98\x95v\xb6\xe8\xf5\x98w%\xa4\x8flo\x980\x89\xc9D?4\x90B\xf8-\x8e\xa0>\xbb\x9e\xbe&\xd0\x86\x0a51\xfb\xd0\xefP\x8fM\x84\xdfc\xfd\xfdJ\xdb<_\xa3IS~%\x15\xd55X\xa1\xe8B\xc9Z \x13\x95m\xae\x0cP`\x04d\xe4\x04>\x9f{\x17s\x9a\x9a\xd4\x0bW\xb0\xa6\x8f\x0c\x06ZlI\x140\x1d +o\x99H\xda\x1cI\xbac\x10\x1fRY\xed\xea\xa3@\xee\x8f\x0e\xd7\x0fV\xcf\xc4\x15v[\\\x98X`\x11{\x12]\x11Vy\xa8\x87L\xb5\xd5(?\x91\xa72\xeb;\xd6\x190\xb8\xa7U\x1b\xed\xe5\xcbY\xe5\x89x\x8e\x93\xbf\x0f^3\xba\xd3\x02N5\xa4\xda\x12U\xa9%9W\xa5\xae\xf0\xcd\xf1\x15v\xc8\xf3\xf4\xe1\xe9s\x00\xd5\x9d\xa8\x80\x9eKAK\xf8\x0b\xbb\x8aP\x81\x1cO\xff\xa6f\xf0\x08\xf3\x00\x18\xe1\xc4\x14.\x11'\x154Q8\x1euuy+*\x9dF\xabP.\xd0=\xeb\x8f\x841\xdcY\x16\x86o\xab\x1f&C}\xcd\x01MlcY\xcc\xe8'\xd9\x1fF\xd9\x84\x0fEHh\x04\xcb\xe4\xafm\x97B&\x9f\xe6Q4\x07\xda\xe4\xe7>1\xf4\x0f\x7f\xe3%\x01\xa3}V\xb0<\xae\xd9j\xcc(\xedD\xf8\x03\x0f\xd42\x99\x04\x9e\\\"\x92\xb8\xba\x0e\xbfJ}xb\x81\x99e\x86\x0fp\xef\x1a\xb3\x1c\xe2\xb6+2\xa6\xe4A\xbc+ \xf0\xc5\x10\xda\xe8\xba\xb4Mc;\x8b\"\xf7?\xbbf\x84\x10$\xc1\xd1\xf1\xc1\xe7\xc5\xf1\xa6\xb5\xdf7y7\xb1\xbe\xce\x07\xbf\xcc\x03\x82\x93\x04/\x18N\x97\xf76g.,\x08\xb1$[\xd6c\xdfS\x9a\xf37h\xb8{\x16Iff\xd0\xc5\x0f\x11\xa7\xb2\xdcC\xea\xf7m\xfe\xb6\"\x9f\xeb\x1a?U\xff\x0a\xa3ow\xd5\x8fp`\xf8\x82\xef\x04\xb8\xbf\xe9\x99\xf7#\xce\xd0\x0b\xb3&\xe0\x1a\x19\xb1\xb6\xb4\x92\xe2\x9b\xfc\xd0\xdf;\x96Nc\xcd\xee\x90\xd9\xd7|\x9c\xdc\xfc\xc1\x0f\xfe\x9cH\x90\xf2\x16E\x07\xc07\x83\xff\x95\xad\xc5\x16\x06\x93\x10\xe7\xdcy~\x82\xc7\x04xB<\xd5\xe6\x8a\xf7\xdc\xa11\xd3\x1a\x8ff-\xc8\xc5\xa3\xfd\xe0\x1bM\x99x]\x8a\x15dt\x80~\x8f\x9f<\x09\xdb\x1a5\xfc\xe4\x97\xd8\x1e\xc6;\xe3\x11u\xa5\xeb1\x90\xd0\xd5\x9do\x9d\x12nwu\x8fF\xa1u\x9e(\xa6\xcfU[\xc2c\x07\x91\xac3c\xa4\xc3-Q\xb5\x12w\xd7XB\x94\x0e~e\xd0\x99\xa2\x80\x89\xd9\xd7\xacM.\x11\xcf9\xfc9\xd1>H%?\x09\xa2,i\xad\xf7\xad]\x0e9\xeb\xda \x9b\xef\\\xab\xee\xdd\x8csN9\x8e\xcf\x18\x91 Cp+\xc1\x88\xef\x1d\xec\xe4\xda\xfd\x09\x8d9\xa3\xfdFu\xa7\x91\x15+\xf4P\xa4\x8e\xcb\xe1\x988\xa9\x02:\xb2<\xb1\xb2\xc9\x1d\xcf\xe3?4\xd0\xba\xb0\xa6\xc8OT\xfdj\xdc\x04\x87=}\xfe(\xfa\x09\xd8\xeb\xec\x01\xee\xca\\\xfe\xb2\xe9\\]4\xde.(U+\xee\x92\xa4\xc5\xd8\"\xa2\xe8\xabW\xd2\xf3(\xb0@\xb7\x8b<\x98*\xca\x1dY\x8e\x8eq+\x952U\x19z\x9d\x89X\xb4\xc0#\x16\xcb:V\xcc\xdfW\x89{\xcd\x97\xa0\xe8.\xcdh\xb2\x0c\x8f>\xf4]\x0bc5\xfaFp6V\x1c\x90\x99\x999;(Y\xcd\x95j\x1c1\x9a\xc4&\x0b\xbbt?A\x05\xaa\x18n\xa0\xbc?\xf9\xc6\xd3\xd6\x15\x9a\xce\x8ao\x97\xcbw\xc2\xb0\x0b\xe9n\x15\x85\xd4H\xb4\x07z\x8d\x0e\xe8^9y\xa2FS\xf1=Ml\x85\xebe:\xd7\xfb.}u\x03\x1c\xe9\xc13`\xe9\x8bQ\x1d7V\xda\x1a\xc5e\x07}/\x15\x89\xed\x17x\xb2\x18e\xff\xc8kD\\\x8f\xf7\xbc(\xcf\x83\x82x\x89(\x0b\xbc \x08l\x029\xbd\x03\x86\xbcrb\x8d\x9en^\x86\xa4\xc7J\x84\x9b\x959W\xc6Z9\xf1\x1f\x13\xfd*\xe4\x9c\xb5\xcc\xc6\xc2\x02\x94\xe8\xcdAG\xf3\xd7\"\xe4Q\xf9b\xf3\x1d\x849Q}\x84\x7f?\xba\x91ct\xd2h\x84\x1a8\xc3\xe8Z\\\xed\xb6\x12\x9d\x87\x1e\xe8t^\x99\xd8\xc8\xb1\xd0\xd6f\xb6>\x7fW\x1f\xb4\x9a;\xb2\xb1!\xc9h\xa3\xe1`\x94c\x0fG\\\\?\xf9\xea\x84T\x898e4J\x13\"$\xa7\xc67;\xcd>b\xcfI\\\xad\x1e\x89\xa7d\xaf\xbf\xc5>\xfc\x10\x1dq\x1a^\xc4\xfd\xb3\xb9\x00!o~\x972\xe3\xdd\xabT\xecaA\x9b=\xa7T\x97\xf2\x16\xe1\xc8\x8f\xa8-4\xaa\xa2j\x17\xff\xd5\x00u\x16\x06A\x91X(9B\x04?\x0c\x11\x8fE{\xe1\x83\xc2u0\xd6\xab,)O \xe0\xb8\xb5&p,\xd5\x02\xa1\x11(\xd6C[#\xd6\xd5q\x89)\x82\xfeR'\xb1\xab[\x0c\x9f\x0d\x15L~\x10\xac\xc89<\xfc\xcfp\xa0\\\xb12q\x16\x00\xdb\xae\x81~\xef\x1a\xbb7\x8b\xd1X7n\x82ak\xf2+p\xf1\xfdi\x8f\x9f\x15\x8a1\x0f\x9do\x00\xfa\x0a\xdc\xb0\x13\xcf\"\xdc{o\xd4\xfcp\xc1\xe6g\x89w\xb3a\xf7\xf9c\x95u\x17\x83\xcfW\xa02\xca\xc8\xdb\xafd\xe1^\xbd(/\x1e\xd2\xaefW\x9d\x0d\xc6<!\x9e\x91h\x13\x05\x9a3\x90\xb1\xa4\x9c`\xeeo\x93\x00\xbb\x80\x8bR\x0e\x96\x08/\x02\x0a\x99\x0f0~+\xd5\xb5ea\x80\xf7\xaa\xe6\x13m3\x0es\x05\xb6\x9a\xfa\x02#\xd7\xad\xf7\xa7\x0a\xcf\xdcf*\xb7;\xabF\x17_\x1a\x9d\xa7\x95&M\xb1\xb7\xf3\xb2_\x96375-\"\x02U\x98\x9e9\xb5t\x11\xa5\x1ac11\xee\x80\x815\xb3;f\x93{a\xc5I\x84?\xb0\x95w\x85\xc1\xc5\xbb\x95,u\xd7\xc6\xd8\xab\xb5\x06\xc1*\xc2&\x98\x06\x94\xdd\x8d\xfc!\xa6\x81R\x97\xcbx[\xe8~|\xa0\xc0\x8b]\xde\x8c\x95\xf3/\\\x83+y\x06\xb8\xa5\xf4\xf6\xd5\xeb+\x9b*\x0f\x1113'8{\xb4\xc7)Y\x94\xf8\x14\xc88+U\x9bnp]\xbe\x0f\xdb\xbe\xf8\x1b\x9c\xfd\xd1m\x05e\x8a+\x07i\x7f\xce\xbb\xccF\x89\x17\xd6m\x09\xa7\xf8\xc17{\xc9\xc0d\xd6\x99\xbc\x0a\xde\x04\x15\xaei\x18\x90\xbf0\xab73\x04\xba\xbf'v\xfaj\x8e\xce\x0d\xb6\xf1\xde\xba\xbcI\xa8&2,3\xaaU\x12\x11\xef\xe2\xd2\x14\x9e\xaf8\xbaG\xd2\xfd\x80~~\xac\xdd$y\x995\xb6g\xb2\xcar9\x01\xe9\xb9l\xe7\x86\"bl\xb1\xf2wk\xfctjw\x96\x17\x8b\xc1|\x8a*\x89\x87\xa5\xf2\xf9+-\x96\x96\x9e\xd3N\xc1\xd9H\x0c>\xf3\xb5Ou\x1f&\xaePj\xe1B\xb0\x95\xc5\xe2\x0b\x0cnt\x1c \xb1\x80N\xb4\x98\x88\xf0\xf0P\xf7G\xe0\x96\xc7\x03X\xe4\x1b\x8b\x97\x0b4\xc6\x91\xd0\x8f\xd3\xea8\x1c\x12\x0b\x0f\x8d\xa9{\xa7\x8f\x9d\x8d\xd5\xf9}\xc7\xdap\x1cPv9\x1aq|\x96N\xaa\x18TG\xfa\xad'\xd5w\xbe\x81\x9e(\xef\x06L\x0f*N\x93\xd5\xcd8\x10o\xa3&\xe2\xd4\xa7\xe9\xdeX\xdcc\xa8\x8eR\x8a\xa3\x94\xe1\x08\xfb1B|3\x86\xaf\x8eaA\x89G1\x84\x09:i\xf4*\xf0$s\xb3aC\x9e$<\x99\xb0\xae\xc7Y\x92\x04\xf5$,X\x92_3\xe9\xffar\xcb\x14m\xaf\x9f\xae\xc242\x04\x97L\x8b\xf7m\xf5\xc1M\xa1\xb6'\"\xa3\x98\x97@\xf4\x97\xc63\xb4a\xd4m\xec`Q\x83\x9a\"\xe7\x85\x1aAy\xec\x07\x07\xfb\xf0\xd1@\xcfg\x95\xe3\x88\xcc\x13\xcd\xc8\xd35?\xfd\xf55\xdf\xe9\x83Q\xf0l`b\x0cdS'.\xe8X\x87\xfb\xed\xc3G\x04I\x92\xd7\xaaNk\xdc<\x1f\xa0q\"1\x19\x8f\x86\x06\x04\x96\x98\xe6\xfd\x9eT\x1b]\x8c\x8a&\xbc\xban?)F,A\x86\x95\\\xd7\x1c]'\xfc!\xd2\x00\xb2\xc3o\xa0\xc3\xb0eh\x93\xf5\x11\x9b\xc7z\xca\xe0\x84[\xabl_?\xbby\xfb\xb5<IJ\xde\x1a\xf1\xe5\xef\x8f\xd0\xd1,\x1f}{H\x0a\xaf#\xa7\x1e\xad\x05M=B3:;\xbf\xef]\\\x0a6\x91O8\xc7\xe7\xc9j\xf3\x9e\x99\xd7~I\xca\xc4\x97\x0c\xd4\xefP\"a\xc3\x99\x96\x88\x12\xd9\xeeR\x88\x07>\x95\xd6\xfbc\xff\xb5\xd0x\x8d5[@\xd7VT\x98\x13\xcb!%,\xc8\xd8\x87\xe6$\xf4\x9b\x03\xf4\x0e[n\xbb\x88\xbei\xc3u\x8c(\x14\xd6\xd17u=j\x0d\x11W\x7f,\xd3\x82D\x0d@\xb4\xfcE}\xf3\xc6'\xb5O4\xf9\x84f\x81\x90\x8ac\xddx\x83\x80\xaf\xc6\xfa\x1e\xfd+\xa2\x05b\x1aR<\xcb\xf2x\xe9\xc0\x89\x83\x89r\x0f\xb4om\xb3\xcd6]n\xb9+\xe7\x1e\xce\x8d\xa9AL\xeb\xf1#^c%J\x15\xf7\x8e\x05\x07\x11\x01\xa7)\x96\x0e\xe1\xd2\xbf\xd7\x0ey\x9dd\x88\x0f\xf0)\xf3\xe4\xa3a\xef\xa6\x84\xd7kq\x01\x0a\xfd\x99\x01\xfe6\x17\xc3\x8a/\xf1\x007:\xddf\xb6\x062@\xa2\xe3\xca\xdf\xcfu\xacRt\x93\xbd\xbe$\xe2\xab\xa3\xa3\x1c\x05\xc95\x85\xcd?\xac5lx\xb3$\xcf\xe9\xf3Q\xab\xe1\x85\xccg]\xad\x07\xfbp\xa5\xf12&\x91\x12\x7f\xeb\xae\xb8\x09\xf6\xa7\x13E&\x8e\x98`\x98\xb6\x06\x8f\x95\xbd\"\xe3E\xc3\x83~Bfi\x1f-.2\xaf8K\xe3\x07\x8f^\xe5\x19\xbe\x00\x09F\xf5\x0f\x89\xcd\xb7SQ \xdf]\xa0\x03\x82!\x8cu\x00\xaf\xbb*XV\xc1\xc2L\x18\xf7\xcf\xe1\x9d\xf0\xd9\x9co\xb1h+\xfc\xa3uG\xd2X<B\x9f\x9c \x15?t\x069w|^<I\xec\xba\xef\xcdR\xc7(Oy\xae\xc7\x09\xaf\xe3\xacU2Y\xac\xb9H7\xd5\xaaq#\x9c\xf7\xf8I\x1b\xb4\x995\xb8\x054k\\\xabQz\xef}\x87f\xc3\xe0\x9b\xdf\xf1\xc06\x19\x83\xa3[-\x0f\xb4\xad\x12\x92~\xf2\xc9\xaf\xa0#\xa3\xfe\xddw\xe8Z'\x14\x1bi\xde\x04\xa7\x07\x17\xae\xe1`8\x9a\xaa\xb5YV\xe7\x1d\xd7\xa6\xce.\xaa\x90\x05{\xa9\x18\x05\x7f4\xdb\xf2\xeb\xb7\xeb\xcc\x12\x02\xb9bTJX:\xfaz\x087\xfd\x178C\xdf\x87\xcd\xcd\xb0\x11\x90\xee\x8f\xebm1\xc4n^Ry\x86)\x12\x90Kd5 \x15}\xcf\xe1\x88X+\x01g\\\xc8bO\x922\xa1\xb5\x05\xe3\x851s\xb2\xa1\x0bo\xaf\\\x99\x90\xdf\x9b\"\xa2\x9do\xb2+\xdfe\xaa[\xd7\x9fn\xbe\x91\xb2;\xe7\xef\xa0)\x0eg*\x1e\xc2v7\xb1}\xd8C\x83\xb1\x10\x134\"\xc7\x85\xb6\xb8\x16N\xce^\xa3lb@\x0b+\x03 \x0a\x05\xfe\x99\x04\xe5\\\xeb\x1a\x891\x8e\x1d\xc0\xde\xb7\xd5\xd9\x8f=\xc4,i'\xe45\xcc]\xf4\xa3\x81\xb4\xd6\xfd\x03\x1a\xb5\xe9\xb9\x0a>\x9b\x94\x16ha\xb8\xf6\x0f\xa4\xd3!\xfc\xe9\xbc\x91\xc6\xea8\xba\xfe6*\\\x13]\xcd\x8f\x18\xcb\xcf\\ZF_s\xbcr\x1e\x12{$6\xfd\xcd]9\x97:{\x0d\x8ay\xb8\x0b/BGX\xcb\xa8\xc6\x04\xean)\x83\x1a\x81\xe1_\x91E}\xf3\xec+\xcb\x05\xa4v\xc5*\x0d `7\x8b\xe4P\xc57\xf8a\x8f%KC\xb5a4o\xe8>4\xde\x10\x8b  +\x18 \xad}\xe6\xd6\xbf\xc2[\xf2&I}\xbc7\xccsL\x9b\x9aG\xfb\x13;\x97\xa9\xe4\x10G\x14\xba\x02\x9d\xba\x95\x9b\xc5\x0a1\x96k\x92\xe9\x07-\xb8m\x020\x0f{\xe9\xd0\x808\xa3S\x1d\x139\xd5\xe6\xa3?C\xd7\xc1\xd1\x90\x99#2\xb6qSurmHJ8s\x189\x1f\xb9\xca\xa3\xe5\x9f\xbe\xe8\xf0\xa1`7S\xc5\x9b\xbfK\x89\xd4\xca\xdb\xbd,P\xecg\xc5\x0d]\xa4\xe5^\xc4b\xe0/Y\xe1\x16\xefS:\xf8\x8d\xfc[04\xfbS\xe3z\xad\x11\xc3\x0c\x14\xc1\xbfe\xa0\xc4\x1a\x07\x02/b\x97\xb2\x11\xdcA\x8f\x8f\xda\x9a\xb0MB\x05\x12\xb5\xa8\x08\x01\x83Z\xf4\xcb\xcd\xf0jq\xf7\x16\xcd{\xdale\xe5\x83\x13\xe6\x92'\x86\x9f\xccc\xc8\xe9\xcc\xa6\xe8\x93!\x92\"\xff:\xa6\xbe>\xa3\xd2\xe0>\xf8\xcd\xfd\x89W8\xadAZ\xe9\xbe\xd7\x19\x83OG\x12\xe4I=\x99\x18\xfb\xe4\xbb\xaa\xb3\xecZl\xc1)\xacuG\xa3%V\xcd\xd1=\xc9\xfc)\xf9\x11ti\x8b\x02V\xca\x17\x81\x8e\x0aZ\xc7\xc8\xb8\xd2\x00\x11\xde\x17\xf5\xae(\x9d\x18\xf52\xc8@\x16\x08\xcf\x93\xea\x83\xb9YG%5a\xf7>\xfb\xc1Wg\x8eC\xb3\xc2csn}\xb3\x95\x91h\x07m\xd43\x8fW3\xb1\xc9\x9c@=\x92\x10J(HHZ2\x88\xc2\x81\xf9\x9e\xe2\xa96\x985\xa3\xfe\x8d\xc8\x0d\xe2\x88z|\xd3\x1fLX\xc2\xb4\xbf\xc0\xf2x\xd2\x15\xd8\xa2\x18\xad\x95\x970]\x8b$\xbdYA\x81\xbdW\x1a{\xa5\xcf\x93}\xa2\xa9;\xe8\x7f\x05\\\xa5\xb0s0\x84\xe9\xcd\xf2\x19+\x9e>\xb7\xd0\x90/\x1a\xbas\x82>\x0d\x08\x1f]Dv\xe2\xeb\xd1\xec\x8a*:\x90Bb\xf0\xa4\xd39\xca\x95\xb5\xca5!\x85\xf0\x02e\x95 \xf9\x9e~D\x12\x8ctp([X|\xc4'U|\xf9t\xb9\x9e/\xd3R\xc7\xcfd\xfc\xb4\xa2I\x05\xc9\"\x9b\xde-\xe5\xccQ\xef\x8f\x990\x04\xee\xa6\x8bpd\xa4\x08\x1d \xb9\x1c6\xc9\x12\x0c\xd2\xf7\x0e\x923U^w'!1\xd5\x8d!m%\x8bgL\x0a\xc0\x8f\xeeS\x88\xbb\xf3\xc9z\x1d\x05_@\x7fD\x7f\x9a~\x8a\xc1\x83\xad\xc5m\x85\xc5\x97t)\xbd)}\x1f\x12D\x9c\xbe\xc2uc\x1b\x80&\x1c\xc5\xb0r\xd5|R8t\xdaUM}\xf4\x84\x90\x1c=tnO\xcf\xb4U\x8b6\x01\xee\xedb\xf1|Y\x01m\xcehe\xd0\xec\xa1\xeb\x89\xa9\xaa\x87\xc6\xe4\xce\xccX\xe4H\xdb\xef\xae\xbd\x8e:\x80R\xf5\x1fw\x9c:V\xfa\xeb\xcb\x8b!a\x9e\x8c)0TN\x80\x8f4\x157\x14\x05\xa4aJ\x97\x8f\xc6\xb4^G\xfa\x1dz\x7f\xaa\x07\xf4Z,\xff\xa4^7J\x7f`\x96\xf3\x17\x8c\xc9(\\\xd2&ux\xab\xb4a\xc8\x95\x86\xb8\xcbl6\xe3\xb7\x99\x0b\xb0\xb4=/\xe9[\x02\x8e\x1eQ\xf4\xe6\xac\xc8\xe0\xc9\x8a\xf3\xe8d`\x1b\xe7\xe2\xfbo\xab\x9f1\xf2\xc9\xc6G8Q/@\xce\x1cF\x14*\x1b\xa2\\y\xf2g)\x0f\xfe\xfcz\xbc^\x87\x09e\xcc\x8c\x19s\xe8E\x08\x93\xf6\xfa\x9b\xacf&\xeca\xf0p\\t\x19\xef\xf7\x7f\x82Y\xf1j\xe1~+\xaf\x92X\xe91B\xb59g\x9a\xf6\x9d\x1f\x1f\x06\xd7l\xc9c\x1c0\xd4\x15\x09\x99\"SB\xa1\xc0\x02\x95\xf3E\x95\xaf\xd4Z\xfa\xdc\x97zh\xea\x9e\x1c\xa5\xb1\x1e<\xf4\x9d\x7fW\x8d\xd01\x98\x8e\x90v\x88\x85\xa7=\x81\xa3o\xe1\x81,\x9eF\xa9\x10\xcf\xaa\xd7\xb8\x86%|\xfb\xa2X\xd2\x96?j\xac\xdb\x0c\xcf&\xd6N\x14C\xe6M\x9d\x0d\x1a\xceCc9\xe3V&\xee\xe0/@\xa2\x1fB\xb6\x08F1{\xf0K-D\xc0\x13\xa7L\xf32U\x92\x16\x0bP,\xd7O@ \x9e$\xda\x18\xee^\x16XYi\x95\x97\xd9\xfc\xcc\x1f\xcc\x80\xbf\xa1\x91m\xbfV-\x13\x07\xb1\x9a\x1a\xdd\xdf\x9d`\x9d\xe44\xb2\x1c\x97O\xd4\x12U\xfe\x92\x919\xd9U0\x86\xb4r\x0d\x8c\x1b\xcd\xef\xcd1\xa1\x95\x139\x0e\xf4m\xc1\xe0\xfb\x09\xc2\x1a\xd8\x16\xbaKH\xb0Hl5]\x8eU\x1a\x88\xb7>\xceg\xe1\xf2\xec.a\x1e\x84I\xa5a\xa8OuK\xfcy\xdb}\xe3\x9f0\xcfC\xeeD\x0b\x1f\xf5\x0d\x92\x13o_\x15\x87\xf9%\xb4\xc4\x11\x11\xa3\x15\xda#j\x92hu\xae`\x8cc\xb2[k\x16T]\xba\x93\xcb\xb8\x05\x1c\xca\xca\x83\xbd^[O\xd7\xa6\x9d.+\x91\xca\x87j2:\xaf\x81\xb8tm\xa4\xafo\xc7\x19\xbf}S^ \x15^ \xcb\xcf&{\xee\xa5E1\xe29\xe4\x84\xf3l\xe3\xc8F\xa0\xf2l\xf0tfN\xe2\xc2:\x81S\xfd\x988E\x02\xc90^\x04\x9e\xbf\xf2\xcaj\x9f\x0a\x88\xac&K\xe9Rw\x00\xbf\xa6\xa4+\xbc;\x94K\x87$\xc1\x1e\xa2(\xc8q\x92`\xe8\x0b+\xd2\xe7\xe3\xe7\xe5q\xf2\xec\xe9\xd3r_\x88\xa9/1\x8d\x90\xbe\x82^\x93[\x12\x06\xe5\xf1irv8\x88\x96oww[\xa5\xb3\xa4\xf7+\xa4D\xcex_\xbf\xc0\xb8\xff\x02#\xbd5?Q\xac\xf3\xe9O0%\x0f\xb3 \x11\x95\xd1\x9f\x14 \xdck\xd5\xad\xcf\x9ef4o\x1d\xf4\xfb4\xee5" +
+	"\x13\xd4\xc3^F\x88\xe3\x16\x870\xf4\x93\x97\xe7\xdf\xf3O\x0e\x810\x9f\xc4\xd4\xa4\x88\x0f\x1am\xe7\xba\x9f\xdc\x1e?\xd1d0\x07oW\x1e\xf8y\x16\xf1\xc2\xa6M\xd2\xe4\xac=\x86\x1d\xce1z\x0e\"\xe5P\xefU\xdc.]\x8e\\~\x0f\x93,\x08\x95\xa4\x15o\x8c\xb7@\xc3%\xac\xb1\xa4\xcfd\x81\xb4f%\xd6\xb07^\x19\xce\x9e\xc4G79\xf9\xb2\xeeb&\x02W*\x88\xfe=\xbe\xe9\xb3\xa7/J\x0d\x7ft\x9c\xef\xd6'\x95\xa4\x18\xaeO*\x05\xc8\x98@\xa5\xc03:\x81\x0a\xceA'4\xa2\x1e4\xeaOO\xbf\xf2d/\xd3\xcc\xd7\xc1#d\x07\xab#\xb7\xd2\"<\"\xc3}\xad\xa0^\xaa\xa5\xcc\x8d5\x82\xa8\x08\x1dm\xc4(\x82\xc5(\xd0\x85\x942\x06A\x7f\xc1\xb8\x08\xbe\xdf\x84\x8c\xd4\x05\x0a\x89e7q=\xbb\xf3\xeciy\x8b\xee\xc2Y\x0d\x8a\xa4\xd3/\xf3\xcaNi\x00#\x7f\x8d\xafHe\x13\x86\x9a5\x96\xb9\xc5\xec\xc4\x04\xe3\xc1\xa9\xff\x17\xbe;\x9b\xf0X\xf8\xe3>M\xb6\xa6\xd2:\xff\xe9?\x8c~\x00\xfe=\xfc*\xef\xf3\xe6OU\x0f]\xfd\x9f\xff\xf4\xf7\xff\xfa\x8f\xff\xe9\xef\xff\xeb?\xfe\xe7\x7f\xfc\x8f\xe1\xb7?-l<\x8f\x08\x93n00sr\xd0o\x19F9@w\x81\x91\xc6]\xf0\xde\xaaR\xfdc\xe1\xc0\\\x8f!V\x0e\xee\x18#aI\xdcp\xa1\xcfw\x90\x9c\xf11\xfc~\xf6\x07\xdf\x9f\x92\xb4\x13)\xf8w\xccr0\x01L\xc5\x0bi\xd5\xa2\xd9\xe1\x96\xf0H7\xce\xea\x9b\xf7\xb1p12\x02S\x98%-\x028\xe9B\x8f\xca\xe3\x9e\xea\xae\x9e\xa82\xce\x88\xe7\xee\x84\xbf8[;4[\x85/\xa7\xc2\xe2\xe1lg\xf7\"B\x0c\x111H>\x16\xa3\x84\x8d\xadL\xf8\x02M\x03\xed\xe2\xfb\xebf\"\x12L\xc5\xa4Z\x82\xd6\xc4\x1b\xcbW\xf0kC\xabG\xaa\xbeK\x95p&\x83\x98\xc2{\xae\x15\x07\xe9J\xfb\xc3r\xb5\xaf\xeb\xe2\xd24\xd6&&M\xf1\xbb${\x1a|A\x1c\xb0\x1akc\xc8\x0cZ\x13\xc1_\x81>].4r\x1a\x9c\"\xbc\x03\xce\x0fz\xad\x14\xef\x8d\x85\xcf\x8b\xaf\xf22\x0e\xb0H\xccl\xc0\x8aXf\x1elQ\xe4s\xc7\xe5\xd7wp\xee\x8c$=\xe7\x8d#\x848\xc16\xa4\x0d\xbf<r'\xabP\xe4\x1b\xc0`\xe6\xd2S\xebZk\xb5\x12\xfb\x09\xcef\x9d\xd1\x07\xd3\\\x15m\xc3\x9b\x04/MT\xf7\xa4G\xb8\x0dF\xef\xe7\x16\xce\xfb\xcff\x98\x1e\x1d\x02\x84bl\xad\x83\xc9A\xbf\xcb\x14\xea\x15\xe5\x80\x8b\xce\x1e?\xf5`L6.\xf6\xc1\xb5\xd2\x0d\xc5}f\xd4\xbe\x9d`\xbfG\xca[\x820\xe3\x1f},6\xe0`\x06\xc5T\xe0\x88 \x0a)\x18\x0f^N\x97`\x0b\xbd\x90\xe1S2\x86.\x9f\xa4\x90c\xd58B8&l\xdf\xfe\xdeJ\xd7\xe9uS\xd0\xaa\xe4\x14\x8cs\xd5S\x9e\xceR\x1ff\xf80\xdd\xd6\xba\xba\xf3cO\x93yf\xf2.\x0e\x14u\x01\xd1l\xd1g\x11\xea\x09\xcd\xfco~\x18[\xe8\x04\xea\xd2!!w|2\x98\\[m\xc6\x07\x87a\xb4\xb0\x8b`\x9c\xaa4\x98\xb1\xe4s\xa3!\xb7\xe2\xb0^\x16\xec\xd7\xd5\xee\x87\xd9 \x90x\x10\x86\xcf\x0b\x01I5,\x19.h\xc2K\xb4h\xc3\xc9u\xbeh\x01\x1b>K#?r\x806\x9c\xd5O>\x11 1L\xcf\x83;\xf8*\xb5k\xe8t\"\xf7\x1b\xfc\xb4\xc7\xcf\xabJ\xc0\x09\xdc F\x07r\xd6=-GJ\x1e-L-\xabb&\xe8\x9e\x06\x0e0h\xda\x07\x17\xcb0\x06\xd8\xc0\xe4K\xe3jQ\x0f\xd0U\xbauI\x01\x08\xad\x09t\x83\x98m\"gP3\x7f\x19\xf6\x01\xff&\x15EC\x03#\x98\x1a\xa3\xef\xdc=4#\xacUq\xdd\xad\x0c\x00\xb3\x0a\x185$\xca\xef\x84\x09%\xefM~\xd5\xa5\xee\xf9\xcdx\xe9\x15s0\x9b\x95i\x0dzX#\xfc=\x9f!\xe9\\\x8dj\x8d\xfeS\xbc\xb1`i\xfc=7\x91r\x81\xa8\\\x07^\x04\x0fF\x8e8\x0f\xfd^~\xa9\xa2\x1f\xda\xc7\x1b\x9c<0\xbas\x8a\x0c\xebc\xd8\x1dm\xe1\x06\xf1J\xb5p\x81\xa6j\xa0q\x9bnem\xbd{\xb6\xd2\xa4q\x9f\xcb\xa9\x09.0D\xdb}q\xd2\x88Fw\x03*\xeb\x8d\x0f\xdaP\xc9&\x1a&\xa8\xfb<\xa3\xb8\xb2\xeaz\x85\x02\xeepq\x15\xc2Fg\xa0\xa9\xd1\xe3\xc1'4\xaeRa)\x14\x00,\xa2\xbf\xc4L5\xd1\x0d\xe8\xabK\xe0\x99\xa6pJd:\xf0\xf7TW\xaa`\xcd\x15\x19\x04m\xd3\x0f5f\xd9\xc2K>\\\xe1j\x1d\x0b\x8a\x09\x1d\x8e\xd2\x08\x03I\x8c\xe892s\xf5pe\x99\x9f\xcd\xc7X\x81+\xe5\xa2\xe1\xf8\x15&\xce\xcc\xbc\xdb\x90\xb8\x8b\xa9/\xe3\x10Q\x03}\x05\x13\x8c\x9d\x17\xc8\xf5@\xf8\xf9p\x16\x9eD-\x91\xad\x12e7\x1f{O\xda\xad\xda s>\xd5D\x12\x0ct\x85x$\xe2\x0a\x97-\xac]\x1a\x8b\x09+t\x1d\xa7\xf1\xc2\xd3\xe0\xafj\xe7\xf4\x0f\xe8v\xe9X\x9fQ7v\x870\x84\xa1\x8f\x98\xa1@Y\x7f\xf4\xc3Z\x91\xe0\"2h\xedz\xd1\xa9G\xfc\xb8\xe0\x17\x02\x98\xb8n?7\x02\xa2\x1d\xdd\x18\xec`v\x171\x8f`\xf4\xcaOp\xf0VH\x8a\x9a\xe2\x02c,Ui\xc1\xf5\xa5\x81E-E\x1aeT\x90\xa0\x11\x0b!LN\xc8\x0f\x0d6+\x92\xce\x93\xb7\x96\x81|i\xfb\x81\x04\x84M\xa7\xc8\xadV\xd9\xf0RQ\xfdM\xa6\x83\xbf\xe5z\xfe\xa8\xf8\xa1\x0a\x17\xe2\x00Z\xf13|j\x03\x9d\xce\x0e%pZ~=\"0\xcd\xcc\x16\xfa\xe3.\xb9\xf0\xde\\\x99\xbe\x7f\"\x19c\x01\x85\xebr\x12m\xc6\xac\x08\x7f\x9b\xec \x92\x87\xd7\xbd\xbbP<\xde\xf9\xa6\x0b\x9d\xaa\xfe\x8b\x1e\xac\xe5\xb1\xcb\xbb\xfaG\x85\x18\xc7}\xfd\xe3\x0dRpo\xf2%\xa5\x81\x01\x97\x93\xf7Q\xd9\xf9\xe8T\xf5T\xb7\xe3m\xa1\x9eY\xf6n\xccOboi\x06\xaa<\x8dW\xf2\x05\x9a.\x88\xe9\xe3B\xea\xd8\xf5[\x15\x8e\x091\x17$\x9a\xd2\x0d\x8fVb\xc1s\xd1\x97\xfa\x02\xef\xb2\xfa\x89\x90\xf2\xa8\xfb\xc91\x83<C\xa99\xdfzv\x14\x8adc5?W\xd5\x0d\x12n\x14\xad\x08B|\x13\x9dC\xc5\x8f\x1a(*JH0\x0eMsK\xd0\x18\x83E\xf2\x11\x078\xc3\x03\\|(\x94w0\x04\xab\x1f\xa5\x15\xa8\xd4w\xa1kX\x95pq)^\x09\"\xde\xb6\x92_\x0f@\x15c\x9b\x9a\xcb\x89\x89\x1c:6\xf3\xff\x04D?#\xdf\xdf\xa3\xd4\xe6\xe2\xd3\xab\xf5ZVM\xa1\xda@\xae\xc4\xb1/\x17\x16u\xf85\xb8K\xffg~\xcd\xd0y\xb6V[\x1f\xaa\xb1q\x86\x9c$\\\x0fYn\xe0r<M\x0b\x016)\xa41\xe7!0\xa8Kjs\\\xc1\xdf\xcd{\xeaR7<<&/\xb0\xba\xb4\x98\xb9\x82\x0b\xec\xe1\x12\x86\xd6\xf2\xd3>\x8fP\xc0\x16\xbcA\xe0E\xa7\x9a\x16\x1e\x84\xd89A\xc0'9\x14EA\xcd\xfc\x07\xcbI\x18\x9b/\xca\x02\x84[\xdex\xd2\xac\xd2\x9d\xc2*q\xc0\x85T\xd8<b\x12\xd5\x13H\xa0\xb9\x08I\xa2;\xacGP\x9e\xbd\xb8m\xe2\xa0\xd8\xb0#\xc0\xf1\xe0\x93\xb81\xff\x01g\x90\x9aJ\xf4/\xc9\x13[\xaa:\x03\xc1\x8e\xdb\x8e\x10\x18\x1boy'p{J\xb3\xcc\xb86\"\x82\x0c&\x18#c\x0d\xa3G\x8f\xec\x0dI\xbco\x9cK\x83k\x8c?\xaa\xad\x1f]\xe3\xbb\x8boW\xa7B\x92\x94\xb9`\xf4j`h\xeb\xe0\x04\xc4\xc8\x03\xdep\xacp\xd6\xed\xecG?j\xe5\xf7\x129\x0b\xdcCJ\x19\xeb\xc5\xbb\xce\xda\xe4\xab\x0c\xf5T\xe4\\K\xc1\x11\x00E\x0e\x9c?\xc3\xbd\x1b\\\xbfM\xcd\x11\x18\xdd\xd9\xd0\xd4\x8d\xf5\xd15n\xda\xd1\x9e>\xd5\x03k\x1b&Z\x1d\x9b\x8fuC\xbec\xb8\x04:c\xe9\"F\xdfk \xe5\x93o]c=\xc3\x0c+\x88Wa29\x0aHU\x9b\x8fpt\xbdT\\\xa9H\x10!\xfb\xc2\xf1\x8d\x8f\xe2O&\x04\xa412\xaa\x84\x8b\xc7s<\xf8\x18\xd9\x9c\x94\xfb#\xa6\xf1\x14\xfe\xb8\xdc\xce\xafo\x9b]\xf3%\xff\xd4\x9c\x1d\xf40\xb9|\x8f\xb2\xe5\x1a\xe1\x8fT/\xb9z\x05\xe8\xc2\xcf=\xfd0DDa\xd9\xc9\xa9\x01\xd2p\xe6\x15\xe3\xec\xd9\x9bL\x03Ve\x84ilb\x92JDO\xa5\xdc\xba\xf3i\\H\xa2/\xca\x81\x9c\xcd\xd5\x04/\xbe'\x04\x7f\xc4\xc8 Q\xe2\xf2\x03\xbe]j\xff\xd8\xf4\xd6\xc0\"HC\x8aM\xb0\x95E\xddL\xab\xe8\xbf\xf8\xe1\xdfAhd\xa2\xee\xeb=xM\xbd\xca\x8aP\xe4\xa0\xdbi\xf4\x87\x99\xea6!\x0e\xba\x0f\x1f[\x99\xf3:\xfe\x15y6D\x87z\xbd\x01\x9e?\xbd\x12!\x9fc 6!\xd7\xed\xc6\x87\xad-\x1bB\x1b\x99h\x1f\x04T\xdb\xf9\x18~B\xab\xf1\xa2\x12\x0b\xfd=\x0c\xd0\x84\xd7S\x04\x1a\xfa?\xe1u\xf9\x0f\xfd\xc9\x8fn\xe8\xa2&\x8f\xb9Y\xb4\xca\xd30\xf8\x09X\xac?\x1e\x80!\x82\xd8F\x86M\x1a\xc9\xa6\xa1\x851\x1e\xbd\xdcJw\xeb\x00\x80\x8cm)+@+c-\xe6\\\x0b\x8a,\x9b\x97Z\xb2\xcdD\x06\x9e\xeb\x89\x17\x02S'l\xc3I\x06\xc0(\xab9\x03}\xe5\xe7\xcc/\xb3\xb3\x985\xd7\xbbb\x15\xc0\xf1s\x7f\xaa\xfb\xd3\x9e\x7ff!\x8d\x8b\x1f\x0e\x10\x9e\xe5J(\xfa\xf95\x1a\x8f\x12\xe8F\x83\xfdsZw\x9e|8;\x0f\xc4\x9e\x91\xbc\xca.\xa9M6\xe2O\xb8V\x08\x87:n\x1f\x18h5T\xe5\xf4\x92\x07\xcd\xf9P\xb0\x96G\xa6F\xf1\x8d\x97\x80\xa7E\x8e\xadK}t\xe7k\xcd\xf1|\x1e\x99_p\xa42\x1a\xbct\x11\x8e\xac\x84\x09!\x1f\x8dy\x85=p\x81JB\xbaW\xbeh4 \x89+6,)\x8b\xef\xf0\"\x8a6\xf7p\x81<\xc1`o\x90{\x16\xc2\x0e\xa0\xc8\xc8(\x19\xc0\x89\x12\xb7\xad>\xc2x\xef\x8e\xaeE\x0e\xec\xa4\x8c\xf9\xe0\x97\x8ao\x99B\x99B\xde#&\x85\xbe\xad\xfez\xed\xa8\x0dFpt\xc8/\xa2\xd9\x9e\xfc\x01\xe2\xf5\xcfMb8\xa4\xfa\x13\x8b\\\xd9%+bXe\x18\xf5\x8e\xe6$\xdd#\xee\x8c\xce\xb7\xbc#N\xf5\xc5\xe3\xca\x87\xdb<\x83\xa7M\xc8\x14\xbb\"a\xeb\xc4\xc5\x1c\x97\xe05\x09\xeb\xe7\xafn\x83I\xe0\xd5\x12X\x00\xee\xed\xf2^1\x0f!\x9dN\xf3|\xcb\xc5\xf6\xe1\x8f\x92\xf6I\x13\xf8\xf9\x059(\xc9`\xe1\xe8\xfb\x8ex\x09\xc3\x85\x95\x8c\x17\xb1\xddC\xb35\xba\x1bK\xb8_\x02\x84\xc5\xae\x8f\xc1cc\xfb/z\xeaQ\xf1\x9bI\x90tov#\xbd\xc6F\xd2\xc7\xdb\x94\xbf\xdd\x1a\x92\xd51\xbe\xdb\xf29Zp\xef\x842C4\x82\xe9o6\xce\x8a\xa9\xcb\xa6F\xcar:v\x0a\xef\xd2\xb0\x11,\x1c\xa5\xa2\xa4\xc3%/\xdc>\xb38\xea\x95j\xfd+|\x0d\xcf\x0bux\x8b\\\x94\xda\xfc\xca\xe1h6\x0b\x09y\xb1\x7f\xab\x83\x9a`2\xb2\x17\xe6\xcb\x1e\xd9Q2m9\xed\xa1VU\x82\xf1!tCh<\x131\xcb\xf8rZ\x12qLp\x8f\x84\xd8n\xa1\xf132\x91\xd1\xf7}X\x92\xa5\x02\xa9Gc)\xcdd\x11\xed\xcf\xb5-\xe4m\xeaf\x863\x0d\xfd\x87R\xae\x93\xe5_\x85g\xf8\xd5M\xbd8\xe5\xf4%\x9c\xa0\x93j\x9f\x0e&\xd8\x87\x0f\x9e\xae<\x1foi\xf0\xedr\x8a\x88\x03\xb9\x04\xe1\xc8#\x10W\xe9\x0a^\xcc\xd1\xcd\xf1Q(\x02\x12\xd1\xc1\x89\xe3;\x9ap\xceO\x02\xc3G\x8b9\x92V\xa6\xe6 \x1f\xc3\x1e\x86\xfd\x86\xf0\xf1\xcb\x0fy\x177\xc2\xe8\xe2\xa4C\xe2\xc2\xd5:\xa6r\x187p\xc1'\x89\x97\x93\x01\xf1J\xed\x8a\x06\\\xeb\xba\xa4\x9fv\x02\xcfk\xea\x01\x0e\xa0\xe2\x19\xeaVU\x0f0\xb9n\x97\x06\xec\xdd\xd80\xcf\x89;\xf4~\x1c\x83a\x9b\xb9<\xabj\x00\xda\x9fM\x89v\xfd\x0c\xdd\x81C\xc9\xd6)eB2\xf6\x8c\x84kQ\xfe\xcc\xe93\xe6\x92\x97\x9c\x915\xe2qD}\xf00B\xa2\x89.b(\x8b\xbd\xf4,U\x1e\x91;\xa6\x11\x8chP]\x10\x1e6\xa0F\x97&\x12jQE\x89\xa3p\xe5\xf0\xe0\xe5Es\xedW\xc7\xbb\xb99+d\xb4\xce\x1c\xbe\xf9Y\x83\x90\x11\x7f\xa0\xf4\x85c\x1d$\x97\xc2^\xa0{\xa9HP\x9f\x99\xedw\xc2\x12H\x9c\x8cV \xd0k\xf9\xfaE`\xc5\x0aQ_\xba\x04m\xb36a\xb5\x89N&uP\xf8\xcet\x90a\x9c\xe3\xc2\x17\xe6\xa5c\xda\xe8\xc1\x8f\x11<8N\xae:\xba\x960\x1bM\x1c\x03x\xba5\xbb0%EM\x86\xbf\x1a\x9e\xab\xa6&\x11\x11\xb9\xaa \xc1\xfb\xd9UH?>\\\x04\x7f\xbbz\x0d\xb2\x96\xbf6S\xb5h\x1a\xbc\x98!.s\xf8\x89\xaa[\xeb\xea(\x82\xc7\"f\x83:K\x1d\xd5\xd5P\x88m+1\xb6\xbc\x94\xdc\xf6\x97*\xf5&\x17\xe6\xac\x94\xb9\x14'_0\xb0m\x98\xfa\xc5\xca:\xd5\xfd\xc4\xaa\x13\xf9!\xb2\xe5\x11VpO?\xb4\x18\x82{\x7f6\xa8d\x0f\xe4\xf7\x7f\x88\xdc\xd62#\x16{\x80[[&\xbf\x1b\x89\x90iy\xf6\xbf\xc8BQ7\xb4|Z\xd4\xb4\xcb\xd7\x89{\xdf]r1\xd4Y\xe8\xb3\x92\x9a\xd6\x13vB8\x124\xe6%\x85:r{\xc1\x00\xdfr\x09\xdd\xe6\x19\x04B\xef\xa29\xf8\xc7L?\x1a:\xeaV\xd8\xc5\x9e\xa2\xd6l\xea4\x91)\x85.\xb8\xdc\xda7\x01'\x9e\xa4\xc0\x89\xae\xee\xc8\x88\xd15e\x83\x12\x95\xdbH\xdaD`\xa5\xd0\xd1\xba\xb8\x1d\x0b\x85\x0f1\xd8\x93Q\"$\xb1\xd9+\xaaA/R-\xb1\xeb\xc8\xf4r^0\x9b\x11FfJ}\xb1\x859Fp\xafJ\xc0\xa0\x9d*\x1f\x8ep@|yq\x82,\xa6H\xf1\xac\xe5\xb7M\xa0\xb2\x8fH1>\xd03\xb6^\x02,\xccTl\x96\xd9\x01\xb7\xea\xfe\xd4\xb0&1\xff\xb9\x83f\xf4\xc7jp\x0f\xf0M\x95*\xf2D\xbe\xe0\x1b\x1cF\x0a(\xb5\x9d\xdf\xc6\x04>\xc9\xa4=\xde\xdc|SX%\xb4dn\x96\xab\x8b\x95\x85h(^j\xbcA*\x1b8\xe7.\xfdE\xb5\x9f\x84\xc6\x80\xdfz\xce,\x03\x86\x04\x9f\xcb\x16i\xafV\xfe\xed\xd42\xa4W\xd1r\xa9K\xb4{\x13\xf5\x1e\xa1\x97@6\x03\xbb\x83+\xe6 \xdc\x7f\xa4\x04#Y\xee\x0a\xc2f\xaa\x81\x0b\xda\x19\xd24\xb8C\x03\xa6e\xeaJ\xa0E\xb7pN\x8c|\x00i0([\xa7I\xf2\xcd_0\xa9aNL\xd0\x19\xc1ur\xe8\xbb\xc2\xb5\x12EL\x1c=\x96\xab\x9c\xd7\x11\xe8Oi\xf6l5w\xf6b\x0dk\x9cF717_\x16\xd9\x13&\x04h\\$W\xd6\x84\xe5\xacnO\xfc\xf1j3\x07\xe4l\x11Tw\xf1T\xb9\x83\x1e_\x94R4\xb8\xa0\x9c\xca8G\x8d\x99\xaa\x92Ry\xe6\xf8x\xb8\xee\x15\x0f\xf7\xe5\xd34K\x9fT\xfar\x83&pR\x82\xd2\x1c\xfcDl\xcd\x0b\xc1\x04\x1e\x96]0b}K\x89H\x96\xc6\xa3q+\xa1h\xc3o\xaf\xad\xf2\xaf1\xa6\x16\x9a\xb3\xf34\"$F\x12\xa5\xfd\x1b`\xd6\x93]\xb5\xb1|)\x0a\x08\xc7U\xb1?\xd5\x83[\xce\xbeG\x89dKwj\x03y\xd8\xa7\x91G\x98\xe2\x7f\x9d\x92w\x14\xb7\x88y\xa0\xd9\x88M\xa1\xd3\x861\xa1\xc5\x87\x8a\x8eTS\xc3\x11\x0cb\x8c\xc9\x1c\xd4\xbc\xa6o\xff\x8b\x1b\xbe\x01\xdfsE\x18U\x82\xee\xe5\x17&\xa7)\xeeX\xa4\xb6j\xcbz\xb8\x86\xa2\x01\xaeF5L\x94Y2\xb8\x7f\xc0~\x99\xab\xe2h\xa4g.\x7f\x91\xfa\x97\xc4M\xf9\xd8\xd4y\x94;\xde\xfcL\x87?\x12\xc0{%\x19\xe0\x0f\xf8-\xd9uT\x8et\x13s\x0d\x9d\xd0\x80\xefv\xd59\xf8\xaa\xa28>y\xb9*\x03g2E\xc8\x02\xc9\xec\"t\xee\xf7 \xf9^\xbe\xf8?tX\x15\xf8#\xb5\x1e,\x0ac\x15\xc5\x81\xcbc\xcb\x08\xee\xd4\xfd\xe9T\xb7\xa4\xe3y\xed\xa9)\xd0hB\xb5Ke\x8e\xeb\xb4\xbe/_>\x0a\x8f\xc5`\"\x0eF\xbb\x19\x12ka-U\xef\xc3\x88\x92)\x8d\xda* \xef\xe5\xab4\x1a\xb3-\xdd\xa1\xf3V\x0c\x1f\x99\xf9\x8d\x8aG\"\xd8a\x05\xc2M\x8eI1\x0exR\x04\x9a\xd5\x08\xcco\x08\xf3\xbb\x9bW\xe8\x93]\xb0\x81\xc6\x1d\xc2h\xc0\x1f!\xaa\xbc\xfc>\xaf\xe7\x80\xcf\xae\xee\xeb\xce\x1b\xa1\x81\x16\xba\xeaR_8\xe6\xc0\x0b\xd6\xa7z\x00\x0c{\x81[Jj\xa1\xd4M\xba9\x89\x00MW\xf7\xc1\x0d\x1d\xb7y\xd8\xd74\x82\xb4\x1c\xca\x8d\xac\xf5\xc9\x02\xadGs\x86/0\xb9\x81\x96\xa0M\xe4]\xdcV\x97\xd8\xc6\xd2#B7\xd1~6/\x19=\xc86\x95b!\xa0\xb7" +
+	"M\x9f\xcf(V\xef\xfd\xbd\x1f\x8a+uZ\xffU\xf43\xf9\xe1\x96\xdf\xf9m\xd9\xefY\xc9\x03Q\xb1\x14\xecRy\x18\x03zm?\xb7\x9f\xc7\x18\"O\xd8\x98\xb3\xf7H\x06\x9dZ\xab\x02?\xde\xf09\x8b\xc5\x9aIt<\x98j\x89\xda5\xb9O\xc7\xa81\xa9\xbed\x16\x8f&\xd3\x99\xd8b\\\xe4\xd3k<r\xe8\x1cci\x06t\x07U\x97G\x16X\x02hY\xbe\xa0\x10\xabtp\x91\x88\xa0\x862\xc9\xb1\xc5e38\xf5\xb6\xe4>\xfc\x9b\xbd[jp\xfadR\x1b\x9b\x8f\xc94!\x94\x7f\xf2\x9dDYs\xf8&.\xf2\xef\xe7/\x1b\xd3\x8a8\xf4\x8c?\xa9i#\x11\xa6\x81\x16FE\x05|\xf2\x8d\xbf\x98\xc0\x1c\xf4\xf6\x99\xf8\x14\xb6\xa46G\x17\xf2`\xab\xa4\x1e\xaf\xeefS\x8e\xdf\xf7\xd3\xe7> .\"+\xdap\x84\x0e\\\\6\xe8\xfb\x18\x93\x90-\xc7\x98\xe9y\xc0\"\x1b|\x96i\xf1\xf7\xec\xdc\xaf\x9e\xad$i\xd8\xcb\xef\xea\xde]\xbcB\xf6\x12\xdb\x01\x1b5\x02i7\x12n0\xee\xeb\xf6\x89<\xadX\xd8\x17\xe4\xe9\x9cb\xa1M\x11\xb6\xc7_\x11d\x11\xab\x13\x16_\xe2\xb9\xc5X\xff-\xdee\x04\x89\x91Gn\xee\xcdRHk\xfb\xa4\xfa\xef\xa5\x88\xd5\x96\xee\xf4\xf7L\xbe\xd7y\x08)\xc1\x06\x1e\xa2eh\xd2\x01\xbe3 |3\xb2,\x1b\\\xe1\x81\xd6\xc2\xaa\x0b\xa7\xcc\xc7\x02\xf5\xf7\x7f\x0b'a'\xbe\xb1\xb6\xf2U\x02\x11\x0e\xa2\xd2\xbb\xc5.\xd5,\xdb\x96+\x11h\xc8\xe6e[\xd4W\xa9\x1c\xbc\xeb\x96s;\xaf^\xce\x1f\xa1\xf9|P\x16\x96\x11\xd9\xde`\xb2#!_\xc68\xd3\x89=\xe1\xa1\xba\xf8\xd6\x9f\xab<~\x86\xb3{\x82\x01C\x13%\x0d2\xe9\xa8\xfe\xc4X\x03\xbc\xd8\x00\x93\x1f+y\xb7\xd1\x9f#\x16\x9c\x03A\xf6\x88\xdfu;.\x1e\xce\x08\xa5\xc4\\\xb3\x9e\xe3\x0c\xd9\xe7\xd8A(\xa1\xea\x97\x9b\xde D\x1a\xd2fL\xc7\x1f\xb1\x9d&\xa0\xc8#-\x93\xc6\xb8\xe3h>\xed\xa8|\x14q\x02\xe0f\xa8\x90\x16m\xb1\xd80\x8b\x8d\xf7\xb5\x1d\x84\x8da\xf2\xfd\xe6V\xdcW\xa1\x81?\x9b\xad\x0fo\xb7}\xd4\xfd\xf4\x12\xbbX\xf5\xed~sIP\xf3w\x05t\xa3l%\xc5\x1b/0\xf9iGSM\xe2\x8ea\xa2$\xd4\x83_\xa0gR\x8d\xd6w\x09_`@\x8b\x9cu\xf7\x16Eg\xc1p\x07b\x19\xd1|a$\xe2L\xc7\xef\x8b{\x00\xc9\x866E\xfa\x02\x15T\x16\x91\xbb\x0d\xf3\x1dlg\xe9\xdf\xaf\xa9\x01\x7f\xf5f\xa9\xe8#\xaa\xf175\xae\xa1\x0a\xc9\xafi$\x0f\x84\xc8H*\x0e\x93UZ\xd9\xd6,\xa0\x9fD\xda\xd9C\x9a\xeaC\xcc\xc1\xe09h\x96/?m*\xf1\x98\xaak+\xc4'\xe7\xed1O\x1d\xefA\x897A\x18\xe0\x95\"CC\x0b-\xb5b$\xa9Z\xa2\xf4y\xb7\x12\xe3\xfb\x1a\xe1\xcf\xd7O\x0b\xab\xf6\x0c\x12\xc4\xa3r\xcf?\x13\x96'\x09w%\xe0\xa0Y\x09\x0fu,\x97\xf1X2\xac\xd8\x89F\x98u\x8e\xe1\xe6\x1a\xba\x83\xd4\xd0\x95d\xe0\xad\x8a\xa4Y\xe5\x12\xc1W\xd1\x1a7\xf2\xf0\xb3a\xfdU\xed\x18I\x83\x8d\x15`\xed\xb0M\xde\x88h\x9bG\xeb\x8cf\xa0\xf1\x97\x0c\xe6\x7fn\xaf%B\x96\xb3sb\xf6\x8d\x16\x91\xde1\xfc,\xafx\x0a\xec\x02\x91n]\xb9\x98-\x82\xb5\x9c\xf3}\xcc\xa4\x7f\xfdH\x9e'\x1e\xffgw?\xe0K0S\xe3\xbd;\xc31\xaa\x9c\x0c\x93\xa8W}\x01\x0d*X'\xe2XV)\xd1\xb1\xa5u\xc7a\xdc\x80D\x83\x86\xe9\xf3\x0fL-S,\xaf\x95\x9a\x9b\x9d\x8d\xb7\x04\xdb\x8e\xe8\x80\xd1]b\xfbj\x1f\xa9G9C&\x0d\xbc]+\x7f\xa4\xa1C=\xa7\xcd \xf5\xa8f\x95\xfc\x02\xf7\xee\x00\xbd\xa4\xa8\xc3\x88\xa5r}Ky\x1ac3tA\x03\"\xc3\xeb\x86\xe4C\x1c\xb0\xdbd\xc4\x0a\x02y\xce\xc7\xa2\x84v\x1b\xcej_\xc8\xc4\x86~K\xc9\x0d~\\\\\xff\xf09m\xc3\xa7\xd7\xa3\xdav\x1dz\xc6\xf8\xc1\xb1\xcd\x8e\x97\xc4*L\xb1U\x9a\xf6/\x0c\xd6Rr\xeaj\xfa1\xca \xbe?B\x91\x07\x87\xcd8]\xff\xad\x98\x8e\xf5\x98d\x9f\xcf\x91\xa0l\xe4F:k6\x88\xc7\xd6a\xa6H\xd9P\x15\x1c0_\xe8\xa4f5n\xe2n2K\xa9\xc8\xe2\xe2\x035u\x03\x9f\xc0C\x9f\x98\x0b\x1c\xe2\x0c\xdd\xd7$\x9b\xe5r\xcb\xbc\xb8\xa6C{}\xf6\xe6\x93t\xb7:K\x8bS\xb4D[~\xfbT]\x80\x96GM\x0d\xc5E|\xb4\xac\xef\x94\xb6c:\xb2\xf0\x14_AF\x96\x0a5.\xb6\x9f\x8d\xa2*\xc8z\xb1A\x99\xa4\xa5\xf34\xe1\xecU\xddd\x08\x1f%\x16\xd8y\xaeF{2G\xe4\x1b]5!\x09\x14$c\x8es\xe6\x9d\x98 \xfc:w\x89H\xb0\xc7\xb8\x8bd\x90\xe9\xdd|\x13/\xb6\x8d\xb9\xd4\x06I\x9b\xafp\x0d\xcd\x91F9\xccp\x91\xe7\xf1m\xc2\xf3\xf8\xff\xfa\xc7\xff\xf2\xf7\xff\xc7\xdf\xff\x9f\xd7X\x1e7?\xf4B\x96\x88`\xb4\xb0\xa8]\xea\x8eK\xff\xb5i\xa2\x1d\x9aaP8\x14\xe7\xb0M\x1a\xc0\xf2\xcc\xc2\xe8d\xee\x01\xb36mp\xed3\x0bA\x8c\xbb+\x06Q\xd6\xc1M\xb2\xf8m\xafS,~d\xf8\x0eN>\xb5M\xf5\x96B\x84\x90,\x11#\x82\xc1*\x9a\x84\x07-tX\xaf\xf9\x9d\xc5\xe2\xf2\x88\xaa\x99\xf8\xc5b\xe1\xfetv\xb4\xec\xcd\xdc\xe3\xebL\x8a\\\xd9\xe4\x08\x96\xc7dQC\x07M\xeb-WT\xb1\xe3\xb8\x9bPbM@0\x11\xee\x11V\x93\x0a\x9a\x03\x0c\xa5\xb7.]o\x8e\xa9(\x0f\x01#:b\x84\x0e\xf2\x9e\x09\x1e\xc1\xe7\x03L\x06\x89\x05\xfe\x90\xc9\x90/\xb2K\\\x83j/\xf6a\xb9\xfbh\xb7=X\xc5\xce\xc2 \xe7b\xc5y\x8c^\xc8u\xb7\x9c\xb9\x8f5[\xd7Y)\xc5b\xb86\x9b\x1e1{\xe6\xb9\xa7p\x9a\x0f\x97v\x9a\xe7\x0d\xb6\x00\xe5\x13\xdc\xe8\xcc\xc5\xb6]Q\x87\xc827\"\xe3F\x9d\xae\xb4\xc1\x85w)\xb7Vb|c\xb7\xc6\x8a~\xcd\x9d\xb1 .\x16\xd4\x13I\xd0R\x0e}5'C\xa5TWy3\xdf38\x90\xb6\xa43\x1c\xdcH\x0b\xc1\x1e?\xab?\xc3\x00\x1d\xd9\x1e4*}o\xe8\xd7g\xbdC\xc0\x89\x0ez0\xb8U\xc4p\xa6%CT\xdfwi\x8d\xb0\xf3\x87\xfa\xc2\xa4^\x06\xd8\xe5\xfa\x09X\x04\x98M\x05\xcc\xe2\x86\xbfB?+\x9b\xe0\xf9r\xe4\x01\xa8\xbc\xaa\x9f\xdb\xf8W\xdf\x93\x8b\xfa\x1b4v\xaa9\x1a\xe1\x87\xfa\x00\xe3\xac\xd8T\x83\x98\xb8\xb6\xae\x91\xe6%\x9eew\x9bg\xf9j\x16\xd8\x97\xa1$A\xb1\x95\xc66&<[\xa5I-9\xbe\x1e\x7f\xe1(\xd1,\xb9\xcb\xf0\x9b\x16\xf0sF\x82\xd0I3\xb9\x08\xe0\xac\x12_\xa0\xa9\xb9/p\xac\xa1\xdd\xc6\x8aw\xa9b\xb0\x0d\x84rK\x16#\x9f\xeb\x89\x8f\xd7\x91aK\x08^\x0fn\x84\xdco\xd8\x84\xe7\xd8\xe6\x04\x879zt^[\xab\xcd\x19i\xb8\xae&\xc9\xaf/7\x8e\xab\x18\xa4\xba\xb2:\xc3\x00\xd0}+\x8e>n\xbbq\xe0b\x11S\x1b\\-\x83D\xdcq\xc4\xc0\x02]\xcd\x81\xbc\x10\xcd\\\xfa?v\xa1x\xb3\xd0\xfa\x9b\xac\xf9\xbf\xbe\xdd\xb7\x91\x11'\xf3M\xc5,7\x1cW\xc6\x8b\xc4\xe1\x9dIQ2\x0d\x885o\xd9&\xcd\xcf\xcdWt\x06=\x11Y\xfb\xd6\xbc\x99\x85\xaf<p\x91}\x9f\xf3\xbc\xfb\x16a%Z\xe1+\x10Bff\x92R\xc8\xbc\x9cu\x09u\x82\xea\x07\xd5F\xa7H}\x81)\xd9\x91\xaeS\xb0\xc6\x87\xf6\x0d\x0c)\xe4\xc6\xcaZ\x85\x81)b4\x86H\xa4\xf5\xa5\"\xe2\x0d\x1bM%\x80p2\xecR\xfe\xb7%\x84\xf0jQ\xec\xdd\xd3\xecUf\x9d\xbc\x91^\xde\xaa\x17\xa3]\\\xc8s\xdd\xde\xe1\xdfC\x7f\xcd\xa1\xec\xc2\x0c\xbe\x80\xe7}\xfa\x06J\xd4\xe2V\xb5{\xdc>\xb5\x89O\xa5,\xc8#\xf8#\x0c\xb0\xe7\x9f\xa4(y\x82\x8e\xe3%\xc2?\xa5\xf2\x99\x89t=E0\xe2\xa2|\xc3r|\xf7\xac\xf4F\x1d\xc5\x19\xaa1l\xf5\xe7j\x83m\xb9%|\x18\x99\x16\xf1\xd5\xf1\xbbL0\xbb\xff\x14\x98P(\xb4\xa4\xfb\x96)\xb7\xbc\xd4X\x95\xd3\xef\xe5\x97rfm\xb7\xc6\xd6i \x81\xf6\xe4\x8d\xd9\x8e\xb8r\xcb]P\xfdi\xabF\x19k\x1a\xa6\xa8\x1f\xa9\xd3\xbc\x81h\x945?\x9a\xb4X,\xd6b\x14\xab{g\xedI\x15\xb79}\x18\xe5!\x10:\xd9\xd4\x1d\xf8LR\x1c)>h)6M\xaeI\xfe\x10d\xc1\xbd\xc8\xb7\xe0\xcc\x13\x85\xc7\xfc\xb6\x9a=\xb7Erg\xa4\x03\x19)\"\x87\x0e\xe89\xe2\xc9.\xad|\x8ca\x99G\xf0\xccE\x86\xd3\x9f\xe6\x05\xb9\x0b\x05\xc9\xe7\xc0\x19kUu\x05\xdb\x85\x83l\x82\x01\xa3\x00\x94\xea\xd8+\xc07R\x9f\xc4\x0b\xb1\xeb\x9aV\x1f\x90\xb6\x93Hp\xd8\x0dy\xa7e\x16m}\x02\xd9;\x1eO\xae\x17iR\xe7L\xc5\x17\xe8a\x9c_\x97\x16\xbe\x94\xbe\xa6\xc7\xdbTl)'\xf07\x95M%\x9dt>\xe3w\xa4\x05\xee^e:n\x91\xa1}\x00\xf6J-\xeb\x17\xcb\xcc\xedR\x9c\x91\x84\xb5\xf5\x116\xa2\x7f\xac\xc1\x9bRcr2\xc1\xa5\x01\xe5Dh\x85\x92I\x91J\xbb\x8b\xec-\x93\x83D\xefk\x97\x95 -v\xdfJF\xe5\x91q\xea\xbb4\xb7\x8c\xab`x\x97\xb1dF\x89/\x00M'\xa3<\x18\x9e{\xfa!\xef\x90\xf3\x8f\xf4'h\x14\x10\x90\xa2\x8f9\x1cS-ID\x8c\x0e\xf6\x18>\x91r\x9c\x1c\xe2\xd9\xd4\x0d\xb41\x10x\x8d\x04\xf8M\xccR\xf6\x0f\x11\xe2\x1f\xdf\x07\xe8} \xbe\xcf\x12hv\xb1\x9e\xfa\xcf\x84\x15$\xa7F\xe6%\xe6E\xe62\x1c\xbb\xd9\x1el\x06\x0f\x03W\xf9\x06\x88\xc4\xbb\xf8~+#\xa6P\xb7\x11W\xdd\x02\xee\xf4\x0c\x03\xf1\xf9\xcf\x82\xb9e\xbd\x10 ?\x98\xb5\xc3\xaf\xb3\xa3\x8a2\xbd\xb9\xd86\x0d\xc3w\xae\xc7\xe8Y\xe9v*\xaf\x86\xf11Y|\xd0\x05I\xb8c\x04\xe0\xd8\x81\xd1\xc1\xbe\xbds\xce\xb6s4\x08\xae\xb3\xd8\xd8\x9a\xdfV\x89E\x18\xee\x98\xa2\xa9\xdf\x99u;\x8av\x15\xf4A\x8d\xe5\xb3\x0e\xf4\x8e\xcc\xa6_\x99\xd3ti\xe2\xd2\xa48w\x02\xdf\x10\x1aI\xd96\xd8T\xa1\xc0\x1f\xc9\xa8\x88\xaeJ\xffP|\xe3ec\x95\xc7Lt\xa4\x9e\\gAE`\xbe]+X\x8b`\xbe\x87\x9a\xcc\x1f+\x81e\x04\xadM=\xb8\xe3Y\x89\xe5\xfcp\x82]\xc1\xcf;:\\+\x88\x1brLh\x8dq5\xd9]\xe7E-.>\xa2\x10\xdf\xc1\xbe\xa5\xf4E\xe6\xae\xfc.v\xbb\xe7\xd7\xd9\xed\xd2BO\xa9o<\xc0\x05\xf6\xe1\x83Zef\xc7\xcb\xc6\xf4\x05Z\x14\x09\xd8%\xfe1\x8d\x0b\x9c\x8a\x13\xcf9e\x0a!\xca\x86\xa6\x0e\xb7\x97Fwx\xa3t;\x94\xd1\x91U\\~A\x96b\xb3n-\x8e\xac+\xb3\xe6\xc5\xb2\x16<\xbe\x8a\xb6\x80\xa1\xd0\xd8\xe5-!\x92\xe0\xac\xc2\xbd\xa3IK\xc5\xb0't\x16p\x1eU\x82\xf74{\xac\x1f\xe1L\xd4\x07\x97z\xe8\x1d\x9c\xd2\xaf\x05r\xef0Mzrf\x13\xe3\x00\x17N;h\xcd9\xa3o\xc0e\x93\x13\xbf\xf8\x84A\xc9\xe5\xa4\x91\x02\xbeg\x8b\x82a\xff\x9fpL\x92[\x871S5\xdb\xa2\xb0\x89\x92T\x87\xf3\xe6\x10\x97\xf5\x05\xaf\x1cj\xb8\x81e\xb5T\x18 \x011c\xfc\xdatw\xd3\xb9^2N5a/.\x09\x13\x04\x85C\xa8\xc1#Y\x9d=\xec\xcf\xbeGf%a/!\xce\xfc\xa4\\\xfa\xd3\xe7\xd0,\x8d8~\xb1\x0c\x9d\xea\xfb7Q\xdc\x80\xfe`o\x9d\xe8\xdb\xf0N\x08\xfd\xaf`\xa09\x06|!\x8c\xf1\x1d\x93H\xb5\x1eFS\xcf;\x89\xd8}wq\x13\xd7-\x9a\x18*>tK\x9b\x9c\xd6\x99\x08\x0d^=`\x81\xca\x1e\x0bTn \x8c\xd5tRA\x08e\xca\xc8\xe3\xd3!\xb84\x0et\xb1*\xca\xab\x1c\xa0e\x16\xa7!-\x12 ?\x10q\x18\xfdL\x9cE4&\x0e\xa1`\xb4\x87u\xfa):W\xea\xf7\xd8\xe7\x0f\xf7\x1d\xa1_?\xf3\x06\xe2\xd7\x9fM\xca\xbd\xec\x09\x95\xe5\xbd\x16\x9c\xa2\xdf\x01#{\xf6\xe662\xdau \x95\xb5\xfa{\xb8w\xe3L\xd2\"\xee\xdb\x18\x88v\x99\xad&Q\x99\xe0\x99\xf4\x95\xeb\x9d\xc1J'k\xa3\xce3\xa21J\xea?{\xe8\xa7\x94\x95\xb5\xf3q\x196@\xa2A\xe9Ri\xad\xc3\xdb\x10\x83\x8d\x02So\x82\x07<\xc6\xfb|\xf6\xb6\x10%\x98\xb5dRH7\xb7J\x93\x9c\x1f\x7f{N\xdd\x88bj\x11[9\x01\xf6\x1c\x9d\xfe\x0d\x8d\x9d\xa5=B\x1f\x88X\xc3x\x85\xa0\x7f\x18\x9a\xcd\x0d\xfd\x85j\x97v\x15\xb1\xddTs\x0b\x9f8\x95)\xad\xbb'\x09\xedL\xa5\x96w\x1a\x9b\xd0|\x80\xa1\xbaw\xbf=DS\xf5\x02SdYJJ\xed\xa4Pt\xf2\xfd\xd177p\xe0ZT\xca\xdf\xe0\xec\x8fN\xea\xbf\xc56\xf8\xb7\xdf\x80\x81\xf2\x17?\xd5C\xc2\x190\xf3\xbe\x7f\x84q\x80~v\x8d\xf7m\x18\xf1\xee\xbcp\x99\x98\xa8\x12\xba(\xff\x09.s\xcb6\x04\xf6s=\xdf\xb0\xb2\x0d\x88:\x087,\x82\xfc\x1f\x98S\x82\x10\xcd\xa1\xac\xa6\x85\xc6\x10I\xcb\x15\xa3f\xa1x\xdf\x9c\xfc\x95d\xfe\xb7\xd7\xd9r?&\xe8\xb4\x1cU\x01\xad\xeb\xa4hi\x80\xb3;\xec\xf1S\xef\xc7x\xe5\x09\x8d\xfdD\xe1\\y\xadSK\x1d\x8f\xc4\x90\xb9617\xefG\x18\x1e\xa0\x9b\x05(\xcf\xcc\x85\x88\xc2\xddF\x0e\x96\xfc\x0bl\xa9\x7f\x1eag\x90\x8f\xf4\xcd\xc6\x86\x11C&1\x9c\xa5>\xf6\xf7\x0e\x9e\xd0J\x10d\xbd\xf4\x91\x0b\x04\xfc\xc8\xeb\xd5x\xac\xcd\xb9\x819\xf7c$\x12\xae\x95\xe8\xa7\x83#M\xddOp\x0eg\xd1\xec\xdb\xa6\xee\x1c\x0d\x96\x0d\x0d\xa4=\xfd\xd8&Y\xef\x9c\xbb\x95\xae\xefb\x11^h\x9b\x8b\x1fz\x88\xf7\xa2\x15sY\xb4\x8f\x06\\Z\xa4y\x0b#\xae)\xf4\x8a\x99\xcd`XZ\xa0S\xaa\x0a\xb4\x8d\xe6\xe4\xe6}\xbb72\xfc\xb3\xe5\x89\xc2\xbeG'\xb4\xd4T-f\x99\xe4\x18EZ|\xb1\x98\xe2\xbe\xf5\xbdn\x14P\x98I{\x9e\x09\xbaIE\x043\x1b L\x1eY\xa3\xf2\x09\x14\xd7\x9d\x18\xc3\xc6\x80\x95b\x97\xe9\x1a\xdc\x84\xf7L\x9d:\xb96\xcb|pvm\x7fK\x92\xed\xfb\x85\x18\xcdC0\xb5\xfa\xaa\xee`\x14\x94\xb3\x08xH\x03J-f\x09Im`\x10a\x9fM\xf1>2\x80\x1f\x85\xf2\xd9]'\xe6\x8d{\xe9\xd1a\x00\xbb\x917\xa0\xa7G\x8b\xdfR\xa3\xde35\xaajD\x1e\xa0Q\xc9MZ\xe3\xce1|\xd2Bw\x10\xa2\xc9\x0bv\xba!\x1d\xe1\x0bp\x1d\xc46}U\xd9B\x18%hjl\xc4e&O\x90\xda\\F:\x1a?J\xdd\x90\xab\xa9\x8dR\xb6\xc5\xd5\xee\xd8\xb7\xdb\x9cW\x87\xae\xe8\xa6\xab\xac\xa4\xaf\x16x\x07Z\xb2\xfc\x09\xba\x93\xab\xbd\xb0\xcfz\xf4\x80\x85\xee\x8c\xf3\xd9\xe9>.E9t\xb4*\x94\xb1\x9d\xf6\xc9]\xb0\xb1DVQ|\xa1]\x0c*\x84g\xb0PB4x4\x84\xbc\xd1;l\xedz\x81`\x86%E6%L\xe1\x90\xb4\xc2\xd2\xe9\x06x\x01\xba#&\xff\x8e)Gk\x0a\xb0\x13\x02V\x0f2\xf9\xd3\xbd7,Bi\xfeK\x8f\xde\x15Q\x91\x96\xfctM3i\x1d`\xf1\xfcu\xb1/q5\xee\x8f~\xd8\xe3g\x03\xbdmT\x9b\xcb\x0cs\x1b7T\x0d\xff\x04{c\xacG\"\xf9[\xd80te\x9f\xdd\x06[2[\x9eZ\xbbp\x10Z\x8e<\x07\xcdE\xb1m\xab\x07\xd1\xd7\x86\x1c\x19N0\xc8e\x93B\xdbz\xf4\\4\xc4\xce\xb0\x8c\xe2\x87\xb4\xd6\xdc\xdc\"\x0dN\xd9\x87,\xda\xdb\xbcxn~&\"\xad\xed*\xc9X\xa4\xf9It\xc8\x85\x0c\xfaw\x84Z\xae\x00\\\xdf\xdc,\x83\xd80\xef\xdeA&}\\\xca\xd4\xebR\x82)\x1c\xcf\x0dK\xcbd\xd5\xb8\xdb\xd9N@'\xc9\xf4\xc3\x80\xc6\xa1\x1e8\xf9\x81\xba\xca\x1a\x13;\x0az?$\x8am\x82\xef\xe2a\x94\xce\x8c\xa2Ba\x0b\xf5\xbd\x8b&\xe7\x09\xbd\xc7\xe4\x00.I\xfc\xb6\xfa\x89M\xc8\xa6&\xf8=\xf4L0\xaaWq\xa8\xd2\xe1\x0e\xd0\x1fE\x83)\xbbc\x08<\xf1\x05\xad\xce\x09\xf1x^\xe7{\x8e%\x1c\xd1#\xc3\x86\xccK\x9a\xf3\x94\xb3\xb2\"%j\xc3\xd9\xfe\xc8\xe1~l\x03\x84\xd3\x87\x83\xb7\xfa\x0e\x9a\x96\xa0\xb9Sd@P\xdd\x89Z\xdc\xd13\x0c0\xe9\x18@\x8b+F\xaf\x9e\xfcNb\xdf\xa7\xb6a\x94(L\xcd\x0b\x8c\x01\xe2\x9bD\x12\xe2%\xc6\xb24p\x88'\xa5d" +
+	"\xad\x05\x88q\\\xc6Y\xa8\x083S\xad\x87A\xe4\x95\xd1Z\xe2/\xd9Q=:\x15\x17\x17D\xa5\xe8\x8b\xf3\xc5\xe9~H\xd1\xc7\x94op\x14\xcf\xf6\xe4a\x17\xb7\xc1\xa3#\xa7\x00&\x0dda\xc6\xb8\x7fP\x83\xe1_f\xbc\x94G\xdf@\xf5\xc9M\xb0[\x13\xdak\xdc\x04\x09u`1\xaaG!Q\x8bc\xcfD\x88\x04\x8blH!K!;\xea\xb0<dG\xd6\xfc\x92\x17\xa9\x00\x1b\xf5\x19\x86\xba?\x8aE\xda@\xeb:\xc5\x95\xd9\xa3(m\xf5\xe4\xf7\x16\x15G\xee\xefd9\xa3V\xa6bQ\x1b\x8a\xe0\x85\x8bj\xe2\xab\x8e\xfc\x0b\\\xc6\xb4\xf4\x16#\xf0z\x8dY\xc9\xa1(\x06e\xe6\xf7\xdf\x96(\xc6f\xe8|\x8br\xb9\x01T\xf5\xe2YZ5m\xb9\x16\x07?W\xbf\xa1\xd2!\xe2VU\xcaY\x0c\xe8a\xe5\x18e\xd3\x04\xa2\xda\xc6\x8a^!\xa9\x0e\x8dq\x01\xa4\xce6<\xd6f?e.\x08-V\xb4\x80\xdf\xa3\x0b,\xd9\xf4\xfa\xa1\xdb\xc3U$\xfa\x8dw\x16$\xaa\x15\x99\x08eL\x84e\x12\xe0\x8da\x91\xb5\x95\xc5*\xb8\xc3T9\x95\xe5\xf5sf\x19\xe6\xc2\xa8\xfd\x88\x901\x9d?1\x8c\xa5\x14b\x05r\xb5L\xd4(aF(a\xc7fH\xa5\\7\xdf\x8f\xd3\xca\xf8}n\xd4H>\x9f\xe2\x04\xef \xb8e=)0!\xd4\xca\x10\x9a\x0c\xaeB\xb2\x82\xb4\xeby\x0f\xa7\x9e\xbc\xd4\xc3\xc9\x99\xfd<\x96\x81\xde\x93\x85\x8f\x83q\xa1\x1e.\x02i\xfco\x9e\x05\x1d\x84\xe9\x17\xaf\xc18\x15j\x9b\xc5\xb7\xfe\xf6:\x1b\xf4_\xbf\x0e\x05\xb0KI\xf9\x08\xe8n\x9aD\xc5\xaf\xe8\xb00fW\xca\x88\xcd(\xc8 Q\xa7\x95W\\7\xde_\x94\xf3cx\xf2\xf2S\xefVDp\xae\xc4\xa3\x0b\xb1gK\x993\xc0\xc9\xef\xc3\x87\x02!\x0dU\xdbX\xa3\xdb{p\xdd\x81\xb6B\xea\xfcp8v\xfe\x8d$\xcd\x09H\x1b|\x9c\x91F\xac3\xae$\x0f\xc4\x97e\x18\xab\xa8\xcb\x9aZ\xcf\x13\x9f#%\xd6IY\xc7\xd2\xc35 k7K\xad_jj4TM\xe1\xf0\x11\xfa\x99\x89\x9c9\xfb\xbf\xb8(8/\xb2\x82\xd0A\xa9\x83\xf0\xc0\x1b\xbc\xf4\x17\xaf\x17\xb0\xbf\x85\x05\x8c\xf1\xec\x19\xb7\x896\x02;x\xb4!`\xac\x90y\xcf\xa4\xba\x972\x8a;C\x1b\xdf\xa5\x8b\xef\xa9>\xc1Xup\x1ff4\xd3\xb1\xba\xeaS\x1d\x00\x0f\xf8Y\x1d`p#\xa9\xe1E\xf0(\x97:$\x15\xc6\x06A\xf0.ZZ\xf6\xcfU\xd1\xff\xc1\xcdb\xf4\x0d\xc4\x82\x13\x1e\x19\x1cG\x7f\xdc\xe4\\\xc7\x1e\xbcxS\x9c\x99d\xffaT\xfe\xe2\xe5\x8e\xd1 \xfd\x04\x83\xdb\x7f\x12\xd5\x15~\xe34Z\x07\x93\x1f\xaaK\xdd\x0a\xcf\xc7'\xe8\x8c\x14\x9e\x0ala\xc74X<\xa6T\xa0\x94\xc3\x97B\xa3\x1e\x06\xc6\x9fj\x953es\xf8\x8e=\x1fk\x12=\xcb\xda\xc18K\xfa\x9b\x08\x93\xbf\xcfd\xccbg\xe9\xa2g\x13)\xdfqm\xd4\x08hx\x86\x85,<vy\xff\xa9\x96\xa5\x93\x11Z\xc7\xd2\x85\xf7\xf5\xe0;?\xf2\x12D\x829\xb2\x81\x7f\xc8\xeb\x16\x93>2'7ur\xbeH3\x98\xce\x16-%\x96\x03\xb1qZ\xe6.\x7f\xa4\x8f\xfe\xf2i\x91\x7f\x92\x99\xbc\x89\xfb\x95)Pv9k\x80\x98^=\xddf\x08\xbf\x08\x11i\xb4\x15\x85\xe2\x86\xe5j\xe8\x9a\x9c?\xbf]\xe40\x92\xaf&\x04ea\xa7h\xa0\x17\xa1\x02<4\xe9H\xeeE\x93i\xcbu\x80\x12\xb6y\x06\x84\xdd@\xac<\xc7\xff\xfc\xbc\xa8\xc3\x87\xef\xcf\x92\x8b-\xb8\xbeH\x8f\x80{E\xe4FP\x94\xb9S\x0a\xbb(5\xd4\xa0@\xe4Q\xd4\x17\xd2Mu\x11\xcfw\x86\xc1\x8f\xca\xf3\x07\xed5\x1a\xedg\xcb\xef\x1a3[\xc6\x0f\xc3.\xdb\xd3\x0f)\xc3\xe8\\,Bc\xbfU\x05\x12\x8f>\xf2\xeb\xa4\xa5\x1dt\xa5>\xbd\x92O\x95\x89M\xdb&\xac;(Y\xb7\x8907S@G\x80\x87\x9fC\x9c\x89P.\x82M\xda\xcdJ\x89\x0b\x8f!\xac\x05\xd7\x9a\xed\xf9r\xb3\xfd()\x0b\x8d\xc2\x87\xb5\x94\xfc=\x8e\xaa\xf8\x06\x86\xba\x85\x0a&r\xf2\xd4\xed\x1d.\xd0\x84\xa4b\x0f\x9d\xef\x99\x88\x92\x03\xc0\xc5q\x17FT\xe8\xf7\xa8\xa1\x9f\xe1\x11d[[\xae\xd7\xba\x81sY\xf5\"\xe3`\xb0\xe5UI\xb5i\xfaD\xfc\x15\xbd\xc8\x9e~\x90{\"S$\xe5\x08&\x87\xfd\x8a\x8e\xc6\\TqP\x06E\xd6L\xdc\x99;\xa8\xa2D\xcbY\xa6\xac :\xd2}n\xb0\xb7\xb6&q\x9e]\x03\x97\x0f\x14\x93\xedo W\xfe\x98\xe6\x06\x12\xd23+jl\x96\\\x18\\\xc3EzK\x15\x91\x9a}\x8d,\xbf\xe8tmWn\x80@\x011\x8eR\xees\xb6\xa6g\xe1N\xca\x1c\xb4q\x81kAd\x90\xbe\xc0\x822h\x1a\xce\xb8\xb2'%\xc6yY\xe0\x15%\x10\xb6\xeb>\xf8n\xc5\xfb-\x90\x8c\xbc\xcbM\x11U\x0b\xc5\xf1\x1a\x0fLY3\xaf\x14>\xb2\x0aD\x82\xaf\xef\xfc\xad\x84\xd0-\xf8\x02\xaaX\xeb\xc6c\x81\xb4H\"\x9f<\xecO\x9e\x96\x8f\xaa\xfd\x1c\xdc\xa2=\xfd\x10\xdfx\x02\x144\xcf\xecr\xda-\x0d#,/G\xf5\xa7\xfa\x02\xd3\x1e?\xd7\x8c\xb1\x94\x03\xfax\xc5 \xc3\xea\xb8\x96J\x8bHGqst\xe34\xb8\x83\x1f\xdd\x96\x8a\x13\xde%n\x19\x81~l\x82[\x01\xd9\x94=\xd1]\x9d\xed\x910Sv3\xf1E\xae\x89\xbbr1}y\x96\"\x9d 7}:\x18\xe0|\x039t\xd6\x87<\x0e\xf7\xfc\xb3B\x0c\xb9\xdc\xce\xe4y\"\xc0i\x91\xf1\xc3\x8202\xeb\xe9\xbfIIT\xcdN\x9cl\x9d\x8apW\xba\xa7\x1c\xd6\x96\xe0\x17eU\xd4')m\x9aK\xb3\x001/\x03\\\xd2B\x12z\\\xa3s1\x17<\xc8\xc2\x90W\xb9c\"\xb5u\xb2D\xe2\x88\xda\xe3'y\xe6\x1a\xae\xa0\xb5\xcf>\xf0\xaf\xd0\xb8\xa1$x\xb8C\xa6\x86`j\xee\xe5\x17\xa9ee|\xf07\xd0\xb6\xf0\xab\xf6\xa2\x0f\xb93.\x9c\xdeEG\xaa\xab\x87c\xddP\x9a\xfe\xe0\x8f\xd0\xec\xca\x0d\xe6'\xdc\xae\xe8\xbc\x9c%N\xd6lJ\xe8y.v\x8eQ\x90\x18'\x8d\x14\x8eb\xcf'\\\x95\x01\x82|\xef\x0e\x19\xcb\xcd\xef\x07+\x1a\x8a\xea|5(\xaf\xe5\xd6\x80@\xfd\x1a\xe2v\\PCR\x0e\xd2Pe\xfb\x00\x8e2\xdbv\x12\x91?n$k\xe6\xf6\xd8\x0fP\xb8D\x94\xd3U\xcbsW9P3:\xab\x852\xe7\xcf+\x15wV\x92WJIf\xb4\xc5i\xd5M\x12w\xb0h\xf8\"L\x86\x1a\x0a'\xa5\x91}6s%W\x18\xbf\x81\x8e;\x02\xc6xs\xb5\xbav\x85\x8d6\x1a\x10\x1ch\xe0\xda\x98\xd0pI-m\xb6\x94\xd9\xbc\x0aMtd\x9bU*\xf3\xe4b\x08\x807K\xf9\xd2R\xc3%\xa2\xd7\xf9\xba\xb5\x06qeTR]z\x1eO'\x98\xc4\xc1\x08\x1d\x91&f\xcc\xee'\xefY\xe2\xc2$\x00P\xaf\x08 Y\xa8q\xa5\xa0Z\x1f\xdd\xf9\xb4\xe4\x07\x81'\xf2\xe2M}v=\xdcF\x83\xf7j9nm^\x94\x8d\x14\xf1\x1e$S\x97\x93\xfc\x88\x0f?\xfaA\xf2'\xe1\xf5\x07.\xe60\x8e\xed\x10\xacn\xdf?X\x19\xaaH\x8e3cl\xcd\xc2\x97!\xe0\xc4\x07\xd4\xf7u\x03\xfd\x9e~\x84\x9a\x0c}\xa6\xd8\xdc\x834\xf7V\xe6L\xc1H\x16\xaf\xf1\x81n\x9e\xa1\x95\xf0\xfd\xdd*\x87\xc3\xab\x97e\xad\x8bIx0\xb2\xd4\x86\xd879\x84St\x16\xc3\xf8\x9aG\x99\xe91\xa1\xbf\x87/p\xd8-\x06Et\x13#\xb3\xe7\x08\xd5\xc9\x0f\xa0z\x7f\x1d\xb8~\x1f>\x90\xb8hy\x15d\xa4\x03Ol^\xd7\x94TA\x0d\x01c\x82H\xd4\xb6E\xb7\xf8s\xfb\xb9\xd9\x87\x8fo\xe9z\xd7)\xbfq\xeaa-\x13]\x8b2\x0a!u\xa4Q~\xfc\xbb\x08o\x11\xb2_\x0b\x921T%\xba:\x94o\xaa\xc6Z+L\xf1\xd4M\xcb\x08.5\xfd\xb0*\xc2\xb0m\xe3\x05\"\xc9nX%\xf5\x12\x1b\x85*l\x0b\x94?\x8b\xb6\xbe6\xc9\xc1\xc3\x84\xaa>7\xf0l\xff\xb5\xb0\xec\xb4\xd0\xb8}\x8b\xae\x1c\xc5\x93\x03\x886\xbcz\xb4\xd5\xc4F\xcd\xa9:#\x8bF\x97\x00\xbc\x1f\x18\xac\xdc\xe1\xb3\xc9\xdcoX4\xb2\xab[\x90\x12?s\x00|3\xf8_\xf3x\xf1)\xfcO8$\xa2\x15\xb0\x92H\x14\xcej\xfd\x05JE\xc3\x9a\xd9\xf0\x17Su\xf9d\xb96\xc4.O_\x17\xab\x88\xf4\xdd\xd1O\x86>\xc4\xb8?\xdf\xde\xf03\x8c~\x029\xcb\x84\xeew\xd5R\xcd\xe6\x93Jms>G\x19K\xe6\xa0\xea\xae\x16\x84\xbd\xfd\xae\xbc\xb3\xe3\x9dn\xe0\x04\xdfD\x0f\xbc\xf3\xd5\x19\\\xb1\x11X]EX\xfcd@\xb1\x8a\xa7\\\xc2\x9ei\xf3\xab\xbb\xb4\x9e\xb3\xf1\x8c\x0d5\xe2\x8c\xe1\x06b\xd9\xf7\x0f\xd0\x98\x9c\xa6F&4\xb7A\xa1\xab\x8f\x06\x1e\xa7\xc9\x0dDR\xb9*!\xb5\xb5\xd8\xa2\xf2q\xbc|E\xb4\xe4\x92\xd4W\xe7g'G\xe8$'\xb5\xd1\xf8k(r\xbb9Ka%\xa2\xa2\xb7\x05Hfv\x8a\x9d9\x8c>h\xf1\xafQ;\x95Y\x09\x92\xe8K\x04\x96%1\xed\xe5j\xe9\xc1\x9f\xdc\x0d|\xea\xdf\x85\xb7\xc6\xbd\x80\xd4\xbe\xd2\xcd\x8c\xc0\xd4\xd5\xa5\xee'\x87\xfb;mmK\xad\x17a\xd6\x02\x85\x02\xdfU?\x06|\xc67\xef\xe1\xb8\xab~\x19\xa1\xf3G\xae~\xfd\xe10\xc0\xd9u\xe8c\x1d\xfd\xd17\xd5G8>\xf0\xcc\xeb\xebS=\xb8=\xfdH\x10\xfe~\xec\xe1\xfc\xed\x93\xea\x97\xba]zl2\x0c\xf0\xb3J\xd1\xffe!\xefd\xa9\x9a\xc4H\xb4QV\x83\xe2-\xe9\xa8d|49\xe67\x85m\xdc\xc0\xce\xbeF\xbd\xbb\xab\xdaz\xbe\\\xedllCL\x11\x9a\xc6[C\xfa\xcce\xae\xca\xf9|\x9e\xb1>\x7f\xcc\xb6\x8f\x8d\x89\xa9n\xed\x1e\xb1\xa3\x98\xceI\xa2ef\x7f\xd9\xddLE\xa1;5\xb0xu\xc1\x98N\xa7\x0b\xb3\xac\xd4\x83:W\xb1\x12\xed\xdd\xd7H\x8b\xde@\x08o\xf8\xe8\xfb{\xc3\xf8_t\xff\xca\xbd\xb3\xc9k\x0d\xbc2\x96\x1d]\x18\x99\x0a\x98\x86\xd6\x0d\x186\x02\xb7\xa7\x1f\xcb\xee\x01\x97\x1d\xc7\xcbR\x9cpo\x91N\x078\x85,\xa5\xe3[~\xf3\xfe\xd8\x0b\x95\x1b\xfbY)\xb4\x9eW\xe9z\x84\xe1\x9d\xdd\x84\x11\xbd\x90\xa2\xb5\xae0q?\x9f\x8b6X\xd4\xda&lw_r\xea\x1b\xdef\xa1/\xeeS\x8a\x90\x0f\xf6\xafk0\xbc4\xc0\xc8Y\xd5\x94C\xf0\x17!\x86\xa4\xc1\x03DPJ\x1b6\xb5\x80y;\xe5\xf0F\xf59]\xd5\xfc\xe0\x9b=~\xae\x85B#\xe7\xf8\xc7k\x8e\xe5M\xe1\xebYN\x83}\x97\x8d8bi,\x00eO~^\xd4]\x08\x17\x08\x85\\)NA\x81\xf6\x82\xb70\x92\x0b\x99\x15\xfe\xc3\xd8B\xa7A\x19\xc4\xa5\xcc+\xd4R\x1e\xde>\x14\x8e-(&r(X\xf4\x84\x93\xb9\xbe\x8d\xb9\xc3D\xad>9H\x1f\xb6u\xa1%9q\xa4!\xccd/\xb0j@s\xe2\xbae\xf4cbZdg\x0bE\xf1\x02K\x17\xdahi\x09f\x16\x90I\xb7\xf6\xd2\xbe>\xcb\xf0I\x02\x9c\x98\xcf#{\xff,\x85\xa5k|\xa2\xbas\x1b\xdb\xbbR\xb5\x96c\xf8*\xe3\xe1\x9a\x01\xd2\xc4\\1\x8d\x7fo5V\x92\xd8\xf3Y\xcc\xb2Y\xafQ\xd4Z\xd1\x9c\x8dzl\xe8\xf0\x1e\xeb\x86\x07Cz\x84\xe6v\x0a\xc9\x98\xb6^\x0e\x80\xbd~\x95\xa4\xe7\xc6\x9a^q\xf6\xcc\xa9y\x91g\x11\x92\xb2p>\xa6qM\xf4\x16\xf8\x80d\xf5\x92At\xcc\xea7Nk~&k\xc45Tc\xa7\x00\x9c\xe5\xe0\xf8\xeb\xd7\xc9\x0b\x1aV\x83\x83o\xe9\xcdxy\x92Z+\x8b\xc4\xe3B^~{\x82\x90\xeb\xb3\x92b)\xaf[i\xe2V\xf1\x1a\xbe?5n0\xba\x19<UR$\x04\x9f\x85\xa3\xaaI\xa1\xff8\xfb\xfc\x09\xb2\x1bD\xb7{\x1d\xb3\xfa\xfa\xcdR\xe8o\x82\xb3\x9fW@\x99\xb0\xdf\x00g\x18y\x86\x1e\xdch\xeal\xb8\x0b\xf3s\xa1\x83\xc9^\x80k\x12\xf0=Ogp\x87\xe5\xa7\x8c\xce\xda_\x97\xf6\x8dYi|\x92V[\x91\xc4\xeb8\xf1\xc5S'\xc3\x1c\x88\xf9\x10.\xb2]\x02r\x9a<\xe4F\xf2\xfa|A4\x0a\x19\xcd7\xd6M}\xc4u\x81Q\xcf\xb6\xcci\xc9\xc3\x176B]\xae\xb3\x84\x15\xe6\xe9/|P\xe2\xf7\xec\xe6I\x91[\xe3\xdeo\x8c\\<\xf9t\x9f\xcf\x9f\x09\xa3\xd3!\xcc\xdez\xdc\x13H\xc1\x019\x80\xcb\x87nL\x1c\x06\x03p\xdb\x80\x04\xeb\x8f\x10\xa0u\xd1\x7f\\\xbe@\x80\xa4\x9fYO\xb0A\xfe\"\x82~\\\x11\xfa\xa6.\x16\xdd\x8fl\xfb\xfb\xdb\x15E\xf1\x1b\xb1\x16\xb7\x97\x8c\xc5\xba\x9f\xf5(\xcd\x9b\xbb\xc5\x08\xf5\xd11@\xb5\x89(=\x04F\x0bBO\xca\xfb\x0c\x80Ka3t\xa6\xb1\x82\xac&r\xcc\x9a\x14\x0c\x93\xd17\xd7$\x9c,_\xe8,AWF\xb6\xcd\x0bj\x18:\xbb)\x00\x0f1\x8f\x07= \x85\xb6k*\xd7\xbb\xb5(\xae\xcb\xcb\x8aM\x06u\x14\x1d\xcb\x1e\xc6M\xafA\xf2\x8c\x9f\xfajU\xc7\x9bT\x9b#\xb2yGXE\x00\x97I\x91\x96\xc1}\xbaQ\xc9\x91$\x1di\xd8pR\x9a\x03\xdd\xdf\x12n\xe5\xe5\x87z\x9e\xc2j217Q\x90\xee|\xaai\xaf\xc1\xdeD\x10\\\xd9\x8d\xa4Q\x16!\xfa\xc4\xd75\xef\xb8\x9d\xe8\xbe\xe7=\xf4\xc1\x94\xde1\x0b8^\x81\x91e\x8d\xcf(\xdd,y\x0aW\xa1H-/i!\xeb\x89%d\x17\xed\xa6\xb8\xae\x17\x91\xad\xb8\xa6$\x8c\xb9aT)\xc2\x1ec0\x92[X\x80\x8d\xdb\x12\x05\xba\xe8\x15l\xdc\x9b\x17e\xd5l\x8e\xc8%\x11\x96\x07El\xe1\xbc\xbf\xaf{\xf8\x8d\x0a\xe1\xec\x8e\x07\x93\x9b\xa1Mv%\xccn\xb0\x0e\xdc\xe0\xc8=\xfeL\xf1\x8b\xa3\xab\x1a\x7f\xf0\xc3\x9c>/e\"~\x04\xf2\x8c\x1e(\x01;]Y\xf8^\x96\x9b\xe4\x8f\x86\x84\x95\x1d\x12\xf5<\xf2\xe5-\x05\x88\xe1_\xa8\xda\xb9\x88\xf5z\x0c\xba\xebM\x82\xee\x02\x16\xeb0\xf9F\x9b]\xdc\x88\xddb\xb4\xc3l\xb4%1\x8b,\xbe\xbb3]?\xeb9\x1bw\x90\x8aE\xedM\xbaF\xf4\xe7\x8a\x88\xae\x93\xbf\xcc\x86\xddB=OR\xdd$w\xde\xael\x11\xdf9U\xa6\xd0\xfa\xb0#,\xcfC\x9c\xa9\xd7&\xde\xeb,&\x81\xeb\xc2\xb5+\xeb3KYp^\x9c#\xd8\xe5\xc6\xf7\x9f\x1c\x8d}[\xc6\x9e\x0bb\xa0\x93B\xce\x12\xf5\x82\xa5\x19\xa5vY~\x83h\xbcS\x945\xf2\x7f/B)\xe4\xc5\x8e.A\x88\xddZMu5\xaan\xe4\x1c\x04\"\x7fC4^\x03g\xcb\xef\xfa6\x8d5j8\xe6\xa1>:\xf2\x7f\x8d\x9dh\x82\x87\x1a1\x0cV\x8e\x84\x11\xb3\xe8\xe1rn\x8aY-\x8c6\xc5\x0dq\xbd\xb7O\x93\x98\x01\x05\xe0\x85G8\x84\xf9\xb6\x0b\x14\xbc\x9do\xf0U\xbe\x09\xa5z\xbb\x82K\xf3~\x80C\xf5\x1d\x8c_\x1c\xad=\x1c\xe7\xc5\xd9\x95\x18\xda\xc5\x09\xa6\x00\xd4\xd2\xdd\xd1O\\\x00\xd8\xedhB\xd1\x86\x01\x1d\x0d\x8b`\xbf\x07\xf3\xbd\x85f\x91~\"_\x0eL\x80\x9a* nuH\xdeF\xc2Z\x07G\xc0<\xb2\xc9gg\xbb9\xbd.U\xbe\xcf\x1e\x0d+\xc8v\xa55L\xac\xb3\xf2y3WOm3K\x96\xab\xf0\xe8\x9d\xae\xe3\x99\x00\x8ck\xce-\x8c\xab\x9e\xc4/\x8a\x1e'\x0b\xdaH\x83F\\\xb2h`\x97&\x13\xa6\xab\xc3\xfc{\x9c,\xee\"\x9f\xcf\xdbg\x96\x96\x9e\x08!9\x9c\x16\xc6\xc4g\xa1\x19\x98=K\xd6\x06I2*d=Yk!)\x87\xd5\xd9\xd8\x1fa\x00\xcc\xe7@\xeb\xd5dI\xf8\xdf\x1a?\xef\x17=Q\x1b\x0b3[\x118\x96Z\xd2h\x0bGl6L\xb6\xc7>\xf9O~\x80\xbe\x027\xd0W\x02\xc5\xa8C\x9f\x9c\xe9\xe12P\xc5\xe3\xc6\xc9\xf2\x90\x7f^\x84m\xd2\x88\xd8\xcc\xc6\xc16B_\x8a\xfa(\xb2>\x97\x0aU3\x90w\xbe\xea4`rL\x8f\xd8%S;\xd8r\xa3\xc5\xb0\xbbn\x92g\x9b\xd5_\xdf1\x99\\)\xe7pY\xd1\xcb\xb9%\xfd\xb0-\xac\xc1\xb1\x90(4\x93\x0cf\xe3K\xf0\xf0\xc9!N\xec>E\xa6\xa2m\xc9=\xfd\x979\x11Rz\xfbw\x1c\xbc\x95\xd5\x0d\x8d/\x9c\xb7\xf1\xa164\"\xb7k\x14\x1c\x9d\xe1\xfc\xb2qdN_w>&\xa9{ \xd3?.\x1b\x99-\xa8\x05\x0e\xee\x8c}\xc0\xc2\x00s\x16\xdc\xa3k\xd4\xcf\x94\x9b\xfe-\x95\x9f1\xb1?\x1c\x1d6\xe1\x8c\x93\x94X\xa9\x0a\x8f\xdf\xf9<Fz\x9b\xdc\xc2\xdb\x97\x91\\\xb7\x8d\x8eCF^\xa0\xf7\x100\x8b\xa9\xcdL" +
+	"\x06A\xb1\xfb\xd3U\xcd\x14\xd5\x811\xa6D\xc7\x93\xbe\xd3\x8a\x0a\xfa\xfa\xdd\x8d\x027H\x0ak\x99\xac\xf9\x10\x09v\xbc\xd3\xb8\x82\x04\x15p\x95.G\x97\xaf\xb7\xdd\xabl\x16-\xb4\x1a\xb3@\xe1\xdb\x13\x9a?\x8b\xb8\xfdbT\xd5\xc7\xbaq\xc4)\xb6t|\x91T\x85\xa6Dr\xf4\x82\xbe\xd6\x1ar\xf1m\x11p\xc6F\xd6\xf1\x8b\xa30Z\x12-\x8a\xec\xeb\xc749\xa0\xa9<:\xfc\x0b\xdc\xc30_\xbb\xce\xbe\xf1\xdd\x1e?#\xcd\x06;y\xf4f9\x9f\xf3l\x07\xef\xbe\x0e{\xfe\xf6\xcd\x1c{\xfey\xf1\xad\xe7\xd5)\xc2\x9ce\xd0\xe4\x09\xdb\xe0&\xa5Q\xd9\x8a\xb2\xb6\"\xec\x89{}\xc7~\x05P\x0clr{\xfc\xac:\x18\xe0@\xb4\x9b\\\xc1\x18QS\x0a\x1bIL\xc7xFI\x8e\x85\xa5\x0ec\x0b}\xbc\x1e\xc9{\xfb\xf6\xf74\xd1c\xd8\x1c\x1e\xdf\x9c4\x14e:\xd37j\x03 \xf93\x9f8\xbb}\xf9\x9c(\xc7\xf0\x99\x07\x9b-\xca\xce\xe6\x9c\xa9\xac\x94\x08>v\xaa\xd0]\xe6U\x8d\xe6\x91\xc8W\xd8\xd8\xa7\xda\x8a\xd7\x90f2`\xf4VB\xb2\xa6\x00\x0aCX6\xe8\xd1m\x7f\x0c\xb5\xd47\xear\xdeH\xd5}\xf7\xf4\xe9b\x98]\xb9}\xe9\x8a60\xb8\x0f\x1f\xd5\x06\x9f\x9a\xf2\xff\xdb\xb4&.\x0a\x97s\xf0h<\xc3\xb0$\x93\xceT<YR\xfc\x00\xae\x91\x02\xa5\xc2\xc2h\x1c\xeb\xf0\xd2\xc9\x11\xb1\xfb\xdf\xb7W\x1d\xe5}\xc9]N\\\xe4U\x91\x8d\x8f\x7f\xac\xf7|\xf7\xf4\xee\xe6i\xc83\x073k\xad\x0b;i\xe7i\xa6\xcc\xc1G\xb8N\xbf\x8b\x84\xc7[\xe3\x9eZ\x80\x9dC\x9a\x01}\xa9\xa9\x1e\x82)\x82\x0b>E\xf4\xec\x92\x1f\xcc\xba\xce[\x8e\xae\xd9\x92\xaf\x90?\x82\xc2m\xc3\x19.A\xc2%\x07>\xa9~\xa4\x05\xd1\xec\xb1\x1f\xb0\xdc\x1f\x0bQ\xb1@\x1c\xda\xb8\xa0$\x07\x96l\xae\xe8b_k\xf7g\x89\xfav8\x97\\<\xdb\x8e\x98\xc8\x8c#\xa5\xf1\x0e}\xf1}\xf8(Ph\x7f\x0a\x13r8\x80g\x12\x10\xb6\x16MA\x91P\x8f\xebW\xf1\x0e\x08+zR}\xf4G8\xef\x91\xf8jQ\xe2lE\x16\xf5wh\xf6\xde=U\x1f\xec}wpx9\x81\xe6q\xb1\xe4\xc9i\xc0L\xde\x9e\xdfH+\xeb\xacw;:\xf4\xd99\x07\x14H\xad\xe7*s\xc7\xcf`\x0b\x88\x0a/\x16\xd6l\xbch\xb8\xfa\x861I\xc8\xabW\xf7S=@'\xc983\xd5\xff\xa8M\xf2\xee\xe9\x8b%\xc8@\x1c\xcb\xbb\"r`.\xc5p\x86\xee\x00{\xfa\x8cY\x03>0\x82 g\x17\x93>M\xaeXX\xf5\xbf]~\x8b$\xc70\xf7\xcd\xbe\xa3\xf1(\x9eE\x02\x80M3\x1dsJX\xc6\x17,9J17oe\xf1\xe6\x13w\xc3;?:M\xb1g\xe8\x84k\x9e\x13E\x06\xf1\xad2\xf4\xd0WzNwO\xd3$E\x8c\x00\x95\x97\x87\xa3\x9b0)p&J\x18\xc4\xc9\xe0\x13\x88\xb0\x8d R\xe1\x08\x0d\xb4\xd0_\xc1\x89A\xbf|dq)\xf8\xfe\x0f)\xd9\xbc{\xfa:Y\x15\x8f\xc5-)A\xa2\x14\x9d\x11\x83\xf7eu;5\x95\x12\xa8\x91\xd2{n\x16\xf0\xb3\xdb\x9d\xe6Z\xad'\"W\xeb\xeaO\xa4\xa6\x08\xe7\xf2\x83\x86kh\x197\xf3x45\x05\xe6J\xc6\x89\xc6\x108\xcdZ\x9e\x02\xf7\xd0T\"\x83\xc0[gJZ-^\xa2I\xfc\xbae\x8e|I\x1e\xcc\x85\x8f\xb4\x06\x00\x9aq\x8d\xca\xcf\xd8\xaa\x17\x0enK\xb5?=HH\xf8\xaf\xf4\xbazKstB\xe4\xcd`\x93\x80\xe5\x17\x89xdE\x80\x8c\x8f\x93Z\\\x1e\x0e\xe8\x92\x1fa\x84\xa1B\x1a\xbaj\xc3\xc7\xfdw\xfe\x00\xdb\x1d7.:\x11l{\xa6\x88L\xaa\x0f\xa5t1k\x0b[\x1b\xec\xfb\xf8\x0f\xfb\xa0\xc5AJ\xd4\xbf\xe9N\xe5\x06W ]\xbaAq|\\\x09\xe7\xdf=}\x9b\xe0\x13\x98\xeexu\xd6\xe0\xb3\xb3\x8e\xdb\xac\xcdff\xafb\xb7\xf7\x9b\x80\x9a\xc5wV\xa3c\x17.\xe0\xaf\xdd\xf1\x00\xfd\xc9\xf7L:\xef\xaa\xa9\x0e1\x0b\x1f\x0f\xc5\x94\xd5\x99\xd7[{\xb0C\xd7h\xf2\xe7\x94\xfc\x8d\xed\x82\xa3\x83\xc8j\x95\x95I-\x82\x88\xc8z\xbaB=\xbd\xce\x95v\x17\xb5\xb6\xbf\xe3\x87\xdd\xcbS'@ey\x7f\x1a\xcf\xe8\x9e\xc3\x18\xabo.\xd0\x9f\x1ah\x95Sp^\xc8\x1a!\xca\x18)3\xdf\x18!-\x92\xea\xfd\xc3\x96\xe9(\xc1\xfd\xf3j\x1c\xb4us\x01\x08\x91\x0e\x17\xc6Pk\xce\x15\xd4\x0f\xc9[J\xcd\xb3X\x1e\xce\xc1\xee\x1c\xff\xf4.\x93[\x0fE\xc0\xfe\xcc\xe0\xb9\x10\xc8\xee} /\xffa\xf2)\xd2\x9c\xd5\xa9-\xa1\xa7\x15\x9f\xe3\xc9]\xfd\x02\x1e\x89o\x7f\xe8\xefYz\xe2}[!\xd9/O^\xc7S\xcc\x14\x96\xab\xe4\xbc\xc8NW\x1bZ~\xb7\xb16M\xa489Z\xc9P2#\x87r\xef\xa1\xad\xb0Ys\x8b\xc2\x94\xf3\xee\xacp\xe5#\xbd\xc1\xbb\xbc\x04\x7f\xad\xec\xd1 \xd7\xe5\xdf\xee\x00G\xfdwWw\xfe\xde\xe9?Bu\x85|5\xf8\xc6\x7f\xc3\xbf\x8f\xfe\xde\x1f\xf9w\xad\xf3\x91\xb4ZR\x00\xca\xf5=\xfa]\xac\x1e\x95\xfb\x89\x8e\xbd\x8d\xfc\x19\x9d\x8f(\xb7\x99\xbf\x10\x8f\xcaU\xf5\xfc\xbb\xe7IZ\x94K\x12\xc3\x86\x80\x9b.\x9c\x0c_\xc4\"$\x80\x93B\x11q\x99Fk\xe6\xdc\x0c\\\x07\xb9.\xeb\x92\")}'\xea\xfd\x1b\xd27\x97\xd2\x89\xc8`k\xe4\x92\xd4\xda\xbfr]\x84\xbd\xfa>\x05\xf9\xc1\xda\x12\x98\x80\xad.\xf5\x80o\xae/\x8e3\x8b\xab\xee\xf2V`>\xf80\x8d\xc2\x93\xd1\xea\x8c\xc6\x8a\xe6\xdd$h\xcf\xb1\xb1\xfe>\x99\x14G'\xf8m\x13\xa6\xd3\xeb\x85n\xc6i\xb6\xab&\x98\x1ah\xc16\x8d<\x136Mr\x924\x05\xa1\xdfl]\xb1\x9eS\x0fm}\x81Q\xd6\xb7\xc8\xea\x93\x99'r\x82\x0e\xa49eS\x1b\xd6\xadI\xf8[h\x85\xbe\xd4\xfd\x03\x12\x92\xaf4{\xe2l]\x01\xeb\xf6\x0f\"jA\x0d\x19\x06O\x1c/I\xe5\xc1lKT\xf4\xbbpq:l\xc4\xcc\x09\xe2\xa3\xd4\x0d\"\x12@\xcd\x9d\xf8\xf3m2u7r\xea\xdf\xdd\x95u\\\x82#\xd4?hu\xd9^\x04\xfe\xefa.\xbf\xf2$\xea\xe8\xb9\xa3\xbf\xc4\x10BGd\x8fb\xa1\xcd\x9a\xf4\x08Y)LR\xe1\x18\x89fiEZ~\x85\xd77\xec\xae\xa9\x1bF\xe5l\xe9\x04\xb6\x01Q\xfb\x0d\x07G\xb3`(\xaf\\ha\xc3\x889w\xdf\xba\x89J9\xc5\x88\x98\x9c\xa59\xb0,zg\xe8.n\xb0\x95$\xcdLO\xb3\xe08\xd2L\\\x8d#\x8d\xee\\\x0a6di\xab\xe5\xc6|\x93\x80)$Y?\xb9\x13\xa4R\xfd\xc6\x81\xde\\\xf8a\xa1\xe7\xc7\x8d\xbc{<\xd6%1\x19\xc6KV\xad\xc6j\xa5\xc9*+9\x7f<\x1cA\xd1\x9e\x09\xff\x0c\x8c;,\x8at)\xbe\x04\xba\xfcL\x03\xbf\xb5<\xf0\xbbH\x0d\x96\x16\xd6%\xa4\xebqTr\xc2\xb2\x05Y\x936\x18\xdf\xd4:?Cyht.\x18rtK7Z%\x1aR\x7f\x17\xae\xb7p\xd4|\xf5\x8b\x93\x90\x92\xd47F\x98V\xe6\xcc\xdb[\xa4^\x94}\xdc\xe4\xfe\x0c\xe7TD\x93\xaf\xf3U\xa3e\xb8\xfc,\xcf\x9e\xcebv\xb9\x9d\x90\x87\xc0g\x934\x0f\xbbG0\x18&q'mn!t\x0f\x89\xcd\x89\x16\xf4a\x82\x84\x90i\x93\x91\x09\xd3\x09\xf8@\x8a+\x8a\x835\xda\xab\"\xbflj\xc6\xd5T\xcd\xbe\xe6\x9c\xd6\xbc\xba\xc3\x15\x8a;\x84\x03\x8f2\x8bM}\x06?\x86u&t\xc8\x03\x9c5\xdb\xdb\xc2\x05\x06\xe8g\xd6\xfd\xae\xc8\x94yr\xfd=4\xbc\x8d\xd9\x8ac\xd2\xf085n\"\xa3\x0b\x86\xcc\xf63Z\xb9\x1a#\x11V\xd7\x8c/XW\x9d`\x00\xd8y\x132\xcb\xad\x13\xba \xdb\x9cl\xba\xcf\x99\xe5\xe8\xc2\xd0A\xcbe\x81\xa5uP\x1f\xe8\xc1\xc1>|Pe\xdc\x19ZX\xc7\xa2-\xaf\x88Q\x8e>)9\xe1^U\xc2Qz<\xfaw\xc5+\xee'vw\x92\xb6\x8f~E\xd2\x84m\x18\x1e\xa3d\xa4[\x0c\xe3\x94[,\xabZ\xd2\x06\xe1\x84HRy\x9b\xd4\x18eR\xc6V\\\xca\xd4\xcf\xa6\xa5\x1d\x8fCa\xdc={\x96\xcc\xe5\xd9D\x16In\xecQ\x82D\xaa\x1e\xda\xa6\xa9sX\x92(RX\x08%>\xdf\xe4\xe0\xb2\x0f\x1f\xa6*$\xc7s\x1eDc\x84\xba\xdc&\x97\x85r\x8fw\x00\xd3\xafB\xffD\xca\xb7\x09L\x8fl:\x94t\x99,T\x89X\xae30a\x09\x81\xd5?D\xf8M\xdc\xcfc\xd5\xf5=\x9c(\xc0\xb1V\xad~\xb7\xac\xf6\x9f\xf2L\\\xca\x92\xc3F\x1d\xd6,\x8e\x92\xb0\xc9\x17\xf6\xac^\xb4\xa9\xb1`\x9f\x8d\x19A\xd4\xa4\xcat\xbb\x924\xdd\xe2\xcepE\xdd\xe2\xee\xd9\x8b\xdb\xab\x0f\xd8\xb78>\x92\xbe0\xe72\x97hD&(\x10\x1f8L\xcd\xcau\x1c|c\"\x80I\xf5K\xf0Y*\xd7;\xa6\xd7\xaa\xde\x1f\xa1\xa8\x9b\xaa]\x85\x8f\xad\xa7E\xb5\x05\xbeGVf|\x13\xf1\xf9\xdd\xb3\x97YF\xa4\xf8\x08\xc7\x02\xd5\x1b\x1b\xe6DO m*\x95\xa7\x03\xa7\x0dcC\xc4P\xbf\xe6\x18]H\xa9\xb8\xfe\x14\xbfc\x85-]P\xe0\x08\xdb+e8\xebU)w\xcf^\xcd(\xad\xafp\x03n3\x0e\xfa\x84\xad\xe1\xe8\xfc\xbdc'\x85\x02_\x9a\xcc\x1ek\\h\xc2\xf8.ims\xbe^\xa3;\xe5\xdd\x16B\x8a6\xb8;p\x0f\x03\xf4\xcb\xb9\xbfg\xaf\xaf\x0dx3\xde5\xc9;\xd3\x10\xe4\xd5)\xb2\x8cmFz\x04\x1e\xedZ|\xf3\xfe\xc8b~F\x9d,k\xbd\xb1u!\xb7\xa3\x81)\xcc\x19\x869!\x03\xdcj\xdbG\xdb\x1c\x97\xeb'y\x94\x13\xdd\x9d&\xabKY\xa4l\x8b\xe5\xa8\x96\"|Z\xc9\x00Gu\xfb\x9f\xc98\xe0[g\xe8n}a)K\x19\x1c\xde\xcc*\xa8\x92\xec\xa0\x91\x95Bi\xc9\xba?b\xf2\x92`\xa5\x1c{Pj\x0d\xf4\x87\x9a:\xcc\\5\xcc(\xef\x82\xb2\x8f\"\xcd\x05\xc8+\x8a1\x94&\xb8l\xd0\x9bx\x85\xc0{\xe68\xe9+\\\x99w\xcf\xde.\xe8\xbd0\xca\x93\xb0\xbf\xf2xR\xf9\xd8\xcc\x12\xcc\x05F\xe2\xc6\xbf\x9b\xe9c[!\xec?\x1ba\x03\x86\xe2z\xd5.@\xf0\x0d\xe0\x91\xd2\xb3F{\xbb\xab;\xd7\xba\xc6U\xdf\xbc\x1f\xc6\x07\xfa\x1b\x9c\xfc\xac:\xf1?\xfd\x870\xec\xf9\xf7\xf0k\xcc\xd8\xfc\xa9\xea\xa1\xab\xff\xf3\x9f\xfe\xf1\x7f\xff\xc7\xff\xf2\x8f\xff\xf9\xef\xff\xdf?-4P\xac\xd3q\xbfV-@W\x0d\x10\xb0@\xa4\xb3\xab\x1c\x8a\x1a\xa0\xb6\x8cP\x18\xa68\xbb\xa6[\x09F\xa8\xf9\xf3\x13L~j,)K}\xae\x07\x1c\x14d\x08\x91\xc22/B\xcc\x8d\xf8\x05\xce\x0f\x16\x06-c\xd4\x90\xdce{[.\x912\xb7O\xe4F\xc7\xd5x\xae:|vM\x8c\x8c%M\x8d\xee\x17\xf4VS\x0f\xed\x077:\xeeJ:\x89\xbb\xac\xfa\xeb\xcc\xe4X\x93\x0c\xa1\xe3PP3\x82\x95\xac\\\x0a\x0f\x9a)<\xc3\xa9\x1a\xdd\xd9\x0d\x06VpM\xab\xb8\x18\x1f\xa2\xa7&\xc3\x07\xe2X\x17\x14\xf8\x92\xd80\xefRu\xcf\x91\x1f\x13\xb9\xf9\xde1\xa1y\x07\x0f\xd0i*\x90F\xb5\x114\x9a\xfc \xf19\xd2A\x09E5\xa6\xbf/\"[\x9bX\xef\xe3\x03\xfc\x0a\xdf\xc0\x14\xe59x\xd5\x85#\xd5L\x10 w\xf3\xfdo!\xfe2\xf3\x9f\xf4\x0dw\xe6\xcd\x95\xe96\xec\xe4\xe1\x08\xd6\xb4\x8fY\xcfTb\xf3\xe6m\xcc\x88\xa1\xa7\xcb\xc2\xde\x94\x0f\xb3\xdd\xcf4\xach)\xbf3\x88\xd0Bf\xc9F \xca)_\xed\xb6\x0e\x9as\xeb\x1b\xf2\x86C0\xdd\xb7 \xd1m\x05\xa7\xf5'\x8f\xdd\x8b\xe6\x89\x8d`\xa2!\xc7\xcfTm\xa4\xa5\x83\xc3H\xae\xf1\x96q\xe4\xa6\x06\xc0\x96\xc7/%Am\x09eD\xd8\x91Se\\[1\xb7\xe0\xe8Z\x8a\x9e\xceY\xcf\xa5\x88m\x14\xe4d\xe7z\xdfq5\xd0\x03e\xf9z\x0e\x9a\xcd\x09\x8ct\xbb\xcd\xad\xb7e\x8e\xfc\x92l\xbfU1\x86\x09\x10\x07\x80\xd9\xf5\x9e\xbd[\x12\x8c\x0e\xb5J\x04\x9f>Bo\xa4sp\x9f\xe8\xa0\xff-\xac]{\xf9\xa5\xdaP8\x82y\xd0\xb6\x12\x02R\x1dI\xba(a\x8e\x93\x12\xad\x18\xd0\x13\x01\xe7(\x11\x1d\x0c?\xc7\xf4g\x1b$\xc3\xd9>\xc9\x83\x06:xf\xb3g\xae\x1fr\x86F\x03\xdf\x12\xc7\xaf&\xe8\x8f\xb0\xc7O\"\xf8\x19a\xa0\xd7\xdc.\x8e\x89\x1bX\x1c\xca1z\x19\xd9\x93o\xa8\xc4\x0b\x84\xe8kt\xe1\xc2R\x08\x8e\xc7\x19(\xb7\xbe\xa5\xa6i\xcd\x12wt,\xa9nW_\x84\x1a_`\xca\xdf\xaf\xf10\x82\xbc\xdf\"K\xfa\xba\x87W\x0e\xde/\x168\x92\x08\xf7\xa5I\x04\xc0\xb0\xf4\x88(\x02B{\xe0\xc2\"\xfd\xf4A\xa5\x97\xd8sZ\xd0\x86fah\x0e\x13\xc3H\xb1\x80z\x80n~\xb0\xb4l\xa1/[h\x89%1\xda\x12\xf8\x04\x8b\xa5\xc3\xf2\xfa\x09\x06\xa1#\xf5\x0e\xde\x98(\x81\xb8\xb3\xd3\xf6k\xa97\xde\xde\xd6\xdav\x9d\xca\x97\xa8}\\\xa7v\x99\xda\x87f\xbc0\xf6n\xcd\x82X\x9aH\xa6'y\xb5\\\xf4\x9ch\xdb-\xc3\xbbm\xe1\xf4\xa5\xee\xfd\xd9hs\xaf\x80yt\xdd\xfa\xfc\x0d\x14H\x18\xa9\x8d\xd5*\xf1\x873\xf4\xd0\xa0\x0c\xa3\xef:e\x17\xc0i\xa5Q\xa3\xf3\xe7\xa1\xb3\x02\xed\xe95\xc2a\x16\x1c\x16\x1ev\xf2\x17D\xc6\x96Nx\xdf\x9e\xa1;\xfa\xd6\xb5\x00\xe7\x10\x1c>\x84M\xf6\x1b\x08\xd7q\xfd\x15\xe3\xe6.\xa1\x1d%\xf5\x92X\xb7d\x00\xf6'f\xb0o\xea\x90\x89\x9erCT\xd8\x82M\xde9/\x9b\xb0\xd7\x90\xfayerc\xa5sW\xf9\xce\x0fi%\x19Z\xb7\x07bI\xb8if/Lb\xc4x\xb4a\x00q\xbcO\x02\x06c=\x9a\xdeXq\x8fJ\xae\xb5\x1a\xc8\x1c`\xe7B\x1f\x16\xdb\x0e}\xc6+7\xbeF\x1a\xa3@\x83` &8\xccO\xc0\x04J]\xa2r\xa2\x92\x10\xc4\x0b\x9cqk\xe2\xf8\x03<H\x08\x90l\x1b\x9e\x16\xb8\xb8\xa2\xed\xb1\xa5%\xc5\xbbjs/L\xc3<\xefG$G\x1f\xeb\xcfa\xef\xfc\xcc]\x10#\xa5=\x9cK\xcf\xaf\xa2&\xfdI\x9fd+\x8f\x92D|1\xa6\x8f\xa9\xa8\xef\xea\xd32\xa7B\x0b\xdd\x050\x876\xc1\xc8[\xa5\x04!\xc2 \xf9\xea\xa5\xea\xee\xb9\xed\xad\xc4\xda\xfb\x00&\x8bhT\x96}\x07\xd3\xde\xa3\xc6g\xc2o\xcd\x1a\xeb\xd1\x1d\xc6\xb0\x7fS#&\x0d\xfa\x98o\xb4\xd4\xc3)eF\x89\xa8x\x91\x9cx\xbf\xc0S\x9c*P\xcd3\xe2\x14w\x8d\xbc\xf9\xdf\xb3\x05\xa2\x83\xe7q\xa6\xe6uR\xfb\x089\xd1\xbc%\xdeG\x8c\xba\xd0|\xb8\xe0\x9d\xb0\x1aN\x7f\xab6\xf4\x0e\xdb\x84\xa7R=\x92\xac\xd4T\x1e=\x89\xbd\x09\xcd\xb6\xa4\x0e\xc3\xc3.?\xe7\xcbrP\xec\x00\x9f\x0a\"\x0e\xe9\xd6\x9b\xf4\xc0W\xaf>\xb9\xfb\xfa=\x0e\x08\xf6\xa4\xd3hA\x14\xc7u=\xc7\x12\xb1\xd1x\x8a.3\x82i\xfa\x93r\x8d\x14u\xc1g\xc3\xf3\x95`\xfd\xe2\xce\x98\x10\x1c\\\xa3\xd1\xaa'\x95\xa5\x1b \x94\x82z\x8a\xc6\xf8\xcbB\x0c3**hDV\x84\x99\xc6\xfa\xa3\x1f\xce\xd0\x00\xbb\xf9M$\xe5\xc2\x92\x9f\xb3\x92GSJk\xd38\xe8`\xda^\xdb\xb5^-\x8b.\x8a\x06\xd7\x8c\x13)r\xfc\x80y\xd7\x8e\x07\x81-\x87\xb7\xe5\xfci$\xd6\x96k\xdb3\x8a\x1a$x\x1b\x12}e\xc9e\x15\x95\x8c\xd9\xa5\x16:0r\xa7+\xees\x98\xcc\x0c\xb1Z\x8e\xff\xa6\x94\x9f%h$\xaeZ\xd1\x1d\xce\\\x89\xe0g\xabd2B\xcb-\x8e\x83\xda\x04\xc7\xa4\xa5UI\xc4C\xbeM\x1aaG\xb9NN\xe3\x10\x11\xce\xd7\xae6o\x0a:\x81\xca\x1cfe\xa5\xa3\x9fo2\x91)E\xf1Q&`Z\x990/BL\x06@Sw\xd0\xff\x0a0e\xdc?\xd9\xec\xfeX\xc0\xe9\xcf\xa20\x91\xa9@\x02a\xf8\xc4Wu[\xd4Y\x85\x83eL]x_\xc7\x99\xb5\xd03\x9c\xa0M\x1c\xb6\xe4\xf5\x8e.\xac\xc3\x12\x96G0\xc8\xcf\xfe\x93\xad\x8b\xc0\xbf\xfd\xc2\xf1;\xebH$r\x07c\xddx\x8c\xb5U\x9bP5\xb2\xdc\xa3\x06)/a\xc6\xb3o}\xff\x00\x11y\x88\x09\x8b\xb0+\xcf\xc9\xfa0Z\xd6\xba\x80\xc6\xaf>\xe0\xa4\xd7DN\x82W\xcc$\xd04\x87D\xeb!.L\x9d\xe7{\x1b\x9bT\xb0\x8d\xd8s\xb1\xb6g\xce?\xb7\xa4\xfas\xd1\xe7c\xb0\xf0r\xe0\xf7ial\xcfF\x15\xf6\xb3t\xacft\xa2\x8a\xa3\x89~\xc6\x17gCb\xd3\xf9\xe6\xdeap/\xbeuX" +
+	"J\xb4\xb7\x8b\xa1\xfcdA3\x85Y\x0e\xaf\x9e\xa0\xfc(\x9fd\xa5vC\xd6\xe8\x9eT\x08\x82K\x87\x09\x8f|\x81\x94\xe4.-u\x8bT\xce\x1dP=\xcfr\x0b\xde\x95\xf6x\xb6\x1d\x06\xb6\xf0\xb2\xa065\x12\x95aG\x18\xe6\x86\xd6\x86\xfe\x01\xd4\xb2es6v\xb8;\xc0>|\xccc\xddQI\x9dl\xfd\x8dj\x85m\x93\xf8\x87\x86?\xf0\x19\x8b\xf3\xbd\x8d\x850(\xc8i\xec6\xecm4\x99\xe3\xfd\xc2T+\xeeC\x1d\x05\x15\xa6\xbd\xfc\x12\x85\x97\x19\x12\xa2\x17\xe9V\x1a\xf8Y\x16\xc2KR0}b\xf3\xd12{\xe0\x98\x1b\xf9Z\x03\xad\x9a\x1b\xe4\x8az@\xa1fW\xb5\xe0\x83\xfb]\xfd\\gH?\xb1\xebp\x96\x8a\xdft\x80s\x18\xcf\xb2\xf4\xb6>\x94Y\xb0\xcez\xf8B\xfc\x07\xb4\xa7gC\xc8^\xca\xa4\xde'\x87A\x19\x8c\xe3\xda2\x9aty\x17\xa2Y\xd5=\xdf\x191|:\xf7\x00G\xe0X\xd1\x86_c[\x9d\xea\xf63\x02\xa2\xa4\xac\xf6T\xf7p\xa9.u\x7f\xef\x07o\xfc\xa4\xe4\x86\x0f\xd0\xb8~A=\x1eg\xdd\xa9\xda\xd0\xd0\xdc\xa6\x84B\xa9\xcb\xc6\xeb\xb6\xf8\x19\xfd\x89\xf8\xb9\x1a\x06\\\x98\x1d\x8fK\xdd:\x98`\x1f>\x9eT\x9b\x8f\xc9\"\xb4]&y\xff\xe7\xfe\xd4\x90A\xf0@\x99\xcaF\xa4\xd9\xf1\x95\xc5O\xed\xcb\xd4\xee\xcbS>\xac\x0c\x0f\xbe\xf1\xc3\x15c\xf0Y\xca\x07+\x10s\x13\xbb\xd0\xa7Z\x9b\xbf\xa9S\x97z&\x13\xf4\x970\xe1p\xeem-,\xb1\x0a(\x1a^O\xb2\xd5m\xc3(\xb6m\xbc\xaa\x94\xc4\xcaz\x12L\xef\x982~Wm\xce0\xba\xd6\x1eO\xd5]\x11\x86\xaf!\xab\x0a\xa3\x89\x14x\xfc\xe2\xcc\xdeh\xdc\xf5\xbd\x01\x11\x85\xc5\x17\x1d\xe8\xf0E\xd5\xa4\xc6\xc3\xd7\x16\xceFxM\x1aZ\xae\x07\xdf]\xa0\x83y\x8cT\x88g2\x91)pC\xb59\xfb{m]\xf2\xd1e\x8b;j\"\x8d_b\xf2\xdd\xc1\x9f[Kj~`J+\xda\xf8\xc3\x05q#\x82\x1e\xba=~r\x06n\x177\xf0p\xbc\xc2\x1c\x8e\xae\x13\x1d>\x1f\xb9\xdf{\xf2\xdc\xa7z\xe8\xa1yR\xfd\xb9\x80G\x8eu_\x01H\xec\x07\x8aS\xf6X\xd2o&v\xb8\xba\x8bB\x0d\xc3\xd9\xc1h\x0e\xb8P\x16{\x1f\x02h\xadkD\x10\xc6\x0d\x90\xae\x038\x85\xf0+\x0f#\x8c\xae\x7fH\xc6s\\\x94\xa2Y\x80\xf9\x9b\x0fli2\x91?\xae\xa7h\xc8c\xb4\xdf\x8e\x0dY\xc97I\xdb\xe1v\xd8R\xa9Z\xd6\xaa\\WtDci\x84\x83\xc3\xcd\x14\xcb\xb3\x99F\xa9\xf5\x9d\x04\x95\xa8\xe7\xd0\x92\x1e\\9HQN\x88\xc4\x0ds\x13\x8e+fD\x0e\xf5\xf0\xabkVp?\x11\xd6d\x96\x87\xc1W\x1b\xee\xf5p\xd5\xea{\x18\xfcH\xd1Z\xe6f\x91>\xec1>\xedR({S\xb3\x83i\xeb0h\xe3\xc3#ZT\x0e\xde\x10\x91\xca\xf2\x93\xbd\x8a\x15\x8c\x0b\x99\x90\x886%\x8c\x15\xe1R\x05\xee\x11\xbe\x90\xe7%\xd3\x1e\x01VL\xe5\x81f\xa5\x9f+cO!\xb4\xec\xaac}\xf0\xd5\xd9M\x9c\x01\xca\x9c\x1e\xa2\xdf\xb7RLI\x85\x12\xdes\x85it\xf1\x95_\xafJ^\xd9\xccA\x8c:\x1b\xbd#\x89aqx\x10\xe3\xaf\xad\xddF\xf5\xf5\xe2+D\xfa\xc9\x84*\x86\x91\x12ZO\xa2\x15S\x9b0y\xb6\xd1\xc0\x8d\xa3\xda\xb6\xa6iG\xce\xf5\\\x1cG\xf2\xe8\x17\x9epd@\xd7-\\\xc2\xa7\x9b`\xb1M)i\xf3\xee+\x1aU\xbd\xe4\xcd\x0f\xfd\x09&\xb4\xbe\xc9\xd0\xc5`\xcb\x86\xa3\x99\xe8\x98\xe9\x8c;\xf9\xeeB\x85\xfa\xda\"\x94^7\x1b\x8b\x8dc\xad\xa4#3\x07\x10\xad\xc4mTy\x9d\xe0\xa2\xfb\x0bQ8\x85C\xf7\xf4#\xfc\xcdq\x02\x8b\x10\xa5b\xd0\xfeE\x97\xa7\x8b\x1b\xe1l\x9e\xd4(\xa2s\xedU\xbc^\x06s\xf9\x90\xf3\x8e'\xe1\xee\x18Jh`E5\xe7\xd9[;j?\xa5P\xa7\xb4\x84?\xe2?\xe3\xc8\xc0\x882\xad\xe8\x86\x00q\x82\xb3\xdfwu\x0f\xe7\xa8\x91\x7f\x91\xe7Q\x8dS\x134X^H\x9eGWR\x9a\x92\xb8T\xc2\xb9\x81m\x03\xcec0\xbfw\x06\x05-E\x07\xec&-2\xf5+\x014\xcfK\x8a)\x06\xb79\x9dvs\xc2\x0fj\x9e\x9fd~%\xe8\xf1\xc8\xcdH\x1b}\xdd?\xd0 \xb1%O\xb9o\x1d\x03U\xcb-q\xb7\x1c%\xfc9\x89\x8cI`|\xa8\x7f\xab\x1bg\xe3\x80\x83\xcb\x11<;\xdd6\xcd\x05pk\"1\x15N\x8fo\xab\x8b\x90\xd0\x80@\x08\xfa\x13\x82\x1f%x4f\xcf\xd0\x9fP @\x11\x04l\xe1E}=B\xb9\xf6\x85C\xf9\xab\xc1\xa5g\x96\xee1\xc7\x0f%!\x80D\x92\xb8&I\xe2\xc4#\x95\x19\x99\x05\x0c$H\x01\x04\xb97\x91K\xc9\xe1\x1bWc{E:\xf8y\xaa;\xb1\xf9\x1f`JA\x15\xdb\x04\x93d\xc1X\xd9\x88zG\xeeQ\x84\x1e\x8a\x97\xaf\xfaI\xa2'\x12k\xb28\x1f\xc8\xaf\xa8\xb9\x06\x0d\xbe\x1d\xddEa\xa8\x02\x89\xe4\x8bm\x17#\xb3\xcfc>\xcaX<\xe2\xd5Mp\xa1*j\xe85\x1e\xb5Lu\x8b\xec\x8f\xbb5\"Mmy\xcc\x04->\xd3\x8b\xe5\x09\xf2>G\xb1\xe7\x0bu4\x9c9\xa6\xda\xf9\x16\x10\x82\x8aS b\xa8v\x8f\x86L\xc9\x80l\xca1\x91\xbd\x01\x10\xfdq7\xe5\x91r\xa5\xbb5@,{\x85\xe6&\xa5\xd2\xf4\xea\xd8~\xf9\xb5\x8d>\xd8V\x8f\xc6\xa8\xec\x0a2\x06\xa5\xf5>\xe4ss\xd6xKWH\xa6\xf8\x0a\x7f\xc9\xc2\xe9F\x87\xe6\x10\xc6p\x83\xf5\xe1~r)5I).?+}\xa6\x0c\xd4\x86\x82)\x19N\xe4[-\xf0\xa1\xedQ\xb3\x08\xb2H|[}7\xeb\xcd\x08C\xd4\x88\xee\xf2\x9cM\x8a\x04\x9a\xfa \xaa\xef3\xfafz=y\xdc`\x01av\x01z\xae\xeb\xcc\xddc\xfd\x1a\x9dG\xaeuR\"\xf4\x10o\xf2\xbd\x81N\xa0\xa0bl\xe0\x9bJ\xb8\xf3\x0d\xf3\xaa3\xff\xfc\xf5\x9c\xfc\x0c5\xdc\"\xe1F\xe5z\x17z\xc1\x8b\xf2\xaa\x1d\xf8\xef\x12U\xdcM<g\xf2\x9c:\xeb9\xe6\xe0&8\xec\xf13Q\x10\xee#K\x9az\x803RY1A\x04\x04\xc8\xfe\xaf,\xef\x91\xb9\xc5\x0a\xa5nb\xb8\xdc\x86\xbf\x83\x95[\x8f\x13T\xe1\xc0\xe5v\x89\x8a\xe2\x13\xa0\x1dE\xd8\xecm\x02<\xc2\xb9&\x9b \x87\xe1%\xac\xb4\x0f\x1f\xfd\xc3J\xeevC\xe9\x0bl\"zO\x16\xc4\xa7\xcb\x86&\xe4@\xdf\xa7\xcf\x07\x88\x90\x02\xd6T\xf4H[: r\x81\"/\xd2\x96\x17\xe8O'b\xf7CS`\x8f\x9fv\xe1\xc6\x1b\xf1a\x91{\xbd\xe3G\xde&]\xbc\xa3\x88\x1f\x9e\x92W\xe8^CQ\xbf\x8d\xfe\xed\xb9\x89^\x9bl\xd4\x96Y1&\x16\xb31,B\xd32\xc8M\x18Wc/Z\xfc\x8d\xc7\x16\x91\x17\x17\x1e`\xe2\x1b\x9b\x0d\xf9\x8a\xbau\x84\xb7\xe83n\x10\xef\xb1\xe5\xd52\x82\x14\xb8\xf2\x81\x96\x9f\x8ay\xdd\xcb\xbcF\xab\xa9\xa1\x17O\xe7\x94\xa1\xb9D\xe7~\x8d\xab\x97\xa6\xe1N\x95\xc4\x93\xd0W\x81\xe7.\xd5\x821\xd7\xc2\xc6\x12\xebD\xeb\x96e\xbdY\xd4\x07\x8f\x80\x99`m\xae\xad@/\xeeJ\xf5'\xb6\xb1\x0d\xea|n\x1c|G\x88\x07\xa5#l\xbcJ\xe8w\xe6\xcf\x9dgf\xd3\x94=D\xcd-\xa3i\x10C\xa0x%h</\xcf\xab\xe7%\xb1\xd3+\xd3\xe2\xc5\xb3T\x83\xb5Z\x15a\xed\xc4\xa5h\xe85lJ\xd8\xd4\x81R\xfc\xcev\xc4\xe4['\x92\x8bt\x85Hp3\xdb\xccV+\xa4^<\x7f\xdc\x03s\xb9\x97\x15\x1a@\x13'\xae=K\x84'\xf3\x81\xe4'\xd8-<\xb7\x1a\x10q\x02.\xbf\xc2\x8b5\xc6\x0f\x19\xf88fiz\xe6iC\xdd\xa93!x\xfe\x9e]\x93d\xf0'\x17z\x8c.\xfc\x8b\x04\xbb\xb4q\x1a\xd3\xc1;SHGj1)\xb6\x8b\xfbmD\xbfd\x91\x9d\x9d\x80\xaf\x04\xa7|tt\x15<-\x09p\xd9\xf65\x90@\x17\xe2\xa2\"%\xc6\xd2\x1c\x08($z>B\x8f\x87K\xe2\xbek\x1fJ^\x99\xaa\xc9B\xd0\xa6\xee\xa1\x9d\xd93\x83\x9f\xd7\x1d\xcf\xa4|\"\xcc*\x85\xd3\xcd\x14\x19u\\\xd3\x10\x91Q\xb6\xd8\xde\x89\xcd\x87\xdb\x1d\x85\x9d\xea\x01\x87r\x924\xc9\xc5\x80\x85\xe8#\xa60 \xd0\x04\xcf0f\xc8\x98\xdc\xd30\x89D\xa9<Yp\xd7\x0545\xc2\xed\x89T\xb9\xfa\x02\xbfjf-$\xd6y[2\x96\x81\xc5i\xde{H\x0a\xefY\x08\xe6\xb8\xc05\x99J'\xdcj0\xbe0\x06\xa3D\x92|\x07\x93\xc9\x86\xe3\xde\xc8\x9e\x95$M\x12H\xa4\x05K\xee\xd8\xc0\xe7\xcd3\".\xd2%\xc6T\xb7\xd8~\xde\xc4i\xb9\xd5\xf06\xf4\x0eV\x82\xa5/\xded\x04l\xd1\x94\xd3M\xc5\xb5\xd0d\xa8\x00\xecT\x89\x1c0\xd0\xad\xd3\x95\xbfL`r\xc5+|\xf1v\xc5+lL(\x85\"M\xcb\x08\xa65\xcc\x92]\x7f\x9a$\xe8a ~\x9a\xceH\xf1H\x8b]\x0c\xf7\xd0\xe2N4\xef\xdc\xf0\xd5\x8a\xee\x8d\xeeX'\xdf\x1f\xbd\xb0\x87\x1d`\x10\x842L\x17\xdf\x17\x0b\xa2)\xe8\xd8@\xbfY\xe5:}\xf9t\xb9U\x7fa-\xd6\x88e\xe4\xbeLMF\xa3U\x89\xe1\xb4\x06\xb8d\xa5\x98L\xcb\xd6\xbf%\xdfY\x84\xa5\x18\xaf\xe0\xfa\x89\x8a\x98\x05 \x1fj\xc2\xaf\x0c\x98\x97w3\xec-\xdfM\xf0\xe2S\x98\xbfG\x0az`\x0a\x90\xd6\x0a\x8d\x8b(}\xf7\x01\x06\xcf[g\x80G\x863\xbe\x15\xd3\"\xbc9>\xf7\x06\x8f\x92\x0d[\x0e\xddF\xf0\xb3q\xe2\xb8\xcdD\xb5\x1c_\x8f\xc1\xbc\xe6-\x17\xdd\xee\x97\xcff\xafvt\xe2\x0a\xad\x12\x84V\x1bf\xd2\xc3\xf8\xa0\xf4\xf4\xbfRX\x1b\xb7\x14\x84!\x11\xf8T|\x10\xcc\xad\xbc\xb3\x11K\x8e3H\x0c|V\xbc\xb5\x96+\xbfb\xef\xbd|^\x061JdA\xb6\x96X\x99\x8bN\xf3\x99\xbb\x90\xe7(V76\x1c\x06\xfd\xb6\xe0O\xfe-,\xa6u\xa7\xa8\xdb\x1cx\xc5\xc3\xa1Rh\xa6T\xdc5\xe5\x14\xc9\xc1\x8dP\xb5\xfe\xe2'\x8ax\xb2;p\x0d\xa7\xfb\xf2E\xa9\xba$\xa6\x1c\xd2\x9e\x03\xed9\xb3\xbcp\xa4Z\x9b\x9c\xd6/\xce\xbc\xf7\x8e\xc0w^TW\xea\x83\x1f\x85\x03EzKyI\x12\x82\xd2\x07\xe6\x9dEJ\xbeV\xd0\xbc\x16\xe8\x1fe\x18\xc3\x1e\xd9H!d\xf0\xd50\x9dz#<\xd0\xeeWQ\x8a-\xcd\xc2^\xd9\xa6^\xaa\x0d()\xbd\xac?3$\xde\xac\xb5\xe6\x15zO\xaa\x85K\xe1\xd0\xe2\xcb\x10SG\x0f\x83\xd6\x1e\xac\xc5\xc0\xc8\x0f\xbc\xb2 \xbfZ\xa8\x03] \xff\xc3\x7f\x9d\x17\x8b\x80m\xa6i\xf9\x9e\xaf-\xe3M\x19\xabS&y\xd0B\xe5\x1c(\xdaKr\xce\x9f\x95a\x0c\xf9N\xf6\xf2\x0b\x1b\xe5\x84`\x13q\xef#\xb0\xba\xaf\xba:T1\xc8B0\x8b%\x90\xabA\xfc\x97o\x96\xf7\xb8\xef9\xe9\xe8\x87\xdeY\x06i\xba!\xc9_F\xb6\x9e8_\xe6\xc0\xb7'\xd5\x87\xe5\x8b\xd8\xa5d\x91\x0a\x8a/\xd9\x88?rm\xe9x{\xc3\xd6\x1dq.M\xcc\x9br\x12oM\x82J\xc1P8\x8a\xc4a\x16:\x14\x82\x1f\x0f\x8c\x85\xd8\x98?\x07o\xa7\x85\xf6*F\xbb Q\x17n\x90)\x05\xcb\x03\xbb\xde\xb1\xc1\x1f#\xd4\x11\x86\x1f\x02\xc8\x19\x02\xff\x8ai\xf0J\xad\x1e~\x9c\xa3?\xc1\x12\xc2\"\x01\xfc\x1f>\x9f\x89\x0d+\xbd\x1f\xee\xf1\xac\xf5\x84\xf5\x19\xdf\x96\x02\xd0\xb6\xe47\xae\xdf\x0d\x0c\xbew\xa0Nj\xeb\xba\x0b\x8b:\xa2\xd7\xa1\xf3\xf1\xfa\x0aCh\xc0++\xcc+\x93\xf4\xb5)H\x9azT\x07J\xd0h\xaa\xc1\xb3$jX\x0b\xc2\xdf\xc3\x83\x08yh [\xf7G\x1b*\xa1\xe8\x10\x9d\xa3!&kZ+\xa8)u\xb7:\x1f\x0dH\xeevf\x95\xee\xa3L*\xed\xb8G\xe2\xc7\x8flc\xb2\x10\x1d\x1c\x0c\x17\x8f\x03\x17\xabg~\x83a@Sa\xfa\xdcmu\x97\x84\x89\xe2%\xba\x0fX\x14\x8c)\x0cY#\x9c\x93l\xce(\x1c\xddY\xd2t\x83x\x910l>\xc1D\x18\xf3\xd0\xd4&\xc6\x8f\x15T\xd5\xe6/\xe01U\xf1\xab\xff\xed\xbc\xd2\x89\xcf\xaelx_\x82}\x12>\xe2N\x95f\x01\x9a3|\x81\xc9\x0dv,/Uu\xd8j\x82\xa3#\xe6\xd5\xe5GS\x03n\xf37\xc0\xa8\xc6\x95\xf2g\x8e\xf2\x10\x93\xfe\xb5r\xf6W/\x12\x14X|27)i\x97-]Ul\xa5*\xbbSN:\xf9\xf6\xff\x12\xbe\xc6\xf2ZH\xfa\xfeR\x0f\x9e\xe0%\xb8\xf34\x889\x9d\xf6\xfc3\x94\x8c\xf6\xf7n\x8f\x9f[\xe1\xf5\x9esN\xf2\xba\xff8\xea\xf3W/\x174(\xa8\x070\xb7t!PO,\xcc\xad2\x8ea\xc1\x03\xb28\x85\x1f\xe8\x0b}\xa0\xc4&B\xb6\x05eh\xf9=*A\xaf^=\xd2\x1a\xa3\xb9\xb1`\x8f\xcd\xbfdR\xe4\x85\x05\x1b\xe1\x8a)\xc8)\xcd\xd0\x18.3\xc9Knd\xc9C\\9\xf4[\x85\x9d-f0\x91\xa6\xefD)\x17b\x0f\x88\x83Y\xd1\xc8\x18\x8f;\xc1>|,7\xd7\xeb\xab\xd4\x1f\x84\xed?9\x1e\x02\xa9\xc2\xa8\x1b\xc34F\x9d!\xc6\xb3\x1e\x19\x06\xc8\x17\xd8\xb2\xcbM\xa5.a\x1b:\xd2o\x9b\x8c\xde\xe3\x13\x0cq?\x12\xb9\xbe9'\xc6\x9c\xbd\xe3\x0a\x81\xc5\"\x99|\x1a\xb3_n\xa17k\x12\xbe\x7f\x83\xb3?\x12\xee\xe7G\x18\x07\x08\xc6B.\x15-\xc4\xe1\x17\xff\x00\xae\x82\x1e\x9a\x98\xc2O\x8a\xb8\xde\xcdg\xc1\x07x\x80wq\xcc\xa2=\xb7\xe2?@\x8c\x14\xf1H\xfd\x90\x14)\xf2\xb6\x7f\xf6\xf7\xf7h\x02\xa9\x89\xf3\xa4\xfa\x09\xfa\x09\xc64\x13?Ac\x97\xe8b\x11\x1b\xfb\xd78+\x17\x83\x06\xafV\xec\xc5u\xb6\x8a\x9b\xad \xb5%\xa8\x8c\xf1\x8a\x01\xfbZ\xad\xb0\x8dY\xcb\xb7\x88/\xe2\x852\xec\x9a5\xfa\x8a[]\xc1W\x90\xfcy47Ef\xe65N\xbc\xf1`\x99\xad\xfa\"\xd3\xee\xab\xd8{^'\xc5U\\1\xfb\x83\x9a\xd3\xd9>u\xa9\xfb\xc9\xf5'\xed\xfc\x1f\xfd9\xda:L\x8c`A\xa4\xcc\x81*\xbd\x15\xbc\xb4\xf0'\x0d\xc81\x85\x1e>\xbfd\xf3\xa4\xa2v\xc3\xf5c\x8d\xdf\x0aO\x0f3\x985\x0a\x98\x0cU\x14\xe2\x84\x1b\x8a\xb6('`1\x8a\xd8\xd2\x96|\x0eZEZ\xa1?\xec[\x8c\xc3'\x11jv\x0a7\xea\xdc\x10\xea6CL\x99\xe4\xbap\x0e4\x08\xc2\x95~\xbc\x8f{\xa1\xb9\xb2\xc3V\x1b\xe0\x0c\xa3\x83F5\xe3\xd9ZLD\xd1%\xb0{L/f\xb3lL\xbc\x1e\x0b\xb8\x17\xfb\xfcY\x92\x8c\xcd\x04\x9f7l\x94\xc6\xd6\x0e\x9cV\xb6\xae\x1a\xa3~\xfe\x82\xfb\x9f\"[T\xa2!d/\xff-8\x02\xbe(7at`\xec\x06\x14nptc\xd8\xe9e\x9b\xc0{J\x0d\x91\xb2\xf9\x16\x8b\x89\xea\x01\xce\x14w\xda\x18(\xe2\xb5i\xac\xf6\xde_\x12\x91\x9b\x98\x89\xfa\xd1\xcb8\"-\xcbXntd\x83\xac\xf84\x02\xd2(\x16\xb7%\x85\x18\x86\xdb\xe7Rw\xf5\x89\x83\x96\xbc\xf9\xe8\xe1S\xdd\x9fN\xe1\xe6\xcd\x82\xe90\xaf\xd7\xe6\xda\xc3U\xe4BS3d\x12\x8a\x04\xb09\xaf\xf1\x1a\x94\xe1\xf5\x9c/\x82\xafM\x8d\x88\xaf\x11\xc6},\x8f\xa7\xfc\x90Y(4\xdd\xd3\xc1\xe8\xfa\xd3\x9e~H\x0e\xcc2p\xe0\xbc\xf9\xdf\x81x\xa3D\xb6\x91q\xae3&\x9a\x02\x00\x9b\x83CE\x01\xd3\x033\xd8I\xc4\xe1\xf7\x9f\x1c\xa1yCg\\eLYn\xea\x97\x8bM\x9d\xb5\x91m\xf6\x8f~\xa4\x11\xfbg\x18\xbcn%\xff\xc5\x0d\xdf\x80\xf2\xa0u\xbe\x81=\xfd\x90\x95[uA7\xdc\xa6i\xa9\xab\x96\x1e\xefc\x0d2Q\xf2\xd8\x9cP\xb0m?w\x87\xcf\x94\x93Q\xc1\xaa\x94\xd0u)\x93\xb2\xdc\x0e)\xcf/\xd7\x06\xae\xb0\xb2D\x14#\x15l\xba\xd1\xc9\xc3\x16\xab\xb2\x13\xdb\xc2\xf5N\x9e\xf4\x91$\x93\xaf#q\x83\x1fg7\x99\x95\xfegfS|h\xce\xb6C\x1e\xcb\xdc\xd1\x03\x85\xab\x18ji\xc9\xdd\xc0\xb9u\xfb\xf0\xc1\x8f\xad\xa8D\xb1\xf2\x09\xad\xa1.\xde\xb5\x97\xc9\x0d\xda\x95\xd7\x99e\xdb\\\xe4LW\xd8m\xac&\xb5 \x1bG\x19DW\xf5u_7U\xf79~ab3Za\x97\xf9\x15G\x9fR\x94\xeazj\x0a?\xbf\xad\x8c\x96\xa3\xd0iJ\xee\xcb^\xe9Z\x83\xa8q*s\x89[Dv\xa2*\x9dP\xdb\"O\x8cF:\xc9g\xcc;MX\x1b\x8f\xe0\xfei=@\xf8\xe6i\xb1b\xb64\x0a\xa8{v\x95\x1d\x95\xe9\x8c\x91G\xfdK\xdd]`\xe0G\xd5n\xe2e\x12\x9b\xaa\x95#\xae\xb4\xd6\x9b" +
+	"\xbb\xe4\xf1$\x9f\xa9\x82\x19|\x95\xf4\xa1\xcc\xd82(\xacD\xa8\xcd\xe2r\xf0\x0d\x8b,\xa0\xdc\xcd}Xf\x1b.\x95f\xc3\x1f\xa7\x98\xe28\x97\x05$\x94x\x95I\xcb\xa6z\xc0=mh\xa1?\x85s\x08\x05,\xaa\xd0\xb4\xa8i!\xcamX\xb97\xcf\xe6\xbaS\xf2\xe8\xa7\xcf\xcd\xe7\x88\xb1\xe4\xe4\x9eT\xa61\x15\x06\x05\xff\x8b*g3J\x16\xa3\x8b\xe1\x1b\xb8\x12\x80}\x93\x95c\xa7\xa1\xc6\x0c\x9d\x18z\xd0\xc6\x11\xc348\xd2\xf6\"\xf4\x1c<%\x02\xb9\xc5\xa8\x99\x14\x89\xde\x12\xd7\x10\x95S]\xdd\xa6\xb4}\x1e\xd0)*F\x95\xe4\"LR\x8fM0\xd6_p\xdd\xdb\xd3\x8fd\x85(\xe8\x87\x1e\x1d\xac\x11Y\xdc\xb8\x81\xbf\x89\x91@\x9eu\xe1\xfd\x17}$\x8d\x96tY\xcd\x15o\xb2\x86J;.%*<\x94^$\x86\xf1\x97\x0c\xe8k\x13\xf8e\xc9f\xce&\xea\x07\xf3\x90J\x09`\x1e\xf3\x9f\xaa\xbf\x19\x96e\xf7\xce84\xcc8`('\\5\x02\x92\xa5V\xbf\xba\xa9\x17M\x18\xa5\x7f\xff\x06s\xcc\xcb,\x9a\xaf\xacFK4\xe0\xf5\xb1x\xf2p\x9e\x8eoO\x88/\xa5\xce(\x04\x82\xd1\xbb\xbe\xd6V\xafS\xb1n\x84z\xa5<\xdej\x95\x8d\xe0\x8f\x84\xdd\xd5\xd5N\xa4\xdb\x19\xd8\xc0\xfb\x9a\x9a\xcf\x88\x0c\x1f|\x87\xfdV}\xacG7\xf0\xf6\xca\x95\x81\xcaL%\xed\xc5\xae,\xb7\xad\xb94^e\x8f\x9f\xec\x94\xf2%\xc63\xb4 \xc9\xe5!;\xcb\x08\x0aS\xaes\x99D\xdc\xae\x0f\xd7\x1a\xed\xcd\x9a\xb5\x94\x8c\x9bl\x8d\xe7\xbeKRs7\xdb\xb9\xb1l\xdfh\xa96u\x03\x0f\xa0Z\xb1\x19\xdf\xc1\xaeR\xaep\xec\xdb\xfd\x9e\xa1\xe0\xa6X\xcb\xea\x02\xc6Bt\x1c^TFN\x0f\xfd\xa4\xca^L\xa3\x08$\x17\x17\x1e&\x04?\x8dZ\x7f8T=\x0a\x15\x95[Fd\xc9\x02\xc2d,\xfa|\x04\xe2MP3H\x05}\xad\x9b\xde\xc6j\xfb\x1f\x08=\xfa\xab\x1b\xba\x08\xae\xfb\xb9\x90\x83w\x17\xc2\x85\xf2~0t!\x1a'\x997\x1a\xdf\x09\xd3PRQL\xdfW\xe5\x12\xfc4*bA.jXS\x14\x9c\xce\\!\xfb\xa3X\xe4\x95\xb7\x7f\xfbt6\xb3\xbb\x9a+j\xc2\xbb}\x07\xbd\xab~\x18\x07p\xad\x11pj\xc1\x0b\xc9\x85\x96\xd3\xd2S\x85\x19\x95\x8c\xd1\x03\xb92\x82\xe7\xd70\x15\xdb\\\x1d.Z\x0ebU\x8d\xeb\x8f0*\xf9\xe2;\xe9f\x04\xee\xd9\x8dO\x04\x08QdP\xc3\x0a\xb6\xca\xfa\xe8\xa2\x03\x0441\x82M<\x93\xc2\x13\x12_\xd9\xdf\x0dg\xd0\xd1\xf1I\xfcf\xa69\xd8^\x87\x07\xb8B\x0f3\x8b\xe8p(\xfeZ\xcf\xdce\x09\xf2\x12\xca\x8d\x9f.A\xb8\xd9$\x0ft\xd2PLq\x98H\xc5\xefL\xb6x\xed\x15n\xb4\xf6\xde\xa6\x05\xb11\x1f\xefz7\x0b;\x1d\xe0\x88\x81C\xeb\xf0$\xd5\x01\x06\xd8R\xceY\xb0S\xc6\x11\x03\xad\xd4\xb7\xaf\x1f\xd3\xf4T\xa5M\xcbV\xd5\x86A\xc7\xf1\xbb\x15\xba\xab\xc5\xf7\xbc\x91\xb5\xb5\xc7\xad'\x9fE\xc8\x1d0\xf4\xe4\xab\x1e]$'9\xc0\x89\xe7\xbf\x90\x18h4\x87\xd1\"\xb1v\xfa\x00\xae\xd9\x87\x8f'IA\xb4.\x90\xcc\xe8\xb63B\x0a+\xe1\x04\x9b\xb6\x17\x05\xa3!TlM\x89\xbau\x9e\x882\x05\xcd\xe2L\x84\x00M3\x07C;\x8b\xcb\xf8\x03\x98\xe8\xde\xbeHFZ\xb4\x0b\x13i\x0d\x03\xa7\xa0\x8a\xbb\xfd@ew\xb3\x07H\x89|b8\x82\xca\xb2y\x0cE\x93\xb4\xc8(\x81\xb0\x0c\xc2\x12Xf\xde\xac\xcd\xb2^\x90\x88HZ\xcc\xcf\xd0\x09\xbb\x83\xacl\x09S\xbd0s\xf5\x95\x97\xdb\xf1e:\x92\xe3\x95\xb3\xc0\xfc\x9a\xabP\x0a\xd9\x92\xab\x17\xd3\xc1\x92\xcbY\xbf\xd6\xe0O+\xb3\xee\xd5\x8d,\xfa5\x81\x81\xc6\xc9\xcdP\x15\x82\x1a\xd0\x06\xd6\xf8\x11s\x00\x91X\xd9\xe2#\xbc\x8eL\xf6c\xe3(WP\x98O\x0c\x07\xed\xe0\x13\x0e=\x09\xd7\xeef&K\x86\xda\x8c6\xcbr+d\xfc\xa2V;\x90J\xe5XFT\xd9\x15?O\x90I\xb1\xe1\xbe-\xdan\xecC2\xfc\xde\x102q\xc2\x1e\xdd\x93\xbf\xf9\xde\x8f\xdfV\xbfp\x0cF\xa2\xdb\xf1;\x86m\xeb-2\x1a\xf0\x84#C\xdf\x94\x10\x95=\xaf\xcfE\xbb4]\x0dMV2kp\xe8\xa8\xf6\x18\xffH\xe1EY\xc7\xc2d_\x99\x01o\xf3J\x9a\x16\xbc\x8c\x8f\x94\x98W\x8ba\xf8-9\xc2\x95*\xcdY\xa2h\x01{\x14\xe8r7t\xd5-Qn\x8d\x96\xed\xd1J'\xcaV\xb8\x88\xde\x11\x06\x9ce\xae\xdd\xa7Og\x1arU\xa6\xc3c\xc7\xben\x18\x82\xb3\xfc-VN'\x859\xa2\x86\xda\xd4\x9d\x1f\x1aX\x01\xbd\xc7\xac;U\x8c\x87\xb3\xa0\x81vU\x01\xf2\xe9\x0a-\xcaOI\x12\x88\x16qX\x85\x11k\xad\xb8\x19\xe9\xf9\x96o1\xa31\xf7\x900\xaf\x98\x0cR\xd9$I\x1c\x94k\xf4\xd0O\x9fe\x01h5\xddqu\xdb\xd3\x8fH/\xd0\xd4a,`\x02\x88~\xd9\x8a4|\x071r\x96\x1d\xc3\xfb9w\xd8vi\xb9D\xf0\x08\xa6\x83\xd2R\xe2\x12|\x94\"f\xf8psX\x11W\x9f\xae\xee\x17\xf4^\x96S\xf5jS=/s\xb8\x1b{\xd2v\x1a~\xb7\x94\xd3\xa4\x05\xeah\xf2ZQ\xbe\xec\x0b\xdc\xbb\x03\xf4\x04\xfa\x94t\x97\xa1f|4\xee\xf9\xee\xe9\x0a{I\x82\xf4\x8e6L\x87\xfa\xe6\xabv\xcb\x09:h\xc4>\xd9D]\xd2m\x92\xddN\xd8?D\x8f\xca\xb0P\x0b\x16\x8b\x12\x87\xd0xs\x8e\xd9\xc0\xc5\xb6\xa3)\x17I\xb1\"s8zI\xae\x9f@\x13\xde\xab\xeeD\x9c\x1c\xcb\x8a\xc4O_\xdah\xd7\xa6t\xafml\xc7#\\x5\xe8<\x03qp-\x82\x93\x99\x1b\x93o\xd9\xbe&\x86\xd1\x12\x00ae\xdcR\xcd\xceJO\xa75\x999\xb0A\x1a\x16.\xb0\xcf\xa0C\xb2\xbc\xc8\xcaT\xc0~\xf2\x01\\Agk;-\xb5\x05\x99\x8bI\xa8\x15]\xb7MJK\xb4c`,\xb9\x83\xb9\xbb\xd80\xc9\xc3\xc9Mk\xed\x81\xc1\xca\xab\xf3\xf6u\x02\xcf\x98m\x1e\"\xa5]\xa07\x8f\xe6w\x1a\x9b\x8f\xf5xj^\xe0\xa3\xf3*\x89\xe2n\xb2\x95\xcf\xe9\xf5U\xd5%B\xf9\xe46\xc5\x9b|n\xa1\xe1\xca\x04\x92\x9e3bhV$F\xa8\xa6qgu\xa5BE\xa9\xc6c\xcf\xe8\x0c\xdd\x01\xf6\xf4\xf9#\x07?f\xa8\xb7\x9f\xc2\xc0\x0d\xc9\xbf\xc7\x09\xdf?}s\xe3\x9a\x93U\x97\xfcP\xa8.\x89.\x8ar\xa5l\x17\x9d\x14\xaa\x8a4\xef?+h.\x0c\xba\x8d~\x87@e\x0a\xfcj\xbbJ\x99\x94J@\x10\xb6+\xbb\x0f\x92a\x15G\xb4\xf0d\x89\xa9\x1b\x10\x96u\x0b\x0d,^\x1caU\x02Z\x8a\x9c\x8b\xa4\\\x9d\x80\x98n\xd8\xb7\x12\xeb\x12#dI\xe2q\xa6U!R\xd3\xb89\x1e\x00y\xa3\xd9iP\xfcd^\xca\x95j\x8e\xfe\x19\x1a\xd7I\xabh\x98\xc0\xdaZ\xcb\x12\xa3w*1\xfa\x7f\xfb\xc7\xff\xf2\xf7\xff\xf7\xa3\x04Fw\xd5\xc6\xf5\xce\x109\x1aX\xbd\xfa\x85\x94y\x1a\xfd\xe8\xfb\x98\xae@\xf5\xa0\x01\x8bK\x95Qg\xac?\xc1\x80\xcd\x8d\xab\xfd'\xb7\x9b\x95\xcaD\xe4\xc3\x86\xa1\xadq\xe2|\xe7\xee\x99\x046N\x9c_\xe0\xecwW5M\xe1$J\xe1\xf9.j\x97\xda\x82\x86\x86\x89<dr\x0a\xc6j<\x0ao\xe1\x17\xa8\x9as\xe8`\xc5\xcb'\x11\x00\xc9{\xed\xae\xca}\xa6R\xe6\x02\xa2s\xfd\x04\x15\xad\x1ct\xdd\xa4,,\xca8\xc7\x0c\xdb\x93j\x13\x11t\xb6h$\xdf:D\x9e\x04\x87\x9c\xd5\xee4\xac\xcf\x1a\xa2\x0a\xe5C\x83\x1f\xc3\x05\xc3\xcf\xad\xd9\xb8\x96=6\xa1N\xea\x89\x9cS\xc7\xb7\xb9Y\x9c0\x86\x1f2#\xf8\x14\xc8\x84\x9f\x88\xe3ys\x10fl\xfd\x1b\xfaf\x05\xe0`i%\x89\xa211\xe0%rZF&\x86B\\O\xaej\x86~\xc8\xd1\x9c\x86\x01[\xde\x98\x90\xcf\x1eF \x83\x94\xc3\x0a\xbci^W\xb1\\@\xfeo\x12\xc5pe\xbeR\x1c\xcb\xd18\xd6F\x17-\xd6\xa32\xecI\x0bl\xcd\xd8\xb7\x15\x9b\xb1\xf4\xdcd\x90\xc2\x1bN.\xaf~m\xc0\xf5;\xb3[/\xb0\x83\x8d\xf6!\x0a\xccK- z\xa8/B&r\xea1n\xcd\xb0\x80\xac\xd7\xd2,\xf8\xcf\xb8\xc8)\xf7vW\xb70Au\xf1\xeau\x9a\x84\xc2\x9c\x10\xd0\x10y*\xf4\x87\x856#UQ\xc7\xfa\x04G\x07]\xbc\x93\x083\xf2\xf7\x97\xba\x7f\x08\x83\xb0\xe7\xe1\xfc\xb3H\xce\x86\xd0\x87o\xdd\xc8\x9e\xc4\x87r\x95Sw\xad\xca)\xc9\xfcf\xc5\x11\xb7\xeb\x07\x1fI\x9f\x9f0ga;\x1b\xa1'\xc3'!\x09Q=apC\xa2>\xdb\x9fP\xba^xB\x8f\x96\xb6\xd6'\xd5_\xe0\x9a\x0a:\x8c.0\xc4\xd8\xce\xef\x0c\xceD\xa1\xb2\xeac\xdd\x18w\xe2\xe7\x99I\xcc\x93\x9duq\x1b\x03S\xec`\xe2\x0c[\\\x19\xcb\x19v\xec7\x89\xb4\xd8D\xf0\x0f\xbd+\xa2\x8b\x1f\x89\xcd{\xb3\x98\x93\x88\xf40\xc2`A18\x1b\x94K\x97c\x1a\xdbfQ\x8e\xcb\xf1\x13}M\xcb\xc1Z\x86\xce\xc5 \x1f\x08\xb3\xa5`\xfaZ\xb3J\xd0}\xb4\xda\xbf\x18Q\xd58-\x99\x93\x96\xed\xd2\xbeF\xc2\xef\xdb\xba\x8bf$\x0d/\x81\x8a\xf7\xa4Uo\x14\x9f\xfa\xdc~n\xf6\xe1\x83\xf1\xafW5FS\xfe\x9d\x1b$\\\xaa\x0dm\x93lE\x13jS\x8b$\xf8\x98\xd0\x0ex\xc1Op\xe0\xdaJ\x95N\xb4j\x941W\x86\xc8X\x18\xd9\xe0\x89\x9c\xb6\xe8m\x93\xfe\xfe-\xfa\xa1+o#\x09\xb53\x9c\x1c\x98)\xc0\x02\x12\x95\x8d3\xe1\x83\xca@9\xba\xf2(\xf9\x85\x12\xb8\xe8\xc5\xc9U\xf6\xfc3Q\x8cl\xd8\xce~7\x870\x8d\x10\x06\x92\x1aQ\xf8\xf2a\x9a\x9e\x96M\xa7(&*>\xe3\x9cN\x0f-\xb3\x8d\x1a\xfb\xa2\x91\xb1\xbb&\x16\xfb\xce\xc2\xe0\x0f\xf5@c/\xd8\"b\xfc\x19:\xfc\xf5\xe2\xc3\xbb4\x99z\xc0Ka_\xe3\x92\x14\xd3\xe7\x84\x8d\x91\xd2pK\xf4\xb4\xe6fHY\xdf\xc5MQ.\x06\xb7~\x14\x93\xc4\xbfTR\x9f\x9a\x8a;(\x80/\xe5 \xfa\x98\xe4\"\x8a\xd2O\x97z@\xec\xc7\x19\x06 \x9eZB\xa5pa9\xcf|\x0e\x0c\xeem\\\x13\xfb\x9a}1\x11\x08\xfa\xb6\x84p\xa4\xc6\xd15T\xdd\xb7\xa2).|\xdf\x84\x1d\x19\xe0V\x0b\xeb\xeeyF\x13\xd9-\x113\x89ad\xb0<B\xb5YY\xeeQ\xd9C\x16I7\x8d\x05\x8e\xb6\xe9\xd1bn\xc7\xfa\xe2[\xcf\xec\x9a{\xfc\xd4\xca\x07\xbd\x9d&\xe3\xa0\x83ht\x18\xea\xcd\xc2\xbe\x85\x86zh\xdb\x0b\xa2\xe6\xe9\xd8U\xc2M:j\x81^\xea\x9a\xbb\xfc\xa2\xc4\xach\x1e\xa5\x1e\xbc\xe1\xa2E\xa7\xc2\xa10\xe1\xe0\xd1\xb9\xd9\x00\xceJ\x11\xa4\xe1\xb2)\x09\x8e\xce\xac\xc2\xd8\xfc\x89_\xc9\x89\x8f\xb6\xf3\xf2z\x84c9\x7f\x81\x91,\xab\x0c9\xc2m\xf1\xbdS\xb8\x12\x11\x08\x8b\xd1\xb1\x88\x00\xf9\xf6\xba\x16\xe8w\x85\x90\x8a\x09f\xa5\xd2\xde\xaa\x01\xaf\xa2-\xb2\x06k\x0em^|\xc7n\x91\x81\xfa\x1a\x01i\xbalF^z,\x1c\x13\xbbE\"+\xfe\xb4Jx\x12\xd51\x7fX\xd0\xdd\xb5y#\\\xfdb\x81x\xc45\x90\x04EN\xc9\xaf\x07\xe2seLH\xfc\xe8~\x843?\xabi\xbe\xd1!\xfblZ\xe0iO\xbb\xae\xe9\xfb:\xc3\xed$\x85\xb2\xd1\x164\xcb\xdb\xb6\xac\x03\x16\xbd\xd8\x83o&\x97\x18\xef\xa5`\x9cNj\x01c]|\x0bB\x81L\xd7&j\x07\x13\xab05l\xb4#\x901\x9eL\x8e(EHN\x04\xc2\x19m\x81tu\xa9\x8f\x9f\x85L\x80L\x98o\x8df\xc8\x12\xdb\xeb<\x987\xa3%J\xda.\x9c\xe4\xc6\x87\xed\\\x92Cu\xff\xa8yg\xac\xb28+i\xa8P\xb6\x0b]'^\"\x95\xfd\xf0\x00'g!j)\xee\x02g\xb3fcl\xd9ml\xaa,.dzIY\x8a\x18\xb4\x9c\x86P\x0b\xb4\"l:\xde\xc6\x91\x9f\xaa\x8e>VP\xd5\xecI\x08\xe5\xc8\x89\\lW\xf2\x8c9s\xca%\x09/%\x90\xce\x0b\xee\xa78\xdcJ\xc6\xdf_M\xbfR\xdc\xd0\xce5DH&Obo\xc0\xdb\x88\x095\x88\x90\x0b\x0d\xe4\x0d\xc7e\"\x7fK9{\xb1\xbb.\xf9\xb9yX\xa0\xcf0nM\x19\x0ek)>\xe3\x8a\xcd\xd5\xfe[>\x88G\xc5\xa9\xf9\xdc\xd0d\xe8\x12\xa1\x9d\x85%Qp\x8c\xd8\xaelZa\xf0I\xe83\xae\x0bx&\xcd\x1fw\xd4`\xaa\x9aW\xdb\xcb\x1b\xd2\xcb\x18]u\xf4\xa7\x8c>vd:;f@\"\xdd,[\x01\xbcG\xd0\x19\x16\xc2\xe6\x81U\xc6\xef\xd2\xae\xdc\xba0QO\xd0\x1f\xe7e\xe3\xca\x0f\xc1\x1e\\.\x9eK\xac\x19\x9b({Q\xe0:I\xcfA\x04\xf1z\xe1HT\xf0\xfc\xb8\xdeU]\xcd;`\x9ee\xd8\xdd\xa2\x1f\x93\xea\xc8\xdf #\x13\x85/\x7fb\xed5\x9d\xb2f7\\\xc4\x93p\xc14=\xf4\x0d\"\x86\xeb\x10\xac\x94[f\xd1[\xd2\xfe\xe8\x8fdF\x87\xe6)\xaf(]A\xac\x98D\xa8\xf6\xa9\xc2\xd7\xd7\x88Q\xa9\xd9\xf9S=\x1c\xa0?:\x86R\x1c=\xc4b\xedp\xeb\xc2N\x9e\xa3\x0c:\xdft.X\xe9\"\x1a\xf8y\x10Vh<\x169\xae\xd9\xa0J\xb0\xacf\x04\xe2\x81\xd98~R\xbd?\x12|h\xfeX\x04\xa30TQ\xa3\xfb\x15s-\xdfV\xb1\xba\xa9\xa0-\x11\x01\xb6J\x97X]l\x13\x84\xa5\xef\xdb\xeb*uWP\xb0'\x8cM\xfc\xa8\xe5\xfc\xb1 wcx\x16\xca\xe17h\xfcJ:\xc5\x18D1\x9d{]\xbb\xee\xabs<K\xf1\x7f\\\x0dQ\xbajk\xc4\xe296\x81W?\"\xa6\xf5\x9aFh\xaa\x8bn\x90\xec\x97\xbas\xdd\xc5\xf5{\xf9\xc5Z;\xb6\x00\xcd\x88\x889};\x1aT\x9c\xeb\xb6lXX?\xbf5\x8e5\xd9\x1cR\x0b.C\x98\x0a9\x8eR\xc4\x94^\xb6\xb832OOv\xc7\xd9\xa1g\xd7Cut\xbd5\xfc\xf0\x16l\xb840\xeafG\x15`\x0b\x0f\xa1y\xaf\xd65\xce\xa0\x0a\xd0\xf6\xa1D\x01\x02\xdd&\x18\xf9m\x0e\xb4\xe2\xf0\xdd\xa2\x90-u\xeeB\x1d7\xda$\xd7:\xf1M^\x12\xf7\xa3?\x97*\xe2\"y\xc1\xc55n C\x10\x1di\x1e\xf4k\xf6\x7f4\x1f\x85I\x83H\x0ed\xa4r\x1cQs\xa4TH\xc9f\xa6\x092\x1e]X<\x86F\x15\x08\x90$\xb9\x02m\x9b\x0b4\xc4\x01b\x96\x0fZ\xdd)\x16IE\x07<\x99Dt\xc87`\x82\xbd\xb7i\xd4\x15\xd7\x02\xdb^\xd0\xc4[P\x82\xd3V\x13Y\"d\x19\xd9H\xec\xc1\x8cG\x14#h\"\xa1G\x89\xc5CPM\x05\xfbEb^~t\xc3\x12\x88N\xac\xf4L\xd8:\x8d\x93Ou\xe7e\x03\x88^\x9be\xe8' V\x07\xfd\x11\xfa\x13VH\xe0`\x06\x15\x97y\\\x85\xcf\xf3\xa7\x8fj\xe6\xd4y\x90\xf7\xc6\xed\xa6\xf1s\xb9\xc98b\xb5u\xd4:\xbbi\xef\xf9\xf6\xba0\x9e\xa02\xe2\x8b\xa74\xcb\xd4\xe98\x7f\xb7\x96\x8e<\xce\x9f\x93\x0fm\xb9\xa7\x1f\xb1\x9a\xa2\xb7uq2\x0a\"\x89\x8b\x0ehnh#-/\xf1\xba\x19TKZ\"\xe3g\x0f\xcf6\xcf'\xf3:#\x01\xcd\xf9E\xef\xfd\x09\xb2K.\xc2l\x8f\xeeBC\x86\x96Uf\xa7S5\xbf\xc8\xd6\xfc\xb3\x19\xdd\x85\x82\xf0\x84\xb3\x9dQ8\x89\xdd-\xee\x97\x95~/%m\xc3\x19\xb9\x92\xc4\xbbY#\xecR\xc0\x99\xf5\x91\xaf\x80\xcf\x9e\xdc \xcc\x97\x14\xf5\x9a\xd1\x8eL.K\xa5R\x07\xb4\x81\xa6H6\x9bl\x80\xa6.\xf3R\x0f\x17\xe8\xef\x09\xed\x10NH%\xf1\x9aH\x1fr\x9cS$\xe0\xd7&1\x85\x97\xce\x95\x18\xe8\x121\xbc\xba\x86\xab\xbcA\xdeH\xed\xf7`\x8d\xe5\xc2\x89\x7f\x96\x15q\x8e\xfd4cB\xf3t\x9aI\xc0\xa7\x934\xad\xa2\xe5Wx\xba\xd5\xd8\xad/\x84\xf6Y\xd5\x16y\xfeb\x96\x80\xcf\x90\xdc\xfa$=\x8c\xf5\x19\xa6\xc6\xc7UI\xf3$d<\xf2\x11v\xe5\x08\xe3\x00\xf7_E\x0a\xe89\xb1\xe2\x95\x0e40+\x0d\xdd\x1c\x13y\xfa2\xeb\xf3\xb5ny\x19\xed\xbe\x96\x95T}\x87\x163G\xcfl\xe4$<\xc4'\xdfA\x1a\xb8\xe9adY\x0e\xb4\x94\x96\xc9\x7f\xff%\xae\xd7\xf3\xd3\x0d0\x14\xf7\xc7p\xa0\x85-\xb8\xc6\xb7H\xb6 V\x07s\x90\x19\xa5!\\H\xc3!fT_\x08\x87z\xad\x19^\xa5\xa2=y\x859\xceY\x13\x86_\xd8\xa5\xd5\xf94&\xa5\xef:\x1fc\xc6K\x1bw\xb5\x09M\xb7]\x8b\xff%\xf4\xab\xf3:\xb2\xd4m\x7f\x84" +
+	"\x98\x1d\x06B=F\xb3\x84c\x0bo\xcf\x0bPX\xc7\xe1\x8b\xd6t+b\x12\x9b\x88s\xc8\x054\xf3\xc1\x05\x14\x9dIj\xbc7\x0c\xd4\xcb*_\xef\x8aj\x9d&\xfagI\xbcnP\xa4#Y\xef\x160\xcaG+O\xc72\xe1\xf8\xa6BO\x81(\xae\x86\xb4\x09\xd9\xcd\x91\x9a\xf6C=P\xc4,X\xfc!'I\xa3\xc1\xe4\xdd\xea\xfb\x1aQ\x8e\xd5w\xd1m\xfa\xd1\xa7\xc3>\x82\xdb\xee\xeb\xc6.\xcf\xf9N\x80\x12\xf8\xfdV\x8fe\x15\x1de\x1bK\xe46\xf4\x82\x9b+\x9a\xc7\x91\xfc\xb1n\x85nF\x0dKA%\xc5LO\xe2bf(\x06S:%d\xa3\xf3s\xe2\xe1\x18\x0c\xb9&\xf2\xa5v\xe2\x9f\x09p*R8\x8a\xed\xe7\xb2\x8a\x89\x02\x16\x18\xa2\xf0C\xdc\x0d\xc3\xdeE\xe8\x127\xec4\xe3\x94\xd6\xd2\x7f\xf4\x86\x970\xe5\x94\xd3\x94\x7f\x95\x90\xa6E\xe0\xd3\x18\x16\x99\xf0K\xe0\xfc\x95\x14\xee\xa1\x9e\xdc\xff\x8f\xb8\x7f\xeb\x95\xdc\xc8\xd6\x04\xc1\xbf\xc2z\x92;\xe0\x1ePHqQT<\x04B\x15\xddPd\xa4\xf2$\x8e\xd4(d\x03\xf5\xb0|\x93r\xda\xe6e\xfb!i\x10v\x02\xf30\x98\xee\xaeA\xfd\x8b\xc6`P\xfd0\x8dA\xa1\x1f\x06\xf3O2\xff\xcd\xc0\xd6\xcd\x96\x19I'\xa5<\xa7\xe7\xc5w\xc4\xde\xeet\xd2.\xcb\xd6\xe5[\xdf\xa7\x95\xf9\x06\xf5\x86\xaf\x84[^\xd9\xeb\xb1\xd3EYf\xf4\xc4\x8e\xbc\xadt\x9b\xc5\x8a\xee\\\xda\xe0S\xe8\xb9$\x7f\xd0\xde\x16\xdevb\xc9c3.r\x0d\xee\x90\x98\xfb\xc4\x9f\xce\xce\x80?\x81k\x0c\x1b1/D\x19Q\x03\xac\xa3\xe6K?\x15=tr\xbc)./\x18nxfM\x0d\xfc\xcf\x05w\x80<\xd7\xbc\xd9\xb7I\x1d\xb4\x7f\x00\xa4\x9eJ\xca\xd9%\xa0w%\xd4w\x8a\xed\x09\x8b\xe5Z\xb5O\xdd\x05\xd3\x03\x8c\xf0\xbd\xfa\xde\xf7\xd7\x17\x85\x0c\x95\xd6\xa9\x0b\xe8\xa1\xc1\xe8\x8a\xf5,\xc2\xff\xe5+\xb8Y\xccV\x82\x1e\xc1\xd7\x848\xee\x1f\\kc\xa3\xf0\xc1p\xe2\xf5<\xec\x87\xa6*\x1a\xb8A{\xb4]Ln\xd1\x04\xd3W\xc9\xdb~\x17\xfbJT\xb3\xfbHO4\xd7\xbcn\xa2%y`\xceK\x161\xa7uA\x83\x94I!3\xeaA\xc2: \xa0\x07\xd3r--\xbb\x8c\x91\xbd\x13\xa1t\x17\xdb\xfc\xe9J\xc1\xf0\x08\xadt\xcc\xa8\xf1\xf9\x9a68\xd0:\xb1[)Nq,/JEB\x8f\xf4\xaa\xf4\x10\x95\xb3\xc3\xbb\xc2\x17\xe8\x0c\xaf\xfb\xc9;O\xafW\xafRg\x84\xcc\x98\x09\xe9~\x00\xaez\x04\xb3\x82\xf3\x0dn\x10\x87\xb0\x06\xedC<\xd4\xfe\x11z\\(u\xd5#r\xeb\xc4#\\\x92\xad$\xcc\xe8\xe8\x07?!vU\xec-\xff\xbej\xab\x11\x14\xf2jv\xca\xcd\xc7]\xab\xc0\xc9\x8bo\xdcT\xfc\xc1\x97N\xd2BM.\x15\xd6\xc3\xb8\x9c\xde\xda\xd3\xd2\x93*\xfd\xf1\x1aA`\x86\xcd\xdb\x93\x1b\x8a\x81\xfe\x0a\xed\xcd\\\xed\x07\xf7X\x9c8\xb1\xe5\xe2i.\xe8\xbf0MN\xaad\xd8\xc3@\xcb\x88\xff\xba\xd4\x16\x11V\xcb9\xbcDc\xb7\xad\xb4'D\xaf\xd9\x89\xb6\x1cMb\x96\xc50\xdf\xa6\xcf\x14\xd2\x15\x99H\x92\x9c\xdc\xb1\xa3\xf1x\x9a\xf5\xc9\xa0\x17\x0d\xfdQ\xb0T>f\xc1\xf9\xfcAF\x88\x9f\x16X\x03$C\x1axj$^\xf9\xe25U\x1a}\xf5<\xbbT\x1cjh\xaa\x06\xa6#\xc3\x8b-\x84\xa6\x11\xdc$f\xe4S\xd5A\xc3\xebA\xb7qgS\xea\xe9i\x98(\xb6&\xe6\xad\x8deu\x95\xad\xaf/\x8eT\xc8b\xc9j\xb5,Y\xe9\xe8\xc8\x9f\x92\x11Z\xcc\x0a\xa5\x03\xc4D\x0a\x1cP\xf2E\xd2>?\xdb\x98!\xcb\xfb\x80oA\x8f\x13\x06O\x84\xc2#\xe16\x13\xf17\x01\xf4\x80X\xfc{\x9d\xb6\x83\xbfne\x07\xa3\xb8\xe0\xa7e\x1b\x87k\x1b\xc1QF}\x87W)\x92\xc9\x90=b\xb9!,\xf2\xf0d\xf4\x11\xe1\xaab\"\xd2[\xeb;\x98\xce\xbe\xd3\xdeD.\x9b\xd1'\xd6\x98\x88;\xd6\xb42\x92\xc0\xf1:\x91\xc7#\xa7\x0a\xb0`\xa7\xc3\"\xcf\xc01\x11\xe2\x8f\xe9\xda\xb9#\x8d\xe4\x0c\xf1\xb9\xb6\x06\xf7\x9d\xc18\x89%\x8aq)1\x91\x9fW\x08\xc9c\xb2\xd1<\xdb\x1c\xc7\x19\xb1<\xefS\x15\xd4\x18\xecD\x15\xea\x99\xec\"F\xc6\x8a\xfaA\x02|\xa9=I\xff\xd9\xfbYG\x1d\xf3,\xc7.\x1f[\xdd_\x9a\xbc\xfbr\"\xaf\xbfN\x05\xec#\x8b\xf2W\x1f\xcb\xe2\xa0\xc4\xbfG\xa1\x96\xd3\xaa\xf0\x0f\xde\x97/\x8a\xcf3n\xb5$\x9f\x8d\x95=Mk\x9d\x96\xc1\x09\xc2\xd8\x9e\x03\xfaX8\xc0\x8aI\xe4\xb2G/\xb6\xc5\x0f\xe7e\x8c\x19\xfd\xb8\xcegR\xad\xa0\x19\xd9GA\x9e@\xd5\xb45\xa3\xf1+\x89\xf8\xce5\xd8+\x83p\x87-\x19\xc7o~S\x09\x01\xcdh\xb8\x8f\xc5\x0e4\xd5\x98y\xbaP\xbe#f\xcf\xd2\xee\xb2\xae\xea\x05\x93\x89>TRU-\xab\x01F6+\xa7\xbcG\xac\x87N\xea{U\xe3\xad\xa8\xa8\xfc\xbf[\xe6\x9f\x8f\xd4\xf1l\xe8,\xc1\xe8n\xd1\xc4/\xb2v\x17\x96eX\xb2\xa7\xec\xa4\xb5\x09\xf7\x98\x19\xe6\x06\xa3\xac\xf2gJ\xa0\xebU*\x0b\x09\xefP<\xe7,\xff\xa0\x8fr\x82K\xc5k:\xbf\xe7\xba)\xa9\xf0\x0e=\xc5\xa4\x0a\xad\x05\x8cX\x10\xbepY\x84\xa4\xcb\x8a\xf4\x1eu)K\xb3\xb8\xaa\xd6]]\xcb\x1dqI\x17.\x8e\xed,4\xfai\xc1\x09 X\x9a\x85\xe2\x92\x8b\xc9d<\x093$\xc7\xd6\x97\xe5\x0a\xceB\xee3UXGN+\x86\xa7\x9d\xb6\xa5\x13\xf1\x0ad~hCX\x06\xa8\x16z\xbe;\xe5\xf3\xec\xbcR\xc0h\x16\xa3\xf1\xff*\xd2\x0a\xaf\x97\xf9\x96\xac\xeaD\x03m:\x8a\x92$(\xa3\xfe\x97\xd5rZ\xec\x99#\x95tY\x12i\x87\x19\xeb\x08\xf8\x8b\xef\xcf\xe1e\xee\x82\xea7r\xfc\x93\xf3)nm\xda\xb7w\xf4#V\xdb@\xa3\xe6fw\xb3\x05\xb2\x8e\xb0\xc6\x07\xe8\xa0\x87\xe9\x18}\xc6\xd2\x85\x03,jk\xc4O\x1e\xa3j\xa4\xb87\xf2H\x9a\x1a\xbcB?9\xcc.F\x8d\x90X\x0e\xc6\x03\x01\x01<G\xbc\xff\xf7\xb9\x04\x9c\xc6\xfbh\xc92\x0e\x09b\xd7U\xed\x8b\x95\xd1\xe5\x03\x9ec\xf6\xdf\xda.\xf1\xfa\xbb%z{\x91_\x0e\xbe\x15e\xb0sJ\x17\xdc\xcf\xf7h\\b\x9e-\xa2\xd4-\xf2\xe1\x0bR\xf2\x96\x0bl1\xa9\xa8\xda!B\xe4\x8e&\x83!_\x05\xd3\x0e\x01\xc8O\x84\x97\xa2\xf4\x90\x1b\xa1>F\x1f&\xe2b\xfak\xb3N:8\x07\xd1\x86\xc3\x81x\x1b]Bp3\xd3\x8f\xa7\xb3rr1Y\x8bf\x013'\xe1\xea\x12\xf9\xa6\x1c\xd1-\xc11{\xf68\x0dT\xf2\xc5\xb6l\xe3\xa7\x19\x98R`\xe7\xb2D\xfd\xe4\x13|=\xe5\x87B'6\xd5zi\x0eRr\xc0U\xc9W\x1d\xcc\xf0\xc9\x04\xe6m\x87-~\xbch*\xa2\x9eU\xa9\x10g\x9cJ\x86\xf5\xe2/p\x99\xddc\x1b|\xf3r\xc1A\xfdy\x84\xce\xdfsO\x8b\x9fjh\xab\xfa\x05\xff\xfc\xb7\xf4Q\x83\xeb3\xf3\xfc\x84\xa8g\xe0<\xd5a\x82>\xacJ^W\x86\x97\x1a\x15[\xa0\xe9\xfc\x90\xa3\xdfSON\xf8\xd85VZ\xf3\xe2\x96m\x08n\xcb\xaa\x091_Te=\xca\"\xec,\xa3\xee\xa1|\x12\x8a\x94\x1d\xea7o\xbeI</\x9e\x99\x85\xf1\xa6\x99X*\xfc\x9b\x08H\xfb\x94\x19\x15\xb7\x04M\xc3\x7f\xd50 \x06\xfe\x940\xbb\x85\xa3l\xd0\xf7$\xfd\xf3)\xf3\x07M:\x15v.\xe1\x0ag|\xc5\xcf}X\xe02\xa5\xd0v\xf2\xed\x19_\x13\x82(\xe8\xa3\x8d\xb9Vm0?\x89\xf4\x06\xf1 \xa5\x9dT\x99\xbf\xb95\xc6\x11\xfa\xbb\xb9\x9c\x17\x90m\x16&\xb4\x0b\xd4f0mxD\x19P\xdb\xa1\xa9z\xb88\xe9}\xa1%G'\xf5:v\xa9\x80M\xf4\x92XZ\xa2\xc3\x88I\xb4\xd4Y\x07\x99G\x9b\xc3\x91\xdd\xa80\xda\x06s/\xd0\xef\xd0\xd0\xb4#\xc7\x9d\x10\xbe\x9f\x80+[\x090Ipm\xa2P\x13\xc9\xdd\xb0\x1c\xcc\x90\x1d6\xe1\xb4\xf62\x82\x1e\xa7\xe2\x17\xd8=,\xfdOh \x0c\xfai\xce^u\xbd\xa2\xdfhJK\xf4\x8b\x08\xaeL\xc6<e\xfc\x9c\xe7Q\xd0\x0el-\xb9\xd7\x19\x8c\x874\xd1=\x8fO\x84*\xf0R\xe4\xed\x1dE\xe9\x87\xd170\x90C\x99V\xcaJW\x08G<9\x05\xc5\xe4\xae\xd4Dq\xb2 s\xca3\x9b\x14\xa5\xb4\x9f\x0b\xe5\xe9\xd6#\xbcY\xd40\xd9v}t\x93\x113\xce?\xe2\x00\xd1N\x82\x81\xda\x05{V\x8f\xa9Y\x1fw\xc9\xe1\xeb|8Q\xce\x7f\x94\x8d$<$SR\xe1\x1b\x1a\x18w\x09jbd5!\x8e\x11+7\x03\x8c\xc6Z\x85U:H\x98w\x07\x94\x90\xd2\x8dw09\x12\xfeF\xa9\xe6\xfej&\xb5\xbf\xc3\x9b\x13U,\xc7\xea\xa9\x85\xfa\xfcdZ\xfb\xe8\x08\xe0\xf4\x99\xf0n\xc4\"!\x8e\xd6=\xef\x84\x0f\x1e\xeb\xe8-\xfb&\xa7U\xe7\x84.\xf1b[?r\x86\xb9'6\xa83\xfd\xa0\x89?\x08\xb0\xf0,\xff8.\x8aJ\xb5\x97\x01j\xd7\xcd$\xc7,?Q\x82\xb5\xf0\x0fx\xf0\xc5\x10\x1b\xd7\xad\xb6e\xf1\xe5\xe6\x918\xbe\xcd\x14\xc0\x0c\xbf[K=\x0c\xecX\x98K<\xfb\xab\xa7\xe6\x10\x08\xa3J%\xbe\x11n\xce\xa0\x1e\xd18\xed\xd0\xb8L7\xa1k\x0515\x01?xJ\xc3\xf9\x08\xbc\x9a\xcc\xfd0,\xb7\x80\x9e\xc4\x7f\xb9\x06\x93:\xed\x03\x8c \xcd\x02Y\xc7\x12O\xc4\x12\xb0\x8bD\x86\xf9\xb8\x09\x1f>-x\x00l\x98\xec\xd4\xfe'\x9eZ\x1e\x0f\x8c4m\x16\xf5\x8f~\xda\xd2@H\xa53\xdd81\x94\x1c7A83K\x87P\xb6br\x03\xb8c\xc1\xee>\x10Ox\xff\xec\xbbD\\0\x0b\x8b\xf9JV\x1dz\x8a!\x07\x92\xf6\x84\xf3\xea\xf3X\xa3\x85ekE\xff=0\xbfL\xed\x86\xe2\xe6\x91I\xf9\x19K\xc8_\xfd\x8b\xbf\xec\x90\x85\xfcl\x9f%\x91D\xa3\x81e\xd0\xe2\x00\xbd\xf5\xe4 \x86\xdf\xc2)D\x0bO\xb5\xc8\x1a\x9f\xfb\x89\xb7j\x08\xae\x0d\xf4\xc5\xe4y%8\xecJ\x82\xce\x11)j\xaa\x0aD\x11\x99/\xa1\x0e\x1f\xc0`\xe8Cf\x8d\xc3\xe7-\xde\xc2T\xa28\x0d\x1a\x96\xe1nm\xc8?cprg\x05\xa6\x0an\xb8\x8aqht\xba\"d\x86;C\x0f\xb1\xb0q\x94\x93'\x06\xa7\xb1\x14DNZ\xe9\x1e\xfc@\xae\xa9\xb4b\xf8SQ\x83+\xa0n}{\x017\xfd\xbb%\xc1*\xe2o\xab\x86\x9b\x7ft\xc9\x19\xd4\x85\xcbm=\xff\xab\xc5\xedo\xf6(S\x90\xd0\xaa\x13[\x08\xfd|\xcd\xceL'u\\;\xe0R\xfc\xf8\x04m\x816/j\x0d\xceM\xb0\xe8`\xd2ud\x87\xee\x10ILFFn4\x07M\xcbr\xe4rU\xff\x0c\xfd$\xd8\x1c\xc4Q\xb8\x0bw\xba\xfb(\xd3\x9bd\xf1v\xc8\x14\x06\x9f\x95o\xe0$\xea<\xc2E\x11\xc7\x00\xfbA\xc7;\xec\xf7\xb2\x98\x120\xed\x06\xf6\x90>l34\xa9g6=\xb5\xd0\xecP0\\HE-\x1d\xa17\xbbg\x8e\x96\xe63L\xda\x89Ixp1\x8d~\x8cI\x19\xfc\x05\xd1\xab\x94l\x02\x85T\xa5\xa2\xaf\xcbN\x0dST\xb1\xccPldbW\x19\xd5]}\xeb\xa6\xdd\xfa\x86\xe1Q\xe9;c\xa0.N\x8bq\xe8\xd1\x9d\xba\xc2x\xc6W\xa9dG\xb5\x90\x19a\x92\xe8\xd6\xe91x\xce@\x09M\xf5\xe8^\xe0@\xdd\x0b\x179\xe8A\xeb\xee\xce\xf4\xa3\xf1\xa7(\xa5wqu1\xfa\x07\xc7\x09\x10+\x16\xd6\xfc\x0a\xf1\x99\x8c\xe5\x99\x070\x0f\x15\xf79\x1dz\xe8\xe0\xd8\x18\x9c\xfe\x04\x9d\x97Z\x9b&\xab\x13\x9a\xb1\xce\xa7\xfb\xeaBT\x02\x1be\xb3\xb7\xeff\x01\xcc\x9d\xda\x84i-\x98\xa0\xf6\xb1\x1f\xcd\xcdt\xcb\x9b\xca\xf5W\xd7\xdb\x08?\x19=I\x02/58\xf4\x93_\xec\xd6\x18)pTGC\x89k\xb7x\xc7\xd4\xbbZ\x9a\xe7\x9f*\xe8Kp\xa6\xe1%\x97\xb3\x9c\xc2\x94h\x9a$l\xde\xce3\x1f\x105\x86P\xe0\xceTx\x19|C\x005\xbc\xdc\x90\xeb\x98\x1e1|\x8a\xd7\xe7q\xb7\x92#\x1e\x90\xbc\xfd\x0fq\xd3/\xac]\xda\xfc\xab\xeeYjI\x0cSnL\xeb%\xce&\xd96e6\xa6\xd0Z\xbb\x13$z\xbfU\xc3\xd5\x19\x14F\xd4\x06\xe6Q`\x10\x06\xb6_\x9e\xe5\x1f\x12\x82S\x86\x1bmY\x97\xed\x91L\x0f`\x89]o\xaa\x06\xb6\xf3\x11GO~\xe2L\xe2D\xf9\xbe\xe6a\xbf\x84y\xfc\xf0l\x11\x93a\x1c\x01\xa6\xe2\x11&\xfa\x1f~4\xf1\xa1\x0b\x07\x09\x88u\xf4\x17_\xbf'\xb0\x15\xaa$\x85\xffS[4\xfaV\x98i\xf8\xb0\xb5\x04\xbe\xf9-q\xba\xa4N\xfb\xeaZq\xeb-\x199\x17\xf9\xf2\xd8\xc1\xa9\x8a0C5\xa7\x89\xd1\x87&h\x97\x16%\xb0\xb4\xed2\xd5\xe7\x0b\x08\x16\x1a\x93\xb6:\x05\x17h\xa2Jf0\x80\xfe\xe2\xce\xe1\xe5\xb4-t\xf9\x17#\x85M\x95\x07L=\xa6g\xaf!_@\x9c\x13%A|\xbd\x02\xcc\xb9\xdf\x87\xf5\xdd\xab\x85\xbc\xb9\x11(\xf9\x11\xca\xf3s\xd8jw \x1e?=\xfb\xaf\xc0]\xfeA\x94G,\x13p\x0e=\xc9\x9e\xcf\xcf\x8b\xc6\x0f\xe8dLa\xbc\x19y99\x04\xa5\xce\xd8E){h\xfa\x90\x17\xdc|\xe2\xc2Ez\x8d\xe3\xdc2\x87+(\xd5\x9aXt\xe9\x0d\xc0\xc5\x17\x8f\x7f\xab\xcb\x1f\x0aM\x10\xa8\x04w+]\x86G\xe5\x11\xbd7\x9a\x0f\xbe\xf1B\xa8\xbf8\x04\xb2\x04\xa1\x14\x1d\xb4\xd9\x81+\xec\x17Jd\xc3\xcfU\xd4\xd0\x9ckhx\x86\xd7J\xd5\xa48\xf6\x085\x01\xc9\xac\xb0\x8a\xb1~;Uhc%\xdb\x8dH\xff\x19\xce\x1b\"^2\x00\x1a^\xee\x86G\x94\x17Kl\xbf[\xd5\x7f\xd8f\x0e\x9f\xe1\x15\xb6\xb56\x97u\xa9\xb1\x90As\xa8\xf1j\x14\xb0d\xad\x01?x\x1eL!;p)0dg!\x82\x0e\xe2\xb4\xa6A\xb5\x1a\x1c\xfbT\xe8Z]\x06\x8dnX|\x12\xf5\xfb\xe7\xce\x8em\xb3O\xa2\xda,laok\xb7\xdc\xe6\x8e\x15\xfe\xafX\xa2\x80\xde_\xab\x01\xeaX\xa7\xc07iq\x9d\xbb\x8d\x8d\x1d\xb8\xc1\xe4'I3\x84\xd9\x0a\xc3\x10K\xb7X\xf2\xfaP\xa4]\xcf\xe1Y*\xd3\x98H\x1f\xa1\xceg\xdd\x10\xca\xb4r,L\x17\x91/h\xf6^\x14\x1f\xed\xe5:hF_Fy\x19\x0bD:\xa2OO9\x1cN\x90\xe3\xbd\xa22\x09\xde\x0a\xd3\x02\xca\xd2\x8fE\xca\x09\xfc/\xbcu\x1ao\x80\x1d\x12\x10\xdfB\xcd\x90\xc8K\xb8\x08\xf3\xa2\xf8\x01\"\x0aB6c\x063!T}|\x9b6[\x867r\x14\xbbC\xe23\x91$V\xabC\xe3\xca\xa8j^\xbbQ\xc4\x0e\x9a8\xc6\x84\xc1\x98\x158\xa4Q\x01\xed\x95\x10\xf6\xcfK\x1fB\xa8\x91\xf8B\xda\xeb\xc9>/V\xa4K\xf3\xbfX\xb5,\x93\x8cA\x0a\xe5\xc4S\xfa@\xa9jL\x911-H\xe7wj\x7f\xdeG\xed%5_$\x8fO\x8b\xbe+\xc0\x91\xb4\xcb#\xed\x10\xba\xde%\xa5}\xf7\xf27\x98A\x13%\x11\x83\x1ao\x86\xa5\x1eM:\xe0\x19\x8b&d\xdaK\xa5\xd6\x98SI\x8e\xf7$\x10l\xab.\xa7\xa3v\xef9p\xd1Ve\x0d\xfc\x15\xfd\xafRb\x09\xd2m\x80G\xe8\xd4\xeb/\xe5f1\xb9\x94\x1f+r\xfa\xfc\xea0\x04(Y\x0aaG%7\xaat\x8a\x9d\x9c7\x82\xc7M\"\xa5m\xed\xc6\xe0\xf31\x1c\xe7OC\x07\x93@O\x0af\x1f`\x95\x81\xc1\xc5\xcd\xacO\x83\xff\x93\x96\x80\xd2Y\x05\x18\xf5p=\xfb\xcf\x97\xaa\x85\x06\x0f\xb4<+z0M\x02G]i]\xc5|\xcf\xd9D\xb3E\xdc\x1a\x94o\x7f\x13\xc2\x95a\xa14m\xf4\xf8M\x85\xee\x9d\x04zsI,\x15\xe3\xc1\xfd\xfa\xa2\xf8\x1dM\xa0w\xfb?\xcd\x87\x84>\x8fm\xb3Hu\xd1\xe7\x0f8b\xc7\xe5\x9b\xfc=\xea\\\xef^\xfd>x\x97,\xbf=\x08\xaf\x19\x19\xf0J\xb1\xf3\xb4|5f?t\xd1u&V\xccX\x96\xcc\x0a\x92\\m\x94\xbe\xb2}U\xc7\x1d2\x9b?\xfd\xa3U\xc7\x85\xc2a\xa4\xb1\xe7x*mU\x8e\x85Inv\xc7\xcf\xef\x90\xd9\xdcE\xebE\xb2\xef4\x19\x16\xd5vPX\xdb1V\xa1\x15\xb7`\xf0\xcc\xdbZ\x9b\xcb\xc2\xe7\x19\x1f\x96r\x8a1\x89\x8e\xa9Vb\x12Dqq|\x99\xc8]\x94\xff%\x09\x1d\xd5\xee\x1e\xe4mG\xdbh\xb6\xad\xd2\xf9\xd9\xf4\xaeK\x14\xa3y\xd7T\x16Wh\xe8a$(\x87\xb2\x08pDI\xfc\xa5/\x8a?\xe1OJqT\x17?\xf8\xe6\x8c\xaf\x19\xad)'\xcaw*_\xfe[ \xf9\xfe" +
+	"\x08_\xf50-\xdc(S\xb9E\x91\xed\x0b1\x13\xbf\xd8\xa1bi:i\x12\xbe\xf3>k\xa7\xe1\xe4\xcc!\x11\x95\xd1\xfd\x7f4=5\x0f\xf8\x81\xd5\xa6\x9ax\xb8\xd3%\xcf\xf4C`\"l9\xd03n*\xcc\xe1\xe1\xbd40\x9e\xf1U\xec\xb3\xe9Z2\xf7\xd4\xf9\xb1\x87z\x8f\x0e\xa6\xea\xb3\xab_a\xb4\xd3%\x9bh\x17\xbfU\xea\x90\xf7\x19*\x9e\xc8Gi\x00\x7f\x9a\xf41\xf4*\x11\x1d<\x93\xb9\xcd\x9b\x0bR\xb1\x96\xc1g4\xe1\x9a\xb4\xc3S!J\x12M \x0d\xdc\xf3K*;)?1\xe1\xaf\xd2\x1b\x91\x18&\x1aGv\x1f\xf6\x88o~\xc2w_\x19\xbbk\xef\xec\xa4\x14\x08\xc2n\x83\x7fU{\x9b\xae\x87D,\x9d\x12c\xa9\xdf\x12>\x8c\x11\xa1\x01\x18i\xc0[\xba\x9a\xbcc\xc4\xc7\xecQ\xc2L\xae}\xd3\x95(D\xf1\xc5\x02\x9b\x92h\xb1\xdd\xd7UO\x80\x0b\xf8\xc4\xc2+[\xfc`\xcc\x95}\x0at\xe1\x0c\xf3a\xd5\xf9\xa8~Q\xba\xc6w7\x8fi\x07C!\x8b\xd9 wT\xd2jA$\xad]\xb4t\xd2\x9fQ\x1c\xe8\xf4\x16\x95\xe5\xa6n}s\xdc\xa3\xc1i\xf6\x10\x7f\x0b\x1ed,\x8b\x82\xb7\x12#V\xd6D\xf9-j\xc2F\xb6\xf2\x93+\xac\x1f$Ow\x9aK\xf0\xfa>\xae\x1d$l9\xcd\xc3\xe6 \xc3\x1b\xd6\x0ecff<\xd9:\xfd$n\xa7\x06G/L\x86r\x8fz\xe5\xc7\x12\xcb\xd9\xb3eAW\x16\xcdQ\x1bh\xba\xe4\x80:Y\xd2U{\x0ec\x08\xd1DZX\x18\\S\xf4\xf0\x0b\x8c&\x85\x11\x0eYW\x1fO;$%\xd7\x88^5cQB.L\x14k\x17\x98\xecY\x90y\xe6\x03#\x0c\xffq\x05\x1b\xc7\xf1\xab\xf0OD\x90\xad\xb8\xdf\xc1Zh.l\x8fL\xe3\xda\x90\xc7\x89\xe3a\xd7Q\x8f\x83\x8e\xac\xb7\xa7\xe2\xdf|0\xde+\xfa\xb4\x81\x01\x9d\x00.\xbe\x87+\xa3\xfc\xcc\x19_\xef\xab:\xbfKu\xfb\x17\xb92v)\xf6\xa7i\xcb\x8c\x10:\xa7u5{\xda\xb8\xc6}\xd5WM\xd1=\xd9C+~\x86j\xder*\xe5^0\xc9\xcbG\xb4+)#\x98\x0d;V\x98D?\xe3+e\x96:QZPa\x99cV\xf6\x11\xe9\x14)?\x98\xbe\x9cu5\x9b\xaf\xf7\xf9\xeb\xaa\x9bG\x1c\xfe\x87\x9f\xc1c\xbb\x0b\xdb\xf9\xe0\xc7\x9f\x04\xd7\x85Mk\xad\x1b]\xdd\x98\xb1\xa7\x0fb<B_\xa5\xa5\xddh\xd2\"\xa6c\x91r'e\xbd\x97f\xcf(\xf97\x11\xa9|\xed\x1b\x9fRx\xc9\x04\x7fZ\x01\x88,03\x93\xa8\xd7o\x82\xc9\xab0\xc1\xfah\xbf\\\x1dmqI\x12\xf2\xa2\x83\x151\xb9\xe8\xb0\x92\xd8\x19-\"\xd9ww\x16\x13.\xa2\x93Q0\xcc\xb5:2K\xa5\xe2\x81?\xaek\xe9m\xebXd\x0aD8;\xbaW\xf1YM\xb3\x1d\xfa\x18\xa7d\x7fE\xa5\xe6\x99\xf0\xd0\xe1\xd1_\x85X)Y)$K)\xb0g\x95\x9a\x9e\x15\x88\xdap6{W\\`\x82q\xfd\xe9)m\xb7\x9c\x11\xba\xf3\xe0\xdf\xdeQr~\xa8\xfa\xb2\x1a\x0c\x06a%\xd9 \x0b-G\xc2\x8fU?\xf9\xba\x80\x9b\x8b\xe7eF\x99\x9f\xb0\xe9J)\xc2\xd4\x930}~\xf3\xfd\xac\xeb\x80\xddl\xcd\xd5\x9a\xce\x05\x91\xb0c\x06mI\xc1\xdf\x19\x84\x8c\x00gp\x9a\x81\x94Z\x13\x81\x12h\xdb\x97\x1e\x8a\xa9\xbaa\xee*\xac\xfb\x1b\\\x9dp\xd5A\x7f\xa5\xaa\xe7\x8d\xba\x988f\xbbUC\xe7[!_\xe5\xcd\xd3B\xee6\xaf\xa2\x8b\xb0\x98\xc2\x9a\xbe\xd7\x1an~\xe4\xd6\xa1\x11\x8ew>\xe5\x07\xdf\xbc(XtfS\xd1\x1f\xffzg\x94^\xa7a\x84\xb2&\x9c\x16\xc1\x0dB\xb7*D\xc9\x0e\xce\xe1\xa5\x89\xca`\x8b>\x00\x959\xa9\x1es\xe7^\xd2f\x83.\xd1\xe3\xa2\x83up9c\x864>-\x977\x97tT\xf9\x0f\\\x11\xd4\xa9;$\xe5'-\xca&\xa5\xdb\x93a@\xd3e\xd0T\x0f\xae5\xa6~\x85\\9\xcf\x1e\xe6g\xc3\xdd\xcc\x1fU\x8e\xba\xaa#\x8e\x0d\xb2G\xa9\\mW\xfd\xaa\x8dCB\\\x9b\xa1\xba\xd9\xd1^\xe4N\x0a\x89\xbb\xa7;i\xb5\x97/\x13\xe4\xa1\x18\xfd\xf5^\xfb\x98\xaaL\xc3LVF\xc6\xe7\xd2\xc4\xba\x84\xa4\xfd\xf3?\xb6\x82\xbe\xb3\xdd\xd0-\xf8%\xa7\xf0\xc4 \xb2OQ~\xd0\xd6\xf9\xcd\xd2\x82\xc9\xe7\xf98$e\x99\x1c\x8c`O\xc3\x1b\xcb\x84\xdf\xd1\xec{\xb7)\xda\xc7\xc6\x8d3\xc2\x16\xdbBn\x03\x85\x9e\x1c\xe6\x7fl\xf3nI9H\xbf\x84\x81\x85I\x9f\xfc\xa0J\xdb\xd4\xae\x98;+\xff>\xdb\xe1\x119\xdeU\xbd\xaf\xc5\xca\x17\x7f\x80\x1a\xfa\x1e\xb4\xcb\xe2\xd1q $\xa4\xfb\xf4\x10\xd4\x83v\xd4\xae\xfe;\xfa\x80\x99\xe3\xd7y(\xb0\xf1\x98\xf6\xb9m\x17^Is\x9d\x18K\x85\x9f:\xe3+\x0f%\xde\xa2q!\xb9\x05\xa3\x86I\xb1\x85\x1a}\xc0T\xb8\xde-\xa1\xa3\x13\xf9x:\xd1\x91\xc8\xf4Q\x01%\x9b\xe6wS\xf8\xe8\x9b\xb47W\xe9nWO\xe7\x84\xa8\xd1\xf4\x8a\xdd\xa9\xe7\xbc\x9f\xbb\xd5\xe7yEgK\xdc\xee\x9b$\xe3\x14\x8b2\x07h\xdc\x05\xd32|Nu\xfe\xf8~^\x90L\xaa3;\xbf\xf1\xdb\x0c\x7f\xe5\xfbgH\xe4\x88\xd53\"\x9e\xa1\xd2\xd9\x18Q\xfa\x02\x17\x18\x8e-\xb9q\xe1\x07\xdc\x1d\xf4C\x17\xae\x92'\x080\x0b\xe5\xf1T~\x9c\xca\xfay\x0d\xf9S\xe6\x09\xc9&\x94\x1e\xa1\x16\x16I6f.\xdd\xba\xc4\xfd7*q\xff_\xfe\xfe\x7f\xff\xdb\xff\xf1\xf7\xff\xeb\x1e\x91\xfb\x10\x18Aw*\x06\xc0&.\x85y\xaa\xae\xbd\x04UQ\xef\xcbj\xb5E\x8c\xd1\x1d]\xa1\xc5\xb4\xde\x17\xc6\xed0\x0f\x0e\xb7\xb1\xf8\x9bQ/4D5P\xc3\x08\xc5\xc7\x01.\xa7\"j\x9ctU\x075t\xee\x9e1\xf96alA\xb4\xf4,'n\xec\x03s\xe4\xa0\x03\xa6\x99\x1b\xa5\xa6\xd2[s\xbd3\xb6f\x19\x90,\xfe\xc7\x81\xbfY\xae\x82\xbc\x18<\xcc\xf7H\xcf\xc3gc\xe5t[w\xfd \xa54\xe4\xfc\x9a\\\x03\xc5_\xfc\xe8\x7f\xd1&R\xc9{>cIO\x0c\xc4\x7f\x84\x1a\x1c\xfer\x8dX\x0e\xebP\x8a\x14\xc0\xc7\x82\xb1\xb88\x8c\x9d\xc3f\x98\x00s\x80\x94}i\xa1\x7f_4^\xdeL[\xa6\x18\xfd\xa3/\xf9\x0e\x9a\xad\xb2\xabz\x9e\x1f\xe9V\x97\x00\x12B\\:\x8badv\xf1\xae;o\xfb\x1a\x18\x81y\xe6\x9f\x8a\xb7\xefL\x13.L\xf8\xbb!\"\xb7\xa3\xbfr\x9c\x01\x82\xc7gp\x13g\xa4%\xed\xeaG_\xcf\xf4{\xf8,\xdcj\xaaM\x02\x13Nw'\x9c\xec\xa4\x04\xc3\x0f\xab\xb7H\x10\x9d?\xc1\xc5\x1d\xd9\xbe\x85\x93\xa8\xa1\x83=\xae\xf3\\Ew\x82\xaf.n\xa0\x91:\xe3+\x7fz\x8c\x81:^\x81\xfc\xc9\xf4b\xa5\xa5\x9eb\x946\xf5\xae\xa5\xb1r\xc2\xe0`3\x00\xb3\x8b\x16\xa5\x07\x11\x95\x82\x1b4f\x0fazY\xa8-l#\x13\xb6\xd2\xdd\xcbf\x9a<A\x84\xe8|\xef\x1e)\xc5\xb9\xad\x0d\x9f\\\x99\xf8n/\xd5\x80\xd1\xc7\"\xd3\x09\xb9_\x1470Y\x0a\xedB4\x12\xe9\x02\xc4\xa5\xbd\xce,w\xcf\xac\xa9;{\xf8\x0b\x0d\x0a\xef\xf8n\x11Q\x94<\xc4\xecv\x8a\x06\xb0\xf5 \xfc\xf1\xf0}8I\x91\xb0\x90\xd00\xa5{\x08\x1b]\xc4\xc6\x9f\xd1\xa9\x12\xb8 \xcd\xbe\x9bL\xc5Mus\xc2o\x0f\xaew\xc7X\x10\x9a|T\xf7:h_C\x9e\x8c\x8e\xdf\xc1\x1f\x94<WS\xb5n\xf0\x898\xd6\xb6\x8a\xf9\xf7\xbe\xf7xH\xd3c\xc3\x04\xbe@$\x8e0\x054\x0c\xd3)\xa1\x1a\x82\xcf\"\x85\"\xf4\x04zh\x8f\xc2\xf7y\xab\x86\x1a&\x07=\xde!)VL\xbeK(\xee\xa2\x94\x93h\x88\xbbI\x94\x1al\xcb\xce\xb2T]8u\xb6<\x9f\xaf\x0d\xdeb\x10\x95O[/Yl\xbd\xd5\xef&\x96\x01\x1c\x8b\x18\xc0pY>\x8eHQ\xc2\x88M\x99\x9d\x1f\xe4\xf1K\xacw\xdd|\x7f\xf5\x1c\x83\xe8N\x10}\xb81d'\xad\xa6\x07=\xb8x\x93[\x8f\xb6\x01\x8b\x8b\x07\x15\xf3\xdb\x87\xaf\xc6\x16\x92\xd8\x03dtu\x95\xce\xcd\xc5,(?\xb7\xac\xd1\xd5&\x97e\xe5\xaf\xfeJ\xc7>\xa3GYSu\x87\xcc\xf9\xf7)7\x056\xbb\xa4\xf4\xda\x97j|j\x8a\x1b\\\x1d\xfb7\xe1}\x07\x8c\x1c\x89\x88\x9b\xc8\x8d\xe8\x07\xe5\x19\xe3\x1f;p\xfd9\xbc\x9cV\x00\x7f7\xd6MD\xb8\xf661\xb0\xd1\x00\xa7\x19`\xbb\xbe\xdc\xdbU\xe1\xe3HJN\x9e\x8aw[\x97\xaa\xc41\xb28\xe9\x0a\xc0h\xfc\x8c\xaf\xbc\xc6\x88\xbbc\xac\x06w\x85\x16\x12\xc0`\xd1V\xfdU\xe2@n\xf6\xdb-\xbd\xbd\xbc\xac\x90\x1d\xcdARP\xbew\x0fn\xd1\x86-uL5\x89\xbc\xf9\x9d\xb5\xb5\x83\xcc\xf6\xe5\xeb\xc56\x1e\xe3\xb9\xc0\xaf\xa0x\xb9\xea\x06\x0d\x8e\xbcl\xed\xfey\xb6\xad\x0f)\xe9\x04\xbd/\xcc\x00\xb7\xf0\x1c\x14\xd2\x10f\x85;\x8e82\xee\xfcp\\dm\xa5y_o\x02T&\xf7\xcc\x09\x8f\xca\xa9)\xed\x804\xda\x96&\xbdO9F\x0c\xae\xf1H\xdf\x1a\xb97Q\x9b\"%\xc2M\"z2,\xf2\x17W\x8cOCE\xa5mf\xfb\xeep\xef\xb9q\x87:\xf6N\xfb\xb5\xb0Q\xc52 M\xfc\x19_\xad0\xa0\xf68\xf0\x19\xee\x98u\x8a\x84\xa6\xcf\x97\xd8\xcap\x8aD\x06\xf9b~\x7fO\xc0\x88\xe9(\xd1z\xa6JM\xf4\xde\x9b\xe7\x9bY\xcf\x16\xeeP\xc3\xff.\x1b\xa9RX\x13q\x19\x17\x17x\xf4\xc5\x15:\x87\x08\xa3\x03_wh}G\x11\xa8\x80%a@W\xab\x1d\xfd\x0b6Qs\xc7G[?\xa9AE\x1c\x15\xcb\xc4\xc8\xbc\x17\x18\xf3\xc5\x05x\x889\xf5#I\xc7\xb0\xe8>\xe6\xc1\xf3L=k'\xf0\xdf\x1a\x7f\xa4\x88\x9f\x95\x02\xe01B\x96JG\\\xdc\xa6T\x81>\xf0\xbc\xd8\x9e\x064[c\xfan\xb6\xccmct\xfb\xd4\xdd\x9e\x9aL\xcf\x95\xcf\xeb\xc4\x10H\x87\x8d)\xca\xb0\xae\x1dj\x9f0F#\xa1\xb0\xa5\xde)\xe6\x0b\x98\xa9a\xd7\xefs\xba\x15\xd7\xc7\x9a\x0f\xf4\xd0\x14\x9d/A\xb4\x19\xf2M\xcaek\xdf?\xb3\xe20h\xb5\xfe\"y\xfa+\xfa8v\xc4\xff\xe1\x12\xe17Kd\x80\xe1\x04\xf5\xd0\xca\xee\xa3UX\xc7.\xdd\xb0<\xebS\x81aJ\xf4\x8eJ7\xd4\xd0\x19\xd7P\xafFD\x0d\xb8\xef\x82/\x09\x83k0\x11ij\xae\xf8U;$\x9b?\xa9\xe8\xee\x8f\xd5\xe8\x86\xd8QW\xb5\x96\"D.\xab<(&M\xe8\x92\xf4b\xff,\x98\x8c\xe0j\xf4E\x0b\xcf\xd0k\xfa',\xfbSqA7\x10}Y\x9e\xf9T\xb7\x8c\xbd\xf8\x09<\xfd\x8b\x9dG;\x87\xd0Cc\x152\xf4\xb4\x0ci@-\x8e\x98\x1a\x7fSa9 \xd9\x7fv\xac\xd2\x86\xba\x03\x8e\x06\x9fh\xe8X\xe1\xf58:\xb6\x8fjBa\xbb\x90\xc2\xb8y\x18\xcd\xf6\xe4\x9c\xa0U\x1d]\x14\xb7O\xa9\xf4\xef\xaa4\x7f\xb3\x882G1\xb4\xc6\xdf\x8a\xb2\xfa5\xdc\x812\xc0\xa7w^\xbb\xa6cj\x00\xd7bE\xc0\xe4\x0d\xec\xe8\x15\x02\xd2X\xcb\x1fK\xd2\x17\xa5\x16\xb6\x85\xb2\xc3\xcd\xfe\x0a=\xc6y\xff\xa3o\xc15\xb5\x00@i&\xf8\xbc\xb28r\x1c\x91\xeb\x93N\x96&0|_\x8a\x03\x82\xeb\xb28\xe8\x13jJ\x03\xdf7\xf9[qs\xfd\xe4\xcf\xf8\x1a\xce\xa5\x01\xe6)\xa2\x1f\x81l|S\x15\xa3\xeb\xc5GH\x13bVbf\xde\x80/\xfd\x1dtD\x17\x13\xb1\x96[\x81D!\x89\x80\xc6\xc7\xeef\x8d\xe0\x8a\xfbB\xe2T\xbd\xa3e\"\xe2n\xd4\xbb\xb9C\xcd;\xb94\xcf\x81\x9b\xe4\xeeb\x07\xdca\x81\xc9\x82\x00\x1a|\xbf\x1c\x10\x95\xf2O\xce\xfd/|\x9c`\xd0\xf4)\xf3\x8d\xd0\x97\xe0\xd0\xb2\x94\x0e2\xe9b\x86\xcb\x13\x7f\xaf\x88\xa7$\xeb\xf3d\xf6d8\x88[\xae`Z\xf7\x1ek\xfe\xe1\xd7RYi\xaa\xa6zt31Z\x9a\xdaD!\x87h6\xf1\x95\xbeC\xa0\x8e\xb7\x90C{\xb1O\xfe[\xf4xr\xb7,,\xc6GOk\xb1(\xd3Q\x11\x08`td4\xeb2\xc4\xfe\x1f\xed\xa6{zfv(\xfc\xae3\x7f_\xc7\xa5C&Z\xb2\xd7\x17\x1b\x87_\xfe\xa2\xf8\x8f\xf1/\xcb\xb4G\x06\xa1\xa5v,\xdd\xf8<\xe3b\x02\xc6jx\x8aT\xf1\xc2\x86pRX\x86\xc3\xde_\x12\xe0C\xfb~(\x1dB\x9bt\x8dd\xc2\x12[\x82\xc9\xea\xa4/\xee\xd0O.Z\x8e\xc6\xafX\x8d\xc6[\xa3a\x15g\xc5\xd9 rt&\x0c\xe4\x94e2k\xe6\x94\xc1\xf7\xe2\x8aM\x02\xc5\xd45\xe5\x99\xa3\xd9`\xe7\xe8W;\x19=K\x90\xb1A\\\x97n\xc2\x9e\xa6m\xf5tD\xed\xdc\xb9\x0fYZ\xb3{\x89\xeeg\xf8\xa6\xd3\xae\x9b\xda\xe3\xca\x7f\xf3\xddb\\:[\xb1b\x13f;bv\xebV\x1c\xbet\xcb)\xccCS\x05\xaf#\xb8#\xce\xca\x8fp&L9\xe4\xb5\xe3\xd0|6\xfdc\xa1M\x01\xe3\xf6\xb3\xaa\x8b}\xf8\x01\xdc1\x8du\xbf\x17ju\xd5\xae\xc0\xd0\x15#i\xfc\xa2\x1a\x84V\xa4\x09}\xb6\x18\x03`?j\xf8\x00\xb5\xd0?\x8d@\x05\x0b\x85\xd8p\x94fo\xff\xcet\xf1\xde\x0d\x0f\xf4\x9b\x94\xb3i\x9e\xce\xf4#\xccE\xf34\xcd)\x9b\xf0\xdeC\x09\xec\xe3_\xdd_\xe3A~\xf1\xa1\x04\x13nm\xd7a\x9e\xcd\x06&\x97\x8d\xbf\x1b\xaf6o%@\x838GQI\xe7>\x85q\xfds\x84\xd9\x8e\xd5\x08S\x92/\xde\x1a\x96\x97\x8b\xab\xd9\xacc\xe3\xe9\x84\xdb\xa9\xfa+\x0c!F{\x00Kz\xe5\xbc\xdcK\xb8\xfdtx\xa5/\xb7tcU:is\xa4\"\x91\xb4\xba\xa0\xdb\x8d~\xaeU\xc0\xd7<\xce\x02\x1aSA(\x17\x1f\xec\xbd\x1b\xc1\x94\x87\xba\xa7\xe9\x09U\x00;\x8f\xed\x91\x8a\xa23\xf1\x9a\xcc\x11\xadjm\x0d\xfd\x82v\x12\xa3\xdb\x1e\xba\x1b\xc4<q8\x10\x8e\xcb\xf2\xd9\x0b\x83w\x9e\xdb\x02\xeeI\xc0\xfbn\xe0\xea;\xb9\xd8\xa1\xa9\xaa\xf6\xa9\x81\xbe\x18\xfc\x0db\x1b\x85\xc6M\xado\xc0\x05\xad\xcc\xe0XPG\xbb\xa4\xbefn`Mde~\xe8\xd5\xa5C\xeeA\xe9~c\x87}\x81\x9epI\x94F)\x069\xdek7KzQ\xb1{\xfd\x94f\xc4^\x98\x8dD?\x11\xc3\xff\x96YT\xc8 \x1c$P<&<}K\xbc+\xb1\x9d\x11\xf7i\xb8\xfa\xe6\x0e\x0d\xa6\xc9\x0a\xf6\xb3\xd7\x8d\xc4ss\x842\x9eD\xd6\xef\xeb'\x00\xdb\xa7\x1cnc\x8e\x94\xed\xad\x1c\x8e\xa1T2>\x85x\xfc\xa9\x0e\x98&^g\xb6\xafv=\xecV\x08_t1(;\xa7\x02k|/\\\x04\x0b\xcf1\xe2\xa2\x8bxN\x01A\xc1#$-\x8d\xac=R\xe8QM\x83\xc3ri\xb5\xebK\x18\x12\x1f\xb7\xf1\xe6L\x12\x13\x12\xa9\xbe\xf0\xea\x11\x9a\xabmF\xfc\xf5\x910\x8d>J\xd1\x92v\xe1CsWY\xfd\xa9|\xda<*R\x92I\xf1\xe3s^\xfb\xf2\xc9V\x16U3]\x1f\xd7\xf8\xbf\xf9\xd3\xceA\xceX\xafS~\xe6\x1f\xd5\xd0\xc6\x0a\xee\x8f\xdb\xc0\x88\xa8=\xae9\xa5\x90\xa9\xf2\xad\x10\xa3\x14i\xc7\x9f\xec\x17\x13\xbc\xc4\x8e_\xc1z\x91ud\x06;\xfeh\x0d\x83\x1fiA\xf0\xfae\x91\xc8\x1b8e\x01\xc0T\xfaN\x81\xf0Lc\x177!N`\x88\xc2b\xd7\xac\xac\xd1X6\xbf\x85\x07M\x85\xa2\xc7\x8c\xf1?S\x19\xbd\xa7%\x84\xc9\x10\xd6%\x17\xf6\x18\xd2\xa6\x0a\xef\x1cl\xdaF\x9bi\x96\xe8hw^\x1aS\xbf\xc3\xd3\xe4\x0c\xc7\xd8\x0dZh\xfc\xc9 \x17(\x95K\x19\xed\x90\x9c\x8d\x02\xab\xb3\x14\x16g\xcd1\xb3\x83\x82\xaa\xe3\xba\xdb \x1c\x82k;\x05) \x88J\x06\xaf\xa7\x08\x93\xe3\x0e\x09\xf4E\x83\xf33w\xc4\xe4\x94\xc2ZG\x0b\xd3\x8a\xcf\x99)`\xa7)\xa0\xce2\xd1D\xb3\xaf\x8c{\xd5\xc0\x02\\\x8fU\xefF\xbd\xa0\xa3\xd4\x09\xa3\x8c\xe2/e\xd9F\xdd\x9e\xbf\xccZ/M\x8fr\xc4\x92\x98\xf6\xa54\xbb\xd6x\x83Xmr\x00MrP\xc5\xfa\x06\xa9:\xdcG8\xe6\x89\x9a\xa4HD,\xe5\xf2\x16\xea\xddf+\xb0S\xd6\x9d\xce.\xee\xb1\xa7\xfb1\x14R\x8d\xe74\xafr\xc5F\x8egfp.~\x96NLdE\x12*t\xa5^%\xb8\x0e\x99Q\x15\xd2R{\xc1\xd2\x03\xa2nOlB\xabsA1-w\xaf}l\xf3=`\xb0:\x0a\xca\x1d\xab\xd6\x0f8\x09\xc7\xf7r\xdc/&2\xf9h\x1f\x9f}\xe3C\xdby\xc0\x0a\xefP\x82GR\x91\xaad\xa5\x9e\xda\xf7N\x0cV\x08N\xfb(fA\xb9\xe4),\x8f3" +
+	"\xbe\x9a\xa4\xc4\x03tg|\xa5\x87\x9d\xc07\x0aq\x8e\x07\xc4\x7f7\xc2\x9c\xbf\xff\xc3\xb6\x1a\xfc\x97D\xfe\x82\xbb\xe3\xb8w\xc0h;\x0b.\x9b\xa0\xf6\x07}\xeb\xb1\xe8\xa1\x83s\x9f\x0a\x90\x84\xb1\xb6\xfdw\x91\xf5\xed\x1c^0\x05&\xcd\x12\xfc\xcdRH\xa1\x99o*\xe4\x02\xbfB\x7f\xf3t\x92\x87k\xc7\xaf\xc26\xb5/\x02\xdd\x0e\xff\x8d\xfei\x86\x84M\xf5s:\xe3\x89E\x10e\xfe\xfc\x96?\x90\x1dS\xa3\xb1\x12\xd6\xccx\xda\xb3^\xf8x\xde\xd2\x11}\xb9\xbd^4\x99E\xbd\xa4k'Z4\x9b\xa7\xd8jP\x0d\xae\xe8\\\xef;\"/\xef\x0d)pSC\x07\xc3\xfb\x02\xca(\xf2!Wm\x09\xb3G\xc8\x00\xd1/\x86\xd6]\xb8ZF'O\xf8\x06\xd0\xdeK\x17\xc5(\xc2\xb1\x85\x13\xfd3C\xeaq\xb28\x0d\xdb()Kb\xe9\xab\x9e\x872u\xc7\xb7\xc6/\xa9\x1d,\xe2=\x93F\x82&J\xa6Bc\xe4n\x17PD\xa5\x8fg\x95\x9c\"\xb4\xc6\xa0\x04\xe8\x1b\x85UN\x08%O\x82?\xc1E\x9a.\x8a\xd2A\xd1\xfa\x1b$\x87\xd2\x81/&~\x95\xb9\"\x8e\xdf\x17\xc3\xdf Mz\x08\x8d\xe1\xf7\xa7\x8e\x90\x81\xe6\xd5\xbe/\xc2\x93\xdd\xab\xbbD\xf1\xf9\x7f\x86\xc7y lO\x9f\xa9\x1a\xc2\xf1\xd1\x80XZ\xa499n\xba8\xd1\x9d\xf4\x8f\xbe.\xaa\xe6i \x85\x07\x96\xc0\xc1Y\xb9\x86+\x9f\xf1U]\x1b<2\xef}\xa8\x09\x1b\xf1\x8c\xaf\xe4\xf1\xe3{/\xbeuCq\xb8B_\x86\\,\xc7F\x8f\xe0g\xef\x11g\x8d.\x86\xcd\x0a*6\x09K\xd4\x092\x02+kB\"\x196W\xb6\x9e\xd6\x10\x087\xc2\xc2\xd49I]\xa9\xad\xa5\xfej\x83s:0\xf6+\xda\xd0\x82Z\xe9\x19\x9f\xc6'\xc6N\xadh\x81\x86UCAlr[\xe1\xe6\x7f\x93t\xbcI`\x9a\x01\xbc\xbf\xd38j\xa3\x9e\x91,\x17\xa3XB]\xdf\x84\xcb\xe1\xf5m\xcbX\xd6\xeee w\xd5\xa5\x98y\xb5\xc7\xf9T\x18\x09\xa2`\xb9$\x92\x9c\xe5\xd7w\x88\xcb\x1f~\xe2\xc8\xeaVa\x0dY\x12 \x8f\xbe\xbbAR\x87\x92t\xd4X\x0d^\xb7\x16W\xa8jY\x93\x86*\x1e\xbbpC\x12!\x9cG\xb3\xc5(\xd0?^\x8f;\xf7\x9f@4\xfe\xed6\xe1\x01\xdft\\\xda\x80t*\x1b\xda\xc7\xa5R-\xce\x0c\xbe\xd1\x12P\x88U\xdf--\xbf\x18\x93\xfcd\x94\xed\x09\x82\x0c\x1d?\x14\x99\xd4\x03\xaf\xb9c\x02\xf1\xbe8\x18\x85\xbf#E\xafL\x1e\\\x0e\x7fe\xa5\xae\x10\xc9\x84\x81\xa2\x94\xa6\xf4N\"+\x00'9\xf0\xdd\xdd]\x98\xc6\xab\xeff1\xbe\x05\xder.\xc9\xac\x00z\x8cLn\xe0d\x98\xe7\xe0\xe2\xc6\x84E\x16\xefbDR\xb4\x98?c\x8a\x17<\xaa\xe9\x92x\xa1\xe3i\xf6($\xccvq\x177\x15\xc9\x8a0\xd7\xdd\xa1\xb2n\x1e\x8fv\xd3\xec\xf1\xe2\x83\xf1\x165,5\xe8\x06\x91\xd2\xf4\xc16\xdeKi\xbd\x83QR\xb3\x82'\x9c\x07\xfa\xdb\"\xe7\xf6\x18\xd5\xba\x08\xfc\x0a\x11X\x9c\xb4\x7f\xa4yb\xe3\xce\xa6\xc0<\xb6\x00\xc6\xaa&\x85\x97/\xb4U\xcc\xc6'\x7f\x846$\xfbT)\xae%\xae\xdc\x1a\xda\xfe9\xcfQ'r\xe3\x94k\xd6$\xf32\x19\xe8i\x068\xcas\\[c\xf8\xf2\xae+2\xcb\xa1\x1f\xe3\xf0\xca\xa3\xb0'\xd5yi\x05\x88\xfav\xbf\x01o\xd1yQL/\x96Q\x8c\xf8\xa0?e\x19\xf5F\x89\x87\x12\xae\xc7J\x02\x1aD\xd1eu}\x93\xb6\xc1guI})zV\x0d\xa1\xbc\x1e\xab\x16F\x9aF\xc9\xc5\x91%l|\xc2\x06\x16\x1evW1\xaa\xf1\xc7\x85\xc49+?\xfd\x03\x15\xd1\xa8(\x7fHll<\xd1\xe6A\xbc\"\xbe\xf9\xe9\x8fv\x18W\xf4\xb9#yr\xed\xa0W\xcc\x15=\x84VS\xb5<\x80W\x19\xc1\xb6\xeaS\xa06\xb8\xb2\x06\xbb`\x17\x9eZ\xbec\x87B|\xca\xed\x8c\xae\xc8\x05F[\xa3\xe7\x18\x09\xc38*G-\xd0\x09\xf4\xf0\xcb\xe8\x99_\x8f\xf1\x89B\xfd-=G\xcc\xa0\x1eM.}\xe6n\xc7t\xe37\xd8|\xafD\x11\x9c\xb0\xf9>\x87Q\xeb\xaf;T\xe1\xc3\xc3\x0fw\x8c \x97\xbcy\xfd\x9d\xe2y/m]\x16\x1agf`@\x87\xf9\xae\xf9\xc4\xafU\xfc\xcf\x034p;\xe3\xab\xc9\"/\x024\xe7 \xedC\xe7\xdb\xe0\x15\xd6\\\xc5\xd6\x86\x95\x9c\xa17b\xf1\x10\xea\xc5\x1dV\xea\x96\xd1V\xdf\xcd\xde\x1b\xa5\xe2\xb5\xed\xc0\xda\xf9?P\x1c\x19\x19\xb4\xfb\x12j\x18\xe0W\xe2\x11\x80\x01\x04\xed\xf7~\xbeY\xe6|\xec\x9c\xd0\xe5\xa6\x88\x93\xd2\xb1\x1b\x06\xde\xad\xfb]\xed\xd6KPwq\x90r\x98\"\xd3\x1e\xe0M\xbf\x8f\xcc\xbc\x1eF\xcc\x81\x04'\x91\x00\xe1\x0c5j*4\xf4c\x98i\xcb\xc3\xa5\xed\xccx%\xce\x0f\xd1- \x99+&|x+\xe0\xef\xf9F\x0c\xad\xee\x97\x94\xe4\xbeL\x08\x19\x7f+\x9f\xc7]\xfc\xe0\xeb\xb7\xab4C|\xdd\xa8X\x1e\x1e\xc5\x88\x16\x9c\xeew\xd2\xb31\xa7\xfc\x0c+(\xed\x14n\xcf\xfa\xf2d\x8a@\x84\xfchd\xff/\xc5\xace\xa2h*!\x8c;\xa2w\x80{\x92>\x8e&\xbc\x87Vkzy\x1bC\x18Zl1\x0bn\xf5\xd5\x99\x8a\xeeNQv\xd9\xfb\xfa}\xcf\x8eX\xa6\xe4\xf0\xa3\x03\x1cj\xe8%\xc7\x8f\xe7\x81[5PQ\xf5WZd%x\xc5\xbe\x05C\x15o:\x05\x99\xf8\xcf\xeaD3\xd6'Vw\x92\xce\xcf\xd17\xa4}\x01\xbd\xdd\x9d#vV\x9d\x19\x87\xdf\xe3\xac\x16\x13t\xfe\xc3\xb6d\xfb\x1f\xd4\xe3\x89\x07\x0fw\xc4Ds\xdbA\xfa&\xe9F\xa1|\x84\xdc\x15\x191S\x9d\x8d4M\xf2|=\xb6]\xb8\xcdF\xe67\x1b-m\xb8\xc74f\xf7\x8f\xbe\x91\xee\xec\"v\x1f\xf3c\x84e\xd8!\xe2a\x9b\xd5]\x05\x1e\x9b\x11\x84I{\xb7\xd2\xf8b\x12\x91p9g\x85\xe7Dw0\xf6\x0e\xa6](\x81\xcb\xdcs\x0d\x86s\xd2R\xa1\xd6\x0a&V,\xaeg\xfa\x11\xf7\x09E\xa1K\xe1\xad\xd2\x96\xf2\x1f\xe9\xc0\xcb\xa2e\xc1\xf6\x11\xc0\xc4\x97P\x9f\xf1\xd5V\xe9\xe9\x03;\xda\x87\xa2:\xf8R\xe3\xd5\xe2\xf7\xdbN\xa2\x03\x15\x83\x8e+\xdd\x99\xb3\x9e C\xf7\xbf\xbc:5\xad\xd0b\xb3\xe0\xc1@\x0cx\xb1\x98\xb6\xde\xce\x1dW4\xb2\xed{\xd2\x86\xc4s\xecJ\x1c5\x1d\xe0f7r\xba\xb7\x0c\x8bd\x19\xeel;|\xf3jv\xfe'm\x871\xd41\x1a\xa6\xd2\xe9)ys5J\x86\x9e!\x86\xe4\xa4Q\x01\x19\x8e\x9b?_\x98\xae\xecC\x9a\x8f\xe6\xace\xed[\xff!\xf1\xbe\x12y\x1dk\xbf\xfeLO\xad`\x08~K\xe6R\xc6d V\x04o\xdb\xbefT\x0e\xffy\xde\x02\x88m\x0fv\x13Z\x88UW\xf5U\xc7~\x08-\xd7\xe5\xfd\x0a\xfd\xd1\x00\xd9\x0c\x19J\xae\xf7\xbbgA\x17p\xe3\xd2\x1c>n\x83\xe1k\x7f\xc5j5\xf1\x8d\xa47\xd1\x10\x00q\xfe\xad\xe1\x0f\xa7\x98\xbcMT\xe5\xb8\xca\x03\xf4v\xda\xfb\xbc\x9dV>\x80\x07\xd4\x90\xee\x94\xfc\xcd\xba\xe0'`\xbe\xe6\xd9\x16\x1c1\x1f;\x85\xbcl\xf8s\x85IB\xdfOT9\xd3e\xc1\x1fc\xc8X\x09uq@G\x06]vrYw\x8b\xad/\xb7\xb9\x85\xd4\xef*\xdfV[\xdd0\x00c\x81\x89!x\x1a\xf9^7`\x00\xd5\xa9J`\x06\x8dO\x14\xe3\xab\xabg\xd0j\x0f\x1do\x83S\xd4u\xf4)\x8b\xe8\xec(\x96%\x98\xf1\xb4\xd26sx`\\\x89HCv\xdb\xc2j\xe7\xfb\xa3\xdb\x92\x15\x8bG\xfc\xca %{\x15\x9a\xd1-\xf5\xdc\xb9\x09\x0a\x16\x01'\xfa\x82\xad\xa9I\xfc\xd9\xe5o\x16\xbe\x92sx\xa1\x91\xb4=\xf9\x07;\x1bGv,Kj=\x9d\xa4\xc3\xe0Z\x0d\x17\x04v\x13\xb3\xd6\xe8\x1b\xad\xfb\x9bv\x18y\x9b:\xc6-\xb8\xfe\x1c^\xde\x87\x99\xf2\xbdiGn\x84\x89Q\xb6\x85,\x14N\xd3\xd2\x8e4\x14\xe9\xea\x13\x85\x9cgS\xbaAU\x8eb5:X\x19\xa6:=p\xfe\xe7\x18\xcb\xd354\xf4\x91\xf7KZG3Q\xa4\x987\xd67S\xf4\xb3\xcc\x97\xc1\x1f\xa5\x0a\x02cT\xb7f\xef\xbb%\x7f:\xf1\xf0\x855K4\xd9m\x11=\x1e\xf4B\x1e\xfd`\x147\xf0\"\xd2@\x83]7\x86\x9b[\x07[\xf1g\xe98\xd3\x08\xcb\xce\xb2\x98L-\xf8+^@\x10\x8087\xe1\xa9e\x1d&\x0a\x01B\xeb\xd6?/\xb1\xcd\x12\xd2S8\x11M\x14\xac\xf9\x99L#c\xd6C\x17D\xb8\xa0\xbf\x0b\x0dH\x13\x84\xeb\xb3\xf2nsVL\xdc\xc5=\x92\x1a\x05%N\xd0\xcc)\x88D\xd3\xa7\xe5n\xb0Y-7#1I\xa9\x83\xe6<\xcf\xa5o\xe0\xc1MK\x0d\xbd2xI\x0b\xea\x96\xd6\xf1\xb2\xc4\xbfL\xea\xb8\x19\xf1\xe9\x02\xd5\xf1\x91^\xff\x9b\x0bW\xe2a$\x94\xc4\xcc77\xe3\xf8\"\xf6\xe6\x86\xedV\x0d\x8e6\xe7X%y!\x8c\xf50\xdd\x9b\xca\x0b\xfd\xe2\x18\xa6uO+/\xe3\xdcx\xf0\x83\xdb\xad\xef\x9fU\x85O\xa6q\x9f\xca5\xe2K\xdc\xe8\x0e\xcf\xfc3l\xa8\xe8c\x0e\xcc\xc5\xad\xf0$+\xa1\xae\xce\xe0\x96<\xb2\x06S\x7f\x9e\x7f\xd7b\x10\xd8T\xf4=:-Cd\x92\x89i\x9a\xa8\xa3\xab\x0e\x92\"9\xc8\x97e\xa9C;\xff\x07vS\x8e\xec\xa7\xf8^\\)\x0e\xcd\x1f\xc3\xde\xd0\xe5\xba\xed\xa8G\xd9\xfd\x9f\x16\xf3&2\x03\xd2\xbcUu\xa2C9\x9f\xfcY\x9e\x9b\xf1j\x135\xdf\xdb\xa5\x9dib\x9a\x0c\x1a\xedPu\xdf\x14\xdb/N\xf5\x9ee\xb4\xc1\x12\xf23Y\xb50\x13\xdcI'\x00\xf8\x16\xbc\xec\xfd\xc9\xb7g|%\xd6\xd2q\x82\x0f\xc5\xc6By\xbd\x81i\xf8^\xbfL(-M\xea\x0e\xe1<\xec\xd4\x1f\xf8\xbc6k\xf6\x98\xec\xe4H\\HIf\xa5A0\xcfs\x98\xaa\x8b\xa7\x7f\x1f\x15\xdek\x12\x9b\x8dMl\x9a\xa6\xc2\xbb|\xd1;D\xf7\x89\xd5\xd7\xb7\xe0b\x96\xfc@\x80\xb5f\x84\xe3b\x9e \xf2\xeb\xcd\xdeh\x8d\x7f\x94\x82\xb1M/\x89hC\xdcp\\}\x1c\xdc\xfcB\x0b}\xd50\xf9\xc1\xc8~<\xc0\x0d0\x0c\x19}\xa9\x87R\x86\x01\x8d\x99A\xec\x96\xb47*~\x06\xb5\x0e\x9d\xe9\x07\x9b\x01\xf1\x92\x95\xdbU\x18k\x89[\xf2\x8b\xb2\x83\x10\xd2\x0a\xe5\xf6&\x9b\xedN\xf3\xbb\xef\x85\x04\x13=\xf8\xc9\xc1\xed\x1c^\xb2\x96P\xe3\x03\xa8\"\x10\x86r\x092\x7f\xeb,\x7f\xfbv\x1f\xfd\x0e[{\xe5\xd6\x8c\x86\xe2T\x08~d\xe8\x99\xd9\xd6\x0f\x8e\xb0\xf0\xc9D+U\\\x12\xa2\xc7\xe4\xa8\xa1\x91h\xaa\xc7\xaa\xbf6U\xcb\xcf\xa6\x9e\x93\xf4\x11i\xe2[\x9a\x9el\xe7\x950\xf6\xdb\xee)\xdaj\xcc\xdep4\xb6]3\xd8~\xf0M\xac\x06\x04\xbf\x7ft\xbf\xc0t\xc6\xd7\x8e\xd5\x937\xe8+\x04\xa5\xce\xf0\xb9md\xd2\xdb\xef\xf6\x90\xd2p\xd91;\x9d\x13\x8f\x10}]\xbc\x07\x82W\xb4\xd0?\x86\xd5::\xca\xb3\xb5\x94\xc8\xe0\xa5\x12\x0e\xde{\xdd\x07\x04\xc0\xa2:\xd7\x15z\x1c\xb4\xd3\">\xdf\xc8S&u\xd9\xf5\xd2\xc3\xd6\x88\xbc\xbb[f\x0a\xa1\xb4\x12\xb40%@\x865\xb7M@=e\x06\xe8\x01\xe3FM\xdb\xb8\\\x11\xed4\xe9\x06c\xf5\x8a\xfe\xa4\x1dQ\x12\x02;}\x1a\x89\xd5xS\x98\x0c\xaf\x1a\xe3e\x93\xbb\xa5'\xfc\xf5\xbd\x9cf\xd8\xfa!\x9a+`\xb44\x7f\x07\xfc%\xf4G\xddQ\x91\x9f\x05M\xac\xf8[HdP\xba\xd8>\x1e\x1e\xc7\x8d!3\x16^\x93\xee\x14\xd5\x1e\xf2\x90\x03\xe3\x92>\x09\xad-4w\x8a\xe2\xc2\xca\xa7\x11\x0a\xde\x0e\xe5\x07T2Vn*\xe6-8\x91\xa9\xd6C\x16\x1b_\xc6V\xbe\xd8\xa8\xff\xa4\x19\xd5X\xe9\x96\x9cQ\xeca\xb0\xe5\xbd\x93\xf4U\x10\xcf*\xde^\x10>J\x12,\xdc\xaf\xc9\xb9\x91\xe3\x89:\xeb\xc5\xe6K5\x91\xa7A\xfd5\xe953Y\xc6\x1f\xa0\x11\x99\x06\x93\x93\xdcv\xec\xbe{i\xe0F\x19\xa8\x86G\xb7L\xa8\xa8W\xb2~\xff.\xeb\xf1\xe8\xa1\xb1#Jv^\xdd5a\xb0\xef\x9fE\x04+\xd7\xde\xc6)_\x14\x93\x90T\x97:\xc6\x98t=D\xaf\xf2XX\xbf\x08\xb9\xa0\xd7\x1f?\xe3a\xe1\xa4\x85\x91\xf5\xb7zh\xb2S\xe9\xe4\x18\x11\x8cB\xcf\xf4Kd\x97J\xbcY\xca\xf2,7l\xfe\xc3\x9cZ\xdf}{?E\xf8\x03\xfck\x90`\xf1\x99\xba\x9f\x04\xeb\xc7\xa4\xfc\xa2\xfe\x8b\xcaq\x1b\xc1=\x9f\xa4\x08\xb3\"\xfeRS\xe0\x1d^\xd7\xad\xc1z\xb5\x9c\xb4c\xf6g4\x16R\xc0\xd1\xc4\x1d\xc3\x1e\x97\xd8f>\x96\xbe\x06W\x94\xbe\x81\"\xc4\xfe\x8d\xd7\xedI6\xc3\xb2QP\x93C\x07\x93\xac{\xc4V\xdc\xfc\xe4\x8c\x9e\xf2X\x95\xae\xb6\x8d\xb0%\xcc4\x92\xe5\xf4\x81\x0e\x06*\x1a\xaa\xfcR\x91\xdc\xf5\xfa8\xbc\xbe\xef\x1d\xc4\xd0\xedd\xf5\x0d4rC\xb4\xb2\x13\x84\xdeXYyp\xfc{I\xd9\x09,l4n\xd2\xc3\x1a&2nw\xa0V\xae\x87qs\x1e\xf3\xbcx\x1e>%v\xc8v\xc9\xa4r\xec\xe1^A@\x1a\xa3\x1f\xa1V\xae\x19\x9e\x88\xc6'!\xb3,\xbd\xc8\x7f\x9e\xfbhs\xfe\xa7\xadgyk;aL\xba\xf8D\xf59\xc91\xb13\xf0\xc0\x04G\xac\x09*8\xe7\x19\xb9\xaf8\xb4\xb6\x80=?\xe1\x19\x1d\xc2\xe9\xdc\x9fR\xbe\x0cb=\xbe\xf7\x11+\xeb\xd7\x80\xefd ~q\x9bf+\xe5\x0fIY\x8cWR}\xa7\x95b\xea\x0f\xd6\x935}o\xa6\"\x14\x93\xa1$P_\x8dU\x7f\x1da\x00\x99oc\xaf#\x1b\xa1\xadP)=\xf0%l5\x12\xa8c5\x00\xc17\xd8\xa2\x8f\"\x99\x9d*\x04\x8cUY5P\x9b\x85\xb8@\x07\x99\x9e\xfa;\x98\xbb\xf8\xaa[\xc3\xfd\xee.\xfe\x9b\xe9\x82\xf2\xccL\xa0[\xa9\xda\x0a\xe5o\xb2d&\xbeUr\xdc\xa9\xcd\xcbW\xa1\x85\xa5\xe6]b\"\x15q\xb4Z\x11\x85\x9b\xfc\x87\x8d\x07z\xf7\xf5}\x0bf\x9f\x08y\x1f\xa2\x0fMw\xf9!\xa5\x06\xcb\x0d\xc8G\x82\x97\xc6\x07\x0a\x17\xe1\x87\x9a\x81\x09i\xd2b\x1f\xb9`\xaf\xb81\xd3R\x9d\x1b<\\z\x0d)\xbb$\x92\xe7\x88e\xd2\xf5\x13\x8e\xd7\x8589\xef*\xfc\xad\x90\xad\xfb\x99\xaaw/\x7f\xcbQ\xb1>(!\x16\xd6\x1a\x13uy\xeb\xe6\xf8-\x1e\xd1@\xa1\xe5\x81\xd5\x89\xb6\xcat\xef\xa2og\xda\xc7\xb2g\xf8\xb3\xf6\x0a\x87Y\x9eX[J\xd8]tu\xb3\x15^\xf6l\x08@\xea\x88\xf4\x87\x11\xc6\x8b\x00\x80\xb5\xfa\x7f\xf8\xfdnI\xff?\xdb\x93!\x0aV\xe44\xaa\x8d'6\"\xc4\xaf\xb5\xd5\x04)\xdd\xf7\xaf\xf0\x08\xb5J@\xe01\xaf}\x8f\x1a\x81SD@\xa9\xa3\x8b\xc2\xc5\xc8\xb6v~\xee\xc0\xed\x96\xd6\x97\xd2\xae8\xce\x91\xc8\xae\xa9\x8ap}\x86\x0c\xdbxJ\xa7\xce\xd6\xe0V+8(\x86\xe4\xbb\xe2\x02>\xfa*\x8dc\xb3\x94\xd8#_\xfa\xba\x09c\xd4\xa1k\x10\xb0yD\xc5\x11\xcb\xe2=e\x0dw4g\xbe3\x0c\x14\x0aI\xda\xbdq\xe8\x1bats\x06|\x81\xe5\x11$e\xeb.\xde\xe4#M\xa3;\xb9\x0bkw\xfe\x0a)E\xab\xe9\xfe*\x9d,\x97\xfe\xd9\xae(\x96\xd5(h\xe9\x88\xee\x03\xae\xaf&\xc6\x8e\xa53\xd5\xe5\xb0\x8c\"\xdc?\xc5\xf6h\x90OT7\xb9\x98S\x82$\xdc\xe1{\xa5\x87\xcb\xb6\xef\xf5\xee\xb7R7c\x86\x88\xf7\x10nx\xd3\xdd\xafv\"\xd8\xa5sxY\xe5{\xfe\xd73o\xdfm\x03D\xa4J\xc4\xb7n\xee\xbb\xd3V\xdee\x90\xfc,\xf8Z\x05\xca\xef1^\xef\xee2\x99/\xbb|\xff>&\x9d\xa0\xbf6\xbe-\xdc\xc5#\x1f\x83\xf8\xb7\x0b\xc8\xf6\x1f\x05%\xc1\x99\xa3,#\xe3\xfa\xf19\xa6\x80h\x83Q32t\xdbt\xcb_'\x0cj\x94\xc0\x06\xd74\x1a\xe0\xb9\x8b?\xcb\x0d6\x15\x1du#\xc6G(<R\x18^\xe1\x83\x18OB^]\xc8k\xa0\x9c\x1a\xc5\x9bV>G\xb2P\xa6\xd54MaZE\x9f\xc2\xf5\xb8\x13\xb3~\\c=\x88\xdbz\\j\x8a\xd0\x8c\x0d\xf7p[\xcc\x01\xd2\xa9\x09\xe9\xf2\x9dO[\x0f\xc3@q\xd9\x19$\x9e\xa0\xd8#Bp\xdb\x81\x09c#\xc3\x0d\xf4\xf2\x89N\x0dV\xc7o-\xfd\xe8\x09\xfb\x80\xb0~\xca\xb6q\x97\\\xecC\xc7\xaa!\x16\xcaj\x7f\xc1\x9c\xee\x91U\x16\x1b\xbf\xa4\xcdr\xbfS\xe4\x8f\xa1\x960m\x0ado\x13\x1b\xfd\x1em\x9a\x97_\xbf\x8c\xbb=\xb6\xa0%\x93\xc0tS&\x19\xda\xfbk5X\x1a\xae\xc6\xa7Z\x95\xa1h\xc4a\xd0" +
+	"\x1a\xca#\xfdH\xca\x99|\x90{9\x16\x7ff\xf1\xc1\\\x96\xfb\x05\xdfV\xb8\xf8\x9f+N\xae\x87\xe1\x0f\x93\xd8;\xd0\xb2\x14\xc1\xffO\xc5\xaf\xf0\x0bL\xa6\xe9#\xdd\xa6\x9f\xc7\xf0\xbf\xf0\x99+\x84)\xb5o\xac\x96y\xc2Fh\xab\xfa\xce\xc0F/\xd1\xf6g\x1d\xc8N\x1f\xa3\xbbFQ\xf7\x99~X\x9d\xb9Xm\xcb\x94\x11B\xbd\xe7G_C\xd7Ay|\x1fer\xe2\x81\xc5\xb9\xc4\xd83#\xc0\x8d,(\xae:!\x93\x18B\xd1\xb1'\x8c\x8bV\xc9\x05\xdd!\xbdF\xa9hC\x99 \x8e\xa4i\x0e+\x99\xda\x01vg|\xbeM\x9b7t) }f\xc6\x01\"\xac\xc6\xae\x83\xbe8\x9f\x8b\xe02z\xd6\x0f\xf0\x86\xd3\xf1*\x80\xd0\xfb\xfa\xeaI\xcanE\xbd\xa0\xab:\xd7OP\xf8(\xca\xa36V\xf7\xeaX\xb1\x0b\x15P\x03'r7\x17\xa8\xfe\x8c\x9e#\x9e\xedc\xd5U\xe3\x04\x052P\xae\xdff\xca\x04\x10.\xd6\xf0\x8d\xe6\x94b\x89b\xd4qE\xa10Up\x0f\xfe\x95\x94\xcal/I\x96\xba\xdc\xea\"y\xf9\xf5\x9b\xbb\xf3h\xf3\x9f\xf7(\x97b\xd2'\xdd\x989\xa7\x11\xbe];\x8fI7\x11\xfa\xb3\xfc\x83T\xf8\xef\xdc\xadzg\x1c\xcd\xa7\x04\xfa\xd0E\xe2\x15J\xdd\x84/q\xcd\xc8\xf7)c\xd8\xba\x90c\xd5]E\xb4\xf4\xf1\x13\x8d\x83.2\xd7\xeb\x1ey\xa0\x8aT_B\x09\xcdr\x0f\x0f\x96.\xc2\x0d\xf4\xcf\xeb\xacC/\xbf\x8e\xad\xea\xb3\xfa\xc9g:\xa4It\xfc\x00W\xe8\xe0\xd8Ps\xeb\xb2\xf6o\xc7\xc4TN\x92\x97\x8f\xd0p\xc8\x99\x95*i\xcck\xff\xf8\x08V\x95\x8e;\x96\x7f\xf2\x0f\x1a|\x94\x09(t=3\xdb\xf9\xf1y\xd8!T\xf5.Q^\x8cN9\xa6\x11-\xda\xd8.$\xa1\xdfi\xdc9\xbc\x18{*x_\xb4\xabI\xb3\xab\x84\xd0\\\xa6a7O\xfa#%\xb2\xd0\xad\"zE\x89P\x00wvI\xe3Rl\xaa\x1e9\xc0\xb7\xc3 7/\xa6E\xa6\x04!?h\x98a\xf4\xedr\xa3\x0eB\x9b\xf9\x80\x13\xff?\xb6\xb8\xdd\x93\x83\x0b\xedl\xd93IP\xea\x1a7\x90\x01]_\x7fV9\x8c\xf3\xf4\xd2L\x83\xc9\x07\xbcg\xa3\xa0Ee\xff\x1a\x06\xb8IS\xd4A\xb2\xccM\x85\xc3\x93sN\x92\xd3\xf0\x0c\x0df\xda,E\xa2\xa4]e\x84N\x898\x09\xa1\xf2\xe2M \"#\xca\xa3\xe0\xfc\xab\xa0\x8d\x11\xca\x9e\x8dS\x0as!\xdf\xd4\x16\x08K7=\xb5\xd0\xb0u\xf8B\xc9^)p\xff\x0e\xe1\xeb\x97\x8b\x84\xfc\xdc\xb0\x10\x95\x87y\x10\x98\x9b\x9en%?^\xee\x08\x94C\x03\xed\x0b\xa3=:\xf9\x08q{0\x1eH\xe9\x94e,E\x0c\xdb\xb4\xc5\xa1q\x13\\\xce\xf8zL\x84\xd2%\x92\x0a\xfe~\xd5\x92?>VWhA:\xf9%K\x87\xfb\xa3\xb8U\x93\xef\x1f=q\xdeq5\x80\xa3`\xa9\x00\xe4\x1a\xc7\xeb\xe2\xb5\xdf\x8ax\xed\xdf\xfe\xeb\xdf\xff\xa7\xbf\xfd\xb7\xbf\xfd\x7f\xff\xf6\xff\xde#_[\xb4\x00]\xd19\xd7\xddQ\xb0\xfd\xd8\xceDlii\xd0oy\xec&aa3\x0a\x97\x0c\xf3\x10AIC\xb8\x87\xe3\xf9\xef\x0b\xd8B<\xdb\xc3\xd3\xb0\xe7l\x8b\xe5F%\xe3\xbf\xb0uQ|\x17\xbb\x09\x13\xf4\xa8\x81\x1f\xfe|0t,\x96_\x04\xed\x99\xc1\xbe}\x12\x86\x9d\x0e\x9e\xa1S\x08\xd8W\x1f\x07\x18\x9f\x85e9R&\xcc\xa5]\x83\x05\xb2\xbc\xd3\x97j\xa8J\x18D\xca\x80\x88\x89dD\xab\x1e\xcf\xff\x176;\x8a\x989\xd2S>t\xd0\xd4\xadoP}\xfc\x94\xac\xbb\x19\xfb\xea\x08\x03\xcb\x94\x9fL[\x9a\x8f\xe6&\xd8\x0b\x8c\xa2\x0f]\x94\xb7\x10\x95\xf4Mq`\x06P\xa8\xd4\xaa\xd1\xcf\xe9\xa5R\xad\x8a\xd11 .\xae\xbe\x0f\xc0D\xfa\x81\x7f\x0cv\x00\xdc\x99~\xa8'V|J?\xc7\xbfgm\xf3\x8b\x87:l\xdb\x9a\x1c\xe6\x1b\x8c\xc1\x16\xd0\x8f`+u\xf8\xfc\xe4o4/\xe4n\x09\x16\xb4\xbff\xa1&\xfe\xe1yF4\xa1\xd6gy|\xc5\x15\xcdvq<`\xb6E\x88?\x19\xdf\x95\xb4\xd3\xf9\x1b/\xe8`\x9e\xe9G\xb4\xaf\xd0\xdd\x1c=$\xc5\xf8\x17\xdf\x9f\xf1\x95\xb9gN\xe1V\xa1\x83\xfe\xcc?\xf1}7\x04h5OC\x17k$\x0fp\x11m\xc4\x94+\x94\xfepB\x90\xc6\x80&\x9f\x16\x07\x88L\xd2\x08\x1d\xc4\x8ey)P$\x81n\x0f\x9d\xde\x003Q\x8e\xf6\x1d\xca\x7f\xab\x85\xd8\x81\xc86\xfe-\xe7\xe5R\x0d\xbf\x84\x89\xd9\xd6QV.\xea\x03\x94j\xcd\xad\x9d\xa8\xab\x01\xfaF\x91f\xcf\x94J\x99\xfc\xc4`S\xfa\xab\x18\xbf\xd0\x1f\xa5\x87\xbf\xa9\xb4\xa13\xd1$m\"a\xc1\xe3\xf8\xd5'A\xd53\xf8/\xc9\xd1\x93\xde`l\xfb;\xeag\xd9F\xf0\x13\xc3 \x15\xc1\xe2\x9fRN,\x03\xb9\xf9\xc5\x0dI>\x13\xb9\x7fs7\x9a?r`[\xd5V\x0d\xfa\x14\xa1\xb0\xd0V\xe1\xce0 \xab\xea\x8a\xa8\x83\xe3\x11.\xb1a\xed{W\xf4\xd5\x00\x8d\xa1\xb1\xaf\x1a\xd2;\xc2x\xe8^\x08\xf6f\x86\xfb\xa6\xc85*\xc9\x8a\xea\xea\x18\xbcY\x1e!\xf4\x99\x08\x87\xe2\x9a\x11N\x16\x03m/sT\x95\xd2(v\xcaI\xff\xd0uR\xba\x9c]\xf5\xe1\xa9\x9f\x9ej\xbaj\xe6\xde\xae\x88+\xdbB\xaaqU\xc2?\x147\xd4z\x18\x8f\x89\xfe\xb5Q\x11\xe4;F\xbc\xe2B\xf9o\xe9{8g\xd0T\x03\xe6R\x9b\xf1n\x94\xabQ\xe3?\xe5\xae\x15-\x91<[\\\xf5W\xb8\xc9\x99=\xf3\x070\xc9\xa2\x09\x1cN\x83\xe6\x1b\xf5H\xee\xa4\xac:Y\xaas\x8a\x15\xcd2D\x99=\x01\xd5\x13\x05 \xf4Ri\xbb:\x83\xd0\xc6\xcd\x9f\x91zF<>\xf9c\xdb\x9a\xd1\xf18\xce\xf1\xc5x:\xa1\x0a\x166\x92\x8c\x15~\xeb\xad\x1a\xc2\x0c\xab\x95\x06A\xc5\x92\x0e\xb7P\xe1A\x7fU\x18D\xb4\xc3\x18\xbb\x84@_R\xc5\xd6\xa3\x8c\xd9.,\x8e\x87\xffx\xbc\xd2\xbd\x89\x8d\x18\x06\x09\x85\x95p\xaa\xf3I6No\x82`\xdc\xefM\xee\xf5\xfb0c&\xed\xfa3\xbaW{t\x97\xa1\xe3.D\xea\xe2\xa5\x9c\xe7I[2(\x1a\x95\x0a]W\x0dP\xc3HUx\xb2\x9b\x11\x00\x98\xd6\xf8\xab\xc0%H\xd8n9\xa2\xe8\x03\x85b\x0b\xd3O\x8c\x0ch\xc7H\x96\xe1\xe7\xec\xab\xc9[8\xa3`p\xecXV\xc0l\x13\xfa\x0d\xf8\x91\x1d\x92\xcc\xdf\xdbML<\xd2\xa4\x84qN%1\"[\x13\xe5'\xb8\xe0yu\xad\x1bp\xfdp\xd0-9.KSeT\x16\x890\x80\x8eY\xe9\xb8\\D'e\\V\xfe\x12\x09ju\x8d\xe1\xa6Qp\x9a~K\xfaf\xbc{\xcd\xbf\x86A\x8a9 \x04K\xd2\xf2\x95\x90y\xd6\x89aX\xceL\xa61\xde\x00\x9f\xac\x02*H\xdb\xb9BP\xaa\xa7T\x92\xd6\xe4\xb7\xdf$[\x9e\x08\xc5\x18\xaa\xe6m\x11\xeaE\xf7\x161\xee5E\xd3\x8dk5\xff\xd5\x19\x91-\xd4Lh\xa8\x02\x03\x8d\x17\xe2\x16\xce\x0c\x9c\x12\xbd\x07(q\xa1#)\x13&D\xeeP\x19\xbcL\xbc\xef+\x92{q{\xdc\x04#j\xcf+\x9e\xc1?j\x9a\xea\x90HD\x1e)o\xa0\x8b\x90\x01\x94x\xa3\xa6m\xf8d\x9a+L\xefm\x0d\xadk]\xd8\xb0\xaa\x82\xda\xbb\xb0Q\x92~\x0f\xb3\xeb\x92\xf2N\xa2Js\xc1\xc6\xeb)8\xf2\xf8\x03\xa2+h\xf2k\xc9$DcdN4\xec\xe8\xd9\xd6\xb2\xfe\x01T5(F\x8a\x87\x9aSl@3vDU\x8e\x08!\xd6\x9aa\x0d-\x9c\xf9g\x92b\x1e\x13\x0ep\xbb\xb9d\xad&\xa2\x1f\xbc\xc0\xb5\xfe\xad\xbd\xd0\x9a\xcf\x13\xce\x8d\xec\xbc\xf2\x0d\xc4J\xe9T\xb5p\x83\xc6(\xf9h\xd5\x02\x9c\xea\xcf\x0b\x80\x1e\x9d\xbb\xa2\xf3\xad\xa7&^\xf1o\x80\xa8\xaf\x92\xdb\xe5\xcf\x98\xf7\xd3\x10\x84a9\xb8\x0b\x940\x1d\x13\xaf\x90<\x03\xb4\xb7\xf1\x88vp\x1e\x1d\xb0\xad\xda\xa1\xd0\xfd\x83\x15\xb4\x8a\xb3C5\xdc\xc3\x0d&_\x1f\xe5\x1c4'\xefB\xfa\xffDIA\xde\x07M\xd5\x81\x87^\x0cR\x01\x13\xd5S\xa6\x1026#\x90P+}\x0d\xed\x8d\x0b\x16\xe4\xcf\xf4\x83\x99\x01)D\xbf\x11\xc9Q_\xdc\xaai\xfb\x18x\xb3\x9e\xba\xfe\xc9\xb1[Ok:\xab\xf8\x89\x07\xf4\x87\x80\xa9\xb3\\\xc5m\xd4(+\xe6\x17\xc5,'\xc6\x1f\x09\x1c\x91\x1a:\xd4\x16\x9e\xf5_R\x0a\xb6\xeb6\xae\x8c<Y\x7f\xf50\xe2\xaa`\xb5Z)z\xe2\xdf\x0f4r\x981\xa9a\xc0\xbf\xe2\xda^8\x12>\xac?\xc1\xc7\x12\x07eT\x19\x8b\xe2\xe2'\xe3\x15%\xa8\x18.F\x847\x87\x0f]\xc3~(\xae\xbeu\x93\xf2B\xf3\x1e.\xf1_\xef%\x94\xb2'&%\x16\x94\"\x9a\xaa/\xc6B\xa4\xdfK\x05Z\xbbC\xf1\x17\xacE\x1d\x91\xdc\xb8A\xf9OL\xc78\xf8[\xecV\xbcQ\xdf\x80\xdaA\x99\xf0\x85\x11\xb1\xf4\x1bZ!\xce\xdc\xc1;6rQ\xe4a\xab\x08\xf16uy5,\x15\xdf\x1e\xdc\x10\x00\x01\x8f\xea\xba\xd3\xfa\xe5\xc3\x1a\xd7\\\xeb\xb0\xdb*\xbc\x13#\xc2` \xcf\xf4CGA}V\xfe \x0a\x02\x89\xa4$B\xf1\xbc3\x1c\xa5\xd0]0e\xf3\xc9\x8a\xa7\x1c\xda\xa7+\x0890Ol\x1b|\x0a1\x857\x97\xb5B\xdf\xaa\xa1v0\x8a\x04'\xb40\x9d\xc3\xcb\xa9\xe0~-4\x01\xde\xd5\x94i\xa2i\x0e\xff\xd7\xfbK\x9bZ#\xe6\x98\xaf\xee\xbb\x1bt@\xcd\xc5Ww4'\x89q2a\xaa]HO\x93\x8a\x02^\x9e\x89Y\xa1\x97\xe6yI\x19\xcb4+\x88\x9a\x01\x97\xe8\xed\xa5J\x0c\x91\xd6'Q\x94f\x17\xf4\xa4\xe2\x0c\x98|\x15{\xb9\xf3y\xce\xe6\xdf;D\xea\xbf_\xcb\xcf\x93\x02\x17\xd5\x935\xe8\x0b\x1e\x8aJ\xfb\x1dm\x97\xb4\xf6\xca\xd0\xcc\xaf\xa8\xdb,^\xd7\x02\x16c\xa8\xcfA7\x85=\xa1\xa2\x0c\xcf\xa0\x12\xdd\xe4\xc6\xc6\xac\xe5\xa1t\x14\x87\x11w7\x16\xa6\xdd\xc8op\x93\x17\x06\xcc\xb1\x1a\xa6T\xa2\x8d\xbfP:\xdcB\x8bx\xec\x95\x95\xa3\x89\xbe\x9b\x16U\x96\x9a1R\x87h\x8f\xc2\xe7j7J\xea\x9d\x1ac\xc9\xceu\xc4sZ:\xe8\xa2kE\xf6\xe0\x0fPC\xcfx\x0fN\xc3\x8c\xe4r\x9f\xf15\xff\xf4\x0e\xa5|6\xd2Y]\xd5v\x18\x12a\xae9\xa4\x97\xab\x04I\x85@r\x15Q\xc0\xcb|A8\x05>\x14?\x88o\xb1Ts\x82\x06Z\x0a03\xce\x07=\xfe\xa8nt\xda\xd6\xae?<\x03\xf2\xce\xac/^\xea\x8e\x8c}\x901\x92\x1a\x01\x1b\xc1\xf0\x0d\xf7\xbeLC\xc4\xd5B5\x01\xae\xb8\xb1\xef\xac\x83I_j\xf9t4\xe7\xa5\x9fH\\l\xeb\xd4\xebv\xa3\xbb\xb6\x7f\xc9\x97\xd6N\xdd\xf5\x05\xac\xd0\x05\x06\x09(\xc2\x89I\x10\x03\xe4KN\xf6{G\xde\x00>AM\x06\x98\xee\xba\x87_\x1a\xa8\x13\x81\x98\xa1\xfak\xb5X\xd6Np\x07'\x01\x1eh\x88/\xddR\xe1\x1e\xe9\x07^u\x98T\x9f12\"\xc7MI9\xc0\xf7K\x89O\xadYS\xdeY\xf6\x8e\x94\xbb\x0fj\xac\x8e\xa7m)xYg\xa3\x1f\xaeP|\xf5\xb1\xecS\xe0\x8a\x80:%-\x9a\xf2\x8f\xdd\x05k\xb1dvT\xde\xc2!'\xb2\xf03\xfd\x90U\x00=4\xc5\x83\x7f\xf0\x09Bf\x96\xa7\xd0\xbb\xa1\x87>\xd3\x0f\xb9W\xf6#;\xcf\xea\xda\xef\xb7\xd5\xd8\x0f\x86h\x86\xa9\xc3\xa22Lxb\xdf\x17_\xe1\xb7\xfb\xae\xb8\xb8\x0e\xc22\xb9\xc00\xf9.a*\x80\x16+M\x84\x04 \xad\xdflV\xdc\xe4w\xa8\x96\xff\xd3|\x13\xd2v\xb6\x9b]\x10\x92\xa5s\xcd\xc0\x82\x0c4\x0fD\xeeVBo\xa1f\xbfy\xe7\xca\x16\x90\x94@\xc2\xdbB\xb1\xcd\xfa\xaaT&\x1bA\x10i*4\x95\xc8\xd5\x93\xc2\x1c$\x07<(z\xe8\xee\x14Y\xdf\xe4\x99\xd0\xd6\xc3\xd8\xc4\xed\xc9\xf7\xfe\\u77Y\x85\xde\xb5T\x80\xa5C\x14\x10\xbd\xee\xc2\xda\x95\xfe\x06\xbd\xc2\x1ccdb\xfe\x16\xfe@`\xc3\xd2]\xc0\xf6\xcf\x1f\xcd\xdb\x14\x19iz\x19\xb11\xf3\xaa{\x96\x89\xc4\x8e;\xd4\xc4\xffi\x16\xf9\xfeC9q\xce\x86w\xbeyt\x7f\x0d\x91\xf5b2|!\x84\\j|\xeb\x9f\xab\x91\xf2Rkc\x1e\xab\xd5\x8f\xbeY\x03\xcd\xf8\x0b\xd8<\x10}\xe1i[\xcd|\xf5\xe4\xb4d\xd35(\x9e\xac\xe8\x80k]U?U\x03t1y\xc5m\xb2\x92\xbe\xfc\x1c\xfe\x83\xf1)\xce\xe1\xec}6\xa1\xc3_\x90^y\x87>\xf9?\xdd\xbb\xeb\xe0bt\xe8b\xb4U}J\xb6U#\x15M~\xabn3\"\x0c\x8d\x0e\xec\x0e]\xf1\xe8\x88\x9fr\xea\xb8`T\x84&\xb2\x14H\xb9\xef4\x87L\xab!\x02\xc8\x99\xd2\x9b#[|c\xc4\xac\xe4x\x80\xee\x02\x0f0;Q\x0d\xf6\xe3\x1eFw\x964\x98W\x13\x936\x88\xce\x0f\xc8\x7f8[\xd36\x88\x8d\x1c\x11z\x8d\x98\xb9}\xcf\xcb \xae\xd1e.\xc4\xd57\x85\x15\xbe[\xd6\x9c*0\x12I\xc4\xc1\x0fc\xa6\xe0\xa0b\xf4\x0f\x12\xed\x09\x85\x07\xfd\xddM~\x06y \\\xd5\xf5)@\x91\xc9\xc9\x9b\xc0ST\xc1\xcbv\xb8T\xacq\xcfR\xa0\xe4S\xf5KZ\x13x\xd2\x15\x1dL\x8e/\x8d\xe4!\x0f\xa8\x1ayPUc\x8b\x85\xa2C\x0c\x8eH\xcbQ\xf50\xf4\xcf\x9aV`\xa0\x89\xc1\xed\xcc\x04\x12S@\xdd\xeav\xa7`~\xa6\xc9\x01}\x09\xae\x7f^\xaa\x07PS\x87\xd6L\xf8BG\x91vN\x1b|\x15K\x95\x86\xb9\xdaU\xb7\x121\xd2\xea4\x9d\xf9\xd2\xd8|!d8F^\x17\xb8\xb0\xf2 \x93:\xa4I,\x9e/\xfe\x00\xa3\x11p\xe6\x90\xe4|%(3\xdd\xfe\x11\xc9g\xfc\x8b\xbbe\x1a:\xba8\xf8\xd8\xa1\x9c>k1\x11\xd7\x05\xdd\x84\xa7\xe6\x1c^\x92\xec\x17\xe1\x083\x90)\x126\xd3\xb6!E\x9fk\x94\x13YL\x02G\xdc\x14~\xcc\xf50\x1a\xad\xef\x00kb\x87\xad\xae.0\xe1\xbas\xcd\x08\xd0\x9f\xbf`(\xf8\xef\xb6\xb5\xc8\x7fd\xcd\xac&/\xcd\x10\x93\x09\x17`q\x9e\xe6\xcd&\xa5\xe3I\xc5\x83\xd8\xfa\xd2&\x9f\xa0\xe5\x12\xe4\x99\x0a\xe0\x81\x0f\xc5\x8f\xbf!o\xf8b\xf1\xc4\xbex\xe9\xcb0$V\x06%!\x8e\xed\xc7\x09\xbc\x01~x&u]\xd2\xa8\xcb{\xf9\xf8\xf6E\xb2\x12\xad\x9e\xfa\x8e\x84\xde\xd6\xc40]G\xf1\xa95\xb4D\xb8:V\x0d\x940\x90\xec\xe6\x04\xc2\x91\x1aV\x1f\xd9\x09x\x84\xc4p,\xaf\x83\xd2\xc98\x1d\xa8.NmE\xc7\x9c8%W}\xe2\\~\x8d\x9e=\xc7)\x83\x13\x9cwL\xa71\x1e\x91z\xbf-\xdf\xa3\x1b\xc5\x0b\x12\xc8=4\xa0I\x14\xc9\xa01\x88@sg\x89\xfa\xe9&,\xe0\xdbWI\xa2\x8b\xef\x1c\xfe\x0a\x17\x85\xf5\xa7^\xea\x0c\xab\x81\xef]\xc44Sa\x8bC\xf4\xf0\xa7\xac~)\xe0\x07\xce\xe3+\xfb\xed!\\R\xe8\x85\xb7\x05\xde\xfflr\x95\x14\x83\xf2\xf0\xa1\x85i\xee0'Pg=\x1d\xd6\x07\xc9\x91N\x10,\xf5{\xcd\xfff\xa0>\x83\xefy\x8f\xd8=\x99\x8b\xba\xea'w\xc6W\x09?{\xf0R\xd4\xca\x0a\x97\xaa\xae;\x8b\xf2\xeec\xc05\xb2\xdd\xfe\x1c/`|8B*\xed\x10\xa0\x9f\xf9\x8edl\xd3d\x05\xba\x09\xa9\x83\xb5\x10\xf9\xdc\x85\xd7\x9e\xb4\xdb\x8b\xe0\xfbWF{\x9f\xe5\x1f\xa1\xa3\xac\xf6%\xf9\xf9\x7f\x82q\x80>\xe6\xa0\xb9\xbb\xe4\x14\x8b\xe9\xec@7\x14\xbf\x1b\xb9xk\xc0\xd6:\xc4\xd9\x15Wv\xe7X\xfdfU\xe4y\x86lQ\xb6\xdbHv\xa3\x80\xf2\x0fKn[H\xec\x16\x07>\xe2\x8f\xeb\xee\x1d\xbb\xdd[\xce\xdd\xdb\x15\x19\xa2\xdc\xed\x96\x09H\x80\xe4\x11\xcc=\xc0\xe4\x87\xcc,\xb1\x01\x08\x83\x19\xbai\x06\xb8\x08\x88G\x1d\x1f\xb5\xc1\x08\xf7(\xeaP\xed !.\xdd\xe4|Z\xa3\x9b`\x99\xa5\xcdR`2\x8b\x19\x83}\x02}\xa0r\xa5\x92\xf7s\xe7\xb2\x1e\x8b\x87\x918@7\x8c\xc6w\xab^\xc5R\x94\xa2\xe7\xe2?\xcf<\x8a\xa8\x924c\xcb\xd7\x83\xd0\xa6\xa9\xa4\x8b5\xcc\x834\xce\xc6\xc7\xac\xa1\x91>3\xda{\xf4\x85\x09W\x96j\xc3?\xc3d\xe3l\xd3\x87Ek/P\xcb\xc9\xe9\xfd}\x8a \xeb\xc2L\x86/dH\xbc2\xf0U\xfd\xe4\xf7\xe8\xba\x1bO\x17n>\xd5\xca\\\x8c\xcf\x95\x0f~\xf9mG5\x04\x02\x03;3P\x91\x02\x80\xff\xa1\xeb|{\xe6\xbb\xfd#\xf8\xba\xe8\xa0\xfe\xc5\xff\xb5>\xbe\xd8VxW\xe0\xeb\x17\xd69\x89h\x17\x05Z\xaa\xad\xd0\x15\x14\x03D\xe8\x1f\xa0\x9b\xcf+L\xe0%\x82\xfc\x85\xaf\x87\x1d\x9e\xecx" +
+	"\xf0\xbc\xde\xaa~r\x8c\xd9\xc1\xce\xac\x05\xa1\xbb\xc9_a\x14D \xf9\xc6\xdd\x0d(\x99\x0c\x8f\xd1\xda\x87o;'J\x87\x94\xe7\x86\x0e\x9d\x09vI\xb7e\xd6\x19\xc4d\xaa\xc7b\xd0\xach\xd3|_\xe8B\x0c\xf3Z\x0dP\x9f\xe9\x07\xeb\x09\xdb\x13\xe7\xc8`\x1a\xfc\x1c\xe1\x02\xf3\x0fa\xa2K%R\xabN\xf5R9i4U7w\x0e/!q\x84;E\x97\x9d,'\"\xd0=H\x09V\xd6\x13\xf7\x08\xc4\x8d5GZ\x85M\x18\xaeB\xc5\xee\xf7\xb6\xe8\x1cc\xfb\x87*|\x02\xc7\xf8.$'\x15`\xe7Q[8\x85\x9f\x97\x1a\xc7\xe8O?VM\xa8\xcaE\xcb\xc3)S\xf5&Uo\x9fBJ\xfd\xbd\x0dl\x95\"\xc4v\x95\xbeP`\xd6\x1c\xe8\x89,g\xaarl\x02\x8d\xd3\x0c+M\xb7)\xf5?\xb9\x16\x1d\x91\xe4\xad\xde\xab\x1f\xdc\xcdZG\x0d\xf6\xef3\xd5l\xfb\xdd\x09\x9d\xee\x85\x0f\xdcD\xd7^\xfcs\xb4\x9a\xeb\xb0\x8c\xff\xe0\x1b\x7f\xb3\xb5a\x166D5\x90\x84\x0e\xa0Q\x17\x85~],\xb6f\xb9\xb6\xf3\xda_\x94\x1d\xd4\xeb\xcdN\xafb\xb3\xd3\xdf\xfe\xb7\xbf\xfd\xb7\xbf\xfd\xd7\xbf\xff\xe7\xbf\xff\x97\xbf\xff\xcf{\x1a\x9eN\xd4\xf1\x84\xcdN\x87\xd8\xedt\xb4\xbdLt\x8e\xcd\x0a\x95i\x9a\xcc\xf0_\xabN0\x0c.\x05\x9f\xd0g\x8b\x07\xa8\x01\xa3\xee\x14\x86\x92\x9c6\x14\xbcJr@\xd2\xa7\xac\\H\x01\xcf<}\x86\x18\x0e\x0b\xc2\xad\x86\x9b\xbf\x93\x12X\xe8\x8e\x92\x09q\xadk\xdc>T\x15\x1d\xbdM\xf5\x80\x80.\xd1.[\xf4\x9d\xc5bSX\xcf\x9b\x98P\xe8\xb7\xaat\xf5i\xb3\xb3h5\x91L\x01R0\xf8\xbe\x01\xb7\x10d\xa9\xde\xc0<\xfb/\xb5\x15\x0a,\xcf\xf4\xc3%>\xa5\x86\x99\xd1s\xb5\xbd\xf6$=j\xdf\xc1\xa5\xebk\xf3\xd4h5\x83~G\x14\xa7l_0 \x95\xa1x\x04_o\xf7\x04-\xf7\xff\xd2P`\xa2\xe6\xe6\xfb\xd3\xdc\x89a\xaf\xb3\x814\xcdk(\x85$\xc0\xa5\xbe\xb1\xf8I^\xa8\xb9`U\x14\xec\xdaS^\x90Q^N\xd0\xad\x17\x82>\xadC\x98\xbe\xf8t\xb9oS}\xbc\xfeM\xce\xea\x8f~\x84\x9c\x04,\xb6AR\x17\xedA\xbdV[\xbf\x8b\x9d\x89jj\xc5<\xb4\x98\xb9\xf1]\xe7\x7f\x87\x81\x80\xbe\xc0\xa2\x06_G\xcb\x0c7aZ;\xd7\xca\xe2\x94\xd2\xe1\xfd\xae~\xa9\x8cR\x81\xfdi\xc3m\x8f\x0ck\x17\xc6/1\x08&\x04\x93\xcf\xbe\xf1\xc3v\xb3\xce'\x12\x85\xa2\x02\xceQ\x999p\xdc3\xc1D^\xb6\xb1\xc3\x9f>T\xf4\xaeQ\xda\xcc\x02&\xf4\xfd\x122\x9c\xfe968G\x92\x9b\xc3\x7f\xef\x86\xaf\x00\xd3#\x08*\xa2x\xeb,\xff\xc8\xf0\xf3\xcf\xc1^%\x9cph\xc1\xb8\xaf\x0b\x0d\x97}wX\xe3\xad\xab#\xe9hl\xc0\xa7\xee\xfcN4\xfb\xd1\x12\x99\xf7igH\xe7\xc9\x8fZ\x990\\$O\x17\x88\xad\x09\xca3\xc1\xd0\x99-\xd6\xf6\xc5)(\x1e\xfd\x15\xbd=&\x91\xe2k\x85;\x86\xa6\xf5\x1d\x82\x1f\x16B\xfe\xa86\xa6\x93\x7fb\xc92+\xc9\xd9#^\xaa8\xd0\x9c\x1d\xb3\xd4\xc5c\"\xb2.\xa9\x07~\xf3\xf9\x8b?jX\x9b\xa5\xcaBv\xd8\x1e\"[\x9c\x9d\xf6\xd1\xedRK$\x144\"\x9d\x1d4\x0c\x04\x8b\\\x07J\xd5\xb7p\xdf\xb2u\xd6\xd8%\xc9\x8e\xc1\xb35c|jo\xf1~EG\x0fg\xa6\x8e\xe8l\x1aU\xa1\xb2]bZ\xa0\xe8\x8a\x8f\xd2\xb0\xb5\x8a?\xf9\xfa\x14\x90&\xa7\xe2\xe7\x11:_\xce\x9e}\xac\xb8\x96\xc4\xe6\xff\xe74\"0\x8el\xdaM\xc1\x8e\xf3\xcf6i\x81\xa7\xd4\x19_\xf3\xb2\"\xdb\xd9\x00\xb4l&w\xc6W\xc3\xb3\x91J\x06\xc7\x0e\xde\x04\xeb.\xe0\xf4\xe2\xc0\xfe\x06j\xdc?8,\x17\x95\xd0\xe7\x13\xfe\xd3\x82NB\x9c\xc9\x94\x0c\xaft#vV\xd8\xc8\xd2\xa8\xe0\x8bH\xe8\xb6\xa6\xac8\x00\x03\\\xfd9\xbc\x98Z\xc5\xf5Z\xb5n\xa4\xa3dAS/\xdc\x072\x944Q}z\x9b\x94\xef\xe5\xd7Yh\x90L\xc3\xac\xf9\xb4!tqv\x87z7\x9c?_\xa3\xa6\xfa\x10\xad\xef\xc0\x85\x85\x08\xb6\xad\x86\xd8\xd9\x98\xe2\xfe\x94\xe2\x8f7_\x87X\x8c\xab\xaf\x1bM'@\x7f\xec\xbc\xacu\xcc\xbed\xed\xf1\x02\x8cX\x95\x08^\xe6'\x8a%BJZ\xe3\xd8\x06{\x88\xad\xcc>\xa9E\xca\xa3,x\x8ch\xfb\xb1zv\xa4K\x94\xc9\x1a\xe2~\xaeP\x88@[\xd8W}\xd5\x14\xdd\x93L\xacSy8\x92\xce\xe1T\xd5\x8c\xdc8\xdf\x17[s\xafA\xcf?\xcf\xe6<?i\xd3\x95`\x9c\xcd\xcd\xf1\xf2)MF\xa6\xb4@\xa4\xbd[\x0e\x9f\xc9`\xd7\xe1\xb88\xd3\xeb\x9f\xa4f\xcb\x99 \x98\xfcd8\"\xd3\xb4\x1e\x95\xe0q\x84\xe2\xa0\xf9\xfbi\xbdOY\xd6\x98~\x8f9\xa3\x16+\x92\xe1\xee\xf2\x8aV\xe7\x9b\xce\xf5\x16\\\xb0\xa3qL@@\xb94\xb1\xb9Jz\x0f\x02\xa1\x08\xe3\x14\xb3\xcf\xc1{&\xbd\xce\x16\xfatq(\xf36\xdb\x9f3\xff\xd4\xef!(\xa6\xee\xe5+\xee\xb1P*\xe0\x7fX\xc6\x0b\xe6\xa26_pw\xb0\xeekI\"\x92\xfd\xb7\x08J\xbe\xfcv\xa9\x8e\x92\x80\xab\xe4\x1e\xfey\xd5\x9a}Y\x1d\x0bt\xf5G\x0cL\xc5\x041\xa9%\x8e_\xac\x8a\xe6\x92\xd4W\x11uN\x10\x87\x12\xb4g8\x98\xa5\xdb\xc9\x04]\xb5\x16\xbe,@\x83\x03\xb7\xdd\xc5\x16c\x11{\xf5\x1e\x93I\xd1\xff\x95g<\xd3\x0f\xae[\xa4\xa7\xfb_f.\xe7A\xc8\x0a\x82\xed;.V\xfe\xfcT\x1ctXqi!\xd6\x0eJ7\xa0\x07g\xc8$,\xc0\x18\x93\xc2X\xe4\xdfZ\x0cI\xf8\x16q\xfb\xc4^Qu\x0a\xdc\x83\x09F\xd2\xbb=\xe3k\xc2\xabDc\x8d\xeb\x15\xd3\x7f\xe9Rm\xa9\xa2\xf4+^\xebL?\xc87\xa3\xdak\xb8\xe7\x16N\xdb\xad]\xa5+p\x08\xc8=\x08\xcf)@\xca(BA\xf0M\xea\xcbp\xbd\xef\xa2i\x027\x14=\xd2<l\xf7\xe2\x94\x0e?L\xdf#\x9f\xe3\xf3\xa3/j\xe8n\xd8k\xa3\xf49n\xc4\x93\xb9j#\xe0;bN\x0e\x17\x8c=\x83\xc7\xaa3\xa4\x85\xf6\xb1\xbaV=6\xb0\xf7\x8f~\xf0\x9aG\x8d\x17\x0f\x07f\xd1\xc1\xe4NZ\xab\xd71 \xeee(\xb9\xc0\x1d\xb5+v\xf4\x8c\xacp\x0ed\xe0\xf5\x13\xa7\xef\xcfI\x16?\x8a}\xd0I\x05\x97H\xa9\xe30\xfc\xba:\x03\xf2\x0e\xb3lpt\x18P\xeb\xbd\xe2[\x1b\x04\xf7\\5\xad\x93i\xe6pc\x81t\xda\xe4J\xb4\xcb\xf2\xa5\x9a>>\x08\xac\xf5\xb8\xb4\x0b\x15v\xb5?o\x14\xfb3R\xe9\xa7\xd0\xee\x04\x13\x91Z,T)\xb2\x0e/\xedo\xcb\x1c=\x19\xb7\x1a\x9a\x0f\xc5\x1fL\xd8}\xd5\xbc\x8f\xf4\xbd\x089\xad\xda\xba\xc6\xb8y\xd7+\xf4\x93\xb3dC3\xa6\x92\x1d\x0d\x1a\xb1\x1f\xd0\x0e\xda\xbc\x10:\xfa\x06\x06\x0b\xca\xd9n\xc7\xf8\xb4 \xae\x13\xe6\x8a\x89r\x7f\x84z|\x0e%^9c\x1b\xdf\xdd|\xaa\x96Z\xb0)\x14\xe7\x99q\x98\x8byz\xceivP\xafb-\xc6\xa7\xee\xf2\xd4_\xd7\xa3\x18&$\x16\xc5\xc4<\xc9\xa1\x85b\\\xb9Igh\xed\xfa\x92A\xecB\xda\x85W\xc4\x8d\xcb\xf4\x93\xc2?z\x94\x15\x8e\xe7\x7f\x0a\x86J\xa4cf\xe0\xbe\xe5T \xf90\xb2h\xf8A\xd2\x04e\xf2\x81.0\x0b+\x89\x04\xf9\x84\xa8n/0)7\xa9@%\xa1&\x1b\xa8\xd5\x0b\x0a\xf9\xb1\xb8:\xf0\xbd9g\x1f\x17c\xc4\xe9o\x81J\xd3;\xbc\x97\xb4#\xc6\xcc\xb4\xf0(K\xa2\x05\xf1T\x03\x14\x07,\x90\x1d\xa3\x1cq\xd5V#\xc6\x98w\xc0\xdd\x86\x1c(A\xe7t\x89\xc6<F\x9d\xcaJI}\xce\xab\x9fc\xbb\xce\x0c\xf1\x04/d\xf6\xa5%LA\xcc\x14\x86\xe17jM)\x19\xe5a\xac\x9a\xaa\xa4M\xe2\xd3\xe0\x10\xb7\x82 \x8ea\x8a\xfdz\x8d?IEMD\xe22\x8d\xe8`\x9c\x02+\x15^\xd4\xc6\x1f\xf8\xdb\x96\xfd\xcc\xa8\xd2\xf5\xa2X\x96\xf775\xcd'n\x81&\x13\x15\xc6j\xeb\xed3z\xeb\xa8dg\xd9\x02\xad\x1aK\x0ey\x09\x9f\x10NW\xce\x1a\x8d\xd5#4\xbc\x8d\x17\xd5M\xd6\xbd\xc5\xd8\x8b\x94\x14[0A\xf6b\xbb\x03\x89x\xc7\x84\xfc\xb8\xbd\x07\xe5\x9da\xe0-I\xb2b\xc7\x14\xfbU:\x94\xbb\xa8\x89\xfc\xdc\xe0\xc0\xd6Y\x9f4\xe9Z\xcdJc/\x8a\xff\x818]\x90B\xeai\xe8,\"\xd7\xdeD\x18\x12\xaa\"\xd0@\x8e\xd0\x02l\x09;~\xf3j\xeb\xe84\xdc\xa6\xe9f\x9c7\xfd\x86\xb5\xa3\x98|\x847\x8aCB\xf4k\xfa{\x8c\xa2\x07\xc2\xe5J\xeb\x10\x91\xae\xe1\x99\x136\xac'=\xe2\xa6\x92\x06\xeb\xed.&\xfa\x12i\xa2fx\x8d\xa0\xed\xc8\xdf\xa1\xcc~\xe7G\xd7-\x0d9i\xd2\xefi\x02\xc6\xafa{)i\x02\xaa6\x99\xdc`\xeb\xa9\x96\xb1\xa3\xad\xe8\x13m\x9d\x95\xa1\xc46\xa5\xa5\xb1\x0c\x7f8\xa5\xa2\xfd\x0fp\xf1\x93<\\\x18\xe6\xb3\x8c5\x15\x08\xab\xa1\xf3\x0d\xb0o\xf3>\xd9\xeahU\x8a\xc3T]\xa19\x1a\xafnGcP\x04[H*\x0b\xb1\xebw\xf6T\xc2W\xc4Op\xf5\xe2\xd5g(\xd2e\xba\xf7\xf7\x09GK,\xd0\xad\xd8\x8cR\x89\xa6qfW\xcaq\xdb\x9d>\xab;\xe5i\x19\x08\xd9U=:cI!\xc7\xb4A\xff\xe2\x86\xd8\xfd\xf6\x08\x93\xafE\x15\x8d\xb3\xbd\xcc\x1d\xd0T\xe4XB\xff\xe1\xb4\xdd\xd1\x83\xa9o\x06q\x16\x8f\x1c\x98\xbd\xcf\x1b,#,\xf5\x1f\xee\x92\xfe\xf6\xeb\xa5P&B\xa2sm\x08\x06\x8e\x9f\xe7\xbc\x13\xe9N\xb23\xbbT\xaf\x16E\xeb\x1c\xb9\xf1}8z0\xc6\xa5\x1fQ\x0eo\x11U\x954.u>\x01\xc1\xee\xee\x96\x89w\x11K\x961\xc5\xf7\xc5\xdbe\xc1;A\xb5\xe8p\xfd\xb5\x09GG\xde$\x9c7\x08\xd7\xbf\xa5Ex\xb1C\x98\xd9`\xb2&a*\xa1p)\x03\xc3\xc3\x03\x91\x9d3\xd2\xedF\xb8l\xce\xacD/\xe9\xd1C[\x84\xca #/\x87\x11j\xb8\x84\xcb\xec\xe8\xd9\xc8\x10\x1a;\xe22\xda1\x96\x99\x03\x899R^\x8e\x84\xc96A\xb1\x98D\x04}\x8e\xc1\x0bx\xe0%\x14\xab\xbche\xa0\xd1\xeeK\xfd2\xcbY2\x0f\xee\x05\xea0\x9e\x029\xe5\x15-\xbf\x95$a\x0b\xcf@$!\xe0\x936\xec\x88L;\xdd\xf9\x12!\xec\x90\xef0\xae\xc6\x8b\xedF\x92O;\xaf;\xa7\xf4\x95\x09\x1a\xfc\x18>\x87?\x85\xd6\x97e\xcd\x9f\x86\x8b\xc3\xd6\x91\xf7\xa9<\x88\x19 \xbe\xb8(r\x10)\xedv\xcbA~\xdb\x19\x906T\xfd\x9apR\xb6Xc\x16J\xf5\x14\xe4C^\xb5\xc8\x1ai\x0a\x88\xd2\xbei\x95\xb6v\x93\xef\xaf\x89\xedf\xac U\xfbn0\xd97\xf7\xd7+s\x81e~\xacx\x098\xf5\\\xcb\x8d\xc7R\xaam\xb1P^\xdd\xd1\xd2\xb0\x025\xf8|!\xce\xc5\xacJdu`\xd8 \x93\xbd\xa3\x84i\xef\x8a\x03\xa1\x0cO\x89\x861tB\xb1\x1022F?\x85i@bK}\xe3\x13\xd5sF\xc5gTb;\xba\x0bV\x05k\xb2K\xa5g\x8c\x9e\x18\x86c;\xdeNB\x093\xeb\xa11R\x09K\x05\xf9\xc8R\xa2\x8a\x07\xd2\x80 \x9a\xafK\x97,\xfdPC\x03\x18r.]\x96\x84nN;\xe4\xb2v\x00\xfbe\xd0\x96t\xc4\xc0\xea4i\xe2<\x11\xf6a\xc4y\xe3#\x81QF8#\x89\x83\x84\xe7X;\x00I\x8b\x89\x9e\xa88|\x0fn\xf2\x14\xeeqR\x92\xc2\x18\x17<Ws\x9f\xc5a\x96\xd8:\x12\xea\xec\xce\x89H\xde\x8d\xae\xdf\x1a&\x17\xb93d\xd3= \xbe\xc8\xe7\x08/\x09\xec\xc4\xb0\x9b\xc3\x978$\xe2\x11\x90\xab7\xc6\xc2\xe2\x06\x12\xe8\xdb\xef\xf6\xcc\x09\x0f\xd5\x12\xee\x96\xc9\x8f\xe9\xcc\x16f\x83\xf4\xaf\xd8\x9c\xd6\x08\x01l<\x8eM\x8b\x89Xl\xb9\x90q\xb7\x982nF\x01\xd4A\xfc$\xff\x9e\xce\xd4\x1d\x90\xff\x9f\xc8\xe0\x06\x90\xc7\x8cPlCa\x0a\xab\x0a\x83+&\x0f\x87\xc6\x1f\x8b\xcfc\x07\x8eZ\x9a?\x8f5\xfc\xcb\xb2\xc8\xd6)A\x1e\x88V\x16\x9e\xa7\x03\xd6\xac\x0d\x09!\x92\x1c\xeeh\x05X1\x91\x82\xf7\xb5\x96n\xc1\xf3\x9f\xe0\xb6\xb4`\x9f\xd3U`n\x0b\xcf\x93'h\xfc\x0eL~\xb6\x96\"\xde\xc0\xde\xa0\xaa\xca\x11\x19\x8a7\\\xa1\xd0/Uy\x15c'\x0d\xbd\x91w\xe9\x80\xbff'p\xc3\x1dN\x01\xee1\x97\x95)\xf1[y+\xb2\xb9\x03H\x9e\x9e\x96K\x0b-\xe4\xf0\x02\xed`\xa5\x9c\xd9r\xa8\xb7\xd8K,\xc9\xd5\xac\x8fW\x9cc\x82[\xc4Z\x08\xfe\x91\xa8\x0f1\xa5\x00\x13\x08i\xf6QZ\xd4[hN\xdb8u5)\"\xea\x7f\xad\xae0\x9e\xf15\xf2*\xdd\xc2m\xb96z\x81\x1d\x0e\xdc\xb5a:\x8a\x16R\x92\x9a\x09f\x82K!D\xbb\x9d\xf1u\xc66\xd1<\x8dOw\x8e\x8eW\xaf\xb2<\xae\xac\xf6H/\x9dw\x9akq\x9d\x02\x84\x85A;\xca3cR=\xe3+\xa2\xb3\xf7Ni\x00g2wW\xec\x82\xe7\x89l\x85!\xfe\x10\xd9\xe0\xf5wM\xc5\xa5\xa6(&F\x0e\xaa\xd1\x9d\xe0DG\x96\x91\x97\xc2\xb5M\xd5\x8a\x9d\xa6\x143\xf4\xd1U\xb0H#\xde\x1e\xc5\xe1Kz\xd88\x96)U\xc4\xec\xf7\xbe\x81^\x13\x09\x1a\x15\x8e\xbe\xbbA\xcdIQS-\xd3\x1aV\xb6\x8d,\xc2\x02\x13\x04\xab\x92>\xaf^\xdb2R\xfa\xad\xa5#y\x8f\x94\xe3H\x9b\xf8\x17y\xba\xa0wD\xe23#\xba<\x19\xb7\x9f`m\xec\xf0\xc7l\xa2\xc1#\x8b\xe4\xa3.)s>/5\x93F\xfc\x1f\xf7\xda\x99\xd4\xd9\x96qz\xb3\x0a\x90N\x94\x87$\xff\x17\x96\xe9`!\xa8\x82U\xe1\xf63K\xa6\xcb\xb4}G\xfeP\xecm_\xd0\xbc\xcc\xdfR\x1c\x00\xe5p\xc2w\x1c#U\xc2\x12wF[\xf5\xcfp\x8b\x9c\x18\xeb\xcf\xfa6\xe6%\x14qu\xc7\x1e\xb3\x11\xa6G\xd2\x05n\xa9\x17\xac \xb9\xacx\xf0-uE\xad\x81?\xb9k\xe3D\xbelt\x1e#\xd1\xe1\xfa\x13Dz\x9d\xbf\x10\x943\x9eQ\x07\x0ap\x8e\x9c\x0cpX^\xd5V\x00\x95\xc2k\xc1\xf5Lg\x98\xd2@K\x82\x80\xf9\xe2`\xab\xec\xb9\xa7\xe2I_\xb9\xce>\xba\xfe\xa0\xeffb\x85\x89\x98\xf6\x9a\xd4\x94\x19\x0f\xa2\x1dp\x0dL\x8bdi\xa2R\xb1\xde9\xa0\x8e\xcf\x9f\xa1\x017\x03\x17p\xfa8\x0c[\xe3\x90:A\xd4!}c\x8c\xdc\xe4'\x7f\xa3\xc3\x19n\xaaw\xb1\xfa\xa5\xea\xd2\xa0\xa3\x9f\xd7Me\x81\xc8\x9a\x8b]\xa94\x14s\xe8\xa8\x8b@\x83E/\xe07\xb5\xea\xbdVO\xc6\x90\x01\xb9\xa8\x18e\x1aI\x9e\x13-\x03\xab`\xc1P\xd9$\xa7\xc9\x90\x18s\xb8\xd2,\xc5\x8c\xcf,\x07\xba\xc02iT\xe2\x97\xb8oKi\xdaY\xe5\x8c\\\xe0\x88\xdc\xd1\x8c\xf6:Q\xde\xfa\xdf\xff\xf6\xff\xfa\xdb\x7f\xdb\xdf\x88v\xe2N\xb4\x9f\xfc\x00S2\x98\x07\x0d\xdb\xc2J;&r\\g\xdb{,\xa3|\x0c\xde4\x99Ny\xeb1\xf6\xaagJ\x172Q\xdb\x9db+@\x1c\xa62\xc6\xf4L\x1b\xd23dn\xb4\x18qL\xba\xa5NEF\xbe:?\xcd%\xa9\x98:\xe2cp_7{\xc4\xbew\xdai#\xb0r\xb90\x8a\x94\xe2\xf1\xa2\x85\xcb$\x0d\xce5\x94\x96\xc8\x0d\xb9\x99\x13\xcf\x843\xbe\x16\x07r\x15\xa5\x19\xa1\xa9Zh\xe4[\xf2\x8e\xce\x034\xeeBU\xb2\x84*\xe8\xb8O\xf7\x89\xa1\xb7Pf\xb0\x19i[\xa7\xccS\xd6\xec%\x9c T\x1cAL4\xf4\x06'-\x85\xb0\x89\xfb~\xb7[\xa5\"\xd0?\x12\x8bi\xe8\xabUp\x9aAW\xc0#\xb4\xaa\xc8\x91P\x80\xeb[\xaf\xbe/}\x98\xa0\xc3]\xe9\xb67\xf75\xe4\x7f\x80\x84\x05\xdf0b\xe8\"\xd7\xd4\xe5\\+>\x15\xc21\xd7\xb9\xbav\x87\x04\x8e\xc1\xf0\x1a}\xfc\x08A\x8e\xfa\x09\x16\xd3\x12s\xa7\xebj\xa0xC\x0ca\xdf\xec`I;\x01\xe5\xf9\xf5\xcb\xe7-\x80\xf8\xb4Q\xdb\x98:\xda\x90\x1a\x86\xa6\x8b\x8d\x8d\x16\xe21GJG\xa7\x0d*\xb6\x9bR~\xca{\xeb\x92^\xfb|\xa6\x16\xfa%\xbe\xe4\xfd\x12\x9d2U\xdc\x97\xe2\xfdM\x1d~\x87\x94\xe1\xea\x986\xc8\xf0\xe4\x85\x05\x7f\x8etz\xd8,\x17\x16\xfb\x0e\xb4\xbd\x15\xcc\x9c\xc8:\xa7}\x9a\xb3\xb8O\xa7,\xad\x94\x0b\x87g$\xe7\xba;\x0a\xdf\xac\xd3\x06v\xa0\x92\xd6\x87\x01F((\xa5n\xea\xbf\xf6[(P\xe5J(\x85\xcc\xab\xce\xd6\x12s\xc16\xae\xfb\x8e.2\xfa\x9a\xd1\xb5(\x97i\xcb\x84" +
+	"\xd0p\xf4}\x0f\x94J\x8d\x9e\x8f\xbd\xa5\xadY{5c\xb90\xbc,JL\xa2R\x1bi~\x84\xf0\xef\x93\xb4\x17\"\xd7\xed\x19_\xa3/m{\x97\xd2\xfaPOj\xfe\x05L0\x06{\xb5\x8d\x80V\xee\xben\xd1:f*y\"*\x10\xec\x90n\xc4\xd25pAC\x8c\x89%Fd\xaa\xc7A\x83fOzj\xdd\xae\xdd\xb0\x85\xd3~\xb3s\x13j\xf1\xf2\xea\xaf~\xa4\x08=l\x933\xff\xc4s\x9bF\xef\x18\xe9a\xe2\x1e\xae\x1d\x8c\xf2\x06\xaaO\xaeQ\xcb\xd3\x08\xe0\x81\xb3\x07Q\x1d\x99hT;\x09\xe75z\xd8\x82\xe0\x13\x93\x80(\xae\xd36\x90\xb9\xa9\x1e<\xb4.\xfd|\x18\x0a?\xb8sx)\x0e\xd4\xb7w\xb4\x07l\xe9$Y\x8b\xb7!\x16\xfe(\"\xbe\x98?\xa9\x1ea \x87\x05\xf3\xd8\x03f\xc2\x9d\xde\xe1\xbd\xda\xc8\xcbws\xc7#\x8e2t7\xaa\x09/Iv\xcaF\x9dKw\xd2e|w\xf1\xb5}c&Q\x91j>\xbc\xd8\xc6\xf8\xce\xefPn\x85+\xcf\x9a\xa3\xd7J\xe7Y\xffE\x1d\xb7t\xde\x1cd\x8au\x19\x92\xa3\"2\xaa\x09\x00y\xa6\x90O\xb4\x19\xa23\xc7\xb5\xefX,\xdd\x07(^\xacF\xc43@\xd5\xdc\xe2\x11\xda\xd4\xf0W\xe8\x11\xb5\xf5\xde\x8cr\xe6\x03\x90k\x9d\x9d\xfe4\xc2E\xc2P\xb4\x13\xba\x9a\x8e\xb7\xa3\x9eK\x02\xc3\xc7^8\xf8\x95a\x9e4\xe7g\xfa!\x96;e\xd1X\x80\"D\x8e\x9b\xd8\x10\x91\xf4\\\x83H\x09\xe5\xaa^\xaa\xde\xdc\x18\x9d\xb8\xfe\xd9u\xb7\xfb\xc7d\x0aw\xcc\xca\xa7\xd1\xf3X\xa2\x0a*i\x08J\xc9\x82j\xed\x09\x1f\x00=\xdeX\xd2\x0b\x0dA\x83\x1b\xdd\x0e\x9c\xe1\x96\xd7\xc2\x91\xc5BE\x85\x9d\x12\xad\xe3\x9e;\xbf\xe2U\x99\xeb\x84\xfb\\\xbc\x98\xe2B\x0er\xbd\xee\x0e\x9bUD\x19.i[\x9e\x84\xbf\xc1\x92)\x86A\x0c\xa9.\xcb\xff\x99|\xd8\x84\xef)\xb9\x83\xc9\x1b\xfd\xa8O\xb2\x13D\xb8\xe7,\x92\x0c\xfe\xe1c\x09\x9d\xf0'A\x0fu\xd1:R\xcbt\xe1d\xd2T\xc1\x08-\xbf\xab\x0d\x09\xb0\xa1\xa8\xdd\x04\x9dJ\x88\x87\xb5|\xc1\xd6\xd4\x1d@\xc1\xf9^\xd3\xdbzt\x11{doMM\xbc\xb4\xc0C\x0f\xe3\x0e\x9c\xde\x0a \"\xea\x84\x0e\xbf\xb8!\xfab\xa9.\xdc2\x1a\xbd\xd1\xb4\x9c\x95C\xe2\xd8\xdd\x92\xe2\xfc\xab\x0c\xe7\x0e\x94\x9f\xb0\xc1\x92\xc4`\x93  (KB\xae\\8@\x8c\x90\xa45t\x05\xbdC\xa1\xc8\x83\xaf\x8b\x03>\x1d\xf4\xc7\xf3\x17\x01+L\x08\xddQ\x1bd\xfa\xa6$\xf2\x1aPHl\x07>\xf0G.\xfd\xe0\xfb\xa9\xff\xd3\x8c<gZ8\x13kS\x99\xa7m\x00\x9e8\x1f\xee\xd2\xba\xf1E\xf1\x19\x0aj\xbc)\\\xe3)\xbb\xce\xd7*\x0e\xd1\xc5\xa0\xc5\x15n\xe6.\xf3T\x06U\x8b\x0b\xc8\x06\xec\xf8\xc5\xd8\x99Ax~\x8d\xc69S\x80\xf7\x81\xdfuL\x9eM\xbc\xdc\xf4^6\xc2\xe4os.\xac\xe2s\xf8z\xedq_\xec\x02\xa0E\x8b\xdf\x90U\xf4\xf0\xbb\xb9\xc45\xdb\x9e\xfd\xf3\xbf\xe6\xd2\xdex\xaeW\x1b#M\\3\x9a\x95F\x00\xfc2\xaeS\x92\x11\xf7\xbd\xd7o\x93\xc2Xz\x01n^ \xc0\x0fW\xf5\xc3\xc1\x1b{\xf4Mm|\x0b)\xfa&\x9f0g'\xcc\"\x0e\x0e\x0d\xb4\x10\xac\xd4\x95x\xb1x\xcf\xa4eO\x01M\xd8\x1b1\x18Yw\xc1d\xfd\xd4\xdc\x91\xe3\xf9\xf6\xed\xc6X\x1f\xbe\xccodq\x88W\xf2=ib\xe5\xb4\x0d\x94I\x1e\x86\x85\xc7M\xb96\xe6\xf7\x88\x0d\xe1\xb4\x0dG\xc1]q\x0f\x01g\xfao\xb2\xf5\xafz&T|\xc5\xc1\xaeF\x98N\xda\x81\x1c\x8f\x04\xf1\xd2c\xdf\xae\xa5z>\x98$)4\xa3C\xbd\x0b\xc3\xb2rb:\x9c\xfc\xaa\x0ae\xcbz\xf3N\xdb\x08\x16\xb1\x86\x06\x84,\xcf\xf7\xcc\xb8\x8f:\x8cLd$\xa0\xef\xd8\xaa\xa1\xbe\xdcX2\x91f\x8e\x9b\xf7Y\xdc{\xf0c8\x86\x7f\x85\xc7\xb02\xc3yE\x85\xd3\x18\x84\x1ew\xa0J\x92#9\xc3W\xafulY\xdaW\xc9D\xc9\xe8,\x96\xb6\xd1N\x13\\\x9c\x8a\x0c\xb3w\xe12\xd8AS8\xf3\xca\xb4KZ\xb5\xbf\xd8\x0d\xe7\x9a{\xb2\xcaW\xf8D\x0f\xb3^\xc7H1\xae\x12\x00\xdb`\x8f\xe4V\x0c\xac\xcf?\xfa\x9a\xd4\x8c\x02\x0d\x8ed\x04\xf0\x17\xc5AnM\x12\xecG\x8e\x90\x94\x869\x89\xbf\xc8Bo\xb1\x8d.\xbb\xd7\xab\x94\xd6s\x9e9<\x02\x8a\xc3\x84}\xf6\xf8z\x14\x11;\x02%\x1e\x093\x03n8\xcb?\x8a\x83N7\xb4n8\xee\x00\x0d\x1c>\x09\x8a#\x9d\x90c\x14\"\x1d\xd9K\xaa\x16\xba\xbb\x1e\x19\x00\x8e\x0e~\xb8\xc9\xfb\xe7\xe0\xab\xb9\xdbL\xc5\xff&\x82\x9a1\xc3YV})\xe7l2,\x06\x02\x14\xb1C\xaag\x0d#;X\xbe\x84\x01\x8a\xd2\x97\x1e3\x94\xb8O\xce\xf8\xca\x0d7\x13\x04+q\x83\xfe\x8c\xaf;\x0a\xf6?\xa6\\\xb1\xf8]-\xaf\x1c32\xd6\xae-z*\xa5k\"b\x9f#\xb5\xbb\x8b\xfb]\xac\x1d\\\"\x1f\xddR7\xc6rW8\x1b%\xcb:y\x07\x8c{\xda.\xa7\x97\xd0/}\x91\x10{\xb1\xa1\x8c\x8c\x01\x96\xe9\xeb\xc5v\xe1\x9c\x00\x03\xb3G\xd5,,\xe5\x19'\xe8|\xd8\x17^\x00\xe1;\x8a\xde_(`\xca5\xd9\xc8La,\x91qU\x89\x98\x99\xd52\xd3\x16\xc4\"\xfa\xa8x\x03\xf7\xb8\xa9p\xb5X\x0a\x8dM\xba\xb7\xd7\xdf\xde/\xa6\xfd!o\xa9\x8d_qZ\xe0\xb1\x8a\x9c>\x17\x18\x0a\xa1\xa9\x8fTN\x15\x97J+\xc4\xde\xc6(\xf0X\xa4lxlb\xac\x87p\xb4\x19gh\xddV\x8b\xe6\xebWs'?\x19\xc0\x8fF,\xe3\xde}7^\xc1K~t\x11\x06\xdeB\xff\xe8\x05K\xcdO\xf6\xc0|\xe9\x84\xd5\x0a\x97\x0fx\x8f\x96\xf2\x13\xe1\x9c\x16\xee5\xf9\x8a\x98B\x94\xb5X\xb8\xdem\xc4/\xaf_g\x93\x96\xb7\xd4K6'R\\\xdf\x9f\x10n\x01gTx2\xe3\xf7\x0a\x92\xc3\x0dke\xdb\xf2\xc0\xaf\xdfD\x97r\xb1\xad\"\x96\x92W\xbe\x80\x8e\xc3\x01\xea\x0e&\xf2?\xcf\xfd\xf3=g\x04\xdd\x8c\xad\xdbz\x9b/\x11\xaeH/\xaf\x14\x129\x92D\xa2p\x8e\x1f\x98\xce.x\xf9\xa7\xa2\x06Gay\xb8q\xa5\x1c[\xbd\x81\xef\xf6Ld\xb2\xcdJ\x87Cb\xc8/\x93Z\xdb\xba]}\x97{\xb7\x7f\xf4S\xad\xfd'KD\x97Y\xde-!l4\xdc\x99{\xfd\xeb73\xff\xda\x8d\xd3\xe0X8\xc2\xa6\xb6$BY2\xff\x0e\xd2\x1e\x92\xa5\x1c\xe5\x84 \xc0\x16\x0b&\xcb\xea\x97\x0cN\x09\x13\x17v\xe5\xc6:y\xf32I\xc1(7C\x9cgC\xca\x96LM\x18\xe3\xd3l\x90\x0b\xcb&\xba\xfa\xa5z\x9e8\xd8\xe2%\xf4Iin.u\\\xba\xa6\xea\xa1\xddz\xcco#\x92->\xd9\x9c\xaeC5|\xd0\xe4\xbb\xde\xa5O\x1e\xcd\x8a\x05\x12$,w\x02\xee\xf1#\xfa\x84\xebw\xf4*\x83\xf7\xcd\xbfJ\xbe\xa3\xa1\xdb\xe2d\xfb\x12\xc3\xe2\xe4\xdb3\xbe&\xa3\x84\x9fZ\xbf\x81\xd7\xc9\xcc\xdf\xaa\xe1\xea\xd44\x0a\x88I\xba\xf1\xd2\x87&\xf7\xeb\xaa,\xa6a\xbeg\x9d2U\x0b\x8d\x80\x8b,\xc2Te\x86b\x88)PS\x12v\xab\x92\x8fb\xcd8\"\xcf1x\x84>:\x1d\xca\xd1=\xa7\"\xde\xf4\x10\xde$yr\x03+\xce\xb8\xcc\xb0&\x1f\xd6\xfbQIK\xb0&Ca \xedd\x0b\x87*\xdd\xe4\xbb\x1b\x8cE\x0d\x17\x8a\xa5)Y1\xfa\xcb\x1d\x1c\xc9\x9bT\x84E\xd9\xa9nUOnx\xf3410\xb8\xa9\xb8\xa1\x8an\x8a\xa7G\x8e@f\x00%\x08\xc3\x84Co\x9c\xbd{\xf9\xcc7j\xb4\xd9\x82\xaeo\xcd\x14\x09\x1a\xae\xdc\xf8\xf7\x8b\xc7lTao\xbdP\x866~\xbd$\xfd\xe6]\xe2&s|g\xfc\xd8\xc8F\xbe\xf0M\x1e&\xeeg\x1bj\xd7o\x9d\xdeo\xbf\xbe\xef#b\xeb\x83\xc1\xe4GK\xdebC\x9fR+w\xa20\x83H;\xcc\xb6m\x1c\x90o_\xde\x1b\xeb\xcf=n\xc6\x9bG\xf1\x17\xfa\xd1\x84\xe1\x0b\x05G\xc3\x16\xc0\xb1\xa6\xc5Y\xb1:\x9a \xe8\x0f\xdc\xe4\xfd\xcc\xa2f\xd0n\x9d\x09o-\xc65\xc9\xe6hL\x1b 6\x85\xef\xfc\x90\xf4\xec\x9cV\xd0\xf3\xc3Sw\x09f\x09\xba\x8b\xa3\xcd*t\x0e\x1d\xe0\xe8\x86e\xc1\xe4^\x9b7\x97\xca\xdeE\xf4ld\x13\xc4\xcc\x9dG\xc3\x12|z\xc30\xeb\xfb\xc9\x0fZ\xf6\xd16f`\xce\xd9\xcb\x9dN\xb6\xb7\xaf\x92\xefE3\xa1\xc2w\xd2'\x89A\xb1n\xd3pEh\x09\xe4\x82\xf4/\xb1X\x8a\xa5`\xed\x90\x10WU\xa14\x94Pg\x00\xcap\xa7\xb4\xf5v9K\xb2\xc2\xa0\x9df\x96\xf6\xd3\x9f/\x81K\x0c\xc9\x85\xb9\xc2\xfa\x8d\xae\x97$u\xbc\xb2\xdbk\xab\x09\xcfw\x9b0$:=f%A\xc8\xaaWT\xf5z\xb2\xe6\xed\xdb\xff\xbf\x8c\x12\x03\xbb\xd6ok]F*\xa2\xc9\xc9\xf4\x7ft\x0d\xd4\xb3\xbb\x8b.2vF\xadZ\xd4\xb7jQ;]\xbb\x09\xbaV\x8a\xd1\xf3\xb9\xc1\x86\xc1\x9d3\xe4;\xb9\x9d\x0d\xe4\xcdw\xeb\x80Ey\xe2sz\xea}l\x8b\x1f\xdc\xe3\x10\xfdh\xf1\xafl\x97\xd5\xea\xf3\x7f\xf7r\x06r\x8a\x17R\xfcj\x96%Y\x9c\xf0#+ 0\xdbV\x8aW\x0cA\x1d\xb4h\xe1bVj\xfd\xa6\xbe\xb17e\x0a\x04\xb5\xb4K\x9f\xf1\x15/\x96!\x8d\xd0Rpo\xb4+\x1dtN\x9d\x80\xbb\x84,\xdf\xfd\xc3\xc63:37\xb8\xba\xf5\x87{\x95\xac\xb8i\xc6\x1d\xa6\x19\xc8\xfb\xcc\x90\xebO\xf2z\x06)R\xef\xed.=\x88~\x1f70\x8b\x0b\x1a\x16:+ ,\x01\x88\x17\xf6\xc6\x08\xe1t\x15\x16\x147y\xad\xd4\xa8\xfb\xc6y\x87\x0e\xe0\x17va\xb5_\xd4fU\xb65\xb1\xbf{\xb3\x0bl\x92d(\x85\xa3\xfc7\x00G\xbe{\xbb\x0782k\xba\xa3\xe2\x00<Go<h=\x87\xf7!g\xd7\x19_\x99\x99\xc9\xf6b\xc0\xd5\xdf\xb3\x12\xdf\xc5\x9a\xc4\xbd\x065e\x85V\xc8g\x8417\x15qf\xa8\xec\xc4\xaa\x1a%\xbd_\xa1\xd1Z\xb9\xc8\x0ba\x0b\x88_\xce\x8f,8\xa4\xd8iQ:&!X\xeae\xbcT\x03\xf6\x11`\xc7\xa4\xcdL\xcd\xa1\xbc\xbb\xce\x94\xef\xb2\xae\xb1\xbb:\xf9\xd0\x18\xa2\xcc.\xef?R\x11\xe1\xaa\x85\xb1\xd9l\xa3|\xf7u\\\xa2\xb1\xa5\xf3\x90X\xdb\xfc;\x8ek\xfa\x92\xac\xdd\xba\xc6\x02\x1a\xae\x04Ww\x0e/\xc5\x81:\x81\xe8(^\xb5H\xef^n\xaa)\xcd\xb0\x95\x89\xca%zs\xfc\x9d\xeb\x83\xf0Mb`\x83_\x117\xe9\x02K5`q\xcd\xa6\x99\xd6\x1f\xe0[\x0b\x83\xc6\xed\xb3h:\x9b\x10$\x93\x09\xba\x0b\xfd~\x97:\xb4\xa3\xe4S1\xd7\xd2V5B?\xd1<\xcd\xd9\xa0r\x81,\x1b{\x1f\xc4,\x1ce\x81\xd3\xa1\xa8\xd0\x8c\xf9l\xfa\xf1y\xb8c\xfc\xde\xbd^\xed\xb00\x1d\x12\x91\xce<|}\xb8\x8dG\xa8q\x91\xad\xf9\xaf\x09\xe6\xff\x10>~g\xf9\xbc\xc9\xdbNW\xda\x9f\xafW\xb8\x15\x9c3\x98\xa2\x187\xf5\x9c:\x1cf\xd7_i\xb5r\xbc\xac\x13\xd7J\x85k\xdeOu\xa6\x1fw\xeb\xe1\xef\xe6\xb5\xc1\x9c\x00>^X2\x90\xa5\xe4\x9aX\xc5\xae\xeann\xb2\x9a\xef\x86\xb1\x86\x8ef*\xe8\xac\x8f\xd4w\xc9\xd1\x8f\xf1\xfbxqu\x12\xb4v\xfeQ7\x06\x1b\xce2\xcd>\x19\xc1\xbc\xcc\xfe\x11P\xe9@\xf4\x19wf\xec]\x0a\xe0\xe9.Pk_dD\xea\xe4I7^\xd1\xcf\xd0 \xe3\x11<B{\xdc\xd1\x01\xf9\xc6v@\xfe\xfd\x7f\xf9\xdb\xff\xfe\xf7\xffi\xab\x03\x92\xd4a\xc8\x1e\xd0]H\xff\xda\x84EV\xee|]\xce\xa8\xb8~\x02j\xe5\x0cSu\xad\x06\xe6\xd1\xc6\xeb\x1c1\xf1\x8c\x87\xfeO\xfe\xc1\x99\x84\x09\xfe\xeeg\xd7_\xaf9\x8b\x06\xcf\xaeeg\xddn\x8cd\x025\xb1\xda)hO*b\xaan\x83Y5\xf9\xb5\x98\x0c\xdb?\xfe\xdb\xf518\x07'\xa7\xdb\x9f\xf9d\xc9\xcd\x18\x11\x9c\x9cl\xa7l\x84\x85\xe0\x82`o\xba\x86\xc6J\xe1~Tb\xa6Z\xb9\xf8\xe4`'\xd4\x01\xdd\xf96\x8d\xff\xb7\xd9\x90\xed\xa0\x16\x7f\x91\xcc\x96\xcc!\x9b\xb7\xdf5o\xaf\xecM\xac\x83\x80\xb9\xdd\xa2\x0fq\x8e\xbb\xc09\xbc\x14N\xa5\xfe\xa9\x09;\xcc\x9d\xd1b\xd9\xa7>\xb7\xfc\xbd\x17\xd7\xd3&\x9c\xaa\xa1\x87\x86\xb1#a\x9c\xdfk\x03\x05\x9a\xf4\x8bo\xfd\xd1 \xd2\x81\xe7Z\x0e\x1at;\xce\xf8*L\xf4\x02\x9c7Z\xde\xbc\x87\xeeg\xe7g\xbd\xf7rdTu\xf43\xe9V\x1c\xfao\xdau\xc9\xc9\xa5\xc6d\xf0i_\x8b\x98F\xd1\xd0\xe7E\xcb\xd9\xbc\xbb\xadn\xe8j\xd1\x07$\xc3}Cq\xa4\xf0q\xb8\x97M\xb0\xb7\x8c\xb3\xd5\xb9\xc6\xb7\xc1\xa5\x85\xfe\x8c\xaf\xe8\x17\x17\xb6\x91\xd6\x14i\x99$\x1e1y\xb7TWk9.j*d\x94\xc7N\x13\xfe\x97\xc2g:xFo\xe0X\x10\xfb\xf4R\xd42\xe7gBX\xc5\xe8\xea\xdf\x84\xaa\xf8\xce\x9a\xf9\xc3\xe2\x0a;\x16\x8d/\x91I\xe8\x0a-\x0f{[\x95\xe0NdBc$q\x0d'\xb8\x13\x18\xca!\xfa\x82X\xe0\x0e9/\x87-\xe3\xa9\x9c\xb0|OF'\x18\xe5*\xe9\xc8\xbd\x87QI\xdcv\xd5\xf1\xa6o8`\xc7\x1ce)\x8e\x0b\xf0\xb9T\x03\x1f\xff~\xc6\xd7\xa8T\xa6\xa2\x9c\xd2\xe6\xd7\x82\x9f\x8b\x16h\x7f\xdd'^?\xc1W\x91. \xe1\xc1`[mn\x83t\xde\xf7\xb4\xa7b\x94z\xa20u\xe6\xf3/\xb2u\x1acp\xca\xb6\xb1\x98#U\x11\x99m\xf4\x03*\xa3R\xbb\xdf\xb1\xc8:tN\x86\xd4\xe9\xc0;\x8d\xfe\x86s-kBv\x1e\xa7\x88{h\xba]M\xb0|2J#\x98\xc86\xf9e\x8e\xd1\x845\xef}\xf1Wp\x93\xefOE\xf34tp*pO\x0e\x96\xb7\xb2\x83\x07\xe8,\x1b]\xb6\xc5v\xa46\xc3;\xee\xe63cv\x11M\x89\x1b\xee\x19\xcc\x97\xdf\xe4v\xdeR{f\x9c\x9e\xa7%\x06Qnd\xa3%\x9a\xf7DR;\xf6\xa4\x17<\xf0\xbb\x8fK\xee\xc4?0\x16\xe7\xfd#\x025tn\xa3\xb9\xf2[k\x9b>9Z\x91\xfa\x10\x04\xc8H\\\x1e\xb5$q\xd9kg\x1fV\xa3\xb5\xb3\xa2\x05\xd7\x9f\xc3\x0b\x0e\xef\x03b\xe7^\x14\xffX~{\xcfs\xaf\xd3\x83\xdc\xef-\x96\xbdoHq\xecX0\xdd\xad\xce\xab\xb5\xcc\xaa\x84\x01\x19\xe2\xaf(\xe1\xea\"*&|\xe0\xe0\x1aU<\x9c\xabT\xb3a\xf1\x13\x0f\x85\x1atc\xb5o\xd0\x80{o/\xa0\xa2\xf6\xc4\xdf+$\xbdz2\x1ar\x16\x15g`:\xa2\xf08a\xc6\xb8\x05\x82\xf5\xe0\xce\x7fZ\xfa\xd86s\xe4\xcb\xd7\x0b\x9b\x0cz\x0a\xc8\xf2fT^5\xfc\x0d\xf8\xefH\xcd|}B5\x1f\xe97a\xfbz(\xe3e\xe3\xd9\x99\xb0\x08s\xb0f\x8e\x18{\x80\xd2L\x89V\xc9\x1e\xc1(9\xb3\xe3\xd7%\xfb\xf0\xc6\xe9=\xfa\xca#\x19\x07\xd9\x04s\x1b\x91qe'w\xf3b\xbb\x05ZZ\xa7\x10\xdc\xf6\xb1\xb5\xcd\x8c\xf1\x8c\xc7^$\x03\xba\xb1X\x14Iq\xeb\x9b\x0fp\x833\xdc\xe0\xf8\x81\xd5\xd2\xbaE\x12\x8d\xfd{\xea\xbbY\xbf~\xc6K|^\xa4'6<v>\xcd\xc63 \xa9x\xf4]\x0b\xf5\xdc\x88H\x05x\xc9U\xfb\xcdt\xb4i\xcb\xb5\xfaOK\x0c\xa7\xbe\x18\xa0\x86\xd1\xc1\x12\xc1\xa9W\x82\xd3\x9d\xbd\xd393\xb0\x0d\x9d\xc6j\xf0\x89p\xeb)\x9bN\x02\x15H\xef6\xdc\x00\xe9g\x18:\xbd\xc49|`\xca;\xec\xb7\xf1\xc2\xd5\xb5\xa3=\xfa\xf0\xfd\xfcj\x01W\xd9\x97$0\xc67f\xfa\xb9\xf3\xaf\xcf}\xce\xe2\xe2Z\\\xcf7!\\>\xeb\xbf(\x05Lrl\xda\x15\xb4\xa39Zi\x17\xfd\x1d\xae)^\xef+\xa8\xad\x94\xf3\"\x8a\xd2[~\xca\x84\xfa\xba\xa9\xaa\x11\xe2\xb9$\xc3\x9f\x80\xf4p\xccW\xe1b\xac\x1c\xb5\xa31\x9a\x10\x9b\xa5\x1b\xe0\xea\xe9l\xba\xbau\xb5\xb0\xa5\xf5\xcb\xb7\xb4\xb6\x82\xf9\xcf\xba\x86\x97v\x9cN$I\xfb\xff\xf6\xc7IN_\xe9\x07-\x97{\x15R0h\xdaS%\xee9Gm\x99\xd2l\x0e\xa1\xfb\xf4\xd4_\xab\xfez.\xe9gF\x13iU}v\xc8\xb7\x1cX\x0bDjfa4$-\x19\x0b\xa6\x18\xe5\xaa\x88n\xd2\\\x11\x8e\x0f\\\xf0\xc6!2\xadyyr ^\xa5H\xf2\xba\xbc\xbe\xb8\xe9*\xa1\xbd\xd6\xb97\x8aw\x87\x04\x11V:\xf9\xe4\xf1E!\x12\xee(\xda7\x91Z\x0b\xb1\xfc\x8eY>\x07\x9f\xea\x1eV\xeb\x9b7\xfb" +
+	"\x1ad\xa4\x7f:\xe3\xb2\xec\xafPr\xd6\xa7Q\x80t\\\xd15\x04v\x8e\xf0w[\x8a\xb6\x00\xbf_\x9e\xfa\x12F\x17A\xff\x80\xa0\x0cz#\xda-\x14.\x91\xde\xc8\xe4\xb30\x81\xc4\xad\x0asC$\xa5\x9b\xfc\x8c\x90{pi\x1b\x14\x8f<M\x0a\x84\xbf\xefh2\xff\"\xbd\xfd\xe6\x11]o\xc0\x0f\xca2m\x97\xc6\xac\x8d\xed\x13&\x96p\x97$Z%\xa1k\xc3iM\x9e\xbbNI\x17\x19b8\x19y\x8d\x82@\xa7\x1a\xbaC\x0f\x17w\x0e/3\x01r\xa2a>\xca\xa6\xfb\xfe\x0e9\xaelX,\xb1\xb9\xb6\xf3\xcb\x88\xda*<\xb6\x18\xa40\xe4\xf8\xd0\x18[\xb8)LT#8\xa6\xa7E\xd6\xbb\xad\xcd\xfb\xddf\x99\xadt\xc2\x1d\xc1\xd9\xb4$i\xcc\xc00(\x81sz^e\x11xa,r\xcbfm'\xcf\xaa\xb5[\x1cFr\xae\x18`w|\x9f\xe2\xcf\x97\xa4\xddp[s\x15M\x8e}-'\xdd|\xaft\xbe?F\xd612\x83\x0a\x90\xfbX\xe6\x04l\x86l\xf2\xc7\x85#\x83\xa6\x8f\x1b\xe9\xe9\xbb\xb7\x84\xba\xd2\x96\x7f\xc4\x8c\x13h0\xf20\x89\xda\x8ft\xf5\x9d\xe4\x1b2\xb51>\xadS\xa3\xc4\xbbn\xa9\xd0\xf5L\xa7\x8fH\x02\xdeo\x92\xff:\x15X\xcbN\xa0\xd5\x8e\xbe\x7f\xbf\xe7T\xc2%\xb4\xefh:d\xca\x81|\x89c\xd1T\x17pq\xbc\x8b\x7fZ\xb8#\\\x86\xe1m\x85eU\x8cQ\xc6!2\x93\x1e\x0d\x9ec\x01Q\x85^\xf3U\\\x1eqW\xda\xea\xe2j\xfe\x82Dv\x09\\s\xc6_\xf3lD\xa8Y:Z;\x98\x10dGRc\xe4W\x1f\xcb>9r\xb4\x9bK\x16\xc5i\x97\x80\x9d\xac\"\xa39\x97\x87`y\xc1X\x0b\x9e\xa2\xb0U\x18\xca\xb6\x8c\xf15\xa3{]]-\xa7m\x8e\x86;\x16\xe9W\xf8E*\x09\x89\xfd\x01\xd7,Z)\xa9c\xf6W^\xcc\x87\xe5\xdeOjw\xf3\xfdW\xf8I\xdf\x9dh\x94\xcdV\x9f\x0d\xdeR\xe9\xf5\xb7\x1b\x87o\xbf\x9dSY*gdX\x8cg\xfa\x91\xf4\x8cp\x10TRbN*\x92\xe9\xa3'\xcfIb\xe1\xe6\xad\x9c\x85\x93\x1d\x99Nl\xb2\x83W9\xfb\x0fx/\xc7\xcco\xb1\x19w\x136\x13\xdb\xb8b\xc9\x8c\x10&\xfdN\xbfV\x8f\xde\xbb\x14\xe5\xdb\xec\x13)n\x0e\x0fLz\x04\xae\xd3\x1f\xe3A1\xa3@\xb5\xad\xad\xa5k\xdd\xcdO\x8e>\xbc\xd6EbA\x0a\xf7\x14\xb3^\xafH\xaefD\xb2\xcf\x83k\xb4\xfb\xd1\xa5\xfe^.\\\x9c+\xf5G\xc1\x9e4\x10\x96E\xf3\xc9\x01\xcb\x864\x10\xa5BPf\xe2\x8c\xafF\xa7\x9f.\x8b<L\x8d\x903\xd50PW\xbb!\x05\x0b\xa7-\x14\x87\xa0\xc6x\x0c931\xd0\xb3Ee\x96\x93y\xe2t\x051\xd8\xc2\xd2\x01\x08\xeb\x00\xba;\xb8\xcc\x09G\x9al\x84\xa4\xd7\xf0\x00\x1d\xf4*\xc1$v`\x03\xee\xfam\x8a}\xbe\xcf\xce\x89wPd\xd4\xd6\xbe\x0bG\x8c\xb2\x8e=W\x83\xb7\xaa\x01?)\xb8\x81\x0bF#<\xc21\x8a2\xa1W\xf0s\x0d\xd7\xdaO\x85\x9b$\x00\xa3\x01\x89\x95#\xfc\x16\x16\xdc^%\xedP!b\\\xb8\x11Y\x10>\x833\xaa\xd7[\x8cF&\x03z\x88\x82\xdeL\xde\xcb^\xc8\x85\xdb|\x0c\x1eD)1DF\x8e\xb1\xe7\xe1-\x11l\xd6\x90\xa0\xff\x8a6\x81B\x86\x0f\x063|\xdc!\x9d\xf4\x07M\xb3\x19Q\xae\xb0^o\x8d$@u\x83'\xf9\xcf\x05f\x14\xb1`Bp\xbc$\x06\x9d\x0c\xba\x04\x8f&gl|\xd6NZ\xea\xf9z\xca\xa3O(\xdb\x1d\x1aD\xb1\xefED&hM\xf7\xd0A*LI\x7f\x0f\x0f\xc1\x8cD\x09\xb4H\xbd5c\xa2q\xb4E5#\xa6\x98\x92\xe0\x1a&\xa7\xee4'^xw\x1f\x95\xa1\xf3@\xeb%\x11!\x90\xeba\x01\x86\xf4\x9d\xc0qm;W\x80\xa6\x8d\xcc\xb9={H\x18)0\xd50q\x1a\x01\x10Nj\x9bK&\xe1\xa9W\xb8d~R.\x016Z7\xba\x9a\x93\xca\xa793{\xa4\x19_f{O{\xb0\x169j\xc3\x9a\xdf!l\x94\xd6\x8d\xe8\x00\x89Py\x06\xa3\x1a\xc3\x8f\xc9$%\xd6\xe3\xc3\x03]F\xfce\xcd\xb7\xa7\xee\x91\xe2\x08d\x994!O\xc2\xa0\xafcb\x8f\xf8\x97\x85\x83\x1dl3\x9f\x96\xf6z\xc8\x85\xba\xc7\x01j\x09\xc4inF\xb2\x0e\xf1\x04&G@h{l\x0f\xb0*\x08\xda\xc4\xc3\x05\xae\xb1;Y/B\xc1\xc0\x82\xa3\x7f\x83\x1a\xd8\x1c.\xba\xfa\xd5\x08\xc3\xa9\xa0J\xfc\x8c\xd3\xff\xb4\xcdy\xb3\xea\xcd\xa2\xc4;\xab\xfd\xf4\x11\\E9\xdap2\x18\xfa\xdc\x09~\x85\xa6\x81v'iM\xca\xbbI\x07\x96e\x8f^nZo\xab\x16fb\xa4\x8c\x06K\x86\xf3}\x11!\x83$2\xb2`\x12\x13+G\xc3\x95\x94B\xf2\xcc\xdci\x9b\xf3\xa6\xa9\x04\xdbz\x8e\xff,\x0e\x9do\x1e\xdd_\x81ix\x1b7\xc1\xe5\x8c\xaf4\xdb)\xb9\xa7\x02\x08#\xbfwR\x1a\x13\x00G\xa1\xf4\xdajr27\xbft\xd9E\xad\xfb\x98\x8b@Ha\xfe\xb4\xcd\xa3\xd3\x99\xea\xd6\"\xa2:\x13\xb7A7\x96,\x13sc@'\xe4\x98\xc1\xae>@\x8f\x8cb\x13\x82$Z\xf4\xdez)5\x1a\xef@j\x8c\xea\xa5\xa71\xc3\\\x09jW\xce\xef\xb4\xcd\xcc\x83_\x14]\\\xfc\"\xdd\xb7\xd4\xc4\x92\x88\xa6\xc7\xf6b=\xb9\x17N\xdaL\x07\x1f\x1a\x02\xabS\x13\xc06m\xcf\xbd\x9b\xd2\xe2>\xf4\xd7\xd1\x0fg\xfaQ\x1c\x18\x8cE]:G\xf6\x92\xc6%H\x95%\x0f\xbd\x87\xa6\xda\xc1\xd6\xc3e\x83\x06\xea\xf4\xd9\xd3\xde\xbf\x8c\xd88\xd5\x1d\x97*\xa8\xa9\xf9_\xf0\xeby\xa1T\xc3%\x0c\xe0Y\xbb$\x1b\xe895\x19\xfe\xe3\x06\x97\xc5\xe3\x09G!\x9f\xe7)\x85Q\xd2O\xf1a\xa7\xb6\x8e\xb9\x1e\x1aHY\xb5\xf8my\xf2B\xc8\x94\x9c\x85n\"<\xaaH\xc8\x93\xbb\xaa\x85)j'\x86w\x1d\x1e\xfd\x15\x8e\x12P\xff'\x0c\xa8\xf3\x070$\x94\xb1\x04\x94f\xdbv\xe8\xf5\x08\xd5\x92\xe1\xcb\xe1\x03\xc0\x92J\x0a\x8dS\x12\x9e2\xa3K\x92\x7fXD\xf8o\x0a{\xbc\xde\"\x85\x9b1\xf0H\x88Yz\x06\xcfg\x12R\xdc\xe6\xb4Pn\x9c\xc3t\x05\x98KG\x9e\x14\xbe\xfd&q\xca7\xd9\xd2\x08g\x0d\xb9\xdfg\xf2\"\xd2\xe50_\x0b<\xe3\xe4\xacc\xcc\xa6\x1fn\xaa\x09@\xc0'!\xc8\xec\xc2\xef;\x88\xb1O\x82\x0f\xc8P\xc6I\x91\xfa\xc36\xed\x11\xef`\x1a\x00\xc6\x03\xe8=\x8bD\x87\x00\x9c\xa3\x8b\x8cwzP\x03\xcdq$z|\x1c\x8d\x99\xb4\x07r&\xd70\x84w\xcb\x1c\x18\xaf#>\xb9\xc5\xad\xdf\xaaa\xe2\xfe\xaa\x1d\x1cGZ\xba\x11\xbf3\xc1x^k\xd7j\x03g\xbc\x17f-\x19\x9c}\xd0\x88f\xb65\xbf\xe4Ml\xde\x14KMk\xcd\x14\x15\x0f\xda\xceq<m\x93\x18\x05U\x9e\x14\x94\xa2%m;!\xeb\x0di\xb9c\xb4\xa8_\xff\xa2\xf8\x12\xb6m\"\x12\x967\x90\xdc\xed\x1cy\x9dd(4)V:\x83E\xbb\x07\xa1\xc8\x83&\x03$\xe3\xf8\x0c\x88(\xc3\x0c\xb9\x95\xa8\xdfz~J\xf9D\x8b?\xa3\x88!\xd38Q;\xd5J\x7f\x14\xbd\xb3\x843\x15>wP'}\xb2u\xe6\x9e\xb9\x0d-2\x17zh\xce\xe1%,\xe9\xe0\x97B?\xeb\xbbP\x03/\xe9\x9b\x04\xad\xc0\xfd\xa0EdN\xcc\x03\xc5\xd17\x80\xa4aaE\xc7/T>\xb1;\xb3\xfa\xdd\x12\x04`\xac\x84\x9e\xda\xd00Jv\x87\xb8\xb4f\\f\xc7\"}\xc8\x13\xb1\xf8JC+\xc3\xdf;\xcc\x89ua\xdbH\xae\xa2t\xa0I\x13\x18\xe0\x0ey]dfUqp\x957v\x83S\xaea\xbau\x0a\xdbO6Y\x8ee+v+\xdc\xa4\x07\xd7\x18y\xc2\xa2\xa8;\x97\x94\xc2\xddI\xc4\x17uvLv\x1f\x81\xb7\xfd5VMa\x02\xdf\x18%\xc4+\xa3\x8b\xc9\xe2\xb1\xb7B\xec\x0d\x07D\xe9\x9c\xf15\xc0\xbf\xbe\xd0\xd6\xc0\x15\x01d\xb2\xeax\xd3\xf9\xac\xcbz\xb8O\xd7\xf2\xf5\xaaB\xd9\"\xcc&<F\xe9o\xd0G\xc0M\x8c\xa6F\xf7\x8bF\xba\xe1\xae\xdes\xc3.\x17c\xe6\xef2\xdd)\xf4\xde\x85&d\x9484\x0e\xa8\x12\xda\xef \xa4\xfa\x03\xc3\xd4M\x92\xda7\xbe\xd3\xb0\x89\x83\xfb\xa6\xc2\xea0S\x99K\xfa:\xa1\x8bd\xd2,\xce\xa2%\x14\xbb6\xddL\xfba\xee\xc3i\xbb\xbf\xde\x09\xae\x8b\xda\x82\x8fl\xbb\x84\xa5&\x8e\xa4\xc4Rb\xe5mH6\x09\x0f#|;\xe2\xd5\xb3\x8f0\xfcV\x02'\xedrO\xb2\x8dV\\\x0c\x97?\x0cB\x12G\x9ae3Q2U.\xdbPTz\xf3\xcd~C\x98\x1b-6o\x98J\xe9\x1f\x9c\x1a\x84\xd6\x95\xe9q\xc8\xed\x84\xd8\x80?N@\xb8\xfcH\xab\xb4@(3SU\xa0q\x90*.\xd2\xd3\xb2>\xa5B\xb8\xe8bT\x0c\xe7\x9cq\x1c\xd7rE\xf6\x95\xfa\xea\x10\xaf \xe2Q\xa6:z\xdcA6\xb6zj\xadf\xfe)+M\x7f\x9fIq\xa5\xa9\x16^\x94\"\xaac5a\xf4\x13L\xe2kI\xa7gU\xa9C\xdc\xf3\xa2\xf0\x97]\xb3\xb8I/H\x86\x95!'\xab\xb7\xc3\xf9[YK\xdf\xbcZO0-\xe4VD\xf61\x11\xdfL\xb4\x00g\xb0\xf1\xdf\x9d\x91\xb5\xaa?1I\x1f~\xc9d\x8d\x198~\xab7\xff\xcd\xebE\xf4\xab<\xa5\xedzA*bl\x0f\xa1\xe4S\xaa\x06S\x94N\xd4X\xd0\xa0\xa9!\xc3\xbd\x05\x93\xfbW\xe8\x05\x88\x9d\x10\x98\xa9\x14Ox\x9d\x11\x88\xa5\xf5)_/@\xe6\xe3N\xee\xb5qv\xafyS\xe0\xea\xdd\xeaw\xc5\x1e\x9b\x17\x91\xe2&L\x89g]\x9d\xe8\xe9\xcf\x82y\xc9\xff o!\x92+\xfa\x1b\x04\xa4\xc4\xe8\xf5\x8d\xa3\xab\xa5R6AM\x11}\x09C\xd8\xdb4\x04\xbed\x12\xecVnf)\xcd\x16n\xe5\xae\xd5\xcdh\xe0\x06\x87}>\xd4\x0b\x84_J\xed@\x0c\xe5\xa1\xac\x9d\xeb]\x87MP\xf2-p!\x8b\x85\xab\xd5x\xda\x84J\xe1\xa5\xf1\x8f\xf4\x89\xc8\xaa\x981 \xedn\x19z\x93\xb8\xa7\x92\xc1\xea\xaa\x8c|\xafhC\xac\x16\x05\xd2\x11?Y\xe0\x97_\xcf\xf4\x83B\xee\xc6Mg|EL\xca\xed\xa9~\xea\xcf\xf8Z4\xf0\xec\xb5M.U3\x97\xd2\xa6k\x9c\xd6\x806 no\x0c\xdd(G\xa6\x1d\xb3l\xe4\x82i\x07\xec\xcc9\xdaF-:\x8e\x83?UG2|y\xf8$}\x86\xeb\x09\xb7\x13\xd1\xd5c\xf7\x03\xd1g\xf8\x89F\x85\xf1\x92E\xeba\xd0>\xb8C\x07\xa5\xb4\xc9^\xaa\x01o\xe1\x8c\xaf\xc5\xaf0\xf4\xec\x9c\x1b\xc6m\x9d\xdc\xa7\x8b\x8c\x88x\xe0\xb1w\x8d\xc7\xe6_\xa3\xc5h\xa7A\xd9\\@\x91\xbco\xde\x89)\xd5\x08\x99\xa0J\x11>\xd4W\x9c\xf6LJh(\xcb\x81\x9bu\xcd\"\xf4\x9d\xe7\xb48S<\xb5U\x17\x9c\xfc\x9bk|\xa8Tr^\xbet\x0b\x10|t\x7f\x8d\x9fI\x97\xa9\x86\x1e\xa7\xb9\x89\xcd\xa1\xc5O\xdb\x80\xc1\xe8K\x7f\xf1\xf7\xe4\xc3\xdf\xbe\\:l\xb0\xf6\xd4\xa4\xa0g\xe5\xcd(Tn\xd9\xca\xa4\x13g|\xcbVD\xdd\x8e\x05|\x80^\xfc@\xef\x0d\x0f\x81%*\x1e\x11\xf0\xb6\xb4F\xef\x89X0\xb2\xfa\xbe\x0c\xf9\xe0\xf0\x9ab\xd3\\\xeb\x1ai\xd7\x8d>7P\xf89R\xe7\x10_1DLi2m=\xfbB\xa9\xb4\x1dL\x88\xb1\xbc\xab\x9c\x7f|\xd8\x14nD\x8eF\xfaA\x03\xf9X\xf5n\x14\xcc/y\xc2\x99\x82 mizwz\x81\x86I:O&\xd8\x0f\x1f~\xf0\x0f\xfe\x1c^D\x9f^\x0e7~l\xbc\x94\xda\xf83\x19z;\x0cM\"\x9ci\xe3B\xfe\xd4T\xbbV\xeesy\xa06\x18-\xbf]\x8e\x17\x147d[l\xd2\x8aA\xd6o\x93.\x91Lv4\x02\xed\xad\xc7$\x04\x0a\x16a\x84\xfa\xfc0Bq\xe0\x0f\x15\x8f\x1en\xbe?\xeeey\x9c\xf1n(j>A\x7f\x9bHhq\x0f[\xd6\x15N\x06-\x96*w\xd0<Fp\xa4'\x91m\xdf\xdd\xa0\x83\xe8\"Jr\x87\xa4]F\xa8\xe1Y\xcd\x1a\xed\xa2\xf9\x90_\x90W:\xfc?\xaf\xf5K\xfc(W\xcdj\xb8\xb9gA3B\x14y\xaa\xd2\xd9U=\xfc\xd2\x80\x9a\x9dY\x0a\xd0MJ54r\xda\x87\xbft\x89~\xe8T\xe0\x14\xe8Z\x96~\xbc\x0f\xc5O\x94\x9a\xbf\xf9GA \xc4y9-A>\x96\x93\x97;9,\xd3\x99\xe0\xd0\xdaL\xc8\xbf\xc7*\xc6\xbc\x02.Cyq\xa3?\xe5\xd3`;\xcdd\xecKC\xbd\x814\x0e\x8cW\xd3\xf4\x14\xd3N\x14\x1d\xf4\x9c\xec\x0f\x1f)\xdd\xe8\x07_3|\xd5\xbe\x99\x05%\xdd\xac\x9a\x19\xb9\x10\x05\xf3~\x81PX\xefo>4)A\x17k\xc7\x14\x86X\xdaq\xf5\x1b\xf0[\xe5Y\x9a\x0a\xe1\x1c}L\x07\xb2\xcb\xadX\xb1\xf0,9\xa7\xc1\x87m&\xcf\xa4:c\x0a\x17y}f\xaa\x86\xb8\xa8\x96\xea4!_\xc0\x90@*\xd1\x900\x1e\xf7\x81H\xfd[\xc2\xcb\xd0S4L\xd88P=\xc2\x8d\x15d&\xf0\x0c\xebx\xa8\x10\x0d\x1eN\xe9\xe3\xfay\x8eG7=zZ@\xdd\xc9\x15j\xcc\x8a\xb46\xc7S\x9c\xfcCw\xf1\x9d\xe7\xe3[\xca\xa7\x0bMK\xb2\xd2NE\xec\xfd\x15\xbd\x88\xce\x87uCa]\x98\xc1[\xd5_\xb1\x17\x9a\xbd\xd8\x1a\xa6\x84Ac\xbb\x819\x12\x91\xe2\x9876\x15d\xab\xca!\x19\x11:{\xf1\x87Z/\xf1\x87\x91\x89\x0d\x7f\x85\xab\x15\x15k.0\x9a\x89\xd4\xc5\x08\\\xbc1\x80\xceX\xeb\xfd?9D\xde\xa4\xee\xfbzq\x8a3\x8f\xc1\xb4cu^qo\xc2_\xc1\xea\x00q.\x17\xfc\x15s\x85p\xef\xe1?\xf8*=\xea\xbeu\xd3,\xfc\x8e\x9d\xb7\x8c\x05A\xa2%\xc3\xeeD\x02\x0e\x9c\xfa\x94\xe5@\xfb:\xdcM\xfa\xfb\xce7\xae\x131&\xbeC\xccd\x90\x15\xe5\xa07\x9c\x1f\x819\xfaD\xff\xf6\xfd\x04\xc2\x17\x81\x97\xba\xb8\xfe\x8a\xb9\xf4\xe9\x1c^\xe8\xc1HU\x0e\x14'J\xed\xfd\x87\xac\xbf\xff(\x19[\xba\xaf\x83HS\x1d_ls\xc8\xde\x9b\x1di\xd2\x08\x83\xe6\xa5\xc4\x92\xd5\xa1l\xbb{\xdcv\x8b\x17:\xe7\xf3\x9a\x11\xc1\xae~\x1c\x1f\xd2\xa4\x03\xb8\xba\xa1&\x02z\x18i\x88\xf8\x8d\x87\x0b<\x86\xa9\x19\xdd1\xff\x90\x98\x16J\x91\xdc\xf8\x18^\xee\xd9H4F\xc9u\xc4y\x85\x09\xc6\xceg\xb6\xc2\xf4h\xc5j\xf1\x9d\x19\xf8&\x01\xe4\xdaBId\x19\\\xc2\xdcF\xa0\xb7\x02\x99/\xd0K\x9f[\xc2`N~\x83<\xcb?\x06\xfb\xfe\xee\xdb\xb9\x02\x8a\x18\xde\xb4;\xdf\x84\xa9y\xa0\"TDs\xb7e\x0d\xf5\x89\xa0\xd1\x17\xdb\xc4\xbc\xa9\x16.%\xd3k\xee\xb5\x9e\\\x03G\xf6\xf3\"l7KnpJ\x9a\xbez\x84ft\x8c\xac>\x9a'\x12\x91\x8e\x80\xe1\xbf\xd7\xf4\x85w\x1d!\xee\xd88Yp\x0f\xfd\xbc~!%\xcb\xe0\xdbp\xfd\xce\xf5\x13\x11\xed\xee\xa4\x0c\x96:\x8c\xbd\x13j3\x8cz\xb9\xe1\x99\x1a\xee\x93<\x99\xf6\x01\xae-\xa0\x05\x94\xbf'9p\xdb\xe4\xf0\x19\xb2\x1a+\xcb^\xee\xe0\xfa]\xbbO\xc3a\x19\x81\x0aBX!|%i\x84\"\xb5\x8enQ\xe6@\x84/Ul+\xbc\xc7\x91\x04Az\x1d|\xc3s\xec\xe5\xa6_\x8cI\x84\x87\xeb\x84D%\x05\xda\xfdGh\xfd\xe2\x1d\xcdA\xc2\xfc\x87u=\x18{ \xcf\xb3\x10~\x9c\xb6D\x1f\xbe[)\xf0?\xeb}\x10\xd1\x90\x94u\xa3\xc7`:\xc8\xa5>@\xf0\x13\xcd\xfd\xe5\xb5\x85\xb4\x9fg\x07\xfa\xe0\xbb\xefVk\xba\xb4G$@\xaf\xb1\x88\x9c\x88\xbbb\xea!\xfcZ\x0c\x0b\x95S\xa0\xbbXY\x14\x0d\xa4]3\x82\x11\xf3\x8b\xff\x1f\xb3&\xfb\xc8o\xce>\xfd\xe0\xc7\xfb\x0f\xf1.\xaa#B_\xfc&+\xb3\xd4\xfa\x92Z\x99\x14\xb36\xeb\xe2\x8a*\x0b1\x9ei 5\xf3Jw\x1a/8V-F.\x16\x9a\xbb\x05\xf4\x9d\x15\xa3\xb4EGkN\x19@\x92\xdc\x12.%\x91t\x12\x17\x93\xca\x99\x94\xd9\xaa\x1b\x19O\xce]l\xccK\xf9\x08\x8a\xd2\x88\xf4\xb6@J\xa3\xc6\x17Pr\x02(\xce4\x07\x80\xa7\x18\x16\x18\x09\xa8\xa6\x1a\xe0\"\xbd\xf2\x1f[+\x8c\xa2\xa1\x08\xd7\x1a\x9b\xea\xd1\xd1\xb1\x80\xe7\x1a{\x85\xb7\xe0\x0a\x8e\x1e\xf9\xcbl\xec\x11\"\x0d.\xea\xe8p/\x14\xf6v\xd3\xe6\xbc{\x99\x0a\xfd\xdc`r*(\xd4?\xba\x98>\xd1rv\xc4\xbb]\xf8Mk\x8a30A\xdbh\x7f\xcc\x99~\x1c\xc2\xb8b\xec\x18\xdb\x0e\xfa\x8a@\x03\x18\xaf'y\xa09\xf1\xb4t\xff^\x81\x17" +
+	"iG^*gg\xecWQ\xba\xf0\xb8\x91y\xca\xf8sxfv\x10Y\x7f\x9a?u\x0c\x82i]*.(\x92\x84\xf9\x81<\xa7\x0b&\x1eL\xd3\x10\xd5\xc8n\xae\x9f\xa0\x95Ao<AQhO>T\x03`~\x09\x9c\xb0f\x9ed\xb4u\x84\xe8\xd1QA\x99g\xf0h\xc2!\x0c\x07nU\xefn\x86L\x18\xd5\xaa\xa2mc\x0a1D\xa6\xa5:\xb5\xd2\x8b\xdd?C\x13y\x91hEg\xef\x04\xb7\xcc\xde\x12\x1b`B2\xca\xf2\xfe\xd5@\xb4\x1e\xf3\x86\x91\x94\x80B\xb0+bH\x0cOn\xe7\xe3d\xc6S\xd8\xcf\x0b\xd8/\xb6\xb9\xc4?\xa1\xd5\xc9`6y/h\x16J\xd2\x1a\x08\xe3F}\x89\xdcm\x98\x83dT\xa5\xda\xd0\xfa\x96N\x9b\xbe\xf1\x81L&{\xd6\x06\xb7\xfe\xb1\xe5\xb6\xfa\x14\x06x\x17\x05(M\xd4;\xc8\xd1\x17\x96\x7f\xba\x06\xe3.\xdc\\}\xb1\xb4\xc6kp\xaa\x86k\xd5\xba\xfe\xc1\x0dE\x03\x8dC\xab!\x06\xa3yj\x9e\xeab\xaa\xae\xd0(|\x86\x02x\xd6\xeah*\xb2\xb6\x93\x88\x1a\xfb\xde\xc1QqQ\xca\x0b\xb6\xe9(\xbcW\x10G\xe7\xc9\x99\xe5\x93f\x84a\x07+\xfb\xc2\x10M\xbe\x81\xc1\x98\xd7\xb8\xfe\x93\x96\xc2\x1a\x86\xab:J\x94\xa1?t~`\x82'3\xbd9\xdczt\xa3&v\x99+!\xd9\xba\xae\x91\xc7I9\xd262\xbc\x91\xe5\xfdc\\z\xf8e\xb2\xbaHl\xf9\xb4(%\x12o*&\x13\x1bh\x17V\xeb\xac\xd7l\x93\xe6`\xb4\xc0Ln-\xcb\x9fx)\xd9\x90\x89\x02\xec\xe0\x8e\xff\x1e\xcfo\xb3\xf9,\x1b\x0at\xd0\x16#\xb4U\xcd\xcd\xdeQ\xd6\x96;\xbe\xe3Y0\xa3rq\x9d\xe9\xac\xb1\x93\xdb\x88\x93\x95\x82\x7f\xfbg\x0bY\xb4E\x86\xfe\xde\x05\xf2\xb1L\xbbk\xfe-\xc6\xee;\xb3j\xa2\xdf\x10\xa6\x16\x1e \xaaHd\x1c\xe2\x06\x0d\xcf\xdaqy/\x0a\x15e\x19\x8b\xc576`\xbc\xf7b\x9b\xf5>9\x95\xe4\"\x91\x012\x11\xc4\xef9E\xb3\x9a\xb4,T\x8a\x8f\xfa\x05\x93\x9e\x92{h\x96\x97_/;\xa2\xc9W\x1fT\xa8^\xd3/\xcbw\xa3>_\xf8T\x8aL+Wi\x8b\xb2@\xd9 |)\x0b\xbc~\xeb/\x97\x02q\xd6\\\x9f\xa0\x89\x85\x12x\x86)bK\x8c\x12\x0e\xc6\xc3r. \x8f7\xf4\x08\xcfR\xdde\x9aiZ\x89K\xdeZ\xec\x06\xa4\x0e\xf0\xe5\x00\xfe\xbej\xaa\x19<\x0d@\xb1P\xa4:\xdc\x12\x1f\xfe\xee\xda\xd8\xcb\xaf\xa3F\xf8Lt\xe6\x9f}\xed\xc3&(\xfdX\x1c\xfe\xe0.\x83k\x8f\x16\xf9\x96\xe8\xac\xe0\xa2Wi\x88D\xc4Y\xa3+v\xa4\x8bC\x0d\x93[UrQ\x93\xb3\x88\xe3\xa3\xe0\xeb7G\\\xda\xfdD\xe9\x96\x8dT\xc3\xcb\xaf\xbf\xbd\xaf\xa8\x947v\xef\x99\xdft\xb8\\\x88}\xa2t\x17\xbb\xe4\x0a\x92\x8e\x02\xa6\x1a\xfd\xca\xac\xff\x99\x97\xe0\x05jX\xa4\xbc\x9b|\xe9\x05\x81T\x07\x05\x07\xb9\x04\xaa\xbb>\xc2`.\xcf\x17\xf9\xea\xe3#t\x1a\xdd\xe8\x9d\x0a \x17\x8f\x04~\xeb\xc7A\x1c\x8d\x8dD\xea\xcb\xaf_\xed\xa3\xba[\xe6\x96|\x86\xe9\x1c^\x84\x1cC\xa3\xf5S1#+X\xf3E\xadb\xd3\"\xd6\xf5>\xc8\xf5\xe5\xd7\xcb\x126K\xfb\xb2\xa9.\x01\xb4\x86\x95n5\x87\xbf\xebQO\x96p\x86\xbd${\xa1\x1bsb\xdc\xb9\xed7\xab^\x01%\xa3\xd2\xd6\xc9J\xc2\xbb\x8809p\xf5Z0\xda\x9d\x1f\x1aK\xe3\x99\xf4\x8bk\xb3!4#\xf07\x88\x9d\xac\x09\xd6\xca\xf9\xfe\x09\xc3JYO\xf6\xbbt`\x02\xa5\xe21\xa5{HVx\x0b7N\x0e\x95\xbc\x84\xc9\xbc4\x15~\xb1\xba*\xd9=\x0b\x9fa\xff\x1c\x97\x83\xb61\xefp\x9b_~\xad\x9e\xd6_f\xb5F\x13\x9e\xb2\x17\xbf\x84KG\x9c[?\x813\xde\x91\xb8\xff|\x92\x90;#M\x1e%\xf4\x966b\x0f\x07ID5\xdf\xaf'\xbc\xfc:g\x12YXhx\x1d\xaa\x16\x9b\x822g\x80\xb4\xa6L\xb3K\xb9\xf5\xc6\xf7\x0f\x8e(oc'\xf9\xd6z\xe6\x13\xb7\x85{\xc7\xd4\xbb(\x04>R\x12(c\xeb\x94a+z\xe8\xa7u:\xd9\xae\x1a\xfc\x1d!\xb0\x97/\xd75.\xe5p\x0b0ir\xfc\xee\x94\x8c\x99\xc9\xc2d\x91J\xeaozx\xba`\xb7D^<\x0a\xe9*WCInr8\xde\xde/\x7f\xbd\\2/r\xff#\xfc\xceQ\x00\xe1\xf0Y\x93\xbe\xe4#\xa5Y\xdf\x98\xe0\xe5\x94\xedd[\xe4b\xf9\xa7\x7fN\xf3\xba8N\xf9\x87\xddh\xaa\x8e\x07\xa5\xe1\x9b\xf1*\x88'o\x12p\x89\xafC\xa5*\xe6\x07;\x94.\xccps\x0f\xc4\xfe2\x95=H\x09\x06\x996\x1a\x1f\xde\x02\xb5\x0e\xe4\xd4 \xc3\x99\x87:Qb\xa1tNxh\xe8\x84yx\xaa\xfap@v\xa7\"\xc2*\x13\xd5*2\x0d\xad\xc3\x8c\xd8\xe05p\xa8\xaeU\x0f7vJ5?s\x10e\xd5c\xff\x9c\x94\x1a\xa5^kk\x93\xef\xc5\x0e\x85E\"\xf6/\xe6\x1f\xec\xd9(\x95\xe4\x06-\xeb\xa0}\xfc\xd8t\x8d^\xd8\x02\xb1\xdd\x8c\xee\xecnR\xf2\xe5\xcbuo\x8a\xe7\xd8\x8eK\xac\x0b\xd0\x96\xa2\xd6\x9a\x85\x8aA\xae\xe7j\xc8\xa2\xfagH\xc6@\xcdD\xe7\xc7\x9e\xbcf\xb4\xfc\xe5va\x16\xab\x8bw\x9e-\xe3\x98\x13Hy\xd4\xed\x16\"j\x09W\xe7\x1d\xb4Ta\x8c\x19d\xc9\x17R\x82\x87\xc09h\xf3\xd2\xfa\xb3\xd1\x0e\xcfz\xb7\x85/\x861\x9cw\xee\xfe\xf5\x9dzC\xcc\x86*\xcb\x9bT\xdeY\xd5\xe1H\x15 \xe4\x93\xa4\x96\x0a\x96v\xb8\x08+\xae\x09\x88F\xb4\x0f\xce\xc8\xc9\x85\x8c\x9a\x9f\x88\xb3\xca\xc2L\xdf\xcb\xc4^r\xf7e\xaa\x06\xf22\xf8\x06LT8\xa3\x17\xcc(\xeb\xba\xaa\x85\xee\x06\xe8\xb1#\x8f\xf1\x1a\xcb\xd7?dH\xdf\xdc\xc9\xae\x99\x9a\xa9\x96\x04\x92\xf1\xb9\xf8\xe9\x8c\xaftzb\xd3]\xd4s\xc4\xb5\xcd\x81\x85\xeb\x1d/\xae\xf0\x94\xf4\xbf\x01:\xfa\xa3\x89\xb8\x96=\xd4\xf8\xf5K\x80\xaa\xe5\xf0}\xc7u\xc87\xe1\x10\x8e$4\xee\x8c\xd4\xdbx\xe4L\x12\xf7\x1e\x8b\xa6\xa2\x1ey\xe8\x93\xfc\xe2\xe9\xf7\xfb\xf0\xa9\xf6\xc2\xa2D\xeb_\xa0\xf6\xa5S\x12,Y\xeb\xe99\x84\x7f|\xc0C<\xcatf[\x95\xfb\xe0$\xf8\xde\xcfsy\x9f\xe0\xf2\xce\xc3\xbd\xcb\xe9\x16N+NK'\x0b\xda\xdd\xe9\x05\xd3\x84aS\x8d\xe1\xe6\xa2C\x1b&\xbd|\xaa\xf9\x10^p^&\xf0\x17\x98\x12\xef\x84\xab\x04\xe1x\x00\xd78<\x9a\x9d\x10\xbf\xfd\x9f\xe4\xd9|\xb3\x9c\xc7\xfa|\x19\xa0v\x9d\x18}9j\\\x07\x9d\x9c\xe87\xcc\x0dI\xd1 \xac\x81R\x8e\xf6\x1bL\xbe\xces~}QC\xef~\x91\xb4q\xa4 \xbax\xe92r\x88\x8a&\xc2\xdem`\x08\xa1\x12\xd7\xd5X\x8c\xfe\x04\xe2Vu\x16\xf9\xc0Xr\x0c^\xe4\xce\x8ek]\xddkE\x87T[\\<Lf\x10\xdf;#\xfd\xcd\xac#t)/L\xdd\xbe\xf7\x18\xd8J\x97\xf8\xf3\x19\x90se\xf40\xb9}\xe7\xe6\xbe\xcd\xf7\x09\xdda\xd6\xb4\x96\xa0\x0d$\x89\xf3\xb9\xf1\x14q\xc0\x15\xba\xb8t\x12l=N=\xbd\x9d\xb1\x8e\xbfk\xceq\xca\xef<\xc5rB%\xb0b=:\xf4\xd0p\x9b\xd7\xa1\xe50$\xd8\xd0w\xff\x09.\x93\x9f\xe7X\x0fd\xf4\x8e&W\x865\xc8B\xd9\x16V\x03 ;#i\xfa\xc5O~\\\x12\xdf\xcej\xa4\xf3\xd3/\xc9\xdc\xef/\x88\xbe\xfc\xc6\xf8/\x83'b\x0b)\x97%+\x18\xef\xfdl\xea\xb9aS\xd4\x1c\xcbJ'jZ\xab \xb1Ls_\xcb\xda\xef\xcb\"\x89L\xaa`\xea\x15&e(5\xce\xd4\xb1A\xcaPS\xe4\xd3J\xeb\xddk-\xb6\xf4\xeeTP\xfa\xe6\xcd\x9a,\x91\xf6\xf2pT\xc6\xde\x12\xfe\xcf\x14\x02\xf9\xaf\xb2\x05\xa3\xba\x92\x80\x92$\x17.\x0a\x05\xb2\xcd^\x14\x1f\xcda\x18\xbf9\xacC\xb8\xc0\x90\x9d\x10wj\x85+5\xb7;\x0f\x1dKf\xfce\xb8ljp\x9a'\x15\xf0\xa2\x96\x03\xf1m8\xaf\x14Ld\x92\x9a\x86\x18\xc9\x1c\x07\xfbD\xe5\x0f\x9a\xb8:\xdat\xe5\xd2\x87I\xe2\x19JX\xd8@\x1a\x0c\xdf|Q\xc23\xdc\x17\xfd\xfb\xee\x8e\xd7\x7f\xa9(\xc5\xbeJ\xd9>S\xbbH\xc8\xf6\x95\xcfa[|\xf9\xad\x15_\xfe\xdb\xff\xf3o\xff\xc7\xdf\xfe\xdb\xdf\xfe\xeb\xdf\xfe\xd7-\x01\xe6\x19QObA:\xa1\x03$\xc8\x1a\x0b\x0e\x17\x04M\xc0\xa0^T\x0d\x918\xab\xf8\x11\xc6GW\xba\xb6\xf8\x01\x06\xac{&\xbf\xfc\xf8/c\x0dKdG\x0d\xd4\xd4M\xd2\xba\xd61#\xe2\x15\x06\xfa;\x96\x06k\x98\xe2\x8a\xa7|\x88m\xa2ZnE \\]fS>9H\x18\x02\x7f\x94d\x9cq\x85~\xdc\xae\xf5l\x9d\xcf\xc5\x8f~\x04\xe2\xcb,\x0e?\x83\x1f\x84B\x13\xdf\xab(\x12\xfc;\xfd\x99\xc5\xf88\x05\x89\xbb\xf1{\xe8]\xf1y\x1c\xc0\xb5\x921)\x88=\xee\xb8N\x1f\xa7\x800\xa2?\xd6X\xb0\xf1\xa7Maf\xe1S\x85\x1e\x1al\xb6\\Q\xab\xa7\x07\x86_A\x88-\xcf\xe1\xa5\xf8\x93\xcf\xc3\x9f2\x8e6.\x9fTR\x8e\xe1E\xdb=3\xaf\xd2\xa8C\xd7\x8e2\x04\xe9\x1e\xb6CF\x99q\xc2'\x1a\x81\xec\x9f\x9613\x92\xacRdg\xca\x91\xe3zW\x94>|\xa0e\x02n\xe2\xef6\x9f\x9f)\x82D\x91`\xfa\x83\x9e\x8aa}nq\xf0\xe5\x82|\xa4]E\xb9\x9d\x11`*\x90\x09H\x029+\xf5\x1f,(\x1e\x1f\xe8%T\xe1\xae\xed\x9f\x11\x91\x97cC\xc5c3\xda\xe2\xf4\x9e\x8co\x16k\xd6S\xf2 \xb9\xf0\xcc\x00\x8f\xd0R\xeeR\x94>\xce\xfc\xf3d\x85=\xa2\xca;\xf7\x94\x8fd\xca\xdb-v\xa4e\xef3\xdd\x7f\x9d/\xae\xaeu\x83R\xb7\xe1\xceh\xc1\x12\x16\x89\x1c\xb5nKb\xd4\x9e\xbcE\xaaa\x81\xb4j\xe0Y\x05\x83\x92\x8e\xe4t;\x13\xae\xaa}\xeanOMr\xc0\xde-\x87,\x90\xac'j+\x81\x8a\x03\x86\xe0\x83\xce\xff\x86\x93\x1f\x16[\xd6f\xfd8\xbb\x14\xce?\xbb\x1e\xd9rX\xba\x8a\xc2{\xf6\xaf\xbbgJ;\x96\x1eN\xc5![`\x09\x1728\x0b\x13~\xf6\x9c#\x08\x9b\xed\xdcy\xb9q\x93NLeY\x8a\xae\x0a'\x8a2\x9ew\xd0C\x81\x18\xda3\xbe\x92\xd3\x05#\x07_\\\xcbi\x9d\xd9\x18\xbdI\xb3\xbb\x9es\xb9cU\xc3\xc5\x8dg|\x15\xc4\x15&\xff\x12\xc5\x16\x0f\xe3\x9d\xeaJ,\x05\xf9\x12\xc2\x0d\x11\x19\xbf\xb8\xb2l*(Q\xdd(\x8d\xce\xe1O\xcfp\xcc\xf2\x1fc\xd58\"c\xe3u%\xe2\xe9$\xd7\x1c\x06z\xa8\xa1\x810\xf4\x82Rd\xce$z\xe7A\xc8uQ#8_\xac\x99*R80\x82\xe3\xbe\xe2\x07&\xb5\xd6m\x05\xef\x95Z}\xef\xac;\x9c\xd7\xdb\x0e$pj7\x0e\x86\xc9\x09B\xb2\xa9\x13\xb4\xc2\x02V\xecG\xffU\xe7\xfa{\xb8-.w%\x19\xb0\x12\x12X\x14Y\xb6m9\xef\x19\x0e\xeb\xe9\xf7\xb2\xd0\xb1\x16\x17\xf3M\xfe\xbe\xec\\\xd2\xd3\xc1\xe8\x87\x10\xc2<\xc5\xc2.\xef\xd6d\xef\xcc\xdf\xc5n'\x86\xad|~\xcb\xf5\xc2i\x8d\x04x\x08\xdc\x1c\xe1\x1c^vjb'\x0b0\x13\xc4\x96;\"\xe2[\xe8K\xd1\xd4\xe3D\xe2\xcd\x8f\xe1S\xf8\x17\xf3\xd9\xe4\x92\xf4G\xba\x1c\xc2\xbc\x10T\x91\xb1\x90?P\x87\xe7\xd0;H\x806\x8b\"\xc6VB\x94\xb2\xb4P\xfb\xfe\x8c\xaf\xb1\xbf\x92\x04\x8e%\xcaO\x9a\x1d\x12oE\xf8\xcf\xf9tA\\\xf3\x8bm\xe5\xecO\xd0\x9bz\x9fL\x84\x9b\xfc\xa2/\x84\xeb\\\xbb\x0e\x08[f&\x1b\xdfGV\xa5\xc5\xb2+\x13\x0c\xd5\xd5\xa0\x99\x0a6#\xd2W-\xf5\xfd\\;R\xaaw\xe4\xc6rx\xfa\xe8\xbb\x1b0La\xb8\xf8\x06v\x88c3\xaf\x11<@+\x97\x0b\xf3\xf1\xe0\x1b\x7f\x93F3s\xc0\x19\x82A\xd7\xbb\x04[V\xbb\x11.\xea\x0ev~\x0b\x9b\xf4z\x15\xdc\x910\x11\xb8\x98\xea(\xe19J#/\x15>\x18\xfa\x81\x1f\x0esD{\xea\xd0TDv\x8cGeV\xd6\x15\xf0qv\x0b\x94\xc4X\xfe\x06\xcdq\xc81<\xf8\xab[\xb8\xb4,\xcaL\x13\xe3i\xcc\x99\x0f\xa2\x86h\x11\x8f\xe9\x93\xcd\xc4[\x1d$<W\x94\x8cOa\\\xac\x86`j\x8d/\xb6\x05\xb05_B;\x1d\x11\xa2\xd9\xc9\x8c[\x89\x8a\xc4<\xe4\x12\x1b*#\xf7\x1aj\x18\x0d-Qj\x15\xa5\xe3\x8b\xb0D\xb1\x08\x09\xf4\x13\x80\x88\xab\x1cg\x85\xd0\x16\x1a/\xf4\x8c|\xe2\xb2#\x12/&\x0b\x01s\xeecu\x83~\x82\x91\x99X\xc3\xe7uI\xa6\xe2\"\x01\x0bP\xf5\x93\xe7A\xb6]\xa3l\xf6D\x82\xd4\xdc\xf7X\xd1\xaf\xcf\xf4\xe3.\xf4\xf4m\xa6\x8a\x057\xe0\xb0\x8bL\x80\xef\xb4R\xf0'_'q\xb9\x8c>\xad \xdd\x8b\xe2\xc9\xac\x932\xfd\xc8\xdd\x97\xb8\x96L@q\xfe\xd3\xbd;\xfdni'\x9aV\x0csf\x8eU\x03\x94w\xc5\xb4\xf7\xaf\xeeh\x17\x05QVZ\xbb\x15\xe5\x0a'\x9f\xf4\x00\xb9\xa8\xbf7co\xcb\xfe\x1c\xf7\x81\xa8\x85\xcb\xd5\xd9\x91zdG\xea}a\x18u\xd5\x09\xcdH(\xaa\xe1\x01#$\xfa\xb7\x1f\xef\xa1\x8b\x12\x9e\xfe\xcb\x122}>B\x92\xefg\xc8\xb2\x1f\xa1\x86\xa2\xa9\x0a\x18\xa4\x9c$zF\x89\x80\x91`\x16c\xd4N\xe2\xab<\xbc\x86mf\xad\xc0\x8f\xdfDc\x8e\xf9L\x17\xb3\x9b\xae\xd9!T\xfe\x85Q\x0b0\xba\xfez\xa6\x1f\xb1\xe1(\\$\xfe\xcf\xf5\x8a\xb8\x8f\xbf\x9b|\x1a\x0eb@'y\"\xc4\xb1Y\x7f\x9c\xd6\xf6\xec\xd7\x89i,\x1dw\x8f\xec\x10/\xff\xf3\x8a*\x17\x03\xa6\x0d\xf1XL^E\x19\xc11\xfe\xc5P\xac\xd3=\x8a\\X>\xc3xQ\"Gp\xf8\xa3A\x9es\x9cy\x13|\x16M\xe5'\xe8\xe0>R=\x96\x9d\xb2\xbc\x12W\xabQ\x8f\xc02\xa39\xe4\x90p\xfdU\xd2\x87\xd1\xd3R\x08\x01B\x8e\x93\x15O\x83k(\x89\xe58\xdd!Lny\x125Mi\x8e\x01\xeb\xbfQ\xdewN\xbf\xf5\xc9\xd1\x8dd\xcd\x08I`\x8d\xcb\xd0]<\xc9\xf7\xc5\x8c\x80\x91#\x0d#Y`\xcc>&\x85O\xc3\xa9W\x95\x1e\xb8\xb1\xc0\xd3\xff\xce\xfc\x1b\xd13\xa9\x06\xa4\xd5k\xa1\x7f\xf4\x932L\x08\xef\x04\xf9\xa53!\x96u,\x84\xc6\x17\xfc5\xf1\xa8!O\x08jSX[h\xe4\xac\xfa)\xaa\xcc\x84\xf707\xb1\xd1!\x8a\x85%\xbe2\xd9!\xdf\xdeQ\xabJ\x95\xd8I\x9eD\x8bz\x83\xeb\xe2\xf9h\xaa\xe0\xa5\x8f\x1d+U\xef\xa9v\xf0Ls\x9a\xde\x9a\xa4\xf6\xfe#\xd4\xc0\xfe|\xe3OE\x03\xa3#\xe1?\xcd\xf8\xd0\xb0d\xa9\x83\xe4\x1beQ\xf5\xa5+]S@\xe3\x85\xb8\xa5zp\xed\x96\x1e\xb1\xfa\x93\xb2F\xd7\xbb\x1a\"\xe9j0\x19!\xea\x8fI\x9byE\x00\xdc\xa2\x96^X\xcaYI\x7f\x15FI\xa8\x82\x1db\xea\xec+\xb8t\xde)\x1e\xb8\xc2Y\xfe!DA\x8dtj\xd6\xdc\xa5g]\xb1\xa2sc\xc3\xbd)\xe6vr\xb5\xa1\xe5\xf5\xd8_\x83\xe3\x80\x9eNG$\xf3\xa1\xb64L@=\x82X<\xbc<\x0dO\xe3\x0e\xf5\xf3L\xde\xad\xc3\xcf\x9d\xe5\x1f\xf6P\x1b\xc1\x970\xc0\x99\x7fj\xe3\x10\xe1\xc7b\x13\x91\xbe=\xec\xbe\x82\x10\x84\xbb\x9b\x82\xbe\xf9n\xb9\\\xa8,.\xc9\xe1\xa0\xd9*TC\xad\xea\xd80\x1f\xe3W\xd3\xd9,\xc2\x8c\xbcb\x1a\xedF\xc9\xf6p\xd8A1\xff\xdaO0\xeeP\xda\xbe\xd3\x0d:\xe1\xafi7_\xaa\xb6\x0a\xe3m\xe2KN\xeae\x1f\xc6\x94/\xeb\xd2\xfa6J\x09D\xc0\xa1m|NN\x12\xee\xb0mw\xe8n/\x16\x96-\x02>%Z4\x1eV\xd8b\xa9\x1f\xc8\x1c?n\x12p\xe4\xf2\x96\xbc\xeb\x1cC,\xa9m;\xc7\xdf\xbe\xbc\x8b\x84\xeb.\xbe\xf7F\x89\xa3\xf32z\"~D\x9b\x10zb\x85\xce\xd9\xe3;Kd8\xef\xf6x\xf4W0\xc5\xe6\x9fR8>\x7fy\x0a\xfcd\xa2\x87\x10\x00\xec\xe8\x07\xf8\xf6\x9b\xbb\x8f\xd7\xa3\x89q\xc5_]\x9f\x8f\xf5_\x1d/\x91\xe4{#\xe5\x02\xa7\xa4\x1f%\x0cfiL\x85\xe1 \xe3\xfb\x0e\x01\xe9{\xc3\xfe\xe8~U\xd8\xa1\xe2\xdd\xb8\xcfF\xdeD@\xadY\xad\x9b\xee\xe7\x90j\xa6W=\x0c/\x8a\xdc\xd9/\x1d}\x1b[=\x04\xd2.\x1d\x08\x09\xab\xbe\xd0Up\x9f\xa1\xcc-\xd4m@\xeb\x0c\x98\xe5V\xe0o|\xbe\xf8w-\xd1\x1b\xb0\xa7&\xe1\xe9\xd1\xb2\x05\xe1`\xb9\xedN`\x14\xdb\xaaG\xdf\xbe\xda\xb5\x1a\xa84\x03D\xcc6" +
+	"\xb9.\xf6\xd1\xcc\xa0%\x06\xe5p@6\xe8\xfe\x17\x08\xf5X\xf6\xc1\x1c\x14e\x15\x1aC\xa4\x1b\x83\xd5\x82\xb1o;[p\xf8;*\xd5\xa2C^\xba(\xe7\xc4\xec\x92x\xd3\xbf\xc2\xe5\x9e\xf5O\xd5\xaa\x0dt?|\xef\x04\x18\xde\xa6\x0c\x1c]\x15\xfe>\x08\xb5+J\xbf\x1a\xf0H_\x0d\xf0\x00\xc9\x0a\xfa<\xc3y`\x04\xa0T\xda6D\x00\xf4tX#i\xa7\x88\xf3\xc2i\xed\x1a?\xe5,\x1bQ1o\xaeX\xc9\xd8\x9d\x05\xaa\xfem\xceE\x94\x9bc<\x8b\xb0\x1e\xfc\xbe\xb9x{\xe7\xa9\"\x81\xdf\xac\x18\xcc[\x98J\xbb\xa7\xc5f%f\x0a\x89\x8e{F6\x1a\xb6\xd0\x18\xb5\xf1\xec;\xcd\xd8q\xab*\xae\xd5\xb1\xe2P\x8f\xc8\xefv\x88\x1b\xff\x14\xc6\x89\x8eQI\xfeso\xc5\x84X\xf9\xfe\xc1i\xe3\xbeD\xc5\xdbz\xbf\xbc\xb84^e\x08\x18\x9bAE\x02\xf2\x05\xcd\xe9\xb1\xb2rv\x07\x983\xfe\x08\xd6ck\xab\xee\xc6,\xa0\x95f\xe7\x06h\xd6R/-\x95<\x1f\x81\xbb;\xa9\x9b\x80\xbc\xaa\x8d`\xf4\xd5\xd7ym\xbe\x81\x1aq\xac\x93\x0a\x0c\"\x16\xd4\x90\x11.\x88VI\xb2\xe5\x93\xd3d\xad\xc1o,\xc8j\x99\xc0\x92\xe5\x13I%\xf1Cq\x9f ,\x91\x98\x81\x09\xce\xe1\xc5\x9c\xcb\xc5!\x1c\xd4w\xe5\\R\xb5\xe14\x19\x8d\xc3\x9b\xd4\xf8\xcc\x19\xe4\xdb\x10\x87xf\xdf\xc0|\xed\x15&\xe8\xcf\xf1\x9f\xc7\x8c\xd2\x9c\xdaa\xf0\x8f\xb4\\<\x97^\xe2GL\x0f?.\x13\xcbX\x88L_r\xb1\x16\x1b\xae8\xf7\x83\xa3q\xaf\x95\xe9\xd5\x9d\xfemQb\x82\x92e\x19\xcf\xf8jV(\xf5\xa6\xdb\xc02)\x1b\x03\x93]\xaa:\x93\xb9\x88\x9bbe\x8a\xcc\x8d\x0d#r\x0c\xc6W\x1f\x87\xf1y#\x10\x8d\x9a\xc5\x11\xe1UBo\x05\xab8\x0db9\x1f\xd2{\x8d\xf8\x15\xb29\xb1\x8d\x00\x97\xcd\x19_\xefY\xd6\xa8.\xfcG\xa2\x1c\xc6\x8fO\xfe\xd1\xd7'k\xf5:\x9f\x92\x88\xa8*\x03\x06\xadc8\xa1,>\x9cV\xc5\xc4\x04\x0e\xca\x13\x1d\xbd\xab\xf81\xed\x8e\xe9\xfc\xa3;\xff\xc9x;\xc64\xa7\xac\xc0\xc3\xe4\x0a\xfe\xb4\x08\xef\xdd\x05\x90!n\xbe\x9fr,=\x05\xe3/\xb6%\x89?\xcdO\xfa\x8c3#)\xee\xb3\xa1\xb4\xa2\x0d\x8b\x10\xc2\xbdeg\xf6\xd4]_:\xfdh5L~\xf2\xb7\x1d\xf2\xc2\x9a\x15W\xfb=I+.\xba\x8a\xe1\xa8N\xa5\x9b\x91+\x0c\xdf0U!\xcc5\x1d\x1d\xc6\x08d\xa5)\xa8\xa1sZ\x9d\xcc\xfd\"j|\xd2\xc6\x07$\xffN\x8cR\xdct1\xd5\xc3AvSa\x1f\x7fC\xee\x0a\x9e\xe0*\xd6\xb5-d\x1c\xd3\xba6\xc3\x93\x91\xbe\xe4y\xa6D&h\xac\x12\x11\xe6\xe4o\xa4\xdfQ\xce\xdf\x15\x12\x95n\x0cY\xc8\xf0Z\x08j\x8f\xfaJ\x17\xd8<\xa9\x07$!S\xf8\x12\xdd\x8b\x99\x05e7N\xd2\x99\xf1\xc0\xe2h\xaa\x87bt\xb5\xdbB\xb0E\x19\xe5?\xba\x9a\x14k\x16\xc6bF\xe2\x7f6\xff6\x85\xdc\xa5\x0eDe0d\xb0w\x9f\xac\x9b\x96 \x14}\xd5\xa1+Bv\xf5\x10=\xe4;\xe7\xc0\xbb\x05r\xccl\x00Y>Q\x16\xa2K\x09\xec\x8a\x09\x0f\xad\xb0\xb6\xe8\x1fT\x88\xe9K8\xe3\xab6:<\xf8\xe1\x84\xf6\xd7\x1c\xd8\x0d9FN\xbcZC\x0c)\xd0\x1e)y'J}\x17\x18\xfc\x86<\xc5\xeb\xaf\xef\x9cpP:Co\xc7\x96\xf1\x02\xd4\xf0@\xc4\xc8\xa7m\x9dc|\xaf\x80\x83\xe9\xee\xe6\xa2\x82lo}x\xb0\x9ee\"\x85\x0ee*n\x0e\x91OZ\xbd\x17\x09\x0f\x9c\x07P\x0b\xdf?Gn\x90\x98\xae\xd1\x0a\xb5\xc8\x83I%\x0fG\x8e\xc7\xa7\x98?\xfe_,\xf09\x15\xd11\x102\xd1\xad\x99\xa0\x83\x84\xd8\xd5\xde]Gj\xf0U\xe0\xa8\xc6\x1f\x92\x99o!\xd5za\xe3=\xdb\x9b7\xf6\x09\x0e\xd2\xdf\x18\xd6\xd4q\xfd\xdeS\xac\x19\xd9\x8a\x0b1\xc47n\xe2\x82_\x03\xd3\x86\xaaS\xd4}F\x8bb\xf0\x1eLL\x09\xc1\x0dh\xd90\xa1\x9b&'\x16L\xbev\xdc-\x8b\xfek<r#i\x1b\xda\x96\x01N\x8c\xbf2!N\xc8\xbd;\xe8\x88\xc2\x8d\xcc\xa7\xbf\xf8\xe1\xa81}\xf09\xc2\x91\x87\xe6\xfd\xc5>\xdd\xe7y\xda\xb3\x86\xae\x96|\xdb\x17\x05\x04\xff\xa0e\xa0\x05M\xc6\xac\xcc\x91\xa6\x12\xd0\x8a\x14\xab,SH\x93q\xf1-_\x87\xfbp\x16zz\xee\\C\xeb\xb0\xa3\xe7\xf0\x0a9zSA\xaam\x01\xe9\xd5r\x84\xb4\xcf\x90f\xdc'S\xbb\xbeT1\"\xd2\xe5\x8a\x89jZ\x0d\xb1\xee,\x04\xec\x098$,\x91\xa5w\xe3\x19~2\x98\x12+\x00\xd9yC\x1fKTE\xfd#\\\x13\xac\xde\x8bm\xc9\xe9O\xb6Ii\xf6\xc8&\xb7{\x80\x12\x8ek\x9a\x18+\xfd\xf5+\xb5\xdc\x1e.\xee\x1c^h\xda\xd6\xaa\xb9\x06Q#%\xea\xff\x11.^\x0b\x07\x9f\xc0\x97;4\xaa\xe76\xe0\xcf\xb49\xcd2\x8e9\x98\xf0\xa7\xd0\x1e3\x916C\xaaBdK\xfb)\x0d\x90\xc5|\x8f\x90@\xa9\xa3\xe2\xf8\x85\xe4uDV\x82MZ\xd2+\xde9\xae\xf7\xf5\xcf[\xbc\xd4\xaf\xdf\xaeR?k|8xQ$\xc9tVW\x03(y'gR\xd3\xcdg76\x15\xb3Rz+\xc36MU\x15\xde\x14j\xdb(\xc9\x0e\xc2\x83\x10~\x9fe\x0d\xc3\xaf#\x04\xd8\xa6\xaai\x15\xd60\xf81\xacC\xbc\x94\xdb\xa1f\xfd\xb3\x8d~\x18\x1ct\xf3\xb2\xd2\x18\xa7T\x08\xcdE\x9e~V\xb4\xbb`|\xb8\xa5\x06\xc1\\\x09\x01\xeb\x04\x8c\xa7\xc0G8\xd8\x0bJp5\x13;m\xaa\x01\xc6\x17\xc5\x9c\xda\x87\xf6\x0f\x86\x17\xad\x1b\x8bh\xeb\xa9M\xe6\x8f\xe01\xa6\xfa\xc5\xff\xb5>\xeeP\xc9\xce{\x83\xa7\x99\xf4\x89\xf2~\xe2#\xc4\xd5\xeb\x06\xd7\xb1\x96-\xad\xd9c\xdeB\x84+^AYq\xe4\xb4\xae\x12\xdf\x1d\x9f\xactB\x99A\x9c\x03\xd6\x13\xa7\x16w\x0e]W\xa5\xde\x7f\x1e\xa1\xf3e\xb8S(.\xd5$\xd5\x8d\x83\xfa{\xbeyt\x7f\x0d\xb9\xeb\xa4\xb1\x1a\xb3\xa3\x0b\x0c\x1e\xd2\x06\x9f]\xcfRzKvVh\xf9\x93\xc7\x8a\xcf\xadcw\x7f\x81\xbe\xf9zYZ\xe1$\xc1\xc9r\xa3\xf0bk\xce\xc1\xb5abL\xad\xce\xdd(.\xb9\xa2#e\x85*\x17\x9eH\xa9z\x91\x1cq\xd6v6o+\xeb2\x91#\x1aq\xff\xe82\xf9G\xd2l\xb9TWt\x91H\xf2J%6S\x8a\xca,\x0e5w\xc9#y\xc6W#oM38W\xe0\x99g\xb8\x04\xd6\x131\x89\x1b\xc7\xe3\x9b\x97ks3\x85a\x10Z\xc7K5P\x8f\x99\xac\xc9$\xd7\xa83\xe5\x1f}i\xa2\x85\xceG\x95\x802rg\xd8\xce\xa01|D\x1d:wi\xddH\x0e\xc7rd\x05\x0d\xa7\xf5\xe5s\x06S\x80\xe3C\xea\x0a*#\xa3\x9d\x80\x9b\x1a}F\xd3\xdb\x85e\x16\xee\xe4\x98\xdf\xc5\xcf\x02\xd6\x8e\xeec\xe3\x0b\xd7;\x95\xffR\xf2:\xbe\x11D\xc0\x08\xaf\x0b\xc2Z\x1a\x9f\xa5f\xd1\x1f\x92\xb7\xc7=\x17\xfe\x1c\xbf\x833\xfd\xc6\x0c\xc7\xfc\xc5\xac[\x1c? $\xd3M\x85<\x99\xca+\xa1\xae\xb3\xca\\\xee\x80\xb0D\xe5n:;u=\xa8\xa3Q\x0dW\x92\xf0O\xeb\x93K\xa7j\xcc&P\xcc0\xafW\xe6m(BzB5QY[\x9aW\xd5\x0ao\x97\xb4K#:u\xa7\xbev\x0d\xe1\xeb\xf1\x08vi\xa9j\xc4\xa9\xb8\xb9\xf9\xc3\xc8Q\x87\x9d$\x92\x95i\xaaAQL9|\xe9\x06\xa3\xe7@\xb5\xf1%H\xc7\xa0o\"RCKK\x8d\xf8\xd0D\xb2\x19\xb6\x99\xa9\xec\xe9\x05)\x16c\xfd\xa7\xb4\x0b\x0d\x17\x12\x96&\xa3\xfb\xc7GMz&\x86\x82G\xff\xe8\"U&G\x1ai\xd1?Z\xc1\xc9a\xe1\x81Rd;D\xbd\x93\xd5n\xd1\x0d_\xfc\xc9\xc6{*\xf6\xd7D\x158{\xdb)\xda\xf8l\x88\xc5\xffL\x01\xc1\xd6\"~\x93,\xe2st\xbb\x94\xf9\xf0Y\x92l7h\xcf\xf8Jq\x80\x9f\xe4\xb8\xeb<'%\x93\xce\x11\xb3\x9d\xa8\xae\x00\x83\xef\x1d\xe17vd\x8a\xe5|\xe0\xb4\xd6N\x09\xec$\xe5\xc9\x14\x00\xeay\xcb]G3\x11\x05YfK\xbc\x1a\xbcZ\x86O\x0eN\x94[\x91\x13\x80c\xb5g\xeaT0=\x8d\xe1Y\x01\xbf#E)\xd1t\xd9\x96\x14e\x81A\xdb\xaf\xe1\xfd\x84\x99h\x82\xe4\xed\x90\xc3\xb6\x955+\xb9\x06\x9d\x16\xeb\xa4\x0f\x91\x05\xe7\xc4\xde<bRi(P\xbc8G\xdb\x86G\x90\x9ee\xba.z\xb8\xfc\xd8\x8e\xf2E\x07\xe8\xaf\x8e\xb6\xf7(\x97%\x817\xcc\x86\x9d/ h\xc0\x0f1\xb7\x91\xb6G\xd0\xfa\x8e\xda\x10\xccq\xa8\xf0<\xb4\x86\xdb\xe2\xdaxsk\xa3\x80\x83`u\x9aI\x1e\x81\xe7+,\x09\xf6\x8e1\x13\xcagq\xfa\xa4\xb8\xef$Bt}1\x01e\xef\x01%\x04(\x8b\x05]riK\xcf)d\x0b\x8a\xa3\xde\x1e\x08jJ'YOQUv\xbd\xa5p`\xb6\x0bn\xde\xdf\xd6\xbd\xbe\x17\xa5\xc7#Y\xcde\xf0N8\xfb\x00\xfd\xb5\xf1\x93\xa1U\x91\x05B\xcf\xaf\xbbJ\x1duy\xeb\xba\xdap\x0c\xee5A\x90\x9e \x0d\xc9O\xc7R\x1a!?x&\x0c\xf7t\x92-\x8dO$\xde\xce\x0e\xcd\xeb\x15\xf6I>\x07T\xc1\xe9\xc8\xfe0\xe7\xf6\xa2\xe0\x90Bo\x89]\x1c\x81n\x8bmJ\x91q\x8f\x029\xe8\xa0e69\xc7\x90\xc0\xa2\x01rK\xd2F\x82\xde(\x00\xc0\x03\xf3%Du\xd6U^\xca\xa8\x7f\xbcC\xb6z\xb1m\xe2\xe2' \xeal\x0ae\xb5C\xa47\x9a\x1f\x868\xe9\xd0C\x1f:\xb0%\xcb\xc5y@\xbc\x8a\x086\xcao\x17\xe8g\xf6\xd5\x1d\xde\xaeS;\xf28\xd4\xd0\xdd\xdc\x10\xc6\x0b\xado*z\xba\xc4\xb16\x0b\xb5\xd2#\x8d\xaa/\x96\xa6\x9d1\xb5\x81\x93.n\xcap\xa9\xf7l\xebZL\xef\x97\x18\x81\\)\xf6\xed\x09\x0cb\xe3N\xcc\x01+6\xb4\x18\xa1\x06\x15\xd6\x1f\xab\xc9\xc1\x0e\xf9\xe9\xa8\xcb\x92\x86\xaa7t\x1b&f>\xef|\x9c0\x06\xbf\x861:\xd3\x8f\xe2\xe1\xa9/\x9f\x8c\xb6F\xe2\xe9\x9c\xb6\xc5\xa6\xe9\x068\x05\xaf1\xd9\xc9z\xe2:\xd8@\xc9\xa1\x11R\x12,\xb5h\xa1\x17\xcc\x05{x\xc5\x08\xdb.:v\xe3p@qA\x15k\x9fR\x16\\DA\x95\xbf\xd5\xec&s\xbaS\xe69Y\x89)\xb2$[\x95a9u\xbe\xb8V\xad\x1b\x93~\xbb\xc3\x8fU\x13,Q\xcc\xc1\xf8\xd1\x0d\xbcr\x85\xd4%.\xb0\xf9x\xcb\x14\x8f\xd5\xad\xea\xa9q\xa4\xf3\xa9y\xe0~\x92,\x8a\xdfQ6\x88\x8a\xca\x07\x09\xce\xa5\x996\x89\xc4\x8fY\x14\x12\x19,tT\xb1\xe7\xf1\x8c\xaf\x86?\x03\xff\xc5\xbd\x91\x94dk\xd2D)\x98\x9d|\x83\x89\x04\x8c/P\xabh\xad~\x13^\xca\xedSIv\x0a\xe1\x1fkh\xc5 \x99\x05\x04x\x08X\x05\x1e\x0e>\xc3\xb9\x7f3]\xd6\x872^\x8bV'^0\x9c,\x97\x19\x04\x94\xbf\x0c\xff$\x94\xde\"#y\xc0\xb0C\xb2\x09\xc7\x1d*\xc9\x9fD\x0f\xcf8\xde\x1d\xfb\x0e\x83c2\xa7\x0b\xd4d\xf9\xa0\x8641a\xe7\xcb] <8\x89\x01*6\xeb}\xd1\xcd\xa9\x1a\xce\x82K\xc3\x86*\xf5\xaaX\xdcB\xe9S\xfd\xa3\xdb\xa9e\xdc,\x14\x1c\xf9\xab\x1a\x0c\x8cG\xdf\xd0\xdf9\xa6\x7f\x80A\x88/\xe2\xd9\xc1q'7f\xb7P\xcb\xe9c>C\x7f\xce?4\xeb\xe7h\xf8\xd4@\xe0\x1f\xe7$b\xa6_\xbci\xb4\x1b;\x05\x81\x9b\xe5\x9a\xaa\xb0\x0d\x1an\xe22\xf2\xc3M\xae\xe5\xbf\x91\xac\x90\xe4\xf3\xe6\x1a\x01\xf4\xde$\xc409t\x82\xe3\xd25v\xc8\xe7\xce5\x0d\x85\xc3\x8a07\xe6\xca\xa6\x14\x05\xbf\xf2hrY\xec\xae\xc85+\x02\x1a\x9d\x0a[\xee\xc25\xb8\xda\xb4L\xb8\x0d\xae\xd3Ho\xf7\x0e\x95\xdd\x99HK\xcc/+\x15\xac\x9c\x80\x1c\xb3\xc5\xd4\x12\x87t\xcc\xb4\xf8>\xd2n0>&\xd2\xd1\xc1\x8dOr\x02\xbf\xbeO\xb9b\x1ch\x88\xdaT#u\x84\x1a\x9f\x8a\xbe\x0a\xad!\x8c\xb0C\xa5w^\xeb\xfaY\xc9\x10h\xcc\xa4sO\x00\x04\x8c\xbd!\x7f\xd3t\x92&x\x82\xd5\x92\xa8q\x13\xa5\x83\xb2\x87\x81\x1c\xb9{p\xb7T\\\xd7\xb2\xb9\xf6V\xb7N*\xae\xc3S\xfdb\xa1\x96\xff\xcfO\xb5pW\xd0\xee\xf7\x83\x1f\xa17\xb6B\x8f\x0d5s\xe4\x81'\xd0j{\x06\xa2\xe7\xb3%\x08\xbb~\xe2\xc7\xde\xfcEe<\x0ew\xc2\xc6[c\xfa]\xf0BK\xcb\xf8G\x9f\xa6]rZ\xf5g\x1aCdp\xa3u9\xf3hN\xdb\x82\xb7\x92\xfd\xe0R\xceB'\xd5\x8b\x1cP\xab\x19\x1e\x15\xf1\x16j\xe6{m-Q\xc6v>\xcb\xf3\xb4\xb0\xad.<:\xce\x1cv7\xdf\x1a\xaa\"\x1f]\xe8\xc1\xdfRf\x9d\x19\xc8\xcd\x8c\xa0\x02\xe9fW\xe0)(\x1d\x9c8[\x10\x86TS&\x19\xd0\xea\xc6\x04Yd\xf8\xf4]\x1a?l\xad\xb1w\xf7\x85\x80\x14}s5\xb0\xe1\xdc^-\xe0\x8d-7\xca\x03\xb5\x15\x0a\x82\xecT\x10\xda\xd3F\xd6t\x1d\xad\x13\x85\x95\xe94'f\xc4\x00\x0ew!\xd1\xef\xbe\xde\x06\x891\x1fA^\xa2\xbcU\x03\x85+\xca\xba\x86\x9ek\x04\xd3\x84L#\xdd\xeb\x04\x05\xb0\xe01\x02fi-4\xf7V\xfa\xbb\x14\x8a%\x17\xe5b\xbeP\xb6T\x17\xdf\x17\xcd\xd3\xd0I>\xf9z\xf5\x83\x05\xf5@\xeb\x86\x86)\x02\xc0\x9d\xe9G\x81\xda\xa4\xad^\x80\xdd\xe3\x01F|?U\x1e\xb6\xf5T\xe3\x8d=\xc2D\x00|\x06`\x84\x01\xac\x10MM?4E\xe5Rh5~Xp\xca\xf1r\x91p,R\x87\x8a\xb3y\x96\x7f\x14\x17\xb6\x18\xcc9\xa6\xb9\x99{\x11Q\x94\x0b\xfd\xb8>\xaa\x83\xefDy\xa2\xea`<\x99;\xeb\xc15\x94\x97\x0b\xcf\xa9\xf9\xb2\xa5\xe5\xa1\xf0\x0bZ&\xaeh\xaa\xf0i\xa5L\xb5\xabE\xdd\x17\x1d\xa7\x05R\x1e\xfcuH\xb0\xc8\xf9\xb7\x80 S\x94\xb8vO#\xed\xb2T\xe2\x88\x15\x9f`\x02\xa9\xbaV\xe2#a\xe0\xb3Q~KuEc\x81\"q\xe7l\xf1^\xbe\x89\xd6\xbexW\x92\xb2\xa6)\xcf\xc9\xc4\x92\x0aX\xc4p\xa5\xba\xea\x1fK\x08\xcf\x17U_\x13\xfa\x1aC\xa0\xa5\xcf\xf6y\xeb\xd9^\xaf\x9b\xfc/\x94:\xa9\xa0/\x89\x1f\x09JXX\x9cIm\xe8\x8c\xaf)\xc3\x91\xef\x9d`\x94\xb2C8\xf5eyG-\xeb\x80\xe8\xd7%3\xb7a\xbf\xdf\xdd\x81\x1f\xfd\x07\xa9\xd8\xe8hZqq*\xa6Q\xcc\xd1\xa7\x08\xd3m~\xd6\xdf\xd6D\xbb\xf5\x0co\xef\xe6\x05KG\xcbH\xf9pK\x97\x08\x0b\xa4l\xd0\xcb\xf9Pj\xe8\xe1\x9a\xec\x8c\xbba5[#Y\x9a\xb3\xfc#!\x81\xe3X\xa0d4\xa6\x81\x12\xc8U\xae\xe8-4\xf3\x92\xa1\xa2y\x0e\xa5\x1b\xab\xa1\x9a\x8e\\p\xf3\x8d\xe9\xf3M0\xeb~\x82Sqq\xa3\x88\xb57\xd0\xbc(~\xa6\xe0\xb7\xa9\xcap\xb9\\\xeb%\xa3\xc1yQD'\x1d\x9f\x1b\xf1\x0b\x05\xdc\\\xac\xf5j\xe7\x1e\x1e\x83\x89T\xbf\xe8\xbc\xc4\x01\xc0\xcf\xdfs\xbf\xdf}\x975\xa7I\x85\xd8\\\xe5tOS,\x91s\x8b:r\xccd\xd9\x17\x07\xfe,\xbd\xfd\xf8o\x8c\x08W\x14\xf8\xac\xe6\xbf\x0b\x11\xbe\x03\x0c\xfe\xee]\x16661\x89k\x93\xad\xcch3\x97O\x93M\xa1\x10\x0c\x83\xa2Do\x85\x97\x06\x15y9Sa\xdf\xbf\xe0\x89\xca<\x95\x8a\x932\xd98B6k\x93J\xa5\xae\xa8\xc5\x1cXm\xd7\x01\xae\x9e\xd7W\xff\x0c\x1f(\xf0[n\x90\x88-@\x91N\x89\x03\x05\xa9s\xedQx\xdda\xf7\x15\x0b\x1d\x86\x05O'\\\x01\xc4\xf7rN\xff+\xe1\x89\x9e\xcab\xf0\xb3\xb7)M\xc2\x045UiM\xff?\x07\xf1\xbd\x08\x7fY\xccT\xc6\xee\xe8&\x82A\xc0T4\xae\xb9+z\xf7r\x9f3\xdf-\x11c\x87\x94\x9dkq\x99@\xad\xa86\x83of\xa8\xc8\xc4^\xbb\xc1p\x1b\x86\xe7\x88\\\xc2\x8b.\x0a_\xa1C\xfb\xdf\xbb\xe1+\xf0\x0a\xb4\x8f>\xc2b8F \x9c\xfe\xca\x94\x1c\xa7\x82\xe8\xecG\x0c\x91,C\xd8\xdeN\x18#\x0c\x8b7\x8a&\xe1W\xb8\xac3R\xc7H\x88\xce>\x81\xcc\x93\x1c\x8e\xec;9\xeee\xfc\xce\xf2\x0fZ\x0e\xbc|q\xe9\x10\x1a\xb3Sb\xa1\xd9V\x15\xfbq\xf1\xcd\xe4\xce\xf8j\xa6\xe3\xfd\x92\xe0\xb4\x02\xfa\xcd0\xf1Hg#E=\x1b\xae\x87\x11\xf6\x8b\xc5*\xbd\xf3\x81/z\x8c\xdc\x80T>\x909>\xf0\xf4\x93\x94\xa2\x0b\xb8:\xf9\x07U\xf70Q\x1c\x9e\xf2\xf0c5\xba\xe1h\x18\xfbx" +
+	"\xad\xc6RwQ:0_\xb9\xce\xdf\x1fy\xcfK<\xf0[?\xf8\xfa^\xfcc4\\-\x06\x9c\xd1vV\xb0g\xbe\xd4e\xa9|r\xd0\xb5)\x93a/\x94\x88\x19Ys\x07#\x98.\x90\xccW\x8c\x91\x12\x07N\x99P\xf9\x03\x84\xcatq\x01?(\xf7<\xb3+o\x0b\xfe\xbe^O\xb3F\xe9\xd9\x84\x87\xae2\xa7\x9c\x94\x82\xd2\xdc)a\x87\xc3e\xe4C\x07\x85eho\xef\x12\x0e\x94\xbc\xfaf\x05\xdfy\xa3k\x14\x17R\x94\x14^aZL\xa2\x0b*}\xc7{Tb?-\xdfy\xe6\xa0\x8b\xa63\xba\x8e~8\xd3\x8f\xac6\x0b\x0fl\xb0\xc3-\xb4\x10\xf2\x7f\xf8:O\x89DT)\x7fC\xffL=\x13\xf8]\x9d\xe3\x7f\xefQd\x9d\x1f`\xdfS\xc4\xa5\xd9F\xb5\x9e\x14\xde\xd2@\xfb1\xaaM\x17\x07\xdc\x16\xd8\x89\x19\xbeX\x1a\xea7\xa4\xd1\x16\xb2\x98\\.\xeb\x9f\xe3\xea\xd6\xb1-\x1d\x0fPV\xd35I\xabg\xdf_\x9b0\xac\xb9\xbb\xcbMJa\x17\x07t\xe9i\x87\xb4k\xb9\x9a\xe5\xc9bg\xcdV\xcchC\xe2\x9f\x95=\x84\xd8F67\xd4RC\xe2\x8e\xe7\xeb0\xe7\xef\xc6\xa2\xcc\x12\xd2T|\xa8\xfd\xf8\xec\xbf\xda\xa3\xdf\xba\x94\xc0$\x89 \x9cZZ\x08\xa3\xfcj8\xff3\xd4\x1d\xeeFZ\xe7=trjA\xcf\x0bC\xe1h'\xe9.\xe3\\\x0a\xb4\x05\x8c\x1d\x80o\x8b\xda\x8f=\x14\x87\xf0\xf1s\xbc\xc6D\x1ah\xc75\xce\xb0j\x80\x91Yc\x87h\xdb\xa8*9\xa3\xa0\xe2\x16\x15\xa5\xa4\x13\xe3\x13\xb0~D\x02\xd3\x12Q\xc9\x95\xac\xaee\xf6\x0b\xdb{k\xea^n\x94\xc8~\"4{\xa8$\x9a\xbdB\xc7uN\x9d\xa2x\xcf\xfc\x0f$z\xa5F|\x80GH%\x8f(\x99C\x0f[\xbbHM\xd0UCK\x7f\x91\x983|\x00\xae^\xa1\xcf\xe1\xc3\x91\x84\xef\x8a\x7f\xda$\x02X\x97\xa9\xf9\xce\xca\xd4\xfc\xfd\xff\xf6\xf7\xff\xfc\xf7\xff\xeb\x96D\xcd\xca\x08%B5\xea\x0a\xc5F?\xd6\xaa\xf9\xd8\xb2\x02\xc8\xe1c{\xe6\x83G\x06%\x1e\x11Q\x8d\xb1\xea\xaf\xcd\x93\x88\xaa1\x0f\xc1\xfbM\x15\x18\xf5\x9e\\wqq\x80\x90\xbe\xfe\x94\xb0\xb7\x85\xbfR%\xc3\xaa:\xb1\xcb\x8d\xfc\xee\xa6,k\x92\x98|\x16e\xc7\xd4Z\xfdPe\xff\xef\xb0\xe0\x9fR\xd5\xe7<\x19\x91\x02\xac\x91\x7fpS?F\x9b[\x1bh\xed\x00\xf2\x08\x10\x01\xf69\xbc\xdc\xa5\x8d\xb0;fq\xec\xee<v\x12\x91kb\x8f\xcc\xa1\xf8\xa4\xd0C\xb3[~E\xd2/y\x96\xf6.\xa3\x11\xe1\x1a\x83\x93i\xfaD\x8a\xbe\xea\xab\xa6\xe8\x9eL;[\xd0\x10D?\xac&.2\x8a\x00\x12\x8e\x16\xae\xe7S\x13\xb5o\x15\xb1\xf8>\x8f\xd5\x95\x04\xf4\xc0\xa9\xd4\xd8>{_\xe3\xfeM\x82\xba=P&\xe0\xc8\x89\xad\x98\xb4\x8a29\xbdW\xdeN%M\xb0\x82\\c\x95h\xd0ii\xf9dD-\x92\x9b\x9f\xf1T\x10W>\xd7w\xe2\xd6\xdd\xd6P\xf9i\xbd\xaa$\x0dA&\xd9\x97\xc6>\xc8\xe5\xaf)#L!G\x1d*\xf4$\x1f]\xd2K\xd8$gB\x92\x84\xa03*\xe1\xe1\xdf\xd6\x0cY\x8e\xa4\x17$\x02\xf5Y\x04\xfea\x1e\x09\x98y\\\x13O\xd0C]\x0c01\xe9\xd1\x04}yG{\xf2\xddR\x9d\x83\xc2<\xb2\x17\x8b\"y.\xdc\xe8\xd5ssTJXs,\x06\xf8\x17\xca\xb2\xa8s\xa6\xd5m\xdbr\x16\xe1'Vz\xa8\xbf\xd6a14\xd0\x7f\xd8\xd6\xe30\xa0W\xc9\x0d`\xff\xd2-\x04\x93\x90\x10\xed0\x96\x07\xe1u\x88\xce\x8e\xccQW\x0fi\xef\x12R\xe6\xc3\x02\xcd,;{\x06]\x93tL\xe3\x9f\xa3A?\xff\xe8\x85\xf2\xc6\x12\xbe\x09$\xdc\xc5\xc4r<>\xf8\x96<\xa1\x00\xf0]\x07\xd7\xbb\xcd \xece\xb2\xa99\xfc\x98\xfc-\xe3]).\x15\xd3\xc2\x8b\xa6\x05\x0f\x00N\xd7\xb6\xacG&\xc2\x04\xfd\x95s\x12\x96\xd5E7\x10g\xf2;\xe8\xa1Y\xf0\xa7,\x8d\xf7\x7f\xb2\x08\x8c\xa9\xba\xc1\xa4\xc4\x83\xfd\x95$7\x84\xd0\xbe\x85\xce\xd8\x13\x04\x01\x16\x87\xe4A\x8e;T6\xf0&\x1b7B\x9d\xc27\xa3\x90!\x09\x16[\xf67\xa6\xda\xfbi!\x9b\xcc\x895Zxg\xfa\x91\x1c\xceK-\xcaVo\x81\x9ct<8\xe4\x10d\xe2\xe5-)\xcaW\xb3\xf0\xbb\xab\xfa\xeaJ\x85\xd6\x84\x92\xc7\xe5\xbc2$\xfd\x94\x08\xd2\xc7\xbc\xd9I\xe071\x8e\xe1\xa7\xa4\xdfp\x0e$\xcf-\xbd_+tb\xa7\xc9\xe0\xe3\xa7\x99\x9c\xfb4c\xd1s\x8c\x0b\xd5\x9eH\x03\x15X&j\x80\x0e5\xaf|]\xa4\xec_\xbb\x05A\xbe\x00\xc14\x1d\xf7'd\x87H\xbc[=>,\xa3\xd7\x81\xa6\x8c\x84\xda1\xf6\x0d\x85,\x924\xc8\x0e\xed\xaa\xf3\x0d\xb9f\xc6\xdf\xa2\xf3\xaf8(=C\xc5\xd5`]*\xc7\x0f\xc5Or\x06\x99\x9dB\x09mA\xd5\xfe~\xfd\xf1\x0f\xfbt;r.\xa6H\xe9nX\x9fr~\xb11ac\xb74\x04\x12u\xdd\xb7\xcdt\x92H:kUZK\xf1)\xa6_?\xe1]\xc1\xf5\xc7\x82KI\xf5\xf9R\x0dW\xdf\x83\x90\xf7\xe5\x16\xd8\xef\xd4\xdb\xb0^\x13\x15,\x15s\xcb\xed\xd0\xc1Mp\xd3IQ\xea\xb8V\xc3\x13\xaa'M)\x10\x0co{`=\x16\x1cq\x0b\xdf\x0dk\xbb\xa3\xd5\xe9k\xb7\xf4a7(\xdf`\xdc\xec\x91\x81\xdc\xe2Z[\xaf\x1c\xaf<\xd6\xc8%\x1aQ\x97{\x947\x99\xa8\xed\xfb\xe5^\x91x\xce!\x1c\xd8\xae\x80\xbb\xf5\xd6\xd5\x06\x14)\xb7\x12\xc5\x1b^h'\x8a\x0c{[n\x0aeQ\xa0Tr\x97\x9a\xf1\xda!_\xf2)c\xbb\xb1\xaa\x19t<\x9e\xb0\x1eUCkl\x81\x1f\xdeGH\x08\xf6s\x9d\xb5\x81-Ng\xa3T\xe9\xe1?h\xaayRC~)\xcem\xf8\xeap\xe7\x83\xc8\xd6@\xd1\xe2\x81\xc5M9\x98*\xba\xb9~\xf2a\x8a\x99\x94\xd3(\x16\xf7\xcf\x82\xd7\x96;\x94\x1e\x8f\xb8\xa4\x97\x89\xca\xb5\x8a\xd7\xc2\xe0\xa2\x10&\xf4\xf1\x12\xd4\xb6a\xae$Y0Z\x0cM\x85E+k\x906\x1a\xdbS\x85\x149\"\"\xc5B\xe6\x93\xa4l\x93\xf4\x08\x8a\xd2\x9cy\xf3*\xa3\xf4=\x9d/\xb8\x05V\xe4\x1e\x04]\xf2\x13\xb7\xc7\xa8s\xd2\x18:YT\xba+\xdd\xe8z\xf7\xe1\xa8\x80T\x83\xb33\x85\xa1\x90\xb9\xc8>sD\xf9\x9f\xc1I\xca\x8d3S\xe1Wr1\xbeS\xc3:\x83\x8e\xbfj\xc6\xea\xf1\xbd\x80}57m\xb8D\xe5\x06\x0a\xbc\x03\xc6\xcf\x8e\xe1\xac\xbf7\x1c\xf8a:\x07o\xd5\x80]\x0cE\xf34\x99EB\xa9wO[q\x80\xa6\xf3T\xceH\xc59J\x07\x85\x98O\xf2\x1b;\xa0\x9d\x90Q2\xf1\xce\x8f\x9ft\xa0_\"\x1fQE.\x01\xa1&\xef\x16\xb5\xa6\xb6\xea\xa0>\xb7!\xd6\x9d\xb2\x84\xa1ucB(\x81>!9V\xad&\xc7e4\xeeu\xa8}\xb3\xccMO`P9<\xc9\x1c\x19\xb6\xa6`\xa7m\xcbS\x9a\xbd!\x16\\.\xe9\xb0\x8b\x0a\xe1\xb0\xc4\xd5\xd2U\x1d4\xe3\x82\x98$\x89\xf1[\x17\x14\xefB\xbd\xad\xa4\xbf\xca\xd8\xd4K5\x84\xb1d\x95\xe1N\xd7\xcd\x96J\xc6\xcb\xa5\x0d+\xb1\xac\x90\xd3\xdc\xaaab\xee\xbe\xa8C\x9f\xa1\x04h+\x9b\xda\xf5\xbcdJ\xa9wJ\x05\xa1\x0d\xaez\xa1\xd1\xa674\x15\xd5\xc2p\x0a\x8d\xae\xfe*\x8c\xe0E\xf1e\xce\xb4H\x97V\xe1\x7f\xf1\xdb\xd8i\x90\xfb]\xf8\x84MXq\xc7\x8e@\xf8\xc8r\x19:\xcd\xc3\xd5\xc3Q/f\x03\x87ub/\xfa\xbb\xd8\x86\x7fZJ\x99\xc5\x1e\xfc\xe4v\xf3\xbb\xfbi\xe6\x94\xeb\xc1\x9a\xde7\xc1 C\xc2\xee\x02\xa5\xd0}rZbkih`\xf9'\xe8'\x87\x04]EVI>&\xb9\xaeG\xdf\xc5T\xd3Q\xbc\x94\xc9]\x93\xcf5\x15y8\xfcg:.1\xc3S\xa6\xfc\\\xe6\xda\xf2\xdc+_\xd1\xba.\xff\x0aC`A\xdfP\xe8\x13G\x06\x8b)\x8c\xaa=\xde.\x10/r\x05wy\x9f\xdd\x13Y\xef\x1d7\x86\xcc\xbatK'\xb9\xa7\xa2$\x8d\x8b\xd9\x93\xafcA\x19k2_R\xc9\xd7\xbd7;$\x8b/\xf8\xed\x07\x11\xcd<F\x17F(IlKB\xf1%2{f\xe5\x1c\x13|_\x10\xf0sm`H\x92\xac\xb2\x19\x0c\xd6\x93\xdf\x06a\xe0j7\x08#\xac\x18qur\x04\x11\x9e\xee\x94\xe2@\xb1\xfa9\xa6\x8c\x8es\xab>\xa7\xf0\xdb']\xc5\xb70\x03\x04\x9a\x15-\xb7\xc3\x11\xc9*DEq\x7f\x92\xdaw}8\xbf\xc7\xa7\xa6\xb8\xc1\xd5\x9d\xb6\xb5\x98d\xcc\xb4\xbe\xcf\\\xba\xea)|\xeeG\x01{Y\xcc\x90P\x07%\x19\x0c!\x07$\xbf\x02\x11]\x8b\x05\xaf\x05]\xe4\xc6'\xa4\x83\x8d\xcf=\xef9E\x9b\xc6\xf3=s\xcf\xb1`W\xefv+4}\xb2\xed\xe5\x944\x8aQ\x8f\xfe\xe1\x0a\xc5\x00\x93\x1f\x8b(\xf9Z:\xce\xcd(\x8a\x89\xfev\xc0}\xbaC_i\xbe\xe5\xe8x\xda\xf2\xc6f).\x8c\x15\x8f\xef\xc3(\xf9\xfe\x99k~g\xf2R\x94T{\xa2\x0eI\xdf?\xbb5\xa6C\xadAp\xda\xc1(.h\x15\x11\xf4=\xf0Hr\xd1\xa2\xd0@\x84w\xca\xc7\xbdJz\xb1\x80d\x0d\x93F\x1f_\xa8\xc9\xd9\x9a\x0d\x11|\x0b\xddo\xe3'\xcf7\xc6\x19AA\x08\x86\x91\x98\xc2\x19\xb6\xb5\x0c2\x18\xf0\x03H\xf3e\x0c*\x17\xba\x96\x88\x17\x95\xa0\xf5\xb2\xea#\xa2%`^\xc5$\x1e\xe2\x8d\x1f\xd3\xd8\x12\x1b{\x8b\xb0\xf5CRB~\xfeI\xd8\xads\x1f\xcb\x88Ex2\x0fyjdi@w\xeaN!\x8c\xe9B-\xec\xe2\xeb\x1b\\\x13\x99\x85\xa5\x1cR\xcc\xdbq\xd4\x14L\x0e\xe7\xb6\xfbG\x86\x1dG\xb3B\xdc\x89ES\x0d\xae\xacA\x96LV\xbf\xc78\xb5\x83i]\x06\x8b\x11\xb8\xc7\x94\x8e1\x96m\xb4\x9d\xfe\xfd\x1a6\x80\xc8\xa8\xcc\xb30\x05D\xc2\xedI\xd8x\x95g\xc4\x86 \x98\x88E\xf0\xc4\x880n5tE\x1d\xa2\x8b\x1e~\x19\xbd@\x07\x04\xf5\x1a\x9b\x10\x99K\xaf\xfa5\\\x05Etv\xeal\xcd\xcd\xe7\x171|xQ\xf2\\\xd5\x06\xf2\x92|Oq\xd0,O\xe2\xc2\x93\x14\x07\xce?\x1fg\xa1\x0f\x95\x8c\x17S,\xfcQ\x04\xc2\x1e\x8b\x8b\xa3%\xe3\x184\xbd\xd8\xb4k\x8ao\xa9\xac9\x8d~\x8e\xbf%\xfc8\xfb-\xd7\xea\xf1\xa9%\x8dZ4\x877o*\xa6\x9a\xad\x88$\xe2\xae\x0fS\xe2z\xdf-GF\x9cv\xc2w\xc8\xb2\x84\xe0\x1a\x84\xb4\xea\x84\xc7\xa63\xc5\xac\xd2\xd5\xb6\x0d\xa5\xbf\x92\xf6x\xd8\x80\xaa\xab\x83\xd7\x8a\xe2i\x94F\xf0\x037\x05\xf0.u\xe3\xe4\x06\xa8aJ\xde\xf6X\xb5U\xf3\x1b4\xcb\x8c\xed\x9f5\x18\x87#\xc2\x94\xf45\xcb\xb2\xa0\xd9\xfc\xec\xe0\x1c^\x1a\x0cejhW\xd2\xc3\x16*\x00\xfd\xc1r}*\x16`\x87T\xd9\x8f\x9a\xf7\xc2\xacO\xfeU\xc7\xf4P\xf0\xc3\x15\x8a\xaf>\x96\xfd\x09\xef\x01\xda0;y\xebY\x88\xe4k&\xa2AL\x0f~\xca0\xc2\x97.X\x02\xb5<\xd7\xaae$\xa4E@a\x18\x0c\xae\xb8\x85W\xe8\x8b\xda=\x824\xc3\xfb\xa9\x1a\xa0\xa8\xa1\xf5\xf4\x11\xfe\xc5T]\xa0=e\x19TdB\xb5P2\xe8K\x184X+\xdd\x0d\xfa3\xbe\xca\x1e*\xa1\xd6\xf5\xc33 @\x1a#*z\xba\xb3\x82\xf0\x1a\xef\xf7\x09\xa9\xcd%\x1c\xf5\x04\xc60\xcd\x12\xed\x97\x1e\x8a\x8c\xee\xff\x94j\xebs\xcb\xe7\xaa\xc4\xe9\x81[\xcd\xd0@\x84\xcb\x99>C\xea.\x94\xde\x1fW4U\xeb\xc2Np\xf4q~\x97\xc1\x83\"\xa1\xe6\x19_M\x93b\xf6\xad\xe6c\x94\xe8\xf3\x94\xddm!\xcc\xd2\x0eU\xb7/Uv\x9f\x92A\xa8atX\xc4\x08\x7f\xd3\x19\x89\xef!\x18v\xe3\xa9\xf9\x9c\xdecH\x89L\x15/\xed\xa2\xd4\xf6\xc93\xbe\xe6\x8fq\xda\x96D+\x19\xa0\x9c\xb03SY\x08s\xef\x9c\x02\x8b\xac\x9a\xb1\x10\xfd+\xf4q\x9eH\xd7Y5#\x10\xf0\xdb\xc0\xed\x8c\xaf\xa6+X9\xd2a\x98@cBj\xa65~7\x8br.c\xb0\xf5c\x8d\x17\x0a\xfa}\xbah\xfc\xa0(\xa2M\xa3\xc4\xe3LK\x90U\xf0b =S\xd5_ \x17m|\xd1\xb8A\x07\xbdO\x8c$A\xd43\x9c\xd2i[\x0e\xadd&\xce\xe8\xbc\xba!m\xbd\x8a\"exb\x9b$[D\xc4@\xa3\x89\xb9&+\x0f7\xfe\xc4\x90Q\x1b\xf9\x91k8I[\x16~rFS\x1f'\x09\x1f\xf9\xac\xabm\xc3C\xfe\xd6 \x82\x17\x97\xce\xac\x99\xa28\x9f\xed\xe4,\xae\xa9\xfe9\xef\x97\xe2N^\xd3zu\xc8\x18\xfdR-\x86H\xf3j\xc4\xf2\xf1\x97c5US5\xe2\xc1\xdeA\x9fQ\xde\x89\x1dc\xdc\xe9\xa2\xb6\x89\xe02>l\xcb\xaa\xfdLL\xbd\xd0x\x94\xb5\"\x0a\x8d\x90O<\xfe\xfb\xf0\x8d\x0a\x8d=\xc5\x09\xccWI\x87\x9a\x00\x8d'9y\x9b[\xa0\xed\xc7\x1f\xdc\xa1\xc6\xf6\x89\xbdl\xb8A&\xbe-I\x05\x960\x96\x06\xf3\xb8\xa3:\xcf\xd3\xd1\x01\x8c\xcf\x00m\x0b\xe1\xa6[\x80\xe2_\xfc\xaf\xbf\xc2\x04\x85\x0b\xbf+.\xf8\xa3.\x0e\xec\xe2\x09e\x1d\xf7d\xe0\xc3r\xd8\xc9\x84~\xbf\"\xb4Z\x8ce\xd5\x90$g\xa6\xd2h\x14!\x0dL\\\xb6\x84e\xaf\x8f\xe6\x87--\xce\xba\x96gkh\x0d?\xaaR\xe1\x9e\xb6U\xdc\x88\x92n)-qJ\xc5\x10\x948\xe7\x80Cw\xdc\xdak!8\x0c\x05\x13\xa6RI\xbe\x81W\xa5R\x917\x15\xban\x98\x06\xaf&<C\xe7M\xbc|\xd5\xf7\xd2\x02~\xd0\xcd|\xcc\xe0^tg\xee\xc1\xf5\xef\xb75\xdd`\x02_\x80\x1b,jld\"\x15\x1e]\xdep\xf7\xbaY\xb3@5\\\xac\x85\xebV>\xe6\xd57)7\xef0\x81\x1ei\x14Z*I\xfd{\xda\xcf\\\xd4\xf1-\x98\x1a)\x9d\xa4\x94\xc6GSE%i\x11\x08>\xc6C\"\x864\x12\xf4\xc5\xde8\xaeV\x85\xa3X,\x19\xf9 \xec\xc0\xb8\xc9\x17\xc3\xd3\xe5\xa9\x968\x19\xc1e\xe7\xf0\"\xc1_\xb9p\xe6\x94\xbe\x06W\xc8\x9a.}\xeb\x7f\x8f\x1d\xd8\xad\xf8\x96\xb6\xd5_H\xe80L\x9b\xc0\xab\xb4\xdc-\x07\x09\xfe\x9eN\xce\x08\xc9x?\xd3\xa4t3a\xae0tr\xec\xee\x90\x81\xfb\xe4\x8a\x11zH6\xbd\xb2\x9b\x0b\x03\x93\xc2\xdf\x7f\x80\xe6\x85\xed\x077^r\xf1g)\xd1\x93\x17]B\xbf\xf8g\xc60\xef\x94a\x0b\x1fi3\xc78\xf5\x9c\xb5\x10u2Zj\x94u\x90\xf4>\xb8\xa1\xa8\xfd\xa38\xfd\xab\x8d\xf9*\xbc!\xfb\x0dE@0\xbf\x11\x06c\xf2\xdd\xc5\xd7g\xfa\x91\xe9\x8f\x9a\x83/\x7f\x1b{\xb0x\xc9\x06\x11\xd3\xda\xd7\xce\x9d`B\xfd\x1cn\xa2Oz@\xf9\xbc\xc2v\x96/\xb1&\xc4|\xfb\x9c\x8e~\xb1-\x0a\xf7\xc3\x02K\xb5\xa2\xe1$U\x93\x0f\x1f3\xdfS\xccy\xa6\x1fR\xc3h\xa0ESBA\xae\\\xcb\xd8]Z\x05\x9c\x89\xb2J\xa6\x9c\xac1oM\xe5e0\x87to}\xbc]\xe6\xcc\x17\xe6\x01%Te?\xda\xf0\xa92\x83Cx\x1fi\xb5\x9e\xe9Gd@H\xd2{T\xe0&uYN\xec\x0c1\x8ch\xb4\xb7_0v\xbc\x10\x0d\x1b\x147\x9dG\x04\x96\xb5$1\x89\xb6C\xaf\xcdd\xc6\xb5\xe1\x1c\x0b\xa5\x85\xd0\xa6\xc8\xf0\xc6>\xb6\x0b\x0c\x81\xcf\x7f\xde\xca\x9av\xe5~\x991\xb9\x08>q\x8f\xe2\xd6\xfb\xe2\x02\xb5\xbe\xc7\xb87T\xc9\xfdr\x97OEs\xc4\xca\xc0\xcc^Q\xe8#D\x00\xca\x91f\xad\x7f\xbc\xd7*\x97\x8a\xc3\x95\xae\xad&\x10\xe6>L\x0c'$:\x16\xfce3 x~\xe0G\xe6p\x97\xa8N$R)G\xdbLq*\xd6[\xd1\xf8\xa8\x09\xd1%\x95b\xdc\x89nJ\xda\xfb]\xef\x12\x056\x8b\xd8\xe3\x85\xfc\xe0Z\xb3\xa8\xb8(\x1f\xd5bm\x13%\xf90\x0f0\xc1\xa4z\xcc\xef\xed\xed1\x9be\xc6\x09\xc6\x7fl4\xd1T\x82yV\xb2I\xb2\xc2\x8c'+\xdc\xbf4\x82\x8f\x1en~+\xcd\xffzE\x8f$\x91#\xf9=\x8a\x17F\xebB\xbc\"\xf9{V\xeb\xfc\x1c\x85.\xd8p\x11\xc8O\xce\xbfG\xd7\xc7\xa0=\x04u\xa4\xe7\xc1m\xa7\x93\x80k\xc3\x01[\xfc\x19&?5\x89n2&\x9fJn\x02\x9d\xfc\x00=bPE\x06\xc2\xf6\xb9\"\xd4\xcdV\x0b\xb0X\xf0\xc5\xe7y-+9\xd6\xf9\x0f\xc5G\xa4:\xf4\x83\xa7Tqx\x1e\xe2\x09\xa8\"+\xcd\x95\xaa\xf5\xf1\x08?\xae\x90#b\xc8\xfeb[F\xf0c\x12N\xd7PR\x1eE\xcea\xba\x0b9c\x8a\x07\xff\xe01O" +
+	"\xa9\xe6]\x11l76-\x0b\xf4\x14\xb3Un\xde\xcb\x92R\x16\x03\xf6>e\xde\xfb\xd8\xd8)X.\x920\x09L6Z\xf7I5_\x7f\xf3;\xce\x1c\xd6QI\x95:\xa4G3\x9c\xf7V\xd3\x91\x0bh\xfc\xe3\x8b7\xfa\x00B\xa9\x15\xf3\x03\x82McL8k\x00\xf6\xcfzd'WK\xa84\xa8\x85\x0c\x9b<S@\xb0\x95\x8cM\xb0\xed\x9a\xcb \xa7?<?\xd5\x05\x8e;5\xff\x96Z\xc2\x82\xba\xb5\x1aa)3X\x1e\xf2\xaez\x86\x06\x81g\xf3\x8e8$(\xd38H\xbc\xfc\x1c\xc4=\xab\xcd\xd9\xe2\xd5\x16v\xf4\xf5\xab\xdfA\x8b\xe7rM\xa2\x19+\xde\xa5\x1a\x90\x0e\xef<#\xc5KE\x1bd\xbb[\xc6y\xa9gP\x9e\xaf#\xda?\xa8wI\xefusN/\x0d8\x96h\xbdJ\xa6\xf1\xa5\xea\x13/j\x05\x00$\xbc\xb1Y\xa3w\x19kt\x1d\x85i!)\x9a$\xce\x12\x1e\xb0CSa\x1d\x8b\xdc\x01\"t\x8f\x154\xd4\x93\x08\xd5\xdc\xac\xbb\x141\x81-\xb9$\xc7\xc2\x87bmx)\x9e\xa3\xac\x17\xc1\xeb\xe2\xa5P\x12\xcd\x88\x9a\x88\x9f\xd4?\xc3\x04;\xd4\xfd>Y;\x13\xbb\xcf&?&\x04\xd3*\x0a\xb5\x83\xcc\xc0\xbe%%5x/\x1by\xb6w(+\xa7\xb3\x9f\x15\x81&\xd7\x12\xfe\x8e\x7f\xad8\xbe\x0c\xbcH\xe4\x9e\x85z\x1854\xa7\xb4\x1c\xd3_%\xc5\xa3\xa4K\x8d\x90.-J\xc6\xcf\xe4v\xe4\xe9\x9e\xda\xa7\xe6\x1c^\xa0\xdf!1\x88\xdb\xedn\x0fE\x91\x8bN\xf1rt\xb6\x1b\x95\x9f?\xde\xbc\xac<\xb2\x99\xc2x;\xb7\x08<\x0a\xad\xbf\xc1\x02sj\xe9\xd4Ab`t\x92\xe2\xf8P\xac\xb7:\x8aOzO\x8f\xfb\x14 \x0a\x9cG\xb2\xe6\xech\x05\xb7\x19\x1e\xc7\x14\xaf|Q:+\xd7\xa5\xbc\xdfs\x06\xae/aB:\xd1(\xd4=\xf8T\x00W\xf7\xf9=\xf6\xd29\xed\xda\xceF\xe2\xd7\xdf\xcd\x04A;\xaf\xbb;Uj?\x89^\xb4\xd4U\xa8i\xe4E\xf1\x07\xaba\x8a\x1b[\x9e\x80Pk\xda\xe1iD\xdc\xfa\xc9'\x82\xa8\xcf\xd5\x15\x8f\x1d\x16K\xb4b\xa6\x05'\x92\xcd\xef\xd0r.Q]\x1d\xd4\xbb\xa1\xf1\x08\x17;f\x9d5\xab\xc1\xcf\x1azd\xdf\x11\x95\x04=*\x03y\x14\x12\x1a\xabh(\xe9:1\xe5F\xb4\xdc\xc3D\x9b\xcb\xf2{\xf1\xcaM(\xbeRv/\xe3\x0eli\xdcm\xf8\xfbH\x14\x94U\xc6:?\xb8\xd2\xd6%\x9a\x1c\xe3\\W\xe8\xdc\x0a)\xe3QY\xfc\x99-\xbf\xa9\x0a\x06+s%\x15K\x8a$\x1f\xf3\x9e\xce\x06-\xdb\xc8E\xe4\xb3\xd8yP\xfb\xfe\x8c\xaf[\xb2ViS\xa7t\xb8F\xd2\x8f\xfbw\x93t\xe0\"\xca\x85\xee\xc95|PR~\xb5\xd1<R\xfb\xd4\x09\x00\x9d\xbc\\\xe1\xc0V\x85\xa1{J\x04o\xbe\xb9w\xb7:\x10dsC\xe7\xd2I\xa6\x06\xd9\x1f\xfd\xb8:E\xdf\xc3\xaf\x1c<w,-\x0e=\xcb\xcfd\xca\xab\xe1W1\xea\x84\x11\x8a\xb6\x9a\\m6/\xde\x04\x7fx\x07~0\xca\xe3\xfd\xc875\xeb\xda\xf8\x19j\xafA\x9a<5^~\xbd\xf5\x18\xc1\x0dSx{\xe9\x96d\xf2\xa0aP\xe5!\xe99`\xac\xe81NZi\x93\xbfV\xf0L\x0e\x99\xc6H,\xeb\x85hJ\x19\x9c\xcb\x9ap%\xf4\xf1\xb2w\x96\x00\x1f\x7f\xa8=\x80_\x06}%m\x12[\x83\xf9*\xe1E\xcb\x92\x19\x0c\x948\xd0h\xb1\xa1\xc3q|0\xdd.\xa8\xbe\xae(\x04\xae\x82\x9e\x0c\xd8\xeb\xb1z\x84\xc6\xb4\x1e\xfav\x8f\x92\xdd\x1f\xd3\xee\xef\xb0\x9d\"Y\x159\x04H2b@\x09\x86\x88\x94\xf3\\9\x0fzJ\x85\x17\xee\xd7\xb2\x8fc\xe2\x92>XB?\xfb0<\xc2\x90~\xd8\xb5\x9d\xcf>\xbaC\x11o\xc9\x06~\xa9]Y\x0f\xba\xbd\x82\xbf!\xcc\xbf:\x90!\x82\xf47\xb0\x04\x86\xe9-5\x9e\xee\xc8*O\xe8\xe0\x84\xbf\x9c\xe3\x9f\xc5\xc5I\xaf\xd0mQV\x1aU<\xb7\x87Go\xe5<d\xa1\xc7\x02\xb1\x8fZ\xcb\x81\xc6\xefP\xa6#\xbch\xc2C\xcdj;x1N\x99c\xd8s2\x815\x1d\x18\xcb\xa4!Q\xc22\xe7\x0f\xd9\x1a\x8eww\xf7ODo'\xd9\xc8^\xb2rM\xccJ\x18\x17\x17\x9f#\xafV\xcbG\xe9\xf6\"\xa9\x12=(\xf7\x8dl\xe7\xe3\x8cl\xdb\xbcN\xf6\x07\xdb\x81Hk\xae\x11\x9a\xbe9\x94S\xd0\xfb\xba\xf62\xdc|\x017@\xbf\x8e\x8fEzZ\x95\x0a\x17yW+\xcd\xb0u\xf3\xe9\xe9+\x07\x98\x02y\xf0\x08e\x8fZ\xcd>\xff\x01\xef\xd85\x18wA\xed\xe9\xa4\xa5]\x87\xe7\xec\x05\xcb1!\xc7\xb74\x93?\xda\xda\xbe\xbe]\xaeE\xd8\x12w\x81\xc9\xb2\x8bZJ\xc3[\xd5\x87\xd8\x1f\x83S^R\xc5\x1a\xe9\xa4\xf8i2\x8cM\x85\x89j\xe8M\x0ex\x8f\xea\x9a\x83\xe2@\x0f8c\xca\xfd\xde2\x8b\xeb\xb7\xdek5\xba\xb3\x93\xed\x0e\x96Hl\xb3X\xfa\xf6\xdb{\x1b\xe7\x0fs\xdc0%\x07\xc2\xfd\xb2\xe7\xd0\xe0\x89\x0a}\xe3\x97\xa1\xc6\xdd\xa5\xba@O\xcbN\xcf\x0d\x1dQ\xdf\xf2\x11\x1b\x1b\xcb\xb7\xef\xf9\xd5\xe6\xf2{\xbf\xba\xfc\xc2\xbb\xc3\x12\xc8\xcf0\xe8A\xda&u5\x12\xc1\xcf\xde\xc5H\xef.\x1e\xdd\xaf\x11\x9d:\xba\x9a\xf9\xe3e\xb8J\x07\xf1\xbd\x82\xbdq\xfd\x87b\x9d\xfb\xb4\x85\xc67\x99\x14\x8f\xc7\xce\x9e\xad\x81\x8a\x1c\x0e\xf4Hk\xcb\x8f\x087\xc3\xb7L\x90\xe2\xfc)\x0f8\xce\xef-\xc7\xe5'\xeb\xaf\xd9\xb2\xd7o\xdf\xdc]v\xae!Go]3F\x96\x90\x90\x85\x1e\xc2~8\x1a\x0e\xd0\xf9:\x0c\xf1)\x9d\xe7\xbd\x9c\xe7\xcf\xe1\xfa\xae\xf3\xa7\x85'\xa4\xeb\xd2ad\xc8{\xfd_\xfdP\x90\xa1\xdd\x1a\xfd\xb7\xc92MV \xb4\xd0\xaf\xafQ\x8d_83\xd03\xc0\x16\x9f\x98\x02\xcdH\xee\xe8\xfa\x09P\xce\xb8\xf3\xb3O\xd0{)\xda\xe1\xdc\xd6\xc2\xdf${\x0b\\1W99S^\xb7\xe6[\xd9\x03h\xd7\x9a\x0b\x95\xae/]\x7f\xe5\xbeGNr\xa1$\x1e\x82E\xf2\xfd\xd5WWJ\xa4\xc8\xe7B\x10U\xac\xad\x88VY\x98 W:\x8cN\xb9\xbfA\x1d\xc1+[3\xf4\xdd\xfd\xfd\xf1\xb9\x17\x14\xc4\xcd\x91x\x93\xe5\xefW\xad\x0c\xff\xbeh\xaaV\xb6C\xe3)\xe9X'\x1d3\xd8\x92HY\xbc\x8b\x97$\x1e\xfd\xcb\xf8F\x16TB\x1bJ\xf2{\xf7r\x12Q\"\xeec\x09\xd4dTO\xd5\x90\xa8\x10kcT\x92\xdc\xec\xdc\xd88=\xcf0\xcb&z\xaf\xd1\xef\x09\x9b\x90n\xbe\xab\x82\x81n\x1aH\xbe\xe2\x14M\x9b\x96\xc5M\xfeF\x0c\xec\x00\x8f\x91\xfc$,\xf0\xd1\xc8\xd5\xf0\xe5vJ\xc8\x01=&\xf4\xd0\x14\xd2\x9a\xc8kK\xd7)?w\xf2\xb4\x88\xd7<\x19\xf4uhY\x99\xdc\xc0V\xae4\x89\xaa\xf2ix\xea\xaft9\xbaH1\xd1\x85\x0d-qS!\xdd\x862Ql\xb1\xa3\xe7*q\xb9\x90\x14G\x15I;s\xa75E\x9b\x18\x93\xf3\xbd\x87\x06\x8f\x8f\x19\x00/\x1c\xad\x0f\xcet\x05\x86\xb7\xca\xedG\x85V\xda\xc1\xa8E]\x8c(\xc9\x1d\xdes\xe0\xe7s\x17_\\\xe0\x86\x1f<\xee\xd0\x92\xe3\xe5\x97Z\x81\xd9\x02\x8c#\x8a\xb7\xff\x0c\x93\xeb\x8a\xd2\x11#\x82\xea\xdd\x9a&\x87\"*\xbe\x93B\xc0\xe8\x82\x0f\x97\x0a\x1a`}\xcb\x83\x96p\xe1Y?kI\x19\xd8\xb1\xc7\x16\x91\xce\x8a\x9f\x99\x89\xa0d>OF\x9eR\xe2\x89/\xab_1\x0f\x17\x93\xd7\xfdU\xd4\x08\xf5\xfeT\xc0W\x82\x8b\x05\x89-fr\xf1F\xf2PO\xfc\xd81%jn\x1dx\xe8\xa3\xff\x1exe\xb9\xd6`\xf6\xfa?nj\xb6\xe4\xab\xbe\xcdZ[$\xa1\x97\x1d\xd6\xa6iY\xce\xedO\x7f\xf5m\x03C\x0f\xae_\xea\xb8\xfe\xa8\xd9\xc1\x84\xd1\xb8\xf3B96A\xcf\xb5\xa5\xad{|\xb5M\x84(`V!\xdb\x8b\x09\x85\xd2\x15\x87\xce\xa3\xb6\x85\x974\xc4=2\x7f\xa26n#\xdf\x15&\xb8n\xe0\x8e\x19j\xec\xb4\xad\x99'\xec?\xdc\xe9\x19\x02\xe5\x9a\xcf~\xfc\x8a\x1d\xcau?\x90ca\x85\x08\xe9\xc6u\x19s\xe2-R&1\x89\xd2\x89\x1d\xd4\x8c\xa5I\xdf'\xf0\x1b\xcax\x89\x9b\xdbz\xe4H\xaf\xdd\x04\xdd\xa9\x88\xad\x19\x12`#\x18\x0d\xbd5E{x\xac)H2\xc8\x9e\xba?\x80\xb3\xcbD\x84\xf6I/\xb5\xea\x9f]32\xe16\xfdN\xe2\xb4\x06a\x8f\xbf\x81.'*\xe1\x09Q\x8c\xf9Z#\x8d\x14&\xc2X\x0f\x82\xdfDr%gO\x7f\xa9\x8d\xf4\xcf\xd6{*\x9d&\xe4\xf2\x86\x86\x98n\x16\xb3\xaf\x17P+\xffW\xd1\xaf\x8a\xf5\xe4\x89\x90\xb6;$\xf7\xec\x03\xac\x89sf\xbds\xdc4\x89qx\xd2\x9e%d\x9e\xca\xf2K\xa8R\xf1\x15\xe4P\x9b\xdcd\xb9\x03\xf1H\x11\x10\x8f\x94i\xd0\xbb\xa6\x92\x0b\xff\xe5,\xff\xc0\x8b\xecV\xd0\xfbb\x07Y\xf7\x8b\x15\x1bG:\x8b\x1d\xf2u\xdb\xbb\xa60\xdb\xc6\x99\xedq\x10z\xb1\x9f]\xe7\x87\xa3\xdc\x0a\xaf~}\x1b\xdb\xf2g\xd7\xc3\xe0\xf2\xcd`Q\xb2\x82\xa6HZ\xce4q\x9b\xa8\x83q\xde\xdc\xe9A\x7fx\x80\x1a\x02\x02\xc8~\xed\x0e\x8d<C\x17\xf5b.r\x81\x09K.\xc0\xb7\xee\xe6''\x86M\x8b\xb5\x04\x18\xbb_>{\xf7\xcd\xfdi3fn\xc6\xfb\xb1C\x91nk\x06c\x16V\xcbA\x84E=\xcd\x0d\x96\xb8\xb2\x0d\xf7\xc65\x8e\x9a\x97\xe8\x16\x1b\xf0]\x12\xe0\x18\x1a\xc3a\"t\x81\x0b\x95\x80;\xf6\xfa\xdd\xab\xec\x18\xdd2\x84\xc9\x8c\xfc\x05\xbez\xf4\x8f8S?\xd2?\xc3\xdd-\xa0\xaf:\xb6\x8e\xe8\xbb\xcf\x00\xd4t\xdaPDW\x8bO\xaa\xa7\x9b,\xb6\xf08\xf0\x0cC\x92\x1bH\x93\xe0\xf45\xe2\xfbE\xe6+g \x0e\xbbE\xe3\xccc/\xa8Ke\xe0\x00\xcfeV<\x0d\x84G\xc3dC\xd5\xad\x99\x03\xa6\xf9\xf9\x09\x83/\xfe\x97Dv\xd2\xa8s\xb0\xf2\x12\xd0\xc2t\x0e/G&]\x82F\x9f\xde\x86\xb2q\x00\xbc\x19\x80\xd3\xb6\xa2\x9c`\xef\xc3uoO\x13\x1a\x88\xb3\xfc\x03\xfb\xb3\xa5\xe0\x93\xad\xf6\xf0'\xa3\xb3\x81y\xc0\x01\"\xcb\x18-\xe4\xc3\xcd\xf7\x0f\xd0\x1cy\xe1\x0b7\xb7V\x1b\x17\x8e\xc0\x9f\x1d\x09\xda\x1d\xe0\x86_p\xdc\xba\x01\x96U'\xfb\x15\x0au\x03\xd4\xd2a~,\xe0F^\x86\xe1S\xb5\x02\x1b\xad\xa6C\xbbp\xc8\x14\x87\xa4\x1b\xfd\x18\x07\xbd\xf1\x93\x17\xe5\x96\x8a\xca\x0dx\x077\xe8a\xdc\x11\xfd\xbfK\xf33)\x0d\xb7\x1b\xd9\x1c4n\x11\xea\x86oP!/\x93\xb6\xde\x16e\xbb\xb3\xb6?\xf7\x01:J+H\xe9\x95fA\x83\xd6\x02d\xe0G>L\xd1\xc36\x0a\x1b\x0dKJd\\\xd9\xe1\x81H_\xadh}\xebk\xe5\x88\x88\x9f\xb3\xe1\x04\x16\xb0\xb6\xc6\xf2]B\x1e|q`A\xaa\xd4_\xe0\x84\xe8\x8b\x15u\xa0\xf4\x8dl\x93\x11b\xcb\xb3\x9a\xfd\xc4\x81\x9a\x9c\xbfI\xefC\x98\xf4\x06Zq\x9c\xb2\xc6\x00C3)P\xec\xd3\x0e\xbd2u\xf1\xfbpV4\xe1J\xaa\xcf\x17\x05\x04M\xf4oM-\x01\xc4\xf8\x01\x1e\xab\x16\xc6\xd3\x0e\xbd0\"Q\x99\x9bl`\x0a\xc6D\x8a\x08a\xe1\x93\xbf)\xd0\xcf\x08\xd2\xa5\xdc\xe1\xcc=\x8a\x80fC\xc3\x91\xac_)\xfcu\xc2^\xb3G\xb3K\x16]\x03\xf5\xc2\xd3#\xd68LM\x0a\x1e=\xe0\xe1r49:\xabS\xf9\xc5[Yy4\xc3\xda{\xd5\xf8{\xd0\xae\xc8X\xcb\x82\x8b:[\xe2\xa41;\xd0\x0c~\x8d\xb7\xbbKxk\x1e\x9f\xd2\xc3\xe3\xd7j!{\x9e\xeb\xe3`w6\xb3\x0c}\xebP\xc7>\xa1\xc4\xdf8\x19\x8db\xd6_\x96\x17\x0d\x1b}\x07\xe7\xd1Ari]Gi\x9f\x11\xa6\xca)Y\xd2\xa4Z:\x0b\x93\x18ka\x96\x9fa\x8f\xf0U$\xbfX\xbb\xe7\xc6\xff\xe2c\xf6!G\x0fZ\xb4\xf4!}+u\xa9\xf8\xee\x06\x11{\x88\xa4\xd9\xb8Nk\xb8\xf91\\?\xed\xa4\x9a\xd3\x8b\xe7\xda'\xe2\xf1\xf4\xae\x05'\xce\xf7\x81>~\\\x93\x14\xdd%\x88\x15=l\x89\x9c\xac\xb1\xca\x96\xf1\x09\xd9|/piln\xd1\x0e\x87\xf9{*O\xdeX\x10f\xd8b\xe1\xdd\x06/\x1b~5\x03\x8a\xee\x91\xc4\xba/[u'\xa2\xcc@}\xb8T\xfdp\x85 \x93W\x82\x1fc\xd3n\xb2w\xf7\xc8Q\xadI\xad\x9cR\x83G\xbc=\x01~\x8a\xcc!\xfb\xd0~Fo\xca\xda\x82\xd8\x96M\xd0\xb6x\xf7\xae\x9f\x84\xa4>\xe0\x93\xa8e)c\xb8\x8ag3\x0ffQ\xc3\xc5\xe1R\xe5\xcbq\x97\x04\x02%\xf0o\xc1)\x986.\xd6Uc\x83 \xd3L\xd5\x8e(\xda\x18\xf8\x8c(\xf6pyr]6\xa5\x13\xbe^~\xfc\x0c\x8f\xe5\xfa\x08b\xbf8\"\x8e@g\x8f\xc5\xe6\x96i\xc4\x1a\x1fQl\xb3\"\xa3\xa1\xe65\xae\x08\xfd\x16/\xf7\xdf\xa9\x82b\xc2\xad\x1d)\xb6\xe6\xb6!\xe6[\x96\xd8q3e\xa0\x98(\xce\xca\xda\x0e\xeev*\xa3\xad\xbd\x08\xe3g\x9e\xea\xc9\x06|]\x1e\xea\x9d\xc8C\xfd\xfd\x7f\xfe\xdb\x7f\xfb\xfb\x7f\xf9\xfb\xff\xbc%\x0e\xf5\x05\xe0\x97\xe2\x07\x08qa\xa0\x0fr}\xf1S\x0dP\xbe\xd8Tl:\xfc\x85fG2\xaea2i\xb8\xb1\x0d\x08\x9d\x19C\xc6heX\x93\x0e0U\x9a:\x15\xff#408\xd8\x96I\"\x07H\xea\x9fN\xe4E\xf2Q\xd3Z\xbdWh\x1d1\x12o+'}\x8e\x08/\x1a\xfb\xbf\x18\"\x91d\xc5\x91,\xb1\xe20\x90\xf8\x98\xeb:7h#@\xa3t\xd4c\xec\x0a\x7f\x89\xa9/\xc15\xdd\xaa\xa1\xc7j\xc7C\xf5+\xc4\xd5`\x1e\xea\xbf\x0b\xdb\x127\xc5&\xaf\xc5\xeb\xd5v\x98\xf0\x85Z\xbd\x8a\xf9\xce\x80\x06uX\x1e\xb8i?c\xecjs\xe3D\xa4\x92K5\x91\x0e\xfa\xd2\xb7\x12X\xf4\xfeZ\x0dPk0\x16\xb1ut\xfb\xfa\xd9\x9b\x9f\xaaa}\xa6\xdf\xc4\x99\x8e]\xf6\xbf\xc2\x10\xaeD\xd75\xbf\x08\x06\xaa&J}\x92z\xbab\x96\xe3_\xfcE\"\x94Rh\x9c\x1c\xd8\x01\xccDVK\x87\xe4z[)\xc4\xb7\xb1M\xdb\xe9\x92]\xe8:\xd2:\x01VIR\xc11\xc1\x92\x05HtH?\x1c\x13<\x09\xddN\xcf\xa4\x95\x7f\x81\xfaYh\xdd\x8c\x9c#\xbb\x91f\xf1\xd8\x1e`[\xb5J\xe4\xa1K\xb7CF\x89\x9f*\x0f3\xed\x1e\x88\x08>\xed@\xc5[\x0f'v\xe3#C\xff\x8e\xc5\xa3[\xe1\xc0\xe5\xa9c:\x9a\x9a\x16\xc0\xcaH\xe1;?$\xa2o\x93\xdf\xd4\xacW\xd2\x99p\xfb\xf3>?\xe3a\xf1\x95\x8a\x95w\xfe@\xb0\xc2\xd8\xe2Y\xb2\xfc\xd0\xf9\x8b\xb7\x1a\xbfl\x1b*\xe9sL\x9b\xb3\xe5\x90v\x93\x19)\xfc\xcb\xa1t\x8c\xe0\xc7\xaeDz\x1b\x96\xc3\xa1\x83}\x98\xe0\x97_\xff\x96i4\x99\x13r`1\x1a\xdc\x18\x85\x9f\xc3{D\xdc\x83\xb8z\x98\x0a</\x11.\xf3b\xc9\xa1\xcf\xa4\xe7\x93\xbbR\xbbp\x97\x8d\xc6X\xd50m=n\x0a*t\x90\xea\xbd\xb9z\xc0\x8e\x93\xde?R\xf6\xd5\xe0\xf4A\xf7\xa8\x1b\x9fa\x88\xc7\xae\xb4\xe1\xc4\xa3^\xb8='\x18\xb1\xfe-\xb3\xa4\xac\x9d\xb7j\xba\xc7\x99\xf6\xf2\x1bk5xKcX\x8b+D5\x16\x7f\x06?\x84\xa8\xd7J\xfb\xd2N8\xf3\xcf\x17F\xe6bVK\xac]\xd3\xb10\xcc\xd5\xe1\x03b\x89\xbeA\x1e\x09|=m\xeb8\xb1\xd7\x1f\xd6M\x0b#U\xf9\xa17\x8a\"-t\x19j\x9a\x0f<\xc2\x0e\x84\xd0\xbd/B\xd3(\xb7\xa2\xbb\xe5r:\x0eg\xf3\xeb\x9d,K\xc2\xda\x96\x7f\xf6\x02\xcdd+\xeb\x19\xe0\xe2\x94\x16\xc8]\xd2\x856>u\x97'L\x17\x85\xe9\xa3\x86\xf1=\x92F\xd5X=\x12\xe4\x04z!)\x1c\xb8\xdc\xa5\xa9\x1e(J\x87\xc4\xd9\x0d\x075\xc9\xdf\x941`\xfe\xa7\xd2\xb1\xd4t\xf8 F\xddR\xfd{\xb1OLH\xdb\x110\xed\x8aR\\\xc7\xe2G\x18\x9em\x05Vz\\\x95\x8c\x97\xbb\xa0A\xf4o\x8c\xea\xc8\xe0x7]\xb9B/F\x82\xc2-\xe4\x12\xa6\x0a\xd6\xe4:?\x9c\xb6e}L\xa3\xbe\x04\xce\x13\\\xdcP\x1c\xe6\x85\xa9\xa3\xa5\xddxo\x92v\xb1\x99\xf3\x89\xb1\xfd\xba\x05\x12*\x80\xc7\xaa\xed,\xbe\x08+\x8a\xe2\\\xf5\x93o\x8ej\x8a\x12\xfa\xb2\x1d*5<\xaa\xf7`\xb7\x0c\x82\x9a\xf5\x89E\xdf\xd7(\xcbc3\xdd\xad\xea\xfc\x00\xf5\xc9\x10\x81/\xee\x98-\x93\xf8\xce\xb8\xb1\x87Gw\x19\\{\xdc\xbcU\xd7;\xdb\x10K_K\\\xd0\x02:1J\xac\x18\xb4\xfb\xd4m\xc9\xc9\xe0\xfc\xc3&\x99\xf8\xd7\xf7\xc7\xf3\xfb\xb9\x97A\x0e\xc6\xca" +
+	"\x17\xab\xcfJg\x10;F\xf2^\x9ek\x06H<\x87\x15P\xb33\x89s\xe5\x8d dd\xe1\xfe\xab\xeb\xe1\xdf\xddO\xb3E=\x8e?\xe0`\xcfd)V\xfc\x8b\xce\xcf\xcf\xd6m\x0f\x83z\x86\xf1x\x95\xdd`2\xf4\xe6\x14\xcf;\xdc\xfbE@Q\xb8\x06]\xb8N\xbfZ\x12ZC\xa3\xe1\x13W\x0c\x1cr\x847\xdb$\xe1i\x03\x1b\xcf1n}\xdc\x13\xd9Y\xdc?\xbb\xd1\x19\xf3#HI|o\x845\xc5z\xfa3\x15.|\xbdC<\x00\xef\x00\x8f\xb5\x11\x1a7)\xb3\x8c\x18jB\x05\x92\xc0K\xe1\xa08(!\xed\xb1`R\x13\xd4reB\xb3\xc6\x0f\x1d\x9c\xd6\xd9\xcaN\x91\xef\x9d\xb3\x9eT\xf1#uWv\xffD\xaa\x07\xffB\xb3\x98\x88Y\xa8\xb6+\x0c\x93\xe3\x06\xdb\xd2q'\xda\xd6\xd8\xa7hw^\x98\xdcD\xacU\x7f;\x90$\xe1\xbd\x82\xe1O\xf4iO\x0bI\x17\x03<\x8f\xc9\xc4\x1e\x9a\"c\x1f\xee\xfcNi\x81+\xca\xfc\x12\xd3\xefl\xe4e!@X\xc9\x06\xd6KoQ\xb2PDA\xfa+\xe9x\x11O-~\x9a\xa3\x8f\x11\x9aX\x97\xde\x96\x1d \xca\x8bp\xe1\x930a3\x05|\xd5\x0b\x15\xba\x0c\x18\xf7=K\xc3\x89\xabaR\x9b\xa2\xd4Sx\xc1%I\xf3\xcc4k\x8bG\x0f\x7f%\xb4\xfc\xcd\xc3(\x19\xc3\xfc\x14\xf9\xb3\x9c\"xy\x98u\xe3\x12\xc0 od0\xc6\xd18%\xdbr\x10o\x97\xb78\xf1(\xe0\x02\xb0\xc8Wr\xc0-\xb1\xfd\xb5B\xe5>\x92\x05\xe2?/\x00\x1a\xe8\xc2K\x80\xfbY\xbb\x83\x05\xba@\x07\x93t=\xec\xa0\xf4\x0f\xdf4\x82/I\xf1v\x08\xee\x00\xf4\xc5\x0f0\xf8\xfe\x84\xd0\xd0\xac\x91F\xdd\xcb\xe4\x98~\x84\x1a\x88%\xc7]\xfc\xd6'\xe8\x80\xa1\x07=m\x93\xdaw\xa9!MD>\x18\xab\xab\x8c3\xab\x87i\xd4\x1e\x9a-\x07\x9c1\xe2\xb0\x92MB\xf1\x0e\xb9M\xf0\xec{\xe87\xaaN\xdf~\x9d\x03\xe4>\x8f[~Gw\x01\xe1\xcf\xfb\x14\x03\xb3\xc6\x9b\xd8\xe8s\xff\x18\xfc\x982\x92\x7f\xaa\x9d1\x0eA\x0f\x17w\xda&W\xdf\xb8J\xd4Zl\x18\xd7\x83\x03\x87J8\xec\xd7A\xc91\x06\xdf/\xa3\xd0\x92\x96\xbaC\xd4v:\x16c\x0d-\xaf\x8b\x03N\x1d\xd0\xef\xff\x0a\x0dL\x12\x0b\x87O\xa1\xeb\xbf\x83\xa3\x9c\xadO\x12\x08\xb9\x8bo|\xbc\xaf\x19$l\xf6\x90I(D\xbcg;\x98\xbf?Q\xbcg\xa2\xa1\xb1\xea\x9e\xae\x18\xfa\xa8~\xa8\x0c\xc3\xc9X\x94\xf0\xfd1<\xca\xff\xb2\x16\x1c\xd1\xa7\xd6\xa2\xa3-\"\xe6\x98\xd5\xa5\x86\xe8\xcf#p\x02R\xcdJ\x94v\xa54M&\x86\xcb\x9ag\xcf\xa9\xfc'\xb1\xba\x9c\xe9\x87\xc9u[m\x9d\x17\xdbL\xdd\xa4y\xd2\xc23\xa7\xcf\x0a\x91\xdd\x97VW\xea1C\xdb\xfe\x93\x7fpab_\x14\x1f\x19\xc4\xf1\xc9\xc5\xfe|e\xe8\xd3\xfeY4\x17\xe1-5\x18\xc3\x1a\xc3\xa5x\xda\xe3\xa4\xd0\x7f\xb4\x15\x0a\x7fW\xdc\xc9\x18F\x06\xefd[\xd3\x03\xd8\xe2\x0b\xbb\xd9\x1aGp\x83~(1}\x0e\xe8!\x07\xcc\xd1\x02\x86g\xebE\xf1Y\x8b\x0a\x0c\xee\x8b\xb2\xde;x\xb7\xa5\xcd\x8f5\xe5\xc2U\x04:y\x96\x7fp\xdc\x89\xd4D3I\xaa\x82;\xb0h[\x18\xad\xf19\x8a\xe1\x169G,y\x1a\xae\xde\x1d}\x9f\x91\x17;\x93\x12\xc2\xdcB\x94\x0aJT\x81\x13A!~\xa3\xc8\x0e\xcd\x0b\xce\xfa\xc9\x9cNR)T\x96\xb9\xe6Rw\x003\x94\x88\x048\xa6\x0a\xb8\xb1\xa9\x05?z\x9f\xa9)e\xde\x96$\xa4\xdc\xb2\xa5)\x92nn\x04\xfc\x12\x17q0\x1b\x07\xcc](-\x09cJ%VQ\x92\xa1\x10\xa60Y\x97<=\xddk\x0bT\xa4\x9f\x01\xb48\xfc\xe3\x9a\xf4\x8bm\xf2\xeb\x9f\x16\x12\xff\\\x98P\x8e<b\xf5\x9e\x0d/\x0e\x9e\x0a\xfb\xb1\x9a\xa6i\x85\xc0\xcb\x99\x81)]\x04\x83\xbf\xd8f\xa5\xfe\x0fI6\x08\x9d(\xed\xab\xc0\xdcP\xf1\xf92@\xed\xd2\xdc\x10\x1f\xb6\xaa\xc9\x14&=\xa3%uk\xed1a\xd6\xe8\xe8\xee\x09\xc8\xd5Z\xcd\x8b?\xc1\xc5\xed\xa0\xb1\xfe\x1c\xc5\xd1$1\x95\x9a-\xed-z\xcf\xc6\xeb?B\x0d\x8e~K\x15\xed\xa4\xbf\xb6\x7f\x16\xb5l\xe3\x17\xea\xb9H\x1b\xeb\xa4\xbf O=\x92\x80()7\xda\x0b\xb1O\"T\xf1a\x9bL:\xbb\xb9\xb4v8\xe7fk\xbc\xadg!|z\xc6t wf>\xa66\x15\xbb\xfe9\xa3\x1f\xc3\"+z\x80.c\x82;\xdamZ_\xbdZy\xaa9\xbb\x80\x0c:q\xa1d\x0b\x88\x7fKYn\xca\xbe\xae\xa5\xc48\x98\xd9A@}o\xa0\x93\xba\xa7)U0\xb0y\x0a.\x0b\xf5KD\xb8\xe4jD%\x8fH\x1c\xd1\xf0\xabj\xbb\xd0Sm\xd1\x8c\xbf\xc9\x9dcY\xce\x11\xcd\xda?\xb8&#J5\xda\xf8\xe1\xe9\xc2\xa3\xf2\xee\xfd`\"MM\x18 \xe3\x12\xdfo\xd2\xf2\xca\xbd\xa6C82\x84\xfbX\x08ly\xd9\xa0\x0e\x8c\xe5\xcfj\xa1\xdbJ9\xbe\x9a\xf5\xc4\xf0\xddE\xb7\x1f\xdd\xc3pJ\x84\xcb\x11\xefY\xee*v(\x18\xa0K\x15{\x94\xc3?\x89\x96\x90\xebM\xa9\xacG\xb6\xb2\x82#\xc1v\x88\xfb\x19iS\xed$m\xb6\xfbd\x96\x0agDv\x89\x0e\xa9\xa0\xc9\xd9 \x0c>S\xe3/\xed\xd5\x16\xe0\x09h\xa8\x12A\x83,P\x17(\x80\x12D\xcf\xaf\xb05'\xef\xb2Fr4\xa7b\xf3\x0d\xd8:{\xce\x04+\x96<l\xe4\x00\xba@\x9d=0\x1e}\x02\x00H\xabR\x9fG\xa5,\xa4\xba<\x1f\xc90\xba\xfez\xa6\x1fzz\xa2O3\xe9\xfe\xea\xef\x1d\x1a);\xf1\xca\x1d$\x14\x87\xaau\xc2\x19P-^%RM\xfc\x09L\x18M~\x92z3\xae(<\xd2p\xcf\xb8\x1d,\xbc\xf3\xaaLm\xce\xe1\xf4\x0e\x8f'>\x96\xb1\x93\xfd_\xe7L.\xdd\xcd\xb5\xae.l\x01\x8d\xb8/\xc3o\xee\x0f\xee7\xb3\xc1\xd5.\xc6\xc8a\xcb\xc21T\x04\"\x161\xee\x10\xf8\xb9\xf6\xc3\xbc\x01\xb2G\xf9\xd1\x847\x86'AJ\xaa\xd8Q\xe3{x\x84\xa98\x98w\xece\xb0M\xbf\xee\xba\xbc$u1\x98u \x051N\x00\xa1K\x86\xa9\x1f#\x9c\x19s\x0c;\xd9hwO\xbe\xb8dc\x07\xae-\x0eQy4\xe8\xc9\xce\xabw\xfb\xa6\x1f\x1d1\x82\xe8\xab\x87Y\xce\xdf\xbbwE$\xf9YN\xd9#\x99>\xd4m\xf8\x02NH^H0\x15jZ\x82\xe2vP\x97%f;\xe8NV\xd7,\xe1pR-\x82\xbb\xec\x8ao~\xcf\x90\xf3v;\x16\x9f\xcb!`%\xffu\x86|\xc1\x0b\xb6\x9b\xef\xfe\x00\xbf\xbd\xbb\x90\x83a\xa4mSt0Lp\x91\xcc\xfd\x969\xfan\x01U\xbd@t\x90\xb4G!.\xa2wM\xd8\x1d\x18\x9a\xb3\x9d\xa7=r\x83\x01\xf0Y\xa4H\xcc\x8c\xe9L\xe8\xaeG\xc7\xec\xf2\xd6\xc6\x0b\xeb\xd0\x9f|m>\x12\xaf\xa5\xe1I\xf8\xf78\x80k\xef]9\xa3\x89\x8bd\xa7\x19S&\x1a\xc4\x98?\x89\x08\xc6\x88 7\x1a*\xd6\xff\xcb\x97OJ\x81\xdd?\xfb\xfe\xda\xf8\xc1\x1c\xd9Hi/\x1b\x00\xfa\xab\x1bw\x10\x84\xfe\x08\x1a\xa5\xb7\x1c\xb6\x94\x1a\x03\x9e,Y\xfc3\x88\x16f\xd4\xfa\xb2\x1a\x95&\xe1H\xd0\xd30\xb0\x94>\xb3\x9a\xab\xe9\x83\xa4M\xc9U\xe7]\x0c\xedO\xdb\xb4\xa1B\x82\x98W\xad\xfd%\xec\xfa\xad\xe6a\x03k\xa7;\x80\x11\x09l\x86+\xd8\x98\xcc\xb1\x94\xc1\xa1t\x83\xbfrv5\xaa\x07n\xb3\x7f\xb2\xa1W\xcd\xcct\xe9\xa3\xbdL;\x03\xd7\x17\x83\xedJA\x14+e\xafn\xbe/\x0e\xf4\x05\x98\xa8\xa0;\xa7\x1e\xa1\xcc\x90\xe0\xd7\xf1\x9a\xa3\xbdE:u\x14\x9aT7\x98\xdc\x1e\x8a\xd0\x8cQ\x9d\x02\xdb<\x919Qxp\xf5\xbd\xf10\xf06#\xcb\xb7\x0f8\xbb>\xcc\x0bt\x01%\x1d!\xf8C\xf5\xd7\x8a\x1b\xdb\x9c\xd1\x0e\x8d|,{\xe1Po\xbe\xcdR\xb2t\xb1\x88\xf6\xc4\x9d\x8ai\x14\xe3\xc8\xe5\xfc\x90\x02\xda\xc0\x0a\xb2`#vpe&\xec\xe0X\x0b9\xfc\x81A\x12hzN\x0b\x1akFI\"\x14\xf7\xbe\xcc\x04\xc3\xe1\x06\xe7\xa4\xa7\xd9\xf6\x02\xbb\x09N\x8b\xef\x08~S\xa3\xb4\x9c%\xf4\x8b\xef\xca\xa4BO\xa9\x8e\x02\xdf\x14\xd2\x9b\xee \xe6\xc4\xb7\xc7\xf6\xc8\xab\x87\x11\xdcqQ\xdba\xd7\xbd\x98Tn\xcb\xa9\\\xde\xdeSE]\x0a\x94\x90X\x86\xb8\x93n\xb7\x9524\xf2\xed\x96\x07\x88\x0f\xd7HR\x17\xbe\x88\x9e\xe2\x06\x93\x9f\xb0\xad%\xdc\xc4\xf1\xc36\x85'g\x1d1P\xe5\xc2g\x8c\xbe'\xdf64\xa1x@\xc4\x02l\x87\xa5oJ\x1f\x10`2A\xe6E\xad\x1f-S\xa8L\x92-W\xec\xa7\xe8\x94Yn@\xf5\x8a\x98\x0c\xa0s\x0d\xc1\x16\x16\x88\xd6R\xbfA\x90\xa7\xe4\xac\xd7\xbe5\xc0n\xb0J\x03\x11`\xfaa\x07\x89g\xd5\xb9\x98\xcd\xcfr-\xda\xe4f\x07B\x8a&\xf4=\x94*1\x9d\x9a\xf1S\xb1\x05EO\xa4p_\xacx\x1b\xdb\xf5\xe21\xdb\xfa\xe9\x0e\xa6\xff\xcd\x9c\xf2\xc1XX\x1a,\x18D|0\xda2\xad\x10P\x87H^\x1cH\xbc\xbd\x7f0\x89\xf5\xf6\xeb\xb4\xae\xc6\xb7\x89\xf7&\x8b\x8b\x9a\xcf\xcd\xe6\x989@cU\xc3\xe0G2\xcfxr\x92\xedT\xbe\xb5\xe1^m\xef\xed\xcbe\xf9\xbb\\\x04K\xb3\x8d*b\xd4!Q=\xf4W\xc7\xdfA|l\x02d\xc2\xdc\x98\xd8\xa8\x04Z\xd4T]5NN\xce&\x01\x17\x09\x95\xf9\x0e\x92\xcc/\xc9PE\xb6xL15+r&xL\x88W$-\xc7+\xb5\x0f=#\xf9\xc9\xd2\xde\xda\xcd\xb5\xf76\x89La\xe6r\xa6\x19\x8a\xe8\x80\xc6\xd3\x8d\xaa@\xc5\xa1\x83f\xf4e\x00E\xc6\xac\xee\x8a\xdeD\x96-_\xeb\xb7S:J\xe8\xd1\xf8f\xb6=\xae\x7f\x12\xad\xa7\x01c\xe9\xda\x9c6,m\xbf\x93\xcewz\x03\xf5\xcd\xf1;\x94X\x1dU\xd1\xb7\xd8\x1e_\x99\xa4\"\xdc@\xe4T\"\xed\xc9\"m\xbe\xc0\xab\xc4c^\x14g\x92\x9b\xbf\xfa\xb1@/\x99X\xc7\xc22\xbeB\xfa\x00cE\xda\xc8\xc5\x0d\xfaR\x1a\xad\xf7\x90g\xce\x80=\xd4q\xc6\x06E\xe6\x82\xcf\xd5\xac\x8a&l:\x0e\x86\xac\x83-qB\xc9\xaf\xbfA\xffH\xc3\xd1\xdd\x9e\x84\x81'\x10\xb9\xd7)\xd3\x82\x8a\x041b\x06k\x1dz/a#=@\x97$JN4\xbf#\x92'u\x80B4\xd4\xb9\x9az\xec\x8a\xb6b\xe3d\x1e\x91\xe9\xe9C\x98\x82+\xab\xf4M\xe4?\xa3?R\xd3\x944r\x9foQPs\xab\x17'9\xd1\xb9\xec\xcc\xb7\x82\xfd\x8b1\x0aL\xb7Z\"\xa6\x96\xa9c\xb0\xa6c\x07\xed9\x06(VJ\xd1M~\x9fxb|B%\xd7\xc3\\\xef]V\xc6\xb4|\x0d\xc63J\xe7m\xb6\xef\xf9\x093+Rj\xaa\x86\xb1\x0d\xb2\x18\xff\x9c\xcaas\xc4_'\xda\x93\xbb\x890?\x96x\x9b\x86\x03\x0co\xecZ\x83\xbb\x10\xe9\x96\x81)\x10\xe5K\x94\x0aL\xc7m~n~8mSX\xce\xe8\x8dO\xe9\x99\xe0[7&\xd2\xb5\x0c\xbb\xe36\x81\xaa\x87\xe1\x8c\xaf\xc9\xc7\xec\xe6\xc2\xf2\x10&\xb8\xee1C|7'\x86\xc8\xda\xd7\xe6\xf7\x10\xd9\x0b\x85tc^\xa4\xcf\xe1zH\xe3\xb7\x8f02\xdb\xf5B\xa7@\xde2\xf4g\xf9G\x96\xd0'6\xc3\xd9\x9b\xac@\xeaM\xd0\xfa\xb6Q\xfb\xb4M\xf88\x9b\xac\x10P\xb5Qd\xe00\xfb\xdac\x8a\xe9t\x08\xe8cH\x00\xdf\xd8a\xa6\x9b\x1f\x8e\x18\xcb\xe3\x93\xa8&m}\x07> J\xf8\xd9\x87\xdb\xc1m\xf8\xb3x\xec\xb8gq3p\xd00\xc2\x82$\x99\x1b\\'\xfe\xf0\xd9\x16FW\xe9B\x94)\xf0ZC\xf0\x0es\xb2\x85\xe0*8\x98V\xfa\x87>\x9c\xb6\xa9\x0f\x97\x98\x90\xa7j\xb8V#\x9c\xc3\x8b\xad\xcd\xd1\xd1\x90q\xe7)\xb7\xeb<0\xa9\x85\x0e\xf8Z\xbb\x89\xfa\"D\xf5\xebPSSQ3B\x98\x8dD\xd8.F\xe2\xf41\xa3\xef\xe0\xee\xe4G\"=\xe2\xe1\xb3j\xd9\xe0\xd7\x1c\xa8\x1ev4\x1d%\xc2\x043O-\xa2\xc7x\xdf\xb97\x02a\x03wM\x88\x03W?\x0d\xe1\xc0\xdc\xe6_\xccM\x06i\xbd-0`\xc4xc\xc6b\x92z\xa8%\xd4p\xddCe\x98\xa4\x8fB\x01\x19\x8cN\xd2\xf8\x0c\xbf\xc0W0i^d\x96v5\xed<\xfb\xed\xfa\x0e\x16\xc2O\xabJ\xadk\xd3\x10\x0d\xdcA\x11t\xc7=\x87Y\xa4\x06\\\xa7T\xefcp\x1a)\xcel\x7f\x04'\xff\x09p|\xda\xe6\x0e$\x16\xb83\xfd\x90\x1c\xcc\xadz\x00U\xd8\xe1l\x9c\x1e\x11\x98\x9c\xc1r\x1b\xfd?\xd5F\x1e\xc2\xa6\xa9w\xd0\xf6\xf1\xd5c\xf6\xba\x0ck\xbcQ/\xea/\x8b\xaef\xc4#\xee\xa0\xea\x8bq\xa4\xc16\xfe\xf6i\xdb\xc1\xdf\xf7\xf3b\xb4\xealN\xabt\xaa\xfcMKx\xe1\x9c]\xdf\xd9\xad\x85\xcdb\x02r\x07;\xdf\x02$R\xd7\x11v\x9fA_<\xfa\xce\x02\xbcd\xd7\x93eLM\xdfo\xb2{\xef^\xa7\xb3 I\x8dD\x95:yx\xba\xc1\x9c\x9f&\xba\x1d\x88c>\xf3\xcf\x17\xdb|t\xbf\x9b\xf3\x85\x0a\x10k\xabP\xbd\xfb\x9e\"\x152w\x87\x1a&\xa7$;\xd8\xe5c\xc9\xa9\xf7r\xb9-\x80\xb3\xe8t\xf0%\x15\x90\xa3>h\xec\xcf\xbd@\x0d#AW\xae0XA\x9be\x06\x00\xfeXr\xa9;\xa1:\xb5\xc8\xc6\xc6\xaf(\x8f\x91\x88P\xf2eMU=RMv(@ym\xee\x8e\xc4wYn\xd4\xc4\xdb\x8bQv\xd4\xf1L\xe3\xed\x17\x05\xc7\x02K\xad/\x99\x94:E\x064bT\xae \xaa\x8e4\xc3\xd6\xc1p\xc6\xd7\x0f\x9b\xfc'\xdf|-\xfc'\x7f\xfb\xff\xfc\xfd?oq\x9f\xfc\\\x03\xd4\x00\xdb\\')\xe1\x93\x1f|\x9f.\x86\xde\x19^\x073I\x8c\x1a\xf0#\xd4\xef7IMT\x0dX\xdd\x08\x99\xda\x089Vw\xc4Gl\x04\xa9E\x9f6)M\xa8\xe6U\xbaI\xee?jMk\xf5Pi\x0a\xb4\x04\xc0&tW\x99\xd9P\xc3`\xcdk\xed\xd8\xa7_\x11J\x00\xb87\x19\x9b\xa9\xbf\xfa8\x8c\xcf/6IA\xe6\xc5\x17\x02\xd8\xda2\x05\xdd\xf7\xc9\xfeE\x8f\x80\xf4wYA\xc3\x00v\xf5S\xf0+\xa5\x86\xee\xa5p\xed\xf6yL\x84\x98\xaa\x01\xb0\x8aE'\xb9A\xbfg\xb85\x93>\x19\x82M\xe1B\x8aI\xa2L\x04\xac\xf0\xfd\xb3\xdb&\xf1\xf8D\xc4\x0c\x1c\xcd\xc5\x84\xae\xad\xff\xb0\xa3\xa7\xa5\x13\x93\xd5\xc5&\x83O\xccZ\xc4\xe7~[\xc0\xd8\x01\xf8\xb6\xa8\xfd\xd8Cq\xe8\xa1\x83sx\x89\x00\xa9\xf5\x95\x18\xe5&(\xa7\x91\x91$Kk\xb4B\xa0>l3\\|1\xbd\xeb\x92\xcdr\xdcRj\xc8I]4\x8a\xb1\x9b=\xd2\x94b\xd2R\x03\x9d\x03z\xad\xbd;.`H\x93o\x99\xc3\xf7\xa2\xd9\x87_A*\xd2\xaa\x14\x9fp\xdc\xa7\xa2\x96s\x85\xd6\xc8i\xc6\xec\xa9\xbf\x8d\x06#b\xa7\xf5\x94\xcf\xae\x87\x7fs7Br%\x9dv~\x84\x1d<\x16\xa9wC}\\\xa6\x1a\xc9\x8b\x9c\xf2\xa9\x02.e\x05\xdej\x08\xb2\x98u\xe7\xdf/\xb4\xf3iF\xb4\xc5%\x19[\xc9O\x01Qv\xa7\xbe\xfb2\xc9\xb5\x7f\x8cU\xbb\xaaC\x08\\\x13A\xd2t\xd8\xf0M\xc5\x02'\xa5\xc1\x94n\xec \x93u|\xb1\xcdP\xb14\x1c\x92k\xbe\xbb\x05kh\x8e\x86\xbarVO\xfd\xc8\xad3\xdcF\x86[\x86p\x1d1\x0f\x80\x07Ex\xe7\x0e\x0e\x8b\x9f\xaak\xbcQ\xeb\xe9i\xf2\x83W\x0c\xc9\xf3\xb3%\xc2\xf1@|\x1e&U\xac\xd2Et.\xc3-\xb2\x9e\x0f\x09\xd7KO\xa1Iy\x85\xb3\x10\xee\x15w^\xbeI\x16\xb2M\x15e\x19\x89\xb0z[G\xd1\x98\xddc\x88\xdaxZ\xe8\xa2F\x8f\xd3\x885@?{oT\xbd\xcc 2\xf2\xd7g\xa5V$\xe4|)>\xd1\xd6\xfe|\x9bY?'\xe8\xea\xb0\xd3A:\xddi\xeb\xd4\xe06,\xe0w9\xdc:\xbc\xdd\xd2\x01\xf3\xd2\x0b\xf4\xcf\x0dL\x0d\x03\xab\xb1(\xdfV\x0d7\xd7iN,\xfc\xed\xe6\x1b\xdf\x16\x87\x12|\x7f4\xde$\xad\xb2&\xc4f\xfdUZ\x0b\x99\x84\x01J <lS\xdd\xaa\xa1\xf5\x96\x85W\xbe`7W\x05y\"3>\x84?\x06\xab5\x18\xfe.\x19\x9c-N\x86\xaf\x13%R\xb4't\xa1\xfe\xd9\x0e\x8d\x11\x01\x9a\xdc\x05\xce\xe1\xa5\x88\x8d\xa1\xc5XU\xcd\xd3P\xf8\x16\x86(C\xf4\x1c\xb9\x8c\x1e\xaa\xdb\x1d\x1a\xcfo^n<\xde\x9f\xab+\x03\xbd\xf8\xb8\xa7EN>^\x96\xc3\xbej\xf7\x8fu\xf3)\xd3\xd3?\x8b6\xeb" +
+	"i\x9b\x8d\x81\xe9\xe9\xb04\xdfr\xd5+\xac\xbc\xa2\xa9\xf0\xe4\x80\xc6\xf6\xd4\xe3\xe9Y\x84s3&~\xfd\xe4\xea\xe2\xa1\xea\xaa\xa1%\x8f\xb4\xbfA\xf1\x00\x0fPF\xd5\x9a\xce7\x8f\xee\xaf0\x995A\xddU\xa7m\xb6\x06Zu\xf8\xf4ae\x85C7iY1=2T\xb6O\x98\x8cE\x98\xc5\x80\x84\xb8'\xa0\x1a\xef\xa4e\"e\xc2\x9f\xab\xe1\xea\xda\x08\xf2\xfa\xef\xdd\xf0\x15\xf8>;\xafLI\xa3\x85\xee\x06\x1e5\x88a\xdcj\x99\x7f}o\xf3Zv\xae\x16\x88\xfa\x00'\x09\x87\x04\x0f\x03\xec\xa9\xdd\xa6+\xa0\x82-E\xae\xf6\x02\"\x97x\xda\xee\xe9\xa7\x02\xd6\x0dF\xbeDS5\xd0x\x19\xf4\xd6\x85\x8b\xdf\xb9\x8eZ\xa9T\x14\xc9\xa8\x1d08\xed\xdeE\xde\xd9\x9b1\\~\xf28\xb1\x91>D\xb9\x93Oi\x8b\xee\\9\xb6\xa9K7!7\xfa3\x00\xfc\xdeG_\xc6\xa8\xed\xeae\x80#\xc5\x9e\x8a\x00\xdc\xbb\xc67\x0b\x0f&Wrx\xf2\xf9\xc9g\x12\x97\xa7\xed\xd6\xec(\xaf\xe0$\x80\x8f\xc4e)\x99\xe4v\xc74\x19{\xbcHt4\xe8\xd3;:\x9b\x13\xa7\x88>%\x07\x13\x06\x84?\xb2;}`#v\xdc#\xe1\xf2\xed\x9b\x0d\x93\xfa\xd3B\x8b\x9d\x0bK\xad\xeaq\x80\xc3\xb2\xc3\xf2Ez\xd8n}\xed\xdbUV\xcd\x05q(\x06N\xab\xbd\xe2\x8cL\xeb\x0cM\xfdv/pB\xd0\xa5u\x0a\xd7\xd6\xb6J/$\x13\xb1\xb9\xb1t\xf2\x96\xd3v\x03.\xc6\xea\xf1\xb0\x9d\xa0\xd1\xb36\x08q\x8d\xd8\x17M\x8b\xf0VM\xce@\xb6\xda\xf4d&v\xb0\xfe\x0a\xae8\xfc\xc9\xb5G>T\x09k\xc5\x7f`\xf0Z\x08\x8f\xe8\x08+\xa6\xea\x16\x15\xecJG)`\xf4\xca\x0elt\x8fT\x85\x13>j\xfc\x0f\xb2\\\xe4n\xfe\xbc\x9b,I\x02\xf2\xd8\x90s\x1blD\xa4l4\x82$\xa5\x83\xd1\xd71\xea\x0f\xb5D\xc0\xd2]\xe0\xc5\xdfn\x07\xce\x1b\x93\xc9\x94\x88jw\xe4\x14\x115\xcf\xc8\x7f\x94\xc1v\x0eb\xc1\xe4\xf09\xce\xd4\xc5G\xb2\xa9\x0b\x1d\x9d\xb6\xc9\x98+\xd9\xb8\xd5\xb0\xabE\x04\x8c\x7f\x04\xbb\xaa\xd4\xb7\xe8|\xb2\xb0\xb4\x10\xac\x147\xe2;\xab_]\xfa\x06\x8a\x077\x81r\x13\xfa\xc8I\xca\x92\xb53\xee\x9b\xc8\xe66F\xb8\x16M\x01\xd9oL\x9bq\x8a8\x834>] \xaa\xc9_*\xceV\x86_C\xff\xde4i*\x95\xbf\xbce\x82\x1a+\x03\x9c\xf3QQ\xd3\x1f\xa1|N\xe0\x88|#\x13{\xfd(\xa3g:\"#HM\xcd\xc7i\xbb!\xbb\\\x8aK;\xcf.6\xe6\xd7\xbf\xf8\x1d\x1d\xd2\xd1;\xa1\x80y\x0c\xe6\xdd,5e\xd7a\x12\x1eC\xe9~Z\x12[\xc6)\x84\x02{\xe2\xf9\xbcQc\x1f6\xc9v\x93s~Gx\xbd\xd4w\xca\xb2)\xe5\x9a\xf3\xf4~\xbb\xf9\xb8c^\x07b\xaeY\xfeZ\xc32\x14v\xd59\xbc(\x054\xf14_\x82\x83\x9d&q\x1c\x14t\xc4\xc1\x04\x9e|\xf0\xad&\xcb\xdc\x993\xe5\x1c\xba\xa1\xdc\xb1\xc33nNd4\x93\x15E\x17\xe0Z\x0d\x10\xd5\xeb\xf0m\x9c9\x12\xe5\xc4\xee2\x1f\xc3\xdd-\xc8+'\xe8\x1f\x16\xd7\x87\xdc_v\xef\x1f\x9b\xb8\x06\xcd\xdbO\x9c@\x90F\x04\xaam\xe1y\xbf\xbb\x978k\x85Y\x9e\xe5xN\x94<\xd9w\xd8\xb4\x8475\\\x82=\x91\x9c\xe2\x10W\x97uz\xbf\x87\xdeq\xff\x91\x02\x98U\xa0j\xa1\xf3\x1d\xa7J\x8a!\xf3o_h\xfa\x9f\xef\xd9\x8boB\x87O\x98\xcc\xa2\xa9\xb0?\x8fW\xce1\x91\x01e\x8bk\xba\xaa)\xff\x92\x9f\x85\xcb9\x0eE\xbcmwG/:U\x193?\x8f.!\xd2q\x14P2\x80\xfb\xeb\xb1K\x07\x1fhAl\xac/\xfd8A\x13+\x82\x94\xda\xd9\xd1\xdb,1\x14\xaf\x82\x88jm\x80P\x88\x8di\x91\x90\xa5\xa9\x09\x96\x1d\xad\xc5K\xba\xd2q#3\xfd\xf1\x01\x7fsL!\x11\x99\xd8\xa7\xc9\x94\x09x\x80XH\x8b\xa6\x0a\xee?\x8b\x0c\xe8\x19\xc4\x17 b\x97\x8d\x89z\xfdr\xcfx(i7\xd6\xe7\x18\"\x12\x0by\xec \xd5\xbe\xdd\xd2a\x7f\xfdM2:\x98\x16\xf8\x15.1\xa9\x11\x19+\x84\xc2\x85{\x0eSL\x08\xe6\x93\xb4\x0d\xa9\"\x86>7\xc1\xe5dl\xa5\xed\x8a\x1f\xa1ez\xfd\x84\xae\xa5\xf57x\xbf\xdd\x1e\xfc\x17;;\x89Jb\xe7\xa9\xe4%\xb9\x8b:\x98\xd3\x81\x97\xe3\xdd\x8fqU\x09\x9f\x0f=\xba\xf3#\xfc'\xc1\x15\x9aj!E\xccTM\x037\x14\xb5\x7f\x84\xfe\x85q\xc2H\x1e\xa0\x89\xba\x13\xfa.\x11\xc0z\xacz7\x9e\xf1\x95.G\x1f9\xd3\x8f\xc8U5t\xf0\x00\xdd\x19_w\xb4)\xff\x08\x12uB_\x90\x1f(\xbf\xb9\xb8\x1e\xed\xd4Y\xfe\xd1\xe5\x84\x0b\xb8\xb0i\xe1\x18I\xe1S1U\x03U4\x9633|\xf0\xe4\\J\xf2\x00\xd0@\xbb\xab\x0dy\xe0\xd1?`u\x0e\x11|\xb1j\x1f\xe7J\xe5\xf4\xe2\xb1\xb1\x92Y\x8bo\xb5)\xe5\xec\xcd\"\xd8\xdc\xfc\xd6\xd0.mT^\x0b\"\xe7\x19\xaf\xf4\x9c\xbb\x93\xec\x12\xe52\xcb\x82\x9c\x98V\xea\x1eE\x02:L\x88G\xb2\xb2\xe3\x8e\xb6\xe4\x15\xe3b+\xfe\xc9\xc1F\xfb7\x16*\xfc\xe8\x069;\x07W\xf4\xd5\x95\xf0\x11a\x06C\xc9M\xe1&\xaev\xc3\xaeL\xf8\xeb\xefrmtb\xb0\xd4\x06\x9f\x04\xb4\xc5\xe6\"\xa6\x11\xc3\xf7\x84A\x0b[\xc5d\x82/\x9e(\x88\xc8J\x93\xe3OO\xa1\x0d\x0c3\xa9J\xa3Y\x93[\xad\xe0\x15@\x0bu\x16:\xc9\xddP\xdc\x99RkK\xb88\x85\x01\xc1jQ[M\x1b\x8a\xea\xaf\xdf\xddK,\xfe\xc7\xc5\xa784\x11\\|\x8c\xc29t\xaa\x89\x00\xdf\x00|\xdf\x91\x10\xbftF9\x8f[\xd3,\x07\x9a\x88\xc8\xa2\xa4H\x08\xc2\xf0!zp[0\xbc7_[\xbb$+-2\x03\xe24\xf2 \x85H\x9b#I\xc2\xb7\xfb\xa1\x98\xdc\xcd\x17%<\xc3\\J\x99\xd6\xc1\x8b\xed\xf6`;|i\xe3\x80\x0c\xe6\x7f\xc0\xbeMq\xfb\x96\x08\x90\xaeP\xc2\x99!\x91M\x85{\xd0jLsMP\xf9\xf9\xb0\x15I\xb05\x8d\xf1\x08\xd1\x91\xe21+fVCN%\x14\x86\xcb|\xbc\xa5\x1b\xd8\xd3Hl\xda:\xee\x90\x1cRF\xd2H5\xa6\xddq\x06i\x9d\x14\x11o,\x08A6(\xfc\xfd\xb8\xa3Kx\x99\xd049\x88\"\xff\xbf\xa9\xbcZ\\?\xd4\xad\xe3\x0dO\x85W\\\xcb\x89Y\xf2\xb9Y\xf2\x89A\xea\x93]PtU\x1b\xf6\xf5M\x06\xb8\xf4:w\xf8\x05~\x82nO;2\x0ex\xed\xba\x9b\xef\x95\x1b6l\x18\\\xc5\xc5\x01\xbf\xfa\x982#\xda\x85\xad\xe1Ql\x88\xbc\xc0\xe0\xc6\x055\xe6K\x85\xf8Pz{\x18,],\xe1G\xc2\xf3\xb7\xa3\x91\xf8\xf0S\xa5\xcbOf\x07m\xc2q\x05\xb2+\xe8\x82\xe2\x80\x1c\x0c\x08[J\x1a\\\xf8n4\xb1X\x1c\xc8\x1b=*P\xcc\xa9\x86$\xbf\xd9\xb7p\x0e/\xf6c[\x0d\xb6o\xee\x99\xc9\x9f\\\xcb\x81\x93\x1dp{y\xd9\x8c?\xa65\xc3)\xd4\xa9'\xec\x1a\x87^\xaa\x8bg\xfe\xa9\xb8x\x94B\x7f6'$\x0e\xc8XA\x03\xfd9\xbc\xd0\xa1M%F\xac-\x06\x03\x87[\x8bW\xe1V'\xc2\x9b\x8c\x91\x99\x1ct\xc4D\x12p\x8c\xbcm\xce\xe4\xbd\xd8\xee\xf5]\x12\xdb\xcf\x9b\x03\xa8Z9Cs(\xb6\x8c\xc2\xb9\x02\xff\x8a\x10\xdf\xb0\xe2\x8e;zv?\xe5\x89\xe6\xac\x1f=/\x9d\x9b\xa2\xa5\x1c\xbdUk1\x08\x91d2\x1c\xf2/\x96L\xc9\xca{-m\x8a\x9e2\x05\xe2x\xaf<5\x07\xea\xe7g\xdd\x93\xd4\x03\x80\xfe\x9a\xbe\x99!\xdd\x83\xe5\x16\xd6Sj\xab\xf3*\xadn\xd3u\xcf\xf9\xe5\x110\xb6\xccJ\x12&\x0b\x8f\xf5lbS7\x14\x872AG\x13!\x12\xaa\x87o\xd7L\xdenE\xa5\x1fg\xddms\x98\x06\x17!\x04e*\xba\xd9\xd5\xe0\x0a\xf7W\x97\xd4\x84\x856v\x85\xa7\xe7Vu\xae\xbb\xb9>Z\x9a\xfe\x0a\x8fdih\xcc\x16\xae5\x07\x06[\x86:\xd4\xd7\x16\xfc\x06y\x81\x8d\xcbL\x87\x19y\x87\xd5\xf2\xb1B\xc6\xed\x90\xbes!\xee(0\xf68\xcd\xed\xb5\xf9\xa6\xe0@\xbaKv\xe55]\x82\xf9\x95\"\xf7\xdf\xacI\xd3@ \xdd\x94\xf4l\xde\x90\xf0?61R\xf7#:$;Z3\xbf\xb9\x7fxs\xb8\x925\x82\xc5{\xc4\xd33\xa9\x09\xe0\xd3bb\xec\x8c\xaf\x86h\xb68\x08\xd8\xe0xJ\xb8\\\xe6\xc1\x88\x0d\xedl[\xed\x8c0\x01\xdf\xce\xd5\x04\xd1\xbbH\x0c\x10\xbb\x01\x14\x04\xf2\x1b\xe6\x09?\xcf\x1dO\x1c\x811@\x90\xdf\xce\xc1c\x94\xd8uQe\x17\x0d\xc2\x8e\xce\xf2\xb5\x0cc\xba\x93\x92\xdc3Vt\x04r\x8a\xe4\x8aXp!'\xfd\xac\xff\x8a\x03f\xdc&\xba8=44#\xe4\xe9?%\xe2\x8f5\xba6\x15\xcf/\x0e\xd41\x1b\xd0\xbb\xc7\xd9\xf2:\x84>K\xfc\xd3\xd6\x02[\xc6\xde]\x8c\xaa\xe7B{\xc7\\ \x1b\xa9\x15GXB\xe5r'u\xdec\xf5\xa2\xf8\xacD;\xcc\x0e/\xea\xb1\xf3\xe8\x0e\xa7vG\xb78\xc2\xee\x7f\xc7\xbd\x879fi\x90\x9c\x8fD\xd7\xc1\x1d\x9e\xa5E\x0e0j|\xc5*,EZg\x1b\x95\x12\x0c<l\xb9\xd6\xdd\x01\xf3\xbc}\x93\xd7Ds\xbe%\\}\xad\x1b\xb8:|\xce\x94B\xac\x18\xefL\xa6\x97\x96\x96\x95\x9bge\xe4\x1c,\x8ff\xb7\x0e\x9e$V\x15E\x0f\x99\x92A\xdc*y/\xf9\xf1v\xbd\xeeo\xfa\"\xf2L\xb8\xf5(\xd3\xe2\x98t\xf3\xa4b\xe6\x07Sd8\x92\xdc\x009i\x83\xcbR\x12IW\xa4a\xeem\xb0_\xa0(]\x0b\x9eAm\xe2\x9a!\xe82\x96\x97p\xcb\xa2g \xac\x7f\xb4`\xf1]\xdc[\xc0\xef\xe9\xaf\xd7\xaa\x85\xee\xb8\xbb\x0f<\x89\xd9\xe5\xe0\x83\x16(l\x1c\xb4\xf7\xb3z\x84A}b\x0e\xe09\x90$\xb5z\\|\xf8(\xb2T\xe4f\"3\xcc\x8b\xed\xb6\xf00gr;F\x01~\x94r\xb3\xcf6ml\xac\xd9H\xb8\xc7>\xef\x10\xd5\x98\xd9;\xdd#\xe3y\xceJ\xdd\xd1G@7\xd0\x8f\x1e\xd5l\xca\x05\xf6\xbfys\xa5T7\xedu\x0e\xbc<\x88?\xf3h\x15\xda\x12rN\xac\x12\xcd\xbeEr\xd5\xd1\x9e\x9bKw\xd0\xf7\xecY@\xfb+\xech>\x8f\x89eu1\x88A\xcc\xd0\xa9.Q\xf7\xa5\x84\xc0i\x95-\xabtF\x18\xeb\x1c\xa0[u~h\x00\x81\x1b\xe1\x0f\xae\xbb\x81\x14\xcc\x12C\xcb\xf4\xcf\xb8\xe2\xec\x87\x16O\x04\xcc\xd1l\xa8\"|\xf7\xcd\xaa\xc9\x085Q\xee\x85\xe1\x93w\xc9`ety\xa7y\x8b\x1c\x07\xff\x88B\x08\xc3k\x0c\x01f;v\xf4\xa9\xffhi\xd2\xf9\xb4\xa1\x83\x18\xc3\xce\xe8$\x85]\xb2+\x17\xfb\xdd\xab\xcc\xdd?\xa5\xb0d:m\x94\x8e\xb7\x94\x19\x0bv(\xea\xfa2N\x99k\xdf\xcc\x12\xf6\xa3\x0f]C\xb1hd\x01\xe8\x0c,C\xd2P\x01\xed7~\xcbf\xc5\xce\xf0\x95\"\xf8\xdc\xf1\xcf\xb6\xa4\x7ft<6J\xd2hQ'M,\x02\x19\xfe\x85\xd2\x8db}p\xc1\xfd\x04\xdd]&\x8773\xaa#NJb\xc1bMW\x09\x06.\xe7$\xb2]/\x8a\xa5\x84G\xb0_\xe1\xf3aXQ\x9d\xc8\x16LM\xd5\x8b9\x11c\x88\x84\xe9j\xfc}\xdc\xcf\x1f\x0a\xcbZ2Q\xda\xa1\x85\x0e\x8a.\xfcK\x168a\x9e\x98\x1cJ\xbaa4sJ\x8e\xb5\xa3I\xd6\x1d\x99\xd4\xbd\xe3\x86>i\xf2\x1dmC\x7fe\xd6C6\x93\xf1\xc4\x85F\xaa\x9a\xfb\xdb\xe1\xf7\xc5,\xf7\xbf\xb7\xa9:\xf0\xe2Ls\xff\xec\xa9\xe0n?\xfce\xe9Fd\xd1\xd5\xe2\x7fu\x81\xfe\x8c\xaf\xf4\xd0\xc8z\x80\xdeM\x98(S\xa7h\xec\x8a\xb4\x00w\x1a\x15-\xc9\x05W\x94\x97Z\xf6\xc6\x0d\xe1\xa9\xd8\x89\xaf6G/c\x0aa\x89SB\xaeV\xfbt\xe1\xb2\xef\x91E\x1a\xab\xee\xe2\xa3\x89\xf3\x17\x1f\x97\xa8\x87!\xa3!\xcdF\xfes\xd2\\\x14\xb5d\x08z%\xa3\x19\xfe\xc3\xc3\xd1\xfa\x1b\xecn\xf8\xb7k\xb6Ky4\x83#\x1c\xf6N\xe4\xda\xbb\x016g\x9bg\"\x0eL\xab\x16AN\x04\xd6\xc4s\x96\xaf\xd3\x82\xb8\x04\xbd\x1dG\xb8\x86\x01\xa6\xfc3\x0b5\xa3\xaa\x83\xfe\x17\x08o\xfd\xb0\xcd20;\x86(\x8b\xa3\xf1\x81\xc5\x1dF\xb6tV\x1a_\xb2\x13\xe3J\x90\xcb$B\x84\xc4K$\xc5p\xa4^\xa8\xdf\xb1\xa8\x1f\x98B)\xd6\xe4\x17\xac\xc2\x05\x0d\x0c0\xc3\xa4\xd0tn2\xe3\xbf{\x99\xed\xf3\x1c\xc0\x10\xeb\xbdt\xc2FA\x8b\x85\xd9\xef\xdd\xa9`\xa2\xade\xdb\xbc\x09i\x8e\x9c\x09k\x06Z$\xe8bf\x92\xb8A\xfa\xab\x13\x99\x88i\xa67\x98\x1c<x\xec\x9c\xb6\xb9\x14\x0e\xc2\x1av\xb4\x92\x12\x113\x14\xcch\xb1BMu\x18)\xa3v\xa4\xb23r\x9483-\x1b\x89\xf9H\x9d`h\xd7\x7f\x85\xcbWf X\xb7\x8be\xce\xb24\xf4\x0d\x9bi\xf0\xb7W?\xf1\xf2\xa0\xf7\xd8\xd2o\x0bM\xdezxW DvH\xf4-\xcc:1pN\xa4\xed`G\xd7\x82\xc6\xf4\x80R\xa3\xc2`\xdc\x02:\xe8a\xda^\xafw;F8\x89\xaaH\xaa\xa7\xe1\xa9\xbf\x86FV!\x04\x92c\xe0\x88.\x1c\xd9\xef\xad\xa9\x88<\x0ej\xee\x93\x1d\xc2\xe0>M%Zq\x9d\xcc\x84\xca\x9b\"[l\x18m!\xa1\xbaby\x98\x05\xc5\x1f\xabGh\x98\x8e\x1e}\x04n\xba]=\xd9\xc2\xc8c\x93\x1eg\x85}@Bo\x0e\xe7\xdb{\xc3\x99\x84\xebkY!\xaa\xd8K2f1}w`\x93\x18l\xfcq\x81\x7f\xed\x0f&\xa6a1\xe2\xe2`\x1c\xd7y\xb1\x97\xbf\xb8\xf6\x8d\xef\xb08\xe6\x0ahj\x87\x82\xf1V\x8c$\xf7O\xe4\x98\xe9\xaf\xb5\xeb\xc38\xeb0\xa2\x9d\x08\xb70\x89\x09\x9e\xbc\xb9Tf\xfc0\xed2O7J.\xfc\x02\x0d\x0ci\xd5\xbd1\x0c\xa6)M.\xde\x0dt\x17?h;\x1b\x0f'\x06\xfda\xed\xfa\x1b\x14`\\\x86\x11\xb3p\xfdq\x07\x87\xc5OK\xc7\x8b\x8a[+\x93\xbd6\x87\xc6\x04)w\xe2b\xf7\xbb\x81\xb1\x85\x84$\xda\xd5\x9b\x9f\x9c\xd4gy\xd1o\xad\xb6w\x964W\x16-\x8e\x0c\xf6\xba'N\xf5!Jt\xa9D\x05\xbekFz\x19\xb6\xc7B\xc9\xe0N(\x9dk\xf6+\x7f\x91\xf0LD\x81\x91\xf5v\xcd\xaf\xa3\xb6\xa7\x89\xa1\x15\xb0\x199b\x8b\x8fm\xf1/~\xf8\x0a\xfa\x85-\x14\xabs\x9dk|[\x84\x04\xac\x91\xc9S\xe0\x09SM\xae\xdf\x8d\x1e\xe4\x8byB\xcd\x93\"!-\xb3:N\xc5\xc5\x0f\x9e@,O\x8cQ\x15\xd8\x1f\xf9\xdc\x86\xefm\xff\x9d|\x93g;\xe5\x93\x07\xe9\xf2%d\x0c\xffmr\xfe\x86]\x89\x0d4T\xeb\xcf\xa9\xbf\x14^c\xea\xf2\x93_\x84\xcb\xe1sH\xec\xe7\x859\xe5\xe2\x06_t~\x80\xee\xfd\xfam\x7f\x9b\x0d \xc2\xeaG\xd7\x9c\xf1u\x86\xebH\x0f>m\x00q\xd0Ya\xc0\xc8\"a\x04\xe5o\xbe\xf55q\xbc\x1d\xef\x1f>/\xbf~\x95\xd4\x9eg\xd4\xc7y\x81V\xb9\x1c\xd9\x0b\x92\x03\xdb\x8c\x12/O\xd47\xa3l\x09\xf9\xab\xbf\xe3\xf1N\xcb\xcfGp'x\xdc\xecx\xfe\xfa\xf52\xbb\xd9\x942D\x08\xd0&!\xf8Z\xd5\x8c\x16\x95Mc\\\xfb\x075\xae\x87t\x19\x1f\xf1v\xc3\xdf\xcf\xf4\xe3\x1e\xa3\xe5\xcb\xafS\x01\xecOq\xf3*\xbe\xf2p\xa9\x1a\x18\x8f\xe9\xbd\xb2\xb3?\xc1`\xc9\xc5\xef|\x8f\x1e\xcb\x96}ZT\x1d\x0c\x9d\xa2$\xfd\x16\xd4\xcb#c\x0aU)\xce\x1b\x9c5/\xbf\xd63\xe3\xcf\xc96\x13L[t|Q\xb3\xf7Q\x9b\xa30f=\x12\x03\xe5\xa0\xc1\xbe\xae\x99K\xd5>5g|}\xafY\xa0p\x87\x91\xae\x06\xaf\xb0\xc1=vJ\xba\x99Ri\x07a2\xc3\x9d\x0a\xfd\xfdz\xe1\xcb\xaf\xdf-?\xa8\xde\xf1\xd5\xf7\xa0L\x7f\x91'\xed \xbf:\xda\xedD93{\xa7Y\x03\xa4)\xcd\xab\xb7a\xa5jY\xe2G;\x80\xefAQ^F\xc2\x97\x8c'gMp\xc1\x84\xcd\xab\x8a\x0b\x19=4J-&\x9e\xab\xb8M|\xe8\x877\x9c\xfft\xf76S\x0au\xdf\x97\xbe\x89\xd3\x8d\x86\xf9\x10\xc1\x00\xbc^\xdd\xe0\x8e\x0b\x8b\xe0\x87\xe0R\x16_\xf08c\x15\xcd\x1e\xfa\xc9\x01\xc1\xed\xafh\xc7\x02+x\xdd\xfa\x06\xcb\xa5\x1b\xe8\xc06\x03N\xa9^zS!\xd5\xf9=J\x8e\x97\xdf\xacU)q.\x86Gh\x12\xc6dn\xb9o\xabZXmW\xca\x96\x82R\xa6\x01O+T\x01\x05M\xcd\xe6\x89\xa3\xe6\xda\xe2 (\xca" +
+	"^\x0a\xc8In\x84?\x1d\xc6\x08\xb8\xd6\xd6\xdc_^\x09s\x8c\x0d-\x04G>\xa3\xfc\xa2\xe7!\xea\xb7\xe2\xe3\x00\x97\xa5\xa2\x0dR\xb77\x09\x02\xc4\xb7\xe4\\\xb7\xee\x94\x94\x8ds>\x03d\xe2\x0e#\x849P{F4\xbf2m\xda!\xfc\xe9\x98\xf2\xc8aV\xf4\xc2n\x03\xa3[R\x87\xe6\xce(\xbc\xca\x94\xfck(~\xa6R/\xed\xf6\x7f\x86G\xa0\xc59V\x06X\xa9\xdb{\x8306p\xde\x1b\xa7P\xf0<\xc8=u\xf3C\xaf4\xc9TI\xb5g\xe1i\xa5\xb5\xcbV\x04\xa8\x13Ny\x0a(P\x9f\x09\xa8n\xb2(\xbd\xdeS\xed\xbd\xcd\xb4\xdeQ\xf7J\xf55dY\xb7\xfe\x06\\K\x95\x8f0f9.\xda/\x84\xb1\xb8\xc1\xe4\x8a\xc8\x12\xd5\x81.\xfc\xc6\xdf\xe1\x17y\xf92\xe9(88%\xb9\xb5]\xe3y\x8a\x0fZp\x0dL\x0ay\x0c\x80\xb06\x13\x1c\x0d\x0f\x94\xc8\x81Kb0\xbcYO\x07wi\x03\xd2\xf43\xec\"\x19|\xf92\xc5&.!\x0bC\x92\x87D\xc4\xd2\x88!D\xd4\xf8mG\xa9\xf8Grf\xce\x08\x87\x7f\x16n\x9c\x82u\x89\x91\xfb\x12\x7f\x10\x93\x8e9H\xd3\xd8\xb6\xc1\x90\x82%RFZ&\xffa\xffgK4\xff\xe5\xcb\xef\xee\x10\xcbZ\xc1\xcf\x16\xb8\xe7h\x11O\xc2\xe9\xc8\x96\xe8\xc8\xd7\xdd\xa8\x97\x09a\xc6x\xef+\x19\x16J$\xc1+\x86T\xca\xa37\xe8a\x8c\xb8~s\x8f[{\xea\x9b\xafg5,\xe1\xb9\x0e\x8b&x/\xb8C\\\xe3\x064#\xb52'\x90mQ\xeb\xb9\xbcN:\xf0\xda\x0d?e\xbd\xf0\x9c\x17$h\\\xed\xdb\x92{5\xaa\x01\x1e!;Z\xc2\xa7\xa8\xbe\xbb\x91\x1c{\xf9MN\xb6\xc6\xd4\x81]E\x90\xb5\x92\xfa\x92\xa0\xe6o\xc6\x82\x0d\xe6\xb3H\x00L{\x9f\xf4\x93\xe0\x07\x98\xce\xf8*\xb3\xde\xf9\x16\x9c\xb2\xa6\xf5\xdc\xea?\xf9\x9b3\x95\xbe\x12|\x7f\x0e/Q\xc7\xbe(\xddQ\x16n\xb8\x0e\xe7a1\xf1QB'\x03C\xbe\x06/\x11\x986J\xda/\xbf\x99k\xae(&\x89;\xd9\xb5\xed\x88\x9d4<\xe4\x0b\xaae\xebC\xfd\x86\x9e\xc6\x97\x91\x0eh\xa5D\xfbs8\x97\xf3\xce\xe0\xb8q\xa5[\x17\xd9CO&\xa1bwo\xb4\"\xf1\x0d\xda\xc8\xc5\xf0O\xe4s\xcc;v\x8d\xbe \x15\xca\xcf_d\x96\xad\x87\x94\xb4\xdc\xea\x87\xf0\xbd\xea\xf8\xb0l\xc9\x98(\x8f\xe3/\xb7l\xcb7\xaf\xd6<\xb3<%\xa3i\x9e5d\x03c\xddn\x98&\xa4|&\xcb\x0au77\x9d\x162\x14\xda\xb3\xa0\x8d\x80\x09sp\xea\xf2\xf9i\xdbP,\xf5\xd2\xbbEf$\xd1x\xe7\xda\x7fr7\\\x18\xca\xbf\xfd\x84\xb7XC\xcb\x7f\x0fG5n\xbd\x05\x11O\xae\xa0l\xda\x81\xadFz\x93\xf1;\xad4~\xa7r\x1d\xa9\xc4x\xf0\x1fl\xd6\xfbR]\xb1\xa3\x9a\xccs\xd2\xaf\x81\x1f!\xdak\xfe\xd4\xe6h\xbf\xdd\xf0z;\"\xf1K\x87%\x01\xdf\xc4\xc5\x9aa\xc5\xe3C\xa9v\xeb<:!\xbdu\xcda[Y\x95j\xe0\xad`\x90\xa1w\x9e$\x85\x9fE\xc94\x95\xa9\xe0\x9dj\x13{\x07,\xa5w~|\x1e\\\xe3\xfac\xcay\xacp\x8f\xa4/k\x8d\xf38.\xad\xe8\xa9\x13\"\xe7\x80\x11g\x03\xe3\x993$\xa9R\x92\xb9\xa2\x9b\xfc\x87=\xad\xb5\xcb\x9d\xb5\x0b \"\xb8?f\xefRG\x17\xcf\xde\xe7D\x10\x8e5\xba\xb0\x08\xb0@\x17L\xcb\x19\xef&l%\xa6\xcc\xd0\x8f\xc3#\xb4\xf6\x8d\xa5\x9b\x98\xf4\xfc\xc4\xed\x99\x88\xf4\xa5|(\xa396c\x94o\xd3\xe6\xc0\x11.$\x8f\xd40\xa5\xea\xb8\x9e\x1a\x14MB\xe4\xc12\xedR]\xd5\x05 \x8f\xd1\xb2\xa3\x99\x9dB\"t2\x0e\x0f\xf4\xc9\xdf\xaa>:hz]\x04Z(\x8f:&|\xcf\xf8\x9aB:\xf1CKo\x19\x1d\x91\xec\x0c\x91\x13\x89M\x01:jD\xc2\xb3\xee\xfa}\x9b$ \xf2x,\xd4\xc6\xb8U\x0d\xf0\xb9\xa2\xb3\xaf\xa3\xb6Z>P9\xb0\xb3\xfc\xc3\x12yG\xd2\xbf\x8b\xef\xaf\x09\xa2\x1d\xeba\x86\xce/`\x03\\Jm\xaf\xe6\xa1\x81\x01\xdf\xaeXu\x9f\xcbu\xb9f\xbfY\xf86\xc9Z\x98\x98-F\x88J\x13G\x0e\x01s\xacZ\xe1\\t!\xec*\x8b\xfc8\x9c\xf7\xc0\xbbO\x18\xc2\xbb\xf0E\x02u\x8c1lx\x8f!\xdb\xa6<Y\xf6.\x1a\x07h\xdc\x05\xa6\xe2\x10I\x95S\xa4\xf3\x02`\xb0\xb9\xcb\x8c\xfb\xed\xb6\x9e\x86\xc0\x01\x9d\xc1\xbe\x18*\x92\xb4\x85b\x88\xa2\xdc|F\x16,p\xd7\xe01\x88\x81\xbd=\xe8\xf4K\xf3\xc6\x0d\xbc\x96Y~\xa4\xbem*8n\x82\xcb\x19_\x13~\x8a\xf5g}\xb5l\xd82\xfa\xfad\x01\xb2\xadC{${<\xc9\xab\x1c\"m\xfa\xf1\x84\x99y\x9f5>\xc24\xf3S\xb8\xd9B\\\x15\x1aUvXB\xffG&\xbfnF\x98\xdd\xc8F\x19\xdf\x08y\xa1\x87*_Dn\xb51l\x07E\xed\x18\xa5K\x09\xc6-\x17\xe6\xdb;ru?\xc2\xe8\xfa\xeb\xb9\xc3\x1f\xc5!L\x02\x9aj\xe8'qT\xf0\xdf\xf4)\x06\xd0Z\xcc\xf0\xbf#\xf7\xb9!Kcu\xa59\x81{*R'9d\x82j\x0bf\xa5zM\xa9*\x9c\xf7t\xdb2\x87g]$\xe0\xa5\x8a\x04\xfc\xd7\xbf\xffO\x7f\xfb\x7f\xfc\xfd\x7f\xf9\xdb\xff\xf6\xf7\xff\xf2\xb7\xff\xfa\xb7\xffuS0\x80N\xb2\xaa\xd1\x00U\xeb\x0450&\xaev#\\\xa4B\x0c\x1d>\xca\xb2\x12\xa10]\xb3\xa7\xdaB\x0b\x0ez\xca\xf6F\xef\xfd`x(\x8e\xdbR\x05?s\xeaza\xef\xf1\"'\x81s^\xdc\xc1cd+3\xe02?\xeb:7\xdb\\\x1f\xc0\x8a~\xaap\x0cV#\xfa\xe7\xa5\xe7\xeb\xc0\xf5\xe7\xee.\xd9\xa1\x96M\xc3s\x1f\x8b\x1a\xa6x:$><\x8e\x0f\xd9G\xab\x0fA:\x9d\x94\xfcL\xfa\xcc;\xa2\x07\xd3\xb7\xcb\xaa\xfe'm\x12\x17q\x1a\x97\xd4\xf1\x12\x9e\xba\xe2\xf0\xe8\xb1\xf9\xf0V\x0d\x93\xb3\x90\x10H%\x8b)\xd85\x8d\x9d\xe2\x11\xca\x1b\x9e\xa1\x19\x99\xbawcO\xbeZ\x88\x82\x04\x16\xa0\xaa\x0f\xdc;\x9f\x97\xff\x12HN\xe7!\x16X\x96\xf5p\xa4.\x93\x8a\xad\xfc(\xb3J\x8bQ~\xc7W\xde\x08,b\x10G\x09\xd2\xd4Hr\xf0\"\xf7}H\xcc,\x1dp\xc7\x02\xdb4\xcf\xf8*h\x94\x16\xc9\xda\x98\x95\"2\x1b\xb0DeM\x99*\x10|\xad!\x85\xed\x9f\xc1\x0d<g\x04,f\x00BB\x0c\"\xc6\xd7M\xb2O\xa4\xa1P]\x9bs\x07=\x90\xad>\xe3k\x8e\xc6(Jw\x0ev}\x8b\xe0b\xaeZt\x10\"\xc2#\x7f91\x10\xc4\xcd\xc9\xad|\xf8\x9f\x8cmTN4\xae\"T\x9f5*y\x9f,T\xab\xfc\xc3\xd7\xfb\xb0)fA\xdf\xe5h\xc1\xf5\xd7\xf0t|Z\xd1\xab9\x81,v\xc5\x1a\x0a\xe5^$\xe0B\x0b\x8d\xc3\x17\xf30\xe1v\xa8\x83*\x87\xca\x8a\x86\xc03\x983\xa6\xbb\xab\x97\x132\xfe'\xa3\xc0\x11\xef\x9eV\xef\x0e\xe1\x0c+\xae\x8e7(en\x1eC\xc4.\x9f\xf1U\x12\x88\xf4\x1d\x94\xfd\xc3W\x099\x92\x84jl\xbb\x9b\xdf\xfa}g\xf6\xdd\xb2\xe2\xf1Ta\xdd\x80@\xf8\x87\xdc\x83\x17\xcc\xfe1\x1dVK:e\xb87M\xcdtvs\xf8\xde\xa6\x12`\xbe\xa6\x82\xa2?\xf54\xef!Lr\x05/\xb6\xc5<~Z\xa9\xb6L\xcbmI\x91,Ly\xf45k\xae1r\xd8\xbb\x973\xbe\"*\xadu\xa0\xd4]/\x16`\xac:\x915t.\x98\xeam\xbd\x8d\x99^R\xff\x9c\xech\xda\xcb\xf6\xb0\xe2y\xfa<\x13U\xb2I\xb6R\x92\x82\xd8XbVN\xd4u\"J\xf5(\x18\x84\xd4N\x93\xbb\xeb(\xbcLy^&\x98\x1ah\xc1R\xb5\xd0|\xa2/L\x19\xa9Hq\xa1\xefj\x81\x02%\xb4\xb5\x863\xe5n\x99U\x8f\xfa\x8c^2\\,\xa9\x16\xbe\x17\x08u^\x93bV\xe5\xbc\x0fY\xee9\x12|em\xabMU:\xe8\x10\x04hb\xaaSF\xfc;\xc1\xdd\xdb\x7fu\xbf7\xe4c\xe9kp'\xce\xd8\xae\x92|r\x0c\xb7 7\xfe\xd9u[)\xbb\xd7\xe9\xdcU\x13\xdc\xc8!\xe5\xf4P\x88f\xeb\xc4\xdfd\\\xfa\x92\x15\x93u3A\x0f]\xda\x05\x1f\xa2\x9d\xf0,\xa6:B\xdb\x09\xa3zt\x0av\xe8w\xcc\xed\xa8\xb6\xd6\x7f\x8e\x8e\x88z!\xec2[\xac\xc6L\x9aI+A\xeaX\xee\x10\xdc\xf8)\x8d\x01\x95\xbd\x87d\x88\x8d\x8ec\xb8^\xd8\x84\x07\xaa!\xaa\x0a\xb2\x0d\xf94)\xe8\xa5\xa8a1\x95/\x8a?$T\xddb0s$\xf6\xa98\xa4W\x8cT\xb8\xd2;\x7fg\x0b\x9bz\"V\xe0c\xae\xb2}\x0a#\xd6\xc8l\xd6\xa0\xba\xd7\xbc\xec'\xc7\xc0j\xfd;\xc3\x06\x12\xbc\x98\x95\xc9\xe9\x0b\xa2v\xed\xf0\xd5\\\x86\xd2\xb5\xfd3\xdc\x84\x84\x94\x96>3\x07\x0aHz\xcck\xa6\xa3\xfb\x05B\xf6\x8fR\x89\xf2\x1d\xe1\x97g|\xb5\xab.\x0aG\x9e\xfftwH\x92lf\xb3\xa0\x0f\x16\xd7\xd6\xa2\x1f\xccq\x05\x17\xc6\xcf\xf2\x0f\xfdD\x98\xe1p\xb5S\x84\x08\xf1I\x81_\xe5\xd0\x06\x15\xd0_\x1b\x1f[\xb4\x196\x1d>\xc7\xbe\x009{\xe2\x0b\x84\x8f\xb4\xd5\xe4\xd6U\xc5\xbe\xf9:3:cE\x05&M8RR\x91\x03duE\xea\xaa\x9f\xdc\x19_\xef\xd94\xa3N\x928\x89Q\x8c\x13q\xe1\xe7\xc9\xd7\xb1\xaa\xd9\x89a\xb0\xb0\xf2\xd2\xdf\x10\x07h\xedZ\x07\x93;~\xd8V#\x89\xfb3\xd1n\xb3\xd3\x93\xdcG*S\xad\xfbH\xad\x04%\xc9\xc3\xaf\x06?B\xc3\xc7+\x9f\xf6\x18\xcb\xfc\xe4\x1f2\xe5;\x99FT\xa0\x8b\xad\xafy6\x19\x17\xe8=\xf5\xa2X\xb2\xfc\xa4\x894>Y\x14\xd0\x09Qp\x9f\x99\x85t\x85\xd0\xd7\xe8}I\x01>||\xdd\xe2~\xf3\xea\xb7\xcc\"\x8d^\xf8\xc6\x0f\xc5<\xe5\xf3?p\xf9<\xdcB\xed\x1f\x1f\xa1\xee\xfc\xbf3\xc0pK\xae\xb6\xa8P\x98y\xe3#t \xa2A\x9b\xef\xe7hB\xfb7\x0a\xb6o\x04\x89\xb9p\x18\x90\xef@\x0dx\xc5\xa4\xa9\xf84'\x05\xf8\xb0\xde`\x14\xfe&A\xfe\xa4\x8a\x8f\xd7y\xba.\xc4fI\xf4\x13#\x9e%B\x08\xd3G\xf7}\x94\x02\xbf\xa3\x08\x16/\xb7$\x0a\xc6a\x90\xf6\xe6\x03\xbfqKy\xe6\xcd\xef\xd7U\xce\xe5\xe3\x97\xd5\x95O\xf9\x06K&\xf107\xb0\x04\x87\xe2\x15eH1d\x87\xa0\xcb~O\xc9\"\x961\x8d\x8f\xac3G\xd9bG\x1bL%\xc4%#\x92\xe4\xb1\x14\xc7(\x95\x82\xb3\xfe\xe3\x1e\xea3\x16\x1f\xf1y\xd3\x0c\x8cm\x13\x91'\xca0\xaa:&\xc7\x88]^\x82\xa9\x96\xf3\x95O\x15\x04\x15\xe7\x8eKf\xce\xf9]:\x06\xdc\xb2\xd54W\xa3\xfe\x079Vj\x08\x07\x07LN6\x11\x11\x82\xd0\x15\xef\x0f\xc4\xbbU*\x9b\x0ce\x7fZ\xe8\x86\x9a\xe9\xe7\xf1\x92HL\xbe\xdae|\x8a\x04\x9dF\xcf\x1a\x9e%\xa6\x14\x84\x12\x86'^\xe8{N\xd1aVo\x8ek]7.zgc\xc8F\x0a\xa3\xb7\x17\xdbrB\xa6\x142\x0f\xf3Q\xc2?o\x8d+.\xd1(\xb4\x99oL\x120\xe2\x01s\xad\x951\x0bq]\xdd\xa0\xf4\xa6\xf1\x0a\x1f\xec&\xedE\x99\x1fM\xce\x0eU^\xdc\x90\x06\x07Kk\x96(\x8c\x0a&\x06\xed\xaf\xfcl\xc9j|\xf4W\xd8\xcc+\xa5\xf5\xc9$SQr<\xc9\xcf\xdc\xbb\xac\x97\x80\x06\xef\xa9y\xaa%j\xd3\xc19\xe0h\x1e\x8b\xebS\xff`\x8e\x9d\xa4wz\xfeU\xec\x86-\xd1w\x9b\xea\x03\x8c)\x04wVtx\xb1-\xef\xf4).4m\x98\xe0I\xb6\xbdG0\xc1M\xab`7\xee\x10]\xc8\xa9[T\x0f\xcf\x96E\x02\x1c2\x92m\xe5\xa4\xe7\x8c\x08\x9fF/\xb6\x85\xa4>\xc5\xd4\xf0\x0aCZ\xe6|\x9e\xd2j\xf9\xc5\xb7D\xa0g\xabHL\xc3d\\\xb5K5T%\xf5|Q\xc2\xff\x0a\x83\x1b\x8b\xf0\xbb\xbb\xf7\xf9jY\x9dYF\x98BU\xb8\xa0B#\xa1\xbb\x92\xcc\xfe\xec\x00\x8f\xe9KN\x9c\x86\xdcY\xab\xbc\x140\xb9\xd3R.\x15\xf3v\x1f\xb6\xb5\xb0~V\xf2\x7f\xf1\x8d\x1e\xdd\xaf`q\x98\xf8/\xa4\xe9\xc8\x1a\xc1\x1eS.\xda\xa6\xc2\x066Mx\\\x80w.\xad#n\xbe?</!\xb5\xb9K\xa06\xadFZ\x97\xa6\xefp\x8dr\xa1\xef\x90\xe0bSW\xbb65\x95d\xe6\xc8\x01\xa3\x9e\x1d.\x9d\xd0\xc8\xd5\x02\x97\xbcx\xfe\x93L]\xf1\x14\xfaf\xc2\xcb\x8b\xe2\xf0\xa3qdq\xc5\x1d\xb3\x9eg\xd7\xe7\xac\xc7!\x06\xb5\xfe\xee\xb9\x93\xae\xf3\x19H\x07J\xf8\xbcd\xa2]\x7fm`\x88\x128kZ\xf4;\xa4\xc2~\xa4\xb5\xa6i\x15\xd3/5Lpq\xe1\xd4\xb7\x99\xaf\x17\xc5\x97X&\x0d\xa7\xe0\xad\x1a>\xcf\xc4\x0f\xedvG\xb6\xbc/\x92\xcb\xcci\xa8]\xaf\xa8ofA\xd2\x92\x87\xc9\xa7\x10\xd5\xce\x8bm=\xb25V\x94\x1b\x91\xc6P\x1a:\xd3\x91\xb5\xb9\xf8\xb1j\xe0\xb3\x83~5\x0b\x86\xcbt\xa3<\x16\xa5\xcb>\xf6%zN\xebK/\xd6\x99\x09bs(\x89l5I\xc5\xb3\xe9\x80\xee\xc6\x1fi\xa1ip];a\xfecz\xae&9T\xe8\xdc)\xf8\x1c\x09\xef\xb8\xa1\x0b3\xef-\x12W\xcf~\xc0\x9c$\xc3\xc4\x90\x1a\x9b\x16\xca}=\x9c\xca\xd2\xd1\\\xddm\x83~\xf5u\x96\x1fB\xd7;,\x94c>99\xcd\x88\xac\x87\xe6\xa9\x7f~\xea\xafg\xfe)\x0cm\xb4];\xc3\x99Q\xba\xe2\x06\xbdk\xd2\x1e\x01z\xce\x11i\x18\xb0\x7f\xfc\xa9\xa5\xb4\xf8ZE\x84yC&\xfcD\xfdb[J\x0b\xb1\x1d\x09\xcd!\x06\xd3j<\xe6\x95\xa7\xa5\x8a\x15\xde\xf3\xa4 \xe2%\xa5\x9c\x87\xaa{zB'\xcaV\xfe\x9c\xa0E\xd8\xc8Z\x06\xbb\xec6v\xe8y\xcd\xc3\xf0\x9fD\xb8&I\xb4(m\x04[j\xe5\xdaOS@|\x00\x0f\x82\xb0c7`f\xbfx\x9f\xd9\x00-Z\xc6\x95\xed\x99x\x1fj!S\x98\xc0\x8bm\xbd\xb0\x8f\x13)A&\xd9\x0a\xc9\xc1\xd8l\xc5\xf2\xf3\xeb&\x94g<r\xf6\xf5g\xf3\xd1\xb8\xb5\x93\x85\xf8\xc4\xd1PL\x83S\xc5x}i\xb6\xae/}\x7fu\xb3\xefC\xcc\xe6\xb6\\\x99\x19\xdf\x05\xa5HcP.a\xd2\xcf\xf8*\xbfn*\xaay\x04sC\x1eM\xec\xb2\xe6\xdc\xfe\x8d\x1aBZ\xe4\x08\xf0CZ\xe4[&5\xa2\x13\xd9\xc4\x1c\xe2\x9e\x92UP\x06\xfc\x83\xb1\xa8G+\xd9\xd7`k\x9dC\x079f\xc1\xc9\x19\xbdU\xfdc\xd8R\xcf\xcb\xf7`\x18\xe7?l\x8b\xaa\xc5\xadQ\x1c\x88\x7f\x8e}\xb4\xc5\xb8\x11\x1ao\\\x8b*\x81\xbd/\x93\x1a\xb2\xc5\xa3\xe2\xb3\xe6h\x17\x17\xff\xe4[g\xbao\xc7j\xf0@\x94<\x17\xd7Bf\xc9\xe2\xf7n`=V\x05a\xf8\xdc\xd4\xcbR\x17\x0e\xf7C\x13Y8\x8e:n\xf1\x9f\xd9\xd5ah\xed:6-\xadR\x09\x11\xc5\xc9\xae\x85Y\x99\xca\x90\xb5\xf6\x8ed:\x07e2\xdb\xad\xe5f}\xda\x0eF\xcaUc\xcbX\xac=I|\x92P.\xb0\x95\x96y)\xdd\xe0\xaf\xec\xf0\x8aKO\xc9\x06\xd3,N^.\x0e\xe0\x811X\x883\xa6\xc51V\x040\xbf\xb8GW\x8c\xf0\xab\x91\x8cI7\x02\xc5\x89PC\x0bG\x8d\x9b\x1f|\xe3o\x1aN\xc6\xea+\x1d\x8eH\xd56\xf9\xf0T;T\xd5>\xed\xa6\xef j\xa9R\x19\xd8\xbf`\xe1\xfbg\xec\x18\xe2\xec*\xf5\x9bj\xc1&\xef\xff\\\xb2\xe6\xf7q\xa3\xaf\xde\xe5\x9c\x16\xf3M\x81\xd9!\x9cV6\x8c?g\x15\xc8r\x81\x11\x08\xed\x0f\x95S2\x9d\x90\x11\x92KN\x0e\xb3\xbeG\xbb vH\xb6}\xb2\xad\xba&c\xcd\x90v\x1c\xbaC\xdc\xa2\xc7\xbc\x0a\x80\x9b\xa6\x9e!\xa2\x05\x880O\x85(u!\xc5\x0ew\x91\x03\xaf_\xee\x99}\xe8\xfd\xb5\x1a\x92\x16\xd3\xcf\x97\x01j\xd7\x15\xb5+\xe19|\xa9(7\xa9SsH\x17\x09\xf7\x99\xf2\xc3G\xa5WJ9\xa9\xe8\xf2\xbd(;\x0a\xbd\x1d>Kk\xa9\xd2l\xc8\x0de\xed\xa5x\x80\x89\xb7\xc7\xcc\x94J\xae\x84\xf4igaQ\x8bQ\x9c%\x1dj<A\x94.P\xdaN\xc0-M\xbao\xf7\x90\xba\x89\xdb\xed\x92\xd3V\x10\xb4\xcas\xb4\x85q\xd3\xc3=\x1b\x835\xd1I\x9f\x1f\xf0\xd4\xaff\xf1\x9f\xd8\xff\x05\xbd\x01Go\xdd\xc4\xeb\x8d\x07\xb6\xea\x0aKL\xf8\x82}\xce\xe8\x99&\xf0\xfa\xb1\xa9\x1a:\x18}\xb3h=\xb4\x08\xbf53o6GK=$\x03\xfd\xa7\xd4\x13\xfd\"O\x8a.'\xa4p\xa1\x09\x87\xec\xfa\xad'\xb4\x8b\x18) " +
+	"*\x1d&\x18\xe7\xfd6[\x93\xf0\xd6\xb2;Iz}M\xc5A8\x17\x8c|\x88\x08Ba\xee\xbd\x853\xff\xccIeo\xd5pu\x89\x0a\xd6\xddm\x9bvD\xc9\xb0K\x04\x97}Wx\xcc\x82\xea\xff\xe1O7\x14\xb58\xd3\x8fH\x04\"iDbh:\x04\xa7\xb8\x91\xb87\xd9\xd5\x8aMz\x9f\xa4N\x85\x16\x91)\xaa\x85\xf0\xe6\x187\xf8\x0ea\xb3\x15!\xa8$\\\x8a\xc3\xcc\xe5U>\x04t\xa8m\x80\xb1\x80\xd3!i\xe2\xb5\xc5\x83E\x80\xfd\x1af\xeb<\xb2\xecG\x12\xcb\x8d\xa0U;_B\x9a\xcb\x9a\xcd\x95\x18\xcbK5\xf4\xd0\xe9\xe4n\xdd\xd2\xcb\xfb\xb7t\xf8\x82\xb9\xceH\x15\x18z?\x18m+\x96\x02UK\xcc\x0e*\x1d\x05\x12\xe2\x84\xf5\xcf\xd0\xcc\xd2\xe5\x82{\xde\xba\xbf\x05\xb9\x90\xc9\x96M\x8d1;\xfd\xeey\xae\xc1\xc9xm\x89D}\x9b\xdb\xac\xdc\xba\x1a\xa3e\x17>m\x0fVVH\xef3\"k,\xa4UN\xcd\xb8\x173\xd7\x1f\xc7Z\xe5\xae\xb7\xc6\xf1\xd5\x92\x8e\x1b\x83\xe52b\xd2j\x84REN\xa5\x00x\xff\x14\x8b\x15\xe7\x95(\xc9\x84\x09\x07\x1b'\x1c7\x88\x86\xa3\xacX$\x0fD\xaa\xce(uP\xe2\xd4\x12\xadNq\xb0\xf7{\\\xbf\xe1\x83\x99\xf2\xa3)b\xdb\xba\xdb\xa2=\xb4\xb0\xb7\xdd\x83\xbf~\xd0\xady\x8c\xe4q$\x98\x96\xb4\xf8\xb6\xc4\x14\xcc\xdc\xbf&\xe0YJ_\xf0{\x99S\xd8\xc6\xbf[k_\xcf\xb4\x8fuq\xd0P\xf1\x18\x1d\x19\x85\xb0\xf0\xa2\x98=\xc3\"\xb6\x96aw\x88\xad\xfd\xb0\xad;\xb6l\xa8\xbe\x87\x06\x065L\xe8Tq\xc64\xdb\xee\x09\x0a\xdc\x0a\x012\x000\x18\x18\x17\xfe\xb55\xa9\xefr!\xb4\xb4\xc7;\x10Q\xc0-6`\x95\xae\xbf\xba\xdeT\xd9\xe9\xd7F\xa3[\x9b\xf8\x12\xd3}\xda\xd6\xfa\x92\xea\x89>\x00\xe5c\xc3\x88D\xab\xc7\x17eK\x93\x17\x1e\xefA\xd09\xb7G|Q;D\xbd>E\xd5\xd9\xcf\x82\"\xe7o\xc7G\xff\xa3\x9f\xea\xa2\xa9\x04\xabm\x81\xd0\xb3DX\xc3\xea\xa8\xe4\x1cX\xd1\x09?:\xd8\xa12\xf5\x09\xfa\xf9UM\x00}\xc6,\xb8Z\x82v\xac\xa1au\xe7\xaf\xfe\xc5_b\x07+\xed=\x89\xbfb\xff\x15^\xfd(@AS\xd3\xd4\x04\x9a\x8c\xf1\x1c\xd8\x04mUo+9%\xb7\xbf4c&\xd9\x80Bfg\xf9G\xdaS\x1a\x99)\x14\xf1B\xdcC_D\xcfi]\xb9\xe6\xb4\x84\x88i\xaa\x0b\x84\xbd\xd4\x9f\x92.Y\xdc\xf6\xcfT\xf8\xadz\xdfS\x1d\xf2\xaf\xd0\x00\xd3E,\x96\x19\xbb\x04\xc9\xa8&\xf0\xb4\xad\xf3d\xf0\xddai\x9d\xec|\xcbd\xd7\xae\xe9\x98\x06\x90:A\xb2\xc75\x0d\x0fd@4M\x9e\x86\x14\xc4y\xa3 \xfe\xff\x1fs\x7f\xb63\xc9\x95\xad\x09b\xafb\xbaJw\xc0=\xc0\x98\xc9\x13\x17\x81`G\x15\x92\x19I\x1e\"\xc9\x86\x90u\xb7<\xcc\xe8\xbe\x7f\x1b\xc2\xcb\xcc6\x02\x7f\xdeu\xab\xeb\xa0^C\x90\x84j\x09\xdd\x10J\xad\x86\xd0o\xc2|\x1ba\xafi\xaf\xbdm\x0c&\xcf\xe9\xbe\xa0{\xf0w\x1b\xf7\xb8\x86o}_\xfdi4T\x1e\x06)\xc4vG]=\xb8\x8c\x1b7\x8d\xa5G\xd4>\xf1\xdb`a\xd5/0\xb8\xfa\xb4\xad\x0d\xa5U\x01h\x93\xca\xb3+cn\x0f\xb7\x96\xf9#\xde\x08l\xe7\x0b,Z\x1c\x9dO\xb6e\x9c\x12\x82)b\xa5=\x16?\xf8[\xac\x99'\xa8\xca\xe8j\xba%2\x93\x82)j :\xfd\xba\xea\x80$\xd1?\x11\xc1O\x8c+g\xbds\xa9(\x94%U\xda\x1aj\xbeT\x01\xeaa)cw\xea7\xa5S\x0c\xb3\x01z\xd1\xd8Ez\x80Jg\xa7,\x1d_\xd2\xd3v\x1d\x1e\xa7\x1aF\xaa\xd8\xbdC\\i\xb6\x03\xde\x83/\xb1\x85\x7f\xf2\x0d\x10\xab\x9ef\x86,1\x91Y\x0d\x03\x9aQ\xcap\xbbX\x01p\x8a\x90/\xfc\x7f\xec\xce\xd2\x11\x88V\x8a{/a\xdd\xe3\xef\x08\xb4\x960\x0f\xad\x8eY\xc0I<\x00sk\xc6\xa4\xc93\xe1\xc5\xe3:w\xda\xd6u\xb2m\x9a-\xf7\xc8\xf6\xd6\x8f..\x16\xd22\x8a\xc0E\x06mFH\x10\x95\x00\xc6\x9e\x8fol\x09I\xb2\xc6\xa7\x86t\x12\x9f\xcdV\x9c|\xc1!{\xb5\x86.\x034Q(\xa3\x0f\x7f\xa1\xaf\x13\x0d\x05\x83\xed\x16 S\xe8b!Hh\xdd\xac_\xb1C\xa7*\x83\\\x89\xf0%\xbfm\xb8G\x8c\x0f\xc0C0\x99\x06\x91n'k\xea\xea;\x98f!q\x05\xbaWw\x0e>\xb7\xfe\x0d\x99~Q*\\\xc8\x99\x86G_\xfb^+X)-\xb9CF\xea=)~O[\xf4\xc8\x8f\xa7\xfd\x0b\xddU\xf6\xc2!<\xcdX\xd4\x15a\xc2F\xe7\xef\xd8P\xea;\xdf\xc2\xbb\x0e\xf9\x16I\xfbUb\xaa\xd4SS\x85P\xf5\x93\xb1\x9e\xd5\xc9fX\xba\x9drQfP\xc7\xa1\x836}>\x9cCY\xb2\x92q0\xa3\xda\x99\xbf\x0de]\xe8\x9fC\xc2\xae\xce\xed\x169\xb9\xec\x8eV\x87\x7f\xce@.Y\xad6\x9f\xddyNvm\xfaF\xed\xa9rn%{\xf7\x18\xcc0\xde?\xa8@\x17\xa9u\x85iBC\x15\x91\x08\xea8\x13j\xd3\x12&\xd9\xc2\x1f\xa1\xe6\xd0!\xf3&\xf0\x1bL\x91\xce\xdd#\xf0\xca$\x90I)\xa2\xe6_\xb6\x14e^L\xe8\x0d\xef\xbe\xcb\xb7=\xca\x1c2\xfc\xcd\xee~QN\\\x9fZ\xab\xa1\x84\x1aR\xf7\xd2\x08\xd3J\xb6\xc7\x8cr\x97\xae\xeb\xee0\x16W\xe8J\xe6\xb8k\x80\xa9oR\xee\x16\xb2|\xd9\x9a\xc8\xc8\xe2K\xa3\xf2#\xacJ)\x9c\x9f\x9a*\x8e;\xf4\x09\xc9\x19\xdc\xd6\xe0\x9a\xdd\xda\xbe\x1bZ\x14\xd4\xfb\xae\xec\x1d\x91@\xbc\xff\x9boj\xf7K\x83;p\xb8\x9d.\xcb\xcb\xc6\xcdeM\x0d\xed\xd5\xac\xed\xadf\x96\x86.'\x86\xd6\xbc\x09\xf0[\x8d\xac\xaf_\xaf\xef\xf1\x7f\xf3]\xf1\x83\xef\x8a\xc3_}\xe7\x87\xa3\x9d%\x14\x18N\xa9\x12P\xff\x8bG\x16O$\xccfr\xb8\xc3\x00&%!{\xafz\xe2y#\x98~\xd5=\xfa\xc6\x8d\\\x89\x16\x09MeJ\xa6\xb7\xb3\x0b\xee\xb5j\xe0\x9e\x94:,I,\xd0d|b\x0a\x06\xe8/3S\x9a'\xec?\x16\x94\xfd\xfa\xeb\xc9\xe4\x8c\x1d>5Kei\x8c\x92\x8dj(r\xf4\xac\xf45\x00\xa7\x9a'\xf4i\xc6\x92\x9d\xadR_\xe3[\xfe\xfa\x9b\xd5\xd1\xf0\x1f\xa0\x86\xde\xc1I\x8bwW\xd6\xca\xb8T\x1al\xa0\x92\xe6\xb5\x17GF@hd\x82\x97H(Z11tp8\xed\xff\x8c\xb8\\\\/\x7f\xa4H\xc1\xb7\xe0\xea'\xdb\xf2S\xdf\x9b@\xc4\xac\x03\xa0+\xd5L\xd63\xb4\xfa_\xe1f\x97>\xf1\x8c\xa9LSEV8\xf6X\xfa\xee\xbaj\xa0O\xe7\xa6V?\xb0\xe8 \x0d\xef\x83\xd9\x18\x8f\xd1\xd7;\xeb\xbf\x8c\x8ce\x99\xd4\xd3\x94\x9f\x12\xaf7j\xbe\xf6p\xc7C?\x86%&\xe12Yz\xb6\xfa\xe6\x87G\xff\x07{\xb9\x1d\xb2V\xb3\xe3\xe6{\xe8\x1f!\xf3p\xa21W\xdd>\xf5\x18\x9d\xca\\\xb2`9QwH\xb3 \xf1A\xc8\x1a\x17=J\xb8\xf5f\x8b\xc8\xd0\xfc\xa05\xb4\xe1\xf0U\x80:\xc5\xcde'\xa9\x86\x11\x8ap\xbb\x1d\x9aYIW\x1f\xe0\x0a-\x04`\xc4\xcd\x95I\xb9\x1a\xfd \x01K\x0fo\xf8/\xbcz\xb10\x99)=QJ\xb8\xac\xf8\xea]\xed\x9fl\xebh\xbd\xcf\x95\x1fE-\xfeL_E`6\x0a\xdd\x84Oa\xe0fi\xadL@\x04\xa7\xe1\x93\xc4-Q\xfbO\x81\x9dl\x17<\xd9\x16\xd9\xc2i)\xd0\x99\x94\xab\xd4R\xba\x8b\xe8\xa8\xf0\xe4CF\xdf\x1e\x97\xf7\xbb\x02 \xa0\x8b\xf1HhEmcV\xfcM)\xd6\x1b7\xc8-\xe3\xe1b\xa8\xee\x90\xc6\xfa\x89\xae\xc9\xcf\xd3\xfa\xeeZ\xbb\x8e\x92\xba\x0b\xfc*S\xca\xbbH\xc4@;e\x82[\xb1\xcd\xab^\x0c\x05\x05\xb7e\xb4\xfeH`=A\x8b\x95\xee\xe2k\xc6\xb8T\xed\xe5S\x1d\xf0\x99\x7fx\xf0\x0f\xd8@\xdf\xe3?\xd3\x9a\xaf\xe0#j\"N\x05Z\xc3\xda\x85\xda\x91S\x81\xee\x1d\xf2W\xd1%,\xab\x9a\xd7\x8a\x8a\x90\x02\xd0%\xd2\x9e\x188G\xed\x16\x0c;Ch\xdc\xf1\xa8#@\x08\"0=\xdc@C\x8e_\x9bC\xb3c\x05T\x04\xf7\xe7)\x9c%\x84\xda\"\x95B\xce\x9f%N>\xea\xf215\xd0\x06\xf5\xc2\xb6\xf1\xf0\xcd\x0a\x87\xf7\xae\x84\x88\xd68\xfa\xf6\x9e\xd4\xa2\xe1\x09\x04>#\xe3F\x16\xda\xd5Q?\x83\x81\x9fKb\xe1\x126%\xac\xe3)\x82\xb7#\x84\xbb\xd0\xb3\xb6LG\xad\x95-\x89\xe6\xfa\x0eU(\x85\xa2\xf4\xae\x1b\xddMO\xc7\x14\x89\x19\xcf\xe6\xb2\xfc8\x177\x18>\xb1';4\x9fL%\xed\xa4SiXC\x09\xa96i\xa43\xb5{7\xef`'\x1bk\x0f\xc5\x1a\xfe\xa6l\xde\xd4L{\xf4\x9fr\xf5;\xc5\x8bj\xae\x8e\x84_byA2\xd1\xb9\xd5\x85e\x12\xd1\xbf\xce\xd6\x8ccz\xc9\xd9\x98\xd9\x93\x1d\xf2N\xdf\xdb\xa7*\xdd\xe0\xb94\x10/\x13&\xfdmx46\x0da<r,\xe6\xf1\x94v\xe1\x00\xed\xc5\x8ft\x8d;\xeeY\\\xcb\x1a\xa67\xffs2\xc1Y\xca5\\\xb6\xf5)\xeb\xc8DdxSJI7\xb3\xc3_\x09\xa1\x18\x1f\x19W\xf4\xabo|w\x15\xf8\x908\xbaa\x8b\x93_\xc2T\xed\xa1;\xcb?\x8a\x1a\xe3oX\xb7\x1c\x0en\xa1\xcb\x9d\x05\xdf\x00\x96= \xec\x88\x82G#\xb4\xc0\xf4\xcc1\xc2\xa6\x05\\x\xfb0\x81\x0a\xd6\xc0\x9eh'\x1b\xf4)q:\xbd\x99\xee\x92)-\x00F\xff\x06\xe8`#\x00\x98\xc9M\xa5E\x13t\xc3\xb0\xf5\x16\xa5\xa31\xf7\x1f\xe0\xe2{\xc5\x1e\x1d\xec\x11\xe1\xf7c\xf1g\xf0\xa8\xfc\xf3\x8b\xff\xdb-\x01\x0ek\xe5f\xe9\xee~\x80\x1a\x9c-\xe8\x0e\xf5G;<a\xa36\x95\xd9uq\x06 E(S\x14\xa2E:x\xd4@\xa4\x15\xdfdz\x97B\x151D\x1f#\x15\x87\x8dx\xa4\x91\xa7zoq\xe2\x0a\xabD\x8e\x00\xa9r\x93JlZ|\x0e\xfc G\x89 \xec7s\x8d@\xd5L\x99\x88m!n\x9c,\x9f\xa7\xe6\xec\x0c\xfd\x00\x1b\xb83\xf1\xb0\x7f7\xc0\x13K\x99\x97\x84q1-\x8a\xfe\xe1!\x96\x85\x1f7\xa7\xaanY\x7fr\x89\xcc3e\x9d\x17\x85\xcd\xde\xd5F\x85\xfc\x11u;\xea%4\xff\x81\xf0\xceG\x19hm\x187\\\xfb\x8c\xe4\xa5\x8a\x0d\xcc\xeb\xaf\x93r\x1b\x87\xea;v\x1d\xa2\xec\xab/\xc5R\"\xb2\xeb`\x83\xdf\x8a\xb0M\xbc\xdd\xd4\x95\x99g9\xcb\xe4\xac\"5\xc1!\x1a\x13\xfe#\xc6\x04\x8f*1\x86\x91\xf63}1xgI\x16\x0b/\x10Y1\xf7JWQ\x13\xa5\xf4\x1a\xa7\xe2\x82\xeb<N\xa8\x1bP\x0d\x14\xd7\x9a\xf2\xa4b[\xa8\xae\x88\xa7\x1a:\xd1Y\x89\x92i\xa3\xa7\xd4\xd4\x1e\xad)\xa3\x85\xb9\xc0\xb0\x8b\x92\xf5U\xcf*\x19\x92\\\xe2v\x82\xd25d\xd6D6\xde\xc2\xe5#=\xe1\xb8\xc0\xa4\xec_i\x0c\xb4A\xb9\x83\x93\xbcR\x9f\x17\x06\xbaql\x92V\xe6\x91\xbb\x9b\x84\xf6YBB\xfb?\xff\xfa?m\x91\xcf\x06\x9c\x09\xc3!N\x8a\xdd7\x002\x9e\xcd\xd9\xc6QW\\\x16\x0f\x9d\xdd\xc9-\x9f\xb4\x10\xbd\x87\x9e\xcd\xa2yl\x07\xb0u\xb0\x83~6\xa2\xc4\xcdN|\xa0h\xe51U\xa7\xb6O&\x81p\x12<\xa1H\xf2g\xe8\x9cI\xba?\xfa\xc1;\x0d%\xb0H\x16\xd4\xba'\x84\x9f\xe9\x97\xae\xb8\xfa\xabg\xc6n\x0c\x07E^\x03{\xd1[\xd8SOQ\x9d\x99\x1e\x8b\x9bx\x12Q\xbd\xf8:V\x10\x0f\x93\x9291\xa9\xf1\xb0\xa8\x1c\xce\xf59\xb8\xb7\xd0\"\"y\xa9\x1e+\xa7\x9el\xf2\xe2\xbe\x8f\x01\x01~\xb2I\x91b{\x09G\xdc\xd4\xf3\xa1\xbb\x8d\xd0\xdda\"\x80\xa5\x19\x1f\xe2\x94\x1e*,IO\xb2F\x94\xb6\xded\xa7\xcd\xb8\xfdG\xca\\\xe8\xec\x90Kb\xd72\xcc.\x97E\xc1i\xfd9N\xd9\xd2\x01;+\xe1\x9fj@=V\x83\x04`O\xaa\"\x13\xce\xc3\xf1M-\xccn\xcc&)m2\x8b\">Lz\xbb\x87\xab7>d]\xb1\xab\x8bR\x0e8}\xfc\xc5\xf7\xe2\xf8\x1ejZ\x1bq\xbcO\xa3\x1a\x13(O\xad*\xbc#t\xc1'T\xac#\xfb\xf2c5VCx>7\xfd-\x08\xc2\xa2g\xd0\xc3m\xe5\xd7\xab\x8baM\xd2U\xd3\xa9-\xd3\xc3\xe0\x0a\xe5\x18\x86\xedR\xf8\xaej`\xc8\xf7X\xa3n\xc7=\xad)\x11\xd7\x1aa\xfa\x8c)\x947\x00\\\xf3\xf9\xa9|\x99\xa9A\xa4\xa4%u\xaa\xc6\xa5\xba\x02\xf0h\xdb\xc4\xe8(\x86!\xe5\xfb3}\xf1\x1aC\xb7Mx\xfe\xcb\xea3\x06\x1dO\xc2+&\x86\x01\xab\x0cD\xfb\xe9\x17\x06\xa0\x84\xdb\x84\xa1,<!\xf6\x04>\x98k/I\x1b\x04eT\xe5\x8d\xef\xae\xf6\x9d2L\x96n*\x87.\xb2\x8e\xb8\xfc*\xd5o\xd4\xa5\x91\xa9U\xabz\xbab\x00d\xadR\x8b\xbbF\x03\xd7\xb4\x8f\xc4\x99\xe6hT\xc1\xf5\xf8:#\xae@'\x0a\xfc\x87\xc3\x94\x08\x05\x83ta\xa07\x0clh\xab\xce\xb7\x17r\x871\xfe\x83^\x14|\x84\xb6\x18\xf1\x873}\xc9\xe0r]\xb9\x92\xf6S\x0b\xff\xafy\xb1\xc5i\x16\xbe\xc4\x01\x14\xcbEr\x83\xff8\x0d%\xda\x03\x12Z:K\xff\x87\xee\xf4\xe4T\xa3g\xfa\xc1\xc3\x00\xd8:Y\x02\xff\xb4I\x97<\xb9l\xbe\xe3ju\xa6p#\x8c\x1c:\xd5\x95\x87\xb3\xb7o\x18\xda\xaa\xb6\xba\x90K\xb5\xbc \xd5\x12\xae)$_#kX\xe8\xb7}$\xc7\xe5\xf2\xd6r\xfe\xcd\x1b\xcc\x89\xffn\xe4\xb1:\xfe\x13\x17\xde\xa1\x87\xa2Q\xff\xfe#\xdc`E~8\xaa\xd9\xa9^\x0b:\x0c\xc4\xa2\xd3^<!\xb8\x0c\xe7\"m\x16f#\xef\x1dK\x0a04\xf9;\xc3\xe3SW7\xd7\x81\xd5\xee\xa7\xe5\xc1\xf6\x1c\xbbx\xc2\xd1\xa2)v\xbb\xf3\x98\xa6\xaaW\xa3Wj)\xbd\xa3\x14\x09\xdb\x8d\xf1\xaa\xc7h\xe5Nf\x87\xd6\xc0*\xabf\x96\x8c\x0a\xcb\x05\xc7\xee%\xb2\xe3\xa1\xe0\x80./\xe4a\x81Y\x9a\x01V.\xf1\xe2\xb9B\xe1\x1e1\xfd\xd6\xa1\xffa[\xfa\xef\xe9\xce\xe16\x0b\x1b0t\xafL\x0c9Vwg\xc8y(\xf1\xd9\"\xe1Bu\xb3(U\xa5\xc6\x9d\x18r\x0ac\x91\x0b\x08P\x04/A\xa60\xa3\x0bO\xb4\xd45\xae\xe6+\xd5\x95\xd2\xb2=)\xfe\xc2\x9a\xba\x0e\xf2\xa1#\x82VO\x8aI\x0fJ\x07\xd6\x08\xb1N\x8a\xfdv06\x7f\x17\xf3\xbcihy\xbb`@\x8a\x00\x96\xcb\x04\xb8\xa4 \x16c\xad=}R\xab\x18\x1c\xe0\x1d\xac\xcf\xc9\xd3\x9b\xe7\xb5\xb64?f\xf87\xe1\xf2\xc8\xdb.!>!>\xda\\\xe0#\xc0j$m\xa4\x9anh\xcb\x9e\xf1\xb3@Ss\x07\xbf\xf3O\xd3ia !K\xd9\xfc\x09\x94*WB\xd6\x04*j\x13\x9e\xf13\xd2\xfd\xc1g\xe0Tuw\x85\xc6\xf5\x18\xa8\x03w\xa6\xaf'\xc5\xccC\x09\xfe_m\xaf\xf7\x0e\xa2\xe9\xb5\x83E\xfa\xdb\x19\x99\xc2\x04(2]\x134*\xa0\xd0Y\xe3\xa5'\xf3\x80'\xc1)\x0f#9r\x99:\xa9\xaf\x1f\xa9\"\x89C\xb4\xc6\x84\xc9Ni\xa0A#h\xe9\x08\x94\x0f\xad#\xf3\x9e\x96X\xc6\xcc#\xcd\x88\x88\xb2J\xdf\x9b7\xd5\x1b\xacs\xe6\xa6\xfc\xd6\x13\xdc\x87\xda\xfc\x13\xc9h\xbeS\xef\xef\x19\xa28\xae\x01\xa7\xe5]_\xc1\xad\xbc\xabJH\xc5}i\xef\xbf\x9e\x1d\xe6K\xa3\xfa\x94\xfc\xf2W\xb8\xf9\xd2\xa5\x7f\xfb\xe9\x06pqg\xe7\xd2\xa9\xf1\x03\x0c=\xba1\xf6\x8f\xdf\xc3\x83\x1f\xdcDb\x80E\xf8N\xfc\xba\x82I\x0a\x8f`\xc3:Yf|\xc2\x141;I,\xfex'\x1e3\xf2k\xbf\x9b\x91\x060\xf10\x9c#\x16\xcd\x8a\xe1Q\xd4G\xceU\xe7et\x97\xc2\xa0,Ly'\xa2\xc9;\x15\x17\xd7\x91IH\xf8\xe0\x13I\xc7\x9e\xf1\x93\x7fG\x9b\xf1,\xff\x88 \xe0\x11D\x9d\x8e\xe4K)j\xa3\xc8M\xdem\xdf\x16\xc4\xcb\x19\xdacf/\x9ew\xe7\xd1\xc2aO\xe1I\x91\x13{\x0a\xa3\xa9#\xf1nn\xf1\x08\x0a`0\x91:7m%t\xae\xd3U\xfc\x1f[\xd3\xd4J\xe4\xecR\xe9\xa3b]q\xd0\x7f\xb5\xben\x1d-\xd5\xfa7b\xb7\xd1Q?V\x88`\xd0\\\xe0@P\xa7\xf8K\xc4\xcd'\xa4G\x84z\xb6\x14d\xcc\xde\x1c\xde\xa76\xe8I\x1e\xbb5\xb8\x80%\xa2o\x91As1\x1d\xeb\x06\xd7C+\xfe\x1a\xef\x94L\xa6\xd1\x95\xaet\xb8\xa3\x84\xaeI\x0b%wp\x89\xbf\xe7\x00)\xd3\xa4\x95\x8ek\xbf\x1b\xdf\xf8\x9b\x99(\xe9 f\xa0w\xefG\xcbY" +
+	"\x84\xbc\x9f\xb5o\xdcX\x1c\xe8\xcf\xc7\x1dt\xe3\xef\xf3\xf6\xf8\x18,\xf9\xfaL_R\xe31\xacp^\x1b\xf4\x0fF\xb0pwJ\xeb\xe3\xea\xaah<O\x04n\xd7\xa2\xa1Q\xcfA\xea\xeb`\x05\xa2NE\xe7\x06T1\x95\xf7s\x09\xc86\xe6\xc1\x8b\xc3\x87\x947M\xac\xf8\xe3\x89m\xf7\xbf\xc0@\xf1\x87XZ$\xbeA\xe1\xba-\xd6\x9c\xc8\"\xbe\xdf\x16\xf9\xb71C\xde\x8b\xb4\xb2\xe1\xd6\x8e\x94G7g\xf9v\xafUX\xf5\xce\xf4E\xbd\x10\x1e\x9d\x9e\xd0\x8f\x0e\xe9MK\xf4yi\x12\xa4P\xb8\xc1\x8fk\xac\x84\xa9\xf2q\xfe(\xe9.\x19R(\xd0\x9d\xe9+C\xed\x95\x8e\x8eub\x11\xf39\xac\xdf\x9aq\xa8\xde\xfd\x83\xdbA!\xbe\xbe\xb7\xd2\xf2 \x1du\x03\xdc\x1c\x16\xfdUF\x04\x0d\x0f\xaet\xcd\x0d\xfa\x14\xc2\x97\x00\xee\xa4x\x12\x91\xd1\x9a*\x08o\xaa\x94\x1d\xbev-\x09\x1a\x84\x0e\x04\x96\x0c\xa6\xb0\x03\xa6\xa1\xf9\xc9\xd2(m\x0b\xf5\xe0\xcb\x04\xb4\x11\xab\xefk\xb2\xb2\xc3I\x15R\x04 \xa0&\xce&PR\xf4\xa9\x07\x1d\xf6\xac\x1b\xedZ\x83\xab\x07\xbe\xe3\xbd*\xd7D\x11\xe6+\xd2\"*:guV\x15\x98\x84F\xa7t\xf2\xc3\xb7\xe0F\x8fM}\xd0 \xc3\x84q4\x13\xad\x11\xcct\xb8\xc3(J=\x1c\xc8\xe3\x8b\x84\xcc%E\xd2>\x8aDb\xef[\xb8\x85L\xb8\xeb\xdc\x02+\xd3x\x83\xcf\xf0\xcbi^\x87)\xb2\xe2\xcc\xfe\xdc\xfb\xda\xff\x81\xef\xf9\xe0\xcb'\xdb\x9c\xe9,^\x14|'\x13-\x95\xa1\xa8A\x15]Mn\xf0\xe0&\xeb\xe4\x94P2\x92\xd5\xaa\x12r\x0d\xb5;\xc5\xd5\xa9+\xa1\x07\xac/\xe2>\xafQ\xe9\xf1\x91\x96\x8a\x11\x0c\xc3q\xd5\xc1]X\xe5\xa2\xc7y\xda&d\xe7\xe0\xeb\x94\x8b\xc2\xc60\xc9\xed\xb5\xca\xd0\xd4~\xf9\xa9(K\xdaA+\xcbp\xb4>gE\x8eigfu\xd7G\x134K\xaaU3qTt\x0e\xd4\xd2+\xc6\xaa\xef\xa0\x16\xb6)\xe1\x8b0S\xc6HCR\xb8\xda\xfcFr\x8a|\xa3p\x1euf\xe9\xday\xbd.\xd9\x0e\xf1B\xbf@\xed\xfa\x1dL\xef\xa2Fv2\xbeXl\xb3\xeb\x0d+M\xf0E?\x8d\x9f\xd4\x09\x92z\x96\xe2\x024\x1e\xb4\xd9\xe7\xae\xf2\xc8)\x8ap\x99\x0e\xfe\x06\xfd\x19?7N\x93\xc5)\xfe\x8b&V1Tu\xd58\xb4\xe9p6r\xf7y\xda\xd2\x0e\xba\x16\x1c\xf7\x10\xbd\x1b\xef\xef\xa0U\xd6\x0c$\x99X\xcb\xb8\xa8\\=5\x08\xdc\xe1leo\x09}-R3\xd6\x946\xf1\x0c\xa3\x82,r\xfdrB\xaa\xb3.\xa3\xf1\x06\x0d4u\x92\xa1\xe7\"\xc3t\x8c\x9d\xc2wB\xf4\x13Fr\x9f\x88#\xa2\x0c\x17\xee?\x96\xc9$LEg$)r\x14\"^\xac\x83\x077hfAOQ\xb8\xc3Y\xffU`\xc5\xed\x93m\x02y^^\\}k`H\x9c\xb0Sd\x9a\xc0\x15\xba\xf6\xa37\x0b\xb4,L\xef\x1d<)\xbeM\xbag\xf5x\xbe6\xbe4w\x87C\xe6\x9e\x06n\xe7\xf0Q<\xc0(xx\xc6\x9b\xb1*d\x80\xc9A\x8f-TH\xad'\x8c\xe0\xa9\x91/\xdc\xca\x80\xc5\x8bu\x95@yWck\xcf\x9f\xfd\x0e\x03qxt\x7f\x80\xfeL_\xc9[&\xe1a\xa2Di/\xbe\x11\x0aw\xc4\x1fp\xc0d\x07\x9b\xfc\xb7:\x02i\xeaO\xdc\xd9\x1b\x94\x8f^F{$\xef\xe5\x15\xfa\x94aILFq6\x97\xc8\x8d\xf8\x19\x1e\xdc\xa58P\x199\x86\xa4\x8a\x16\x068J\xac\xb9j\x1c\xbd\x1e\xc7\x0bi\xe2\xe8\xad\xd84(\xde\x8d\xee?\xee[\x1b\x12\xab\x14g\xdd\xa8\xc4\xef\xbe\x851\xe1\"x\x84\xde\xc1(\x05\x7f\xfc8a\x1d\xaf}\x18\x16Q\xa1ne\x1f\x8a\x04b\xe9\x94N\xb7%\xda\x88\xa2\x93>\xa7\xb3)p\xb0y\x8c\xcc\xbf\x1b \x11\xd6I\x90`\x99P\x1f\xea\x84\x14l[\xf0\x8fa\x06\\\xab\xf6\xe2zX\x94\xefdn\x9f;N\xa4\xb4N\xf8\xb4\xcd\xb0\xbfHR\x19A\xf7C\xdez\xd7\xaa\xadF\xe2\x931\xca\xc3\xa7\x85:\xc2\x19\xec\x8fPE,\x9e8K\x981o\xbe\xb5U\x07\xbf\xd4p\xabm0'\x17H\xd7\xd1\x13\xbb4U\xf7\xdcG\xda\xbf\xe8=`7\xfan\x84s\xf8P\xda\x11cq\x14\xe9Za\xccaM\xc3\xb8:\xfaY\x18o\xd2\xa2T^Y.~4\x9b\x86\xe9\x106\xe1-=\x92\xcc\xd14\x91\x13\xca\xac\x10\x83\x18\xeb\xedG\xb2\xc6\x8f\xa1ff6\x0f\xde\x7f\xba|\xba\x15\x07\x14&\xe3G\x991\xab\xc4\x9a\xe2\xbc\x08\xffn\xcd\xec\xaaQN\xb4$F\xc2o)J\xac\xb2\x0d\xb9\xd0\x9a\xf8y\x8c\x1d\xafA\x81\xf0\xf7'\xc5\xfb\xe5@\xb2\x14\x81\x87\xfe1\xbdb\xd0\x19\xa7\xa2\xf5%\xdc\xce\xf8)\x89>\xad\xb8\xdf\xa1S\xf0\x1ea#gF\x8f\xe0-J\xeam\xd0\xd5?M\x03\xd8\x1cS\x17\xfcj\x84y\xa1\xc8\x10tG\xdd\x89\x09\x03\x157\x0b\xda;\xa0\x8d\xech\xe9E\xba\xc7\xac-\xac\xd5l\x1a#\xa6|\xc9\xa81B\x9e\xc9\xee\x96\xad\x91L\x9d\x1a\xd6'\xdb~\xbfe\xad\x92H\xe5e\xad\x06\xf2\xf9\xd7\xeb\x81\x9cK\xd5,\xd6.H\x95\xe8\\\x98T\x86V\xf7\xe8k\xb0[L\xfa\x80\xf5\xcdA'd3\x86\x9e\x96\x85T\x9flK,\xb0\xe2\xbds\x7fs\x9d\x08W\x10\xd5\xc3q\xc1\x7fE\x1e~\xe8\xaen\x1e\xcb\x91\x94\xc4\x95\x8e\xd2\xcaR}2\xc5|X4\xb7Ag(\x93\xfb\x0e\xd6\x81\x17_mn\x10\xf24\x9e\xa2\x1f4D\xef\x1e\x8d\x92\x06ZS\x85\x05\xdd\x1d\x0aV\x88\x8a\x95Q\x91$\x93_yMZ\x0dq\xa8\xaa7o\x94\xac\xa4\x15D\x0dQ\xbaZ3n!k[WW\xe8\xc2\xed\x8fq\x8b\xd0\xc8\x91\xc87\xf0\xdf\x95\x88\xd3T\x00\xa9A\x10\x96Bb\x03\x80\x1e\xce\xf8\x19\x936\xd7\xaa\xaf\x1e\xe0L_'\x0e[\xe0g\xc1\xf1\x07\x9b\x10\"\xaf\x1e#Tg\xfa:M%\x995\xf1\x90\xef\xc1\xb3\x03\x9c\x0b\xa2\xa4\x9b\xd3M\x92\xfe\xc6\x85\x93dk\xcc\xa6\x12\xa6Cg4\x82\xf6?\x86\xce\x19\xe0\xb4-6\xb18n\x1e\\,$\xbfz\xaeZ\xc6B\xc3\xa4\xd0\x09\x85C\x10\\\x95\x05Nv\x93i\xe1L\xef\xfd\xcdp\xbe\xfd\xa1\xf7\xbfH8\xe5cu\x05\xf6<2<\x08\x96AB\x9f\x10\xdb`\xf3\x9c-!$\xc7\xfb\xa9\"u\x87`\x85\x92\xab\x8b\xb0\xc9L6\xef\x98\xf0'\xf1.5q+\xa6LK\x98P\x10B)\xbe>\xd6k \xd3\xd4\x1f\xde\x01\xb1\x1d\xfd<@\xeb\xcb\xd3\xb6\xd4\x84\x90P'\xcct\xf8\x07\xe4\x10\xdb\x96o`ru\xec\xd6\xe2{(\x1fU\xda\x9d\xa7\x92>z`\xdf\xe6\xd2i\xacZ 5\x13\xe5F?\x7f\xf0\xc2X3\xd1\xab1\x9eK85\x1c\x9e\x1a\xe7\x07D\xfca,:\x96%a\xa2\x8f\xd51\x03\x0b2t!\xb6xH\xa3Ja\xe8>\xd9VZ\xf8\x16\xdd-\x9c8\xaab\x1eY\xcf2\xaa%\xa9\x93E\x93\x8eC\xb6\xd4BSC\x8d\xa3\xc1\xf4\x16T\xecz\xa6/\xa6Us\xa3g\xcb,\x0c\xfc\xd1\xdfI\x0b\xed\x1c>4\xb2\x95(\xac\xb3\x84\xce\x80z\x17i\xf8M\xe9\x91\xf147\x8cJ\x13\x86?\xb9\xd3\xb6\x12C\xbb$\x9c\xa1\xb1L;\xa7O\x85\x15\x7f\x89\x1c\xf2\xe8N\xd0*Xi\x85vB\xe9*T\x98\\L\xa2g\x8eU\xe3\xba+l\x9d\xcc\x85\x85A\xc5u\xba\xcd*v\xab\x05na\xda\xad\xfc\x08j\x95\x99\xbf8P\x0f(Y\xb8\x8b\x12J\xd8!\xee\xf0\xde\xb2\xf8[\xd5\xa9\xd6\x13\xb0\x18\x074%\x1bU\xc1(\xc2\xe9\xe7p&\x86\x9d\x84\xb6JG\xf5r3\xeb\xfdP\x91\xe4\x8d\x8b\x91\xb0\xd6`\x80B\x01GX\xe3zw\x09\x93\xf2\xe6;\xc6g$j\x0d\xad\xdf\xa9\xd7pY\x9d(\x8b\x13\xdf\x10\xf3L&\x8c\xac\xea<U\x96\x97\x82\x9cT\xf1\xfc\xc1\x9bu\x1c]\x8f\x14\xe4p\xdc!\xee0\xad+K@\xf2Kl,\x86\xb4:\x97Z\x89\x88\x16keo1\xa9\xa7|!\xab8\xab\xd0\x07\x86\x9a\xc0F\xef\xd1p\xe3\xc3l\xf8\x1d\x91\x07O\xf6\x892\xcc\xdd\xdb\x0fpS\x13\x8b\xf3am\xd5\x89\xa6s\xc2\x0f\xa8\x87UM\x98\xe38\x10\xea\xaa\x05\xaf^6\xbf\xcc\xf1\x8d<\xb6\x89\xf2\xde\xab\xeez\xf3\x9d;\xcb?6\x8b\x1b\x9emK\x17\x1b\xd5\xab\x89\x8e\xf1\x04\x14(Gtpq\xb6\x9aQ\xbcig\x17:\x1eiE]aer\x98\xd7\\\xfb\xc1\xdcW\x92\xad\xbe~*\x01\xba3}E\x9fL\xcf#lfT\xd1\x8d\xd9\x03\x835\xd1\xab\x11^\xd3\x16\x99\x94\x11\x82\x12\xde\x9b\x02k\xda11&e\xb0\xde\xdfgh|\xfc\xe3\xb7\xee\x81*kO\xdbR\x13\xb8\xb8q\xf5\x9d\xc4Q\xf6=m&\xd7\xb8\xe4L\xf1:\xcc\xa84\xa1\xd9\x88\x94f\x9cP\x1e\xa0\x97cf\x9c\xa9y\xca\x03z\x0ek&+\xc6\xa3\xf5C\x04\xf3S\xc9\xd2i[\x0b#<\x0f6\xc8\xa2\x83\x85\x0b\x05\xf1\xb2\xb6Ux\x89\xce\xa5p7\xd1l1\xf4\xe9\xc4\xd3\xdb;\x159J6\\\xbb*p\xf8\xd0\x04\xf2\xe2\xaa\xbb\x04x\xe69\xf7#/b?\x0ar`Q2\x07\xef\x96`\x04\xf0\x97&\x18\xcf;\xa4:\xa8o:\xe8F\x07\xc3\x84\x8f\"j\x17f\xe2 =\\\xfdY\x8b\x93x\xe6Hs\x9dD\x0alV\xd4o\x00\x18i\xf9\x19)\xb8\x15+I\x95\xb7\x83\xb2\x11sg\xe3\xf6\xb3K}'R\xa9)\xbf\x0fV\x8a\xf6N\x83\xfa6IC\x93f\x0b\xe4gaU\xbb6\x83\x08\x91\xb70\x17\xddr\xfd\xcdH\xaa\xed\x11\xf5\x98\xdb\x0d\x12\xfc\xc6\x02E,\x1b\xbc\xc9\xced\xa0@au\xebVKr_~\xbd\xb5#\xdd\xdc\x03\xc5\xdf-Z\xc4X\x0f\xd1\x01\xbd\xf8\x8e\x85]X\xc7\xd6N\xfa)\xe8r\x9a\x99\xb6\x1b)\xa6\x1f\x0f\xd8\xac\xc7\xa5\xc0M\xb4\x11\xc2\xd1\xe7\xf0\xa1v\x02]k\x87\xd6\xc7\x8cO\x1f\xf5\xc32~>\xedl\x18\xbd\xe4~\xf8\x19\xa5\xc95\x83\xe2kXX'\xcd\xce\xd0\xce\xed\x0c\xc8\x10\xd9\x8d\xbe\xdb\xa1\xfca\x82\x98\x02\xebMr\x8d\xa83^\x0c\x15i\xa0E\x1eR)\xb5\x90\x85\xe5^\xf5\x1d\x97\x19T\xbd\x1b!\xf6\xaf\x03\x0d\xa0\x15\x07L\xf7\xb3\xfc[\xdaX\x0a\xd5\xde\x17%\xf9\xb1j\x01~I_\xfa\x8aF\xd5\x0em\x91\xe5\x92\x95\xc5\xba\xae\x90\xec\xe2\x8c\\\xecV^\xf5\xa4W\x9d\xa4L\xcc1\xf4G=&\x8a`N\xe0\xd3\xb2\xd3\x93\xbfd\xde\xec{\xaaD\xde\xa1Ir\xa0\xb0\xa3\x05\x83}\xd9[j\xf5\xda\x01w1V\xe3\xfc\xe3\\\x15\x1b\xdc\x81\xe4\xfd\xec\xb8\x1dL\xd1\x07n\x86|U\xbbQ^`\x94z\xe6\x95\x07\x88\x19\xbd\x9f\xd1!\xb6\x86\xcf*\x91\xf6\xab\xe7k\xb0hl\xc9\xd3\x1cv\xbdK\xea\x0ecv\x9c\x1d\xe7`5%\xc5\x877\xf7\x00\xfe\xed\xdcX\xc5\x87\xfc#4~H:Q&\xea\x0eM\x94\x0fJX\x1d\x83`\xb680AksKN~\x0dO\xba\xb8xL\xa3\x8e\xe1A\xe2\xe3\xfe\xbc\x81!|\xf5r\xb3\x95\xe7\x1bY\xb2Da\xc7i\xfd\x04\x80\x8e\xcd\xcb\xd3c\xac\xfa\x08\xedk\xe0\x91\xc4\xd7\x1b\xf0c\x04o\x0a\x88 \x9a\xcdl\xe5\xc2\x8d\xc21]\x19@\x1e]\x09Gi.\xc2?\xd4\x95 \xdc\xd5m\xe0K\x86\x1cB\xb8\xdc\xdb\x9d\xebPwE~\x8dl\xf9}46\xca\xf7\x1b\xea\x10\xaf^\xcdh\xd9\xd3\xfdR\x9e\xf7X*J\x81J\xb3\x87\x86\x0ds\xf6\x87\xec$]\x82\xd3t\x07=\xe1\xd4\xd4&\xa3\xfaK\x922\xaf^'P\x8aeX\x81\x82\xf7\x11_\xf0\x07\xfc\xb1\xa7\xaaz\xbb\xa40.Jq<\x02o\xb4a\x98\x18\xd3\xe1\x0aPS,/\xfc\x85r\x17\"[,\x99\xe0\xd8\x80\x0c\x84\xe1\x90\x033\xd964\x91ga\xcb\xf7\xcb\x0c\xecW_/\xc5\xa6\xd3g_\xa6\x99i\"\xc0\xca\xec\xfdR\xf1\x9aq}0\x1cr\xb7rL\xba!_\xc3\x92\xe7\xf2\x92\xf2\xbc\x9ce\xe1\xde\\\xbfi9K\x1b\x17\xe6\xc9\x96\x88\xda\xeb\xaf\xe6W\x96\xac*\x1d\x17\x97\xb9\x95-\xe3\x97\x89[\xd4t\xd1\xe4\xf5\xe6\xed\x1b\xbbTMv\xcd0,YH\x8c\xb6y\x92\x89\xe1*aK3u\x9c\xad\xf8\xb3\x97\xc2\x06\xc3\xf47\x99\xdc\xeb\xbc\xd3\xa9\x84M\x025\x9c\xe1\xed\x93\xe6\xcfR\xaf\xb2\xad\xd5\x95R\xf1H\x8fE\xae\xf4{\xbe\x8d\x93\xd8\xb4\xaa\xbas\x0c\xc5\x12t\x88{g*n\xaen\xc9\x85\x9f\x89\x99&zrd\xfc\xad\x19o\xa9\x82\x8e\xe1\xa7\x84\x8f\\\xc0R\xe0\xe3\xc4\x86\xcab]\x82`\xc4\xd4\x95$\xb8\x12\xa6\x97\x11\xc2f\x81\x9f\x14\xed!\x86Pr0|\xbd\x88\x90w\xa3\x7fR\xfc\x11\xda\xbb\xebC\xc9\xf1\xdd%\xbarx\xc7\x85j\xf5\x8f3\x1e\xd9\xf4M\x9e\x14\xd3\xd0'\xcf\x0d\x10Vd\x04\x03$ H\xa3\x1a\x11\xc0|uF\x91\x8f\xb9B\x8e\xd8\x89\xc2z\x06b\xb8\x0a\xbfT\x0c\xe4.\xd2d\x86N\xa1\x8b\xa5\x85\xadxkT\x8e j]\x81\xb8\xaf\x93\xbfF\xad\xa1$\xc4\xab\xe1\x19V0\xf6\xed\x1dZ\xd0\xbd\x81\x0cg\xc1\xc1\x10d\xc7\x1cE\xfd\x94E\xe4\xa3\xd5\xc41\xc7\xachy\x15N\xc2r\x9e\xc5PU\xf5\xa7\xbeh\xa0\x81\xf1\xee\xbbS\xf1\x19\x1a*Y\x88\xd1\x1fd\xeeM5@\x8d\xfa3\x9e*\xd9\x13h\xef\xe8r1\xae\x13\xfa\x88\xf7R:\xb7,\xc9\xd2\x87d5u\x13\xb6\xac\x18\xb0|\xd5'\xc5\xbb\x16\xc6\x02\xa3\xbc\x96z\x82\x17\x92\x92\xeao\xf8\x00\x8a\xae\x1a\x0f\xc2Q\xd2\xf7\xc9\xb6\xf2\xd1\xf7\x19\xa7\xe4\xb5\xea4m\x12\xb3\xfeq\x13\xed\xfe\xb1\x9c\xf8\x93mI\"\x85\xb0\xb8\xc6\xdd\x0a\x8a\xde\x9c\xf9\xeb\xf0\x03\x06\x99\xb0}\x85\x05\xb2\x14\xf0\x0f\x0f\xba7\x8b\x9e\xf7o\xf1\xd4\xa2H\xd1\xec^\xc5\xcd\x9d/de\x1a\xac\xd5\xe2~]\x1e\xde\xe7\x1aV\xdc\xe2\xb6.\x8c\xf0\xd4\x1b\xa9\xf3\xd7\xaf\xed\xbc[.\xb6\xc5\x02\x8eFm\x15\xac\xe20\xff\xab\x14\xd4\x92\xe3*#\x03D\x03\x09\x03B\x86\x88\x12\xb2\x8b\x96\x99\xd9v\xaa\x0d\x85\x8c\xa7\xbb\x81>\x83\xb5\xca\x92\xd1\x87G%\xf5\xfcf \x06\xcfa\xac\xecxQJ\xa1\xf7\x88\xa1\xceW\x02\x13L7 /\xb2\x12\xd0\x98T\xce2\x12-\x80\xeeIq\xf8\xae\xf6#11\xd1!\xd4\xbe\xa3\x0f\xa7r\xee\x9f\x1eEj\xbat=\x86\x16\\\xc6$\x98\x01\x1a\x1a\xc4\x0d\xf4\x8e\xad\xaf\x93\xe4\xa4\xafH\xeaB\x04ADE)1Yf\xa3\xe4n\xf8KH\xb5\xf02\x84\xafV\x0cP\x0f,9\x1c,\x82\xd6\xdb\xb2\x13\x03\xd4\x9f9^Jbt\xf9\xa6E\xdai\xa5\x87\xc5\x92\x8c\x8c$\x09\x7fG0\x89\x84L\xef\xd5\x15\xbak\xda\xb1X\x8b\xcf\xa0\x9c\xf7\x0ebp\xbcq\xc8\xa3\xa6\xf6\xf3{7\x09\xef\xe9AZ\x8b\x1c\x7f!+d\x07)\xdesK\x8a\xf7\xf7\xff\xf4\xeb\xff\xfd\xef\xff\xe9\xef\xff\xf2\xf7\xff\xfc\xf7\x7f\xd9\"\xc7K\xd6\xba\x90\xad\xebBVw\x96\xeeY&\xdb6\x97\xdd\x12\xecG8\xf9\xc5l\x95\xd6\xeeVhl\x12<\xca\x1c\xf2W\xd9\x86Ix! [#\x96\x87\xe79\xd5HH5\xa2c\xd9\xd8\xab_IU\xbd\xd8\xbao\x8a4\xda\xe4O[~\xfc+n\x92\xd0\x90\xb6\xcdi\x93\xfcI s\x9a\xce 9\x873}\xa9\xb19\x82/.\xbe\x14@;\xff9,#\xb5p\xc1\xcc\x01\x0c%m\xd6\x90$0\xb6\xd5X\xf5\x81U\xfc\xc9&\x8fSZ\x16\xd2}\xd4*\xaf\xb0I\x84u\x9f\xa6\xb2\x11\xd8\xe58\xdcL\xcb4\xd0\xde\x01\xfd\xaa\xc0*\xbc\x8b\x97i\xe6*\xaa\xd3\x00-t\xc1\xaa\xc5/\x06\x1a\x97\xee\xeej\xdfPjG\x88\xdbW\xe9\xc37zS\xef\x96\x8c\xed\x1d\xa4F\xdf\xaf\xb7\x06;\xc0\x9f\xa1w\x83;m\xb3\x06\x1d\x1e\xa1\xee\xdcq\xe6dN\xeb\xfc{\xd7\x97\xe0\x87'\xc5\xf7\x09y\xf6\x1e\xca\xf2giFn\x91\xbbO\x8d\xd1\xa4>\x97\xf6\x9f\x01-\xff\xd1\x11<i\x80\xe6h\xd8\xfdvP\xe2|H\x98\xefd\xcb\xd3\xab\xa2\xc1\x8f\x15+\x9d\xd3\xae\x1e\\{\x8f\xd4\xc7\xb6V\xa8\xfeT\x07t;\x09\x9c\xee\xe0\xb9\xd1\xbb\xeb=\xc2\x0d\xd3G\xc9X\x071\xe6\x90\xfeq\xf5\xa4+\xb2\xd2\x95\xd3?N\xcf\x1a\x91%;\xd8`\xf8\x0f\xbeW\xf6\xd7x\xda\xc5w\xd7:\xea\xb7\xd1U\x0d\xf0\x7f\"D\xd2B}k<\xaf\"\xa1\xbf.X4\x14\xa2\x97\xae\xe34#\xda\x9a\xa1\\W\xe3\x84\xa7" +
+	"\xe2G\x1e\x02\xfb\x85o\"\xc1N,\x8f\x14>mN\xfd/\xcaj[\x03\x9dG\xdd\xe8vp\xd1\xa4wZ\xb8\xb8\x10RD\x069\xc3i\xd9\xfa\xa3\xe6k7R\xbdO_\x7f\xe9\xdca\xbaAz\x9e\xd1?\xf8\xe0;\xc3\x8d-\xc9\x83\xf9\x0bE\x91X\x891BY(8\xd7]-\x98_\xe8\xd7\x0f\xeb\x1a\x1cO\xbf\x9e\xa2c\x16R\x12\x8a\x0b\xa3\xe9\x1d\x8a'\xa0{c\xc4pd@\x09uF[u\xd5\x08\xf7H\xeb2/o\x92\x857k\xc1\xb0G\x88\xcb\xeaR\xa5\xcb\xf5\x9f\xc3\x83\x94\x09{\x87\x88\xe0*=\xa31\x98\xeb\xea\xe2\xbb3~\x16\xb5\xef[Q\xe3\xb9^}\xffFW\xc9\xe4\xb0\xf0Rx\xaeT\xf3\x84N9\x87\x8f\xac\xa0G\x8c<[\x17d\x8f\xd5\x0ba\x01\xcdi\x9b\x94\x04M\x9c@vS\xd4\xf0\xe8\x99\x06\x94\x81\x1f7\xdf\x0f\xae\x83\xe2@\x07\xfc\x0d\xdc\xe8Cu\\L\xa6\xc3\xe0\x10\xf4\xd9\xba\xf0t'6\x98Z\x9eB5t\x8bq\xb4v5\x0b\x9fR\x96$=\xbbP\xc6(\x05\xb5\xb6\xcf5\xd0y\xaf\x1a\xa2(W@\xc2\x88\xe42R\x1byJ\xb8\x0b\xc2\xdb\x08h\x0b\x1b\x02\x90\x90c\xf0\xb3\xac,\xca\x8e{\x08\x10\xea\xe3\xfa\xf3\xa5\xf1\xd7_\xa0*c\x16\x11\xfb\xd7\x0a\x7f\x96y=\x9a`j\xf6t\xf03\xdb\xc1\xb2\x0e\xdc\xb1\x19\xc3\xdd\xd6\x9e\xcf\xbc\x8c\x9eX\xf5p\xf3g\xfa\xe2\x96qa\x0a\xf9q\x07\x9b\xca\xc6rEX\xb9\x1f|d\x8d%U\xdb\xa8\xa4\x97\x15t\xfc\x11HK\xa0N\x9cs\x91\xca\xf5\xb2\x85\x1c(-|\\\x88\xd6RY\x08\xe7\xed8F\x86\xd5\xc9T\xf7\x1f\xa6\xfb\x1dl\xb4^\xc8\xbf\x13\xfe\xfd\x0dM\xffg\xa9\xec\xd3\xbdj}\x0dg\xfa*\xa6\xd8\x16\x93\x9a\xf0L\xf7\xd1=\xc0g\xb8\xc8\xab\xffs\xcf\x1a;\xfcL\xf8\xd4Z\xd5\"\xb6\x92\x80oi\x1cel\"L\xd3\xa3\x1ene\x1e\x83Uy)C\xadEcB\x80\xdb\x80\xb78A\xbc\x8e\x13\xe6h\xe1\xc2\xc7\xce\xd42b\xbav\xd4'\xf9\x16\xf1\x90\x0c\xae\xa8i\xd2I\xdc\xe9@\x0a\x9d\x12\xce\xc0W\xa0\xec\x96\x08\x03C\xd1U]U\x17\xed\xa7(\x11k\xe8{w0\xc440\xdbp\xd2\x08\x0d\xd4\xee\x1c>\xb4\xd1\x14\xf5wu\x8d\xf8\xfc#\x8e\xe4p\xde\x01\xebS9\xac\xa1\xf9\x03)d6\xc4\xfb[\xccCjQ\xfc@\xd5&\xe5\xa7Y\xd6}b\xc4\xa7\xa4Vd\xed5e-\x8a\x8a\xaa7o\xf9:\xd9\xde\xe6D\xe9\x8d\xa0\xe1\xb7\x1c\xea\x924\xb6\x90\x16\x85\xb5\xd4QD\xae\xb4<y\x06\x8b%\xb9\x8dT\x0e\x9fc\xdfB9\xd2\x15#t\xbe\x8f\xf1\xaa\x10\xab\xefy\xcf\xd7\x9aQ\xc2\xa0P\xc8\x8d\xd7`y *\x95\xbc\xc3\xa0\x14&1S{x\xa8:7\x1c\x93eZ\xc4[)\xbbL.\xf1\x049I\xd3A\xa8[#=\xdb\x81\xad+\xa20\x0d\x00\xb3\xe3\x0c\x84\xee=t&\xbfK\x09W\xf7\x11z0\xc9y\x92\xd9\xa0\xf4\xd5|\xaei\xb5\x9eO\xe5\xe7\xc2\xc3E\x91\xf3'\xdb$-\xef\xb8W4$8\x17\xb0\x19\xa0\x0d?ka$\xa7\x1cqK0\x0d\xcf\xfd\xc3\x8a\x19\x89P\x0a\xe6#\x02\xf2\xc2\xe4\x073\x10@T\xfa\xa4\x09\x8d\x9d\xf7\x9b\xd4\xc2\x9e}\x93\x05Q\xcbO$\xc1\x92L!t\xddx\x18A-!8\xe3\xd0\x95\x8e\xd5\xa6O3\xea\xc56\xc2\xf6WI|U\xbd\x90\x1am<\xe1\xf3y=\x12\xca\xd7\x0b_\xfc\x91\x91\xa83\x86\x8c\xae\xab\xaa\xe9\x88\xb5H\xaa\xe9xZ\x16\xfa\x0bCU\xa9\x05\x0ff\xa7\xc5\x9dw\xb5Z\xe9\xf9\xd3\xa9\xbf\x93\xb9\x9e\xe4f\xf1\xa8\xf4\xad4%:y\xdb<\x1aq\x05\"5\x9f\x94%!\x01\xce\xcb\xae\x94h)k\x82\xf7\x10\xf5\x0d\x8c\xf4\xd5OQC\xb2I-\x05\xf1\xd1N\xab6B\xa6\xc9M\xd8\x9c/\xb2\x12v\x10u\xf0\x90\x0d\x0fN\xd5\x08\xd6RX\xb5\x11J\xa3;n6\x00l\x9d\xaej={\x97\xcc\x0c\x1b\x9e\xab\x81\xfa\x18O3\x18\x96\x10\xe7\xb9\xd9*J\xf6T\x10rc\xd8\xbaU\x13\xfc\x9f\xa9&\xf9\xcb\x0c\x12\x8e\x0bLX\x16\x8c]+\xb0C\xb4\xc4\xe7\x8e\x0a?\xec\xa4\x03I\xa6C\xd4\x9e\x90\xbe\x0f\xad4\x02\x82\xd7-'g\xfa\xc8\x92`\xa7,=C NS\x14M\x94\x9c\x9a\x89\xef\xf5~E\x1e\xf2y\x8e@\x93\xea\x86D\x9b-\x1f\xb6\xac\xf0\xa1+9\xaf\xaaB\xb6\x9fHaP|\x8c\xfe\x9eG}\xf8\xb2I\xc9\x11\xf6w\xd4\x85H\x83\x16o\xb7\xf9.\x90.\x0c\xa9t\xf8\x81\xe5\x12\x94\xa9\x0a\x0b\x9dI\x03f\xe2O\xf4Dk\x05\xe6\x91F!\x8eO\xa2\xcb\x99\x8e\xc3xD\xedF\xc3\x1c\x8e\xc9\"\xfc\x936\x19\xfe\x1f\x1e\xcdk\xe9lu\\\x12\xcei`\xa5\xc01r\x11|\xb7n{\xaaW\x1d\x16\x0d(\x99\xb1\xef\x12\\\xefkZ~\xa0\xd8\xe6\x9a$\xafg\x9f0\xad\x99\xe86I\xe3#\xf9@\xcc\x9a\xed4D\x97\xed\xd0M+4\xb2\x04(\x11\xed/\xaeo\xa1\x93\x9b\xbe\xe7d\x0f\xaa8\x92-\x8d\xed\xad\xa5\xea\x91\x0aO\x86\xf9\\\x9e\xe4\xb1\x1a\xa0\xd9z\x96\xa7\x89\xafV\xba\xd6\x0f\x1d.\x87\x06\xa1 |\xf5\xbd\x91\xed\xbd\x06\xaf\xbd\x8fr\xd3\xf5T\x93\x99\xcf>p\xf9\xd1\x91\\\x04\xace|\xc000F\xd1\xa9\xc0\x97\xee\xb7\x06?r\xa3\xdfQ.\x9e\x06e\x0d\xbac\xb2Y\x9fw\xee\xd8\xb1\xbc\xfbg\xaa[C\xab\xe4\xa8\xd8\x8ep\x19\x82\x89td4\xbb\x02\x1e\xa0\xd1\x10\xc9h\xce*\x84\x08_\x14\xfc\x1bh/0\xf2\x1a\xc1\xa7mTQ\xbf\x98\x7fU4\"\xc4\xc4\x89\xef\x17\xaeEF\xc4\x19?%H\xd8\x87\xb8\xe4x\xc6\xcf'\xc5\xcf\xea6\xe4v\x87-\xd3\x09\x17\xc4\xd7\x92+\xdbQ\x1f\x19w\xf0\x0e\xc1\xf1\xa9\xfd\x98\xd0\x0b\xc9\xfe\xbaD\x1dA\xde\xc3\xdc\x10\xc2p\xed\xe8\xc3\x80;\xf0\x86u<\xed\x1f@j\xa4\xacJ\xe1\xbfx\xb9\xd2\xb2\xa1\xde\x9e\xd6F\xf0%\x10i\xc6\x1f\xc1\xe8$\xb3NT\x02@;|\x10S\xf5\xc8\xcea\xb8\xc2\xc5\xd7\xa33u\xab;\xca\xc4\xb9\x03\xfe\xbd\xeb\xff\x00^\xbc\xce\x16\xaf}\x96\x7f\xc4\x08R\x92,\xec\\1B}\xf1\xbd\x95S]T\xa7\xff\xd4^l\xf6|W\x15v\x16\xa8\xe2\x9d\xfcp\x87\xd1\x8fG\xdaZ\xeeAz%B[J\x1f\x83$\x99\xad\x14\x8e&o5\x96l\x87\x17\xd3\xc5\xe4[\xe8\\\xf1\xdd\xd0\x83k\x8e\x8b\xefAq\xda\xf6\xc2\xdbV\xafTn\xe1\xfa\x1b\xd1\xab\x17_'+\xe2A\xf4=\x8es!\x07\xd4<\xc1FG\x95\x14[;\xcf\xcf6V=\xfa\xf0s\x05\x97\xcam\xb0\xa3rzb\xd4Y-q.\xa4z\xd7\x14\x1f\x08\xd1\xfa3\x90L\xa9\x80\xd7\x91-\x02\xae\xd0\xcf\xb7\xa3\xa2v$\x96\xb1\xa3rz\x81\xd9\xeb\xf0](\xb0\xb9#\xa1r\xf1=\xf4\x8f\xd0\x16\x97\x8aH\xf1\xdc\xc5c\xa4\x88\x94O\xb2i@s\xd7(\xf6c\xaa\xe5\x14\xb7\x95VP\x1f\xce\x94\x9b\xd1\xb5\xc8\xc8\xe3\x10\x9e\xf2\xba\xf6I\x949\x06\xc6\xa1\x0c=A_E\x80\x16z\xae\xbf\xf0\xc1\xab=\xd3W\x01\x0e\x13(\x833*O\xd0\xacP\x95\xbeL\xe4\x13\xcd\x9a{\x8a\xcc^\x0cK\xa3$\x81\x96\xb8\xa1g\xcd\xd6\x0d\xc3\xbd\xc3\xc1\x19\x9dv\x8e\xb2\xab\xfd\xa4\xb0x\x1e:\xbe\xa3\x98;\xd3\xbf\xbdB\x0b\xc5\x08\xfe\xe6J\x06\x17\xf1h\xa6_\"f\xe8\xc4\x7f\xe1\xa7\xd4\xd2\xe8:UzU0\xcfTK\xf2\xfc\xc1?\xd9\xae}\xfe\x90\x15\x1a\x1e\xee,+x\x96\x7f\xf0\x8e\x85\xfb\x9d-\x90\xc6\xc7\x8b\x1b\xee\xbd\xfa\x88\x16\x97u\x114\xc0\x80?Bg\xf7\xc3H\xe7\x8fY\xf70\x98\xb0\xfau\x81\x91\x8d\x08R\xe5![\x18\\w=\xd3\xd7\xf1\xc9vU3\xae8\x17\xa7\x00\xd8\xcc#e)\x9c\xf9h\xeb\x8e\x82\xe0w\x09\xd1H\x18\xd0\xae\x17\xfd\x95\x1b\xf4\xa3$\xed\xa0>G\xbd\xcbd\x85\x99\xd0\xbd\x10\x1c!,\xddx\x99\xe3iG\xcd._\x90\xc89\xd2BP\xa2\xf3;\xcb?\xd2\xfb\xbd-\xd0\x02;-Jb\x0ePB\xbf\xa3\xd2vS\x8e\xe4\x06\xa3;\x87\x0f\xb1\xf2G\x08C\x0c\x94f\xe3\x98\x082\x9c~{a-U\x16\xf3hR\x0c\xf9\xbe\x8b\x7f\xb3z\xf1yfZ\xbe\x95\xbdA0\xd0i\x1c\xc1\x1d\xee!\xd9\xb9\xa3\xe2t\x01\xcc#=\x99\x91H\x9a\x02z\x0at\x88\xd7 ;\x00\xb6\xaff\xaf\xb4W\xe1\xe6y\\\xcdF\x82y\x0b&\xb4\xb4\x95\xa6\xddj\xbaXEjFr\x1c\x92\xcaE.P\xd6lXZi\xfay;J\x876\xe7\xb6\xd7r\xee\xaf\x9e%3C\xeb5.\xd5\x05:\xe4\xbc`\x9b<V\xb9\x09v\xa0\xae\x06L\xb1\xdf\x8d`i\\\x86\xf0rQ\x0c\x99\x8a]\xb4\xa74*\xaf\xa1\x09\xfbZ\xaa\xde\xcf\xacy\xdb\x95\x9a?\x13\xd3\x0f\xf6\xe9<\xcf@\xb6\x8c1\xf2P\xa9\x89\xea\x0a\xed\x00\xc5\xb3\x9a\x87\xe1-\xdc\xb2\xc3+`\xf1\xac\xff*\x0eX\xa3p\xccc\x97\x1c\xae\x97=`\x84\xbb\xbd\xd2j\xd7\xe8\xca\xfcGb=\x90\xbc\x0e\xf7\x94\x09/\x9f\x16\xab+(\xae\x82Q\xc6I\xc2\xe4\x14\xbd\xa1~\xc4?\x8e\xb6\x9a\xac\xaa]]\x10\xc7\xd1\x98\xe2Z\x97k9\xa7\xe2\x01\x93\xab\x98\xfa/\xd7\xb9\xb9\"9\x1a\x84\x12a\x10\xdbP\xc5\xa9\xa9\xebB#\xec\xa8\x87L\xae\x1e9R>x\x8b`\xe6\x1a\xb3X\x0b4\x1f\xfa\xe3\x8a\xba\xd6\x16\xa5\x91\xa8\xbbU\xcc;m\xd75F\xf1\x85G\xf2y\xd4S\x98P^(>\x003\xbd\x94\xef\x99\xe5\x13\xaf\xab\x07\x97\xa4DKQ\xa3\x15\x92\xd4\xf0j\xf73~\x86\x1f[\x06\x8e\xf6nG\xb1\xe1\xf7KTa\xb4\xca\xdc\xb8v4>\xce\x81\x0dh\x18\xc1\xcb\x89I\x062\xdd\xcc\xe3\x92\x8dW\xe5tuzh\x92\x89V\xe3$\x04=\xde\xee(L\x1c\xc1\xcf<<\x17\x98\x90\xf9&\xb3\xc2\x90?\xc7\xd9\xe0\xbbk\xedz\xd7=\xc2\xdb\x1d\x85\x87\xe1\xad\x0f\xfc\xda\xec\x8b\xce;\xac?\x0a\x93nT\x97K\xa8o\xae\xae\x918b\xf1S\xb4<J[i\x80\xae\xbf.\xa4y2\xa7d\xdb&\\\xd0\xfa\xcd\xdd\xc7\xe8\x98\xea\xb9kq\xaeXL\xf8\xae+\x11]\x90\x9d);\x83+n\xe1\x89:\xf8e\xf0\xfa\x18\x14=$\xa7\xb3Q\xfc\x1b\xc7$H\xddWri\xadO\x0b.\xa3\x1c\x91i\x81\x14\xd1B#ej$\xe2\x8b_\xaf@\x0a72\xdf\xedj\xecL\xc7\xf0d\x96\x98\xb9\x9c\xb9\xda(\xcf\x92>\x97U\x0f\xd7;\x7f\xcf\x09\xc8\xd9\xfb\x97\xf4\xed]\x9c\xb2\x8c\xca-\xca\x1f\x9c\xb4x$\xe3\xefa\xa2\x92\xddX\xcd\xd7\xcb\xd8\xa0IJE\xd4+\x13j\xa1\xfd%\xca\xaf\x97sA\xeb\xc1\xb18\xd1\xaf\x95I\x9f\xe5\x8f\x86\xb8\xe0\xab\x95\x0f:\xc4\xa7:\xee)\xe9\xd2\xe1\xcbo\xc8N\x01\x0a,\xc0\xe0n c\x11\x7f\x88:\x8aX\x14\x8f\xc2\x9dF\xe60\\ \x0c\xd0\x8cm\xc6Z\x89c\xd5\xfb!<;~\x8fU\xff\x09\xb9P\xce\xd0^h\xbc\x91i\x82!\xd9\xc6\xc7\xb2\xcd\xed*\xb05\xa0\x17/\xa2y&z\x8e\x11?Y\x15\x99]j\xce\xa4\xb5x\x0e]\xff\x11\xaahs\xaf1*\xd9cXZv\xb7=Uc3\x96\xce\xc5\xd70\xd1\x95#\xab\xf2\xee\xbaH\x83\x13i\x87\xa0\x85Q\x88\x07\x0e\xba\x0f\x12X\xffh\xd8\xa0\xa2/\x8a\xae8r3\x15\xabe\x81_\xcf\xf0,\xa4\xe0\x0a\x8d\xfa[%\x12\xb1 \xee\x02\xbd\xe2\xff\xb9b\xb5\x1fCyP_\x82\xea,9\xfb\x12Q,\x9b\x14\xe7\xaf\xbfYz\xb2\xb4\xce\x94L\xbc\xeeJ\xfa\xda\xc5\xc5A\x1d\x05\xfb\x9d.\xc5\x86F7t\xe9Y\x9f\x84\x83\x9a7\x17*\xba\xd7\xc2;_\x7f\xb5\xf2@\x91Q\xa2\xb4\xdc\x13\xba\xda\xe9\xb1!\xbb{\x851\xe8\xdd\x07\x0b\xd0SAu\xe4\xc0Ar\x1c\x86\x06\xc0L\xb5?Y\xf7k\x9b\xf6\xd7\xa6\xb4*w\xecgm.q\xb2P\xc2(\x92\x88\xd8\x1fK'ga\xf2\xca\xaez\x0cm\xaaa\xa5<\xe7\xebgY\x99\xc9|\x90\xfbR\x8d!\xbc\x07\x11W\xe4\xf2lt\x9dl\x92[)in\xc0|spS\xb0\xbeM\xc6.\xb7\xec\xbc\xb8\x1fC\x8e\xc2\x04\xb9C\x88>\xde\xa1\xb6\x0f\xca\xe4y\x98\x9d\xc6$86\xb1\x90nPU\xe2\x12\xfa\xa1\xfc\xd4]\xf1\xf0\xa56\xff?md$\xbf~\xb1L\x16\x1a9u\x06\xc7M\xb5j\xb9(\x0d\xea\x83\x15z\xe0h\xe9F\x1e\xe0\xeb\x97\xd9\x10\x88\xf9V\x83M\xcdX~\xf8\xddf\x8a\xfd\x17\xe6\x07\xd2\x98n=\xc9\xab\xe5\x16\xf9I\xda\x01\xcd\x90\x7fG$\x0fb\xd9\xb1\xd0\xc9\x83\xa7\xd1\x96\x1c\xf2\x87w\xfd\xf0(a\xa8\x01\xfa\xadgx\xfdo\xd1\x1a\x12\x1e\xdez\x98\xafw4\x08/\x03,H\x1f\x96PA\xf3\xc5\x14\x83\xd4\xb8\x1a\x16[Q>%h\xafd\x1c\x94\xc88\xc2\xa4\x8c\xa2D\xe9\xe48\x8ei`\x88\xf0\xfc\xc3o\x1dy\xdf\xfc\xaem}\xf8\x00\x0dx\x05\xf0H\xe2\xd4\x18o-t\xb1'J7\xba\xbb\xdfx\xc4o\xbe\x9aY\xb23\xe2\xeeM\x97h.\xa4g\xd7\xb9\xb9x\xe9\xbaf\xd77OSd\xc5\\\xb9\xb7\xf0\xa0@'\xc5\x1b\xf30\xb8\xd1\xdf\xa2]\x0b\xae;J\x1a\x8b\xfa\x95\x90\xe1\xf1\xc0\xe4\xc7$\xf0\xcf\x07(lU\xda&\xa9\x12+\x9d!\x95\x98)\x83\xa0\x8b\x9c'\x97\xea\xc2\xd8MU|L>\x1d\xf3C?\xf9\x8fN\xe5=-\xa8L\xec8\xf7\x0b!Q\xd7\x80G\xdf<Kx\xf7\xe2X\xb6K\xee\xf5\x06\xee\x92\xaf\xc3\x1d\x04\xd3\x97\x07\x9d!\x83\x0b\xcf\xf3\xde\xc1\xfc3\xd9\x98\xf5ro?_^\x06,n\x07\xa7 #Zy\xc4\x9d\xf9;\x16\x06\x84\x18\x0a\x9a\x82\xd1\x83\x15F\xec\x05\xb0\xd6F\x8c\xf9\x1b\xdd\xc7\x1e\xcd\xb3d\x04X\xfcT\x9a\xad\xaa\xbd\x85\x1cS\xa4\xf07\xc1\x82\xbfy\xb9\xee\x9f\xd8y\x86\xab\xa1\xda\xf63\x0da9\xde\xf0\xec\xf9\xa6X~\x18\xdd\xc0~\xfe\xd4P\x81]\x0c\xda\x12\xb1M\x8a\x0d\xb1\xbc7\xc1\xa5\x16/0c\xccZ\x1a\xc9\xcb\x0f\x92\xe0\x17\xea\x95Q#]\xc1\x0b\xbb\xbc(\xf7\x17\x95\xa09\x14\xf8<\xf3\xb7P./\xdf<\xf1\\\xb2\xeb\xa7\x82\xc0|\x1b;p8\"BQ\x16KGJ#uk8\xe8\x96r\xb0\x8aG\xa2\x9b0\x1b\xaf?*\xd7\xaf25I0\x90\xf8}\xe5ic\x86\"\xe1\xb9)\xa7EL\xa6}#\xad\x89\x00\xea\x0euE\x08\xc5\xe5\x04\xd4\xd3\xaf\xbeJ\xd8\xb0\xb9\x11I\xc6=\x13\x9f\xd68\xaeq\x0e\xc3\xf1Q\xb6!\x04\xb3\xb2\x95?\x8b\x85'L\xe9\xf3\xfe\x05%c\x1f\xb8\xf4a\x86\xc1\x0a\xe7rW:|0,K\xbe\xc5VJ\xcc\xf8\x95\xa2\xf7\xa7y\x09Cp\xadk\xa8!\xf4\x8a\x1b\x9d\xbf\xd3\xca\xa2\x05\xac\xe1\xb6\x04\x19\x0c\xbbR\xe3\xa0+:\x18\xe02\xc9@\xd8\\\x80\xa4\xef\xe3&\x18F\xe5\x8c:=\xac\x97\xe8?\x9b#7\x90\x1d\x1cF\x14w\xa4Q|\x90\x94\xdaq\x8a\\Z(\xec\xd7\x9c\x9c0o\xac6\\\x0a5\xcf\x1f\x07U\x04\xba\xf8<\xbb\x1f\x82p\xcd\x12U\xe9\x1e\x15\x8d\x7f*\xda9z\x00\xe1\xd5\xfa\x13\xdc\xa0\xeb\xa0]y\xe2\x08\x06\xf05\xd8aRCod\xfb\x93\xfcX~\x1b\x9bm\xa3\x19\xfe\x11>\xaeUY\x7f\x15\xb5Y\xc2\x96[\xc3-\xcd\xd4\xa8_\x98dg\x92\xc0\x8a\xe8\xaf\x99\xe4L\x0a\x0f|\xbb|\xf7W\xeb\xde\xb6,\x1b\xe1\xe2\xa8\xf5e<U\xdc\xbc\x1c\x977\xa8:x\xadh;e\xcbm\xdd\\\x9evXm\x95\xd7\xd1\xe8I\x9f@\x00\xe2zi[\xaaz(cZ\xda\x1e\x13\xd776\x03\xd85pr0\xc5\xf5bJ\x00\x97b\xd2\xe6\x9c\xb8\xefK\xd0\xb7=\xc6\xc1\xd3\xaf\xbe\xde@ \xffL\x8b\xa4a]\xba\xb9.\xc9\x04\x9c\x84\xfe#)\xf5\xaaz\xca+\x9bR\xaf\x95\xa7\x98\xa5\xdf>1\xad\xe05\xc95\"\x9a\xefL\x9f\x1f\x04\xa4\x8d\x91Ej\xd2\xed\xa1\xa2\xc4W\xd8\xf6\xc82\xedL\xf7YM\xbe\xd6\x15=\xdcZ&0Jk\xa14\xfaO\x07\xecgjx\xfaUR\xf9#.\xe1\x1c\xd2\xb5z\xa8\x88X\xab\xe2\x0d\xf8PW\x83C\x9d\x9d\xa3\xf1%\x1f\xaa:\xc6\x90\xe3\x95\xf0\x80\xc6\xb3\xf8.\x06\x18B?\xe4\xd3\xc1\xce\xd21\xac\xe9\x9fMrj\x850e\x9e\x07) \xbe\xa4*\xec\xc2\xa2ui\x1eH\x09\xfa\xbb(\xd7WW" +
+	"XK\xaa\xb7}\xb3T\xf0\xb7\x84\xf2\x84\xee\xba5\xd6\x9f>\x9b\x8c\xf51\xa9\xf2\x94\xe2\xb2\x9b\xc7u\xaf\x81\x16L\x1e\x1c\xe7\x81\xb2\x8c\xae{\xc5O\x9f>\xcf\x16\xb2\x89\xd3\x8e\xe3\x91/z\xe0\xab\x1eEU\x083\xb5C5\x12\xf9D\xf8\x93\x14\xdfr\xea\xd00\xdd\xcec^Q`h\xb3E^l\xcc\xfe\x0f1\"3}\xd6\x88BA\x18\xd6\x08=\xda?\xe2\x11O\x8bj\xa2\xc1\xbe\xd5z/\xe7r\xdd:\xde]\x0f\xa79\xcaX)\xf6\xd3\x10;?\x02\xaeC-\xb8\xee\x1c>B\xd9\xf2\x03\x1cO\x91\xb7\xdd\x06\x9e\xb4\xf0G\x16mi\xe6p\xf1\xe5\x8d\xeb\xe9\xab\xe4\x89\x8dw+\x15+\x7f\x81\x07\xa6s\x89\x81\x927K\xc5v\xef\x1d\x9c\xf8/\x07\xa9\xb3\xa4h\xc5\xd1\xc6\xeb\xd6\xe5\x85\x9e\xa6\x94)\x99\x187\xb3E\x8eQr\x05\xef\x8c\xaco\xed\xdd\x09\xf5l\x04_\xc7G\x15*\xcb\xc6\xa1\x92\x8f\xa8\xc2'D\xb4S\x8e,#{5\xaf!\xbf\x0e\xc6\x8b\xe8!\xe5 #\xabo\xe5\xfd\xbf\xde\xed\xe4\xc9\x92\x8f\xbbA\xb2\x09\xd0\xf2~\xfa\xb2\x0d \x98\xb2\xd9\xe4[\xe6\x9f{\x91\xf0\xcf\xfd\xcb\xdf\xff\xf3\xaf\xffu\x8by\xee\xf0]'\x1b\xff\x11\xd1\xb6E\xb8\xe2he\xf3\x84mFS\xa7\xa8J\x1e\xfeBUz\x0d\xe0\xcf7_\xfb\xf6\x8c\x9f\xd3<\xffQ\xb7\xf7\xec\x9aq\xf7G\xeb\x90>\xf0\xf4\x87\xaa\x81\x81\x11\xe6\xd9\xdeB;\xd0*G\x98\xae\xd0?V}\x18\xf1Q\xf0\xb4\xff\x1b\x9a\x1d\xd0\xcd\xb0\x14\x84\x9f\x945\xb6\x07\x160\xcd\xeaX\xc4mF\x94~1T=\x84\x04c\x18\xc4xRn\xc5$\x19\xe8h\x88\xf1\xe9*\x7f)\xc2\xd5\xbeN\x9aU\xe8 y\x0a\xc5\xd0o\x96]7\xd5l\xb6J\x95\xff\x82\x11)V\xd7oH\x1d*\\\x1a;\xcb\x9a\xfcH\xd3\x18\x05\x9f\xaa: \xd9\x9b\x15\x9c\xe9\x93M\xc2\xc0?\xcf6\xb2\x81\xea\xc0g\xd79\xb3\x05\xdcg;\x8c1G\xd9\x8f,\xd6\xf9F\x18\x06gNT\xd4a\x9d\xdf\x08\xdfq~\x0c\xd0\xcd\xe2o|\x9fS,\xf6M\xe8\xbbKw\x83\x9e\xa2W\x18\xfe\x0f\xed\x94\xec\xa3\xben\xd7\xca\xc16 \xb0\x9d/\xfd\xad\xf8\x0c\x9d\x1b\xe1L_\x19\xd0\xbf8H\xc8\"<\xfdq\x0a\xbaL\xc04\x94X\xa7[`\x8fgC\x9e\xcf<$\xb7G\x14|Y5\x18\x88\xb8\xfb\xc6\x87!\xbb0\xe4\xb9\x846\x8c\x10\xb4\xc7\xea\xc1%\xe6\xe8\x88s\xb9\x853\x1bF\x14\xe8\xd80\xcd~\x81a\xc5\x16~\x99\xf32\xce\x8d\xd8\x11\xfc\x05F\xad\xdc\x8b \xbc\x10\xa0\x0c\xed\x18Jk\xc9\xf9>\xcem<\x86\xe6Wee\xe6\x04\x1e\x9el\xb2G\xae\xf64QH\xa2\xdb\xc7\xe3\xd1T.%\xdd\x9a\xd2\xb0cC\x9f\xf1S\xf1\xaa.\xc6\x85b8\x018\x1c\xce\x1dC\x8f\x10Z\x83r\xf44>\xb0w\x03D\xc1c\x85%{_\x9dY\x8c\x92\xb6)\xdd\x8e\xfa$4W\xb6\x09,\xdf\xa30\x03\xde\x97\xd9\x13\xeb\xaaq-\xfc\x13[Y\x0d\xd4\x1d\x8c\xf4\x188\xe3bB\x96 t\xcb\xf7\xdf\xc8\xf6\xc4\"\xe3a\xa4.\xe0\xf9\xed\xba\x92\xca\xb7\xc9u\xe4\x85\x93\xabX\xc3\x83\x86cM\xc3\x85\xa72\x8d\x95\xb6\xd5\xe0\xa1\xd5\x8bg\x04\x15\x0bO\xbe\x91\xa4\xb2,U_\xdar\xd4p\xc93\xfd\xd6\x1e|\x9a\xc0C@\x11Yq\xb4\xd6\xbe\xef\x1ch\xee\xa8c\x9b\x07s\xa99\x9d\xf0A/\xc0\x8a\xfc\xa4\x0b\x1b\xe6\x19\xad-?\xd3|\x9e\xd3>,\x0e\x09\x97\xbfMmapd\xf0\x8d\x1b\x11\x7f\xcf\xffZa\xe0{\xba\xaf\xc8C\x92p\x17\x12\xba\xa2\x8as\x1b\xef\xc5\xa1\xa31\x08|\xae\x10\x9cyRd\xf8\xea:\x96\xd0L\xafE\xb9\x8d\x08C\xbaP\xaa.\xce>\xdc\x0f\xf4\xf7\xb4\x10w&\xa8\x1e\xc1\xd8\xe2c\xe3\x8f\xe5\xa7A)\x05j\x03k'\xe1 4\xfd'a\xdfX\xd3\xd6^\\S(\xbb\x9eH,PM\xa9p\xbeu\x8f\x0e\xdd\xf4\x99Wd7 f\xca.\xab\xd2NO\x0d\xb2\x86xDR\x94t\xa4\xc6\xb2\xf7\xc2[\xa5D\xd9a\x97v\x8c^\xc2\xff\x811\xa2\x08(<N\xcd\xab\x89\x80\xd9\xf5\xb5d\xd3\x18\x1f\x05\xcf?\xe6\xe1\xc7huK\xe9b\xd2\x0a\xb1\x84q\xcb\xf1~\x9e\xbf\xfa\xf2\xe6\xbd\xc7\x12`N\xa2\xbcK\xde\x16\xffl\xf4\xc1\x96-\x0b\xbb\x03\xcd\xc4\xbcc\x1d\x09\x13\xc8|\xd9*\x17\x83\x0c?Ua\x8et\x8f\xf2\x10[+K\x8a\xfco\xbd\xa1\xae\x10^?\xb1\x97\x93e\xc3RS\x99\x91\xa8\xc1\xa6\xbb\x14\xbd\xc8|\x16\xcfu\xd2\x82;\xe8V\x0f\xdfuW\xd6H\x9f\x8c_Z\xef&W\xe5\x89\xec\x1b,\xdc\xe1\x7f\x94\xf20\xec\xa7*p3\xfcj\xf3\xae\xd4vt\xa8f\xde\x89 \"\xacK\xa7x%\x1c\xcc\xdd\xf5\xca\xb4l8bm\xb6\x83\xb2U?\xb2\x89R:\x98t\xb5,N\xeb\x13\xf9UZ\x0b>G\x0a\x14^*$\x02\xa9}\xe6\xa76\xb6Md-\xca\x12r4\x12;\x1c\xe8\xb8L\xba\x91\xac\xa8\xaa\x97\x16\xc3\x80bD:\xb0\xb7~H\"\xc1G\x02\xac\xc9\xda\x1eF\xae\x19\"[\xf3\xf6\xf5D\x89B\x04\xa85\xdc\x84\x9e\xef\x81\xac\xea\xa3\xc6\xf3\xe9H4\xa2\x13~\x1d\"n\xb3V\xb5\xf5\x16\xbf\xd8\x7f\x8b\x11\x8fD\x97\x18Uk&\xc2\xc4v~}\xa1H\xf1\x0e\xda\xd8\xf5]\x17\xd5\x97\xb9\xadh\x0c\x1c\x0d\x12 \x02\x87\xb1\x92\x86m\x9b\xc1\x91f\x9c\xd2_-KZ\xcc+\xa0\xde\xab\xd2\xdd\x96\xd6\x10\xdb\x021Pyb\x14\xd8i^\xb8\xea\xc96\xc5,\xa1.\x92\x85\xcf,\xca_\xb4\xfcY\xb5\xe9T\x1a\x14\x7fH\xbd\x99Y[jae<\xee\xa0\xa2]\xd7\x10y\xc8k\xcd\xa8\x16\x9b8\x8ek\x14=\xc4o\xc5F\x14\x93\x8c\xf0\xe69s\xbe\x9dQ\xb7&\x99\xd5\xde\xdf\xe6\xab\x03\xc5\xae~p\x862\xcc\x87\x1b\xf4\x98\xfe\xff\x87;H\xd2\x9f\xd2\xea\\\x89L\xb6\x1bE\x15{\x17!\xe4\xee\xc6d/s\xf5\x8b\xfa\x98\xfc\x84d\x0a\xe4\x0b\x05'Zu-\xa2\xcb\xd6j\xea\xa9!\xc8d\xa5Kj\xe43\xca7\xdb\x03<\xa1\xb2\x8f\xdd?g_\xb3\xa3[W\x88\xdb\xe1\x98dMA\x89k\xa2\xbf\xac\xad\xe3\x99\x1f(X\xd3b\x1b\xb1MfE\x83\x8b\xc3%\x8c\x7fZi#\xa7_]\xf5p\x81\xf1@\x11\x81\xe9#\xb9\xa1f\x83qE\xe28W\x9d\xc9\x83r\xb1\x1c\x0d\xe0\x97:\xcaB\xd3k\x15%\x94\xf0\xa4x7\x87\xf3u\x92w\x88\xab\x0de![\xff\xd6t\x0f\xefQ\xdb\x81\x8b\xd36\x09\xf1\x96\x0fd\x83U\x13:\x0a\xfc\x7f&B\xc7\xdb\xf3\xb4\xcf\xe2W\x06\xf1\xd0\xa9\xcf\x9a\xac\xb6Ixs~\x8d&Km\x9bP8\x82U\x0eu5\xba:\xdc\xbeqe\xe8&\xc6E\x91\x19T\xbb\xbc\xb2\xc4X\xcc\x91\x0d\x82\xfc\x828i\x08\xa5\xae\xef\xb3A\x9f\x11\x19v\xdf;\x83\xa0\x99\x8a\\\xc72fr\xda\x1e!\x98\x02\xb8&7\x91\x07\xbej\xab\x01\xe3\xe4\xd4Pa\"\xda\xda\xf98t\xceZ\x9b\xf1m\xd5\x05\x03\x17{Gp\x99\x18\x8a\xc7\x80\xbf\x016\x8f\x10k\x03\x8b\x1b\xd4\xae\x8e&\x89\xa4\xa5\x8e;\x98z\xff\xcf\xd3\xb8&.Z<h)$\x9e\x85i\xc3\x01\xa7\xb9\x18\xbe=\x13\xc7\xd3\xe7\x85\xab\x138\x8e\xae\xb1\x10Y]}\x02<`.\x8909q\xe9\xea\xf4\x04\xaa\xfd!\x02\x19c\x98?Ge\x0fp9\xc2\x96\x8f\xd0\x00\xf6\xa2\xbf\x176F3/\xc2\x94\xe7\xb1l\xe5\xd01\xf1\xa7z\xe8\xdb$\xc7_\xb8yc\x14\xc9\x15\xbdo\x95\xa0\x1dC8\xf8\x07\xdc\xec.\xc8d-\xc5\x95AdWb\xb2\xe1\xaa\xc5\xc0\x90\xa3;\xd7\xcf^o\xbe#\xf9\xf3\x89X\xb7Aw2\x1d\xb9\xcd\x1e\x1d(}t\xa4\xf2\x88\x1d\xcc\xbe\x7fR\xe3Ym5$\x01\xb5\xfb\xb0\x8b\xd0\x95\x0c\x85K\xef\x1f\xde^\xdf1\x153\x0boj\x94\xfeJ'\xbe\x87\xe0\xa0\"(\x9f\x81<\x98l\xc6\xed\x03\xef\x15\xf7\x0f\xf6\xe0\xf0\xb0'\xc5wq\xe4P+\xe4\xb6\xdeo'\xf7\x89\xc4\xc0?\xab\xdb\x8d\x81\"\x18aX\xe8oI\xbf\x04\xcf\xd2\x14\xfc\x95\xaet\x08\xa7\xe1Am\xd2\x80\x98\x1e\x0b\x88aO,?\xe5\x8c5\x9d=9\x86Jd\xe7L\x7f\x0a\xc4\xf6\xbe{tk\xef\xf5\xfc\xab)\xea;\x01E\xcc\xa4\x89\x9c\x017\xfcq\xba\x95\x93\xd0\xf4\x1d\xba\x12\x87\xc4\xa3\x82\xebT\x9c\xc8\xa8M\xbd\x99\x93\x84\xb5E\xc3A\xd4\xc5ns\xa75\xf9\xc1%(3\x1b\x85[\xfc\x95O\x97\xdb\"Y\xc3\xbe\xb4\x09\xb4\x0d\xcc\x8b\xe7\x89#sn{\xa7\x1aFL\xf6\xc3\xc0\x87Kv\x87ep\x1cJ\xef\xd3\xc1\xb4N\x8a\x8c0\xce\xac\x19\x13\xab\xf3\xa3\x88^\xbb\xae\xa8=#\xb1K\xa9\xea\xca\x9fg\xfeA\xf49\x04\xae\xec\x090B}C\xec\x08\x8fp\x9b\xf9\xcb\xcc\x91Dlv\xa6\xaf\xb5\x9ff\xcee\xea\xc03\x7f\xdb\x94\xe5\xcau\xe4\xe0j\xef\x911\xb5\x9a\x1c\x9cnmnh\xa0=\x19\xc9\xb3\xf3\x92\xf0\x99\xdc\xabj\xe0\x11\xbd\x15\xfc\xce'Y^]\x83\xda\xbb\xaeC\xc5\x1d\xb1\xb3\xe8\xd6Gn\xe0\x94eJv\x96\xe0\xc2\x8dF\x0b\x1b\xf1\x0dtbN.o\x88!j\xdf\xd4l\xf7u\x8f\x1eh')\x9d\x04\xcat4d\x02\xc9q\xb1)\x98\x89\x9a\x92\x8e\x06!G\xee]\x96\xb8\xbf\xad\xec\xab\x89\xae\xe26Z\xe5\xf9\xb3\x14\xac\xf2\x19\xd3\xdd\x0b\xa4B\xa5\xeb\x1d:N\xc6o:\xcd;4\xa1\x93(\xd3\x8c\xd7<NP\x94\xc5\x007x4\"\xc3\xd8\x97\x04IX:HG\xd6\x93\xe2OV\xf6\x9c\x1c\xab\xd4\xee\x0d6L=!\xfd$\xbfz\xd6#m<\x0c\x01\xf2\x80\xa6\x04\x15\xec\x1d\xbf\xcc9My\xc5\x97\x08\xb1\xc2\x83QC'K\x8e\xa8\x0a\x86\xd5\x1bk^]\xef\x8e\xcc\xec\xc8(L\xf5\xb8\x17\xde\x0dz\x9f\xbc\xdcd^1\x8bw\x98U\xea%_q\x9e\xf0\x9a\x85\xc4\xd7\xd0\x9dr{A\xc4\x92\xf9w[\xdd_/\xd4\x18\xf2\xd6\xcc<j\x93\xda;\xa6\xe2jrz\x8c\x89\xd0\x12\xf1\xc2\x19\x0d\x83\xd2\xf1\x8b\xd6\xd4Ij\x03\xcd\x8a?\xdc\xa1\x1e i\x09\x9a\xcc\x8a\x9fi\xc3\xc0\xf3\xf4\xfe\x0d|\xf4=\x01_\xcb\x19%s\x06\x13\xb9\xcei?E$i\xb8bTy\xf9H\xb0\x1f)\x10 g\xf4\xb3\xe3~\x99\xc6\xa0Z|\xcc\x84\x90af\xd3\xde\xed\x9a+\x11/]\xf1\x98\xf3\x0f\x97\x8e\xdae-\xd7\xb0\xc2\xe7\x9e\x12\xafDq\xf8\x18\x97\x8aTr\x96\x99\xad\xb8sPX\xea\xfc?~\xea\xc6O\xb73}-\x88P o&\xfa\xc5\x89m\\Bg$\xa6\xe6yw\xa5\x9cw\x0f\xff;6\xf0\x81\xc1u\xc7\xe2#.<\xd2\x8e\xc7\x9c\xab\xe6I\x90\x8fVQp>\x98Wg\x15\xad\xa0X;K\xfd7\xfe\x12_\x09\xeab\x0c\xab\xff\xb0`\xd2\xde\xab\x06\xf3\x13\x98\x00A\xb9\xd9Q\xc0\x05t`Q\xc3G\x02\xfa\x1f\xe9\x9f\x14\xd1\xc79\x11>\x8a\x8b\xa3\x0dL\xc5\x19\xe8\x19\x8f\xfaX\xad\x1f\x1d\xf4\xa0\x0f\x80\xe5\xf36\x01\xe4X\xfb,t\x1b\xa6@\xe5J5\x10L^4c\xadP\x19\xbf\x1f*\xb4\xa95O\x1d\x07\xe1\x0f\xad\xef\x95\xee\xefhj}\xb0\xce\xc7\x1c\x19\xa6\x88\x04\xd28x\x16\x1e\x07a.\xe8\xda\x14\xb7L+\x9f\x06\x963\xba\xe9\xd1\x99\xe8F\x7f+\xe0\xee\x9e\x14\xff\x0d\xf5\x94\xe8\xa9H/S\xfc\xcc\x0dg\xfc<\x9eLp\xd3\x11A\x0b\x0fn:\x01\x97\x9d$\xd8\x09\x85\x86;S\x1fD\xf3/FD\xfel\xfe\xcd\x86\xba\xc8\x1b/{_i\x95\xf8\x93m%\x80o\xc3\xe0\x1f\x82C\x90\x09z\x17-\x0c\x0f\xae<\xd3\x97-O+\x9d\xe8\x05Y\xdf\x0b\xbd~\xf1\x97Bs^\xfcXt\xd0R3$%\x19l\xf8\xd7cXx\xaf\x8e\x03&\xf8\xbf\xd5\xb8\x162\x8c\xba\x02\xb3v]\xe9\x1aR\xb3\xe28\xc6\xbd\xea;\x07W\xe0\x87\xa2\x83\xb8\x84\x11\x0fx\xf0\xd0\x04\x9b\xce\x09zA`\xa06\xa8\x8a\x81p\"\x1f&A\xe9DM:9\xa2\xbd\xc0#\xf4\xaa\x1d\xac\x81\x18\xe2\xe5\x8c\x86\xfd\xe8)\x10G\x8bp\x19\xc3rG\"&\xa4U+\x92\xbeHt\xf0\xfa\xa9\xfb\xb8\x86\x05\x8b\x82\x06\x87\xef\xa9\xc4\xcaf\x1b&\x0e\xe1Q\xac@\x1b\xa4\xc77\x9c\xaf\x8d`\x86\x0d\xf9\xf5\x98\x17\xc0fa\xa5\x84TS\x02\x01'\xab\xea-\xf7\xed 4\xa85M\xd2;\xa7\xe9\xaf\x84\xd7J\x1au1\xa7P\x8c\xd0\xddaCl\xf8\xf9\"I)!\xbb\xa1\x85\xe6\x1c>2\xdal\x06\xbe\x16\xbf\xc0\x08\xed\xa7\xfe\x0aT}D\xd4)\x91\xe1Y\x97O\x06|\x80\xeb\xa7\x84/|\xf8\x0d\xae1{B\xf5\xac\x8e\xd0\x10\x04`\x11\x96J\x97\xa0%\xee\x84].R\x8eT\xa9\xf6\x8c\xbf\x87xt\xb8t\xe8=\x99\xe9!\xa5\x1e5\xfd&cAU*,H\xde\xb4\x08\x0f\x8a\x8f\xbef]\x8e\xc4\x0aar\xa5\x8f\x15s3\xca\x05\xd0\xfe\xdc!=A\xb5&\xb4\x19]\xab\x06\xee\xc5\x15\xc1U\xa1\x99\x1b\xf0\x9a;\xc3\xd5E\xdb\xb9qw?r\xb9]\xa0\xd0\xaa\xf5\x17E\xa8\xe1\x9fc\xcc6\xfeph\xe0\xea\x8e\x05|\x86\xeeMzK^j\xba\xd2\xdd\xce\xc1h\xc0\x7fE^\x9d\x92e\xf6T\x96e\x8cA\x8a\xd1IR\xdf\x01\xd7\x86R\xd4\x86\xa4\x0ah\x8b\x9eX|x\x96\xf0\xdc\xc8.$\xe4\xe7\xbc\x86Qk'\xd7\x8f\xfe-\x9f\xc3\x10\x8c0\"\xb6\x156~v\xd6\xa6\x8f\xe4\xb6\x03\xa8\x89\xf9O\x86\xf1\xa9\xb8\xe8\x0eb\xa6\xa0\xb5\x84\x84-\xcaP\x82\x05\xc4Yw\x95\xa1\x13i\xa6pu\x85G\xc4\x84\x04^\x88\x84\x9f\xa2\xca\xe2u\x87\x80\x929\x9a\xd5\x98\x13\x12\xe1Y4\xf0\xb2/\x8e\xb5\x19\xa6|\x91:\xc0)\xa9\x09\x91^U=<\x84?e6\xa0!\xc8J\x8e\x96\x08,B\x87\x98d\xe3\xb8W\xde\xc3\x84\xc3q\x10MSv=\xd48\x84O\xc4E\xc7bJW\x04\xdf\xd7\xd0IN\xf3@l\x1cg\xfa:r@,\x9e\xc0\xd5z\x1d\xf73\x8d}\x19\xf8\xacl\xc1\xbb\x94\xc8w\x05\x0f\xc7?\xe0KG\xc1\xda\xa02\x7f;\xe3\xa7\xe8b\xb1-M\x03\xc28\x08\x87\x8b\x1f]\x1f\x9e\x89\xbe\x8fE5XI\xe2hR\xe2\x1f\x0f\xa4\xa2\x0d\xddY\xfe\x81\xef\x1dU*\xaf\xbe\xf3\xdd\xf5L_\xfc\xd0\x0c5\x10\xdfp\xf6\x96n\xf4;v\x17\x1a\xe0\xc4\x07)\x97\xc3\xe7Z\xcbr\x7fp\x0dx\xe8\x8a\xda50\xd2\xe3:\xb4\x092c5\xaa![s`\x87\xe8\x8a\xb5+\xaf\xd0\x11\xe7\xea\x84\x0cm*t7\x8d\x19/\x09\xf5\x12\xf0rA@X\xd7\x9f]O\x9dp\x80\xf0r6!\xc9\xa3y\x82j\x91\xc5\xad\xfa,~\x7f\x94\xa0Lyh\xe8\x10\x0ez\xabOB\x09s\xa3\x9c\x8b\x1a\xc1)\x8dM<\x8cu\xd4=P\xa6\x96\xbd}=0\xe5\xdcIO\x12\xd4v\xed\x9e\xf0\x1b\xd9wY\x1a\x18\xcb!\xf8\x0f\x1e8%\xb2\xd7\xc2\x7f1Ol<\xef\x93O\xfcpM\xcff\x96\x97k\xefn\x0d\xc0\xf1\xc5\x14\xa0{\xa4Z>L\xaa\xa33_\x05\xba\x82\x15,\x94\xcaN\x85\xe3\x0eR\x16uT\xea)Y\xde\xd2\x113!w\xcd\x04\xea#\xe2\xcf\x92\xb1\xfb\xa50\xe8\xa6\x8cOJ\xb3\xc2&\x84\x09\xbc \xf9\xd0\xc2\x8b\xd2`\xc1\xf0\xb1\x10\x12wW*\x93#\x90p\xb0\x80\x8e34\xde\x91\xd1r\xf6\xe5\xdb\xaa\xfb\xd4\xa8\xd45Y\x9d;D_\x98S\x9d\xe2{\x15\x92c\xf2\xe4\xa3\x0b\x1d\xea\xaa\x85\xa1!\xd2\x83\xa3)\x06\xa7\x97M\xb8\x9b\xe9}\x84-\x11F\x7f\xdb!\xf2\"b>u\xc5\x8e`F?\x83K9+N\x97\xccfm\x14\xca\x0c\x8d2\xa7\x11T\x9f\x9a\x0f\xe9\xe1\xea\xcf\xe1\x03\x7f\xa6{\xf1\x11\xec\xdbA\x03\xfelu\x98\x93\xce\"\xe8M\xedY$,\xd3\x8b\x885\xb7\xa7\xad\x8a%<\x7f\x87\xb6K2\xe9\xb1\xb6\x9b\xfd\x9a\x04\x98~*\xbet\xd4}\xf9\xa0\xe3\x02\xa0\xc0C\x13\x96\x133\xa5\x15hk\xe6-\xf6\xb8\xcc\xd4\x1d\x15\\\xdb\xa9\x8b\x97\xcf\xa6u\xc6v\x05\x1b\x01\xc6\xb5\x17\x0e\xff\x97\xf8\xef\xf1 \xd5\x13\x8d\xd6p\xca\xcb\xb2\"\xfc$\xfc0\xc4\x0e\xb0\xa6~\xf3|~\x85\\\xaa\xa1\xc2\x12K\x18\xcf5\xd6\xc4\xe1A6\xf4>0\x08Q\x17;\x0c\x881\xeb\xb6\xf1\xd7\x05\x9fxusd}\x7f\x9a\x10j\xc8\x9d\x0e\xca\x990BD6\x96\x8e\xb1\x18" +
+	"T\x14\x9c\xfeH\xcbl\xd8I:hr\xf9\xa0\xdf\x03c\xf0r\x85\xab\xf3\xe7\xd9\xee\x0f\x8fg\x92\x0e8\x1a\xa8\"4\xa9\x96\xe5\x9db\x1a\x88\xaf+,h\x96\x81d\xb3\xeff\xebD\xe9\x8c\xc4\xc5\x96l\xb6\x9c\x9d\xa6\xf74d\xdc\xc2\x08\xe7\xf0\xb1r5\xcdt\xe8\x03\x9b\x91\x8e\xb7J\x10\xb8Sm-<\xdbp\xa7\xce\xbdS\xa2\xf5\xf1\x88b?5VY\xb5\xa05a\xca\xf2<\xe2P\xdb\xc0\x0a\xbc\\\xb4\x06/\x9cXZ\xa4\x96P\x9d\x9a\x14\xb1)\x9a\x08<JL\xe4\xc2\xd0p\x91\xff\xf4\xde\xc1\x84z\xcf\xeaW\xd6\xa9\x8d\x82FY\x88|\x86\xff\xf7\xad#y\xb5+\x84\xa7\xa0\x8b%\x8c\xbau\x16Z\x8a@\xa5\xe4\x8a\x12!\xa3\xdb\x87\xab\xc8#TW\x02i%\xe0\xc4(l%\xd1\xd9\xde\x957 s\xcc2aG\x86\xf8\x09Ko0\x05k\xe8\x8b\x03\xf30\x1d\x13LH9\x95x\xc2\x051\xb6K\x01\x98R\xae\xba\x11\xfc\x001\xec)\"&D\xe7P\xfb\xc0\x89#\x83A\xf4c\x12\xdd\x9f4|e\xe9y\xe6Sb${w\xe4(\x9d<\xec\x01\xc7\xc9\xd1p=\xfccu\xc0I\x09\x09\x07\x81y\"j\x1cx\xf4\x1d\xc8\xdf1\xf8{Z\\HNI\xf2\x9d\xa3;\x84\xdc\xde\xa1\x0a\xb5\\\xd8\xb7\xc2J\xa1\x11\xa7\xaa\xa5\xa8\xfd\xc10m\xe2\xe6\x1d0\xae\x92\x064\xf4\xde\x9a\xe4d\xeat!Z\xd1\x88\xb0\x96(\xa8\xcc!\x91{qU\x9fL\xa2\x16\xc6\xe2\xa1j\xaa\x9a\xe6s\xcb\x9b\x1f\xc6@\xd6\xd2\x8b/\xbf\xde\x87\x12\x8c\x99h\x9b\xc4=\x18\xbc\x80\xc0:\xf2\x14\xf7i\x09j\x1d\xa6fx\xbek\xb6\xa0\x9cRd\xa1\xcdg\x8e\xee\x0a\x85\xc8)\xa38\xae\x84\xfa\xc3}\xb1\x80H&}\x1c;\xc5\xe0/\xfev*\x08)\xac\xab1\x08\xff_q\x87\x1a\xd0\xe1l<\xf4G\x1c2\x85!\xdfQ\x92\xda2\x19\x92\xc5w\xc3#\xfc\xe1Iq\xf8N\xd9\xec\xaf\xc0H\x95\xa4\x94s\x0eu7I\x97XD\xb2\xd1-*\x0exUL\xde\xe0|\xd3\xb9\xdf\x12\x00GD\xbaM\xbe\x9e\xa0\x93\x09$\xef\x189\x1a\xf50\xe3^?),\x1b\xa4\xfa\xd3\x0a!\x8e\xee\xaby\xb1\xdf\xa7d\xe8\xe57sn\x9a\xa2\x82T\xd6\x9b\x85\xee|\xeb{\x1a4\x13De\xc4\x01\xe9\xd89id{\x06E\x93\xec\x0d\xf6\xac\xdd\xad\xb1TQ\xf1[\xda!\xd5TS\x84\xcdY\xffU\x8c>\xd9\x86\xc6\xaa\xbfU\xdd(\xc2\xe27p\xa5\xee\xc4\x80d\xa2G\x1b\xe3\xa6\xc2\x03\xc2\xbbP\x00\xde\xc4\xcc\xed\xe0\xc3Q:;=R\x98\x0b\x8f\xdeK\xd5\xb7P\x0f\xbe<\xce\x03\xefN\xb10\xa2\xf6\xc5\xf0\xe9\x1e\xcd\xac\x0c\xfa;\x93\x8aZ,Q\xd9\xd1\x9eO\xa7\xb0\xd7\x9b\x94\x8e\xc5\xa8Zq\xf1#\xba\xedf*\x9a_\xef\x0e\xf3\x81\xcb\x07\x0c\xe4\xd3N\xa63\x1e\xc2e\xf1\x09\x87\x03\x95M\x0c\xd0\xc2y\x88\x92\x94aK \xfcm\x0d\xf1\x0cr\x95\xe5\x17C\xd2\x8fp/\xfe\xb3\xbb\xf8\xb3\xbbx\xfb\xa7\x0c`\xa8\xd8.I\xe9\x86#\x8b\xe4\xd0\x09\xb2kr\xb1\x82o<s\xa9\x95\xe3V\xae\x87\xcf\xbcy5s\xd4\xcc\xb5\xf2\xad\xa6\xa8}\xf7\x91\xb2x\xb6\xe5\xea\x10\x00>\xe3g\xba\"\xa7\x03\x82.$\x02\xf2y\x17\x09+[G\xe8\xbb\xa8t!\x92\x0f\x06J} #\x8b\xeeN\xb9k\xfc\xf7\x99\xferp\x9d;\xe6\xd8\xaeY\xf0\xfc\xc1\xa0\xe7uC\x06\xc4\x8d\xd8\xc3uy\xcfG\x1c]Lj\x10\xaa\x11\xeeJ\x87\x11\xeb|O\xd1\xc1\xe9\x1d\xc7\x06\xb9\xd2\x07I\x97w\xae\x86\xdd#\x1c\x10\xac7\xc5\xf1\x1b\xf5\x8f\x1d\xf2\x87\x89\x7fj\x8bS,\x1f<\x07X8\xf8\xb1\xe8\xaaL\x1c\xcf\xbf\xc4\xf0^9\x8d\x0b2\xe9v\xd2\xfdx\x86\xe7\x0b\x90 ?uo\x88\xbbF,i\x1bvZ^\xfa\xc6\xaa\xc5q\x9a\x17\xdd+\xe5pq\x88W=\xce\x17T\xa8\x1f\xb9E\x8d6sV\xeb\xad\x8a\xd9v\x84g%\xe4kb.Y[\xcd\xdf\x97\xa3\x13\xd2Fj\x81\x9c\x94R\xcd\x1co\xc3D\x1ci\xe0X\xf7\x9cB\"t\x05*\x1c\xa9,2U\xc7X\x17,y\x89\xe5H\xc7o$\x02z\xbe\xe0\"\x88\xef\xc9\xd1=\x19e)@WM\x91xTn\xb3m\xd8h3o3I\xd9\xce\xf5n\xf0\xe8|\x7f\xa6/\x0a9M\\x\xc6\x9d\x18\"r5\xbb?cv\xf28\xb1\xb7\xe6\x82n\xa8\xfd\xe2\x0a\x81/q;\x18\xd5\\)A\xfe\xf8\xe9\xc2\xc2\x1bs\x84\x0dX\xaa\xbdC\x8d\xf3\x03\xbd\xf5\x84\xab'K\x1fgQ\xa1\x99\xd4y\xd6\xb8\xef\x9d\xa5\xabT\xf2\xe48N#9\xbf\xe2\x05\x0fCU\xa3\xf7|$\x0b\xe6`.@6\x01\x86\xc4\x0f\x8c1;\xce\xd1L\x9e\x7fP\x94HX\xae\xb9n\x7f\x0a\xd7\xd9\x80\x00ML(|\x94\x15\xf4\xda2;\xe1\xcb\x84\x9d\xf0\xbf\xfb\xf5\xbf\xfe\xfd\xbf\xff\xf5\xbf\xfc\xfd_\xb6\x18\x0a\xf1\xaeC\x14\x9cH\x9eU\xb3b\xef\x9a\xe2\xdf\xfb\xfe?f\x92\x80\xfc\xa6\x04?\x8fy\x18\x06\x7f\x90<\x98\xe2X\x89~\xc1r\xd07\x18\x7fE\xb0\xca&\xcf\xe0#\xe7Eh\xb0Dy\xad\x05\xa4A\x0c\xf0,\x8b\x88\x18\x9eL\x0c\xba\xe0n\xc8qn\xda\xd8Yt\x06\xf7\xca\x13\x0fB\x98M\xc8\xda\xbe\x8a\xf7n\xabN6r_\x87&;\xd3\x17&ZUE\x16\xee\xee\x1c>\xd6\xb6\xdcEY\xf5\xc8\x07d\xe5F29]J\xec\x0b:\x91\xfcb\x81\x89\xe6*%\xba\x03\xda\x14\xed\xddw'\xa1B\xca \xdeQ\x06\xc54\xaa\xd5\xa78p&\xedhv\x1a\xa3\x08HtHL\xc9\x9e\x19\xe7\xbaa\xf0;\x1e\x0b\xbd\x00t\xbf@8_X@\x13}=\xba\xbdQH\x9b\xa8\xa7%\x1e@[\xb5;(\xfd_\xac\xc3\xe2r\xb91\x95\xa2]P\xdd\xaa+\xe2R\x89i\x81\xd0QP\x82B6\xc5,\xd0\x81\x87\xca\x03\xa3\xa7_\xb1~^\xf7\x1c\xba\xe9\x9b\x99\xf0\xbb\x96Ns\xdc\x98\xf8\x01\xb9\x191\x85\xc6M\x92S\xacls\x01.\xa7\xa9\xdf\x8b\xa4\xd8yM\\\xec$\xb9\x10b\xbd\xb9\x13\xcex\xf4\x8d\x1bje\x91P\x06\xfbT\xaa,\"R\xf0\xe4jtp\x8f\x08Z\xc2\xcen\x84\xd7W4\xbaR!a\xa7|\xaa\xd8\xf2\x07\x0e\xe5?f\xb2:=\xdc`p\xb0{\xdb\xda]\x9b\xb0\xf1\x1e;`\x03\xc2^e\xb3\x15\xe4>\xe1g\xe4DPT\xc8\x1d\x06\xe8\xcf\xf8\xf9\xb6\xf8\xde\x92m\xd9\xf6\xb0=\xc0\xb6>\xa1\x8eG\xda\x09\xf4\xff\xf8\x96\x82\xa34\xbbAb\xc0\x0b\xce\xc4\xc1\xdb\xd3&\x87 e\xa73\"\xb0\xd9\x87\x0b\x88r\x14\xd5\x84\x1e\xc2\xc0\x9a=\x93\xabo\"O\xb2:[\x8aR\x04u\xc9\x0e\xa1\xab\x9bc\xd0H\xa0\x16.f\x0b\x98p~I\xb1y\x86\xe30\xc9\xac\xb9\xf5!\xb2\xacH\xd3r\x00\x97w\xc2\x0e\x8a\xc1\xdd\xdc\x16\x13\xd27\x86\xa8\x97\x05\xa7\xd1\x8f\x8c\xb9\x99\xe8#\x08\xea\xfe\x02(~\xc2D'\xf2?Z\xe1GQL4}P\x8d\xa11\x95\x98\x89\x1b\xc5J\xf7\xd8\xd2\xd8\x82H\xb8\xcb\xea\xb3\xba\xb4\xcbU+\x1c\x9c\xd0\xb5\xfe\xb8\x83\x001\xb2G%\xd3\x113|b\x13jy\x81d\xf7J\xc7\xf6?\xe1\x10\xa8|{\x16\xb8\x9d\x80\xbf\"\xca\x0e\xeb\xf8\xcf\xf8\x19\xa7?4\xae\xc7\xe8>\xb83}\xe1\xa4\x87\xcf\x10\xa1\x97\xe9\x9dy\xdf\xc1&h}\xe1\x86\x11:8\xd3\xd7\x0e\x9e\xc4o\x93\x8d\xd8HvP\x0c\xbcv\xd0\x0aM\x92\x00\x80\x1e\xa5<\x9c\xe5F\xac\x17p\xc6Ol\x8e\"\xa5\xf9\x99\xbd\xee6M\xa0(\xde\x18i\x13\xc1fj\xee\xacw\x92\xfa\xa0~\x83\x10\xf67oDa\xc4\xba\xbaV=\xb4l1a\x12\x05z(\xf0\x81W\x05m\x9e\xaf\"\x8a\x9ap\xe7\x9e\xd6\x88\xe41\x86\xaa\xbd\xbb1t\x9fyv]\x94.US\x85\x11}\x8aW\x0ac\x9c\x07\x1b\xf4\xa2wLz\xddk\xec_jK\x1c\xde1\x8d}\xc2N \xa1\xc6\x14b\x90e\xc0\x93\x9b\x0e\xb4\x9f\xcb\x9dO&\x1b-\xc7\xb0\xd1\xc1G\xf0F\x1fZ\xb1\xde\"S{\xb9\xb2Q\xde\x81\xb5\x13\xa7xI\x95t$r\x1a\x0a\xca\xc5\xa9Cr;I\xb6\x16s\x95\xa6\x19\x16\xcb\xd3t\xd15\x9eF\xe4\xd7\xec\xf2\xac\x9a\x89Y\xbf\xddz\xd9X\x0d\x14O\x8aEd\xf4\xfcn\xf43\xb0i]n\xd2ZHz\xcf\x83q@\x8fO\x8a\xc3\x1f\xa1\xa1\xb2\x13\xb6\x02\xf0\xd5S]s\xf2\xafa\xf4a@J8%\xd1\x8c\xde\xc1\x7f\x87\xfe\xadS\xe6CSp#t<\x06)\xad\x02\xc5\xd1\x8c \xf1\xe3\xfce\xc9\x85\x107\x83C\x93\xa6d\x82w;yX-\xf9 \xcf\xe3M*\xc9[kL\x1b\x17\xaa\x01\xf7\x89\\>\x06\xf3\xd78\x84R\xffC\x07\x19nES}s\x8cC\x1e7{\xfd\xebL\x05\xe4\x90<1\xf5T\xae\x0c\x92\x13\x18\x9e,\xffa\xe85\x09U;\xe3\xa5q\x93ULyH\xbb`\x18\xbfG,a\xc4\x88\xceI\x04\xd0\x11JH\x177*\x9d\x86\x9e(W\x04\xd6!\xd7\xb9\xba\x8514c\xf0zN\x92\x93\xe3\xdfS\xa9\x19W\x1c\xe8\x1e\xc77\x96\xd9\\ug\xc4\xcf\xa0\xa5c\xab%\xd5\xe0XtE\xe6\xdaV\xde.n9\x09\xdfg\x16z\xe4\xc5\x12\xef0\x91\x04\xe1\xf4>\xa17ym\x9aq\xf9:$\x0a\xa6\xaa\xf1\xd6\xab\xca\x88\x81\xc4e\x817Y\x80\x88G\x0b\x1a\xd7F\xc3\xe2\x03\x09\x10\x0d\x90\xda\x9d{\x19vS\x12B\xbcX$0D}u\x0a\xf0\xe2\xa7\xc4\xa0)\xfb\x1e1G4+\xa8\xb5\xbf\xc4\xbc7\x86\x82DG\xf3h'\x87\x00\xc9B\xa8R\xe2a\x91-\xf5\xb5E\xc0\xc1\x05\xfa\xb7o\xac~\x91\xacj-%\x0a\xd1\x1c\xd8\xc1f\xf8-\xad$\xb3QLm#\xe8Fw\xc6\xcf\x18\xd2\xc7RX\xd9\xbbC\x93f\xbePx\xdc\x05\x7f\x86)K\xd5\x93\xb1\xde\xc2\x91~\x14\x8e;\xb1k\xf0\xed\xf0'\xd9\x9a~\x9a\x19\xf9\xa1W\xbaR\xc7\x83\x0eoKs\\\xda\xd5\xd7R\x97\xd3\x04i\xc2D\xf6\x8ej\xf8\x18Y\xa1\x05\xf9\xc7\xe8\xd2oL\xd3\xc8\x0a\xf8\xa3\xf2\xb3\xe9C\xb2\xa9&\xef\x8f\xf2\x0b\xce\x04\x87\xb0\x89B\x0f\x17\xa1T\x89@7\x0b\xc5\xe4\x9c\xa2\x9e\xf3I\xff\xe8\x1ez\xc0!z{\xf0\xbe\x87\x15\xb1\x91\xe7)\xe3F\xcb\x92\x8d\x1a$\x8d\x08\xb6I\x85e\xc3\xca]qp\xe3\xc1\xe1e\x0e\xe8\x03a\xb3\x95\xd5%N\xf0\x11\x9d\x9e5\x1cjd\xb7\xfb\x91\x93~g\xc9\xfe\xb1\x85\x90H4\x16w\x84\xce0\xb5\xb5\xc5\x0e\x89Y\xcb\xe0I\xd7\x95\x11\x12\xe4\x86\xd1\xf5@Uq{\x08\xed\xd2\x8d>\xdd\xe29\x1eq\xaf>\xb2b\xca-\xad\xce\xab\xe1\xe2\xc7\xe2\xeeGwcwE\xa6\x04\x1e*#\xe1R\xf5\xd7\xaa\xf9\x84\xd1\xb2\xb3\xfek\x07\x09\xdd\x07\x09\xd3b\x1b\xdf\xa0\xce\x1aH\xf6\x1aM\x06\xd0|B\x0e2\x8e\x85\xb4\xbe\x87\x1b\xadU|\xf4AN\x0fj{j\xd6\xdc\xab\xce\xdf\x90m\xa2\x9e\xe79\xc0p\xdd\x0e*\xb6\x95\x06\xc5\x9b\x16\x13??4\xe9\xd5a\xe0\xc7\x83\xadx\x1c\xaa\x1e\x1e'\x13\xe0]\xe9o\xe0\x0a!4=\x94\xbe\x09\xf6`\xed\x8d\xb9@\x0b\xf64\xc9\xb95\xbd\xa3\xdcz\x15\x0a\xcdj\\\xbe\xc3\x9a\x83\xd6\x19\\]\xed\xdf\xe8\x9d\xc3-\xf9\xee\xba\xa4\xeaV\xe0~\xf1\x8d\xd6?\xb5\xd0\x15P\xf7p9\xd4\xfe\xb8\x83\xc7\xec\xa7\\\xc2B\xf7y\xb5\xf3\xc2\xcd\xd1j\xd3\x98\x1b\xb7q\x12\x83\xa3\xf3\x92\xf2\x88\xda'\xa3\xc1\x12\xcc\xf2[\x80\x8f\xfb<\xe7rvp\x94\x99\x1d\x07\x9bzN\x9f:\x13w\xf4m\x9d(\x03&\x8f.Q\xef\xa48\x1f>\"|u7Y\xd8\xfbX\xc5\xc7\xd9\xe1\x13\xb7\xc9\x07\x96\x96T0lTT\xea\xe0\xe2NE\xeb\x07\xbf\xc4\x14\xc2+45$V-'[5\xbd\x91\xeag\x09\x1c\x1a\x97\x88\x1f\xb9ew\xf0$\xad\xed\xe18\x1b\xf30e\xda\xf5kAG\xa2\x1d\x0f\xbf I\xfa[\x89\x83\x97\x06\x08\xc1\x91el\xa8;\x02\x8bGL\xf0i2UVa\xae\xc4hC\xc0\x99.\x8f\x82t#\xf4\xa3k\x90\x15\x0bF\xdf\xb3-\xd5\xad\xb11?\x9f\x96\xa8\xce\x0a6\xa7#\xbaE3\x03U.\x8e\xe9\xb0\xb9\x06\xef\xb0\xb1\xf6\xde\x07\xd2\xc0\x14e\x00\xbd\x809\x09\x9f1r(\xdf\xa3\xe2YqgT\x08\xbe\xfb\x0e>\x9b\x7f\xce\xbb\xadt\xd1W\xac\xab\\+7\x86L\x86\xaa\xafF\x06\xad\xf9\x1a\xcemT\xc8\x9d\xad\xefI\x1f\x15\xb58h;\xccvJ\xf2\xf6pu\\}\x83eM\xf5\xdaV\xffi`\xfa]S|p#\\\x8a\xc3\xcf\x08\x0f\xd5\x1c\xe7\xf7~0\x83d\x82e\xff#\xd0\x10D(\x14\x17/u\x8fU?b0\x10\x04\x0c_|\x86\xbf\xb9\x9e8\xb3\xa0\x1b\xfdq\x07+\x89f\xfcX\x05\x9e\x95\x1fs\xa93\x99C?V\xfd\xd5\xd9j\x14,\xda4\x9c\xcb\x93\xe0^\x84\x8c\x86;\xf0\x92D\x98)\x9d\x11\xe8\xfa%\xd9\xbf\x1bt\x1f}\x7f\xa6\xaf\xd5>HwT\xb3j\xe9U\x8f\xf4d?\xf8[1\xc2X\x83E\xae\xc4\xe74>\xd0\x13\xe9\x86\xeez\xad\x1a\x92\x063\xf6sb\xef\x1d>2m}\x1cn9\x0d\x92l\x0e\xc5\xfbI\x07k\xe0tbG\xd0\x9e\x9f!!\xdel\xd3\x91`\xb6r\xf6\xfd\xff\xf0\x8e\x93}?\x0f\xd0\xfaR\xa8OJ_\xfa:\xecE\x03\xfb\xa8\x1d\x12\xc5\x115\xc3\xb5\x0asopg\xf9G\xe2\xb6\xfa\xf6\x1c>\x90\x94\xbf\xba\xf8q'\xe9\xc7\x07\xdb~\xdcB\x96~ 2^*)\x0f\xba-s\x07\xa9\x06Sa\x1d\x99\xf4\xfdc2|\xf7\xa8z\xf1\xd5\xe2\xcc\x960\x03\xbe9K\xcb\x15\xdfW5\x02\xbf\xd5\x8dj\xbc\x12Mu\xd5\x15)\xca\x7f\x82\xd2\xb7Ga\xa2A{\xa8tX\xbf\xef\xe4\x09\xf1\xff$\xe0\x84 \xfe3~\xa2\x01G?^ \xcc\xeb\xc2\x0a\xa2\xe6\xe0\xb2G\xd1\x01\xc4\x82=\xce\xbc\xbf\x91\x04;\x1b4\xa6\xfd\xf4\xc4\x18\xf0\xe5H\x03\xe7\xada\x0f\x99\xc5L(\\|\xbd:\xc5\xa1\xe9\xd2l\xb3Q\x89X\xb1\xacg\xa4{\xacl8\xe1\x11)8s4R<\xb5\xac\xee\x8a\x12,]\x81a\x0d\xa9\xd2\xa3\x8b\xa1\xb9\xf5v\x07\xefDbV\x12E@\xb8\x8e\x83\xc4\xb2\x0c\xed\xc2\x00J\x8c4Aw\x96\x7f\xe0o\xc1\x8c\x83\xae\x04\x17\xae\x82\x07K\xda\xea\x02\xfdAKuB\x92\x8f^\xc5\xfa\xe6u\xd5H\xc4\xc9\xa4\x801!\x00c\xde\xbca\x918\xd9\xdc\xca\x97\xedb\x86\xf0B\x80F\xb1\x8e\xa6\x8eQ\xb2\x14\xe0%\xfdu\x83\xcfPt\xf0\xcb\xe0\xc9\xa6\xa1\xb6\x1eU\xcdu\xa2\xdc\xab\x114\x01\xf0\x08\xa5.\xb3\xc2\xbc=m\x93.\x10a\xe8T\x0eXt|\xab\x0b\xeb#\xdba\x1e\xf3>\x1c\xe8%(\xaa\xd6Q\xc4\xa9\xd0\x80\xebNqt\x8ae\x1c\x16\x94\x91\x19\xf0\x10\xe6\x93BV\x98\xec7it\xc6\xe4gg\x12\xc3\xf76K\xc3T5\x80\xaa\xb88\xd3\x8a\xa0`\x84\xc7\x845\x9e\xcdj\x9b{\xa5\xfcE\x0b\xdd\x03ukq\x10\x16\xe3\xae\xacj\xdc\x19.\x08\x018\xd3\x17\xefn\x0d\xf8<\xc9\xa9\xa1H\x93\x12\xd1 rv\x11\x8c\xa5\xd7\x89Y!\x87\xb70\xc2\x8d\xa6\x0c\x8d\x13\xb5\xf9I\xb9jr\x9dm\xce\x85\xf4Fm\xd5A\x8fe\x04\xd9\x95L\xf1\x90\xae\xb6#\x90U&\xa0\xf2\x06'1~\xeeaQ \xc0q>\x1f$\xebJ\x14 \x07~\xd1\xa3\xd4N(6\xcc\xf7th\x0c\xc4$\xc0\xae\xe8 \x87\xeb\xd3\x91a5\xf6\xe8\xae\xfb\x01n\xb0\x87\xf0 }FG\x08%\xc0\x0a\x10\xf3h\xe4\x14dA\xb7\xb2\xaaa\x8c\x14\x8f\x95V\xfa\xb3\xf2I\xdc\x938\xb0\x9f\xeb\x0d+\x8dL\x01\xae7Z>Do~\xda&;\x800W\xa5[\x0d\xc0\x8azO\xe8\xb9d{\x1d\xa1\x8b\xb0\x19\x18\x19\xa3g\xaf\xc1\xb9\x0d\xd7\xf96\xbf\x88\xe1$\x19\x84H\x87\x99\x82\xf0\xec\x93N\xe1s>\x97\xc94A\x0b\xcff=wp)\xac\xd3x\xb6\x88\xcfm\\_\xaba\xc0ld\x8c\x86\xe6{\xb2\xff9\x01\xecE#\xf4`\xb9\xb5\x8fo\x84L(\xae\x91|\xa54\xb6!$\xd9\x9c\xc8\xa91@E\x89\x9f\xe3\x0eZ\x84TB2\xf6\"\xaf'&\xf8\xac\x06\x1b\xee\xd98KM\x90\x81zW@*\x19y\xa9\xc2|\x0eh\xb7\x1fwP\x13|?\xc7\xf4\xaf`\x98\x89\x1b\xadUK\x0f\xee\x06\xa5\x94[A\x09w" +
+	"iq\xa9\x9c\x95\xa0\x02\xff?\x1e\xbf\x0b\xef\x96\x84\x9f\xd3\x19\xdb^\x1c\xeb\x96z(\x1a\xf0c\x06\x0b\x09\x15D%\xc9\xb4\xe34\xc4_]\xe7\x8a\x11>K\x99\xd4P]\xad\x9f\x8e\xf9A\x18\x1cC\xc1\xeeps\xe3\x1b]y4\x8a\xcd&>{\x7fE\xe9\x10\xa6C\x8d\x81k\xb5>\x08\x95\xce\xb8\x1d\x94\x00\xfc~\x92$\x93+(\xc0S\xc6\xa1\xd0\x0d\x09\xfe\x19\xcc\xfe\x11G\xea\x01\x83\xb9\xc8p\x10\xd6\x1cq\x12\xfcp\x83\x1b\xf4\\\xb9\x90e\x87\"\xc3\xcf\xce\xaa\xf88\xab\x1e\xa79\xe9\\\x8bOW\x18B\x8ffH\xedY\\(\x1e\xceh\xd5\x9c\x88\xf1\xdd\x94\xd2\"Fv\xee\x9aa\x94\xc8$\xef\x11\x9cA,}\x7f\x83\x1a\x8eq\xb8\xaa\xc0\xfe\xbe\xe2kM6\xeb\xd4\xc5\xe9\xc9\xf3%\xa2?\xac\xd5\xb7\xb0\x91\xa0\x9f\x99O\xd9\x1d\xf5\xd73x\x90\xda\x18B\xae\x1b\xa1\xf0w\xb8\x19\xd2\xbd\xa4FX\xd2W\x86.\xa1w\x036*\xd5\xab\xebk\x1c2\x88\x0dr\xa3@w\x9c)m\x80i\xfc\x9c\xef\x89\x0d\\\xac\xb6p\xc2D\x84h\x93\xcfP\xd7\xd0D{\xdb\xc2\xe4q\xc7+\x0e\x88\xf08R\xb6\x82_^\xb77e\x04l\"AI[\xb5\xfe\xc1\x88|i\xe05\xcc\xbd\xbc25\xc4g\xcf\xe1#AD\xfc\xf0\xb8\xa7N\xf7\xaf\xf9\x04\x9e\xe3\xe3\xd39\x12^n\xb2\xac`\x0fU]\xe8&\xe4cPk\xaetT|U\xb5\xf0\x08\xad\x12\x89\xfd\xe1]?<\x9e\x8a\xc3{\xa67\x99\xa4p\x18\xd6;\xf2\xde\x86\x89\x1cq`\x18\xc9gA#d\xb3[\xa6\xc3X-%\xa7\xbdw{\x8bu#=\xe5,\xe0J5-\xfd\x83/\x17%\x05\x1e\xcd+%\x08\xddS\xa2`e\x90\"?9v\x85fN%\xfd\xd7\xe8H8r*\x87\xf0\x08\xe9\xc0\xfd\xab\x02 ,\xe7p8\x05\xebT\xcd\x09\x08\x0ez+\x0fU\x92#B\xa7\xf0\x85\x05\xd0Bd\xb3\xfc\xd8\x01\x84\xc7\xb6\x88k\xd7$\x9b_=\x9d\xc20\xcd\xb40\xd6q\xc4B_\xfd\xd5\x0f\xc8L\x88\xdfJ\xb7=cR\x07(\xbfXC\xd1#\xa1A\xdbD\xd2=&\xe8\xdeQ\xa6\xb8\xb0\xaf\xc5M+!\x03,\x06\xd78\xc40]\xa1\x1b\x9d\xad!6\x92\x98sv\x1c\xba\xad\x93#C\xe7<\xfa\xda\xf7;\xca\xd5\xdegj\x0e\xd4\xf3\x0b\xc3\xa68\xf0$\x9b\xcb\xeb\xa4\xfc~4\xdbi\xf9\xe9\x11\x0a\x1d\xd9\x95eR\xd8\xab<\xc0\xcd5\xb42\xdf'\x01{\xdck>\xf2R\x0c#\x9ck\xc4zE\xcb\x87k\xda\xeb\x8a\x84\x10\xd76\x93W\xd3\xb2\x0a\xe9\x1f\x8a\xd1\xb5\xccQ\xd6;S\xfaF\x83\x98\xd7WDO\xd0\xe6J\xcd\xc5\xfb\xf3\xe2-_\xae\x8aNdY\xdaD\x94\xf6\x84s$\xbc7\xc6}\x1f4\xdf\xc1\x8a\x91.K\x0d\x86\xa3D\xf4]s\xdc)\x04\x137\x8f\xadJ\x85W\x0b\\f|{v\x90B\xba\xe4l\x93&R\x80\x94b\"K\x07kl|\xaf^\xaf6O\x8c \xb6\x97\xaa\x81\x8e\x88\x84\x0e(:q\xcc\x00\xe0\xa1H\x91\x94$g\xea\xeekW\x8b\xf5.\x09\xfd\xbb^SH\xb9\x95\xde\xe3L_\xb2A%`\xd3\xe5W\xf9z\x8f\xbc\x88\x9a\x8ec\x9clhC\x0a\xfa\xd1pa\xa9\x19\xe9;\x7f+\x1e\xdcg\xd0|\x18\xf4\x94\x87\xa0\xa3\x0fr\x10\x9b\xc0\xe4\xaa\xf1\x08>$\xa4\xe1\x17\x92\x04\x8c\xd7\xbfT}\xd0i<\xcd0\xb4E\xed\x8d\x09\xdc\xd6\xc2\xdc\x8d\xec<6\xaa\xdc/\xd8\x11\xa4\x11\xb0\xd8dj<\x1c\x1e\xa1\xee\xdcQ\x8aA\x1b\x17\xae\x16\xe66\xe8\xf8\xc7I\xa7\x84\xa9\x06$\xae%K\xbc\xc7\xd6\xd0\xb0)\x81\xe79*\x92k\xa0\xd5\x82\xcd`\x05\x87w^,r\xf9*\xc6\x93\xa8-\xe7\xc1\xc1\xfe\x02\xe3)\xd2_\xcd\xf0]\x11\xcb\x15;\xd9\x14xz\x00\xe1\x96g\xb4/-\xfc\xdc\xef\xd4g\xc1\x1d\x7f\x88\xcaC<d\xe9\x90\xf6\xb7W*\xbf~\xba\xb5\x18\xd1;\xad\xbc\xca\x9c\xfa\x09[g|nZ/.\xb15\xfciZ\xb4\xbff\x1f\xbf~\xb6kB\xd9:\x1fT\xf1\x04L\xdc\x0e~\xa9\x92\x7f\xacbe\xf3\xc1\\\xd6\x16Y\xe5:\xc1\xe7L1X\xe7\xce/P\xa1\xbc(_\x9e\xb6\x11\xd6\xc0\x88y\x0a\x16\x0f\xba}\xeaq\x83\x92\x12\xc4\xe5W\x7f\xbekYdj\x06\x13\xf9\xe0[\xc6,\xab\xdd\xa4\xd2\x05Sq\xd8\x88n\xb3\x8e\xdb\xb4\xa9}#&\xd1\x8a\xa4\xff\xeb\x99\xddu\xf7V\x07\x9d\xbf\xf6hd\x1a\xef'\xfcT\xb8a\xaczw\xa6/\xfa\x13q\xf5\x89\xa3\x8f\x7f\x8aNg\xf7Xu\x04\xfcB\x16ZRw')\xb8R5\x8d\xf0\xc2-\xb4\xbfU\x99\xe6\xf5\xcb\x0c\xc3=\xc5\x15\x94\x0eWB]\x87[\xe8G\xd0Y\x80\x98\xbcHW\x85\x92\x9d*VTaV(M3\xcb?\xdd\x00\xed\xc5\x8frU\x84\x02\xea\xb0\x82\x8e\xf9>Y\x15zL\xf4?\x16_Fw\xfc\xa4\x8a\xc0\x9c\xfa\xa4\xf8Ik\x11\x86JE\x80\xff\x91\x9d\xff\xf5\x8cc\xaf\x10~\xdb!\x9cT\xd5\x1c\x1f\xe3\xa2L\x9e\x12\x91\x8c\x92-\xb4\x8e<%/\xc2\x05\xdd\x05J\x18\x03\xcb\xcb\x81Isc^D\xb0\x0bt\x8c\xf5jH1\xe5\x06\x8dPx\xe2\x927\xc5\x8f\x87\x83\xdfJ\xef\xd1*o\xf0\xe0\xc8\xf0\x89A\x05\x17\x12\xd8\xfe\x98\x19`\xcbU\xf6\xafl\x95\xfd\xaf\xff\xdf_\xff\xb7_\xff\xeb\xaf\xff\xe5\xd7\xff\xebV\x95\xfd\xcf7\x80\xe2'\xe7\xda\xe2{\xe7\xda\xcdj\xf7\xef:\x84p\xc45C\x83\x97\xb2\x1eJ\"p\xbbb\xfc[\x92w\x81\xd2\xe5i\xde\xa8x\x1c\x13\xf1\x88\x86W\x8d\xae\xdc\x9a[\xa7q\xce\xa5]\x9d!\x09I\x90\xf2S\x09,\xbeQ\xeb\xeb\x07\xf77\xa9\xe3\x10\x8e{\xde\xde:\xe8F\x07\x03\xa0\xacd}\xc6O}B\xdf\x85\xff\x8bL,\xbb\x8a\x97-\x93\xb5eK\xce\x9e\x8a0U\xb6f\x15zojuf\x83\x1c\x13d>\xfa3\x86)U\x8a\xf3W\x99v\xd4\xeeW\\\xbf\xa9\xe66\xc3\xdd\xb02D-\x82X\x121\xf3R\x87\xba\xea\x1ea\x04\xce\x04!o\xbb\x1b\xe1L_b\x17\xe03G\xb8\xc7\xa7\xe6S}\x0e\x1fk#\xefu\x16q\x99A@\xd8\x1c)\xd15\\\xaa\x9e\x0f\xa5\xacG\xcc\xb6\xa02Vm\xb43\x18\xc0C\x9bK\x0b\x1f\xa1\xe5\x83\xce\xf4\x15\x8b\xdb\\\xfdv\xb3\x8ax\x0f\xf1\x7f\x0a\xc4g\x11\x00\x1a\x15#t\x08\xc2b\xaa\x08\xa1\xb3y/\xe8\x8c$\xc9\xbdo\x1e}\xb3\x98~\x92(\xb9}\x1e\xceG\xd8!\xd8\xd70\xc0\x17Y\xa1O\xbfZ@=3\x16\xd7 B\x1f\xab\xde\x13\x1aN@\x1f\x84G\xc3\xd5Y\xeb\xffq\xc4\xb9\xb4~H\x8b\xa1O\xdb%\xaeRp\x8bg\xff{\xd7\xff\x01|\xa82a|h\xde\x9c\xb6&p\xbb<\x95\xe1\xa8\xf8\x12\x9b\xe0^\xc4W@\xedEV\xc4RB\xdb\xf9\x17\xe0\xc8\xfb\xaaQ\x99\x15\xba#i\xb9\xf6\xeeF\xf2.KBB\xc7j\xf0 \x89HR\xf95\x91\x9da\xfe\x0d\xf7\xe4?\xb9K\x1f\xe80xJ#\xfapG\xb5\xe9{zP-\xcc\xc82e\xbcr\xc4WN_\x96\xfc\xd9\xda\xef.\x17\xd5Z@\x1a!Y5+\xdd\xe2\x90\xf4eR\xc1E\xf7\xc3\xda,|\xae\xfb\x1c\xb21\x86\xd41\xb1\x91b\x19\x8b\x83l(g\xf9\xc7\xf1\xcdLF\x97\xf1\xf5\xad\x81\xa0\xe0\x9a\x0aw8O\xea<\x19\xdft\xda\xae \xc5L&-\xbe\x0b\x80L\x1e\xd9\x0c\xebP\x9b\x8b\xa4c4z<y^v>\x89\xb2\xe9gf|i\xaba\x84u6\x9dX\x16\xdaTw\xe4\x84\x0b\x97\xf9\x16:W|7\xf4\x10@\xce\xd8\xc8G\x0d}\xd4\x11\x11\xba];)o\x93\x87\xbf\xbf\xf55t\x1a\xde\x8eDX0\x84\xee5\x80\xc5\x03\xc9\xe4\x90\xdd\xdd\xba\x13+\xe1\x89\xd8\xbb\x0bW\xe8\xa0>\xe3'7\x1a\"o\x88\x88\x97\xbb\x91ns\xa9hKA\xd5&\xda\xe3\x90\x92\xb4\xf5;j\x17\xe3\xa5ghSr\xbf\xb3\xd2\xce\x95\xd8\x0c!\xbb\xf5\x12=\x09\xd5_1\x1f\xb8\xee7\x07\xf7\xa4\xb8\xfa\x0ev\xd4\x0a\xce\xadc\x98\x7fS\x8c#>\x0dW\xa0`\xd9\x19.1\x8e\x9b\xd5\x8d\xe6\xe1&\xcfT\xdf\\\x03\xbf\xec\xa8\xd0\xfb34\x1e/\xdc0\x81r\xa4\xcec\xaa4\xdcB\xe2\xaa\"Xu\x93L\x12\x07b\"\xb5V\xfb\xc25\x98\x83\xe9\xc7\x1c\xdbT\xfbb`\xf8\x17=w\x1cH\x16*\xbc]\x02\xf7\xad/\x9d)/m\x82OpK\x9f!\xc6o\x0f\xa8\xe7\x08\x17\x86\xed\xb6>\xc1\x83\x10\xd7\xb2\x99Q[\x85:\xcf\xf3\xc9\x83\xdb\x99\xc1sc\xeehf\x92c\xdeh}\xcb\x8bej\xb8_\xe7\xd3\x92\xae\xf9#\xa7\x07\x97r\x83gK\xc7\x96\xa7\x07\x99PY\xec\x83c\xa2\xae i\xb34\xa0C\xed\x14|\x01\xcc\x80n\xb5\xcf\xcb|\xb4K;\xcd\xb8\xa5CUW\x8d\x0b\xc6\xb5i\xbf9\x88^\\\xe3\xb7Z\xf0U\xd2\x82Z\xd6\x8e\x11\xec\xb4\x1d\x95_\x9f\xfe\xb7\xab\xba\xaa>\xe3g\xd1~\x8a\x83\xeb1\xd2\x1fm\xbd\xfa\xeb\x99\xa1a\xc35\xc9\xbe\x80{\x81^\xdf\x11\x14\xc0\xa4\xda\xb57\xa6\xf4\x9caS\xdd]Of\xdb!m\x00\x8d\xd4y\x18\xc0\xb1r\xad\x14U\x8f\xd3T\xf3t8\xfd\xd3d<%:\xd9\x88\xb7\xf2\x1d\x9b[\x9bI\x93g\xdfl\xb5\x1f\xb5^r\x0fI\x05\xfcli\xd4 X\x85\xa9P\x80\x9fh!P+'\xeb\x11\xf7E(\xb6\x81\x1ev\x94{}\xb5\xd6\xca\xc6\x9b:\xe0\xcd\xf8\x09\xcc\xa6\x13\x0c\xc2\xae\x84\xd1\xdd}G\x0b.b7\xd3\x02!\xae+B\xf5\xcb\x1eM1\x8e\xb2\xa2;\xb81!\x9e?\xddh\xd4\xf7R\x92$L\x8f+\xf7\xa2<@l~^a\x97\xf7$l\xfd\xadFL\x81d\xbc\xf2E\xc6\x97\xf1Sx\xbc1\x8a|F\x1d(\xfe\x89R\xab\xdc\xb5\xc7\xc27\xd0\x9b\x87\xde\xa9eO;\x15B]\xb5\x10\x94s\xeb\x14_\xbc\xc0\x837d\x9d\xe6)@\x09\xce\x08\x80\x8dU\xb9\x98\xe2w\xa1\xe8lQhN$4w\xd4se\x9d'+\x05\xe6\xdf\x90\x18\x80\xff\x11\x89IK\xc8\x97\xd7d\xf9\xc1fv]\x02\x1b\xd4y\x00\xb7\xc6\x11Y\x95\xa0\xdf\xbb\x12\xdci\xbbh\xcb\x81\x11\x88\xbf\xfa\xc1\xf5\xb2\xcc\xf6\x8e\x81\x87\x86\x82[\x8aH\xc2\x8d\xbaG\x10\x18e\x0c\x01Z\x10\x06^\x07\xba\x07\xbfc\x17x\xfe*\x8b,Ow\xd2\x8e\x8d\x03\"\x1a>2i\x98\xad\x08\xc3\xff\xad]\xefZ\x0d\xa8+\x05&\xbd\xca\xb4O\xa1\xce\xa5*\x0f\xb1-\x8f;\xa4\xa1%\xea\x96{\xad2IYJp\xbe\x83t\xcd\xd8\x9aq_'\xa6`\xe9j}\xdcp\xe1\xb3\xb9\xbaQ\xbb\x9e0];\xa3\x03\xddB\xdd\xf8\xf6\xb4]\xadT\xce\xb1'Q\x0bR\xf0E}\x90\xaa\xa7\xc7\x9a\xc0zv\x94\x18\x0dU\xfb\xe9*\xb4\x10\x0aE\xcd_\x0e\x17\xb2m\xb5+\x85\x0bmW\xec\xe0\x0a&\x15q\xf9\xed\xa8\x13%\x81t7\xa6c\xe6]N-!7Q\xf6\xd1t1\xc2\xf5\x8c\xaa\xe5\x83\xc6r\xd7_ic\x0a\xc5\xda\x9d%'\xf1\xafp\xe2\xe4@\xa4]\xcb\x0b6\x17\xcc\x18\xbb\x15\xa7\xe9\x1e\x84\xc8\x1b\xd6\xa4z\x12\xe9_\xae\xb51U\xcb\xb8\xba\xcd\"\xc5\xfe,\xfbJ\x826\xe5E\x8b\xbc\x099b\xeb\xc6/\x92Y\x14+\x88\xe2\xce\x85a\xea\xaa\x05\xce\xfc\xf1vu\xe6\xef\x84\x13(\xdb\x94\xab\xd6\x99\x18%w\xc1i\xa6 v\xaeY\xb7\x19(_\xbc\x9c\x14\xabF\x8fc\xa4\xe8m\xdcw\xf3\x0d\x8f\xb6\xca\x8a\x88\x0e\xba2\x84\xa0\xbb\x12(a\x9c\x84^\x0cA\xaa\xdb%\xf7I\xf3'\xc0\x85\xbak\xed\x11\xc7\x9e\xcf\xa2\x810\\\x8a):\xd8\\\xf9\xf1\xb4]e\xb2\xa2\xd3\x99\xd1\xb5\x7fA\xa0\xe6\xc5\xd7y \x94N\xd6*d\x8c\xf8m\xf5\xca\x96\x01l]#3\xb1\x92\x87\xc6;J\x8d\x09D\x17=\xe5\x92\x7f[\xfc4E\x1f\xc4\x91tg\xb5T\xc3\xf3u\x85\x07\x1a\xd5\xd4\x0dr!\xc3\xe9\xc4|>\x98\xa9\x9b\x1b\x96\x0a\x08\x85\xda]`\x8ca\x99\xd6\xe7A>c\xaf\xb7\x9f\xc2@d#\xab\x950M\xed\x12f\xf5\xc1\xb1\xd8D\x09\x9d=\xb9{\x84\xc6]\xb0\xb5Z\xf4X\xb6\x04\xdc\xbe\xca\x0c\x87\xdcq\x8at:\x96\x12\xf9\xa0\xc4a\xf9\x8bD|\xa8P\xdb%c+\xfc|\xda\xae\xfc\x98\xb9d\x0b\xa3\xd4\x91P\xd6\x8a\xa2\xdd\xf1\xb2\xb1\xd9\x11\xe3\xe20#\xde\x80\xdeU\x13\xe5k[\xc5\xbd\xeaGh\xe1\x1c>d\xa0m5\xe1\xb3Ia\xf2g\xb8=\xfa\xda\x82ZkCFn\xa3aZ\x0b_\xce\xe1\x08%f\x9c1\xcd\x1dL\xf4\xe6x\xb2*\x09\x0b[\x11'N\x07?\xf8f+X\xfarJ\xe4m6\xc4+\x9b\x87Y%\xa71\xff\xc6\x0a]f\x06\x95\x15\xf5\xa7\x11\xce\xe1cGq\xc7![\x05\x8e\xb3q\x04\xc9\x0e\xdaFH\xa6\x9fF1\xeb\xea\xa3kN\xdbU\x1a\xe5B}\xb6\x10\xce\xde\xa09\xdf\"\x7fS\xe7\xda\x0b\xbd,\xb4X%\x82\xa5\xba\xdb%\x11\x93\xdb\x84\xf3\xe6\x9f\xdc\xe66\x11){\x85s\xf8\xd8\xea\xbb\xd7\xc9\xbe\xf2\x81\x12\xdcB\xa1\x94\x86\xf2}\xdb\x89\xf8\xf9\x08!;\x86\x9f\xf8#iU\xba\xfe\xb4]\x84PJm\xd81\xa30\xa6\xbbT\xc8\x0c /\xd4\xfa\xc6\xad4T4\x99\x0d5\xca-RP\xe1\xeb@\xe7\xaf\x15a~t\x8d+\x0e\x1cY\xc0\xe9e\xc6\xc5\x0e\xcc=6\x94m\x99\x0b4P\xc4A\x1ck\x8d\x1fq\xf6\x18\xbeM\xf2\x12\x14\xfd=V\xfd\xc5\x8d\xbb\x10\xe9\x98l\xe7\xa8|Uz\x88\x1d\x8fX\x19\xd6)%w\xf9$\xb3!\x1c}g\xd3\xfe,\xffH\x16\x93\x9fV\x07\x1736\xf6\xb8\x0al\xc1y\x9f\xad\xc6e\x7f\x9a\xa0\x12\x92\x8b\xbf\x99\xc9\x09cN\x0fc\xe4\xb5?q\xea\xdf\xa8ef\xf4=b\x0bo=\xe6\xf3\xc4\"M\xd7\xde\xb9\xd5\xd6\xd7\xe4^5\xe0\x85\x89I\xc0\x86d\xfc\xa9+Y\x88e\x86\\d\xf99\xd0\x99\xfaB<\xc2\x0a\xbeR\xa9\xf7\xd5w\xbe\xbb\xee)\xdfK\x11\xe6L\xd9\xabuS\xea!\xa4\xab\x03\xb2\x1a\xee\x03\x90\x7f \xac\x1bb\xbbjk\xa3\xcd\x84\xaf\x06\xf1\xd0dr\xed\x80|\xeb=\x12\xb6\x93\xe9\xe3\xae\xda\xc4\x11\xd4\xfd\xa5x\x87\xb6\xea\xfd\x9dKR\"\xe0!\xb6{\xcc\xb12\xcd&a\xb9\"\xda\xfd7a R\xe4\xf6\xbf\x0d\x08\xe2\xd57\xa9:\xb6\xaa\x0e\xa4\xc0\x99\xda\x0dp+\xbe\xbb\xf4p[\x91\x1f\x8fx\xe5\x0fL\x19\x07y\xb4\x0d)[52D[Ff\x9a\xc7P\x151Wlx\xd9\xaf\x9ff\xf6f\xbe\xb4|P:\xf0p\xb5\xf0@7h\xe0\xcc\xdf\x91;\xc7\x80\xad\xc6\xaa\xf6]<g\xdb\xe8}=\x01Zp[e\xef\x96\xdf<e\x808\x94\x9f\xe0\x88\xefmr\xc0\x88[PV\xe1`\x04\xbd=m\xe3u\x89\xbf\x93\x09\xe8oGk\x7fL\x8bA[\xaf\xe4\x13I\xed\xe7V\xcb\xbf\xd8h\xf9\xc3\xb7\xb4lj4\xf2(\xa8k5\xc2%\x06\xe3\x8a<m\xe4b\xd5(O\xd3\xad>\xd0\x15\x8a\xdb~\"\x08\x91\x93~\xd8$\xa6 \xb34\x9e\xc1\xb6\x92\x19\x88;\x80\xab\x9a\x10[M\x82\xadt\xe0\xebx\xa5\xa9\x09\x9e2\x02G*ae\xcf\x09\xacw\xb4\x19R\xb9\x00\xb92?\xb1\xf7\xfdn\xcd\xfb~\x9dy\xdft\xae\x14xN\xd4\x7fp\xcfE\xbf5cs\xc0\xdd\xd6\xe1\xef\x8b\xb7J\"\x99\x0aA\xfa+\x975\x9aA*\xca5\xc4\x8e [\xf8\xb2J\xc6W\xf6\xc2*\xd6Z{\xd1\xc1\xb4K&\xcfn\xaelZ\xbb\xeaS{\xd5\xbf\x9a\x18u+c\xa6\xb6y}u\x1a\x95\x1b\x02\xb9&\xd9y]\x0e\xaf|\xfdlz\x9f\x16\xc6\xa2\xf6\xea\xa0.\xfa\xa3\xb5\x9f\x94\xa3l\xcc\x96\xafu\xa98\x98\xe9\x12\x16\xa09x\x18\x03\x14\x12\x06\x9e\x9b\xab[.\x11\xc2\xd4\x15\xff\x1e~\xaa+N\xf8,\xc3?\xa8\x8ac\xf1\xe9^\xd8\xb60\x88\xf9pu\xe4\xaf\x1a\xfd\xe8\xfb\x88y\x9c&\x88\x98q\x1b\x03\xc6G\xed\x9d\xe5;\xbe\\\xb9\xe3rv\x0e5\xb6\x06\xa8\xc1Y\x9a\x7f\xe1c\x15F\xf2\xe5\xbb&\xae\x1c\xf5j#b\xaa\xb5\x9f\xf7\xc4\xa3\x8c\xce\x0e\x98\x0d2\xff,\xdf\xffu\xfa\xd6B@\xc1\xfc\xeb7'\x99g;\xbe\xd4_\x89\x02R\xcb7\x98\x04\xf5$\xe7\x81\xc5s8zTM9*\xadF\x8b\xe9\xeaW\x0a\xa4\xbe\xfef\xb3@JKK\xd2J\xa0\xb0\xdck\xa2o\x8d\xf8\xe5" +
+	"\x9b\xafR\xc7\xd4)\x81\xef\xd1\xc4\xda\x89r\xb3\xbf\xc2\xa6\x8a\xc3\xf2\x8d\x9e\xa6\xf9\x9d@\xfd\xe3nxue\xef\xa8\x1a\x18\x0c\xef\xa4k\x17o\x87\xdd\xce\xc5\xd0\x8bw|\xb6\x9aQJ\x83\xfe\xef]\x1b\xbah\xc6\x861\x1b\x9blL\x8c\xa0f\xf3\x09\x8b\xef\x16\x1f\xe2y\x8c\xd0EYq\xec\xab\xb7\xc5{\xb6_L\x11.\x0e\xf4\xa266\x0b\xff\xc9\xf2\x89svt\xc3~\xf9\xe6E\xe2?K\x14hJ\x9c\xc6\x9d\xfd\x10\"\xeaN$Th\x81\xe3\xceH\xc8\x8a\xbfp\x0e~\x93\xac<6XP\xb8K\xe3\x86m\xbf\xe9\x9bW\xf3!\xd7L\x94#\x0cA\xd4`\xe8\xa3\xb6\x07=\xff?-^Y\xd7\x07j\xca`\x8aQ\xe7\xfc\x93\x96\xa10\xe5\x1c\xf6\x7f\x98\x1f\xa5\xefP?\x92K\x1d\xf1\xe5\xa13h\x82\xe5\x96\xf8:\xb3~8\xf9\xd8\xde\xa9y\x15r\xc2\xf3a\x9a\\\xd8\x12\x86\xfa&%\x13\xe1et\xa2\xc3\xc1u\x1c\xcb5\x97H \xbc\x0c,OcA\xb5\xd56\x10IF\xe1\x1e\x19\x1e\xe1\x17\xf8\x03\x8c\x02\x1c\x08\xaa\x83\xcb\x17N\xd6\x08\xba$j\x06\xc6\xeb\x12_5g\xd2{\xb8\xac\\\xecY\xa6Z!\xd4\xd8\xdc\xa1VL\x86I\x87\xd1\xb3<\xd4\x95\xf4\xb1\xa4\xda\xc5\x94\x8f\xc9\xc8\x19\x05\xdf-H\xedW\xcf\xff\xe1\xf2\x04\xf4\xd3\xcf\xf8Y\x1cb\xee\x8f\xf3Z\xe2\xa6\xffV\xff\xfc\xe9W/\xfe\xf7\xa8R\x88yF\xe5\x8b\x9dT\xc2\xdc\x15\x00\xbar\xa1W\x99w.\"\xe6\xb2\xf0\xfd\xe0o\xc7\xdc_O7\x01\xa9E0\xc0B\xadDX\xbe\xef|\x18\x06SLe\x82\x1d\xc3\x17 M\xe60\xacT\xcf\x86\xa0}\xc7\x08\xa2]\xbe\xd7\xd7\xc9\x98\x96\x87\x9b0\xd9\xe0\xf4\x01\x18\xe3\x0fk\xa5\x0c_}\x93\x17\x13L\x8b\xa8\x0c\xf1\x8fu\xaa\x87\x02\xb0\x84\xf7L_\xc4y\x1a\x8e\xaa\xfd\xac\x02\x9e)\xb0\xb2\xeb\xda\x8a@Z\xba\xd6|\xd1\x1bo\xcd\xc8\xa7O\xd7Sy\x13\x02\x974\x8f\x1a\xd0\xceR\xb8\xa8\xb6\x18\xc1Hp\x00-h\xa3\xdf\\\xb7\xadX\x15+[~\xf0\xb7)\xf8^\x8b*EY\x80\xf3\xa6\xeb\xc2\xc4\xcbc\xf8\xe9s+)\xe8F\xcf\x94\x06-41\x07{L\x97\x93\\\xfa4\xe6,k/\xb0\x0fE`B\xe8\xf8\x95\xd7}\xb1=\x02#pjp\xfd\xe2*\xbcr\x8fX\xc3\x12\xfcU\xd7\xb9\xa3}\x93X\xdc7#o\xac\xec2,\xbb\xbf\xd9}\xaf\xd6G\xd6\x14\xa6\xca4\x1a-x\\\xa3nU7\xba\xe2\x06.,\x88\x91\x05\xc2.\xbf\x11/\xba\xec\xbe\x95\xae\x87\x87M\xb3\xe1\xe9\xd3\xd7v\xb4-T\x90/i$LWk\xa8\xfdr\xc9\xd6\xd3t\x09\xe3\xf0\x8e\xfa\x18\xacZ\x1f\x16De\xc4\xe3\x1a\xaa\x19A\xfa\x18\xa2\x17Wv6H\xdf\xfa\xba\x8d\xdc\x1f;\x16\x86o\xa6Y\xc1x+\xdc\xa3\xb6\x92\x01j\x81\xd6p\x87\xc6\xba\xcd\xad_%\xcfy\xfa\xec\xabI:94{\xc9\xb1\xb0i\xda`\xa6\xf4\x04\xab5Vn\xf1\xf4\xf71E\xfe5\x8c\x90g\xcf6\x8d\x90\xdf\xc7\xf0x\xf6<1<\x90\xa8\xfd\xb7Y\x1e\xcf^\xac[\x1e\x7f\xf4\xe5\xbf\x82\xdd\xf1\xec\xe5l'\xfe\xab\xd8\x1d\xcf^%\x93\xf6w\xb2;R]\x1e\xbb\xe2C\xed\xf7\xd9\x1d\xbf\xbf\xc1\x11\xcb\"\xde\xa5T\xd7\xa4L\xd8\xe5\\\xa9#tp\x13\x9e\x09\"\x0b\xb6\xbc\x90-\xb8\xee\x1c>V\x1awZ\xa2&8\x8aK\xd5\x85\xa9~\xe6\xef\xc8qQ\x0f\xbe\x14\x12Z\xae\xc8REJ\xf4]\x96\xc7\xcd\xf3Y\xf6<\xdd\xa9]=H\xd1h\xf2\xb7Y\xda\x92\xbaz`\x96\xb5K\xd5]\xdd\xb0R.\xfb\xf4\xb7\xdbq+W}6\xe1v\x9c\xbbhb\x12uW\x8b\x88\x88\x83*\x95#$\x83j\xe5\xce\xb12\xc0\xc1\xd6\xb5\x97h\x8cOi|py\x88<O\xe2\xc5\xa8h}\xc6\xcf}\xe8\x93\xa7\x11~\xbfP\x08\x1d\x8d\x89\xd2\x8d\xae\xbd\xb3\xceaDoc^xk\xdf\xdc\x04\xd53\xbb*\x86\x91\x06x\x80(\xa7y\xca\x98\xe5\xc9\x04\xeb`\xa8n\"\xd7\x97\xf2\xbd\xf2O+\xef\xac\xab\xcb\x01\xaeRBz\\\xd4\xeegR\x92\x8b\xa3\xed\xccj\xda\xaf\xbc\xf0\xd7\xd9\xd4uK\x86k\xe9\xb8\x09B\xbbn\xb6\xe37s\x91;\xbb-\xfd\xd1\x97\\\xea\x92\xa9n\x08\x8f\xed\xff\xf16\xf6\x15u\x0fe\xd1\xff}v\xf6\x17O\x93\x9d\x9d\x95W\xa6{\xfb\x9e\x8a\xd2\xa7/\x9e\xadn\xee\xa7\xe2\xa7\x1b\x11v\xfd\xfe;\xfc\x8b\xe7\xffv;|\x84\xaa\xff\x9e;\xfc\x8b\x97\xffpd\x81\x0a\xca~\xcf\x1d>b\xc9\xdf\x95q\xc9\xe1Y*\xdc\xdfR\x7f^\xba\xc1u\x0e7V\xae\xe8\xc1\xa3\xd1{\xe4\xdd\x18\xda\x95\x04\xd1\xd3\x88*\x8f.\x81Y\xbf\xa9\x08z{\x05\x7f\x91\xac4#t\xd0\x9e\xf1\x93S\xc8\xf7\xa0%}\xc6\xcf\xa2\xfe\xd4\xb7 E7\x8f\xac\xd1\xd2\x04\x93bE\xe9\xfd\xc57\x99 \xa7/\xeep\x831\xb2\xf4cC\x13F\xebL_l\xe6\xa8\x10~\xd1\xfb\x16ng\xfc\x94\xd6\xe9\xe1\xc1u\xcb\xee\xe0\xcb\xaf~\xfb\xb8[\xb9\xea\xd3\xf9\xbc\x9fX4\xe1JN)wg\x0b\xef\xaa\xcf\xe1\x10\xa4]_\xee\x95\x88dV\xeex\x8f\xdbH\xef\x85{\xa9@y4\xad\xbe\x8etZ\\\xf5\x10\xc2+\xe0v\xc4\x14^>\xcf6\xd7\xb5\xd2a[{\x99\x15\xd4\xcf\x8a\x06\xd6\x98\x85u7\xd7\xaf4jts\xa0M\xe6b\x8c\x9d\xc8]T'\x81\xa1}ak\xe4\xe2\xbdr\xae\x9aT\xf0f\xa7\xb4:\x98M\xa5\x7f\xa0t\xf4i\x04-\xd3B=\xb1I\xbe\xeb\xc2\xa6]\xd5\x9fP\x1b$4\xeb\x88D\x9d\xce\xa6\x1dP\x13B\xedzA\xf5\xd4$fq*\xac\xe1\x9e\x9d\x81\xf9\x8b\xd9\xd3\xd2\xf2\xb8\x11\xb3W+p\xbf\xa7)U|6\xa8\x87p\xee\xcd\xbe\x81\xc5jJ3+-\xe6\xc9$\x85\x88\xf8`\xc6\x04D\x92\xb1\xdfb\x07\xbe|=\x09aX\x8081\xa8\xb2\xfdb\xe9\xb5K'i\xaafe\xce\xa5\x01$\xcd\xd6G\xb3\xf5\x1f\xb0~.\xbe\x1e\x9d\xc9\xe5%\xc4\xa0\xbf\xd9\xf4y\xf9\xbf\x07\xfd\xd3\xab\xaf\x12+\xe8\xcf~\xbc\xad\xdb@+\xec7O#\x0ea\xd6\x06\x0a\x17_\xb4\x80\xda\x15\xdbg\x83\xdf\xe9\xd5\xb3\x7f;\x0b\xe8\xd5\xf3d\\\xfdN\x16\xd0\xab\x17\xbf\x8b\x05\xf4%1\x8eM\x03\xe8\x95a\xd54]\xa3E\xc4\xaex\xa8:7\x14M\x85X\x95H\xea\xc0\xab\xfaJ\x1b\xbe\x9a\xb2\xedD\xea\x98\x94b\x94\xbbGL\x07\\ld6\xb0\x9e\x93\x8a}\xd9\xedln\xb3\xb6\x02\xea[\x8b\xd3\xab\xd7\xd9>\x9ao\x08\x7f\x04\xc7\x03:\x99\xa9y\xac^\xa2\xf4\xa7iT~- \x1f\xb2\x17[\xa3>\x16_\x87I\xbb\xb6\xcf\xe3\x80\x82\xeeJ\xb1\xa2\x8f.V\xfdk\xe1\xdcf{Dvh\xb9\xdd<Ln&\xda\xae\x94R\xcc\xf1$\xba\xcf\\\xbfw\xccy\x95\xb2\x84\xd4\xd6\xa3\xbd\xfejZ\xad\x10\x1f\x82\xe0\xd7\xe9\x03(\x0e\x7f\x85/\xebi\x0e\xaa\x925\xe4^\xf5a\xfb\x86\xae\x18=\x14\x07\x1a\xa7\xc4\xe7\xf0\x98X\"\x19\xf8o_\xd0\xfd\xf5\xb3\x05\xf5W\xf5\xdd\xb7\xab\x14\x9e\xa6\x8c\xc5x>\x8bY\xa6\xc2H\xf8\xc7Pt\x88\x9a\x10P\x03a-Q\xd7\x12\x8b\x84\xa2\xe6%\x13\xa6N\xc7*\xebsO\xc8\x8f\xd7j\x12\x9eF\xd04\x8d\xd3\xdf\xb8\x05\x9f\xffu6\xe2\xd7/\xb7c\x10\xbf\xffN\x1c\xf1\xd3?\x8a\xf2\xeb;\x14\xd0\\\xdd\x8e\x97\xd7\x87\xd7\xaf\xb3\xed\xf8\xa7G\xff\x07p\x97\xdf\x1e\x82X\x99-_\xff\xde;\xf0J;}\xf3\xaf\xe1\x0bf\xa8\xe9\x7f\x8b\x1dx;\x06\xf1\xb5\xc9\xc0\xb5w\xdfw\x92\x01\x1d\xa1\x86\x9e}\xfa\xcc\xc4_C\xe9\xf6\xfe\xeajX\xf1\xb3S\xf4\xf5\xe8\xda\x0b3\x18J5\x17\xfdEW\xa1@z\xb2\xf2\xf8\xcfW\\\x11}\x1a\xf5\x02\x19[[\x9fo\xb18fz\x12<@\xb082\x9f9\xd6\xf5&\x9e\xf5\xca\x9b&\xb1\xf2\x9d9\x01\x05\xfek\x85\x83oa<\x87\x8f/\xa0\xf7z\xfa\xf5\xcb\x7f\x13\x1f}y\xba~=g\x80}\xa1\x9f.\xa9\xf5\xbc\xa0\xfc\x11j'E\xe4\\\xba\x93,\xdf\x8b^z\x8f\xcb\xdbJ\xab\xbd\xcet\x02\x89^\"\xb4\x08NB\xbc{(\x98&\xf9\x1bK#m\x8c\xb2\xf50\xc1\xca\xdd\xe3\x0a\x97.\xa39\x13\xfaD\xb6*O\x19\xed3j\xbe\xfefb\x07\xcc\x04\xf8\xf9YR'9\xc9\xcd\xcc\x00\xd6;\xf0\xdd\xd5\x15\xf0\x19\xba\xcc\x07\x16\x89\x0e\x19os^\xfd\x0a\x87\xe6W\xff\xc7\xc5\x0b|\xf3\xf4\x7f\x07\xdf\xfa\x9be\x90\x82\xb2\xba\xe7\xccY\xa5S\x99\xd6\xe8\xeb\xec\xe4x\x8d(\xf2\xd2Qe\xc2g \xd5W\xba\xd6\xbb\xfe\xfc\x17\x1f\x94K\xce\xefZ\xd7)\x97\xf0\xca\x05_D\x13\x82\xcd\xd9\x1b\x8c.\xa3\x92\xbf\x8aC#\xd1\x99%n\xce\xb9\xba\x91\xccl\\y\x96\x08\x10g\xb6\x0e\xb8\x85\x1ey\xd7\x8b\x0c?\xc2\xaeVz\xe3\xd5\x8e\xde\xc8\x06\xe6A\xe4\xf3\x05\xc9\xfd\xc1\x8dp9\xd7\xe13\xe1\x1b\xda\xc8\x00~3\xc7\x8cNC\x17%\x00\xb5\xe9\xc8\xa0%\xe1}\x8e\x9a\xd0R\x8a\x08\x13\xdf@\x9b\x14\xe2\x9bu&\xc8s/\xdf\xff\xeb4l\xdf\x80O_:\x15\x08\xe6-pf\xbbQo\xe6\x82E\x8d\xefV\xb1\xd5\x11h\x8e\xcb\x13\x17\xb5Qu\xad!\xab\x12\xe4\xedDM\xf3\xf8\xa6\xc8h\xccL\xaa4EY\xae\x15\x06<\x8bH\xf4\xf7\x86\x01\x01_Yj\xb6bk$#}\xc65'\xf1\xce\x95\x9b\xe5p\xd1d\x85\x8a\x8f{*n\xe8\x0b\xce)\xc6\x0b\x10\xac\\)\xc9y\x96!\xd7#\xdb4^!m\xdb(~\x83l\xe3\xa7\xac&\"\xcaa!\x02sM\x9e\xe4\xd9W\xcf\x93Nm71\xb1\xb4\xb2\xb3\x83\x18\x9e\xf1\xea\xb7\xaa\x99\x9f}\x95\x16\xa5\xa4R\x02\xa2/\xca\xc0\x96\xd2\x0d\xd5\xb5\xea\xa36U\xe8\xd8\xb7\xcb\x97~\x99\\:*\x8f\xdc9\xee\xdez\xb2\x17\x94\xc8i*V\x11k\xdaX\x08\x14\xed\xc7\x9b\xef\xce\xf8\xb9\xd2x\xaf&\xbb\xfa\xacDC\x1c\x04\xe2^C\xf7\x91p|\xc9\x81+w\x9a0\x01&\xc5dv\x89)r\xa2\xf3X\xba\x84o\xe9\xd6'\xd7\xd7S\xba\x02\x85`h\xd0BR\x0b\x94\x1d\x0d\xe5\x1d\x89\x99I\xc0E*\x8d\xbf\x86\xfb\xed\xd9+V\x86\x8f.<\x9aR!BM=\xf7I\xa1O;\xf5\xf3\x82x\x1d\xd4\x9ed\x0bVx\xb0\x13\xa8B\xba\x87x\xb6L\xa7\x9b0k\x02\x9f\xf9{\xe5\xf2Og5\x7f\xef0F~~!2\xa2\xeb\xdbG8\x19\x9d\xa2\xbb\xef\xec\xcaY\xaf\x8a,?{:\x1fM\xd7\x89nm\x15\xd1D\x14E\x13S\xc6o\x1ff\xe5f\xcf7\xc4\xbfQt\xe2\xa0Ut\xc7\x89T\x1f\xda\x1b(\xb3M\xc6\xe2R\xcej-\xda\x8a\xe9\xa0\xe5g|\x91\xc1\xc7\xb085\x8e%\x99\x92u\xd5\xc3\x05\xc63\x7fK\xb5{0n\xabz\x05\x83\xf4\xeciR\xfbF\"\x9d:~\x90\xe8T\xe5\x1af+;\xb1\x9a \x04\xa5\xa8f\xfd\x8bA\xef\xcf\x9e\xbeJ\x04|\xac\xa3\x81\xbb\x9d\x8b\xf4l\xb9\xec\xf3$\xdecbF\xe1\xef\xc1.\x0ba\xf3\xf8\x0ak\xfe\xd0\xf2\x94~\xfaz[\x9f\xf9@\xde\xc2>\xb3}\xa5;\xbe\xceT\x94ic\xb6\x94\xf6\xcaI\xe1B\x18\x9b\x16\x19*\x94\x0c\x97>\xae\\;Aq\x1e\xe4\xda\xa4\x8by\xafzO\xb5\x96\xb8\x9f\x15\x17(\x89\xa9n\xd9\x82F\xea\xc2\x15\xa2\xfby\x97\xec},\x9e\x8em\xf5\xbdN\xdd\xd8ZQ\x8dz\xe5\x1eO3(,.2\xefHl\xc4\xd9bZ\"\xfe'\xd3\xd2\xc1\x1d\xf2\xb5\x10\x97N\\\xca\x96\xb7\xefg\xcfr\xf3*\x1c/\x97\x8d\x80\xdb;{\xc9\xf42\x8f\x91\x16\x16\x950\x97;(\xe2\xbd\xe3,\xa0\xd2i\x82ap\x1bEbQy\x89c\\u\xa7^\xeaLRJ\x1ep\xa5a\x93u\xe7\x1e\x06\xae\xeb\xcf\xfcMy{\x87S\x9f6\x96\xf9\x82\xdb\x95\xcb\xeb\xaa\x83d\x84\x06UIc\x12\xfd\x8e\x01\x12\xde\xb6\xee\x1a\x06y\x0fE\xe9L[7\xeb\xc4 \xcf\x9e\xbdJk{\xf3\xeb\x0e\x9e/N\xab\xcb\x8c=B4\xcf\x11\x15\xc3\x8b\x06\xd6T\xaf\x8c\x95\xd7k\x85\xf1\x87\xacE\x8f\xc9b)d\xaaQl\x93\xfeBc\x88v\x17?\x9aP\xb3\xf2\xe8\xd6\x151\xa7\x1a\xc3&\xfc\x86s\xa1\xa8+4*\xc4\x00\x99\xf4\x99*%Y\x15/\x13L\xaa+\x95\xf9\x83.ew\xe6*\xd5\x1dJv\xaf\xad\x92\xdd\xdf\xff\xe5\xef\xff\xe9\xef\xff\xc3^\x15\xbb\xae8\xfc\xe4{\x18\x09!\xcb\xc3z*V\xc7\xd2\xe3\xfa\xc3\x91\x1c\xe7I\x85\xd4iS\x0f/7\xe0\x98\xc8\x8c{\xc7\x8dP\x84!\xe9zA\xcf,m~\xa7M\xb5<\xa1f\x98\xdbb\x05\xd5\x1f\xd7x\xe9s\xdf\x01\xfe\xf27\xa8U\xaa\x15\xfb\x84\xe2\xa04\x9e\xd1\xe1\x13d \xd47\xd7\xafL\xcc\x17\xb3K\xf6\x82rL\xeaVf\xb78\x91\x81\xabb\xe7j3B\x87\xde\x1fJ5\xce\xca\xaa&\xaaP\xd1\xc5\xbbV8v\xaf\xc5!\xabk?n\xab\xee\xcdHs\xe6\x8dL\xaa\xc5\xb1x>zA$m\x9d(o\xcaK.S\x8e\x0a\xdd_\xc83l\xeb\xec\xbd\x9f\x89f\xfb\xcc\xdc\x0d\xad\x1d\x0d[\x0c\x88\x0cnp3\x86\xc7\xb7\xee\x01\xea\x01:\xf8\xb2\xcd4\x82\xd1\xbf\xcb\x85\xd8\x12\x19\x12\xb585\x9f\xbdh\x8fi\\\xe1\xee\x9e\xe0\xae\x9c,,\xe4>h6\xab\xbe\xc1\x05z5\xe71L\x01#x\xe5\xffM\x8e\x1e|\xe3o\xe1\xc2I\x8d\x09\xe7g\x83q\xb4\xcd\x1f\xfd\xf5,\xe1y\xe9\x88%:t\xf3\x81\xd6\xbb#\xde\x87\xb6X7T\xbdg\xb78\x16\xbb\x86(\x99IF\xd7\xb3\xe1\x1b\x164(\x99\xfe\xcf\\\x14\xba\xb5\xa3\x87\xaav#)$\xe3\xc2\x8d\x1d\xfa\x93\xff\xe8\xc4\xe9\xf8r\x16\x06\xed\xeaL,\xedh\x11&\xa1\xd7S\x84\xc9)t\xa2\x0aP\x9fV\x8d]\x1d\x85;\xe4\xffB\x034\x96\xd3|\x81\xb9\x91\xbb\xe8`%3dyF\xc1\x8c2\xd8B\"\xbe\xc1\xc2\x14\xb4x\xf4P\x9f\xf1S\x13I\xa5\x03EU\xaa\xd8\xc6\xb5\x1a\xdcxBQ\xa9\xf0\x1cD\x9f\x17\xb6\xb6\xba\xa8+d\x85\xac\x81\x88\x9c\xefL\x86R\xdd\x9eL\xda,O\x8bB\xed\xc7,\xdd\x91\xf8\x80\x0aNF\x90\x1b/\xafx\x16!0\xc3\xb0\xee\xce\x1fV\x82\xbc\xbaYr\xb2\"\x1dH1\xa2`X\xb2J7\xfa\x9a\x89\xe0\xc42\x08\xffc\x94\xc7\x1dk|6p\x8b\xd6\x00q$\x05\xeb\xe7\x8dh\xa4\x82\x09g\xd7\x9eG\x04\xfc\x16a\xf2\xa7I\xda\xc2\xb2Z)wv]\x15Mu\xabz\xd42i\x8d\x00}\x14~/\xc2\xd24#ab\xc9\xf0\xd0\xf2\xaa\xdd\xf8\xa48|\xa8JoT`\xa9\xeeZ\\\xf6\xb0\xed:d\x89\xf7p\x8b\x07\x1d\xa2TF\xe9B\x9b\xd6`\xf9\xa0gxy\xb3\x01\x90Z\xe7Q\xaf\xf2\x17\x18\\\xbdY~3\xbbtMd\x07i\x0f\x8e\x09\x0a\xa6\xa2m\xef*\xb3\x11#\xfd\x91\x906\x05e|\x17m-\xa3\xd5\x81\x88\x97\xad\xa7L|\x08c\xc7\xbb0{1\xd4\x1b\x85\xaey\x00\xb2\x0f3|j/\x14\xebg\xa5\xc6\xa3\xf2\x18`h\\/\x86\x96\x0e2\xa2\xe0>\x09\xdd\x11[\xfa{VndNAZ\xe3F\xb8\x87;\xa1Y\xbc\xb0\xe2r\x06\x9fb\x87\xabx\xd9\xc5\xad\xfb\x83U\x1bd\xd2\xfa\x06\x87-\xb6\xf7{\xf0%\xbe\xe4O\xbe\xc1\xe0\xef\x1beUf\xb7\xa4\xbb\x06\xe5\xee:*W\xfeT]\xa1\x81\xe2\xee\x1f8;c\xe0\x01m\x859_\xa5\xccA\xbb\xc0\xf8\x80\x86e<\\\xfdK\xd8`\x12\xebD\x9eV\xdf\xec3\xf4np\xf8:\xb4\x81\x95n\x12\x89\xc7\x05\x91\x01\x99V\x85Q\x01[\xc1\x99\x1a\x1d^\xb5\x1b)\xa4\x00=\xa0\xc5\xc5V.\xbd\x14\x1d?P;p87\x9bN\x07\xc4\xd4\x1d\xa7j@\xe1\xd8\xa2\xf6}\xe7\xe0\x0b\x86\xee\xeb\x94\xbd\xf7\xe6\xc2JV+\xbc\xde4\x87!\x95\xee]\xf1\xe0Bt\x99\xde\x99E\xed\xf0]l\xbe\x82\xbcg\x18}\xaf\xd0\x96\xd0\x0c\x17\xb1l/\xa1i\xd7\xec\xda\x18$\xfa#\xa5q\xa4DV|V\x9a\xe1\xa5c\xef\x18\xf1\x86\xa3\x9d\xe2\xbc\xef\xe1\xdf\xff\xa9\x08\xfd\x84\xff<\xe3\xe7\x89\xd6]V\x0b\xa2g\x1a\x00g\x0b}\x85E7f^Y\xc2\xda\xb9\xee\x01j\x0a\x07h\xf3\x94I\x13I\xeag\x92B\xd7\xec\xcfF$\xeei\x0a\xbfBS-$I\xbbG\xdf\xda\xb6" +
+	"\x84\xcf\xba\xb2\x1c4*\x8c1\xae\x9a\xb4\xa7\xa9ABt\xb8x\x1f\xc7n\xf9i\x86RB\x83\xae\xa4\x0ay\x83\x96\xc7\x00\xee\xb4\xe1\xd1\x87G_\xfb\xde\x15\x94\xb1(\xbe\xf76{\xc2<~\xd3\xa2[f\x82\xa0\xff)J/&\x81\xbbx!\x1f\xc4\x03\xd4\x09\x95\xc0Ca\xc2\x02x#\xbeE\xef\xca\x1b\xb87\xb3t\x90Z\xe9t\x0b\x98\xa1\xef\xe7\xf8!\xad\x1e\x81\xbc\x02\xdedK\xf0\xee\xabt\xaa\xa0\x93W\xf5X\x90M\x83\xffl\xe7@\xce\x08\xcb\x90?SN\xcf>\xc3\xcd\x97\xe7[X\\8\x01\xc7\x9d\x8dof\xbd\xd0\x1b\x94\xae\xdf!\x18*\x84&\x04\x0a\x9e\x11\xf6\x08\x1e\x9f\xb1\x7f\xa2\x03XW=\x0c\xe4\x8a\xd8\x139/\x10\x1e\x97l(\x0a\xf3`\xfe.u\xdd M\xb4\xc5\xfe\x80\x06\x86(\xe8\xdao\xbb,\xcfR\x999\x01N\xb5\x10\xcd\xbaCL\xc6r\x13\x1e\x19\xa23]\xa0\xadn\xab\x86y$]\x16\xe3\xe4$\xc3\x92\xa4\xfby\xd7\xf2\xa9Sf\x14\xd2\x8a\x9f\xe0\"\x1a\xf6\x1c,\xb9W\xdd\xe8\xa2\xd1K[\xf7\x0e\x8d\xd2\x19\xb7\xb2{\xf0\xb8\xc4\x09>\xe03t*\x1fO\xc3\x0f\xab\xe6,\x11\x0bv\x83\x93y\x98\xef&R\xe5\xa8\xc5Q\x03\xae\xac\xe8E\xef\xc0\x1f\xc5p\xe9\xbb\xda\x1bJe\x11dc\x13\xd0\x90`\x8b\xc4\xc0I\xe4\x1eqS\xa7f\x95\x90\xb4v\xcdo\x8e\xa2\xc8\xbe\xd3]o\x80\x804\xf9;mV\xd0\xa8}\x7f\x0c\x0f\x92\xa2\x02xa\x0fo\xa2\xc1\xaf\xd3\xb6d*\xee\x0c\x93\xe5\x9d\xde\x1ao\xa5\xec\xbfW\x95\xb10\xacP=\xe6\xc2[\xdc\xbd\xa26\xac\xa8\xf0+1\xef\"\xc8m\xc0\xfa\xadG'\xcc\xc5\xe9\xaf\xb8\xf9\xd7k\xf9\xa8W\x89\x8e\xf8\x898\x0a\xd9\xc5\xfe+\x8d\\\xe6}\x96\x19\xff\xde\xc1\xc9\x1e\x10\x07\xd1\x1f\xde\xf5\xc3\xe3\xfe\xaa\xb4g\xafs\x0es\xd9H\xe3\x84\x15\x9b\x88u$\x14.\x8dk\x0d\x05L\xeam8\xee&\xe21r\x8cD5\x9bJ\x0cr\x0c\xc5\x1c\x8b\xc1\xf70\xd6\xbep\x9d\xe3\x15Fe\xb72\xe7B\x17\xa7K\xd5ShL!\xc9r\x08\x9e\x9fZ\xecy(\xfe\xe2>ZA\xd3m\x05ViE\x87\xca2\xcd\x7ftC\x12\xdf\x98\xe8Nf\xe54b\xacfo\x85\xf0lt\x06\xb1\x01\x8c\x0c\xca\x0e\x89\xd5d-\xa3\xf39\x04\x14\x96L\xff]4\x9b\x92G9\xb8\xc1\x1dM\xa4\xed\xbdbj(\x15\xd0A\x0bvr\xb1\xb7\xdd\xfa\x1en_\xe4\x7fG\x12\x14\x0ajMj8\xa3^\x17\x06\x15\xd8Q\xd3\x0c+\x1b-q\x0f\xb2m6!gQ\xfa\xdf\xaa\xe7|\xf3n\x99U\xe9\xdbr\xa9s\xa1\x8f\xca\xa2\x89\x0d\x87\xd1\x1f\x81\x92\x93\x11D\xfa\x99\xca\xb9\x16\x8d\x91{\xd5w\xe4\xc8\xf9\xd1\x0f\x16L\x12\x08Q?\xe1\xfa)2e\x92\x97Es\x0e/\xdb\xc2C\xd5\xb8\xe1\xb0\xad\xff\xf7\xfc\xf9\x96\x84\x82\x1b\xd7\xe4\x1d[\xd7\xb8\x9a\xc5\xf6F\x1e@\x87\x07\x7f\x85\xa3\xfd1<\\\xe7T\x8a\x88\x8a\xb2\xd8\xe9\xb8\x87\xa8\x18i\xe5J\"Q(\xce\xe4\x9d\x9c\x84`\xfe\x89\xe2>\xb1!\xf3\x09\x1bq\xeaF+k\xab\x09^X\xba\x9a5Tz\x0f\x0fp\x0e\x1f\xc6\xef\xc1u\xce\x15\x16\xe8s\xca\x01|\x16\x14\x84\xdeH\x99j)\xdf\xb9\xf4E\x1de\x9c\xd4$\x87{s\x1d\xdb<\x0a$\xbe\xfb&r\xd7\xda\x84+o\xc2O\xb6\x95f'\xc1\x03\xab\xfa\x86\xb2\\\x05\xf7\xc1,\xa4..\xc57(]\xd8\xac\xb0\xdf\xe5\"G\xca\x11]\xaf>\xeb\x19\x06\x06\x09\xb5,\xbalt\x9f3}\x15n\xdc\x94_}5\x1b\x82\x8a\xe7gq&\xd9\xc9N\xfa\xaf\x05\xdc\xde\x01AFG\x05\xe1\xa0\x7fb\x9d\x18\xa4-\x7fKC\xd0\xa8\xc4\x0bZ\xee]\x93,;\x04\x89\xbf\x80\xab#PhzV\xd0\x84W3\xf6\x8d\"\xbe\xa5\xec#\xb82\x80\xa6\x91>Ch\xef\x95J\xa1\xe7\xb6\xbe<\xb4\xf2T\xd1E\xd9\x9b\xad\xce\x9d\x94\xb7\xa6\x1d}\x81\x06\xd8\x8dNvDZ\xa4\x10S\x85\x90\xb6\xcff`\x93\x84arq\xe2\xa5\xb4\x16 \xdb\xea(=\x1d\x0c\xf6\xa3\xf1\xa2\xc3\x90\xb7i/\x9e)\xc5!\xb8\xd7\x1d\x8a\x8b\xe1\xf71\x92\x86\x16\xa5\xeb`\xb7n\xef\x92\x91\xb3\xb47;\xf5\x04U\xc2=\xd9\x93tU\xbb\"\xa8\x19\xa7\x86\xb1\xe3#\xea\xb7\xf6\xbahkc\xa4\xfe\xd9\xef\xb3YM\x0c\x91?|\xf7K\xef$v\xfc\xb1\xeaKW\x1f3l\xf3\x83\xeb\xacwF\xf3\xd5>]$\xdfN^1\xbc!\xe7\xeeUr=\xdd\x97H\xad\x9aT\xcd0\x8b\x8f\x8b]\x18\xee\x93%()\x17\xf60j\x10\x02o\x18\x8eB\x9bB\xd0\xb5\\O\xb7%\x0c\xfa\xd5\x8c\xc6m\"Z\x1f\x0dg\x8c\x982\x0f=\xa5\xef'\x8d2\xed\xe0\xf9\xd7oa\xe4\x06\xa8\xab\xd2\xddg\x13J\xba$I\xf0!\xbb\xe8X\xf5M5b\x94K\xb218\xcf\x9c\x08 \xdbu,\x04\xcc\xd5\xf2\xe6\xc0\xa3\xc4\x92\xa4\x1d\xbb\x8f\x9f.\xbc\xda\x0bn\x09\x1f\x91BI\xca\xab/\x91\xf2\xb0\xa6C\xc7\x01\xa6\xb0V\x1d)nu\xc1p\x1cN\x8c8<\xe9\x12\x9c\x99\x99\xc8\xa3\xca\xef\xf4$\x07\xc9\xf2\x1cq@Sm2\xeb\x9a\xcf]\x9f\x9e\xe74suyC\xb43?\xc0c\x92|\xf6\x8d\xdb\x1c\x1dOW\xb6\xff\xbf\xf8\x0b\xe9\xb5\x09B7\x99\xd5\"\xb9\x8e&Rmz\xd1D\x8e\xd0]\xec\xa0Q\x07)\x09'-\x00\x1c\xe4$\x84\xfal=\x7f\x92\xb8\xe2\xbc9\xd9\xa5\xaa\x99\x8d\x16\xd4c\xaa\xef\x95\xa4\xcf{\x7f\x0f\x17t5\xdc\xcc+\xb6\x9e!\xeb\xac\xcd\x93\xeb\xf6\x11\xbb5\xa9\xda\xc6a\xdb\xd9\x18F\xed\x17\x03\xf0#\xdc|\xb4b\xa30\xd8\x8a\xa0\xea\x97,\x82/\x9eg\xd5'\xc5\xa3u\xa2i\xc4a\x04\x0b\x9f\xd8\xc4BN8O\xaa+\x1dt\x90\xa9\xd3\xc0M\x14 \xdc\x10N\xec\xa8\xfe}N\xa7\x85\xc2/7\xdf\xf8\xeeq\xad\xbb\xb1\xe2c\x87F\xf6\xfbL\xa1\xc3t\xde\xf7\x93\x90\xb9\x1bF\xe8`v\xbd\xa1\x11I\x83\xb4\x81n\x04\xc7G\x0b\xc8@\x85\x00>\x85+\x81d\xddx'\xa4\x80\xc8\x80\x93\xf1\xae\xf5,\xa5'^\x9d\x013`f\x8d\xcd\xf7\xd7\x9f\xe6\xa5l\xf8\x09\x1a\xf7\xd1\xe1\xfeO\xca_\x98O\x82\x8f\xfa\x1e\xe6\xba4\xba7I\x98A\x83\x8c\x92`#\xa0\x99\x82r\xc3(b\xbfQ\x96A\x1d]\xf8\x7f\xd0\x9a%\xda\x96\xc8\xfe\x06\xe8\xc3\x8b\x9d\x99\xbb\xee\x1aLYE\xf7\xd6\xe0\xdb\xa3\xf0\xf4e\xe4\xb9\xcc\xdat0s\xc3\x1b\x99\xdb\x96\xd7.|bi\xc6\x9f\xc9\xc2\x8cr\xe4R\x88\x14L\xacc\x91\xe8\x87\xcauQ\\l%t\x15Y\xe3\xde\xcf\xe7\xe2\x98\x0e;a\xbb\xa1r\x16[\xc7RW\x88x2\x9e\xed!\x1eyT\\\xc0\xdb\xe2\x8fU\xc7u\x01\xf4;\x97\xc6P\xd2?\xed\xa6\xa4N\x8f\xcc\x06\xcaEl\xf5\xd6\xeb\x9d\xd2\x82t\xcd\x0e\x06'@?\x8cw\x12`\xc7B\xf1\xd7X\xb1#\xdc\x84\xbb4k\xc3\x1f\xc2\xe5\xa9\"\xba`$\xf1\xa0 \x9c\x83\xa0\x0b$\xe3\xae\x15\x89\xe1\xfe\xa6\x8c4<EL\xf7\x97\xce?l\xae\xa1I\x99\x0bO\xd7\xd2\xa1\x0e3\xb9Z\x82Q\xa0w\x8a\"N;8\xdfl\xb1F\x04\xf6\xf1\x09\xd4\xd5;\xb4\xde\xe7\x8b\xc9\xbf\xad\xfa\xc1\xb7w\x1a\xffV7&\xc6\xab\xa4\xc6\x10wo\x9e\x8c\xe7As5\x12\xe4\xea\x99#P\xb9\x00d\xde\xcc\xd1\xab$\xba\xdb&\xfa\x88\xf7HW\xf0\x02\xf3\xd8\xb8\xc1\xe0s\x1c\xe9 \x93\x96\xac\x07.\xc2j\x01S\xd5\xf6F\x8a\xde\x9aJ@/\xef\x9d\xdb\x8cp/\xbf\x9a\x02'\xee\x9e\xfe\xd5}\x04R\x04k\xa1\x06M \xe7\x0d\xa7\xd2\xca3\xa7\x1c\x10\xf0\xbfQ\x86\xf7d[\xcf}\x19c\x91-\xff\xa8\x93O\xf7\x8e\x19\xf0\x93\xc5b\xf3\xa3\xb69I\xac\xe6N\"\x1a{C\x88\xe9e\x9a c\xc4\xa7%}\xd4+\x13\x88\x14J\x80\xae\xe8=r\xd3\xd9\xc5B\x11)_\xc2W{\x90j\xf2\x90ln\xe0\x01z\xe8\x84AXf|\xbb\x0d\xc5|\x99\x18M\xb4%\xe5\xd4BK`c;\x9dim\x90\xbc+\xb2L\xec\x90l\x0f\xf7<8\xc6\x80\x92\xf0\xec\x91\xe9\xa6\xd8\xae-\xa7\xb2\xb2\x99\x92lN\xdb\xa5\xc9\xeb\x98\xa1\xfa\x05nnxd\xcd\x00\x1e\x8c\xcaP\x18\xa5\xd7\x08g\xbf\x1e\xf2\x8f\x0c\x8a\xf3w\x97\xa8J\\\x18\xd9\xfdi\xab\xb6\xea\xfc\xcd\x1d\x8b\x0e~\x19\xfc\xa1\xf5\xc7\x13\xc3\xc4\x0e\xf1\xbc#\xe7\x17\xdf\x16?%\xe2\xa9~\x8a\xde2\x8e\x03v\xb1\xf0LY\xba\xab-W\xe2\xe5\xab\xd9$\xaf\xeb\xa8\x9a)I'J\x19c\xac`\x90\x1e\xf8o\x07\xd7\x87gc\xc6\xac\x99U2\x06\x80B\x10`\xce~6\xc3h2\xdc\xa8}\xea\xcf\xbc\xa1\x10\x0d\x15k\xf9\xfd\x06I{3\xb2\xcby\xd6\xac(\xaa\xaa\xb4WO2\x9bm\x84\xbat\xbd\\c\xc5\xbd\xabza\xcf=\x94NW\x9d\x15$\xf9L\xa1\xa9\x14\xac\x13Q\x15\x8c0\xa8\x11G\x7fBj\xab\x93\xe1\xb6B\xbb*\xe3\xb6b\xc2\x8cZ.1S!\xf8%\xecV\x91\xc7\xf1\xc3L\xb9\xdc,\x8e\x8c\x11w\xd5\x03\xdc\xc6\xaa\x07y\x10\x8b\x07\xf9\xe1Q\xdd\xb6\xbbk\xdc-\xfc\xe1I\xc1\x01[\x83F\x8b\xc1\xd6\x98\x89\xcc\xb3x!qR\xd5~\xf4FV\xf1\xbd\xdb\xe2Y\x8cT\x91\xefF\xf01\x16\xb8\xc0\x91c2\xc7\x9a6\x96\xc5W\xfa\x0d\\/,~)cK$-\x1f\xbd\xc8\xd3\xf2\x83J\xf1\x93\xe5G\x96\xc5\xf7.Yy\xe8(/\x7fJ\xb2\xd2\x13B\xa7`'\xe3\xf3\xc8=\x0cAr T\x90\xf6-]RcZ@\x09\\\x88z\xd0J\xd4\xe3\xdb\xe2[\x02\x17\x1d\xa2\xbe\xf3q\xa5\x02L\x1a\xe31\x0c>*\xaa \xe0 \xa3(\x97k\x16\x9e\xee\xe9\x08I\xa9`\xe3K0\x95\xc3\x8e\x84\xceoOI\xa7\xc8\x09a\xf1\x01w\xa6\xaf\xa2t\xc5\xc7p\xc93~jh;?i\x8e\xf19,O\xd7\xfaS\xfd)\xb4\xcaQ\xc2\x1a\xc9\x9d(\x8d\xd7\x86\xfdM\x02\xca\xa5\x87\xa2\x01?\xfe\x1e\x8doP\x98&\xd3=\xd9!\x96[\xfa\xd9JK\xd3\x1eYW\x1d\xe0XF\xdd\xf2\x94\x14\x84kd|\xe3F#\xa2\xa1@7\xde\xb7\xcf?\xe4\x8dz\xbd\xb9\x861z\xe1\xf4\x01n\xd0%\x07H\x03\xe2\xb8>0\xd6\xf1\xa8\xbd\\\xdf\xa0q\xbf`\xd3a\xf7\x7fiS\xbek\x91\xd1\xbbt\xb5\x1bm1\xf6\x15\x97?w\xc0\xe8\xe7b\x9b=_o3\x17\x1e@\x1cAj\xa1k\xd5\xc0\x9d|\xa2\x12Fe6\x0b\xa3\x80\xff\x89Wa\xb3\xd9\\\xacS\x9a\xe8\x02\xc2\xd3\xc5\x16\xc0:\x16)\x0d\x8bNtE'@w\x14 \xde\x0f_>\xcd1\xa0\xfa3i\xb9\xd0\x81\xd3\xe2\xe6\xb9\x95\xf6@+\xd8y\xbd\xf9^\xac6_\\_\x0f\x11\xe2J{S\xeb\x1b\xd0H\\$\x8d\xea\xae\x1eWUt\xb0\x1a\xb8:N.\xdb&M(\x16\xc2\xc5\xfb\xeaoU\xed2TY\xb6\xa2\x7fa\xb3\x05C!\xdf\x0e\xff\xdb\x80g\x12\xc7\xc5\xd7\xa3Y\xfeZ?\xc3\xf2\xfd\x9b\\\xb9W/\x97\xf7c,}\xc5\x07\x8e\xca\xa3\x0b\xb0\xa7\xccq $\xab$\x03\xaf7\xa4\xe1\x12\xeb\xc8\xc4\x99f|{s\x11\x0b\x19\xd6\xbc\xb0\xe8I/\xbf\xd1\xab\x88eQc\xd8F\x92\xe5v\x0c\xe9\x96R9\xba='\x85\x0fu5@\x17v(RO\x97\x93z\xb8\xfas\xf8\x98\x9c\x8e.#\xda\x17g\xfc\xa4\xf4\x02\x9fv\xf1#\xd8\xc2\xb1\xe5\x87\x7f=\x93\x83\x9a\x0f\x07gi\xe9\xa1\xa2\xcd\x8d\x05q)\xb4C\xcaJ\x98t\xbfTW\x17\xf6\x13\x1c\xd1\x88\xdf=\xe3'\x9e\xf0FR\x113q\x02\xa9 \x11(\x1c\xef\xc4\xfe\xe2\xfb\xe5\xf2\xe2W\xf3\x9c\x97\xd3\x10?R\xb7D\xfb\xc5u\xcebC\x92\xe7\x84\xd6q\xb0\xfc\xcd\xa2\x04M\xf9\xa9\xbb\xd2:\xa2\xffJth\x8a\x1a\x9a\xadL\xcf\xab\x15\x13\xf5\xdb\xe0&6\x06pt\xe0P\xd5\x91cU30\xb1\x8b\x91\x9aDW\xeb\x8b\xe5\x82_\x7f\xb5\xc2\x15\x19N\xf65\x14\x1f1.$k\xadE\x96\xc6\x13\x0e%\xaeXG\x01\x0d\x0cU{w\xe3\xf2\x0a=\xba\xbb/\xe1q\x07'\xdf\xeb\xa73\xb543\xacU\x93\x08\x9ckh\x99\xc4\xad\xc7\xf2\xec\xf1\x0a\xc7\x8b\x1b\xbe\xeaoZ\xe1^?[\xee\xce\xefQ\xaf[\"\x18\xb7\xb0P\xf7\x93lv\xaa\xdeq\x08\xcfw4\x963\x05\x95\x0fT\xe5yL\"\xd5k\x8ePJC\xbcI\xba\x17S\xcf\xb6T\x84\xc0c\x87)VD\xeb\xbc\x84o|\x8b$0\x96\x09`<c\xf9\xb1_|\x19\xfd\xef\x1c\x0c\xb6t\x16\x03k\xeb\xa5\xca\x19\x82\x83MD\xeck\xc3\xd5@\x9b\x15\x9a\xcew\xdf\x99\xedg~\xdf\xb2DK&4\xc6\xa6W\xac\xca\xc7g(\x0e\x7f\x06\x7f\xf3M0q~\xf1\x7f\xbb\xad4\xd2\xab\xd9\xd5/\xe154\x85\xfe\xd2W\xc8]\xd7\x10w]\x18t7\x1av\xd4\xc94\xf8x_=\xf3\xf71\xc5\x9f\x06{\xaaq\x83\xbb\xc9\xee\xb4\xb6\xd5\xbc~\xfd\xe5\x94\x7f\xb3T\x03d+\x92\xc7\xfe\xc5\xf4:\x0b\xe4\xc82\x05b\x80\xbd\xa9\x06`\x14\x1c\xbez\xaae\x1a\x8bB\x19\x14\x18\xfd\x86\x90kZ(\x01\xdeSt\xfe\x8d\xb1&haZ$\xc4=\xcd\xd6\xc8K\x954\xf1\xb0\x8a\x05gy\xb9\x17\xeb\xbf\xbfZ\xdaD}\x82}7>\xcf\xd4\x85\xa6\xd9%\xec5\xb8\xb7FU\xf8\xe3\xea\xb9\xa3o\xec\xb9\xc1\x0f\xbf\xba\x06\xbaS^\xcf\xa5\xd9S\xa5\x15h\xa5\x12y\xf9\xe5\x9eN\xc4\x92\xa61\x08y?\xf6\x8bB8\x14\xef\x80\x80\x10\xfb\xbcj`E\xda\x05\x8d\x8b\x7fXi\xafC\xb4l\x8f\xf1UO\xab\xca\xfe6\xcb\x1b\xd9=>\x80n\xffv:J\x9ex\xb9\x1d\x9e\xcdiI\xc6J0j[\x84x\xdb\x18\x1e\xd3WD\xb3l\xa8HqB\xf5\x11\xc3\xca&\x8d\x12mtH\x83\xb1\x93*[\xccu\xa51V\xf5\xa4\xd0\x80\xa2\x86$\x02\x8f\xd9FX~\xd3\xe7\xb3\x01\xfc#\x91\xe8\x1e(t\x7f\xe4\xf7\xf2\xed\xdd7\x1a\x9a\x88t:\x9e\xbco\xcd=\xcfre\xa8\x8a\xf2J\xef\x04_\xe2\xea\xce\xe1#2v4\x9f\xda\xfb\xa7\xfa,\xffX^\xe1\xbf~\xb1^\xe1(\x88\x9a\x8c\xbe`J\x019+\xfe,\x8f}\xfe`\xf4\xd1\xa9H7\x8e\xe5\xb6j\xdc\xdd#\x01\xa1\xf0PL\"]\x09\x8b\xd8\x96b\xfa\xd7/S+s\xf47\xe6r\xe3\xa1\xc8\xa6\x8f\x19\x85k\x09\xa9\x94 \xc5\xce?\xc9\xcc\x1c\xe0\x0eg\xb8\xafX\x1b\x91\x8b\xfb]\xc2r)-\xd0FK{v\xec\xa6\xd1w\xadR\xa2\x8aQ4\xd8\xccdu\xc3\xe8z@\xc61\xf2\xd2$\x12`\x86\x16\xe6y\xdd\xdb]\xb9\xb6\x94\xb8\xf9\xbc@\xdf\xfc\x1b\xf7\xcd\xaf_\xef\x9aL\xa5\x1b\x9d\xbf#\xe8\xb4\x86\x1a\x1a\xe9\x95\xb1\xea\xeb\xea\xc1\x8f\x04q\xc4\xb0;\xbd@b%e\xbf\x90\xc5\xa4\x92\x1f\xce\x18tuu\xc3l\x0b{\xf7\x84\xfe\xc3dh:#\"c\x18\xc6\xec\x15(\xaa\xbc~\x1b\x8b\xe5\xd7\x93M\x83@Ji\x84\x15\xbd\x07\xfc\x95^\x9c\xb3-#by8\xcc\x8b\xd3F\xe7\x16\x0e\x80\x07)\x97\x80+p\xf1Y\xe8^\xe2\x02?|\x8b\x0e4\xb6q\xcc\x11&\x95\xee\x84b\xe0w\xc2\xd0\xaeY\xb8\xd8*}\x93[Z\xe1\xfc\xcc\x18Y`\x01\\n\x95\x88\xe3\xcd\xf1\x90\x91\x96\x86\x90\x12\xdc\xfd,\xccV\xf5\x08\xad?\\\xa8\xce\xf3\x98'I8z&d7iB\xdeP\xa1OF/H\xe9y\xd5C\x1b\xafR\xe0\x98\xd3\xcc\x12\xdc\x86G\x9em4l\xd7\xfc\xa5o\x12\x1f\x98\x80\x9f\xc5\xec\x9b\xaa>\x1b\xd7`\x87\xe6\xae\xbd0\xb7\xb4\xbe6T\xb5l\xeaw\xe8\xb0=)\xd0\x81\x88X\x10\x87\x0d\x93z\x09C\x85\xf9\xb0&jW\xea,\x90\x08\xc3\x9e>\x8b\x9c\xeb\x010|cX\x88\xa9F\x89P\\.M$$\x99\x00\xe2;W\x1c\xbe\xafj%V\xca\x179*\xdf\xfa\xe8\xb4T\xa6{\x04\xb2\x8a\xcff\xce\xf3\xa0<)\xb6-}\x04^\"\xa1\xde(\xd5\xdba\xe6dt\xef\xf1\xc2\x91\xf5:\xba\x1d\x87\xd4y=2:\xf12\x1d\xdd]Z\xfd9\x83\xfbu\x10\xa3C\xd3\xe3\x97P\xc6\xa7yx1\x9a\x96\xbb\xd9>\xa9Xk.L\x95\x92\x87\xdf'\xec\xbd[\xf4H\xcf\xd3ep_\"\x15\xb5\x81\x15\x9bn\x10\x0c1\xd4aw\xaa\xe0\xaa \x9d\x02\xb9RQ\xce<\xa3\xddS" +
+	"\xda'\xf1\xd9\xa8 \xb5\x81\x06\x18\xce\xbfK\xe4a\x99\x91\xef\xeb\x84\x91\xef\xbf\xff\xf5\x7f\xfd\xf5\x7f\xdd\xcf\xc8\xf7\xbds\xed&\x8f\xdewst}D\xcaw\x90AyL\"\x01\x0c\xfd\x0f\xbb\xc1\x93M\xf2<E\x0f\xf18_\x88,!\x90\x85\xc8\xa1J\xe8\"C\x0f\x97\xd8\xa0\x03\xbe\xce\xe3\xf7\x85\xb4yr\x87\x14\xfc:T\x9f\xab.\\\x9f\xbe\xa6\xfa\xab\x0b\x15i\x98a\xfe\x88\x17\xc2\xc1\xa6{{\xe7\xba\x12\x06k\xb4'\x85\xb5\x86\xa6\xa0\x80\x0ej\x03\x821Q\xa1\xd0|\xac+L\xac\xe2*\xf9n4\xc7f\x91P\xf1-WV\xb0\x14\x7f\xb8M\xd8\x17\xa5\xc3\xd0\xecQ&\x9d\x1az\xdf9\x05\x1d-\x00;BsH\xe6\xe4\xf0}5\x84\xb8(\x92\xae@\x17/\x98\x17\xd5\xdf\xc5\x0b\xce\xfa\x80\x7f\x9eq\x84\x98\x02\xe7\x10\xee\xb3L\xbb\xfb*\xd5v\x92\x17\x1b\xab\xab\x14\x15c\x17'\xf4\x82\xe9\x80\x88'M\x17\x95\x84h\xea\x8f@eu\x04\x9d1\xd44f\x81\xb5>Z}\x83 !\xdc\xe7)\xea\xd5\xb8jf!:\xe2D1O\xe4.\x1eg\xd9\x1bY-\xfd\xe0\x1d3\xe6\x9d$\x04\x89\xce\\\xcd\xb4\x7f\xf8\x10\x1a%'\xa78S\xd9)\xb1\xd3\x19\x1eP\x1c~p\x0d\x97\xc0d\xd1z\xbdZ\x16\x98\xe7\xbcL\xd8KKw\xc3\xa0\xe8|\xc9\xc0\x87\xa4`\x8ej\xf7\x12\xc9\x88\xd6\xe7\x85\xa3D?d\xb7\x9c\xa3\xd8d=\x14\xeb\xe2\xed)\x07a\xe9\xee\xbe\xbb\x92\xdb\xe0X\xddV\x10P\xda\xd3\x1cgp\x174\xb2\xa5\xba\x85\xa4i\xfd\xe0#\xa0\xa7t7A\x1f\xae\xce\xdc\xf5\xa1\xb3R\x03\x12~\xd9\xe6\x18|\x1fK=\xb9\xcc \xccd\xbe\xb9l\xa9\x87\xef\xe0(\xf7\xba\x87\x15\x8b\xac\x8d\x11\xb0\xab\xf0%\xb8D \xfc\xb3\xf5O\x8a?\xe5t\xedF\xb6\xb7\xf5%\xdc\xce\xf8)\"\x8c}\x0b\xdd/\x00#\xf7\"'\xdfF\xf0\xfc\xaf\x00\xa0.LSB\x07\xb5$Qs\xa4,\x93\xcdo e\x9ff(^\xban\xfdi\xf8\xc4\xec,\xe1\xc5d\xb2d]\xc3\xa9\x92\x01\x1e\xd8\x99\x90(}\xd1\xc3\x0d\x06\x17\xd3\xabB\xd2\x08]\x09\x98f'{(]cn\xc0q\x0c6\x0cW\xc1q\\\xcd\xa7V\xe2\x03t\x0f\x9b\x9b\xf1\xd3\xa7K\xdd\xcd/\x18u]\xa8v\x02\xff\xf6\x98hZj\x01]\xedGY0\xe8/d\x9f\xd6T1C\x9d\x1e\xe6F\xf7\xc8\xd7a6\x13\x7f[D5Gc\xea\xb4M=\xa8\xe8i\xac>2\xb7\x08\xfd\xe0\x07o$L\xe9\xc9\xcf\xfa/\x89\x0a{9\x96\xf2=\x8a\x02A\x99>\xd1\x0e\xd4\"H\xd3&\xb1\x9e\xc8\x1b\xe1\x7f_\x90A\x9d\x987pk|S\\\xc0\x8dI\xec\x91\x0a\x8bh\xf6F|[\xdc^$F\xd4\x84\xcb\xa2\x1b\xaa\xc5Lo\xbf\x8cw\xb0\xa6\x10\xa9\xac\x91I?\xbb\x8b\xb7#n c\x87\x07\xa2\xd1\x99O\xd2\xabe\xf4]\x1c\x98n\xe3\xd8\x93\x19\x89\xd6=f\xea\x93\x99\x0c\x1c\x87VgF\xc1N\xd2B\xc9\xfa\xe3\x9b\x91Z\x92o=\xed.\x03\xcb\"\x16PC\x83\xef:\xa3\xda\x82\x93\xd9\xd5m\xd8\x83\xea\xaa\x83\x8bC/\x88l;\x81L0\x09\xd9D\x1f\x88\x8d\x1e\x0e\x1f,\x1a=b_\x85\xbe\xdc\xc9X\x88\xef\xc3\x15\xaa\x15\xd5\x91\x1c\xbe\xaf\xda\xfb\xcd\x0dJ\xfak\x8dO\x9eUM\xd5]\xb10\x8f6+\x1cJ\xd4\x9fN\x92zR\x92\x12\x09\xcd\xb2j\x940\xeeC\x9b\xba7b\x92\xccH<\xe1\x96j\xb4\xa6\x8e\xdc\xe2t(!\x89xJ\x86-\x0f\x0f\xaf\xc1\xb7\xb2\xad\x88K\x9b \xe2\xb2\xebK\xbd\xd2\xbd\xeaG\xfe\xb3UH\x8a\xbcka\x90i\x02\xc7\\Lo\x8d6\x155i\xd5\xb9\xee\xc1Gv\x09\xa0\x95L\x0f\x0d\xa6UB\xa7<C\xb3\x19\xc3_B\x04\x95X(V\xb0\x80\xcf\xc0\xcb\x1b\xed\x91\x81L\xc4\xd0L\xe2Q\xdd\xb5~l5\xff\x1cY\x1f\xe5}fI\xf1\x16K\xfc\xb0\xbd:G\xdaHX\xd6'\xf5\xc4ll\xe1S#\x83,\xf3\xb1$\x05\x92\xef\x1a\xbd\x88\xb0\xcc\x9e\xe6\"z3\x1ay\xbf\x89m\xf6u\xf2\xb6Y\x97\xd8\xd7\x0d\x93S\x08\xfe\xf6\xb1\xfb\x9df\x84\\\xa1\xd4\x00\x81$z\x99\xdfb1J\x1d\x80+\x9bz\x8d\xd1\x13\x966>!m\x89.]\xb6X\x8c\xb1)D/,\x0c!g\xfa\xd2\xe8\xaa\x98\xd1\x93\xf2\x05\x82\xf8Q\x90[\x8b\xb02.\x9a\xe9\xacj\xa1w\xa4\x9cR\xf5\x0e\xea\x85\xa3\x04\xbd5\xc77\xbeD3>aJ\xff\xd4\xc7H\x92\x0d)h\xe2rG1w\xe4\x99L\xcav\xf1\xb1\xa2GZ])'\xc1O\xdf\x0bz?-\xff\xa3\xb9)\xfc\xae\xa7\xb8\x14\xcc\x91\xa5\xa21\x07&{\x85\x86k=\xf8\xd2z\xc2\xbe\xf37\x9a\x83\x11\xfb\xc4\xb1\x1fj\xe8H?\x1b\x0e\xcd\xa5T\xdf\xda\x94m\xbcA\x0c\xc2\xa6>r1\xb3\xd5\xcc\x0418r\x8aA\xb9\x13o\xf06\xda\x9b]t\x8f\x9c,\xae\x03\xa5\x94\x0d\x95\xd0\x82\x83\xee\xcb\xe8(\x95\x87J\xee\x14w#\xbc\xa7\x7f\xf0\xdd\x95\xe6\x06\xd2\x84_a8\xe3g1T=\xac\xf5R\xb2*)\xd1\x14\xb7\x02\xef\x97\x97\xaa\xf7]\xe9\xba\xab\x9a\xeb\xd8\x14\x91M#tO\xcb=y\x11\xe9\x0d\x0f=\x83\xc9z\x99\xb6!\xd09\xb3\xe2.\xab-\x93\xe5\xd0\xc1P\xdd\xd4\xe7\xd9\x14\xda\x7f\x96\xd6\xf6\xc5G\x89\x16p\xdc\xdf\x85Qt\xefRrZeY=\xcdH\xf7\xcf\x0f\x0aU)\xd9M\x95\x895t<\x85\x9d\x11\xd5\xc1t\x092\x7fI\xb7}\x0f\xe5\xf9\x11:\x83\x92'S#\xe2\xd9\xac_\xc9\x8f\xae\xf8\x89:\xf4\x1e\x93*\xee\x86\xcf={\xbe\xf0\x98\x91\xc4w\xf0\xa17\x0bp\xfd\xccsF.\xccp$`8\x83\xb2\xfb\xa9\xc5\xa2~Q\xeb:\xdf\x0a \xab\x83\x9a\x16\xf4r\xe6j\xcavO\x97!\xb3\x9ak^\xd5\xba\xb3\xcc\x9bz\x8f\xd0\xc0m\xd86\xcc=\xe6\xcd\x1e\xc9;\xe3\x12\x84xO\x09\xc7\xd2B\x83\xae\xca5h\xa3\xb0oR\x10Y;\xdfV\x05\x00\xf2\x92\xf0\x1c\xdf\x92\xbe3z.\xc7\x93zew\x09\xf5\xc09\xfe\x93/\xdez)\x87H\x1aLfx\xc4\xce\xdaHI1z\xb0vB\x03\xdd\x83\x1f\xd1w\xe86\x89r\x9e\xa5\x02\x94l\x88\xd1\x8c\xc67)\xf8-\xdc(k\xc9A\x88\xb2\x8ef\xa71L\xff\x91\xec+\x8a\xf8\xf0\xe3\x95\xfe\xa6\xcc\xbc\xb3Ss\xba\xf00s\x1c\x12\xad6\xde\xb2\xe2\xa9h\x80%&\xce\xa4]\xeb\xcd\x99\xfbrA\xa8\xd2\x00\xc2\xb0Qf\xf6\x90:\x1f\x1d\x9aw\xae\xab\x16\x1a\x7f\x0e\x1f\xd0\x9d\x8a\x0d\x8e9a`&x\xec\xd55\xec\x89_\xa1\x17-Y\xf6\xf3\xc4;S\x90o\xccv\xb5>\xeb7\x1eAHn\xe6f\x99A\xd4\xea\xe6\x8aU|\x0etoXn\xfb(\xc1\xc7\x8f\x99\xc0Y8\x88\xfeF\xb5OU\x07N\xd2m\xc7S\xb1\xa0\x1c\xfe\xa7Y\xa5\x8a\x0f\x118\xc3\xcb\xf2?\xba\x18G\xc4\xe6\xbe>\xcb\xecp\xe9\x0e\x0c\xd3qt4\xe1\xe8\x92\x05\x17\xb3_\xc5AC|\x0b\xc40S\xa9\x9e\xd0e&\x8b\x867\xe2\xc95s\xd5\xc2\x99\xb9Ngy\x18\x7f\x0b+\xd6\xb3\xb9\x8a\x04$\xb5\x94\x0e_\xcc\x7fZ\xd3\xads5\xdc\"\xc9\x05\xb3\x02,\xb55tP\x0bq+\xc2\x8dJ@\x96L+\x9cO\xefLW\xaa\xc3%\xa9L\x0aEf)\xba\xae\x052\x18\x18\xa1e\xe0\x8e\xb2<tP\x0b)\x8c\xe1\x90\xae\x11\xec\xc0\xd7\x18Q\xc7c#\xeff\x92\xf6\x01\xe2\xb1c\xae\xf6\xf0\xaf\xe2\xbbnP_0R\xe7\xddat\xeb\xb9t\xd8\x94\xd6\x88\xc4\xb7!\x9b{\x08\xf3q\x02\xaa\xff\x8eX\x05\x82O\x8d!\xa9\xf0.\x0c\x97UZw|\xde\xefq?\x86\x07H\x07z-\xec\xda\xa5\x0bv\xa1\xaf\xc5\x9b\xaf\xbdF\x95\"\x98d\xd42\xa11\x8c\xc7\x11;\xa1%\xadF\xea<r\xb4\xc9T\xa1F\xd2Z\xa8\xf05@=0>6\xa6\x8a\xdd\x08\x05\xebLl\xb5\xc8\x8a3\xa4N\xbf\x01\x10\xcf\xbf\x9f\x91\x88\x00\x12\xefQ\xc7\xc9Tx\x97NT{\xf0\xf9\xef,b\xd0W\xdd\xb5\x10\xf0\xd1\x1e%\xaa\x9fq\xa6\x90\x15\x8d<X\xb4\xdb/\xcbS\xe59\x03\xeaG\xb6\x1e\x88g\xceR\x99\xa7\xb5i\xb9L\x14\xe5\x15\x0cQr\xa5jU\x96\x0aG\xae\xdf]o\xb8\xa1\xd2nRn3\xa5~\xb5\xdc!J\xba\xb9\xa5]\xc5O\x0e=\x02\xae\xb0\x9e\xb0\x17\xb9\x086\xe6.\x8cq\xec\x98\x84\x81\x90D\xd6z`\xb1\xab\x9bc\x02\xe3\xb0$\xa3\x9b\x83E\xccE\x0d\x8f\"\x0ck\xd6\xf2\x19\xf7\x09j]$\x16y\x9dvP\x16/\xcbH\xfd\xce\x02R\x9fBM\xf2'i\xc9\xdfID\xea\xa0\xd6\x0e\x87l\x12\x1e)q<'\xa6\xd0\xf9\x83\xcf\x92\x9d\x8b\x9aS\x1b\xa4\xe0\xb0\x06qxn\x88U\xbeP\x94\xa9\xae\x8c\x1c\x93F\x1d>\xc2G\xa4D\x98\x91db\x86\xb3\x8a\xd8\xcd\xe8UK\x0f\xf1n\x87\xba*J(!XX\x94I\xae\xf0}u\xcd\x9c\xa2Eyx%BO8f\x05Vs\xc8\x98\x8bUwu&\xd1>a'%\x07gE\xdfi\xd2\xd8{d\x9e\x0c+\xf4jxr2\x99N\x1b\x11\xa0\x8cs4\x01\x14\xe3&\xcc\x0e},\x0d\x8da\xa7=\x04\xce\xd0IN\xab\xf6\xc5\x1f\xa1\xf7\xb4\xf6\x13\xf21\xbc\xf9\xadh\\I\xc4~R\xb6Y\x8b\x15\xe0G?\xc8\xd4b\xf67\xc3\xc8\x1aZ\x1c\xc2\x9eb\xc3(U\xc7\xb5\x93\x0a\xa6>\xd6s\xbc\xa6\x1a\xd6L_M\xa1\xe1\x9b\xaeI\xa4n^@\x9e[\xf8\x00=\xa85\xc7\xb0I\x04@0\x93-\x92rC(\"\x8c\xd9\x12\xff-!\xbe\x83;\x00w@\x1e\x97\x83\xee\xb0i\x09c\xe4\xf6\x95\x1d-Q\x09;\xd9C#KZ&\xf4\x8d\x07\xe5\xa4\xd7\xdd*\xa1\xfc\xab\xe5\xcd*\xa9\x04\\\xe4\xb6^S5\xc3\xd8\xee\xc9\x80\xcc'D\xacs\xe8\xc0(cV\xba\x8b\x87\xf1|\xf1\xac\x9fZS\x9fPL\x80\xa9\xdf\xa5>\xe7\x10\xd6c\xe9\x10\x16\x11$\xbd)\x96?\xed\xaa\xbah?\x89\x0f!\xc5\xb4[#\xeau\x9epI\x82\x192\xc3i\xea\x98\x8c\xa8e\xe2a\xc6l\xe9WS=\xc6\"\xa1\x04\x06\xd7\xba\xfb\xa4\xf3D8Xd\xd1\x0ej)\x1fMXW\x84c\xd35\x0e[wb\x83[\x15\xe2y\x8fq7E\xb4\x01\x13\xe4\x88\x11\xcb\x12\xcd\xcc\x86\xa7DFH\x9b\x8a\xe8\x00\x087\"\x0c\xa3P{E\xbd\xd6\x14\xe4\xbc\x81c\x18\x0c.,\xb5\xe7\xf2\xee\xc6\xd1+\xb1\x82\x85\xd7\x18\xa5\x1cv\x81\xee\xea;\x19\x19H,\xe4,\x04W\xb8\x09]\x147\xc2neK\xa8\x9c#\x81\xb7\x19\x0d*\x06\"\xe7l\x91\xd7H$\xbdwsR\x97F\xc5\x01\xbakM\xd5(v\x97\xc3 \x98\x158\xcb ud\xbfX\x0d#z\xde\x943<\xfcP\x03?~\xb2vE\xf1D\x01\x10\xf0J\xb0Z\xe6\xf4\xe2\xab)y\xd4\xcd\xd7\xbe\xddx|\xcb\xeb\xa3F\xe2\x04\xa9\xde@\xb0\x9a\xf0\xca\xd8S\xfb\x0a\xccqP\xef\xa16\x96e\x7f\x01{x\x96\x7f\x98\\noIV\xea\x8a\xb1\xf4d\x86(\xb2\xbfv\x18\xa4\x99k^\xca\xaf\xed\xe4-\xc6\x87\x0b+=\x0f\xf1\x06\xea.b\xa6\"@\xb1\xf4\x9d\x0b\x97`\xb9\xb1\xc5\x07Y\xb45K\x17\xe0:\x91\xef\x80\x8b\\7PF/\x96\x8b\xba\x0dI^\xba\xa7\xd2V\xf3\xae93\xe0\xf8g \x0dq\xd1G\xa7S\x94x\xf0\x8aEH\x83;\xcb?\x14XJ\xcb\xb9\x01\x06p\x06\xb7q#0\xca\xce\xe8\xfe\xcd\x94\xf0\xb2\xef\xc7m\x83P\xf4=\x9c\xc3\x02\x95\x89\x9c\x08\xdf\xfb\x1b\xb4-\x94G+\x12\xec\x06\x97:n\x17\xe8a\x140\x87\xf0\xa4>\xa2\x7f\xc8\x195\x12\xc92\xcdd\xf2vX\xbf\xba\xe51\x18\x82\xcc\x1d\x84\xbb\xccy\x9b\xd1\xecr\x82\xef\x82\xea$w\xd0\x0e8\x15\xbaB5hr\xd8\xba\xb8\x16\x14=\x06\xdd\x03\x11\xbdN2\x8eB\xf1\xc6F\xe49|(\xaaEs(\xd6(\xfa8S\xd6\x98\x97p\x8e\xb3o\x11\x8cV\x82z\x9e\xd7\x01\x9f/^\xa5\x1dk\x1f8\x17\x88\xe6\x88\xc7\xcf7\xdfO\xfa\x11\xd7\x05\x8cN\xe9#\x1dt\xf4\x87}O\x82JbYF\x86&\x9ek\xd6\xfb9%*\xda\xed\xa4`\xc2\xb2<\x16\x87\x00\xd8w\xc3\xe3Q\xc6[\x848\x90\xe94k\xd7M\xab0R\x85\x82/\x98\x1b\x09\x06yVn,\x96\xdfb\xab!\xe7E[u\xae\xbd\xc3LieN\x89 %\x14\xb9\xa7\x15\x1e\x14\xab\x95\x88]R\xd2\x1bzS\x1c\x04\xe2j\xe10\x88\xed\xa6\xf5\xb15\xf7 \x1b\xd4q\xb0\xf1\xe5\xc8\x97'\xecFM\x83lf\xfa\xb5\xben]\xb7\x9b78e\x1f\x9f\xeb\x9eX9\xafV#\xfb\x05\xf3\xc65Sl\xd6pS\x01\xd0)\xf2\xcd\xacTl0\xdb\xa4X~\x868\x05a\x99\x15$\"\xc9\x1c\xf8\x1a:S\x9eJ\xd5\x9f\xe1\x12\xa4G t6|\x19#&\xb7y\x9b7:\x1c\xf0\xffc\xadF\xf2\xaetB\xc7\xae\xac\x09%\xc0\xadq\xd6\x9f@\x8fO]?\xfb\x0e\xca\xef\x8dO\xba\x96z\xaac\xfe\x92\xea\x88B\x1c\xc5\x85%\xcfu\xd73}\x99\x96\xd8\x1a\x03+l;\xef\x85\xc0L\x00\xb5\xadJ\x9b\x119G\x1c\x0a\xa6\x10\x93~r\xa38%\x07f\xf5\x88\xab\x86q?8@\xcb\xe2\xc6\xbc\xf9b[\xc6\xc2\x1f\x09\x15\xb1\xb4#M\x0c\xb4\xd8b\x8a\x81\xdb\xe7\x1f\xe5S\xc6y\xc0\xbc7\xe9\x8a\x81^Wq\x18\xa1\x93(\x13\x02!\xe1F\xb5\x0bs\x85\xfbt\x05[\xfd\xc6s:D\x19\x88PV\xbb\x9d\x82~\x1c\x9dOy\xd5\xa8\x15\x09\xb0\xa4M6\xeb|\xc7\xeb&T\xd8\xfc\x06Y\xe9],\xa1\x126\xbd\xbb\xef2\xff\x8d~\xd6\xb1\x96v\xdf\x12\xdct\xdd\xd8}\xf9t\x8f\x89\xa6)\xe1\x00\x09\xf3\xbd\x1f\xcf\xf8i\x8a\xe1\xb1\x023\x8e\x91t\xb1\xb2k\xbeys&\\\xdeA\x0e\xfd\xcf\xd3\xc2!}\xb6H\xeb\x97\xdeT\x94ht\xdb\x92\xa7\xb3K\xa4k\xa1\x93j\x13\xee \xcb\xf6\xb2\x93\xf1Y\x0adJ'6\xc9\xc1^\xe5\x98\xdb$\xf3K\xb4\x9a\x03\xad]\x94\xd3\xb0\xd8\x9b\xe4\xf14c$\x89:\xbb%\xd8-p\x98,XF?dE\xa6N\"u\xeb$H/_d\xb4\xf2\xa4\x98M\xc3\x13n\xb8\x87\xf9p\xdf\xc6\xa5\x0c\x09\x03`Ii\xc2\xce\x15\x87\xc9\xa7\x06\xeaX\xbf\x1c\xf3OR\xba]Ju\xba\x96\x0cf;\x096(\xb1\x13\xcep{\xd0w\x98\x9f\x94\x8a}\xb2\xcdVm\xfb\xda\x0cg\xe6\x83\xd1\xb9`\x98\xa5c!\x8b\xedu\xcea\x1b\x06<\xfb\xea9%\x18\xd1\x91\xb7\xae\x80\x16\x9as\xf8\xa0\xff\x8d\xd55\xf1\x97`\x1a\xce\x90\x03\x97\xaew\xadj\xca\xe0\x93\xb9\xeeJ\x10\xcf+\xf8F\x1a\xd7\x8e\x81\x07\xb8\xb9f7\xf5\xf5\x12%\x0fy\xb5\x9a\x99[\\\xb1lE,\x84\x1dZ\xcdd\xc1\x18o\x9elh\x17\"\xdd\x11\x9d=\x1f\x8eK\x06;\x95\x80\xe0\xec\xd3\x9b\xec`\xc4~\xbf\xd8o\x7frRr\x11w\x04}z\xf6q8\x9b.;jT\x7f+\x1dj\xbfY\xd17\x8bA\xe1\x81X\xeb\xfa,\x9b\xcar\x95\"\x86KJ\xc0\xd2\xf4\x1b\xf4\xd0\xe2\xe6\x89\x8b\xc7Gw\x8c\x19\xa5\x934\xa6]W%\x8b\x88h\x0f\x0f\xb7\x10\x81\xbd\xa9\xedI\xe5\xd5-|\xc4\x8b\"?\xf2\x99\xbe\xc2V\x90\xfa\xdf4\x1d\x8b\x03\x0d\xdccf\xbd\xbeU\xa5\x95\x15b\xb6M6\xfe<\x1c\x09w`4\x1c\x1a5\xe2R\x1e\xa5e\xf3%C\xa3\x0b\xdc\x16\x14x\xb8\xa0r=\xaa\xea\xe3\x972\xd2b\x01.tQ\xd9\x8aU\x0c\xb8\xcd\xea\xaat\x10y`\xf8nN<G\x17\xee\xc8\x15\x12\x12\xe3\x88:\xbc\xcc-\x12\xd9\xcf\xea\xea\xa3kh\x00|0(\xb6\x1f\xa9\xbauc\x9d\xfef\x12\xa4\x90\xf3%\xab\x96\x88:\xd4\x9fF8\x87\x8f\x88\xbccE+r\x9dJ\xac\x873\xaa&\xd6\x93\xd2-d\x87\x97\xfe\xc6\x14\x18q\x1c\x9f\xf7\xe4\xa9\xd2\x84>\xd4\x1bm\x1e[\xf9\x92\xeaD\xb4U\x03\xb5O\xe8x\x9elS\x96\xf3\xec\"$H&W\x19\x1dD\xc9\x7f\x99}\xb8\xae\xa8\xe4@\xab\xb21\xee\xf6\xd9q\x84\xa9\xbf9\x18x\xa00\x1e\xd1p\xc6X\xc3\x9d\xaf\x97P\x8ft\xfc\xbfuUC\xc31O\x0b=W\xb3\x10n\xd0\xbaP\x1d\xb6\xcd\x09n/b\x16C\x8eyv\x0f\x99\x7fHv\x06\xfe\x10\x01:\x01'\xd4\x07'ID\xeb#" +
+	"\x95\x0a\x95\x83@\x0b\xc9\xfeb\xee1uA\x97\x9a\xf0M\x0a\x8f\x9cD0\xd7B\x97C\xd5W#'\xa1#\xbd\xc9\x0a[\xeb\xb3U2\xa1\xd2\x11f\xe6\x18\xcb_0\xbc#\xb3v\x1e\x89\xfe\x1e\xe3\x8d\xcc*[\xfb\xd1\x9f\xf9\xeb\x83\x9f\xe1K!\xdfo\x8bd\xff\xf9\x1ev\\Z\xd8p3\xa5\xc0{\xaa~\xf0f)h\xc2\x91\x1d7{Q\xb6!\xb9p\x09\xab$\xeb$\xc2\xa6EM\xaa\x04\x93\xd4>\xb8\xe2\xc0\x85FG\xba\xccI\xb7J\xa9\x9c\x0d\xa1\xc4\xea\xce\xc6\x0b\x87\x9d\xe9bjOS\x08\xe6\x14\xef1!\xedW\xba\x18\xb3{n\xb3iG\xdd\xb8C\xb2N\x1d\x8d\xf2_\xe2\x83\x9b\xfe\xc4\x0e\x94Jd[\xbd\xcf\xc3\xa4S\xf6\x90\xf44\x0c\x92\x98(B\xe4\x8a\xa7<\xe6q\xdeJfD\xd4\xdf\xe0\xf2$$z\xf0\xaf\x1cE\x13hf\x85\xa6^\xb8\x82\xc8\xd4\xa51\x94\xfdf\xcf\xab\x97\xff\x0a\x13\x83!\xe5\xac\x95\xd2\xc6j]\xad\xc6\xdf\x9a\x06i\xca\x1a\x09\xe2E|2\x0e\x0dc\xa3p\x0a,!Z:\xd9\x82\xb7\xc4\xe2\x18\xc8X\x1f\xb9\xe5\xba\x9d\x84\xd5\xefJ\xd4\x80J\x8bqF\xf0\x17\x8e\xa7\xb0\xa3\x87\xad2B\xa2\xb9\x83&>\x9e2@Sa\x91t\xfb\xe9\x0a\x1b\")X\xbc2\xfa\x95\xdc\xd1\xab\xc40\x8a[~\xe4\x89\xd7\xed(\xec\xf3jM\xf3\x88k]\xe3\xc2\x92\x1e\x82\x12\xf9D\xc3\x0e\x0b\xbf\xe7\x0c\x06X\xd9\xf7S`^\x8a\xea%\x96\x13?\xf5\xdbV\x98\xf0\xc3#\x1dw\x90Tg\xaf\xb6\xc1\xb6KT\x87\xc1\x0c5\xf9\xb2\xdf\xc4\xba\x9bX\x0f\\\xef\xc8\xe0\xb8\xd8D?\x1b\xa6\xfbK\xd5\xdf\xa0\xe6\xe7\x09\x93(\x16t\xbdw\xc8=yu9A\x15R\x18i>\x8f\x99{9\xd7\x7fR\x87prV\xd5U\xdd\xe8\x19\xd5y3\xca\xcaz$\x13|\xc5\xdc\xee\x0ef\xeb\x19\x86|\x86\xb2tV\xd2\x9b\xc3\x82\xba$H.Uj\xdd\x89~\x90\x19\x02\xfd@\xcbQ\x08\xcc\x134\xd2l\xf2FSZ\xf0\x86Q~4GI\x88\xf32\xb8\x0e\x90\xac\xc4\x92X\xbf]6\x9f\xd8\x9d\xdfXs^?\xfb\x9d[\x81x\x15\xff\xd5Z\xa1e\xb3\x98^\xdf8\xdbsD\x8f+\xadc\x18&\xb7Z(#\xe5\xc2\xc55\xeah\x10\xfb\xd6\xfcXP\x9e\xc94\xcd5yT3t\x0c\x13\x81\x08\x90|\x84>\xf1\x9cJ\x88\x84D\xe1\x09\xb8>\x80\x1a>\xb4/\x95jD\xbco\x14_\x8e\x93e'\x11\xf8\xffqm\xc6\xd7S\xce\xa68J\xe6\xc9e\xb9B\x17\xea\xc1YP\x06?kDp\xaf\xc8s\x88=D\x00\xf9\xf0\xd01;dt\x05\x1f\xa9+jI\x84\x12K\x9f\xd99\x9a\xaa{\xa4\xcd\xdd\x98\x839\xe5`\x8a\xe6<S\xc2\xfaK\xc9\xc9?\xd8\xd4\x91n\xba\x98O\xa5\xcc\xbb\x10GFL\x8e\x83\xc7\x05\xc5\x81<\x85>)?\xa7\x9a\xd4\xfe\x12\xa6\xec\x0dz\xc0e\xba\x97:\xbd\xdaw\x1f\xdd\x19?\xc9\x0b\xc3\xa7,\xb0\xd4\x02%\xbej\xdf\xa0-\x1aX\x8f\x09X\x93U\xba\x9c\xc3\xc7\x93\xe2\xf0\x9d\x0eK\xb6\x11E\x99n\xb1x;/8\xa2\xfc>b\xa8\xae0\xcb\xde\x19-o_\xcfI\xf2\xcb%(\xebv\xbd\xaa \xdd\x96pA\x12s\xfb\xc8`\xed\xf9\x0c\xa2maz*]\xfa\x0e\xb5,\x09\xd0i\x04(\xd9B\x1fr\xa6\xa2\xc6\x93\xe0\x1b\x9f\xd7i\x1dAtV\x8f\x89\xabo\x19>\x1a\xa2\x121\xc1J\x94\xb8I\x1c\"n\xb8\x84w\x8eT\xd9R\x0e\xab\xa9\xd4\xc5T(\xf6\x10\xe57V\xb3GK\xdd\xb3\x9f\xfd\xed\xb5\xe51\x98\xea\xeaO\xea\x9f\x84\xd5\x13\xe3\xb84\xbc\x13\xc3\xbb\x91\x8dI\xf4zk?\x13\xef\x9c\xe1\x87I\xb4I9\x85F\xb76\xb8j\x0d \x85U\xed\xccK\x9b\xe6@Lf\xaf\xf6&\xb1\xa7\xcc \x12\x8a\xe9\x1e\xf9\xd6\xca\xc8\x8do\xf2\xb6Hp?\xf7\xaao\xbc\xe8\xc7\xaf\x11\xc1\x04\xba\x08j\x05\xa98\x0f\x7f9\xe3\x9f\xd9\x84\xde\xa6\xe2\xcf\xaa\xbf\xa97R\x9dO\x8d\x8b\xb5\xd5\x15\x88T$\xd1\x06\x9f\xad\xe5\x17\xf3_C\x9c4\xc85\xcd\xfe\xe9\x0agtLT\xfe\x9c\xc4vD\x9aC0\x04[\x09~|\xe07\x13\x9e\xd2\x05\xbd\x8fJ\x1d\x9d\x04m\xbd\xc5\xe6\xfd\xd5\x9eXI\\9\x1c\x8eGM\xcdU\x1f1!\x9c\xdcQ|\xcc\xd6\x9f$\xcd\xb5\x14\xbd[%\xb5\x96%\x03\xdd>\xf6\xf8b<\x14\x0b\x13qq\xe7{\xd0\x9c\x11B\xfe\xf0\xc3\xe4\xc2\x98W\xdbj\x90\xa7S\\fh\xeb\xb6\x86.\x8e\"a\x98C-^\x1a\xcb<\x94h\x81\xf9>\xadx\x0b\xef\x19\x03\x85R\xb0\xa0\xd2#R\xf5\xdc\xd9\x8d\x12\xc1>\x86&[1VK\xde.\xeb\xbb\x18\x14C\x03\xc5AJgw*\x0b\x08dg\xd2v\xc2\xc9\x81n\xf1\x08\xe7\x8fZ\xb5+\x19\x15j\x19\xec\x86<\x94Q\xfa\x1b8V|Q\x07\x10\x1d\xbb;\x9bX\x9c\xff\xc0\x01a\xd0\xdd\xb9\xbf\x8d+\xa4\x0az\x1a9\xc4G\x94(\xaa9\x8a\\C\x03>[\xd8\x1b\xd7\xde\xa5\xb64\x1a\xbb$\xb7\x81\xd0G3\xad\xde;0K$\xf7>\x1dF\x01\xf8'\x05\xbd\x93\xbe\xd2)&6i\x12\xce/\x1d\x04\xf4q\xc5\x816H\x06\x8an\x0dIu\x19~H\xb6ej\xeb\x04\x88\xbb@\xfcjS\x9b\xa1\xb1>\xb5\x97Ol\xda\xba>\xdd\x9b\x977\xcd\xf7,\xa58\xc5\xd7[\x12\xb3\xa5\x19\xbd\xae\xda\xfc\xf5\x8bEnr\x86yM\xea)2\xaer\x99b\x07Z\x0e\xe6I\xca\x19\xc6C'2\x8f\xd5\x9by\x0e\xe7\xe5\xdb\xa6\xc4\xe1\xba\xb7\xa9\xc0m\xbbEt\x16-^\x89l\xe9E\xa9K\x13\x1eq\x87\xe6\xe0\"\x8fx\x86\xf5\xdb!\xdb\x90\x18 \xca\x01\xfb\xe0.\"\xde\x8bz\x98U\x03\xf5\xc0\x82\xeb\x18\x1e?\xe3\xe7\xd1\xc0E\xec\x8c\x99R\x8d\x0a\x95O\xcc\xf7\xe1/s\x12\x86\x91\x81d\xae.d\xa6\x1e\xa4\x9b\xd5\x08eA\x97XQ\xb35\xaf^Md\x02\x92\\\x1b\xee\xf5\xd0\xc3\x9d\x9c_y\xf1\xa2t\x19\x15D\xeb5+\xefp\xc9\x95\xdf\x8fZ\xb5\x8f\x0b?#V\xe3V\x99\x83W\x95>'Z\xb46x/.\xfc\x06\xe7\x16J\xa8\xed\x94\x84\x98\xbfQ\xc2\xb1H\xcd\x80z\x0fj\xe1[\x08\xf6\xd1\xe40\x8d\xc0Q\x99\xd0d\x13\xf4e\xa6\xdd\x08\xad\xd2[\xcf\x97~,\x0eHxq\x8c\xcdS\xce=\xefP\xc5\xa6Ye\x12J\x82\xa7x\xcd\x1d\xfa\x11\x99\x9bG!I\xa2\xa8!\xb5\xce\x83\xa6R\x8e\xf2\xf2T\xa7\x03w\xd0\xfd=\xcc\xe6\xa0\x1a\xf0\xe5\xe1\xcepV&\x0dQ\xdca\x18%\x19\x9f\xc9e>)\xbe]\x8bs\x9e~K\xa0s\x99q\xfd\x1b\xcb\xb8\xfe\xeb\xff\xf6\xf7\x7f\xf9\xfb\xff\xe5\xd7\xff\xf1\xef\xff\xf9\xd7\xff\xd7\x16\xef\xfa\xbb\xc6\xfdR4\x00m\xd1\xee\xe1]Wr\x1d*K\xe0b\xe7\xab\xeb\xb3r\x9c\xd2\x09\xf7\xf7!\xa4\xa8\x8f3j\xf2&\xe2\xc0V\xe6\x129.\xd9\x98!\xae\xdc\xc0\xd5\xbd\xdddo\x9f\xc0\x04\xeb\x0cY\xff0\xb3/\x0a| \xa9\\\x9d\x89\x1d\xac!tb\xb1Rr\xe2\xfb\x94ytr\x1aVYm3\xc1\xbfc\xf9\xf3Ykfv\xfb\x9a\xef\x1b<\xae\xf1w?r\xca\x92D\x82\x10\xdc\x82Z\xc9\x14\x1f\xeb}=U\x97\x0c+\xeb\x96N\xe2\xcb\x15m\x13Y\xc5\x83\xd7U\xb5>b\xe6x\xc2N[\x9d\xb8\x1f\x0eZ\xe2C\x19\xffc\xe4l=\xf1,$+\xe1Ia\x92\x1b\x91\xcc1b\xe0\xbeHK\xee\xd5T\xc1\xc4\xbcN \x02r7(\xe7\x1e\x1b\x7f`\xb3F\x0c2\xb2\x08\xd9\x0b\x10\x16\xcb\xd9\x08\x88\xdd\xce\xf1Y? }\xb2\x86\xb83\x92$\xc1(\xed\xe16H\xb6\x9c/\xf1\xf7&&\x06\xd5\xc9\xc1\xdd\x0f9\xdb{\x1e \xe0\xcb\xcd\x9d\x7f\xa9\xe2i\x17[\xf8\x97\x99\x8b\x8b\x15\x15\xdb<\xe8\x1aEV\xcbJ\x96\xaf\x03[\xda\xd1\x82\x95\x0d/\xb2\x91\xb9\x8b?\x0be\x12\xf3\xc3\xbb\xda\x00\xd0\xdb\xaa\x85zH8\xf8\x92\xed\xed]\x1d\xab\xb5y\xd70~\x00q>+mo\x1bU\x8a\xc9\xfa$\xd2\xfa,\xd8\xa7\x95\xd3\xfc\x0cO\x8a?\xda}\xe4\x83\xa7m\x84\xf6\x8f\xb0[c03<\x08\xea\x88\xa7\x8c\xcfi\xe0c\xa7\x14\xce\xee14\xc9\x19\xe3`Z\x18\x0b-\xd3@LJ(\x0f\xe2\xa6\x1f\xe7\x02\x09\x81\xeab\x1fcz\xe9T\x83\x92G@\x86\xcf\xdc\x86;\xa7Z\xd8-'\xa1D\x08\xbft\x03\xd4n\x0cqW\x8e\x18*\x84\xfb\x98\x09O\xf2\x9e\x80\xe1\xe5{\xf1\x8b\x1b\xbbl_\x95\xda\xaa<\xf8 qC\x1c\x86\xec\x1aY\xd2\xd3\xd4\x8e\x95t5.\x91\x02ZY/\x14Q\x0el\x8c\xab(\xc5c\xf1\xad\xd4\xcc,\x00Vu(\xe1\x04\xe24Y\x09\"*\xc6L\xa1\xfb\xe8\xde\x87\xf55q}\x13\xc6\xf6\xfe\xa7/\xdf\x87[\xdf\xc1/k\x84\xd7\xcf\x16\x18\xe9'\x16\xffZ<\xd5\xb53\xbc\xf4L(\x87\xb8\xa6\xf0\xdc\x1dt\xa33`\xdb\xb6j1\x85\xa2+kLX\x19\xac\x8d\x05,I}\x06/4\x87!\x8c4\x7f?\x9e\xa6\xd7\x9a\xd7rH\xd9F\xa6|\xb8R\x1e\xbe\x83\xda}\xd2\xa3\x19\x0f\x08>\xce\xa5\xba \xda\xe1\xd3\x00)\x90\x08\x7f8\x9b\x9f\xa3%\x7f\xb4\xf6\xbf=N15\xd8\x1e3\x15\xf1\xa9H=\x05\xc1g\x10{\xb4\x16/\x88\x9d\xecH\xdd=]\x16_\x9e+\xf7\xfc\xc1\xdf\xb2\xa8x\xcc\xe2I\xed\xa9Y\xc4\xe4%{w\xf1\xcc\x90V{J\xe0 X*2\xa7q\x18T\xadsTD+.\xc1\x9a\xea\x85\x0a\xc4\x0c\xa4\xa5z\x8b\xf5B\x9dH\x08\x1f\xe3\xb5\x91\x83\x15_\x8ei\xea}{\x0fW\xd6\x7f\x15\x17*\x06\xd0Dy\x12I\xbbSV\xfd3\xfd\xacE9\x1c\xa1\xc4\xd5\x05\x93%\xbc\xc4\xec`\"\xcf\x12\xe0\xdf]z\xb8\xb9\xf6\xa4\xb0\xb4)!\x18\xf5F\\4\x83\x83h\xf0u1\xff;\x95\x1a\xc6\xa4|\xf1\xd7%\xfe\xa0,\x06\xdfz[\xa4\xb6\x03K\x1e\x19\xc7\x93\xe9\x9bJx\xb1\xb7\x9c\xe00\x12\xbd\x83\xfe\x86R\x93B4\x17\xb5\x11q\x8a\x17\xd3@\xd5\xeae\xd9P\x85\xf6\xee-\xb1%G\x96\xc5\xf8`,z\xeb:ZF\xf9g\x8aO\x18\xac\xb1L_i\xf3\xf7N,JF?\xe4m\xfd\xc7E\xb7zB\x10\xb1\x83\x02\xfd}\xc2LxH\xb4\xf1\x0d\xd3\x0f\xcfT\xafa\xa5\xa4\x169\x14iN\x85c%\x92\x8b\x96)q\x1f\xfb\x86\x93m343I\xb5\xfd\x01\xae\xd0\x82h\x92\xaa\x89I0\xa23}m3\xc3>\xfd&\x83\x94\xafk\xb6\x16yF\xd8B\xf3\"\xa9\x04i\x15U}\xeb\x1b\xc0\xe8\xae\xa1\x8e\xc5\xc5\x0eCX\xa4\xb1@]q\x9c\x1bcs\xd3\xa5\xa5J\x07\xd5\xc8\xdbA\xdb=\x8dr~\xcb\x14\xae\x14,\xb6\xb1u\xee\xc5\xf8\xc6)]\xc7o}\xe9\x1cTE\x0e \x86\xcb\x1a\xb8\xce;\x81\xe4\xf8y\x1885\x92*@\xee\xa0\xdc\xd6\x9b^\xd1\x86\x9c\x97\x7f\x8duH<Q%4*\xea:\x1bg\xdd~\x87\xb9\x96\x12ko(\x8b#\xe2Z\xf3%1\x82\xa13\xc1E\x9d3\xb6}\xb8x2S\xca\xcdd\x14b\xae4l1\xae+}xe\xda\xaf(\xbak\x97\xb9\x9d\xec\xdb3\xa8\xf4_\x8c0Y\x1e\xae\xe5\xfbe\xb1\x11\xc4Z\x8a!\x8fv\x02\x08y:\xa3\xd5>\xf8d\xfb\xe6\xd0\x0f\xb5\x18\xb6\x0d\xcb\"\x95\xee\xb6\x97#:\xa9\xbda\xe43n\x83q\xb7\xe4\xf6\x98\x86\xf6\xbe\xf5\x1dq\xff\xc0\x08>\xd2!\xa9\xf8\x92x\xa4\x11g&F\x82\xd0\xb7\x15p\xcf'\xc5\x82f\xb35Lg\xf5\x9bU\x15S\xfc\x88\xdf\xa4\xde\xfc\xec\xe5\x8c\xed/M1\xcf\xf7L{\xea\x99\xbf7v\xcd$Dd\xd7\x97;jk`\xdb\x0f\xeeV\x0c\xc0SQ\x8c@\xbcbV\xe7\x15\xc9tlIJb\xda\xdaz-\x8d*pF\xd4\xfcv\xb0\xa66t\xf6'>\x8d\xf8|\x96N\xe2\xb2\xa9$\xed\xd4z\xe6:\xa6\"\x97\xd3t:\x9aT}\x98\x8d\xe15e\x12\xde\xfdJ\x1f\xa5(\xfb?\xfb\xf1\x96r\xf9\xa9\\\x92\xc6r\x8c\x13\xb7\xd6\x97P{u\x9e\xef\xae+\xe1f\xf8Peq4:\xbcG\xe5Q<\x08\xba\xf6\xf8f\xe2\x8c\xe6z3}\x0d\x03\x98\x00\xe5\xb7\xee\x81\x12\x7f;\xa8\x9ec\xb5]\xe7\xaf\x11\xb8WB\x9c\xae\xdf\x0d\x98\xdd\x80\xae\xf8+\xfc\xe1?\xfa\xcbijiK\x03\x09\x9d\x02U\xa7s\xaa\x163F\xbc\xb1-\xb0\x0dv\x8f\x1a\xc5\x13\x0d\xa70\x12\xdfL\xfd\xaf\x12\xa3%\x11\xd5\x96M\xe3\x85\xdc\xd1z\xb0\xe7\xd9\xd7\x0b\xf6=\x9b\xf58 d\xea\xde\x17\x0c\xfb5!\x18\x1b\xcd\x8a\x92JR\x97:\x86\xe0\xbbJR\x19\xc2\xbf\xb0\x07\xf2Y\x0c\x81\xc4W\x0a\xce9)\x86\xe4p/S\xa0\xb3\x83C\x99s2\xc3\xe4\xd1\x91\xc4\xcfR\xbf\xabR\xc9\x89MI?\x8d\xd2\x85\x05\x03\xd7\x01Z3h\x88\x9f\xe9+nM\x8aO\xb7;C\xd4\x0a\x8cf\xeb\xcc\xee\x90p\x84\x186\x1b\xb3\x8b[\x17h\xad\x9cd\x9b\xa8#2\x1bK\xef/\x88\x85P\xfcL\x84B\x0eF\x0b\xb7%h%eI\x08\xa4\x02\xbe]\x80\"\xee\xe0Oy\xfetN\x9f#\xec\xeb2\xa9\x0ew\x8aQ6\xe0j\x18\x8f\x19\xbd\x0eO\xe8\xa8\x9f\x0e\x17\xe8\x8b\x90\xedw}4\x14\xa6\xed>\x13\xe9\x13\xa4\xc0\x0d\xba\x8f\xa8\xb7\x1e\xc9\xacD|\xe7\xa7O\xe5\xa7\xf6H\xc4l\x99@M,i]\x8d\x17l5\xc7\xb3\x0c\xf6\xb6\xba\x12[\xd9)(iN\xf3\x0d\x8a\x1fm\xab\xcd\xc6r'1Kc\xddB\x99\\\xfd\x09\xc7/\xe8\xd6g\xfe\xd6&\x8b&\x0b-e\xd4t\x18\x98?\xcb?\xc80\xa6\x9c0i\x94\xd2\xbe\xe3`\x82\xda^\x90\x1d\xc6\x80\xcf\xa1t\x94a\x0e\x1b\xcb\x0ef_;\xaax\xe4\xd0\xe0:\xaf\x8d\xb1H\xec\x85mzb\x15\xd8\x1e\x06(\x06?\xa0Q\x8f!\xedC]\xd1U\xf3\x82\xbf\x03\x1d-\xfa\xe9\x08\x86$\xd6\xd9\xd1\x88\xe3\xb3e\x9dIE\xcf\xb2\x13\xcc\xd0O\x1b\xe0\x81\x98\xf5\xf8pO\x8a\xe5\xd1m\xba\x8a\x8d\xa5\x99\xbej\xfd)\xeb\xab\xd6S\x1b\xfc\xe6\xae\xda\x1a\xf7/\x16P\xfd\xe6\xd1\x05\xa3\x81+#\xee\x15\xec\xc1\xe02\xa4sU\x14\x9d\xa4\x8bb{\xe2\xe2\x84\xc4\xd0;\x08\x88\x17B\x94\xf4n\x92\xe3\xd3Bk\x18Y}\xdej\xa2-\x1a\xd7PC\xb3\x83\xd1\x177\xee\x99\xc8\xe81\x0a\xa4\xa6d{'\x95:\xe5\x97f\x8d\x8b\xa4\xfa!\x97\xdb\x12n\xd7a6\x8cw*0G]\x8b\xce3\x83\xd8\xd0zF{\xc5\xc2_\x14\xe6^W\x8d\x93M3\x8amO\xf6\x87\xfd\xdc\xbd&^k\"F\xf1\xfdl$Z\x02\xba\xd7\xb0M+&mx\x8c\xf5\x02\x04%\x95\xeb\xa1\xdb\x89\x9f\xdaAWBev\xd7\xb9}_\xc6\xf7\x06\xde;e\xd9\x15\x0a5\xf0m\xf1\x87wP\xe2\x93\xfc\x1cR\x17%\xdb\xfc\x09\x02\xde\x0c\xa0\x9a\xab h[\xd2\x05\x82C,\x87\xbbw!x\x1c>\x8f\xc5\xfc\x13R\xa6\x8ce-\xa7\xd2\xeb\xa8\xc1S*=\x80\xdarg\xfd\x97\xael\x912@\xa1Wq\xe9\xea\x1dgn\x0e\x14|\x10\xecJ\xbd\x1aJ\x0fN\x04\xdd\x1eW\xea\x07hO\xfb\xc8u\xb9E\x11\xdd{\x9a\x8am?)&3\x98\x9av\x96\x9e\x8f(\xe8RL%\x95E\x9d\xf1\x13\xcdf\x8c\"Bw\x8e\xff4\xb3\xfdI\xf1.\xbc(\xc3\xed\xe2\xa2\x83\xc5F\x03\x01['\xe1\xb5\x84TsI\x09\xdf\xa2S\xcc0\x08DU{Y|Sr\xbbC\x8c\x8c0c\xc7\xe0\xea!\xa1l\x0c\x99\xa5\x98p\x99dY4\xfa\xc0\x8b\"[\x85F\x93\xd7?`|\x14\xf7\xd1\xde\xd5\xae\xd1\xea\xd7\xf9\x0b\xc9\xa4\x1d|\xf8\x95\xd4\x1e\x15&s\x0d\xfbe\x7f\xda\xb8D\x02\x89O\x81\xef\xbbN\x1d\xc3\x9d\xc2\xf6\xa8lUB\xef\x96G\xdf\xa2\xd6\xa2\x88\xb1\xca\x04\x81\xc9\x18\xd0\"\xcfL\xba5\xcb\xca\xed`7\xfe\xb1\xeaC\x82\xa8\x85\xb9P\x1a^\x1dE\x954^w\xd6\x7f\xcdFW\xa4\xda\xa3\x81\x0b\x9c\xc3GD\xb5z\x18\xa9\x82`f\x12\xe1\xdf\x13i\xa7\xa6j\xe3U\xe9\xe7xMB\xbc\x1b3|#\x81\xf3\xe2\xd9\xac!0\x81\x9b\xa5|/1\xf9\xe7\xd3\xc0\xa4\x00\xa0~{T!\xe5D\xbe\xc7>8\x9b\x7f\xa3\xbdA\xe3/\xb4\x1d\x9bw\x14\x0b\x7f\x13g\xb9\xb6\xb0P\xbe\xc4\xba\x90\xb9\x10\\\xd3\xfa\x1d\x04\xc6\xd3(\xbc\x09\xecR\xf0\x97\xd6\xb5\x1b\xd4\xfb\xa2\x88\x0b\x91" +
+	"CQLX\x88\x1c\x12e\xea\x0e\x9e\xe2o\xe1#\xcb\x0feeF\x9f\xe1\xf6\xe8S\x14.\x8a\xdb\xc4\xe0\x8ba,,9\xe8\xcd\x96\xc9p\x83f\xc2\x9fO\x7f\x94\x080\xa9\xcd\xf3\xae9\xdd\xe4\x8e\x14\xb1\x08\xd7e\xa7\x7ff\xf4sdPC\xd4\x07\xba\xc51\xcb_\"\x86\xfaPW~\xc4\xe1\xa1\x81\\w\x81\x12\xc63}\x99\xcc\xbdU\x95\x9a\x03\x91\xecC\"\xa5\xdc\xc7\xd3\xe2\xc3\xb2\xba\xa8.\xd4\xbb[\xe3\xa8Q-\xac\x9f~\x0b\xc5\x9a0Qo\x8b.\xc1\x0c\xf9\x1c\xfa\xb6\x93\x05VR\xa8y\xc6i\x8avUl5\xb2\x9f\x9e\xf1\xf3\xa8;C\x8a\xce\x8elz\xf3\xbf\x87\\j\xa4\xc6!C\x8b\x09&d\xe9\x03)\xaf\xc0#\xf2\xac\x0dQ\x87\xf5p\xdbS\xe6\x9a\xb2%\xeb<B\x7f\x0c\xe3m)!\xa7<\xe2\xdc\x98\xb6\xa2\xea\xd3\xba\x8cz\x1aa\x9cPg*\xfbz\xc6\x98i(\x83\xf5no\xe6\xf7\xc4\xc3\x14\xbf\xf3}U\x87\x17\xd1\xc5br\xd5\xac\x10lRU\x14\x13;\xb5\xc0 \\\xf7\x05\xa5\x01/\xbe\xde*\x88@\xda2[ \x1a\xdfS\x91\x86\xd8Dw\xb6\x11\xcdE\x0et\x95#\xfa\x98\x8d\x1b\x0as\xb8\x8c\xf5Q\xfc\x9b.\x8c\xa37\x05t%\xb8\x9a\x84\xaf\x01\x93d\xc9\x83p\x1c1\\\xed\x98\x86s{ \xdd\xb4\xf9\xfa\xabC\xbbf\xd3}\x13\xb7F\xbc\x18\x1ahs\xfc\xa2\xb1\xbd\x8d7Z:\x8bC\x9b)\xa5\x0c\xdd\x8aU\x94O\x8a\x84on\xad?\x99\x1bna\x13\xdb\xa0\xb2\xfdj)9h\x86\xdd\x02/\xb6\x02\xe1\xb3\x89\x85k\xf4D\xd0&\xa2\x00\x03[\x86\x08\xddN\x96\xa5\x14\xf15\xb9\x0ao\x8d\xb42X\xfc\xe2\xcc>\x11\xeb\xa7\x85\x8fa\x86\x9f}wA\xd0\xcb\xa73\xb8\x04j\x01\xd4\x86L\xa8\x93Lmu\x1a;\xd8\xb3\x04I\xb9\x01e$\x84\x0b0Ym\xde\xa6{,\x83R\xe3\x15\x12\x0bb\xae\xb2\xa8\xcbQK_\x9c\x7f|\xb9\xc2\xe6\xf2\xdf\x84\x06\xb1\xb5\x9c\xa2\x87^\x0f\xb2\xd4\xb1\x86$\xfd\x0f\x0e\xf7\x14\x0biI\xfe\x8c-\xc5\xb9t\xea\xed\xc933\x91\xb8\xb4\x15\xbd\x07\x8c\xec\xf8\xd0\xcc\xc9P\xaf\xd1\x0d[(\xe9\xee\xfd\xd5\xed\xa44\x8e\xbe\xbc\xeb\xac\x80\"\x93\xac\x0c\xd5\xb5\xea\xc1\xce\x17\xa2 \xfb\x80\xe6\xb9\xd1\xbf\xc0\x90\x99\x91\x98\x14\xdbL\x8a\x1d\xf2\xe4\x94\xf1\xa8\xc3\x15s\xd2\xde\x92\xef4\xc9N3\xcf\xec\xb2@\xd5\xe8.p\x0e\x1f\xf3%0\x98\xb2\x09\xf3z\x8d\xddkBt\xfc\xa5\xcd3\x99\xd7\x7f\x82\x1bt\x1d\xb4Y&\xacqw?\xba\x05\x80\xc3i\x9b\xa88\x82-c\x0c\xcb\x8f\xfeN)\xb2\x18\xf0\xb4\x1a\x1d\xa5\xc0\x9bC\xac\"t]\x9d\x84\x91U@\x8dw\xa9\x84\x17O&\xcb_`\x00\xc7Z\xabZ\x1eZ\"\xb7\xce\x1a\x16~7\xcbp\x88/\x9a\xbah\xe5\xc11dj\x16$\xe0[\x17\x0ei<\x0cZF\"A\xc9w(\x8c\xfd\x00;8~ciZ\xacL\xf9\xac\x00\xdb\x9ey%Z\x18C\xd1\xa7@\x02\x14WfD\x92\xbe\x80f+%\xb1\xfd\xa22\x80\xf3\x0c\x7f\\\xb2\x95DF\x9a\xf6\x9e\x904\x1a\xc1\xd0s\xa2:\xce\\m\xb2\xe3#\xe1\xe7)\xee\xe3\xd0\xb8\x1e\x03\x8e\xe0\xce\xf4U\x94<\x8e(\xe8#%\x0754z\x15\xb4\xf3X\x9aw\xc0\x0a\x913\xc7\x0bu\xe4,\x07\xbc[hN\xdb4\xb7\x07t\xf7\x8ez\x1a\xf2\"D \xe9g\xa8\xc3\x03q\x07\xc9~\xbe\x93 6\xe1\x15\xc6*\xc51+C\xb7B\x0b\xf0\x99e\xbe}\x1f\xe2\xed\x04\xc6L\x0a\x95p~\x9d\x8d\xb9\xcbx1\x8b\xea\x14\x1e\x00#gk\xa2\x11\xdf\xff\xc62\xac\xe5\xda\x00\x93'\x162\xe6d\xde\xab\xa1\x93\x08$\x98\x88\xf0$r\xc0vlWr-\x0a\x8c\x80\xcb\x14\x1d\xd1(}U\xf1s\xd5E\"F\xc9\xd8$R{\xd3Z\x91K5\xf2s\x88\xef\xc1=P:\xf2;\xa3~\x15\x05\x96c\xa2{\x85Y\xf0Y\x1e\x18\xd9$s\xc8\xa0|\xc6'\xb2k\xd7\x0f\x8f\xa0\xfd\xc7\xd9\xb6\xa8\"\xc6$\x0fR\xed\xbf\x0ca\xfc\xde\xa4[\xf7A\x18_=\xdf\xd1\xdf\xccs\xb9\xbf\xc7u\x9f\x13\xd6r\xeezN\xa4tWDO\x99\x98@8\x90\xe8\xc4\x89I\x96\x88\xafat\xc6\xa4\xfe\xc2A0g\x81G\xda\xc4\x88j\x95\xe3\xc7-\xaaq\x8a\xab\xad\x93j\xbfz1/\x105\x03\x17\x8bq\x910\xeb\xa1\xb8\xfa\x9e\x05\x84Zp\xdd\xb9\xc5\xea\xe9\x19\xd3_I0\x1a+\xf6L\x0e[\xbc\xd1\xe9\x8bc\xa3\xaf^\xe6<\xd0V\xd9\xa0\x03W\x175\xdc\xa11\x97,?Abt\x1a9.\xdfw\x1cJ\x18\xc1hP\x85q\xfeF\x88\xdf(o>\x03M\x94[D=\xec\x12\x10\xba\xa4f\x9b\xcei\xc14O\x8a\x019M\xb4M\x13\x9b\xc9\x91\xa8\x1b\xca\\%\x13:\xf8\xd9\x18I\x99iY\x1d\xb0\x1b\x8eS\x9a\xf8\x83\xe0\x07\xd1x\xc3\xb0\xcc\x87\xaa\x81ZN\x8c\x8c\x124\x8d\x0f\xacZ\xa8\x81\xc4\xf5\xe1\xf7\xfa\x8b \xe6\xa79e\x9c\xd4\xad\x93\xb5\xfb\xc0 \x15I\x0fF\xb5\x00+\x16 \xb64\xd7\xd6\x0dU\xedF\x1a\x98=\xb4\xf7O\xf5\xb9\xad\xe8\x1fZ$C\xfe\xf6A\xa6<\x05\x13\x94+\xf0h$\xe3\xdcm\xc9\x0b\xba\xcd\xbaA\x96k\xe6\xed>\x12\xdc9i\x1f\x8e=&\xd2>\x93\xd4\x88V'a\xa1\xe80\x02t\x11x\xfc\xaeQ|\xae\xcd.+\xbd\xa1L\x84\x84\xef0q^\xc2\"\x18k\x02-myt\x19\x04\xa5\xc3\x96\xda\xbc\xb1\x84\x83\xee\xed>\xda\xdc9K\x0bK\xad9\x01q\x10V\xcd\xba\xea]yC\x02=\xd2\x1c\x9b/9\x1d\x85\x132\x9b:\xb8\xf9\x9e\xf1\x13\x0f\x9cY\xf4\xa6U\x89\xc2D\xb5HXw\x01Wo\x939<\xff*!s\xf8\xaf\x7f\xff\xcf\x7f\xffO\xbbh\x1c\xfe\x0c\xd0\x16\xdf\xef\xa1q\xf8\x99]\\\xcc\xd5\xd1&\xde]\x07(\xfe\xe2[\xf8\xecN\x9b\x0c\x0bQ\xc5W\xa5,\xab\x9aY\xa351E\x93\xc7\xdc\x04GC\x14x\xde$<\xe0d\xa6\xc88R\x81\x1b\x95K|\xab;%\xda\xa3\xc1d5\xea\x7f\x826\x0d\xf7?\xc4U\x14\xba+\xa7\x1d\x04\x0c}0\xba\xc2I\x13\x1c\x85\x9a\x9cau\xb3\xd1\x0aq\xe96\x89\x10>\x88\x18}\xac\xd2\x95(\x9a\x03\xa5\x1c[\xb1\xcf\x12V\x83i^\xef\xc7\xaa{D\\\xf86\x91\xc1\xe1'*+>Z\x8d\x04\xb3WG\x8d\xaf'\x09i\x97\xf4\x1b:\x8c\xac\xbc@E 3&\x0a\xaf\xb4\xbb\xe5P^g1\x8b\x88\x1c\xa5\x13%mts\xbd\xf0\x89\x084\xc0Z0o\xf2\xb0\x09\x97o\x1e\xf4\xb8\xa3\x9a*\x92\xbc\x82\x06\xdc>\x0a\x01\xd9\x16\xf2\x9d\xcb\xd5\xae\xa7\x14\xb8\xde-\xecO\x80\xc5\xa9\xba9\xbd\xcd\xb9-\xd7\xfc\x8f\x0c\x93(H\xffH9\x90%\xaf\x0e\xa3\x7f@\xfe\xfd\x8c\x85\xc4\x04\xb5J\x17\x9e\xcf\xd7\xb3(\x11\xd3w\xd3\x12y\xbb\xc2\xf1f&\x00\xe8\xb4Le\xd3;\xddQj\xa6\xe2\xadw\xae\xd0\xca\x01U\\\xa84[\x91\xc6V\x89p\x85\x94U\xefF8N\x09\x08S\xaa\x97\xb7\xc9oya\xa6RmNk\xac\xa9\xb2A\xf2\x97\x9f\x1adM\x09\xc8J4C\x8e\xca\x9a\xdfB\xdd\"\xb864uB\xd29\xa7\x08\xa6\xb6\x05\x9fD\xdd\xb5\x82\xe31\x12\xb1Y<q\x06\xd4\x93\xa0w\xd0\xdaY\x02|\x08\x8e\x87L\x8d,\xc6\xb9\x82\xf9\x98=\x0f'\xc2\x16\xec#r5H\x84\xea\xb4(\x8f=Oy\xc3\x1dw \xa8\xb6\xa5\x11\xf1\xbd\xafO\x19<\xd2\xda=suX\xba\x91\x09\x170\xb2\\~\xaa\xcf\xe1cm\x9c?}\xba\x0cTX*\x17\xcc\x0a$#u\xdb\xc1z\xa6G\x9a\xad\xf8\xa3\x91\x9b\xd9\"\x90\xdaGh\x10#\xb2ch\xda\x92\xd0\x1aL\xe8\xbe\xc8\x0c\x1b\xf6~\x87\xdbt\xaf\xb5i\xbb)\x01f5\x1a8\xac4<\xc2/\xf0\x07\xb1\x1a-\xe4.\xaf\xac\xb2\xeeN\x82+\xb3\x9eSv\xf0\x1a\x81\xd1\xd3\x17\xbb\x9bE\x0c\x09#\xc9\x11{8\x98\x0f\xb8\xb3\x9f\xe9k\xad\xda\xf8\xe5\x9c(\xc7\x97\xb1\xaap\x88\xc9H\xaa\xa3\xfd4B\x8b4\\\xa4\x80\x14M\x9a\x1de\xf2K\xcfB\xc9J~\x92\x999D\x00s\x9ba\xebG\x0a.\xca\xc1\x98y\xaeZ\xef\x0c\xac\x16\xc6\xf4\x0dp}\xb1\x0a\xd6\x14\xd8u\xf5\x00\xe4\xfb\xa1D\xd2\x8e\xba\xf8\xef)\xf2\xd6\x8f0\\\xdc\xad\xc9R\x99\x85\x10\xdf\xe7\x8a\xd9\xf7j\x14\xde}\xb3\x9bf\x07\xd1\x1f\x07\x7f\xf1\xb7\xd3v)\xf9\xa2\xfc\x05\x8bfLSo\xf2\xef\xe9\xcd\x19\x0e\x9d>\xe3\xd2\xbb\xd0\x1f\xff\x83\xbf\xf9~O)\xb8$\x07\x1bO\x95s\xe4\x05\x92\xd8\x15\x88\xd5\xdf\xc2\xe8\xe2pL\x0e\xe5\x9fz\x97\x9e\xd9\xa5a\xb6\xb9\xb8\x9aX'\x04\xd4\xe3\x80RZN,wbx\xa8\xbf\xf8\xfe\xb8\xa3\x0a<%\x00\x9a\xc5y\x85n\xe1\xdaDn\x02]\xb7iM\x0d7\xc4\xdd\xdd,\xd71\xfc\x83\xc7\x1c\xd8\xb6;\xaa\x01%\xd3\xb7&6\xf9\xe2\xe2\xa0\xdeQ\xbd\xfd\x8f=\xaf\xc8UP-\xc3\x99\xbe\x18\xb1\\unh\xbd\xec\xc7\xa9\x82\xc5\xc7\xaa+\xab^J\x9f\xb9\xfec\xac\xba\xb1\xeaU\x9dC+\x00K\xc7V,\xc7\x90%\xbd\\\xe0YT\xb0\x1a\x8e\xa3\xa2\xd5\xdf\xa9\x9e\x97\x11\xe2\xa6\x02\xeb\x17W\xbb~gq\xf9\xfe\x16]\x85\x17\xb2\xf8\x17\xb8\xee\x1c>\x80\x98\xf7\x07Ix|\x86\xbe\x83\xa2\xf6\x8d\x1b[\xbf\xfe\xde_\xf4\xda\xf3v\xd0\xbas\xf5\xec\xf9o{\xff\xd1\x95\xbeo}\\=2\xe5\x93\xb8\xe8\xf8\x01n\xb9\xa2\xc9\xac\x9e\xc9\xbf\xce\x08Pn\xbfU\x8a\x81\x17_\xd8\x0a'\x9eR\xc1\xeaC\x05\xb3\x04GR\xbbFA\xaf\x07,\x12\xbc \xd5>)|\xd7~T%\xa3\x1e\xee\x82\xad\xe69*y\x08\x02\x8c\x9bL\xc4i&\x15a\xd1\xac\x92\x8f\x98,\x99\xff:\xad\x1a\xde\xfc\xea\x89v\x18jh\xd6L\xdeg/\xbf\xb0yy\x90\x05+\x92\xf9\x10\x17 \xbc\xaeG\xfc(\x8e\x1e\xcd\\K\x1e\x80\xbb\x08\xba\xeb\xd55\x968\xa2\xb8\xf3\xdf\xc8`\xf4\xa40mT\xe7\xb48%t&n);\xf7\x93\x94\xa8X\x04`\xe2\x86\xeeR\x986\xef\xbfs\x98\x9a\x9f\xd0{~\x84)\x18s\xc4\xec\xe3\xce\xaao\x8e\x0a\xc9T\xf8W\xf04N\xd3\xad\x9b\x7f\x99\xb8\xcb\x86\x9e%\xc6\xadR\x8b\xc7\xfd\"\x09\x8cDdm\xae\x8d\xde\xc8\xb4\xf9\x1d*\xe5\xbfN\x8d\x1bB\xfc[<;M\xe6\xb8\xcf\x93 \xb5\xc9~S\xc0\"\x09\xc9P\x9a4,\x7fJY\xe3Z\xd7\xb8\x9ae\x0fR\x0c\xe5\x89%\x92\x84\xd8\x80qd$[W;\xc9\xb9odw\x947\x89\x86\xbb\x91.Mt\x94\x0f7\xa8\xd3I|4\x04K'[S\x99\xc6\x132\x19\xcc\xec\xa0\xb4U\xde\x16\xefy\x8d\xd1\x87}\xa8\x1a\xa2g\x89\xee\xc0t\xc7^\xad\x06\x8c\x95\xed,\xb4<\x0f\xb2<\x19&\xcc\x1b|F\x99\xf4!\xcc\xc6b\x84\xee\xceb-\x86[\x93[-M\xa7h\xa1eH\x03$T\xe7\x82\x02S\x05Q\xca\xd9\xa4\xb0\xdfT\xbfQy\xf16\x99*\x9e\xa7\xb5J\x98\x92aT\xffgx\x80[\xab\x9c\xa1M\x08mK\xe3\x1b\xc6\xa77\xc5\x01\xfd#\xce\xe2\x0c\xc7@!\xd9+\x060%\xb7\xd75ALR\xda\x85F9\x07\xcb\x9d\x7f6\x1e\xb8\xbf\xc0M\\_{\xdd\xa0\xcf\x84&\xf9\x91\x9f\x85\xab\xb5z?\xbc\xf9\x82@\xf0i\xbb4^\xa5\xf4i\x9d\x89\xc2\x94f\x99\\\xa6[c\x8fsR,1'Q\xb4\x9f\xa9\x9c0\x8c\xdbu\xecT\xc11w\xb9\xeac\x10&\xc7n\xa1\xf6\x9b\x16A\x0bzS\xd3\x1e\x1a;0\xfc\xe3\xf271\xd0I\xa5j\xa2K\xa0n\xa2=~\xb5\x80\xf3y\x16\xa2\xa5l;wc\x98\x0c\xdd\xe8o\xccb\x81\x90\xf1\x0b\xae}\xa7LAVc\xc1\xc5\x8e~4\x1b\x92\xdc\x11O7\xc0\xb5t\x85\"\"f\xe1\xb8\x14\xc0\xaddC\xe9\x92\xd1+K\xadQ}\xce\x19\x12\xfa\xe0\xd7lWk\x0f\xd5\xcdak\xcf\x04\x99\xf0a-\x00x+G\xcfA\xd8<#oX\xeekh\x1cm\xda\x0d\xd4\xc6\x13^K\xc7\xafA\xea\x9f\xbf\xb4|\xe2\x8c\xe2W\x95\xfe\x09f:\x02\xe3\xa4\x00\x94\xad\xd4\xf8\x07)6\xd2T\xea\xc1\xa4\xcas\xda\xe6$\x9a\x1a\xdb\xdf\xa6\x10\xde\xee+\x13\x97\xb1\x82\xcf-\x03E\x0a\x1fR\x0e3\x1e\xc0\xa7\xa2s\xc3\xc7X\xbb(\x9c\x19rw>\x09W\xc3d\xfc\xa7E\xd6t\x87\xd6\x0f\xee\x12\x82\xcd4\x03\x8e\xb6\x94\xb4\xae\x06hp\x05M\xb6\x92\x94\x05\x86m\x83\x9c\xa9t\x82&\xe1D\xe4vp\xf5\xf9\xeb/\xa5\xf2\x0b0\x8b\xd9\x9a\xc3/\xc7\x8fm\xa1\xc4\x0e\xd1\xf68\xfeN\x0e\xcb!\xab\xcf;\xce\x18\x1a\xab\x98\xfaX\xae.\x93\xd0e\xac4U\x0f\x17\x18\xb3$7v\xe1\x0dj\x0a\x83$\xd5OJS\xf0\x0b\xd4\xae/Z7\xd4\xae\x8b\")\xe7\x89TJLs)\xd04g?_t\xfcS\x9c\x83\x98\x05)\x0d\xdcL\xe9\xf9\x86\x02\xf3\xf3or\xec\x1d\xce\xa70 \x0f#\xb4h\x18\x1c3u\xd6Z\x80F\xa5\xe3@k8N#\xe7\xa3\x99\x81h\x83\xe1\xd5\xac\x9axgN\x88\xbc\x9f:\x0bgn\x17^\xe4\x0e\xc5\xdf\xa0\x86\xd1\xfc\xdcB=\xf8\xb26|#\xddG\xb8\xc3\xa4\x9d\xf8A\x0e\x09<C\xfa\xf2\xb8R\x9f\xd0\xb80\x0c\xaf\xd0Q\x9a\x92\xd9!V\xf1O\xb10}\x02\xa0M\xe3\x8b\x89g\x86I\x90\xd6\xf3\x04L\x7f\xa2\x09\x9c\x9d\x10\xbd3\"O\x10Z\xcdw%.\x04\xd1'\xb7Dt\xd9\xfa\x1b\x19\\\xa3\x80\x996\x8f\x8c\x85\x18\xd7\xb5\xf3\xf5m\xd4\xd1\xb6\x1c\xad_$\xa4\xbd\xa3\"\x9c\x90+\x1d\xb4w\xa8S\x81,D\xe3\x89\xf7\xd8\x80\x1f\x93\x15Y\x11\x18\xb4C\xea\xea\x8b\xe3\x92C\xa0\xba\xfc\xcd\x9b\x1d\xb9P/o\xbb\xc7bJ\x1aaQw\xdc\x13\xa1K\xbe\x00\xd3\xfbb\xa5\xde\xe7]\xc9\x7fX\xcd\x92/Q\x98F\xdd\xb09&\x1b\x92\xb2\x0a\xa1\x96\x08\x090v\x93\xf1\xf77\xcd\xe5\xc3.E\xb5Xl\xfe\xcfaW\x8c\x12\xf7\xa7Y\xb7(\xf1\x84\xa2\xf7Q\x1c\xbe\x1bP%=B\x80H3L\xe1,\xbcm\xdft\xf0ZV\xd1\xd2\x8d\x9f\x1a\xa8-\xb7Q\x98\xd2\xff\x94\x0a\xf0\xc7\xc4T\x7fw\x03\xdc\xce\xf8\xb9\xa3^}\"\x84B\x094&\x9a\xd0\xcd\xdf.\x0c\xc8\xd6\xde]\xe3 Sp\xe6\xbaVZ\"\xdaAw\x88\xb2#\x99\xb1\x91fJ\x1f\x1d6v\xcaj\x18\xfe\x8e\x0f\x13\xe2\x07\xa7\xedBwV\xe7R\xb9\xbe\xde\x89&\xe4\x06#\xfeTqd+z\xfc\xde:\x96\x0bJ\xb1d\x97\xef\xac\x1e_\x8dZR\xd0R\xdd\xfe\xdbgz\x00\x12\xd8q-\xee\x80\xdd\xd5u*\xdbp\x17\xf0\x05\xa2P\xa2\xa9i\xb6\xa6t\x99i}\xe6\xaaD%\xf2T;\xfc\x0eM\\\x99\x1bx\x84^\x96oa\xa0\xd4s8\xcai\x13<*$\xf61k\xde7\x14\xbb;\xe3g\x97\xe9\x8b\xef\xac\x05\xffi\x8b\xe0>\xe1\xc4V\x17\x97z\x8c ,\x13\xfa{\xf1\x90S\xe8i\x8eq\x99\xe7\xaa\xa1\xfd\x1d\x0bF\x8fV\x18\x1ciK=\xe1\x8c\xb4\x94G\xb1\xb1KX\x07\xb2G\x94\xd6\xbea\xde'!\xefV\x1c\xdf\x17\x17w\xc6bo6N\xb2\x98.\x0e1\x1a_\xfc\x16\xf8o\x95x\xbaV=\xa0\xe7\x05\x9f\x95\xbbS\xa7`\xc7l\x8b\xcc\x9e\xc7QV\x89\x00\xad\xd1A[\xb2\xea\x80\x1b\x08\xa1\xd2\xe6L_o\xe89\xb0\x1f1=\xc2\xe9\x0c\x8e\xa5\xe3\x00\x0e\x82\xb4\xb73~Fb\x1dq\xaa\xe8\xf0\xf0\x06X\x80\x88\xefQuY\xf1\xdc\x0f\x8fK\xd4\xd3\x137\x89\x1f\xb7\xd8B7\xbc\xf8fq\xc0\xca\xe0\xa4G\x9b\x920\xa84\x980\x0d\xe4U\xa1\xbb\xdc\xb4\x97i\xec/\xdb\xa6\xab\x1a\x863~\x8a;\xcaCb\x86\x83<\xcb\x12\x11\x94S3C\xd9ry\xa0\x98\x8a&\xa3=\x12\xfbQ\x9e\xfd\x18-9\xfbR\x079\xee\x98\xdemIE\x13o\x9a\xb0\xcf|9{yZ\xf7\xcd\xcf\xcft\xa0\x12\xa1\xc8V\\)\xf4\x1c}{\xf1\xb73}\x05+\xde2\xa4\xb5\x15\x8d\xd2Cv\x14:\xa4:xj\xdf9\x82p\xf6\x81\\,\xaf\xfc\xe5xk\x86p\xe6Y\x88\x17\xd8\xbf\xf7\xbc|\x96\x0c\x83)\xf1\xac\xc1\xdd\xb2v\x8b\x99\x923\xd6\x17\x8c,\x9d \x0b<%E\xf3\xc9<=s\xf4\x8d\xcb\xcfB^!\x04\xe0\xa51\x10\x09F\x10G\x0a+" +
+	"\x1b\xd7k\xc8\xe3\xb4f{\x81\xdb\x9d\xedLz\x04!\x0d`\x89\xd1E\xc3\xc1#\xa6\x08F(n\x94\x08UT0\xcb\x8f*\x9c\xc4\xb4\xa7\xde\xe4^\xf5\xf4\xaa\xe4\xc6\xc9\xdd~\xa6\xa1\x13!\xb6\x9bv\x0b\xc7\\\x13\xf0\xd3f\xa1{B\xb7\xa2#8\x9cz\xdcQ\xda=\xbfK\xcc`U\xea\x0aJ\x80\x8e\xf8\xaa\x8c\xb7\xc8\xa6\x8b\xd6\xd1\x843\x8e:\xa0\x93\xb3\x92\xe1]{\x18\xf7_\xc63\x83\x04]'VE\xb1\xa9\xe7/\x88n\xcd\x80+[!\xa64\x15hj\x0bc6\x10W\x9d'\xdb%\xe8\x9b\xe8\xbe\xd0Ka\xb7\xbb-\xf5a\xb0\x0a\xde\xb0W\x96D\xda.\x8c\x8a\x94\xca*L*'\xd5u\x03\x84\xd5\x08\xc1\xec\xec!\x15?\xd1j\xac\x8bq\xb2\xd6\xe0b6\xad\x12\x8d\xa1\xd6\x1d\xb5\xe9\x96\xba~\x8d\x0d\xc5\xa6\xcd\xb8\x9a\x17\xb7\x9e\xb8\xd6\xceC\x02\x8f\xcb4\xda\xbaA\xce6\x0b\xd9\"u\x85Kl\x8c\xd1p\xa9\xdd\x8d\xf1\x9b\x17\xe8\xae\xb5\x1b9\x91\xe7:t`\xb2\x1fc\x91\x89\xa4\xa3h\xc5\xc1\xe6\x13w\xe1\x11j\xd7Q\xd1\xe4V]\x7f\x0a\"L\xddA\xed\xea\x16\xba_\xb0;\xc3\x18<.D\xec\x08\x10\x1d\xd2\xf0\xae\x1b\x01\xba\xc2\xff\xcd\xf7\x09k\x84\xf0\xe6\xda\x90\xf3\xa5\xe2\x15-\xf8d\xd0\x99\x04J\xb8\xd7\xce\xda\xfc\x9f\xa6\x94\x90\x91\xb9\x8d\xba\"r\xeatZ\xa6\xdb\xc2G\x0aQf9r\xd1Y*&\xd7\xb6\xd5\xb9b\x97'!\x14\xf4\xc1\x01yIQ\x92nvb\x91w\xac\x1d9%r\xf6\xb3\xea8s\x01\x09&_\xbdC3x\xbcT\x03\xf5&\xf5L\xb4\x10\xcdD\x8cV\x97\xef>:\xdano0\xc7\x80\xc1\xa5\xf5-\xf8\xa3\x16\xe8\xee\xaf\xd8G\x02\x00\xc9\xffd\xbc\x08T\xb2\x93P\x1aZ\xc9\xd9\xb8\xab\xc6\x14\xe7\xc2\xd3\xc9\x1c0\xb9\x9a\x1c\x03\x7f4\xeeE\xe3\x06\xd1\xebo\xfd\x8e\x0a\xb6\xa7R\xc1\xf6\xf7\xff\xe1\xef\xff\xfd\xdf\xff\xd3\xaf\xff\xe5\xef\xff\xf2;W\xb0}G\xb3\xdf>3\x0f`\xc3<\x89D\x907,\xb9\xdc\xaei\x8bb\xdeF\xdb\x9cm\xf0E\x96\x07\x1e_,\x7f\xbfY\xd1&l\x0fs\xe1|NVs\xa6\xfaD\x195\xbc.G\xb9Md\x1f;\x8ff\x07\xbb\xc3\\\x8e\xc7@\xeamm\xde\xef\xd7\xed\x13\\<\xa3\x86\x8ci\x13C+\xb5\x91kx\xdc\x97px\xb5\xae\xd0y\x80\x12\x8e\xc5$\xcc(\xc0\xb4;-\x0e\xd0\xd9\xd1}\xa1\xdfc\x0e\xe0Q@\x1c\x19(\x8a\xe2\xb14\xa1\x085\x92\xef|\xc6\xbc\xb1\x07c\x82_jA \xc8\xeb\x99\xf8h\xad5J\x91\xcbFG\xe5\xcdu\xab\x8c\x86\xaf\xe7r\x9c9/Uh\xfc\x0cn\xcf\xa9\x17\xb6\xcc\xa3|\xc1#\xf1\xf0\xaa`\xd2P\x85J\xa5\xf3'\x16\xe4)\xa4\x14\x8a\x0d\x7ft\xd4\x87*\xdc\xeb,\xe3\xab\x18|\x8b4\x8a,9[\x8cU\xb8Ix\x8a7Bk\x81\xb1$\xa3C \x97\xe7\x07\xd9+B\xf4\xf5,\x15\xeao\xe0\x96\x11\xd1b\x0b\xd0\xf1\xfd\x15\xce\xf8)\x8f\xd2\xa1\xde\"\x15e\xaf\xcc\xdfobaR\x1d\xb9\x06\x0cK\xcc\x1b\x0d\xfb\xd9%:\xc6\xf9\x17T\xa9\x7f\x0b\x90\xcd(\xdaf\x963Gvh\x1c\x8f\xcel\xa7\xad\x17\xff\xb73\xb9\xda\xb6j\xaa\x91\xe2FW\xdf\x05\xf6\\\xfa*\x0e%\xc3\x05k\xac\x9f&\x98>\x85\x10\xf1\xdfI\xe6\xeez\xfd\xf4\xc8\xb9\xe2\x1a\xb1i\xb1B\xcf\x02\xdek\xb6\xb5)\xceb\xcc\x0bD\xa3+9\x8d\x09\xb1\x8d\x96\xafc\x09+\xcbG\x06_~\xf1\x0e\xb9\xc7\xff(\xbc\xdf;\x0a\xb8x\x9b\xc16\xcer\x87\x06\x17l\x18\xe4E\xeegJ\xf5\xaa\xfd\xc4\x1a0\x94J=\xcb?\x0e\xe4:Y\"\xdf\xc8y\xb8dM\xb2;\x9e\x14\xc8\xa8\xfbkk\xfd\xf6U\x80\x0dKv\"']i+\x8d2\x11\xff\x11\xf9\x15pO3\xcaz\xb9&\xa3M\xe6\xce\xe5+\xe8\xf8\xe2`\x8f>\xcei\xaa;0\x87\xcf\x8a\xa9/$EtW\xa0s\x97\xc5\xfd\xa3\xd6z\x9c\x92?\x87\xa0\xde\xc3\x16'\xea\xd3\xe7[\xdaC\xa1\xb1rYQ\xe8\x04F  {\x06\xa7R\x04D(\x03u\xd1\xb7\"\x06\xe1\xdc\xda\x9f\x8c\xad'\x86\xf7\x0ch-\xa7\xf4\x9d\xcd\xd4\xcdi\xfd\xd6\x15\x8e\xb4\x189\x1c6%w\xd2\xf29\x1c@F\x1a+\xc3\xe4\xa8\x06;\xce\xc8\xe3\xba\xfe\xfa\x9b\xf0\x7fqlF#/\xb22\xa6\xb1\x0b\x19b\x04H8\xd3\x97F\xc4\x1e\xe1\xee\x0c\xa1c\xb8'\x8b\xe5\xce\x8c\xe2\xc0\xd2V.\x08\xc4\x17\xf8\x80-\xa6,\x13!\xf6D\x87}G\xf1\xdf\x97\xcb\xcbK\xe2\xbe\xf6\xffj\xf2\xf2'I'_\xc17\x8e^\x89~Q\x81/y\x0c\"M\x0e\x87\xbbT\xd9\xdazw\xd4\x1e\xb1\x91L@i\xad\xf9\xf8q?x\xca\xa8\xfd\xe3*\xf6\xb1\xce\xf1\x90\xce\xce\xe3\xdc4\x9b\xb2P!y\xb34\xba\xe1\xd4\x1e0\xad5\x16\x17\xf7\xe0\x8a!\xf0ihrS\x85\xd1Q&\x00F\xf0&%#\xffk9\xfc\x05\xb0\xc6>\x1f\x03\xffJ09\x1d\xd2nh`\xc0\x10\xe82\x0d\xd7\x1f\xa1\xf1\xc3\x97\xf1\xae\xc5\x12J\xdb\x12\xf3\xa8\xda\xc1\xf7\xa4\xf9\x18Mlk\x99\xe9\xa6\x8b\x07#/y\x13\xd99b\x8d\x82\xd5TcN\xf2\xa2\xcc<\xe3\xa8\x9e\x19\xb1$(\xd3E\xee\xe9\x96\x90\xac\x02~o\xd0\x9co\xc2C\x18\xb8\xd8\x1f\x1c\x82E\x0e\xb8C\xd3\x9a\xb8W\x1fx\xb2\x08k\x9c\xae\xf51\x1e\xab\x8b\x1e\xc3\x1cX$\xe38\x87K\xbe0\xb6#Cv\xb0=\xdd]k\x7f\x9b\xed\xec\xad\x9c8\xdf\x93FBK&\xda\x15\xc4?\xd8)\x14<Te\xe8\x84\x0e\xf4qy\xd4\xeaC#v\xbdC\xc3\x8f\xd1\x17\xfd\xb4s\x86\x0a+\xf3\xcf\xf8I\xc7pa\x10\xfd\xbb\xae\x9a\xaa\x04\xb7\xa3\xba\x13\xd1\xeb\xb5<\x8cA9\xce\xec\xf2\x91\x1eXH\xfe\xb8\x90\xab\xae\xeeU7\xa2ks\xb4\xabJZ\x9cb\xffl)\x03\x03\xd8\xd1\xf7]R~I\xae\x05\xc6\xaf\x89\xbe\x84\xec\x93Qh\xd4\xa6\xfe\xae\xd5L\xb8\x84\xdfn\x96Y\xa4\x1a\xd0$\xb7\x1e\xeb$`\x0b\x84\xeb5Q\x0c:\x90\x88\x8f\x13\xea\xc6{\xd5q\xaa|gAjtHk\xb0kp\xc6\xbd\xf7\x9d\xac\xfe\xa6\xad\xd4\x9e7\x04w\xea7\xe7\xa4y\x07\xec\xcf\xe3\xa9\x90\x18\xae+\x0e\xb89\x1c\xcd\x06e\x91\x81\x9c\xc1r\xb4\x15\x93\xc5@\x7f7\xabP\x94\xc3.\xa6\x10\xd9\x83\xc2\xaa\xe9\xea\xf6B\xb2J\x85:\x03\x7f\xf7QZ\x88Cd\x88yW\xbc\x97\xadT/\xc2\xf3+\x94\x06\x1a8\xe3\xa7\x96\xaf\xbf\xddW\xb6:1i\xe9\xa6p\x93y\x1b\xf7\xcdh\xf1)\xad\xf5Z\xccj\xde\xce\x8e\xf9\xec\xeaj(G.\xfe\xe6\x9bb\x84\x86\xdf\xa9\xfeT\x7fb\xcb\xfe\x06\xf9#\x10\x17\x85\xa2\xcd\xd8'#\xaa\xa6\x9d\xd5\xaa\xf6\xb5\x0df*\xc7B\x99\xa5\x13\xdb\xa5t7\x84A\xa6J\xf0h\x8a\x9eMRE\xcb\xdb\xa2+\x99\xdb\x16r\xe8<I\x8b\x9a\x18_\xc0}\xe9\x06\x8a\x1b\xef\xa8T\xa5'r\x94\xa2\x8c\xf2\x06)w\x1fr7\x8d\xd0\x1b+\x0a\xcf\xbbC\x18}\x91\x93b\xf0\xf51\x1f\x9bl\x8f\xf50\xec,\xec\xfc}\xa8W\x97\xca\xaa\x7f\x13\xa7\xe6\x93/\xe4\xd4|\xb3\xcd\xa9\xb9]R\xad\x96\xe3\x07)\x005C~a\xdb\xb0[\xc6\xec\x80\x99\x84\x87\xe6\x1d\xd1\x9de\xa1C\x05]\x09\xb8.\xdc?\xdd>ug\xfc\x94\x07(b\xb4\xbb\xa3\x88\x10\"f\x85\xbc\xa0\x18]7\xc21\xd8z\xe43\xa5\xa1\xc7@7u\xe33(\xe5\xa5lk\x8c\x9c@\xb7A\x8cW\x93\xe3\xbf\xc1\xc5\x0dg\xfcD\xdb5l\x0b\x8d\x1b\xa8r\x1a\x1d\xcd1F?\xe6\xa7\xd4?V\xfd\xf9\xb3\xf0\xbeO\xa9yD\x03\x9f\x12\x89\x9aH\xa7<k\x92\xb0\xa4U_r\xb9\x9a\x80\x12\xd6\x8a\xc3\xeaU\x8f$\x9d\x88\xfc\xdc\xc8\xee\xbd\xb6x\xcc\xb0%c\xd0iO\xf1db\x11mSlJ\x83\xe0R\x81\xb3\x82^9.\x19\xa0\xac\x10z\x0c\xfdQ\x8f\x89\x14\x01\x88\xce\\!Q\xce\xf4\xd9\xd4nTvU\\Hf\x09\xccNS5\xe9\xf9\x15\xf7\x0b\xb5\x84\x9e\x7f5\x93\xf7S\xd2\xa8\xe9\xfdR\x00\x86hk'\xc7mJv\x19|\xb2%\xcfE\x15\x857K\xef9Y(\x18_ofD5\xac@\x8f\x9e?\xdd1F\xe6\x86\x08aO9\xe8\xc8\xc8S\xc1\xda\x93_b\xf9M\x95X\x04\xa5\xd1%V\x8a\xb6p\x82x\xb5\x98\xfa\xf9\xfa\x9e\xe3\xefM\x1e1T\xadO\xcc\xa2\x01\xba+\x8c\x05\xba\x98\xd4\x8e\xc2\x9a\xbe\x89\x80}\xfel\xb6fRr\x04\x0d\xb4\xbe+>\xb5\x17\xa8\xad&\x88\xac\x15\x14r\x9f\xd4O~\x01s\xf1\x97\x12\x17K\xa9\x84V\xf2)\xea8\xe1]B~\xa6\xbb\xbf\xd9\x9a\x05\x84\xda\x7f\xa9T\xce\x8c\xf4\x91\x89P\x0d\xd5\xe8x-\xdf\x00\xcc=\x7fn\x83\x11ZP4-\x00V\xe9+r}\xc4\x15\xc9\xf1\xff\x87\x04\xccq\xd4\xfam\xb2\xf8S\xb4\x18\x03{9\\\xcb\xf3\x92\x0e\x87.;\x9a\x0b\xc1\xf4$\x09^J\xbdj\xa8\x0b\xcfP\x13\x0bi\xfdI\x80\xce\xa6\xf8s\xf6n\x86\xb4\xc1\xa3)'\x9a\x8a%\xdf\xab\xce\xdd}q`\xff\xe58sZ\xa4%\xe8F\x80\xd1\xb1\xb7\xf9\xa5:\xc6*\xa5\xab\x935\x14vQq6<\x00U\xafD\xa7U|\xdc?\x86\xfe\xf8\xe0\xa0\x85q\xca\xfe\x90\x93\xa7Db\x8a|\xc5\x8f\x916\x94\x127j]V}\xdf\x149\xd9rZA\xd3\xc30ZU\x10\x8e\x91 \xdb\x8d\xa2\xa2\x87O\xf5\x17]\\\xed/\xe8\xa8\xf6\xc3\x08s\xecp\"\xb2\xf0]\x07\xcd\x0e4\xc6\xb3\x84O\xf8\x7f\xf9\xf5\x7f\xfa\xf5\xff\xfd\xeb\xff\xf3\xf7f\x14\x0e\x1dC\xcf}68\x8c\xb8L\xd4U\x0fW\x0f\x9dM\x9b\x9e0\xbb\x8f\xa9\x87\x08,\x08\x8bs5\x8cP\x84\x83\x9el\xc26~\x16\x16\xca\x84\x9d\xf5`T\xb5\x8e3Z\xf8\x88P\xf67h[(3\xb6l\x13$0\x098}-S\x01\x15\xa13\xf8\xf4\x7f\x81\xcb%\xcc:\xb8B\x1fAP\x99\x00\x96)o\x90\xed\xc0\x94+\xa5Ng\x06e\xca\xaedK)\x92\xda\x8d\x84K \xc5o<\xd9D\xa8\xd0\xb0kvxksL\xb5ENUKs\xb0\xea\xd06\x1c \xc3\x8e\xa23\xdb\xc2#\x90\x8e\xe2\x08C\xf1\x87w\xfd\xf0h\xc9(\xa2 \xb45\x99\x0c\xd1\xc3\x84u\xc3`\xe9D\">\x13$\x13jI\xae\xbeg\xf7\xd4\xa0\x84\x13K\xe8\xed&\x98F*\x81`\xf4\xbdpQ[\x85\x89\xba\xe2p\xbe\xbe\xfd\x81\x8e\"W\x82d\x0c,\xaf\x05\xda\xdb\xe9vUCI#Q\xb5l{w\xf1L\x8a-\x08\xca\xf0\xd0n\xf4\xdd5\xec\x7f\x9b\x80\x9b\xbfN\xac'Zki\x1a\xfe5\xe5\x17\xa3\xa7\xb8\xde\xc0]\x8a2I.\x9fV\x11\x0d\xdb\xdc\xd4\xaf\x93\xe7\x11\xd0^Z/@\xb7\x0b\xed\xac\xc3\xd1\x08\xf2\xc8\x9e,\x17\xf0\x0d\x16`u\x92\xb6W\x02\x0b\xe5\xef\xdb\x06\xa0|X\x009\xd7\x15\x062\x8d\xc2\xe9\x80\xc4\x13\xa3+\xc0\xf5\xc2w\xd8\xed`A\xce\xef`\xe3\xc7\xbc\xaf9a[\xd2%\xb3\xe8?\xdd\x8a\x03\xbf\xd81\xa9\xbd7\x0f\x19'\xcd]|\xc8\xb0[\x86\x0d\x17\xedp\xbeC\x18\xe0B$\xe3\x8e2\xcb\xd0Ja[c\x87\xfd\xfb\xf4\xab\x19Q\xb6\x0c\xb3\xc9\xdcIh\x19\xd7\xb1,\xac\xc1\x1c,Fv?\x06\xbfZ\x08K\x89`Q\xb4\xad\x13\xd6\xfe$\x8b&\xfd\xcb>S\xef%\x82\xf4-E,\xf8a,k\xb5\xd0\x8en\x92UG\x0c\xc84\xbe\xf4=4\xe0j\x18\x8b\x16b\x09\x15\xe2\xf9]\xa2Cp\xf1c\xd1=\xc2g8F\xd6\x8e\xb9\xe2\xed$v\xca\xd6\xeb\x94\xf7R\x88\xba\xb6\xd2\xef\xd6\x1f\xe1\xca\x99\xa1\xaa]\x0f\x0a\xc6\x96b\x98\xc8\xed\xe1jX\xa6\xf8U~\x8f2J%4\x92\xff\xc5L\x9ceP)\x0e\xe9\x18\xd0l\xea_\xc1h\xd4\x9e\xec8\x90\xe7\xe1Q/\x85+\x1c\xeb\x95\xf7VE\xd8C]\x91\xd1{\xe4\xabDB\x94\x19R\xdc\xcc\x83\x0d\x87/\x140#\xaa\xf2\x8bp\x1e$g\xa3uI\\\xae\xa0\x81\xaf\x0f\xfap\x19\xf9\xcb\xa8L>\x18\x06\x92\xed9%\xd6\xa6\xb6\xd1\xaa\xa3\x88t\x8c;\xe0\x07?\x8b\xb8\x87\x9a!\x01\x88ps\"\xcb\xf1`e9\xd8\xc8}`~\x8a\xc8\xf6\xde\x0f\xd0\xc29|\xec\xc6y\xe0.\xda\x8b\xb0\x1e#\xa1\x0e\x14cv\x9dK\xb9Qx\x00\xfa\xbb\x10\x0b\xceP\xc9\x87m\xaf\xf5\xe1\xdc'+5\xa5r\x0d\x0a\xd3\xd1VO\xa0\xdd\x01$\xedh\xe3\xdc54'\xfb(j\xbcpy\xc0N\xd8\xc0\xcbe\x8c\xa2\x81\xfc\xf1\xa2\x95\xa9\xc3d\xbeqJW\x8d'\xc7\x0c\x9b\xd8z\xf5\\\x95\x91)Gg%\xc7\xd0s\xfe\xc1\x97\xc12\x80GCx,1;\xff@6\xaaB:p\x93\xb6\xe5\x06*\x8a\xc99\xe2\x1d\xd0\x89?C{\xf11\xb6\xf5\x00\x9e\x0b\xb7\xb9\x8a\x1cYD3\xacp,\x9e5ZM\xfcd\xb1\x94\xb9\xf3\xb7\x82\xd8g\xa1\xf6cd\xd3<)\xb1\xbe\xd8\xb8\xf0K\x0d7\xe9\xd0\xf0\x9f\xe5\xd1\xb3\x00\xb6\x1d@\x87\x9f1&\xa1N\x9c\xb1\x7f\xb0\xc6\xe4\x0a\xa9\x06S\xb8{7:\x16\x1at\x1d)\x9e\xdc\xa1+97\x83g$b\x81\xca\x111\xc20a\xc3\xd8\x1e}\xb1T\x98\xf6\xd4\x8c\xdd\xc5\xb5l\xcaYb\xc0\xc9J\xf7\x0b\x0c\xae~[|o\xba\x1dO\xd8\x91\xfd\xff\x87\xf9\xc9M#\x14\x0f\xd0up\x93\xb1RW\xa5\x13\xc9&.\xa2'\xee\x809\xc0\xc7\xbe\xe6J\xe9\x9fa\xe1\xd9\xb19\x8a\x83x\x8bP\xabb\x11\xdf\x87\xe7\xa9,\xa0aQ\xc2\xd5\x1bM$>\x86\x16\x7f[\x00\xad\\\xc9\x1a\x0c\x8c\x9bYb\xd3\x11i\xc9jF\xfd/\xf9\x9a\xc6k:G\x19n^\xd6/C\xb2\x1e=\xd9}\xc9\xfd\xe9J\xbb\x83\x0a%\"\xbb\x89\x1d\xe9 \x98\xb0\xc8\xc5\x11\x0faZ#\x8c\x80\x1eJ-W\x08h\xcf9\xff\xf5\xb7Q\xa7\xa4\x99\xf3\xbd\xf2Y)Xwu\xed\x8d\xa6\x8eZp)\xf8>\xf6\xfd\xdb\x99F\x15CPl\x02CA\xb0Rl\x8a\x04\x04\xfb\xb2\xe6K;ff\x84|\xef\x07\x8ck\xa4:\xfa\xf3\xa8\xc0\x83\x04K\x8e(\xe7\x8eft\x98\xef\xc5\xc1\x06Fh\xfb\xc5;\x8a'2\xb9~8.\xa2Sp1\xf8\x16:W|7\xf4\xe0\x9a\x9d\xfc\xcd\xc9-&z\xfet\x87\xd6\xb5w\xd7\x9d\xe5\x1fy\x1c\x85\x1f c\xce\xa0+\xb3A,\x83\x1d.\x82\xe0\xcf\xe5%\xb8\x88*\xdd\xd5w\xe4\xd2\x93\x1e\x92puZ\xce\xdb\x9a\xde\xf2\xa3\x1f\xe6^4F\xb0\xa9bU\xa3\xa8\xb9ec\xb9\x96\x1a78\x0b\x96\xdfIu\x9cH\xb0Oj\xa5\x92\x9d-\xd5\x9d\xf5-\x8c\xba\x14XK\xc7\x02\xcc]\xa7\x04~%W\x13\xa4\\5\x09\x1c.\xd5\xff\x95m\xc3J{\xec\xca\x15\xedc\x95K\x83D9T@k\xc8\xdf\xeeK\xcb\xef\x95?4j\xf6\xe8Y\xa0\xa3q@~\x8f\xac\xc0\xedX\x80\xeb%\xc4D?\x867\xf1\xc3|\x89\x84\xe1\x127\x14\x1c\xd0\x81b\xbc\xcd\xf2\x85kAW\xdc\xaa\xcf\xc4\x8f\xd2EM\xc8r\xd2E\xab\x0d\xb5'\x9e\xf6\xec\xeb\xf9H\xc2h\xa1\xef\xdf\xba\x06\xafo\x94\xdb\x90\xcf\x9b\"\xa8\xc7\xb4\x04\x15\xe6\xd9X:\xe89R\xb0\x9dF\x9fz\xff?\xc6Y\x97>CZ|6\xa9\x19$e\xf4\xc2\x99\xa1\xbaZ\xf5;\"\xeb\xc3mc\x0b\xcf\xa8\x84u\x172\xc0\xcc>QI\x1fq^\xcc\x15\x05P\xe4\x9c(\x83\xd0\xdf\xbf^\xf7\x14{>O\xd2\x0b\xff\xb7_\xff\xe7_\xff?\xbf\xfe\x97_\xff\xc7\xad\x04C\xc8\xe2\xfd\x00\x177\x9b\xc2\x8b*LSxz\x88t\xa2M\xe0\xba\xab\xeb\xa0;N[yB\xb9\xd8\xfa\x0e~q\xf5l\x8e\x85\x17\xf5\xe5T\x8b\xe2KN\x9bi\x90\x04\xfan\x80@\x9f\xe1\xf6\x18\x89f5\xff\xfd%\xcf\xf3\xa5h\x06\xfbHFK|\xbe.\x07)\x92\",F\xec\xef\x1f+\x0a\xcd\xac\xf1\xe9\xa4\x99\x83)\xb7G\x1e\x96\x1c*\x09\xc7\x97\x1e\x8a\x8b\x87\x1b\xd5Qs\xba\xeeSw\xe5\xcaB\x85\x91\xe4\x97q\xc3H\x92&\x18$\x88m\xf2\xb7[xT\x96H\xa0\x17p\x17/\x89\xd0\xd9KA\x07\xf59|\x14\xb8\xeb\x8cm<\x15\xffZ\xd3\x1a\x8b\xe1\x0c\xd6}{RL\xe3\xe6\x0a\x01\xd2 \x0d\x09g\xb4\xbe\xf1xM\xc4\xf9\xbc7\xc4L\x92\x90\x9a\x11`\x8cB\x11B\xa8K\x1b\xdfa\x8f\xf1\xab" +
+	"&\xc6\x8f$\x80`\xf1\xad\xd9\xbb\xb2\xc5\xc6\x12\x82Xh\x02\xeeXt\xd0\xc2\x0c\xf8\xf5M\x02P!;\x1aJ\xd7\xe4\xa4\x9d\x9cu\x8e\xd8\xc0\x0cT7si!\xe3\xbc\xe7\x8f<\x88V\xe5\x00\xbe\x84\x1e\xce\xfc\x8d\xbdD\x14\x80\x14\xbb\xf2\x0d\x9c\xf1\xb3\xf59<\x01#\x980\xc2\xd0\xfa\x19?\x92\xe6\xd2\xcd5\xf0K\xa1\xee\x1a\xc7\xdf\x0e1\xb1\xf5i \xec\xb1\x99\xd1Ch\xb7\x07\xa0j\xbe0\x80\xe5\xd6<}\x13\xc4Zw\x85\xf6\xce\xe2\xa5{\xd3\"j}\x85E\x92\xf68r1\xf1I\xa8\x03\xfc\x08\xed\xccN\xd3\xfa\xba\x95L\xd9\x0c\xef\x1f6Z\"\x0cd\xf2J\xa1\xde\xca]\xbc\xf2S\xcf\xaa\xee\x86\xfd\xdcw\x8f\xe0\x8a\x9b\xbf`\xba)\xdc,L\x7f\x90\xd0\x03\xe6\xea\xc4\xdf\xeboP\x17\x87\xcf\xd0\xbb\xe1\xdcV\xf8\x8dQA\x9e\xf4\xe4!\xc8\x9a?#j\x8c/\xd4NV\xf5\xe0\x94<\xb8\xde!=\xf6G\x0f\x8d\xe3\x88\xac\x14Pha\x99,y\xd3\x81t\xe0\x91DL\xa9:\xc03C\x91\xcbN\x10\xac\x98?\xf7a\xa3R\xe4\xf5ze\xa0\xdax\xd0^\xc2t\x0a\x0b{\xf7\xa0\x88\xa8\x0e.\xee\x1c>\x8aR\xfeT\x83]\x8f\xe8o?\xf8\xdb\xa9\xf8\xee\x82h\x87\x13\xb9v\xe1\xf8\xef\x06(\xee~\xec\xa1\xf8\x1e\xfaGhO\xd1C35txck'\x98\x87`S\xfd\xeaw\xe4\xcc0\xf3\xcd\x8bW\x06\x09\x9e\xb7\xc2\xd4a\x89\xa9tc\xa9h^\xact\xa0\xdb\xc7t\xaf71\xde\xf5\xe2\xf2o\xac\xe9\xb1\xa8\xe6[H\x0f\x91\xa3n\x11m\xbc$(\x9f\xb7\xef;\x07\xac\xfa#\xc2@\xd4\xa9I>_\xca\xeez8\xf3\xb75\xcdka3\xcb\x10\xe7\xdd\xd5u\xc5\xf8\xe9\xce15>s\xca]\x9a\x95w/-B\x0c\x1c-R\x92\xa5\x9d!o\xb51\x0f\x7f%\x02\x8b\xd4C\xce\xdf\x16\x87\x12\xc67u\xcc\x062\x98\x9bl\xc5|6\xbd\x05\xae\xb6\x1c\x8b\x8e\xf9I,\x87\x96\x1ce\xcb9rfr\xa8\x8d\x06\xff\x88\x15\xe8\xfd'I\x9a\x8a\x91H5\xe9\xb9\xde\xb9\x94)V=\x96\x86\x9f\xf1\xb3\xb8\xfb\xfe\x02\x03\x9e\xb0#\x1d\xf8\xde\x0d\xbc\x13\x96.\x84\xd4g\x17\xde\xae(\xdd\xf5S\xf7\x91}\xd9Cd(\xa3'\xe0\xdft\xff\x0f\xff\x1ewViO\x97\x90\x19Kw\x9et\x1c\x0b:\xc2\xc4t\xc2\xfb\xc3\x0f6I\xd8\xaa\xfd\xfd\x17\x94\x97\xfd\xe111\x9e\xba\x07\x1bH\xaa\x85R\x93\xf0\xd0\xa3\xbb\x87!\xf0\x08_\x94J\x9b\xbe\xd5P\xa9l\x85\x89|\xcc\xa5\x97\xffH\xe9\xfe\xd2\x97\xbe.\xfe\x0a\xe3\xd0\xbbKq\xf8\x1eJ\xd7a)y\xb4\x06\x8c\x10\xbdV\x94r\x94Q\\\x0c[!\x94\xc3S%\xf0\xe3\xba\x11\x0a\xf77\xa7M\x80{\xf4\x81%\x84\xe8z\xe8\xd2uW\xed\xf2\xec\x99\x13\xc3\xbf\xf7-\xdc\xce\xf8)y\xd1\xfe\xe2\x83M\x94\xd4\xf1>\xc0\x15\x8e\xf6\xf9\xecyd\xf6\xe6\xd67_\xe8\x94\xfa\xe3\x918I\xc2\xd6\x0d\xac\xd5\xfe\x99\x92\x14\x8cCp\x1b\x1f9\xed-D\xcb\xa2fYu\x0f\xbe\xb7\x09\xee\xd2Q\x93\xe5lP\x08\x0fn}?B9\x11gH\xeb\xb6\x12b{\xc2\xdaIb\x95\xfdd)\x90\xc6\xabe\x10}\x1a\xeb\x06M> &`\xdcY\x92=\xe7'KD+\xda\x07\x17\xde4\x13\xf7J!\x03\xb6\xf9\xe5\xc91\x17\x80\xa1\x1bfQ,\x0em\xc8\xb2\xf7I'\xf3Zn\x0d\x03\xcb\xd6\x0e\xb5i\xe0\xbb4\x07\x1e\x8053\xab \x87W\xcba\x8e?\x0b\xcd\xd9dP\xf1\xcbj\x94#\xcc$\x1bv\x09cI\xf9f\x98\xa5\xae\x85\xd1\xc5\xda\xc4\x903\xf47\x01p\xe2\xb9\x07\x11\xe3\xb9\xb9\xae\x84>=\xefh}\x89\x10\xa00)\xff\x8fpG\xce\x0b\x0e\xc4\x88!(uQ\xe8{m\xadD\xaf\x97\x9b!\xad^\x12<g\xe3\xd0/t&\xc4r\x18\xa1.C\xfe\x88\xba\x05_J\x80a\x02\x0c\xb8\x04TH\xa7\xfe\x08\xb6\x86\xfd\x9d\xe9\xa6\"\xb7E\xab\x90\x96\xf7\xf3\x11\x8d\x84\x8cE)\x87l\x8e\xed.\x0f\xcbe\xfc\x0cKN\xa8F\xb6\xb3\x8d\xb32!\xea\xd3\xcd\xeaR\xde \xac\x7fg\xfar\x16\x1d(2\x99s\xfb\x93\xa5\xc7\xc8\xacu\x13\x05\xfc\x1e\x88\xbe\xdc\xec@v\xcaL\xa2\xb4h\xa0\x84M\xe2.\xd4\xd0\x96\x8b\x91F\xca\x8e\xe4\xe7\xf7\"@\x14\\F5.\xc2\xf8\xd7\xb4\xbd\x98B\xa2\x02)\xfa0'\xcb\x95l\x12\x15\x18\xa5,S\xf3\x89a\xff0j\xa4\x9c\x96\x8a3~j\xed\x80i\xb8\xbb\xeb\xaeC\xd49PP\xf1IP\x9a\xf8p\xf1\xa9h\xc5\xba\xb9\xd05\xb6\xf8\xe0#|\x941M\x0f\xd1\xb8R\xc6\xfe\x08\x0f\xc1\x81\xe0\xba\xd26i\x0a\x1a\xb1yqiB\x8b\xa5\xd1Q\xb2\xb2\xdb(>O\x83\xe5\xee\x07\xa3\xa8q\xc4\xc4\xb1\xda\xb3S\x9fLR1\xaa7\xb81\x94c&8\x96\x1c_]\x0f\xd8A\x9f\xe1\xa8\x1aY*\xd6m\x98ZQ\xb5\x8f\xcaK\xc2\x9a\x7f\xaf\xfa+s\xcd\xe04\xdfu\xaa\x8e\x0c.:\xcd\xb6;\x0cfZ2\x1f?\xf8\xee\x8c\x9f\x09(\x87{\xfd]\x0f\x97\xe2[(\x03\xbb\xc4@\x0e\x1c;]gu\xbd\xc4\xb9\xa2\xa2\xd63}I\x98\"B\xc2b\xa0=\xdc\x9a\xef\xc5\x15\x04\xed\xdc\xb6Es\xe843\x85(\xd0\xb5\x9dm\x9e!\xa4T\xad\xb2\x03y\xb5hwz%[\x1b|O.k\x99\x90I\xd0\xf6S\x08\xaf^\xf4\x10\xed2\xd4\x07\x8c!-\xadG\x13Y6b\x0f\xc7$\x8bF\xe6\xbb&\xb0\xd0\xeb\xb8\xf8qk\x80%F\xba\xd4\xe3\xccx\x07\x82v\xdb3jN\x0b\x80J\xe6\xa5\xe2X\x94\x1a\xe4sf\xbb,\x91nL\x8a\xdf\xd1\xdf\x8e>br\xce\xd2\x9c\xd3\xdd_\x15{r\x910\xda{\x15\x08RWT\x03\xb8\xaf\xe0[7\x88\x99F\xe3)?\xbb\xd3T\x84\xbe\x9d-\xd1\xce}\x15VIb\xe9\x8d,\x9f\xaai\xc2\xab\xbf\xfa~\xc2\xd0\x10\x0f\xcad\xaep,\x9f\xe9+\xb5Si\xff\xe9=*\x85\xe1\xb3\x04/\xf0\xb4\x9d\xe5f\xdb8\x91\xd2\xc0\xe9\xb7\x89\x14\xe88\x04\xce[\x81FV:C\xec\xff\x88!\x93\xd4\x90xp\x0cQIx\xf6\xc5D\xa1l?S\xcfJlp#y\xf1\xdb\xe2\x9ei\xc5\xb9\xdd!\x1a\xf0kd\xb1\xf4\xbf\xcc\xff\xa4\xc6\x0fQ\xfc=T\xdd\xb5\xae\x9a\xb0\xf1p\xc5\xf2L~\x12M\xa7\xba\"\xbeJ\xda6\xb1X+{\x0e\xb4Ok\xe8\xe6\x86)\xd3\xd8\x88\x95\xb1\x14\x8c\xf9\xe0\x99\xc07A\xcd\xef\xcc\xc4g2\xd2\xf6)\xde\xdd\x1a'QIDU\x04h\x86\xfb\xdb-x\xd5\x96T\xc4o/>\x14\xb5\xa9\xba\xb1\xea\xaeg\xfeN\xd8\x8a\xe91\xda\x8a+\x81\x0dT\xaf\xaeLp\xc1\x0c\x16\xf5\xa5e\xb9\xc0(m\xf0\x04\xb8\xa2N\x0f\xc0gA\xfb\x14\x8f\x09\xb9\xabn\xbf\x1cr\xc1!\xe64DAY\xee\xdb\x19?O\x89\xeb\xcc\xd8\xc2\x11\xc2\x0b\x1b\xa1\x07\xdc%.\xd5\x15\x01%8\xe3\xf1d[\xb8\x83\x17v\xdd\x03\xd4+}=\x98@\xd4N\x1d\x84\x98\x0b\x8fy\xd2Z\x9d\x13y\xad$\x88\xdf*\x18\xf8O\xea\x8c\x89~\xa4\x14h\xbanZ9(bQ\x0e\x06^%pul\xd9\xc4\xe6\x95\xa8\xf6\xb9\x0cq\xd1\xfa\xf1\x0f\xdczP\xfb\xe2c\xd5\x83\x8bU\x84d?~\xd4\x98\xe5*\xe3\xe2\xb3o\xa6\x14h\x93\xe7\x17/\xe9\xa0bn\xc7|\xf7\x0a\xf6O\xd4\x7f=D\xbf\x10\xb3\x0d)I\xee2\x0d\xe0$\xe8\x9c\x12\x16\xc6\xac\x82\x08A\xb7^\xa0\x8a\xb1\xc4wG\xc6>tm\xd2\x85\xdc\xd1x\xbb3~\xc6\xfdGR\x9d\x16\\\x19\x09\xb3\xea\xea\xc1%59b^\x8a<Z&\\\x87A\xf3I\xd0\xb9\xf4@\x98F<:\x19\xd1Sk`\xb7\xe9\xfd\xfc\xe9\x94\xd3\xa5\x90\xb7\xb3>\xa1\xf6\xf6!m\x95\x0e.\xee\xc8\x81\xe2\x11\"\x03\xe5\x9c\xb93\x8b?\xe5 +\x82P\xb1aF\xcd\xbb\xb4\xe9\xb0&\xb8\x10ve\xda\x181q2\xd8\xa1\xd1=\xa6p\xe3\xd67\x0ev\xd4\x8a/u}\xda\x0e\x11\x90\xcd.\xdfg\xe8\xdc\x08q}\xb4\x81\x17\x81Q0\x0e'\x979FC,\xd2t]\x1cNMU\xb3E)#FThu\x84\x91\x11\x9e\x0f\x1b\x87F\xf1\x1fU\xa5-ccF:\xc0\xedzn\\~qn\xc7\xa7\xc5\xbe.\x1d-\xd3\x1c.\x98\x12\xa4\x855Ke\xa01\xa4w+\x1a\xa8\xbd\xb6\x97\xdd\x1c\xff\x047\xd7\xb8G\xb8%\xa0\xd9\x92\x03U\x09\xa3\xdd)4\x17\xc8\xdf\"\xfd\xf6\x08@\xd0\x8eY\xa3y\xc6\x1c\xc2LC=\xf8\x92_\x90-\x88\x86\xf3\x02\x98\x9c\xd6\x9c\xde\xa9\xb8\x81+\xe0\xd6\xf8&4\xdd\xa8\xdc!U\xcf\x90=\x1e\x1d\xf4\x873}\xad\xc5\xf7\x9e'\xa8\xc9\x98\xd8\x8ail\xe1\x95N\x9a:)\xe2\x8f\xaf\x8a\xf4\xaf\xb7\xe20\xf8\xae\x83\x1b\xce\xca\xd6\x1f7\xcd\xc0?\x87p\xdf\xf8e\xcc\x83\xcf\xe7\xa1\x92M\xb0K\xc3\x87lZ!\x0f\x1d\xe9\x02\x07\x94\x85\xdf8\xa8n]\xb7q\x10\x8f>\xe6?!\xc6\x05\xdc\x18W\xcf\xe2\x9a\xfd\xd5c\x10K\xbaqL}\xf3\xc3\xa3\xff\xc3\xe6\xdd\xfa\xa1*\xab:\xd82[\x07\xde=\xd6\xde\xae7\x8cB}\x8a\xba\xba}\xea\xdb\x9d/\x9d\xf9\xc8\x87.\xe4\xd2\x05\x0a\x92\xf1\xed\xe9\xa6\xaa\x0axU\x0d\x05\xba\x08\xec0~\xc1.\x9a\xd8\xc4\xbaP\xdea\xf4\xcc\xd7\x1f\x9f\xfb1\xcb\xcd%\xc5\xc1\x17\xca<\xce\x8e\x12\x09\x9c\xcd\xa6\xc3\xe4\xad\xc2`\xc1-\x15\x0bUU\xc4\xe4\xa4\xfc\xfci8\xd6\x85V\x164O\x03\xae;j\xa0\x86K\x87-\xf4\xc22\xa0!\xb4\xbf\xbfA\x0dn\xf6\x89\xc8\x9c\xa1\xd9\x82\xbf*\x91\x1b2B\x9f\xe8k\x0f-\xf4\xf3\x05\xcc\xc2Irs\xb2\x00\xdbh\xad\xd91\xd2~o\\{\x87\x9bI\xa6\xc7\xadVS\xb6\x0c;\x94SpW\x9e\x1c.\xf6\xed\xcfp\xa3\x95y\xacz?\x14b\xfe\xeaN0\x07&\xa435|\xa9yb\xccE<\xba\xda0\x13H\xceY\x97\xc9\x94}\xf5\x11\xa4\xba\xe2\x14\x9f_<\x9fTo\x9co\x96\xe9\xfe&\xa8\x18\xce\x19\xea5\x04F+\x01\xa4\xff\x00\xae\xb4X\x8d`\xbbb\x8a\xa9\xea\x1boU\x0b\x11\x0f\xc7\x84\xb0\x9d\xd8\xe2\x18m`\x9c\x01|fE+c\x9e\xa3\xfa(\x19\xf8\x96\xaa\x01\x09l\x13\xc4\x85L\x9fX\xdez\x0d\xd7s\xc7\xcc\x8a\x99\x80\xcc\xb4\xdc\x89\xa7\xd2\x1c\x0a\xcd\xae\x10M5\x80\xd8c\xf4\x8aRhm<\x9e\x1c\xe7\x90)\x07\xe15\x91\xbeCT\x9dvh\x80G\xca\x01\x99\xc8\xdc\x0aw\xdf\x11\x98\x01\x93\xbc\x13\x88\xbd\xa0AFY\x07x\xe7'\xe3\xf0\x09\xc3\x83\xa6\xcbEbP\x1f\x15vH\x9b+\xceg|\xe1\x88\xff1wC\xfa\xa5\xc6\xb3\x0d\xb3\xd9\x14\xd9\xcaDW\xa2\xf6\xc1+\xd5\xfe\xb4-\x07\xbe\xa6]\xf2\x08w\xea\xb4\xde\xa1\xf2\xfd\x99\xbf\x950\x9f\x83-f\x92\x18c\xdd\xe4t\xa9\xe0(9P\xca\xf1\x0ew\xdf)C\xfe\x97 X\xd1]\xfb12#\xec\x10\xeaVj\x90<\xffy\xf1\xaa\xa7|\x87Z\xb2\xde\xd4\x18f\x9fLR]\x0a(,\x9d\xa2\xecLt\x9b\xb3r~\xf4\xf7\xd8\xd9\xcb(\x9e\x9cOr+\x90\xf0\xe2\xe9>\xd4\xd3\xa5\xea\xffV\xba\xdaaL\xf8`\xf4Cp[Ov\xf5\xbf\x85\xc3\x14\xb8\x8a\x06\xc0\x99\xbe\xd6l\xd1\x17\xcf2\xa5#\xaaN5\x0c\xeb8\"\x1ce\xe9\xefp\x15{c\\\x8b\x16\xbe0\xe1\xe3I\xf9\x8c\xe4S\x0d0\x89h\xed\\\xcd\xa4\xbc\x01\xf6\x1ax\x11\xc9S$;\x84\xb9{\x8f\xca_&Y\\\xac\xec\xcb\x05\xf6\xaeU\x83s\x8a\xeeQ|\xc4l\x9f\x02\xc5BC\x1f\x93\xceTmL\x8d\x84nI\x0c\xee\x10\x98\xfe\x09\xb7\xad{\xf0-\xd8r\xcb\x1c\xfb\xc3|H\xfdhJ\x88b\x12\xb0j=\x115a\x8b\xfeS\x81W\x7f3\x0b\xc9\xb3\xf6\x1a\xba\xbe\xd6\xd6y\xb2\xad\x17\x1d\x83[\xc3L\xf1!\x89\x06+\xf7}X\xc8\x8b\x01\xea\xc1\x09%=k\x8c\x89\xf0\xccU\x14\x96\xef\x13\xba\x9c\xd3\xb6\x06t\x94f\x16fL\xa4b\x93\xa5\xe6\x0a-\xa40\xb2\x00\x00\xd2e\xcc\x9c\xc9c@\xd3\x13\xa1\xd1\xddN\x05eB\xb6\xc9\"\x93jG\xcf\x8c\x13\xee\xca\x19\xae>\xd3\x0f\xa2\xf2l\xccjC<\xb8C\x95\xf8\xfdJ\x1d\xc6B@\xbe\\\x86+L$+n\xae\x07_CW\\\x11\xba\x92\x82@\x7f{\xe9\x02\xc3\x1ev(\x01\xaf\xaf\x8c\x9a=\xe7\xf7v5\xdc\x8c\xc7p\x9e\xfa[t\x9e\xa2\x9f\xf0D\x8d\x8ef\xf8!\xb9\xea\xc7\xb0\xfc\x90\x01\xc7aI\xdcu\xe8\x8b\x8d3d\xa8'[\x84\xaf\xf2\x07WJ\x10.)\x85 4PJ*\x83\xd6\x88\x14\x09%\xe2\xef1\x8e\xdbTw\x18\xeaDz\xd2\x90E\xc4`nJ\xbe\xb3C\xebxm\xa2G\xeb\xf6\x06\x0d4\x12Zk}\x1e\xdf\x9eh<H\xec\xae\x85\x81\xec[\xf1)P>\xba\x18\xccD\xe4fi\\\xad\x96\x14\xcb\x00\xa4`X\xc9\x03\x95\xbc\xa4*`DDIi.EC\x8f\xb7\x0b\xce\x16h\x84\x94\x9c\xcbh\xed\xc6QBF\x03AZ\x8c\xd1\xa0\x1a\"\x9b\xe7\xc4?\xef?'\xde\x07Kb\xf0a\xbf\xe0Ft\x12\xb5[\x98\xf7\xc5\xcd=\xf4pK\xd0\x02Yx\x80\x97\xa6-\xb6r4\xaa\x09\xb5\x8f\xffl\x19\x12\x85\xde\x05\xab\x91\x89\xa2~w\x0d\xb1\x11?\xe8\x009\x91A\xc6\x8b\xafe\xf5\xa4\xbb?YU\xa4\xcf\x0b\xab\xf0gU\x81H7P\xd5MI\xbc\x1d\xb3NMG\x1c\xff\xc8c.\xf1\xb2TO\x17\x85=\xdd\xa8/\xf4\xfb\x96\x90\xbc4\x1cFa\xb6\xe0\xc0\xa6\x92\xc5\xab\xbf\x09\x82\x0ee]l\x8a\x83A\xe1\x92n\x99d\xc0\x0f\xd9\xb4<r\xa2\x0c/\xaf\xec\x97[\xd7\xa5W\xb5\xa9\x8ex\x10g\xaaT\xbf\x8f\xaeE\xa3\x86\xc0\xb5\xa2\x17\x92\x86\xedta\xc0\xd5\x96\x13<Y-\x904\xf4_\x97 E\xe4\x9e\x13\x9b\x81 zG\x81\x17\x9a\x94\xe6i6\xd5O\xb2\xea\xa5\xe3B(\x1c\x93\x94$h\xa2\x1a\xde\x1d\x16\x97\xb2d\xd8\xe5\xb9\xe7|\xc4\x1e\xc6\x10\x02n\xef\x98\xdf2\xa1\x8b\x1d\xeeC6\x8e\xba\xd1w;\xb4\xca\xc9I\xc6eZ\xd6h\x9d\xac\xb3[\xa2\x15I\x8f\xa17\x1a*\x07;V\xba1N'6\xaf\x92\xa9f\xe2e\xc1\x9f\x1cj\x17fC]}\x0cg\xd2\xee\x16\xae\x04\xbd\xab\xb9\x11\x14\x8e2\xf7\\\x07;_\x8f\x93-b\xad\x01\xe13\x0c\xbb\xdd\xaf\x97\xcf\xf7\x19\x193\x022\x83\xaf]\x92\xa6\xa6\xa5\x92_*\x9a$\xa5\x0b\x86\xa8\x09\xab\x82\xa9\xf3\xd6\xf5&\xc1\xa8\x90\x8f\xd5\xc2@B\xb55t\x12*bW5f\x95J\xe7\x09\x9e\xc8I\xe1\xc1\xd7\xe2\xb5\xc6G\x18(lC\xb7>1\x07\x8a\xbe\x0c\xc9\x8f\xc0\xf0\xe8\x04iq\x87\xee\x81\xeau\xfa\x90~\xb8O$\x14f\xf0~q\xb9\xc2W\xc0\xc6\xc0 \x08o\x1b\x8d\x8f~\xde!*\x7f\xfa\xde\xab Tx\xaa#\xcd\xcbD+'\x9c{\x10K\x1d\xeby\xact\xe3\xbb\xd4\xfe#\xd3\x8a\xfb\xbd8h\x98\xea\x98\xbb\\\xd3\xf4\x9e\x90k\xe0%\xa4\xd8-\x82\xbfG\xb8\xb8\xfeI\xf1\xdf@?\xd7\x00\xb4,\x0d\xfe#k\x1a\xf0 /\xd3E\xc9\x96?\xd2,\x8b\x151\xb5\x1b\x1d\x95\xa2\x1c\xf3\x00D\x12\x11\x8f3zR$8T\x0d\xb4p\x0e\x1f\xf4\xbf<\xbb\xa1\xf8\x0c\xbf\xc0\x98\xf5bLO\x9b\xe5\x15PP\x97)`\xa4\xb0\xb2t\xa6\x84t\x87\x02\xfe\x9f\x12\xb8\xf9\xcd\xf5\"\xf5\xa3\xbaZil7\xc2\x1a\x86\xdd\x05\xd7{g\xf8\xcbi\x04Q\x8bNgr\xbc28C\xb9\x80o\xef\xc0\xd3\x03;?\x16\x8b\xcc\x14\xc9Fs-\x1as\xf2\xdb\xd4\xcc\xb3g\x99?/\x18\x85{\x8e\xb6\xdc\x1d\xf3>\xcf\x1eC\xe84\x8d\xd2\xe3\x8c\xbd\xcd&\xc4\x8f\x99\xc3\xb7B\x91\xfc\x08\xf5\xc0\x8aO\xfb\xfa\xed\xd5\x0a\xb6=\x09\x14%\x11\xa3`\xf1\xde\xa0\x81\xcfj\x1d\xfc\x80\xf1\xba\xcd\xe8\x9a\x9c\xa5\xaf\x1c\xcf\xce\xd3\xe8\xc3R8g\xd5\xd7z\xbdO;7.\x05\xb3I\xec$\xd7\xd1@\xddI\xa6\xdf\x19\xb8Pi@4\xaa~\x95\xa1\"LY\xe6\x1e\xdd\xe3T\xb1~\xe5\xa9\xa7?\xc5\xc4\x1e\xfd\x13D\x04\xb7\xae0\x0a\x0d]2\x0eyU\x9a[\x0f\xd4\xd8\xe7,\x94\xab}S\xd4\xd5%\x84\x08\xaf<\xbeqn\xef\xc8\xa1\xbd\xfc\xe67 \xb8f=9^\xe5\x93C\x137G\xeb\xe0\x14Daj\xa8|\xe3q" +
+	"u|p\xcd\x05\xab\x81\x8a\xba\x0a\xeb8n\x8c\xa3\xbfx9Z\xd0\xc1S\xc3X\x0a\xb5\xe8\xa2\xb4\x11\x11\xfaG*U\xea\xaa\x83F\xb52\x0c9!W\xaa:\x8e\xedX\x83\xf6\x1f\x87\x8a\xbe\xfajv\xc8?\xc4\xe2\xd2\x90d\xab\xba\xd1\xcd\xc5\xa2N_R\x1fY.\x0d\xca\xea\"1\xe1\x0b\xf4\x05\x8d\x94\xf0\xbe\\}\xc8\xf21:|1\xae\x9c\xf0\x83&\xda\xa8\x89\xf1\xd2R\xe4\xf0\xa8\xab\xb3F\x94\xf2\xfa\x16\xd2\xec\xc1H\xf9\x15/\x11\x1f\xe0\xb8Z\x06'\xe2Q\x8b-\xfc4FG\xad\xbc\xea\x88i\xa3\x0e1bLf\x8f:'\xca`\x83[\x9b3\xd4w#\x9aVw^>\x18\xf5I\x9b\xddP\xdd\xaa\x0b\x8cg\xfc\\[\xe2^=\x8b\xfdm\xb3e\xa9\xdevx2O\xbb\xef\x8c\xae=\xa7\x8d\x19\xfd\xc3Q\xb8\x03vK\x19\x116\xb6\xe2Mk\x0d\x85N=\xf4\x99G\xd1f&\xbf0\x0f\xb1\xfc\xec\xcf#O\x8fR\xad\x8e\x86\xf8\xd7\x88\xb3\xde\xc8,\xecY4h^\xe2*\xb1\xb3\xa6B\x0a\xd95\"MIjk\xd9\x92\x80p\x85:\xd9\x90\x95\xf5\xed\xc4\xc6$\x0e\xb4\xb9K\x93\x1dHN3\x0e\xb0\xd5~|\xb1ZV\xc6\xbb\xce\x02\xadO\xbe\xd7D\x89\xb6U\xa1\xbd\xe5!\xae\xf6\x9bV1\xd4\xd0X\x99\x82\xcc\xea}\xb3\x88\x1d\x8f<\xf3\x1c{\xde`\xa3\xa7R\xbc\xe5fz\x15\xc9\\\"\xe5\x14\x15\xde\xfb\xda\xd2E}j\x80\x8b\xc3t\x96\xd3k/\xd5\x8f\xbc#\x04\xda\xad\xe0\x08n\xd8wJ4r\x84\xa0{\x16f\x89\x7f\xe5\xd7P\xde\xd6\xc17\x1b\xf5\x95\xaf^oS\x84g\xec\xd0S\xd6f\x8d\xdb\x8d@S0'\xf8\xd3\xc4\xcf\x00\xfdY\xfe\xc1\x97\xb3,x\xcc\" \x0a_l\xe5~\x01\xc7\xce\xab\xaf#c}\xf2\xd0\xa3k\xef\xd9\xfe\xce\xf7D\x0a\xcc\x19@)\xca8\x9b\x0d\x9bWD\xfc\xf3\x97hn\xbf\xfa\xe67\x86\x19h\xeb\x98\x83KffW\xa0\x14y\x93V\xedE`\xa2r\xe1\x8f\xbe\x0c\xedq\xe6\xef\x94\xac\x95\x16\xb1$\xb4\x82\xf8\x93j\x96Y\xa6\xae\xb0\x9e\x9f!.h\x07\xeft\x13_\x7f\xb5;\xe5\x9d:!\x93\xf1n\xec\xb5\x1c\xda\x8a`\xbf\xc5'\xd0m3\x15p\xa6\xb5\"\xcc9'\xe13h\xa1\x81\xeeL_\x92\xa2\x16kH\x8f\x0aF\xe79|\xb4~\x16\x17Fsb\xc6\xa0_\xd5\xf7\x94\x0d\xcd\x12\xd9\xed\x80\xde\xbd~6\xbb|\xe7\xd1\xf5\xd6\xd7\x82\xc0\xee\xe2bB\x92\x18\xa7(!B\x0aXg\x15\xc2\xa2\xc7\xe5\xa8)\xc5{\xa2\xf69\x1a\xe4|A\x09)\xca\xf0\xba\x05\xef\xcaE\x9e\xffk}s\xc0\xee\xcb\x89\xed\x8e{\xb8\x00G!\xe4\x1a\x14\x15\xa7M9\xf30\xf9\xafx\x18\xc6-\x849\xb6\xa3\xff\xbd|*?\xdd\x96G\x81n\xf9\x8a\xb2\x8e\xa1\\\\\x11\xe62\xa4Kp\xcbiFux\xec]\xed\xba\xa53D?.\xbfuR\xca5\x93L^\xb8\xdc?\x96\x9aX\xa6\x0f|\xa1\xf4\x81\xff\xcb\xaf\xff\xe3\xaf\xff\x8f-\xda\xc0ymO]<(\xd9\xf2%\xca\xcd\xe1\"\x84\x05\x91\xed9\xde \xfa\xb9\xf4\xf6\x13\xe5=\xa5\x06\xfc2x\xf3\xb3\\9&\x0f\xecSfR\x0b\xa9H=F\x7f\xad\xabbJ2\xad\xbf\xa2>I\xa2?\x93\xbc\xbbQ\x9bQ\x18\xdd\xe4\xbd\xb4\x03\xd3N\xa5\x8e\xde\xe6\x1c\x9cu\xe1\xc9\x84\x9bP\xb7LmISm\x9c\xea\"\xd92\xff9^\xceat|\xc6\x09\x1dX\xda\x9ck\xcf/6\xa7e\x93[\x1aT\x01\x1c\xf1\x83\xd3\xa7\x08+\xf0\xcf\x86P\xa6\x1f8\xcc\xa8\xdd\xc1\x15Hd\x7f\xfc\x0d\xfa\x1en*\x08\xc6\xd5\xdd\xb9.,\xffY\x07e\xccY\xd9RS\xf2\xbc\xeb\xea\xa3\xd0u\xc9\x12\x98\x93k\xdb\xdal|\xc2\x8b\x1f\x13\xce\xb2\x18\xbf(\x0e\x7f\x06\x8fS\xf9\x17\xff\xb7\x1b\x13\x8f\x9c6\xd9\x1e\xe7\xeaSw\x14\xa7\xaep\xc4\xc7\x1a\x9d\x846`I\xacC\xc2L\x16=\xbe\xbf\x10\xe7\xe5\xeaH\xbdT=\xaa\xbc\x19\xb4+yU\xc7\\\xc0!\xca\xf1_\xe1n\x81+L\x13R\xb5\xe4\xd8\x1f(sq%t\x03\xae\xfd\xe1\xfc\xe3)\xa3\x82\x10O\"\x1cq*\x10qY\x1c\x1e\xaa\xce\x0dGC\xa3\xbeNs\xf6j\xf5\xdd\x98\\\x16U\xe3\x0f\xb1^\xf4\xc8\xb1\x0fzr\xc2\x13!i\xe9D\xbe=e\xf9\x12\xf2\xeaD\xbd\xb4\xea@}5$\xb1t\xc5\x81\xb7R\xf5\x1b\xc8\xde6RS\x0b\xfaQ[\xaa\xc7\xaf\x97\xde6Yn\x8a\x03\xdfv\xac\xda`\x9e\x86\xcf\x90xx\xa2|\"\xd1\xf3%oC\xc97\x8dVi\x8e\xf3\xb4XC\xcb\xdd\xdfznA\x81\x0e]\xa0\xc4Vb\xda\x0d\x94;\x0a;3\xfe\xe3L_\x8cuH\xdd\x9eT\xd0\xf4\xc0\xa5:V\xa2\x98\xf2\xe1\xc7EE\xa4M\xd2>\xd6\x91(y'\x8aBx6\xf0\x99\xf1\xa8\xc1H\xed%\xa4\x0d\x1c\x84\xa0\xc0\xd9\xd55 \xac5Q\xc7\x9f\xf2\x8a\x0b*\xa0\xb20p'\xa5u\x9a\xa9\xe6\x93\x14.\x12\xbd\x00V4\xc8\xfb>\x80\xdfA\xfe\xb7BRM\xbc\x0c\xb9\xce\x9d]\xa0E\xed\x88\x83\xe0\x9a\xba\xa3\x0b\xbd-\xfe\x940:2\xd2!\x0b\xf8\x85\xbel\x0d\x12L\xd6\xfd\xd0\xa8\xc2l?\xfa\xdb\x14\x83r\xf5-\x0a/\xd9\xbd\xfd\x1fU\xc5\xb5|\xe78\x19\x8f\xc2\xd2\x8b\x02\x17\x94\xccy4\xc4%2\x93\xc2&w\xdc)\x0a6L\xe9>\xac,\x8b\xbc\xe6{\xf0eA\xa4\x8a\xf4\x8a\xe1\xa0'\xc5A\x8e\xfd\xc5\xf5-tG\xcb\xfa\x968\x0f\xdce}\xf8\x0b}\x9dR\x9cqX\xd7\x91\x93\x08?\x15\xbc\x14\xee\xcb\xfb\xde\x94\x1e\xb3\xf1\x1d\xd4D|20\"%\xcc\xd4m\x9e@\xe5$\xf1\x94\xf3\xb9\xc0\x83\xa7\x8bD\x17\xeb\x8c\x9fxS_{\xcc\x98C\xf7\x08\xad\xa1<\x13rFvZ\x1a\xe8\x92\x9d2\x0d\xa0\xd5Q\xdb\xebK)\x1e\x9fM\xf4\x15\x0e\xaa\xdf}d6J\x1c\x19?\xf9\x06\xc8\x7f\x96L .\xe5\x1c\x04p\x85\xc1o[\x0d\x9ax\\0\x8d|#\x05_\xf3\xa7\x0f\x9f\xfaj\xebt\xa3\xf8\x84\xcf\x0b\x0d\xa5\x81?\x82\xebq\xc9j/p\x85X\x881G\xe8\xf7\xe0\xba\xc8\xfaq\xa9\xb0\x99\xcc<g\x8dzb\xaf,t\xa6X&Gd\x01T5\xb9\x88i\x8a\xf1\x13\xc4\xe7\\\xa70*\x810E\xe1\x09\xae\xd4I5W\xc23\xe0\xd6o\x15_\xe0\xee\x18\xf0\x12C\x9d;\x88\x16\x7f\x0c\xb7\x7f`:\x15QZ$#G\xba\xd5:I\x8a\xd5\xd2\xf8\xce\xb5*q\xbe\xe1\x97\xac\xe0(>\x8e\x9d\x09c\xf8\x95\xbe\xe8/\xaew\xe1/\xf8E\xc7\x1fp\xccc\x13J\xd8\xa9\xfe$\xb2\xf1\xa19|m\xab0\x0f\x91\x12\x09C\xfa\xe1\xd2u\xa8:\xfd\x96z\x8b6T\xc7\xe8\x8e+\xaf\"\x9ab'\xf1B\xdd\xfeE\xd2\x81\xc6B\xa2t\x88\xef\x87\xeb\xdd\x99>?x\xb5\x06G\xf2\x99k\x18\xdcm\x07KbR\xc0\x95\x86E\xa4\xfaG\x19\xf1\xe2t\x9aJjg6\x88\x91\xc5\xc1sC\xaf\xb81\xe2\x9czx\x84\xbb\x9cL(\xa0\xf1\x13\x16YE\xe8\xaf<\x95\xc6\x80\x82{\x80M\xda\x9f0\x01@\xdcu\xbcm\xa0\x11\x13\x8e\xf7S\xbe\xa8yiP\x14\xe9o\xf2\xad5\xca\xda\xd9]|\x7frxAs\x8de.}[\xfc\x04\x17\xf8\x039d\x0b\xbb\x9a\xca\x86L\xf4B\xc8\xcaV2\xfa\xba\xbax\xdc\xdc17\x14\xfe\x00]\xdc\xf1\xf5\x8f(xqH;F5\x9c\x8e\x91\xa7\x8e\x97n\x16\xc5\xc3Af\xdd\x94C\xe9\xa0\xf3W\x06\xc7\x1e\x13\xedr\x1d\xbeY\x80\x92\x12\xf5\x87\x1f\xaa+\xc3/\xd9Gb\xb3)\xb2c!,\x93\xaf\xa8GMMnv\xeb\xb7H\x12_M$\x97\xfb\xca*Np\xec\x8e\xfc\"\x18\xb9\xc4=m\xa1\x0bt\x0fR;\x14\xf7><\x85 \x80u\x15:\x82\xfa \x8d\x89\xc7\xbf\xabG\xd2^\xfc\xcd\x15\x87{\xb0R\xcf\xf8y\xb4$\x1d\xb7\xe2\x0e\xb7\x10k\xc4\x9f\x8aw\xe3\xd0\x14\xa5\x99\xf8\xb4\x00\xe3o?\xb9\xb2\xdfA\x0f\xf9\x9e\x8d*\xb5`\x16<\xdfXwA\x14L\xe8\x8bd\x95\xbd\xb4.\xc4\x0c.\x9b|\xb8\xd4\x1f&&\xdc\xd1\xba\xf4\x89^\xe8\xc4\xa4&2a\xf2\x7fv0;N\xf4\x94\xf2]J\xffB\xe3\xebl\x87\x19\x9e\x18\xeb{\xc9\xdb\x8a\x1bW\xb0\xbfX\x0d(\x19\x9cU\x8f\x99B\x9eV\xbcB\xf2\xca8{3\xf2\x83\xa1\x87\xfa\x8c\x9fGc\x10\x84\xad\x80\xfe\xad\xf3\xa4\x0c{\xca\x08\xe7\xf0\xc1\xc1\x9c\xd0+\x9c\xd1g\x06\xfc\xc1i\x9eT\x86\x18}\xc1Z\xd1\xd5\xd3\xd4\x91\xd8\x91\xccB\xbf\x84\x97\x01|x\xf3\xec&\xa4\x95\xc0\x89I\xde\xc7\x01\xdaD\x13\x02\xff7f\xa6I\xaf\xc9\x83x\xb8\x91\xecDlZ\xf2/\x93\xda\x93\xdc\xf3\xfcG\xdd\x08\x16O\xca\xb4\x93\x12W\"\x19\xa1\x17\xe8\xc9\xc7\xdf\xd6\x17~\xb6\xecJ\xb8K\xe3\x06)\xc0\xe5\x90K{\xf1\xf5\xc8^\x85p\xca\x13\xb77m\x98\xea\xc9r[d\x99\xc1+\xaa\xb7\xb0\xb8\x9b2\xc9\x8a\xcd\xf8\xc5%z\xa9\xc0\x9e\xee\xf0\x8d\xb5&\xf55\x92\x07\x9b\xcc\xf7p\x0e\\\xa1\x97\x05V^\xb7\x12B\x1fKQd\xbdi\xb6!#\xed\x93\xf8\xd5\x84\xee\x8b\xe7\xf5p\xf5\xe7\xf0\x11\xab\x1dFN7\xc9\xb6\xc4\x01n\xa5\x8a\xd8\xcbf\xf5l\x85`\xb7\xea\xad\xc1\x10}\x16\x0a\xaa\x05\xa1\x03\xc2z\x8c\xb4\x91[\xe2\xda\xd3$\x15O\xf1\xff\xb8\xfd\x93\x0aa\x0f\xa3\x09\xc4\xa6\xf1Wv\xbfg\xd1\xfc\x92\x8b\xc4\xb7CLc\x8b\x17\xc0\x85\xe4\x10\x15\xc6\x8f\xb3\xe2\xf8S6\xeeT%\xdf\xbeqTTc\x826IK\xec\xd4\xfcC\x98\x8a%f\x16\x8d\xfb4gj\x06U\xce\x95\xc9\xd3H\xc0\xe4gd\x940\xd8\x06\xb9\x1eV\xf0iVI\"\\rv\xe4\xb6\x89d\xb3lY\x9b\xc2\x89t\xd5LE\xc2\x93\x87!\x97?\\\x0e\x1f\x02\x87\xe19\x92\"G*W\xa4\xba\xfe\xa7\xe2\xf0\xa3\xa4i-\x86\xdb.\xb0\x93T\xc7\xcf\xe4\xbf\x18\xb9\xa7\xd5\xe4\xe7\xb3\x17\x9b\\\xd1\x99\xd6 \x9b{i\xdc\xd4\x0e\xc0\xf0\xffa\xd0\xc8[M/\x9c0X\x94\xf92X\x83D\x8f\x08\xb93\x93%<\x9eb\xbd\x7f\x8c\xa5\xc5\x15\x12\xcf\x96?&A\xb5u\x90c$k\x9c>\xf4\x87H\xd9\xcdqK\x82;\x1d.\x91\xa4\x9c(\xca#\x03J\x04W\xd6\x8c\x8dC\x17\xb5\x94\xadt\xeer\xcc\xcc\x97\xf32H5_\xb8\xc0\x96\x0c\xea\x0a\x0d:\xef\xe3n4\x9c\xe3\xbe\xbd\xfb\xa6\x96?c\xb2\xda\x80\xf3\x98\x18\x11\xfb>\x8a7\xf2\x9c\xc73T\x11\xa0\x13aI\x1e\x95l\x90\xe7\xe7\xce\xe5\x16\xb7^i\x85\xd2\xfc\xc7\xaa\xc7\x18Q\x82TA\xd2\xac\xf6\x02\xb73}Ag\x9a\x91T~LY\xc9{\x07\x91\xb1\x02\x09#\xcf\xfc\xf5\x03\x85\xb1'*`\xe1|\xd7\xfd\x9f\x02\x0d\xa4H]\xf1K\xbfk\xd2\x099\xcd\x01h\x96\xf5\xc9>\xe9\xc0\xd4\xb4\xc6\"r\xe53\x96\xe5\x8f\xde\x9a\xd4)%\xcd\xcf\xa8\xd8\xee1\xbe\x9c\xd4\x98g\xa5\xd8\xc2W)\x87d\xc5\xe6\x12\xee\xae\x99\x1e\xc1\x08\xac\x0b\xcf\xc5v\x8e\xf8\x9bm\xd0\xd4\x07\xb8CW\x13\xca4\x8c~\x8c\xaa\x90\x08\x81\xeb\x9c%\x87[L\xa3\x191\xc4\x1d\x8c\x85\xd3\xc1\xf4-\xc3X\x04\xa0\x96,\xdf\xca\xbe{0\xfc\xca\xbc2SCX\x0d\x1b\xaaYF\x01\x84D\x84R\x18\x03\xab\x01`\x9c\x85\xd4q\xf9)\x9bDx\x1dNP\x96\x0e\x1e|\x0d[\xcemJQ\xb8\x98F2K\x9b\x9b\xdb\xdeE\xea\xbf\xb5\x84\xc3\x05\xeb\xe3vn\xe6\xc1#9\xf3%Br\x1b\xdfF\xedf\\\x1f\x0e \x80=\x01\xc9\x1e\xad\xd4\x15N\xe795\x18\xbc$\x81V0\xc8\xe1\xd0\xc44\xe3?j\x19/i\x9d`Yv\x09\xf7\x82\x09'i{\x8d\xe4,\x97\x8c\xb2\xf5\xcd\\\xce\x91m9\xcc\x87\xce\xf2F\xe5\xa03\x12\xa0\x97z\xe1\xd8\xee\xe1\x95\x95\xecK\xfck\xc1\xce\xfaF\xf4S\xc4\x9cZ2\xd8\xb7\x86\x83\xda\xab\xff\xbc\xfdp\x9b\xef\x93\xbe\xbe\x1dC\x99\xbd\x13U\x13\xac\xb0\x84\x8a\xecJ\xb5\x95\xfe!\xa1\x95'\xeb\xe2mL\xb9\x19QAF5\xa3\xf3\xb8\xb0\x0c\xa0\xc0\xf4V\xb3<\xdfH-\xffN\xdd\xcc\xaf`\xdf@E\x85H\x18\xcai\x09\xa3D\x8e\xc9\x9b?d\xea\x13\xd8\x8e.\xe3-k\x85\xd9\x03g1\xad\x13\xf8o\x9a\xeb\x13\x14\xa0\xd1\x8bL\xf6:\xb5\xd1\x15\x93\x82\xc1+\x0a\x10\xde\xdd\x8dy\xf1h\xc9$>\x91!<\x95\x06au\x8a\xd1\xfc\x0d\xa1\x1f\x9a\xeed\xfc\xa0&U\xb8\\E\xc4\xe2\xaeh\xaa[\xd5/ k\x9e\xa4r\xfe\xa5\xc3\x98\x94E\xff\xcf\x16\x1f\xefU\xd2\x7f\xfebs\x87\xe5\x15\x01}%\x8e\xdbD\xacs\xbeG\xde}g\xb7\xe3\xc9;\xa1\xb3\x8aL\xce7C\xddKQ\x88e!%\x99\xfcW\x17FU\x9f\xab\xa6\x96\x0e\x9f4V\x87\x0a\xdb\xca\xb6T\xfe\xf3\x97{\xb6\xe3\xd6\x09\x0cGx\x11U(\x11\xfa\x112\xda\x85\x83\xecr\xf8t\xc7h\xe6.l-\xa5\xc3!\xb2M\x89\xb8Q/ \xb0\xa8\x96\xb8\x8bp\xfe\xb1\x97\x92\xd3\x1dg\x84\xf0\x11\x81\xdf\xde\x09\x80p\xd88\xe3\xf8\xc4D\xff\x17,\xa2T!t\xab#R\xbe\x9c9r\xae\xd8\xd6\xf8'\xd9lc\xc3\xd7\x96M\x19C\x91Z}\xc3\xa3X\x99~k\x17L\x8e7\x8b\x00\x865L\x13\xa2z\x8b\x03\x13\x0fG\xa4\x8f\x05,F\xc9\x1e\x8a \xcbE\x0d_3\xaal]\xe0Ro\xce\xdeD%\x0bZl\x9fnD\x92\xd9\\5\xdd\xe6\xee\x0e\x9c\x94\xf1\xfd\x15\x8e;\xa8\xf2\xd6AS_\x00\x97\xe2\xf2\xb1\xdf\x84\x96*\x1d\x82$\xc9\x96a\xdfu}z|\xf3o0=4\xb8c3\x89\xb3\x13\x07/uX\x998\x89Z\x07\x99\xc0p\xe7:\xab\xb8\xa4u\xf0K\x8d\xad\x96H\xea\xd8j\xf9X\xc0\xc6\x0e\xd7\x8fi`\xe2K\x08}^|\xb5\xa0\xd9\x87\xf6=\xa7-dsF\xf8CD<\xab\xd7\xacQh\x06y\xab\xe7\xac\x9c\xc9\x14\xf6\x89t\x9aT\x7f\xfbOi\xa0H\xc6\x02\xda\xd5X\xcd\xc55\xdf\x9c\x05\xd8\xf2j\"3\xde\xf7y\xa5/\xe7\xc7%\xaa$\xb9\xbc\x1b\x14?\x85\xfa\xfb\x7f\x17\xc6\x84\x7f\xc2\xdfT\x1d \"[T\x09B\xeb\x8e('_\xe0\x16\xff\xd7\x92]\xe2\xb3>\x04\x9b\xd9\xae\x8b\xba\xb7X?\x82s\xb2[\xef\xf4,\xc9NDv\xb7\xd0J\xc6\xc27rja\x0f\xf30\x80\xa9;d\\NpOZ\xe8~\x01\xcd\xd4\x18\xadz\xec\xb4\x1b\xf4\x96lnN\xc1\xc1$\xbb89\xbah\x10\xa2\x9f\"\xad\xfd\x17\x02A\x98\xdc\xe9\x04\x04A\xbd\xae\xf6m\xe9\x07\xba\xfe\x8efJ\x0cY\xd5\xeeR6\xec\xacx']\xc4\x0c\xb5\xdfRy\x15z\x0d\xd8\xe6\xe4\xe5F\xbdE\xa2(\x9b\x05\x12\x92\x02\xd4\xbc\x0f\x90\x08O\xb7\x17n\x14K\x13\xd0z\x1b\x1f\x9c\x7f\xac\x838\xa3G~\xb6T\x0b\x92\x82A\x8a\xf9\xe3\xfb\x19@\xa0\x91\xd2+\xd6\xe1\x96\x9a\xcc\x88!F1z\xe3_&\xee\x0b?\xb6\x119\x9a}\xc6\xc1\xdd$\x87\x8c\xd6\xf5Vg/Y\xae\xf7\xaa\xefRu\x9d\xb4\xdf\xd1+?\x1b\xdf\\Z\x15>\xc2B\x80\x9f/i\x884\xaci<G\xfd\xb6j!\xef`P\x9c\xdd\x8b\xe5\x0e\xf9\x8a\xa3S\x84\x89\x89\xa7\x1d\xf8}U3[\x0f]\x81\x95v\xb5l\x04!;Cu\xf7\x8d\xbfeCs\x19\xd8go\xc3Ku\xb8C\x96z\xd3\x87\xeb\xb1x\x89>?x\x06\xaeL\x02 \x05\xd4\xeeB\xb5K\xbe\xaf\x01\xbap\xec6\xcb\xe3\xc6\xde\x0f\xb5\x8fT\xfd\xadtF\x8a\x03F\x07\xe7\x06\x0d\xce\x07AiG?\xd2\x9b\x98\x09\xcbF\x0bV\xac\xbe50 \xd4\xba\xf4p.=P\xe0\x9d=\xf3\xb3\xa6wS\xea\xc0_\\M\xc8\xb6\x83\x04\xb9\xb5.\xb7H\xf4a#\xfe\xd6\x98\xae\x05;\x18\x9f\x05\xa7\xfd\x04\xa3\xb7s\x13k:\x06\x05b\xd3j\xa9tq\xd0\xb7s\x16\x93^W=\x0c;H.\xa7\xed\xff\xdf\xdeI\x92 \xd6H\xd4\x12\x0c\x946g\xdb&\xb4s\xe4\xcb~R\x843k\x1f\x9f?\x92ZF\x89\xa2uV\x97\xad\xa5\xe3\xeb/6\x19\x114\x9f\xa4\x9f\x9f\xac=G\x84Nm=\xca\x8a\xf5\xfa!.\xb2\x9c\x0b\xa7dJ\x04\x82\x8f\xae\xc9Kk\xda\xaa\xf5\x0d\xcc\xc4$c\x9a\x03w\xa1\x8d\xe7z\xb9\x12\x1bF44e\x16\x06\x0c\xb3\xe7\x95\x88\xf17\xc4\xf6%6E\x98\x09\xb5\x8f\x90\x07U[\xc4" +
+	"\xe1!\x95\x0a\x1a\x0b\xd3\x01\xa2\x95\xa5S\x9f\xa9t\xda;\xd2a\x92\xfc\xc8\x00\x10\xb6\xc7p\xf2\xf6&\x1d\xf1\xbe\xaa7SJ/\x93\x80\xf2\\\xf86\xbc\x8b\x06p%\x04\x94\xea\xaa\x1f&\xebs\xc8s\x91\xfe\xe2\x03\x8c&\xc5z\x88\x96\x1e\x07\xd9\x19#\x92\xd2p\xa8\xa3E,\x9f\xa2\xd0\xdb\xcd\xd14\xf4\x0a\x98\xa3\xb6\x0b/-6b\xb0\x94>CQW\xc5Vu}\xa4\x8e#z\xcc\xc47X\x89\xa2L\xa2\xe8&\x01\x8ai\x97\x16:\xeb\x05\xc8\xabu\x1f\xe1\x0e\x08\x00\xc0#\xba\xe3\xf4M\x02\x1c\x07\xd7\xc1m\xce\xb6%\xf1\xe7\x18\xae\x958\x93\x92\xcf\x8a(\xf4\x9b\x0c\xceS\xfakX\xf1\xaf\x91J2N\xfd\xf9g\xdcA\x83E\x02\x85b.f\xc0\xad,\xdcguF\x9d$\xafB\x1b\xc9\xdch\xa0\xd6rk\xdc\xbcf\xcc\x8a\xde\xdf\xf5\xca|Av\xd1\x06P6\xfa\x9ffZ\x8c~+\x0eB#t\xd4ls\x0fW#B\xd2\xe1\x9fw\x94\x8d\xbe\x94\xb2\xd1\xbf\xffw\xbf\xfe\x97_\xff\x7f\xbf\xfe\xd7\xdfX8\xfa#\x838r\x04\xc7\x892\x92&\xf6\xab\xce!\xb7]A\xd9\xd93}E\xf7\xa9\xbb\xfa\xde\x0fT\xd4\x18\x0ek\xe1#\xb4\xac\xedq\xcc\xab\xdc\x07x\x84{\xd8\xde\x86\x80\x93\xa6\xaf\xe2 \xf0\xabcQz8\x15\xa3\xbb\x92\xc1\x89#\xe4I\xf4\xa9Q\x84\xb3\x16\x1e\x12\x06\xa3`\xb8a)\xaa\xb0L\xa1E:u\xb8\x0e\xefE\xf5\x98\x12\"\x13\x96\xa0k\x1aTk&\x8bQ\\h\x18\x119\xfb\x84\xa4\x93\x0db\xb5\x06tf\xc3\xcd\x94E\xa4\x91\x90\xb0n\xdd\x04$\xc2\xad\x13\xaf\x9a_q@\xf0\xc1\xdd,\x84\x09\x97\x9d`\x9ef\x8ab\xbf<1\x9d\xd0?\xaa.HTb2\xaa(\xef\x0c\xc1u\xb0n\xde\x95\xb8\xc0\x09\xca(\x01>\xa5M\xa3\xfeK\x0251\xeec6\xb6\xdf\x9a\x02V\xdc\x08\xe5\xda\xef\x1d\xe8\xbe\xd1]\xb9HJ\xe8I\x09\xe5\xcb\x84Fu5\x82\xbf\xb9\x12\xba\xe3\xdb\xcdbQ\xd5\xf4\x9b1\xf6\x09\xb2\"\x8d/\xf7\xb26\xe81\x11\xb6\xd1\xdc\xb2^\x01\xc3\x11\xc6gH\xfc+\xea\xca\x040\x8b\x0dH\xd7\xe0\x9a&\xc3\x1aG\xd3t\xbbn4\xe9\xced\x8b\xa0\xf4{Bq\xc5\xcaP\x96\xd7;|X6\x8eL\x93\x91|\x8f\x12\x1e\xc1\xe8\x0dt\x0b'\x0f\x8f\xe0\xc6(\xeb\xd1\x95\xe4\xb0\xb9\xbb?\xcd]H\xf6\x9f\x0d\xbe\xa2Y\xc4\xbd\xdc*Q\xe0\x1e\xfcM\xf9\x98\xf1\x1d)\xbe\xdb\x101$\xfeN\x04K\x9c\xaf\x1df\xaex\xb6W\xbeq\x9dAw\x85\x07\xa8U\xab\x14\x0fO\xa8\xc8\"j\xae\xbb\xde|\xe7\x92\xf0PR\x1c\xb3Y>\xfa\xcf\x0b\xe5\xea\x96a(sv\x8a\xf7+\x90\xd0\xf9\"gL\x05\xa4\x17\xfdM\xeaW_\xcfU3&\xa9X\xd9\xb5\x0e\xdc\xb0L\xeb\x89=C\xd5\x01w*\xa0\xa1v\xa5{\xfa\xde\xd7\xe4\x96S9\xe8#\xd4D\xec\xcbGrU\x88\xabO\xc5\xc1VgM\x0a:K\x87\x09[A\x0e\xe2\x03\xbc%G~Io\x93\x19y\xb6sO\\Q\xbc| /aq\x82\x0437\xac+\xae\x83\xc1\xa0\xf2\x91\xa6\xd9\x1a<+\xba\xc6\xb9\xfb\x96\x9bU\x0c4\xdb.?U\xfe\xbbS\xb2\xc1\x90\x1a\xbdkkJ\x8f\x84\x900\xf6\x03\xfe[\xc2\xd1\xd7\xb0\xa3\xd6x\xc8g\x0d\xf83\xc4\xbb\x01\x8f\x7f\xc5c\xeb*\xc9\xc2\xd2\x83\xc2\xc8\x84\xb9tFXoj\xde\x10\x8a\xa1b\x93V\xe8\xee\xa8_o\xfe\x81\xae\xf8\xa4\xb0\xa5\x0fu\xc5\x15\xc8\xfc\xe3v\x15\xe8\xb7\x86\xae&\xa6\xe8\xa9\xcb\xd0\xedk\x1c\xe8\x1bE\xeb\xecLw\xe3\x9fY\x8d\x87\xb2\x06!\xdb.\xc50xXGc\x9a\xf1)\xe7\x19)\xca\xa2\x9cr\x0d\x14\xa5\x0b'2\xe0uaJ\x87>\xee>B\xc7\xb1\xe1\x07\xb8\xc5\xd8\xc8\xda\xda\xc0g\x15x\x82\x1e\xc9\x88\xee\x8f+\x98\xf7\xa7Og5\xb0\xd1\x0eH\x05hH\x90X\xe3E\xf87p}\xd1B\xe7&\x98D5e\xe3\x96\x8e\xc5\"]qX }9Z>ac\x9d\xc5#\xee\xbe\xe3\x95\xa5\x94\xa2\xbf\x8cLX\xf9y'P\x84\x10LT\xe6\xba\xd8\xfe\xd3\xb0j\xe9\x981:\x9c\xec[\xdf\xebsh\xea1\xfc\x91\x0f\xcaS\x15\xa5\xab}\x8f\x1eR8\x8a\xa8\x80\xf5Q\x0e\xcc\\\xa7\xfa]\xc7\x84(#e\xc7\xd8d\xa9\x8eC7n\x8f%\xdcv\x96\xddR\xb2\x00\x17\xd6\x039s\xe8\x00\x14\x0d\xf8\xf1\x8d$\x09\x1c\xcaa\xf7'\xb4X\xf0\xabD\xa9\xd4\xd6u\xbe5l \xe0\xba\x02\x06\xc7\xe8Q\xac\x84\xa2\xe6%tW\xe2p\x84\x1b\xd0\xc2\x91\x00\xe9\x98\xfc\xe4\xea\xa2\x03x\xe5\x92\xad\xe4@#\x8d\xa4B\xd2\xd6BUJ\xf3\x1a\"\x19\x93\xf8\x8d\xf114\x93\xc3\xb1\x10\xb8Cs\xc6Obu|\x84\x9e\x8c\xdd\xd0\xae\xf8\xc86\xe8j\"\x00\xbd\x13\xc9\x1b\xdd7\xec\x91\xdb5'O\x9f\xa7\x04=\x9ain\xd9i\xd52F\x19n\xe6\x18\xfa\xa3\x1e\x13!J\xb8w\x95r\xa1\x11\xb4\x12\x09\xeb\x99s'\xf0\xc2EB\"\xb5cy;\xc3h\xedF\x8flj\x7f\xe5\xa2Z\xdc\x7f\x8e\xc9p\x8c\xe8Z\xae\xdf8!\xa8\xb8{d/\x91\x97\xd7\x1e\xc2\xde\xbe\xb0\xf6q\xec\xb7G#=\xb8\x9bi\x05\x86\xe2Y\xc5\xa1\x85;\x9c\xe1\x0e\xc5gh\xc0\x87\xc5a\xa8FwwCQ\xfb\xc6\x8d\x05\xf4nG\x01\xed\x9f&X\xad\xacr@\xee*\xc1\xb9\xf0\x80\xe1\x05\xb9f>\xdc\xdb:\x1bx\xd0i>\x18\x16\xec\xe3\xba\xea\xa8\xca\xe0^\xf5\x18y\x0e\xd7\xe2\xd9\xe2LT-Y\xc3m\xf8',\xb4\xc3#\xc9\x08\xb7>\xa3\xf0\x99\xfajE]\x8d\xa2+c\xf8E(^`hZ\xb4\xf0!:\xa1;\xd8\xa5\xfe\xff\xcc\xfd\xdb\xae\x1cI\x96%\x08\xfe\x8a\xe6\x93\x9b\x01f\x84\xf3N&\x1f\x08\xb2X5\xe1\xc1ddt\xd2\x0b\x83h`\x1e\xb6Q\xd5\xcd\xe4\xa8\xa9\xd2BU\x05\xc4\x89\xb7*T5z\xea7\xe6!\xd15\x9d]\x93=\xd3\x18\xcc|\x89\xfb\xdf\x0cd\xdfd\x8b\xe8\xf5xxt\xcf\x8b\x1d\xf2\x1c5UQ\xb9\xee\xcb\xdak=\x9e\xf6RB\x7fZ\x9aT\xb1\x99\x12\xbf\xe8\x8da\xc7\x1f9\xc0\x1f\xc3CvZ\x1cSuWox\xd7\xf7\x0f`my\xfc\"\x19j\xa1\xc1\x1a\x11f\xd0\x1f\x1a\xdf\xb7\x86 \xb7\x14.V\xa91m\xa0\xbe\\}\x1dYOl0\xe6\xcc\x02\x11\xf8\xdd\xfd\x96\"\xcf\\\x9cq\xa4\x02\xc8a\x05\x9c\xd0\x1b\xf4\xcf\x1f\x8f\x813\x09\xea2\x8b\xca\xbb\xda_\xa9\x10\xe2k\x97f\xd1\xd1\x87\xee\xab\xfc\xec\x83\xa1`\"\xfd\xf0uh\xcfgw-v\xf1\xcb\xfb\x8c?O.\xa6\x84\x00\x9fSPG\x82<\xd5\x92h\xef\x93L\x92\xcan\x08\xd0\xe3F\x0f\xe3\x9b\xed\xa9\x16\xbb\xae:`\"\xdf\xa9\xe32\x9e\x08y\xae\x89\xdc\xbe1E\x8c\x15\x83D;K@\xcbaK\x0a\x03\x82\xca\x90S\\.\xb9\xc8dC\xc9\x04\x9a\xb4a3\x0b\x0f\x18\x13*\x8a\x85\x1a\xc4\x16\x8cd\xd1aD\xa3\x98]\xa3r1'\xb0\xdf\x93\x1c\x06=(\x8fBp\x0cb\xb789_O\x8a\x1d\xa3\xa5`\xe7\x82\x1f\xa6\\2\xee\x8c\x0d\x15\x96c\xae\xda\xf0\x04\x948,\xcea\xbaC\x94\x02\xbb\xc0\x02\x0d\xca\x93\xc7\xcb\xb7\xa4\x81\xad\xcaH\x02-\xc1\x0b\xe8\x0f\xebU\x84\xd37\x9d\xc1\x90NSw\xc3\xe0&\x1d\xbdFs\xa0|l\xc4![qMg\xa8\xa3c\xb4Q\xcd\xef\xc4Q\xe78\xb8?\xf9\x8e\x17\x87\x9eX\x1bj\xffL\x91\xd54\xc8d\x9c\xd3\xddP\xda\xf6yV\x8c1Q\x0fnD\x801V\xda\xfe5e3#_\x03}X\xdf\x00o>\x91\xd1\xee\x9aqO\xfd\x9a\x97}\xbe5:9E\xa1\xfap\xc4\xc5\xceD(C\xfe\xef\xcdH`\xccdn\xd3k\xf3\xeen|}\xe7\xfe\x92(y\x1f\x8a\xbf\x84\x09\xc4\x1c\xe6\x16\xb1\xa2\xb3\xf9\xae2\x00\xd3\x9ee\x01\xb7\x94\xc4):\xaff\x0c\xd64\xfe\x9c\xa3.;\xdck\x10\x13\x183L\xbb\x98\xf6|\x08j#\x16\xb1a\xbc\xba\x8e\xb4\xc5\xf8\x10\xe2'\xe9\xc1.\xdeVh\xea(ta\xa3\xdf&\xf6\x01\xbd\xbb\x1a?T\xc3\x1cX>\x7f\x0c\x1f\x06\x89\x0b-\xce\x0a\xcc\xe2 \x15\x9f\x12\xfdD\xb4g\xcaC\xc5\xd1\xd1\xe2\x0c\x9d\xeb\x8f\xf8Y\xdc\xfc\xe0\xa4\x9c\xa0\x83\xcb\xe4\xdd\xbfA\xd7\xaa,zN\xfexq\x03\x8a\x82\xd4K;ybi\xa8\xfd\xc2\xb7\x89\xcdU\x9b\xf0\xe4Z\x9cqG\xf9\x07.3\x01O\x8f\xfe8T]k\x8d\xdaS\xd5a\xcb\x8fY\xfbw\x98\xac\xe7\xbe\x9a\xf4\x9a\x93C\x9d\x06o\x16;{\x88\xbb\x9a\xbfB\xb3\x10\xa0\x1bgh\xd6IKc\x9d\xdd\xe7\x95\x15\xcex\xa5\x06\xf1_\xb4\xc8\x92\"\x115-.p\xe5\xa2\xd5\xa6R|\xee\xa8\xc0\xca\xc2oqZN\xa5\xccR\x0a\x14\x07\xcd1|\xe0\xbd\xc9\x838\xd2\x0f\xf4\x1f-\x95d{\xbe@G\xa5^\xb7\xaak\x1d\x9c5\x16\x96\x98\x9b\x9d?\xbb\xc3z\xe1\x1f\x9c\xa1\xb3\xd1ZU\x15\xcdZ\xca!lC\x1e\"i\xca\xfc\x952/}iP\xc7\x04\xc3\x9f\xaa\x96\\x\xb7\xb1\xa4o\x0a\xa5f\xa0:\xec\x82\x11\x94\xeb\xddU\x82?\x06/\x99\xf3[\x1e\xec\xae\x1c~!\xf5\xc0\x09b0V\xf3M\xbe\x9f%\xd4\x98\x17\xe8\xfb\xc4\xdb\xdd\x8e\xd57\xf6\xf9\x02\xd9P\xc7\xa6\xbb8\xbdYxM\xee\x83\xce\xf5K\xa0\\\xbc\xea\xe6\xaenf\x812q\x19'\x0d2\x0c\x82%\xb3Z\xe03QM\xa1\xe9/\xdf\xaan\x08\xdf\xbf(\x0d\xd3\xf8\xc21M.#\x0e80b\x8cr\xc3\xdbF~\xdb\xbc\x14d\"\x9c\x1c\x85\xcb6\x14\xc8\xed\xde\xc7\xe0\xf0\x9e*+\x8a\xef\xde\x95\xd1\x13I\x08\x9e)(f\x14'\xa2\xb4\x03\x9b3\x1cT\xe3\xc6\x07\x8b<\x1c7\xf8\x83\x96R\xd5@\xcf,\xe7</\xfd\xe0\xa0\xd3`[\x0d\xce\xe2|\xf4I\xfc\xa6\xbd\x1f\xaa\x0e6V\x7fM\xe3v\x96\x98\xb9M}\xa3\x12D\x94\xbe\x86\xe2\x0b\xfa\x14\xb4\x174\xb9En8tF+Ev\x85\xe6\x81\xbb\xc2\xf3\xa8\xdd@\xf3\x121\x15F\x0b\xe2\x0aM\x02\xc5!9\x90\x1d\x15\xc7\x8c\xb6\xad7\xb6/k\xc2\x16\xf3.\x87\x07Q\x15\x15\xf6\xc2\xaf\xc3,\x8d\x7f\xeb\xd7\xf0\x87O_\xac\x94%\xc7I&\xa9\xcf\xdf\xc3\x05\xda\x16\x9aq9 f\xbfjK\x09\xafsq\x98\x80\x01\xa2\xf3z\x06\x16\x0e\xea\x92L\x07Y@SdXXs8\xc5\xf8\xb3\x81\x93\xea\xe9\x8cL\xc8Pu\x0e_!\x916\x09\xebtF<\xa4\x96p\xb4\xd6\x03K<\x09\xff\xa3\xd1\xbb\x19\xfei\x8a6\xbb6\xae\xa5T\xac\xd5i\xbd\x95\x05\xc3s\x126\xa9\x8cl\xd2\xacE\xe35&,4\x17\xa8^\x1e\xe9\x0a\x11\xea;\xa2fi\xc0\xeb\x1fYg\x9a\x9f6P\xa1\xec\x88&\xddO\xb8Bo\xc9@\xefba\xc5.\x96Ve!\xcb\xd8\x82q!y\xae_\xb3\xc8\x08\xffjQ\xe9'e\x10L\xb8\xd4\xa78z\x16@\x9a\x19\xac\xd6\xf5\xc48\xb3\xa1\x18\x8c\xa3\x9c\xf7\x19\xda\x0b;\xb1\xbe\xc0\xd5\xfd\x04\x97\xa3\xfc#\xb4\x04\xb5w\xa89\xa3\xb4&Npv\x18w\xe4\xea\xec#\xff\x1b\xa3\x1a\\s\x83\xb9\xd8\x10_9\xe3c\x19\x0co\xea\xed\xcb\x94\x16K\x8b\x9d+\xd6\xa7\x0a\xbd\xa11\xb3\xf2\xb7|N\xb0\x1e\xedZ\xbdB\xbd\xa5xl\x82fE\xb4W#\x06*\x10p\x0aV%\xa9\xbbN\x94\xee15a3\x12\x8f\x8aI~\x93p\xb7\x9d\x18\xe0\xfbB\xe0\xa3J\xbeS\xb8\xd6MTVj\xcaU(\xaf5H\x10\x11|\x17hx]OQB\x85/\x16PBm\xb7I\xe6wI\x8bB<\\\xd8<\x1b\xed\xd0Q\xa8E\xcc\xfdc\xfc'CF\x83\x7foy3\xdeZ\xae\x95\x05R\x88\x19\xe2\x07CM\xc8$\x1e\x0bU^\x89\xe0\x80#mz\x9c\x0c\x9bk\xf0\xa6\xf7\x07\xb4\xf9rn-\xce\xe5\x11\xbe\xa3\xb8V\xed=\xdcX\xec\x84n\xc1\xbc'UI\xf5\x85P\xebe[q\x86\xf1\xcb\xe9\xd4\x1a\xed?\x99\x88!\x09i?\xc8\xff|\xf6d\xfa\xd4\xeb}s\x03\x0d\x8f\xb6\xd0\xa8\x9f,\xc9\xf3\xda\xc3p\x0c\x1f\x05]\xfb&\x03\xa29t\xb3\xc6\xf5U\x0b\xe1\xb2\x98y\xb1\x99.V|\x1ca\xeeY\x1e\x96B\xd1\x1c\xaa;\x86\x0f\xab\xd6\xfd\xa8\xf8\x91+\xbf&\xdb2\xd5\x06\xdc>\xeb\x8a\xae\x8f<\xb7:#'\xfc\xc9H\xd7\x17\xa0\xd3\x09\xf0\xb8C88G(\xf7\x87\xf5\x9a\xc0\x08\x10\"z\x0a\xaaH&K\xde\xee\xff\x1c\xf5\xc5\x8b\x05\xea\xa1\x17\xd2\x9a\x84\x10w/\xfe\x89\xff\x18\x7f7.\xc6\xa0S\x81g\xdb\x0c\xf6$9/~\x94\xcc\x8d\x85!\xb5\xd0\x0e\xee\xd8J\xca\xcb\xc0\x1cX\x17\x91x\x97I\xb0p72\xd0\xf9\xa2\xfd\xac7\xa8\xca\x89\x8f\x04\xcc\x15\xc3\xddu\xc6\xf7e\xe6\x0bg\xe1\x043c\x05\x13\x0f3\x95tY1J\xd5x\x8cb2\x9b\xf6\xd4\x9d\x16\xa1Hi\x11\xe0\x14\xfd\xbf\xe6\xdb\xed\x10\xabr\x9c\x04\x1c%\xa2Y\x13`u\xbd\xf8\xee\xc0\xb5v9\xc9\x85\xf12\x8dp\x0b\xb1B\x0eFQM\x94\x05\x0c>\x84\xd2\xad\xa3\x8dK\xcb\xe7\x85{\xd3\xd5\xc6\x8cj(\x1b?mIMc\xdc\xe2.\x86\xd8\xf0\x8d\xc5\x889]t\x0c\x1a!\xe7\xb3\x80\xc0M}\x0e\xd2\x06\xa0\x0bl\xd3\xbe\xd9\xea\x08S\x88b\xb6L\x04\x1eR\xf5\x1e\x8d\x1c>\x14`\xf0E\x031\x11L\x91\xcc\xf0\xd2\x96\x80B\x9b\x83i\x19\xa2\x1b\xb8q\xe3\xa0\x8dZ\xab\\\xf8h@\x17\x03\x86!BeG\xc3\xa0K\xaa\x1a\xa6]\x0d\xee\xe0\xaa_\xde\xa64\xbe5\xaa3_\x04\xf1B\x8b \xfe\xcb\xcf\xff\xcf\xb5\x02\x88?\x01\x14\xbds\xed\xba(U\xd5\xb8\xc8[\xc5!\x98\xd6_\x8a\x8b\xab\x1b\x98\xd7;{:y\x96\x93\x95\x96\xe4\xa4(\xfc\x19s\x1c\xebrC;\x0d\x97\x97\xb8\x09\xe3\x0c\xb8\x8bj\x08\x81\xb7m>q\xa8\xf3S\xb9D1\xf4\xb7\x1fi\xdbdX\x8b\xc9p2\xe9b\x1dVq\xd3\xef\xce\xa4>\xe9\x8bft\xc4EO\xcd7\x82Z\xc8\x05\xdf\xb9\x00\x97+\x8aK\x97\xddbJl\xf9\x0aW\xd8\xa0\x94\xf3y,\xc7\xa1\xe4\x90a\xfb7,_\xbb\xba\xc29/\xbb\xca>\xa9\xecN\x19\x0b\xc2\x11\x8c-\xca\xb7\xd5E\x86\xdcW\xebZ\x82\xcd\x88\x08\x89x\x90\xe4\xb1\xb8E\x93\xa9\xa0\x95s\xc8EU\x93\xf7U\x94p\x96,\xde\xb8\xd3\x06\x8e..\xc1\xf4^\x8f*\xc8\xb9\"\xdeH\xc2hh\xad-\x1d\x83\xc2\xc6\x0a1\xfag:\xde\xac\xde\x88\x1f\xfc\xad\xd850\xc0\xde\xf04'n@V\xc5\xb7\x98\xd4\x8f\xd8\xdb\xcf\xd0p\xadL*\x97\x0b&\x9f67A\xe5\xe2\xc1\xe8#ipc\xf1;\x87\x09\x05\x80\xb5\xb9\xf0\xf8\xf1\xfc\x0e\"5\x01sO\x9d\xc8\xf0\xfbH\xe8l\xbf\xa0\x10\xc9$\x075\xa6\xc5o|\x07\x97\x88c+]\x06.\x89\xa2\x0a\xa1I\x96\x8d\x07N\xd0\xc5\x9c\xb7\x90\x8aO\xe3\xfc\x97u\xca\x1e?YN\xc9+\x8a\xdb\xbe\xbe\xc6\xf3\xe42\x7f\xa5\x18\xff2y\x10k\x0e\xd4\xd0\x1f\xf131,\x07=\x80\x05\xa3kK\xc4\xe8I\xb52\xbcX\x04\xed\x0fm\xe9k\x93\xaa\xce!\xb5\x1b0\x98x\xc6\x8b\x8aN\x9c\xc2\xf4\xe8[\xd5\x05\xef\xa7\x01\x92\xa4A\xb6\xf7\xaaE\xed\xd8\x94\x8b\x8ckk\xd3\xaa\xbd)\x9fd\x03@Q\xc4}\xf8\x9e\x19D\"Mty\x9e\x9c\xfc\xe4\xc3,\xef\x81\xf8\x9c\xa6\xfe\x9f\xfb\x95\x02\xd4<\x93vt\x9f\xbd\x84\xa7\x07w\x06\xf5\x99\xc2\x7f\xf8A\xdb\xc8\xd2&\x88AKW\x98\xf7h\xd6\x82\xd4\x11n8\xa2c\x1e\x11\x01\xa7\xd1\xa4\xc8\xbb\xca:0\xc0N\xd5\x98\x12\x96\x16\xa3z\x80r`G[\xf8P\xd4s\x8f\xb9q\x1fo\x0bI<~\x91\xbdN\xd6\x85&)a\x02\x98$\x95\xd2/v\xaf\x98\x1bv\x92\xd4\x1b\xfa7\x09\x8b\xd7\xd57\xb8s\xa7\x94\x109\xebVClW\xecTa\x88\x0fp\x9eE\x18.Z{\xf2\xab\x95\x91\x1dO\xa7S\xd5\xb5\xd0\xbb\x13\xc2\x9e\xdb\xb3\x9c\xb7\x84\xa3\x19\x87'R\x1e\xa2K\xd5\x0e\xae\xd8\x09\xe6\x97\xa9\x1b\xa7b\xf7\x1d\xdcA\x13\xd1\xa0\xf8\x0f#\xd8\xa9\xae\xa7\xd1\x99A\xd1\xc4\x98jZ{u=\xf2\xff}\xb2_L\xac\xa9\x8f\x15\xbd\xab\xb4#\xe6\x0e\xe5\xcd\xa3\xd3\xce\xd4U\xf1\xddGv]\xb1\x13l\xa4\xed\xc7}\x12\xccK\x1f\xe3\x9b\xe8\xef47\x9c" +
+	"\x8b\xc3\xea\xd0\xa6\xaa\x0a\xb4\x0b*\x85\x86\xbfC&\xab\xaf\x03z\xaf9Y\x91\xb2Ww\xe7\xea\x0c\xfd\x11?\x0b\x07ciW\x12\xe7\xa9\xfd\xa1\xc0C8\xdc\xdf\xe7\xbd\xb9\xd6N5\x09~\x90[$\xc5f\xb8\xd1\xba6,\xeeD\x82\xa5I\x0b\xf0\xe7\xe8\x8fG1\xad\x0d\x12\x06\x9f\xa84\xb6\x80\xda\x84\xa4\x98\xcbk\xc7jCi\xd2S\x82\xc8\xb5Qk\xb5%\xa9\x11\x8b\xcf\xc5\xb0\x8d\x97\xd0\x87\xadQ}\xbb\x0e\x07\xb4m\x93\x95\xc0M\x1bX\x98=\x96\xfb\x86\xc7\xd2T\xdc\xf1\xde0\xdeq\xf3:-\xa5\xe7\x10?\xa0\xf6q\x85\xaaF@.\xa3\x80s\x9c\xa9\xc4,\xa0\x18Os&\xfa\x18\xc9/\xa8\xbe\x1b\xc7e\xef\xc3\xfc\xc6\x93\xb0\xf6o\x1f\x88a\x0c\xfdA\x81\x89Su\x8e\xea\xbe\x19\x1b\xfe\xaf\"\xbe\x1f=GCi\x96\xc0\x82\x0b08v@\xf0N\xc9\xc2\x85\xf8\x18\xd4\x1e/f\xd6\x8a\xdao@\xe6}\x10\xb9)\xd1\xa1\x0a\xc1\xbd\xc8V\xd7{\"I\xae\x08~\xc0\x0b\xad\xf8a\xb4P\xdf\x099\x09\xb3\xff\x99\xe0M\x98\x90M\xed\xcdI\xb7\x01\xac\xf7\xce\xaa\xdfI\x90'2\xe4\xd0l D\x8fr\xcfg<\xbb\xd4\x8f\x1d\xa1$&\x88\x86\xd1j^\xa7\xf5N\xf1puBZ\x9b\xea\xd9\xe2Nei\x00JGL\x96d\xf7\xee\xc5\xd8\x0c\x0d\xa2\xa2\xb7\xbc\xb0^N\xe2{\xa8\xa3\xe1M\xcfXbT|\xf2:\xc18Rj\x825N1\xca\x11I\x829\x94\x8b\xa1v\xca\x89\x87\xffy\xe8\xc8\xbd{#\x9c\x07'8\x86\x8f\x11\xcfb\xb3\x98\x10\xd5\x03!N\x07\x16\xeaK\xa8\x8b\xc5\xfd\xb7\x10 \x94\x04\x11>w\xce\xd7\xd1pax'\x0e\xf4\xf8e\xb4\x8d\x02\xaeC>\xd4\xaf\xf51|\xact\xdd\xd3\xc7I\xd7%\xd9\xb9h\x98\x91\xfc\x14\xc5*\xc2\xcb\xc4$E\x86\xd9\x1d\x91\x98\x09`\xe2`\x81\xa6v2\xeef\xbf\xb2\x8f\xc5\x15*\x9c\xba\xcd\xc7x\xfa$%\x9fA$E>\x92r4\xa8\xc3\x85\x11*C]\xbb\x91\xc2\x9b&\xf5H2\xcf\xe4\x05p\x0e\xec\xd3\xc8\x01\x93\x1a\xa86.\xce\xab\xa9\xaab\x91'\xc7?)(\x83Y\xcd\x85\xdc\xdf\xdd\xb9c\xf8\xd0\xc4\x8f\xa5\xfe\x97\xf1|(5\xb5\xec#7\x15?:\xf9\xf6H\xf2q\xb5\xef\x1aab>\x9f\xbd\x91\xa0\xbbA(\x1e\xb4\xdfS\xf9\xb2\x06\x06(\xc0u\x87u`QJQ\xc0\xc7\x17\xd4Q\xe9\x05.Z\x04\x99\x93;b(\\#\x91IL\x8bc\x0d\x86\x98#\x8f\xb3\x11\xf6\xe9\xed:\x98(2\x99\x9233m\xad\x14R\x1d|\x94\x7f\xe8\x04<H=u\xdazR\xe4\xd3\xe6k\x8e\x0c\xaf\xb3\xb8?IF$\xdf\x97\x9d\x99\x83\x12\xcb\xa7\xcd\xd3\x97\xbf\xd5<\xc6\xfa\xcc7\x1c\x84\x03M3Hig\xe7\xe8\x0a\xda\xe4\xe2\xe9\x1d:\xa3\x1bp\x98\x07+\x03g\xed\x893\x11\x08o@\xc7\x94\xb6$\xd4d\xa2\x18\x88v\xab\xba\xaa\x84\x0e\xda\x11\xf0\xaa\xae0\xaf\xb1\"\xa2\xbe\xa1h\xf0iZb\x14\xf74\x15\xe6\xc3\xbe#\xe9\xdd\x06\xda\xbf\x04[\xfc(\xff0\x8aP;\xe1\x12(\x1dH\x16\xa5\xae\xf4+\x91>\xb5\xbe\xb8n/\xbb\xa3~\x81\x03\xf4'\xaco\xa5!\x95\x09\xea\xb7@K~\x94\xe2(V\x9c\xe1!\x97\xbe\x15\x83\xbf\x96\xb2[\xb2<\xe0J\xf6H\x1aam)\xd7\xe9h2P\xe0kT\xa4\x1b\x06\xa6\xb8)\xdc\xbf\x08\xff_\xa4P~\xbc0sq\x9an\xde\x811\xe6\xc0\xd0\xf13\xc521\x95a\xa2\xd18\x13Op\x09\x1dnS9\x8d_\xac\x8ey\x96\x94Z\xe1#t[\x90b\xc2xhZ\xda\xe2:\xf4Z\x07N\x83:\xf2\xf0\xc5\xec\xec\xd3Q1N.\xa2\x9ej\xa8\x0fH\x86q\xb5:\xeaJ$\xa5\xbd\x13;L\xa0j93\x80\xf4\x92\xeb\xd5\xb7\xd8\x90Af\xfd\xd3\x1ds\xc4\xcb7\x15\xed+\x90\x00\xb840\x98A\x8c\xa2\xe6Ei\xfa\xd1\xb0\xcf\xf6\x98\x96\x84\x96\xce\xd1\xb8z\x8c\xb0\x02E\x1c7\xe6b#\xb3q\xc6\xbd\x9c\x12\xed\xfe\x08\xb5\x1f\xd4\xffd9A\x0d\x9c\xb0h\x8c\x166\x9b?\xf1\xa9F&_J\x80Jk\x88\xfa@\x88\xfbv\x19\xb8DI\xa5\xf6\x1b\x88[\xc7\xcc\x10\xc6\xde\xcc\xf0-fQ\x09K\x86J\x03g\xca\xc0\x13r\x8b\xa9M\xaa\x8d\xb4\x86\xc9\x06\xa2\xd3\xa9\xfe\x9f\x1d\x81?H\x95\x08\xb3\x08f\x85\"}=*\x0bO\xd9\xc2\xa4\x87q\x09,`\xfa\xd64Y\xd8k\xcd\x98\xd6m\xe4M|\xf9\xa5\x9a\x01\\\xc0\x11\xe3)+X%z\x108enG\xceIM\"\xd8\x1a\xd7j\xbc\x19\x93y\x17}3\x85\xeb<r\xfd\xbd\xbb\xe2{\xee\x86p\xf2\x96.\x95\xd1b\xf1*\xe4\xf3\x0bG\xca\xb0\xff\xadt\xb5\x9e\xbd\xce\xe2\xab\xd19\xc5D\xe7\xac\xcf\xa7\xc4w\xda\x8b\x0a\xee\x8e\xf6\x93)id\xba\xe7\x13\xa5:k\xe86\xd0\xb9\xda,\x05\xb5\x0b\xd7\x8d\xca\xc6\xd3\"\xfb\x06\xbd\x9b\x11\xe5\x12\xc4b\xe2\xfd\x88L\xfb\xd2\x1aJ\xb9SK78\x8fdb$\xe3z\x85+\x1cf\\]\xba{4\xd0\xceU'\xd5\x05\xa1<\x12K\xd3B\xef\xdeE\x88\x93\xdd\x04\x1e\xad\xb3\x98N\xe7\x04\xde\x95\xfe\x02\xae\xf8\x82\xf9\x05\x9e\xbd\xee\xef\x0a\xd6\x83\x8cb\x1f<\x81L\xb5A\x17u9\\\xe9;\xfa\xf5\x0e[\xbb\x7f\xab!\x9b6ZNVT\x8d\x83\x8d\xe3\xa8])\xea\x82\xe1k\xff\x14\x81\x16;\x14dY\xe7=\xfd\xd1\x0a\xd5\xea\xb4#\xb0h\x98\x92\xbc\x11\xa73\xf2\xb0\x14\x7fH\xbc\xd5-\x8e\xea\xf3gsJ\x06\xd8\x0a\x090$\xea8\x9d?sm\xbfF\x18\xcbT\xec\x80U\x91\\\x84\x0bN\xab\"\xe1WV5\x91\x9eO\x07\x01\x95\x8d\x8e\xca~\xd2\xa6\x07?\x0dO\xf9#\xfd\x88;\x9bc\xb1\x1a6#\xe6\x9f\x9a'\xa8\xc2;\xba>\x8c\xc5\x91~L\xba#\x89\xeeKU\xfa\xcb\x81\xf6\x83keQU\xc8\xe9p\xc4\xcf\xf9\x06\xc43N\xde\xd1\xe0)\xac\xa6\x8d\xa9\xa6e\x92\x1c\xf9S\xce\x9f\x861\xfc\xf9'\xea&\xbe\xfb\xb8\xa2\xb8\xff9\xbc\xac\x1c\x87\xca\xd4\xfa\x05]$\xb6\xd5h\xd9M\xe8\xe0\x13\xb4g\xbe\x15\x89\x8b\xb4\x1b\x19Uc\xb6f=`\xaa\xee\xe6z\xb8\xe8\xd4\"\x12\xcdT\xa8\xb5n\\\xbbO%;\x0e\xc5\x05\xa65\x87p\x0b&x\xe9,\x12)\xb2\xaf\x85\x89U\x87\x14\xa0\x86\xa7\x91\xbe\x05\xf3\x82I\x01dx\xdc{h]\xf1\xae\x84&1\xe9\xf2L\x07\xb3\xfa\xbd-\xb6\xf1C\xc6\xf3:R\xca\xf3(\xcd\xb6?\xe1\x90 #\xc2X\x06\xdc\x10T\x08\xe0\x0d2\x07\x84\xcdw\xcd\x93Uv\xcb\xa1\x1a1\x13F\xb3\x8c\xcdy\xaeC\x0c\xa9M\xcb\xc3\x98\xf4W\xc3\xe4\xfdo\xe7\x1b\xa3\xdb.\xbf\x86\x94pY\x95\x13\xc9\x16\x86\x08U#\x82\x02\xed\xd2n\xfe\xc2l\xa01\x15\xa0\xb5j\xc9<\xb3hLz\x90\x0a\xea\x08\xc5\xd1\xd2\x81\xfdB\xf7\xc1?&w5\xc0&\x92\xa4\x17A\x1c:\x9f\xf0\x08\x8dVl\x0c\xc1X\xff\xb55\xf5\x12=\xd4\xbd\xc4=\xa0v\x06\xc1E1\xe9\x18\x8a\x92\xe6\xe3\xdf%\xa86\xdf\xfe\x17\xb9\xfby\x05?\xf6@1g\xa4q\x91\x0b\xdc|\xcf\xc2~g\x04\x04q\x0cG\xdf\xd2ZP\x01Z\xf75D\xcc\xf1\xb3\xd81O\xd9\x9e\xe6\xecApDC\x1a\xdaK\xa0^\xbb\xe5\xe1~\xf9+\xde\xc1\x9f\xcc\xb3\xc4\x12IO\x8f)\x1ey\xa14\xd1@\x15F\x9a&HY\xe826\x01\xe7\x9b\x9e\x98\xe8#\"\x1e\x09\xcd\xdaJ\xba\xf6\x1e\xd2\x80@\xcc\x92\x16\xa5\x83hm\x06s\x1eZ\xea\xbad\x91\x8e\x84\xe3y\x99\xce6\xf2\xf5\x82\xfe!\xdcQ\xd88l \" \x89\xc6f\x14\xea\xe0)OW(\xfa\x9f\x98\xec\xee\xa1\xc6]1\xac1#\x18;\xcc\xc1Y\xae\xe1y|\xa6N\x93}pe\xd0<\x16\xf4\xfb,Z]\xba\xa4\xb5\x0f\xc0\xd9Q\xa8bw!\xe6\xd0\xbde\xdb\xd3\xc9\xb6\x8ba\xcaPr\xb8\x9f\xe6\x98_\xae\x0e}\xf9x\x0d\xc1\x9f\xd0\x82\xa4`\x93\x04O\x1a#\xdc9\xb1E\x16\xda\x9a\xaa\x8e\x9a\"\x12\xd0\xfb\xb11\x19C\x0c\x1c\"B\xf4\xc7\x08\xf8u\x0e\x17\x85N\x9b\x9dt/\x9f\x8c'\x9dR\x08\x8e\xe99\xa2>\x8alA\x0cD\x97W\xe0\x02\xc4\xb0(\xc2\x8d\xce\xe7\xd1f\x9b_\xbb!\xfd\xf2\xf2\xe9\x84\x87mL=\xcd\xe5P\xfe\xff\xc8?iow\xado\xa0\xfd\xabs\x1b/\x9f\x8d\x91JghN\xeeZ\x88\x9cy\xd45\xb7%]\x87\x02\xce\xd0\x8d\xea\xecB\xe8\x92Y\xa7\xe7\xdf[O\xbd\xf7a6_\xe1x\xa2\x9f&\xf7\xa7\x91d\xbc\x99\x0eK\xe8\x92\x0b\xd1Z\x8e\xbe\xa8\xe3S\xa1q\xa1\xb2_\x8e\xa2\xf02\xbe\xed\x1d\x9cW\x93\x89/_$NTdDf\x838\xbe\xf6}U:e\xd8\xcb\xaaC>fX\xb7\xdcv\xef\xe0\x02\xbdS\x08i\xfegQ\xc0\x9do\xe5\xcb\xa9\x95\x9d\xa9\x83\x86\xe9t\x01*k2\x81o\xb3\x9a\xb5d\xa6\xaf\x067\xb8\xba\x00\xd7\x15\xbb\x06Z\x14\x8eL]S\x97p\x87\x83\x11\xe4\xc0\xd6\xfe\xddlS\x93\xa3\xca\xc5h\x88A\xa1\x92\x81\x1bZ\xf7F\xe8*\x8a\xab\xbf\x8d\x0e%Dz\x8eaSi\xd0@'P\x84\xfa\x0e\xa8\xcb\x83\xe5\x01W\xd4\xa03\xdb\x11\xf1\x0a\x17\xd7 \xcf\xc5\xe1\x83\xd9WY\x10\x14\xfaAS\xcc\xfaT\\\xca&]\x98u<\x86\x03\x94\xad\x03Iz>\xcc(0\xc5\x9a^\x84\x103y\xe3|\x81\x80\x1eU\xb4!\xa7m\x90D/.\x8b\xc6\x17ps\x82\xf1\xbb\xf7r@\xdd\x81\x1f\xcd\x00\x12\xcf\x0c'jM\xf5\xf4no\xbe\xb8\x0eL{\xf5x\x92\x92o\xba\x87F%\xac\x12\x8b\xe5\x88Z\xbcpBg\xc5\x0d\xfem\xf1\x9e8g>8\xd0\xef%*\x15\xe2\xc2\x8a\x96\xc9\x032\x7f\xaf\xa6\x9d!.K\x125\x11\x8ccg<\x9d\x0a\xffV\xdb$\x8d/\xb7\xf7:\xab\x7f\x0fe\xb0\x04\xfe\x8e\xfe\xc7\x83A\xd1\xd6\xf0\xbd\x05\x08\xfc\xab\xa7\xc9\x1e\x16s\xd7\x02Z\xe3\x0d\x92=\x07l\xaf\x1c\xc0\x82\xa1\xc9\xd0\xea\xa5\x9a3)\xf2\xce\x82\xed6T_\xbd\x94\xea\xab\x9f\xff\xf9\x97\xff\xf4\xf3\xff\xf6\xf3?\xa3\x14\xcd\x7f]\xab\xc3\xc2\xf2\xaa]'\xb5\xa5{\xf5\xe9\x8b\xfe\x02?\x1d\xc3G\x8c`\x1a\xde\x93\xf6\x1e\xfe/\x87\xd5\xe2\xad\x92\x89\xbf\xc6Oh\xaa+\x10\xbe{\xfa\xe6\x1c\x94`&{\xa2P\xa7\x7f\x85\xe1\xea\x1d\x0c\xfb\xf5\xd2\xaf\xa5\xc77\"\xaf\xa9\x16\xecz\xe1\xd7\x98\x0f\x06\xc1\xb1\xda\xee\xe2\xdf.\x14&\xea\x01\x8d_\x1c-\xc2\xe4\xb0\x92X\x99\xb2\x10i\x05x\xa9\xa9\xa2TAy\xa8\xba\x93\x1b4\xb5\xfc@%\x8d\xd4\"=_\x1c\xf4\xd2@\xad\xb4\xadj\x18d\xb4\xc2\x05\xb1\xc2\xe1\xe4Z1\xfd\x86\xa5j\xb4\x97v\\b\xe4'\xd8\xc9\x04\xf0\xdb\xe5\xb3`\x1f\xcfP\xb8\xa5\xd2\"L\xe2R\xfa\xee\x02\xf5<\xf7\xa7\x1e\x91\xf2\xe5$\xac\x92%\xd8\xc3\xcbuG\xc5C\x060dsr_\xa0\xc3\x8a\xc8\x1b\x91\xd5\xb1\xeeR\"Tv\x0d\x83\xd09i-Q\x0fU\xed\x9d\xef\xfcz\x19\xd7\xbf\x8fRM\xbd\xeb\xac\xfd\x9b\xd4\x970\x0c/\xd2\x00\x1d\xd6\xab\xadl\xad\xa9uew9\xbf\xd5>\x9e\x12\xbes\xc7\xf0\x91\xbe=\xef\x92\x0ep_\xba\x83\x8e\xe54\xc2\xd9\x8e\xe7\x1c\x11\xc8 \x0f\xf3R\xd8\xf2\xf1\xe3d\x03\x1d\xb8j\xef:\xa2d\xdb\x05\x0e\xeb\xce\xd5$\x02\xba\x9f\x96[6\x0c[\xb5\xaf\xfd\xc5\x9a3Z'\xb6\xe8$\x85\xf3\x8c\xee\\|\xde\xe0\xa3\xd9:~R;\xb8\xba\xa5\xba7\xdd\x09\xdf\x1b\xcah1\xf6.UG:\x9aZ\xc4H\xe1-\x93\xbdO\xe9\x82\xcf\x17\xd7*\x13\xe8\xc6Z%9-s\x12/q\xdbs\x0e\xf33\xba\xd8\xed\xfd\x96\xc2\xa3\x89\xfb\x8b\xdb+\xa7\x9b\xa4\xb9\x13,\x89\x96\xe3\xf1\"6\xaf\xb6\xa1\xb0g\x9c:M$z'$\x8e3\x89\xde\x0d\xd56<\xcd\xe4\xe5j3\x1b\x94\x9fJ&\x079H8\x19\x04\x85\xc5\xbf\xcbmc\xb6\xa5\xed\x89\x11\xb3\xd9\xa53\x09\xb9\x1d\x9f\xfd\xfb\xb7\xdb\x0aq\xe4\xce\xa6p\xb76\xf2\xd0\x9c9\x13\xdd\xbb\xf1\xf30FF\xbd\xf8v\xbd\x00gl\xa3\xff\x89!\x84\x11\xb3\x11f\xb5ka\xd9\xec\x7f\xfc:\xd9\x0d\xd2\x8a\xa5T\xd7\x85\x86S\xcd\x85Q\x0az\x0ep\xdcT?H}\xe4\xb6\xf2\x93fcR\xd5\x0d \x06$\x87\xca/d\xb14\\\xfc\xb1\xa5\x8c\xc4|\xb1\xaen\\\x88b\xf9\x848J\xa3uy\x8b\xef\xa1\xbb\xcd.\x13\xab/Jw\x8b\x19\x16\xddL?J\x12t\x125@\xa4<\xa7\x8a&\xf5P5h\xa6\x85\x92\xab!\x0d\xd2\xe4!\x8d\x94\xa1T\xd2\xae\xa7\x85b\xfdX3b\xc3!2\xa4X\x0b3yHP\xc0W\x95?7\x90I\xa3\x7f\x04\x17\xbaY\xac\xcf.\"\xb6\x11\xeb\xbe\xa0U\xe4\xd4\x94\xe4&/ <\xbd\xfe~\xbd*\x83{\xbbj\xe1\x96$\xb0\x06\x09\xc4PDf\xc5G~\xf2\";L\xac\xe6\xa5\xa6t\xa9^\x8du\xa66\xd4G|\xb6!\xc5\xf4\xb0\xe1Ci\x96g\x89Q(C\xc8\xab\xe2\x8f\xa59\xaf\x9b\xc7\x1f\xb9\x0c\xfax\xe3\x7f\xe4\x9b\xb9L\xde[\xd5\xb8\xe6\xe6\xda\xa3\xfcCN\xc5\xb9\x82?o\xb4\x083\x0a:\xa1\xc7ia\x0b\xf7\xee\x1fg\x9e<Qr\x98\x94\xc1\xe9\xf3\x13\xb6\x815Z\xc5\xef\xe7@e\xa6DD\xdds1\x16B\x9a\xef\x14m\x8a\xa9\xe2;\x90\xea\xbb\x0d5\x0b\x0ck\xe0\x08\xb9lD;\x8a\x8fs\x91\xa9\x1b\"a\x1c\xa0B\xb2\x1b \xa3y\xc2\x8b\xb4,\xb2\xe7p\xc6_\x88\xe4u\xbf\xa1\xce\xe0\x13\xf1\"4n\"\xe79Q\xb79W5\xcc[P\x0f\xc3\x86\xe2\x83\xf1\xd93\xb9\xb2B\xe0\x17\x1a8\x86\x0f\x861\x847\xdbP\x09\x90L\xd42g\xaa\x94\x04\xfa\xbc\xd4\xed\xb6\x14\xfb\xd3i\x00H\xaay\xbb\x11\x84\xf9\x0f\x00\x85\xbb\x02\\\xc2\x8f+D\xc1\xe3b\x87TO6\xb4\x84\xf1\xe2\x1a\xb3\xb6\x0d\xa5\xf0\x04(\xc61\x11\x13\xd8%7\\\xe4(\x0e\xeb\xd5\x01\xe5\xfc\x89L&\xcfx:\\\xa0\xf3}J\xd33>\xa3\xf0J\xdd\xe1\x95C\x12\x13bgw\x85\x95\xbd\xf8\xa9\xdd?e\x0b\xc8\xd2V\x09\x89\xfeX\x9d\xa0\xcc\xe8\x9a\xadh\xe8.R5\xef\x1fJg9.?\x96\x85\x18e\xe1\xb0\xfcx#\xfa>\x05,U\x89\x89\x93\xe8 \xa8\x17#\x92ts\x10\xa6\xc3:p\x9e}V[\xd7e\xa5fC;zJ\x11P\x9c(\xa8\xc8\xec7\xa0\xdc?YVrM\x0aY\xf2s!V:\xac\xa3\xd19\xfa\x11qE\x0c\xca_\xae\xf2\x8d\xf5\x82\x1b\xd8\xdf\x94f\x16\x91MG\xfc\xb4\xc8yR\xeb\xdd\x00\x13\x17H\xd5\x00\xf5e\x80#\xfd0~\xd9\x89\x87\xef\x88\x9fK\xc8\xf3\xe8\x87\xb9\xaa\x84n\xb4\x85 \x1ft\x1f\x11\xbc\xbd+\xea\x0b4\x828\x0f3Ut\xa50/vu\xdd\x06\xc0\xf5\xee\xff\xcct\xfe{\x16/\xa0\x19 \x8as\x1d\x17\xc4MS\xc5bbo\x03J:\x16=R\xa7s\xbb\xc3(\xc3\xb5\xfez\xf9zM\x0b\x83\xf1\xa5\xe1\xe4k\xdeI\x16\xb1\xd8\xaf,N-\xf4J\x9c'\xc5\xc9\x95\x10z\xe7(\xff\xb0f\xc4\xd5aMG[*\xff \x09\x1a\\\xf1@\x064B\x0f\xeb\xa8\xde\xbe\x82\x1a\xda#\xd4\xa3):TW\xdf\x15\xbb \xb8\xda\x9e\x0b\xdf\x0e\xb0\x8f\x1c\xb8\xe1\xb8\xd7\\\xf2\x09\\\xbd\x01\xad\xfb\x0fpMr\xd5\x0f2*{\xca\x94\x9e\xaa.\xc4goG\xfc\xdc\x00\xcf}\x1f!<\x19\xc1W\x16\xeb\x9a4\x1f\xa9\xec7\x97\x857\xc4\xaa|3t\x83\x0e\xeb\xd0\\\x99\xfe\x13'\x16yv\xf4\xe8#\xff\\\xa8/\xb6g\xc4\x08\xfc\xfdv\x1d>\xcbO\xa58\x86\x1b \x8fc\xd8\xdf%\xc1\x8cr*\xbd\x07S\xa7n\xb8\x83\x0dj\x8c\x03\x0c\xa2\xfa5:AV\xce\xd9\xe7\xcf\xb2\xe1\xc5H\x05%ibq\xb97\xe4Q\xadk\xef\xc0\x87\xa8Z\x83%\xde\xc1\xee\\{\xc8\xf3\xc4\x12t\xa0\xb7\x09+\xcbJ\x10Sl\x0bo-<\xa0\x94\x1c?\xc3\xe5H?\xd8\x15M\x05\x9f7\xc0g\x7f0\x0e\x10Fc\xe4\xf5\x98\x00\x8f\xeb\xda\xf2\x83\xdev\xfb\x05\x9a\x1b\x06\x90[\x0aS 9\xc1a\x1d8+\xf8,\xcd\x1b\xb2]D\x87\x8b\x16\xcb\xab{\xb0V\x0f\xdfW]\x85T\x82\xdc\x17\xfb\x0dP\xda\x04\xb2\x883\xd20.\xc2\xe0\xde\x1e\xd6\x81\xb0\xd1\x18\x11>\x8b\xf0M\x99\xe2" +
+	"6\x1fLE\x0bq\xa1\x1f\xccJ\xb0\x18n\xaa.\x0a\xd7a\x81 \x0c\x85k\x17br\x11\xe4\x9a\xec-\xaeM%\x03\xea\xaa\x91\xd2\xaa\x0d\xea\xda/\x1e\xa7\x89\x02\xf3mN\xcd\x86\xfbGD\xea\xa9\xea\x10c8\xe5F\xa0\x05\xb6\xbc\x18\"\x1au\x17\xc6\xa4\x0d\x8f!\xb5\x017\xe0:C\x9d}m;\xd3\x85\x82\xab5\xde~\xfbz\xf9\xda\x16\x7f\x81?\xff\xd97\x1bp\xa6\x93\xe8\x0e-\x996\xf7R~\xe838M\x87,p\xc3o\x00\xa3&\xcf.#\x83rx\x0a\x9a\xed\xf4|\xce\xb9\x14T\xcf\x81\xb6{\x0f\xdd\x94\xbe\xfb\x06Tj\x83\xb8nbw`\xa6\xa9\xea\x86\x09\xe1T|~\x03@t\xd6q\xb5\xb3M\xa4s\xb1\xe7\x9c-\xfc\xe6\xb7\x8b\xb5\xc4\x11.\x15\x8cK\xcc\xbe\xfa\xc1D]\xf1Kq06\xc0?U\xe9E\x98,\xa8-\xe6\xe9\xf1v\xec\xb4\xe4\xe8'Fe\xa9A\xf9\x05\x82\xdc\x8f4\x09$\xb2\xcf\xa9\x04\x14\xed\xdb\x00\xeeL\x1af\xf8'\x1b[&4\xd1\x9b\xba\xa9\x05`\xf4\xd2\x1c3\x80\x96\xd90\xa2\xbc\xa3\x9bb\xdcd\xda\xba\x0aJ\x00j'\x0c\x1b \x94\xff`\xa3\xae\xd4'C\xd5\x9d\xab\x1e\x8e\xe1\xc3\xb0\x10\xdeUw\x90\xee\xdf\x83\x07\xa3\xdb\xb4\x11\xfc\xd8\x8f\x09<\xb1\xad9\xa3\xc5.\x00I]\x7f\xbf\x8f3\xd1\x14\xa2\x8e\x8f\x114\xc6\x0e\xeb`\xc4r\xba\x0d\x04)\x1e|/\xa4\xde\xc71\xb9w\xa2\x83\x87t\xda\xb5\x90g\xada\xd9\xd2\xe0Q\x84\x83q\xb1\xef:1\xf3\x98kk\xb1\xcb\xf3z\xdf\x19\xcfx\xca/N\x8f4\x0c\xe9m\x80\x11&[\xe3\x1f\xd0B\xd5x\\GX\xee\xbf\x9f\xa0\x16\xeeQ\xd9\xe8\x18>\xd4|\xb5\x9a\xc6;\xdea\xc3\xf7\xf7\x1b\xa0\x82\x11j\x1a\x1f/5\xce\xe2\x0aI Y1w'Kq\xbe|\xba\xbe|9z\x92\x9c=\xd0B]|\xf1_\xfc\xa4dA06\xda;O\x81I\xa9t\x7f\xb4\x0d\xaa\x87\x8f\x81\x93E\x8ba\x17\xbb\xc1\x07\xe3\xefN\x1d\xa2\xd0\x8d\xe9\xbc\x9cX'\x1c\xea\xa6\xfdl\x03\xc2N\xb2\x11!\x97\x83\xd5\xf08/\xae\xc1\xd1\xc3\x99\x83q\x82\xd0\x9c\x12\x0b\xca}\xe7/E\xacc\xda\x00\x8d[\x0ew\x0a\x9a;\xa5H\x9b\x0fz.\xfa\x9c\xaf\x1e\xcf\x1e\xe5j\xa1\xce+\x85%e\xc6\x1b i\x1f\x13&\xcc\xa4\xec\x7f\xd4|\x14J^\x87\x91\x8dv\xd0\x1fN]8X\x92CG_\xe5\xcc0\xe0p\xe1\xee\x0f~\x81\xb0\xf4Ud\x07\xbd\xb6g\xd6\xc0\xe4:\\\x97W\xaaG9\x16\x86\xf7\xc0\xc0}\x13\x04\x85g\x13\\\xaf\"\x95\xf5\x0f\x13\xcf\xc8\xca\xbb\xf1pkU\x9e\x16\x83\x9b\x99gl\x13\x9f\x18J\x0e\xe8\x8c\xd9\xa7\xe7\xc9zq\x00\x0d p\x09l\xad\xdc\x93ai\xe1l\x0b\xf6\xc8y\xfey/\xa7|\x15h\xcfg\xa6#\xd0\x08\xe8\x8fLk\xd7T\xfd\x00\xb8tV\x1c\xd0W\xaf\x92\xd3\x9bU\xfe1\xe0#\xc5\xad\x1c\xff)\xea*\x87[\xcdO\x81\xd7\xa3y;\x01\xf0\xcd\x83 =\xd4nXY\xea\xaf'\xad\x8dIn\x82D\x98\x90\xd8\xa7\xe7o\xfbx\xaa\xc1\xb7\xaa;\xbb\xb1\xe8\xa4\xcc\xaa\x0c\xb4\x9e\x94;9X\x0a\xc10\x98\x1b\x96\x0am^\x9b\xb5\x9fgo%\x19\xb82\xb8\xaf\x9f&\x9d\xc5\x04\x16\x8e\x08\x9d\xc6\x88{\x0e\x7f5U\xe3\xebDpw\x10V\xcf\x96\xd7?\xff\xbe\xf60\xcc\xef\x03\xaf\x9f\x8d\xfa\x94\xd7^\xba\x07X+\x9f\xb94\xe7\xef\xa9\xeb>nV\xb3\xbd\x1c\x8b4o0\x04YZ\xfaa\xd6\xfaM4H\xe6G\xe1\x85-\xe9\xbb\xc0\x95V\xf0\xd1Je)DKyX\x8d\x0eV\xe3\x05I\xba\x01L\xfd\xfa\xe5r}\xfd\x07RV%\xa1B\x18|q\x82\xf6\xec[=\xc9wa\xaeC\x0d\xdd^6s\x9e\x8e\x84\x12\xe4\xafRa\x98\xe4\x11o.q#)j\xb4\xa5\xad9).\xed}h\x9d\xd4^\x04\x90\x8a\xc6\xf7\x14\xba\xd2\xc1f\x8f/1xx\x1dO\x14U-h3\xbfN\x92E3\x13b\xb4\xcb\xd0\xa2\x1e\x05\x849\xd4D\xe1\x98\x9c\x98#J\xfd\x08Q\xe3\x02\xdf\xfc\xf7Qh\"\xde\x15Z\x7f\xee\x94\xd9D\xee\x12\xb2m\xf4\xb6\xd0B\x1dC\xdf3\xf1-T\xe5[xr\x9aS\x97\x0c\xaf\xa3\x01\xbfL\xb1\xb1\xdb\xc7\x1bu\xcf>\\\xbb\xf0\xa04g>\xb0\xae\x11\xde\x847\x92\x1b\x04\x14'\x94\x80e\x8aR\x02\xb9\x8f!\xa2$\xa5\xcdcw\x98\x1b\xc4@\xa1\x1b\xee^\xfb\xd4b\x81:j\xd2\xd0tF\xd0\x04\x17\xc6@\xad{@\xb8\xa6\x11\xb1\x9e\x9f\xb8\xc6\xf1\xca\x08\xcd\x1b\xfb\xc2\x8dWT\xd5\x18o\x11\x9a\x80vD\x18MI'^-\xc63\x01:\x19\xfa]\xd7\xf6*|\x12Aj\xea\x80/F4q \xd6(\x9a\xbf\x8f|\x15<\x12\x8a\xd9V\x0e\xd6^\x8198\xc1\xa5\x9fq\xbf\xe8\x1c\xe5\xc5C\x0f3z5\xb8\xaeWws=V\x8c\x06\xc6\xa2{\xadQ\xc66\x19\x9a\xf6\x05\xea\xfa\xef\xc7\x8c\x84\xb70\x13\x1c\x0b]'\x03,\x93`\xfen\x91Zp\x14\x8cVL\xb7&,h&0K\xc6\xff^N\xc1\xe3\xef_l\x8a\xc3\xb2\xa4\x7ft\xb7V\xd0\xa1\xdf\xbf\x9c\xf0JO\xbe\x8fkN\x17tU\x7f\xed\x8a\x9e\xe7\xfc\xb68\xef\xe3\xef\x0d\xber\xec\x1f\xcad\xf9\x0d|\xc4\x85\xc1}\x9di\x0al\xf2\x15\xb9ik+$\xc2\xd2?\xfcm\x87\x7fF\xb9\xc3\xfa\x84\xbf\xce\x13|\xfcx\\\x02\x1b\xf7\xf6\xa9\xad}W\x87%\xe1P\xb5\xfb\x87\xfe\x02\x7f\xd6\xcd\xbe\x85\x93\xfb+\x8f\x9a\x08\xf4\xc6\x96\xc4Q\xa9+\xde\xbai\x80\xc4\xe9\xa2\xc1\x0a\xad \xa0\x82JW'\x91\x0e\xab\xa7\xaf\x9dM\xdf\xcf\xa4\xad\xff{\x04\x88\xaa\xdb\x93\x89\x88J\x17\xac,\xaa\x14M>_'\x1d\xdf\x8e\xd3R\xf86\x9f|O\xef\xf6;\xe8\xfc\xd2\xc0=\x1f\x0d\x9c\xe1\xcaK\xaak\xa2y\xfc\xb0H\xd2\xe3\xc7\x13A+[i\xcb&\x97T\x99\xcdY[\x94\xddYx\xcc\xcb\xc99h\xd9\xa3\xe5u\xac\xf6;\x85\x82Qha\xe1\xde\xaf\xa6J\xbc\xef|\xed\xccM\xab\xed\xd4&\x8f\x1f\xbf^\x8b{\xe9}'7\xb5\xbf>\xee\xf5\xf8\xc9\xf7\xd9\x9e\xf6\xf7\xc5\xa6]-\x9dZk\x9b\xdb\x93\xc7\xff\x07D\xbf\x1e?\x99\xab\x96T\xcb\xe3\xaf\xdb\xef\x9e,\xc4\xa9\xae\xf7\xd0OG\xa9R\xf8\x85\x09\xb1/<(\x86\xab\xdcz()\x8b\x1e\x89\xce@\xaa\xdf1@\xfdm\x9e\xcc\xe0\xf1\x13C\x133\xf8a\xc2_}\x0f\xdf]-e\x97A\x13\xc6\x18\xb7T\xb9\xce\x9f\xa9O^d\xf3\x8f\xadP\xa9\x1f\x8c\x15}&\x09\xd4\xf8L\xe2r%\xc8\xf0\xf8I\x1a\x9d\x1a\x0b\xfa\xa0\xcf7\x16\x1c\x93Dw\x9e\xb2_x\x1f\xdd#\x84\xb5\xec7B\xe9=~\xb2\xbaY\xd0\xa4\xfb+\xcd\x9f\x85\xc2\xa9_\xb9U`\xb3\xd6F\xe8\xe9\xff!;\xc4\xd3'\xeb\x01\xf2_\xb99<\x9dN\xe1\xff\x83\x1f\xd2\x12\xa7\x89\xed`\xe1\xa6\xcf\xe6c\xca\xf9\xb1\x1d^\x02\xd7g\xa4q,v\x92\x0c:\x9a\xac\xd0\x9eh\xff\xe6\xe7\xf4\xd3\xe7\xf9\x9c\x8e\x98\xe0.`\xc0\x10\x0a\x05\xc5\x8e(\xed\xc2\x83\x12\x0d\xc8\xc4\x8fN$ap\xd3Xx\xdd\x17\xd3\xb9\x85H\xa1\xf9\xb0\xc4\xc2\xe3\x8c\x8a|\xe4\xa2\xed.\xe0\xa2$\x19\xd5V&\xa3\x05\"\xd5{\xf5\xae\xd8\x19\x05\xb6\xbd\xe1f\"!\xd2\x1b\x9c\xddB\x9f&|\xe2\xfa%d\xb7~\xb4\x81\xa2l\xfe\xc6\xaf'\xe7\xdd\x9f|\xeb\xfb\xb5\x897\xdf\xdcg\xdf/\x9e@W\xe8\x90\xa7\xb1\xa8\xe1\x06\xd71y\xf5\xc2\x8d\x1f\xc7\xb9\x15\xa3\xdaafb\x1c\xa6-]D\x98\xcd\xbb\x02TtF\xe1\x95\xf0\x9d\xa5\x85\xf9\xecI\x8eg+\xddP]\x857\x83XK8\xf5Nt\x8fP\x02A\xb8\x03pl\xe1\xc6O\xb32\x8dT\\7\x8a\x12\xce\xbf\x07I_\xc4BSF\xb6\xcd?R\xf7\x03a\xd8\x92\xa8 B\xc4e\x85\x15%\xa3U\xe8\xedL\xfc+CL.\xbc\xdc\xf3\xe9\xa5\xa8Q\xdb\x18\xb2\xc50\x0e-p\x08\x07\xac\xc8*cW']\xda\xc3\xf23'\\\x06\x95-\x10\xc4$\xe4p$<K\xef\xaa\xd6\xf5\xc5\x15N\x0bQ\xd0gcW\x01\x11\x11\x86\x94\xac\xaf:\x08\xbf\xea\xdc\xc9\xf3\xce\x05\x03x\xc2u-\xdc\xf9\xd5L\x06\xc85\x99\x1a,>\x15Z\x7f\xae:qN\xc9\x81Sb\xf1\x14=\xce\xc4\xfd\x13R\xc7\x0b\xad\x89\xb25\xa6\x9e|w\x0fE\xac\xc6\xc8\xaa\xcbGT_\xd3u\xe64\x06b\xaaA\x0b\xf51|\x98c\xa1\xccI\xe8\xf1\xef*P6?\xb3#\\\x1a;\x9c\xf7\xc2\x9cGHK8\x8f\xf2\x0f\xdc5n\x905\xc1\xd4\xd8@\xdd\x87E\x80A\xc3\xf9]\xf4\xb9I\xb6!%K$\xf1\x9f\x82*)\x99\x18eI)\x05\x95\xc2\xcc\xc2r\x0eM\xfe\xfb\xf9g>I1\x08\xac\x0d^u\xa1\xcf\xe8W\"\xe8\xb9\x8ciK\xcd 4q\x17\x0a\xc7s(\xb4\xb0,7\xee\xea.\xc8~x\xf6\x034$\x953=\xc6\x94\xcf\xcc\xc6v\xa1s\x9fe\x8f\x94\x89L$O\x18\x93~[\xbcW\x9c\xd0\xee\x0b1`\xec\xd5\x07a\x9d\x8b\x85G<\x9f\x84\xce>\xec(\x8d\xf0\xe3wf\x0er\x1b\x04\xfa~\xf2\xb5\xc0\x010\x96\xb3p\xbb\xd4\x09\x09\x05OR*}j\xbc!*\xc7\xf4\xf6y\x86\xa8|a$\xa7\xe8\xd3Y\xfcxw\xf1'O\x0c/-\xf4p\x12\xcc\x07\xe7\xfa\xa1\x95_\xdc\xb9vb\x92\xdda\x91g\xa6\xa091\xf3\x1e\xee/=\x7f=V\xba\x91Fuc\xfe\xb2\xde\xfd\x84\xd6\xed\xfc\x0d#\xca\xf8\xe3v\x07\x0c\x9f\xb5[q\xc0^<^(\xc77i\xd5c\x9aZ\x8d`\x8d\x85F\x1b?\xc4\xca&$\x04,Z\xe9\xb9SNm\xc3\xce\xb8b)\xbcx\x9a\xdb\xf0\xa3\xe9\xc5\x1bj0L\x1e\x82\xdd}\x1c\x01\xc3T\xfc\xc8m#!f\x99WD\x96.\xbb\xf4>\x91p\x90\xa5\x14\x023\xa5\xaf\xa1MO\xb6\x85\x97z>\x0bsd\x85\xd385\xc3`+g\xd3\x8e3qU\xeb[ (u*\xbd\xba\xf0\xb6/\x96\\\x88\xd13\x07\xe8O\xee\xa2U\xdb\xabw\x7f\xb9T\xa5:Zj\x92\x83\x9c\xbf\xdf\xab\x0c\xd762J\x8b\xbe\xee\x9dV\x0a\x07c\xca\xcb\xa6\xc4\xcb\x02\xff}4\xd1\xd2\xa8\xc2\xbf\x9f\xf2\xbfW\xb0\xa9\x8f#\xf8w\x9a\xea\x83\xd8_6\xc6KV\x05y\xbf\xcf\xa0\xa1\xa6\xda*\xd2%\x17;\xa1r\x15*\x9f\xba\xbaF\xb0\xb1dCe\xff\xdbA\xedN\x88\x17\xa0[h\xbd\xcb\xc2\xc8\xae\xc2\x82\xc9b')\x0b\xe6\xc9\xe5\x0a\xeb\xa9\xf8\x83\x14#\xad\xf8m/\x9fd1\x9bI\xe3\xc1\x0d\xb18_`\xe6\x0f`\xd7|\xfc\xf2\xe9\xc2\xbb\xd1\xfa\xc7\x96O>g\x89\x13\xeb\xf1\xcbTZ@AT\x91\x9d\xdd\xd2\xf6F:}vo6\xe9\xf0<~\x99\xa4?0U\x1fS\x10\x87\xf9\xf9\x80Wn)\xe0\x7f\x9c\x91{\xa6\xc4\xa9ME\xaa\xb8\xcc\xc4KU5\xd8_\xe1$=\xe3V\xbc`\xa5\xbc\x1c!\xef\xb0\x98MJ@\x90\xc9+,W\xc4V]\xa1\xc9U\x98\xa0\x81\xa1\x08\xd5\x8dX\x1fs\x833{\x85\xbc\xea\xc2W\x1c\xb9\xc6\x13(m\x01h\xd7+\x08\xed\xc7)\xc4w<\x8ev\xf0\xd8\xc9\xd98x\xaf\xa7\x06oj\xd4\xee\xfc9\x1d\xba\x051\xed\xefg\xf4\xf7\x1aO2\x04\xf6\xd0\xba\x91\x86\xdb\x88u\x98\x1f[\xafP\xaa>N\xe9)\xeb\xe5`\xea\x0d:X\x8b\x12\xbe\xcat)\x89p\xcd\xdf9\xaa\x072\x01v\xac\x91b\x10exB\x08>\xad\x93)\xbeR2\xc5\xffm\x8d@\xf1\xf3\x05\xa0<\x10\xcd\xa0l\xb1\xc5}\xde\x83p\xf6\x8b\x1b\xcc\x93\x09:\x81\xb1\x8b\x8a\xb9\x7f\x899\xaaT\x87P\x1b2\x16\xbeC\x8d\x83H\xc3\xd3\x9e\x17\"\x10Ocy\xe50V&}\x80(i\xca&M\xcb\x9d\xb3\x9f\xc2/\xbc#\x9f\x1e\xf7hT\x17\xa1R]\x18\xd8\x7f\xbe\xd2\x02\xb9V7\xe8\x85!\xe2\xd1*'\xa3\xf1\x04\x88O\x8dc\x0f\xfc\xbb\xba\xa2\xe8\xbf\x81Y\xcd\xd6\x81(\xe7jR\x03B\xbc\xb9\xe3\xc1\xc8\xd0Q\x81|L\xab\xc7\xe8:\xb8\\\x9d%\x1f\xa3\xceU\x8fu\xe5`\x7f\x9e\xcbhG\x9a`\"\xde\x8d\x0a\xda\x91\x89\x16\x97\xeeg\xc0\xf0\xd7\x1d\xbc-6\xe0_.pMR\xc4\x84\xa0\xee\xa0]\x14)\xb0\xdd\x8f`>\xdcZ\xe7xsv}\xd5\xc1\xbd\xe6\xf14\xca\xf2G\xf9\xa6z\x17h#\\\x81\xdcrJ\xc4\xed\xed\xbb\x8e\x18`V^\xaatu\xc5x\xf0G\xab\xbc\x94\x86H\xffVu-\xd5\x9b\x10\x1d$\xde1<\x8b\x96\x1d\x9c\xa1\x81T=\xf2\x0d\xfey\x87F\x14.\x0a6\x85\x0f\x93\xbc\xf9;\x9c\x02\xca\x17\x0b%\x1c\xc9\x91]g\xb2db\xf2\x94\x9b?Z\xac\x16\xd0\x1b\x9d\x137@\xe49\xd4-F9\xb3\xcf\xfe\x18>\xac\xb3E7?~d4f?\xfa\x96n\x0d\x86^$\x08\xa0\xac\x12^\xdaXC\x93\x12r\xd0~\x19\xb0\xbaa\xcc.\x80G\x04k\x17&\xc7\xd3\x8c\xb6\xe8\x1fim\xbf]\xa7\xc6\xb4\x8d\xb0<\x9bu\xd5\xc1\x1d\xcc\x10\xb4\xe2hM3\xb3\xbe-v\xbfw\x14\x8e\x10\x86\xefX\x93\x1bMrp\xb5(\xbb\x1e\xe9\x07F\x11\xe8a\xfb\x0d\xb4\x99\x9f=Q\xdf\x19>v\x8a\xe5\xa7\xc4\xb1=\xb4\x0ca\x91\x9a\x91\xa3\xfcC\xaf\xec\x1162\x1c,[\x0cA..\x8b\xc7\xf9\x93\xe8p\\y\x8dp\x929)\xa8+Xx#=C\xc2H#S\xd5\x1f\x82\xe8\xd3w\xef\xc2\xe1\xf9\xef\\\xf7\x1d\xf8\xd1\xb1\x99\xbe$n\x9e\x87u\xd2K\xcc\xa7\xf7\xd0\xf8\xf2@\x0f\xc2\x84$\xd7\x89Q\xda\xeb\x9d\xab\xe1\xf2\xa8\xf8\x94\xf8\x06K\xfdT\xfbp\xf26U\xcbl\xe1\xb6lp\x03O\xe6\xe7\xaaI*+'w\x04\xdb\x8d\xe6\xfe<\x97\xa2z\x85\x99\xac\xfb\xd55\xf7\xf8y\xc2mb\x00\xf3\xd8\x96!\x97\xf6\xc3\xd6\xcc\x0a\xfc\x0d\xca\x8f\xc2\x02!t|c\x17-\xb3\xbe\xbeXgX\x14\xa06\xf9\xc9_*\xd2\xc5\xbdj\x10\x1e\x7f\x1d)\x8c\xd02\xc7\xbf\xa8\x1cpM\xf9a\x9an\x92\xf7\xb9\xb8\x1eN\xab>\xb4a\x99@dmx\xac%\xeb\x9e1y\xc96\x90|aa\\\xd8\x0f\xe0\xcb\xb1\x19Xc\xae0\xe3L+\x1d\xbb(\x03\xc0\xa0q\x14\x92\xd3{\xb4N\xc69Y?\xaf\xba\x1cg\xdf\x86\xa2\x0b\xfa\xc1=\x19\x836\x9c\xb7&\xe6\xaa\xd0\xe4\xbd\xc9\xeeV8\xd5q\xe1,&z_\xdb\x95\xb7\xcbtAh\x0f j\x99#\xfd\x18g\x1dI\x8c/$\x85\x8d\x9cq{o\xbb\xc5F\x976\x12ybKB\x8f\xd3\xa1H\xdb\xba\xa0\xfc&ps\xe1O\x17W7p!P\xc1\xc9\xddA\xddC\x0b\xaa\xf8I\xd5\xa7U\xaf\x01\xb5\xbe\xba\xba\xde]6q}~ \x04%\xb2\xa7X)]$\xf1\xe8\xdc0\x0d\xf1@\xd7\xab\xcb%41~~\x87\xe5\xeeg\x98\x17\"yb\xaa\x97\x92\xafc\x08\x14\xf7\xb3\xc6\xbb=MV\xa9\x98\x0a\xe3Q\xdd\xf9A\x0c\xe9hA\xef\xa3\xf8\xfb\xe4\x0a\xfe\xbd*zp\x90\xbf\x83\x1e\x8a\x01j\x1fx\xe3\xc2\xea\xdc\x8b\x89\\z(\xa6_T\x80\xaf\x96\xc0\x87\xacrC\x95G\xd4\xa4<'\x14\x15\xc1\x81\xad\xd0\x9b\\\xdf\xc1<\x866B+\xbc\x01\xa5#p\xd7]\xde\xe8\xf6\x1e)\x8f\xe4\xb1\x09_\x1b\x83!\xf9j\xf7 ,\xe4\x93i\xb8L\x0f\xbe\x84\x0ej\x8f\xe6\xa3%\x1c\x0au`\xb4\x82\xfc%\xfe\x17\x91\xe554'R\xfc\x1e\\K\x13\x9a\xea,\xe2\xb7\xf1\xba\xb0u3\xd6\xa2\x9c\xaa\xfd3\xc50t\xc7\xc6\xd3\x01-\xa51\"\x19BL]xuA\xbf\x0f\xeb\xe1Vu%\xa5\xa6W6X\xcb\xbf\xea\xcb\xc5\xea \x82Ap^p4\xd8\x8d7\x05\x85\xe8d\xa6\x0d\x97\x9c\xf9\x10v\xe2\x8b\xc5\xc4\xd2e$Y\x0f\xed\x04\xd5\xf0=h\x9edj!\xb2\xb1Td2L\xf1,\x1bO\xcc)z\xab\xa8\xf59'w\xcc\xe9\xdc\xf6\xcc\xe5-\x054\xd1C\xbbV\x17:s\xa8B\x88\xa4E\x8d\x85\xe9Zg\xf3\xa8\xd8\xdb\xa3\xb4\x96J\xe8\xf8;\x87\xba/\x8d\xd2\x1eQ\x9f\x92\xfc|^\x1c,T\xc4\xf7\xbe=\xd7>xZw\xbe\x14)\xb1\x01\xfci!\xde\xf4\xe4\xf9\xb8*\x0a\x9f\xe2\xd4\x94\xa8+\\\xf5\x1ai\x9e\x18'\x9a\x89\xe3d\x0ai\x13P\x9f\xbb\xde\xa8\x9ag|\x1e\x0a\x18|\xb4\xce\xb1\x1b\x8aaB\xf7\x1d&0\xe9\xc6\x0d'" +
+	"b\xc3\x0b\\\xddO\xc18\xbb\xb1\x90\x16\x9e\xa3\x8d\x0f3\xc37\xee0\xb71\xedx\xd7\xdb[\x922\xd6\x1aZ\xdf\xa8\x98\xc7\xe3\x02\xdf\xa0h\xe1\xa7\xdeG\xe0E\x8c\xe8'\xec\xa9\x0c\x1d\xc1-\x8b\x8e\xd24B0M\xa1\x8a\xdfj\xed\xd7\xd2\xea)c\x95\x05\xffc8d1\x98\xa6\xba\xfa\x1b\x90yIq\x97\x8b\x1bV\xe2a/\xe7\x05\xeeful\x92\xa2OJ\xd7'.Z1@{\x933\xfe\x11\xf9\x91\x02\xbf\xb4\"\xa1R\xd3=\x0e\xfa\xf0@F\x16\xf0\xe9\x18]\xfa\xfa\xa3\xac\xe3\x16\x92d\xc1$\xebZ\xa5F\xfd\xea]\xa3 ;\xaf\x9aF\xc8\xca\xf6Uca \xf9\x81q\xfa\xbe-\xb49\\\xfe\xb1\xa5U\x88\xbf\xde\xf6XVty\xbb\xce\xcc\x9cD\xd9L\x1e/j3fa\x90\xc63L/\x167\xa3\x05F\x99\x88D\x1fN\x04\xbd\xe0\x1e\x86c\xf8\x10[Q/\xd6\x8c\x91\x88'L\x91\xf5\xf1\xee\x84\xf5\x8d\x0b3\xfc\xe9\x84\xad\x0a\x9do\x9d%\xe5\xa5\x0d\xe8\xb3\xbf\x02\xe1\xaa2\x8a*\x01\xa1\xa3\xdf\x91-\xe5\x05\xaf\x02\xab\x09\xd79\xa1\xc78H\xf4\xb9\x94\x8e\xdd\x98\xcd\xb5/\xe1\x18>$$Fb\xd2\xe2J\x9c\x98U\x1d{\x13=\xbb\xf8\x07\x8c\xfab\xdb\xe8W\xfd\xd7\xae:\xac\xf3D\xcb\x91\xb5Vh\x8c\xe7U\x8d\xa7E/\xb4i\x1a\xe4\"I\x94x,\x04\xad\xf3\x12C\x16\x9c\xa0\x09w\xb8\xc2\x15\x9c\x01,\x0a\x1d\x9e\x18\xf1\xe1\xad\xc3\xa2\xbf\xb8\xabZ\x8d\xca\xd6\xb9\xc6\xfd\xfd4M^\xbf\x17\xc4\x8c\xf6'YcV\xb5\xbe\x96\x9a\xf0B\xc8\"n_\x87\xaf\xb8\xff\xd4\xd4\xc3\xd7\xeaRu\xda\xb0\x0d\xfc\xd4\xcb\x95Wh'\xe8$\x8c\xde\x93\x1c\x81\xbb\xd2\xc1\x9eH\xa6\xae\xd5\x00\xb5\xcaw\xd7\xbe\xeb\x9d\x90\x02\x9d!\xac\xcd\x93G\\\x1e\x12\xc75\x91i\x10a\x92\xfbCQ\xa7D\x15+F\xc5\xd3\xe7c\xf2\xc4<\x9a\x80e\xdfhh\x08s!A\x81\x14\x8f\x98T\x82k\xf0\xcf\x84;J\xd7\xb8\xab\xab9C(>\xc9\x9d\x87\x9b\xa7^+\xe1R\xe7Q\xe9\x7f\xdb\x9ek\xc0\xb0R\xa2\x87\x1f6\xeb\xb5)1\x83\x08\x8f.u\\v\x10\xe6d$\xe1\xae\x9aP\x8cj\xa8Z1\x12\xd0\x05W\xae*\x1ah\xc1\x84rL\\|\x89>\xf6\xe9\xcb5\xe7>6g\x9f3\xd8\x11\xea\x1e\x1bz\xb9\xbaH\x93\xc0\xb18$r:\xac\xb3[\x97F\xb5I\xedh\x8d\xbd\xb3\x18\xd3\x15\x9a\xe2T]\xab0\x02\x1b\x88\xadYxC\xe6\x01\x0b$\xaa\x95&\x08R\x8b\xfd\xc0\xc7\xee\x09\x1d\xab\x0a\x12;q8:\xd7x\xa9|\xdc\xcb\x18\x10Cp\xd83\xb1\xf2\x9e\xe9f\xbe-p\xc6?\xfb~vM\xce\x19\xbf4\x9c\xbf\x99\x05\xfc,\x09Xhh\x8b\xcd7\x8do\xbd\xbb\xbf\xf7'\x83\x8f\x17^0\\t\xc7\xf6~~G.\x1dZ\x0d^\xc7T\xa1\xa5\xe1\x94\x15u~N\xee\xaf,\x96\x88t\xdf)\x96\xf4'\xd75F\xe3\xe4w\xd0\x0e\xd0\xd4Q\xa8\x9b\xd8\x00h\xf8\x95<\xb0\x0a\xb4\x124\x96\x0d\xb4\xa5\xb3\xb0^\xd7\xfa\xb5\xda\xe6gOG\x87\xb9L-*\x0e\xde\xa9\xd7\xda\x9eEtE\x06e\x9f\x14\xb0\x98u&>l\x0e^\xee+E\xd1\xe3\xffq\x19\xca\x1e\xcb\xc9\x12<\x86t\x16D\x8be\x86 {\xb3\xd9\xf2,94\x90\xab\xc6p)Q\x9c\x08W\x02\xae\xcb]\xd0!\xf5\x83$Dn\xbe\xf6\xf6r7\xf8i\xd7\xe6\x8avep1\x9b\xdb\xc8I\xe1\xb7B\xdeD\xec[\x9c\x8b{\xdd\x9a#g\xc3#\x11\xa4\xfc+\x8c\xa5\xe3\"\xb9\xc2\xb3\xe7\x93\xab%G4\xfd\xbd!\xd7\xc0*\xf0\x12s\xb5\xdf\xfd\xd9\x9f\xf2\x01\x98R<4Y\x9e\xf0Ewm\xfc1|\x98\"#\xb7\x81C}A\xe0,\xf0|Y}3\xae^'\x13\xdfb\xf7\xcd<\xdc\x17P_\xaeP\xdbV\xa8N\xb5\x04\x0b\xce\x0a\x9e\xd9\xb1c\xab\xb3\xb9\xad\xceU\xa7\xcc\xba\x1b\xe8\xd9\xe7\x90\xe1\xd9\xb67Y\x93j\xa7\xfc\xcd\x19R\x06\xa6\xacZ\xde\x13_\xcd\xef\x89?\xf4\x0d\xb8\xeb\x81j\x00\xe1;\x1c\xa0\xff\xde_k\xf7\xd3\xd5\x85Y\xd6d\x95\xb8\xa3\xb5\xb7\xa9\x01S\x0eP]]\xbevTH\x91$\x85&\x02'\xd3\xab^\x9d\xb4\x84\xbb\xbbt!\xacD`\xf8\xb9\xd3\xe3\xb0\xce\x01\xafh<\x94,\xf8\xee])\xfd\xed\xda\xc1\x1f\xf1\x93\xfb\xe5\xe4kn!\xa3\xb6\xd6\xb9\xdeE\x16!\xc9FwCu\xad\xc4 \x1d\xa0\x0f\xee\x0a\xb4G\xfc\xdc\x1b^-\x0cq\x19\xd5\x86\x04\x9cat\xc0\x11:\x8a\xad_\xb2\xa2\x9f'P\xa48\x15\x05\xf90I\xef\xbf_\xe7\xf7\x0f[\xd6=\x11\xf7,\xc9\x0a<\x7f\x9a\x9b4\x91\x09\x07\x91\x96\xa9+\xac\x826\x92\x19{\xb4\xce\xbc>\xc2\x90\xf0\xfc\xb3\xb3\x86\x154\xe2\xa9\xc3\xefG\xcf;\xb9\xfe\x88\x9f\x8bob\x14\xcc\xcf|@\xef$I\xf4\xd0\xa9\xcd\xb2\xa0\x0f\x9c\xd9\x01\"xX\xa7j\x97\xa9\x1d\x89\x80\xa1m\xa19$\x19BZ\xed\xc2\xf0\x15\xc3\xac)\x18Q\xbe\x9bp[s\xdb\xd6\x8aG\x9f\xbf\xcc\x86\x9ep\x9aR\x90P\x9c\x1c\xe51\xa5A\x02\xd4\xc0J\xf0\x9dLr\xdd\xcb\xa7\x88\x94\xc9Y\xca\xfeR\xba\xb0\xabo\xe0t\xff\x90h\xceD\xab]h\xcb)\x00@\xef\xdc\xc0\x17\x98gS\x8e5\x14\xbb\x0f\xd3\xc2E\xfb)\x1c\x16B%T\xfaw\xa26\xb8\xf1\xbc\x12i\xb4\x022\xab\xea\xa1>\xe2\xa7fG\x09\x86\x1bk=\xc4\xff\xde\xa9&\xc1\x1c\xee\xc9\xceEm\xe1\x8f\x8e\xdb\xc7\xba\xf7\\\xd1\x9cqrj\xa2yY\x84\xce\xc6\x15W\x8c\xd4\x17\xdf\xafJ\xb0\xad\xeb\x9b=*\xb0\xf93m\x0f\x8b\xb1\x89a\xe8D\x13-\xdc\xbb\xea|\xe3\xfbZ\xf5\x97*\xa3\xa3ux\xe8\xfa\xe0*\xa9\xb5\xb7~<\xcd\xe6W\xec\xaepv\xfbI\xf4\xc1\x1b\x89\x0c\x91Z\xee\xa6\xe6S\xfa\x8e\x98\xd8\xd8n\x0f/\x80\x7f\x9a\xa2X\xbc\xba\xf0\x0egh\x09MD7\xda6\x8e\xc9\x89\x93T2\xcaT\xcb\xa3!\x86!#\x06\xd2\x09\xdc=C\xbcmUPj\xf2g0\xd2+c0\xfa\x1a\x8a>\x15\xbb\x8bk\x17\xcc\xe5\x17O\xc7\x9c\xadN\x9eh\xb2*\xc2\x8ds\xfdZ\x1f\xc3\x07\xb4\x07\x95\x17\x90\xe0:\x0c\x93\xefC_]!c\x98Q\x00\xb8\x1fe\x04\x08\x19\xc5\x85{\x87Bv\xfe\x1b\x16\xee\xb0VoXD\x17\xdf\xba\xb5\xe8\xfe\x8b\xe7c\xfd\xd4\xb4Hu\xd6gfX\xce,\x80U zye\x95z1)\xf3m\x8cI\xfd[\xe6\xb8Qy\xfe5\xf0W,\x0e\xda c>\xa3a\xbe\x0c\xe0k\xcf\x18\xaf\xdb\".0\x92\xa1}O@\x0a\x93\xcbahE\xf4\xa0\x0e\xeb\xda\x00\xb1\xb2m\x92\xd2v\x03\xe7\xff\xbb\xda\xab\xa2rt\xec8\x0d\x0b\xad$no\xbe%\x90[\xe8\xabk\xd1\xc0\x15\xbe\xf3\xd7\x02\xbe\xbb\x82\xad$sZ$`\xd3\xcd\x1b\x857My\x10\x873\xbf\xc1\xe5\xde\xc7\x034\xc4.#\x00l\xa2\x02X'\xa0\xc5d\x8c\xe7\xa0\xa9\x10]\xd9\xc0^>\x9e\xe7\xf8\x88\xaa\xfa\x1c\xc8)2)\xdb\xc95\x12\xfa[R\x92\xa6r\x9a\x13\xa9*\xd6\xbd\xd6\xae'\x93u&\x0cx\xf7}\x18\xca\x8e\x05\xb8\x8d\xce8\x06\xc4\xfd\xe0\xfc-\x8d\xcev_/\xc5\x0e[\x03\xed\xfe\xa3\x7f\x93\x83B\x992\xb1\xeb9\x9f\x1e\xcf\x06\xca\xac\xeb\x9d\xd6\x9a\xfd4\xd3\xdd\"\xca\xf6q\xe1\xb8\x88X\xe2\xdd%6{X\x97\x1c\x10\xc4\x82;]]\xffF\xf0 \x99\x96\xa30\xc4\x09\xb7\xed\x12\x95\xc5O\x0b\x92o\xb1T)\x8b\xe8Y\x96Jl\x89\x8aQ\xa9\x96\xdc\x05\x91\xfbN\x14\xb6b'&\x95\x0b\xfe\xa8SDC\x8f\xa5\x07\x0e^\x054e\x91\x13\x18g\xaf\x1a\x8f\xa2\x06a\xa1\x1df\xfc\xe7\xdfx\x87l\x0a{\x8e\x91\xbc]\x1b\xcf\xa8\x09\x15^sD\xae\\{\xab\xbac6&\xb5\xbb(\xfb\x10E\xee)\xed\x11\x1a~s\xc2UA/3\xbev\xfbjy\xb92P\x9f\xb8>\xc9\x93\x1b\x12\xf1\x07\xe8T\xbf\x99(/\xb6\x92\x89\x1a\xe0\x0f\xd8\xdby\xaf\xec\xe5\x8c\x08f\xf8\x12\x0e&W\x1d\xc2\x00}\x93\x92\x82D\x11\xb2\xb57}\xbd8 6\xd5\x84{\"E\xfb/\x92p\xb2\xcf\x8c*\xfc*\x8d\xb6YVa\xa6\x9b?O\xd0\xb0\xceks\xd9\x06\x1e\xd6U\x15\x12\xa4\xa2\xa9\x9a\xa3\xa5T:,_\xae\xa1\xa5\xe4-\xd6\x99\xe2E\x1f\x1d40\xac\xd5\xae\xbez\x92vl\xee~\xa0\xf4\x19\xa2f{\x80V\xa6*\xd4~\x8cn\x89:\xe3\xcb\xbb\xda\xab\xa7\x0b\xece\xc7O\x1cRm|}\x09\x0d\xca\xa5\x037\xc8-,-\x86\xc8q\xa0:(\x14\xe1>~\xf4{\xdc@E\xb8L@\x87\xb6\x9e\xf4\xa3?\xb2\xeb\xbb\xd6\xad\xd3\".\xef\xeaL\x17\x96U\x9e\xb20\x86\xea\" \xc1\x8c\x16\xe6\xcca8\"\x0e\x89.\xcdz\x0c\xf3\xee4&\x8f\xd6\x95\x1c\xaca|\x017\xb6\x8c\xc9\xb0\x12z\x8bv\x80\xc2\xdf\xe0b\xed)\x8etQ\x000D7/\x9c\xf3\xd5\x92\xb3e\xf9\xba\xf0>\xb1\x1a\xe7\xd1\xba\x1aD,\xc3i\xdd$\xb0\xdf\x98H\x18\xac\xb2\xc2\x10\x8f\xd6%!\xa6y\xbcF\x05\xdb:g\xf6b\xf3j\xc3\xfaJ\x00\x95\xa7\xaa\x83\x9b -\xaepv\x1bj!_k-\xe4?\xff\xf2\x9f~\xfe_\x7f\xf9\xcf?\xff\xebZM\xe4G\xc4\xe4\xecl\xcf\xecm\x85\x12f\xd2sWd\xec\x0b\xbc\x17\x00\xf6\x96\xb2\xc9\xd0A\x19\x06>\x07\x02e\xcd\xda\x9b4\x07\x8a3\xef\xe3\xc2c\xd0,1[\\\x854\xc1`O}\xd7rhu\x00\x18\xf4)\xc7?,\xccs\x13\xaa=s\x0d\xaf\xacw\xf4\x11\xa05\xba\x06\xa6\xc2\x8ch\x01\x98\x13\xa0\xbfw\x9d\xab9\x12:={1\xd0}\xab\xae\xae-=\x86\xdb\x8c\xeb\xb7\xcbK\xf0Rl\xdd\xbdHx\x0bp\x8f\xdd\x82\x1c/\xd5b\xc2\xd9DB\":?\x92`\xe35G\xfc\x8cVl\x9axK\xd0\x84M@E2\xf8,\xddD\xd4I\xca\xca0N\x0a\xc1/\xc49\x9b\xba\xbf\xbe\x1es\xd9\x8es0\\{Cn4\x859\x89\xf8`\xbd\x005'\x9c\xa0G\xaa\x0e\x83\x8c\x07\xb4P\x1f\xf0E|\xa2l\x80\x1cDp\x1b\xc32\xc2T2=\xc1\x8e\xc4\x91\x7f\xa6\xc7\xb0Xo8\xfd\x8a\x8cgF\xb3\xa0S\xce\x7f\\m[\xbc\x7f\xa3\xfd\x0c\xcbPL^Y\x83\xbf\xf3a\xa5\xc5\x93\xefM\xc1\xdf\xf5\x039L\x0d\x85\xd0\xb0\xd8\xc6I\xc5\x89\xf9;\xfd\x12\xffN\x97\xca\xdf\x05\x85\xd2\xc0\x00ht\xd0\xd3\xaf\xd0\x1e\x0a*!\xe1J\x920M\x08\xbc*x\x18\xc3.\x16m\x98G\x85\xacK\xe9\xb0\xbf2P1\xd7Y\xc6\x12V\x89b,5`\xbcO\xb8^\"a\xc6\xbc/\"\xf9\xa4\xc8c\x98]N\x8b\xa4|QVW\x0c\xceb\x09\x80\xd6W@\x0f\\%\xdeQ\xe5\xf6\xc0\x06v\x0b\xf5#\xb9[\x02kV\x90\xbf\x1f\x0aw\xf2\xa8\xe0Nn/\x95\xbb\xc7\xff\xe1\x85\x83;CQWgz:\xe3kw\x8c\xf1\xdcO\"mY\xd6V#\xaf\xfe`\xeb\x98-\xda\x85pY!\xfa<\x19\xd7\xfa\xe0$\xbfb\xc4\xf7\xe8\x1dn\x98\xb2\xa00\xce\x12\xcd\x84\x1e\xe8\xbfW\x9a*B\xf4\x8eE\xee\xd2]\xa5\xa9\xba+\xda\xc0\xbb\xf0\xdc=\xdbK\x1f\x94\x0c\xb1\xa9:W^\xf0-\xf0\x86b\x07\x90\xc9\xf9\x87{\xae0\x8f\xecXaW\xbb\xf7\xb5\xef\x0e\x85\x90\x1e\xd2\xf0\xf0}(\xca\x1e,+\xbc\x0a\xe3%n\xf0o8\x08\x98\x91\xa3e\x0a\x80H\x0b\xe6\xaf\xa4\x0c\x18\x81M\x8f\x8a\xa8\x0fd\xd0\x0f\x14\xbf\xe7,Y\xe3\xa9\x06\x80\x1a\xd3\x90\x99\x91\x9c\xb0\xba\x8fM\xa8\xfeg;s\xb8\x89\xee;l\xc4\x98\xaf\xab\xf08N\xad]\x09%\xec\x9b\xf9,l\x9as\xe2\xc0\x8d\x04\x80\xb8>:\xdc\x90\x16\xd9\x05:\x8e\x9a\xd2\x9b \xab\xe2\xce\x10\xc1\xec\xe7\x94\xd1R(&\x0d\x9e\xae[y\xae\xf2\xcbI}\x19\x11\x1e\x86\xabMx\xe8\xeao\x1a,\xe1^\x8cMS\xb5\xe2\x96\xaf\xc0\x91\x84\x8ce)\x0a\x0e\xb5g\x82\x9fRz/\x16\xdd\xa2\xb6kR\xc4\xa3\xa6mAe\xadG\xfaa\x893\x84\xce\xd0\xf0q)v#\x16\x10\xd0Y3\x19\x83%\xa4\xed\x91~\x18\x93@\x17\x00MX\xb4\x84\xe9t\xc3\x0dy*$\xa0\x86z\x16e_\xd3E\x8a\xb80\x13\xca\xb9H\xe2\xa2h|\x7f\xdf\x89D%GS\xa8\x9c\xb4=\xef5\xbe\x93\xae$w\x82\x12\xf7\x12j\xed\xd1\xf0\xd9\xaa\xcdcKb\xc2\xa6n\x99A\xb1\x10N&\x16\xe6j\xf7*+-\xe8\xfd0\xfc\xb7:\xaby\xc7\xea\xf2qG\xbf\xd3b\xc2i\x15\x01YUs\x9e\x9bZE|a\xe4\x07\xc8+B\xb4\x0b\xa0\x06\xc3W%\xb4mX\x81\x101f\x8f\xd6\x8b\xef\xc7/\xf3;\x90\x0d\x91>?\xfa\x94\x85Z\xc1*\x11\xaf+;\x94\x18\x96\xff\xb8\xc4\x90]H:K\xcb\xec\xaa\x0e\xdd\x90\x1a\xa1a\xc0\x9c\x80h\xbc\xd0*0\xc7\xd4\xd5C\x9fm_d\xb8O\x16\x8aI\xfd0\xd5\x97\xb8/\xbe&C\xe6\x06F\xa1\x8ekQN0,\xea1\xccw\xd8\x04\xae\xd2*\x1f\xc5\x9a\x056\xe9\x1f\xe0\xc0\xc8\xa6\x8b\xf6\xc0\x15\x88\x06\x0d\xfd\x91\x0d\x9c\x00\x1f\xa0]lN0\x19\x8a\xc4\xf4FE\xf6c\xf8H5\x92\xd6\x8a\xb6\x9f>\xa0sh\xedA\x1d\x91\xa5&\x98E\xc8:<\x7f\xb1\xe5\x8c\x1dI5\xc9\xd6Z\xf3linG\xa3\x01\x11\xd883\xa0V\xe6\xc4\xbfrT\xd6\x1b\xf7<\xe3u\x14\xaf6\xac\x00!\x05\xb7s\x99\xb7\xc7}\xa6\xa1\xa7\xbe\x891\xbb\x1e\x15+\xdd?Z#\x9d?\xbb\xc2\x8d\xca\xa7\xc4\xaf\xf3g\xa7\x81v\x8d\xea\xb5\x9ab\xb0X\xd9\x18\x94\xac1\xde(;\x81\xda\xf1\xf7s%^5>g\xa3\xee\x93\x1a\xf2\xef\x0dO\xc8\x15n\xae\x87\xf6\xc8?5\xbe\xae\xd5\x0f|\x1d\x1d\xbe\xc8\x85*~\xf5\xcd\xb7\xa3\xaf\xef\xe4\xfb\xc1\xb37\x92\x004kh\xbf\xc2I\x9cn\x93\x7f\x88\xa6\x89\x90\xb4q\xe8b\xbc_\x86=\xf52\xdaf7\x90\"\xccE\x1a\xee\xc0_\\1\\\xe0|\xf1\x83\xe6\xc6\xb3X\x82\xca\xea$\x96\x93X\xfe\x96y\x85\xc3V\xac\xa0\xf4\x06\xa1\x06'C\xe5-\xea\xda1\xc7;\xe6\x0f\x0ct5\xeb\x94\x09\xf2\x02\xd8s\x1d\xd7\xf2\xd70\x10\xed\xcb\xd5\x16W\xea\x0200\xcd\xe8\x95c\xc0!\xa5\x11\x19\x1f\xb3\xec\xa3\x87v\xf3p\xaa\x90\xa1an\x9e\xfbzt\x82\xa0^R\x12x\x9d!+vd\xf1H\x18\xa2\xf3a\xa3i\xa1w\xa7\xfd\\\x1b)8\\W\xe8\x10\xcb\x8cb\x01\xa9\xb7IN,\x86\xdfU\x8c!3\x14J0\xd2\x96d\xb2\xbd]\xa7m`\x06\xcby\xa4jn\x96gB\xfa\x0c\x0d:\xd2\x0f\x0bL>\xc8\xbal\xef%\xfd\xe2\xb9\x80e\xf6;\xf4oY\xbc\xf4\xb8\xf6\x0cW\xd7\x15a\x7f\x03w\xa4\x1f\x8f\xc4!\x94\xb2u\xa2\xb8\xd48\x98\x01!\xe2\x84\xb1\xee\xa3\x86\xbe\xdas\xed\xd0\xb4n\xef\xdcb\x1412J\xbc\x9b\xe2|\x8e\x85\x8c\x87\xa9d\xbe\xec'\x1a7\x00\xc7\x0a\xfc\x14\xc2a\x14V\xe9`\xf0]k\x8e\xec\xde\x07w\xe6H?\xe8[\x8e\xcc%\xf5\x7fJ\xa7\xe4\xf5\xc9\xc6\xc4U\xb1\x03\xb4\xd0\x1c\xf1\xd3\x90\xeb \xf0\xef\x88\x9f\xc57\xe8ZJd\xca*\xc4G\xd7\x18@\xa7\xdfI\x81\x80\xbbP}f\xc8\x0e\xb5!\xd4C?\xa0=\xd8\xf6p8\x03[s\x81\xf6\x8b\xef\x08\xe3\xd7\x81;\xe2gf\xd5\xc5\\qrhd\x88\xf2\xbc\x0at\xad\xa6bq\xe1>y2E\x9c9\x95\xb9\xf3ud\x15+\xc2\x18\x87\x16G\x0f\x10\x0de\xb6\x08\x8a\x1f\xfa\xb0\xf6\xae\xaa&\xcd\xcb\xe3\x0b\\@\xa4\xcdt\x0d\xedF5\xba\xfa\x02'\xe2R\xc3G\xed\xdf\x16\x9c\xd1\xa5\xf2\xe7\xa4.\xe1\x9a\xb1h\x99]%\xbbM\xf4.\xad\x00\x84\xddE#\xb3ap?\xb7\x9d\xd1\x91\\\xc3.E3\xcd\xe3\x06?\xda\xcd\xa3,\x93eod\x90\xea.\x04\xa5\x93\x92\xe0=\x05\x01\x83\xbb\x18\x96\xcc\x11?\x0f\xe1\xd8\xaa\x06\xe88\x05O9\xe8\xab\x9b\xc0\xd7%n\xa0\x0c\x82\x99\xb52\xeb\xb9\x88\x96\xeeR\x8a*{\xae\xc12\xea\xc7\x1f\x06\x9f\x1c1|\xd0\x8a\x07\x8e)\x107x\x0d\x1f\xb6\x18\x11\xef\xdd\\\x08\x9a\xfd\"\xc4C&W\xf5\x12\x1c\xe0'H\xa8j\xc8)\xa2\"b\x08\xb1\xb1K!\xd3'\xcf6-\x86\xa6j\xd1w\x13\xbb\xd9\xd7 \xbb" +
+	"\xf3\xc5\xb5dIEJ\x82\x80%\x1d\x99\x8aS\xb3\xbe\xf1u\xe3\x92\xda\xcf\xbaj\x01o\xb5\x7f\xcb\x8a\x89\x19\xf8x\xd44$\x00\x11;\xf8\x9f\x08pm,m\x11uZ\x0a\x94\xadq\xa9\xa81\xff\x8fc\xb6\x86\x84jmX\xa4g\x93\xa2,\x82\xee\x1a\xb7\x9a\xbf\x8d=H}\x19\xe5N$\xa0\x19.\xe81\xde\xb4\x81J\x03\xc7R\x0e\x1ea\x0bL\x1fVW\x17\xd7\x82\x14P\x84\xff\x95\x1e\xc3\x7f\xbeuS\x8c-\xd8\xb88\xa6\x12F\x91HN\x8f\x0b\xf1\x0a\xde\x92aS\x94c\x03\xe5\xc4\xe71u5\x15\x161\x97\x14mt\x8a.\xbbBS$I\xe0\xbe\x1a\x1c\xdc\x08\xd2\x8e\xe1\xf3\xe6\x06\x0d\x8c\xb2h\xd91\xb2\x81\x15b\xf7\x03`\xd8O\x9fF\x0f?\xc1\x05z(\xdeup\xb2\xc3D=y\xaa\xdas\xfd\x95\xcbb\xb4\x18a\x9f\xb5E\x0c\xacG\xeb\x14\x0c\xea\x91\x84\xeeH^/\x12pK\\\x99\x852\x8a\xdd\xc9\x97*\xad\x9d\x16TkRz\x9an\x87\xbe!\x89\x08\xe5\xdbJB\xddx\xf3\x944\x1c\x9f \xcc\x0f6\xd3b\xda\x14\\\xf1\xfd\x9b\x82\xc3h\x84\x01\xa3[\xb1\xcf\x01\xc5\x05\xaeh\xfb~\x9ef\x18\x1e\xc8\\5\xf4A\xb2\xf2\xb2\xd0\xda\x06\x16\x88\xcf3)~`\xda\x84\x85\x0a\x80\xc11/\xc7\xa3uR\x07\x8d\xf2\x8fC\xad\xa3-R\x08\xf8\x13\xa8iQ\xba\x02\xd9\x13D\xb6p!*\xff4\xb5m\xf0\xf8P\xc0\x1e\xadU\xdc/\xa3\xc0Jf~x\x18B\x91\xd6PPv6\x95\x82\x90\xa0\xa9\xeen\x116#|\x15\xb6\xa2\x02\x07\xf2}8*jz\x8b\x1c\xf7\x12\x92\x0e\x15A\x17m\xfd\xcd\x8cy\x87\x91\xec\xb7\xeb\xc4\x0e\x1f&\x8c\xaao\x16\xe2\x93\xf0\xbb\xb7V=\x9bd\xfa\x97\xdd\xc3\xd5\x85\x1bY\x1e>Y\xb2\x13\\{#VC\x8d\xef\xc4:6If\x10\xf1\x9b\x0dM\xc8Y\xb6\xa8LxX'o\x803t\x19\xadP\xc8\xfd2\x97.\x917\xec\x13\xeb2\xab\x8c\x15c\x0e\x0f\xfa\xf4}\xe8D\xd5\x8e\x0d-\xcej\\\x11?d\xd3\x00\x02\xaa\xcc\xcf\xe8\xec\x9e\x1b\x08\x1ct\xb2\xd1\xcba X\xcd_Fg\x8c\xc29t\xd8%\xc44\x1c\xf7\xa9\x0d\xfb\xd9\x8ebx\xd0\x1e\xe5\x1f{1\x01\"\xd8\x83l\x96\x05\xa3\x8d\xebUp\xb32\x9a&\x11\xdb\xcd6$\x9br\x8f\xd6Y\"fl\xc5\xf4v\x11\xfes\x98iA\x9a_\x904ThB\x91u\xea8;\x1f\xfd\xae]\x84\x0eb\xaf\xef\xc9\x7f\xff\x0b\x9c\xde\xae\xb3N`\xd7\xd1\x0e\x99\xa6f\x07\x81\xecD\xf3E\xe3\x9e.C$\xcf\xe1#8\xd5\x12\x8d\xe6\x99\xfa+|G\x89j\x8e\xe3\xac\xb8\xab\x0eUG\x89\xbf\x84\xc9d\x84\x98a\xa5\x9e\xce'S\xe4@\xef\x96cVh\xe3\x1c%%c\xc1\x00\xbfg\xa8\"\x85+\x1c\xf9'\xa7\xf5t\xe0bR2\xbd\x0ff%a\x00?\xf9t\x9a|\x9c\x00\x1b=21\x9e\x9a\xaa\x85\x8bf\xcb\xc2\xf2y;\xd5O\xff&\xac\xbc\x18M\x0d\xd39\xb2\xe8|\xd4\x08\xfb\x91\xc37\x03|\x83\xba\x86\xeb\x1c\x0f \xe7'6\xd0\x8cL\xe7\xb9j\xf0\x0d\x81\x85k\x02\xa6\\\xf04\x06\x17\xf3\xa4P\xc2\x04\xd7\xb9\"_\xf9\x9b|\xec'R\x10S\xe8\xc0\xc3:\xdd\x88Y\xae)\xb7\x1b\x89uD\x88\xbdk\xb9\x86\x14'3\xae8\xc9\xad\xd3\x99\xa2\x8eW]\xd5p]#\xcex\xbcLc\x9bC\xf8\xde]\xc7(>\xa5\xed\xb0\xb4y\xa3s\xf6MV\xcc'\xd67\xefI\xd8\x83;\xfd/\xda\xb1\x91\xdb\xc5\xc8\xdas\xa9U\xbc\x17n\xa9\x13 \x11\x12nl`\x80c\xf8\xe0\xeb\xf0`\xdcI\xfaa?}w^\xab\xb1\x9aK\xb1\xab\xf9\xc9O\xb42D*\x13\xcd\xa3\x15\xb0rdMQ[\xbe:c\x0d\x9f\xfb&\xd2\x88Z\x90\xd3p\xd8\xa6d\xa0$^\xb9O/\xe5\xf6\xa0\xebI\xac\x1c\x1c\x9d\x18\\\x89QD\xc6\xe6 &F\xa8s\xc6w\xa0s\x16/b\xf9\xbb\xb0m\xc0`\xeal(j\xa1\x12\x11x\x8fX]\xcch\xf5\x0c\xddE\xb7\xda\x16\xe9\xd3\xe8\xde\x00m\x89\x0c\xed%\x18\x04z\xdc?p\x11\xeb@ k\xca\x06v\x98\xf7\x94\x0d5N\xa9\x805i\xd3\xeb\xef\xe1'\xf8\x0e\x86d\x8f\x9e\xda\xd1\x18\xe6\x12\x03\xfdz/\x14\xb3\xf3g\x08\x87]_\xbb\x9b\xd7\xc7E\xdc4z_\xb8\xe5\x0c>\\\x10zVu\xd3\xa1\x86\xebJ\xf9\xe4\xb3\xd5dg\x86h\x8d/\xc6\xb0\x0f\x02\x84\x17\x1f\xe52\xc6\xc6LS\xef\x8f\xb0I\xbaY3\xe4\x9csK\x1a;\xd9\xc6\xd6\"p\x1dJ\xe5\xb7\xd0$9\xda`+\x9d\xfcp ^R\x09\xb9\xce\xa1(\x84\x85\x91\xdag\x02\x18\x1c\x99xS\x94\xe6\x89\xf8\xac\x91\x05\x97\x0cz4\xd8\xb0\x11\x83;\xc11|\x18\x87\xfd\xec\xb0\x15\xa1Y\x1b\xc8_\xc6#\xf5\x7f\x86\x0b81\x03D\xc7>\xef\xfb\x83@\xf1\x0c\x87,>\xf6|\x01w*\xca$<{\xc8y\xcf\x0c\xa6\x97a\xacY\xb5\xc5\xc8LQ\xe6\x18c\xff\xb2\xd7O\xc7\xce\xda\x81\x92\xb1\xc5\x08*w:Pg\x83\xf4Y\xd1jxy\x9d\xa9\xb4\xfb\x85\xbf\xa8\xa6A\xf8u\xcd\xd9\x81\x9e\xad\xc2\x91='Gi\x85\xc4l\xbc\xd5'\xe4G\x9d\xb3'\xect\xac\x92\\\x82\xbb\xea\x0a}\x1eg\x8fA:3kh'V\x05\x13q\x99BW,9.)\xcb\xcdN\x9e\x97\xba^\xac\x0bG\x0d]p\x93\xd8E\xb3\x19\xce\xd2]\xdd\xcd\x0f\x1c\xf7\x89\xd5W\x89\xcb\xc6\xb5\xe0:\x0d\xc8W\xe52p\xf6\x01\xd6Yr\x92\xfaM\xb5\x0c\xd8L9Q\x12\xc4\x90\x96\x05s\xe30\xc6\xf1\xcdp\xc4\x13\x9a\xcfp\x9dl\xa0\xa1d\x17\x88\xbf\xea\x06SQ\xab4\xeb7G9\xe5h\x9a\x1a\xfa\x05\x83]\xf0w\x0e\xda\xc3|\xf0)\x0aLl\x08@E\xc2\x9e\xcf\xd4\xe2)\x0e\xe0$\xaa\xbc\xa3\xd3%\xb2CrD<<6\xac\x0e\xf5\xe9b\x05\xf6\xd9\xb7\x90\xce\"u\x0d\xa5\xf4\x1f\x7f\xed{\xb8,N\xd1\xe7\xa9@\xa9N,k\x7f\xceO\xd0\xfc)\xd3%\x14\x1c\x91\xcaK\x10\x98\x15\x97\x9e4~\x08\xdeTv\x8a\xe4\xc0\x15Oe\x9b\xaeUJ'\x84wH\x84\xf42*o1\xe0\x10r\x8d\x8e\x962\xf1p\x82\xbe\xb99\xf6\x9di\x04\xe63=o\xff\x96\x16\x12\x82\xf8\xd6I\x8c\xb6\x03\x03\x11wy\x03\xec\x89\x01\xfah\xfcN\x00\x87\x0e9\xc3\\\xc0\xc3\x87\x83\xa9\x80\x9e\xb3\xa1\xecnN\x91bK\x1fK,\x0a\xa1\xd3\xc7\xf0a,\x99\x1c\xdb\x9c\x97\x0f\xf0\x97\xd3\x02\x82{\xb8_RMy\xfe,\x95\xcdC#\xc7\xa4\x8b,\x08\xf2 \xa8\xd3\xe8\x9f\x1a\xe4\xcf\x1f\xeeA\x971\x07E\x0d1I\xe3\xe3\xdeg\xc0\x04\x02\x9dg\x197\xe2K\xd9@\xdf\x14\x1a\x8b\xe0\x99\x9cy/;)F\x92UR\x15\xc1\xaf\xb4\xdcb\xa5\xd8d\xc3H\x91\xb6\x09\x1c\xa2\xbd\x87\x92\x04P7\xf0:qJ\x84\xe6J1\x0aG\xab\xbe\xb9\x04g\x90\xa9\x06sM7\x0aR]\xa1\xad\xb9\xf4\xb0\x0e\x07\x188\xfcE\x04\xee\x89\x16o]}\x83;w\"\"\xaaD\xdeX\xde\x82\xd0\x81I\x1ej\xbeb1?$\x8b\x1d\xa1\x00X\x80l\x9d@\x0ac1\xe3\xd7\x97\xc5KG\xdbw\xd3\x95NM\x1e-LbP~\xbd\xe2RB\xd8\xdfm\xa0\x94z\xb7\xde\xd0\x84\x94\x948ap\xe6\x8c\x9a\x8f\xe720\x0bX\x1b\xd6Vp\xcc\x99\xf4&\x9ar\x1at\x99|\x03\x1b\xeb\xfen\x0b\x7f\xd5{V,\xa0-u/\xf0 \xcd4j\xce\x82\xe2z\xd0\x1e\xcd??\xfa\xb8\x00,\x1cFs \x8a\xb6\x9ba.0$\x0d+\xa5\xe2\x98\xdf\xd8\xcc(\xa5\xdcw6\x7fdCQ3dC\xd2}S\xf9\x9d\x83M\xec\x93d\xb2\x1b\xa01!`K\x9a\x94REA\xa9i\x9c9tN\xde7o\xd7\x19\xa4>\xc4\xc0\xf9\x18\xfb6\x8dW\x13\xe3\xae\x11\xf2qU\xa6\x15\x1b\x81\xf5\xda\xc2Z\xb9\x98\x10\xda\x8e_+\xfa\xe6\xe4zD\xff\xaf\xf45\x14_\xdc\x00\x1b\x98\xa2\xb4\xd5\x1a\x91fa+\x8e\x02\x98\xe0\x0a\x8dU\x07\xd9\x15\x1b8\x9d\x12o\x9e\xfcs\xdf~qG\xfc,v\xa9\xaa\xdf~\xec\xe5O\x9ac8\x0f\xe3\xccP$\x8e\xce\x915\xf8d\x88\xb4=Z'\x82\x9a\x89\xaeC\xc6\x04\x82\xd4*B,O\xe8\xed4\x9e~\x81\xa5 \x81!\x01Z\xa3\xa8z\xbe\xa2\xa6=\"\xf2\xe1\xc23)0i\xe1\xe4\x8e\xe1c\x9f\x98\xf1\x8d\x7f$rM\x9a\xd5\x107\x9bY3\x8a\x1d\x89\x8f\xd9=\x10\xda\xe2\x027\x8f\x0e(4pU\xb9N\xde\xe2Wv\x93\xb5a0a\x0a\\0\x98!\xc8\xf8rLp\x86\x03\xa4\xd8\xfd\xa1\x19cR\x9d\xf9\xb3\x86\x8a\xe6\xd6v\xb6\x97\x13\x82u\xd1\xa9B9\xdf\x98Fc[\xe4&\x7f\x89\x18\xb2\xa6\xea)|*\"\xb8\x1cV@\x8a\x9eVA\xfd\xe7\xaa=\x9f\xa1\xa1\x953\xdeHK\x13\x13sg\x7fE\x115\xcb\xb8\\\xd4\xd0\x8e\xab\x7fq\x14#\xbd\xd7\x8f\x04j\xfd \x94H\xb9_d\xa7\xc0\xa3u\x96\xac\x0f\xec\x0fQf\x85\xc0@5\xa8\xff\xd7\x00\x1b\x80\xd6\xdf\xb0k>\xc2G\x89\x87c\xda1\xd1\x10\xa8\x01\x99:\x7f3\xcf\xe3\x90\"\xc6\xc9)\x01H\xee\xe78`\xc6\x9eA\x8b+\xa9\xd8\xb1\x08\x0f\x1e0\xb6\\x\xbf\x81\xe0\xeb\x03\xd9\x034\xa5\xda\x12\xff\x85\x8eOx\xa3]\x18\xc0\xd0\xafp\xe9\xef\xa1S\x9e\x01F_\"\xa9\xa9\xbb\x86-P\xe0\x7fo\xb8G\x0c\xf7\xfc\xc9\xd7\xbe\xd8\x19\xad\x1c\x93\xf5N\x9b\xcb\xdf\xd5\x9c\x1dA\xb9;(\xc2\xe2\xc7?\xf6P\xf7\xee\x88\x9f\xe6AF\x87h\xe4\xed\x1a\xf1!5IS\xaf\xd6q\xa1\xc6\x06\xe21\x9a)\x96>\x0b\xcf\xe4\xc1\xc1\xed\x18>8\x15\xc0\xe1\xa0\xfd\xd8O\x90\x9c\xbb\xcd\x02P\xb6\xbe\x99(\x1e\xdd\x9a\xa8\x7f\xf9x\x0aHG\xe7M\xe98{4\xb2.N\x15\x13\x8a\x1e\xf9'\xa5\x16D0DbN\x89H\x7f\xbc\x0d\x95\xa22\x94\x17\x07*\xa5C.E\xa2f\x801\"\xef\x16\xcc\xa03\x1c\xf9\xa7!\xd9\x7f'\xe9X\x13\xf8K\xc0/\x8d\xb7\x9a\xa2q\xbc\x85C\x1c\xd3e_\xb2b^=j\xc5!c\x18\x85\x95\xc4\x8fW3\xcav@\x97X&\x10\xeeb\xae\xd5\xda\xc2O\xd3y\xee\xf7\xc4\x89c-`eY\xe5\x9a\x00[\xa2\x80\xb215\x92\xe2\x85?J\xa9\x82\x1a\x09\x0f\xa5\x1c\x8b1\x17\xc5w\xee\xa6\xd9e?\x85c\xc5e\x037e\x81\xa6\xee(\xa6B\x0b\x83\xbf\x93\xee\x08_\x9b\xf8\xb6\xe8\x1bu\xbeF\xd1\xcc\xfa\xa1\xc6\xec\x06\xb2\xb8\x0f\xcb\x86\xab\x89.\x98\xd5\x80\xedgV\xec\x89\x95 8\xb4\x0d\x0bB\x99\xb5\xa5\xa3F\xc4\xe0\x84\xb0#z\xf0_\xb9.>V}u\x07\x97\xb0S\x13\x00\xf2\xea\x9a\x1bk*\x10#\xd8\xa3\xe2}\xd5\xb1L\x9f\xd8\x0f\x7f''\x98\x8c5\xb7\xb5u\x9bF\xf5\xd1:\xa3\xde\x874\x09\x18\xc8tk\xcb\xe85\x896L1\xf5\xc4\xdd\xa4\xe5\"F\xe7P\x84?XE\x1d\xbd\xf3}\xf2w\x9eL\x84U\x95\xf7q\xa1\x7fB\x9a\xa0j\xb9\xb8\x10\xf5AQf\x81\xbf`G\xbft\xb9\xa4\x0c\xdeC\x0fnN\xf9\xea0\xe7\xc1 \x09\xfe\xcfR\xd3C\xb3\x8a\x1ex\x99\xd8\xc8\xc4\xc6\x17\xd1t\x89\xe7\x19\xd9\x10\x1b\xfa\x07n\"\xc4\xf1\x12\xde\xa5\xaf\xea\xea\xea\xc2/\x8a\xef\xdeu\xfd\xbd\x11\xc9\x8d\xcc\xf1a@\xa6\x8e\xecb|\x8eZ\x05\x88\xc4HT=\xbf\xd2m\x1a\xf6\x83\x12]D\xd6\xa8\xac[f\xb9\x9b\x9e}\xaf\xdcM\xff\xdf\x9f\xff\xf9\x97\xff\xb0\xce\xdc\xf4;\x80\xe2\x93s\xcd:\xe3\xd2\x87\x18\xbd#D\x06\xaa\xdf\x1bn\xa5\x98\x94Z\xa2Q\xf9\xb4\x0eR\xd1\x1d\x8b\xee\x93\xc6a\xc9\xbc\x95\x1at\x12\x8c\xa2\xdb\xd7U\x07}q\xf1\xb5o\xc44\xceYEHoM(\xad\xa9\x9fw2\xff.h\xe5\x90\x93\xb1O\x0a^\x7fg\x10`\xd1\xd5\xe5\xfa\xc6]Ob\xdfP_\xae\xbe\xde\xaf\xf3\x07EF\x13\x13\xcdCY\xd5\x1a\x0c=l\xee\xf7\xce\xca\x88\xd2YWDG\xca\x04\xbfoX\xac\x12\xd6\x9e\xab3S\xefT\x9d\xa0\x17~F\x18|Q\x7f\xc5X\x1a\xfd\x8c\x18\x0bn\x1f\xdc+\x95\xcd:[\xd0\xe7$\x83tP\xfdw\x1c\xbcU\xddu\xd6\xd8Z\xabj\xd8s\xa8D\x8cJ\x8fz.\x9a\x93l\xbf\x00\xd9\x9e\x0d\xd4`\x02\x0a\xf8]\xb9\xb7\xd6&\xc1\xb7hm\xc6\\\xb4 \xa0\x19\x83)\xf6*\xb5\x17\x06w\xd5\x1b\\\xab\xf6^\x10,* \\\x99\xb7\xc3\xab\x91\xb4\xa5\x8e\x03\x854\xb6\xc1\x92Q\x9de\xaa\xe4\x1d\xe0\x86\xe0\xa4\xd2]\x10\xbb\xcf\xaa\xefo\xd7\x99\x87L\xa81\x96E-\x1aQb\xebM\x1aS,\x82\xb9DJ_2\xe7\xdd6Nlu\xb3w\x9fr\xba\xda\xbd\xae\x07\xe4\xab\xa1]\xb9d\xc2\xa8X\x1b \xc5\xa6v\x0b\xc7d\x97\xee\xdf\xd9\xc6m\xc74\x85mp\x9a\xa4\x1bT\x09\x97\x844o~\xe1\x98\xc2\xef\xef\xfa\xaa\x83\xfb\xe40\x9ff\x96?D}&\xd2\xf1\x11R\xcb\xa6\xe2\xe4x\x1au\xcb\x94c\xb1-\x0b\xd5G\xaa\x99\xa7\x84\"T\xc5L\xecW\xfc(\x16\x84\xc2 \xdf\xd5\"*\x05\x8b\x00-\x0f[\xb4.\xe1\x0aG\xfc<\xac2\xf0\xdc\xa7\xef[\x8aH\x01;<\xea\x9d%\xd6D\xd4\x87\xa1)\xcaM\xcd\x14LbS'LW\x929\x8d'\xf0\x09n\x10l\x8d\x9bV,Qc\x88E\xebH?\x92\xbf\xd4\x15\x1ei\x19\x9bh\x96\xdb\x9bV\xf6\x9b\xa5.<\xac2\xd4,\x0dG\xf4\x89\xeb\x0a\x09\xf6g\xb3\xd6\xdcar#\xfez\x04Y\x98;\x98\xf8\x92C\x02\x9b\x1c\xb9\x98\x0c\x81\x95\x7fJ0\xb9\xb2\x94\xd8\xb63!\xeeX\x16\xba\xc6K1]\xfd2\x13\x03\x0e\x87q\xe7\xc7\xb3a\x97\xc7\xcb\xa6y\xf4q#\xfe\xe24h\xb7\x13\xd7u\x9f\x94\x8di)\x8a^\x1fs\xad\xa9\xcc\x9fU\xb9\xe0\xc6\xf1\xfe/\xdbBL\xd1l\xc9\xa6<~<\xaa\xd4H\xbd\xe4d'\x91\xf1Q\x87\x80\xd5k\xf1o\xa5\x87\x02\x03b\xd1\x13 \xf0n\xe9o\xe3\x8b\x04E,\xcdei\xdf\xda\xdbTw\xf8=\x1fH\xc0\xc4C\x9c:\xa0\xcde\xc79\xff\xc6\x09p\x96\x98:\x98\xd9\x82\xa5SV\x82\xde\x91FfV\x9c\xd9v:\xcd\x0bq13\xf0^\xb0\xdbh\xb1\xd4\xa4\x02\xdcQ(;\xb9\x1a=\x9e\x18\xf5\xa4\xf7Su\xee\xbe\xaa\x09\xec\x86\x04\xa6r\xb4E\xa6\xcb\xb8\x03pl\xd5l\x00a2m\xe0\xaea\xa8\x801\xfd\xd0_IB+\x16i\x11k\x1a\xf6L\x0a\xd3\x8eX\x00\x1b\x9f\xe81Q\xcc\x95\xbaBQ9\x13b\xc6\xf3\x16\xa5\x98\xb6\x09\xf9\xd8\x06*\x9c\x8d\x1c\xaaL\xac\x15\x0f\xe1C\xc4\xae\x8e\xac\x0f\x93\xda\xf75\xb8\xdd\xa2<_$\xbe\xd9QG\xef\xc7cV\"\xeat\xa0\xe7f\x1e\x02\x1a\x1d\xfa\xdb\xf6\x1c&F\xf1{w\xea\xdcuL\x1e+|G\xd6\x1d\x9fG\xdbL\xfb\x8a\xd4\x0aNp\x8b\xe2\xa7\x09\xb7E\"6q\x0cDR\x9d\xe3n;\xbb\x0fn\xa0\xb4\xd9\xfd\x89j6\xf1k;J\x9d\xef\xe3!mVp\xedO\xbe\xdb\xbf\xa1)T\x18\xecp\xe7\xa4`!\xab\xfe\xef\xc2\xd0\xfb\xf6\xde\xba\xb3\x8f\x8a\x1d\xaa\x14\xcc\xc9uj\x0e1V\x0b*,\xd8\x9c[1\xa2\xf81C\x1a\xff5\xf4\xa6\x91\xf2\xe6\x8f\xf6Qy\xa4\x9a\x9d~\x89`\x88c\x1f\x07Z\x10f\x88\x0c\xca\xfc;\x0d\x9c\xa7\xaf3\xcd\x8b\xdb\xc0\xc0z\xd9(\xd6\xb6\x04~\x8c\xec6\xef\xd9X\x8ci\x1d\xa1\x9f\xb6AY#\x1bk\xe7L\xc15\xbc4\x13\x08\xf3\xbc\xe3\x1a\xacz/\xf1;\"\xe5\x08\xf1F\xf2\xd8tMS9N]\xf5\xc1W\x09\x1b\xea?N\xc3\xeb\"]\x09-\xb4\xa1j\x88\xa8\xda\x81\xad\x03\x8b\xc9\xef\x9dd\xbf\xe5\x1b\xb9\x10\x89\"\xcc\x15\xf7\x8f\x11\x01\xf9\xf5b\xd7\xbd\xce\x98Z\x95T[U\xf9\xf6\xa47\x84\xf7\xae/\x0eZ6\xb1\x93\xd2:\x9a\xecJp\xbd\x08\xcc~\xf2\xfd$\xa8\xe0\x8c\xf1\x0a[\x07\xe4\xaeb\xf3\x8d\x01\xe36\xdd\xd1\x8f\x93\xd1\x02.\x88\xe5X\xf6\xe6r|\xc3\x0d\x02%\xc3\xd4\xfc\x1b\xe1\xd8>\x09gR\xb2\xb0\xf0\xc4\xda\xc0Wc\x8a\x16\xc6\x19\xd5R\x12\x08hNP,\xadAeHD\xa1\x1b8\xb0\xe8\xf4\xb3_\\Sx`\xd2%H\xe2\x0e\x09\xc7\x880\xfb\xa1\xe5w\xa9Nx\xfe\xd4\x98^k\x99!%\xf78vR \xda\xde\x87\xa5" +
+	"p\xaaj\xe8\x8f\xf8\xa9W%Mn,\xed\x01\xd5\x1d\xc6\x0b\x91\xdc*\xbcE\xb4\xb0\xb4\xc6v\x9cq\x1eOz!\xf7\x8ed\x17\xb4\xbd\xae\xd3\xccD\xcb\xea:iYe0$\xdb\x0b6\x85\x94F\x8f\xf8\x10,N\xbe\x1e\xdc\x11?M]@a1\xfdx\x8a\xbfY\xe8\x9d9\xa2\xd8\x8f^B{\xf4\xdfQ|o\x9d\x1cf\x06J\x81(n<\xdb?\xf9~\xf4N1\xf4\xa6z\xca\x11\x92e\xab\x1f\xd6YM\xb8;\xff\x9d\xeb\xbe\x03\xdf\x1e\x8a\xdf\x81(\xea\xffw\xd0\xf9\x96{\xa5I\x84\xea\xe4d\xdc\xfd\x00j\x83\xca<\x80\x0b&\xce/\xaeKy\xcc7S\x88P\x05)\x0c5\\\x81_~j\xe0\xc7U\xeb\x1c-\x10\xcd\xdd\xe9&\xbf\xf7-\xcb\xa0\xb4P\x1f\xc3\xc7|\x08E\xc4\x16\xb9\xf3K\xc7I\x1f\x07\x1dG\x0e\xd0{)\xbeA\xeb\x068\xd2\x0f~.\xbf.[\xb87_\x94\x90\xb9\x91d:\x12\x0bU\xae\xcf\xd0;8\xf6.$\xa0\xabk\xf0Q60\x9a|\x88\xe9(\x19L\xb5\x8e\xf1@\xea\xa1;\xca?\x82\x85\xaa=\x12_\x87@D\xe1\xcc\xf0\x17\xe9z\x8bd1\xd4\xc4c\xb2\xa0\x89\x00\\\xb8_}\x81o0\xa0\xaf\x1c\x8bO|\x0d\x1d\xd1B\x91.Fxl\xeb\x9a\x93\x88\x90w\xbegp{\xdc\xbd\xd6&P\x02\x8f\xc1\xc6\xafU@P\xba\x05\xf7\xac\x94X\x13_;f\x98;\xe5OYLp.\x14_%\xfa\xb6k/\x92\xc9l\xe4$!\xc9\x14\x8d\x16\xfbHHTg\x816\xd7X\x03\xe1\xcb\xed\xfd\xa8\x8b&\x08\xf5x6\x8c\xdb\xc1\x03.\xd2[)\x10Y:\xd2\xb2\xfc\x0b\xde\xa8t\xb2\xc9\xe5x\x80Q\xa1\xec\x04\xe04\xc1cs\x19\x12ck\xe3QD[\x8e\xf2\xc6Y\xc1O\xa9O\xdd\x85\x03nO(O\xaef1\xa4\xe7\xe6nF!G\xb0g\xa88\xe90\xc2@A|\xa5\x91\x81\xf6\x0b4\x0c\x0bIq\x088\xc1o\xd0\xf8\x05\xd1\x89UQ\x88\xa6J+\x0c\xf0\xe8\x91\xd7\xdd@\x94\xb3\xb3\xebb?U\x00\xce\xde\xfau\xd6\xc91\x9ap\xe8\xf5'k\xf4Q\x91\xf2\x0d\xc8\x9b\x13\\\xbfv\x03\xe4\xd6\x01u\xb7\x10\x85J/\xe1\xa5\x7fG\xad\xd3y<!:fj\x8a\x1a\xe6\xa2\x93^\xb7\x0a\x82\xa6\x8e\xdc\x8b\xac5\xc2\xa3i\xc4\xc1H#R\xd7\xdb\x1b\x89\xfdJ1\xa58\x1bV\x96\xf1\xd3\xefg\xe8Vd\xe9\xb2\xf4\x9d}%;\x10\xf3\x1bi\\\x9dR\xfb\x13\xa7a_\x05\xbf\x9b4\x10\xfb\xc1\xe1\"\"\x96A\x93\xd6\xca\xb2Y\x1b(zv\x7f\x82\xbau\xf1\xf6\xe2\\K\xd6\xecP|\xf7\x0e\xcaC\xf1c\x0f\x0d\xb3\x8dO\x88\xb8\x0e\\\x9bnRg\xb4\x80\xb3\xd9\x7f\x16\xa0\x9d@\xb1\xeb\x0a]4hc\x0a(\xa7(Y\xe7\xfb\xd9\xd6\xb9\xf2\x00\xe9\xe2\x84\x9a\xf9\x166\x0fw=6UC\xffz\xb4N\xb6#\x0cTi4\x83\x80\x01W\xd4]\x8f\x8a\x9euU\xd0\x81\xdf\x9e\x0f\x861\x8b\xc5\x15r2\x92\x14\xf0\x1d\x15<\x98\xc7]X\xf6\x96\xf9V\x0cI^\xf2<C\x93\xa7\x0f\xd4\x13\xc1\xd6Vl`\xf9\x99C\x0b\xd3\x84\xf8\x93\xef\xfdO\x0f;\x03b\xa5!\xad\xcf\x16\xda\xc1\xe1^\xc44\x8d\x1d\x9c\xbd\xcdT\xc7\xb7\x0f7\xb5\x9b\xf3\xa1`T\x90\xf03\xd1q\x86\xbf\xbb\x1e\xb44+\xd9x\xc6\\\xa9\xed\xd9u\xae\xd1#\x83\xb2\xbf7\xdf\xeee\xba\xb7\xf7\x19S\x91\xc1\xec\xf73\x88\xfd|\xb3\x0f#\x19^\xec\x18>\x1e\xad\x93\x18I\x1cm\xa6:G\xf3\xf9Y\x1e\x9fi\xe0m!l*\xb9'+\xd7\x14\x1b\xd5!rZ\x83\xc6\xdcE\xc1\xbdd\x83<\x92c\xc9o\xe6\x8c\xee\x99^:#^\xbf\x81\xc1\x99\x9awn\x1cYa4\xd1\xabo\xa4\xf3@?6\x10\"}HQ\\|\xd6\xd8\x0d\x19\x1d\xa2\x830\xedE\xb2\x16D\xc3\xe1\xffB\xeb\xa0Mhz9\\j\xb4\x16\xeb\xca\xc2\xc9\x0e\xeb\xbcE*\xec\x1c\xbf\xa5\x84\xb8\xe6\xf6Z\xcb\x89\x98\"J\xc6\xa8\xe9>\xda\xe7p\xe7\x0a\xc7_J\x83\x99\xb8h\xc9\x08(a\xad\x1e\xc3z\x0b<As\xe2+\x82\x06&\x1a\x1f\xa5\x13\x11\xd4\xa8\xd4\xa1\x98\x96\x16B\x00\xb7L\xbc$yR\xac\x1aN\xbd\xa3\xb87\x10e\xc9\xa3u\xea\xa4\x7f|\xf0\xf9\xab\xe5\x82\xc4\xf4c\x04\x1e\xc7\x16Bn\x1al\xa0\xe1\x99=\x8d2\xa2I4\xb9\xf4\xcd\xeb\xaa\x97\x8c\xe6N\x95J\xf6SCM\xe4\x93\x9c\x08m\xabs%\xfe\x09\"\x04\x1f\xad\xb3\xef\xbc7\x94Yh`1oY\x1co\xcc\xddFrf\xbb#\x86}\xf6\x0a\xbd\xb3rm\xd5\x09\xda\xd2\xc52\x81\x98\xfcE\x86\xf8\x9c\xa6\xcb>\x13\x1a\xb8\xda\x8c:N\xbc\xe8\x894\xe0\x89#\xac\x0bQ)|\x96\x08\x9c\xc8\x81@\xe6\x0a\x0f\xfd!\x89i@-p\x00J\xfc\x1f\x8c\"j\xe85\xc9[EL%\xef\x90\xe8\xccm\xa0\x11\xb2C\xadRP\xe2f\x1db\xe5N\xe7\x93*\xda0\xd6x\xba\xe7\xe7\x9d\\\x0c\x88\x94Yc2\x8f\xcc:\xbb\x8fU\x0b7\xd8'w\xa9\xb5v\x91\xe2\x11\xa9l\x9e\x82\xab5\x1f\x99\xb0h+(\x1eG\xbe\xf65\x07\xa9\xc5\xd3\x1b\xbd\xda\x8eG@\xb1\x9f\x9b\xe4\xcc\xde\xae\xb3\xd8|\xf6eD\x11a\x19\xfa\x14\xcbX\xdc5\xf3\x86\xb4\xf7ym|cp\xa7\xf8uza\x02\xdeX\xf1\x16\x9e}\xa5R\xbeNp\xc3\xa2\xe73%70^\xbakf@^^\xb6\x15\xd6d\x08r2R.\xe2j\xe6V\xc5n\xf3\x19\xafp\xf8\x02\xbdZ\x1cV\xc2`\xf8\xce\xf7\xe8\xed\xdb7\x9btpmt^*\xb8\x12;~\x8dJ\xe5\xf9$\x81\xaf\xd4\x07J\x98\xdc\xec-\xf1T\xb1\xb0\x9a\xe8R\x12\xb6*,O\xfc\xb2C\x96\xa0s\xce\x1b\x06\x8dP+l\xa1\xb5I\x81A\xae\x0d\xc3\x87\x07\x06\x0d\x11\xf9\xd378;\xc6=\x0dh\xef\x97\x89\xa7=\x84\xbd\xafD3\xfc#3\xae\xec>\xe4T\xcf\x8aKKj\x01\xd8J\x91w,\xd9O\xa3\xc3\x85w\xc4\xf8j,-\x89\xd0\xd4\x07\x11\xd9\xec\x9c\x08\xe5\x1f\x94\x98KC\xae\x19?\xab)\x8a\xe5\xcdw4\xcd\xafUC\xd9W.\x98\x9eF\x93\x8d\xa3ns\x9a\xd3\xce\xe2\xfe\x92\xc0X#\x106\\\xea\xb6\x94\x92\xa9\xbaUs\x1co\xd3W\x8c\xc5\x17\xf5\x89\xa8\x1e\xb1B\xed\xf4j\x96\x15{\xfc\x1a#d\xcd\xe7\xd1\xfeA(\xde\x1e\x1a8\xf6\x18\x966\xbd:\x19~\xcdx\xe7#\x81\x99\xa0I&\x92\xfd\xbb?\xdc\xc3\x9aF\xf6\xb3\xd7k\x05\x1bPf\xcd\xcb\xc65+\x8f\xc8\xebMt:\x87PsB#\x19\x89\x18h/\x17\x11\xc2\xf6L\x1bu$\x1f\xc2\xb1#\x83\xa2\xe8\xab\xb0\xa6V\xde*\xd2\xce\xfc\x91[e\x0b\xac\xac\x99h\x92\x86\x09\x1b\x84\xd9(m^j\xa3S\xbb\xa5\x1e)X\xfe\xa5\xba\xce\xfcB\xc5\x1f\xb3\xde\x9ck\xf7{\x14\xec\x93\x92\x12\x9b\xa1(\xbf\xce\xe5&F)\x09\x0aPl\xa0\xc3\x99dO\xa4@\xa0\xe9\x9c\x98\xc0\x9aM\xc3\x88\x0d\x97\xda\xc5\xe9v\xc9\xa5\x94\xe1Q\xb6\xb20\x81\x9e\x1c\xd6\x09n\x92`M\x1a\xce\x8f\x84A\xb6\xa1\x04\x1d\xb8U\x1dbs\xc3\x10\x03\xfc\xa4Us\xd1\x15\xc6\xc4.\xd4\x92\xa3\xcf\xfe\x92\xb1;GGj\x03=\xcdR\xfeP\x88\xa22\x1e\x0c\xf4\x0f\xc9\xe9\xc2\xcb\xbeA\xe7\xfap\xdd\x8f\xe0\xbbh\x0a\xbc\x87\xd6\x15?\xf4\x1d\xb8\xeba\x9d\x0fFq\xea\xe1\xd8J\xe5\x84r\xb5\xf1\xa9\x91^\xa6+|\x9e\x9e\xfbV\xd2\x8e\x8c\xe8\xa9\xed\x8f$o\xa2r\xde\x89V\x10\x1a\xb5ac\xb1\xe8ej}H\x09pM\xa1b\xb5)\xb7\xc9P\xea\x88\xda\x16rr\"v\xa43\x9c\xe7\xe4BQ\xf9\xf3\x17\xa3\xd51g\xf4m)\xb9\xd8\x18\xaa1\xa1\xbd\x92\xe5\x8a\xe3\xdf\xa2\x87\xa6K}WW\xae=\xbb\x16\xda\x02j\xb2\x911\xcc3\x82wD6P\x0a\x9f\x8b\x87\xfc\x05n\xe0\x88*\x1c\x1d\xad\xb06Xv\x9e\x13n\xd1\x86\x992\x16\xad\x10\xeb\x83\xc1\x1d\xcf\xa7E\x13nL41\xa1\x0c=\x89\xb55\xd7\x0b[\x1bS\xae\x8c8\xce\xf8\xcf\xdb\x09\xce^\x8d\x08\xb5\xae\x89j\xa6\xec\x00\xc3\xb4\x06\x0e\xbe\xff\xa1(\xed\x97\x11\x8c\x84\xb7\xd8\xcf\xef\xa4RH\x90y\xd6\x0cS\x9f\xd1\xe4d\xed\xc10D\xa5\xab\xdd\xc0\xe3^\xa4\x1c\x9f\x1bD#\x9e\xbf\x9e\x1c\x18\xb3E\xb3\x03\xc7\xfc\xe8C\x16\x06\xcf\xc3\xba8\xc1V\xc7$|s\x8d\xec+%\x8d\x91\xf5\xadH\xc0\x89\xf3S\xd4\xeb2>\x8a\x8f\xdez\x8d\x14\xb7\x7fT|^\xf1\xef&(q:'\x19[\xb8\x04\x8a\x1d\x13\xa4\xc8ks\xd3\x10\xc7\xc5\xb5\xe4s\xac\xb1I<NxX\x8e6E*\x11?\xd2\x915*\xde\x1a)\xa0\xdeq\xfd\xe0:\xb8\xe0\xb8\xa1\x0b\xfdF\xb0\xbfr\x07\x12J\xcfY\x09&]C+h%\xe8\xa2\xab\xd4\xc3I\xf4^keE|\x1dG\xf9\xb0me*\xd1\xc6\x06r\x9aY\xac\xb7-\x80l\xbc!)\xcd\x8bd\x86\xf5\x02\xbe\xb8\xf5Nh\x85\xab_\xb2].<\xd2\xde|\xe6<]\x99Dr\xf5\x0eS\xb0\xb4(2\x18.\xe9\\~\xd6l\xe0\xab\x99\x9fI\xb8\xcd\x0a\x1a\xdf\x8b\xbd\xc3>\x89\xe5\x12\xc1\xcc:\xb8\x02\x06\xb8\x1d\x8c\xf8\x84NAnfs\x823\x91\xb8v\xfe\xc6\x01\x9eA%\xc0\xe9R\x09\xdc\xa9\xe6\x9d\xb8q\xf7\x12\xbc\xc6\xb2_\xae\xc1\x9c\x1d\xec8\xd6x\xfc\xbcC\xf4\xc0l\x1d\xec\xa3\x0d\xe49\x06\xc2H\x00\"\x8c\xc9\xfe\xea)\x13\xd1\xec\x1f\x1cl\xc1\xb2?\x9a\x13\x8fy\xc8\xebL\xb0\xf8\x86F\x17Z\xec\xbc@<z%\xb7\xcc\xf2\x15Y\xf8\xa8\xa5\xd2I\xc0\xa3}5\x81\x1b\xe1z\xc1%\xa0H\xedc\xa2\x7fR\x04x\xf0m\x89,\xb7\x83O=\xcd\x8d\x9d\xf1r\xbav\"R[\xc5\xec\xec\x00-\x95&1w\x0c\xa3\x8b\xaa\xa1\xc2\xfd\xcc\x1d4C\x1e\xd9x\xab\xb3onp\x0d\xff\xa3:[\xf9\xb2\xbb\xc2\xc5u\x827\xa1n\xa3\x05\xa4p\xbc\x16j\xf3\x0db\xe6.\x1dc\xd8h\xfe\xed\x93\x8d=\xb3 \xa1\x87bWV\xdf\xa0\x87\xbd\xbdQ\xbc\x07~\x0bo\x84V\x9a\"\xb2\x07\x0f\x07\x03P\xc2\xcb\x0c\xe6\xfc\x1b\xfcD\xc6\xad\x11\x86\x7fT\xec\xd0#Q\xaa\xde\xc8I7\xdbB\xb8\x83kDX\xb7D\x17UB\x9b|\xa5\xa9\x1a\xb8@\xb3R\x9b\xf1\xe2\xd5\xcc8\xc62%\xde\x92D\xce\xb2\xb1\xac\xbe\x0c\xd5\x968\x0b\x1f\x05\x1c\x1e=\xe0\x9f\xd1\xc6F\x9c\xee`\x84\xf7e\xa9\xfc\x1e\xb1\xd5\xac\xae\xc17\xbf\xa3\xdf\x15\x0e6p\x00M\xc9oR\xb0u>\xa0&%\xc4\xd9~\xff\xb6xo\x8f\xa2D\xe3b\xcba\xf4\xf2\xfb\x0dy\xe8X0=\xa5\xa2\x10\xfa\x1a\x09\xc5l\xf2\x9eB\xb3\xa3\x08\x82)\xd4T\xe8\xec\xa3\x82B\xdd\x19\xf3\xf5Zq\x7f$\xe1\xe1\xb0\xe6\xa9\xbaVa\x07\xe3\x0c|;\x80\xc3.\xe8\x85A\xaa\xbaT\x9d\xc6\x97\xd5YTXuW\x0d\x87u\xa2\x97\x18\x9b\x15\xe8,\x87fo\xd0B\x9f\xa81\xf2\x9dOPC'_\xba-\xbc\xd0\xd3\xf8\x0c\xdd9\xf2Pr\xa2\x1a\xf3\x89G=\x13SIl\x05)a\xd3\xd4\xd0a\x9ddd\x97\xef\xef\xfbT\x1dh9\x04\xf6\xc9V\xdb\x93\x8eKAU\xed1\xe4\x87\x0a\xb3\xb1\x9e\x90\xb8\x9c\xe9`U\xf6\xf1x\xea\xe0\x02\x8d\x80\xbc\xb1\xd1\xb4\x0c\xd3X\xe1\xf0y>oM\x1a\xb4\xbd\xad\x1e\x0c&\xaa\xaf\xa1\xe8\\\x8a\xab\x8b\xd4\xb2d\xc8\xb68\xee\xe9wyX\xec-v\xe2\x1b4P\xf7\x0e\x06h\xe7\xf7\xbe\x97/L]\xda\xe4\xe4H\xb3\x0c\x86\xf4w\x89xh\x85\x9e\x86\xcb\x1b\x1f\xce/\xc4\xa8\x8f56\x96\x97+q\xa3\xd9\x80\x912\x85\xb7\xd0)\x06\x9e\x0b\x00\xe5\x88\x1a\x97E*0\xd4\x10\xe8\xe3\xd5\x82\x0bM{\xb4\x80\x81\x92\x87\x1c\x86\xfb\xc9@\xb9vr,\xe2\xf9&\xb8H\x1e\x89C\xd1B\xe3\xf5n\xf8\xf5\x1e\xee\x92bm#A1\xdf?\xaf6\x97!d\xa2\x88\xb4\xf5J\x1b\xa93\xc7\x04\xe5\xa5\xed\x83/\xa8\x92>\"\x87\x9c\xfc\x16\xd7V\x99\x10\xec\xcc-\xa4\x98\x8d\x0e&\x9c+}e\x9b(1w:\x8b\x1b_\xdf\xb9\xbf lA-iu\x10\xdc_d#z\x93\x1e\xec\x89\xd5)\xe6\xa3\x02\xec\xdcM\x04%\x98h\x05\x85}\x11\x97\x11\xf9u\xb8\xec\x97\x0e\x94\xb0\x03t\xfe\xec\xe6\xe4\xf3n\xa4}\xc3\xa3\x1b\xd99\xe6\xc71\x95\xc2\x9ct\xc1\\K\xed\x1f\xaa\xae\x85\xda\xb8\xf5\x07\x9d\xb6Q\x0b\x99Wk[B\x07\"\xf68yM\xb3\xc8g\xf7*\x09\xac\xa0Z\x05\x99\x88\x0d\xb4?\x012k\xe0OC\xe6\x82\xaf\x9c76t\x98y\xe8\xd8\xba\xa3\x90#\x0fr\x1d6\xf2\xabWHV\xd5\xf5a\xf1H&\x01\x01d\xb1<\xf0\xcco\xd9\x8a\xd0]\xe2uC{\xae\xfd0q\xb9\xb6\xf1(\xff`\x83\xbd\x9d\xbc\x98\x18\xbf\xe6;*\xa9H\xfb\xa0U\xb6\xd9\x16\xc3\x96\xddd\xf1u\xf0\xec\xf6<q\xa7\xaf\xd8[\xd6.1\xec\xe2\x89\xcc\x8e\xff\xacE\xf7jR\x16<!\x0d^/\x9b\xfbm\xaa\xe6\xde\xd1\xee\xbcr\x1d\x0e\xc8|9\xdd\xaf,\x9f\x9b\xd3n0p5-o\xa4t\xd7\x8a\xf6\xd5\xab\xa7\x93\xa5O\xab\xe5n;)\xed\x0d\xdb3]\xb6\x9f\xa9\x81\xab\xab\x01&P\xb4\x9a\x02\xe8\xa0\x07\xc2\xd1\x9e-s,\xd3\x02G\x11\x9a\xbc\xfe\xd0\x9c5\x19l\xc2\xcc\xb4T?'\xe7\x85w\x0b\x00\xf4W\xcf&{F\x9bm\xa8\xd4I/e\xba\xe6Ksa\x8dd9R\x0cN89U\xe5\xcc\xa5@\xa8\x99\x0aW\xd5\xc8v\x89\x09,\x9dgE\x12g_.\xcdia\xe3\x92\xc54\xa5R\x92u\x81\xe4\xf6\xa9#\xc8\xe8\xf8\x88\xac\x18\xac\xb9\xde\xfb\xb6U3\xd6\xb4\\\x98\x96f\xf1\xf4\xac-\xce\xf9-L\x9f\xb9\x16\xfa\xd1\xb2KM\xe0y\xda\xb7\xc7B\xfb\xf6\xcb\x7f\xf8\xf9\x7f\xfb\xe5?\xfd\xfc_\xff&\xb4o1\xe4\x93 \xcb\x1a\xdf\xc1\xc5\xa4\x90\xd6u.\"\x818:\xa5\xbc\xd2P\x7f(\x81Lc\x0e\xe2\x1e\xea\xd6\x15\x81\xactJ\xff\xfa\xa0'\x97h\x8flQA\x8c\xd4EF\xa1\xf7\x84\xe6Oq\xae\x9a\x93\xeb *]\xc9\xdfc\xa2uA\x8bG\xcb\x0d$\xb7\xd2P\xf9\x1d%\xda\x16\xa5n22\x98\x11zv\x10\xd8\x85E\x80\x0cA9W#\x9a\xa2\xa4\xbb\x9f\x02\xe8\xe1\x97S*\x9f\xa1\x0aM\x8d\x88\x9e\xa2\xf7\xcd\x89\x98\x03\"\x80E\x19&5\xc6T:\xc4\x9a*W\x95\xaaZ\x86+\xde\x8c\x94\xa6]&_\xb9\x96<\x99gh\x7f\x1f\xc0\x87\xbd\x8a9D\xf8\x04\x1b\xa5\x92\x92\xd0J\x1a\xb6\x9c3\xe2\xf4\xda\x17\xa7x'\xa5\xd8\xd1\xc84\xfd\xe6\xde\x12K\xf0\xbb\xe2\xb8+)\x8cA&_C\x1c\x0a\xfb\xff\xce[v\xb1q><\x09\x15\x93\xd9\xfa\xa5\xbaBM\x10\xda\x9e\xfc\xa79\xda\xd7\x11Z\xf4\xb0N\xb4v?\x13 2\xf5p$R\xf2\x17\xa8S\x01/\xda\xabqz\xf3\xa4\xd9)Pd\xaf\x80\xccU\x82\xb0\xe5\x0c]\x04\xa2\xb4\xc2\x02J9Ss\x82\x0au\x0d\xc48\x09G\x1d\xc2\xf1|\xc4\xcfu:\xd6\x05\x95\xd6L\x08i\xf0\x11\xb9\x96\x1cX\x7f\xcaC\xcf\x14?\xa0\xbc\xbf\xe7\x80\xae]+\x14\xaa\x0c\x03v\xa4\x1f8\xb4\xa4\xbe\xb4\xfb\x13\xbf\x7f\xef~\x82a?\x99$\xf9'8\x9d\x96\xa8@\xe7\x19\xb6>p\x81\x80m\xadP\xb0\x87\x07\x9c}\xeb\xdb\xf3\x91~\xf0^\xf1\xb5\xfeJ\x8cj\x03\xf4d\xb1;I\xfb\xd4pq\xa9(g+\xe1`\xd9Qj(\xa1#\x9f\x05\xda#\xff,v\x82\xa1\xddG\xfa{\xcePA\x0f\x8f\x8a]\x00XU\x04\xb2pC\x8c\xb1cp*\xcc\xb3YT\x0b\x96\x96n`\xd7R*ynw\\\xa23\xb8\x05\xfe'\x91\xa5\xf5\xc8(\x18\x12a\xb8\x0e\xfa\x90:C\x93\xeb\x83\xcb\x91w\xd2?\xf4?\xec\xea\x88j\x1b\x981\x9e\x9d\xbf\xd2\x8b\xd2\x89\xef\xfc\x10\xb9\x84>*\x9e\x0d\x03M\xe8N\xc1\x00hL\xdc\xa0\xee!\xca\x18\xc7[$\x81<\xc6|\x0d\xc6\xdc\xd4[\xad\xcd\x9e\xd4\x09\xe1\xfeb'\xb7\xc5T\xce\x9d\xbf\xc4\xae\xb2\xd3^&\x8b\xee\xb5\xd8\x07\x91I\x87\xbfD\xf1zu\x07\xb1\xb1\x17\x07\xbdJ\x05\x18\x9e\x1an\xfd\xc9\xb5\xe2\x0a\x0e:\xfe_\xaa\xa6\xea\xae\x82\x0bb\x04\\\x02U\x8e\x99I\xceE\x8e\xca\xc4\xea\x0aS\x1a\xd0.\"\xe5?\xadcN\"\xbd\xd7\xefS\xa4.\x97\x1e6\x04\"\xcd" +
+	"w\x1d,\xb2\x15:\xb7\x84d\xdd\xd4\xea\xdd\xaa\xc1u\x07=\xd2\xf0\xbfj\xe9P9/\xf8\x06KD\xa9\xb2\x1e\xabD\xd7\x06\xda\xe0\xd51hr\x83\x8e\xfd\x9fi\xd7\x08m\xbf\xb2\xba\xe9FM\x85\x94\xf2\xe7]\xf4\xca\xef\x89\xb3o\x1f\xd3.\x89\x12\xdb\xb4\x8c\x8aL\xea\x99\xd84)9\x19.<[\xc7\x8ac\x88\x93Ok\x88\xd8`mr\x8aQ\xe6\x1a\x1b\x11 \x8e=\x13\x93\x19\xa1\xc1 G\x90m\xc1\xf5\xb3&\x12\x91\xbd+\xb1\xac!\x0e\x92-\x8f\x99\x82\xa7X\x7f~\x0cA\x8b\xe1\xe9\x9c\x80!\xa9\x0d\xb7\xa8\xab\x9c\xe7\x06_W\xb2\x01c\x8e\x1e+'\x99\x04\x12\x8c\x00\xe1\xe8\x1cL\xd9`\xa7\x9e\xce\xe6PB\xb3\xf3\x96\x1e_\xda\xd0\xaaEI\x8c#-!\xcaNN\xd8:\xd5\x19\xeea\x82\x8du\x94a\x84psSvp\x0e\xee\x8b\xbf\x8cf;\xba\x19\x12\x94\x8d\x80\xd5\xdeQ\xdd*&\x06h\xef\x12\x15\xfb\x0e\xfaqT\x9fN4\xaer\xce5\xd5\xa7\xb1\xb7\xb4/&\x06\x09\xde@\xcb\xce\xa2\x10\x86\xdcgZ\xaf\x83\x0b\x89\xbb\x81\x0b\xd37\xb0\xa0\xf1h\xe8|\xa5\xbd$\xad\xe8\xca\x10\xb0\x11\x8c\xca>\x11_\xa6\xad\x0c\x87\x81#\xb8\xdd\xae\xae\xb0>Z\xe8\xc2\xf9\x08\xa6\xef\xa7O)]\x0f\xcd\x09:\xde\xefbEE\xd2\x7f1\xcf3-J\xbb*\x09\xf28\x09\xd1\x7f\xa4\x8a\x84E\x99/\xb5U\xd3\x19?+\xac\xb0\x85\x84,\xa9\xf7\xc8\x99\xf9|\xef/G\xfcT\x0a\xcb\xe2\xec:\xaehO\x8b?l(\xa8t\xb5on\xc4\xf9\xa2\xb2\x95\xeb\xecd[\x95M\x0eX\xd8\x8a^-\xb4\xf8\x9f3\xc6I\xb8wj\x7fu\x83Y\xd2\x88[&\xd8x\xa6Z?\xae#\x7f\xd8\x10\xa6tci\x8cJ\x03\xbb\xa651\xdf\xd9\x9e\xe1\x16c\xdcS-\xb4\x1b\xe5\xc7\xd1\x1b\x99\x03\xca\x86~\x0d@/\x95\\Cn\x0c\xa7m\x93\xd7\xd4\xcb)\xe0E\x17q\x8a\x86\xaf=\x88\xd9=\x0f\x7f\xa1\xf7\x84+-y\xaa`I\xe3q\xb8\x01/\x88\xa8o\xa0\x12\xfbH\xc1\x84\xf0\x98#\xfdHb\xb2'\xc3\x00\xc9\x95b\xd8\xa1\xd0n\x98,\x8d\x91:\x8cYfF\xcdu\x90\x1c?9\x18\xd8\x06bR\xa9+\xb6\xb6\xd7\xe6P\x02\xf8\xbf\x9fC\xac\xdd: \x19*Q\xd1\xd1@\xa5g}\xaa\x91H\xeb\x07\x17\x07\xba\xa1 \x9f\xbcY\x06A\xf9\xeb\x04\xd5\x9e<\x9b\xb1@1%\x8b\x8c\x8e%\xc5\xb7\x88\xbf\xa2\x89B4\xa6F\xa2t\xd0\xd8\x08\xf4\x9dKL\x95\xc8\x03C\x16\xc9\x91~\xa8\x004\xa3\xa5u]\xcf\xbd\x8b\x925\xd2\xf7\x177\xa6\xe7\xa3\x0dz\x10\x08P\x12\xba\xad\x1ah\x8f\xf89F\xda\xca\x0b\xc4\xd2\xfa\xb3\xefG\x02\xf0\xb8\xe4yF\x96nd\xe2\x96\x02\x82\x0d\xafH\x00Y\xd9\xa1\xb4\xe2+\x9cR8\xfe\xbe\x81\xe1\xe8\x1b\xc9\xbd\xa1\x862\xeb\x9c'9\x14\xaa\xd9w-\x1f)\x82\xb8] \xda\x7f\xf0\xc4x\xb1XNFFofJ\x8eMvZ\xbb\xd1\x9d\xc4\xc6\x1d\xf9g!\x18#D\x16\x11\x8d\x11\x86F\xf0@+..\xa0\x0en\xae\xf6\xb5\xee\xac\xada\xbd5\x19\xc1&r\xb6\xa6Lo\xeb\xbcd\x9fFF=N\x161Ny\xddE;\x82\xd4B&\x80[\xd4#\xeaT&\xb4,\xc2\x00\x9bY\x8b\xeai\x1a|\x85\x91\xbb\xc7y\xfakO\xb7h\xa0\x18\xc7U\xee\x1c\x8f\xd2\xdcJ8\x14\x12\xf7\xa3E\xfef\x14L\x9b*\x8b\x1a!H4\x1a#Od\xadc2\xd0MNe,\xd7\xb9h\xa4?\x99-r\x9c\x9c\x90\x7f\x02\x0a\x84\xd5\x196\xc4\x94.*\xc3\xf9]\xd5\xba\xde\x96t\x0cU\x8e2\xc2k\xa5\x7f\x14U2\xb9\x16\x0b8CG_\xb8V\x0303\x05G^\xc2.\x01\xdf\xb8\x0a\xf4\x06uQ\x13u[\xa3\x14%u\x15\x9b\xc4\x0c\\hg\x8c\xd6\xe1e\xbd\xa8\xe0\xe9F\xf2\xfc\xb1p\xb5q\x9c\x0de\x1c\xfdm\x84|k\xaa\xb6:\xfbK\xcd\x87\xb6\xeb\x9c\x15\xb1\x85:\x16Fsa@\xf0\xb6\xa7W\xc4\xb8%\xd9\xce2\x00\xa6\xff\xc3I\xa3\x7f\xd0|i\xe9.\x04,\xe6\x8cL\xaa'\xc1\x8f\xb8\x03L\xc1%\xea\x81*\"\x81\xaf\xa9V\xc5Z\xf7>6V\x0e)\xbe\x09\xe9\xeaQ\xff\xd5\xf8\x85\x82Iv\xd0\xde$\x0b\xc8\xe8\xadR\xfe6\xb1L\xb0\x12\xcc _\xe5*6\xff\xec\x8d\xd2/b\xd5\xd7\x06^\xae\xcf\xbc~/\xaedf\x13\xaa\x9b\xd9\xcfe\xf3~\x8dn}\xe4\xe3\x9a\x0cB0\x91M\x0d\x03dd\xbbY\xa1\x0ef\xa9L\xee\xf8\xb0\xc4Sr\x98\x0c\x82\xe4p\xf8%\x83\xaa\xbdW\xe9\xfc\x95b\xf3\x94m+\xad)\xab1\xc0(\x96lT\xed\xf8\xf1\xeb\x95\xae\xda%\\,F\x1c\xb4\x83\xbc\x9b\x0e\xb9\x96\xa9i\xaf\xea7\x9a\xd4\\\xe9\xa0`\xf0%\xee\xfdDi\x19\xeaD\xbe&\xc5\xe9\xb8\xe7\x18\xe3\x88\xb1\xaf\xc8\xbb\xb9\x81\xeb\xeas\xc8O\x1c1K\x11\xcb;,\"\xc3Y\xd1\x90\x88\xfd\x9a\x83E\x93M\x1a\xb9\xbe\x1f\xfa}\xa3\xe8U\x85\xf4o&B\xb2\x91\x98\x0a\x8d\xdb\xfe\xbe\x1ax\xa7:#yec\xe2\xef0\xf8\x82~\x1bim$\x97\xf7\x80jKu\xda\x16\xaa.WC\xccO_\xa6\x89\x1d\x99\x026D\x96\xa0\x95x\xab\xc72\xb3\x03\xd5\x8a\x85w\x18\x00\xe3\x0bd\xa0!\xd5x\xdc\x93\xb9*D/b\xf2\x15\xbc\xeeP`t'\x17w\xc8\x03\x81h\xd8\xa1\x0b\xf2C|o\xaaa\xa0@\x19\xdd`\x03\xa9\xd4\xef\xddR\x984\x8d\x16\xed2\x0d)\xe1A3\xc5\x86\xac\x0deS\xb3\x8e:GH\xfcX[aZ\xfe\xf6\xaej\xfc1|l`\x9f\x9a\x1d |\xec\xee\x07\xd2\xa5 \x7f\xb6\x06_\x10\xbe\x84\xb5\x911\xb0C\xa7^\xd7\x83&\xd9%\x1aC\x1e\x90\x04\xb8\x01\x99\x7f%]\x17\xcb%)3\x15V\x14\xd5\x88\xf7A\xca!\x9b\x98f\xeb\xb75\x18x\x9f?BO\xf4\\\x86J\x9e\xff\xce+\x10\x06\xb7@\xdb\xdd\x03\xb6i\xab\xa2\xfc\xb3\x07X6\xe3\x02\xba\x04\x9cD\xa35\xe4\x81\x88\x8fF\xcc\x86\x0e\xa8\x09\xa7\xf4Z57.\xbaI\x03k\xc9\x0e\x0d\x03\xf8\xe9\x1b$)7\xa0\x92\xe0v\xf8\xda[\x81o\xa2\x9by\x1b\xd2L\xe2\x1f\xe5\xb1\x0a\xd5\xd3\x9c\xab\xda\xa6\xf9\xf2\xc0\x10\xc7\xb3\xc7\x0f\xefcu\xe9\x18Y\xab\xff',\xe68Mt(v\xf4\x0f\xbc\xc2\xd4\x1b\x13\xc2a\x7f\x1832%\xf7\x14b\xfa\x08\x0bj\xfcu\xe1\x88z\x96\x96\x94\xf2ib\x1b\x86\xa0\x85X\xf4\x12\x0e\xeb0\xb3\x85f*f\xb5\x84h\x0a\xba\xe8\xfa3\xfe\x88\"\x16\x09\x0a\x0a\xbd\x91h6\xa9\xfa\x97\xd9\xd3\x7f\xac\xbaP\x01\xf8h\x9d^\xebG\xb1'L\xfd\x96\xca\x12\xa398r\x90\xf61VF9sL\xa5\xe9\x94\x98\xe2\x03\xcd\xcb\x0e,\xd8\xd1\"\xcd\xb3e\x1d\xde\xf4\xd8\xf8\xc9\x0ab\xa7\x0c\x0f\x18k$f~!\xa6/\xdde\x03]\x96\x1c\xc4W\xf0\xb4\xb7I\xd0&\x99\x17t\x14Oa\xc0$\x89\x88P0\xcc\x04f\xa9\xa4\xd4\xff\x90\xa0\xaf\xd8\x1d\x93\x983\x89\xf8r\xbd\xd7\x0e\xf1 \x85\xad\x9c2\x0d@Iv92\x88\x9a4\x09\xd5\xec\xb1mo\x8b1\xe6dj\xe6g\xcc\x1e-|\x83n\x0c\x80h|\xdd\xb89a\xb8\x94\"\x9d\x92\xf0\x0c\xf32%\x00\x02\xf4\xd2\xd3n\xa2\xbf\xeb\xea\x8c~m\x12i\x9b\x12\xd5\xd8qrZO_\xa6\xf2\xe5\x9aL\x0a4\xe0\x9f\xee\xc0_6\xb3\x90m!_1\x9c+B\xb0\x82I\x10\xd6\xf9\xba\xba\xde],\xc7\x07_\x83\xd5\x90\x94U\xb6\x14\x08\x12\xc83\xeed\x0c\xdd\xe9\xc2?\x86\xd8\xad\xce\xb5if\x1ff\x12r\x1a\xf2\x9dH\xeb\xe5\xb1\x13\x1d\x02\xbb\xd6,\xe9.r\xd3ZX\xa2k\xcf\x1em\xa2\x0d\xc4i\x96r2\x02%\xa7}+\xb29v\x84\xb7B\x05R\xa6p\x11\x16 \x16\xc4+\x17h%\xa7\xa9,\x91\x18~\xfe\x8eo\xf8\x80\xb0\xdc'\xbc9\xcaF\xd4\xe0\xf9\xea\xe0\x1e6\xd3T?{\x99\xb2\xc7\xa9\x86\xafM\x82$\x98\xecDlK(\xe2\xd4\xf7#\x1eg\x1f(\xd8<\xeb\x1f\xa1n\x16\xe7<\xae_oP+\xc4\xd2\x91\xd5+d\xb0\xcc\xa2y\xf3\x1c\x1e\x11U\x97\x91\xee\x91\x14\x1fO\x16\xd3\x98\xb6\xe2\x9b\x17Q\xbf\x8a\xec:%\xd1\xce\xb2^\x1f\xb4\xe64A\xa5\x05\xd8\x91\x1b\xfc[-\x8e\x9d\xc3a4\xae\x105\x02Y{\xacKH\xf6l\xec\x1f\xab\xea\xe4\x12,\xa9N\xdf*I\x11\xed\xe8\x0e|;<`\xb8\x91\xab\x94iI\x167\xa9]\xc4Q\x06\xd5\xfd\xb4G\xa4M\x96\xb2\xe7C+\xfd`\xf3\xbb\xf7P\xbbv\xf4r6\xf5\xd0GMD\x9a\x07A]i\x9d\xdd\xedSB\x9f\x11\\\x0b#\xffA!\x85\xc3(\x09#\x9c\xf4\x0d\\\xe1V\x0d\x109\xb5\x89\x18*\xec_\x85\xc2\x97\xe8\xbf\x17\xe8\xe0\xb6\x04<\xfe~\xe4\x15\x8b\xc4K\x02i\xa0\xf1\xa0\xa3AEm\xd9\xb6\xd6\xb2\xfa\xd2\xc5F\x86\x92\x93\x1e.\x98\xf1S\x87\xc2\x8d\x90\xcd?\xb4\xca&x\x81\xba\xe6\xee\x07\x15 \xb0#`\xd6#\x1d@\x91\xcc\xc6\xa8U@\x9d\xe67s\x01\x921\x00\xc80\x10k\x0cNF\xa1`a\xbf\xde\x19\x0e\xcd\"\x82K\xecnm\xb3\x05\xc9\"\x19\x9f\x12\xcb\x09\x01\xee\x03\xc9BL\x83K\xb2l\xc6\x06\xda8F\xfbDW\x92\xdb\x1a\xee\xdd\xba:\x8a\xbd\xc6\\\x94\xb3@6e\xa0\xa1\x001m\xd9\x0c\xfc\x90\xfb\xba\xa9i\xca\xdb\x7f\xb8\x19&j\xc3=\xcb\xaf\x0bLwO\x96P\xe0\\:\x8f\x01j\xb8\xc1\xd0x\x1axc\xe9\x1b\x17%J\x17\xa9\x94:\x87\xbcc\x82\x99<\x1eD\x08N\xc50\x11\xb62\x19\xb7\x8c\x85\xc1\x11\x94\x7f\xab\xda\xfb\xf0\xfe1.\x15:l%\xc6\x18I\xe7\x92\xbc\xd3D\x99\x9a\xd8\x0d3eh\xf8\xf5R\x85\xa5\xbf\xb9+\xdc\xc3E\x90\xca<\x9d\x99\x15\x937\xbf\x96B*\x8c\xfc@\xa38\xd9\xe2$\xf7>6X\x89\x91\x9b\x04\xf5C\x9f}\xf1\xb5\xbf\xf1\xf5\xfd(\xdc0BZLD\x09\xde\xae\xb3\xe1\xfd\xc0X\x99\xa9\xe7\xa4\xd9\xd2\x19\xc9\x82(\x9f\xc9'\xaa\x1c\x9ad\xe1.\xdd?\xf1\xc3'\x04\xbc7T\xeb<\x91j\x9d\x9f\xff\xf9\x97\xff\xf4\xf3\xff\xeb\x97\xff\xf1\xe7\x7f\xfd\xe5\xff\xfa\xdbU\xec|\xf7\xce\xb5\xc5g\xe7\xda\xe2\xbf\x03\xf8i\xbd\x08g,\x09\xb0M\xe8\xdab}m\x01e9\xbd\xeb%>\xe6je\x8e\xaa|\xaa\xad\x96\xa7\xeb\x0d^;\xff\x13\x89\xe5|\xc8 3\xbfF\xb7\xf7y\xec\xfe\xe6\xe6:\x12\x1b6\xf0\xf0[\xf5E\x0c\x0c|?\x81\x06\xec\x84;@\xb9\xfdp\x86\xed\x8br\x0a\x96j\xe2\x93\xca\xb4\xa4\\\x88\xc7(a\xdf0c\xeb\xa2D}\xd2\x072\x93\x0fSSyF\xf31\xcb\x01\xad\xa7\x7f\x96\x11\x06\x09\x89]\x9eOK@\xc0\x07+\x0f\xf8\x0dz\xd43g'Bj/\xdf\xc4\xea\x8a\x84\x0f\xa98y\xce\x0f&!\xbd\xbe\xea\xe0\xe2\xcc\x1dW\xaaE_N-\x0a\xe6\x0fH\xa6z\xe3\xe3v8Q\x94\x96\xb1\xe3\x08\x14S\x88:\xe9\x1e\x85o\x1aO7\x09o\xda\x96\xbe\xf4u\xf1\xa9\xaaCx\x98\x03\x00\xf4\xbb\x1di\x0c\x1c\xe9G\xc8Y\xd7\xd5\xd5\x85\x13\x99\x8c2\xcb\xcee\x1e\xc3\xbeD\xe2\xcc\xa0\xb2T\xc0\x05\x16;fn\xb5a\x83\x84\x09\x90\x8d>$\xb4\x12q\xa3\x84\xca\x9f|\xb5\xeco\xc2M\xb1^\x17D\xe5\xe0W\xf0f\xdc\x05\xd4-\x91l\xfdK\x06\xe6A\xa3\x1b\x016\xbb\xb0&\xf7Z\x92\xc7\x15+L\xd5=\x15\xe6MU\x995\xd4K&m,\xcb\xfa\xba$d;\xf2\x01r\x9d\xceT\xcc\x96\xe8#\xf5\x1a\xaa\\^\xafSz7\x80\x8f\xd5H6\xa8\xb5i=\xbd-\"\x8f\xfbA\xd6y\xbct\xa7E\xdd-i\x16\xda\xda\x9e\x18\xef\x1f/h\x18\x9c\x02\x12\xe5\xa0\xfd\x95\x91\xffX\xae\xf4#O\xd2T\xa56\x12\xb7P(\x87\xcdH\x8e\xd3`p\x17c\xfd]X2i\x16n\x97I{\xf7&\x89\x19\x99\xaf\x98\x1b\xc50\xb4\xd5!2\x94\x02&Y\xcep\x80oP#@\x06l\x15\xdfQ.a\x87cCER\x10'\xdfGv\xc3\x8c2\xf5QVxc\xb8\xfd\x08\xa3\x82p\x12QgBK\x8e\xe8\x92\xa0=\xca?\xf0f\xe6\xea\x9c\xf3a\xfc\x05\xda,\x0eQ\x93\x86\x96\x07\xf7?\xbe[{.N\x0e\xea\x88\xbd\xb8\x8a\xbe\xc8\x8f\xc6\xedW\xa5p\x9e_\x9d\xbf)\\\xe3\x83\x9b\xc0\xb2fi\xd1\xf0\xe75\x1a\xda\xc7O\xe6\x0d\x85pfTm\x09\x17\xe8&\xf8h\xdfp\xe7b`\x13\xd3\x89\xccd\x88\x9d\xbc$\xdf.\xe5-\xcd\xcd\x0d5\xb4Dv6O\xad+\xd0\xd4\xad+\xe1\xa9\xd5\xa4\xd6\xcc}\x7f\x0f\x9d\xfb\x8e\xcc\x7fK\xf1H\x8b\x05\xce\xd0@\xeeI\x86r\xd7\xde\xe1W\xac2\xfd\x1f\xfc%5\x96\x86j\xe1p\x9b\xbf\x92o-\xb7\xfd\xe1\x14N\xd8\xe6\x10e\x91~\xe8\x81D\xc5\xff\xbe\xf8\xb1:\x83(&P[S\xac\x8f\xcc.2\xa3N\x1cp\x15\xfeY\x15\xc1\xea\xa6\x88\x8a\x1a\xdf\xdfw\xae\xe6\xfbf\xb5\xce\x11\x9a)g\x98\x1e$\x10\xbeD\x7f\xe0\xef\xba\xc1\x17\x0b'D9\xa1\xcb&7\xd8\xe1\x1d\xf6x\x9d\xb9\x05\xef\x04\"\x11\x1b\xfe\xbc\xdfP\x8be\x00\xec;T\xfa\xc5D\xd8>\x86\xf4M?\x05/\xf6\x8b\x87+\xf1\x1bZ\x06\x1d[}rE\x161\x8d\x15j\xe9J]\x85\xc5V\xbb\x09\x86\xa0$.nd\xa2ec\xae+<i\xd3(9\xf9\xbeQ:\x94#i\xb8A+w9\").\x02\xc3\xdcgL\x83\xc4\x85\x90Q\x0d\x9a\xc8QR~2\x0a\xac/\xab\xbc\x94NY\xd8S\x1f\xfa\xdd\xf5\xc8\xc4x\x9c\x18\xc0\x09\xdc\xde\xb9\xeb>\xd3R<$q\x9e\xc4\xddW\x83I\xed\xdd\xf3\xd7\xf6\x0b\xef.\xb2Tq(\x17\xf98\x1e\xa7\x94\x15\xdc\xed\x94\xde\xeb\xf0\x94\xda%\xd3Zfo\xeb\xf6)8Y0\x9b\x0d\xb4\x901r&\xeb\xdbb\xeeH\xbc\xc3\x15\x17\xf8\x06E\x0b?\xf5\xde\xe2\x9e\xa7K\x173\x19^\xa2gJ(\x1d4i\x1a\xe5xfYB\x854\x03Jw-Jgx-y\xf1\x1e\xf5\x88\x8e\x94\x04\xfa_\xff\xa8x\xcf\xdb\xa3+\xa0\x81\xeb1|\xc4\x0b\xe3\xde\x99\xfc19\xba0* \xc0\xd6)\xf2\x03\xeb\xa0hm\x8d\\H\xf2\xab#\xc3 n\x05nX\xa5>x\xbc\xe6J\x09\x9d$\xef;\xca\xdcJ35ng\x8a\xb0g\xda\xe3\xf0\xa5\xb8\xb9\xe0\x9cb\xc5\x09\xa6g\xb1\x80\x99<\xd3\xa7\x90a#D\x88\x0f\xde\xe7r\x0b3\xca7\x1b*\xcf\xc6\xec\xc62uh\xe1\xb0\xfd\xb0\xe3\x02\xcc}\xa2L\x8e\xbf\x97/\x10\xe2\xf9\x0b\xc6\xc4\xc2\\\x0a\xb4|\x94\xba\x81\x8b\x8f\xc2`'\x8c\xf1b(\xcar\xa4\x87\xae\xd9ao\xee\xa9\x08\xf9\xedz\xe9\xd8?.\xa7`\xadJ5?\xa5\xa9\x10\xbf)S\x1f\xffD\x0b\xfd\\uP\x94\x8e\xf6r\x89\x03\xcf\xd6\xa11V\x96`\xb4i\x0d\xfaD\xfa\xc2\xbc\xa2\x8d\xde\x15;&\xf8FM\xa4}\xb0~\xd1r\x99\x09\x80-\xccJ\xd9\xeb\x8c\xbc\x92y\xe6TV\x93\xcb\x01c\x90tC\xb9\x9c\x11\xbb\xba\x06\x90\xcf0\xcb\xb3\xf3\xc6\xf0\x17\xa8\xd4\x9c\xe5}+]1>\xebG\x01\x91\x8f\x1e\x86\x04\x0f\x8b1\xb1\x0d\xb5t\xefY '\xcd\xb9\xf2\xf3,v1\xca\x9b\x99\x98\xff\x00\x98\x932\x979\xa6\x8f\x88\x12(\xdb\xefO0\xe5\x89\xdcyk%\xb79\x83\x19\xbf\xa8\xe0\xe6\x09\x01[\x06e\x10\xefw\xa6\xd2\xb6^\xad\xf7.):6~\xda\x88\x13\xca\xfa\xb4\xfa\x9ah\x18\x93%*\x06\x06\xde\xc8\x98\x83\x02\xf2\x08\xcc\x83z\x08\xbd->W\xb5#\x8a\x80A#\x1e\x89Ic\xa8-v%\xe1\xa0p\xb3\xcb\xe1%\xbf\xca\x14\xa1(\x82\xe6\xae,2<\x15D[F\xd0d5y\x04\x09\x9by\x98n\xc5\xb8O\x18bS\xc1%\x1f\xf5_\x0b\x19)E\x8aH9s\xca\x9a9\x134\xd9$\x8e\xb1#s*\xe2\xdd\x8b\x01\xb0\x9c\x0b\xf0\xab\x89n\xe4\x14\x94^[*S\x7f\xfa8\x9be\xa2Ot\x10\x96!\xc4\xb1R\x909\xc0v\xfce\x0e\xa3\x09\x11\x02\"\xbd\xa3\xb5\x10K\x18\xf2=j\xaa\xb0x\x16\x97\xf1h\x0aFd\x94\xda\xe9T1\x84\xa9\xb4F\xfd\x0d.a}rd\xa6\xf3\xac'H\xfeC\x8d\x14'=\xd0\x8e\xc8\x1b3e\xb2\xcf\xa0\xc5\xa14\xb5\xa7\xd1#\x9a)\xcd7/\xf1Z\xa9\x08\xd5\xa6S\xe5\x7f\xe1" +
+	"/\x18A\x98\xd33\x9c\xaaH\xf8T\xb5\xa45\xb1\xa5\x1e\xf2\xbd-'\x9b\x80\x84\x85#b\x17\xe3\xc8\xeay\x9f\xa1\x84#3\xf8 \x91\xb6\x05\xeaX\xca\x0a,\xe2tF0q\x1c\xc5\x94\xa0Z\xaa\xb3\xdc0q\xbe\xf9\x1e\xdc\x88\xeb4\xb2\x92F\xf7\xb3\x85N\xfft\x89Q\x98:\xacq\x18\x8e\xf2\xf3\x0f\x09\xe2q{\x80\xc2\xf5\xd4\xaa\x8d\xa5\x98Y12Y\x9b\x03\xf8\x93\xc0\x02\xf2y\xce\xef\x01\xf0\x13M\x19d\xbb\xc3\xbd\x87\xff\x15'?\xb5k\x1a\xe0zX/t,\x13\x92O\x15\x8c\xd9\x95_\x7f\x85|O\\|\xd2>:\x96\x19\x1c\xb5\x9f\xe2I\xe1-a!\x96\xccUn\xbf\xder~\xf2r\x0aA)3i\x14\xdcR\x1b4\x1d\x93\xfcD\x03\xa9\xbfH\x94\xd1\x13\x96N\x0e\xb3\xeb\x9c\x153}>nF\xd34,Wh7L\xc7\x1d\x8b\xed\xecG\xad\xdd\xaeR\xf5\xe4\xd5\xc4T\xfdS\xe6X\\\xfc\x1d\xb4\xea;\x19\xce\x1e\x05\xd1h\xc8\xefDK/O\x11L\xa4\xce$\xb8\xbe\x1d\x07lJ!5\x12\xb3S8\xea~T?\xe0\x98,%\xd6\xff\xe7\x8a[\xd8l\xa8/W_\x1f\xf9g\x14\xbb\x1d\x98\xc4\xec\x83#\xb3\xaf3[2\x955\xea\xbb\xd9\xa0~\xe2\xf2\x0a\x9d\x84B>\xb21\xdfP\xc2\xc8@\x14J\xa86\xbew'\xb3\x990\xab\x11\x13 k.?\xb2}\xe0\xf1\x1f\x01\x86\x03\xb4ghc8\xfc\x90l\xab\xb2\xe5\xa8\x91\x8b'<\x9bv\x13\xfbP\xe3\xf7\x1b\x8a\x04\x95\xcb8%\xa5\xbdV7\x02\x14\x87v\xf03\"e\xeb>[Lez\x13c\xdb\x8c\x12Jy6I\xb2H\x89u\xbc\xa1 \xf0a\xd5C5\xdc\xe0z\xc4\xcf\x02K\xc8\xda3)\xd1\xd0\xc6y\x85{\xe8\xf0\xa5\xae\xe0\x07\xe5\xa8J\xa8\xe56T\x0db\xa9\x8f&\x9eh\x1e\x1d\x12F'\x0c\x1e*\x7f\xcf\x889!6\x12\x8b\xd2\xaa\xeeR\xb5\x03\x9a\x81X\x19WS\x1e\xc0\x0f\xd9\xf6c\x1cAk\x15\"\xe47\x0c\xe74\xc2\x06Se\xcc\x85>8\xb8%\xaar\x0an\x8a5n\xe6wh\xb4\x1c\xd6\xab\x0ca\x00?z-\xe3hpj\x06\xad\xf8\xb8\x0c\x0c\xed}\xe2\xf8\x86\x050=\xfb\xe9\x1b\xfb\x0d%\x80\x1fb\xa0n\x93\xca\xc9\x08\x90d\x0ev\x0a*L\xea\x1c\xaa\xc6\xbdY\x0a\x02\xad,\xae^\x1b\xce>\xc8~C\xa9\x9f\x12\x03@\x0c/\xa7\x1ep\x08\x07Yo\xa0\"$\x9c\x08\xf8\xa8\xf7\xfc&r\xc2*]s\x84\x95\xd9j\xd7p\x1d\xfd\x97*\xeag)\x0cI0?gjI\xfc\x16\xcb\xbb,\x19\xc8\x8dU\x82X\xeb;-\x0d\x13b-\x0e%9\x8f\xe1C\xf5\x19\x0dn\xfb\xa8\xff\x0a\x9e\"\xefV\x19+P\x03]\xe2\xc3\xeb|\xdbP\xe0\xb7\xd2B\xec\x1c\x0c\xf8\xc1\xe0/n\xcf\x8e\x0b\xf5M\x8cr\xe1\x86\x8f\xdb\xfb\x05\xae0\xa8\x97J<\x83\x91\x9fNu\x04\xb4\x007$\x90\xe0\x1bt0\xa4\x09\x9076~\xd6Tm8=.\xc9\x01\x82S\x90-\xab\xa8\xc0\x90N\xaa5\xa4IZ.\xb8+f:\"\xebo\x8c\x9c_\xa1\xb6t\xd8\xe4\xe4\xdb1\xb8\x12\xbeoC\xbd\xdd\x07h\x95\xdaA\xd2;\x12\x0a\xd0\xe5\x90\xc4\x06(\x9f+\xd4\xbf6\x00\x15OZ.\xcc\x0e\x93\x87\xd6\x03^\xac\xd5\x04\x0c\x91\x1a0\x1f\x14^a?\xabS*\xe7\"\xb3\x88M\x0786\xd4\xbc\xad\xc7el\xc7\xa9Q8T\x1d\x95\x1aX\xa5L\x84\x9e\x84E\x13\x9c\xd2\x8c\xccjC\xa5Z\xf2\x8e\xe16\xb4\xf7\x84.\x99\xda!\xb0\x13i\x88SdnQ\xae\xd8\xeaL\x1ex\x81:\xa9\xd9\xd1h~\xf48V\x8a\xb5b\xa1\x1a\xd9\xfei\x03\xe3A\xa7\x13 #\xb0\xb1(\xae\xc9\xd3\x96\x80C\x17\xb8\x1e/Qm\xcc\x0f\xb0,\x7f\x91\x16\x91%\x99\xfb^\x98>\xd8\x06\x84\x1a\xa6\x02\x98jc5\xae\xb99\x0ca\x1ay8\xa3W!~\xd1R\x94-U\xe9O\xe8\xf33\xc0\xfb;\x82\xcaJ\x14\x92\xce7\xa61\x8f\x89c:s\xf6k2\xf8\xcfG\x06i\xd2X\xdd7p\x96\xd2\xf6$U\xad\xa9\xb6)\xab\xf4\xb1U\xb1\xa3\xd4\xc2\xbe\xb8\xb8\xd6\x06\xdf\xe8\xaeBMJ|J\xca\x81]\xf5~%\x0c\xb8D\xd70\xc3l\xc4\x96\xe8\xe8\xafuE\x85\x1eQ\xe2\xdf\x82\x99)+\x14\xaf\xd9\x17\x12\xd1\x92\x0b\xf3\xa4\x8c\x05Kn\x0d\xe1&i`\x8b\xc6_:\xa3\x9f\xbd\x98\xc8\xf7\x8f\xcd\xa0\x18\x11\x9f\xc2y\xd1D\x9fR\xc2H\x1d\x82b\xfb\xda\x90<\xbe.\x0f\xadk\x09\x0b#\xa6\xf1\xe9\xa9\x82\x84\xd8o\xa8\xba\xfa#\x9e\xe0\xac\xf5\xed\x8d\x08\xb0`\x82-P\x9fS\x9ax\x18\xe5J\xf1\xa2\x1b3|\xbdBX,\xf4=\xa6\x13\xfe8\xe9H\x89\xce\x95\xd25\x98\x81\x17I\x99\xa4\xf2J;U\xcb\xa0wj&-\xb9\x86\xcf^m\xe0\x00\xa6\xd8\xa8\xb2\xd7\x91\xb8\x88U\x96\xbc1R5-\xb9\xfcX}\x83;w\xc2>\xa3o\xe3\x10+\xff}8 \xa1\xb9Q|u\xd79\xf4d\xf3\xd8_V\xf7\x91\x97\x94(u\xfeL\x85O)\xa4\x02$\x8f\xd09\xf5@\xf8R\xdd2\xa7\x0a\x96\xb4\x16\xc8\xba\xf1\xb9\x07\x9f\xaf\xe1\x9d\xc4O\xf9\xf74 \xfbI=zi\x7f\x18SJ9\xe2\xc5\x02\xc1\xe1\xea\x96\xfd\x86\xc2,E\x94\x19\xd8A]up7\x81&;\x88\xdb\xaa\xc1\x18]i\xe1\x0f\x1a\x15\xb1L\xea8H\xd0B}\x04D\xe2II\xa0\xe2(\xdc\xf4=,\x10)\xbd\xc5\xb5\xbaB\xed\xd6\xbe\x7fX/\x003\xaec{\xb6\x9c,\x96\xc7*<\xebx\x95\x06\xc5\xe6\xef\xec\xf3\xf7\x93\xc1\xc0\x83\x85\x98F!\xec\xb6\xf4W\xfa\xdad\xd7\xad\x86\x0cc\xb0\x0d\x83U\x1b\xeb\xa0x\x19\xfa\xf6L)r#n\xc6\x93\x89@\xf6\x91v\x92O\xafc\xf8\x903\xb0t\xa0\x99\x14\xfe\xd5\xad\xea\xc8\xa1P\x85M\xec\xd7\xd2\x09\xaf@1\xc0\xc9u\xfc\xdbH\x95\x85\xbb\x814\xc1S%\xb2a6\xe1\xba\xe6\x0c\xab\x97P\x9d\x87Lg\xe8\xe7\xe2a]9.\x8a\x90\"\x8f\x0d\xf5Y\x18PZG\xcb\xe3o\x12\xfdQ\xed,\x12|#\x8a\x8e\xcft\"(s\x96!\x95\xb4\x89\x81\x1a1\xbb\xd3\xa2\xa6&H6\xfe\x12Z!\x0e%\xc4\xc9\x98\x95\xe0\xc4$\xef\xc0\x17\xb8\x80r@0\xf5J8\xf4\x9c\x1d}3qYb\xc5\xa4!\xd9\xb34\xa1l~\xd1\x91G\xc2X\xc8\x84ea\x12s\x98\xab\x8e<Z\xaf(\x13\x91X\xfa\xaaI\xa4\xd7\x16=+\x99\xa0\x07W\xda\xe4U&\x16\x88\x9d\x80\xa0\x08\"F\xae\xf9\x86B\xa9\xa7I\xa1\xd4\xff\xfa\xf3\xff\xf2\xf3\xbf\xfe\xf2\x1f~Ci\xa3\xaaq\xe3)\x14\xe3\x0f\xb0\\Z\xfft\xd2\xa3\x9b\x9dO\xa3*\x91_!\xa1\xfcl\xd6\x9f\xcd\xa8Y\xc2\xa3\x1c\xda\xf3q\x95\xfc\x03x\xcc\xa9\xfe\xe4\xffB\x81h\x8c]\x11{\x8e\xc5\x03\xf1\xf4\x1bhS\xd8\xb5\xee\x0aN\xcb\x03\xf0h\xe5\x88\xa6\xa9_\xbf\xb8\xbaY\x08??\x9f\xd2\xb6\x8be\x8e'\x09\xd8\x9a\xec\x8c\xe1\x9e\x91\x18\x9d\xd5c\xd5\xcc}\x94}J<\xe1\xb7\xab\xc5J\xef\x89\xdb\x9d\xde%ta\x0b'w\x0c\x1f\x93r\xc7\xb1fg\x9a\xd9uCI\x11\xeeM\xa64\x1e\x9fH\x85\"\x13\x8a\x0b\xb1\xea\xdf\xd8\xf5\xd7\xc8%\x18U\xde\x96\xf8s_%=o\xde)r\x11\x8f\xfc\x9bE\xed\x00\x03!\xdc1\x04\xdb\xb5\xb6h)\xa7\xcd\xa0\x02\x8d\x93\x17\xbd\x90\xb8\xd2nU\xe7\x9b\x1b4\x00\xad\xe9V\x96\xd0Y\xec\xd3\xd7iX\x1aW\x01\x99\x9d\"\xe1x?/\xbf\x9c\xd6\xf7.$\xd2\x94\x0d!3C%)\x91P#|\x96\x9cX\xe9\xf4~\xe8\x80l\x93\x16\xd9,\xeb\xf3\xa7\\y\xd4\x87\x09\x1a\xd5;\xc5\x91`\xbf\x87\x15\xd3\x8d\xdd\xd5`D\x09O\x82#~\x0a\x83\xd8\xf8V\xc9\xfe\x94\xf9}\x1b\xaa\\>\x18~\xb1\x84\xb1\x0c:=T\xe8O\x03k\x07\x89\xe8\"*\x8cb\xac\x16\xa7<\x8b\xc4\x88H<\x1d\xbd\xc0L+T\x13gK\x93$\x0d\xc5\xb5=1(R:\x0a\x02Dd\x17\x87@\x02=\xda~C\xb1\x89}#\x1de:\xd4T]\x16\xfa\xb0\x9c\x92:\x1c3\\\xd8\xbd\x0d'wh\xa6e\x959\x11\xf20x\"%\xdc\xa2;\xf3\xd9\x8c\x15\xd5\x0c\xca\xa8\xde<\x85Y)\xbd`\x0b\xdf\xb1LP\xca\xfe\xc5%\x17/\x91Z@\x087{\xbf\xf6\x9e\x03\xe4\xd9\xec8\xfb/\x18_\x8a2\xd6\x17(>\xfb/\xce\xaa\xa9)\xb8\x06cM\xb1\xf3\\\xeb\x14\xa1\xed\xd0A\xbf\x08x\xbb\x8f\x06!\x1b\xec\xd0\xdc\xf8\x89\xd0\xe3\xc9tX\xaf\xac('\xac->|\xc46IR/\xe1\xcf\x9b\x15^>$\xe9\x82H\xfe/\xd0\xc8i<LdV6WEe\xb8\xcfs\xce\xad=\xa3o\x1a\xa5\xa0\xfem\xefa\xa0\x928\x0aJ\xb3gN\xb9\xe7\x0d\xa8\xf3?J\xad\xe1\x07g\x0b\x0d3?\xb5\xd4\xa8L\xdc\xeb,z\x16\xbfY_|OX&\xdcoh\x11\xa2\xb3*\xee\xe3\x06T\xf8\x1fy&\xc8\xa4\xec\x13\xb5\xbf\x91\xb65k\x9e\xd4p\x82.\xea8\xf2v\x11#`v\x84\xce\xe0\x8a\xc6\xf5\x92\xa3\x1b\xd7\xbf\xa3\xba\xe5\x1b\xcd57\xbe\xc6]\xfe\xe2\x06h\x8a[U\xc3 \x19\xa7\xd2A\xc1\xe8\xee\x96q\xabKa\xf5Tz\x84\x8f$\xa2\x1a\xdb\xf14\xa2\x0e\xa3\xd1K+\x94\xf0p\xb6\x122P\x92~`\x17\x04{\x1c\xe5sb\xb3R\x8c\x02\xb9\x15VT\x88Dk\x95\xbd\"\x96AlT/\x19\xcf\xfeq\x8d\xbbEj\x9al\xab\xf5\x8cfz_\x07\xcaZ*:\x1d\x1f\x159\x928\x1c\xfe\xbdP:\x89\xbc\x1b\xb7#\xb6'\xd0\x1d\x15\x1f\xab\xabd\x9c\xcbp\\P\x8dP\xa0\x0ecR\xa2\xb1\x1c/_\x8cPK\x16\xdb\xa0\x0d\xf6\xc8\x8au\x1b\x80\xe1c\xa5\x12%\xc8N\x90\\\xe3\xae\x18\x09_\xa9RnL\x951\x8bkN\x8d\x9ail\x8d\xa3\xcc\x91\xfe*\xcc\xdc\xda\x0d\x94\xe0jE~;#\xd9\x1c\x85 K\x7f\x86c\xf8`\x02\xc5- \xf0\x01X&\xf12\xe2\xa3\x09F\xa3\x92A\xa6\xb6\xb1\xc4\x8d\xc5\x96L\x95o\xa2\xc6\xbb@$\xa5\xf6\xea\xed:\x9c:\x85\x8b.\xe5+D\x88\x0c\x97\x95+Np\x83\xfa\x88\x9f\xfa\xa73\xb4~\xe0X6\x9c\xa1\x81\x09\x02L\xbcv*\x01\x9e\xda[\xa6\x02\x86k\xb5\xf6\xc5]u\x07\xf5F\xa1\x84\xa73\xf1\x9a\xc3dL\xda\x92u\xd8<Y\xc60@\xce\xcb\x15\x1a~G\xb2\xc5,c\xd9\x04\xd1}\xe9oD\x1dR\x94\x8e\xbf!\xc9\x94\xdf\xb4\xafg\xbaZ\x99\x16\xa9\x1b\xa9\x13\x8f\x0f\xe9J\xb5*v\xff\x84t\x8f\x18Z\xc9s|\xcc\x1di3r<n\x98\xf3\xf3g\xd8s\xd2't\"\xd4^u\x86\xc5D\x94\x1a/\xe3\x93\xed\xf0\x80\xdf\x8fCCb;d\xa0\xd9qw)\x81\x9e\xb7L\x973\xb2{\x93C\xa0i\x91\xbc\xf0\x96%\xf24O\xa4\x19\x89u\xb5\xbc'\xcf\xc7\x9am\xd1A\x95\x8d\x1dH\xe0\x9c\xf5\xe8X&\x97\x99\xa0\x16\xe4\xedqg\xea\x07X\xd3CO\x95RD\x8fK\xf2\xba\\\xe7\x9c+Ka\xa7\xaa\xee'\xa0\xa1:OF/uard\x90\xc8i\x04\x11\xe4\xaa\xcda#?\xacC\x809\x94\xb9\xa3Y\xc4\x07\xc0>cx\xe6\x93\xb9\xf6o&\xf3'\x06mGs\xeb\xe2J\xb8W\xf9\x84zM>!\x85\xdc\xee\xae\xdc]\xf0\xa8\xe8\x1f\xedS\x95+\xc4\xa9\x17\x03\xf8\x8b+q\xe5\xcb\xafb\xf2T\x10\xa9X@\x8a3H\xfc\x0d\xad\xf5H\xac\xf6\xf1M7\xe0m\x19\xce\x90\xa8pF\x10O\x06\x02\xcba\xdem\xb2\x09\xe9\xb9\xc4\x14Ze\x94{M\xbe\x17\xab\x16\xb3\xa0\xb5\xec\xb1D \x9b0$\xdeT\x1fw#\xaeVd\xec'\x1f6O\xdd<}\xe4\x19v\xbe\xde]X\x19gvW]\xa2\x93^\xdf\x06\x9e>^7\x90\x84\xc17\x91\x1b\x92J/\xad7\xd6\x92dj\x8a\xa0\xdb:'.\x04bx<\xc8\xf9\xb3\xc3\x00\x16\x95\x14_\xc0\xfd\xb4\x0f\xb7]\x13\x85x2]\xca\xa6\x83\x07gw\x0c\x1f\xe2\x86\xb1\x8b\xfd\x96O\\\xf1\x85\xa5\xd6,\xf5en\xc2\xc1b\x8d\xd0\x09\xe5\x11\x05\x11\x9b;b\xe46\xf3A#8\xb4\x8f@6:Z\xc0\xb5Q\xeb\xb2\xc4L\xe7p(\xe0\x0c]\xbcR\x9b\xc0.\xc4\xad\xea\xce^\xd4'\xf3\xbbQv#}\xeb\x14\x1ei%\xdadi\x08\xbc|\x03`\x98\xc2\xdf\x02\xa09Q\xa6\x8965\xd5\xe3\x0c\xdd\xd7\xe2\x93\xc4{\x16\xaf*Foq\x1a\xec\xa4_\xb1(\x03\xda\xfd!\xb3\xac\x83\x03\xab\xfc\x1b3\xa5\x1cI\xf4`l\xb6_\xbf\x06\x97\xeaH?\xc2!\x065\xdd\xae\xf3M\x9c9\x88Y!\xd3\x00O\x08\xc4\x1e\x9f\xcf\x84\xa0\x82z\x9f\xf4WS\xb5\xe0j\xb7\x14\xfcMeKv\x1f\xed\xcb\x10\xd8\xe1\xe6\xda\xc1\x1f\xf1S\x1f\x12\xdbu\x1c\xb7\xeeT\x9d\x91\xf7\x0c\xbf\x8dD\xcfG\xfcL\x9a\x16N\xb8\xeaZ\xd5\xac\x17\xb5\xd8\xc4\xe7\xabM\x14\x87\xf6\xa8\xff\xe2\xe0]\xd5@\x9f\x14\x7f\xee%\xad\xc6\xa1\xd8\xa8P\x92\xfa*u\xd5W-\xb0r\xc7\xd4j\xd2_JU.\xe1\xafSQ\x09\xc9\x99\xcc\xcb\x93/\xc9\x7f>\x9d'f\xb6t\x9b\xb4\xff\x9f\xe1.\x92\xc8ML\xaf\xb8\xbf\x1ft;\xb6\xb5w\xfd=8$\x04\x8b\xca7\x04\x02b!@\xf9;GL\xad\x80^\"\x1b#\x19\x88\xf6>\xbc\xde\xf2\xdc{9\x9b\xab\xe2\xa7\x1d\xcdS\xb3\xc4g{F\xa8h\xac\x8e\"&\xa3\x98\xf8\x8c*\xc8\xd6\xebo\xcf\xcaU\x99\xab\x9d\xad\x86\xad\x9f\xbe\x9aU@\x9d\x1a\\\x1e\xa0\xf1iJ\xdab)\xab\xc0~\x8a\xf6\xf6]\xe9/\x80\xd1\xea\xe6kX\xe2a\x84\xeb=\x9f\x02\xb5\x8f\xb5!\x12\xce\xc6\x0b\xf4\x94\x00b\xbf\xc1.\x80\xf3\xa5s\xa7\xc3h,\xf5\xe0&\x08\xef\x11?y@\xcdT\x08C)\x9b\xe4\x1aCE\x84-\xef~G\xbc\xc2$A\xb0_\x00\xf23(\xbc\xfd\x09\x94^\x16\xdd\x85\x08\xc2\xb2\x99=S\x80I,\xdf\xbb\xd2u.\x16\xf7\xe4\xb0\xa6\xc8\x10V\xfb\xc1[,\x9e\xdb$9bS\x92\xa9\x14\xa1X\xacf\xe4oU\x0duqrT\x11\xc5\x84U0\x00\x9e\"7\x180\x0b\x96\xb1\xfe\xa5\xd9{;\x8dP\x13\xf9\x02CL\xaf\x8e/\x1a8}3\xc1\x97\x80\xce\xe0\xdb\x0d\x82\x1f\xd4Y\x87\x84\xdb\xf9\x1b\xfcd\xb4\xe6w\x89\xc3\x1f\xb2\x1b@\xd4<\x0do\x94\xfb\x09\xd2d\xcbS\xdb\xde\xbb\xba\x07\x03\xb7\x97\xads\xbf\x01\x12\x8dQ n\xd7\x04\xe1mh\x1f1\x06\xe5\x89Yh\xbf@3\xc6\xdc\x173b\x90a\x05F\x8a\xba5\xc4\xf6\xd3d\x86\xc4\xa0\xd2P\x9d\xfdE\x13K\x09\x17O\xc6\xc2\x95a`f&.C)\xb0M\x9b\xb1\x1d\xcf6B\x01\xec\xbe*\xbb\x01\xe3\xfcQZE\x0b\x82H\x16\xcer\xe5\xf8\x86\xff_c\x90\xd4\xa6\xd1\xa8\xce\xdc\xc4>\xa1%Wv\xa3\xaa\x83$h\x8d\x83d\xe5H>*K|\x9e\x84\xb7\xd34\x83S\xf3\xfcQsz@\xf2T\xfcT\xad\x01\xf2\xa8SB\x89\xd1i\xba\x86\xb8~1\xeey3\xc7\xe2\xf6\x85\x0d&V4\xc1xQ\x80\xa7\xf1\xf5\x9d\xfbK\x88\x8d\xf3?\xe8\x9b\xdc\x11\xff\xceu\xdf\x01\xb3G\xdePp\xf7H?$\xbc\xc0\xd3\x1b\xef\xbc&\x7f\xc7C\xce`2#\xf9\x87,i\xb4eC[\xc0\x15\x9a5\x8e\xfdTiU|\xcc\xd2\xc14|a\xdb;+r\x8dj\xaf\xbbA\xbf\xdfT\xed\xc0\x05O\xcb\x08\x87\x94\xfc_\xf1b\x1f3\xa9\xd0Q\x04\x99\x96\x816G0|\xfa\x0b7\xa8\xcf\x12\xbd\xc7\xf1k\xdc\x1bnc-\x9e\xe5\x90js\x9b#m\x9f\x0ef\x04\xd4\x7fn\xe4lA\xac\xce{\xcb\xbf\x03W \x93\x1c\xfa\xee\x07f]7\xcbN\x8cYZ!\xbbX\xc0\xba\x97\xf4k\xef\xc1\x8d\x92h\xb9\xd4\xa6!\x1a\x0c\xac\x06\xe3\xf8\xc0.\\V\x94_Yz\x1cN\xe8\xea\xed\xa7\xc8\xf3\x8dB\xeaL,|\x83\x94A2I\x19?p\x95\x1aR6\x0e,\xa0\xe5\xb08\x15\x09$\x1a\xe2\x04\xf8\xc2\x8b\x18\xad\x14d*\xab\x19\x0d\x94(o\xc9K9\x9c\xbb\x1d\xdc\xc3~j\xdc\xc25A\x0d\x01\xdd\xdb\xda\x82\x90?U\xbd\xeb\x0a\xd7\xba\x88\xdfc\x83q'\x17\xef\x11\x16\x03\xeeH?\xf0bRW&\xc98\xd4\xad\x0d\xb1\xc0&7\x7f$\x13\x80\x0b\x07\xe1ho\xd7A\xa0\xef\xa5\x8dP\x8f=\xfdL\xe6V\x05\xa2\xe97D\xac\x9df([\xd5" +
+	"e\xdaY\xe1\xd0\x85\x96\xc4\xc3:Sx\x0ao\x96\xb1\x1d\x9d\xab\xabV\x1b\xa0\x0f\x09\x03\xf8\xa8j\xcb\xdbY\x181h\xe0\x18>p\x9b\xd3\xc0\xf6\xd9u\x18\xd2jc\x04}\x9d\xb3\x1e'\xa4\xcc\x064s\xda3t\xfe\xe2\xe2\\\x88\x88Xzc\xb2\xabmf\xeb\x06\x83\xbfXV\xcb\x8fSH\xf8\x84\x01?\"\xe4~\x82\xde-,\x9d\xe7\x93K\xc7\x94\x15z\xd9\xb4\x91\x04\xee\x10\xcbzQ\xf8K\xae\xc4\xe6r8\xaa=\x87\xaen\x12\x0c\x1b\xbb\xe6;\xae\x19\xdf\xcf\xa3\xd2\x13>\x8fD\x1bLo&5\x13W\xf6\x8f\xc3\x95_\xbe\xb6\xc3\xd79\xb7\\\x000\xf3\xdd\xf0r*\x92z\xf3C\x07\xc5'\xe8\xee\xa1)v?\xf4\x18\xfb\x90\xd6\x08\x8c\xad\x05h\x8dt+\x1bP\xbb`\x8b\xb9\xfe\x1e\x97x\xff\xb5\x03)\xb1Z:\xd3\x9e\xbf\xda\x10\x08e\x1d\x9f\\i\xd7\xda=8\x0e\x0c>\x07jx\x96\xf2\xd1,\xb0\x04\xbe-(\x0f]31\xc1G*D\x0d\xd4\xbd/M\xf57Q\x09FB\xe9\xd9\x99\xd8{\x09\x12adx\xbe\x1b\xf4\x84\xfb\xa1\x97\xa6\xa6\x91\x1c\x09\xf0\x12~`\xae\x167\xb4\x85aN\xbb\xbaj\xe1\xe4$\xec\x9eL\xab\xd2\xc59EE\xff'_\x0f\xce\xd4\xb2\x0b\x16\x83\xb6\x82\xf7\xd0\xba\xe2\xdaw\xe0\xae\xb3h\xd3\xef\xc7d\xe7\x1fS\x90\xb8=\x01\x93\xe6HS\xce\xd0\x0e\x8e\x1c\xe6X\xdb9\x83\xaf 1\xee!\x18\xb2\x0b\"D/\xe6\xebb\x7f\xe8a\"\x1ecf\xc7\x8cF\x96\xd4\xd0}\x8c\xac\xa5\x19\xcd\xaf\x0a\x98\xe9\x17\x0d7`\x18\x0bL\x8e\x86\xcb\xdf\xd5>`\xca\xf1\xdf\x9b\x1d\xa2\x17\x09H/{\xb6\x8dM\x94\x8eb7\x0a\xd0\xe4\x80\xc9\x9b\xc9 \x15\xb6\x8et\xf5\xa3\xcf\xcd\xce\xd2|[\x9eNm\"?\xf4j*\x8bU\\W\x84\xbc\xe1\xc9\xb7f\xcd'\x86v\xe3s\x1b\xfbBS\xbc4\x94x\xf1 \xad#)\x98\xadbO3\x8e\x91h\xdd\xd00K\xad7G4\x8d\xf1\x18Y]\x07\xa0d)\xd7\x00\xedW\xd3\x85/\x9eM\xa2\xe7\x13I\x0a\x91kJ\x89^%\x10!:\xc4H)\xeeb\x96j\xfb\x8cy\x9e;\xf8\xfc\xee\xe1.\"jp\x94\x7fp\xc0L\xb8A\xf6#\xf4\x19cP\xea\x8a\xb4N\x13E\xea\xf981\xd5\x92\xdeC\xdd*\xa9\x85\xa9z\xc4\xc2l\x15j\x98\x7f\x93Hv\x91\xee\xea-\xf6ldz\xd3\x82I\xbbh\xa7\x1d\xb7x\x90M\xc9!c\xb8\xb5d\xc5\xa4\xd9f\xbd\x8c\x99V\xa4\xf3\x0b\x9f\x05\xd4\x1d\x9c\xb2\"L\x99\x93th\x90\x03@\x8b\x8e\xcb\xb2F\xf9*y\xa5\x87\x07\xde_\xe8\xd9\x1aMm\x8b\xcd\xfc\xe8\x0f\x0c\xd3\xaf\xab:\xd0!\x0f\xae\x83X\xe8\xdfx\xdbv\xb6dM\xc9Q\\Ga\xe8\x16\x09\xd5^\xbc\xce\xebsf\xeb\xa6y\x88R\xd1c|4\xcfz\x19<\xc2\xdcO\xdd\xa7W\xb1\xfb\xf9R\x05\xe3>\xf5\xbe61.E\xf9 \x15\xa3\xca\xfc\xb8~\xa8:w\xa4\x1f\xfck\x17\xb9\x0eW\xf6\x80\x97\xf1\x18rU)\xca\xcbv.\xde\xd0\xd2>\xd2\x8fh\xb0S\x96\xe6\xe6\xe0\x0aG\xfc\xc4\xff\x97\xce\xb62e\xf2\xc9+\x9bJ\xe7\xdas\x18=<\x01\xc2(q^\xbb\x84\x1b\x92\x85Pe{\x88z\x0a\xad3uD\x0dW}\xd0zB\xfber(qv\x85\x9a7\xc3K\xce}\xa8%\xb2\x91\xad\x9a\xbe@\xe3\xbbM\xf2\xf9e<\x86\xc6]\x93KXZ\xfa\"A Il\x03.\xba:u\x07^x\xec\xb3mJ\xd3\x18\x8b\xe8!\xebT\xf2\xce\x99\xa1`UK\xe6\xe5\xf3DD\x19\xa7w{&\xbe\xbf)7\xdf\xa6\x8c\xcc0&\xb4o\xf3\x0f{1\x1fi2\xf9\x0a\xd9(\x09]B\xff\xb3Y5\xb9n\x82\xb5`\xfe\xd1/\xb3\x1d\x9e\xe3\x096^\xf0\x09\xae\xae.N\x8e\x8e\x9b\xa8\xb7\xd6\x9c|\xeb9\x95\x1dLt%\xf4t\x93\x02\x9f\x1c\xe4\xc5\xe6S\x11\x18\\\xd1]\xe31q\xad[K\x12\xbd|5_\x1a\x97\x94\x98\x09\xea\xe6B\xc6\x90\xc0U\xecZ\xe0n\xac\xab\x93\x14\xf5$j\xa2h\xa9~qJ*\xcawXJ\xfd\xbc|=\x8d\xf5\x8c\x80\x8c\x81\x982\x10\x1d0\xb8[\x98A\xf7\x98\xa1\xbb\xc0\xb0?\xcceB\xcc7\xc3I0_\x92\xf5\xfd\x1c\x05r{v\x1dH\x11e\x0a\xcb\xe4TS\x07\x17\x10\xee\x97\x93C\x90\xf3\x91\x7f\xf2m\xde\x16\x9f\xa3\x03\xb6\x93\x86\xd1\xd7Y\x7f\x9d\xa2\xc1\xc7\xbc\xc2\xf8\x18K\x8dY\xd2S\xb2\xbd_`\x80A\xf3\xdf+y\x93W\x8f\xc7\xca\x8d\x9cz\xa2Q\x9fKb\x1bU\xf1\x16j\xeef\xa8\x89e7\x12\xb4\xda\xc0\xa0\xbf\xc2\xb1\xa1B_\xccf`\xc4(|\x1bC'\xf3M|\x12\xcf\xb9\xb4J\x05[\xc5\xed\x18\xd7\xfaO\\\xf3\xdd\xbb\xae\xbf?L#ZPkh\x0du\xf8*K3\xf1\xf2\xd59\xc91\x94b\x97\xa5\x00\xf7\xc2\xb2\xd4\x80k\x8f\xe1C\xf44\xe2t\xaa\x1a\xef\x8c5\x19\xc3\xb5\x1b9\xaa^=\x9b\xa0\xd1\xa4N u%\xc9\xa8\xec\xb32\xe1\xd9k\x94\x9frD\x9d9%\xa1\x1f\xcb\xe3\xe6\x9b\x98\xe4\x95&\x873\xce\xab9\x16\x877\x93\xb5\xcd\xb2\xc90-\xe6\x1b12D\x09\xf6(0\xe2\x05\x87xD\xed\xa6_\xe4\x83>\x8a\xd4\xce\xbfb\x96p\xca\xe9\xd1\x93)7\x91\xe0\x9a*\xfe\xe8\xef\xe1'\xf8\x0e\x867f\xb3w\xc5\xce\xd6\x98L^Od<\xfb\"\xd7\xf7\xab\xbd\x8b\xc4\xc4;Ba\xeeS|\xc6=\xd4\xae]\xa9o~\xf5r\xb9\xb0\x93\xa0\xb3\xda\x99\x9b\x0b;\xc5C\xcb\x0a9\xf3\xd3\xcf\xf0:\x8b\xd3+\x00\xe3\xda\xda\xd8DB\x13\xc3\x08B\xa6(\xa5\x17\x14%z;\x1b\xda|\xf5\xca\x866w\x91\xff\xb9\x1a\xe0\xe4\xdd\xbe\x08\xb5ta:\xf3\xa6'\xed\xfb\x93J\x18\x1f&C\xbe\x09\xa5\xb9\x04\xdb\x121\x8a\x95\x83\xfb\xd5\xebQ\xce\x1b_\x12\xb7\xe0\x0b8m\xd1~dT\x8d%b>\xe3\x86\xb5\x0bs\x11\xa1\xb9dJh\x19\x0a\x95\xded%\xb5J\x02\xdcB\xefN\x09\xa2\xf2\xe4;\xcb64\xcf\x1a\xf0,a\x0d\xf8o?\xff/?\xff\xf3/\xff\xc3\x16\xd6\x80\xe67`\x0d\x90x\xcba\x955`\xd2\x80`\xfc\xaa\x92\x93r\x92\x12{2rW\xd6pqc\x1cn\xb8\x87UP\xc9\x8b5\xd6Y\x05PD\x9e\x1eF\x807y\x1b\xf1\x9f\x98T\x91c\x13\xad\xbfp\xe1\xffa\xb5\xf0\x7fwO\x1e\xae\xbd\x83E\xe5\x0a\x15A\x86\x91\xf8h\xb0\xc5\x02d\xa7\xc4\xba\xc1\x0b\x1cVk\xfd%\x94jI\x8d\xd8@\x1es\xa2\xa4\xe7R\xd4\xa5\x9b:\x97\x0e\xabU\xff\xe9A\x84Y\xb5\x0e&)\x9bW\xce\x9f\x83\xd9\x07-\xdaZ\x86\x9b\xf6\xd7u\"\x80\xa8w\xc4\xa7\xb48c\x17\xa8\xcda\x1d\xc3\xfd\x18`\xd3\xce\x8fe\xd8\xe6\xb5\xa8\"\xa3\xd8}\xe0\xe3!\xaa\x80\x89-\x90\x96\xa0l\xa4N\xc8\x91\xfa1\x11\x12\x8d\x1e+\xc1\x85\xe1\xe5\xa5\xa2\xc5,=\x8c\xc3NE\x88\x9d\x93B\x0f-L\xe7\x10-\x9c\xfc`b\xbf\x87\xf5\xbaw\x1e\x10\x96\x87f`\x9dF\xb4\xb7\xd2.\xc6\xaa\xf3]\xf4\xf9\x02\x85}~\x1a\xa0gw(\xaeU{\x8f\xde\xc7U\x14\x89k\\<\x82\x84+>O\xd7?o;\x19b\xa5\xf9\xfb\xd9#QI[\xe3\x9es\xd0b\xeeD\xa3.\xc7bl\xa8}\xd8P\xe4\xad\xd5\xed#\xee5)\x8fR\xe1\x9c4\xd0ntL\xa5-)\x0b-\xa7\x17w&\x9d\x1dV\xc7\x9e\xf6\x84D\xdd\x15\xbfxvW\xd8\\>\x9e\x8c\xcb\x0esD\xfb\x04[\xa7#\xab\x11bG5n\xb5\x14jN0\x09%\xb5\xed;fN\xdbP\x02\xbe\x132\x9f=\xad\x8a\x1d-\x0b\xc3\xe2|\x81v\x80&\x0b\x17\xd3/eg'\xed\x82\xf9\xad\xfc\x8f<\xbcL=\xbb\xa1\x12<\xb9\x95\xa0\xc0\x12\xc7\x1d\xef\xef\xef(\x9f\x9e\xa0\xa8\x1e8\xf5\xfc\xd5-\xacr\xddG%]\xcfsi\x1f\xb36\xc4\x99v5\xb5S\x13U\xce;\xcc\x1e\xfe\x80\xd9C\x93\x81AJ\xbc\x12\xea\x11y\xdf\x08\xcc%\x12l\x912\x03M[\xa5\xcbY\xaf\xdc\x9e\xd0\xe2\x0c6\xec\xd7\xe6\xf4\x95\xb5\xc7\\\xe72\xd5\x93\x89f\xadd\xa5(\x87\x1a7\xd0\x8d\xe5\xd1}\xfe\x18\xc3\xe0h\xb1K6?\x14H\x82h\xe7\xab0\xbc\xcb\x01\xb0\xe0\xf4\x05$\xcdz9r)\xec\x85\x06\x09\x93\x86\xe0k\xce?\x05?\x9d\x079\xfcn\xd70\x87la\x86u-Lf4\x9cd\xe7\x12P_\xf95\xe5\x02\x9f\xab*TbD76\xfc%\xbe\xba#U9i\xed_\xf2j\xea\xfd\xe6\xd2\xe1\x9d\xd2\xe0\xfd\xe4\xba\x06\xda}\xc4\"\xd0v\x8b\xb9\xb7\xe8\xddf\xe9\xc9$\xc9\xc2:\xa5xz\x87\x8d\xe60\xb7\x87\x95\xae\xae\xee\xa0;\xac\x97\xe3RPL\xc7\x05%\x09(\x0b\x80\xa1\xcc\xaa;U\xe3\x19\x9cb\x14\xd0\x85\xef\xc4\x0e\xa3\xc7[\x1c\xcb\xe6:\xd6w\x046\xbb\x18~)\xda\x1f\x09\xb0?@\x01\xaeK\\w\xf6\x8f\xb6K\xdc\xd4\xd5\xc9\xb7G\xfcd\xd4\x1aq\xfe\x1c\xe9\x07\xdd\xdc\xb5%\\\x8e\xf8\xb9\xa1\x90\x94n+\xc51\xc7\xf8\xcf\xbc\xf2\x083\xcd\x8b\xdc+\xb1\x1e\xd4\x14\x9b\x1bt\xa4&>\x98\x01fH\xb0q\xa6\x96l\xbd\x8e\x13g_nQk\xe44\xfc\xbe\x9f\x88\xff#\xb9\x01'\x0d\xf00\",\xf3ec\x91e2[\xcd\x09\xc4\xeen\x0d\xc9VPD\xd5\x02#\xd4\xe7\xdaEE\xa3\xa7f_\x0a'1a\xe0\xf9d\xcb\xa6q\x19M\x18c\x87\xf4\xb4\x97\x1fr\xe2\x145c\xd2R\xcd\x84Am\xa3\x96\xc8\\?\xdc\xdc\xd5]2#\xc1\x06\xcb04\xbdg\xe2)h\xcf=\x1c\xe9\xc7\xc6\xf2\xbf\x04l\xddLr\xef\xaf\xe9\x9cH\x1b2q\x88\x98\x15\xd2\xf4!\xcd\xf7-\x87\xd8\xd3\xe9\xe4\x97\xd4E\xe0\xdcf\xda\xb4\xfd\x08h\xc3\xa6\xc4a\xbdX\x8e\xf6\xa1Hi^\xa3\x0f\x16\xb5\x12\xf5\xff\xaa\xfc\x18|C!4ws\xc5:\xa5\x0bcP\xbbaqZ\xea.\xd4\x10\x8b8K\x952\xfe\x03-\xce\xfd\x98\xa2!\x9e\xabM\xd5\x84\x8e\x9cH\x08\xb6\xd0\xad\xd5\x1f\xbd\x9cN\x9e$=\xeb\xda\xfd\x08\x16\x07\x03\xb0\xfa\xabo\x8a\x1f\xfd\xe9\x04\xdfMk\xab\xa6\xc6\xe5\xa3|\xa2\xe5\xac\x04\xf5*\x02s.\xf1\xb9Q\"#\xcd\x03-\x8a>%\x11\x84,|PDsU\x9d\xe7\x0dU^3O\xd7\xbb\x8eP\x81\x17\xf8\xf3a\"i\x97\xa3G\xd6\xe2\xfa\xcf\xbe\x9f\\G\xec\xa8\xdf\xc4\x06N\x8a\xecL\xf83\x11\x13\xd7\xac\x87\xd5[\x13$\xeaa\xbd~\x0aCW&\x8f\"\xdbh\x0d\x9d;M\x86\xd7\xd3b7\xba\x90\x1c\x17\xe1\xd59\xd8\xe0xR.\xa7X\xf7n`\xe8\xd1a\xbd\x82*\x81\xc3X\x95\x1b\x09y!\xceaFe\xe3\x0a\xcb,\x85\x7f\xac\xda{\x08\xf7\xdcP+\x95\xdc\xfb\xf6\xf5\xf2\xb5-\xfe\x02\x7f\xfe\xb3\xc7\x88\xe2a\xbd\x9a\xa9\x81\x1a\xda\xb4\xe6.\xea'-\xae\x9aXa\xb4\xfb\x01\xb9U(\xe4W\x7f\x1d\xc2\xbd\x8a\xc6\xe1]\xb4\xa0\xc0\x95\xee\xa2\x9b}\xe8m?\x1c\xd6K~\xa4\x16S\xee\xd0\xde\x1bC\x8ed[\xa1\x85~C\x15\xcd\x1f\xb1\x98\x8c\xaa(\x0c1__u\xd5\x10\x7f\xcb\x8a[G\xfa\xc1)\xf8\x0d\xa50\xeaP\x0c>n\xceRd\x16f%\x09\xb1H<\x01\x03\x1b\xfb\x87\xbd\x89n\x11\xff\x04\xbd\xa6y\xc76|R<if\x16\xba\xd9\x1b\x0a9\x92\x09e\xfc\x9c\x18FcL\x0c\xa7\xab\xf9\x84\xd1p\xe0J\xf5\xd0\xf3\xc7\x93\x0f\x9a\x85\x95\xa5\x92\x0f\xd6\xd2\x86fa\xa5>\x7f\x92\x07\xc2'\xa5n\xc598\x82\xeb\x8e\xf2\x8f7\xebE\x0f\x11\xa5\x0f5\xb8\xa2\xf7C%N\xcc\x05\xae\xbeg\xc2\xc6\xf8\xdb\xa1:\xc1\xf5P\xec\x90\xdaqO\xd1\xdf\x12nG\xfc\\z\x8fg\xcbV\xfd\xb4\x1dvr%\x82\x057\x14\x1f|0\xe6W|+\x12K\xa7\x1cD\x03_\xa0I\xa0K|\xf2@#3Y.\xb5\x00\xbe-\x85\x06S\xdb\xb1\xc8$4@\x82r\xda:-\x18\x93?\xf8\xd6q\xf9\x97*\x0fJ\xb8\xdfV\xb1\x1b|\xd3a\xbd\x0aA\xb71\x96(NS\x17\xf3\x8a\xc6\xd5\xa9\xeaT\xc5{\x83\xae\xf1\xf3\x05\xc8N\xe3K\x02\xca\xa4$\xd3lO\xc0\x05z\xc3\x16;.\xbc\xe7\xe2\x8c\x0d(\xff4*\xfff6\xc7\xa9\xc0V\x92\xec\xd8\x90\x13|\x9e\xe4\x04\xff\xe7\x9f\xff\xf9\xe7\xff\xfb/\xff\xe5\xe7\xffi+\x97\xf8jR\x90\xf3\x81\x01\x1beih\x8c\xa0\xc9\xd2\x01\xbbN\xb7?s\xbeB9v|S\x80\xd5\xa2\x92aZ\xce0\x07>\xdd\xc6F\x8em\xb9Y\x13Q@\xa2\xf8\x17a\xb9=\xca?\x12ER\xddb\xab\x13\x07\xdaM\xad\xc3\xfea\xafa)\xc1)'\xb6\x9e\x9d,\xe3\x0btXn\xc1\x84\x91\x0dm\x9c\x0eX\x7f\x93$c\xd9\xbc\x1a\"U\xb9\xa6\\x0X\xaaAreV\x07\x17\\\xc7\xb7A\xef\xcf7Qm\x8cC*o\x0a\xdb\x9c\xda\xf5\xd8%\xe1\xc6\xed\xd6\x9e\xd0\x0an\xedYXR$z\x91i\x9aG\x98\xb1\x91\x0aPzF\xf8\xc2%\xb9\xa3\x90o,\xfd\xe1\xba\xc2QE\x9b\xe4\x8d\xae\xc6\x12\xb7Y(\xed\x0b\xcc\x834{Sb\x10k%\x8e\xe6\x9fKj\xb3/\xa35\xa4\x98\xfc\x14\x8e?8\xb8\x1d\xe75<I\xf6\x1b\xdb\x9a\x1a\xa1\x87\xd5,k\xe9\x12\x86\x88\xacCK\xc7\xbdh\x0a\x94\xd4\x04\x0c_\xa5\x90\xe5D4\xbe\xaf\xc2\xd7\x8e\xec\xadC\x0e\x10\x8c\xe5\xe1\xc4\x84{\xb1T\xb8bV\xcaL\xdc\x98\x88|\x9d\xf1\xd4\xb2\xe2/\xa4\x92\x9e\x1dyF\x94\x8e\x12$T\x8aW\x17H\x9f\xfe\x92A\x06B\xb3!\x94\xf6BQj\xb1\x0c\xaa\xf9\xaf\x8d6\x11\xb4\x0d\x09\xdf\x0f\xae`\xdbF\xa6]\x06168~\x99\x90g\xdfB\xe63\x8a\xfb\x96WB\xf3\x05*R\x9eW\x05\xc8\xddr\x88`^\xeb5Fw\xed8H\xcf\x11\xc4\x91\xb6\xd7\xb4\x86\xf8\xf2a\x1fS\xd5\x81\xdd\xcd\xa0X\x94\x1b\x93\xeb\xac\x97\xc4\xcbG%\x09*\xcc9\xd1$,\xf2`g\xc3\x8a\x9coM\x81G)\x11\x19{e\x10\xbf\x82\x1f\x0c\xb1\xba\xd2\x92\x1b\xe1b\x10\x050\xb8\x87\xae\x98W\xcd\xc9i\xcd5\xad\xfd\xc5\xa8\xba\x17z)\xdaEG\xfc\x94\xf3NE~7$\xd2Ee{L\x8a.\xefb\xca\x15&EX\x04P\xa9\xb1\x8cb'\x13\x89\xdc\xfe\xfd\x12\xa9\xf8\x8c\xfa\xf1\xaf\xb0\x1f\xc6\xc7\xcdO\xaev\x0b}\xf0l\x0c\x11N\xa8o\xa6J`\xa8^\xf2\x9a\xc8\xcd\xb2\xde\xe8\xe8;T\xbf3@\xed\x07\x8c\xdb\x1c/\xd1\x10\xe3\x80]\xc2w\x09W\xe85\x0c\x8c/2\xa6\xe4\x9c\\\xe8\x1b\xd2\xfb#z/\xdc6\xe9\xeb\x054p-z\xb8V\x17\xde\x1c\x8d\x0d/\xa4%\x1d\x8bU\x1ai\xf3\xd3\xd2=U\xb0\xd5\xde\x92\xde\x94\x94\xd5G\xb7\xd4\xa3'%\x85x\x18L\xf5\xf1\x8b-i\x81\xccA\xb4)\x92\xa8T\xf3#\xf8\x0e\x91\xf6q\xa6\x91\x05@\xa5\xbb\xb1rw\xda\xdd$e\xde\xa3\x15\xe8UUf\xfc\x1a\x86\x85\xeb\x8c\x141IB\x14;\xba!\xf4\xb0\x08X}\xfcr\x14\xaa\x9dn\xd3\xa4\xf5bKJ\xe5\xc8d\x80\x1c\x93\xff\xb2\x1d\x958\xa6\xa6H3F_\xc5n\x9aD9\xd8\xbc\x0bO\xff\xba\x0a\xdb_\xed\xac\xa0u^\xd1\x98\xed\x17\xca{\xc7\xab\x86\xf5\x94\xd3\xef\x8cd5'VR\xaagc^Gh\x0d\xd6\xc1\x17\x1a\xdfJ*\xa6\xa7\x18\x95hM\xf7\xf7\xd09\x18\x88\xe3p\x10\xda\xd5\xce\xa5\xdd\x8dU\x03\xb2j\xa4\x1aY\xbe*e\xca\x19b\x02\xaf+\xb0\x80\xa5\x85\x9fz?\xb5\x19m\x8cyGd\xc6T\xeeh.e\x135@\xa1V\xdc\x97\xb7\xb6\x8aUL\xb7\xa6\xc3\xe7\x8d*\xa79\x09\xa8Th\xdeDxt\xa6H\x93\xf6*|`\xb4,\x04\xc3\xf1\xf0\xc2\xcd\x88\x0fI\xb8_\xf5\xce\xe5\xd7F\xed!\xc2\xdc\x1d,A\xb6\x12\x92f\xa7\xd5*r\xd2\xb0\xd5S\xcaiR;\xda\xc3\x10w^\x89R$\x94\x84\x09]\xda\x88\xca\x82\xe2\xca\xb3\xa7_\x09m\xb2\xc9\xdb\x83\x83\xcd+hL\xd2GY-\xc1$\x03\xa5B\xe8\x1dv\x83\xef\xa8\xc4s\xb4.\xa9\xd1\x8b\x1c\xcaI\x0aX\xf1\xeb\xf3\xc9)\xae)\xf6w~\xcc\xe0\x08\xe7\xf0\x0f\x87\x87\xb0u\xc9R\xf4\x911z\xe4\xf0mEPr\x1c\xb7+]\xe7\xcf\x8b\xc7\xd5\x93\xb4\xee\xe6Vu-\\\xea\x88\x832Z\xcf\x09\x1ff\xb8{\\\xed\xad\x11r\x19" +
+	"\xac0\xbd\x86\x00\x19\x90\xc2E\x86%\xb0n\x87\xbb6\xfe\xf8\x87\xe4b\xc3\x1c\x16*\xda\xc2\x98\x85%F\x8e\x1d\x0f%\x93\x05\xf2[_\xab\x01j\xe2:\x1bp\xb4I\xf0\xa7j\xcf\xd8z\"\xa8a2\xbc\xa46\xc223\xa2)(\xcbD\x8e\x11\xc6\xa8\xa5\xed\xc7\xca\xa3=\xfb\x97\x0d\xf3\xec$\x9cA\"\x18\xa3A\xbf\xb7\xeb\xa8\x9dy\x86\x93\x8f:\x8b#\xc7sN\xe4\xaa\xf3\\\xf2\xdcDBR\xbb\x0182\xcb\xfa\x0fL\xe3m\xbc\xbc\x12\xb2\xce\xd0\xd4.^\xcf\xaeV\x03=HIH\xb9U\xafy\\\x13\xf4 \xf9\x8c\xf0\x0e\x1b0F\xa9?\x9eF\x14\xe4YY\xc58\xbe0\"j\x0f\x89 z;\xa0\x0f\x93*HKU\xcb\x99\x8ctE\xcb\xda\x84\x7f[\xb5U]4_\xa3\xfc\xe54J\xfd\xa1y\xfe'/\xc6.BR\xa6\x938\x83\x11\x95\xce\x95\xd1\x0a\x04\x16hz\x8d\x0a\x82}E\x8b\x8c\xb7\xe7\xb3PN\xd3\xd7F\xa6\x8b9\xbe\xc3\x9f\x08d\xce\xb19\x0cr\xd9\xe2)\x9b\xec\xa6\xe3g\xfb\xc9\xff$1#\xb5^\xcc\xca\x10\xcf\x16\xaf\xd1Y\x1e\xdb>\x84\xdd\xb1tt\x0b\x86s\x04_\x00[\x16vE<-\x13\xfa\x85I\xd6\xf6\xe8N\x9c`@\xf2\xb4\xad\xdc\xff\xc9\xcd\x99\x0e\x95\xb6\xd3\xb8\xb9\xfb\x86]r?\xf8\xe1Q\xf1c\xfa\x07,\xc0?\x9f\xdd\xd5\xf0\xd2\x85\x1b\xec\x8b\x93\xaf}\x81\xf5\xa8|\x08b\xc4\xeb\x8f\xc9\xeb\xb0\xcfb\x11\xc6\xb3\xfe\xdc\xb6J\xfa'\xafW!\x90\x8c\xee'\xa9\x99\x1d\xb7poV]p\xf0\x07\xdf\xd5)\x97\x84\xa22Z\xe8\xa6\x00\xd3z(\xdc\xfb\xce_b1\xee\xd2k\xacAh\xa2\x1dU\"\x0a\xeeW\x18\x1dd#\xff\xe8\xcd\xa6\x11\xd6ZO\xf1\x0b\xf5y8\xc5I\x86\x17\x1ew;d \xd8?*8\xd4<\x91\xa8Z\x01X%\xdcE0\xf3\x06\x14\xa2\xda\xe5p0.RKq\xab|0\x12\xea'\x09i\x05\x98\xaa`)\x92\xdd5Lh\x93l\x1eEi\x950\x02\xed\xe4$2\x82\x86b\x88!oV\x17H\xb7y~\x05y\xb7\xc6\xef'\x81\xc1\x88y\xe1\x9d\xc3\xc4\x14\xa2\xd5\x85\xab%\xb4\x87v\x08\xc3\x95>\xb5\xd5\xe5J\x98\xf2\xe29y\x80\x1c\x86a\xbb\x93\x8e\xb5;*\x1e\x833\x08\xb4\x95\x031\xea\x1cL\x9d\xbd\xa6\xbatm\xfa'\x018\x9cl\x9a\x0f\x88*\x1d\xc18\x86\xf6\xa8\xff\x9a\xd1,\x90\x95\x91\xd0Jp\xe1\x0f\xc6\xab-q\xf1_\xa3\xe3\x9aR\xf9\x8f\xa6\x81@\xb9e(\xfe\x98P\xd4p\xea\xe6\xeao13\xc7V+\x89\xe7X\xdem\xb9\x0ae\xe0\x1a\x84j\xd2&E\x05!\xa8\x1b\xe9\xb8\xae\x02\xd1\x0f\x1c\x1b\x0a\x7f\x80\xab\x82T,\x7f\xf9\x94\xa9\xc4\xf1|\xf1!\xd7\x06\xedy\xa2\x7fj\x03\x96\x87I\x08^\x9c\xa0d\xb2\xe7\x19\x18\x95\x94\x93\xfe\x17_)vE\xe9\x90\xfb\x01\x9b9\x92\x09 1\xa2\xa4\x97\x13\xeb\xceJ\x8d\xe2\xd41\xbd\x92\x08\x94'\xc2\x85U\x8f]\xaa\x04^\xe8\x13\xfb\x13\x0c\x1bd\x04>q\xbd\xb23\xba\xdb\x8c\x83\xb8\xf9;e/\x18U\xe9[j\x83\xbej\xaa~\x80\x02\xae\xd0l\x80`J\xfe!\x16\xb5W\xc0l\xd0i\xc4\x9a\x9e\xb1\x01j6\xce\x84\xcf\xe7\xf4_$9\xfd\xff\xdb\xcf\xff\xf2\xcb\x7f\xfc\xf9\x9f\x7fS}\xf0\x98\xe2\xa5\xc0$M\xfc\xdf:\xa5\xcf\xdb&\xad\xe2\xbf\x02\xe09\x82b\xee\xc8\xdf\xde\xe7\x0e7\x1d\xca\x09Dr`\xd6\xec\xc5\x8cO\xa6Ka<r)\xf8\xdbN-\xf1l\xde\xbc\xffQ\x94\xd7\x93\x0a*M)NHd%i\xb17\x13\xac\xcf\xc7\x8fz\x1a\x8dc\xf9\xda\xe3\xf8V\xd8\xd7a\xa0\xc1H\x136\x96t\xc7\xd5!\x90\xc8}\xcc\x19\x10\xea\xd2]\xd4\x9b\xdc\xf3\xf8\xbd-\xde\x83\xe5\xcc\x0b-\xa1\xc9\x94\x80\x8d\xad@\x93<\xfbFB>p\x95\xa6%\xf0y;F\x84\x80\xdb\x1f\x1e\xe6{\xad\xa2\">\x10\xf6\xe1\x96\xd3\x8cb\x1c J\xce\xa5~\xc3\xbd\x8cH\x9e\xc8\xb4cd\xb34VR\xa8\xaeZ\xe2n\xda\x85BW\x8c9\x10\xa7IN\xa9\x11\xc9f\x81\x97\xe4\x8e\xee\xc0\xe2p\xfb\xa2\xfc*sp]\x0a\xdeZX\xe2\xbb\x95N\x05\x89\xacG#Xc1\x84\xc6\xef\xe9\x06=x\x98\xc3.)=\x89\xfay\xb7\xaa\xf1\xc1\xa1;\xca?\xd6\xd6\xcc\xcb\xbf\xd6\xf1\xb7\xfc\x04\x12lYS\xedJh\x99\x06Wg,K\x93\x09\x87Y\xed\xb0h\xdf\xaf\x11QLS4M\x04#\x1cXr\xa0\x18C\x0bk\x0dW/\x86\xae\xe2\xfaR\xfa\x89\xd1\xbe\x13\xa4WY\x881\xfb:\x1f\xf7\x1c\xea\xa2\xf7TN\x19\x0f}\x82\x0c\xa0y\x88\xf1\x94\xd0r\xaa\x90\x8c\xe5;j\x92\x13\x01\x17\xad(\x83\xa4\x80\x1b\x1c\xd3=\x0e+;k*\x00\x96]\xd0\"\xf1\x1e\x15\xff\xc6\xd7\xfev\x8d\xb2\xcd8\xf7P\xc16\x95\x93\xa1\xff0,k\xe24n\xcf\x10^k\x0a\xfa\xbd2^\xaf\x17\xa25\xb5'\x0a\xf3\xf04Sg\x8b\x9d\xd4\xd8\xac\x93\x95\x80(\xb9\xeb\xf2\x10\x8a9\x02\xc9\xdb\xba\xb1K%>5\xbf*}\x8f\xf0\x81\xc6\xdf~Dj\x7f\xd3\xfe\x06\xc9s\xdas-\x93\xf0\x90n\x9c\xb9\xc5\xbcN\xeah\xf9m\x96\xfb\xdfx<\x1eR\xa6\x9a\x1b\xa3/\x83\x07\xea\xeaL\x12\x84Wl\xe4\xdd\xc0)y\xb0\xb2\xeb>\xd3\xedK$\x0aib\xe1\xd7m\xfaVy\x80v\xbaA\xec\xe5\x90\xeb\xfcM\xf1\x16\xca\xa3\x1a\xf4}\x07:9\xf7\xb1\x85\x0c\x9d\x03\x09B\x1c\x84\x7ft\xc6\xd7\xce\x82&By\xc4\x8bt^\x82g\x94\xeaZ\x1b\x92$\xee`o\xa1\xc6\x91\xd5\x05\x9d\x8b\xa9D_\xf9\x0a\xdeh\xd8\x95S8\x1cS\x96v\x02W\x1b]:\xf55\xe8=[\xb2\x00\x98\xf2a\xc7\xcf\xda[\xa6\xf8\x0f1\xf7\x9b\xd7\xe2L\x0a\x0d\xa3Eir\xcf\x02\x8a\x9a\xd1\x86$\xa8\x1c\xa7\xfe\x9aU\xf2\x83\xac\x840Us\xe6\xa2]N\x1brH\x0d+\xa3#\xe3\xfb(\xadGo\xe8&\xf1Lu4\xb8pN\xb4\x9a\xd2 \xbb\x8d0\xcc\xc5\xbb\x0eN\xca\x9c<Z\xc2\x8b\x93\x87\x0f\xf7fB\x7f~aB\xe0\xc6\x15Fv\x03Zh9Y;>\x18#5\x08\xbb\xe5\x09\xb9UN\x95AxC\xd7\x0f\xee\xcf\x80\x92\x7f\xa0\x13d\x96\x09w\x82R\x93\xe6#\xef\xba\x08\xa0D\xfbz\x80\x0dP\xa0O\x8a\xef\x16^\xb5\x8bo\xddQ\xfe!\xdc\xb3\x1ay\xc9\x98X\xdf\xe8\xe4\x90\x90/&\xc3\xfej\xf8\x8e\xe8\xc8\xb8v SD,\x1d\xb6\x13\x19/fGS.)=\x8fT\xe1N^\x95\x82\x0f\xe1\x7fLt|\x06L\x86[4\x9a\xef\xc3\xdb\xc3}\xd8\x919\xcb\x07\x17\xa7\xf3ut\x95`\xe0\x8bO\xc9\xed\x94\x0b\xb0\xbd\x87\x1b\xd7)q\x89\xbb;C\x08>\xf8Kq\xf2W\xda`3\xc1\xbbRQie\xf5\x0dz\x1ab\xdf\xf8\xce\xdc\x99b?t\x9fp2\xb7\x85\x13\xe6\x82)\xba2Z\xafN\xf8\x12d\x95\xb5\x84\x96sRa\xfbo\xdbs\x0d\xde\x8e\x18\xff&E\xd5\xd4\xde\xaa\xa5i\xef\xf2\xafyH\xe8*\x86\xfe\xe1\xe0D\x1e]\x0ab\xe3?\xf9\x01\x9d+/\xe0\xde\xe0\x8380\x0d\xd9\x13U\x80\x9d\x979_!\xdbl\x01-\xd4\xc5\x17\xff\xc5\xd7~\x82&Cc9r9?\xb8\x9c\"\xbb\x18\xaa\x0e\xa3\xe9+L\xcek\xdb\xec\x8b\xe9\xc55\xba)\xa7\x1a\xc2\xa9\x91\x10\xbb\xc5\x0e\xc3y=\xbf\x90\"\xb6\x0a-H\x0ce\x85\xd9F$\x02\xf4/e\xe1ce\x95\xf95.+\x99\x82\xd8&z1\xa9=\xb41\xe2\x90B\xb5\xd2\x1e\xb5'\xf7\xf4\xaa\x959\xfdo\\/\x00\x92\xc6k\xec\xe5\x90(\xe8\x98\x88L3\x8e\x8c\xd4\x9e\xf1 \xe1_\xe3\xaak5\xc3xF\xf0\xeb\"z\x15\xd1R\xac\xd3\x8by2>9k\xffV\xc8\xe7C\xebm\xbb\xd9\x1dm\xb0\x12\xd3\xd4\x94\xa6\x14\xfe\xa4B4q\x8a\xfc\x9b\xea*\x9e\xcfAL\x98+\\\xfe\xae\x98M6\xd8D\x83\xf8Z\xff`l\xba\xdc~\x982\xa4\xcb\xaf\xed\x99\x16\xdb8\xcaQ\x9c\xb0Ik3\xff\xd5\xc6\x99O\xbd{\x83~p\x88\xd1\x1b\xe0\x06\xad\xf8jF&P'm\xe8\xf7cT\x10NG'#&\"\xd5R'u}\xcc\x03\xf6\xf9\x01\xa5\xe2M\x152\xb6\x1b\x10c\x11\x0a\x0e\xbdk\xcfG\xfa\xa1\x0d!Qgf\x18\x1cVN\xc5\x88\xca\x89\xc8\x9e\xe0a\xe6%\xd4;>i\x83F/~\x17c\x19\xfc/\x83\x99OHNp\x89\xad\xa3s\x12\xae\x8d\x9d\x9b\xe1\xa4\x1a\x9b\xe1\xa5#/\x10g\xb8\x04\xddW\xf3\x80\x1fS\xe1\xd1\x0b\x9c\x88\x9b\x84 \xa4\x8dO@\xa4\x13\"\xdc\xdf\x1cjh\xc2\x9d\xc8x\xc6\xfb\xe1~\xdd\x86f\xd2\x8fh^\xbf\x99J\"H\xee\x9a\xc0P\xa3J\x0dS\xf40-\x9a:\xcaDZ\xd1\x98\x01\xda[xV]\x94\x93\xdfG\x85\xa85`F\xe2\x07\xc9\xc0\x14\xbb\xdf\xf9r_\xf4\xe0K\"\xe6\xf6M\xf1\xdd;(\x19\x9bF\xe3\xc5E#s\x96\xb5\xe8`\x95a+)\xde]\xfe\x0c\xf0\xd3\xf8t\xa4\x96*w`\xdc\x10g}\xef(\xce\xa7w+\xe1b\x15\xb7\xe2\xbe\xa73\xe2\xf7c^\xacq\xa8\xf90>\xb8\xc5NN\x08\x8cP\x09\x90F1\xb2\x08`\x89\xc6f\x86\xa8O3\x8c\xb5\x89n\xedH\x87X\x9d\x19\xa5\xaa\xc5_K\xc0\x94\xdfi?\xd2\x8cb\xd0XB\xeda\xef\x1b'\xa4\x81\x1f\xe4b\xac\x19\x1ah\xc9\xa4\xd9\x80\x07RG\xe8\x87e\x9d\x9c$Hn\xd0W{:^\x98VU\xc3\x1e\x1a\xd0\xd9\xe1\x9f\xf78\xd2h\xe1&\xd9~\x13u\x0a\xcb\x13\x19HSz+\x9a\x9b\x88\x04\x0a\xd7\x10\xde\xc5\x86\xe2\x0d\x0a\xe0k\xf9\xf5\xb2\xf6\xbe\xcf\x96\xe5\xd8\xe8\xfe*qp\xa2\x10KhGD\x9b\xdc\x85\x85W\\q\x8c\x8f\xf4\xc3\xda`\x12\xfd\x1d\xc5l\xc3/\xe3\x9d\x04\xb1*\xa90*^4c\xccoR\xecP\x04\xf0\xefB9\xc8\xa5\x8er\x17q\xae\xd0J\xa2Zn2\xd1%\x14%\x958\xe7\xaa\x83B*\xe4\xa9\xe21\xba\xb7\x84\x1b\xce\xaa\xd6\x0e\xeb0\xb9\xb8qB\xfb\x85\x008\x9c\x17\x95\x8a\x8fX\xa6\x88^\x9eV2q\xdc7\x84S\x93\xa0K\x02\x1f\xac+\x06[R\xd8T\xca\xc2w\xa7\xaa\x86\xfe\x88\x9f{I\x95\x8b\x9c\x82\xe0\xeb?\xc4\"\xfaX_iaK#J\xac5\x16\x8c'\xf3U\x18)\x9e\xa8:\xfb\x8b0\xb9\x94\xde\xc4\xd8K\xa6d\xbb\x1e/b\xfcs]C\xb0l\x08';y\x83\xc6\xc7\xef*Z~\x0bM\x93\x01\xb9\x1e,zU\x11\xafode\xad^i\xab\xc4\xb5Z\x02+\xef\xa44\xf1\xce#b\xc8\xd81\x87,.\x16u\x0f4\x072\xaa\xdf\xccU#R\xd0\x87\xe1\xf9J\xc9)\"T\xea\x01\xd0\x8f'/\xb7\x0d\xa9\x01\xb0\xb6a%\xb9#\xfd@\xce\xfa\xaav\x03t\xcd\xc4\xa0\xb4\xba\xcbg,Yx@\x99\xaa\x16\x7f\x0d\xfb6~\xce\xe9\xd7\xaa\x8f\xbb\xdf\x80\x15L`\xc4k$\xf6\xe2\x12\xfeh\xf4\x9cC\xdb\xab\x1a\x065vv\xd6\xa9\xd9\xe7\x9a\xa2T\xab K\x8f\x13K\xf6\xe4\x8baGb\\\xb5\xae\xf0[\x01\xacA\xed\xc8\x87?\x05\x97\xeal\xd7\x8d\xad\xcc\xa3a\x8f\xd6='\x936 \x0c3\x83\x97M'\x1c\x045m\xfb\xaa#;\x0f\xf9*\xcd9\xa5\xf46\xb8J,g\x03\xefe\xa3\x83$l\x8b\xd8y7*\xed\x86\x16AT\x89\xec\xe8\x98.\xb7\xd1S\x7fg\xc6\x82\x9fi@X\xc5Gj\xbe\xde\x9c;\xb8\xaf\xaeU\x0f.\x9f@\xb9:.\xde{\xff\x10.\xf3\xa7\xdfg\x16\xd3\x8c\xb0.\x93&\x8f\x85\x8a\xe3\xb2\xd0.\xac'\xc9\xde\x15$$\x07\xa3`\xe71R\x9d\x07\x9b\xe9&\xc7z\x94\xfa\x0f\x0f\xe6jxf\x10\x95N\xd04\xab\x09um\x16\xb7{j\xf0.\xc9\x1bS\x94\x07M\xf6\x90\x97\x09\xaf\x93'\xf0\xa3Lq\xdc\xed\xa2\xefo\x84,\"\x1e\x90.T\x16\x7f\x0e\x02\x85\x93\xeaX\xa2\xc6\x93d\x1d\xa3\xc0\xb4\xfc\xaes\x9b+\xd1SL\xe4T\x89\x15\xb6r\xfb\x9b5\xc0^IB\xf9\xa2-d,\xa7\xb4\x90{\xc3zTi9A8T\xc8\x06\x95*\xa7\xb4^;\xc6\"\x8aL\xcde{f<\x85/BB\x82g\xd1u\x0c\x84\xe0XS?\xce\x91\x8dD2\xa6\x00G\x1f\x0c\x8a\xbd\x83\x1e8\xe6\xac\x07\xa6~\x1b\xad%\xca\x85'x|k\xec70\xb8\xb7\xc5\x9f0pp\xc1\xc0i\x9f1\x91Q\xd2\xf9#\xde\x86\xaa\xf7\x12Cm#:\xf2\xd7\x86\x0bpb03O\xb1\x8b\x18\xcbi\x88\xa5J^s\xb0\xc8\xb5\xcc\xbd\x92)\xec\xe6.\xdb\x9fX\x07\xe9\x80(FC\xe1.[f\x860\xb7P\xe5N\xa9\xb6\xd8\xf4o\x9d\x15\x8bcH\x14n\xddd\xbal\x86U\xaa\x04&\x9c\x88\x1f\x83\xef5S\x96f5u\x86`\x09\x8a\xfd\x85\xab\xc5&\xf2c\x14$\xdc\xb9\x98\xe4\x9c&\x9f\xa0t\xe4\x00\xd41\x00g\xac4\x0b\xf2\x9f\xf2\x828c\x9f;\xa1\x12\xaa\xa2\xe9\x1bf\xdc\xd7\x00\x8e\xfc\x1a\x85q\xac\xc4\x19\x06s\xf6iY-\xc0 N\xa3\x88\xfb<*v\xe4&\xed9E\x1b\x15\xa8\xb1\x19\x18-;\xd8$Z\xe9,q\xa4\xdc}N\x9b{\x1e\xf3\xf8R0\x8f\xbf\xfc\xe7\x9f\xff\xe5\x97\xff\xfc\xf3\x7f[C;\xfe\xe34\x94O\xb0H\x17\xc0U_\xec8:\xc9U\xb1t\xccp\x8cC\x83\x80\xf7a\xafbhp\xc8\xa7`\xb8\x8f\xff\xb5\x16}\x7f2\x97=o|\xddp\x84\xf4TuTa@Ft\xd5\x0d0\x12\xc0\x8c\xd8\x0c=w\xf9O\x02\x02*\xca\xe8{\xde\xd3\x88\xfd\xd9\xd0f\xa9\xd1\xaf\xafu\xbe\xc0\x8d*\x99\xe7\x93\x16\x92\xdf\x0d\xef\x0b\xaevE]A\xb9\x01\xb2\xf5t\x1a\xb6\xac!\x811py\xbcGEd\x0aG!\x87\x8b\xbb\x8e\x03c\x13\x9d\x9a}s\xb2#R'4\x96-z\x18\xc4\xcc\xa6\xa9\x81\xa3\x0em\xe9\xb0\x88\xdd\xfe'Y\xa6\xab\x80\xcfw\x9cdTA\xa7*\x96\xc1\x8c_>\xac\xca\xa6*i\xd2\xb1\"'\x06M\xdb/\x9e\xe8\x13O\x14C\xb8V\x97\xaa3\x85\xebYJ3\x8d\x92\x9eC\xf7[\\\x19\x99\xc6\xdf\xa8\x13\xcc\x90\x8bM\xa7eE'\x9c\x9bh\xd8\x9d\xc8H\x88\x1c\x02>Z Cu\xc2\xbarN\x96R\xdbq\x82\xd7\x17\xd7\xa5}.M4\x13\x92\xa5\xc1'\xad\xab1\x05-;\xc7t\x15}\x17o\xe3\xef\x9cQ\xefL8\xd9\xcd\xaf#\xd3\xf64\xf6\xb7\xbf\x87\x8b+\x0bc~&\xfbB\xca]z\xef\xe0\x18>\xb4ZheV<O\x08^\x92\xba\xd4\xe2\x86\xe6\xf0\x98\xd4yq%\xaek\x01\xf1\xf7\xe7j\x95\xee\xa0mS\x980b\xcc\x04\x99\x8aEL\xdb\x12\x8e/\xad\x05>^\x9d\x87\x84\x11\x99\xbdS\xa6}\x90.\x96\x09`\xc8J\xe8B\xb5\xa6\xa7\xc20\xeb\xa8\xcay\x90\xb7@\x1d\x951+\xaf\x8d\xb1\xc5\xcd\xf1D\x882\xb5+\x9d\xf2z\xb6\x8e#\xdb\x14\xa7\xb2d$\xf5\xb9p\x14p\xd9r\xf4\xd4\xd0\x14\x9a\xd8\xe9w7\xb8\xa0z0\xfe\xd8oo\x7f&!4m\x7f\xb7g\xf2\xf6\xc3\xba\xc7%C\x05\x11\xd1mP\xc4E\xa6\x9f\x93\x1a\xeeZ\x9eC\x9bP6fi\xae\xf1M^D\xee\x9a\x9b\x94o\xe2~!\x80\x183\x8c\xe3\xddV\xe6\x18\xbbQ\x14\xeb8\xd2\x8f\xbdZ\x83K\xcc\x00\x8f\x1f\xcf\x0f\xf0\xd4q\xf7\x00v\x08-I\xdcvl\xc6\x10\xa0\xad\xcc\xe6\x07l@\xa6M\xc0\x0a\xcd\xb6\xb1f\xc2\x00U\x0dMl*t\xfc\xa0\xefQ\x84\xc4\x01\xde?<\x0a\xdc\x91~\x8c7b\x85\x18\xa6\xa9Dc\xb2\x96\xe9a\xd6\xea`5\x02\x81\x16\xe2E\xba\xfb\x9d\xd0\x9e\xa9\xf0\xc5T\x80z\x1b\x89\xd5)\x9c?`\x94.8\xee\x88\xaf\x1bj\"pg\xa2\xa8\x12\xc2\x9dk/\"l7\xc2\x8b\xf1%{\xfef\x08+ELw\x92\xa8n\xcf\xbew]\xe3q\x12\xaep\xb3\x1fr\x18[\x04\xe8\xd2.\x9a\x05\x82\xb7\x80\xd4\xcc\xa2Og\xe7\xad:\xe3\xad)t\x932\xfcX\xc4\xed?\xc1\xe9\xc40*H\xcc\x1e\xee\xae\xfe\x02\x0e+\x87M\xf1\x1a\xe7MI\x93\x9c\xd2\xd0jx\x87{E}D\x8d\xbbG\xd3/!\xabx\xbb\x0ex\xdbQN1$\xf5;\xdf\xdc\xa0\x01\xdc/\x19\xa2\xb3O\xe6\xf2\x18\x843O\x9c<RW\xc0\x11\xb1\xf3\x9e:HI\xa8\x09+\x10\x02\xcf'B\x8e\xb3v\x17x\x0c6\xcc|\xb5\xf7\xbd\xcf\x84/\xf5\xfbs\xdf\xab/" +
+	"\xd0\x08\xba\xe8Z\xfd\x05\x06\xb9\x9af\xc7-8\xa8&\x81<\xbeA\x03\xa5\x96R\xf5\x10\x82\x94o\x92\x15\x19\xeb\x1dm\x07\xcc\x13\x1aCK8*h\xe3\xa4\xd1I=3s\x18\x11\xc9\xfb\x8d!5\xe4\xe2B|\x87X\x1cf\xe4g\x98\x0c\xdd\x1cJ\xcd\xd7!X#\xbe\xf7\xfd\xe2\xa4y\x91\x16\xa5fTP\xe3\xe2\xa0\x18SUJ\xd0\xc6\xc7\x07k\x0d\x8e\xd8dWo\x05\"\x9b\x94\xa9`\x0aq\x9d\xdam\xf8\xde\xee\xda\xf84+\xdc\xc3\x05\xc2\xb9\xc6?\x8b?\xc0\xc9\xed\xb3\x8c:\x0f&\x87\x800\xb9\x0b\xa5\xcbB;s\xa0\xa2P;\xa4\x14.6-\x15Y\xf8g\x17\xe9\xaf\x80\xb1\xd9G7\xd6\x0b\x11\x98\xb4qv\x01S+9\">5\xad%K\x17\x93\x90\xb8\x82\x97\xb3T\x8f\xd3\xbc\x8e\xaa\xe3'\x12Gx\xa0\x1e\xe9\x87\x89\x83\xd8\xea.\xc9\xfe\xd6\x15\xed\x9a\x96i6\x93\x93\x19\xdc\x09\x8e\xe1c\xba\x048\x91}3\xa9\xad\xc8\x91\xca\x1b\xf9OP1 $-\x02\xef\xa1\x84.\xaeb\xb4Qy\x86~\xa4\xc6Z\xce\xb5\xb7\xeb \xadOdZc!\x0b\xda\xf7\x13\xc1\xd9xZ\xfd\xc0Az\xa9,;P\xb2j\x9f&\xc6p\xccB**\xfcG6\x0d|\x8f\x10xg?\x01\xff\xbf\x0b\xbf\xd8Oz\"W\xa8\xdd1|\x14\xeci\x07\x8bI\xaaA\x13y\xe2\x8bwZ\xf7\xcd\x0e\xbc\xef\xe1\x02IA8\xd5I\xa1%\xb1\x11\xe15k\xefe\xe9\x9bO\x9c.\x14\x1cYL\xbe\x10\xcd\x9b\xef`\xd0E\xcaC\xcc\x90\xe6\xa9K\x0d\x1fN\xd1@\xdd\xfbR6y\x8c\xaazN-\xc6-{'\x18\x81=\xfb\xf4\x07Ko2z\x0b\xe6\xc4\xbe\x87\xda\x0dz\x1f%q\x8aG\xba\xe6\xef\xc4j\xbb\xd1\x1f\xd2\x1d\xfe\xe6\xdas\x1f\xed_\xa2\xb7\x0c{\xcc\x81SD3>\xdb\x06,\xd7\x8f\xa0\x85\x95\xed9\xa8O'{\xb4\x81\xbf\xed\xd8^4\xca:\xe6I\xfbG\x85\x84w\x04\xa75\xc0-\x02+\xa8\xcf\x84\xd36\xfaK\xf7\xbe\x06\x87D\x8b\x05K\xbd\x06\xa3\xe2\x0a&\x03\xd9BW\xec\xc2\x94\x08\x97e\xa2\x84\xd1Q\x1e\xd3\x99N7t\x0b\xe2\xca\xec\x10R\xa5\x93(\x91S\xb6#\xe2\xbc\"\x0b\\X\x12u\xee\xf3\x95)k\xe2\xc5\x9f<\x03q\xe9\x9c;\xc3\x12]\xd6\xd3\x8dx\xd6\xd2]\xa1na\xb0\xf0\xa60\xf7\xafN\x82\x17C\xf0\x81\xce\xc9\x9e_\xba\x93\x1f@.\xb0\xb8\x8a\xb5\xdez\xb6\xc1\x1b\xb6N\xad\x96\xf8\x88\x1c\x93=\x1d\x87\xd0\xa7\xed\x17\xf7v\x1d\xd3\xb3\\\x0d\x13\xd3\xc9\x8c\x16\x0esN\xd3\xaa\xe8LI\xf6\xa9\xb4\xa7a\x98/\xb4\x19$\xf0\x90\xfe\x1eM\xf2\xe8\x83d\xe4}\xc8\x00\x8c\xe0\x07%w\x11\x87\xac\x81\xf6\x8e\xc1\x15D\xfe\x09\xedyC\xb7\xbex\x98\x13-I\x8etko\xe1\xa7(2\xb6V\x9c\xb6-\xb0b\xbf\xf8'\xb8\xf8\xd2\xed\x13\xf2\x17Y\x070\xf8\x8b`Y\xa9\xc2Ja\xebam\xb4R\x86\xc4\xe0\xe0\xd1\x09\xd3\xc1\x05z\xb7\x1aa{\xf2rR\x94x\x97\x86\x04\xf7\xd1\xae\x84+`\xf5~H\\\xa2\xaes{\x0f\xdf\x0c\xf0\x87Q\xf0\x8d\xaf\xfd\xf5\x88\x9f\xb4\x80\xcdZ\xb9a\xb7\xabM\xf3v\x1d\xe7\xb2\x16\xe9\x9a\x1a\xca\x89jT\x8c\xe9\x9c\x98\xae\xacj|WGn\xb0rT\xdcG\xd0\x03\x1cT\xacl\xa1\xb5M\xa5\x15\x04\x19\xcc\xa2b\xc5\xee\xc1\xb1\xb0\x88Yy7\x80O\xdc\xe2\xf9C0;\x01\xdb\xb3\xeb\x84 s*\xa0\x0c\xcd\x8d(\x0e\x0da\xedJ\xcf\xa7:\x8dt\x8c\xe0\xec\xac+\x0e\xac\xc7\xf3\x02\x7fO\xab?\x09\x06\xebq\xcc\x9e\x09\xef\xf9\x91\x19[QE\xe7\xaa\x85kn\x98f\xc6\xe6\x07h\xc7w\x907Lo\xc0\x96p\x0f\xed\x91~X\xf1\x84\x89\x08\xac\xac\x98\xa9\x94\\x\xe4F\x1e\xad1\xaal\xb2\xa9$\xaf\xdcx\xaa\x0ePEhn\xc3\x94\x11w\xe7.\x9c\x92\x8b)\xe0(m\x0f\xa4\xd8\xdb\xa6\xf7\xbb\x17n#:\xb5C\\\x03\x0f}\xc4\x15^\xbe\xc1u\xe9\xb5\x9e\xac\x1e\x111\x03\x8a\xa5\xc8\xfb$\x11\xaa\xf4\x0fY\x1a\x94\xd3\x91ao\xe8\xc3\xde\xf6O\x98\xdc\x16>\xc1\xb5\x13$\xa5\x06J\xa5\xe2|y\x81.V\x85\xc9\xd3\x84O\xc0\xda\xe0:\x0ey\x1c\xfb\x01\x8b\xf6\xe9\xd3\xb9\xb4\x84\xb5\xbb\x0f\xc5\x00@\xfc\xc7q\x87\xd1_\xfd\x93\x96\xe8\x8fR\xf9h\xfaL\xb7\xac\xf1\x0fU\xcd\xfc\xebG-\xafseJP\xc2\xde\xd0!a\xd3\x1e\x96\xaajb7\xa2\x11C'kc\x0e(\x02,\xb2\x82\x82k\xd5\x88\xf3F\xe0\x07h\xc0%%\xf8\xd7\x18\x85\xa0\xc8}\\\xf77\xcf\xcb\xa91d\x0es\x04\xd1\xb8n;\xec&\x1a\x92m#\xf2brD\xb2\xba\x97X\xb2u#YI\x0e^\xf5U[B\x11\x1e\xeb9\xdcl\x0dg\x0d\xe4Kl\xf0P\x8c\xd3\x7f\xe1\x9f\xdc\xde(3\x93 \x92\xe4j\xec\xbf\x0bt\x03\x1c\xf1s\xe9\xad\xd4`@\xb2\x0f\xa2\xcb07\xc8\xe8\xfd0b\xd5\x93\x80i\xa8\xef\x11\xd0\xab6P\x84\x17\xf6)1\x1e\x93\xc8\xd6<am\xf6n\xe2<[j\xef\xab(\xd4A\x90&\xf67]\xeb\xa6l\x7f\xb8\x83:\x82c\xe1\xa7\x9a\xb2\xdc;\xee\x97\xfd(\x8b\xcb\x81\x90tK6\xd8\xf6\xf0\x0e\x07\x11A\xba\xd9\xdf\xa6GFi\xe7\x02\xfd]]\xb4Ln\xc0lh\x91\xc3\x95p_D\xa8S\x82\x96\xaa\xf89\xfdT9\xca\xc5\xac\x0f\xc6\xcd\x9eb\xae\xc9Tc\xbe\xaa\xa9<\xea\x19\x85\x9e\x8a]\x8d\x1d\xc3'w\xaa=\xfdf\x86]Z\xbct\x19\x8a\x0d\xc0\x9dW\x96\xac\xec\x97\xff\xf0\xf3\x7f\xfd\xf9_\xd6\xa0;\x9f\x17\x90\xde\x09\x8e\x9e\x18o\x13\xa5n<C\x0f\xab`\x1c\x9e\xd1\x11\xf5\xa1\xfb\x9b\xbdy\x1cI\x8a(\x99\x18+.\x932)\x15\xe1\x80\x9b\x9c\x97\xed}\x88%uT\xb2FU\xdea\xb0pK\xd3,\xb6 _\xe9U&\x91\xae\x87U\x88\x8d)\xfd\x96\x17\x8a\xc9r\xad\xf0\xd0\x02X\x9a\xca\x1e\x86`g\xb4\xf7P\xef7\x08\x9d\xb9(J\x14e[\x14\x874\xc8\x18\xd4\x951t\xa7r\x85\xdc\xce\xbaB\xa7\xc5\x12`\x0f\x14\xa1E\x02\x9f\xd0)\xe7\xd1E;\xf3x(aO\x0b*\xa3\x0b>Z5\xfbI*\xb92\xb2B\xd0\xf2\xfb\x14-\xb9\x09\x1e\xbbu\x115~'\xdeT\x17\x12\xa53\xd1\xc6\xd8kX\x06\xbe5[:O\x09a\xc9\x00\xa8Um\xe0I_*N\xa7\xbe\xbc\x7f\xa8\xac\"\x0eW\xa4\xd2\xd9\x84AI\x1bvF\x10\xe3\x84\x03?\xdb[\xe5\xa8sQ\xfbz\xf6z\x1b\x88\xe7\x98\xc0\xad\x03&\xfb\xa7\xe4a\x1aUS\xbcr*L|vW\xd7\x11\xf3\xa8 \x0b\xf6F5\x96\xee\x94P\xe0\xfb\xae\x06\x17\xa3\x8bt:\x89\xc7-\x9bDI\xa0!\x1b\x81\xcf\x14\xbahlv\xd9o\xf7\x12\x0a\xebI|\xe1\x88\x9f\x197\xe96J\xb5\x07,!j\xcbx\x0d\xfd\x1a\"\xc8%\xe5M\xea\xaf>\xa1l%\x02\xa6\x03\xd5T\x90\"\xad\x1b@\xf9fh\xd4\xf3\x82\x89\xc3* \xc7\x0a_\x91)id\xf9F\xf5\x00\xe8\x02PH\x01\x1bI0 \x86/\xf1\xaf\x1b\\\x1b\x1cV\xa4\x1e\xc3\x0d\xaeG\x0c\x80\x9e\xdba\xf1\x10\x19\xe5\x0d\xd3\x16\xd8\xfeaIn:\x82p\xde\xc3\xe5\x1b\xa7j'\xbdO$&\xe8\xab\xc1\x81\xa9X\xf4\x93!\x97\xe9\xcby%\xfc\x08\x91\xedQ\x0d\xf2\xc1\x06\xf3\xd8\x83\x98Pf\x0aS\xe7\x0c\x1d\xb1$\x84\x07\x8a5\xc2\xa5D\x0e\xf2\x8bV%\x9bF\"PM\xd5V7\x18\\|\xc8\xb8\xcealc\x87\xeb\xd8\xc6~\x90j\x9c\x05\x15\xbf\x87\xf2\x9b\x93\x13\xb1S05c\xab\x91m\xa0\xae\x8a\xdf\xf9\x12\xdc\xc9\xdd\xc3e\x1f\x0d\xb0\x94j\xe2w\xd0\x0d\xc0\x89\x0c\x8a\x87\xa7\x059\x98Bh\x89yC\xa4\x041\xf1%\x80x6[D\x05T\x00\xf5olLMR\x1bl\x0f\\\x1d'\xfet\xb8b\xfa-\x89TM\xf1\x05\xa6\xba\x1a\xbb3C>x3\x8c\x01\"rR\x8f\xf4#F\xa1x<\xee\xa2#\x12\x81\x9fu%a\x01\xde\x12\x1bO\x00\xd3\xc9\xabEm\x9e.\x0c\xe0W\x91\xe1\x9c9\xdeS~\xc1u2$\xb5\x1799\xa3%\xf1V\xde&\x06\x05&\x8e|\xeaV\xeb\xa128-\xad\xfb\x92\x817\x95\x98\x0d\x1c\xc3\x87\x1e\xe7s\xb1xnS\x02\x92\x89CH4\x8at\xd8\x09mtF\xd4\x10\xdfG0\xb2r\xb5\x81\xd7\x94\xf1Im\xce\xed\x8e\x87\xe1V\xc8T\xbeM(Xee\xbb\x958\xc5\xc4I\xa1g\xe8\x8c\x9c/EP\xf8\xf8\x94\x1d\x12]\xb6#~n\x80?\xcd\xaaS\x1cW\x14*\\\xe26'\x11\x14\xd3\x09\xa5\xd3\x10m\x14Y\x14\xdbh\xfe:\x1b\"[g\xb2\xdc\xc0\xf9\xb5\xbe\xbf\xe9\x06-\x99\x15\xc8P\xed8w\x86\xe8\x8b\xb30\x0e\x8d{\xd1As\x83>\xee}\xefId\x87\xa3C.\xe1\xd9w&\x19\x10\xee\x9e\xedj\x8a\xf7&\x98\x93\xa1\xe2\x99\xda\xb3>N\x13\xb2\xef\x10\xd6\xbeO\x9f\xe4\xdeD\x1b8\x03\x97\x86m\xa0N\xa85\xb2V\xcd\xec\xf1fgR4z\xed\xa6\xc8\x04R\xdeW\xc3\x02\x8a\x98FS\x9fCU\xdd\xf4J\x1b\x88\xb0\x96\x05''\x07;\xe9;\xce\xeb\x9a@K\xe0\x03\xc3\xf1v\xfbDU,\x16H\xd5>\x96\xf8\xe1\x11{H\xb3\xc6\xf4\xed\xac\x90@\x8e\xac\xfb\xaaC%\xa6\xe0O\x15?\xf4WhD\x81)\x06:n\x94o\xdb\x01\xee\xe3\xc4v1S;\x90\x07\x0e\x92\xe3_D\x12\xa7B(3z\x01\xc9\x05<\x17\xa6q\xeb\xb4\x8am\x11BV\xf6\xb9F\xe9\xa4\xb6\xfa\x8f\x04\xfe\xf8*E\x82c\xcb\xcf\xb3%\x11\xff\xac\x08\x8a/\xa2\xe5\x9d\xff\xad\xc7\xfc\xd4N\x162M\xb9\xb0\x18\xf0\x1d\xa9\xf8\x84\x8d\xd8\xd1\xe6\x0dy\xfc>\xd9\xb3\xdf\xcc\x0c\x06\xbb\xc8\xb4\x9d\xfe\x1a\xd7\xd7\x98\x81v{\x1c\xc7\xbdd\xd6\xfe\x05N\x89\x16\xf9\xc6\xba\xdb\xc7\xaf\x16@\xd14\x0f0\xc9\x18\x1d\xc8\x09\x13\x80\x0b\xd9\x97\xac\xed\xc6\xeb\xbb\x16\xb7`\xda\x1d\xac\xe5:I\x02\x9c\x18j\x86\x16\xdc\xcfDfr\x0c\xfc\x0c\xe1F\x9a\xcf\xcb\xa3k\xc8:P\xec\xd0iY\x96\x075\x8a\x8e\x9d\x06\x99e\xf7O\xe4\xc6\x13\xa3\x11\x1f\x8e'\xc6o\xe9hZ\x01E\xb5\x9eR\xf8..\xcfm\xad\xc4K\xb1\x8d\x07\xa9\xa3\xd6\xfaM\x9cd\xd9m\\\xebT\xd8X\xa2\xe2\xa1[/\x940=C\x9b\xa6qv\x16\xd1\xbd\x0f\xf1w8C\xa7\xd6\x17\x13Gb\xe07\xa6\x10\x85zD\xcc\xb3\x93\xaf\x07GA\x85\x19\xc4>~\x8d\xdbB\x1c\x95\x8ba\xc8\x8d\xbcX\xbb\xa1\xca{\x18\xf5>\xe2L:\xc6\x7f\xeec\xc4\xb9\xd8\xe1\x04M\xf8;\xf6\xc6V\xc7-\xd6\xf8\x16\x1ezp\x0a\x01\xe7\xb59:\xad[\xd4v\x88\xa6\xd2x:=\xbc\xe4\xfaIF\xe1+\x10\xa9\xe9B\x8cx\xca\xf1\x81\xd5\x0f\x96R\x07u\x88\xae\xae\xc6\x93\xbdsZ{\x9f\x02x\x80\x0912\xeaZ%dWI\x19\xa9\"\x19\x11\x81\x0b\xf0\x7f\x03\xcd\xd4g\x09\xb60Z\xb0ma\xf0\xd7,\xfa\x8b\xbc\x7f\xb5\xc7\xd8S\xcd\xa4\x1f\x87)\xd5\x1b(\xe3AX5\x9e\xd1u\x9e\x14\x03H>\xd5<aQz\xf3Y\xa6\x1fnk\xf9\x93\xc5d\x10\x05\xe9Z\x8al\x0c;\xfe\xce^\xbe\x149\x19\xb2\xaf\xc8\xdd\\1T\xed\x19.E\xfdu\x08>e\x0d\x17\xc5Qi\xc0\x0f\xe7\xb5:ef\xcb=\xcc\x86\x9e\xb9\x92\xfba\x8ei\xc4\x83Q\xa4r2[D\x93\xd0\x90@]\xe3<\xe47\x0d\x07\xf1\xbe\xf8\x04\xfd\x9d+\xdd\xb5\xf8\x1dt\xd0\x8cJ\xa4/\xd5\xb7\xf0F_\xbbS\xac\xeb\xac+\x094\xeen\xc4\xa6\x86/\xd2\xee\x0d\xec\xe3\x0a>\xa2\xa1\x19\xa4\x13c\xb4\xf7\xd9v\xa4\xf1\xdac\x1e\xb9\xa5kLe\x04\xf7\x11\x9e\x88\x02%\xce\xd0\x88mT\x9f\x88$\x18\x96,@\xb8\xe9B\x89Y/\xb3\xe7\x96\xb0\xa8\x85\x9dWy\xc7'!:q\xd2I\x97N\x14\xe3\xec\x1f\x15\x9f\xb3l\x93\xa4\x08\x16<\xc0\x09\x09\xb9\x10\xdf\x90\xcd&\xd7\xc5\xfe\x12\xbc\xbe\xeex\x02\xdf\x19\xb2\xf4Dxw\x1ch\xbf\x87\xc8\x06\x91U0<\xd0N2\xa2\x95d\xecL\x16sCK\x0c\x05c\xbb\xa5\xaez\xa5\xf5\x898\xf8\xf8\xbb;\xb8\xb8\xab\xbb\x97\xbc\x9b\x9e\xe2\x93\x19(<\xbeL\x98\xb6\x9c.\xd21sO\xee\xf7\x0d\xee\xdciB\xc2\x1c\xae.\x8c\x01\x95\xf3\x98\x85\x1c7\xf1\x0b\xd41\x93\x8aW\x17t\xb5\x94%\x05O\x05\xb1/\xee\xeaj\xd7\xce\x1fH&Z\xb5\xf5@z\xb9`\xa4\xc6%Q\x0f\xae\x9e\xec\x1f\xe1\x0a\x8c\xbc;\x94\x954\xac\xdb\xd1{\x95\xd1\x99\x00\xf0\xc7\x03.1\xf4]\xb6\xbf\x1c\x0a\xd7\xf6\xf7\xd1_\xb0\x90\x14@\x04N$\xa7\x0c|\x12]\xd1As\xf2\xe4g\\cl\xe4\xec\xdb\xe0\xa7Ri\x93QJHX[\x10*\xcen\xe3\xbc1=\xdeV\x8c\xa1f0\x0fd\x84\x10\xaf\xe7\x84a\xbcE&4=\xb6(\xc7\xa1c\x911+\xa6l\xffp\x86\x86\xdb{\x81Z\xc4\xa3\xf5\xc8a\x883\xbb!\x88\xcf\xa0\xaf\xd0D\xfb\"J))\xab\x18fT6\xe0\x1c\x95c\xc2\xe4\x04C\xdb\x13 \xe6$e\xb7\xa9\xd9*\x1d\xc4|b\x07\xfd\xb4\xd7\xc4\xb0$\xae\xed\xc70S\xef.E\x0fL\x1e\x85w\x94\xa4\xe5\xc7X\x83@\xbf*:_\xfb\xef8\x01z\xe7K\x9cE\xa4\x0d\xdf\xf9\xd6Y75\x03\x88Z\x0e8\xd9^\x11\xb4B\xd8\x91\x14\x0e\x8b\x12\x925\xaa\xec\xdf\xf92\xa55\xe8\xddO\xa1\xc6*|\xa6\xd4\x82?\x02\x96^`\xdb\xe6\xae\xf9\xa1\xbd\x0b~\x05+\x9b3\xdcb\x80\x16\x1aCpyV\x85\xbd\xc1\xb7\xd0\xabb\x05\xfe\xcf\xba\x015\xb4\xfa\xe5\xf0k\xcc\xfa\x8b\x93H~B\xd5\x13\xedM\x18\x1c*e9CM&\xbf\xe6{n_\xeb\xaf5\x12\xf7\xda\x9b\xe1\x99*\x06\xcf\x85E\xbb\xc3\xdf\x8f\xfc\x936\x05<\xf7s\x8a\x86\xbb\xaa=\xd7\xd5U\xbf9\xc5\x7f!.(G\x91\xc6{\xf7\xfeQ1\xeb\xcf=L\xda\xd5P\xe3\x8f\x8e\xc2X\x8f\xd8.\xe4\x8c\xe6\x010\xaf\x13\xb5\xbe\x7f\xf9\xf9\x7f\xfe\xf9_\x7f\xfe\xe7\x9f\xff\xeb\xbaZ\xdf\x12Hq\x04?T!\x94,\x0c#\xe9\x03A\x9c\x99\xf8\x1f\xc7J'\x0e\x0d9\x88p\x08:\xe3j\x7f\xda\xa0\xe8\xfck_ \xc4\x1c\x1d\x97)u\xf4;,aq\xf4\x0b,V<La/S\x8c\x7f\"\x16\x11\xbe\xc7\x85\x11\x1d\xf4\x874\x96\x17~E\x91\xa5p\x19\xfe\xc9\xc5\xcc\x88n\xa4\xa7\x8c-\xe4 \x00\x06:h&0\xe4\xd06^\xce$58c\xc9\x0f\x84\xedc\x9d8g\x0c\x08\x8dH\x860\xda\xdaUL\x1d\x8d0\x0c\x1cs\x7f5\xc6\xc92\xff{\xe9\x02\xb2y\xa6R\xd3Hq\xd6\xdf\x909\xf2\xec\x1e\xb6*f\xf1Dk\xa2.\x0d\xab`Gg%\xecx\xe1\xbf\xd0\xed\x1a\xb4\x0e\xa3\xe8\xf8T\xa8\xfc\xd1&\x91\xbf:\x13#\xb2\x94\xf7'\xe8\xd4\xdb!\xcb\x00\x9b@~\xac\x8b\x99:\xf3\"\xf3eW\x07cg\xfe\x15\x99\x9a\x17\x1b\x17\x97S\x0e\xeb\x18`\xfc\xda)\xdf\x96\x1a\x18\xe4\xe3\x9f\x10G!\xd2\xae\xc1\xda\xe9I\x8d\x80A\xfd\xd5\xd5\x0d\xae\x88A(\xedi\xa1\xda\xa0\xdb4\xbew\xa7\xb8\xbd\xd0/11@\xae\x95\xb1\xbc\xd8\xde\xd3\xf8\xcd\xc8=\xc3_\xf4p\xe5\xf3G\x90\xfdL\x7f\xb0\x11\xd4\"\xe5\xa68\xa7\x1bQ\x9f\xc0\xdc\xe5U\xbc6\xb5\x85\xbdd\x8e\xae\xe0\x0b\x8e\x8au\x98\x10\xaa\x1a\xf0\xd0\xceU\xf4\xd8z\x81t?&\x18\x0e\xbb\x97\x87\x94\xac(\xcf\xdfR\x0a\xe0\x8bk\x85\x061f\x86\x19\x03\xc7\xe7\x96~K~\x1f\xe6\x9ck\xb1\x1a\xd7\xa5y_\xc5\x18%OJ*\x9e\xb4&\xecP\xd4\x15N\x8d\xf0\xcf\x92Yu\xba\x0b\xd4\x00\xed\xa3\xb5\xaa\xef\x98<\xdb\x8c]T\x83X\x0cQ1\xcfJ\x05K\x1a\xc1\xd9Q\x9es\x19\xb1\xb7\xce\xc1\x13\xd7\xbe\x08\x9f\x9c\x05\x1diU`\x12\xc3A\xa0cT\xc9I\xa6\xcc5R\xd07D\x9c\x9ei\xd3\xfe]\xf1#\xe1\x06\xae\"\xee\xd3\xe3RQ\xd8\xcb\x8d\xd3\x01V^\xca\x1c5\xf11\x19\xa6\xc6|O)\xban\x1ci\xe4]\xabg\x0c\x0a!\x0dnT\x8d\xc4\xf5\xa8\xef\xecl\x8f\xaaG\xf8\x95\x83\xbeM\xf8\xd5Xl\xb7\xe5\xfbB\xe9\xae2]\xb2\xee\x90\xf0`\xb8\xe4\xcd\x14\xef\x0d\x12h\xb6\xc3\xcc.\xa6\xdf\xdd\x00\x84\xfa\xc7\x94\x18A\x87*y(n\xe8" +
+	"\xbe\x84\x0e\x83(R\x08f_s'\xb4Z\xe1?R\xa2\xbaO\xde^D\x1eh\x13\"c\x19j?\xb0,CR\x96k1e\xba\x19\xb0^\xdb:\xcch\xab\xe9\xd4W$\xd5\xaa\xd5\xd21\xbcf\xad\x04\xbd\xc8L,\xa2sC\x17@\x95\x90\xbb\x01\xbeI\x1e\xd5\x9ecfI\x08\x87N{\x96\xc0\xbeiC\x0c\xdcM>=<\x18\xbd\xf5\xf1\xb3\xe3\xd5\xe9\xa3\xed\xe3h~Rn8\xd4{\x87\xaf\xd16\x17\x91aw\x12\x01\x16\xfb\x81\x8f\xb0su\x8d$\x95\x86S\xbf\xba\xabP\xfb\xf93\xa9\xdd\x1f\xf1\xb3\xb8\xd1\x97cxf\xa7\xbf\xd9[L\x8cr\xe4iY\xc7,\xb8e\xf8z\x82\xe1\x90\xe8\x0a\xce\xed\xa6(\xab\xb7\x01\x92\xb46I\xfcE\xe5\xbc\xd8P\xba\xf9\xee\x04G\x06\xbe\xee\x02_\x7f\xe7\xce\x00\xed\xde\xd0H\xb0\xb5\x8bC\x9e\\\x8fS>\x10\xf0gC\xc2\xfe\xf5\xf1\x0bK\xe6\x86\x9e\xd1\xf9X\xe6\x97\x86\xa8\xcd\x1d+r\xe0N\x88\xde<\x91\xf0\xbd#\xc9i\xe1**\x9d\xad\xcc\xe04OM\x10\xeb\x1a\x9bx\xc6\xec;-J\x93\x0a\x0aCB\x8c\xbbD\xc8!\xd1P)\xd4\x82\x1e\x8a;w\xa7rum\x82\xd1|\x90\x8f\xf4G!\x1c\xf9\x91\x04\xce\x1e\x84\xbaI\xea\xd18\x03x\x98F8)Uq\xb4\x87\xa5\x9bR\xd4\xb3\xfc6EK\x8fm\x80\xee\x14&*\x1c\xe9\x87\x96)\xfa\xda\x1f\xc3G\xb2\x7f\xf5\x94R\xaf\xab\x16\xaeG\xa9\xdb\xcc\xfa\xc4B\x0d\xe8\xf2\xc6_\x1dd\x83X:\xa3?\xe2b\xd6\"\xf9\"GY\xed\x17\x17\x9d\xd4\xb1!\xf0I\xda\xb8\x8e\xeb\xb2\xa7\x07\xaa`\xbe\x87\xd2;[:\x9e\x94yk\xf2+,\xb2yA\xe5\x8f1ci\xca\x0a\xd1\x0e\x90K\x8b\xef\x0c\xc6s\xf0m\xe9\xeb\xeft\x19\x1a{#\xdc\x83 0IiE\xe3\xf3\x9a\x9f\x15(\xc6a\\?\xa6\xd5qF\xc3\xd9\x14\xcaA\x1b\x1e\xd2\xcf\xcf\xfc_\xad\x99\xfcx#\x9b\x81=\xce\xb5\xc6jt\x15\xaaD\x87\xc8t&\xfe:\xdd\x0b\xe3$j\xe8\x91\x0e\xce\xfe\x18>l\xddS@jxL\xc0J9\xafVK]\x14\xb2{\xe7b\x19\xfdD\x8d\xd9\xf2V\x8fN\xc3\x83\xb0[S\xf2G\x16\xe2\x14\x96B\x9d\xe3(%\xc0\x0fgh\xb0\xf0X\xaa-\xe7#\xe2\xa5\xb3XF\xfbk\xad\xa3\xd9\x92\xb3x\xbb,\xb8\x1f\x91MJ\x19NQ{[\xee%\xee@D\xcaH`\xd0\xf5WhL\x05\xcb\x84\xb2\xf9\xa8Jw\xe5\xf6\xf5\xf8\xf6\x14\xcbI\x81\xc5\x87,\xff|\x0d\x9e\xf0%\x16z\x8d\x1a;\x8d\xb1P'..\xe39=\xbc-RQ\x8bh\xa5\xa9Q>_\xc0\x9d\xb2\xb1\x8eE\x1e\x0fOS\xcf\x06A\x9f\x7f\xaf\xf4\xdd\xffq-\xf0\xf9\xdf\x01\xfc\x84\xf1\xf4\xc8\x99\xc2\x8eS{\x86\x81\xce\x95\xf5\xe8\xe3\xeeS\x16\x15\xa2\xcd\xe1\x1eT\xa5)r\xaf|\xc11:+\xbd\x90\xa5\xcfJ3\x81\x0bB\xe7\x94Q\x97\xc8\xc2>~\x03\xadS)\x92\x88\xe2S\xa3\x88\xf7\x84\xb6\xb4\x91\xc1\x86\x06\x86\x02\xee\xc0\x9d\xd6\x98\xed\x0d\xdd4o\x11N\xcb\xf5\xb9\x0a\xbc\x8d\xbf\xe04\xc0\x00-\xf2\x87h\xc2X\xdc\xc6\xb0\xbb\xef\xdf\x1el\xdeG\x98\xdf\xdb3]\x03m\xa2E\xe1\xdbs\xed\xda\x07\x86\x00Sa\x9e\x89\xed(JX\xa1\xf3\xc0\xbbP\xe8\xf2\xc1\x9f\xfc\xe5\x88\x9f\xfb$\xda\x16\x05\xcf\xc2\xdd\xc3Q\x15E\xb5%\xcc\x88\xf3\xbaC\x9a\xfc\xf6\x0b\x0cK\xfa=\xe6\xd0\x92\xcd\xe0\x90j!\x05G\xcf\xf7\"\x9d \xc9T\x11\x9e\x89\xbf1Z\xf4\xc9\xf4J\xfd\x83S\xd5E\x90_\xac\xd9\xff\x02\xbe8\xc1\x15\xfcz\x90p\x81\x9a\x88\x97\xb3Q\xbf\x88\xa8B\x97\x02Wb\xc4\\\xacQ\x89\xd4\xb7\x06\xa9~q\xd0\xab`>\xdfr\x8a\x88\xb6\xab\x06\xa8\x8f\xf8i\xec\x8e\xb7\x9b\"z\xf8\xfc\x0b47\xca}\xe1\x1cH\xe4\xb6\xae\xe1\xb65\x0f}h\xcc\xd9\xb7\xbe=\x1f\xe9\x07\xef\\_\xeb\xaf\x97\xf8\x95\xc17'\x7f\xb1\xdfI\xc8)\x93\xcc\x9b\x84\xd9\x98\xa3\xach\x96J\x8du/V$<.\xb4(\x98PBk\xf7\x0f\x0c\x16\x0f\x0en\xc7\xf0\x11^\xf3\x04)\xa9\xd3\x8e\x85\xb9D\x8ck\xbf-\xce\xc5\xaf\xc9H\x09\x8a\xa5\xd3\xf8(\x13&c\x1c\xb9\x9c\x86\x84y\xae>\xef \xc3\x9f\x19z\x1dq\xaaG\xfc\xa4\x83\xc3\xdd\xb9c\xf8H;\xadtu5@sX\x8f\xe4`\x87\xe0\xedj\xdf5\xeaA\x87\xc9v\xa4\x1f9\xc2\xbc\xa1\x9c\xb0\xe6\x9a}\xef\xdb#~\x1e\xd6\x83,\xe9\xb8\x90\xe0)\x8d\x02\xf6\xfd\x11?\x8b]\xe8\x84\xfd!N\x18\x15x\xc9\xba\x83v\xd0{\x9e\x17\xe1(\xc0\xb1\x0d\xe8\x9f\xc8\x1e\xcd\xa1\xa2\xf0D\x02?\xb3\xfe\xf1\xa6\x1a\xa4\xcf\xa9\xc6\xeex\xcfa\xac\x1f\x86\xdc\xff\xe0/<\xc3\x88a8\xd8\xc0\xc4\xa4\xf1c\x0f\x8d/\x0f\xebni)\xb4\x00\xef\xa0<\xd0\xbf\xfe\x9d\xeb\xbe\x03/d\xd4\xbe)\xfe\xc1\x0f\x97\xc3\xba\x9b\x95\xb4\xe4\x9d\xab\x95V\x06o\xf2\xa3?\x9d\xe0;\xe5\x94\x98x3\xab\xabBu@\xe8\xe5\xf7US\xf5X\xa6f\x12^\x1an\xbb\xf8\xda\xeb4\xa4/D\x99\x94\x0d\xce\x89\xdd>e{q\x97XQO^\xb9< \x8c14\xf0\xd6\x16\xa1%\x99}\xd9\xc2\xa3\xaf!vy~\xab\x13t\xfe\xd16\xea\xd9\x05\x9dC\x8d\x19Dpq;s\xb8\x9e\\\xefj=[\xb9\xf2\xef`\xf7\xd5\x93\xbb0\xf8\xdbdY\xa1s\xe1\xdf\x05\xa2\x1aP\x86\"|m\xdd\xd8\x8f\x08/\x12\xc7U\xdd\xf1\x94\xa6l\x10\xe1zK\xf9|\x88\xf1!\x9cL(\xf1w\xaa\xc8\xc4\x97<ID\x15\x8f/r\x9d\xdb`@S\xa5\x05Y:\xa1t\x12\xda\x9b\xe7\xfb\x96\x8ek)i\x84\xa5 \x87\x8fN\xec$\xfc\x8b\xbcK0\x94\xe0\x1b\xf4<\x0fI\xa4\x08\xa5\xe76\xaa[\x93\xa9\x80\x96\x17\xd4\xd0\xc1\x80\x8b\xc0\x0f\x19:\xeb(\xd3\xee\x91(\xf6EK\x9d\x9fz\x85\xce\xe9\xb0-*/&Lb\x01+I\xe0!\x8a\x05\xd6p\x8d\x0f\xb9\x84{\x0e\x18\xb3\xef\xa1\x0dw%=\xdc%\xf4\xea\xe3\xe9\xd7\x8b\xa7\x1f\x99\xcc\x13\xf0!\x19\xfc\xa4w\xcf.\xec\xb4I\xfc\xcb\xfe\xfd~\x19\xe34\xcd\x9b\xa5\xe5l#\x13\xec\x1an\xcd\xb6\xe7\x05\xae\xa8\x7f\xc5\x96\x1b.\x96\x1e\xc3\x9b79l\x85J$\x1000\x83\x85\xd01\xec\x8b\x06\x06t\xc89\xf3\xaa\xf4\x93\x08\xa4Su\xec\xc1\x93\xf9?\xc0\x1d4\x1b\x10\xdc\x1fL\xa4\xfb\xbe\xea\x06p3\x1a\xf4\x18\xdb\xc6\x85\xc6@\x18\xf4\x8f\xa4\x0a,\x98gF6\xb8\xf6\x9b\x95{3!/y\xe2?Tl\xba\xd9\x9cl\xd5\x95\xde\xc0]\xb9J\x13\xcf\x82\xb4H\x09\x0d\x1f\xec\xdbN\xe0[\xd03(p\xab\x18.\xdf%\x81CW'\xb8\xa3\xbcg\xcc\xae\x9d\xd0\x02\xc6\xc8\x96n\xd7\x87u\xf0k\xect\xd6\xf1l\x08\xe7\x1e\xf7\xa3SE\xe7\x9e\xa9N\xbf\xda^)\x9d\xe9\x0bTSM\xdd\xdf\xb5!x\x99p/N\x8e?\x9b\x09F\x95-*\xb1\x1d\x0a\xa8-T\x85UN\x8c\xc4si\xe2\x1e\xd9\xf2H\x14\xfdB\xfea\xad\xb1\xafV\xe6\xcb\xefG8\x9f\xa1\xe2\xf1gIPh\x8f\x1fM<+\x0dV\xd6^\xd4HG\xf4A\xbcCi\x98f\xad\xa5\xaf#\xbc\xf9\xc6\xb0GF\xdc\x1c?\xfa\x84\xdd\xaetX\x8d\x8a\x01\xb2z\xaa\xe2\x94\xb2O.\xdc\xdd\xd8\x99\xc7\x8f~\x9d\xd5q\x97\x08\xcfSy\x02\xe9\xfc\xdd\xab\x02\x83\xec\x18{U\xaf\x1d\xc0\xb0\"\x08?\xfeT\x1cQ8a[\x7f\x11&\xe9\x0fn,\xe3\xf7\x09\xe1\x98PBA\x8c=\xd0\xaeD\xf9R\xc2\xc5\xd2\x89t-'\x9c\xcf\xc0\x88\xda\x15\x19\x05\xfc3\x17\x1d\x18\xd4n\x98h\xc5\xce\xa4(\xf7\x1b(\x12y\xef\x9b\x96r\x08\x1br4Qh\xff\xabr\xa7\x8cOT\xf5\xcdR\x01^\xd15\xd5\xf0\x02\xedg\xb7*\x9c\xde\x1d\xd58\xf0\xbfB\x19\xd7:e\xe1\xeeO\xd4\x1e\xd31\xc4\x82\xcd\xcf\xa5%\xfc'e\x14\xfbc\xd5\xf8\x8e\xe8\x14\xc3\xefb\xec?\x8av\xef\xc2\x0e\xdcJ\x11\x19\xc1?\xad(9\x82\xc2\xe2\x10\xf8\x90\xc0\\\xa71$\xc8w:\xb4|C\x02w;c6\xd4\x15R\x17-\xa9\xdaf\x85.\x09\xcb\x91)}\xca\x95\x94\xb5\x98\xe2\xcdD((\x99\xbcKf\xd0\xd3\x17\xa9\xdaK\x97\xaa\xbd\xf8\x06\x86c\xf8\xb0\x88:ZvZ\x03\x92\x8a\x15\x8d\xb8\x888\xd5]\xf5\xd0)\xd25\xb1\xad\xe5Z\xb51&\xb8\xb0\xac\xc6\"\xce\x12\xfauT,\xbf\xc3\xa0\xc3\x05\xc3\xf7\x82^\xa2\x12;\xcd\xba23\xf9>\xbaO\xbc\xd4\xd0f\xa4\xf5ex\x8f\xde\xae\x13\x0d&\xe6\xd4M\x99\xf5\x12v)c\xb8\x16C\xd5\xd1\x83\xf3\xa0\xc8\x82\x06'\xd4p\xa5\xbaq\xcd\xec\x9f}\xcb\x0aT\xaa\x98nK\x04HQ\x86l\xc2\x15\xf9\xf1\xa7\xaff=-3\xd4\x8b*\xb2I\xb9n\x8e\xa4\xa1s\xb3j\x013|\xd6\xdf2\x099Q\xd1\x94j%\xf4A\x97\x16\xcc\xebeaS\x05\xaa\x8cV\x0cs\xcf\x96\x96\xac\x08\xd71\x1184U\x13\xa0\xa2\xb8\xd14^\xe7\xf5Pu'7\x14\x0d\x0cp\x81N\x82\xcb\xf1o\xe1\xf5v\x8b\xa5\xc2\xcf\xbe\xcf\x16Y|\xb4\xc9\x95\x08_R\xc3)P}\x14n\xcc\xa2\xaaM\x16\xd7b\xda\xfdYz\x1a\x89.K\xa2\x0b\x1dO\xd2\x1b\x8b'\xef\xc4\xa1\xd8\xab\x9erI\x8eO<\x8f\x96k@\x9e=\xc9\xf7\xf2\xdbX\xd6\x95\xa7l\xe89b4\x99q\xf1\xb2]\x94\xc0\xb1\xbcH\xfd\xc9w\x0b\xbd\xfdt\x99t+\x86\xb7\x84\xd0\xdc\xc9\xff.\xd6\x90\x08_8\x1a\xfd,=\x08\xe9\xa4k\xa0\xbe\\\xfd\x12\xb3\xe0\xb3\xf9\xee\xc0\xe5\x83\x93\xe7\x0a\x97\xe3\xa9R\x05\x17f\x10\x95-\xd0*\xb5s'h\x86\xf6Ku\x83\xe1Q1KA\x1e\x9c\xad\x04\x16E\\\xf6\xb8\xe1\x84w\x9bo\xf8\xf3\x98\x95\xd5\xd0H\xa2\x11\xc2\xc9\xd6\xe9\xf5e\xd8w\xacmx\xf2b\x10\xc5\x0c\x13\xd4\xbd\xa9\xe8\x91$\xa7,k\x9b|2`]\xcd\x99\x8d,\x05\xbe\x80M\xdf\xe3G\xbf!m\xf78\xa9]\xf8\x7f\xfc\xfc\xcf?\xff\xeb/\xffeK\xf5\x02\xe6\xefv\xd0\x9e\x9d@\xa60\x05wbD|Y\x9db\xa2\xd5/UN\xd9\x08\"|\x9b\x80p]\xfc\xdd\x02\xc9[\x16\xcc\xbc\xc1\xf5\x88\x9f\x06jx\x0f\x9d4\x05\xe7\xc0z\xe6\x8a\x0a\x88yC8\xc6\x9dA\xa5\xef\xe1\xec\x8e\xe1\x83!\xc2\x1b\x18$\xedZ4\x81\xb9\x91e\xca\xf5u\xcb\xc0\x81\x94m\xd1\xdez\x17\xd6\xd7\x1e\xd3\x97L\xfb@\xf7\xe3\x98\xf4\x06`u\x18W\x9b1\x8ag1\x02\x1f\x8e\xf8\xb9\x05\xf6;\x8e\xb5X\x8ey\x1bp\x09h\xa5\x8a\xa8\xf4\xd1@Xg\xf4+\x1da\x89l\x0c\x06_?Ru\xbd\xbb\x1eY)3\xe1\x11\xd6\xefm@\x9e\xfeXu\xb5\x1f|=\x9d\xaf\xa7\x94\x0a\x867\xfa\x016\xe4#\xc4\xe5\x98\xa8\xd4\xa0cT\xbb\xe5\xf4\xb5\xfcj\xc2\x0aWp\x87\xf5\xec\x81%Zm\xef!\x120]\xa1\x96\x93\xc4\xcc\x0b\xb7\x0e\xde\x88\xce\xc9\xef\xc6_\xde3\xbe+\xee\xea)\x0d\x0aq\xb2h\xb2\x11n\x8eC?\x1b@[\xbb\x0f+\x0a\xf5\xff\x94\xaa\xc5\x8bh\"{}F5\xdd_\x19:MT\xd8\x94\x122t\x1e\xbf\x1d\x90)V\x03'Q\x93\x01\xf7b\xfc\x0c4\xdb\xddY\x04T`\x80\x02\\w\x94\x7f\x1c\xd6\xf3\x01j\xa81&\x91\x13\x99\x8cU\x80\xd6\x8a\xe9=\x9a\x94\x99\xb5\x15\xae\xa2\xb0\xb9\x80H\xe9X\xf5\xe7\x04nq\xc5\xc4\xddC\xee\xa9\xcf\xb3$V\xc1\xd6\xf5]Q:\xe2\xc4DKoC\xa8\x9e\x8cs\xb6\x05\x84\x171\xd6'9\xc3\xb0)\xef\xf7\x13\xdc\xc1\xd6\xe8\xbbL`!Y\xb7\x1a\x7f\x17\xe5\xc2\xe2\xa8\xb3\xebk\xa7G\x94\xf0\xdb\xb7\x13\x7f\xd5X\x10\xb9<\x84\xba\xdd\x1c\x8e\x8f\xa9ou\x9al\xf5\xea\x0e=\xc9^9\xea\xf63~\xd4=\xd4nK\x94\x1e\xcf\xbb;\x1f\xe6\xe6\x0d\xca\x1cx\x9dJ\xca%\x05BF\x00\xe9\xed6:\x95\x88E\xd27\xdb\xa1r\xcb\x11?\xf7\x9c\xa7e\x16\x8fx\x0de>\x97N\xce\x0dqs|\x8f`,r8%\xf3\xe82ln\xfe c\\\xda\xb3l\x075\xb4r\xc0F)\xe1Lb\x0c\xbb\x8d\xf3J\x1b\x02\xec\x9f}\x09\x97\x1a.R\xea!/i\xd5c\xbf\x10;\xae\xd8\xa3C\xf8\xf3\x0f\xa7\x0e.\xae\xe1\xf0V1g\xc3\x94\x0eQV\x8b\xc3\x16eS\x85\xa1\x7f\x9fm\xf1\x0a[%\x17Aa\x05\x86\x8eT\xe9\xf1\xe0\x0a\xd0x\xd9o\xb5\x9dy\xecQ\xae\x13\xf2\xfa\xb9\xbdI\xbcf\xc4,\xaf\x05wS\xbcLI\x82\xffgg\xd2N\xcd1|h\xe9\x9f\xb0u\xb6\x09\xa0\xb4t'\xf8\xc6\x8e=\"\xd3\xa1\x85\xba\xe8\xe1\xe6\x8as\xd5\xf8zC\xd0\xfe\x1fB\xf7\x94\xee\xe2\xb8rt$\x00\x19{\x06{2\xcb\xe8|v,\xeeO5\xec\x1b\x0e\xb0\x18\x82\xdf1\xc3i\x8a\x13\x02O\xb7\x89\x15\x02a\xda\xc4\xd1\x89\x1c\x0b\x13\x8e\x8a\x1a\x1c\xb6\x8dYL\x1f\xbf+\xa2X\xa9p,\xfa85\x9c\xa0S\x86\xe7Q\x85\xef\xae\xae\xaep\x11Q3\x9a\x00\xd8\xed8\x0b\xe0\x1a\xa6\xfa\x0f\xfde\x89\xe2\xde\xc6\xf6y$]?0G0GD\x9b\xaa\xa9jW\xeb\xf4\xadn\xbe.\x90\xcc\xc80\x13\xb3\x88V\xf6\xbe\xbbw\xb5l\x0b\xfb\x09t\xfe\xe0\xc5K\x84\xb6\xf4kS5\xa6\x02\xa6;\xd7\xb2\x10H\xa0\xa6\xa9\x1a\xca\xaa\xe8lH\x8f~\x0bdM\xcb\xe8\x1eV\xf7\x1d\xa3\xfc2=rS\x93s\x0d\xe4\x135\xbe\xb8\x80+n\xd0\x01\x13I\xace\x11\x9e\x8c\xb0\xc2\xd9\xde\x90\xb9\x14\xae(\x9dG\x0aqaxS\xc2\xda\xaaC\x0e\xc5\x9dBX\xf6\x87\xf5\x10\xbc\xd4\xbf\x06;E%\xe6\xcd\xda,N0\xf8c\xf8\xe0\xd0\x10\xb4p\xd9\x101\x97\xf2+hKL\xd4Q\x9cZ\x86OPJ\x86Vh\"\x1ez\x0d\xc7\x80w\x94\xb7\\\x9bD\xcf\x93\xed\x86\x05\xc2\x84Pb\x16\x84\x9fd\xfc\x1c\x87\x8f#\x08h\xb1.7\x8d\xa3\x7f\xa4>\xb4\x06\x90\xde\x0f\xf9\x89Ce\x12\\\xd1\\\xf3p):O\x8aA\x9d\xcb\x94\xc7\xc3\x8a\xbb\xa05\xb2A\xf7\xc6<\x19\xc9M\x05\xf2g\x9e\xccx\x0f\x1c\x89\x19\x93)I\xba`~v\x0b=R\x1aI\x0e\x96\x14=\xfa\x93\x0f\xb3p\xd2\x86\x1b\x99p|\xb6&\xec\xf8\xe6|PdV\xca \xd3\xf8\xfa\xce\xfd\x05\x06#\x8e\xb25t\\:(v|\xdb\xbd\xa1\xd6\xa40#UZ\x08B\x83\xc5\x02\x0c\xd9D\xb2-E/Bv@\xe4\xe5;\x17\xbd\xbb\xb8\x8e\x02\xf8\xf2\x97\xb3\xbb\xc2\xca\x0c~\xf6}\xd2PF]\xd4=\xb5\x99\x0cCmOD2j\xa2\xdd\xc4\x0c\xc9\x0b\xbb\x82\x1f\xb2\x0c\xc1\x15j&\xabC\x94\xbbb\x9c\x03Z\xfc\x0a\x1b\xa3\xcbq\xa4w\xb5\xeb\xc3(\xbe\x832\x19\xc9\x00Gj\xc6[\x09\x06\xceb<I\x96\xfea=\xb8L\xdfL\x90\xb7'\xe2X\xc6z;\x83b\x0a\x1d\xe3\x18\xc4, \xa6\xd2q\x9d\x18\x1dld\xaa\xf6\xc1\xd1\xab7D\x94\xd5]\x92\xb7%t\x1f\xe2\x0d\xe5\xad\xcbi\xd7]\xc3\x11aU\xb7a\xd1\xd1\x0f2\x14\x10c\xaeU\xcd0x\x92MX\x9b&\xa9Fk\x0c\x80\x84\xb1-\xa0=\xd7\xde\x14\xeeji4\xee\xbc\xd8/6\xbe\\\xba\xe0^c\xe1\xf5\xc0\xeaVj\xaa`Fu)\x93\xf4\xec\xf9TK\xc6\xd8\x00q\x06\xdb3c,\xe9\xd7\xc1\xb9\x89\xbe\xa2\x91\xf2\x99\x9f\x0fI\x08p\xc7\x9bF\xca\x84\xc8 Q\xe3\xf3O\x07\x05T\xb3S\x8eO\xe4\x09\x98\x7f\xd7d\xc75HpJ\x8c\xf2\xbb\x19n\x9f\x1e\xa4\x89\xfb\xa2\\Ud\x14\xf9\xe1\xd9\xc7'[\xad\xfa\xc9)t\\E\xefB\xe4\xe2\x18>,M\"_\xa4\x8a\xcf\xd8\xb4\xf9\xf7}\x9d\x06\"l\xf9S\x96!\xc4=\xb4\x0f\x93\x9b~D-\x1c\xa9\x13gxw]aFX\xeb\xc8f\xe3\xc7\xe9FHa+V\xff\xb3\xf2\x02\x019\x8e\x1a\x86\xfb\xc9\x82V\xa8\xe3\xf6;Q\xd9\x12\x8f\x0eC\xc9 \x8c\xb6\xf3mKw\xc2\x11\xdd\xcf\x19J\xf2\x8c}\x8a\x90*\x9d\xc8?\xfd\xef\xd7\xd4'6\xbc-\x06\xb4(7\xd0\xc3\x10{\x1cw\xcf\xa4zcd%\xa4I\x7f\xbb\xc1\x1aE\x8e\x94 \xfe\xb7>#\x9f\xe7u@\xb2\xe9\xd0!\x1e\xa6b\xd5\xcbIi\xd3Uq\x83\xe6\x1d!G3\x8f\xf6\x83\xcc\xfc\x9co\xd2\xb3<5M\x16\x85!\xd1" +
+	"\x88\xb9\xd0\xc9,\x19\x0d\xc9\xda\x19\xfc<\xa1\xf8A\xdc\x9a\xad\xf73\xd2@\x93B\xc4\xf1\xef\x12\xae\xb52#\xb3\x06\xf2|s\x12\xe37\xc1\xd7\xe9\xcep\xc7D\x94\x02\xd5\x8e\x13F\xa5\x17\xe3\xbc9\x95\xb2\xb0m\x12o\xf4X\xdd\x87\xdf\xd9G\xaav\x0a\x17<\x18\xa8A\xde\xfd\xd3\xdf\xcb\x1b\x84\x9d\x1aPp\xcd\"c\xed\xf3\xa5\xdaD\xf6A\x05\xb9\x14\xee(\xed\xfbS\x9ad\xfa(lp\xe8\x9b~&8\xe7\xc7P\xd23\xff\xe4\xd7\xd9&i\x1e?\x16\x0cA\xf6\x85\x02\xd8\x01\xc0\xa8|\xb0,c\xe0\xee\x04\x17\xfa\xc3P5&\x17MH\xc3\xfd\x1b\xec\x86\x84\xf3@\xfa\x09\xa3\xc1:nQ \xe3^\x92\x0dK\x16\xc4\x8bt\xa7\xaf\xab\xb0\x02j\xa0gL\xcfK\xa2\xd1\x8b\xf9\x97{!U\xa0\x02\x88\x19\x1d\xd7\xf9\\\xf0\x93$\x17\xfc\xff\xfe\xe5\x7f\xfc\xf9_7e\x81O\xbev\xc3aS\x82\xf7c\xac\xcd#\xe9\xfe\x0d\xe2\x87\xf8\x12\xd7p\xdeu\xc6H\x0f\xf9\xf4\xc3\xa6D.\xc6{\xdfCx\\\xf1\x09\xbek|\xb7\x9e\xaee\x15\x85\x9b6U\xb9\xebvd\xf0\xec\xb7)\xe1\x05\xbfC\xee\xa1\x09\xa1\x96\x85\x99J(\xe06\x9f\xd9{9\x9d;\x0e\xb9-\x89\x1d$\xb9\xdd\xf5\x9clr\xe0\x99\xa3\x8e)\xd7\xbf^\x11\xf5\xb6\x9e}\x1d\xa5\xfc\xa8Or3\xee\xfc\x15%)6\x16\x86q\x09_v\x8f\xbbE\xf0\xe0\xe3\xc73\x8b\xc6P\xd5\xb1\xac\x99\x02\xed\xe7\x10gZ\x8b\xb4!\xd5:\x9b\xc9=\x91V\xf0\xb1ACG\xb2\xb90\\\xdcR\xd2\xfc\xf1\xd3q\xcf\xaaWR~\xed\xbe\xa2%\x92\x8b\x06F\xe8P\xd81\x8f\xe1\xa3\xbd\xffM\xb2\xab\x9cF\xb5zJ\xb4\xfb%\xb5&\xa6\xcbT%\xe7\x81EW\xaeud\xfd\xbc-\x82\xce\xfe(\xbd\x84~\xd7\xdb\xf5\xc4\xe8'\xe4\xdb6\x80\xb2\x08!\xddu1U|\x83\x962\xd1\xed=\xc8F\x8e\xbe\x81\xa0\xcf\x89\x13\x10-1l\xc2\x81\xd8kz\xb8\x03\x96 {#<'\x89\xb4\xc6\x18s7T\xa65k\"d/\x1f\x98dN\x12\xcc\x84\xc2\xa5z\x09\xe2&X\x9ai\xaf\xc6h\x01\xe4\xdc\xe9\\\xa4\x047ec\x89\xa6NB \x89[\x10\xff\x86 \xb8\xf67\x16\x1am)uq\xf7Z\x83\x01\xbc\xde<Q?)\xa0\xb1q\xado\x0cJ\xaa\xc2\xd4\x0cS\xcf\xc90Q\x13\x16\x86\x88\xf3\x14\xebY\xda\xd8{Cu\xadj\xcak\x92\x94\xb9\x0b5\x0b\xf8\x19\xfe\\V]5p\x1b)\x1e\x04\xdf\x12G\x81\xdb{r%\xb2\x86\x1e\xe5\x1f\xd4\xc6/\xd0\x0e\xae\xa6me\x80\xa0\xbf\xef6\x16]\xc5\xd93b\x8e\x8a\xa2\xc6-\xd4\xc5\x17\xff\xc5\x8a21\xcf_V\xd8\x18\xbe\x18\xbeJ^6\x92\xa7Q\x1a*\xbb\x81\x06'c\x91>\x87di\xf4\xa6\x18i\xf0m\x0d-\x8d\xe6\x97~\xd4j1\xb1\x9a\x07\x9c\x8f\xc3\xc4L\x95\x11\\\xacy{2\x0a\x15\xe3*\x8e\\\xd0T\xcc#C\xe2\x036\xd1\xd3\xaf\"\x1d\x15s3a\x14\xe0\xaej]\x9fB\xbe\x83\x87\xef\xb6\x94p\xb9d\xfd\xa6\xce\x1b%\xd6\xab\x93\x1f0B\xe9\xc2\xb3v\xe7\xea\x0a=\xe7o\x1d\x95\xe2\xb3\x02\x08\x12u\x9e\xfc\x95\xe3>\x03\x1c\xc3GFP\xf6\x13T%\xd7\xad\xd0h`\xd0I+/\x89\xfak\x9b4\x07\xaa\x94_\x1d6\x15+/k7@\x17C\x9bP\x1f\xc3\x07\xa2\xed\xa2\x94\xd8\x15\xee\xa1u\x91\xc1\xa1\xafPO\xc72\xbcb\xca\xda\x0fNY}C\x91Z\xc8\xc5l\x11\xca\xa0\xe8\x8cZ\xeffJ\xd3\xde\xac\x95\x1ezI\x8cI\xf4\xc2L\xd5\xdeC`\xc0Z\xae\x9d|\xb1\x9c\xa4\x9b\xc8S\xb1|J_\x11V%fYHr\xe4H?2y\xd0$\xfb\xba3BW\xfb\xcdEb\x09-~\x94Hc*N#N'h`\xd9\xb4\xf9w\x1b\xb7\xec'\xaf\xe6J,\xe5\xddc\xd1\x9c\x08]\xccd(-\xd5\x8e\x00\x8f\x1e\xc4\xba\xf6$\xf5\x06\x17\xa3\x03e\x14\x0b\xe9\x85\x9d\x96\xceP5\xb1G\xb8]\x0e\xda\x0cU+:<x\x89p%\xc6\xf8\xcd\x86$o*\x05\xb8)f\x84\xe5\xaeB\xeb@\xc9J\xec\xc8#\xfd\xb060\xf3\xf7\xae\x1bg1\xb3;&X\xfa\x11o\x8b\x8cHc}N\xa81\xdf0T\x1dAAR\x83\x1a\xaf\x13\xe4\x8e$\x92\xea\xf5b\xbc\xa7O\xa6#[\xa5\xd3\x10>\x9a ?9du>\xf2O\xb9,\x0aS\x8a$\xee\xe0\xcb0\x9aG\xfe\x89f'\xb0\xbd\xc9_\x9a\x0dv\xbd]\xcf\x1b\xbfKo5=\x8c\x06\xb7\x13fb\xb0\xd8Od\xb1soL\x04\xe2\x12\xe5\xd4G\xeb\x89f\x9c\xf0\x91\xa85\xb6\x87\xa2\x99\xb5\xd1.\xe1(f\xe3z\xb8FHy\xd8\x7f\xef\x0c\xc0\xe7\x81|fO\x9f\xcf\x0d\x9c\xc6\xc1\x88(\x9a\xa3\xe7a\xeed\xe3 sG\xbf\xb0C\xe8E\xca\xb5\xb4P\x9c\xf4bfP\xc6\xa4\x09y5O\xc0\xc3\xbe\xb1<`\x19\xa1\x0eb\xe9\x1c\xaa(N\xc1\xfe\xf7\x1b2\xd4\xd2&\x81\x00\xc4x+\x9e\xc3a\x9c\xc2\xe6+\xb6\xb4(#\xd3e\xb5)\xca\xc5\x04\xc9\xdb\xf5d\xf4hfJ\x94m\xc02\xe8bWW\xdc\x0f\xfb\xb8l\xb4b\x86\x0b2\xe9\xd205B5|\x10{\xa6v\x9c\xc1\x9d\xf6o\x8bl\xd2e>\xb5\xdek\xa4\xba\x90LK\xa6\x12\xaf\x06V\x9a~X6[^\x93^\x81\x8e\xbbh\x85\xa4L\x99\xaa\xa1\xed\xcd%J\xb1\xf9v=#\x9d8\xaa\x12\xf5\xf3\xb7\xc8(\xaas\xf5\x828\x84\xb8\x08N\xd5\x09ZE\x9d]|x\xf9\xb7\xebyf^K8K\xb4\xca\x91oj\xc4\xac4\xd0\xaf\x83\x93\xa47\xc3~|u=\x99\xc8o\xd7\xd3\xcc\xd9\xd4Qa\x0fI\x9b\x0f\xee\xe6\x8b\x12\xee\x81g\xc0Lt\x92dDD\xc1\x8c\xfeP\xb5\x10\xbf\xbe!\xeb<?\x8bq7\xef1\x94\xae\x84\x8cp\x81\xe2\xb3\xff\x12u2\xac\xcf\xa7\xdd\xd6\x85\xa8\x09\x1e\xe5\x1b\xb2\xcb\xbf\xb7\x98G\x0aJdD\xba\xbc\x99\x9c\xfd9\xc8s-+\x05\xff\x10a\xa4\xb1\xde\xe5\x84\x9a\xbc\xa5\xbb\x1c\xe9\xc7Z\xe6;\x0d\xa7\x9c\xa2\xc8\xb2\x8cW2\xf7\x04\xd7hj\xc9G\x00\xda}\xb2\x05\xa7\xe4\x14q\x0a\xaf\x03\x15^\xe4A2\x1b(\xd4\x13\xed\xec[\xb0\xf2\x09iE$\xcf\x8dT\xcd\xd4\xec\xc6\xa5\x1b\x96\xc5\x0e\xd3\x1c\xf5JF\xe0>M\x0b\x90$7M*\xad\xd2\xc5T\x99h\xc4/)S,c\xe8\xb2\xe4\xb5\xa9\xdd$gP\xad\xa6\xb0\x15\x0ep\x8bG\xc1\x94\xf95\xa601\x0c#\xe8\xe0\x1e\xc6\xd5\x9e\x11?d\xab=y|\xe8\x8e\x94\xc2\x0f\xd1\x8b\xc0\xd2\xb2\x9e\x17\x1f=\xc3Tt\xde0\xd3\"5\xc9=\xc00Q\xe4Y:\xc2{\xc4J\x98\x93\xc3\xcd\xec\xc8?\x8b\x9d^\x82\xd0\xfe\xfd\x86t\xc6S\x9b\xce\xf8\xe5\x7f\xf8\xf9\x7f\xfe\xe5?oKg8QK\xa3)\xcbMZ\xaf`\xab\xc3rN!\xda\x12q\xefY\x15\xca\xc0=\x82\x07\xbd\xc0\x02\x95\xd4\xb5\xa1\xc3n\xf8\x0c\x0c?\x11\x9e\xcb\xb1\xd6)\x92\xf77\xe0\xa1-.\xf0\x0d\x8a\x16~\xea\xfd\"|E\x1e\xf6\xef\x91\x09I\xee\x8b\x8f%\x07Y\xd9y\xbf\xc1\xe5\xdeK;\xf0?5\xc1t\x19\xb8\xbb\x81^\x91\xbf\x1c\xc61n?\x18\xf1\x0cV\xfd\xee\xf7\xee\xd4\xb9\xeb>a\x85Y,!\xcc\xd9Y\x92\xdaq\x0a\xa3U]\x09=\x85)\xf9\xfeT\xc2d\x8bN\x89c\x9eVG\xe7\xe5\xc4\x80\xfe\xba\xa1j\xce \xcb\xa2o\xef\x7f\xf2\xb5\xe5\xb8{\xb4\x9a\xa0\xf9\x18\xa1\xe6\\,\\\xc3pP(8E\xa8\x98\xa6\xeb\x0a\x0bw\xd4\xa5\xc9\xc9\xca\xd2\xa9\x02\x18~y\xc7\xe8D\x9a\xa7(\xf2\xdaA\xbd/J\x0f\x85\xbf\xc3<\x8c\xef}W\xdc\xa0\x85\x0bY\xa1\x96\"l\x17\x1e\xbe\xdfPA\xc7xw\xae\xc8on`\x0e\x1cfG\x09\x9b\x84Y1Yx\x96\x0eq\x99g\x9b\x94\x15\x06\x13\x1a39\x8a\xfc\xce\xa5[Eu=~\x92\x9c\xb2Z\x00\xe3\x9bb\xd7\xf8\xfe\xbes5\xe9\xc4\xee\xa3}\xd4\x9c x\xa8\xd8\x84\x1cy\x91?\xf8\xedzBht|\xe9\x98\x89G\x837+\xe2\xa4.v,+\xeao\x913?\xccb\xae\xed\xa1]4\x0aEl\xc8\x17\xf1A^\xba\xe2\xb3+;\x18\xdc\xb5\xf8\xe4\xc3[\xc2\x86|\xcf\x07C\x99F\x94k\xddYi^\x18\xaa\xbb\xa1\xf6M\xa7H\xf6\xca\x8a\x9b\xcd\x9b\x86\xfd|\xf3\x83\xa3m\x85]\xce\x18d\x0a\x7fZ\x1c|]\xdc\x7f\x8c\x87*f\x94\x9al{\x8f\xc8\xd9\xd2\xa4\xe1e\x90\xa3\x94\x88\x95k\xd6(\xc3b\x13\xa6\xc3jQ\xcd\xc5\x1a\xa3\x02Z\x98,\x13\xdb+\xe81a\xe2_\xd6\xf2z\xfczj\xfa\x93\xce)\x1a\x0a\xbb\x0b\xe4\xe2\xe3\xb8(\xf6\\\xbe}>\xc3\xad\x80k\xf1\x0f\xc0erp-\xfe\xfd_\xfe\x02\x1b\x12,\xe1\xeaO\x91p3\x8c\xf1\x19\x0eI\xe3\x87\xaa\xd3\xd2\x9c\x9d\xa4z2\x8f\x8f\xa0\xd5o\xd7S&\xecd\xed\xf0\xed\xf6\xd6K\xdc\x85[\xee3\x15\x06\xebi\xf2\x05\xfa\xd0\x0d\x85pc\xd2\x02\xd5\xb0\x10\xee\xe5\xc6\xaa\xd2\xd1\xd4Y\xd4\xcb\x89\x99\x85\x1f\x14\x1a\x9c\x9e\xdc-4plUbT\x95\xb2Oa`\x8f\xf8\x99c\xf7P\x11\xd2\x0a#\x1bE\xde\xdce\xbf\xf96\xc6\xfa9\xd6Kb\xd1\x09Qw\xda\xa6\x98p\"\xe2Ah\x8f\xf2\x8fCNLO\xa9\x15a\xb0\x14\xb3\xc6\xfa\x08\x13\x0c.\x8c\xe3S\x9d\xd5\xd4G\xc7\xf5j\xf3\x96[\xa8\xef\x06\xf0\xb2\x16$/%\x9e\x1eA)89\xc4\xcd\xfe\xe2\x068\x86\x8f\x15\xd7\xdb\xd4\xdb\xfd\x18zj\xcf\x16?\xf6\x15\xb9J4\x12u\x85\x14\x1e\xd0\x16P_\x9c\xe8\x8d\xc6\xdfb\x01\xee\x86\xe4\xc5$\xe3\x91\xd2*j\x9d\xe6\xa1\xe8\xef\xe1'\xf8\x0e\x86IW\xcd\xf8s\xb6\xae#\x95Mo\xcf\xee\x8e\xb2\x9e\x11}1R\xc5fO\x00%[]\xb1\xfb\xc3\x92-\xf9dc\xca\x9c,X\xa9pQR\xfe\xac\x03\x0f\x11:\x1e\xc1'h\x1a\xd2\xc0J\x9f\x18\xaa\xab\xf0\xb7\xb8\xe4\xb7\xe8\x02\x8f\xbdE\xb1S\xe5h2\xc1\x1c\xec[6\x1f\xd0\xf5\xdc\xb4\x86BX\x83W\x8f\xd4:\xe4\xc0G\xfd{\xb4\xefcn\xd0\x0e\xac \x1a\x94\xd4|k\xc2%A}\xe2\x09\x11\x8d\xbb\x89\x12\x1f{r\xc6\xd4\x8cq^\xa3o\xcd\x1b\x80L\xb2l\xc6\x7fs\x1b\x12.Li\x1a\xec]\x7f9\x86\x0f\xdb\xeb\xd3\xa5\xf3\xea\x80\xdb4\xd5M\x12\xb5Q\x17\xc3\x88BU\x9d\xb8|\x9d\xd0f\xfc\x81+gB. \x98\x00\xdb\xeedj\x02h\x03{\xb4\x8d\x83O\x99|\xc2\x0c\x93s\x10\xd9|\x12\xc2\xaa\x19\x11\x10\xfe\x13\x86\xc5\x19\x0dj\x94\xb2\x15N\xb1@\xe8\x17R\x1bHE8\xccbc\xb4D\x87\x12\xf7\x92\x80|\xd0\x13H\xbc\x8c\xe9\x9a\x8dF\xa9\x916c\xce\x85\x0d\xc9\xa6\x84\x81/\xc3\x86\x85\x99\xe20E~\x0c\x1fd8\x09\xc1;'\xd0\xeb\xea\xceE\xea@\x0e\x1e\x15u\x85R\x9b\xd0\x1e\xf5_a\x06\xbb\xeb\xf4,+\x1a\xb8@q\xf5\xd0\x0b\xc1\x01\xe5K?\xf0\xd4\x19\xb1\x11\xed\xa2\xb8;\x94\xc1\xd2\x8b\xc1\x1c\xe5v4\xc2P\xb1p\x91O\x8d\x18\xf6i\x90\xf6\xf1\x0eZ\x85\xae\xdd\xaa\xce\x0f\x85;\xf9\x80{\xca`\xaf\xd1R`V1\xa6\x0c\xf0_\xdc\xa3\x95\x95\"-\x9e\x806=Z\xcf\xb6\x8d\xb8\x08\xc4\x08\x9e\xdcWv\\%\xc0\xf1\x91\xb7\xebI4\x11\xd5\xa4\x99\xc8[\xa2\xdd\x89\xc0\xeb_\x83\x1b\xfcvs6\x8c\xfc`\xd9\xf5\xe7C\xf7\x17p\xa7C\xcc\x1f\xd0\xd7\xe2\x80\x8f\x81\xfc\x0f\xc9\x87Q\xdd\x02A\xbb,\xb4*\xe1%\x8a\x01E\xc1\xdd\x1e\xe5\x1fX\xd2\xb8\x81\x1c\x10\xd1\xaf\xa3/K\xe5\xb0e\xd8$k\xf1\xe6;\xa2\xf5\xc3\xb0\xf4\x86\xdc\x96x\xa5\xa8\xf9\xdas\xa1v:\x07p\xa9&\x8aX\x8d\xab\xfd\x15\xd7\xb1\xea).\xb8\xc0O\xd3\x08\xe7\xf8A\x8aKbx\x93\xb2U\xf2\x0e0\xf2\xfcQ*\x96\x0c\xc1\xc3z\xaa){*~\x99\xa9T\xaf\xf2N\x98\x14\xc7\x05\xb05\x0e\xf7\xec\xf1(\xdc\xc4w\x9a\xd8{M)\xbe\xfc\xc1\xae\xea\x9e\x07nC\xddb\xf62\x96\xd6\xb4!\xf5>2\x1a\xc9\x9b\xb3EV\xfb\xc3z\x8e(\xbb{\x8a(\xd1-\x90F\x8du0\xf9\xc4i\xc3\x01\xd8\x1f\xd6\xb3@\x8b\x8f\xb04z\x96e\xef\xb0\x9e\xc1Yn\xfaRm\xd9\xad\xe3j\xd8o\xd0\xba\x85L\xa9-\x16\xec\\\x01\xae+\x1ah\xdd\xa1\x80\x1b\x9c\x1c\x0am\xde \x88<.\xa6\xc3\x92\x94\xca|\x83\xab\x81@\x17u\x15z=AY\xed\x8c\x1c\x86\xfa\x08\xa1\xe7\x16F\xf8\xd5|7\xc5\xf2Y\x85\xa9\xd6\x80\xe6\x8e\xb1%\xe8\xf7_|\x1d,\xc5\xad\xb9\x8c\xe9\x97\xc3\xb9\xaa\x0c\xef\x8d\xbb\xba\xda\xed5]\xd0\xdf\xbb\xef:8\xacW\xf2\x8d\x9f`\xe8\xab%\xbfFQ\xc6\xef\xdeA\x99\x88O\x1c\xd6\x8b\xf1T\xb9\x83\xcar\x99\xbcg\x06\xfa\xefb\xad<\x05bq\xde\xee\xb7\x14\xd2A\xfb\xf0\x12\xd3\xb1\xb4\x1fm#\x94|Cs\x8a~\xc1\xd2\xd4\x87\xf5\xe2\xb7\xf0%*\xf0?\x8e\x18\x0b\x92\xad7\xeaK\xad\x94\\\xea\x82\xc7\xd3I]X\xa1\x84\x80\x01zK)\x80\xe9B\xb2\x07e\x090\xeajC\xfd\x1a\x8d\x8dX\xc8\x19\xf4\xec\x9e\x8f\x98h\xea(U\xfd\xdb\xf5Z\xb4\xa02f\xe3\xea9\x8cl\xaco\xa6\x08\x0d\xfe\xe3q\xae\xfe\x12\xf9\x0c\x02\xaaoCm\xda\x8f\xd4\xfd\x9860\xc28\x94\x14v\xb0$\x97\xfc\xdc\x88uP\xfcK\xcc\x13\x0e\xb7\xdc\xb3\xed\x99\xdf\x97\xd4\xa1\x0d*`c\xe5XjUv\xd0Cq\xa9:\x8b\xef\xbf\x89\x08#\xa1\xc8\xde\xaeWs}0\x82\xf7F\x809\xf1l\xf1\x04\x9a\xbf\xd7\xe3\x18\xeb(5r\xd7TW\xc4\x8c\xd6\xd0\"\xfb\xee\xfc\xd7\x9f\x8c\xf8\x82\xfd\x9d/s\x09\\\x0e\xa0\x85\xa8]\xf8\xdf\xee\x83\x83-I\xd6gI\x92\xf5?\xfe\xf2\x9f\xd7k\xc6\xec\x8c\xa0\xf0\\{\xcf\x99a\x0d\x95W\xac\xa3D\xd4\xb0'\xbfT\x04\x94lI\x16\xbd\xb6\x9b\x08M;\xcc\x91r\xd4\xdc\x90\x81\xec\x84Gco\x82A181b\xbc\xd8E\x11\xbf=\x17\xf0\xca\xd2\xf0=1\xf4\xf9~\xab\x90\xdf\x87\x04\x03ZzF\xbe\xfd\x01Nn\xaf\x16\x05\x85xF\x81Oe\xb5\x88\xda*\xc4\xbf\xc3\x9dH\x01/\x06\x13\xb4\xf7\xb0\x9a\x02\xfe\xb0\x18H\xcd\x08q$\xaf\x8ft\x10i\xa1\x1b}\x95\x89O\xbeTg\xb2\xec\xd8'Fp\xd0\x12h\xeey\x1e\xb1\xc1\xb1\xba\x84\x0d\xf2\xa2\xe0E4=EZE\xb6\xa8cV\xde\x9bBNv\x06s\xb2\xdf(\xb0\xb7X\xc7\xfc\xa80\xf4h\x84\xaf!\xa7z\x9f@vg(\xa6\x15\x93n\x92SF\x9c\xa3ex\xdc.#\xef\xdco(\x1aT\xaeH\x94\x1e&8\x1f\xaa\xda\x85.\x92\x7f\xc5(\x0c\x12LG~i\x9c\x04#|~X\x8eW\xe3a\x0dD\xf8\x0b\xedjf[\x03\xeeV\x80\x00)\x9c\xa5\x0f\xa4\x97\xd0\x86\xf8\xc7\\;sB\xdbF\x8e\xb4X\xfb\x1a&\xe3\x9a\x80\xca\xebi\xb1\xb5\xc3\x92\xdaZ\xe3\x83\x95\x93\xd2\xf3\xdb\x0b1\x91M\x05%\xbb?\xf8K\\\xb3c\x18\xb0\x05\x88\xab,\x83\xe8rt-q\xfdW\x9d\x13\x16\xe7-Iv%\xf3\xc1G\x96>\xf1\xad\x0c=\x971:\xa1\xf6\x85\x8b]\x98\x06\xe4\x81H\xe6\x0e\x928=\xc1\x89Q`<\x1f)\xebU\xfb\xb5:\x84\xac<\x93*n|\x0dT\xd4\xd2\x0e\xfe\x88\x9f\x1a\x83d\xca\xb7\x98d\xdaG\x05\xc3\xc0\x09\xe0;\xb8lH\xd6ku\x8f\xb8}\x18\xc8l\xaaF|\x9c\x09\xf2UUo\x1d*\xf2F\xc3_E\xf9\x8f\xd2Z\xb4Dyse\xc99\xdc$\xd5\xc4\x1c\xd8\xf7y\xb4-\xaf\x8fm\x0c=\xea\x870\xbf\x8a\xba\"\xe3rw\x82\xcbPuF\xa8\x09\x83\x9d\\'u\x13eI\xa8\xfd\x86\xe4\xfd\x9f\xa6h\xb0oZ\xe8\x11\x05\x09\xb8T\xae\"'\x7f\xbaJ\x16\x93p5t<\xcf\xb7d\xff\x17\x84\x15b`\xf2\x06W6\xda\xa8\x15\xaa\xa1\xc9\xe3\x02$aaZBQ\xb8\xf6L\xbb\x9c^\xbf\xa9V4\xdc\xf0\x8a3\xf9R\x94p\xe9\xef\x19\x9c\xf0\x178\x85\xba\xfd\xd2p\xa8Op\xa9/\x90\xdf.\xbckC|\x9e\x91\x96\x9a'\xd5\x867}\xc8[F$\x91\xba\x8f7\xdfN\xecj\x8f\x8a\xdf\xbc\x1f^o\xd1\xfd\xbd\x84\xda\x87~\x91\x0fK _\x15V\x8f\x1b$j\x0b\x17\xe8'\xcc\xac\x0dp\x02\xed\xc93\x95\x17\xd1" +
+	"\xb1*\xe1\xbb\x89\x93\xee\xf6\xb5\xfeZ\x17\xf5\xd7(S\xea\x9b\xd3b\xd9\xd1\xe3\xbf\xd5\x04{\xf2\xe4\x81\x13\xac\xf8\xdbM\xb0'O\x93\x09F!\x06\xca\x17\xe6\xa7g\xf6\x86\xc5.Dk7\xe4\xd73*/{\xe8\xbfW\xa1\xe2\xda\x0d\x06_\xcd\x08\x82,(\xbb;9\xe8ao\xfc\xda\xf0\xad\xb7S\x14\x99\xe1\xc8\xf3\xf8\xe7\xe2T\x9ds\xdd\x9aI\x91\xc0\x88\xe9\xdc\xc0\xd5\xf3$O\x05\x10\x8a\x92X\xb8U\xb9\xd7D\\\xd3&\xdb\xd2\xa0r\\\x12\x16\xe5\xc3o\xdc\xffT0\xd7\x7fmNK \xe9\x08\x05\xf8\x88\xf1\xe4\x1c\xb6n\xd3\x91u4\xbb\xb5)\xbf\xe6\x91/\xe77\x898\x9c\xcc\x98\xe7[\x1c\x8f\xc1\xb5\xa0'\xc3\x97\xaf'\x90\xe3)\xd1\xd4\x1e\xa4N\xad\xd8\x0d\xae-1-b\xb1!\x06k\xbd1[\xaf\x19\x92\xeb\xa8\xb6\x03-\xa9t)\xaaJp0\x89\xcfy\xb1\xcb\x8e\x0f^\xfbJa=\xbc1n\xdb\xd9]]'\xa5\xec\x18\xb0BD*\xaaxq\xb4\xcc\x87'\xf1u\xfb\x8d\xf9\xf8X5\x01\xed\xf9\xec\xae\x05\xc2\x94#\xfd\x9d\xfcW\xcd\xbb[\xb1\xc3f\x12\xe6\x8f#\xa9\xbe\xc5\xd7\xdc\x90b\x7f\xf7\xdb\xed|O\x1f/J\xd9$\x119-\xa0&\x96\xcfN\xa5\xb5;\xfcc\xf8r;x1\xf4\x04\x11+\xdfb\xea\x9f.\x08\xc6\x0c\x05\x09;k\xa1D\x1d~{\x15\xf5\xdc\xbd\x1d\x8b\x9a\x1c\xa9\xe2\xe4Z\xf4l6\xa4\x99\xff\x8f\xdf\xc4\x9f\xa6\x9bx\x08\x95\x1e7\xee\xe1-\x9c\xdcbr\xe9\xe9\xb3\xdf\xc8\x0a\x10~\xd3\xc8\xb9\xbb\xb3\xe1Uq\xe1\x0f\x06\x0e\xc2\xb5\xd3\xe1}\x140\xc3\xc6\xed\x15\x1aJ\x90%\x02\x01\xe8d\xbb\xf6\xdc\xbb\x05\x96\x9a\xa7F\xc3\x85\xf6 \x0e\xd3\xa2=\x1e\xee\xff\xa8\xb0\xacrJ\xc5\xb8\x11\xc8\xd0\xdf\xfbz\x81\x92\xe8\xe9\xbc\x1a4\xf2\xb7\"\xb9qL H\xf0\xc3\x9824\x92a\x05\x86\x0fF\xd6\xcc\xb9\xb2\xf9\x88/\x92\xfe\xce[\xbeY\xd5es\xf2w\x1e\xf1e;8s\xbfW\x1dN\xf5}1@\x83ki\x97r|\x1c\x0c\xb1\xeb\xc9\x8b\xd0\xb5\xca3\xf0+t\xa9\x10\xc8\xaf\xd9d\xe6E\xe0\xb09U\xff\xb5F\xfb\xd3\xbc\x97\xa8\xb5E\xa1\x11\x949\xdcJ\xbc\xfb\xd77\xfa\xd9\xf7\xb3\x8d\xfe\xdf};y\xf6x\xa5-i\xe80\x91\x99\xcf^y\x83\xb6\xda\xa7\xf1\x9c\x95\xd0\xad,v\x12\x99\xb7\xf3\x07\xc7)J\xa0\xe0*\xc6\xdf\xe1\x1a\xb6\xa2\x9a]\x0d\xbd\xe5E\xff\xb8HE\x9aS0~\xcd\xc3V\x02\x92\xc6\x976!h\x03P2\xb1D\"\xc7\x06\x030eE?Y\xdc\xa2\xc3\xd4\xb8\x96\xe8\x11\xaa\x13\xf4\x1aVEj\x086S\x91h\xedX\x1b\xba5L\xd8\xacg\xb9\x13x\xeb\\A\xbbhl\xa2\x15\x0a\x83/\xce\xccW\x1bw5\x0dg\x12G\x19\x19\x17\x9b\xab\x1a\xffOr?7\x08\xcb\x19c\x14$:e\x8b\x02y\x16\xb4\xa5\xef\x8a\xba\xc2\x18F\xbd\xa8\x10\xf8\xc2\x06\x01\xd9\x9a5\x19\xa9D|o\xbaZ2a\xb1\xd4/\xf1\xb8\xb2\xe5S\x10\x06&\xfc\xe6\x06\x177lH\xad\xaf\x12@1\xfalR\x96y'\x8a>,\xf8j\xe4\xb67\x14$\x9a0\xf6\x04\x0bY_u\xd5`H\xc8\xc8\xe6\xf2u\xd4\xadx\x88fS_\xb5T\xbb\x1bU\xa1\xfenY\x1c\xe0\xd9\xebESPQ\x12\x19=\xaf\x94\xc3\x85#v\x89\xbb2\xd9N\x95e\x829\x85\x19g\xdb\x13FWT]\xc4f\x0c8\x87\x1b\x9fM\x1b\xb9r\xe7\xf6\xebL\x01@\x1c.,F\x13B\xe5 tS|\x9a\xd9\xb5\x1f\x18\x14{\xfed\x81\xd9x\x0c\xd5d\xa8#\x86ba\x80\x81g\xd8\xc9\xd7\xfe\x18>\x0a\xfc-\xb4\xebI{\xf3\xb8\x1d\x05T\xf7r\x8c~q\xd7\xf0\x1e\x0aQ\xa0\xcc:\xef5!\x81\x1a\xaa\xd87$\xef\x1f\xa0\xd7e\xa8\x19\xa3V\x17\xa6C}{\x06w\xa4\x1f\x87\xf5l~\x99\xc9\x85:\xa28\xcf4\x1f\xe8\xbcy\xcf,\x0e\x1br\xac\xcf\x13^\xce\x7f\xfd\xf9_~\xf9\xcf\xbf\xfc\x0fkY\xd6\x1d>k?V\x8c^'\xea\xfc\x93\x1d\xf9\xf6,u\x9a\xc0F\xc4\x86|\xa6\x83dQrDf\xbd\xfc\xf4\x13?\x8e\x8c\xaf\xb6\xc4\x80twr_\xa0\x83\xf5T\xe1'+Q\x8b9\xe3bw\xaa\xba\xaa\x84.\x16\xc6\x06\xc1*\x17\x82\x05\x0b/\x92\x98\xdc!\xf0\xe7/G\xfa!\xb1\xf7 Bv\xc4O\xdc\xeeJ\x0fG\xd6\xfc\x1d0\xddf*\xa07H.\x0a&%v\xba\xd2\x8d\x1ax6\xf5\xea\xb5\x1a\xa8\\\xb5\xad:\xf8\x82V3\xfa\xb2\x0b\x99\xa2XEf\xb8\xc8\xef$\xff\x97\xb0\xdd(\x12\x95\xef\xbed\xfe'\xb4\xe8Cu\xc6f\xe1[\xb8\x86\xb2\x82\x896x\xe9H\x97q\x02B\xcc\x9cp\x1b\x9e\xf9\xf8\xfb\xf9N\xeb\xf8\xf4\xc1l\x0f\xb3\x99\x90H\xedr-I\xccR}0\xbakP\xc2\x88\x01\x0e\x07\xbc\xa8\xbd\x06\x85\xa3MVW\xd7\xaf7\x08O\\$\xa7J\x93T'w\x17\x08\xff\xee\x9c1\x9cj\x7fUV\x9a\xf6\x0cG\xfc\xa4\xbf^\xa0\xf3Mq\x82\xc5R\xee\xc7)P\xdaB\x8a\xa8\xc3\x13T\x91\xa9\x04ck\xfa\xedzJify[l9GP\xe4\x8c\x1c\x02\xb2\xad\x86\x05\x8d\xc3\x04\x0d\x99?\xe0N\xc0\xf4x\x12\x17ps\x8f6\xe6y~\xc3\xd7\x8f\x88\xa6\xc8go\x09\xd3\xc2&\xa0e\xad\x13\x9a\xd9\x1b\xbfV\xb5\x08\x9dX\xcf\xf0\xfc\xd6\xef\xf7:\x1d^\x15\x15\x09\xed\xbb\x82\x1f$\xa5}U\xf21\x919\xd7*LN\x9fn\xc8\xc7\xe4J\xe9\xb8\xdap\x03J\xd4\xdddoj \x84h\x8e\xf4ck\x0a\xe6\xb7\xeb\x1b\x9b\x81\xe9\\l5\xa3&\x84\xb1\x10=\x12\xe8\xee\x96\xb0J\x7f\xab\xd5\x99\x124j\xe1O8\x80\x0ab\x10\xc7\x94\xf2\x91\x7fZZ\x82\x02S\xc3\x9cv\xe0\x91\xbe\xb2@\x18\x912\x1f\xe9\xc7\x86\xf4\xc6o\xfdV\xc6Qk\xfcDu\x12\xee\xd4\xb8\xe9\xa3\xe9\xbc!\xef\xa0\x8a\x01\x14\xb2)>\xfce\x84\xb84\x9b\xba\xa8tP\xad\x0cj&n\xaa\xf6\xfb\xad\xfb\xe1\xf5|?\xe4<fQ)\xd5\xf2\xd13\xe9\"&\x1a\x88\x01\xe0\x833\x1e\xa4;-\xd2+<\xfd~\xea\xc5\x82\xda\xec\xf1\xafx+K;\x18\xf3?FR\x14\xcf\x8f\x96St\"\x80y\x01gN\x1c<\x1c6D\xdd\x7f\xe3\x01\x89!\xf4\xdfQ@\x06\xbe\x83K.\xf2p 4\"\xde\xb1\x87\xe0\x8c\xdc\x88\x89\xe9\xe4{$D\x0d\x03\xd4\xa3Zw{\xe7;?\x96#m\xa0\x86\x82/C\x0aF\xc3\x8e\xad\xb4\xedx?\xea\"\x8a\x84[\xed\x9da;q\xe0o\xd8;QA_\x19F\xfd\xa1\xd8e\xfd\x13R\xb3\xd7\xea\x06\x98\x08\xd0\xa3\x1d\xaf\xf8\x02\x8ep\xb7\xcd\x09\xce\x0c\xf1\x9bz\xf7\xfb\x18\xd9\xa7d\x19\x83dVJ`\x9e\xfe\xad\xac\x84\xa7/s@1\xd6W\xa8\xe2m\x02ke\x94X\xc8E\xa1\xf9z)\x1a\xf8\x06\x9dZM\xbb\xda]\xe1\x86yL\xd7\xdeC\xbd!\x84\xfd[\xbf\x8e\xee;\x7f\x8c\xd4!\xa4+\x9b,\xc0H\xcb\x05\x96\xf8$\x04\xab\x16i\x87\xbe\xff\x1b\xb5;\x86\xa4\xffq.\x86\xc6\xd2\xb1\xb2X\x0cm\x86\xc9\x8d\x86\xe2\xaas\xf8\x96?\xf9\xf6\x18>\xe8$\x80\xda\xad\x11\x06<\xfb[m=1\xe8\x9c\xd2\xf4\xab,\x00\xc7\x1e\xe4F\x84\xe2\xb3\x11\x10\xee\x82-\xb2j\x11\x86a\xf8\x9e!\x91\x02\xd4_P]Q\xd5\x96\xaet\xb1.\xca\xb7_\xa0&\xe6\xfd\x07\x08\xa8\xfd\x86\xdd\x95\xf2\x1dd\xf4\x03\xc4\xadL\x91C\xa0-\x85\xd9\xa2\x95/\x05\x8fY\xcf\xbc5\x1bB\xb8\xbf\xb2\xfd\x1b*\xa0\xeaJ\xdbA\x8b0\xd6\x92&\xf1\x882%/\xdf l\xf6\x1b\xf7\xb9\x09\xa7:\xb51\xd2\xb6K\xaf\x86&*\x1c\xd3\xe4\x9e\xae\xae[\x8f\xa5\xfe\xd6\xcd~\xb2\xdal\x05\xb4s\xf4\xb2\x81/\xd0\xd8\xce6\xf5\xc7T\xa4\xb7A\xbf\xeb\xb7~\x8d\x89\xe5\x9b\xab\x14\xdc\xaa\xae\x81\x12Zg\xe4\xcc\x84\x84\x90\x16v\xe7\x8a\xde\x0fU\xc7\xb5\xe7\xf6\x15K7\xea\x17\x16i\x0f\xfb\xe5\xe0j&z\xc0\x12\x8f\xadEP\xbf\xe1\xfb\xbf\x98\xe1\xd8\xd7\xb0\xce\xb4\xd6B\xff\xf5F\x07\xf2\x180\x8f'\x02s\xf7\x09J\x9br\x18\xb4\xbbF \xa1\xc5\x94\x17\xbb[\xd8N|\xeb\x8e\xf2\x0fn\x89\xadK-z\xcfd\xef\x94\xf5\xceh\x02\xf1\xfeK6\xee\xf3\x97\x7f\xab~4\xecS\x11\x1f\xa9}\xe6(\x1b\xd2\x90\xd2@\xd8F7\xf8\xbf\xcf\xffV[\xce\x8b\xef\xc7\xb5b\x11|q\x02\xad\x03%\xa8\x08\xff\x82\xb1\xb0\xeb\x95W\xbfuk\xb3\xc4K\xa4\x8b\x98\xde%\xe97!+={\xc7\xbf\xd5n\xf2\xe2Y\xb4\xe5\xd3\xc6\xedj\xaa\xa3\x82v_\\\xdc\x1d\xf8b\xf0\xc1F\xec\x96\xd5\x13^\xfc\xad\xd6\xfd\x8b\x17\x7f\xdd\xa9C\xe5\x01\xf3\xed\xfe[\xad\xb3\x17\xaf\xa6\xdbMp\x99\x92\x0f\x99#~\xeeG1i\x8aF\x13iE\x19\xc0\xcd\x0b=\xff\xb7Z|/\xbf\xffu;n\x14g\x0dS\x9b\xc5n\x8e\xf4c>m\xf2\xb7Z\x94/#\x13\xcc\xfb\xbc\xbd\x9c\x9e\x0c\xfa;\x03\x1c\x8a\xc0\x1dxA\x98\x83\xbb\x1c\x82\x97\xe0\xd8q'\xe2\xc5f\x01c\xfa\xf2o\xb5P_\xe6\xc7\xfe\xff\x9f\x9eW/\xffV\xeb\xff\xe5\x8by\xbb\x87X\x88\xa1\x1d\xe0z\xa4\x1ftg\xda\xb7\x98\xc7\x87,\xa2cf\x1b\xb9\xb6\\\x1a\xce\xbf\xd5\xae\xf0\xd2\xf8\xf4\x17(\xde\x9d}{&\xd61\x09VRf\xd7\x08g\xda`\xe5G\x92x\xd9\x90]~\x91T\xf0\xfe\x8f?\xff\xf3/\xff\xf1\xe7\xff\xcf\xcf\xff\xd3Z~\xf9\x1d\x876\xb8\xf6\xda\xc2g\xd63\xcc#6\x02\xd6\xc5\xae:\xec\x97\"r\x1a\x85{/\xab:\xe9z\xda}\xe4\xab\x85\xda\xb7CA\x05<\xe7\x13\x90\x92\xd6\xe7EQ\xc7\x14\xbft\x05\xb7Pb\xa9\xebL\xa2;\x18v-\x1d\xcb\x1d\xd6L\xbe\x86\x10\xa0c\x04Ao\x13\x9dL\x02\xee\xcaZP\\\xfd\xd5_\xe8\xce\xf8\xcf#~.\xdd\xf5\xc5$\x03\xb0\x83\xa2\xacN~T\xbf\xb9^OZ\x9a:v\x1c\xf0\xc1\x9dA\xbbt=\xf7\x8c\xe4Zz}QC\x0b\xed\xa3B\xc1\xe2\x18ZGt{r\xc5\xf6\x04t\xfc\x9e\xeb\x9c\xb9q_\xb5\xe7\x9e\xc3\x15\xc95\x0f\xc83\xcf)\x02+[\x05\xc9;m(\x83T\x98\x9f!\xf3F7\xc9\xc0\xa6\x96)\x0bb\x06\xf9\xbdE\x81\xddAKyW\x91\x00\xdc\x90 \xfe\\i\x87\x08\x9fY7Q\xf4\xa7l\x0f\x1b\xaa\x0d\x89D \xf63\xc2y\xa6o+Y\xc3\x0d\x8a\x91\xba\x9ds\xca#\x0a\xed\x194\xcfe\x80\xa2j\xc2\x1fd7\x1f`\x03\xafp_u\xe1\xf7c\x1f\xb9\xa5\xdfb4\xe8\x88\x9f\x9b$\x1c?\x09bN\"f\xccX\x9a\xa9\xa2q\xcf\x0ep\xc3\xffo\x10l\x8c\xbc\xfd\x88\x8bG5\xb8C\xf1%<.* \x82\x12\xbb\x10\x06L\xf9~\x92\xc8\xcaa=\x09\xdc\xa46D\xd8*YK[\xe2~\xe6\xcco\xe0\xe4\xeb\x8d\xa4\xbe\xa9\xfd:\xa5\xcd\xe1\xaen\x01\xa4\x94\xf0\xdc\xb0$\xdf\xc9w\xbe=O\xdf.\x8c@\xbb\x94\xdbJ\xcb\xeb\xa6\xadU\xab\xbfxX\xcf\xedJ\xf6tZ\xca\x0e\xcd\xde\x0d\x85p\x9f3\xf5J*+Z$Q\xdc\x92\xa1\xcd\x14+pZu\x09kC\xc48\xde\x93<=\x1c%\xd6o\x06=\xbb\xcah\x10b\xdc\xff\xb0\x9e\xd3e\xee\x16\xd4\xd40\xa5\x08\xd4 \x8fJ\x04E\x1f\x1a\xb51\xad\x9b\x1e\x1d\x87X\x83)\xe4\xd8\x0f;^\x9e\xbc\xcaw\xdaH\x90\x83@\xa00=tt\x89c\xa2\xf4\x0b\x82\x19O\x12\x92)\xba\xc7\xcd\xf5I1\x88\xef}{\xc4\xcfb\x17VIHc\x1d\xd6\xd3\xb2\xe1\x86-\xf8h\xc2\x10\x94\x04\x8d\xa8\xab\x87\x05\x95\xedd=\x8d\xaa\xed\x0f\xeb\x99\xd5|Q\xd3\xab\x84\xce\xae\x0f\xeb\xe9\xd3T\xa3\xf2R\xb5!\xefE\xf4\x9e\xcc\xa3@\x7f\x1c\xaa\xee\x0a]R\x9a\xb0h\x14>K\xed\x96\xdewG\xfc\x94\xd7;\xc1\x95\x9c\xce`?\xd7\x1b\x14\xcd\x96a\xc7\xe2@\x9d\xc6\x8e#\xef\xdba.\x86;\xac\xa7$\xcb\x9c\xbe\x80o~\x86\xd2\xf5G\xfc\x0c\x8b\x0f\xbe-\xa5vua`\x02\xbd\xf8\xe2Z-\xd1\xacN\xe1B\xdf\xf8n\xb1\x07#0\x1c\x1b\x93\xbd\xaaP\xa4gkn=\x91(\xb4\x8d\xfd\x03\x8d\x8fG\xdb\xe8\x12G\xb7\xc5\xbdj\xd9\x02y\xb4\xad\xb2%\xb1\x1e\x0f\xb1,vdUnP\xd7\xfa\x80F\xdb\xaew5\xb1\xf3\x9b\xc27\xaa\xaa\x856\x9c\xee\xb2&\xf1\xbf\x91\x11\xd8\x95\x0b'd\xca\x89\xc8E\x00\xbc5@/\x92\xf6\x177@\xb3!\x03\xf8#{j\x81;=\xe1\xacRr\x9a\x0d)\xbd)F8C\x19W \xe9\x1c\x1e\xb2\xd5\xb7\xe5\x8c\xd5\x8b):\xed\xaa\xf3\xfd\x91\xb9\x00\xa8pY(\x85\x95\xaaw#\xab\xa1\xb6M\x88I\xb3\xba\x17.\xf3A\xaf\x8f$ZapI\x1e?R\xeb\x06\x1b\x1f7\xf5+\xfe\xe3 E5\xfdH!\x95\xe9\x1dc\xc1y\xe9\x98&\x91\xbc\x82\xe6\x04\xd7\x05e\xbaW\xc6\x1f\xc5\xd6\xa5|\xfe.[H\xc5\xee\xce\x9fa\xffvk\xbd\xc5X\x12t\x85r]\x1fTN\xb9NF\xb5a\x03W\xa2\xed\x15)\x00\xd2j\\\xab\x1a\x85\xfa\x09\xa6pE\xc9\xe5\xdb\x85\xb2\xbc\xe7c&\xd4\xb1\xbeV\xaeq\xc1\x09\xb3\x9e%xl%\xdaa=\xb38z\xa1\xa6\"\x92y2\x07\xfe\x02\x7f\xfe\xb3o6\xd2\x1e\xca\xf7\xc3\x0e\xef\x88\xac\xb9\x11\xbc\xf5\"\xfd`R$Q\xc2\x05\x17!\xbe\xac\xdc\x12K\xd0\xd5\x1a`F\x0e\xdc\x85\xb6\xe6\xf3\xf0vt\x1f\xc1\xb3`U\xb9\xbd\xe1\x05|O\xd7<\xda\xc0g\xc85Y\x8e\x99\xabD\xaf\x92\x9c\x06\x1d\xf8?*I\xd6J\xb1Y\xcc\x9b\xa5\xc5\xc9z\xd0\x11h\x89\x0be\xdb3\xdc\x12\x08\x12V\xf8\xb6\x08\x91\xdd\x90A\x13\x9e\xc7\xc8\x9fD\xce~\xed#\xe2\xde\x0f?%!\xc2uJ\xd6\xe7f\x916\xbe\x9e`\x8d\xc5\xc4%\x0c@u\x903WlH\xaa\xa5]\x14\xd6\x1aoM0\xb8\x04\x03\xa2\xaa\"\\\x8bY\xc3\xd5\x1d\xe9\x87eb\xa6x\x9f\xe1\x9aZO\xc1EY\xdf3&\x08\x94\xd1\x9c\xec\xab\x89%\xca\xf5Y\xd0\xa8&\x92\xa9\x8e\xcbG\x9a9\x95C\x0d\x1d\xabB\xec3\xc6\x0fc\xaa\xf2\x17\x96\xb5\xfe\xd2$\xdf\xe7\xd1\xc6\xa2\xdd)D\x1b7n\x92<K8_\x939\x18\xabBU\x17!+\xf7*vR\xe5\x9b\xde\x0e\x93J\x0b<\x91O\x1f0Y\xe3,\x1d`I\x1b\xef\xc5\xb3\xb9\x19\x8a\xe545Cl\xa6&\xa8\xb9\xe0\xedz.\xf1#\\\xf9\x1e\x85\x0a\x8a\x1c\x92C51\xa9K\x07\x05\x87V\x09\xda\xdb\xb9\xf6\xcc\x1d-\xb1R\xda\xfd+\x89\xa8\x07\x97\x08\xa7\xda\xf9\xd1z\x06r\xf7\x99\x06\x85\x09l\xf6\xf3\xd2\xf0\x99\xe4H\x89\xa4#\xa1A\xfe\xecd\x0f;\xac\xe7$O\xac\x98\xce\x8eIx\x87\x89\xb3W\x9d~\\~\x17\xe8\xdd\xb5\x80\x1b\x1c\xe1\xb6\x905|\xc8&f\x95\xc9\xfd\xca\xd6\xfebv\xf7bB\x9c\xf9\xa9\xa1\x7f\xdf\x90\x92\x9c\x9e\x19\xad\xeb\xbf\x80xrvV@\xef\xda\xc5\x15\xa7\x94\x0do7f(Wz\xec\xe6L\x8f!\x0a\x96d\xaf%\xdc\xd7\x9e\xcf_\xfb\xaf\xf5\x11?i\x8c\xef\x17\x88\x94^>\x99\xeb\xd5H\xb7\xc6\xd4C\x93}\xcbW-\xf6\xed\xd3\xe4X\x90\xfb\x867\x89ly\x96X\x98\x08\xafM\xb4\xd6\xa8\xbf\xfa\x1e\xe9\x1dKF\xedb\xbd\xb8\xeb\x1em\xc8r\x0aa_\xaelJ\xf2S\xa7 \xe3\xd5B\x03\x08S\xd7t\x19\\\xa0x\xbf\xe8\x11d\xf9\xc9w\xb5\xa7\xc0Ls\x8b\x8fh\xa0\x87\x02y\x9bD\x9b\x09\xda#\xfdP\x82\xb7G\xeby\xcad7\xe0'\xb8\xa8\x0e\xcc\xbf1e\xb0\xccS%us+\x07\xd0\xcb\xe9\xb2ri\xa0%\x80<\xc1\x97\x18\xef#\xe3\x0c32\x87\xf5\xf4$\x01\xbc#\xd1\xc0Pu7\xa9n\xda\xfd\x03\xf8\x8b\xbf\x86n\xff\xc9\xff\xe5\xb2@[\xfa:M\x0d\x92\x839\x08\xf4\x9f`;\x13t\x84And\xc9Bze\x90\x09\xca\xb3\x85\xd1\x800+\xdc\xb5\xf8\x0e\xae\xd0\xb8\x85t\xf5\xab\xc7\x0b\x14\xd1*r\xd7UMu)z\xb8K\xbbwv\x09\xbd2\xa2\xb1\x8d\x8fVM\xd1U\x7f\xa9j\xd1h\xa6\xfaFf\xb1\xd7H\x92q8VrE\xaf\x9efLPl\xc5\x92\xe8K{\x0f\x81\xc4\x0aU9\x09\xd3\xd7}m\xcf\xf5\xd7\xc5|\xe0\xabgv\xdc/pU[\xc8\xd5\x82J\x0d\x83?\x9f\x04~\xf5" +
+	"|\x14\xe8\xb2\xd2\xa2\x86\x04M\xa0\xfc\\\xf4.\xea\xcb\xd6\xf8YyT\x9a\x04\x95\xf7\x8fU\x19\x02[\xc7Jl\xa1\xdaZ\xd1\x0c|e\xfd\x06k\x86\x91\x9f\xce\xb5\x0c\xdf\x14#\x85o@\x05~!\x98k\x9e>G\x8a\x8f\xc6\xc0\xfc\xf3\xa3\xbf_\xc2\xcd3\xe55r\x01\x99{\xa0\xaaBX\x8b\x0d\xf4Sr\xd7&\xed\xb8\xb3y\xc7\xf9%\xfa*\xd5\x94-]\"xRW\x03\xf2\x9bk\xed<Od\x02&m\xcfN\xbe\xfe>\xcb\xc6$o\xa8\xef\xb31\xec\xf8\xfaq\xd2\xe6\xbab&(:\x8b\xc3\x86\xddxNk\x19b\xe7,I={\xf3'\x0fk\xaa\x86\xefd\xf1r8\xb6_\x98\xc1\xaf\x9fNt\xba\x98o\xe2\x09\xdb\xfc\xderl\xf0u\x12\x16/\xdd\x09j\x93\xfb)\xee\xb8\x84`\xfe\x95\xa7CzB\x94\xd6\x87S\xb7\xa6\xad/*\x0e\xb0N\xe4=\xd4\xae\x8d6@\xbbp\x04\xbf~\xf1k\x0f\xf9\xfb\x99C~\x1e\xfe\xf22!W\xf8\x97\x9f\xff\xdb/\xff\xe5\xe7\xff\xb6\x06~1Ux1+m\xaa\xb7\xb2\xbf @D\xdf\"\xa5\xdd\xdf\x19\x1d\x83XoHkQe\xbf\xc2\x85K,E\xef\xdd\x1d\xa5\x1c\xd7I\xf2?\xda\xd2\xd0#\x99\xa4\x1d\xdcA\xca5@\xe5g\\\x87\xadb6\xc2\x06H\xd5y\x07e%\x88LI\x94\x9aO\x99D6\xf1E\xe8\x1b\xbe\xfb\x06\xc4\x13@\xff\x0b\xae8\xfd\xf3\xbf\xbf@\xf8\xb7\xfe\xe9=\x0c\x17\xd7\xbeI\x1b\xf1\xc9\xd0Vnk\xc4\xb3\xac\x11#\xdd\x9d\xacWx\xa9T-4h\x06\xfe}\xa1\xb1\xc3\x0f\xa4\xfe\xddW\x0d\xdcC\xa3@\x85\xef\xdeu\xfd\xfd#\xedLn^\xccS\xe3.QW\xca\xa6\xd2\xb8T\x8d\xcfp\xb3\xc7\xa3\xd1\xfc\x1d\xed\x1a\xfc\xf3\xa4\x98_\x0b.\xa1\xa3(>\x98\xa6B#\x1b\x9f+\x1ah\x81L\x18\xfc\x0dM_\xba\x9c\xa6*\xf70\x9e\xb9\xa6yt\xfe\xaf$\xa0\x9f?|\xfe\xd1\xa3\xedb\xc1\xcbKW\xc7\x93\x96\x07\x998d6\xf0yd\x9aM=\xe1\xfc\x1b\x8e\xf3\x08v\xd5\x01\x8b>\x98k\xe8\x97z\x0d~%\xf6\xe5\xcc\xe4s\xbd+.0l\x10\x90\x7fO\x88\"\x8d;\x8c\xa49\xfe\x09z\x7fU\x15\xc9\x16~\xaa\xd1\xe3\xbf\x021\xb4\xa3\xeb\x81s\xe0\x02\xdd\xa0\xc0\xc3\x8cV$\x91\x02\x0c\x93\x11\xed\x1e\x12\xe9\x85\x1a\xe6\x81N\x13\x01\xbe\xa6\xd2F\xb0\xfc\xb2#h\x9d\xb4\xa1\xbdO,\x04\xab\xcf\xb8\x9c\x93\xc94SG\xa1\xd7L\xce\x95\xdeQ\xccQ\xec\xa7\xa8\xaf\x00\xa8I\x18iO\xb2\xef2\x96\x92\x062\x89\xfd[Y\xa6\x18b\xeb\x90\x06,|e\x93\x1d\x87\xcf\xda\x80_K\xb7\x9e\x94\x0f\xd8\xc8\xe4C@\x14\x86\x0f\xf5\xb4\xc2\x8f\x9d\xaa\x1b\xa6b\x9d\xed\x197\x8eT\xfa\xb1\xae\xce\x15\x16\x95\xca\xdd\xbf\xc0\x05\xeea\xa2\x03\xc4\xf9\x88\xe1)>|\xda{h\x07\xdf\x9a\xe3\x07\x7f'MB\xa8T\xb3\x15Z7\x19Y\x9f\x98X2\xad\x99\x9c\x0c5U\xa9\x89\x07\x1d\xfa\xb8G\xc4:\xfe\x1e\xea`\xd8k\xa9\xdc>\xdfe\xde\x16\x9c\xe2\x84\x06\xf2i\x9e\x12\"\xc6F\xc9\xf4\xde\x0b\xb3\xf0\x8dFBr\x9a\xb7\xaa\x85\xfa\x8a!\xf2\xdd\xa7\xaa\xc6\x93\x9cq7\xe4\xea\x0c\x88\x0b+q\xe3\x1b\xc4\xd7\x99f\x08\xdd\xf8`\xcd\xe3<\x8a\x8aU#\xca:\xcb\xa2\x11!\x0b,)\x92+\xa7\xda\x9d\x7f\xea\xdc\xda\xb6\xefG\xec\xe3\xe7\x84F\x9aU4]{\x07M=Z\xcb\xf8\xeb\x88%q5G\xe4\xe8\xcf\x9c\xa4\xba\xba\x1b\x0c\xc73\x10\xd9\xb3y\x11\xfd\xbaF\xa30\x0d\"j\x1b\x86g`\xbc1\xc1b\xa1\xf5\xe3'9\x8c!&\x9e\x12)\xd5D\x92\xb4\xf1u\xe3\xdaLA^\xb5\xa3U\xc0\x87V\xa2\xa1>\xef\x1d%\x83\x0bF\x1f\xf2\x1bh\xb5\x1eA\x98\x84\x0euW\xae\xf0\xef\xfdQ\x9b\xca\x81\x1ff\xb8.\x82\xe7\xea:\xa0\xae\xc2B}~\xb9\x14\xfcO\xd4/\xc1\x9c\x85o\xc0!+\xcb\x8f\x96L\x8b\x1a\xae\x16S\xf6\xa8\xe0\xf4]0q\xbb\xf0 \xcb\x16\xd9C\xb7\xa6$\xf24)D\xb7\x1d\x9e\x8a<\xb5\xf0\x93\xab\xe7\xbaZ\xc2\xf1Kt\xbc\xae\x89\xe9\xfe\x1f\x95S\x1c\xe3\xea\xf1\x1cq\x91\x81\x80\x8f\x86\xbe\x12\x90\x19\xed\xb34\x98\x8d\xe7\xf7*\"9\xe2nzt>\x92Qc\x0c\x00\xe5\xb1dF\x84\xce\xd1a\xdf\x96N\x1f\xc0\xb9\x9eF\xa5X\x8a\x7f\xa0\xbau\xd2\xef7\x1b\x9a29\xb6\xa5\x8b{\x8b\x16\x10\xbb6l\x1d\x1f\xdc\xb8\x144\xb4\xb5\x83K\x03C>\xff\xc2\xe9\x12\x8bE\xa5\xd6\x071-\x8f\xd6\xa1\xc2\xff81n\\\xd5\xccD\xe5\xd32^a-\xed\x8b\x08\xdbM\x04\x02\xc2\x9e[\xcbx1\x0c\x81\xed\xdcc\x8f;|\xa4Q\x14%\x80\xc8({\x07\xdf\xe0\xa4\xf7\xaaZ\xe8\x0e\x82O\x14\x8b\xe9\x0b\x8e\x89\x15<\xe6\xf1(\xa5v\xf4|\xc6\xb0\x0fe\x97\xe8Q\xac\xe2\x95J\xf8I\xcd\x96\x1b\xdc\xcd\x0bF\xf8\x8c\\\xb8\xd8\xc2\xaf\xfd\xd7\xf6lT\x80a\x00V\xa0\x125/\xda\x0e\xdf\xc4{\xab\x0eM\xb8\xa3\x1c\xc5t\x15=`\xd7\x87\x95\xad\x9b\xbd\x10\xf8\xbb\x9b\xdf \xe5\x82\x16\xe2-\xd9D\x94\xf6\x01\xd7M1T\xa7`\x87\xb3\xa5!\x86\xa2\xc1\x8c\x8epXH\x92\x1bB{\xc8\x7f\x85_q\x10I\x0dTI\x9a\x09\xb2NUwum\xe9\xdb\xb3\xd8l\x89\x1c\x02\x0a\x8e\x1f\xf1S\xbe\xc1\xbe\xc2\xda\xf6\xf2\"\xda\xe1W\xdf\xd4\x91\xb4\x18\x01&\x12\xe4\x9f\xdd0\x0e\x9c\xf4`\xf2\xbf\x0b\x0c\x16\xd1z\xc6D\x88\xb1\xe5\x85\x09Y\xa4\xf5-\xa2\x97\xbc\xb9\x11\x17v\xca\x9c\x97\xd1\xd6O\xe4\xbe\xd1\x101\x14\xcc1\xfa\xfd\xeeJ\xe4\xc01\xd2zH\x18\xbc\xaep\xc5-\x0fs\x1c\xcc\xe0\x1bL\x89s\xc2\xb3\x1f\xae\xca\xdf\x13\x93\x8eH\xb8/\xae\x1b\x1d\xa7S\xfb\xd0\x9c\xcd\xfc\x13\xf4\xae\xde\x00\xb3\xffH\xe6\x88\xcc\xec\xc6Oi2D\xe3E#\x18L\x91\x14\xa5g\xdb\xfb\\\xef?\xc5:\xdcUW0L\xf5\x8d\xb7T!&b\x83(\xc9}\xe2k\xa0\xdc\xb4\xeb\xeae4\xce\xe3W\xdb(K\x0d\xe0$\x98\xfc#\xbfp_\x9c 9\xfa\x98E5\x9e~%\xb4\x0f\xb3\xb9$I\xcaC\x88\x06W\xb4\xb7P\x8e\xee>\xbc<Z\xa4\xc9\x8c}\xc3I?U\xe9x\x90\x95\xf5zS\xf1\xcd\xbc\x8b|0|\xda\xc9\xf7?_\\Y\xba?\xbb\xaa\x9d\x06\xa7i\x89#\xd4}\xce\xda*<\xc7\xef\xc7/E\xe7\xb3\xb1\xdeh\xfe\x8fn_S\xaa3\xcb\xe1D\xaf\x8e\xa9\xf9\x93\"\xa1qI\xe6\x0a\xa3\xf3\x93\xef\xc7k\xe40\xb5:\xea\x0aW\x05\xb4\x04\x8b\xc1\x0d]\xc9\x8e\xb1\x80\xc6\xb5J\x84\xab\x8c\xc0Mu%\x15\xc3\xf6P \x97*\xf4|\x11\xe27\xcfp9\xe2g\xe2H\xd1Qw\xa2j\xb3@j\x89?\x8c\xf0=\xcd\x08\xa6\xe1\x1e\xa8G\xa1\x85\xfa\xa0{\xdc\xc5\xdf\xe9F\x09-4G\xfcT\xe5\x923\x9c}C\x95\x9e(`;@\xeb\xde\xc4}\x8d/\x16\x19;\xd9\xa9D\xdc\x13\x1bI&\xbb\xd4\xaf\x17\xb5\xa7\xa2\x17\xb9\x85|\x8d\x0ev9}\x04,T\xecZh\x07\x8az'\xb2\x00\x1dW$A0\xb5\xe4l\xa49K\xbd\x12\x9aQ^8 &\x1b\xe7xd\xe8\x14\xbd\x82k\xe3 \x85|F\x0bIra\xe9(\x8f\xb53\xef\xab\xee\xfa5D\x15\xf03\x0f:\xed$\xc5@\x0a|\xb6\xe1R{J\x93B\xbd\x83\xab\xa7z\xa9P\x9d\x08\xfd8\x90\xac\xf9\x81\xd2\x11\xd8u\xe90\x9d[\xd8\x9d,\xef\xe8LP\x91\xab\xc4\x01H9Q\xa4\xac\xf9.\x08\xd3\x8epP\x06\x80\xc4\xbe\x963B9\xff\xf8\x9e\x1b\xe2T\xb1r\xe8G\xd4\xff\xa7UrB\xdd2\xb8y3(\xcd-\xe6\x01\xc2\x0b\xed\xa2M\xc4.dfM\x86U\x86eK\x913\x0c\x89\xaf\x05\x92\x86\x07x\x9e]\xd7\xf8C^\x9e0>\xe3\xa8?X\xb5\xc4b\x0eP2\x82\xc6g9\xb7\xfc\xe4iZ\x1f\xa0\xe7\xe4\xe8\xd6\xe9\xa1HF\x0c\xd5\xca\xd4P|q\x83\x11\xc0\xd6\xa1\xba\xfa\x9b\x1f\xa2\x1dy\xe0\x097\xba\xcd@\xf6\x8a\xba\xb0\xe3;MM\x0a1\x1e9:\xaa\x88\x03_\x83+z\"I\xb4\xd6\x14\x89Z\x09\xdc\xb7\xa1\xddK|\x80\xc3z%\x97\xf8\xd3\xe3\x03!\xd8\x07r \xdc\xfb\xce\xc7\xd2\xfc\x13\x93\xad\xe3%\xd4\xdc\x13\x16\xc0\x98\x09\x1d\x15\x8a\x99\xf7\x8e\x89\xeb\x8f\xf2\x0f\xce#Q\xf0d\xc24\x1a\xa5\x96>\x86\xf6\xc7\xc0\xde\x8fUw\xf3wnCY\xd9\x92\xda\x8f\x1f|_tq\x0d\xd3\xdf\xb3z\xc6\x93\xaf\x07w\xc4Oz9\xcc\x8a\x195h\xba\xa9X\xb1\x92\xbc\xe0\xc3R\x8d\xdar\x8a\x19[fNd\xad#\xe7\x9d\xeb\xf4\xc8\x16\xa2k\xa9\xa7\xd3\x8a\x97S\xd5;\xb1\x84KHt\x8d\x85c\x91\xfe|\xa9N\xec%\xe3Qw&\xa6\xca\x9f\x00\x066\x85\x84\x17r'\x0dRm\xae\x1b\x81\xa2\xf4q*\xbe\xc5W\x8e\xe2pf\x1a4U\x8b9f\x14\xd7i`/I\x82.\xdd6\xd38i\xe4\xd8\x0d\xc7\xdex\x870)\x9f\x8f^\xb0\xee6\xfd\xb8AVn\x14S\x9e\x9a\x18A\xf94\xb4\xf6\x87S\x07\x17\xd7\x14\xa3\xf2'\xf5\x96p\xf0\x85o\x9d,\x98\x16N\x8e\xcd\x0f\x99\x02\x1c*\x9c\xf28\xb4\xbb\xc8]\xbdU\x83o\xa5\xb0\x85,\x90\xf1\xd7\x96\x1d\x92'/G\x15\x04\xd8v\xd79\xe6\xfd\xd7 \x0e\xdf\x8f\xa7\xfdx9d_e\xf1a\xbe\xfc\x87\x1e\x8a\x9b\x1f:(>Aw\x0f\xcd\x9bx=oo\xd1\x9d+~h\xef\xdc5^ \xfdHG\x07\xfalS\x86/\xa9(\xb6\xf7$OT\xf4\x14\xe4\xe7\xf29w)zb6/R\xb1\xf23\x94p\xe4`S\x07\x97\x13\xb4\xee\xfe\xde\xa4c0\x10#;\xec7\xb8s':\x8fRwA\xa2,;ul\xf1\x18\xb4I\x11\xf3 t7\x14\x0e\xfe\x85b\x81lD\x89-\xc0\x05<I\xad\xab\x90n\x87\xc7O\x09\xe1\x8a\xacx\xd5\x0f&'6\xd1\xc9[\xf2d\x89\x87 9\xdb\xb9If\xef\xb72\xe1^Y\xe2\xd9\xd9vH\xfc\x00\xada\x9ce\xfb\x83\x8a^LJ\xff\x9fL\x02\x92\xfef\xdc)q\x04\xd5X\x92\xfe\xa0\xc0`r\xb4\x12\xc1\x12\x86B\x0f\xb64\xaa\x96\x9a\x8d\xc6\x8b\xb3to\xb5\xc0\xe9WZ\x89\xa2\x8c \x94\xea1\xb4\xf0g\xb4H\x1d\xa3\xc2\xfe\x7f\xbc\xfd\xdb\xae$Gv&\x0c\xde\xcfSx]1\x02\x88\xc8a\x1e\x99\xa9\x1cL\"\xd9\xecA\xb1RI\x11\"\x1b\x0d\xf6\xdd\x8atg\x84m?d\xc8\xdd\x0d\x89\xcd;\x95\xaaj\xd4\xf5\x1a\x83\x06\xd5ji\x84\x92\xd4\x7f\xa3\xfaIv\xbe\xcd\x0f[\x07\xb3e\xe6'\xdb,V_0v2\x0e\xe6\xe6\xe6vX\x87o}_\x92\x9e\xfeZ>\x9fKO=\xd8\xae\xcc\xdd\xf1\x16\xc5\x90\xdd\x15k\x06.\x8d\xe1CGm\xcb\xe4g\xf9\x89GS\xbc\x13\xce\xc0\xd2\xd4ft\x9d\xa33G\x9b\xaf\x8e\x1d\xb7\x86x\xca&\x06\xf1[{\x81\xb6\x85\x92\xc3AJ\xce[ZR\x86\x1c\xd5\xa2\x9d=FPR\xfeF_\x19G\xfbg\xb7\x93\x97\xc16\xcf#\xae\x99\xdf\xde\xfd\xd3\xdd\x8fw\xff\xf2\xf17\xdbl33GSP+\xa4\x82\xf1P\x8f\xfe\x01:3\xea\xbc\xc5\x0d\xa6\xe2\xce\x96\xe0\xc8u\xe0$\xf6\xfe'r\x1a\xf9,\x11\xeb\xa8s\xca\xad\xd1\xe1\x0d\x19L5\"\xd2\x81\xe1=4\x05\x86\x90#\xdf\x17-\xdd\x95\xd3\xf5\xado \xccX\x06Yl\xa3y\xfejf#\xff\xe1\xe26\xb4\xc2\x0ccE\xa2uqV\xf3P\xec\x14p5|Kt\xd1\xcc\xc9\xe2@\xf0\x0e\xbe\xa7Y\xec\xbaK\xdf\xd5\xb1\x15s\xb2>\xe2\xf1\xe5\xc9\x1e\xc3\xff\xcf\xfa\xab\xf1\x83i\xe0b\xfaZEV\x96T\x04\xf9\x9d#\xff%\xd3\xe1\x1d*\xad\xb3\xcf\x15\x9e}k\xdd\xba%\x9b\x1a#,\x8b\x99m\xd1\xc4\x01\xf8>\xde+h\xff\xd8F-m\x8e\xbdO\xcd\xa9\x18\x82{\xa3\xa0\xc5\xe5\xbe\x0f=\x82s0F=\xa1\xae\xe0{d\x83f\x87\xa7\xa7P\x93\xec\xf1<\xeb\xcb\xaaf\xd9+\xea\xc7\xc9R\xb9.\xf9\x81B\x7f\x87f\x05u\x89\xb4\x8d\xfbw\xee\x1e\xfbX\xb8\x92\xd7\xbe\xc8\xfd\xd4\x9a\xba\xbbLA@?5\x1f\xec}\xa2\xcfSg&.N\xa1\x8c\xae\xbb\x9b\xfd\xc2\xfd;\xe7\x07!gD\xe8\xd7\xb0\xfe\x84\xed\xedx\xc4\xd7x\x18\x98\xceEn\x1c\x8f\xf6\xc9\xd5\x11\x07\xccWr7\xab\x07\xfb\x84O\x14G\xbb\xea\xa0u\xce\xbb\x15\xf5\xad\xd6\x0c\xb5\xe9\xe2\xe1\\A\x9d\xcc\x84,i\x9a\x8a\xee\x9c\xadm{\xc4W\x1f\xdb\x88\xc9\x8b)\x92\xe8\xd3m\x09t\xffZ\x95\xe6\xb2\x0d\xc5Z\x8f7{[\x99v\xc8\xd90\xab\xd7\xf6\x13o\xe8b:\xd2F;y\xa9\xee\xa5\xe4D\xaa\x11\x9a\xb4\xb1\x1a\x94\xf7^\xa0r\x1a\xd1_\x14G\xf9<\x93f\x0a\xc3\x15\x8e\x06w\xad:\x0796I;\xfb|\xaa*\xd5\x96\xb0^$@I\x01\x08\xf4$u\\\x8a\x7f\xbf\xc1\xe7\xa2\xfc>_\xf6\xbcC\xa3w\x84\xd1\xc5\xe6\xa0\x11Y6\x9a>~\xdfN\x87\xb7\xb1W\x1f\x0a\x9a@\xfa\xba[\xa8\x073\xd5\xcf\xdb\xc6\xad!x\xc6WD\x04\xe9\x86\xc3z>&\xdd<\xa6\x10\xda\xd2xx\x8e\xfb\xd0%\x1bP\x06\x8c\x8cN\x18\x0c\xc8\xa16\x9a3\xcf\xb2\x83\x8a\x9bqh\xa3\xae\x04yO\xb7M&\xd9N7G\xcd\xec\x8b\xc6\xb4\xab\xedt\xd0N\x9a\xa1\x00\xde\xa4\xb1\xe2\xc6\xb6~KE\xe9Af'\xf2eW\x82\xb3A\x9a\x94\xf8r\x02\x1cNB\x1c\x8c\xd0t\xd6c\xab\x09\xb1\x1e\xc4\x00TA\xb7\xd0\xa4\xbb\xd8\xa9d\xa5\x82\xac\x90p\xe5\xd6\x1c\\\xb2\\\xda{\x83m\x9f'z\xa9\xaa\x9a6L\x9d9\xce\x00\xb2h5\x03\x0c\xb9\x9c3\xd3bz\xdc\xca\xf9\xbaS\xdc\x10\xfb\x82\x1a\x13\x91\xb2\x89S\xbb\xd0:\xfb\x9d\x12\x9dC\xa6#\xd7\xf7\xd6\x0e^\x1d\xae\xb4\xfd\x85\xca\x0d\x82\x1fE\xd3F\x18\x03\xe5\x91ja\\,6\x0f]\xf0\x06\x0ecS\xc5\xb9\x0aQ\xb3\xaa7\xfc)\xd9\xe0\xb6\x0e;\xaf\x1c\xe6\xa3\x94j\xd3cc\xffk\xea\xc4G\xb8!\x8e@kO\\@\x11o\x19\xae\x97FNqW\xad\xcdHR\xa1'8\xd5\x11\x82\xd9} \xf8\x17G\x90B\x8d\x15\xff\xc1\xd6\xf6*\x95\x18r\xc5X\xc7@\xb3\xeb \x16\x97a\xc1,z\xa0\xab\xe5*G\x03V\x1f\xf15I\xffoC0So\xda'\xf6\xe5\x91\x85\x8a\xaf\x95\x997\xd1\xb6\xe3\xef\xd4*\xd5\xe5\xde\\\x9c:~^-N!\xdf \xf9@q\x9buu\x82\x1b#H}t\xf6\xf9WK\xae\xffm\x98\x80t:y\x16\x08\x8d\xb3\xce\x00sF\x9b\xc4\xdc\xc2\xd1\xbe)\x02\x86\x08\x8ar\x10Ci1\x96\xc1&\xabO\x17x\xc0\x9c\xbe\x8a\xfb\x92wT\xbc\xb5f\xcb\x0b\xf4\xb1\xe7\x1d\xed\x85\x87T3\xc7\xfc`\xba)P#\x00K\xc9r\xef\xd6\xba\x8bC\xfd\x01\xea5=\xf0\x87\x0f7\xe6\x9d\x9fv)\xa8\xaf\xb5\x01\x9f\xd4#\x90\xf7|\xa4?\x1e\xb4U\xb4p\xd3\x98\x81\xbe%\x1aB\xf8\x15\x9e5q4G\x1f\x18EM\xe0\xe0s\x88\xd5\xc4\x1b\x97\xef\x8e\xea\x85D\xeaj\x0fw\xe3\x8b\x9e\xfcg\x87\xf9k\x06\x02\xd6\xed\x80Z\xbb\xc0'C\xe6Ua\x1a<C\xf8@\xc2\xc7\xe0\x8e\xc7+\x08\xcav\xce\xea\xf9\xa9\x98\xd6G?\xcb\xaeQ_\xec`\x07\x99y\xb8\xc4y~E\xe2\x98\x1e\xc4=TeU\xbb@\x91\x0eB\xb2\xeb\xb1W\xd8\xe3hQ<(\xbeK#XZ|\x9cK\xe0\x82P5qZ\xbf\x9c\xd4H*\xbc\xec.l\xcc\xa5\xe1N\xe1\x89\xba\x94e\xfa\x13\x02s\x0fS\x85u\xeaRPm\xd9y\x01%\x1e\x07]\xcd\xa7\x02\x952x\xc1\xf9\x98}&\xfaa\xbc\"'Q\x8f\x04\xed\x1c-[\xdc\x12\xd5\x8d\xe3Qx\xc9b\x04{\xf2\xd5\xac\xae+\x94\x93\xc0\xce\xe0iu\x81\x06\xc6C1\xda\x0e\xe4\xbd\x1f\xa0\xc6\xb7\x00e\xb8W\x9c\xc5\x97?\x9f?\xfe\xf0\xc9\x96%\xbf\x88\x92\x09\xe1\x17T\xb7\xe7\xd5\xd8\xda\xbe\x86\x80p kd\xacZ\xe8^\x15:b\x82\xbb\xba\xf5\xe5\xd8\xaa\xe6\x92\xe7#}(i\x90\xe8;:`\xa4\xe0\xd2T.\x1fxb\xac\xd0\xea\x9e\xde_\xa8\xaaYN\x0ee\xfal\xd4\xd0\x07\xf8c\xfc\x94\xbb\xdb\x08\xcb\xc0\x0d\x06\xe0\x07{\xe2\x88\xff8\xc4\x8b\x02mn\xb4R\\S?\x91\xd0\xf3aJv\x1e=\x147\x10G\xfa\xa3\xf8\xd6\xf0Q" +
+	"\xb8\xd4\xf0\x00&\xce\x90`\x8e\xc2%\x9d\x822\x9b\x1bpUe\xf1R\x16\x96\x19\xfd\xaf\xbc\xcf\x1en;\xc7\xa7\x0e \xbe_\x02\xc1\x82\xba2 ~\x98#W\x07\xd0\x850B\x9d\xe2;\xff\x94qN\xec\x83E\xdd\x1b\xea\x0e\x07j\xdf\xce\x82\xa9\xd8|<h\xbb\x7f\x8aY\xdfF\xec)\xcd|t\xa8v\x14\x1c\xd8\x87Ao\xedl\x8c@\x0f~\x98\xba\xca\x0e\xdbE\x89\x04Z\x0b\xad\x1dn{#\xbb\xbd\x0f\xb0,4\xd3\xda\x97\x89[\xe3\x9e\x90\xfb\xa2n9-\x86\xa0\x05\xbd\xe3\xb4\xf5\xde9\x97\xab 2j&\x85r^\x19\xde\x96\x01\xf1\xfb\x86\xcc@\x9d\x15\x86\x01L\x843Us[\xde\xb6\xd7\x09\xb4\xf6e\x82\x9c\xf3\xdb\x05[\x9a\x9b\xf7\x92\xfe`\xd2\x90\x04g\xed\xd9d\xc0\xef\xb6\xe0\x9c\xab\x91\xb5\xc0m\xb8P\xc0/\x14jf\xad\xe8\xf7\xe1\xc2\xe6U\x8dpUF\xdc\xeb\xdaN\x00\x03ol\x88\xeeAw\x96\xf2\x82\x9f\x0b#\xf0(&Q\x10^0\xd9R\x8c:S\x02\xd9zt\x1a\xe2\x82c\xf4\xdd\xa1\x18\x08\x07\x83\xf5\x00\x83\xb9\x1c)[\xbdH\x0e\xb61\xfaDX\xe66\x9a\xf7\xe9\x91\x15\xc8\x15\x99X-lZ\xf8\xbf\x03\xd8\x12z8\xf2_|\x93\xfc\x0eg\xc7\x9d\x03B4\xde\x99\x16b\x1e8\xf8\x82\xd6\xc5\xe5\x1e\xe5\xf1\xfd\xae\xd7\xe9\x03/F\x1a8\xf8d4\x819\xea %g!\xfa\\\x1a\x9ck\x1c\xa7\xf4\xdbb\xa4\xe8\x92]\x0c\xb4\xb8\xab\xf2\x88c\xbe>\xe0\xb5\xc4\x1f\x0b;\x16\x07\xba\x00\x91\x0f0\x84\xaf\xee\x1a\xe3\xb69\x9f}\xde\xd3\x1d\xbc\x9d_\xfc|\x0el,\xfd\x8b\xf9\xe1d\xb1_\xcaM\x8d\xd7\xbd\xaf[\xca\xc8s\xbeHH\x05\xfe\xfd\xee\x0f[\x19\xceo+\xe6z\x99#\x09X\x89\x93\xba \xa9/\x82\xff\x19y\x02\x92\x9a\xd4\xe0\x86L\xe3\xea!\xbf\x889\xf1\x9a\x81X\x84\xdd\xbb:9\x0b\xfe\x1bE \x07\xa0\xe2\x93\xb3\x1dL\x9fP\xde=(bn\x15:\xb9\x0e3G\x97/\x8e\xd7\xd3\x06\xa9&\xe4\xdb\xa7\xaa\x1b\xab\xee|\xe4\xbfa\xcd\x10\x06\xac\xc5K^\xa0\xd3+\x07\xcfrL\x15\xec\xfd\xd9\x12\xea\x0fq\x93\x1a\x19 \x10\xc7Vw\x98\xb4\xf1u\xd0\xd0\xcb\x10\xca\xad\xe0\xb7\x06\xfc\xed\x91\xfe\xc4\xc9\xe4\xa6j\xaf\x92\x7fC7gn\xc1\xbfT\xc01;tT\x1c\x8a\xb2CG|Mv\x00\xc6\x01\xc4\x11\xbd\xa2\x0c\x9fL\x0b\xac8A\x86\xb5m\x0d\xf9\x9cZ[\xc5[_\xee\xcd\xc0<x\x98'K\x0d\xa0\x81\x0f\x80\x88\x85\x0cF\x08`*\x15\x1c7\xc6\xfaV\x1d\x14\xf3\x07\x99\x9eG\x93-E\x970\x87\x0c\x04\x1a\x89!\xb4\x8e@k\x95=\x0a\x9f\xf0\x11CV\xe5\x06\xf6\xfeIP;Y\xc0\xd7\xf2m\xcc1\"\xaf\x9eI\xae\xee $L7\x8e\xaf\x99CZ\xfb#\x05ORlw3K\xf8\xfa\xca\xac\x10\x8a\xf3\xb1:\xf9\x8c\x06\xf1\xd6\x92\x0a\xd9\xae5\x8d\xa9\xa7;\xc4\x9e\x8e\xc6\xd0\xc0\xc9p\x1d\xcb\x88,\x8f{\x09\x15\x05}\xca\xf7\xf5{\xaa\xda\xa5\xdc/\x19\xd28\xf7$\x86\x98\x06\xec\xa4\x0e\x0e\xc7\xf7\x0b\xa3\xb2\xecm@oq~r\x06\xc6\xb5\x0e\xbb\x8a \x8d #B\xb5\x0a=\xb4(\xf6Y\xec\xbe\x07s4{\x1d\x97\x0a\x16\x7f\x0a\xaab\xc8\xee%\xb8@{?c\xf1\x9e\xfd\x1a\xf3\xf9p\x8f\x8dR\x9cS=\xb0,PU\xbf\xef\x8b\xda\x12\x0b\xd1\x04c\xce\x9f\xdbnt\x9f\xfb\xaaF\x15\x03\x8e\x86\x09\xf3\xe0\xd0M;\x1d\xe1\xab\xc3\xacXA\x0c\xfdDn\x98\xcf&\xd3\xef\xe7\x1el9\xd4a\x00\xd6IA\xaf\xa5\xb4uQ\xbf\x1f\xe1\xe8^\xe8I\xf0\x8c\xa3\x07\xc2\xf9x\xfa\x1f\xbc\xc0\x81lU\xb7\xb4N\xce\x14\x0d\x06\xe1-\x8c\xa6=\xc4\xdb+\x86\xa9\x16B\x99\xb8\xcf_\x19\xf2\xa6B\xe3\xd4o\xf7\xf8\x03z\xbe*A\x9f\xbc\xf1\x9a\xbb\xe51\x8b\x91?\x0f\x8a\xd7\x13T<\xdd\x82NA1e\xa5i\xc1#\x9d\x142\x8c\xb2fR\x10\x19~\x80E\xaa\x0d\x05\xb2\xd6S\x0e\xb3>\x04\xc6D\xa2\xcdi\xcd\x01\x0f\xfe\xf7\xaf\x1c\x95\xb9\x06F|\x0f\xb5\xb0a\x9d\xaa\xfebn\xfa\x80lq\x07Dd\x97`\xdcC\x08\xcb\xd2\x19\xe2\x8ft\xdaR\xf6\x01k\xca\xc87U\xc9#\x1c\xb2\xbe\x8e'\xf1\xbc\x09\x9e=\xbb\xado\xb8\x01\x1bDY\xab\x85r\xe1\x94l\xc9\xa5r3\xfa-\x94\xc6S\xd4\x8b\xcdI\x98\xd1\xd7\xddpy\xdf\x87P\xf2\xae\xae\xc8\xce\xc1\xe0.\x8f\x86\x03!\xde\x98\xde\x09\xa4\xf9\xf7\xe4\x87\x9f\xb8!2\xdd\x08\xe6\x93\"\xba\x07y\x10\x89\xb1\x14\xda\x8aby\xbe=\x8f\x9f\x84\xde\xba\x1f\xa3\x96\xcf\xac\x07\xa4\xf7\xa5\xe3\xb4\xf6c\xf9\xc2i\x8c\xe4lG \x90OTv\xae\xef\x9a\xd5\xa7&9R\xed6zC\x92\x92\xb7\x83\x1dP\xd7\x00\xef2BZz\xaaL\x9a\x97\x15\x96{peI\x17W2\xfa\xf9\x91\x8c-y%?Q\xc9\x8c\x1ep\xa8\x88M\x07\x88\"\xcd\xf4<\x0e*\xd4T\xbe\xf7E\xf7\xdf\x11\xfb\x9a[TH\xb9\xdc\x1b\xaa\x19kB\x1d~7q\x8b\xb50\x81\x9e\x84L\x05I\xf2\x01\xb4RMZ\xfd\xfc\x1f\xbbs\x0d\x9c]\x15[\xd9\x99\x03\xb5\x89\xe7F\xcdP^\x9a\x19K)\xa6\x97E|\x03\xd1\xee\xc4\x97\xdaf\xbe\xd9*0\x7f8\x9b\xc7P\xa9\x95\xb9h\xbb\xd0\"Hw5wE:\xa0\xfchn\xd7\xdd\xb2Y\x1e\xea\x90\xe1\xe0=R\x92un@\x02Z\x99<-F\xa5Ht\xb1&\x9a\xf7\x06Z8\xba\x17\xd7\x9e\xe2y\xbf\xc2h/\x11\xc4\xd8\xb5G\x87'2\xdf\xb9\x85\x81\xc9\xa3\x803\xd4\xdd!~\x1b\xc3\xa1\xa8\xa0\x96AUXl\x0fy\x8e\x0d\x0d\xca\xf5\x88&\xf2\x02\xe7\x0cn\xed\x18lG,\x1f\xcd\xc6\xf5n\",\x98\x1b\xbd\xc3\xec#\xa4\xfc\xb4\x1f\x97x0\xa3\xe8\xc7\xb0\xdc<\x8fF\xc8\xa6FX[\x1e\x18:l:\x97%ZlJ}k\xd2\x18\xd7 IeYC\xfb\x92\xafcy9\x81\xd7\xc4W'\xbfZ\x05\x9d\xb2\x84\x08Sn\xfc\xe9\x0e\xcf\x1b\x83A\xa7\xd8\x04\xc2$\xb6)\xa6\x0e\x17{\x021\xdf@\x88*j\x1bz4\x19\xf9\xb1\xb73w\xdb\xf2\xf4T}\x16\xb5\x00|\xb4$Ib\x15\xcc\x80\xbe\x93\x84Ed}K\x88\x02\xbd%\x8c\xad5\x06\xa1,\xa7\xf7\xf5\x83\xe2\xeb\x00\x15\xe1u=Tj\xf6\xc8-J`\xb8:\xc1\xc8q\x14w\xa4\xa8\x83D\xfa\xe6\xd3az\x9c\xdd\x93\xaf\xde\xe1\x8e\xbc>\xb0sH\xed\x9f<\xca\xde\xdb\xdc\xbd\xd5-\xedg).\xbe\x83\x8b-EB\x1a-\x95\x06ZX\xc2\x95\xf6\x98N\x80\xda\x9c$\xed&\xd6\xbe\xc0&\xf5\xea\x9b\xcf\xe6\xad\x03jC.n\xf7\xb9\xa5Z\x83\xa5- \xbd\xad\xdd\x89~\xb0/\x86\x0b\xe5\x1c\x18^Z\x1aHw|\xce\xcdy\xc2 \xb7\xc9\xcf\xe2.F\x18kh\xfc\x0fh\xefa\xc6\xa8\xefM\xcf\xdf2\x13\xf6\x9ch\x19\x02\xf1\x9e7\xd5U\xac\x1dU\x8d;7\x05\xdc\x0fpqF\xd6\xfe\x81\x8eV\xaa\xaar\xefA\xbbuT\xc6\x8a\xca2\xa5\xc8@Q\xd5\x7f\x0b\x9c\x06\"\x9c\x8e\xa1\xec}\x08_K\xc6o9.\xad\xc1\xdb\xa2\xb981\x1a~\x80\xc6\xb4\x19\x99\xc1\xb5\xea\xac\x8dJ\xac\x80\x01\xe1Rd\xb65\xb5\x95\x90d\x8dK\xc3\xb3\xd9\xdd:\x9d\xae\x98\x1e\xa9\x86\xf7u\xb1\x13=\x93\x97\x021\x9b\xbd\xf6a\x11\xba\xf1'\xa0\x0b\"\x07%A\xc7-\xb2\xd7`r/\xee\x1a\xa6\x01\x15\xb1\xdd4\x19\x18[\xf8l\x92o\xbaW\x1b\xc5v\x9fF\xe0\x08\xda][\xf0i\xe4\x94\x93#\xa4\\\xd2\xcf\x89\x00\xfb\xe5\xe2'+\xfe_\x9auXN\xf2\x91\xd2\xc5P\xa1\x07\xe11\xf3\x81\xf7G\xd1\xfe\x8bEV\x9d,\\Xt\xfa\x10\xac,\x1b8\xf6\xa88X\x98\x8d\xc6\xe8t\xd0`O\x1f\xaeV\xc5\x9d\xaaX\xeaZ\xf5.9\xdd\x02tG\xf5ow\xdf\xd4O7{%\xe6%\xea\xf4Q\x91\xf4\xa9\xea\xbf\xc7Z\xf8\x0c!W\xca]\xe0\xe5\xe9\x00#O\x92\x88\\\x06\x04[\x17_\x188\x88\x86\xba\x9f\xdf8\xe6\xe7\x0b\x98S\xe0q\xc6\xc2\x82\xc3\\z\xa5\xb5=\\\xe6\xbc\x81\x0c\xd6\x84\xfc>\xfe5\xdc\xf8\x9e\xba\xab|c\xdf\x99\xe8\xff\xab\x1b\xb8\x8cU\xafo\x87B\x14\xee\xb9\xbc\xa9\xa0\xa5`R\xf8\xc5[_\xf5z\x98\xa6\x8b\xf4[o\xacz\xa3\xc2\xe8\xae\xc7z\xbf\xa9\xe0l\x09U\xe3\xbb\xe5w\xb19]\xdf\xaa\x1f\xaa\xda\"\x1c9C\xfdv:>o\x03\xa1\x85\xbe\xd32z\xa3=U\x14\x99\xb3W\xd5qN?\xbc\x1eZ\x00\xdb\x14\xbf\xb4C\xe7\"\xeaI\xb2\x8d8V\x83o>OH\xfc\x93\xd2l\x8b\xa9\xc2g\x9f\xeaT\xe1\xc7\xdf~\xfc\xed\xdd?\xde\xfd\xf3\xc7\xdfm\x97D\xae\x9f0\x13$\xb2\x10\x15\xa2\x09\xe9\xb2\xfb%!J\xecEk\xbaW-tG|\xa5\xc3Gm\xf6$\x141\x13L9a\xa5\xc1\x91\xfe\xe8\"U\xb6\x12z\x18 \xaa!\x7f\xe9kT\xe6lH:\xd1\x0c\x95\xf1\xcd3\xa5\xad\xe2\xe6\x9d\x03KA\xcd\x92\xf1\x81\xf8\xde\x9e\x8f,\x05\x0f\x9c\xab\xca:\x04J\xe0_y?T;\x8a\xec\xb9\xf92\x80\x1bs\x81\xd2M\x16\x0c\xda\xfa\x81M\xeb\xd2\xdd\x07\xbb\xe4\xa1x\xb07\x1b\x1e{6\xd7\x93\xea\x8d\xa1z\x07=x\x1c\xf5\xd2xO\x90\xcd\x0b\xa3\xff\x00%m\xc8\x9dZ\xe4u\x1d\x1c\xa0\xdc\xc1\xfa98\x1a\x7f*\x84\xf5SZ\x92\x84@\xd7Wz\xd6V\xc4\xe8\xa6 \xd1\xd23-\x8fUO\xc2\x8a\x0a}\xc6\x16Ao\x87\xed\x9c\xf5\xafb\x17\x17\xdc\x1c\xba\xb2\xc9\x9ax\xb9\xce\x0a2\x1d\x17K\x12S\x87[\x0b\x1a\x10\xdbVh\xfc\xd6!O\xea\xdemL\xa9aziYFw\x0b\xb5\x19\xcd\xae\xb5qh\x11C\x97QM\xe0\x8e\xe1\xf5{&A\xdc\xcc\x89\xbe\x81\xde\x9c$\xcd\x10\xe3\xe5Z\xcb\xb1\xc7 \xb5\xc7\xc09\xcd?\xd2\xb2@\xe6/\xdd\xe8\xbe1\xd0\"*Z\xd5\x0c\x19\x0a\xc6\xc49\x83\x9f\x87\xff\xfd\xc9\xac\xafO\x8f\x9d\xb8\x93\x084\xdb@\xa99\xe8\xa2~k\x86\x90\xb9\x9dO\x13|\x96\x06^&\xa5z\x89;\x85N*}\xb4\xa6\xce\x96z@\xa1v\xdc\xfd_xk\xba~\xe0\"\xc78\xef,\xd8\"ml\x08\x15\xc4\xe7\x0e\xdd\x9e\xe7\x1b\x0d\x06\xae-\xf5\x04L (KjEpS|g\xf0p\xc2\x1a\x87(\x08G\xdb\xf5\xf2F\x87\x09\"\x87\x8b\xa1\xc0D\xa8\xa0\x96Q\xe6/#\xd4\xa9\xbb]v\x12e\xf2\xb8<p\xad\x88\xce\xdc\x93\x0bh^\xf7M\x9a\x94\x9e9\xf7}\x03\xb5\xe4\x01\xafV\xb3\x86\x06]d\xec\xaa\xc4\xf7v\xd2\xb5TU\xef;1\x9bp\xbc.\xa0LP\x0a\xe0\xfaP\xb4*\xce _k\xeb\xbbV\xd6\xda\xda7\xf3HF}\x8cc\xa6\xd3\x87ix[q\xf5;0\x0a\x0c\xe0\xe6J\xf1\xbd\x19;\xa9\x98\x9aaR\xfdB\x9e\x80\xf1\xbd\xbb\xd5\x17z0\x17\xdf\xce\xb6g6\xeep^\xd8L\x1b\x0b\xa8\x89\xa4\xd7\xb1mY\x16\xc9}\xc9\xad\x80\xb9\xe5\xff\xd23R\xcf\x13\xc7!\xea\x18z\xb8\x16;\xe2[\xd9+wz\xa7T\x7f\xb0/{\xde\x00E\xfd{\x83[\xeb\xb0\xc5\x9e\x154g\xea\xfb\xb1g\x85@\x87-\xe1rD\xea5\x1d\xc26\xed\x89*~\xf5\xf1\xad\xcaa\x16\xb0\x8dd\xf0\xb9\x8d\xc2L\x98f\xf4v\xce\x91\x95`\xe8\xffLd*\xcf\x13\xd0\xa9HuA\xef{\x17\x17Q\xe2\x93.\x85}\xb6\xb6\x05\x94\xa6YI\xdc\xf8:\x0c\x8a\xbe\x06\xab\x0e\xcex\x02x\xcea.\x1e\xf0fa\xd8\xb6\xbb\xea\xec\xf6D;\x9b\x8c\xf6Tps\x87\x8c\xef\xe0vRv\xa6\xe9\x0b\xabb\xe8\xc1\xd0\xc8}\xb6Ej\xf8\x00]kg\xa1T\xcb7\xbdr\x9f\x02\xac\xe4\xac\x09+\x855\xe0\xb0\xe4\xaa`b0}k\xa7\x0bb\x1ar\x89:\xea\xe52WC-\x1b\x91\xb3O\xf3\xfc\x1a_\x88\x06\xe3\\j\x99NI\xe2\xbb=\xfa\x7f%m`o\x93J+{\x83G\xb2\xfbB\xc8v\x0bVub,K\xdd\xa5\xfc \xe0\xf2\x92\x8a\xa1T\xe27\x020\xee\x94_\xb1\x97\xeeq\x13\xb1\xa7\xa0\x14o\"\xcb\xd3\x11\xc9A\xeb@\xb7\xc4\xf8\xe8>\xda\xcf\x1d\x0bou=\xc5\x05\x1ah\x16N\x10_\x0d=\xff\x89n\xc0-.\x1d/\xf7\xb0\x0f\x13i\x9dr\x1f\x89\x86Dc\x9c.\xd0\xcfT\xbe \xa5\xb0.\xb2G\x11\x7f\x18a\x107\xf6\x83\xe9\xe4\x92q\x9d\x1d\xfe4\xf1N\xf12\xbel?\x19VDAQ\xc6\xe3,\xf4~\xc5\x88\xa5\xe2h\x0f}0\x9d\xd2]\x9c\x9bX82/\x93\xb0\xadh\xdf\x8e0\xbd\xcb\xd0\xd1\xf4W\x01\xd29\xff\xcbd|T\xb0Z\x11\x85\x84\xbao\x02F\x12\x16\xc5lX\xf4~z\xde\x07)\x1cR\xda\x1e\xac9T\xc2CCd\x07H9s\xa4?\xad\x95T\xe2\x04z\xc7Y\x09\x8c\xf1\x86h\x05\x09\x83\x87\xe7(\xf7\x1eO\xaei\xf08\\\xb9c>1\x1a\xca\x81\xd9\xc8\xd7\xe7\xdcbd\xfe\xbb\xb9B\xe9-\x85\x9f\xb8R\xf4+8\x99\xc9\x0e\x96\xbdo\xa9}\x9a\x0b\x17Q\xdf\x85\xe24\x879\xe21I\xc9\xdeJ\xd8M\x1c\x918c\xfb\xce\xf6&z\xefT\xf5?\x98\x0e\x92\xef\xb9\x80\x80\xbd\x04\x9f\xb3\xbb\x8d\xbf\x11J\xd7\x871\x8e\xfc)\x8a@\x9e\x87\xca\x9f\xae\xa1\x8e\xea\x18\xe2\xbeq\x1d\xbc\xf1\xe9UR\xfa\x9a\x08\xc3x\xc0\x9a\x1e\x14\xdd\xac\xb8'\xee+\xe2\xa1D\xcfWF\x97v\xb29\xab\xe0\xe7\xa8b\xbdW\xd0N\xce\xdc+g\xeb\xdb\xe5J\xcb\x07\xb3\x00\x07\x9a\xe4W\xa4\xd9\x84A\xa1\xa4\xc8,\xf0\xd0\xe6%\xba\x1e\xda\xf7c\x94\x0e\xb9p\x98\xc5\xb6'\xdb\x13\x0d\x13_ #\xdc\xf90\xaa\x8c\xf8\x9f\x1f\xff\xf6O\xae\x8b\x08(\xd4iq\xc4\xec\xe7\x7f\xae\x8a\x89\xff\x0c\x97\xd5\xa7[W\xddD=xd\xf6.E\xb0\xae8Q$\xaa\xf2j36\xf4\x1akJI#\xda\x87\x05\x84\xbf\x9e\xc6\x8a6\x88\xf4\xd2\x11^n\xe6\xd2\xf7\x0c\xe8\xcc\x19\xd0\xc1\x9fB\xd3\xb5(\x0d\x85J\xbf\xba\x95\x99t\x82\xde\x0cA\x06\xa3\x87\xd1\xba\xfd\x1a\xdc\x01\x06\x91\xd5I\x19C\x1a\xad\x13tg\xdb\xa9\x9f\x0dv\xb0]Q\xbf\xaf\xdfg\x90_\xb9\xcd~g|\xf9&\x87\x8a\xde\xdaa6P\xa4\x02 n\x09\xbb\xb7j\x8c;+\xf52\x1f\xfc\xab=\xb2\"\xd2\xda\x8bL\xc0I\xea\\@\xc6#\xeeo\x1a\xc6\xac\x94j\xa2\xec~\xc0kh\x0eh\x1f*\xdf\x1f\xfc\xd3h\xf1\x0b\xae3Q\x11I9\xa1\xc0\xa6\xdau\xcf\x8b\xab\x06 ']\xfal}l\x1d\x87\xad9u\x96)lS\xf4\x81\x1b\xd7\xcf\xa13\xc5\x97C\x0f\xa69\xdco\x88\x0e\x91>\x06\x95 1 \xc4=\x0e\x0c\x1f\x14\xdf\x82\xc5b\xc1R3^\\\xe0\x04\xbd'\x10\x17yY:\x9d12!6\x0d%\x15\x14\xf9A\x04\x9d\xa4\xabt\xe7\xa2\xe3\x00\xdc\xebK\x0be\xb1\xf3\xf1y\x89bE\x0f\x91\x08\x80\xc9\xe6V\xe6@R\xc0\xb3\xce\x91}H\x02\x9c2\xa0_\x06y\xf7\xc1\\L\xaf~\xb2\x11\xc7\xf9L?\xc8!\xd2m#W\x08}\xb9D\xb4n\x8e\xa9\x97\x8d\x81D\x8a\x88\xd6FQ\x1a(\x90n\xd0O\xb4/\x87\x06\xdaW3\xd4\xec\xf3\xf3Ro\xb5\xeb\xde\xe5\xf3\x04\xc4E\xd1x\xb70J*\xaed\xf6Y\xba\xaa\x8c\xbam\xec\xe8\xca(\xae\xee\xe9\xdc\xaa\xfa\x8c\xa8zbwc\x87\xb1\xb7{\xb2\xb4\x1c\xbb`\x07\xa1I*\xc8q\xf6\xa4\x9d9Zi\xce\xbe3\xdd\xed\xd6#Y\xd2\x8a$\x0em%\x0e\x1a\xcf\x98\x88\xe2\x9a\"\x05\x01V\xee\xde\xe8\x99UW\xd2%\x9d\x10\x86\xaaZ\x0d\xfc\xdd\x11_\x8b\xb6\x1a\xeaP\x18+%\xe9\xfeF\xfed\xff~\xb0u8\"1h\x8c\xfd\x8f\xa8>;\x03g\x08\xb9@\x0e'\xdfR\x90\xaff}\x0a\xe1\x01\x08\xf8\xadW\xdb\x9e\x8c\x84\x193I\x1b%h\x15\xe7\x00\xe3Y\x14\xf4Ln\xcc\x07t\xbfX\xbc\"\x91[\xf4\xf5\x147JEp\x9b\x9e\xc2[\x1f_\xcd\x12\xec\x08W\xb2s\xa2\x8f\xee\xc5\xe7\xb6D\xd8\x16\xcfz\xaf\xff\xddup\xc9\xad\xf1})\x09Vi\x0a{\xed\xb6{a\xdf\xe2\xaa\x10\x15\xcf-M|\xa9O^\x97\xdd\xa66`\x86]\x8d'\x0e\x94d\xb9\xedcrbL\xc1\xa8\xd4\x0cZ&\xf2\xa4uu\xf4\xa4\xa0\xa3\x8d\xd4UP0\xbf\xd8\x89\"\x18\xd7,\xf8\xb4\xb8\xa0\xd8" +
+	"\x95\xa0\xe2\x06[x\x06\xe8t\xcb_(\x83`\xe05Q\x01\x88)XZ\xe8\xa6'\xb07\xea\xb5\xb9se*\xf8\xa3\xfc\xc3\xeb\x85\xa0S\xe5\xf3@\xd1\xb1 \xf3\x8d\xebA\xa9'G\xf9Gm\x15aGu\x06\x9c,\xba\x97b\xe0|\x9d^[]X\x90\xab\xfa\x88s\xc8!\xe6\xf5\x88\xafH\xad\xeb\xf4\x11\xc7\x17\x86\xea\x1c=neq\xf8\x05O(l\xff=\x9c?\x1c\xe8ig\x18\xe1Y\x01b\xe3a\xab\xbaTD`\xe0\xab?Q4\x1f\x88\x0c\xe3\\\x9a\x03r\xca\xcf\x9f=\x8a\x9c\xac\x7f\xfa\xf8\xdb\xbb?\xe6Plw\xd0\x8d\x06\x06\xf8\x09U\xe8p\x9d\xfaX\x0f6\x91K?k\x81:\x1e\x84\x13\x8b\xf5dG(.\xb6\xb7\xdf'v\\\x9a\x199\x842Hx\x17\xebrMi[\xd1\xf2\x1f\xec;\x13\x15\x8d\xf3\x8e\xcb\xfc\xc9\xfcv\x90%\xf9\xa5\xa9[g/\xbc\x1e\x8aol\xd7y}\xffY\xca\xeb \xe0\xa7\xc1'\xbe\x9e\xa8\x1a\x82\xea\x08\x19\x81\x9b.\"n\xd67X/7\x19%\x9c\xe2\xb8\x1et\x19\x09v\x01C\xec\xf6D{\xb2\xb7.B\xbc\xf7g\xf1\xa1\x03\xeaK\x855\xff4u'\xed\xde\xfc$u'\xef)~\xad\xe0\x8a3\xf4sWSs\x96\xce\x0bp\x88\x9f1\xad\x94\xe0\xc4\x95\xfb\x89\xfb\xa2\xa7\xae$\xbf\xb6\xae\x9a\xaa\x04\xa3f\xe2\x07b\xd5<\x1d\xf1U<\xdd\x134\x0f\x0a\xe7\xf2\xe3\xf6\xed \xedD\xba\x18\xfa\x19\x1e\xed\xbc\xd2\xdck\xe1\xba\x88\x85\x90\xb8{\x1b^\xe0\xba\xad\xfd,\xe0UF\xe6\xa9\xd4.\xdel\xf2\x16:;\xf2\x96M\xe0\xfe\xc4\x0c*\xa1\xfe\x00\xa1\x98g\x98\xd4\x8b\x0cp\xc3![\xca\x95r\xc8\x83h\x93\x08Nz\xe4\xbfa\xc6K&\x08z\x8c\x95\xd3\xef[\x18Q\xdf\xfd\x90%\xf0\xdeA\x9f\xebK\xbd\x9d\x0b\xcd\x9e\xe5\xe2\xfe\xcax\xcc%X\x8e\x08f\x8b\xf2\xa6\xb1fh@X2\x9el\x99\xf6a-\xfa\x0f\xf5\xfd\x11\xef\xcf\x97\x9fv\x8c\xa6\x91\xdb\x0b\x86X\xa3\xf13\x0b\xd8\xb0hTd\xd0\xaa\xd6\xfaB\x04Ya\xf3\xbc\xa5>\x05E:X\x870\xe3\xb6\xf1\xbex$\x8bhFL\xc1\x99\xc2\xef\xa3\x88Y\xae#\xb7\xc9\x1d\xe9\x9ezoC\xd8\x9f\x99\x02\x99:\xb0\xf8\x95m?\x81\x91\x07\xcd\xa1\x9f\xaas\xd5O\xb4\xfc\xe6\xe4g\xa3b\xed\xe2\xc6\x823}\x1a\x93G\x15y?\xf7\xe4SU\xf6\x887\x15\xe4h\xa3\xbb\x09\xb7\xe1v\xb7^\x05\xc1KS\xb4\xb6\x06\x15\xb5\x15%\xec\xe8|\xc0O$\xe4$\xcb\x1f\xf3=G\xfa\x93\xf2\xf1o\xa0\xea\xe3\xecV\xc2\xdb,\xf2\xee\xca\x17\x85\x11l\x10\xcd\x0c!\x19{\x82^=\xc2\x1b\xab\x8e\x89R\x9f\x0c\xf2H\x04?V\xf2\x9cp\x8b\x161\x9f\xf5\xe5\xfdxB\xa3a\xba\xd4T]\xbf\x0e\xf9\xaa\xa7'\xeb,\x11\xf6\x0cw Vr85\xbf\xa9\xdc/\x8f\xee\xc5a\xdd\xf1P\xe8\xab\x1f\xaa\xdad\x98\xa0\x8f\x13X\xf3\xef\xee~\xfc\xf8\xb7\x1f\x7f\xfd\xf1\xef?\xfen\xcb\x10\x95\xa92WC\xb5\x84\xfc\x9d\xf1\x0b\xe8`\xa9\xad9,\x9e\x1a\xda\xb4\"\xab0\xc2\x9bE\xf5\xa4+\xe1\xdb\xf9\xb6\xa6F\xc8Z\x1bK\x05c\xba\xd9Ln\xbdGi\xb0k\xb4Sv\xc8,ZH:\xa0\xb6\xa8!\x93\xdc\xd3\xcfBo\xf98\x97Hg\x91\x920\xae+?Lu\\)\x02\xb7\xa3\x80\xc1D\xce\xba4\xb5\xed\xde\x99\xc2\x1d?s\xdc\x97\xac\x9e'\xc1\xa7\xf5\xf2\xc6's{\x09\xef\xa1\xb4\x93\xf8\xc8\xa2=\xd9\xcb\x11_\x13w\xd0o\x0d5\x9cm\xcbp\x869t\xab\x98LX\x05B\xe9C/\x8e\x13S\xcfy\xeb3$Zj\xb8\xb5!\x9f\x02]\x09\x01<\x82GI/C>\x1a\xb8\x1e\xdd\x8b\xfb&\x95\x9ah\xc5[3r%d\xe47\xa5\xf5X\xe4\x04\xef\xbc,H\x07=\xc9\xad@\x0d\xc5\x07\x18\xae\xdc\xc5\x84\xd3\xc9U6\xb7\xef\xcfZ\x8f\xcct0@\x1d\xcb4S\xd4\xa3V\x93\x9d0\xb1\xa5\xb9\xfa\x84H\x9a4y\x95\x958Zb\xe7\xf6x\xe2\xb7|F\xedx\xfe\xed\x0f\x14l\xc5\x9d\xc1J\xafMG?\xd6\x90\xd9\xa8\x9c\xa1=Y\xe6\xe4\x8a\x92\xd9J\xaex\x92\x05\x9a0\xdc\xde\x92z\xa9\xc8\x95f\x807\xa1\xe5\xac\xa9\x02\xf7\x1c\x14\xaaEw\x19\xcf=\x957\x9c\xbd\xa7\xb5\xb4z~\x88?#\x03\x95\x1a\xdb\xf3\xce\x06up7s\x09Gt\x01\xbd\xc7\x15\xffjJ\xe7\xcfQ\x1fgjW\x0dt7\x0blR\x8aaD\xc7E\xf0lVs \xa9pCca\xc7\xbc\xe8d\x0d\x84/\xfb\x04\xd2\xd7\"\xadX\xa1S\x0b\x9d\xe2\xa7\xc7~u\xee\xf3\xde}0\x11b\xe6\xcc\xc5:CA[\xb5p\x81\xd6d\xa7S\xd6\xea\x88\xc9^$\x95_\xf6\xb0\x9cq^\xd4\x95P\xd1\x1cf\x0e9\x16N\x811xJ\x88l\x9c<\xd0\xc6\xf5\x95\x0b\xba\xc9\xac\x9a\x0eS\xed\xd5q\x82\x14YP8>\xd0\xbf\x93\xa0~\x0a\x8e#Z\xbc\x8c\x01\x14\xa3{\xc32\xdev\x03\x02\x18\x04S\x08\x82\xa3W%\x1fA\x8a]!>\x13\x83?\xe6\x8d\xf3D\x03\xba\xec(\x0b\xbd\xb9\xc1z\x1b\xb3\xcc\xf0\xd2\x10\xaa\xf3\xca\x8d\xa6\x04\\\x13\x83`F]\x13\xcd_\x8a=ys\x8f\xbd@\x89\xfa\xf0\xb9\xf5\x9e\xf1\x04\x1a\xde\xf6\x92\xce\xf2iu;\xb3\xbc\x1cu\xde\x9e\xa1\xf62\xed;\xc0i\xcb\xbb\x03]s_K\xe9[\x81\x91\x7f\x95\x0b\x08\xee\xe4\xad\xb8\xe7P+\xe5J\xe1\x9c\xf7\xf5\x0f+d\xbeM%A\xf0<\x8fD\x82\xfbX\xf4\xc3%?\x1a85w'\x0a\x9f\x07\xb1;F\xc3L\xf7'__P\x8a:W\x1d4[\xd5?\xcb~\xc1\x93(4\xfd\x8fw\xff\xfb\xee\xc7\xbb\x7f\xd8\xf6\x09>\xffY\"\xd2/i\xfd\xf3\x8f5\xf5\x1d\x87\x07\x89f\xb0F\xc5\x08\x9f\x08po9\x1c\xbf\xe8\x99\xba\x98\xc0Od\xb5[\x0a^{\xa9\xe9Zi\x05\xe8Y\x1d\xd4\x8b\x99\xdb'\x91\xc6\xf0\x1f\x0b}\xe7\xcfS\xb5\xa5\xab\x95\xa3~&\x07KHv\xfe\xcd\x03I(s!no\xaf\xb2w\x95\xe6\x04g;H\x08\x97?\x11\xc0qT`\x13N4>/\\A]\x86}m\xe0~\xf2b>j?[\x05\xc9%\x97R\x04\xb9T\x009\x1fM\xe3)\xe1N\xfc\x0b\xac8\x07O\x13f\xa8d\xe7\xe3\x1dq\x97b\xcb\x98X\x933\x8e\x93OH\xa2\xfcb\x1b\xcb\x88\x93\x19V\x14\xda\xb0\x90\x17\x85s%\x8b\xe4(1K\x9ag\x8b\xcfeHy\x96\xeb\xce\x0d\xd3\xea\xc0Y`\x0c\x9d\xd6\xf8\xaa\xade\x8c\x97W\xa4\xde\x0b\xdd1\xfc3%\xdc\xd7\x07\x03\x8f{\x14\x1e\x8eDq\xa6\x91\xf0\xd6H\xc6R\xb7k\x82\xf4\xa8\xb7\xc7gQVU\xdfX\x16/q?\xdcO\xf92\x7fb!\xd1_Mk\x81\x08f\xe2\xad\xde\x08&\xbcp|h\xbd\x82\xb9h\xd3\xdb\xaaE\x91<\xf7\x1c\xa9\xb4\xcc\x9d\xa9Q\x91\xb4\x8a\xc6\x86\xb6\x94\x13.\x9f\xfd\xa4\xd0j\xb18\x8b\xb0\x84J\xd9v\x19\x16,\x87\x1d\xbd\xf6\xb5\x8a\x9d.!>\xf8c\xc6\x0c\xed\x84Xc?\xb1k\x84{\xe3g\xd4\x1ez\xb1(=Q\xba[\xf0i\xffH1\x12\x9b\x0e\xb2\x7fW\xf9\xcc=\x12\x91\x1c\x05d\xdf\xed\xa0\xbdRz\xbe\xae\xd0\xca\xc2U\xc4\xffZ\xae\x94\x9b\xc7\xc9\x9c\xaa\x1eZh\xc8\xbaY\x92\x80\xb2\xa3\xbd\x9a\xb9\xab\x85\x08\xa9\xe0K\xe8|\xf8IX\x955\xde\x9f\x9f\x8c>YgV\x0c\x07\xf5|\x0a\xee\x0d\xb4\xa1[\x89>\xc9q\"T\xb2B[\x14\x84*~\x92\x04\xde\xc3\x87\x11\xc9\x0dWc\xc3\x885\x84\xe6\xe4ze\xbd\xf4Eg\xda\xab\xae\xf3H\xe5\xd3nb6\xe6\xbc\xb9\"\x13#\x95\xaf\x9cl\xbc\xee\x1c]4K\xef%D\x19pS\xae\xa9E\xc5\xa5\xe4\x03E\xb1}\xa3\xc8!B\xe8%\xca\\\xa1\x8a\xb0l\x1e\xb4!x\xf7\xd3\xf9\x0c\x9eOc\x07-t\xe2\xb0\xf9r#:\xba2\xb0O;2&\xf7\xd1)3G%C\xe3\x16*\x8e\xa6\xfemT\x1f=\xad\x16\xbf?^I\xdc\xe6\x84\xf0AL\xdb\xb4B(\xf5p\x83Y+*_\x9a\xa4ARFnZ\x1c\xdd\x8b*\xec\x8a\xe2\x85\x09\xadd\x8b\xa2\xdf^%QNe\xe8\xe1\xe2&P?\x82\x86\xce\xc9!\xfdg\x917\x9b\xd7b^r\xf8\xfd\xf4y\xf7\xfe\x04(\x90\x8d\x03\xb1?\x88I\x1br?\x14\x0d\x82\x0b4Y`\x07%'%y8_\x0559\x11I\x80y\xc4M\xdb\xf5\xf9\xca\x90K\xc6\x87\xf4\xb4\x15\xb9a\x94\xf5\xe3>\xeb\xdc\x90_D\x82\x10\xffG\x01\x03)E\xe6\x02\x94s\x05\xa8\xd9d\xb9\x19\x91\x8c\x8c\xdc\xdfg\x11\xa1\x88\xcc\x19\xd3\xdd\x88\x12\x8b\x1a\x8c+\xbe\x1d\x97$\xc5'\x9d\x8b\xf1_a,\xce\xd0\x95l\x8350h\xa2,\x0f\xca\xf4\x93n\xb6P\xefk\xdc\xc9a(~\x15\xe3k<1m\x06/\xddw\xb9\x09\xf0\x07?\x1b\xc3\xd1\xd3\xc8\xe5\xff_\x1f\x7fs\xf7\xe3\xc7_\xe7\xb0\x1b\xc5\xf5r\xfc\x18\xbawUO\xdbf\xbay\xcc\x95\xf5\xfa/k\xe5\x11\x8asD: ;Ju\x95p5\xfb\xc2\x94%\x99\x10\x94\x98\xf8\x007\xd0\xef\xc9Guy\xeb\xb3k\x9d\xda\xc0o2b\xa3\x1f\x93u\xa3gI \x15J\x0b{EVEK\x8f\xc4\"\x1eQ\xdd*\xbf\xb9+\x8dS> \xe6\xdc\xaap\x8d\xf8\xb3X\x8b\xdb\x915\xa9\xdc\xb9\xba\xba1\xa1\xce\xa6;{\xf3g\xa29?\x7f\x80\xb7U\xe3\xd6<\xf4s\"\xf5k\xdc>\x12u\xa4\xd2\x01\xbfA\xf3\xd2\x0d\xf0\x947\x89\xc0\x81dL]?\xd0Q\x09\xf6-\xd7\x18\x08w5=\x062].\x01u\xdcg\x84c^\xc7\xf9\xfd I_\xd50r\x9d\x9c\x9f\x16|\x9f\xbdKp\x99f\xa2\xb6\x82\xdb\x19k|\x13}\xd0\xc2w8\xff>0Q\xb1\xc2\x8c\x97Vj*\xf0C\x89a\x98&\x8d\x0a\xcd\xd4\x1d\x8f\x82\xafu\xbbs=$\xa4\xad\xc2\xc8\xa7`v\xe4\x85\xa1\xe9I*.\xde\x98\x1bm1\x83c\x9d\xf2U*!\xae8\xdc,\xcba\xd3\xdc\xf3\xcf\xd2\x1dh\x985\x80\xc6\xb3$\xe7\xa9\xc5\xb0\xcd\xe8~L \x85\xa9\x02\x0f\xe7`\xb4\xf8\x8e\xb7&\xe7,\xd5yKh\xde\x8dy\xe7\xa4\xac%\xad\xe4\xfc{\xe8\xf6\xd8\xf8ly\\C\x9b\xa7\xfb\xbe.h\xdd)\x0c\xe3\xfe\xab\x85_\xfb\xc9)cVW\xa8\xf2\xcd\xa3\xa7\x12\xb3[Fp\x94\x96^,?\xa6\xb5x\x01S\xd2\xfe\xbfk\xdfw\xef\xaf`\x87j\xaf\xa6\xe3\xcc\xcf#\xb0P\x0fg{t/\xc5\xce\x8bV\xc3\x00~Q\xed\xe5\xf0\xf6o&\x9c\xc2\xa39Cq\xb2\x0dtBczF2\x19\x91\xe0\xdf\xec\xbd\xe7\xa1\x9c\xff\xe2\x05ZWt\xa6\xf7\xf5`H\x14\x14\xae\xe5<@@\x01\xc1\xc5P\xbd\x19t%\xfa\x8d\xc9|*\x8e\xe9\x8cJW\xc3\xd4X\xf0\xd9~Y\x0b\x184\x81\x8b\xe7\xb6\xa7\x19\xd3\xe5h\xf7|)neo\xba\xd1\xe3\xa2\x84#\x80\xa3\x13\x1aO\x1bX\xd8\xe7\x17\xc4r\xf7\xa3\xd5|\x81+\x82\xb1\x17\x9dzO/\x1a\x19Fl\xaf\xf2\x8doG\x13\xbfE\x0f7\xdeaw\xd7\xe0X\xe0\x04mA\xf1\xfd%\x85)\xc1\x92mA\xe6l9\xe5\x89PD\xf5r\x19\x0f\xe9r%_\xa4\xec\xb9\xbb\xc0h\xf6\x118Z\x034v\x91\xbd\xc2y\x1f<\xb8J3B\x03\x7f\xb3\xe7\x13\xacT\xd3Q\\\x9c\x84R\x83\x9b$+\x9a\xf4\xeb\xdc\x0di)Ph\x8c\x0e\xb8%\xec\xef\xb7v\xa0\x08\xa4w/\xf6B\xcb\xd3\xda\x8d\xab\xda+\x1f\x83\xcc y\x0b\x1f\xa0\xf7\x96}rB\xedb\x87|\xafO\xc0\x97\x13\xbf\x8c\xa1\x9d\xd5`\x1b3Jf2,TtdO\x18yN\xefA\xe9\x92\xadM\x9d =\x1b{\xc1l\xdc\xb8I\xe0\xa3\x0f4\xba\xd39\xe2\xd7x\x04\x03\x1f\xfc<\xa0\xe3\x07\x9fJ\x15\xc8=\xa26\x95\xd6\xcc\x8a~\x91RK\xf4N\xaa\x80\xcdO\xd5I\xe5C}<\xa6Eg\x9f\xac\xa2\xba*\xa1\x0f\xf1Ej(N\xb5\xaa+\xd4U\xa3\xb9\x1e\xfc\xd7\xbc\x1e\xbc\x98YuE\xb7\xba\x12\xa4|\x1e'\x83{\xb8B\xc0c\xa2a-RK{\xa1\"\x08Xu\"|\x08{\x16E1\x941\xfc\xd7J\xa5\xf4+o\x8eQ|\xc5\x0cp\x0a:N\xdc6f\x988A\x85_\x08@%\x9a\xc4\xf8\xdbH\x10\x8e\xbe\x1d\xeb\x1a\xd3\x90lGfu\xba%?\xd1\xe2W\x14\x9fw\xfc\xfdI\"\xa6\xc6D\xfc}#\x94\xaf\x9b<\xd9j\x1e\x94v\x12\x04\xd0\x16\xd0\x86\xc8\x1f\xd4\xd0\x04\xc6\xb6\xc5\xe2\xb7U\xdb\x86\x8f&\x9a\x05gU_\xd5\xdaCF\x19\xe7\x17\x82\x19\x19\xed0[\xc0\xad\xca|\xa4\xddIu\x84<\xf8\xaa\xe7J\xfe\x1d\xf2i\xbe\x83\xf6\x88\xaf\xe4\x01\xed\x05\xc4\xe3s\x8fsB\x99\xfa\xce\xa7\xb1q\x89g\x9ci\xb5\xc5\x09\x86\xa9}\xbah\x89k\x97\x0f[\x0f\xe0\x82y\xb3u6\xb2\x8eB\xaf\xc7{\xc9\xbd\xbe\\\x8cO'\xa5\x0c\x0b\xf6\xb0\xdfo\xd8t\x0fq\xa1\xb0Om\x87rC\xe0k\x92Wwh\xc4\x8b\xce\xffJ\xc9\x8d\xdb\x1b\x88\xc8\x0a+\xd6\xbe\x8e-%\xe1{\xf3\x15\x0ap\x96\xecV\x0a\xe7\x1d`\xaa\x88<\x8f\x0e\x98\x91y\xa1\x9f\x99\xa6\xb5h\x8c\xe9T\x1a\x1aIv\\\x8cl/\xc7]\x9e%P\x8b\x7f\xbe\xfb\xc3\xc7\xdf\x7f\xfcm~\xe4%e\xb78_\xa0\xa7\x08Xr\xa8\\\xa0\x81F6\x15\x07\xc1\xf1\xb1\x1a\x9a\xd7\x83\xd4\xd1\"\xc26\x89\xdb\xbc\xba\x17\xeb\xe0\xa6+\xbf\xb2\xab`@>\xc1\x19\xd5\xd0\x189\xf2N\xe8\xad+\xb1\x0b\x82J\xb3M\x18\x91&~]5\x06M~\xfe\xd0cB~\"\x99V\xe4\xca\xfa2\x06&\xcepO\x03{h\xdeA\x0f3\x94\xd0\xb3\xb0\xa8\xc0\x81\xb5\xfb%|?\xa0\xea\x88T\xd0\x9ba4\x1f \xe1\xa2\xf0_\xa3 \x1b'f\xfd\xb7\x89\xbbS\xf6\x1c3\xdc\xba\xafF5v\x9a\xb4\xfa\x02Dk\xb1\xbbJ\xbf\xa1+\xe8\x02\xf4#n\x80\xdb\x13\x96\x0c\xca\xa7\xfb\xff\x9b0a3\x92l\xa7\xac&\xeafb7\xede?\xbcUD\xd6\xfe\xe7\xa1\xe6Q\xee\x87o=\x1d\x91@\xae\x9d\xf4D\xdf\xd5\x8e\xed\xdd\x99\xbb\xc3\xbb\xf1\xe3\x8a\x07\xaa\xa6\xd2\x8dJ\xa8e\x96N\x87\xb13\x13&\x18\x9e\x12\xdd\x0d|\x08\xa2^\xdfV!\x9e#\x0d\xd0\x0fk[\xa0\xd5\xac\x0e\xb6\xe5\xd9* \xaf\x8dB\xa6'\xd3 \xb9+W\xb1\xa0\x88scWq\x1a\x1e\x14\x996\xffS\x1a\x84w\xef\xbb\x12+\xd9C\xd1\xb8;\xf1\x8a\x1a+R\xa0\xdb\xa7\x9e\x83\\\x18\xba\xd1\x1e=\xcb\xa5v\xdbxG[H\xe1\xa4\xabZ\xdc\xd4\x1dG\x19(\xc8\xf0+s\xeaM3aG\xe4\xd4\x99?\xb3^\xf2\xfe\x1e\x8aj]\x13-A5\xc7\xa3\xfcC.z\x0dZ\\x\x9dm\xaf\xfeWjS\x08\xe1pr\xdd\xc9;\xc2\\\x19\x1a\xce\xae\xd5(\xa7z\x86n4\xba\x08\x89\x83}\xca+\x9d+\x1b\xa2\xd6\xf1c\xd4t;$T\x04\xf8\x7f#xP\xa4\x9f\x03\xba\xe6\x8eM\x13s\x82R\x7f\xf1J*\x13\xf8\xbf7\xd0\x95\x10R\x11\xd7\xaa\x87\x0f\xd0m\x87\x02\xd6\x89\x12$qD\xeb\xc3\xa7\x1aE\x93\xdf\x13\x86\\\xbd\x92\x0b\xfd\x1e.x\xa8\xd5\xd0\xabj]\x01\x0aQ\x94d\xb4\xa8\xear\x03g\x1c\xce\xe9c\xc6\x96j\x18\xa0?h\x9f\xc3\x87\xb8\xbd\xc7#\x87\x95R\xd8\xf5y/\xb2J\x92`\x8d\xf2\x89\x86\x8a\x81Ha\x94\xe7\x7f\x9f\xe1\x19\xcf\x01\xcdf\x05%\xaa\xd6\xd6x)\xfb\x83\xed\x15\xbe\xc4t)\xcdt\x0d\x92\xbd\x94p\xb3\x08,\x89w}O\x18\xcc}t\x96\xdc,\x9cf\xf7\xa5F\xd6}\x0a]\xd1\xc1`/\x00\xc5\x8e\xde\x11\xde\x89\xd6\xf6\x9d9\xe2+Q~\xc4T\xcd\x0eP\xdc\xdam,\xcb\xcf\xc8\xbarP\x03\xcd\xf6A\x1c\"\xe8\xce\x17C\xa1e\xdc\"Vw+\xe1\xcc7\xf0R\x02O\x8cj\x0a\xa0:pV\x9c\xeb\x0fNK\x11\xcb5\x84\xd1\xc6\xe9\xd0)\x0d\xb5\x81UFB\x0e\x8a\xe0c\xf3b\xa1C\xe0K\xf2\xa0|\xf7\x89\xf4\xc2\xf3\xdf\xc7\x94\xe6/\x97E:\x17\xad\xee\xfb\x94\xe5\xd2a\xc1j\x8bi\x129\xe0z\xe6\x0b\x14I\xfa\xcf\xa0\xf2\x84[\xee3\xf5K\x0f\x0a\x8a\\&!\xee\x1b\xb8@\xd7\x01\x11\xa3+T[\x04\xeb!`d\x1e\xb2g\xe2\xfe\xb7\x98\x82\xe3\xbd\xfe+\xcbU\xb3\xf4\xbf\x7fi\xc7\x8b.\x07\xf4\\\x02KT\xf3oDkMX" +
+	"!y\xcaR*\x09=\"\x95\xc9\xba@{\x02\xed\x90\x86@!~t\xc4W\x0c\xc50\xaa\x12\xb5\xdc\xa4wB\xfer1\xd0\x05\xc3\x029\x85E\xee\xcdt\xe7#\xfd\x91d\x82\xff8\x94fH\xc5\x9e7\x11\x18\x08Y\x9a\xda\x8c^\x85\x80\x04\x08\xf6\x1a\xca\x14J\xbdv^\x94\x86\xee\xdf\x97\x0b\xbdu\x8b\x9bv\x1a\xbf\xb4\xf9\x89\xcaJ\x9b\x86M\xdco\x8a\x9d|q\x9f\xad#\xfbEdy\xab\xc7\xfa\xff1\xfd'`\xbb\xf5G\x982S\xe2>\xb2X3\x81\xf0N\xe8\xceV\x18T\xd1.\xab\xad\xe8\x87Q\xbe\x9a\xf1'\xc7\xb7\xc2\xd5\xfa\xbd\xe9K\xb0\xc3\xc1\x9fP\xad\x0f\xd6S\x9d\x84\xeam\xa9\xb3\xd4>\x16\xb6\xf0#EEqYg\x15\x7f\x14\xb1\x9f\x04JB\xa1 4\xddh\x8a/[\xaf,\xd4ze\xac\xa2\xae.\xef\xfb\xd6w'\x04\x19Gc\x99\xb1\x81\xef\x13\xd5\x0c(\xd6\xa1+]zW\xda\x88\x11\x08\xe8\xf6\x0c\xf7\xa3\x99\x041Y\xa1\xbb\x05\x18q\xa4\xc5\xf0D\xd6\x98#\xbd~u\x0b\xe1\x87\xa1 r\xa1\x94d\x04\x183\x82\x03\x9f%\xf5\xd9\xbf\xf9\xf8w[\x81\x81T\xe5\xeb;.\xbe!\x9e\x09\xd7\xcd#\xedV\xb8\xedJ\x11En\xcd\xc4a\xd3\xb3\xffN\x01\xc1\xf0\xc0\x85\xd10*\xa3\xb4\xd7C\x1a\x87s\x8c\xeed\xb5.K\x13)K\x17Zh|d\xdfww\x8a|\xf1\xd1\x89C^\xed\xb2\x0fi\xce\xc7\xa20\xc2t5\xc3\x11_\x19\xfe0\xc5e{\xc6\x01\x8c\x0b\xd2\x9c\xe2\xc8\xf8T\x90n\x86Nv\xa8\x8c\xdb\xf1\xce\xec\xe0bc\x1c\xe0\xb2\xae'G|=\x14P\x06\xf6=\xba\xe2Jc\xaf6]C\xd1\xd8(\xae\xd0\x95@\xc8\x19\xba9\x1aQ\x09J\xba\x13\x03/\x87\x96\x11\x87e\xa5N0\x96X\xf2f\x0eZ\x9f\x81;\xf7\x1d\xbcc\xa5\x96\xb81#\xe22Z\x9c\xd7M\x94\x0c\x8e\xd8\xc8\xca\xa0\xb5\xef\x93\xef\x17\x03\x83\xaf(Q\xfcp\x92\xdf2\x98\xe4>\xf2_\x95g\xf0\xe9\xdd\xf8\x1bt\xe070\x1e\xdd\x8b\xf3X\xdc!\xdf\x17\xc3-\x98\x11:\xd5\xc00\x17\xc2\xc7\x03+r^\x9c\xf7\x0b\x0d\x1c\xf15\x8f\xb3u\x01\xc3\xcc\xe7\x9d\xf8\x93\x87I\x05\xc8\xaf\xf8\xf4\xfa\xd3\xc1\xc8\x9f- rJC\xc3\xa1\xf7\xddN\x9b\xa9\\\xef^\x0c\x16\xfa\xc98p\xea\xc6\xeb\x1d\xf3\xc7L\x8cp>WMu\x86\xfa\xb0\xe9l\\\xa0\xbd\x9a\xfeH\x7f\x8a\x1d5\xb3g}]\x12\xc6<\xe2k\xd1\xd0V\xd3\x15-\xf4\xc4\x90\x80\x88\x0c\x9c\xf9K\xf72T5\xa3\xf2\x8b\x9d~\x12T\xe9s\x10/\xf3\xc8\x7f\xe5\xfa\x94\xf9\x94\xd8RZ\x88\x1e\x95\xb8Pi\xd1\xb5\xea\xbbI]\x0d%kKS\x94\xb63\x8a\xf4\xf6\xea\xa1\xd8\x92\x88\xd9(S|\x91\xd4\x06\xa7A\xaa\xcf\xab\x0e\xfa\x02J\xae\xd3=l\x97\xfc\xb0\xc4\xfcR\x7f\xf8h\xae\x99\xf2C\x90\xf6;\x1f\x05\xc4O\xea\xd8\x0dI\\&I7\x055\xaa\x97Z\xa1\x1f#9\x1e|\xeb9,\x13\x1e6\xcc\xc5m\xd9o\x9f\xc6\x98\xa5\xf9\xf2i\x11p\xf5\xf7\xe4\xb9\x1c`\x04\xc2\xa2\xd6\x04<\xd9\x85q\xa0y ;\xeah\x02(\xcc\x04saQ\xb9v\xbaed[\xa2o\x95\xafW[\xe2\x1a\x0d|\x0f\xf8l*\xe2gP^\xde=:r_I\xfe\x19\x93H\xa9R\xfb\xa8\xd8w\xe1@\xa3\xaa\x97\x82\x81\x05B\xf0\x17\xd4\xf0\xf5~\xc7\xb4\x06e\x80\xab\xdc\x9b\xa84\x94\x1528\xa7\x86\x11\xe8\xe4s\x8fWPE\xe8zD\xabc.\xcb\x90[f\x180\xfa\xbc\x19(#N['\x9a\x06\xc0_d\xa7Y\xbb\xa8w\xd4\xd7p+\xfb\x97\xb1\xa1\xf7Kh\xec\x90F\x95\xb1\xb5W\xdbhxM*\xa8\x11Q\xb6\xe5`j\xa8\xf3\"\x13O\x12\xd6\x88\x1b\xe4E\xca#\xe3\xb6K\xb7\xbe\xa5\x9cH\x97\xa5_\xb4\xb9\x8e\xa9\xc7\xe3Wb\x03\xeazTo\xdcF\xca\x8d\xc5P\xe1~\xb5\x8f+\xe6\xb61\xf6\xaf#\x19\x80\x1e\x06( \xa2\x08Uqz\xa4~\xb2\xe0\xbc\xd1P\xc1JU|\x1aruc\xdd\xf5\xfb\xc2\xf5\xab\xf6c%n'\xdb\xc1\x15\xc3\x84\xbc)u\x82\xa3{\x09\x03{\xaa\xfa\xf3\xfb\xee\x9d3\xee\x0e\xdb\xf0x\x9c\x02\xf0\xf3\xdf\xcc\xd9\x85h\xf1&\xc0\xd3!:\xfc\xb0\xcb{\xca\x8e\xd2\x08\xe3\x88\xff\x91\xcf\xc6\x0a\xdd\xed\x8e1\x14\xeaT\xd8\xab\x83\xe3\xf8\xc6\xbe\xda\x06\xccO\x18:\xa7\xc5\xfbLV0\x01\x0f\xf35\x8b]\x1f#V$\xcd\x04n\x00\xdc\xd8]\xaa\x13\xa9l\xd7\x15\x8az`\xdf2E\xd5y\xf8#Z\xa2X+\xde\x19`\xce\xce\xc4?\xca&B\x1b^\xaa`Z\x8c\xd4\x91c;\xc0-\\5\x0f85\xfe\xaa\x08\x05b\xd2\x8a`\xfa\x9c\xe5`K\xe8\xd1r\xc0\xe3r\xe2\x96$!a\xb5\x97\x89\xa3\x91WB\x1f\x82Z#\xd8\xc0N_\x9a\xa4T\x08a\xa6=H\xb1PDS,r**I\x93J\xfd\xe8\xae\xbf*\xfejA\x0dk\xc6:\x80\x06\xdab\xc7\x9e\xc6\xde-\x03\xc7 \x9f!\xdf\xbex?,T\x800Z\xdc\xa4(\xf3\xe51=p\xf1\xc8\x097\xbd^9\xe66\xa6R\x0a\x93\xb2\xb7\x03%\xd2\xec\x10\xcc\x17\xa2\x06\x0aS\xe0\x06\x883\xc2\xe5H^m\xeb\xac\xd3,\xa6\xfe\xc6q%\xdc\x87\xddUq_\x82&L%[\x83D\xe4\xc4\xfb\xeen9\x13\x02\x84\xe6\x0cu\x7f0\x82]n\xfc\x8c\xf5\x03\xd4n*\x16\xfcj[\x81}Z\xd8\x9c\x1c;1+C\x19\xb8\xc3\xeaH\xde\xf4*\x06\x1ae\x9a\xbc\xf3\x89\xbfp\x8b\xd0\xeb\x97~\x8b\xe41\xfb\x02Z\x18%\xe6\xe8\x13\x98\xc3\xad\xadm\x9f\xa1\x8c\xbe\xd9\xef\x88.\xcc\xed\xb8\xb4\xd4\x8b\x93\x81:\xe2S<L\x88\x17\xe2\x83\x8ev\xd9\xd2\xd4RI\xbca\x13>z\xbam\xdd\xbe\x81+t\xb57\xf2\xdd\xa5\xa1{\x07-\x9b\x1e\x99\xa4\xab\x1bN\xc8\xa3g\x99\xb4\xa4\x0er\x13\x10\xe9\x94T1\xf8x\xd0\x9a\xa6\xa1a\xa6\xe1\xc1k-M\xce\x04\xa8Uq\xde\xb2\xab\x126\xa8\xaa\x81!c<\xfdI\xfb&R2\x96h\x10:\xdf;\x95\x0f\xc2\xae\xef\x05VL\x1c5\xf8\xb4\xe7\xd5\xfcBUe/\xce\xfb4\xcc-\xe5\x8f\x86\xf2/;wQ.u'\x82\x89@\xc2+IH$\x01;\xe2\xeb\x1ar\xeb\xd1\x0ay\xec\xb7\x82\xba\x0b\x99\xbb\xda\xc6\xfb^\xcb\xe7\x1b\xd46K\x12\xda}O\xfc'|0=\\\xda\x90K\xa0\x8c\xd4.8\x93tX\xa0\xdb\x84`\xb8\xbdg\x7f\x8a%Q$\xa5\xc0\xd8\x01\xb3\\\x0a\xde\x1b\x8e\xd3(Q\x8a\x8d\x89\xfcbs\xd5O\xcf,\x86Jy\x15c\x05\x16L8\x0c\xf4r\x8f\xc4\x91]vg\xd1\xc2\x8ao?\xd6\x04\x9b\xa1&\xdf\x98\xe4\x8f?\xfdI\xb3`\xb0\xee!\x16`\xfaHE\x15\x15\xf7\x1d\xed\x06%\x80fE\x0ah\xe7q\xff\x03\xa2\x8a\xe3\xf3\xaed-\xbcJ\xfa\xbc\x1cG\x7f\x1e\xc5\xd1\x7f\xedb\xe9[q\xf4\xafl\xc7\xdc\x195\x8eY\xc4V$\xe7\xb5m\xcc\xb0\x1d\x12\xa7\xbd\xb5\xe8L\xdd\xc2(E\x86\xec\xa4\x04\xec\x92\x0e'\x13%6\xcd\xcd\xb3i2\x11i\xd1f\x17N>\x8dS\x9c8\xc3:b\x8c\x0aqG|]\xdb\x10\x9e,^sr9,'D\xad\x89\x1aq\x9c\xf4\x80\xcfke\xbdO#\xb3e\xeaA\xd0\x9e\xaa\x88nf\xa2pW\xdbE_?l\xc6U\x97\x93\x0f\xab\xc3\xff\xd9lh\x83\x9f\xf0!b\xdd\xff\x9a\xd8\x07\xde&\x0bT\x9c\x93\x01FE\xd9\x1aXi\xd7\x9b\x98cN\xa4\x8dN\x8c\xb3Ln\x95\x047bj;.\xb4\xee\xdd\xa5\x04\x88\xbd\xbf/\xe4\x1e\x09\xf0\xb8\x0a\"&}\xa6\xb4|c;4\xdb#F \xfd\x11\x16\x95\xf9\xcc\xc3>3\x9a7{\xa7\x03\x05~\xf5\xa9D\xf6\xae\xbb$1\xf1b\x8c\xe4b:\xc8@\xb6\xeb\x16\x10\xfc\xd5\x08p\xaa&~\xbc\xa2\x15\x12@\x97O\xb7=\xeb\xc5\x1f\xb6chI\xd3\x9e\xf87T Pq\xbb/\x03n\xaf\x80\xee\xf6\x08\x1c\xe7\xe1\x1f\xbb\xf8\xdfa;\x12F\xbd\x86\xda\x0a8*A\xcc\x1d|\x99r]uX\x84\xc9\xcc\xf7\xdd\xedJ\xf3~\x0b\xe1\x82WN\xb6ri\xc2\x8e\xba\xb8W\xaaL\xd0A\x9d\xc1\xcf\xf0\xda3\xbe\xc6u\x03h\xfd%\x9c.\xd3<\xfa.h\xb1\xed\x0b\x07m\xac\xba\xf3\x91\xff&\x96\x0b\xd9Tx4lE`\x83\x91\x1bjw<\x9fb1\x12\x92\xce\x00\x023\x88*\x12\x83\xe2\x19\xbc\x07+\x89/G=\xe1\xb3N;\xd6\xff2]\xf1\xb6\xaa\xdd\xcdi\xb9\xa1\xb9\x1f^\xab\xd64\xa6>\xf2\xdf\xa2\xaeN6 \x10\xd4r\xa4\xb5\x11\xd3UQ\x9f\x8e\xfc\x17o\xb8\xadZ\xf7SW|7\x98f\xcfQ\x8b+.)\xe8\xcda;\xc0\x13\xb1\xb0s\xdc\xf3\xd6\x0c\xe6\xc25}u\xf1=\xd4\xa6/Z3\xd4\xa6\xdb\x1f\xb6\xc32\x8c^9YriJ\xc3\x08\xfe\x16\x1a\xb8V#\xd4\xc2,@\x86\x083j\xfb\xd3;\x1a\x0a\xceX\x8d\xa6\xb4\xfda;&\xc2\xe2\xe1,\x0b\xe5\xbbp1#\xb4\xbe\xe6\xa1E\xb3\x83\xce\xa0\xaa\x81kq\xc6r\xbb\x8c\x10\x85\xde3\xaf\xd0\x9d\xcf\x08\xf5j\xf9_z\xe0\xffb;z@\xd3\xfb\xeb\xaa'\x8cUi\xa8\xf6\x16\x9b\xd8\x99\xce \xb3\x01\xdeCTILEu\x85<x\x07@\xd9\x96\xcf{\xf48:*\xaerQ\x19e:\xff\x9cQa\x06\xc7yo\xd6X\x86\x83+\xce\xb7\xa0\x91\x8f\xea\x18\xa0\xd2<\xc6\x87\xe1\xec\xb9Z\xf1(<x\x84o\xf0^\x9e\xf5\x89\xcd\xe2Q\xdd\x82\x1e|\xf1x:\x03c\xb4\x97G\x16\x0dOhto/\xd0\x845\x1d\x00\xc9\xfb\x07\xdb\x1e\xf6\xb7\xb1\x1e\xa9\xd8Q~\xf2\x1d\x16\xd3\\\xda\xbe3#Li\x80\xe3(\xeb\x00\xa3\x03J5\xb0\xb2\x08\x83\xc7|\"\xdc76\\\x1a?\x00\xcc\x91\xeb\xf39\xb8m\xb8;\xddz\x00\xcf\x15\x15\xa9\xbb\x0d\xed\xe93,\x9a\xe6\x10\x98\xba\xb8\x121MLv\x14\xa7d?\xb75\xc5A\x88\x19\xd6\xd7\xf4\xc7tx\x87\x94\xde!Hs\xedtB\xab\xb5\xfblOR\xe5\xeb\xec;\x14\x9f\x09\x006A\xf8`\xa3\x1e\xa4\x19\x1b\xe2f14\x83\xdc\x1e\xd9\xee\xde_\x92\x1e\x9b\xcf\xc2D\xa2\x03%\xfa\xd9\xe1\xc3P\xb21T\xbdk\xce\x99>b\x02-_\xec\xe1:S\xf5\xeb\xd2^\xc0`[5\xde\x92\x9b.I\xd2\xcd\xbd\x85\x0b{\xe9\xae\x13[h\xeb\xfeC\xf44\x82\x12\xf3\x88\xbb\x8b\xa9z8\x8d\xd6\xa7\x0fE\xa0\x16\xd7\xd7~\x19\xb0oF;w#\x91\xe6PHf\xf6Fu`\xb9\xef\x8f\x83\x81\xe0\xcb\xe7p\xa7\xc3@\x14A\x07\xe6\xc2c\xeec\x11\x1b\xe7\xce\xa2\x8b\x98T\xa5o\x91f?\x9e\x17\x9d^D7\x12_\xc1\xae4\x02\x99\xd9O\x8b\x1b\xafUg\xddQI>\xb4\xa2\xd1\x92D\xf1ro\x9e\xce\xc5\xc1\xaf0Z2\x04u(V\xf7\x0eUn\xa9\xd6_E\xa7\xe6\xfa\x8f\x99\xf4\x9d\xf6?\x97\x95\xc0\x9fEi\x04\x8d\xe3\xda\xa5\x84\xdf\xfb\xbf\x08I\xb2X\x8e\x1c\xb1\xdbuu%\x89\xe5\xe5\x8b}\xb6x1\xcd\xa5\xec\x8ef\x96b\xde%\x9c\x13\xec\xd9)\xc8?W\xe4v\xb7\xb0\x9cy|\xfc\\\xed\xee\x1f \xaa3\x9dF\x088\x9d\xef\xec\xccKR_@\x1a\xa0Lw\xb0\xfc\x80_\xc4\xb7y\x8do\x93O\x90\x1b\xe8n\x8c\xaa\xb4\xa8\x95\x04\x17[\xb1\xb8\xbe\xc87`\x9ff\x84\xab/.eV\x11\x15\xc1}\xb9\x11\xfc\x08\xb2#\xc9\x13st\x0b\xc2 \xe3\x8e\x84\xc5@\xcb\xa7\xe1\xf4\xeena\x8e\xe6aR\xa4\x96\x1edj\x95\xb9\x9eb\xed\xce\x078\x85\xbco\xe8\x15\x0f\x0eu\xd6\x8cv\xe3\xa8z\xf2pn\xdc\x15\xfb\xb0L\xb0\xda\x8e\xf6H\x7f^QJU3.xd\x98\x0f\xf7E\xbf\xf0\xe2\x041\x08\x7f>\xdf\xb0\x1c\xb2\xf2\xdby\xb0\x02O\xd5h\x06T{p\x97\xbd\x86\x14+\x1bjb9\xe3\xfc#g\xe7\xc6\x96/#\xf6:\x96\xd4\xc0\xca\xa8\xc5k\x07\x0a\xc8\x08\xde\xb8\x17\xb8e\xb8\xe8h\xbb\x92\xacN\x84\xe8\x1f\xc8a\xf7=b\x07\xa5\xae.\xa6\x03!z\x9d\x13\xd5a\xefT\x01\xc5\"\x952\xb9\x97Ct\xc3\xbao\xc5\xe0\xdc\xf8\xb1\xeaW\xa2\x80O\xa2}\xd5\x9d\xf7B.\xb2\xbb\x0d\xa5\xa2R;z|\xe3\xd4e\x91\x13g\xbf\x1eX\x0aB8\xa63\x8e\xb7\xb7 \xde\xde\x07\xc5Wx\xcc\xbe\x09Q\x7f\xf7\xccM\xed\xef\x801\x9dhv\x0f\xb6?\xd2\x1fw\x08\x13\xc1T\x008\xed\xa7\xb4S%\xf8\x86j:\xe0\x96\x9f\xe8S\xed\x95\xbe\xaeC\xae\x82\x16\xd9%\x95\xd3\x89\xce\xc1\xde\x15\\w8 \xd0\xc2(\xbfY\x1e\xe8\x05@\x0a&n\x90\xe6%U=\xd5\xfe\x1f\x16r\xc1\x18\xb8\xdf\xed\xb8\x8a\x09\x8e\xce\x8c\x9a\xdd#\xd5:\xe5\xe2$\x15\xa7x\x09\x13E*\x17\x19\xa7\xa4\x97\xbb\xe7I\xe0\xdcm\xad\xf5\x9a\x90\xcf\x93\x09\xf7\xf3\x00'\"\xad\xac=\xd3z\x98dj;\xc3\xb6\xc5T\x13\x9e\xa4$\xea'>v|\x90\x87\x04\x89\x03\xec\xd9q_0\xbb\x8a\xaa\xbb(\xdf{o\xdf\xa4\x8b\x06Q\xa4\xa1\xf0\x04l\xbb\xea\x9c=QY\xa3\x06\\\xa7\x04Qh\xc0c\xb8\xcfU\x0f!\xaa\xcb)\x84`\x09\"\xd0W\x1f:\xaeS\xe6\x9d\xe2\x87.F\xe8\xe0\xe2^K;$\x1d\x1e\xab~\xd50\x7f\x1a{\x01rE9\xae\x03\xf7\xb3XP\xee\x9d\x0d\xfd\x8c\xe5\x8b=\xcc\x02\xf3\x84\xbc\xa8\xbem\x06\xfb2N\xd8t\xefL\x1f \x014\xf3\xd3\xdd\xf6\xe0\x0b\xc8'\xf0d\xd9s\xf4\xfe\xb8\xe6\x17\x9b\xa8\x08}A'\xe7l6c\x85O\x1f\xc5uC\xdc\x8b%\x10\x8bJY\xa3q=T\x0eTq)l\x9bU\\\xf2\"b\x9e\xf8g\xc7\xf7\xb9\xad?\xfd\xcb`\xffl'\xbe\xd8&H\x12\xf6\xaf\xb2\x92YT\x1a\xcf\xeb\xf5\x9e\x0d)\x10\x86m\x8bo\x07hm\x89\xcf\xf2\x93\xd7P\xce@\xbfT\xbb\x19\x02\x10%\\-k\xf7R\xd31\xa3\xadhg\x06\xb13\x9e\x80.4^\x06/\xd9B_\x9c\x0c\x0c\x19\xa8\x7f}I\xbc\x85\xac\xebAw\x96\x12\xd2\x01\xdc<)J\xcc\xb8\x90U\x01\x94TG\x14\xe1a3\xbf\xa5hD\x98^\xbc\xf6W0\xa3\x8dO .\x08\xe3\x12\x9a\xd6\xa7OK&\xce\xc1\xe1\x8e\xb0N/=:\\\x0aZ\xc2\xbd*2X\xaa\xd0\x1f1t\xee\x96y\xebI\xaf\x826^0\xa5\xceg\xdb\x14\xd7\xf7\x97\xf7]Q\xdb\xbe\x8d\x05\x0b\xde\x0fH]\xd0\xc0\xd5\xd6\xfb\xcc$Y\xadIO\x05\x0e\x87+\xdcs\xaf\x0be]j\x86o'\xc7p\xcek\\\xbd\xae\xb9[B3z\x06\x84\x94\xa9\xeb\xa8\x09\xbbJ3\xc1\x9dr\xde\xc5\x97>\xdf\x8f\xa7\x0aG#)\xe6\xd4\xbb\xa8\xd4\xca#\x962\xa2\x06c\xe3\xc4\xcf\xa4[\x04Z\xc4\x06\x1c\x82/ \x9e\xb9X\xa3\x9b\x81r\x9f\xa4D\xc2.\x0f\xa6\xe7\x01\xee\xc0\xa5\"\xd8\xa1\xab\xab\xe2l;\xeb\xee\x80\xb22\xf0\x01\x8b6\xba\xaa.\xda\xf7\xb7\x18\xf9\x17\x15c>>Od\x1agd\xeb\xe0\x0c}\\O\x14\x13\xb5\xa4;\xb8\x87\xb0\xe1\x0f\xd1Q\x15|(z\xb1\xa3;\xdb<\xe1\x13(\x09\xc9\x0cP\xbb\xc4?0CF\xc4\xaa80\xa5\x19\x8d\xbdJ\xc5\x97\xfb\xf7v\xde\x8e\x0b\x1d}x\xdb\xab\xf4\xd0X\x1e\xe9\x0f?mg\x83v\xa3\xe5R\x1a.G\xe6\xcb\xd1'\x19\xa9=\xca\x08\x84\x18>U\xf3H\x12\xe5\x92q,\x85L\\I\xb6\x0fV\xc67 U~\x07Y\x15\xf1U\xe8C\x8f\\A)\xbb\x8c\xe4\x1cb\xeb&4\x13\xa1\x08\x9b\x01\xefG\xfe\xcb\x97a\xfa\xd3\xa8\x03\xb2mr>@\x9a\"8\xb9[\xd9\xc5'\xaf\xfb\xe1\xd6\xa7\xa6\x04l\x89\x86h\x03\xfb\xad-\xe8\xe1\xf3\xa9s\x8c{:NF\x83v\xb5\x86\x9aI(\xfb\xc0\xc5\xda\xa1\x1cG\x18\xd2\xd0\xbal\x95D)\xd3\xfb\x10\x15\xfe\x16^\xe0\xe1\x8b\xe4\xdc\x9b\x91\xf3\x9f\x10$R\x04\x8b\xf6\xc3>\xa1=\x08\x95\xc1\xe54\xe9\xfb\xda\x85;X`\xf0\x9d\x8f.\x9fjK\xd9\xad\xad\x84\xc3\xa7\xb3;\x0f\xd4\xee\xe6k\xd3\xcd\xaa\x1a\xbbO#\x95t\xe2;\x8c\xd8\xb6\xeb\x1c,2\xae\x09\x1a\x17.\x0b\xd0\x18/,\xb8\x0dq\x9d\xde\x94\x170\x87\xed\x8c\x9f\xe2\x97S\x87\xda\xca/\xfd\x0e\xe3B\x89G\xf7\xc2I\x15\x846n\xe7\xe7v\x09S\x8a\x80\x1bU\xc9\xbe\x8f\xce\x98\xce\xb6\x8a\x19d\xa8h\xc0\xbc\xf2~ \xd4\x9bQ&" +
+	"\x0a\x0b\xeb\xe8^\xf4\xf7\xdcF\x95\x9d\xdd\xd3\xf3\xf2\xde32RA\x98\x99\x90\xff\x19.`B\xd5\xc1\x09LM\xad\x91o\xc8\x92\xc6\xc9\xf5j\x1bO\xdb\x8c\x8c\xe0\x17\xa2w\x98\x96\x11\xc1\x15\xee;#\xfd\xaeG\x9d\x97\xbezEHN@\x04!\xc6\xa6\x1aXX>\xb3\x9e@Q\xc9\xf4#\xc6cg\xaa\xaf)\x08\xd3B\xf7=x\xc4\xe8Z\xb7_\x04\x8ab<DLC\x05\x8d\x18\xd0\x83\x8e\x89\x0c\xa8\x95\xec\x84\xd9\xce\x93\x12~o\xfaV\xcd\xe2\xaf\xab3\x17W\xbb\xa7\x8e'\xe3\xa9j*\xb4S\xc9C?{\x8e\xc7\xa6\xbaT\xfdjZ\xe3\xe1\xa4z[Xk\xe4\x0a\xde^I\x09\x93\xf2\xea\xf6\x1e?\x9a\\\xe1T5f\x94\xe6y\x05\xf6\xd0\x8dR\xfds\x85\xee\x86\xd1\xe2d\xfb_mc1{\x9b\x99\xaeJ4\x098\x8eI\x8fw\x96O3\x00j?_\xb5]\x1fG\xa0\xc5\x1b{\xa6\x0b\xf8\xb9_\xbe\xef\xd1\x1f\xa0%\xdd\x80\xcb\xb8\x0b\xad\x99\xcc\xaa\x00\xc9\xa4\x9c|n\xcaINI\xfa\xedX\xb5\x80\x08\x03!\x0e\x8f\x18\x99(\xaf\xd5\xad$\xd7\xa2\xf5KM3\xbb:\xf6\x10:)Yp\xd7\xd8\xf1E\xf6A\x02\x0a\x886\xd1\xcb\xbdt+\xd6L\xc8 Mj\x8a\xf0b4Y\xa9\xe1\xa5\xcc\xd8\x83\xed4\x11\x8e\x92\x0b\xa3\x06D\x91\xb8\xb0Q>\x08]\xae\x8c<\xd0\x17\xd0M\x1aP\xe2\xd7\xeb\xcd<\xf9t\x81\xa3R\x05c8\x09\xa1\x03=\x1f\xe0rk\x8b\xdd,u;O\xd9}Dmk\x1b\xb3\x923x\x18E\x98\xf5\xb5\x11\x1a\x1cU\xa4\xaaR\x86\xee\x16L\xef\xe2\xcd8\x03\xc4\xc6\x16H\x82Z>\xab\xf8\xde(\x0au\"&E\xdb\x80\xba\xe2hk\x9a\xcb\xce\xca\x9f\xbf^\xc8{]\xabF$+x7]\xbd\xba\xdf\x0e\xbe\xf4\xd9\x1e\x1a\xdctTU>|\xa8\xdaj\x18\xa1\x80\x06\xda\x8c\x8c\xc57\x15t%\x18\xd9f\"\x02I%X\xc241\xe1\xb6\xc9\xac\xee\xa0\x05f\x8a\xd9\xce\x10H\xa5\xb7\x9e+\xee\xa7\xc5\x15O\x03\xbc>\xee\x00\xb4\xfd{\x835#\x1d\xa0\xb6\xe5\xb4\xed\xf7\xa3\xdb\xcdl\x0fc1\xba'r\x03\xa8\xa5\xb4\xda\xeegI>'=^\xd5&\x16L}\x0f\x93\x0e\xc9N\x0f\xe2q]A\xd1+\xac\xaei\xb1O\xd0Q\xaf:(\xd1\xc9x\xb0\x1d\xff\xffbJ\xc3\xab\x97\x83\xbaw!N\x95\xe9\xb6\x8e8\xc8\x88\xcbO.\xcd\xb8\xcdp\xc9\x94_xN\x10x>\xcf\xb5\x0e\xf2|\xfa\xe9\xfdo_<T\xb7\x0d`\\g\x89\x14\x0c\x13\x83\x0c\xf4\xd8\xff\x94x\xfcb\x17j*i\x0cN\x07:A&#\xe0\x1d\x04\xcd\x1clJY\xf8;\x12\xeev\xd9\x98\x0eZ\xef}.\x9e\xfc\x8b\xf1\xee\xcf>\x8d\xc9\x94\xee\xfex\xf7\xe3\xdd\x1f\xee\xfei+\xe2\xfdM\x15\x8en.i2\xdd\x08E]Q\xa0.b\xa0\x17\xb7\xce\xb4W\xd8\x8e\x8f\xcf\x108F\x04>j\xb1\xf1\xeaz\xdf@\xbd\x86\xac\x0e\xb9\xe6)t\x95\xf9]\xbf\x8by\xe0\x89\xf2\xe5H\x7f04\xb6]\xf8\xf1v\x12\xd7(\xa1\x13\xe2U\x8a\xaey|\xd8>\xa2t\x10\xf2\xf2*\xf8_5\x94T\x04\xdb\x98\x16\xc8`\xec\xcd\xc9b\x02\xa0\xcb,\x13\x99\xa4\x07\xc5=to\x06\xce\xd7\xedH\xfb73\xd9t\x84\xceb\xc6\x8f\"\x93R\x03\x09\x8e9\xacu\xcb\xf9b\x9a}N\x8dH\xc9\x08\x07\xc6\x1dq\xb3\xdeUv\xadu\xe7\xdat\x12\xd9\xca\x08L\x7f\x9d\xd2L&\xe1*\xc9t6n`\xe90[\xe1\xd0y\xa1\xcd\x8e(\x947i\xf0\x84\x08\x03\xbf\xa3V\xfd\x09\xd7\xc2\x0a\xf2\xfaS\xdd\xf8\xe4L!\xc3\xb8\x80\xba\x87\x13\x02p\xab\x8eA/\xf4\xc9j=\x81_\xac\x9c\x9a\x8d\x01\xc3~\xa4\x1f\x14\x7f\x15\xd6ZA\xc9\x90:\xc9\xbd\x92\xdf\xc1\xcb\xad:\xd9\xc1\xed\x96\xbda\x9e\x9a\x9e\x15(Tp\x8es-,\xd0\xb2\xda\xcd\x88\xe7.0\x7f\x978S\xb10\x7f\xf5\xe7\x8f\xf5\xcf1A\xf2=\xb4\xa6\xc16\x04\xe3\xc8\xd5\x8dR\xed\xcf`\xbf\xed\x80nJ,%AD\x0c\xecrb\xd1\xcb\x1c\x19\xde\x97=F\xd1%\xe0O\x08\"\xc21\x91\xe1\xbb\xf5\xc4\x80\xab\xd2\x00\x8a\xc9+5:\xb0\xa9\x04\xdc\xaax\xa1\xd8>\x84k\xa0\x9f8\xbb-2#\x06\x1c\x18\x16\x1a{\x85\xa1\xa8mc(f\xd0@\x06\xa9\x86w\x83\x08$\x14Kl\x9c\x10\xa9\x8a\xfbo\xe0pe\xe41a\x9b\xe1\xec\x8c\xc8\x8c\xba\x87 t\xeau\xa2wT\x16s\xaa:\xe7S\x08\xa3\xf0-f\xc3V\xc7\xf9\xc5\xfc.'D\xc9\xc6W\xd2c\xa1\xd5\xf7\x18Bi\x10\xcd2H\x01\x14jy\xe6\xb0>p\x9a\xc1Pz\xa1\xbdb\xae\xc9\x0e\x98k\xe2\x98Wh\xfb\xb0\x1d\xea\xe4\xf2Ki\xd4#\"\x84l\xdc\xbdI)M\xd7\xc3\x8c(\xe7\xa2\xdf\xaa\xd9\x99\x87\x0b4k!\xcc\xc7\xc9t\xf0Jd\x84\x1d\x9c6\xb5\xb6\xbe\x1fE\xd9\x95I\xbf\xe8\xe4\xc4g\xcf0\x0b\xc4\xcb\x16\xec\xa5\x8cn\x13\xee\xd6\x18\x1a\x9f\x06X\xa6X\x85\xc5N\xca\x0bd\xea\xa2iS\xc6\xb2I\x81\xae\x84L\x06\xb8\xc2\x11\xaeR\x1e\xc3\x9f\x82\xc7$\xad\xe0\xfe\x9f\xad\xde!\xc15\x9d\xbbdD\x02\xbde\x06\xea\x8cR\x82\xd9&\x89T*\xb0u\xbb\xbd{\x132\xac+\xde1!4\x85IGrGj\xab\x92\x00\x91b\xd8\xd9qRD\xac\xc5\xf5\x82\x8d\x17\xabL\x7f\x11\xf1i\x0b\x8d]?\x17\x1f\x7f\x9a\xcev?\x12\xb1\xcc\x19\xdf\x08\x8c\xe0\x02\x0c%8>@wOzZc%\xd8,;\x7f\x84 \x14-\x04\x0aMm!\x8cB\x18S+R\x8a&\x09;\x11\x94\xb6\xe6*\x95\x0c\xed\xe0\x04\xea\x9f\x11\xe1\xdc\x1al\x92\xfe>\xd2\x1f\x0f\x93\xbe\x9a\xda6\x9e\xc0\x0e\x11\xc6\xab!\xdb\xc7[\x97\x0b1}\x16\x05]m\xeeIn\xef\xfd\xd6\x93\x13\xb1\x0c{\xd8\x0ee\x82\x90\x03\x8a\x92Ax\x02\x94\x06CWk\x14Z!F\xf9\x96\xb4i\xeaYrnNY1\xc6\xcd\xb3\x09\"*sE@\xea\xb1Z\xf1\xbd\xa1\x80\x09>\x00\xfa_\x13\x0a\xab\xe8\x90y\xdf^\xdf\xd7G\xf9GF8\xf25\x01\xe5\xa3\x9a`l{\xfe\x16\xc8\x8cL\xea\xc5VJ\x17V\x80\xf3/\xc2\xa9\x9f\x18B\xbf(\xa6\xc5\x9e\xfe\xb8\xd6\xa2\xc4)\xd2\xb2\x16n80}\xd1\xc2Zj\xe8I\x8c\xef\x80\xb9\x80\xac\"\xb3%\x10`\xed:\xd8\xda\xde\xabQ\xa6\xd6\xda$d\x12\x08\x86\x1fl\x87?\xff\x93H\x8b\x9c\xa9\xc6\xc6\xcb\xbcs\x7f\x02\xc7tR]\xe3\xf1&\x8a\x90u\xd6\xb8\xc4tc\xb3\xae\xba\xf0$\x09S\x18\xe8c9\xcb\x91p\x87\xd0\x16\x1e\xe3}\x82\xd14\x844\xc6\x99\xd8\x82\xe9\x8e-\x96F%\xfa\xa4\xdd\x8d\x0d\xa5\x05\xbc\xb5 \xd9N\x08\x1d\xcb\xddd`\xccY\xd0-d\x15\xfcD@\xc4\x19-\x12{\xb2\xbd\x0c\xe1\xbb\xea\x8a@\xa6\x09\x84\x0a\xcb/C\xa2\x15W)w\x07\xe5\xfc\x1b8\xf2_W\xd0@\x08-\xefr\x1c\xb6\xa3\xb0\x09x\xd6\x0fGU\x13T\xc4\x036\xd1\x99ACx?\x87\x82\x17i\xec0|\x9e\xf0fi 3\xa2E\x0f%Z\xf4\xf1w\x1f\xff\xfe\xee\x9f3\xa2D\x8b$\xc4\xae\xbe\x02y\xfccdp\xb1\xe3\xb1u[\xb5\x97\xfe\xd8\x07\x0a\xd6\x9e\xa5\x14l\xdbFP!\xa1\xb2\x8b\x08X\xbdi\x12Xk\xa8\x9d\xed0\x94\xebZ\x92\x84v\xfa\x0a\xee\xc2\xb5=\xcc`\x17D\x12g\x939)\x12\xac\xcd\x89Y\xe1\xdcup\x11\x02\xe68\xd0Sk%r5\x95:\xf4D9#h\xf5\x90z\xf9bO\xd2\xc0|,\x10-\xca\xfe\x81#\xfe\xfd\x00G\xf7\x12\xb8\xc5\x08\xaf\xef\xe1\xc5\x1eG\xa6\x80\x8a\x92\xaa\xa8:\xcc\x94\xa4:+\x1e\xa3\xce\x17g\xbf&\x82\xff\xc5[\xd4\xe8/z\xe0\x88E\"o\xb7\x85,^y\xd6\xdfql\xae\xb6\xd3G\xed'\xf1m\xd5[\x9e\x11\x0a\xd19\x985\xf4\xe8\xb3\xa8\xde\xdd\x15\xa0\xe0E\xb0\xd4]0\xccn<\xdd\xd3\x96\xf5\xdf\xa0\xb0\x03\x9b\xcc\x1cL\xce\x88\xadMB\xd4\x83\xa2rv7\"\xb7 \xe5\xd3\xfc\xb8\x8cK\xd0\x13\xda\x8e\xf5I\xd4$HHf\x83.L\x01\x1d\xd4\xc5M\xb4\xbb\xf2\xc1\xcf\x08=\x05\x8d\xb7\xcc\xd7x\x82\x1b\xdc\x93\x1c/)\xd1\xeb\xed5\xbe\x9c<\xc6\x1d\xf3\xa2\xd4\xd0\x1b\xf9\xb8\xbb%\x02\xc0\xa0z'\xc1M\xb7\xea\xb6C\x84>O\xb4\xf1tg\x87F\x0e\x09\xe8\xd1\x98w\x06\x10\xfdY\xf1\xc9^\x04\xafc\xe9\xca;\xbe\xa8\x87\x8d\xee\x1a8\x1b\x7f\xb9\xe4J\x8c\x1e\xa6\x7f\x1ah\x8f\xee%#\xd0\xd8\x8a\x1dSG\x05\xdb\xbev\xee\x93\xb7\x0e\x1d\x8cK\xb0\xbdZ\xff\xb1\xaf\x1c9Nx}y\xeb\x8b5\x06\x8e\x19\x81\xc9Y\x01n!P\xbd\xd8\x1b\xadw\xc8w\xdaT\xa754\xe3\xa3\x98\x1a\xa3%\xd2\x14\x86\xb3+\xda\x14\x0cKJ\x1d\x8c\xcao\x8a\x8c4\x96(\x1f\xf15\xfd\x0a1\xec\xa8\xfa\xcd\xbd\xffU\xa4v\x94\x03>\xd5*\x95L7E\xbe\xbe\x08\x09 \xf8X\xf6\xc4W\xdb\x11\xcb\xaf\x99\x10\xc1\x8d\xe8\x0c;\xf9\x0c3$bw+R:FP[\xcd\x92\xb5\x84\xcb\xcf\x08P\"\x0c\xc2W%h\xf6VO\xa7\x92H\xe4.\xeaI\xf0\xf5\x8f\xfc\xf7\xd5v\xc4Rk\xc9\x84v\xe5\xecG\xd1}\xaf\x0c\xc4\xcaL\x09\x1bf\x0b#\\\x18\x8c\xc6\x02B\x8d\x19\xe1U\x1e\x9a\xd5_\xdb\xb6'u\xfe\xe1^MEF\xdeVtf\xf8\x91\x91k\x19AN\xbfOxu^dn\xf6\x97\x08\xbb+]\x86g\xa9\xd7\xf0eZ\xfc\x80.(\xa6l\x8b\xa1o\xc1\x17\xc9\x8b\x96F\xf7\x1e\x91\\\xfb\xc5\xc0\xc3\xe9\xaa\x90\x90`t;@\xaa)\xaf\xb0\xd9\x06:!\xff\x8aXRa\xc0\x08\x08S?oA\x14\x1ff\x9d\xf3\x87\xd9\xf8\x8d\xaa\x8b!\xfc>36\xfa\xf7\xcf\x0b\xb4`3\xfbM@*\xfa\x03>\x98\xa9A\xfe\xdc\xcbdS\xc4<\x0b\x9c\xda\xe1\xefk\x8b\x8b\xca\\\xed\xb1\x04\xc9\x7f\xb8'\x9dE\xf0\xff\xe8\xf16\x05\xea\xaf\xa4\xb6$\xb8\x8d\xf2\xb4\xa8\xc8\xa3\xe6\xc2\x8b[4W1\x09\xe2\x86\xf78\x0a+\x87U\xa5\x87\x84\xa0\xb9Oc\x1f\xa0,\x0f\xd3\x16\xec\x87\x0f\xf0\xc9\xa1\xb8\x85\xf3\xc5\x8e\x83\xfb\xc7'\xf6o\xf0k\x1d\x0c\x9b\xf7=\xe1\xee+!Nu\"t`\xe4\x1dY\x12p;\xce\x11\xec_\xaa\xfe\xb8I\xc16\xd3\x88O\xb8^\x84\\ \xe3\x08q)\xe0\xa3\x0f\x04\x949T:\x01\xdc;\xa3\xd9\x97\x1ai\xa5\xf1\xbe\xb8\xfb\x05\xd7\x1fx\xc3\xad\xae8}t\x98\xa9\xe3V\xe4y\xc4\xads\x94\x7f\xc4\".\x8a\x01\x8d\xa8f\xb7\xe3\xdd\x1b\xcbq2\xa2\x1cb\x880\x00\x1eY\xf2~\x81\xe6:\x94\x1eI\xe6.\x03\xb1\x1by&Xi\x9ft\xc1\x0f\x116\x7f\xf0\xda\x15\xb1\xf4B\x987J'\xee\xf8\xd6F5\xe64\xcc\xfc\x0b/Q\xc0\xe3\x896\xb5'\x91\xb70\xba'4\x18\x18)\xee(\x85@\xee~3\"\xf7at\x7fQ\xbc\xf6\xb2\x84\xce3,\xcc\xc9\x16'\xb8B\xed\xfeg\"\xf2VW$\x94V[\x15F\xf4\x82\xf0C\xd5Z(f\xd4\xe2\x1b\xa8\xcd\xd1\xbd\x88F\x9a\xcb\x96H\x8d\xfe\xcf\xb1`\xa4t=\x97\xf3\xf4\xb3GQy\xe7?}\xfc\xddV\x08#\x10\xbb&\xd5\xd5\xa9r1\xc2\xf1\"\x85\xee\xa0\xad\xfe\x17\xea\x18\xf1z(J3\xe7\xc6t\xc5\x8e\xb5\xa0\xb9\xb0\xff\x90\x92<\xd2*\xf9fBh4\xd3\xb24\x12\xa8\xee\xeb\x1b\xeb\xf6\x8a\xed\xa8\xc7\x8ej\xbbZ\x1f\xc5`6\xf3\x1a\xdcMi\xaar\xb4\x15\xb8\x973l\xbd^\x8f\xac\x9e\x0f3*q\x12\xe2\xb4\xa0!\xd1K[\x07W\xeb5`a\x04\x01\x08\xa3N\x0e\xc9\xb7n\x89\x1aeJ\xab\x06\xd1\xb4\x0a@jJ\xfb\x04 \x06C\x88\x91;\x01\xea<\xeaW\xfd\xd0\xd5\xdc\xad-\xfe\x1b\x90O^\x09\x13\x1b-\x7f+\xec\xd5\x1e[\xb9\x9fg\xac\x8c\xe5)\x0e\x9b\xe1\x8dr\x86\x92\xd6\xb0\xaf\x05R\x8c\xa3\xa5\x81oL\xb7\xfc\xc8|?}7\xa9\x97\x88\xe1K\xfa\x96\xa7\xdd\xa5\x1e\x19\xc5Q\xd9\x1b\x11\xdc\xb9\xdfDB\xfd(wVr\xb3\xd7\xaag\x90G\xf0g}3s\x0d\xdc\x18\x11_\xba1\xdd\xf1\x86\x0bv\xbdY\xa6O6d2*\xf5A\xfb\x13\"/\xe2\xd0\xdf\xb8\xb4\xb2;\x18\x10\xf4\x14QN\xba\x95\x80\xefz\xdb}z\xa4\x11S\xa5<2\x1c\xe2\x95\xb5\x15\x84]\xe4\xc8d=\x8f\xfd\x9a\x06\xe0\xf3\xf5i\x13\x91bFB;,\xa8\xb3\xf7\x95\x83\xb1\xb8\x15\\a$l?\xe6~\x84\x80\x80\x14\xc1d\x12\x11\x03\x11`\x9c\xdb)\x92AGH\x96<p\xd6\xb4\xb7\\,\xe1\xc17X\x0fl\x94\xc3K\x08\xbf\xa0\xe8\xe2\xa6\x81\x97n\xa7-\xf5\x18v\xd6\x1d\xe9\xd3\x1f\xe9\x0f\xc9*\x7f\xcb\xc4\xeaU\x8d\xc9\xda\xe2\xa4r\xb6\xa4\x1c\xc3\x83\xb5\x9for\x08|d\xfb\x02\x83\xa3J\x19\x07\xa3\xa8~\x14\x02\x16\xc3t#\x98 2\xdf\x8a\xd0w.\xe1\xef\xccs\x9d\x08'\xf1\x13\x12\xe7\xd1?(\xec\xa80J\x91R^\xc0\xb6\x0a\x85\x93Q\x87xHY\xdb\xd6xa\x8c\x08-\xe0\xae+?\xf4\xe8\x15\xb5\x0a3Ue\x87\xb8XF\xa1\x8eM@\x1dO\xd87D\x16:\xfd6\xef\xff\x0a\xd0!4d\xc5\x85\x157_S\xe8K&\xb9\xb3o.\xf1)\xd9\x9f\xaa\xd2M\x982G\x0c\xec\x8b\xc5\x9d:\xc2S\xaf\x9d\xa92\xd5\x9b\xea\x0a\x83\x8f\x8eJ\xf0\x98\x8b\xb8\xf6\"%\xae\x145\xd8\x94K\x1b\xe5AXi\x16\xcb8\xbe\x0az\xfc\x0d\xf4&SNlf\x1az\xfa\x11^*\xc1\x06\xd9)\xe3h\xc9\xe2\xd0\x80\x89\xf8c\xad\x01\x09\xb5\xa2\x93!4\x0c\x9fh\xdd\xd9rt\x95I\xe1\x955@_\xa4\xe1\x90\xaf\x039\xa6\xf79$\x1e>\xf9\x93f\xec\x08\xbcC\xaa\x19:\x07\xd7\"\xe8\xdb\xb9\x88\xb3\x06\xf1\x08\xddF\xadNp$Q\x86:\xe0\xbc\x1bs\x99h\xd6\xe4\xd0P/\xd4-/\xf65\xee\x8f\x17I\x80[\x8b{!\xee\x0e\x0c{\xf4*\x9ey\x01Cm\xa4\xdd\x18J\x1dE\x19\x07\xaf\xd4\x837\x97(\xf5 \x0c\x04\xce\xd0\x02\xf1!\xee\x0f\xd3b\x13\x7f\x04#\x90\xc8t\xb6M\xd8@\xbc|\xc3P\xb9I\xbecu$\xc5d\x9eCvMH\x80@\x9c\xed\x8e\x994\xa2_D\xe6\xf8\xe4t\x0a \xcc\x12\xe2\xbceX0\xe2\xdd\xd2\x06\xe3\x03\x07\x98h\x0c\x05\x95\x01\xef\xdfrQ\xff\xf8\xe0'\x88\x94\xb5\xd5pc\xca#\xfd\xd1\x80\xd6\xbaB\xa8\x9d\x04\x1eY\x89,!\xb1j%Z\x948\x13!\\\xef\xfe\xed\xd2\x93\x86\x8ff\xfa\xfa~\xc3`}\xf8b~\x02\xf9\x0d\x0b}{j$\xe6=\xeaq\xa7\xf4\x17\xc2A\xdbi\x10\xa49A\x09\xe3\xfe |M\x03\xdc\xc4fiY\x0d\x1c\x88\x91\x7f\x9c\xab\xde\xb6\xb6[EV=\xfa4SH\xc8+\x00\xa9\xc1\x93\xdcg\x99\xd4CO\xfc5OrP\x89\x92vv46\xa3[\x8aJ0\xa5C\xc4+\x13\xf2\xfa\x02\xbd\xfb $\xab8\xc8\x18\x1d`\xfe\xfbT\x08\xbd-`\xf4\xe8\x1e\xfd\x8c\x8f\xcdIQX,\xb2#R\xf0,G\xc4'qr\xf8\xd2\xdd\xbb\x96R\x19O6YO\xde\xe5I\xa5\xe0r\x1e\x82?\x85_S-\x01\x9a\xb0;?\x13\xd8Gq\xd6g\"\xd5\xaa\xcbf\xb0\xd3\xbd\xc1\x03\xef\xf8\xd5\xe2\x0e\xa3\xc9o\xc2\xef\xfeZ$\x05g\xb0\x93\\\xa0\xeb\xbe\x9al\xf0\x87\x90[fi6\xa5A\xdc@\x0bG\xf7\xb2\xba,T\xc1#kJB,\xd8\x1cIT\xadC\x8f\xa2\x13j]k\x88\xa0XH\x94\xe2C\xa9\x92\x10\xa7\xcfDU\xed\x84\x15\xf7\x1bh\xdc\xa7\xcb\xb3\xf3\xf5\x0c\x7f\xc1A\x0c\xf6\x95\x9b\"\xc5q\xae\xba\x89\xac\xf4\xdaN\xe4\xe3v\x01\xbb\xbb\xa7F\xddo[\x18 \xaaz\x7f\xb55\x11\xfd\xb9\xbcS\x99d\xbc\xe8\x9e\xab\xd9\xc2\x80\x06bE\x1e\xf8/L,\x1b\x89\xcfM=%\xb5\x08g\xc8\x19\xd7*-\xb5|\x19\x81\x84\xb9\xc9>T+\x0b{\x88\x9b\xc4s\x08`\xc9\xdbq\xdex\xa2\x81-ub{o\x86\xb3\x1f\"\x95\x12yp\xeco(i&W\x93\xe7=C\xb1\xa2\xb4<\x19\x8f/H\x07\xbf\xd2e5\xf3_e\x03p\x11\xd1n\xf0\x02\x8d{\xa4\xbd\xa4%\\1\x00\xcd/\xfa\x84!\xf3\xe0\x15vI\x80~\xb4l\xfa\x04\x9a\x8bb\x00\"\x7f\x1a4\xe7\xc5r(\xf7qR\xb9\xf8\xaf\x1f\x7f\xfb\xf17\xdb" +
+	"L})\xbf\xe5P5\xa6\xb5\xa3:\xb33\xb4\xab\xa0;[\x8a\x09\x93\x07N\xe0,\x8a\x9d\x96\xa2R\x81\"\x1e\x9e\x0a@V\xb7\xce\xc8\xee\xef\x03\xff\xbaV=\xb3D\xc1\x08\x96#\x8a$?A\xd9k\xfa\x02\x07\xc8\xf1b\xdb!Kl\x8a\xb6\x9fI+\xb2\x9d\xbfc\xad6U\xde\xea\x837=e\x9e\xd6=\x9e\xa7\xcbx@\xd93=)\x00\xf0N\x94\x04\x17\xd7\xad\xc9g\x0b\xdc\xea\xee)\xb9\xe7A\xf83z*\xbc\xc5\xd0=\x8f\x08\xf3\xe4\x87X_\xecpk?ap*\xbc\x03P?V\xa2\x9fU\xef\x9c\xbc\xee\x9e\xfaW\x04\x8e\xc0]\xc9x\xc1\xc6P>B,\xc0\xd1\xde\xe9%\x7f\xb6\xd1L\xdfT'\x8bP?]\x0d|\x10\x94-\x19\x98)@P\x1e!\x01\xb1\xc7\xaa\xb6\xdd\xcaM\xbd\xd0\xfb4\xf2\x9fs\xfc\x06PE\x07/\xd5\xc2\xf9\xd2\xbb\xcd\x99\x0c\x17\xfa\x82\xaf\x86\xbc@\x8d\x9cDdm\x87t\xf7\x17FvO`\xd6)F\xaf\x044\x06\x9a9\x99\x91-]\xf5\x1aJ\\\x12\x008\x8bGp\xe2\xd6^\xb4\xa0\x8a\x06wm\x17\xc9\xc6\xf1'\x05\x82v\xa7\xf0\xce\x99\xf2{\x06\xb6`\xacA:\xb4J\xf6\x8c5\x1d\xb3\x85@4W\xea\xaa\xad>P\xd8\x81\x1f\xb0\xf0%\xab\xdb 3\x8c\xa0\x1d\xe3\x1a\x15\xde\xa3\xb5\xba\x9e\xa0\x1f\xfaF\"\x12B\xcfs\x94\x7f\xa8\x15\x8a\x1d\xfa\x09j\xf81\xf2\x80)[|\x03\xb6=Q\x8c\xb6\xae\x1c!M\x1dt\x7f\x13l\xef\x83<\x80\x14S\x9d\xcf\xb2\xf3)\x19q\xe8\x0e\x1a+\xe4\xc66\xfe*\x9e\xa1.\x87W#\xc0\x81\xfeQ\\a\x10\xdf^\xd7\x00gUzn\xc0\xa5\x15p\x98\xb2\x91\xd3\xdc\x80\x8f\xf4\xa3}}\x88u\xa5\xb1\x80\xc6OB\xd2U_\xd2\xbc\xa2+\xee(\x80\x9e4+\x1da6\xce\x8c\xa8\x0b\xba\xea\xfc\xf5\xc8!\xf8k\xb1F8\x95\x88\x9d \xa5Q\xc5\xa7\x14\xb1_nD\x16b\xca\x8e\xa9ZD\x14%\xf5\xe5@\xce\x98\x8b\xb4\x9a(\x10DC\xcb\xb7\x1b\xc0\xf4\xca\x1a\xf6\xe8\x1e\xd7/{Z{\xd0\xcf\x03qtw6\xe3\xeej\xbb\xbd4U\\\xabw\xc8\x9e\x83 rM9\xe8\x83\xccXK\x85{7\xb2d\xc20\xe2\xb3l\xa0\x1e\xa0\xbbw\x85\xab\x92\x85\x11\x8a\x10\xf1\xf18\xb22\xe7\xc8\xf9 \xbd\x84\x84\xc8\xa2\xd3q\xef]X\xf8lU2\xd7R\x14\x85\xbd/y \x9fi\x93X8\x05a\x84\xcc\x87\x82\xd2\xda\x1a\xe3\xc4+1<Y\xcc\xb2\x8d\xe6\x0cd\x0c\x1c\xc8\x94\xf2F\x0f\xbe\xa9\xdes\xdf\xf4l\xb2>\xf6\xcf\xf1\x84\xea\x8c>\x9c\xd4X-J\xf8\xba\xbe\x91)\xe5\xf1rL\xf4^X\xec]\x0a\x1d\x7f\x10\xbe8\x09\xfb\xdb\xc5\x8a\x1d\xd7,\x01\xeb)K|\xc4W\xb2^\x8e\xde\x86Q\x8e\x93\x17g\xa7\xe7\xdeE\xd2ML\xfa\x89A`6\x00\xc34@\x89\xe6\x1d\xc9\xcd\xfax\x00Y\x87\x0f\x12?\x84{\x8ek\x0e\xca$\xc2m\xe7\xf21\xb5\x19\x17%\x91i\x82EI\x0b\xa9\x8a\xf4\xb5\x095\xf4\xb63\x1c\x89{9\xdf\x14\x86KH\xf7\xb1\xea\xaf\x95\xd4\xf6S\xf3\\W\xd1\xd2\x12\xb8\xc7\xbd\xb3\xfb\x86\xd5\x8a\x94\x98\x95ix(F\xdb\x81\xbc\xff\x03\x089\x08\x976\xe2\x927\xdd\x0dL\x98\xdc\xfc\xbb\xc1\x10!P\x85\xa4\xc8\\\xc70^\x18\x08\xc1X\xf4\x92\xb3\x98\xbc\xaf\xc9rM\x15`v\\J,\xea\x83A*\xdc\x1b`\xfc\x8d\xa9RX\x99\xbe\x89\xbaH\x947\xf1\xf1\xe66\x02\x93'\xf7\xf72e\xd0\x99\xc2\xc8\xe91\xc5\xea\xd0\x19\xce\xe1\x93\xc49\xfc\x97\xbb\xffq\xf7\x87\xfb;\x87.\xbc\xd8\x14\xbb\xe0&\xde\xc79<\x08\x9d!\x95,\x85\xfd\xf5\x17Y@\x16\xd9\xf1P\x19\x98f\xc9/6\xfd9J\x9d\xd7`\xf0\xa7\xae\xdf\x86\x05H\xf2`\"A1\x153Z\xa3\xc7a\xae\xb5\x10\x15\xb0O\xe2\xf1\xb4\xcb\xf8\xb2.\xa8\x07[\xeeg\xe7`\\\xb3\xd8\xdd\xae`n\"\xa4\x05\xcd\xf4\x9d;\xcd;{\xe1\x1c\xc6\xe8}\x14\xb7\x9f)\xaf`\xdb\x9b\x0a\x82\xa9\xc4\x01\xedY\xa1\xb7Q\x08\xb8k\x04\x8f\xd1S\xb5\xb97v\x140\xc3\xd30\x98\x12\x83m\xcc\x98Q\xb6\xb1\xc0Z\x85\xcb\x83c`\xb6\\\xb9\xbb\xe0\xa8|\x1e;)K\xde\x09u\xef\xb5\xae\x88\x11|=\x85[;\xb7\xb7\xac\xd9;\xb1p\x83S?W\x11D\xd7\x86\xe2\xec\xd0\x99\xafL\xb2\x97`y\xd1@V\xb2\xdcH\x9b\xcc\xe4H\xedb!\xac=\"\x97\xf4YwM\x12r\x94\xd3\xf5is\x0f\x98\xa7_\xf0\x0fW\x01\xfdO'\x80\xfe\xd2\x00\x17H\xd3a.R\xcf$\x9aC9\xe5U\x14\xfe\xb3en\x18\x86\x11\xd6\x02\xa7\xdc\xfb\xb3|H\x89D\xc9bpC\x17\xab\x00ky\xa1m+\xfbu\xe0mnO\xd5\x090)E\xc9\x9a\xda-E\xfc\x1b\xbc\xb9\x16n\xd7\xcb\x8a\x1f>_d>\x0d)\xe8\x9a@\xb2\xec\x9d\x899\xb5\xf3\xc7tiDLg5\xca\xf60^\xb7AK\xb14\xf0\x8b\xe2s\xef\x19\xc9hQ\x93\xaf2*\x19\xfc\xb3^j\x93d\xc92\x9b}8;\x85\xc2@d\x94\x080\xac\x9dU}\x81\x8a\xaf\x07hC\xe0\xb9\xadz\xf4\xfa2\x88]\xa2n\xa4,>\xa1Bq\xa1T/\x83\xeb\x85\x11\xeaS1j?3\x99Nc\xaa\xff2\x98\x8b\xb8\xdf\xa5a\xdaC\xc1\xbfL\xc5\xaa\xf7\x19\xcc0KW\x0a!J\x86#f\xe7:\xf4\x9a\xe1\\z\xa7S\xe9;\x0a\xa1\xec\x8bo\xe0o\xa0\xcfHR|;\xa3\x0b\x137\xb2*\x0d\xa3\x92\x09\xf2\xdd\x90A\x0a\xa5\x18!\xa7\xea\xd6:\x9d\"\x19\xa4-\xbb\xaf\xb0#\xd4\x8f\xbd.\x94\x86\x1az\xe6\x98\xe2!\xcc\xa0\x92\xfe\x82\xc1Z#\x0c\x82\x18u\x96\xb6i\xb8bf\x08\x99\x15\xc9\xb9\xec3\xa8V\x02sp\"M\x81-h~\xad\x10\xba\xc4I%\x17\xfb\x8b0>\xee\xfeR\x19M\xc9\xeai|Mh\x89L\x18\xf7]\xc1p\xcc\x9f\xf7\x079+\x16\xe8\xff\x8b\xd7\xcd\xf1\x8d\x19\xe1$\x97&\xc6}r.\xd5/c\x9c>\xd6\x90c\xde\xdf\x10\xa9\xdc\xe4\xfb\xb3W*\xe8J\xe4b\xf9\xef\xb4\xb6n\x8d\xd6g\xea\xe1l\x8f\xeee\xa3U\xcf\x95C0Q\xcc U\xdd\xed\xacG\xc8\x94\x9d\x01\x8d\xec\xf1\xf8\xafU\xceU\xc3\x119\xf3\xcc\xd5\x0b\xf20L\x17\xea\xfe|\x18\xc4\xb6Wh\x01:N^\x16_\xb0\xe7Oi\x10>\x83B1\xca\x00c\xd4\xb7\xf4\xd2\xbe\x1e\x7f\x8a\xaa\xaf$!p\x85\xd9\x9f\x91\x0f5\xa6<\xd3\xde\xbb\xc6\xacf\xaf\xa3#*\xaa.&\x1a5\xa2W\xb6kj]5ks9>Z4A\x0e\x88\x92\xa4\xd2\xcb\xc36\xedO\xe9\x03 \xcc\xd5H\xa7<*\x8c6+\xfc\x08\x91\x097\xd8\x93\x0d\x94\x01\xadm\xc0p\xb9t\x06\xbbOdg\x84a\x92\xe0\x18\xbf\x12\x1c\xee\x1dt\x93\xb2\xba\xc36\xe5\x8fL1\xcc\xb8g\x8f\xb1\xdf\xdew\xdfQ\x12\x10\x7fF\xb3\xc5\x07RZ\xab\xbcU\x9a:|\xb6\xc0\x8d\xa5XVk]\xbc5\x83\xe4\xe7[\x03\xd7\xe3hX\xdb`F \x15CJ~\xb2\x8a\xc8\x16;\x8d\xab\xa4[/R\xd2-\x1f=\xc3`\xffa\x9b\x7f'\xa8\xc4(6!\x8f\x07\x19\x05\xc0\xb4\xca\x16\x198t\x04\x0f\xb0\xf3\xc2\xa3K\\\xed\x87m\x02\x9c\xe9\xce\x13\x0e\xf6\xa4X\x97\xe6\x97\x9c\xcd[j\xb2\x8f\xa7\xc2\xe7\xf8 d\xb3{\xa30\xee\x8cf]\x14\x1a\xbc\x07\x85\xdf\x93'1\xa9f\x9b\x80\x81\x97\xe9\xff3\xe5A!\x82M\xb7'\xe3\xb2mu\x88s\x8d\x17\xd3\x1c\x82+\xb7 3\xad\x7f\xb9\xfa\xd4\x9f-_;\xd1\xbb\xcb \xd7\x0b\x9c\xe0\x0c\x1f\x8a\x18g\xa8]\xd1\xf8\xd8\xb0\x08\x13=O_\x89t\xb6\x1d\x13\x8bE%\x96\xb7\xf0=|\"J\x97kTi\xf2\xcd\x0c6\xef\xb7\xc0\xe8{\xa2\x98\xa3*\x95\xc9I\xbb_\x81\x89\x12\xbd\xff\xfe\xd5a\x9b\xb6{\x86O\x89t\xf2\x9a\xaa\x04S4Fx\x89\x1b\x16\xc3\xab\xab\x9b\x15\x87$\x90q7,\xe0`\xf8<\xed\xce\x90A\xaf\xfd9U\x1b\x8e~\xd7S\xb4f\xaa\xf0NvU\xb6\\\xe6Tm\x1a7mz\x07\x84\xe0\x7fx\x95\xa7\x93])^x\xfax\xf1\x14u\xec8\x02\xb4\x89+E\x09\x86N\xd7fa;\xe1-_\xbe\xd0\x93\xc5\x0bMk\x1a6%\x1eT\xa6h\xf1\x82\x09\x15u\xccc(Y\xa4\x80,.\x05+\xb5,\x8e\x90\x13\x96x\xfal\xa6\x84=\xad^[\xb9\x84\x1cN;\x97\x07\xdc\xab<\x0cw\x98\xc2\xda\x06d\xd2G\x805\xc2\xab\x91\x88\xfe\xae6#\xec=9S\xca\xcc\xc4p\x08\xd9G1\xd2\x9d\x11\xd5~\x1aE\xb5\x7f\xfd\xf1\xf7w?~\xfc\xed\xb6<i\xaa\"\xd2\x9a,i\xb8\x88^`\x96\xf8\xee\xc6|\xd0\xd6\x10\x17<\xbb\xf1cvh1AW\x1c\xb0D\xc79T\xf6E\xd5\x89o\xc2\x19\xe4\x1fd\xe0\xfe\xadq\xd76\xfbb\xb4\x0d\x9d\xdc\x0d\xfe\xc3)\xf2o\x86\xcc?\xb7$\x0fJ\xab\xe0P\x04\x80\x1b]\xd5\xa3\x8fo\xed`\xf5\xc50\xb0pS\xb5@\xb7\xeaC\x83\xed\xd5\xf6+\xf9\xd8\xa7\xc9\xf6#w\x8c\x0cP\xb4\xd3\xb8y\xa1k\xbd#Y\xd0m\x14\xd4\x97\x93\xb8\xc9\xe7\xa6\x99\xd5\x8a]7?>\x9b\x15Il\x81\xca<\x9d\xaf\xdd8\x9b\xa0\xaepKZ\xa39\x7f>\xc7X\x8c\x1e\x02\x1b\x91\xac2E\x0c-kg\xf9\x8b\xd8o`\xea\x16\xf7?\xd4\\ij\x99\x17\x19A\xf5XSP\x9eD2xo*\xf7\x09h\xe05\xf4fc\xec4\xfb\xfa\x84\xb62\x08\x07M\xc0\xdc\xbf\xd8\x8e\xa6\xff\x92\xb7*M\xcb\x84I\xc0\x09t@\x9a\xe7)\x9b\x81\xbf\x89\xc5\x0e\xe9\xa8\x8bY\xa2x\x8c&N\x80\xc8\xc9I\xf4@\xb9\xb4\x0dEH\xd6y\xb8\x9f\xac,\x8b\x18\xbf\x82NH\xb2\xc1d\x84\xdf\xdbj\xa8\x0d\xd5\x82\x08\x9f\x8e\xad\xf1\xd6\xc8\xe0;\xd2\x9f\xbc\xc0\xfb\xaf\xe2,\xd7\xb9\xea\x89e\xaa1%\\Z\xabRR.\x85\xbc\x0f\xc7(\x07\xe3y\xa1#\xcd\xe4\x11_)\x98\xe1\xf6\x1a\xb3_\xed\xc3<\xbc\x10\x07E\xbc5f\xf7\xf4H\x1e^\x10\x9e\xff\x1e\x93\xfc\x14\x9e\xdd\xf1^\x83\xe0\xc4\xae\x04C\xd6=\xbc[\x05\xd1?\x9c\xe4\xca\x14xh !;i\x87/LEw\xb5\x1d\xb1['\x84Wv\x84e\xc9\x80\xae\x08][J\xbc\xb8p\xd7Wf[\xcc\x87\x9a\x845\x1a\x12\x98r&D\xb6X\x00_\x09\xff\x0be\xc6\xba\x11\x8c\xd2\x97D\xc2\xcfL\x0a\xf7\x98\xef\xc6\xb7\xb1\xdf4\xebBx\xff?\xc3\x0d\\\x8e\x1f\xdckl\xbeS4n\x9f\xec\x0b\x98\xd0\xee\xcd\xd1\xbdd\x14\x89\xbc\x89\xb1;\x0ae\xc8\xb5\x12\x99\xdc:\x1f&\xbd\x14\xf7\"\xd9\x88m\x0fmFd\x9e;A!N\x854av\xfd\xd8Fo\xa1\x81k5zh\"\x1e\x82%\\\x86\xdb\xb5\xfe?[\x9e\"\x0f\x0a\x99\xfc\x1d|\xef\xc2\xce\xbe\xe2l\xcf+a'5[\xfb@\x88\x19\x81\x183\x19\xdc\xbd\xf8\xa7\xf0\x10\xba\xac\x96/F\x8a\xcaL\x94\xcc\x04\x13\x92\xbd\xdab<}\x1e_*\x1e\xcfh\xb91\xb78\xa5\xb3\xafU\x7f5\xa4] \xc9\xfd\x0d\x96\xdbG\xd1\xe1\x8d\xb6\xb1ug\xdfh\x9c.\xaf\x11rGy\x03\xba5\x93B\xd3\xb8\xeb\x93pz\x08z\xc9\xde\x06lf\" \xd4\x95\x00C\x18:\xae\xd6'\xbc\xa2\x16\xa0\xdb\xeb\xbc\x08\xd8\xfb\xc4YB\x14\x95+\xb0\x0cD!\x09\xba\x9a\xbb@\xbb\x92f\xcb\x08\xb2\xfa\x84\x9d\x11\x06c\x1e4\xb84\xdaxE\xdb\xe6\xec\x8c\xba\x81rv\xfb\x0c\xa6\xf57\x15%9i\xd3$h\xd4\x04\x9e\xba\xe7L\xfa\xf4\xab\x87\xed\x08\xad\xef~\xfd\xa7]*\x83\x9c}\xdd\x0d1~\x83\x91\xf0\xff\xa9:c\xc1\x05\xdc@\xb1\x1b\xa1\xbb\xe2\x00\xc7aS\xe8\xf6\xaf\xb6\x83\xbc\xe8\x87HZ\x98czC5Vc5\xb8\xee\x98 \xf5\xe7\\0\xde\x0c8\xaa\xd8\xc3\xc5\xb4+\x13aJ*\x88-F\xb6U\xe5\xec\x04\xa2\xa7\xf1\xd8\xa5\x09\xb3\"\x96\x02IZ\x97=\x9d\x8d\xe8[\x08\xfd\xc6MJbL\xfb\xdfCu\x85\x01\xba\xf3_,RGm\xc7\x89\xfd8j\xddIf\xcf\xda\x87h\x873\xf2\xd1\xb3\xf31\xcd\xee\x8c\x07\xaf\x0ai\xc2h^m{\xe5\xcf\"N\xa9\x1f?\xfe\xee\xee\xdf\xdc\xeb\x96W\xee\xfb\x191\x1c\xa3E#\xb3\x0f\xb3\x0e\xcc!N\xa9\xc6!Tb\x91\x9a-\xa6L\xe8Hol\xeb\x8eeK\x07\x9fTU\xa9S\x01\x15\xa1\xed2\xab\xe6\xa3\x0c z\xe0\xd7\xf7|A\xbd\x99\x99\xa7\xa7\xaa\x7f\xe7\xc2\x1a\x1c\xd0\xc3F\xbcw\xdb\x9d\xed\x8d\xd1d\xdf\x82\xd8\xa2jH\xe8Y5K\xc3P\xa3\xac,\x9b\xf0\xf8\xeb\x9e\xe7\xe3\x86\x10j\xc6\xbdaR\x0e\xfb\x95\xd2\x1b\xbc\x0c\xd97g:\xdd:\xe0\xae'ap\xf3\xa7\xb8\xf5\xdb\xcfv\xd1\xd5\xb4\x0b\xb8\x9aJ\x03\xf5\xb2<!I\x0a\x1f|\xf9\xc5O,\xb5\x98\xcfz\xcd\xe5>\xdczq\xa7\x0c\xdc\xe0\x90\x13\x89\x01>\xed3e\xd9\x912\x8b\xd9\x0d<z\x8f\x1e:\x99\xf6\"Dezw\x0f\xb6\xdf\x06\x10J\xb5[\x0b#Q$\xecT\xaeg/s9\xec\x17\x8aVO6\x16\xd7\x9bCT\xec\x13T8\xa1A\xb8L\x14\xad\xd1\xac\xac\xdbp\xc3\x90\x87a@o\xd1\xc1\x0f<\x05\x15\x91\x08-\xbd\x00\xf2su*\x1c\xc8\x85\x11\xa8D\xb0\x83c\xbb\x86\xdb\x7f>\x13\xcaT1\xfe\xa8J\xa64\x83\xadI\xbb\x95\x0f\xf6()#4\x82\xa3iS\x19\xa3\xd2\x8c\xf0!\xa7\xee\xeb\x9b\x19Z\x1a\x95\x05\xd2U\xc4>\xab\xec\x89\x94\xbc&\x1a\xa2\xcd\xcb\x0b\x04\x9cuB>\x14\xd8\x83\x08u\x1c\x14U\x83\x95\x85\x0bn\xe7j\xb8Hd\xad7\xad\xeb\xd2\xb0V\x00\xf4\xe9\xf2}\xa8\xe7\xc6%\xf9\x81\"\x8e\x0b\xa0\x92\xc7\xe9\x9ch1/\xe3\xc4\xa1\x83UY\xf7\xa0\x10[%\xc5u\x88\xf1\\\xc3\xbc=\x8c\"m\xc2\x8c\xe4\x8bS\xb4\x96IP\x0c\x93\x1e\x1c4\xb8`R\xa3^\xd4\xd5M\xd5w\x06\xedtt\xbd\xc4>:\xbb\xf4Y\x0f\xd8\xd2h2a\x9c\xbar@\x9eQr=\xda\xb3\xeb\x0a\x01\xb7a\xee\xf2^\xbf\xa7\xc5L\xc1\x07\x86*\xbb\xf7\xc6\xaa\xcfR\xf9S\x15\xff2,\xb6\xb45\xd5Z5l\x9dI\x8c\xe8\x8a,21\x9di\x0f\x83|\xcb\xb7\xb4s\x93\xa8&\xe2\x03\x1dST\xf3\xad$\xc4\xa6?\x03`#\x03\x12\xd3\xfct@\x01\x8a\xdd\xd5\xe1\xe1\x8f\xf8\xca\x03\x81s\x88\x8a\xee\xa5\xe3\xfeyw\xc5\xc9\x02\xe3\x1f\x11\xde\xcb\xa01w\xec\x93\xbd\x84\xef\x1e\xf15ON\x90\xe0SU\xc9$\xd1\xd1\xa3;U7n[\xa2?>\xd1\x07\x04\xf5\xbf\x1aw\xba\xe0\xab\x8cBg\x88\x9fop_\xaf\xe1\x1ddH\x0c\xca\x16\xef\xbe~t/\xa2\x98\xe6-\xb4p\xd9(\xc0\x88gn\\\xa3\x99\xb9{+\xc6\xee\x08<\xc0\xf6/\xb5h\x14U\xcfP\xf1\x11\xb7y\xb6\xdd\xc0\xa5\xca\x88\x92\xed\xbe\xa3\xbd6\x10\x98\x94\xa8e\xe0\xec1\xb8\x84\xe3N)$\x95\xa6\x03\xca\xaf\x0f\xd0\x0c.\xce\x90\xc9M\xe3o\xa8\xae\x1a\xe3\x1cF\x83\x8a!\x98\xf1\xba\x85\xee\xc8\x7f\x11..#\x8c\xba|\xb6\x84\x10\xd2`\xf0>\x1aU\xbe\x86?\xd4\xcf\xb1_\xe4?i\xedhB\xc1l\xd5\x8fp\xc2r\xa0\xed\xd0\xdb\x17*#\x10]\x14\xe3\xbd\x1d\x14;:\xfc\x0fq\x0d\x0b\xf7\x82\xbe\xea\xac\x158\x83)Zx\x07\xad\x92\x95\xe2\x9d\xae\x87\x1bU=8\xacA*\x1fNNz\xf7\x10\x8c\x14U\xf0FU\\\xa0\xb1\x035x17 \xd06\xfcl\xacN@!\x02\xb7\x01\xd50\xb3'\xbb\xe9\x15\xe6\xbc\x9a\xf2\xb4\x97\xfb\x03`~G\xe7Y\x19\xf6\"s\xc9\x08\x11.U\xff\xc9.\xce\xbc\xed\xd4\x03<bZ\x9f\xf1-\x0d\xd9\xbc\x98\xd9\xd3{\xff>#z\xb8fqs\xd9\x7f\x08\x8f\x8b\x0d\x11\x93\xed\xaa\xf8\x04t\xe7\xc1\xf6G\xfa\x93\x11w\x9c\x97B\xf6\xf1y\xde\xbdP\xae5H\xd0D\x15\xf4IE\x8b\xe2{\x8fpB\xa9eEf\xbb\xe0\xb4\xb6x\x0c\x1f=\x8d\x09\xb1f\xea\xf9\xc9\x16\xd9\xa1?\xe8\"\xa6g\xf1\x8d\xc75\x9c\xdb\xa3(%\xce\xb4(\xa2\xe3#\xf8\xd5V\xf8K\xec\x8d-g\x94\x84\"\x0dr\xf5\x99\xfb\x87hmF\xd4\x05\xa5\x09\x1c\x18\xf7\xe5\xdb\x8eP(\x12\xd2\xc1\xfck=\x0d\xb1O\x99\xb4\xa9\xb2\xa8\xb4\x8d\xa8\x06\xba\xb3\x11\x02'zR\xcaKu\xeb;|c" +
+	"S\xfe]\x8b`\xce{\xc5\xe1\xe4\xa6t\xce\xc8L\xb9\x98\xa7\x82\xae\x18\x11\xa9(}\x01\x9d=\x89\x80\x11\xf8\x0e\x0f\xca\x91\xff\xaeH\xbeM\x0b)1\x10 \x1foM\xbey+\x7f\xe7\xebB\xf6k\xe5\xc2\xbeVr\x16\xe3\xe1\xa2T\x9a\xcf\xd3\xebF\xcfC?\xc8\xf3\xce-\x16\x8eQ\xe1JF\xa5uGu\xb1KJ\x93\x9d\xe1\x11\x18Y\xb8\xc6+\x81\x08O\xf0/\xee\x96\xb5\xc2\x8d\"$\x0a\xb5\x89\x9f\x9b\x9b\x8d2\xec8\xc0\xac\xf0\x81\xcb\xe8\xdfB\xc7\xf7Z\x18\xf1=>\x0e\x85\x9c%\x89\x15\x10G\xba\x0b\xf4P4\x01\x7f\xb2\xc4\x92\xb0\x1c\xd2\xfa,)\x9f\xfc\xc3\xdd\xbf\xb9\xd0\xd6\xdd?n\x05\xb5\x1cT\xbah'J\xf8|wH)\xa08k\x11\x18C\x93#\x0f\x85\xb2\x9b4\xbd\x0fH+\xbcF\xc5\x14\xd0\xc8\xe0\xb0\x1a\x96\xccj\x17\x033'\x8e\xfd\xd3S\xf0\xb3RW\x83Y\x18\x8e\xeee\x15\x86\x98y\xc5\xf6T\xb1\xd2\x10\x97J\xe3\xfb\x17P\x15\xb7\x84\x90T\x1d\xc0l\xe7\x11_\xd7\xba\xf04\xfb\xa6=#\x14\xf2\xdaoGn\xbc\xab\xef\x94\xc6\xe3\x8c:\x83\x90'\xc2x\x87\xcdhK\x9c\xeb\x0d\xab\x04\x99\x10&\xc4e\x9e\x11\xc1E\x09\xb7\xc3*\x11\xa8\xe5d\x10\x0e|\xe4\xbf\xde\xe9\xb8\xc0\xd5\x0eu\x8e\xac\x97F\xb60\x97\xb44\x82\xa4\x0e\x19h\x14\xddD\xcc4\x12\xba\xd3\xbd\xb3}\x1dHI\x8a\xb2:\xd9\x0c\xa5-\xdd\xb4\xa6\x01\x93\x86\xa5\\NJU\xf9x\xc9\x08\x0a\xec\xbe\xf2\xfb\xf8\xbc\xa0\xd8^\xf2\x94\x10\x04=9c\xa9%\xfc\xb8\x98\xc3mU\xb3R\x1e[X\xe9\x10\"\xd0\x17\xaa\xab\xab\xdbt\xb2\xfd\xf4q\xa6\x90*nh\xb5\x90\xea\xe1\xd3iRL\xb9\x1d\xd3\xdc\xe4\xbc\xd2\x85\xca\x01f`Q|\"!\xb2IZ\xd2\xc6\xa8\xe7U\xd3]v)C\xe1\xea/=%\x8b;\xb5\xcf>D&\x97\xa2z\xe1}\xe2\x96\xfb'\xb9\x9f\xbb4\x83\xa5)\x0f\x95C\x10\xab\xaao\x84\xe9\x16\xbd\xa6e2%\xb6\xc8\xb3p%\x7f\xe6\xc7\xf4\xe8\xd3\xf9\xc74\xa3\xc4\xd6\x9b\xc0\x0a|1\x1d\xbc\xdavRe\x18\xa9\xcd\xa6\x1a\x09\x85D\x19DR^D\x00\x18\xb6Y\xbf\xaf\xdf_\x8a\xddF\xaeP\x95\x8c\xd2:\x9a\x15\xef\xcc(\x12\x0dt>\xf2#\x972p\xff\xc2\x9a\\2\x1a\xdd\xe7Ga\x9b\xe0P\x8e\x1f\\\xfeY\x86\x93\xf7\xe7\x7f\x8cO\x17\x1fc$~\xb6\xa3\x01\xdfSz\xd1\xe1\xae2t\xffu\x8f\xd0\xb9\x99\x94\xbbE\x99\xc8u\xacH\\\xbd\xc8y\x92\xf84aB\xfb\xee|6J\x0aZSU+\xc1E0}\xe1R\x04}F\xf5\xe8\x9f\xff1h\x1a\xba\xd9\xe3f*r'8\x99\xaf\xab\xfel4O4\xe6\x85\xea\x88E,\x88$\xfb/q\x97\xf2\xbc\x90\xe5kH\x008\xa1r\x1b\xcd\x19\x8awpZS\x8c\x0d\x9e\x83R\"\x08t\xc2i\xa0Zl0\xcc\xc1\x14p\x15\x92\xf4\x8dZ\x94\xc7\xf3\x81\xa2\xb8\xee\xf5}72\xec\xc1\xddMA\xba\x17\xf8\x94\x99\xc0\x98fUa\x86q\xd5\x17R\xb1\xa1\xf7\x8en\xd4\xbd\x14\x06\xe8\x94\xa1X\x94\xa4Tmg\xd6\xaa\xf8\xfe\xcfm\x01\x01\x8e\xf2e\xf0\x85\x89\xe3Sg\x1b5'\x93;\xa9\xb0@\xa9\xd8E\xbd\xd8g\x94\x0c\x86\xa7Z\x1a\xf3\x83(\x03&a\xc0\x11\x0a\xfb\x83\xed\x8bA\xa8\x8c}\xae\x05p\x17\x0a_\xc9\xa82\xfc\xf3\x8f\xe0\xf3\x85\x11\x0c\xd6\xd5\xcbh\xa4:\xb3\x0f\xa1\x11\x0f\xf7\xf7\xd9@\xdd\x89\xb1\xeai\xf1>\xd8\xc6\xa1\xfc\xca\x93\xbc\xe9\x85xu\x8dz&_\x16St=\xf4\x1f\xb1\x9f!v\xc7\xf1\x8d\xcd\x80\xa3\xfc\xd9G\xf5\xc9\xc3\xec\x94>\xd5\xa2H!%\x1a\x08>1\xc5nti9?\xb8CLPmF\xe8))v\x94,\xc5\x83\xed\x82\xc8/\xd8\x0a\xe4i\xe8\x92\x1cG\xf7\"1\xc7\x1d\x06\xea\x8f\xf8\xba\x8f\x96\x0ff\xd9\xcc\x83\xed\x8a\xc8\xa5\x03\xc0\xef\xf7o\xc1S\x84\xb8C\xcd\xef\xca|\xaeU\x1d\xd4\x92\xabL\xc0\xec\\\xb1I\x18\xbe\xad\x12\xbeyTG\x99\x1a\xad\x0b9\xd3E\xe0\xc5*\xe9\xe8\x93\xffsn\xc6\x93g\xcb\x03>-\x13\x8c\xc6^\x98\xde\x11l\xdc\xb9\xaf\xd2\x1f?\xc4\x88E\xe7 \xae\x9b\x8b\x1c\xd9\xe6HUWV\xf5\xcb\x89B\x8cOI,\x19\xfd[\xcf\xeb\xff\xdcv\xf7\xe4\xf9\\\x8ek\xc9M\xe6\x91\xfbk[\xdb\x1a\x81\x11\x89\xa4d\x00\x98\xf6\xb6\xb6\x9fd\x94v\xfe\xd9o0\x14v\xba\xe7-\xf7\x13hQ\xd8\x7ffy\x82PI\xe8U\xe4)\x8b\xc7,\x14\x19H\xbb\xe7QX\xf2ww\xffp\xf7\xdf\xb6\x02\x92\xdf\x0a\xc5\x11\xf5\x85\xaf;\x08c]?Bw\x0bG|\xdd\xc6\xc5IcT\x15\xa3Fu;\xca\xa8\xf7\xb8+\xd1\xc9\x115\x9bg\xa6_\xdd\xf6\x16\xcb7_\x16\xb5{\xc8\x0b\x1a\x04\xdb\x81@\xa5F\xbf\xderht\xbb\x00m>\"\x11{JDS\x1eKD\xbf\xda\x0e\x09N\x09{\xa5\x91+\x0cP\xbf\xca+A\x8b\x9c$!\x0f%\xe8\xe9\x91\xfe\xe4\x86\xfc\xa2\x96FS\xda\xdeg\x17\xcd0\x9a\x1e.0f\x86\xfe\xa2\xa6(}\x16\xee\x0d\xb3\xcf\x99\x81\xbe\xa8!b\x1b\xf7\xe1\xd9w\x94jf\xac\xebZ\x93\x8f&M\x9eL\x07\x1e\xa3\x82\xa3F\xfa\xed\xeed/v\x14\xf4\xdc\xabXC&\x8f[\xc2\xb7\xd3\xf8\xa5\x05-EyHD\x8fq9\xfb\\V7\x9cz\x92`\xa6\xe8\xca\x07\xf6g\x15\xd7\x9ck\xff\x9d\xed\xd7\xe2\xb3O\x13yrnY\xf4\xde\x95\x9f\x8c\xdb\xa9\xb91G\xf7\"\x84I\xf8\xc5#\xfdY\x1b\xef\xc8\xf2\xaf\xab\x93\xed\x8e\xf8\xeaY\x11\xd7\xf4\xf0\xe73\xa9\xbftw\xfd\xc6G.\xa3\xfc]\x12\xd6\xa1\xf0o\x86,=\xc2w\xe8\xf4\xd8q\xca\xb7T\x94\x87\xfb)k\xa2\xd7\x88\xb5\x12\xfd\xc3\xaa\xfa\xe6}{}_\x1f\xe5\x1f\x19$m\x04\xa98Y\xe2S\x8bT&\xd1\xfc\xbf\x12\xc9\xbd\x17w\xbc\x9an\xb4\x19dm\xd4.\xa6\x08\xd9l\x89w\x18\x16y\xa1\x80\xfb\xf70B\xfb\xbe?C\x07\xe4\xb4f`I\xe6<jQ\xb4!91)\xea\xd1E\xa1W\xd1y\xcc\x88\xd55\xc4\x13A]\x8c\xab>\x17\x0f\xf8HT6#\xa0\x17k^G\xf897\xe0'(\x8f\xee\xa5`\x05\x9e\x0c\x9a\xb7\x05d^]\x0d\xd5\x8dM1z\x89\xa2S\x11\xdc\x0b\xc6\xc3d\x14\x8c\xb1)\xe2\xf7\x00\xd7\x86)\x0d\xd9\xa9\x1dtp\xc9\x08\xd7\x85M\xb9\x8d\xb8q\x87\x0a\xab\xce\x9a\x9f\x88w\xf0\xec\x1f\x88\x95e\xb9A\xcf\x9b}1\x03\x9c2k\xb8\xc2\x89\xed+\x89\x12\x86G\x9f7L\x10\x06\xab\xd5\x92/b\x84J\xa4\xddBg<\xb6\xfd\x0eF\x18=\xcc\xce}X\x9b\x11N\x19\xd1\xb37\x01\xed\xcfS\x01\xf3\xf1_\xc8n\xbe,IL\xe4e\x9a\x1e?\xd1\xc4\xc2\x18_Fn>\xd3\x85\x96\xb9\xe7\xd6\x18\xfa4kTW\x8f&\xd0G\xb5\xb9\x0b\xc6\xb3\xc0DX\x9fI\x98u\x86\xd2\x0cG|-\xfa\xaa\xf5\x04\xd0nj\xae\xe5\xbc\xa3\x13\x121\x8eG|\xf5>\x97\xbdLp\xfd\x19\x01\xb1/\xa6\xa0\\Y\xd3A\xc53\xd2s\x19\x0c\x1cEm:\xa2\x16\x0a\xdf\xc3\xb9\x9bQ\xb1\xf5\x8d,GO\xddC\xa7\xbd\xa0\xa6\x08#\x85\xde\xb7\\\xfd\x9d\xad\xedu\x8bl6\x04\xc6\x14\xbb\x87\x02es\x92\xb7\x14\x836*zO\xb8\xce+R\xeey\xc9\x92V\xc4f\xdd:\xb3\xe3A\xf1v-n\xc8\x0b\xf5\x8b5\x9a\xb0\xe7\x93\xe2\xfd\x83\x14\x06\xf5\x96\xb6\xb6\xabk\xcb\xa31\x84(\xdf9\xe6\x17\xf8\xfe\xe8^\x0e\x93m\xc8=\x86\xa3{Q\x1aFfR\xf6\xef\x86\xb5p\xf1IUi\x8bpS5h\xfa~\x89\x91\x9d!?\"\xc3\xe2\xae\xa2T\xe53Bx_R%j\x80q\xc5\xc9\xffm\x01\x83\xc3\x8a\x82\x81\x1c\x9c\xb9(\xa4'\x9fnC\x1d[\xc1=\xd4\x13\x94c\x84s#\xe9\x0b2\xcb\xaa\x1aF\x8dW\x0bE\x89,P\xb7@\xc4F\x0f\x00']1\x8a\x94\x9c\xa2\xc2\xa7k\xa54\x17\xaf\x1b\xb4\xd8.\x05\xc3\xa91\xfa\xd7\x83H\x1crE\"\xdb\x90#tpIB-\xcb~\xfb\x8b\xc4o\xff\xf1\xee_\xef\xfe\x98\x0d'Z\xc3\xa9\xbc\x83\x93\x1d\x8b]w\x0b\x1f\x02\x1e\xa7v\x96\xc4\x9f\x08(Zj\x1b\x05\xfc\x90\xe6i\x85\x98*\x0f[\x84\xce\x09\xedU\xb2\x97\xd05\x90\x0f\xe2O\x86\x11u\xf4\xc4L\x82\x89\xfa\xd3\xb1Ag\x18m/\x82U;\xaa\xf9\xde\x8e\x09\xec\xa6jXRJ\xebe\xea5\x0f\x00\xef`\xce\x97 \x04\xe5\x08-\xed\x1a,\xcfS nu3`\x904@\xb4&\xa6\x0f\xb8x\xfe\x02.\x97m\xd4\xd0/a\x0c\x0b1v}\x0a\xaa\x09\xa1z\xa2\xed\xe8\xc1\xd7\xd0\x95\xb28\x8b\xd1v\xa5\xad3\xca\x97v\x7f5\xcb\xcd\x16-d\x09\xbb\xa5\x85NZ\xa5\xb84\xbc\xcb\x85\xfdHAk]]I\x03\xaf\xb6\xc3\x0d|\xad\xdd\xe4Q\x167\xf6\x0c{\x8d\xecO\x02A)W\x93\xa0\xdc\x1c\xc0\xa9hl\x0b\xe3\x16\xe0\xe7Q\x02\xd5Oi{\xa0\x01\xabx\x9d4\xa7'#X\xbb3\x8dA\xc8\xcb\xf6\xf6l\xb6\x83\xfe\x0f\xe7\xb1\xedQ\x83\xe4%\x0b)\xb5\xbb\x1e\xdb\xad4\xc0p\x93CO\x8f\x9e\xa77\xd6\xfb\x11\xcd\xab\xd1\x1bY\xf8\xb8\xfcq\x85\"BX~\xc6&I\x8e\xe4\x14\x92\x82\x0f\x8c\xf6\xbb\xa8\x93\xe9\x16\x14\xfc\xbe6\x83\x83\xbf\xda!\x87\x1b\xe7[VD\x96s\xd3\x9d~\xd0\x9d\xcf\xa6a\x91\x91\x86\x05\x9a\xed;S\xa0\x98\\\xf1\x97\xf4\xce\xb7\x17\xfb\x01^n\x079\xe6\x12\xfa\xb1$\xd5aJw/\xb3/\xcf\xed}\xf8<\xa6\xc0\x14\x91\xd5b\x17_(\xf0\x0e\x97@\x03\x88\x19,\xc6\xe6\xfaX \xa5\x7f8\xe2\x1b)\xf2#\x7f0t\xfb\xad)\xf7b\x82t&_\xd9^M{E\xf3\x8b\xad\x97\xa0\x1b\x02\xbdp\x16\xe2\xf75q\xa0\xc3\x05o\xac\xafG\x9fF(0\xf7\xf0\xe7eV\xf1\x11\xdb\xfa\xc6\xfc\x00\xa3\x8a\x89g\xc4E\xbe%\xd6\x8bH\x17\x8c\x1d\xd6\x12\"\x9d\xb0\x1c\x99\xe6yR\xfa\xa1\xea\x81\"\x14X\xd3R\xa0\xbfF\xd4\xaf\xee\x96r\x0aX\xd0\x98\x14\x98z`\x9dC\xa7b\x80\xfe(\xff\xc0\x00\x8e\x17\xa1\xa8z\x1bf~Q\x83m\xf3d\x89w\xdf`\x8f\xa7\xdbz\x8d&\xef\xc8\x8fW+\xef\x10\xeab\x8b~>\xa6d\x0c\xdc\x86R\x9c,\x81L\x87\x86)}1D\xd0\xd9\x93l]\x16YN\xaa!\xc8\x16-m\xc6\x92F'o'P1\x86H\x15}\x9ff\xec.\xb1\xc3\xf7\x19\x01\x16>\x97p\xde\xb3\xba2\xd2\xcc_\x19v\xe7uTk\x1fI|\xc5C\x12\x01(W\xe3X!\xbfGs\x03\x9f\x02\x15:tg\xdbagi>\xe4\xb3e\xc4\x1c9\xdcp\x94\x14p\x8d:\xa7\xfdZ\x9c\xdd-A$R\xe8#\xfe\xee[\x1c:?U]\xb9\xc1\x85\x1d+hZ\x8c\xb5h\xbd\x08J\xd0p=AF\x18\xe5K\xde\xf4\xdfA\x9fR|\x08\x8a\xc03\x898\x9c\x80\x04\xd3\xf7i\xb8|\x15v\x15\xc1\x0d\xe2\x90-\x96\xfe\xbe\xa3ZK-w:Vg{\xc9\x08\xb28\x0d9\xcb\xb5\xb9\xac\x0e[\x0d\x1c\xe9\xe1\x98\x02\xbd\xed&\x89 \xc7\x81\xa1\xe3\xee)\xaf\x91\xcd<Y\xe0U\x8c\xf9\xa9\xd8\x9a\xc4\x9d\xa5\xd8iD[\xc4\x18\x92\x11\x9e\x09\xa4~;\xb2\xaa\xf7\xde\x82\x1d\xd9+\xa5\xc3d\x8d\xd4/\x13\xb2T\x1a}@p\xac[\xe8B1\xd4\xe4\xb5\xb9\x15\xe5\xaeD\xa4\xd7)<\xc2\xea.\xe1j\xbb\xe2'D\xb3\x1fG\xc7:\xed\x0c\xb8\xab\x8f\\l\x9b\xcb\xe0\xf68\x96\x8e\x19\xa6\x11~\xdaK0\x12\xcf!\xf3\xdd\xea\xe6\xf5$Z\x84\xa0n\xf18\xd9\x12\xbd\xc1\x8e\xb3C\x0e\xfd\xce\xeb\x0ctp\x11;\x83\xcd\x0c\xb1J.\xf0\x01\xf9\xca\x06\x9b\xc7\x93>\xbd;\xac?\xba\xf7\xcd=\xdap`FEj\xe9\xcc\xcf`{J~\x9c\xbdBv1\xa0;\x145\\\x85A\x07\xc3>\xabt\xaf\xc1d7\xa0\x0e\x87]\x8b\x1a)\x8a6\xeddq\xeaR\x9d\xa5\xbb\xa9W\xdb\xd8\x9f7\x13\x0a\xb2\xc4\xbf\xe2\"D\xac\xaa\xab+wKV\x8a-\xd6\xc7M\xe9\xfckf\x8d\xeb\xa4\x9aF\x8b\x03Lf\x0a^\x17\x07\xf0D\x85R\xb5\x19\xf7\xdb\x90\x9f\xb0q\xc4t\x7fE\xd2\x14+K\xcawz\x18\xdc\x9c\x09\\\xdfL\\M\x13%\xc0~\xb4\x0a\xf4N\x84\x8a\x0b\xd2L\xf6\xc2\xdb\xc3\xfb\xbe\xc2\x93\x9a*^\xa3\x82\xd2\xc5\x88\xd3\xf3O}\xc4\xe9\x8fw\xffp\xf7o\x9bq&\x03z\xca\xc5,\x1e\x8ca\"[k;\xac\xc4\x982\xbdG{\xf5\xdc\x93\x1d!<\x92m\xb2\xa2H\xd7\x87\xc2\x99\xe8Ez\x01\xb1\x89_\x81O\x1a\xd7\xbc\x03C\xed\x0fy\x0a\xf0\xa5\xbb\x7flq\x1f\xa1\x96\x9dGK\xb6\x19\x13\x82\x857\x18\x12,\x140\xdd\xf7\x00j\xb6\xbd\xda\x8c2\xcd\xed\xe18q\x02\xb3k\x7f\x02\x0a\xd5o\x97\x9f\xe16%\xf4\x01pK*d\xdbt>n\x82_\xa0Q\x0a+;\x87\x99r\x86\x08\x8c0\xb4\xe8\xb4\xf3\xf8\x04\xe5\xa3\xc4\x91c\xfc\xd2>\x8f\xc9\x07&\xf7\x1d\xe9\x07\x04\x80\xa2\xbbLu\xaez\x10}\xfa\xf9\x07\xb3\xdf\x8e41a\x98\x81hO\x10\x0e\xf3\x0ct\x8a\x1e\xdd\xee\x0c\xa7m6\xdf\x87\xcb\xb4\x99AIw\xff\x8b\xb8\xd6\x96n\xe8H\x7f8\x810\x13\xb5\xd9\xac\x1f|\xf8(\xea\xf8z\x1a\x00\xf7b\x86\x9f\x93{{\x81\xd1l\xd8<S>\x1aJr\x1e\xf1\x95\x1f\xabimc`\x15U\xf1$\x82\x87\x95f\xf4.DC\xb5\xd2.F\xb2\xda\xc2S\xd5\x11\x8a\xf5S\xb2\xc7\xe5\xf2\x1a\xa3\xb4\xb0\xf6\x19\xc8\x13\x1a\x1d\xd7k\xaf\x8e{\x82z\x8d\x1b(\xb0\x17b\xb5Y\x13\xa8\x13_\x16 \x09\x05ha\x14s\xb6\xae\xf0\xcc\x87nm\x93P\xc5_\xaaZp\x8e\x8c\xf0UN\xbc$!\xa8;\xcc\xb6\xe4\x1d5\xae:\xca#\x0e\xf6A\x07\xe6E\x12\x8e\x1e\x0c\xc5\xac61\xad\xe1\xe2&\xfc\xec+\xca\xa8P<R\x88\xab\xedi%Y\xfc\xe8\xd1\xa4qt1D\x9b\xd9O~\xbed\x08\xdb\xd2E\xd6\xd9\xc2\x1f=^^\xdc/#\xden\xe1$\x0c$\x1b\xba\x8aWH\xffj/\x8d\xed\x8f\x8f\x1c\xec\x08\xd4B\xa3\xadf\x9d\x9cJa\x19\x0b=\xd9\xea\xc3X\x91\xe0\xd7A\xf3\xc0\xf7\xe0R\x995\xa3\xbev*\x85\xb3\xcf\xc0\x8f\xc4E{\xa4\xf8N\x19\xe49V\xa3\x0c\x8a`Ui\xe7Qi\x11\x0c\xcdx\xc8eF0\x83\x80\x08T\xcf\x03\xb7\xb6?\xe2\xeb\x1a\xbc\xc6/\xb5\x1f\xc0\x8c\x8ci\xa8m\xdfB\x16yo\xc0B\xecH\xb2\x1d\xa1n\x19\xd5P\x02\x9d\x90\xca\x02\x0a\x90;\xf56;\x1e\xe9O\x16,\xe3g\xf7\xef\x1f\xcf\xe2\xbe\xe9\xd7\x83\xf5\x15\xfdm\xd5V5\x15l\xee|Z\xc0\x83\xe6n\xc9&(\xed\x8a\x11\x17\"\x09\x93\xbc\x99\xac\x07\xaf\x8cS\x0c`K\xe8WWW\x08\x19\xe0/\xcc\x89F\xe2\xe46\xe0\xdc\x10@\x8f\xd5_\xbd)\"\x89\xdeUkW\xc1\xa2\x16dx\x12zp_\xb5\xc3IH\x05\xeap.\x9c\x83\x08n\x95\xec\xf9\xe9\xfe9\xc1@[;\xb9\x8c&I\xcf\x88\x03\x8cn\xfa}\xf0|9\xcc\xaeW\xf4+A\x84'\x11\x04\x91\x01\xa9\xc2G;\xed\x90\xbb\x82\x1d\xedu\xbd\xe8\xff\xc9\xc38\x1eC?i@\xf2\x9d5\x92\x9b]\xd7\x0a\x17\xd2\xcc\x1a\xf3~/\xa8Os\x8c>\xc3+{\x181\xe5\xfe\xe3\xc7\xbf\xfb\xf8\xf7\x1f\x7f\xbf\xad\xcb\xfeZ)\x8f\xe0`\x94\xe6\xec\xca4\xcf\x99\xd25\x0b\x1c\x0f'\x175\x18WA\xb9\x8f\xb7\xa9\x19\x14)C^\xca^\x9a\xb1\xdd\x08\xc7P\xe8x\xb2\xddh\x82\x11\xc8b\x00R\x01\x18\x18\x8d\xf6Y\xf9{u\xcf\xc9\xf6\x85\"[9*)\xcf\xe6\x99-\xec\xc8hY\x0eIW\x8d\x85k\x16l_\xda\xe8\xed\xe5\x88\xc0$wW#\xaap\x04\x0ay\xe4G\xda\xdfOG\x06n\xcd\xd1\xbd\x04\x9ahO\xa7\xe3\xcc$J\x93\x1e\xf1\x95('r\xf4e\xd8\x8dw^t\xa0\xab@\x9e@\xdb\xd9\xfb\xb2y &\x12\xba#\xff-v\xd0B\xa3\xc4\xf6\xbd\x94\x04\xc1\x9b\xefI\xe8!\xe8\x11\xd3T\xdd\xed\x06\x8e{ve\x04H2\x92\x07a\x8ci\xb5\x95\xd9u\xc1\xbc\x9e\x06qKyn\x0fa\xb7\xbd\xfe\x1a\xeaI%%,\xabA\xe9\xac\x0c7\xdas\x87\xe2\xf5\x9c\x82U\xb2'\xe4\xfaH\xa7\xaa\xafJ\xa1\xfd\xad\xfaS\xd5" +
+	"\xad@Q\x82\x93T\x0a;R\xeb\x07\x8dn\xca\xe5WL\x1f\x0b\x81\xe0.\xbd\xea\x84F\xab\x01\xdb\x8euP\xbfw\x9etw+\x82\xa8x4:Gx\xed>_\xccr\xefD*p\xda\"\xfe\xde\xf4\xad\xd7\x00\xf4\xf2\xc0\xee\x18tg\xcd\xbe\xe0\xca\x03\xe5X\xee~eN\xbdi\xf6\x19\xb8\xfc\xa4V\x1ee\xf81\xf0;\xf9\x00)`\xa1\xe3D(RG\x9aA4\x7f\x93o\x7f\xf2\xba\x1fn3\x94Y\xf8fF\x80\xd1\x042\xca\xd2 \xee\xa8\x08\xb0(\xf6\xd4\xafU\xff\x0e\xd6|\x8c([5V-\x1a\x9a*\xe8\x88C+5\xc7\x1a\xea\xacBEg\xd3@\x86_\xf6\x85\xc6vw\x0a\xc9!u\xfc\x18\xc4\xa5\x07\xe1n\xcd~o=\xd9\xeaj\xa20\x96s)\x93\xa8\xa9t^u\xf8\xe4\xb2\xc8M(?\xc2\xe6\xbd\xd0\xe7\xf9\x02\xe6\x94I\x89\x18OA\xb9T\x80\x0b\x0f\xb7`Fix\xac\xfa\xda\x8e\xb6\xce\xf0\xc6(\xb6E\x12_\x81\xf9\x87Y\xfb\xce9\xac\x1bQ\xcfr\xc4\x95\x87\xaa\xad\x86\x11\xd6\x01l\x8f\x9e\xa7\xb0\xf19\xf5_\xea.\xcdp\xd2\x1e\xd1@\xd6@`\x9f\x89\xeaW\x0cz\x815\xdd\x13\xf9\xcd\xb0\xe9yh\xb0*o\x8d\xf9\xf4\x0e\x1c\xc8\xd37\x9da*>\x8aL\xc5\xff\xf6\xf1w\x1f\xff\xf6\xee\x7f\xdd\xfd\x98o,z\x0e\xae~\x9d~+\xcbTD;\x91\x1d\x82\xd5\x93\xf1\xf1\x8a\xd1\xa4\x13\xf9h5\xdd\xcf^D[\x06\xba#\xffu\xfb\xac\xd3\x11Z)>}\xfa\xb3\x1c\xb3\x81u.O\xc9\xedY\x80Jz\xa4\xe4\xa1\xd0\x1aE\x1a\x13]\xc2m\xe0@\xdaI\xf5;\xbb\x14\xfb\xc0\xf1$\xa8\xa6)\x80~\xd3\xf6\xfcN_3a]\x9aAg\x14u\xe5\x1e\x93\x8f\x03\xa8\xc7\xb6\x1b\xec`;o\xa5b\x99\x89iW\xc9[\xd4\x19\xdd@[\x10\x01\x12\x0e\xf6\xe0\xab6\\\x14\xae\xae\x92\xa0\x1c\xcbM\xe2\x95V\x82\x0d/b\x19K\xdcsd\xf8\xf0\x12(]\xe3\xefwE\xf08\x03|*\xe9\x92\xe8l\xc1mI*\xf37\x80\xc3\x1f`0\x0e8\x85s\xcb\x8d\xf0>\xc3\xd4\xd5\xc6Ct\xb2\xef\xe5\xf9\xa0Y\xad\xda=\xaa+\xac\xf3\xad\xa8TAZ\xe6\x1cVD\xc4\x07q_P\xe8\xb4\x88\x88\xdc|\x89\xadG\xe6TD\xcd\xa1\xc8\xc0EH@x\xad3k`\x87\xd5\x8e\xc8,Y\xec\x00\xb9\x05\x196\xb6pe\xbbH\xb5\x8fQ{\xe8W\xf6<\x9b\x13\xef\x9d\xd6|I\xe1Uc\xd1\x00\xef\x8d\xe2(\xcaHT|\xbb\xc4\xf0\x17z\xb8N\xf1\xf7<\x8e~\xa3\xfdw(\xc6?\xb5\xd9\x17\xa9\xd8\x7f\x0c\xd8\xf1\xdar\xa1@\xc7m\x9d\xc5P\x95\xa66\xe3\xd5v\x85\xa2\xe0|\xdf\x9d\xe9y\xa3%\xf2\xa0P\x15{\x1c2\x8b#K\x9cT\xa8P\x11I\xc2\xf1\x19\xa7\xf4\xe3\x84'\xf6\x7f}\xfc\xdb\x8f\x7f\xfb\xf1\xf7\xdb\xe2G\x8a\x9b\xc2\xd3R\xcc\xd7\xa9\xbaP{w\xde<\xbd\xc5H\x9a\xfc\xde\xc3\xab\xaa\x0e\xf3\xb4\xc5\x08\xb5\xd7}V\x83\x14\xb3*9{\xbe\xb3\x17s\xbf\x83>\xec!\xee\x1a\xc2{\xda\xd1\x11\xc1\xcf'\\Q!&\xba3\xdee\x06\x01\x04J\xc7\xa6\xc18\xd6\xf1\xbaE\xdb\xfa0\xa7\xdc\xa7\xe9!4L\x7f\xdbv\x98\x15@Y'\xbd\x98h=\xccc\xcf\xa4\x0c\x0dm\xccp\xc8G\x96\xe2\xab\xcd\xd3}&\xc1LS\xc6\xabGE\x03A\x09\xda\x99]ptY\xfd\x16\x81_\xb4\xfb\x9a\x9b\x1b\xd3m\xe7\xf4\xe7\xf6\x13\xfa\xed\xeaz\x0f\xcb\xfdK\xc0\xa3Le\x8e\x1d\x1c\xca%o3N\xe4\x9f\x8b\xe3%\xe3\x18^\\a?\xc9\xa6\x88\xbda\x0f\xbf\xd8jP\x1cXF\x0d\xcd\x82\x085\x10\xd0\xe7\xb1]\x1c/#\x9e\x15m\x19\xa5a\xfd\xda\x90\x98L\x0a\xa9\x0f\xc5T.\xfa\xcb\x80X(\xdfw\xe7\x05~v*\xce\xda\xa8pYQ\xed\x0f \x8aH\xb1\xdc\xdb,C\xc5\xa6-\xae{\x84\xbe\xd7~\xf8:\x97\x120(\xfb\xb3E\x0d\xff\xf0\xe9b\x1f\x0e\xb3[L\x9aC\xd1\xd9SI_\xe0v@l\xda{/v\xb1\xde\x8dg\x1bJ\xc0^\xc1&\\\x8f\xcc\x91-#\xe6\xb3i\xc3\x13\xb9\xd5\x94Z\xec\xcb\x0e\xebNR\xdeH\x19t\xa2\xba\xe0\x19\xbcU\xd7\xf1<w|\xd5\xce\x95\x12\x86\xa1\x1d\x89<\x12~\x17\xdb\xf36\xf6\xc9\x97McnoWv\xb2\x87/\xd6\xb62\xff\xfb\xd5\xdd\xec\xd1\xa7\xa9\xf52\xb3\x9d\xe5\xc7\xdcP\xe7\x1b\x99\x8b\x9b\xea\xb2L'/\x81f\xff\x98\xc8+\xb8\xaf\x16\xca\xd2`.\xda\xc5\xc1\x0eW\x9b\xae\xd7\xa9\xc9&\xd2\xd8a\xd4r\x1f\xcbU\x1d\xf1\xb5\x18H\x0d\x01A\xf4e^D\x0e\x01\xa2!j\xc3N\x0c\xae5R\\\xf6z`\x92\xe8\x96wf]\x8c\x9c:\x92\x19\xe9$\xbc^}\x81\x16\xfa\xa2\xb5}\x90-m\xdc\xa6E\x90a\xe4\xff\xdd\x8e\xcc5\x986\x96\xbd\xb4\xae\x86\xd1\xf6\xe6\xa5\xca\xcdOkJ\xc8\x87\x0e\x0f\xd5\xe9\xd9\xbew\x12\x81\xf8\x9aQ7\xe2N#\x91y\x8aoC\x01\xd1\xf0\x1eG\x18:s\x9f\xf0\x9d\x17y\xa2s\xca\x8f\x96\xaf\x9a\x9f\x1c\x11a\x82\xeb\xf9}_\x15\x8f%\xa2\xbc\x83\xdc\x8e\x9e\x05h%\xbb4\xf6,o8#\x1d3\xebH\xe4\x18]j\x86\x82/(\xa5\xe7\xa6mPh\x89I\xdap2\x97\xe6z\xa4?\x04\xe9\x19\xd7\xf1\xb3\x0fs\xfb\xe1\x06\x02\x1fmR\xf3\xcf\xe5Z\x87\xb0h\xe8cN\x1bqr?\xb34e\xe2\x1eP\xfc}*\xd3\x1ey\x04t\x04y\x95\xef\x99\xc3.\xdd\xa4fD`\x06\x187\xe4\xbf\x03Z\xe5\xca\xd1\x9d\xd5q\x92\x84tmz\xd3\xf2:tj\xfbp\x86\xa9\x95Iw\x95[\x08\x13\x11\xbf\x1e\x96\xa7\xcd\xe2\x1cELB\x06\xb8\xe5\xe7\xdc\x7f\x1f?\xdb\\t\xd8\xad\x980\xe3\x0c\x1d\x81\\}|3\xe0\xe2\xc8\x94\xf0\xf3.\x89:-\xfb\xe2O\xd2\x88\xf9\xdd\xbf\x7f\xfc\xf5\xdd\x8f\x1f\x7f\xfdg\x8d\x98_12\x9eT\xe8\x1dX\x0e\x0dk\x8b\xd1\x9b3\xdd\xad\xbf\x00iu\x8ckuX\xb31y\x84\xc8\x99\x1e\xd6\x1d\x96'1\xfa\xa5\xa9\xb8rm\xc2\xcd\x12\x11KIQz\xfd~x\x9f\xcb\x91p\x9f[\xf7\x94\x87t\xe3\xccr\x1c\xa8\x03\xc4'\x9eD\xe5ks\xc2\x12IF\x0a\xac\x16\x8b\xccG\xde\xa7\x0c\xaa4\x15O\x14#\xf5\xa8\xc3\xa9\xd6\x94\xbd\xb1A\x09\xad\xa5%:\x8c&\x96\x01\xacZkV\xab\x02W\xaa\xb6h5\xe0\x01\xe7\xccEz\x18=q\x8a\xe3\x99\xcf\xc0\xcd\xed\xe8z+%\xba\x1c\xe1p)\xb7z\xf07w\xadZz#\xe4\xa2\xcb\x95\x19\x9e0\xceq\xab\xf3G\x04\xb3\xe0\x0d\xd0\xc2\xd1\xbd\xc86\xb5\xcf=+\x1e~:7@\xc7\x8ca:\x91\xde\xe99\xc3y\x8f\xc7\x07\xb5R{\x03\xb5\x9c.\xff\x01[rC\x0e\xb5\xdd\xf2X\x1e\xa59`\x9a\x0aQ\xf0\xb7\x80\xab\x99\x91\xa5.v\xe4\x8e\xed\xef\x1bEW\x9a\xf8\xaa|H\xc1\xec\xdc\xdaE\xc09t\x8bO\x86\xaa\xcd\x1e\xe4\xb8\xdb\xc9\xa5}p\x8dck\xa5\x99\x8bLG\xb3$\xbfj9\xb8\xd8;J\xe2(\xd7>\x90\"%\x0bY\x9a\x0d\x8e>\x1a\x15\xab\x98\x98g\xd1q\x1b\x03<\xe6\xc01\xef\xe0\xc2\x04\xc4\xee=_\x90\xe5\xb6\xca\x1c\xb0K\xe0\xc6$\xc8K\x19\xc3\xbf\x9dj\xd6\xc9vgs\x1f\x8c\x0b6w\xb2\x8d:\x17p\xdf\xc6\xe4\xdd\x0a\xe0x\x01\xd7\xe2+\x86\x1ak\x10<R\xc3\x88\xc3\xef\xffg'.\x1f\x97\x85\xe6\xe0V\x9cB\x1c\xa9\xe0%\xcc\xcd)\xd3\xf1\xb2#\xac\x0f>\x8f/H\xe3Q\xa9\xc1\xec3\x03(\xe3\x98Q\x1d\xc0\x99\xc1Y\xbd%\x1d\x85[\xaf\xed|\xf48\xcd\x0f\x06n\x814\x95\xe6\xb3:\xed\xc9v\xb7\x94T-Y)\xd2\x07\xa3\xf6\xf7\x90\xf54E\x0d'\xe8e\x97U\xfe\x8c\xa63\x08\xe2w\x19$\x91#\xb1R,\xda\x9f\x0c\xf0\x85\x16\x9ab\x80\xa6\xba\x1c\xc8\xf2\xf5\x01\xb0\x8b\xc7o\x8b,\xc7h\x87#\xbe\xea\xf5\xb9l\xca=\x8dL\xb9\x7f\xb8\xfb\xc3\xc7\xbf\xbf\xfb\xa7,\xae,6\xb1\x12\xc8\xd3\xd9\x9e1\xe9\xb3\x85r\x8b,\xbb\x10\xed\x0f\x82ky\x16[\xd8\xf79\x8cS*\x9dz~o;\xd3A\x15E\x02\xb1k\xa0\xee\x88\xdeu&\xfe\xdc:\xe3\xa8\xb8Bo\xc6m\xd3M\x9e\xa5{\xc6\xbb\x00v,D\x9e\xbc\x86[[\x9c\xa0\xceIp\x08\xd8^\x0b\x91\x07\xdd\x84\xb5\x8d\xed\xb3\xa48/8~~\xd4\xd2Es\xcd\xd1\xbdZ\xf5\xcf\x9f\xcf$X=Q\xe3-\x1aLS\xf7-\x09\xb9sRe\xe1{\xdc\x83(`\x11a6\xfa\x1a\x06P\xc2\x99\xdfV\xfd\xd5\xde\x98mS\xec\xbb\x14\xf3\xc7\xa2\xce\x09\x0d#\xc5\x87\xe8\xba\"\xd7\xe9G4&-\xcd\xb0\xc8\xd6\x03)n\x87\xf4\x82\xde\xef\xde\x9f`E\xd7\x81\xc7\xa8q\xd2\x9e\xee\x85i)\x05\xaf\xeck\x82\xd2\xad|\x04\xeb\xaaaDb5l3\xb8\xafy\x12\xe1\x12\xba\xe9\xa7bl\x85e\xe2fuV\xd1\xe6F\xfc\xc8\xef\x83\xb8\xc7\x93\x8bZ\xb8\x0d\xf1\xe8^\x0a\xb6\xbb\x9a\xea\x12uJ\x01\x1a\xdc\xef\xa0!}d\x07\x17ef\x81\xee\x0c\xe6H\x7f^J\xd5A]\x9d\xaa\xde\x05\x1a\xbas\x14p\xcd0P\xa3\xfb\xc0!aw&\x0a\x9b ]\xe0}\x0d\xd2/\x88\xb8\xea;\xe1\x0a\x14T\x91\xe3\x13\xb84\x18V`Q\xef\xd66\xa0F\x0b\xed\x88\xdaS\x01\xa0\xb1\x98c\x93\xea\x0b\xf2Z\xea\xce.R\xd9\xa9\xd5\x84\xef\x0d&\x87\xcc|\x16\x15\xab\x9azk\x1b\x93\xa3\xe7\x8f_~ca\x00\xaf]\xb2\xa4g\x9b\x91\x84\xf9\x86\x0a(/\x93\xa2h\x96\\p\xa6\xfd\xd1\xbd\x14W\xac\xd4\\\xf5~\x92\x10,\xfex\xef\xd9\xa6\x90{\x86\xde\xfav\x80\xd6\x96\x19\xd8\x8c\x8d\x18\x8f\xb7\xc5\xb7\xf3\xaa\xc1Z\xbcN\x8d\xa6\xab\x0d\xbb~\xe4\xe9m\x0a9\xfb%\xfc9\x99v\x12H\xe6\xee\xe8\xc8!\xcd'o\\\x06\x94U\x86\xe1\x88_\x9e&\x9b\xfe\x12,\xee\xba\xdf\xdb\x1fr\xd0#\xcf\"3\x07\xd1\x9d\x1f\x7f}\x1f3'\xd8\x154[\xc8(\xf6\xc2\xe3\xdbv\xce\x1cjg\xa5\xc1\xd5\x1c\xd8\xe3\x09^\x97\xac-\x86\xb1\xa0/E\xc1\xdb\xce\x19,\xc3v\x04+\xa4\xc5\xf1\xbcB\xdc\xa6[\xef;\x07\x9b\xd0\xe4\x19$\xbb\x82A\xd85\x070&\x00\x9b\xd6\xce\xc6u\xb3e$\xd4\x0aEid\x93{\xb5i\x17}\x11}?\xd1\xb6dn\xa6\xd5\x8a\xa9\xcf\xa2\x99\xa6\xc1^\x8c{f\xf6\xc8\xc16'\x93\x9c\xaa\xfcQI\xa3\xc2'\xec\xb6M4#\xfb\x1d\x9d\x12\xb8\xc5y\x8c\xd5\xd9\xb3\xbb\x10\xb7\x8a\xdb\xd5=iV\x0b\xe3\xca\x9e\xfebJ\xafR\x9a\x0e\xda+\xd4\xcaH\xe9\xe1\x02\xc3\xda\x0e\xfciB\xd13\x89F\x94b0\xa8\xd2h\x9aHRIq\xe5m0b\xe0\x16\x9c\xd9\x95\x81d\x19\xf6\xc2\x8c\xeb\xd1\x9d\x01\xe5\x09\x8b\x8b\xbd\x81.\xaf\x1c\x09\x83\xa4\xa9@b\xae|\xc6\xe3\x9fT\xb8\"W3\x03\xed\x97\x1b\x8a\xdb\x0frq\x97\xe1a\xf8R\x05\x8a\x19u%\x14g\xdb\x83\xcdB\\\xa4\xa7M\xe4\x9e\xf7H\xe8\xcb\x04;,QG\x9d\xbd\x81\x0b\x8c\xaa\x9c<\xdcw^|\x08\xeb-j\xeb\xa6\x878W|1\x99$\xf7n\xfb\xb3\x19\x92\x7ft\xdfY\x01z\xbe\xbe\xd4\xa0D\xc9\xdcwu\x0a\x0cz\xe6\xbeG\x02\xa1\x8c\x83'\x92\xb7\xbf\xfb\xff\xdd\xfd\xf1\xe3o>\xfe\xd7\xad\x83\xe7\x1b\xa2\xe2p\x17\xef\xa0\x85\x19D\xfa\xb7\xa6;g\x1c:\xb7\x89\xcd\xe8\x09\xfc4\x08}\x17\x98\x0a\x9d\xe9\xb4\xbf\x9f\xe7-\x82\xbb5\x94\xd0;\x9b\xd4\x15}\x1d\xe9\x8f\xa0\xa6\x85@j\xb4\xdd\xcdJ\xa5\xcc\x93\xb9\xf6=\xcd@n\xb5\xfd\xd3\x88\xab\xc0\x97\x8f\xba[\x8b\xdb\xe0dlop\xdb\xbf\x98\x11\xda#\xbe\xael\xe1\x01\x1b\x04\xad\x91\xc4D+\xb1\xb2\x9d\xec\x01\xfbYz\xb1\xc0m\xa3\x82\xb8\x8d\xbdf\xb8\xeeR\xfbB\x1cA\xc1~c\x93\xf7A\x91z\x0c:$\xa6\xca\xad\xc2.\xe3,*\x0e\x8d=\xc8\x17\xb2\x82Z=P\xba\x15\xb0\xdf\x9b:p\xc2\xde'\xe7\x81\x98\x9b\xa1:\xc1\xc9/\xd2Pl%uL\x1c\x05P\xef\xf0w\x99\x09*\xe3\xd8Z\xe4\xf9dl?\xdfU\x97\x90\x84f\xd6\xc6Nq\xc4hB\x17;J\x02\xf8\xd6o\xc0^V\xb9\xa2\x1e>J\xa1M\xaaQ\x19y\x97\xfb0!\xe7\xc1\x80\xa0\xfc\\G\xc4]\xab\xe6a\xcb\xb5\x87K\"8{\xaam\xbeoJ\xc3;\xd4I\xb5\xde\x1cK\xb1'\xa3\xc2\xa4V\x0e\x11\x11\xdf\x06N\x0c\xb5Ubr\x94\x97?E\x80\xe1r\xbb\x96ZW\x8c\xd2\x14\x91\xa5\x1a\xa1\xa9\xea$\x1e\xe0\x8d\xb9\xa4\xe4\x8d\x1fr\x88\x8b\xbe\xa1\xf8[\xf8\xa9\xf0\xde2:\x868\"Q\x97<PF\xd6\xee\x80\xcf\xc2\xf4\xa9Q7]\xca&\xc3\x04\xb8K\x04R\x98\xef\xcaHi\x88\xc3\xf1F\xb5\xf0\xe5\xa9\xa7jn\xe8\x90\xbay\xfbP\x8c\xc4\x15\xef\xfe\xf7\xdd\x8fw\xff\xfe\xf1\xf7w\xff}\xfbX,\xbd\xbb\x1e1\x88\xb3\xc7\xbec\xfe\xc6}\x0a\xe5\xdd\x96[\\\xa6\xe6 \xba|\x8c\x84\x9a\x0e\xb6\xcfF\xc9uc\xa8\x87\x82\x1c\xae\x9f\xb7p\xc9A\x12L\x178\x93Y]\xa1\x83\xc1\xaf\xf3\x0c\xd4\x80F\xca\xed\x82p\x1c\x07\x8b\x06\xeb\x96\xdf\xf4\x0a\xdb\x07\x9f\xd7\x90\x81R\xdc8\x86Nb\x0a]\xab!\x15\xd7\xf7\x97\xf7]\x00\xf6\xd8\x15?\xf9\xb3Y\xe1\xf1\xee|\xaeZdX\x99\x91\x1e?_L\xc3\x11\xd1\x06\xae+Q\xba\x9f\x8b\x80\xe5ExJ\xcc;\xd9\xd1\xce\x80\x07\x14\x02\x9fW\x13\x8c\x9f\xc6\x05z*F\xc9\x0a\xf9\xdb\xe7\x0d\x8d@\x10\x17\xee\xa3-;Q>\xf2\x91\xdd\xb3\xedrN\x1d\xad\xad\x84O7\x86\x11r\x1c5\xe3\x8cI\x1b\x1a\xa1\xbe\x8cp\xa4?EJ@\x97Ya6\xd1\x90r\xa9\x9ajD\x80\xba!\x05\x9bU\xb4g|l\x9c\xa0\x1b\xa19\xd2\x9fb\x80\xae\x84^\x9bF\xae\"2S@\xd1\x05^\xa1\x84\xce\x1c\xfd\xbf|;\xc4f\x9dq6\x08?\xb3R\x8e\x95E\xa0#%\xb6\x1b\xd1\xd5\x06wDB\x12,9\xe4\x90\xdcu^\xcd04c@?\x8fWy\xc2\x0017M\xdaXu\x86z\xad\xadGS\x1e\xf0\xa4\x09O\x04\x9e\x91\xc3\xf6)\xda\xd8v\\\xa8\xcb\x89\xe8m'6\x89f\xba\xcd\xd1\x02\xc0\x03h\x8e\x8f\xa0\xea)\x90\x03#\x0c\x92?\xdfNl\xeb\x94\xb0o\x88\xc9\xf7I\x1fb\x0dy\x98\x90\xd6\x91\xa3\xe0\x91^s\xf4\xfb9*\x0a\xf3a\x02\xb6\xa1\x9d_p\xa4\xec\x09\xc1Q\xb6n\xf5\xd9\x8c p\xd4\xec\x07\xb81'\xe8B\xcb\xb4\xd9\x0fpJ\x83\xd2\xcb&F\xac\x03\xf5\xb7w\xff\xb4]\xce\x8f\xa1%$2\xc9\xcbZS\xb0\x96\xd1\xafW\xdb\xe4\xa2\x0c\xf1\x17\xe7\xaa\x83k\xf0\xc6\x1cJ\xd34y\x9e1]\x97\x80\x8aU\xef\xcc\xdc\xed0\xec\xd7\x1b\xc2\x08\x8c\xc6!\x18\x13\xbd\xe5\x16\xb1\xb3\xc4\xf7T\x1a1\x97\x9b\x835\xd3\xf4\xd9\x9c@\xc2\xdc\x9e\x16\xd6}H\x99%\xe9gL\xc3|\xf2\x1a\xca\xed\"=\xb1P\xafUGI\xf2/{yJ!\xd4'\xaa\x09G\xf9G\xd61\xfc<\xc9\xeb7\x98\x1b\xea;\x02\xdbbK\xc5\x8e\xc3\x9e\xefG\x8co:\xda)bC,JSt\xd5\xd9\x19\x19\xf4\x87\x0a3\xb3p\x81x\xfb\x949\xf2w\xf2~|O\xb1B{t/\xb4\x84\x83\xe4M&k\x14\xb6,i\xaad\x90P\xe5\x1a5\xaak6\xad{\xd0u\xe5\xd9\x05\xf5\xf28\x87\xea\x03\x89\xf3\xd3\x1f_\xfa}^3\xba\x1e>\x8a\xe29\xbe\xf8\x8a\x9a\xa4\x9e\x145J\xae\xd4\xd0Em\xae\xb2P\x06k\xa5\x0eaB\x99\x80XI{\x9d\xe2\x9f\x8awU[\x8d\x06w\xce\x0c\xb3e\x0a\xa3K\xf2\xc1\x9e\xa9 #D\xcb\x90M\x0f\xdfe\xc8\x96\x92>\xea\xce\xf6\xc6x~Y\xb2\x0d\x84\x18\x98\x94)\x08\xd9\xe5\xe6\xe0W\xb7\xba<\x87\x93\xfe\x1a\xb5\xa9\xeb\x0c\xf1\x1a\xb5\x0dl\x1a\xdc\xe86\x80\xf3Y\xd2\xfb\xf5.c(m\x84\xc1\x14}\xf5CU\xe3\x07\xbec\x0b}\xa2\xb3\xa1\xcb\xeb\xceg\xf7\xaer\x8cw-\xbe\xef\x02:\xa8\x8b[\x18M\x9bas\x951\xdb\x8d\xaa5\x81\x1bO\xf3m\xf0F\x05\xb2\xdc\x9a\xa1^\xd9\x1c\x1e\xbe\x98\xb4\xddV\xad\x14\xb1\x8eP\\q5\x04pe\x0f\xc4]\xe1\x82\xbe\xc5\x09\x90B\x907\xfb\x0b4\xd0\xa4\xc9\x87}\xa6\x945_\xba{\x87\xe2\xd1|\xf1S\xd5\x95\x10\xa4\x00\xf13\x08n \x86" +
+	"5\xd6L+\xbfy\xfc\x8aI\xbce\xb1\xfb'\xf4\xa0x\x1d#\xf5Y\xa7O\x00\xc7\xb6\xb7\xe3\x11_3\x12\xcc\xb8(0\xa6\xe0&7\xaf\xd2\x97\x85\x80\xa5\x94\x92\xe9\x09z3\x1c\xf15\x93\x19+\xa1>\x98\x13,\x11\\\xcd\xcb\xa2\x9c\xfc\x00\xadP\x956=\xd0\xead\x131r\xef\x0a\x91\xe5>\xe3\x8f|)v\x9e\x10\x94\x04\x8c\xe3B\xa0\x89L\xe7\x8cH\xa7F\xe5\xec\x02NQ\xa4l\x08\x82\xe2\xee\xe4\x9e\x9aQI\x10\xc6\xb8\xff\xe5t\xe1\xd5*\xdc\x1f\"\xc9\xe4\x9cEE\xd3U\xf8\xc7\xa3\xc8s[l\xf6\x8c\x8f\xdc\x1f\xdf52*\xae\xb6\xfb\x99.\x88p\xd9sq\x01\xbb\xd5\x92\xca\xe7*,\xeb\x8c\xe7@M\x1dD\x85i!9k\x89\xda\xbcZ\x09,\xb9\xb8iy\x81u\xc4\xcb\xa3\x17\xd1\xc0b|\x89.\xc3\x87\xe5\x0d\xb4\xf0\x091\x03\x9e\xe0H\xafol\x06\xa1\xf2\xb4)\x0ci\x1c\xdf\xd8m\x03\xfd\xc5\xa71\xf0\xf4\xe3o\xee\xfee\xb3z\xa8\x9eT\xca\xc6(rgu\xb9\xa9V\xec\xdeV\xf5\x9a\xde\xc0\xa3\xc9&\x16Kr\xb0\xde\x0cV\xe9\x8cP`k\xae\xe1<\x03\xdf\xedS\xc4d\xec\x99\x89W5}\xb7%}\x03\xe8K1Zh\xfa\x9e\xc1\xf9\xbdp\xbbR\xb2\xf941\xc7c\x16\xf3\xb8\xe6 \xce\xf0\xcf\xad\x91\xc8\xb9]#\xc7O\x88v&[\x8c\xb2*\xba\xf3\x05Nf\x08\xa7\x98\xb2576\x8fT\x8cm\xae\x90bi\xb1K1\xc3\xab\xed\xf0\xa1\xadI\x04(z6\xad\xaf\x98\xf1\xcc\x0d\xa5\x05RZoa\xcc\xe0\x7fm\x0c\xc5\xb5\xbb\xf0\xc3\x939\xadE\xda\xa2\xe0I<S0\xc5:\xed\x0e&\xe72\x0cv\xce\x7f\x94F\x06l\x8e\xe7\xaf\xa5\xac#mB\x83\xad\xb3\x10\x92s%\xff\xabm\xae\x93\x18M0Pm\xd5TW\xc0\xe9s\xb2\xc4\xdeD\xb1\x1c\xfc\xbf\xac0#\xb3\xe8h{L\xb3\x9dc\x949K!\x83\xf3\x8b{e(\x86\x82\xc0\x0b\xd6\xa2\xb0x\xf4\x1a\xff\xfc\xc3gQ\xc74\xde\x97-\xc4(\x80\xff=\xd4&'\xd4\xe8\xf94C8\x1d\xd9\xefp;_\xc7\xe0\xb2\xed\xea\"R\xd5\x10\x88\xeb\xab~\x80\x13\xf4\xeb_\xa9\x1dZ\xb4\x18`=\x11\xf7<\xc93\xec\xee\xd5\x1f\x9c\x08\x9cM;#\xa4\x08\xf7\xf7\x0e\xbaL\xb5\xd3\xc9\xd5\x08\x1c\xc2C\x9f2\xb2(d\xc8\xe4\xa2\xa67\x0fr\xeaw\xc4\x9dM\xb9\xd0|\x98\x1cyLz\xb8\xc2\x98q\xaa\xc6\xb4\xe7\xff\xfe\xf1\xb7\x19\x82T\xc4\x14,|\xe7\xbe\x18\x8b\x15t\xbd\x14V\xdea\x1a\x17L\xa1)\x85\x02\xd8\xab\x9c\xfe\xba\x01\x94\x94\xd4\x0d\x08ZmUDn\x1a\x1fS-\xb8\x11\\\xed@\x94\x19`p\x19I<\\I\x9bs\xfd\xea\xcf&\xc82\xf9qw\xa6(\xf6\xfa\xef\xa3:6\xb4\x1d\xfd\xef\x05\xa7\xc3\xd8?\x86\xe9\xac\xe69\x9e\xcfD\x80\xf194\x17h\xc3\xc1@\x17B&\x17\xdc\x85\xf7E]}\x0f\xa1\x88\xa6\xaeF\xa8?\xc0:\x01\xe7|\xa9\xdbr\xce\xbfc\x91!\x7f\xf1\xcc0U\xca\xbfc\xcff\xda\xf6\xf9\xfd\xf8\xbe7yZQ\xbb7\x0a\x8d\x1d\x0eO\x0f^\xde\xf50\xd8\xc6\x8d\xb3\xa4\x10\x02\xa3B\x9e\xa8\xf3\xa3\xa4pG\xf4\xebT\x9f9\xaa\xcfp\x91@\xb4\xbb\x11@\x0fG\x1f\x06M\xfe\xda\xf5\x94\x9f\xf3\xee\x1br\xbe8V\x12\x07\xaf\xfe\xa2\xd8\x91\x19\xf5\xb9\xc1\xbc\x0aB_(\xa1T\x8d\xa6\x93\xb9\x82K\xc8\xa5\x8d\xf1\xd1oET\x9eD\xca\xcd~\x90\xfcH\xaa\xaa\xf7[w\xb06\xe6B\xd7$\x1d?\xa8!be\x121\xdcAC\xc8]\x01\xe7\x09N\xee\x1d$\x87\xe0\xf1\xa1\x98\x91\x9f\xe0\xb7\xd0\xc2\x91K\xf6\x0b\xcf\xbb\xc0T\xff\xd0\xad\xca\x8fE\x1b\x00\xb5\xc8B\x82\x08\x17\x0a\xd4\x09T#?\x9a\xae\xf4A5\xcd\x05\xb9\xbc1G$\xc3\x1f\x7f\xf3\xf1\xf7\x1f\x7f\x93\xb91\xcfmf\xc5\x8e\xf6h\xda-3\xfd\x9cxgM\x15{\xf3v\xe7\x00\x15\\\xaa/\xda\xde\xa3\xa3\xc5\x8cY\xf3\x10\xc2\xe2hqU\xc2\xd1\xbd<\xf8I\xda~\xde\"\xdf\xa1\x1f\xc1\x07\x19\xd9\x9a\x8a\xcd\x15K\x9e\xea\x0f\x99\xfb:6\xdb\xe1\xc2)\xd8\xae\x8e\xca/\xab\x1e\x81;[\xd4L\x9fE\xbb3\x9a\xee\xce\xb0\xac}\x92\xee\xd6\xa0\xac\x82\x04o&\xa8\xba\xfbK\xff\x1d\xa7$\xe9tC\x91\x06\xe2\xba\xfa\xa1\xda\xe8\xfb1Z\xe02\x0a<\x00\xd9\x12\x7fx\xd3\x1aS8\xb9s9\x97\xc8\xc6\xb5y\xf5d_\x88\xf7\xe8Z\xf0\x112\x11\x8d\x94\xa6e\x11x\xad~\xdas\xee[\xe7\x15\xa7*\x95;\xb8\x8du\x8du-\x92\x04(&`T\xabZ\x1f}]\x17\xfdaT\xb5|(j7\xc0\x94n\xc3\xb8\xa0gg\xe6\x8dQ\xdb\x9a\x11}BFB\xe2\xdb\x84JqB\xc9+\xa0\xd5\xa5#/[&P\x1f\xcc5\xc5\xe6\xa1\xf3\x8b\x98\xdb$m\xb4uy\xcc\xd8\x09R\x0b\xcf\xc1\x84m\xa8P\x8f:\x8b\x9bDJ\xff\x9b\x11\xf7\x97\xbe\xc3\xf75!\xfe\xfd\xbc\xdc\xa5\xdb\x91\xd4z\x04L\xe4*\x90\xe8EZ\xd0\xa5\xcaw*\x15\xe1P[!\xa5%\x89qM\x85\x068\x05\xd0\xdb\x01S\x98\x0d\x0ck\x17\x0e~\x0b\x87\xa0w\xa5\x01}\x15\xac\xfb\xa8Z\x18\xe1\xe8^\x94\xf1\xe4\xaap\xb0\x94\xd6\x05,\xb5\x8a\xf4m\x84i?\x9b\x8c\xbc@xv\x94\x17\x8a\xf2i\xc2\xd9\xec\xa2\xa49dF/\"b\xe1\xbb\xff\xeb\xee\x9f?\xfe\xd7\xac\x93\x99(*\xbc\xe3\xe4\x82\xc5(\xa8\x02\x17\xe8\x8anm+|4\x09\x16\xce\x09\xb58\xd9lS\xecP\x9b%\x03\x93/\xe1b\x1f\xb5\x09\xf2.\xb4\xe0}\xbc\x9b\xe1\xbc'w\x94{\x0c\xe5vtr\xa2\xf4\xc1Q\x11\xc6p\xb8G\xaf\x00\xacn\xa7\xc5\xab\x12\xeb\x9c\xc0$j*\xa0\xd8\xf9b\xd5\xfd\xf6\x11\x1f\x1e\xb7\xdc#q\x03\xe9\xd0\x1b\xf4n[\xe4\xac&\xdd\x11\xdb\x87;\x178\xdf\xfbL\x15Sx\xe4 \x1d}\xb8\x8f\xb3#8\xb3\x0c2\xf1#d\xc1\x97/Q\"\x90.G\xdfm\x0cV$\x99\xd6n\x03\x16\xbe\x80.\xe3\x0a\xee\xec6\xdd\xd9)\x91\xe9\x0b\xf9\x11\x90\x03'\xcf<\xc8\xba^]\xd5\xb6\xe7\xf4\xf0\xdc%\xdd\xf6^\xdb5u7U\xcc\xca\x18\xff\x83\xb2\xa5Ch\x8c\xc8\x94\x1b\xaf>\x8d\x1c\x0c6\xc5\x0bd\x86Cc\xce\x02O\x81\xe8\xb6$\xf7zH\xafF\x81\xe7\xbe4u\x9e\xeb\x18]\x837\xd4\x98\xba*\x94;\xe2\x05\x06r\xb72\xb6\xa2\x98W\xed\xdf\xef\xfep\xf7\xcf[[\xd1|L\x1aE\xf7\xce\xb5\x84\xdfp!\xba5\xf1*oO\x8a\xda\xd28[Y\xce\xf8\x90Nx\xcb\x9b\x1b\x932\xcaYP\x1a\xb7\x90\xee\xbc\xb28\x9eL\xe3\xdb^\xae\x98w\x96\xa1:Y\xd47\xeb\xa0\x85\xfdJG\x9e&\xe5`\xd1\x1e&e\x94u5\xd8\xc6\x8cA\x05\x9a6\xb6U\xd0\xf6\xb3Y\x87&\xa3\xc5\xed4\x86\xa0AI\x8d%\x10\xa9\x0dUS\x0d`\xd8\xd6ar\x09\xe6\xd9\xdf\x1f<\x85_\x13Rb)\xd7\xda\x8e\xbf\xcc\x82=k\x10\xa4\xe71E\x8b\x82\xfc\xf1tw\x97\x094\xa8\xb2t/\xd0\xc35c\xb2?M\x14\x1a3\xa8\xfc\xf1\xdc\xdd\xb9\x04\xc9\xdeY\x0bTWO\xff\xfb_P\x0f\xf6\x1eG\xee\xc9\xd6f,\xbe1\x1d\xdc'\xab\x87\xe9@\xce.\x16F\x80\xbe\xd0\xde\xdb\xef\xddH\xefE\x12.|vj\xa9\xde\xedS\xf3M$\xfd[\xfb\x1a\xe2\xa24P\xd4U\xc1)Nn\xbbw\x8f\xf1r\xa4?h\xa2nU\x1a<KK\xd3\xeeE\xdeA(\x81\x97S\xae\x91\x98\xd2\x08\xeb\xaa\xe7)\x8d6\xd7N\x1d\xf4\xd8\xba[\x89\x1fM\x94i\\\x88\xc0\xec5\xed\xbb,\xe0\x1d\x9b\xc8uE\xa1\x12\xe8\x8e\xe1\x9f\xfb\xd5\xe4\xd44cH1\xa5_Bm<b\x14J\xd3\x1c\xdd\x8b\xce=./\x97\x98\xc1\xc0\x95\x91n\xf2\x17|\x0e\xef@m\x05;\x1a\x08;\xeeg\xaaJu\x91\xe8\xe6\"\xfa\xc2\xfb\xcd\xf3s\x17\xf7\xa6\xb3K\xcd7\xee\xffV\x82\x16\x8f\x93\xbeR\x81\xaa\xda_&\"L\xdb\xeb\xec;\x08\xf0*\xacH\x15\xc1K!h\xec)\xce\xda\x15\xf5\xaa,\xda\xd3\x09:\xc6\xb5'!\xd5\x00\xc3\xd3\xc5\x16\xa5\xa9\xa9\xe82K\xb6\xea\x0d}\xb7\x81\xcb/\xe2\x02\xce\x90\xe5\xd7\xbeM^\xe4\xf9\xb3TV\xd4\x04\x1e`\x09\xf6\x0cU\x7f\xe2\x90\xcf=\xd9\x0a\x96\xce\x01\xde`v\xad\xcd`#\xf8\xdc#~\xafl\x84\xe2\x09G\x1c\x16\x89\xc2\xc2jl2X~b\xb9\"|\xc5+S\xab]gp\xfb\xca\x98\x91\x01?\xcdu\xceQ\x92\xcd\xf5L\x8a\x87\x98\xe8\xd5=\x98\xc2G(2\x92\x030\x82\xf5h\x05\xdb\x93\xf2\x1b\x05\x1c\xe2\x1a\xd5\x8cD\xf8\xe7\xf7\xee\xb8\xde\x08\xa3`\xca\xab\xed\xf8\x92W\x87)\xe3b\xe3\x19E\x18\x09\xd1\xd3\x1cd\xd2\x09\xcd3\xfbj;\xd8\x14\x96\xcaAl\x1a\xba\xb7P\xc6\xeal\x8f\xaa\x1b\xcd\x0c`\xb0\xe8\xcc\xe0$3\xf9\xd8\x85\xde\xd4\x85\xcb\xc2\x1f\xdd\xcbj\xa8e\"0\xe3\x7fV,\xe8\x92\xcc\x0aUo\x14\xfe\x9c\x0cI\xa9p8\x05\x9c\xad\xdb\xf8d2\x9e\xc7\xb1\xe4\xd2z\xa5\xd3\xf3p6\xa7QVi\xdb\xe3\x1a\xff\x0b\x9c\xa03\xb7k\x88q\x15\xfcM\x05p&\xde\x94f*\xeen\xe1%s\x14\xdf\xd8RrK\xc24\xef\xfe\x1f\xe9eZ\x1b\xed'\xfb\x0c\xdb1bU\xf8\xf8\xeb\xbb\x7f\xd9\xae\xeeX\x01W\xd9\xdev\xc2\xae\x12*\xf8\x15!OM\xc8\xdf5\xd0j\xe4\x1d\xce ]\x92\x86\xf2\xe8}\xdeN\x7f\x14\x17\x09\xe3A\xdb\x9b\x93\xa5\xbc\xfbv\x10\xe7\xebpST\xdc\xda\xdbnF\xf5B\xd2\xe8\xa6V\xe2\xadt|\x9a\x1f\x8c\x8a\xde\xf9Yy\x86\xd1\xf6\xb1\xf0W\x0e9\x90td\x87\x9a\x10{\x84\xa4\xdf\xc0e\xacz j\xf4+\x18L-\x98\x91Js2&\xc7\xf3\x04Y\xf8\xfb\x8f\xbf\xdb\xae-\xfe\xab\x09!\xc8-\xd4\x9d)\xe0\xd2\x18\xaav.\xd2Z\xff\xd6\x0e\xb7n#\xd9\x05X\x1b\xef~\xcc-\x1a\x95\xd7\xeb\x80\xdc\x0e\xce\xd0\xba\xe5\xb1'\xfei\xdb\xc6$d\xbe\xca\xc1\xd6\x02\xfa\xecn\xd7\xa0d\x13m29\x0e)9\x8c\x13\x853\xc4\x01\xe7\xe8\x1dsf\xaf\xc0\xc2\x91\x1e\xba\xa3\xfc\xc3\x13ir\xd2>,\x8e\x0c\x8fI\x95\x80\xfa*\xa3\x9d\x19L\\;\x9e!\x1b\xfbd\"\xdfD|I\xfd\xb5z\x87\xff\x98e\xea,M\xe0K|\xdd\xd05S\x8d\x9dH\xddiP\xc5\xe5\x1b\x8f!\xaf\xdc\xea\x02M\\\xc9\xe9\x06\xb2\xc7\xcd\x91\x1c\xa6\xc1^\xe1\x16$3\xee\x9d\x04^gm\x85b 5C1\\\xbf\xb8[\\\xca\xe1\x96\xce\x0e#\x9a\x0d\xb8{\xd8\x178\xab\xd4\x90\x0aA<_\xc6\xa7\xe6Mo\x82E$\xee\x99\xed\x00\xdf\xfd\x01j\x18_*\xbe3U/\xe6\xba\x97^d\xdb\xca]\xa7x\xcc\\l\xb7\xd1\x8a\x0b4\xe0n\xcb\x98B\xe9\xbd\xa4A\x0d\x8d\xae\x90~P\xbc\x9d@\xb6\\ \xa9\xb7\xf5\x11_\x0b\xe6T|\x90!\x06\xf7S\x89+\x85\xc9\xb7\x9d\xeb\x8a\xf8\xf8\x9b\x1d\x09>&\xfb\xad\x1e,A\xb2\xa5\x11\xaa\x82\x08\xf8\xa8\xe0\xfc\x93\xd7e\x97\xcf\x8c\xb94\x92X\xe7\xdf\xc2\xb1\xa1\x8d\xec\x01O^L\xef\x84\xf0\xa8\xf4\xc9\xf7\xe4j;\xfeN\x98\xcb\x0f\x8a\xef\xd2\x89&\x03\xb2c\xa7|\xaf\x04?4\x8fKB|\x9eq8Du\xa1w\xff\xca\xff\xfd\xf7\\\xe1\x8ai\xbd\x09/W~\xc7\x97\xd5\x94p\x19na\xdc\xe7\xa2\xe6\xa2\x80+r\xd0Q1\xcb\xc9\x15)\xbaW\xf42Fi\xdd-U\x97a\xd8g\xe3\xea\x14p\x1c\x93\x90\x82\xc0\x16\x06q\xec\xc2N2$\xa7\xeal:\xb3\x7f\xb5\xa1\xe8\xf2D'\"\x15\x9b\x82\xc5\xacs\xf7\x0ey9\xe8F\xdc\xbf\xb20xs%\xdb>\xb9\xc5C\xd4V\xbd\xe9F\xca\xaa\xee8\x9e\xa2\xe7\xcf>X\xa0\xdb\xfb\xd3\xd7Q\xdfe\x9c\xea\xaa\x08L\x80(\x84\xdd\xdd\x06U.(IO\xb4o\xe1\x1d\xb4G|=\xc8V\x1b\xd7\xf9\xc4gI\x98\xd1^8x\x0b\xef\xf5YX\xe9n\xfe\x93,\xbb_\xc0\xb2\xb3\xd4\xb8w0Q\x1a\x82\x05~\x80\xbe\x87\xcb\xd5v\x07\xef\xfe\x04\x09\x05v\xc6|w\xd6F*J\x1d\x9d\xd6;\x81|p\xdc\x0b,H\xbdo/\x10\x8f\xbc\xbd\x90\x1f~\x1a\x17\x90\xfc\xf1\xee\xc7\xbb\x7f\xf9\xf8\xfb\xbb\x1f\xef\xfe1+\x8a\\[\xa2l\xd4;v\x83\x8f\x1a\xd9\xe7\xce\xb2\xae\xc7\xaaw\xff\xb8\x1c\xf15\xb3\xa0\xc4*y\xc9w\xd5h\x07\xe2\xd8\xf6\x9aNW[;s\xbd\xd8\xd5\xb6\xb6E\x0dX\xe9\x98\xc9\xbb\x165~K7 }5'8\xba\x97\xa0\xa0\xe0\xd0\xbb\xdb\xab\xb7\x05<#H\xee\xe8\xe47\xb6\x93\xcdC\xccRGN\x96\xc2\xca8Z\xf4\xa7\xa8\x1d\xd1\x9a\xbb\xd3\xd6\x0e+\xe5\xf1\xf3\x99\x94\x88L\x92 \xee\x86T\xe8\x15g5\x9e\xb3\x05a\xcbS}\x9c,\xbc\xed\xe2%5?\xfan \xb3\xc9\xed+\xd4\x87L\xb1\x98\xc9\x15\x90\x90\x8an\x86\xb1X\xbc\xdfa\x99\xa4\x8a\xad!Xd;\x94\xa6\xa3\xdd!\x14\x12\xeb!x\x85Q\x91\xe2\x9d\x17(\xa2].\x13\x91[\xbaP\x8cYV;*J(!#\xda6\xdd\xe6\xe7\xd5=\x85`]nK\xb6\xe0\\B\x88\x87\x9f>Lb\x06?\xde\xfd\xe1\xee\x8f\xdb'\xff/]\x0f\xde\x18ha\xdc^\xfdZ\x8d\x9b~\x93\xe7\xe8/*\x85g4\xf2d\xfd\x1c\xf3\xa6%\xd52B\x07\xe6\xe8^\x82`fuZ\x0dVFK=&}I\xda>\xd9\xc6\x8a\xf7\xe5\xe2\xb0\xb6?\xd2\x9f:\x87Qq\x15\xb6\x88'\xdaH\xea\xd6\x8c\x02\xc3\xd3n\x9f\xb5\xceu\x0d\xb9\xd6\xd6\x14\x1e6\xf1\x84-\x0ck]\xcdBX\xf6\x86\xf7\xe2\xfb\xf5\xf5E\xd4WN\xceqP]IS\xb2\xa7\xf3K\xf8`\xd6\x8a\xfd\x1e~\xba6\xb3\xe2F\xd6\xcb\xac\x1e\xa6\x94\x84\xb3\xc4d9\xeb\xefQ\x92\xef\xfd\xbb\xbb\x1f\xef\xfe\xc7v\xdc\xee\xf3\xaao+w\xa4\xe0+\x9e\xc8\x82q\xc0\xf8\x14\xe5\xf4\xb6\x97&\xc7\x92jL\x00\xc6\x02R\xb8\xefm\xa7\xa8~\xe5#\x9d\xce:vX\x16\x0a\xafF\x1ae\xb2?\xedR9\xb5\x16\xd5\xd7\xf6y%,7\xf7\xb8\xd4v4b\xdaq\x8c\x9c{\xf4\x0bw\xd9\xe7\xc7\xf8\x1dY\x18\xb7\xb0\xc6E\xe0\xd7\xafXw\x8c\x03\x08\x89\xab\xc8\xd4\x0b\xae\xba1\xed\xf6\xca\x9d\x82c\xd7ZW\xdcF\x9f\x80\xe9lS\xdc\xc2\xdf\xe4(\xab{\x14[\x0d\x1e^\xc6\xb8P<\x94MZ\x8f\xce\xb9*%\xcf\xbb\xe3h\x06\x8e\xa9\x9f\xaf\xd4\x06\x81v\xf1\xf9\xe7\xac\x94\xc7\x89e\xfb?3\xb9\x8b\xda5\x08\xf3<\x82Y\x1b<zTe\x87t\x9569Z<\x7f\x02\x08\x00?\xea`\xa8.$'L\xff\xd2\xe1\xb1\x11\xdc\xff\xfb\xb4\xda\xf6\x0f\x98\xc3\xb3\xc2\xaa\xc4\x1c\xe4\xd5\xc3O#\xe8\xd5\xc7\xff\xef\xc7\xdf\xde\xfd\xeb\xb6m\x10$\xf1qqa\xe5*\xc9\xc4\xbbEdq\x0f\xc7t\x90+\x9b\xa8\x1a\xc8'l>\xa3\xe1\x1cJ\xa1\x83\xa4\x87\xab\xab:\xd2\x9f\x9c(\xac\xd4\xe0I\x15\xb4\xc7 \xbb'\x1e$\x9c1\xbe\x93[d7!\x80O\xb2\xd7)*\x17\xeaD\x1f\xd3\xef\xbc\xbf\xb4\xe3\x05\xda\x9c\xaa\xf5\xb9\xbc\x8a\xff\xf9\xea\x116\xc9\xe0!p\xd9+\xc3\x95\xc6uo\xcf\xf1+~[\x94\x9a2\xe9*w\"x\xb3\x97\x94E]!a\xc3=\x93\xeb\xaeg\xf8\\t\xd5g\x16\x7f\\ h\x8dE\x9e\xf0\x86K\x83y\x1c\xdc\xc2F\xe3\x16(\xbe\x0a\xc8\xbd\xbb\xd1\xe0\xca\x955\xf24\xe6T\xcb\xa9a\xba?\xf7\x97(\x86\xf6Td\x15b\x89\xc2@\xe5\x08O\x9c\xc8x\x06\xa9\xab\x06\xf0\xfa\xe8\x1a\xf3\x81+\"{\x09\xcd\x84d\xd6\x05\xbawh\xb6\x16o\xe0\x93\x13b\xbdF\x04r\x1e\x07\x03\xaf\xb2\xfc\xf4/\xfc\xd23Tu\x09qJ\xa1\xb7Z\x09\xfe}W\xbe\xef\xceG\xfe\xbb\xbd\x04%\x97\x8fK\xca$:t\xc8\x07\xe6\x92\xe00@\xb3\x17\x0d\xf1N\xa67V\xea\xaci\x8e\xc5\x0c\xea|#2l^V\x8fl\xfe\x12lw,={Xi\xa8l~g\x1b\x18\xb3\x0e;\x8fk\xfa\xf8\xeb\xbb?|\xfc\xfd\xdd\xbfon\xba\xe4/\xd7\xd5\xc9\xc0\x00I\x9a\xc0\xa5\xa7\xccp\x9b\x9f(\x0b\xcd\xc8\x93q7\xe6\x0e=N\x00\xdb\xc1\xb4E\x89%\xcc\xb4\x0b\xe3\x1b\xebd\xb9\x8f\x97\xd4O\x82\xf2\x1ae|p\xd2;d\x93iL]\xf4\xb6%\x9a\x91b" +
+	"\xc7\xd3\xeeA\x1e\xd8)\xa2\xa2\xf0L\xbcq\xe0QM\xee\x986\xd7\x9f-\xd0b\x1e)R\x03\xc2*`;\xe6\x9d\xa1qV\xfe\xb7w?\xde\xfd\xf1\xe3\xdfo\xa3:#\x17\x85\xea\xfd\xf7SX\x05\xe6\x9d\xb6\xd7\xfc\x97\x94\xa5J*\x83\x09\xdf\x9dC\xdb\xf58\xe6\x07\x92`\x0at7\xb4\x1f,\xb3um?\xab\xb7T\xf86\xb1\x19\xa6b\xea\xebX\xa5\xa7\xe9$\x9e4\x80\xbe\x11\xe3\x03\xb0\xad\xac\xf2\xf59\xe3\x8d\x149Ln4\x0dK`\x08j\x888\x15/uHa\xe3\x09\x19\xf1\xcal\x8a\xd2\xf8\x1f\xff\xee\xe3\xdf\xe7x\x8b\xcb(\x8f\x84\xa6\xa5\xf5\xc5P\x8aef\xdbx~K\x91\x04\xafS@\xa3+1<O\x1e\xc6`\xaa\xfa\xbd\x8b\xa669\xf8\xc7-\xf9\xbf\x96\xcbu\\\xc7KJ\xe7\xc9f\x8e\xd0\xd8\x9c\x11\x8d9Q\xff\xee\xee\xc7\x8f\x7f{\xf7\x87\x9c\x15\xfa\x86\xd0\x0d\x0d\\$k\xe4X\xae\xf2\xf5\xf0\x1fi\x06\xa7\x18\x14\xc1\x1b\xa1\x8f!\xe2\x0d\x12c\xff\xf6\xa0}!\x1e\x1b\xf2\xef\x16W\xdf\x1c>\x08j\x90\x0aIV\xdb\x8b\x10\x06\xe0\xbb\xc8\xd4\x9e\xbehh\xb5\xb3y\xe13e\x07q\xeb\\\x8c5\xbd\xc8\xfdn\xc1\xaf\xe1\xff\xc4\xf5\x1e\xb8]\xba\x8a\x94R\x8a@\\\xca\xe1r\xa0\x0f&L\x89\xcb\xb3\xe6a\x94g\xf9\xf8\xbb\x1co\xf4uT\xc8&\x02gU?\x0a;J`\x1a\xa8\xabV8(\xf3\xc9\xba(\x94\xe0\xb5\xc40\x9a\x83\xf7\x15C(\xeeaX=\x8e\xc2o\x98#\x1dN\xe6\x92\xe01nL`eso9z{\xf7\x15\xd2F\xd4\x19\xed\x89\x1c\x0b\xc7\xefD:\x91\xd2\x16\xa4\xb8\x99\xf3\x10\x1e&G\xeb\xbfm\xb3\xa5\x91\xa8-asK\xeb.FC\x7f\xb2\xc5_\xc2\x85!\x16\x93\xdc\x04ek\xd7\xab\xa1\x1fM0\xa0\xa7\xaa\xff\x1e\xaa\x12\x14\xf2O\x93A\x0a\x09\x84_8\x86\x0b\xff\xd7\xa2\xadJ\xb1DJ=W\xd5\xdf\xdd\xd1R\xdd\xbco\xa0\xce[\xe8;\x0a\x861\xff\x91\x19F\x84F\x1f\xa8\x14\xe0\x03(q\xdem\xef\x94\x00\x0c\xa6h\xaaK\xd5\x07\xd6\xfbF\xce`8\xd9\xac\xc7\x1c\xc7H\xff\xdb\xdd\xff\xff\xe3o\xee~\xbc\xfb\x9f\xf7\xdf\x9f\xbf\x10\xc9E\\\xf3\x01D\xff\x1fs\x1e+\xfeJf\xac\xda\x87\xdc\x08\x03\x12\xbb\xa8\xb9\x9e\xabt\xfb8*\x1e0P\xe22\x83\x8eI\xf2\x94\xb4\x03\xa6\xcf\xdd\x07uNUM\xf8\xf1\x1c\xbd\xdbP\xa1\xcf\xc8k\xf8\x0b\x03\xf9\xfb\xde\xe3\xc4\xdb\xcd(\xb8\x98>\x07\x16\xbb\xe7z\xd0(C\xc9O\xc5\xc5z`\x00S\x0c\xf6d\xb3\x12\xcc\xbdQyv\x86\x09\xb9'\x91Y\xc6\x94\xfe~Zy\x8c%\xc7\xc5\x19\xcb\xd7\xf2\x1e@\xd2\xe8\x07\xe8\xcc\x08G\xfaS\xa0>\xd8\xb9\x18\xccEKI\\P\x99\x92\x9c\xac\x93\xbd\xd8\xe6\x88\xafy\xe7hr=\x87wwA,S\x08\xab\x03\xfdo\xfe\xc3~\x92\x1cr?\xde\xfd\xdb\xcf\xfb\xb0wb\xc7a\xa4\xba\x0f\x11=|\xf8{9\xcc\xb6\x17\xe7_\xc3\x0dl~[\x99\x95\xcel\x80n\xf3\x17jk\x8c\x86vw2\x98\x02\xdf\x17\x83s6\xfcV`X\x85W4\xc42\xc5\xd0\x91\xdeg05S9\xf0U\xf6a;/UqM\x0e1\x8b\x13\x8e\x12\x9c\xfc\xbe\xb8\x11'=\xb9[y\xf2\xff\xaf\xff\xe7\xdf\xd8\x1e\xba\xff\xf7\xff\xe3\xff\x1e\x00@\xe0\xb9g\x94\xa1\x14\x00"
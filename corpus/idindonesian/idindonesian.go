@@ -0,0 +1,22 @@
+// Package idindonesian provides Indonesian translation of Alquran by Indonesian Ministry of Religious Affairs from http://tanzil.net, stored gzip-compressed.
+// Original file name: "id.indonesian.xml".
+//
+// Run "go run gen.go idindonesian id.indonesian.xml" in corpus directory to regenerate it.
+package idindonesian
+
+import (
+	"sync"
+
+	"github.com/alpancs/quranize/corpus/internal/gzipped"
+)
+
+var (
+	once sync.Once
+	xml  string
+)
+
+// XML returns the corpus in Tanzil XML format, decompressed on first call.
+func XML() string {
+	once.Do(func() { xml = gzipped.Decompress(compressed) })
+	return xml
+}
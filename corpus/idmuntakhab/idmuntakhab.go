@@ -0,0 +1,22 @@
+// Package idmuntakhab provides Indonesian translation of Alquran by Muhammad Quraish Shihab et al. from http://tanzil.net, stored gzip-compressed.
+// Original file name: "id.muntakhab.xml".
+//
+// Run "go run gen.go idmuntakhab id.muntakhab.xml" in corpus directory to regenerate it.
+package idmuntakhab
+
+import (
+	"sync"
+
+	"github.com/alpancs/quranize/corpus/internal/gzipped"
+)
+
+var (
+	once sync.Once
+	xml  string
+)
+
+// XML returns the corpus in Tanzil XML format, decompressed on first call.
+func XML() string {
+	once.Do(func() { xml = gzipped.Decompress(compressed) })
+	return xml
+}
@@ -0,0 +1,22 @@
+// Package gzipped decompresses corpus stored as gzip-compressed go string.
+package gzipped
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"strings"
+)
+
+// Decompress returns decompressed text of gzip-compressed s.
+// It panics if s is not valid gzip data, as corpus are generated by gen.go.
+func Decompress(s string) string {
+	r, err := gzip.NewReader(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	text, err := ioutil.ReadAll(r)
+	if err != nil {
+		panic(err)
+	}
+	return string(text)
+}
@@ -0,0 +1,18 @@
+package gzipped
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecompress(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	w := gzip.NewWriter(buffer)
+	w.Write([]byte("<quran></quran>"))
+	w.Close()
+	assert.Equal(t, "<quran></quran>", Decompress(buffer.String()))
+	assert.Panics(t, func() { Decompress("<quran></quran>") })
+}
@@ -1,6504 +0,0 @@
-package corpus
-
-// Original file name: "quran-simple-clean.xml".
-const QuranSimpleCleanXML = `
-<?xml version="1.0" encoding="utf-8" ?>
-<!--
-
-# PLEASE DO NOT REMOVE OR CHANGE THIS COPYRIGHT BLOCK
-#====================================================================
-#
-#  Tanzil Quran Text (Simple Clean, version 1.0.2)
-#  Copyright (C) 2008-2010 Tanzil.net
-#  License: Creative Commons Attribution 3.0
-#
-#  This copy of quran text is carefully produced, highly
-#  verified and continuously monitored by a group of specialists
-#  at Tanzil project.
-#
-#  TERMS OF USE:
-#
-#  - Permission is granted to copy and distribute verbatim copies
-#    of this text, but CHANGING IT IS NOT ALLOWED.
-#
-#  - This quran text can be used in any website or application,
-#    provided its source (Tanzil.net) is clearly indicated, and
-#    a link is made to http://tanzil.net to enable users to keep
-#    track of changes.
-#
-#  - This copyright notice shall be included in all verbatim copies
-#    of the text, and shall be reproduced appropriately in all files
-#    derived from or containing substantial portion of this text.
-#
-#  Please check updates at: http://tanzil.net/updates/
-#
-#====================================================================
-
--->
-<quran>
-	<sura index="1" name="الفاتحة">
-		<aya index="1" text="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الحمد لله رب العالمين" />
-		<aya index="3" text="الرحمن الرحيم" />
-		<aya index="4" text="مالك يوم الدين" />
-		<aya index="5" text="إياك نعبد وإياك نستعين" />
-		<aya index="6" text="اهدنا الصراط المستقيم" />
-		<aya index="7" text="صراط الذين أنعمت عليهم غير المغضوب عليهم ولا الضالين" />
-	</sura>
-	<sura index="2" name="البقرة">
-		<aya index="1" text="الم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ذلك الكتاب لا ريب فيه هدى للمتقين" />
-		<aya index="3" text="الذين يؤمنون بالغيب ويقيمون الصلاة ومما رزقناهم ينفقون" />
-		<aya index="4" text="والذين يؤمنون بما أنزل إليك وما أنزل من قبلك وبالآخرة هم يوقنون" />
-		<aya index="5" text="أولئك على هدى من ربهم وأولئك هم المفلحون" />
-		<aya index="6" text="إن الذين كفروا سواء عليهم أأنذرتهم أم لم تنذرهم لا يؤمنون" />
-		<aya index="7" text="ختم الله على قلوبهم وعلى سمعهم وعلى أبصارهم غشاوة ولهم عذاب عظيم" />
-		<aya index="8" text="ومن الناس من يقول آمنا بالله وباليوم الآخر وما هم بمؤمنين" />
-		<aya index="9" text="يخادعون الله والذين آمنوا وما يخدعون إلا أنفسهم وما يشعرون" />
-		<aya index="10" text="في قلوبهم مرض فزادهم الله مرضا ولهم عذاب أليم بما كانوا يكذبون" />
-		<aya index="11" text="وإذا قيل لهم لا تفسدوا في الأرض قالوا إنما نحن مصلحون" />
-		<aya index="12" text="ألا إنهم هم المفسدون ولكن لا يشعرون" />
-		<aya index="13" text="وإذا قيل لهم آمنوا كما آمن الناس قالوا أنؤمن كما آمن السفهاء ألا إنهم هم السفهاء ولكن لا يعلمون" />
-		<aya index="14" text="وإذا لقوا الذين آمنوا قالوا آمنا وإذا خلوا إلى شياطينهم قالوا إنا معكم إنما نحن مستهزئون" />
-		<aya index="15" text="الله يستهزئ بهم ويمدهم في طغيانهم يعمهون" />
-		<aya index="16" text="أولئك الذين اشتروا الضلالة بالهدى فما ربحت تجارتهم وما كانوا مهتدين" />
-		<aya index="17" text="مثلهم كمثل الذي استوقد نارا فلما أضاءت ما حوله ذهب الله بنورهم وتركهم في ظلمات لا يبصرون" />
-		<aya index="18" text="صم بكم عمي فهم لا يرجعون" />
-		<aya index="19" text="أو كصيب من السماء فيه ظلمات ورعد وبرق يجعلون أصابعهم في آذانهم من الصواعق حذر الموت والله محيط بالكافرين" />
-		<aya index="20" text="يكاد البرق يخطف أبصارهم كلما أضاء لهم مشوا فيه وإذا أظلم عليهم قاموا ولو شاء الله لذهب بسمعهم وأبصارهم إن الله على كل شيء قدير" />
-		<aya index="21" text="يا أيها الناس اعبدوا ربكم الذي خلقكم والذين من قبلكم لعلكم تتقون" />
-		<aya index="22" text="الذي جعل لكم الأرض فراشا والسماء بناء وأنزل من السماء ماء فأخرج به من الثمرات رزقا لكم فلا تجعلوا لله أندادا وأنتم تعلمون" />
-		<aya index="23" text="وإن كنتم في ريب مما نزلنا على عبدنا فأتوا بسورة من مثله وادعوا شهداءكم من دون الله إن كنتم صادقين" />
-		<aya index="24" text="فإن لم تفعلوا ولن تفعلوا فاتقوا النار التي وقودها الناس والحجارة أعدت للكافرين" />
-		<aya index="25" text="وبشر الذين آمنوا وعملوا الصالحات أن لهم جنات تجري من تحتها الأنهار كلما رزقوا منها من ثمرة رزقا قالوا هذا الذي رزقنا من قبل وأتوا به متشابها ولهم فيها أزواج مطهرة وهم فيها خالدون" />
-		<aya index="26" text="إن الله لا يستحيي أن يضرب مثلا ما بعوضة فما فوقها فأما الذين آمنوا فيعلمون أنه الحق من ربهم وأما الذين كفروا فيقولون ماذا أراد الله بهذا مثلا يضل به كثيرا ويهدي به كثيرا وما يضل به إلا الفاسقين" />
-		<aya index="27" text="الذين ينقضون عهد الله من بعد ميثاقه ويقطعون ما أمر الله به أن يوصل ويفسدون في الأرض أولئك هم الخاسرون" />
-		<aya index="28" text="كيف تكفرون بالله وكنتم أمواتا فأحياكم ثم يميتكم ثم يحييكم ثم إليه ترجعون" />
-		<aya index="29" text="هو الذي خلق لكم ما في الأرض جميعا ثم استوى إلى السماء فسواهن سبع سماوات وهو بكل شيء عليم" />
-		<aya index="30" text="وإذ قال ربك للملائكة إني جاعل في الأرض خليفة قالوا أتجعل فيها من يفسد فيها ويسفك الدماء ونحن نسبح بحمدك ونقدس لك قال إني أعلم ما لا تعلمون" />
-		<aya index="31" text="وعلم آدم الأسماء كلها ثم عرضهم على الملائكة فقال أنبئوني بأسماء هؤلاء إن كنتم صادقين" />
-		<aya index="32" text="قالوا سبحانك لا علم لنا إلا ما علمتنا إنك أنت العليم الحكيم" />
-		<aya index="33" text="قال يا آدم أنبئهم بأسمائهم فلما أنبأهم بأسمائهم قال ألم أقل لكم إني أعلم غيب السماوات والأرض وأعلم ما تبدون وما كنتم تكتمون" />
-		<aya index="34" text="وإذ قلنا للملائكة اسجدوا لآدم فسجدوا إلا إبليس أبى واستكبر وكان من الكافرين" />
-		<aya index="35" text="وقلنا يا آدم اسكن أنت وزوجك الجنة وكلا منها رغدا حيث شئتما ولا تقربا هذه الشجرة فتكونا من الظالمين" />
-		<aya index="36" text="فأزلهما الشيطان عنها فأخرجهما مما كانا فيه وقلنا اهبطوا بعضكم لبعض عدو ولكم في الأرض مستقر ومتاع إلى حين" />
-		<aya index="37" text="فتلقى آدم من ربه كلمات فتاب عليه إنه هو التواب الرحيم" />
-		<aya index="38" text="قلنا اهبطوا منها جميعا فإما يأتينكم مني هدى فمن تبع هداي فلا خوف عليهم ولا هم يحزنون" />
-		<aya index="39" text="والذين كفروا وكذبوا بآياتنا أولئك أصحاب النار هم فيها خالدون" />
-		<aya index="40" text="يا بني إسرائيل اذكروا نعمتي التي أنعمت عليكم وأوفوا بعهدي أوف بعهدكم وإياي فارهبون" />
-		<aya index="41" text="وآمنوا بما أنزلت مصدقا لما معكم ولا تكونوا أول كافر به ولا تشتروا بآياتي ثمنا قليلا وإياي فاتقون" />
-		<aya index="42" text="ولا تلبسوا الحق بالباطل وتكتموا الحق وأنتم تعلمون" />
-		<aya index="43" text="وأقيموا الصلاة وآتوا الزكاة واركعوا مع الراكعين" />
-		<aya index="44" text="أتأمرون الناس بالبر وتنسون أنفسكم وأنتم تتلون الكتاب أفلا تعقلون" />
-		<aya index="45" text="واستعينوا بالصبر والصلاة وإنها لكبيرة إلا على الخاشعين" />
-		<aya index="46" text="الذين يظنون أنهم ملاقو ربهم وأنهم إليه راجعون" />
-		<aya index="47" text="يا بني إسرائيل اذكروا نعمتي التي أنعمت عليكم وأني فضلتكم على العالمين" />
-		<aya index="48" text="واتقوا يوما لا تجزي نفس عن نفس شيئا ولا يقبل منها شفاعة ولا يؤخذ منها عدل ولا هم ينصرون" />
-		<aya index="49" text="وإذ نجيناكم من آل فرعون يسومونكم سوء العذاب يذبحون أبناءكم ويستحيون نساءكم وفي ذلكم بلاء من ربكم عظيم" />
-		<aya index="50" text="وإذ فرقنا بكم البحر فأنجيناكم وأغرقنا آل فرعون وأنتم تنظرون" />
-		<aya index="51" text="وإذ واعدنا موسى أربعين ليلة ثم اتخذتم العجل من بعده وأنتم ظالمون" />
-		<aya index="52" text="ثم عفونا عنكم من بعد ذلك لعلكم تشكرون" />
-		<aya index="53" text="وإذ آتينا موسى الكتاب والفرقان لعلكم تهتدون" />
-		<aya index="54" text="وإذ قال موسى لقومه يا قوم إنكم ظلمتم أنفسكم باتخاذكم العجل فتوبوا إلى بارئكم فاقتلوا أنفسكم ذلكم خير لكم عند بارئكم فتاب عليكم إنه هو التواب الرحيم" />
-		<aya index="55" text="وإذ قلتم يا موسى لن نؤمن لك حتى نرى الله جهرة فأخذتكم الصاعقة وأنتم تنظرون" />
-		<aya index="56" text="ثم بعثناكم من بعد موتكم لعلكم تشكرون" />
-		<aya index="57" text="وظللنا عليكم الغمام وأنزلنا عليكم المن والسلوى كلوا من طيبات ما رزقناكم وما ظلمونا ولكن كانوا أنفسهم يظلمون" />
-		<aya index="58" text="وإذ قلنا ادخلوا هذه القرية فكلوا منها حيث شئتم رغدا وادخلوا الباب سجدا وقولوا حطة نغفر لكم خطاياكم وسنزيد المحسنين" />
-		<aya index="59" text="فبدل الذين ظلموا قولا غير الذي قيل لهم فأنزلنا على الذين ظلموا رجزا من السماء بما كانوا يفسقون" />
-		<aya index="60" text="وإذ استسقى موسى لقومه فقلنا اضرب بعصاك الحجر فانفجرت منه اثنتا عشرة عينا قد علم كل أناس مشربهم كلوا واشربوا من رزق الله ولا تعثوا في الأرض مفسدين" />
-		<aya index="61" text="وإذ قلتم يا موسى لن نصبر على طعام واحد فادع لنا ربك يخرج لنا مما تنبت الأرض من بقلها وقثائها وفومها وعدسها وبصلها قال أتستبدلون الذي هو أدنى بالذي هو خير اهبطوا مصرا فإن لكم ما سألتم وضربت عليهم الذلة والمسكنة وباءوا بغضب من الله ذلك بأنهم كانوا يكفرون بآيات الله ويقتلون النبيين بغير الحق ذلك بما عصوا وكانوا يعتدون" />
-		<aya index="62" text="إن الذين آمنوا والذين هادوا والنصارى والصابئين من آمن بالله واليوم الآخر وعمل صالحا فلهم أجرهم عند ربهم ولا خوف عليهم ولا هم يحزنون" />
-		<aya index="63" text="وإذ أخذنا ميثاقكم ورفعنا فوقكم الطور خذوا ما آتيناكم بقوة واذكروا ما فيه لعلكم تتقون" />
-		<aya index="64" text="ثم توليتم من بعد ذلك فلولا فضل الله عليكم ورحمته لكنتم من الخاسرين" />
-		<aya index="65" text="ولقد علمتم الذين اعتدوا منكم في السبت فقلنا لهم كونوا قردة خاسئين" />
-		<aya index="66" text="فجعلناها نكالا لما بين يديها وما خلفها وموعظة للمتقين" />
-		<aya index="67" text="وإذ قال موسى لقومه إن الله يأمركم أن تذبحوا بقرة قالوا أتتخذنا هزوا قال أعوذ بالله أن أكون من الجاهلين" />
-		<aya index="68" text="قالوا ادع لنا ربك يبين لنا ما هي قال إنه يقول إنها بقرة لا فارض ولا بكر عوان بين ذلك فافعلوا ما تؤمرون" />
-		<aya index="69" text="قالوا ادع لنا ربك يبين لنا ما لونها قال إنه يقول إنها بقرة صفراء فاقع لونها تسر الناظرين" />
-		<aya index="70" text="قالوا ادع لنا ربك يبين لنا ما هي إن البقر تشابه علينا وإنا إن شاء الله لمهتدون" />
-		<aya index="71" text="قال إنه يقول إنها بقرة لا ذلول تثير الأرض ولا تسقي الحرث مسلمة لا شية فيها قالوا الآن جئت بالحق فذبحوها وما كادوا يفعلون" />
-		<aya index="72" text="وإذ قتلتم نفسا فادارأتم فيها والله مخرج ما كنتم تكتمون" />
-		<aya index="73" text="فقلنا اضربوه ببعضها كذلك يحيي الله الموتى ويريكم آياته لعلكم تعقلون" />
-		<aya index="74" text="ثم قست قلوبكم من بعد ذلك فهي كالحجارة أو أشد قسوة وإن من الحجارة لما يتفجر منه الأنهار وإن منها لما يشقق فيخرج منه الماء وإن منها لما يهبط من خشية الله وما الله بغافل عما تعملون" />
-		<aya index="75" text="أفتطمعون أن يؤمنوا لكم وقد كان فريق منهم يسمعون كلام الله ثم يحرفونه من بعد ما عقلوه وهم يعلمون" />
-		<aya index="76" text="وإذا لقوا الذين آمنوا قالوا آمنا وإذا خلا بعضهم إلى بعض قالوا أتحدثونهم بما فتح الله عليكم ليحاجوكم به عند ربكم أفلا تعقلون" />
-		<aya index="77" text="أولا يعلمون أن الله يعلم ما يسرون وما يعلنون" />
-		<aya index="78" text="ومنهم أميون لا يعلمون الكتاب إلا أماني وإن هم إلا يظنون" />
-		<aya index="79" text="فويل للذين يكتبون الكتاب بأيديهم ثم يقولون هذا من عند الله ليشتروا به ثمنا قليلا فويل لهم مما كتبت أيديهم وويل لهم مما يكسبون" />
-		<aya index="80" text="وقالوا لن تمسنا النار إلا أياما معدودة قل أتخذتم عند الله عهدا فلن يخلف الله عهده أم تقولون على الله ما لا تعلمون" />
-		<aya index="81" text="بلى من كسب سيئة وأحاطت به خطيئته فأولئك أصحاب النار هم فيها خالدون" />
-		<aya index="82" text="والذين آمنوا وعملوا الصالحات أولئك أصحاب الجنة هم فيها خالدون" />
-		<aya index="83" text="وإذ أخذنا ميثاق بني إسرائيل لا تعبدون إلا الله وبالوالدين إحسانا وذي القربى واليتامى والمساكين وقولوا للناس حسنا وأقيموا الصلاة وآتوا الزكاة ثم توليتم إلا قليلا منكم وأنتم معرضون" />
-		<aya index="84" text="وإذ أخذنا ميثاقكم لا تسفكون دماءكم ولا تخرجون أنفسكم من دياركم ثم أقررتم وأنتم تشهدون" />
-		<aya index="85" text="ثم أنتم هؤلاء تقتلون أنفسكم وتخرجون فريقا منكم من ديارهم تظاهرون عليهم بالإثم والعدوان وإن يأتوكم أسارى تفادوهم وهو محرم عليكم إخراجهم أفتؤمنون ببعض الكتاب وتكفرون ببعض فما جزاء من يفعل ذلك منكم إلا خزي في الحياة الدنيا ويوم القيامة يردون إلى أشد العذاب وما الله بغافل عما تعملون" />
-		<aya index="86" text="أولئك الذين اشتروا الحياة الدنيا بالآخرة فلا يخفف عنهم العذاب ولا هم ينصرون" />
-		<aya index="87" text="ولقد آتينا موسى الكتاب وقفينا من بعده بالرسل وآتينا عيسى ابن مريم البينات وأيدناه بروح القدس أفكلما جاءكم رسول بما لا تهوى أنفسكم استكبرتم ففريقا كذبتم وفريقا تقتلون" />
-		<aya index="88" text="وقالوا قلوبنا غلف بل لعنهم الله بكفرهم فقليلا ما يؤمنون" />
-		<aya index="89" text="ولما جاءهم كتاب من عند الله مصدق لما معهم وكانوا من قبل يستفتحون على الذين كفروا فلما جاءهم ما عرفوا كفروا به فلعنة الله على الكافرين" />
-		<aya index="90" text="بئسما اشتروا به أنفسهم أن يكفروا بما أنزل الله بغيا أن ينزل الله من فضله على من يشاء من عباده فباءوا بغضب على غضب وللكافرين عذاب مهين" />
-		<aya index="91" text="وإذا قيل لهم آمنوا بما أنزل الله قالوا نؤمن بما أنزل علينا ويكفرون بما وراءه وهو الحق مصدقا لما معهم قل فلم تقتلون أنبياء الله من قبل إن كنتم مؤمنين" />
-		<aya index="92" text="ولقد جاءكم موسى بالبينات ثم اتخذتم العجل من بعده وأنتم ظالمون" />
-		<aya index="93" text="وإذ أخذنا ميثاقكم ورفعنا فوقكم الطور خذوا ما آتيناكم بقوة واسمعوا قالوا سمعنا وعصينا وأشربوا في قلوبهم العجل بكفرهم قل بئسما يأمركم به إيمانكم إن كنتم مؤمنين" />
-		<aya index="94" text="قل إن كانت لكم الدار الآخرة عند الله خالصة من دون الناس فتمنوا الموت إن كنتم صادقين" />
-		<aya index="95" text="ولن يتمنوه أبدا بما قدمت أيديهم والله عليم بالظالمين" />
-		<aya index="96" text="ولتجدنهم أحرص الناس على حياة ومن الذين أشركوا يود أحدهم لو يعمر ألف سنة وما هو بمزحزحه من العذاب أن يعمر والله بصير بما يعملون" />
-		<aya index="97" text="قل من كان عدوا لجبريل فإنه نزله على قلبك بإذن الله مصدقا لما بين يديه وهدى وبشرى للمؤمنين" />
-		<aya index="98" text="من كان عدوا لله وملائكته ورسله وجبريل وميكال فإن الله عدو للكافرين" />
-		<aya index="99" text="ولقد أنزلنا إليك آيات بينات وما يكفر بها إلا الفاسقون" />
-		<aya index="100" text="أوكلما عاهدوا عهدا نبذه فريق منهم بل أكثرهم لا يؤمنون" />
-		<aya index="101" text="ولما جاءهم رسول من عند الله مصدق لما معهم نبذ فريق من الذين أوتوا الكتاب كتاب الله وراء ظهورهم كأنهم لا يعلمون" />
-		<aya index="102" text="واتبعوا ما تتلو الشياطين على ملك سليمان وما كفر سليمان ولكن الشياطين كفروا يعلمون الناس السحر وما أنزل على الملكين ببابل هاروت وماروت وما يعلمان من أحد حتى يقولا إنما نحن فتنة فلا تكفر فيتعلمون منهما ما يفرقون به بين المرء وزوجه وما هم بضارين به من أحد إلا بإذن الله ويتعلمون ما يضرهم ولا ينفعهم ولقد علموا لمن اشتراه ما له في الآخرة من خلاق ولبئس ما شروا به أنفسهم لو كانوا يعلمون" />
-		<aya index="103" text="ولو أنهم آمنوا واتقوا لمثوبة من عند الله خير لو كانوا يعلمون" />
-		<aya index="104" text="يا أيها الذين آمنوا لا تقولوا راعنا وقولوا انظرنا واسمعوا وللكافرين عذاب أليم" />
-		<aya index="105" text="ما يود الذين كفروا من أهل الكتاب ولا المشركين أن ينزل عليكم من خير من ربكم والله يختص برحمته من يشاء والله ذو الفضل العظيم" />
-		<aya index="106" text="ما ننسخ من آية أو ننسها نأت بخير منها أو مثلها ألم تعلم أن الله على كل شيء قدير" />
-		<aya index="107" text="ألم تعلم أن الله له ملك السماوات والأرض وما لكم من دون الله من ولي ولا نصير" />
-		<aya index="108" text="أم تريدون أن تسألوا رسولكم كما سئل موسى من قبل ومن يتبدل الكفر بالإيمان فقد ضل سواء السبيل" />
-		<aya index="109" text="ود كثير من أهل الكتاب لو يردونكم من بعد إيمانكم كفارا حسدا من عند أنفسهم من بعد ما تبين لهم الحق فاعفوا واصفحوا حتى يأتي الله بأمره إن الله على كل شيء قدير" />
-		<aya index="110" text="وأقيموا الصلاة وآتوا الزكاة وما تقدموا لأنفسكم من خير تجدوه عند الله إن الله بما تعملون بصير" />
-		<aya index="111" text="وقالوا لن يدخل الجنة إلا من كان هودا أو نصارى تلك أمانيهم قل هاتوا برهانكم إن كنتم صادقين" />
-		<aya index="112" text="بلى من أسلم وجهه لله وهو محسن فله أجره عند ربه ولا خوف عليهم ولا هم يحزنون" />
-		<aya index="113" text="وقالت اليهود ليست النصارى على شيء وقالت النصارى ليست اليهود على شيء وهم يتلون الكتاب كذلك قال الذين لا يعلمون مثل قولهم فالله يحكم بينهم يوم القيامة فيما كانوا فيه يختلفون" />
-		<aya index="114" text="ومن أظلم ممن منع مساجد الله أن يذكر فيها اسمه وسعى في خرابها أولئك ما كان لهم أن يدخلوها إلا خائفين لهم في الدنيا خزي ولهم في الآخرة عذاب عظيم" />
-		<aya index="115" text="ولله المشرق والمغرب فأينما تولوا فثم وجه الله إن الله واسع عليم" />
-		<aya index="116" text="وقالوا اتخذ الله ولدا سبحانه بل له ما في السماوات والأرض كل له قانتون" />
-		<aya index="117" text="بديع السماوات والأرض وإذا قضى أمرا فإنما يقول له كن فيكون" />
-		<aya index="118" text="وقال الذين لا يعلمون لولا يكلمنا الله أو تأتينا آية كذلك قال الذين من قبلهم مثل قولهم تشابهت قلوبهم قد بينا الآيات لقوم يوقنون" />
-		<aya index="119" text="إنا أرسلناك بالحق بشيرا ونذيرا ولا تسأل عن أصحاب الجحيم" />
-		<aya index="120" text="ولن ترضى عنك اليهود ولا النصارى حتى تتبع ملتهم قل إن هدى الله هو الهدى ولئن اتبعت أهواءهم بعد الذي جاءك من العلم ما لك من الله من ولي ولا نصير" />
-		<aya index="121" text="الذين آتيناهم الكتاب يتلونه حق تلاوته أولئك يؤمنون به ومن يكفر به فأولئك هم الخاسرون" />
-		<aya index="122" text="يا بني إسرائيل اذكروا نعمتي التي أنعمت عليكم وأني فضلتكم على العالمين" />
-		<aya index="123" text="واتقوا يوما لا تجزي نفس عن نفس شيئا ولا يقبل منها عدل ولا تنفعها شفاعة ولا هم ينصرون" />
-		<aya index="124" text="وإذ ابتلى إبراهيم ربه بكلمات فأتمهن قال إني جاعلك للناس إماما قال ومن ذريتي قال لا ينال عهدي الظالمين" />
-		<aya index="125" text="وإذ جعلنا البيت مثابة للناس وأمنا واتخذوا من مقام إبراهيم مصلى وعهدنا إلى إبراهيم وإسماعيل أن طهرا بيتي للطائفين والعاكفين والركع السجود" />
-		<aya index="126" text="وإذ قال إبراهيم رب اجعل هذا بلدا آمنا وارزق أهله من الثمرات من آمن منهم بالله واليوم الآخر قال ومن كفر فأمتعه قليلا ثم أضطره إلى عذاب النار وبئس المصير" />
-		<aya index="127" text="وإذ يرفع إبراهيم القواعد من البيت وإسماعيل ربنا تقبل منا إنك أنت السميع العليم" />
-		<aya index="128" text="ربنا واجعلنا مسلمين لك ومن ذريتنا أمة مسلمة لك وأرنا مناسكنا وتب علينا إنك أنت التواب الرحيم" />
-		<aya index="129" text="ربنا وابعث فيهم رسولا منهم يتلو عليهم آياتك ويعلمهم الكتاب والحكمة ويزكيهم إنك أنت العزيز الحكيم" />
-		<aya index="130" text="ومن يرغب عن ملة إبراهيم إلا من سفه نفسه ولقد اصطفيناه في الدنيا وإنه في الآخرة لمن الصالحين" />
-		<aya index="131" text="إذ قال له ربه أسلم قال أسلمت لرب العالمين" />
-		<aya index="132" text="ووصى بها إبراهيم بنيه ويعقوب يا بني إن الله اصطفى لكم الدين فلا تموتن إلا وأنتم مسلمون" />
-		<aya index="133" text="أم كنتم شهداء إذ حضر يعقوب الموت إذ قال لبنيه ما تعبدون من بعدي قالوا نعبد إلهك وإله آبائك إبراهيم وإسماعيل وإسحاق إلها واحدا ونحن له مسلمون" />
-		<aya index="134" text="تلك أمة قد خلت لها ما كسبت ولكم ما كسبتم ولا تسألون عما كانوا يعملون" />
-		<aya index="135" text="وقالوا كونوا هودا أو نصارى تهتدوا قل بل ملة إبراهيم حنيفا وما كان من المشركين" />
-		<aya index="136" text="قولوا آمنا بالله وما أنزل إلينا وما أنزل إلى إبراهيم وإسماعيل وإسحاق ويعقوب والأسباط وما أوتي موسى وعيسى وما أوتي النبيون من ربهم لا نفرق بين أحد منهم ونحن له مسلمون" />
-		<aya index="137" text="فإن آمنوا بمثل ما آمنتم به فقد اهتدوا وإن تولوا فإنما هم في شقاق فسيكفيكهم الله وهو السميع العليم" />
-		<aya index="138" text="صبغة الله ومن أحسن من الله صبغة ونحن له عابدون" />
-		<aya index="139" text="قل أتحاجوننا في الله وهو ربنا وربكم ولنا أعمالنا ولكم أعمالكم ونحن له مخلصون" />
-		<aya index="140" text="أم تقولون إن إبراهيم وإسماعيل وإسحاق ويعقوب والأسباط كانوا هودا أو نصارى قل أأنتم أعلم أم الله ومن أظلم ممن كتم شهادة عنده من الله وما الله بغافل عما تعملون" />
-		<aya index="141" text="تلك أمة قد خلت لها ما كسبت ولكم ما كسبتم ولا تسألون عما كانوا يعملون" />
-		<aya index="142" text="سيقول السفهاء من الناس ما ولاهم عن قبلتهم التي كانوا عليها قل لله المشرق والمغرب يهدي من يشاء إلى صراط مستقيم" />
-		<aya index="143" text="وكذلك جعلناكم أمة وسطا لتكونوا شهداء على الناس ويكون الرسول عليكم شهيدا وما جعلنا القبلة التي كنت عليها إلا لنعلم من يتبع الرسول ممن ينقلب على عقبيه وإن كانت لكبيرة إلا على الذين هدى الله وما كان الله ليضيع إيمانكم إن الله بالناس لرءوف رحيم" />
-		<aya index="144" text="قد نرى تقلب وجهك في السماء فلنولينك قبلة ترضاها فول وجهك شطر المسجد الحرام وحيث ما كنتم فولوا وجوهكم شطره وإن الذين أوتوا الكتاب ليعلمون أنه الحق من ربهم وما الله بغافل عما يعملون" />
-		<aya index="145" text="ولئن أتيت الذين أوتوا الكتاب بكل آية ما تبعوا قبلتك وما أنت بتابع قبلتهم وما بعضهم بتابع قبلة بعض ولئن اتبعت أهواءهم من بعد ما جاءك من العلم إنك إذا لمن الظالمين" />
-		<aya index="146" text="الذين آتيناهم الكتاب يعرفونه كما يعرفون أبناءهم وإن فريقا منهم ليكتمون الحق وهم يعلمون" />
-		<aya index="147" text="الحق من ربك فلا تكونن من الممترين" />
-		<aya index="148" text="ولكل وجهة هو موليها فاستبقوا الخيرات أين ما تكونوا يأت بكم الله جميعا إن الله على كل شيء قدير" />
-		<aya index="149" text="ومن حيث خرجت فول وجهك شطر المسجد الحرام وإنه للحق من ربك وما الله بغافل عما تعملون" />
-		<aya index="150" text="ومن حيث خرجت فول وجهك شطر المسجد الحرام وحيث ما كنتم فولوا وجوهكم شطره لئلا يكون للناس عليكم حجة إلا الذين ظلموا منهم فلا تخشوهم واخشوني ولأتم نعمتي عليكم ولعلكم تهتدون" />
-		<aya index="151" text="كما أرسلنا فيكم رسولا منكم يتلو عليكم آياتنا ويزكيكم ويعلمكم الكتاب والحكمة ويعلمكم ما لم تكونوا تعلمون" />
-		<aya index="152" text="فاذكروني أذكركم واشكروا لي ولا تكفرون" />
-		<aya index="153" text="يا أيها الذين آمنوا استعينوا بالصبر والصلاة إن الله مع الصابرين" />
-		<aya index="154" text="ولا تقولوا لمن يقتل في سبيل الله أموات بل أحياء ولكن لا تشعرون" />
-		<aya index="155" text="ولنبلونكم بشيء من الخوف والجوع ونقص من الأموال والأنفس والثمرات وبشر الصابرين" />
-		<aya index="156" text="الذين إذا أصابتهم مصيبة قالوا إنا لله وإنا إليه راجعون" />
-		<aya index="157" text="أولئك عليهم صلوات من ربهم ورحمة وأولئك هم المهتدون" />
-		<aya index="158" text="إن الصفا والمروة من شعائر الله فمن حج البيت أو اعتمر فلا جناح عليه أن يطوف بهما ومن تطوع خيرا فإن الله شاكر عليم" />
-		<aya index="159" text="إن الذين يكتمون ما أنزلنا من البينات والهدى من بعد ما بيناه للناس في الكتاب أولئك يلعنهم الله ويلعنهم اللاعنون" />
-		<aya index="160" text="إلا الذين تابوا وأصلحوا وبينوا فأولئك أتوب عليهم وأنا التواب الرحيم" />
-		<aya index="161" text="إن الذين كفروا وماتوا وهم كفار أولئك عليهم لعنة الله والملائكة والناس أجمعين" />
-		<aya index="162" text="خالدين فيها لا يخفف عنهم العذاب ولا هم ينظرون" />
-		<aya index="163" text="وإلهكم إله واحد لا إله إلا هو الرحمن الرحيم" />
-		<aya index="164" text="إن في خلق السماوات والأرض واختلاف الليل والنهار والفلك التي تجري في البحر بما ينفع الناس وما أنزل الله من السماء من ماء فأحيا به الأرض بعد موتها وبث فيها من كل دابة وتصريف الرياح والسحاب المسخر بين السماء والأرض لآيات لقوم يعقلون" />
-		<aya index="165" text="ومن الناس من يتخذ من دون الله أندادا يحبونهم كحب الله والذين آمنوا أشد حبا لله ولو يرى الذين ظلموا إذ يرون العذاب أن القوة لله جميعا وأن الله شديد العذاب" />
-		<aya index="166" text="إذ تبرأ الذين اتبعوا من الذين اتبعوا ورأوا العذاب وتقطعت بهم الأسباب" />
-		<aya index="167" text="وقال الذين اتبعوا لو أن لنا كرة فنتبرأ منهم كما تبرءوا منا كذلك يريهم الله أعمالهم حسرات عليهم وما هم بخارجين من النار" />
-		<aya index="168" text="يا أيها الناس كلوا مما في الأرض حلالا طيبا ولا تتبعوا خطوات الشيطان إنه لكم عدو مبين" />
-		<aya index="169" text="إنما يأمركم بالسوء والفحشاء وأن تقولوا على الله ما لا تعلمون" />
-		<aya index="170" text="وإذا قيل لهم اتبعوا ما أنزل الله قالوا بل نتبع ما ألفينا عليه آباءنا أولو كان آباؤهم لا يعقلون شيئا ولا يهتدون" />
-		<aya index="171" text="ومثل الذين كفروا كمثل الذي ينعق بما لا يسمع إلا دعاء ونداء صم بكم عمي فهم لا يعقلون" />
-		<aya index="172" text="يا أيها الذين آمنوا كلوا من طيبات ما رزقناكم واشكروا لله إن كنتم إياه تعبدون" />
-		<aya index="173" text="إنما حرم عليكم الميتة والدم ولحم الخنزير وما أهل به لغير الله فمن اضطر غير باغ ولا عاد فلا إثم عليه إن الله غفور رحيم" />
-		<aya index="174" text="إن الذين يكتمون ما أنزل الله من الكتاب ويشترون به ثمنا قليلا أولئك ما يأكلون في بطونهم إلا النار ولا يكلمهم الله يوم القيامة ولا يزكيهم ولهم عذاب أليم" />
-		<aya index="175" text="أولئك الذين اشتروا الضلالة بالهدى والعذاب بالمغفرة فما أصبرهم على النار" />
-		<aya index="176" text="ذلك بأن الله نزل الكتاب بالحق وإن الذين اختلفوا في الكتاب لفي شقاق بعيد" />
-		<aya index="177" text="ليس البر أن تولوا وجوهكم قبل المشرق والمغرب ولكن البر من آمن بالله واليوم الآخر والملائكة والكتاب والنبيين وآتى المال على حبه ذوي القربى واليتامى والمساكين وابن السبيل والسائلين وفي الرقاب وأقام الصلاة وآتى الزكاة والموفون بعهدهم إذا عاهدوا والصابرين في البأساء والضراء وحين البأس أولئك الذين صدقوا وأولئك هم المتقون" />
-		<aya index="178" text="يا أيها الذين آمنوا كتب عليكم القصاص في القتلى الحر بالحر والعبد بالعبد والأنثى بالأنثى فمن عفي له من أخيه شيء فاتباع بالمعروف وأداء إليه بإحسان ذلك تخفيف من ربكم ورحمة فمن اعتدى بعد ذلك فله عذاب أليم" />
-		<aya index="179" text="ولكم في القصاص حياة يا أولي الألباب لعلكم تتقون" />
-		<aya index="180" text="كتب عليكم إذا حضر أحدكم الموت إن ترك خيرا الوصية للوالدين والأقربين بالمعروف حقا على المتقين" />
-		<aya index="181" text="فمن بدله بعدما سمعه فإنما إثمه على الذين يبدلونه إن الله سميع عليم" />
-		<aya index="182" text="فمن خاف من موص جنفا أو إثما فأصلح بينهم فلا إثم عليه إن الله غفور رحيم" />
-		<aya index="183" text="يا أيها الذين آمنوا كتب عليكم الصيام كما كتب على الذين من قبلكم لعلكم تتقون" />
-		<aya index="184" text="أياما معدودات فمن كان منكم مريضا أو على سفر فعدة من أيام أخر وعلى الذين يطيقونه فدية طعام مسكين فمن تطوع خيرا فهو خير له وأن تصوموا خير لكم إن كنتم تعلمون" />
-		<aya index="185" text="شهر رمضان الذي أنزل فيه القرآن هدى للناس وبينات من الهدى والفرقان فمن شهد منكم الشهر فليصمه ومن كان مريضا أو على سفر فعدة من أيام أخر يريد الله بكم اليسر ولا يريد بكم العسر ولتكملوا العدة ولتكبروا الله على ما هداكم ولعلكم تشكرون" />
-		<aya index="186" text="وإذا سألك عبادي عني فإني قريب أجيب دعوة الداع إذا دعان فليستجيبوا لي وليؤمنوا بي لعلهم يرشدون" />
-		<aya index="187" text="أحل لكم ليلة الصيام الرفث إلى نسائكم هن لباس لكم وأنتم لباس لهن علم الله أنكم كنتم تختانون أنفسكم فتاب عليكم وعفا عنكم فالآن باشروهن وابتغوا ما كتب الله لكم وكلوا واشربوا حتى يتبين لكم الخيط الأبيض من الخيط الأسود من الفجر ثم أتموا الصيام إلى الليل ولا تباشروهن وأنتم عاكفون في المساجد تلك حدود الله فلا تقربوها كذلك يبين الله آياته للناس لعلهم يتقون" />
-		<aya index="188" text="ولا تأكلوا أموالكم بينكم بالباطل وتدلوا بها إلى الحكام لتأكلوا فريقا من أموال الناس بالإثم وأنتم تعلمون" />
-		<aya index="189" text="يسألونك عن الأهلة قل هي مواقيت للناس والحج وليس البر بأن تأتوا البيوت من ظهورها ولكن البر من اتقى وأتوا البيوت من أبوابها واتقوا الله لعلكم تفلحون" />
-		<aya index="190" text="وقاتلوا في سبيل الله الذين يقاتلونكم ولا تعتدوا إن الله لا يحب المعتدين" />
-		<aya index="191" text="واقتلوهم حيث ثقفتموهم وأخرجوهم من حيث أخرجوكم والفتنة أشد من القتل ولا تقاتلوهم عند المسجد الحرام حتى يقاتلوكم فيه فإن قاتلوكم فاقتلوهم كذلك جزاء الكافرين" />
-		<aya index="192" text="فإن انتهوا فإن الله غفور رحيم" />
-		<aya index="193" text="وقاتلوهم حتى لا تكون فتنة ويكون الدين لله فإن انتهوا فلا عدوان إلا على الظالمين" />
-		<aya index="194" text="الشهر الحرام بالشهر الحرام والحرمات قصاص فمن اعتدى عليكم فاعتدوا عليه بمثل ما اعتدى عليكم واتقوا الله واعلموا أن الله مع المتقين" />
-		<aya index="195" text="وأنفقوا في سبيل الله ولا تلقوا بأيديكم إلى التهلكة وأحسنوا إن الله يحب المحسنين" />
-		<aya index="196" text="وأتموا الحج والعمرة لله فإن أحصرتم فما استيسر من الهدي ولا تحلقوا رءوسكم حتى يبلغ الهدي محله فمن كان منكم مريضا أو به أذى من رأسه ففدية من صيام أو صدقة أو نسك فإذا أمنتم فمن تمتع بالعمرة إلى الحج فما استيسر من الهدي فمن لم يجد فصيام ثلاثة أيام في الحج وسبعة إذا رجعتم تلك عشرة كاملة ذلك لمن لم يكن أهله حاضري المسجد الحرام واتقوا الله واعلموا أن الله شديد العقاب" />
-		<aya index="197" text="الحج أشهر معلومات فمن فرض فيهن الحج فلا رفث ولا فسوق ولا جدال في الحج وما تفعلوا من خير يعلمه الله وتزودوا فإن خير الزاد التقوى واتقون يا أولي الألباب" />
-		<aya index="198" text="ليس عليكم جناح أن تبتغوا فضلا من ربكم فإذا أفضتم من عرفات فاذكروا الله عند المشعر الحرام واذكروه كما هداكم وإن كنتم من قبله لمن الضالين" />
-		<aya index="199" text="ثم أفيضوا من حيث أفاض الناس واستغفروا الله إن الله غفور رحيم" />
-		<aya index="200" text="فإذا قضيتم مناسككم فاذكروا الله كذكركم آباءكم أو أشد ذكرا فمن الناس من يقول ربنا آتنا في الدنيا وما له في الآخرة من خلاق" />
-		<aya index="201" text="ومنهم من يقول ربنا آتنا في الدنيا حسنة وفي الآخرة حسنة وقنا عذاب النار" />
-		<aya index="202" text="أولئك لهم نصيب مما كسبوا والله سريع الحساب" />
-		<aya index="203" text="واذكروا الله في أيام معدودات فمن تعجل في يومين فلا إثم عليه ومن تأخر فلا إثم عليه لمن اتقى واتقوا الله واعلموا أنكم إليه تحشرون" />
-		<aya index="204" text="ومن الناس من يعجبك قوله في الحياة الدنيا ويشهد الله على ما في قلبه وهو ألد الخصام" />
-		<aya index="205" text="وإذا تولى سعى في الأرض ليفسد فيها ويهلك الحرث والنسل والله لا يحب الفساد" />
-		<aya index="206" text="وإذا قيل له اتق الله أخذته العزة بالإثم فحسبه جهنم ولبئس المهاد" />
-		<aya index="207" text="ومن الناس من يشري نفسه ابتغاء مرضات الله والله رءوف بالعباد" />
-		<aya index="208" text="يا أيها الذين آمنوا ادخلوا في السلم كافة ولا تتبعوا خطوات الشيطان إنه لكم عدو مبين" />
-		<aya index="209" text="فإن زللتم من بعد ما جاءتكم البينات فاعلموا أن الله عزيز حكيم" />
-		<aya index="210" text="هل ينظرون إلا أن يأتيهم الله في ظلل من الغمام والملائكة وقضي الأمر وإلى الله ترجع الأمور" />
-		<aya index="211" text="سل بني إسرائيل كم آتيناهم من آية بينة ومن يبدل نعمة الله من بعد ما جاءته فإن الله شديد العقاب" />
-		<aya index="212" text="زين للذين كفروا الحياة الدنيا ويسخرون من الذين آمنوا والذين اتقوا فوقهم يوم القيامة والله يرزق من يشاء بغير حساب" />
-		<aya index="213" text="كان الناس أمة واحدة فبعث الله النبيين مبشرين ومنذرين وأنزل معهم الكتاب بالحق ليحكم بين الناس فيما اختلفوا فيه وما اختلف فيه إلا الذين أوتوه من بعد ما جاءتهم البينات بغيا بينهم فهدى الله الذين آمنوا لما اختلفوا فيه من الحق بإذنه والله يهدي من يشاء إلى صراط مستقيم" />
-		<aya index="214" text="أم حسبتم أن تدخلوا الجنة ولما يأتكم مثل الذين خلوا من قبلكم مستهم البأساء والضراء وزلزلوا حتى يقول الرسول والذين آمنوا معه متى نصر الله ألا إن نصر الله قريب" />
-		<aya index="215" text="يسألونك ماذا ينفقون قل ما أنفقتم من خير فللوالدين والأقربين واليتامى والمساكين وابن السبيل وما تفعلوا من خير فإن الله به عليم" />
-		<aya index="216" text="كتب عليكم القتال وهو كره لكم وعسى أن تكرهوا شيئا وهو خير لكم وعسى أن تحبوا شيئا وهو شر لكم والله يعلم وأنتم لا تعلمون" />
-		<aya index="217" text="يسألونك عن الشهر الحرام قتال فيه قل قتال فيه كبير وصد عن سبيل الله وكفر به والمسجد الحرام وإخراج أهله منه أكبر عند الله والفتنة أكبر من القتل ولا يزالون يقاتلونكم حتى يردوكم عن دينكم إن استطاعوا ومن يرتدد منكم عن دينه فيمت وهو كافر فأولئك حبطت أعمالهم في الدنيا والآخرة وأولئك أصحاب النار هم فيها خالدون" />
-		<aya index="218" text="إن الذين آمنوا والذين هاجروا وجاهدوا في سبيل الله أولئك يرجون رحمت الله والله غفور رحيم" />
-		<aya index="219" text="يسألونك عن الخمر والميسر قل فيهما إثم كبير ومنافع للناس وإثمهما أكبر من نفعهما ويسألونك ماذا ينفقون قل العفو كذلك يبين الله لكم الآيات لعلكم تتفكرون" />
-		<aya index="220" text="في الدنيا والآخرة ويسألونك عن اليتامى قل إصلاح لهم خير وإن تخالطوهم فإخوانكم والله يعلم المفسد من المصلح ولو شاء الله لأعنتكم إن الله عزيز حكيم" />
-		<aya index="221" text="ولا تنكحوا المشركات حتى يؤمن ولأمة مؤمنة خير من مشركة ولو أعجبتكم ولا تنكحوا المشركين حتى يؤمنوا ولعبد مؤمن خير من مشرك ولو أعجبكم أولئك يدعون إلى النار والله يدعو إلى الجنة والمغفرة بإذنه ويبين آياته للناس لعلهم يتذكرون" />
-		<aya index="222" text="ويسألونك عن المحيض قل هو أذى فاعتزلوا النساء في المحيض ولا تقربوهن حتى يطهرن فإذا تطهرن فأتوهن من حيث أمركم الله إن الله يحب التوابين ويحب المتطهرين" />
-		<aya index="223" text="نساؤكم حرث لكم فأتوا حرثكم أنى شئتم وقدموا لأنفسكم واتقوا الله واعلموا أنكم ملاقوه وبشر المؤمنين" />
-		<aya index="224" text="ولا تجعلوا الله عرضة لأيمانكم أن تبروا وتتقوا وتصلحوا بين الناس والله سميع عليم" />
-		<aya index="225" text="لا يؤاخذكم الله باللغو في أيمانكم ولكن يؤاخذكم بما كسبت قلوبكم والله غفور حليم" />
-		<aya index="226" text="للذين يؤلون من نسائهم تربص أربعة أشهر فإن فاءوا فإن الله غفور رحيم" />
-		<aya index="227" text="وإن عزموا الطلاق فإن الله سميع عليم" />
-		<aya index="228" text="والمطلقات يتربصن بأنفسهن ثلاثة قروء ولا يحل لهن أن يكتمن ما خلق الله في أرحامهن إن كن يؤمن بالله واليوم الآخر وبعولتهن أحق بردهن في ذلك إن أرادوا إصلاحا ولهن مثل الذي عليهن بالمعروف وللرجال عليهن درجة والله عزيز حكيم" />
-		<aya index="229" text="الطلاق مرتان فإمساك بمعروف أو تسريح بإحسان ولا يحل لكم أن تأخذوا مما آتيتموهن شيئا إلا أن يخافا ألا يقيما حدود الله فإن خفتم ألا يقيما حدود الله فلا جناح عليهما فيما افتدت به تلك حدود الله فلا تعتدوها ومن يتعد حدود الله فأولئك هم الظالمون" />
-		<aya index="230" text="فإن طلقها فلا تحل له من بعد حتى تنكح زوجا غيره فإن طلقها فلا جناح عليهما أن يتراجعا إن ظنا أن يقيما حدود الله وتلك حدود الله يبينها لقوم يعلمون" />
-		<aya index="231" text="وإذا طلقتم النساء فبلغن أجلهن فأمسكوهن بمعروف أو سرحوهن بمعروف ولا تمسكوهن ضرارا لتعتدوا ومن يفعل ذلك فقد ظلم نفسه ولا تتخذوا آيات الله هزوا واذكروا نعمت الله عليكم وما أنزل عليكم من الكتاب والحكمة يعظكم به واتقوا الله واعلموا أن الله بكل شيء عليم" />
-		<aya index="232" text="وإذا طلقتم النساء فبلغن أجلهن فلا تعضلوهن أن ينكحن أزواجهن إذا تراضوا بينهم بالمعروف ذلك يوعظ به من كان منكم يؤمن بالله واليوم الآخر ذلكم أزكى لكم وأطهر والله يعلم وأنتم لا تعلمون" />
-		<aya index="233" text="والوالدات يرضعن أولادهن حولين كاملين لمن أراد أن يتم الرضاعة وعلى المولود له رزقهن وكسوتهن بالمعروف لا تكلف نفس إلا وسعها لا تضار والدة بولدها ولا مولود له بولده وعلى الوارث مثل ذلك فإن أرادا فصالا عن تراض منهما وتشاور فلا جناح عليهما وإن أردتم أن تسترضعوا أولادكم فلا جناح عليكم إذا سلمتم ما آتيتم بالمعروف واتقوا الله واعلموا أن الله بما تعملون بصير" />
-		<aya index="234" text="والذين يتوفون منكم ويذرون أزواجا يتربصن بأنفسهن أربعة أشهر وعشرا فإذا بلغن أجلهن فلا جناح عليكم فيما فعلن في أنفسهن بالمعروف والله بما تعملون خبير" />
-		<aya index="235" text="ولا جناح عليكم فيما عرضتم به من خطبة النساء أو أكننتم في أنفسكم علم الله أنكم ستذكرونهن ولكن لا تواعدوهن سرا إلا أن تقولوا قولا معروفا ولا تعزموا عقدة النكاح حتى يبلغ الكتاب أجله واعلموا أن الله يعلم ما في أنفسكم فاحذروه واعلموا أن الله غفور حليم" />
-		<aya index="236" text="لا جناح عليكم إن طلقتم النساء ما لم تمسوهن أو تفرضوا لهن فريضة ومتعوهن على الموسع قدره وعلى المقتر قدره متاعا بالمعروف حقا على المحسنين" />
-		<aya index="237" text="وإن طلقتموهن من قبل أن تمسوهن وقد فرضتم لهن فريضة فنصف ما فرضتم إلا أن يعفون أو يعفو الذي بيده عقدة النكاح وأن تعفوا أقرب للتقوى ولا تنسوا الفضل بينكم إن الله بما تعملون بصير" />
-		<aya index="238" text="حافظوا على الصلوات والصلاة الوسطى وقوموا لله قانتين" />
-		<aya index="239" text="فإن خفتم فرجالا أو ركبانا فإذا أمنتم فاذكروا الله كما علمكم ما لم تكونوا تعلمون" />
-		<aya index="240" text="والذين يتوفون منكم ويذرون أزواجا وصية لأزواجهم متاعا إلى الحول غير إخراج فإن خرجن فلا جناح عليكم في ما فعلن في أنفسهن من معروف والله عزيز حكيم" />
-		<aya index="241" text="وللمطلقات متاع بالمعروف حقا على المتقين" />
-		<aya index="242" text="كذلك يبين الله لكم آياته لعلكم تعقلون" />
-		<aya index="243" text="ألم تر إلى الذين خرجوا من ديارهم وهم ألوف حذر الموت فقال لهم الله موتوا ثم أحياهم إن الله لذو فضل على الناس ولكن أكثر الناس لا يشكرون" />
-		<aya index="244" text="وقاتلوا في سبيل الله واعلموا أن الله سميع عليم" />
-		<aya index="245" text="من ذا الذي يقرض الله قرضا حسنا فيضاعفه له أضعافا كثيرة والله يقبض ويبسط وإليه ترجعون" />
-		<aya index="246" text="ألم تر إلى الملإ من بني إسرائيل من بعد موسى إذ قالوا لنبي لهم ابعث لنا ملكا نقاتل في سبيل الله قال هل عسيتم إن كتب عليكم القتال ألا تقاتلوا قالوا وما لنا ألا نقاتل في سبيل الله وقد أخرجنا من ديارنا وأبنائنا فلما كتب عليهم القتال تولوا إلا قليلا منهم والله عليم بالظالمين" />
-		<aya index="247" text="وقال لهم نبيهم إن الله قد بعث لكم طالوت ملكا قالوا أنى يكون له الملك علينا ونحن أحق بالملك منه ولم يؤت سعة من المال قال إن الله اصطفاه عليكم وزاده بسطة في العلم والجسم والله يؤتي ملكه من يشاء والله واسع عليم" />
-		<aya index="248" text="وقال لهم نبيهم إن آية ملكه أن يأتيكم التابوت فيه سكينة من ربكم وبقية مما ترك آل موسى وآل هارون تحمله الملائكة إن في ذلك لآية لكم إن كنتم مؤمنين" />
-		<aya index="249" text="فلما فصل طالوت بالجنود قال إن الله مبتليكم بنهر فمن شرب منه فليس مني ومن لم يطعمه فإنه مني إلا من اغترف غرفة بيده فشربوا منه إلا قليلا منهم فلما جاوزه هو والذين آمنوا معه قالوا لا طاقة لنا اليوم بجالوت وجنوده قال الذين يظنون أنهم ملاقو الله كم من فئة قليلة غلبت فئة كثيرة بإذن الله والله مع الصابرين" />
-		<aya index="250" text="ولما برزوا لجالوت وجنوده قالوا ربنا أفرغ علينا صبرا وثبت أقدامنا وانصرنا على القوم الكافرين" />
-		<aya index="251" text="فهزموهم بإذن الله وقتل داوود جالوت وآتاه الله الملك والحكمة وعلمه مما يشاء ولولا دفع الله الناس بعضهم ببعض لفسدت الأرض ولكن الله ذو فضل على العالمين" />
-		<aya index="252" text="تلك آيات الله نتلوها عليك بالحق وإنك لمن المرسلين" />
-		<aya index="253" text="تلك الرسل فضلنا بعضهم على بعض منهم من كلم الله ورفع بعضهم درجات وآتينا عيسى ابن مريم البينات وأيدناه بروح القدس ولو شاء الله ما اقتتل الذين من بعدهم من بعد ما جاءتهم البينات ولكن اختلفوا فمنهم من آمن ومنهم من كفر ولو شاء الله ما اقتتلوا ولكن الله يفعل ما يريد" />
-		<aya index="254" text="يا أيها الذين آمنوا أنفقوا مما رزقناكم من قبل أن يأتي يوم لا بيع فيه ولا خلة ولا شفاعة والكافرون هم الظالمون" />
-		<aya index="255" text="الله لا إله إلا هو الحي القيوم لا تأخذه سنة ولا نوم له ما في السماوات وما في الأرض من ذا الذي يشفع عنده إلا بإذنه يعلم ما بين أيديهم وما خلفهم ولا يحيطون بشيء من علمه إلا بما شاء وسع كرسيه السماوات والأرض ولا يئوده حفظهما وهو العلي العظيم" />
-		<aya index="256" text="لا إكراه في الدين قد تبين الرشد من الغي فمن يكفر بالطاغوت ويؤمن بالله فقد استمسك بالعروة الوثقى لا انفصام لها والله سميع عليم" />
-		<aya index="257" text="الله ولي الذين آمنوا يخرجهم من الظلمات إلى النور والذين كفروا أولياؤهم الطاغوت يخرجونهم من النور إلى الظلمات أولئك أصحاب النار هم فيها خالدون" />
-		<aya index="258" text="ألم تر إلى الذي حاج إبراهيم في ربه أن آتاه الله الملك إذ قال إبراهيم ربي الذي يحيي ويميت قال أنا أحيي وأميت قال إبراهيم فإن الله يأتي بالشمس من المشرق فأت بها من المغرب فبهت الذي كفر والله لا يهدي القوم الظالمين" />
-		<aya index="259" text="أو كالذي مر على قرية وهي خاوية على عروشها قال أنى يحيي هذه الله بعد موتها فأماته الله مائة عام ثم بعثه قال كم لبثت قال لبثت يوما أو بعض يوم قال بل لبثت مائة عام فانظر إلى طعامك وشرابك لم يتسنه وانظر إلى حمارك ولنجعلك آية للناس وانظر إلى العظام كيف ننشزها ثم نكسوها لحما فلما تبين له قال أعلم أن الله على كل شيء قدير" />
-		<aya index="260" text="وإذ قال إبراهيم رب أرني كيف تحيي الموتى قال أولم تؤمن قال بلى ولكن ليطمئن قلبي قال فخذ أربعة من الطير فصرهن إليك ثم اجعل على كل جبل منهن جزءا ثم ادعهن يأتينك سعيا واعلم أن الله عزيز حكيم" />
-		<aya index="261" text="مثل الذين ينفقون أموالهم في سبيل الله كمثل حبة أنبتت سبع سنابل في كل سنبلة مائة حبة والله يضاعف لمن يشاء والله واسع عليم" />
-		<aya index="262" text="الذين ينفقون أموالهم في سبيل الله ثم لا يتبعون ما أنفقوا منا ولا أذى لهم أجرهم عند ربهم ولا خوف عليهم ولا هم يحزنون" />
-		<aya index="263" text="قول معروف ومغفرة خير من صدقة يتبعها أذى والله غني حليم" />
-		<aya index="264" text="يا أيها الذين آمنوا لا تبطلوا صدقاتكم بالمن والأذى كالذي ينفق ماله رئاء الناس ولا يؤمن بالله واليوم الآخر فمثله كمثل صفوان عليه تراب فأصابه وابل فتركه صلدا لا يقدرون على شيء مما كسبوا والله لا يهدي القوم الكافرين" />
-		<aya index="265" text="ومثل الذين ينفقون أموالهم ابتغاء مرضات الله وتثبيتا من أنفسهم كمثل جنة بربوة أصابها وابل فآتت أكلها ضعفين فإن لم يصبها وابل فطل والله بما تعملون بصير" />
-		<aya index="266" text="أيود أحدكم أن تكون له جنة من نخيل وأعناب تجري من تحتها الأنهار له فيها من كل الثمرات وأصابه الكبر وله ذرية ضعفاء فأصابها إعصار فيه نار فاحترقت كذلك يبين الله لكم الآيات لعلكم تتفكرون" />
-		<aya index="267" text="يا أيها الذين آمنوا أنفقوا من طيبات ما كسبتم ومما أخرجنا لكم من الأرض ولا تيمموا الخبيث منه تنفقون ولستم بآخذيه إلا أن تغمضوا فيه واعلموا أن الله غني حميد" />
-		<aya index="268" text="الشيطان يعدكم الفقر ويأمركم بالفحشاء والله يعدكم مغفرة منه وفضلا والله واسع عليم" />
-		<aya index="269" text="يؤتي الحكمة من يشاء ومن يؤت الحكمة فقد أوتي خيرا كثيرا وما يذكر إلا أولو الألباب" />
-		<aya index="270" text="وما أنفقتم من نفقة أو نذرتم من نذر فإن الله يعلمه وما للظالمين من أنصار" />
-		<aya index="271" text="إن تبدوا الصدقات فنعما هي وإن تخفوها وتؤتوها الفقراء فهو خير لكم ويكفر عنكم من سيئاتكم والله بما تعملون خبير" />
-		<aya index="272" text="ليس عليك هداهم ولكن الله يهدي من يشاء وما تنفقوا من خير فلأنفسكم وما تنفقون إلا ابتغاء وجه الله وما تنفقوا من خير يوف إليكم وأنتم لا تظلمون" />
-		<aya index="273" text="للفقراء الذين أحصروا في سبيل الله لا يستطيعون ضربا في الأرض يحسبهم الجاهل أغنياء من التعفف تعرفهم بسيماهم لا يسألون الناس إلحافا وما تنفقوا من خير فإن الله به عليم" />
-		<aya index="274" text="الذين ينفقون أموالهم بالليل والنهار سرا وعلانية فلهم أجرهم عند ربهم ولا خوف عليهم ولا هم يحزنون" />
-		<aya index="275" text="الذين يأكلون الربا لا يقومون إلا كما يقوم الذي يتخبطه الشيطان من المس ذلك بأنهم قالوا إنما البيع مثل الربا وأحل الله البيع وحرم الربا فمن جاءه موعظة من ربه فانتهى فله ما سلف وأمره إلى الله ومن عاد فأولئك أصحاب النار هم فيها خالدون" />
-		<aya index="276" text="يمحق الله الربا ويربي الصدقات والله لا يحب كل كفار أثيم" />
-		<aya index="277" text="إن الذين آمنوا وعملوا الصالحات وأقاموا الصلاة وآتوا الزكاة لهم أجرهم عند ربهم ولا خوف عليهم ولا هم يحزنون" />
-		<aya index="278" text="يا أيها الذين آمنوا اتقوا الله وذروا ما بقي من الربا إن كنتم مؤمنين" />
-		<aya index="279" text="فإن لم تفعلوا فأذنوا بحرب من الله ورسوله وإن تبتم فلكم رءوس أموالكم لا تظلمون ولا تظلمون" />
-		<aya index="280" text="وإن كان ذو عسرة فنظرة إلى ميسرة وأن تصدقوا خير لكم إن كنتم تعلمون" />
-		<aya index="281" text="واتقوا يوما ترجعون فيه إلى الله ثم توفى كل نفس ما كسبت وهم لا يظلمون" />
-		<aya index="282" text="يا أيها الذين آمنوا إذا تداينتم بدين إلى أجل مسمى فاكتبوه وليكتب بينكم كاتب بالعدل ولا يأب كاتب أن يكتب كما علمه الله فليكتب وليملل الذي عليه الحق وليتق الله ربه ولا يبخس منه شيئا فإن كان الذي عليه الحق سفيها أو ضعيفا أو لا يستطيع أن يمل هو فليملل وليه بالعدل واستشهدوا شهيدين من رجالكم فإن لم يكونا رجلين فرجل وامرأتان ممن ترضون من الشهداء أن تضل إحداهما فتذكر إحداهما الأخرى ولا يأب الشهداء إذا ما دعوا ولا تسأموا أن تكتبوه صغيرا أو كبيرا إلى أجله ذلكم أقسط عند الله وأقوم للشهادة وأدنى ألا ترتابوا إلا أن تكون تجارة حاضرة تديرونها بينكم فليس عليكم جناح ألا تكتبوها وأشهدوا إذا تبايعتم ولا يضار كاتب ولا شهيد وإن تفعلوا فإنه فسوق بكم واتقوا الله ويعلمكم الله والله بكل شيء عليم" />
-		<aya index="283" text="وإن كنتم على سفر ولم تجدوا كاتبا فرهان مقبوضة فإن أمن بعضكم بعضا فليؤد الذي اؤتمن أمانته وليتق الله ربه ولا تكتموا الشهادة ومن يكتمها فإنه آثم قلبه والله بما تعملون عليم" />
-		<aya index="284" text="لله ما في السماوات وما في الأرض وإن تبدوا ما في أنفسكم أو تخفوه يحاسبكم به الله فيغفر لمن يشاء ويعذب من يشاء والله على كل شيء قدير" />
-		<aya index="285" text="آمن الرسول بما أنزل إليه من ربه والمؤمنون كل آمن بالله وملائكته وكتبه ورسله لا نفرق بين أحد من رسله وقالوا سمعنا وأطعنا غفرانك ربنا وإليك المصير" />
-		<aya index="286" text="لا يكلف الله نفسا إلا وسعها لها ما كسبت وعليها ما اكتسبت ربنا لا تؤاخذنا إن نسينا أو أخطأنا ربنا ولا تحمل علينا إصرا كما حملته على الذين من قبلنا ربنا ولا تحملنا ما لا طاقة لنا به واعف عنا واغفر لنا وارحمنا أنت مولانا فانصرنا على القوم الكافرين" />
-	</sura>
-	<sura index="3" name="آل عمران">
-		<aya index="1" text="الم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الله لا إله إلا هو الحي القيوم" />
-		<aya index="3" text="نزل عليك الكتاب بالحق مصدقا لما بين يديه وأنزل التوراة والإنجيل" />
-		<aya index="4" text="من قبل هدى للناس وأنزل الفرقان إن الذين كفروا بآيات الله لهم عذاب شديد والله عزيز ذو انتقام" />
-		<aya index="5" text="إن الله لا يخفى عليه شيء في الأرض ولا في السماء" />
-		<aya index="6" text="هو الذي يصوركم في الأرحام كيف يشاء لا إله إلا هو العزيز الحكيم" />
-		<aya index="7" text="هو الذي أنزل عليك الكتاب منه آيات محكمات هن أم الكتاب وأخر متشابهات فأما الذين في قلوبهم زيغ فيتبعون ما تشابه منه ابتغاء الفتنة وابتغاء تأويله وما يعلم تأويله إلا الله والراسخون في العلم يقولون آمنا به كل من عند ربنا وما يذكر إلا أولو الألباب" />
-		<aya index="8" text="ربنا لا تزغ قلوبنا بعد إذ هديتنا وهب لنا من لدنك رحمة إنك أنت الوهاب" />
-		<aya index="9" text="ربنا إنك جامع الناس ليوم لا ريب فيه إن الله لا يخلف الميعاد" />
-		<aya index="10" text="إن الذين كفروا لن تغني عنهم أموالهم ولا أولادهم من الله شيئا وأولئك هم وقود النار" />
-		<aya index="11" text="كدأب آل فرعون والذين من قبلهم كذبوا بآياتنا فأخذهم الله بذنوبهم والله شديد العقاب" />
-		<aya index="12" text="قل للذين كفروا ستغلبون وتحشرون إلى جهنم وبئس المهاد" />
-		<aya index="13" text="قد كان لكم آية في فئتين التقتا فئة تقاتل في سبيل الله وأخرى كافرة يرونهم مثليهم رأي العين والله يؤيد بنصره من يشاء إن في ذلك لعبرة لأولي الأبصار" />
-		<aya index="14" text="زين للناس حب الشهوات من النساء والبنين والقناطير المقنطرة من الذهب والفضة والخيل المسومة والأنعام والحرث ذلك متاع الحياة الدنيا والله عنده حسن المآب" />
-		<aya index="15" text="قل أؤنبئكم بخير من ذلكم للذين اتقوا عند ربهم جنات تجري من تحتها الأنهار خالدين فيها وأزواج مطهرة ورضوان من الله والله بصير بالعباد" />
-		<aya index="16" text="الذين يقولون ربنا إننا آمنا فاغفر لنا ذنوبنا وقنا عذاب النار" />
-		<aya index="17" text="الصابرين والصادقين والقانتين والمنفقين والمستغفرين بالأسحار" />
-		<aya index="18" text="شهد الله أنه لا إله إلا هو والملائكة وأولو العلم قائما بالقسط لا إله إلا هو العزيز الحكيم" />
-		<aya index="19" text="إن الدين عند الله الإسلام وما اختلف الذين أوتوا الكتاب إلا من بعد ما جاءهم العلم بغيا بينهم ومن يكفر بآيات الله فإن الله سريع الحساب" />
-		<aya index="20" text="فإن حاجوك فقل أسلمت وجهي لله ومن اتبعن وقل للذين أوتوا الكتاب والأميين أأسلمتم فإن أسلموا فقد اهتدوا وإن تولوا فإنما عليك البلاغ والله بصير بالعباد" />
-		<aya index="21" text="إن الذين يكفرون بآيات الله ويقتلون النبيين بغير حق ويقتلون الذين يأمرون بالقسط من الناس فبشرهم بعذاب أليم" />
-		<aya index="22" text="أولئك الذين حبطت أعمالهم في الدنيا والآخرة وما لهم من ناصرين" />
-		<aya index="23" text="ألم تر إلى الذين أوتوا نصيبا من الكتاب يدعون إلى كتاب الله ليحكم بينهم ثم يتولى فريق منهم وهم معرضون" />
-		<aya index="24" text="ذلك بأنهم قالوا لن تمسنا النار إلا أياما معدودات وغرهم في دينهم ما كانوا يفترون" />
-		<aya index="25" text="فكيف إذا جمعناهم ليوم لا ريب فيه ووفيت كل نفس ما كسبت وهم لا يظلمون" />
-		<aya index="26" text="قل اللهم مالك الملك تؤتي الملك من تشاء وتنزع الملك ممن تشاء وتعز من تشاء وتذل من تشاء بيدك الخير إنك على كل شيء قدير" />
-		<aya index="27" text="تولج الليل في النهار وتولج النهار في الليل وتخرج الحي من الميت وتخرج الميت من الحي وترزق من تشاء بغير حساب" />
-		<aya index="28" text="لا يتخذ المؤمنون الكافرين أولياء من دون المؤمنين ومن يفعل ذلك فليس من الله في شيء إلا أن تتقوا منهم تقاة ويحذركم الله نفسه وإلى الله المصير" />
-		<aya index="29" text="قل إن تخفوا ما في صدوركم أو تبدوه يعلمه الله ويعلم ما في السماوات وما في الأرض والله على كل شيء قدير" />
-		<aya index="30" text="يوم تجد كل نفس ما عملت من خير محضرا وما عملت من سوء تود لو أن بينها وبينه أمدا بعيدا ويحذركم الله نفسه والله رءوف بالعباد" />
-		<aya index="31" text="قل إن كنتم تحبون الله فاتبعوني يحببكم الله ويغفر لكم ذنوبكم والله غفور رحيم" />
-		<aya index="32" text="قل أطيعوا الله والرسول فإن تولوا فإن الله لا يحب الكافرين" />
-		<aya index="33" text="إن الله اصطفى آدم ونوحا وآل إبراهيم وآل عمران على العالمين" />
-		<aya index="34" text="ذرية بعضها من بعض والله سميع عليم" />
-		<aya index="35" text="إذ قالت امرأت عمران رب إني نذرت لك ما في بطني محررا فتقبل مني إنك أنت السميع العليم" />
-		<aya index="36" text="فلما وضعتها قالت رب إني وضعتها أنثى والله أعلم بما وضعت وليس الذكر كالأنثى وإني سميتها مريم وإني أعيذها بك وذريتها من الشيطان الرجيم" />
-		<aya index="37" text="فتقبلها ربها بقبول حسن وأنبتها نباتا حسنا وكفلها زكريا كلما دخل عليها زكريا المحراب وجد عندها رزقا قال يا مريم أنى لك هذا قالت هو من عند الله إن الله يرزق من يشاء بغير حساب" />
-		<aya index="38" text="هنالك دعا زكريا ربه قال رب هب لي من لدنك ذرية طيبة إنك سميع الدعاء" />
-		<aya index="39" text="فنادته الملائكة وهو قائم يصلي في المحراب أن الله يبشرك بيحيى مصدقا بكلمة من الله وسيدا وحصورا ونبيا من الصالحين" />
-		<aya index="40" text="قال رب أنى يكون لي غلام وقد بلغني الكبر وامرأتي عاقر قال كذلك الله يفعل ما يشاء" />
-		<aya index="41" text="قال رب اجعل لي آية قال آيتك ألا تكلم الناس ثلاثة أيام إلا رمزا واذكر ربك كثيرا وسبح بالعشي والإبكار" />
-		<aya index="42" text="وإذ قالت الملائكة يا مريم إن الله اصطفاك وطهرك واصطفاك على نساء العالمين" />
-		<aya index="43" text="يا مريم اقنتي لربك واسجدي واركعي مع الراكعين" />
-		<aya index="44" text="ذلك من أنباء الغيب نوحيه إليك وما كنت لديهم إذ يلقون أقلامهم أيهم يكفل مريم وما كنت لديهم إذ يختصمون" />
-		<aya index="45" text="إذ قالت الملائكة يا مريم إن الله يبشرك بكلمة منه اسمه المسيح عيسى ابن مريم وجيها في الدنيا والآخرة ومن المقربين" />
-		<aya index="46" text="ويكلم الناس في المهد وكهلا ومن الصالحين" />
-		<aya index="47" text="قالت رب أنى يكون لي ولد ولم يمسسني بشر قال كذلك الله يخلق ما يشاء إذا قضى أمرا فإنما يقول له كن فيكون" />
-		<aya index="48" text="ويعلمه الكتاب والحكمة والتوراة والإنجيل" />
-		<aya index="49" text="ورسولا إلى بني إسرائيل أني قد جئتكم بآية من ربكم أني أخلق لكم من الطين كهيئة الطير فأنفخ فيه فيكون طيرا بإذن الله وأبرئ الأكمه والأبرص وأحيي الموتى بإذن الله وأنبئكم بما تأكلون وما تدخرون في بيوتكم إن في ذلك لآية لكم إن كنتم مؤمنين" />
-		<aya index="50" text="ومصدقا لما بين يدي من التوراة ولأحل لكم بعض الذي حرم عليكم وجئتكم بآية من ربكم فاتقوا الله وأطيعون" />
-		<aya index="51" text="إن الله ربي وربكم فاعبدوه هذا صراط مستقيم" />
-		<aya index="52" text="فلما أحس عيسى منهم الكفر قال من أنصاري إلى الله قال الحواريون نحن أنصار الله آمنا بالله واشهد بأنا مسلمون" />
-		<aya index="53" text="ربنا آمنا بما أنزلت واتبعنا الرسول فاكتبنا مع الشاهدين" />
-		<aya index="54" text="ومكروا ومكر الله والله خير الماكرين" />
-		<aya index="55" text="إذ قال الله يا عيسى إني متوفيك ورافعك إلي ومطهرك من الذين كفروا وجاعل الذين اتبعوك فوق الذين كفروا إلى يوم القيامة ثم إلي مرجعكم فأحكم بينكم فيما كنتم فيه تختلفون" />
-		<aya index="56" text="فأما الذين كفروا فأعذبهم عذابا شديدا في الدنيا والآخرة وما لهم من ناصرين" />
-		<aya index="57" text="وأما الذين آمنوا وعملوا الصالحات فيوفيهم أجورهم والله لا يحب الظالمين" />
-		<aya index="58" text="ذلك نتلوه عليك من الآيات والذكر الحكيم" />
-		<aya index="59" text="إن مثل عيسى عند الله كمثل آدم خلقه من تراب ثم قال له كن فيكون" />
-		<aya index="60" text="الحق من ربك فلا تكن من الممترين" />
-		<aya index="61" text="فمن حاجك فيه من بعد ما جاءك من العلم فقل تعالوا ندع أبناءنا وأبناءكم ونساءنا ونساءكم وأنفسنا وأنفسكم ثم نبتهل فنجعل لعنت الله على الكاذبين" />
-		<aya index="62" text="إن هذا لهو القصص الحق وما من إله إلا الله وإن الله لهو العزيز الحكيم" />
-		<aya index="63" text="فإن تولوا فإن الله عليم بالمفسدين" />
-		<aya index="64" text="قل يا أهل الكتاب تعالوا إلى كلمة سواء بيننا وبينكم ألا نعبد إلا الله ولا نشرك به شيئا ولا يتخذ بعضنا بعضا أربابا من دون الله فإن تولوا فقولوا اشهدوا بأنا مسلمون" />
-		<aya index="65" text="يا أهل الكتاب لم تحاجون في إبراهيم وما أنزلت التوراة والإنجيل إلا من بعده أفلا تعقلون" />
-		<aya index="66" text="ها أنتم هؤلاء حاججتم فيما لكم به علم فلم تحاجون فيما ليس لكم به علم والله يعلم وأنتم لا تعلمون" />
-		<aya index="67" text="ما كان إبراهيم يهوديا ولا نصرانيا ولكن كان حنيفا مسلما وما كان من المشركين" />
-		<aya index="68" text="إن أولى الناس بإبراهيم للذين اتبعوه وهذا النبي والذين آمنوا والله ولي المؤمنين" />
-		<aya index="69" text="ودت طائفة من أهل الكتاب لو يضلونكم وما يضلون إلا أنفسهم وما يشعرون" />
-		<aya index="70" text="يا أهل الكتاب لم تكفرون بآيات الله وأنتم تشهدون" />
-		<aya index="71" text="يا أهل الكتاب لم تلبسون الحق بالباطل وتكتمون الحق وأنتم تعلمون" />
-		<aya index="72" text="وقالت طائفة من أهل الكتاب آمنوا بالذي أنزل على الذين آمنوا وجه النهار واكفروا آخره لعلهم يرجعون" />
-		<aya index="73" text="ولا تؤمنوا إلا لمن تبع دينكم قل إن الهدى هدى الله أن يؤتى أحد مثل ما أوتيتم أو يحاجوكم عند ربكم قل إن الفضل بيد الله يؤتيه من يشاء والله واسع عليم" />
-		<aya index="74" text="يختص برحمته من يشاء والله ذو الفضل العظيم" />
-		<aya index="75" text="ومن أهل الكتاب من إن تأمنه بقنطار يؤده إليك ومنهم من إن تأمنه بدينار لا يؤده إليك إلا ما دمت عليه قائما ذلك بأنهم قالوا ليس علينا في الأميين سبيل ويقولون على الله الكذب وهم يعلمون" />
-		<aya index="76" text="بلى من أوفى بعهده واتقى فإن الله يحب المتقين" />
-		<aya index="77" text="إن الذين يشترون بعهد الله وأيمانهم ثمنا قليلا أولئك لا خلاق لهم في الآخرة ولا يكلمهم الله ولا ينظر إليهم يوم القيامة ولا يزكيهم ولهم عذاب أليم" />
-		<aya index="78" text="وإن منهم لفريقا يلوون ألسنتهم بالكتاب لتحسبوه من الكتاب وما هو من الكتاب ويقولون هو من عند الله وما هو من عند الله ويقولون على الله الكذب وهم يعلمون" />
-		<aya index="79" text="ما كان لبشر أن يؤتيه الله الكتاب والحكم والنبوة ثم يقول للناس كونوا عبادا لي من دون الله ولكن كونوا ربانيين بما كنتم تعلمون الكتاب وبما كنتم تدرسون" />
-		<aya index="80" text="ولا يأمركم أن تتخذوا الملائكة والنبيين أربابا أيأمركم بالكفر بعد إذ أنتم مسلمون" />
-		<aya index="81" text="وإذ أخذ الله ميثاق النبيين لما آتيتكم من كتاب وحكمة ثم جاءكم رسول مصدق لما معكم لتؤمنن به ولتنصرنه قال أأقررتم وأخذتم على ذلكم إصري قالوا أقررنا قال فاشهدوا وأنا معكم من الشاهدين" />
-		<aya index="82" text="فمن تولى بعد ذلك فأولئك هم الفاسقون" />
-		<aya index="83" text="أفغير دين الله يبغون وله أسلم من في السماوات والأرض طوعا وكرها وإليه يرجعون" />
-		<aya index="84" text="قل آمنا بالله وما أنزل علينا وما أنزل على إبراهيم وإسماعيل وإسحاق ويعقوب والأسباط وما أوتي موسى وعيسى والنبيون من ربهم لا نفرق بين أحد منهم ونحن له مسلمون" />
-		<aya index="85" text="ومن يبتغ غير الإسلام دينا فلن يقبل منه وهو في الآخرة من الخاسرين" />
-		<aya index="86" text="كيف يهدي الله قوما كفروا بعد إيمانهم وشهدوا أن الرسول حق وجاءهم البينات والله لا يهدي القوم الظالمين" />
-		<aya index="87" text="أولئك جزاؤهم أن عليهم لعنة الله والملائكة والناس أجمعين" />
-		<aya index="88" text="خالدين فيها لا يخفف عنهم العذاب ولا هم ينظرون" />
-		<aya index="89" text="إلا الذين تابوا من بعد ذلك وأصلحوا فإن الله غفور رحيم" />
-		<aya index="90" text="إن الذين كفروا بعد إيمانهم ثم ازدادوا كفرا لن تقبل توبتهم وأولئك هم الضالون" />
-		<aya index="91" text="إن الذين كفروا وماتوا وهم كفار فلن يقبل من أحدهم ملء الأرض ذهبا ولو افتدى به أولئك لهم عذاب أليم وما لهم من ناصرين" />
-		<aya index="92" text="لن تنالوا البر حتى تنفقوا مما تحبون وما تنفقوا من شيء فإن الله به عليم" />
-		<aya index="93" text="كل الطعام كان حلا لبني إسرائيل إلا ما حرم إسرائيل على نفسه من قبل أن تنزل التوراة قل فأتوا بالتوراة فاتلوها إن كنتم صادقين" />
-		<aya index="94" text="فمن افترى على الله الكذب من بعد ذلك فأولئك هم الظالمون" />
-		<aya index="95" text="قل صدق الله فاتبعوا ملة إبراهيم حنيفا وما كان من المشركين" />
-		<aya index="96" text="إن أول بيت وضع للناس للذي ببكة مباركا وهدى للعالمين" />
-		<aya index="97" text="فيه آيات بينات مقام إبراهيم ومن دخله كان آمنا ولله على الناس حج البيت من استطاع إليه سبيلا ومن كفر فإن الله غني عن العالمين" />
-		<aya index="98" text="قل يا أهل الكتاب لم تكفرون بآيات الله والله شهيد على ما تعملون" />
-		<aya index="99" text="قل يا أهل الكتاب لم تصدون عن سبيل الله من آمن تبغونها عوجا وأنتم شهداء وما الله بغافل عما تعملون" />
-		<aya index="100" text="يا أيها الذين آمنوا إن تطيعوا فريقا من الذين أوتوا الكتاب يردوكم بعد إيمانكم كافرين" />
-		<aya index="101" text="وكيف تكفرون وأنتم تتلى عليكم آيات الله وفيكم رسوله ومن يعتصم بالله فقد هدي إلى صراط مستقيم" />
-		<aya index="102" text="يا أيها الذين آمنوا اتقوا الله حق تقاته ولا تموتن إلا وأنتم مسلمون" />
-		<aya index="103" text="واعتصموا بحبل الله جميعا ولا تفرقوا واذكروا نعمت الله عليكم إذ كنتم أعداء فألف بين قلوبكم فأصبحتم بنعمته إخوانا وكنتم على شفا حفرة من النار فأنقذكم منها كذلك يبين الله لكم آياته لعلكم تهتدون" />
-		<aya index="104" text="ولتكن منكم أمة يدعون إلى الخير ويأمرون بالمعروف وينهون عن المنكر وأولئك هم المفلحون" />
-		<aya index="105" text="ولا تكونوا كالذين تفرقوا واختلفوا من بعد ما جاءهم البينات وأولئك لهم عذاب عظيم" />
-		<aya index="106" text="يوم تبيض وجوه وتسود وجوه فأما الذين اسودت وجوههم أكفرتم بعد إيمانكم فذوقوا العذاب بما كنتم تكفرون" />
-		<aya index="107" text="وأما الذين ابيضت وجوههم ففي رحمة الله هم فيها خالدون" />
-		<aya index="108" text="تلك آيات الله نتلوها عليك بالحق وما الله يريد ظلما للعالمين" />
-		<aya index="109" text="ولله ما في السماوات وما في الأرض وإلى الله ترجع الأمور" />
-		<aya index="110" text="كنتم خير أمة أخرجت للناس تأمرون بالمعروف وتنهون عن المنكر وتؤمنون بالله ولو آمن أهل الكتاب لكان خيرا لهم منهم المؤمنون وأكثرهم الفاسقون" />
-		<aya index="111" text="لن يضروكم إلا أذى وإن يقاتلوكم يولوكم الأدبار ثم لا ينصرون" />
-		<aya index="112" text="ضربت عليهم الذلة أين ما ثقفوا إلا بحبل من الله وحبل من الناس وباءوا بغضب من الله وضربت عليهم المسكنة ذلك بأنهم كانوا يكفرون بآيات الله ويقتلون الأنبياء بغير حق ذلك بما عصوا وكانوا يعتدون" />
-		<aya index="113" text="ليسوا سواء من أهل الكتاب أمة قائمة يتلون آيات الله آناء الليل وهم يسجدون" />
-		<aya index="114" text="يؤمنون بالله واليوم الآخر ويأمرون بالمعروف وينهون عن المنكر ويسارعون في الخيرات وأولئك من الصالحين" />
-		<aya index="115" text="وما يفعلوا من خير فلن يكفروه والله عليم بالمتقين" />
-		<aya index="116" text="إن الذين كفروا لن تغني عنهم أموالهم ولا أولادهم من الله شيئا وأولئك أصحاب النار هم فيها خالدون" />
-		<aya index="117" text="مثل ما ينفقون في هذه الحياة الدنيا كمثل ريح فيها صر أصابت حرث قوم ظلموا أنفسهم فأهلكته وما ظلمهم الله ولكن أنفسهم يظلمون" />
-		<aya index="118" text="يا أيها الذين آمنوا لا تتخذوا بطانة من دونكم لا يألونكم خبالا ودوا ما عنتم قد بدت البغضاء من أفواههم وما تخفي صدورهم أكبر قد بينا لكم الآيات إن كنتم تعقلون" />
-		<aya index="119" text="ها أنتم أولاء تحبونهم ولا يحبونكم وتؤمنون بالكتاب كله وإذا لقوكم قالوا آمنا وإذا خلوا عضوا عليكم الأنامل من الغيظ قل موتوا بغيظكم إن الله عليم بذات الصدور" />
-		<aya index="120" text="إن تمسسكم حسنة تسؤهم وإن تصبكم سيئة يفرحوا بها وإن تصبروا وتتقوا لا يضركم كيدهم شيئا إن الله بما يعملون محيط" />
-		<aya index="121" text="وإذ غدوت من أهلك تبوئ المؤمنين مقاعد للقتال والله سميع عليم" />
-		<aya index="122" text="إذ همت طائفتان منكم أن تفشلا والله وليهما وعلى الله فليتوكل المؤمنون" />
-		<aya index="123" text="ولقد نصركم الله ببدر وأنتم أذلة فاتقوا الله لعلكم تشكرون" />
-		<aya index="124" text="إذ تقول للمؤمنين ألن يكفيكم أن يمدكم ربكم بثلاثة آلاف من الملائكة منزلين" />
-		<aya index="125" text="بلى إن تصبروا وتتقوا ويأتوكم من فورهم هذا يمددكم ربكم بخمسة آلاف من الملائكة مسومين" />
-		<aya index="126" text="وما جعله الله إلا بشرى لكم ولتطمئن قلوبكم به وما النصر إلا من عند الله العزيز الحكيم" />
-		<aya index="127" text="ليقطع طرفا من الذين كفروا أو يكبتهم فينقلبوا خائبين" />
-		<aya index="128" text="ليس لك من الأمر شيء أو يتوب عليهم أو يعذبهم فإنهم ظالمون" />
-		<aya index="129" text="ولله ما في السماوات وما في الأرض يغفر لمن يشاء ويعذب من يشاء والله غفور رحيم" />
-		<aya index="130" text="يا أيها الذين آمنوا لا تأكلوا الربا أضعافا مضاعفة واتقوا الله لعلكم تفلحون" />
-		<aya index="131" text="واتقوا النار التي أعدت للكافرين" />
-		<aya index="132" text="وأطيعوا الله والرسول لعلكم ترحمون" />
-		<aya index="133" text="وسارعوا إلى مغفرة من ربكم وجنة عرضها السماوات والأرض أعدت للمتقين" />
-		<aya index="134" text="الذين ينفقون في السراء والضراء والكاظمين الغيظ والعافين عن الناس والله يحب المحسنين" />
-		<aya index="135" text="والذين إذا فعلوا فاحشة أو ظلموا أنفسهم ذكروا الله فاستغفروا لذنوبهم ومن يغفر الذنوب إلا الله ولم يصروا على ما فعلوا وهم يعلمون" />
-		<aya index="136" text="أولئك جزاؤهم مغفرة من ربهم وجنات تجري من تحتها الأنهار خالدين فيها ونعم أجر العاملين" />
-		<aya index="137" text="قد خلت من قبلكم سنن فسيروا في الأرض فانظروا كيف كان عاقبة المكذبين" />
-		<aya index="138" text="هذا بيان للناس وهدى وموعظة للمتقين" />
-		<aya index="139" text="ولا تهنوا ولا تحزنوا وأنتم الأعلون إن كنتم مؤمنين" />
-		<aya index="140" text="إن يمسسكم قرح فقد مس القوم قرح مثله وتلك الأيام نداولها بين الناس وليعلم الله الذين آمنوا ويتخذ منكم شهداء والله لا يحب الظالمين" />
-		<aya index="141" text="وليمحص الله الذين آمنوا ويمحق الكافرين" />
-		<aya index="142" text="أم حسبتم أن تدخلوا الجنة ولما يعلم الله الذين جاهدوا منكم ويعلم الصابرين" />
-		<aya index="143" text="ولقد كنتم تمنون الموت من قبل أن تلقوه فقد رأيتموه وأنتم تنظرون" />
-		<aya index="144" text="وما محمد إلا رسول قد خلت من قبله الرسل أفإن مات أو قتل انقلبتم على أعقابكم ومن ينقلب على عقبيه فلن يضر الله شيئا وسيجزي الله الشاكرين" />
-		<aya index="145" text="وما كان لنفس أن تموت إلا بإذن الله كتابا مؤجلا ومن يرد ثواب الدنيا نؤته منها ومن يرد ثواب الآخرة نؤته منها وسنجزي الشاكرين" />
-		<aya index="146" text="وكأين من نبي قاتل معه ربيون كثير فما وهنوا لما أصابهم في سبيل الله وما ضعفوا وما استكانوا والله يحب الصابرين" />
-		<aya index="147" text="وما كان قولهم إلا أن قالوا ربنا اغفر لنا ذنوبنا وإسرافنا في أمرنا وثبت أقدامنا وانصرنا على القوم الكافرين" />
-		<aya index="148" text="فآتاهم الله ثواب الدنيا وحسن ثواب الآخرة والله يحب المحسنين" />
-		<aya index="149" text="يا أيها الذين آمنوا إن تطيعوا الذين كفروا يردوكم على أعقابكم فتنقلبوا خاسرين" />
-		<aya index="150" text="بل الله مولاكم وهو خير الناصرين" />
-		<aya index="151" text="سنلقي في قلوب الذين كفروا الرعب بما أشركوا بالله ما لم ينزل به سلطانا ومأواهم النار وبئس مثوى الظالمين" />
-		<aya index="152" text="ولقد صدقكم الله وعده إذ تحسونهم بإذنه حتى إذا فشلتم وتنازعتم في الأمر وعصيتم من بعد ما أراكم ما تحبون منكم من يريد الدنيا ومنكم من يريد الآخرة ثم صرفكم عنهم ليبتليكم ولقد عفا عنكم والله ذو فضل على المؤمنين" />
-		<aya index="153" text="إذ تصعدون ولا تلوون على أحد والرسول يدعوكم في أخراكم فأثابكم غما بغم لكيلا تحزنوا على ما فاتكم ولا ما أصابكم والله خبير بما تعملون" />
-		<aya index="154" text="ثم أنزل عليكم من بعد الغم أمنة نعاسا يغشى طائفة منكم وطائفة قد أهمتهم أنفسهم يظنون بالله غير الحق ظن الجاهلية يقولون هل لنا من الأمر من شيء قل إن الأمر كله لله يخفون في أنفسهم ما لا يبدون لك يقولون لو كان لنا من الأمر شيء ما قتلنا هاهنا قل لو كنتم في بيوتكم لبرز الذين كتب عليهم القتل إلى مضاجعهم وليبتلي الله ما في صدوركم وليمحص ما في قلوبكم والله عليم بذات الصدور" />
-		<aya index="155" text="إن الذين تولوا منكم يوم التقى الجمعان إنما استزلهم الشيطان ببعض ما كسبوا ولقد عفا الله عنهم إن الله غفور حليم" />
-		<aya index="156" text="يا أيها الذين آمنوا لا تكونوا كالذين كفروا وقالوا لإخوانهم إذا ضربوا في الأرض أو كانوا غزى لو كانوا عندنا ما ماتوا وما قتلوا ليجعل الله ذلك حسرة في قلوبهم والله يحيي ويميت والله بما تعملون بصير" />
-		<aya index="157" text="ولئن قتلتم في سبيل الله أو متم لمغفرة من الله ورحمة خير مما يجمعون" />
-		<aya index="158" text="ولئن متم أو قتلتم لإلى الله تحشرون" />
-		<aya index="159" text="فبما رحمة من الله لنت لهم ولو كنت فظا غليظ القلب لانفضوا من حولك فاعف عنهم واستغفر لهم وشاورهم في الأمر فإذا عزمت فتوكل على الله إن الله يحب المتوكلين" />
-		<aya index="160" text="إن ينصركم الله فلا غالب لكم وإن يخذلكم فمن ذا الذي ينصركم من بعده وعلى الله فليتوكل المؤمنون" />
-		<aya index="161" text="وما كان لنبي أن يغل ومن يغلل يأت بما غل يوم القيامة ثم توفى كل نفس ما كسبت وهم لا يظلمون" />
-		<aya index="162" text="أفمن اتبع رضوان الله كمن باء بسخط من الله ومأواه جهنم وبئس المصير" />
-		<aya index="163" text="هم درجات عند الله والله بصير بما يعملون" />
-		<aya index="164" text="لقد من الله على المؤمنين إذ بعث فيهم رسولا من أنفسهم يتلو عليهم آياته ويزكيهم ويعلمهم الكتاب والحكمة وإن كانوا من قبل لفي ضلال مبين" />
-		<aya index="165" text="أولما أصابتكم مصيبة قد أصبتم مثليها قلتم أنى هذا قل هو من عند أنفسكم إن الله على كل شيء قدير" />
-		<aya index="166" text="وما أصابكم يوم التقى الجمعان فبإذن الله وليعلم المؤمنين" />
-		<aya index="167" text="وليعلم الذين نافقوا وقيل لهم تعالوا قاتلوا في سبيل الله أو ادفعوا قالوا لو نعلم قتالا لاتبعناكم هم للكفر يومئذ أقرب منهم للإيمان يقولون بأفواههم ما ليس في قلوبهم والله أعلم بما يكتمون" />
-		<aya index="168" text="الذين قالوا لإخوانهم وقعدوا لو أطاعونا ما قتلوا قل فادرءوا عن أنفسكم الموت إن كنتم صادقين" />
-		<aya index="169" text="ولا تحسبن الذين قتلوا في سبيل الله أمواتا بل أحياء عند ربهم يرزقون" />
-		<aya index="170" text="فرحين بما آتاهم الله من فضله ويستبشرون بالذين لم يلحقوا بهم من خلفهم ألا خوف عليهم ولا هم يحزنون" />
-		<aya index="171" text="يستبشرون بنعمة من الله وفضل وأن الله لا يضيع أجر المؤمنين" />
-		<aya index="172" text="الذين استجابوا لله والرسول من بعد ما أصابهم القرح للذين أحسنوا منهم واتقوا أجر عظيم" />
-		<aya index="173" text="الذين قال لهم الناس إن الناس قد جمعوا لكم فاخشوهم فزادهم إيمانا وقالوا حسبنا الله ونعم الوكيل" />
-		<aya index="174" text="فانقلبوا بنعمة من الله وفضل لم يمسسهم سوء واتبعوا رضوان الله والله ذو فضل عظيم" />
-		<aya index="175" text="إنما ذلكم الشيطان يخوف أولياءه فلا تخافوهم وخافون إن كنتم مؤمنين" />
-		<aya index="176" text="ولا يحزنك الذين يسارعون في الكفر إنهم لن يضروا الله شيئا يريد الله ألا يجعل لهم حظا في الآخرة ولهم عذاب عظيم" />
-		<aya index="177" text="إن الذين اشتروا الكفر بالإيمان لن يضروا الله شيئا ولهم عذاب أليم" />
-		<aya index="178" text="ولا يحسبن الذين كفروا أنما نملي لهم خير لأنفسهم إنما نملي لهم ليزدادوا إثما ولهم عذاب مهين" />
-		<aya index="179" text="ما كان الله ليذر المؤمنين على ما أنتم عليه حتى يميز الخبيث من الطيب وما كان الله ليطلعكم على الغيب ولكن الله يجتبي من رسله من يشاء فآمنوا بالله ورسله وإن تؤمنوا وتتقوا فلكم أجر عظيم" />
-		<aya index="180" text="ولا يحسبن الذين يبخلون بما آتاهم الله من فضله هو خيرا لهم بل هو شر لهم سيطوقون ما بخلوا به يوم القيامة ولله ميراث السماوات والأرض والله بما تعملون خبير" />
-		<aya index="181" text="لقد سمع الله قول الذين قالوا إن الله فقير ونحن أغنياء سنكتب ما قالوا وقتلهم الأنبياء بغير حق ونقول ذوقوا عذاب الحريق" />
-		<aya index="182" text="ذلك بما قدمت أيديكم وأن الله ليس بظلام للعبيد" />
-		<aya index="183" text="الذين قالوا إن الله عهد إلينا ألا نؤمن لرسول حتى يأتينا بقربان تأكله النار قل قد جاءكم رسل من قبلي بالبينات وبالذي قلتم فلم قتلتموهم إن كنتم صادقين" />
-		<aya index="184" text="فإن كذبوك فقد كذب رسل من قبلك جاءوا بالبينات والزبر والكتاب المنير" />
-		<aya index="185" text="كل نفس ذائقة الموت وإنما توفون أجوركم يوم القيامة فمن زحزح عن النار وأدخل الجنة فقد فاز وما الحياة الدنيا إلا متاع الغرور" />
-		<aya index="186" text="لتبلون في أموالكم وأنفسكم ولتسمعن من الذين أوتوا الكتاب من قبلكم ومن الذين أشركوا أذى كثيرا وإن تصبروا وتتقوا فإن ذلك من عزم الأمور" />
-		<aya index="187" text="وإذ أخذ الله ميثاق الذين أوتوا الكتاب لتبيننه للناس ولا تكتمونه فنبذوه وراء ظهورهم واشتروا به ثمنا قليلا فبئس ما يشترون" />
-		<aya index="188" text="لا تحسبن الذين يفرحون بما أتوا ويحبون أن يحمدوا بما لم يفعلوا فلا تحسبنهم بمفازة من العذاب ولهم عذاب أليم" />
-		<aya index="189" text="ولله ملك السماوات والأرض والله على كل شيء قدير" />
-		<aya index="190" text="إن في خلق السماوات والأرض واختلاف الليل والنهار لآيات لأولي الألباب" />
-		<aya index="191" text="الذين يذكرون الله قياما وقعودا وعلى جنوبهم ويتفكرون في خلق السماوات والأرض ربنا ما خلقت هذا باطلا سبحانك فقنا عذاب النار" />
-		<aya index="192" text="ربنا إنك من تدخل النار فقد أخزيته وما للظالمين من أنصار" />
-		<aya index="193" text="ربنا إننا سمعنا مناديا ينادي للإيمان أن آمنوا بربكم فآمنا ربنا فاغفر لنا ذنوبنا وكفر عنا سيئاتنا وتوفنا مع الأبرار" />
-		<aya index="194" text="ربنا وآتنا ما وعدتنا على رسلك ولا تخزنا يوم القيامة إنك لا تخلف الميعاد" />
-		<aya index="195" text="فاستجاب لهم ربهم أني لا أضيع عمل عامل منكم من ذكر أو أنثى بعضكم من بعض فالذين هاجروا وأخرجوا من ديارهم وأوذوا في سبيلي وقاتلوا وقتلوا لأكفرن عنهم سيئاتهم ولأدخلنهم جنات تجري من تحتها الأنهار ثوابا من عند الله والله عنده حسن الثواب" />
-		<aya index="196" text="لا يغرنك تقلب الذين كفروا في البلاد" />
-		<aya index="197" text="متاع قليل ثم مأواهم جهنم وبئس المهاد" />
-		<aya index="198" text="لكن الذين اتقوا ربهم لهم جنات تجري من تحتها الأنهار خالدين فيها نزلا من عند الله وما عند الله خير للأبرار" />
-		<aya index="199" text="وإن من أهل الكتاب لمن يؤمن بالله وما أنزل إليكم وما أنزل إليهم خاشعين لله لا يشترون بآيات الله ثمنا قليلا أولئك لهم أجرهم عند ربهم إن الله سريع الحساب" />
-		<aya index="200" text="يا أيها الذين آمنوا اصبروا وصابروا ورابطوا واتقوا الله لعلكم تفلحون" />
-	</sura>
-	<sura index="4" name="النساء">
-		<aya index="1" text="يا أيها الناس اتقوا ربكم الذي خلقكم من نفس واحدة وخلق منها زوجها وبث منهما رجالا كثيرا ونساء واتقوا الله الذي تساءلون به والأرحام إن الله كان عليكم رقيبا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وآتوا اليتامى أموالهم ولا تتبدلوا الخبيث بالطيب ولا تأكلوا أموالهم إلى أموالكم إنه كان حوبا كبيرا" />
-		<aya index="3" text="وإن خفتم ألا تقسطوا في اليتامى فانكحوا ما طاب لكم من النساء مثنى وثلاث ورباع فإن خفتم ألا تعدلوا فواحدة أو ما ملكت أيمانكم ذلك أدنى ألا تعولوا" />
-		<aya index="4" text="وآتوا النساء صدقاتهن نحلة فإن طبن لكم عن شيء منه نفسا فكلوه هنيئا مريئا" />
-		<aya index="5" text="ولا تؤتوا السفهاء أموالكم التي جعل الله لكم قياما وارزقوهم فيها واكسوهم وقولوا لهم قولا معروفا" />
-		<aya index="6" text="وابتلوا اليتامى حتى إذا بلغوا النكاح فإن آنستم منهم رشدا فادفعوا إليهم أموالهم ولا تأكلوها إسرافا وبدارا أن يكبروا ومن كان غنيا فليستعفف ومن كان فقيرا فليأكل بالمعروف فإذا دفعتم إليهم أموالهم فأشهدوا عليهم وكفى بالله حسيبا" />
-		<aya index="7" text="للرجال نصيب مما ترك الوالدان والأقربون وللنساء نصيب مما ترك الوالدان والأقربون مما قل منه أو كثر نصيبا مفروضا" />
-		<aya index="8" text="وإذا حضر القسمة أولو القربى واليتامى والمساكين فارزقوهم منه وقولوا لهم قولا معروفا" />
-		<aya index="9" text="وليخش الذين لو تركوا من خلفهم ذرية ضعافا خافوا عليهم فليتقوا الله وليقولوا قولا سديدا" />
-		<aya index="10" text="إن الذين يأكلون أموال اليتامى ظلما إنما يأكلون في بطونهم نارا وسيصلون سعيرا" />
-		<aya index="11" text="يوصيكم الله في أولادكم للذكر مثل حظ الأنثيين فإن كن نساء فوق اثنتين فلهن ثلثا ما ترك وإن كانت واحدة فلها النصف ولأبويه لكل واحد منهما السدس مما ترك إن كان له ولد فإن لم يكن له ولد وورثه أبواه فلأمه الثلث فإن كان له إخوة فلأمه السدس من بعد وصية يوصي بها أو دين آباؤكم وأبناؤكم لا تدرون أيهم أقرب لكم نفعا فريضة من الله إن الله كان عليما حكيما" />
-		<aya index="12" text="ولكم نصف ما ترك أزواجكم إن لم يكن لهن ولد فإن كان لهن ولد فلكم الربع مما تركن من بعد وصية يوصين بها أو دين ولهن الربع مما تركتم إن لم يكن لكم ولد فإن كان لكم ولد فلهن الثمن مما تركتم من بعد وصية توصون بها أو دين وإن كان رجل يورث كلالة أو امرأة وله أخ أو أخت فلكل واحد منهما السدس فإن كانوا أكثر من ذلك فهم شركاء في الثلث من بعد وصية يوصى بها أو دين غير مضار وصية من الله والله عليم حليم" />
-		<aya index="13" text="تلك حدود الله ومن يطع الله ورسوله يدخله جنات تجري من تحتها الأنهار خالدين فيها وذلك الفوز العظيم" />
-		<aya index="14" text="ومن يعص الله ورسوله ويتعد حدوده يدخله نارا خالدا فيها وله عذاب مهين" />
-		<aya index="15" text="واللاتي يأتين الفاحشة من نسائكم فاستشهدوا عليهن أربعة منكم فإن شهدوا فأمسكوهن في البيوت حتى يتوفاهن الموت أو يجعل الله لهن سبيلا" />
-		<aya index="16" text="واللذان يأتيانها منكم فآذوهما فإن تابا وأصلحا فأعرضوا عنهما إن الله كان توابا رحيما" />
-		<aya index="17" text="إنما التوبة على الله للذين يعملون السوء بجهالة ثم يتوبون من قريب فأولئك يتوب الله عليهم وكان الله عليما حكيما" />
-		<aya index="18" text="وليست التوبة للذين يعملون السيئات حتى إذا حضر أحدهم الموت قال إني تبت الآن ولا الذين يموتون وهم كفار أولئك أعتدنا لهم عذابا أليما" />
-		<aya index="19" text="يا أيها الذين آمنوا لا يحل لكم أن ترثوا النساء كرها ولا تعضلوهن لتذهبوا ببعض ما آتيتموهن إلا أن يأتين بفاحشة مبينة وعاشروهن بالمعروف فإن كرهتموهن فعسى أن تكرهوا شيئا ويجعل الله فيه خيرا كثيرا" />
-		<aya index="20" text="وإن أردتم استبدال زوج مكان زوج وآتيتم إحداهن قنطارا فلا تأخذوا منه شيئا أتأخذونه بهتانا وإثما مبينا" />
-		<aya index="21" text="وكيف تأخذونه وقد أفضى بعضكم إلى بعض وأخذن منكم ميثاقا غليظا" />
-		<aya index="22" text="ولا تنكحوا ما نكح آباؤكم من النساء إلا ما قد سلف إنه كان فاحشة ومقتا وساء سبيلا" />
-		<aya index="23" text="حرمت عليكم أمهاتكم وبناتكم وأخواتكم وعماتكم وخالاتكم وبنات الأخ وبنات الأخت وأمهاتكم اللاتي أرضعنكم وأخواتكم من الرضاعة وأمهات نسائكم وربائبكم اللاتي في حجوركم من نسائكم اللاتي دخلتم بهن فإن لم تكونوا دخلتم بهن فلا جناح عليكم وحلائل أبنائكم الذين من أصلابكم وأن تجمعوا بين الأختين إلا ما قد سلف إن الله كان غفورا رحيما" />
-		<aya index="24" text="والمحصنات من النساء إلا ما ملكت أيمانكم كتاب الله عليكم وأحل لكم ما وراء ذلكم أن تبتغوا بأموالكم محصنين غير مسافحين فما استمتعتم به منهن فآتوهن أجورهن فريضة ولا جناح عليكم فيما تراضيتم به من بعد الفريضة إن الله كان عليما حكيما" />
-		<aya index="25" text="ومن لم يستطع منكم طولا أن ينكح المحصنات المؤمنات فمن ما ملكت أيمانكم من فتياتكم المؤمنات والله أعلم بإيمانكم بعضكم من بعض فانكحوهن بإذن أهلهن وآتوهن أجورهن بالمعروف محصنات غير مسافحات ولا متخذات أخدان فإذا أحصن فإن أتين بفاحشة فعليهن نصف ما على المحصنات من العذاب ذلك لمن خشي العنت منكم وأن تصبروا خير لكم والله غفور رحيم" />
-		<aya index="26" text="يريد الله ليبين لكم ويهديكم سنن الذين من قبلكم ويتوب عليكم والله عليم حكيم" />
-		<aya index="27" text="والله يريد أن يتوب عليكم ويريد الذين يتبعون الشهوات أن تميلوا ميلا عظيما" />
-		<aya index="28" text="يريد الله أن يخفف عنكم وخلق الإنسان ضعيفا" />
-		<aya index="29" text="يا أيها الذين آمنوا لا تأكلوا أموالكم بينكم بالباطل إلا أن تكون تجارة عن تراض منكم ولا تقتلوا أنفسكم إن الله كان بكم رحيما" />
-		<aya index="30" text="ومن يفعل ذلك عدوانا وظلما فسوف نصليه نارا وكان ذلك على الله يسيرا" />
-		<aya index="31" text="إن تجتنبوا كبائر ما تنهون عنه نكفر عنكم سيئاتكم وندخلكم مدخلا كريما" />
-		<aya index="32" text="ولا تتمنوا ما فضل الله به بعضكم على بعض للرجال نصيب مما اكتسبوا وللنساء نصيب مما اكتسبن واسألوا الله من فضله إن الله كان بكل شيء عليما" />
-		<aya index="33" text="ولكل جعلنا موالي مما ترك الوالدان والأقربون والذين عقدت أيمانكم فآتوهم نصيبهم إن الله كان على كل شيء شهيدا" />
-		<aya index="34" text="الرجال قوامون على النساء بما فضل الله بعضهم على بعض وبما أنفقوا من أموالهم فالصالحات قانتات حافظات للغيب بما حفظ الله واللاتي تخافون نشوزهن فعظوهن واهجروهن في المضاجع واضربوهن فإن أطعنكم فلا تبغوا عليهن سبيلا إن الله كان عليا كبيرا" />
-		<aya index="35" text="وإن خفتم شقاق بينهما فابعثوا حكما من أهله وحكما من أهلها إن يريدا إصلاحا يوفق الله بينهما إن الله كان عليما خبيرا" />
-		<aya index="36" text="واعبدوا الله ولا تشركوا به شيئا وبالوالدين إحسانا وبذي القربى واليتامى والمساكين والجار ذي القربى والجار الجنب والصاحب بالجنب وابن السبيل وما ملكت أيمانكم إن الله لا يحب من كان مختالا فخورا" />
-		<aya index="37" text="الذين يبخلون ويأمرون الناس بالبخل ويكتمون ما آتاهم الله من فضله وأعتدنا للكافرين عذابا مهينا" />
-		<aya index="38" text="والذين ينفقون أموالهم رئاء الناس ولا يؤمنون بالله ولا باليوم الآخر ومن يكن الشيطان له قرينا فساء قرينا" />
-		<aya index="39" text="وماذا عليهم لو آمنوا بالله واليوم الآخر وأنفقوا مما رزقهم الله وكان الله بهم عليما" />
-		<aya index="40" text="إن الله لا يظلم مثقال ذرة وإن تك حسنة يضاعفها ويؤت من لدنه أجرا عظيما" />
-		<aya index="41" text="فكيف إذا جئنا من كل أمة بشهيد وجئنا بك على هؤلاء شهيدا" />
-		<aya index="42" text="يومئذ يود الذين كفروا وعصوا الرسول لو تسوى بهم الأرض ولا يكتمون الله حديثا" />
-		<aya index="43" text="يا أيها الذين آمنوا لا تقربوا الصلاة وأنتم سكارى حتى تعلموا ما تقولون ولا جنبا إلا عابري سبيل حتى تغتسلوا وإن كنتم مرضى أو على سفر أو جاء أحد منكم من الغائط أو لامستم النساء فلم تجدوا ماء فتيمموا صعيدا طيبا فامسحوا بوجوهكم وأيديكم إن الله كان عفوا غفورا" />
-		<aya index="44" text="ألم تر إلى الذين أوتوا نصيبا من الكتاب يشترون الضلالة ويريدون أن تضلوا السبيل" />
-		<aya index="45" text="والله أعلم بأعدائكم وكفى بالله وليا وكفى بالله نصيرا" />
-		<aya index="46" text="من الذين هادوا يحرفون الكلم عن مواضعه ويقولون سمعنا وعصينا واسمع غير مسمع وراعنا ليا بألسنتهم وطعنا في الدين ولو أنهم قالوا سمعنا وأطعنا واسمع وانظرنا لكان خيرا لهم وأقوم ولكن لعنهم الله بكفرهم فلا يؤمنون إلا قليلا" />
-		<aya index="47" text="يا أيها الذين أوتوا الكتاب آمنوا بما نزلنا مصدقا لما معكم من قبل أن نطمس وجوها فنردها على أدبارها أو نلعنهم كما لعنا أصحاب السبت وكان أمر الله مفعولا" />
-		<aya index="48" text="إن الله لا يغفر أن يشرك به ويغفر ما دون ذلك لمن يشاء ومن يشرك بالله فقد افترى إثما عظيما" />
-		<aya index="49" text="ألم تر إلى الذين يزكون أنفسهم بل الله يزكي من يشاء ولا يظلمون فتيلا" />
-		<aya index="50" text="انظر كيف يفترون على الله الكذب وكفى به إثما مبينا" />
-		<aya index="51" text="ألم تر إلى الذين أوتوا نصيبا من الكتاب يؤمنون بالجبت والطاغوت ويقولون للذين كفروا هؤلاء أهدى من الذين آمنوا سبيلا" />
-		<aya index="52" text="أولئك الذين لعنهم الله ومن يلعن الله فلن تجد له نصيرا" />
-		<aya index="53" text="أم لهم نصيب من الملك فإذا لا يؤتون الناس نقيرا" />
-		<aya index="54" text="أم يحسدون الناس على ما آتاهم الله من فضله فقد آتينا آل إبراهيم الكتاب والحكمة وآتيناهم ملكا عظيما" />
-		<aya index="55" text="فمنهم من آمن به ومنهم من صد عنه وكفى بجهنم سعيرا" />
-		<aya index="56" text="إن الذين كفروا بآياتنا سوف نصليهم نارا كلما نضجت جلودهم بدلناهم جلودا غيرها ليذوقوا العذاب إن الله كان عزيزا حكيما" />
-		<aya index="57" text="والذين آمنوا وعملوا الصالحات سندخلهم جنات تجري من تحتها الأنهار خالدين فيها أبدا لهم فيها أزواج مطهرة وندخلهم ظلا ظليلا" />
-		<aya index="58" text="إن الله يأمركم أن تؤدوا الأمانات إلى أهلها وإذا حكمتم بين الناس أن تحكموا بالعدل إن الله نعما يعظكم به إن الله كان سميعا بصيرا" />
-		<aya index="59" text="يا أيها الذين آمنوا أطيعوا الله وأطيعوا الرسول وأولي الأمر منكم فإن تنازعتم في شيء فردوه إلى الله والرسول إن كنتم تؤمنون بالله واليوم الآخر ذلك خير وأحسن تأويلا" />
-		<aya index="60" text="ألم تر إلى الذين يزعمون أنهم آمنوا بما أنزل إليك وما أنزل من قبلك يريدون أن يتحاكموا إلى الطاغوت وقد أمروا أن يكفروا به ويريد الشيطان أن يضلهم ضلالا بعيدا" />
-		<aya index="61" text="وإذا قيل لهم تعالوا إلى ما أنزل الله وإلى الرسول رأيت المنافقين يصدون عنك صدودا" />
-		<aya index="62" text="فكيف إذا أصابتهم مصيبة بما قدمت أيديهم ثم جاءوك يحلفون بالله إن أردنا إلا إحسانا وتوفيقا" />
-		<aya index="63" text="أولئك الذين يعلم الله ما في قلوبهم فأعرض عنهم وعظهم وقل لهم في أنفسهم قولا بليغا" />
-		<aya index="64" text="وما أرسلنا من رسول إلا ليطاع بإذن الله ولو أنهم إذ ظلموا أنفسهم جاءوك فاستغفروا الله واستغفر لهم الرسول لوجدوا الله توابا رحيما" />
-		<aya index="65" text="فلا وربك لا يؤمنون حتى يحكموك فيما شجر بينهم ثم لا يجدوا في أنفسهم حرجا مما قضيت ويسلموا تسليما" />
-		<aya index="66" text="ولو أنا كتبنا عليهم أن اقتلوا أنفسكم أو اخرجوا من دياركم ما فعلوه إلا قليل منهم ولو أنهم فعلوا ما يوعظون به لكان خيرا لهم وأشد تثبيتا" />
-		<aya index="67" text="وإذا لآتيناهم من لدنا أجرا عظيما" />
-		<aya index="68" text="ولهديناهم صراطا مستقيما" />
-		<aya index="69" text="ومن يطع الله والرسول فأولئك مع الذين أنعم الله عليهم من النبيين والصديقين والشهداء والصالحين وحسن أولئك رفيقا" />
-		<aya index="70" text="ذلك الفضل من الله وكفى بالله عليما" />
-		<aya index="71" text="يا أيها الذين آمنوا خذوا حذركم فانفروا ثبات أو انفروا جميعا" />
-		<aya index="72" text="وإن منكم لمن ليبطئن فإن أصابتكم مصيبة قال قد أنعم الله علي إذ لم أكن معهم شهيدا" />
-		<aya index="73" text="ولئن أصابكم فضل من الله ليقولن كأن لم تكن بينكم وبينه مودة يا ليتني كنت معهم فأفوز فوزا عظيما" />
-		<aya index="74" text="فليقاتل في سبيل الله الذين يشرون الحياة الدنيا بالآخرة ومن يقاتل في سبيل الله فيقتل أو يغلب فسوف نؤتيه أجرا عظيما" />
-		<aya index="75" text="وما لكم لا تقاتلون في سبيل الله والمستضعفين من الرجال والنساء والولدان الذين يقولون ربنا أخرجنا من هذه القرية الظالم أهلها واجعل لنا من لدنك وليا واجعل لنا من لدنك نصيرا" />
-		<aya index="76" text="الذين آمنوا يقاتلون في سبيل الله والذين كفروا يقاتلون في سبيل الطاغوت فقاتلوا أولياء الشيطان إن كيد الشيطان كان ضعيفا" />
-		<aya index="77" text="ألم تر إلى الذين قيل لهم كفوا أيديكم وأقيموا الصلاة وآتوا الزكاة فلما كتب عليهم القتال إذا فريق منهم يخشون الناس كخشية الله أو أشد خشية وقالوا ربنا لم كتبت علينا القتال لولا أخرتنا إلى أجل قريب قل متاع الدنيا قليل والآخرة خير لمن اتقى ولا تظلمون فتيلا" />
-		<aya index="78" text="أينما تكونوا يدرككم الموت ولو كنتم في بروج مشيدة وإن تصبهم حسنة يقولوا هذه من عند الله وإن تصبهم سيئة يقولوا هذه من عندك قل كل من عند الله فمال هؤلاء القوم لا يكادون يفقهون حديثا" />
-		<aya index="79" text="ما أصابك من حسنة فمن الله وما أصابك من سيئة فمن نفسك وأرسلناك للناس رسولا وكفى بالله شهيدا" />
-		<aya index="80" text="من يطع الرسول فقد أطاع الله ومن تولى فما أرسلناك عليهم حفيظا" />
-		<aya index="81" text="ويقولون طاعة فإذا برزوا من عندك بيت طائفة منهم غير الذي تقول والله يكتب ما يبيتون فأعرض عنهم وتوكل على الله وكفى بالله وكيلا" />
-		<aya index="82" text="أفلا يتدبرون القرآن ولو كان من عند غير الله لوجدوا فيه اختلافا كثيرا" />
-		<aya index="83" text="وإذا جاءهم أمر من الأمن أو الخوف أذاعوا به ولو ردوه إلى الرسول وإلى أولي الأمر منهم لعلمه الذين يستنبطونه منهم ولولا فضل الله عليكم ورحمته لاتبعتم الشيطان إلا قليلا" />
-		<aya index="84" text="فقاتل في سبيل الله لا تكلف إلا نفسك وحرض المؤمنين عسى الله أن يكف بأس الذين كفروا والله أشد بأسا وأشد تنكيلا" />
-		<aya index="85" text="من يشفع شفاعة حسنة يكن له نصيب منها ومن يشفع شفاعة سيئة يكن له كفل منها وكان الله على كل شيء مقيتا" />
-		<aya index="86" text="وإذا حييتم بتحية فحيوا بأحسن منها أو ردوها إن الله كان على كل شيء حسيبا" />
-		<aya index="87" text="الله لا إله إلا هو ليجمعنكم إلى يوم القيامة لا ريب فيه ومن أصدق من الله حديثا" />
-		<aya index="88" text="فما لكم في المنافقين فئتين والله أركسهم بما كسبوا أتريدون أن تهدوا من أضل الله ومن يضلل الله فلن تجد له سبيلا" />
-		<aya index="89" text="ودوا لو تكفرون كما كفروا فتكونون سواء فلا تتخذوا منهم أولياء حتى يهاجروا في سبيل الله فإن تولوا فخذوهم واقتلوهم حيث وجدتموهم ولا تتخذوا منهم وليا ولا نصيرا" />
-		<aya index="90" text="إلا الذين يصلون إلى قوم بينكم وبينهم ميثاق أو جاءوكم حصرت صدورهم أن يقاتلوكم أو يقاتلوا قومهم ولو شاء الله لسلطهم عليكم فلقاتلوكم فإن اعتزلوكم فلم يقاتلوكم وألقوا إليكم السلم فما جعل الله لكم عليهم سبيلا" />
-		<aya index="91" text="ستجدون آخرين يريدون أن يأمنوكم ويأمنوا قومهم كل ما ردوا إلى الفتنة أركسوا فيها فإن لم يعتزلوكم ويلقوا إليكم السلم ويكفوا أيديهم فخذوهم واقتلوهم حيث ثقفتموهم وأولئكم جعلنا لكم عليهم سلطانا مبينا" />
-		<aya index="92" text="وما كان لمؤمن أن يقتل مؤمنا إلا خطأ ومن قتل مؤمنا خطأ فتحرير رقبة مؤمنة ودية مسلمة إلى أهله إلا أن يصدقوا فإن كان من قوم عدو لكم وهو مؤمن فتحرير رقبة مؤمنة وإن كان من قوم بينكم وبينهم ميثاق فدية مسلمة إلى أهله وتحرير رقبة مؤمنة فمن لم يجد فصيام شهرين متتابعين توبة من الله وكان الله عليما حكيما" />
-		<aya index="93" text="ومن يقتل مؤمنا متعمدا فجزاؤه جهنم خالدا فيها وغضب الله عليه ولعنه وأعد له عذابا عظيما" />
-		<aya index="94" text="يا أيها الذين آمنوا إذا ضربتم في سبيل الله فتبينوا ولا تقولوا لمن ألقى إليكم السلام لست مؤمنا تبتغون عرض الحياة الدنيا فعند الله مغانم كثيرة كذلك كنتم من قبل فمن الله عليكم فتبينوا إن الله كان بما تعملون خبيرا" />
-		<aya index="95" text="لا يستوي القاعدون من المؤمنين غير أولي الضرر والمجاهدون في سبيل الله بأموالهم وأنفسهم فضل الله المجاهدين بأموالهم وأنفسهم على القاعدين درجة وكلا وعد الله الحسنى وفضل الله المجاهدين على القاعدين أجرا عظيما" />
-		<aya index="96" text="درجات منه ومغفرة ورحمة وكان الله غفورا رحيما" />
-		<aya index="97" text="إن الذين توفاهم الملائكة ظالمي أنفسهم قالوا فيم كنتم قالوا كنا مستضعفين في الأرض قالوا ألم تكن أرض الله واسعة فتهاجروا فيها فأولئك مأواهم جهنم وساءت مصيرا" />
-		<aya index="98" text="إلا المستضعفين من الرجال والنساء والولدان لا يستطيعون حيلة ولا يهتدون سبيلا" />
-		<aya index="99" text="فأولئك عسى الله أن يعفو عنهم وكان الله عفوا غفورا" />
-		<aya index="100" text="ومن يهاجر في سبيل الله يجد في الأرض مراغما كثيرا وسعة ومن يخرج من بيته مهاجرا إلى الله ورسوله ثم يدركه الموت فقد وقع أجره على الله وكان الله غفورا رحيما" />
-		<aya index="101" text="وإذا ضربتم في الأرض فليس عليكم جناح أن تقصروا من الصلاة إن خفتم أن يفتنكم الذين كفروا إن الكافرين كانوا لكم عدوا مبينا" />
-		<aya index="102" text="وإذا كنت فيهم فأقمت لهم الصلاة فلتقم طائفة منهم معك وليأخذوا أسلحتهم فإذا سجدوا فليكونوا من ورائكم ولتأت طائفة أخرى لم يصلوا فليصلوا معك وليأخذوا حذرهم وأسلحتهم ود الذين كفروا لو تغفلون عن أسلحتكم وأمتعتكم فيميلون عليكم ميلة واحدة ولا جناح عليكم إن كان بكم أذى من مطر أو كنتم مرضى أن تضعوا أسلحتكم وخذوا حذركم إن الله أعد للكافرين عذابا مهينا" />
-		<aya index="103" text="فإذا قضيتم الصلاة فاذكروا الله قياما وقعودا وعلى جنوبكم فإذا اطمأننتم فأقيموا الصلاة إن الصلاة كانت على المؤمنين كتابا موقوتا" />
-		<aya index="104" text="ولا تهنوا في ابتغاء القوم إن تكونوا تألمون فإنهم يألمون كما تألمون وترجون من الله ما لا يرجون وكان الله عليما حكيما" />
-		<aya index="105" text="إنا أنزلنا إليك الكتاب بالحق لتحكم بين الناس بما أراك الله ولا تكن للخائنين خصيما" />
-		<aya index="106" text="واستغفر الله إن الله كان غفورا رحيما" />
-		<aya index="107" text="ولا تجادل عن الذين يختانون أنفسهم إن الله لا يحب من كان خوانا أثيما" />
-		<aya index="108" text="يستخفون من الناس ولا يستخفون من الله وهو معهم إذ يبيتون ما لا يرضى من القول وكان الله بما يعملون محيطا" />
-		<aya index="109" text="ها أنتم هؤلاء جادلتم عنهم في الحياة الدنيا فمن يجادل الله عنهم يوم القيامة أم من يكون عليهم وكيلا" />
-		<aya index="110" text="ومن يعمل سوءا أو يظلم نفسه ثم يستغفر الله يجد الله غفورا رحيما" />
-		<aya index="111" text="ومن يكسب إثما فإنما يكسبه على نفسه وكان الله عليما حكيما" />
-		<aya index="112" text="ومن يكسب خطيئة أو إثما ثم يرم به بريئا فقد احتمل بهتانا وإثما مبينا" />
-		<aya index="113" text="ولولا فضل الله عليك ورحمته لهمت طائفة منهم أن يضلوك وما يضلون إلا أنفسهم وما يضرونك من شيء وأنزل الله عليك الكتاب والحكمة وعلمك ما لم تكن تعلم وكان فضل الله عليك عظيما" />
-		<aya index="114" text="لا خير في كثير من نجواهم إلا من أمر بصدقة أو معروف أو إصلاح بين الناس ومن يفعل ذلك ابتغاء مرضات الله فسوف نؤتيه أجرا عظيما" />
-		<aya index="115" text="ومن يشاقق الرسول من بعد ما تبين له الهدى ويتبع غير سبيل المؤمنين نوله ما تولى ونصله جهنم وساءت مصيرا" />
-		<aya index="116" text="إن الله لا يغفر أن يشرك به ويغفر ما دون ذلك لمن يشاء ومن يشرك بالله فقد ضل ضلالا بعيدا" />
-		<aya index="117" text="إن يدعون من دونه إلا إناثا وإن يدعون إلا شيطانا مريدا" />
-		<aya index="118" text="لعنه الله وقال لأتخذن من عبادك نصيبا مفروضا" />
-		<aya index="119" text="ولأضلنهم ولأمنينهم ولآمرنهم فليبتكن آذان الأنعام ولآمرنهم فليغيرن خلق الله ومن يتخذ الشيطان وليا من دون الله فقد خسر خسرانا مبينا" />
-		<aya index="120" text="يعدهم ويمنيهم وما يعدهم الشيطان إلا غرورا" />
-		<aya index="121" text="أولئك مأواهم جهنم ولا يجدون عنها محيصا" />
-		<aya index="122" text="والذين آمنوا وعملوا الصالحات سندخلهم جنات تجري من تحتها الأنهار خالدين فيها أبدا وعد الله حقا ومن أصدق من الله قيلا" />
-		<aya index="123" text="ليس بأمانيكم ولا أماني أهل الكتاب من يعمل سوءا يجز به ولا يجد له من دون الله وليا ولا نصيرا" />
-		<aya index="124" text="ومن يعمل من الصالحات من ذكر أو أنثى وهو مؤمن فأولئك يدخلون الجنة ولا يظلمون نقيرا" />
-		<aya index="125" text="ومن أحسن دينا ممن أسلم وجهه لله وهو محسن واتبع ملة إبراهيم حنيفا واتخذ الله إبراهيم خليلا" />
-		<aya index="126" text="ولله ما في السماوات وما في الأرض وكان الله بكل شيء محيطا" />
-		<aya index="127" text="ويستفتونك في النساء قل الله يفتيكم فيهن وما يتلى عليكم في الكتاب في يتامى النساء اللاتي لا تؤتونهن ما كتب لهن وترغبون أن تنكحوهن والمستضعفين من الولدان وأن تقوموا لليتامى بالقسط وما تفعلوا من خير فإن الله كان به عليما" />
-		<aya index="128" text="وإن امرأة خافت من بعلها نشوزا أو إعراضا فلا جناح عليهما أن يصلحا بينهما صلحا والصلح خير وأحضرت الأنفس الشح وإن تحسنوا وتتقوا فإن الله كان بما تعملون خبيرا" />
-		<aya index="129" text="ولن تستطيعوا أن تعدلوا بين النساء ولو حرصتم فلا تميلوا كل الميل فتذروها كالمعلقة وإن تصلحوا وتتقوا فإن الله كان غفورا رحيما" />
-		<aya index="130" text="وإن يتفرقا يغن الله كلا من سعته وكان الله واسعا حكيما" />
-		<aya index="131" text="ولله ما في السماوات وما في الأرض ولقد وصينا الذين أوتوا الكتاب من قبلكم وإياكم أن اتقوا الله وإن تكفروا فإن لله ما في السماوات وما في الأرض وكان الله غنيا حميدا" />
-		<aya index="132" text="ولله ما في السماوات وما في الأرض وكفى بالله وكيلا" />
-		<aya index="133" text="إن يشأ يذهبكم أيها الناس ويأت بآخرين وكان الله على ذلك قديرا" />
-		<aya index="134" text="من كان يريد ثواب الدنيا فعند الله ثواب الدنيا والآخرة وكان الله سميعا بصيرا" />
-		<aya index="135" text="يا أيها الذين آمنوا كونوا قوامين بالقسط شهداء لله ولو على أنفسكم أو الوالدين والأقربين إن يكن غنيا أو فقيرا فالله أولى بهما فلا تتبعوا الهوى أن تعدلوا وإن تلووا أو تعرضوا فإن الله كان بما تعملون خبيرا" />
-		<aya index="136" text="يا أيها الذين آمنوا آمنوا بالله ورسوله والكتاب الذي نزل على رسوله والكتاب الذي أنزل من قبل ومن يكفر بالله وملائكته وكتبه ورسله واليوم الآخر فقد ضل ضلالا بعيدا" />
-		<aya index="137" text="إن الذين آمنوا ثم كفروا ثم آمنوا ثم كفروا ثم ازدادوا كفرا لم يكن الله ليغفر لهم ولا ليهديهم سبيلا" />
-		<aya index="138" text="بشر المنافقين بأن لهم عذابا أليما" />
-		<aya index="139" text="الذين يتخذون الكافرين أولياء من دون المؤمنين أيبتغون عندهم العزة فإن العزة لله جميعا" />
-		<aya index="140" text="وقد نزل عليكم في الكتاب أن إذا سمعتم آيات الله يكفر بها ويستهزأ بها فلا تقعدوا معهم حتى يخوضوا في حديث غيره إنكم إذا مثلهم إن الله جامع المنافقين والكافرين في جهنم جميعا" />
-		<aya index="141" text="الذين يتربصون بكم فإن كان لكم فتح من الله قالوا ألم نكن معكم وإن كان للكافرين نصيب قالوا ألم نستحوذ عليكم ونمنعكم من المؤمنين فالله يحكم بينكم يوم القيامة ولن يجعل الله للكافرين على المؤمنين سبيلا" />
-		<aya index="142" text="إن المنافقين يخادعون الله وهو خادعهم وإذا قاموا إلى الصلاة قاموا كسالى يراءون الناس ولا يذكرون الله إلا قليلا" />
-		<aya index="143" text="مذبذبين بين ذلك لا إلى هؤلاء ولا إلى هؤلاء ومن يضلل الله فلن تجد له سبيلا" />
-		<aya index="144" text="يا أيها الذين آمنوا لا تتخذوا الكافرين أولياء من دون المؤمنين أتريدون أن تجعلوا لله عليكم سلطانا مبينا" />
-		<aya index="145" text="إن المنافقين في الدرك الأسفل من النار ولن تجد لهم نصيرا" />
-		<aya index="146" text="إلا الذين تابوا وأصلحوا واعتصموا بالله وأخلصوا دينهم لله فأولئك مع المؤمنين وسوف يؤت الله المؤمنين أجرا عظيما" />
-		<aya index="147" text="ما يفعل الله بعذابكم إن شكرتم وآمنتم وكان الله شاكرا عليما" />
-		<aya index="148" text="لا يحب الله الجهر بالسوء من القول إلا من ظلم وكان الله سميعا عليما" />
-		<aya index="149" text="إن تبدوا خيرا أو تخفوه أو تعفوا عن سوء فإن الله كان عفوا قديرا" />
-		<aya index="150" text="إن الذين يكفرون بالله ورسله ويريدون أن يفرقوا بين الله ورسله ويقولون نؤمن ببعض ونكفر ببعض ويريدون أن يتخذوا بين ذلك سبيلا" />
-		<aya index="151" text="أولئك هم الكافرون حقا وأعتدنا للكافرين عذابا مهينا" />
-		<aya index="152" text="والذين آمنوا بالله ورسله ولم يفرقوا بين أحد منهم أولئك سوف يؤتيهم أجورهم وكان الله غفورا رحيما" />
-		<aya index="153" text="يسألك أهل الكتاب أن تنزل عليهم كتابا من السماء فقد سألوا موسى أكبر من ذلك فقالوا أرنا الله جهرة فأخذتهم الصاعقة بظلمهم ثم اتخذوا العجل من بعد ما جاءتهم البينات فعفونا عن ذلك وآتينا موسى سلطانا مبينا" />
-		<aya index="154" text="ورفعنا فوقهم الطور بميثاقهم وقلنا لهم ادخلوا الباب سجدا وقلنا لهم لا تعدوا في السبت وأخذنا منهم ميثاقا غليظا" />
-		<aya index="155" text="فبما نقضهم ميثاقهم وكفرهم بآيات الله وقتلهم الأنبياء بغير حق وقولهم قلوبنا غلف بل طبع الله عليها بكفرهم فلا يؤمنون إلا قليلا" />
-		<aya index="156" text="وبكفرهم وقولهم على مريم بهتانا عظيما" />
-		<aya index="157" text="وقولهم إنا قتلنا المسيح عيسى ابن مريم رسول الله وما قتلوه وما صلبوه ولكن شبه لهم وإن الذين اختلفوا فيه لفي شك منه ما لهم به من علم إلا اتباع الظن وما قتلوه يقينا" />
-		<aya index="158" text="بل رفعه الله إليه وكان الله عزيزا حكيما" />
-		<aya index="159" text="وإن من أهل الكتاب إلا ليؤمنن به قبل موته ويوم القيامة يكون عليهم شهيدا" />
-		<aya index="160" text="فبظلم من الذين هادوا حرمنا عليهم طيبات أحلت لهم وبصدهم عن سبيل الله كثيرا" />
-		<aya index="161" text="وأخذهم الربا وقد نهوا عنه وأكلهم أموال الناس بالباطل وأعتدنا للكافرين منهم عذابا أليما" />
-		<aya index="162" text="لكن الراسخون في العلم منهم والمؤمنون يؤمنون بما أنزل إليك وما أنزل من قبلك والمقيمين الصلاة والمؤتون الزكاة والمؤمنون بالله واليوم الآخر أولئك سنؤتيهم أجرا عظيما" />
-		<aya index="163" text="إنا أوحينا إليك كما أوحينا إلى نوح والنبيين من بعده وأوحينا إلى إبراهيم وإسماعيل وإسحاق ويعقوب والأسباط وعيسى وأيوب ويونس وهارون وسليمان وآتينا داوود زبورا" />
-		<aya index="164" text="ورسلا قد قصصناهم عليك من قبل ورسلا لم نقصصهم عليك وكلم الله موسى تكليما" />
-		<aya index="165" text="رسلا مبشرين ومنذرين لئلا يكون للناس على الله حجة بعد الرسل وكان الله عزيزا حكيما" />
-		<aya index="166" text="لكن الله يشهد بما أنزل إليك أنزله بعلمه والملائكة يشهدون وكفى بالله شهيدا" />
-		<aya index="167" text="إن الذين كفروا وصدوا عن سبيل الله قد ضلوا ضلالا بعيدا" />
-		<aya index="168" text="إن الذين كفروا وظلموا لم يكن الله ليغفر لهم ولا ليهديهم طريقا" />
-		<aya index="169" text="إلا طريق جهنم خالدين فيها أبدا وكان ذلك على الله يسيرا" />
-		<aya index="170" text="يا أيها الناس قد جاءكم الرسول بالحق من ربكم فآمنوا خيرا لكم وإن تكفروا فإن لله ما في السماوات والأرض وكان الله عليما حكيما" />
-		<aya index="171" text="يا أهل الكتاب لا تغلوا في دينكم ولا تقولوا على الله إلا الحق إنما المسيح عيسى ابن مريم رسول الله وكلمته ألقاها إلى مريم وروح منه فآمنوا بالله ورسله ولا تقولوا ثلاثة انتهوا خيرا لكم إنما الله إله واحد سبحانه أن يكون له ولد له ما في السماوات وما في الأرض وكفى بالله وكيلا" />
-		<aya index="172" text="لن يستنكف المسيح أن يكون عبدا لله ولا الملائكة المقربون ومن يستنكف عن عبادته ويستكبر فسيحشرهم إليه جميعا" />
-		<aya index="173" text="فأما الذين آمنوا وعملوا الصالحات فيوفيهم أجورهم ويزيدهم من فضله وأما الذين استنكفوا واستكبروا فيعذبهم عذابا أليما ولا يجدون لهم من دون الله وليا ولا نصيرا" />
-		<aya index="174" text="يا أيها الناس قد جاءكم برهان من ربكم وأنزلنا إليكم نورا مبينا" />
-		<aya index="175" text="فأما الذين آمنوا بالله واعتصموا به فسيدخلهم في رحمة منه وفضل ويهديهم إليه صراطا مستقيما" />
-		<aya index="176" text="يستفتونك قل الله يفتيكم في الكلالة إن امرؤ هلك ليس له ولد وله أخت فلها نصف ما ترك وهو يرثها إن لم يكن لها ولد فإن كانتا اثنتين فلهما الثلثان مما ترك وإن كانوا إخوة رجالا ونساء فللذكر مثل حظ الأنثيين يبين الله لكم أن تضلوا والله بكل شيء عليم" />
-	</sura>
-	<sura index="5" name="المائدة">
-		<aya index="1" text="يا أيها الذين آمنوا أوفوا بالعقود أحلت لكم بهيمة الأنعام إلا ما يتلى عليكم غير محلي الصيد وأنتم حرم إن الله يحكم ما يريد" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="يا أيها الذين آمنوا لا تحلوا شعائر الله ولا الشهر الحرام ولا الهدي ولا القلائد ولا آمين البيت الحرام يبتغون فضلا من ربهم ورضوانا وإذا حللتم فاصطادوا ولا يجرمنكم شنآن قوم أن صدوكم عن المسجد الحرام أن تعتدوا وتعاونوا على البر والتقوى ولا تعاونوا على الإثم والعدوان واتقوا الله إن الله شديد العقاب" />
-		<aya index="3" text="حرمت عليكم الميتة والدم ولحم الخنزير وما أهل لغير الله به والمنخنقة والموقوذة والمتردية والنطيحة وما أكل السبع إلا ما ذكيتم وما ذبح على النصب وأن تستقسموا بالأزلام ذلكم فسق اليوم يئس الذين كفروا من دينكم فلا تخشوهم واخشون اليوم أكملت لكم دينكم وأتممت عليكم نعمتي ورضيت لكم الإسلام دينا فمن اضطر في مخمصة غير متجانف لإثم فإن الله غفور رحيم" />
-		<aya index="4" text="يسألونك ماذا أحل لهم قل أحل لكم الطيبات وما علمتم من الجوارح مكلبين تعلمونهن مما علمكم الله فكلوا مما أمسكن عليكم واذكروا اسم الله عليه واتقوا الله إن الله سريع الحساب" />
-		<aya index="5" text="اليوم أحل لكم الطيبات وطعام الذين أوتوا الكتاب حل لكم وطعامكم حل لهم والمحصنات من المؤمنات والمحصنات من الذين أوتوا الكتاب من قبلكم إذا آتيتموهن أجورهن محصنين غير مسافحين ولا متخذي أخدان ومن يكفر بالإيمان فقد حبط عمله وهو في الآخرة من الخاسرين" />
-		<aya index="6" text="يا أيها الذين آمنوا إذا قمتم إلى الصلاة فاغسلوا وجوهكم وأيديكم إلى المرافق وامسحوا برءوسكم وأرجلكم إلى الكعبين وإن كنتم جنبا فاطهروا وإن كنتم مرضى أو على سفر أو جاء أحد منكم من الغائط أو لامستم النساء فلم تجدوا ماء فتيمموا صعيدا طيبا فامسحوا بوجوهكم وأيديكم منه ما يريد الله ليجعل عليكم من حرج ولكن يريد ليطهركم وليتم نعمته عليكم لعلكم تشكرون" />
-		<aya index="7" text="واذكروا نعمة الله عليكم وميثاقه الذي واثقكم به إذ قلتم سمعنا وأطعنا واتقوا الله إن الله عليم بذات الصدور" />
-		<aya index="8" text="يا أيها الذين آمنوا كونوا قوامين لله شهداء بالقسط ولا يجرمنكم شنآن قوم على ألا تعدلوا اعدلوا هو أقرب للتقوى واتقوا الله إن الله خبير بما تعملون" />
-		<aya index="9" text="وعد الله الذين آمنوا وعملوا الصالحات لهم مغفرة وأجر عظيم" />
-		<aya index="10" text="والذين كفروا وكذبوا بآياتنا أولئك أصحاب الجحيم" />
-		<aya index="11" text="يا أيها الذين آمنوا اذكروا نعمت الله عليكم إذ هم قوم أن يبسطوا إليكم أيديهم فكف أيديهم عنكم واتقوا الله وعلى الله فليتوكل المؤمنون" />
-		<aya index="12" text="ولقد أخذ الله ميثاق بني إسرائيل وبعثنا منهم اثني عشر نقيبا وقال الله إني معكم لئن أقمتم الصلاة وآتيتم الزكاة وآمنتم برسلي وعزرتموهم وأقرضتم الله قرضا حسنا لأكفرن عنكم سيئاتكم ولأدخلنكم جنات تجري من تحتها الأنهار فمن كفر بعد ذلك منكم فقد ضل سواء السبيل" />
-		<aya index="13" text="فبما نقضهم ميثاقهم لعناهم وجعلنا قلوبهم قاسية يحرفون الكلم عن مواضعه ونسوا حظا مما ذكروا به ولا تزال تطلع على خائنة منهم إلا قليلا منهم فاعف عنهم واصفح إن الله يحب المحسنين" />
-		<aya index="14" text="ومن الذين قالوا إنا نصارى أخذنا ميثاقهم فنسوا حظا مما ذكروا به فأغرينا بينهم العداوة والبغضاء إلى يوم القيامة وسوف ينبئهم الله بما كانوا يصنعون" />
-		<aya index="15" text="يا أهل الكتاب قد جاءكم رسولنا يبين لكم كثيرا مما كنتم تخفون من الكتاب ويعفو عن كثير قد جاءكم من الله نور وكتاب مبين" />
-		<aya index="16" text="يهدي به الله من اتبع رضوانه سبل السلام ويخرجهم من الظلمات إلى النور بإذنه ويهديهم إلى صراط مستقيم" />
-		<aya index="17" text="لقد كفر الذين قالوا إن الله هو المسيح ابن مريم قل فمن يملك من الله شيئا إن أراد أن يهلك المسيح ابن مريم وأمه ومن في الأرض جميعا ولله ملك السماوات والأرض وما بينهما يخلق ما يشاء والله على كل شيء قدير" />
-		<aya index="18" text="وقالت اليهود والنصارى نحن أبناء الله وأحباؤه قل فلم يعذبكم بذنوبكم بل أنتم بشر ممن خلق يغفر لمن يشاء ويعذب من يشاء ولله ملك السماوات والأرض وما بينهما وإليه المصير" />
-		<aya index="19" text="يا أهل الكتاب قد جاءكم رسولنا يبين لكم على فترة من الرسل أن تقولوا ما جاءنا من بشير ولا نذير فقد جاءكم بشير ونذير والله على كل شيء قدير" />
-		<aya index="20" text="وإذ قال موسى لقومه يا قوم اذكروا نعمة الله عليكم إذ جعل فيكم أنبياء وجعلكم ملوكا وآتاكم ما لم يؤت أحدا من العالمين" />
-		<aya index="21" text="يا قوم ادخلوا الأرض المقدسة التي كتب الله لكم ولا ترتدوا على أدباركم فتنقلبوا خاسرين" />
-		<aya index="22" text="قالوا يا موسى إن فيها قوما جبارين وإنا لن ندخلها حتى يخرجوا منها فإن يخرجوا منها فإنا داخلون" />
-		<aya index="23" text="قال رجلان من الذين يخافون أنعم الله عليهما ادخلوا عليهم الباب فإذا دخلتموه فإنكم غالبون وعلى الله فتوكلوا إن كنتم مؤمنين" />
-		<aya index="24" text="قالوا يا موسى إنا لن ندخلها أبدا ما داموا فيها فاذهب أنت وربك فقاتلا إنا هاهنا قاعدون" />
-		<aya index="25" text="قال رب إني لا أملك إلا نفسي وأخي فافرق بيننا وبين القوم الفاسقين" />
-		<aya index="26" text="قال فإنها محرمة عليهم أربعين سنة يتيهون في الأرض فلا تأس على القوم الفاسقين" />
-		<aya index="27" text="واتل عليهم نبأ ابني آدم بالحق إذ قربا قربانا فتقبل من أحدهما ولم يتقبل من الآخر قال لأقتلنك قال إنما يتقبل الله من المتقين" />
-		<aya index="28" text="لئن بسطت إلي يدك لتقتلني ما أنا بباسط يدي إليك لأقتلك إني أخاف الله رب العالمين" />
-		<aya index="29" text="إني أريد أن تبوء بإثمي وإثمك فتكون من أصحاب النار وذلك جزاء الظالمين" />
-		<aya index="30" text="فطوعت له نفسه قتل أخيه فقتله فأصبح من الخاسرين" />
-		<aya index="31" text="فبعث الله غرابا يبحث في الأرض ليريه كيف يواري سوءة أخيه قال يا ويلتا أعجزت أن أكون مثل هذا الغراب فأواري سوءة أخي فأصبح من النادمين" />
-		<aya index="32" text="من أجل ذلك كتبنا على بني إسرائيل أنه من قتل نفسا بغير نفس أو فساد في الأرض فكأنما قتل الناس جميعا ومن أحياها فكأنما أحيا الناس جميعا ولقد جاءتهم رسلنا بالبينات ثم إن كثيرا منهم بعد ذلك في الأرض لمسرفون" />
-		<aya index="33" text="إنما جزاء الذين يحاربون الله ورسوله ويسعون في الأرض فسادا أن يقتلوا أو يصلبوا أو تقطع أيديهم وأرجلهم من خلاف أو ينفوا من الأرض ذلك لهم خزي في الدنيا ولهم في الآخرة عذاب عظيم" />
-		<aya index="34" text="إلا الذين تابوا من قبل أن تقدروا عليهم فاعلموا أن الله غفور رحيم" />
-		<aya index="35" text="يا أيها الذين آمنوا اتقوا الله وابتغوا إليه الوسيلة وجاهدوا في سبيله لعلكم تفلحون" />
-		<aya index="36" text="إن الذين كفروا لو أن لهم ما في الأرض جميعا ومثله معه ليفتدوا به من عذاب يوم القيامة ما تقبل منهم ولهم عذاب أليم" />
-		<aya index="37" text="يريدون أن يخرجوا من النار وما هم بخارجين منها ولهم عذاب مقيم" />
-		<aya index="38" text="والسارق والسارقة فاقطعوا أيديهما جزاء بما كسبا نكالا من الله والله عزيز حكيم" />
-		<aya index="39" text="فمن تاب من بعد ظلمه وأصلح فإن الله يتوب عليه إن الله غفور رحيم" />
-		<aya index="40" text="ألم تعلم أن الله له ملك السماوات والأرض يعذب من يشاء ويغفر لمن يشاء والله على كل شيء قدير" />
-		<aya index="41" text="يا أيها الرسول لا يحزنك الذين يسارعون في الكفر من الذين قالوا آمنا بأفواههم ولم تؤمن قلوبهم ومن الذين هادوا سماعون للكذب سماعون لقوم آخرين لم يأتوك يحرفون الكلم من بعد مواضعه يقولون إن أوتيتم هذا فخذوه وإن لم تؤتوه فاحذروا ومن يرد الله فتنته فلن تملك له من الله شيئا أولئك الذين لم يرد الله أن يطهر قلوبهم لهم في الدنيا خزي ولهم في الآخرة عذاب عظيم" />
-		<aya index="42" text="سماعون للكذب أكالون للسحت فإن جاءوك فاحكم بينهم أو أعرض عنهم وإن تعرض عنهم فلن يضروك شيئا وإن حكمت فاحكم بينهم بالقسط إن الله يحب المقسطين" />
-		<aya index="43" text="وكيف يحكمونك وعندهم التوراة فيها حكم الله ثم يتولون من بعد ذلك وما أولئك بالمؤمنين" />
-		<aya index="44" text="إنا أنزلنا التوراة فيها هدى ونور يحكم بها النبيون الذين أسلموا للذين هادوا والربانيون والأحبار بما استحفظوا من كتاب الله وكانوا عليه شهداء فلا تخشوا الناس واخشون ولا تشتروا بآياتي ثمنا قليلا ومن لم يحكم بما أنزل الله فأولئك هم الكافرون" />
-		<aya index="45" text="وكتبنا عليهم فيها أن النفس بالنفس والعين بالعين والأنف بالأنف والأذن بالأذن والسن بالسن والجروح قصاص فمن تصدق به فهو كفارة له ومن لم يحكم بما أنزل الله فأولئك هم الظالمون" />
-		<aya index="46" text="وقفينا على آثارهم بعيسى ابن مريم مصدقا لما بين يديه من التوراة وآتيناه الإنجيل فيه هدى ونور ومصدقا لما بين يديه من التوراة وهدى وموعظة للمتقين" />
-		<aya index="47" text="وليحكم أهل الإنجيل بما أنزل الله فيه ومن لم يحكم بما أنزل الله فأولئك هم الفاسقون" />
-		<aya index="48" text="وأنزلنا إليك الكتاب بالحق مصدقا لما بين يديه من الكتاب ومهيمنا عليه فاحكم بينهم بما أنزل الله ولا تتبع أهواءهم عما جاءك من الحق لكل جعلنا منكم شرعة ومنهاجا ولو شاء الله لجعلكم أمة واحدة ولكن ليبلوكم في ما آتاكم فاستبقوا الخيرات إلى الله مرجعكم جميعا فينبئكم بما كنتم فيه تختلفون" />
-		<aya index="49" text="وأن احكم بينهم بما أنزل الله ولا تتبع أهواءهم واحذرهم أن يفتنوك عن بعض ما أنزل الله إليك فإن تولوا فاعلم أنما يريد الله أن يصيبهم ببعض ذنوبهم وإن كثيرا من الناس لفاسقون" />
-		<aya index="50" text="أفحكم الجاهلية يبغون ومن أحسن من الله حكما لقوم يوقنون" />
-		<aya index="51" text="يا أيها الذين آمنوا لا تتخذوا اليهود والنصارى أولياء بعضهم أولياء بعض ومن يتولهم منكم فإنه منهم إن الله لا يهدي القوم الظالمين" />
-		<aya index="52" text="فترى الذين في قلوبهم مرض يسارعون فيهم يقولون نخشى أن تصيبنا دائرة فعسى الله أن يأتي بالفتح أو أمر من عنده فيصبحوا على ما أسروا في أنفسهم نادمين" />
-		<aya index="53" text="ويقول الذين آمنوا أهؤلاء الذين أقسموا بالله جهد أيمانهم إنهم لمعكم حبطت أعمالهم فأصبحوا خاسرين" />
-		<aya index="54" text="يا أيها الذين آمنوا من يرتد منكم عن دينه فسوف يأتي الله بقوم يحبهم ويحبونه أذلة على المؤمنين أعزة على الكافرين يجاهدون في سبيل الله ولا يخافون لومة لائم ذلك فضل الله يؤتيه من يشاء والله واسع عليم" />
-		<aya index="55" text="إنما وليكم الله ورسوله والذين آمنوا الذين يقيمون الصلاة ويؤتون الزكاة وهم راكعون" />
-		<aya index="56" text="ومن يتول الله ورسوله والذين آمنوا فإن حزب الله هم الغالبون" />
-		<aya index="57" text="يا أيها الذين آمنوا لا تتخذوا الذين اتخذوا دينكم هزوا ولعبا من الذين أوتوا الكتاب من قبلكم والكفار أولياء واتقوا الله إن كنتم مؤمنين" />
-		<aya index="58" text="وإذا ناديتم إلى الصلاة اتخذوها هزوا ولعبا ذلك بأنهم قوم لا يعقلون" />
-		<aya index="59" text="قل يا أهل الكتاب هل تنقمون منا إلا أن آمنا بالله وما أنزل إلينا وما أنزل من قبل وأن أكثركم فاسقون" />
-		<aya index="60" text="قل هل أنبئكم بشر من ذلك مثوبة عند الله من لعنه الله وغضب عليه وجعل منهم القردة والخنازير وعبد الطاغوت أولئك شر مكانا وأضل عن سواء السبيل" />
-		<aya index="61" text="وإذا جاءوكم قالوا آمنا وقد دخلوا بالكفر وهم قد خرجوا به والله أعلم بما كانوا يكتمون" />
-		<aya index="62" text="وترى كثيرا منهم يسارعون في الإثم والعدوان وأكلهم السحت لبئس ما كانوا يعملون" />
-		<aya index="63" text="لولا ينهاهم الربانيون والأحبار عن قولهم الإثم وأكلهم السحت لبئس ما كانوا يصنعون" />
-		<aya index="64" text="وقالت اليهود يد الله مغلولة غلت أيديهم ولعنوا بما قالوا بل يداه مبسوطتان ينفق كيف يشاء وليزيدن كثيرا منهم ما أنزل إليك من ربك طغيانا وكفرا وألقينا بينهم العداوة والبغضاء إلى يوم القيامة كلما أوقدوا نارا للحرب أطفأها الله ويسعون في الأرض فسادا والله لا يحب المفسدين" />
-		<aya index="65" text="ولو أن أهل الكتاب آمنوا واتقوا لكفرنا عنهم سيئاتهم ولأدخلناهم جنات النعيم" />
-		<aya index="66" text="ولو أنهم أقاموا التوراة والإنجيل وما أنزل إليهم من ربهم لأكلوا من فوقهم ومن تحت أرجلهم منهم أمة مقتصدة وكثير منهم ساء ما يعملون" />
-		<aya index="67" text="يا أيها الرسول بلغ ما أنزل إليك من ربك وإن لم تفعل فما بلغت رسالته والله يعصمك من الناس إن الله لا يهدي القوم الكافرين" />
-		<aya index="68" text="قل يا أهل الكتاب لستم على شيء حتى تقيموا التوراة والإنجيل وما أنزل إليكم من ربكم وليزيدن كثيرا منهم ما أنزل إليك من ربك طغيانا وكفرا فلا تأس على القوم الكافرين" />
-		<aya index="69" text="إن الذين آمنوا والذين هادوا والصابئون والنصارى من آمن بالله واليوم الآخر وعمل صالحا فلا خوف عليهم ولا هم يحزنون" />
-		<aya index="70" text="لقد أخذنا ميثاق بني إسرائيل وأرسلنا إليهم رسلا كلما جاءهم رسول بما لا تهوى أنفسهم فريقا كذبوا وفريقا يقتلون" />
-		<aya index="71" text="وحسبوا ألا تكون فتنة فعموا وصموا ثم تاب الله عليهم ثم عموا وصموا كثير منهم والله بصير بما يعملون" />
-		<aya index="72" text="لقد كفر الذين قالوا إن الله هو المسيح ابن مريم وقال المسيح يا بني إسرائيل اعبدوا الله ربي وربكم إنه من يشرك بالله فقد حرم الله عليه الجنة ومأواه النار وما للظالمين من أنصار" />
-		<aya index="73" text="لقد كفر الذين قالوا إن الله ثالث ثلاثة وما من إله إلا إله واحد وإن لم ينتهوا عما يقولون ليمسن الذين كفروا منهم عذاب أليم" />
-		<aya index="74" text="أفلا يتوبون إلى الله ويستغفرونه والله غفور رحيم" />
-		<aya index="75" text="ما المسيح ابن مريم إلا رسول قد خلت من قبله الرسل وأمه صديقة كانا يأكلان الطعام انظر كيف نبين لهم الآيات ثم انظر أنى يؤفكون" />
-		<aya index="76" text="قل أتعبدون من دون الله ما لا يملك لكم ضرا ولا نفعا والله هو السميع العليم" />
-		<aya index="77" text="قل يا أهل الكتاب لا تغلوا في دينكم غير الحق ولا تتبعوا أهواء قوم قد ضلوا من قبل وأضلوا كثيرا وضلوا عن سواء السبيل" />
-		<aya index="78" text="لعن الذين كفروا من بني إسرائيل على لسان داوود وعيسى ابن مريم ذلك بما عصوا وكانوا يعتدون" />
-		<aya index="79" text="كانوا لا يتناهون عن منكر فعلوه لبئس ما كانوا يفعلون" />
-		<aya index="80" text="ترى كثيرا منهم يتولون الذين كفروا لبئس ما قدمت لهم أنفسهم أن سخط الله عليهم وفي العذاب هم خالدون" />
-		<aya index="81" text="ولو كانوا يؤمنون بالله والنبي وما أنزل إليه ما اتخذوهم أولياء ولكن كثيرا منهم فاسقون" />
-		<aya index="82" text="لتجدن أشد الناس عداوة للذين آمنوا اليهود والذين أشركوا ولتجدن أقربهم مودة للذين آمنوا الذين قالوا إنا نصارى ذلك بأن منهم قسيسين ورهبانا وأنهم لا يستكبرون" />
-		<aya index="83" text="وإذا سمعوا ما أنزل إلى الرسول ترى أعينهم تفيض من الدمع مما عرفوا من الحق يقولون ربنا آمنا فاكتبنا مع الشاهدين" />
-		<aya index="84" text="وما لنا لا نؤمن بالله وما جاءنا من الحق ونطمع أن يدخلنا ربنا مع القوم الصالحين" />
-		<aya index="85" text="فأثابهم الله بما قالوا جنات تجري من تحتها الأنهار خالدين فيها وذلك جزاء المحسنين" />
-		<aya index="86" text="والذين كفروا وكذبوا بآياتنا أولئك أصحاب الجحيم" />
-		<aya index="87" text="يا أيها الذين آمنوا لا تحرموا طيبات ما أحل الله لكم ولا تعتدوا إن الله لا يحب المعتدين" />
-		<aya index="88" text="وكلوا مما رزقكم الله حلالا طيبا واتقوا الله الذي أنتم به مؤمنون" />
-		<aya index="89" text="لا يؤاخذكم الله باللغو في أيمانكم ولكن يؤاخذكم بما عقدتم الأيمان فكفارته إطعام عشرة مساكين من أوسط ما تطعمون أهليكم أو كسوتهم أو تحرير رقبة فمن لم يجد فصيام ثلاثة أيام ذلك كفارة أيمانكم إذا حلفتم واحفظوا أيمانكم كذلك يبين الله لكم آياته لعلكم تشكرون" />
-		<aya index="90" text="يا أيها الذين آمنوا إنما الخمر والميسر والأنصاب والأزلام رجس من عمل الشيطان فاجتنبوه لعلكم تفلحون" />
-		<aya index="91" text="إنما يريد الشيطان أن يوقع بينكم العداوة والبغضاء في الخمر والميسر ويصدكم عن ذكر الله وعن الصلاة فهل أنتم منتهون" />
-		<aya index="92" text="وأطيعوا الله وأطيعوا الرسول واحذروا فإن توليتم فاعلموا أنما على رسولنا البلاغ المبين" />
-		<aya index="93" text="ليس على الذين آمنوا وعملوا الصالحات جناح فيما طعموا إذا ما اتقوا وآمنوا وعملوا الصالحات ثم اتقوا وآمنوا ثم اتقوا وأحسنوا والله يحب المحسنين" />
-		<aya index="94" text="يا أيها الذين آمنوا ليبلونكم الله بشيء من الصيد تناله أيديكم ورماحكم ليعلم الله من يخافه بالغيب فمن اعتدى بعد ذلك فله عذاب أليم" />
-		<aya index="95" text="يا أيها الذين آمنوا لا تقتلوا الصيد وأنتم حرم ومن قتله منكم متعمدا فجزاء مثل ما قتل من النعم يحكم به ذوا عدل منكم هديا بالغ الكعبة أو كفارة طعام مساكين أو عدل ذلك صياما ليذوق وبال أمره عفا الله عما سلف ومن عاد فينتقم الله منه والله عزيز ذو انتقام" />
-		<aya index="96" text="أحل لكم صيد البحر وطعامه متاعا لكم وللسيارة وحرم عليكم صيد البر ما دمتم حرما واتقوا الله الذي إليه تحشرون" />
-		<aya index="97" text="جعل الله الكعبة البيت الحرام قياما للناس والشهر الحرام والهدي والقلائد ذلك لتعلموا أن الله يعلم ما في السماوات وما في الأرض وأن الله بكل شيء عليم" />
-		<aya index="98" text="اعلموا أن الله شديد العقاب وأن الله غفور رحيم" />
-		<aya index="99" text="ما على الرسول إلا البلاغ والله يعلم ما تبدون وما تكتمون" />
-		<aya index="100" text="قل لا يستوي الخبيث والطيب ولو أعجبك كثرة الخبيث فاتقوا الله يا أولي الألباب لعلكم تفلحون" />
-		<aya index="101" text="يا أيها الذين آمنوا لا تسألوا عن أشياء إن تبد لكم تسؤكم وإن تسألوا عنها حين ينزل القرآن تبد لكم عفا الله عنها والله غفور حليم" />
-		<aya index="102" text="قد سألها قوم من قبلكم ثم أصبحوا بها كافرين" />
-		<aya index="103" text="ما جعل الله من بحيرة ولا سائبة ولا وصيلة ولا حام ولكن الذين كفروا يفترون على الله الكذب وأكثرهم لا يعقلون" />
-		<aya index="104" text="وإذا قيل لهم تعالوا إلى ما أنزل الله وإلى الرسول قالوا حسبنا ما وجدنا عليه آباءنا أولو كان آباؤهم لا يعلمون شيئا ولا يهتدون" />
-		<aya index="105" text="يا أيها الذين آمنوا عليكم أنفسكم لا يضركم من ضل إذا اهتديتم إلى الله مرجعكم جميعا فينبئكم بما كنتم تعملون" />
-		<aya index="106" text="يا أيها الذين آمنوا شهادة بينكم إذا حضر أحدكم الموت حين الوصية اثنان ذوا عدل منكم أو آخران من غيركم إن أنتم ضربتم في الأرض فأصابتكم مصيبة الموت تحبسونهما من بعد الصلاة فيقسمان بالله إن ارتبتم لا نشتري به ثمنا ولو كان ذا قربى ولا نكتم شهادة الله إنا إذا لمن الآثمين" />
-		<aya index="107" text="فإن عثر على أنهما استحقا إثما فآخران يقومان مقامهما من الذين استحق عليهم الأوليان فيقسمان بالله لشهادتنا أحق من شهادتهما وما اعتدينا إنا إذا لمن الظالمين" />
-		<aya index="108" text="ذلك أدنى أن يأتوا بالشهادة على وجهها أو يخافوا أن ترد أيمان بعد أيمانهم واتقوا الله واسمعوا والله لا يهدي القوم الفاسقين" />
-		<aya index="109" text="يوم يجمع الله الرسل فيقول ماذا أجبتم قالوا لا علم لنا إنك أنت علام الغيوب" />
-		<aya index="110" text="إذ قال الله يا عيسى ابن مريم اذكر نعمتي عليك وعلى والدتك إذ أيدتك بروح القدس تكلم الناس في المهد وكهلا وإذ علمتك الكتاب والحكمة والتوراة والإنجيل وإذ تخلق من الطين كهيئة الطير بإذني فتنفخ فيها فتكون طيرا بإذني وتبرئ الأكمه والأبرص بإذني وإذ تخرج الموتى بإذني وإذ كففت بني إسرائيل عنك إذ جئتهم بالبينات فقال الذين كفروا منهم إن هذا إلا سحر مبين" />
-		<aya index="111" text="وإذ أوحيت إلى الحواريين أن آمنوا بي وبرسولي قالوا آمنا واشهد بأننا مسلمون" />
-		<aya index="112" text="إذ قال الحواريون يا عيسى ابن مريم هل يستطيع ربك أن ينزل علينا مائدة من السماء قال اتقوا الله إن كنتم مؤمنين" />
-		<aya index="113" text="قالوا نريد أن نأكل منها وتطمئن قلوبنا ونعلم أن قد صدقتنا ونكون عليها من الشاهدين" />
-		<aya index="114" text="قال عيسى ابن مريم اللهم ربنا أنزل علينا مائدة من السماء تكون لنا عيدا لأولنا وآخرنا وآية منك وارزقنا وأنت خير الرازقين" />
-		<aya index="115" text="قال الله إني منزلها عليكم فمن يكفر بعد منكم فإني أعذبه عذابا لا أعذبه أحدا من العالمين" />
-		<aya index="116" text="وإذ قال الله يا عيسى ابن مريم أأنت قلت للناس اتخذوني وأمي إلهين من دون الله قال سبحانك ما يكون لي أن أقول ما ليس لي بحق إن كنت قلته فقد علمته تعلم ما في نفسي ولا أعلم ما في نفسك إنك أنت علام الغيوب" />
-		<aya index="117" text="ما قلت لهم إلا ما أمرتني به أن اعبدوا الله ربي وربكم وكنت عليهم شهيدا ما دمت فيهم فلما توفيتني كنت أنت الرقيب عليهم وأنت على كل شيء شهيد" />
-		<aya index="118" text="إن تعذبهم فإنهم عبادك وإن تغفر لهم فإنك أنت العزيز الحكيم" />
-		<aya index="119" text="قال الله هذا يوم ينفع الصادقين صدقهم لهم جنات تجري من تحتها الأنهار خالدين فيها أبدا رضي الله عنهم ورضوا عنه ذلك الفوز العظيم" />
-		<aya index="120" text="لله ملك السماوات والأرض وما فيهن وهو على كل شيء قدير" />
-	</sura>
-	<sura index="6" name="الأنعام">
-		<aya index="1" text="الحمد لله الذي خلق السماوات والأرض وجعل الظلمات والنور ثم الذين كفروا بربهم يعدلون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="هو الذي خلقكم من طين ثم قضى أجلا وأجل مسمى عنده ثم أنتم تمترون" />
-		<aya index="3" text="وهو الله في السماوات وفي الأرض يعلم سركم وجهركم ويعلم ما تكسبون" />
-		<aya index="4" text="وما تأتيهم من آية من آيات ربهم إلا كانوا عنها معرضين" />
-		<aya index="5" text="فقد كذبوا بالحق لما جاءهم فسوف يأتيهم أنباء ما كانوا به يستهزئون" />
-		<aya index="6" text="ألم يروا كم أهلكنا من قبلهم من قرن مكناهم في الأرض ما لم نمكن لكم وأرسلنا السماء عليهم مدرارا وجعلنا الأنهار تجري من تحتهم فأهلكناهم بذنوبهم وأنشأنا من بعدهم قرنا آخرين" />
-		<aya index="7" text="ولو نزلنا عليك كتابا في قرطاس فلمسوه بأيديهم لقال الذين كفروا إن هذا إلا سحر مبين" />
-		<aya index="8" text="وقالوا لولا أنزل عليه ملك ولو أنزلنا ملكا لقضي الأمر ثم لا ينظرون" />
-		<aya index="9" text="ولو جعلناه ملكا لجعلناه رجلا وللبسنا عليهم ما يلبسون" />
-		<aya index="10" text="ولقد استهزئ برسل من قبلك فحاق بالذين سخروا منهم ما كانوا به يستهزئون" />
-		<aya index="11" text="قل سيروا في الأرض ثم انظروا كيف كان عاقبة المكذبين" />
-		<aya index="12" text="قل لمن ما في السماوات والأرض قل لله كتب على نفسه الرحمة ليجمعنكم إلى يوم القيامة لا ريب فيه الذين خسروا أنفسهم فهم لا يؤمنون" />
-		<aya index="13" text="وله ما سكن في الليل والنهار وهو السميع العليم" />
-		<aya index="14" text="قل أغير الله أتخذ وليا فاطر السماوات والأرض وهو يطعم ولا يطعم قل إني أمرت أن أكون أول من أسلم ولا تكونن من المشركين" />
-		<aya index="15" text="قل إني أخاف إن عصيت ربي عذاب يوم عظيم" />
-		<aya index="16" text="من يصرف عنه يومئذ فقد رحمه وذلك الفوز المبين" />
-		<aya index="17" text="وإن يمسسك الله بضر فلا كاشف له إلا هو وإن يمسسك بخير فهو على كل شيء قدير" />
-		<aya index="18" text="وهو القاهر فوق عباده وهو الحكيم الخبير" />
-		<aya index="19" text="قل أي شيء أكبر شهادة قل الله شهيد بيني وبينكم وأوحي إلي هذا القرآن لأنذركم به ومن بلغ أئنكم لتشهدون أن مع الله آلهة أخرى قل لا أشهد قل إنما هو إله واحد وإنني بريء مما تشركون" />
-		<aya index="20" text="الذين آتيناهم الكتاب يعرفونه كما يعرفون أبناءهم الذين خسروا أنفسهم فهم لا يؤمنون" />
-		<aya index="21" text="ومن أظلم ممن افترى على الله كذبا أو كذب بآياته إنه لا يفلح الظالمون" />
-		<aya index="22" text="ويوم نحشرهم جميعا ثم نقول للذين أشركوا أين شركاؤكم الذين كنتم تزعمون" />
-		<aya index="23" text="ثم لم تكن فتنتهم إلا أن قالوا والله ربنا ما كنا مشركين" />
-		<aya index="24" text="انظر كيف كذبوا على أنفسهم وضل عنهم ما كانوا يفترون" />
-		<aya index="25" text="ومنهم من يستمع إليك وجعلنا على قلوبهم أكنة أن يفقهوه وفي آذانهم وقرا وإن يروا كل آية لا يؤمنوا بها حتى إذا جاءوك يجادلونك يقول الذين كفروا إن هذا إلا أساطير الأولين" />
-		<aya index="26" text="وهم ينهون عنه وينأون عنه وإن يهلكون إلا أنفسهم وما يشعرون" />
-		<aya index="27" text="ولو ترى إذ وقفوا على النار فقالوا يا ليتنا نرد ولا نكذب بآيات ربنا ونكون من المؤمنين" />
-		<aya index="28" text="بل بدا لهم ما كانوا يخفون من قبل ولو ردوا لعادوا لما نهوا عنه وإنهم لكاذبون" />
-		<aya index="29" text="وقالوا إن هي إلا حياتنا الدنيا وما نحن بمبعوثين" />
-		<aya index="30" text="ولو ترى إذ وقفوا على ربهم قال أليس هذا بالحق قالوا بلى وربنا قال فذوقوا العذاب بما كنتم تكفرون" />
-		<aya index="31" text="قد خسر الذين كذبوا بلقاء الله حتى إذا جاءتهم الساعة بغتة قالوا يا حسرتنا على ما فرطنا فيها وهم يحملون أوزارهم على ظهورهم ألا ساء ما يزرون" />
-		<aya index="32" text="وما الحياة الدنيا إلا لعب ولهو وللدار الآخرة خير للذين يتقون أفلا تعقلون" />
-		<aya index="33" text="قد نعلم إنه ليحزنك الذي يقولون فإنهم لا يكذبونك ولكن الظالمين بآيات الله يجحدون" />
-		<aya index="34" text="ولقد كذبت رسل من قبلك فصبروا على ما كذبوا وأوذوا حتى أتاهم نصرنا ولا مبدل لكلمات الله ولقد جاءك من نبإ المرسلين" />
-		<aya index="35" text="وإن كان كبر عليك إعراضهم فإن استطعت أن تبتغي نفقا في الأرض أو سلما في السماء فتأتيهم بآية ولو شاء الله لجمعهم على الهدى فلا تكونن من الجاهلين" />
-		<aya index="36" text="إنما يستجيب الذين يسمعون والموتى يبعثهم الله ثم إليه يرجعون" />
-		<aya index="37" text="وقالوا لولا نزل عليه آية من ربه قل إن الله قادر على أن ينزل آية ولكن أكثرهم لا يعلمون" />
-		<aya index="38" text="وما من دابة في الأرض ولا طائر يطير بجناحيه إلا أمم أمثالكم ما فرطنا في الكتاب من شيء ثم إلى ربهم يحشرون" />
-		<aya index="39" text="والذين كذبوا بآياتنا صم وبكم في الظلمات من يشإ الله يضلله ومن يشأ يجعله على صراط مستقيم" />
-		<aya index="40" text="قل أرأيتكم إن أتاكم عذاب الله أو أتتكم الساعة أغير الله تدعون إن كنتم صادقين" />
-		<aya index="41" text="بل إياه تدعون فيكشف ما تدعون إليه إن شاء وتنسون ما تشركون" />
-		<aya index="42" text="ولقد أرسلنا إلى أمم من قبلك فأخذناهم بالبأساء والضراء لعلهم يتضرعون" />
-		<aya index="43" text="فلولا إذ جاءهم بأسنا تضرعوا ولكن قست قلوبهم وزين لهم الشيطان ما كانوا يعملون" />
-		<aya index="44" text="فلما نسوا ما ذكروا به فتحنا عليهم أبواب كل شيء حتى إذا فرحوا بما أوتوا أخذناهم بغتة فإذا هم مبلسون" />
-		<aya index="45" text="فقطع دابر القوم الذين ظلموا والحمد لله رب العالمين" />
-		<aya index="46" text="قل أرأيتم إن أخذ الله سمعكم وأبصاركم وختم على قلوبكم من إله غير الله يأتيكم به انظر كيف نصرف الآيات ثم هم يصدفون" />
-		<aya index="47" text="قل أرأيتكم إن أتاكم عذاب الله بغتة أو جهرة هل يهلك إلا القوم الظالمون" />
-		<aya index="48" text="وما نرسل المرسلين إلا مبشرين ومنذرين فمن آمن وأصلح فلا خوف عليهم ولا هم يحزنون" />
-		<aya index="49" text="والذين كذبوا بآياتنا يمسهم العذاب بما كانوا يفسقون" />
-		<aya index="50" text="قل لا أقول لكم عندي خزائن الله ولا أعلم الغيب ولا أقول لكم إني ملك إن أتبع إلا ما يوحى إلي قل هل يستوي الأعمى والبصير أفلا تتفكرون" />
-		<aya index="51" text="وأنذر به الذين يخافون أن يحشروا إلى ربهم ليس لهم من دونه ولي ولا شفيع لعلهم يتقون" />
-		<aya index="52" text="ولا تطرد الذين يدعون ربهم بالغداة والعشي يريدون وجهه ما عليك من حسابهم من شيء وما من حسابك عليهم من شيء فتطردهم فتكون من الظالمين" />
-		<aya index="53" text="وكذلك فتنا بعضهم ببعض ليقولوا أهؤلاء من الله عليهم من بيننا أليس الله بأعلم بالشاكرين" />
-		<aya index="54" text="وإذا جاءك الذين يؤمنون بآياتنا فقل سلام عليكم كتب ربكم على نفسه الرحمة أنه من عمل منكم سوءا بجهالة ثم تاب من بعده وأصلح فأنه غفور رحيم" />
-		<aya index="55" text="وكذلك نفصل الآيات ولتستبين سبيل المجرمين" />
-		<aya index="56" text="قل إني نهيت أن أعبد الذين تدعون من دون الله قل لا أتبع أهواءكم قد ضللت إذا وما أنا من المهتدين" />
-		<aya index="57" text="قل إني على بينة من ربي وكذبتم به ما عندي ما تستعجلون به إن الحكم إلا لله يقص الحق وهو خير الفاصلين" />
-		<aya index="58" text="قل لو أن عندي ما تستعجلون به لقضي الأمر بيني وبينكم والله أعلم بالظالمين" />
-		<aya index="59" text="وعنده مفاتح الغيب لا يعلمها إلا هو ويعلم ما في البر والبحر وما تسقط من ورقة إلا يعلمها ولا حبة في ظلمات الأرض ولا رطب ولا يابس إلا في كتاب مبين" />
-		<aya index="60" text="وهو الذي يتوفاكم بالليل ويعلم ما جرحتم بالنهار ثم يبعثكم فيه ليقضى أجل مسمى ثم إليه مرجعكم ثم ينبئكم بما كنتم تعملون" />
-		<aya index="61" text="وهو القاهر فوق عباده ويرسل عليكم حفظة حتى إذا جاء أحدكم الموت توفته رسلنا وهم لا يفرطون" />
-		<aya index="62" text="ثم ردوا إلى الله مولاهم الحق ألا له الحكم وهو أسرع الحاسبين" />
-		<aya index="63" text="قل من ينجيكم من ظلمات البر والبحر تدعونه تضرعا وخفية لئن أنجانا من هذه لنكونن من الشاكرين" />
-		<aya index="64" text="قل الله ينجيكم منها ومن كل كرب ثم أنتم تشركون" />
-		<aya index="65" text="قل هو القادر على أن يبعث عليكم عذابا من فوقكم أو من تحت أرجلكم أو يلبسكم شيعا ويذيق بعضكم بأس بعض انظر كيف نصرف الآيات لعلهم يفقهون" />
-		<aya index="66" text="وكذب به قومك وهو الحق قل لست عليكم بوكيل" />
-		<aya index="67" text="لكل نبإ مستقر وسوف تعلمون" />
-		<aya index="68" text="وإذا رأيت الذين يخوضون في آياتنا فأعرض عنهم حتى يخوضوا في حديث غيره وإما ينسينك الشيطان فلا تقعد بعد الذكرى مع القوم الظالمين" />
-		<aya index="69" text="وما على الذين يتقون من حسابهم من شيء ولكن ذكرى لعلهم يتقون" />
-		<aya index="70" text="وذر الذين اتخذوا دينهم لعبا ولهوا وغرتهم الحياة الدنيا وذكر به أن تبسل نفس بما كسبت ليس لها من دون الله ولي ولا شفيع وإن تعدل كل عدل لا يؤخذ منها أولئك الذين أبسلوا بما كسبوا لهم شراب من حميم وعذاب أليم بما كانوا يكفرون" />
-		<aya index="71" text="قل أندعو من دون الله ما لا ينفعنا ولا يضرنا ونرد على أعقابنا بعد إذ هدانا الله كالذي استهوته الشياطين في الأرض حيران له أصحاب يدعونه إلى الهدى ائتنا قل إن هدى الله هو الهدى وأمرنا لنسلم لرب العالمين" />
-		<aya index="72" text="وأن أقيموا الصلاة واتقوه وهو الذي إليه تحشرون" />
-		<aya index="73" text="وهو الذي خلق السماوات والأرض بالحق ويوم يقول كن فيكون قوله الحق وله الملك يوم ينفخ في الصور عالم الغيب والشهادة وهو الحكيم الخبير" />
-		<aya index="74" text="وإذ قال إبراهيم لأبيه آزر أتتخذ أصناما آلهة إني أراك وقومك في ضلال مبين" />
-		<aya index="75" text="وكذلك نري إبراهيم ملكوت السماوات والأرض وليكون من الموقنين" />
-		<aya index="76" text="فلما جن عليه الليل رأى كوكبا قال هذا ربي فلما أفل قال لا أحب الآفلين" />
-		<aya index="77" text="فلما رأى القمر بازغا قال هذا ربي فلما أفل قال لئن لم يهدني ربي لأكونن من القوم الضالين" />
-		<aya index="78" text="فلما رأى الشمس بازغة قال هذا ربي هذا أكبر فلما أفلت قال يا قوم إني بريء مما تشركون" />
-		<aya index="79" text="إني وجهت وجهي للذي فطر السماوات والأرض حنيفا وما أنا من المشركين" />
-		<aya index="80" text="وحاجه قومه قال أتحاجوني في الله وقد هدان ولا أخاف ما تشركون به إلا أن يشاء ربي شيئا وسع ربي كل شيء علما أفلا تتذكرون" />
-		<aya index="81" text="وكيف أخاف ما أشركتم ولا تخافون أنكم أشركتم بالله ما لم ينزل به عليكم سلطانا فأي الفريقين أحق بالأمن إن كنتم تعلمون" />
-		<aya index="82" text="الذين آمنوا ولم يلبسوا إيمانهم بظلم أولئك لهم الأمن وهم مهتدون" />
-		<aya index="83" text="وتلك حجتنا آتيناها إبراهيم على قومه نرفع درجات من نشاء إن ربك حكيم عليم" />
-		<aya index="84" text="ووهبنا له إسحاق ويعقوب كلا هدينا ونوحا هدينا من قبل ومن ذريته داوود وسليمان وأيوب ويوسف وموسى وهارون وكذلك نجزي المحسنين" />
-		<aya index="85" text="وزكريا ويحيى وعيسى وإلياس كل من الصالحين" />
-		<aya index="86" text="وإسماعيل واليسع ويونس ولوطا وكلا فضلنا على العالمين" />
-		<aya index="87" text="ومن آبائهم وذرياتهم وإخوانهم واجتبيناهم وهديناهم إلى صراط مستقيم" />
-		<aya index="88" text="ذلك هدى الله يهدي به من يشاء من عباده ولو أشركوا لحبط عنهم ما كانوا يعملون" />
-		<aya index="89" text="أولئك الذين آتيناهم الكتاب والحكم والنبوة فإن يكفر بها هؤلاء فقد وكلنا بها قوما ليسوا بها بكافرين" />
-		<aya index="90" text="أولئك الذين هدى الله فبهداهم اقتده قل لا أسألكم عليه أجرا إن هو إلا ذكرى للعالمين" />
-		<aya index="91" text="وما قدروا الله حق قدره إذ قالوا ما أنزل الله على بشر من شيء قل من أنزل الكتاب الذي جاء به موسى نورا وهدى للناس تجعلونه قراطيس تبدونها وتخفون كثيرا وعلمتم ما لم تعلموا أنتم ولا آباؤكم قل الله ثم ذرهم في خوضهم يلعبون" />
-		<aya index="92" text="وهذا كتاب أنزلناه مبارك مصدق الذي بين يديه ولتنذر أم القرى ومن حولها والذين يؤمنون بالآخرة يؤمنون به وهم على صلاتهم يحافظون" />
-		<aya index="93" text="ومن أظلم ممن افترى على الله كذبا أو قال أوحي إلي ولم يوح إليه شيء ومن قال سأنزل مثل ما أنزل الله ولو ترى إذ الظالمون في غمرات الموت والملائكة باسطو أيديهم أخرجوا أنفسكم اليوم تجزون عذاب الهون بما كنتم تقولون على الله غير الحق وكنتم عن آياته تستكبرون" />
-		<aya index="94" text="ولقد جئتمونا فرادى كما خلقناكم أول مرة وتركتم ما خولناكم وراء ظهوركم وما نرى معكم شفعاءكم الذين زعمتم أنهم فيكم شركاء لقد تقطع بينكم وضل عنكم ما كنتم تزعمون" />
-		<aya index="95" text="إن الله فالق الحب والنوى يخرج الحي من الميت ومخرج الميت من الحي ذلكم الله فأنى تؤفكون" />
-		<aya index="96" text="فالق الإصباح وجعل الليل سكنا والشمس والقمر حسبانا ذلك تقدير العزيز العليم" />
-		<aya index="97" text="وهو الذي جعل لكم النجوم لتهتدوا بها في ظلمات البر والبحر قد فصلنا الآيات لقوم يعلمون" />
-		<aya index="98" text="وهو الذي أنشأكم من نفس واحدة فمستقر ومستودع قد فصلنا الآيات لقوم يفقهون" />
-		<aya index="99" text="وهو الذي أنزل من السماء ماء فأخرجنا به نبات كل شيء فأخرجنا منه خضرا نخرج منه حبا متراكبا ومن النخل من طلعها قنوان دانية وجنات من أعناب والزيتون والرمان مشتبها وغير متشابه انظروا إلى ثمره إذا أثمر وينعه إن في ذلكم لآيات لقوم يؤمنون" />
-		<aya index="100" text="وجعلوا لله شركاء الجن وخلقهم وخرقوا له بنين وبنات بغير علم سبحانه وتعالى عما يصفون" />
-		<aya index="101" text="بديع السماوات والأرض أنى يكون له ولد ولم تكن له صاحبة وخلق كل شيء وهو بكل شيء عليم" />
-		<aya index="102" text="ذلكم الله ربكم لا إله إلا هو خالق كل شيء فاعبدوه وهو على كل شيء وكيل" />
-		<aya index="103" text="لا تدركه الأبصار وهو يدرك الأبصار وهو اللطيف الخبير" />
-		<aya index="104" text="قد جاءكم بصائر من ربكم فمن أبصر فلنفسه ومن عمي فعليها وما أنا عليكم بحفيظ" />
-		<aya index="105" text="وكذلك نصرف الآيات وليقولوا درست ولنبينه لقوم يعلمون" />
-		<aya index="106" text="اتبع ما أوحي إليك من ربك لا إله إلا هو وأعرض عن المشركين" />
-		<aya index="107" text="ولو شاء الله ما أشركوا وما جعلناك عليهم حفيظا وما أنت عليهم بوكيل" />
-		<aya index="108" text="ولا تسبوا الذين يدعون من دون الله فيسبوا الله عدوا بغير علم كذلك زينا لكل أمة عملهم ثم إلى ربهم مرجعهم فينبئهم بما كانوا يعملون" />
-		<aya index="109" text="وأقسموا بالله جهد أيمانهم لئن جاءتهم آية ليؤمنن بها قل إنما الآيات عند الله وما يشعركم أنها إذا جاءت لا يؤمنون" />
-		<aya index="110" text="ونقلب أفئدتهم وأبصارهم كما لم يؤمنوا به أول مرة ونذرهم في طغيانهم يعمهون" />
-		<aya index="111" text="ولو أننا نزلنا إليهم الملائكة وكلمهم الموتى وحشرنا عليهم كل شيء قبلا ما كانوا ليؤمنوا إلا أن يشاء الله ولكن أكثرهم يجهلون" />
-		<aya index="112" text="وكذلك جعلنا لكل نبي عدوا شياطين الإنس والجن يوحي بعضهم إلى بعض زخرف القول غرورا ولو شاء ربك ما فعلوه فذرهم وما يفترون" />
-		<aya index="113" text="ولتصغى إليه أفئدة الذين لا يؤمنون بالآخرة وليرضوه وليقترفوا ما هم مقترفون" />
-		<aya index="114" text="أفغير الله أبتغي حكما وهو الذي أنزل إليكم الكتاب مفصلا والذين آتيناهم الكتاب يعلمون أنه منزل من ربك بالحق فلا تكونن من الممترين" />
-		<aya index="115" text="وتمت كلمت ربك صدقا وعدلا لا مبدل لكلماته وهو السميع العليم" />
-		<aya index="116" text="وإن تطع أكثر من في الأرض يضلوك عن سبيل الله إن يتبعون إلا الظن وإن هم إلا يخرصون" />
-		<aya index="117" text="إن ربك هو أعلم من يضل عن سبيله وهو أعلم بالمهتدين" />
-		<aya index="118" text="فكلوا مما ذكر اسم الله عليه إن كنتم بآياته مؤمنين" />
-		<aya index="119" text="وما لكم ألا تأكلوا مما ذكر اسم الله عليه وقد فصل لكم ما حرم عليكم إلا ما اضطررتم إليه وإن كثيرا ليضلون بأهوائهم بغير علم إن ربك هو أعلم بالمعتدين" />
-		<aya index="120" text="وذروا ظاهر الإثم وباطنه إن الذين يكسبون الإثم سيجزون بما كانوا يقترفون" />
-		<aya index="121" text="ولا تأكلوا مما لم يذكر اسم الله عليه وإنه لفسق وإن الشياطين ليوحون إلى أوليائهم ليجادلوكم وإن أطعتموهم إنكم لمشركون" />
-		<aya index="122" text="أومن كان ميتا فأحييناه وجعلنا له نورا يمشي به في الناس كمن مثله في الظلمات ليس بخارج منها كذلك زين للكافرين ما كانوا يعملون" />
-		<aya index="123" text="وكذلك جعلنا في كل قرية أكابر مجرميها ليمكروا فيها وما يمكرون إلا بأنفسهم وما يشعرون" />
-		<aya index="124" text="وإذا جاءتهم آية قالوا لن نؤمن حتى نؤتى مثل ما أوتي رسل الله الله أعلم حيث يجعل رسالته سيصيب الذين أجرموا صغار عند الله وعذاب شديد بما كانوا يمكرون" />
-		<aya index="125" text="فمن يرد الله أن يهديه يشرح صدره للإسلام ومن يرد أن يضله يجعل صدره ضيقا حرجا كأنما يصعد في السماء كذلك يجعل الله الرجس على الذين لا يؤمنون" />
-		<aya index="126" text="وهذا صراط ربك مستقيما قد فصلنا الآيات لقوم يذكرون" />
-		<aya index="127" text="لهم دار السلام عند ربهم وهو وليهم بما كانوا يعملون" />
-		<aya index="128" text="ويوم يحشرهم جميعا يا معشر الجن قد استكثرتم من الإنس وقال أولياؤهم من الإنس ربنا استمتع بعضنا ببعض وبلغنا أجلنا الذي أجلت لنا قال النار مثواكم خالدين فيها إلا ما شاء الله إن ربك حكيم عليم" />
-		<aya index="129" text="وكذلك نولي بعض الظالمين بعضا بما كانوا يكسبون" />
-		<aya index="130" text="يا معشر الجن والإنس ألم يأتكم رسل منكم يقصون عليكم آياتي وينذرونكم لقاء يومكم هذا قالوا شهدنا على أنفسنا وغرتهم الحياة الدنيا وشهدوا على أنفسهم أنهم كانوا كافرين" />
-		<aya index="131" text="ذلك أن لم يكن ربك مهلك القرى بظلم وأهلها غافلون" />
-		<aya index="132" text="ولكل درجات مما عملوا وما ربك بغافل عما يعملون" />
-		<aya index="133" text="وربك الغني ذو الرحمة إن يشأ يذهبكم ويستخلف من بعدكم ما يشاء كما أنشأكم من ذرية قوم آخرين" />
-		<aya index="134" text="إن ما توعدون لآت وما أنتم بمعجزين" />
-		<aya index="135" text="قل يا قوم اعملوا على مكانتكم إني عامل فسوف تعلمون من تكون له عاقبة الدار إنه لا يفلح الظالمون" />
-		<aya index="136" text="وجعلوا لله مما ذرأ من الحرث والأنعام نصيبا فقالوا هذا لله بزعمهم وهذا لشركائنا فما كان لشركائهم فلا يصل إلى الله وما كان لله فهو يصل إلى شركائهم ساء ما يحكمون" />
-		<aya index="137" text="وكذلك زين لكثير من المشركين قتل أولادهم شركاؤهم ليردوهم وليلبسوا عليهم دينهم ولو شاء الله ما فعلوه فذرهم وما يفترون" />
-		<aya index="138" text="وقالوا هذه أنعام وحرث حجر لا يطعمها إلا من نشاء بزعمهم وأنعام حرمت ظهورها وأنعام لا يذكرون اسم الله عليها افتراء عليه سيجزيهم بما كانوا يفترون" />
-		<aya index="139" text="وقالوا ما في بطون هذه الأنعام خالصة لذكورنا ومحرم على أزواجنا وإن يكن ميتة فهم فيه شركاء سيجزيهم وصفهم إنه حكيم عليم" />
-		<aya index="140" text="قد خسر الذين قتلوا أولادهم سفها بغير علم وحرموا ما رزقهم الله افتراء على الله قد ضلوا وما كانوا مهتدين" />
-		<aya index="141" text="وهو الذي أنشأ جنات معروشات وغير معروشات والنخل والزرع مختلفا أكله والزيتون والرمان متشابها وغير متشابه كلوا من ثمره إذا أثمر وآتوا حقه يوم حصاده ولا تسرفوا إنه لا يحب المسرفين" />
-		<aya index="142" text="ومن الأنعام حمولة وفرشا كلوا مما رزقكم الله ولا تتبعوا خطوات الشيطان إنه لكم عدو مبين" />
-		<aya index="143" text="ثمانية أزواج من الضأن اثنين ومن المعز اثنين قل آلذكرين حرم أم الأنثيين أما اشتملت عليه أرحام الأنثيين نبئوني بعلم إن كنتم صادقين" />
-		<aya index="144" text="ومن الإبل اثنين ومن البقر اثنين قل آلذكرين حرم أم الأنثيين أما اشتملت عليه أرحام الأنثيين أم كنتم شهداء إذ وصاكم الله بهذا فمن أظلم ممن افترى على الله كذبا ليضل الناس بغير علم إن الله لا يهدي القوم الظالمين" />
-		<aya index="145" text="قل لا أجد في ما أوحي إلي محرما على طاعم يطعمه إلا أن يكون ميتة أو دما مسفوحا أو لحم خنزير فإنه رجس أو فسقا أهل لغير الله به فمن اضطر غير باغ ولا عاد فإن ربك غفور رحيم" />
-		<aya index="146" text="وعلى الذين هادوا حرمنا كل ذي ظفر ومن البقر والغنم حرمنا عليهم شحومهما إلا ما حملت ظهورهما أو الحوايا أو ما اختلط بعظم ذلك جزيناهم ببغيهم وإنا لصادقون" />
-		<aya index="147" text="فإن كذبوك فقل ربكم ذو رحمة واسعة ولا يرد بأسه عن القوم المجرمين" />
-		<aya index="148" text="سيقول الذين أشركوا لو شاء الله ما أشركنا ولا آباؤنا ولا حرمنا من شيء كذلك كذب الذين من قبلهم حتى ذاقوا بأسنا قل هل عندكم من علم فتخرجوه لنا إن تتبعون إلا الظن وإن أنتم إلا تخرصون" />
-		<aya index="149" text="قل فلله الحجة البالغة فلو شاء لهداكم أجمعين" />
-		<aya index="150" text="قل هلم شهداءكم الذين يشهدون أن الله حرم هذا فإن شهدوا فلا تشهد معهم ولا تتبع أهواء الذين كذبوا بآياتنا والذين لا يؤمنون بالآخرة وهم بربهم يعدلون" />
-		<aya index="151" text="قل تعالوا أتل ما حرم ربكم عليكم ألا تشركوا به شيئا وبالوالدين إحسانا ولا تقتلوا أولادكم من إملاق نحن نرزقكم وإياهم ولا تقربوا الفواحش ما ظهر منها وما بطن ولا تقتلوا النفس التي حرم الله إلا بالحق ذلكم وصاكم به لعلكم تعقلون" />
-		<aya index="152" text="ولا تقربوا مال اليتيم إلا بالتي هي أحسن حتى يبلغ أشده وأوفوا الكيل والميزان بالقسط لا نكلف نفسا إلا وسعها وإذا قلتم فاعدلوا ولو كان ذا قربى وبعهد الله أوفوا ذلكم وصاكم به لعلكم تذكرون" />
-		<aya index="153" text="وأن هذا صراطي مستقيما فاتبعوه ولا تتبعوا السبل فتفرق بكم عن سبيله ذلكم وصاكم به لعلكم تتقون" />
-		<aya index="154" text="ثم آتينا موسى الكتاب تماما على الذي أحسن وتفصيلا لكل شيء وهدى ورحمة لعلهم بلقاء ربهم يؤمنون" />
-		<aya index="155" text="وهذا كتاب أنزلناه مبارك فاتبعوه واتقوا لعلكم ترحمون" />
-		<aya index="156" text="أن تقولوا إنما أنزل الكتاب على طائفتين من قبلنا وإن كنا عن دراستهم لغافلين" />
-		<aya index="157" text="أو تقولوا لو أنا أنزل علينا الكتاب لكنا أهدى منهم فقد جاءكم بينة من ربكم وهدى ورحمة فمن أظلم ممن كذب بآيات الله وصدف عنها سنجزي الذين يصدفون عن آياتنا سوء العذاب بما كانوا يصدفون" />
-		<aya index="158" text="هل ينظرون إلا أن تأتيهم الملائكة أو يأتي ربك أو يأتي بعض آيات ربك يوم يأتي بعض آيات ربك لا ينفع نفسا إيمانها لم تكن آمنت من قبل أو كسبت في إيمانها خيرا قل انتظروا إنا منتظرون" />
-		<aya index="159" text="إن الذين فرقوا دينهم وكانوا شيعا لست منهم في شيء إنما أمرهم إلى الله ثم ينبئهم بما كانوا يفعلون" />
-		<aya index="160" text="من جاء بالحسنة فله عشر أمثالها ومن جاء بالسيئة فلا يجزى إلا مثلها وهم لا يظلمون" />
-		<aya index="161" text="قل إنني هداني ربي إلى صراط مستقيم دينا قيما ملة إبراهيم حنيفا وما كان من المشركين" />
-		<aya index="162" text="قل إن صلاتي ونسكي ومحياي ومماتي لله رب العالمين" />
-		<aya index="163" text="لا شريك له وبذلك أمرت وأنا أول المسلمين" />
-		<aya index="164" text="قل أغير الله أبغي ربا وهو رب كل شيء ولا تكسب كل نفس إلا عليها ولا تزر وازرة وزر أخرى ثم إلى ربكم مرجعكم فينبئكم بما كنتم فيه تختلفون" />
-		<aya index="165" text="وهو الذي جعلكم خلائف الأرض ورفع بعضكم فوق بعض درجات ليبلوكم في ما آتاكم إن ربك سريع العقاب وإنه لغفور رحيم" />
-	</sura>
-	<sura index="7" name="الأعراف">
-		<aya index="1" text="المص" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="كتاب أنزل إليك فلا يكن في صدرك حرج منه لتنذر به وذكرى للمؤمنين" />
-		<aya index="3" text="اتبعوا ما أنزل إليكم من ربكم ولا تتبعوا من دونه أولياء قليلا ما تذكرون" />
-		<aya index="4" text="وكم من قرية أهلكناها فجاءها بأسنا بياتا أو هم قائلون" />
-		<aya index="5" text="فما كان دعواهم إذ جاءهم بأسنا إلا أن قالوا إنا كنا ظالمين" />
-		<aya index="6" text="فلنسألن الذين أرسل إليهم ولنسألن المرسلين" />
-		<aya index="7" text="فلنقصن عليهم بعلم وما كنا غائبين" />
-		<aya index="8" text="والوزن يومئذ الحق فمن ثقلت موازينه فأولئك هم المفلحون" />
-		<aya index="9" text="ومن خفت موازينه فأولئك الذين خسروا أنفسهم بما كانوا بآياتنا يظلمون" />
-		<aya index="10" text="ولقد مكناكم في الأرض وجعلنا لكم فيها معايش قليلا ما تشكرون" />
-		<aya index="11" text="ولقد خلقناكم ثم صورناكم ثم قلنا للملائكة اسجدوا لآدم فسجدوا إلا إبليس لم يكن من الساجدين" />
-		<aya index="12" text="قال ما منعك ألا تسجد إذ أمرتك قال أنا خير منه خلقتني من نار وخلقته من طين" />
-		<aya index="13" text="قال فاهبط منها فما يكون لك أن تتكبر فيها فاخرج إنك من الصاغرين" />
-		<aya index="14" text="قال أنظرني إلى يوم يبعثون" />
-		<aya index="15" text="قال إنك من المنظرين" />
-		<aya index="16" text="قال فبما أغويتني لأقعدن لهم صراطك المستقيم" />
-		<aya index="17" text="ثم لآتينهم من بين أيديهم ومن خلفهم وعن أيمانهم وعن شمائلهم ولا تجد أكثرهم شاكرين" />
-		<aya index="18" text="قال اخرج منها مذءوما مدحورا لمن تبعك منهم لأملأن جهنم منكم أجمعين" />
-		<aya index="19" text="ويا آدم اسكن أنت وزوجك الجنة فكلا من حيث شئتما ولا تقربا هذه الشجرة فتكونا من الظالمين" />
-		<aya index="20" text="فوسوس لهما الشيطان ليبدي لهما ما ووري عنهما من سوآتهما وقال ما نهاكما ربكما عن هذه الشجرة إلا أن تكونا ملكين أو تكونا من الخالدين" />
-		<aya index="21" text="وقاسمهما إني لكما لمن الناصحين" />
-		<aya index="22" text="فدلاهما بغرور فلما ذاقا الشجرة بدت لهما سوآتهما وطفقا يخصفان عليهما من ورق الجنة وناداهما ربهما ألم أنهكما عن تلكما الشجرة وأقل لكما إن الشيطان لكما عدو مبين" />
-		<aya index="23" text="قالا ربنا ظلمنا أنفسنا وإن لم تغفر لنا وترحمنا لنكونن من الخاسرين" />
-		<aya index="24" text="قال اهبطوا بعضكم لبعض عدو ولكم في الأرض مستقر ومتاع إلى حين" />
-		<aya index="25" text="قال فيها تحيون وفيها تموتون ومنها تخرجون" />
-		<aya index="26" text="يا بني آدم قد أنزلنا عليكم لباسا يواري سوآتكم وريشا ولباس التقوى ذلك خير ذلك من آيات الله لعلهم يذكرون" />
-		<aya index="27" text="يا بني آدم لا يفتننكم الشيطان كما أخرج أبويكم من الجنة ينزع عنهما لباسهما ليريهما سوآتهما إنه يراكم هو وقبيله من حيث لا ترونهم إنا جعلنا الشياطين أولياء للذين لا يؤمنون" />
-		<aya index="28" text="وإذا فعلوا فاحشة قالوا وجدنا عليها آباءنا والله أمرنا بها قل إن الله لا يأمر بالفحشاء أتقولون على الله ما لا تعلمون" />
-		<aya index="29" text="قل أمر ربي بالقسط وأقيموا وجوهكم عند كل مسجد وادعوه مخلصين له الدين كما بدأكم تعودون" />
-		<aya index="30" text="فريقا هدى وفريقا حق عليهم الضلالة إنهم اتخذوا الشياطين أولياء من دون الله ويحسبون أنهم مهتدون" />
-		<aya index="31" text="يا بني آدم خذوا زينتكم عند كل مسجد وكلوا واشربوا ولا تسرفوا إنه لا يحب المسرفين" />
-		<aya index="32" text="قل من حرم زينة الله التي أخرج لعباده والطيبات من الرزق قل هي للذين آمنوا في الحياة الدنيا خالصة يوم القيامة كذلك نفصل الآيات لقوم يعلمون" />
-		<aya index="33" text="قل إنما حرم ربي الفواحش ما ظهر منها وما بطن والإثم والبغي بغير الحق وأن تشركوا بالله ما لم ينزل به سلطانا وأن تقولوا على الله ما لا تعلمون" />
-		<aya index="34" text="ولكل أمة أجل فإذا جاء أجلهم لا يستأخرون ساعة ولا يستقدمون" />
-		<aya index="35" text="يا بني آدم إما يأتينكم رسل منكم يقصون عليكم آياتي فمن اتقى وأصلح فلا خوف عليهم ولا هم يحزنون" />
-		<aya index="36" text="والذين كذبوا بآياتنا واستكبروا عنها أولئك أصحاب النار هم فيها خالدون" />
-		<aya index="37" text="فمن أظلم ممن افترى على الله كذبا أو كذب بآياته أولئك ينالهم نصيبهم من الكتاب حتى إذا جاءتهم رسلنا يتوفونهم قالوا أين ما كنتم تدعون من دون الله قالوا ضلوا عنا وشهدوا على أنفسهم أنهم كانوا كافرين" />
-		<aya index="38" text="قال ادخلوا في أمم قد خلت من قبلكم من الجن والإنس في النار كلما دخلت أمة لعنت أختها حتى إذا اداركوا فيها جميعا قالت أخراهم لأولاهم ربنا هؤلاء أضلونا فآتهم عذابا ضعفا من النار قال لكل ضعف ولكن لا تعلمون" />
-		<aya index="39" text="وقالت أولاهم لأخراهم فما كان لكم علينا من فضل فذوقوا العذاب بما كنتم تكسبون" />
-		<aya index="40" text="إن الذين كذبوا بآياتنا واستكبروا عنها لا تفتح لهم أبواب السماء ولا يدخلون الجنة حتى يلج الجمل في سم الخياط وكذلك نجزي المجرمين" />
-		<aya index="41" text="لهم من جهنم مهاد ومن فوقهم غواش وكذلك نجزي الظالمين" />
-		<aya index="42" text="والذين آمنوا وعملوا الصالحات لا نكلف نفسا إلا وسعها أولئك أصحاب الجنة هم فيها خالدون" />
-		<aya index="43" text="ونزعنا ما في صدورهم من غل تجري من تحتهم الأنهار وقالوا الحمد لله الذي هدانا لهذا وما كنا لنهتدي لولا أن هدانا الله لقد جاءت رسل ربنا بالحق ونودوا أن تلكم الجنة أورثتموها بما كنتم تعملون" />
-		<aya index="44" text="ونادى أصحاب الجنة أصحاب النار أن قد وجدنا ما وعدنا ربنا حقا فهل وجدتم ما وعد ربكم حقا قالوا نعم فأذن مؤذن بينهم أن لعنة الله على الظالمين" />
-		<aya index="45" text="الذين يصدون عن سبيل الله ويبغونها عوجا وهم بالآخرة كافرون" />
-		<aya index="46" text="وبينهما حجاب وعلى الأعراف رجال يعرفون كلا بسيماهم ونادوا أصحاب الجنة أن سلام عليكم لم يدخلوها وهم يطمعون" />
-		<aya index="47" text="وإذا صرفت أبصارهم تلقاء أصحاب النار قالوا ربنا لا تجعلنا مع القوم الظالمين" />
-		<aya index="48" text="ونادى أصحاب الأعراف رجالا يعرفونهم بسيماهم قالوا ما أغنى عنكم جمعكم وما كنتم تستكبرون" />
-		<aya index="49" text="أهؤلاء الذين أقسمتم لا ينالهم الله برحمة ادخلوا الجنة لا خوف عليكم ولا أنتم تحزنون" />
-		<aya index="50" text="ونادى أصحاب النار أصحاب الجنة أن أفيضوا علينا من الماء أو مما رزقكم الله قالوا إن الله حرمهما على الكافرين" />
-		<aya index="51" text="الذين اتخذوا دينهم لهوا ولعبا وغرتهم الحياة الدنيا فاليوم ننساهم كما نسوا لقاء يومهم هذا وما كانوا بآياتنا يجحدون" />
-		<aya index="52" text="ولقد جئناهم بكتاب فصلناه على علم هدى ورحمة لقوم يؤمنون" />
-		<aya index="53" text="هل ينظرون إلا تأويله يوم يأتي تأويله يقول الذين نسوه من قبل قد جاءت رسل ربنا بالحق فهل لنا من شفعاء فيشفعوا لنا أو نرد فنعمل غير الذي كنا نعمل قد خسروا أنفسهم وضل عنهم ما كانوا يفترون" />
-		<aya index="54" text="إن ربكم الله الذي خلق السماوات والأرض في ستة أيام ثم استوى على العرش يغشي الليل النهار يطلبه حثيثا والشمس والقمر والنجوم مسخرات بأمره ألا له الخلق والأمر تبارك الله رب العالمين" />
-		<aya index="55" text="ادعوا ربكم تضرعا وخفية إنه لا يحب المعتدين" />
-		<aya index="56" text="ولا تفسدوا في الأرض بعد إصلاحها وادعوه خوفا وطمعا إن رحمت الله قريب من المحسنين" />
-		<aya index="57" text="وهو الذي يرسل الرياح بشرا بين يدي رحمته حتى إذا أقلت سحابا ثقالا سقناه لبلد ميت فأنزلنا به الماء فأخرجنا به من كل الثمرات كذلك نخرج الموتى لعلكم تذكرون" />
-		<aya index="58" text="والبلد الطيب يخرج نباته بإذن ربه والذي خبث لا يخرج إلا نكدا كذلك نصرف الآيات لقوم يشكرون" />
-		<aya index="59" text="لقد أرسلنا نوحا إلى قومه فقال يا قوم اعبدوا الله ما لكم من إله غيره إني أخاف عليكم عذاب يوم عظيم" />
-		<aya index="60" text="قال الملأ من قومه إنا لنراك في ضلال مبين" />
-		<aya index="61" text="قال يا قوم ليس بي ضلالة ولكني رسول من رب العالمين" />
-		<aya index="62" text="أبلغكم رسالات ربي وأنصح لكم وأعلم من الله ما لا تعلمون" />
-		<aya index="63" text="أوعجبتم أن جاءكم ذكر من ربكم على رجل منكم لينذركم ولتتقوا ولعلكم ترحمون" />
-		<aya index="64" text="فكذبوه فأنجيناه والذين معه في الفلك وأغرقنا الذين كذبوا بآياتنا إنهم كانوا قوما عمين" />
-		<aya index="65" text="وإلى عاد أخاهم هودا قال يا قوم اعبدوا الله ما لكم من إله غيره أفلا تتقون" />
-		<aya index="66" text="قال الملأ الذين كفروا من قومه إنا لنراك في سفاهة وإنا لنظنك من الكاذبين" />
-		<aya index="67" text="قال يا قوم ليس بي سفاهة ولكني رسول من رب العالمين" />
-		<aya index="68" text="أبلغكم رسالات ربي وأنا لكم ناصح أمين" />
-		<aya index="69" text="أوعجبتم أن جاءكم ذكر من ربكم على رجل منكم لينذركم واذكروا إذ جعلكم خلفاء من بعد قوم نوح وزادكم في الخلق بسطة فاذكروا آلاء الله لعلكم تفلحون" />
-		<aya index="70" text="قالوا أجئتنا لنعبد الله وحده ونذر ما كان يعبد آباؤنا فأتنا بما تعدنا إن كنت من الصادقين" />
-		<aya index="71" text="قال قد وقع عليكم من ربكم رجس وغضب أتجادلونني في أسماء سميتموها أنتم وآباؤكم ما نزل الله بها من سلطان فانتظروا إني معكم من المنتظرين" />
-		<aya index="72" text="فأنجيناه والذين معه برحمة منا وقطعنا دابر الذين كذبوا بآياتنا وما كانوا مؤمنين" />
-		<aya index="73" text="وإلى ثمود أخاهم صالحا قال يا قوم اعبدوا الله ما لكم من إله غيره قد جاءتكم بينة من ربكم هذه ناقة الله لكم آية فذروها تأكل في أرض الله ولا تمسوها بسوء فيأخذكم عذاب أليم" />
-		<aya index="74" text="واذكروا إذ جعلكم خلفاء من بعد عاد وبوأكم في الأرض تتخذون من سهولها قصورا وتنحتون الجبال بيوتا فاذكروا آلاء الله ولا تعثوا في الأرض مفسدين" />
-		<aya index="75" text="قال الملأ الذين استكبروا من قومه للذين استضعفوا لمن آمن منهم أتعلمون أن صالحا مرسل من ربه قالوا إنا بما أرسل به مؤمنون" />
-		<aya index="76" text="قال الذين استكبروا إنا بالذي آمنتم به كافرون" />
-		<aya index="77" text="فعقروا الناقة وعتوا عن أمر ربهم وقالوا يا صالح ائتنا بما تعدنا إن كنت من المرسلين" />
-		<aya index="78" text="فأخذتهم الرجفة فأصبحوا في دارهم جاثمين" />
-		<aya index="79" text="فتولى عنهم وقال يا قوم لقد أبلغتكم رسالة ربي ونصحت لكم ولكن لا تحبون الناصحين" />
-		<aya index="80" text="ولوطا إذ قال لقومه أتأتون الفاحشة ما سبقكم بها من أحد من العالمين" />
-		<aya index="81" text="إنكم لتأتون الرجال شهوة من دون النساء بل أنتم قوم مسرفون" />
-		<aya index="82" text="وما كان جواب قومه إلا أن قالوا أخرجوهم من قريتكم إنهم أناس يتطهرون" />
-		<aya index="83" text="فأنجيناه وأهله إلا امرأته كانت من الغابرين" />
-		<aya index="84" text="وأمطرنا عليهم مطرا فانظر كيف كان عاقبة المجرمين" />
-		<aya index="85" text="وإلى مدين أخاهم شعيبا قال يا قوم اعبدوا الله ما لكم من إله غيره قد جاءتكم بينة من ربكم فأوفوا الكيل والميزان ولا تبخسوا الناس أشياءهم ولا تفسدوا في الأرض بعد إصلاحها ذلكم خير لكم إن كنتم مؤمنين" />
-		<aya index="86" text="ولا تقعدوا بكل صراط توعدون وتصدون عن سبيل الله من آمن به وتبغونها عوجا واذكروا إذ كنتم قليلا فكثركم وانظروا كيف كان عاقبة المفسدين" />
-		<aya index="87" text="وإن كان طائفة منكم آمنوا بالذي أرسلت به وطائفة لم يؤمنوا فاصبروا حتى يحكم الله بيننا وهو خير الحاكمين" />
-		<aya index="88" text="قال الملأ الذين استكبروا من قومه لنخرجنك يا شعيب والذين آمنوا معك من قريتنا أو لتعودن في ملتنا قال أولو كنا كارهين" />
-		<aya index="89" text="قد افترينا على الله كذبا إن عدنا في ملتكم بعد إذ نجانا الله منها وما يكون لنا أن نعود فيها إلا أن يشاء الله ربنا وسع ربنا كل شيء علما على الله توكلنا ربنا افتح بيننا وبين قومنا بالحق وأنت خير الفاتحين" />
-		<aya index="90" text="وقال الملأ الذين كفروا من قومه لئن اتبعتم شعيبا إنكم إذا لخاسرون" />
-		<aya index="91" text="فأخذتهم الرجفة فأصبحوا في دارهم جاثمين" />
-		<aya index="92" text="الذين كذبوا شعيبا كأن لم يغنوا فيها الذين كذبوا شعيبا كانوا هم الخاسرين" />
-		<aya index="93" text="فتولى عنهم وقال يا قوم لقد أبلغتكم رسالات ربي ونصحت لكم فكيف آسى على قوم كافرين" />
-		<aya index="94" text="وما أرسلنا في قرية من نبي إلا أخذنا أهلها بالبأساء والضراء لعلهم يضرعون" />
-		<aya index="95" text="ثم بدلنا مكان السيئة الحسنة حتى عفوا وقالوا قد مس آباءنا الضراء والسراء فأخذناهم بغتة وهم لا يشعرون" />
-		<aya index="96" text="ولو أن أهل القرى آمنوا واتقوا لفتحنا عليهم بركات من السماء والأرض ولكن كذبوا فأخذناهم بما كانوا يكسبون" />
-		<aya index="97" text="أفأمن أهل القرى أن يأتيهم بأسنا بياتا وهم نائمون" />
-		<aya index="98" text="أوأمن أهل القرى أن يأتيهم بأسنا ضحى وهم يلعبون" />
-		<aya index="99" text="أفأمنوا مكر الله فلا يأمن مكر الله إلا القوم الخاسرون" />
-		<aya index="100" text="أولم يهد للذين يرثون الأرض من بعد أهلها أن لو نشاء أصبناهم بذنوبهم ونطبع على قلوبهم فهم لا يسمعون" />
-		<aya index="101" text="تلك القرى نقص عليك من أنبائها ولقد جاءتهم رسلهم بالبينات فما كانوا ليؤمنوا بما كذبوا من قبل كذلك يطبع الله على قلوب الكافرين" />
-		<aya index="102" text="وما وجدنا لأكثرهم من عهد وإن وجدنا أكثرهم لفاسقين" />
-		<aya index="103" text="ثم بعثنا من بعدهم موسى بآياتنا إلى فرعون وملئه فظلموا بها فانظر كيف كان عاقبة المفسدين" />
-		<aya index="104" text="وقال موسى يا فرعون إني رسول من رب العالمين" />
-		<aya index="105" text="حقيق على أن لا أقول على الله إلا الحق قد جئتكم ببينة من ربكم فأرسل معي بني إسرائيل" />
-		<aya index="106" text="قال إن كنت جئت بآية فأت بها إن كنت من الصادقين" />
-		<aya index="107" text="فألقى عصاه فإذا هي ثعبان مبين" />
-		<aya index="108" text="ونزع يده فإذا هي بيضاء للناظرين" />
-		<aya index="109" text="قال الملأ من قوم فرعون إن هذا لساحر عليم" />
-		<aya index="110" text="يريد أن يخرجكم من أرضكم فماذا تأمرون" />
-		<aya index="111" text="قالوا أرجه وأخاه وأرسل في المدائن حاشرين" />
-		<aya index="112" text="يأتوك بكل ساحر عليم" />
-		<aya index="113" text="وجاء السحرة فرعون قالوا إن لنا لأجرا إن كنا نحن الغالبين" />
-		<aya index="114" text="قال نعم وإنكم لمن المقربين" />
-		<aya index="115" text="قالوا يا موسى إما أن تلقي وإما أن نكون نحن الملقين" />
-		<aya index="116" text="قال ألقوا فلما ألقوا سحروا أعين الناس واسترهبوهم وجاءوا بسحر عظيم" />
-		<aya index="117" text="وأوحينا إلى موسى أن ألق عصاك فإذا هي تلقف ما يأفكون" />
-		<aya index="118" text="فوقع الحق وبطل ما كانوا يعملون" />
-		<aya index="119" text="فغلبوا هنالك وانقلبوا صاغرين" />
-		<aya index="120" text="وألقي السحرة ساجدين" />
-		<aya index="121" text="قالوا آمنا برب العالمين" />
-		<aya index="122" text="رب موسى وهارون" />
-		<aya index="123" text="قال فرعون آمنتم به قبل أن آذن لكم إن هذا لمكر مكرتموه في المدينة لتخرجوا منها أهلها فسوف تعلمون" />
-		<aya index="124" text="لأقطعن أيديكم وأرجلكم من خلاف ثم لأصلبنكم أجمعين" />
-		<aya index="125" text="قالوا إنا إلى ربنا منقلبون" />
-		<aya index="126" text="وما تنقم منا إلا أن آمنا بآيات ربنا لما جاءتنا ربنا أفرغ علينا صبرا وتوفنا مسلمين" />
-		<aya index="127" text="وقال الملأ من قوم فرعون أتذر موسى وقومه ليفسدوا في الأرض ويذرك وآلهتك قال سنقتل أبناءهم ونستحيي نساءهم وإنا فوقهم قاهرون" />
-		<aya index="128" text="قال موسى لقومه استعينوا بالله واصبروا إن الأرض لله يورثها من يشاء من عباده والعاقبة للمتقين" />
-		<aya index="129" text="قالوا أوذينا من قبل أن تأتينا ومن بعد ما جئتنا قال عسى ربكم أن يهلك عدوكم ويستخلفكم في الأرض فينظر كيف تعملون" />
-		<aya index="130" text="ولقد أخذنا آل فرعون بالسنين ونقص من الثمرات لعلهم يذكرون" />
-		<aya index="131" text="فإذا جاءتهم الحسنة قالوا لنا هذه وإن تصبهم سيئة يطيروا بموسى ومن معه ألا إنما طائرهم عند الله ولكن أكثرهم لا يعلمون" />
-		<aya index="132" text="وقالوا مهما تأتنا به من آية لتسحرنا بها فما نحن لك بمؤمنين" />
-		<aya index="133" text="فأرسلنا عليهم الطوفان والجراد والقمل والضفادع والدم آيات مفصلات فاستكبروا وكانوا قوما مجرمين" />
-		<aya index="134" text="ولما وقع عليهم الرجز قالوا يا موسى ادع لنا ربك بما عهد عندك لئن كشفت عنا الرجز لنؤمنن لك ولنرسلن معك بني إسرائيل" />
-		<aya index="135" text="فلما كشفنا عنهم الرجز إلى أجل هم بالغوه إذا هم ينكثون" />
-		<aya index="136" text="فانتقمنا منهم فأغرقناهم في اليم بأنهم كذبوا بآياتنا وكانوا عنها غافلين" />
-		<aya index="137" text="وأورثنا القوم الذين كانوا يستضعفون مشارق الأرض ومغاربها التي باركنا فيها وتمت كلمت ربك الحسنى على بني إسرائيل بما صبروا ودمرنا ما كان يصنع فرعون وقومه وما كانوا يعرشون" />
-		<aya index="138" text="وجاوزنا ببني إسرائيل البحر فأتوا على قوم يعكفون على أصنام لهم قالوا يا موسى اجعل لنا إلها كما لهم آلهة قال إنكم قوم تجهلون" />
-		<aya index="139" text="إن هؤلاء متبر ما هم فيه وباطل ما كانوا يعملون" />
-		<aya index="140" text="قال أغير الله أبغيكم إلها وهو فضلكم على العالمين" />
-		<aya index="141" text="وإذ أنجيناكم من آل فرعون يسومونكم سوء العذاب يقتلون أبناءكم ويستحيون نساءكم وفي ذلكم بلاء من ربكم عظيم" />
-		<aya index="142" text="وواعدنا موسى ثلاثين ليلة وأتممناها بعشر فتم ميقات ربه أربعين ليلة وقال موسى لأخيه هارون اخلفني في قومي وأصلح ولا تتبع سبيل المفسدين" />
-		<aya index="143" text="ولما جاء موسى لميقاتنا وكلمه ربه قال رب أرني أنظر إليك قال لن تراني ولكن انظر إلى الجبل فإن استقر مكانه فسوف تراني فلما تجلى ربه للجبل جعله دكا وخر موسى صعقا فلما أفاق قال سبحانك تبت إليك وأنا أول المؤمنين" />
-		<aya index="144" text="قال يا موسى إني اصطفيتك على الناس برسالاتي وبكلامي فخذ ما آتيتك وكن من الشاكرين" />
-		<aya index="145" text="وكتبنا له في الألواح من كل شيء موعظة وتفصيلا لكل شيء فخذها بقوة وأمر قومك يأخذوا بأحسنها سأريكم دار الفاسقين" />
-		<aya index="146" text="سأصرف عن آياتي الذين يتكبرون في الأرض بغير الحق وإن يروا كل آية لا يؤمنوا بها وإن يروا سبيل الرشد لا يتخذوه سبيلا وإن يروا سبيل الغي يتخذوه سبيلا ذلك بأنهم كذبوا بآياتنا وكانوا عنها غافلين" />
-		<aya index="147" text="والذين كذبوا بآياتنا ولقاء الآخرة حبطت أعمالهم هل يجزون إلا ما كانوا يعملون" />
-		<aya index="148" text="واتخذ قوم موسى من بعده من حليهم عجلا جسدا له خوار ألم يروا أنه لا يكلمهم ولا يهديهم سبيلا اتخذوه وكانوا ظالمين" />
-		<aya index="149" text="ولما سقط في أيديهم ورأوا أنهم قد ضلوا قالوا لئن لم يرحمنا ربنا ويغفر لنا لنكونن من الخاسرين" />
-		<aya index="150" text="ولما رجع موسى إلى قومه غضبان أسفا قال بئسما خلفتموني من بعدي أعجلتم أمر ربكم وألقى الألواح وأخذ برأس أخيه يجره إليه قال ابن أم إن القوم استضعفوني وكادوا يقتلونني فلا تشمت بي الأعداء ولا تجعلني مع القوم الظالمين" />
-		<aya index="151" text="قال رب اغفر لي ولأخي وأدخلنا في رحمتك وأنت أرحم الراحمين" />
-		<aya index="152" text="إن الذين اتخذوا العجل سينالهم غضب من ربهم وذلة في الحياة الدنيا وكذلك نجزي المفترين" />
-		<aya index="153" text="والذين عملوا السيئات ثم تابوا من بعدها وآمنوا إن ربك من بعدها لغفور رحيم" />
-		<aya index="154" text="ولما سكت عن موسى الغضب أخذ الألواح وفي نسختها هدى ورحمة للذين هم لربهم يرهبون" />
-		<aya index="155" text="واختار موسى قومه سبعين رجلا لميقاتنا فلما أخذتهم الرجفة قال رب لو شئت أهلكتهم من قبل وإياي أتهلكنا بما فعل السفهاء منا إن هي إلا فتنتك تضل بها من تشاء وتهدي من تشاء أنت ولينا فاغفر لنا وارحمنا وأنت خير الغافرين" />
-		<aya index="156" text="واكتب لنا في هذه الدنيا حسنة وفي الآخرة إنا هدنا إليك قال عذابي أصيب به من أشاء ورحمتي وسعت كل شيء فسأكتبها للذين يتقون ويؤتون الزكاة والذين هم بآياتنا يؤمنون" />
-		<aya index="157" text="الذين يتبعون الرسول النبي الأمي الذي يجدونه مكتوبا عندهم في التوراة والإنجيل يأمرهم بالمعروف وينهاهم عن المنكر ويحل لهم الطيبات ويحرم عليهم الخبائث ويضع عنهم إصرهم والأغلال التي كانت عليهم فالذين آمنوا به وعزروه ونصروه واتبعوا النور الذي أنزل معه أولئك هم المفلحون" />
-		<aya index="158" text="قل يا أيها الناس إني رسول الله إليكم جميعا الذي له ملك السماوات والأرض لا إله إلا هو يحيي ويميت فآمنوا بالله ورسوله النبي الأمي الذي يؤمن بالله وكلماته واتبعوه لعلكم تهتدون" />
-		<aya index="159" text="ومن قوم موسى أمة يهدون بالحق وبه يعدلون" />
-		<aya index="160" text="وقطعناهم اثنتي عشرة أسباطا أمما وأوحينا إلى موسى إذ استسقاه قومه أن اضرب بعصاك الحجر فانبجست منه اثنتا عشرة عينا قد علم كل أناس مشربهم وظللنا عليهم الغمام وأنزلنا عليهم المن والسلوى كلوا من طيبات ما رزقناكم وما ظلمونا ولكن كانوا أنفسهم يظلمون" />
-		<aya index="161" text="وإذ قيل لهم اسكنوا هذه القرية وكلوا منها حيث شئتم وقولوا حطة وادخلوا الباب سجدا نغفر لكم خطيئاتكم سنزيد المحسنين" />
-		<aya index="162" text="فبدل الذين ظلموا منهم قولا غير الذي قيل لهم فأرسلنا عليهم رجزا من السماء بما كانوا يظلمون" />
-		<aya index="163" text="واسألهم عن القرية التي كانت حاضرة البحر إذ يعدون في السبت إذ تأتيهم حيتانهم يوم سبتهم شرعا ويوم لا يسبتون لا تأتيهم كذلك نبلوهم بما كانوا يفسقون" />
-		<aya index="164" text="وإذ قالت أمة منهم لم تعظون قوما الله مهلكهم أو معذبهم عذابا شديدا قالوا معذرة إلى ربكم ولعلهم يتقون" />
-		<aya index="165" text="فلما نسوا ما ذكروا به أنجينا الذين ينهون عن السوء وأخذنا الذين ظلموا بعذاب بئيس بما كانوا يفسقون" />
-		<aya index="166" text="فلما عتوا عن ما نهوا عنه قلنا لهم كونوا قردة خاسئين" />
-		<aya index="167" text="وإذ تأذن ربك ليبعثن عليهم إلى يوم القيامة من يسومهم سوء العذاب إن ربك لسريع العقاب وإنه لغفور رحيم" />
-		<aya index="168" text="وقطعناهم في الأرض أمما منهم الصالحون ومنهم دون ذلك وبلوناهم بالحسنات والسيئات لعلهم يرجعون" />
-		<aya index="169" text="فخلف من بعدهم خلف ورثوا الكتاب يأخذون عرض هذا الأدنى ويقولون سيغفر لنا وإن يأتهم عرض مثله يأخذوه ألم يؤخذ عليهم ميثاق الكتاب أن لا يقولوا على الله إلا الحق ودرسوا ما فيه والدار الآخرة خير للذين يتقون أفلا تعقلون" />
-		<aya index="170" text="والذين يمسكون بالكتاب وأقاموا الصلاة إنا لا نضيع أجر المصلحين" />
-		<aya index="171" text="وإذ نتقنا الجبل فوقهم كأنه ظلة وظنوا أنه واقع بهم خذوا ما آتيناكم بقوة واذكروا ما فيه لعلكم تتقون" />
-		<aya index="172" text="وإذ أخذ ربك من بني آدم من ظهورهم ذريتهم وأشهدهم على أنفسهم ألست بربكم قالوا بلى شهدنا أن تقولوا يوم القيامة إنا كنا عن هذا غافلين" />
-		<aya index="173" text="أو تقولوا إنما أشرك آباؤنا من قبل وكنا ذرية من بعدهم أفتهلكنا بما فعل المبطلون" />
-		<aya index="174" text="وكذلك نفصل الآيات ولعلهم يرجعون" />
-		<aya index="175" text="واتل عليهم نبأ الذي آتيناه آياتنا فانسلخ منها فأتبعه الشيطان فكان من الغاوين" />
-		<aya index="176" text="ولو شئنا لرفعناه بها ولكنه أخلد إلى الأرض واتبع هواه فمثله كمثل الكلب إن تحمل عليه يلهث أو تتركه يلهث ذلك مثل القوم الذين كذبوا بآياتنا فاقصص القصص لعلهم يتفكرون" />
-		<aya index="177" text="ساء مثلا القوم الذين كذبوا بآياتنا وأنفسهم كانوا يظلمون" />
-		<aya index="178" text="من يهد الله فهو المهتدي ومن يضلل فأولئك هم الخاسرون" />
-		<aya index="179" text="ولقد ذرأنا لجهنم كثيرا من الجن والإنس لهم قلوب لا يفقهون بها ولهم أعين لا يبصرون بها ولهم آذان لا يسمعون بها أولئك كالأنعام بل هم أضل أولئك هم الغافلون" />
-		<aya index="180" text="ولله الأسماء الحسنى فادعوه بها وذروا الذين يلحدون في أسمائه سيجزون ما كانوا يعملون" />
-		<aya index="181" text="وممن خلقنا أمة يهدون بالحق وبه يعدلون" />
-		<aya index="182" text="والذين كذبوا بآياتنا سنستدرجهم من حيث لا يعلمون" />
-		<aya index="183" text="وأملي لهم إن كيدي متين" />
-		<aya index="184" text="أولم يتفكروا ما بصاحبهم من جنة إن هو إلا نذير مبين" />
-		<aya index="185" text="أولم ينظروا في ملكوت السماوات والأرض وما خلق الله من شيء وأن عسى أن يكون قد اقترب أجلهم فبأي حديث بعده يؤمنون" />
-		<aya index="186" text="من يضلل الله فلا هادي له ويذرهم في طغيانهم يعمهون" />
-		<aya index="187" text="يسألونك عن الساعة أيان مرساها قل إنما علمها عند ربي لا يجليها لوقتها إلا هو ثقلت في السماوات والأرض لا تأتيكم إلا بغتة يسألونك كأنك حفي عنها قل إنما علمها عند الله ولكن أكثر الناس لا يعلمون" />
-		<aya index="188" text="قل لا أملك لنفسي نفعا ولا ضرا إلا ما شاء الله ولو كنت أعلم الغيب لاستكثرت من الخير وما مسني السوء إن أنا إلا نذير وبشير لقوم يؤمنون" />
-		<aya index="189" text="هو الذي خلقكم من نفس واحدة وجعل منها زوجها ليسكن إليها فلما تغشاها حملت حملا خفيفا فمرت به فلما أثقلت دعوا الله ربهما لئن آتيتنا صالحا لنكونن من الشاكرين" />
-		<aya index="190" text="فلما آتاهما صالحا جعلا له شركاء فيما آتاهما فتعالى الله عما يشركون" />
-		<aya index="191" text="أيشركون ما لا يخلق شيئا وهم يخلقون" />
-		<aya index="192" text="ولا يستطيعون لهم نصرا ولا أنفسهم ينصرون" />
-		<aya index="193" text="وإن تدعوهم إلى الهدى لا يتبعوكم سواء عليكم أدعوتموهم أم أنتم صامتون" />
-		<aya index="194" text="إن الذين تدعون من دون الله عباد أمثالكم فادعوهم فليستجيبوا لكم إن كنتم صادقين" />
-		<aya index="195" text="ألهم أرجل يمشون بها أم لهم أيد يبطشون بها أم لهم أعين يبصرون بها أم لهم آذان يسمعون بها قل ادعوا شركاءكم ثم كيدون فلا تنظرون" />
-		<aya index="196" text="إن وليي الله الذي نزل الكتاب وهو يتولى الصالحين" />
-		<aya index="197" text="والذين تدعون من دونه لا يستطيعون نصركم ولا أنفسهم ينصرون" />
-		<aya index="198" text="وإن تدعوهم إلى الهدى لا يسمعوا وتراهم ينظرون إليك وهم لا يبصرون" />
-		<aya index="199" text="خذ العفو وأمر بالعرف وأعرض عن الجاهلين" />
-		<aya index="200" text="وإما ينزغنك من الشيطان نزغ فاستعذ بالله إنه سميع عليم" />
-		<aya index="201" text="إن الذين اتقوا إذا مسهم طائف من الشيطان تذكروا فإذا هم مبصرون" />
-		<aya index="202" text="وإخوانهم يمدونهم في الغي ثم لا يقصرون" />
-		<aya index="203" text="وإذا لم تأتهم بآية قالوا لولا اجتبيتها قل إنما أتبع ما يوحى إلي من ربي هذا بصائر من ربكم وهدى ورحمة لقوم يؤمنون" />
-		<aya index="204" text="وإذا قرئ القرآن فاستمعوا له وأنصتوا لعلكم ترحمون" />
-		<aya index="205" text="واذكر ربك في نفسك تضرعا وخيفة ودون الجهر من القول بالغدو والآصال ولا تكن من الغافلين" />
-		<aya index="206" text="إن الذين عند ربك لا يستكبرون عن عبادته ويسبحونه وله يسجدون" />
-	</sura>
-	<sura index="8" name="الأنفال">
-		<aya index="1" text="يسألونك عن الأنفال قل الأنفال لله والرسول فاتقوا الله وأصلحوا ذات بينكم وأطيعوا الله ورسوله إن كنتم مؤمنين" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="إنما المؤمنون الذين إذا ذكر الله وجلت قلوبهم وإذا تليت عليهم آياته زادتهم إيمانا وعلى ربهم يتوكلون" />
-		<aya index="3" text="الذين يقيمون الصلاة ومما رزقناهم ينفقون" />
-		<aya index="4" text="أولئك هم المؤمنون حقا لهم درجات عند ربهم ومغفرة ورزق كريم" />
-		<aya index="5" text="كما أخرجك ربك من بيتك بالحق وإن فريقا من المؤمنين لكارهون" />
-		<aya index="6" text="يجادلونك في الحق بعدما تبين كأنما يساقون إلى الموت وهم ينظرون" />
-		<aya index="7" text="وإذ يعدكم الله إحدى الطائفتين أنها لكم وتودون أن غير ذات الشوكة تكون لكم ويريد الله أن يحق الحق بكلماته ويقطع دابر الكافرين" />
-		<aya index="8" text="ليحق الحق ويبطل الباطل ولو كره المجرمون" />
-		<aya index="9" text="إذ تستغيثون ربكم فاستجاب لكم أني ممدكم بألف من الملائكة مردفين" />
-		<aya index="10" text="وما جعله الله إلا بشرى ولتطمئن به قلوبكم وما النصر إلا من عند الله إن الله عزيز حكيم" />
-		<aya index="11" text="إذ يغشيكم النعاس أمنة منه وينزل عليكم من السماء ماء ليطهركم به ويذهب عنكم رجز الشيطان وليربط على قلوبكم ويثبت به الأقدام" />
-		<aya index="12" text="إذ يوحي ربك إلى الملائكة أني معكم فثبتوا الذين آمنوا سألقي في قلوب الذين كفروا الرعب فاضربوا فوق الأعناق واضربوا منهم كل بنان" />
-		<aya index="13" text="ذلك بأنهم شاقوا الله ورسوله ومن يشاقق الله ورسوله فإن الله شديد العقاب" />
-		<aya index="14" text="ذلكم فذوقوه وأن للكافرين عذاب النار" />
-		<aya index="15" text="يا أيها الذين آمنوا إذا لقيتم الذين كفروا زحفا فلا تولوهم الأدبار" />
-		<aya index="16" text="ومن يولهم يومئذ دبره إلا متحرفا لقتال أو متحيزا إلى فئة فقد باء بغضب من الله ومأواه جهنم وبئس المصير" />
-		<aya index="17" text="فلم تقتلوهم ولكن الله قتلهم وما رميت إذ رميت ولكن الله رمى وليبلي المؤمنين منه بلاء حسنا إن الله سميع عليم" />
-		<aya index="18" text="ذلكم وأن الله موهن كيد الكافرين" />
-		<aya index="19" text="إن تستفتحوا فقد جاءكم الفتح وإن تنتهوا فهو خير لكم وإن تعودوا نعد ولن تغني عنكم فئتكم شيئا ولو كثرت وأن الله مع المؤمنين" />
-		<aya index="20" text="يا أيها الذين آمنوا أطيعوا الله ورسوله ولا تولوا عنه وأنتم تسمعون" />
-		<aya index="21" text="ولا تكونوا كالذين قالوا سمعنا وهم لا يسمعون" />
-		<aya index="22" text="إن شر الدواب عند الله الصم البكم الذين لا يعقلون" />
-		<aya index="23" text="ولو علم الله فيهم خيرا لأسمعهم ولو أسمعهم لتولوا وهم معرضون" />
-		<aya index="24" text="يا أيها الذين آمنوا استجيبوا لله وللرسول إذا دعاكم لما يحييكم واعلموا أن الله يحول بين المرء وقلبه وأنه إليه تحشرون" />
-		<aya index="25" text="واتقوا فتنة لا تصيبن الذين ظلموا منكم خاصة واعلموا أن الله شديد العقاب" />
-		<aya index="26" text="واذكروا إذ أنتم قليل مستضعفون في الأرض تخافون أن يتخطفكم الناس فآواكم وأيدكم بنصره ورزقكم من الطيبات لعلكم تشكرون" />
-		<aya index="27" text="يا أيها الذين آمنوا لا تخونوا الله والرسول وتخونوا أماناتكم وأنتم تعلمون" />
-		<aya index="28" text="واعلموا أنما أموالكم وأولادكم فتنة وأن الله عنده أجر عظيم" />
-		<aya index="29" text="يا أيها الذين آمنوا إن تتقوا الله يجعل لكم فرقانا ويكفر عنكم سيئاتكم ويغفر لكم والله ذو الفضل العظيم" />
-		<aya index="30" text="وإذ يمكر بك الذين كفروا ليثبتوك أو يقتلوك أو يخرجوك ويمكرون ويمكر الله والله خير الماكرين" />
-		<aya index="31" text="وإذا تتلى عليهم آياتنا قالوا قد سمعنا لو نشاء لقلنا مثل هذا إن هذا إلا أساطير الأولين" />
-		<aya index="32" text="وإذ قالوا اللهم إن كان هذا هو الحق من عندك فأمطر علينا حجارة من السماء أو ائتنا بعذاب أليم" />
-		<aya index="33" text="وما كان الله ليعذبهم وأنت فيهم وما كان الله معذبهم وهم يستغفرون" />
-		<aya index="34" text="وما لهم ألا يعذبهم الله وهم يصدون عن المسجد الحرام وما كانوا أولياءه إن أولياؤه إلا المتقون ولكن أكثرهم لا يعلمون" />
-		<aya index="35" text="وما كان صلاتهم عند البيت إلا مكاء وتصدية فذوقوا العذاب بما كنتم تكفرون" />
-		<aya index="36" text="إن الذين كفروا ينفقون أموالهم ليصدوا عن سبيل الله فسينفقونها ثم تكون عليهم حسرة ثم يغلبون والذين كفروا إلى جهنم يحشرون" />
-		<aya index="37" text="ليميز الله الخبيث من الطيب ويجعل الخبيث بعضه على بعض فيركمه جميعا فيجعله في جهنم أولئك هم الخاسرون" />
-		<aya index="38" text="قل للذين كفروا إن ينتهوا يغفر لهم ما قد سلف وإن يعودوا فقد مضت سنت الأولين" />
-		<aya index="39" text="وقاتلوهم حتى لا تكون فتنة ويكون الدين كله لله فإن انتهوا فإن الله بما يعملون بصير" />
-		<aya index="40" text="وإن تولوا فاعلموا أن الله مولاكم نعم المولى ونعم النصير" />
-		<aya index="41" text="واعلموا أنما غنمتم من شيء فأن لله خمسه وللرسول ولذي القربى واليتامى والمساكين وابن السبيل إن كنتم آمنتم بالله وما أنزلنا على عبدنا يوم الفرقان يوم التقى الجمعان والله على كل شيء قدير" />
-		<aya index="42" text="إذ أنتم بالعدوة الدنيا وهم بالعدوة القصوى والركب أسفل منكم ولو تواعدتم لاختلفتم في الميعاد ولكن ليقضي الله أمرا كان مفعولا ليهلك من هلك عن بينة ويحيى من حي عن بينة وإن الله لسميع عليم" />
-		<aya index="43" text="إذ يريكهم الله في منامك قليلا ولو أراكهم كثيرا لفشلتم ولتنازعتم في الأمر ولكن الله سلم إنه عليم بذات الصدور" />
-		<aya index="44" text="وإذ يريكموهم إذ التقيتم في أعينكم قليلا ويقللكم في أعينهم ليقضي الله أمرا كان مفعولا وإلى الله ترجع الأمور" />
-		<aya index="45" text="يا أيها الذين آمنوا إذا لقيتم فئة فاثبتوا واذكروا الله كثيرا لعلكم تفلحون" />
-		<aya index="46" text="وأطيعوا الله ورسوله ولا تنازعوا فتفشلوا وتذهب ريحكم واصبروا إن الله مع الصابرين" />
-		<aya index="47" text="ولا تكونوا كالذين خرجوا من ديارهم بطرا ورئاء الناس ويصدون عن سبيل الله والله بما يعملون محيط" />
-		<aya index="48" text="وإذ زين لهم الشيطان أعمالهم وقال لا غالب لكم اليوم من الناس وإني جار لكم فلما تراءت الفئتان نكص على عقبيه وقال إني بريء منكم إني أرى ما لا ترون إني أخاف الله والله شديد العقاب" />
-		<aya index="49" text="إذ يقول المنافقون والذين في قلوبهم مرض غر هؤلاء دينهم ومن يتوكل على الله فإن الله عزيز حكيم" />
-		<aya index="50" text="ولو ترى إذ يتوفى الذين كفروا الملائكة يضربون وجوههم وأدبارهم وذوقوا عذاب الحريق" />
-		<aya index="51" text="ذلك بما قدمت أيديكم وأن الله ليس بظلام للعبيد" />
-		<aya index="52" text="كدأب آل فرعون والذين من قبلهم كفروا بآيات الله فأخذهم الله بذنوبهم إن الله قوي شديد العقاب" />
-		<aya index="53" text="ذلك بأن الله لم يك مغيرا نعمة أنعمها على قوم حتى يغيروا ما بأنفسهم وأن الله سميع عليم" />
-		<aya index="54" text="كدأب آل فرعون والذين من قبلهم كذبوا بآيات ربهم فأهلكناهم بذنوبهم وأغرقنا آل فرعون وكل كانوا ظالمين" />
-		<aya index="55" text="إن شر الدواب عند الله الذين كفروا فهم لا يؤمنون" />
-		<aya index="56" text="الذين عاهدت منهم ثم ينقضون عهدهم في كل مرة وهم لا يتقون" />
-		<aya index="57" text="فإما تثقفنهم في الحرب فشرد بهم من خلفهم لعلهم يذكرون" />
-		<aya index="58" text="وإما تخافن من قوم خيانة فانبذ إليهم على سواء إن الله لا يحب الخائنين" />
-		<aya index="59" text="ولا يحسبن الذين كفروا سبقوا إنهم لا يعجزون" />
-		<aya index="60" text="وأعدوا لهم ما استطعتم من قوة ومن رباط الخيل ترهبون به عدو الله وعدوكم وآخرين من دونهم لا تعلمونهم الله يعلمهم وما تنفقوا من شيء في سبيل الله يوف إليكم وأنتم لا تظلمون" />
-		<aya index="61" text="وإن جنحوا للسلم فاجنح لها وتوكل على الله إنه هو السميع العليم" />
-		<aya index="62" text="وإن يريدوا أن يخدعوك فإن حسبك الله هو الذي أيدك بنصره وبالمؤمنين" />
-		<aya index="63" text="وألف بين قلوبهم لو أنفقت ما في الأرض جميعا ما ألفت بين قلوبهم ولكن الله ألف بينهم إنه عزيز حكيم" />
-		<aya index="64" text="يا أيها النبي حسبك الله ومن اتبعك من المؤمنين" />
-		<aya index="65" text="يا أيها النبي حرض المؤمنين على القتال إن يكن منكم عشرون صابرون يغلبوا مائتين وإن يكن منكم مائة يغلبوا ألفا من الذين كفروا بأنهم قوم لا يفقهون" />
-		<aya index="66" text="الآن خفف الله عنكم وعلم أن فيكم ضعفا فإن يكن منكم مائة صابرة يغلبوا مائتين وإن يكن منكم ألف يغلبوا ألفين بإذن الله والله مع الصابرين" />
-		<aya index="67" text="ما كان لنبي أن يكون له أسرى حتى يثخن في الأرض تريدون عرض الدنيا والله يريد الآخرة والله عزيز حكيم" />
-		<aya index="68" text="لولا كتاب من الله سبق لمسكم فيما أخذتم عذاب عظيم" />
-		<aya index="69" text="فكلوا مما غنمتم حلالا طيبا واتقوا الله إن الله غفور رحيم" />
-		<aya index="70" text="يا أيها النبي قل لمن في أيديكم من الأسرى إن يعلم الله في قلوبكم خيرا يؤتكم خيرا مما أخذ منكم ويغفر لكم والله غفور رحيم" />
-		<aya index="71" text="وإن يريدوا خيانتك فقد خانوا الله من قبل فأمكن منهم والله عليم حكيم" />
-		<aya index="72" text="إن الذين آمنوا وهاجروا وجاهدوا بأموالهم وأنفسهم في سبيل الله والذين آووا ونصروا أولئك بعضهم أولياء بعض والذين آمنوا ولم يهاجروا ما لكم من ولايتهم من شيء حتى يهاجروا وإن استنصروكم في الدين فعليكم النصر إلا على قوم بينكم وبينهم ميثاق والله بما تعملون بصير" />
-		<aya index="73" text="والذين كفروا بعضهم أولياء بعض إلا تفعلوه تكن فتنة في الأرض وفساد كبير" />
-		<aya index="74" text="والذين آمنوا وهاجروا وجاهدوا في سبيل الله والذين آووا ونصروا أولئك هم المؤمنون حقا لهم مغفرة ورزق كريم" />
-		<aya index="75" text="والذين آمنوا من بعد وهاجروا وجاهدوا معكم فأولئك منكم وأولو الأرحام بعضهم أولى ببعض في كتاب الله إن الله بكل شيء عليم" />
-	</sura>
-	<sura index="9" name="التوبة">
-		<aya index="1" text="براءة من الله ورسوله إلى الذين عاهدتم من المشركين" />
-		<aya index="2" text="فسيحوا في الأرض أربعة أشهر واعلموا أنكم غير معجزي الله وأن الله مخزي الكافرين" />
-		<aya index="3" text="وأذان من الله ورسوله إلى الناس يوم الحج الأكبر أن الله بريء من المشركين ورسوله فإن تبتم فهو خير لكم وإن توليتم فاعلموا أنكم غير معجزي الله وبشر الذين كفروا بعذاب أليم" />
-		<aya index="4" text="إلا الذين عاهدتم من المشركين ثم لم ينقصوكم شيئا ولم يظاهروا عليكم أحدا فأتموا إليهم عهدهم إلى مدتهم إن الله يحب المتقين" />
-		<aya index="5" text="فإذا انسلخ الأشهر الحرم فاقتلوا المشركين حيث وجدتموهم وخذوهم واحصروهم واقعدوا لهم كل مرصد فإن تابوا وأقاموا الصلاة وآتوا الزكاة فخلوا سبيلهم إن الله غفور رحيم" />
-		<aya index="6" text="وإن أحد من المشركين استجارك فأجره حتى يسمع كلام الله ثم أبلغه مأمنه ذلك بأنهم قوم لا يعلمون" />
-		<aya index="7" text="كيف يكون للمشركين عهد عند الله وعند رسوله إلا الذين عاهدتم عند المسجد الحرام فما استقاموا لكم فاستقيموا لهم إن الله يحب المتقين" />
-		<aya index="8" text="كيف وإن يظهروا عليكم لا يرقبوا فيكم إلا ولا ذمة يرضونكم بأفواههم وتأبى قلوبهم وأكثرهم فاسقون" />
-		<aya index="9" text="اشتروا بآيات الله ثمنا قليلا فصدوا عن سبيله إنهم ساء ما كانوا يعملون" />
-		<aya index="10" text="لا يرقبون في مؤمن إلا ولا ذمة وأولئك هم المعتدون" />
-		<aya index="11" text="فإن تابوا وأقاموا الصلاة وآتوا الزكاة فإخوانكم في الدين ونفصل الآيات لقوم يعلمون" />
-		<aya index="12" text="وإن نكثوا أيمانهم من بعد عهدهم وطعنوا في دينكم فقاتلوا أئمة الكفر إنهم لا أيمان لهم لعلهم ينتهون" />
-		<aya index="13" text="ألا تقاتلون قوما نكثوا أيمانهم وهموا بإخراج الرسول وهم بدءوكم أول مرة أتخشونهم فالله أحق أن تخشوه إن كنتم مؤمنين" />
-		<aya index="14" text="قاتلوهم يعذبهم الله بأيديكم ويخزهم وينصركم عليهم ويشف صدور قوم مؤمنين" />
-		<aya index="15" text="ويذهب غيظ قلوبهم ويتوب الله على من يشاء والله عليم حكيم" />
-		<aya index="16" text="أم حسبتم أن تتركوا ولما يعلم الله الذين جاهدوا منكم ولم يتخذوا من دون الله ولا رسوله ولا المؤمنين وليجة والله خبير بما تعملون" />
-		<aya index="17" text="ما كان للمشركين أن يعمروا مساجد الله شاهدين على أنفسهم بالكفر أولئك حبطت أعمالهم وفي النار هم خالدون" />
-		<aya index="18" text="إنما يعمر مساجد الله من آمن بالله واليوم الآخر وأقام الصلاة وآتى الزكاة ولم يخش إلا الله فعسى أولئك أن يكونوا من المهتدين" />
-		<aya index="19" text="أجعلتم سقاية الحاج وعمارة المسجد الحرام كمن آمن بالله واليوم الآخر وجاهد في سبيل الله لا يستوون عند الله والله لا يهدي القوم الظالمين" />
-		<aya index="20" text="الذين آمنوا وهاجروا وجاهدوا في سبيل الله بأموالهم وأنفسهم أعظم درجة عند الله وأولئك هم الفائزون" />
-		<aya index="21" text="يبشرهم ربهم برحمة منه ورضوان وجنات لهم فيها نعيم مقيم" />
-		<aya index="22" text="خالدين فيها أبدا إن الله عنده أجر عظيم" />
-		<aya index="23" text="يا أيها الذين آمنوا لا تتخذوا آباءكم وإخوانكم أولياء إن استحبوا الكفر على الإيمان ومن يتولهم منكم فأولئك هم الظالمون" />
-		<aya index="24" text="قل إن كان آباؤكم وأبناؤكم وإخوانكم وأزواجكم وعشيرتكم وأموال اقترفتموها وتجارة تخشون كسادها ومساكن ترضونها أحب إليكم من الله ورسوله وجهاد في سبيله فتربصوا حتى يأتي الله بأمره والله لا يهدي القوم الفاسقين" />
-		<aya index="25" text="لقد نصركم الله في مواطن كثيرة ويوم حنين إذ أعجبتكم كثرتكم فلم تغن عنكم شيئا وضاقت عليكم الأرض بما رحبت ثم وليتم مدبرين" />
-		<aya index="26" text="ثم أنزل الله سكينته على رسوله وعلى المؤمنين وأنزل جنودا لم تروها وعذب الذين كفروا وذلك جزاء الكافرين" />
-		<aya index="27" text="ثم يتوب الله من بعد ذلك على من يشاء والله غفور رحيم" />
-		<aya index="28" text="يا أيها الذين آمنوا إنما المشركون نجس فلا يقربوا المسجد الحرام بعد عامهم هذا وإن خفتم عيلة فسوف يغنيكم الله من فضله إن شاء إن الله عليم حكيم" />
-		<aya index="29" text="قاتلوا الذين لا يؤمنون بالله ولا باليوم الآخر ولا يحرمون ما حرم الله ورسوله ولا يدينون دين الحق من الذين أوتوا الكتاب حتى يعطوا الجزية عن يد وهم صاغرون" />
-		<aya index="30" text="وقالت اليهود عزير ابن الله وقالت النصارى المسيح ابن الله ذلك قولهم بأفواههم يضاهئون قول الذين كفروا من قبل قاتلهم الله أنى يؤفكون" />
-		<aya index="31" text="اتخذوا أحبارهم ورهبانهم أربابا من دون الله والمسيح ابن مريم وما أمروا إلا ليعبدوا إلها واحدا لا إله إلا هو سبحانه عما يشركون" />
-		<aya index="32" text="يريدون أن يطفئوا نور الله بأفواههم ويأبى الله إلا أن يتم نوره ولو كره الكافرون" />
-		<aya index="33" text="هو الذي أرسل رسوله بالهدى ودين الحق ليظهره على الدين كله ولو كره المشركون" />
-		<aya index="34" text="يا أيها الذين آمنوا إن كثيرا من الأحبار والرهبان ليأكلون أموال الناس بالباطل ويصدون عن سبيل الله والذين يكنزون الذهب والفضة ولا ينفقونها في سبيل الله فبشرهم بعذاب أليم" />
-		<aya index="35" text="يوم يحمى عليها في نار جهنم فتكوى بها جباههم وجنوبهم وظهورهم هذا ما كنزتم لأنفسكم فذوقوا ما كنتم تكنزون" />
-		<aya index="36" text="إن عدة الشهور عند الله اثنا عشر شهرا في كتاب الله يوم خلق السماوات والأرض منها أربعة حرم ذلك الدين القيم فلا تظلموا فيهن أنفسكم وقاتلوا المشركين كافة كما يقاتلونكم كافة واعلموا أن الله مع المتقين" />
-		<aya index="37" text="إنما النسيء زيادة في الكفر يضل به الذين كفروا يحلونه عاما ويحرمونه عاما ليواطئوا عدة ما حرم الله فيحلوا ما حرم الله زين لهم سوء أعمالهم والله لا يهدي القوم الكافرين" />
-		<aya index="38" text="يا أيها الذين آمنوا ما لكم إذا قيل لكم انفروا في سبيل الله اثاقلتم إلى الأرض أرضيتم بالحياة الدنيا من الآخرة فما متاع الحياة الدنيا في الآخرة إلا قليل" />
-		<aya index="39" text="إلا تنفروا يعذبكم عذابا أليما ويستبدل قوما غيركم ولا تضروه شيئا والله على كل شيء قدير" />
-		<aya index="40" text="إلا تنصروه فقد نصره الله إذ أخرجه الذين كفروا ثاني اثنين إذ هما في الغار إذ يقول لصاحبه لا تحزن إن الله معنا فأنزل الله سكينته عليه وأيده بجنود لم تروها وجعل كلمة الذين كفروا السفلى وكلمة الله هي العليا والله عزيز حكيم" />
-		<aya index="41" text="انفروا خفافا وثقالا وجاهدوا بأموالكم وأنفسكم في سبيل الله ذلكم خير لكم إن كنتم تعلمون" />
-		<aya index="42" text="لو كان عرضا قريبا وسفرا قاصدا لاتبعوك ولكن بعدت عليهم الشقة وسيحلفون بالله لو استطعنا لخرجنا معكم يهلكون أنفسهم والله يعلم إنهم لكاذبون" />
-		<aya index="43" text="عفا الله عنك لم أذنت لهم حتى يتبين لك الذين صدقوا وتعلم الكاذبين" />
-		<aya index="44" text="لا يستأذنك الذين يؤمنون بالله واليوم الآخر أن يجاهدوا بأموالهم وأنفسهم والله عليم بالمتقين" />
-		<aya index="45" text="إنما يستأذنك الذين لا يؤمنون بالله واليوم الآخر وارتابت قلوبهم فهم في ريبهم يترددون" />
-		<aya index="46" text="ولو أرادوا الخروج لأعدوا له عدة ولكن كره الله انبعاثهم فثبطهم وقيل اقعدوا مع القاعدين" />
-		<aya index="47" text="لو خرجوا فيكم ما زادوكم إلا خبالا ولأوضعوا خلالكم يبغونكم الفتنة وفيكم سماعون لهم والله عليم بالظالمين" />
-		<aya index="48" text="لقد ابتغوا الفتنة من قبل وقلبوا لك الأمور حتى جاء الحق وظهر أمر الله وهم كارهون" />
-		<aya index="49" text="ومنهم من يقول ائذن لي ولا تفتني ألا في الفتنة سقطوا وإن جهنم لمحيطة بالكافرين" />
-		<aya index="50" text="إن تصبك حسنة تسؤهم وإن تصبك مصيبة يقولوا قد أخذنا أمرنا من قبل ويتولوا وهم فرحون" />
-		<aya index="51" text="قل لن يصيبنا إلا ما كتب الله لنا هو مولانا وعلى الله فليتوكل المؤمنون" />
-		<aya index="52" text="قل هل تربصون بنا إلا إحدى الحسنيين ونحن نتربص بكم أن يصيبكم الله بعذاب من عنده أو بأيدينا فتربصوا إنا معكم متربصون" />
-		<aya index="53" text="قل أنفقوا طوعا أو كرها لن يتقبل منكم إنكم كنتم قوما فاسقين" />
-		<aya index="54" text="وما منعهم أن تقبل منهم نفقاتهم إلا أنهم كفروا بالله وبرسوله ولا يأتون الصلاة إلا وهم كسالى ولا ينفقون إلا وهم كارهون" />
-		<aya index="55" text="فلا تعجبك أموالهم ولا أولادهم إنما يريد الله ليعذبهم بها في الحياة الدنيا وتزهق أنفسهم وهم كافرون" />
-		<aya index="56" text="ويحلفون بالله إنهم لمنكم وما هم منكم ولكنهم قوم يفرقون" />
-		<aya index="57" text="لو يجدون ملجأ أو مغارات أو مدخلا لولوا إليه وهم يجمحون" />
-		<aya index="58" text="ومنهم من يلمزك في الصدقات فإن أعطوا منها رضوا وإن لم يعطوا منها إذا هم يسخطون" />
-		<aya index="59" text="ولو أنهم رضوا ما آتاهم الله ورسوله وقالوا حسبنا الله سيؤتينا الله من فضله ورسوله إنا إلى الله راغبون" />
-		<aya index="60" text="إنما الصدقات للفقراء والمساكين والعاملين عليها والمؤلفة قلوبهم وفي الرقاب والغارمين وفي سبيل الله وابن السبيل فريضة من الله والله عليم حكيم" />
-		<aya index="61" text="ومنهم الذين يؤذون النبي ويقولون هو أذن قل أذن خير لكم يؤمن بالله ويؤمن للمؤمنين ورحمة للذين آمنوا منكم والذين يؤذون رسول الله لهم عذاب أليم" />
-		<aya index="62" text="يحلفون بالله لكم ليرضوكم والله ورسوله أحق أن يرضوه إن كانوا مؤمنين" />
-		<aya index="63" text="ألم يعلموا أنه من يحادد الله ورسوله فأن له نار جهنم خالدا فيها ذلك الخزي العظيم" />
-		<aya index="64" text="يحذر المنافقون أن تنزل عليهم سورة تنبئهم بما في قلوبهم قل استهزئوا إن الله مخرج ما تحذرون" />
-		<aya index="65" text="ولئن سألتهم ليقولن إنما كنا نخوض ونلعب قل أبالله وآياته ورسوله كنتم تستهزئون" />
-		<aya index="66" text="لا تعتذروا قد كفرتم بعد إيمانكم إن نعف عن طائفة منكم نعذب طائفة بأنهم كانوا مجرمين" />
-		<aya index="67" text="المنافقون والمنافقات بعضهم من بعض يأمرون بالمنكر وينهون عن المعروف ويقبضون أيديهم نسوا الله فنسيهم إن المنافقين هم الفاسقون" />
-		<aya index="68" text="وعد الله المنافقين والمنافقات والكفار نار جهنم خالدين فيها هي حسبهم ولعنهم الله ولهم عذاب مقيم" />
-		<aya index="69" text="كالذين من قبلكم كانوا أشد منكم قوة وأكثر أموالا وأولادا فاستمتعوا بخلاقهم فاستمتعتم بخلاقكم كما استمتع الذين من قبلكم بخلاقهم وخضتم كالذي خاضوا أولئك حبطت أعمالهم في الدنيا والآخرة وأولئك هم الخاسرون" />
-		<aya index="70" text="ألم يأتهم نبأ الذين من قبلهم قوم نوح وعاد وثمود وقوم إبراهيم وأصحاب مدين والمؤتفكات أتتهم رسلهم بالبينات فما كان الله ليظلمهم ولكن كانوا أنفسهم يظلمون" />
-		<aya index="71" text="والمؤمنون والمؤمنات بعضهم أولياء بعض يأمرون بالمعروف وينهون عن المنكر ويقيمون الصلاة ويؤتون الزكاة ويطيعون الله ورسوله أولئك سيرحمهم الله إن الله عزيز حكيم" />
-		<aya index="72" text="وعد الله المؤمنين والمؤمنات جنات تجري من تحتها الأنهار خالدين فيها ومساكن طيبة في جنات عدن ورضوان من الله أكبر ذلك هو الفوز العظيم" />
-		<aya index="73" text="يا أيها النبي جاهد الكفار والمنافقين واغلظ عليهم ومأواهم جهنم وبئس المصير" />
-		<aya index="74" text="يحلفون بالله ما قالوا ولقد قالوا كلمة الكفر وكفروا بعد إسلامهم وهموا بما لم ينالوا وما نقموا إلا أن أغناهم الله ورسوله من فضله فإن يتوبوا يك خيرا لهم وإن يتولوا يعذبهم الله عذابا أليما في الدنيا والآخرة وما لهم في الأرض من ولي ولا نصير" />
-		<aya index="75" text="ومنهم من عاهد الله لئن آتانا من فضله لنصدقن ولنكونن من الصالحين" />
-		<aya index="76" text="فلما آتاهم من فضله بخلوا به وتولوا وهم معرضون" />
-		<aya index="77" text="فأعقبهم نفاقا في قلوبهم إلى يوم يلقونه بما أخلفوا الله ما وعدوه وبما كانوا يكذبون" />
-		<aya index="78" text="ألم يعلموا أن الله يعلم سرهم ونجواهم وأن الله علام الغيوب" />
-		<aya index="79" text="الذين يلمزون المطوعين من المؤمنين في الصدقات والذين لا يجدون إلا جهدهم فيسخرون منهم سخر الله منهم ولهم عذاب أليم" />
-		<aya index="80" text="استغفر لهم أو لا تستغفر لهم إن تستغفر لهم سبعين مرة فلن يغفر الله لهم ذلك بأنهم كفروا بالله ورسوله والله لا يهدي القوم الفاسقين" />
-		<aya index="81" text="فرح المخلفون بمقعدهم خلاف رسول الله وكرهوا أن يجاهدوا بأموالهم وأنفسهم في سبيل الله وقالوا لا تنفروا في الحر قل نار جهنم أشد حرا لو كانوا يفقهون" />
-		<aya index="82" text="فليضحكوا قليلا وليبكوا كثيرا جزاء بما كانوا يكسبون" />
-		<aya index="83" text="فإن رجعك الله إلى طائفة منهم فاستأذنوك للخروج فقل لن تخرجوا معي أبدا ولن تقاتلوا معي عدوا إنكم رضيتم بالقعود أول مرة فاقعدوا مع الخالفين" />
-		<aya index="84" text="ولا تصل على أحد منهم مات أبدا ولا تقم على قبره إنهم كفروا بالله ورسوله وماتوا وهم فاسقون" />
-		<aya index="85" text="ولا تعجبك أموالهم وأولادهم إنما يريد الله أن يعذبهم بها في الدنيا وتزهق أنفسهم وهم كافرون" />
-		<aya index="86" text="وإذا أنزلت سورة أن آمنوا بالله وجاهدوا مع رسوله استأذنك أولو الطول منهم وقالوا ذرنا نكن مع القاعدين" />
-		<aya index="87" text="رضوا بأن يكونوا مع الخوالف وطبع على قلوبهم فهم لا يفقهون" />
-		<aya index="88" text="لكن الرسول والذين آمنوا معه جاهدوا بأموالهم وأنفسهم وأولئك لهم الخيرات وأولئك هم المفلحون" />
-		<aya index="89" text="أعد الله لهم جنات تجري من تحتها الأنهار خالدين فيها ذلك الفوز العظيم" />
-		<aya index="90" text="وجاء المعذرون من الأعراب ليؤذن لهم وقعد الذين كذبوا الله ورسوله سيصيب الذين كفروا منهم عذاب أليم" />
-		<aya index="91" text="ليس على الضعفاء ولا على المرضى ولا على الذين لا يجدون ما ينفقون حرج إذا نصحوا لله ورسوله ما على المحسنين من سبيل والله غفور رحيم" />
-		<aya index="92" text="ولا على الذين إذا ما أتوك لتحملهم قلت لا أجد ما أحملكم عليه تولوا وأعينهم تفيض من الدمع حزنا ألا يجدوا ما ينفقون" />
-		<aya index="93" text="إنما السبيل على الذين يستأذنونك وهم أغنياء رضوا بأن يكونوا مع الخوالف وطبع الله على قلوبهم فهم لا يعلمون" />
-		<aya index="94" text="يعتذرون إليكم إذا رجعتم إليهم قل لا تعتذروا لن نؤمن لكم قد نبأنا الله من أخباركم وسيرى الله عملكم ورسوله ثم تردون إلى عالم الغيب والشهادة فينبئكم بما كنتم تعملون" />
-		<aya index="95" text="سيحلفون بالله لكم إذا انقلبتم إليهم لتعرضوا عنهم فأعرضوا عنهم إنهم رجس ومأواهم جهنم جزاء بما كانوا يكسبون" />
-		<aya index="96" text="يحلفون لكم لترضوا عنهم فإن ترضوا عنهم فإن الله لا يرضى عن القوم الفاسقين" />
-		<aya index="97" text="الأعراب أشد كفرا ونفاقا وأجدر ألا يعلموا حدود ما أنزل الله على رسوله والله عليم حكيم" />
-		<aya index="98" text="ومن الأعراب من يتخذ ما ينفق مغرما ويتربص بكم الدوائر عليهم دائرة السوء والله سميع عليم" />
-		<aya index="99" text="ومن الأعراب من يؤمن بالله واليوم الآخر ويتخذ ما ينفق قربات عند الله وصلوات الرسول ألا إنها قربة لهم سيدخلهم الله في رحمته إن الله غفور رحيم" />
-		<aya index="100" text="والسابقون الأولون من المهاجرين والأنصار والذين اتبعوهم بإحسان رضي الله عنهم ورضوا عنه وأعد لهم جنات تجري تحتها الأنهار خالدين فيها أبدا ذلك الفوز العظيم" />
-		<aya index="101" text="وممن حولكم من الأعراب منافقون ومن أهل المدينة مردوا على النفاق لا تعلمهم نحن نعلمهم سنعذبهم مرتين ثم يردون إلى عذاب عظيم" />
-		<aya index="102" text="وآخرون اعترفوا بذنوبهم خلطوا عملا صالحا وآخر سيئا عسى الله أن يتوب عليهم إن الله غفور رحيم" />
-		<aya index="103" text="خذ من أموالهم صدقة تطهرهم وتزكيهم بها وصل عليهم إن صلاتك سكن لهم والله سميع عليم" />
-		<aya index="104" text="ألم يعلموا أن الله هو يقبل التوبة عن عباده ويأخذ الصدقات وأن الله هو التواب الرحيم" />
-		<aya index="105" text="وقل اعملوا فسيرى الله عملكم ورسوله والمؤمنون وستردون إلى عالم الغيب والشهادة فينبئكم بما كنتم تعملون" />
-		<aya index="106" text="وآخرون مرجون لأمر الله إما يعذبهم وإما يتوب عليهم والله عليم حكيم" />
-		<aya index="107" text="والذين اتخذوا مسجدا ضرارا وكفرا وتفريقا بين المؤمنين وإرصادا لمن حارب الله ورسوله من قبل وليحلفن إن أردنا إلا الحسنى والله يشهد إنهم لكاذبون" />
-		<aya index="108" text="لا تقم فيه أبدا لمسجد أسس على التقوى من أول يوم أحق أن تقوم فيه فيه رجال يحبون أن يتطهروا والله يحب المطهرين" />
-		<aya index="109" text="أفمن أسس بنيانه على تقوى من الله ورضوان خير أم من أسس بنيانه على شفا جرف هار فانهار به في نار جهنم والله لا يهدي القوم الظالمين" />
-		<aya index="110" text="لا يزال بنيانهم الذي بنوا ريبة في قلوبهم إلا أن تقطع قلوبهم والله عليم حكيم" />
-		<aya index="111" text="إن الله اشترى من المؤمنين أنفسهم وأموالهم بأن لهم الجنة يقاتلون في سبيل الله فيقتلون ويقتلون وعدا عليه حقا في التوراة والإنجيل والقرآن ومن أوفى بعهده من الله فاستبشروا ببيعكم الذي بايعتم به وذلك هو الفوز العظيم" />
-		<aya index="112" text="التائبون العابدون الحامدون السائحون الراكعون الساجدون الآمرون بالمعروف والناهون عن المنكر والحافظون لحدود الله وبشر المؤمنين" />
-		<aya index="113" text="ما كان للنبي والذين آمنوا أن يستغفروا للمشركين ولو كانوا أولي قربى من بعد ما تبين لهم أنهم أصحاب الجحيم" />
-		<aya index="114" text="وما كان استغفار إبراهيم لأبيه إلا عن موعدة وعدها إياه فلما تبين له أنه عدو لله تبرأ منه إن إبراهيم لأواه حليم" />
-		<aya index="115" text="وما كان الله ليضل قوما بعد إذ هداهم حتى يبين لهم ما يتقون إن الله بكل شيء عليم" />
-		<aya index="116" text="إن الله له ملك السماوات والأرض يحيي ويميت وما لكم من دون الله من ولي ولا نصير" />
-		<aya index="117" text="لقد تاب الله على النبي والمهاجرين والأنصار الذين اتبعوه في ساعة العسرة من بعد ما كاد يزيغ قلوب فريق منهم ثم تاب عليهم إنه بهم رءوف رحيم" />
-		<aya index="118" text="وعلى الثلاثة الذين خلفوا حتى إذا ضاقت عليهم الأرض بما رحبت وضاقت عليهم أنفسهم وظنوا أن لا ملجأ من الله إلا إليه ثم تاب عليهم ليتوبوا إن الله هو التواب الرحيم" />
-		<aya index="119" text="يا أيها الذين آمنوا اتقوا الله وكونوا مع الصادقين" />
-		<aya index="120" text="ما كان لأهل المدينة ومن حولهم من الأعراب أن يتخلفوا عن رسول الله ولا يرغبوا بأنفسهم عن نفسه ذلك بأنهم لا يصيبهم ظمأ ولا نصب ولا مخمصة في سبيل الله ولا يطئون موطئا يغيظ الكفار ولا ينالون من عدو نيلا إلا كتب لهم به عمل صالح إن الله لا يضيع أجر المحسنين" />
-		<aya index="121" text="ولا ينفقون نفقة صغيرة ولا كبيرة ولا يقطعون واديا إلا كتب لهم ليجزيهم الله أحسن ما كانوا يعملون" />
-		<aya index="122" text="وما كان المؤمنون لينفروا كافة فلولا نفر من كل فرقة منهم طائفة ليتفقهوا في الدين ولينذروا قومهم إذا رجعوا إليهم لعلهم يحذرون" />
-		<aya index="123" text="يا أيها الذين آمنوا قاتلوا الذين يلونكم من الكفار وليجدوا فيكم غلظة واعلموا أن الله مع المتقين" />
-		<aya index="124" text="وإذا ما أنزلت سورة فمنهم من يقول أيكم زادته هذه إيمانا فأما الذين آمنوا فزادتهم إيمانا وهم يستبشرون" />
-		<aya index="125" text="وأما الذين في قلوبهم مرض فزادتهم رجسا إلى رجسهم وماتوا وهم كافرون" />
-		<aya index="126" text="أولا يرون أنهم يفتنون في كل عام مرة أو مرتين ثم لا يتوبون ولا هم يذكرون" />
-		<aya index="127" text="وإذا ما أنزلت سورة نظر بعضهم إلى بعض هل يراكم من أحد ثم انصرفوا صرف الله قلوبهم بأنهم قوم لا يفقهون" />
-		<aya index="128" text="لقد جاءكم رسول من أنفسكم عزيز عليه ما عنتم حريص عليكم بالمؤمنين رءوف رحيم" />
-		<aya index="129" text="فإن تولوا فقل حسبي الله لا إله إلا هو عليه توكلت وهو رب العرش العظيم" />
-	</sura>
-	<sura index="10" name="يونس">
-		<aya index="1" text="الر تلك آيات الكتاب الحكيم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="أكان للناس عجبا أن أوحينا إلى رجل منهم أن أنذر الناس وبشر الذين آمنوا أن لهم قدم صدق عند ربهم قال الكافرون إن هذا لساحر مبين" />
-		<aya index="3" text="إن ربكم الله الذي خلق السماوات والأرض في ستة أيام ثم استوى على العرش يدبر الأمر ما من شفيع إلا من بعد إذنه ذلكم الله ربكم فاعبدوه أفلا تذكرون" />
-		<aya index="4" text="إليه مرجعكم جميعا وعد الله حقا إنه يبدأ الخلق ثم يعيده ليجزي الذين آمنوا وعملوا الصالحات بالقسط والذين كفروا لهم شراب من حميم وعذاب أليم بما كانوا يكفرون" />
-		<aya index="5" text="هو الذي جعل الشمس ضياء والقمر نورا وقدره منازل لتعلموا عدد السنين والحساب ما خلق الله ذلك إلا بالحق يفصل الآيات لقوم يعلمون" />
-		<aya index="6" text="إن في اختلاف الليل والنهار وما خلق الله في السماوات والأرض لآيات لقوم يتقون" />
-		<aya index="7" text="إن الذين لا يرجون لقاءنا ورضوا بالحياة الدنيا واطمأنوا بها والذين هم عن آياتنا غافلون" />
-		<aya index="8" text="أولئك مأواهم النار بما كانوا يكسبون" />
-		<aya index="9" text="إن الذين آمنوا وعملوا الصالحات يهديهم ربهم بإيمانهم تجري من تحتهم الأنهار في جنات النعيم" />
-		<aya index="10" text="دعواهم فيها سبحانك اللهم وتحيتهم فيها سلام وآخر دعواهم أن الحمد لله رب العالمين" />
-		<aya index="11" text="ولو يعجل الله للناس الشر استعجالهم بالخير لقضي إليهم أجلهم فنذر الذين لا يرجون لقاءنا في طغيانهم يعمهون" />
-		<aya index="12" text="وإذا مس الإنسان الضر دعانا لجنبه أو قاعدا أو قائما فلما كشفنا عنه ضره مر كأن لم يدعنا إلى ضر مسه كذلك زين للمسرفين ما كانوا يعملون" />
-		<aya index="13" text="ولقد أهلكنا القرون من قبلكم لما ظلموا وجاءتهم رسلهم بالبينات وما كانوا ليؤمنوا كذلك نجزي القوم المجرمين" />
-		<aya index="14" text="ثم جعلناكم خلائف في الأرض من بعدهم لننظر كيف تعملون" />
-		<aya index="15" text="وإذا تتلى عليهم آياتنا بينات قال الذين لا يرجون لقاءنا ائت بقرآن غير هذا أو بدله قل ما يكون لي أن أبدله من تلقاء نفسي إن أتبع إلا ما يوحى إلي إني أخاف إن عصيت ربي عذاب يوم عظيم" />
-		<aya index="16" text="قل لو شاء الله ما تلوته عليكم ولا أدراكم به فقد لبثت فيكم عمرا من قبله أفلا تعقلون" />
-		<aya index="17" text="فمن أظلم ممن افترى على الله كذبا أو كذب بآياته إنه لا يفلح المجرمون" />
-		<aya index="18" text="ويعبدون من دون الله ما لا يضرهم ولا ينفعهم ويقولون هؤلاء شفعاؤنا عند الله قل أتنبئون الله بما لا يعلم في السماوات ولا في الأرض سبحانه وتعالى عما يشركون" />
-		<aya index="19" text="وما كان الناس إلا أمة واحدة فاختلفوا ولولا كلمة سبقت من ربك لقضي بينهم فيما فيه يختلفون" />
-		<aya index="20" text="ويقولون لولا أنزل عليه آية من ربه فقل إنما الغيب لله فانتظروا إني معكم من المنتظرين" />
-		<aya index="21" text="وإذا أذقنا الناس رحمة من بعد ضراء مستهم إذا لهم مكر في آياتنا قل الله أسرع مكرا إن رسلنا يكتبون ما تمكرون" />
-		<aya index="22" text="هو الذي يسيركم في البر والبحر حتى إذا كنتم في الفلك وجرين بهم بريح طيبة وفرحوا بها جاءتها ريح عاصف وجاءهم الموج من كل مكان وظنوا أنهم أحيط بهم دعوا الله مخلصين له الدين لئن أنجيتنا من هذه لنكونن من الشاكرين" />
-		<aya index="23" text="فلما أنجاهم إذا هم يبغون في الأرض بغير الحق يا أيها الناس إنما بغيكم على أنفسكم متاع الحياة الدنيا ثم إلينا مرجعكم فننبئكم بما كنتم تعملون" />
-		<aya index="24" text="إنما مثل الحياة الدنيا كماء أنزلناه من السماء فاختلط به نبات الأرض مما يأكل الناس والأنعام حتى إذا أخذت الأرض زخرفها وازينت وظن أهلها أنهم قادرون عليها أتاها أمرنا ليلا أو نهارا فجعلناها حصيدا كأن لم تغن بالأمس كذلك نفصل الآيات لقوم يتفكرون" />
-		<aya index="25" text="والله يدعو إلى دار السلام ويهدي من يشاء إلى صراط مستقيم" />
-		<aya index="26" text="للذين أحسنوا الحسنى وزيادة ولا يرهق وجوههم قتر ولا ذلة أولئك أصحاب الجنة هم فيها خالدون" />
-		<aya index="27" text="والذين كسبوا السيئات جزاء سيئة بمثلها وترهقهم ذلة ما لهم من الله من عاصم كأنما أغشيت وجوههم قطعا من الليل مظلما أولئك أصحاب النار هم فيها خالدون" />
-		<aya index="28" text="ويوم نحشرهم جميعا ثم نقول للذين أشركوا مكانكم أنتم وشركاؤكم فزيلنا بينهم وقال شركاؤهم ما كنتم إيانا تعبدون" />
-		<aya index="29" text="فكفى بالله شهيدا بيننا وبينكم إن كنا عن عبادتكم لغافلين" />
-		<aya index="30" text="هنالك تبلو كل نفس ما أسلفت وردوا إلى الله مولاهم الحق وضل عنهم ما كانوا يفترون" />
-		<aya index="31" text="قل من يرزقكم من السماء والأرض أمن يملك السمع والأبصار ومن يخرج الحي من الميت ويخرج الميت من الحي ومن يدبر الأمر فسيقولون الله فقل أفلا تتقون" />
-		<aya index="32" text="فذلكم الله ربكم الحق فماذا بعد الحق إلا الضلال فأنى تصرفون" />
-		<aya index="33" text="كذلك حقت كلمت ربك على الذين فسقوا أنهم لا يؤمنون" />
-		<aya index="34" text="قل هل من شركائكم من يبدأ الخلق ثم يعيده قل الله يبدأ الخلق ثم يعيده فأنى تؤفكون" />
-		<aya index="35" text="قل هل من شركائكم من يهدي إلى الحق قل الله يهدي للحق أفمن يهدي إلى الحق أحق أن يتبع أمن لا يهدي إلا أن يهدى فما لكم كيف تحكمون" />
-		<aya index="36" text="وما يتبع أكثرهم إلا ظنا إن الظن لا يغني من الحق شيئا إن الله عليم بما يفعلون" />
-		<aya index="37" text="وما كان هذا القرآن أن يفترى من دون الله ولكن تصديق الذي بين يديه وتفصيل الكتاب لا ريب فيه من رب العالمين" />
-		<aya index="38" text="أم يقولون افتراه قل فأتوا بسورة مثله وادعوا من استطعتم من دون الله إن كنتم صادقين" />
-		<aya index="39" text="بل كذبوا بما لم يحيطوا بعلمه ولما يأتهم تأويله كذلك كذب الذين من قبلهم فانظر كيف كان عاقبة الظالمين" />
-		<aya index="40" text="ومنهم من يؤمن به ومنهم من لا يؤمن به وربك أعلم بالمفسدين" />
-		<aya index="41" text="وإن كذبوك فقل لي عملي ولكم عملكم أنتم بريئون مما أعمل وأنا بريء مما تعملون" />
-		<aya index="42" text="ومنهم من يستمعون إليك أفأنت تسمع الصم ولو كانوا لا يعقلون" />
-		<aya index="43" text="ومنهم من ينظر إليك أفأنت تهدي العمي ولو كانوا لا يبصرون" />
-		<aya index="44" text="إن الله لا يظلم الناس شيئا ولكن الناس أنفسهم يظلمون" />
-		<aya index="45" text="ويوم يحشرهم كأن لم يلبثوا إلا ساعة من النهار يتعارفون بينهم قد خسر الذين كذبوا بلقاء الله وما كانوا مهتدين" />
-		<aya index="46" text="وإما نرينك بعض الذي نعدهم أو نتوفينك فإلينا مرجعهم ثم الله شهيد على ما يفعلون" />
-		<aya index="47" text="ولكل أمة رسول فإذا جاء رسولهم قضي بينهم بالقسط وهم لا يظلمون" />
-		<aya index="48" text="ويقولون متى هذا الوعد إن كنتم صادقين" />
-		<aya index="49" text="قل لا أملك لنفسي ضرا ولا نفعا إلا ما شاء الله لكل أمة أجل إذا جاء أجلهم فلا يستأخرون ساعة ولا يستقدمون" />
-		<aya index="50" text="قل أرأيتم إن أتاكم عذابه بياتا أو نهارا ماذا يستعجل منه المجرمون" />
-		<aya index="51" text="أثم إذا ما وقع آمنتم به آلآن وقد كنتم به تستعجلون" />
-		<aya index="52" text="ثم قيل للذين ظلموا ذوقوا عذاب الخلد هل تجزون إلا بما كنتم تكسبون" />
-		<aya index="53" text="ويستنبئونك أحق هو قل إي وربي إنه لحق وما أنتم بمعجزين" />
-		<aya index="54" text="ولو أن لكل نفس ظلمت ما في الأرض لافتدت به وأسروا الندامة لما رأوا العذاب وقضي بينهم بالقسط وهم لا يظلمون" />
-		<aya index="55" text="ألا إن لله ما في السماوات والأرض ألا إن وعد الله حق ولكن أكثرهم لا يعلمون" />
-		<aya index="56" text="هو يحيي ويميت وإليه ترجعون" />
-		<aya index="57" text="يا أيها الناس قد جاءتكم موعظة من ربكم وشفاء لما في الصدور وهدى ورحمة للمؤمنين" />
-		<aya index="58" text="قل بفضل الله وبرحمته فبذلك فليفرحوا هو خير مما يجمعون" />
-		<aya index="59" text="قل أرأيتم ما أنزل الله لكم من رزق فجعلتم منه حراما وحلالا قل آلله أذن لكم أم على الله تفترون" />
-		<aya index="60" text="وما ظن الذين يفترون على الله الكذب يوم القيامة إن الله لذو فضل على الناس ولكن أكثرهم لا يشكرون" />
-		<aya index="61" text="وما تكون في شأن وما تتلو منه من قرآن ولا تعملون من عمل إلا كنا عليكم شهودا إذ تفيضون فيه وما يعزب عن ربك من مثقال ذرة في الأرض ولا في السماء ولا أصغر من ذلك ولا أكبر إلا في كتاب مبين" />
-		<aya index="62" text="ألا إن أولياء الله لا خوف عليهم ولا هم يحزنون" />
-		<aya index="63" text="الذين آمنوا وكانوا يتقون" />
-		<aya index="64" text="لهم البشرى في الحياة الدنيا وفي الآخرة لا تبديل لكلمات الله ذلك هو الفوز العظيم" />
-		<aya index="65" text="ولا يحزنك قولهم إن العزة لله جميعا هو السميع العليم" />
-		<aya index="66" text="ألا إن لله من في السماوات ومن في الأرض وما يتبع الذين يدعون من دون الله شركاء إن يتبعون إلا الظن وإن هم إلا يخرصون" />
-		<aya index="67" text="هو الذي جعل لكم الليل لتسكنوا فيه والنهار مبصرا إن في ذلك لآيات لقوم يسمعون" />
-		<aya index="68" text="قالوا اتخذ الله ولدا سبحانه هو الغني له ما في السماوات وما في الأرض إن عندكم من سلطان بهذا أتقولون على الله ما لا تعلمون" />
-		<aya index="69" text="قل إن الذين يفترون على الله الكذب لا يفلحون" />
-		<aya index="70" text="متاع في الدنيا ثم إلينا مرجعهم ثم نذيقهم العذاب الشديد بما كانوا يكفرون" />
-		<aya index="71" text="واتل عليهم نبأ نوح إذ قال لقومه يا قوم إن كان كبر عليكم مقامي وتذكيري بآيات الله فعلى الله توكلت فأجمعوا أمركم وشركاءكم ثم لا يكن أمركم عليكم غمة ثم اقضوا إلي ولا تنظرون" />
-		<aya index="72" text="فإن توليتم فما سألتكم من أجر إن أجري إلا على الله وأمرت أن أكون من المسلمين" />
-		<aya index="73" text="فكذبوه فنجيناه ومن معه في الفلك وجعلناهم خلائف وأغرقنا الذين كذبوا بآياتنا فانظر كيف كان عاقبة المنذرين" />
-		<aya index="74" text="ثم بعثنا من بعده رسلا إلى قومهم فجاءوهم بالبينات فما كانوا ليؤمنوا بما كذبوا به من قبل كذلك نطبع على قلوب المعتدين" />
-		<aya index="75" text="ثم بعثنا من بعدهم موسى وهارون إلى فرعون وملئه بآياتنا فاستكبروا وكانوا قوما مجرمين" />
-		<aya index="76" text="فلما جاءهم الحق من عندنا قالوا إن هذا لسحر مبين" />
-		<aya index="77" text="قال موسى أتقولون للحق لما جاءكم أسحر هذا ولا يفلح الساحرون" />
-		<aya index="78" text="قالوا أجئتنا لتلفتنا عما وجدنا عليه آباءنا وتكون لكما الكبرياء في الأرض وما نحن لكما بمؤمنين" />
-		<aya index="79" text="وقال فرعون ائتوني بكل ساحر عليم" />
-		<aya index="80" text="فلما جاء السحرة قال لهم موسى ألقوا ما أنتم ملقون" />
-		<aya index="81" text="فلما ألقوا قال موسى ما جئتم به السحر إن الله سيبطله إن الله لا يصلح عمل المفسدين" />
-		<aya index="82" text="ويحق الله الحق بكلماته ولو كره المجرمون" />
-		<aya index="83" text="فما آمن لموسى إلا ذرية من قومه على خوف من فرعون وملئهم أن يفتنهم وإن فرعون لعال في الأرض وإنه لمن المسرفين" />
-		<aya index="84" text="وقال موسى يا قوم إن كنتم آمنتم بالله فعليه توكلوا إن كنتم مسلمين" />
-		<aya index="85" text="فقالوا على الله توكلنا ربنا لا تجعلنا فتنة للقوم الظالمين" />
-		<aya index="86" text="ونجنا برحمتك من القوم الكافرين" />
-		<aya index="87" text="وأوحينا إلى موسى وأخيه أن تبوآ لقومكما بمصر بيوتا واجعلوا بيوتكم قبلة وأقيموا الصلاة وبشر المؤمنين" />
-		<aya index="88" text="وقال موسى ربنا إنك آتيت فرعون وملأه زينة وأموالا في الحياة الدنيا ربنا ليضلوا عن سبيلك ربنا اطمس على أموالهم واشدد على قلوبهم فلا يؤمنوا حتى يروا العذاب الأليم" />
-		<aya index="89" text="قال قد أجيبت دعوتكما فاستقيما ولا تتبعان سبيل الذين لا يعلمون" />
-		<aya index="90" text="وجاوزنا ببني إسرائيل البحر فأتبعهم فرعون وجنوده بغيا وعدوا حتى إذا أدركه الغرق قال آمنت أنه لا إله إلا الذي آمنت به بنو إسرائيل وأنا من المسلمين" />
-		<aya index="91" text="آلآن وقد عصيت قبل وكنت من المفسدين" />
-		<aya index="92" text="فاليوم ننجيك ببدنك لتكون لمن خلفك آية وإن كثيرا من الناس عن آياتنا لغافلون" />
-		<aya index="93" text="ولقد بوأنا بني إسرائيل مبوأ صدق ورزقناهم من الطيبات فما اختلفوا حتى جاءهم العلم إن ربك يقضي بينهم يوم القيامة فيما كانوا فيه يختلفون" />
-		<aya index="94" text="فإن كنت في شك مما أنزلنا إليك فاسأل الذين يقرءون الكتاب من قبلك لقد جاءك الحق من ربك فلا تكونن من الممترين" />
-		<aya index="95" text="ولا تكونن من الذين كذبوا بآيات الله فتكون من الخاسرين" />
-		<aya index="96" text="إن الذين حقت عليهم كلمت ربك لا يؤمنون" />
-		<aya index="97" text="ولو جاءتهم كل آية حتى يروا العذاب الأليم" />
-		<aya index="98" text="فلولا كانت قرية آمنت فنفعها إيمانها إلا قوم يونس لما آمنوا كشفنا عنهم عذاب الخزي في الحياة الدنيا ومتعناهم إلى حين" />
-		<aya index="99" text="ولو شاء ربك لآمن من في الأرض كلهم جميعا أفأنت تكره الناس حتى يكونوا مؤمنين" />
-		<aya index="100" text="وما كان لنفس أن تؤمن إلا بإذن الله ويجعل الرجس على الذين لا يعقلون" />
-		<aya index="101" text="قل انظروا ماذا في السماوات والأرض وما تغني الآيات والنذر عن قوم لا يؤمنون" />
-		<aya index="102" text="فهل ينتظرون إلا مثل أيام الذين خلوا من قبلهم قل فانتظروا إني معكم من المنتظرين" />
-		<aya index="103" text="ثم ننجي رسلنا والذين آمنوا كذلك حقا علينا ننج المؤمنين" />
-		<aya index="104" text="قل يا أيها الناس إن كنتم في شك من ديني فلا أعبد الذين تعبدون من دون الله ولكن أعبد الله الذي يتوفاكم وأمرت أن أكون من المؤمنين" />
-		<aya index="105" text="وأن أقم وجهك للدين حنيفا ولا تكونن من المشركين" />
-		<aya index="106" text="ولا تدع من دون الله ما لا ينفعك ولا يضرك فإن فعلت فإنك إذا من الظالمين" />
-		<aya index="107" text="وإن يمسسك الله بضر فلا كاشف له إلا هو وإن يردك بخير فلا راد لفضله يصيب به من يشاء من عباده وهو الغفور الرحيم" />
-		<aya index="108" text="قل يا أيها الناس قد جاءكم الحق من ربكم فمن اهتدى فإنما يهتدي لنفسه ومن ضل فإنما يضل عليها وما أنا عليكم بوكيل" />
-		<aya index="109" text="واتبع ما يوحى إليك واصبر حتى يحكم الله وهو خير الحاكمين" />
-	</sura>
-	<sura index="11" name="هود">
-		<aya index="1" text="الر كتاب أحكمت آياته ثم فصلت من لدن حكيم خبير" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ألا تعبدوا إلا الله إنني لكم منه نذير وبشير" />
-		<aya index="3" text="وأن استغفروا ربكم ثم توبوا إليه يمتعكم متاعا حسنا إلى أجل مسمى ويؤت كل ذي فضل فضله وإن تولوا فإني أخاف عليكم عذاب يوم كبير" />
-		<aya index="4" text="إلى الله مرجعكم وهو على كل شيء قدير" />
-		<aya index="5" text="ألا إنهم يثنون صدورهم ليستخفوا منه ألا حين يستغشون ثيابهم يعلم ما يسرون وما يعلنون إنه عليم بذات الصدور" />
-		<aya index="6" text="وما من دابة في الأرض إلا على الله رزقها ويعلم مستقرها ومستودعها كل في كتاب مبين" />
-		<aya index="7" text="وهو الذي خلق السماوات والأرض في ستة أيام وكان عرشه على الماء ليبلوكم أيكم أحسن عملا ولئن قلت إنكم مبعوثون من بعد الموت ليقولن الذين كفروا إن هذا إلا سحر مبين" />
-		<aya index="8" text="ولئن أخرنا عنهم العذاب إلى أمة معدودة ليقولن ما يحبسه ألا يوم يأتيهم ليس مصروفا عنهم وحاق بهم ما كانوا به يستهزئون" />
-		<aya index="9" text="ولئن أذقنا الإنسان منا رحمة ثم نزعناها منه إنه ليئوس كفور" />
-		<aya index="10" text="ولئن أذقناه نعماء بعد ضراء مسته ليقولن ذهب السيئات عني إنه لفرح فخور" />
-		<aya index="11" text="إلا الذين صبروا وعملوا الصالحات أولئك لهم مغفرة وأجر كبير" />
-		<aya index="12" text="فلعلك تارك بعض ما يوحى إليك وضائق به صدرك أن يقولوا لولا أنزل عليه كنز أو جاء معه ملك إنما أنت نذير والله على كل شيء وكيل" />
-		<aya index="13" text="أم يقولون افتراه قل فأتوا بعشر سور مثله مفتريات وادعوا من استطعتم من دون الله إن كنتم صادقين" />
-		<aya index="14" text="فإلم يستجيبوا لكم فاعلموا أنما أنزل بعلم الله وأن لا إله إلا هو فهل أنتم مسلمون" />
-		<aya index="15" text="من كان يريد الحياة الدنيا وزينتها نوف إليهم أعمالهم فيها وهم فيها لا يبخسون" />
-		<aya index="16" text="أولئك الذين ليس لهم في الآخرة إلا النار وحبط ما صنعوا فيها وباطل ما كانوا يعملون" />
-		<aya index="17" text="أفمن كان على بينة من ربه ويتلوه شاهد منه ومن قبله كتاب موسى إماما ورحمة أولئك يؤمنون به ومن يكفر به من الأحزاب فالنار موعده فلا تك في مرية منه إنه الحق من ربك ولكن أكثر الناس لا يؤمنون" />
-		<aya index="18" text="ومن أظلم ممن افترى على الله كذبا أولئك يعرضون على ربهم ويقول الأشهاد هؤلاء الذين كذبوا على ربهم ألا لعنة الله على الظالمين" />
-		<aya index="19" text="الذين يصدون عن سبيل الله ويبغونها عوجا وهم بالآخرة هم كافرون" />
-		<aya index="20" text="أولئك لم يكونوا معجزين في الأرض وما كان لهم من دون الله من أولياء يضاعف لهم العذاب ما كانوا يستطيعون السمع وما كانوا يبصرون" />
-		<aya index="21" text="أولئك الذين خسروا أنفسهم وضل عنهم ما كانوا يفترون" />
-		<aya index="22" text="لا جرم أنهم في الآخرة هم الأخسرون" />
-		<aya index="23" text="إن الذين آمنوا وعملوا الصالحات وأخبتوا إلى ربهم أولئك أصحاب الجنة هم فيها خالدون" />
-		<aya index="24" text="مثل الفريقين كالأعمى والأصم والبصير والسميع هل يستويان مثلا أفلا تذكرون" />
-		<aya index="25" text="ولقد أرسلنا نوحا إلى قومه إني لكم نذير مبين" />
-		<aya index="26" text="أن لا تعبدوا إلا الله إني أخاف عليكم عذاب يوم أليم" />
-		<aya index="27" text="فقال الملأ الذين كفروا من قومه ما نراك إلا بشرا مثلنا وما نراك اتبعك إلا الذين هم أراذلنا بادي الرأي وما نرى لكم علينا من فضل بل نظنكم كاذبين" />
-		<aya index="28" text="قال يا قوم أرأيتم إن كنت على بينة من ربي وآتاني رحمة من عنده فعميت عليكم أنلزمكموها وأنتم لها كارهون" />
-		<aya index="29" text="ويا قوم لا أسألكم عليه مالا إن أجري إلا على الله وما أنا بطارد الذين آمنوا إنهم ملاقو ربهم ولكني أراكم قوما تجهلون" />
-		<aya index="30" text="ويا قوم من ينصرني من الله إن طردتهم أفلا تذكرون" />
-		<aya index="31" text="ولا أقول لكم عندي خزائن الله ولا أعلم الغيب ولا أقول إني ملك ولا أقول للذين تزدري أعينكم لن يؤتيهم الله خيرا الله أعلم بما في أنفسهم إني إذا لمن الظالمين" />
-		<aya index="32" text="قالوا يا نوح قد جادلتنا فأكثرت جدالنا فأتنا بما تعدنا إن كنت من الصادقين" />
-		<aya index="33" text="قال إنما يأتيكم به الله إن شاء وما أنتم بمعجزين" />
-		<aya index="34" text="ولا ينفعكم نصحي إن أردت أن أنصح لكم إن كان الله يريد أن يغويكم هو ربكم وإليه ترجعون" />
-		<aya index="35" text="أم يقولون افتراه قل إن افتريته فعلي إجرامي وأنا بريء مما تجرمون" />
-		<aya index="36" text="وأوحي إلى نوح أنه لن يؤمن من قومك إلا من قد آمن فلا تبتئس بما كانوا يفعلون" />
-		<aya index="37" text="واصنع الفلك بأعيننا ووحينا ولا تخاطبني في الذين ظلموا إنهم مغرقون" />
-		<aya index="38" text="ويصنع الفلك وكلما مر عليه ملأ من قومه سخروا منه قال إن تسخروا منا فإنا نسخر منكم كما تسخرون" />
-		<aya index="39" text="فسوف تعلمون من يأتيه عذاب يخزيه ويحل عليه عذاب مقيم" />
-		<aya index="40" text="حتى إذا جاء أمرنا وفار التنور قلنا احمل فيها من كل زوجين اثنين وأهلك إلا من سبق عليه القول ومن آمن وما آمن معه إلا قليل" />
-		<aya index="41" text="وقال اركبوا فيها بسم الله مجراها ومرساها إن ربي لغفور رحيم" />
-		<aya index="42" text="وهي تجري بهم في موج كالجبال ونادى نوح ابنه وكان في معزل يا بني اركب معنا ولا تكن مع الكافرين" />
-		<aya index="43" text="قال سآوي إلى جبل يعصمني من الماء قال لا عاصم اليوم من أمر الله إلا من رحم وحال بينهما الموج فكان من المغرقين" />
-		<aya index="44" text="وقيل يا أرض ابلعي ماءك ويا سماء أقلعي وغيض الماء وقضي الأمر واستوت على الجودي وقيل بعدا للقوم الظالمين" />
-		<aya index="45" text="ونادى نوح ربه فقال رب إن ابني من أهلي وإن وعدك الحق وأنت أحكم الحاكمين" />
-		<aya index="46" text="قال يا نوح إنه ليس من أهلك إنه عمل غير صالح فلا تسألن ما ليس لك به علم إني أعظك أن تكون من الجاهلين" />
-		<aya index="47" text="قال رب إني أعوذ بك أن أسألك ما ليس لي به علم وإلا تغفر لي وترحمني أكن من الخاسرين" />
-		<aya index="48" text="قيل يا نوح اهبط بسلام منا وبركات عليك وعلى أمم ممن معك وأمم سنمتعهم ثم يمسهم منا عذاب أليم" />
-		<aya index="49" text="تلك من أنباء الغيب نوحيها إليك ما كنت تعلمها أنت ولا قومك من قبل هذا فاصبر إن العاقبة للمتقين" />
-		<aya index="50" text="وإلى عاد أخاهم هودا قال يا قوم اعبدوا الله ما لكم من إله غيره إن أنتم إلا مفترون" />
-		<aya index="51" text="يا قوم لا أسألكم عليه أجرا إن أجري إلا على الذي فطرني أفلا تعقلون" />
-		<aya index="52" text="ويا قوم استغفروا ربكم ثم توبوا إليه يرسل السماء عليكم مدرارا ويزدكم قوة إلى قوتكم ولا تتولوا مجرمين" />
-		<aya index="53" text="قالوا يا هود ما جئتنا ببينة وما نحن بتاركي آلهتنا عن قولك وما نحن لك بمؤمنين" />
-		<aya index="54" text="إن نقول إلا اعتراك بعض آلهتنا بسوء قال إني أشهد الله واشهدوا أني بريء مما تشركون" />
-		<aya index="55" text="من دونه فكيدوني جميعا ثم لا تنظرون" />
-		<aya index="56" text="إني توكلت على الله ربي وربكم ما من دابة إلا هو آخذ بناصيتها إن ربي على صراط مستقيم" />
-		<aya index="57" text="فإن تولوا فقد أبلغتكم ما أرسلت به إليكم ويستخلف ربي قوما غيركم ولا تضرونه شيئا إن ربي على كل شيء حفيظ" />
-		<aya index="58" text="ولما جاء أمرنا نجينا هودا والذين آمنوا معه برحمة منا ونجيناهم من عذاب غليظ" />
-		<aya index="59" text="وتلك عاد جحدوا بآيات ربهم وعصوا رسله واتبعوا أمر كل جبار عنيد" />
-		<aya index="60" text="وأتبعوا في هذه الدنيا لعنة ويوم القيامة ألا إن عادا كفروا ربهم ألا بعدا لعاد قوم هود" />
-		<aya index="61" text="وإلى ثمود أخاهم صالحا قال يا قوم اعبدوا الله ما لكم من إله غيره هو أنشأكم من الأرض واستعمركم فيها فاستغفروه ثم توبوا إليه إن ربي قريب مجيب" />
-		<aya index="62" text="قالوا يا صالح قد كنت فينا مرجوا قبل هذا أتنهانا أن نعبد ما يعبد آباؤنا وإننا لفي شك مما تدعونا إليه مريب" />
-		<aya index="63" text="قال يا قوم أرأيتم إن كنت على بينة من ربي وآتاني منه رحمة فمن ينصرني من الله إن عصيته فما تزيدونني غير تخسير" />
-		<aya index="64" text="ويا قوم هذه ناقة الله لكم آية فذروها تأكل في أرض الله ولا تمسوها بسوء فيأخذكم عذاب قريب" />
-		<aya index="65" text="فعقروها فقال تمتعوا في داركم ثلاثة أيام ذلك وعد غير مكذوب" />
-		<aya index="66" text="فلما جاء أمرنا نجينا صالحا والذين آمنوا معه برحمة منا ومن خزي يومئذ إن ربك هو القوي العزيز" />
-		<aya index="67" text="وأخذ الذين ظلموا الصيحة فأصبحوا في ديارهم جاثمين" />
-		<aya index="68" text="كأن لم يغنوا فيها ألا إن ثمود كفروا ربهم ألا بعدا لثمود" />
-		<aya index="69" text="ولقد جاءت رسلنا إبراهيم بالبشرى قالوا سلاما قال سلام فما لبث أن جاء بعجل حنيذ" />
-		<aya index="70" text="فلما رأى أيديهم لا تصل إليه نكرهم وأوجس منهم خيفة قالوا لا تخف إنا أرسلنا إلى قوم لوط" />
-		<aya index="71" text="وامرأته قائمة فضحكت فبشرناها بإسحاق ومن وراء إسحاق يعقوب" />
-		<aya index="72" text="قالت يا ويلتى أألد وأنا عجوز وهذا بعلي شيخا إن هذا لشيء عجيب" />
-		<aya index="73" text="قالوا أتعجبين من أمر الله رحمت الله وبركاته عليكم أهل البيت إنه حميد مجيد" />
-		<aya index="74" text="فلما ذهب عن إبراهيم الروع وجاءته البشرى يجادلنا في قوم لوط" />
-		<aya index="75" text="إن إبراهيم لحليم أواه منيب" />
-		<aya index="76" text="يا إبراهيم أعرض عن هذا إنه قد جاء أمر ربك وإنهم آتيهم عذاب غير مردود" />
-		<aya index="77" text="ولما جاءت رسلنا لوطا سيء بهم وضاق بهم ذرعا وقال هذا يوم عصيب" />
-		<aya index="78" text="وجاءه قومه يهرعون إليه ومن قبل كانوا يعملون السيئات قال يا قوم هؤلاء بناتي هن أطهر لكم فاتقوا الله ولا تخزون في ضيفي أليس منكم رجل رشيد" />
-		<aya index="79" text="قالوا لقد علمت ما لنا في بناتك من حق وإنك لتعلم ما نريد" />
-		<aya index="80" text="قال لو أن لي بكم قوة أو آوي إلى ركن شديد" />
-		<aya index="81" text="قالوا يا لوط إنا رسل ربك لن يصلوا إليك فأسر بأهلك بقطع من الليل ولا يلتفت منكم أحد إلا امرأتك إنه مصيبها ما أصابهم إن موعدهم الصبح أليس الصبح بقريب" />
-		<aya index="82" text="فلما جاء أمرنا جعلنا عاليها سافلها وأمطرنا عليها حجارة من سجيل منضود" />
-		<aya index="83" text="مسومة عند ربك وما هي من الظالمين ببعيد" />
-		<aya index="84" text="وإلى مدين أخاهم شعيبا قال يا قوم اعبدوا الله ما لكم من إله غيره ولا تنقصوا المكيال والميزان إني أراكم بخير وإني أخاف عليكم عذاب يوم محيط" />
-		<aya index="85" text="ويا قوم أوفوا المكيال والميزان بالقسط ولا تبخسوا الناس أشياءهم ولا تعثوا في الأرض مفسدين" />
-		<aya index="86" text="بقيت الله خير لكم إن كنتم مؤمنين وما أنا عليكم بحفيظ" />
-		<aya index="87" text="قالوا يا شعيب أصلاتك تأمرك أن نترك ما يعبد آباؤنا أو أن نفعل في أموالنا ما نشاء إنك لأنت الحليم الرشيد" />
-		<aya index="88" text="قال يا قوم أرأيتم إن كنت على بينة من ربي ورزقني منه رزقا حسنا وما أريد أن أخالفكم إلى ما أنهاكم عنه إن أريد إلا الإصلاح ما استطعت وما توفيقي إلا بالله عليه توكلت وإليه أنيب" />
-		<aya index="89" text="ويا قوم لا يجرمنكم شقاقي أن يصيبكم مثل ما أصاب قوم نوح أو قوم هود أو قوم صالح وما قوم لوط منكم ببعيد" />
-		<aya index="90" text="واستغفروا ربكم ثم توبوا إليه إن ربي رحيم ودود" />
-		<aya index="91" text="قالوا يا شعيب ما نفقه كثيرا مما تقول وإنا لنراك فينا ضعيفا ولولا رهطك لرجمناك وما أنت علينا بعزيز" />
-		<aya index="92" text="قال يا قوم أرهطي أعز عليكم من الله واتخذتموه وراءكم ظهريا إن ربي بما تعملون محيط" />
-		<aya index="93" text="ويا قوم اعملوا على مكانتكم إني عامل سوف تعلمون من يأتيه عذاب يخزيه ومن هو كاذب وارتقبوا إني معكم رقيب" />
-		<aya index="94" text="ولما جاء أمرنا نجينا شعيبا والذين آمنوا معه برحمة منا وأخذت الذين ظلموا الصيحة فأصبحوا في ديارهم جاثمين" />
-		<aya index="95" text="كأن لم يغنوا فيها ألا بعدا لمدين كما بعدت ثمود" />
-		<aya index="96" text="ولقد أرسلنا موسى بآياتنا وسلطان مبين" />
-		<aya index="97" text="إلى فرعون وملئه فاتبعوا أمر فرعون وما أمر فرعون برشيد" />
-		<aya index="98" text="يقدم قومه يوم القيامة فأوردهم النار وبئس الورد المورود" />
-		<aya index="99" text="وأتبعوا في هذه لعنة ويوم القيامة بئس الرفد المرفود" />
-		<aya index="100" text="ذلك من أنباء القرى نقصه عليك منها قائم وحصيد" />
-		<aya index="101" text="وما ظلمناهم ولكن ظلموا أنفسهم فما أغنت عنهم آلهتهم التي يدعون من دون الله من شيء لما جاء أمر ربك وما زادوهم غير تتبيب" />
-		<aya index="102" text="وكذلك أخذ ربك إذا أخذ القرى وهي ظالمة إن أخذه أليم شديد" />
-		<aya index="103" text="إن في ذلك لآية لمن خاف عذاب الآخرة ذلك يوم مجموع له الناس وذلك يوم مشهود" />
-		<aya index="104" text="وما نؤخره إلا لأجل معدود" />
-		<aya index="105" text="يوم يأت لا تكلم نفس إلا بإذنه فمنهم شقي وسعيد" />
-		<aya index="106" text="فأما الذين شقوا ففي النار لهم فيها زفير وشهيق" />
-		<aya index="107" text="خالدين فيها ما دامت السماوات والأرض إلا ما شاء ربك إن ربك فعال لما يريد" />
-		<aya index="108" text="وأما الذين سعدوا ففي الجنة خالدين فيها ما دامت السماوات والأرض إلا ما شاء ربك عطاء غير مجذوذ" />
-		<aya index="109" text="فلا تك في مرية مما يعبد هؤلاء ما يعبدون إلا كما يعبد آباؤهم من قبل وإنا لموفوهم نصيبهم غير منقوص" />
-		<aya index="110" text="ولقد آتينا موسى الكتاب فاختلف فيه ولولا كلمة سبقت من ربك لقضي بينهم وإنهم لفي شك منه مريب" />
-		<aya index="111" text="وإن كلا لما ليوفينهم ربك أعمالهم إنه بما يعملون خبير" />
-		<aya index="112" text="فاستقم كما أمرت ومن تاب معك ولا تطغوا إنه بما تعملون بصير" />
-		<aya index="113" text="ولا تركنوا إلى الذين ظلموا فتمسكم النار وما لكم من دون الله من أولياء ثم لا تنصرون" />
-		<aya index="114" text="وأقم الصلاة طرفي النهار وزلفا من الليل إن الحسنات يذهبن السيئات ذلك ذكرى للذاكرين" />
-		<aya index="115" text="واصبر فإن الله لا يضيع أجر المحسنين" />
-		<aya index="116" text="فلولا كان من القرون من قبلكم أولو بقية ينهون عن الفساد في الأرض إلا قليلا ممن أنجينا منهم واتبع الذين ظلموا ما أترفوا فيه وكانوا مجرمين" />
-		<aya index="117" text="وما كان ربك ليهلك القرى بظلم وأهلها مصلحون" />
-		<aya index="118" text="ولو شاء ربك لجعل الناس أمة واحدة ولا يزالون مختلفين" />
-		<aya index="119" text="إلا من رحم ربك ولذلك خلقهم وتمت كلمة ربك لأملأن جهنم من الجنة والناس أجمعين" />
-		<aya index="120" text="وكلا نقص عليك من أنباء الرسل ما نثبت به فؤادك وجاءك في هذه الحق وموعظة وذكرى للمؤمنين" />
-		<aya index="121" text="وقل للذين لا يؤمنون اعملوا على مكانتكم إنا عاملون" />
-		<aya index="122" text="وانتظروا إنا منتظرون" />
-		<aya index="123" text="ولله غيب السماوات والأرض وإليه يرجع الأمر كله فاعبده وتوكل عليه وما ربك بغافل عما تعملون" />
-	</sura>
-	<sura index="12" name="يوسف">
-		<aya index="1" text="الر تلك آيات الكتاب المبين" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="إنا أنزلناه قرآنا عربيا لعلكم تعقلون" />
-		<aya index="3" text="نحن نقص عليك أحسن القصص بما أوحينا إليك هذا القرآن وإن كنت من قبله لمن الغافلين" />
-		<aya index="4" text="إذ قال يوسف لأبيه يا أبت إني رأيت أحد عشر كوكبا والشمس والقمر رأيتهم لي ساجدين" />
-		<aya index="5" text="قال يا بني لا تقصص رؤياك على إخوتك فيكيدوا لك كيدا إن الشيطان للإنسان عدو مبين" />
-		<aya index="6" text="وكذلك يجتبيك ربك ويعلمك من تأويل الأحاديث ويتم نعمته عليك وعلى آل يعقوب كما أتمها على أبويك من قبل إبراهيم وإسحاق إن ربك عليم حكيم" />
-		<aya index="7" text="لقد كان في يوسف وإخوته آيات للسائلين" />
-		<aya index="8" text="إذ قالوا ليوسف وأخوه أحب إلى أبينا منا ونحن عصبة إن أبانا لفي ضلال مبين" />
-		<aya index="9" text="اقتلوا يوسف أو اطرحوه أرضا يخل لكم وجه أبيكم وتكونوا من بعده قوما صالحين" />
-		<aya index="10" text="قال قائل منهم لا تقتلوا يوسف وألقوه في غيابت الجب يلتقطه بعض السيارة إن كنتم فاعلين" />
-		<aya index="11" text="قالوا يا أبانا ما لك لا تأمنا على يوسف وإنا له لناصحون" />
-		<aya index="12" text="أرسله معنا غدا يرتع ويلعب وإنا له لحافظون" />
-		<aya index="13" text="قال إني ليحزنني أن تذهبوا به وأخاف أن يأكله الذئب وأنتم عنه غافلون" />
-		<aya index="14" text="قالوا لئن أكله الذئب ونحن عصبة إنا إذا لخاسرون" />
-		<aya index="15" text="فلما ذهبوا به وأجمعوا أن يجعلوه في غيابت الجب وأوحينا إليه لتنبئنهم بأمرهم هذا وهم لا يشعرون" />
-		<aya index="16" text="وجاءوا أباهم عشاء يبكون" />
-		<aya index="17" text="قالوا يا أبانا إنا ذهبنا نستبق وتركنا يوسف عند متاعنا فأكله الذئب وما أنت بمؤمن لنا ولو كنا صادقين" />
-		<aya index="18" text="وجاءوا على قميصه بدم كذب قال بل سولت لكم أنفسكم أمرا فصبر جميل والله المستعان على ما تصفون" />
-		<aya index="19" text="وجاءت سيارة فأرسلوا واردهم فأدلى دلوه قال يا بشرى هذا غلام وأسروه بضاعة والله عليم بما يعملون" />
-		<aya index="20" text="وشروه بثمن بخس دراهم معدودة وكانوا فيه من الزاهدين" />
-		<aya index="21" text="وقال الذي اشتراه من مصر لامرأته أكرمي مثواه عسى أن ينفعنا أو نتخذه ولدا وكذلك مكنا ليوسف في الأرض ولنعلمه من تأويل الأحاديث والله غالب على أمره ولكن أكثر الناس لا يعلمون" />
-		<aya index="22" text="ولما بلغ أشده آتيناه حكما وعلما وكذلك نجزي المحسنين" />
-		<aya index="23" text="وراودته التي هو في بيتها عن نفسه وغلقت الأبواب وقالت هيت لك قال معاذ الله إنه ربي أحسن مثواي إنه لا يفلح الظالمون" />
-		<aya index="24" text="ولقد همت به وهم بها لولا أن رأى برهان ربه كذلك لنصرف عنه السوء والفحشاء إنه من عبادنا المخلصين" />
-		<aya index="25" text="واستبقا الباب وقدت قميصه من دبر وألفيا سيدها لدى الباب قالت ما جزاء من أراد بأهلك سوءا إلا أن يسجن أو عذاب أليم" />
-		<aya index="26" text="قال هي راودتني عن نفسي وشهد شاهد من أهلها إن كان قميصه قد من قبل فصدقت وهو من الكاذبين" />
-		<aya index="27" text="وإن كان قميصه قد من دبر فكذبت وهو من الصادقين" />
-		<aya index="28" text="فلما رأى قميصه قد من دبر قال إنه من كيدكن إن كيدكن عظيم" />
-		<aya index="29" text="يوسف أعرض عن هذا واستغفري لذنبك إنك كنت من الخاطئين" />
-		<aya index="30" text="وقال نسوة في المدينة امرأت العزيز تراود فتاها عن نفسه قد شغفها حبا إنا لنراها في ضلال مبين" />
-		<aya index="31" text="فلما سمعت بمكرهن أرسلت إليهن وأعتدت لهن متكأ وآتت كل واحدة منهن سكينا وقالت اخرج عليهن فلما رأينه أكبرنه وقطعن أيديهن وقلن حاش لله ما هذا بشرا إن هذا إلا ملك كريم" />
-		<aya index="32" text="قالت فذلكن الذي لمتنني فيه ولقد راودته عن نفسه فاستعصم ولئن لم يفعل ما آمره ليسجنن وليكونا من الصاغرين" />
-		<aya index="33" text="قال رب السجن أحب إلي مما يدعونني إليه وإلا تصرف عني كيدهن أصب إليهن وأكن من الجاهلين" />
-		<aya index="34" text="فاستجاب له ربه فصرف عنه كيدهن إنه هو السميع العليم" />
-		<aya index="35" text="ثم بدا لهم من بعد ما رأوا الآيات ليسجننه حتى حين" />
-		<aya index="36" text="ودخل معه السجن فتيان قال أحدهما إني أراني أعصر خمرا وقال الآخر إني أراني أحمل فوق رأسي خبزا تأكل الطير منه نبئنا بتأويله إنا نراك من المحسنين" />
-		<aya index="37" text="قال لا يأتيكما طعام ترزقانه إلا نبأتكما بتأويله قبل أن يأتيكما ذلكما مما علمني ربي إني تركت ملة قوم لا يؤمنون بالله وهم بالآخرة هم كافرون" />
-		<aya index="38" text="واتبعت ملة آبائي إبراهيم وإسحاق ويعقوب ما كان لنا أن نشرك بالله من شيء ذلك من فضل الله علينا وعلى الناس ولكن أكثر الناس لا يشكرون" />
-		<aya index="39" text="يا صاحبي السجن أأرباب متفرقون خير أم الله الواحد القهار" />
-		<aya index="40" text="ما تعبدون من دونه إلا أسماء سميتموها أنتم وآباؤكم ما أنزل الله بها من سلطان إن الحكم إلا لله أمر ألا تعبدوا إلا إياه ذلك الدين القيم ولكن أكثر الناس لا يعلمون" />
-		<aya index="41" text="يا صاحبي السجن أما أحدكما فيسقي ربه خمرا وأما الآخر فيصلب فتأكل الطير من رأسه قضي الأمر الذي فيه تستفتيان" />
-		<aya index="42" text="وقال للذي ظن أنه ناج منهما اذكرني عند ربك فأنساه الشيطان ذكر ربه فلبث في السجن بضع سنين" />
-		<aya index="43" text="وقال الملك إني أرى سبع بقرات سمان يأكلهن سبع عجاف وسبع سنبلات خضر وأخر يابسات يا أيها الملأ أفتوني في رؤياي إن كنتم للرؤيا تعبرون" />
-		<aya index="44" text="قالوا أضغاث أحلام وما نحن بتأويل الأحلام بعالمين" />
-		<aya index="45" text="وقال الذي نجا منهما وادكر بعد أمة أنا أنبئكم بتأويله فأرسلون" />
-		<aya index="46" text="يوسف أيها الصديق أفتنا في سبع بقرات سمان يأكلهن سبع عجاف وسبع سنبلات خضر وأخر يابسات لعلي أرجع إلى الناس لعلهم يعلمون" />
-		<aya index="47" text="قال تزرعون سبع سنين دأبا فما حصدتم فذروه في سنبله إلا قليلا مما تأكلون" />
-		<aya index="48" text="ثم يأتي من بعد ذلك سبع شداد يأكلن ما قدمتم لهن إلا قليلا مما تحصنون" />
-		<aya index="49" text="ثم يأتي من بعد ذلك عام فيه يغاث الناس وفيه يعصرون" />
-		<aya index="50" text="وقال الملك ائتوني به فلما جاءه الرسول قال ارجع إلى ربك فاسأله ما بال النسوة اللاتي قطعن أيديهن إن ربي بكيدهن عليم" />
-		<aya index="51" text="قال ما خطبكن إذ راودتن يوسف عن نفسه قلن حاش لله ما علمنا عليه من سوء قالت امرأت العزيز الآن حصحص الحق أنا راودته عن نفسه وإنه لمن الصادقين" />
-		<aya index="52" text="ذلك ليعلم أني لم أخنه بالغيب وأن الله لا يهدي كيد الخائنين" />
-		<aya index="53" text="وما أبرئ نفسي إن النفس لأمارة بالسوء إلا ما رحم ربي إن ربي غفور رحيم" />
-		<aya index="54" text="وقال الملك ائتوني به أستخلصه لنفسي فلما كلمه قال إنك اليوم لدينا مكين أمين" />
-		<aya index="55" text="قال اجعلني على خزائن الأرض إني حفيظ عليم" />
-		<aya index="56" text="وكذلك مكنا ليوسف في الأرض يتبوأ منها حيث يشاء نصيب برحمتنا من نشاء ولا نضيع أجر المحسنين" />
-		<aya index="57" text="ولأجر الآخرة خير للذين آمنوا وكانوا يتقون" />
-		<aya index="58" text="وجاء إخوة يوسف فدخلوا عليه فعرفهم وهم له منكرون" />
-		<aya index="59" text="ولما جهزهم بجهازهم قال ائتوني بأخ لكم من أبيكم ألا ترون أني أوفي الكيل وأنا خير المنزلين" />
-		<aya index="60" text="فإن لم تأتوني به فلا كيل لكم عندي ولا تقربون" />
-		<aya index="61" text="قالوا سنراود عنه أباه وإنا لفاعلون" />
-		<aya index="62" text="وقال لفتيانه اجعلوا بضاعتهم في رحالهم لعلهم يعرفونها إذا انقلبوا إلى أهلهم لعلهم يرجعون" />
-		<aya index="63" text="فلما رجعوا إلى أبيهم قالوا يا أبانا منع منا الكيل فأرسل معنا أخانا نكتل وإنا له لحافظون" />
-		<aya index="64" text="قال هل آمنكم عليه إلا كما أمنتكم على أخيه من قبل فالله خير حافظا وهو أرحم الراحمين" />
-		<aya index="65" text="ولما فتحوا متاعهم وجدوا بضاعتهم ردت إليهم قالوا يا أبانا ما نبغي هذه بضاعتنا ردت إلينا ونمير أهلنا ونحفظ أخانا ونزداد كيل بعير ذلك كيل يسير" />
-		<aya index="66" text="قال لن أرسله معكم حتى تؤتون موثقا من الله لتأتنني به إلا أن يحاط بكم فلما آتوه موثقهم قال الله على ما نقول وكيل" />
-		<aya index="67" text="وقال يا بني لا تدخلوا من باب واحد وادخلوا من أبواب متفرقة وما أغني عنكم من الله من شيء إن الحكم إلا لله عليه توكلت وعليه فليتوكل المتوكلون" />
-		<aya index="68" text="ولما دخلوا من حيث أمرهم أبوهم ما كان يغني عنهم من الله من شيء إلا حاجة في نفس يعقوب قضاها وإنه لذو علم لما علمناه ولكن أكثر الناس لا يعلمون" />
-		<aya index="69" text="ولما دخلوا على يوسف آوى إليه أخاه قال إني أنا أخوك فلا تبتئس بما كانوا يعملون" />
-		<aya index="70" text="فلما جهزهم بجهازهم جعل السقاية في رحل أخيه ثم أذن مؤذن أيتها العير إنكم لسارقون" />
-		<aya index="71" text="قالوا وأقبلوا عليهم ماذا تفقدون" />
-		<aya index="72" text="قالوا نفقد صواع الملك ولمن جاء به حمل بعير وأنا به زعيم" />
-		<aya index="73" text="قالوا تالله لقد علمتم ما جئنا لنفسد في الأرض وما كنا سارقين" />
-		<aya index="74" text="قالوا فما جزاؤه إن كنتم كاذبين" />
-		<aya index="75" text="قالوا جزاؤه من وجد في رحله فهو جزاؤه كذلك نجزي الظالمين" />
-		<aya index="76" text="فبدأ بأوعيتهم قبل وعاء أخيه ثم استخرجها من وعاء أخيه كذلك كدنا ليوسف ما كان ليأخذ أخاه في دين الملك إلا أن يشاء الله نرفع درجات من نشاء وفوق كل ذي علم عليم" />
-		<aya index="77" text="قالوا إن يسرق فقد سرق أخ له من قبل فأسرها يوسف في نفسه ولم يبدها لهم قال أنتم شر مكانا والله أعلم بما تصفون" />
-		<aya index="78" text="قالوا يا أيها العزيز إن له أبا شيخا كبيرا فخذ أحدنا مكانه إنا نراك من المحسنين" />
-		<aya index="79" text="قال معاذ الله أن نأخذ إلا من وجدنا متاعنا عنده إنا إذا لظالمون" />
-		<aya index="80" text="فلما استيأسوا منه خلصوا نجيا قال كبيرهم ألم تعلموا أن أباكم قد أخذ عليكم موثقا من الله ومن قبل ما فرطتم في يوسف فلن أبرح الأرض حتى يأذن لي أبي أو يحكم الله لي وهو خير الحاكمين" />
-		<aya index="81" text="ارجعوا إلى أبيكم فقولوا يا أبانا إن ابنك سرق وما شهدنا إلا بما علمنا وما كنا للغيب حافظين" />
-		<aya index="82" text="واسأل القرية التي كنا فيها والعير التي أقبلنا فيها وإنا لصادقون" />
-		<aya index="83" text="قال بل سولت لكم أنفسكم أمرا فصبر جميل عسى الله أن يأتيني بهم جميعا إنه هو العليم الحكيم" />
-		<aya index="84" text="وتولى عنهم وقال يا أسفى على يوسف وابيضت عيناه من الحزن فهو كظيم" />
-		<aya index="85" text="قالوا تالله تفتأ تذكر يوسف حتى تكون حرضا أو تكون من الهالكين" />
-		<aya index="86" text="قال إنما أشكو بثي وحزني إلى الله وأعلم من الله ما لا تعلمون" />
-		<aya index="87" text="يا بني اذهبوا فتحسسوا من يوسف وأخيه ولا تيأسوا من روح الله إنه لا ييأس من روح الله إلا القوم الكافرون" />
-		<aya index="88" text="فلما دخلوا عليه قالوا يا أيها العزيز مسنا وأهلنا الضر وجئنا ببضاعة مزجاة فأوف لنا الكيل وتصدق علينا إن الله يجزي المتصدقين" />
-		<aya index="89" text="قال هل علمتم ما فعلتم بيوسف وأخيه إذ أنتم جاهلون" />
-		<aya index="90" text="قالوا أإنك لأنت يوسف قال أنا يوسف وهذا أخي قد من الله علينا إنه من يتق ويصبر فإن الله لا يضيع أجر المحسنين" />
-		<aya index="91" text="قالوا تالله لقد آثرك الله علينا وإن كنا لخاطئين" />
-		<aya index="92" text="قال لا تثريب عليكم اليوم يغفر الله لكم وهو أرحم الراحمين" />
-		<aya index="93" text="اذهبوا بقميصي هذا فألقوه على وجه أبي يأت بصيرا وأتوني بأهلكم أجمعين" />
-		<aya index="94" text="ولما فصلت العير قال أبوهم إني لأجد ريح يوسف لولا أن تفندون" />
-		<aya index="95" text="قالوا تالله إنك لفي ضلالك القديم" />
-		<aya index="96" text="فلما أن جاء البشير ألقاه على وجهه فارتد بصيرا قال ألم أقل لكم إني أعلم من الله ما لا تعلمون" />
-		<aya index="97" text="قالوا يا أبانا استغفر لنا ذنوبنا إنا كنا خاطئين" />
-		<aya index="98" text="قال سوف أستغفر لكم ربي إنه هو الغفور الرحيم" />
-		<aya index="99" text="فلما دخلوا على يوسف آوى إليه أبويه وقال ادخلوا مصر إن شاء الله آمنين" />
-		<aya index="100" text="ورفع أبويه على العرش وخروا له سجدا وقال يا أبت هذا تأويل رؤياي من قبل قد جعلها ربي حقا وقد أحسن بي إذ أخرجني من السجن وجاء بكم من البدو من بعد أن نزغ الشيطان بيني وبين إخوتي إن ربي لطيف لما يشاء إنه هو العليم الحكيم" />
-		<aya index="101" text="رب قد آتيتني من الملك وعلمتني من تأويل الأحاديث فاطر السماوات والأرض أنت وليي في الدنيا والآخرة توفني مسلما وألحقني بالصالحين" />
-		<aya index="102" text="ذلك من أنباء الغيب نوحيه إليك وما كنت لديهم إذ أجمعوا أمرهم وهم يمكرون" />
-		<aya index="103" text="وما أكثر الناس ولو حرصت بمؤمنين" />
-		<aya index="104" text="وما تسألهم عليه من أجر إن هو إلا ذكر للعالمين" />
-		<aya index="105" text="وكأين من آية في السماوات والأرض يمرون عليها وهم عنها معرضون" />
-		<aya index="106" text="وما يؤمن أكثرهم بالله إلا وهم مشركون" />
-		<aya index="107" text="أفأمنوا أن تأتيهم غاشية من عذاب الله أو تأتيهم الساعة بغتة وهم لا يشعرون" />
-		<aya index="108" text="قل هذه سبيلي أدعو إلى الله على بصيرة أنا ومن اتبعني وسبحان الله وما أنا من المشركين" />
-		<aya index="109" text="وما أرسلنا من قبلك إلا رجالا نوحي إليهم من أهل القرى أفلم يسيروا في الأرض فينظروا كيف كان عاقبة الذين من قبلهم ولدار الآخرة خير للذين اتقوا أفلا تعقلون" />
-		<aya index="110" text="حتى إذا استيأس الرسل وظنوا أنهم قد كذبوا جاءهم نصرنا فنجي من نشاء ولا يرد بأسنا عن القوم المجرمين" />
-		<aya index="111" text="لقد كان في قصصهم عبرة لأولي الألباب ما كان حديثا يفترى ولكن تصديق الذي بين يديه وتفصيل كل شيء وهدى ورحمة لقوم يؤمنون" />
-	</sura>
-	<sura index="13" name="الرعد">
-		<aya index="1" text="المر تلك آيات الكتاب والذي أنزل إليك من ربك الحق ولكن أكثر الناس لا يؤمنون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الله الذي رفع السماوات بغير عمد ترونها ثم استوى على العرش وسخر الشمس والقمر كل يجري لأجل مسمى يدبر الأمر يفصل الآيات لعلكم بلقاء ربكم توقنون" />
-		<aya index="3" text="وهو الذي مد الأرض وجعل فيها رواسي وأنهارا ومن كل الثمرات جعل فيها زوجين اثنين يغشي الليل النهار إن في ذلك لآيات لقوم يتفكرون" />
-		<aya index="4" text="وفي الأرض قطع متجاورات وجنات من أعناب وزرع ونخيل صنوان وغير صنوان يسقى بماء واحد ونفضل بعضها على بعض في الأكل إن في ذلك لآيات لقوم يعقلون" />
-		<aya index="5" text="وإن تعجب فعجب قولهم أإذا كنا ترابا أإنا لفي خلق جديد أولئك الذين كفروا بربهم وأولئك الأغلال في أعناقهم وأولئك أصحاب النار هم فيها خالدون" />
-		<aya index="6" text="ويستعجلونك بالسيئة قبل الحسنة وقد خلت من قبلهم المثلات وإن ربك لذو مغفرة للناس على ظلمهم وإن ربك لشديد العقاب" />
-		<aya index="7" text="ويقول الذين كفروا لولا أنزل عليه آية من ربه إنما أنت منذر ولكل قوم هاد" />
-		<aya index="8" text="الله يعلم ما تحمل كل أنثى وما تغيض الأرحام وما تزداد وكل شيء عنده بمقدار" />
-		<aya index="9" text="عالم الغيب والشهادة الكبير المتعال" />
-		<aya index="10" text="سواء منكم من أسر القول ومن جهر به ومن هو مستخف بالليل وسارب بالنهار" />
-		<aya index="11" text="له معقبات من بين يديه ومن خلفه يحفظونه من أمر الله إن الله لا يغير ما بقوم حتى يغيروا ما بأنفسهم وإذا أراد الله بقوم سوءا فلا مرد له وما لهم من دونه من وال" />
-		<aya index="12" text="هو الذي يريكم البرق خوفا وطمعا وينشئ السحاب الثقال" />
-		<aya index="13" text="ويسبح الرعد بحمده والملائكة من خيفته ويرسل الصواعق فيصيب بها من يشاء وهم يجادلون في الله وهو شديد المحال" />
-		<aya index="14" text="له دعوة الحق والذين يدعون من دونه لا يستجيبون لهم بشيء إلا كباسط كفيه إلى الماء ليبلغ فاه وما هو ببالغه وما دعاء الكافرين إلا في ضلال" />
-		<aya index="15" text="ولله يسجد من في السماوات والأرض طوعا وكرها وظلالهم بالغدو والآصال" />
-		<aya index="16" text="قل من رب السماوات والأرض قل الله قل أفاتخذتم من دونه أولياء لا يملكون لأنفسهم نفعا ولا ضرا قل هل يستوي الأعمى والبصير أم هل تستوي الظلمات والنور أم جعلوا لله شركاء خلقوا كخلقه فتشابه الخلق عليهم قل الله خالق كل شيء وهو الواحد القهار" />
-		<aya index="17" text="أنزل من السماء ماء فسالت أودية بقدرها فاحتمل السيل زبدا رابيا ومما يوقدون عليه في النار ابتغاء حلية أو متاع زبد مثله كذلك يضرب الله الحق والباطل فأما الزبد فيذهب جفاء وأما ما ينفع الناس فيمكث في الأرض كذلك يضرب الله الأمثال" />
-		<aya index="18" text="للذين استجابوا لربهم الحسنى والذين لم يستجيبوا له لو أن لهم ما في الأرض جميعا ومثله معه لافتدوا به أولئك لهم سوء الحساب ومأواهم جهنم وبئس المهاد" />
-		<aya index="19" text="أفمن يعلم أنما أنزل إليك من ربك الحق كمن هو أعمى إنما يتذكر أولو الألباب" />
-		<aya index="20" text="الذين يوفون بعهد الله ولا ينقضون الميثاق" />
-		<aya index="21" text="والذين يصلون ما أمر الله به أن يوصل ويخشون ربهم ويخافون سوء الحساب" />
-		<aya index="22" text="والذين صبروا ابتغاء وجه ربهم وأقاموا الصلاة وأنفقوا مما رزقناهم سرا وعلانية ويدرءون بالحسنة السيئة أولئك لهم عقبى الدار" />
-		<aya index="23" text="جنات عدن يدخلونها ومن صلح من آبائهم وأزواجهم وذرياتهم والملائكة يدخلون عليهم من كل باب" />
-		<aya index="24" text="سلام عليكم بما صبرتم فنعم عقبى الدار" />
-		<aya index="25" text="والذين ينقضون عهد الله من بعد ميثاقه ويقطعون ما أمر الله به أن يوصل ويفسدون في الأرض أولئك لهم اللعنة ولهم سوء الدار" />
-		<aya index="26" text="الله يبسط الرزق لمن يشاء ويقدر وفرحوا بالحياة الدنيا وما الحياة الدنيا في الآخرة إلا متاع" />
-		<aya index="27" text="ويقول الذين كفروا لولا أنزل عليه آية من ربه قل إن الله يضل من يشاء ويهدي إليه من أناب" />
-		<aya index="28" text="الذين آمنوا وتطمئن قلوبهم بذكر الله ألا بذكر الله تطمئن القلوب" />
-		<aya index="29" text="الذين آمنوا وعملوا الصالحات طوبى لهم وحسن مآب" />
-		<aya index="30" text="كذلك أرسلناك في أمة قد خلت من قبلها أمم لتتلو عليهم الذي أوحينا إليك وهم يكفرون بالرحمن قل هو ربي لا إله إلا هو عليه توكلت وإليه متاب" />
-		<aya index="31" text="ولو أن قرآنا سيرت به الجبال أو قطعت به الأرض أو كلم به الموتى بل لله الأمر جميعا أفلم ييأس الذين آمنوا أن لو يشاء الله لهدى الناس جميعا ولا يزال الذين كفروا تصيبهم بما صنعوا قارعة أو تحل قريبا من دارهم حتى يأتي وعد الله إن الله لا يخلف الميعاد" />
-		<aya index="32" text="ولقد استهزئ برسل من قبلك فأمليت للذين كفروا ثم أخذتهم فكيف كان عقاب" />
-		<aya index="33" text="أفمن هو قائم على كل نفس بما كسبت وجعلوا لله شركاء قل سموهم أم تنبئونه بما لا يعلم في الأرض أم بظاهر من القول بل زين للذين كفروا مكرهم وصدوا عن السبيل ومن يضلل الله فما له من هاد" />
-		<aya index="34" text="لهم عذاب في الحياة الدنيا ولعذاب الآخرة أشق وما لهم من الله من واق" />
-		<aya index="35" text="مثل الجنة التي وعد المتقون تجري من تحتها الأنهار أكلها دائم وظلها تلك عقبى الذين اتقوا وعقبى الكافرين النار" />
-		<aya index="36" text="والذين آتيناهم الكتاب يفرحون بما أنزل إليك ومن الأحزاب من ينكر بعضه قل إنما أمرت أن أعبد الله ولا أشرك به إليه أدعو وإليه مآب" />
-		<aya index="37" text="وكذلك أنزلناه حكما عربيا ولئن اتبعت أهواءهم بعدما جاءك من العلم ما لك من الله من ولي ولا واق" />
-		<aya index="38" text="ولقد أرسلنا رسلا من قبلك وجعلنا لهم أزواجا وذرية وما كان لرسول أن يأتي بآية إلا بإذن الله لكل أجل كتاب" />
-		<aya index="39" text="يمحو الله ما يشاء ويثبت وعنده أم الكتاب" />
-		<aya index="40" text="وإن ما نرينك بعض الذي نعدهم أو نتوفينك فإنما عليك البلاغ وعلينا الحساب" />
-		<aya index="41" text="أولم يروا أنا نأتي الأرض ننقصها من أطرافها والله يحكم لا معقب لحكمه وهو سريع الحساب" />
-		<aya index="42" text="وقد مكر الذين من قبلهم فلله المكر جميعا يعلم ما تكسب كل نفس وسيعلم الكفار لمن عقبى الدار" />
-		<aya index="43" text="ويقول الذين كفروا لست مرسلا قل كفى بالله شهيدا بيني وبينكم ومن عنده علم الكتاب" />
-	</sura>
-	<sura index="14" name="ابراهيم">
-		<aya index="1" text="الر كتاب أنزلناه إليك لتخرج الناس من الظلمات إلى النور بإذن ربهم إلى صراط العزيز الحميد" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الله الذي له ما في السماوات وما في الأرض وويل للكافرين من عذاب شديد" />
-		<aya index="3" text="الذين يستحبون الحياة الدنيا على الآخرة ويصدون عن سبيل الله ويبغونها عوجا أولئك في ضلال بعيد" />
-		<aya index="4" text="وما أرسلنا من رسول إلا بلسان قومه ليبين لهم فيضل الله من يشاء ويهدي من يشاء وهو العزيز الحكيم" />
-		<aya index="5" text="ولقد أرسلنا موسى بآياتنا أن أخرج قومك من الظلمات إلى النور وذكرهم بأيام الله إن في ذلك لآيات لكل صبار شكور" />
-		<aya index="6" text="وإذ قال موسى لقومه اذكروا نعمة الله عليكم إذ أنجاكم من آل فرعون يسومونكم سوء العذاب ويذبحون أبناءكم ويستحيون نساءكم وفي ذلكم بلاء من ربكم عظيم" />
-		<aya index="7" text="وإذ تأذن ربكم لئن شكرتم لأزيدنكم ولئن كفرتم إن عذابي لشديد" />
-		<aya index="8" text="وقال موسى إن تكفروا أنتم ومن في الأرض جميعا فإن الله لغني حميد" />
-		<aya index="9" text="ألم يأتكم نبأ الذين من قبلكم قوم نوح وعاد وثمود والذين من بعدهم لا يعلمهم إلا الله جاءتهم رسلهم بالبينات فردوا أيديهم في أفواههم وقالوا إنا كفرنا بما أرسلتم به وإنا لفي شك مما تدعوننا إليه مريب" />
-		<aya index="10" text="قالت رسلهم أفي الله شك فاطر السماوات والأرض يدعوكم ليغفر لكم من ذنوبكم ويؤخركم إلى أجل مسمى قالوا إن أنتم إلا بشر مثلنا تريدون أن تصدونا عما كان يعبد آباؤنا فأتونا بسلطان مبين" />
-		<aya index="11" text="قالت لهم رسلهم إن نحن إلا بشر مثلكم ولكن الله يمن على من يشاء من عباده وما كان لنا أن نأتيكم بسلطان إلا بإذن الله وعلى الله فليتوكل المؤمنون" />
-		<aya index="12" text="وما لنا ألا نتوكل على الله وقد هدانا سبلنا ولنصبرن على ما آذيتمونا وعلى الله فليتوكل المتوكلون" />
-		<aya index="13" text="وقال الذين كفروا لرسلهم لنخرجنكم من أرضنا أو لتعودن في ملتنا فأوحى إليهم ربهم لنهلكن الظالمين" />
-		<aya index="14" text="ولنسكننكم الأرض من بعدهم ذلك لمن خاف مقامي وخاف وعيد" />
-		<aya index="15" text="واستفتحوا وخاب كل جبار عنيد" />
-		<aya index="16" text="من ورائه جهنم ويسقى من ماء صديد" />
-		<aya index="17" text="يتجرعه ولا يكاد يسيغه ويأتيه الموت من كل مكان وما هو بميت ومن ورائه عذاب غليظ" />
-		<aya index="18" text="مثل الذين كفروا بربهم أعمالهم كرماد اشتدت به الريح في يوم عاصف لا يقدرون مما كسبوا على شيء ذلك هو الضلال البعيد" />
-		<aya index="19" text="ألم تر أن الله خلق السماوات والأرض بالحق إن يشأ يذهبكم ويأت بخلق جديد" />
-		<aya index="20" text="وما ذلك على الله بعزيز" />
-		<aya index="21" text="وبرزوا لله جميعا فقال الضعفاء للذين استكبروا إنا كنا لكم تبعا فهل أنتم مغنون عنا من عذاب الله من شيء قالوا لو هدانا الله لهديناكم سواء علينا أجزعنا أم صبرنا ما لنا من محيص" />
-		<aya index="22" text="وقال الشيطان لما قضي الأمر إن الله وعدكم وعد الحق ووعدتكم فأخلفتكم وما كان لي عليكم من سلطان إلا أن دعوتكم فاستجبتم لي فلا تلوموني ولوموا أنفسكم ما أنا بمصرخكم وما أنتم بمصرخي إني كفرت بما أشركتمون من قبل إن الظالمين لهم عذاب أليم" />
-		<aya index="23" text="وأدخل الذين آمنوا وعملوا الصالحات جنات تجري من تحتها الأنهار خالدين فيها بإذن ربهم تحيتهم فيها سلام" />
-		<aya index="24" text="ألم تر كيف ضرب الله مثلا كلمة طيبة كشجرة طيبة أصلها ثابت وفرعها في السماء" />
-		<aya index="25" text="تؤتي أكلها كل حين بإذن ربها ويضرب الله الأمثال للناس لعلهم يتذكرون" />
-		<aya index="26" text="ومثل كلمة خبيثة كشجرة خبيثة اجتثت من فوق الأرض ما لها من قرار" />
-		<aya index="27" text="يثبت الله الذين آمنوا بالقول الثابت في الحياة الدنيا وفي الآخرة ويضل الله الظالمين ويفعل الله ما يشاء" />
-		<aya index="28" text="ألم تر إلى الذين بدلوا نعمت الله كفرا وأحلوا قومهم دار البوار" />
-		<aya index="29" text="جهنم يصلونها وبئس القرار" />
-		<aya index="30" text="وجعلوا لله أندادا ليضلوا عن سبيله قل تمتعوا فإن مصيركم إلى النار" />
-		<aya index="31" text="قل لعبادي الذين آمنوا يقيموا الصلاة وينفقوا مما رزقناهم سرا وعلانية من قبل أن يأتي يوم لا بيع فيه ولا خلال" />
-		<aya index="32" text="الله الذي خلق السماوات والأرض وأنزل من السماء ماء فأخرج به من الثمرات رزقا لكم وسخر لكم الفلك لتجري في البحر بأمره وسخر لكم الأنهار" />
-		<aya index="33" text="وسخر لكم الشمس والقمر دائبين وسخر لكم الليل والنهار" />
-		<aya index="34" text="وآتاكم من كل ما سألتموه وإن تعدوا نعمت الله لا تحصوها إن الإنسان لظلوم كفار" />
-		<aya index="35" text="وإذ قال إبراهيم رب اجعل هذا البلد آمنا واجنبني وبني أن نعبد الأصنام" />
-		<aya index="36" text="رب إنهن أضللن كثيرا من الناس فمن تبعني فإنه مني ومن عصاني فإنك غفور رحيم" />
-		<aya index="37" text="ربنا إني أسكنت من ذريتي بواد غير ذي زرع عند بيتك المحرم ربنا ليقيموا الصلاة فاجعل أفئدة من الناس تهوي إليهم وارزقهم من الثمرات لعلهم يشكرون" />
-		<aya index="38" text="ربنا إنك تعلم ما نخفي وما نعلن وما يخفى على الله من شيء في الأرض ولا في السماء" />
-		<aya index="39" text="الحمد لله الذي وهب لي على الكبر إسماعيل وإسحاق إن ربي لسميع الدعاء" />
-		<aya index="40" text="رب اجعلني مقيم الصلاة ومن ذريتي ربنا وتقبل دعاء" />
-		<aya index="41" text="ربنا اغفر لي ولوالدي وللمؤمنين يوم يقوم الحساب" />
-		<aya index="42" text="ولا تحسبن الله غافلا عما يعمل الظالمون إنما يؤخرهم ليوم تشخص فيه الأبصار" />
-		<aya index="43" text="مهطعين مقنعي رءوسهم لا يرتد إليهم طرفهم وأفئدتهم هواء" />
-		<aya index="44" text="وأنذر الناس يوم يأتيهم العذاب فيقول الذين ظلموا ربنا أخرنا إلى أجل قريب نجب دعوتك ونتبع الرسل أولم تكونوا أقسمتم من قبل ما لكم من زوال" />
-		<aya index="45" text="وسكنتم في مساكن الذين ظلموا أنفسهم وتبين لكم كيف فعلنا بهم وضربنا لكم الأمثال" />
-		<aya index="46" text="وقد مكروا مكرهم وعند الله مكرهم وإن كان مكرهم لتزول منه الجبال" />
-		<aya index="47" text="فلا تحسبن الله مخلف وعده رسله إن الله عزيز ذو انتقام" />
-		<aya index="48" text="يوم تبدل الأرض غير الأرض والسماوات وبرزوا لله الواحد القهار" />
-		<aya index="49" text="وترى المجرمين يومئذ مقرنين في الأصفاد" />
-		<aya index="50" text="سرابيلهم من قطران وتغشى وجوههم النار" />
-		<aya index="51" text="ليجزي الله كل نفس ما كسبت إن الله سريع الحساب" />
-		<aya index="52" text="هذا بلاغ للناس ولينذروا به وليعلموا أنما هو إله واحد وليذكر أولو الألباب" />
-	</sura>
-	<sura index="15" name="الحجر">
-		<aya index="1" text="الر تلك آيات الكتاب وقرآن مبين" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ربما يود الذين كفروا لو كانوا مسلمين" />
-		<aya index="3" text="ذرهم يأكلوا ويتمتعوا ويلههم الأمل فسوف يعلمون" />
-		<aya index="4" text="وما أهلكنا من قرية إلا ولها كتاب معلوم" />
-		<aya index="5" text="ما تسبق من أمة أجلها وما يستأخرون" />
-		<aya index="6" text="وقالوا يا أيها الذي نزل عليه الذكر إنك لمجنون" />
-		<aya index="7" text="لو ما تأتينا بالملائكة إن كنت من الصادقين" />
-		<aya index="8" text="ما ننزل الملائكة إلا بالحق وما كانوا إذا منظرين" />
-		<aya index="9" text="إنا نحن نزلنا الذكر وإنا له لحافظون" />
-		<aya index="10" text="ولقد أرسلنا من قبلك في شيع الأولين" />
-		<aya index="11" text="وما يأتيهم من رسول إلا كانوا به يستهزئون" />
-		<aya index="12" text="كذلك نسلكه في قلوب المجرمين" />
-		<aya index="13" text="لا يؤمنون به وقد خلت سنة الأولين" />
-		<aya index="14" text="ولو فتحنا عليهم بابا من السماء فظلوا فيه يعرجون" />
-		<aya index="15" text="لقالوا إنما سكرت أبصارنا بل نحن قوم مسحورون" />
-		<aya index="16" text="ولقد جعلنا في السماء بروجا وزيناها للناظرين" />
-		<aya index="17" text="وحفظناها من كل شيطان رجيم" />
-		<aya index="18" text="إلا من استرق السمع فأتبعه شهاب مبين" />
-		<aya index="19" text="والأرض مددناها وألقينا فيها رواسي وأنبتنا فيها من كل شيء موزون" />
-		<aya index="20" text="وجعلنا لكم فيها معايش ومن لستم له برازقين" />
-		<aya index="21" text="وإن من شيء إلا عندنا خزائنه وما ننزله إلا بقدر معلوم" />
-		<aya index="22" text="وأرسلنا الرياح لواقح فأنزلنا من السماء ماء فأسقيناكموه وما أنتم له بخازنين" />
-		<aya index="23" text="وإنا لنحن نحيي ونميت ونحن الوارثون" />
-		<aya index="24" text="ولقد علمنا المستقدمين منكم ولقد علمنا المستأخرين" />
-		<aya index="25" text="وإن ربك هو يحشرهم إنه حكيم عليم" />
-		<aya index="26" text="ولقد خلقنا الإنسان من صلصال من حمإ مسنون" />
-		<aya index="27" text="والجان خلقناه من قبل من نار السموم" />
-		<aya index="28" text="وإذ قال ربك للملائكة إني خالق بشرا من صلصال من حمإ مسنون" />
-		<aya index="29" text="فإذا سويته ونفخت فيه من روحي فقعوا له ساجدين" />
-		<aya index="30" text="فسجد الملائكة كلهم أجمعون" />
-		<aya index="31" text="إلا إبليس أبى أن يكون مع الساجدين" />
-		<aya index="32" text="قال يا إبليس ما لك ألا تكون مع الساجدين" />
-		<aya index="33" text="قال لم أكن لأسجد لبشر خلقته من صلصال من حمإ مسنون" />
-		<aya index="34" text="قال فاخرج منها فإنك رجيم" />
-		<aya index="35" text="وإن عليك اللعنة إلى يوم الدين" />
-		<aya index="36" text="قال رب فأنظرني إلى يوم يبعثون" />
-		<aya index="37" text="قال فإنك من المنظرين" />
-		<aya index="38" text="إلى يوم الوقت المعلوم" />
-		<aya index="39" text="قال رب بما أغويتني لأزينن لهم في الأرض ولأغوينهم أجمعين" />
-		<aya index="40" text="إلا عبادك منهم المخلصين" />
-		<aya index="41" text="قال هذا صراط علي مستقيم" />
-		<aya index="42" text="إن عبادي ليس لك عليهم سلطان إلا من اتبعك من الغاوين" />
-		<aya index="43" text="وإن جهنم لموعدهم أجمعين" />
-		<aya index="44" text="لها سبعة أبواب لكل باب منهم جزء مقسوم" />
-		<aya index="45" text="إن المتقين في جنات وعيون" />
-		<aya index="46" text="ادخلوها بسلام آمنين" />
-		<aya index="47" text="ونزعنا ما في صدورهم من غل إخوانا على سرر متقابلين" />
-		<aya index="48" text="لا يمسهم فيها نصب وما هم منها بمخرجين" />
-		<aya index="49" text="نبئ عبادي أني أنا الغفور الرحيم" />
-		<aya index="50" text="وأن عذابي هو العذاب الأليم" />
-		<aya index="51" text="ونبئهم عن ضيف إبراهيم" />
-		<aya index="52" text="إذ دخلوا عليه فقالوا سلاما قال إنا منكم وجلون" />
-		<aya index="53" text="قالوا لا توجل إنا نبشرك بغلام عليم" />
-		<aya index="54" text="قال أبشرتموني على أن مسني الكبر فبم تبشرون" />
-		<aya index="55" text="قالوا بشرناك بالحق فلا تكن من القانطين" />
-		<aya index="56" text="قال ومن يقنط من رحمة ربه إلا الضالون" />
-		<aya index="57" text="قال فما خطبكم أيها المرسلون" />
-		<aya index="58" text="قالوا إنا أرسلنا إلى قوم مجرمين" />
-		<aya index="59" text="إلا آل لوط إنا لمنجوهم أجمعين" />
-		<aya index="60" text="إلا امرأته قدرنا إنها لمن الغابرين" />
-		<aya index="61" text="فلما جاء آل لوط المرسلون" />
-		<aya index="62" text="قال إنكم قوم منكرون" />
-		<aya index="63" text="قالوا بل جئناك بما كانوا فيه يمترون" />
-		<aya index="64" text="وأتيناك بالحق وإنا لصادقون" />
-		<aya index="65" text="فأسر بأهلك بقطع من الليل واتبع أدبارهم ولا يلتفت منكم أحد وامضوا حيث تؤمرون" />
-		<aya index="66" text="وقضينا إليه ذلك الأمر أن دابر هؤلاء مقطوع مصبحين" />
-		<aya index="67" text="وجاء أهل المدينة يستبشرون" />
-		<aya index="68" text="قال إن هؤلاء ضيفي فلا تفضحون" />
-		<aya index="69" text="واتقوا الله ولا تخزون" />
-		<aya index="70" text="قالوا أولم ننهك عن العالمين" />
-		<aya index="71" text="قال هؤلاء بناتي إن كنتم فاعلين" />
-		<aya index="72" text="لعمرك إنهم لفي سكرتهم يعمهون" />
-		<aya index="73" text="فأخذتهم الصيحة مشرقين" />
-		<aya index="74" text="فجعلنا عاليها سافلها وأمطرنا عليهم حجارة من سجيل" />
-		<aya index="75" text="إن في ذلك لآيات للمتوسمين" />
-		<aya index="76" text="وإنها لبسبيل مقيم" />
-		<aya index="77" text="إن في ذلك لآية للمؤمنين" />
-		<aya index="78" text="وإن كان أصحاب الأيكة لظالمين" />
-		<aya index="79" text="فانتقمنا منهم وإنهما لبإمام مبين" />
-		<aya index="80" text="ولقد كذب أصحاب الحجر المرسلين" />
-		<aya index="81" text="وآتيناهم آياتنا فكانوا عنها معرضين" />
-		<aya index="82" text="وكانوا ينحتون من الجبال بيوتا آمنين" />
-		<aya index="83" text="فأخذتهم الصيحة مصبحين" />
-		<aya index="84" text="فما أغنى عنهم ما كانوا يكسبون" />
-		<aya index="85" text="وما خلقنا السماوات والأرض وما بينهما إلا بالحق وإن الساعة لآتية فاصفح الصفح الجميل" />
-		<aya index="86" text="إن ربك هو الخلاق العليم" />
-		<aya index="87" text="ولقد آتيناك سبعا من المثاني والقرآن العظيم" />
-		<aya index="88" text="لا تمدن عينيك إلى ما متعنا به أزواجا منهم ولا تحزن عليهم واخفض جناحك للمؤمنين" />
-		<aya index="89" text="وقل إني أنا النذير المبين" />
-		<aya index="90" text="كما أنزلنا على المقتسمين" />
-		<aya index="91" text="الذين جعلوا القرآن عضين" />
-		<aya index="92" text="فوربك لنسألنهم أجمعين" />
-		<aya index="93" text="عما كانوا يعملون" />
-		<aya index="94" text="فاصدع بما تؤمر وأعرض عن المشركين" />
-		<aya index="95" text="إنا كفيناك المستهزئين" />
-		<aya index="96" text="الذين يجعلون مع الله إلها آخر فسوف يعلمون" />
-		<aya index="97" text="ولقد نعلم أنك يضيق صدرك بما يقولون" />
-		<aya index="98" text="فسبح بحمد ربك وكن من الساجدين" />
-		<aya index="99" text="واعبد ربك حتى يأتيك اليقين" />
-	</sura>
-	<sura index="16" name="النحل">
-		<aya index="1" text="أتى أمر الله فلا تستعجلوه سبحانه وتعالى عما يشركون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ينزل الملائكة بالروح من أمره على من يشاء من عباده أن أنذروا أنه لا إله إلا أنا فاتقون" />
-		<aya index="3" text="خلق السماوات والأرض بالحق تعالى عما يشركون" />
-		<aya index="4" text="خلق الإنسان من نطفة فإذا هو خصيم مبين" />
-		<aya index="5" text="والأنعام خلقها لكم فيها دفء ومنافع ومنها تأكلون" />
-		<aya index="6" text="ولكم فيها جمال حين تريحون وحين تسرحون" />
-		<aya index="7" text="وتحمل أثقالكم إلى بلد لم تكونوا بالغيه إلا بشق الأنفس إن ربكم لرءوف رحيم" />
-		<aya index="8" text="والخيل والبغال والحمير لتركبوها وزينة ويخلق ما لا تعلمون" />
-		<aya index="9" text="وعلى الله قصد السبيل ومنها جائر ولو شاء لهداكم أجمعين" />
-		<aya index="10" text="هو الذي أنزل من السماء ماء لكم منه شراب ومنه شجر فيه تسيمون" />
-		<aya index="11" text="ينبت لكم به الزرع والزيتون والنخيل والأعناب ومن كل الثمرات إن في ذلك لآية لقوم يتفكرون" />
-		<aya index="12" text="وسخر لكم الليل والنهار والشمس والقمر والنجوم مسخرات بأمره إن في ذلك لآيات لقوم يعقلون" />
-		<aya index="13" text="وما ذرأ لكم في الأرض مختلفا ألوانه إن في ذلك لآية لقوم يذكرون" />
-		<aya index="14" text="وهو الذي سخر البحر لتأكلوا منه لحما طريا وتستخرجوا منه حلية تلبسونها وترى الفلك مواخر فيه ولتبتغوا من فضله ولعلكم تشكرون" />
-		<aya index="15" text="وألقى في الأرض رواسي أن تميد بكم وأنهارا وسبلا لعلكم تهتدون" />
-		<aya index="16" text="وعلامات وبالنجم هم يهتدون" />
-		<aya index="17" text="أفمن يخلق كمن لا يخلق أفلا تذكرون" />
-		<aya index="18" text="وإن تعدوا نعمة الله لا تحصوها إن الله لغفور رحيم" />
-		<aya index="19" text="والله يعلم ما تسرون وما تعلنون" />
-		<aya index="20" text="والذين يدعون من دون الله لا يخلقون شيئا وهم يخلقون" />
-		<aya index="21" text="أموات غير أحياء وما يشعرون أيان يبعثون" />
-		<aya index="22" text="إلهكم إله واحد فالذين لا يؤمنون بالآخرة قلوبهم منكرة وهم مستكبرون" />
-		<aya index="23" text="لا جرم أن الله يعلم ما يسرون وما يعلنون إنه لا يحب المستكبرين" />
-		<aya index="24" text="وإذا قيل لهم ماذا أنزل ربكم قالوا أساطير الأولين" />
-		<aya index="25" text="ليحملوا أوزارهم كاملة يوم القيامة ومن أوزار الذين يضلونهم بغير علم ألا ساء ما يزرون" />
-		<aya index="26" text="قد مكر الذين من قبلهم فأتى الله بنيانهم من القواعد فخر عليهم السقف من فوقهم وأتاهم العذاب من حيث لا يشعرون" />
-		<aya index="27" text="ثم يوم القيامة يخزيهم ويقول أين شركائي الذين كنتم تشاقون فيهم قال الذين أوتوا العلم إن الخزي اليوم والسوء على الكافرين" />
-		<aya index="28" text="الذين تتوفاهم الملائكة ظالمي أنفسهم فألقوا السلم ما كنا نعمل من سوء بلى إن الله عليم بما كنتم تعملون" />
-		<aya index="29" text="فادخلوا أبواب جهنم خالدين فيها فلبئس مثوى المتكبرين" />
-		<aya index="30" text="وقيل للذين اتقوا ماذا أنزل ربكم قالوا خيرا للذين أحسنوا في هذه الدنيا حسنة ولدار الآخرة خير ولنعم دار المتقين" />
-		<aya index="31" text="جنات عدن يدخلونها تجري من تحتها الأنهار لهم فيها ما يشاءون كذلك يجزي الله المتقين" />
-		<aya index="32" text="الذين تتوفاهم الملائكة طيبين يقولون سلام عليكم ادخلوا الجنة بما كنتم تعملون" />
-		<aya index="33" text="هل ينظرون إلا أن تأتيهم الملائكة أو يأتي أمر ربك كذلك فعل الذين من قبلهم وما ظلمهم الله ولكن كانوا أنفسهم يظلمون" />
-		<aya index="34" text="فأصابهم سيئات ما عملوا وحاق بهم ما كانوا به يستهزئون" />
-		<aya index="35" text="وقال الذين أشركوا لو شاء الله ما عبدنا من دونه من شيء نحن ولا آباؤنا ولا حرمنا من دونه من شيء كذلك فعل الذين من قبلهم فهل على الرسل إلا البلاغ المبين" />
-		<aya index="36" text="ولقد بعثنا في كل أمة رسولا أن اعبدوا الله واجتنبوا الطاغوت فمنهم من هدى الله ومنهم من حقت عليه الضلالة فسيروا في الأرض فانظروا كيف كان عاقبة المكذبين" />
-		<aya index="37" text="إن تحرص على هداهم فإن الله لا يهدي من يضل وما لهم من ناصرين" />
-		<aya index="38" text="وأقسموا بالله جهد أيمانهم لا يبعث الله من يموت بلى وعدا عليه حقا ولكن أكثر الناس لا يعلمون" />
-		<aya index="39" text="ليبين لهم الذي يختلفون فيه وليعلم الذين كفروا أنهم كانوا كاذبين" />
-		<aya index="40" text="إنما قولنا لشيء إذا أردناه أن نقول له كن فيكون" />
-		<aya index="41" text="والذين هاجروا في الله من بعد ما ظلموا لنبوئنهم في الدنيا حسنة ولأجر الآخرة أكبر لو كانوا يعلمون" />
-		<aya index="42" text="الذين صبروا وعلى ربهم يتوكلون" />
-		<aya index="43" text="وما أرسلنا من قبلك إلا رجالا نوحي إليهم فاسألوا أهل الذكر إن كنتم لا تعلمون" />
-		<aya index="44" text="بالبينات والزبر وأنزلنا إليك الذكر لتبين للناس ما نزل إليهم ولعلهم يتفكرون" />
-		<aya index="45" text="أفأمن الذين مكروا السيئات أن يخسف الله بهم الأرض أو يأتيهم العذاب من حيث لا يشعرون" />
-		<aya index="46" text="أو يأخذهم في تقلبهم فما هم بمعجزين" />
-		<aya index="47" text="أو يأخذهم على تخوف فإن ربكم لرءوف رحيم" />
-		<aya index="48" text="أولم يروا إلى ما خلق الله من شيء يتفيأ ظلاله عن اليمين والشمائل سجدا لله وهم داخرون" />
-		<aya index="49" text="ولله يسجد ما في السماوات وما في الأرض من دابة والملائكة وهم لا يستكبرون" />
-		<aya index="50" text="يخافون ربهم من فوقهم ويفعلون ما يؤمرون" />
-		<aya index="51" text="وقال الله لا تتخذوا إلهين اثنين إنما هو إله واحد فإياي فارهبون" />
-		<aya index="52" text="وله ما في السماوات والأرض وله الدين واصبا أفغير الله تتقون" />
-		<aya index="53" text="وما بكم من نعمة فمن الله ثم إذا مسكم الضر فإليه تجأرون" />
-		<aya index="54" text="ثم إذا كشف الضر عنكم إذا فريق منكم بربهم يشركون" />
-		<aya index="55" text="ليكفروا بما آتيناهم فتمتعوا فسوف تعلمون" />
-		<aya index="56" text="ويجعلون لما لا يعلمون نصيبا مما رزقناهم تالله لتسألن عما كنتم تفترون" />
-		<aya index="57" text="ويجعلون لله البنات سبحانه ولهم ما يشتهون" />
-		<aya index="58" text="وإذا بشر أحدهم بالأنثى ظل وجهه مسودا وهو كظيم" />
-		<aya index="59" text="يتوارى من القوم من سوء ما بشر به أيمسكه على هون أم يدسه في التراب ألا ساء ما يحكمون" />
-		<aya index="60" text="للذين لا يؤمنون بالآخرة مثل السوء ولله المثل الأعلى وهو العزيز الحكيم" />
-		<aya index="61" text="ولو يؤاخذ الله الناس بظلمهم ما ترك عليها من دابة ولكن يؤخرهم إلى أجل مسمى فإذا جاء أجلهم لا يستأخرون ساعة ولا يستقدمون" />
-		<aya index="62" text="ويجعلون لله ما يكرهون وتصف ألسنتهم الكذب أن لهم الحسنى لا جرم أن لهم النار وأنهم مفرطون" />
-		<aya index="63" text="تالله لقد أرسلنا إلى أمم من قبلك فزين لهم الشيطان أعمالهم فهو وليهم اليوم ولهم عذاب أليم" />
-		<aya index="64" text="وما أنزلنا عليك الكتاب إلا لتبين لهم الذي اختلفوا فيه وهدى ورحمة لقوم يؤمنون" />
-		<aya index="65" text="والله أنزل من السماء ماء فأحيا به الأرض بعد موتها إن في ذلك لآية لقوم يسمعون" />
-		<aya index="66" text="وإن لكم في الأنعام لعبرة نسقيكم مما في بطونه من بين فرث ودم لبنا خالصا سائغا للشاربين" />
-		<aya index="67" text="ومن ثمرات النخيل والأعناب تتخذون منه سكرا ورزقا حسنا إن في ذلك لآية لقوم يعقلون" />
-		<aya index="68" text="وأوحى ربك إلى النحل أن اتخذي من الجبال بيوتا ومن الشجر ومما يعرشون" />
-		<aya index="69" text="ثم كلي من كل الثمرات فاسلكي سبل ربك ذللا يخرج من بطونها شراب مختلف ألوانه فيه شفاء للناس إن في ذلك لآية لقوم يتفكرون" />
-		<aya index="70" text="والله خلقكم ثم يتوفاكم ومنكم من يرد إلى أرذل العمر لكي لا يعلم بعد علم شيئا إن الله عليم قدير" />
-		<aya index="71" text="والله فضل بعضكم على بعض في الرزق فما الذين فضلوا برادي رزقهم على ما ملكت أيمانهم فهم فيه سواء أفبنعمة الله يجحدون" />
-		<aya index="72" text="والله جعل لكم من أنفسكم أزواجا وجعل لكم من أزواجكم بنين وحفدة ورزقكم من الطيبات أفبالباطل يؤمنون وبنعمت الله هم يكفرون" />
-		<aya index="73" text="ويعبدون من دون الله ما لا يملك لهم رزقا من السماوات والأرض شيئا ولا يستطيعون" />
-		<aya index="74" text="فلا تضربوا لله الأمثال إن الله يعلم وأنتم لا تعلمون" />
-		<aya index="75" text="ضرب الله مثلا عبدا مملوكا لا يقدر على شيء ومن رزقناه منا رزقا حسنا فهو ينفق منه سرا وجهرا هل يستوون الحمد لله بل أكثرهم لا يعلمون" />
-		<aya index="76" text="وضرب الله مثلا رجلين أحدهما أبكم لا يقدر على شيء وهو كل على مولاه أينما يوجهه لا يأت بخير هل يستوي هو ومن يأمر بالعدل وهو على صراط مستقيم" />
-		<aya index="77" text="ولله غيب السماوات والأرض وما أمر الساعة إلا كلمح البصر أو هو أقرب إن الله على كل شيء قدير" />
-		<aya index="78" text="والله أخرجكم من بطون أمهاتكم لا تعلمون شيئا وجعل لكم السمع والأبصار والأفئدة لعلكم تشكرون" />
-		<aya index="79" text="ألم يروا إلى الطير مسخرات في جو السماء ما يمسكهن إلا الله إن في ذلك لآيات لقوم يؤمنون" />
-		<aya index="80" text="والله جعل لكم من بيوتكم سكنا وجعل لكم من جلود الأنعام بيوتا تستخفونها يوم ظعنكم ويوم إقامتكم ومن أصوافها وأوبارها وأشعارها أثاثا ومتاعا إلى حين" />
-		<aya index="81" text="والله جعل لكم مما خلق ظلالا وجعل لكم من الجبال أكنانا وجعل لكم سرابيل تقيكم الحر وسرابيل تقيكم بأسكم كذلك يتم نعمته عليكم لعلكم تسلمون" />
-		<aya index="82" text="فإن تولوا فإنما عليك البلاغ المبين" />
-		<aya index="83" text="يعرفون نعمت الله ثم ينكرونها وأكثرهم الكافرون" />
-		<aya index="84" text="ويوم نبعث من كل أمة شهيدا ثم لا يؤذن للذين كفروا ولا هم يستعتبون" />
-		<aya index="85" text="وإذا رأى الذين ظلموا العذاب فلا يخفف عنهم ولا هم ينظرون" />
-		<aya index="86" text="وإذا رأى الذين أشركوا شركاءهم قالوا ربنا هؤلاء شركاؤنا الذين كنا ندعو من دونك فألقوا إليهم القول إنكم لكاذبون" />
-		<aya index="87" text="وألقوا إلى الله يومئذ السلم وضل عنهم ما كانوا يفترون" />
-		<aya index="88" text="الذين كفروا وصدوا عن سبيل الله زدناهم عذابا فوق العذاب بما كانوا يفسدون" />
-		<aya index="89" text="ويوم نبعث في كل أمة شهيدا عليهم من أنفسهم وجئنا بك شهيدا على هؤلاء ونزلنا عليك الكتاب تبيانا لكل شيء وهدى ورحمة وبشرى للمسلمين" />
-		<aya index="90" text="إن الله يأمر بالعدل والإحسان وإيتاء ذي القربى وينهى عن الفحشاء والمنكر والبغي يعظكم لعلكم تذكرون" />
-		<aya index="91" text="وأوفوا بعهد الله إذا عاهدتم ولا تنقضوا الأيمان بعد توكيدها وقد جعلتم الله عليكم كفيلا إن الله يعلم ما تفعلون" />
-		<aya index="92" text="ولا تكونوا كالتي نقضت غزلها من بعد قوة أنكاثا تتخذون أيمانكم دخلا بينكم أن تكون أمة هي أربى من أمة إنما يبلوكم الله به وليبينن لكم يوم القيامة ما كنتم فيه تختلفون" />
-		<aya index="93" text="ولو شاء الله لجعلكم أمة واحدة ولكن يضل من يشاء ويهدي من يشاء ولتسألن عما كنتم تعملون" />
-		<aya index="94" text="ولا تتخذوا أيمانكم دخلا بينكم فتزل قدم بعد ثبوتها وتذوقوا السوء بما صددتم عن سبيل الله ولكم عذاب عظيم" />
-		<aya index="95" text="ولا تشتروا بعهد الله ثمنا قليلا إنما عند الله هو خير لكم إن كنتم تعلمون" />
-		<aya index="96" text="ما عندكم ينفد وما عند الله باق ولنجزين الذين صبروا أجرهم بأحسن ما كانوا يعملون" />
-		<aya index="97" text="من عمل صالحا من ذكر أو أنثى وهو مؤمن فلنحيينه حياة طيبة ولنجزينهم أجرهم بأحسن ما كانوا يعملون" />
-		<aya index="98" text="فإذا قرأت القرآن فاستعذ بالله من الشيطان الرجيم" />
-		<aya index="99" text="إنه ليس له سلطان على الذين آمنوا وعلى ربهم يتوكلون" />
-		<aya index="100" text="إنما سلطانه على الذين يتولونه والذين هم به مشركون" />
-		<aya index="101" text="وإذا بدلنا آية مكان آية والله أعلم بما ينزل قالوا إنما أنت مفتر بل أكثرهم لا يعلمون" />
-		<aya index="102" text="قل نزله روح القدس من ربك بالحق ليثبت الذين آمنوا وهدى وبشرى للمسلمين" />
-		<aya index="103" text="ولقد نعلم أنهم يقولون إنما يعلمه بشر لسان الذي يلحدون إليه أعجمي وهذا لسان عربي مبين" />
-		<aya index="104" text="إن الذين لا يؤمنون بآيات الله لا يهديهم الله ولهم عذاب أليم" />
-		<aya index="105" text="إنما يفتري الكذب الذين لا يؤمنون بآيات الله وأولئك هم الكاذبون" />
-		<aya index="106" text="من كفر بالله من بعد إيمانه إلا من أكره وقلبه مطمئن بالإيمان ولكن من شرح بالكفر صدرا فعليهم غضب من الله ولهم عذاب عظيم" />
-		<aya index="107" text="ذلك بأنهم استحبوا الحياة الدنيا على الآخرة وأن الله لا يهدي القوم الكافرين" />
-		<aya index="108" text="أولئك الذين طبع الله على قلوبهم وسمعهم وأبصارهم وأولئك هم الغافلون" />
-		<aya index="109" text="لا جرم أنهم في الآخرة هم الخاسرون" />
-		<aya index="110" text="ثم إن ربك للذين هاجروا من بعد ما فتنوا ثم جاهدوا وصبروا إن ربك من بعدها لغفور رحيم" />
-		<aya index="111" text="يوم تأتي كل نفس تجادل عن نفسها وتوفى كل نفس ما عملت وهم لا يظلمون" />
-		<aya index="112" text="وضرب الله مثلا قرية كانت آمنة مطمئنة يأتيها رزقها رغدا من كل مكان فكفرت بأنعم الله فأذاقها الله لباس الجوع والخوف بما كانوا يصنعون" />
-		<aya index="113" text="ولقد جاءهم رسول منهم فكذبوه فأخذهم العذاب وهم ظالمون" />
-		<aya index="114" text="فكلوا مما رزقكم الله حلالا طيبا واشكروا نعمت الله إن كنتم إياه تعبدون" />
-		<aya index="115" text="إنما حرم عليكم الميتة والدم ولحم الخنزير وما أهل لغير الله به فمن اضطر غير باغ ولا عاد فإن الله غفور رحيم" />
-		<aya index="116" text="ولا تقولوا لما تصف ألسنتكم الكذب هذا حلال وهذا حرام لتفتروا على الله الكذب إن الذين يفترون على الله الكذب لا يفلحون" />
-		<aya index="117" text="متاع قليل ولهم عذاب أليم" />
-		<aya index="118" text="وعلى الذين هادوا حرمنا ما قصصنا عليك من قبل وما ظلمناهم ولكن كانوا أنفسهم يظلمون" />
-		<aya index="119" text="ثم إن ربك للذين عملوا السوء بجهالة ثم تابوا من بعد ذلك وأصلحوا إن ربك من بعدها لغفور رحيم" />
-		<aya index="120" text="إن إبراهيم كان أمة قانتا لله حنيفا ولم يك من المشركين" />
-		<aya index="121" text="شاكرا لأنعمه اجتباه وهداه إلى صراط مستقيم" />
-		<aya index="122" text="وآتيناه في الدنيا حسنة وإنه في الآخرة لمن الصالحين" />
-		<aya index="123" text="ثم أوحينا إليك أن اتبع ملة إبراهيم حنيفا وما كان من المشركين" />
-		<aya index="124" text="إنما جعل السبت على الذين اختلفوا فيه وإن ربك ليحكم بينهم يوم القيامة فيما كانوا فيه يختلفون" />
-		<aya index="125" text="ادع إلى سبيل ربك بالحكمة والموعظة الحسنة وجادلهم بالتي هي أحسن إن ربك هو أعلم بمن ضل عن سبيله وهو أعلم بالمهتدين" />
-		<aya index="126" text="وإن عاقبتم فعاقبوا بمثل ما عوقبتم به ولئن صبرتم لهو خير للصابرين" />
-		<aya index="127" text="واصبر وما صبرك إلا بالله ولا تحزن عليهم ولا تك في ضيق مما يمكرون" />
-		<aya index="128" text="إن الله مع الذين اتقوا والذين هم محسنون" />
-	</sura>
-	<sura index="17" name="الإسراء">
-		<aya index="1" text="سبحان الذي أسرى بعبده ليلا من المسجد الحرام إلى المسجد الأقصى الذي باركنا حوله لنريه من آياتنا إنه هو السميع البصير" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وآتينا موسى الكتاب وجعلناه هدى لبني إسرائيل ألا تتخذوا من دوني وكيلا" />
-		<aya index="3" text="ذرية من حملنا مع نوح إنه كان عبدا شكورا" />
-		<aya index="4" text="وقضينا إلى بني إسرائيل في الكتاب لتفسدن في الأرض مرتين ولتعلن علوا كبيرا" />
-		<aya index="5" text="فإذا جاء وعد أولاهما بعثنا عليكم عبادا لنا أولي بأس شديد فجاسوا خلال الديار وكان وعدا مفعولا" />
-		<aya index="6" text="ثم رددنا لكم الكرة عليهم وأمددناكم بأموال وبنين وجعلناكم أكثر نفيرا" />
-		<aya index="7" text="إن أحسنتم أحسنتم لأنفسكم وإن أسأتم فلها فإذا جاء وعد الآخرة ليسوءوا وجوهكم وليدخلوا المسجد كما دخلوه أول مرة وليتبروا ما علوا تتبيرا" />
-		<aya index="8" text="عسى ربكم أن يرحمكم وإن عدتم عدنا وجعلنا جهنم للكافرين حصيرا" />
-		<aya index="9" text="إن هذا القرآن يهدي للتي هي أقوم ويبشر المؤمنين الذين يعملون الصالحات أن لهم أجرا كبيرا" />
-		<aya index="10" text="وأن الذين لا يؤمنون بالآخرة أعتدنا لهم عذابا أليما" />
-		<aya index="11" text="ويدع الإنسان بالشر دعاءه بالخير وكان الإنسان عجولا" />
-		<aya index="12" text="وجعلنا الليل والنهار آيتين فمحونا آية الليل وجعلنا آية النهار مبصرة لتبتغوا فضلا من ربكم ولتعلموا عدد السنين والحساب وكل شيء فصلناه تفصيلا" />
-		<aya index="13" text="وكل إنسان ألزمناه طائره في عنقه ونخرج له يوم القيامة كتابا يلقاه منشورا" />
-		<aya index="14" text="اقرأ كتابك كفى بنفسك اليوم عليك حسيبا" />
-		<aya index="15" text="من اهتدى فإنما يهتدي لنفسه ومن ضل فإنما يضل عليها ولا تزر وازرة وزر أخرى وما كنا معذبين حتى نبعث رسولا" />
-		<aya index="16" text="وإذا أردنا أن نهلك قرية أمرنا مترفيها ففسقوا فيها فحق عليها القول فدمرناها تدميرا" />
-		<aya index="17" text="وكم أهلكنا من القرون من بعد نوح وكفى بربك بذنوب عباده خبيرا بصيرا" />
-		<aya index="18" text="من كان يريد العاجلة عجلنا له فيها ما نشاء لمن نريد ثم جعلنا له جهنم يصلاها مذموما مدحورا" />
-		<aya index="19" text="ومن أراد الآخرة وسعى لها سعيها وهو مؤمن فأولئك كان سعيهم مشكورا" />
-		<aya index="20" text="كلا نمد هؤلاء وهؤلاء من عطاء ربك وما كان عطاء ربك محظورا" />
-		<aya index="21" text="انظر كيف فضلنا بعضهم على بعض وللآخرة أكبر درجات وأكبر تفضيلا" />
-		<aya index="22" text="لا تجعل مع الله إلها آخر فتقعد مذموما مخذولا" />
-		<aya index="23" text="وقضى ربك ألا تعبدوا إلا إياه وبالوالدين إحسانا إما يبلغن عندك الكبر أحدهما أو كلاهما فلا تقل لهما أف ولا تنهرهما وقل لهما قولا كريما" />
-		<aya index="24" text="واخفض لهما جناح الذل من الرحمة وقل رب ارحمهما كما ربياني صغيرا" />
-		<aya index="25" text="ربكم أعلم بما في نفوسكم إن تكونوا صالحين فإنه كان للأوابين غفورا" />
-		<aya index="26" text="وآت ذا القربى حقه والمسكين وابن السبيل ولا تبذر تبذيرا" />
-		<aya index="27" text="إن المبذرين كانوا إخوان الشياطين وكان الشيطان لربه كفورا" />
-		<aya index="28" text="وإما تعرضن عنهم ابتغاء رحمة من ربك ترجوها فقل لهم قولا ميسورا" />
-		<aya index="29" text="ولا تجعل يدك مغلولة إلى عنقك ولا تبسطها كل البسط فتقعد ملوما محسورا" />
-		<aya index="30" text="إن ربك يبسط الرزق لمن يشاء ويقدر إنه كان بعباده خبيرا بصيرا" />
-		<aya index="31" text="ولا تقتلوا أولادكم خشية إملاق نحن نرزقهم وإياكم إن قتلهم كان خطئا كبيرا" />
-		<aya index="32" text="ولا تقربوا الزنا إنه كان فاحشة وساء سبيلا" />
-		<aya index="33" text="ولا تقتلوا النفس التي حرم الله إلا بالحق ومن قتل مظلوما فقد جعلنا لوليه سلطانا فلا يسرف في القتل إنه كان منصورا" />
-		<aya index="34" text="ولا تقربوا مال اليتيم إلا بالتي هي أحسن حتى يبلغ أشده وأوفوا بالعهد إن العهد كان مسئولا" />
-		<aya index="35" text="وأوفوا الكيل إذا كلتم وزنوا بالقسطاس المستقيم ذلك خير وأحسن تأويلا" />
-		<aya index="36" text="ولا تقف ما ليس لك به علم إن السمع والبصر والفؤاد كل أولئك كان عنه مسئولا" />
-		<aya index="37" text="ولا تمش في الأرض مرحا إنك لن تخرق الأرض ولن تبلغ الجبال طولا" />
-		<aya index="38" text="كل ذلك كان سيئه عند ربك مكروها" />
-		<aya index="39" text="ذلك مما أوحى إليك ربك من الحكمة ولا تجعل مع الله إلها آخر فتلقى في جهنم ملوما مدحورا" />
-		<aya index="40" text="أفأصفاكم ربكم بالبنين واتخذ من الملائكة إناثا إنكم لتقولون قولا عظيما" />
-		<aya index="41" text="ولقد صرفنا في هذا القرآن ليذكروا وما يزيدهم إلا نفورا" />
-		<aya index="42" text="قل لو كان معه آلهة كما يقولون إذا لابتغوا إلى ذي العرش سبيلا" />
-		<aya index="43" text="سبحانه وتعالى عما يقولون علوا كبيرا" />
-		<aya index="44" text="تسبح له السماوات السبع والأرض ومن فيهن وإن من شيء إلا يسبح بحمده ولكن لا تفقهون تسبيحهم إنه كان حليما غفورا" />
-		<aya index="45" text="وإذا قرأت القرآن جعلنا بينك وبين الذين لا يؤمنون بالآخرة حجابا مستورا" />
-		<aya index="46" text="وجعلنا على قلوبهم أكنة أن يفقهوه وفي آذانهم وقرا وإذا ذكرت ربك في القرآن وحده ولوا على أدبارهم نفورا" />
-		<aya index="47" text="نحن أعلم بما يستمعون به إذ يستمعون إليك وإذ هم نجوى إذ يقول الظالمون إن تتبعون إلا رجلا مسحورا" />
-		<aya index="48" text="انظر كيف ضربوا لك الأمثال فضلوا فلا يستطيعون سبيلا" />
-		<aya index="49" text="وقالوا أإذا كنا عظاما ورفاتا أإنا لمبعوثون خلقا جديدا" />
-		<aya index="50" text="قل كونوا حجارة أو حديدا" />
-		<aya index="51" text="أو خلقا مما يكبر في صدوركم فسيقولون من يعيدنا قل الذي فطركم أول مرة فسينغضون إليك رءوسهم ويقولون متى هو قل عسى أن يكون قريبا" />
-		<aya index="52" text="يوم يدعوكم فتستجيبون بحمده وتظنون إن لبثتم إلا قليلا" />
-		<aya index="53" text="وقل لعبادي يقولوا التي هي أحسن إن الشيطان ينزغ بينهم إن الشيطان كان للإنسان عدوا مبينا" />
-		<aya index="54" text="ربكم أعلم بكم إن يشأ يرحمكم أو إن يشأ يعذبكم وما أرسلناك عليهم وكيلا" />
-		<aya index="55" text="وربك أعلم بمن في السماوات والأرض ولقد فضلنا بعض النبيين على بعض وآتينا داوود زبورا" />
-		<aya index="56" text="قل ادعوا الذين زعمتم من دونه فلا يملكون كشف الضر عنكم ولا تحويلا" />
-		<aya index="57" text="أولئك الذين يدعون يبتغون إلى ربهم الوسيلة أيهم أقرب ويرجون رحمته ويخافون عذابه إن عذاب ربك كان محذورا" />
-		<aya index="58" text="وإن من قرية إلا نحن مهلكوها قبل يوم القيامة أو معذبوها عذابا شديدا كان ذلك في الكتاب مسطورا" />
-		<aya index="59" text="وما منعنا أن نرسل بالآيات إلا أن كذب بها الأولون وآتينا ثمود الناقة مبصرة فظلموا بها وما نرسل بالآيات إلا تخويفا" />
-		<aya index="60" text="وإذ قلنا لك إن ربك أحاط بالناس وما جعلنا الرؤيا التي أريناك إلا فتنة للناس والشجرة الملعونة في القرآن ونخوفهم فما يزيدهم إلا طغيانا كبيرا" />
-		<aya index="61" text="وإذ قلنا للملائكة اسجدوا لآدم فسجدوا إلا إبليس قال أأسجد لمن خلقت طينا" />
-		<aya index="62" text="قال أرأيتك هذا الذي كرمت علي لئن أخرتن إلى يوم القيامة لأحتنكن ذريته إلا قليلا" />
-		<aya index="63" text="قال اذهب فمن تبعك منهم فإن جهنم جزاؤكم جزاء موفورا" />
-		<aya index="64" text="واستفزز من استطعت منهم بصوتك وأجلب عليهم بخيلك ورجلك وشاركهم في الأموال والأولاد وعدهم وما يعدهم الشيطان إلا غرورا" />
-		<aya index="65" text="إن عبادي ليس لك عليهم سلطان وكفى بربك وكيلا" />
-		<aya index="66" text="ربكم الذي يزجي لكم الفلك في البحر لتبتغوا من فضله إنه كان بكم رحيما" />
-		<aya index="67" text="وإذا مسكم الضر في البحر ضل من تدعون إلا إياه فلما نجاكم إلى البر أعرضتم وكان الإنسان كفورا" />
-		<aya index="68" text="أفأمنتم أن يخسف بكم جانب البر أو يرسل عليكم حاصبا ثم لا تجدوا لكم وكيلا" />
-		<aya index="69" text="أم أمنتم أن يعيدكم فيه تارة أخرى فيرسل عليكم قاصفا من الريح فيغرقكم بما كفرتم ثم لا تجدوا لكم علينا به تبيعا" />
-		<aya index="70" text="ولقد كرمنا بني آدم وحملناهم في البر والبحر ورزقناهم من الطيبات وفضلناهم على كثير ممن خلقنا تفضيلا" />
-		<aya index="71" text="يوم ندعو كل أناس بإمامهم فمن أوتي كتابه بيمينه فأولئك يقرءون كتابهم ولا يظلمون فتيلا" />
-		<aya index="72" text="ومن كان في هذه أعمى فهو في الآخرة أعمى وأضل سبيلا" />
-		<aya index="73" text="وإن كادوا ليفتنونك عن الذي أوحينا إليك لتفتري علينا غيره وإذا لاتخذوك خليلا" />
-		<aya index="74" text="ولولا أن ثبتناك لقد كدت تركن إليهم شيئا قليلا" />
-		<aya index="75" text="إذا لأذقناك ضعف الحياة وضعف الممات ثم لا تجد لك علينا نصيرا" />
-		<aya index="76" text="وإن كادوا ليستفزونك من الأرض ليخرجوك منها وإذا لا يلبثون خلافك إلا قليلا" />
-		<aya index="77" text="سنة من قد أرسلنا قبلك من رسلنا ولا تجد لسنتنا تحويلا" />
-		<aya index="78" text="أقم الصلاة لدلوك الشمس إلى غسق الليل وقرآن الفجر إن قرآن الفجر كان مشهودا" />
-		<aya index="79" text="ومن الليل فتهجد به نافلة لك عسى أن يبعثك ربك مقاما محمودا" />
-		<aya index="80" text="وقل رب أدخلني مدخل صدق وأخرجني مخرج صدق واجعل لي من لدنك سلطانا نصيرا" />
-		<aya index="81" text="وقل جاء الحق وزهق الباطل إن الباطل كان زهوقا" />
-		<aya index="82" text="وننزل من القرآن ما هو شفاء ورحمة للمؤمنين ولا يزيد الظالمين إلا خسارا" />
-		<aya index="83" text="وإذا أنعمنا على الإنسان أعرض ونأى بجانبه وإذا مسه الشر كان يئوسا" />
-		<aya index="84" text="قل كل يعمل على شاكلته فربكم أعلم بمن هو أهدى سبيلا" />
-		<aya index="85" text="ويسألونك عن الروح قل الروح من أمر ربي وما أوتيتم من العلم إلا قليلا" />
-		<aya index="86" text="ولئن شئنا لنذهبن بالذي أوحينا إليك ثم لا تجد لك به علينا وكيلا" />
-		<aya index="87" text="إلا رحمة من ربك إن فضله كان عليك كبيرا" />
-		<aya index="88" text="قل لئن اجتمعت الإنس والجن على أن يأتوا بمثل هذا القرآن لا يأتون بمثله ولو كان بعضهم لبعض ظهيرا" />
-		<aya index="89" text="ولقد صرفنا للناس في هذا القرآن من كل مثل فأبى أكثر الناس إلا كفورا" />
-		<aya index="90" text="وقالوا لن نؤمن لك حتى تفجر لنا من الأرض ينبوعا" />
-		<aya index="91" text="أو تكون لك جنة من نخيل وعنب فتفجر الأنهار خلالها تفجيرا" />
-		<aya index="92" text="أو تسقط السماء كما زعمت علينا كسفا أو تأتي بالله والملائكة قبيلا" />
-		<aya index="93" text="أو يكون لك بيت من زخرف أو ترقى في السماء ولن نؤمن لرقيك حتى تنزل علينا كتابا نقرؤه قل سبحان ربي هل كنت إلا بشرا رسولا" />
-		<aya index="94" text="وما منع الناس أن يؤمنوا إذ جاءهم الهدى إلا أن قالوا أبعث الله بشرا رسولا" />
-		<aya index="95" text="قل لو كان في الأرض ملائكة يمشون مطمئنين لنزلنا عليهم من السماء ملكا رسولا" />
-		<aya index="96" text="قل كفى بالله شهيدا بيني وبينكم إنه كان بعباده خبيرا بصيرا" />
-		<aya index="97" text="ومن يهد الله فهو المهتد ومن يضلل فلن تجد لهم أولياء من دونه ونحشرهم يوم القيامة على وجوههم عميا وبكما وصما مأواهم جهنم كلما خبت زدناهم سعيرا" />
-		<aya index="98" text="ذلك جزاؤهم بأنهم كفروا بآياتنا وقالوا أإذا كنا عظاما ورفاتا أإنا لمبعوثون خلقا جديدا" />
-		<aya index="99" text="أولم يروا أن الله الذي خلق السماوات والأرض قادر على أن يخلق مثلهم وجعل لهم أجلا لا ريب فيه فأبى الظالمون إلا كفورا" />
-		<aya index="100" text="قل لو أنتم تملكون خزائن رحمة ربي إذا لأمسكتم خشية الإنفاق وكان الإنسان قتورا" />
-		<aya index="101" text="ولقد آتينا موسى تسع آيات بينات فاسأل بني إسرائيل إذ جاءهم فقال له فرعون إني لأظنك يا موسى مسحورا" />
-		<aya index="102" text="قال لقد علمت ما أنزل هؤلاء إلا رب السماوات والأرض بصائر وإني لأظنك يا فرعون مثبورا" />
-		<aya index="103" text="فأراد أن يستفزهم من الأرض فأغرقناه ومن معه جميعا" />
-		<aya index="104" text="وقلنا من بعده لبني إسرائيل اسكنوا الأرض فإذا جاء وعد الآخرة جئنا بكم لفيفا" />
-		<aya index="105" text="وبالحق أنزلناه وبالحق نزل وما أرسلناك إلا مبشرا ونذيرا" />
-		<aya index="106" text="وقرآنا فرقناه لتقرأه على الناس على مكث ونزلناه تنزيلا" />
-		<aya index="107" text="قل آمنوا به أو لا تؤمنوا إن الذين أوتوا العلم من قبله إذا يتلى عليهم يخرون للأذقان سجدا" />
-		<aya index="108" text="ويقولون سبحان ربنا إن كان وعد ربنا لمفعولا" />
-		<aya index="109" text="ويخرون للأذقان يبكون ويزيدهم خشوعا" />
-		<aya index="110" text="قل ادعوا الله أو ادعوا الرحمن أيا ما تدعوا فله الأسماء الحسنى ولا تجهر بصلاتك ولا تخافت بها وابتغ بين ذلك سبيلا" />
-		<aya index="111" text="وقل الحمد لله الذي لم يتخذ ولدا ولم يكن له شريك في الملك ولم يكن له ولي من الذل وكبره تكبيرا" />
-	</sura>
-	<sura index="18" name="الكهف">
-		<aya index="1" text="الحمد لله الذي أنزل على عبده الكتاب ولم يجعل له عوجا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="قيما لينذر بأسا شديدا من لدنه ويبشر المؤمنين الذين يعملون الصالحات أن لهم أجرا حسنا" />
-		<aya index="3" text="ماكثين فيه أبدا" />
-		<aya index="4" text="وينذر الذين قالوا اتخذ الله ولدا" />
-		<aya index="5" text="ما لهم به من علم ولا لآبائهم كبرت كلمة تخرج من أفواههم إن يقولون إلا كذبا" />
-		<aya index="6" text="فلعلك باخع نفسك على آثارهم إن لم يؤمنوا بهذا الحديث أسفا" />
-		<aya index="7" text="إنا جعلنا ما على الأرض زينة لها لنبلوهم أيهم أحسن عملا" />
-		<aya index="8" text="وإنا لجاعلون ما عليها صعيدا جرزا" />
-		<aya index="9" text="أم حسبت أن أصحاب الكهف والرقيم كانوا من آياتنا عجبا" />
-		<aya index="10" text="إذ أوى الفتية إلى الكهف فقالوا ربنا آتنا من لدنك رحمة وهيئ لنا من أمرنا رشدا" />
-		<aya index="11" text="فضربنا على آذانهم في الكهف سنين عددا" />
-		<aya index="12" text="ثم بعثناهم لنعلم أي الحزبين أحصى لما لبثوا أمدا" />
-		<aya index="13" text="نحن نقص عليك نبأهم بالحق إنهم فتية آمنوا بربهم وزدناهم هدى" />
-		<aya index="14" text="وربطنا على قلوبهم إذ قاموا فقالوا ربنا رب السماوات والأرض لن ندعو من دونه إلها لقد قلنا إذا شططا" />
-		<aya index="15" text="هؤلاء قومنا اتخذوا من دونه آلهة لولا يأتون عليهم بسلطان بين فمن أظلم ممن افترى على الله كذبا" />
-		<aya index="16" text="وإذ اعتزلتموهم وما يعبدون إلا الله فأووا إلى الكهف ينشر لكم ربكم من رحمته ويهيئ لكم من أمركم مرفقا" />
-		<aya index="17" text="وترى الشمس إذا طلعت تزاور عن كهفهم ذات اليمين وإذا غربت تقرضهم ذات الشمال وهم في فجوة منه ذلك من آيات الله من يهد الله فهو المهتد ومن يضلل فلن تجد له وليا مرشدا" />
-		<aya index="18" text="وتحسبهم أيقاظا وهم رقود ونقلبهم ذات اليمين وذات الشمال وكلبهم باسط ذراعيه بالوصيد لو اطلعت عليهم لوليت منهم فرارا ولملئت منهم رعبا" />
-		<aya index="19" text="وكذلك بعثناهم ليتساءلوا بينهم قال قائل منهم كم لبثتم قالوا لبثنا يوما أو بعض يوم قالوا ربكم أعلم بما لبثتم فابعثوا أحدكم بورقكم هذه إلى المدينة فلينظر أيها أزكى طعاما فليأتكم برزق منه وليتلطف ولا يشعرن بكم أحدا" />
-		<aya index="20" text="إنهم إن يظهروا عليكم يرجموكم أو يعيدوكم في ملتهم ولن تفلحوا إذا أبدا" />
-		<aya index="21" text="وكذلك أعثرنا عليهم ليعلموا أن وعد الله حق وأن الساعة لا ريب فيها إذ يتنازعون بينهم أمرهم فقالوا ابنوا عليهم بنيانا ربهم أعلم بهم قال الذين غلبوا على أمرهم لنتخذن عليهم مسجدا" />
-		<aya index="22" text="سيقولون ثلاثة رابعهم كلبهم ويقولون خمسة سادسهم كلبهم رجما بالغيب ويقولون سبعة وثامنهم كلبهم قل ربي أعلم بعدتهم ما يعلمهم إلا قليل فلا تمار فيهم إلا مراء ظاهرا ولا تستفت فيهم منهم أحدا" />
-		<aya index="23" text="ولا تقولن لشيء إني فاعل ذلك غدا" />
-		<aya index="24" text="إلا أن يشاء الله واذكر ربك إذا نسيت وقل عسى أن يهدين ربي لأقرب من هذا رشدا" />
-		<aya index="25" text="ولبثوا في كهفهم ثلاث مائة سنين وازدادوا تسعا" />
-		<aya index="26" text="قل الله أعلم بما لبثوا له غيب السماوات والأرض أبصر به وأسمع ما لهم من دونه من ولي ولا يشرك في حكمه أحدا" />
-		<aya index="27" text="واتل ما أوحي إليك من كتاب ربك لا مبدل لكلماته ولن تجد من دونه ملتحدا" />
-		<aya index="28" text="واصبر نفسك مع الذين يدعون ربهم بالغداة والعشي يريدون وجهه ولا تعد عيناك عنهم تريد زينة الحياة الدنيا ولا تطع من أغفلنا قلبه عن ذكرنا واتبع هواه وكان أمره فرطا" />
-		<aya index="29" text="وقل الحق من ربكم فمن شاء فليؤمن ومن شاء فليكفر إنا أعتدنا للظالمين نارا أحاط بهم سرادقها وإن يستغيثوا يغاثوا بماء كالمهل يشوي الوجوه بئس الشراب وساءت مرتفقا" />
-		<aya index="30" text="إن الذين آمنوا وعملوا الصالحات إنا لا نضيع أجر من أحسن عملا" />
-		<aya index="31" text="أولئك لهم جنات عدن تجري من تحتهم الأنهار يحلون فيها من أساور من ذهب ويلبسون ثيابا خضرا من سندس وإستبرق متكئين فيها على الأرائك نعم الثواب وحسنت مرتفقا" />
-		<aya index="32" text="واضرب لهم مثلا رجلين جعلنا لأحدهما جنتين من أعناب وحففناهما بنخل وجعلنا بينهما زرعا" />
-		<aya index="33" text="كلتا الجنتين آتت أكلها ولم تظلم منه شيئا وفجرنا خلالهما نهرا" />
-		<aya index="34" text="وكان له ثمر فقال لصاحبه وهو يحاوره أنا أكثر منك مالا وأعز نفرا" />
-		<aya index="35" text="ودخل جنته وهو ظالم لنفسه قال ما أظن أن تبيد هذه أبدا" />
-		<aya index="36" text="وما أظن الساعة قائمة ولئن رددت إلى ربي لأجدن خيرا منها منقلبا" />
-		<aya index="37" text="قال له صاحبه وهو يحاوره أكفرت بالذي خلقك من تراب ثم من نطفة ثم سواك رجلا" />
-		<aya index="38" text="لكنا هو الله ربي ولا أشرك بربي أحدا" />
-		<aya index="39" text="ولولا إذ دخلت جنتك قلت ما شاء الله لا قوة إلا بالله إن ترن أنا أقل منك مالا وولدا" />
-		<aya index="40" text="فعسى ربي أن يؤتين خيرا من جنتك ويرسل عليها حسبانا من السماء فتصبح صعيدا زلقا" />
-		<aya index="41" text="أو يصبح ماؤها غورا فلن تستطيع له طلبا" />
-		<aya index="42" text="وأحيط بثمره فأصبح يقلب كفيه على ما أنفق فيها وهي خاوية على عروشها ويقول يا ليتني لم أشرك بربي أحدا" />
-		<aya index="43" text="ولم تكن له فئة ينصرونه من دون الله وما كان منتصرا" />
-		<aya index="44" text="هنالك الولاية لله الحق هو خير ثوابا وخير عقبا" />
-		<aya index="45" text="واضرب لهم مثل الحياة الدنيا كماء أنزلناه من السماء فاختلط به نبات الأرض فأصبح هشيما تذروه الرياح وكان الله على كل شيء مقتدرا" />
-		<aya index="46" text="المال والبنون زينة الحياة الدنيا والباقيات الصالحات خير عند ربك ثوابا وخير أملا" />
-		<aya index="47" text="ويوم نسير الجبال وترى الأرض بارزة وحشرناهم فلم نغادر منهم أحدا" />
-		<aya index="48" text="وعرضوا على ربك صفا لقد جئتمونا كما خلقناكم أول مرة بل زعمتم ألن نجعل لكم موعدا" />
-		<aya index="49" text="ووضع الكتاب فترى المجرمين مشفقين مما فيه ويقولون يا ويلتنا مال هذا الكتاب لا يغادر صغيرة ولا كبيرة إلا أحصاها ووجدوا ما عملوا حاضرا ولا يظلم ربك أحدا" />
-		<aya index="50" text="وإذ قلنا للملائكة اسجدوا لآدم فسجدوا إلا إبليس كان من الجن ففسق عن أمر ربه أفتتخذونه وذريته أولياء من دوني وهم لكم عدو بئس للظالمين بدلا" />
-		<aya index="51" text="ما أشهدتهم خلق السماوات والأرض ولا خلق أنفسهم وما كنت متخذ المضلين عضدا" />
-		<aya index="52" text="ويوم يقول نادوا شركائي الذين زعمتم فدعوهم فلم يستجيبوا لهم وجعلنا بينهم موبقا" />
-		<aya index="53" text="ورأى المجرمون النار فظنوا أنهم مواقعوها ولم يجدوا عنها مصرفا" />
-		<aya index="54" text="ولقد صرفنا في هذا القرآن للناس من كل مثل وكان الإنسان أكثر شيء جدلا" />
-		<aya index="55" text="وما منع الناس أن يؤمنوا إذ جاءهم الهدى ويستغفروا ربهم إلا أن تأتيهم سنة الأولين أو يأتيهم العذاب قبلا" />
-		<aya index="56" text="وما نرسل المرسلين إلا مبشرين ومنذرين ويجادل الذين كفروا بالباطل ليدحضوا به الحق واتخذوا آياتي وما أنذروا هزوا" />
-		<aya index="57" text="ومن أظلم ممن ذكر بآيات ربه فأعرض عنها ونسي ما قدمت يداه إنا جعلنا على قلوبهم أكنة أن يفقهوه وفي آذانهم وقرا وإن تدعهم إلى الهدى فلن يهتدوا إذا أبدا" />
-		<aya index="58" text="وربك الغفور ذو الرحمة لو يؤاخذهم بما كسبوا لعجل لهم العذاب بل لهم موعد لن يجدوا من دونه موئلا" />
-		<aya index="59" text="وتلك القرى أهلكناهم لما ظلموا وجعلنا لمهلكهم موعدا" />
-		<aya index="60" text="وإذ قال موسى لفتاه لا أبرح حتى أبلغ مجمع البحرين أو أمضي حقبا" />
-		<aya index="61" text="فلما بلغا مجمع بينهما نسيا حوتهما فاتخذ سبيله في البحر سربا" />
-		<aya index="62" text="فلما جاوزا قال لفتاه آتنا غداءنا لقد لقينا من سفرنا هذا نصبا" />
-		<aya index="63" text="قال أرأيت إذ أوينا إلى الصخرة فإني نسيت الحوت وما أنسانيه إلا الشيطان أن أذكره واتخذ سبيله في البحر عجبا" />
-		<aya index="64" text="قال ذلك ما كنا نبغ فارتدا على آثارهما قصصا" />
-		<aya index="65" text="فوجدا عبدا من عبادنا آتيناه رحمة من عندنا وعلمناه من لدنا علما" />
-		<aya index="66" text="قال له موسى هل أتبعك على أن تعلمن مما علمت رشدا" />
-		<aya index="67" text="قال إنك لن تستطيع معي صبرا" />
-		<aya index="68" text="وكيف تصبر على ما لم تحط به خبرا" />
-		<aya index="69" text="قال ستجدني إن شاء الله صابرا ولا أعصي لك أمرا" />
-		<aya index="70" text="قال فإن اتبعتني فلا تسألني عن شيء حتى أحدث لك منه ذكرا" />
-		<aya index="71" text="فانطلقا حتى إذا ركبا في السفينة خرقها قال أخرقتها لتغرق أهلها لقد جئت شيئا إمرا" />
-		<aya index="72" text="قال ألم أقل إنك لن تستطيع معي صبرا" />
-		<aya index="73" text="قال لا تؤاخذني بما نسيت ولا ترهقني من أمري عسرا" />
-		<aya index="74" text="فانطلقا حتى إذا لقيا غلاما فقتله قال أقتلت نفسا زكية بغير نفس لقد جئت شيئا نكرا" />
-		<aya index="75" text="قال ألم أقل لك إنك لن تستطيع معي صبرا" />
-		<aya index="76" text="قال إن سألتك عن شيء بعدها فلا تصاحبني قد بلغت من لدني عذرا" />
-		<aya index="77" text="فانطلقا حتى إذا أتيا أهل قرية استطعما أهلها فأبوا أن يضيفوهما فوجدا فيها جدارا يريد أن ينقض فأقامه قال لو شئت لاتخذت عليه أجرا" />
-		<aya index="78" text="قال هذا فراق بيني وبينك سأنبئك بتأويل ما لم تستطع عليه صبرا" />
-		<aya index="79" text="أما السفينة فكانت لمساكين يعملون في البحر فأردت أن أعيبها وكان وراءهم ملك يأخذ كل سفينة غصبا" />
-		<aya index="80" text="وأما الغلام فكان أبواه مؤمنين فخشينا أن يرهقهما طغيانا وكفرا" />
-		<aya index="81" text="فأردنا أن يبدلهما ربهما خيرا منه زكاة وأقرب رحما" />
-		<aya index="82" text="وأما الجدار فكان لغلامين يتيمين في المدينة وكان تحته كنز لهما وكان أبوهما صالحا فأراد ربك أن يبلغا أشدهما ويستخرجا كنزهما رحمة من ربك وما فعلته عن أمري ذلك تأويل ما لم تسطع عليه صبرا" />
-		<aya index="83" text="ويسألونك عن ذي القرنين قل سأتلو عليكم منه ذكرا" />
-		<aya index="84" text="إنا مكنا له في الأرض وآتيناه من كل شيء سببا" />
-		<aya index="85" text="فأتبع سببا" />
-		<aya index="86" text="حتى إذا بلغ مغرب الشمس وجدها تغرب في عين حمئة ووجد عندها قوما قلنا يا ذا القرنين إما أن تعذب وإما أن تتخذ فيهم حسنا" />
-		<aya index="87" text="قال أما من ظلم فسوف نعذبه ثم يرد إلى ربه فيعذبه عذابا نكرا" />
-		<aya index="88" text="وأما من آمن وعمل صالحا فله جزاء الحسنى وسنقول له من أمرنا يسرا" />
-		<aya index="89" text="ثم أتبع سببا" />
-		<aya index="90" text="حتى إذا بلغ مطلع الشمس وجدها تطلع على قوم لم نجعل لهم من دونها سترا" />
-		<aya index="91" text="كذلك وقد أحطنا بما لديه خبرا" />
-		<aya index="92" text="ثم أتبع سببا" />
-		<aya index="93" text="حتى إذا بلغ بين السدين وجد من دونهما قوما لا يكادون يفقهون قولا" />
-		<aya index="94" text="قالوا يا ذا القرنين إن يأجوج ومأجوج مفسدون في الأرض فهل نجعل لك خرجا على أن تجعل بيننا وبينهم سدا" />
-		<aya index="95" text="قال ما مكني فيه ربي خير فأعينوني بقوة أجعل بينكم وبينهم ردما" />
-		<aya index="96" text="آتوني زبر الحديد حتى إذا ساوى بين الصدفين قال انفخوا حتى إذا جعله نارا قال آتوني أفرغ عليه قطرا" />
-		<aya index="97" text="فما اسطاعوا أن يظهروه وما استطاعوا له نقبا" />
-		<aya index="98" text="قال هذا رحمة من ربي فإذا جاء وعد ربي جعله دكاء وكان وعد ربي حقا" />
-		<aya index="99" text="وتركنا بعضهم يومئذ يموج في بعض ونفخ في الصور فجمعناهم جمعا" />
-		<aya index="100" text="وعرضنا جهنم يومئذ للكافرين عرضا" />
-		<aya index="101" text="الذين كانت أعينهم في غطاء عن ذكري وكانوا لا يستطيعون سمعا" />
-		<aya index="102" text="أفحسب الذين كفروا أن يتخذوا عبادي من دوني أولياء إنا أعتدنا جهنم للكافرين نزلا" />
-		<aya index="103" text="قل هل ننبئكم بالأخسرين أعمالا" />
-		<aya index="104" text="الذين ضل سعيهم في الحياة الدنيا وهم يحسبون أنهم يحسنون صنعا" />
-		<aya index="105" text="أولئك الذين كفروا بآيات ربهم ولقائه فحبطت أعمالهم فلا نقيم لهم يوم القيامة وزنا" />
-		<aya index="106" text="ذلك جزاؤهم جهنم بما كفروا واتخذوا آياتي ورسلي هزوا" />
-		<aya index="107" text="إن الذين آمنوا وعملوا الصالحات كانت لهم جنات الفردوس نزلا" />
-		<aya index="108" text="خالدين فيها لا يبغون عنها حولا" />
-		<aya index="109" text="قل لو كان البحر مدادا لكلمات ربي لنفد البحر قبل أن تنفد كلمات ربي ولو جئنا بمثله مددا" />
-		<aya index="110" text="قل إنما أنا بشر مثلكم يوحى إلي أنما إلهكم إله واحد فمن كان يرجو لقاء ربه فليعمل عملا صالحا ولا يشرك بعبادة ربه أحدا" />
-	</sura>
-	<sura index="19" name="مريم">
-		<aya index="1" text="كهيعص" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ذكر رحمت ربك عبده زكريا" />
-		<aya index="3" text="إذ نادى ربه نداء خفيا" />
-		<aya index="4" text="قال رب إني وهن العظم مني واشتعل الرأس شيبا ولم أكن بدعائك رب شقيا" />
-		<aya index="5" text="وإني خفت الموالي من ورائي وكانت امرأتي عاقرا فهب لي من لدنك وليا" />
-		<aya index="6" text="يرثني ويرث من آل يعقوب واجعله رب رضيا" />
-		<aya index="7" text="يا زكريا إنا نبشرك بغلام اسمه يحيى لم نجعل له من قبل سميا" />
-		<aya index="8" text="قال رب أنى يكون لي غلام وكانت امرأتي عاقرا وقد بلغت من الكبر عتيا" />
-		<aya index="9" text="قال كذلك قال ربك هو علي هين وقد خلقتك من قبل ولم تك شيئا" />
-		<aya index="10" text="قال رب اجعل لي آية قال آيتك ألا تكلم الناس ثلاث ليال سويا" />
-		<aya index="11" text="فخرج على قومه من المحراب فأوحى إليهم أن سبحوا بكرة وعشيا" />
-		<aya index="12" text="يا يحيى خذ الكتاب بقوة وآتيناه الحكم صبيا" />
-		<aya index="13" text="وحنانا من لدنا وزكاة وكان تقيا" />
-		<aya index="14" text="وبرا بوالديه ولم يكن جبارا عصيا" />
-		<aya index="15" text="وسلام عليه يوم ولد ويوم يموت ويوم يبعث حيا" />
-		<aya index="16" text="واذكر في الكتاب مريم إذ انتبذت من أهلها مكانا شرقيا" />
-		<aya index="17" text="فاتخذت من دونهم حجابا فأرسلنا إليها روحنا فتمثل لها بشرا سويا" />
-		<aya index="18" text="قالت إني أعوذ بالرحمن منك إن كنت تقيا" />
-		<aya index="19" text="قال إنما أنا رسول ربك لأهب لك غلاما زكيا" />
-		<aya index="20" text="قالت أنى يكون لي غلام ولم يمسسني بشر ولم أك بغيا" />
-		<aya index="21" text="قال كذلك قال ربك هو علي هين ولنجعله آية للناس ورحمة منا وكان أمرا مقضيا" />
-		<aya index="22" text="فحملته فانتبذت به مكانا قصيا" />
-		<aya index="23" text="فأجاءها المخاض إلى جذع النخلة قالت يا ليتني مت قبل هذا وكنت نسيا منسيا" />
-		<aya index="24" text="فناداها من تحتها ألا تحزني قد جعل ربك تحتك سريا" />
-		<aya index="25" text="وهزي إليك بجذع النخلة تساقط عليك رطبا جنيا" />
-		<aya index="26" text="فكلي واشربي وقري عينا فإما ترين من البشر أحدا فقولي إني نذرت للرحمن صوما فلن أكلم اليوم إنسيا" />
-		<aya index="27" text="فأتت به قومها تحمله قالوا يا مريم لقد جئت شيئا فريا" />
-		<aya index="28" text="يا أخت هارون ما كان أبوك امرأ سوء وما كانت أمك بغيا" />
-		<aya index="29" text="فأشارت إليه قالوا كيف نكلم من كان في المهد صبيا" />
-		<aya index="30" text="قال إني عبد الله آتاني الكتاب وجعلني نبيا" />
-		<aya index="31" text="وجعلني مباركا أين ما كنت وأوصاني بالصلاة والزكاة ما دمت حيا" />
-		<aya index="32" text="وبرا بوالدتي ولم يجعلني جبارا شقيا" />
-		<aya index="33" text="والسلام علي يوم ولدت ويوم أموت ويوم أبعث حيا" />
-		<aya index="34" text="ذلك عيسى ابن مريم قول الحق الذي فيه يمترون" />
-		<aya index="35" text="ما كان لله أن يتخذ من ولد سبحانه إذا قضى أمرا فإنما يقول له كن فيكون" />
-		<aya index="36" text="وإن الله ربي وربكم فاعبدوه هذا صراط مستقيم" />
-		<aya index="37" text="فاختلف الأحزاب من بينهم فويل للذين كفروا من مشهد يوم عظيم" />
-		<aya index="38" text="أسمع بهم وأبصر يوم يأتوننا لكن الظالمون اليوم في ضلال مبين" />
-		<aya index="39" text="وأنذرهم يوم الحسرة إذ قضي الأمر وهم في غفلة وهم لا يؤمنون" />
-		<aya index="40" text="إنا نحن نرث الأرض ومن عليها وإلينا يرجعون" />
-		<aya index="41" text="واذكر في الكتاب إبراهيم إنه كان صديقا نبيا" />
-		<aya index="42" text="إذ قال لأبيه يا أبت لم تعبد ما لا يسمع ولا يبصر ولا يغني عنك شيئا" />
-		<aya index="43" text="يا أبت إني قد جاءني من العلم ما لم يأتك فاتبعني أهدك صراطا سويا" />
-		<aya index="44" text="يا أبت لا تعبد الشيطان إن الشيطان كان للرحمن عصيا" />
-		<aya index="45" text="يا أبت إني أخاف أن يمسك عذاب من الرحمن فتكون للشيطان وليا" />
-		<aya index="46" text="قال أراغب أنت عن آلهتي يا إبراهيم لئن لم تنته لأرجمنك واهجرني مليا" />
-		<aya index="47" text="قال سلام عليك سأستغفر لك ربي إنه كان بي حفيا" />
-		<aya index="48" text="وأعتزلكم وما تدعون من دون الله وأدعو ربي عسى ألا أكون بدعاء ربي شقيا" />
-		<aya index="49" text="فلما اعتزلهم وما يعبدون من دون الله وهبنا له إسحاق ويعقوب وكلا جعلنا نبيا" />
-		<aya index="50" text="ووهبنا لهم من رحمتنا وجعلنا لهم لسان صدق عليا" />
-		<aya index="51" text="واذكر في الكتاب موسى إنه كان مخلصا وكان رسولا نبيا" />
-		<aya index="52" text="وناديناه من جانب الطور الأيمن وقربناه نجيا" />
-		<aya index="53" text="ووهبنا له من رحمتنا أخاه هارون نبيا" />
-		<aya index="54" text="واذكر في الكتاب إسماعيل إنه كان صادق الوعد وكان رسولا نبيا" />
-		<aya index="55" text="وكان يأمر أهله بالصلاة والزكاة وكان عند ربه مرضيا" />
-		<aya index="56" text="واذكر في الكتاب إدريس إنه كان صديقا نبيا" />
-		<aya index="57" text="ورفعناه مكانا عليا" />
-		<aya index="58" text="أولئك الذين أنعم الله عليهم من النبيين من ذرية آدم وممن حملنا مع نوح ومن ذرية إبراهيم وإسرائيل وممن هدينا واجتبينا إذا تتلى عليهم آيات الرحمن خروا سجدا وبكيا" />
-		<aya index="59" text="فخلف من بعدهم خلف أضاعوا الصلاة واتبعوا الشهوات فسوف يلقون غيا" />
-		<aya index="60" text="إلا من تاب وآمن وعمل صالحا فأولئك يدخلون الجنة ولا يظلمون شيئا" />
-		<aya index="61" text="جنات عدن التي وعد الرحمن عباده بالغيب إنه كان وعده مأتيا" />
-		<aya index="62" text="لا يسمعون فيها لغوا إلا سلاما ولهم رزقهم فيها بكرة وعشيا" />
-		<aya index="63" text="تلك الجنة التي نورث من عبادنا من كان تقيا" />
-		<aya index="64" text="وما نتنزل إلا بأمر ربك له ما بين أيدينا وما خلفنا وما بين ذلك وما كان ربك نسيا" />
-		<aya index="65" text="رب السماوات والأرض وما بينهما فاعبده واصطبر لعبادته هل تعلم له سميا" />
-		<aya index="66" text="ويقول الإنسان أإذا ما مت لسوف أخرج حيا" />
-		<aya index="67" text="أولا يذكر الإنسان أنا خلقناه من قبل ولم يك شيئا" />
-		<aya index="68" text="فوربك لنحشرنهم والشياطين ثم لنحضرنهم حول جهنم جثيا" />
-		<aya index="69" text="ثم لننزعن من كل شيعة أيهم أشد على الرحمن عتيا" />
-		<aya index="70" text="ثم لنحن أعلم بالذين هم أولى بها صليا" />
-		<aya index="71" text="وإن منكم إلا واردها كان على ربك حتما مقضيا" />
-		<aya index="72" text="ثم ننجي الذين اتقوا ونذر الظالمين فيها جثيا" />
-		<aya index="73" text="وإذا تتلى عليهم آياتنا بينات قال الذين كفروا للذين آمنوا أي الفريقين خير مقاما وأحسن نديا" />
-		<aya index="74" text="وكم أهلكنا قبلهم من قرن هم أحسن أثاثا ورئيا" />
-		<aya index="75" text="قل من كان في الضلالة فليمدد له الرحمن مدا حتى إذا رأوا ما يوعدون إما العذاب وإما الساعة فسيعلمون من هو شر مكانا وأضعف جندا" />
-		<aya index="76" text="ويزيد الله الذين اهتدوا هدى والباقيات الصالحات خير عند ربك ثوابا وخير مردا" />
-		<aya index="77" text="أفرأيت الذي كفر بآياتنا وقال لأوتين مالا وولدا" />
-		<aya index="78" text="أطلع الغيب أم اتخذ عند الرحمن عهدا" />
-		<aya index="79" text="كلا سنكتب ما يقول ونمد له من العذاب مدا" />
-		<aya index="80" text="ونرثه ما يقول ويأتينا فردا" />
-		<aya index="81" text="واتخذوا من دون الله آلهة ليكونوا لهم عزا" />
-		<aya index="82" text="كلا سيكفرون بعبادتهم ويكونون عليهم ضدا" />
-		<aya index="83" text="ألم تر أنا أرسلنا الشياطين على الكافرين تؤزهم أزا" />
-		<aya index="84" text="فلا تعجل عليهم إنما نعد لهم عدا" />
-		<aya index="85" text="يوم نحشر المتقين إلى الرحمن وفدا" />
-		<aya index="86" text="ونسوق المجرمين إلى جهنم وردا" />
-		<aya index="87" text="لا يملكون الشفاعة إلا من اتخذ عند الرحمن عهدا" />
-		<aya index="88" text="وقالوا اتخذ الرحمن ولدا" />
-		<aya index="89" text="لقد جئتم شيئا إدا" />
-		<aya index="90" text="تكاد السماوات يتفطرن منه وتنشق الأرض وتخر الجبال هدا" />
-		<aya index="91" text="أن دعوا للرحمن ولدا" />
-		<aya index="92" text="وما ينبغي للرحمن أن يتخذ ولدا" />
-		<aya index="93" text="إن كل من في السماوات والأرض إلا آتي الرحمن عبدا" />
-		<aya index="94" text="لقد أحصاهم وعدهم عدا" />
-		<aya index="95" text="وكلهم آتيه يوم القيامة فردا" />
-		<aya index="96" text="إن الذين آمنوا وعملوا الصالحات سيجعل لهم الرحمن ودا" />
-		<aya index="97" text="فإنما يسرناه بلسانك لتبشر به المتقين وتنذر به قوما لدا" />
-		<aya index="98" text="وكم أهلكنا قبلهم من قرن هل تحس منهم من أحد أو تسمع لهم ركزا" />
-	</sura>
-	<sura index="20" name="طه">
-		<aya index="1" text="طه" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ما أنزلنا عليك القرآن لتشقى" />
-		<aya index="3" text="إلا تذكرة لمن يخشى" />
-		<aya index="4" text="تنزيلا ممن خلق الأرض والسماوات العلى" />
-		<aya index="5" text="الرحمن على العرش استوى" />
-		<aya index="6" text="له ما في السماوات وما في الأرض وما بينهما وما تحت الثرى" />
-		<aya index="7" text="وإن تجهر بالقول فإنه يعلم السر وأخفى" />
-		<aya index="8" text="الله لا إله إلا هو له الأسماء الحسنى" />
-		<aya index="9" text="وهل أتاك حديث موسى" />
-		<aya index="10" text="إذ رأى نارا فقال لأهله امكثوا إني آنست نارا لعلي آتيكم منها بقبس أو أجد على النار هدى" />
-		<aya index="11" text="فلما أتاها نودي يا موسى" />
-		<aya index="12" text="إني أنا ربك فاخلع نعليك إنك بالواد المقدس طوى" />
-		<aya index="13" text="وأنا اخترتك فاستمع لما يوحى" />
-		<aya index="14" text="إنني أنا الله لا إله إلا أنا فاعبدني وأقم الصلاة لذكري" />
-		<aya index="15" text="إن الساعة آتية أكاد أخفيها لتجزى كل نفس بما تسعى" />
-		<aya index="16" text="فلا يصدنك عنها من لا يؤمن بها واتبع هواه فتردى" />
-		<aya index="17" text="وما تلك بيمينك يا موسى" />
-		<aya index="18" text="قال هي عصاي أتوكأ عليها وأهش بها على غنمي ولي فيها مآرب أخرى" />
-		<aya index="19" text="قال ألقها يا موسى" />
-		<aya index="20" text="فألقاها فإذا هي حية تسعى" />
-		<aya index="21" text="قال خذها ولا تخف سنعيدها سيرتها الأولى" />
-		<aya index="22" text="واضمم يدك إلى جناحك تخرج بيضاء من غير سوء آية أخرى" />
-		<aya index="23" text="لنريك من آياتنا الكبرى" />
-		<aya index="24" text="اذهب إلى فرعون إنه طغى" />
-		<aya index="25" text="قال رب اشرح لي صدري" />
-		<aya index="26" text="ويسر لي أمري" />
-		<aya index="27" text="واحلل عقدة من لساني" />
-		<aya index="28" text="يفقهوا قولي" />
-		<aya index="29" text="واجعل لي وزيرا من أهلي" />
-		<aya index="30" text="هارون أخي" />
-		<aya index="31" text="اشدد به أزري" />
-		<aya index="32" text="وأشركه في أمري" />
-		<aya index="33" text="كي نسبحك كثيرا" />
-		<aya index="34" text="ونذكرك كثيرا" />
-		<aya index="35" text="إنك كنت بنا بصيرا" />
-		<aya index="36" text="قال قد أوتيت سؤلك يا موسى" />
-		<aya index="37" text="ولقد مننا عليك مرة أخرى" />
-		<aya index="38" text="إذ أوحينا إلى أمك ما يوحى" />
-		<aya index="39" text="أن اقذفيه في التابوت فاقذفيه في اليم فليلقه اليم بالساحل يأخذه عدو لي وعدو له وألقيت عليك محبة مني ولتصنع على عيني" />
-		<aya index="40" text="إذ تمشي أختك فتقول هل أدلكم على من يكفله فرجعناك إلى أمك كي تقر عينها ولا تحزن وقتلت نفسا فنجيناك من الغم وفتناك فتونا فلبثت سنين في أهل مدين ثم جئت على قدر يا موسى" />
-		<aya index="41" text="واصطنعتك لنفسي" />
-		<aya index="42" text="اذهب أنت وأخوك بآياتي ولا تنيا في ذكري" />
-		<aya index="43" text="اذهبا إلى فرعون إنه طغى" />
-		<aya index="44" text="فقولا له قولا لينا لعله يتذكر أو يخشى" />
-		<aya index="45" text="قالا ربنا إننا نخاف أن يفرط علينا أو أن يطغى" />
-		<aya index="46" text="قال لا تخافا إنني معكما أسمع وأرى" />
-		<aya index="47" text="فأتياه فقولا إنا رسولا ربك فأرسل معنا بني إسرائيل ولا تعذبهم قد جئناك بآية من ربك والسلام على من اتبع الهدى" />
-		<aya index="48" text="إنا قد أوحي إلينا أن العذاب على من كذب وتولى" />
-		<aya index="49" text="قال فمن ربكما يا موسى" />
-		<aya index="50" text="قال ربنا الذي أعطى كل شيء خلقه ثم هدى" />
-		<aya index="51" text="قال فما بال القرون الأولى" />
-		<aya index="52" text="قال علمها عند ربي في كتاب لا يضل ربي ولا ينسى" />
-		<aya index="53" text="الذي جعل لكم الأرض مهدا وسلك لكم فيها سبلا وأنزل من السماء ماء فأخرجنا به أزواجا من نبات شتى" />
-		<aya index="54" text="كلوا وارعوا أنعامكم إن في ذلك لآيات لأولي النهى" />
-		<aya index="55" text="منها خلقناكم وفيها نعيدكم ومنها نخرجكم تارة أخرى" />
-		<aya index="56" text="ولقد أريناه آياتنا كلها فكذب وأبى" />
-		<aya index="57" text="قال أجئتنا لتخرجنا من أرضنا بسحرك يا موسى" />
-		<aya index="58" text="فلنأتينك بسحر مثله فاجعل بيننا وبينك موعدا لا نخلفه نحن ولا أنت مكانا سوى" />
-		<aya index="59" text="قال موعدكم يوم الزينة وأن يحشر الناس ضحى" />
-		<aya index="60" text="فتولى فرعون فجمع كيده ثم أتى" />
-		<aya index="61" text="قال لهم موسى ويلكم لا تفتروا على الله كذبا فيسحتكم بعذاب وقد خاب من افترى" />
-		<aya index="62" text="فتنازعوا أمرهم بينهم وأسروا النجوى" />
-		<aya index="63" text="قالوا إن هذان لساحران يريدان أن يخرجاكم من أرضكم بسحرهما ويذهبا بطريقتكم المثلى" />
-		<aya index="64" text="فأجمعوا كيدكم ثم ائتوا صفا وقد أفلح اليوم من استعلى" />
-		<aya index="65" text="قالوا يا موسى إما أن تلقي وإما أن نكون أول من ألقى" />
-		<aya index="66" text="قال بل ألقوا فإذا حبالهم وعصيهم يخيل إليه من سحرهم أنها تسعى" />
-		<aya index="67" text="فأوجس في نفسه خيفة موسى" />
-		<aya index="68" text="قلنا لا تخف إنك أنت الأعلى" />
-		<aya index="69" text="وألق ما في يمينك تلقف ما صنعوا إنما صنعوا كيد ساحر ولا يفلح الساحر حيث أتى" />
-		<aya index="70" text="فألقي السحرة سجدا قالوا آمنا برب هارون وموسى" />
-		<aya index="71" text="قال آمنتم له قبل أن آذن لكم إنه لكبيركم الذي علمكم السحر فلأقطعن أيديكم وأرجلكم من خلاف ولأصلبنكم في جذوع النخل ولتعلمن أينا أشد عذابا وأبقى" />
-		<aya index="72" text="قالوا لن نؤثرك على ما جاءنا من البينات والذي فطرنا فاقض ما أنت قاض إنما تقضي هذه الحياة الدنيا" />
-		<aya index="73" text="إنا آمنا بربنا ليغفر لنا خطايانا وما أكرهتنا عليه من السحر والله خير وأبقى" />
-		<aya index="74" text="إنه من يأت ربه مجرما فإن له جهنم لا يموت فيها ولا يحيى" />
-		<aya index="75" text="ومن يأته مؤمنا قد عمل الصالحات فأولئك لهم الدرجات العلى" />
-		<aya index="76" text="جنات عدن تجري من تحتها الأنهار خالدين فيها وذلك جزاء من تزكى" />
-		<aya index="77" text="ولقد أوحينا إلى موسى أن أسر بعبادي فاضرب لهم طريقا في البحر يبسا لا تخاف دركا ولا تخشى" />
-		<aya index="78" text="فأتبعهم فرعون بجنوده فغشيهم من اليم ما غشيهم" />
-		<aya index="79" text="وأضل فرعون قومه وما هدى" />
-		<aya index="80" text="يا بني إسرائيل قد أنجيناكم من عدوكم وواعدناكم جانب الطور الأيمن ونزلنا عليكم المن والسلوى" />
-		<aya index="81" text="كلوا من طيبات ما رزقناكم ولا تطغوا فيه فيحل عليكم غضبي ومن يحلل عليه غضبي فقد هوى" />
-		<aya index="82" text="وإني لغفار لمن تاب وآمن وعمل صالحا ثم اهتدى" />
-		<aya index="83" text="وما أعجلك عن قومك يا موسى" />
-		<aya index="84" text="قال هم أولاء على أثري وعجلت إليك رب لترضى" />
-		<aya index="85" text="قال فإنا قد فتنا قومك من بعدك وأضلهم السامري" />
-		<aya index="86" text="فرجع موسى إلى قومه غضبان أسفا قال يا قوم ألم يعدكم ربكم وعدا حسنا أفطال عليكم العهد أم أردتم أن يحل عليكم غضب من ربكم فأخلفتم موعدي" />
-		<aya index="87" text="قالوا ما أخلفنا موعدك بملكنا ولكنا حملنا أوزارا من زينة القوم فقذفناها فكذلك ألقى السامري" />
-		<aya index="88" text="فأخرج لهم عجلا جسدا له خوار فقالوا هذا إلهكم وإله موسى فنسي" />
-		<aya index="89" text="أفلا يرون ألا يرجع إليهم قولا ولا يملك لهم ضرا ولا نفعا" />
-		<aya index="90" text="ولقد قال لهم هارون من قبل يا قوم إنما فتنتم به وإن ربكم الرحمن فاتبعوني وأطيعوا أمري" />
-		<aya index="91" text="قالوا لن نبرح عليه عاكفين حتى يرجع إلينا موسى" />
-		<aya index="92" text="قال يا هارون ما منعك إذ رأيتهم ضلوا" />
-		<aya index="93" text="ألا تتبعن أفعصيت أمري" />
-		<aya index="94" text="قال يا ابن أم لا تأخذ بلحيتي ولا برأسي إني خشيت أن تقول فرقت بين بني إسرائيل ولم ترقب قولي" />
-		<aya index="95" text="قال فما خطبك يا سامري" />
-		<aya index="96" text="قال بصرت بما لم يبصروا به فقبضت قبضة من أثر الرسول فنبذتها وكذلك سولت لي نفسي" />
-		<aya index="97" text="قال فاذهب فإن لك في الحياة أن تقول لا مساس وإن لك موعدا لن تخلفه وانظر إلى إلهك الذي ظلت عليه عاكفا لنحرقنه ثم لننسفنه في اليم نسفا" />
-		<aya index="98" text="إنما إلهكم الله الذي لا إله إلا هو وسع كل شيء علما" />
-		<aya index="99" text="كذلك نقص عليك من أنباء ما قد سبق وقد آتيناك من لدنا ذكرا" />
-		<aya index="100" text="من أعرض عنه فإنه يحمل يوم القيامة وزرا" />
-		<aya index="101" text="خالدين فيه وساء لهم يوم القيامة حملا" />
-		<aya index="102" text="يوم ينفخ في الصور ونحشر المجرمين يومئذ زرقا" />
-		<aya index="103" text="يتخافتون بينهم إن لبثتم إلا عشرا" />
-		<aya index="104" text="نحن أعلم بما يقولون إذ يقول أمثلهم طريقة إن لبثتم إلا يوما" />
-		<aya index="105" text="ويسألونك عن الجبال فقل ينسفها ربي نسفا" />
-		<aya index="106" text="فيذرها قاعا صفصفا" />
-		<aya index="107" text="لا ترى فيها عوجا ولا أمتا" />
-		<aya index="108" text="يومئذ يتبعون الداعي لا عوج له وخشعت الأصوات للرحمن فلا تسمع إلا همسا" />
-		<aya index="109" text="يومئذ لا تنفع الشفاعة إلا من أذن له الرحمن ورضي له قولا" />
-		<aya index="110" text="يعلم ما بين أيديهم وما خلفهم ولا يحيطون به علما" />
-		<aya index="111" text="وعنت الوجوه للحي القيوم وقد خاب من حمل ظلما" />
-		<aya index="112" text="ومن يعمل من الصالحات وهو مؤمن فلا يخاف ظلما ولا هضما" />
-		<aya index="113" text="وكذلك أنزلناه قرآنا عربيا وصرفنا فيه من الوعيد لعلهم يتقون أو يحدث لهم ذكرا" />
-		<aya index="114" text="فتعالى الله الملك الحق ولا تعجل بالقرآن من قبل أن يقضى إليك وحيه وقل رب زدني علما" />
-		<aya index="115" text="ولقد عهدنا إلى آدم من قبل فنسي ولم نجد له عزما" />
-		<aya index="116" text="وإذ قلنا للملائكة اسجدوا لآدم فسجدوا إلا إبليس أبى" />
-		<aya index="117" text="فقلنا يا آدم إن هذا عدو لك ولزوجك فلا يخرجنكما من الجنة فتشقى" />
-		<aya index="118" text="إن لك ألا تجوع فيها ولا تعرى" />
-		<aya index="119" text="وأنك لا تظمأ فيها ولا تضحى" />
-		<aya index="120" text="فوسوس إليه الشيطان قال يا آدم هل أدلك على شجرة الخلد وملك لا يبلى" />
-		<aya index="121" text="فأكلا منها فبدت لهما سوآتهما وطفقا يخصفان عليهما من ورق الجنة وعصى آدم ربه فغوى" />
-		<aya index="122" text="ثم اجتباه ربه فتاب عليه وهدى" />
-		<aya index="123" text="قال اهبطا منها جميعا بعضكم لبعض عدو فإما يأتينكم مني هدى فمن اتبع هداي فلا يضل ولا يشقى" />
-		<aya index="124" text="ومن أعرض عن ذكري فإن له معيشة ضنكا ونحشره يوم القيامة أعمى" />
-		<aya index="125" text="قال رب لم حشرتني أعمى وقد كنت بصيرا" />
-		<aya index="126" text="قال كذلك أتتك آياتنا فنسيتها وكذلك اليوم تنسى" />
-		<aya index="127" text="وكذلك نجزي من أسرف ولم يؤمن بآيات ربه ولعذاب الآخرة أشد وأبقى" />
-		<aya index="128" text="أفلم يهد لهم كم أهلكنا قبلهم من القرون يمشون في مساكنهم إن في ذلك لآيات لأولي النهى" />
-		<aya index="129" text="ولولا كلمة سبقت من ربك لكان لزاما وأجل مسمى" />
-		<aya index="130" text="فاصبر على ما يقولون وسبح بحمد ربك قبل طلوع الشمس وقبل غروبها ومن آناء الليل فسبح وأطراف النهار لعلك ترضى" />
-		<aya index="131" text="ولا تمدن عينيك إلى ما متعنا به أزواجا منهم زهرة الحياة الدنيا لنفتنهم فيه ورزق ربك خير وأبقى" />
-		<aya index="132" text="وأمر أهلك بالصلاة واصطبر عليها لا نسألك رزقا نحن نرزقك والعاقبة للتقوى" />
-		<aya index="133" text="وقالوا لولا يأتينا بآية من ربه أولم تأتهم بينة ما في الصحف الأولى" />
-		<aya index="134" text="ولو أنا أهلكناهم بعذاب من قبله لقالوا ربنا لولا أرسلت إلينا رسولا فنتبع آياتك من قبل أن نذل ونخزى" />
-		<aya index="135" text="قل كل متربص فتربصوا فستعلمون من أصحاب الصراط السوي ومن اهتدى" />
-	</sura>
-	<sura index="21" name="الأنبياء">
-		<aya index="1" text="اقترب للناس حسابهم وهم في غفلة معرضون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ما يأتيهم من ذكر من ربهم محدث إلا استمعوه وهم يلعبون" />
-		<aya index="3" text="لاهية قلوبهم وأسروا النجوى الذين ظلموا هل هذا إلا بشر مثلكم أفتأتون السحر وأنتم تبصرون" />
-		<aya index="4" text="قال ربي يعلم القول في السماء والأرض وهو السميع العليم" />
-		<aya index="5" text="بل قالوا أضغاث أحلام بل افتراه بل هو شاعر فليأتنا بآية كما أرسل الأولون" />
-		<aya index="6" text="ما آمنت قبلهم من قرية أهلكناها أفهم يؤمنون" />
-		<aya index="7" text="وما أرسلنا قبلك إلا رجالا نوحي إليهم فاسألوا أهل الذكر إن كنتم لا تعلمون" />
-		<aya index="8" text="وما جعلناهم جسدا لا يأكلون الطعام وما كانوا خالدين" />
-		<aya index="9" text="ثم صدقناهم الوعد فأنجيناهم ومن نشاء وأهلكنا المسرفين" />
-		<aya index="10" text="لقد أنزلنا إليكم كتابا فيه ذكركم أفلا تعقلون" />
-		<aya index="11" text="وكم قصمنا من قرية كانت ظالمة وأنشأنا بعدها قوما آخرين" />
-		<aya index="12" text="فلما أحسوا بأسنا إذا هم منها يركضون" />
-		<aya index="13" text="لا تركضوا وارجعوا إلى ما أترفتم فيه ومساكنكم لعلكم تسألون" />
-		<aya index="14" text="قالوا يا ويلنا إنا كنا ظالمين" />
-		<aya index="15" text="فما زالت تلك دعواهم حتى جعلناهم حصيدا خامدين" />
-		<aya index="16" text="وما خلقنا السماء والأرض وما بينهما لاعبين" />
-		<aya index="17" text="لو أردنا أن نتخذ لهوا لاتخذناه من لدنا إن كنا فاعلين" />
-		<aya index="18" text="بل نقذف بالحق على الباطل فيدمغه فإذا هو زاهق ولكم الويل مما تصفون" />
-		<aya index="19" text="وله من في السماوات والأرض ومن عنده لا يستكبرون عن عبادته ولا يستحسرون" />
-		<aya index="20" text="يسبحون الليل والنهار لا يفترون" />
-		<aya index="21" text="أم اتخذوا آلهة من الأرض هم ينشرون" />
-		<aya index="22" text="لو كان فيهما آلهة إلا الله لفسدتا فسبحان الله رب العرش عما يصفون" />
-		<aya index="23" text="لا يسأل عما يفعل وهم يسألون" />
-		<aya index="24" text="أم اتخذوا من دونه آلهة قل هاتوا برهانكم هذا ذكر من معي وذكر من قبلي بل أكثرهم لا يعلمون الحق فهم معرضون" />
-		<aya index="25" text="وما أرسلنا من قبلك من رسول إلا نوحي إليه أنه لا إله إلا أنا فاعبدون" />
-		<aya index="26" text="وقالوا اتخذ الرحمن ولدا سبحانه بل عباد مكرمون" />
-		<aya index="27" text="لا يسبقونه بالقول وهم بأمره يعملون" />
-		<aya index="28" text="يعلم ما بين أيديهم وما خلفهم ولا يشفعون إلا لمن ارتضى وهم من خشيته مشفقون" />
-		<aya index="29" text="ومن يقل منهم إني إله من دونه فذلك نجزيه جهنم كذلك نجزي الظالمين" />
-		<aya index="30" text="أولم ير الذين كفروا أن السماوات والأرض كانتا رتقا ففتقناهما وجعلنا من الماء كل شيء حي أفلا يؤمنون" />
-		<aya index="31" text="وجعلنا في الأرض رواسي أن تميد بهم وجعلنا فيها فجاجا سبلا لعلهم يهتدون" />
-		<aya index="32" text="وجعلنا السماء سقفا محفوظا وهم عن آياتها معرضون" />
-		<aya index="33" text="وهو الذي خلق الليل والنهار والشمس والقمر كل في فلك يسبحون" />
-		<aya index="34" text="وما جعلنا لبشر من قبلك الخلد أفإن مت فهم الخالدون" />
-		<aya index="35" text="كل نفس ذائقة الموت ونبلوكم بالشر والخير فتنة وإلينا ترجعون" />
-		<aya index="36" text="وإذا رآك الذين كفروا إن يتخذونك إلا هزوا أهذا الذي يذكر آلهتكم وهم بذكر الرحمن هم كافرون" />
-		<aya index="37" text="خلق الإنسان من عجل سأريكم آياتي فلا تستعجلون" />
-		<aya index="38" text="ويقولون متى هذا الوعد إن كنتم صادقين" />
-		<aya index="39" text="لو يعلم الذين كفروا حين لا يكفون عن وجوههم النار ولا عن ظهورهم ولا هم ينصرون" />
-		<aya index="40" text="بل تأتيهم بغتة فتبهتهم فلا يستطيعون ردها ولا هم ينظرون" />
-		<aya index="41" text="ولقد استهزئ برسل من قبلك فحاق بالذين سخروا منهم ما كانوا به يستهزئون" />
-		<aya index="42" text="قل من يكلؤكم بالليل والنهار من الرحمن بل هم عن ذكر ربهم معرضون" />
-		<aya index="43" text="أم لهم آلهة تمنعهم من دوننا لا يستطيعون نصر أنفسهم ولا هم منا يصحبون" />
-		<aya index="44" text="بل متعنا هؤلاء وآباءهم حتى طال عليهم العمر أفلا يرون أنا نأتي الأرض ننقصها من أطرافها أفهم الغالبون" />
-		<aya index="45" text="قل إنما أنذركم بالوحي ولا يسمع الصم الدعاء إذا ما ينذرون" />
-		<aya index="46" text="ولئن مستهم نفحة من عذاب ربك ليقولن يا ويلنا إنا كنا ظالمين" />
-		<aya index="47" text="ونضع الموازين القسط ليوم القيامة فلا تظلم نفس شيئا وإن كان مثقال حبة من خردل أتينا بها وكفى بنا حاسبين" />
-		<aya index="48" text="ولقد آتينا موسى وهارون الفرقان وضياء وذكرا للمتقين" />
-		<aya index="49" text="الذين يخشون ربهم بالغيب وهم من الساعة مشفقون" />
-		<aya index="50" text="وهذا ذكر مبارك أنزلناه أفأنتم له منكرون" />
-		<aya index="51" text="ولقد آتينا إبراهيم رشده من قبل وكنا به عالمين" />
-		<aya index="52" text="إذ قال لأبيه وقومه ما هذه التماثيل التي أنتم لها عاكفون" />
-		<aya index="53" text="قالوا وجدنا آباءنا لها عابدين" />
-		<aya index="54" text="قال لقد كنتم أنتم وآباؤكم في ضلال مبين" />
-		<aya index="55" text="قالوا أجئتنا بالحق أم أنت من اللاعبين" />
-		<aya index="56" text="قال بل ربكم رب السماوات والأرض الذي فطرهن وأنا على ذلكم من الشاهدين" />
-		<aya index="57" text="وتالله لأكيدن أصنامكم بعد أن تولوا مدبرين" />
-		<aya index="58" text="فجعلهم جذاذا إلا كبيرا لهم لعلهم إليه يرجعون" />
-		<aya index="59" text="قالوا من فعل هذا بآلهتنا إنه لمن الظالمين" />
-		<aya index="60" text="قالوا سمعنا فتى يذكرهم يقال له إبراهيم" />
-		<aya index="61" text="قالوا فأتوا به على أعين الناس لعلهم يشهدون" />
-		<aya index="62" text="قالوا أأنت فعلت هذا بآلهتنا يا إبراهيم" />
-		<aya index="63" text="قال بل فعله كبيرهم هذا فاسألوهم إن كانوا ينطقون" />
-		<aya index="64" text="فرجعوا إلى أنفسهم فقالوا إنكم أنتم الظالمون" />
-		<aya index="65" text="ثم نكسوا على رءوسهم لقد علمت ما هؤلاء ينطقون" />
-		<aya index="66" text="قال أفتعبدون من دون الله ما لا ينفعكم شيئا ولا يضركم" />
-		<aya index="67" text="أف لكم ولما تعبدون من دون الله أفلا تعقلون" />
-		<aya index="68" text="قالوا حرقوه وانصروا آلهتكم إن كنتم فاعلين" />
-		<aya index="69" text="قلنا يا نار كوني بردا وسلاما على إبراهيم" />
-		<aya index="70" text="وأرادوا به كيدا فجعلناهم الأخسرين" />
-		<aya index="71" text="ونجيناه ولوطا إلى الأرض التي باركنا فيها للعالمين" />
-		<aya index="72" text="ووهبنا له إسحاق ويعقوب نافلة وكلا جعلنا صالحين" />
-		<aya index="73" text="وجعلناهم أئمة يهدون بأمرنا وأوحينا إليهم فعل الخيرات وإقام الصلاة وإيتاء الزكاة وكانوا لنا عابدين" />
-		<aya index="74" text="ولوطا آتيناه حكما وعلما ونجيناه من القرية التي كانت تعمل الخبائث إنهم كانوا قوم سوء فاسقين" />
-		<aya index="75" text="وأدخلناه في رحمتنا إنه من الصالحين" />
-		<aya index="76" text="ونوحا إذ نادى من قبل فاستجبنا له فنجيناه وأهله من الكرب العظيم" />
-		<aya index="77" text="ونصرناه من القوم الذين كذبوا بآياتنا إنهم كانوا قوم سوء فأغرقناهم أجمعين" />
-		<aya index="78" text="وداوود وسليمان إذ يحكمان في الحرث إذ نفشت فيه غنم القوم وكنا لحكمهم شاهدين" />
-		<aya index="79" text="ففهمناها سليمان وكلا آتينا حكما وعلما وسخرنا مع داوود الجبال يسبحن والطير وكنا فاعلين" />
-		<aya index="80" text="وعلمناه صنعة لبوس لكم لتحصنكم من بأسكم فهل أنتم شاكرون" />
-		<aya index="81" text="ولسليمان الريح عاصفة تجري بأمره إلى الأرض التي باركنا فيها وكنا بكل شيء عالمين" />
-		<aya index="82" text="ومن الشياطين من يغوصون له ويعملون عملا دون ذلك وكنا لهم حافظين" />
-		<aya index="83" text="وأيوب إذ نادى ربه أني مسني الضر وأنت أرحم الراحمين" />
-		<aya index="84" text="فاستجبنا له فكشفنا ما به من ضر وآتيناه أهله ومثلهم معهم رحمة من عندنا وذكرى للعابدين" />
-		<aya index="85" text="وإسماعيل وإدريس وذا الكفل كل من الصابرين" />
-		<aya index="86" text="وأدخلناهم في رحمتنا إنهم من الصالحين" />
-		<aya index="87" text="وذا النون إذ ذهب مغاضبا فظن أن لن نقدر عليه فنادى في الظلمات أن لا إله إلا أنت سبحانك إني كنت من الظالمين" />
-		<aya index="88" text="فاستجبنا له ونجيناه من الغم وكذلك ننجي المؤمنين" />
-		<aya index="89" text="وزكريا إذ نادى ربه رب لا تذرني فردا وأنت خير الوارثين" />
-		<aya index="90" text="فاستجبنا له ووهبنا له يحيى وأصلحنا له زوجه إنهم كانوا يسارعون في الخيرات ويدعوننا رغبا ورهبا وكانوا لنا خاشعين" />
-		<aya index="91" text="والتي أحصنت فرجها فنفخنا فيها من روحنا وجعلناها وابنها آية للعالمين" />
-		<aya index="92" text="إن هذه أمتكم أمة واحدة وأنا ربكم فاعبدون" />
-		<aya index="93" text="وتقطعوا أمرهم بينهم كل إلينا راجعون" />
-		<aya index="94" text="فمن يعمل من الصالحات وهو مؤمن فلا كفران لسعيه وإنا له كاتبون" />
-		<aya index="95" text="وحرام على قرية أهلكناها أنهم لا يرجعون" />
-		<aya index="96" text="حتى إذا فتحت يأجوج ومأجوج وهم من كل حدب ينسلون" />
-		<aya index="97" text="واقترب الوعد الحق فإذا هي شاخصة أبصار الذين كفروا يا ويلنا قد كنا في غفلة من هذا بل كنا ظالمين" />
-		<aya index="98" text="إنكم وما تعبدون من دون الله حصب جهنم أنتم لها واردون" />
-		<aya index="99" text="لو كان هؤلاء آلهة ما وردوها وكل فيها خالدون" />
-		<aya index="100" text="لهم فيها زفير وهم فيها لا يسمعون" />
-		<aya index="101" text="إن الذين سبقت لهم منا الحسنى أولئك عنها مبعدون" />
-		<aya index="102" text="لا يسمعون حسيسها وهم في ما اشتهت أنفسهم خالدون" />
-		<aya index="103" text="لا يحزنهم الفزع الأكبر وتتلقاهم الملائكة هذا يومكم الذي كنتم توعدون" />
-		<aya index="104" text="يوم نطوي السماء كطي السجل للكتب كما بدأنا أول خلق نعيده وعدا علينا إنا كنا فاعلين" />
-		<aya index="105" text="ولقد كتبنا في الزبور من بعد الذكر أن الأرض يرثها عبادي الصالحون" />
-		<aya index="106" text="إن في هذا لبلاغا لقوم عابدين" />
-		<aya index="107" text="وما أرسلناك إلا رحمة للعالمين" />
-		<aya index="108" text="قل إنما يوحى إلي أنما إلهكم إله واحد فهل أنتم مسلمون" />
-		<aya index="109" text="فإن تولوا فقل آذنتكم على سواء وإن أدري أقريب أم بعيد ما توعدون" />
-		<aya index="110" text="إنه يعلم الجهر من القول ويعلم ما تكتمون" />
-		<aya index="111" text="وإن أدري لعله فتنة لكم ومتاع إلى حين" />
-		<aya index="112" text="قال رب احكم بالحق وربنا الرحمن المستعان على ما تصفون" />
-	</sura>
-	<sura index="22" name="الحج">
-		<aya index="1" text="يا أيها الناس اتقوا ربكم إن زلزلة الساعة شيء عظيم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="يوم ترونها تذهل كل مرضعة عما أرضعت وتضع كل ذات حمل حملها وترى الناس سكارى وما هم بسكارى ولكن عذاب الله شديد" />
-		<aya index="3" text="ومن الناس من يجادل في الله بغير علم ويتبع كل شيطان مريد" />
-		<aya index="4" text="كتب عليه أنه من تولاه فأنه يضله ويهديه إلى عذاب السعير" />
-		<aya index="5" text="يا أيها الناس إن كنتم في ريب من البعث فإنا خلقناكم من تراب ثم من نطفة ثم من علقة ثم من مضغة مخلقة وغير مخلقة لنبين لكم ونقر في الأرحام ما نشاء إلى أجل مسمى ثم نخرجكم طفلا ثم لتبلغوا أشدكم ومنكم من يتوفى ومنكم من يرد إلى أرذل العمر لكيلا يعلم من بعد علم شيئا وترى الأرض هامدة فإذا أنزلنا عليها الماء اهتزت وربت وأنبتت من كل زوج بهيج" />
-		<aya index="6" text="ذلك بأن الله هو الحق وأنه يحيي الموتى وأنه على كل شيء قدير" />
-		<aya index="7" text="وأن الساعة آتية لا ريب فيها وأن الله يبعث من في القبور" />
-		<aya index="8" text="ومن الناس من يجادل في الله بغير علم ولا هدى ولا كتاب منير" />
-		<aya index="9" text="ثاني عطفه ليضل عن سبيل الله له في الدنيا خزي ونذيقه يوم القيامة عذاب الحريق" />
-		<aya index="10" text="ذلك بما قدمت يداك وأن الله ليس بظلام للعبيد" />
-		<aya index="11" text="ومن الناس من يعبد الله على حرف فإن أصابه خير اطمأن به وإن أصابته فتنة انقلب على وجهه خسر الدنيا والآخرة ذلك هو الخسران المبين" />
-		<aya index="12" text="يدعو من دون الله ما لا يضره وما لا ينفعه ذلك هو الضلال البعيد" />
-		<aya index="13" text="يدعو لمن ضره أقرب من نفعه لبئس المولى ولبئس العشير" />
-		<aya index="14" text="إن الله يدخل الذين آمنوا وعملوا الصالحات جنات تجري من تحتها الأنهار إن الله يفعل ما يريد" />
-		<aya index="15" text="من كان يظن أن لن ينصره الله في الدنيا والآخرة فليمدد بسبب إلى السماء ثم ليقطع فلينظر هل يذهبن كيده ما يغيظ" />
-		<aya index="16" text="وكذلك أنزلناه آيات بينات وأن الله يهدي من يريد" />
-		<aya index="17" text="إن الذين آمنوا والذين هادوا والصابئين والنصارى والمجوس والذين أشركوا إن الله يفصل بينهم يوم القيامة إن الله على كل شيء شهيد" />
-		<aya index="18" text="ألم تر أن الله يسجد له من في السماوات ومن في الأرض والشمس والقمر والنجوم والجبال والشجر والدواب وكثير من الناس وكثير حق عليه العذاب ومن يهن الله فما له من مكرم إن الله يفعل ما يشاء" />
-		<aya index="19" text="هذان خصمان اختصموا في ربهم فالذين كفروا قطعت لهم ثياب من نار يصب من فوق رءوسهم الحميم" />
-		<aya index="20" text="يصهر به ما في بطونهم والجلود" />
-		<aya index="21" text="ولهم مقامع من حديد" />
-		<aya index="22" text="كلما أرادوا أن يخرجوا منها من غم أعيدوا فيها وذوقوا عذاب الحريق" />
-		<aya index="23" text="إن الله يدخل الذين آمنوا وعملوا الصالحات جنات تجري من تحتها الأنهار يحلون فيها من أساور من ذهب ولؤلؤا ولباسهم فيها حرير" />
-		<aya index="24" text="وهدوا إلى الطيب من القول وهدوا إلى صراط الحميد" />
-		<aya index="25" text="إن الذين كفروا ويصدون عن سبيل الله والمسجد الحرام الذي جعلناه للناس سواء العاكف فيه والباد ومن يرد فيه بإلحاد بظلم نذقه من عذاب أليم" />
-		<aya index="26" text="وإذ بوأنا لإبراهيم مكان البيت أن لا تشرك بي شيئا وطهر بيتي للطائفين والقائمين والركع السجود" />
-		<aya index="27" text="وأذن في الناس بالحج يأتوك رجالا وعلى كل ضامر يأتين من كل فج عميق" />
-		<aya index="28" text="ليشهدوا منافع لهم ويذكروا اسم الله في أيام معلومات على ما رزقهم من بهيمة الأنعام فكلوا منها وأطعموا البائس الفقير" />
-		<aya index="29" text="ثم ليقضوا تفثهم وليوفوا نذورهم وليطوفوا بالبيت العتيق" />
-		<aya index="30" text="ذلك ومن يعظم حرمات الله فهو خير له عند ربه وأحلت لكم الأنعام إلا ما يتلى عليكم فاجتنبوا الرجس من الأوثان واجتنبوا قول الزور" />
-		<aya index="31" text="حنفاء لله غير مشركين به ومن يشرك بالله فكأنما خر من السماء فتخطفه الطير أو تهوي به الريح في مكان سحيق" />
-		<aya index="32" text="ذلك ومن يعظم شعائر الله فإنها من تقوى القلوب" />
-		<aya index="33" text="لكم فيها منافع إلى أجل مسمى ثم محلها إلى البيت العتيق" />
-		<aya index="34" text="ولكل أمة جعلنا منسكا ليذكروا اسم الله على ما رزقهم من بهيمة الأنعام فإلهكم إله واحد فله أسلموا وبشر المخبتين" />
-		<aya index="35" text="الذين إذا ذكر الله وجلت قلوبهم والصابرين على ما أصابهم والمقيمي الصلاة ومما رزقناهم ينفقون" />
-		<aya index="36" text="والبدن جعلناها لكم من شعائر الله لكم فيها خير فاذكروا اسم الله عليها صواف فإذا وجبت جنوبها فكلوا منها وأطعموا القانع والمعتر كذلك سخرناها لكم لعلكم تشكرون" />
-		<aya index="37" text="لن ينال الله لحومها ولا دماؤها ولكن يناله التقوى منكم كذلك سخرها لكم لتكبروا الله على ما هداكم وبشر المحسنين" />
-		<aya index="38" text="إن الله يدافع عن الذين آمنوا إن الله لا يحب كل خوان كفور" />
-		<aya index="39" text="أذن للذين يقاتلون بأنهم ظلموا وإن الله على نصرهم لقدير" />
-		<aya index="40" text="الذين أخرجوا من ديارهم بغير حق إلا أن يقولوا ربنا الله ولولا دفع الله الناس بعضهم ببعض لهدمت صوامع وبيع وصلوات ومساجد يذكر فيها اسم الله كثيرا ولينصرن الله من ينصره إن الله لقوي عزيز" />
-		<aya index="41" text="الذين إن مكناهم في الأرض أقاموا الصلاة وآتوا الزكاة وأمروا بالمعروف ونهوا عن المنكر ولله عاقبة الأمور" />
-		<aya index="42" text="وإن يكذبوك فقد كذبت قبلهم قوم نوح وعاد وثمود" />
-		<aya index="43" text="وقوم إبراهيم وقوم لوط" />
-		<aya index="44" text="وأصحاب مدين وكذب موسى فأمليت للكافرين ثم أخذتهم فكيف كان نكير" />
-		<aya index="45" text="فكأين من قرية أهلكناها وهي ظالمة فهي خاوية على عروشها وبئر معطلة وقصر مشيد" />
-		<aya index="46" text="أفلم يسيروا في الأرض فتكون لهم قلوب يعقلون بها أو آذان يسمعون بها فإنها لا تعمى الأبصار ولكن تعمى القلوب التي في الصدور" />
-		<aya index="47" text="ويستعجلونك بالعذاب ولن يخلف الله وعده وإن يوما عند ربك كألف سنة مما تعدون" />
-		<aya index="48" text="وكأين من قرية أمليت لها وهي ظالمة ثم أخذتها وإلي المصير" />
-		<aya index="49" text="قل يا أيها الناس إنما أنا لكم نذير مبين" />
-		<aya index="50" text="فالذين آمنوا وعملوا الصالحات لهم مغفرة ورزق كريم" />
-		<aya index="51" text="والذين سعوا في آياتنا معاجزين أولئك أصحاب الجحيم" />
-		<aya index="52" text="وما أرسلنا من قبلك من رسول ولا نبي إلا إذا تمنى ألقى الشيطان في أمنيته فينسخ الله ما يلقي الشيطان ثم يحكم الله آياته والله عليم حكيم" />
-		<aya index="53" text="ليجعل ما يلقي الشيطان فتنة للذين في قلوبهم مرض والقاسية قلوبهم وإن الظالمين لفي شقاق بعيد" />
-		<aya index="54" text="وليعلم الذين أوتوا العلم أنه الحق من ربك فيؤمنوا به فتخبت له قلوبهم وإن الله لهاد الذين آمنوا إلى صراط مستقيم" />
-		<aya index="55" text="ولا يزال الذين كفروا في مرية منه حتى تأتيهم الساعة بغتة أو يأتيهم عذاب يوم عقيم" />
-		<aya index="56" text="الملك يومئذ لله يحكم بينهم فالذين آمنوا وعملوا الصالحات في جنات النعيم" />
-		<aya index="57" text="والذين كفروا وكذبوا بآياتنا فأولئك لهم عذاب مهين" />
-		<aya index="58" text="والذين هاجروا في سبيل الله ثم قتلوا أو ماتوا ليرزقنهم الله رزقا حسنا وإن الله لهو خير الرازقين" />
-		<aya index="59" text="ليدخلنهم مدخلا يرضونه وإن الله لعليم حليم" />
-		<aya index="60" text="ذلك ومن عاقب بمثل ما عوقب به ثم بغي عليه لينصرنه الله إن الله لعفو غفور" />
-		<aya index="61" text="ذلك بأن الله يولج الليل في النهار ويولج النهار في الليل وأن الله سميع بصير" />
-		<aya index="62" text="ذلك بأن الله هو الحق وأن ما يدعون من دونه هو الباطل وأن الله هو العلي الكبير" />
-		<aya index="63" text="ألم تر أن الله أنزل من السماء ماء فتصبح الأرض مخضرة إن الله لطيف خبير" />
-		<aya index="64" text="له ما في السماوات وما في الأرض وإن الله لهو الغني الحميد" />
-		<aya index="65" text="ألم تر أن الله سخر لكم ما في الأرض والفلك تجري في البحر بأمره ويمسك السماء أن تقع على الأرض إلا بإذنه إن الله بالناس لرءوف رحيم" />
-		<aya index="66" text="وهو الذي أحياكم ثم يميتكم ثم يحييكم إن الإنسان لكفور" />
-		<aya index="67" text="لكل أمة جعلنا منسكا هم ناسكوه فلا ينازعنك في الأمر وادع إلى ربك إنك لعلى هدى مستقيم" />
-		<aya index="68" text="وإن جادلوك فقل الله أعلم بما تعملون" />
-		<aya index="69" text="الله يحكم بينكم يوم القيامة فيما كنتم فيه تختلفون" />
-		<aya index="70" text="ألم تعلم أن الله يعلم ما في السماء والأرض إن ذلك في كتاب إن ذلك على الله يسير" />
-		<aya index="71" text="ويعبدون من دون الله ما لم ينزل به سلطانا وما ليس لهم به علم وما للظالمين من نصير" />
-		<aya index="72" text="وإذا تتلى عليهم آياتنا بينات تعرف في وجوه الذين كفروا المنكر يكادون يسطون بالذين يتلون عليهم آياتنا قل أفأنبئكم بشر من ذلكم النار وعدها الله الذين كفروا وبئس المصير" />
-		<aya index="73" text="يا أيها الناس ضرب مثل فاستمعوا له إن الذين تدعون من دون الله لن يخلقوا ذبابا ولو اجتمعوا له وإن يسلبهم الذباب شيئا لا يستنقذوه منه ضعف الطالب والمطلوب" />
-		<aya index="74" text="ما قدروا الله حق قدره إن الله لقوي عزيز" />
-		<aya index="75" text="الله يصطفي من الملائكة رسلا ومن الناس إن الله سميع بصير" />
-		<aya index="76" text="يعلم ما بين أيديهم وما خلفهم وإلى الله ترجع الأمور" />
-		<aya index="77" text="يا أيها الذين آمنوا اركعوا واسجدوا واعبدوا ربكم وافعلوا الخير لعلكم تفلحون" />
-		<aya index="78" text="وجاهدوا في الله حق جهاده هو اجتباكم وما جعل عليكم في الدين من حرج ملة أبيكم إبراهيم هو سماكم المسلمين من قبل وفي هذا ليكون الرسول شهيدا عليكم وتكونوا شهداء على الناس فأقيموا الصلاة وآتوا الزكاة واعتصموا بالله هو مولاكم فنعم المولى ونعم النصير" />
-	</sura>
-	<sura index="23" name="المؤمنون">
-		<aya index="1" text="قد أفلح المؤمنون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الذين هم في صلاتهم خاشعون" />
-		<aya index="3" text="والذين هم عن اللغو معرضون" />
-		<aya index="4" text="والذين هم للزكاة فاعلون" />
-		<aya index="5" text="والذين هم لفروجهم حافظون" />
-		<aya index="6" text="إلا على أزواجهم أو ما ملكت أيمانهم فإنهم غير ملومين" />
-		<aya index="7" text="فمن ابتغى وراء ذلك فأولئك هم العادون" />
-		<aya index="8" text="والذين هم لأماناتهم وعهدهم راعون" />
-		<aya index="9" text="والذين هم على صلواتهم يحافظون" />
-		<aya index="10" text="أولئك هم الوارثون" />
-		<aya index="11" text="الذين يرثون الفردوس هم فيها خالدون" />
-		<aya index="12" text="ولقد خلقنا الإنسان من سلالة من طين" />
-		<aya index="13" text="ثم جعلناه نطفة في قرار مكين" />
-		<aya index="14" text="ثم خلقنا النطفة علقة فخلقنا العلقة مضغة فخلقنا المضغة عظاما فكسونا العظام لحما ثم أنشأناه خلقا آخر فتبارك الله أحسن الخالقين" />
-		<aya index="15" text="ثم إنكم بعد ذلك لميتون" />
-		<aya index="16" text="ثم إنكم يوم القيامة تبعثون" />
-		<aya index="17" text="ولقد خلقنا فوقكم سبع طرائق وما كنا عن الخلق غافلين" />
-		<aya index="18" text="وأنزلنا من السماء ماء بقدر فأسكناه في الأرض وإنا على ذهاب به لقادرون" />
-		<aya index="19" text="فأنشأنا لكم به جنات من نخيل وأعناب لكم فيها فواكه كثيرة ومنها تأكلون" />
-		<aya index="20" text="وشجرة تخرج من طور سيناء تنبت بالدهن وصبغ للآكلين" />
-		<aya index="21" text="وإن لكم في الأنعام لعبرة نسقيكم مما في بطونها ولكم فيها منافع كثيرة ومنها تأكلون" />
-		<aya index="22" text="وعليها وعلى الفلك تحملون" />
-		<aya index="23" text="ولقد أرسلنا نوحا إلى قومه فقال يا قوم اعبدوا الله ما لكم من إله غيره أفلا تتقون" />
-		<aya index="24" text="فقال الملأ الذين كفروا من قومه ما هذا إلا بشر مثلكم يريد أن يتفضل عليكم ولو شاء الله لأنزل ملائكة ما سمعنا بهذا في آبائنا الأولين" />
-		<aya index="25" text="إن هو إلا رجل به جنة فتربصوا به حتى حين" />
-		<aya index="26" text="قال رب انصرني بما كذبون" />
-		<aya index="27" text="فأوحينا إليه أن اصنع الفلك بأعيننا ووحينا فإذا جاء أمرنا وفار التنور فاسلك فيها من كل زوجين اثنين وأهلك إلا من سبق عليه القول منهم ولا تخاطبني في الذين ظلموا إنهم مغرقون" />
-		<aya index="28" text="فإذا استويت أنت ومن معك على الفلك فقل الحمد لله الذي نجانا من القوم الظالمين" />
-		<aya index="29" text="وقل رب أنزلني منزلا مباركا وأنت خير المنزلين" />
-		<aya index="30" text="إن في ذلك لآيات وإن كنا لمبتلين" />
-		<aya index="31" text="ثم أنشأنا من بعدهم قرنا آخرين" />
-		<aya index="32" text="فأرسلنا فيهم رسولا منهم أن اعبدوا الله ما لكم من إله غيره أفلا تتقون" />
-		<aya index="33" text="وقال الملأ من قومه الذين كفروا وكذبوا بلقاء الآخرة وأترفناهم في الحياة الدنيا ما هذا إلا بشر مثلكم يأكل مما تأكلون منه ويشرب مما تشربون" />
-		<aya index="34" text="ولئن أطعتم بشرا مثلكم إنكم إذا لخاسرون" />
-		<aya index="35" text="أيعدكم أنكم إذا متم وكنتم ترابا وعظاما أنكم مخرجون" />
-		<aya index="36" text="هيهات هيهات لما توعدون" />
-		<aya index="37" text="إن هي إلا حياتنا الدنيا نموت ونحيا وما نحن بمبعوثين" />
-		<aya index="38" text="إن هو إلا رجل افترى على الله كذبا وما نحن له بمؤمنين" />
-		<aya index="39" text="قال رب انصرني بما كذبون" />
-		<aya index="40" text="قال عما قليل ليصبحن نادمين" />
-		<aya index="41" text="فأخذتهم الصيحة بالحق فجعلناهم غثاء فبعدا للقوم الظالمين" />
-		<aya index="42" text="ثم أنشأنا من بعدهم قرونا آخرين" />
-		<aya index="43" text="ما تسبق من أمة أجلها وما يستأخرون" />
-		<aya index="44" text="ثم أرسلنا رسلنا تترى كل ما جاء أمة رسولها كذبوه فأتبعنا بعضهم بعضا وجعلناهم أحاديث فبعدا لقوم لا يؤمنون" />
-		<aya index="45" text="ثم أرسلنا موسى وأخاه هارون بآياتنا وسلطان مبين" />
-		<aya index="46" text="إلى فرعون وملئه فاستكبروا وكانوا قوما عالين" />
-		<aya index="47" text="فقالوا أنؤمن لبشرين مثلنا وقومهما لنا عابدون" />
-		<aya index="48" text="فكذبوهما فكانوا من المهلكين" />
-		<aya index="49" text="ولقد آتينا موسى الكتاب لعلهم يهتدون" />
-		<aya index="50" text="وجعلنا ابن مريم وأمه آية وآويناهما إلى ربوة ذات قرار ومعين" />
-		<aya index="51" text="يا أيها الرسل كلوا من الطيبات واعملوا صالحا إني بما تعملون عليم" />
-		<aya index="52" text="وإن هذه أمتكم أمة واحدة وأنا ربكم فاتقون" />
-		<aya index="53" text="فتقطعوا أمرهم بينهم زبرا كل حزب بما لديهم فرحون" />
-		<aya index="54" text="فذرهم في غمرتهم حتى حين" />
-		<aya index="55" text="أيحسبون أنما نمدهم به من مال وبنين" />
-		<aya index="56" text="نسارع لهم في الخيرات بل لا يشعرون" />
-		<aya index="57" text="إن الذين هم من خشية ربهم مشفقون" />
-		<aya index="58" text="والذين هم بآيات ربهم يؤمنون" />
-		<aya index="59" text="والذين هم بربهم لا يشركون" />
-		<aya index="60" text="والذين يؤتون ما آتوا وقلوبهم وجلة أنهم إلى ربهم راجعون" />
-		<aya index="61" text="أولئك يسارعون في الخيرات وهم لها سابقون" />
-		<aya index="62" text="ولا نكلف نفسا إلا وسعها ولدينا كتاب ينطق بالحق وهم لا يظلمون" />
-		<aya index="63" text="بل قلوبهم في غمرة من هذا ولهم أعمال من دون ذلك هم لها عاملون" />
-		<aya index="64" text="حتى إذا أخذنا مترفيهم بالعذاب إذا هم يجأرون" />
-		<aya index="65" text="لا تجأروا اليوم إنكم منا لا تنصرون" />
-		<aya index="66" text="قد كانت آياتي تتلى عليكم فكنتم على أعقابكم تنكصون" />
-		<aya index="67" text="مستكبرين به سامرا تهجرون" />
-		<aya index="68" text="أفلم يدبروا القول أم جاءهم ما لم يأت آباءهم الأولين" />
-		<aya index="69" text="أم لم يعرفوا رسولهم فهم له منكرون" />
-		<aya index="70" text="أم يقولون به جنة بل جاءهم بالحق وأكثرهم للحق كارهون" />
-		<aya index="71" text="ولو اتبع الحق أهواءهم لفسدت السماوات والأرض ومن فيهن بل أتيناهم بذكرهم فهم عن ذكرهم معرضون" />
-		<aya index="72" text="أم تسألهم خرجا فخراج ربك خير وهو خير الرازقين" />
-		<aya index="73" text="وإنك لتدعوهم إلى صراط مستقيم" />
-		<aya index="74" text="وإن الذين لا يؤمنون بالآخرة عن الصراط لناكبون" />
-		<aya index="75" text="ولو رحمناهم وكشفنا ما بهم من ضر للجوا في طغيانهم يعمهون" />
-		<aya index="76" text="ولقد أخذناهم بالعذاب فما استكانوا لربهم وما يتضرعون" />
-		<aya index="77" text="حتى إذا فتحنا عليهم بابا ذا عذاب شديد إذا هم فيه مبلسون" />
-		<aya index="78" text="وهو الذي أنشأ لكم السمع والأبصار والأفئدة قليلا ما تشكرون" />
-		<aya index="79" text="وهو الذي ذرأكم في الأرض وإليه تحشرون" />
-		<aya index="80" text="وهو الذي يحيي ويميت وله اختلاف الليل والنهار أفلا تعقلون" />
-		<aya index="81" text="بل قالوا مثل ما قال الأولون" />
-		<aya index="82" text="قالوا أإذا متنا وكنا ترابا وعظاما أإنا لمبعوثون" />
-		<aya index="83" text="لقد وعدنا نحن وآباؤنا هذا من قبل إن هذا إلا أساطير الأولين" />
-		<aya index="84" text="قل لمن الأرض ومن فيها إن كنتم تعلمون" />
-		<aya index="85" text="سيقولون لله قل أفلا تذكرون" />
-		<aya index="86" text="قل من رب السماوات السبع ورب العرش العظيم" />
-		<aya index="87" text="سيقولون لله قل أفلا تتقون" />
-		<aya index="88" text="قل من بيده ملكوت كل شيء وهو يجير ولا يجار عليه إن كنتم تعلمون" />
-		<aya index="89" text="سيقولون لله قل فأنى تسحرون" />
-		<aya index="90" text="بل أتيناهم بالحق وإنهم لكاذبون" />
-		<aya index="91" text="ما اتخذ الله من ولد وما كان معه من إله إذا لذهب كل إله بما خلق ولعلا بعضهم على بعض سبحان الله عما يصفون" />
-		<aya index="92" text="عالم الغيب والشهادة فتعالى عما يشركون" />
-		<aya index="93" text="قل رب إما تريني ما يوعدون" />
-		<aya index="94" text="رب فلا تجعلني في القوم الظالمين" />
-		<aya index="95" text="وإنا على أن نريك ما نعدهم لقادرون" />
-		<aya index="96" text="ادفع بالتي هي أحسن السيئة نحن أعلم بما يصفون" />
-		<aya index="97" text="وقل رب أعوذ بك من همزات الشياطين" />
-		<aya index="98" text="وأعوذ بك رب أن يحضرون" />
-		<aya index="99" text="حتى إذا جاء أحدهم الموت قال رب ارجعون" />
-		<aya index="100" text="لعلي أعمل صالحا فيما تركت كلا إنها كلمة هو قائلها ومن ورائهم برزخ إلى يوم يبعثون" />
-		<aya index="101" text="فإذا نفخ في الصور فلا أنساب بينهم يومئذ ولا يتساءلون" />
-		<aya index="102" text="فمن ثقلت موازينه فأولئك هم المفلحون" />
-		<aya index="103" text="ومن خفت موازينه فأولئك الذين خسروا أنفسهم في جهنم خالدون" />
-		<aya index="104" text="تلفح وجوههم النار وهم فيها كالحون" />
-		<aya index="105" text="ألم تكن آياتي تتلى عليكم فكنتم بها تكذبون" />
-		<aya index="106" text="قالوا ربنا غلبت علينا شقوتنا وكنا قوما ضالين" />
-		<aya index="107" text="ربنا أخرجنا منها فإن عدنا فإنا ظالمون" />
-		<aya index="108" text="قال اخسئوا فيها ولا تكلمون" />
-		<aya index="109" text="إنه كان فريق من عبادي يقولون ربنا آمنا فاغفر لنا وارحمنا وأنت خير الراحمين" />
-		<aya index="110" text="فاتخذتموهم سخريا حتى أنسوكم ذكري وكنتم منهم تضحكون" />
-		<aya index="111" text="إني جزيتهم اليوم بما صبروا أنهم هم الفائزون" />
-		<aya index="112" text="قال كم لبثتم في الأرض عدد سنين" />
-		<aya index="113" text="قالوا لبثنا يوما أو بعض يوم فاسأل العادين" />
-		<aya index="114" text="قال إن لبثتم إلا قليلا لو أنكم كنتم تعلمون" />
-		<aya index="115" text="أفحسبتم أنما خلقناكم عبثا وأنكم إلينا لا ترجعون" />
-		<aya index="116" text="فتعالى الله الملك الحق لا إله إلا هو رب العرش الكريم" />
-		<aya index="117" text="ومن يدع مع الله إلها آخر لا برهان له به فإنما حسابه عند ربه إنه لا يفلح الكافرون" />
-		<aya index="118" text="وقل رب اغفر وارحم وأنت خير الراحمين" />
-	</sura>
-	<sura index="24" name="النور">
-		<aya index="1" text="سورة أنزلناها وفرضناها وأنزلنا فيها آيات بينات لعلكم تذكرون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الزانية والزاني فاجلدوا كل واحد منهما مائة جلدة ولا تأخذكم بهما رأفة في دين الله إن كنتم تؤمنون بالله واليوم الآخر وليشهد عذابهما طائفة من المؤمنين" />
-		<aya index="3" text="الزاني لا ينكح إلا زانية أو مشركة والزانية لا ينكحها إلا زان أو مشرك وحرم ذلك على المؤمنين" />
-		<aya index="4" text="والذين يرمون المحصنات ثم لم يأتوا بأربعة شهداء فاجلدوهم ثمانين جلدة ولا تقبلوا لهم شهادة أبدا وأولئك هم الفاسقون" />
-		<aya index="5" text="إلا الذين تابوا من بعد ذلك وأصلحوا فإن الله غفور رحيم" />
-		<aya index="6" text="والذين يرمون أزواجهم ولم يكن لهم شهداء إلا أنفسهم فشهادة أحدهم أربع شهادات بالله إنه لمن الصادقين" />
-		<aya index="7" text="والخامسة أن لعنت الله عليه إن كان من الكاذبين" />
-		<aya index="8" text="ويدرأ عنها العذاب أن تشهد أربع شهادات بالله إنه لمن الكاذبين" />
-		<aya index="9" text="والخامسة أن غضب الله عليها إن كان من الصادقين" />
-		<aya index="10" text="ولولا فضل الله عليكم ورحمته وأن الله تواب حكيم" />
-		<aya index="11" text="إن الذين جاءوا بالإفك عصبة منكم لا تحسبوه شرا لكم بل هو خير لكم لكل امرئ منهم ما اكتسب من الإثم والذي تولى كبره منهم له عذاب عظيم" />
-		<aya index="12" text="لولا إذ سمعتموه ظن المؤمنون والمؤمنات بأنفسهم خيرا وقالوا هذا إفك مبين" />
-		<aya index="13" text="لولا جاءوا عليه بأربعة شهداء فإذ لم يأتوا بالشهداء فأولئك عند الله هم الكاذبون" />
-		<aya index="14" text="ولولا فضل الله عليكم ورحمته في الدنيا والآخرة لمسكم في ما أفضتم فيه عذاب عظيم" />
-		<aya index="15" text="إذ تلقونه بألسنتكم وتقولون بأفواهكم ما ليس لكم به علم وتحسبونه هينا وهو عند الله عظيم" />
-		<aya index="16" text="ولولا إذ سمعتموه قلتم ما يكون لنا أن نتكلم بهذا سبحانك هذا بهتان عظيم" />
-		<aya index="17" text="يعظكم الله أن تعودوا لمثله أبدا إن كنتم مؤمنين" />
-		<aya index="18" text="ويبين الله لكم الآيات والله عليم حكيم" />
-		<aya index="19" text="إن الذين يحبون أن تشيع الفاحشة في الذين آمنوا لهم عذاب أليم في الدنيا والآخرة والله يعلم وأنتم لا تعلمون" />
-		<aya index="20" text="ولولا فضل الله عليكم ورحمته وأن الله رءوف رحيم" />
-		<aya index="21" text="يا أيها الذين آمنوا لا تتبعوا خطوات الشيطان ومن يتبع خطوات الشيطان فإنه يأمر بالفحشاء والمنكر ولولا فضل الله عليكم ورحمته ما زكى منكم من أحد أبدا ولكن الله يزكي من يشاء والله سميع عليم" />
-		<aya index="22" text="ولا يأتل أولو الفضل منكم والسعة أن يؤتوا أولي القربى والمساكين والمهاجرين في سبيل الله وليعفوا وليصفحوا ألا تحبون أن يغفر الله لكم والله غفور رحيم" />
-		<aya index="23" text="إن الذين يرمون المحصنات الغافلات المؤمنات لعنوا في الدنيا والآخرة ولهم عذاب عظيم" />
-		<aya index="24" text="يوم تشهد عليهم ألسنتهم وأيديهم وأرجلهم بما كانوا يعملون" />
-		<aya index="25" text="يومئذ يوفيهم الله دينهم الحق ويعلمون أن الله هو الحق المبين" />
-		<aya index="26" text="الخبيثات للخبيثين والخبيثون للخبيثات والطيبات للطيبين والطيبون للطيبات أولئك مبرءون مما يقولون لهم مغفرة ورزق كريم" />
-		<aya index="27" text="يا أيها الذين آمنوا لا تدخلوا بيوتا غير بيوتكم حتى تستأنسوا وتسلموا على أهلها ذلكم خير لكم لعلكم تذكرون" />
-		<aya index="28" text="فإن لم تجدوا فيها أحدا فلا تدخلوها حتى يؤذن لكم وإن قيل لكم ارجعوا فارجعوا هو أزكى لكم والله بما تعملون عليم" />
-		<aya index="29" text="ليس عليكم جناح أن تدخلوا بيوتا غير مسكونة فيها متاع لكم والله يعلم ما تبدون وما تكتمون" />
-		<aya index="30" text="قل للمؤمنين يغضوا من أبصارهم ويحفظوا فروجهم ذلك أزكى لهم إن الله خبير بما يصنعون" />
-		<aya index="31" text="وقل للمؤمنات يغضضن من أبصارهن ويحفظن فروجهن ولا يبدين زينتهن إلا ما ظهر منها وليضربن بخمرهن على جيوبهن ولا يبدين زينتهن إلا لبعولتهن أو آبائهن أو آباء بعولتهن أو أبنائهن أو أبناء بعولتهن أو إخوانهن أو بني إخوانهن أو بني أخواتهن أو نسائهن أو ما ملكت أيمانهن أو التابعين غير أولي الإربة من الرجال أو الطفل الذين لم يظهروا على عورات النساء ولا يضربن بأرجلهن ليعلم ما يخفين من زينتهن وتوبوا إلى الله جميعا أيه المؤمنون لعلكم تفلحون" />
-		<aya index="32" text="وأنكحوا الأيامى منكم والصالحين من عبادكم وإمائكم إن يكونوا فقراء يغنهم الله من فضله والله واسع عليم" />
-		<aya index="33" text="وليستعفف الذين لا يجدون نكاحا حتى يغنيهم الله من فضله والذين يبتغون الكتاب مما ملكت أيمانكم فكاتبوهم إن علمتم فيهم خيرا وآتوهم من مال الله الذي آتاكم ولا تكرهوا فتياتكم على البغاء إن أردن تحصنا لتبتغوا عرض الحياة الدنيا ومن يكرههن فإن الله من بعد إكراههن غفور رحيم" />
-		<aya index="34" text="ولقد أنزلنا إليكم آيات مبينات ومثلا من الذين خلوا من قبلكم وموعظة للمتقين" />
-		<aya index="35" text="الله نور السماوات والأرض مثل نوره كمشكاة فيها مصباح المصباح في زجاجة الزجاجة كأنها كوكب دري يوقد من شجرة مباركة زيتونة لا شرقية ولا غربية يكاد زيتها يضيء ولو لم تمسسه نار نور على نور يهدي الله لنوره من يشاء ويضرب الله الأمثال للناس والله بكل شيء عليم" />
-		<aya index="36" text="في بيوت أذن الله أن ترفع ويذكر فيها اسمه يسبح له فيها بالغدو والآصال" />
-		<aya index="37" text="رجال لا تلهيهم تجارة ولا بيع عن ذكر الله وإقام الصلاة وإيتاء الزكاة يخافون يوما تتقلب فيه القلوب والأبصار" />
-		<aya index="38" text="ليجزيهم الله أحسن ما عملوا ويزيدهم من فضله والله يرزق من يشاء بغير حساب" />
-		<aya index="39" text="والذين كفروا أعمالهم كسراب بقيعة يحسبه الظمآن ماء حتى إذا جاءه لم يجده شيئا ووجد الله عنده فوفاه حسابه والله سريع الحساب" />
-		<aya index="40" text="أو كظلمات في بحر لجي يغشاه موج من فوقه موج من فوقه سحاب ظلمات بعضها فوق بعض إذا أخرج يده لم يكد يراها ومن لم يجعل الله له نورا فما له من نور" />
-		<aya index="41" text="ألم تر أن الله يسبح له من في السماوات والأرض والطير صافات كل قد علم صلاته وتسبيحه والله عليم بما يفعلون" />
-		<aya index="42" text="ولله ملك السماوات والأرض وإلى الله المصير" />
-		<aya index="43" text="ألم تر أن الله يزجي سحابا ثم يؤلف بينه ثم يجعله ركاما فترى الودق يخرج من خلاله وينزل من السماء من جبال فيها من برد فيصيب به من يشاء ويصرفه عن من يشاء يكاد سنا برقه يذهب بالأبصار" />
-		<aya index="44" text="يقلب الله الليل والنهار إن في ذلك لعبرة لأولي الأبصار" />
-		<aya index="45" text="والله خلق كل دابة من ماء فمنهم من يمشي على بطنه ومنهم من يمشي على رجلين ومنهم من يمشي على أربع يخلق الله ما يشاء إن الله على كل شيء قدير" />
-		<aya index="46" text="لقد أنزلنا آيات مبينات والله يهدي من يشاء إلى صراط مستقيم" />
-		<aya index="47" text="ويقولون آمنا بالله وبالرسول وأطعنا ثم يتولى فريق منهم من بعد ذلك وما أولئك بالمؤمنين" />
-		<aya index="48" text="وإذا دعوا إلى الله ورسوله ليحكم بينهم إذا فريق منهم معرضون" />
-		<aya index="49" text="وإن يكن لهم الحق يأتوا إليه مذعنين" />
-		<aya index="50" text="أفي قلوبهم مرض أم ارتابوا أم يخافون أن يحيف الله عليهم ورسوله بل أولئك هم الظالمون" />
-		<aya index="51" text="إنما كان قول المؤمنين إذا دعوا إلى الله ورسوله ليحكم بينهم أن يقولوا سمعنا وأطعنا وأولئك هم المفلحون" />
-		<aya index="52" text="ومن يطع الله ورسوله ويخش الله ويتقه فأولئك هم الفائزون" />
-		<aya index="53" text="وأقسموا بالله جهد أيمانهم لئن أمرتهم ليخرجن قل لا تقسموا طاعة معروفة إن الله خبير بما تعملون" />
-		<aya index="54" text="قل أطيعوا الله وأطيعوا الرسول فإن تولوا فإنما عليه ما حمل وعليكم ما حملتم وإن تطيعوه تهتدوا وما على الرسول إلا البلاغ المبين" />
-		<aya index="55" text="وعد الله الذين آمنوا منكم وعملوا الصالحات ليستخلفنهم في الأرض كما استخلف الذين من قبلهم وليمكنن لهم دينهم الذي ارتضى لهم وليبدلنهم من بعد خوفهم أمنا يعبدونني لا يشركون بي شيئا ومن كفر بعد ذلك فأولئك هم الفاسقون" />
-		<aya index="56" text="وأقيموا الصلاة وآتوا الزكاة وأطيعوا الرسول لعلكم ترحمون" />
-		<aya index="57" text="لا تحسبن الذين كفروا معجزين في الأرض ومأواهم النار ولبئس المصير" />
-		<aya index="58" text="يا أيها الذين آمنوا ليستأذنكم الذين ملكت أيمانكم والذين لم يبلغوا الحلم منكم ثلاث مرات من قبل صلاة الفجر وحين تضعون ثيابكم من الظهيرة ومن بعد صلاة العشاء ثلاث عورات لكم ليس عليكم ولا عليهم جناح بعدهن طوافون عليكم بعضكم على بعض كذلك يبين الله لكم الآيات والله عليم حكيم" />
-		<aya index="59" text="وإذا بلغ الأطفال منكم الحلم فليستأذنوا كما استأذن الذين من قبلهم كذلك يبين الله لكم آياته والله عليم حكيم" />
-		<aya index="60" text="والقواعد من النساء اللاتي لا يرجون نكاحا فليس عليهن جناح أن يضعن ثيابهن غير متبرجات بزينة وأن يستعففن خير لهن والله سميع عليم" />
-		<aya index="61" text="ليس على الأعمى حرج ولا على الأعرج حرج ولا على المريض حرج ولا على أنفسكم أن تأكلوا من بيوتكم أو بيوت آبائكم أو بيوت أمهاتكم أو بيوت إخوانكم أو بيوت أخواتكم أو بيوت أعمامكم أو بيوت عماتكم أو بيوت أخوالكم أو بيوت خالاتكم أو ما ملكتم مفاتحه أو صديقكم ليس عليكم جناح أن تأكلوا جميعا أو أشتاتا فإذا دخلتم بيوتا فسلموا على أنفسكم تحية من عند الله مباركة طيبة كذلك يبين الله لكم الآيات لعلكم تعقلون" />
-		<aya index="62" text="إنما المؤمنون الذين آمنوا بالله ورسوله وإذا كانوا معه على أمر جامع لم يذهبوا حتى يستأذنوه إن الذين يستأذنونك أولئك الذين يؤمنون بالله ورسوله فإذا استأذنوك لبعض شأنهم فأذن لمن شئت منهم واستغفر لهم الله إن الله غفور رحيم" />
-		<aya index="63" text="لا تجعلوا دعاء الرسول بينكم كدعاء بعضكم بعضا قد يعلم الله الذين يتسللون منكم لواذا فليحذر الذين يخالفون عن أمره أن تصيبهم فتنة أو يصيبهم عذاب أليم" />
-		<aya index="64" text="ألا إن لله ما في السماوات والأرض قد يعلم ما أنتم عليه ويوم يرجعون إليه فينبئهم بما عملوا والله بكل شيء عليم" />
-	</sura>
-	<sura index="25" name="الفرقان">
-		<aya index="1" text="تبارك الذي نزل الفرقان على عبده ليكون للعالمين نذيرا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الذي له ملك السماوات والأرض ولم يتخذ ولدا ولم يكن له شريك في الملك وخلق كل شيء فقدره تقديرا" />
-		<aya index="3" text="واتخذوا من دونه آلهة لا يخلقون شيئا وهم يخلقون ولا يملكون لأنفسهم ضرا ولا نفعا ولا يملكون موتا ولا حياة ولا نشورا" />
-		<aya index="4" text="وقال الذين كفروا إن هذا إلا إفك افتراه وأعانه عليه قوم آخرون فقد جاءوا ظلما وزورا" />
-		<aya index="5" text="وقالوا أساطير الأولين اكتتبها فهي تملى عليه بكرة وأصيلا" />
-		<aya index="6" text="قل أنزله الذي يعلم السر في السماوات والأرض إنه كان غفورا رحيما" />
-		<aya index="7" text="وقالوا مال هذا الرسول يأكل الطعام ويمشي في الأسواق لولا أنزل إليه ملك فيكون معه نذيرا" />
-		<aya index="8" text="أو يلقى إليه كنز أو تكون له جنة يأكل منها وقال الظالمون إن تتبعون إلا رجلا مسحورا" />
-		<aya index="9" text="انظر كيف ضربوا لك الأمثال فضلوا فلا يستطيعون سبيلا" />
-		<aya index="10" text="تبارك الذي إن شاء جعل لك خيرا من ذلك جنات تجري من تحتها الأنهار ويجعل لك قصورا" />
-		<aya index="11" text="بل كذبوا بالساعة وأعتدنا لمن كذب بالساعة سعيرا" />
-		<aya index="12" text="إذا رأتهم من مكان بعيد سمعوا لها تغيظا وزفيرا" />
-		<aya index="13" text="وإذا ألقوا منها مكانا ضيقا مقرنين دعوا هنالك ثبورا" />
-		<aya index="14" text="لا تدعوا اليوم ثبورا واحدا وادعوا ثبورا كثيرا" />
-		<aya index="15" text="قل أذلك خير أم جنة الخلد التي وعد المتقون كانت لهم جزاء ومصيرا" />
-		<aya index="16" text="لهم فيها ما يشاءون خالدين كان على ربك وعدا مسئولا" />
-		<aya index="17" text="ويوم يحشرهم وما يعبدون من دون الله فيقول أأنتم أضللتم عبادي هؤلاء أم هم ضلوا السبيل" />
-		<aya index="18" text="قالوا سبحانك ما كان ينبغي لنا أن نتخذ من دونك من أولياء ولكن متعتهم وآباءهم حتى نسوا الذكر وكانوا قوما بورا" />
-		<aya index="19" text="فقد كذبوكم بما تقولون فما تستطيعون صرفا ولا نصرا ومن يظلم منكم نذقه عذابا كبيرا" />
-		<aya index="20" text="وما أرسلنا قبلك من المرسلين إلا إنهم ليأكلون الطعام ويمشون في الأسواق وجعلنا بعضكم لبعض فتنة أتصبرون وكان ربك بصيرا" />
-		<aya index="21" text="وقال الذين لا يرجون لقاءنا لولا أنزل علينا الملائكة أو نرى ربنا لقد استكبروا في أنفسهم وعتوا عتوا كبيرا" />
-		<aya index="22" text="يوم يرون الملائكة لا بشرى يومئذ للمجرمين ويقولون حجرا محجورا" />
-		<aya index="23" text="وقدمنا إلى ما عملوا من عمل فجعلناه هباء منثورا" />
-		<aya index="24" text="أصحاب الجنة يومئذ خير مستقرا وأحسن مقيلا" />
-		<aya index="25" text="ويوم تشقق السماء بالغمام ونزل الملائكة تنزيلا" />
-		<aya index="26" text="الملك يومئذ الحق للرحمن وكان يوما على الكافرين عسيرا" />
-		<aya index="27" text="ويوم يعض الظالم على يديه يقول يا ليتني اتخذت مع الرسول سبيلا" />
-		<aya index="28" text="يا ويلتى ليتني لم أتخذ فلانا خليلا" />
-		<aya index="29" text="لقد أضلني عن الذكر بعد إذ جاءني وكان الشيطان للإنسان خذولا" />
-		<aya index="30" text="وقال الرسول يا رب إن قومي اتخذوا هذا القرآن مهجورا" />
-		<aya index="31" text="وكذلك جعلنا لكل نبي عدوا من المجرمين وكفى بربك هاديا ونصيرا" />
-		<aya index="32" text="وقال الذين كفروا لولا نزل عليه القرآن جملة واحدة كذلك لنثبت به فؤادك ورتلناه ترتيلا" />
-		<aya index="33" text="ولا يأتونك بمثل إلا جئناك بالحق وأحسن تفسيرا" />
-		<aya index="34" text="الذين يحشرون على وجوههم إلى جهنم أولئك شر مكانا وأضل سبيلا" />
-		<aya index="35" text="ولقد آتينا موسى الكتاب وجعلنا معه أخاه هارون وزيرا" />
-		<aya index="36" text="فقلنا اذهبا إلى القوم الذين كذبوا بآياتنا فدمرناهم تدميرا" />
-		<aya index="37" text="وقوم نوح لما كذبوا الرسل أغرقناهم وجعلناهم للناس آية وأعتدنا للظالمين عذابا أليما" />
-		<aya index="38" text="وعادا وثمود وأصحاب الرس وقرونا بين ذلك كثيرا" />
-		<aya index="39" text="وكلا ضربنا له الأمثال وكلا تبرنا تتبيرا" />
-		<aya index="40" text="ولقد أتوا على القرية التي أمطرت مطر السوء أفلم يكونوا يرونها بل كانوا لا يرجون نشورا" />
-		<aya index="41" text="وإذا رأوك إن يتخذونك إلا هزوا أهذا الذي بعث الله رسولا" />
-		<aya index="42" text="إن كاد ليضلنا عن آلهتنا لولا أن صبرنا عليها وسوف يعلمون حين يرون العذاب من أضل سبيلا" />
-		<aya index="43" text="أرأيت من اتخذ إلهه هواه أفأنت تكون عليه وكيلا" />
-		<aya index="44" text="أم تحسب أن أكثرهم يسمعون أو يعقلون إن هم إلا كالأنعام بل هم أضل سبيلا" />
-		<aya index="45" text="ألم تر إلى ربك كيف مد الظل ولو شاء لجعله ساكنا ثم جعلنا الشمس عليه دليلا" />
-		<aya index="46" text="ثم قبضناه إلينا قبضا يسيرا" />
-		<aya index="47" text="وهو الذي جعل لكم الليل لباسا والنوم سباتا وجعل النهار نشورا" />
-		<aya index="48" text="وهو الذي أرسل الرياح بشرا بين يدي رحمته وأنزلنا من السماء ماء طهورا" />
-		<aya index="49" text="لنحيي به بلدة ميتا ونسقيه مما خلقنا أنعاما وأناسي كثيرا" />
-		<aya index="50" text="ولقد صرفناه بينهم ليذكروا فأبى أكثر الناس إلا كفورا" />
-		<aya index="51" text="ولو شئنا لبعثنا في كل قرية نذيرا" />
-		<aya index="52" text="فلا تطع الكافرين وجاهدهم به جهادا كبيرا" />
-		<aya index="53" text="وهو الذي مرج البحرين هذا عذب فرات وهذا ملح أجاج وجعل بينهما برزخا وحجرا محجورا" />
-		<aya index="54" text="وهو الذي خلق من الماء بشرا فجعله نسبا وصهرا وكان ربك قديرا" />
-		<aya index="55" text="ويعبدون من دون الله ما لا ينفعهم ولا يضرهم وكان الكافر على ربه ظهيرا" />
-		<aya index="56" text="وما أرسلناك إلا مبشرا ونذيرا" />
-		<aya index="57" text="قل ما أسألكم عليه من أجر إلا من شاء أن يتخذ إلى ربه سبيلا" />
-		<aya index="58" text="وتوكل على الحي الذي لا يموت وسبح بحمده وكفى به بذنوب عباده خبيرا" />
-		<aya index="59" text="الذي خلق السماوات والأرض وما بينهما في ستة أيام ثم استوى على العرش الرحمن فاسأل به خبيرا" />
-		<aya index="60" text="وإذا قيل لهم اسجدوا للرحمن قالوا وما الرحمن أنسجد لما تأمرنا وزادهم نفورا" />
-		<aya index="61" text="تبارك الذي جعل في السماء بروجا وجعل فيها سراجا وقمرا منيرا" />
-		<aya index="62" text="وهو الذي جعل الليل والنهار خلفة لمن أراد أن يذكر أو أراد شكورا" />
-		<aya index="63" text="وعباد الرحمن الذين يمشون على الأرض هونا وإذا خاطبهم الجاهلون قالوا سلاما" />
-		<aya index="64" text="والذين يبيتون لربهم سجدا وقياما" />
-		<aya index="65" text="والذين يقولون ربنا اصرف عنا عذاب جهنم إن عذابها كان غراما" />
-		<aya index="66" text="إنها ساءت مستقرا ومقاما" />
-		<aya index="67" text="والذين إذا أنفقوا لم يسرفوا ولم يقتروا وكان بين ذلك قواما" />
-		<aya index="68" text="والذين لا يدعون مع الله إلها آخر ولا يقتلون النفس التي حرم الله إلا بالحق ولا يزنون ومن يفعل ذلك يلق أثاما" />
-		<aya index="69" text="يضاعف له العذاب يوم القيامة ويخلد فيه مهانا" />
-		<aya index="70" text="إلا من تاب وآمن وعمل عملا صالحا فأولئك يبدل الله سيئاتهم حسنات وكان الله غفورا رحيما" />
-		<aya index="71" text="ومن تاب وعمل صالحا فإنه يتوب إلى الله متابا" />
-		<aya index="72" text="والذين لا يشهدون الزور وإذا مروا باللغو مروا كراما" />
-		<aya index="73" text="والذين إذا ذكروا بآيات ربهم لم يخروا عليها صما وعميانا" />
-		<aya index="74" text="والذين يقولون ربنا هب لنا من أزواجنا وذرياتنا قرة أعين واجعلنا للمتقين إماما" />
-		<aya index="75" text="أولئك يجزون الغرفة بما صبروا ويلقون فيها تحية وسلاما" />
-		<aya index="76" text="خالدين فيها حسنت مستقرا ومقاما" />
-		<aya index="77" text="قل ما يعبأ بكم ربي لولا دعاؤكم فقد كذبتم فسوف يكون لزاما" />
-	</sura>
-	<sura index="26" name="الشعراء">
-		<aya index="1" text="طسم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="تلك آيات الكتاب المبين" />
-		<aya index="3" text="لعلك باخع نفسك ألا يكونوا مؤمنين" />
-		<aya index="4" text="إن نشأ ننزل عليهم من السماء آية فظلت أعناقهم لها خاضعين" />
-		<aya index="5" text="وما يأتيهم من ذكر من الرحمن محدث إلا كانوا عنه معرضين" />
-		<aya index="6" text="فقد كذبوا فسيأتيهم أنباء ما كانوا به يستهزئون" />
-		<aya index="7" text="أولم يروا إلى الأرض كم أنبتنا فيها من كل زوج كريم" />
-		<aya index="8" text="إن في ذلك لآية وما كان أكثرهم مؤمنين" />
-		<aya index="9" text="وإن ربك لهو العزيز الرحيم" />
-		<aya index="10" text="وإذ نادى ربك موسى أن ائت القوم الظالمين" />
-		<aya index="11" text="قوم فرعون ألا يتقون" />
-		<aya index="12" text="قال رب إني أخاف أن يكذبون" />
-		<aya index="13" text="ويضيق صدري ولا ينطلق لساني فأرسل إلى هارون" />
-		<aya index="14" text="ولهم علي ذنب فأخاف أن يقتلون" />
-		<aya index="15" text="قال كلا فاذهبا بآياتنا إنا معكم مستمعون" />
-		<aya index="16" text="فأتيا فرعون فقولا إنا رسول رب العالمين" />
-		<aya index="17" text="أن أرسل معنا بني إسرائيل" />
-		<aya index="18" text="قال ألم نربك فينا وليدا ولبثت فينا من عمرك سنين" />
-		<aya index="19" text="وفعلت فعلتك التي فعلت وأنت من الكافرين" />
-		<aya index="20" text="قال فعلتها إذا وأنا من الضالين" />
-		<aya index="21" text="ففررت منكم لما خفتكم فوهب لي ربي حكما وجعلني من المرسلين" />
-		<aya index="22" text="وتلك نعمة تمنها علي أن عبدت بني إسرائيل" />
-		<aya index="23" text="قال فرعون وما رب العالمين" />
-		<aya index="24" text="قال رب السماوات والأرض وما بينهما إن كنتم موقنين" />
-		<aya index="25" text="قال لمن حوله ألا تستمعون" />
-		<aya index="26" text="قال ربكم ورب آبائكم الأولين" />
-		<aya index="27" text="قال إن رسولكم الذي أرسل إليكم لمجنون" />
-		<aya index="28" text="قال رب المشرق والمغرب وما بينهما إن كنتم تعقلون" />
-		<aya index="29" text="قال لئن اتخذت إلها غيري لأجعلنك من المسجونين" />
-		<aya index="30" text="قال أولو جئتك بشيء مبين" />
-		<aya index="31" text="قال فأت به إن كنت من الصادقين" />
-		<aya index="32" text="فألقى عصاه فإذا هي ثعبان مبين" />
-		<aya index="33" text="ونزع يده فإذا هي بيضاء للناظرين" />
-		<aya index="34" text="قال للملإ حوله إن هذا لساحر عليم" />
-		<aya index="35" text="يريد أن يخرجكم من أرضكم بسحره فماذا تأمرون" />
-		<aya index="36" text="قالوا أرجه وأخاه وابعث في المدائن حاشرين" />
-		<aya index="37" text="يأتوك بكل سحار عليم" />
-		<aya index="38" text="فجمع السحرة لميقات يوم معلوم" />
-		<aya index="39" text="وقيل للناس هل أنتم مجتمعون" />
-		<aya index="40" text="لعلنا نتبع السحرة إن كانوا هم الغالبين" />
-		<aya index="41" text="فلما جاء السحرة قالوا لفرعون أئن لنا لأجرا إن كنا نحن الغالبين" />
-		<aya index="42" text="قال نعم وإنكم إذا لمن المقربين" />
-		<aya index="43" text="قال لهم موسى ألقوا ما أنتم ملقون" />
-		<aya index="44" text="فألقوا حبالهم وعصيهم وقالوا بعزة فرعون إنا لنحن الغالبون" />
-		<aya index="45" text="فألقى موسى عصاه فإذا هي تلقف ما يأفكون" />
-		<aya index="46" text="فألقي السحرة ساجدين" />
-		<aya index="47" text="قالوا آمنا برب العالمين" />
-		<aya index="48" text="رب موسى وهارون" />
-		<aya index="49" text="قال آمنتم له قبل أن آذن لكم إنه لكبيركم الذي علمكم السحر فلسوف تعلمون لأقطعن أيديكم وأرجلكم من خلاف ولأصلبنكم أجمعين" />
-		<aya index="50" text="قالوا لا ضير إنا إلى ربنا منقلبون" />
-		<aya index="51" text="إنا نطمع أن يغفر لنا ربنا خطايانا أن كنا أول المؤمنين" />
-		<aya index="52" text="وأوحينا إلى موسى أن أسر بعبادي إنكم متبعون" />
-		<aya index="53" text="فأرسل فرعون في المدائن حاشرين" />
-		<aya index="54" text="إن هؤلاء لشرذمة قليلون" />
-		<aya index="55" text="وإنهم لنا لغائظون" />
-		<aya index="56" text="وإنا لجميع حاذرون" />
-		<aya index="57" text="فأخرجناهم من جنات وعيون" />
-		<aya index="58" text="وكنوز ومقام كريم" />
-		<aya index="59" text="كذلك وأورثناها بني إسرائيل" />
-		<aya index="60" text="فأتبعوهم مشرقين" />
-		<aya index="61" text="فلما تراءى الجمعان قال أصحاب موسى إنا لمدركون" />
-		<aya index="62" text="قال كلا إن معي ربي سيهدين" />
-		<aya index="63" text="فأوحينا إلى موسى أن اضرب بعصاك البحر فانفلق فكان كل فرق كالطود العظيم" />
-		<aya index="64" text="وأزلفنا ثم الآخرين" />
-		<aya index="65" text="وأنجينا موسى ومن معه أجمعين" />
-		<aya index="66" text="ثم أغرقنا الآخرين" />
-		<aya index="67" text="إن في ذلك لآية وما كان أكثرهم مؤمنين" />
-		<aya index="68" text="وإن ربك لهو العزيز الرحيم" />
-		<aya index="69" text="واتل عليهم نبأ إبراهيم" />
-		<aya index="70" text="إذ قال لأبيه وقومه ما تعبدون" />
-		<aya index="71" text="قالوا نعبد أصناما فنظل لها عاكفين" />
-		<aya index="72" text="قال هل يسمعونكم إذ تدعون" />
-		<aya index="73" text="أو ينفعونكم أو يضرون" />
-		<aya index="74" text="قالوا بل وجدنا آباءنا كذلك يفعلون" />
-		<aya index="75" text="قال أفرأيتم ما كنتم تعبدون" />
-		<aya index="76" text="أنتم وآباؤكم الأقدمون" />
-		<aya index="77" text="فإنهم عدو لي إلا رب العالمين" />
-		<aya index="78" text="الذي خلقني فهو يهدين" />
-		<aya index="79" text="والذي هو يطعمني ويسقين" />
-		<aya index="80" text="وإذا مرضت فهو يشفين" />
-		<aya index="81" text="والذي يميتني ثم يحيين" />
-		<aya index="82" text="والذي أطمع أن يغفر لي خطيئتي يوم الدين" />
-		<aya index="83" text="رب هب لي حكما وألحقني بالصالحين" />
-		<aya index="84" text="واجعل لي لسان صدق في الآخرين" />
-		<aya index="85" text="واجعلني من ورثة جنة النعيم" />
-		<aya index="86" text="واغفر لأبي إنه كان من الضالين" />
-		<aya index="87" text="ولا تخزني يوم يبعثون" />
-		<aya index="88" text="يوم لا ينفع مال ولا بنون" />
-		<aya index="89" text="إلا من أتى الله بقلب سليم" />
-		<aya index="90" text="وأزلفت الجنة للمتقين" />
-		<aya index="91" text="وبرزت الجحيم للغاوين" />
-		<aya index="92" text="وقيل لهم أين ما كنتم تعبدون" />
-		<aya index="93" text="من دون الله هل ينصرونكم أو ينتصرون" />
-		<aya index="94" text="فكبكبوا فيها هم والغاوون" />
-		<aya index="95" text="وجنود إبليس أجمعون" />
-		<aya index="96" text="قالوا وهم فيها يختصمون" />
-		<aya index="97" text="تالله إن كنا لفي ضلال مبين" />
-		<aya index="98" text="إذ نسويكم برب العالمين" />
-		<aya index="99" text="وما أضلنا إلا المجرمون" />
-		<aya index="100" text="فما لنا من شافعين" />
-		<aya index="101" text="ولا صديق حميم" />
-		<aya index="102" text="فلو أن لنا كرة فنكون من المؤمنين" />
-		<aya index="103" text="إن في ذلك لآية وما كان أكثرهم مؤمنين" />
-		<aya index="104" text="وإن ربك لهو العزيز الرحيم" />
-		<aya index="105" text="كذبت قوم نوح المرسلين" />
-		<aya index="106" text="إذ قال لهم أخوهم نوح ألا تتقون" />
-		<aya index="107" text="إني لكم رسول أمين" />
-		<aya index="108" text="فاتقوا الله وأطيعون" />
-		<aya index="109" text="وما أسألكم عليه من أجر إن أجري إلا على رب العالمين" />
-		<aya index="110" text="فاتقوا الله وأطيعون" />
-		<aya index="111" text="قالوا أنؤمن لك واتبعك الأرذلون" />
-		<aya index="112" text="قال وما علمي بما كانوا يعملون" />
-		<aya index="113" text="إن حسابهم إلا على ربي لو تشعرون" />
-		<aya index="114" text="وما أنا بطارد المؤمنين" />
-		<aya index="115" text="إن أنا إلا نذير مبين" />
-		<aya index="116" text="قالوا لئن لم تنته يا نوح لتكونن من المرجومين" />
-		<aya index="117" text="قال رب إن قومي كذبون" />
-		<aya index="118" text="فافتح بيني وبينهم فتحا ونجني ومن معي من المؤمنين" />
-		<aya index="119" text="فأنجيناه ومن معه في الفلك المشحون" />
-		<aya index="120" text="ثم أغرقنا بعد الباقين" />
-		<aya index="121" text="إن في ذلك لآية وما كان أكثرهم مؤمنين" />
-		<aya index="122" text="وإن ربك لهو العزيز الرحيم" />
-		<aya index="123" text="كذبت عاد المرسلين" />
-		<aya index="124" text="إذ قال لهم أخوهم هود ألا تتقون" />
-		<aya index="125" text="إني لكم رسول أمين" />
-		<aya index="126" text="فاتقوا الله وأطيعون" />
-		<aya index="127" text="وما أسألكم عليه من أجر إن أجري إلا على رب العالمين" />
-		<aya index="128" text="أتبنون بكل ريع آية تعبثون" />
-		<aya index="129" text="وتتخذون مصانع لعلكم تخلدون" />
-		<aya index="130" text="وإذا بطشتم بطشتم جبارين" />
-		<aya index="131" text="فاتقوا الله وأطيعون" />
-		<aya index="132" text="واتقوا الذي أمدكم بما تعلمون" />
-		<aya index="133" text="أمدكم بأنعام وبنين" />
-		<aya index="134" text="وجنات وعيون" />
-		<aya index="135" text="إني أخاف عليكم عذاب يوم عظيم" />
-		<aya index="136" text="قالوا سواء علينا أوعظت أم لم تكن من الواعظين" />
-		<aya index="137" text="إن هذا إلا خلق الأولين" />
-		<aya index="138" text="وما نحن بمعذبين" />
-		<aya index="139" text="فكذبوه فأهلكناهم إن في ذلك لآية وما كان أكثرهم مؤمنين" />
-		<aya index="140" text="وإن ربك لهو العزيز الرحيم" />
-		<aya index="141" text="كذبت ثمود المرسلين" />
-		<aya index="142" text="إذ قال لهم أخوهم صالح ألا تتقون" />
-		<aya index="143" text="إني لكم رسول أمين" />
-		<aya index="144" text="فاتقوا الله وأطيعون" />
-		<aya index="145" text="وما أسألكم عليه من أجر إن أجري إلا على رب العالمين" />
-		<aya index="146" text="أتتركون في ما هاهنا آمنين" />
-		<aya index="147" text="في جنات وعيون" />
-		<aya index="148" text="وزروع ونخل طلعها هضيم" />
-		<aya index="149" text="وتنحتون من الجبال بيوتا فارهين" />
-		<aya index="150" text="فاتقوا الله وأطيعون" />
-		<aya index="151" text="ولا تطيعوا أمر المسرفين" />
-		<aya index="152" text="الذين يفسدون في الأرض ولا يصلحون" />
-		<aya index="153" text="قالوا إنما أنت من المسحرين" />
-		<aya index="154" text="ما أنت إلا بشر مثلنا فأت بآية إن كنت من الصادقين" />
-		<aya index="155" text="قال هذه ناقة لها شرب ولكم شرب يوم معلوم" />
-		<aya index="156" text="ولا تمسوها بسوء فيأخذكم عذاب يوم عظيم" />
-		<aya index="157" text="فعقروها فأصبحوا نادمين" />
-		<aya index="158" text="فأخذهم العذاب إن في ذلك لآية وما كان أكثرهم مؤمنين" />
-		<aya index="159" text="وإن ربك لهو العزيز الرحيم" />
-		<aya index="160" text="كذبت قوم لوط المرسلين" />
-		<aya index="161" text="إذ قال لهم أخوهم لوط ألا تتقون" />
-		<aya index="162" text="إني لكم رسول أمين" />
-		<aya index="163" text="فاتقوا الله وأطيعون" />
-		<aya index="164" text="وما أسألكم عليه من أجر إن أجري إلا على رب العالمين" />
-		<aya index="165" text="أتأتون الذكران من العالمين" />
-		<aya index="166" text="وتذرون ما خلق لكم ربكم من أزواجكم بل أنتم قوم عادون" />
-		<aya index="167" text="قالوا لئن لم تنته يا لوط لتكونن من المخرجين" />
-		<aya index="168" text="قال إني لعملكم من القالين" />
-		<aya index="169" text="رب نجني وأهلي مما يعملون" />
-		<aya index="170" text="فنجيناه وأهله أجمعين" />
-		<aya index="171" text="إلا عجوزا في الغابرين" />
-		<aya index="172" text="ثم دمرنا الآخرين" />
-		<aya index="173" text="وأمطرنا عليهم مطرا فساء مطر المنذرين" />
-		<aya index="174" text="إن في ذلك لآية وما كان أكثرهم مؤمنين" />
-		<aya index="175" text="وإن ربك لهو العزيز الرحيم" />
-		<aya index="176" text="كذب أصحاب الأيكة المرسلين" />
-		<aya index="177" text="إذ قال لهم شعيب ألا تتقون" />
-		<aya index="178" text="إني لكم رسول أمين" />
-		<aya index="179" text="فاتقوا الله وأطيعون" />
-		<aya index="180" text="وما أسألكم عليه من أجر إن أجري إلا على رب العالمين" />
-		<aya index="181" text="أوفوا الكيل ولا تكونوا من المخسرين" />
-		<aya index="182" text="وزنوا بالقسطاس المستقيم" />
-		<aya index="183" text="ولا تبخسوا الناس أشياءهم ولا تعثوا في الأرض مفسدين" />
-		<aya index="184" text="واتقوا الذي خلقكم والجبلة الأولين" />
-		<aya index="185" text="قالوا إنما أنت من المسحرين" />
-		<aya index="186" text="وما أنت إلا بشر مثلنا وإن نظنك لمن الكاذبين" />
-		<aya index="187" text="فأسقط علينا كسفا من السماء إن كنت من الصادقين" />
-		<aya index="188" text="قال ربي أعلم بما تعملون" />
-		<aya index="189" text="فكذبوه فأخذهم عذاب يوم الظلة إنه كان عذاب يوم عظيم" />
-		<aya index="190" text="إن في ذلك لآية وما كان أكثرهم مؤمنين" />
-		<aya index="191" text="وإن ربك لهو العزيز الرحيم" />
-		<aya index="192" text="وإنه لتنزيل رب العالمين" />
-		<aya index="193" text="نزل به الروح الأمين" />
-		<aya index="194" text="على قلبك لتكون من المنذرين" />
-		<aya index="195" text="بلسان عربي مبين" />
-		<aya index="196" text="وإنه لفي زبر الأولين" />
-		<aya index="197" text="أولم يكن لهم آية أن يعلمه علماء بني إسرائيل" />
-		<aya index="198" text="ولو نزلناه على بعض الأعجمين" />
-		<aya index="199" text="فقرأه عليهم ما كانوا به مؤمنين" />
-		<aya index="200" text="كذلك سلكناه في قلوب المجرمين" />
-		<aya index="201" text="لا يؤمنون به حتى يروا العذاب الأليم" />
-		<aya index="202" text="فيأتيهم بغتة وهم لا يشعرون" />
-		<aya index="203" text="فيقولوا هل نحن منظرون" />
-		<aya index="204" text="أفبعذابنا يستعجلون" />
-		<aya index="205" text="أفرأيت إن متعناهم سنين" />
-		<aya index="206" text="ثم جاءهم ما كانوا يوعدون" />
-		<aya index="207" text="ما أغنى عنهم ما كانوا يمتعون" />
-		<aya index="208" text="وما أهلكنا من قرية إلا لها منذرون" />
-		<aya index="209" text="ذكرى وما كنا ظالمين" />
-		<aya index="210" text="وما تنزلت به الشياطين" />
-		<aya index="211" text="وما ينبغي لهم وما يستطيعون" />
-		<aya index="212" text="إنهم عن السمع لمعزولون" />
-		<aya index="213" text="فلا تدع مع الله إلها آخر فتكون من المعذبين" />
-		<aya index="214" text="وأنذر عشيرتك الأقربين" />
-		<aya index="215" text="واخفض جناحك لمن اتبعك من المؤمنين" />
-		<aya index="216" text="فإن عصوك فقل إني بريء مما تعملون" />
-		<aya index="217" text="وتوكل على العزيز الرحيم" />
-		<aya index="218" text="الذي يراك حين تقوم" />
-		<aya index="219" text="وتقلبك في الساجدين" />
-		<aya index="220" text="إنه هو السميع العليم" />
-		<aya index="221" text="هل أنبئكم على من تنزل الشياطين" />
-		<aya index="222" text="تنزل على كل أفاك أثيم" />
-		<aya index="223" text="يلقون السمع وأكثرهم كاذبون" />
-		<aya index="224" text="والشعراء يتبعهم الغاوون" />
-		<aya index="225" text="ألم تر أنهم في كل واد يهيمون" />
-		<aya index="226" text="وأنهم يقولون ما لا يفعلون" />
-		<aya index="227" text="إلا الذين آمنوا وعملوا الصالحات وذكروا الله كثيرا وانتصروا من بعد ما ظلموا وسيعلم الذين ظلموا أي منقلب ينقلبون" />
-	</sura>
-	<sura index="27" name="النمل">
-		<aya index="1" text="طس تلك آيات القرآن وكتاب مبين" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="هدى وبشرى للمؤمنين" />
-		<aya index="3" text="الذين يقيمون الصلاة ويؤتون الزكاة وهم بالآخرة هم يوقنون" />
-		<aya index="4" text="إن الذين لا يؤمنون بالآخرة زينا لهم أعمالهم فهم يعمهون" />
-		<aya index="5" text="أولئك الذين لهم سوء العذاب وهم في الآخرة هم الأخسرون" />
-		<aya index="6" text="وإنك لتلقى القرآن من لدن حكيم عليم" />
-		<aya index="7" text="إذ قال موسى لأهله إني آنست نارا سآتيكم منها بخبر أو آتيكم بشهاب قبس لعلكم تصطلون" />
-		<aya index="8" text="فلما جاءها نودي أن بورك من في النار ومن حولها وسبحان الله رب العالمين" />
-		<aya index="9" text="يا موسى إنه أنا الله العزيز الحكيم" />
-		<aya index="10" text="وألق عصاك فلما رآها تهتز كأنها جان ولى مدبرا ولم يعقب يا موسى لا تخف إني لا يخاف لدي المرسلون" />
-		<aya index="11" text="إلا من ظلم ثم بدل حسنا بعد سوء فإني غفور رحيم" />
-		<aya index="12" text="وأدخل يدك في جيبك تخرج بيضاء من غير سوء في تسع آيات إلى فرعون وقومه إنهم كانوا قوما فاسقين" />
-		<aya index="13" text="فلما جاءتهم آياتنا مبصرة قالوا هذا سحر مبين" />
-		<aya index="14" text="وجحدوا بها واستيقنتها أنفسهم ظلما وعلوا فانظر كيف كان عاقبة المفسدين" />
-		<aya index="15" text="ولقد آتينا داوود وسليمان علما وقالا الحمد لله الذي فضلنا على كثير من عباده المؤمنين" />
-		<aya index="16" text="وورث سليمان داوود وقال يا أيها الناس علمنا منطق الطير وأوتينا من كل شيء إن هذا لهو الفضل المبين" />
-		<aya index="17" text="وحشر لسليمان جنوده من الجن والإنس والطير فهم يوزعون" />
-		<aya index="18" text="حتى إذا أتوا على واد النمل قالت نملة يا أيها النمل ادخلوا مساكنكم لا يحطمنكم سليمان وجنوده وهم لا يشعرون" />
-		<aya index="19" text="فتبسم ضاحكا من قولها وقال رب أوزعني أن أشكر نعمتك التي أنعمت علي وعلى والدي وأن أعمل صالحا ترضاه وأدخلني برحمتك في عبادك الصالحين" />
-		<aya index="20" text="وتفقد الطير فقال ما لي لا أرى الهدهد أم كان من الغائبين" />
-		<aya index="21" text="لأعذبنه عذابا شديدا أو لأذبحنه أو ليأتيني بسلطان مبين" />
-		<aya index="22" text="فمكث غير بعيد فقال أحطت بما لم تحط به وجئتك من سبإ بنبإ يقين" />
-		<aya index="23" text="إني وجدت امرأة تملكهم وأوتيت من كل شيء ولها عرش عظيم" />
-		<aya index="24" text="وجدتها وقومها يسجدون للشمس من دون الله وزين لهم الشيطان أعمالهم فصدهم عن السبيل فهم لا يهتدون" />
-		<aya index="25" text="ألا يسجدوا لله الذي يخرج الخبء في السماوات والأرض ويعلم ما تخفون وما تعلنون" />
-		<aya index="26" text="الله لا إله إلا هو رب العرش العظيم" />
-		<aya index="27" text="قال سننظر أصدقت أم كنت من الكاذبين" />
-		<aya index="28" text="اذهب بكتابي هذا فألقه إليهم ثم تول عنهم فانظر ماذا يرجعون" />
-		<aya index="29" text="قالت يا أيها الملأ إني ألقي إلي كتاب كريم" />
-		<aya index="30" text="إنه من سليمان وإنه بسم الله الرحمن الرحيم" />
-		<aya index="31" text="ألا تعلوا علي وأتوني مسلمين" />
-		<aya index="32" text="قالت يا أيها الملأ أفتوني في أمري ما كنت قاطعة أمرا حتى تشهدون" />
-		<aya index="33" text="قالوا نحن أولو قوة وأولو بأس شديد والأمر إليك فانظري ماذا تأمرين" />
-		<aya index="34" text="قالت إن الملوك إذا دخلوا قرية أفسدوها وجعلوا أعزة أهلها أذلة وكذلك يفعلون" />
-		<aya index="35" text="وإني مرسلة إليهم بهدية فناظرة بم يرجع المرسلون" />
-		<aya index="36" text="فلما جاء سليمان قال أتمدونن بمال فما آتاني الله خير مما آتاكم بل أنتم بهديتكم تفرحون" />
-		<aya index="37" text="ارجع إليهم فلنأتينهم بجنود لا قبل لهم بها ولنخرجنهم منها أذلة وهم صاغرون" />
-		<aya index="38" text="قال يا أيها الملأ أيكم يأتيني بعرشها قبل أن يأتوني مسلمين" />
-		<aya index="39" text="قال عفريت من الجن أنا آتيك به قبل أن تقوم من مقامك وإني عليه لقوي أمين" />
-		<aya index="40" text="قال الذي عنده علم من الكتاب أنا آتيك به قبل أن يرتد إليك طرفك فلما رآه مستقرا عنده قال هذا من فضل ربي ليبلوني أأشكر أم أكفر ومن شكر فإنما يشكر لنفسه ومن كفر فإن ربي غني كريم" />
-		<aya index="41" text="قال نكروا لها عرشها ننظر أتهتدي أم تكون من الذين لا يهتدون" />
-		<aya index="42" text="فلما جاءت قيل أهكذا عرشك قالت كأنه هو وأوتينا العلم من قبلها وكنا مسلمين" />
-		<aya index="43" text="وصدها ما كانت تعبد من دون الله إنها كانت من قوم كافرين" />
-		<aya index="44" text="قيل لها ادخلي الصرح فلما رأته حسبته لجة وكشفت عن ساقيها قال إنه صرح ممرد من قوارير قالت رب إني ظلمت نفسي وأسلمت مع سليمان لله رب العالمين" />
-		<aya index="45" text="ولقد أرسلنا إلى ثمود أخاهم صالحا أن اعبدوا الله فإذا هم فريقان يختصمون" />
-		<aya index="46" text="قال يا قوم لم تستعجلون بالسيئة قبل الحسنة لولا تستغفرون الله لعلكم ترحمون" />
-		<aya index="47" text="قالوا اطيرنا بك وبمن معك قال طائركم عند الله بل أنتم قوم تفتنون" />
-		<aya index="48" text="وكان في المدينة تسعة رهط يفسدون في الأرض ولا يصلحون" />
-		<aya index="49" text="قالوا تقاسموا بالله لنبيتنه وأهله ثم لنقولن لوليه ما شهدنا مهلك أهله وإنا لصادقون" />
-		<aya index="50" text="ومكروا مكرا ومكرنا مكرا وهم لا يشعرون" />
-		<aya index="51" text="فانظر كيف كان عاقبة مكرهم أنا دمرناهم وقومهم أجمعين" />
-		<aya index="52" text="فتلك بيوتهم خاوية بما ظلموا إن في ذلك لآية لقوم يعلمون" />
-		<aya index="53" text="وأنجينا الذين آمنوا وكانوا يتقون" />
-		<aya index="54" text="ولوطا إذ قال لقومه أتأتون الفاحشة وأنتم تبصرون" />
-		<aya index="55" text="أئنكم لتأتون الرجال شهوة من دون النساء بل أنتم قوم تجهلون" />
-		<aya index="56" text="فما كان جواب قومه إلا أن قالوا أخرجوا آل لوط من قريتكم إنهم أناس يتطهرون" />
-		<aya index="57" text="فأنجيناه وأهله إلا امرأته قدرناها من الغابرين" />
-		<aya index="58" text="وأمطرنا عليهم مطرا فساء مطر المنذرين" />
-		<aya index="59" text="قل الحمد لله وسلام على عباده الذين اصطفى آلله خير أما يشركون" />
-		<aya index="60" text="أمن خلق السماوات والأرض وأنزل لكم من السماء ماء فأنبتنا به حدائق ذات بهجة ما كان لكم أن تنبتوا شجرها أإله مع الله بل هم قوم يعدلون" />
-		<aya index="61" text="أمن جعل الأرض قرارا وجعل خلالها أنهارا وجعل لها رواسي وجعل بين البحرين حاجزا أإله مع الله بل أكثرهم لا يعلمون" />
-		<aya index="62" text="أمن يجيب المضطر إذا دعاه ويكشف السوء ويجعلكم خلفاء الأرض أإله مع الله قليلا ما تذكرون" />
-		<aya index="63" text="أمن يهديكم في ظلمات البر والبحر ومن يرسل الرياح بشرا بين يدي رحمته أإله مع الله تعالى الله عما يشركون" />
-		<aya index="64" text="أمن يبدأ الخلق ثم يعيده ومن يرزقكم من السماء والأرض أإله مع الله قل هاتوا برهانكم إن كنتم صادقين" />
-		<aya index="65" text="قل لا يعلم من في السماوات والأرض الغيب إلا الله وما يشعرون أيان يبعثون" />
-		<aya index="66" text="بل ادارك علمهم في الآخرة بل هم في شك منها بل هم منها عمون" />
-		<aya index="67" text="وقال الذين كفروا أإذا كنا ترابا وآباؤنا أئنا لمخرجون" />
-		<aya index="68" text="لقد وعدنا هذا نحن وآباؤنا من قبل إن هذا إلا أساطير الأولين" />
-		<aya index="69" text="قل سيروا في الأرض فانظروا كيف كان عاقبة المجرمين" />
-		<aya index="70" text="ولا تحزن عليهم ولا تكن في ضيق مما يمكرون" />
-		<aya index="71" text="ويقولون متى هذا الوعد إن كنتم صادقين" />
-		<aya index="72" text="قل عسى أن يكون ردف لكم بعض الذي تستعجلون" />
-		<aya index="73" text="وإن ربك لذو فضل على الناس ولكن أكثرهم لا يشكرون" />
-		<aya index="74" text="وإن ربك ليعلم ما تكن صدورهم وما يعلنون" />
-		<aya index="75" text="وما من غائبة في السماء والأرض إلا في كتاب مبين" />
-		<aya index="76" text="إن هذا القرآن يقص على بني إسرائيل أكثر الذي هم فيه يختلفون" />
-		<aya index="77" text="وإنه لهدى ورحمة للمؤمنين" />
-		<aya index="78" text="إن ربك يقضي بينهم بحكمه وهو العزيز العليم" />
-		<aya index="79" text="فتوكل على الله إنك على الحق المبين" />
-		<aya index="80" text="إنك لا تسمع الموتى ولا تسمع الصم الدعاء إذا ولوا مدبرين" />
-		<aya index="81" text="وما أنت بهادي العمي عن ضلالتهم إن تسمع إلا من يؤمن بآياتنا فهم مسلمون" />
-		<aya index="82" text="وإذا وقع القول عليهم أخرجنا لهم دابة من الأرض تكلمهم أن الناس كانوا بآياتنا لا يوقنون" />
-		<aya index="83" text="ويوم نحشر من كل أمة فوجا ممن يكذب بآياتنا فهم يوزعون" />
-		<aya index="84" text="حتى إذا جاءوا قال أكذبتم بآياتي ولم تحيطوا بها علما أماذا كنتم تعملون" />
-		<aya index="85" text="ووقع القول عليهم بما ظلموا فهم لا ينطقون" />
-		<aya index="86" text="ألم يروا أنا جعلنا الليل ليسكنوا فيه والنهار مبصرا إن في ذلك لآيات لقوم يؤمنون" />
-		<aya index="87" text="ويوم ينفخ في الصور ففزع من في السماوات ومن في الأرض إلا من شاء الله وكل أتوه داخرين" />
-		<aya index="88" text="وترى الجبال تحسبها جامدة وهي تمر مر السحاب صنع الله الذي أتقن كل شيء إنه خبير بما تفعلون" />
-		<aya index="89" text="من جاء بالحسنة فله خير منها وهم من فزع يومئذ آمنون" />
-		<aya index="90" text="ومن جاء بالسيئة فكبت وجوههم في النار هل تجزون إلا ما كنتم تعملون" />
-		<aya index="91" text="إنما أمرت أن أعبد رب هذه البلدة الذي حرمها وله كل شيء وأمرت أن أكون من المسلمين" />
-		<aya index="92" text="وأن أتلو القرآن فمن اهتدى فإنما يهتدي لنفسه ومن ضل فقل إنما أنا من المنذرين" />
-		<aya index="93" text="وقل الحمد لله سيريكم آياته فتعرفونها وما ربك بغافل عما تعملون" />
-	</sura>
-	<sura index="28" name="القصص">
-		<aya index="1" text="طسم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="تلك آيات الكتاب المبين" />
-		<aya index="3" text="نتلو عليك من نبإ موسى وفرعون بالحق لقوم يؤمنون" />
-		<aya index="4" text="إن فرعون علا في الأرض وجعل أهلها شيعا يستضعف طائفة منهم يذبح أبناءهم ويستحيي نساءهم إنه كان من المفسدين" />
-		<aya index="5" text="ونريد أن نمن على الذين استضعفوا في الأرض ونجعلهم أئمة ونجعلهم الوارثين" />
-		<aya index="6" text="ونمكن لهم في الأرض ونري فرعون وهامان وجنودهما منهم ما كانوا يحذرون" />
-		<aya index="7" text="وأوحينا إلى أم موسى أن أرضعيه فإذا خفت عليه فألقيه في اليم ولا تخافي ولا تحزني إنا رادوه إليك وجاعلوه من المرسلين" />
-		<aya index="8" text="فالتقطه آل فرعون ليكون لهم عدوا وحزنا إن فرعون وهامان وجنودهما كانوا خاطئين" />
-		<aya index="9" text="وقالت امرأت فرعون قرت عين لي ولك لا تقتلوه عسى أن ينفعنا أو نتخذه ولدا وهم لا يشعرون" />
-		<aya index="10" text="وأصبح فؤاد أم موسى فارغا إن كادت لتبدي به لولا أن ربطنا على قلبها لتكون من المؤمنين" />
-		<aya index="11" text="وقالت لأخته قصيه فبصرت به عن جنب وهم لا يشعرون" />
-		<aya index="12" text="وحرمنا عليه المراضع من قبل فقالت هل أدلكم على أهل بيت يكفلونه لكم وهم له ناصحون" />
-		<aya index="13" text="فرددناه إلى أمه كي تقر عينها ولا تحزن ولتعلم أن وعد الله حق ولكن أكثرهم لا يعلمون" />
-		<aya index="14" text="ولما بلغ أشده واستوى آتيناه حكما وعلما وكذلك نجزي المحسنين" />
-		<aya index="15" text="ودخل المدينة على حين غفلة من أهلها فوجد فيها رجلين يقتتلان هذا من شيعته وهذا من عدوه فاستغاثه الذي من شيعته على الذي من عدوه فوكزه موسى فقضى عليه قال هذا من عمل الشيطان إنه عدو مضل مبين" />
-		<aya index="16" text="قال رب إني ظلمت نفسي فاغفر لي فغفر له إنه هو الغفور الرحيم" />
-		<aya index="17" text="قال رب بما أنعمت علي فلن أكون ظهيرا للمجرمين" />
-		<aya index="18" text="فأصبح في المدينة خائفا يترقب فإذا الذي استنصره بالأمس يستصرخه قال له موسى إنك لغوي مبين" />
-		<aya index="19" text="فلما أن أراد أن يبطش بالذي هو عدو لهما قال يا موسى أتريد أن تقتلني كما قتلت نفسا بالأمس إن تريد إلا أن تكون جبارا في الأرض وما تريد أن تكون من المصلحين" />
-		<aya index="20" text="وجاء رجل من أقصى المدينة يسعى قال يا موسى إن الملأ يأتمرون بك ليقتلوك فاخرج إني لك من الناصحين" />
-		<aya index="21" text="فخرج منها خائفا يترقب قال رب نجني من القوم الظالمين" />
-		<aya index="22" text="ولما توجه تلقاء مدين قال عسى ربي أن يهديني سواء السبيل" />
-		<aya index="23" text="ولما ورد ماء مدين وجد عليه أمة من الناس يسقون ووجد من دونهم امرأتين تذودان قال ما خطبكما قالتا لا نسقي حتى يصدر الرعاء وأبونا شيخ كبير" />
-		<aya index="24" text="فسقى لهما ثم تولى إلى الظل فقال رب إني لما أنزلت إلي من خير فقير" />
-		<aya index="25" text="فجاءته إحداهما تمشي على استحياء قالت إن أبي يدعوك ليجزيك أجر ما سقيت لنا فلما جاءه وقص عليه القصص قال لا تخف نجوت من القوم الظالمين" />
-		<aya index="26" text="قالت إحداهما يا أبت استأجره إن خير من استأجرت القوي الأمين" />
-		<aya index="27" text="قال إني أريد أن أنكحك إحدى ابنتي هاتين على أن تأجرني ثماني حجج فإن أتممت عشرا فمن عندك وما أريد أن أشق عليك ستجدني إن شاء الله من الصالحين" />
-		<aya index="28" text="قال ذلك بيني وبينك أيما الأجلين قضيت فلا عدوان علي والله على ما نقول وكيل" />
-		<aya index="29" text="فلما قضى موسى الأجل وسار بأهله آنس من جانب الطور نارا قال لأهله امكثوا إني آنست نارا لعلي آتيكم منها بخبر أو جذوة من النار لعلكم تصطلون" />
-		<aya index="30" text="فلما أتاها نودي من شاطئ الواد الأيمن في البقعة المباركة من الشجرة أن يا موسى إني أنا الله رب العالمين" />
-		<aya index="31" text="وأن ألق عصاك فلما رآها تهتز كأنها جان ولى مدبرا ولم يعقب يا موسى أقبل ولا تخف إنك من الآمنين" />
-		<aya index="32" text="اسلك يدك في جيبك تخرج بيضاء من غير سوء واضمم إليك جناحك من الرهب فذانك برهانان من ربك إلى فرعون وملئه إنهم كانوا قوما فاسقين" />
-		<aya index="33" text="قال رب إني قتلت منهم نفسا فأخاف أن يقتلون" />
-		<aya index="34" text="وأخي هارون هو أفصح مني لسانا فأرسله معي ردءا يصدقني إني أخاف أن يكذبون" />
-		<aya index="35" text="قال سنشد عضدك بأخيك ونجعل لكما سلطانا فلا يصلون إليكما بآياتنا أنتما ومن اتبعكما الغالبون" />
-		<aya index="36" text="فلما جاءهم موسى بآياتنا بينات قالوا ما هذا إلا سحر مفترى وما سمعنا بهذا في آبائنا الأولين" />
-		<aya index="37" text="وقال موسى ربي أعلم بمن جاء بالهدى من عنده ومن تكون له عاقبة الدار إنه لا يفلح الظالمون" />
-		<aya index="38" text="وقال فرعون يا أيها الملأ ما علمت لكم من إله غيري فأوقد لي يا هامان على الطين فاجعل لي صرحا لعلي أطلع إلى إله موسى وإني لأظنه من الكاذبين" />
-		<aya index="39" text="واستكبر هو وجنوده في الأرض بغير الحق وظنوا أنهم إلينا لا يرجعون" />
-		<aya index="40" text="فأخذناه وجنوده فنبذناهم في اليم فانظر كيف كان عاقبة الظالمين" />
-		<aya index="41" text="وجعلناهم أئمة يدعون إلى النار ويوم القيامة لا ينصرون" />
-		<aya index="42" text="وأتبعناهم في هذه الدنيا لعنة ويوم القيامة هم من المقبوحين" />
-		<aya index="43" text="ولقد آتينا موسى الكتاب من بعد ما أهلكنا القرون الأولى بصائر للناس وهدى ورحمة لعلهم يتذكرون" />
-		<aya index="44" text="وما كنت بجانب الغربي إذ قضينا إلى موسى الأمر وما كنت من الشاهدين" />
-		<aya index="45" text="ولكنا أنشأنا قرونا فتطاول عليهم العمر وما كنت ثاويا في أهل مدين تتلو عليهم آياتنا ولكنا كنا مرسلين" />
-		<aya index="46" text="وما كنت بجانب الطور إذ نادينا ولكن رحمة من ربك لتنذر قوما ما أتاهم من نذير من قبلك لعلهم يتذكرون" />
-		<aya index="47" text="ولولا أن تصيبهم مصيبة بما قدمت أيديهم فيقولوا ربنا لولا أرسلت إلينا رسولا فنتبع آياتك ونكون من المؤمنين" />
-		<aya index="48" text="فلما جاءهم الحق من عندنا قالوا لولا أوتي مثل ما أوتي موسى أولم يكفروا بما أوتي موسى من قبل قالوا سحران تظاهرا وقالوا إنا بكل كافرون" />
-		<aya index="49" text="قل فأتوا بكتاب من عند الله هو أهدى منهما أتبعه إن كنتم صادقين" />
-		<aya index="50" text="فإن لم يستجيبوا لك فاعلم أنما يتبعون أهواءهم ومن أضل ممن اتبع هواه بغير هدى من الله إن الله لا يهدي القوم الظالمين" />
-		<aya index="51" text="ولقد وصلنا لهم القول لعلهم يتذكرون" />
-		<aya index="52" text="الذين آتيناهم الكتاب من قبله هم به يؤمنون" />
-		<aya index="53" text="وإذا يتلى عليهم قالوا آمنا به إنه الحق من ربنا إنا كنا من قبله مسلمين" />
-		<aya index="54" text="أولئك يؤتون أجرهم مرتين بما صبروا ويدرءون بالحسنة السيئة ومما رزقناهم ينفقون" />
-		<aya index="55" text="وإذا سمعوا اللغو أعرضوا عنه وقالوا لنا أعمالنا ولكم أعمالكم سلام عليكم لا نبتغي الجاهلين" />
-		<aya index="56" text="إنك لا تهدي من أحببت ولكن الله يهدي من يشاء وهو أعلم بالمهتدين" />
-		<aya index="57" text="وقالوا إن نتبع الهدى معك نتخطف من أرضنا أولم نمكن لهم حرما آمنا يجبى إليه ثمرات كل شيء رزقا من لدنا ولكن أكثرهم لا يعلمون" />
-		<aya index="58" text="وكم أهلكنا من قرية بطرت معيشتها فتلك مساكنهم لم تسكن من بعدهم إلا قليلا وكنا نحن الوارثين" />
-		<aya index="59" text="وما كان ربك مهلك القرى حتى يبعث في أمها رسولا يتلو عليهم آياتنا وما كنا مهلكي القرى إلا وأهلها ظالمون" />
-		<aya index="60" text="وما أوتيتم من شيء فمتاع الحياة الدنيا وزينتها وما عند الله خير وأبقى أفلا تعقلون" />
-		<aya index="61" text="أفمن وعدناه وعدا حسنا فهو لاقيه كمن متعناه متاع الحياة الدنيا ثم هو يوم القيامة من المحضرين" />
-		<aya index="62" text="ويوم يناديهم فيقول أين شركائي الذين كنتم تزعمون" />
-		<aya index="63" text="قال الذين حق عليهم القول ربنا هؤلاء الذين أغوينا أغويناهم كما غوينا تبرأنا إليك ما كانوا إيانا يعبدون" />
-		<aya index="64" text="وقيل ادعوا شركاءكم فدعوهم فلم يستجيبوا لهم ورأوا العذاب لو أنهم كانوا يهتدون" />
-		<aya index="65" text="ويوم يناديهم فيقول ماذا أجبتم المرسلين" />
-		<aya index="66" text="فعميت عليهم الأنباء يومئذ فهم لا يتساءلون" />
-		<aya index="67" text="فأما من تاب وآمن وعمل صالحا فعسى أن يكون من المفلحين" />
-		<aya index="68" text="وربك يخلق ما يشاء ويختار ما كان لهم الخيرة سبحان الله وتعالى عما يشركون" />
-		<aya index="69" text="وربك يعلم ما تكن صدورهم وما يعلنون" />
-		<aya index="70" text="وهو الله لا إله إلا هو له الحمد في الأولى والآخرة وله الحكم وإليه ترجعون" />
-		<aya index="71" text="قل أرأيتم إن جعل الله عليكم الليل سرمدا إلى يوم القيامة من إله غير الله يأتيكم بضياء أفلا تسمعون" />
-		<aya index="72" text="قل أرأيتم إن جعل الله عليكم النهار سرمدا إلى يوم القيامة من إله غير الله يأتيكم بليل تسكنون فيه أفلا تبصرون" />
-		<aya index="73" text="ومن رحمته جعل لكم الليل والنهار لتسكنوا فيه ولتبتغوا من فضله ولعلكم تشكرون" />
-		<aya index="74" text="ويوم يناديهم فيقول أين شركائي الذين كنتم تزعمون" />
-		<aya index="75" text="ونزعنا من كل أمة شهيدا فقلنا هاتوا برهانكم فعلموا أن الحق لله وضل عنهم ما كانوا يفترون" />
-		<aya index="76" text="إن قارون كان من قوم موسى فبغى عليهم وآتيناه من الكنوز ما إن مفاتحه لتنوء بالعصبة أولي القوة إذ قال له قومه لا تفرح إن الله لا يحب الفرحين" />
-		<aya index="77" text="وابتغ فيما آتاك الله الدار الآخرة ولا تنس نصيبك من الدنيا وأحسن كما أحسن الله إليك ولا تبغ الفساد في الأرض إن الله لا يحب المفسدين" />
-		<aya index="78" text="قال إنما أوتيته على علم عندي أولم يعلم أن الله قد أهلك من قبله من القرون من هو أشد منه قوة وأكثر جمعا ولا يسأل عن ذنوبهم المجرمون" />
-		<aya index="79" text="فخرج على قومه في زينته قال الذين يريدون الحياة الدنيا يا ليت لنا مثل ما أوتي قارون إنه لذو حظ عظيم" />
-		<aya index="80" text="وقال الذين أوتوا العلم ويلكم ثواب الله خير لمن آمن وعمل صالحا ولا يلقاها إلا الصابرون" />
-		<aya index="81" text="فخسفنا به وبداره الأرض فما كان له من فئة ينصرونه من دون الله وما كان من المنتصرين" />
-		<aya index="82" text="وأصبح الذين تمنوا مكانه بالأمس يقولون ويكأن الله يبسط الرزق لمن يشاء من عباده ويقدر لولا أن من الله علينا لخسف بنا ويكأنه لا يفلح الكافرون" />
-		<aya index="83" text="تلك الدار الآخرة نجعلها للذين لا يريدون علوا في الأرض ولا فسادا والعاقبة للمتقين" />
-		<aya index="84" text="من جاء بالحسنة فله خير منها ومن جاء بالسيئة فلا يجزى الذين عملوا السيئات إلا ما كانوا يعملون" />
-		<aya index="85" text="إن الذي فرض عليك القرآن لرادك إلى معاد قل ربي أعلم من جاء بالهدى ومن هو في ضلال مبين" />
-		<aya index="86" text="وما كنت ترجو أن يلقى إليك الكتاب إلا رحمة من ربك فلا تكونن ظهيرا للكافرين" />
-		<aya index="87" text="ولا يصدنك عن آيات الله بعد إذ أنزلت إليك وادع إلى ربك ولا تكونن من المشركين" />
-		<aya index="88" text="ولا تدع مع الله إلها آخر لا إله إلا هو كل شيء هالك إلا وجهه له الحكم وإليه ترجعون" />
-	</sura>
-	<sura index="29" name="العنكبوت">
-		<aya index="1" text="الم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="أحسب الناس أن يتركوا أن يقولوا آمنا وهم لا يفتنون" />
-		<aya index="3" text="ولقد فتنا الذين من قبلهم فليعلمن الله الذين صدقوا وليعلمن الكاذبين" />
-		<aya index="4" text="أم حسب الذين يعملون السيئات أن يسبقونا ساء ما يحكمون" />
-		<aya index="5" text="من كان يرجو لقاء الله فإن أجل الله لآت وهو السميع العليم" />
-		<aya index="6" text="ومن جاهد فإنما يجاهد لنفسه إن الله لغني عن العالمين" />
-		<aya index="7" text="والذين آمنوا وعملوا الصالحات لنكفرن عنهم سيئاتهم ولنجزينهم أحسن الذي كانوا يعملون" />
-		<aya index="8" text="ووصينا الإنسان بوالديه حسنا وإن جاهداك لتشرك بي ما ليس لك به علم فلا تطعهما إلي مرجعكم فأنبئكم بما كنتم تعملون" />
-		<aya index="9" text="والذين آمنوا وعملوا الصالحات لندخلنهم في الصالحين" />
-		<aya index="10" text="ومن الناس من يقول آمنا بالله فإذا أوذي في الله جعل فتنة الناس كعذاب الله ولئن جاء نصر من ربك ليقولن إنا كنا معكم أوليس الله بأعلم بما في صدور العالمين" />
-		<aya index="11" text="وليعلمن الله الذين آمنوا وليعلمن المنافقين" />
-		<aya index="12" text="وقال الذين كفروا للذين آمنوا اتبعوا سبيلنا ولنحمل خطاياكم وما هم بحاملين من خطاياهم من شيء إنهم لكاذبون" />
-		<aya index="13" text="وليحملن أثقالهم وأثقالا مع أثقالهم وليسألن يوم القيامة عما كانوا يفترون" />
-		<aya index="14" text="ولقد أرسلنا نوحا إلى قومه فلبث فيهم ألف سنة إلا خمسين عاما فأخذهم الطوفان وهم ظالمون" />
-		<aya index="15" text="فأنجيناه وأصحاب السفينة وجعلناها آية للعالمين" />
-		<aya index="16" text="وإبراهيم إذ قال لقومه اعبدوا الله واتقوه ذلكم خير لكم إن كنتم تعلمون" />
-		<aya index="17" text="إنما تعبدون من دون الله أوثانا وتخلقون إفكا إن الذين تعبدون من دون الله لا يملكون لكم رزقا فابتغوا عند الله الرزق واعبدوه واشكروا له إليه ترجعون" />
-		<aya index="18" text="وإن تكذبوا فقد كذب أمم من قبلكم وما على الرسول إلا البلاغ المبين" />
-		<aya index="19" text="أولم يروا كيف يبدئ الله الخلق ثم يعيده إن ذلك على الله يسير" />
-		<aya index="20" text="قل سيروا في الأرض فانظروا كيف بدأ الخلق ثم الله ينشئ النشأة الآخرة إن الله على كل شيء قدير" />
-		<aya index="21" text="يعذب من يشاء ويرحم من يشاء وإليه تقلبون" />
-		<aya index="22" text="وما أنتم بمعجزين في الأرض ولا في السماء وما لكم من دون الله من ولي ولا نصير" />
-		<aya index="23" text="والذين كفروا بآيات الله ولقائه أولئك يئسوا من رحمتي وأولئك لهم عذاب أليم" />
-		<aya index="24" text="فما كان جواب قومه إلا أن قالوا اقتلوه أو حرقوه فأنجاه الله من النار إن في ذلك لآيات لقوم يؤمنون" />
-		<aya index="25" text="وقال إنما اتخذتم من دون الله أوثانا مودة بينكم في الحياة الدنيا ثم يوم القيامة يكفر بعضكم ببعض ويلعن بعضكم بعضا ومأواكم النار وما لكم من ناصرين" />
-		<aya index="26" text="فآمن له لوط وقال إني مهاجر إلى ربي إنه هو العزيز الحكيم" />
-		<aya index="27" text="ووهبنا له إسحاق ويعقوب وجعلنا في ذريته النبوة والكتاب وآتيناه أجره في الدنيا وإنه في الآخرة لمن الصالحين" />
-		<aya index="28" text="ولوطا إذ قال لقومه إنكم لتأتون الفاحشة ما سبقكم بها من أحد من العالمين" />
-		<aya index="29" text="أئنكم لتأتون الرجال وتقطعون السبيل وتأتون في ناديكم المنكر فما كان جواب قومه إلا أن قالوا ائتنا بعذاب الله إن كنت من الصادقين" />
-		<aya index="30" text="قال رب انصرني على القوم المفسدين" />
-		<aya index="31" text="ولما جاءت رسلنا إبراهيم بالبشرى قالوا إنا مهلكو أهل هذه القرية إن أهلها كانوا ظالمين" />
-		<aya index="32" text="قال إن فيها لوطا قالوا نحن أعلم بمن فيها لننجينه وأهله إلا امرأته كانت من الغابرين" />
-		<aya index="33" text="ولما أن جاءت رسلنا لوطا سيء بهم وضاق بهم ذرعا وقالوا لا تخف ولا تحزن إنا منجوك وأهلك إلا امرأتك كانت من الغابرين" />
-		<aya index="34" text="إنا منزلون على أهل هذه القرية رجزا من السماء بما كانوا يفسقون" />
-		<aya index="35" text="ولقد تركنا منها آية بينة لقوم يعقلون" />
-		<aya index="36" text="وإلى مدين أخاهم شعيبا فقال يا قوم اعبدوا الله وارجوا اليوم الآخر ولا تعثوا في الأرض مفسدين" />
-		<aya index="37" text="فكذبوه فأخذتهم الرجفة فأصبحوا في دارهم جاثمين" />
-		<aya index="38" text="وعادا وثمود وقد تبين لكم من مساكنهم وزين لهم الشيطان أعمالهم فصدهم عن السبيل وكانوا مستبصرين" />
-		<aya index="39" text="وقارون وفرعون وهامان ولقد جاءهم موسى بالبينات فاستكبروا في الأرض وما كانوا سابقين" />
-		<aya index="40" text="فكلا أخذنا بذنبه فمنهم من أرسلنا عليه حاصبا ومنهم من أخذته الصيحة ومنهم من خسفنا به الأرض ومنهم من أغرقنا وما كان الله ليظلمهم ولكن كانوا أنفسهم يظلمون" />
-		<aya index="41" text="مثل الذين اتخذوا من دون الله أولياء كمثل العنكبوت اتخذت بيتا وإن أوهن البيوت لبيت العنكبوت لو كانوا يعلمون" />
-		<aya index="42" text="إن الله يعلم ما يدعون من دونه من شيء وهو العزيز الحكيم" />
-		<aya index="43" text="وتلك الأمثال نضربها للناس وما يعقلها إلا العالمون" />
-		<aya index="44" text="خلق الله السماوات والأرض بالحق إن في ذلك لآية للمؤمنين" />
-		<aya index="45" text="اتل ما أوحي إليك من الكتاب وأقم الصلاة إن الصلاة تنهى عن الفحشاء والمنكر ولذكر الله أكبر والله يعلم ما تصنعون" />
-		<aya index="46" text="ولا تجادلوا أهل الكتاب إلا بالتي هي أحسن إلا الذين ظلموا منهم وقولوا آمنا بالذي أنزل إلينا وأنزل إليكم وإلهنا وإلهكم واحد ونحن له مسلمون" />
-		<aya index="47" text="وكذلك أنزلنا إليك الكتاب فالذين آتيناهم الكتاب يؤمنون به ومن هؤلاء من يؤمن به وما يجحد بآياتنا إلا الكافرون" />
-		<aya index="48" text="وما كنت تتلو من قبله من كتاب ولا تخطه بيمينك إذا لارتاب المبطلون" />
-		<aya index="49" text="بل هو آيات بينات في صدور الذين أوتوا العلم وما يجحد بآياتنا إلا الظالمون" />
-		<aya index="50" text="وقالوا لولا أنزل عليه آيات من ربه قل إنما الآيات عند الله وإنما أنا نذير مبين" />
-		<aya index="51" text="أولم يكفهم أنا أنزلنا عليك الكتاب يتلى عليهم إن في ذلك لرحمة وذكرى لقوم يؤمنون" />
-		<aya index="52" text="قل كفى بالله بيني وبينكم شهيدا يعلم ما في السماوات والأرض والذين آمنوا بالباطل وكفروا بالله أولئك هم الخاسرون" />
-		<aya index="53" text="ويستعجلونك بالعذاب ولولا أجل مسمى لجاءهم العذاب وليأتينهم بغتة وهم لا يشعرون" />
-		<aya index="54" text="يستعجلونك بالعذاب وإن جهنم لمحيطة بالكافرين" />
-		<aya index="55" text="يوم يغشاهم العذاب من فوقهم ومن تحت أرجلهم ويقول ذوقوا ما كنتم تعملون" />
-		<aya index="56" text="يا عبادي الذين آمنوا إن أرضي واسعة فإياي فاعبدون" />
-		<aya index="57" text="كل نفس ذائقة الموت ثم إلينا ترجعون" />
-		<aya index="58" text="والذين آمنوا وعملوا الصالحات لنبوئنهم من الجنة غرفا تجري من تحتها الأنهار خالدين فيها نعم أجر العاملين" />
-		<aya index="59" text="الذين صبروا وعلى ربهم يتوكلون" />
-		<aya index="60" text="وكأين من دابة لا تحمل رزقها الله يرزقها وإياكم وهو السميع العليم" />
-		<aya index="61" text="ولئن سألتهم من خلق السماوات والأرض وسخر الشمس والقمر ليقولن الله فأنى يؤفكون" />
-		<aya index="62" text="الله يبسط الرزق لمن يشاء من عباده ويقدر له إن الله بكل شيء عليم" />
-		<aya index="63" text="ولئن سألتهم من نزل من السماء ماء فأحيا به الأرض من بعد موتها ليقولن الله قل الحمد لله بل أكثرهم لا يعقلون" />
-		<aya index="64" text="وما هذه الحياة الدنيا إلا لهو ولعب وإن الدار الآخرة لهي الحيوان لو كانوا يعلمون" />
-		<aya index="65" text="فإذا ركبوا في الفلك دعوا الله مخلصين له الدين فلما نجاهم إلى البر إذا هم يشركون" />
-		<aya index="66" text="ليكفروا بما آتيناهم وليتمتعوا فسوف يعلمون" />
-		<aya index="67" text="أولم يروا أنا جعلنا حرما آمنا ويتخطف الناس من حولهم أفبالباطل يؤمنون وبنعمة الله يكفرون" />
-		<aya index="68" text="ومن أظلم ممن افترى على الله كذبا أو كذب بالحق لما جاءه أليس في جهنم مثوى للكافرين" />
-		<aya index="69" text="والذين جاهدوا فينا لنهدينهم سبلنا وإن الله لمع المحسنين" />
-	</sura>
-	<sura index="30" name="الروم">
-		<aya index="1" text="الم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="غلبت الروم" />
-		<aya index="3" text="في أدنى الأرض وهم من بعد غلبهم سيغلبون" />
-		<aya index="4" text="في بضع سنين لله الأمر من قبل ومن بعد ويومئذ يفرح المؤمنون" />
-		<aya index="5" text="بنصر الله ينصر من يشاء وهو العزيز الرحيم" />
-		<aya index="6" text="وعد الله لا يخلف الله وعده ولكن أكثر الناس لا يعلمون" />
-		<aya index="7" text="يعلمون ظاهرا من الحياة الدنيا وهم عن الآخرة هم غافلون" />
-		<aya index="8" text="أولم يتفكروا في أنفسهم ما خلق الله السماوات والأرض وما بينهما إلا بالحق وأجل مسمى وإن كثيرا من الناس بلقاء ربهم لكافرون" />
-		<aya index="9" text="أولم يسيروا في الأرض فينظروا كيف كان عاقبة الذين من قبلهم كانوا أشد منهم قوة وأثاروا الأرض وعمروها أكثر مما عمروها وجاءتهم رسلهم بالبينات فما كان الله ليظلمهم ولكن كانوا أنفسهم يظلمون" />
-		<aya index="10" text="ثم كان عاقبة الذين أساءوا السوأى أن كذبوا بآيات الله وكانوا بها يستهزئون" />
-		<aya index="11" text="الله يبدأ الخلق ثم يعيده ثم إليه ترجعون" />
-		<aya index="12" text="ويوم تقوم الساعة يبلس المجرمون" />
-		<aya index="13" text="ولم يكن لهم من شركائهم شفعاء وكانوا بشركائهم كافرين" />
-		<aya index="14" text="ويوم تقوم الساعة يومئذ يتفرقون" />
-		<aya index="15" text="فأما الذين آمنوا وعملوا الصالحات فهم في روضة يحبرون" />
-		<aya index="16" text="وأما الذين كفروا وكذبوا بآياتنا ولقاء الآخرة فأولئك في العذاب محضرون" />
-		<aya index="17" text="فسبحان الله حين تمسون وحين تصبحون" />
-		<aya index="18" text="وله الحمد في السماوات والأرض وعشيا وحين تظهرون" />
-		<aya index="19" text="يخرج الحي من الميت ويخرج الميت من الحي ويحيي الأرض بعد موتها وكذلك تخرجون" />
-		<aya index="20" text="ومن آياته أن خلقكم من تراب ثم إذا أنتم بشر تنتشرون" />
-		<aya index="21" text="ومن آياته أن خلق لكم من أنفسكم أزواجا لتسكنوا إليها وجعل بينكم مودة ورحمة إن في ذلك لآيات لقوم يتفكرون" />
-		<aya index="22" text="ومن آياته خلق السماوات والأرض واختلاف ألسنتكم وألوانكم إن في ذلك لآيات للعالمين" />
-		<aya index="23" text="ومن آياته منامكم بالليل والنهار وابتغاؤكم من فضله إن في ذلك لآيات لقوم يسمعون" />
-		<aya index="24" text="ومن آياته يريكم البرق خوفا وطمعا وينزل من السماء ماء فيحيي به الأرض بعد موتها إن في ذلك لآيات لقوم يعقلون" />
-		<aya index="25" text="ومن آياته أن تقوم السماء والأرض بأمره ثم إذا دعاكم دعوة من الأرض إذا أنتم تخرجون" />
-		<aya index="26" text="وله من في السماوات والأرض كل له قانتون" />
-		<aya index="27" text="وهو الذي يبدأ الخلق ثم يعيده وهو أهون عليه وله المثل الأعلى في السماوات والأرض وهو العزيز الحكيم" />
-		<aya index="28" text="ضرب لكم مثلا من أنفسكم هل لكم من ما ملكت أيمانكم من شركاء في ما رزقناكم فأنتم فيه سواء تخافونهم كخيفتكم أنفسكم كذلك نفصل الآيات لقوم يعقلون" />
-		<aya index="29" text="بل اتبع الذين ظلموا أهواءهم بغير علم فمن يهدي من أضل الله وما لهم من ناصرين" />
-		<aya index="30" text="فأقم وجهك للدين حنيفا فطرت الله التي فطر الناس عليها لا تبديل لخلق الله ذلك الدين القيم ولكن أكثر الناس لا يعلمون" />
-		<aya index="31" text="منيبين إليه واتقوه وأقيموا الصلاة ولا تكونوا من المشركين" />
-		<aya index="32" text="من الذين فرقوا دينهم وكانوا شيعا كل حزب بما لديهم فرحون" />
-		<aya index="33" text="وإذا مس الناس ضر دعوا ربهم منيبين إليه ثم إذا أذاقهم منه رحمة إذا فريق منهم بربهم يشركون" />
-		<aya index="34" text="ليكفروا بما آتيناهم فتمتعوا فسوف تعلمون" />
-		<aya index="35" text="أم أنزلنا عليهم سلطانا فهو يتكلم بما كانوا به يشركون" />
-		<aya index="36" text="وإذا أذقنا الناس رحمة فرحوا بها وإن تصبهم سيئة بما قدمت أيديهم إذا هم يقنطون" />
-		<aya index="37" text="أولم يروا أن الله يبسط الرزق لمن يشاء ويقدر إن في ذلك لآيات لقوم يؤمنون" />
-		<aya index="38" text="فآت ذا القربى حقه والمسكين وابن السبيل ذلك خير للذين يريدون وجه الله وأولئك هم المفلحون" />
-		<aya index="39" text="وما آتيتم من ربا ليربو في أموال الناس فلا يربو عند الله وما آتيتم من زكاة تريدون وجه الله فأولئك هم المضعفون" />
-		<aya index="40" text="الله الذي خلقكم ثم رزقكم ثم يميتكم ثم يحييكم هل من شركائكم من يفعل من ذلكم من شيء سبحانه وتعالى عما يشركون" />
-		<aya index="41" text="ظهر الفساد في البر والبحر بما كسبت أيدي الناس ليذيقهم بعض الذي عملوا لعلهم يرجعون" />
-		<aya index="42" text="قل سيروا في الأرض فانظروا كيف كان عاقبة الذين من قبل كان أكثرهم مشركين" />
-		<aya index="43" text="فأقم وجهك للدين القيم من قبل أن يأتي يوم لا مرد له من الله يومئذ يصدعون" />
-		<aya index="44" text="من كفر فعليه كفره ومن عمل صالحا فلأنفسهم يمهدون" />
-		<aya index="45" text="ليجزي الذين آمنوا وعملوا الصالحات من فضله إنه لا يحب الكافرين" />
-		<aya index="46" text="ومن آياته أن يرسل الرياح مبشرات وليذيقكم من رحمته ولتجري الفلك بأمره ولتبتغوا من فضله ولعلكم تشكرون" />
-		<aya index="47" text="ولقد أرسلنا من قبلك رسلا إلى قومهم فجاءوهم بالبينات فانتقمنا من الذين أجرموا وكان حقا علينا نصر المؤمنين" />
-		<aya index="48" text="الله الذي يرسل الرياح فتثير سحابا فيبسطه في السماء كيف يشاء ويجعله كسفا فترى الودق يخرج من خلاله فإذا أصاب به من يشاء من عباده إذا هم يستبشرون" />
-		<aya index="49" text="وإن كانوا من قبل أن ينزل عليهم من قبله لمبلسين" />
-		<aya index="50" text="فانظر إلى آثار رحمت الله كيف يحيي الأرض بعد موتها إن ذلك لمحيي الموتى وهو على كل شيء قدير" />
-		<aya index="51" text="ولئن أرسلنا ريحا فرأوه مصفرا لظلوا من بعده يكفرون" />
-		<aya index="52" text="فإنك لا تسمع الموتى ولا تسمع الصم الدعاء إذا ولوا مدبرين" />
-		<aya index="53" text="وما أنت بهاد العمي عن ضلالتهم إن تسمع إلا من يؤمن بآياتنا فهم مسلمون" />
-		<aya index="54" text="الله الذي خلقكم من ضعف ثم جعل من بعد ضعف قوة ثم جعل من بعد قوة ضعفا وشيبة يخلق ما يشاء وهو العليم القدير" />
-		<aya index="55" text="ويوم تقوم الساعة يقسم المجرمون ما لبثوا غير ساعة كذلك كانوا يؤفكون" />
-		<aya index="56" text="وقال الذين أوتوا العلم والإيمان لقد لبثتم في كتاب الله إلى يوم البعث فهذا يوم البعث ولكنكم كنتم لا تعلمون" />
-		<aya index="57" text="فيومئذ لا ينفع الذين ظلموا معذرتهم ولا هم يستعتبون" />
-		<aya index="58" text="ولقد ضربنا للناس في هذا القرآن من كل مثل ولئن جئتهم بآية ليقولن الذين كفروا إن أنتم إلا مبطلون" />
-		<aya index="59" text="كذلك يطبع الله على قلوب الذين لا يعلمون" />
-		<aya index="60" text="فاصبر إن وعد الله حق ولا يستخفنك الذين لا يوقنون" />
-	</sura>
-	<sura index="31" name="لقمان">
-		<aya index="1" text="الم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="تلك آيات الكتاب الحكيم" />
-		<aya index="3" text="هدى ورحمة للمحسنين" />
-		<aya index="4" text="الذين يقيمون الصلاة ويؤتون الزكاة وهم بالآخرة هم يوقنون" />
-		<aya index="5" text="أولئك على هدى من ربهم وأولئك هم المفلحون" />
-		<aya index="6" text="ومن الناس من يشتري لهو الحديث ليضل عن سبيل الله بغير علم ويتخذها هزوا أولئك لهم عذاب مهين" />
-		<aya index="7" text="وإذا تتلى عليه آياتنا ولى مستكبرا كأن لم يسمعها كأن في أذنيه وقرا فبشره بعذاب أليم" />
-		<aya index="8" text="إن الذين آمنوا وعملوا الصالحات لهم جنات النعيم" />
-		<aya index="9" text="خالدين فيها وعد الله حقا وهو العزيز الحكيم" />
-		<aya index="10" text="خلق السماوات بغير عمد ترونها وألقى في الأرض رواسي أن تميد بكم وبث فيها من كل دابة وأنزلنا من السماء ماء فأنبتنا فيها من كل زوج كريم" />
-		<aya index="11" text="هذا خلق الله فأروني ماذا خلق الذين من دونه بل الظالمون في ضلال مبين" />
-		<aya index="12" text="ولقد آتينا لقمان الحكمة أن اشكر لله ومن يشكر فإنما يشكر لنفسه ومن كفر فإن الله غني حميد" />
-		<aya index="13" text="وإذ قال لقمان لابنه وهو يعظه يا بني لا تشرك بالله إن الشرك لظلم عظيم" />
-		<aya index="14" text="ووصينا الإنسان بوالديه حملته أمه وهنا على وهن وفصاله في عامين أن اشكر لي ولوالديك إلي المصير" />
-		<aya index="15" text="وإن جاهداك على أن تشرك بي ما ليس لك به علم فلا تطعهما وصاحبهما في الدنيا معروفا واتبع سبيل من أناب إلي ثم إلي مرجعكم فأنبئكم بما كنتم تعملون" />
-		<aya index="16" text="يا بني إنها إن تك مثقال حبة من خردل فتكن في صخرة أو في السماوات أو في الأرض يأت بها الله إن الله لطيف خبير" />
-		<aya index="17" text="يا بني أقم الصلاة وأمر بالمعروف وانه عن المنكر واصبر على ما أصابك إن ذلك من عزم الأمور" />
-		<aya index="18" text="ولا تصعر خدك للناس ولا تمش في الأرض مرحا إن الله لا يحب كل مختال فخور" />
-		<aya index="19" text="واقصد في مشيك واغضض من صوتك إن أنكر الأصوات لصوت الحمير" />
-		<aya index="20" text="ألم تروا أن الله سخر لكم ما في السماوات وما في الأرض وأسبغ عليكم نعمه ظاهرة وباطنة ومن الناس من يجادل في الله بغير علم ولا هدى ولا كتاب منير" />
-		<aya index="21" text="وإذا قيل لهم اتبعوا ما أنزل الله قالوا بل نتبع ما وجدنا عليه آباءنا أولو كان الشيطان يدعوهم إلى عذاب السعير" />
-		<aya index="22" text="ومن يسلم وجهه إلى الله وهو محسن فقد استمسك بالعروة الوثقى وإلى الله عاقبة الأمور" />
-		<aya index="23" text="ومن كفر فلا يحزنك كفره إلينا مرجعهم فننبئهم بما عملوا إن الله عليم بذات الصدور" />
-		<aya index="24" text="نمتعهم قليلا ثم نضطرهم إلى عذاب غليظ" />
-		<aya index="25" text="ولئن سألتهم من خلق السماوات والأرض ليقولن الله قل الحمد لله بل أكثرهم لا يعلمون" />
-		<aya index="26" text="لله ما في السماوات والأرض إن الله هو الغني الحميد" />
-		<aya index="27" text="ولو أنما في الأرض من شجرة أقلام والبحر يمده من بعده سبعة أبحر ما نفدت كلمات الله إن الله عزيز حكيم" />
-		<aya index="28" text="ما خلقكم ولا بعثكم إلا كنفس واحدة إن الله سميع بصير" />
-		<aya index="29" text="ألم تر أن الله يولج الليل في النهار ويولج النهار في الليل وسخر الشمس والقمر كل يجري إلى أجل مسمى وأن الله بما تعملون خبير" />
-		<aya index="30" text="ذلك بأن الله هو الحق وأن ما يدعون من دونه الباطل وأن الله هو العلي الكبير" />
-		<aya index="31" text="ألم تر أن الفلك تجري في البحر بنعمت الله ليريكم من آياته إن في ذلك لآيات لكل صبار شكور" />
-		<aya index="32" text="وإذا غشيهم موج كالظلل دعوا الله مخلصين له الدين فلما نجاهم إلى البر فمنهم مقتصد وما يجحد بآياتنا إلا كل ختار كفور" />
-		<aya index="33" text="يا أيها الناس اتقوا ربكم واخشوا يوما لا يجزي والد عن ولده ولا مولود هو جاز عن والده شيئا إن وعد الله حق فلا تغرنكم الحياة الدنيا ولا يغرنكم بالله الغرور" />
-		<aya index="34" text="إن الله عنده علم الساعة وينزل الغيث ويعلم ما في الأرحام وما تدري نفس ماذا تكسب غدا وما تدري نفس بأي أرض تموت إن الله عليم خبير" />
-	</sura>
-	<sura index="32" name="السجدة">
-		<aya index="1" text="الم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="تنزيل الكتاب لا ريب فيه من رب العالمين" />
-		<aya index="3" text="أم يقولون افتراه بل هو الحق من ربك لتنذر قوما ما أتاهم من نذير من قبلك لعلهم يهتدون" />
-		<aya index="4" text="الله الذي خلق السماوات والأرض وما بينهما في ستة أيام ثم استوى على العرش ما لكم من دونه من ولي ولا شفيع أفلا تتذكرون" />
-		<aya index="5" text="يدبر الأمر من السماء إلى الأرض ثم يعرج إليه في يوم كان مقداره ألف سنة مما تعدون" />
-		<aya index="6" text="ذلك عالم الغيب والشهادة العزيز الرحيم" />
-		<aya index="7" text="الذي أحسن كل شيء خلقه وبدأ خلق الإنسان من طين" />
-		<aya index="8" text="ثم جعل نسله من سلالة من ماء مهين" />
-		<aya index="9" text="ثم سواه ونفخ فيه من روحه وجعل لكم السمع والأبصار والأفئدة قليلا ما تشكرون" />
-		<aya index="10" text="وقالوا أإذا ضللنا في الأرض أإنا لفي خلق جديد بل هم بلقاء ربهم كافرون" />
-		<aya index="11" text="قل يتوفاكم ملك الموت الذي وكل بكم ثم إلى ربكم ترجعون" />
-		<aya index="12" text="ولو ترى إذ المجرمون ناكسو رءوسهم عند ربهم ربنا أبصرنا وسمعنا فارجعنا نعمل صالحا إنا موقنون" />
-		<aya index="13" text="ولو شئنا لآتينا كل نفس هداها ولكن حق القول مني لأملأن جهنم من الجنة والناس أجمعين" />
-		<aya index="14" text="فذوقوا بما نسيتم لقاء يومكم هذا إنا نسيناكم وذوقوا عذاب الخلد بما كنتم تعملون" />
-		<aya index="15" text="إنما يؤمن بآياتنا الذين إذا ذكروا بها خروا سجدا وسبحوا بحمد ربهم وهم لا يستكبرون" />
-		<aya index="16" text="تتجافى جنوبهم عن المضاجع يدعون ربهم خوفا وطمعا ومما رزقناهم ينفقون" />
-		<aya index="17" text="فلا تعلم نفس ما أخفي لهم من قرة أعين جزاء بما كانوا يعملون" />
-		<aya index="18" text="أفمن كان مؤمنا كمن كان فاسقا لا يستوون" />
-		<aya index="19" text="أما الذين آمنوا وعملوا الصالحات فلهم جنات المأوى نزلا بما كانوا يعملون" />
-		<aya index="20" text="وأما الذين فسقوا فمأواهم النار كلما أرادوا أن يخرجوا منها أعيدوا فيها وقيل لهم ذوقوا عذاب النار الذي كنتم به تكذبون" />
-		<aya index="21" text="ولنذيقنهم من العذاب الأدنى دون العذاب الأكبر لعلهم يرجعون" />
-		<aya index="22" text="ومن أظلم ممن ذكر بآيات ربه ثم أعرض عنها إنا من المجرمين منتقمون" />
-		<aya index="23" text="ولقد آتينا موسى الكتاب فلا تكن في مرية من لقائه وجعلناه هدى لبني إسرائيل" />
-		<aya index="24" text="وجعلنا منهم أئمة يهدون بأمرنا لما صبروا وكانوا بآياتنا يوقنون" />
-		<aya index="25" text="إن ربك هو يفصل بينهم يوم القيامة فيما كانوا فيه يختلفون" />
-		<aya index="26" text="أولم يهد لهم كم أهلكنا من قبلهم من القرون يمشون في مساكنهم إن في ذلك لآيات أفلا يسمعون" />
-		<aya index="27" text="أولم يروا أنا نسوق الماء إلى الأرض الجرز فنخرج به زرعا تأكل منه أنعامهم وأنفسهم أفلا يبصرون" />
-		<aya index="28" text="ويقولون متى هذا الفتح إن كنتم صادقين" />
-		<aya index="29" text="قل يوم الفتح لا ينفع الذين كفروا إيمانهم ولا هم ينظرون" />
-		<aya index="30" text="فأعرض عنهم وانتظر إنهم منتظرون" />
-	</sura>
-	<sura index="33" name="الأحزاب">
-		<aya index="1" text="يا أيها النبي اتق الله ولا تطع الكافرين والمنافقين إن الله كان عليما حكيما" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="واتبع ما يوحى إليك من ربك إن الله كان بما تعملون خبيرا" />
-		<aya index="3" text="وتوكل على الله وكفى بالله وكيلا" />
-		<aya index="4" text="ما جعل الله لرجل من قلبين في جوفه وما جعل أزواجكم اللائي تظاهرون منهن أمهاتكم وما جعل أدعياءكم أبناءكم ذلكم قولكم بأفواهكم والله يقول الحق وهو يهدي السبيل" />
-		<aya index="5" text="ادعوهم لآبائهم هو أقسط عند الله فإن لم تعلموا آباءهم فإخوانكم في الدين ومواليكم وليس عليكم جناح فيما أخطأتم به ولكن ما تعمدت قلوبكم وكان الله غفورا رحيما" />
-		<aya index="6" text="النبي أولى بالمؤمنين من أنفسهم وأزواجه أمهاتهم وأولو الأرحام بعضهم أولى ببعض في كتاب الله من المؤمنين والمهاجرين إلا أن تفعلوا إلى أوليائكم معروفا كان ذلك في الكتاب مسطورا" />
-		<aya index="7" text="وإذ أخذنا من النبيين ميثاقهم ومنك ومن نوح وإبراهيم وموسى وعيسى ابن مريم وأخذنا منهم ميثاقا غليظا" />
-		<aya index="8" text="ليسأل الصادقين عن صدقهم وأعد للكافرين عذابا أليما" />
-		<aya index="9" text="يا أيها الذين آمنوا اذكروا نعمة الله عليكم إذ جاءتكم جنود فأرسلنا عليهم ريحا وجنودا لم تروها وكان الله بما تعملون بصيرا" />
-		<aya index="10" text="إذ جاءوكم من فوقكم ومن أسفل منكم وإذ زاغت الأبصار وبلغت القلوب الحناجر وتظنون بالله الظنونا" />
-		<aya index="11" text="هنالك ابتلي المؤمنون وزلزلوا زلزالا شديدا" />
-		<aya index="12" text="وإذ يقول المنافقون والذين في قلوبهم مرض ما وعدنا الله ورسوله إلا غرورا" />
-		<aya index="13" text="وإذ قالت طائفة منهم يا أهل يثرب لا مقام لكم فارجعوا ويستأذن فريق منهم النبي يقولون إن بيوتنا عورة وما هي بعورة إن يريدون إلا فرارا" />
-		<aya index="14" text="ولو دخلت عليهم من أقطارها ثم سئلوا الفتنة لآتوها وما تلبثوا بها إلا يسيرا" />
-		<aya index="15" text="ولقد كانوا عاهدوا الله من قبل لا يولون الأدبار وكان عهد الله مسئولا" />
-		<aya index="16" text="قل لن ينفعكم الفرار إن فررتم من الموت أو القتل وإذا لا تمتعون إلا قليلا" />
-		<aya index="17" text="قل من ذا الذي يعصمكم من الله إن أراد بكم سوءا أو أراد بكم رحمة ولا يجدون لهم من دون الله وليا ولا نصيرا" />
-		<aya index="18" text="قد يعلم الله المعوقين منكم والقائلين لإخوانهم هلم إلينا ولا يأتون البأس إلا قليلا" />
-		<aya index="19" text="أشحة عليكم فإذا جاء الخوف رأيتهم ينظرون إليك تدور أعينهم كالذي يغشى عليه من الموت فإذا ذهب الخوف سلقوكم بألسنة حداد أشحة على الخير أولئك لم يؤمنوا فأحبط الله أعمالهم وكان ذلك على الله يسيرا" />
-		<aya index="20" text="يحسبون الأحزاب لم يذهبوا وإن يأت الأحزاب يودوا لو أنهم بادون في الأعراب يسألون عن أنبائكم ولو كانوا فيكم ما قاتلوا إلا قليلا" />
-		<aya index="21" text="لقد كان لكم في رسول الله أسوة حسنة لمن كان يرجو الله واليوم الآخر وذكر الله كثيرا" />
-		<aya index="22" text="ولما رأى المؤمنون الأحزاب قالوا هذا ما وعدنا الله ورسوله وصدق الله ورسوله وما زادهم إلا إيمانا وتسليما" />
-		<aya index="23" text="من المؤمنين رجال صدقوا ما عاهدوا الله عليه فمنهم من قضى نحبه ومنهم من ينتظر وما بدلوا تبديلا" />
-		<aya index="24" text="ليجزي الله الصادقين بصدقهم ويعذب المنافقين إن شاء أو يتوب عليهم إن الله كان غفورا رحيما" />
-		<aya index="25" text="ورد الله الذين كفروا بغيظهم لم ينالوا خيرا وكفى الله المؤمنين القتال وكان الله قويا عزيزا" />
-		<aya index="26" text="وأنزل الذين ظاهروهم من أهل الكتاب من صياصيهم وقذف في قلوبهم الرعب فريقا تقتلون وتأسرون فريقا" />
-		<aya index="27" text="وأورثكم أرضهم وديارهم وأموالهم وأرضا لم تطئوها وكان الله على كل شيء قديرا" />
-		<aya index="28" text="يا أيها النبي قل لأزواجك إن كنتن تردن الحياة الدنيا وزينتها فتعالين أمتعكن وأسرحكن سراحا جميلا" />
-		<aya index="29" text="وإن كنتن تردن الله ورسوله والدار الآخرة فإن الله أعد للمحسنات منكن أجرا عظيما" />
-		<aya index="30" text="يا نساء النبي من يأت منكن بفاحشة مبينة يضاعف لها العذاب ضعفين وكان ذلك على الله يسيرا" />
-		<aya index="31" text="ومن يقنت منكن لله ورسوله وتعمل صالحا نؤتها أجرها مرتين وأعتدنا لها رزقا كريما" />
-		<aya index="32" text="يا نساء النبي لستن كأحد من النساء إن اتقيتن فلا تخضعن بالقول فيطمع الذي في قلبه مرض وقلن قولا معروفا" />
-		<aya index="33" text="وقرن في بيوتكن ولا تبرجن تبرج الجاهلية الأولى وأقمن الصلاة وآتين الزكاة وأطعن الله ورسوله إنما يريد الله ليذهب عنكم الرجس أهل البيت ويطهركم تطهيرا" />
-		<aya index="34" text="واذكرن ما يتلى في بيوتكن من آيات الله والحكمة إن الله كان لطيفا خبيرا" />
-		<aya index="35" text="إن المسلمين والمسلمات والمؤمنين والمؤمنات والقانتين والقانتات والصادقين والصادقات والصابرين والصابرات والخاشعين والخاشعات والمتصدقين والمتصدقات والصائمين والصائمات والحافظين فروجهم والحافظات والذاكرين الله كثيرا والذاكرات أعد الله لهم مغفرة وأجرا عظيما" />
-		<aya index="36" text="وما كان لمؤمن ولا مؤمنة إذا قضى الله ورسوله أمرا أن يكون لهم الخيرة من أمرهم ومن يعص الله ورسوله فقد ضل ضلالا مبينا" />
-		<aya index="37" text="وإذ تقول للذي أنعم الله عليه وأنعمت عليه أمسك عليك زوجك واتق الله وتخفي في نفسك ما الله مبديه وتخشى الناس والله أحق أن تخشاه فلما قضى زيد منها وطرا زوجناكها لكي لا يكون على المؤمنين حرج في أزواج أدعيائهم إذا قضوا منهن وطرا وكان أمر الله مفعولا" />
-		<aya index="38" text="ما كان على النبي من حرج فيما فرض الله له سنة الله في الذين خلوا من قبل وكان أمر الله قدرا مقدورا" />
-		<aya index="39" text="الذين يبلغون رسالات الله ويخشونه ولا يخشون أحدا إلا الله وكفى بالله حسيبا" />
-		<aya index="40" text="ما كان محمد أبا أحد من رجالكم ولكن رسول الله وخاتم النبيين وكان الله بكل شيء عليما" />
-		<aya index="41" text="يا أيها الذين آمنوا اذكروا الله ذكرا كثيرا" />
-		<aya index="42" text="وسبحوه بكرة وأصيلا" />
-		<aya index="43" text="هو الذي يصلي عليكم وملائكته ليخرجكم من الظلمات إلى النور وكان بالمؤمنين رحيما" />
-		<aya index="44" text="تحيتهم يوم يلقونه سلام وأعد لهم أجرا كريما" />
-		<aya index="45" text="يا أيها النبي إنا أرسلناك شاهدا ومبشرا ونذيرا" />
-		<aya index="46" text="وداعيا إلى الله بإذنه وسراجا منيرا" />
-		<aya index="47" text="وبشر المؤمنين بأن لهم من الله فضلا كبيرا" />
-		<aya index="48" text="ولا تطع الكافرين والمنافقين ودع أذاهم وتوكل على الله وكفى بالله وكيلا" />
-		<aya index="49" text="يا أيها الذين آمنوا إذا نكحتم المؤمنات ثم طلقتموهن من قبل أن تمسوهن فما لكم عليهن من عدة تعتدونها فمتعوهن وسرحوهن سراحا جميلا" />
-		<aya index="50" text="يا أيها النبي إنا أحللنا لك أزواجك اللاتي آتيت أجورهن وما ملكت يمينك مما أفاء الله عليك وبنات عمك وبنات عماتك وبنات خالك وبنات خالاتك اللاتي هاجرن معك وامرأة مؤمنة إن وهبت نفسها للنبي إن أراد النبي أن يستنكحها خالصة لك من دون المؤمنين قد علمنا ما فرضنا عليهم في أزواجهم وما ملكت أيمانهم لكيلا يكون عليك حرج وكان الله غفورا رحيما" />
-		<aya index="51" text="ترجي من تشاء منهن وتؤوي إليك من تشاء ومن ابتغيت ممن عزلت فلا جناح عليك ذلك أدنى أن تقر أعينهن ولا يحزن ويرضين بما آتيتهن كلهن والله يعلم ما في قلوبكم وكان الله عليما حليما" />
-		<aya index="52" text="لا يحل لك النساء من بعد ولا أن تبدل بهن من أزواج ولو أعجبك حسنهن إلا ما ملكت يمينك وكان الله على كل شيء رقيبا" />
-		<aya index="53" text="يا أيها الذين آمنوا لا تدخلوا بيوت النبي إلا أن يؤذن لكم إلى طعام غير ناظرين إناه ولكن إذا دعيتم فادخلوا فإذا طعمتم فانتشروا ولا مستأنسين لحديث إن ذلكم كان يؤذي النبي فيستحيي منكم والله لا يستحيي من الحق وإذا سألتموهن متاعا فاسألوهن من وراء حجاب ذلكم أطهر لقلوبكم وقلوبهن وما كان لكم أن تؤذوا رسول الله ولا أن تنكحوا أزواجه من بعده أبدا إن ذلكم كان عند الله عظيما" />
-		<aya index="54" text="إن تبدوا شيئا أو تخفوه فإن الله كان بكل شيء عليما" />
-		<aya index="55" text="لا جناح عليهن في آبائهن ولا أبنائهن ولا إخوانهن ولا أبناء إخوانهن ولا أبناء أخواتهن ولا نسائهن ولا ما ملكت أيمانهن واتقين الله إن الله كان على كل شيء شهيدا" />
-		<aya index="56" text="إن الله وملائكته يصلون على النبي يا أيها الذين آمنوا صلوا عليه وسلموا تسليما" />
-		<aya index="57" text="إن الذين يؤذون الله ورسوله لعنهم الله في الدنيا والآخرة وأعد لهم عذابا مهينا" />
-		<aya index="58" text="والذين يؤذون المؤمنين والمؤمنات بغير ما اكتسبوا فقد احتملوا بهتانا وإثما مبينا" />
-		<aya index="59" text="يا أيها النبي قل لأزواجك وبناتك ونساء المؤمنين يدنين عليهن من جلابيبهن ذلك أدنى أن يعرفن فلا يؤذين وكان الله غفورا رحيما" />
-		<aya index="60" text="لئن لم ينته المنافقون والذين في قلوبهم مرض والمرجفون في المدينة لنغرينك بهم ثم لا يجاورونك فيها إلا قليلا" />
-		<aya index="61" text="ملعونين أينما ثقفوا أخذوا وقتلوا تقتيلا" />
-		<aya index="62" text="سنة الله في الذين خلوا من قبل ولن تجد لسنة الله تبديلا" />
-		<aya index="63" text="يسألك الناس عن الساعة قل إنما علمها عند الله وما يدريك لعل الساعة تكون قريبا" />
-		<aya index="64" text="إن الله لعن الكافرين وأعد لهم سعيرا" />
-		<aya index="65" text="خالدين فيها أبدا لا يجدون وليا ولا نصيرا" />
-		<aya index="66" text="يوم تقلب وجوههم في النار يقولون يا ليتنا أطعنا الله وأطعنا الرسولا" />
-		<aya index="67" text="وقالوا ربنا إنا أطعنا سادتنا وكبراءنا فأضلونا السبيلا" />
-		<aya index="68" text="ربنا آتهم ضعفين من العذاب والعنهم لعنا كبيرا" />
-		<aya index="69" text="يا أيها الذين آمنوا لا تكونوا كالذين آذوا موسى فبرأه الله مما قالوا وكان عند الله وجيها" />
-		<aya index="70" text="يا أيها الذين آمنوا اتقوا الله وقولوا قولا سديدا" />
-		<aya index="71" text="يصلح لكم أعمالكم ويغفر لكم ذنوبكم ومن يطع الله ورسوله فقد فاز فوزا عظيما" />
-		<aya index="72" text="إنا عرضنا الأمانة على السماوات والأرض والجبال فأبين أن يحملنها وأشفقن منها وحملها الإنسان إنه كان ظلوما جهولا" />
-		<aya index="73" text="ليعذب الله المنافقين والمنافقات والمشركين والمشركات ويتوب الله على المؤمنين والمؤمنات وكان الله غفورا رحيما" />
-	</sura>
-	<sura index="34" name="سبإ">
-		<aya index="1" text="الحمد لله الذي له ما في السماوات وما في الأرض وله الحمد في الآخرة وهو الحكيم الخبير" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="يعلم ما يلج في الأرض وما يخرج منها وما ينزل من السماء وما يعرج فيها وهو الرحيم الغفور" />
-		<aya index="3" text="وقال الذين كفروا لا تأتينا الساعة قل بلى وربي لتأتينكم عالم الغيب لا يعزب عنه مثقال ذرة في السماوات ولا في الأرض ولا أصغر من ذلك ولا أكبر إلا في كتاب مبين" />
-		<aya index="4" text="ليجزي الذين آمنوا وعملوا الصالحات أولئك لهم مغفرة ورزق كريم" />
-		<aya index="5" text="والذين سعوا في آياتنا معاجزين أولئك لهم عذاب من رجز أليم" />
-		<aya index="6" text="ويرى الذين أوتوا العلم الذي أنزل إليك من ربك هو الحق ويهدي إلى صراط العزيز الحميد" />
-		<aya index="7" text="وقال الذين كفروا هل ندلكم على رجل ينبئكم إذا مزقتم كل ممزق إنكم لفي خلق جديد" />
-		<aya index="8" text="أفترى على الله كذبا أم به جنة بل الذين لا يؤمنون بالآخرة في العذاب والضلال البعيد" />
-		<aya index="9" text="أفلم يروا إلى ما بين أيديهم وما خلفهم من السماء والأرض إن نشأ نخسف بهم الأرض أو نسقط عليهم كسفا من السماء إن في ذلك لآية لكل عبد منيب" />
-		<aya index="10" text="ولقد آتينا داوود منا فضلا يا جبال أوبي معه والطير وألنا له الحديد" />
-		<aya index="11" text="أن اعمل سابغات وقدر في السرد واعملوا صالحا إني بما تعملون بصير" />
-		<aya index="12" text="ولسليمان الريح غدوها شهر ورواحها شهر وأسلنا له عين القطر ومن الجن من يعمل بين يديه بإذن ربه ومن يزغ منهم عن أمرنا نذقه من عذاب السعير" />
-		<aya index="13" text="يعملون له ما يشاء من محاريب وتماثيل وجفان كالجواب وقدور راسيات اعملوا آل داوود شكرا وقليل من عبادي الشكور" />
-		<aya index="14" text="فلما قضينا عليه الموت ما دلهم على موته إلا دابة الأرض تأكل منسأته فلما خر تبينت الجن أن لو كانوا يعلمون الغيب ما لبثوا في العذاب المهين" />
-		<aya index="15" text="لقد كان لسبإ في مسكنهم آية جنتان عن يمين وشمال كلوا من رزق ربكم واشكروا له بلدة طيبة ورب غفور" />
-		<aya index="16" text="فأعرضوا فأرسلنا عليهم سيل العرم وبدلناهم بجنتيهم جنتين ذواتي أكل خمط وأثل وشيء من سدر قليل" />
-		<aya index="17" text="ذلك جزيناهم بما كفروا وهل نجازي إلا الكفور" />
-		<aya index="18" text="وجعلنا بينهم وبين القرى التي باركنا فيها قرى ظاهرة وقدرنا فيها السير سيروا فيها ليالي وأياما آمنين" />
-		<aya index="19" text="فقالوا ربنا باعد بين أسفارنا وظلموا أنفسهم فجعلناهم أحاديث ومزقناهم كل ممزق إن في ذلك لآيات لكل صبار شكور" />
-		<aya index="20" text="ولقد صدق عليهم إبليس ظنه فاتبعوه إلا فريقا من المؤمنين" />
-		<aya index="21" text="وما كان له عليهم من سلطان إلا لنعلم من يؤمن بالآخرة ممن هو منها في شك وربك على كل شيء حفيظ" />
-		<aya index="22" text="قل ادعوا الذين زعمتم من دون الله لا يملكون مثقال ذرة في السماوات ولا في الأرض وما لهم فيهما من شرك وما له منهم من ظهير" />
-		<aya index="23" text="ولا تنفع الشفاعة عنده إلا لمن أذن له حتى إذا فزع عن قلوبهم قالوا ماذا قال ربكم قالوا الحق وهو العلي الكبير" />
-		<aya index="24" text="قل من يرزقكم من السماوات والأرض قل الله وإنا أو إياكم لعلى هدى أو في ضلال مبين" />
-		<aya index="25" text="قل لا تسألون عما أجرمنا ولا نسأل عما تعملون" />
-		<aya index="26" text="قل يجمع بيننا ربنا ثم يفتح بيننا بالحق وهو الفتاح العليم" />
-		<aya index="27" text="قل أروني الذين ألحقتم به شركاء كلا بل هو الله العزيز الحكيم" />
-		<aya index="28" text="وما أرسلناك إلا كافة للناس بشيرا ونذيرا ولكن أكثر الناس لا يعلمون" />
-		<aya index="29" text="ويقولون متى هذا الوعد إن كنتم صادقين" />
-		<aya index="30" text="قل لكم ميعاد يوم لا تستأخرون عنه ساعة ولا تستقدمون" />
-		<aya index="31" text="وقال الذين كفروا لن نؤمن بهذا القرآن ولا بالذي بين يديه ولو ترى إذ الظالمون موقوفون عند ربهم يرجع بعضهم إلى بعض القول يقول الذين استضعفوا للذين استكبروا لولا أنتم لكنا مؤمنين" />
-		<aya index="32" text="قال الذين استكبروا للذين استضعفوا أنحن صددناكم عن الهدى بعد إذ جاءكم بل كنتم مجرمين" />
-		<aya index="33" text="وقال الذين استضعفوا للذين استكبروا بل مكر الليل والنهار إذ تأمروننا أن نكفر بالله ونجعل له أندادا وأسروا الندامة لما رأوا العذاب وجعلنا الأغلال في أعناق الذين كفروا هل يجزون إلا ما كانوا يعملون" />
-		<aya index="34" text="وما أرسلنا في قرية من نذير إلا قال مترفوها إنا بما أرسلتم به كافرون" />
-		<aya index="35" text="وقالوا نحن أكثر أموالا وأولادا وما نحن بمعذبين" />
-		<aya index="36" text="قل إن ربي يبسط الرزق لمن يشاء ويقدر ولكن أكثر الناس لا يعلمون" />
-		<aya index="37" text="وما أموالكم ولا أولادكم بالتي تقربكم عندنا زلفى إلا من آمن وعمل صالحا فأولئك لهم جزاء الضعف بما عملوا وهم في الغرفات آمنون" />
-		<aya index="38" text="والذين يسعون في آياتنا معاجزين أولئك في العذاب محضرون" />
-		<aya index="39" text="قل إن ربي يبسط الرزق لمن يشاء من عباده ويقدر له وما أنفقتم من شيء فهو يخلفه وهو خير الرازقين" />
-		<aya index="40" text="ويوم يحشرهم جميعا ثم يقول للملائكة أهؤلاء إياكم كانوا يعبدون" />
-		<aya index="41" text="قالوا سبحانك أنت ولينا من دونهم بل كانوا يعبدون الجن أكثرهم بهم مؤمنون" />
-		<aya index="42" text="فاليوم لا يملك بعضكم لبعض نفعا ولا ضرا ونقول للذين ظلموا ذوقوا عذاب النار التي كنتم بها تكذبون" />
-		<aya index="43" text="وإذا تتلى عليهم آياتنا بينات قالوا ما هذا إلا رجل يريد أن يصدكم عما كان يعبد آباؤكم وقالوا ما هذا إلا إفك مفترى وقال الذين كفروا للحق لما جاءهم إن هذا إلا سحر مبين" />
-		<aya index="44" text="وما آتيناهم من كتب يدرسونها وما أرسلنا إليهم قبلك من نذير" />
-		<aya index="45" text="وكذب الذين من قبلهم وما بلغوا معشار ما آتيناهم فكذبوا رسلي فكيف كان نكير" />
-		<aya index="46" text="قل إنما أعظكم بواحدة أن تقوموا لله مثنى وفرادى ثم تتفكروا ما بصاحبكم من جنة إن هو إلا نذير لكم بين يدي عذاب شديد" />
-		<aya index="47" text="قل ما سألتكم من أجر فهو لكم إن أجري إلا على الله وهو على كل شيء شهيد" />
-		<aya index="48" text="قل إن ربي يقذف بالحق علام الغيوب" />
-		<aya index="49" text="قل جاء الحق وما يبدئ الباطل وما يعيد" />
-		<aya index="50" text="قل إن ضللت فإنما أضل على نفسي وإن اهتديت فبما يوحي إلي ربي إنه سميع قريب" />
-		<aya index="51" text="ولو ترى إذ فزعوا فلا فوت وأخذوا من مكان قريب" />
-		<aya index="52" text="وقالوا آمنا به وأنى لهم التناوش من مكان بعيد" />
-		<aya index="53" text="وقد كفروا به من قبل ويقذفون بالغيب من مكان بعيد" />
-		<aya index="54" text="وحيل بينهم وبين ما يشتهون كما فعل بأشياعهم من قبل إنهم كانوا في شك مريب" />
-	</sura>
-	<sura index="35" name="فاطر">
-		<aya index="1" text="الحمد لله فاطر السماوات والأرض جاعل الملائكة رسلا أولي أجنحة مثنى وثلاث ورباع يزيد في الخلق ما يشاء إن الله على كل شيء قدير" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ما يفتح الله للناس من رحمة فلا ممسك لها وما يمسك فلا مرسل له من بعده وهو العزيز الحكيم" />
-		<aya index="3" text="يا أيها الناس اذكروا نعمت الله عليكم هل من خالق غير الله يرزقكم من السماء والأرض لا إله إلا هو فأنى تؤفكون" />
-		<aya index="4" text="وإن يكذبوك فقد كذبت رسل من قبلك وإلى الله ترجع الأمور" />
-		<aya index="5" text="يا أيها الناس إن وعد الله حق فلا تغرنكم الحياة الدنيا ولا يغرنكم بالله الغرور" />
-		<aya index="6" text="إن الشيطان لكم عدو فاتخذوه عدوا إنما يدعو حزبه ليكونوا من أصحاب السعير" />
-		<aya index="7" text="الذين كفروا لهم عذاب شديد والذين آمنوا وعملوا الصالحات لهم مغفرة وأجر كبير" />
-		<aya index="8" text="أفمن زين له سوء عمله فرآه حسنا فإن الله يضل من يشاء ويهدي من يشاء فلا تذهب نفسك عليهم حسرات إن الله عليم بما يصنعون" />
-		<aya index="9" text="والله الذي أرسل الرياح فتثير سحابا فسقناه إلى بلد ميت فأحيينا به الأرض بعد موتها كذلك النشور" />
-		<aya index="10" text="من كان يريد العزة فلله العزة جميعا إليه يصعد الكلم الطيب والعمل الصالح يرفعه والذين يمكرون السيئات لهم عذاب شديد ومكر أولئك هو يبور" />
-		<aya index="11" text="والله خلقكم من تراب ثم من نطفة ثم جعلكم أزواجا وما تحمل من أنثى ولا تضع إلا بعلمه وما يعمر من معمر ولا ينقص من عمره إلا في كتاب إن ذلك على الله يسير" />
-		<aya index="12" text="وما يستوي البحران هذا عذب فرات سائغ شرابه وهذا ملح أجاج ومن كل تأكلون لحما طريا وتستخرجون حلية تلبسونها وترى الفلك فيه مواخر لتبتغوا من فضله ولعلكم تشكرون" />
-		<aya index="13" text="يولج الليل في النهار ويولج النهار في الليل وسخر الشمس والقمر كل يجري لأجل مسمى ذلكم الله ربكم له الملك والذين تدعون من دونه ما يملكون من قطمير" />
-		<aya index="14" text="إن تدعوهم لا يسمعوا دعاءكم ولو سمعوا ما استجابوا لكم ويوم القيامة يكفرون بشرككم ولا ينبئك مثل خبير" />
-		<aya index="15" text="يا أيها الناس أنتم الفقراء إلى الله والله هو الغني الحميد" />
-		<aya index="16" text="إن يشأ يذهبكم ويأت بخلق جديد" />
-		<aya index="17" text="وما ذلك على الله بعزيز" />
-		<aya index="18" text="ولا تزر وازرة وزر أخرى وإن تدع مثقلة إلى حملها لا يحمل منه شيء ولو كان ذا قربى إنما تنذر الذين يخشون ربهم بالغيب وأقاموا الصلاة ومن تزكى فإنما يتزكى لنفسه وإلى الله المصير" />
-		<aya index="19" text="وما يستوي الأعمى والبصير" />
-		<aya index="20" text="ولا الظلمات ولا النور" />
-		<aya index="21" text="ولا الظل ولا الحرور" />
-		<aya index="22" text="وما يستوي الأحياء ولا الأموات إن الله يسمع من يشاء وما أنت بمسمع من في القبور" />
-		<aya index="23" text="إن أنت إلا نذير" />
-		<aya index="24" text="إنا أرسلناك بالحق بشيرا ونذيرا وإن من أمة إلا خلا فيها نذير" />
-		<aya index="25" text="وإن يكذبوك فقد كذب الذين من قبلهم جاءتهم رسلهم بالبينات وبالزبر وبالكتاب المنير" />
-		<aya index="26" text="ثم أخذت الذين كفروا فكيف كان نكير" />
-		<aya index="27" text="ألم تر أن الله أنزل من السماء ماء فأخرجنا به ثمرات مختلفا ألوانها ومن الجبال جدد بيض وحمر مختلف ألوانها وغرابيب سود" />
-		<aya index="28" text="ومن الناس والدواب والأنعام مختلف ألوانه كذلك إنما يخشى الله من عباده العلماء إن الله عزيز غفور" />
-		<aya index="29" text="إن الذين يتلون كتاب الله وأقاموا الصلاة وأنفقوا مما رزقناهم سرا وعلانية يرجون تجارة لن تبور" />
-		<aya index="30" text="ليوفيهم أجورهم ويزيدهم من فضله إنه غفور شكور" />
-		<aya index="31" text="والذي أوحينا إليك من الكتاب هو الحق مصدقا لما بين يديه إن الله بعباده لخبير بصير" />
-		<aya index="32" text="ثم أورثنا الكتاب الذين اصطفينا من عبادنا فمنهم ظالم لنفسه ومنهم مقتصد ومنهم سابق بالخيرات بإذن الله ذلك هو الفضل الكبير" />
-		<aya index="33" text="جنات عدن يدخلونها يحلون فيها من أساور من ذهب ولؤلؤا ولباسهم فيها حرير" />
-		<aya index="34" text="وقالوا الحمد لله الذي أذهب عنا الحزن إن ربنا لغفور شكور" />
-		<aya index="35" text="الذي أحلنا دار المقامة من فضله لا يمسنا فيها نصب ولا يمسنا فيها لغوب" />
-		<aya index="36" text="والذين كفروا لهم نار جهنم لا يقضى عليهم فيموتوا ولا يخفف عنهم من عذابها كذلك نجزي كل كفور" />
-		<aya index="37" text="وهم يصطرخون فيها ربنا أخرجنا نعمل صالحا غير الذي كنا نعمل أولم نعمركم ما يتذكر فيه من تذكر وجاءكم النذير فذوقوا فما للظالمين من نصير" />
-		<aya index="38" text="إن الله عالم غيب السماوات والأرض إنه عليم بذات الصدور" />
-		<aya index="39" text="هو الذي جعلكم خلائف في الأرض فمن كفر فعليه كفره ولا يزيد الكافرين كفرهم عند ربهم إلا مقتا ولا يزيد الكافرين كفرهم إلا خسارا" />
-		<aya index="40" text="قل أرأيتم شركاءكم الذين تدعون من دون الله أروني ماذا خلقوا من الأرض أم لهم شرك في السماوات أم آتيناهم كتابا فهم على بينت منه بل إن يعد الظالمون بعضهم بعضا إلا غرورا" />
-		<aya index="41" text="إن الله يمسك السماوات والأرض أن تزولا ولئن زالتا إن أمسكهما من أحد من بعده إنه كان حليما غفورا" />
-		<aya index="42" text="وأقسموا بالله جهد أيمانهم لئن جاءهم نذير ليكونن أهدى من إحدى الأمم فلما جاءهم نذير ما زادهم إلا نفورا" />
-		<aya index="43" text="استكبارا في الأرض ومكر السيئ ولا يحيق المكر السيئ إلا بأهله فهل ينظرون إلا سنت الأولين فلن تجد لسنت الله تبديلا ولن تجد لسنت الله تحويلا" />
-		<aya index="44" text="أولم يسيروا في الأرض فينظروا كيف كان عاقبة الذين من قبلهم وكانوا أشد منهم قوة وما كان الله ليعجزه من شيء في السماوات ولا في الأرض إنه كان عليما قديرا" />
-		<aya index="45" text="ولو يؤاخذ الله الناس بما كسبوا ما ترك على ظهرها من دابة ولكن يؤخرهم إلى أجل مسمى فإذا جاء أجلهم فإن الله كان بعباده بصيرا" />
-	</sura>
-	<sura index="36" name="يس">
-		<aya index="1" text="يس" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="والقرآن الحكيم" />
-		<aya index="3" text="إنك لمن المرسلين" />
-		<aya index="4" text="على صراط مستقيم" />
-		<aya index="5" text="تنزيل العزيز الرحيم" />
-		<aya index="6" text="لتنذر قوما ما أنذر آباؤهم فهم غافلون" />
-		<aya index="7" text="لقد حق القول على أكثرهم فهم لا يؤمنون" />
-		<aya index="8" text="إنا جعلنا في أعناقهم أغلالا فهي إلى الأذقان فهم مقمحون" />
-		<aya index="9" text="وجعلنا من بين أيديهم سدا ومن خلفهم سدا فأغشيناهم فهم لا يبصرون" />
-		<aya index="10" text="وسواء عليهم أأنذرتهم أم لم تنذرهم لا يؤمنون" />
-		<aya index="11" text="إنما تنذر من اتبع الذكر وخشي الرحمن بالغيب فبشره بمغفرة وأجر كريم" />
-		<aya index="12" text="إنا نحن نحيي الموتى ونكتب ما قدموا وآثارهم وكل شيء أحصيناه في إمام مبين" />
-		<aya index="13" text="واضرب لهم مثلا أصحاب القرية إذ جاءها المرسلون" />
-		<aya index="14" text="إذ أرسلنا إليهم اثنين فكذبوهما فعززنا بثالث فقالوا إنا إليكم مرسلون" />
-		<aya index="15" text="قالوا ما أنتم إلا بشر مثلنا وما أنزل الرحمن من شيء إن أنتم إلا تكذبون" />
-		<aya index="16" text="قالوا ربنا يعلم إنا إليكم لمرسلون" />
-		<aya index="17" text="وما علينا إلا البلاغ المبين" />
-		<aya index="18" text="قالوا إنا تطيرنا بكم لئن لم تنتهوا لنرجمنكم وليمسنكم منا عذاب أليم" />
-		<aya index="19" text="قالوا طائركم معكم أئن ذكرتم بل أنتم قوم مسرفون" />
-		<aya index="20" text="وجاء من أقصى المدينة رجل يسعى قال يا قوم اتبعوا المرسلين" />
-		<aya index="21" text="اتبعوا من لا يسألكم أجرا وهم مهتدون" />
-		<aya index="22" text="وما لي لا أعبد الذي فطرني وإليه ترجعون" />
-		<aya index="23" text="أأتخذ من دونه آلهة إن يردن الرحمن بضر لا تغن عني شفاعتهم شيئا ولا ينقذون" />
-		<aya index="24" text="إني إذا لفي ضلال مبين" />
-		<aya index="25" text="إني آمنت بربكم فاسمعون" />
-		<aya index="26" text="قيل ادخل الجنة قال يا ليت قومي يعلمون" />
-		<aya index="27" text="بما غفر لي ربي وجعلني من المكرمين" />
-		<aya index="28" text="وما أنزلنا على قومه من بعده من جند من السماء وما كنا منزلين" />
-		<aya index="29" text="إن كانت إلا صيحة واحدة فإذا هم خامدون" />
-		<aya index="30" text="يا حسرة على العباد ما يأتيهم من رسول إلا كانوا به يستهزئون" />
-		<aya index="31" text="ألم يروا كم أهلكنا قبلهم من القرون أنهم إليهم لا يرجعون" />
-		<aya index="32" text="وإن كل لما جميع لدينا محضرون" />
-		<aya index="33" text="وآية لهم الأرض الميتة أحييناها وأخرجنا منها حبا فمنه يأكلون" />
-		<aya index="34" text="وجعلنا فيها جنات من نخيل وأعناب وفجرنا فيها من العيون" />
-		<aya index="35" text="ليأكلوا من ثمره وما عملته أيديهم أفلا يشكرون" />
-		<aya index="36" text="سبحان الذي خلق الأزواج كلها مما تنبت الأرض ومن أنفسهم ومما لا يعلمون" />
-		<aya index="37" text="وآية لهم الليل نسلخ منه النهار فإذا هم مظلمون" />
-		<aya index="38" text="والشمس تجري لمستقر لها ذلك تقدير العزيز العليم" />
-		<aya index="39" text="والقمر قدرناه منازل حتى عاد كالعرجون القديم" />
-		<aya index="40" text="لا الشمس ينبغي لها أن تدرك القمر ولا الليل سابق النهار وكل في فلك يسبحون" />
-		<aya index="41" text="وآية لهم أنا حملنا ذريتهم في الفلك المشحون" />
-		<aya index="42" text="وخلقنا لهم من مثله ما يركبون" />
-		<aya index="43" text="وإن نشأ نغرقهم فلا صريخ لهم ولا هم ينقذون" />
-		<aya index="44" text="إلا رحمة منا ومتاعا إلى حين" />
-		<aya index="45" text="وإذا قيل لهم اتقوا ما بين أيديكم وما خلفكم لعلكم ترحمون" />
-		<aya index="46" text="وما تأتيهم من آية من آيات ربهم إلا كانوا عنها معرضين" />
-		<aya index="47" text="وإذا قيل لهم أنفقوا مما رزقكم الله قال الذين كفروا للذين آمنوا أنطعم من لو يشاء الله أطعمه إن أنتم إلا في ضلال مبين" />
-		<aya index="48" text="ويقولون متى هذا الوعد إن كنتم صادقين" />
-		<aya index="49" text="ما ينظرون إلا صيحة واحدة تأخذهم وهم يخصمون" />
-		<aya index="50" text="فلا يستطيعون توصية ولا إلى أهلهم يرجعون" />
-		<aya index="51" text="ونفخ في الصور فإذا هم من الأجداث إلى ربهم ينسلون" />
-		<aya index="52" text="قالوا يا ويلنا من بعثنا من مرقدنا هذا ما وعد الرحمن وصدق المرسلون" />
-		<aya index="53" text="إن كانت إلا صيحة واحدة فإذا هم جميع لدينا محضرون" />
-		<aya index="54" text="فاليوم لا تظلم نفس شيئا ولا تجزون إلا ما كنتم تعملون" />
-		<aya index="55" text="إن أصحاب الجنة اليوم في شغل فاكهون" />
-		<aya index="56" text="هم وأزواجهم في ظلال على الأرائك متكئون" />
-		<aya index="57" text="لهم فيها فاكهة ولهم ما يدعون" />
-		<aya index="58" text="سلام قولا من رب رحيم" />
-		<aya index="59" text="وامتازوا اليوم أيها المجرمون" />
-		<aya index="60" text="ألم أعهد إليكم يا بني آدم أن لا تعبدوا الشيطان إنه لكم عدو مبين" />
-		<aya index="61" text="وأن اعبدوني هذا صراط مستقيم" />
-		<aya index="62" text="ولقد أضل منكم جبلا كثيرا أفلم تكونوا تعقلون" />
-		<aya index="63" text="هذه جهنم التي كنتم توعدون" />
-		<aya index="64" text="اصلوها اليوم بما كنتم تكفرون" />
-		<aya index="65" text="اليوم نختم على أفواههم وتكلمنا أيديهم وتشهد أرجلهم بما كانوا يكسبون" />
-		<aya index="66" text="ولو نشاء لطمسنا على أعينهم فاستبقوا الصراط فأنى يبصرون" />
-		<aya index="67" text="ولو نشاء لمسخناهم على مكانتهم فما استطاعوا مضيا ولا يرجعون" />
-		<aya index="68" text="ومن نعمره ننكسه في الخلق أفلا يعقلون" />
-		<aya index="69" text="وما علمناه الشعر وما ينبغي له إن هو إلا ذكر وقرآن مبين" />
-		<aya index="70" text="لينذر من كان حيا ويحق القول على الكافرين" />
-		<aya index="71" text="أولم يروا أنا خلقنا لهم مما عملت أيدينا أنعاما فهم لها مالكون" />
-		<aya index="72" text="وذللناها لهم فمنها ركوبهم ومنها يأكلون" />
-		<aya index="73" text="ولهم فيها منافع ومشارب أفلا يشكرون" />
-		<aya index="74" text="واتخذوا من دون الله آلهة لعلهم ينصرون" />
-		<aya index="75" text="لا يستطيعون نصرهم وهم لهم جند محضرون" />
-		<aya index="76" text="فلا يحزنك قولهم إنا نعلم ما يسرون وما يعلنون" />
-		<aya index="77" text="أولم ير الإنسان أنا خلقناه من نطفة فإذا هو خصيم مبين" />
-		<aya index="78" text="وضرب لنا مثلا ونسي خلقه قال من يحيي العظام وهي رميم" />
-		<aya index="79" text="قل يحييها الذي أنشأها أول مرة وهو بكل خلق عليم" />
-		<aya index="80" text="الذي جعل لكم من الشجر الأخضر نارا فإذا أنتم منه توقدون" />
-		<aya index="81" text="أوليس الذي خلق السماوات والأرض بقادر على أن يخلق مثلهم بلى وهو الخلاق العليم" />
-		<aya index="82" text="إنما أمره إذا أراد شيئا أن يقول له كن فيكون" />
-		<aya index="83" text="فسبحان الذي بيده ملكوت كل شيء وإليه ترجعون" />
-	</sura>
-	<sura index="37" name="الصافات">
-		<aya index="1" text="والصافات صفا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="فالزاجرات زجرا" />
-		<aya index="3" text="فالتاليات ذكرا" />
-		<aya index="4" text="إن إلهكم لواحد" />
-		<aya index="5" text="رب السماوات والأرض وما بينهما ورب المشارق" />
-		<aya index="6" text="إنا زينا السماء الدنيا بزينة الكواكب" />
-		<aya index="7" text="وحفظا من كل شيطان مارد" />
-		<aya index="8" text="لا يسمعون إلى الملإ الأعلى ويقذفون من كل جانب" />
-		<aya index="9" text="دحورا ولهم عذاب واصب" />
-		<aya index="10" text="إلا من خطف الخطفة فأتبعه شهاب ثاقب" />
-		<aya index="11" text="فاستفتهم أهم أشد خلقا أم من خلقنا إنا خلقناهم من طين لازب" />
-		<aya index="12" text="بل عجبت ويسخرون" />
-		<aya index="13" text="وإذا ذكروا لا يذكرون" />
-		<aya index="14" text="وإذا رأوا آية يستسخرون" />
-		<aya index="15" text="وقالوا إن هذا إلا سحر مبين" />
-		<aya index="16" text="أإذا متنا وكنا ترابا وعظاما أإنا لمبعوثون" />
-		<aya index="17" text="أوآباؤنا الأولون" />
-		<aya index="18" text="قل نعم وأنتم داخرون" />
-		<aya index="19" text="فإنما هي زجرة واحدة فإذا هم ينظرون" />
-		<aya index="20" text="وقالوا يا ويلنا هذا يوم الدين" />
-		<aya index="21" text="هذا يوم الفصل الذي كنتم به تكذبون" />
-		<aya index="22" text="احشروا الذين ظلموا وأزواجهم وما كانوا يعبدون" />
-		<aya index="23" text="من دون الله فاهدوهم إلى صراط الجحيم" />
-		<aya index="24" text="وقفوهم إنهم مسئولون" />
-		<aya index="25" text="ما لكم لا تناصرون" />
-		<aya index="26" text="بل هم اليوم مستسلمون" />
-		<aya index="27" text="وأقبل بعضهم على بعض يتساءلون" />
-		<aya index="28" text="قالوا إنكم كنتم تأتوننا عن اليمين" />
-		<aya index="29" text="قالوا بل لم تكونوا مؤمنين" />
-		<aya index="30" text="وما كان لنا عليكم من سلطان بل كنتم قوما طاغين" />
-		<aya index="31" text="فحق علينا قول ربنا إنا لذائقون" />
-		<aya index="32" text="فأغويناكم إنا كنا غاوين" />
-		<aya index="33" text="فإنهم يومئذ في العذاب مشتركون" />
-		<aya index="34" text="إنا كذلك نفعل بالمجرمين" />
-		<aya index="35" text="إنهم كانوا إذا قيل لهم لا إله إلا الله يستكبرون" />
-		<aya index="36" text="ويقولون أئنا لتاركو آلهتنا لشاعر مجنون" />
-		<aya index="37" text="بل جاء بالحق وصدق المرسلين" />
-		<aya index="38" text="إنكم لذائقو العذاب الأليم" />
-		<aya index="39" text="وما تجزون إلا ما كنتم تعملون" />
-		<aya index="40" text="إلا عباد الله المخلصين" />
-		<aya index="41" text="أولئك لهم رزق معلوم" />
-		<aya index="42" text="فواكه وهم مكرمون" />
-		<aya index="43" text="في جنات النعيم" />
-		<aya index="44" text="على سرر متقابلين" />
-		<aya index="45" text="يطاف عليهم بكأس من معين" />
-		<aya index="46" text="بيضاء لذة للشاربين" />
-		<aya index="47" text="لا فيها غول ولا هم عنها ينزفون" />
-		<aya index="48" text="وعندهم قاصرات الطرف عين" />
-		<aya index="49" text="كأنهن بيض مكنون" />
-		<aya index="50" text="فأقبل بعضهم على بعض يتساءلون" />
-		<aya index="51" text="قال قائل منهم إني كان لي قرين" />
-		<aya index="52" text="يقول أإنك لمن المصدقين" />
-		<aya index="53" text="أإذا متنا وكنا ترابا وعظاما أإنا لمدينون" />
-		<aya index="54" text="قال هل أنتم مطلعون" />
-		<aya index="55" text="فاطلع فرآه في سواء الجحيم" />
-		<aya index="56" text="قال تالله إن كدت لتردين" />
-		<aya index="57" text="ولولا نعمة ربي لكنت من المحضرين" />
-		<aya index="58" text="أفما نحن بميتين" />
-		<aya index="59" text="إلا موتتنا الأولى وما نحن بمعذبين" />
-		<aya index="60" text="إن هذا لهو الفوز العظيم" />
-		<aya index="61" text="لمثل هذا فليعمل العاملون" />
-		<aya index="62" text="أذلك خير نزلا أم شجرة الزقوم" />
-		<aya index="63" text="إنا جعلناها فتنة للظالمين" />
-		<aya index="64" text="إنها شجرة تخرج في أصل الجحيم" />
-		<aya index="65" text="طلعها كأنه رءوس الشياطين" />
-		<aya index="66" text="فإنهم لآكلون منها فمالئون منها البطون" />
-		<aya index="67" text="ثم إن لهم عليها لشوبا من حميم" />
-		<aya index="68" text="ثم إن مرجعهم لإلى الجحيم" />
-		<aya index="69" text="إنهم ألفوا آباءهم ضالين" />
-		<aya index="70" text="فهم على آثارهم يهرعون" />
-		<aya index="71" text="ولقد ضل قبلهم أكثر الأولين" />
-		<aya index="72" text="ولقد أرسلنا فيهم منذرين" />
-		<aya index="73" text="فانظر كيف كان عاقبة المنذرين" />
-		<aya index="74" text="إلا عباد الله المخلصين" />
-		<aya index="75" text="ولقد نادانا نوح فلنعم المجيبون" />
-		<aya index="76" text="ونجيناه وأهله من الكرب العظيم" />
-		<aya index="77" text="وجعلنا ذريته هم الباقين" />
-		<aya index="78" text="وتركنا عليه في الآخرين" />
-		<aya index="79" text="سلام على نوح في العالمين" />
-		<aya index="80" text="إنا كذلك نجزي المحسنين" />
-		<aya index="81" text="إنه من عبادنا المؤمنين" />
-		<aya index="82" text="ثم أغرقنا الآخرين" />
-		<aya index="83" text="وإن من شيعته لإبراهيم" />
-		<aya index="84" text="إذ جاء ربه بقلب سليم" />
-		<aya index="85" text="إذ قال لأبيه وقومه ماذا تعبدون" />
-		<aya index="86" text="أئفكا آلهة دون الله تريدون" />
-		<aya index="87" text="فما ظنكم برب العالمين" />
-		<aya index="88" text="فنظر نظرة في النجوم" />
-		<aya index="89" text="فقال إني سقيم" />
-		<aya index="90" text="فتولوا عنه مدبرين" />
-		<aya index="91" text="فراغ إلى آلهتهم فقال ألا تأكلون" />
-		<aya index="92" text="ما لكم لا تنطقون" />
-		<aya index="93" text="فراغ عليهم ضربا باليمين" />
-		<aya index="94" text="فأقبلوا إليه يزفون" />
-		<aya index="95" text="قال أتعبدون ما تنحتون" />
-		<aya index="96" text="والله خلقكم وما تعملون" />
-		<aya index="97" text="قالوا ابنوا له بنيانا فألقوه في الجحيم" />
-		<aya index="98" text="فأرادوا به كيدا فجعلناهم الأسفلين" />
-		<aya index="99" text="وقال إني ذاهب إلى ربي سيهدين" />
-		<aya index="100" text="رب هب لي من الصالحين" />
-		<aya index="101" text="فبشرناه بغلام حليم" />
-		<aya index="102" text="فلما بلغ معه السعي قال يا بني إني أرى في المنام أني أذبحك فانظر ماذا ترى قال يا أبت افعل ما تؤمر ستجدني إن شاء الله من الصابرين" />
-		<aya index="103" text="فلما أسلما وتله للجبين" />
-		<aya index="104" text="وناديناه أن يا إبراهيم" />
-		<aya index="105" text="قد صدقت الرؤيا إنا كذلك نجزي المحسنين" />
-		<aya index="106" text="إن هذا لهو البلاء المبين" />
-		<aya index="107" text="وفديناه بذبح عظيم" />
-		<aya index="108" text="وتركنا عليه في الآخرين" />
-		<aya index="109" text="سلام على إبراهيم" />
-		<aya index="110" text="كذلك نجزي المحسنين" />
-		<aya index="111" text="إنه من عبادنا المؤمنين" />
-		<aya index="112" text="وبشرناه بإسحاق نبيا من الصالحين" />
-		<aya index="113" text="وباركنا عليه وعلى إسحاق ومن ذريتهما محسن وظالم لنفسه مبين" />
-		<aya index="114" text="ولقد مننا على موسى وهارون" />
-		<aya index="115" text="ونجيناهما وقومهما من الكرب العظيم" />
-		<aya index="116" text="ونصرناهم فكانوا هم الغالبين" />
-		<aya index="117" text="وآتيناهما الكتاب المستبين" />
-		<aya index="118" text="وهديناهما الصراط المستقيم" />
-		<aya index="119" text="وتركنا عليهما في الآخرين" />
-		<aya index="120" text="سلام على موسى وهارون" />
-		<aya index="121" text="إنا كذلك نجزي المحسنين" />
-		<aya index="122" text="إنهما من عبادنا المؤمنين" />
-		<aya index="123" text="وإن إلياس لمن المرسلين" />
-		<aya index="124" text="إذ قال لقومه ألا تتقون" />
-		<aya index="125" text="أتدعون بعلا وتذرون أحسن الخالقين" />
-		<aya index="126" text="الله ربكم ورب آبائكم الأولين" />
-		<aya index="127" text="فكذبوه فإنهم لمحضرون" />
-		<aya index="128" text="إلا عباد الله المخلصين" />
-		<aya index="129" text="وتركنا عليه في الآخرين" />
-		<aya index="130" text="سلام على إل ياسين" />
-		<aya index="131" text="إنا كذلك نجزي المحسنين" />
-		<aya index="132" text="إنه من عبادنا المؤمنين" />
-		<aya index="133" text="وإن لوطا لمن المرسلين" />
-		<aya index="134" text="إذ نجيناه وأهله أجمعين" />
-		<aya index="135" text="إلا عجوزا في الغابرين" />
-		<aya index="136" text="ثم دمرنا الآخرين" />
-		<aya index="137" text="وإنكم لتمرون عليهم مصبحين" />
-		<aya index="138" text="وبالليل أفلا تعقلون" />
-		<aya index="139" text="وإن يونس لمن المرسلين" />
-		<aya index="140" text="إذ أبق إلى الفلك المشحون" />
-		<aya index="141" text="فساهم فكان من المدحضين" />
-		<aya index="142" text="فالتقمه الحوت وهو مليم" />
-		<aya index="143" text="فلولا أنه كان من المسبحين" />
-		<aya index="144" text="للبث في بطنه إلى يوم يبعثون" />
-		<aya index="145" text="فنبذناه بالعراء وهو سقيم" />
-		<aya index="146" text="وأنبتنا عليه شجرة من يقطين" />
-		<aya index="147" text="وأرسلناه إلى مائة ألف أو يزيدون" />
-		<aya index="148" text="فآمنوا فمتعناهم إلى حين" />
-		<aya index="149" text="فاستفتهم ألربك البنات ولهم البنون" />
-		<aya index="150" text="أم خلقنا الملائكة إناثا وهم شاهدون" />
-		<aya index="151" text="ألا إنهم من إفكهم ليقولون" />
-		<aya index="152" text="ولد الله وإنهم لكاذبون" />
-		<aya index="153" text="أصطفى البنات على البنين" />
-		<aya index="154" text="ما لكم كيف تحكمون" />
-		<aya index="155" text="أفلا تذكرون" />
-		<aya index="156" text="أم لكم سلطان مبين" />
-		<aya index="157" text="فأتوا بكتابكم إن كنتم صادقين" />
-		<aya index="158" text="وجعلوا بينه وبين الجنة نسبا ولقد علمت الجنة إنهم لمحضرون" />
-		<aya index="159" text="سبحان الله عما يصفون" />
-		<aya index="160" text="إلا عباد الله المخلصين" />
-		<aya index="161" text="فإنكم وما تعبدون" />
-		<aya index="162" text="ما أنتم عليه بفاتنين" />
-		<aya index="163" text="إلا من هو صال الجحيم" />
-		<aya index="164" text="وما منا إلا له مقام معلوم" />
-		<aya index="165" text="وإنا لنحن الصافون" />
-		<aya index="166" text="وإنا لنحن المسبحون" />
-		<aya index="167" text="وإن كانوا ليقولون" />
-		<aya index="168" text="لو أن عندنا ذكرا من الأولين" />
-		<aya index="169" text="لكنا عباد الله المخلصين" />
-		<aya index="170" text="فكفروا به فسوف يعلمون" />
-		<aya index="171" text="ولقد سبقت كلمتنا لعبادنا المرسلين" />
-		<aya index="172" text="إنهم لهم المنصورون" />
-		<aya index="173" text="وإن جندنا لهم الغالبون" />
-		<aya index="174" text="فتول عنهم حتى حين" />
-		<aya index="175" text="وأبصرهم فسوف يبصرون" />
-		<aya index="176" text="أفبعذابنا يستعجلون" />
-		<aya index="177" text="فإذا نزل بساحتهم فساء صباح المنذرين" />
-		<aya index="178" text="وتول عنهم حتى حين" />
-		<aya index="179" text="وأبصر فسوف يبصرون" />
-		<aya index="180" text="سبحان ربك رب العزة عما يصفون" />
-		<aya index="181" text="وسلام على المرسلين" />
-		<aya index="182" text="والحمد لله رب العالمين" />
-	</sura>
-	<sura index="38" name="ص">
-		<aya index="1" text="ص والقرآن ذي الذكر" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="بل الذين كفروا في عزة وشقاق" />
-		<aya index="3" text="كم أهلكنا من قبلهم من قرن فنادوا ولات حين مناص" />
-		<aya index="4" text="وعجبوا أن جاءهم منذر منهم وقال الكافرون هذا ساحر كذاب" />
-		<aya index="5" text="أجعل الآلهة إلها واحدا إن هذا لشيء عجاب" />
-		<aya index="6" text="وانطلق الملأ منهم أن امشوا واصبروا على آلهتكم إن هذا لشيء يراد" />
-		<aya index="7" text="ما سمعنا بهذا في الملة الآخرة إن هذا إلا اختلاق" />
-		<aya index="8" text="أأنزل عليه الذكر من بيننا بل هم في شك من ذكري بل لما يذوقوا عذاب" />
-		<aya index="9" text="أم عندهم خزائن رحمة ربك العزيز الوهاب" />
-		<aya index="10" text="أم لهم ملك السماوات والأرض وما بينهما فليرتقوا في الأسباب" />
-		<aya index="11" text="جند ما هنالك مهزوم من الأحزاب" />
-		<aya index="12" text="كذبت قبلهم قوم نوح وعاد وفرعون ذو الأوتاد" />
-		<aya index="13" text="وثمود وقوم لوط وأصحاب الأيكة أولئك الأحزاب" />
-		<aya index="14" text="إن كل إلا كذب الرسل فحق عقاب" />
-		<aya index="15" text="وما ينظر هؤلاء إلا صيحة واحدة ما لها من فواق" />
-		<aya index="16" text="وقالوا ربنا عجل لنا قطنا قبل يوم الحساب" />
-		<aya index="17" text="اصبر على ما يقولون واذكر عبدنا داوود ذا الأيد إنه أواب" />
-		<aya index="18" text="إنا سخرنا الجبال معه يسبحن بالعشي والإشراق" />
-		<aya index="19" text="والطير محشورة كل له أواب" />
-		<aya index="20" text="وشددنا ملكه وآتيناه الحكمة وفصل الخطاب" />
-		<aya index="21" text="وهل أتاك نبأ الخصم إذ تسوروا المحراب" />
-		<aya index="22" text="إذ دخلوا على داوود ففزع منهم قالوا لا تخف خصمان بغى بعضنا على بعض فاحكم بيننا بالحق ولا تشطط واهدنا إلى سواء الصراط" />
-		<aya index="23" text="إن هذا أخي له تسع وتسعون نعجة ولي نعجة واحدة فقال أكفلنيها وعزني في الخطاب" />
-		<aya index="24" text="قال لقد ظلمك بسؤال نعجتك إلى نعاجه وإن كثيرا من الخلطاء ليبغي بعضهم على بعض إلا الذين آمنوا وعملوا الصالحات وقليل ما هم وظن داوود أنما فتناه فاستغفر ربه وخر راكعا وأناب" />
-		<aya index="25" text="فغفرنا له ذلك وإن له عندنا لزلفى وحسن مآب" />
-		<aya index="26" text="يا داوود إنا جعلناك خليفة في الأرض فاحكم بين الناس بالحق ولا تتبع الهوى فيضلك عن سبيل الله إن الذين يضلون عن سبيل الله لهم عذاب شديد بما نسوا يوم الحساب" />
-		<aya index="27" text="وما خلقنا السماء والأرض وما بينهما باطلا ذلك ظن الذين كفروا فويل للذين كفروا من النار" />
-		<aya index="28" text="أم نجعل الذين آمنوا وعملوا الصالحات كالمفسدين في الأرض أم نجعل المتقين كالفجار" />
-		<aya index="29" text="كتاب أنزلناه إليك مبارك ليدبروا آياته وليتذكر أولو الألباب" />
-		<aya index="30" text="ووهبنا لداوود سليمان نعم العبد إنه أواب" />
-		<aya index="31" text="إذ عرض عليه بالعشي الصافنات الجياد" />
-		<aya index="32" text="فقال إني أحببت حب الخير عن ذكر ربي حتى توارت بالحجاب" />
-		<aya index="33" text="ردوها علي فطفق مسحا بالسوق والأعناق" />
-		<aya index="34" text="ولقد فتنا سليمان وألقينا على كرسيه جسدا ثم أناب" />
-		<aya index="35" text="قال رب اغفر لي وهب لي ملكا لا ينبغي لأحد من بعدي إنك أنت الوهاب" />
-		<aya index="36" text="فسخرنا له الريح تجري بأمره رخاء حيث أصاب" />
-		<aya index="37" text="والشياطين كل بناء وغواص" />
-		<aya index="38" text="وآخرين مقرنين في الأصفاد" />
-		<aya index="39" text="هذا عطاؤنا فامنن أو أمسك بغير حساب" />
-		<aya index="40" text="وإن له عندنا لزلفى وحسن مآب" />
-		<aya index="41" text="واذكر عبدنا أيوب إذ نادى ربه أني مسني الشيطان بنصب وعذاب" />
-		<aya index="42" text="اركض برجلك هذا مغتسل بارد وشراب" />
-		<aya index="43" text="ووهبنا له أهله ومثلهم معهم رحمة منا وذكرى لأولي الألباب" />
-		<aya index="44" text="وخذ بيدك ضغثا فاضرب به ولا تحنث إنا وجدناه صابرا نعم العبد إنه أواب" />
-		<aya index="45" text="واذكر عبادنا إبراهيم وإسحاق ويعقوب أولي الأيدي والأبصار" />
-		<aya index="46" text="إنا أخلصناهم بخالصة ذكرى الدار" />
-		<aya index="47" text="وإنهم عندنا لمن المصطفين الأخيار" />
-		<aya index="48" text="واذكر إسماعيل واليسع وذا الكفل وكل من الأخيار" />
-		<aya index="49" text="هذا ذكر وإن للمتقين لحسن مآب" />
-		<aya index="50" text="جنات عدن مفتحة لهم الأبواب" />
-		<aya index="51" text="متكئين فيها يدعون فيها بفاكهة كثيرة وشراب" />
-		<aya index="52" text="وعندهم قاصرات الطرف أتراب" />
-		<aya index="53" text="هذا ما توعدون ليوم الحساب" />
-		<aya index="54" text="إن هذا لرزقنا ما له من نفاد" />
-		<aya index="55" text="هذا وإن للطاغين لشر مآب" />
-		<aya index="56" text="جهنم يصلونها فبئس المهاد" />
-		<aya index="57" text="هذا فليذوقوه حميم وغساق" />
-		<aya index="58" text="وآخر من شكله أزواج" />
-		<aya index="59" text="هذا فوج مقتحم معكم لا مرحبا بهم إنهم صالو النار" />
-		<aya index="60" text="قالوا بل أنتم لا مرحبا بكم أنتم قدمتموه لنا فبئس القرار" />
-		<aya index="61" text="قالوا ربنا من قدم لنا هذا فزده عذابا ضعفا في النار" />
-		<aya index="62" text="وقالوا ما لنا لا نرى رجالا كنا نعدهم من الأشرار" />
-		<aya index="63" text="أتخذناهم سخريا أم زاغت عنهم الأبصار" />
-		<aya index="64" text="إن ذلك لحق تخاصم أهل النار" />
-		<aya index="65" text="قل إنما أنا منذر وما من إله إلا الله الواحد القهار" />
-		<aya index="66" text="رب السماوات والأرض وما بينهما العزيز الغفار" />
-		<aya index="67" text="قل هو نبأ عظيم" />
-		<aya index="68" text="أنتم عنه معرضون" />
-		<aya index="69" text="ما كان لي من علم بالملإ الأعلى إذ يختصمون" />
-		<aya index="70" text="إن يوحى إلي إلا أنما أنا نذير مبين" />
-		<aya index="71" text="إذ قال ربك للملائكة إني خالق بشرا من طين" />
-		<aya index="72" text="فإذا سويته ونفخت فيه من روحي فقعوا له ساجدين" />
-		<aya index="73" text="فسجد الملائكة كلهم أجمعون" />
-		<aya index="74" text="إلا إبليس استكبر وكان من الكافرين" />
-		<aya index="75" text="قال يا إبليس ما منعك أن تسجد لما خلقت بيدي أستكبرت أم كنت من العالين" />
-		<aya index="76" text="قال أنا خير منه خلقتني من نار وخلقته من طين" />
-		<aya index="77" text="قال فاخرج منها فإنك رجيم" />
-		<aya index="78" text="وإن عليك لعنتي إلى يوم الدين" />
-		<aya index="79" text="قال رب فأنظرني إلى يوم يبعثون" />
-		<aya index="80" text="قال فإنك من المنظرين" />
-		<aya index="81" text="إلى يوم الوقت المعلوم" />
-		<aya index="82" text="قال فبعزتك لأغوينهم أجمعين" />
-		<aya index="83" text="إلا عبادك منهم المخلصين" />
-		<aya index="84" text="قال فالحق والحق أقول" />
-		<aya index="85" text="لأملأن جهنم منك وممن تبعك منهم أجمعين" />
-		<aya index="86" text="قل ما أسألكم عليه من أجر وما أنا من المتكلفين" />
-		<aya index="87" text="إن هو إلا ذكر للعالمين" />
-		<aya index="88" text="ولتعلمن نبأه بعد حين" />
-	</sura>
-	<sura index="39" name="الزمر">
-		<aya index="1" text="تنزيل الكتاب من الله العزيز الحكيم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="إنا أنزلنا إليك الكتاب بالحق فاعبد الله مخلصا له الدين" />
-		<aya index="3" text="ألا لله الدين الخالص والذين اتخذوا من دونه أولياء ما نعبدهم إلا ليقربونا إلى الله زلفى إن الله يحكم بينهم في ما هم فيه يختلفون إن الله لا يهدي من هو كاذب كفار" />
-		<aya index="4" text="لو أراد الله أن يتخذ ولدا لاصطفى مما يخلق ما يشاء سبحانه هو الله الواحد القهار" />
-		<aya index="5" text="خلق السماوات والأرض بالحق يكور الليل على النهار ويكور النهار على الليل وسخر الشمس والقمر كل يجري لأجل مسمى ألا هو العزيز الغفار" />
-		<aya index="6" text="خلقكم من نفس واحدة ثم جعل منها زوجها وأنزل لكم من الأنعام ثمانية أزواج يخلقكم في بطون أمهاتكم خلقا من بعد خلق في ظلمات ثلاث ذلكم الله ربكم له الملك لا إله إلا هو فأنى تصرفون" />
-		<aya index="7" text="إن تكفروا فإن الله غني عنكم ولا يرضى لعباده الكفر وإن تشكروا يرضه لكم ولا تزر وازرة وزر أخرى ثم إلى ربكم مرجعكم فينبئكم بما كنتم تعملون إنه عليم بذات الصدور" />
-		<aya index="8" text="وإذا مس الإنسان ضر دعا ربه منيبا إليه ثم إذا خوله نعمة منه نسي ما كان يدعو إليه من قبل وجعل لله أندادا ليضل عن سبيله قل تمتع بكفرك قليلا إنك من أصحاب النار" />
-		<aya index="9" text="أمن هو قانت آناء الليل ساجدا وقائما يحذر الآخرة ويرجو رحمة ربه قل هل يستوي الذين يعلمون والذين لا يعلمون إنما يتذكر أولو الألباب" />
-		<aya index="10" text="قل يا عباد الذين آمنوا اتقوا ربكم للذين أحسنوا في هذه الدنيا حسنة وأرض الله واسعة إنما يوفى الصابرون أجرهم بغير حساب" />
-		<aya index="11" text="قل إني أمرت أن أعبد الله مخلصا له الدين" />
-		<aya index="12" text="وأمرت لأن أكون أول المسلمين" />
-		<aya index="13" text="قل إني أخاف إن عصيت ربي عذاب يوم عظيم" />
-		<aya index="14" text="قل الله أعبد مخلصا له ديني" />
-		<aya index="15" text="فاعبدوا ما شئتم من دونه قل إن الخاسرين الذين خسروا أنفسهم وأهليهم يوم القيامة ألا ذلك هو الخسران المبين" />
-		<aya index="16" text="لهم من فوقهم ظلل من النار ومن تحتهم ظلل ذلك يخوف الله به عباده يا عباد فاتقون" />
-		<aya index="17" text="والذين اجتنبوا الطاغوت أن يعبدوها وأنابوا إلى الله لهم البشرى فبشر عباد" />
-		<aya index="18" text="الذين يستمعون القول فيتبعون أحسنه أولئك الذين هداهم الله وأولئك هم أولو الألباب" />
-		<aya index="19" text="أفمن حق عليه كلمة العذاب أفأنت تنقذ من في النار" />
-		<aya index="20" text="لكن الذين اتقوا ربهم لهم غرف من فوقها غرف مبنية تجري من تحتها الأنهار وعد الله لا يخلف الله الميعاد" />
-		<aya index="21" text="ألم تر أن الله أنزل من السماء ماء فسلكه ينابيع في الأرض ثم يخرج به زرعا مختلفا ألوانه ثم يهيج فتراه مصفرا ثم يجعله حطاما إن في ذلك لذكرى لأولي الألباب" />
-		<aya index="22" text="أفمن شرح الله صدره للإسلام فهو على نور من ربه فويل للقاسية قلوبهم من ذكر الله أولئك في ضلال مبين" />
-		<aya index="23" text="الله نزل أحسن الحديث كتابا متشابها مثاني تقشعر منه جلود الذين يخشون ربهم ثم تلين جلودهم وقلوبهم إلى ذكر الله ذلك هدى الله يهدي به من يشاء ومن يضلل الله فما له من هاد" />
-		<aya index="24" text="أفمن يتقي بوجهه سوء العذاب يوم القيامة وقيل للظالمين ذوقوا ما كنتم تكسبون" />
-		<aya index="25" text="كذب الذين من قبلهم فأتاهم العذاب من حيث لا يشعرون" />
-		<aya index="26" text="فأذاقهم الله الخزي في الحياة الدنيا ولعذاب الآخرة أكبر لو كانوا يعلمون" />
-		<aya index="27" text="ولقد ضربنا للناس في هذا القرآن من كل مثل لعلهم يتذكرون" />
-		<aya index="28" text="قرآنا عربيا غير ذي عوج لعلهم يتقون" />
-		<aya index="29" text="ضرب الله مثلا رجلا فيه شركاء متشاكسون ورجلا سلما لرجل هل يستويان مثلا الحمد لله بل أكثرهم لا يعلمون" />
-		<aya index="30" text="إنك ميت وإنهم ميتون" />
-		<aya index="31" text="ثم إنكم يوم القيامة عند ربكم تختصمون" />
-		<aya index="32" text="فمن أظلم ممن كذب على الله وكذب بالصدق إذ جاءه أليس في جهنم مثوى للكافرين" />
-		<aya index="33" text="والذي جاء بالصدق وصدق به أولئك هم المتقون" />
-		<aya index="34" text="لهم ما يشاءون عند ربهم ذلك جزاء المحسنين" />
-		<aya index="35" text="ليكفر الله عنهم أسوأ الذي عملوا ويجزيهم أجرهم بأحسن الذي كانوا يعملون" />
-		<aya index="36" text="أليس الله بكاف عبده ويخوفونك بالذين من دونه ومن يضلل الله فما له من هاد" />
-		<aya index="37" text="ومن يهد الله فما له من مضل أليس الله بعزيز ذي انتقام" />
-		<aya index="38" text="ولئن سألتهم من خلق السماوات والأرض ليقولن الله قل أفرأيتم ما تدعون من دون الله إن أرادني الله بضر هل هن كاشفات ضره أو أرادني برحمة هل هن ممسكات رحمته قل حسبي الله عليه يتوكل المتوكلون" />
-		<aya index="39" text="قل يا قوم اعملوا على مكانتكم إني عامل فسوف تعلمون" />
-		<aya index="40" text="من يأتيه عذاب يخزيه ويحل عليه عذاب مقيم" />
-		<aya index="41" text="إنا أنزلنا عليك الكتاب للناس بالحق فمن اهتدى فلنفسه ومن ضل فإنما يضل عليها وما أنت عليهم بوكيل" />
-		<aya index="42" text="الله يتوفى الأنفس حين موتها والتي لم تمت في منامها فيمسك التي قضى عليها الموت ويرسل الأخرى إلى أجل مسمى إن في ذلك لآيات لقوم يتفكرون" />
-		<aya index="43" text="أم اتخذوا من دون الله شفعاء قل أولو كانوا لا يملكون شيئا ولا يعقلون" />
-		<aya index="44" text="قل لله الشفاعة جميعا له ملك السماوات والأرض ثم إليه ترجعون" />
-		<aya index="45" text="وإذا ذكر الله وحده اشمأزت قلوب الذين لا يؤمنون بالآخرة وإذا ذكر الذين من دونه إذا هم يستبشرون" />
-		<aya index="46" text="قل اللهم فاطر السماوات والأرض عالم الغيب والشهادة أنت تحكم بين عبادك في ما كانوا فيه يختلفون" />
-		<aya index="47" text="ولو أن للذين ظلموا ما في الأرض جميعا ومثله معه لافتدوا به من سوء العذاب يوم القيامة وبدا لهم من الله ما لم يكونوا يحتسبون" />
-		<aya index="48" text="وبدا لهم سيئات ما كسبوا وحاق بهم ما كانوا به يستهزئون" />
-		<aya index="49" text="فإذا مس الإنسان ضر دعانا ثم إذا خولناه نعمة منا قال إنما أوتيته على علم بل هي فتنة ولكن أكثرهم لا يعلمون" />
-		<aya index="50" text="قد قالها الذين من قبلهم فما أغنى عنهم ما كانوا يكسبون" />
-		<aya index="51" text="فأصابهم سيئات ما كسبوا والذين ظلموا من هؤلاء سيصيبهم سيئات ما كسبوا وما هم بمعجزين" />
-		<aya index="52" text="أولم يعلموا أن الله يبسط الرزق لمن يشاء ويقدر إن في ذلك لآيات لقوم يؤمنون" />
-		<aya index="53" text="قل يا عبادي الذين أسرفوا على أنفسهم لا تقنطوا من رحمة الله إن الله يغفر الذنوب جميعا إنه هو الغفور الرحيم" />
-		<aya index="54" text="وأنيبوا إلى ربكم وأسلموا له من قبل أن يأتيكم العذاب ثم لا تنصرون" />
-		<aya index="55" text="واتبعوا أحسن ما أنزل إليكم من ربكم من قبل أن يأتيكم العذاب بغتة وأنتم لا تشعرون" />
-		<aya index="56" text="أن تقول نفس يا حسرتا على ما فرطت في جنب الله وإن كنت لمن الساخرين" />
-		<aya index="57" text="أو تقول لو أن الله هداني لكنت من المتقين" />
-		<aya index="58" text="أو تقول حين ترى العذاب لو أن لي كرة فأكون من المحسنين" />
-		<aya index="59" text="بلى قد جاءتك آياتي فكذبت بها واستكبرت وكنت من الكافرين" />
-		<aya index="60" text="ويوم القيامة ترى الذين كذبوا على الله وجوههم مسودة أليس في جهنم مثوى للمتكبرين" />
-		<aya index="61" text="وينجي الله الذين اتقوا بمفازتهم لا يمسهم السوء ولا هم يحزنون" />
-		<aya index="62" text="الله خالق كل شيء وهو على كل شيء وكيل" />
-		<aya index="63" text="له مقاليد السماوات والأرض والذين كفروا بآيات الله أولئك هم الخاسرون" />
-		<aya index="64" text="قل أفغير الله تأمروني أعبد أيها الجاهلون" />
-		<aya index="65" text="ولقد أوحي إليك وإلى الذين من قبلك لئن أشركت ليحبطن عملك ولتكونن من الخاسرين" />
-		<aya index="66" text="بل الله فاعبد وكن من الشاكرين" />
-		<aya index="67" text="وما قدروا الله حق قدره والأرض جميعا قبضته يوم القيامة والسماوات مطويات بيمينه سبحانه وتعالى عما يشركون" />
-		<aya index="68" text="ونفخ في الصور فصعق من في السماوات ومن في الأرض إلا من شاء الله ثم نفخ فيه أخرى فإذا هم قيام ينظرون" />
-		<aya index="69" text="وأشرقت الأرض بنور ربها ووضع الكتاب وجيء بالنبيين والشهداء وقضي بينهم بالحق وهم لا يظلمون" />
-		<aya index="70" text="ووفيت كل نفس ما عملت وهو أعلم بما يفعلون" />
-		<aya index="71" text="وسيق الذين كفروا إلى جهنم زمرا حتى إذا جاءوها فتحت أبوابها وقال لهم خزنتها ألم يأتكم رسل منكم يتلون عليكم آيات ربكم وينذرونكم لقاء يومكم هذا قالوا بلى ولكن حقت كلمة العذاب على الكافرين" />
-		<aya index="72" text="قيل ادخلوا أبواب جهنم خالدين فيها فبئس مثوى المتكبرين" />
-		<aya index="73" text="وسيق الذين اتقوا ربهم إلى الجنة زمرا حتى إذا جاءوها وفتحت أبوابها وقال لهم خزنتها سلام عليكم طبتم فادخلوها خالدين" />
-		<aya index="74" text="وقالوا الحمد لله الذي صدقنا وعده وأورثنا الأرض نتبوأ من الجنة حيث نشاء فنعم أجر العاملين" />
-		<aya index="75" text="وترى الملائكة حافين من حول العرش يسبحون بحمد ربهم وقضي بينهم بالحق وقيل الحمد لله رب العالمين" />
-	</sura>
-	<sura index="40" name="غافر">
-		<aya index="1" text="حم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="تنزيل الكتاب من الله العزيز العليم" />
-		<aya index="3" text="غافر الذنب وقابل التوب شديد العقاب ذي الطول لا إله إلا هو إليه المصير" />
-		<aya index="4" text="ما يجادل في آيات الله إلا الذين كفروا فلا يغررك تقلبهم في البلاد" />
-		<aya index="5" text="كذبت قبلهم قوم نوح والأحزاب من بعدهم وهمت كل أمة برسولهم ليأخذوه وجادلوا بالباطل ليدحضوا به الحق فأخذتهم فكيف كان عقاب" />
-		<aya index="6" text="وكذلك حقت كلمت ربك على الذين كفروا أنهم أصحاب النار" />
-		<aya index="7" text="الذين يحملون العرش ومن حوله يسبحون بحمد ربهم ويؤمنون به ويستغفرون للذين آمنوا ربنا وسعت كل شيء رحمة وعلما فاغفر للذين تابوا واتبعوا سبيلك وقهم عذاب الجحيم" />
-		<aya index="8" text="ربنا وأدخلهم جنات عدن التي وعدتهم ومن صلح من آبائهم وأزواجهم وذرياتهم إنك أنت العزيز الحكيم" />
-		<aya index="9" text="وقهم السيئات ومن تق السيئات يومئذ فقد رحمته وذلك هو الفوز العظيم" />
-		<aya index="10" text="إن الذين كفروا ينادون لمقت الله أكبر من مقتكم أنفسكم إذ تدعون إلى الإيمان فتكفرون" />
-		<aya index="11" text="قالوا ربنا أمتنا اثنتين وأحييتنا اثنتين فاعترفنا بذنوبنا فهل إلى خروج من سبيل" />
-		<aya index="12" text="ذلكم بأنه إذا دعي الله وحده كفرتم وإن يشرك به تؤمنوا فالحكم لله العلي الكبير" />
-		<aya index="13" text="هو الذي يريكم آياته وينزل لكم من السماء رزقا وما يتذكر إلا من ينيب" />
-		<aya index="14" text="فادعوا الله مخلصين له الدين ولو كره الكافرون" />
-		<aya index="15" text="رفيع الدرجات ذو العرش يلقي الروح من أمره على من يشاء من عباده لينذر يوم التلاق" />
-		<aya index="16" text="يوم هم بارزون لا يخفى على الله منهم شيء لمن الملك اليوم لله الواحد القهار" />
-		<aya index="17" text="اليوم تجزى كل نفس بما كسبت لا ظلم اليوم إن الله سريع الحساب" />
-		<aya index="18" text="وأنذرهم يوم الآزفة إذ القلوب لدى الحناجر كاظمين ما للظالمين من حميم ولا شفيع يطاع" />
-		<aya index="19" text="يعلم خائنة الأعين وما تخفي الصدور" />
-		<aya index="20" text="والله يقضي بالحق والذين يدعون من دونه لا يقضون بشيء إن الله هو السميع البصير" />
-		<aya index="21" text="أولم يسيروا في الأرض فينظروا كيف كان عاقبة الذين كانوا من قبلهم كانوا هم أشد منهم قوة وآثارا في الأرض فأخذهم الله بذنوبهم وما كان لهم من الله من واق" />
-		<aya index="22" text="ذلك بأنهم كانت تأتيهم رسلهم بالبينات فكفروا فأخذهم الله إنه قوي شديد العقاب" />
-		<aya index="23" text="ولقد أرسلنا موسى بآياتنا وسلطان مبين" />
-		<aya index="24" text="إلى فرعون وهامان وقارون فقالوا ساحر كذاب" />
-		<aya index="25" text="فلما جاءهم بالحق من عندنا قالوا اقتلوا أبناء الذين آمنوا معه واستحيوا نساءهم وما كيد الكافرين إلا في ضلال" />
-		<aya index="26" text="وقال فرعون ذروني أقتل موسى وليدع ربه إني أخاف أن يبدل دينكم أو أن يظهر في الأرض الفساد" />
-		<aya index="27" text="وقال موسى إني عذت بربي وربكم من كل متكبر لا يؤمن بيوم الحساب" />
-		<aya index="28" text="وقال رجل مؤمن من آل فرعون يكتم إيمانه أتقتلون رجلا أن يقول ربي الله وقد جاءكم بالبينات من ربكم وإن يك كاذبا فعليه كذبه وإن يك صادقا يصبكم بعض الذي يعدكم إن الله لا يهدي من هو مسرف كذاب" />
-		<aya index="29" text="يا قوم لكم الملك اليوم ظاهرين في الأرض فمن ينصرنا من بأس الله إن جاءنا قال فرعون ما أريكم إلا ما أرى وما أهديكم إلا سبيل الرشاد" />
-		<aya index="30" text="وقال الذي آمن يا قوم إني أخاف عليكم مثل يوم الأحزاب" />
-		<aya index="31" text="مثل دأب قوم نوح وعاد وثمود والذين من بعدهم وما الله يريد ظلما للعباد" />
-		<aya index="32" text="ويا قوم إني أخاف عليكم يوم التناد" />
-		<aya index="33" text="يوم تولون مدبرين ما لكم من الله من عاصم ومن يضلل الله فما له من هاد" />
-		<aya index="34" text="ولقد جاءكم يوسف من قبل بالبينات فما زلتم في شك مما جاءكم به حتى إذا هلك قلتم لن يبعث الله من بعده رسولا كذلك يضل الله من هو مسرف مرتاب" />
-		<aya index="35" text="الذين يجادلون في آيات الله بغير سلطان أتاهم كبر مقتا عند الله وعند الذين آمنوا كذلك يطبع الله على كل قلب متكبر جبار" />
-		<aya index="36" text="وقال فرعون يا هامان ابن لي صرحا لعلي أبلغ الأسباب" />
-		<aya index="37" text="أسباب السماوات فأطلع إلى إله موسى وإني لأظنه كاذبا وكذلك زين لفرعون سوء عمله وصد عن السبيل وما كيد فرعون إلا في تباب" />
-		<aya index="38" text="وقال الذي آمن يا قوم اتبعون أهدكم سبيل الرشاد" />
-		<aya index="39" text="يا قوم إنما هذه الحياة الدنيا متاع وإن الآخرة هي دار القرار" />
-		<aya index="40" text="من عمل سيئة فلا يجزى إلا مثلها ومن عمل صالحا من ذكر أو أنثى وهو مؤمن فأولئك يدخلون الجنة يرزقون فيها بغير حساب" />
-		<aya index="41" text="ويا قوم ما لي أدعوكم إلى النجاة وتدعونني إلى النار" />
-		<aya index="42" text="تدعونني لأكفر بالله وأشرك به ما ليس لي به علم وأنا أدعوكم إلى العزيز الغفار" />
-		<aya index="43" text="لا جرم أنما تدعونني إليه ليس له دعوة في الدنيا ولا في الآخرة وأن مردنا إلى الله وأن المسرفين هم أصحاب النار" />
-		<aya index="44" text="فستذكرون ما أقول لكم وأفوض أمري إلى الله إن الله بصير بالعباد" />
-		<aya index="45" text="فوقاه الله سيئات ما مكروا وحاق بآل فرعون سوء العذاب" />
-		<aya index="46" text="النار يعرضون عليها غدوا وعشيا ويوم تقوم الساعة أدخلوا آل فرعون أشد العذاب" />
-		<aya index="47" text="وإذ يتحاجون في النار فيقول الضعفاء للذين استكبروا إنا كنا لكم تبعا فهل أنتم مغنون عنا نصيبا من النار" />
-		<aya index="48" text="قال الذين استكبروا إنا كل فيها إن الله قد حكم بين العباد" />
-		<aya index="49" text="وقال الذين في النار لخزنة جهنم ادعوا ربكم يخفف عنا يوما من العذاب" />
-		<aya index="50" text="قالوا أولم تك تأتيكم رسلكم بالبينات قالوا بلى قالوا فادعوا وما دعاء الكافرين إلا في ضلال" />
-		<aya index="51" text="إنا لننصر رسلنا والذين آمنوا في الحياة الدنيا ويوم يقوم الأشهاد" />
-		<aya index="52" text="يوم لا ينفع الظالمين معذرتهم ولهم اللعنة ولهم سوء الدار" />
-		<aya index="53" text="ولقد آتينا موسى الهدى وأورثنا بني إسرائيل الكتاب" />
-		<aya index="54" text="هدى وذكرى لأولي الألباب" />
-		<aya index="55" text="فاصبر إن وعد الله حق واستغفر لذنبك وسبح بحمد ربك بالعشي والإبكار" />
-		<aya index="56" text="إن الذين يجادلون في آيات الله بغير سلطان أتاهم إن في صدورهم إلا كبر ما هم ببالغيه فاستعذ بالله إنه هو السميع البصير" />
-		<aya index="57" text="لخلق السماوات والأرض أكبر من خلق الناس ولكن أكثر الناس لا يعلمون" />
-		<aya index="58" text="وما يستوي الأعمى والبصير والذين آمنوا وعملوا الصالحات ولا المسيء قليلا ما تتذكرون" />
-		<aya index="59" text="إن الساعة لآتية لا ريب فيها ولكن أكثر الناس لا يؤمنون" />
-		<aya index="60" text="وقال ربكم ادعوني أستجب لكم إن الذين يستكبرون عن عبادتي سيدخلون جهنم داخرين" />
-		<aya index="61" text="الله الذي جعل لكم الليل لتسكنوا فيه والنهار مبصرا إن الله لذو فضل على الناس ولكن أكثر الناس لا يشكرون" />
-		<aya index="62" text="ذلكم الله ربكم خالق كل شيء لا إله إلا هو فأنى تؤفكون" />
-		<aya index="63" text="كذلك يؤفك الذين كانوا بآيات الله يجحدون" />
-		<aya index="64" text="الله الذي جعل لكم الأرض قرارا والسماء بناء وصوركم فأحسن صوركم ورزقكم من الطيبات ذلكم الله ربكم فتبارك الله رب العالمين" />
-		<aya index="65" text="هو الحي لا إله إلا هو فادعوه مخلصين له الدين الحمد لله رب العالمين" />
-		<aya index="66" text="قل إني نهيت أن أعبد الذين تدعون من دون الله لما جاءني البينات من ربي وأمرت أن أسلم لرب العالمين" />
-		<aya index="67" text="هو الذي خلقكم من تراب ثم من نطفة ثم من علقة ثم يخرجكم طفلا ثم لتبلغوا أشدكم ثم لتكونوا شيوخا ومنكم من يتوفى من قبل ولتبلغوا أجلا مسمى ولعلكم تعقلون" />
-		<aya index="68" text="هو الذي يحيي ويميت فإذا قضى أمرا فإنما يقول له كن فيكون" />
-		<aya index="69" text="ألم تر إلى الذين يجادلون في آيات الله أنى يصرفون" />
-		<aya index="70" text="الذين كذبوا بالكتاب وبما أرسلنا به رسلنا فسوف يعلمون" />
-		<aya index="71" text="إذ الأغلال في أعناقهم والسلاسل يسحبون" />
-		<aya index="72" text="في الحميم ثم في النار يسجرون" />
-		<aya index="73" text="ثم قيل لهم أين ما كنتم تشركون" />
-		<aya index="74" text="من دون الله قالوا ضلوا عنا بل لم نكن ندعو من قبل شيئا كذلك يضل الله الكافرين" />
-		<aya index="75" text="ذلكم بما كنتم تفرحون في الأرض بغير الحق وبما كنتم تمرحون" />
-		<aya index="76" text="ادخلوا أبواب جهنم خالدين فيها فبئس مثوى المتكبرين" />
-		<aya index="77" text="فاصبر إن وعد الله حق فإما نرينك بعض الذي نعدهم أو نتوفينك فإلينا يرجعون" />
-		<aya index="78" text="ولقد أرسلنا رسلا من قبلك منهم من قصصنا عليك ومنهم من لم نقصص عليك وما كان لرسول أن يأتي بآية إلا بإذن الله فإذا جاء أمر الله قضي بالحق وخسر هنالك المبطلون" />
-		<aya index="79" text="الله الذي جعل لكم الأنعام لتركبوا منها ومنها تأكلون" />
-		<aya index="80" text="ولكم فيها منافع ولتبلغوا عليها حاجة في صدوركم وعليها وعلى الفلك تحملون" />
-		<aya index="81" text="ويريكم آياته فأي آيات الله تنكرون" />
-		<aya index="82" text="أفلم يسيروا في الأرض فينظروا كيف كان عاقبة الذين من قبلهم كانوا أكثر منهم وأشد قوة وآثارا في الأرض فما أغنى عنهم ما كانوا يكسبون" />
-		<aya index="83" text="فلما جاءتهم رسلهم بالبينات فرحوا بما عندهم من العلم وحاق بهم ما كانوا به يستهزئون" />
-		<aya index="84" text="فلما رأوا بأسنا قالوا آمنا بالله وحده وكفرنا بما كنا به مشركين" />
-		<aya index="85" text="فلم يك ينفعهم إيمانهم لما رأوا بأسنا سنت الله التي قد خلت في عباده وخسر هنالك الكافرون" />
-	</sura>
-	<sura index="41" name="فصلت">
-		<aya index="1" text="حم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="تنزيل من الرحمن الرحيم" />
-		<aya index="3" text="كتاب فصلت آياته قرآنا عربيا لقوم يعلمون" />
-		<aya index="4" text="بشيرا ونذيرا فأعرض أكثرهم فهم لا يسمعون" />
-		<aya index="5" text="وقالوا قلوبنا في أكنة مما تدعونا إليه وفي آذاننا وقر ومن بيننا وبينك حجاب فاعمل إننا عاملون" />
-		<aya index="6" text="قل إنما أنا بشر مثلكم يوحى إلي أنما إلهكم إله واحد فاستقيموا إليه واستغفروه وويل للمشركين" />
-		<aya index="7" text="الذين لا يؤتون الزكاة وهم بالآخرة هم كافرون" />
-		<aya index="8" text="إن الذين آمنوا وعملوا الصالحات لهم أجر غير ممنون" />
-		<aya index="9" text="قل أئنكم لتكفرون بالذي خلق الأرض في يومين وتجعلون له أندادا ذلك رب العالمين" />
-		<aya index="10" text="وجعل فيها رواسي من فوقها وبارك فيها وقدر فيها أقواتها في أربعة أيام سواء للسائلين" />
-		<aya index="11" text="ثم استوى إلى السماء وهي دخان فقال لها وللأرض ائتيا طوعا أو كرها قالتا أتينا طائعين" />
-		<aya index="12" text="فقضاهن سبع سماوات في يومين وأوحى في كل سماء أمرها وزينا السماء الدنيا بمصابيح وحفظا ذلك تقدير العزيز العليم" />
-		<aya index="13" text="فإن أعرضوا فقل أنذرتكم صاعقة مثل صاعقة عاد وثمود" />
-		<aya index="14" text="إذ جاءتهم الرسل من بين أيديهم ومن خلفهم ألا تعبدوا إلا الله قالوا لو شاء ربنا لأنزل ملائكة فإنا بما أرسلتم به كافرون" />
-		<aya index="15" text="فأما عاد فاستكبروا في الأرض بغير الحق وقالوا من أشد منا قوة أولم يروا أن الله الذي خلقهم هو أشد منهم قوة وكانوا بآياتنا يجحدون" />
-		<aya index="16" text="فأرسلنا عليهم ريحا صرصرا في أيام نحسات لنذيقهم عذاب الخزي في الحياة الدنيا ولعذاب الآخرة أخزى وهم لا ينصرون" />
-		<aya index="17" text="وأما ثمود فهديناهم فاستحبوا العمى على الهدى فأخذتهم صاعقة العذاب الهون بما كانوا يكسبون" />
-		<aya index="18" text="ونجينا الذين آمنوا وكانوا يتقون" />
-		<aya index="19" text="ويوم يحشر أعداء الله إلى النار فهم يوزعون" />
-		<aya index="20" text="حتى إذا ما جاءوها شهد عليهم سمعهم وأبصارهم وجلودهم بما كانوا يعملون" />
-		<aya index="21" text="وقالوا لجلودهم لم شهدتم علينا قالوا أنطقنا الله الذي أنطق كل شيء وهو خلقكم أول مرة وإليه ترجعون" />
-		<aya index="22" text="وما كنتم تستترون أن يشهد عليكم سمعكم ولا أبصاركم ولا جلودكم ولكن ظننتم أن الله لا يعلم كثيرا مما تعملون" />
-		<aya index="23" text="وذلكم ظنكم الذي ظننتم بربكم أرداكم فأصبحتم من الخاسرين" />
-		<aya index="24" text="فإن يصبروا فالنار مثوى لهم وإن يستعتبوا فما هم من المعتبين" />
-		<aya index="25" text="وقيضنا لهم قرناء فزينوا لهم ما بين أيديهم وما خلفهم وحق عليهم القول في أمم قد خلت من قبلهم من الجن والإنس إنهم كانوا خاسرين" />
-		<aya index="26" text="وقال الذين كفروا لا تسمعوا لهذا القرآن والغوا فيه لعلكم تغلبون" />
-		<aya index="27" text="فلنذيقن الذين كفروا عذابا شديدا ولنجزينهم أسوأ الذي كانوا يعملون" />
-		<aya index="28" text="ذلك جزاء أعداء الله النار لهم فيها دار الخلد جزاء بما كانوا بآياتنا يجحدون" />
-		<aya index="29" text="وقال الذين كفروا ربنا أرنا اللذين أضلانا من الجن والإنس نجعلهما تحت أقدامنا ليكونا من الأسفلين" />
-		<aya index="30" text="إن الذين قالوا ربنا الله ثم استقاموا تتنزل عليهم الملائكة ألا تخافوا ولا تحزنوا وأبشروا بالجنة التي كنتم توعدون" />
-		<aya index="31" text="نحن أولياؤكم في الحياة الدنيا وفي الآخرة ولكم فيها ما تشتهي أنفسكم ولكم فيها ما تدعون" />
-		<aya index="32" text="نزلا من غفور رحيم" />
-		<aya index="33" text="ومن أحسن قولا ممن دعا إلى الله وعمل صالحا وقال إنني من المسلمين" />
-		<aya index="34" text="ولا تستوي الحسنة ولا السيئة ادفع بالتي هي أحسن فإذا الذي بينك وبينه عداوة كأنه ولي حميم" />
-		<aya index="35" text="وما يلقاها إلا الذين صبروا وما يلقاها إلا ذو حظ عظيم" />
-		<aya index="36" text="وإما ينزغنك من الشيطان نزغ فاستعذ بالله إنه هو السميع العليم" />
-		<aya index="37" text="ومن آياته الليل والنهار والشمس والقمر لا تسجدوا للشمس ولا للقمر واسجدوا لله الذي خلقهن إن كنتم إياه تعبدون" />
-		<aya index="38" text="فإن استكبروا فالذين عند ربك يسبحون له بالليل والنهار وهم لا يسأمون" />
-		<aya index="39" text="ومن آياته أنك ترى الأرض خاشعة فإذا أنزلنا عليها الماء اهتزت وربت إن الذي أحياها لمحيي الموتى إنه على كل شيء قدير" />
-		<aya index="40" text="إن الذين يلحدون في آياتنا لا يخفون علينا أفمن يلقى في النار خير أم من يأتي آمنا يوم القيامة اعملوا ما شئتم إنه بما تعملون بصير" />
-		<aya index="41" text="إن الذين كفروا بالذكر لما جاءهم وإنه لكتاب عزيز" />
-		<aya index="42" text="لا يأتيه الباطل من بين يديه ولا من خلفه تنزيل من حكيم حميد" />
-		<aya index="43" text="ما يقال لك إلا ما قد قيل للرسل من قبلك إن ربك لذو مغفرة وذو عقاب أليم" />
-		<aya index="44" text="ولو جعلناه قرآنا أعجميا لقالوا لولا فصلت آياته أأعجمي وعربي قل هو للذين آمنوا هدى وشفاء والذين لا يؤمنون في آذانهم وقر وهو عليهم عمى أولئك ينادون من مكان بعيد" />
-		<aya index="45" text="ولقد آتينا موسى الكتاب فاختلف فيه ولولا كلمة سبقت من ربك لقضي بينهم وإنهم لفي شك منه مريب" />
-		<aya index="46" text="من عمل صالحا فلنفسه ومن أساء فعليها وما ربك بظلام للعبيد" />
-		<aya index="47" text="إليه يرد علم الساعة وما تخرج من ثمرات من أكمامها وما تحمل من أنثى ولا تضع إلا بعلمه ويوم يناديهم أين شركائي قالوا آذناك ما منا من شهيد" />
-		<aya index="48" text="وضل عنهم ما كانوا يدعون من قبل وظنوا ما لهم من محيص" />
-		<aya index="49" text="لا يسأم الإنسان من دعاء الخير وإن مسه الشر فيئوس قنوط" />
-		<aya index="50" text="ولئن أذقناه رحمة منا من بعد ضراء مسته ليقولن هذا لي وما أظن الساعة قائمة ولئن رجعت إلى ربي إن لي عنده للحسنى فلننبئن الذين كفروا بما عملوا ولنذيقنهم من عذاب غليظ" />
-		<aya index="51" text="وإذا أنعمنا على الإنسان أعرض ونأى بجانبه وإذا مسه الشر فذو دعاء عريض" />
-		<aya index="52" text="قل أرأيتم إن كان من عند الله ثم كفرتم به من أضل ممن هو في شقاق بعيد" />
-		<aya index="53" text="سنريهم آياتنا في الآفاق وفي أنفسهم حتى يتبين لهم أنه الحق أولم يكف بربك أنه على كل شيء شهيد" />
-		<aya index="54" text="ألا إنهم في مرية من لقاء ربهم ألا إنه بكل شيء محيط" />
-	</sura>
-	<sura index="42" name="الشورى">
-		<aya index="1" text="حم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="عسق" />
-		<aya index="3" text="كذلك يوحي إليك وإلى الذين من قبلك الله العزيز الحكيم" />
-		<aya index="4" text="له ما في السماوات وما في الأرض وهو العلي العظيم" />
-		<aya index="5" text="تكاد السماوات يتفطرن من فوقهن والملائكة يسبحون بحمد ربهم ويستغفرون لمن في الأرض ألا إن الله هو الغفور الرحيم" />
-		<aya index="6" text="والذين اتخذوا من دونه أولياء الله حفيظ عليهم وما أنت عليهم بوكيل" />
-		<aya index="7" text="وكذلك أوحينا إليك قرآنا عربيا لتنذر أم القرى ومن حولها وتنذر يوم الجمع لا ريب فيه فريق في الجنة وفريق في السعير" />
-		<aya index="8" text="ولو شاء الله لجعلهم أمة واحدة ولكن يدخل من يشاء في رحمته والظالمون ما لهم من ولي ولا نصير" />
-		<aya index="9" text="أم اتخذوا من دونه أولياء فالله هو الولي وهو يحيي الموتى وهو على كل شيء قدير" />
-		<aya index="10" text="وما اختلفتم فيه من شيء فحكمه إلى الله ذلكم الله ربي عليه توكلت وإليه أنيب" />
-		<aya index="11" text="فاطر السماوات والأرض جعل لكم من أنفسكم أزواجا ومن الأنعام أزواجا يذرؤكم فيه ليس كمثله شيء وهو السميع البصير" />
-		<aya index="12" text="له مقاليد السماوات والأرض يبسط الرزق لمن يشاء ويقدر إنه بكل شيء عليم" />
-		<aya index="13" text="شرع لكم من الدين ما وصى به نوحا والذي أوحينا إليك وما وصينا به إبراهيم وموسى وعيسى أن أقيموا الدين ولا تتفرقوا فيه كبر على المشركين ما تدعوهم إليه الله يجتبي إليه من يشاء ويهدي إليه من ينيب" />
-		<aya index="14" text="وما تفرقوا إلا من بعد ما جاءهم العلم بغيا بينهم ولولا كلمة سبقت من ربك إلى أجل مسمى لقضي بينهم وإن الذين أورثوا الكتاب من بعدهم لفي شك منه مريب" />
-		<aya index="15" text="فلذلك فادع واستقم كما أمرت ولا تتبع أهواءهم وقل آمنت بما أنزل الله من كتاب وأمرت لأعدل بينكم الله ربنا وربكم لنا أعمالنا ولكم أعمالكم لا حجة بيننا وبينكم الله يجمع بيننا وإليه المصير" />
-		<aya index="16" text="والذين يحاجون في الله من بعد ما استجيب له حجتهم داحضة عند ربهم وعليهم غضب ولهم عذاب شديد" />
-		<aya index="17" text="الله الذي أنزل الكتاب بالحق والميزان وما يدريك لعل الساعة قريب" />
-		<aya index="18" text="يستعجل بها الذين لا يؤمنون بها والذين آمنوا مشفقون منها ويعلمون أنها الحق ألا إن الذين يمارون في الساعة لفي ضلال بعيد" />
-		<aya index="19" text="الله لطيف بعباده يرزق من يشاء وهو القوي العزيز" />
-		<aya index="20" text="من كان يريد حرث الآخرة نزد له في حرثه ومن كان يريد حرث الدنيا نؤته منها وما له في الآخرة من نصيب" />
-		<aya index="21" text="أم لهم شركاء شرعوا لهم من الدين ما لم يأذن به الله ولولا كلمة الفصل لقضي بينهم وإن الظالمين لهم عذاب أليم" />
-		<aya index="22" text="ترى الظالمين مشفقين مما كسبوا وهو واقع بهم والذين آمنوا وعملوا الصالحات في روضات الجنات لهم ما يشاءون عند ربهم ذلك هو الفضل الكبير" />
-		<aya index="23" text="ذلك الذي يبشر الله عباده الذين آمنوا وعملوا الصالحات قل لا أسألكم عليه أجرا إلا المودة في القربى ومن يقترف حسنة نزد له فيها حسنا إن الله غفور شكور" />
-		<aya index="24" text="أم يقولون افترى على الله كذبا فإن يشإ الله يختم على قلبك ويمح الله الباطل ويحق الحق بكلماته إنه عليم بذات الصدور" />
-		<aya index="25" text="وهو الذي يقبل التوبة عن عباده ويعفو عن السيئات ويعلم ما تفعلون" />
-		<aya index="26" text="ويستجيب الذين آمنوا وعملوا الصالحات ويزيدهم من فضله والكافرون لهم عذاب شديد" />
-		<aya index="27" text="ولو بسط الله الرزق لعباده لبغوا في الأرض ولكن ينزل بقدر ما يشاء إنه بعباده خبير بصير" />
-		<aya index="28" text="وهو الذي ينزل الغيث من بعد ما قنطوا وينشر رحمته وهو الولي الحميد" />
-		<aya index="29" text="ومن آياته خلق السماوات والأرض وما بث فيهما من دابة وهو على جمعهم إذا يشاء قدير" />
-		<aya index="30" text="وما أصابكم من مصيبة فبما كسبت أيديكم ويعفو عن كثير" />
-		<aya index="31" text="وما أنتم بمعجزين في الأرض وما لكم من دون الله من ولي ولا نصير" />
-		<aya index="32" text="ومن آياته الجوار في البحر كالأعلام" />
-		<aya index="33" text="إن يشأ يسكن الريح فيظللن رواكد على ظهره إن في ذلك لآيات لكل صبار شكور" />
-		<aya index="34" text="أو يوبقهن بما كسبوا ويعف عن كثير" />
-		<aya index="35" text="ويعلم الذين يجادلون في آياتنا ما لهم من محيص" />
-		<aya index="36" text="فما أوتيتم من شيء فمتاع الحياة الدنيا وما عند الله خير وأبقى للذين آمنوا وعلى ربهم يتوكلون" />
-		<aya index="37" text="والذين يجتنبون كبائر الإثم والفواحش وإذا ما غضبوا هم يغفرون" />
-		<aya index="38" text="والذين استجابوا لربهم وأقاموا الصلاة وأمرهم شورى بينهم ومما رزقناهم ينفقون" />
-		<aya index="39" text="والذين إذا أصابهم البغي هم ينتصرون" />
-		<aya index="40" text="وجزاء سيئة سيئة مثلها فمن عفا وأصلح فأجره على الله إنه لا يحب الظالمين" />
-		<aya index="41" text="ولمن انتصر بعد ظلمه فأولئك ما عليهم من سبيل" />
-		<aya index="42" text="إنما السبيل على الذين يظلمون الناس ويبغون في الأرض بغير الحق أولئك لهم عذاب أليم" />
-		<aya index="43" text="ولمن صبر وغفر إن ذلك لمن عزم الأمور" />
-		<aya index="44" text="ومن يضلل الله فما له من ولي من بعده وترى الظالمين لما رأوا العذاب يقولون هل إلى مرد من سبيل" />
-		<aya index="45" text="وتراهم يعرضون عليها خاشعين من الذل ينظرون من طرف خفي وقال الذين آمنوا إن الخاسرين الذين خسروا أنفسهم وأهليهم يوم القيامة ألا إن الظالمين في عذاب مقيم" />
-		<aya index="46" text="وما كان لهم من أولياء ينصرونهم من دون الله ومن يضلل الله فما له من سبيل" />
-		<aya index="47" text="استجيبوا لربكم من قبل أن يأتي يوم لا مرد له من الله ما لكم من ملجإ يومئذ وما لكم من نكير" />
-		<aya index="48" text="فإن أعرضوا فما أرسلناك عليهم حفيظا إن عليك إلا البلاغ وإنا إذا أذقنا الإنسان منا رحمة فرح بها وإن تصبهم سيئة بما قدمت أيديهم فإن الإنسان كفور" />
-		<aya index="49" text="لله ملك السماوات والأرض يخلق ما يشاء يهب لمن يشاء إناثا ويهب لمن يشاء الذكور" />
-		<aya index="50" text="أو يزوجهم ذكرانا وإناثا ويجعل من يشاء عقيما إنه عليم قدير" />
-		<aya index="51" text="وما كان لبشر أن يكلمه الله إلا وحيا أو من وراء حجاب أو يرسل رسولا فيوحي بإذنه ما يشاء إنه علي حكيم" />
-		<aya index="52" text="وكذلك أوحينا إليك روحا من أمرنا ما كنت تدري ما الكتاب ولا الإيمان ولكن جعلناه نورا نهدي به من نشاء من عبادنا وإنك لتهدي إلى صراط مستقيم" />
-		<aya index="53" text="صراط الله الذي له ما في السماوات وما في الأرض ألا إلى الله تصير الأمور" />
-	</sura>
-	<sura index="43" name="الزخرف">
-		<aya index="1" text="حم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="والكتاب المبين" />
-		<aya index="3" text="إنا جعلناه قرآنا عربيا لعلكم تعقلون" />
-		<aya index="4" text="وإنه في أم الكتاب لدينا لعلي حكيم" />
-		<aya index="5" text="أفنضرب عنكم الذكر صفحا أن كنتم قوما مسرفين" />
-		<aya index="6" text="وكم أرسلنا من نبي في الأولين" />
-		<aya index="7" text="وما يأتيهم من نبي إلا كانوا به يستهزئون" />
-		<aya index="8" text="فأهلكنا أشد منهم بطشا ومضى مثل الأولين" />
-		<aya index="9" text="ولئن سألتهم من خلق السماوات والأرض ليقولن خلقهن العزيز العليم" />
-		<aya index="10" text="الذي جعل لكم الأرض مهدا وجعل لكم فيها سبلا لعلكم تهتدون" />
-		<aya index="11" text="والذي نزل من السماء ماء بقدر فأنشرنا به بلدة ميتا كذلك تخرجون" />
-		<aya index="12" text="والذي خلق الأزواج كلها وجعل لكم من الفلك والأنعام ما تركبون" />
-		<aya index="13" text="لتستووا على ظهوره ثم تذكروا نعمة ربكم إذا استويتم عليه وتقولوا سبحان الذي سخر لنا هذا وما كنا له مقرنين" />
-		<aya index="14" text="وإنا إلى ربنا لمنقلبون" />
-		<aya index="15" text="وجعلوا له من عباده جزءا إن الإنسان لكفور مبين" />
-		<aya index="16" text="أم اتخذ مما يخلق بنات وأصفاكم بالبنين" />
-		<aya index="17" text="وإذا بشر أحدهم بما ضرب للرحمن مثلا ظل وجهه مسودا وهو كظيم" />
-		<aya index="18" text="أومن ينشأ في الحلية وهو في الخصام غير مبين" />
-		<aya index="19" text="وجعلوا الملائكة الذين هم عباد الرحمن إناثا أشهدوا خلقهم ستكتب شهادتهم ويسألون" />
-		<aya index="20" text="وقالوا لو شاء الرحمن ما عبدناهم ما لهم بذلك من علم إن هم إلا يخرصون" />
-		<aya index="21" text="أم آتيناهم كتابا من قبله فهم به مستمسكون" />
-		<aya index="22" text="بل قالوا إنا وجدنا آباءنا على أمة وإنا على آثارهم مهتدون" />
-		<aya index="23" text="وكذلك ما أرسلنا من قبلك في قرية من نذير إلا قال مترفوها إنا وجدنا آباءنا على أمة وإنا على آثارهم مقتدون" />
-		<aya index="24" text="قال أولو جئتكم بأهدى مما وجدتم عليه آباءكم قالوا إنا بما أرسلتم به كافرون" />
-		<aya index="25" text="فانتقمنا منهم فانظر كيف كان عاقبة المكذبين" />
-		<aya index="26" text="وإذ قال إبراهيم لأبيه وقومه إنني براء مما تعبدون" />
-		<aya index="27" text="إلا الذي فطرني فإنه سيهدين" />
-		<aya index="28" text="وجعلها كلمة باقية في عقبه لعلهم يرجعون" />
-		<aya index="29" text="بل متعت هؤلاء وآباءهم حتى جاءهم الحق ورسول مبين" />
-		<aya index="30" text="ولما جاءهم الحق قالوا هذا سحر وإنا به كافرون" />
-		<aya index="31" text="وقالوا لولا نزل هذا القرآن على رجل من القريتين عظيم" />
-		<aya index="32" text="أهم يقسمون رحمت ربك نحن قسمنا بينهم معيشتهم في الحياة الدنيا ورفعنا بعضهم فوق بعض درجات ليتخذ بعضهم بعضا سخريا ورحمت ربك خير مما يجمعون" />
-		<aya index="33" text="ولولا أن يكون الناس أمة واحدة لجعلنا لمن يكفر بالرحمن لبيوتهم سقفا من فضة ومعارج عليها يظهرون" />
-		<aya index="34" text="ولبيوتهم أبوابا وسررا عليها يتكئون" />
-		<aya index="35" text="وزخرفا وإن كل ذلك لما متاع الحياة الدنيا والآخرة عند ربك للمتقين" />
-		<aya index="36" text="ومن يعش عن ذكر الرحمن نقيض له شيطانا فهو له قرين" />
-		<aya index="37" text="وإنهم ليصدونهم عن السبيل ويحسبون أنهم مهتدون" />
-		<aya index="38" text="حتى إذا جاءنا قال يا ليت بيني وبينك بعد المشرقين فبئس القرين" />
-		<aya index="39" text="ولن ينفعكم اليوم إذ ظلمتم أنكم في العذاب مشتركون" />
-		<aya index="40" text="أفأنت تسمع الصم أو تهدي العمي ومن كان في ضلال مبين" />
-		<aya index="41" text="فإما نذهبن بك فإنا منهم منتقمون" />
-		<aya index="42" text="أو نرينك الذي وعدناهم فإنا عليهم مقتدرون" />
-		<aya index="43" text="فاستمسك بالذي أوحي إليك إنك على صراط مستقيم" />
-		<aya index="44" text="وإنه لذكر لك ولقومك وسوف تسألون" />
-		<aya index="45" text="واسأل من أرسلنا من قبلك من رسلنا أجعلنا من دون الرحمن آلهة يعبدون" />
-		<aya index="46" text="ولقد أرسلنا موسى بآياتنا إلى فرعون وملئه فقال إني رسول رب العالمين" />
-		<aya index="47" text="فلما جاءهم بآياتنا إذا هم منها يضحكون" />
-		<aya index="48" text="وما نريهم من آية إلا هي أكبر من أختها وأخذناهم بالعذاب لعلهم يرجعون" />
-		<aya index="49" text="وقالوا يا أيه الساحر ادع لنا ربك بما عهد عندك إننا لمهتدون" />
-		<aya index="50" text="فلما كشفنا عنهم العذاب إذا هم ينكثون" />
-		<aya index="51" text="ونادى فرعون في قومه قال يا قوم أليس لي ملك مصر وهذه الأنهار تجري من تحتي أفلا تبصرون" />
-		<aya index="52" text="أم أنا خير من هذا الذي هو مهين ولا يكاد يبين" />
-		<aya index="53" text="فلولا ألقي عليه أسورة من ذهب أو جاء معه الملائكة مقترنين" />
-		<aya index="54" text="فاستخف قومه فأطاعوه إنهم كانوا قوما فاسقين" />
-		<aya index="55" text="فلما آسفونا انتقمنا منهم فأغرقناهم أجمعين" />
-		<aya index="56" text="فجعلناهم سلفا ومثلا للآخرين" />
-		<aya index="57" text="ولما ضرب ابن مريم مثلا إذا قومك منه يصدون" />
-		<aya index="58" text="وقالوا أآلهتنا خير أم هو ما ضربوه لك إلا جدلا بل هم قوم خصمون" />
-		<aya index="59" text="إن هو إلا عبد أنعمنا عليه وجعلناه مثلا لبني إسرائيل" />
-		<aya index="60" text="ولو نشاء لجعلنا منكم ملائكة في الأرض يخلفون" />
-		<aya index="61" text="وإنه لعلم للساعة فلا تمترن بها واتبعون هذا صراط مستقيم" />
-		<aya index="62" text="ولا يصدنكم الشيطان إنه لكم عدو مبين" />
-		<aya index="63" text="ولما جاء عيسى بالبينات قال قد جئتكم بالحكمة ولأبين لكم بعض الذي تختلفون فيه فاتقوا الله وأطيعون" />
-		<aya index="64" text="إن الله هو ربي وربكم فاعبدوه هذا صراط مستقيم" />
-		<aya index="65" text="فاختلف الأحزاب من بينهم فويل للذين ظلموا من عذاب يوم أليم" />
-		<aya index="66" text="هل ينظرون إلا الساعة أن تأتيهم بغتة وهم لا يشعرون" />
-		<aya index="67" text="الأخلاء يومئذ بعضهم لبعض عدو إلا المتقين" />
-		<aya index="68" text="يا عباد لا خوف عليكم اليوم ولا أنتم تحزنون" />
-		<aya index="69" text="الذين آمنوا بآياتنا وكانوا مسلمين" />
-		<aya index="70" text="ادخلوا الجنة أنتم وأزواجكم تحبرون" />
-		<aya index="71" text="يطاف عليهم بصحاف من ذهب وأكواب وفيها ما تشتهيه الأنفس وتلذ الأعين وأنتم فيها خالدون" />
-		<aya index="72" text="وتلك الجنة التي أورثتموها بما كنتم تعملون" />
-		<aya index="73" text="لكم فيها فاكهة كثيرة منها تأكلون" />
-		<aya index="74" text="إن المجرمين في عذاب جهنم خالدون" />
-		<aya index="75" text="لا يفتر عنهم وهم فيه مبلسون" />
-		<aya index="76" text="وما ظلمناهم ولكن كانوا هم الظالمين" />
-		<aya index="77" text="ونادوا يا مالك ليقض علينا ربك قال إنكم ماكثون" />
-		<aya index="78" text="لقد جئناكم بالحق ولكن أكثركم للحق كارهون" />
-		<aya index="79" text="أم أبرموا أمرا فإنا مبرمون" />
-		<aya index="80" text="أم يحسبون أنا لا نسمع سرهم ونجواهم بلى ورسلنا لديهم يكتبون" />
-		<aya index="81" text="قل إن كان للرحمن ولد فأنا أول العابدين" />
-		<aya index="82" text="سبحان رب السماوات والأرض رب العرش عما يصفون" />
-		<aya index="83" text="فذرهم يخوضوا ويلعبوا حتى يلاقوا يومهم الذي يوعدون" />
-		<aya index="84" text="وهو الذي في السماء إله وفي الأرض إله وهو الحكيم العليم" />
-		<aya index="85" text="وتبارك الذي له ملك السماوات والأرض وما بينهما وعنده علم الساعة وإليه ترجعون" />
-		<aya index="86" text="ولا يملك الذين يدعون من دونه الشفاعة إلا من شهد بالحق وهم يعلمون" />
-		<aya index="87" text="ولئن سألتهم من خلقهم ليقولن الله فأنى يؤفكون" />
-		<aya index="88" text="وقيله يا رب إن هؤلاء قوم لا يؤمنون" />
-		<aya index="89" text="فاصفح عنهم وقل سلام فسوف يعلمون" />
-	</sura>
-	<sura index="44" name="الدخان">
-		<aya index="1" text="حم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="والكتاب المبين" />
-		<aya index="3" text="إنا أنزلناه في ليلة مباركة إنا كنا منذرين" />
-		<aya index="4" text="فيها يفرق كل أمر حكيم" />
-		<aya index="5" text="أمرا من عندنا إنا كنا مرسلين" />
-		<aya index="6" text="رحمة من ربك إنه هو السميع العليم" />
-		<aya index="7" text="رب السماوات والأرض وما بينهما إن كنتم موقنين" />
-		<aya index="8" text="لا إله إلا هو يحيي ويميت ربكم ورب آبائكم الأولين" />
-		<aya index="9" text="بل هم في شك يلعبون" />
-		<aya index="10" text="فارتقب يوم تأتي السماء بدخان مبين" />
-		<aya index="11" text="يغشى الناس هذا عذاب أليم" />
-		<aya index="12" text="ربنا اكشف عنا العذاب إنا مؤمنون" />
-		<aya index="13" text="أنى لهم الذكرى وقد جاءهم رسول مبين" />
-		<aya index="14" text="ثم تولوا عنه وقالوا معلم مجنون" />
-		<aya index="15" text="إنا كاشفو العذاب قليلا إنكم عائدون" />
-		<aya index="16" text="يوم نبطش البطشة الكبرى إنا منتقمون" />
-		<aya index="17" text="ولقد فتنا قبلهم قوم فرعون وجاءهم رسول كريم" />
-		<aya index="18" text="أن أدوا إلي عباد الله إني لكم رسول أمين" />
-		<aya index="19" text="وأن لا تعلوا على الله إني آتيكم بسلطان مبين" />
-		<aya index="20" text="وإني عذت بربي وربكم أن ترجمون" />
-		<aya index="21" text="وإن لم تؤمنوا لي فاعتزلون" />
-		<aya index="22" text="فدعا ربه أن هؤلاء قوم مجرمون" />
-		<aya index="23" text="فأسر بعبادي ليلا إنكم متبعون" />
-		<aya index="24" text="واترك البحر رهوا إنهم جند مغرقون" />
-		<aya index="25" text="كم تركوا من جنات وعيون" />
-		<aya index="26" text="وزروع ومقام كريم" />
-		<aya index="27" text="ونعمة كانوا فيها فاكهين" />
-		<aya index="28" text="كذلك وأورثناها قوما آخرين" />
-		<aya index="29" text="فما بكت عليهم السماء والأرض وما كانوا منظرين" />
-		<aya index="30" text="ولقد نجينا بني إسرائيل من العذاب المهين" />
-		<aya index="31" text="من فرعون إنه كان عاليا من المسرفين" />
-		<aya index="32" text="ولقد اخترناهم على علم على العالمين" />
-		<aya index="33" text="وآتيناهم من الآيات ما فيه بلاء مبين" />
-		<aya index="34" text="إن هؤلاء ليقولون" />
-		<aya index="35" text="إن هي إلا موتتنا الأولى وما نحن بمنشرين" />
-		<aya index="36" text="فأتوا بآبائنا إن كنتم صادقين" />
-		<aya index="37" text="أهم خير أم قوم تبع والذين من قبلهم أهلكناهم إنهم كانوا مجرمين" />
-		<aya index="38" text="وما خلقنا السماوات والأرض وما بينهما لاعبين" />
-		<aya index="39" text="ما خلقناهما إلا بالحق ولكن أكثرهم لا يعلمون" />
-		<aya index="40" text="إن يوم الفصل ميقاتهم أجمعين" />
-		<aya index="41" text="يوم لا يغني مولى عن مولى شيئا ولا هم ينصرون" />
-		<aya index="42" text="إلا من رحم الله إنه هو العزيز الرحيم" />
-		<aya index="43" text="إن شجرت الزقوم" />
-		<aya index="44" text="طعام الأثيم" />
-		<aya index="45" text="كالمهل يغلي في البطون" />
-		<aya index="46" text="كغلي الحميم" />
-		<aya index="47" text="خذوه فاعتلوه إلى سواء الجحيم" />
-		<aya index="48" text="ثم صبوا فوق رأسه من عذاب الحميم" />
-		<aya index="49" text="ذق إنك أنت العزيز الكريم" />
-		<aya index="50" text="إن هذا ما كنتم به تمترون" />
-		<aya index="51" text="إن المتقين في مقام أمين" />
-		<aya index="52" text="في جنات وعيون" />
-		<aya index="53" text="يلبسون من سندس وإستبرق متقابلين" />
-		<aya index="54" text="كذلك وزوجناهم بحور عين" />
-		<aya index="55" text="يدعون فيها بكل فاكهة آمنين" />
-		<aya index="56" text="لا يذوقون فيها الموت إلا الموتة الأولى ووقاهم عذاب الجحيم" />
-		<aya index="57" text="فضلا من ربك ذلك هو الفوز العظيم" />
-		<aya index="58" text="فإنما يسرناه بلسانك لعلهم يتذكرون" />
-		<aya index="59" text="فارتقب إنهم مرتقبون" />
-	</sura>
-	<sura index="45" name="الجاثية">
-		<aya index="1" text="حم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="تنزيل الكتاب من الله العزيز الحكيم" />
-		<aya index="3" text="إن في السماوات والأرض لآيات للمؤمنين" />
-		<aya index="4" text="وفي خلقكم وما يبث من دابة آيات لقوم يوقنون" />
-		<aya index="5" text="واختلاف الليل والنهار وما أنزل الله من السماء من رزق فأحيا به الأرض بعد موتها وتصريف الرياح آيات لقوم يعقلون" />
-		<aya index="6" text="تلك آيات الله نتلوها عليك بالحق فبأي حديث بعد الله وآياته يؤمنون" />
-		<aya index="7" text="ويل لكل أفاك أثيم" />
-		<aya index="8" text="يسمع آيات الله تتلى عليه ثم يصر مستكبرا كأن لم يسمعها فبشره بعذاب أليم" />
-		<aya index="9" text="وإذا علم من آياتنا شيئا اتخذها هزوا أولئك لهم عذاب مهين" />
-		<aya index="10" text="من ورائهم جهنم ولا يغني عنهم ما كسبوا شيئا ولا ما اتخذوا من دون الله أولياء ولهم عذاب عظيم" />
-		<aya index="11" text="هذا هدى والذين كفروا بآيات ربهم لهم عذاب من رجز أليم" />
-		<aya index="12" text="الله الذي سخر لكم البحر لتجري الفلك فيه بأمره ولتبتغوا من فضله ولعلكم تشكرون" />
-		<aya index="13" text="وسخر لكم ما في السماوات وما في الأرض جميعا منه إن في ذلك لآيات لقوم يتفكرون" />
-		<aya index="14" text="قل للذين آمنوا يغفروا للذين لا يرجون أيام الله ليجزي قوما بما كانوا يكسبون" />
-		<aya index="15" text="من عمل صالحا فلنفسه ومن أساء فعليها ثم إلى ربكم ترجعون" />
-		<aya index="16" text="ولقد آتينا بني إسرائيل الكتاب والحكم والنبوة ورزقناهم من الطيبات وفضلناهم على العالمين" />
-		<aya index="17" text="وآتيناهم بينات من الأمر فما اختلفوا إلا من بعد ما جاءهم العلم بغيا بينهم إن ربك يقضي بينهم يوم القيامة فيما كانوا فيه يختلفون" />
-		<aya index="18" text="ثم جعلناك على شريعة من الأمر فاتبعها ولا تتبع أهواء الذين لا يعلمون" />
-		<aya index="19" text="إنهم لن يغنوا عنك من الله شيئا وإن الظالمين بعضهم أولياء بعض والله ولي المتقين" />
-		<aya index="20" text="هذا بصائر للناس وهدى ورحمة لقوم يوقنون" />
-		<aya index="21" text="أم حسب الذين اجترحوا السيئات أن نجعلهم كالذين آمنوا وعملوا الصالحات سواء محياهم ومماتهم ساء ما يحكمون" />
-		<aya index="22" text="وخلق الله السماوات والأرض بالحق ولتجزى كل نفس بما كسبت وهم لا يظلمون" />
-		<aya index="23" text="أفرأيت من اتخذ إلهه هواه وأضله الله على علم وختم على سمعه وقلبه وجعل على بصره غشاوة فمن يهديه من بعد الله أفلا تذكرون" />
-		<aya index="24" text="وقالوا ما هي إلا حياتنا الدنيا نموت ونحيا وما يهلكنا إلا الدهر وما لهم بذلك من علم إن هم إلا يظنون" />
-		<aya index="25" text="وإذا تتلى عليهم آياتنا بينات ما كان حجتهم إلا أن قالوا ائتوا بآبائنا إن كنتم صادقين" />
-		<aya index="26" text="قل الله يحييكم ثم يميتكم ثم يجمعكم إلى يوم القيامة لا ريب فيه ولكن أكثر الناس لا يعلمون" />
-		<aya index="27" text="ولله ملك السماوات والأرض ويوم تقوم الساعة يومئذ يخسر المبطلون" />
-		<aya index="28" text="وترى كل أمة جاثية كل أمة تدعى إلى كتابها اليوم تجزون ما كنتم تعملون" />
-		<aya index="29" text="هذا كتابنا ينطق عليكم بالحق إنا كنا نستنسخ ما كنتم تعملون" />
-		<aya index="30" text="فأما الذين آمنوا وعملوا الصالحات فيدخلهم ربهم في رحمته ذلك هو الفوز المبين" />
-		<aya index="31" text="وأما الذين كفروا أفلم تكن آياتي تتلى عليكم فاستكبرتم وكنتم قوما مجرمين" />
-		<aya index="32" text="وإذا قيل إن وعد الله حق والساعة لا ريب فيها قلتم ما ندري ما الساعة إن نظن إلا ظنا وما نحن بمستيقنين" />
-		<aya index="33" text="وبدا لهم سيئات ما عملوا وحاق بهم ما كانوا به يستهزئون" />
-		<aya index="34" text="وقيل اليوم ننساكم كما نسيتم لقاء يومكم هذا ومأواكم النار وما لكم من ناصرين" />
-		<aya index="35" text="ذلكم بأنكم اتخذتم آيات الله هزوا وغرتكم الحياة الدنيا فاليوم لا يخرجون منها ولا هم يستعتبون" />
-		<aya index="36" text="فلله الحمد رب السماوات ورب الأرض رب العالمين" />
-		<aya index="37" text="وله الكبرياء في السماوات والأرض وهو العزيز الحكيم" />
-	</sura>
-	<sura index="46" name="الأحقاف">
-		<aya index="1" text="حم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="تنزيل الكتاب من الله العزيز الحكيم" />
-		<aya index="3" text="ما خلقنا السماوات والأرض وما بينهما إلا بالحق وأجل مسمى والذين كفروا عما أنذروا معرضون" />
-		<aya index="4" text="قل أرأيتم ما تدعون من دون الله أروني ماذا خلقوا من الأرض أم لهم شرك في السماوات ائتوني بكتاب من قبل هذا أو أثارة من علم إن كنتم صادقين" />
-		<aya index="5" text="ومن أضل ممن يدعو من دون الله من لا يستجيب له إلى يوم القيامة وهم عن دعائهم غافلون" />
-		<aya index="6" text="وإذا حشر الناس كانوا لهم أعداء وكانوا بعبادتهم كافرين" />
-		<aya index="7" text="وإذا تتلى عليهم آياتنا بينات قال الذين كفروا للحق لما جاءهم هذا سحر مبين" />
-		<aya index="8" text="أم يقولون افتراه قل إن افتريته فلا تملكون لي من الله شيئا هو أعلم بما تفيضون فيه كفى به شهيدا بيني وبينكم وهو الغفور الرحيم" />
-		<aya index="9" text="قل ما كنت بدعا من الرسل وما أدري ما يفعل بي ولا بكم إن أتبع إلا ما يوحى إلي وما أنا إلا نذير مبين" />
-		<aya index="10" text="قل أرأيتم إن كان من عند الله وكفرتم به وشهد شاهد من بني إسرائيل على مثله فآمن واستكبرتم إن الله لا يهدي القوم الظالمين" />
-		<aya index="11" text="وقال الذين كفروا للذين آمنوا لو كان خيرا ما سبقونا إليه وإذ لم يهتدوا به فسيقولون هذا إفك قديم" />
-		<aya index="12" text="ومن قبله كتاب موسى إماما ورحمة وهذا كتاب مصدق لسانا عربيا لينذر الذين ظلموا وبشرى للمحسنين" />
-		<aya index="13" text="إن الذين قالوا ربنا الله ثم استقاموا فلا خوف عليهم ولا هم يحزنون" />
-		<aya index="14" text="أولئك أصحاب الجنة خالدين فيها جزاء بما كانوا يعملون" />
-		<aya index="15" text="ووصينا الإنسان بوالديه إحسانا حملته أمه كرها ووضعته كرها وحمله وفصاله ثلاثون شهرا حتى إذا بلغ أشده وبلغ أربعين سنة قال رب أوزعني أن أشكر نعمتك التي أنعمت علي وعلى والدي وأن أعمل صالحا ترضاه وأصلح لي في ذريتي إني تبت إليك وإني من المسلمين" />
-		<aya index="16" text="أولئك الذين نتقبل عنهم أحسن ما عملوا ونتجاوز عن سيئاتهم في أصحاب الجنة وعد الصدق الذي كانوا يوعدون" />
-		<aya index="17" text="والذي قال لوالديه أف لكما أتعدانني أن أخرج وقد خلت القرون من قبلي وهما يستغيثان الله ويلك آمن إن وعد الله حق فيقول ما هذا إلا أساطير الأولين" />
-		<aya index="18" text="أولئك الذين حق عليهم القول في أمم قد خلت من قبلهم من الجن والإنس إنهم كانوا خاسرين" />
-		<aya index="19" text="ولكل درجات مما عملوا وليوفيهم أعمالهم وهم لا يظلمون" />
-		<aya index="20" text="ويوم يعرض الذين كفروا على النار أذهبتم طيباتكم في حياتكم الدنيا واستمتعتم بها فاليوم تجزون عذاب الهون بما كنتم تستكبرون في الأرض بغير الحق وبما كنتم تفسقون" />
-		<aya index="21" text="واذكر أخا عاد إذ أنذر قومه بالأحقاف وقد خلت النذر من بين يديه ومن خلفه ألا تعبدوا إلا الله إني أخاف عليكم عذاب يوم عظيم" />
-		<aya index="22" text="قالوا أجئتنا لتأفكنا عن آلهتنا فأتنا بما تعدنا إن كنت من الصادقين" />
-		<aya index="23" text="قال إنما العلم عند الله وأبلغكم ما أرسلت به ولكني أراكم قوما تجهلون" />
-		<aya index="24" text="فلما رأوه عارضا مستقبل أوديتهم قالوا هذا عارض ممطرنا بل هو ما استعجلتم به ريح فيها عذاب أليم" />
-		<aya index="25" text="تدمر كل شيء بأمر ربها فأصبحوا لا يرى إلا مساكنهم كذلك نجزي القوم المجرمين" />
-		<aya index="26" text="ولقد مكناهم فيما إن مكناكم فيه وجعلنا لهم سمعا وأبصارا وأفئدة فما أغنى عنهم سمعهم ولا أبصارهم ولا أفئدتهم من شيء إذ كانوا يجحدون بآيات الله وحاق بهم ما كانوا به يستهزئون" />
-		<aya index="27" text="ولقد أهلكنا ما حولكم من القرى وصرفنا الآيات لعلهم يرجعون" />
-		<aya index="28" text="فلولا نصرهم الذين اتخذوا من دون الله قربانا آلهة بل ضلوا عنهم وذلك إفكهم وما كانوا يفترون" />
-		<aya index="29" text="وإذ صرفنا إليك نفرا من الجن يستمعون القرآن فلما حضروه قالوا أنصتوا فلما قضي ولوا إلى قومهم منذرين" />
-		<aya index="30" text="قالوا يا قومنا إنا سمعنا كتابا أنزل من بعد موسى مصدقا لما بين يديه يهدي إلى الحق وإلى طريق مستقيم" />
-		<aya index="31" text="يا قومنا أجيبوا داعي الله وآمنوا به يغفر لكم من ذنوبكم ويجركم من عذاب أليم" />
-		<aya index="32" text="ومن لا يجب داعي الله فليس بمعجز في الأرض وليس له من دونه أولياء أولئك في ضلال مبين" />
-		<aya index="33" text="أولم يروا أن الله الذي خلق السماوات والأرض ولم يعي بخلقهن بقادر على أن يحيي الموتى بلى إنه على كل شيء قدير" />
-		<aya index="34" text="ويوم يعرض الذين كفروا على النار أليس هذا بالحق قالوا بلى وربنا قال فذوقوا العذاب بما كنتم تكفرون" />
-		<aya index="35" text="فاصبر كما صبر أولو العزم من الرسل ولا تستعجل لهم كأنهم يوم يرون ما يوعدون لم يلبثوا إلا ساعة من نهار بلاغ فهل يهلك إلا القوم الفاسقون" />
-	</sura>
-	<sura index="47" name="محمد">
-		<aya index="1" text="الذين كفروا وصدوا عن سبيل الله أضل أعمالهم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="والذين آمنوا وعملوا الصالحات وآمنوا بما نزل على محمد وهو الحق من ربهم كفر عنهم سيئاتهم وأصلح بالهم" />
-		<aya index="3" text="ذلك بأن الذين كفروا اتبعوا الباطل وأن الذين آمنوا اتبعوا الحق من ربهم كذلك يضرب الله للناس أمثالهم" />
-		<aya index="4" text="فإذا لقيتم الذين كفروا فضرب الرقاب حتى إذا أثخنتموهم فشدوا الوثاق فإما منا بعد وإما فداء حتى تضع الحرب أوزارها ذلك ولو يشاء الله لانتصر منهم ولكن ليبلو بعضكم ببعض والذين قتلوا في سبيل الله فلن يضل أعمالهم" />
-		<aya index="5" text="سيهديهم ويصلح بالهم" />
-		<aya index="6" text="ويدخلهم الجنة عرفها لهم" />
-		<aya index="7" text="يا أيها الذين آمنوا إن تنصروا الله ينصركم ويثبت أقدامكم" />
-		<aya index="8" text="والذين كفروا فتعسا لهم وأضل أعمالهم" />
-		<aya index="9" text="ذلك بأنهم كرهوا ما أنزل الله فأحبط أعمالهم" />
-		<aya index="10" text="أفلم يسيروا في الأرض فينظروا كيف كان عاقبة الذين من قبلهم دمر الله عليهم وللكافرين أمثالها" />
-		<aya index="11" text="ذلك بأن الله مولى الذين آمنوا وأن الكافرين لا مولى لهم" />
-		<aya index="12" text="إن الله يدخل الذين آمنوا وعملوا الصالحات جنات تجري من تحتها الأنهار والذين كفروا يتمتعون ويأكلون كما تأكل الأنعام والنار مثوى لهم" />
-		<aya index="13" text="وكأين من قرية هي أشد قوة من قريتك التي أخرجتك أهلكناهم فلا ناصر لهم" />
-		<aya index="14" text="أفمن كان على بينة من ربه كمن زين له سوء عمله واتبعوا أهواءهم" />
-		<aya index="15" text="مثل الجنة التي وعد المتقون فيها أنهار من ماء غير آسن وأنهار من لبن لم يتغير طعمه وأنهار من خمر لذة للشاربين وأنهار من عسل مصفى ولهم فيها من كل الثمرات ومغفرة من ربهم كمن هو خالد في النار وسقوا ماء حميما فقطع أمعاءهم" />
-		<aya index="16" text="ومنهم من يستمع إليك حتى إذا خرجوا من عندك قالوا للذين أوتوا العلم ماذا قال آنفا أولئك الذين طبع الله على قلوبهم واتبعوا أهواءهم" />
-		<aya index="17" text="والذين اهتدوا زادهم هدى وآتاهم تقواهم" />
-		<aya index="18" text="فهل ينظرون إلا الساعة أن تأتيهم بغتة فقد جاء أشراطها فأنى لهم إذا جاءتهم ذكراهم" />
-		<aya index="19" text="فاعلم أنه لا إله إلا الله واستغفر لذنبك وللمؤمنين والمؤمنات والله يعلم متقلبكم ومثواكم" />
-		<aya index="20" text="ويقول الذين آمنوا لولا نزلت سورة فإذا أنزلت سورة محكمة وذكر فيها القتال رأيت الذين في قلوبهم مرض ينظرون إليك نظر المغشي عليه من الموت فأولى لهم" />
-		<aya index="21" text="طاعة وقول معروف فإذا عزم الأمر فلو صدقوا الله لكان خيرا لهم" />
-		<aya index="22" text="فهل عسيتم إن توليتم أن تفسدوا في الأرض وتقطعوا أرحامكم" />
-		<aya index="23" text="أولئك الذين لعنهم الله فأصمهم وأعمى أبصارهم" />
-		<aya index="24" text="أفلا يتدبرون القرآن أم على قلوب أقفالها" />
-		<aya index="25" text="إن الذين ارتدوا على أدبارهم من بعد ما تبين لهم الهدى الشيطان سول لهم وأملى لهم" />
-		<aya index="26" text="ذلك بأنهم قالوا للذين كرهوا ما نزل الله سنطيعكم في بعض الأمر والله يعلم إسرارهم" />
-		<aya index="27" text="فكيف إذا توفتهم الملائكة يضربون وجوههم وأدبارهم" />
-		<aya index="28" text="ذلك بأنهم اتبعوا ما أسخط الله وكرهوا رضوانه فأحبط أعمالهم" />
-		<aya index="29" text="أم حسب الذين في قلوبهم مرض أن لن يخرج الله أضغانهم" />
-		<aya index="30" text="ولو نشاء لأريناكهم فلعرفتهم بسيماهم ولتعرفنهم في لحن القول والله يعلم أعمالكم" />
-		<aya index="31" text="ولنبلونكم حتى نعلم المجاهدين منكم والصابرين ونبلو أخباركم" />
-		<aya index="32" text="إن الذين كفروا وصدوا عن سبيل الله وشاقوا الرسول من بعد ما تبين لهم الهدى لن يضروا الله شيئا وسيحبط أعمالهم" />
-		<aya index="33" text="يا أيها الذين آمنوا أطيعوا الله وأطيعوا الرسول ولا تبطلوا أعمالكم" />
-		<aya index="34" text="إن الذين كفروا وصدوا عن سبيل الله ثم ماتوا وهم كفار فلن يغفر الله لهم" />
-		<aya index="35" text="فلا تهنوا وتدعوا إلى السلم وأنتم الأعلون والله معكم ولن يتركم أعمالكم" />
-		<aya index="36" text="إنما الحياة الدنيا لعب ولهو وإن تؤمنوا وتتقوا يؤتكم أجوركم ولا يسألكم أموالكم" />
-		<aya index="37" text="إن يسألكموها فيحفكم تبخلوا ويخرج أضغانكم" />
-		<aya index="38" text="ها أنتم هؤلاء تدعون لتنفقوا في سبيل الله فمنكم من يبخل ومن يبخل فإنما يبخل عن نفسه والله الغني وأنتم الفقراء وإن تتولوا يستبدل قوما غيركم ثم لا يكونوا أمثالكم" />
-	</sura>
-	<sura index="48" name="الفتح">
-		<aya index="1" text="إنا فتحنا لك فتحا مبينا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ليغفر لك الله ما تقدم من ذنبك وما تأخر ويتم نعمته عليك ويهديك صراطا مستقيما" />
-		<aya index="3" text="وينصرك الله نصرا عزيزا" />
-		<aya index="4" text="هو الذي أنزل السكينة في قلوب المؤمنين ليزدادوا إيمانا مع إيمانهم ولله جنود السماوات والأرض وكان الله عليما حكيما" />
-		<aya index="5" text="ليدخل المؤمنين والمؤمنات جنات تجري من تحتها الأنهار خالدين فيها ويكفر عنهم سيئاتهم وكان ذلك عند الله فوزا عظيما" />
-		<aya index="6" text="ويعذب المنافقين والمنافقات والمشركين والمشركات الظانين بالله ظن السوء عليهم دائرة السوء وغضب الله عليهم ولعنهم وأعد لهم جهنم وساءت مصيرا" />
-		<aya index="7" text="ولله جنود السماوات والأرض وكان الله عزيزا حكيما" />
-		<aya index="8" text="إنا أرسلناك شاهدا ومبشرا ونذيرا" />
-		<aya index="9" text="لتؤمنوا بالله ورسوله وتعزروه وتوقروه وتسبحوه بكرة وأصيلا" />
-		<aya index="10" text="إن الذين يبايعونك إنما يبايعون الله يد الله فوق أيديهم فمن نكث فإنما ينكث على نفسه ومن أوفى بما عاهد عليه الله فسيؤتيه أجرا عظيما" />
-		<aya index="11" text="سيقول لك المخلفون من الأعراب شغلتنا أموالنا وأهلونا فاستغفر لنا يقولون بألسنتهم ما ليس في قلوبهم قل فمن يملك لكم من الله شيئا إن أراد بكم ضرا أو أراد بكم نفعا بل كان الله بما تعملون خبيرا" />
-		<aya index="12" text="بل ظننتم أن لن ينقلب الرسول والمؤمنون إلى أهليهم أبدا وزين ذلك في قلوبكم وظننتم ظن السوء وكنتم قوما بورا" />
-		<aya index="13" text="ومن لم يؤمن بالله ورسوله فإنا أعتدنا للكافرين سعيرا" />
-		<aya index="14" text="ولله ملك السماوات والأرض يغفر لمن يشاء ويعذب من يشاء وكان الله غفورا رحيما" />
-		<aya index="15" text="سيقول المخلفون إذا انطلقتم إلى مغانم لتأخذوها ذرونا نتبعكم يريدون أن يبدلوا كلام الله قل لن تتبعونا كذلكم قال الله من قبل فسيقولون بل تحسدوننا بل كانوا لا يفقهون إلا قليلا" />
-		<aya index="16" text="قل للمخلفين من الأعراب ستدعون إلى قوم أولي بأس شديد تقاتلونهم أو يسلمون فإن تطيعوا يؤتكم الله أجرا حسنا وإن تتولوا كما توليتم من قبل يعذبكم عذابا أليما" />
-		<aya index="17" text="ليس على الأعمى حرج ولا على الأعرج حرج ولا على المريض حرج ومن يطع الله ورسوله يدخله جنات تجري من تحتها الأنهار ومن يتول يعذبه عذابا أليما" />
-		<aya index="18" text="لقد رضي الله عن المؤمنين إذ يبايعونك تحت الشجرة فعلم ما في قلوبهم فأنزل السكينة عليهم وأثابهم فتحا قريبا" />
-		<aya index="19" text="ومغانم كثيرة يأخذونها وكان الله عزيزا حكيما" />
-		<aya index="20" text="وعدكم الله مغانم كثيرة تأخذونها فعجل لكم هذه وكف أيدي الناس عنكم ولتكون آية للمؤمنين ويهديكم صراطا مستقيما" />
-		<aya index="21" text="وأخرى لم تقدروا عليها قد أحاط الله بها وكان الله على كل شيء قديرا" />
-		<aya index="22" text="ولو قاتلكم الذين كفروا لولوا الأدبار ثم لا يجدون وليا ولا نصيرا" />
-		<aya index="23" text="سنة الله التي قد خلت من قبل ولن تجد لسنة الله تبديلا" />
-		<aya index="24" text="وهو الذي كف أيديهم عنكم وأيديكم عنهم ببطن مكة من بعد أن أظفركم عليهم وكان الله بما تعملون بصيرا" />
-		<aya index="25" text="هم الذين كفروا وصدوكم عن المسجد الحرام والهدي معكوفا أن يبلغ محله ولولا رجال مؤمنون ونساء مؤمنات لم تعلموهم أن تطئوهم فتصيبكم منهم معرة بغير علم ليدخل الله في رحمته من يشاء لو تزيلوا لعذبنا الذين كفروا منهم عذابا أليما" />
-		<aya index="26" text="إذ جعل الذين كفروا في قلوبهم الحمية حمية الجاهلية فأنزل الله سكينته على رسوله وعلى المؤمنين وألزمهم كلمة التقوى وكانوا أحق بها وأهلها وكان الله بكل شيء عليما" />
-		<aya index="27" text="لقد صدق الله رسوله الرؤيا بالحق لتدخلن المسجد الحرام إن شاء الله آمنين محلقين رءوسكم ومقصرين لا تخافون فعلم ما لم تعلموا فجعل من دون ذلك فتحا قريبا" />
-		<aya index="28" text="هو الذي أرسل رسوله بالهدى ودين الحق ليظهره على الدين كله وكفى بالله شهيدا" />
-		<aya index="29" text="محمد رسول الله والذين معه أشداء على الكفار رحماء بينهم تراهم ركعا سجدا يبتغون فضلا من الله ورضوانا سيماهم في وجوههم من أثر السجود ذلك مثلهم في التوراة ومثلهم في الإنجيل كزرع أخرج شطأه فآزره فاستغلظ فاستوى على سوقه يعجب الزراع ليغيظ بهم الكفار وعد الله الذين آمنوا وعملوا الصالحات منهم مغفرة وأجرا عظيما" />
-	</sura>
-	<sura index="49" name="الحجرات">
-		<aya index="1" text="يا أيها الذين آمنوا لا تقدموا بين يدي الله ورسوله واتقوا الله إن الله سميع عليم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="يا أيها الذين آمنوا لا ترفعوا أصواتكم فوق صوت النبي ولا تجهروا له بالقول كجهر بعضكم لبعض أن تحبط أعمالكم وأنتم لا تشعرون" />
-		<aya index="3" text="إن الذين يغضون أصواتهم عند رسول الله أولئك الذين امتحن الله قلوبهم للتقوى لهم مغفرة وأجر عظيم" />
-		<aya index="4" text="إن الذين ينادونك من وراء الحجرات أكثرهم لا يعقلون" />
-		<aya index="5" text="ولو أنهم صبروا حتى تخرج إليهم لكان خيرا لهم والله غفور رحيم" />
-		<aya index="6" text="يا أيها الذين آمنوا إن جاءكم فاسق بنبإ فتبينوا أن تصيبوا قوما بجهالة فتصبحوا على ما فعلتم نادمين" />
-		<aya index="7" text="واعلموا أن فيكم رسول الله لو يطيعكم في كثير من الأمر لعنتم ولكن الله حبب إليكم الإيمان وزينه في قلوبكم وكره إليكم الكفر والفسوق والعصيان أولئك هم الراشدون" />
-		<aya index="8" text="فضلا من الله ونعمة والله عليم حكيم" />
-		<aya index="9" text="وإن طائفتان من المؤمنين اقتتلوا فأصلحوا بينهما فإن بغت إحداهما على الأخرى فقاتلوا التي تبغي حتى تفيء إلى أمر الله فإن فاءت فأصلحوا بينهما بالعدل وأقسطوا إن الله يحب المقسطين" />
-		<aya index="10" text="إنما المؤمنون إخوة فأصلحوا بين أخويكم واتقوا الله لعلكم ترحمون" />
-		<aya index="11" text="يا أيها الذين آمنوا لا يسخر قوم من قوم عسى أن يكونوا خيرا منهم ولا نساء من نساء عسى أن يكن خيرا منهن ولا تلمزوا أنفسكم ولا تنابزوا بالألقاب بئس الاسم الفسوق بعد الإيمان ومن لم يتب فأولئك هم الظالمون" />
-		<aya index="12" text="يا أيها الذين آمنوا اجتنبوا كثيرا من الظن إن بعض الظن إثم ولا تجسسوا ولا يغتب بعضكم بعضا أيحب أحدكم أن يأكل لحم أخيه ميتا فكرهتموه واتقوا الله إن الله تواب رحيم" />
-		<aya index="13" text="يا أيها الناس إنا خلقناكم من ذكر وأنثى وجعلناكم شعوبا وقبائل لتعارفوا إن أكرمكم عند الله أتقاكم إن الله عليم خبير" />
-		<aya index="14" text="قالت الأعراب آمنا قل لم تؤمنوا ولكن قولوا أسلمنا ولما يدخل الإيمان في قلوبكم وإن تطيعوا الله ورسوله لا يلتكم من أعمالكم شيئا إن الله غفور رحيم" />
-		<aya index="15" text="إنما المؤمنون الذين آمنوا بالله ورسوله ثم لم يرتابوا وجاهدوا بأموالهم وأنفسهم في سبيل الله أولئك هم الصادقون" />
-		<aya index="16" text="قل أتعلمون الله بدينكم والله يعلم ما في السماوات وما في الأرض والله بكل شيء عليم" />
-		<aya index="17" text="يمنون عليك أن أسلموا قل لا تمنوا علي إسلامكم بل الله يمن عليكم أن هداكم للإيمان إن كنتم صادقين" />
-		<aya index="18" text="إن الله يعلم غيب السماوات والأرض والله بصير بما تعملون" />
-	</sura>
-	<sura index="50" name="ق">
-		<aya index="1" text="ق والقرآن المجيد" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="بل عجبوا أن جاءهم منذر منهم فقال الكافرون هذا شيء عجيب" />
-		<aya index="3" text="أإذا متنا وكنا ترابا ذلك رجع بعيد" />
-		<aya index="4" text="قد علمنا ما تنقص الأرض منهم وعندنا كتاب حفيظ" />
-		<aya index="5" text="بل كذبوا بالحق لما جاءهم فهم في أمر مريج" />
-		<aya index="6" text="أفلم ينظروا إلى السماء فوقهم كيف بنيناها وزيناها وما لها من فروج" />
-		<aya index="7" text="والأرض مددناها وألقينا فيها رواسي وأنبتنا فيها من كل زوج بهيج" />
-		<aya index="8" text="تبصرة وذكرى لكل عبد منيب" />
-		<aya index="9" text="ونزلنا من السماء ماء مباركا فأنبتنا به جنات وحب الحصيد" />
-		<aya index="10" text="والنخل باسقات لها طلع نضيد" />
-		<aya index="11" text="رزقا للعباد وأحيينا به بلدة ميتا كذلك الخروج" />
-		<aya index="12" text="كذبت قبلهم قوم نوح وأصحاب الرس وثمود" />
-		<aya index="13" text="وعاد وفرعون وإخوان لوط" />
-		<aya index="14" text="وأصحاب الأيكة وقوم تبع كل كذب الرسل فحق وعيد" />
-		<aya index="15" text="أفعيينا بالخلق الأول بل هم في لبس من خلق جديد" />
-		<aya index="16" text="ولقد خلقنا الإنسان ونعلم ما توسوس به نفسه ونحن أقرب إليه من حبل الوريد" />
-		<aya index="17" text="إذ يتلقى المتلقيان عن اليمين وعن الشمال قعيد" />
-		<aya index="18" text="ما يلفظ من قول إلا لديه رقيب عتيد" />
-		<aya index="19" text="وجاءت سكرة الموت بالحق ذلك ما كنت منه تحيد" />
-		<aya index="20" text="ونفخ في الصور ذلك يوم الوعيد" />
-		<aya index="21" text="وجاءت كل نفس معها سائق وشهيد" />
-		<aya index="22" text="لقد كنت في غفلة من هذا فكشفنا عنك غطاءك فبصرك اليوم حديد" />
-		<aya index="23" text="وقال قرينه هذا ما لدي عتيد" />
-		<aya index="24" text="ألقيا في جهنم كل كفار عنيد" />
-		<aya index="25" text="مناع للخير معتد مريب" />
-		<aya index="26" text="الذي جعل مع الله إلها آخر فألقياه في العذاب الشديد" />
-		<aya index="27" text="قال قرينه ربنا ما أطغيته ولكن كان في ضلال بعيد" />
-		<aya index="28" text="قال لا تختصموا لدي وقد قدمت إليكم بالوعيد" />
-		<aya index="29" text="ما يبدل القول لدي وما أنا بظلام للعبيد" />
-		<aya index="30" text="يوم نقول لجهنم هل امتلأت وتقول هل من مزيد" />
-		<aya index="31" text="وأزلفت الجنة للمتقين غير بعيد" />
-		<aya index="32" text="هذا ما توعدون لكل أواب حفيظ" />
-		<aya index="33" text="من خشي الرحمن بالغيب وجاء بقلب منيب" />
-		<aya index="34" text="ادخلوها بسلام ذلك يوم الخلود" />
-		<aya index="35" text="لهم ما يشاءون فيها ولدينا مزيد" />
-		<aya index="36" text="وكم أهلكنا قبلهم من قرن هم أشد منهم بطشا فنقبوا في البلاد هل من محيص" />
-		<aya index="37" text="إن في ذلك لذكرى لمن كان له قلب أو ألقى السمع وهو شهيد" />
-		<aya index="38" text="ولقد خلقنا السماوات والأرض وما بينهما في ستة أيام وما مسنا من لغوب" />
-		<aya index="39" text="فاصبر على ما يقولون وسبح بحمد ربك قبل طلوع الشمس وقبل الغروب" />
-		<aya index="40" text="ومن الليل فسبحه وأدبار السجود" />
-		<aya index="41" text="واستمع يوم يناد المناد من مكان قريب" />
-		<aya index="42" text="يوم يسمعون الصيحة بالحق ذلك يوم الخروج" />
-		<aya index="43" text="إنا نحن نحيي ونميت وإلينا المصير" />
-		<aya index="44" text="يوم تشقق الأرض عنهم سراعا ذلك حشر علينا يسير" />
-		<aya index="45" text="نحن أعلم بما يقولون وما أنت عليهم بجبار فذكر بالقرآن من يخاف وعيد" />
-	</sura>
-	<sura index="51" name="الذاريات">
-		<aya index="1" text="والذاريات ذروا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="فالحاملات وقرا" />
-		<aya index="3" text="فالجاريات يسرا" />
-		<aya index="4" text="فالمقسمات أمرا" />
-		<aya index="5" text="إنما توعدون لصادق" />
-		<aya index="6" text="وإن الدين لواقع" />
-		<aya index="7" text="والسماء ذات الحبك" />
-		<aya index="8" text="إنكم لفي قول مختلف" />
-		<aya index="9" text="يؤفك عنه من أفك" />
-		<aya index="10" text="قتل الخراصون" />
-		<aya index="11" text="الذين هم في غمرة ساهون" />
-		<aya index="12" text="يسألون أيان يوم الدين" />
-		<aya index="13" text="يوم هم على النار يفتنون" />
-		<aya index="14" text="ذوقوا فتنتكم هذا الذي كنتم به تستعجلون" />
-		<aya index="15" text="إن المتقين في جنات وعيون" />
-		<aya index="16" text="آخذين ما آتاهم ربهم إنهم كانوا قبل ذلك محسنين" />
-		<aya index="17" text="كانوا قليلا من الليل ما يهجعون" />
-		<aya index="18" text="وبالأسحار هم يستغفرون" />
-		<aya index="19" text="وفي أموالهم حق للسائل والمحروم" />
-		<aya index="20" text="وفي الأرض آيات للموقنين" />
-		<aya index="21" text="وفي أنفسكم أفلا تبصرون" />
-		<aya index="22" text="وفي السماء رزقكم وما توعدون" />
-		<aya index="23" text="فورب السماء والأرض إنه لحق مثل ما أنكم تنطقون" />
-		<aya index="24" text="هل أتاك حديث ضيف إبراهيم المكرمين" />
-		<aya index="25" text="إذ دخلوا عليه فقالوا سلاما قال سلام قوم منكرون" />
-		<aya index="26" text="فراغ إلى أهله فجاء بعجل سمين" />
-		<aya index="27" text="فقربه إليهم قال ألا تأكلون" />
-		<aya index="28" text="فأوجس منهم خيفة قالوا لا تخف وبشروه بغلام عليم" />
-		<aya index="29" text="فأقبلت امرأته في صرة فصكت وجهها وقالت عجوز عقيم" />
-		<aya index="30" text="قالوا كذلك قال ربك إنه هو الحكيم العليم" />
-		<aya index="31" text="قال فما خطبكم أيها المرسلون" />
-		<aya index="32" text="قالوا إنا أرسلنا إلى قوم مجرمين" />
-		<aya index="33" text="لنرسل عليهم حجارة من طين" />
-		<aya index="34" text="مسومة عند ربك للمسرفين" />
-		<aya index="35" text="فأخرجنا من كان فيها من المؤمنين" />
-		<aya index="36" text="فما وجدنا فيها غير بيت من المسلمين" />
-		<aya index="37" text="وتركنا فيها آية للذين يخافون العذاب الأليم" />
-		<aya index="38" text="وفي موسى إذ أرسلناه إلى فرعون بسلطان مبين" />
-		<aya index="39" text="فتولى بركنه وقال ساحر أو مجنون" />
-		<aya index="40" text="فأخذناه وجنوده فنبذناهم في اليم وهو مليم" />
-		<aya index="41" text="وفي عاد إذ أرسلنا عليهم الريح العقيم" />
-		<aya index="42" text="ما تذر من شيء أتت عليه إلا جعلته كالرميم" />
-		<aya index="43" text="وفي ثمود إذ قيل لهم تمتعوا حتى حين" />
-		<aya index="44" text="فعتوا عن أمر ربهم فأخذتهم الصاعقة وهم ينظرون" />
-		<aya index="45" text="فما استطاعوا من قيام وما كانوا منتصرين" />
-		<aya index="46" text="وقوم نوح من قبل إنهم كانوا قوما فاسقين" />
-		<aya index="47" text="والسماء بنيناها بأيد وإنا لموسعون" />
-		<aya index="48" text="والأرض فرشناها فنعم الماهدون" />
-		<aya index="49" text="ومن كل شيء خلقنا زوجين لعلكم تذكرون" />
-		<aya index="50" text="ففروا إلى الله إني لكم منه نذير مبين" />
-		<aya index="51" text="ولا تجعلوا مع الله إلها آخر إني لكم منه نذير مبين" />
-		<aya index="52" text="كذلك ما أتى الذين من قبلهم من رسول إلا قالوا ساحر أو مجنون" />
-		<aya index="53" text="أتواصوا به بل هم قوم طاغون" />
-		<aya index="54" text="فتول عنهم فما أنت بملوم" />
-		<aya index="55" text="وذكر فإن الذكرى تنفع المؤمنين" />
-		<aya index="56" text="وما خلقت الجن والإنس إلا ليعبدون" />
-		<aya index="57" text="ما أريد منهم من رزق وما أريد أن يطعمون" />
-		<aya index="58" text="إن الله هو الرزاق ذو القوة المتين" />
-		<aya index="59" text="فإن للذين ظلموا ذنوبا مثل ذنوب أصحابهم فلا يستعجلون" />
-		<aya index="60" text="فويل للذين كفروا من يومهم الذي يوعدون" />
-	</sura>
-	<sura index="52" name="الطور">
-		<aya index="1" text="والطور" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وكتاب مسطور" />
-		<aya index="3" text="في رق منشور" />
-		<aya index="4" text="والبيت المعمور" />
-		<aya index="5" text="والسقف المرفوع" />
-		<aya index="6" text="والبحر المسجور" />
-		<aya index="7" text="إن عذاب ربك لواقع" />
-		<aya index="8" text="ما له من دافع" />
-		<aya index="9" text="يوم تمور السماء مورا" />
-		<aya index="10" text="وتسير الجبال سيرا" />
-		<aya index="11" text="فويل يومئذ للمكذبين" />
-		<aya index="12" text="الذين هم في خوض يلعبون" />
-		<aya index="13" text="يوم يدعون إلى نار جهنم دعا" />
-		<aya index="14" text="هذه النار التي كنتم بها تكذبون" />
-		<aya index="15" text="أفسحر هذا أم أنتم لا تبصرون" />
-		<aya index="16" text="اصلوها فاصبروا أو لا تصبروا سواء عليكم إنما تجزون ما كنتم تعملون" />
-		<aya index="17" text="إن المتقين في جنات ونعيم" />
-		<aya index="18" text="فاكهين بما آتاهم ربهم ووقاهم ربهم عذاب الجحيم" />
-		<aya index="19" text="كلوا واشربوا هنيئا بما كنتم تعملون" />
-		<aya index="20" text="متكئين على سرر مصفوفة وزوجناهم بحور عين" />
-		<aya index="21" text="والذين آمنوا واتبعتهم ذريتهم بإيمان ألحقنا بهم ذريتهم وما ألتناهم من عملهم من شيء كل امرئ بما كسب رهين" />
-		<aya index="22" text="وأمددناهم بفاكهة ولحم مما يشتهون" />
-		<aya index="23" text="يتنازعون فيها كأسا لا لغو فيها ولا تأثيم" />
-		<aya index="24" text="ويطوف عليهم غلمان لهم كأنهم لؤلؤ مكنون" />
-		<aya index="25" text="وأقبل بعضهم على بعض يتساءلون" />
-		<aya index="26" text="قالوا إنا كنا قبل في أهلنا مشفقين" />
-		<aya index="27" text="فمن الله علينا ووقانا عذاب السموم" />
-		<aya index="28" text="إنا كنا من قبل ندعوه إنه هو البر الرحيم" />
-		<aya index="29" text="فذكر فما أنت بنعمت ربك بكاهن ولا مجنون" />
-		<aya index="30" text="أم يقولون شاعر نتربص به ريب المنون" />
-		<aya index="31" text="قل تربصوا فإني معكم من المتربصين" />
-		<aya index="32" text="أم تأمرهم أحلامهم بهذا أم هم قوم طاغون" />
-		<aya index="33" text="أم يقولون تقوله بل لا يؤمنون" />
-		<aya index="34" text="فليأتوا بحديث مثله إن كانوا صادقين" />
-		<aya index="35" text="أم خلقوا من غير شيء أم هم الخالقون" />
-		<aya index="36" text="أم خلقوا السماوات والأرض بل لا يوقنون" />
-		<aya index="37" text="أم عندهم خزائن ربك أم هم المصيطرون" />
-		<aya index="38" text="أم لهم سلم يستمعون فيه فليأت مستمعهم بسلطان مبين" />
-		<aya index="39" text="أم له البنات ولكم البنون" />
-		<aya index="40" text="أم تسألهم أجرا فهم من مغرم مثقلون" />
-		<aya index="41" text="أم عندهم الغيب فهم يكتبون" />
-		<aya index="42" text="أم يريدون كيدا فالذين كفروا هم المكيدون" />
-		<aya index="43" text="أم لهم إله غير الله سبحان الله عما يشركون" />
-		<aya index="44" text="وإن يروا كسفا من السماء ساقطا يقولوا سحاب مركوم" />
-		<aya index="45" text="فذرهم حتى يلاقوا يومهم الذي فيه يصعقون" />
-		<aya index="46" text="يوم لا يغني عنهم كيدهم شيئا ولا هم ينصرون" />
-		<aya index="47" text="وإن للذين ظلموا عذابا دون ذلك ولكن أكثرهم لا يعلمون" />
-		<aya index="48" text="واصبر لحكم ربك فإنك بأعيننا وسبح بحمد ربك حين تقوم" />
-		<aya index="49" text="ومن الليل فسبحه وإدبار النجوم" />
-	</sura>
-	<sura index="53" name="النجم">
-		<aya index="1" text="والنجم إذا هوى" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ما ضل صاحبكم وما غوى" />
-		<aya index="3" text="وما ينطق عن الهوى" />
-		<aya index="4" text="إن هو إلا وحي يوحى" />
-		<aya index="5" text="علمه شديد القوى" />
-		<aya index="6" text="ذو مرة فاستوى" />
-		<aya index="7" text="وهو بالأفق الأعلى" />
-		<aya index="8" text="ثم دنا فتدلى" />
-		<aya index="9" text="فكان قاب قوسين أو أدنى" />
-		<aya index="10" text="فأوحى إلى عبده ما أوحى" />
-		<aya index="11" text="ما كذب الفؤاد ما رأى" />
-		<aya index="12" text="أفتمارونه على ما يرى" />
-		<aya index="13" text="ولقد رآه نزلة أخرى" />
-		<aya index="14" text="عند سدرة المنتهى" />
-		<aya index="15" text="عندها جنة المأوى" />
-		<aya index="16" text="إذ يغشى السدرة ما يغشى" />
-		<aya index="17" text="ما زاغ البصر وما طغى" />
-		<aya index="18" text="لقد رأى من آيات ربه الكبرى" />
-		<aya index="19" text="أفرأيتم اللات والعزى" />
-		<aya index="20" text="ومناة الثالثة الأخرى" />
-		<aya index="21" text="ألكم الذكر وله الأنثى" />
-		<aya index="22" text="تلك إذا قسمة ضيزى" />
-		<aya index="23" text="إن هي إلا أسماء سميتموها أنتم وآباؤكم ما أنزل الله بها من سلطان إن يتبعون إلا الظن وما تهوى الأنفس ولقد جاءهم من ربهم الهدى" />
-		<aya index="24" text="أم للإنسان ما تمنى" />
-		<aya index="25" text="فلله الآخرة والأولى" />
-		<aya index="26" text="وكم من ملك في السماوات لا تغني شفاعتهم شيئا إلا من بعد أن يأذن الله لمن يشاء ويرضى" />
-		<aya index="27" text="إن الذين لا يؤمنون بالآخرة ليسمون الملائكة تسمية الأنثى" />
-		<aya index="28" text="وما لهم به من علم إن يتبعون إلا الظن وإن الظن لا يغني من الحق شيئا" />
-		<aya index="29" text="فأعرض عن من تولى عن ذكرنا ولم يرد إلا الحياة الدنيا" />
-		<aya index="30" text="ذلك مبلغهم من العلم إن ربك هو أعلم بمن ضل عن سبيله وهو أعلم بمن اهتدى" />
-		<aya index="31" text="ولله ما في السماوات وما في الأرض ليجزي الذين أساءوا بما عملوا ويجزي الذين أحسنوا بالحسنى" />
-		<aya index="32" text="الذين يجتنبون كبائر الإثم والفواحش إلا اللمم إن ربك واسع المغفرة هو أعلم بكم إذ أنشأكم من الأرض وإذ أنتم أجنة في بطون أمهاتكم فلا تزكوا أنفسكم هو أعلم بمن اتقى" />
-		<aya index="33" text="أفرأيت الذي تولى" />
-		<aya index="34" text="وأعطى قليلا وأكدى" />
-		<aya index="35" text="أعنده علم الغيب فهو يرى" />
-		<aya index="36" text="أم لم ينبأ بما في صحف موسى" />
-		<aya index="37" text="وإبراهيم الذي وفى" />
-		<aya index="38" text="ألا تزر وازرة وزر أخرى" />
-		<aya index="39" text="وأن ليس للإنسان إلا ما سعى" />
-		<aya index="40" text="وأن سعيه سوف يرى" />
-		<aya index="41" text="ثم يجزاه الجزاء الأوفى" />
-		<aya index="42" text="وأن إلى ربك المنتهى" />
-		<aya index="43" text="وأنه هو أضحك وأبكى" />
-		<aya index="44" text="وأنه هو أمات وأحيا" />
-		<aya index="45" text="وأنه خلق الزوجين الذكر والأنثى" />
-		<aya index="46" text="من نطفة إذا تمنى" />
-		<aya index="47" text="وأن عليه النشأة الأخرى" />
-		<aya index="48" text="وأنه هو أغنى وأقنى" />
-		<aya index="49" text="وأنه هو رب الشعرى" />
-		<aya index="50" text="وأنه أهلك عادا الأولى" />
-		<aya index="51" text="وثمود فما أبقى" />
-		<aya index="52" text="وقوم نوح من قبل إنهم كانوا هم أظلم وأطغى" />
-		<aya index="53" text="والمؤتفكة أهوى" />
-		<aya index="54" text="فغشاها ما غشى" />
-		<aya index="55" text="فبأي آلاء ربك تتمارى" />
-		<aya index="56" text="هذا نذير من النذر الأولى" />
-		<aya index="57" text="أزفت الآزفة" />
-		<aya index="58" text="ليس لها من دون الله كاشفة" />
-		<aya index="59" text="أفمن هذا الحديث تعجبون" />
-		<aya index="60" text="وتضحكون ولا تبكون" />
-		<aya index="61" text="وأنتم سامدون" />
-		<aya index="62" text="فاسجدوا لله واعبدوا" />
-	</sura>
-	<sura index="54" name="القمر">
-		<aya index="1" text="اقتربت الساعة وانشق القمر" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وإن يروا آية يعرضوا ويقولوا سحر مستمر" />
-		<aya index="3" text="وكذبوا واتبعوا أهواءهم وكل أمر مستقر" />
-		<aya index="4" text="ولقد جاءهم من الأنباء ما فيه مزدجر" />
-		<aya index="5" text="حكمة بالغة فما تغن النذر" />
-		<aya index="6" text="فتول عنهم يوم يدع الداع إلى شيء نكر" />
-		<aya index="7" text="خشعا أبصارهم يخرجون من الأجداث كأنهم جراد منتشر" />
-		<aya index="8" text="مهطعين إلى الداع يقول الكافرون هذا يوم عسر" />
-		<aya index="9" text="كذبت قبلهم قوم نوح فكذبوا عبدنا وقالوا مجنون وازدجر" />
-		<aya index="10" text="فدعا ربه أني مغلوب فانتصر" />
-		<aya index="11" text="ففتحنا أبواب السماء بماء منهمر" />
-		<aya index="12" text="وفجرنا الأرض عيونا فالتقى الماء على أمر قد قدر" />
-		<aya index="13" text="وحملناه على ذات ألواح ودسر" />
-		<aya index="14" text="تجري بأعيننا جزاء لمن كان كفر" />
-		<aya index="15" text="ولقد تركناها آية فهل من مدكر" />
-		<aya index="16" text="فكيف كان عذابي ونذر" />
-		<aya index="17" text="ولقد يسرنا القرآن للذكر فهل من مدكر" />
-		<aya index="18" text="كذبت عاد فكيف كان عذابي ونذر" />
-		<aya index="19" text="إنا أرسلنا عليهم ريحا صرصرا في يوم نحس مستمر" />
-		<aya index="20" text="تنزع الناس كأنهم أعجاز نخل منقعر" />
-		<aya index="21" text="فكيف كان عذابي ونذر" />
-		<aya index="22" text="ولقد يسرنا القرآن للذكر فهل من مدكر" />
-		<aya index="23" text="كذبت ثمود بالنذر" />
-		<aya index="24" text="فقالوا أبشرا منا واحدا نتبعه إنا إذا لفي ضلال وسعر" />
-		<aya index="25" text="أألقي الذكر عليه من بيننا بل هو كذاب أشر" />
-		<aya index="26" text="سيعلمون غدا من الكذاب الأشر" />
-		<aya index="27" text="إنا مرسلو الناقة فتنة لهم فارتقبهم واصطبر" />
-		<aya index="28" text="ونبئهم أن الماء قسمة بينهم كل شرب محتضر" />
-		<aya index="29" text="فنادوا صاحبهم فتعاطى فعقر" />
-		<aya index="30" text="فكيف كان عذابي ونذر" />
-		<aya index="31" text="إنا أرسلنا عليهم صيحة واحدة فكانوا كهشيم المحتظر" />
-		<aya index="32" text="ولقد يسرنا القرآن للذكر فهل من مدكر" />
-		<aya index="33" text="كذبت قوم لوط بالنذر" />
-		<aya index="34" text="إنا أرسلنا عليهم حاصبا إلا آل لوط نجيناهم بسحر" />
-		<aya index="35" text="نعمة من عندنا كذلك نجزي من شكر" />
-		<aya index="36" text="ولقد أنذرهم بطشتنا فتماروا بالنذر" />
-		<aya index="37" text="ولقد راودوه عن ضيفه فطمسنا أعينهم فذوقوا عذابي ونذر" />
-		<aya index="38" text="ولقد صبحهم بكرة عذاب مستقر" />
-		<aya index="39" text="فذوقوا عذابي ونذر" />
-		<aya index="40" text="ولقد يسرنا القرآن للذكر فهل من مدكر" />
-		<aya index="41" text="ولقد جاء آل فرعون النذر" />
-		<aya index="42" text="كذبوا بآياتنا كلها فأخذناهم أخذ عزيز مقتدر" />
-		<aya index="43" text="أكفاركم خير من أولئكم أم لكم براءة في الزبر" />
-		<aya index="44" text="أم يقولون نحن جميع منتصر" />
-		<aya index="45" text="سيهزم الجمع ويولون الدبر" />
-		<aya index="46" text="بل الساعة موعدهم والساعة أدهى وأمر" />
-		<aya index="47" text="إن المجرمين في ضلال وسعر" />
-		<aya index="48" text="يوم يسحبون في النار على وجوههم ذوقوا مس سقر" />
-		<aya index="49" text="إنا كل شيء خلقناه بقدر" />
-		<aya index="50" text="وما أمرنا إلا واحدة كلمح بالبصر" />
-		<aya index="51" text="ولقد أهلكنا أشياعكم فهل من مدكر" />
-		<aya index="52" text="وكل شيء فعلوه في الزبر" />
-		<aya index="53" text="وكل صغير وكبير مستطر" />
-		<aya index="54" text="إن المتقين في جنات ونهر" />
-		<aya index="55" text="في مقعد صدق عند مليك مقتدر" />
-	</sura>
-	<sura index="55" name="الرحمن">
-		<aya index="1" text="الرحمن" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="علم القرآن" />
-		<aya index="3" text="خلق الإنسان" />
-		<aya index="4" text="علمه البيان" />
-		<aya index="5" text="الشمس والقمر بحسبان" />
-		<aya index="6" text="والنجم والشجر يسجدان" />
-		<aya index="7" text="والسماء رفعها ووضع الميزان" />
-		<aya index="8" text="ألا تطغوا في الميزان" />
-		<aya index="9" text="وأقيموا الوزن بالقسط ولا تخسروا الميزان" />
-		<aya index="10" text="والأرض وضعها للأنام" />
-		<aya index="11" text="فيها فاكهة والنخل ذات الأكمام" />
-		<aya index="12" text="والحب ذو العصف والريحان" />
-		<aya index="13" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="14" text="خلق الإنسان من صلصال كالفخار" />
-		<aya index="15" text="وخلق الجان من مارج من نار" />
-		<aya index="16" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="17" text="رب المشرقين ورب المغربين" />
-		<aya index="18" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="19" text="مرج البحرين يلتقيان" />
-		<aya index="20" text="بينهما برزخ لا يبغيان" />
-		<aya index="21" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="22" text="يخرج منهما اللؤلؤ والمرجان" />
-		<aya index="23" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="24" text="وله الجوار المنشآت في البحر كالأعلام" />
-		<aya index="25" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="26" text="كل من عليها فان" />
-		<aya index="27" text="ويبقى وجه ربك ذو الجلال والإكرام" />
-		<aya index="28" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="29" text="يسأله من في السماوات والأرض كل يوم هو في شأن" />
-		<aya index="30" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="31" text="سنفرغ لكم أيه الثقلان" />
-		<aya index="32" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="33" text="يا معشر الجن والإنس إن استطعتم أن تنفذوا من أقطار السماوات والأرض فانفذوا لا تنفذون إلا بسلطان" />
-		<aya index="34" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="35" text="يرسل عليكما شواظ من نار ونحاس فلا تنتصران" />
-		<aya index="36" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="37" text="فإذا انشقت السماء فكانت وردة كالدهان" />
-		<aya index="38" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="39" text="فيومئذ لا يسأل عن ذنبه إنس ولا جان" />
-		<aya index="40" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="41" text="يعرف المجرمون بسيماهم فيؤخذ بالنواصي والأقدام" />
-		<aya index="42" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="43" text="هذه جهنم التي يكذب بها المجرمون" />
-		<aya index="44" text="يطوفون بينها وبين حميم آن" />
-		<aya index="45" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="46" text="ولمن خاف مقام ربه جنتان" />
-		<aya index="47" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="48" text="ذواتا أفنان" />
-		<aya index="49" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="50" text="فيهما عينان تجريان" />
-		<aya index="51" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="52" text="فيهما من كل فاكهة زوجان" />
-		<aya index="53" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="54" text="متكئين على فرش بطائنها من إستبرق وجنى الجنتين دان" />
-		<aya index="55" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="56" text="فيهن قاصرات الطرف لم يطمثهن إنس قبلهم ولا جان" />
-		<aya index="57" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="58" text="كأنهن الياقوت والمرجان" />
-		<aya index="59" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="60" text="هل جزاء الإحسان إلا الإحسان" />
-		<aya index="61" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="62" text="ومن دونهما جنتان" />
-		<aya index="63" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="64" text="مدهامتان" />
-		<aya index="65" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="66" text="فيهما عينان نضاختان" />
-		<aya index="67" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="68" text="فيهما فاكهة ونخل ورمان" />
-		<aya index="69" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="70" text="فيهن خيرات حسان" />
-		<aya index="71" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="72" text="حور مقصورات في الخيام" />
-		<aya index="73" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="74" text="لم يطمثهن إنس قبلهم ولا جان" />
-		<aya index="75" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="76" text="متكئين على رفرف خضر وعبقري حسان" />
-		<aya index="77" text="فبأي آلاء ربكما تكذبان" />
-		<aya index="78" text="تبارك اسم ربك ذي الجلال والإكرام" />
-	</sura>
-	<sura index="56" name="الواقعة">
-		<aya index="1" text="إذا وقعت الواقعة" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ليس لوقعتها كاذبة" />
-		<aya index="3" text="خافضة رافعة" />
-		<aya index="4" text="إذا رجت الأرض رجا" />
-		<aya index="5" text="وبست الجبال بسا" />
-		<aya index="6" text="فكانت هباء منبثا" />
-		<aya index="7" text="وكنتم أزواجا ثلاثة" />
-		<aya index="8" text="فأصحاب الميمنة ما أصحاب الميمنة" />
-		<aya index="9" text="وأصحاب المشأمة ما أصحاب المشأمة" />
-		<aya index="10" text="والسابقون السابقون" />
-		<aya index="11" text="أولئك المقربون" />
-		<aya index="12" text="في جنات النعيم" />
-		<aya index="13" text="ثلة من الأولين" />
-		<aya index="14" text="وقليل من الآخرين" />
-		<aya index="15" text="على سرر موضونة" />
-		<aya index="16" text="متكئين عليها متقابلين" />
-		<aya index="17" text="يطوف عليهم ولدان مخلدون" />
-		<aya index="18" text="بأكواب وأباريق وكأس من معين" />
-		<aya index="19" text="لا يصدعون عنها ولا ينزفون" />
-		<aya index="20" text="وفاكهة مما يتخيرون" />
-		<aya index="21" text="ولحم طير مما يشتهون" />
-		<aya index="22" text="وحور عين" />
-		<aya index="23" text="كأمثال اللؤلؤ المكنون" />
-		<aya index="24" text="جزاء بما كانوا يعملون" />
-		<aya index="25" text="لا يسمعون فيها لغوا ولا تأثيما" />
-		<aya index="26" text="إلا قيلا سلاما سلاما" />
-		<aya index="27" text="وأصحاب اليمين ما أصحاب اليمين" />
-		<aya index="28" text="في سدر مخضود" />
-		<aya index="29" text="وطلح منضود" />
-		<aya index="30" text="وظل ممدود" />
-		<aya index="31" text="وماء مسكوب" />
-		<aya index="32" text="وفاكهة كثيرة" />
-		<aya index="33" text="لا مقطوعة ولا ممنوعة" />
-		<aya index="34" text="وفرش مرفوعة" />
-		<aya index="35" text="إنا أنشأناهن إنشاء" />
-		<aya index="36" text="فجعلناهن أبكارا" />
-		<aya index="37" text="عربا أترابا" />
-		<aya index="38" text="لأصحاب اليمين" />
-		<aya index="39" text="ثلة من الأولين" />
-		<aya index="40" text="وثلة من الآخرين" />
-		<aya index="41" text="وأصحاب الشمال ما أصحاب الشمال" />
-		<aya index="42" text="في سموم وحميم" />
-		<aya index="43" text="وظل من يحموم" />
-		<aya index="44" text="لا بارد ولا كريم" />
-		<aya index="45" text="إنهم كانوا قبل ذلك مترفين" />
-		<aya index="46" text="وكانوا يصرون على الحنث العظيم" />
-		<aya index="47" text="وكانوا يقولون أئذا متنا وكنا ترابا وعظاما أإنا لمبعوثون" />
-		<aya index="48" text="أوآباؤنا الأولون" />
-		<aya index="49" text="قل إن الأولين والآخرين" />
-		<aya index="50" text="لمجموعون إلى ميقات يوم معلوم" />
-		<aya index="51" text="ثم إنكم أيها الضالون المكذبون" />
-		<aya index="52" text="لآكلون من شجر من زقوم" />
-		<aya index="53" text="فمالئون منها البطون" />
-		<aya index="54" text="فشاربون عليه من الحميم" />
-		<aya index="55" text="فشاربون شرب الهيم" />
-		<aya index="56" text="هذا نزلهم يوم الدين" />
-		<aya index="57" text="نحن خلقناكم فلولا تصدقون" />
-		<aya index="58" text="أفرأيتم ما تمنون" />
-		<aya index="59" text="أأنتم تخلقونه أم نحن الخالقون" />
-		<aya index="60" text="نحن قدرنا بينكم الموت وما نحن بمسبوقين" />
-		<aya index="61" text="على أن نبدل أمثالكم وننشئكم في ما لا تعلمون" />
-		<aya index="62" text="ولقد علمتم النشأة الأولى فلولا تذكرون" />
-		<aya index="63" text="أفرأيتم ما تحرثون" />
-		<aya index="64" text="أأنتم تزرعونه أم نحن الزارعون" />
-		<aya index="65" text="لو نشاء لجعلناه حطاما فظلتم تفكهون" />
-		<aya index="66" text="إنا لمغرمون" />
-		<aya index="67" text="بل نحن محرومون" />
-		<aya index="68" text="أفرأيتم الماء الذي تشربون" />
-		<aya index="69" text="أأنتم أنزلتموه من المزن أم نحن المنزلون" />
-		<aya index="70" text="لو نشاء جعلناه أجاجا فلولا تشكرون" />
-		<aya index="71" text="أفرأيتم النار التي تورون" />
-		<aya index="72" text="أأنتم أنشأتم شجرتها أم نحن المنشئون" />
-		<aya index="73" text="نحن جعلناها تذكرة ومتاعا للمقوين" />
-		<aya index="74" text="فسبح باسم ربك العظيم" />
-		<aya index="75" text="فلا أقسم بمواقع النجوم" />
-		<aya index="76" text="وإنه لقسم لو تعلمون عظيم" />
-		<aya index="77" text="إنه لقرآن كريم" />
-		<aya index="78" text="في كتاب مكنون" />
-		<aya index="79" text="لا يمسه إلا المطهرون" />
-		<aya index="80" text="تنزيل من رب العالمين" />
-		<aya index="81" text="أفبهذا الحديث أنتم مدهنون" />
-		<aya index="82" text="وتجعلون رزقكم أنكم تكذبون" />
-		<aya index="83" text="فلولا إذا بلغت الحلقوم" />
-		<aya index="84" text="وأنتم حينئذ تنظرون" />
-		<aya index="85" text="ونحن أقرب إليه منكم ولكن لا تبصرون" />
-		<aya index="86" text="فلولا إن كنتم غير مدينين" />
-		<aya index="87" text="ترجعونها إن كنتم صادقين" />
-		<aya index="88" text="فأما إن كان من المقربين" />
-		<aya index="89" text="فروح وريحان وجنت نعيم" />
-		<aya index="90" text="وأما إن كان من أصحاب اليمين" />
-		<aya index="91" text="فسلام لك من أصحاب اليمين" />
-		<aya index="92" text="وأما إن كان من المكذبين الضالين" />
-		<aya index="93" text="فنزل من حميم" />
-		<aya index="94" text="وتصلية جحيم" />
-		<aya index="95" text="إن هذا لهو حق اليقين" />
-		<aya index="96" text="فسبح باسم ربك العظيم" />
-	</sura>
-	<sura index="57" name="الحديد">
-		<aya index="1" text="سبح لله ما في السماوات والأرض وهو العزيز الحكيم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="له ملك السماوات والأرض يحيي ويميت وهو على كل شيء قدير" />
-		<aya index="3" text="هو الأول والآخر والظاهر والباطن وهو بكل شيء عليم" />
-		<aya index="4" text="هو الذي خلق السماوات والأرض في ستة أيام ثم استوى على العرش يعلم ما يلج في الأرض وما يخرج منها وما ينزل من السماء وما يعرج فيها وهو معكم أين ما كنتم والله بما تعملون بصير" />
-		<aya index="5" text="له ملك السماوات والأرض وإلى الله ترجع الأمور" />
-		<aya index="6" text="يولج الليل في النهار ويولج النهار في الليل وهو عليم بذات الصدور" />
-		<aya index="7" text="آمنوا بالله ورسوله وأنفقوا مما جعلكم مستخلفين فيه فالذين آمنوا منكم وأنفقوا لهم أجر كبير" />
-		<aya index="8" text="وما لكم لا تؤمنون بالله والرسول يدعوكم لتؤمنوا بربكم وقد أخذ ميثاقكم إن كنتم مؤمنين" />
-		<aya index="9" text="هو الذي ينزل على عبده آيات بينات ليخرجكم من الظلمات إلى النور وإن الله بكم لرءوف رحيم" />
-		<aya index="10" text="وما لكم ألا تنفقوا في سبيل الله ولله ميراث السماوات والأرض لا يستوي منكم من أنفق من قبل الفتح وقاتل أولئك أعظم درجة من الذين أنفقوا من بعد وقاتلوا وكلا وعد الله الحسنى والله بما تعملون خبير" />
-		<aya index="11" text="من ذا الذي يقرض الله قرضا حسنا فيضاعفه له وله أجر كريم" />
-		<aya index="12" text="يوم ترى المؤمنين والمؤمنات يسعى نورهم بين أيديهم وبأيمانهم بشراكم اليوم جنات تجري من تحتها الأنهار خالدين فيها ذلك هو الفوز العظيم" />
-		<aya index="13" text="يوم يقول المنافقون والمنافقات للذين آمنوا انظرونا نقتبس من نوركم قيل ارجعوا وراءكم فالتمسوا نورا فضرب بينهم بسور له باب باطنه فيه الرحمة وظاهره من قبله العذاب" />
-		<aya index="14" text="ينادونهم ألم نكن معكم قالوا بلى ولكنكم فتنتم أنفسكم وتربصتم وارتبتم وغرتكم الأماني حتى جاء أمر الله وغركم بالله الغرور" />
-		<aya index="15" text="فاليوم لا يؤخذ منكم فدية ولا من الذين كفروا مأواكم النار هي مولاكم وبئس المصير" />
-		<aya index="16" text="ألم يأن للذين آمنوا أن تخشع قلوبهم لذكر الله وما نزل من الحق ولا يكونوا كالذين أوتوا الكتاب من قبل فطال عليهم الأمد فقست قلوبهم وكثير منهم فاسقون" />
-		<aya index="17" text="اعلموا أن الله يحيي الأرض بعد موتها قد بينا لكم الآيات لعلكم تعقلون" />
-		<aya index="18" text="إن المصدقين والمصدقات وأقرضوا الله قرضا حسنا يضاعف لهم ولهم أجر كريم" />
-		<aya index="19" text="والذين آمنوا بالله ورسله أولئك هم الصديقون والشهداء عند ربهم لهم أجرهم ونورهم والذين كفروا وكذبوا بآياتنا أولئك أصحاب الجحيم" />
-		<aya index="20" text="اعلموا أنما الحياة الدنيا لعب ولهو وزينة وتفاخر بينكم وتكاثر في الأموال والأولاد كمثل غيث أعجب الكفار نباته ثم يهيج فتراه مصفرا ثم يكون حطاما وفي الآخرة عذاب شديد ومغفرة من الله ورضوان وما الحياة الدنيا إلا متاع الغرور" />
-		<aya index="21" text="سابقوا إلى مغفرة من ربكم وجنة عرضها كعرض السماء والأرض أعدت للذين آمنوا بالله ورسله ذلك فضل الله يؤتيه من يشاء والله ذو الفضل العظيم" />
-		<aya index="22" text="ما أصاب من مصيبة في الأرض ولا في أنفسكم إلا في كتاب من قبل أن نبرأها إن ذلك على الله يسير" />
-		<aya index="23" text="لكيلا تأسوا على ما فاتكم ولا تفرحوا بما آتاكم والله لا يحب كل مختال فخور" />
-		<aya index="24" text="الذين يبخلون ويأمرون الناس بالبخل ومن يتول فإن الله هو الغني الحميد" />
-		<aya index="25" text="لقد أرسلنا رسلنا بالبينات وأنزلنا معهم الكتاب والميزان ليقوم الناس بالقسط وأنزلنا الحديد فيه بأس شديد ومنافع للناس وليعلم الله من ينصره ورسله بالغيب إن الله قوي عزيز" />
-		<aya index="26" text="ولقد أرسلنا نوحا وإبراهيم وجعلنا في ذريتهما النبوة والكتاب فمنهم مهتد وكثير منهم فاسقون" />
-		<aya index="27" text="ثم قفينا على آثارهم برسلنا وقفينا بعيسى ابن مريم وآتيناه الإنجيل وجعلنا في قلوب الذين اتبعوه رأفة ورحمة ورهبانية ابتدعوها ما كتبناها عليهم إلا ابتغاء رضوان الله فما رعوها حق رعايتها فآتينا الذين آمنوا منهم أجرهم وكثير منهم فاسقون" />
-		<aya index="28" text="يا أيها الذين آمنوا اتقوا الله وآمنوا برسوله يؤتكم كفلين من رحمته ويجعل لكم نورا تمشون به ويغفر لكم والله غفور رحيم" />
-		<aya index="29" text="لئلا يعلم أهل الكتاب ألا يقدرون على شيء من فضل الله وأن الفضل بيد الله يؤتيه من يشاء والله ذو الفضل العظيم" />
-	</sura>
-	<sura index="58" name="المجادلة">
-		<aya index="1" text="قد سمع الله قول التي تجادلك في زوجها وتشتكي إلى الله والله يسمع تحاوركما إن الله سميع بصير" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الذين يظاهرون منكم من نسائهم ما هن أمهاتهم إن أمهاتهم إلا اللائي ولدنهم وإنهم ليقولون منكرا من القول وزورا وإن الله لعفو غفور" />
-		<aya index="3" text="والذين يظاهرون من نسائهم ثم يعودون لما قالوا فتحرير رقبة من قبل أن يتماسا ذلكم توعظون به والله بما تعملون خبير" />
-		<aya index="4" text="فمن لم يجد فصيام شهرين متتابعين من قبل أن يتماسا فمن لم يستطع فإطعام ستين مسكينا ذلك لتؤمنوا بالله ورسوله وتلك حدود الله وللكافرين عذاب أليم" />
-		<aya index="5" text="إن الذين يحادون الله ورسوله كبتوا كما كبت الذين من قبلهم وقد أنزلنا آيات بينات وللكافرين عذاب مهين" />
-		<aya index="6" text="يوم يبعثهم الله جميعا فينبئهم بما عملوا أحصاه الله ونسوه والله على كل شيء شهيد" />
-		<aya index="7" text="ألم تر أن الله يعلم ما في السماوات وما في الأرض ما يكون من نجوى ثلاثة إلا هو رابعهم ولا خمسة إلا هو سادسهم ولا أدنى من ذلك ولا أكثر إلا هو معهم أين ما كانوا ثم ينبئهم بما عملوا يوم القيامة إن الله بكل شيء عليم" />
-		<aya index="8" text="ألم تر إلى الذين نهوا عن النجوى ثم يعودون لما نهوا عنه ويتناجون بالإثم والعدوان ومعصيت الرسول وإذا جاءوك حيوك بما لم يحيك به الله ويقولون في أنفسهم لولا يعذبنا الله بما نقول حسبهم جهنم يصلونها فبئس المصير" />
-		<aya index="9" text="يا أيها الذين آمنوا إذا تناجيتم فلا تتناجوا بالإثم والعدوان ومعصيت الرسول وتناجوا بالبر والتقوى واتقوا الله الذي إليه تحشرون" />
-		<aya index="10" text="إنما النجوى من الشيطان ليحزن الذين آمنوا وليس بضارهم شيئا إلا بإذن الله وعلى الله فليتوكل المؤمنون" />
-		<aya index="11" text="يا أيها الذين آمنوا إذا قيل لكم تفسحوا في المجالس فافسحوا يفسح الله لكم وإذا قيل انشزوا فانشزوا يرفع الله الذين آمنوا منكم والذين أوتوا العلم درجات والله بما تعملون خبير" />
-		<aya index="12" text="يا أيها الذين آمنوا إذا ناجيتم الرسول فقدموا بين يدي نجواكم صدقة ذلك خير لكم وأطهر فإن لم تجدوا فإن الله غفور رحيم" />
-		<aya index="13" text="أأشفقتم أن تقدموا بين يدي نجواكم صدقات فإذ لم تفعلوا وتاب الله عليكم فأقيموا الصلاة وآتوا الزكاة وأطيعوا الله ورسوله والله خبير بما تعملون" />
-		<aya index="14" text="ألم تر إلى الذين تولوا قوما غضب الله عليهم ما هم منكم ولا منهم ويحلفون على الكذب وهم يعلمون" />
-		<aya index="15" text="أعد الله لهم عذابا شديدا إنهم ساء ما كانوا يعملون" />
-		<aya index="16" text="اتخذوا أيمانهم جنة فصدوا عن سبيل الله فلهم عذاب مهين" />
-		<aya index="17" text="لن تغني عنهم أموالهم ولا أولادهم من الله شيئا أولئك أصحاب النار هم فيها خالدون" />
-		<aya index="18" text="يوم يبعثهم الله جميعا فيحلفون له كما يحلفون لكم ويحسبون أنهم على شيء ألا إنهم هم الكاذبون" />
-		<aya index="19" text="استحوذ عليهم الشيطان فأنساهم ذكر الله أولئك حزب الشيطان ألا إن حزب الشيطان هم الخاسرون" />
-		<aya index="20" text="إن الذين يحادون الله ورسوله أولئك في الأذلين" />
-		<aya index="21" text="كتب الله لأغلبن أنا ورسلي إن الله قوي عزيز" />
-		<aya index="22" text="لا تجد قوما يؤمنون بالله واليوم الآخر يوادون من حاد الله ورسوله ولو كانوا آباءهم أو أبناءهم أو إخوانهم أو عشيرتهم أولئك كتب في قلوبهم الإيمان وأيدهم بروح منه ويدخلهم جنات تجري من تحتها الأنهار خالدين فيها رضي الله عنهم ورضوا عنه أولئك حزب الله ألا إن حزب الله هم المفلحون" />
-	</sura>
-	<sura index="59" name="الحشر">
-		<aya index="1" text="سبح لله ما في السماوات وما في الأرض وهو العزيز الحكيم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="هو الذي أخرج الذين كفروا من أهل الكتاب من ديارهم لأول الحشر ما ظننتم أن يخرجوا وظنوا أنهم مانعتهم حصونهم من الله فأتاهم الله من حيث لم يحتسبوا وقذف في قلوبهم الرعب يخربون بيوتهم بأيديهم وأيدي المؤمنين فاعتبروا يا أولي الأبصار" />
-		<aya index="3" text="ولولا أن كتب الله عليهم الجلاء لعذبهم في الدنيا ولهم في الآخرة عذاب النار" />
-		<aya index="4" text="ذلك بأنهم شاقوا الله ورسوله ومن يشاق الله فإن الله شديد العقاب" />
-		<aya index="5" text="ما قطعتم من لينة أو تركتموها قائمة على أصولها فبإذن الله وليخزي الفاسقين" />
-		<aya index="6" text="وما أفاء الله على رسوله منهم فما أوجفتم عليه من خيل ولا ركاب ولكن الله يسلط رسله على من يشاء والله على كل شيء قدير" />
-		<aya index="7" text="ما أفاء الله على رسوله من أهل القرى فلله وللرسول ولذي القربى واليتامى والمساكين وابن السبيل كي لا يكون دولة بين الأغنياء منكم وما آتاكم الرسول فخذوه وما نهاكم عنه فانتهوا واتقوا الله إن الله شديد العقاب" />
-		<aya index="8" text="للفقراء المهاجرين الذين أخرجوا من ديارهم وأموالهم يبتغون فضلا من الله ورضوانا وينصرون الله ورسوله أولئك هم الصادقون" />
-		<aya index="9" text="والذين تبوءوا الدار والإيمان من قبلهم يحبون من هاجر إليهم ولا يجدون في صدورهم حاجة مما أوتوا ويؤثرون على أنفسهم ولو كان بهم خصاصة ومن يوق شح نفسه فأولئك هم المفلحون" />
-		<aya index="10" text="والذين جاءوا من بعدهم يقولون ربنا اغفر لنا ولإخواننا الذين سبقونا بالإيمان ولا تجعل في قلوبنا غلا للذين آمنوا ربنا إنك رءوف رحيم" />
-		<aya index="11" text="ألم تر إلى الذين نافقوا يقولون لإخوانهم الذين كفروا من أهل الكتاب لئن أخرجتم لنخرجن معكم ولا نطيع فيكم أحدا أبدا وإن قوتلتم لننصرنكم والله يشهد إنهم لكاذبون" />
-		<aya index="12" text="لئن أخرجوا لا يخرجون معهم ولئن قوتلوا لا ينصرونهم ولئن نصروهم ليولن الأدبار ثم لا ينصرون" />
-		<aya index="13" text="لأنتم أشد رهبة في صدورهم من الله ذلك بأنهم قوم لا يفقهون" />
-		<aya index="14" text="لا يقاتلونكم جميعا إلا في قرى محصنة أو من وراء جدر بأسهم بينهم شديد تحسبهم جميعا وقلوبهم شتى ذلك بأنهم قوم لا يعقلون" />
-		<aya index="15" text="كمثل الذين من قبلهم قريبا ذاقوا وبال أمرهم ولهم عذاب أليم" />
-		<aya index="16" text="كمثل الشيطان إذ قال للإنسان اكفر فلما كفر قال إني بريء منك إني أخاف الله رب العالمين" />
-		<aya index="17" text="فكان عاقبتهما أنهما في النار خالدين فيها وذلك جزاء الظالمين" />
-		<aya index="18" text="يا أيها الذين آمنوا اتقوا الله ولتنظر نفس ما قدمت لغد واتقوا الله إن الله خبير بما تعملون" />
-		<aya index="19" text="ولا تكونوا كالذين نسوا الله فأنساهم أنفسهم أولئك هم الفاسقون" />
-		<aya index="20" text="لا يستوي أصحاب النار وأصحاب الجنة أصحاب الجنة هم الفائزون" />
-		<aya index="21" text="لو أنزلنا هذا القرآن على جبل لرأيته خاشعا متصدعا من خشية الله وتلك الأمثال نضربها للناس لعلهم يتفكرون" />
-		<aya index="22" text="هو الله الذي لا إله إلا هو عالم الغيب والشهادة هو الرحمن الرحيم" />
-		<aya index="23" text="هو الله الذي لا إله إلا هو الملك القدوس السلام المؤمن المهيمن العزيز الجبار المتكبر سبحان الله عما يشركون" />
-		<aya index="24" text="هو الله الخالق البارئ المصور له الأسماء الحسنى يسبح له ما في السماوات والأرض وهو العزيز الحكيم" />
-	</sura>
-	<sura index="60" name="الممتحنة">
-		<aya index="1" text="يا أيها الذين آمنوا لا تتخذوا عدوي وعدوكم أولياء تلقون إليهم بالمودة وقد كفروا بما جاءكم من الحق يخرجون الرسول وإياكم أن تؤمنوا بالله ربكم إن كنتم خرجتم جهادا في سبيلي وابتغاء مرضاتي تسرون إليهم بالمودة وأنا أعلم بما أخفيتم وما أعلنتم ومن يفعله منكم فقد ضل سواء السبيل" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="إن يثقفوكم يكونوا لكم أعداء ويبسطوا إليكم أيديهم وألسنتهم بالسوء وودوا لو تكفرون" />
-		<aya index="3" text="لن تنفعكم أرحامكم ولا أولادكم يوم القيامة يفصل بينكم والله بما تعملون بصير" />
-		<aya index="4" text="قد كانت لكم أسوة حسنة في إبراهيم والذين معه إذ قالوا لقومهم إنا برآء منكم ومما تعبدون من دون الله كفرنا بكم وبدا بيننا وبينكم العداوة والبغضاء أبدا حتى تؤمنوا بالله وحده إلا قول إبراهيم لأبيه لأستغفرن لك وما أملك لك من الله من شيء ربنا عليك توكلنا وإليك أنبنا وإليك المصير" />
-		<aya index="5" text="ربنا لا تجعلنا فتنة للذين كفروا واغفر لنا ربنا إنك أنت العزيز الحكيم" />
-		<aya index="6" text="لقد كان لكم فيهم أسوة حسنة لمن كان يرجو الله واليوم الآخر ومن يتول فإن الله هو الغني الحميد" />
-		<aya index="7" text="عسى الله أن يجعل بينكم وبين الذين عاديتم منهم مودة والله قدير والله غفور رحيم" />
-		<aya index="8" text="لا ينهاكم الله عن الذين لم يقاتلوكم في الدين ولم يخرجوكم من دياركم أن تبروهم وتقسطوا إليهم إن الله يحب المقسطين" />
-		<aya index="9" text="إنما ينهاكم الله عن الذين قاتلوكم في الدين وأخرجوكم من دياركم وظاهروا على إخراجكم أن تولوهم ومن يتولهم فأولئك هم الظالمون" />
-		<aya index="10" text="يا أيها الذين آمنوا إذا جاءكم المؤمنات مهاجرات فامتحنوهن الله أعلم بإيمانهن فإن علمتموهن مؤمنات فلا ترجعوهن إلى الكفار لا هن حل لهم ولا هم يحلون لهن وآتوهم ما أنفقوا ولا جناح عليكم أن تنكحوهن إذا آتيتموهن أجورهن ولا تمسكوا بعصم الكوافر واسألوا ما أنفقتم وليسألوا ما أنفقوا ذلكم حكم الله يحكم بينكم والله عليم حكيم" />
-		<aya index="11" text="وإن فاتكم شيء من أزواجكم إلى الكفار فعاقبتم فآتوا الذين ذهبت أزواجهم مثل ما أنفقوا واتقوا الله الذي أنتم به مؤمنون" />
-		<aya index="12" text="يا أيها النبي إذا جاءك المؤمنات يبايعنك على أن لا يشركن بالله شيئا ولا يسرقن ولا يزنين ولا يقتلن أولادهن ولا يأتين ببهتان يفترينه بين أيديهن وأرجلهن ولا يعصينك في معروف فبايعهن واستغفر لهن الله إن الله غفور رحيم" />
-		<aya index="13" text="يا أيها الذين آمنوا لا تتولوا قوما غضب الله عليهم قد يئسوا من الآخرة كما يئس الكفار من أصحاب القبور" />
-	</sura>
-	<sura index="61" name="الصف">
-		<aya index="1" text="سبح لله ما في السماوات وما في الأرض وهو العزيز الحكيم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="يا أيها الذين آمنوا لم تقولون ما لا تفعلون" />
-		<aya index="3" text="كبر مقتا عند الله أن تقولوا ما لا تفعلون" />
-		<aya index="4" text="إن الله يحب الذين يقاتلون في سبيله صفا كأنهم بنيان مرصوص" />
-		<aya index="5" text="وإذ قال موسى لقومه يا قوم لم تؤذونني وقد تعلمون أني رسول الله إليكم فلما زاغوا أزاغ الله قلوبهم والله لا يهدي القوم الفاسقين" />
-		<aya index="6" text="وإذ قال عيسى ابن مريم يا بني إسرائيل إني رسول الله إليكم مصدقا لما بين يدي من التوراة ومبشرا برسول يأتي من بعدي اسمه أحمد فلما جاءهم بالبينات قالوا هذا سحر مبين" />
-		<aya index="7" text="ومن أظلم ممن افترى على الله الكذب وهو يدعى إلى الإسلام والله لا يهدي القوم الظالمين" />
-		<aya index="8" text="يريدون ليطفئوا نور الله بأفواههم والله متم نوره ولو كره الكافرون" />
-		<aya index="9" text="هو الذي أرسل رسوله بالهدى ودين الحق ليظهره على الدين كله ولو كره المشركون" />
-		<aya index="10" text="يا أيها الذين آمنوا هل أدلكم على تجارة تنجيكم من عذاب أليم" />
-		<aya index="11" text="تؤمنون بالله ورسوله وتجاهدون في سبيل الله بأموالكم وأنفسكم ذلكم خير لكم إن كنتم تعلمون" />
-		<aya index="12" text="يغفر لكم ذنوبكم ويدخلكم جنات تجري من تحتها الأنهار ومساكن طيبة في جنات عدن ذلك الفوز العظيم" />
-		<aya index="13" text="وأخرى تحبونها نصر من الله وفتح قريب وبشر المؤمنين" />
-		<aya index="14" text="يا أيها الذين آمنوا كونوا أنصار الله كما قال عيسى ابن مريم للحواريين من أنصاري إلى الله قال الحواريون نحن أنصار الله فآمنت طائفة من بني إسرائيل وكفرت طائفة فأيدنا الذين آمنوا على عدوهم فأصبحوا ظاهرين" />
-	</sura>
-	<sura index="62" name="الجمعة">
-		<aya index="1" text="يسبح لله ما في السماوات وما في الأرض الملك القدوس العزيز الحكيم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="هو الذي بعث في الأميين رسولا منهم يتلو عليهم آياته ويزكيهم ويعلمهم الكتاب والحكمة وإن كانوا من قبل لفي ضلال مبين" />
-		<aya index="3" text="وآخرين منهم لما يلحقوا بهم وهو العزيز الحكيم" />
-		<aya index="4" text="ذلك فضل الله يؤتيه من يشاء والله ذو الفضل العظيم" />
-		<aya index="5" text="مثل الذين حملوا التوراة ثم لم يحملوها كمثل الحمار يحمل أسفارا بئس مثل القوم الذين كذبوا بآيات الله والله لا يهدي القوم الظالمين" />
-		<aya index="6" text="قل يا أيها الذين هادوا إن زعمتم أنكم أولياء لله من دون الناس فتمنوا الموت إن كنتم صادقين" />
-		<aya index="7" text="ولا يتمنونه أبدا بما قدمت أيديهم والله عليم بالظالمين" />
-		<aya index="8" text="قل إن الموت الذي تفرون منه فإنه ملاقيكم ثم تردون إلى عالم الغيب والشهادة فينبئكم بما كنتم تعملون" />
-		<aya index="9" text="يا أيها الذين آمنوا إذا نودي للصلاة من يوم الجمعة فاسعوا إلى ذكر الله وذروا البيع ذلكم خير لكم إن كنتم تعلمون" />
-		<aya index="10" text="فإذا قضيت الصلاة فانتشروا في الأرض وابتغوا من فضل الله واذكروا الله كثيرا لعلكم تفلحون" />
-		<aya index="11" text="وإذا رأوا تجارة أو لهوا انفضوا إليها وتركوك قائما قل ما عند الله خير من اللهو ومن التجارة والله خير الرازقين" />
-	</sura>
-	<sura index="63" name="المنافقون">
-		<aya index="1" text="إذا جاءك المنافقون قالوا نشهد إنك لرسول الله والله يعلم إنك لرسوله والله يشهد إن المنافقين لكاذبون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="اتخذوا أيمانهم جنة فصدوا عن سبيل الله إنهم ساء ما كانوا يعملون" />
-		<aya index="3" text="ذلك بأنهم آمنوا ثم كفروا فطبع على قلوبهم فهم لا يفقهون" />
-		<aya index="4" text="وإذا رأيتهم تعجبك أجسامهم وإن يقولوا تسمع لقولهم كأنهم خشب مسندة يحسبون كل صيحة عليهم هم العدو فاحذرهم قاتلهم الله أنى يؤفكون" />
-		<aya index="5" text="وإذا قيل لهم تعالوا يستغفر لكم رسول الله لووا رءوسهم ورأيتهم يصدون وهم مستكبرون" />
-		<aya index="6" text="سواء عليهم أستغفرت لهم أم لم تستغفر لهم لن يغفر الله لهم إن الله لا يهدي القوم الفاسقين" />
-		<aya index="7" text="هم الذين يقولون لا تنفقوا على من عند رسول الله حتى ينفضوا ولله خزائن السماوات والأرض ولكن المنافقين لا يفقهون" />
-		<aya index="8" text="يقولون لئن رجعنا إلى المدينة ليخرجن الأعز منها الأذل ولله العزة ولرسوله وللمؤمنين ولكن المنافقين لا يعلمون" />
-		<aya index="9" text="يا أيها الذين آمنوا لا تلهكم أموالكم ولا أولادكم عن ذكر الله ومن يفعل ذلك فأولئك هم الخاسرون" />
-		<aya index="10" text="وأنفقوا من ما رزقناكم من قبل أن يأتي أحدكم الموت فيقول رب لولا أخرتني إلى أجل قريب فأصدق وأكن من الصالحين" />
-		<aya index="11" text="ولن يؤخر الله نفسا إذا جاء أجلها والله خبير بما تعملون" />
-	</sura>
-	<sura index="64" name="التغابن">
-		<aya index="1" text="يسبح لله ما في السماوات وما في الأرض له الملك وله الحمد وهو على كل شيء قدير" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="هو الذي خلقكم فمنكم كافر ومنكم مؤمن والله بما تعملون بصير" />
-		<aya index="3" text="خلق السماوات والأرض بالحق وصوركم فأحسن صوركم وإليه المصير" />
-		<aya index="4" text="يعلم ما في السماوات والأرض ويعلم ما تسرون وما تعلنون والله عليم بذات الصدور" />
-		<aya index="5" text="ألم يأتكم نبأ الذين كفروا من قبل فذاقوا وبال أمرهم ولهم عذاب أليم" />
-		<aya index="6" text="ذلك بأنه كانت تأتيهم رسلهم بالبينات فقالوا أبشر يهدوننا فكفروا وتولوا واستغنى الله والله غني حميد" />
-		<aya index="7" text="زعم الذين كفروا أن لن يبعثوا قل بلى وربي لتبعثن ثم لتنبؤن بما عملتم وذلك على الله يسير" />
-		<aya index="8" text="فآمنوا بالله ورسوله والنور الذي أنزلنا والله بما تعملون خبير" />
-		<aya index="9" text="يوم يجمعكم ليوم الجمع ذلك يوم التغابن ومن يؤمن بالله ويعمل صالحا يكفر عنه سيئاته ويدخله جنات تجري من تحتها الأنهار خالدين فيها أبدا ذلك الفوز العظيم" />
-		<aya index="10" text="والذين كفروا وكذبوا بآياتنا أولئك أصحاب النار خالدين فيها وبئس المصير" />
-		<aya index="11" text="ما أصاب من مصيبة إلا بإذن الله ومن يؤمن بالله يهد قلبه والله بكل شيء عليم" />
-		<aya index="12" text="وأطيعوا الله وأطيعوا الرسول فإن توليتم فإنما على رسولنا البلاغ المبين" />
-		<aya index="13" text="الله لا إله إلا هو وعلى الله فليتوكل المؤمنون" />
-		<aya index="14" text="يا أيها الذين آمنوا إن من أزواجكم وأولادكم عدوا لكم فاحذروهم وإن تعفوا وتصفحوا وتغفروا فإن الله غفور رحيم" />
-		<aya index="15" text="إنما أموالكم وأولادكم فتنة والله عنده أجر عظيم" />
-		<aya index="16" text="فاتقوا الله ما استطعتم واسمعوا وأطيعوا وأنفقوا خيرا لأنفسكم ومن يوق شح نفسه فأولئك هم المفلحون" />
-		<aya index="17" text="إن تقرضوا الله قرضا حسنا يضاعفه لكم ويغفر لكم والله شكور حليم" />
-		<aya index="18" text="عالم الغيب والشهادة العزيز الحكيم" />
-	</sura>
-	<sura index="65" name="الطلاق">
-		<aya index="1" text="يا أيها النبي إذا طلقتم النساء فطلقوهن لعدتهن وأحصوا العدة واتقوا الله ربكم لا تخرجوهن من بيوتهن ولا يخرجن إلا أن يأتين بفاحشة مبينة وتلك حدود الله ومن يتعد حدود الله فقد ظلم نفسه لا تدري لعل الله يحدث بعد ذلك أمرا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="فإذا بلغن أجلهن فأمسكوهن بمعروف أو فارقوهن بمعروف وأشهدوا ذوي عدل منكم وأقيموا الشهادة لله ذلكم يوعظ به من كان يؤمن بالله واليوم الآخر ومن يتق الله يجعل له مخرجا" />
-		<aya index="3" text="ويرزقه من حيث لا يحتسب ومن يتوكل على الله فهو حسبه إن الله بالغ أمره قد جعل الله لكل شيء قدرا" />
-		<aya index="4" text="واللائي يئسن من المحيض من نسائكم إن ارتبتم فعدتهن ثلاثة أشهر واللائي لم يحضن وأولات الأحمال أجلهن أن يضعن حملهن ومن يتق الله يجعل له من أمره يسرا" />
-		<aya index="5" text="ذلك أمر الله أنزله إليكم ومن يتق الله يكفر عنه سيئاته ويعظم له أجرا" />
-		<aya index="6" text="أسكنوهن من حيث سكنتم من وجدكم ولا تضاروهن لتضيقوا عليهن وإن كن أولات حمل فأنفقوا عليهن حتى يضعن حملهن فإن أرضعن لكم فآتوهن أجورهن وأتمروا بينكم بمعروف وإن تعاسرتم فسترضع له أخرى" />
-		<aya index="7" text="لينفق ذو سعة من سعته ومن قدر عليه رزقه فلينفق مما آتاه الله لا يكلف الله نفسا إلا ما آتاها سيجعل الله بعد عسر يسرا" />
-		<aya index="8" text="وكأين من قرية عتت عن أمر ربها ورسله فحاسبناها حسابا شديدا وعذبناها عذابا نكرا" />
-		<aya index="9" text="فذاقت وبال أمرها وكان عاقبة أمرها خسرا" />
-		<aya index="10" text="أعد الله لهم عذابا شديدا فاتقوا الله يا أولي الألباب الذين آمنوا قد أنزل الله إليكم ذكرا" />
-		<aya index="11" text="رسولا يتلو عليكم آيات الله مبينات ليخرج الذين آمنوا وعملوا الصالحات من الظلمات إلى النور ومن يؤمن بالله ويعمل صالحا يدخله جنات تجري من تحتها الأنهار خالدين فيها أبدا قد أحسن الله له رزقا" />
-		<aya index="12" text="الله الذي خلق سبع سماوات ومن الأرض مثلهن يتنزل الأمر بينهن لتعلموا أن الله على كل شيء قدير وأن الله قد أحاط بكل شيء علما" />
-	</sura>
-	<sura index="66" name="التحريم">
-		<aya index="1" text="يا أيها النبي لم تحرم ما أحل الله لك تبتغي مرضات أزواجك والله غفور رحيم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="قد فرض الله لكم تحلة أيمانكم والله مولاكم وهو العليم الحكيم" />
-		<aya index="3" text="وإذ أسر النبي إلى بعض أزواجه حديثا فلما نبأت به وأظهره الله عليه عرف بعضه وأعرض عن بعض فلما نبأها به قالت من أنبأك هذا قال نبأني العليم الخبير" />
-		<aya index="4" text="إن تتوبا إلى الله فقد صغت قلوبكما وإن تظاهرا عليه فإن الله هو مولاه وجبريل وصالح المؤمنين والملائكة بعد ذلك ظهير" />
-		<aya index="5" text="عسى ربه إن طلقكن أن يبدله أزواجا خيرا منكن مسلمات مؤمنات قانتات تائبات عابدات سائحات ثيبات وأبكارا" />
-		<aya index="6" text="يا أيها الذين آمنوا قوا أنفسكم وأهليكم نارا وقودها الناس والحجارة عليها ملائكة غلاظ شداد لا يعصون الله ما أمرهم ويفعلون ما يؤمرون" />
-		<aya index="7" text="يا أيها الذين كفروا لا تعتذروا اليوم إنما تجزون ما كنتم تعملون" />
-		<aya index="8" text="يا أيها الذين آمنوا توبوا إلى الله توبة نصوحا عسى ربكم أن يكفر عنكم سيئاتكم ويدخلكم جنات تجري من تحتها الأنهار يوم لا يخزي الله النبي والذين آمنوا معه نورهم يسعى بين أيديهم وبأيمانهم يقولون ربنا أتمم لنا نورنا واغفر لنا إنك على كل شيء قدير" />
-		<aya index="9" text="يا أيها النبي جاهد الكفار والمنافقين واغلظ عليهم ومأواهم جهنم وبئس المصير" />
-		<aya index="10" text="ضرب الله مثلا للذين كفروا امرأت نوح وامرأت لوط كانتا تحت عبدين من عبادنا صالحين فخانتاهما فلم يغنيا عنهما من الله شيئا وقيل ادخلا النار مع الداخلين" />
-		<aya index="11" text="وضرب الله مثلا للذين آمنوا امرأت فرعون إذ قالت رب ابن لي عندك بيتا في الجنة ونجني من فرعون وعمله ونجني من القوم الظالمين" />
-		<aya index="12" text="ومريم ابنت عمران التي أحصنت فرجها فنفخنا فيه من روحنا وصدقت بكلمات ربها وكتبه وكانت من القانتين" />
-	</sura>
-	<sura index="67" name="الملك">
-		<aya index="1" text="تبارك الذي بيده الملك وهو على كل شيء قدير" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الذي خلق الموت والحياة ليبلوكم أيكم أحسن عملا وهو العزيز الغفور" />
-		<aya index="3" text="الذي خلق سبع سماوات طباقا ما ترى في خلق الرحمن من تفاوت فارجع البصر هل ترى من فطور" />
-		<aya index="4" text="ثم ارجع البصر كرتين ينقلب إليك البصر خاسئا وهو حسير" />
-		<aya index="5" text="ولقد زينا السماء الدنيا بمصابيح وجعلناها رجوما للشياطين وأعتدنا لهم عذاب السعير" />
-		<aya index="6" text="وللذين كفروا بربهم عذاب جهنم وبئس المصير" />
-		<aya index="7" text="إذا ألقوا فيها سمعوا لها شهيقا وهي تفور" />
-		<aya index="8" text="تكاد تميز من الغيظ كلما ألقي فيها فوج سألهم خزنتها ألم يأتكم نذير" />
-		<aya index="9" text="قالوا بلى قد جاءنا نذير فكذبنا وقلنا ما نزل الله من شيء إن أنتم إلا في ضلال كبير" />
-		<aya index="10" text="وقالوا لو كنا نسمع أو نعقل ما كنا في أصحاب السعير" />
-		<aya index="11" text="فاعترفوا بذنبهم فسحقا لأصحاب السعير" />
-		<aya index="12" text="إن الذين يخشون ربهم بالغيب لهم مغفرة وأجر كبير" />
-		<aya index="13" text="وأسروا قولكم أو اجهروا به إنه عليم بذات الصدور" />
-		<aya index="14" text="ألا يعلم من خلق وهو اللطيف الخبير" />
-		<aya index="15" text="هو الذي جعل لكم الأرض ذلولا فامشوا في مناكبها وكلوا من رزقه وإليه النشور" />
-		<aya index="16" text="أأمنتم من في السماء أن يخسف بكم الأرض فإذا هي تمور" />
-		<aya index="17" text="أم أمنتم من في السماء أن يرسل عليكم حاصبا فستعلمون كيف نذير" />
-		<aya index="18" text="ولقد كذب الذين من قبلهم فكيف كان نكير" />
-		<aya index="19" text="أولم يروا إلى الطير فوقهم صافات ويقبضن ما يمسكهن إلا الرحمن إنه بكل شيء بصير" />
-		<aya index="20" text="أمن هذا الذي هو جند لكم ينصركم من دون الرحمن إن الكافرون إلا في غرور" />
-		<aya index="21" text="أمن هذا الذي يرزقكم إن أمسك رزقه بل لجوا في عتو ونفور" />
-		<aya index="22" text="أفمن يمشي مكبا على وجهه أهدى أمن يمشي سويا على صراط مستقيم" />
-		<aya index="23" text="قل هو الذي أنشأكم وجعل لكم السمع والأبصار والأفئدة قليلا ما تشكرون" />
-		<aya index="24" text="قل هو الذي ذرأكم في الأرض وإليه تحشرون" />
-		<aya index="25" text="ويقولون متى هذا الوعد إن كنتم صادقين" />
-		<aya index="26" text="قل إنما العلم عند الله وإنما أنا نذير مبين" />
-		<aya index="27" text="فلما رأوه زلفة سيئت وجوه الذين كفروا وقيل هذا الذي كنتم به تدعون" />
-		<aya index="28" text="قل أرأيتم إن أهلكني الله ومن معي أو رحمنا فمن يجير الكافرين من عذاب أليم" />
-		<aya index="29" text="قل هو الرحمن آمنا به وعليه توكلنا فستعلمون من هو في ضلال مبين" />
-		<aya index="30" text="قل أرأيتم إن أصبح ماؤكم غورا فمن يأتيكم بماء معين" />
-	</sura>
-	<sura index="68" name="القلم">
-		<aya index="1" text="ن والقلم وما يسطرون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ما أنت بنعمة ربك بمجنون" />
-		<aya index="3" text="وإن لك لأجرا غير ممنون" />
-		<aya index="4" text="وإنك لعلى خلق عظيم" />
-		<aya index="5" text="فستبصر ويبصرون" />
-		<aya index="6" text="بأييكم المفتون" />
-		<aya index="7" text="إن ربك هو أعلم بمن ضل عن سبيله وهو أعلم بالمهتدين" />
-		<aya index="8" text="فلا تطع المكذبين" />
-		<aya index="9" text="ودوا لو تدهن فيدهنون" />
-		<aya index="10" text="ولا تطع كل حلاف مهين" />
-		<aya index="11" text="هماز مشاء بنميم" />
-		<aya index="12" text="مناع للخير معتد أثيم" />
-		<aya index="13" text="عتل بعد ذلك زنيم" />
-		<aya index="14" text="أن كان ذا مال وبنين" />
-		<aya index="15" text="إذا تتلى عليه آياتنا قال أساطير الأولين" />
-		<aya index="16" text="سنسمه على الخرطوم" />
-		<aya index="17" text="إنا بلوناهم كما بلونا أصحاب الجنة إذ أقسموا ليصرمنها مصبحين" />
-		<aya index="18" text="ولا يستثنون" />
-		<aya index="19" text="فطاف عليها طائف من ربك وهم نائمون" />
-		<aya index="20" text="فأصبحت كالصريم" />
-		<aya index="21" text="فتنادوا مصبحين" />
-		<aya index="22" text="أن اغدوا على حرثكم إن كنتم صارمين" />
-		<aya index="23" text="فانطلقوا وهم يتخافتون" />
-		<aya index="24" text="أن لا يدخلنها اليوم عليكم مسكين" />
-		<aya index="25" text="وغدوا على حرد قادرين" />
-		<aya index="26" text="فلما رأوها قالوا إنا لضالون" />
-		<aya index="27" text="بل نحن محرومون" />
-		<aya index="28" text="قال أوسطهم ألم أقل لكم لولا تسبحون" />
-		<aya index="29" text="قالوا سبحان ربنا إنا كنا ظالمين" />
-		<aya index="30" text="فأقبل بعضهم على بعض يتلاومون" />
-		<aya index="31" text="قالوا يا ويلنا إنا كنا طاغين" />
-		<aya index="32" text="عسى ربنا أن يبدلنا خيرا منها إنا إلى ربنا راغبون" />
-		<aya index="33" text="كذلك العذاب ولعذاب الآخرة أكبر لو كانوا يعلمون" />
-		<aya index="34" text="إن للمتقين عند ربهم جنات النعيم" />
-		<aya index="35" text="أفنجعل المسلمين كالمجرمين" />
-		<aya index="36" text="ما لكم كيف تحكمون" />
-		<aya index="37" text="أم لكم كتاب فيه تدرسون" />
-		<aya index="38" text="إن لكم فيه لما تخيرون" />
-		<aya index="39" text="أم لكم أيمان علينا بالغة إلى يوم القيامة إن لكم لما تحكمون" />
-		<aya index="40" text="سلهم أيهم بذلك زعيم" />
-		<aya index="41" text="أم لهم شركاء فليأتوا بشركائهم إن كانوا صادقين" />
-		<aya index="42" text="يوم يكشف عن ساق ويدعون إلى السجود فلا يستطيعون" />
-		<aya index="43" text="خاشعة أبصارهم ترهقهم ذلة وقد كانوا يدعون إلى السجود وهم سالمون" />
-		<aya index="44" text="فذرني ومن يكذب بهذا الحديث سنستدرجهم من حيث لا يعلمون" />
-		<aya index="45" text="وأملي لهم إن كيدي متين" />
-		<aya index="46" text="أم تسألهم أجرا فهم من مغرم مثقلون" />
-		<aya index="47" text="أم عندهم الغيب فهم يكتبون" />
-		<aya index="48" text="فاصبر لحكم ربك ولا تكن كصاحب الحوت إذ نادى وهو مكظوم" />
-		<aya index="49" text="لولا أن تداركه نعمة من ربه لنبذ بالعراء وهو مذموم" />
-		<aya index="50" text="فاجتباه ربه فجعله من الصالحين" />
-		<aya index="51" text="وإن يكاد الذين كفروا ليزلقونك بأبصارهم لما سمعوا الذكر ويقولون إنه لمجنون" />
-		<aya index="52" text="وما هو إلا ذكر للعالمين" />
-	</sura>
-	<sura index="69" name="الحاقة">
-		<aya index="1" text="الحاقة" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ما الحاقة" />
-		<aya index="3" text="وما أدراك ما الحاقة" />
-		<aya index="4" text="كذبت ثمود وعاد بالقارعة" />
-		<aya index="5" text="فأما ثمود فأهلكوا بالطاغية" />
-		<aya index="6" text="وأما عاد فأهلكوا بريح صرصر عاتية" />
-		<aya index="7" text="سخرها عليهم سبع ليال وثمانية أيام حسوما فترى القوم فيها صرعى كأنهم أعجاز نخل خاوية" />
-		<aya index="8" text="فهل ترى لهم من باقية" />
-		<aya index="9" text="وجاء فرعون ومن قبله والمؤتفكات بالخاطئة" />
-		<aya index="10" text="فعصوا رسول ربهم فأخذهم أخذة رابية" />
-		<aya index="11" text="إنا لما طغى الماء حملناكم في الجارية" />
-		<aya index="12" text="لنجعلها لكم تذكرة وتعيها أذن واعية" />
-		<aya index="13" text="فإذا نفخ في الصور نفخة واحدة" />
-		<aya index="14" text="وحملت الأرض والجبال فدكتا دكة واحدة" />
-		<aya index="15" text="فيومئذ وقعت الواقعة" />
-		<aya index="16" text="وانشقت السماء فهي يومئذ واهية" />
-		<aya index="17" text="والملك على أرجائها ويحمل عرش ربك فوقهم يومئذ ثمانية" />
-		<aya index="18" text="يومئذ تعرضون لا تخفى منكم خافية" />
-		<aya index="19" text="فأما من أوتي كتابه بيمينه فيقول هاؤم اقرءوا كتابيه" />
-		<aya index="20" text="إني ظننت أني ملاق حسابيه" />
-		<aya index="21" text="فهو في عيشة راضية" />
-		<aya index="22" text="في جنة عالية" />
-		<aya index="23" text="قطوفها دانية" />
-		<aya index="24" text="كلوا واشربوا هنيئا بما أسلفتم في الأيام الخالية" />
-		<aya index="25" text="وأما من أوتي كتابه بشماله فيقول يا ليتني لم أوت كتابيه" />
-		<aya index="26" text="ولم أدر ما حسابيه" />
-		<aya index="27" text="يا ليتها كانت القاضية" />
-		<aya index="28" text="ما أغنى عني ماليه" />
-		<aya index="29" text="هلك عني سلطانيه" />
-		<aya index="30" text="خذوه فغلوه" />
-		<aya index="31" text="ثم الجحيم صلوه" />
-		<aya index="32" text="ثم في سلسلة ذرعها سبعون ذراعا فاسلكوه" />
-		<aya index="33" text="إنه كان لا يؤمن بالله العظيم" />
-		<aya index="34" text="ولا يحض على طعام المسكين" />
-		<aya index="35" text="فليس له اليوم هاهنا حميم" />
-		<aya index="36" text="ولا طعام إلا من غسلين" />
-		<aya index="37" text="لا يأكله إلا الخاطئون" />
-		<aya index="38" text="فلا أقسم بما تبصرون" />
-		<aya index="39" text="وما لا تبصرون" />
-		<aya index="40" text="إنه لقول رسول كريم" />
-		<aya index="41" text="وما هو بقول شاعر قليلا ما تؤمنون" />
-		<aya index="42" text="ولا بقول كاهن قليلا ما تذكرون" />
-		<aya index="43" text="تنزيل من رب العالمين" />
-		<aya index="44" text="ولو تقول علينا بعض الأقاويل" />
-		<aya index="45" text="لأخذنا منه باليمين" />
-		<aya index="46" text="ثم لقطعنا منه الوتين" />
-		<aya index="47" text="فما منكم من أحد عنه حاجزين" />
-		<aya index="48" text="وإنه لتذكرة للمتقين" />
-		<aya index="49" text="وإنا لنعلم أن منكم مكذبين" />
-		<aya index="50" text="وإنه لحسرة على الكافرين" />
-		<aya index="51" text="وإنه لحق اليقين" />
-		<aya index="52" text="فسبح باسم ربك العظيم" />
-	</sura>
-	<sura index="70" name="المعارج">
-		<aya index="1" text="سأل سائل بعذاب واقع" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="للكافرين ليس له دافع" />
-		<aya index="3" text="من الله ذي المعارج" />
-		<aya index="4" text="تعرج الملائكة والروح إليه في يوم كان مقداره خمسين ألف سنة" />
-		<aya index="5" text="فاصبر صبرا جميلا" />
-		<aya index="6" text="إنهم يرونه بعيدا" />
-		<aya index="7" text="ونراه قريبا" />
-		<aya index="8" text="يوم تكون السماء كالمهل" />
-		<aya index="9" text="وتكون الجبال كالعهن" />
-		<aya index="10" text="ولا يسأل حميم حميما" />
-		<aya index="11" text="يبصرونهم يود المجرم لو يفتدي من عذاب يومئذ ببنيه" />
-		<aya index="12" text="وصاحبته وأخيه" />
-		<aya index="13" text="وفصيلته التي تؤويه" />
-		<aya index="14" text="ومن في الأرض جميعا ثم ينجيه" />
-		<aya index="15" text="كلا إنها لظى" />
-		<aya index="16" text="نزاعة للشوى" />
-		<aya index="17" text="تدعو من أدبر وتولى" />
-		<aya index="18" text="وجمع فأوعى" />
-		<aya index="19" text="إن الإنسان خلق هلوعا" />
-		<aya index="20" text="إذا مسه الشر جزوعا" />
-		<aya index="21" text="وإذا مسه الخير منوعا" />
-		<aya index="22" text="إلا المصلين" />
-		<aya index="23" text="الذين هم على صلاتهم دائمون" />
-		<aya index="24" text="والذين في أموالهم حق معلوم" />
-		<aya index="25" text="للسائل والمحروم" />
-		<aya index="26" text="والذين يصدقون بيوم الدين" />
-		<aya index="27" text="والذين هم من عذاب ربهم مشفقون" />
-		<aya index="28" text="إن عذاب ربهم غير مأمون" />
-		<aya index="29" text="والذين هم لفروجهم حافظون" />
-		<aya index="30" text="إلا على أزواجهم أو ما ملكت أيمانهم فإنهم غير ملومين" />
-		<aya index="31" text="فمن ابتغى وراء ذلك فأولئك هم العادون" />
-		<aya index="32" text="والذين هم لأماناتهم وعهدهم راعون" />
-		<aya index="33" text="والذين هم بشهاداتهم قائمون" />
-		<aya index="34" text="والذين هم على صلاتهم يحافظون" />
-		<aya index="35" text="أولئك في جنات مكرمون" />
-		<aya index="36" text="فمال الذين كفروا قبلك مهطعين" />
-		<aya index="37" text="عن اليمين وعن الشمال عزين" />
-		<aya index="38" text="أيطمع كل امرئ منهم أن يدخل جنة نعيم" />
-		<aya index="39" text="كلا إنا خلقناهم مما يعلمون" />
-		<aya index="40" text="فلا أقسم برب المشارق والمغارب إنا لقادرون" />
-		<aya index="41" text="على أن نبدل خيرا منهم وما نحن بمسبوقين" />
-		<aya index="42" text="فذرهم يخوضوا ويلعبوا حتى يلاقوا يومهم الذي يوعدون" />
-		<aya index="43" text="يوم يخرجون من الأجداث سراعا كأنهم إلى نصب يوفضون" />
-		<aya index="44" text="خاشعة أبصارهم ترهقهم ذلة ذلك اليوم الذي كانوا يوعدون" />
-	</sura>
-	<sura index="71" name="نوح">
-		<aya index="1" text="إنا أرسلنا نوحا إلى قومه أن أنذر قومك من قبل أن يأتيهم عذاب أليم" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="قال يا قوم إني لكم نذير مبين" />
-		<aya index="3" text="أن اعبدوا الله واتقوه وأطيعون" />
-		<aya index="4" text="يغفر لكم من ذنوبكم ويؤخركم إلى أجل مسمى إن أجل الله إذا جاء لا يؤخر لو كنتم تعلمون" />
-		<aya index="5" text="قال رب إني دعوت قومي ليلا ونهارا" />
-		<aya index="6" text="فلم يزدهم دعائي إلا فرارا" />
-		<aya index="7" text="وإني كلما دعوتهم لتغفر لهم جعلوا أصابعهم في آذانهم واستغشوا ثيابهم وأصروا واستكبروا استكبارا" />
-		<aya index="8" text="ثم إني دعوتهم جهارا" />
-		<aya index="9" text="ثم إني أعلنت لهم وأسررت لهم إسرارا" />
-		<aya index="10" text="فقلت استغفروا ربكم إنه كان غفارا" />
-		<aya index="11" text="يرسل السماء عليكم مدرارا" />
-		<aya index="12" text="ويمددكم بأموال وبنين ويجعل لكم جنات ويجعل لكم أنهارا" />
-		<aya index="13" text="ما لكم لا ترجون لله وقارا" />
-		<aya index="14" text="وقد خلقكم أطوارا" />
-		<aya index="15" text="ألم تروا كيف خلق الله سبع سماوات طباقا" />
-		<aya index="16" text="وجعل القمر فيهن نورا وجعل الشمس سراجا" />
-		<aya index="17" text="والله أنبتكم من الأرض نباتا" />
-		<aya index="18" text="ثم يعيدكم فيها ويخرجكم إخراجا" />
-		<aya index="19" text="والله جعل لكم الأرض بساطا" />
-		<aya index="20" text="لتسلكوا منها سبلا فجاجا" />
-		<aya index="21" text="قال نوح رب إنهم عصوني واتبعوا من لم يزده ماله وولده إلا خسارا" />
-		<aya index="22" text="ومكروا مكرا كبارا" />
-		<aya index="23" text="وقالوا لا تذرن آلهتكم ولا تذرن ودا ولا سواعا ولا يغوث ويعوق ونسرا" />
-		<aya index="24" text="وقد أضلوا كثيرا ولا تزد الظالمين إلا ضلالا" />
-		<aya index="25" text="مما خطيئاتهم أغرقوا فأدخلوا نارا فلم يجدوا لهم من دون الله أنصارا" />
-		<aya index="26" text="وقال نوح رب لا تذر على الأرض من الكافرين ديارا" />
-		<aya index="27" text="إنك إن تذرهم يضلوا عبادك ولا يلدوا إلا فاجرا كفارا" />
-		<aya index="28" text="رب اغفر لي ولوالدي ولمن دخل بيتي مؤمنا وللمؤمنين والمؤمنات ولا تزد الظالمين إلا تبارا" />
-	</sura>
-	<sura index="72" name="الجن">
-		<aya index="1" text="قل أوحي إلي أنه استمع نفر من الجن فقالوا إنا سمعنا قرآنا عجبا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="يهدي إلى الرشد فآمنا به ولن نشرك بربنا أحدا" />
-		<aya index="3" text="وأنه تعالى جد ربنا ما اتخذ صاحبة ولا ولدا" />
-		<aya index="4" text="وأنه كان يقول سفيهنا على الله شططا" />
-		<aya index="5" text="وأنا ظننا أن لن تقول الإنس والجن على الله كذبا" />
-		<aya index="6" text="وأنه كان رجال من الإنس يعوذون برجال من الجن فزادوهم رهقا" />
-		<aya index="7" text="وأنهم ظنوا كما ظننتم أن لن يبعث الله أحدا" />
-		<aya index="8" text="وأنا لمسنا السماء فوجدناها ملئت حرسا شديدا وشهبا" />
-		<aya index="9" text="وأنا كنا نقعد منها مقاعد للسمع فمن يستمع الآن يجد له شهابا رصدا" />
-		<aya index="10" text="وأنا لا ندري أشر أريد بمن في الأرض أم أراد بهم ربهم رشدا" />
-		<aya index="11" text="وأنا منا الصالحون ومنا دون ذلك كنا طرائق قددا" />
-		<aya index="12" text="وأنا ظننا أن لن نعجز الله في الأرض ولن نعجزه هربا" />
-		<aya index="13" text="وأنا لما سمعنا الهدى آمنا به فمن يؤمن بربه فلا يخاف بخسا ولا رهقا" />
-		<aya index="14" text="وأنا منا المسلمون ومنا القاسطون فمن أسلم فأولئك تحروا رشدا" />
-		<aya index="15" text="وأما القاسطون فكانوا لجهنم حطبا" />
-		<aya index="16" text="وأن لو استقاموا على الطريقة لأسقيناهم ماء غدقا" />
-		<aya index="17" text="لنفتنهم فيه ومن يعرض عن ذكر ربه يسلكه عذابا صعدا" />
-		<aya index="18" text="وأن المساجد لله فلا تدعوا مع الله أحدا" />
-		<aya index="19" text="وأنه لما قام عبد الله يدعوه كادوا يكونون عليه لبدا" />
-		<aya index="20" text="قل إنما أدعو ربي ولا أشرك به أحدا" />
-		<aya index="21" text="قل إني لا أملك لكم ضرا ولا رشدا" />
-		<aya index="22" text="قل إني لن يجيرني من الله أحد ولن أجد من دونه ملتحدا" />
-		<aya index="23" text="إلا بلاغا من الله ورسالاته ومن يعص الله ورسوله فإن له نار جهنم خالدين فيها أبدا" />
-		<aya index="24" text="حتى إذا رأوا ما يوعدون فسيعلمون من أضعف ناصرا وأقل عددا" />
-		<aya index="25" text="قل إن أدري أقريب ما توعدون أم يجعل له ربي أمدا" />
-		<aya index="26" text="عالم الغيب فلا يظهر على غيبه أحدا" />
-		<aya index="27" text="إلا من ارتضى من رسول فإنه يسلك من بين يديه ومن خلفه رصدا" />
-		<aya index="28" text="ليعلم أن قد أبلغوا رسالات ربهم وأحاط بما لديهم وأحصى كل شيء عددا" />
-	</sura>
-	<sura index="73" name="المزمل">
-		<aya index="1" text="يا أيها المزمل" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="قم الليل إلا قليلا" />
-		<aya index="3" text="نصفه أو انقص منه قليلا" />
-		<aya index="4" text="أو زد عليه ورتل القرآن ترتيلا" />
-		<aya index="5" text="إنا سنلقي عليك قولا ثقيلا" />
-		<aya index="6" text="إن ناشئة الليل هي أشد وطئا وأقوم قيلا" />
-		<aya index="7" text="إن لك في النهار سبحا طويلا" />
-		<aya index="8" text="واذكر اسم ربك وتبتل إليه تبتيلا" />
-		<aya index="9" text="رب المشرق والمغرب لا إله إلا هو فاتخذه وكيلا" />
-		<aya index="10" text="واصبر على ما يقولون واهجرهم هجرا جميلا" />
-		<aya index="11" text="وذرني والمكذبين أولي النعمة ومهلهم قليلا" />
-		<aya index="12" text="إن لدينا أنكالا وجحيما" />
-		<aya index="13" text="وطعاما ذا غصة وعذابا أليما" />
-		<aya index="14" text="يوم ترجف الأرض والجبال وكانت الجبال كثيبا مهيلا" />
-		<aya index="15" text="إنا أرسلنا إليكم رسولا شاهدا عليكم كما أرسلنا إلى فرعون رسولا" />
-		<aya index="16" text="فعصى فرعون الرسول فأخذناه أخذا وبيلا" />
-		<aya index="17" text="فكيف تتقون إن كفرتم يوما يجعل الولدان شيبا" />
-		<aya index="18" text="السماء منفطر به كان وعده مفعولا" />
-		<aya index="19" text="إن هذه تذكرة فمن شاء اتخذ إلى ربه سبيلا" />
-		<aya index="20" text="إن ربك يعلم أنك تقوم أدنى من ثلثي الليل ونصفه وثلثه وطائفة من الذين معك والله يقدر الليل والنهار علم أن لن تحصوه فتاب عليكم فاقرءوا ما تيسر من القرآن علم أن سيكون منكم مرضى وآخرون يضربون في الأرض يبتغون من فضل الله وآخرون يقاتلون في سبيل الله فاقرءوا ما تيسر منه وأقيموا الصلاة وآتوا الزكاة وأقرضوا الله قرضا حسنا وما تقدموا لأنفسكم من خير تجدوه عند الله هو خيرا وأعظم أجرا واستغفروا الله إن الله غفور رحيم" />
-	</sura>
-	<sura index="74" name="المدثر">
-		<aya index="1" text="يا أيها المدثر" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="قم فأنذر" />
-		<aya index="3" text="وربك فكبر" />
-		<aya index="4" text="وثيابك فطهر" />
-		<aya index="5" text="والرجز فاهجر" />
-		<aya index="6" text="ولا تمنن تستكثر" />
-		<aya index="7" text="ولربك فاصبر" />
-		<aya index="8" text="فإذا نقر في الناقور" />
-		<aya index="9" text="فذلك يومئذ يوم عسير" />
-		<aya index="10" text="على الكافرين غير يسير" />
-		<aya index="11" text="ذرني ومن خلقت وحيدا" />
-		<aya index="12" text="وجعلت له مالا ممدودا" />
-		<aya index="13" text="وبنين شهودا" />
-		<aya index="14" text="ومهدت له تمهيدا" />
-		<aya index="15" text="ثم يطمع أن أزيد" />
-		<aya index="16" text="كلا إنه كان لآياتنا عنيدا" />
-		<aya index="17" text="سأرهقه صعودا" />
-		<aya index="18" text="إنه فكر وقدر" />
-		<aya index="19" text="فقتل كيف قدر" />
-		<aya index="20" text="ثم قتل كيف قدر" />
-		<aya index="21" text="ثم نظر" />
-		<aya index="22" text="ثم عبس وبسر" />
-		<aya index="23" text="ثم أدبر واستكبر" />
-		<aya index="24" text="فقال إن هذا إلا سحر يؤثر" />
-		<aya index="25" text="إن هذا إلا قول البشر" />
-		<aya index="26" text="سأصليه سقر" />
-		<aya index="27" text="وما أدراك ما سقر" />
-		<aya index="28" text="لا تبقي ولا تذر" />
-		<aya index="29" text="لواحة للبشر" />
-		<aya index="30" text="عليها تسعة عشر" />
-		<aya index="31" text="وما جعلنا أصحاب النار إلا ملائكة وما جعلنا عدتهم إلا فتنة للذين كفروا ليستيقن الذين أوتوا الكتاب ويزداد الذين آمنوا إيمانا ولا يرتاب الذين أوتوا الكتاب والمؤمنون وليقول الذين في قلوبهم مرض والكافرون ماذا أراد الله بهذا مثلا كذلك يضل الله من يشاء ويهدي من يشاء وما يعلم جنود ربك إلا هو وما هي إلا ذكرى للبشر" />
-		<aya index="32" text="كلا والقمر" />
-		<aya index="33" text="والليل إذ أدبر" />
-		<aya index="34" text="والصبح إذا أسفر" />
-		<aya index="35" text="إنها لإحدى الكبر" />
-		<aya index="36" text="نذيرا للبشر" />
-		<aya index="37" text="لمن شاء منكم أن يتقدم أو يتأخر" />
-		<aya index="38" text="كل نفس بما كسبت رهينة" />
-		<aya index="39" text="إلا أصحاب اليمين" />
-		<aya index="40" text="في جنات يتساءلون" />
-		<aya index="41" text="عن المجرمين" />
-		<aya index="42" text="ما سلككم في سقر" />
-		<aya index="43" text="قالوا لم نك من المصلين" />
-		<aya index="44" text="ولم نك نطعم المسكين" />
-		<aya index="45" text="وكنا نخوض مع الخائضين" />
-		<aya index="46" text="وكنا نكذب بيوم الدين" />
-		<aya index="47" text="حتى أتانا اليقين" />
-		<aya index="48" text="فما تنفعهم شفاعة الشافعين" />
-		<aya index="49" text="فما لهم عن التذكرة معرضين" />
-		<aya index="50" text="كأنهم حمر مستنفرة" />
-		<aya index="51" text="فرت من قسورة" />
-		<aya index="52" text="بل يريد كل امرئ منهم أن يؤتى صحفا منشرة" />
-		<aya index="53" text="كلا بل لا يخافون الآخرة" />
-		<aya index="54" text="كلا إنه تذكرة" />
-		<aya index="55" text="فمن شاء ذكره" />
-		<aya index="56" text="وما يذكرون إلا أن يشاء الله هو أهل التقوى وأهل المغفرة" />
-	</sura>
-	<sura index="75" name="القيامة">
-		<aya index="1" text="لا أقسم بيوم القيامة" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ولا أقسم بالنفس اللوامة" />
-		<aya index="3" text="أيحسب الإنسان ألن نجمع عظامه" />
-		<aya index="4" text="بلى قادرين على أن نسوي بنانه" />
-		<aya index="5" text="بل يريد الإنسان ليفجر أمامه" />
-		<aya index="6" text="يسأل أيان يوم القيامة" />
-		<aya index="7" text="فإذا برق البصر" />
-		<aya index="8" text="وخسف القمر" />
-		<aya index="9" text="وجمع الشمس والقمر" />
-		<aya index="10" text="يقول الإنسان يومئذ أين المفر" />
-		<aya index="11" text="كلا لا وزر" />
-		<aya index="12" text="إلى ربك يومئذ المستقر" />
-		<aya index="13" text="ينبأ الإنسان يومئذ بما قدم وأخر" />
-		<aya index="14" text="بل الإنسان على نفسه بصيرة" />
-		<aya index="15" text="ولو ألقى معاذيره" />
-		<aya index="16" text="لا تحرك به لسانك لتعجل به" />
-		<aya index="17" text="إن علينا جمعه وقرآنه" />
-		<aya index="18" text="فإذا قرأناه فاتبع قرآنه" />
-		<aya index="19" text="ثم إن علينا بيانه" />
-		<aya index="20" text="كلا بل تحبون العاجلة" />
-		<aya index="21" text="وتذرون الآخرة" />
-		<aya index="22" text="وجوه يومئذ ناضرة" />
-		<aya index="23" text="إلى ربها ناظرة" />
-		<aya index="24" text="ووجوه يومئذ باسرة" />
-		<aya index="25" text="تظن أن يفعل بها فاقرة" />
-		<aya index="26" text="كلا إذا بلغت التراقي" />
-		<aya index="27" text="وقيل من راق" />
-		<aya index="28" text="وظن أنه الفراق" />
-		<aya index="29" text="والتفت الساق بالساق" />
-		<aya index="30" text="إلى ربك يومئذ المساق" />
-		<aya index="31" text="فلا صدق ولا صلى" />
-		<aya index="32" text="ولكن كذب وتولى" />
-		<aya index="33" text="ثم ذهب إلى أهله يتمطى" />
-		<aya index="34" text="أولى لك فأولى" />
-		<aya index="35" text="ثم أولى لك فأولى" />
-		<aya index="36" text="أيحسب الإنسان أن يترك سدى" />
-		<aya index="37" text="ألم يك نطفة من مني يمنى" />
-		<aya index="38" text="ثم كان علقة فخلق فسوى" />
-		<aya index="39" text="فجعل منه الزوجين الذكر والأنثى" />
-		<aya index="40" text="أليس ذلك بقادر على أن يحيي الموتى" />
-	</sura>
-	<sura index="76" name="الانسان">
-		<aya index="1" text="هل أتى على الإنسان حين من الدهر لم يكن شيئا مذكورا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="إنا خلقنا الإنسان من نطفة أمشاج نبتليه فجعلناه سميعا بصيرا" />
-		<aya index="3" text="إنا هديناه السبيل إما شاكرا وإما كفورا" />
-		<aya index="4" text="إنا أعتدنا للكافرين سلاسل وأغلالا وسعيرا" />
-		<aya index="5" text="إن الأبرار يشربون من كأس كان مزاجها كافورا" />
-		<aya index="6" text="عينا يشرب بها عباد الله يفجرونها تفجيرا" />
-		<aya index="7" text="يوفون بالنذر ويخافون يوما كان شره مستطيرا" />
-		<aya index="8" text="ويطعمون الطعام على حبه مسكينا ويتيما وأسيرا" />
-		<aya index="9" text="إنما نطعمكم لوجه الله لا نريد منكم جزاء ولا شكورا" />
-		<aya index="10" text="إنا نخاف من ربنا يوما عبوسا قمطريرا" />
-		<aya index="11" text="فوقاهم الله شر ذلك اليوم ولقاهم نضرة وسرورا" />
-		<aya index="12" text="وجزاهم بما صبروا جنة وحريرا" />
-		<aya index="13" text="متكئين فيها على الأرائك لا يرون فيها شمسا ولا زمهريرا" />
-		<aya index="14" text="ودانية عليهم ظلالها وذللت قطوفها تذليلا" />
-		<aya index="15" text="ويطاف عليهم بآنية من فضة وأكواب كانت قواريرا" />
-		<aya index="16" text="قوارير من فضة قدروها تقديرا" />
-		<aya index="17" text="ويسقون فيها كأسا كان مزاجها زنجبيلا" />
-		<aya index="18" text="عينا فيها تسمى سلسبيلا" />
-		<aya index="19" text="ويطوف عليهم ولدان مخلدون إذا رأيتهم حسبتهم لؤلؤا منثورا" />
-		<aya index="20" text="وإذا رأيت ثم رأيت نعيما وملكا كبيرا" />
-		<aya index="21" text="عاليهم ثياب سندس خضر وإستبرق وحلوا أساور من فضة وسقاهم ربهم شرابا طهورا" />
-		<aya index="22" text="إن هذا كان لكم جزاء وكان سعيكم مشكورا" />
-		<aya index="23" text="إنا نحن نزلنا عليك القرآن تنزيلا" />
-		<aya index="24" text="فاصبر لحكم ربك ولا تطع منهم آثما أو كفورا" />
-		<aya index="25" text="واذكر اسم ربك بكرة وأصيلا" />
-		<aya index="26" text="ومن الليل فاسجد له وسبحه ليلا طويلا" />
-		<aya index="27" text="إن هؤلاء يحبون العاجلة ويذرون وراءهم يوما ثقيلا" />
-		<aya index="28" text="نحن خلقناهم وشددنا أسرهم وإذا شئنا بدلنا أمثالهم تبديلا" />
-		<aya index="29" text="إن هذه تذكرة فمن شاء اتخذ إلى ربه سبيلا" />
-		<aya index="30" text="وما تشاءون إلا أن يشاء الله إن الله كان عليما حكيما" />
-		<aya index="31" text="يدخل من يشاء في رحمته والظالمين أعد لهم عذابا أليما" />
-	</sura>
-	<sura index="77" name="المرسلات">
-		<aya index="1" text="والمرسلات عرفا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="فالعاصفات عصفا" />
-		<aya index="3" text="والناشرات نشرا" />
-		<aya index="4" text="فالفارقات فرقا" />
-		<aya index="5" text="فالملقيات ذكرا" />
-		<aya index="6" text="عذرا أو نذرا" />
-		<aya index="7" text="إنما توعدون لواقع" />
-		<aya index="8" text="فإذا النجوم طمست" />
-		<aya index="9" text="وإذا السماء فرجت" />
-		<aya index="10" text="وإذا الجبال نسفت" />
-		<aya index="11" text="وإذا الرسل أقتت" />
-		<aya index="12" text="لأي يوم أجلت" />
-		<aya index="13" text="ليوم الفصل" />
-		<aya index="14" text="وما أدراك ما يوم الفصل" />
-		<aya index="15" text="ويل يومئذ للمكذبين" />
-		<aya index="16" text="ألم نهلك الأولين" />
-		<aya index="17" text="ثم نتبعهم الآخرين" />
-		<aya index="18" text="كذلك نفعل بالمجرمين" />
-		<aya index="19" text="ويل يومئذ للمكذبين" />
-		<aya index="20" text="ألم نخلقكم من ماء مهين" />
-		<aya index="21" text="فجعلناه في قرار مكين" />
-		<aya index="22" text="إلى قدر معلوم" />
-		<aya index="23" text="فقدرنا فنعم القادرون" />
-		<aya index="24" text="ويل يومئذ للمكذبين" />
-		<aya index="25" text="ألم نجعل الأرض كفاتا" />
-		<aya index="26" text="أحياء وأمواتا" />
-		<aya index="27" text="وجعلنا فيها رواسي شامخات وأسقيناكم ماء فراتا" />
-		<aya index="28" text="ويل يومئذ للمكذبين" />
-		<aya index="29" text="انطلقوا إلى ما كنتم به تكذبون" />
-		<aya index="30" text="انطلقوا إلى ظل ذي ثلاث شعب" />
-		<aya index="31" text="لا ظليل ولا يغني من اللهب" />
-		<aya index="32" text="إنها ترمي بشرر كالقصر" />
-		<aya index="33" text="كأنه جمالت صفر" />
-		<aya index="34" text="ويل يومئذ للمكذبين" />
-		<aya index="35" text="هذا يوم لا ينطقون" />
-		<aya index="36" text="ولا يؤذن لهم فيعتذرون" />
-		<aya index="37" text="ويل يومئذ للمكذبين" />
-		<aya index="38" text="هذا يوم الفصل جمعناكم والأولين" />
-		<aya index="39" text="فإن كان لكم كيد فكيدون" />
-		<aya index="40" text="ويل يومئذ للمكذبين" />
-		<aya index="41" text="إن المتقين في ظلال وعيون" />
-		<aya index="42" text="وفواكه مما يشتهون" />
-		<aya index="43" text="كلوا واشربوا هنيئا بما كنتم تعملون" />
-		<aya index="44" text="إنا كذلك نجزي المحسنين" />
-		<aya index="45" text="ويل يومئذ للمكذبين" />
-		<aya index="46" text="كلوا وتمتعوا قليلا إنكم مجرمون" />
-		<aya index="47" text="ويل يومئذ للمكذبين" />
-		<aya index="48" text="وإذا قيل لهم اركعوا لا يركعون" />
-		<aya index="49" text="ويل يومئذ للمكذبين" />
-		<aya index="50" text="فبأي حديث بعده يؤمنون" />
-	</sura>
-	<sura index="78" name="النبإ">
-		<aya index="1" text="عم يتساءلون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="عن النبإ العظيم" />
-		<aya index="3" text="الذي هم فيه مختلفون" />
-		<aya index="4" text="كلا سيعلمون" />
-		<aya index="5" text="ثم كلا سيعلمون" />
-		<aya index="6" text="ألم نجعل الأرض مهادا" />
-		<aya index="7" text="والجبال أوتادا" />
-		<aya index="8" text="وخلقناكم أزواجا" />
-		<aya index="9" text="وجعلنا نومكم سباتا" />
-		<aya index="10" text="وجعلنا الليل لباسا" />
-		<aya index="11" text="وجعلنا النهار معاشا" />
-		<aya index="12" text="وبنينا فوقكم سبعا شدادا" />
-		<aya index="13" text="وجعلنا سراجا وهاجا" />
-		<aya index="14" text="وأنزلنا من المعصرات ماء ثجاجا" />
-		<aya index="15" text="لنخرج به حبا ونباتا" />
-		<aya index="16" text="وجنات ألفافا" />
-		<aya index="17" text="إن يوم الفصل كان ميقاتا" />
-		<aya index="18" text="يوم ينفخ في الصور فتأتون أفواجا" />
-		<aya index="19" text="وفتحت السماء فكانت أبوابا" />
-		<aya index="20" text="وسيرت الجبال فكانت سرابا" />
-		<aya index="21" text="إن جهنم كانت مرصادا" />
-		<aya index="22" text="للطاغين مآبا" />
-		<aya index="23" text="لابثين فيها أحقابا" />
-		<aya index="24" text="لا يذوقون فيها بردا ولا شرابا" />
-		<aya index="25" text="إلا حميما وغساقا" />
-		<aya index="26" text="جزاء وفاقا" />
-		<aya index="27" text="إنهم كانوا لا يرجون حسابا" />
-		<aya index="28" text="وكذبوا بآياتنا كذابا" />
-		<aya index="29" text="وكل شيء أحصيناه كتابا" />
-		<aya index="30" text="فذوقوا فلن نزيدكم إلا عذابا" />
-		<aya index="31" text="إن للمتقين مفازا" />
-		<aya index="32" text="حدائق وأعنابا" />
-		<aya index="33" text="وكواعب أترابا" />
-		<aya index="34" text="وكأسا دهاقا" />
-		<aya index="35" text="لا يسمعون فيها لغوا ولا كذابا" />
-		<aya index="36" text="جزاء من ربك عطاء حسابا" />
-		<aya index="37" text="رب السماوات والأرض وما بينهما الرحمن لا يملكون منه خطابا" />
-		<aya index="38" text="يوم يقوم الروح والملائكة صفا لا يتكلمون إلا من أذن له الرحمن وقال صوابا" />
-		<aya index="39" text="ذلك اليوم الحق فمن شاء اتخذ إلى ربه مآبا" />
-		<aya index="40" text="إنا أنذرناكم عذابا قريبا يوم ينظر المرء ما قدمت يداه ويقول الكافر يا ليتني كنت ترابا" />
-	</sura>
-	<sura index="79" name="النازعات">
-		<aya index="1" text="والنازعات غرقا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="والناشطات نشطا" />
-		<aya index="3" text="والسابحات سبحا" />
-		<aya index="4" text="فالسابقات سبقا" />
-		<aya index="5" text="فالمدبرات أمرا" />
-		<aya index="6" text="يوم ترجف الراجفة" />
-		<aya index="7" text="تتبعها الرادفة" />
-		<aya index="8" text="قلوب يومئذ واجفة" />
-		<aya index="9" text="أبصارها خاشعة" />
-		<aya index="10" text="يقولون أإنا لمردودون في الحافرة" />
-		<aya index="11" text="أإذا كنا عظاما نخرة" />
-		<aya index="12" text="قالوا تلك إذا كرة خاسرة" />
-		<aya index="13" text="فإنما هي زجرة واحدة" />
-		<aya index="14" text="فإذا هم بالساهرة" />
-		<aya index="15" text="هل أتاك حديث موسى" />
-		<aya index="16" text="إذ ناداه ربه بالواد المقدس طوى" />
-		<aya index="17" text="اذهب إلى فرعون إنه طغى" />
-		<aya index="18" text="فقل هل لك إلى أن تزكى" />
-		<aya index="19" text="وأهديك إلى ربك فتخشى" />
-		<aya index="20" text="فأراه الآية الكبرى" />
-		<aya index="21" text="فكذب وعصى" />
-		<aya index="22" text="ثم أدبر يسعى" />
-		<aya index="23" text="فحشر فنادى" />
-		<aya index="24" text="فقال أنا ربكم الأعلى" />
-		<aya index="25" text="فأخذه الله نكال الآخرة والأولى" />
-		<aya index="26" text="إن في ذلك لعبرة لمن يخشى" />
-		<aya index="27" text="أأنتم أشد خلقا أم السماء بناها" />
-		<aya index="28" text="رفع سمكها فسواها" />
-		<aya index="29" text="وأغطش ليلها وأخرج ضحاها" />
-		<aya index="30" text="والأرض بعد ذلك دحاها" />
-		<aya index="31" text="أخرج منها ماءها ومرعاها" />
-		<aya index="32" text="والجبال أرساها" />
-		<aya index="33" text="متاعا لكم ولأنعامكم" />
-		<aya index="34" text="فإذا جاءت الطامة الكبرى" />
-		<aya index="35" text="يوم يتذكر الإنسان ما سعى" />
-		<aya index="36" text="وبرزت الجحيم لمن يرى" />
-		<aya index="37" text="فأما من طغى" />
-		<aya index="38" text="وآثر الحياة الدنيا" />
-		<aya index="39" text="فإن الجحيم هي المأوى" />
-		<aya index="40" text="وأما من خاف مقام ربه ونهى النفس عن الهوى" />
-		<aya index="41" text="فإن الجنة هي المأوى" />
-		<aya index="42" text="يسألونك عن الساعة أيان مرساها" />
-		<aya index="43" text="فيم أنت من ذكراها" />
-		<aya index="44" text="إلى ربك منتهاها" />
-		<aya index="45" text="إنما أنت منذر من يخشاها" />
-		<aya index="46" text="كأنهم يوم يرونها لم يلبثوا إلا عشية أو ضحاها" />
-	</sura>
-	<sura index="80" name="عبس">
-		<aya index="1" text="عبس وتولى" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="أن جاءه الأعمى" />
-		<aya index="3" text="وما يدريك لعله يزكى" />
-		<aya index="4" text="أو يذكر فتنفعه الذكرى" />
-		<aya index="5" text="أما من استغنى" />
-		<aya index="6" text="فأنت له تصدى" />
-		<aya index="7" text="وما عليك ألا يزكى" />
-		<aya index="8" text="وأما من جاءك يسعى" />
-		<aya index="9" text="وهو يخشى" />
-		<aya index="10" text="فأنت عنه تلهى" />
-		<aya index="11" text="كلا إنها تذكرة" />
-		<aya index="12" text="فمن شاء ذكره" />
-		<aya index="13" text="في صحف مكرمة" />
-		<aya index="14" text="مرفوعة مطهرة" />
-		<aya index="15" text="بأيدي سفرة" />
-		<aya index="16" text="كرام بررة" />
-		<aya index="17" text="قتل الإنسان ما أكفره" />
-		<aya index="18" text="من أي شيء خلقه" />
-		<aya index="19" text="من نطفة خلقه فقدره" />
-		<aya index="20" text="ثم السبيل يسره" />
-		<aya index="21" text="ثم أماته فأقبره" />
-		<aya index="22" text="ثم إذا شاء أنشره" />
-		<aya index="23" text="كلا لما يقض ما أمره" />
-		<aya index="24" text="فلينظر الإنسان إلى طعامه" />
-		<aya index="25" text="أنا صببنا الماء صبا" />
-		<aya index="26" text="ثم شققنا الأرض شقا" />
-		<aya index="27" text="فأنبتنا فيها حبا" />
-		<aya index="28" text="وعنبا وقضبا" />
-		<aya index="29" text="وزيتونا ونخلا" />
-		<aya index="30" text="وحدائق غلبا" />
-		<aya index="31" text="وفاكهة وأبا" />
-		<aya index="32" text="متاعا لكم ولأنعامكم" />
-		<aya index="33" text="فإذا جاءت الصاخة" />
-		<aya index="34" text="يوم يفر المرء من أخيه" />
-		<aya index="35" text="وأمه وأبيه" />
-		<aya index="36" text="وصاحبته وبنيه" />
-		<aya index="37" text="لكل امرئ منهم يومئذ شأن يغنيه" />
-		<aya index="38" text="وجوه يومئذ مسفرة" />
-		<aya index="39" text="ضاحكة مستبشرة" />
-		<aya index="40" text="ووجوه يومئذ عليها غبرة" />
-		<aya index="41" text="ترهقها قترة" />
-		<aya index="42" text="أولئك هم الكفرة الفجرة" />
-	</sura>
-	<sura index="81" name="التكوير">
-		<aya index="1" text="إذا الشمس كورت" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وإذا النجوم انكدرت" />
-		<aya index="3" text="وإذا الجبال سيرت" />
-		<aya index="4" text="وإذا العشار عطلت" />
-		<aya index="5" text="وإذا الوحوش حشرت" />
-		<aya index="6" text="وإذا البحار سجرت" />
-		<aya index="7" text="وإذا النفوس زوجت" />
-		<aya index="8" text="وإذا الموءودة سئلت" />
-		<aya index="9" text="بأي ذنب قتلت" />
-		<aya index="10" text="وإذا الصحف نشرت" />
-		<aya index="11" text="وإذا السماء كشطت" />
-		<aya index="12" text="وإذا الجحيم سعرت" />
-		<aya index="13" text="وإذا الجنة أزلفت" />
-		<aya index="14" text="علمت نفس ما أحضرت" />
-		<aya index="15" text="فلا أقسم بالخنس" />
-		<aya index="16" text="الجوار الكنس" />
-		<aya index="17" text="والليل إذا عسعس" />
-		<aya index="18" text="والصبح إذا تنفس" />
-		<aya index="19" text="إنه لقول رسول كريم" />
-		<aya index="20" text="ذي قوة عند ذي العرش مكين" />
-		<aya index="21" text="مطاع ثم أمين" />
-		<aya index="22" text="وما صاحبكم بمجنون" />
-		<aya index="23" text="ولقد رآه بالأفق المبين" />
-		<aya index="24" text="وما هو على الغيب بضنين" />
-		<aya index="25" text="وما هو بقول شيطان رجيم" />
-		<aya index="26" text="فأين تذهبون" />
-		<aya index="27" text="إن هو إلا ذكر للعالمين" />
-		<aya index="28" text="لمن شاء منكم أن يستقيم" />
-		<aya index="29" text="وما تشاءون إلا أن يشاء الله رب العالمين" />
-	</sura>
-	<sura index="82" name="الإنفطار">
-		<aya index="1" text="إذا السماء انفطرت" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وإذا الكواكب انتثرت" />
-		<aya index="3" text="وإذا البحار فجرت" />
-		<aya index="4" text="وإذا القبور بعثرت" />
-		<aya index="5" text="علمت نفس ما قدمت وأخرت" />
-		<aya index="6" text="يا أيها الإنسان ما غرك بربك الكريم" />
-		<aya index="7" text="الذي خلقك فسواك فعدلك" />
-		<aya index="8" text="في أي صورة ما شاء ركبك" />
-		<aya index="9" text="كلا بل تكذبون بالدين" />
-		<aya index="10" text="وإن عليكم لحافظين" />
-		<aya index="11" text="كراما كاتبين" />
-		<aya index="12" text="يعلمون ما تفعلون" />
-		<aya index="13" text="إن الأبرار لفي نعيم" />
-		<aya index="14" text="وإن الفجار لفي جحيم" />
-		<aya index="15" text="يصلونها يوم الدين" />
-		<aya index="16" text="وما هم عنها بغائبين" />
-		<aya index="17" text="وما أدراك ما يوم الدين" />
-		<aya index="18" text="ثم ما أدراك ما يوم الدين" />
-		<aya index="19" text="يوم لا تملك نفس لنفس شيئا والأمر يومئذ لله" />
-	</sura>
-	<sura index="83" name="المطففين">
-		<aya index="1" text="ويل للمطففين" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الذين إذا اكتالوا على الناس يستوفون" />
-		<aya index="3" text="وإذا كالوهم أو وزنوهم يخسرون" />
-		<aya index="4" text="ألا يظن أولئك أنهم مبعوثون" />
-		<aya index="5" text="ليوم عظيم" />
-		<aya index="6" text="يوم يقوم الناس لرب العالمين" />
-		<aya index="7" text="كلا إن كتاب الفجار لفي سجين" />
-		<aya index="8" text="وما أدراك ما سجين" />
-		<aya index="9" text="كتاب مرقوم" />
-		<aya index="10" text="ويل يومئذ للمكذبين" />
-		<aya index="11" text="الذين يكذبون بيوم الدين" />
-		<aya index="12" text="وما يكذب به إلا كل معتد أثيم" />
-		<aya index="13" text="إذا تتلى عليه آياتنا قال أساطير الأولين" />
-		<aya index="14" text="كلا بل ران على قلوبهم ما كانوا يكسبون" />
-		<aya index="15" text="كلا إنهم عن ربهم يومئذ لمحجوبون" />
-		<aya index="16" text="ثم إنهم لصالو الجحيم" />
-		<aya index="17" text="ثم يقال هذا الذي كنتم به تكذبون" />
-		<aya index="18" text="كلا إن كتاب الأبرار لفي عليين" />
-		<aya index="19" text="وما أدراك ما عليون" />
-		<aya index="20" text="كتاب مرقوم" />
-		<aya index="21" text="يشهده المقربون" />
-		<aya index="22" text="إن الأبرار لفي نعيم" />
-		<aya index="23" text="على الأرائك ينظرون" />
-		<aya index="24" text="تعرف في وجوههم نضرة النعيم" />
-		<aya index="25" text="يسقون من رحيق مختوم" />
-		<aya index="26" text="ختامه مسك وفي ذلك فليتنافس المتنافسون" />
-		<aya index="27" text="ومزاجه من تسنيم" />
-		<aya index="28" text="عينا يشرب بها المقربون" />
-		<aya index="29" text="إن الذين أجرموا كانوا من الذين آمنوا يضحكون" />
-		<aya index="30" text="وإذا مروا بهم يتغامزون" />
-		<aya index="31" text="وإذا انقلبوا إلى أهلهم انقلبوا فكهين" />
-		<aya index="32" text="وإذا رأوهم قالوا إن هؤلاء لضالون" />
-		<aya index="33" text="وما أرسلوا عليهم حافظين" />
-		<aya index="34" text="فاليوم الذين آمنوا من الكفار يضحكون" />
-		<aya index="35" text="على الأرائك ينظرون" />
-		<aya index="36" text="هل ثوب الكفار ما كانوا يفعلون" />
-	</sura>
-	<sura index="84" name="الإنشقاق">
-		<aya index="1" text="إذا السماء انشقت" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وأذنت لربها وحقت" />
-		<aya index="3" text="وإذا الأرض مدت" />
-		<aya index="4" text="وألقت ما فيها وتخلت" />
-		<aya index="5" text="وأذنت لربها وحقت" />
-		<aya index="6" text="يا أيها الإنسان إنك كادح إلى ربك كدحا فملاقيه" />
-		<aya index="7" text="فأما من أوتي كتابه بيمينه" />
-		<aya index="8" text="فسوف يحاسب حسابا يسيرا" />
-		<aya index="9" text="وينقلب إلى أهله مسرورا" />
-		<aya index="10" text="وأما من أوتي كتابه وراء ظهره" />
-		<aya index="11" text="فسوف يدعو ثبورا" />
-		<aya index="12" text="ويصلى سعيرا" />
-		<aya index="13" text="إنه كان في أهله مسرورا" />
-		<aya index="14" text="إنه ظن أن لن يحور" />
-		<aya index="15" text="بلى إن ربه كان به بصيرا" />
-		<aya index="16" text="فلا أقسم بالشفق" />
-		<aya index="17" text="والليل وما وسق" />
-		<aya index="18" text="والقمر إذا اتسق" />
-		<aya index="19" text="لتركبن طبقا عن طبق" />
-		<aya index="20" text="فما لهم لا يؤمنون" />
-		<aya index="21" text="وإذا قرئ عليهم القرآن لا يسجدون" />
-		<aya index="22" text="بل الذين كفروا يكذبون" />
-		<aya index="23" text="والله أعلم بما يوعون" />
-		<aya index="24" text="فبشرهم بعذاب أليم" />
-		<aya index="25" text="إلا الذين آمنوا وعملوا الصالحات لهم أجر غير ممنون" />
-	</sura>
-	<sura index="85" name="البروج">
-		<aya index="1" text="والسماء ذات البروج" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="واليوم الموعود" />
-		<aya index="3" text="وشاهد ومشهود" />
-		<aya index="4" text="قتل أصحاب الأخدود" />
-		<aya index="5" text="النار ذات الوقود" />
-		<aya index="6" text="إذ هم عليها قعود" />
-		<aya index="7" text="وهم على ما يفعلون بالمؤمنين شهود" />
-		<aya index="8" text="وما نقموا منهم إلا أن يؤمنوا بالله العزيز الحميد" />
-		<aya index="9" text="الذي له ملك السماوات والأرض والله على كل شيء شهيد" />
-		<aya index="10" text="إن الذين فتنوا المؤمنين والمؤمنات ثم لم يتوبوا فلهم عذاب جهنم ولهم عذاب الحريق" />
-		<aya index="11" text="إن الذين آمنوا وعملوا الصالحات لهم جنات تجري من تحتها الأنهار ذلك الفوز الكبير" />
-		<aya index="12" text="إن بطش ربك لشديد" />
-		<aya index="13" text="إنه هو يبدئ ويعيد" />
-		<aya index="14" text="وهو الغفور الودود" />
-		<aya index="15" text="ذو العرش المجيد" />
-		<aya index="16" text="فعال لما يريد" />
-		<aya index="17" text="هل أتاك حديث الجنود" />
-		<aya index="18" text="فرعون وثمود" />
-		<aya index="19" text="بل الذين كفروا في تكذيب" />
-		<aya index="20" text="والله من ورائهم محيط" />
-		<aya index="21" text="بل هو قرآن مجيد" />
-		<aya index="22" text="في لوح محفوظ" />
-	</sura>
-	<sura index="86" name="الطارق">
-		<aya index="1" text="والسماء والطارق" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وما أدراك ما الطارق" />
-		<aya index="3" text="النجم الثاقب" />
-		<aya index="4" text="إن كل نفس لما عليها حافظ" />
-		<aya index="5" text="فلينظر الإنسان مم خلق" />
-		<aya index="6" text="خلق من ماء دافق" />
-		<aya index="7" text="يخرج من بين الصلب والترائب" />
-		<aya index="8" text="إنه على رجعه لقادر" />
-		<aya index="9" text="يوم تبلى السرائر" />
-		<aya index="10" text="فما له من قوة ولا ناصر" />
-		<aya index="11" text="والسماء ذات الرجع" />
-		<aya index="12" text="والأرض ذات الصدع" />
-		<aya index="13" text="إنه لقول فصل" />
-		<aya index="14" text="وما هو بالهزل" />
-		<aya index="15" text="إنهم يكيدون كيدا" />
-		<aya index="16" text="وأكيد كيدا" />
-		<aya index="17" text="فمهل الكافرين أمهلهم رويدا" />
-	</sura>
-	<sura index="87" name="الأعلى">
-		<aya index="1" text="سبح اسم ربك الأعلى" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الذي خلق فسوى" />
-		<aya index="3" text="والذي قدر فهدى" />
-		<aya index="4" text="والذي أخرج المرعى" />
-		<aya index="5" text="فجعله غثاء أحوى" />
-		<aya index="6" text="سنقرئك فلا تنسى" />
-		<aya index="7" text="إلا ما شاء الله إنه يعلم الجهر وما يخفى" />
-		<aya index="8" text="ونيسرك لليسرى" />
-		<aya index="9" text="فذكر إن نفعت الذكرى" />
-		<aya index="10" text="سيذكر من يخشى" />
-		<aya index="11" text="ويتجنبها الأشقى" />
-		<aya index="12" text="الذي يصلى النار الكبرى" />
-		<aya index="13" text="ثم لا يموت فيها ولا يحيى" />
-		<aya index="14" text="قد أفلح من تزكى" />
-		<aya index="15" text="وذكر اسم ربه فصلى" />
-		<aya index="16" text="بل تؤثرون الحياة الدنيا" />
-		<aya index="17" text="والآخرة خير وأبقى" />
-		<aya index="18" text="إن هذا لفي الصحف الأولى" />
-		<aya index="19" text="صحف إبراهيم وموسى" />
-	</sura>
-	<sura index="88" name="الغاشية">
-		<aya index="1" text="هل أتاك حديث الغاشية" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وجوه يومئذ خاشعة" />
-		<aya index="3" text="عاملة ناصبة" />
-		<aya index="4" text="تصلى نارا حامية" />
-		<aya index="5" text="تسقى من عين آنية" />
-		<aya index="6" text="ليس لهم طعام إلا من ضريع" />
-		<aya index="7" text="لا يسمن ولا يغني من جوع" />
-		<aya index="8" text="وجوه يومئذ ناعمة" />
-		<aya index="9" text="لسعيها راضية" />
-		<aya index="10" text="في جنة عالية" />
-		<aya index="11" text="لا تسمع فيها لاغية" />
-		<aya index="12" text="فيها عين جارية" />
-		<aya index="13" text="فيها سرر مرفوعة" />
-		<aya index="14" text="وأكواب موضوعة" />
-		<aya index="15" text="ونمارق مصفوفة" />
-		<aya index="16" text="وزرابي مبثوثة" />
-		<aya index="17" text="أفلا ينظرون إلى الإبل كيف خلقت" />
-		<aya index="18" text="وإلى السماء كيف رفعت" />
-		<aya index="19" text="وإلى الجبال كيف نصبت" />
-		<aya index="20" text="وإلى الأرض كيف سطحت" />
-		<aya index="21" text="فذكر إنما أنت مذكر" />
-		<aya index="22" text="لست عليهم بمصيطر" />
-		<aya index="23" text="إلا من تولى وكفر" />
-		<aya index="24" text="فيعذبه الله العذاب الأكبر" />
-		<aya index="25" text="إن إلينا إيابهم" />
-		<aya index="26" text="ثم إن علينا حسابهم" />
-	</sura>
-	<sura index="89" name="الفجر">
-		<aya index="1" text="والفجر" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وليال عشر" />
-		<aya index="3" text="والشفع والوتر" />
-		<aya index="4" text="والليل إذا يسر" />
-		<aya index="5" text="هل في ذلك قسم لذي حجر" />
-		<aya index="6" text="ألم تر كيف فعل ربك بعاد" />
-		<aya index="7" text="إرم ذات العماد" />
-		<aya index="8" text="التي لم يخلق مثلها في البلاد" />
-		<aya index="9" text="وثمود الذين جابوا الصخر بالواد" />
-		<aya index="10" text="وفرعون ذي الأوتاد" />
-		<aya index="11" text="الذين طغوا في البلاد" />
-		<aya index="12" text="فأكثروا فيها الفساد" />
-		<aya index="13" text="فصب عليهم ربك سوط عذاب" />
-		<aya index="14" text="إن ربك لبالمرصاد" />
-		<aya index="15" text="فأما الإنسان إذا ما ابتلاه ربه فأكرمه ونعمه فيقول ربي أكرمن" />
-		<aya index="16" text="وأما إذا ما ابتلاه فقدر عليه رزقه فيقول ربي أهانن" />
-		<aya index="17" text="كلا بل لا تكرمون اليتيم" />
-		<aya index="18" text="ولا تحاضون على طعام المسكين" />
-		<aya index="19" text="وتأكلون التراث أكلا لما" />
-		<aya index="20" text="وتحبون المال حبا جما" />
-		<aya index="21" text="كلا إذا دكت الأرض دكا دكا" />
-		<aya index="22" text="وجاء ربك والملك صفا صفا" />
-		<aya index="23" text="وجيء يومئذ بجهنم يومئذ يتذكر الإنسان وأنى له الذكرى" />
-		<aya index="24" text="يقول يا ليتني قدمت لحياتي" />
-		<aya index="25" text="فيومئذ لا يعذب عذابه أحد" />
-		<aya index="26" text="ولا يوثق وثاقه أحد" />
-		<aya index="27" text="يا أيتها النفس المطمئنة" />
-		<aya index="28" text="ارجعي إلى ربك راضية مرضية" />
-		<aya index="29" text="فادخلي في عبادي" />
-		<aya index="30" text="وادخلي جنتي" />
-	</sura>
-	<sura index="90" name="البلد">
-		<aya index="1" text="لا أقسم بهذا البلد" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وأنت حل بهذا البلد" />
-		<aya index="3" text="ووالد وما ولد" />
-		<aya index="4" text="لقد خلقنا الإنسان في كبد" />
-		<aya index="5" text="أيحسب أن لن يقدر عليه أحد" />
-		<aya index="6" text="يقول أهلكت مالا لبدا" />
-		<aya index="7" text="أيحسب أن لم يره أحد" />
-		<aya index="8" text="ألم نجعل له عينين" />
-		<aya index="9" text="ولسانا وشفتين" />
-		<aya index="10" text="وهديناه النجدين" />
-		<aya index="11" text="فلا اقتحم العقبة" />
-		<aya index="12" text="وما أدراك ما العقبة" />
-		<aya index="13" text="فك رقبة" />
-		<aya index="14" text="أو إطعام في يوم ذي مسغبة" />
-		<aya index="15" text="يتيما ذا مقربة" />
-		<aya index="16" text="أو مسكينا ذا متربة" />
-		<aya index="17" text="ثم كان من الذين آمنوا وتواصوا بالصبر وتواصوا بالمرحمة" />
-		<aya index="18" text="أولئك أصحاب الميمنة" />
-		<aya index="19" text="والذين كفروا بآياتنا هم أصحاب المشأمة" />
-		<aya index="20" text="عليهم نار مؤصدة" />
-	</sura>
-	<sura index="91" name="الشمس">
-		<aya index="1" text="والشمس وضحاها" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="والقمر إذا تلاها" />
-		<aya index="3" text="والنهار إذا جلاها" />
-		<aya index="4" text="والليل إذا يغشاها" />
-		<aya index="5" text="والسماء وما بناها" />
-		<aya index="6" text="والأرض وما طحاها" />
-		<aya index="7" text="ونفس وما سواها" />
-		<aya index="8" text="فألهمها فجورها وتقواها" />
-		<aya index="9" text="قد أفلح من زكاها" />
-		<aya index="10" text="وقد خاب من دساها" />
-		<aya index="11" text="كذبت ثمود بطغواها" />
-		<aya index="12" text="إذ انبعث أشقاها" />
-		<aya index="13" text="فقال لهم رسول الله ناقة الله وسقياها" />
-		<aya index="14" text="فكذبوه فعقروها فدمدم عليهم ربهم بذنبهم فسواها" />
-		<aya index="15" text="ولا يخاف عقباها" />
-	</sura>
-	<sura index="92" name="الليل">
-		<aya index="1" text="والليل إذا يغشى" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="والنهار إذا تجلى" />
-		<aya index="3" text="وما خلق الذكر والأنثى" />
-		<aya index="4" text="إن سعيكم لشتى" />
-		<aya index="5" text="فأما من أعطى واتقى" />
-		<aya index="6" text="وصدق بالحسنى" />
-		<aya index="7" text="فسنيسره لليسرى" />
-		<aya index="8" text="وأما من بخل واستغنى" />
-		<aya index="9" text="وكذب بالحسنى" />
-		<aya index="10" text="فسنيسره للعسرى" />
-		<aya index="11" text="وما يغني عنه ماله إذا تردى" />
-		<aya index="12" text="إن علينا للهدى" />
-		<aya index="13" text="وإن لنا للآخرة والأولى" />
-		<aya index="14" text="فأنذرتكم نارا تلظى" />
-		<aya index="15" text="لا يصلاها إلا الأشقى" />
-		<aya index="16" text="الذي كذب وتولى" />
-		<aya index="17" text="وسيجنبها الأتقى" />
-		<aya index="18" text="الذي يؤتي ماله يتزكى" />
-		<aya index="19" text="وما لأحد عنده من نعمة تجزى" />
-		<aya index="20" text="إلا ابتغاء وجه ربه الأعلى" />
-		<aya index="21" text="ولسوف يرضى" />
-	</sura>
-	<sura index="93" name="الضحى">
-		<aya index="1" text="والضحى" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="والليل إذا سجى" />
-		<aya index="3" text="ما ودعك ربك وما قلى" />
-		<aya index="4" text="وللآخرة خير لك من الأولى" />
-		<aya index="5" text="ولسوف يعطيك ربك فترضى" />
-		<aya index="6" text="ألم يجدك يتيما فآوى" />
-		<aya index="7" text="ووجدك ضالا فهدى" />
-		<aya index="8" text="ووجدك عائلا فأغنى" />
-		<aya index="9" text="فأما اليتيم فلا تقهر" />
-		<aya index="10" text="وأما السائل فلا تنهر" />
-		<aya index="11" text="وأما بنعمة ربك فحدث" />
-	</sura>
-	<sura index="94" name="الشرح">
-		<aya index="1" text="ألم نشرح لك صدرك" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ووضعنا عنك وزرك" />
-		<aya index="3" text="الذي أنقض ظهرك" />
-		<aya index="4" text="ورفعنا لك ذكرك" />
-		<aya index="5" text="فإن مع العسر يسرا" />
-		<aya index="6" text="إن مع العسر يسرا" />
-		<aya index="7" text="فإذا فرغت فانصب" />
-		<aya index="8" text="وإلى ربك فارغب" />
-	</sura>
-	<sura index="95" name="التين">
-		<aya index="1" text="والتين والزيتون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وطور سينين" />
-		<aya index="3" text="وهذا البلد الأمين" />
-		<aya index="4" text="لقد خلقنا الإنسان في أحسن تقويم" />
-		<aya index="5" text="ثم رددناه أسفل سافلين" />
-		<aya index="6" text="إلا الذين آمنوا وعملوا الصالحات فلهم أجر غير ممنون" />
-		<aya index="7" text="فما يكذبك بعد بالدين" />
-		<aya index="8" text="أليس الله بأحكم الحاكمين" />
-	</sura>
-	<sura index="96" name="العلق">
-		<aya index="1" text="اقرأ باسم ربك الذي خلق" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="خلق الإنسان من علق" />
-		<aya index="3" text="اقرأ وربك الأكرم" />
-		<aya index="4" text="الذي علم بالقلم" />
-		<aya index="5" text="علم الإنسان ما لم يعلم" />
-		<aya index="6" text="كلا إن الإنسان ليطغى" />
-		<aya index="7" text="أن رآه استغنى" />
-		<aya index="8" text="إن إلى ربك الرجعى" />
-		<aya index="9" text="أرأيت الذي ينهى" />
-		<aya index="10" text="عبدا إذا صلى" />
-		<aya index="11" text="أرأيت إن كان على الهدى" />
-		<aya index="12" text="أو أمر بالتقوى" />
-		<aya index="13" text="أرأيت إن كذب وتولى" />
-		<aya index="14" text="ألم يعلم بأن الله يرى" />
-		<aya index="15" text="كلا لئن لم ينته لنسفعا بالناصية" />
-		<aya index="16" text="ناصية كاذبة خاطئة" />
-		<aya index="17" text="فليدع ناديه" />
-		<aya index="18" text="سندع الزبانية" />
-		<aya index="19" text="كلا لا تطعه واسجد واقترب" />
-	</sura>
-	<sura index="97" name="القدر">
-		<aya index="1" text="إنا أنزلناه في ليلة القدر" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وما أدراك ما ليلة القدر" />
-		<aya index="3" text="ليلة القدر خير من ألف شهر" />
-		<aya index="4" text="تنزل الملائكة والروح فيها بإذن ربهم من كل أمر" />
-		<aya index="5" text="سلام هي حتى مطلع الفجر" />
-	</sura>
-	<sura index="98" name="البينة">
-		<aya index="1" text="لم يكن الذين كفروا من أهل الكتاب والمشركين منفكين حتى تأتيهم البينة" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="رسول من الله يتلو صحفا مطهرة" />
-		<aya index="3" text="فيها كتب قيمة" />
-		<aya index="4" text="وما تفرق الذين أوتوا الكتاب إلا من بعد ما جاءتهم البينة" />
-		<aya index="5" text="وما أمروا إلا ليعبدوا الله مخلصين له الدين حنفاء ويقيموا الصلاة ويؤتوا الزكاة وذلك دين القيمة" />
-		<aya index="6" text="إن الذين كفروا من أهل الكتاب والمشركين في نار جهنم خالدين فيها أولئك هم شر البرية" />
-		<aya index="7" text="إن الذين آمنوا وعملوا الصالحات أولئك هم خير البرية" />
-		<aya index="8" text="جزاؤهم عند ربهم جنات عدن تجري من تحتها الأنهار خالدين فيها أبدا رضي الله عنهم ورضوا عنه ذلك لمن خشي ربه" />
-	</sura>
-	<sura index="99" name="الزلزلة">
-		<aya index="1" text="إذا زلزلت الأرض زلزالها" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="وأخرجت الأرض أثقالها" />
-		<aya index="3" text="وقال الإنسان ما لها" />
-		<aya index="4" text="يومئذ تحدث أخبارها" />
-		<aya index="5" text="بأن ربك أوحى لها" />
-		<aya index="6" text="يومئذ يصدر الناس أشتاتا ليروا أعمالهم" />
-		<aya index="7" text="فمن يعمل مثقال ذرة خيرا يره" />
-		<aya index="8" text="ومن يعمل مثقال ذرة شرا يره" />
-	</sura>
-	<sura index="100" name="العاديات">
-		<aya index="1" text="والعاديات ضبحا" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="فالموريات قدحا" />
-		<aya index="3" text="فالمغيرات صبحا" />
-		<aya index="4" text="فأثرن به نقعا" />
-		<aya index="5" text="فوسطن به جمعا" />
-		<aya index="6" text="إن الإنسان لربه لكنود" />
-		<aya index="7" text="وإنه على ذلك لشهيد" />
-		<aya index="8" text="وإنه لحب الخير لشديد" />
-		<aya index="9" text="أفلا يعلم إذا بعثر ما في القبور" />
-		<aya index="10" text="وحصل ما في الصدور" />
-		<aya index="11" text="إن ربهم بهم يومئذ لخبير" />
-	</sura>
-	<sura index="101" name="القارعة">
-		<aya index="1" text="القارعة" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ما القارعة" />
-		<aya index="3" text="وما أدراك ما القارعة" />
-		<aya index="4" text="يوم يكون الناس كالفراش المبثوث" />
-		<aya index="5" text="وتكون الجبال كالعهن المنفوش" />
-		<aya index="6" text="فأما من ثقلت موازينه" />
-		<aya index="7" text="فهو في عيشة راضية" />
-		<aya index="8" text="وأما من خفت موازينه" />
-		<aya index="9" text="فأمه هاوية" />
-		<aya index="10" text="وما أدراك ما هيه" />
-		<aya index="11" text="نار حامية" />
-	</sura>
-	<sura index="102" name="التكاثر">
-		<aya index="1" text="ألهاكم التكاثر" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="حتى زرتم المقابر" />
-		<aya index="3" text="كلا سوف تعلمون" />
-		<aya index="4" text="ثم كلا سوف تعلمون" />
-		<aya index="5" text="كلا لو تعلمون علم اليقين" />
-		<aya index="6" text="لترون الجحيم" />
-		<aya index="7" text="ثم لترونها عين اليقين" />
-		<aya index="8" text="ثم لتسألن يومئذ عن النعيم" />
-	</sura>
-	<sura index="103" name="العصر">
-		<aya index="1" text="والعصر" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="إن الإنسان لفي خسر" />
-		<aya index="3" text="إلا الذين آمنوا وعملوا الصالحات وتواصوا بالحق وتواصوا بالصبر" />
-	</sura>
-	<sura index="104" name="الهمزة">
-		<aya index="1" text="ويل لكل همزة لمزة" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الذي جمع مالا وعدده" />
-		<aya index="3" text="يحسب أن ماله أخلده" />
-		<aya index="4" text="كلا لينبذن في الحطمة" />
-		<aya index="5" text="وما أدراك ما الحطمة" />
-		<aya index="6" text="نار الله الموقدة" />
-		<aya index="7" text="التي تطلع على الأفئدة" />
-		<aya index="8" text="إنها عليهم مؤصدة" />
-		<aya index="9" text="في عمد ممددة" />
-	</sura>
-	<sura index="105" name="الفيل">
-		<aya index="1" text="ألم تر كيف فعل ربك بأصحاب الفيل" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ألم يجعل كيدهم في تضليل" />
-		<aya index="3" text="وأرسل عليهم طيرا أبابيل" />
-		<aya index="4" text="ترميهم بحجارة من سجيل" />
-		<aya index="5" text="فجعلهم كعصف مأكول" />
-	</sura>
-	<sura index="106" name="قريش">
-		<aya index="1" text="لإيلاف قريش" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="إيلافهم رحلة الشتاء والصيف" />
-		<aya index="3" text="فليعبدوا رب هذا البيت" />
-		<aya index="4" text="الذي أطعمهم من جوع وآمنهم من خوف" />
-	</sura>
-	<sura index="107" name="الماعون">
-		<aya index="1" text="أرأيت الذي يكذب بالدين" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="فذلك الذي يدع اليتيم" />
-		<aya index="3" text="ولا يحض على طعام المسكين" />
-		<aya index="4" text="فويل للمصلين" />
-		<aya index="5" text="الذين هم عن صلاتهم ساهون" />
-		<aya index="6" text="الذين هم يراءون" />
-		<aya index="7" text="ويمنعون الماعون" />
-	</sura>
-	<sura index="108" name="الكوثر">
-		<aya index="1" text="إنا أعطيناك الكوثر" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="فصل لربك وانحر" />
-		<aya index="3" text="إن شانئك هو الأبتر" />
-	</sura>
-	<sura index="109" name="الكافرون">
-		<aya index="1" text="قل يا أيها الكافرون" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="لا أعبد ما تعبدون" />
-		<aya index="3" text="ولا أنتم عابدون ما أعبد" />
-		<aya index="4" text="ولا أنا عابد ما عبدتم" />
-		<aya index="5" text="ولا أنتم عابدون ما أعبد" />
-		<aya index="6" text="لكم دينكم ولي دين" />
-	</sura>
-	<sura index="110" name="النصر">
-		<aya index="1" text="إذا جاء نصر الله والفتح" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ورأيت الناس يدخلون في دين الله أفواجا" />
-		<aya index="3" text="فسبح بحمد ربك واستغفره إنه كان توابا" />
-	</sura>
-	<sura index="111" name="المسد">
-		<aya index="1" text="تبت يدا أبي لهب وتب" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ما أغنى عنه ماله وما كسب" />
-		<aya index="3" text="سيصلى نارا ذات لهب" />
-		<aya index="4" text="وامرأته حمالة الحطب" />
-		<aya index="5" text="في جيدها حبل من مسد" />
-	</sura>
-	<sura index="112" name="الإخلاص">
-		<aya index="1" text="قل هو الله أحد" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="الله الصمد" />
-		<aya index="3" text="لم يلد ولم يولد" />
-		<aya index="4" text="ولم يكن له كفوا أحد" />
-	</sura>
-	<sura index="113" name="الفلق">
-		<aya index="1" text="قل أعوذ برب الفلق" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="من شر ما خلق" />
-		<aya index="3" text="ومن شر غاسق إذا وقب" />
-		<aya index="4" text="ومن شر النفاثات في العقد" />
-		<aya index="5" text="ومن شر حاسد إذا حسد" />
-	</sura>
-	<sura index="114" name="الناس">
-		<aya index="1" text="قل أعوذ برب الناس" bismillah="بسم الله الرحمن الرحيم" />
-		<aya index="2" text="ملك الناس" />
-		<aya index="3" text="إله الناس" />
-		<aya index="4" text="من شر الوسواس الخناس" />
-		<aya index="5" text="الذي يوسوس في صدور الناس" />
-		<aya index="6" text="من الجنة والناس" />
-	</sura>
-</quran>
-`
@@ -0,0 +1,23 @@
+// Package qurandata provides metadata of Alquran (suras, juzs, hizb quarters, manzils, rukus, pages, and sajdas)
+// from http://tanzil.net, stored gzip-compressed.
+// Original file name: "quran-data.xml".
+//
+// Run "go run gen.go qurandata quran-data.xml" in corpus directory to regenerate it.
+package qurandata
+
+import (
+	"sync"
+
+	"github.com/alpancs/quranize/corpus/internal/gzipped"
+)
+
+var (
+	once sync.Once
+	xml  string
+)
+
+// XML returns the corpus in Tanzil XML format, decompressed on first call.
+func XML() string {
+	once.Do(func() { xml = gzipped.Decompress(compressed) })
+	return xml
+}
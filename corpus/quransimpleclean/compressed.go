@@ -0,0 +1,57 @@
+// Code generated by gen.go from "quran-simple-clean.xml"; DO NOT EDIT.
+
+package quransimpleclean
+
+// compressed is gzip-compressed "quran-simple-clean.xml" (926458 bytes).
+const compressed = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe4\xfd\xdb\x92#Y\x9a\x1e\x8a]\xefy\x0a\xa8x\xb3\xb7\x19g&\x11\xe7\xa0\xcdPF\xcd\x1em\xd2\xc4\xcd\xa18#\xc9t\x99\xdd\x9d3]buu\xab\xaa\x9a\xe2\xe8\x0e\x018\xe0\xe9oQ\x89\x8a\x04\x02qD\"##\x91O\xb2\xd6\xdb\xc8\xd6\x7f\xfe\x97/w 2\xb3I\x9ai\xcc\xa6+\x03p\xb8/_\xc7\xff\xf0\xfd\xdf\xf7g\x7f\xf5\x7f\xfc\xaf\xbf\xfbn\xf0_^\xfd\xf0\xe3\xb7\xbf\xff\xfe\xaf\xbf\x19\xfe\xc5\x8bo\x06\xaf\xbe\xff\xf5\xef\x7f\xf3\xed\xf7\xff\xf4\xd7\xdf\xfc\xf1\xa7\x7f\xfc\xf3\xb3o\x06\xff\xc7\x7f\xfdg\x7f\xf5\x7f\xf8\xf3?\xff\xb3?\xfb\x17\x83\xff\xf8\xef\xff\xf6\xdf\xfc\xfd\xdf\x0e\xfe\xd7\xbf\x1b\xfc\x87\xbf\xfb\x87\xc1\x7f\xfa\xdb\xff\xfd\xef\xfe\xef\x7f;\xf8\xbb\xff4\xf8\x9b\x7f\xfbo\xfe\xc3\xff\xf6\xb7\x83\x7f\xf8\xb7\xff\xee\xef\x07\x7f\xf3w\xff\xf1\xff\xf9\x9f\xfe\xdd\xff\xf6o\xffa\xf0\x7f\xfa\xf7\x7f\xf77\xff\x97?\xfb\x17\x7f\xfd\x15\xfe\xef\xcf\xfe\xc5\x9f\xfd\x8b\xc1\xe0\x1f^~\xff\xff\xfd\xf6\xbb\xc1\xff\xf5\x8f?\xbc\xfc~\xf0\x0f\xaf\xfe\xebO\x83\xff\xf9\xef\xbf\xfd\xdd\x1f\xbe{5\xf8\x9b\xef^\xbd\xfc\xfe_\xf2\x9b\x0c\x86\x7f\xf1\xe2/\x0e\xfe\x97\xf4\x93\xbf\xf9\xfd\x1f\xfe\xf9\x87o\xff\xe9\xb7?\x0d\xfe\xe7\xbf\xf9_\x06\x07/^\x9c\xfd\xf9\xc1\x8b\xe1\x0b\xba\xd5_|\xff\xea\xa7t\xd5\xbf\xff\xf6\xd7\xaf\xbe\xff\xf1\xd5\xbf\x1a\xfc\xcd\x0f\xaf^\xfe\xf4\xed\x7fy5\xf8\x9b\xdf\xff\xeew\xbf\xff\xfe\xc7\xc1\xbf\xf9\xe9\xa7\x1f\xbe\xfd\xd5\x1f\x7fJw=\xfc\x8b\x17\xd4\x8e\xdf~\xfb\xe3\xe0\xd7\xbf\xff\xc3?\x0f~\xff\x8f\x83\xff7\xb4\xe6\xa7\xd4\x9a\xf4\xe9\xcb\x1f^\xfd\xe3\x1f\xbf\xfb\xee\x9f\x07\x7f\xf8\xe1\xf7\xbf\xf9\xe3\xaf_\xfd\xe6_\x0e~\xfb\xed?\xfd\xf6\xbb\x7fN?\xfc/\xaf~\xf8\xf6\x1f\xbf}\xf5\x9b\xc1\xcb\xef\x7f3\xf8\xf5\xef\xbf\xff\xe9\xdb\xef\xff\xf8\xfb?\xfe\xf8\xdd?\x0f~\xf7\xfb\xef\xbf\xfd\xe9\xf7?\xbc\xfa\xcd\xe0W\xff<x9\xf8\xa7\x1f~\xff\xc7?\xa4\xbb\xff\xf8\x87W\xbf\xfe\xf6\xe5w\xdf\xfe\xf8\xd3\x8f\xe9\x06/\x7f\xe2N\xf8\xc3\x0f\xbf\xff\x7f\xbd\xfa\xf5O\x7fA-\xfa\xdb\xff\xf4\xbf\xff\xfd\xe0\xef\xfe\xcf\x83\xff\xdb\xdf\xff\xed\xbf\xc2\x8f\xfe|\xf0\x1f_\xfd\xf0\xbbo\x7f\x84\x0e\xf9\xf6\xc7\xc1?\xfd\xf0\xf2\xfb\x9f^\xfdf\xf0\xd3\xef\xb1\xe9\xa9\x09\xbf\xf9\xf6G|\xbdW\xa9i\xbfz\xf9\xd3\xb7\xbfK_~\xfb\x0a\x1e6H\x0d\xf8)\xbdkz\xbb\x7f9\xf8\xd5\x1f\x7f\xc2\x91\xfew\xff\xe1\x7f\x1b\xfc\xbb\x7f\x18\xfc\xbb\xbf\x87i\xf0o\xfe\xfd\xbf\xff\xbb\xff\xc7\xdf\xfe\xaf\x7f\xc1\x8f\x85\xde1\xbd\xf2\xeb\x97\xdf\x0f~\xf5j\xf0\xc7\x1f_\xfdf\xf0\xed\xf7\x83\x97\xdf\xff\xf3\xe0\xff\xf3\xeaW?~\xfb\xd3\xab\xc1\xef\x7f\x18\xbc\xfc\xc3\x1f\xbe\xfb\xf6\xd7/S\x07\xffK|\xe8\x1f~\xf8\xfd\x7f\xf9\xf67\xe9\xe2\x9f~\x1c\xfc\xf8\xfb?\xfe\xf0\xebW\x83\xffY\xc7\xeb\x7f\x81n\xfe\xee\xd5\xcb\x1f\xbe\xfb\xe7\xc1\xb7\xdf\xff&\xfd8\xf5\xf2\xcb\xef\x7f\x83\xbf\x7f9\xf8\xee\xdb\xef\xffs\xba\xeaw/\x7f\xf3*\xbd\xefo\x7f\xfa\xe9\x0f\xff\xea/\xff\xf2'\xb9G\xfa\xf0\xd5\xf7/\x7f\xf5\x1d4\xeb\x87\x1f\xd3\xdf\xff\xf9\xd5\xab?\xe0\x0d~\xfa\xe1\xe5\xaf\xffsz\xf7_\xff\xf6\xe5\xf7\xff\xf4\xeaG\xfff\xbf\x96\x19\xf5\xfd\xef\x7f\xfa\xf6\xd7\xaf\x06?\xfe\xf6\xe5w\xdf\xa57\xfc\xf6\xfb_\x7f\xf7\xc7\xdf\xd0[~\xf7]O\x97\xbe\xa2\x1eMc ?\xff\xe1\x15O\x99\xd4)?\xfc\xfe\x0f?|\xfb\xf2\xa7W\xdf\xfd3\xdf\xee\x1f\xbf\xfd\x8eo\xf2\x9bW?|\xfb_^\xfdf\xf0\x8f?\xfc\xfew\xa9\x13\xd3Tz\xf9\xed\xf7\xdf~\xffO\x83\x1f\xff\xf8\xab\x1f\x7fz\xf9\xfdO\xdf\xbe\xfcn\xf0\x87\xdf\xff\x00\x13\xd7\x0e#\xbd\xcc\x7f\xfc\xee\xd5\xcb\x1f_\x0d~\xfd\xdbW\xbf\xfe\xcf\x83?\xfe\xe17/\x7fz\xf5\xe3\xe0\xe5O\xff\xaa\xddW\x7fI\xdf\xfee\xfa\xe1WY\xcb\x7f\xf6\xe7\x7f\xfe\xaf\xff\xec\xaf`\x86\xfc\xeb?\xfb\x9f\xfe\xea\xc7?\xfe\xf02\x0d\xe4\xab\xff\xfa\xd7\xdf\x0c\xbf\x19|\xff\xf2w\xaf\xfe\xfa\x9b\xb0\x88\x938\x0a\x8b\xb0\x0a\xb7\xe1\xea\x9b\x7f\xfdg\xff\xd3\xff\xf4W/\xff\xd9^\x97^\xe6\xaf\xbf\x09\xcb\xb0\x89\xd5\x00.\x9f\xc4\x19\xfc#\xac\xc3m\xac\xe2T\xffhb\xf5\xcd\xe0/\xf3\x9b\x1c\xc8M\xd2u\xb7\xb1\x0a\xf7\x03\xbe\xcd:,\xf1\xe7\xdb\xf4\xbf\xb1\x8aM\x9c\x16nq\xe8n\xb1\xe7s\x8f\xf8G\xb1\x82\x9b\x8f\x07\xb1\x895\xbdE\xb8\xefx\xd4\xb1<\xea26a\x91~5\x0d\xdb\xb0L\x8d\xae\xedg\x9b\xb0\x0a\xdb\x8e\x9b\x9c\x98\xf6\xce\xc2}\x9c\x86\x05>\xf51\xac\xc3\"<\x0d\xf0m\xd3=\xe2EG\xf3O\xe5\x1e\xf6G\xe1!=r\x10\xe6\xa9U\xb1\x0a\xabA\xd8\xc6Il\xe2,V\x83\xf0)6a\xcd7\xff\x14>\xc4:,\xed\x05\xb1\x8e\x13n\xca\x87\xf4\xbf\xda\xfe\xbf\xfa\xcb4A\xf2\x89r`'JX\xc6\x8b\xb0\xee\x9d&\xf0\xe4o\x06\xbf\xfa\xf6\xc7\xdf}\xfb\xddw/\x7f\xfb\xb5f\xce\x03\x8c\x1f\x0ecX\x85EX\x0e\xf0M\xd6\xb1I\xff\x1e\xa5\x17\x1c@_\xbf\xc6\xc9UQ\xd7\xee\x9eN\xd8\xa3\xb1\x09\xbf\xa46\xc5:N\x07a\x09\xdf|\xc2\x9b\xd7\xb1\xc1Q\xc2\xef`\x1c\xd3\xd3\xc3U\xfa\xae\x8a\x154$\xbc\x8b\x17q\x1a\x16\xd8\xd1M\x9c\xc6Q\xbc\x88u\xb1\x01:5\xeb\xee&\xe0}\xd3@\xbf\x8b\x93A\xb8\x84\xd1\x1a\xc3#\xed\x17\xe9\x17\x83x\x11\x968\xc5kj\xfb\x9bp\x97\xc6j\xc0\xcd\xa9\xe3\x05\xde\xb9w\xce\xcfa\x86\xbc\x8dc\x9c5\xaf\xa5Ka\xb4\xd6aI\xd3\xc8\\\x08\x9f\xd0\xea\x1d\xc1\x02\xafw\xac\x89K\xeeEz\xedq\x1c\x85u\xac\xc3b\x106\xe9?\xe1g7\xa9\xe7\xf0\xaa\x0fa\x1dV\xf4A\xac\x06\xe9q\x83\xb0\xc2/\xe0c\x98\x0f\xb6\x0b{\xd7\xd4]X\xb9YIo{\x11'\xa9\x07\xf9-\xf1\xd34\x81\xc36\xfbl\x1e\x96\xe11,\xe8\xe1\xe1Sx\x1f\x16\xb1\xc6)1\xc1\xcf\xb6\xe1\x01fj\xd8\x86\x8f\x1d3\xfcL'\x82,\x888\x0d\x8b\xb0\xa1qm`\x0eM\x06\xe1M\xfa;,hjB\xa3y\xac\xed\xb6\x06\xc3\xces\x04\x9b\x91&\x12vJy5\x9cK\x1b\x9ap\x17\x16\xe1>le\xa2\xf3st\xb4\xb0!\xe9#yJ\xfa\x99\xfc\xe8\x12\xd7\xe5<\xcd\xff\xb0\xa1N\xe3\xeb\xde\x87m\x1a\xe9b3\x86/\xa4\x1d\xa3\xd8dCQ\x85u\xf80\x88\xa3\xf0.5P\xa7\x1c4\x0f\xbe\x0c\x8bR\xcf\xcf\xa1w*^Mq\x1c\x16\xdc\xf8&\x8e\xc3CXv\xb5fh\x96\xe8e\xba\xdd\x00\xf6\x80\xc9\x80\x9e\x81\xaf\xb9J/\x19\xee\xf1\x8e\xa9\xd9\xd0Ssl\xedE\xfa\x03\xbeJS\x1e\x1b0\x0d\xb7q:\x88Ux\xecY*\xc3\x03\xb3 '\xfc{x\xaa]l\xf8\xe48\xc5\x17\x1f\xa7\x7fL\xf6\xe8\xe7\xc3\x1dofGx\x0c\xad\xc6O\xdc\xe44\xef\x96V'\xcc\xaf\xc2\xe5a\x13Gq\x86K\xba\xebU\xcc5\xad\x17\xd9\xc2\x9bv\xbc\xc8Q\xfbE&i\xb9\xf0\x09\xd7\x9e\xb0\xa6\xd5\xbc\x9e\xe4\xb7\xe1N\x07\x0bV\xf8\xfb\xd8\xa4c7\xdd\x04\x1b\xec\xc73\xfd6m\x0b\xe3X\x95\xc67\x1d\xed\xb3\xf0.\xbc\xedj\xfd\xb1;4a\"7\xfa\xab\x81lB\xb1\x89\x15My\x9caOp.-\xb8U\xa9\x93\xaa8\xebz\xccIio7\xbd\xb3\x08\xef\xc3\x8a\xb7_\xb0\x0b\xe2\x04\xfe{\xc5[\x0d\x9d\x00#>\xe7\x96\xe16\xac\x06a\x15n\xd2\xf6\x17Vn\x8d\x9b\x05V\xc5YXu\xdaY\xc3Sc\x9e]\xf3\xa2\x1a\xe3\x1f\xd2\xc2A\x9ama\x15\xebx\x11\xee\x070\xf9\xd6\xb8\xd4&t\x0a\xa6\x95\x1f~\x0e\xab\x01\xfe}\x8b\x9b\xc0 <\xc4\x19\xd9\x96\xf8\xf7\x12\x9aEGE\x0d\xaf<6\x9d\xfa\x11o\x18V2\xf5\xd2\xf6\xde\xb9\x86\xce\xd48\xc3\xdd\x05fA\x1a\x88&\xdd\xd0\x9eG\xebp\x13\xb6]\xf79\xb7\xa33\x88\xe3\xf0\x18\xc1\xe40\xeb\xa7\xc2\x95\x81\x16\x8emg\x1d\xd6a\x8bf\xe92\xac\xe3Ez\xd8\x0d\xac\x18\xd8\x88\xe7p<-\xc3\xd6\xbc\xe5\x9b\xf0\xa0\x13\xa7Rc\xa6\x0e\x8b\xb0\x8d\x17\x83p\x9b\x8eS\xde`j|\x8a\xd9goc\x13\x9ex^\xa4\xa1N\x07wy|\x0ft;O;\xed\"\xdc\xe3\xc3\xa4\xa9w\xe1)\x8e\xf2s4\x8e\xb3\x81\x95\xed\xb6\x0a\xefe\x97\x8d3\xb3l\xe7\xd8'\xce\xccM+\xb5\xe23j\x12\xebAx\x8f{\x90\xbc\xcb\x84'\xc8\xd2\x9f\xee\xbe9b\xaa8#a\x1c'\xb8;\xfc\x9c\x9et\x9f\xc6\xb8\xd4\x01z\x824\xd0\xce\xd48Zd\xb4\x8b\x86\x05z\x16\xb8\xf8\xd6<\x8dd\xee\xdf\xc1~6\xc6\x96\xe9\x9au\x16\x1f\xce\xb4-\xfd3\xac\xc0\xec-\xcf\xb6\x83\x83\xdc\xee\x1d\xe0\x84\x19\xf0\xaf\xcd\xe15\x02\xa7\xe3=\xee\x90v&\xa6\x95\x04\xff\x88\xb5\xb7A\xddU\xfc\x9fQ\x98\x83!z\x03\x1b\x9a\xb9\xf0\x1a\x8e\xed4\x91\xd1t\x0e\x0bnE\x1c\xd1\xd9\xca\xb3\x19\xbf\x82\x11H\x0f\xbc\x07\x1be\xc1\xcf_\xe1k\xf7\x9d\x14\x07\xee\xc8\xc3\x83\x0a\x7f\x88\xcbb\xcd\x8b\x8e\xb6\xf0w8B<\xe04HS\x98}a\x1eV8^K\xb0V\xc1\xc6\xaep\xcf\xbf\x16s\x09\xed\xa1t\xd5\xfb\xb4\x7f\xa6\xbe\xc0wKW\xd2\xa9\xad\xf3\xca5\x09\xa6\xdf}\xa7\xefr\xa0\xa7\xde\x08\x7fH\x96\xf0H;\xabN\xed\xcf>\x03'\xdf\x9c\x8e\xb8\x97\xc2?\xc3*6\x03p\x10\xeat\xd0\xf89J\xa3\x7fK\xbb\xfdUZp\xdbp\x0f\x1b\xe5\xeeM\xe0\xd8t\xfc2\xbc\xe7\x07\x16LI\xd8<'\xd2\xbc\xf0H\x8f\x85\x192\xc7\xf7\x84%y\x03\x0d\xa3\x03h\x1d\x1b\xea\xd3\x14\xc0XI\xe3\xd3/\xd2\x1fam\xb6\x14\x98g|6\xc1\xd7\xf4[\x9c\x8bWf&\xeaQ\x1fg\xe1\x81o\x0a+F\x1c=\xbb\x0eq\xeb\xe0\x89\x81\x9b\xe5\x0a6\x9de\x9c\xf1\x90\xf0>\x0c\x9f\x84yx\x97.\x0f7\xe9\xda\xa78\x83\x16\xc4:\xbb\xea\x8e\x82\x15\x1d\x13\xbb\xedT\xf1\xf6\xb6 s\x02\x9c\xeb\x86\xba\xb0\x09\x1f \xe4\x82s\x15^\x01gr\x9a\xac\x1f\xc2\x15\x9f\xf2q\x04\xd3aF3\x1e\xaf*\x0f\xdc\xc8Zi\x03\xecw\xbc\xf66^\x94\xfc\xc6\xec^\xc6\xfb\x83\xb7\xbe\xc0\x1d\x1b\x17\xd4\x82\xb7\xf8\xb5\x1c |\x9a\xe3\xb0\xe8\x8b4\xc9p\xa1\xbe\x1f\x87k8x\x17h>%\x03\xa6)}Ue?d\xe7\x85\x83b\x9b\xeeUxZ\x0c!\xc4i\xbcHQ\x978\x1d\xa4#\xc56\x19{b\x89'v\x15\x9bp\x1d\x16\xa9\x8b)\xbc\x10\x9e\xc8\x81b\xe7\xbe\x0ak\xfd1\xb6\x0e\x870\xd6\xc9w\xc0\x9f\x19\x17 s>J\x0e:xw\x9bN\xa3\xe6@\x9d\xd1q\xba\xf7 \xacxp$$B\x1b\x9cnVs<g\xc3\x8a7\xc7[\x8c\x90\xa5\xef\xae1\xf2\x90^ve?\xc1)\xa9\x9fPhc6HVY\x8f\xb5t\xa0\x9e\xea,\xd6\xf99)\xc7GU\xf0\xc5\xc2\x0d4c\x1b\x16\xf4H6+_\x8b\xb9\xef\xad-\x8cD\xc4Y\x9c\x0e\xc2&\x0d\x1bF\x01\x16\xf8\xb2\xb8Nk4\xfc\xc4\x18 \x0b\xa4\xe4\xe7\x1f\xbe\xf0\xbe\x0a\xed0t\xe8S\xc4*N\xc2\"\x8dX\xb8B\x87\x02\x8e\xe7\x05\x1e\xd0\xf9\xfb\xdc\xc1\xa3F\xe1\x8a\xeeD\xae\x18\x1f\x9a\xba\x83p\x18\x01\xa6\x8a\xf9\xb8N\xdbC\x1c\xb17p\xcfo^\xb3\x0b3\x85\xf7\xbeM\x03\x0f1[\x0c=\xa5\x09~\x1f6\x03\x0c6\xd1KPc\xe7\xb8\x11\xd0\x08\xd0\x19\xdew.\x1fZ'\x1b\x7f\x1b\xde\xa4\xa6\xf0\xab\xcax\x8c\xc1\x0f\xe1\xc1\xdb\xa6> O_\x06\xcf\xf5_\x1cq\xdb\xe6q\x1a\x96\xe8\x85\xc1&`\xef:\x0b\xbf\xc0o~~\xde\x11|(v\x94\xed{\xe8\xad\xb0\x88S\x18O\xb6\x1e\xaa\x01\xdb\x12\x97f\xc3\xddR\x80\x92\xbeH?A[\x86\x82\xe1\x1c\xb5\xc0mt\xdc5\xa9\x0e};\x06hhR\x0f\xf2\x8bc\x0cH^\xfb-\x1f0\x13\x0d#.\xc3\xbc\xe32\xe9\xc4\x09\xde\xf2\xc2\x18\x8b\xd9\xa8S\xb4T\xd7\x91,\x153oc-\xd7\xe3\xe3Wh\xffb\x10\x03=H\xb1\xe9\xe28\xfd\xa3k\xee\x1c\xb5V\x14\xf5t{1\xa5\xc5~\xc3Q\x9a\x09uP\x1c\x99Oy\xe7\xbf\x04\xa3\xba\x09\x1bpL\xe2kh=l\x15\xe3\xe4\xb9\xc0\xd6\x17\x16l\x83\xef\xe1\x06\x1d\x1a\x0bH[h\xc7i\x116\xe9\x9dy\x02\xc4\x1a\xec\x82\x1b^\x98\xc9\xdc\x01\xbb`L\xf3\x87,\x97\xb0\x0e\x9f\xc0\x0c\x86\x1d\xf7:y8oSw\x91\xb1\x01}\x0b\xe1\xfa%\x191|,\xbfOf\x13,\x91\xf4V\xb0\xde\x17\xfa:\xe1c\x7f\"\xe6\xc4X\x9fs0\x94gz\xa2\xa7m\xf0\x09\xba'l\xa9\x99\xea\x00\xd0\x85leC7Z\x7fNz',\xe2,,\xc3\x13[\xd9\xdb\xf0\x81\xdd\x1c\xf8c\x90\xce\xd0Xs\xb0\xa8jm\x8e\x9cX\xe1@\xe8*m\xa2\xb2\xcf\xdfv\xbd\xda\xa9y\xb5\x15x^\xafy\x90\x8c\x19#\xf6dXA\x17R\x84\x97O0\x88n\x0d\xf4|\x02\x930,we:\x0e\xf5\xec-v\x83\x0e\xbb\x1ed`\xff\x93\x09\x93l\xcf&N\xc5\xc7\x88\xcd\xc0\xc4m\xd0>N\x87X\x04g\x04\x83\x14\x13\x0cz\xd5qTH\x11\xe1\xbf\x9bp\x1b\xdeuF\xd3\x0f\xcf\xcbi\x0cc\xd1\xd5\x1cd\xc5\xa1|\x13\x9b\xd4q\xf8~&\x1e\x95b\x15\xb7\xd2O\xe4\x9a<\xcb\x12>z\xe1\xbd\xed%nN\x97\xc9\xde\x81\x8d\xa0\x89\x93A\xb2'\xe3\x98\xdbF\x094\x9e;+\xb2\x92}Zm\xac\xe9\x8e\x91LH\xb6(\xe7\xd8y\xfc\x09]\x8b9\xc2\x06]\xaeu\x1a\xc4\xae6\xdb\xe3OM\xea,\xf3\x83\xa1\xad\xc7t\x1e\xe1\xe6V\xd9\xc8\xa3\xaeuX\xca\x1c\x91\xad\xe3d\xc0;\x13Y\xbe|\xa1\x8d\xf7\xe9\x884p\xb4\xe2\x8a\xbc\x807\x9fPp\xd4\xbeMwl\xe1H\xcfDi\xd1\x84\\\xe4\x85\xfa\x03\x94\x9cZB`\x15\xbc&\xd9\xe5\xede\xfb\xbb\xf6G\xd6\xb5\x9fs\x9aN\x9cHI\xd3\x857a\xa5\x9f\xbf\x8bc\xfe<\x0d\xd1\x98\x9c\xf5\xd4\xab\xbcR\x17\xf0iy\xab8:\xd2\xb0]X\xa1\xb1\xae.=\xc5v\xf8M\xd7\xf8\x96\xd3\xb0\xc1K83\"3K\xdes\x15'z\x17Ns\x86\xb9\xc6C\xb6\x98\x14)\xb7\xc9\xba\xda\x0b\xcdN\xf3@Cwps\xb2\xbe\xb9\xe4\xcd:=v\x09\xfe\xd1\x95\x1c\x89ja\x81\xff\xf0\xbe\xbbWN\xcai\xd5\x8fq\xaaoN\x91\xc7\x098?u\xe6\x1aN9\xe8F\x9biZ\xb9\xdd\xee\xc0\xd1\xe9\x9fx\xc9Oq\xda\x7f\x80+\xc7\xde\xda\xdc\x01X8:s\xa3\xc1\x81\x17\xcc\xd4\x19\xeb\xf8&\xbcK\x0f\x81\x19\x01\x87\xa6\xfc;\x9d\xa5o\xf50\x8f\x0d\x07\x1a\xe4$x\x0fKr\x1b\xae\xf4\x9a\xf0K\xb8\x0b\x0f\xe6\x9atRN\xb2==N{\"\xdbG\xe7\xb9Y5\x0d7\xf0\x9b\x85\x09`\xbd\x01\x0f#\xc5\x9fad\xc1\x95\xa8q\x8d\xc2U\xe9O\x0a\xb7J..6\xe9$\xc0\x9c\x17ZWS\x0d\x8b\xd5&XQ\xb3\xf7a\xbe\x85\x13\xfe\x81-\xcf%Y\xedz,\xe3\xe8t\xa6Y\x8f[\xeeW:\xa1.(\xa1\xaa\xb1\xcfd\xc1\xaf1\xe4\x91\xbdv\xb2[?\xe9o\xf2\x0e\xb0\xeb8N\xc3\xc7\xce\xfe=\x1e\xe6-\x81\xd8;\x87\x17\xabX\x87\x0d\xa6\x97\xd7p\xae4\x18\xf9j0!C\xae\xeb*\x8d\xb2$\xb0\xc36\xdcH\x0c\x16\x02\x0cq\xe6Z\xc4&]G\x8b4*\x8c\xceU\x1c\x91Q\x98\xe6\xa3\x19t\xb85\x8dB\x16s~\x8fK\xac|\xff,\xdf8\x80\xad\xb8\xc9\xde\xd7ny\xb8A\xe1\x10\xa1\xb1m\x1fFY\xa5\x8e\x87\x1d\x95\xfdly\x10f\x08c\x85\xe968\xecjvf\xe0\xfe\x1f\xc95\xab\xfcN\x1d\x96\xd4\xef\xb0\x9fd=?\x82\xb3e\xe9\xb2\x87K\x08\x97\xbe%\x035,\xe2\x05\xee\xef69\x0e\xf7\x91Y}\x976^\xf1\xae\xb6q\x1a\xee[\xb7q\xe6\xa6xa\x9fap\x1e\x1f\x17\xdc'xm\xec\x16\xd3_\xd3\x81&x\xd3\xd0C\xa0\xf5u\xfap\xcd#\x071\xaa\x1b\x8ea\xa2\xc9\xff\xc0\x9b&Es\xd3\xe9\x15\xae\xdc\xc4\xdc\xb1TN\xfc\xc4L\xf3\xef:\xdb\x88$\x9eV\xd3\xd3\x9e1/OM\x0f|\x84\x97\x90\x98" +
+	"\x7f\xa3-\xff\x14\xab\xb0\xd0\xa3\xfa]\xf92\xec\x1dN\x94L \xb0\x14\xc74\xde\xf0eJ$\xe3$\x1a\xe4\x80\xa1\xb1\xe6Oq\xfa\xd1\xfa\xd3l\xb8fU=\xb0\xa2\xd1\xebKox\xd6\xe5\"C\x82\xe2\xceD\xb8\xc99L>Sl`\x0c]\xeb\xe3\xac\xedh\xaa\x07\x1akw?\xb1\xf0\x96\x03\xf4\xb0\xe1\x12\x0e\xec\xc2\x8d\xc2Sz\xc64|\x02\xfbT\x16\x008\x8f\x8dn\xb9\x1b\xe8\xf0\x86\xc3\xa8U\xb8M\x1fu\x01\xf7\xce\x8d\xff\xb6\x84s\xcf\x86\xac\xa9\xa3x\xc9\xc3\xf9\xabp\xb9tU\x0e\xf0\x18\xb5\x07\xfcu\xc7-!l\xf9\xce\xf9\xd1&]\xd6F\x9e\x8c \xb4\xdc\x81\x93\xca\xcf*\xb2\xe66\xe0\x90\x167\xb2\x91\x1dX\x8c\xf0\xc3\xcaxL]9\x90\xfc\xcd\x1a7\xa24\x7fR\x08`EC\x9b~\x94\xd6\xd5\x0a\xde2%i\xd2Q\xb3\xe5-:%\xde9d3\xe6\x88\x1a\x02\x94\xc2{\xb5\xdfd\xba\xd4aA\x9f\xeb\xdc\x87\xb9n\x01Eb\xd1\x86\xeb2v\x86\xe1-\x1d\xf8\xca\xe1\xf3v/4|y\x08\x9f\xc0x\xc3\xa4j\xb8\x85\xa8(f\xec$VGQ\xd9\x86R\x97\xf41C\xfdV\x102[e\xedM\xbb\x11\x8c\x03\x85W/\xc25\xc6\xd1\xf0\xcf\x11\x0e\x16\xe5\xbaR\x0c\x95\xffX&S\x1c\xff\xe0p[\x1an\x0a\x8d\xa9K\x80s\x146\xfay\xb8\x8fS:e\xdc\x17w<\xa3m\xe8\x00\x01\xa4\x03\xcd\x17J\x98<l\xc2\x9c;\xae\xc6\x99\x93!J\xf1\xf6\x13\xf2\x95\x10\xb4\x1a\xc7\x14\x93J\x8f\x0f?\xb3\x87\xf1)|\xb0\xd0\x05\x86a\xc0\x91\xb1T\xe3\xdfC\xb0l~\x81|Q7O\x1a>7\xd5\xbbZ\xc6\x06\xd7\xdf\xd2.`\xf0.\xf9i\x14eEd\x83\x04\xed\xe8\xa1a\xdbc@\x9c\x1ct\xc1\x14}\xb6R?O#\x17\xee\xcd\xe70\xdf\x16p8\xb2\xab\x15\x16\x10\x8a\x95\xe4=\xde+\xc3\xf2u \xf901:\xd0\x84(\x06o\x09\x1ay\xc3H\x05\xb2\x17\xd4\x9f\xfa\xf2\xf8\xceI\xcbr\x83s\x9d\xd7\x02\xa7\xb0h\xb3^C\xb6y\xaa\xd9C9B\xd3<\\\x0f\xe0\xa7\xb5\x84\xc0\xc5\x08d\x93\xfe\x82\xd0\x93\xdec\xabL\x88pO\x98\xc3\xc9\x91\xb7\x1bV\xe9\x95!\x07U\xb6dGq\xc2\x9d2\xc2\\\xa0\x03|\x88C\x08\xa0\xe5\xb0\x82\x96(\x80@6|N\xaeu\xecX6\x0e<1\x9b\xaa\x9a\xf1\x02\xc8\xa2\xf9\xc9g\xaf\x0fqn`\x89\x9a-_\xa1S\xb5\xc2\xdc\xd6\xe1>m\xe2\xd8\xa6\xb7]m\xb2\xc1\\\xc4Z\x00~9,\x06\xe9\xb1\xf08\x898\xc1\xb2\x03W\xef^SH\x15\xe3\xe6F\xf2\x09\xecn\x1f\xd3H\xeeBa\x9f\x9c\xeeo\xabg\xe9\xed\x86b.c2\xd3\x07a\xc5\xce%6\xf5\x02-\xd1,5\xb6\xd2\xe9;\xc3\xec\xbb\xd9r\xb7\xb1\x0e\x0fvI\xe2\x8d\xe7\xd8\xb1f\xa0oR\x1f\xc5I\xd7[\x9d\x95\x92C\x1d\xc7\xcb\x92\xfd\xbb\xa9\xcc\xf5\x19\x18\"\x9aW\x9b\x19\x0c0\xc7i\xf4\x05'\x0c\xed\xc0\xe4\x0a\xfc\x9dn\x8e\xa7]\x8d\xb1x|\x8aLw\x88\x0a\x0a.\x84\xb20\xbfp\x0c\xab\xf4J\xe7_\xf6J\xb0{\xbb\x03n\xe7\x8b\x85G\x02 \xfd\xcc\xce\xd3\xd6\xde\x07\xce\xc7\xb5\x86\xdb>v\xae\xbb\xd3\x17_>\x1cz\x12@\xf3\x06\x8a\xec\xe0\x1d\x82\x81\xa4\x92\xd0+\x00\xce\x04\x0f\xd9\x01M\x1f\xfa\x86\xee;\xfa0\xac\xf0\xfd\x0a\xa1\x0d\xce,\x11C\x0b\xa0\x0cl\x0a\xae\xc35$I`\x81\xf2}\xde\xb3\xe1\xdf\x98\xa1R\x83\xfeM\x9c\x0e\xc2M2\xfd\xd9\xeaH'n\x1c\xf1\xba\xb3[\x02\xe2\xfd\xd4\xd8\xddvF-O\x0f\xda{\xc0\x8a-\x12ptx\x82\xdf\xc3$\x9f\x0bj\x8b\x9eg\xd1\x89d\xac='\x8bx\xaaG\\\xdb\x88N/\x95^\x16SP3|1ZE\x0d\xe3jl]\x0b\xf9\xa1\xaf1\x9e\xb5f\xff\x90c\xec\xf9\x11\xd6\x17\xce=\xf5\x87X\xbcH\x06\xa1\x82\xe2\xc7\x9dg\x19\xec\x1f\xe3\x16f\x0b\xad\xc6\xf7\xe1\x1en\xc5G\xed\xa5\xdb\xd8\xf4z\xda\xf3\xe1\xd0\x1c\xa1\xe7P)\xb0\xc7`\xab\xf4\x16\x1c@f\xa4\x7f\xbc\x80\xe9\xa16\xb4\xde@\xa0\x06\x1d?F\xe3\x95\x1avG\x13\xd3\x1a\x85\x9cp\x84\xbf\xc1\xf2\\\xc4Q\x9c\x00\x00\x97#\xe5Uw\xd7\xdar\x97\x14Ry\x82l\x0a\x87\xa8M\x0d\x89\xc1$\x12\x00\x99\x92\xbf\x98\xef\xbd\xa0\xb6\xa3%\xb5\x91\xbb`\xaa\xd6\x95\x970\xf0%YI\xb8\x8be\x01\x0c\xf40q>\xcc\x04\x06\xd6\x0f\x80?=\xf9\xea\x00x\xcd\xb8J$\xfe5}\xd2:Mo\xc3}\xb8\xc6\x971\x15\x16\xa9Co\xcb&\xd4\x04z`\x11nb\xcdF\xdf\xcc\x19\xaet\x9c\xef\x95\xef8=\xcd\x80\xed\xbe^\x80Noc1XlJ\xc30(\x83\x02K\xdfw\xd6\x0deu:R\x87\xc4\xe1\xea\xf6\xe3]\x0eG\x8ab\xaa\xb0\xc0\xb4\x02\xcd}\xee\xe5\xb00\x99\x92R\x03Lp#\xd6\x1c\xa6\xd0$\x0b<jYxrr\xbc\xc8d3\x90,\x03\xb2c\x1c]\x9c\xcaP\x983\xabq\xd9B\x9a\xc9\xad,\xa1i\x12\xe5w\xe8\x14X\xa1?i\x9b\x10\xeb\xf2\xc5\xe9\x156\x9d\xa9\xd2\xb3\x17\x16F!\xf3\x90q\xae\x15\x84\x8a<\xb0U\xfa\xbc\x09\x0b\xc9\x99\xc2\xa9t\x0f\xa6!;\xda\x12J\xcf\xde\x1e\x93\xba\xecn\xc1\xb6\x00\x96n~\x05Z\x8ap\xd2h\xb7Z\\\x12\x9dN\xfbA\xa2\xceL\xc9-\xdc\x82\x0akR\xdf\x0c\xc2\x06\x92B\x18D\x85\xa5\xf4\x04\xc7qj\xc2\x1d\x04\x18\xdf\xe213\xfa\xba\xc9\xf5\xb3\x83b\xa2\xff9\xf8\xdd\x8e\xd60\xbc\xe5Y\xad\xd9\xcb-\xed\xc8\x07\xca\x18\x08\xee\xc8\x82>]U]\xadu\xc2\xe9.\xb7a\xc3\x90\x95\x9aJS0`*8!\xf42\xc3\"V\xfaA\x05\xbf\x1a\xc3M\\\x10\x94\x03\xcea3\xc0\xb0&#\xda\x9f\x99\xc2n\xfb\xb8\xf4Bv\x81\x8a\x1b\xa9\xc1\xf7X\x11\x8c\xae\xa3\x93\x8f\xf6\xf7\xfd\xc5\xc4\x1c\x91\xaf\xc4hB\x8fP@C\xa0\x90\x04'L|\x83\xb9x\xde\xa6RW\x845\x14\x18\xe5\x89r\xc0\xd4w5\xfc\xd8{\xff\xfc3\x0b\xf6[\xd9\xa0R\x96\x8f\xb7\xcd\xe4s\xdeu\xa1k\xed\x0c\x1b\xf4\x11\xdc\xe6\xb5.}\xdan\xd1P\xbe\x84\x96\xd0|\xc2-h\x11\xa7r\x06 z\x87\x8e\xc4y\xd8P\xec\x08L/0\xa3y\xdf\x9c\xc5\x1a\x8ap\xc2Z\xcb]0\x07\x04m^ \xc6j@V\x8d\xabf\xc6\x13<\xcf\xb2e\xf8^\xbc\x88\x0b\xbd \xa0\xcdIV6\xe3\xc5\xd0\xe4\xee\xe0\xd5s\x87\x89l\x09S\x00\x0c\x98\xcc6\x08V6\x8c\xc6\xe6\x00W\x9a\xe8\xb03_a\x95\x94\xae\xc6\xf8\x9amU\x9f@\xfeR\xc3\xef\xec\xd9\xb5p\xc5\xb7\xc8\x0b\xacG||\xdf\xc5\x11\xc6\xda\xa6\x1a;5\x8d\xdf7\x05\x7fv\xda\x0e\x15\xedN\x99\xc6\x8b8\xd2KL&\x98\x9a\xbb\x0e\x1b\x04\xdc\xe8\xad\xc266t\xab\xb0D\xe8\xf9Zq\xa7K\xbc\x0a!\x89x\x82Cth\x00%\\5\x1by\x08\x07NsN\x0b-nd\xf1\xc3ck\xc4\xd7\x9b#0\xce\x10zm\xd7\x9e\xc5Y\xb2\x97g\xd6\x1f\xe0\xc8\xf0\xf3\xda|n\xd7r\xa9/\xcf\xcav\x03\xfbQSL\x03\xc1\xb1\xbe\xc4\xe3\xc1\x0e\x1f\xcd3Z&tH\xd9}uw\xc9\xf9\xd9\xb9\x1bM\xd3?\x14\x0d\xa7\x01,\x1a`\x84\xfbr\xa8/\xda\x0c\\\xa1\xa5\x96\xa1`\x09)\x18\xe1\xb9!R,\xb8h\xb7\x88B\xe7k\xc2\xbb\x99\xcb\xc1\xd0H\xbf\x81v^\xb5\x0a\xe3\xf6\x80\xc3\x9e\xbfP\xf3&\xbc\xc5$Y\xb6\xee\xb8\xd4A\xb2\x9c\xe4\x94\xf9\x86xn\x04\xbb%p\xb5\xdd\x14\xd7\x99\xfb\x1e\xbb\x0a\xa2\xbc\xdal\xfc\xb0\xa1\x88\x0d\x0dDZ5\xb0| \x99\xd8\xcar\xf0\x1b\xe3_0\xb2\xee\xd5\xb5\x04=Vq\xd6\xd5\x19\xc3\xfd+\xb1;_\xd9\xcejN\xd3\xb7.\xb6Q\xaa,\xeb\x02WBt\x1bf\x00\x9f3\xa6\x82\xa7\x88=Dt8\x16\x1aP\x01\x9a?S\xd3\xfe\x91\x85\xc0\xcc<\xcd\xaa\xf0v\x10\x14\x1c\xb47\xc4\x1b[RG\x1b\xe22\xdb\xb7\xfe$p\x99\xf3\xff^I\x11\x8a/d^<~:\xe5\xb4\xe2\xa3\x0c3\x9c\xa0\x92\x85m1*\x98\x9e\xf0\xfb\xdb\x05|\"k\xd3\x87\xda\xa9H\xaa!?\x96\x01(\xfb\x8f\xe4\x91\x859\xf3o\xd3\xbd\xb0\x9ePz\xe7^\x8b\x13\xf9\x9cm\xf9gw\xe4g\\\xb5\xab*)A=\x0a+]@Z\xd3\xfc\xacb\x8fs\x9f\xb8\x99bP\x0c\xee\x0a\x1bU\xf2#t}\xa6\xc9\x89XB\xef\xf3\xe6\xf1\x10\xb1\x0d\xfb\xe1\xf6\xe7'\xf6\xe1\x80\x17\xbc\x97\xe8\x03\x04r\x1f\xed\x0b\xf3\xae\xc4f\x8b!\x15\x11\xde\xa04+\xe2X\xd0\x88\xe1\x1e\xefD\x04\x03\x93X\x13\xa3@Xc\xd9\xc7h\x00\xce\xc9\x95\xe1\x14\xa9\xf1m\xdf\xa5\xe4a\xb8uU\xbd\x86xc\xaa7\xb2\xef\x9f\xb2\xdd\x0d\x82\x92%\xf0\xd2m\xb2\x9d\x9f\xba\x09\xc3\xce0\x96\x18hE\xc7\x0d\xd8$\xb0s\x8e8n>\xc5\xea\x04K\xed\x92fzj\xc1ex\xa0\x06\xbbc\xb63\xd9\x05\x9b\"\xa2\xe9\xa9\x8cUY\x86z\xa7\xbbZ\x1f\xe5\x86K@S\xaaU\xc0}\xaf\xd1Z\xc3\x7f\x9aw\xaba\xa2\xa4\xbb\xf0\x8b\xda38\xddu\x9f\xa2\xdc\xf3\xf3\xf6v\xea\x90.\xcc4\xa4\x09yk\x0bJ\xb8F\xb0\xe5\xa6~\xc6VNv\xd1\xbc\xbcpV\xb8\x18\x8d[\xe2\xca\x82\xc5*\xb1\x97iX\x02:\xa9\x1dr\xc5sd\x9e*:\x9f\xc1\x004|1\xec\xb3\xc7\xc4^}\xa6=\x06\xcd\xf4\x8d\xf4\xcb\x0e\xbc-\xd9\x87\xc4\xea\xe3\x7f\xd8\xe8\x03\xa6\xbd\xc2\xc78S\xc2\x8a\xb1\xe2'\xf6aFyq\xe0\xe1\xc7T\xe1\xab\xe9>8\xa9\xb5h\x87hN\x8cE4\x89\xe3\x01\xccA\xda\xeb5\xb5\x83\xc3\x9e}\x858\xb5\xd6\xfd\xac\xad\xd9\x8a\x8e\xd2\x9e\x85I\xed[e-\xf2f\x8b\x16\xf7Q\x14\x05\x1c\xd5\x05\x8c>f!\x88\xa7\"V\xd9\x1f\xf2HS\xb0\x05[\x9d \x18\x1b\x85\x82\xe5\x04.\xe9\xe8\x98\xaaw\xc7\xae2f4L\x08O\xe6\xa3\xba\x03\x84-&\xf3j&\xf9Wx\x8d\xb0\xc6\xcc\x07\xd6x\xcd2\xa6&\xe0\xbc \x03R\xe9\x12\xa8\xd1\x97\x1c\x9e\xcf\xb7\xaf\xba\xd5$\xaak\xe6\xd9#\x10\xf2)\x98#\xfc\x99A!\xf0~T\x197\x18\x9c=\xce\xe0\xce\x8co/\x9e/&h\x10\xcc\x8fO\x01\xc3\x01\x7f\x04\xdbd\xd9\xa2\xc7c\xc6crz\xa7\xf3\xa1[\xb3\xb5\x96\x12d\xa0\x1c\x05\xdaO\xa02<\xed\xd6W\xe5\xb5\xacp\xdb\xe75\xe5\xa8\x9b\xd5\xa3\x10\x16\xe5:<\xc5>\xa6\xd5\xcd\x06\x9b\xfd|AX\xd8)\xbf\x8a\xb1\xf7z\xdc\x0bf\xb8*\xb6\xf5\xd82 \xcaq_v\x05q\xa6\xc5\x19\xfb\x16&\xb2 ;{E\xc6C\xa3\xa92\xefah\x80\x8cz\xd7\x01\xf4m\xb66\xa5\x05W\xc9zYZ\\\x8d\xf7\xc4j\x0b#\xab\xf9pa\x84N\x0f\xdc\x7f\xf8\xe2\xc4\xbf\xf9\x14\xea\x09\xee\x04|\xd5pN\x14\xbf!\xc8K\x8a\xc3\xa5\x06i\xdb\xe1\x0b\xbc\x90\xd8=\xf0\xef\x89)L\xca2M\xcf#\x89\x19\xbe\xb0i\xac\x9e\xdb\xe2\xffTB\x83\xd8Wb\xcbk\xb6\x93p\x04>\xc4\x881\x8fo\x8aHu\xb6\xf1\xcc\xb4\x11Z\xb8\x8e\x0d\xdf\x93\xd07\x00$\xe4\x19\xbe\xd1\xd2\xcc1\xe3\x0c\xdf:d\x8fc\xce\xe0a_\x19\xd8\xae\x98\x17\x18A\xd5\xb3f\x84\xbb\x16\xcc\x02a\x0b$`Tl\xe2\xa4\xf8\x02\xb6\xa8\xe5^H z&=\xd9\xc1\x14\x95l\xa7\xdb\xbd\x0f\x04\x8dEf*H\x13\xdc\xe7\x195\xb7\xf9\xb5\xf2\xbe+\x05\x9e\xcc\xb4\xea\xfb\x02q\x0f[\x8e\xc3\xa4WM\xa8\x98[\xc6P\xe3\x11\x86\xa5\x9f.\x04\x82\x0e\xdb\xec\x8b\xd8\x8b\x86\xc3\x17_VXG\xaf\x06\x8f\xe0\xe3\xa5\x10\xf6\xc7\xb5F\x9eMm\xf2\xc1\x8e\x1c\xc7\xbc]+\xd0+.E\xf1-\x86\xddy\xc34\x87\xd3\xe9e\x13QR\xa5\xaf\xe6:\x98a\xf7\xb2\x0f(\xb8\x13\x8c\xa8\xb1\xc9\xea\xaa\x03\x0d\x86\x89\xd0\x04\xad\xe3L\xa6\xca\xb3|\xcf\xe1\xf0\xa0\x94\x0d\x84\"\xfd\x09n\xa8)\xe4?3\x9e\x84\xe4\x086\xb8X\x10\xc8\x860Q\x0f\x12\xfd*\x10\xd1\xe1\xf00\xef`\x06\xf06\xd8q\x98E\xde\xf0\xa7\xa6\xfb\xb6J\xb3\xf7\xf3 \xff\xb5^\xe7\x7f\xcfwm\xfd\x9a\x1a[\xac\xa1T\xd8\x0e<\xc3\x1d\x81\xad\xcc=\xee\xf4T%\xc0\x95\x00\xe6\xdc\xba\xe5P\x88\xf0\x02\x96s\x1a#\xdc\"\x9cqA@V8\xfc\xe2$\x8e:{\xf5(\xe7\x05e\x96\xb3X\xc1\xdf\xe9\x7f\xc2\x16+\xdd\x17i\xed\x0c<l16\x08\xa25IU\xb0(`\xd8\xc3&l\xb12<q\xa7\x80E\xb2\x94\x93\x8e\xd2\"\xa6\xe9\xb2/\xcd\xcd\xa2!\xc0\x97\xa6\x7f\x92\xfb:\xb2\xdb\x98\xda\x8b\x928\xa1$Q\xdd\xbaBc<\xbb)S\x87C\x1f\x91Q\xf0\x15X'\x17\x9ay\xfd\x14\x80\xebh\x04F\xda\x947\xa4Z\x19\xb1\xaee\x0du\xed7TK\xbb\xed\xe3v\x19\x0eO\x8a\x9b\x0cG\x1e\xb3\xba\x87{O\x162\xe3\xc4\x83\x18\xdb\x0a\xf5\xed<\xe2\xc7\xf2\x8b\x0b\x0c\x9euN$5/\x96\xb0\xd7ow\xddZ#\xd1\xe1\x03\xa6h*S=\xc0\x86\xe4E\xac\xa5\x09c\xa2\x1f\x8a\xe3\xceV\xb4\xb20;\x96\xe0D]\x16\x88\x0f(\xb8\x83fx=\x08+&=\x08\x0b6\xe9\xfa\xd6\xb9Z\x1c\x9c\xe6\xc8V\xb9\xe1\xebZ\xf9X)Z\x1cK~\x16LX\x8a\x8c0Dy\x17\xc3\xf2\xd00=\x12JtNA\x9e)\x16\xe9,m\x8d\xfc{\xe5\xa8\x9a\x86\x07\xfd\x833\xeda\x8e6\xf7\xb4\x8d\xa7\xe8\xac\xf8\x1b\x1aFF&\x88\x0bk\x1c\xe4-R\xd0\xb8m\xdb\xd8\xfdf\xd3F\xb3#\xac\x98O\xa2\x8a\x93\xb0\xd2\x83\x8fPM\x10+\xd3i\xcf\x09\x05\xfa\x826\x99\xe9\x80C\x13\x18/\x9d\xa15\xc7\xae\xf0\xd6\xf8*\x12\xef\xb7\xa1F\xcb5d\xbf\xf9\x1c\x13\xf7`X\xa8]7\xe1xI\x08\xca\x91\"GM\x9c\x0dp\xd8V\xe9)\xb1N\xdd\xe1v\xd2\x8c\xd5{\xa6\x16\xaf\x89\xa1y\xcc\xce\xbe\x94]C\xa5t\xfc\x1f\xaf\x06~\xe8h\x17\xffDE\xf0\xae\xc0=\xac8\xc2Q\xae\x8f\xdf#\xf9><8j\x97\xdf-a\xac\x13U\x18\xb8\xab\x8b8\xc3\x10\xfe\x9a\xe3;\x8e\x12\x060\xcaq\x16\xa7\xb2\x0dy2/\xe6\xfb\xa2\xf8\x17p\xb8p\xf2`\xa1\x0e\x11\x10\x9b70>\xfc\x05Gq\xe0/\xa5!\xd9\x9dG\x18\x1e\xe4\xe5\xbeZ\xef!\xd9~\xacL\xbd\x86\xf7\xbd\xb2M\x84\x89Pidbe\xb2V\xb8\xb5\xa6\xf6Uy\xf7 \xc95\xacwj\xeb\xd4\xd4Gg\xd7\xa6V\xc1\xa1\x04\x85\x87\x13\xf2+\x91\x16\x11\xe3\xf1\xd8\x13\x93\x94:\xb3\xc6F-3q\xec?\x02Z\x0fv\x0do\xd2\x9eV\xec\x98\x93\x0eb\xb6\xf6P\x0f\x88\x8db\"\x9c\x90K>\xce\xdf\xd8\xee\xa1bGp+;(Om\x11\x98\x09h\xef\xae\x06s3DB\x92pH'\x97hfQ_\x84\x7f\x0a\x1f\xc2\x13\xbb\x83\xc4f\xfa\xd0B\x03\xd6\x14\xb9#\xcb\xa9{\x9fl\xd5\xec4\x98\xe3lu\xd8\x82\xa1\xc8\xe4\xe8\xc6\xa9\x9bh\xad\xf1^3\x1ace\xd6x\x99\x1em\x83\x14H\x8e+\xad\xd8X\x8d[\xf0\xdd\x91qS\xa6>\x17A\xb0\xdd:n-\xbe)\xe3v\xaf|\xc5\x04\\\x9a\xcep\xba\x0f.\x958\xe6\xa7\xac\xb4L\xbf\xe3-\xf6,\xd4\x1f\x1e\x9c\x97\xdf\x02\xca\xe1\xc9\xbe7\x99\x0b\xa9\xd86\xfe\x90\xc3\xa4\x91\xe9\x82\xef\xcaVW\xfb|\xab\x85|\xae\xc2\x1d\xb4I\xce=\xdf\xa4\xfd:\xdb\xb4\x89\x87w\xbb8\xeb\x86\x96\x09\x91\x03?\xa9\x90|I;\x7f\x85L\x17\xd9l2n9\xd2\xc5\x0f8\xa2b\"\xd9\x8b\xf0\x988\xa5\xf9\xc8.x \xccuS\x0afO\x0c!6\xbdD\xc7f\xaa\xb4\x85v\xc7\x10A\x1b\xf4\xb8\xc9C\x97\xf22\xf8\x1b,\xc6}Eo\x86\x87\x16\xf7P\x87G\xc08\x90\xdb\xe5\xbbg\x89\xe1\x07\x1e\xd1\xb4\xee\x96\x03o\x11\xb8(\x0a\xf7\xd4k\x9ft\xc7e \x19\x0f\xacf\x11\x84\xac\x07\x8fn\xfa\xa2\xe4\xcaL\x98\xd6\x8e\x89w\x08\x1b\xf2\x00O\xa1\xdb\x94\xa4\x18\x98F\xbb4\xbd\xed\\}\xc7*G\xf0Z(G\x93\x01b\xe0*x\x838\xc35{\x89]\xf0\x06\xb2H\x80\x00\xdcq\x14\xe1\x07\xe0\xae]\xd0\x9dx\x0d\xde2\xb9\x01e\x8d\xc8\x89\xeb\xef\x1b\xad\xec1\x01\xa4+\xf22\xee \x04\xc2\xcf '\x9cj>k\xc7pJ\x1f\xc7*w" +
+	"\x0c\x18\xf7\xd5\xa2\x1d\xe8\xcd\xb4\x0f=S\xa1\xf6\xa1\xa9+\xed\x89\x84\xcd\xb4f\x15\x11$q\xd2\xb5\x96oa G\xbe\\\xccQ*\x9a<C\xb1\xa5'\x06\x14P\xe7\xf5,\xee\x18-j\xe8L\xc3\xa2\xfc\xd5\xeb\xe7\xcd\x05\xbf\xda\xd8\x8b\xde \xbb\x99yB\x8d\xa6\x8b\x04\xbfk\x01^\xe6\xd7\x98\x0aw\x9d\xd8R\xd2\x8dN\x0c\xa6\x17%\xab\x88\xc9A\xdd\xf2\x9f7\x17O32\xf1\x1cq\x06>\xb2jz\xe0\"^\x12\xe7\x04:hf\xe4k\xbc\x87\x8b\xb1H\xd0@#>\xefazA|{\x83N\x10\xfc\xaf\x17u10\xb4}\xcf\xfbC\xa3\xca\x00H\xbd\xacj\x8c\xbbk\xe3&[\xfaV\xae\xf7\xdd\x976h\xdee\x8a\x0f<\xf7p&\xac\x8a\xc2\x02\xa7\xa9\xb0[\xb6_\xca\x9c\xe3\x9a\x1bck|\x8eK\x97\xfe\x94Eo>\x1f\x97\xc6\xf9\x0e\x85\x1c:\x94R^d\x19\x1c[\xaa\x02c\xf6\x15\xe7\xbd\xd9r\xba6\x0c\xee\xae\xb9\xd0Hk\xd2\xab*\x0d\x9a\x0f~\x8e\xf50Y`\xa1;\x04\xb6\xad\xb1\xfduJ\x05\x87G\xc3\xff17k\xa5[\x84%D\x15\xc2\x99\x98NKPJ<i\xa1\x8c\xa0\x90\x18+kq@\xc0\xb4\x84MG\xde\xd9\xf7\x89\xb82\xe5\xba\xcf\xe7\xf2\x16\xcbrv;\xf4\xef\x86\x96\xdaQ\"{j\xbc\xd3\xaa \xf34l\x92[8\xa00\x05\x9fX\xd6\xde\xd0\xb8\x85\xb8\xb5\x1c\xb3\x1c\x08B\xbe\xf6\xb9\xec\xf4{\x88x\xeb\x81\xe1\xfdf\xea\xbe+\xdbuh\x13k\xb7\xb1\xf94\x81 \xcb\x84S_\x94\xf0\xdc\xfa\x87\xf3\x14\x07*\xf981P\xe3-<\x8b\xb5Or\xe0d\x1fm\xa3\xf0\x94\xf8\xa8\x9c;|}\xd1\xdf\x07\xdco\x0bHO\xbb\x96LW\xa6\x17\xf8\x19\xf3H=\xae\xcc\x91\x81\x7f\xa2\x9c\x0fY\x0f\xf8\xa6\x94\xce\x1a\xe7!p\x94\xbf\xc1\xeaL8\xbf1\xc2K\x1d\xbfB\xf5/b\x1b\xc6N\xe4\x1b\x85\xf7\xc9\xf7U\xb5EN\x94\x00t\x92(\x80\x90\xd5\xcaW\x8d\xe3}\xf8P\xbb\x81}\x1b\xe7\x03\xf9\xd2|\xd4\xed\x01\xf1\x9a<K a\xd7\x86\xb5scp\xc9\x11\x8c\xb2B\x9c<\xac\xf6i-\x13\xdaS<](\xba\x05x\x8c\x9b\x85W!\x04\xd0\xc4\x0a]R\xbf\xa1\xf0U\xa6\xa4\xb8u\xe5\x15}\xbdOX\xb8\x9d;\xef\x8a\x0b\xb3\x87*e\xd1{\x12\\\x0f\x8fN\x9e\x1f\x09\xde\xda\xb2\xee\xb1\x8c\x13\x7fj\xd9.gt\xba\x16\xaa\xcbfT#\xad\x84\x05f\x9a\xecU\x10><:\xcd\xb4V\xed\x04\x1b;0\x1bX(\xd6\xe6\x86\x10\xd2\xba\xb3[l\x0ag\x02\x93\x04W\x19Vo\xd6\x03h\x94\x9c\x14#\"-[\xdar\xf4;Ld\x10:Z\xb4.\xec\x86\x8dx\x06\xcb\xc9\xc9\xdc\x82\"\xdf\xf0%\xa0\x06\xcbn\x8a\xaf\x8e\xab\x1fk\xfe |\xfb\xfc\x0d\x84\"\x0a\x93R\x87\x7f\xb1\x01r\xfc\xe2O\xd0\xe2\xcf\xdb\xf2\xd2\xe2\x94\xa4\x1c\xa5\xe9\x1c\xf2\x9c\xce\xccD#qe\x11\xc1\x05\x0a>\x99\xef#-\x0e}/\x95\x8eaA\x7fN9{C\xbc\x1f&w\xa1\x0f\x84\x0b\xf6\xe4\x07\x1d\x1a\xfe\xd51\xed`\x9a\x87\xe34f;\x9a6.D\xd3\x1c\xb7\x07\xd7\xd6P\x90LHmq\xb9\x8e\xf7\x09\xaf\xe9\xa5\x9c\xd4\xaa\xfc\xe2\xd8Q:>T&\xd78\xd2\x9c\x0f\xab4\xe0\x9f\x8c\xc3cjL|P\xe3x\xc4G\xdd\xd9\x11C\xe6\xba\x07\xfc\xf1Y<\xd4~a+\x157\x92\xa7unL\x96\xf1\xb5\x0d\xb7\xa4\x8d\x9fi\xe4\xc8\xb2 \xa8\x98\xcb(\x93\x8c\x0d\xc3\xca\xa9\x86\"\x97\xa4\x84$q\x8f\xb6\xe6qV-\x92\xee\xa6\xac\xc8K\xde\xa2\x944\x0cl&\xea\x89\x94\xa4\xd8\xb2\xc8\xca\xa3\xb9\x8aZ\x87\xcb|\xa18*\xfe\xd3$\x16\x9c\xcc\xd6\xae\xae+\x1eu\"\xab\xf7H\x99\xaf\x19\xe7r\x1aL\x0c\xb5e0%iqi\x0b\x08v\x12x\x0f\x8fO\xbb\x84\x87)\xcc\xfc\x88\x0f\x0a+\xb3\xab\xce,\x1b\xdbU\xb7\x16q\xff6p\xd6\xa6\xf9{\xa4\x98\x14\x03\xb5\xb1\xf4\xaaB2\xa7m\x0a\x16ZI&\xd6U\xb8\x0d76\xb1\x11\x00\x9b\x00\x1cn\xb1B\xfc\x0d\xcc\x1bT-\xbb\x95\xb7\x134\xcd\x13i\x080\x86\xbcf\xb9\x86'\x98\x0d|h\xb6\xeb=\xde\x87\x05\xf3|uFD\x8e\xcf;E\x97\xad\x99\xe1Ba\x99\x1e\x88+\x0369\xfc\x96%\xb6\xd4\xb8\xfb\xc4\x94`\xc5\xc6\xef|.3^*\xb7\x8du\xe1s\x04Mwj\x9c\xbe0\xaf\x99\x9f:\xf0X>\xd7\xe6\xac\xbb\x8b\x7f.eg\xca)3Vm\xe9t\xe8\xa0\xc5\xf3\x927'\xc3=T\xaf\xa9va\xc5\x7f\xcc\x0c\xa4tP^\x19\xed\x1a\\\x9e\xb7V\x04\xa9\xb6u\x16\xf3dDu\x92\xf7\x0f\x0d\xf1\xe5\x9d\xcf\x06\x90I\xf79E\xee\xdd\xe4\xceCO.\x89\xe1y\xca\xf4\xd0\xdb``s\xc2\x1b\x8a\xc8\xb3i`pO\x19\xf9\xe1\xc9\x91\x1b\x05\x02\xbe\xc5I\xbc0^f\x07\x1aj\x81X\xbd\xb0P\xe6\x97F6bK\x84\x95\xfe\x1c)>\x1b\x8c\x14\x91(\x14\x87\x16\x19\xe6\xa5\xf8\x0d\xf1\x0aYT\xa2\xa3\xd0\xb7\xa0\xb1Ik\x97\x856o9\xe53sZa\x8e$[Hg\xafs\x8d0\xb0\xa1\xef9\xfd_'x~j<\xbdw\xfa'\xec`\xccrm9e\x92\xa1y\x87\xef\xd5\xb4Z\xe9:\xb4\x08\x9d\xea%z\x1a:\xea\xcc\xb2H:\x01\xed\xca\xea\x9aN34\xc1Y\x97BZ\x15\xc7\xe9\xcf\xddJ\xe7LGq\x8bjJ|\xb1\xc0\xc4\xbb\x89\xa1%G^\x17K$%M~5h\xbb:\xb1\xd6kh\xcb\xbf\x176l\xbeO\xb1\xc7N\\\x82\x12\xbc\xe9u\x98\xbb6\xfa\xaa\xb08\xed\xfe2\x9d\xb5s\xf5\xe2t\xb1C\xaa\xfe\x09\xfdtSU\xcc\xa1\xe0r\xd3N\xfb\xa0\x80\xfe\xc1\\\xe9#\x8c\x8cc&\xdc\x98\xca+\xa9'1\xd6h\xc5\x9a\xca\xf5\x19\x95aX\xfb\xd6\x86\xd8\x98\xa6\x87\xc4\xd5g\xe8\xbdl\xd8I\xb5\x9b\xbf\xab\xd2\xbaKk\x1e\xf5!\xb2(k\xd1\xeb4\x94\xa0]J\xc0\x96m\xbd\xa8_xK\xaa\xdc\x0b!\x927\x91d\xed3\xa0\x81\xaa\x85E\xd9*r\x89\x9bJZ\x19\xf7\x88\x14_v\x1e\x08\xcev(\x15\x83\xd32\xafe\x91\xa7\xea\x04-\xde\xc1\xca\x10\x9b\xa2\xfb\\R\xac\xe1\xe9\x8b]L\x09\xed\"\xc7>\xa6\x04LON\x05\xc4\x88\xd7O\x84AE\xad\xb47\xc4\xfe\xa0\x8aUR/\xc6_\xfe\x92\xd5e^pT\xbf\x8d^\xeb\xb5IOm\xad\x82\x97A\xcf\xec\x85\x96J:4{\x8bY\xc1\xca\x1c\xd6\x1bt\xa3\xc8(\xba\x0f[\x1a\x1b\xda\x11\x7f\x1e\xec\x96/\xef\xdd\x9aO\x0f\x9e\xe3\x0e>S\x10!wR\x0bR\xc9(N5sp\x80b;\x0f[s\xb9@\xa3Dq\xc1\xb0\x12\xdb\x09\xc5\xdfj0\x19\x19\x90I\x9a\x04\xb6\\u&\xca\xb3\x13CI\xee\xdc\x84\x05\xc2\xb0Ds \xbd\xf8'Y\xc2i\\\xee\xc5W\xb8\x0c\xd7\xda\xb2V\x05\xcc\xa78B\xea\x88\x1e\x83\xe7\xf4\xe8\xf9v\x7f\xc1\xd2\xb0E\x80\xc2\x04\x18\xa7\xddL\x80\xad\x02\x82\xb4a\x8ci=\xe0\x9e\x06\xb4\xf4F\xe9\xc9\xb3\xf6\xe5\xb8o\xef\x19\x94\x0b-\xf8'\x06\x9f\xa4\xa0\xee}\xea$\x1d!\xe8^\xbcP\x1fh7\xbe\xa2]P\xd9\x01\xdc\x09\xbf\xe4\xb4XZ\xba\xaa\xae\x0c\xbe\xf5R\x89\xdb]^\xaax\x82(\xd3\xa7#\xd47]c\x86Pc\xf9VO\xed\xb2u\xc4K\x0d\x8a=ol\xde\xc2%\xebQ\x8d\xa8\x0c\xa2T.N\x16\xcfd\xe93>\x04\x8a\xa1E\xe6\x82\xe9K%\xba\xd2\xf2\xa5\xd4\xec=\x87?\xbf\xc3-\xf2\xa18\xa3+@5m\xaf\xb9a\x0bS\x8a~\x8bS\xff!\xd6\x9fG\x00HL[\xb6`Q\x0d\xea\xd4\xc0\x09](\x03\x82\"H\xd8\xce9\x03m\x0b%x\xaf\xdb\xcavt\xa2R\x0e\x82U\x0aq\xe1a\x90\xc7r.8\xad\x82\xb58\x7f\xdc\xf3HL'\xe6\xfc\x07f\xe7\xae\x11'g.\x1bt\xac\"\xa0N\x10G\xbc\x14\xb3\xe9!\xf5\x1f\x9e\x9e=\xef\xa8\xb1\x08L\xd9\xdd/\xe0\xf5\x1e\xf5\xc50,\xc8\x9dw\xab\x15\xa7\xb72w\x18B\xb64\x7fh\x18.\\+\xf3\x10\xff\xc9{\xfe\x16\x1ec\xb6\xd4y\x8a\xe6\x80!\x8fa\xc0\x11\x99-\x8b\xb0\xd5\xcd\x02\xa3\x8b\x10\x14\x9c\x0bV\x8e\x8f\x82\xa5\x12Q\x8a\xda\xc5\x0a|\xf2\xe4\xa7\xb5\xca\xad%H&\xa7\x10j\x0b\xbc.i \xcc\xf6\xdc-\xcf\xb3LP\xe5\xba\x13;X)h\x1a5\x9c\xd8\xa2\x15y\xa0}\x15\x1d\x86\x86\xfe\xb5=\xb04\x97\x11JHd6\xe6<\x17\xc6\x1f\xd8\xc2\xc7\x1aQ#k\xf0\x11S\x9d\x93\x8c\xf1S\x86\x18\x17x\x13\xa7\xed!J{kf\xd7\xf6\x09\x1e\x0c\x95\xdc\x95\x07\x04\x15^f4\x1cT+\x1d+\x84r+x\x0aM\x02\xcf\xb4&\x07\xba\xca\xc4\xb4\x8d\x05\x81N\xf5\x04\x09\xcf\x0e\xf2V\xddA\xb4\x03\xfe\x0d\x1d\xf8\x08\xf1\xcb8\xa2\xa1\xa4\xe6\xb0\xba/D\xd4\\A\xe4W\xb3c\xce\x0e\xbf|\xd1\xc3\x00/\xd4C\xb4W\xbd\xee*\x11kK\x89\xf5\xcdN#\x07Zb\x1b\xc6\xba\x10WS\xacl\xa2\x10W\xf9 =K\xad\xda0\xb2\x7f\x8bl\xc5\xb8}\xd0\x8b\xa0\xa2\xf2 \xd6\x85wH\x01e\xc4\x03!\xcc9\xcd\xe6d\xa6\x88\xe6\x11\xea\xf7\xd0\xee\xde\x15j\xb6bB|\xbc\xe2I\x0e\x183\x8amx\xc1:o\x99\xefp\xe8\x0cKk\x82\xe8\xa4Y\x10\xab\xd4\x0d\xc6JQ\xcb\x94\xeaf\xe5\xbc}\xa3p\x16_\xa6b\x82\xd5b\xc3Z\xd3\xccj\x0a\xd2\xdb\xa7\xc7\xdf\xeb\x80\x90\xd7<\xa3\xd0}l\x92\x87dj\xb6x\xfc\xbe`\xd8 \x08\xe1*vM\xb6\x1b\x98\xc9\x8dMK\x97.\xad\xe6\xa0\\\x01\x15Y\x96t\x99\x1eK\xdf\x11Q\xe7\xa2\x9d.\xc7`F\x9a\x9a\xa5\x0cj\xafl\xde\xf0\xac\xcd:OP\xb6\xb1\xf2&6\x18!nx\x13\x03X\xf6\x1a\x05\xe2S \x1a\xfeq\x0f\xfe\xfa\x952\xbeme3\x07W\x95+\xd8Q\x1a\x14~\xe4\x13\x96\x8e\xa9?\x0d>\xafY\xc2J\xac!b\xd6\xf5\x1e&\xf9\x14nU\xd7^\xd56\xed\xe6A\xa6\xd8(\\3\x8e\x8d$JI\x9aq\x86\x19\xc2%\xc1\xa1Zl\xcb\xe6\xab\x19\x13-U>H\x89\xbf1+\xe8\x0el\xc9i\x81\xa6\xb8 \x04\x09\xbb\xc1\xc8\xa9v\x8eT\xb8c\x89\xa2l\xb1\x8e3\xb1C\xc3*|\xd2x\x09o\x8b\x06\x07\x86\xb7\xedRvS\x06\x0a\xc3_1V7\xb9\x09O\x1c\xc1Z\xa6\xb5\xe2\xb2\x9e\xe6\xcb\x0d\x96\x9b\xf2\x97\xa47\xc1\xc5K+\xcf:A\x83qi\x03I\x8d-\x00l\xbf)\x0f\x81\xd4\x88\xd5\xd6\xb2\xb5\x15\xf4+\x96\xb7\xbc\x17\xa2\x1c\xf6\xe1\xad\x9e>k\x9d\x98\xa8\xa2\x86\xbd\xa9\xb8\xc0(~X\x90\x9c\x99\x9b}\xe7\xc9Y+\xa5\xcdn4U#\xb1\x0b\xa3D\x04&\"g\xc5\xb5\xc3=\xffJy\xda\xc4\xda\x85\xcd\x0c\xe6\xa6\xbd\x7f\x06K2\xcf+\xc8[\x0b\xb5\xf5|\xdf\x8d\xff\xdc\x9c\xe8\x82\x80\x85\xadC\xb3\xdd3X\x7f\xc4\xa4\xc1\xd0\xf9\x05\xf8\xfc\xabl\xc7'\xdd\x12\xde\x0e\xac\xeb\xb9\xe4#kn\x19JRw\xd5r@\x08\xc9\x9a\x95\xdb\xcc|M\xac\\\x85#\xa4\xe7Ns\\\x16\xd4\xcf\xae\xe0\xd5\xac)\xb3\xcb\xc2\xac\xba\xed\xea\xa7\xf3L\xf0@\xc4c\xbb\x10\x0c.\xc4#?\x10\xdew\xe5\xbdg\xb4\xbe7\xc7\xf8\xbc\xb9\xd5\x94\x0e^\xdaa\xca:\xeeZ\x11\xb7\xa5\x10:\"\x94\xae\xe3\x051p\x0a\xaeg.\xe4\xea\x8a\xe6\xa3\xab\xf5+C\x15\xc5,l\x94\x7f\xd1M\x02\x9e70\x88\x0fy\xe1Y&\xe5P\xc4@\x19\x028\xf9\xe1X$\x088\xd7\xde\xfa2{Q\x0bJ~\xa7\\\xb7\xfd\xec\x8b\xc3\xf3\x83\xbc\xe6\x02\x19\x1cf\xa6b\xe2\x99f\xf2\xf9ai\xb2\xc4\x99yS\x8b\xfb3\xecv\x19\x04\xfa\x9e\xf7\xf1\x89\xf6B\xd6<\x0eX2\x8b}\x01o\xbc\x03a\xa9$\xb0\xc6\xd8\xf2\xc3\xb3\xec\xfaFV\xfc\x9a\xeb\xad\x8dK\x9f\xbb\xb8\xe6p\x1c9Y=uI|\x8dK\xf9\xb7\xe5\xa5\\S1w%\xb2\xbc\x05\xf0R\xbf;h\xa9e\xf1|\xa7\xa7t\xacq\x9d\xee\xa2\xf2\xa3\xf2.c\x7f.\xc2\x88M8\xdeEU/\xa5u\xef\x96|\x9f\xbe\xee\xd0\x92\xd1\xe6'3n\xc1\xf0O\xa0|\x0dW\xf9,JMxTf\xf7J\xe0al\xf2:\x83\xdd\x00\xd2\xc2\xad\xbe.&\xf66qlfv:}'\xe1\x93\xfbm\x15n\xe9\xe1\xfby]T\xb3\xf9 \xc0\x965\x18&3\xe4\xa0\xbf'04\x04\xb1\xc4\x98\x8f5\x85\xb4\x94K.\xb5\x0c^\x98 TR2%\xae\x16\xd6iK0\x89\xba\xca\x9d\xca\xe1f\xbf\xfe\xa1W\x9b\xa09q\x832\xb6\xd2\xbc\xeb8\x09\x8bp\x8dm\xc3\xcf\x8c8\x03\x8e\xd6\x06\xa2\x0eWbt'@\xe9\x96Nq6\xe7Q\x0d\x18-\x05\xb4\x8aY\xff\xdd>\x1e\x8fM\xaex\x07d@\"\x9fl\xfaa\xa8\xcf]VY\xfe\x1b\xe3\xa2\xc5\x99\x9a\xc1\xa0\xc3\x0d\x9c \xe8\xd5Up\xffZ\xf8\x1a\x98\x13~\x8d\xda\x17\x0d\xda\xe7f(`\x1a\xa2\xe9\xaf:\xa1\x9bX3\xe7%\xa0\xd0\xef\x89\x94\xd7w1\x03\x9c\xad\xde\xa2aG\x94\xeak\xd7\x01+ \x07\xbdw\xc7\xc1\x9d*\xfb\xbd\x83\\\x11\xad\xf1\x0bT\x1a\x97\xbe\xc4\x10@g\xc4\xad\xd8Ygy\xf0^\xf7>\x85\xad\x91)e\x9c\x06$\x05Y\xe4AG3\xfbG\xe1\x83\xd1H\x058<\xf5\xb9c$\xb1\xde\xa99\xb6\x01gY\x983\xf4C\x03\xb5\xb7\x8elNGnX\x7f\\\x15\xc0\x87\xb0\xe8\x94\xf0\x1c*C2\xb9!i\\\xc3\x073\x80l\xb4\xc0\xab|\xf0\xf8\x1d\\\xb6\x98\xf4\xc9^\xf0\xd9G\xfb\x81\xb2%\xc7\x91efR9[\xe2\x1d\x90c\xae\xd0\xb1qL\x9f\"d\x99R\xdac\xd9\xc6\x08\xdd\x02\xd7\xc0\xd0v\xc0l\xb8\xceL\xb8\x1a\xde0\xf2\xb9Pi\xbf7sl\xf1\xb5\x87\x05\xc1\xb5\xe7\xb7\x02\x0f44tZ\x8d\xb0_^P\xda\xbfE\x8dQl\xdcA!_\xc8\x9e\x1d\x92\x0c-\x8d\x08\xdaF\xe1\x87\x1a\x97\x85\x03h\xcb\xd3{\xd3\xb18\x0f^x\x0a\x9d\xd2\xd8\x8ebc\xf6\xf8b\xd41\xac\x88\xea\x1f.\xc6$\x99\xab\xf5\xcf\"\xb5\x0aB\xa5\x90U\xc7e\x93\x96\x83\xb4\xdf\x96\xae\xc6J\x83\xc9\xfb[r\xd9\xa7\xc5>8\xda\x8d\xff\xda&\x9at(\x0b\xc3\xd4\xef>JD\x14\xfa+\x07\xc7X0\x81\x09\x9bPv=N\xe8\x07\xe1.\x99\x9d\x1dK\xf6\xb8\x1d \xc3\x0c\xe8\xeb\x81\xa3\xde\xb3\xb08\xa8\x88\xdf\xc0!\xae\xf2\xca\x0d\xdapN\xb6\x95\xb3\x95\x1b\x05!\x16\xfd\xb7\x11L\xaa\xfbb\x03O\xfa\xd044\x9e\x0e!\x85\xfa\x193a\xfa\x90\xc47M\x09\x00\xfel\xa0\xaf\xa0\x0ce\xca>\xa7e\x95\x89\xb3\xce\x06\x9d\xee\x1e\xe1\xf7`O\x08\xeb\x07\x07\xb0\x08\x08\x895\x80^;^\xfe\xa9\xb5\x89\x9a\xc3\xebj\xc9\xd93\xeb\x1b\x88\x0c1\xe3\xed\x9b\xb0\xd0\xfdH\x01\x0a\x7f\"\x9c\xd6\x81!\xb7%c\xe1]z\xef\x96\xdc\xb9\xa9\x97c*\xaf\x0c\xdf=\xea\xb3\xbd\x84\xdf\xa5\x9b\xdb\xe5\xc0p\xc6\xce\xe2\xc4A\x80\x8d\x1e\xa3H\x9eeH<\xec\xc0\x8f\xe9/\x13+\xfc\x94\xda\xad\x11\x01\x9f\xc8\xc7\xa3P\x8a$X\x91\xd6\xa1\xcc\xa0@\xf4\x86\xf7Z\x0cf\x15\xb7u\xe5\x8a\x85\xc5\xd5\xa1\x1fHG\xa8-\x07t\xac\xd2\xd8\x9fW\x86\xd6\x18I\x8d\xa9v\xe9*\x87\xd9\x94\x06gVB\xfb\xefe\xfa\x1e\x18\xc6\xd8w\xe2\xcc\xb7!d;vF@\xf3f\xb2\xe7%\xcdG\x8f\xdcT\xef\xb5\x8e\x17}\xd4\xa8\x8e\x07\x1c\xe9\xb2\xb2R\xf1%\xe3\xa4z\xceFC=kj\x98\x19\xed.O\x02\x92\x96+\xd4qJ\x8cI.dfa\x1f\x15I{4\xa4v\x10\xa7\xe1A\xfe\xd4\\\x14k>tbm&\x19I\xac\x97\xa4!V\xd8\x02\xfe\xc6\x12\x16\xf0\x97\xfcE\xa1\xae\x81*x\xfb'R{\x95\xabD\x96K\xd8f\xc5\xe1\x1d#>\xe9k{e\x0bVY\xa6\xc0\xef\xc4_\x87\x19\xe0`x\xe4\xe9,n\x85]\x81\xfd\x14\xdd\x96\xad\xcah\xad\xfa\xd4s\xda\x03\xdb@K\xf9e+\x1f\x8c\xad\xd2>\xed\xc1\xc4\xbcK\x8e\x1a\xb7\xc0\xabMd\xf5\xfe]\x82\xaa\x84\x01\xa8\xf0\xb7@Yh\x8fdQ\xadh}\xc7\x89\xb6b\xc7\x1dwD\xdfc\x95\xacK)I`W\xf2BBc\x14\x9e2\xa7\x0a\xf9\xb0\xa3}\x90\x13_\x02\x8e\xda\xe1C\x8fZ\x04\xe1\xb3>\xb4\xc3\x81!\xeb\xed\xc2\x08" +
+	"\xad\x98do\x86X\xdf\xb5M\x87\xa5\xfc+I\x17\xa2\xb8\xfc\x9aB\xa3\x16\xee\xeb\xd3\xe7\xa5\x1fb\x19B\xe9g\xef\xdd\x8f\xda\x02\xd6.\xa9\xb8\x13:}0<\xed\x18rM\xb8\x94\xe2\xac\xd2\x0b\xb4\xb8a&\xe4\x1f\x12\xeb\xc4 \xd6)\x12Fw,\x85--\xcfi\xdd_L\xcdR\xa6\x9e@\x11\xe7\xfc\x18\x13;\xb9*O\x9e+\xa0\xeb:\xb2\x05\xc9\x8a\x81O\xebr\xaeD\x96+\xb1\xff\x8f\x99*\x05\xa7\xb7\xa5\xc2\x00?\xff\x09\xac\xa6Zk\xf7\xe0\x97i\x0b\xb2\xb0\x02s\x83\x19\x9f\x02+3\xc7\x16J\xe5(\xd8\xb9Tm\x02\x02\xcf\xbeD\xa1\xe4l{\xef\xba\xfe\xba\xf2\xcf\x07\xc3\xb3.8q\x979\x90n\x0b\xb5O\xf4\xcd\x8d\x05\x1av\xd6\xe0\x1a\xca\\Q\x0a&u\x90\xb2a\xbfO\x04e\xb8;\xe3xgU\xca*\x0e\xb8^\xc8L7\x18,;\xe91\xfa\x926\xa6,)\x09W\xf2\xcf\xdctT\x05\x1e5\xb5\xf6\xda\x85\xc9\xee\x1b\xe1l\xe9J<\xdb\x0c\xbc\xd6Yy\x18\xd3\xa8\x07\xdcq`8\xa2w\xcf\xb2\xae\xfe\xb4\xfb<\x92B\x13X\xf5V\xca5\xeex\xdf `\xde\x1dk\"\xd2\xc4L\xfb\x00<o\xda\xb9\x11\xd2`\x91\xd7l\xb8\xe3\x10\x8f\xc6JA\xef3\x11\xca\x09\xac\xa6)\xbb@\x97\xcfvr\x0e\x86\xed\x82\xf3i\x1c\xb3.\x87\xe1\xaf\x83\xee\xd7\xed\xe4\x17\xe1\xa4VBQ\xf8,\\I\x87Th\x88\xe2\xcf\xaf\xe4%\xe6\x18\xe0\xa0F\xefz.\xce\x09\xff\\\x11\x12b\x04+=\xbb\xf8\xe4\xfc\xb9\x12*\xd4\xf5y\x8fU\xdd\x0e\x8a1\xb5\xa5\x904Vp\x9d\xbdJl\xb1\x0c\x1b\xef\x0cF\x9d\xd9\xfb )\x94\xe9\xa08^\x96E\xb3k\xc6V\xe0\x0c}\x10\xccA-\xe9 \xce\x1c\xbe3\xf6$A\x7f~v0\x12\xbaA\xdd\xc2\x8a\xb8\xc1\x00FcL8ph\xc8~\x86J\xe03q\xbc$\xce,UW\xe5X\xb2\x89\xfcpm2\xfb1.\xc1\xc7\x0f\xeb\x88%\x18\xc6n|\xc5_\xf8H\x84\xd8\x13\xa3}\x15\x07\x01_\xf0\xf4\x98\x82 Gx\xcb<g\xdd\xd2/\xcf\x0a\x14\xa2\xcf\x9f\xae\xc6\x8b\x94\xe0`\x87\xfc\xe2\xc1A\x9b\x1b\x82\xf8\xbb\xb2\xbc\x03\x84\x8f\xae\xb0\x99\x8e\xf2J\xd2\x1er\x92\xad\xa4\xe1\xf0\x87\x96\x93\x17\x9c\xbd\xfaY@\xdc\x03C\xd2\xcdJ\x86\xe0j=d#OU\x0f)\x11\xa3\xe1_\xd3j\xa5\xac\xc8\xee\xb0\xcc8\xeaX\xe4 \xdbb\xf5X\xbd\xedi\xac\xda\xd1\x13\x0b?\xfd\x85-+<\xee\x08#G\x02\x0b\xeb\xa4\xd5\x83\x94+\x94z\x94\xb4\xdc\x889\x91Xk\xf9s\x90\x10\x07\x9e\x9f\x1a\xd3N\xefl\xa2\xfa\x09\xe7R\xfb\xee{\x0d\x90\x01f\xd1\xfc{\x02\xf3\x12\xce\xd9\x86_\x10\xbdq\xd5|\x9a\xdat,\xb8g5\x11\x8bP\xcc\x96\xeb\x18UU\x0dk\xb4\xb8BKK\xd5m\xc8?\xf5CX\x10\xd5\xbc\xe4\xbd\xf4\xa4\xd9\xaf:\x06\"\x92\x84\x17\xc04=z\xefk(\x15a\xa4\x1c\xe1\\(\x93\xbf\x0e\x0b\x01\x11\xf1\xd1N\xc7\x0b\xfe&+O\x94J\xdai\xa9\xcc\x01\xe6\xcf:\xdch\xa1\x0d]z\x0f\x9f^y\xf9\xdc}N\xe8s\x97\xfe\x95!\xaf\xc0._\xd0\xee\xcb\xce'.\x0bn\x0ek\x8b`\x82\xe6\xd6W\\\xb4\x86\xcc\xee\x11s\xcb\x8a\xafj\xce\x02\x802\x05\xa1Y,\x14\xe0\xf6\xf87\xab\x1cp\x94\xa8\x80E\xe4\x84\xf0\x88\x03\x1e{\xfc\xa6\xccA\"\x99\x0e\xfc]jDr[V\xe4D\xef\x84C2\xa2\x86\xd3\x15R\x8b\xbf\x0d\xf7\xc5\xdf\x15\x15.\xfa\xe5\xb6\x0f\x0e_\xe4\xe1mXrL\xf7\xa7\x18\x91A+\xa8*b%h'\x0dPt\x13\x15\xff\x1b\xf4\xea;oY\xec+\x1e\xb0\x15\x13\xdb\x08\x9c\xe6c\x9c\xea\xf7}CQwwk\xc3\x11980-9B_\xf7\xb45\xe4\xf1mD\xef\xdc\x18+\x88\x94\xc15|\xc3k\x15\xd5\x03\xd2\xc1L\xb3\xb4\xbd\x1c6\xb0\xe5\x16\xbeVk\xc7\xdd\x01\x03ak\xe2\xe34\xc0+\x99$\x18\xcd1\xe5G\xc0\xa7J,\xaf\x96\xd9\x9d\x13'\xbc\xb6\xc4\xad\xd1~\x9b\x85wt\xf3\xa2\xd0\xc9 \xd7\xde\x1e\x1bb\xc2y\x97~e\x1fYW\x03\xeaT\"\x88\xfe9H\x96\xa5e\xac\xeb;r\x0e\x0f\xbex|u\xb5~ X\xa0\x9e3\xb00\xf0\x07\xefP\x1b\x81N\x13\xb6M\xd3@~0\xf6\x8dU\xaa0\xd3\x84\x1dR\x08s}4\xe2\xb5-\xe8\xd53N'\xbc+lrP\xdb\xfb\xda\x02\xeb\xd1\x90\xfd*!\xb2\xc3\xc3\xecX\xaf\xa5\"a\x85\xb1\x88\x0f\xa4\xb5\x843\x92NFX\x12T\xafI\xf8(\xfcc\xc2\x88`8'u\xd7\x902\x02\xc8U\xa20\x8d\xd78\xc62\xd9{\xd1\x12H\x05\xf13<x\xc6\x00\x94_u\x1c\xa2\x02\xef\x84\x9c\x01\xa9\xe7\x08m\xff\x86$q\xe82x\xfcZJ\xdc1k\x95\xde\xec^p\xd0\xe9?ys\xe4\x9a\xac\xd9\xa8yr-\xa7>\xafig-\xa0A\xf7\xc8\x1c\x16[\x96\x9fZPa\x80\x84DP\x86\x0b\x0c\xbd\xfes\xab\xd6\xfb\xdf\xbb\xd8\xff\x86(a\xb7\xbc\xf0x\xd0\xc6\xb1\xea\xba\xa7-$$\xa5\x06\xe6\xe1\xd3c\xbcX\xa3\xf9\x19K\xff9\x0a\x96\x07\x87G~r\xaa\xa5\xbd\x92\xda^]]ul iU\xbb5\x8d6K\xb7uZ\xb2\xc7k\x02\xff-\x8c\xab\xda\xb3\xbd\x14\xfaS,\x0b\xdc\xec\xc5\x96t\x8f.\xf5h_O\xdd\x85egO\x1d\xe7\xde^_\xa3\xc8\xe9SB{\xaa||\x02\xfa#\xb7\xb1\x12H*\x8e\xe3T\xf1\x9c\x8d/\xc9\xe9,\xe8\x81\xe9(\x01\x0a^\xcd\x8eR\x90Urxo\x86|\xb33\x12=\xa7\xe1\x85\xacP\xee6\xc7\x06c<\x9e\x94(\xbe\x97\xd7\x89c\xec\x8d\x12pV\xe9\xd9ph\xfb'\xb1n\xb5JV\x93\x15(%\x97\xe0\x81\x81z}\xf7\xda\xcb\xdb4\x92\x0f\xdd\xcbW,\xb9\xc2\xd9h\x195\xab\xb0\xe1\xaeFk\x1f\xd1\x9f\x9c\xe2\x9c\x097/\x06\x05j\x12W\xaa\xb5rK7\xec\xb4\xb7\x02\xe5,\xd3B\xbb\xafS\xc2dm\xbe\xae\xa0\x97\xb7\xaaR\xb1\xa3\x96\xb8\x0f\x8f}p\xd8\xf2u\xe5\xe5m\x0c\x89Y\x16\xe6q\xea_\x1e#4\x04}\xa5\xd3\xb2\xf5\xf2#\x00\xb4\x8dx\xa0\xe5\xd2\xcc\x85\xd9\x0a\xd3q\xac\xe5o\xe3\x00.\x01\xa5>\xeb\x98\x91Z]\xcaj\xc7\x9c=\x1c\x10\x94\x85q\xae\x12\xf8Lo\xc1{.i\x82/\xf3\xf4\xcc\xe7\xee\xb8\x9a\xed\xb8\x05\xa7\xe8c\xce^dy)3\x12S<\x107\xe1\x09\x9b{!\xd5\xb2\x96\x84h\x8a\xf0\x91\xe2\xc3[h\x1e\xf6\xf4\xe2\x88\x9dd\x81\xad\xaf!\xb5\xb0\x88S\xb7K[\xd8y\x17\x1e\x94\xb6\xc0/d\x9c=8z\xf1u\x0e'\xad\xc2w\x86h\xe5V\x8c+]\xab\xe3D\xb8t4g(}\x96\xbaj\xf7\xe94\xd8u<q\x0c\xa6t:\xed\x13\x898\x1afR\xb5.`D\xef\xf6\xc5\xbc\x02\x07\xaa\x06\xb1Gn\xc8F\xd4mf\xa8\x8f\xe8\xe9\xe0\xe8\xb0-S\xbfvC\xc2\xe0\x09\xcc\xdcI\x96\xfe\x1e\x9e\xb6fV\x9e\x19\x85,\xe85\xd3t\xb0<\x0d\xe0\x08.,\xad\x97b\xa5\x84\xaf\x9e*C\x01\xbd$jd\xf6=\x93\xab8\xe0]\xa1$\xfb0\xe1z\x85q\xb8\x0ek\xf7%\xc5w\xfa*\xa0\x0f\x8e\x8e\x9eW\x9b\xd7\x7f\xfc\xed\x13x4\xac\xfe\xa4N\xb7p\xe4_\x17H\x12g! X\xdd\x02\x07\x08\xb5\x0c\xbd\x0e\x14O#\\\xe7\x87\xb4\xb2\xd0| M\xfc\x1c\x9e\x95N\x0fLk4a\x99\xf65F\xd85\x06Z\xd7\xd9Q';fM\xac\xe2$\\r\xd0\xa6\x88\xb7s\xd8&RP2\xca`\"\xe7N\xe5\xdf4mX\x1dO\xd8l\xa1,o\x10\xa7<^\x9dcE\xf3o\x06s'l\xd8\xf2'\xc1\x8d>\xc0H\x98k\xea\xc7\xcc\x09\xd3L\xc1\xc4OM\xb4ow\x93\xf0\x9c\x96\x0a\xc9ikqM\x99\xdc\x16U\x06\xde\xf2\x90OZ\x84\x17\x06r)\xcdVv&\xf6\x18\x95\xd0\xcb\xe8J\xe5a\x14)\xd3\xeb/\xf7;8j3>*V~i\x04\x05\xdd \xdc\x0ft\x08\xa1\xa7\x9f\xa8\x1bWf4\xb5k)\xfbd\xa9\xe9g2\xc3\x94:wj\xd5\xda4\xd6\xad\xd7\xe1\xfb\x12R\x0c\xf3'+\x00mK\xd1\x82rB\x19u\xd7\xb6\xb4\x1e\xd2\xd2\xb9*\xc2w\x189\x18\xe0:\"\x89x\xa7eL4\xe0a#\x7f\xb0\xb5\xfd\x0bK\x88\xa5\xde\x98e\xf0\xb9X\xfb\xadf\xb3k79\xdb{DX\x07\x84\x9e\xeb\xd1\xbbc\x91\x0c\"\x8a\xe5\x95\xc0\x15\x89cDz\xcdp\x12-\xd39\x86_\x90Y\x06\xac<o\xe2D\x17x\xac\xf1o \xd7\x85\x01\x85\x18o\xe5\x86Up\xc0\x86\xd4\x97\x8b\xd3\xb8\xe1%n\x92]YC#\x12!K(U\xd6M\xec$\xa4\xb9\x7f\x13\xa7\x18!)\xcd\x85X\x91rp\xc3\xc1\xc2)\xe5\xba\x98U|\x1d\x962\xe5\x88\xe9\x02\xffn$TJ\xf3\xf0\x09\x8cW\x8eX\xd3\x14\x80\xed\xd2\x14a-\xc2'\xe8\xce\xd1 \xd1\xa7\x01\xf8\\,\xef82\xdc\x0d\xf4\xcc\xbe\xf5\xce\xaf\x0e&g\x1d\xde\xb1\x8e\xf7.\xc0\xa2\xdd\x95!X\x19\x16P\"iE\x93(\xd2\xb7\x0c7t\xf1\x0a\x89\xe1\xa0/\xe5&\x19\xaf\xcdG\xcb\x841syao\xd9\x0e\xb8\xa2\xefm\xb8\xd2w\xbbJ\xe6\xe2\x04\xf3\x9e\xf4\x95\x1ey\x0c>(\xa2\x8c\xf6\x95\"88\xf6\x02\xeb\xa4|\xb3\xe6\xf0\xf4\xa4\xffu\xb9\xc2u\xc9\xa7C\xb2\xf7?\xd9\x9d\x0bY\x03q\xaf\xbf\x867\x017\xe9>,\x8c\xfc0\xa9[O3\xfb\xf1B\x81\xd8\xfd\xf5\xe9\x07\xc7\x96\xa4j\x86\xb1\x04\x8e\xfa\xb6:\x89\xa0u\xd0\x86\x1aV\x82\x7f\xc97p\xc8\xcc2\xd0u\xc5\xfa\xb9^\x03C\xaa!ik0\x1b\\\xba#\x91\x98*a\xf6$\xce\xac\xed\xe6U\x86\xe0\x8f\x01\x15\xc2\xdcs\x9d\x05\xd3z\x1b\xa6\x07\xbcM\xd9d\xdc\xa1\xd0z\xa0\x8a\x1b\x9c\xd6i\xa7'\xa6\\\x8d\xaf\x85\xe7\xe367\xa3\x96\xd4\xb2\xfc\x00\xc4!\xbb\x9e{\x98?\x971\xc5\x13z\x0b\x96\xc2\x94\x1e\xa1\x97\xa2n\xf1%uc\x1f\xbb\x8a5k:\x9b\xdfc2v!\xc3\xaaL\xb9(eI\xe0],\xad.\x14\x97\xd4T\xa9\x8e\x12\x01\x84\xa8\xb8eS\xe4>l:\x11[\x94\x9c\xbc\x00\xe8\xc5\xa4\xc5\xd8\x85\xc6a\x9f<T\x19\x08o&A\x0egw\xbd\xc32\xddu\xab\xd3Fa\xbdg\xa3\x05}\xe5g\x9d\xe4\xc1x\xba\xaf;\x186\x0f\x8c\xca\xc9>uI\x8eL\xa0*\xd2\xeb\xe6\xa1!>\xd5\xb9f\x03W\xdb\x12\\\x13\xadN\x98\x10\x1c\xc1\x945Y\x99}\xb7\xbf\xd4q\xba\x7f\x9aW\x85S|I[\x17\xf5\xff\xad0\x0e\xba\xf6r\x1a\x1eL\x90\xa9\xb62N\xe9\"\x1e\x9bLbN\xa29E\xf6\xed\xa2\xd3\x05/\xbeU\xe9Ij\xa2\xc5\xb1\xb9(\xa9\x08\xb6\x12a\x83\xd5G\xbb\x83\xd97\x8b\x95\x83\x18\xa08\xc84\xd7\x8d\xe1]R\x1e\x88\xf7\x10[\x10\"\x92c\xd8\x0d\x985\xadO\xe1\x80\x9f\xf7\x96N\xa3p\x0b!/\x81\xa8J\x97o\xb5\xa0<\xe5?;\x8c\xcb\xe3,R{\x09M\xc9\xe5\xb8\x1b\x9c}\xf7\x03!\x8f\xa2\x1d\xcc\xd1\xbc\xa4\x00\x0f[K(\x14\xab\x04\x9d\x09v\xfd\x89\x8e\x99bR\x91\x05j\xc3\x86\xa2\x9ec\xad\xff[\x0b\xefX:J\xb1xu\x82\xc3;\xa54U50B\xcf\xcf\xf1\xd5\x8fO\xdb3\xd9\xd4\xe2\xb7\x16kl\xc8\xaf\x9b\xd9\xe43\xe6\xc2\xf1(\xb1\x10KL\x8c\xd5ebp\xae\xfa\x17R\xf2\xbc\xa7\xf8Q\xb5\xdb\xe0\xf4\xc6\xe6Q\xb6\x01_\x17M~|\xb6#*\x00\x0b\x0c\x85t[\xc2\xb40\x87Xd\x9d\x94\xf9:-\x0c\xab \x9e\xdd\x08\xee\xd1\xb8\xf5|\x1b\x9b\x08vw\x03\xa3\xbc\x92_\x92\xee\xcb\\\xae\x08\xf3\xec\x8a\xbc\x91-p&n\xad\xc2kSqa\xab\xe7>\x1e\xb1\xf8\xdd\xcc\x8a2(\x19\xf2\x08\xbeZ\x99V\xf3!\x94\xd7\x01S\x99Uf\xfa\xedr\xd5\x8d\\\xd0\x9c\xcb\x12\xe8A\x15+\x0d\xbd\xe6\x02\xa3+\xdc\x1c\x1a\x1c\xef\x1a>\x11\xadR\x98\x90\xef\xf1=\xb4\x171\xebD\xbd8\xc3m\xda\xa0\x1as\x95\x12D\xa6,\xc2\xca^\x07\x9f\xbc\x85g1\xd3IE\xb1\x02\xf5\x1c\x98\xbfs\x19\xaeu\x94\xf4\xef\xc6\xe8n\x8bI\x04\x1fJk\x97\xee\x07\xd93\xc1\xbd\x87\xd2V\x99\xb6\xc4\xaf\x89f-\xe4M\xb1\x1e}\xc2\x18\xe5\x8d\xd6\xc2\xf9_\xde\xc2\xdd\x05u=%\x84\xeax\xa0>\xac\x03\x94\xfa_\xd3n\xcc\x04\xa7\x0d\xa6\xff\xa7\xe1}\xf2\xd88Z\x1a\xa7\x08\x1f`\x10\xc0-\x9fr\x13\xd1\xcc`\xe4\xc2L\xc7K%\x99?[p\xf1\xe0$\x13n\xe8Y\x8d\x90\x86F\xd7\x17\xdf#\xach\xaah\x808\xbe\x96\x1b\xcc)F\x13V\xb4\xf7\x9b\x91\x8b\xaf\xd5\xd8\x82\xfd\xf0)V\xa0,J\x15\xfd\x0d_\x1cG\xc9Z\xb0\x09p\xdd\x80\x9f\xb8\xbe\xec1\xac\x09\x14\xc3\xc6;L9T6\x9b\xf8\x1e\xb9a\xe1{\xcc\xf4\x021\xd8\xcf<\x0c\x80\x83\xd8\xc2\x17\xbc% \x0c}\x03V\xf4\xc2\x04\x8a?\xa7\xfaZ\xb5\xa6\xdaE\x8d\xae\xa0\xc40\x08j=Q;\xec(*\x13\xb7\x98\x12O\xdb\xe02\xd9\xb3\x03d\x0eB\x13k\x81o<\xc2q\x9b\xe0\x87\xa8\xeb\xaa\xcb\xe6\x965\x85\xcc\x96H\xd1h\x95\xe8\xfb\xcch\x96\x11\xae\xfa\xa2\xf7\xc5\x952a\xac\xc4\x92\x0a\x1c\\\xf9\xa3S\x95!\xfb\x97\xea\x04&\x94\xdb\x80\xaa'KBg\xb4}\xc9z\xae\xe3\x88\xdf\xc8|\x81\xffNh\xfdw\x9dJg\x07F5\xeb\x82\xf4\xa2-\x18\xcf\x94S\x98\xb2\x0e\xa1\xa9\x92\x17\x9b\x99\x86;\xdc\xf7\x14N\xde\x9e\xce~\x96\x1f\xa2\x9c\x9cO\x1c\xdf\xc0\xa6,\xb8\xfc\x86c\xafZ5\x8a\x8d\x1a;i\x93\xd4\xf5\x03\xae\x80K=\xfaV\xbd-M\xea\x10d~o\xec\xf3\x08\xe7\xb7\x9b\xea\x8f\xe8\x04\xb2\x99-I\x8e\x05\x1e\xbf$\x8aHw\xa5\x99\x8f\x11\xcct\xe5#\xa2\xc6\xe4\x10\xc6\xbc\x7fmP\x03b\xc7ws\xc3\xf4\x9e\xe0\xbb\x827^+\xeb9\xbb\xc0>\x84\x1a\xab\x14v\x82I\xa4\xdc\xa0\x9c,\xadL/b\x89\x0f\xec\xf0K\xb4\xb4\xa5\xe3\xc2\xc2u\xdd\x1b\xdcO\x90}4}\x09\xb8\xad\x11\xb6\x16\xcd(>B\x1f\x0b?\x7f\xf2$(\xcf\xcd\xf4\x9f\xd8\xfcT\x83\xe1+\xc3\x1f\xcf%\xbe&\xa3p\xa31\xed8Mk\x0c\x1b\x80%e`\x15\x8b\xe4[\xc5u\xbe+]!s\x15\x8cc \x7f.\xc0\xd6\xd2\xf3\xd49G\x13`\xc9a\x87\x14\xb6\"S\x0c\xbbM\x95\xe0\xb4\xb7\xc3e\xd8\xc2\x9fkz\xdc\x80\x8dv\xc4\xe8\xa4\xc9{\x91\x9e\xf4'\xac*<9\xfd\x92\xe8E[*\x88+X\xc8\x83\xa6-\xda\xa6\xc7&\x1e\xca\xeb\x9d]8x+[\x15\x82\xa8\xb2k\x89\x8b\xaf\xcc:\x81\x1fm\x18\xff\xf7\x06\xc2\x0b\x96\x92\x01g\x09\xf0\x94|\xf0\\\x0e\xfd\xa0'\xdaj\xab\xae\x90\x8f\xaa\x86e\x0c1\x80?6\x12\x07\xc9\xd1]\xa3\x17\x9c\xabsyA.\x8b\xde\xa2\x1b\x98\x13CS_\xc2\xec\xf6\xdc\x93\xd8V\xdab\xc6\xcaGx\xb3s\x9e\xff\xfe%\xac\xb2\x0b\xc9wG\x8a\x8b\xc60\xd3s\xc8^\x93\xa9\x0d\x02\\\xccpPDn\x17\xf1\xdd\xc1\xa9\x93\xd6.\x92\x1c\xd0\xdfJ\xf0\xf8\x10\xd6\xf6\xdb\xf0\x80\x0b\xe9\xb2\x04\x8eS>\x8f\xf4\x85\xf5\xbdt\xf3\xc4\x95Yl]\xa6\x1c\xbaB}+\xa1\xc2\xe6s\x14!Z(&\x0e\xee\x98)\xaa\x8d#)\xceHf2\xd6\x16\x86\x85\x996\xb4c\x14\x09\x0b4\xe2\xb2\xd5J\xbc\xe9\x00r\xe2o\xe5\x08\xff\x02\xc0\xa6Q\x0f\xf3\xcc\x83L\xe97\xb3\xc5l\xbbHD\x94)\"\xdf=\x94\xa9\"\xabB\xcc~`X\x8a\xdca\x08\xd2\xea\xf6 \xdc\xf1\xa0\x06-\xbcK\x9b\xfc\xcd\x01\xe9\x1f\xfb\x82\xa1*W\x16'n\xa4\x1c\xff\x0b\x12\xaa\xf6\x02OX\x06\x0e\xea>\x1b.\xd9\xfd\x00\xdeN!\xcc\xd90\x8b\x17\xef\xf6P\xb6\x8f\xce\xe0'\xac\x02w\xea\xd5\x88\xd7\x03O\x0d\xd8\x1d9\xf7\xb2\xa1rD\xab#'u\xb7.e\x93\xba\xfb\x96!(;\x07\xf0\x99T\x1f\xa7G\xcf\xf4\x0c\xc4j,(\xbc\x12*\x17\xb3Ta\x01\xbdqE\xc29\xff\x0d\x0c\xff\xd3\xe3\xe2\xbb\x18y5\x0a\xa0.\xad\xfd\x89\xe8C\x9d\xd7\xcc\x98\xd9\xd8\xa0\x10\x85\xbfR\x8dM2\xd4g9#\x99\x0dDm\x06N\x80\x0c\x9b\xee\x05\xc19\xf51\xe1\xe4\x811C\xa9y5).x\xba!\xba\xbcfe>\xf3\x03J\x98C6\x87" +
+	"\xd1`)\xeaa\xe1\x053\x0e\xcb\xac\xe2\x0c)\xf64\x11\xb3\xc1\xba\x88\x9a\x0e\xc8\xd9 '\x09\xa3\xc3H\x84\xf8\xber\xc8S\xf5\xdb`e\xdc:z=\xdb1p\xb8I`\xd2l\xf2e\xa6\xbf4\xf8V*:\\w\xae\x85\xd3\xfd(<\xb6^P\x04\x0b6n5\x85\x97\xc6\xda)28\x112\xf9\\d\xc8\xfe\x1b\xad\x8e\xe7\xd2\xf6\x95j6\x1ex3\xa5l\xcd\x85X\xf1:B\xcfC\x92\x9c\xe6`^F\xd8\x1a.\xa3\x11\xb8\xbcS\xa6\xf3\xbe\x15L\x88\xcf\xc7\"]\x94\xa8\xec\x13\x17/\x035\xc6\x18C\x03r\xec\\ \xa2u\xe2\xa8\x19\xdc\x7f\x0a\x9d\xbd\xc8\xf0\xe5L\xb3\xf6\x10\xeb\x01\xca\xd00B\xfc\xa3\xa5\xb1f\x06\x15f\xa2A\xd9 \x11\x7f\xfb\x02\xd9\xa0\x833\xa7: \xa3\xa8\x01]\x03H\xb4\xd4iv\xa9c(\x0e\xf1\xc9\x14\xb6\xa3\x82\xa9\xac:\xbev\xe7W\x7fW=K\x07U\xab\xeb\xee\xc3\"6\xfc\xeeK\xca\x8bq\x83\xe7H\xd9ZA\xd6\x09\x99(\x10\xcaG5\x15h^\x04\xc0\xf6)\xf6\x1diG\x94\x92n\x8b\xec\x83\x12\x1f\x99\x87\xa5\xb9F\xcb\xcd\xe1c\x0b\x0b\x9f\xf9\x8a_\xbd\x0a\x1f\\\xa5\xef\xda%\xde\x03\x83k\x88\x13\xd49\x19X&\xce\xa5&\x92\xc1\xa9\xbbc\xc8\xd3L\xeb\xa3\xe3\xc8\xcd\xb7\xeeg\x84\x8dn\xc1\x10\xcbOnp\xa3Be-\x03H\xde\xb8b\x9e\x8f82\xafC\xef6\xcb;\x8f\xa8\x9e\xde\x0b\x87Q\xfa'\xe5q\xa7r\x06\xdd\xe8\x9as\xa1\x0b\x8c \x10\xc3:\x17\x09\x8e\xf0\x0f\xb89\xb8\xfbs\xaeM\xaf8t\x005)\xf6\x00\xc6\x06pM\x12\\\xf3\x01\x83\xe9\xb7l/c\xef\xad\xc4)r\xdf\x90\xadw\x07\x82\xe5~J\xe4\xb7\xc7)\x8a\xbf\xbaW~+\xda76\xbc\xc7h\x90D&fxD\\\xbe\x8c\x01\x11&\x85\x85\x9f\xd9\x88\xba\x91\x1a\xfa\x0b\x00\x17\x17\xb8\xbd\xe6d\xab\xc4\x09\xb5pA\"Wa\x1e\xee\x91s\x84\x81\xb7X\xdf\xcf\xc4j\xce?\xc71\xc0\xf0\xcc\x82\xe9\x9f\x81\xa5>\xfds\x85\x19\x0c\xcc\x8bj\xad+\x17\x0c\xf6\xd1\xa2s\xe5%\xbf?\xdb7f\xae\xc8r_\x86\x05\xf8\xde+\x97\xe8\xa7jL^\x93\x8a\xab\x80\x19\xa6\x1b\xbe?5.Y\xe0\x0d\x99\xe8\x0d\x8bG\xe9p\x13\x9ft\x9c\x01}\x9e_\x91|v\xd8:\x16Da\xc9\xa9\xa0I~\xe6\x86:\x82_\x91E\x86f<\xdf/`\xc8\xb0\xde\x88\xaa:\x15\xca\xf3\x81\xe3\x19\xdb\xd4S<\x1e\xbf\x84{\xb31,\xc0\xb9e\xb5\"\xb6\x02\xf7\xd8\x82p\xe4\xacEcg\x98\x00\x0f\xe0\x92\x99\xed\xf8\xf0\x06\xce\x11C\x11\xdd\xef\x06\xf7u\xe8\x91\xf3\xf6>\x0b\xa0R\xe7A\x82\x8e\xc2<\xa6\xb7\xa0\xd0\x00\x1aV\x0bp\xfa\xa4\x9e\xdd\xf1\x8c`x\xa8\x90\xa4\x81\x89\xfc\xc0\xb6J){\xf3\xccT\xa1\x91\x04|c\xcd\xae\x0d\xd6\xfa,\xb3\x82}\xaeAp\x0e@\xedX\x81j\x09\xaa\x16\xe4\x82\x0d\x82\x98\xa6\x0a.a1\xb7\xd4\xd6\x9e\xa2l\xa0\"x\xd2\xa6*\x0f\xde(\x14R\xdc \x94\xf0\x14X\xfe\x13\xfd\x81\x9d\x89<=\x0a\xf5\x94\x82\x8a\xb12\x98u\xf5\xd1I\xc6\x10D\x15\xdf\xfa^S$\xfe.\x16\x7f\xe3\x7f2#\x87\x0fU\xfe\x8a\x8c\x0c\xfcZ\xdbH\x1b,\xd3\x09M\x0d\xfb\xe8\x86\xa1\x80\xa4%\x90jh\xe7|\xde-]\xae\x8c@\xdc\x0e\xd4z\x89\xb4\xafb}\xc0\x150&\xfd\x12\x9d}\x0f\xc0\xcf\xaaN\x10\xf2R\xe3\xb3\xa3\x81\x8e\xd4Bg\xbb|\x02\xfc\x83\xfc~S,?\xa81\x02\x80\xfb\xc1\xe7 n\xff\xea/\x7f\xfc\xe3\x0f/\xd3?\xd2\x7fyx\x0f\xbf\x19|\xff\xf2w\xaf`\x01@\x1fU8Y\xbei\x09T8\x84Q\xf5\xcd\xe0W\xdf\xfe\xf8\xbbo\xbf\xfb\xee\xe5o\xff\xfa\x1b\x08\xbfT-\xf7\xf2\xd6.\xa9N\xfa\xa4/\xc3\xe0\x15\xeeh\xf9\xc4,\xcfF7{r\xc5^\xaf\xb0\x0d/9\xd6q\xcfqu\xabX\x0f^\xe7\xdah^\xa7i\x19nb\x13'\x85\xf6\x1c\xb9:*\x9cIe)Q\xfb\x0c#\x1b\x9a{\xcf\x16}\xb5,\xa0\x7fs\x19z\xe6\xf1.V\x12>`\xcfN\xb1\x84\xa8\xd8\xa1\xc7-7\xde\x05\x05\xee\xc0\xa9QC\x99\x85\xa6\xf3\xe3\x02\xaf\xf7GL\xf8\xb9\xf0\xbc\x13\xc3\xe8^\xbbx\xd1c\xeaw/\xff\xac\x9cT\x82E\x91s\xa1o\"I\x07p\x12\xa0<=O\xcbmi\x91\xb8\xf8\xc9\xc5\xce\x05\x8f\x0d\xc4_\xc6,\xffCU\xe0\x19\x9d7K\xd5a\x8d\xe6{N\xa6a\xed\xc0\\m\xe9\x07\x95I\x1725\x18\xef\xf4>\x9f\xe0\x8b\x16\x94@\xee'-\xb3\xc1fGe\x1bk\xff\xdd*m\xb1\xb1\xb1Qh\xa5\xc3\xb4_\x1a\xbapc\xe8\xc12\xd9@\xa4e\xda.>j\x98b@\x9cU\xd9)\xc7R\x88g\x827\xd3\xaf\x91\x84\xd1\x1cW~\xd2\xbc\x0b\x9f\xb4O\x05\xcc~O\x98>H\x00\xb0\xecK\x1dgX).\x95q\xc4Z:\x1e\x88\x02:a\xeci\x0f\xc7\x93:\xce:Zu\xdej\x15\xff<\xb9x\"\xee\xc6%\xa3\x0e\x81\x8c\"\xb7\x1a{(.R9\xb3\x01N\xda!F\xa1j\x0a=\x9bNj\x06\xa6\x1f\xa7,\xbaK5\xb6\x0e\xf2R\x9bq!\x02\x9b*\x8b2\x19\"\xea\x9c\xa7\x0b\x0b\xd9\xc3\xbd\xbev\xc9:Q\xfd\x848\x0e\xf7\xe8U\xbe\xc1<\xfd\x9aW@\xddq\x98\xb3\x9cbXf[)\x9f\xb2\x04\xeb\xcex\x101p\xb6lU(\xee+\x14v`\xd04\x93.\xa9\x04\x14s\x9a\xa4\xa6\xe1+\x18\xbd\x1aqiU\xf5d?\xcd\x13#^\x90R\x9d\x14\xea\xb05\xdaT\x1f\x08\xc5J+\x85H\xaf\xb0p\x93\x8b\x98\xb4\xda\xb4\xa7\xfaX\\~2D\x10\x0a\xb460\xe0p-q\xd7\x14\x8a\xd0\xad\xc1\xb0\xb7s)\"+R\xa3\xd93ks\xf9\xb7\x8a\xf1\xb6\x00\x05!~M\xabK\x96\xc0\x19]\xb3\xe9\xa8 dAKN\xb9N\xdf#\xf9\xba\x13\xfc\x9ezV\xfe%\xc6g\xe9O,\x81 p\x1f\xb3t\xc4ix2\xbaT8Eg\xc2\xfc\x05Bfb^\xdci\xf7\"\x97\x86JZ\xc0\xee\xb2\xcd\x84*\xc3\xb5\xf4\x84\x14\xfc\xf7\xa8odjhqFE\xdc\xfc\xc47\xe5\x99|\xecfr\x98\x87_\xe24\xcdDv\x9e-\x1c\xecA\"\xc3%\xf9\x8e<@O\xc1\x8e\xd5\xde\xd0\x16I\x864\x12\x84eG\x88\xa8\x1d\x06\xc0\x830\xe3\x101q\xaf\xb8TS+<\x81N\xd1n\x09\x1f\xe5\xf7\xcf$q\xf5ps\x9b:\xfc\x83\x8f\xbb8\xca}\x00\xd9b\xe8\xa4y\x8eP\xd8\xd0\xcb\x10\x9a\xeaA\xa1\x0dI/\x11/\xccG\x96\x1f\x84?\xaa(ci?2\x12s\x8d\xb8\xb6 Hq\xdb\xd5\x9a3'\xb9\x7f\x9fq\x15u\xdb\xfaE\xe5\x1d\x7f\xc4\x93\x09q\x01\x17TJo\x83A\xbd\xafa\xfb)\x8b\xbb\x1e\x868\xc3\xf2\x90!|u\x196\xe99&\xcb\xaf\x92*\x05\x11\x95\xb0\xf0\xf6\x9f\xab\xaam\xd5\x93i~\x1c\xdf\xbb(\xa9R\xb7\xaaV\xda\xceA\x8b\x83v_U\xb8\x9c#\x06j%bMT\x8a\xb8\x01\x10\x8d\x19\xc1\x17b3\xc8R\x9d\x0b\xb2L\x91\x08\xc8\x1f\x7f]\xfd\"\xdb\\E\xa29a\xaeO2\x95\x0f\xf4\x19c\x13\xb0\x10'\xce\x0c\x0f$\xc74k\x1f\xd1\xac\\\x91$n\xdf\x93\xb4&?o38\x18v\x9aP\x0d\x1f\xf1qZ\x1e\x9c:6\xa2\xf6<\xcd\xc5\x82\x8c@Q\xbc(]k3\xf4\x95>\xc6,\x8a\\\xe7lD\xf2C3\x8ev\xd2\x0e3\xef\xe1#.\xe8\x10\x9a\x87\x7f\x81\x80\x84\x887\x1a\xac\xd3\"<\xda\x18F\xc6[\xb4'?\x8cN,VJt\xa5.2\xd1Z\x0c\xf3\xf2\x95\xf5\xad\xbd\xd0\x12v\xdd5\xeb\xeb\xa3\xe2\x1e\xcb\xd9[*\x0b\xfcO\x85\x14p\x9d\xe4%\xf2F}\x88\x07\xb2\xbf\xc1\x14\x98*=\xfc\xc29C(|\xb5h\xa93\xd6P\xa6\xafCr/o\xc1Q\xba\x85\x14\x89\x11\xce\xb8\xab\xa6Jw\x04\xae\x9e\xa0\xac\xc3\x0d\x87\"\xf1\xbe=\xceJ\xacQ%3\xac\xc8\xe3\xfb\xd2\xac\xe8\x89\xb7Ih\xdc\xe8\xed\xa4l\x99k\xb5V\x062h\xd88\xd8a\x06{n\x95<\xfd\xb0\xcd\xaei]\x95\x0e\x95\xc2\x8f\xd3@f\x1f\x13=\xc2\x98u@\x90O\x8a\xc5\x01\x9e\x13\xbe>5\x15\xd9u\x9c\x84\x9b\x81\xc5\x12\xf1\x8a\x13#)\xd6\xfe:\xf9\\\xae\xa4_\xd6\x00\xccY\x87\x1b\xb1\x1a- \x07G\xcb_\xc3\x9f\x1a=\xae\x06\xaf1Rk\xa6\x07\xf6\x90Z;\xcb\x89\xe9WX7\x93G\xdb\xf3h\xa7\xadH\xfcYHk\xe4J\x03\x9f\xe8\xa1\x06VZ\x8c\x9c\x02\x1dG%\x03\xe2\xae\x0ct\x8cx\xe7\xc10\xb9b1\x84\x87\\J\xc1\x10\x0e\xb7\xc0\x02;\x02\xf2\xe7~\x8a[\xe0\xa5O\xc2<\xc2\xba_\xbb$\x0c\xe4j<`\xb4\x90\xb2\xfb\xbct\xd0\xe7%a\x0c\xd96n\x13\x98\xbdk\xef\x07\x98\xda\x92Y\xc6\xdeE\xb8E\xeegnXv\xd9\x06\x88\xef\xc3\x8aIek\x1a4\xc3{]\xf3\x1f\x0388\xef)\xf0\x03\xe7\xc1b\xaf\x11|\xbe\xea\xe7\xe1\xb0=\x8a\x16\x92rK\xae\xbf5\xdc\x16\x1a\xd7\xa3\xea\xd1\xb0\xe4$\x9a\x1bC\xf1&\xe0+\xf6&:\x15\x17\xba\x83\xf3\x87\x07\xb9\x8bG\x90\xceb\xa0\x0f\xb3fl\x8f\xe5v\xd6\xa0[7\xb6\xbf\xc0D\xa9\x92\xcb\xb4Gi\xed\xbc\x09\xf7\xf0v\xf0\xaa\xa8\x0b\x10\xde\xb4k\xfe\xf8SIs<\x83x\xe3\xd0\x1c\xce\\\x01A\\\x15\xcel\xff\xf0\xdc\xea\xedC\x13_\x97jE4\x0b\x09\xa3\xe1\x1a\xbcF_\x01\xa6\x00\x81\xc3\x07\xec\xea\xf3\xb2L\x98J\xb8\xa0\x82h\x001\xea\xae8\xf7 4>YxR[+\xb5\xf7\xe5\x16\x9f\xb4\xd9\x8aj v[\x99\xf2[\xca\xe5Ic\xed\x15\xf0\xd0\xeb\xac\x14L\xaa?\x97\xee\x96\x9cR\xdf\xb0Q7&\xe8B\x9c\xd8\xdb\xe0S\xe8\x15\xa8\x1dL\x0c\xa2_\xcfa]?0\xe2b\xcc <\xfdI\x9c\xe6`T\x9a\xde7]\xbdq\xaa\xbd\xc1}\x8c\xf7_s=\xcc\x12>\xad\xe3\x84\x03+\x94\xecY\xf2S\xa7Xj\xe2\xe8\xf3@\xc2\x8eo\x95\xc0\x8dk\xb4\x9b\xc7\xd4\xe5(890\xc9Ts\x95\x90\xb9\xae\xd9\x89\xba\x09\xf7\x12\xdf\x11n\x0e\x19\xacAll\x87q\xe5\xf4\x04\x81\xf1\x0fvP\xd1A\xafJnp^\x93\xf0\xb9:\xabJ\x87\x1agq\xca\x86\xdb}\xd8f/I)x|\x83\xf4\xd7\x80\xc3\xf2M\x1e\x94\x975\x8bu=\x1a\x9dws\x1e\x1eQLJY\x92\xd4\xd4$ \x00/\x10\x83\xb1\xbc\x1e\xc5%0k\x05\xed\xb1\xbaM4,-\x86\xe3%\x89a\x81\x8d\x98v\xe5\xd76;\x89\x80\x19\xadk\xb1[\xf0F\x8e\xab\x84\xd1\xc7\xfc$l\x88aICK?`|m\xc76g\xe8UM\xb7\x16\xb8\xee\x1a$\xb7Z\x88\xee\x92pu\xc7\xc6\x97\x8d\xe9F\x96\xbe\xd9B^|\xade\xf3\xec\xebt2\xd4\xbc\xef\x18\x12\xc3tj\x9b\xcaE\xd2\xd8F\x0ak\xd3\x05o`\xa1\x8f-\x88Jy\x88(\xd0\xab\xba9\xecF\x89\xb9\xb7\x8eUx\xe7d\x1f\xe0\x99q\xec\xab\x84\xc0o\xb9U#\xe0=n\x7f\x18$Z\xc6qG\xb4\xec\xe8\xa0X\xb8\x1eV\x85Y\xe6Wk\x17\x1d lo\x10\xf5\xc4\x7f\xdao\xd8J\x13\xc6\xf1\xddG\xa02\xb2f\xcf_@\x8c2\x0d/\xa2\x96\xf9i\xa0\xb7I/\x9f\xda\x906_\xc3m\xb6\x0e\x0b\xfc\xac\xfc\xb4\xdc\x1b\xd6\x0a\xa6\xa5\xb6\xf8\x13\xba\x93p\xee+\xf6\xb6\x81\x16\x90\x1b\x99\x9a6\xa0\xd8\xdd\x8cH\xf7\x07\xb1!\xbc\x04\xdc\xf2\x02g2\xe7\xb1\xf0:\x8c\xd7\xc4\x89\xbe\xe8\xae[\xa6x_x\xec\xf4N\x8f\xbaO\xfa\xfd\x87\xd7\xef\x12v?\xc0\xb1\xdf\xa8Q_\xc1\xaep\xdbM\x98\x95\x8e\x05:;\xf6\x0a\xd0\xa8\x0f(:\xbb\xa5\xd7<\xb1\xeaw\xed\x15fvB\x08\x0a\xc3I7#\xec\xd0\xde\xfb\xd4\xa9_\xfca\xd5\xb3S\xa1\x8a\x84Rl\xa6\x9eA\x9a\xf3\x01vf\xdfnDRXn7\x12\xb0j\x12\x83\x7fMby>\xa4(\xda\xcb\\\xeeM\x89\x89q\xd7\xe4\xb0\x0c\x99\x99w\xd6\xa5\x0aS\x7f\x06\x1aE\xcf1\x81\xf8\x1b\x98l\x91\x10\x17\xfa\xb4!\xf2\xa4\x1b\xc8\x0dR\x8aG\xb8:\x0d\xdf&]\x1d\xe6\xdcqya\xec\x13\xa7;g\x90\xfd\xbd\xca\xb8/\x10;x'!\"\xe94<\xbb\xd7\x86\xf0*\xcb8&S\xff-\xa7\x86\xc6T\x0d)Y\xbfux\xe42\xa0\x16\xc3G\xf9\x86.\x9d\xc5H\x0a\xad~\xd2\x02\x80{\xd5\xae\x1f\x11\xf5<\xdcX\xab\x0d\xbe\x16S\xe8\xb1/ \xed\xc3-\xd9\xa293C\xe2\x84*\xa1\xf8\xf1\xe8\xb4X\x12\xa45\x03k\xa5\x94p\xe7\xa0\xa3{Z\xa8p\x99k\x15`\xe9u\x86\x1d\xbe\x1d\xec.\x98\xa83\x0f\xd8j\xfc\x02\x8d\xd2}\xe5\xdb\x954Q<\x96\xd4\x0ba\xa3{\xa3\xc6n`\xc5\xc5\x91\xd9\x15|\xe9,Q\xa0\xbaZ.\xc3\x1ezK\xa7]\x83\x13\x82\x89\x7f\xf9\xd7\xfa#\x83x\xf1E\xcf\x94+\x83\xe0/\x1e\x04\x9b\x9e\x98\xa7!f\x94,\xa3\xde\xd9AX\xa9\xaa\x8b\xb304T\xd6u\xe7r\x0fz.\x1d\xd4\xefIJ\xb9cNZn\xf2J\xc5\xb0\xe8\x8frr\xf5\xced\xc3\xe1\xa9]\xee\xffq\xe9\xd0t\xfb\xb3e\x84\xbcd\xbf\x17\x8bm\xd0\x0eHs\x04l\xca1Y\x07\xb8t\xc50\xaa\xba\xa1.5\xa4\xdf\xb6\x99\\\xbfFb ZX\xc7\x0b\xfb\xb5\xf9=\xcd\x13\xc3\x04\x92\xa6hX\xc0\xf6\x8dUA\xd4\xa0\x8a\xaa\x88D\xed\xd4e\x19\xbc\xb6\xcd\xd8H\xc2 [\x88\x90I\x96\xbb\xd0\xf8\xeb\x05(\x99y\xdd\xf4`\x80X;\x08!R\xfd\xdd\xb3\x87\xf1\xf5S9\xc7VV\xa4\xd5\xc0\xfd\xab\x05GX\x07\xcdF\xd9\x1cd\x01\xd69T'\x8f?\xed\xa0(3\xdcq\xb4\x81\x0b\xbd\xaa\xe6\x0du\x0e\x09\xe6\xaf6\xe1\x8a\x1d\x99\xe6c\x9fi\xe6\xf2U\x99\xd5\x99\x9f\xad\xac'\x18\xf7\xc2\x83V\x80\xe9L\x1eC\xd5\x02B\xb6\xbd\x87\x11\xa2\xdcY\x06\x1d+\xfb\xbc\x0a\xbb\xe1\x9d\x8c=X\xa5\xa7vt\xe0\x89e\xf7\xad4w<\x96#\xbe#\xe7m\xbb\x95\xe2\xd2\x94j^\x91\xa7BI\xbd{\xa8\xb2\xc2m+\xfc\x9cs\xd3\xffL\xa7\x18\xbb:J\xc9\xbe1\xdfJ\xcd\x02\xff\xda\x960\\\x13QyX\xc5\x19l\x94\xcc\x99\x86\xa4$\xd3\x96\xe4\xdfk\x89l\xc2j\xea\xe8\x98\x03?\xecx\xa6\xa5\x9b0\xbf\xf4Ex\x0c\x8f\xae\xbe\xad\x12_\xfe\xb2\x0bv\x99\x05[\x9f\x8d{0\x84O;c\xb9\x191?\x8b\xa3w\xbd\xf0\x91\x07\xbfQ\xe1\xe2\x8c7t\xc9\xb7\xda\xf1\x95d,\xb9:(\xc3C\x894B\xd3H\x0c\x7fl\xf4I\xa7h/\x14\xba\x88\xbf\x177\xcaA\x11\xb3\x84\x13\x06y\x95\xdb\x18\xda\x8c\xb5\xb9&\x93|\xdf\x0a\xd7\xb7z\xce\xe8i\xf1!\x8f\x7f\xecy\xd0\x1b\xa2\xa5\xae\x8e\xe3<8\x833\xd8\xf2\xcc\xc3\xe0\xb9U\xb0\xc3\x8f(\x80S0[\xa2B\x8f=J.'\x06\xd0\xcd\x8f\x85\xe3k\x16~\x89\x13\x1cJhp\xb8\xd1c\xadRJ\x1d%]`\x8e\xf8\xf6+\xf2\xf5M\xd8\x14\x7f\xf6\xe5\x92\x8d\x86PH\x13\xe5\xad\x9em\xe2,\xd6pT.\xcc<\x03+U\x0eKf\x09\xa4;\xdc\xa6/\xe2\xc8\x8c\xbf&\xb3\x8c\x94eKn\xbf\xd4\xc63oN\xcf\x09\x90\xe0\x19\xcb\xb3&OJv\xcd\x0c!\xce\x0f\x0a/Xj\x10\xabt,\x17xgw\xb82'\xd6\x13\x05\xb6\xf4'\xf08G\xe2\\\x94'x\x0d\x05\x8c,\xa8\xaf\xd3Y?\xb5\xe9Y\xa5\x0a\xab\xd5\x83\xdfv\xa2\x1a\x0cA\xcf\x8e5\xb6\x13\xcb#\xb3K\xabx\xcb\x8f\x1c\xee\xfd\xc8\x09T\xda\xd4\x9adg)\x91\xf4\xbf\xccL&\xe5\x85\xf62\xdf\x9c\xbeyn\x18rj\x0d\xad\xec14f>\x84\xa5\xba\x93\xaeR\xe2u\xb7]'|7\x06\xae\xb0\xb0\xa64\x98\x97*!E\x1c\x0d}b@\x86\xd3Fj\xb5~\xd1F\xd2\x9e6a\xab\x09\xf8%\xef\xd5\xde\xbep\xea\x1aT\xb0\x83\xffq8F\x16Ky\xadez`\x9d\x09\xdf\xab\x8ay\x92T\x9d`\xe72r\x8a\xc2cUh.\x13nNOl\xbeT\x97\xe5\xd4r+B\xf0\x12)\xf4n\xc1\x83\xea\xb99\xd7\x0dI\x03\xfb)\xbaO\x1dG`\xd7\xda\xae\xa4\xd8i\x85\xd5\xb8X\xd9\x8aXi\x98\x12P\x84\x9b\x07y\x1d\xb1t\xeb\xe78\xa2\xf8s\xe6k\xf4\xf7\x90\xc3\x0d\x92j\xe9\xcd\xb5\x9a\xc9\xe2Kw`\xb2L\xc1" +
+	"\xf6\xd4\x03$\x14\xba\xc8HyD\xef)<x[\x80\x80\x8c\xb1\xd4\xb5\xe6\x99\xde\xbbh\xe5\x88e.\xda\x8a\xf7\xff\x11I0\xc0\xcc5\xca\xd1\xafK$b\x92\x92\xef\xd3~\xeb\xe6u\x81\x99\xb2\xd2]q\xebA\xbf(\xc8\x80\xc5\xd2\x02\x9c\xc3\xceR9\x18[\x08\"\xd4\xff\x0b\x8c#z$\xa1\xf17Mej\x95\xa9\xb8\xc9\x97\x86\xc4X\xe2\xfb\x05\xaf\\~\x90\x12\x8d\x86\x17&+\xaa\xeb\x86H\x1ab\x98\x9a\xdc9z]\xaa\xeak0d\xd7\xc0\xe0S\x02b\x02\x8e\xc7\xcaRB\xe9\xe6\x0f\xd6\xce\x86I8\x0aZ\xe1\xcc\xc4V\x97\xbf\xb5S\xad;{\xdb\xbeM\xfb\x82\xaf;i\xcfK\x16U\x9cPH\xden\xadM\x8bm\xbd\x14\x12\xa7?\xe2\x94)@\xafM\x91\x99\xab\xb54r\x93\x84\xd1\xa15\xdca\xcd\xab\xcd\xa6?\x04\x0f N\x05\x9d\xebc3\xf6\x94m\xb5\xb8}\xed=\x05\xe1J\xfdt\xf6\";\xc6<\xeb\"c\xd0T\xaf\xbe\x00\x9d\xcf\xa0\xc4\xd6\x7f\x09s\x7f\xbf\xa5\x09\x80\xda\x028\xb5\x96\xfb\xdd\x14G\x98\x83?T\xe4\x1e\x09+6\xe1\x1a\xd6t\xd6.\x0e\xcd\xb2\xfc\xf5X\x95O\xb4\xf38\xfb\x01\xa3\xcb\x91\x82X\x0d4\x8cIqq\xbe]\xac8\xae6\x11\x03`:PJ\x86\x15WS;&rT\x83%\xeaE\xacgzp\x94\x13B$\x02\xd5\xe4B\xf0m\xb5dI&O\x88\xbf\xad\xd7G\x16\x99m\x9ebOz\xe3\xadg\x07y<\x85\x81\xc7<\\\"\x87\x9e\x15\xd5\xd1\x81\xbd\x08\x1bL}\x96nnQ\xd5#\x86j\xdc\xc7\xa6\x95\x83\xfc$\xc4N\x08\xde\xd9\x10r0N;\x91\x83\x06/\x18\x9eb\x0dx\x8eXC\xf0wfY\x09f\xbb,\xbb3\x1fH(G\xd23\xf6\x06'\xc7\xd7\xfe\xeau\xdbK\x86\x92\x8e\x0a\xe2\xbfxh\xa7\x0f\x92\xf1F\xf8{\xf0zkS\xa3\x00\x89\x7fHG\xd4\xce\xf8s*s\x92p\xb0\xab\xb26\x9c>K\x03w\xee\xe6\x81\xe0\x83\x09\xf3\x0b\xb4\xb0\xfa\x17fn\x84\xc10\xae\xc2'Q\xb5\xf5e,l9\xa1\xd3=%eN\xe1\x9a\x17\xa8K\xfb@\x96\x89|\x07&hW`\xd0PKp\xe9\xb6\xa5\xa0\xc6\xc4\x8a\xe6\xdcM\xd1;nJ\xce\x96\x88\xb5Y\\\x02\xac\x91M\x81\xbd\xa0\xac\x9a\xc6\xa9E}\x0dA\x8b\xb3\xd3R\x81\xc4Mx\xa7\x02)sC\xf5\x8d\x03\xbdE\xf2\xea,OR\xdc\xc2\xc1\x87\x9f\x13\xc4\xbe\xa3\x09\x1a\x05(\x97\xc5\x99\xc2\xfd\x91\xa9\xdfE\x13\xfe\x81\xad\x06\xc3\x84\x87\xb6S\xd7\xb4\xb2\xc1\xebI\x16\xbaWn$\x1b\xe4\xe5\x0d\x0a\x98\xa69e\xd6\x8e-\xee\x81P=\xdf\xa7`\xb9<aX\x1f\xe1]:\xfc\x85.(\x8e0\x91O5\x168\xe1!4\xb6$\xf3\xac]\xab\x0cO\xfd\x10\x16\x9d\x11\xb0\xf3\xe1\x1e\xad\xc4\x89\xce\x95*dA1\xcfbi\x09\xd2n\xa0\xc2\x84?[@6\x96\x93r\xc0\xa9\x1e@\x0al\x85\xde+I\xd9\xa8\x9d]6n?#\x93sn\xf9u\xa7(\x9b\xbe\xb0\xf9\x9a%\"\xff\xc0[^\x15$\xc3\x14\xfa\xdc\xcd\xcd\xca\x0c\x13\xcf\xe3f=?4\xbb\xcd\x84u\x8a\xa8tVbq\x18\x12\xe8\xc0AXG\x91\xd2\xe4\xd9\x15\x0c\xaablxA\x0d\xbf\xcc#r\x81\x16\xc2\x9c\xc6\x9f\"(\xe6\x82\x11\x0b\xfc\x12\xc5\xba\x03\x8c\x9b\xa2\xce\xd2\x9b\x1f\xe5\x06\xc3\x82J{^\xf7Z\xf1\x85\x15[4)v\x08\xad\x9dg\x85\xc2h\x9c\x15\x91\xed8\xc4\xa0\x9c\xe9\x0fc\x1b%\xfd\xac\xd0\xe8\xf9I!4\x8aq\x95\x15A\x8d\x9d\x870\xe1\xf8\x15@\xec\xe1P\x0bKB\xb1\xe1\xf2\x14\x02\x97\x1d\xa8\xb9s\x83\x0fFGF\x82\x85\xe6\xf4\xa9\x90~\xa5\x14\xaa\x07\x87$\xdcQ\x0a\x0f'\xea\x1b\xa3\x08\xd2\xca:I\xc5\xfa\x8d\x1crZ\x9c\x83\xc4\x84X\x13\xce\xd6\x16\xc7%\x14\x81E\xf6\x7faKf\xce\x87}\x00\x83\xe7g\xfb\xe5{\xf6\x0e\xa9\x1a\xe2\x08d\xaa\xa3\xd7n\xb1\x9f\x95\x1as\xfe\xac\xc6`\xf9\x0c\xb9\xc1%\x8e\x03#\xd4\x18Vl\x0f\xb3\xe4&\x18\x1a.\xea\xaaD\x87R\x1e,\x1bW\xf8\x94V$W&\xec|\x93\xe1\x8b\x17\xcf\xe3\xfe\xc4&j\xed\x86\x0dCd\xe0\x87\xce2\xdc&\xac\xa1C\x043\xe4\x0eT\xa6\x01\xed\xa9\xe3\x18\xbe0\x8e\xa1\xe8-\x991\xf71\xea\x15\xc9z)\x03baBP2[\x9d?-6F\xd6\xc3\xf0h9\xb6E\xa2\x8fM;\x82\xf1\xec\x89\"\x1a\xbe8\xf8R\xce_\x8c\xd93\x81\x86\xe5\x04D(\xda\xd4\xb0\xa8\xed\xe9o\x0f_\x1c:\x86\xda-cP\x99\xddwi\xa7m\xb2\x1c\xa1k\x0c\x81\x19\xfa\x18\x92\xc9A\xcc\x02\xfe\x894\xff\xabV\xc2w,\xa0W9\x83\x12r\xe4^\xe4@\xb0\xec}I\xaa\x8b\xc4\xa9#\xe0\x96Gh\xd8\x8a\x14\xaa\xf1\x19\x10\x92\x0dw$8\x86\x8ea\xc6\xf2\x08\xba\x9f\xa8\x16\xe9\xfc\x0c\x95\x17\x99\xc7i\xbc\x08\x0f\xf8\xa4\x8a\xd6\xe3\x1e:#4\xb74\xbd0\xc6\x1d\x89\xea\xc7;:\xfe\xc8\x05f\x14\x15\xc1\x81\x99*\\\x15\xca\x8aM\xdd\xa7Q\xd00\xd5\xdaN_\x09\xcbsu#\x82\x0b\xd2#\xe0\xe7E\x0f\xbf\x82\xbc\xecmg\xb3\x8f[i\x11\x13\xd7R\x1d\xa48-\xcc\x0d'[\xbb\x8b\xab \xd3\xe2\xed\xb47\xbb\x13\x07\xc3\x17'\xed\xf2\xc0eJ\xf5a\xce\xa8f\x95\xa0\x0d\x16\xf8\xc9g\x05\xa0SX\xe0ULPP\xc7\x19;dh\xf6\xaf\xba\xb7\xb6Qx F\xa2\x85w\x94\xda\xb1<\xdd\xd1\x8a\xef\xd3\x0fuZ\xe0\xcb\xe5ML\x9e\x1a\xaaP\x12\xb5\x94\xcc\xdf\xe7p\xc2\x0f_\x9c}\x0d\xedh\x7fz\xb1\x84\xf0\x80\xd5;\xf7\xb1\x89\x86/\xce\xed\x1c\xfc\x12\x0aRo\xbd\x12\x19\xb7&^\xea\x0e\xde\xa8\x17\xc6\x19\xa0\x91\xc3%I\xcb\x96\xb5}\xd2\xd3\xd5,\xc4\xccR\xf7j\x0d\xab\xfe\xd5\xba\xb2\x85\xcbK\x1f_\xae\xc5\x9a(\x1b%d\xf9\x89.\x8d:ef\xe5\x9b\xfb\xc3\xfc\x1a\x87\xeb\xb0\xd6\xef\xfbc\x7f\xc3\xe1\xd0yo0\x13\xd7|\xe6_:\xed9Jm4L\x05EW\xc1\"\xe5?h\xa8\xee\xd1pvjw\x14r\xedj\x87\x9c\xb3$\x1c\xb2\xb2\x01\x13Z/\x13\xaa\xd0\x11\x96\xbb\xebxA\x1b\x93\xea\"\xdf\x1aoY{\xbb\xf5\xb1P0Be\x09\x1f\x9d\x9f\xc2\x87\x121~G\x93P\xf0\x17#9\xedT\xa1\xe3Ux6\x0d\x08b\xd1\xa9\xa6\xdd\x13\x81\xc8\xb3\xa8\xfa9<\xd2f\xed\x1e\x09VA\xe7\xbe0<\xf4\xaa8\xcc([\x9b\x1a\xfa\xc2\xa4\xe4\x13\x8es\xa4\xa8\xf6\xa7|~\xd9{\x857\x08\xc7\xf3\\\x03\x9c)\xc2Z\xa1\xae\x06\xda<u\xc7\x12\xea\x90\xdc\xfb\xd2\x13\x16\x9a\xb6P:9\xd9\x83h!\xb6\x0e\xb7=\x0bX\x86\xc3\xe3L\x0b\x8a\x0b\xdf\x8a2B\x9e?f\xd6\xaa\xb7\xb7\x18\xbc\x9e\xdc\xedpx\xf2?\x00\xc3\xdfW\xd07\x19\x0eO3\xe9Q\xeeC#\xa36\xf2\xa2\xbf\x1d\xfcg\x82\xa6]C\xb5\x94\xb6\xe0\x11\x8e\x03\"\xd1Z\x0d\x88Y\x8dc\xc1\x1f\x9d\xce\x9a\xe2\x8cF\xb8T\x94\xe4\x19\xd6\xe5\xc7rj\x1aLF\xff\xfb~\x86\x93\xe1\xf0\xecs\xe40Mvp\x89e\xcdb?\xdf+\x90J\x92\x8b\x0a\xae\x02\x85\x9e\x05\xbb$\x86\xe8\x1ba\xaf\x88\x82\x80b\x85{Z\xeb\xb4q\xda\x9d\x03ve6e\xa4j\xe6\xce\xd3T\xb0)\x86U\xa3xSN@\x14\xd4\x00\x0b*\x1e\xdd\x10\xc4\xa1ryy\x0c\xa2L\xde\x9fM\xe81W\xc5\xa7O)\xc3\\8\xc3\xe5\x94\x1e\x0b\x06\x97\xa1\xbc\x17|0\x9a\xe4\xa0\x06o\xe8\xbap\xc7_mI\xd0\xcf\xbaY\xbc\xff/be\x0f-8\x04>R\xe4\xb0R\xaf\x1dO\x87\x8fZ[T\xdc(\x1edo\xe6\x01(\x92Uf\xe1\xf5\x15U\xce\xc1\xbd\xb1R\xfd\x0a\xc5\x19~\xa1N\xa3\xeb\x92\x87\x87Wm\xa8\xc8\x0bv\xb75\x85\xfaUWS/\xd7\x92\x0bC\xa8\"z\x05k\x0a1\xc4\x86\xf6\x19\xd9YZQ`\xe1\xa8e&\xfcX\xc1\xca\x7f*\xbea+[\xfd\x09\xbace\x8f=\xe4\x0aZ\xc6:\xbc\xf5vV\xc3b\x02\x0bt\x82R\x1b\x80+3\x13\x09\xdd\x83\x06bh\xa8\xb5\x90o\x16\x1doF\xde\x89R\xc7\xd4\xa6\xfd\xe3(\xbco\x0b'\xb2]\"\xe2a\xd6F&z\x9b\x15L\xccI\xcbn,6\xcd\x01\xe9`m\x92\x017\xce\xd8Q\xd36\xb0v\x91\x9c9\x99j\x1d\x15b\xd6\xdb\x0e\xef{\x14=\x87\x07G\xae\x7f\xf0^\x8c\xeb\xb0\x03\x12\xe6\xe6\xb8\x8c\x8dvWlb\xc5*\x96R\xad\xb8\xb4\x05\xdfo\xd2?\xe3\xc8\x956\x98$\\\x85\xc1\xfb\xae\xb3U)\xb1\x08\x85\xb5kv\xd7\xa4\xc9]\x1b\xa4\xc3H6C\xae\x07\x80V\x17\xda}\x07\x8bq\x8fV#ehg\xabO2;\x84\xd4\xe0g\x8e\xd8\x81\xec\xe9\xf7\x904P\xbd\xc6IX9\xa5s\x09\xf30\xbc\xa2R\x04\xf1\xa3\x12\x94\x95\xe9#\x9e\xc3\xcd\xa8\xe4W0\x1e\x17)\x95\x93\x16\xcb:\x8e\xe4\xfe\xe5d b0\xe3X\x13zi\x92LIV\xa3&\xd3#\xbc\xed,\xdb\x18Zn*A\x9c\x9bg\x82\xb9)TQ\xf8<L!:\x9f\x81\xbe\xd0z+V\xfa\x00\xeb\xa27\xa52<\xf8*\xfe\xf3\x17Im\xec\x91\x9d\x1d\x1e\xbe\xf8\x1cc\x85Jl\xb5<\x11aJ@\x07C\xba\x8d\x15k\xb93ey\xff\xce\xd2\x1f\x10;,K|\x19\xd3\x94\x92q\x0dG9)(\xb0#\xe0}h\x09\x1d\xf6\xe2N\xb2m\xc6`Og\x9bm\xd0W\x1c\x14S.\xe3Uvu\xe3\x80\xb8\x12\x1c\xdbHE(\xc3\xd1\x0d\xd5q\xef\xdc\xeb]\x1c\xee\x94\xbf4\x13\x94\xa5`k\xca\x9f\xdb?S\xd7\x86\x8f\xb8i9k\xa7\x96\xb4\xd3H\xa8X3\x1f\xbe\x8cg\xbd\xc5\x82\xff\x8ev\x1f\xdb\x19\xa0s\x93\xcc8\xa7\x82\xb4\x00*n\xd2\xe7\xed\xf2\x02L\x0c\xdd':\x95C\x97f\xbc\xe7\x13\xe7\xe5\x06\x97\xf02\x99\xe2\xd6Q(cb\x99\xf2\xb5\x1a\x8d\xafy#\xd06\xef\x85\xc6\x1c\x1e\x9e\xecB\xae\x14\xe7\xd4L\xe7\xd4\xd7`l\xc6l\x00\xa97j\x8e\xb1\xea>u\x0fO\x1d\xad9\xe0\x85W6\xf3\xcev\xe8\x14\x9e\x04\x06\xa9\x95\xb0\xd5\x89N\xca*\x1f\xf9k\x02%Q\xe0\x0d\x89k\x96\x1c}\xad0K\xde\xd9*Ca\x846>\x84o<\xa9\xb8\xa6\x91k\xab/\xba{\x99\x9d\xb7#\xf83)\xa5\x10Y\x9a\xf0N>S{\x0c_w\xab\x952\xfb\x93\x0f\x0c\x8f\xbc; D\x1a\xe4\xe0\xac\xd1{\xbe\xc0t\xc0\xc6\"\xa7\xf8[\xf0\xb4)f/D\x1b\xc2\xb3\x03\xa5\x9c\x0b\xcc\xe3)\x9d\x81[\xddp\xe2n\x95RD\x0c\x87BM\x89\xd4\xf0\xa9\xd5l\xb3\xb0\x9f[\x10<4\xc4C5\xda\xf3\x89si\x9f\xf6X!\xd6\x1d\x87\xc7\x91e\xdaEo\x8b\xe47\x19\xf1{\xafn#\x08'\x93~\x07G\xe1{\xbb\xe9\x86\x10\xa6\x1cj\x12\xfdm\xf3#\xcb\x1e^la\xc1+P\x7f\xdc\x13tV\xeaRep\x18\x9c!\x92\x99E)\x00\xf8y\x9d\x97Q\xf5\xc2\xd0\x86GGy<\xad\x82l\x89\x16\x80\xca1\xdb\xb1O\xcc\x142\x08\xed\x1bq56lls\xa4\xf5Z\xa5\xef\x16l/\xba\xf4\xe4\x9cu\x1f\xb87+>\xfd\xe0Z\xb9n\x0b\xcfk\xd8\x17\xe3@{9j\x96\xb6\xab\x9bd\x14g\x03\xf9\xbe\x975ax\xd4\x8a.\x8a\xdc\x03\x9cR\xd2\xff82j\xe1\xe7<(\x14\xdb\xc0\xfe\xfc%\xdc8\x94\x08\x80\x02\x06\xe1\x1aUc\xb2\xb8\xda\x14\xa0\xfb3\x93\x87\xed\xf9\x95`G\x0b\xbf\x828\x83\xf6\xc1\xceW\xb7\x0e\xcdXR\x04\xe9\x7f\xa8\x8cQ\xe4+\xaa\xb0\x15\xb6\x11\xd2nC\x1e\xafA\x1c\x91\xfb\xac\x1b\xab\xf0\x86\xc0\xba0\xc5(\x05\xfd\x0b\xbc4I\x83\x8e\x0c\xb8\x8f\xe0762_4Wv\xae\xbc\xd3\x8e\xc1\xbd\xd0J\x15\xc3PkcOB\x11\xd2/:\xc0\xb86$\xbb\x13U\xbf\x8a!\xedu\xb8\xc6\x98\xe8<-\xa6\xb0\xd0\x88\xd6\xe7h\x93eowf\xea\xcf\xdf\xa4\xe9\x97\x89\xb0\x94\xa7[\xcd\xa2\x15\xe5y\xf5Yv\xa1aK\xfa,\xa0MGx\xddai\xca\x9b\x07\xe81\xe5>i7\x94z\xa8\xc4@\x1e\xef!\xd2qc\xa6!\xaf\x1d\xf9J?\x9csh\x08zR7MP\xa1\xda\x8aOu\xbc#\xed\xa4[M\x91'\x81R\x88\x18\xd5\x19P\x9fr\xc6\x044~\x87\xb0<\x08\x9aM(TM\xebg\x1ek;\x17\xa6\\&\x8a\xea7`[\xd4\xf1\xf5^G\xb8!\x04\xe2\xb3\x8b\xf4\xa2s\xb5R\xaau\xc7\x90\x13\xcc\x16\x8e\x10\xf3f\x19g\x82h\x15\x7f\xe1=\xf8\x8b\x15\x13\x88/\x12\x85\xb8\x14\xb7\xdb(\x01<\xe1\x11O\xbc\x02\xa0b\x8e$y\xb1\xa2\xbf\x0d<VNm\xdeSU~H\xd7D\xdfU\xbc4\x10\x08\x9d&\xc1H*R\x85\xb9\x1d\x8e8e\x82\xe2\xbe\xda\"\x12g\xabaqW\x19\xcaU\xa1f\xfd\xef0-\x0d\x8b\x11u\xf5#t\xbd\x13\x0f\xd7\x8a9Y4\xb7\"l\xa7\xc7;#nT(\x0e\x93\xf9\x0b\x05 ]\xcbJ\x0b\x9fX=\xe4\x13\xbe\xf2\x98\x0b\x11\xad\x05\x9d\xb9V\x0b.N\xaa\x0d\"\x98\x0f\x86\xbcC\xeeP\x8c\xb8$\xd2Z\xec\x09\xf5\xa1\xaf\xc9\xdasRs\xd9<!\xe7\xb8\"\x01[<B\xb7\xb0Y\xc0N\xf3)\xbc\x8f\xaf5\x8c|e\xcd=\xfb)\x0c\xeb\x1c\xa3\xceR\xa7\xe0\xb3R\xad\xa4\xab\xad\x1b\x01\xbb\xf6#{\xeb`_\xc2\xfc\xb9\x1a\xe4\xf5\x87\x13\xa7\x9bf\xd7\x82Ew\xbb2h\xbe\x80\xf2+\x86\x9aod\xa2\x0a\xae\xc1\x95I\x1f,y\"!\x08\xcc6g\x12k9=\xcb\xad\xe2\x06UX\xca\xb5\xe2\xcbf\xf0\x8a\\\xbd\xcaw2\xd4L\x9e\x07\x0e\xb1\xef\xe1\x9d\xdf/Q\x12=\x03\x12\x90\x91)\x81\x9c\x0f\xc4i*\xa5\xa8\xb2*\x07\xd9.\xda\xe2}w\x0e\x8a^\xe3\x11y\xf5\x17d\x88\x8e\x8f\xbb\xf2\xc9\x96\xfbD\xa6\x9c\xc9\xcfc\x112\xce\x16H\xc8\xb3\xd6\xa5\xb1\x94\xd2\xc4\x97~\xb1\x94\xccK\xe6\xdaV\xa5\x08\xf1~\xdd\x0ee\xdel\xca\x86Q\xb1\xc6\xe4\xb6;3c\xf8\xb3\xf6\x8fbvA\xea|\xc5\x87\xad W\xf4\xa3p\x8a\xa2\x11\xb9\xe6\x97\xcb\xe2\x15\xacu\xce\xc6d\xf8\x14\xdeat\xde\x7f\x0cqv\xa3O\xeb\xcaL\xcc\xb4\x96B\xf6\x1ba<3\x9a\xe9\xe8\x82\xae\xc3U6\x85f-~\x15$XD\x7f\x97\xf1\xf5\xbdJ\xd5\xcbN\xb5\x83\xa1\xa5\x06\x83\x98\xd4\x94Zk\x96Y\xcb\xfe\xc6\x8e!}\xa0I+leNw\x01\xd4\x89X\x19\xf9\xce0%;\xb7\xe8\xb3v\xa3*\xc3\xf0`\x1a8)@\xd4D\xca\xafxoC4\xcd\x9d\xc5\xad\xcc\x9a?!\x1aZ\xdd\x18j\xe1\xa6\x1d%\x0b(M\xef\x09\xc4M\xc9\x04\x9f`=\xf9\x026\xca\x0f\x06hr\x1bk.\xf0P\x85b\x19Z\x8dZ\xea\xd3\xc0\x0b\xab\x9d\x96\x8c\xd9\xa8G<{S>\xa7\xc2\x06I\xc61/9\xe9e!\x80\xdftY\x0d'\xad\x80T!-\xc9,E\x9f\xc2\x82:\x80\xb7=\xfc\x0d@#&l-\xa0\\\x9dY\xdd\xfe\xae\x9e\x02\xe9\xabdWO\x86\xdd\xaezX\xd2\xc1\x06Gy\x9c\xb8\x10\xf1\x04\x8d\x9d9\x96\x92T4\xde\xbdl\x83+b\x84\xf8*j;\xc3\x13\x1b\xa3\x1a9\x8d\xaf\x81\x91\xd8s\xc4udA \x8em\x93\x94\xbb[\xabRM\xfdN\x91\xcb\xee\x0d\xc3\xb0\x97\xcd\xa0\x88\x15P\x9c\x8b\xb0j\xe5\x1b\xcb\xf2^-\xf8@\xf1!V?\xffB\x94\xd9K\x14p.3}\xc9\x84b\xd7\x03\xa5)T\x16^!\x1a\xb5v\x17\xec\xcc\xceF0`\xf5:\xe7\xa9\x10\xe6\xe0\x82\xbep\xc6\x1d|)$T\xba\x11py\xe1\x04W\xf4\x87\xd4\xac\xf4w\xd5\x1d\xe9VZ2\xcc\x1b8cX\x8a\xf8IxK\x0cM\xc8\x89\xaf\x9c\x10(YR\x12\xe3D\xba\x9d\x07\xfa\xb8@L\xe1(\xfa.\x0b\x03\xb0\xa7\x04\xcd\xf0\xa4\x95\x02w\xb6\xfc.\xa3%m\xd4m\xfe\xe0<R\xbd\xc3\x01:9\xf5Ae\xf3S\xb6\x1c\xa6a\xa1\x95\x90uZ\xdb8T3\x02@\xa9-!\xa0\xdc\xb0\xe8=#\xc3\"\xdcC\xa6\x88\x7f\xa5V@\x0d~\x04\x8b,\xae\x18\x036\xb1\xf4\xb1\xd8=\xe4*\x0ai.tWx\x8b\x94\x11\x17(\xcb`(P&\x8a\xad\xf7\xb68\xc0e\x1dT\xcc\x92\xba\xf5\xd9\x1c-\xe9\x90F9\xb0\x8a}}VJS\xf6\xd8b\xa9\xb7\xd1\x1d\xe5\xceI\xf9\\8*kcX\x193\xea\x82\x19\x1f\xeeQ" +
+	"\x14\x88\xec\xb0l\xd2jh\xfcY\x05\x9d\xc3\x93v\x02\x08\x13\x04\xde\x04\xb7\x0d\xea\x9c\x05\x15Q\x02/\x06\x14\x97'\x98\xe4\xcf-YVV\xf4\xe8\xea\xd6S+\x12\x89\xa8\x00!F)\x05\xf2\x08\xf0\x12>\xe0_\x08\xb5]\x11\xcaD]M~\x19\x0a\x11\x81\xab)(29\x92A\xe1\x9a\x1dW\"\x0e\xaa\xd1\x90\x99\xe8\xf6h\x8a\xda\xd9\xbb\xefx\x17\xcb\xca\x96\xb7\x8aJ\x96\xda\xf6$\xbe\x0ce-\xda\x8aG\x1f\x10\x04\xa6\xb9\xcd]\x9b\x82\x92\xb1eu,\x90f\x95\xd2\xfa2\x98\xa0\x10L\xd2\xc859\x9a\x90\x94\xb3\"\x9c\x14\x0fujf\x1e\x15A\xad\xef\xa9\xd9Q\xde\xb5\xd6\xf2\x1a\x18\x7f\x8ek*.\xb3\x1c\x1f\xd2\xcc\xa3\xfd\xad\xe0OXLw\xe1=\xdb'#HL\xdf\xb3OG;J\xeeU\xf1\xaa\xc8\x12\xe7\x94d^P\xa9B\x99*\x7fh\xd8\xd1F\x9a\xf2\xe1\xa9\xb7k\x0e8\xa9\x81Y\xacX\x8b\xcc0pSD\xbem'u\x85\xd3z\xfa\xdc\xf9\xe1\x86\xb0\xac\xed<\xc7\x86\x97\x86Q\xca3v\xf2\x0ar\xf3#\xee\xe8Z\xfe|n\xba\xf8\xf4\xa4M\\\x84\x8bn\x9c\xb1\x86\x15\x11\xf6t\x9e\x08&\xca\xd5\xa4\xd8\x01\xd5t\x99\x8do\xf2\xfeF\x0f\x16Q\x98\x19fS?:\x87\xdar\x89\xed_\x9c\xd6\xcd\x84\x16\x16\xcc\x84\x16\x16\xeee\x96\xc4\xac*'\xe0\xee\x97z\x0e\xf9\xd8\xf0\xf4\xac\xd4\xe7\xed\x93\xc1\x01\xe2p\xbe\xa4\xff\xa4\x1bK'\x91O<\xf1\xc8\x96\xcb\x8e\xcb\xd3\xbf\x1d\x99F\xb8\x0c\xd7xe\xeb\x0db\x15g\x9d\xb3\xa6H\x0b\xe6%X\x1f\x0a\xfb\xa7\xaf\x05\x9f\xdb\xd2\xd1FC\xf4\x14\x93x\xc7\xfc4\xcb\xd8\x84k/Na(\xd5J\xcf~\x8a\x13\"l2f>\xeb\xd0\x08\xa4\xdf\xb8\xb37X\xb2(\x98\x99\x8d9\xfc\x04X7j\xd1gj\x88b\xe3\x98\x9d-\x87\xa5\x87\x93\x8ex\xbd\xef\xde\xa2\xcf^\xec7M\x1a\xc0\x99\xb2}\xb6\xefI\xaeI&-TC\xbf\x02\xbey\xafq\x04Ho?ai\xa5\x14r-\x15\xd8\xb0\x84.\xec\xe2\xe9\xe3g\xc3\x83\xc2\xf5\x0e\x1c\xdb\xae(\x14\xc5\xe9\x8b\xdd5\xcc\xfc=x\xca6\xe3)\x9a\x0c\xca\xe6d\x16\xe0\x00]\xf5\xb0V\xf2\xa60\xc7J\x1b4\xba6qJqa6*\x17\x0a\xe4B\x83nf\xcb\x02\xcaea\xe9\xe6\xdc(S\xbajE\xe4\x81\xc2\xa4\x89\x17\xc5\x17>hI\x11sk\x88!s\x8e*#\x86==['\xc05\xfc\x91%\xc2\xb0&4\xb5\xb5\xa8\xa0\x7fv\xd8o\x91\xb7\xdc\xbb\x19\xe1:\xa8BD9\xbf\x7fa\xe17e~\xa2\x85\x8f\x0adq\xca\x8a|D\xe0\xa7\x88\xd3\x99\xcb+^\x08R$\xe3\x98\x9bXW\x19)BZ\xc5\xceb\xb8\xaaKK\xd4\xd5\x1a\x1c\xa4\x03\xf6y\x96\xff\xd9QF\xceN04\x16b\x0f\xf7\xf4I\xa9\xadc~\x17\xddeZ\x0cX\xe1\x9d\xca\xb6M\xbc\x026l\xb4\x1d\x0b\xe4\xd8\x91\xeb0\xc6\xe4\x01\xf2K\x17\xe1\xca\xba:\xb8\x91\xd1\x02\x8c5\x9b\x16\xac\xd60\xee\xa6\xe6\xa4\x10\xd3\xbbdE\xa0\xae\xd54K\x05\xcfY\x15\xd1 \xa2\xa8WF)\x0bk!\xe9\xe5r0%\xf9Y!I\x0a\xec\xeepV\x96\xdf\\\x8d\x9c\x09l\xf4\x13\x97\x91\xe2\x82\xb9Ld\xc0\xc0\xe77\xa8\x96\xbd?\x13G\x86l\x8cu\xfb\x976\xd3N\x05\xbbN\xa0nG]\x02\xce,'\xbb\x06q\xdc\x9de\xd5g\xa7\xcf\xe3~\xdc\xf5\xaa\xd8\xa3M\x9c\xc6)\xe7\xfd\x14\x08Hi\x16v\xf4qk\x9d\xa6\xcc\x15C\xc7\x10Q\x1c>\xc6\x99\xd3\x01\xb1\xa6\xd9\x12\x01%-\x1a\xda8\xa2x\xa3\xb2\x86wk\xa1\x0f\xcf\xbcZu\x877.\x15P\xe60\x9dk&\xa6Q\xd5_\x0a\xf8\x02|\x8d\xdbia\x12\x16\x90\x9c=\x91\x0f\xdb\x0a'\xbcuP\x1c\xbb\xdb\xfe&\xe5Y\xa9\xd6@\xc1\x9b{\x9d\xb7\xcf\x8b\xc7e\xacn\xb8\x96PMl\xf7#\x91\xa2\",\xe2(\x135w\xe4\xe2ZH\x98~lX\xeb\xe1$Yv\x08\x93\x0e\x0d\x93\x9b\x1dX\x86{\xbbC\xf0BE\xf8!|\x84\"\xfc&gp\xe3\xa1\xdfMX\xc5\x91\xdcg\xff\x97\x16L\x17<\x0b\x7f\x12V\x1c;ej\xf8\xf4O\xd0\xa5DR\x0b4C\xa6\xb9M\x80;i\xf1\xcd\x0f\xda2S(\xa1\xcaHo\xddy\x85\x8f\x85\x83\xbdwP\xd5c\xeaa\xe3\xc4ctr\x8d\xadb\x0b\x0e\xcb-\x80\x7f\xd0.Ja\x0b8C\x1bf\x1a\x80?\xf2\xd0#Z,\xce\xe86ZcB(\xcaO\x82\xd5\xd4\x87\x98c\x07o\xcb\xed\x01\xef\x0d\xd1?,\xbd?\xcat\xb5P\x96\xae\xebu\x8fZ\xaf\x1b\xebd|\xebX#<\x89>\xe1Y\x85\xfe\xc2X\xb7H\xe8\xfdiXt\x9c\xaa<\x8a|12\xf7\xd0\xc8\x00\x92\xa4h\xb1\x19V\xb7\x91\x8dH\x89e\xcfQCV\x0f$\xf6\x88\x0f\\\x08YaDC\xabZM~\x8dT\x93\xe6$\xd7\x0e\x1a\xcf\x98\xf1\xcf\x92p\x04\xda\x97\x958\x03\xa8\x84\x9ciD\xe2a\x12\x9d0-\xf4,H\x07\xcfC;@\x8a\xe9k\x17E\xaf]\x92\x9c9c(\x1b\xa1\xbe\x0c\x8e\xb9D\x1b\xc9\x1e\xe1+\x9e]%AX\xc6\xae\x929\xbf\xc5N\x11\xaaF0\xc8t'\xfayq\xfcN\xdc\x91\x05H\xa15\xcc\x04\xb0\x03&\x9d\xc8>\x0d\x9a,\xb1\xec\xbfxw[\x9d\x8f\x86\x14\x1f\xae\x98\x89\xf4p\xbe\x8e,_\x9cu\xde\xdf\x1c\xb8\xe2p\x1b\x19\x13\xf6w\x94M\xf73\x07\xa0\\\xa6\x82\xba9\x8b^\"\xf5\xfcc\x09\xa7\xec\\\xf8\xe7\x05\x02\xf9N\xaa:\"I\x97\xc4j\x17\xfd\xf1\xa5E\xf1\x95\xbe\xc2\x90\x0fXG[\x0e\xb8\xdb\xe0\xb5c\xf6o\x93{\xec\xa2\xf2\x17I6Z|yr!\xf3\xf0\x00\xea\xca\xfbd\xb7R\xf8\xc13\xa9\xf0\x16\xde\xe6e\x9c5\xfd\xb9\x86?\x9f\xf8\xcf\xe7V\x15\xfe\xd5_\xfe\xf8\xc7\x1f^\xa6\x7f\xa4\xff\x8a\xe2\xea7\x83\xef_\xfe\xee\x15Z\x0c,:\xf6Mk\xd4\xbb_\x83\xe3\xe4\xd9\xb4\x1e\x9b\xec \x9d\xfa\xba9rj\xbf\x86r\xb5{L\xf8\xb2\x90-\xd3\x14\xbeC\xd9\x15|\xd9%\x85\xc1\xa8n\x1c\xb3\xe68\xf6\xd6u\xe0\x17\xe8\xe8\x1fm\xd0\x0a\xaf\xd3\xd8\xd1\xccZ-\xb7a\xd1\x1as)\xb4\x92\x99\x1a\xd6pN-\xc3\xe2\x9b\xc1\xaf\xbe\xfd\xf1w\xdf~\xf7\xdd\xcb\xdf&\x84s:\xee\xb3\x87b\xb5\xe4T\xff([\xb3\xb6$\xf3\x8d\xf59\x9a\xb4\xa6bEj\xbe\x05\x9a\x93\x15\x04\xed\xefm\xc9\x0d\x87\x0f\xc3\xd2\xc7\x0e\xdbu\xac\xfe\x96\x8c\x09\xf2\xee!\x06\xba\x0d\x17m\x8d\x1b\xff\x18\x9e\xb2\x0e\x8b\xc2\xeb\x1cf[E\xb8\x8b#N\xa0c+\xe2E\xd8\x84'\xbfs\x9b\x97\xa5\xcc\xc6\x989\x98a\xec\x9f\x84s\xca\x11\xba\xc8\xd0\xa7\x94=\xa4\xe7k.\x9e'\xa9V\xdc\xe8G\x1dM\x81\x0c\xaa\xb8-:5\x19\xae\xb5 \xbf\x82\xc2K\x86\x7f\x8d\x83Psp\xd3_\xdb[2\xc8\xb0\xa4u\xdc5\xd6\xfc\"\xac\x9f\x8b\xe5s\xa8\xd5J\\\x05\xf0\x06O\xe0\xc2\xf1\xf0l\x0d:\xb5\xc2\xa1\x82U\x86\xaf3&I\xc8t'\x8e\xdc\x83\xcevx[l\xdcqA\x9b\xc8\xb41l\xe2\x08\xce\xa0\x9f[\xd3Dj\x913\x98\x1e}\xef\xfc\x8e\xb0\x90\x94\xadc\xd7I\xdf\xc4q\xd8\xf0\xe7\xb5\x11\xa7\xe3y\x7f\xa1\xe8fbK*\xbe\xc8\x89+\x9e^\x8a\x91\x94\xaf*\x07\x91\x07\xdb\xe1\x93\x19\x918N\xd3Az\xfe\x0d\x8c\xd1\xca\x11\x9c\xad\x93\x08)\x9b\xe2\x0a[\xb0\xc7W\xd7\xca\x9d\xf3\xf0\xc0\xf5\\\xda\xc2\x1b\xdf=\xf4\x12\x17\xcb 5\x80\xd1\xb0U\xa9\xb6O\x8c\xabG\x90\xd7\x06\xe6\xdf(\x8e\xb2\xeb8\xc2\xabW\xce\xd1\xf3m\x91O\x09L\x0e^\x07WK\xf7\xeb\x8cR`G9\xee]b{\xccB?\xca4\x1a6\xbcw\xb6\xa5W\x8c\xf54\xe1\xad\x1e8=b\x83!&\x0a\x8f\xaf\xc9\xcb\xc7f\x84{|\xbf\x9a\xac\xa4\x0b\x84\xa6\xc2Gqb\x16\xd6\x97\xdc\xa9\xa2@\xb3\x0a\x0d0\xce\xf5:\xac\xe5\xce\xb8Y\xa0M\xfa\xa1\xf8\x8eg>\x14\x85\xc9`)\xb3\xbb\x00\x8f\xf1\x8as\xa0\xb5&\xc4\x97\xaa\xcd\xa0\xb3\x97>\xa8\xd2\xfb!\xc74NC\xb7\xb8D\x16\xe1\xb3\xd7\xd2y\x86\x01\xba\x0b\xef\x07\x1e\x02QS_:\x920A><\xc0\x86s50T\x09\x9c\xbd\xcdf\x0c\xc3\x14[\x8a\xddp\x89y\x01n9\xcaz\x86\xfbb\xbb\x87/z$\xa1T1]'tkw`\xf2H\xa3\x9fo~Hp\xfat\x8e\xd1n\x80!\x06-\x91|\xe4Gl\xc2\xb6\xf3\xb44\xfc\x86M\xb2\xfe\x0c\xc3\x12\xa7f\x1a\xcbg6V}\xc4\xe4\x8f2O\xd8-\x01j\xc95mx:p \x7f\xa0\xeb\x80\xc5\xa1\xd3\x81\x89\xd9\x07\xec\xfa\x19\x16\xaaMR,t`\x97\x88\xc3\xe5\xb1^7Yp#\xae\x8b&>\x15\xdcx\xc0\x9b\x88u\x1aX<\x02&\xf2#g\xd0\xc1\xa1\x12\xee!\xa4i\x9fh\x9e7\x909\x80\x01\xf3K\x03\xbb\x19\xe7_\xd7p\xe4_\xc3\xfaDT\x04c\x08\xd2(\xcf\xd8\x01\x9d\x84k\xb9\x95y\x08\x01\xac\xaeZ\xbf\x90&*x\x05F*@!\x09\xfc\x93\xe9\xa30>pOF>X\x1f\xbfH\xa0\x9d\xc4\x7f\x7fQf3\x94Y\xe2\x89([,!\xd4\xe8\xf0\x9c\xc2F\xcc\x14\xd9\xe1C\x0b\xe0\xd1g\xb3\xa20\x00\x92\xd6\x94'\xa0/;\xc3'\xe2@\xda\x11\x99\x83i\x0e\x1a\xc9\x92 \xca\x86\x81\xf6\\3N\xda\xb9\xf6;k4$\x03m\xebG?N\xfb\xbb\x9aL\xf7\xac\xb3)~<\xed\xbc-\x9fd\xadvK\xde\xa3\xd0n\xf7\x9d}\x00\xb8\x96\x85\x07\x14[\x0e6\xd4\xa38\x1d\xad\x96\xdb\x87\xa6\x93\x8f\x00\xd3\x01\xa8\xfd\xc6\x88we\x9b4,b\x15\xd6a.\xc0\x10\x88e\xd2w)\xb6\xb4\xe2\x1e\xde\xbd\xe6F9\xde\x96\xd9Y%\xe4\x85\xf8{\"7\x83\x97\xa4-\xa4qk\xa9g\xb8^\x17W\xc6'*mH\xe7dX\x9b\x1fU]\x98#\xa9\x02\xea)\x8a9\xcc\xa9\x84o\xc1.\xc9\xe2 x\x02<\xb9Tym\xc9\xda\x1b\x916\xf8j\x14\"\xd4\x95\xe9K8\xf8\xde\xedR\xa7\x1c\x1e\xb5\x85\x91\xb6\xe1\xb1\xab\xc9\xc8+\x91\xfa\x9f\xdf\xd9\xbf\x88\x1cM\xdc\xc0\xb0\xb0\xcd\xa3.\xde\x03\x09\x93\x91\xd2 \xf666&\x95\xad\xac\xbe\xc8I\x13+=}\xde*ln\xa3\x12\xb4\xd6\x0c\x10!\xb8\xad+\x0b$\x13\\\x7f@d\xda\x9b8\xc6rx\x17\x02l\x10\xbe*I\xf6\x15\x1a7\xb2z\x19\xdfJlWm\x9fef\xe4\x15\xca\x9b\xe6I\xab\x1f\x1e\x18\xc8\x06\xfd\x90\xfe\xc0\xce5\xef\xf0\x06\xd3\x80\\\xb1y)jDaa\x84\x87\xe8\xed\x88\x0b\xa9\xe6\x9a\xd6\x99\xd8\"\xed\xad~%!Y\x0e3\x94[}\xda\x06\xe4\xb1\xa4\x0b\x90\xc8\xe4\x85#\x13k3\x19X\x0a:\x835\"< \\\x83\x1b\xd45\x97\x07\xd4\xa6\"\x18\x88V\xd0\xf2vR-t]\x8b\x0b\x92\xbd\x07\x8fs\xda\xfb8;\xcb\xec\xd5MX\xf9\xb7\xdc\xf1V\x14/\xcf\x9dC\xb2\xd3U\xde\xc8L$V\xe8K\x9d\xda\xa0\xf2\xc6\x8a\x99A\xb5T\xd8e]+J\xbfL[\xb2J\x9e\x93\x169\x92\xa7j\xb6\xf3*%\x80Iw?\x9c?\xbb@\x10\xb2\xbb\x13\x89-\x10\xa5\xcb\x1a#\xf6Y\xb8E\xf5\xf14\x90\x82R\xd7\xb0\x18'a\x85bO\x9c\xc72\xa5\x91\"\xa5X\xd1\xd5\x8e\x17\xc2\xec#K\xb7\x8b\x10*\xe4\x0a3L\x0b\x82>\xcf\x8a\x04\xcar\xb0\xa5F\xea\x83\xc0\x8e\xda\xc4\xd7\xfcnx\x016\xd1@\x1b\xdb;\x02\x89\xe4\x08|L\xa2\x8f\xc5\xf0o\x1e\xffJ\x1b\xda=1\x1d\x11n\xfb\x1e'\x0cD;\x07\xb1\xe2\xc9N\x7f\xd7\xdaEi\xfe\xdd\xc2\xe5\xf0\x02\xa2;\xec\xd2\xecsa\xd2e\xef\x94\xdf&\xcc\xf5k\xfc&\x1d\xc6+\x81%+\x1e\x92\xfb\xb7\xf8J%\x95\x14w\xdbXSZ\x15\x00w.1\xc6\x81E\xf8Dd+3\xb6\x00\x02`\x98\xf2\xc0rC\x0eZ\x11\xaa<P\x88\x7fg\xb6w+\\he\xb3\x18<\x079F\x17\xef\xb4\xf3\xaf\x8e\x15\xd6\x9c\x0c\x88\xdc\xee\xe7\xfe\x13B\xf9IQ\x96K\xc9\xd9\xc7\x1cF\x99\x99\x9a}r\x0dV\xea+\xdc\xc5\xda~\xb0\x8d\x95\xfd\x13\x8f\xf1\xf6\xef9\x89{W\xfa\x8cy\xc1\xed\xa3\xdd)\x8e\xd9{K\xa1\x90\xb5\xc3D\xb3\x81\xec0\\\xb9;f\xe7<\xc7_\xdf\x86e\xfbYx`\xdfZ\x84U\xcbR\xf0\x8d\xbbG~$b\xf24\xfe\xadH5\x89\x10n\xe1R\x9c/h\xd0\xdd\xda\xb1\x885j\xac\x85\xb7Xj\x82\xbd\xf6\xd6%34\xe3\x05g\xb4\xa1T\xa0]Rk\x03\x0cC\x18\xf69\xfe\xd93\xe1\xda\xe79U\x80\xef:\xcf\x0f\x8e2+\x04j\xeaU\xbe\xabo\xd2wD\xb5=\xba.S\xd7\x81\xf9`\x8e\x88Xi\x8e\xeag\x83\xed\x9f\xb3\xfe\xd4\x8a\xe3\xa9\xcb\x1cvF-\xcd\xbc\x81MX\xc4\x11\x15\xe9\x10\xdb\x11n\x99i\xfdp0r)LLp\x15\x86\xd1\xd9t\xbc!T\xd5\xd4y\xcbu\xf7\xe0\x8f\xd8\xa8X\xc1k|\x88\x8d\x7f\x8a\xe5\xb40\xb7\xfc\"\x97\xfb\xa0\xa5z:a\xc1\x81\x158&\xb25\x86'n\xfb\x9cy\xbb`w\xcb\x07\xdb\x02\xcf\xe1\x03\xc2%\xf6\x8f5!\xa4WT\xbb9\xce\xca\x02s\x0dRS\xd2\x96i\xd1\xf4\xe1 h\x7f\xe6\x93\x9aj\x12!s\x0c\x9fq\"\xa4=\x82\xeds\xdd\xbdr>k\xa8\xb9\x0a\x95\xbcc\xbe\x88\xb4\x0c\xefyG'{n\x8e\xb7\x12;\xbclu\x8c\x8cc\xe2\xa2\"\xae\x9a\xb6\xb0\xe8\x14\xc1DN4\xa6\xc5\xa1Z\x88\xfc\x94-\x06\xd3\x0c\xf5\xc9<\x87@j~>'\xc5\xd8\x83\xe9\xf6\xc0RDd5(H\xd8\xc1\x01\\\xbc9\xab\xcbZn\xca|\xff\xf3\x18\xcf\x8cA\xb8\x93\xb8\xa3\x9b3\xf9\xe04\xf7\xa3\x9c|\x0fY\x84\x85\xa7\xb8WRk\x9ej\x98D\xb4\x1a\xad;\x9c\xfaD-\xd7(\x01\x08A\x02\xc8\x0b//\xd5\xb3\xeeN\xe4\xe6\x19\xd1Z>\x9d\x15\"w\x89\xdbozz:X\x9b\x8e(\xfb\xc1\xf9\x17\xb2\x12g\x09\xb9%\xee\x15\xa2\xc0\xc1\x90;gl\xf3\x999 \xa0\xd4\x1a}\xc0\xa9l\x86vvj\xeev\xa5\xcf\xec*v\xe6\x0d\x91\xce\xfd\xbeC\xec\xf0E\xbe\x19\x12\x96\x94\x97\x0e\x17\xb9\xb2\xc9\xcaayx4l\x0biaN0\xd8\xac!xn\x02\xdf$sm\x1b$]-7)\xd3\xc6M\xbd\xb3\"\x09\x7fL~\x83Q\xb3\x1e\xa8\x1a\xac\x8a\xb5@+\x0c\xf2\xceJ\x0e\xa8\xbd\x16\xa7\x14\x9b\xa1\xd3\x10\xfe\xc0\x9b\xaf\xbb\xbb\xaam\xfe2\xa9\xa5\x9c\xebT\x96\xe7\x15h\x8d5\xbeu\xd6xo\xaam\x01\xc6\x80\xa3\xb1\xe9I\xa7\xe9\xd5S&\xe8\x98\xdb\xb4k\xbb&\xa7{\xca\x08\"WO\xd4Rw\x1cf\x91\xeb\x09\xe5\x9f\x19sI\x19\xb3\xcf\xca\x1d\xda\x85\xb7M\x16[\xe1\xfcT\xdb\xa3\x92\xfe(\x00\x87\xacu\xe0\x01\xc7\xac\x19Z~=O\\-\xc3\x04\xe91\xc4\x97g\x12\xab\x1b*\xba\xe9\x98\x090\x09f\xedIP\x0b\xf0\xbb%k\xdc\xca\xf6\x1a\x11\xa1\xb0\xe0\xd0\xc74\xac8d\xb2@\x96\x17b\xe6\xa6\x124\xbe\xffm\xfa\xb2\x1d\xdee\xfb~e\xaa:\xe34\xbcOqR\xf5\xdb?\xb2\x13\x9f~5CD\xa5\x8f\xfc)-\x15\\\xc4,E\xd6U\x80\x12y\xd9\xa8\xc5\x81^\xb2\x9d\xaa\xa7\xbd:w}\xb6\xdeN,\xccq'\x18\xe6=t\x1d\xcb\xe8kTp\x81d\x1cT*\x1c\xc7\xf8ye\x8c&\xf4X\x0a_p[\xf9\xa8\x82\xbf\xc9a\xb9e\xb0\xed\xc8\x0a\x1d\xbb\x87\xef\xb4i\xef\xfa\xde\xf4$S\xfc\\rh\xd7\xe6q\xb1\xbb-\xe7bF-\xbb\xb4\xab\x93<'\xc4\xda\xf1\xf6\xbfD,\xd7\xb3S\xe35\x93\xc0\x85\xf5\xa0\xeb\x16\xfc5\xfc3N\x95\xa2\xe4\x11zp\xc9\x87\xa9~\xa7\x05\x154_\x04M\xde\x05\x1b\xba,U\xe1\xdfJ\x11\x0dKH'\xcf\x91\xc1n#p\xc2;\xfa\xfd\xb4\x8c\xfe\xd7\x1a\xc9\\n\xcc\xc0\xf7\xd84\xb8\xc3v\x1b\x8a\x8a\xc1\xde\x0c\x09y\x90\xd2q\xa9\xdap%\xa7\x15\xca\xafqVd\xbbw<\xfd\xd9n\x14\xd6\xe1\xadj\xb7\xb9\x1a\x9cnE6\xa6\x14\xee\x12f#\x03d\xac\x16\xa4\x94\xa8\xc3\x0d.\xf0\xbd\xc8\xfc\x80\xc7\xebg\xa5\xf7:\xf74.\xc4@\xa5\x81n\x15Y,\xd4\xd9v\xb42\xdf\xa8\x09\x1c\x99`\x1f\xb9\x9aW\x1eE_\xea\x01\xd0u\xac\x1e\xb5\x81\x13n\xae~Do" +
+	"\xb5J\xe2\x86\xe9K\x80x(}OX\x11/\x1b\x06k\x1bV\xc5\xe0pq\x930e\x03\xae\x88\xbc'4M\xda\xcfw\xd9\xe2\x86\xe3|\xc4aH\xf2\xe6n\xc2[%J\xc4\x13\x9643\x97|\xc6\x82\"\x00]\x16\x96j\x12\xc6Y\xf8\x85\xe5\xb6\xfa\x8e\xe3\xa3\x03\xaf\xfa\x0al2\xe9\x9f\x99\x13b\xb9\xf4D\xfa0\x93\xd4@\xc8\xcc\x06\xa9a\x97\xa6v\x96*\x86\xa8\xab\xcdr\xb4\x18\xaa{\x0c\x97\x96\xdax\xf8\x19^\x04\x9b=|\x1d\x9e\x17W\x8e\xe7|\x13\xc7a\x81\xe2\xfc\x94\x16Y\xb1r\x83\x82E,\x87\xa5\x89\xb8,\x8d\xfa\xe5\x96I\xa4\x0d\xef\x8b\xdc\xf0\x13\xf4\x09\xd76\xb4x\x1d\xd6\x18Y\x9e3\xf3\xd4\xeb\xd4\xac\x91\xd4d\xdc\x100Q\x13\xde>b\xf9\x09l\xf6'\xbc\x1aK\x80\x09\xce\xe73\x1b#\x16[\xbf1\xd2r\xf0EX\xc5&V\xf4\xce\x89\x0b\x96\xcf\xd8'F\x7f\x8d\xe8\xae\xa2(\xc6\x82\xb9\xe2\xe0k\x81r\xd7iK\xb8(\x09\x01\x96\x86\xf9\xc8\xd0[Qk\xd7N\xca\xb9U\xc3\xe81j\xd3\x96\x90\xbaA\xd2\x83\xf2\x89\x80\x0e\xd4\xd5\xd62\xc0\xb0\x0a\x1f,\xe6\x98\x86\xb2\xd4\xd2\xe3\x82\x7fo\xe3H\xac\xd0\xcd\x11\xe36h\x90\xf9@\xca_\xe2kut\x93\x9a$\xb9\xf6\xd2L\x18!\xe0\xf0]s\xc1\xef\x02\xfd\x08\x86\xd6\xe2X\x83\xe3\xce\x1a\xa82\xc5my\x17.\xf4\x86\xffX\xe0w6D\x05\x7f\xe3\x80\xf2\x8f\xf0\xadR\x1f\xa4.\x84y>c\x0a\xdc\xad\xa18WS\x08\x9f=P\x05WS\x8c\xeeZ3\xd7[hk\xc8\x87\xfe\xc8|\xe9\x9d\xf2\xbdi\x9e\x92D\x06sG`\xb8\xca\x9f.aI{\xddLmi\x7f\xf0\xe2\xa2\xd7\xea\x8b\xd2\x18\x9d\xee\xde\xb1:*q\xdd\xa9I\xb9\x1f g\xc5\x83\x80\x81\xd4L\x92\x0f\xfd#[\x82U|H9\xb54F\xa2o\x8d]?Mi;\xd5\x9c~=P\xdd`\x05\xb4\xc4\xa9\xe9\x1a\xb6\xc9'\xd4\xf9\x99\xa6\xa8\xb2\x19\x8e\xb9\xdc\xaf\xb2\x12\x0b\xb1B\x00qWW\x9d\xf5\x9f\xcb\x9fx3$\x0e\x8f5R$\xe0\xc4\xa5o\xa1}\xbc\x94]d\xd2\x0amU\xfe\x16\x8e&\x02O\xbb\x11l\x17\xaf\x95O\xa5\xff\xe0>\xdfo\xbf\"\xe2\xc0:g\x1c\xcc\xe8\xe3\xf1\xaa\xa6\xa5\x03\xd6\xe2\x87\xe4=\xbb\xdc\x9f\x86\x9e\x9e\xd6\x85\xc8\xeb4\xf4\x82\xb9\x8b-|\x0cHg`v\xa48\xd3\xbe\xe8\xcd\xa5\x1e\x0f\xf7\xeb\x8bg\xec\xdd\xb9\xa9\x1bnh\x9e\xc1\x1fOP$\x8a$\x079\xcft\xc9h1\xe6\xd0\x9c\xa5\x80\xb2\xed\xd3\xae\xbc\xbe\x1c\xe8\xf1AI\xbeI\xefS\xdaUd\xfa\xe1\x97\x19\x8b)\x05\xe3\xee\x07\xbbw\xff\xe3C'V\xc3\x9b\x9b\x09[\xa90!\xb2\xcc\xb2@*\xedc\x84\xcf\xb0\xde\xc54^t?\xef\xc8?\x0f\xc9k\xee\xf3{8F\xa0\xdd\xee\x15\xad\xb87J\x13\xf2\x06\x82\xb8X\xac\x17g\xb1\xe1\x1fw\x93j\xca\x8f\x19\x12\x9e.\xde\xb1d\x8fM)\xad\x92\xaeU2\xf8*\xa6\xe8\xbe{D\x9ak\x82\x04\xc8q\xcd\xb5\x94\xbdH\xe8\xe3}d\xa0\xb5\xce\x8fk\x9a}\x04\xd8\xc1\xb0\xc7\xbc\xf5O\xc3\x07\x90\xcd\x0f7p\\\x12\x80\x07+\xa7\xa6Z\xf09\xa1rx<\xb7\xe3\x8cOiCV\xe3R;e\x0b\x0e\xd5\"w\xe5\x00\x8fO\x8b\x0e\xaf\x13gbp\x92X\xe6&\xf0\x06\x16\x03\xc1\xfb\xbe^\x15)T\x81\xde\x1b[\xc0|A\xf8\xdfA\xac\xc2S\x9c\x91\xcb\xe7\x1b\xf1\x11\xad\xfc\x8f=\x07\xfeq\xd7)\xc61\x0a\x9bA\xfe\xc5\xc4\x90\xe0\xeb\x05\xbf\xa5T\xabq\xe0\xcbTT\xa4[p\x127\x13\xeb\xe2\x1b\xc35\xc6\xe1\xc62\xb0|D\x91N\x8f\xb4\xea?\xaa\x86hy\xe07\\\x9c.\xd4\xbd\xe5\x0ex\x9e~K\x97Fc\xfe\xb9z\x97u\xce\x1d\xc1:\x06\x0eJY\x92\x00\xa1B\xb2\x11I\xc1\xcc\x069QxF\xe7\xd8\xd2\xbb~\x96\x0c>gf(\xc7Y3\xd1#&\xb6\xea\xce\x19t\xf2b\xbf\x13\x14V\xe1\x96\xfdg\xb6\x99\xdbVc\xab\x1e\xb9U\x8d\xec\xf9\x8a\xda\xfe\x10D\x1eo\x91{\xd6\x0a^\xb6\xcf_\x02IU\x86\xe7\xceh\xe8s@4Ooj\x10\x8a\x1f\xf7\x81W\x1c;j\x0b\x88\xe6w\x06/N\x86\xad\xca\xa3\x1e\xae^\x95y\xb0\xbdc\xc6\xd3\xbc\x9e\xcc\x05\x12I\x13Z&\"\x06\xa6\x91x\xe4\xfe\xdab=\xff#\xa2\x94\xcb\x8d=\xe8\x8c\xf3(\x85\xf3\xccS8wr\x81\xc1\xcb\x01\xcf8\xd1L\xe1\x08\xde\"\x10\xdb\xcfT\x03\xd7c*\x0f\x8c\xff\xfb\x084\xd2f4\xf1\xa2\xdc\xfa\xc3\x1dFOK\x04\xaf\xa0\x81!\xb5u[\xa4S1\xcc\xf7\xdb\xf0\x91\xfe\xc9\x1a\x1f\xcavo\x8df\xad\x92Z\xc2!\xf6\xa9\xdc\xda\xa36\xcd4\xb2u\xa8\xee\x88Y\xee\x13>\x12\x9f\x90\x88\xaa\xcc1m\\\xd4\x00\x8cK]\x8a\xa4fH\xdaB\xa4n\x03i\xd3\xfd\xb7Bj\x12\xb0\x91\x1f\xee\x8b\x8b>\xb1\xc6\xce\x841M\xcb8VcP76A\x95\xd3\x19\x02\x8d\xc7[\x0f\xc2{d9\x94\xac\x0aN=\xe5\xfb\xbc7E\xcf\xae'n1\xbf\xa7\xa5\x86\x88D\"\x06b\xed>\x8c\x90u\xbe\x88c7\xa5q\xc0\x1c\xd5\x8a\xe9i\x9c\xda3\x8c\\WF\x9f\xd8\xb8\xbb\xe8J\x14\x01F\xd4O\xaa\xca\xcd.\xbf\xe5\xeb\xf5\xf3\xc2\xd2EU\x94\x9a\xa2$\x1f\xdb\x96}\xf1\x89Tv\x9b:\xe3:uuX\x95;\xe3\xb4\xbd\xe7M\xda\xc60\x87\xa1\x85Gx\x97i|\xe2\x11\xe6\x88\x99\xe1\x1b\x86\xc7t\x83\xf0\x84\xef\xb5!\xc5\xd4\x8e\x1b\x9d\xb7k<\xf2\xb2\x147\xc9\x1d|^\x18{\xc4_4\x0c\xc29\x9c\xde`\x01\x97\\\x1cX\x8b\x8eN\x13/\xecG\xb9 )\xdb\x9d|\x0d\x9d\xd2\xda\x96\xb0\xee\xd9\x0f\x95\x81\xdb\x15\xc1@r\xba\xc5S\xdc.\x19\xee\xcbT\x9c\x0e\x9feJ1H:\xdc\x86\x07\x9e\xbf#\x12\x1e\xa1-')\x08\x8a\xb2\xa6\xff\xea\x86\xcd\xbbRC\x0e\x0a\xf4(\xf8\x00\x8a\xae\xa0@\xd2\x13\xea\xb2H.\xba[\x8f`!L\x8f\x1d\xe3\x8a;+\x1e$s*\x9a#=\xa6\xde4\xc6\xe9aK'\xc6+\x0b\x14GF\x0bp!E9w\xa8\xdb\xa9\x85\x1b\xc5\x9a\xfe@\x9d?\xac\x12m\xf8\xd8X\xc5ilD\x10\x86\x1b\x0c\x13\x1b\xcb\xa2\xf0?\xbb\x96\xa0e\xc1\xc6\xb6\xb1zf\x07\xa5\xbc;~\xdf\xdbHw\x99pq\x9930\xe3\xf2\xdc\xf5\x1cZ\x06\xab8\x91\xe2\xa2O\xa8\xefb\xb0J\xbf\xc0\x0e\xb4o\xd2\xeb\xb4%\x9a:\xb1\xe5\xa3\xdc\"S\x92\xdcn\x95f\xc3W\xa4\xff\xd98rG\x81\x96\xd4>\x15\x82\x7f\xd6\x02\x94q\xddhC\xe1\xc2}\xc6\xe4V\x9a\x8b\x9b\x85\x07\x1a\x00*\x03\xbarb\x88\x99\x0b\xb7PNl\xbd\x05\x8e\xcb\xd8f\x02v\\\xd8\x17\x17R\x06\xf0\x0e\xb7\xbb\xd9\xbfOK:\x9a;~m\xfd\x81\x91^+\xd4D\xcc\x9c\x9b\xd9\xfd\xe4i\x15\\\x02>%\xfb\xd0\x85\xa7\xa7{\xbaL\xce'H\xaf\xc5-\xcb\x88u/\xa8\x84\xa9\x9d2\xb4\xa4&)N\x8b\x9f\x8f8\x12\xd3\xa9.G\x15T,V\x09,\xc0\xc6|h\x88g\xdf\xc7\xe3\xc6\xf0)\xcf)\xa3!B\x06\x02\x7f\xeb\xf9\xf7y\xb6BO`\x8b\xb4:Ch\xf9\xb9M\xb4\x02dr\xaf\xc45\xc0\x94\xc0\x0d\xee\xd4T\xe1\x86\x14\x11\x96\xa7\x95\xf7\x14\xb5\x89j\xb7\xb9(\xc6\xb7R\xa2\xb0\xd7\x06-\xb3g8[Y\xd6Q^\x98\x93\xb2Z\x15\x01g\xc2:\x8es\x9d\x0f'\xd3\xa5B\x810\xa3!\xdc\xf3\x1e~y\xe5YZ\xd9n\xe5<\xbf%h\xc0E\xdfE\x0f\x97\xdf\x03\xb1\x91;\xef\x11\xc7\xd4\xbd\xe38\xb1\x9f\xbb\xed\xb7\x0a0f&\x88m\x04\xdf%\xab\xbe o46i\x0d2x\xb3/\xb3\x9e1\xc0\xeb\x91\xc9be\xdc\x0f\xa3\x82rT\xe1zy\xe7\x91\xe1\xc6\x8ac4n\xc5\x01K\x1f(\xc1\xacUe*\x99J}\xc7\xbe!W\xcf,Mc^\x92\xc1\xc1\xce]\x16\x92'\xf1\xc4\xd7R\x99\xe1\x1bj\xd7\xf4m\x1cu\xd6p\x9d\xd9b2w\x8a<qE\x91\x80\xf4A\x9d\xd2\xb8\x1e<\x0f\xd0\xeao)\x88\xceP;U\xf4?\x91\xf4\x0b\x1f\xe1\xf5\x00\x1d\x9by\x83\xb7\xe3%Vr\xba\xbbt\xe2:\x12\xd6\xe3\xceUz\xe6\xe5\xc9\xc81\\AbQ\xad\x92\xb4\x9fH\xed\xa8Q\x03\xb5\x93^\xdf\x93M\xb4\xda9\x98p\xea*\xf1\xec\xae\xb2\xc5\xb3\xc3\x96\xb7D\xee9\xd3\xfe\x08-\x00E\x15\xc9\x07\xc0\xbfY\xbd#\xfdP\x0b\xa14\x1eP\x88(\xda\x88%\xef\xa6\x1d\x81KR\x7f#\x19\xb1\x96P\x07`\xb3\x99\x88%\xf79\x19B\xe7\xc1\xb0\xaeb\x01\xb5\x0cW\x94K\xc5\"\x02A\x86\xf8SxgB\xdb\x92\xa1\xef4\x17E\x96\x93J\xc1\xd2\xdfv+\xb8\xc5\x89\xd8V\x95\xc0*\xd6\xac\x10!ME\xc4Tl\xba\x11I\xfa\x1b<\"\xe1rF\x0d\xc0'PR\xd9\xfd~\xc7\xf9F\xf2>\xf9\xf2\x03\xf8\x0f\xae_{((E\x8bM\xbce\xd2\xfb\xed;\x98#A\xef\x90V\xda\xc4\xfd\xbeT#\x9eQ:W\xf1\xa23BpvR\xe0c\x8a\x8d\x94\x80A\xa2\xa0\xc1\x1d)}\xce\xa5l\xf8\x82\xda\x9207S\xbc\x17H\xeb\x1b\xd7\xcb\x87u\xe6\x80\x9df\xbe\\r\xacr\x02\xc7d\xacI \x15\x01 \xb6\xe6\xb6\x83\xb2\x1e\xee\xc2\x15\xf9\xb0Q\xf0\x0e?G\xd0D\xe6\xfd\xf5\x1e\x8c\x86f|\xe4\xfc\x13Ee\xfbX\xf0(\xbce\xd6!7\x1b\xd7q\xac\xe9\xfeLB\x17\xca\x98[ $%\x83\xa0\xc6\x7f\xf0\x16z\xac4X>\xe9M$\xf7f\xb1-\xd7\xb8\x93[[\xf1\xca\xc2E\x86m\xd6\xb56R\xe3\x0bO\xfcZ\x00f\xcc\xdd\xe8\xcb\xb6)\xe6\xa6>\x00\x87\x1a=\x85p\x97\xeb\xc9y\x1d\xb1\xa0Fx\x7fU\xa6\xa3@\x1f\x9f\xd1\x0dr$\xde`q\xba\\\xd8\xd3<\xe3\x81\xd1^\xd5\xe3h9\xc6\xf4\x16\x03\x82\xb2c\xd1\\E\x1b\xad\x18Ip5\xe2\x16\xeeG\x82\xea\xa9\x00/Y\xe6\xaa3-\xe1M\xe7\x8fIT\xd3~\xca\x84b\x95\x8bS\xbeW\xe3\x91V\x1eH\xff[\xf4,\x97\x10d\x0f\xe0\x81H;\x19\x8aE\xd7zi\xd5n\x10\xf8S\x13\xce/+%.\xd1QL\xf0\xa7\x15\x9e\xc7%rEkx\xf5\xcdc\xc3\x13\xbfax#\xb8\xbe\xc9\x11\xe1Qig\xb6\xe6\x14\xfa\x96\xba\xbb\xb9\xba\xca\xa6\xdf\xd0(G\xf7\xea>O\x82\xc1Z\x98\x86+]\xe7\xb5#\xa0q\xb4^y\xbfA&\xb0\xbf\x7f\xea\xd8\xb4\x9dU\x8a,\xed^\x03\x09\xc8\x1cGn\x0dhp\x13\xd3\x14RTT\xe8r\xf4\xe9\xe3t\x17\xf2\xe7\xfc\xa0SS\xb7b\xb2d\x99\xb2+\xec\xce_\x98\xf3\x9dV\xd0]x\x0as\xd9\xda\xda\x97\xd1\xf7#\xc8G\xae\xd1B\\\xc7\x0b\x8c*\xf2u\xe0\xc8\xdd\x13\xdf\x12va\xb8\x92\xe1\xe2\"\x93\x8c\x95\x03\x0e\x06+\xc5amR^\xbcP\xa9g*JQ\x1e\x95^n\x9fVu\xddx\xf7\xae\x10G\xbb_)\xd6\xbbZ0r\xe5\xd87H\x09\xf6\x88G'8X\xbcR*\xd8 \x17\x98t\xa5}\x97\xee\x96\x87\xb4?\x8f\xc7\xe6\xfc\xb0]\x16\xd9\x1ao,\x8b\x87\xf2A\x18\x97\x1b\x10 \xfc\xc5\x8a\x14\x97\x09\x9f\xc2\xa7\xf0\xa1D\xbd\x83\x1b;\x03x\x10\x9d;p\xecP\xbb\xe2\x95*\x11\xb0WL\xde\x0a\xca\xf7\xc9\xa7\xc3\xe4\xc1\x81\xaf-\xed\x8c\xe7\xb5\xa454AE\xdc\xf6^A\xbaOi\xffs\xcbF\xb8\x0a\xd0\xb8f\xa3\xbb\x1c\x1c\x8e#v\xc1L\x02\xf7SX@w\x8b\x8fu\x95\xfeI\x08K\x05\xb1+\xf24\x0f\x14\xb8C\xc5\xbdkW\x99d\xa7ZXy\\\x8esn\xfd\xe47\xd5Z\x07\xb5\xc0\xd5k\x03\xc3\xde\xe9 \x87\xd3\xf9hi\xdc\xd6\x1a`\xbe\x09\x8b8\xe3\xbb\x94\x07\xd2QA\xcc\xbc\x02\xd1L\xf3\x0f\x1en\xa9wn\xe2t\xe7-\xac\x93\xce\xef\x05\xbfC\xdd\xed\xab\x01:\xf3\"V\xe1\x1f\x86\xc6=F\xe0\xf6hK\xd7\xb3\xf6\x0a\xee\xab\xe0\x81\xd1\x04W\xce\xd6\x0a3\xde\xe1J\x9d\xd4\xab\xd2\x96\xb2']\xc8y\xb74\xa42\xa4Id\x10\x19Q\xc6i\xf6\x8b(J\x8e0\x90\x98*f\xc0\xcdL7_\x8dy\xab\xca\xd2\x0f\xaa\xcf\x0f\x0b\xce\xfcD\x82\xd4q\xcc\x80\x8c\x0f-8\x00E\x8aV-\xe3\x98M\x0a\x97+-\x0b;@\x9e\x03\xf7\x82\x1e;\xf6\xac`\xc7~\x85l\x8aY\x87\x8c\xe5\x82Hbl\xb8\xf0\x02/\x883(\xaf\xabwq\xd2\x19\x8d\x06\xfb\xf2\x1d\xc1\x02hxm\x83\\\x85\x93j\x8fZ\x94\xe1\x8bB\xfd>\x0dI\xe7f\xceGk6\x03\xaa\xf4\x8c\xf0\x89\x8d##\x0eF\xc3\xcd\x0f\xc0,\xd3\x80\x81\xa8$\xe3S\xf1s3\xe3\xb3E\x97\xc8<u\x18\x0d\x9f\xb9X8\x06AQ\x1dI\xb4!\x8aq\xbf\xcf[\x84\xc3\x17m(V\xeb\xf83}2b\xe1B=\x1f\x94\xc7\x86\xfc\xe0\x8b\xe4\xfe\x18O\xd8\xa6dZ\xbc\xf7D\xb0\xb0\xe2PA\x19f{\xa98s-\xe6\xb4\xca\xfcl\x08k\x91T\xb7\xe5;|q\xd0zg\xce\x01\x8f\xd4R\x9f\xc7\x8bX\xa5\xcf\x0c\xc0\x87_#\xf5Cz\xd3X\x15\xa3\xbeX\xdc\xc1\xfe\xa9\xf2\xa1\xa5\xe0\x16l\xe9+~\x08\xf5\xf8\xc6\xc6J\xb1ck\xa31\xcd\xa5:o\xad*\xde\xdc\x87\x9c\xe11\xa9\x08\x82\xcd\xc5G\xdd\x0c'\xf6\xcf\xae\xb6!\x0c\x81\x0f0\xdf\xd0\x9e\xaaB\xaa\x1e\xfc\x04\x8faL\x9d}S\xf1*\x994I\x89\x8e\x90\xfd\xc4+Z\xe0\xa7x\xc8\xa82G'c\x92\xb5\xd1\x99\xdf\x83\xf4\xfc\x88q\xe8\x89k\xf2\x8a\xd5{X>\xc61\xe2V\xab\x0b \x0do\x00\xa9Q\xfay\x95\xc6\xc3\x17\x87V\xae2<\x18\xb8U{\xd6\x85\x05\xeb\xa89D\xda\xde:jc7\xeb\x16Ph4\x8fS\xc9\xb4u\xe7R\xe5\xad\xe5\x13!\xe0\xf6\xecCj\xa2q\x0d\x00v\x01b\xd6W\x1d\x9dp\xd4f\xd2\x9b\xf1\x02\xc0=\x08m\xe2<\x89&5\xbe\xb2^\xd2\xca\xb0)\xcaK\xcd\xd9\x9a\xcf\xc7l\xaf\x9a\xcfj\xe0\x99\xb9\xc9\x8cN\x85D\xd2\x01)\x97|>)\xe8\x0b'En`\xad\x9a\xccm\x18\x9a$\xf5eK\xf2\x01.\x90\xcf\xf2\xd60\xeb\xf8*zM\x87-\xe2X[\xa7=;\xe6r\x9b;\xd8=p\xb4\xc2]2::[\xec\xf9\x14\x14\xfe\xb8\x83\xf5{\xefc\xe8\xb45\x01\xc0\xfe\xbc\xc7,WN\x98\x8fl\x04\xd3Bm\xd6^|\x06\xc8\"H=\x1f\xae{\x9ae\xf9\x96\xd2K\xdf1!H\xe5{]\xcb\xbd\xcaW\xd1\x00P\x04b\xab`T\x9f\xee\xf3\xf3\xec\x83-v\x92\xbca\xa1\x88\x9e\xab\x02\xc4\xf7\x8aU\xea\xec\xf0\xd4\xf1Vj\x9a\xcd\xac\x12\xb8\xcdSS\xe7\xb3B\xf8\xd4\xc0z{\\Q6\x88d\xe8\xcc\xd2\xe0eXT\xd8\x9b\x8b?\xdap\xc9]&\xde\xd1\xc9}<|Q\xe0\xa6\x8eUz:\x90\x02Km\xa4p\x05\xd0t\x11\xc3\xab0\x9b\xc9&|\xbe=5\x1c\xb6Z\x83\x99\x03S\x9072\x12\x0e\xf8\xa5\xdat\xd2\xb8/\xd8^\x86\x07\x9dm\xb8\x0bO\x94\xcb\x82>\x916qW\xac\xa5\xa8d\xc9\xca<Zg\x09\x07#t\xec\xe7\xd0\xb6\x0e\x87\x9e \xa9?\x15\x9a'B\xe3,V]9vS\x85Pk\xbd\x84|\xe2(}\xd5]\xd4\xab0i\"`\x08J\xd5\xd5\xedZ\x03i\xdc\x8e\x12\xb7tY\x05\xf7c\\\x0f;\x8f\xc4S\xa0\xa3\xdb\xf9\xfe\xbd\x9e\xfa\xd0\x10\xb2O\x0c3_Z\x9f\xec\xaa\x08\x81\xe9\x8d:\x9b\x97F\x93\x8f\xca}\x97T\x99\xac\"S\xcco\xc83\x84\x18{\x0agM\x17E\x9a=\xac\xd1\xd8\xf2Rx\x9f\x8f?\x1c\x0e\x8f\xdb\xab\xfd}X\xc4\x0bf\xb8\x934\xbeU\x01\xa0\xc3^(\x06\xc9\xc9\xa2z\xd2\x9a\xa9\xfa$\xaa\xe4|Dg\xd3L\xc9iSq\xee\x09\xde\x02k\xfef\xcfw\xe8\x87\xc3\x93\xffQ*\xa9a&\xeeY\xc33\xf4\xf4\xed\xe8\xc0n\xcd\xb1w\xcf\x1c\xcf\x97B[\x15\x16\xa8\xe6R\xe7\xbf\xe0k\x04\xdc\xa0\xda`]O\xb7z\xd2\x18!\xb6\x07-A\x96'\x90\xaaU\x82\xe7\x010A-\x10\xbd\xb5\xb7N\xd2p\xe8T\x870\xb5kr\x90\x94\x83j\xecGo\xa0\xf9S+%\xb4\xe4M\xe0\x0d\xb1\xf3\x8bB\xce6,z~\x07s\x92e\x8c.J)\xe5\x95H\x88k\x0f\x9a\xec\xa8\x0e\xc7\xa05\xe4wa\x83\xe5\x97\xa8\xf8\xb53m4<\xb0r\x92[*^\x8dul\xa8\x0f\xfc\xde\xba5\xf4" +
+	"\xf4m\xe8\x0a\xab\xc6w<iX(]\xea\x0a\x9e\xb9\xa2\x16\xe1>\xc4\xb7\xb9\x85\x84Q\xf9\x19\x07\xffc\xd7\xbd\xe6\x11\xe1\xdb4\xb3w\x00$\x106\xdb\xf1\xbez\x06S g\xc9\x95\xacJfJg%}Z\xd6U-\x1bZ0\x00\xef\x14k%c2\xd0j\xf2\xd6L|N\x16\x7fxp\xd4e\xed\x99`\x93\x19\xa0n\xb5\xe4BB\xd0\xc9A\xdc\x0be\x99\x90\xac]\x95i\x1d\xfa\xea\xf0\x87-ve-,\xa5J\x9dA\xac\xf8\x0bN \xc3\xc4\x9e\x0dZ\x8e\x03\xfe\x10IdYS\x08\xa4.\xb2\x0a\xfc[\x18\xce\x91\x88\xb4\xca\xfe@\xae\x9a\xbf\xfa\xae\x07@6<8)h\xdb;6\x18/\xbb\x9e})\x1cRm\xaf\xc5\xe1\xa2\xfa\xfc\x15K\xcbK\x99\xa2\x11zLT\xed\x96q&]\xf8\xe8.\\l\xf8\xb5I\xfd\x89v\xa8\x15\x06/<\x07w6\xd9\xd3'V\x8a\xcd>\xcd\xb3\xc8[\x9dJ\xe6\x047Hs%\x99^\x85u\xf8D\xf4\x9e\x1c8\xb5\xdc\xd4\xbb\xaa$l\xe4\xbe\x96\xc8kz-\x0e\xcb9\xf1\xb8%K\xfa\x85'~w\xe8E\xadz\x93\xd26\x85\x8c\xb4r|;\x0a\xa0\x86\x07g9\xbf\xa4\xcaC!\x9b\xa6\xac\xc8%\xdc\x89\xa4\xa2\xdfs\x95\x0dY\x9c[\xe4\xfb5\xaa\x12Y\xe4o&\xc5\xc0\x14\xe9L\xab=\xa3\x8f\xe4O\xb9j,\xfd\x99\x95w\x7f@\x98\x0eo\xc9#F*\xbe\x0f\xb7\x06\x19\x0e\x8b\x8e\xcf\x82\x95(\x02vw\xd3\xf3R\xa1\xc3\x03g]\xe03M\x16F\xe9\x91\x8d:\xac\xb7\xc57B9\x13k,\xde|\xe4\xf0=NHK4=\x16\xbb6}\x86\xe9\xdd\x07\xa4/\x85\xaf\xf1;\xe8\xe8\x0b\x8f\xb2G-\xe7}\xfba_\x8f\xf90W(\x81E9\x02@\x04\xd9\xbf\xee\xee\xc6\x85\xd9@<\xb9\xe4+KVn\x97\xaf|8\xfc:\xdb\xdb\x84\x125B\xbc\xb5\x07eT\x9b\xc3<\\\xc6\x06\x0b\xf7i\xccK\x02\xdbJlh\xd1}\x97F\x8f\xfck\xec\xcf\xac\xe4\x9a|\xf0n3\xdcs@\x7f\xc1\xd3\x9f\x05[\x1f*\xd72\xcf\x98\xf7a\x9e\xfe\x83*?\xd4\x7f-\x81p\x04\x8c\x85\x15\xf0\xb6(\xce\xac\x0b\xb9\xcb\x8e\xd4E:\xa9\xbbV\xaf\xf2\"\xdb\x08\xa32&\x00unX\xe6\x91\xb2\x96\xf6}\xf9\xba:\xaf\xfc\xcb[\xba'\xcd\xc7\xd0P\xff\xee\xc3\xc5h\xf2OL\xf0\xdc\xc4\xa9?Gle\xae/\xb7g\x82\xb2v\xf9v\xc6\xa6\x9bq]7R[F\x9c\xa72\x09\xd3\xaf\x9dh\xb0\xab\xb0\xc2\xc7-\x85\xb5\xd8\xca\x91\x1b\xae\x92\x192\\\xb66S]Q\x93XK\xf1\x1b^\xc3\xa2g_m\xb7?<y\xce@tr\xb1Z\xb5=\x9f'\xa0\xfb\x0c8xE\x83\xb1\xfb\x07%\x82\x11GB;\xc2x\x91\xf3?\x19\x97!\xdb\xf0\x8a\x8c\x7fx`/q\xec\xe7\x04\x1d\x0e\xbbA#\xa6\xa7\xaeY\xc1l\xad\x7f\xef\xfc~\x11\xde\xa5\xa7r\x1e\x16\xbe\x96\xb2<\xe5\xe0U\xc1\x0a\xc7\xff\xc0\x8e\xc1Dt+v\"`\x87\xca2\x9cxa9\xfc\xec\x01\xea\xe0\x9fM\x9f-\xb7\xa6D\xbf\xb9\"\x05\xa9d\xb5\xd3\xe9\x0e\xec\xdd\xf2\xd4\x1c\xf6j\x1e\x1b\x07QK[\x99\xba\xf9\xdb\xf0\xce(\xc4\x9bO&\x1c \xeb.]\x1f\x1e\xd9h>\x1c\xa9f\x1aw\x19\xe8\xb8\xa49\x97\x0e!\xcc\x15\x0ey\x93\x05\x1eu\x1a+\x01\xf0\x06\\\xf3wa.\x9f\x8e\xb4\x9c\xd9 \x0a\xb6\\cH\x8c\x1bw)`dR\x94T\x97 |]\xb0\x95Q\xca\x18\x9a\x86\xea\xc8\x85<\x15@t\x98A!\x9b\x00\xb1n\x8d\x14>N \x95\xfd\xfd9\xec\x9a\x08k80j\xcd\x9b\x97\xe5f\x01&\xdb\x8a0\xe4\xe0(x\xd1\xa9a\xb5\xac\xb3{eo \xe57\x85;\xa5\x01\x01C\xf3!\x13Y\x99B\xd7l3\x115;3\xcd\xb9\xc0\x94(\x0e\xad[\xae@A\xab\xbb(\xbd\x99'\xf5\xcb\x99\xee\xfee\xaeT\xcd:\xea-F\xa2\xbb\xb4\xf3\x18\xb1\x18\xeb\xf8\xcb\x973\xeaYF\x09,\x0a\x04O\x92\x9e\xd7\xaf!\xf9\xb3\x80K\xf0\\\x0a?\xebs\xf2\xfc%\xe1\x0b2A\xe7\x9d\x85fCC\xf6\\\x85\x87\xb0L\xffO;\x18\xfe\x87\xa3\xd2\xda^\x93o\x8cu\xf7\x17_\xa7nfxt\xf49\x9a6Zv\xf2E\x1bf\xa9`\x08\xa7\x9b\xba\xeb\x0eu\xbb?\x90\x7fxt\xbcs~)s\xafh\x8e@\xb4id\xe3fqJ\xea\xc7y\xc7*Qe\x87Mst\xd2][\x83[4\xdbYs\xefEbU\xca\xa3#\xbfSJ\xb9;\x98\xce5f6$\xba\xce\xc3_b\x9fq\xbd\x1ek\xce2!\xc9{\x0e\x9cu\xe3\xb3O\xee\xc9\xb0\x03S\xf2v\xe47\x8c\xb0\xe4\x03\xda \x85\xde\xa7\x05\x85\xeex\x8d\x13\x8c\xfeh\xd9\xf2\xef\xc3\"\x8e\xa9\x1d}\x87\xfb\xd1Y\x0e\x9e\xbeu\xe8y\x0cW\xce\xc4Z#q\xdc\x1cJ\xe0\xb2\x81\x1f}Z\xb2\xe8`\xf47\xea<W)\x12\xc9\x0dfLb\xab\x1a\xe1\x113\xf9[\x90\x9d[\xaeL\xfb\xb9\xd3\xde\xe6k\xfb\x9d\xb2\xe3\x17]f\xa2\xd2\xdb\xb5h\x01\xd5`-U\x1e\xc1\x8f.Z\xc1\x97\xc2o-\xa9\xed\x94KiDs\xb6V9&\xf3Y\x99\xc4O\xb6\x1e\xb7\x85\xf6os\xc7\xc5\xac\xc9,V~\x03#p\xef\x05/\xcb\xaf \x9a1<\xde\x99L\xe9\xecp2\xb2\xdb}<w\x8a\xf1\x8e\xe6\xc9.n\xe1\x10\x13\xc1\xbe\xeaKP\xb1\xc7\x87\x0e\xf73\xc7~/\xe4A$tk\x8d\xd4\x99Q\xaet(\xd8\x8d\x90\xf7\x93\xe8\xa6hR\xc1\x0eHZ\xc0\xe3\xb0lk\xde[;\x89(\xd3\x8d\xf9\xc8\x04\xa8#\x04t\x12lS\xb4b\xb6\x98\xdf_\xe2*7$\x84\x8b\xec|\x0b[$\x10i\xe5\xceo0\x93\xad\xb7\xa5\xf3\x080\xb3\xb8(\x99\xcfM[m\xb8~\xcd\x0b\xee\x7f\xb0\x1d[A\xd15\xc5L\x16\x03x\x98j\x8b\x84\xa7\xf4-x\xe2\\~e\x18\x09\x9dX\xf5\x82\x93:\xfc\xbaK\x1cD\x80\xdf\x86E\xe1'*'m\xb9\xf2,{:\x0b\x08\xbb\xc2\xd4\xbd%\x84\x87\x96\xd9Xx\xe3\x01\x00\xeao$3Z8\xee\x95{8\xcb|\xaf,by\x8e\x1ck$\xc5\xc5\xf8\x06@\x11\xd6\xb4Q\x11=\xe3\x84%z\xb1\xc5#\xe29\x7fB`D^\x9aE\xb2%_\xcc\xb8?<\xb6\xc8BwG\xdb>\xe5\xa9^c\xce\xca\"\x90\xfaOmKml\xef\x88\xc8K\xea\xaf\xa9)\xa4H\xe7\xe9\x00\x82\x100_QTI\x9f,\xfc\x93\x19\xa9\x8a\xd6r\xf2'`\xeb,\xf9\x13\x94.\x08\xef\x99Np\xa6n\x92\xdd,\x89$\x83\x8f9\xaa\x88\x9f0%\xeeX\x0ar*3\xb1\x97\x86\x9bf\xa2@\x1fJ\x13\x0a\xf9\xcf\xc78-\xb5\x96\x08\xf7:\xba\xcf\x04)\xe2d@\xebp\xe6\xbd\x03.\xd6k\xc5Q\xf7c\x9f\x1e\x1e\x9f\x17\x18\xeb\xca\x1b\xaea\xfd\xc4\x99F\x99)\x8eW\xa1\x94=\x9d\xc4%o\xaf\x000\xec\xe5\xaa\x19\x9e\xbc\xb0KT\x04\x87:5<Pa\xbbEoI\xb2,\xa8\x00{\x1b'ZZ\x90&>d\xf2g\xac\xf4Q(R\xe9cK\x19:B]\xdc\x90\x0c)\xe9\x92\xb66\x88\xa6\xb0\xc2\xbcVU\xc61O#\xa9c+\xcar\xa1b\xe7.kA\x8f\xea}cU\x86`w\xa2RW\xeb\xf4\xf0pg\x8a\xf7\x16\xcc\xb9\xa2\xcf\x90,\xa9n:\x99\x02\xc1\xe7q*\xd3m\x09\x0c\xdf\xb4\xa0\xef\xf2X\xc3\xca\xaf\xf4b\xedF\xed\xc3?\xed,\x9bif\xd4\xec\xe1\x9d\x9c\x1c\xb6A\xe55\xd2dZP9C\xdf[_\x02\xf6\xb4\x0e\xb7\xdcB\xc3\xcd\xa9\xe6\x00O\x99\xd6o3LAZ\xc6d\xeel\x99f\xec2I\x11aut\x0d\xa9\xcd\x8b4\xf1%\x07\x00'\xea\x02\xf2\xd3\xb2\xf9\xc2\xb3\x1a\xba,\xfd#N1X\x01\xe8\x19\x86\xed\x0b\x07\xad\xea7S\xdb\xee\xc3\"\xd6X\xbd\xf2\x0e\x9c\xd0\xae\xf5\xe3,\x8d\xb0\xa1s+\xdc\x0f\xa0\x9a\xe9Q\x09U\x05\x9e\xeab\xe4\xfa\x1b\x0a\x86\xe1\xaf\xfc/j\xd6\xf2\xd1\xb2\x02\xb6\x89\x90t\xa7{h\xd5\xc3\x97\x07U\x183\xe6\x0cV\x15\xa7\xe1\x81\xffL\xd3\x88\xd9\xc5D\\\xc3+>X:\x95\x9bp\xc5\xd6\x9e@('_\xb2\x9d\x9f\x9c\x14V4G\xe5 k\xd4\xbd2\xf9\x13r\xa7\xd1`\xd5\x15\xa9u\xa0|+)\xc6x\x16\x03\xd9\xf0\xe4t\x0f}\x87\x94\xcf\xe5]\xbdc_\xe6\x1c\x06^\xb4o\x1e\xe3\xe4l\xbf\xa7+U\xf5\x97\xe5!\x9e\x90\xc2\xb0\xa31\xe7y\xf8\x86.o\xd7\xedw\xe2\xd4\xbe@\xdfxx\xfa\xa2;2G\xb3\x16\xbb\x19\xbc\x10\xad\x13\x14K\xcc\x94\xc6TL\x00\xca\x92\xb8\x86pW\x89\x9bM\x88\xfas\xf3\xe8\xfd\xd9\xf3}\xa1\xfam\xce\xe0\x96\xc5\xc3.\xc8'S\xdc\xcc\x01\xb1L\x0b[\xc8\x07Z\x8b\xdc\x84\xe5R7I\x01\xc2\x17\xd8\xbc\xb0\x99\x81\xa9\x85\x1c\x07a\xc1lS\xceR\x07\xd7\xad\xa6\x14B\x9cec\xd2\x19\x0fh\xbd\xd1u\xfa,\\c\xcd\x09\xaa\x93\x95F\xd5\xbf\x19\xbf;\xde\x1f\xca\x09a\x0d\xc394u\\e\xb5\x10{\xa5\xa7\x0b`\xf1O\x8fe8\xb5\xe6\x8fR\xc8\xa5\xdbd\xc33\xf7\xf51\xaa\x8db;-\xdf&\xe9\x03+&\x1d\xab\xd6s\xb6\x06\x1b-\xe63\\@\xd1\x88\x116\x02\x8e\x1c.%@\xdc~o\xfa\xe9\xf4\xd0U\x81W\xdd\xf1\xf6^\x98m\xeae\x929(\x85y\x1a8\x97\xee\x0d\x87\xba\x15\x84m=u\xa1o\xae\x81hyWYd`\xc0.\xbbm\xd9\x02\xea8N\xb4\x0d_\x86s==z\xfe\x9e\xb8D\x95 \xb1\xd8d\x1b\xac\x8b\xf5\x85\xe9\x9b)\x85\xc3\xfa\x031\xa7\xc7{\x8ebf\xe7f\xd1}\xae\xfch\x0cb\x1a\xd7\x8f\xb0I\x08\xd9\x04\x0c`\xfa\x87;\xc6d\xd2=\x83\xd7~xz\xe2\x8b\xf8,x\xb4\x1f**\x0a\x8c\xa4>i1\x8d\xbf\x0c\xe2\x8c\xeb,\x9a4\x1av\xef\xa8\xb96\xf8\x0e'\xaf \x1d\x1f\xe3h`\xd5\xdf\x19\xda\x9b\xe6\xc1\xb5\x91\xcc6\x87=\xff\x98nm\x93\xb4a\x05#\x11\xae\xd3?\xf9h\x9e0\x002\xb59\xed\x9a\xd7<%\xdcs\xcdm\x98\xfb\xe6.\xd6\xe9%\x891\x02\x9f\xe9\x84H'\x04\xa4\xc6D\xf6 \xdc\x92~:a\xaa\x1bQ{\xce\xe2\xe3\x13'\xa3\xf4Aa7e40\x9f\x9e8\x98\x7f\xf5\x97?\xfe\xf1\x87\x97\xe9\x1f\xe9\xbf\xa2[\xf4\xcd\xe0\xfb\x97\xbf{\x85i\xed\xf4f\xe1m*\x15\xff\xa65\xfa\xcf\x937\x82M\xc6\x0a1\x81\x93r\xef\\v\x16^\x82\x19w\x95WqHV\xa5\x03^\xac*\x9c\xb7J\\\xf3\xc8\x92\xc0\"q{\x8b\xa5x\xb9(\xd5\xad\xd1\xb9\xa0\x9c\xc17\x83_}\xfb\xe3\xef\xbe\xfd\xee\xbb\x97\xbf\x05 \xc9\xc6\xba\x18d'\xddZ>\x90[\xed[\xd7U\x07\x9f\x93\x1f\xbd\xe5-\xfb}z\xff\xf0\xd6\x15Q\xea\x91\x84dU\x03\xda\xd2\xd7Z\xef\xb2\xd0J\xac\xc6}r\x81gX\xb8\x97O\xdfXW|)\xea>z?\x0bJ\xc1\x0d\xc4\x88\xc6,9`@\x88.\xad]$\xa2\xcd\x09U\xbb\xc6QX\x84\xc7\xb4\xb7p\xcc\xd7l\xf3k\x91R\x08\xef\xe3\x14Iq\xa9\x12}N\x8as\xcc\xec\xb7\xd5L\xf0F\x8aI\xa5\xb1\x8aB[\xc9c\xe0\xcf\x85V\xb2\xab\x11\x87g0\xda\x9ch\x15YJ\xee\xae\x1f]\"\xd0\xa9\x16I\xb1\x9a]\xe4\x02\xac\xd4O\xb5\xc4\xbd\xaa\xf4\xee)\x7f\xb0\x08\xcb\x92\xa0\xb8x\x0e0a\x95\xb8\xdc\x90z7a%Q\x91p\xcf\x03\x7fK\x17\x84;8\x9a\x10\x95\x1d+5\x82\xe3$'\x15fd\x19'\xb8\xef\xc0\xd1\xd6\x80\x0bq\x0c<\x98\x8f\xd2v\xc7Ll5\x9d\x9dO`\xc8\\\x99\xc71$\x1aL\xc3\xad[\xc2i\xc7#.\x06\xbe>\x81\x1bnmG\xa7}\x1d\x03\x19sAo\xc7\x8b\xb4\x10\xedV\x92$\xeb\x90\xf2\x0b\x9d$\x9cj\x1b\xae\xe8\xc2\x80\x10\x94\xdcv\xf2\xe5VR5B?\xe7\x19\x80l\xf4\x1c\x11\xf3\xe4\xf4\x0dO\xcfq\xac\xdc\x16fnT\x87yz\xc5|\x00Irn\x15\x1b^8\x8d\xf9=N1\xe61\xd3j\x16\xa6\x12\x0b\x1f\x92\x1f\x89\x07i\xac\xc2\x1d\x88\xe0^\x99\x0d\x10J\xfc\xe34\x8e\x06:Y\xb3\xb4/g\xec\x06=\xfb\xd6Q+W\xc7\xd5\xbc,p\x0f\x9b\xf7\xc0\xa4:\xccGcM#q|\x96\x07z\x8b3\xc8E{\xa1\x9865&}6\xe6D\x98\x11 \x97\xaa\x0fs\x0fy\x08#W\xc6\x9a\xf6\xab\xb8\xd8j\x83\xae\xbd\xa3\x82\xf6t\x1f\xd9\xd6\xae$y{\xac\xe7\x0d\x1c\x16[\xe1\x14\xebX\xcf\xc7\x8e\xe5\x97\xa7No_%\xea\xf6\xb0\xe0ov\x88$\x9b\x1b\xe9/\xe1O7D\xbczo1\xf4&U#&\xaej\xdc\xf0\xf2\x85\xcf\x04\xdf\xf3A\x00\xd1Ca\xdf\x9cZ_c:\xe0'Y\x0a:\xd8\xdf\x17\xc4\xc9,Z\xf30\xbd\xef\x08W\x1b\xee\xa4T\xa7\x84\xa6\x0d\x97\x1a\xbe\xa4\xcc\xf0mb\x10\x1f\x90K\xa4\x15`\xe2l\x0a\"\\\xde\xf6\x0e|\x18\x08\x00\x96\xc4\x9d>\x83\x06\x11\xf9\x8f\x94\xbf\xb9\xcdE\xf3\xc9\x0a\xe4\xf7I\xcb\x1b\xd4\xdc\x1aqI8tV\x9d>\xac\xc3\xcf\x10\x0f\xe5[$C4NZ\xb7\x18'?\x95\xba:\x93|d}\x7fh\x1c\xa8\x82\xfe\xff\x89z\xbf\xcb\x09Z\xb1F\x8d\x12\x12\xb6\xd1\x11-MI\xe9M\xd3\x93\xf2c\xf8\x0dv\xe1X\xa9\x96W\xf6P\xf0,\x91\x98\x9b\x19\xe3\xeb\xbeg\x10aI\xbb\xc21\xc9\xe8\xfeF\xf7\xbd\xea\xa0\xc5\xb7\xa9\xf0\x81\x82\xdckp\x7f.\xac\x1ajx\x80=\x1e\x87\xa3O\x88~\xd7\x9e\x89\x8fO\xf7\x05\xef\x9f\xccM\"u.Qs\x7f\x8d\x12\x0b\x13\xbc\xa6\xe2\x8a\xbcxo\x1fcT*0\x0c~\x81c+\xe6\x0f\xd8U\xb8\xfcb@&0\x9b\x97{\xf4\x10U:\x94\xca J\x94\x80f\xe4\xdbD\x97\xcf\x0d\x09q\xa8\xc5\xb2RB\xa6L\xf2dE\xee\x9b\"D\xc9\x05\xddQN\xbd\xa8\xeflrt\x99\x98\xfeM\xa7y2|\x9e\x0bZX\x12\xab\xe2\x92\xc0\x89.\xda\x9a\xca\x83\xb2L\x13\xa5\xc5O\xedy\xa81\xeag?\x13\xb6\xfe\xf2\xc0+\x97\x98\x03\xe06\xaaE\x92\xe5<\x8b\x95\x8e\xaeX\x8c\xe4]\\i\xb2\xae\xf2AXb\xf5\xf9%R\x13\x84\xb7\x9cGL\x99\xaak\x0f\xb8\xc1\x18H\xbaz\x0b\x85\x14T\x92-\x89\xef\x85Ci^\xc2\xa5\x8aUg\xdd9=\xf0\x0a*R\x86\x8f\xcd$z\x15\xcf\x09\x07\x18d\"\xa1\xaf\xc2;\x04}Z\x0e\xef\xb4\xca>\xc8m8}\xb4\x86BWR\xa9X\x0c \x98\x80\xd3q\xaa\x83B\x12\x14\x0ba\xa6K\x97Q\x18m\x1a\xc7\x9fC=@v\xbaZ\"\xb0$\x09\xba\xa5\xfa\xcc\xa6H\x875\x03\xd8U\x8aM\x9c\x14\x8bO\x9e\x03i\x9a\xe0\x8e\xcc\xbdd\xc8\xcd\x9d\xfa\xec\x05\x98\xbf\x0d\xe6\xfd\x92\xaf92U,q\xc2\x0e7\x1e\xa8P0=\xa3\xf0\x15c2>\x1a{[\x97\x98\xa5*HD\xef8UR\xf1\xed$le\x1f%\xa24\xc7:\x94\x83\x9b\xf4+\x14\x0e\xa1\xb0\xbaze\x8f\xc9F,\x84th\x0b\xe1\xf2\xfe\x0e\x0bn\x98\x13\x1f8\xa14+\x96<\xa5Pc\x1a\xe5\xf8\x9a\x96\x18\xaf\x17\xd7\xf7\xa3}\xfbg\x14\xe6\xc0\xd5Aiu#(K\xa1\x85\xb0\x80\xd8!m\xaaK \xf4\x86\xa9\xd2+\xfd\xa1\x00m0\xdc\xde\xca-\x1d\xb1\x99\xc6(\xa1\xd6{\x8aU\x12E\x18\xd1\xeedZ\x1e3\xe7\xb2\xb8\xa9H/\x99\xda\x17C\xfaZQ[T]\xbcM\xf2&\x0f\xa9\x1d\xa1\xad\xdc\xa7\xf5\xf4\xac\xaa\x06\x82\xf1\\E\x077\xa2\xa8|1i\xab\xefJ\xd13\x18*\xbb\x93N\x07\xc2\x13\xa1Q/\xae\x93\x98d4\xe45\xf3\xd8z\x89V\xcc@;\x95\xfb\xf4\x84)a.Qp\x99\xe1VY\xa4\xfe\xb5\xc4\xe9]\x94\xbe\x18\xa379\xb0\xd4I\xbc+u\xceq\xf3\xa23\xae,\x95DY\x96\xbe\x04\x8f_\xc8\xe9b%[\x9c\x8dw\xe1\xfejD\xb7\x17$w:\x80W\x12\xa9\xd8\xce\x87@\xa6\xc9\xd0\xf2d\x89A\xcd\x93\x0dL\xa9\xb4\xdc\xb77\xbb\\\xe5\xe4\x06i\xacRO\x89\xc5\xff\xde\x92:w\x89\x0f\x11d\xbe\xd4\xffg\xae\xean\x01q\xa2\xb0 \xf0Z\x8d\xb1i\x8et\xe1\xb6\x12\xa7\xe1\x16\xbb\x0a@\xa3N\x9e\x04\xd9\x15\x96D\xfe\xcf\xdd/z\x1a\x0f\\\x1f\xb1\x84\xc9S\xcb\x9f\x10\x98\xd1\xf3\x14N\xf2\x8a\xa9)\xe0u\x0d\xda!'\xb7\xa2;\x0fZ_|i_\xd7\x9av\xc2\x09\xf0\xd8\xd5" +
+	"\x8b\xe7_w\xff\xe11\x1cA\x14\xf3\xca\x91zo\xa8\xaf|\x96\xdc\x80\xb5\x8db\xfa\x12&\xc0\xda$\x1e\x1f\xf0\xefQ!\x85(\x97\xcaU\x9f;\xa9\x0e^x\x9aeZ\xc2\x0a{b\x16W<\x07\x17b\xc7\xee\xed\x15\xc2]\xc9\xb3\x8d#\xb5t\x15\xf2,\x16\x05m\xb7\x13\xc4j\x90QGT\x0bJ\xd3\xc7\x95B\xe0\xf8;\xe8\xfe\x96\x83\xd9\xc5\xdd\xf8\xc0\xdb\xf8\xfa\"\x19\xde\xdcp\xc7W\xa9\xe7\xc2\x86\xde\x0cc\xac$G\x9a\xe5\xcd\xd4:YK\xb2@\x1c<\x90\xea[\xa8~\xf4\x0aL\xdf\x09\xfb/\xfdQ!\xc3~\xa5\xbb+\xbe\x01\x8f\x11\xec\x87\xcac\x7f\xc1+\xe4\x86\x1e\xabq\x18\xec\xc3\xe9@\x09\xb1\xc2\xc2V\xcb:\xddv'\xb4\xd3\xf3%\x81\xf8\x98\x8a\xa9\xf4\x0e\x87\xfe\x1d \x7f\x19'&\x0d\x9e1\xc7.\xd8t\xec\x91(\x87\xf1\xb2\x83\xe7\x15b\xa9n@\x99\x94\xe1J6\xf7\xa9\xe9\x94\xed\xc3\xeb\x19\xaa\xd8\xf2\xa1\xd8\x7f\xd2\x83\xcd\x86\xa9\xa4D\xad\xf4\xe2G{\x0d^yX\x04\xb8UQ\x0f3\xca\xcc(\x16,\x90M\x83\xb6d\xcc\x00,\xa5\x1a\x05\x95\x1c\xe5\x11\xb3\xb0\x883\xfc\xa7\x11\xef(5\xfb\xb8=^K\xf1\xc9\x98z\x0c\xf7j'A\xd8pQ`\x03\x8d\xc3Z!\xde\xaa)\xa6c\x12\xcf\x17jq\xa6W\xd9 \xc6\xbd\xd4\xa0\x93\xacA4|L\x1e\x07y\xad+7\x05\xd22\x16\xa9\x1d\x16\x17$\xbcn]8\xfb5Q3\xcf\x10\x98\xfb\xb7\xd2\x87\xcdVY\xb5\xd14,\xc3\x9cl\x92\xf4\xec7\x90h3\xc08\xde\x80\x09\x0dN\xff\xa0\x84\x0d\xa4\xab\x96R\xf8\x03\xbb\x9f\x9c~\xbc1\xfak\x14\xb8l\xb8\x16\xb9\x98\"\x8e\xf9S\xc3t+7\xc8MT\xca\xb2t\xbe\xb9\xadv|\x8b\xe7\xd9&<\xb1A\xda\x0c \x881\x1e`\xec\x8a\x1a\xd0\x0c\x14^\xba\xc0J\xbb\xb4\x11>\xe1\xc5\x8d\x81Ck\xb3\xc7<\x0b\xc1cZ\xc4\x91\xb6\x14\xe7\xe8\x1e\xe7\x80+\x82\xc4[Q0\x96R\x83K\xa8n\x04\xc3\xf9:\xddF\xe8saa1\x93\xf9@\xf9\xfd4\xc6$\xf5\xb9\x0c\xb2D}\xa5\x9f\x9d\x82yG\xc3\x0em\x0d\xc3\x13\x04(V\xac\x84\xc9\x84\xc3\xac\x14\x9f\x96\x183D\xae\xa4\xd86\xa58-\x11\xc0\x8e\xa3\xe5p\xe8\x8a&\xb6\xe1\xda&\xf5\xc0\xbc^\x92\xc9\x13n\xc3u{\xc9L0\x14\x9d\x9e>\x8e\x0d:\x895\x1e9\xcc7xe\x1aK\x93\x90\xf0UM\x9a\x0c\xb8\xcfm\xa1\x9bV4\x00s\xe9_@\xb0\xc4\x19\xee\xdf\x18\xd3_\xf3\xae>\xefzR\xb1'`\\\xee\xbb\xbb\xfe\xc0\x11\x04\x91F\xb7\x881\xc1\x9c\x98\x9a#\xbd\x18\xf6\xc2\xb8\xcd\xc0\x09\xad\xc1\xc0\xe1\xec\xa6L\x14|\xc2d9\xe9\xbb\x92\xac\xc9(\x8e\xe1nZ\x05\xe4\xaa=\x9c\x9b\xc2\xdbAl\x08\xdd\xe9~\xcd\xdft\xff~b\x0cL\xae\x1e\x14\x95f\xa9,\x91RB,J\xbc4.\xb3\x0b\xf2\xf9\x10U{\xc2T0\x1fG\x1d\x07\x8f\xe7\x8cb+\xc6\xac \xb1\x11\xd2\x92c\xb4d\x17\xe7\x0e\xe4\x9a\xb7\x1d\xbb=v\xbd2\xd4q\xb1\x95\xa1\"\x7fT\x1bm\x8e\xea\x9d\x17\xa8\x81\xed\xe2\xb4\x9a\x05S\xa7\xfc\x0e\x15\x94\xf9Ni\xd03\xb1\x15l\x86\xd2\x00\xcf\x10\xc0\xfd.6\xa6\xadJ1\xe5\x90xN\x0b\x9eP\x8f}\x01u\xa5\xbf\xea/\xfd7\xe5\x12\xe2\xb6\x00\x1bAf^Q\xec\x8cQ\xefan\xc7`\x1f4\xc0\xf3\x88\xae:B\xdd\x0bF\x12i\x10\x9d\xbc\xbf:lT\x9fD\x04\xc6r!\xd48\xc3\xc0&'\xc4\xc0\x00\xb8\xed\x9a\x98'\xfb\xd4\x02L\x88\xbb\xd4$>\x16;\x82\x0cD7C\xdc5\x03x\x8d\x91\xba\x10\xa6h\x90\xc6\xb9#LW1\x19\xceR\x04\x8f9%h\xc1\xb1\x02\x8d-\xbd\xe3\xa9\x19\x95v\xa9\xbb\xb7\xff\xfdi\x87/J\x1b@:t\xd2\xa5\x8d\x15<n\xb7\x84K\xb7J-9\xcb2@i\xad\xae9!-\x7fR\x8a\x1bWeK\xc9\xd3\xed\x1dV\x1f8\xfdc\x8a\x1c\x8a\xeeeL\xe5\x97\xad\xa4\x91\xea\x80RC\xcf\xad\x94\xb1,'W\x9c\xcd\x05\xdd\x86Z\xa3\xcd\x9c\xd0\xa0\x1a\xa4\xac\xb2\xc1g@l^\x18R\x01JiS\xa5\xa9[\xa0\xcf\x88\xb4t\x86k\xbaC<\x9f\x19\x878\xeaK\xc0\x09]\xbc0i\xbcK\x03\xd8\x12uO\xd3.\xdb\xeequ\x0e\xfa\x82\xf1o\xb8\x0et\x19\xe6\xb8M\xc7\x99.\x1e\xe8F\"\x1fv\xc9\x8e\xba\xaf\xba\x94\xab\xeaj%Vz\x08\xcb\xd6\xe7\xe4Y\x18\x9aC\xb2\xe3\xe7\xe8rv\xe6Q|\xf1\xbfM\xa7Xf\x8bp\xa9\xd8\x9a\x86\xd5C\x1e\x9c\xce\xb3\xe0.\xf8M\xe1\xc13\xdc\xe2QO\xa9v\xc2\xebk\x07Z@\x91\xde\x95\xa5\xfa\xa1\x99\x959\x0f>~kR\xb4\xa6\xff\xe8\xdd\xdd\x13x\xf3\x01\x94C6\x02\xf9\x91\xc8\xa7%\x1f\xa3_vh\x1aF\xa8\xae\xd1\x9c\xe3&\"\x9fC\xed\xe4J\x16\xb7\xe8MSoZ\xa6\xab\x99\xd0-\xceY=\xd4\xe4\xa1.\x19w\xea\xbf\x18q\xf1\x09\xaaa\x8c\xb5G\xf97\xf0\x8c\xb0\xeaz\xa0c\x86\xec\xceq\xe1\x15\x1d\xa6\xf2\x91U\x08a\xab\x1f\x9f\xc5\x98\xbe:g\x9b[\x916\xda\x95)K\xbb\xf5\x80;\x167Y\xc9\xecu{(\xb3U\xc4*\x9b?\xcb\x9c(\xa8\xd4\xe4\xa3^)\xa7\x8e\x16\xaa\xf2\x04\xe5|\x0cY\x99\xa9\xf2Xb\xc9\xab\x87\xb3!I\xb8\xd0F\xb5w\x08\xdag\xc9\xc1\xa7[\xc8\xe6\x8bqy\x85l,\x88nm\x14>\xea\x01\xec\xc8!\x9d\xc4\xa0\xb3\xd7\x1c\x05\xa8\x07\xa3\xe6\xc4\xab\x06\x95\xaa\xf1\xcc\xf7P\x8d\x90\x01-\x1a\x18/M\x017\\\x8a`\xb5\x90\xa5\xb7|\x11\xe9\xa2\xc8\x0eU\"\xbf)\x0d\xa5ej\xcf\xfc\xb2FV\xbbH&\xd1\xfb\xa1\xcb\xb54\x7f0\xe0\xda0\xa6\xd2\x1f<\x08\xe9B\xfe\x06\xff\xe0o@\xa9\",\xcd\x1f\xf4\xcdF?\xdf\xe8\xa77\\[w\x01y\x99\xc7\x81\x9a\x0a\xa8\x0c@\xe9[D\x0f\x8ea\xe9\xae\x91\x8aq\xf6U\xbaT\xc2\x0e\x1d]j\xc9\xe3/\xa0\xfb\xac\xab\xfb&%\xa3\x95\xce\xa4\xab\xfc0V\xa4Q\x83Qze\x07b\x83\xcc\x9c\xc1f\xb1\x99Jp\xc6\x18\xa5%zC\xb4\xd7\xf8\xc3|!\xd6\x9f\xfb0\xbdS\x05\xe1\x95\x8f\xccx\xd9\x17\xdb:r\xb2g:\x08\x9a92M\xee\x19\x1al\xd7\xd7X\"\x1c\x85\xec\x18Ok<?O\xb3n\xffn\xb5yt\xe4\x9b\xf2d\x1a\x9d'P\xd7\x9b\xd6\x8e\x0f\x18{\x17\x90,\xe44\xd8\xac\x99I\x0cc\xbb'he\x1a\\\x8a\x82\xee\x92M(B\xb0$\xf7\xba\x10\x16\xe4\xf7YVtbRQ\x80\xf8ni\\r\xbdW\x13\x96\x98\xa9b\x0b\x03[h2V#\xda\xb7\x97\xc6y\xe5R\xd8,UO\x1e\xe0\x1a\x9e>v\x84\xa1\x838\x12\xe8\x85\x99,\x9a\x81\xc0\x89\x05{:q\xd2\x94\xa7\xc5\xb9\x9f\x16\x83\xaf9>\xb1\x16sq\xd6\xd2\x8c\xad\xb1\xd8\x9c\x0e\xf3\x0f\x83\x8eG\xc8\xf4d\xfbi\xa5)S\x098\x0c4,\xd6\x82\xd0\xaa\xd4@\xc3%\xa1\xcc\xde&9\xec\x99\xe3=\xb5\xe1+w\x14\xeeZc\xc7\xd6\xd7\x1a\x19[\x06\xc3\x0d0=\xae0p\xfa\xc9\x16\xf4\x8a\xaeHf\x19\xdf\x12\xe3\x87\xf8\x04\xb1\x81\xcd\xb8\x0b\xa5w<\xfc\x0a<\x99\x9d\xb0\x01G\x9a\x89=h\x88\xdd\xdc\xe7V\xe0H\x0dnE\xa6a\x96\xc6!\xb2\xda\xeaY\x8c\x8d\xf1\xc9\x96\x1d\xd1rCd\x07\xb9\xf7\xf8\xda\xbe=\xaeH\xe7.T\xec\xd3\xe6\x8e\"~\x9dQ\x02&CHy\xc8qJI\xa6\xf3-3\xb9u\x08e\xcf\xd1DZ\xd2n\xbd\x0a\xb7l\xec\xa7Vp@\x07mdh\x02\x86\xabm\xd6\x98\x16\x09DK5t\xe5\xc4\xf1\xfaC\xda\xc7\xd6N\xa7\x97\xeb.\xa94\xaa\x92\xd6\x8emUH)\x97\xdd=\xce:\x14 \xe2\xa1e\xc7\x8ca\x9bX#\x81\xd9z\xd8\xc2\xc5%\xe3\x10\xfd>)\xf0\xe3\xe7\xb1\xc2\x8a\xa7\xba\xb2\x85\x01\xb8\x03\x11G\xa9Q\xb7\x93\xd12\x906^\x83\xa9\xf9Z\xbf~\x8b\xe1f\x18\xe7\xf0\x80\xc5\xc6]d\xc3s\xe2\xd3\xb6\x178\x06\xa6F\x03\x93<]\x0b\xc4%\x02$\xd7\x948LQ8\x97\x90?\xa0\xd2`\xbbW)T\xd2\xc5Ag\x90\x86%8\xb2r^\xdf\xfb\xc7\xad\x98<n\x03\xde\x81+\xf0\xdd\x97B\xb9\xf2)1\x9aU\xea<)\x98\x97\x9a^`m\xe2\xf4\xc4\"\x8e;!\x86\x96\xad\xcemN\xcfl,\x9fC\xb7\x89\x97\xc8\xfcV\xecj\x83\x1b(5\xe3\xf4\xcb\xf7h\xbe\xceq@\x9a\x9a\xc0Yx\xa7\xe5\xaf\xdb\x8c\xc9\xf2\xd9\xc2&\x1c/[\x08\xd5\x95\"t\xba\xea\x0c\xf6EB\x1c\x9f\xb5\xf5\xdcq\x03k:\xeb\x97\xf4\xb5\xd9!o\xbd-\xcd}d\xe0\xb7@{^8[<\x04\xcamR\x93\xe8\x82\x13\x93E@\x18|\x82\xa8\x9dJb\x13dQ\x8b\xa7i\xe2\x88\x19\xeb`N\xa04\x0d\x8b\xd6W\x19W\x15'B\xc3\xb5\xc2\x86\xfa\x0c\x92\x93\x17\xfeUf\x0c\xd3S\xb3\x91\xa1z\xca\xfdY\x81\xa0\xc9\x12\xb7)\xa7tBNJ[\xb4\x11@\xc3KW\xd7H\xd0\xae\xca\xa0\x8d\x01\xbap\xafX\xe3;\x18j. FV\x14*R\x0c\x0bH\xe1\xac\\P\x87Z:\xd6\x0a\xf09\xeemB>\xbc\x07\xc4\xfdd\xd8\x9aq\x1a\x92#\xb8z\x1e\x03&\x06>\x8b*Z\xda0rMS\x0cU\x199\x1fbk\x9eYo>N\xd4\x9cv\xe8\xe84\xab:}\xf1\x13\xcb\xce\x8bFM)\xcb\xda\x15\xed\xee\xa9&7\x0c\x82\x0b\x8dTN\xd2\xfc\x08\x9bA\xbb\x99\xbdEB'V\xa1\x90\xcf(\xf4\xae2N\xc3\x9e\xd8\xd6\x96K\xa1L\xb3\xa4\xfd\xcfnn\x1f\xe6\xdc2\xc7u g\x1b?\xfb\x91\xc8\x09\x8f\xf8Ox\xb9\xcf\xf9\xd2\xda\xa0\xd1\xaf\x98\xea\x9f\xe7\x0c\xee&\xf7a\x81w[\xc2\x9c}B2T\xce\x07_(h\xc2\xe2`\x99'\xa7\x9c_\xef\xa2JT.\x99Ax\x02\xa8\x01/\x1d\x15I\xa9\x91\x03\xeak\x17\x08\x8c9V0\xc7\xd5\xc3\x90\xd0EXs\xf83\x150\xa6xyx\x82\x88\xc6\xccgr\xf7K\xd3\x9b\xf5\xd5bT\xaf\xe0\xba\xfb\xae\xba\xdac\xafi-0\x93\xd6F\xef\x0b\xdb\xf4\xc8\xe3\x0d@\xe8\x93I8F\xea}f\xadz\x1fU?\x9d*\xfd\xef4l;\x8c\xac\x93\x93B\x1b\xd9\xe92\"\x12\xad\xf0Y\x161+\x1f8\x0aL\x10\x8e\x8d\x09-1\xb5\x98\x95\xb19\xd6\xa6,i\xd0\x827p\xb30\xef\x1c/0\x90\xc9\xd2W\xaakM\x9d\xc4\x0a\xd3\xbbw\x95\xd3=r\x80im\x85O\xfb\xac\x03\x9f\xd9\"\x19\x808\xe2\xb8V2\xdfV\xe9\xda\x0du\xaa\xdb\xc2SS\x81\x15i\xdc\x0a\x0e<\xc3\x85\xb5&\x7f\xe9\x85\xcf\xf6\xb4?&\\\xb8L\xde\x04Q\xf0 n\x97K6\xbed\x82\x8c\xdd\x041\xc5\xc4\x7f\xaa\xbdh\x1f\xb0\xe5\x8e\xce;\xdfCe\xca\x99\xf6\xed\xf4K\x0ax$\x03I\x0f([<Q\xc9\xbd\xf6\xe1\x86\xe5J\xd8\x81V\xc0\xcak\xde\x81\xabi\x93\x14th\xceb\xa5\x09\xed\x8eR\xec\x17Y\xd9M\xb1V\xac\xbb\x12sn\xc1\\\xba\x1d(I\xa9\xec\xdf7\x1an5\xab\xadR\xe7\xc4\x88\xb3i\x14b\xc4\xac\x95\x03S\x93\x1bk\xfb\xb9`\xab\xca/h\xed\xb4\xdb\xb0\xa1[H]4\xa3\x011\xd7\x8ca\x97X\x09\xf5'\xfd\x13aiYB\xcc\xf48~_\xf8a{\xcbr\x9cWXH\"\x1d\xd1\xbf\x83\x9d\x1edc\xf5uK\xa4\\\x95,_\x85\xbbFq\xf4\x01\xe3-\x0a\x1f\x16'\xcbd1\xaa\x81\xe2\xa2\x04\x1d\xd2\xf3\xc4w\x95\xf7n\xa6\xbd,\xe2\xdf%\x88\xd0\xc4\xc7\xf5\x84C\x9c\x96]\xa9O\x0f?\xbfOamL\xc2\xb5e\xa6\xe4\x96TD~\xef\xd4\xef\x1d\x09\xa5=@\xd2s\x94\xcfrK\x93\xc1\x86\x0c\x91pp\xd3\x0d\x0e\xcb9\xb7\xfb\xa0X\xa7G6\xbe\xcc\xf6\x0e@\x84\x84\xb5?\xben\x19Q+\x84\xe5P\x94*\xd6\xcf\xc2\x0f\x19\x02\xc16\xebh6\x15\xb9\xc7x\x9f \x8f\x08,e\x1bX\x98y\xb2b\xaa\xbe\x83,S\x83\xf8-\xf1\xf1\x1a4HTt\xdfP\xd6L\xc3\xc7\xb0\x16sy\xaa\x15Xb\xbd\x8a\xca\xc2\xb5\xfd\x05\xecTP\xd9\xf2K\x1c\xe1>Rz\xf1\x13w\x0c\x83\x10\x14\xad\x1b\x99\xa19O\xa4\xec\x8aZ\xabHI\xa3\x0fdlkY\xc46\xb3^y\xa1\xb3l\x8f\xb2\xa6w\x0c\xcc\xe9\xdefB/\x09\xad\xa1\xeabq\x89\xb6\x06\xa7\xe4u$\x90\xe2\xc8\x93\xf30\x85~a\x0c\x84Z>}\x96\xc3~j\x8b\x08\xb6\xbd\x0b\xa9k\xc3\xa3\xd8\xf9\x04\x8c\xba\xa9\xe5\x167\x84\xe5\xad\xc9\xcc1$b\x83\"=+\x87\xa6H\xe3\x84\xa8\xcc\xf2\x14\xd2H\x92\xf9\x8d,[\xf4\x06X\x87\x91b\xd2\xc8\xd8\xbae\x81\x87n\xdfV\xae*=\xf9L3Q\xbd\xf1\x02\xc5\xd5t\xf4\xaa\x7f~\xf2\xe3*e\xf0pG~\x00\xf7i\x13\xee\xc2S\xf1\xa8E\x12X\xf6*\x1f8\x84f\x08\xaa;^e\x98\xf9@\xbe\xff\xfb\x98\xfa\xa7t\xa4\x95\xbd\x1f|'\x1bM\xcc\x93Y\x9a\xd8-\xf5^\x7f\xe8\xed\xcc\x1c\xfa\xc4\"\x04\x09\x80\xf7\xe1^O\xc0d\xe4\xaa\x83=\xe9\x8a\x8dg99\xc5\x13\xc1\x99\xac\xc1O}\x0c\x91\x06\x93oV\x87\xfb\xde\xfb\xef\xc5E`C\xaa\xa6\x17.\xe0,\xd80Hf\x1dgZ\x9dTk\xf8\x95&\xbd\xa1\xea-\xf7\xdaa;:\x87<@Z#\xeb\xc6\xf1u\xe6\x07\xae\x988a\xabq\x8cU\x9ax\x90a\xe6y\x9ef\xf1V\x89\xde\x10K\xe9\xa2\xe4\xb0\x13\xda\x93\x1c\xfas\xea\xa2\x82\xa3\xb00`#Q\xb2\x0b\xef)\x85S\xf6P\xce\x1c\x1b\x04\xd5\xf8\xc9\xbe`T\xc7\xf2pq^\x1bl6\xecix\xc2\xc7S\xa6Q\xa2\x0d\xdali\x9e\xcd\xa5\x92O\xd2\xd5R\xc7\x1d\x0c\x80\x9fe\x99\xe7\xc1L\x9c\xe7r\x99\x94\xe9\xe9\xcb\x95R;x6\xceN\xfe\x9b3\x04\x9d}V6\x07,f\xf8\xc4\xd2\xf1U\xcaw\xd8]G\xac\xa4\xa3\xc5\x80\x83\xa5$\xc1K;\xba\xea\xcc\xc15=\xa5\xe1:\xbccZ.\x03E\x10u\x84'\xa1\xe7)\xa6\x7f\x9c&\xf5J%\x8ez\xf9\x85\xce\xces]\xc4_\x00v\xf8\x905\x83VEJ\x0eH\xee\x9b\xb3\xcd\xdcQ\xcc\x86\xe6\xee\xc0\xa7x:\xc2\x94\x0ehn\xd9\xfb\x18c\xb7\x02\xdb_\x0dM\xe0%\xc2b\x8aMX\xc4\xb1\xf3Pj,M$\x0a\xad't%%\x8e\xa8u\xedplmP\xe0\x88?\xa1\x99\x00\xd6\xd7:YO\xe0\xc6:$\xd8\x0d\xf2F?b\\\xd5:,a\xce\x9fq9\x9a@\x04\xb3>\xb1<\xb9#V\xb3\\\x18\xdchv}\x1c\xf3-;\xe9\xa0y\xdd\xcc\x9eA\x1ew\xfe\xe2yD\x86F\x0c\x00\x08H\xd7J\x16\x9b\xce\x91\xb5\x81db\xc4F>P\xb6\xd6\x84\x9c\xda\x08\xb0\xa2\xa2\x95\x05\x81\xb2'\x1av@~\xad\xc0T\xa8\x9fU\xfbs>l'\xc0-\xf8\xc8<\x85\xb6e\xf0\xd4\xb7V\xd5xW\x88]-\xa6\x8e\x1e\x00\x8c\xc8\xbdA2 \xcb\xb7\xe7\xfe\xca\x13\xe9#\xcd\x032C*Eq\x8a\xefy\xe0\x04\xaa\x9e \xd8\x91W]\xe5\x9f\xab\x0bX\xfb\x8a\x01\x07\xe2j\x94\xb5\xd9\xd7\x8d)\x01\xebk\xcf\xb3\x81\xfd\x03/\xf2\x89\xf7\xb9.\x9e\x9ds\x1b\"h|@\xf1\xb9\x94ut\xac\xdd\xc2\x88`\xeb\x9e$l\xa4\x1a\xe1\xae*\x8d9\xc6\xf6\xb9=K:\x96~Z\xf8\x92\xf0b9\x17\xfb\xfe\x94T\xe7\xcfTT\x16\\\xe3\xb4\xb5!S\x11O\xa5\xd3\x0cV\x00\xba7\x04\x7f2t\x97uX\xc7J@\x86p\xe7mK[\xc9\xf0=\xf0\xa6\x0fn\xea\xd2P\x14\xe3\xf1\xf6\xba][JUZ;\xa3)\xe7\xc7\x9fqv\xdbz\xd0>\xfaw\xc6\x98H\x1d\xb62\x91\"\xd5\"f\xafFb\xdfpY\xb3\xa9\xed\xb5)\x86m\x86\x0c\x9e\x0d\x18\xf7\x01\x94\x90\xe6\xfe`yj\x89\xee'C\xbd\x1a\xae\xf8(\xd2\xb3\x82\x8f9w\xba\x11\xbb*\xdc\x99:\x96\xcf \x9e\x0e\x0f\xb1\xc6\xca;x\x0ca\xd8\xa0\xe7\xd3\xbd\x9d\x07X\xa1\x15?\x89#\xee\x96\xf4L*p\x86n\xbb\xc8\xc7\xbf\xab\xe6\xee\x01\x83$\xf0\x9b\xb0(\x0f\xab\xd6dz\xf6\xe3G\xdd\x9aS\xe1\xfa\xda\xd2\x18\xcfp\\\xd2\xacZX\xa3\x0b\xc21\x0d\xf5U\xacip-\x8f\x8d\xbb\xeb\x9aY9\x98\x8d\xf0\x16g\xfbN[I\x0aUW$\xd4\xd2\xb1\x17\x8b\xb9\x99\x0b\xde\xfbA\xee\xa2\xd9\xbf\xb0ch+<\xba" +
+	"h\xfe\x1d\xc3\x7fF\xee\xaf\xb2\xf0\xab\xce\xb2_Y\xdd\x9f#\xc7\xe3o\xd5'4\xe1;Il\xdb\xbez\xe4\x12K~\xfb\x99{\x04H\xcf\xcf}\x80t[\xf0JM}5\x1f^YVQ;\x89\xe5\xb7U\xf03\xacz\xd1!\xc3\x17\x19\xca\xc7\xf8\xda\xb5\xd8\x0f0\x1f\xaey\xb0\x9fp3\xe5\xa42P-@v\x14\xa0E\xe1*\xfb\xd1\xa84{y\xd7\xacU\x0cc\xae\x04;{\xdbQ\xc3\x17\x9f\x85ZV\x01f\xb4p\xe601\x08\x97\xc0\"\xe6\xb2\xf6\xd3\xf5\xbfx\xed\xb0\xec\x0e\\~F D\x83BO\xa1\x947\xed[\xb6\xf7\xb9)\xbb\xae\xed\xe9s\xdb9[\x87/,\x93\x93(K+eS\x9b\xee\x1c\xec\x01\x07\x94\xc5\xd2\xb3\x1d\xa9\xd1\xe1\x8bC?S\xb3-\x84\xe3\x0e\xb7\xb1\xa1\xed\x0e\xfbz\x03\xeb}\xa9\x9f@\x9a\x8c+\xe9\xf1\x9a\xdb\xb0\xb0\xdeW\x87\xf7\xdd\x10&[4\\_\xb76\xb0\x07^\x850\x11\xe3lO\xcc\xde\xf0\xc5Q\x1bHx\x91\x1a)\xb1\xca\x15\x11\xc0\xd4N\xe9\xac\\\xd5P\x0a-\xd9 \x07\xe6#\xa7l\xf4\x01\xdc\xed\xdeW\xba\x847a)\x01\x1bZ%\xb5h\xe1\xbfa\x8a=\xf7~\x13v\x1e\xb5\x80S\x81\x04\xdda\xe6\xe1\x8bgY2\xf6\xf4\xe2\x00\xee\xd8\xb4\xe3\x03c\x0ba>\x00\xc0\xee\x92\xb9V\xb0\x19-l\xe6\x97T\xaa\xec`\x9e\x1e\xbex\x1e\x01\xfe{\xcc\xe7\x83.\xa6\xfaY\xec\x02\xa7\x97c\x96$\xa3s\x02H\xc3[\xf5ua\x82\x87+\"'fy\xc4\x92\xb9\x85\x96\x12V\x10+o\x19\xa4T$\x89jB!\x1f\x00\xff\xb6\x8aU\xfb\xb8C\xec;\xd0WP\xf7C#\x96\xe1\xca\xb5r\x05P\xb9\x0d&\xf3L\xce\xd2\x88\x80\x1aG\xafA\xa8>\xbe\xc12\xe7\x02\x070<5\x87\xa2\x8fX\xd7\xc9\x8c\xa4\xd7\x0a\x80\xb4\xb3\xf7Ax\xa8T\xcc&\xf5\x07\xbe\xa3\x19\x01\xfb<\xe3(M,\x11\xd5ug\xad\xc2\xf0\xc5\xa9\x09@^\xa2\xddx\x8d \xc1\x09#\x0ef\xbe\x16\xf6\x02\x1fsM&\x85\x1d\x99\x86)\xf0p\x98.\xd0\xf03]\x98\xeb\xcc\x05`\xdf\xca\xf8\xe3$?\x10\xa7=\x1d\x1c'\xd2\x0d\x1c]4\"\x97\xfa\x15=\xbe\xe6\x97\x90\x98]X\xf4tZ\x7f\x09\xcc\xf0\x85\x1a@d\xbb\xcf\xd3\xeeD=&D\x02\xea#\x98\x11\xc3\x9eM\x1bZ\x9cq\xbdlmJ\x0d\xc4\x90ZaE\xbeF\xd0x\x06\xfa\xf2\x8fNJ\xf2\x85\x8d\xeb\xd7{\x02\xa7\xfa\x89\xd6\x86/\x0c\x8b'\xd7j\xdch\xe0;O<\x8f\xa4\x00\xc6\xea\xcd\xdc\xf0\x8a\xd0\xfd\x1e\x1a\xc56\x9a`f\xa6,y\x8b\xc2;*\xa4\xf2\x09u\x8f\x8b\x84\xf2\x9a\x973\xb4\x9a\x99I\xd5\x99\x91$vM+\xeec4\x8au\xec \xa0\x9e\xb6\x11\xa2\xd9\x04\x07\x1c\xff^R\xd91\xf6l\xb8\x0f\x1b\x960\xae\\\xa9\x1b\xefN\xb1JC\x81 \xad\x19\x9f\xfepS\x96\xd8\x19\xe7\x95\x9f\x94S\x18K\x99\xe4.\xec\x19\xdeo%\xbc\xb8\x92\xeag\x03b\x06\xc7\xe1\x95|j\xf9\x8b\x1b\xa6\xce\x1c\x85;\xc7\x81\xc8\x08!\xfcAX\xb8\x9f\xd4\xb0\xf1\xad\xc3[^\xd4c\xa3U\x0c\x14\x8b\xeb\xf0\x98\xfd\x82[\x99N9\xb3%\xc7\xd7\x85\xeb\xe28\x8eR\x1b\xba3\xd2S\x19\x9d\x9b\xf0\x96\xa3\xc29\xab\x16\xf25\xfa\x0a\xad\"x$m\x8e\xcc\x88v\xc9f\xdb-\xfa\xa3\x9d<\xd4\xc3\x0c\x0a/\xca\xe2\xbe\x0c\x15\x8dL\x98\x83\x0d\x073\x9dp$\xbe\xf6R\x02uM\x07\x88>,DA:\xdd\x84\xed\xa7MO\x15\xfaP\x85\x07\xf2\x05c\x9aE\xe2\xf1=K'\xce\x10\"\x91v\xf5'DW\x10\x14q\xee\xec\xfd\xadVb\x880\xa1\x99\x91\x1b\x16\x81\xe1f|a\xf9\xcbpxXb\x02\x9dZ\xe6\xc18%\x993C\x9a\x04oQ\x01\xa5\xa2T3b\x1f\xc3\xd6 \xec>\xe8Q`\xf5\xf6J\xae\x10\x19Z<\xd9\xdc\xf9\xd7\x9f\xbb\x1c\x0e3\xe6\xd2\xbe\xcd\x0a;$V&u:\x7ff?\xcb\"\xe6]\x97\xb5v\xe4 \x9e2#\xf0]X\xcb\x1f\xb1\xa1\xbb\xe1\xbe\xb8\xa0\x8c\x96\xe6\xa4\xc3\x8a\xd4\x87\xf9<X\xc0\x05]/\xdd\xe2=m\xe9Q\xb0\xf0\xb97\xaf\x95\xb8\xdc)5\xe4\x85\xb0\x0dz\xe0\x0fhs\xa9^-U\x11\xe9W\xcf\xe56\x1e\x0eO\xca<\xce\xfb\x1e8s\xea,\x14\xe5qZ\xf4\x0a\x9b\xa0mo\x9e\x9aAh9\x93\"\xcb!R\xd4\x00Ur\x1e3\x0a\\\x87\xba\x11,\xbe\x1e\xcfF\xac\xb5A/\xf5\xc2\xae5j\xa2\x81%nU\xe4z\xd5\x0aB\x19bZ\xe9\xe5\xf2%\xe3\xcf>\xeaO\x9d\xb7\xad]8\xcb\xa4F1t\x0ag\x18\xdb\xdds\x0eq\xef\x01\xd3\x84\xa3y\xaa\xea\x7f3\xb2\xc1y\xa5h@\xd2\x945\x8f\x18\xdd\xbbBX\x0e=\x9d\xefD\xaf\x0ak\x03\xe5X\xec\xddu\x0d\xb5\xc9\xbc\xf8\xc9\xc5\x1e\xc9\x94\xf3WF\xa6\x99k\xc21\xe1\x0ab\xd6\x0a\xd6g\x04\xa3\x92;ec\x82K\x81b\xc4l~ti\xfa\x9cw\xadf:@\xc5vD\x98\x9edk\xd2.\x1a\x1b\x96\x0a\xbd\xb0lS_\x09\xf5 \xdc\xce(\xd8\x98\x07\x97\x9c\x08*}&\xe1\xf9\x05\x92<p\x07\xf4\xe8(\x1dX\xe8\xf8s\xf9\xf5\xb1\xad\x18\x9b\x9c\xc5\xba4\x05<\x9f[Y\x03\xf8\xc4j\x00\xab\x04o\xb7\x060\x8dj\x85\x018\x1f<G\xbbqW\xf3M\xb8\xcb(c\xe0E,\x8aq\xed\xb5\x10\x8d\xa9\x05\xe6\xcd\x92as$\xc3\x15\xa7\x7f\x02\x05_\xc1\x81\xea\xbb\x99p\x0c\xda\xc4\xd0P\xd0\xe5yM\xac\xb4\x9c\x17\xbc\xc1\xedr\x13\xab\xf8Z\xc9\x04(d(\x11\x17TW\xed\"\xb5\xd43C\x1a#d5\xa5nn\x852$\xa5\xb7\x11\x0d\xba\xe4O\xca\x1fy\xc0\x1b\x09\x18\xbb\x18J2\xb8\x14T\x87\xac\\\xf9\x92\x1e\xf8\x03\x85\xfc\xf2p\xf1V\xeb8\xb0\x84#|\x8bK\xad\\\x08\xac\xd1\x07\x82\x98[\xec\x90\xa1\x99\xc9\xea$Z,\x02\x82\x8f\xc4\x90`\x0b\xcc\x99Z\xca\x11\xfbYx\x87\xa5'}R\x90D\xe5\x98V\x19+\xd21\xf5\x10l\xe1\x0b\x0f\xb76A\xe4u\x84\x83y\xac\xc2M\xf9\xe0\xa9\xa2\xc2\x14/\xd4|\x98\xaf\x1aq\x86\x9a9\x1d\xaap\x0f\x16\xd5\x9a#\xa3[\xfb\x0b\xb3\x07\x16\xf7J\xa2\x81\xd0Wa\xcf(\xe3j\x99C\xb0j\xee\xb42\xb6\xc4.\x87\xef\x09\xce\x07s(\xf6\xab\x19bt\xcbp\"\x89WMn\xad\x18\x05)\xea\xf5\xc4.2\"\xe1k\xccQ\xbbJ\xd0I\xaf\xdf\xf6Y\xeeZK\xe7E\xc8e\xa5\xda\xdc\xda\xd6\xb2\xad\x9b\xcaAy?\xfc\x0a\x7f~!G\x0d\x1a\"\xb4\xb9p\xf5nB\xcc\xef\x16\x05\xc4G\xc8P\xcb\xd3\xe1\x11\xees\xd6x\xe0\xf4\xea\x92\xc4\xd3\xfc\x0c\x82gO8\xd0\xb9C\x0d\x90+\xa0\x16\xba\x80D\xd3\xcdgO\x06q\x14n\xa90\xca\x0c\x0d\xa0\x963\x97\xfas\xd7\xe8p\xe8\x92q\x90>\xb6\\-\xba\xd2\\Q\x02/\xe3&\x8e\xe8\xa9\x90$'T\x18Y\xf9\xb8\xff4\xbb\x14\xfa0\x09\x88/\xde\x97v5m\xe1\xdf\xc06OZ&t\xb23\xc9\xbc\x9ef\x88%\xa6\xd0\x9aD\xd8\xfb\x8a\x81\xb1*\x84\x01\x1cM\xa6\x1by't6Y\x0d\x99K\x8f\xf4\xca\x13\x1e\xba\xd9\xc0\xd0n\xd4e\x96\xd7O\x8d&HR\x9c\xc86dN\xb9}K/\x86G~\x8c\xe7-\xb9\xf39zG\\/\xc9\xba\xb6a-\xcf\xe9\xb4\xb2f\x18o}B\xc8\x87P\xbd\xd0\xdf\x17\xac\xcc\xd0\x88\xdf\x90\xf1\xe3\xa3c,2\x04\x9b8\xd1\xfb\xb0?\xed\xa8\xa6*\x82\x917\xbb\x94\xd9\xdc\xb3Yg\x81\xc2\xf2\x8f\xb1\xe1C\xb7i\xb3R\xf7X\xa3'\x8eb\x1f\xf0lk\xd1\xdf\xa3\x1b\x84\xb7\xa9+\xc9\xaf\x83)\x18g\x06\"\xecM\xdf\xbe\x88\xd7\xa9u\x87\xe1qi\x07G/\xcf@\x9a>\xa0\xc2\x12\x9b\x0d\xe1}\x1c\x0dlI\x18\x8eQ\xfb\x16K\x0a*\x10k\xe3\x17\x08y\xf1\x8c\x84\x9d~\x86\xb7\xac!\x1bA\xbe\xd1\xcc\xcd[rwl\xd6~\x87\xca\x15\xce\xdb\xd8p\xc3\xd2\xf4I{\xa6M\xdf\xe0E\x0b#k\x8bQ)\xe8^\x0a\xfa9!z\x8c\x1e\x8a\xb8\x87*4H\x0e\x9d\x8c\xfd\x07\xce\x8e-\x95\xfc\x90J\xb8\xc3<\x85\xb58!\xb9\xc2\x88\xa1a\x1c\xf7\xd1\xfc7\xe9?\xa4\xf1\x80u.\x82\x80\x08s\x8a6\xea\xbc\xadx\xec\xca5}\xe4\x80\xc3F\xf5\xb3\xa0\xa1W\\eQ\x96\x0dy\xe1<\x14\xceG*{\xa5\x96-S|\xbc\xe1z\x03\x9c\xe0c\xa9 \xe5O\x8d0\x9b\xfc\xfc\xebm\x95\x07\xc3\\\x8cr\x8e\xee\x90\xe8\xb5-\x949-\xcb\xd1\xe3\x01$I!\xf8\xcb\xc2\xe8gR3:\xa1t\x7f\x9a\x9b\xfb\xb0\x8d\x1eX}Y\xf2\xbf\xa7\x04\x85\x9a\xf9t2\x9a'S\x0e\x0dM\xca\xf50a\x8e\x9f\xc1'\x90j\x1f\xb7|;\xc9?\xbf\x0b\xdb\xee\x96\xc9\xd1\x82\x0f&\xde\x80\xb1\xd6\x1e\xaf\xac\x9b\xc1a\xd7\x85\xaa\xab\xbb\xd0\x8db\x06\xd8N\xef\xdd}U)\xaaU\xefh\x9c\x11\x93\x0e\x95\x19Q\x0b5N\xd1\x9c\xb1X\x8c~\xa9\xa7\xda\x98DL\xa9\xbe\xc2ex\xa9\xb9'c\xe5ck\x1c\xcb\x1e\x1cNSX\xa7D\x01\x09\xf1\x13d\x1a\xc7\xf3\xf9M\xda,\xf8A5l\x18*\xffn\xfc\x9c\x89\xb8{~\xb2+\x0c\x86\x09\x10rr\x1fbWc\xd7\x1d>h\x13\xe0\xed0\xd3\xe7\x00\x87y\xd2\xd81\xe5\x83w\xe9S\x11E\x10\xd6$q\xc9\x1f\xbe~\x03\x11~\xf7\x19\xbd59A\\\xda\xdbZ\xf5\xb5jHn\xbb\x872\xf7sxq_\xa2}r\xc1i]\xb3\xd8\xa7,3\x9c\xcb\x93M\xb8p\x11\x92\x14\xb0sj\xf6\xdf\xef\x06f\xbek\xce\xc1Z\x1d\xbd\x0ai\x1a*\\\xc6\xc9\x80\xc2bq\xd21\x97\xbd\xa2\xab\x14\xa2\xe2\xeb\xae)\x86\x8a\xe1r\xf9\x03\xef2\xd5\xe2m\xedz\xf6\xe1\xc6\x90\x15\xee\x0aL\x1c\x9c\x17=2\x9a5t\x0e\x12\xa8L\xab\x8c2\x01\x17l\x04\xeaq.cE\x15\xb7\xd7\xbb\xb5\xa0\xf6\x1a\xcb\xb5\xd1`\xc6\x03\x9f\x82\xe84\xa9M\x14\xc3\xf3\x14a\x1eY\x06P\xd4\xd5\x00\xf5\xab\xe8gAY\xb70=\xbc\x8cv)L\x11g\xd6&+\xdd\xb7\x91\x16\xb4\x82,\x1foi\x85\xf36\xccB [@\x00%6\x17\xb0d\xfc<\x06\x00\xd7\x9a\x87\xc4Pk\xa6F\x87'\xf8\xd8\xd4\xa2\x09!\x07\xe3\x940S\xfaN\x19\xb0\xa9\xbf?\xc2lZ\xf3\x9e'\xf09e\xbay\xd7\xdd)\x07y\xa0\x0b\xc7\xa6!\xd2;3ohb\xc1\x00,Y\xd1\xa0\xc6\x7fL\xd2J\xe1\xde\x14e\x03\xb2K'V\x19c\x85\xb5\xab\x03\xe1\x12\x80<I7\xc6\xee\xf0\xd0\x8d\x9af\x1a\xf9\xcc/hpdl\x08#\xb3\xba\x98\xf6\x8c\x16\x18G*\xc6\x05\x90\x8b\xdbUL\xea\xea&\x19o]\xad=j\x05\x08\xf0aD\xefS\x0a\x0d<\x12<\"\x9b\x13\x96\xc0\x04\x06\xfe\x81Q\x80+\x8c\xbf\xae8\x966\x05\xefej\x90{iE\xdf3\x08]\xe4\x98-\x93\xa7\x13r\xa56M\xc32\\\xf2>\x09\x8d\xed\xda\x10\x8es\x8f\x06c\x07l\xccs\x1c+\\B\xa4s\xc1J\xee,4\x83\x09q\x90\x94\x13\x99;\xc8\xb6`\xfe+^\xd0Bpq\x0b\x80\xbcmx\x07\xf5\xc1\x05(Zr!Z\x1a\xbb\xab>rm\x9492\x0b\x89a\xe7\xaf\x95\x13\xa8\xed\xb9*\xab\xf2\x1e\xe2L\xbc\x00\xd3\x0b\xdf`F\xcb\xeb\xc4@\x1b\x0c\xe5\x0f\x83;\xd2\xb5\x09w\x96U\xbe^s\xd0\xa3\xc1\xb9\x88\xd0\xc7\x8e\xb9x\xda\x1f\xbb\xcb#w.\xa0\xbdF'\x89\x9d\x18\x9fJM\xa1Vk\xff\x19(\x83\xe9\xf4\xb1\xa5\x8d,BNw\xa8-\x19\x90\xdc=\x84C\xaf\x0ap}\xaa\x0eE\"d\x08Y0R\x87\x8a\xa7X\xc1H\x94B\x89/\xec\x1a\x1d\xa4X\x15\xf7\xe0\x02?)\xfb\xac\xd7\x06\x85j\xb23}\x15\x14F\x9a\xa9\xee:p|Y\xf0#Z\xd3K\xa5t\xcfSHB\x90s\xe9v\xa7\x0f\xba\xc8\xe5\x8a9n[[\xab\x85\xb4\xaf\xd2\xfa\xd1\x8b<\xea\xb4\x86\xa0\xf3\xca\x02M\x85g^\xcff\x0dJ!\xd6\x7f\xc5\xbfpGe;\x86\xb5\x0a\xf7\xb4\"<\xa6\xc5\xe6B{5\x9b\xd0t\xbbD\x05B{?\x14|\xc6\xb0\x0a\xf9\xd8\xe6QV\xe9J\xa8\x89\xd3`l\xf0\xc7{x\xe5G\x07\xed\xe8p\x8b\xf1\xea5O\xc2\xd69 TZ\x0e\x95E\xb6?3%#\xa5K\xfa\x13C\x85\xcc\xa8\x01\x9fw\xed\x04F&g$\xcb\x9fa`\xe2\xf0\xc3\xa3\xb0\xa1r;:S`-\x83\x12\xcf*W\x9eJ\xf9\xef\x8c\xff\xc6\x14\xba>\x8f\xca\xf3\xe8\xc8\xb5\x93\xac\xd4\x8daaP\x9do\x12*\x01\xbcl\x16\xc9\x87HF\x8d\x0b\xd7\x82\x04\x9c\xfd\x06\xeb\xfd\xd6\x92fZr\xe2\xd6X\x90E\xc7\x02\xce\xec\x11,\xe1\x04\xda)\x0f3b\xb1F\xdc\xc7\xd6-J\x04\x8a%|T\xa5\x9e\xba\x90{\xdeST\xf6\xe8\xa4k\xc9\xea\x82Mab]t\x1b!I\x87t\xdb\x92\xf87\xe8\x83;\xcb\xf7'\xc3\xaf\xd9\xe1K.;q+Y\xd2\x90Z;\xd8&O\xa2\xb0k\x8b5\x89\xe7u\xc2<t\x8aH\x9c~\xe1\xce\xc4\xa3\x8a[\x14\xa4\x8a\xc3\x15\x83\xf6\xe2\xccJJw\xc9\x01\xecV=\xe1Y\x8c\xc6_n\\\x19H\x0ep\"\xc3g\xb8u\x87\x07\xfesd\xd9\xff\x9cp\xdf\x973\xfa\x1d=\xf7d\xc2\xc8\xb3\xa5j\xcd\x9c1\x1f\xec\xd9G\xb6\xc2FO5\xc0\xc6\xd5\xe5\x08\xc9\xbe\x03\xbf\xe7\xad\x97G\xf4 *[\xaf[\x97o'\xc09\x1aZ\x9a!K\x0a+\xf16\x13\x1b\x88)\xbf\x96\x98\xb2\xd0X\xe7\x05ns\xd8\xcf^k\x0d=1\xf4\x19\xe7f\x15G=\xfc\x04\xc7\xc3L \xe7!\xace\xb5t\xca\xd0\x1b{#,\xda\xc6\x88`\xd6\x14\xacp/<l\xf8F\xd4A\xef\xe3\x08RO\xd9i\xd2=h\xee\x88c\xfa\x09V\xc9\xd3\xe6\xca\xc1\xbaV\xf5\x13\x18\x1e\xd8\x01\xaf\x94\x15\xfa}l\x06N\xe1\x93\x8b\x00\x06Z\xd7\xa8D\x9e\xb7p\x16.\xcd{\xe1\xd6\xeelE\xbeh\xec\xb3\xbb\xe6\xea\x11\xb7\x9a\xdd\x92U\x1e(\xda\xa5\xf7\xe1t\xe7T\xf3`\xc5\xa4\xeb\xa2R\xc2\xa2/\xd0\x90\x0b\x11\x91q*\x17\x99\xfeJ\xdeh\x15\xac\x97H\x8a\xdd\xc3\x94\xdf[\xa0\xb4\xe3}\xd4+2\x1e\xf2\\\xc1\xd21\\\xb9\x0d`\xc4)fB$\x1a\xe4)\xe5p\x85\xcb\xb1/\x99k\x15\xaa\xd1*0\xf5M\xa8\xa2\xbd@3~\x06\xbf\xbbr\xb4\x9a\x0e|\xe17E\xba\xf1>U\xb4\xc7\xc7\xa5qL\xcd}\xe4\xf8,\xbd:S]\xa1\x86\x12\xa5\xef\x8dBF\x05\xa8\x92\xce\xf9rRJg\xa6fb\x02s\xce2\x1dK\xbf\x92\xac\xf5Z\xc6\xb5\xea\xce\xd9\x16C\x1a;\xc6\xbcIz\x10\x0e\xb9\xe5&\xb3\x82C\x15W\xda\xed\xd2\x900/\xa1*\xe4\x0d\xc4\xda\xd5}l\x84\xf8\xc8\x11\xf2,tWg\xa3z\x03\xa0\xcc\x1b\x8er-\xad\xe4,\x93\x0fp\xf0\x89l\x8b$`\xe7\xc8\xa8 \xc1\xa6\xe8j\xc8|?\xc6\xc9n\x05\x08\xecBa\xe2\xde\xa3mE\xf8JWz\xb2\xc0\xc3\xbf\xcf\x06c\x8fe\x11\xe7\xa9\xe0\xadV\xe1\xd6\x9du\xce\xab\x9e\x99@\x1dE\xe7:\x8a\xe1\x97Jf\xc3\xc4\x04\xf2\xce\xc9\\\x1d\xb0\xb9\x81\xe2\xc6|S\xfb \xad\xc3U\xdf\xfc\xa3\x8b7enzB1\xc9\xe9\x0c\x0bk\x89$\xd6\x13n\x9aq\xb9;\x13\xea'/\x0a9k\x8a\xfb!\xb4\x98m\xbe\xa5\x03`\xf8\x9eH\x0b\x16\\\x02\xb9N b$\xff\x89\x01\x98\xb1\xea\x9a\xe1&n\xf0\x8f\x06\xf4\xe8#3\xae(\xf5\x9a9[?\xb3\x18\xf5dXx\xdf\xfe\x1c}\xc3\xf6\xa6n\xceH\xbd\x94F\xb2\x10\xc7\xee\xaaN\xc5\xde\\\xa1\xf3\xb1\x91\xca\x07\x17L\xc5\xc0\xc9.\x0d\x09\xec\xa0\xb5\xd2\x88eU\xbc8)\xd4\xaeL\xcb\x9a\x82\xd9\x0ak\xbd\xa5U\x85\xbd\x00\x82T\x8c\x9b\xb9\x85\xb0b\xe7\x949\xf4\xeb\xbd\xe2\xa0\xd5\x8d#\x1c\xcf&ok\x89\xe8~\x1cg\xe2%C\x8f@.\x88\x12\x84o\xb9\xf8\xf6\x86)\x09+J\xe9\xe1\x1c\x9a\x16\x82\x8a\xfd\xc7\xf6I\x06\xfdQ\x1f+{\x05^\x96\x15\x86N&\x83t\xd3\xb0lAq{\xe3\x18'\xc7\xb9~\x8bN\xb9B\xe8\x0f\xd7\x89\x9bj\xa6\x9a\xc4\xd0\xf9+)Y\x89\xd1_\xbfe\x0c\xde\x18k\x0aD\x9e\xbe\x81S\xf1\x82\xed\xab\xb1\x04-\xe8\x93=\x1dLg\xebJNx\xbaK\x08\x813\x8dx\xec\xd6\xb1\xc2\xdc\x81A\xc3\\" +
+	"\xc8y\xbc\xd1\xc2\x08l%\xc9\x13O\xfa\x95\x06P:\x92\xe2\xf0\x1c\x97\xc39\x88\xd0^\xe5<\xd9\xc5\xe1/\xd6\x1d\xf9\xc4\xb9;\x01\x99zU\xb9\xf0\x06^[\x07\x9a\xf6\x0c\xfd\xa5\x1c%i\xd7h\xd2\xf0\x83\xfb\xcf\xc9\xcc\x8aq\x9c@\xd1\xc9\xc6\xa5e@Sf\xa3-\xd6\xe6m\xd5\xf4\x19\x13\xe7}\x81;\xb2\xff\xd8<9\xcf\xb3Y-\xf2-\x93\x82\xda\xe9SP\x0c[\x1a\xb4\xa7\x93dX\xf2k\xf4\xe7\xfa\xd5\xb3h3%\xc9,\xca\xab\xc1?\xc3'd\x06\xd4M\xb1\x90\x97\x8b5\xb6\xd0V\xf1\xac`\x01M\x06\"7\xcc\xce\xf9\x06RQ\xc6C\xd4\x10{fZv8\x8aF\x10\x1c2Lc8e\xf0\xdfB\xa2\x08 D\xde\x8e\xca\x0a\xeb\x10f\xdah\xf2W\xdb\xc7Y\x8a\x19K\xa6\xaa\xbd\x7f\x0b#_\xa1n\x93\xa7\xda\xea\xd07\xea\xc9\x07\x9f\x0es\\\xda\x14w\xf7=h\xb6\xa1F\xc7\xa5\xdc\x90\xc1\x82>A\x94\x82l\x93\xcc\xf4\xa3\xde\xe1=%\xcfA\x0c[R\xf4\x0cw\x92:\x0c\xc2\x16#\x81\xa3\xae\xa1\x05\x17)\xe792$QYP<\xd62\x8a\xaaON\xd9\x10\x9f\xef\x82\xf4\xc9J4\xb4\x05\x84r\xef\x0ei9\x08\xf0\x0b,\xbf\xe3\xb7\x9e\xc0j\xc7b\xf5}#\x95\xa7\x07\xb9\x0e\xec<\xd7\x0fQ!>\xae{\xcdP\x88\xcfa\xb2:=\xec\xb2\x1c\xf7*\xe7\xb1\xc8\x85Z\x0b\xb6(\xc64\x96\x1c\x03\xee.\x17\xb8\x94=\xc79\xfd\xcd(yW\xf4ug\x06\xf4\x11]W\xea>\x1f\xdb\xca\xd8\x13>\x03\x92yz\xd4Q\xa1y\x09\x08\xf6\x05\x8a(\x03n2\xdd\x03\x89e\xde!\x89\x09\xc9\x02\xc2\xe4\x8aS\"\xf6RH\xa4 u\xd3m\xc6\x08\x0f\xa1\xc3\x12^\xee\x03R\xba\xf6\xd9\xf7\xa7e\xaf\x1c\x19B\xb2\x16B?\xa2\xad\xba\x0b\xe2<\xd1\xa11\x1eoj_\x17\x1e\xe8\xf4\xa4\x95\x8fHYE/6\x01\xff\x9c\xe0A\x0b\xb0\xdb\x1a\xd2\xe0\x8a_\x99\xf1A\x0c.\xf1H\x15\xaaF\xc2\xef#><\x13)\x867paG\xb3N\xdb\xcd\xc2\xa7\xd3i\x89\x0eiX\x84w\xe1\xd3\xf3\x1b\xf2\xd6\xe8L\xcc\xf0\x94\x91\x1fM\x18\xf0\xedz\xd1\x9c\xcf\x1f\xc2\xa2\xbb\xddg}\xed\x0e\xefc\x156\xda\xee\xab\x8ev\xd3_\x8c\x1c\xce\xde#\xac\xe4g\x8d\x92Y\xf1\xac|\x1e\xca\xf6\xd4\xa9\xfa\xa0o\x9f\xa2Q+\xfa\x07t\x08{\x9e\xa3=p\xf6\xe16\xdd&\x8e\xfaB0\xbd\xc0\xcc\xb3\x17N\x9ef\x91ZA\xefh\xaa\x9c\xe7aE_R\x99\xf4\xc8Vu\x92\xac \x1f=&\x8c\x8e\xd8\xfaV\xcfH@F\x91\xa6,\x12Gcb\x98\xa3P\xc0\x15?n1\xe7\x99a\"\xa9\x87\x87\x9e\xd0\xb8\xa3\xe0G\x83>k$Ao\x89]\x98\xef\xeaC\xe5\xe4W\x98+-a\x8e\x96\x9d\x09\x82a\xa91X\x0aEN\xd0h%\xd3\x98\xfb\x924\x85\xd8\x98\xb9\xd5\xf2\x9e9\xa7\xc5\xa6\x99\x8b\xdfm\xb9+o\x7f\x17O,\xb7\x92\xca\x93\xd0\x87v\x92\xc7KBT\x1b{\xcbfe\xe7\x14\xcfaC\xb7\x8f\xdb\xcb\x11\xe2\xaf0[rK\x8c\xc5\x0b\x074\x87v\xb8-Y\xf3\x844+\xa7\xc9\x05\xc3\xe4\xe7:\xdc\x18\xf0\xc2\x94g\xd1\xa5\x11\xe9\x92\x13\xac\xbb\x10\xc6q\xd9\xd7qF\x86\x15\xd5J\x84\x0d\x15[Q\xc0\xe7\"\xd6\x94\x08N\xd7\xcc\x84\xe8(\xbd:\xae\"\xf7\xa9\x87rV`\xff\xc3\xae\x01\x16\x98\xd3\xf0\xc0\x1c^\xa5\xaa\x96\x0d>\x0aL\x83\xb0a\xe2\xd1\x1aX\x0e\xa0\xa1\x0b\x9c\xec\x03\x7f\xae\xdd\xa4\x1c\xfa^T\xf3\xe6`\x04\x1d\xe259\x00\x80\x12l\xe0)B\xab@|r\x0d\x96\x0d\x8e-\xb3\xeb.\x06~\xcf\xdf\x00\xdbY\xba\xadV1\x81H\"\xbdh\x9c\x86\x0d\xce\xcf:\xad\x13x7\x8a\xe7\xa5\xc3\xde\x82\x1d\xf7\xb0\x08\xcfN\xf3B\x01$\xad{\xcbp\x03\x1c\x0c\xd59\x0c\x97\xe0\x92:\xe6\xa7\x1b\x0e\xd23B\xae\xd6\x11\x16\xd8\xfc300g9\xb5U\xcb2\x16\xea\xa8e[\xe1\x9a\xa8gMt\x8e\xe8/\xb5n NP\x9c\xbc\x0f9\xdf\x1b\"T&\xfb\x0eo\xab\xaf6\xa4v\xb15\xd10\xa9\x09\xf0\xe0\xb9DX\xfdX\x92WT\xa5\x0c\xa3N\x0e\x8e\xe1\x91\x14\x0a\x0d\x87\x95_\xee \x8d<\x7f\xd1\xff:\xad\xfe\x1f\xc1\x9d\xef\xf5\xf5.`w\xcb2$Hr9\xd6\xdde\x86\xd4[k\x03\xa0\xae\xe5\xa4S\xb7\x7f\xe7\xac=\x1f\xe6\x09\xff\x8b\xb4\xdbe$\x1a\x84x\xbeG\xc6b5\xbbK*#.\x1b\xf8\xda+&sQ\x97\xd6\xdb\xd9_\x8d\x8d\xf0\x1c\xba6\x14\xe1\xa5\xa9I\xdb\xd1\x14\xb9\xb1xm\xbcv4++B\x8bQ\xce\xf8\x02\x97\x09\xa6\x1e\x85(VY\x81\x04\x07\xeft\x97\x98\x0a\xa52\xc7l\x8b\xb4\xd7\x9d\xdd\xccO9\x96\x9aC\x0f||` \xe1\x08=\xb6\x9a\x81\xa5\x0d\x86O\xf6\xe0\x92'\xf3Q{\xc9\x14(\xb3>.@_\xd2?\x81q\xc3t\xe4R\xd2\xdb\xa47\x1d'p$>`\xca_\xf5\xa6q\xbf\xa7\x90E\xcda\x96\xba'\xc3jq\xd3\xd9w\xec\xefV\x0a\xdd\x8b\x13\xdd\x01\x1b8\xee@Ua\x17!\xfd\x97\x96^\xb1}\x99U\xdc\x89m\x02\x14o\xe2\x8akj^\x8a\x93\x90g\x96E\xc6\x95z\xbc\xc4\x86\xda\xaa4\xc8\x8174\x0d>A\xb2E\x00\xce\x98\xb8\x104-\xd0F\xc71\xc2\xf2\x17I6\x03\xf6^S5\x8fE|\xac\x9em\xa9I\xad\xb6\xe6\x0aNi,\x97\xb1@\xa2\x19\x0fR^\x89`4|\xb2N-H\x9f\xc9\xef\xb6\x86L\x02\xc3\x19\xbb\xb4\x8b\xce\xdb\x88s\xe2v\x83Nb\xa4\xdb\x02\xd78\xd6\xfda\xb0#})Qw\x1c\x09\xe2\x1b_\xa9UM\x97\x13\xe9\x95\x90\xe9\xe3\x8e\xc2\x85\x07cS\x164\x95\xd8-\xc7\xefAt\x8e\xd2\xd1\xf6HzGt\x82\x95\xea\x0bS\xf8\xa4\xd2\x0a\xba\x9f\x07\xfcR+\xc6\xd0\xb9\xf4\xaa\x94\x9b)\x90w\xcf\x12;%\xe2\xcf\x00\xce#\\\xc54@r@\xc2z\xc4\x10\xb8p\xf1\xc12\xb0\xd1\x84\x86\x99\xc5\xf5\x1a\xfe\xd4\xa8'5\xc4\x9f\xe3\xf8\xe7G$\x10\x18V\xbd\x02\x81\xe7&&a\x1bz\x09u\x04\x8bp\xebIg8:\xb1\xe1\xca\xbf\xda\xf8\xda\xb1\xb6\x01\x03\xa0\x17\xe6h\xe4\x03\x1a\xfd+.\x1d.\xb0\x1d\xf5p\xa1\x9fv\x86\xd9\xb0e\xf6\xe5\xa7i\xe9Q\xdd-;%\xc6\x8a(%\x95\xdb\xa9\xeb\x0bV\xac1\x9c\x1f\x9a\xef\xa1(\xc0\x0e\xe8\xf9\xf9Yg\xab\x99\xf6C2\x85\x14W\xe7R^\x92\xd01\x09\x1b\xfa\xa3NQ\xd7\xbd\x9b\xd7\x9b\x8c:?\xefm\xde;g\xe0KU\x04\xfdg$\xbb\x1c[h\x03\xe2\x85Y97=\xbb\x90D\x11\xc2\x1d+IN\xf1K\xf9\xe2\x96\x92|i\xcb\x08\x0b\x0e|\xd5VC\xe2N\x1a\xf6\x04\x93\x86l\xc3)\x1a\xed\xe8RM\xa9j@\x18\xad\xf8\xa0\x82\x18\xbb\xda\xa8\xef(\xc1/\x12\xfeka\xd6\x05\x0e\xe1%\x1f\xb4\x9fHE\x17\xe2\x18\x0b\x87>u\xf9\xe7k\xd6\x8f\xb8d\"V\xf8\x84\x0b$\xb7B\xad8\xb2\x8b\xb6cr\xf5rE\xbc\xb0\xa1\x1b\xb2\xae\x14\xd0b\xf6;\x16\xb0\xc5\xa4\xf2Dd\xd0S\xcf\xab\x04\xfc\x8c8>\xf1\xba%u[X\xd2\x9b+\x15\x12\x13\xde\xcdpcG;\xf6\xb5\xca\xc6\x86\xc78\xda\xcd\x91\x0f6\x9fpTt\x07\xb8X\xe3TY\xf5\xc8L\xbag3\x81\xab\x98'\xa8\xbd\xba`\xf0\x08\xbd\xac\x9f\x8d4\xd1\xf7\xd5`0\xac\xf6\xed\x0dV\xa9\xeb\x8c\xbe\xae\x85\xca ;Z\xde\x84\x912\xe2\x99\xc4C\x81c\xa1;\xc3k)\xf0'\\x\xb0\x8ec\xce\x0d\x08\xe0\xda\x10q\xc0\x05\x1d_\xe3+A\x0eh\xb4\x93|\xe1\xc5\x91\xafz\xbc\xd1\xb3\x18o\xfbV\xfc\x8a\xb5\x96\x9bT\x9c\xa1{\xe4\x10+c\xf8DK%V\x18\xf64|\x9e.\xa6\xe9\xf2\xdf\x09|\x12\x9b\xf0q\x07\x8f\xbc\x0b\x8b\x94R\xf7u\x8e\xa3\xbc\x07\xd1|\xfa\x86\xc4x\x09\xa2\xb5\xcf\x9eox\xde\xd3\x03\x00A\xa7\xc5\x00j\xe4\xe6r\xfe\x1dS(\xd6.u\xbe\x17\xd3\xc9\x8bV\xa5t^\xaafc\x9d\x1c\x0dT)\x05\xb4\xcb\\\xfd\x03uv6$\x9e\xabq\xd9;a\xcf\xda0_\xd1B/\x02}\xcb)\xd3Ql\xdc\xef\xc8\xa5\x95#\xde\xefV2\xf8XS\xc2\x9a8$\xf3sE(Q\xa3\xa0\x9e\xe3\x9e\x19u%\xa6$B\xaef\x1d\xd9\xe1\x1d\x0a\x00\xe7.7\x09\x84\xeb\x86\x85\x0d_\xe5\x06\x99\x853\xf6q\x82\x00\xb9\x0aa\xa6\x0f\x90sbj\x02%\x93Ly\x8e\xe7;B\xff\x06\xb9(\xa7\xd4\xde\x8b\x9a\xc2L)\xdb\xf9\xb1\xfbQ\x189F\xabij\x0a:\xc5#`\xc7\x95 \x9b\xecA\xf8\xc1\xd8\x06\xce3.\x84\x96;\x81\x0e\xb2q\xdc\x9f`\xd8\x17\xaa\x07\x9c\xc6a\xd6\xd9\xc2\\\x89WY\x9c\x0du\xda\xa5\xe3\xcbw\x8e\x1f\x85\xa6*\xfb-\x15Y\xd6\x94-\xce\xa9\xc0<w\xce\xd2T\x06\x98\xf93\xf1\xaf^\xcc\x8f8w\xb6]\x10\xd9\xa4\x09\xd4=\x05\x87\x07\x85\x9dQW\xfd\xc0\x00\x86bc\xd6\x95\xc5\x08\x08\xef9\x9b\xfbh[4\xbc\xbd\x19\xac\xfa%\x87\x9a\x00K\xf5.\xdc\xc9\x06\xcc\x84\x15\x9f\xc0|Z\xab6\x83\xcd\x03\x8d\xa5\x90Rt\xa4G:\xf2<s{\x09@\x86\x9eR+\xac\xc2c\xf8$u\x18q\xa63\xf3\xca\xedC\x93\xb0\xe8\x8f\xa8\xc0\xb9\x81$\xa6<\x1a\x0d\xc4\x08U\x83W\xab\xba\xf4\x8b]t\xd4\xd0\x9c6\x0b\x17\x173\x13\x1f\xfdb\xd0i\xb1\xf3\xe9\xd2\x8a\xc9V\xe8.\xe4a\xa3]\x1c?*\xe6\xa2\xe8z\xe3\x1a\xe00Y\x18\xc3\xa8\x87\xa1\x0b\xcd\xfa=\xf8\xa9k\xe4\xf6\x1c\xe0:c\x12\x8e1s\x80c40\xdc3}@G\x81z\x86\xeb\xd8\x97\x15\xcd\xb3M#6\xe9)le\xa5\x09\x041g\x0b\x05]x<9s4\xbfR\xaa7\xacGo\xd8P\xb0\x10w*\x8c)\x96\xef\x13\xa3\x03\x8f\x9dS\xe7\xd4\x07\x1dh\xdd\x10\x9c\x95\xd1\xc9\xf8`\x0emH\xdbL\x07y0\xf9\x0e\xe0\xbe\xa1@\x86\xc0\x82\xd7\xdde\xed\xce\x8cEvk+b5\xa6bi\x97v\xd2\xcc\x9f\xb75\xa7\xc7\x86\xa3b\x15\xe6\xcfm\x0d'\xafaq\x0cl\xfdvA$\xcf\xb2]/\xc2\x07(\xf9Y\xab\x88PcHoL\xe0z\"Sc\x8a\x04\x9bXN\xf1V*A\x9d\x83\xd59\x8a<2\xbd\x82\xc8\x96\x15\xb9\x16\xb9\xd2\xf0\x91\xa0\xdd\x14\xd8\xb9\xa6Zp\xd8\xd3\xa7\xae8\x82\xed0\xe5\xb2\xb5?\x0a\x9b\xd8h\xdc\xb2`\x01\xed\xda\xe9\x1cmW\xd7\x98\xb1\x19\xb0s\xe8\x94\xb2\x03\xea\x02\x07\xbc^[\xb0\xb6\x09\x1dO\xbc\xe8\x10k\xcc\x0a\xf9o\xb5\xc8M\x89\x95\xac\xc8\xda\x1c\xb9%b\xc5a\xf3K\xa1v\x13c\xbc\xeb\x85\x0f\\\xe2\xa92\xdc\x16\x10\xc6cx\xec-\xcaR`\xce\xa0\xf6G\xf2\xcc$W\x9a\xf4@\xc9\x0b\xf2\x1eDi\x96\xd4\xa68\xa5@\xb8\xfd\xde\x96\xf9S\xc1\xd72y\xa7&\xf4B\x0c!\xc6\\\x1e@\x87\x9b\xbc\xdass\x88\xc3\x83\xc3\x1d\xe6\xc6\x88A\x1d\x90\xe3h\xb0\x88kL%\xcd\xa6\x04jF\xeb(VR\xaemym\xc8\x10\xa0/\xcd\xc6\xba\xfc\x0c\xa2\xa9\xe1AWI[fy;\x06\x8c\xa9\xd1\xbc'h\xf34\xfc\x82\xff\xf0y\x89\x1a\x05oL\xf9\xee$\xce\xdciO\xeb\xfd\x16E\x0b\x1b\x0e\xbe&\xbftA\xea\x00\xb0\x10\x1fs\xfe\x0f\xcc=\xb2W\xf1\x18>a\xf5I\xcb\xe6\xd7\x8c\x83\xc8G\x96\xd6r\xd5\x83\xa5\x19\x1e\xd8bt:`\xd6\xf61d\xb8R\xa2|\x86\xdd\xbe\x0e\xb7p\x88#\xb0{\x02\x1b\x0b\xd7\xfd\xc5\xda\xddg.\x87V\x9c\x99^\xe0\x1f\x03\xdd\xf5\x05\xed\xd3\xe1\x06\x1b?W\x82\x94G\xd4\xa8h\xd3\xba\x18Y\xf0\x82\xf8(im\x17`\xde\xfb8?\x9e)\x0d\xa7\x0eC\x02\xd4\xa4\x15d\x00\xe5u\xf7\x0a4?\xf4\x0e\x86\x85\xfc\xc3\x1c5\xecI\xa6\xaer\x1a\xee\x8dw[+\xddR\xf3\x99>\xed\xc1Y\x9b\xf0\xb0)\x13\x1e\xa6wB\xaa\xf2\xf7\xdc\xb2\x1b\xd1vYPZ\xea\x9a\xce\xd3X\xe5\x9e\x86\xc9\x15\xd2\xb6\xfdK\x9c\x15\xaeT\x95\x16\xbc'\xe8\x14\xb3W2\xc5M\x01\xfe\x80\x13\x10@\xb0SR\xcc\xb2C\x80Fu\xfa\x0c\xc7\xc1\xf0\x87Yj\xb9\xb4\xb4k\xcez\x95\xc5\x10\xac\xd1\x909q\xcf\xc5)\x0d\x1dW\x9bF\xb6\xb0KL\xd1J\x8bvj\x1b>\x84Eq|{I\xeb\x87\x87^\xed\xbe0|\xb5\xed{\xe1s\x9f3i\x82RT\xc1\xdfXgiu{\x9c\x10\x7f\xc3q\xf2\x07\x9c\xeb|\xc8\"q\x1aN0\xfcl\xc6\xea}\xbc\x07\x13\xc3\xea\xb4@$9}V\xe9\x03\xde\xa7\x8b\x90R3\x8b\xa6\x13w\x08\x96*O\x9c\x08\xd9\x19D\xecX\xe7@\xac\x98$B\xd3\xfe\x82\xc2\x83\xa0\xd1\x8c\xd5y>\xa5Gv\xaf\xcbC\xcf\x1c\x03\xe1\x07\x87\x96\xa3Z\x96J\xf8=\xf1\x13\xf1\xe7\xe8\xfe&\xac\xdf\xbb\x0f\x18R\xb5\x9ao\x82\x08\xf3)\xe69\xea\xacV\xfb\xd2Q\x09=\xc4\x99\xca\xc0 \xb9\xd5\x1d\x0am\x9b\xaalM\xc8\x0a\x12j\xac\xc1H\x97M#P\xd7\x95\xc0u\xfb\x08\xf4\x87\xca\xb1\x86\xcdbE\x19x*f\x1d\x92\x9b\xecc\x9f\xbc]n\x11m\xd7q\xe7\xac\xf0\xcd\"\x88\x17\xa6\xfb\x85\xa7\x0dg\x95a\x1c\xc10\xcc\"]\xa9\x82\x0c\x16\xfbI/\xbc\xb2\x09\x92\x8cr\x9d\x0f\x83\xcf$\xb5\x1d\x1e\x9e\xf4e\x9a\xc4\xd1\x0a\xeb0\xa7\xd6\xb0\xfa\xb6\x085\x8b4\x0a\xc4\xe1c\xc3R\x04\xba~yE\x0b\x0d\xc1;\x8a\xe2\xe1AE_JZ\xeb-\x1b\x90\xba\xa1\xf9\xafY\x1f\x88sR\x93v\x89h\xed\x7f\xcc&3%L\xecO\xfcm3.D\x88\xc9t\xf6\xddi\xc9\x04\x16\xfb\x9a\xdc\xc42^\x9b\x05\xf4\xf1\xccC\xb4\x9f\x852\xfc\xa2~\xcb\x1a\xd3I,^\xecP\xbd&Fo\xca\xc3zR\x03_\x14p;\xec\x10x\xc0bU;\x11j\x9a\"\x00\x04^\x0f\x1cC\xbc?:\x1d\xb6\xd7\xcf\x0csCt\xd8\xc3\xca\xf0W\x86\x85\xbf\x84\x9f\xf1\xa0\x9eB\x87w\x89v\xc0\x08\xd3\xd0F\x19D]\xe0n\x93iG\x07\x95\xf9VM1\xfd\x12\xab\x9f\xa5\xcf\xfc\x02\"3\xe3\x11K\x83\x1f\xe2\x18^u\xca\x9b\xb8\x89Z\xa4\xa3+\xbcC\x00+]\xa0\xe0Kq@\xaf\x84v\x9b$\x0dL\xe6\xd8\xbf+\xe8\x09\x8f\xd4\x03\x9e\xedg\xb4X\xc2\xb72M*Ls\x86K\xb9\xa9\xbeI\xcf+evj|Q\xed:\x12\xc7\xf3\xec\x86\xad\x11|\xedx\x07\x89\xc8\xc2\x1c\x80v\x1cw\xc4\xc0\x8e\x86\xbb\xd0\x1d\x03\x83>@\x87\x13\xe6\xf0\xca\xe1\x09\xb2\xcf\x0d\xc4\x81\x10\x0aP\x91\x0e\x00\xa0\x15\xb2P\x0c(b2\xdb\x07\xc4 p\x85N\x18\x83\x8d\xbeL\xfb\x00\x0c\xe9\x0c$\xc6\xd0\xd4\xd1\xa2\x8apKLz\xb3\x81\xcd\xedq\x1c\xdc\x9f:R\x8f\x14+\xba\xa6\xabw\x0fr\x90a\xb6\x0an\xa9\xc2\x1fb\xf1\xa3\xb0N\xef3hE\xffpRx\\T\xad\xd5\"K\xa2\xa4\x0bwi\xc9)\x1eH\xeb\x8a\xa5\xf9\\\x87~\x1f\xeb^5\xd2#G\xb4.P\x14]\x89\xe6\x88\xfc\xc0\x0ay\xd7\x82\xbc\xb0\x07\xc16\xbcs_^ \x1f&\x1747\x18kX\x13\xff\xa4\xf6\xd0\xb5J\x9cR\xa8\xf2}X\xc5\x0a\\\x19\x8bS^\x93\xde}\xeb\x87\x94\xe6\xac\xb9\xc6\xc9\x84&\xf7\xa5O\x1c\x1e\x1d\x95\x06\xf0\x12\xc9\x14\x8bo\x1c\x96\x00u\xfa\xef\xf2\xc6p;}\xb7\xf7\x88\xfd\x0e?\x13\xaa\xbaNo\xeb\xa7\x11\xac\xa9\x07\xb2A>\x13\x04\xcb\xa1a\x1b\xc3+\x07\x84\xf7\xd2\x95\xde%\xa8}t\\\"HK\x9a\xffx\xf0\x94p\x0a\x03>R\x8c?\xf4\x84\xb6\xab9\xac\xb3d\xa5VE6\x86\x0f\xef\x1en\x92\x16~\x1cQ\x9d\x0c|\x81\xac\x84\x83p\x07\x99%\x14\x1e\xe1\xf3\x85\xa21p\x19\xc4x\xf1G3l~\x9e(\xa3\x98(v?\xc6\xe7\x05\x1c\xbb\x0c\x8b\xf0I\x16?0\xa8\xd3s\xc4\xeb\xd9\x83\xdfixt\xe2xt\xf2 \xd1L\x98\xd9\xb1\xd7\xf0\xd8\x05\xf7\xeb\x01q\x87#B\xf3\xf9I\x1fkq\x97*\xfbS'\x86\x09\x01l\xd6zwq\x85[\x9e\xeb\x86\xb9\x9b\xbbWE\x86c\xc3\x9f\xf1*\x813%<\xa1\x87\xf51=Db\xb4\xeflVp\x89\xb3R*d\xf0\xb5TN\xb2\xc3\xd49\xca\x85\xef\x85'{,\xac_B\xed\xf5\x80$\xf7\xb7\xa2\xb7\x9dJ\x82\xe0\x9f|v\xacIs\x19\xa9\xbf\xb6\xedb\xd1\x1d\xa4" +
+	"YC\xa5Q\x0c\x9b\x92hBVB\xb3\x13:c\xca\xf5\xa5\xd4`\xeah\x8cd\x0a\xd8:\x0b\x09\xdc@o\xd8\x06\xe4rw\xcc\xac\xf3\x10\x16\xccW\xaf\xa4\xaaJ\"\x88\x91=\xe3\xfe\x92\x914b\x9do\xb4\xe5U\xf2\xdd\x9c~\xddiH\xf5s/\xb5\x12\xb2'\x15y\xe4ey\xe2\xc8\x86U\x93\x8dO\xde(\x13\xf7]\xe1%\xd2\xc9H\x03 \x10r\xa2\xd3\xee\x92s\xca\xc8\x1egq\xe26\xee\x96NJ\x93K\xf0X\x1a\xfe\xb5\x09)\xd1\x9e\xa0a -/\x85\xcf\x06\xc2\xf2\x9dY\x12\x86)m\xb0\x1f\xf3e\xfdL\xc8\x01\xad\xc5\xb2\x82g\xa1\x8f2>\x0a\x06k\xd6D\xef&I\x09:R\x1d\xcb^\x93eVua\xa4\xa7\xbb\x0a\xdd%\xdfU\xa2\x9f\xe1\x12YP\xdcjX\x95\xed|K\xfbZ\xa5\xcf\xe2\x05KI\xc4\xa91\xe0`V6Z\x80'\xf7\\[<\xd8\x88\x10\xd4\xef\xe9\xd5>B\xde\xd31\x19\xe1@<\xc5\xa9\xbb\x8b\xb4l\xc1\xd8\xc4\x01e[\x1a\xe9 \x13\xb7\x9dx\xdd\x09E\xf2\x1aS\x01\xa9\xdd\xa07\xc7\\\xaat\xd13\\m*L\xf3v\xa9\xddl)4\xa9U\xb1\xca\xda\x91Z\x8a\x82\x1ds,\xfa4$;\"J\xf5^\xe8\x0dc\xcd\x16\xfa\x02\x1ah\xaa1+PxX\xc8\x1c\x04\xa2\xe6\xa7\x81H\xa4`h\x0e:\xc9\x9cDP\xaaM\xbdL\xde\x03\xa9YW\x8cz\xbdWok\x82\xd2K\x0b\x8c\xd6-\x06\xf4\xaa\xafqBma\xa59\xceon\xed~=\xdd\x9b.\xb1l\x9bh\xb2\xf8\\\x0d\x9a=.M3b4\xa7\xe0}Z>\x04\xf2\x90/1P\xb7J\x07=Tp\xf3\x92r\xb0\x8b=\xdf\xa2\x9b\x12hx|d\xfd\x0c\x83\xe3\xd1\x92<_\xbd\xb7J/R\xe20\xd2\xf9\x12kh\xf7cj\xa4\x01K*v\x9aYZT?Q\x08\x8c\xac\xc0\x89\xd9\xa0z\xd3e\x8e-s\xff:\xba|,\x98\xc7\x85\xb4D\xb4\xfb\xd6\xe4%\x96\x1fneh\xa7\xa6\xc2\x8a\x9d\xd6v\x1d\x99\xb4\xcd\x18\xc2oa\xb0\xf3\xc3\xdbF[\xc6l\xc9M\x07$)\xbba\x1c\xe4D\xe2\xf8]\xc7\xdc\xa9id\xed\x1b\xa9z\xa8m\xd9\xd4iX\xf8&\x8b\xa2n\x98\xf30VZ#\xd5\x02r\xb7\xb87\xc7\xb1*\xcf\x80\xb2\xf7S\x90Q2\xe9g \xe1V\xfdb\xb0g\xb4f]Ol!\xeb\xce\xea\xd8\xa6\xf8\xa3\x9a\x0f\xda>\xca\xe8^\xc6\xef\xa1\xa1\xed$\x96nQ\x8au>\x8d\x97\x02iU\x02\"\xcb\x1c^\"\xee\x84\xff\x90rsNU\xca\xf0\xf5\xec\xb8\xcc\xb2D\xb9\x9dW`\xc2Z\x10\x1b\xc7B\xf5\x10VvV\x8a\xde\x1dqv\x8db\x93\xdf\x02\x09O\x05C\x0c\xb9\x08[\xe3\xc2\xb6,\x7f\xda\xd1\xab\xe7\xadJ8\x1e\xd4\x91\xd4\x9c\xf8`\xb4\x0e\x99\x12\xf4!\xed\x9d\x9d\xa7\xaa\xf3h\x16h\xc5\xb9\xe6,\xbe\xefi2{\x82\xb5\xdb\xee3\xd90\x83V\x82\xc0\x96\x83\x1f&.\x99\xb1\xb3\x01\xa7FU\x0b\xc4p'\xfa_n\xc0x\xba2\x09\x8a4\xfd_\x1b\xa7\xeeZ\x7fn\x95N>\xf6\xe5iN\x86m>\xdd)\x18\x05\\\x13%\xfc*=t\x05\x03\xa5\xae\x90\xd3\xafB\xba\xe4\x8c\x97\xa3E>\xa3\xc8\xa6\xfd*\x15N\x0eZ\x0d\xd6Z\xe4\x06\x91\xde\x9b8\xc6\x7fV\x98\xb1\xa5?\x08\xe1\xd9<W\xca`x\x92\x95\xce\xa4\x066\xc4l\x82\xb6\x87\xe4hQ\x96\x96\x12\x08l\xb3J\xf4\xb2\xf7!{(\xeb\x82G\x0d\xed6x\xdeu&1a\x04o\xc3\x86\xbfc\xf3\x94\xf6'W/\x83W\x03\xb5\x16\x1c\x8c\xef\xb8\x04\x97\xc8\xb6P^\xb4U\xed0\xb6\xd5\x0ec_\xedP\"\x98\xe5\\\xc1\x8a\xc3\xd2\x9ds\xf2\xb8\xbfr\x93\x90\x13\xb4\x99\x8e<\xc7/\xf3\xbc(='s\xd3\xc2'.\xa5=A+\x97\x91{\x1a\xcfz\xa3\x8a\x0d6\xd8\xb3I\xe3\xaeh\xe0\x0b\xae\x0a\x94pu9\x1a\xf4W\x7f\xf9\xe3\x1f\x7fx\x99\xfe\x91\xfek4\xd8\xbf\x7f\xf9\xbbWD\xb6\x83\x15;\x8b8\xfa\xa6\xd5\x1d\x96\x94'V\xe1\xf1\x9b\xc1\xaf\xbe\xfd\xf1w\xdf~\xf7\xdd\xcb\xdfBM\xdc&K_P\xc6<N\xf5\x8fr`JWRn@\x99\x82\xa3\x91\xc8\x95\x09\x80\xf9\x91\x0b\xc3nm\x19\xa6\xa5!X\xb2H\xb1\x92W\xec\xc0\xe9\x1e\xe6\x15P%>\x0a\xc5\xa9\xb7\x8c\x8c\x96i\xed\x15\x07\x0c\x04\x08I+\xd8\\%\x9e\xa9>\xd3\xdf\x06\xa5\xc7F3G\xc0\x87F\xaf\x1f\xcd~\xd2\xb3\xf1\xa1\x97\xb0\xc4\xf3Y\xe2i\xacN\x18\xdev\x9e%\x0e/\xa7\x9b%\x16<\x09\x8dL\xb7\x84NY\x98\x95\xcfd2\xeev\xb0\x99\x9a&\x00\xbbb\x98\xc7\x09\xcf-\x09\x81\x112\xf1\xd2\xa9\x7fdW\xef\xd0s\xf3|rS\xc0\xfcL}\xb5\x98$\xf2\xac\x8c, \x16\xdfv&W\xce\xbc\xb4H\xda\xd5\xa8\xf4\x84\xc4\xb5m\x19\x02e\xb3.\x10\xc1U\xe1n\xc8\xd5|#\xcbiel:\x00Et\xd5K\xe7\x09\x8d\xbb8\xdaq\xeb=4\x8f\xdb6IK\x1f\xa5\xff\xd4\x7f\xd1\x16\x08\xach\x0a{\xa9/\xd9U\xb3z\x9f\xca`i+8>\x9b\xf0\xbe\xb4\xb0\xde\xf7\xf9\xd4\xc3V3rR\x088s\x1e9[\xad\x9f\xc5\x0bmMfW/\xc2&\xa5'\xd8\xb5{\x13\xee\xa1\xb5\xe6S^\x16\x90Xb\x0e\xda\xca\xa4\xb8\xa7*\x10v\xd3\x03\x9b\xd7\xf3\x9a\xc9$\xc9\xd0\xdd\xa2- y\xcd\x1b\xa6Ze\x03!\x8e\x0d\x8f\xc9Tlh-u\x87\xeeX\xb1P\xcct\xc0\xd8\xc1Z\xbe\x9aI={\x17\x8e(o\xde\x086\xa8%\x91\xea\xd3^\xc5\xf8\x0cA\x02\x8d\xc5yY)\xb9!\x8d\xf4\x08\xc2\xffi\xcfG4\xbb'\xf3\x82\xc2\xac\xae(z\xd6\x18x\xed\xe4\x0dL\xd5\xb2T\xbf\x069\xef\xeb\x9d\xa2\xfcx\xab\xac)\xb1\xa2[w\xd9Y\xed~a\xe9\xad\xf0)\xd6\xb1\xe1~\x9f@\xc1e\x0a@\xa9\xb2\x18\x07{\xc6\x9a\x90\xeef\xec2u6\xd7T\xb0\x8fn\x7f\xae\xab\xe2\xb9`t\xab\x980\xca\xa2F_6\xab\xf0\xa4O\xdf\xc7\x8a\x0e\x11\x1f`\xbeAD\xb4V\xfa\xed\xe0w\x1f\x9ee=#\xa3\xad\xf3\xa5\x0a\x0f\xa0(\x8dS=\xdcC\x92\x88*V*\xe6|\xde\xd2p\xb0\x172\x87\x05\x0a\xb3\xea&\xce\xe2\x94^~\xaf\x98\xbd\xdd@\x1b4\xcd\xd2zN\xab\x9cK\x19\xa7hp\xa7T\xf9\x0d\xef\x9f7\xecd\x8d\x15\x0eD\xe0\xf8\xf0\x1e\x89i\xb2(3\xd9\xd4\x06>\xf3\x1eH\xb9\xae\x8c4\x8f%\xc9\xdcql\x9a\x9a\x9a\x112\xa7\x8b\x18\x12\xc7\xfd\x140\x80\x86h\x9a\x00z\x097\xb0N\xe3%\x94l\xd2\x82\x0da+f|\x9d\xdd\x83`\xb0\x18\xe8HF\x12\x87\x93Jo\xe8\xa3\x16\xf6]'\x84*\xe3HR\xbb\x1b\x0c\x80y\x97\xe4\xfe\x05\x8c\x9aIF\xe223\x85\xbcJ\xd8\xb1H\xa4\xd1]7\xd5\x9dw\x84\x05}|\xcb%\x17\x87\x1aFV\xc8\x83\x85E\xf6\xc6\xcbp\x8f\x1e\x00\xfd2\xef\xcf\xf0\xc4\x12\xb0M\xb8K\x10&\x18(\xb5F\xb8\x0fP\x9a\xc4M:\xe8!\xa6\x81\xe3\x11\x98\xb1);Q@\xb0\x19\x15\xe4\xb7\x8cU\xdeN\xac\xfa\x96*3I\xc5\xb5\xe6\x0f\xdfk\x17\xd6\xe4 ;\x16\xb8}h\x09\xd2\xee\xb9h\xe1\xa0/\x19|\x0cE\xa5#\x14T\xa6\xef8f\xca\x04\xdc\x05a\x89;8\x95\xd7;\x95\xfee\xe3\xc1\x83\x8aM\x1b\xf1\xe4&\xf8\x87\xbcgm\x8c\x11g\xb0d,8\xab\xb0`\xd1\xfe\xd7\x83\xeeyu\xdc:\x1a\xe8\xe4\x03\x81\xc7\x86Y3\xf5S*\xe2\xae\x05\x94\xc2Ws\x06u\x874>\xechK<\x04\xc9P\xb9\x08\xf7\xe2\xf1\xe4\x14\x16\xf0\xfe\x0b\x08\xe3\xa5\xd6\x84\x05\xee\x0d<w\x99\x17\x0b\xb1\xce\xb8\xbb\xc1\xf5\x9c\x95\xba\x00\xf2(\x0eV\xa0\xc9A\x7f\xb1\xba_\x1exuj\x03}^\xd2\xc1i\xdf[\x89J\xcb*Ny\xe7\xcf\xe60\xe3\xb2\xd9\xc2\x00\xfdL\xe7\xee\x995\x06D\xb9a\xeb\xf6EzY\xf93\x82\xa2[y\x81\xb3\xcb\xde cP\xacD\xa8\xe8B\xcb\\\xcd\xa1\xc1g\xea\x1a\xfdI\x0a\x0c8\xfa\x8bv\x09\xa1w:'\xcf*\x0b:h\xabhp\xd4\x11\xff\x09\x09\xcb\xac\xa4\xac\x06su\xea\x03<\x842\xf8\xd9\xa4\x8f9?\xc6\xb4\xf99\x07D\x0b;$\xfaR\xf0\xc5\x08\x1eM\x82=}$s\xaaV\xb0\x83y\xf8\xe0<\x8b}\xe1\xe3(\xf0\xe8\xf2\x89\xb5c\xe7O\xaf\x1ck\xdcK\xb9X\x89hf\xc9\xeeN\x97\xa1\xbf\x8c\x0d\xbaK\xc6\xaa\xa8\xc5\x0el\xf9\xcd\x98\x0f\x91{\xaa\x0b\x80V\xd7\xdd:\xeb\xe6\x8cG\x1ef\xa6\xf1}M\x00C)7\x8b\x172$BR\x8e\x1c\xf4LW\x8f\x9f[]\x8e\xfe\xe9\xd4!\x97\xd1 \x83\x9a)\xbf\xdfM\xb4\xac\xb5&\x85\xa5\xab\xedAt\xcf\xaa\xaf\xab\x15KY\x03\x9eN\x12\xd1_\x0d\xe4yx\xd0\xd6P\xe2T\xfb;\xca@\xf9`\xd8*6\xba\xab\xb0\xc2\x09g\xb4\x17Ha\xc4$d&h\xf6N\x94t,\xc5zF\x88-\xc7N\xb10\xc8\xa2\xae\x1b\x05\x1c]\xa4/1\x11\xb6C\xedoO\xfe\xa0\xc3\xc3v\x0c?\xc7h4\x9f\x05u\xf0\xb5\xdf\x8b8\xe1\x08\xf4\xb2\xcd\x1f\xc9n\xa3\x05}\xec\xe68w\xc4\xe6u!\xab\xfa\xb9[\xca\xe1Q\xbb\x9a\x89\x18|H5m\xd4\x12\x1f\xbb\xe1\xa3n\xa2\x82\xf90sp9\xb1`\xb8\xa2\xcb6\xc4M\xd8\xd9\x88\xe3\xbe\x95\xc5\x0aE\x94&\x9c~f\xf5\x9b\x80\x17S\xbf\xbd\xfe\xda\xda\xbc\xae\x90g_\x84\x92%\x0c%\x13\xb7\xa5\xc2\xa3\xb20\xb68rfCK\xe2\\t4\xed4+'\xfe|R\xd9<\xf5\x1cg\xb6\xad\x04k\xa4\xc9A\xc5H\xbe\x92TC\xe8\x05a;.!4\xeau\xa4\x11(\xe6\x84\x0d\xcf\xcem\xe1\xbc\xf2\x88\xee\x10\xe1\xd4\xdf\x7f\xd0\xb53\xfd\x13\xd5'\x1e\x16b\x05\xf7\xe9l5\xfb\")\xccsX\xcf\xe7\x95\xdbf\x9d/\x0b\xf54\x05kfuY\x0c\xf01a\xc5\xab\x19,\xd4)\xfc\x0dy\xa5YX\xe4c\xb0\xc0b6\xda\x95tv\x99:\xe3\x0b<*\xf8\xa0Xh\xe8\xc2(\x02\xb2\x9fd\x88\xc7\xc3\x9ci:\xe0\xcelaZ\xf1\xb9\xf0!l1\xe1\xe9\xdc\xdb\xb5\x8a\x9d\xe0\xe6\x04\x97\x19\xa1\xfb}\xf6\xb8VY\x10\xf6\x8b\xb69N\xfc\x1be\xb5o\x16\xba7U\xa1\xbc\xf4N\xe9?\x0f\xb1\xe6\xc4{\x112!s\xb3\xb7 XkyZ\x99\xfd\xcf\xdaD\xa8gPv_\"s*\xb7\xefK\xf7e\xaf\xa6\xe9\xe9\x1d\x09A\xbb\xa5O\xf8\x8b\x8a\\\xbf\x81\xa4\xd6\xc0W\x82\xa4w\xb7fB\x1f\x8a\xd8\xd4\xfdX=l\x13\x0d\x9b!\xb8\xbc\xb6R\x85\xf0^\x9f\xd0\x94\xea|\xf2\x8e8\x94\xad\x89\xe9R\xf5\xb0E\xa5F\x98\x81l\x90=\xf1{\xdd[;u\xf5\xb3\xb6\xf6#\xcb\x02\x02\xae\x1e\xcdO\xd9]\x12D\xa7\xe62C\xe8\xcdO\x88W\xbd\x01\xa7\xd8\xa8;Z\xf5\x11VWuet\xf8\xba\xb1J\x03`\x0c\xc7\x07\x03\x83 R\x15+\\\x1c\xc7\x1a\xf1\xa0\xa2\xaf\xf4GmRn%a\xb5\x89\x01N\x85\x15\x1f\xf7\x1a\x83q\xea^S\xf4>LlN\xf9Dy\x06\xcfS7\x84k\xa6\x9a\x09\x8b\xc2\xfa\xec-\xc4vU7S\"\xe9.\x8fa\xf9\xd0\xe6\xfc\xe2\xbd\xf5@9\x8e\xb6\xa5\xbf\xf5\x0do\xd1O\x1a!z\x0a~a\x08\xfa\xe1\x17\x06WLW\xebhA~\xa5B\x06\x9c\x07<'\x7f\x81\x7f0\xef&\x9dh|2\\\xb5u\x0c\xf6X6\xc7\x05)\x1c\x02\x86)\xb6\xacE\xd7\x15k\xf0%>\x892\x01\xf8\x8f7\x8a\xc9\xc9\xf1\xd9|\xcav\x8c\x8c\xb5\xbd\xe4\xed\xf0\x80\xbba\xf0\x81y'\x01\x11`\x11\x0c\x09Pm\x99\\\x89\xf5g\x003\x80\x99\x042\x06\xa7Z\x82\xd21\xee\xf0\xba\x05\xb1u4\xe9es\xb5\xf0\xa3&<Ad\xbf\xe3\xddN\xdb\x8c9@\xb6\x8a\xa7\xb0ez\x84i\x7f\xc1\xe7ma\x02\xda\xb5,\x87\xb4dA$q\xf9|)\xcf\xa3\xb3=\x96F\xbb\xd7\x89\xfc\x80\xfb\x9dG\xdeuzKt\x03\x0ay^+\x8f<\xe5E\xc6Y\xc2\x9b\x16\xf4.F\xfe#\xab\xc5b\xec\x15\x9b\xb5G:Q\xb8\xa3\x80\x05\xc5\xcc\xb5\xea\xfe\xc2\xc0`\x8d<\xbb\xb5\xb7\xbd\x0c\x0b\xcb0\x8a\xbf}\x9e\xc6\xf1\x8b\xdd\x9d-;N\xf7,\x9d\xc7\x11T\xc8\x99:\xf6\\\xdd\x0cgR\xac{K>=^\"/\xfd\xe0\x85\xa8\xabo\x87\xc5|<,\xec(\xddB\xac\xa2\xbej4Y\xf7c#\x19\x19\xc5\x888\x05\x0c\xc6\xc2\x11\xa6N\xa9\xc8?\xe7H\x99\x99\x82\x96z7\xc0\xe0\x06Tw;\x86\xf2\xa0\xa8\x07a\x8a\xc3\xc4_R\x1e#\xb3Aoq_)`\xc7\xf7#:7`\xfdN\x88\xee*\xcd\x00\x0e\xf5\xe6\x90\xda\xec\xcbV\xd5\x17vb\x9cY\x97f\xb0\xff\xc9Ng\xdf\xc4\xd5y\x91D\xc5\x00\xe6/\xfc\xc9\xc34\x15?\x155]\xe3\x08\x0fA`C\xd58\x0c\x18,d\x95\xf0\xf7\xa6|\xbe\x8d#\xb1\xd2\x15e\xbd\xa7^Z\x82\xe3\xa3\x02\x8fd\x0b\x8c\xb6\xb7\xb0)\x99\xdc\\\xfd\xd9\xe0YsM\xd9^\xf0\x97_g\"^\xeb\xf0~\x10\x9b\xf0\x09\xe9\xed\x8c\xd2\x84U\xd2\x87\x83h\x82\xe1\xa6[\xe0\xce\xb8\xee\xd5\xa0\x88\xb5\x97\x84\xa8\xc2\x06](\xe4\xb6\x9fs\xb1{\xae\x0b\x8f\xef\xc8\xaf\x04\xb7\x82t\xf8BX\xd4\x9f\x85:=6&\x08\x03\xbeL\x1f\x17u\xdf\xbbc\xaa}\x04\x90\xc7'\xed\"\xa2d\xe6\xdfgAN\xd1\x9f%\xe9`D\xdd\xde\x8a\xae\x90\x84\xd9\xf1(\xe0<j\x05\xad\xe4)r\x8bt\x1bf\x9b]\xa3\xa2\xac\xd9\x9f\xfb\xe4\xe7\x8e;e=b\xc3K.}\xb2\x86\xdd\xea\x96D\xab\x08\x7f'\xc2I\xd2\x928c\x1fP\xc8\x0b\x08\xfe\x85\x12~\xe8\xc2_k\xb2tC\xf0$\xe8\xe5%\x8a\x0a\x90\xb8\xca\xc8\xe7\xed\x96\"\xb3\xdb-\x7f!\x12\xf9p\xfbkQ\x11\xb2\xbe\x9eSq!\xce\xe8\xbd\xeb\x96\x8e3\xfc\x1b5Y\x03\xdf\xaa%\xc3Z\x1cx\xe6_\xa2M\xbdf,\xa7.\xe5\xb0\xe4\x84\x98\xfc\x94\xdd\xc1i\x1c\x87{\xcb\x0a\xd9%~\xaf\xdbx\x1f@Lk\x02\xf8\x10\x99\xdb\x18\x1a+'2~H\xc4\x1eG%\xf5UQPp\xee\x98a\x84\xe5\x82B\x95G\"\xba5N ,\xbcy#\xf1\x10\x0e\xee\xa7r\xe82\x12\xe8\xe4E;\xc3\x8d\xb0\xb59\x9f \xd4v\xa9\x93\x86\xad~\xf1\x1c\xc5b\x0b\xe5o\xbd\xbePx\x9a\x9bQ(;\x8e\x19\xe6\xbf\x89\xb5ei\xdeg\x97:1\\\xa5D\x8d\xc7Ql\xf8\xb5\x14\xa44\x0c\x8a\x7f\xc4\xa0\xcd\x989\xdd\x0d\xdf\xf0\xf3\xa3\xfc\x8a\xcc\x07?8\x11k-E\xcd\xc9\x95'\xc1\"\xc9p\xc3|\x98\xac1!P)y\x1c\xf3\xc9\x89\x19\xbb\xd2r\xb1z\xff\x821\x83\xe9\x1fI\x1d;\xcb,\x85\x1b\xcb\xdej\x0b\xbaA\xf2E\xf6\xdd8\xc2\xa5\x94:\xeb\x13\x94\xc2L3\x05\x84\xce0\x9ad\x16\xed\xa1\xce\x92\x88\xa8\x9eQn\xb7\xa5\xd5\xbe$\x9b\x0c\"T\xb4\x10f\x941\xc7\xd5\xfe\xf5V\x9b\xd5\xe5\xed\xacc<9\xe9^M\xae[\xd0\xb5VA\xd5\x1dklC\x10\xc9+\xcbW\x90,\xc7\x0c`8\x0e\x0b\xe8\xf0\x8e\xbe;\xddw\x19\xda\xe7}\xe92<{\xee2\xd4\x81 \xb0\x15\x06\xec;n\x7f\xfe\xdfb\x99\x85\x05\xfe\x9c\x9c.\x84\xb5\xdbz\x8f8\xc2\x93T\x09\xfe\xa4_\xe1,\xc0\x9a\x15)\x10\x17\xc3\x85\xac\xcfeB\x10 \xa0\xcf=\xe9\x8dq\x8e-\xea\x05\x17x/\xbe\xfb4\xdb\xd89\x86\x82\xe2w2\x83`-\xb8r\xc6[\xca@c\x07\x0c\\X\xbe\xe1\xeb\x1d?\xc4\x08|\x93\xa9\xc6\xf7\xd2\xd6\xc8\xc15\xa5\xd9\xc90\xb9\xddl;\xa7\xad\xe3\x02#x\xf1\x02q5\xe5R\x0bbW\x01g\xf4\x03T\x8c@\x8c\x85\x19\xb0\xa7F\xeb{.A\xf8\x804\xfa&B\xa9\xa2\x9b^o\xb3\x12E\x8d\x8c5Gq\x8f\x13\x06\x0c\x8d\x0a\xa5\x86X\xc1\xb3\xf5\xd9%S|\xd8\xd1\x15\x16P\xb8{g6\x11\x11\x86\xbd\xd5\xa8\x0b\x08\x7f@\xb6i\x19\xd6\xfbm\xd1%\x12\xb1\xdeJ\x99\xd3\xc3\xf6\xde|\x1d\xab\xb4\x17\xdb\xddY\xa3\xf8_q\x7fv\x1eno\xd9/\xa2L\xa1\x13/l\x08\x96\xee\xce|\xd8#bm\x9d\x19Zw\x99>\xeb\xf0\xc1\xfcP\\\x93\x0a]o\x0ed\xd6\xec2\xcf!\x92\xe2,\xb30\xef\xa4\x98;\xb5\x8c\xdd\x9f\xb1\xf1\xd0y\x18k\x18\xd6y\x11\x86\x98N1h\x94\xa6m7q\xa6" +
+	"Z\xac\x17X\xda@0\xca8\x05OD3T\x181\xc0.\xae\x99\xf6}\xc7\xceU\x8b\xd5\x84,\xc3\x8364\x92|\xbb\x8e\xd9u\xbc\xd7\xd9\xda\xca\xce\xf9\x136N\xb2+1\xbb\x89WN\x18kXQ\xafp\x02o\x95I\x87\xb89\\\x19`\x84\xb8%\xad\x9a&\x85\xf2\xe3\xd5xY\xd5\x1b,:-\x99\x13]\xef)\xcf\xe1\x8b\xa4jZ\xd8\x18\xfac\xfa\xa7\x96\xdfh\x1b/\xf8\xbe\x14\xdc\x84\xd5\x02G\xecJ\xb2\x9e\x0e\x0fG\x11{}sX\xd6\xdcS\x03\xa8\x85z\xd61\xb1\xab,\xeb\xcc\xee\x8di6\xdb\x10d:\xd2G\xb8\x90\xe7\xa0\xfdyk&\xdd\xbdF\xf0o\x80\xa1\xad\xcb\xbc8=7\xcfX\xc5\x9a\x0c\xce8uo\x9b\x1bR\xe4\x10\x82\xb5c\xa8\xa3\x19T\xc7\xd6\x0e\xba\x1c\xe8u\xb29\xef2\xec\xb7F\xb3b\x07\xf2\xfc\xcc\x17N\xa1\xd6\xbc\x11\xd1\x16\xcf\x16\xcbu\x91\x00@\xeem@\x9b\x08I\x0dK\x8e:\xdb#.1k\xdc\xeb\xf8\xec\xd2\xaa\x1f\xda \x1c\xc3\x84\xfd\x93%\x1b\x14\xde\x83\xd1~\xd5\xc6\x90L\x89\xa0\x96k\xfe\xe9x\xbe\xd0\x10X\xb7B\xc7\xd9A.\xb1Be\x8a7\x94\x91\xb7\x96w\xb1 q\xae\x94SY\x81\xa5a5\xe6\xc4^Z\x93\xa8\x89\xf3\x14g\x9d\xeb\xec\xec\xb0\xefL'Vli\xd0\x02V\xc1\x1c\xeb\xaa\x88O\xd9\x0c\xc1'<\xd1\xbb\x86\xe0\xc8Q\xc3T\x89C\xae\xa5\xe6\x05\x9f\xd2\x16\x8e\xd1\xe8A\x1c\xc7&\x8e\xa4\xb7<\x01\xf3\x0eD\xc1Y\xdbC\x8b\x153)\x19+\xe0}\xd82s\xf2\x7f\x07+`\xb4'e\x90\x1e[\xcb\x14\xabv;\"p\xf9\x11\x10\xf6\xe7\x8c\xc8\xe9\xb9QJ\x15\xe4\xa4z\xbb\x89L/\xcd\xae\xed\xb0\xbf\xceNJ\x94K*'\x88\xe1<Qpp\x9c\xe05\x88\x89\xf5\xa7\x90\xcd\xe1\xc8\xb1\xb7UWN\xb9m\xb8\xe8[\x98Z\xcc\x11\x15\x81\xb1\x9be4hw\xcc\xc1^[\xe1\xec4\x13\xbd\xe2\xbb0\xc7\xcd\x95:{\x06pb\xcfN:\xa7\xc3J\xde\xd5\xfc\xb6\xa5\xec\x07;\xe8\xa3\x9e\xc5BUu\xdb\xe2\xd9lX\x9e\x8f\x82\xc4w\x06\xae\x8a\x88\xff\xae\x97:\xfbJ\x06\xd0\x94\x83\xbd\xa8\x1b\xb2\x90\xb58\xe8D\xe2TR:\xa7\xdb\xdf\xd4P^2\x1c}\xca\\\x05Ts\xbf\x18x\xa5\x8bZ\xd2@c:\x82;\xde\xf5\xdc\xb3,3\\\xb2q\x92\x08m\xc8\xe4%N\x95{#\xc8\x83M\x19S\x996\xd7\xbb\xa6m\xb7\x85\x80\xc9\xc0\xc6\xb6\xf8t*\x87\xc3\x14_\xb5\xa5\x89\xd1!m\xa8i\x7f@$\x99\xbfs\xd1\xde\xaa\xf0fpT\x8e\xf9\xf9\xfcS\xea\x8e[?\x9d\xf0\x0f\x1e\xe9\x16d\x87ENu\xbe\x11\xf5T\x97Iq\xfe\"\xc7\xf0=7\x9c\xc5J\x9fD\x9c@l\xb4\xb2\xef\x8bY\xc0e$Z\x8e\xd5Q\xb3>\xfc\xd3[|\xe7\x07%|\x8dq\x92\xb5\xfd(\xd1#\xbb\xc1'\x83\xbdg\xca\xf5\x9d7\x10\xd7Z^\xa3\xbf\"\xed\xfc\xf0k\x1b\xa4.\x00\xd72IG\xb4\x0d\x877H\xc2\xb65\x09\x8d]P\xdc\xf3\xa3\xcc\xfa\xf2I\x12X\x9dLV\xc1\xb5\xe4\xcc\xe7\xc3\xb6\xd7\x83\xd0|\x91kJs\x1a\xa8$\x08GI\xec\xd3H\x8c\xee\xeb\xc2\xd2\xa7]8\x9f\xf3c\xcf9\x07\x92\x8b\x9c\xf7\xa6\xf3\xc2\xd2\x1b9\x96$N\xcd\x89\xef\xe8\x1c\x1f\xe4-\x08\x1b_\xe2\xe4\xdb\x89\xcd\xde\xf0\x9f#}]\x91\xd2\x83\x84s\xc6\x99\xd4'\xf6u~R\xd0\x82\xa5\xces*,\x0eci\xf9\xe6p_\xc9MD8J\xc6Y!\x8a\xe2X\x1d\x1f\x03\xbb0:\xe1\x0b\xaf\xf6L\xe1\x9e\xf3S\xa7(:\x8fU\xf9\xb5h\x076\xdcfE\x92\x13\xee\xd1)\x1c\xe8]\x19\x92\xf33\x17Y\xfe\xbc\x87~\x08\xb7Xr\x85\xf3\x11!3]\x0f<o\xbf%o\xaacK\xb7\xa4<[s\x8eT\xb8\xef\x0d\x03\xae\x05\x94\xf5\xef\xadF\x81\x9f\xce\xea\x8a\xa8\xb9]\x81`\x93\x10\x9d\xea\xbeI\xe4F\xa3Of\xa9\xd2\xeeX\x1b\xbd\x0b\xd8\x99\xed$\x7f\x80\x97\\*\xde\xef),\xc3\xd6l4\x13\xfdz\xe4k`z0|V\x9b\x7f\x95\x8b\x10!\x85\x8bD\x92\xd9\xb9\xa5,\xf3[!\x9f\xf2\xde\x84\xa9\x930xIt/`e\x8cvI \xcb\xb4\x97\x95a!:F\xc9\x8d\xba \x03\x87r_\xec\x83\xe92R\xffl\xcc\x18\x00\xec\xc4\xcb,\x13\xc9\xf2\x0c\x83\x86\x97qj/\xf6\x97\xa2\xcd\xb0\xe9&\xca\x7fq\x98o\xaa)\xdc\xa7`\"\x98$\xfcX\"\xf6le\x06\xe1mG\xbcu\x0f\xd2\x1b\x80)\x91\xa6>\xd3\xc7\x98\xe2\xd0\xbd\xfd\xd6^\x9fa\xf8\xe2\xa8e\xefH\x1b\x19\xc0\xb6V\x92\xe9\xcfO\x89\x19I\xffd\x9b\xc5\x86k0y7\x990\xda\xa3\x8e\x93\xb6I\xa8\xab;\x80e\xc7\x186\xb5r\xbb}^\x09U\x86-\xcan\xc2[\\\xd21\xf4\xb6S\xf0\xfe\xa4@m\xc2\xf1:|6\x0f#\xd9_+\x1e\x9d\xcfI\x00Y\xd1\xff\x11D\xca/0\xcf\xfb\x88Q\x12\xae\x8fC~\xe0k\x84!b\x16\xa4S\xc9\xe2\xc5Y\x8e\xdbG\xc0M\xebvK0\x1b\xb80\x1a\x16w\x0fg\xcb\x8b\xf3\xbd\xf0\x13\xf9\xdcQ\x81(\xb0bnY!\xa1K\xc4\xd9\x0a\xd7\xadcc\x14%\xd1\x95\xd3\x88\x08\xec\xc84\xdepB?p\x02\xa3\xea\xa1;\x1a\x96\x93\x85\xe9\xd6\x18\x95\xc2\xb8\x0d\xfe\x93\xe6\xd0H+L\xeea\xf2@\xbd\xeb\x828\x08w)\xb67\x14\x07\x1cK`b\xaf\x8e8t\xe2]\x02\x1cN\x88(bD\xe1n\xcea\xb2B\xcb\x84\xba\xa2nj.\x98\xa3\x9bcj\xbc\xb3\xf7\xeb\x9b\xd3\x903\xee\xbfvJ\xba\x9a\xe2C.\xe8\xdd:\xe1Y\xe4\\7\xc8Ka\x9b#\xc49&\xcb\xcd\xc7\xccma\xdf#Vxi\xc7sO\xda\xc4G\x13\xb6\x03\x85\xa2D?\xc3.\xe6\x89\xb1\x15\xf1|\x8ezRYT:(\x10K\x82=\x02c\xc4\xc1\x95\x0d\x8do'\x1a\xc9\x08\x80#\xa97:\xa7\xe6T\x90>!\xcb\x16\xd2\xe7\xb81\x8c\xf3\x95\x8c]5\x12i\xb39x3\xf5\x1e\"\xe0\x94j6\xces\xaa;\x9e<[2xh\xd3\x07\x9f`R\x91\xb3\x87p\xf61\x05\xbd\xe2\x85|\xb7\x8b\xaf\xcaIb\xcfi2\xb85\xb0\x93\x1d\xac\xb8\xd8\xdfh\xcc`\xdfc\xcc\xa8A\xaf\xc3R\xc7\x06s\xa0=\xfa\xae\x05\x020\xd9\x1c\xf3\x9c\x95p\xfd\xa6\xef\xb0\xac\xc6\x86Fy\x1b%\x1b\x18\xff\xc3\xf9|\xbfG\xf1\xa18Q\x16\x142K\xd8Ze\xc3\xb5(M\xb8C\\\x199\xb9\x9e\x84\x0e\x0b\x9e'P\xb25Tp\xeb.\x0d\x14\xa1\xe9\x01\xd7\\\x0a\xf9\x083`?\xea\xa9\x83\xd2\x9e\x11.\xedB\xd10S\xa5\xd3k\x0f\x99_\xcc\xcb\xc1O*\xc6\x108\x12&3O\x1c\xad\xf3\x94\x86\xc1\x96\x16\xfb\xa0\xd5\x1c\x86\xf9\x93#\xf5\x86\x90)g\x99\xeb8\xa2&\xf7\xf3\xd1\x1aB\x97z\xffS7%\xbd\x10\xd0\xa2\xd3\xd4\x04E\x9b\x9e\x90}\xacc\x13\x1e\x90\xae\xbdN\xa9\xed8sLy\x9b\xd4_$n\x88o\xab\xf9\x80)\xec\x8a \x88>\xe0\\V\xa6<\xa35\x95p\xc3Y\xcf\xca\xc9C\xc0\xfa2&\xbd\x87\x1b1\xcc\x9e\x16\xdbA\xed\xe3\xd4\x97\x99#7\x91\xa2\x03\xf0\xf3$\xf5'qMr\x16r\xa6\x0a\xb5\xb4\xe1\x16\x15q\xcfMw\xc8\xfeZc#\xd6\xe8cf~\x91',\x9f\x1a\x0ej\xf47y\xc6\xbd\xcd\xe2\xcd[<&\x04\x1d\xc4\xfa\xdd\xe8\x0cb\xde\xa3-\xd1Z\xe6l\x1aq\x99\x06;\x18;\xea\x16\xad\xd0p\xad\xe17\x89f\xbd\xc9\xf6<\xa6\xd1\x16\x111tP\xd5\\6\xf0\xeb\xbdi\x8f\x8cPoF(\xe1*u(\x9e\x95\xe9\xbf+\xe1\x1cy\x84\x90\x1eB7\\\x02b\xc9S\x05{\x94\xbd[]X\x95\xc1$\x11\xdd\xa4p\x7f@\x1e\x85\x83\xb1-5w\x8a\x1fen'\x9f\xb5}\xba\xae\x07\x1dJ\x94\\\x10\xb5\xb20\xb5\x99\xe1\x95\xa23\x01\x0d\x1c\xcb:4b\xd4\xd7\xad0P.w\xe4\xe0\xacv\xf0\xc8\xc5;s\x96\x9d'\xacDPz\x010J\x89\x17\x08K>T7\xf1C\x1ca\x15\x03\x7fpo\xa86\x109CL\xe3\x98\x8a\xca2@u\x09e\xbbK_*\xe3)\x116\xbfm\xebUR\x12\xff\xdd\xa0\xdb~\xa5\x96\x9b,\xc6X0 \xa4\x8dB\x9aO\x9c2\x88c\xa83Z\x0d\x18\xb9f\x1e\x93n\x83C@\x83B\x8c\xbe\xd4\xd5\x9c\xac\xda\xdb\xb9=<v\xfc\xbed\xe2\xbd\xa7\xc3Hb\xeb\xa6\x09|\xc6\x12]\x8b\x09\x07}\xc2*\x13\xb1B\x91U&M\xe5=\xe4\x88\x096\x08#/G\xf7\x8c=w\x01X\x1b\xf9\x00\xac\xa7\xc3\x90\xa3\xe2\xa9\xbb\xf1|:\x0b\x94\xf8d\x87f\xc7an\x91\xaf)\x9e\x93\x87\x17%\xd1a\x8cc\x83\xaf\xc2\x81y\x0fv\xe1Ev\xb8V\xa9\x11\x88!\xe2\xb1&\xf9\x88\x85*\x93\x8d\x84\x16\x1eu\xf0\x99xCd%t?3\xd5X\xa5I s\xef\xd1\xac\x90\xb4\xa2h\xf9g\x90\xd7\xc78\x0d\xdb,\x1e%\x86C]r\x09R\xf5\xd7>\x8a\xc2i;F\xdag\x1c\xadrc1\xddq\x8bJ\x82sE_\xd9DL\xda\x1b\xc7\xaa.B\xb33\xb5|\xc1E\x9bU\xef\x02\x05T\xa1\x11U#CX\x080g\xb8\xdfL\x10\x14n\xf9m\x15\x8c\x93\xf0\xb6p\xc0\xee\xd4\x0af\xdb\x9d\x8b\x7f\xd30\xa6\xb1\xa0\xae7\xfa\xbd(\xc8\xf5Y\xce\xd7Q\xab\xb4\xa5S\xb8\x80\x1d\x0a\xa3RQ\x13\xdb\x88\xc3\x89\xef\xe1\x14Y!]fVV\x88\x8fz\x00\x99\x0d\x90\x16\x0b\xcc\xa9\x9a\xfa\xb4\xa4\x08\x13\x1b\x96\xf6\x8aSkj:;F\xe8 \xd9\xd8\xa4oG(]?aX\xcc\xc4\xd8s\x8a\x87\xefv\xcd\x9d\x86mM\x02X^\xa1\xe9\x1anz\xcd\x1cv\x0d\x15\xf2\xc0\xa4\xadpS\xe3\x1c\x1e\xaa\x8b\xa4-\x1e{$\xbd\x99\xf8\x12\xa4n\xba\x0c\xdb\xfcVm\xb37\xd9U8S\xc4\xe7\x04z`\xb0\xe6\x04\xf2M\x0b\xb6q\x1cT\xb9\xe0\x9dC\xbc\xec\x08\x16;\x1a\x10\xeb\xf6\xd8\xb6\xe9{\xc9\x06\x0c\x1f\xce<L\x14\xfe\x82W\xa6\x8a.26U\xea@ |S\xac\xebA\xf9\x135\x96\x16\xf6\x17\xaf\x05\xa4K\\b\x0a\x0a\xb9`\x17y\xc1\xec\xee\xe2\xe7\xcaM%\xf6\xb3\x02\x9f\xf5\xb5\xb4u\"7\xc5\xdd\"\xbd\xc6}\xba\x17\xd4yZ\xcf*<\xa2\x06\x85\x0b$\x8dP\x0b\x8f]&\xd8\xd2\xd2#\xc7\x08\xaeZ\x99\xd7-k\x94\xf4\x9a]GG\xc5\xda\x16\x13B\x83\xaeM\xce\xcfS\x9a\x11\xe0\xbf\x99e\xadz\xb5&/\x8e\xf3e\x89\xe4\x148}F\xc9\x8aW-\x0e\xba\x11\x8c\xac\xa5\x95\xdd\xc1g}t\xect\xf4W\xea?\xcf\xac\xf7\x81\x9bu\xb8\xc5\x1b;%\x95\x0a\xc0[\x1fq}\xf5\xc8\x9cA{i\xd1\xa5E@\x0b\x12\xcb\x89qQ\x8c\x07\x02Q\x17MN<Fg\x18\xf7\x9b\xc3\x8b\xc0\xfep\x8f$\x07\x8b\xdd\x89 e\x08\x81;P\xad\xa5\x13\xbe\xca\x05\xb2\x0coD\x095\xd7\xe6\xf5C\xf9w\xc5\x8bM\x8c=\xbf\x18\xe4\xc9\xb7YX\xb4~\xe4\xd6|:\xb6!\xdd\x89?f\x80\xfcL\xae\xday\x83O\xb1\xe9\xfc%\x93\xdb\xfe\xe9L5G\\\xb2g\x85\x87\xd0\x97X\xf2\x97\x04<\x0eOHw\xb2E\xa9F\xae\xab\x9b\xb0\xbc\x93!,x\xf6\xc1\xec*\x7f\xb1\xb7\x14\xd0\xcb\xbb\xa7M\x17\xd2_\xb7\xeavl\xd3\xe6\x84\x9b\xee&\xdc\xf3\xda\x09w\xb1&\x1a\x0eF\xe5\x18r\x01\x15\x9c\xa6mX\x11\x9b,BM\x0e\xae\x0e\xda\xc2\x0c\xa6\x1f\x95]\xf2\xd4\xe7\xed\x03b\x93\"\x84\xc2Agy\xf5\xc3:\xccMC\x85\x9d\xee\x83\"J\xd4=G\x0f\x89\xdfO\x18\xae\x0d\xd2,6\x19\x17\xf6\xe7\x90_\x0f\x8f_\xb4_\x014\x95\xdc\xbe\xea\x0a\x9c\xb1\xf6\x0a\xb3\xafs,#\x94-\x7f\x19\xde\"h\x84\x0bWVd\xee4n\xb0\x1b\x98u0\xbcT\xc7'\x15\x06\x1cN\xe5\x84`\xb6Ib\x9a\xea\x017\xf19\xa2s\xc96h\xc0\xd5\x9d\x89\x0e\x8c4j\x91\xfa\x0c\x1e\xa2\xc10\xc5 ;\xff\x05O\xdc\xb1\x10\x12YkL\x8eN\xd6\x16\xa90\x03\xda(\x11\xcf\xbde}S\xfa\x1f\xaa\x0b{\xae\xfa\xf9q^#GQ{\x1dw\xb4\x0f\xb0\x0b\xb0o\x90!G\xb0WB406\xe8\xc09~\xca\xfb\xe1\"\xdc\xf6\xb4\xe1\xa0\x8b>\xafM\x17\x0c\x03\x0a\xa1#\xcb\xe4\x03\xb3\xc5\x16\xce\xe0\x00?\xa0\xb5\xd7\xcfa\xdbMx\xa7X\xd1\x9dr\xa9\xa6\xc9\x19\xd5\x1c\x06\xb9\xc0$\xbdF\xdffaQ\x1b\xbc3AC,\x84\xd8\xa8r\xb5.\x9c\xec%\xca~|T\xda9\xc6\x14\x0c\xf1\x82\xa8Z\xebx\x17\x1e\xda\x0b\x02\xba\x10\x1c\x01&\xa0,2\xd7\xa8\xe0;\x06\xdb\x8c\xe2)\xa7\xf3v*\x9e\xa2\xfe:l\xbe\xd2B\xdd\x166b\xccc*\x84s6\xce<Vc\xb1\x13\xdai\xe6:\x8bm\x03\xf6\x80\xa4\xae\xe87\x0eW\xc3B\xcb\xb0\xaf\xacT\x12K\xf08@^\xce\x83>\x8a3\\\xa7\x95\xa9K\x9a)(\x11\x89\xe2\xd1z\\!G\x8d\xad\x90Yq\x98\xbc\x86\xfe\xbe\x8fM\xf6\x85\xa8\x82H\x0e$\x8e\xec\xaa\x15\x8aI\xdd\xd7K\xd0]4\x05z&\xb9\xe7\xc6\x05\x13s`\x17\xbf\x15\xdb\xe0\x15\xa5\xc1\xe0Z\xd7\x9e\xd8\x04\x97\x1c\x15\xbe\xd7\x18\x81qN\xd8I\xc5~\x06\x1eZ\x1bg\x9dk\xc7\xf0\xb6\xd3\x10$\x9aB9\xc6d\xdd\x849Y\xc5\xb8l\xbc\x91x\xc1A\x98&\xfcbK\x89\xde\xc1\xde|\x95\x15\xc6Rp.\xa3\x88\xea\x17\xf2=-S\xec\x19\x8dy\x9c\x94\x1buO\xa6f\xb3\x8f\x951m\xf1\xeca\xb57p\xbeV\x18\xd4\xe0\xa0\xa7\x0b\xe7\xc1\x97k\xfb\x1e\x97\x14>\x98\x08\xdb\xbd\x098\xa6\xa3\x03,\xe6\x11t\x08\x1a\x8a\x12Y\x97\x1ac\xcc\x8d\x02\x07;\xfa\x91&\x0a-\x14\xe3\xf05\xf2rg\xf1\xdd;\x04\xc6\x85k\xbc\xe8\x83\x917\x80:\x16j\x92 A?\x11%\x88D\xf2\xa4p\xc9\xdc9\x8e\xb8\x8b|\xad\x01\x97[l\x93\xc8#\xdb]H\xd6\xa2\x02\xc9N\xa7\x1a~\xb8\xb6\x0a\xd0T\xa8\xad\x19\x88\xe7\xaa\xa3Y)\xdd\x0b\xf6&\xc3\xdc\x00\xbb\xd9a\xcc\x80`\x0e\xa6\xc5^\x93\xd2\xf9J\x1b\xb9\xe0\x80\xa1\x89=\xe4S\x13\x89\xc8\xc9&4\x8b5\x16\x974x\xcc7J\xf4\x93W\xb3p\xda\x8f\x1a\x18g{\xcc\xd7_\xf8\xe4\xd2\xdf3\xcb1\xd6\xa1\xd5^\xb3\xda\x91\x12\xf4\xf1\xf9\x1b-]N\x12ef?s'\xa3=n\xb2c\x8c\xc3@kn\xcbU\xfd;dnM\xf5;\x0d=D\xaf\xc1\xf7\x84\x10\x14R\x87n0\xc8\x88c\\1^\xb2\x17\x7f\x02\xa1=\xb4\x107\x98\xb65\xc7\xdd\x1cm\xa0\x0fh\x94-\x05\xa0\x82o\x92lQF-.\xc1\x81Y\x89\xbe\x1a\xb5/,L\xfb\xb6\xa2`\x8b\x99\x92\x09\xf8\x8e\"\xd1\x166\x18Y7\x06\xd4G\x86\xae\xb5\x96\xf2\xa7\xf4rlF\xb7\xa4\\\xcc\xea\x90\xb4\xd4\x06:\xfa\xf5@\x15\x14X\xddM\xb4\x09\x94\xa8Pb\x9c5\xb3\xf8S\xc6\x8e\xbd\xcd\xb1\xcf\x11x\xd2\xb7\xfd\x15\x81k*m\x8d\x8d\xdd\xcfPy\x0b\x1b\xa9\xe7\x9b\xd6\x18\xd4\xee\x1d\x88\x90\xdbIoql\x7f\xc2e\\O\xb4\x11\xe7\xf4\x92K\xac\xe2\x07y\x09\xcc\x11\xca\x11\x8eU|\xe1\x89\x0dH\x0e\xe7\xa6\xden\xc2\xfd>TbVKx\x84\x85\x09\xce\xbcV\x0c\xac\xe6\x88.\xd8\xb5\xc8y\xf7\\'\xf5$%!\xb7\xe5\x14\xb4\x84%\xa3\xa4D\xde?T\xce\xc8&\xb1M\x7f,\xf1\xa8\x14\x0e\x89[Z;W6\xf9\x81#\xdeh\xc9\xe2H\x81O\x18>\x0c\x0f\x9c\xe4\xa57\xba\x85C[T\xe9\x88\x0c\x0b.\xe7\x1aT&\xaa\xa3/U>aI\xa6\x85P2\xcam\xad\xdb\x81\x1a\xbd=j\xdc\x9bN\xae +\xab\\\x9bJmC /cK\xfaV):_\xcb\xa6\x99\x95\xae\xa1aK\xa5\xc85\x9c}\x10\xf7\xc9I\xdf\xdf\x87\xfb4\x0d\xb3\xa0\x02^\xcd\x92kNH\xb9\xf6\x98\x83U\xcf\x1b\x152\xa9\xc2\xe9Ya\x0cl\xacx\x81\x99\xcb\x8d\xf8` \xbc\xb9\x16\xa3\xe2l\xac\xd1\x804H\x8a\xe2\x9a\x08K~\xdf\xf4\xcf\xb7\xcck\xf4\xdc\xe19i\xbd\x8c\xe7>\x10E;\xf9\x08\xcf\x00\x09\xb9\xe0d!\xb2l\xe8\xeeu\xb8O=\x8cq\x97\xb7\x9d\x8b?# \xc6\xe9\xf7`\x1c\xcb\x89HQz\x1dZ'W\x99K\xbaT6\xcd4\xebH19\xffu\xf2\xb9\xba\xd2\xd9\xfb\x9cu\x9e\xc7\xad0\xaf\x1c\xbf\x95" +
+	"M\xbb\x13\x87\x84\x933K\xdf\x91\x85\xf0\xc0P\xca%+\x1e\xd8\x9c<z5\xc6\xaeR\xd7\xde\xcfl\x88muN\x07\x0b\xe5\xbcC\xce\xf9\xac\xa2\x81?\xc6\x1c\xb9V\x963\x9f\xacD\xd9a\xd4\xe0}\x09\xbfH=p\x0f\xa9\xebX3\xaf+^\xb9iE\xf3$\x06=WQ\x07\xb8\x1b)\xed\xdbG\xcdL,\xf4\x97\x00Q\x02[\xfb\x8f\x9c\xa3\xf1\xc27U\xcb\x10\xb4)\xa5\xea\xd4\xbc\x14\xa1\x06\x0ds\xb3\xe05\x8b\x0b\x8e\xa6d\x0f\xd4\xad\x94r\xf7\x82\x8fgI\xd7\xb6X\xfeR\x1e\x9c\xd3\x17\xe5\xc8w\x13+\xb0\x09\xd4\x8e\xd4\xd1\x00\xb9\xae\xb0\xe0=\x03\xa6Yz\x98\xf1u\xd3\xa3\xe34|\xc0%\x00\xb0q>\xbd!\x85\xd8\xb5\x80O[\x86\x0a\xc2:d\xcb\xe2\xdc\x9c\x02\xff\xc6\x14\xa1N\xdb\x18\x9a\x1c\x1f\xad\x89D]\x08\xe0\x1b\xda\xd29\xbcfrQb~\xd9\\\x8f-\xc4wcb\xad\xf6\xbe]\xfd\xf4\xa0\x95\xd1\xc6y\xe4\xa2\\NT\xaf\xc2\x0dy&:\x08\xe9l\x81\x81\x9d1\"\x16\x85_f&\xb9\x9e\x09\xbfL\xc0.F82\xa1\x0c\xe4\xb8\x82\x85\xfez@7\x99\x1ap\xbcN\xd6\xf2.\x98)\x8co\x15C\xbc+\xbbr\xea\x08\x1d\xfd\xb3\x14\xdc6G\x95\xa9\x8c!\xcd\xc5\xa3\xf8\xd9\x0f\xb6\xf4\xd5n#s\x082\xf5\x05\xaab\x85\x80\xf4\xce!s\xca\xf0\xfdR^\xf536\xc1S\x1f\xf0[\xc5\x89\xdbM\xa6)\xb9e\xfcp\x9d\x94\xb3\x81\x8f\xc0\xa0\xf3\xb3I\xb1\x05\xab\xf9\x9c6\xb4\xd4\x13\"\x03\xab\"\x8c#\x06\xe4X\xea\x13\x8cy\x14[\xda*\x85}\x8f,\xdf\x10\xdf\x84\xae\xa4v-\xb5\xdeoL\x8a\xfci#\x07\xa2\x00+`@r\xe3\xe4\xf8\x0e\xf0\xd8\xc7|\xadl\xbbc\xfc'm\xa6q\"\x07\xea\x0a\xa2y\xda[\x03d\xf0N\x9e\x07N&\x88X\x8f\xdd\x174j\xe6\x8e%\xb4UWj\x0f\xd2\xed\xe11<\xd2O\xe1\x9f\xb9%7\xea\xc3\x8f*\x1f\x14WZW\x88\xf0x~cj\xb7\xb8\x9f\xe1G\x18\x8e\xa7\x8a\x00\xbc\x0e.:\x12\xa2eQ\x12l\x04\x7f\xda@\xfal\xd2\xa1\x8f\xbf\xab\x1e\xf6\xf4\xbc\x8d\xe1}\x80<\x1d\xcd\"\xd1\xc8\x19\x03u\xf7:,ze\xa3\x18\x83EE\x9c\"<\x0a'@M\x9c*<\x13q\x1f\x10\xfc\x0b\\\x0a\xca\x13\xeb\xf2\xa5o\xd2\x16f.\x95\xdaX\xbe\xd4\xf6\xc0\x98%g\xc26,\x08\x0c4\xe0G~\x88\x13w\xb1F\x0eh{\xec\xe8\xae\x8c\x80J\xb8\xc7\x0d\xd7\xa2\xc5\xe71\x9e\x15-\x14~\x1d\xe4\xdck\xf9\x02\x13&\xf4\xcf\xf8\x1bSE(\x98G\x9c\x90~n&\xfal\xe8\xc2Q\"n~\xc1g\xca\x17\xc7\xa2\xce\x0e\x9e\x99\x93O\xfd\x03Q\xa5{\xac\xbe\x93\xe3\xd4\xc8\xbd\xee\x82B\x9f\x1dz\xda'\xcc\x06ND\x1dv\x10\xc7D6\x8e\x81\xf8\x8e\xbb\x1c\x15\x0a\xc2u\xd3\x10\xd3\x03\x05Qn\x9d\xee\x1c\xc9^P\xf6\xa4V\x1a\xee\xf0\x80\xf6^O\xa5\xe6\xd9q\xe1\xb9\x96\x18\x88i]\xe28\xd6\xc4\xd8\xde\x13>\xad5\xe5|\x91\x93\x19Q\xc6\x81\xd4\x1d\xb7ZY\xa6\xd4/D?s\x916h\xcc\xb4\xddh(e\x19\xe6\xb1\x19\xc0\xecL\x83\xa3p\x85\x1d\x19\x06\xc3\xd4\xe4v\xaaV\x89?\x88oI\xb8\x98\xcbP\xd4sz\x82h\x8fF8`\xba\xcf:\x1fk\x95\x901&S#\x02K\x9dlR\x92$\x99\x83)\xd1\x1b\x12p/S\xf1\xdc\xd2\xb6\xbb\x10\x1c?\xf1\x88\xd0$\xbd\x11\xa9_x\xd2\x05\x8b\xe0\x99(v\"\xb0\x87\x98\x87\x09\xe7\xf4G\xc29\x14\xc3\x90\xcd\xb00\\\x19\xfe\xb5\xc8\xaa\x1e\xa7\xc53\x12\xad\xfa}\xde\xa3\xb3\x1e\xc1\xd5<\xee\xb5\x16\xb3D\x02URUx\xb8O\xe8Xl\xd0:\xdb\x1aE\xda\x0f\\\xa3*\x10\x9b\x9cZ\xc82*\x11Vg\"\xbb5$\xe0\xd2o\xa9\x16\xe0:\xac]\x056\xf9]R\x0a\xb0\x89S\x1d\x00\x08\x0b\\2+\xc2\xa2\xbd|\xeb$^\x80\xff\xdcS\xf8dh(\x9d\x9c8\x02m\xb8\x82\x8c\xc5\x1e\x19\x90\x1c\xec=:1\x04O\x96\xa8\xed\xbb\xf4\x19\xcf\xac\x06\xe3\xbe\x02\xeep\xb9\xe4U\xd2\xe0\xa0\xe9\x8bFXX\xf1?`[\x18A h\x81v\xdcZ\xf8\xbeL2\x9a\xa7\xa8j]8r'\x11\xf9~\x1b\xa7\x0a\x0a\x9c\x1a\xee\xcbn\x04\xce\x0e\x9c\xa0\xa1`\x92\xf6\xbc\x09+\x15\xf0\xd7\x07`\x17\x09\x10\xea=1\xb6 \x03m\xfb\x97\x80\x91@(\xf3k\xc74Q1\xcd\xcc\xba\xbbVVy\x98\xc2\xdc^k\xd8\xf1c\xc3;\xee{\x8c\xb6\x18\xf1\xad;\x9a3\xe5{\x1fd$v\x04k~\x82\x85V\x93:6\xa9\x9dR\xed\xa0\x94'\x9aT\x82\xa4\x0c\xcbO9\xcc\x08\xe2D\xc5\xd4\xc4\xd1\x10\xa7\x82x\x09\xc1\x04b\xd2K\x10\xda\x0bf\xefj\xb8r\x13n\xc3\x15\xa8\xf0\x11\xfe\x0f\x15\xb5\xa6\x11\xab\xc2\xaa\xb3aG\x9d\x98\x9a\x1d:\xab\\\x94\x07\x0f\x84\xa0\x0eS8\xa9\xa5\x05\x7f\xe2\x8a\x01xoC6H\x91mp\x17I\xc3\xb9=\xa7\xc5d%*\xf5&\xa5\xa42\x03\xb4RILH\x84\xa4\xc7\x87\xa7\xbe\xcb\xd8\x00.\xd8\xbe\xeeB1\x7f\x0b\x96/\xee\xf5F\xa8F\x96\x06\xbe\xf3u\xac\xd8\x1e\"\x0bsB5\xb0,\xccT|\xf9\x137N\x84\xe7h\x0a\x0aC\x964\\\x83O\x9c\xc2\x15\x0a/\x1b\xe6\xec\xea\xf02\xbe\xb3<1\x8c\xd8N\xb6|pad2h\xfb\xaf\x9c\xb3\xcf[94.T\xc0\xa3\xba\xaf\xb9\x00\x16!\xc0]\xdd\xdf\xb2\xb7Ar\xae(\x06\x0a\x10{\x0at&\xb3\x1dd\xef\xe0S\x8a\x97\xaa\xd9s\x03\xcd\xe9\x8a\xfd\x1c\xbcx\xe1^\xbabI\xbaw@\xfa6\xb6\x1b\xbaF,\xe0k)\xc7K\x11v\x9bR\xa7\x10:\x11\xd0o\xfbX/\x0e^\x0c\xfb\x80vL\xd0@Eg\x15\x0d$\xf3U\x16\x1b\xa7\xda<x\x16\xd8\x82\xb5\xaa\xb7\xbf\x0f^\xf8p\xe0\x1d1\x18\xd0`V<\xfb\\p\x1f\x94\xd9\xafuD\xe3E\xef\x13\x0e[\x02\x8c\x9c\x01\x93\x80\xb7\x90\xcd\xb8\xba\xd5\x9aA\xbb7i\xa7\xc6\x80c\xdb\xdc\x9bs\x08\x87\xc6\xb1\x06\x92.\x9c|\x06\x8c\xd8Hd\x10=\x9c\xb7-\xf9\x88X\x7f\xbe\x10\xdc\xc1\x8b\xa3\xf6[\xa6\x0c\xd1[I\x8f\x867q*\xf3G\x97\xcf\x84\x89\x82\xd3:]\xd1g\xfb\xea\xbe\x1c\xbc8n\xb3\xcb\xd3\x1b\x0d\x08.\x08{\xc1\xb8%\xe4\xd5 \x04\xaf6\x07\x0f\xd8`k\x9daLYD\xc5\x90\xe9J6\xe1\xdf\xe0\xee\xa6\x00\xd8\xacb\xa2?\xfaz\xf0\xe2\xa4s\x09\x18\xd7cl\xf7<SM\x80\x0b\x9d\x0eH\x82\xb0P\x96\xf7\x967\xcb\x9a3'\x1b\xc6ka;\xfe\xea/\x7f\xfc\xe3\x0f/\xd3?\xd2\x7f\x85\xa0\xf4\x9b\xc1\xf7/\x7f\xf7\x8a b\xb8\x81.\xe2\xe4\x9b\xd6\xf6\xb4\xd3\xe3\x92\xdf\xf2I\xe5?\xd3\xba}\x039\x8b#\xbb\xf0\xd5\x1f\xe0\xda&\xfc\xfc\x81\x84\xe1D\xc1\x1f.\xa0\x93 \xfb\xa1\x81\x07\xf5P\x0e\xff\xea\xdb\x1f\x7f\xf7\xedw\xdf\xbd\xfc\xed_\x7f\x03\x0a\x07\xb9\xa2\x1e\x81\x16\xf5\x8f\x8e\x0d\xcd\x8e\xa5&\xb2\x0d\x19\xbe\x1b`\\\x1c<UM\xa3o\xd0st\x1cq\xbc\x96\xc2*Nb\xe3!gVC\xff\x1dO\x05<\xb5\xc0D\xe6`\xe5\xd6\xd4=q\xd4\x83\xb0#e\xb5\xf3\"^\x10,\xa7J\xdf\x86\xb3K\x18j\xf2\x98\x19>\x0a!\x1c\xd8\xa1\x9b\xea\xa3\"9\x9c\xcd\xf6\x1d)\x13\x93qt\x8f$\xeba\xe5\x96\xca\x8cQ:\x90Z\xc4\x85\x0d\xed\x19\x90CR\x1a8\xdd;\xc6\x8a\xd4\x05\xfe\xa9<\x1bD\x05V\xcbV\xb9\x0e\x82V/2\xddS\x99c\x03R1ZwF1N\xcc\x8a\xb2\xf2.c\x07\x18\xbf\xe0`\x0c9\x81K\x0e\xbb\xcdi\xc6\xc1z\\p\xa2\xd1\x98-\xa0d\xa7\xdeJ\xaf\x19\x98'\xea)\x1a\xe8)\x9f/!>\xf4\x9a\xf0\x96x(c\xd4m\xc0\xba\xdfFvl\xa5\x1b,\x04\xa5\x08\xcdCk\x19O\xf1:\x8e\xc3\x15n\xa1\xb5\x12\xe2\xc0\x96\xb6V\x88\xd1\x84\x91h\x80\x14\xbc\xb0=\x93\xa1\xf9`@\x9e\xc2\xd6\x0b\xc4\xec\xa0\x18T;p\x92?\x80\xc4\xa6\x9f\xb4z\x99*x9`\x81\xb5\x18\x96K\xbe\x83\x12S7\x0a\x84A$C*\xf5\x08QP\x1aj;4\xb2H{z\"\x9c\x1fx\xa2W<(K\xd8\x82Gv\xee\xc1\xaa|\x0b=\x0a\x01\x80{\xa4\xfb(\x92\xbd\xe5D9R\x07\x99g\xc4A\xda\x11s\xf9\x13\xb0\xbe+\x8c\x0e,\xe3L\xf7+\x0b\x94\xc3\xa8\xd2#\xd5p\xca\xea\xc8\xc2Q\x99\xde\xef\x16\x10e\xef\x06\xc02\xde\xc5\xa8\xe5\xba\x90%Iu\x8aB\x12\x00je\xd2\x13\x05uDpbt]r\xc9%G\xffJ\xff\x99$\xdb2\xce\xc8\xb3\xc0\x17\x85xe\x9c\x85\xa5\xd1\x8e&\x06\x85\xccV\x06\xf7)\x0dgxjQ\x7f\xca\xdc\xbeF\x9c\xd7RR\x0b)8\x1b\x16\xe5\xf7>\xc8\xde\x1b\xb1\x9c\xbcM\xf9\x05\xaf3@\xa6\x0c+5\x8d\xf0\xa9yR\xc2\xc2l7B\xc3\xc5\xa5\xc6J\xd3\xd9f\xc8\xa6\xa3q\x8b\x02\xc3\x84\x11\xe5\xb8v\xad\xd4\x08\x98\x9f\xbc\xc08\x98\xb9H0ac0\xb4\xe0\xaar\xb5\xbdt@\xab\xf0\x10\xc2N\x17=6\x80f\xd0\xf0\xca\x8b\xae\xebF\xd9\x8c$p\x99\xc3\x0b\x15\xab\xe3|\xe3\xb0\xa7\x1f\x08|\xc9\xe6-\x1a?f\x172\xf2I\xa2\xb8]D\x19\x9b#\"\x83m\x17\xc6O<\x8c\x0bT\x02\xeb\x1a\xb8w)\x8aL\xb1E40jE\xff\x11\x80',\xbb\xdat\x92\x03\x9f\x1b\xc9\xde\xc1?\xab\xf06<\x0c\xe0\x0ek\xddHb\x0520k|\xf0\x04\xd2\x10L\xa7O_\xa2T\x85\xa1M}\x8b\xb5S\x90\xb8X\x12~\xd3UZ\xe9P\xc6*\xcc)\x8d\xad\xe9\xcc\x1a+\xf5\x14\xe9\xd2\x94\xdf\xe8\xd4\xc7&Qmb%]b\xab\xd3EQw\xc5\xafL\xdb\xde\x9a\x80\xeb\xb8\xbf\xf3_\xed\x9f\xc2W\xafy\x9fX\xa6\xff-\x98\x0e\xb8s\x09\xb7\x01#\xbf\xf2ms\x0f\x97{x\xd6\x9e\xa0u\x98\xdb\xdbP\x80\x8f\x93i{\xb1\xf2z\x02\x0c<\xcf\x88r\x9bv\x00\xa5\x1c\xd6-\x1a.P\"&\xb0\xccg\xfc\x03\xa3!\xa1\x0d\xa5\xbb\xa3&CM\xaa\xdb\xa8\xa1G\x1c\x02\x9f0\xdc\xcf\xdb2L\x1bB*\x9b\x80-\x9d\xd6\x94;hu@\xd8\xb6\x06\xa1\xe8\xbb=k9\xee\xf6P\xd4\x854\xc0\xb4\xa9z\xc5\xa4\xe8\xdf\xc7\x0f}0lK\xa5\x18\xa4\xe6\xd8\xb4\xcd\xc2\x8e\xf0\x96S\x13\xcd\xde\x83\x8b\xfa +\x93|\xcf\x18\xb8{\x12!j\x9d\xf2\xe8-\xd0\xae\xa2\xca\xe5\xdc I;u#\xe2\x0e\x0es\xf8\x8b\xe6\x86\x84e\x80A\x8b\x84]\xe0\\\xfdV\x97o=\xf0\x9fML\x9fs\x0fT\x18L\xebj\xc8\xd1\xb3\x06\x7f\xd1\x0eL\xf3\x88\xdb\x8a+r\xf1\xee!\x83\xc1\x84\xab\xa4,\x1e\x1b%^\\pnN\x10b\xf2\xfe\xe9\xca\x9a5\xec\x8c\xde\xd8\xcfX\x020\xe12$\x82\xe5q\xdd0l\xc7\xef\xbbcW9@\x8a\x09Ua\xd9^I\x0a\x13\xea\xe3277\x03\xf5S\x19\xc1\"<\x86\xab\xfew\xd9\xf7\xd4=8\xe9\x15\x16\x9cg\xfa<(\xae\xe5\x08\x9c\xda*\x82\xa0=\xad\xee\x0a\x91\x1f<\x11\x93\x9eM\x96\xa6\xd2\xa4\x9a\x87\xab\xa6\xfaw\xb2\x151\xe2<\x13\x1f4\xb35M\xad\x89\x0bt\xf5)t\x1f\x9c>g\xe2\xf1\xb8\xdc\xc9\x1e`\xb7\x1e\x17z\xa9\xfdes\x0e\x1c\x84\x95\xbe\x9a\xecA}\xa9\xe1\x03O\x83\xe0G\x97\xdck\xfc\xc4\x9e@\xb0]\x89\x8e,O\xablk\xe6\xcaB\x01\xadv\xf3\xfa\x1c\x9c?\xa7\x9f\xf8di\xc7\x9e\x1a\xa5\x90\xa2\xa6\xada\xe7dB\x02\xb4\xa4\x8c#\xb0q\xf51\x9e\xb3`,\xd5\x84\xf8:\x0f\x0c\xf2\x1a!>\x09\xe6y\xe7+\x1d\xbeh\xb9\xe5,\x91 <a-\xf3.N\xd8\xc3@Rj0\xb0\xb4\xc2_>\x11=\xb81\x17\xde\xd1\x1c4\x7f\xe6\x93\x87\xb9)\x84{\xaa\xeaM\xfb\x1e\x0e[\x91a\xe8\xf2\x09\xf3\x9ae\xd1\xaciV\xcb\x016\x849\xaa\x9c\x02\x03\xd8\xb9,s\x02\xd0B\x8er\x1b>]U`\xd9\x80\xeb\xce\x0d\xc7\xf9\xd7\xd5\xec\x83b=\x8b\x9b'\x9ad\x0c\x0b}\x9c\x02\x02 z\xa0\x9e/r:\x93p\x9d#\x8e\xbd\x05#i-\xf0YW\xae4\x87\xdb\x19\xb9\xc9}dW\x0f\x0f;\xb4\x02\x17*\x10\xdb\x98\x9a\x1a^\xe8z\x94v\xfd\xaer\xbf\xc2\xff4\x14\xca\xe8\x96\xe3<\xcc\xd5s4y\xca\x06\x80\xdc\xd5\xcc7\xbe\xbd\x17s\xc3I\x07\x91m\xea\xe8\xb0\xa6:\xc0\x1c3\xc7c\x8cD\xb5\x02\x03\xe1\xcf~\xc9\x04F*[\x91\xfd\x99\xdc\x9d\x86\x81\xd1w!qZ\xe6T\xa1\x18_T\x1f\x89\x91\x0e,b\xc7\x02\xbe\xe8O*\x05\x05\xd7\x04\xd13d\x9b\xe6m\xa0\xdc\xb8\xce\xcc\x83\xdd=L\xec\xd6n\xdb3VV\x7f$C\xbcK[o\x146\xf6\x1e\xb8\x05_s\xeb\xea\xac\xce\xe7\x16\x18\x02\xaf\xf0\x0a\xd88\x89\xdf9+~\xd7\x06r\xc0C\xbc\xbc\xa6\xd7\x8e1\xac\x8f\x13\xaa.~\x97\x19\xa8w0\x0a\xd7\xf91\x8d;\xf9\x8dr*\xf0u\xb0\x0a?\xa8\x9c\x09\xfe\x0d\xab\x07\x83F\xe8\x84r\xa5t\x1c\xf1m\x84\x13\x8b\xdb\xfe\x19h\xde\xc3\x1c\x85\xd5\xd1GXz\xa6\xfe\x959\x93f\xa2\x02N\xfb+\xd5\xfa\\\x920\xba\xfaZ#\xd6\x7f\xfa\x10V\x03pAW{\xec\x9e\xe7\x9e\xbe\xd6x\xd2\xac&h\xfd\x14{\xf6\x0bX\x91\xbc\x0az-\xda\xb3|\x9c\xc6\xfa\x8e\x99[\xbcd\x13Z \xb3\x90\xf9\xecp\xff\x8f^\xb4p\x00\xea\x1a\x8c\xfa,\xef\x0am\x18\x96\xf8\xdfj\xcd1B!bm?\x9dv\xb7`\xb8\xd3~\xfa\x04\xffX\x891)\x0c\x13\x12]\x82s\x19R\xe6\xb9\x93\x11k\x9c#\x9a\x8e]B\xe3\xe0O\xac+\xad\xf4\x83\xb4\xb9\xa6Wjh\x0d\xaau/k\xdd\x83k,\xcf\xbd\x8f\xc8,\x06\xed\xd2\xec\xd7\x03\xd2C\x9df\x95-bf\xd9OaKf\x1a@8~\x94f\xd8)\x0aY\xda\x8d\x8bp\xdf\xd5\xd1>\x96\x1a\xe6\xae\xe1$0\xdaf\xe7\x99\x95/Amq\xe9\xb9\xb4\xf6\xe1`\x06\xf2\x95\x89\xfa>\xec\x80\xae\x98l\x12\x9e\x09\x07\xc2\x1d,\x0e\xe2\x8eT\x8a\x7f\xdc9\xc2\xbd\x9eD\xb0\xda.\xc2\x07\x07\xcc\x99#\x99\xf6@\x0bJFi\xf52x\x8e9\xc2a\xc6\xd0\xbfq\xd7\x16Y\x9d\x9a\xfc\xcc\xd7\x0a\xcdn]\xe2\x17\x17Z'\xbb\xa2NG\x87\xae\xa31\x9d\xc3E\xbd\xd6s\x1f\x10\x99f\x15\xc7NNU|\xf6uX\xd0\xefluB\xea\xb3\xf7\xc4\xab\x85\x99\x89t\x97\xa4\x85\xe3\xbb\x12\xbb\xa8\x14\x86\xdb`\x14\x81\xb0+[\xa5\x1f6\xf9)<\xea\x8a\x13\xe9\xa8\x9d/\xc37\x14\xb0\xdcex\xd0)\xdc\x98f\x11\x16\x8c\xf9f\xcd+7\xf0\xe7$\x8e[\xd7\xf2\xe9\xfb\xbc)P\xdb\xe4\xc0\x04}~\xa4=\xe3\xbe\xe9z\xbd/\x8c;K\xec6,L\xd6!\xf7\xd5\x8d\xe8\xa9\x0e\xac\xf1\x8a{yM\x8el\x08\xe09\x916\x9a'\x1a\xcd\x80\x99\xa4x.J\xf3\xacE\xf2\xb6Ki\xc0\x87\x0e\x01X\xd3\xad_m\x19\x0dw\x84\xe92I\x8f\x01\xecf\x0b%\xcbyb\x9d\x89ux\xab0j\x16\xafi\x19\xccm\x0b\xad\xee=)c\x05\x91\xf8\xa7\xd2K\x9ce\xb3>e\xed\x14\xc7\x9a#\xb32\xcaE\xa1\xbc\x9dp\x8d\xe2\xc4d8\x17qB\xfb\xbe\x86\xf6\xf9\x9d\x98\x9a\x06\x1c%\xf5\xcb\x15\x18\xbdF1\x00>H\xde\"W\xc2 N\xe38<\xea\xb9\x03\x85\x8bX\xc5k\x89\x9f\x1b\x94\x85$6\xd2\xa9\xaa\xa1\xa3x2l\x8e&\xb2\xb2v\xbai\xa8'5*w\xef\x9e\xe1\xac\xa3\xf3l\xb7d\xf4\x11\x11\x98\xa4\x07\x88kbf\x8a\xcb\xd2\xb1Y\x07\xe1\xacOamY\xa9\xd1\x94\x12\xd7.N\x0d\x12\xa4\xadA<z~r6#X\xac\xb1\x9f^\x0b\xd5\x05J\xa2\xbc\xee\xc9$\xfa\xf4u\xc3\x89B\x92\xf0\x83MU*n)9\xc5\x1f\x18\xef\xc8f\xd5 \xdb\xd4\xc4\x8bRs\x87\xad\x94\xa2\x18\xc4Hx\x98\xeb\xde\xb4\xdcg`\xd8M%\xceDK\x8ez\x98M\xb8/=N\xe3\x09\xe3p\x1f\xe6a\xd9f\xcdv\xe3\xaaU\xb6t\xf2iW\x19\xb1\x1a3`X#?\xf3\x02\xddN\x91\xd2\x8f)\xcc\xa6f\xef\x09z\\N\xc1\xba.\xa9\x06$A\x89@\x0fJ\xd5`\xa9\xf2\x1c\xff\xc9\x85)\x86\xef]\xf5\xc5?)\xff-\x15c90o6\x06\xfb\x18!\xc7G_\xda\xed\x85\xd26\x83" +
+	"9\x1a1Y_\xb7\x0a\xa8U;(<\x1c\xd6\xdf\xfeT\xac\xcaR\xf8\x9c\xfcKk\xb9e\xea\xa3}\xd8Ne\xdfs8\xc5\xf4\xba\xc9\x8c\xd5$>\xf9\xefq\x0a\x16J-:\x9c\x0a\x9f\xc5w\xad\x08\xa1\xe5Z\xd0]\xb2\x7fl\xe5\x13/y\xbbOe,\xa3\x1647\x05\x82\x00\x8a\x90\xb8p\xee\x07\xae\x8a\x0fI\xd4\xe9\x99{\xca\xce\x1c\x9f\xb5\xa0\xd2\x92&\x98:q&\x08\x88.\xd0\\f2\xac\x07\xc9\xb4\x18b\x00\xa9\xb3\xc8MjL\xf8\x84\xa5F\x00\xdevf!\x1d\xf3\x98\xfct\x93ga\xec\xa6\xb1!\x1a\x85\x05\x19\xbc6\x9a\xc5%\xa7%\x80\x9aSh#\xdfg\xe1B\x08\x94\xe7zb\xc3\x9b{\x85\xd1\x81\x08mJ\xa1W)\xd6\x8a\x93\x81Q\xd7#(\x11\xde\xdc\x9d\xa2V\xd9\x08Y6t\x95Zl\xb6\xd0jPX.s28`g\x93\xf5+\x8eO\x89\x8d\xc5\xfetlJ\x16S\x83\xdc\xf2J\x84gr#\xfc\xfc\xbe\xeao\xcf\xf7\xc5e\xa5\xb7\xdc\x9b\x13\xf2F\xc0^\x86o\x06\"\xe0\xd0yL\xe3H\x9a\x88\xb3\xec\x88\x8b8\xe9\xd9\x15O\x0e\xb2\xb60\xd6N#\x1c\x90\x1b\xc0R\x88\xb1\x98\x038\xc5\xc6\xda\x80\xac\xf8\x8d\xd2`Y\x12l\xb9GZ\xfd\xe40\x13\x02\x1cq.\xd3\xdb6\xac\x03\x9e\x86\x8e\xd9\xdaZ\x89<\x13w\x13\xdd\xc7QX\x95\xef\xd8v\x0b\xed\xe3\xe5\xdc\xdc\xd3\x08:\xe9\xc9\x103Ma\xbb\x17i\x950\x0d\xe1\xb8\x8c&-=\xeex\x9f\xc7A\xa7d7ssI\x808\x97\\-<5f\xf0\x96C\xab\x03qp\xea85\x91Z\xeahHQP\xc8\xa8t#\xba\xe6\xca\xff\x92F\xc7\xd2\x0d\xb47\xaf\xa5a\x12\xb7\xd4fJ8P\xea\x1c\x7fp\xbd\xc1C`d\x0du\x85\x8c\xd4\x8c)\x98\x93Q\x0d\x1fc\xbaX\xe3\x8b\x9d\xaf\xc4=\x93\xbf\xdd>\xfdB\x13\xae\xd0+\xf0\xab\xb0L\x16\xb4\x87:=\xdb\xf74,\\&%\xa1\x93\xc4\x97\x89\xe32\x80\x08\xf4kc\x9e]\x87\xbbr\xde|-\x05g\\\x8b\xe4\xa3gv'VL\xaf\xd0&\xb9X\xde\x1ek\xcc\x82u\xe9\xf8\x93Z\xb4\xca[\x88\xe9\xd0\x1b`DSB*\x9e\x96\xb92\x1eCw\x16\xd6\x12fY\xde\xc5,0\x1bn\xe3\x04^m!\x9c\x92\x03\x87\x9d\xe8\x02\xbf\xee\xc1<v\xfab\xe7Z\xe7t@\xa5\xe4\x12\xd6\x87\xa9T\x1f\x91\x86V\xc2\xfd\x93vP\xce\"V\x15W\x834\xc5\xfe\xb3\xca\xf4\xa8\x0by\xf6$\xc1\xf7y\xe1a\xef)\xc5\x06\x17P\xbe\x13\xd6\xecN\xcdh\xc7\x8d\xc0tE\x90~\xd5\xd57\xcb\x1a%!\xc0\xee\xc9w\xdaM\x13\xaf\xd10\xd2\x8c\xbdQ=\xae\x1b4\x97u7s9\xb5\x8cQ\xa6\xc3\x02\xa9\xfd\xc3j~\x14U8z^\x14NPI\x86\x09s\xa0\xf4y\xebf\xa6\xe5\xe4\xc6\xb9\x17\xa8\x0c\xaa\xbf\xa2\xechX(\x07\x96\xb1\x9fd\xbb\xc8\xbb\xc0(\xf7P\x8b3}J\xca\xf5\x8cl\x05r\x0bI\x9e\xb9\x8e\xae\x1cHOlC\x05\xd7\x0euY\xbd\xcb\xbe\xa4\xd0\xe9a\x07\xdb\x89=\x97\xfa:\x99\x9b\xbc\xa2w\xaa!\xfc\x8a\xd3O\x12^mu\xd6\x11\xe0\x14\xee\x07q\x1c\x96]M;*6m\xef)\xf8U\xa6\xd8>\xf5:\xcf,\xc9\xf1\x94\\\xc5\xf7\xb2j\xa9\xfd\xefh`\xef\xa6\xd1\x95)\x1f\xe3@\x15w\xff-1\x08\xe5\xa3\x0a\xf9]\xcd\xf0\xeaq\xd3\xb5\x9d/m2\xca\x1b\xe2\xe5\x0a\xbcsW\x81\x87\x9c\xeaW\xdd\xf5w(4\x1c~\xb6\x88\x91b\xf1\xdb\xc4\x07\xdc\xd4m\xb7\xe7\x01\x12.c\xbe\xaf\xaf\xc0\x0d\xf3\xfa\x0a\xef\xcd\xc8.Q\xe4\x0c9\xa8\xdfc)e!\x95\x09\xcb\xfa\x13\xf3\xe6l\xad\xd6\x85\xd6\x82\x9a\xbf\xabp\xa7W\xf4#\x92\x9d\x0f\xc1E\xf4{u\x0f\x87\x99M\xd6\xf16\xdc\xd0\xeba\xe9e\x0eS\xb4\xe1\xe2V/\x96\x0b\x0b@\x19\x0c\xb7\xbc\x1e\xa03\xed#+v\x06\x9f\xdd\x7fK\x0d\x0a\x95w\xac\x9d\xe8!K\xdb0\x09\x8b\xe7\xcd \xae\x8d\xd6`\xd0#m\xf5\x1e\x8c\x8d\x14\xd9,\x11\xad\xf4\xcdh\x12\x03[\x0a\xb3\xe8\xf1\xfb\xbbh\x8a0.2\x99\xb9\xad\x80\xcc\xc0\xb1\xbcT\xfb\xa4\x9c\x8f\xdb\x02\xc3J\xebG\x13\x1d\xa7\xb5D\x96+f\xa6[Yd\x98\xeb\x0b\"\xba\xaa\x81Z[y4@d\xeeA\xffX\x84[\x16\x05\x88\x95\xd0cf\xc1\x16\x89\x9e=\x86{3\xa9D\xca\xa5\x87\x04\x14\xc2'\xa6\xf0G\xe4%F\xca\x04N\xc7A\xa1\x0b\xf7\xb1\xd6N\xf2\xf0\x06\x0ca\xd7\x14\xd1\x02\xb75\xc1\xe2X\xd3H\xbc\x0d\xc0\xfc\x0dX\xae\xce4\xe6\x1a'\xc8\x12J\xf1a\x87\x98s\xb1D[\x95\xcc\xb9\x8b\xbd\xb8-\xf5\x90P\x16\xdb\xbaCY\xeb\xad\xcc\xaf\x8fW\xe1'~\x8f\xe9Y@\xf6\x16eD\xdb\xc8\x84\xd8\xcc\xe0Z:\x94\x0dM\xea\xcaN\x96\xcf[\x03gy\x1f\x88\xe1\xfd\xb1\xb4L\xb9[\xd7`_\xf3\xa9`\xc9\xac8\xd3\xf9@\xe4s\x0c\xae\xd7\x02\xc64\xb1\x16\x9a\xd9Y\xc1\xc8\xben\xd5A;\x14\x1e\xab\xf5\xed\xaa\xb3\\\x84\xf7\x98\xac\xeb\xc8\x9a\xa4\xa9D\x80S\xcd\xc1\x8f\xca\xf06\x09\xb7!\xeb:W\x0b>\x87\xa0O\x1d8\xdfo\x92\xc6\xabX\xac\xa2\xd8yu\x87\xed\xb5\xed\xd3\xa8p\xb2\xe9_e\xbfP\x96\x8c\xa2\xf9^w0\xb3N\xac\xbao\x0f\xc5X+4I\x82\xd3\xec\xf2VJ\xd0aLAs\x1e\xc4\x1aI\xb9\x8d\x95r\xaf^\xc2H\xe1hp\xc7\xf0\x16:\x17\xad\x0b\x04u\xbb\xf0\xb8>S\xb6a\x9b=`\x08\xda\xae\xaa\xc59{\x02\xf2t\xcb|\xdf\xf3\x8ep \xf0\x04\xbe\x84\x80\xc9\x82-\x13\x03\xee\xa2\xcc\xd3}\xf8\xff1\xf7g\xd9m+Y\xd408\x15\x0e\xc1\xea\xa5\xe1T=\xd5\xbf\xd6_O5\x02\x8a\x1d\x8cY\xdc\xcb\x94I\xd3\x92(\xaa\xb1L\x8f\x04\x98M\xad8\xfb\xb4\x01\x04@\xdf\xbcY_=d^\x8b\x04\xd1\x04\"N\x9cf\x9f\xbd\xff\x92\xbdv\xddV\xb2]|\x07K\xc83\xc8\x89Z\xd3\xab\x91D\x0f\xf5;\x83s\x98\x8f\x1afN\x18h\x81\xcc\xd0~\xfd\xc0^Z\xf8\xbe\xb8J\xd9\xe6Wy\xe2\xda\xb1\xfaxF\xdet\xd8{;\x9d\x08(\xc7\xf4V\x86o\xce\x05\x15\xd6\xc3\x9b\\\xa8_\xb9\x99A\x99:8\xf6\x1coZ\x07)\xb0\xb9\x7f\x923\xb0vI\xd0g=\xb1\"\xc3B\x07\x1d|\xb9\x16\x80\xbb\xdd\xaa\xb7\x92\x97\x05:\x1ed\xb6p\x9a\x9d\x0e>RE7\x18\xd3\xb1\x8b\x8d\xe9t!\xc2\x19}\xcc\xb2t\x80\xc1\x1e\xfa\"\xea\xe1\xe6\xca\x98|\xcc\xb6\xd5\xb5d\xa3\x16\x96wxk6\xba+\x0a\xa0\x02\x8f\xee\x93\xd8\x81m{\xeb\x17\xb3\x99\xcc\xa2\x0e\xa8\xe9\x83\xa1\x09w\"\x94\xe4\\V-0\x00vh?\xe4\xd6{o[t\xb2;ZD\x0e\xf5bIQg\x9b{\xed\xf2\xd7\\$L^\xfcs\xf3\xee}\x0e\x09?\x94\x0cT\x07\xc3'|\xddD\x09\xdc\xc3cm\x9fk\xa6\xe9\xa3\x99\x0c\xdd \xd5@y\"3E.\xd1\x82{\x1b\x06\xbc\x9c\xd9\x9f\x0f\x0f\xaf\x80b*\xc3Q\xe7T\x0a\x85\xca\xdc\xb5\xec`L\xab?\x14\xaf\xb4\x86\xd0\x7f1\x113\x96\"LS\xb8\xf9e|(\xdf\xfb\x9e\xae\xcf[\x98R9\xe2u\xbc\x91\xb4F\x18\x89_\x0a\xdc\xa1\xd9\x1a3\x94\x10+\xc0\xa3\x13L\xee\xa3\x88S\xcc\x9d\xee\xbd\xe0@jl\xda\xf7\xa5\xe6\xads\xc7}\xe6\x13\x82|\x8e\xe4\x19RP\x98c\x93No\x0f\xbb\xf8'mtfL\x99\x07\xff/\x0d\xd8\x83+\x14S\x80\x96\xeeL\x86\xc7\x09hH\xe7\x98\xa6f\x1e\xcc\xc3\x08\xc0\xac\xb9z9=i\xacU>;G;V\xef\x0d\xb6M\x17SR\x7f%S\x82\x02\xff\x7f\xfa\x9f\x8e\x8exM\x1f4\x8f\xf2\xc1\x11\xb4\xa9\xbeY\x90'\xad\xb2\x0c\xab2\xae\x94\xb2\xb5\xd5\xc9<\x92tG\x94\xf2\xe4\x83\x14\x7f\xce\x02\xf1?\xad\x89d\x8d8\xe6\xa1\xcb\xe1\xd1\x87\xf7\x04\xabjn$`\x12\xd3\x89S\xee\x97\xa5\xb18\xe8\\\xab\x92\xb7[\x87R\xc8>\xc9\\\x8cH\x89\xbb\x9eZ\xd0\xb0{_'GEW\xd4\xaf\xb64t\xecw\x13wj\x9e\xa4\x90\xcb*\x16)\x15\xb4\x95W\xc1m\xee\x0e%\xc9}\xf2\xaeg\xd1\xb21\xcdO\xbc\xaeI\x96zW\xb9raxJ[(k\xc8\xfa4\xd5\x82\x99\x1e\x0a\x8fl\xee\xcf\x8fv\xa1\xfd\x00\x01\xc85\x13Wzb|U\xee=\x1e\x1d\xc1I\xf0N\xecd\x8f\xb4j\xf7\x8e^\xef`\xb3\x8e|\xd0\x12\x14\xaa\x92\xb4\x01)\x86\x09\x98v8\xd5x~\x13\x9e\xa9\xeb7\x86\xe0\xe4Ep\xf7\xc3\xae\xe4\x09Y\x96\xf3\xdb?\xb1`9#\x99\xe3\xb6]&\xa9>e\xe6\xae\xb9%\xc4\xe5\xb0z\xb6i\x8d\xb5\xd2_\xac\xa1\x0e\xd87\xa7|\x9e\xb1\xda'd\xeb\xa1zLo\x91\x8b\xdbK7\xb5l\x90\xd0\x06\xab9\xf4\xf7\x1et\xd4)N\xf6\xf9]7\x04\x89\x03\xd3\x01\xbbEOC\xb8\x8e\x0a\x0e\x87\xc1\xac\x98\xe1\x89\xc1%\xf4\xf70\xe2\x9cC\x1d\xbe\xe6^[\xf4]\x83\xa8{}k\xe6\xcd\xca\x04\x97\xccF\x1d\x9bO\xfd\x1a\xe9_\xde\xff\xe9B\x1a\x0d~4\x1b\x02\x1e\x16z\xc8\xa2\xac$K\xb5m8\xd8\xaa\xf0\x9a_\xb9WVqe\xf2T\xe1\x07K\xba\xd4\xc1\x16(\x15\x09:?\xe3U\xc0B3\xab\xde\xfcOM\x96h\xd5|\xb3\xf8x^Z\xb6\xbe\xae+o<F\x99k\x883\xa47>-\xf2?[\x7fr\xd4\xf9\xa6\x9d\xc6C\x8c\x09\xad\xa6\x819\x97; CW\x08\xb2\xfa\x86\x83v\x93\xda\xe1\xd0\xd6\x1a\xedH\xd6\x07\x9d\xb9[IB=\x18\x0el#Y\xf1\x82\x8a*8 q\x8f'\xd2_\xbb>\xe7\xda\xe388\x16\xfbLPz\x8c\xb7\xe9\xd2\xda\xd6\x18\x93wm\xcd\xc9\xbb\x0e\x83\xd8Z\xbc\x9a\x05\x9f\xc7\x13\xbe\x1b\x7f\x9a\xd8\xdc\xc2\xad^\x148\xd7Y\x032\xe4]\xb7\x9e\xb5\xb7\xea,\xba\xb9\xa64W\xc1\x17\x8b\xfd\x86\xdd;\\\x0c\x0f\xe6\xe5\x9fZ\xe7~\xbd\x15\x9d{\xd6V\xc6s\x0fw\xbe\x16\xfa\xc6I\xf4\xb8T\xc7w\x9b3\xd5\x9d\xd8\x11bt\x8f3\xdaW\x1dy\xe5\x0a2c\xcc[\xc0Ag\xd6,,l\xf5u\x7fS\xb0\x8b(Nkbw\xe86\xc9\x1d>Q\x9f\xa2d\x85\xe4r\x1c\xb8[C\xf0\x94{k\xbe*\xc3\xf6c\xb3uS\x95\xdd\x05\xa71k\xf2^\xb2\xa9i_\xf5\xab\x80<%\x04\x8b\x14_\xe1X\xed\xc1^6\xaf'\xf6`\x1f\xa1\xa3\x88\xce\x99\x15VY\x07\xc4MJ\xba\xa2\xa3\x0b\xad\xb0\x83>}\xa7\x1c-\x1b\x98\xe9\x84\x0c\x08Q,\xcc\xb9\xd6\x0a.\xefj/&\xe9l+\x08\x01\x9c1\x87y\xae\x17\x8a\xd5\xd8~\xd8PU\x9d-9\x16K\xb1\xee\xbfO\x98\x88S\x85\xf4\xe7Z*\xb0C\x06yp\x1c\x89S\xb9\xc8qq\xe3\xc7\xdf3\x16\xbe\xa1^\x9f\xb6\xbd\x0d\xbd\x14\xcb\x0c!l\x83\xb6\x89c\xed\xeb\xe9\x9do\x9e\xf8\xbeW\xec)qc\x9fy\x0e\xe1\x1br6\xd2Be;\xcb\xd3\xa1\xdf\xb9\x98\xca\xe9\x9bM\xff\x11Y7\x16\x8b_\xe4\x09\xaf\x13#\x99\x91j\xfb\x1f\xb9\xa2\x1eB\xa4\x04\xd1\xa2\xe5;\xc3\x15\x97\xbe=\xa17-\xb2\x01\xa8GrN\x1dI3\xfc\x87w\x1a\xe5k\xad\xa1\x83\x180\x89jk\x15\x848\x15\xf1\x90\xb4\x96\x8e\x93\x81\xdf\xca\xa4\xd0\xdf\xca\x1e\xccU\xa4\xc1\x86x\x03\x05-\xfd\xa4A\x96|\x96\xc9\xdb\xb2m\x94\x19\xf4\xd6\xecX?\xd9Ds\x7f\x0b\xe3\x80\xf57\xa6\xc6)\xe4\xf0]\x88\xf7\x0f\xbb\xa5\xbf\xf4\xdc\xb7\xc9\xd7O}\x08\x1b\x98CEY\x91d\x9f\x0ak\x85^&\x84Z\x92y\xb3hVe=\x94r\x1d\xe6=t\xc61\xe6\x95\xa4\x9a4W\xf3\xd4\xbc\xd2Ir\xab 2r'\xc4\x9e\xb5d\xcfjd\x95\xb6\x12Z\xf6\x05\x96L\x13\x04\x06\xda\xefC,\x99\xd4\x10N\xceH8Z`\xf4\xb5\xc3\xec\xe7/l\x1c\x12{\xe9\x9cX7\xaf( Kk\x98N\xf8\x83\xdd\xf6a@\xe2,f\x1bge@\xa2\x92\x09F KF\x0d0L\x1be\xdd\xf8\xe2jm\x14E\xcc\x1c\xf2\x06\xa3}K\x0f\x86O7\xc9\x9faO\xd8tL\x1c\x9a\x7f\x8b&\xfb\x8ed\xfc{{\x8f]\xe4\x8dM\xe9\xb4\x13\x09\x02\x12&=-\"\x90\x87\xa9\xbc\x0c\xc02\xee\xaeW\x8f\xd9\xb7\xaa\xe5\xcd'\xbe\xae\x98\x1e\x13}e\xfd\x83buC\xa0\xcf3\xbc:ODRQ\xb6\\\xab\x05\x88J\x10=\x8f\x04Qi\xcc\xc4a\xa9\xe4\xc5\xa8\xa9\xa7\x1b*t._\xe6\x95\xeb7Qq\x8eW(G\xd7\xfda\xb5\x04\x06\x7f\x04\x8f\xed+\xbemGw\xfd\xcb\xab\xbe\x82M\xe99\x86S\x05\xc5\xa2\xc4&%\xdd\xc8x\xe7L\xeeS\xf9O\x8d\xber#c'\x1d\xed\xfd\x09-\xee\x8e\x0eA\x86\x0a\x9dbi\xb1?N@\xb4\xeb C\xeaJ\xc8\xa2\xf0\x81\xcc\x1c\xffX\xd2BI\xe6we\x04\xc1\x9f\xda\xac-\x0b\xdea\x91\xd4\xc5\xba\x07\x91\xc5x\xb3;\xdd\xb6kh\x9fj\xea\x16D\xb4\x1bm\xf2\x92=\xe6\x99\xb7n\x86@\xac)Cy\x14\x9367;\x95L\xc3o\xc3\x930\xbf\xa8\xf0Z\xc8\x85\xe0\xfbf\x82K\xc5Y4R\xef\xb9\xbc\xcd\x12\xb8x\xdd\xcdo\xdc^\xb8\x85\xa8\x94{o]!\xba0\x99\x7fA\x16/\xc8R\x83\x06\xf5/@\xceX\xfb%\xa7\xc6\x1af\x97\xbf\xbc\x8b<\xbd\x06\x9bp\xfd\xe5\x89\xaa\x97\x03Ks!\xf0\x0c\x14\\\xd3'\xe6\x08\xcb\xc3Q\xc1\xef3\x80\xbb5\xfa\x9a\x0b\x81@\xf3\x9d\x07u\xc4\x97\xbc\xfa\x92s\xc9~\xa0E\x0a\xa4\xb7T,xKl]\x81\x11\x16\xc70\xab\xef\xb6\xf9\xaeO\xe5\x98\xe3\x9c\xbc>\x0da\x9f\x84q\xddC\x04:\xa5$l\xa1+\xf4,\xe7_\"#Fw\x11\x14\xe6\x16\xd2\x9a\xb2\x0dm\xd2K\xc9\xd7({P\x94j\xf0\xba\x89\xbeI?\x83Z\x0fw\x99\xd3\x9d\xad\xb8\xd1\x12\x95\x0e\x96\xb6\xb6\x1b\x8cl\xfeO\x90\xcdS\x18O\xf3\x94\xfe\xb1\x94\xdfO\xc4?]\xfb\xc7\x9de\xb8\x0e\x09\xeb=\xef\xdd\x8aI\xec\x0c\xf5\xb1\x14\xd16W\x83y\xc86\xd7\x85\xbf\xf1\xc1\x86tzV\xe9\x0a\xc4\xc9\xd2\xc4<\xe2\xa5K\x02\x07\xebN$\xd9\xf1\xee\x03\xd5\x03\xe2L\xe3\x0d\xad\xd8\x09\x1d\xac\xe7\\u\x88\xed\x16T\xeb\\\x19\xba\xc3_l\x85\x0c\x98\xa0\x90V\xce\xe8)d1\xa0u\x03\xae\xb7/\xb3\xbc\xa6\xf9\xb1\xech\xbe\xcf\xddd\xa6\xf2\x9ax\x9f\xf3\x0e\xc3[~\xf0\x90I\xb9\xba\xf2D\xd9\x8c\xfdh\x1e\xb1Z\xf3}[\x1eLh>\xb5\x99r!\xf8\xb7\xa0\xef\x90q\x126[\x97O*Gc\xc9\x93ymW\x0carn\x82>\xce@N\xf1\xea:`\x83z\x1dB\xef\xb89\xa8\xcd\xc24\xa6\xecC\x96\xddn\x0dyZ\xd3\xd5Ohn\x9f\xb7\x15\\\xa1=W\x17(\xbb\xbfVvt\x8a\x81\x80_\xe4O\xf6\xd8\x0cY+*@\xa4'\x86\xbfz$c<\xde\xdf\xde\xd9 \xe6\xed\"!L\xdc\x0b \xff\x91nA\xd0\x84k\xadFX\x1a\x89\x01\x01b\xa8\x05\x9a\xd2=\xd0\xebt%W,Q\xedV'\xf4\xbbK\x0f0c\x13~\xbat\x88\xaaB\x96+2\x8e\xc7\x93[\xe6\x83\x87\xfd\xc6-nu\xfc<\x96\xaa:\x1aC\x8e\x94pcr\x9a\xa9\x00\xb3\x1dm\xac\x0fY(?\xc6s\x9a\x8d\xf7h\xf5(q\xb3\xb5s\xae\xc7\xcd\x0d\x11Uk\xc1\x80w\x8c9rg\x19\xd8M\xdf+\xb1\xaei~\x173\x0d\x0e\x90\x1e\xd6\x9b0\xee\xf2\xc2a\x8fG~xQ\xe8Y=\xa5\x9aw}\xd631\xb3@\x83\xf2\xaf\xcb\xd0\xffX\x99\x07\xa0b\xcdk\xb87\xd8#\xe8\xdf1^\x157?Z[\xfd\xb4\x9d\xf3\x18\xba\xa2\xb3\x93G+\xb4(\xb9~\xc7\x9e;V\xeaog\xf9V\xa1\x13u}J\xef~9\x80\x9d\x09S'\xad\x8e\xbc\xf92\xce^\x07\xff\xd4\x1f\xac<RD\x96\xd7i\xfd\xfbt\xeb\x1e\xe6\xab{\x9bV\xbe\x9f(\xbb\xba/\xcbp\xac\xa5\x099\xcf\xeb\x0b0HS\xcd\x80G\xab;m\xadBC\xbd\xbc\xbeJ\xf3\xd4\xbc(\x8fq`Y\x92\xcd;\xa8\xc6hR\x15\x00\x16\x0a\x9f\xbe\xc9f\xa5)\xab\xb8\xceX\xb1\xf3-m\xf7\xcd\xabdy\x1f:MN\x87\xe6q\"hJ\xba\xab\x92\xe1\xf0pV\xd6\xe9%\xd5\x16\xf6'\xe8F\xefE\x1cAw\xdb\x19\xdc\xab%\x81z\xa8\xcfpI\xa3\xb4\xd5\xa5\x11\x9c\x0d\x13\x17\x8b/\xc7\xe5u" +
+	"\xdc\x13\x8d\xf4\xdf\x9b\xa3\xb0SQ|d\x0e\xc9\xd7iv\x0e\\\xa0h(\x97LZ\xa6<\x08W\xaeDs\xea\xbb[gK\x01x\xd8\x97\xbe\x07$\xcc\xe2G1m\x83]\xf2E\xda-\xfb\x90\xf5\xe8\xb4W\xd0\x10\x1f?\xc9/C]\xd7\x96&\xdd-\xa0\x04hS\xcd\x09\x8d\x8ft\xfc\x94\xadX\xb3\x15\x12\x1dq\x9aWx\xd4\xb7\xac\xbfyJ\x9f\xd5Y\xd7\x87\xdd{\x0dK\x98\xa1\x93\x0a\xaf\xcc\xf9\x03x\x1d\x1e|\x9c\x9f\xb4o@4\x8b8\xa5\x95[Z\xc0\x11\xd9\x87\xfc'vc\xf5\x1c\x8f\x1d\x1a\x97*7\x93\x03`B\xd7\xb0?+\xf0;\xcd\xe2\xdcH\xed]\xdc\xb0\x84\xaf\x84\xc2\xc6\xd4\xd2\xd5\xbf\x95\xee\x09vl5LH3\x99\xa5J\xc8{G\xb2\xe2\xdeu\xad\xc8\x112\xe7\xe5\x88\x19\xc3`\xad\xd9\x87\x0e\x9b\x0c\xdc{~\xfe\xd4R\xf6\xb3\xd9\xc9S\x89 y\xb3a\x0f\xe9\x14,\xb4\xeb\xe6\xf0\xe4\x0d\x9e\xaa\xe1\xcf\xf9\x8a\x8d\xb9\xc06\x07\xd1>%\x13\xba\x9e\x0cQp\xb1#\x9d\xde\xd2\x13\xc0\\\xa0E\xa5\xee\x1d\xca\xcbW|L\xf3\x80\xc6\xd8v\xc5\x10\x8b$!\xd9<\xf1\\\xd1\x0e\x15q\x8bR\x9a#\x0d\x15\xc6a\xc7w\x040\x81'|\x05\x0f*\xdc\xde2\x99\xfa/O1\xa4\x097\x9b]A\x96x\x90*(\x10\x1f\xe4\xfd\xd5\xf1\xa3\xdc\x08\xf5\xf6\xfa\xf7\x19\xa3hmz\xcc\x91\xb7Ve\xedG\xf8\xca\xfa\x8d\x03\xa4\xd7N\xa5\xb9\xe4y\xd8||#\xd7\xe3\x89\x07\xf0\xbf\x90\x88\xbb9\x1f\xb6`\xd1\x91\xeb\x8c\xa4\xe0\x97\x09\xa7zP\x05\xdd\x1d\xe5\xe7\xb4\xba\xc9B\x87\xcd\xa1d\xcf\x02\x84\xf5S\xb2S\xcc\xfb\xcdWH\xb9\xd3e\x06\xa0^dDD\xdcy\x0c\x0fGQ/\xed\xb4\xad\x9a\xd717\xe7\xe6b\x9c\x1bH@\xf4\xd1fW\x05cO\xfc4\xbf\xb2\x96\x1c\x15\xddj\x17\x7f.\xbbus9\xea\xcc.2=\x88Jp\xb3\xee\xb3PP\xe3J}\x95w='\xdf\"\xadl\x03/\xfa\xde*\\G\x9a\x96\xfd\xe5\xe8\x8be{\xef\x9a\x90\x15x\xb0N\xa8\xc6\xe1\x803\xc6\x8dB#\x04\xb8(.Q\xb73/\\\xe4\x93\x9c15\xd9\xdfK\xd5_.\x1e7\xe4^\x86\xa1\xc3B\xb1\xd0\xde\x1fM\x19\x95\xb9\xcbo\xaeJY\x04iv\xf5\xa6\x12\x1e+\x02uK\xc6\xea\x08\x01O\xb8o\xef\xe5\x0e\x96\xdc\xa4\xe2\x96\xc7\x88\xb6\xfc\xcdu\x14s\x8b\x89\x81x=l\xa22\x09V\xc2\xb6\xf6'\xaaP7\x9e\xa0p\xcd\x14\xb3\x92\xe8\xa3\xe2I_\\ m\xeb\x82lb\xe8\xc5\xd2\xf1\x94\xac\x99\xb30\xe3\xb3\x11H\xf3^\xd4\x05\xb7\xddFT\xe1\xad\xec\xbf\xe1\xdb\xb1`m\xd2S\xbe|S(d\xbbD)\xa7\x9f\x95\xf3\xe8\x1a\xb6\x13:!\xdd`\xdfM\xdc\xf5\xeb\x0c#\xebT\x99[L\xa9\\\xf3\x0c\xbaf\xbc/=U\xf5\x14\xf3$\xb3&+\xf81\x90SR\xfe\xe9 \xa97\xed\xf3}\x0eU\x17\xf9\xa2\xeb\x8a\x0eD\xec\xb7\xaeC\xc8\x84R\x9c\x02J\xa5\xf0\x85\xee\xb7&\xad\x97}\xf1\x96\x8c\x1a\x0f\x8b\x00X\xe6B\xcb6\x0dw\xcd\xbf\xe86\xc7\x972\xcd\x01{\xffot=\xdc\xfa6\xe4C\xf3$o\xf1\xd9\x99\xfcv\xc1eF\x16r\x9b\x83S\xb9\x9b+\xa9\x90\xcf\x0f\\\x85\xff\x0a\xbf\x92\xdfR\xba\x18\x1dO2\x8a\x98\xb9\x13\xdc \x82h\x9e\xc4\x92W\xd9\xa2\x1cb\xab\xbb=\x0fF+\xe5\xd0\x9e\xa4'4#\xc5G\xdd\x05;\x9f\xa2G\xad{\xa1\xdf\x1c\xbc\x15\xcd\xc1\xedE\xde#N\x04\xf1\x9e\xa1Pr\x9bY\xf0\xed\xc3\x1a.\xa3W\x80'\xb8\xb2\xf4\xd4\x8ad\xcd\xce\xd7\x81\x17i\xfeZW\x97\xca9\x06\xb0\xa0\x1cu48\xb4j\xefF\xa0W\x9a=\x00\xae\x87\xd6g6\xc1\x9d\xfa5<\xb7\xa2<\xf2\xede\x87\xac\x1dM\xed\xd2q\xaa\xec\x16\xb2Ep\x18a\x0f\xc3\x0d\xdf\xc6\xfdJA\xfd!T\x14NY\x818wV\x9d\x1c\x8a\xe2o\xaf\xba\xf7^\xac\xd1\x9c^\x9e\xd1\xfe\xdcr\x85\xe6\xdf)\xca\xdc^w%\xc3\x18\xb8\x05\x0d\x1a\xce\xbf\xad3<u\x18\xc1\x9c\x0a*\xa0\xc37\x11\xf8\x11\x88\xa0>y\xf6\x98\xa97\xb3@\xd9\xab%w\xec\xb7K\x9bM#h\x88[K,I\xbd\x02v8\xef\xc1\xd5\xc9)Pk\xd0\x19l\x9b\xa3M\xa2\x1eX\xc9\x09l\x98\xb7\x1e\xb7 \x84\xa7^\x08\xb0\x04\xdb<\x92\x94\xd1\x9f\x01t,\xb2\xf3\xba\x00\xec\xe36\xbb\xec\x109\xa0]\x0c\xca=\xdc\xfa\xce\xe3cV8\xe4\xf0\xe3\xdf\x88\xde\\B\xf9\xb4H\xeb\xces$\x1b\x82\x83\xa5\xd2\xcc\xa7P5l\x1a\x85-\xd2\x06\xff\x11\xf4\x85N6y4\x05\x12\x1a\x05y\x7f\x1c\xfd&\xd5\xf8\x81V\x99\x93\xbd\x95\xbb\xb3 \x85\xff\x16\xda#\x98\x0e\x95\xaaQ\x9e\x8eO\x05G\x7f\xb6_{\xbe*8blg|)\xfa\x89\xd2\xe0\xbc\xe8)\x12x\x8a\xd2\xa9!\xb4\xea\xdc\x80@\x18d\xbce\x93\xff\xc3.\xbb\xbb\xf3\xdc\x80v\x9eEn\x91\x1d\xf5\x1do|i\xae\xb5\x0b\xcb\\\xcd!\xf3\xb9As\xf2^\x8f\xc7A\x9e\xe0\"HNUA\xf5\x85\xf2T\xb5\x84e<e\xc9T\x10\xdc\x95#?7\xb6rc~l\xfb\x1e\xf3\xa2\xbf\x04)\xa3\xd6}\xea\x80\x98#\xd8\x95\x98t\x84\xc2\x92x\xfa\xe7F.\x87)\x97\xad\xdd \xae\xf4\xce'\x16\xb4\xec\xc0\xce\xbfR\x14ak!o\xc7\x00\xe5\xb5\xbe\xbbIo\xddb\x8e\x8a\x84V\x07\x91+\x06\x0c\x9a\x1e\xb7S6&\x10\x12z\xc9\xb8\x12G\xe9\xaeP,>\xea\x84\x88k\xfb\x07C\x8e]\xec\x02\xfd\xacM;wA\x96\x15o\xdf\xd1\xf2\xcc\xf9&\xaeP\xcd\\\x85* s\xcb\xa4\x1dw\x86\x97|\x1b\xa94:\xba4\x80\xdc:\x839\xa7A\xd4Yq4\xdfq\xdd\xf7\xb9\xfaG\xf4n\xde\x8ai\xa6\x7f\xee\xf3\xde]\xf7&\x9e\xe4\x15\xcc\x9b]\xf7\xae\x8cA\xa9\xf8U\x16+\xb1A\xb4\xac\xea\xc9\x01\xd3]\xa8D\xb9=C\xaa\x143\xc1BW>\xcbP\xc1\xcc\xa0V\x11\x97\x09\xf0\x0e{$\xcc\xbd.[g\xcd\xf5\x87\x7f\xa7T\xed\xef28Ig\xc3[\x98\xaa\xf2K4Q@\xb8\x1c\xac\xd7!G\x9d\x99\xc6\xc57SO\xa5\xd9\xb0\xc7g\x02\x0cB\xcf\x8b\xbf\xf3\x13tB\xee\xeeN\xbb\xf3\xff\x9c\xcePRz\xce{n\x1c\xdd\xf5qv|`\x07\x10\xf51\x0d~\xd7\xd2\xf2\xc9=v8\xc9w\xcb\x06\xb45\xe0@=\xf2j\x0cW\xd8\xfd#\xd6\xe9\xb3/_2J\xd6\xb74 \xb2_\x9b\x10d\x15\x134Jz\xe2p*ki\x15\xce\xdcMC\xf0s\xd1\xe5\x81j\x81\x1b\x84\xa2Q\xecLW\\\x95\xad\xc2\x89\xe8S\x94\xbd\xc1?v\x04%\x9a\xfbc\x8f\xf0\xecK\xc4\xaf\xb0\xb0^\xe59\x94{fX\xa86\xf3\xd6\x01\x0c'\x8d)\xee\x0f\xcda\x07\xe3\xa83\x12S\x18\x82L\x0e\x83\xablORH\x97\xcf\x08Yk\xb1\x1c\x9ds\xe7\x08<\xeb\x9eMg\x94'\xfd\xec\x8b\xaf\xbf\xfc\xad)5\x8a\x8e\x88\x0eD\xfc\x82 L\xf4\x8c\xb8\x0b\xcf\xb3\xc0\xfd\x7f\x08\x8f\x90j\x7f\xa8\xcc\xf6D\x08\x8d\xf2\xd0\x94I\x18\x9cq\xf8\x07\x13\xfe\xc2\xd1\xd0\xbc\xc8\x0e\x1e\x02\x1e\xce4\x12,\xf9\x13]\xc4\xc2^\xf8J\xc6\xd1\x07\xc7\x95\xe5\x0d\xfc=\x89\x1a\xf0\x0c-H\xbd\xc0\xf4\x13\x0c\xd7\xd9\x97\xcb?H\xe6\xaeD\x07|\xcb\x93J\xa8\x86y\x8f:\x92e\xda\x0b\x02\x0a\x8c\xee\xdd\xdcj\xf7\xa4z.U\xde\x1a\x1aa\x9f\x9f`x\x0c\xbb\"\xa8\xa4\x9f\xec\x1e\xf5\xd7G\xdf\xfe\x8f8Lg_\xae{\xa7>-\xacG\xfcs\x9e\xe3\xf8YT(\xd7\xe2\xd6O;\x13\xfaO\xa9\xe7\xbe\xdc\xf43jo\x02G\x1c\xd8>\x9a\xcd\x844\xd76\xe2Y\x98\x93A\x1d\x01$\xa5F\xab\xd7z\x81\xb3\x82\xea\x03\xb1\xd3n\x94\x87\x051]:\xe1v\xb0@\xa6X\xfc\xb9\xfac\xd2\xc6\xb7\xa6w\xe9Q\xeb\x06V\xff\x9auZ\xbd\xa3\xd8tr\xa7\xd5\xd9\x97\xdb\x0c\xa3D\xf9:\xec\x00f\xff=!\xca\xbay\xcb\x82\xe2\x1d\x99\xec\xafj)\xaav\xae\x0d\xd8\x81[\x91\xdd?>;\xff\x87\\\xdc\x0d\xfd\x04DQ\x8e}A\xacK\xd5Q\xd1pl\xae8\xa4@\xd6\xf6\xc5\xe5L\xda)\xdf!\x9e`\x8bp\xcd\xc4O\xbfN\xb2G\x09\xefKj\xd4\xa2\xfc\xbf\xd6}l\xe0|\xefh_K\xdb\xeft\xc2{m;\xc5A\xf4G\xb3\x15/\xa5\xd3\xc2\xff\xef\xd0\xb3\x9duYW_\xd3\x0f\xc2\xfd:l)>'\xd3y\xf0u\xfbN\xedN\x8dk\xcaW\x7f6\xc7\x0ec\xed\x1f-S\xe3i\xcdv,\xa1\xb0\xfdZ\xa8~u3oa\xa3\xda*\x96R\x9e\xf1\x11\x1eD\x1d\x99HK\x9c\x0dt\x9c\x1eUe\x7fR\x83\xd9\xc6\xa50\x9e\xac\xd8\xa9;\xc2A\x88\x0b\xd3\x07\x0fT;t)\x99\xe4\xc8\xfe\x1d\xfc\x1dV\x89\xdc\x0a\xa5k>\x19\xa5\xde\x006\x09\xf6&\xb6\xb4\xeb\xcd\xe2\x8bl6\x08\xfey36\xa6\xa7?DR\x9c\x19)-\x9e\x8a\x02\x8e\xady\xc0G*\xc0\x1b\xff\x0b\x84\x04\xfc\x07o\xf4\x93'\xfb\x804\x8d\x9bc<\xe2\xd1\xff\xe4\xef!\xcc\x8e\x90}\x0c\xe0v\xf4F\xa6\xcd/\xd9}$\x00\xec\xa7\x8b\x1f\xe3Q5>\xdb\x0e\x8f\xa7\xe0\xaf\x0bL'k\xcb\x1d\xfd\xb6\xb4\xe4\xbcC\x9a\x9f\xd7\xcc\x04\xce41\xc9r\xaf\xff\xc0h\xd9m\xe0<X\x1bC\x8f`\xbf0\xf1\xcb\x0e\xc9Y\xa7\x89G\x00^z\xcb\xdc\x7f\x1e\xe0e\xf3\xb4A`\xe2\xab8\xc9r\xd2.xa|\xe7\x15\xc2\xcd\x0em\x9d~\x194k\xed\xc6\xf9\xb6E\x03\xcf\xb4\x9a\xd3\x05\xd7\xc2\xb6\x08\xdb\xd0\xbd\x0bdF&\xb4q\x96\x1e\xf1\xaa\xf4\x88\x1e\xc3\xf63P\x0b(j\xe6e\x92\x96\xa2d_\xb4\xcf8\x8c\xbby,\xdaUt\xa2\"Fv\xa3\xd7\xfd\x96\x10\xff\xb70\xacw\x99\xd0\x04z\xe6\xdc\x0e\xb0H\xf7\xe4\xe0.\x16\x7f\xe5|M\x7f\x84y9;\xbb\xc9\xfb@sI\x10\x1f\x99\xb9\xe51\x16\x1b\x17\"c\xb5\xd1\x1b0\xb4\xc0\xee\xbc\xa1\xc6\x99/\x8a\xf4r\xd3\xbfk\x02\xcc\xfd\xd6\xbdi\"^\\\xa6\xda)\xf7\x1e\x83\x14\xbc7\xfa\xeb@\xc4\xd2\xd3\xc1\xc0\xe9,\x80\x87\xf5\xe1\x9b\x1f\xed\x9c\x88;\x02=\x82\x87\xb6`BI\x161\xe3\"\\\x0ds\x11\xb6U\xef\x0f\xb2]\xf1W0Ds\xd4D\xd0^\x15w\x17m\x8c\x94\x1d\xad\x7ft\xa4/S\xea2\x0f\xff]@d\x9c\xba'\xf1F\xf5\xc8\x89\x91\xb3\xde\xcd\xb0\x93+^L2\x9e\x19wm4\xe8\x85\xacC\xe5\xf3\x18\xabB\x1e\xc3|W\xf7\x86\x8f\xfd]/\x95\xe5I\x7f\x9b\xca\xae\x7fw\xf4K\xf9\xbb\x0f\xc2\xc2'\xf8\xd0\x96\xfa\xe6W\xbbh\xd6z\xee\xb4\xaa\xb6\xfa\xd7\xa2yn\x17\xcdG\xf3\xbd\xe8\xf1\xe8-}\x0a\x09\xdd\x82\x8a\x13\xdf\xf0)X\xc33\xb8$\xffD`\x83\x9a\x08c[\xbelk\x9dT\xd6\x10\xac\x1e\x9a\x86\xb7\x9a\xf2(h\xc3\xfeD.\x80Yn\xb3\x82W\xef\xeb=\xeb\xa8\xc4\xbaZ\x1b\xfe\x09\xed\xc2\xdf\xc6.\xca\xb7\xb8\xcd>\xa9\xe1\xe5*\x04\x19\x8a\xf4\xfd\xcf\xc4\x94\xe1\xafn\xf5j\xabQ\xba\xdf?\x16N8?\x1f\xd8\xbcB\xf0?\xc7#\x8a\x87\xa1\xacQS\x93\x0a\xa4\xaf\xf1\x86hOJ\x7f\xd28\x98It0\x17,t.\xacgX\x07#Ho\x97\xae\xaf%\xdd#\xdbPW`\xca\x15l\xa2\x08\xf1P\xc3\xcf\xd9\x1f\xd2\x16\x97\xe8/k\xcf\xa7\xa5V\xcfOc_?T\xf2\x04\x02\xfd\xfe;\xcc[g\x8e\x8e\xb8\x8a\x15\xd4.\xbc\x03\xe1j\x1fe\xc1Zn\x8d\xb8#\xd0\xa4\xb4j^`\xbe\xb9{H\xe8~\x16\xe5q\x9a\xda\xef\xdbE\xf6\xd3\xca\xbaR5\xd2(<\xd2UP\xae\xcd\xaeW\x94\xe7\xcf\xafN\x85.\xd7EJ\x7f;\xc1\xe2\x0c\x004\x0c\xa09;\xf7\x8a\x03fe5\xb9\xc0O\xc7,\x0f\x1a\x01\xb2\xc8p\xb3\x91\xfb\xfc\xae\xbd\xc617<w\xd9)\x09\x0d\xe7\xae\x97wHS\xfb\xec\xfc\xe6\x0f&\xc1\xb2\xf9\xd5\x1c\xb8\x93\xc1\xe3f\xf1\xc9\x84\xf6)\xc0J\xdc\xbc\x068\x8bn\xb5\xd90;\x15\xefD\xf4\xcf \x82o\xaf\xe6\x9f\xc9\xcc\x9e\x9dw\x18A\x1e\x95$\\7=\xbe1\xc7\xaad\xdd\x0c\x12>\x0b\xa0\x81\xd3\x8dO\x94\x81\xf8\x08\xa4\xcc\xdbn\x02\xe0\x14\xd7\xcc\x91\xe3N\xbd\xcc\x18\xaft\xca\xc1\xa2\xfd\xaa\xce\xf6\x9d>\x96\xd1\x80X\x98\xd1\x0b\xa3yYM4\xcfG\xee\xc0{O`\xdd/\x7fw\xf6E\xf4\xef\xda\xba\xad\xdae\xf36\xa0}G\xce\xd4\x84L\xdcl\x12E}<\x11\xa2\xa5[\xfe\x9f\xff\xd7\xff\xe7\xff\xfd\x7f\xfd\xdf\xff\xf7\xff\xe3\xffE\xc2yo\x1dM\x0d4\x9e,\xc7\xdc\xb4s\xb7\xa6B\xe8\xbb\xa1\x84 \x18&\xa51\xa0\xad\xe8<\xcb\xb8\xa4=\xb0L\x0e\\Z\x07*N\xd5'\xe1\xd6\x09\xa6\x05a\xd2\xec\xb5\x1e11\xf9)i=5bPg0\xe0^\x08\xcb%2\x0f\x84i]\x14\x09\xa0.bLv\xe8\x10\x7fX\xbb\xd9)\x8c\x93\xec{\xed`_j\xb27\xbcZ\x1b\x086|\x0d\x19VL&*qne\\\xd6\"\xb5a\xf2\xedS8H\x0f\xe2\xe0/}\x08\xeb\x04\xc2\xc2\x9d\xcb\xb3@k\x8f\xd38\x14\x8f+\xbf\xc5\x905\x0bRy\xb2\x8a\x19?\x8b\"\xa4j\x91\xe7\xfdHH\x96q\xa0E\xb9\xc6\xbdt\xc2\xf1(\xfe\x90r\x0e\xa8\xe8\xcc\xaf*\xea\xce\xba:\x8ao\x94\x9061d\xdc\xde\x9a\xcfII\xd2T\xdc\xd2w_\xf8\xa6\xf5Q\x0b\xe7\x7f\x84\x90\x15\xb0\x17C\x9c\x1b\x05\x1a_p\xe9qMf\x91\xd6\xd4O\xc6\x13I\xae\x10\xaf|\x89\xa4\"\xc0r{p\x09\xa3\x81\xeb\x15\x98\x9d\x9dwS\x8e\xdajn\xd80\xc9\x8d\xbd\xf1#2\xaf\x14O\\\xcfV-\xf1\xe0\xd6(\x99\xea\xffF\xde*&7\xd8\x99l\x9e\x9b\x9d\xc0\xddq}\x97 \x95Ly[\xf5\xde\xa7\xf9\xa3\xe5\xe5\xd6\x7f\x9b\xbb\".\xec\xa6$ql\xe1\x9a\xd4\xb3\xc8\xd9\xfc\x8b}&\x0f\xfb\x1a\xe2k\xd94\x9f\xed\x82\xf6B\x1c\xec\xd8*\xe4B\x1a\xfb\xc9\xad\xd3%\x9a\xdfi\x90\x8aq\xc2m\xe6\xf4@\xef\xd5\x03\x87L\xe3\xe7\xcf\x11C'\xe9>\x0f\xad?\xa9`t4U\x1e\x82\xe4W?xU\xb3 6!b;!\x1e\xedX\xac\x03\xea\xed\xef\x11\x8bD}\x16\xc7\x1cn\xb0\x7f\xa9i?\x99\xd0\xb3\x1d\xcf]>V\x9b\xf7\xe7\xd5\xe0 Y\x0e@2\xc4\xd8j\xc6|\xb0h\x93\xd1\xbd\x90\x09}\xcc\x183t\xeb%\x8b\xd1\x1cx\xf7HG\xfa\x02\x88\"\x8f1]\x09N\xf2\xe02E\xe9\xbc\xf2p\xb6\x17\x9f4\xe3\xe9\x0d|R\xf4\xbcQ\xa7\x9a\xa6\xc0\xea$y;\xf1}\xdf&Z\x1cy\xd3\xc0\xb6\xf9\xc9\xa3*1\x09j7[\xa1\xd3\x12\xc8y\xb3q\x7f\x7f\x93\xee\xbf\xb9L\xefwz,E\xc9\xa4\xb3\xf2&\x95\xbe];\x82\x9e=\x93]\x8a\xbf\xf2\x93\xa5\xac\xd2\xe13\xb1\x87\xca/\x8c\x92\xe8\x015\xf3?\x8e\xe8/\xc2\x0b\x06S\x1b\x93i*~\xf2\xdeC\xa7}\xcf>\x9e\xcd\xd3N3\xec\xfa\x94\x0e\xee\xaas\xabs#zl6\xf6\xa8\xed\xd2\xed\xb5\x1e\xb97\xcc!a\xb5\x04x4\xb4\xab\xa5\x8b\x0bU\xea\x1c\xc9\x85\xfe\x9eK8,\x92EYr\xf8\xc3j\x9cc\x00\x84\xab\xce\xdc\xa2\xa6\xf6\xb9#Lo\x17\x99Eu#c\xde\xe2)\x93?=\x059\x15Z\xc2\x13U\xe2\x95tlT\x13\xb0\x15s\x9c\xa5@8\x95U\xad\xb5\x8dV\x8f\x83\xcd\x93+I\xea\xafV,\xc0\x0e0aO\xb0W\x93\xaf\xfd\x95W5\x1dIg&P\xd7t\"$\xeb\x1fT\x08 \xbb[\x1b\x96I\x0a\xf5\x03\xd5\xbf\xeb\x9c\xed\xafR\x95\x92\xd0\x94\xc9I\x17G$\xecY\x99\xd7\xc9U\x91Y\xb0u\xe4\xc9\xf3f\xdb\xfc\x00\xb7\x00~\x01\x15\xb9HS\x98y\xa4\xc7\xf6\xbe<\x0d\\;\xaa\x84\xc3\xbf\xb0\xc6\x19\x88\xb6\x01\xf5^t\xb2\xf1\x1c\xd4k\xe0x\xf3\x88Ue\xeb\xd8`\xc4]\xd5\xc0x\xde<\xc5e1\xa6\x97\xd7V\xa6o!\xab\xbb[\x8bi6\x96\xf7\xb4\xc6SKb2\xc5^\x87\xf1\xa9\xf9O;\xc7\xbbI\xd8\x83#\xe9Y\x89\xf9\x0b\x85O&\xbd\x11\x0e\x9f*\xabV\xf9\x1b@\x03l\xc9\xd3\x8a\xfc\x98\xbc\x98\x83\x12\x07\xc8v\x99\x81\xef4Y\x8e\xb3\xbb\x81\x8c'o{\x0aBX4\xdfM\x1d\x04\x1dop)9\xe9\xc1\xc33\x0f\xad\xf2o\xb4" +
+	"rw<\xfe\x07Pi\xea\x06\xb9\xd5\xe6\x83\xf4d\xdaR\x8e@E\xcf=\"\x88\x97\xbf\x9c\xb9\xae\x85\x8cU\x096\xe9\xbb\xbb\x97\x95\xa6%Bg\x02\x03\xb7\x0c\x09\xd0.\x93\xfe\x80\x09\xa8,\xdb\xdaSHz6\x1c\x1cW\x8f\x09\xdfY\xc7Y\xf3\xd2\xde\xdbn\xc4\x83\xee\xf5\xef4\xbc\xfc)\x14m\x0b\x90\"Y\xee\xd7\x0a%3u\xd9\xbc\xfd\xc53\xba\x04y*\xe8\x1d\xf9x\xad,a?[\x8a\x93\xba\x93\xa4\x1b[\x9d\xc5@|z~\xde\x1fm\xb55\xa3\xec\xa2\x88\xef\xd6\xa0\x03[J\x0a\xc4\xe2\x9c\xd1c\xca/\xc8\xd5\x98a\x07>\xc0\x1f6\xad@\x08\xdf(\xffE%\xac\xaa\xe6\xf5\xdb\xbe-\x88\x9b'J@6\x1f\x809\xd0\xd7\xbeA\x8cZJ-w\xbf\xe0u\x91\xd5\xf8\xc4\xb5#\x12Z\xbd\xa3\xbdd\xe1=\xefVr\xd2]\x19\xdee\xf6\x99\xa9`\x0d\xc8\x8a\xbc\xb0\x05\xe7N\x98\xab\xa0\x87\x9c\xe0\xbd\xd9\xb4\xb3\xf2T\xbb\xe8\x92\x13\xd0%\xc4u\x8e\xac\x88\xcco\xdc50[\xden]`\xda\xc3\xf2\xc1\x86b)\x05\xfd\xdf\xb6\xc7\x04\xe1Te\\\x1d\x96>\x80K\xf3\xc0%\x8fM\xccu\xb4\xd3v\xf9\xcf\xe1\x93\xe7\x97]~\xeeE\xaa\x1d\x0f\xdcN\xba\x0e\x8c=[\x14@b\xdc\xbbx\xe3\x03\xcc&\xf2|@\xb7\x8e\x02\xed\xd5\x05c\xdb\xbc6\xc6]M\xcaI\x99\x1e\xf9\xf1\xb80\x00\x95\x8dgk^S`\xd4N5\xbc\x85\xf3\x8c\xf0\xfc\x97\x82\xbb\xdb\x95\x9f\xb4\xe4\xf7\xec%Qgd\x8e\xe4\xfal\xf8\x9fFd,}\xe1\xd8\xaf94Dq\xc3\x1c\xcf\x15\x8a\xec\x1f\xe4\xe3o\x82\xdb/\xa2\x80[\xcd\xa9\xbdy\x0fx,\xd5\x01\x02\xa5\xa2\xed\xb9\xcax\x8c\x18\xbcH\xebP\xc3\x8c\xbd\xa1\x1e,\xa8T\xb4_T\xcb\x93\xdf|\xa4\x87l>\xc5\xe0\x16\x15E\x1d\x03\x9d\x13X\xe3\xaai\xd5\x83%u\xd2/\xael\xb2\x02\x15\xf7c[\x89\x80\x10i[:\x81\xf69\x97JT^7\xc3\x1e\xa1\x80\x1eC\xe8a\x81\xe1\xf3~\xe0\xae\xe4(LV\x82\x10\xf1\xc4]$\xcdP\xf8\xec;V\xe0\x0f\x93\x0f\xdb\xe1Q\x94\x0dBdf\xf2j\xbe\xf3\xc4a\x8a\x17<g\xd8Z5\xbf\x09\xc7\xb3\xeb\x8c\xc9gs\x0cJ\xc2\x9c\xc8Z\xc0\xffl6\x03C\xe4\xa5\x97O\x1e\xa2\xe0SbN.\x9b'S\x99\xb2\xe4+\x19\xaev\xa9\xd2!~6\xbc\x9b\xf86o'\xcas\x8d\xbd\xae\xc2!\xa6\x8d:\xa5\x95<w\x11Th\x15\x9f\xb8\xe3\x0d\x1a\xa5v\x10H\xb0\xa5\xb4\x16n\xcb\xcf\xe7\xaa6\xedL`\x91&\x84\xdd\xaexE\xf3=p\x16n\xabj\xf7&\xc5\xb1\x89\x00\x7fQ\x06\x9dkF\xad\x1eS\x09\\1\xbca\x06\xe0\x1a\x80z\xed\x9c#4ml}\x03E<\xb2\x81N\xc1\xeek\x98Y\xb4n,\xa9\xc5T\xf7?\xe1\x12.\xfd\xa0\x05\xfa\x0c\xd6^\x19\x96\xf1\xe3PS\xe8L_\x85\\\xaf\xbb'TA9\x88\x7f\x92C\xcc\x8ev\xdcV\xbb\x97\xe4\xf4`\xed\x94\x0d*8\x9cX\x1f\xe1\x18\xf9ta\xc9\xb1\xda\x9d\xac[\xdc\xa0\xd0\xd7y\xd0>\xeb\x8b\xf8\xc5B\xc2r:\xd7I\xfcM\x07\x0a \xf6&\x88\xe4\x0e\xdb\xda\xd6\xba\xd1\xd3W\x1e\x81\xff3\xfd\x13\xf8\x09\x08-\x12#\xff\xa1\x9d\x8e\xcb\xf7i6\xe9\x09!\x08\x82\x12\x0e\xceg}}\xc7S\xd0]\x84\x9d2\xd7\xe8\x18\x16\xe4S>|\xae\x16\xf1\x0a\xfdf\x93\xe3\xa4\x12L\xf0\xd7O\xfa\x85\x1b\x9eA\x1d\xca\xab?\xbaU\xde\x1e\xdd\xda\xc2\xbb\xcb\xee\x8f\x0fk\xe7\xfc\xbdv\x01\x14\xcf\x90\xf1\x01s\xbe\x85WG\x04\xe2\x07qG\xd1Z\x9c\x06\xa0\xa5&\xae\x9e\xd2\xdf\xa3\x82x\x12\xec\xba\xeb\x12\xf5f@\xdd\xff\x92,%\xaf\xa2_vg\xe8A\x0f+\xec\xdeT\xb0J\xa2\xb2\xec\xa8\xd6\x94U(\xf9\xa7\x177\xfd\x11\xb9\xa4\xbb<\x98]\x86d\x1a\xa0\xfb\x1d}P!\xe2\xd8Q\xfd\xb6n\xef-<\x13l-3\xbfJ\x07\xce\x87\xa1\xd5\xa4\xd4\xdd\xce%r\xdaj\x88.a\xf4)\xb9\xf6\x0b_5YLr{C\x8f\x00\xa7\xfa\x9e\x17\xbb\xa8\xd3n\x0d\xa5\xc1n\x06#\xc38\xcc\xe2\xb7 BJ,\x15\xdd3\x12\x99b\xd40h\xd1)\xa9m\xdby`\xc7\x08$~\x88\xfd\xf8\x0bi{\xc4\xa0/L9\x81\x0b.\xeb\xb6\x12epu~g\x1d\xcd\xe8\x9c\x98\x14\xf9\x07\x97\xa05\xf9\xaaM:\xae\xf9~J\x87\xca\xe5\x972\x07\xbf\xf6\x1b\xe3\xd6\xe3\xb7\xde\xfe\xc9!bA\xd7xb\xe3\x1e\x9e6oe\xad\x9e\x90\xfdp\x94# \xff\xe7\xacg-]x\xf5D\x1bY]_\x9e\xbaK\x1c\xf8+z\x92\x1d\x03:R\x14\xbb7r\xd4_z\xc4ptxy>0H`\xcb=\x06~\x07\xb2t\xb8\xa7t\xee7\x87\x89\xe5Tb\xc6\xce\xa5\xb9\xberf\xf5\xf2\xa2|\x13<\x11\xfa/\xef:\x97\xe8)\xeb\x81\x1b\xd8\xb2\xba\xde\xb0\x0eW?B\xf4\x97\x09zq\xe8o\x02\x80\x8e\xf5G\xbe\xfc\x03J\xda\xcb\xab\xae\xab];W;\x96\x92\x90\xd3\x0et\x99\x82[7\x17I\xeb\x985\xb2\xa3\xe2:\x04\x8f\x9a*C\xcf)+V\xa2\xc4\xd9j\xad\xc0[\xd6\xdcy\\\xb4\xabfW^\x00\x81p\x0a?^\"\x83\xd3\xce\x04j&\xd7\x9f\xb4K\xad\xd1p\xd8\xdd\xecXmjI+\xa6/AbP\xfb\xdc\x7f7]\xf8\xf1M\xe8\xf2&\xd4\xcdf\xf0\x01)\x9f\xab@\xb3\xa9\xa4$\x1e\x85\x8b\xe5\xcd\x00\xdb\x9d\xf4n\x06\x0a\x09\x9e\xd5\xe0\x84\xb8-ey\x17h\x0fq\xdbb%\xcc\xee\xa7[y\xa7\\eD,k\xf6\xcd\xdb\xb9\x95\x85~\x0a\x14D\xb1\xbd\xc7L\x80\xa9S\xab\x89\xe3\xb6F]9\x8eY(\x0a\x07\xc9;mh\xd7\x09]\xb9\x03\x80\xc7*\xe1^\xbed\x82E\xcd\x81\xd0O;\xed\x09G\x8e\xc7\x8b\x80\xa2\x18A\x89\x97\xbe,\x8f\xf8\xe9|{G\x870;\xa5(cBV\xcd\x9aS{\x06\xc7\x04\x17\x15\xc3\x1b\xac\x89\xae\xf9\x9brAK|\xbfwo\x94\xbe\xdd\xd9\x8d\x8cjT\xd1%M\x05VCr+\xec:\x89e\x03\x1e\xb1\xaf\xdd\xec\xe1$\x13^\xe250{n\xbbz\xcc\x03\xc8\x8e+o\xd6\xf1\x1a\xb5&\x84\xbd\x94<\xeb\x15\xd5\xd8i\xae\xb4\xb5l\xb5\xb5+\x88\xd1Q\x95etw\xe2[\x1e\x19\x0c?\xaa\x1f%\xca62G9\xb6\xc6\x80P\xe4\xd8Sj\x02r(Y7\x94c\x99\x09\xe3\xcd\x89\x8f\xb6\xcbf\xdflD\xb2\x84\xfbg\xd6\xfa\xb5\x0em[\xfd{\x06\xc2\x84\xa2\x1cy\xc9$\xd4\xf7\xc6\xa1K\xe1\xa7=\xf89\xb7\xaf\xf9H\xe1\x14\x86(\xa7\xfc\xb4\x02\xc2\xa4\xa7i\xcc\xfa\x80v\x82\xe2\x1f\x93q*e\xe1\x1d2X\xb2/h\x14\xfc\x15\x0a_3N8\x09\xcd\xda<\x1b*\x92qG\x0eB\xc5\xed\xbd\x1c\xcdH\x0f\xa7\x13|\xbaG\x13\xf4\x14i\x97LgL\x08cH\xb5\x9d\x1db\xa4\x89\xac\x84C\xe3&\xd0\x1a\xcd\x9c?\x8a+6,\xe3\xd4\xb1\x80\x05N\xa2\xd0\xb1G\xd9\x1c\xcdmk@A\x93\x81\xa2\x14\xd8\xad\xe6\x09\xa9\x09\xad\xaf\xfdm\xf5\xb5\x17cy\xa2x'\xaf\x80C\xfa\xb0,\xbe\xf2%\x8b\x02\x91\xc8\x0f]\x16\xfa\xfb\xee\xc9\x11\xb8\xbd8\xdc\x01\xeb\xa9\xf3\xea\xec\xb8u/m5\x91W\x14\xfa\x09Q\x93\x18\x9a\xfc\xef\x03\x99\xf9\xeb\xb3\xfc1v\x82\xca\xa0\x99\xf6\x0e+$\xdf\x01\xd9 c-q\x906n\xcf3\xff]r\xc7\xe9o\xeb\x10\x92,\xa4b\xd9!v\xbfg%0e\xb9\xd3\xdbp\x9e\\\x9d\xc0\xf2h\xc1\xb3b5\xdd\xc6Bd\x8d\x89\x9b\xd3K\xb6\x8by\xcc\x0b\xf4\x96\xfe\x13`\xc6Gb\x9e\xe2\x9b\xe6\xb9.\xdf\x09\x03\xff\x83\x9d\xc4\"\xef2^\xfa\xfa\xbc\xcf\xf8Eq\x86\x08\xb3\x7f\x06\x86?P\xeb\xfb\x8e\x0ab\xfa+\xbd\xcd\x8b\x1e*\xed\x00K\x8c\xc8\xacr\xaa\xd0I#9\xca\x00\xf4\xbf|\x1dW\xe2\xeb*\xa3\xcb\xdc\xd86{\xd9\xe6\x91\xecS\xcc\xa2\x03\xdb\xfea[\xfe\xf5U\xa7\xbd\x8d\x87i6a\x874P\x00\xd1\x1c\xfa.[\x90Gf\xcbu\x8dpG\xb5\xb8\xfb\xaf|=\xb8\xb7\xb5\xcb|\xba\xe9\xde\x96}\xa9S4f\xbd\xbc9A:\xa5\x04\x94\xd1\xe4\xe0_\x13\x15\x0c\xe0\x8e\xe1e\xc8\xd6\xfeb~\x16\x05\xd7>\xc8\x90\xa5\x17U\xd2\xd84A\xa4^\xacv\xcc%\xd7\x82\xbe&>#\xeb\"[u\xa0\xcc\x0bD\xb9:p4\x1e<\x07\xfa\x0b\x8do\x03\xbb\x8a\x13-rL\xbdLp\x93\xb5v\xee#\xd6\xd57\xebr\xeap\xd4=\xe9u\xc2\x18d\xd6.\x9b\xbd\xdb\xaf\xdeR\xb99]\x08p\x07.\x14\xef|\x86\xed\x98\xd7F\x8c||\x18H\x9em\xa5\x0f\xffp\x1b\xf28\xaeQ\xf9\x1eE\x08t\x19\x9f\x8b\xc8\x00\x0d|\xdb%\xdd\xdb\xbd\xd9\x95\xe0\xd1\xbf'\x0b\xd1\xec\xff\xbc\x9d \xaa\xe54\xbbH\xb6\xc5\xfaB\xac\x1eD\x1b\x0d\xef\x172\xbd\x90\xc7\x97\xc6M/|7\x11\xebo\x9bV\xbb\xa0_#\x85\x83\xd6\x10\x10e;\x94\\\xe0*\xe9\xba\x17\xda25\xa50s!\xa0\x14T\xf5g\xb1\xe0\xc8]d\xae\xe9N6|9\xd8\xdd\\\xf3\x1bN\x04\xa7\x19\xee\x05\x8d\xff\x90i;/\x9b_\xe5\xd1<\xef\xef\x14\xab\x05\xf6\xb3\xc0\x0a\x82\xde\x9co\xb9{\xc4>)\xffv\x05\x82|\x8e\xa3O\xf2\x00%Sx/9}`Z:\xc5\x8c\xe9\x8d\x87\xd0H\xaa\x92\x95\xd0\x1e1\xff4_*\x0c\xd6\xbd\x80%\x05Hd\xc8\xdc\x8aj\xdc\x07\x07\xff\xeaK<E \xd7\x89\xc9\xe0tO\xc9S)=Z\x86\x1eN\xa9\xa7\x1f\x06<\x12x0C\xc2\xac\xb8\xea\xba\x8e\xc9=\xdePS\xe0\xb8\x86U\x1f\x02\xdaV\xa0=kN\xd4\xe5@\xd2\xbd\xec\xe4F\x80]\xce\xba\xdd\\\x9d\xf4\xac\x12)\xbd\xb5_\x11\xf5l<\x95\xefWHu\x1f\x8d4q\xde|kW}\xaf\x87\"{Z\xd1=^\x91\xca=\x0f\xa3\xbb\xfb\xa4e\"F\x0da\xa9S\xbf^F\x01\xa3N\xf3\xddH\xeb\x9dS\x97a\xbb%\x83\x91m%Z\xe3\x8b\xf7\x85HG\xae\xc3\xd7\xad\xba\xf8]i\x03\x1c\xd5\x8c\xf1\xcf\x92V\xfa7\x19\xe29`\xa1\xfc\xa7\xe0\\+j)_\x06\x9a\xa8\xbf\x9b\xad\xe2\xc8\xdb\xcab\x8f\xb9*\xe0\xb5s~S5\xc3\xb7\xfa=&\xa6\xb5\xd4\xdfmG\xc2\xdf\x1b\x0f\xab\xbd\x97\xf2\xf5Ay\x98\xe8a\xaa\xf4se\xac\xe1a\x19\xe2\xac1\x81\x97lR\xc8\xc0>\xc1\x17\xd6}'\xcc\xe9dJ9\xc7\x15sG\xed\x02_\x8c*\xaa\x18\xb8FO\x94M\x15\xb9\xa7o.S\xa7\xb7\x96\xd7E\xdf\xa8\x0a\xf1\xd9#\xc0fl\x1b4k8\xc0\x1b/0\xdd\x9eg*\xdb\xf7Y\xeb(}\xb2\xb5\xd0\xa0]\xb9\x0a\xc9\xe1\xb4d\xa6\x971a\xcd'\xae\x93\xd9P\xf3\x86D\xf6W\xd2.\xea\x05\xc8>\x85P\x0c\xdf\xf5\xd9\x16\xd3\xc0\xc7d\x0f\x82]\xee\x07\\y\xed\x99\xbc\x9a3\x0c;\x9e4\xd3\x8c\xa9\x91\xe4\xef\xb6\xeb\xbc\xf0\x04\x0a\xa9\xdb\xdc)\xf1\x0d\xdc\xce0\xcdt\xf2\x0dn\xc1\xb7^\x06\xde\x19\x84\x82\xbb\x83\xf5\x7f`\xb1m\xf1ou\x03\x9e\xb0\xd5\xe0\xe0\xecdr?/\x14B{\xbfT\x169\x7f5s.\x85\x9dT{\xa1\xc7\xb4:\xa0R\x91\xf7q\xbb\xcd(\xd5\x03\x98)\x12u\xfcm[5\x7f\x9bg\xe9\xac\xd2\x07z\x0ek\xf2\x1a\x19\x0e\x8a\xe7\xe7\x0d\xb6fC\xb8\xe0=V\xc46Y{Q\x1b\x0aU{\xf1t\xe2\xb6\xdb\xdb\xf2\xec\xd1\x97\x02a\x1d\x12JC\xf2\xb33\xf5\xd7\xe9A_\x95\x0c(\xaa\xa1\x0e\xa7\x06\xdc\xab\x07\xcb\x98\xa3\x05b\x0e\x9evfGq\x87\xe8\x9b\x83)gz#\x9b\x14/4\xfb\xdc\xed\xc8J6\xce\xa7Q\xd2\xb2C7\xed\x8d\xb5Y6\xefw\xf9\x0e\x8c~5B\x85o\x9b\x9d\x00\xcbw\xfc\xbe\xd5\xd7\xb8\x97\xce\x09M\x91Ql\xdel\xdcc\xf7\xf5[\x0d\x0a\x1bdZ#\x84Y]\x0a\x09\"m\\\x0f\xc9\x94\x90+\xab\xe7\x07\x96\x1f\xe0\x0d0+\xb6\x8b\xf8\x86\xd3\xe2i+\xf8\x96\x80\x96\x9b\xca\\\x97\xa3\x8b\x9a\x99\xda\x99\x98o\xf2\x95e\xd3\x11\xab\xa3\xaa\xdf]\x1a\x09\xcb#\xe8\xb1\\\xe9Z\xb6U\xfe\x00\x0a\x1985<0\x05\x93\xbcV\xa5]`\xc67K\xf6\xce\x9fzp#\xbb\xf3\xd1\x91\xf1\xbe\xb7K\x8e<\xa8X\xbc\xa5i\x0f5\x10\xf3l\x16Fz6\xd3~\x17\x85[\x88\x8a\x97\xdd\x88\xd0J\xe4=\xd0\x0e\xb39\xa6\xeaa\xbd\x95[\x87\xbb\xe8\x9b$\xe4\x7fV\xcdZ\x98$\xda\x8a\x91\x93K/\x9a\xc8\xf2H5\x03\xe7e\x9b\x8d\xcdF2S2\xaf\x98\xe1(\x0f.\x7f[w\x8bM\xfdYqkAr\x0e\xfb\x89\xddHw\x97Y<+o\x1cynl\x11\xbe\xf4`\xa6\xbeng\xb2\x96\xd7\xd9B\xad)\xfd\xfd\x97\xe5\xe2,'\xeczU'\x19\x1fL\x1e\x1f\xf08\x08\x84\xb3\xdbJB\xb7\xb6+\xeeTw]\x9d\xaf\xee9\x86\"\xd7\x90\xa9\xd8\xe5Q\xb8\xaa:\xd7#\x12\x1a\x9d\x8e\xf8\xa7\x9c\x8f/Gx\x9e\x82\xdd\xbc\xbb\xc9+\xa3\xbe\xc7\x17\xce9\x96\xd1\x7fc\xdc\x9dd\x85\xe3\x08\x9b\xa1\xb3\x8c\xaa\x1c\xb8\x84\x18\xaav*\xdd\x87\x98&\xda\xb9\xefP\x07\x92\xb5\x04\x93\x8d\xc6c\x7f\xfb\x1e\xe3\xd8\x96\x1d\xb4\x990\xf4\xafm}B\xc2\x1dj\xe3K\xed\x1d\xc2vY\x12F\xbd\xbb\xcb\x87\xf4]0\"[\xc9\xbd.d\x0at\xdc\xd6\x99\x09+(\xac>\xa0\x9c\x9c\xab\xce\xd6K^L$'\x1c$\x99\xfd\xf2\xa5\x80\xb3\x9cK5\x9c}\xad\xff`\xa2*A\x07q\xbc\x84to\xed)E\xa0$S\x16\xa5\x1al\xa9\xfd\x12\xd1\xe5\x92\xeai\xab\x00\xc2\x18\xafg[E\x0d\xd9\xe6\xd8X#\xac\xbe/\x087\x97\x19\x0b\xd5\xe0j\xf1\xc2\x0bSf\xc5\xb2\xe6H\x1b(\xe9\xa9R\xda\x9d\x8c\x92\xb3\xca\xfb\x8eU\x0b\xe9\xdf\xeb\xb6\xf4\x12\x0b?\xdc\xf6\x19\x1a\x1dK\x82t\x01;\xaeI\x05\xfa\x09\x9d\xe6$&\xe3/\x19.|\xa8u.oz|\xd7\xb0\x82\xbc\x04,S\xae$R7G\x18\xd3\xddh\xab\xb3\x15q\xdd\xcf#\xab\x12\xcb@O\x85\xe4\xec\x94\x04\xea\xf0\xf3G\xd2::\xc5=\xce\xfc\xd8\xaeD\xc2s/\xd6|I\xa1\xaesd\xfb\xb6*#r\x1e\x13G\xe0\x93$\xb7y\xbc\xfd\x9b\xcd\xed\xcc\x01\xadRC\xf8L\xb5\xb4\xa7\xc0\x02\xf0\xdf\xc4\xd9\xc3 &\x05\x88\x0f\x93\x8d\x04\x89\x84\x07n\x02i\xde\x9a\xb7\xa0\x80\xbam\x88\x0fc\xaa\x1bD2\xe1\x93\x0e9\x9a\x9e\x82\xda_f\xd0\x97\xae\xed\x97\xe4t\xed'\x94(\xfb)M\x04,\xdegYV\xe9w\x93&,\xa7\xca\xe1\xeaU\xace2\xa6\xb6q{\xda\\\xefP\xd7\xf58+\\\x02\xc0\xc0\x02Q\xf9U\x06~!\xbd\x01;i9\x10\xdaV!\x8e\xfd\xd9\xce\xe3\xc1\x86kp\xba\xf9\xec\x8aE\xac\x00\x81\x91\xdb\xba\x9d\x0f\x8b\x1c\xc0\xe6RB\xba\x8f\x1b\x82fQ\xb3I\x94\xb0\xd6\xdaL\x09\xa9Y\x14p\xf7\xd0\x16\xe5Q\x9f\xf9)T\xe0\xb1;S\x1e;\xc26\x8c\xb2\xd8\xa9\xe3H(\xb3\x19|$\xc7\xb6@\xe6\x91\x9a0%J\xc1\xfe\xafb\x02\x93$-\x07\x8e\xea\xff\x01\xcd\xdd<4\xa9E\x09\x0e\xcd\x0fJ\xddtfp\x1c\xd4\xfa\xd0K\xbem\xde\x0b\x1c\xda\x17\xb9\x11\xdad\x0c\xf1:\xe9\x98\x86\xd9\x13.\xcf\xd9\xffg\x17\xc870#\x0ay\x0bd6k\x86F\xa6qA!!Io\xee\xd8\x8f$#;\x95\x09\xca\xeb\xb2\xea2%v\xb8E\xdc\x14\xcd\x18E\x84Cw\x943.V\x7f];uel\x8b_'\x9e>=\xad\xd4\xc2\xa9\xfb@w\x02!\xf9\x01\xe7a\"\x182\xe3\xeaM\xa3\xfe\xcc\xf1\x1b\xbfA\xf9\xfd\x93\xd7}L}\x9f\x15H@k\xa0S\x1d\xe3\x99\xac8 \x0f\x0d>\xc4\xdf/\x95z0\xf6\xde4/\x1a\x89\xc8\x8d\x0d\xf1\xa6\xc9I\xb1m\xa4\x9b\xe8\xc1\xfc\x14\x0a\x8f\x1c\xd3\xaeDbNo\x1b\xc8\xdd\x83\xd9\xa07\x9ak{v\xf3\x99&\xf8D\xec\x8f\x0f[\"h\xe2\x1fs#J\xa9j\x02&\xc4\x8c\\~!H\xc1\x1a}\x99\x02n\xe3y)\xa4\xcb\xaa\xac\xc5-\xb8K\xd1'UI\xed\xf4P\x94\xc9\xfa\xe1\xbc\x09\xeb\x00l\x17m\x05X\x86\xe0\xbd\x97\x05\xc1Y_\xe3\xb2\x06\x80\x91B\xd7mp\x10\xbe\x89n\xe6!\x0b\x93Bl\xf7\xa0\xe9At!!]\xb5o\xbe\xc7\xbb\x94y\xf8Dvqe\xe2\x88\x02/Y7;" +
+	"\xcf\xecN\xed\xach\xc3\x98\xfa\x8b\xa7\xcd\xa4\xd9\xa0V\xd1\xd7\xa3\xdal\xb1\x9f\x13\x09H\xf3\x0a\x08\xf1\x10\x97\x9c\x7fT\xcf6\xe2\xa8\xc0@mh\xc4#\x82\xa5x\x95\xb8\xcf\xadY-+P\xfbMz\x8c\x19\x9c\x84AF\xb8\x9e\x9b\x80\x09?\xf2\xd4*\xd1\x9cdS\xe1\xa5]I\xf8\xea\xba\xd2\x8f\x9co\x92[\x83\xfe\x7f;m\x9eK7\xe6\xa5p\xe6\x19}\xf3\x87+\xdf\x0er]v\x14\xa8\xdb\x056\x16\xce]\x13\\a\xc0F\x9f\x059\xfe\xe6(\x9d\xcf$\xe2\xaa\xec\xc3E\x1f\xe3'\xa5\xd60Q\xc8\xe0\xe2gk\xc7c-\xa5\xf7\x12'\x0e\x85\x1d\xaf\xf4$RN\x14,\xc3B\xb8)\x97\xaeb\x186\xdd\\F\xd7\xef\x1e\x03\x9e\xd4\xc5?\xef\x05<\xa2\x1aA-\x81\xae!p\x81_\xb9\x10\xf7\x7f\xdc\x1fx\x16\x13~s\xa3\xf1\xa6t\xbd\x8c\xbaQ\xbe\x06F\xf3\x0c\x85\xcc\x8d\x83\x19veY\xa4F\xe6\xd0\xdb\x95p9S=\xca\xd8\xb6p\xa8#\xaf\xbc_$\xd1d.\x11\xac\xff%\xd0\xa3\x81\xd6\x90V\xafu\xb1\xa8\x87\x1d\xff\xd6\xae\xb3\xe7\xe6\xadt\x97\xd7=\xbc\x9a!\x83\x02\x93i\x97\x8a\x18P\xb7\x8c\x85\xe5\xa1\"S\xfc\x89\x05\xd4|\x90\xad\xb1\xac.\x1epKU\xa0\xf9\x1fS\x0b\xdet\x14\xcft\xf3d\xe6pU\xd1tlS$\xd7J'%P%\xa9\xdd\xab7[E&6\x9f\xed\xd5\xc2\xf2\xc2\xe0\xe7j\xaam\xb8|\xf6\xc6\xb7s*\xb8\xcdE\x7f\xec\x0d<\x11\x9d\x07I\xb6\xb8\x91[\x88\xa6\x90\xcf\x1bc\xd0\xdb\x85+k\xdbv\xd0\x9ft\xce\xa1\xe3!\x12<%\xdf\xd4\x91\xfa\xfd\x87,s6@,\x04\xed\xde\x94\x12YWF\xfe\x8f\xc1a\xd1\xc6@\xf8\xd6\x9f\xec\xceN%[\xff\x9c\xb6\xcf\xef\xbdr=c\x09\x83\xbb\x1e\xb8u\x8a\xe2\xc5n\x1d\xf3\xfa\x9e%$\x99\xce\x09\xb1\xf7\x11%\xbcIDj\xc9\xb29\x85\xe8\xdf\xb8\xd6\xe2\x86\xb7\xe8J\xaeK\x0fR\x11K\xa3i\xd6\xd5\xa0BR\x00\xb1S2f\xd3\x1c9\x13\x92\xfbg=K\x97\x8c=-\xe1\xaa]F\x96\x8d\x9e\xa3\x87\x9aa\xcf\xcf\x86\xcd\x12\xdaF\xcd\xb2;9\xa0\xff\x86h\xc4\x11\xaa\xcdE\xdb\xd0\xa9\x8eu\xcd\x9fW0zn\xde\xca'\xbe\xf8\xef\x19\x86+\x96\x9a\xdf\x05\xe2\x157\xf7\xffM\x82\"\xdbh\x8d\xa7K\xf4\xa5d\x19\xe2\xb1\x8f\x1cs\xf3\x9f\x1f*\x90\x98^\xb0sY\x14\x7f/\xb2\x0e\x14\x87\x81.\x87}\x0a.:\x9cB\xcd~~\xd5C2\xebS\xccK\xf8\xf39\x86R3\xdb\xec%\xa8[U\x8e]\x82\xde\xc6rrb\xde\xe4\xb4D\xc2@\xf1\xea\xfc&G\xe1H\xa06o\xd6~\x06\xb9\xd8,b\x9e\xb4\xd7\xb9\xd9\x04\xb6\xf3w\xe9/\xfd\xa1\xf9\xf8\xecP\xce\xb3\xb9_\xe5\xf4\xddi\xac7\xcd\x0b\x9f\x80s\x98\xb5\xa4\x9f\xd6m\xedO\xda~\x95\xf1\xf6\x19}\xc6`\xd1z\xdd\xb6s\x92\x03\x91`U\x94]\xeb1n\xa8{\x91W5\x98T\xa7\xf5\x96\xab\xc2e\x17\xa1\x06\xd3\xf5\xae\xd9\xe0\xc5E\x1eH =\x19[\x85\xe6A\xffF\xd7\xed\xb2\x9d7\xaf@\x04\x01\xc3*\xb0\x86\x1db\xce\x95$\x95\x0f\xedj\x9c\x0d\xaa\xf2\x0f\xc3k\x02Uj?\x82x\xbb\xbe\xfdh\x0c\x97\x1d\xb2\xaf[\x92A:4\xfb\x82)\xb2\xd4\x12\xa5\x15\xd2\xdd\x84\x1d\x9b\xfc\x0b\xccqUg\xb9\xb7\x02\xd8c\xbb*s\xad|)<\xaar-\xa4m!0\xbdX\xa0\xd0|\xa6\x9b\x16n\x8f\x13m\xc5E.\x95\xd5\xac\xd9\xe1\xb01]b\xfa>\x93_\xf6-\xaf\xe1p\x01\xa8+2\x1dO\xa7U\xb3\xac\xcf\xacKL\xb6k^)l\xacq^\xe1\xf4BB\x83\x92\x97\x1d}\xadgF\x91\xd8'zG[\xd7\xb6\xe2wC\xbe\x1f\xa3+=\xb1jrq\x1e\xd7\x97\xec\xd8bV]9a\xdf\xce\x0d\x85\xcd\xee&\xf8\xea\xf6\xe2tJ,\xb94\xf89\x99\xcf\xbdQ\xfd\xe4H\x9d1\x9a\x98\x8bx\x7f\x13_v@\xbag\xe6\xa1\xb0n\xfe\xbcK{\xe0\x9fur_\\\xf6\xaa\xadI67\xcd\xd9'Gd}@\xbf\xb6\x8a\xc0|4O:\xd7\\\x0b\x8a\xe7\x9a\xe2\xa8\x90\x99\x99\x92#\x89\x87P\xe9\x1d\xfcur\xd7\xf2\xc5\xd5\x9f\x84\xfb\xcd\x83\xfb\x18\x8a\x00\x8a%M\xf3\xe7\xb19XgL\x91\xe4e\x08\xc1\xeby\xa0\x04y\xa9\x9b3f\x95b\xca<=\x8e\xdf\xd2fA\xff\x05\x93\x90A\x0dSkF\xdc5\xdf Y\xdd\xe7\xfb\x9d\xc6\x01\xd5l\x10\xb7\xfa\xa6\x92f\xabK\x95\xf7LC\x8fZ\xd9\xf39\x05\xb6\x8c\xc32w\xb1\xcb}`\xf6\x95\x9bQ\xaa\x11\x02'2\x96\x9d\x9bb\x04.77\x1c\xfc\xee@nB\x06\x82~\x03\xc5\x85e\x15\xdd\xfa\x01\xbd\x83\xff^\xab\"\xd8\xc6\xe9K\xfc\x10{\xb4\xbcv\xfe\xd5\x08\xa3\x13Y\xa5J\x98\xee9o\xa2%QN\xd2z\x07\x098\x19\x09\xae\x9e\x02\xa5\xb4\x1e\xb5(\xf2\x83\x1a\xf5R\x86o\x14\x1a\x10#Z\xadD\x03\x18\xba\x9eK\xa4\xb9\xee\xc5\xb9\xd9Pmmn\xce\xb3q\x147\xafTUg=\xd7\x1f\xa6A\xc3\x82\x07a\xc2\x82\x96\xdb=\x85@\x97\xe7ZE\xe5\xd9\\\x05\x8c;\x12\x83\x06@\x9a\x172|\x9e\xeaI=F\xea5\xdb\xc6\x04L^A\x04\xf8^\xf2\xcd\xe9\xe2\x87\xe6\x8d\xff4T_=\xf1\x85\xe9r\x8d1\xd09\xad\xda\xdaDO\x0c\xc3[O\x84\xee\x19a\xc4#\xe2\xe3I[\xb5K2\xfdf\x146\xb4\x9aVV'\xe1\x9f\x93\xc4\x9a\x94\xbc\xb7\x8c\xb8\xc1\xd3\xd2\xb7qa\xb63\x1e\xc9SP\xe2\x97\x9d\xfd\xe5\xad\xf9\xbb\xad\xcc`\xa5\xfb\xc5\xb5\x13\xe8t\x91y+\\\xb3\xc1O\xd9\x1bc\xaaU\x8f+]h\xaf_G\xa2V\\\xd3'\xabK\xcc\x1d\x94R\xbc\x00\x1e\xc2\xa9\x0c\x8c\xbb\x03\x18\x95z\x84i*\xcd\x93\xb66\xe0\x00\x0ali\xc4\xe7\xc9\xd4\x01\xfe\xf4\x17\xec\x0c\x1d\xf1f\x9c\xcc\xf7rLE\xde\xd0\xcf\xf0\xec\xca5\xe2\x08/+\x11\x00s\xbe8\xbd\xfb\xb6\"\xe7Ko\x06%\x09\x11\x11?\xb9a \xd0X\xe5\xb3\xc8x\xe71\x96\x07T\x98\x962\xbfj}\x1f+n\xa8t\xbc$\x01\xddY\x09&n\xed\xa0\x05]\x0cA\x89\x83\xca\x07,\xd2\xb7j\x95\x9d\xb7p\x1b3WR]\xb4s\x11\xe1P)Y\xdb\xf5\x10\x1f,\x95\xf2\x97s\xb63\x95\xa0\x9d;wpM}\xf73\x01\xdbu\xa0\xa1`+/\xd1\xc6^\xe6=k:\x96|\xee\xb6j^&\xccTG\xcf\"\xd1K~wu\xb8;\xb86\x02\xa0\x9bB\xd2\x87;q\x19\xdd@\x17\xe0\x95|\x1a\x8e\xd51Y\xb9\x89\xae\xa6\xa5]Q\xa6\x9a\xec!\xb3S/\x94i\x97\xbbt-\xda\x9b\xb4\x95\xefY\xd0l(Pr\xd25\xb1\x984o\xed\x92\x91\x0b\xd6 ]Cm\xc7.\xd1\x11[\x1bd\xcabED\x99\x1d\xe07\xff+\x04#KvHV\x01\xe3ldI\xb6\xf1\xae\\]\xa9r\xb0V\xb9\x80 \xe8\xb9\xa1pj\xa8\x1aG\xb7 \x8d\xafLLS\x16\x86\xbd\xfa\x12 X\xd0\xc9H\xcbS2$+vs\xb1\xe6{\"\xfa\x8dO\xb6d82\x07O\xe4\x92\xcdo\xd0z\x8b3n\xcc\xcclY\x87\x12\x80F\x9aub\x14\x8e\xb8\xfb\x84(\\`(\x9f\x1c\xdc\x9e\xaa\xf8ru\xde\x1f.\xff#<\x0d\x12d\x91*%4\xc0C\xc7\xa69\x08\x8a\xe2U(\x0f\xee\xdb\x8a\xab=\x9cL\x93~&k\x7f\xb1\xba\xe7p\x9b\xed\xd5E1\xba\xa4I\x10\x1a\x1a\xb5\xfb\xc5d\xca\xad7\x94\x9c\xfd\x0d\x90\x89 \x02ZY\x83\xeaRxBf=\x1d\xa5\xe3\xdd\xa4W\x91\x8eQ\xe9\xc5\xe5\xcdn\xa8\xfd\x99\xf3eL\"\x18\xee\x00\x0c\xaa\x7fy\x17\x1b\x06\xf2\x9d\xcaO\xbe\xea\xb8\xc1g\x96\xce\xae\xfb\xa2\xab!I\x99\xabXlD^\x17\x1b\xde\xac\xad\xf9\xcf\xbaK\x9a~\x02i\xc0Uh\x05 wNi\x0ez@8[\xe3O\x9bi\x02>\x00z\x82\xdc\xec\xdf\xe0\xf1\xa0\xd60\xf4\xeft\xbcN\xb9\xc8\x89\xba\x00\x8e\xae\xabW\x0e\x17vg\x9b\xecf\xb3\xb3{\x94\xec\xb1\xf6+=x\x95'\x81Q\xcd\xdb\xa9\xdc\x96\xe5\xbb~\x9b\xd6\x8a\xae\x86\x9f\x18O*8\x06\x86\xe2\xf0L\xbet\xdf<\xb5\xd3\xb6n~\x0d\x13{UY\x0f\xb2\x8bb\x94\x1f\xc1\x19\xd3\x12\xd0\x9aC\x8amHu\xd2\x0f\xec4\xaeV\xa4[\xd5\xefv^\xbaG\x8f\xd3\xc4f%V\xfe1i\x17u\xbaS,\x9bHN4\x9b\xb07[\x13\xccu\xa3\xf4\x19<Z\x14*0\x88>M\xed\x11\"\xaff\xedN\x84\xd0\x81\xe5\xc4C\xcd]J\x86\xca\x80\x9a\x93wy\xaa'z.\xec\xab\xc1\xfa\xba\x1a\xa4\xf3aq\xb8\xee%\xc0\x8e\x0e\xd3v\xc96\xf9\xa3]\xa4\xa3\xfdFi5\xa1\x7fq\xab\xc4ZL[\xe5{\xb3v\x87\x85\xbef\x16`4&\x13\x0d\x87\xa7q3\x12lk\xdf\x1e\xe4\x17\x017\xe0P\xb8F\xf8\x8dA\xb2\xad|\xb30/\xd8\x98*qOFQ#\xfd\xf2\xce\x9bA*2\xdd7\x1d\xd7P\xc2m\x89Q\x9b\x18!\xd9\x96rK\xcdV5\xc6\x10\x10\xe0\x9f\xf3n\x83\xd9N\xa8\x9c\xfc\xc3r\xd1~;H\xeb\xf5?)a\x08J@\x97\xf7\xf4\xb4\xf4\xfaQl\xb06\x00\xee\x9aW\xdd@\xd2\xaf$\x06\xd9\x11\xa8\xa4\x1f_u}YH\xf1c\xe5\xb5Kr\x1e\xbfw\x88\x00\xa5srJM\xfc(\xa5\x10\x81\xb7b45D\xcd\x92\xf3;\xf2\xae\xabv\x15v\xde)%\x96\x9e\x9b\x17\x9c]\xac\x98\xcc\xbba\xda\xb1\x94+\xbc\xd7\xdb\xb0\xc8q\xc7\xae\xbd\xda\x13\x88\xdc\x88\xeb5Oy\xa0\xa0}-\xacs\xc0\xd9\xfd\xe6r#\xf5\xbaT\xfd7q]\xa4\x9c\xe85\xf7\xde$\xfc\x03\x83\x8f\xe6\xd5\x86z\xd2\xc8\xf65\xdf\x9a\x17\x9e\x0f\x0c(Q\xee\xb6{dA\x98p-9\x88\xc3\xc4b\xa8Y\xbf\x94\xb2\xa0hz\xaf\x9b'\xbc\xf55\x85\x19O~h\xdb\x9a\xebf\x0b\x8c\xc1\x8f\xa2[\xe9\x98\xc2\xa2\xf4\xe9\xef\xd0U\x8a5o\xb6\\L\xec\x89\xb6\x9c\x0f\x1f&\xed\xaaBoh\xb3\x93\x8d\x0d\xc6\x81^\xc2\xa6]\x09F:\xb0\xf0\x99\x91\x93\x90\xd4\x0c\xbd|b:\x0c\x89\x89\x9b\xa3\xecGCs\x12\xe715\xf64/\xc3\x94_S\xcf\x18\xfb\x15sv\xaf`Y\xac\xbe\x0fvz[\xb2hK4\x02J\xa3RE\x8dw&{\xffLI\xf6\xef\xfe943\xcer\x9d\xce\xd7\x0a\xcc\x0b\x12j\xb5U\xf39\xca\x04F\xe6}\x0dC%\xd2\xb84\x85~\xa6\xa4\x0cm\x04<\xa6\xaa\x91\xb5\xa5\xb6h@|y\xd6Ck\x9c\xe8\xf5\xec+t\x0b\xf6\xaf\xcc\x9bl7J\x17\xe2f\xcd:\xfdEcH\xb3f\xef\x0a%G\xda\x88^'m%{\xd0V*+\xef\x94\xe6\xee\x10\x07\xb1Gx,n\x8b7\x17\xfd\x8c=\x10H\xde\x9a\xa4A'\xc1\xc8\xf4\x199),R\x1dQ\xdd\x14Y\xa8\x89PL\x09\x9a|\xc5\x92\xf1\xd8/\x1f\x0b>\xd8\xcdew\x961\x88\x98 T\xd92H\xd7H\xab\xef\x18\xf4~3\x8a\xcaZJ\x8e\xa6\xd0<:m\xae\"\xf0&^6YMiV`%qL\xe6\xc2\xa8_G&\xe0\xec\x19\xd6@\xb9\xe1\x01\x0d!\xcfu\x96}4\xe2\x06\xd6\xd3Z\x10\xe89\xb2\x86a\xd9/Xqi?\xcc\"\x15\xc3\x83hy0F\xb0,4\xbd\xd4\xff\xfe\xe9q\xefi\xef\xa5\x08Q\x8b\x08\xfc(\xa6\xa6\xfbQ\x1a\x9f\xdb\xc8_\xd1\xfcE\x8f\xaeD|\xed\xca\xe8\x98\xd4\xa2T\x19\xf7X\x1f\x144\x07\x9e\xf7\xf8L\x1e,\x88hrG\xbe>\xad\x81\xe63]:@\x83wB=\xdeq%h/4\xba\xdc\x9fm\x8d\x7f\xac]\x86\x96\xceC\x1e&\xe5?\xdfK\x8b\xe0\xaeg\x99\xca\xe6p\x0c\xc4\xdfnN\xeb\xfdK\xbe\x8f\xf3\xce\x0f\xca<\x11\x9ar\x96\xcd\xa1p\xf9\xdb/\xf1\xf2\x93@B\xce4X\x96\xdcYS@\x1e\xea\x1c\x07\xe0H\x99\xbfq\x98\xaa*O\xe6\xd0tS\x93\xcf\x99'\xd5\xa2]2\xd7\x94\x0f\x0ffp\x02H\x10bk9\xf0-\xd4\xe7\xba\xdasZ\x8a\x9f\x83\xd9\xd9\xbd\x9a\x04\xb1\xdd[\xb2F\xf7\x0bM\xa9\xb7\x0bLc.\xe8\x09Y\xb04>5\x0f\xb0\xa0\x95\xc9A\x00\x97\xb0m\x9el*\xf8\xcf\xb6\x03n\xe5\xed\xf9\x09\x1e\x9d\xa3LbY\x1f\x95\xef\x07%\x88\"\x8b\x16\xc8\":\xda5t\xc5=R\xea\xee\xbbV\x1b\x1f1L\x8bvIl\x91\xfbab-x\xd0\x14\xec\xb2\x9e\xb2X(\xba\xe1v\xe5c\x06_\x8b\x01/\xca\xb14C.;qm\xbb\x90\xf6c\x17\xd7\xbe\xd3\x19\xb6\xffn\\k\xb4\x98\xed}\xf3a\xbf^PZ\x8c\xaeR\xc9\x834\xaf\x00e<\xe4\xc8&k\xf3\xad\xfe\xa4Gp\x01\x95\xa2a\x82\xad\x98\xd3]\xb7\x950\xad\x8c\xdde\xc6\xdc\xaf\xe8\x07\x82\xfag\x8d\xeek2PB\xd9bG\x1fY\xc7\xa5G\xad~\x90\xf1\xed\xda\xa94\xdd\xc31\x880\xa5\x0c\xecb\x0d\x13\x9aq\x1dj\x07\x1e\xc8\x84\xdd\xde\x14\xd3\x01<\x7fh\x19\xa7\x07\xc4b\xdf\x09\x9b\xaaF\xfa;\xfak \xd2\x87\x1d\xc4\xd1@\x8cH\xec\xa9DUK\xb1\x19\x941\x11\x82f\x18g\xae\x0en\xbco\x91\xfe(\xef\x12\xb7\xff\x0b8#S\xfc\x84\\\xc0k{\x0fC\xf1\xe6a\x9f\xe9\x0a\x06>\xe2\xa9MTF\xc6:\xa2\xef\xaa]\xa9\x88\x89\xf6!\xb838\xb4\xe8\x03\xbf\x86'\xfe\xb1\xeb\x05\x9a\x181=\xa4u\xee]\xc9d\x9b\x8b\xb8Eb]\x07\x80Z\x17\x1d\xb5\xdb!8e[syb)T\xf5i\xd4\xef\xf1\x86\x97\xda\xb2/\xa9\xe1\x1f\xd2\xa6\"\xfb\x83\x9eLQKUL\xee\x85\x8f,MUq,\xe7\x1cV\xb7a\x0d\x99\xd0\xc0\x0b\xf6\xe7u\x9f\x90xf\xdc\xc6$\xddhaO\xb8;\x1b]d<\xf9\xdbi{\x8f\x9e\x0f\xc7q\xc5oV\x80-\xec\x00\xd0\xaaA\xb9D\x93t\xcd\xcft:e\x9f\x10\xc3thW\xcd'\x16\xd3\x81*\x16K\xfc\xcc\x83\xf6<\x92\xb8\xd9\x0e$#\xee\xceG\x16\x17]\x8c\xab\xe2\xaf\xb1\x1a\x16a\xa0\xc2:\x9eVb[\xb5+\x0d\x1f\xe9\xe8\xe6W\xbb\xa2U\x90%\xfa\xb7\x1d\x05\xb4\xa1\x9d\xe1\xee\xa2X\xeds\xad\x01\xa2)%TFjk\xa9\xb2\xd0l\xd2\x91\x93\xff\x02mEG\xad\xdaJq\xd8x\xfaCz\xab6\xb9\x02A\x0c\xe0&\xcdv\x98\xa5\xeb\xa4\xea\x85\xf7\x04\xfe<\x9d\x15\xc5\xba\xff\xb7)\xa7\xbb\xab?M9Y&I} %\x92\xa4\xafv\x93\x81\x14\xd3\xdd\xf5H\xd3\x83\xb6\xace\x04\xc9\x95c\xab\x1f\xe8t0V\xaeA\xfae\xc4O\x9d\xeaL<\xb6\xf7\x8bf;\xb0\x0d:\xb6\xae\xba%\xad\xad\x10;\xf6r\xc7Q\xd4~p\x1e\xba5!n\x01\x03\x85\xfd:\xf86\xf7Cix\xefN(\x1b\x8dW\x8a\xec\xc2\xcd\xa1\x9d\xea\x85\x1b\x92\xc0k\xf6\xc3\xbcX\x92-\xee\xc1yP|\xf1u\xc2\xae\xec\xca\xc1R\x16\xd8\x975\x15\x06\xcc\xd8Ga\x9c=\xe1\x95\xea\xdc\xcc\xd9\xccz|\x7fX7\xa1\xcbj\xaab\xe1b\x8b%\x83\xc1\x15py\x1d\x08\xc2Gt.\xf0!70\xf7\x18\x88,\x10!\x88\xfe^\xfa\xea\xb4\x1c\xb1k\xb6\x05\x03\xe4\xe9\xb3*\xe3\x98\xe2\xcc4\xce\x1d\xa4\xfe\xc3p3\x86\x91\xe3\x1d\xd5\xf1\xa1\x03\x99n\x00N^9H\xf6\x9cX\x05M\x8c\xefF#\xc9\x81\x85g\x8c\xd3n[\xfe\x8dF\x18\x8f\x08Q'&\xfd \xeaA\x9dC\xdf\x8b\x95G\xcf\x98\xa5H\x89\xff\xa4K:D\xe2\xdcXV\x8eE\xcf\xc1sOy\xfe\x85\x89\xa10\xc9B2\xd9[\xa4w\xe3\xa2\x93L\xa5wr\xc9\x92\xe9*\xfaE\x9exjJs%\x12\x0a\xbcs\x8e\xa7\x9dj\x84#\xf6!k\x9dn^\xd3?a8\xd2@\xd5\xed\xfd0\x9b\x94\xf6\xcf\xd5*h\xa4y\x04\xc8\xb1\xed\xc6\xe4\xcfzd\x0ce.z\x02I!\x9aVi\xdbCq4|\xfa\xad;\x1aoJ\xf8\xea\xc6\x83\x9b\x03\xff\xa7\x8fsl>\xf1\xb7\xe62\x1f\xa9\xf6\xf52\xc2\xf74\xd4\x03\x1d\xc27\x97\xfb\x0b_\x04n\xbcY!\xe43\x9dW\xe1\x8d5\x8fu\x81x\x1c\xc7,%adf'\xfd\x8e\xe6\xd8G/\xd7\xc5\x97\xeev\x8d\x14o\xdc\xac\x03\xc7\xe8\xd4(W\x9dP\x96\x91W\x8a\x11\",\xb9\xf6\xcchZ\xad\xcb\xbaj\xf9\xe5\xacn\xbd\x1c\xaeP\x9f\x9du\x95\x83\xd9\x14(\x8eS\x95QW\xedBn\"c'\xc0\xd5M" +
+	"\x87\xdb'u\x9f\xcb\x0c\x1dg\x81\x8c\x97\x01?\xd6t \x14yR\xbeT\xe2\x1eG&G\xd1\xe6o\xd7n\xd1\xeb\x8cK\x97j\xefM\\ty\xed\x0e\xaa\x7f\xe4\xc5\xd5;~fJGRFm\x96;&\xdd\xa4\xd5h'v\x86\xd5*\xb7M\x9f\x9d]\x06\x13p\xafyKf\x16\xa7\xc4\xa1\xd9B\xd1l\xaa\x88\x1cw\x1a8\x8a\x91c5\xc4'g\x0dP\xf2\xa2JN7//[+\xba\xf1\xber\xcb[\xb3\xa1?\x0b\x0d\xf7gW\xb1\xef\x86\x80\xa6\x02\xdd\xca\xc5\x09~B\xc1\x8b\x05i\xb0#\xd3\x8d\x15\xcf~]\xa4\x80u\xcf\x8a:\x7fT\x01\x9fiSu[M8\xd1\xf5}\xc2\xb3\xdd\xa8\x00\xd0\x17\xfd\x06\x88O?U\x95\xb4lpx,\x9e\xdd\xa3k\x845\xea\xf3\x8eJ\x98\x9fT\x0b\xa9\xf2\x1d8Mh\x16\xc2\xeb!\x0f\xc2/\xcf\xce\x0ab\xf7fDj\xc9\xbc\x9b\x1b\xd4lq\x1f\xd6\xdb\xc2\x1b\xc4\xc7\x80\xb8\xd4\xd9\xd9\xed\x18'\xad\x91\xb8j\xb2\x12\xae}E\xd2\x1c\xfb \xc2\xfbJ\xc7\xe1E\x89\x91,>\xe5]\xc64\xe4{*\x1cQ\x86LY\xe2\xef\x92\xd7@\x18\x0fgl\xf5v\xd7L\x96\x9f\x0a\xee\xed\xaa]\x06\xa4\xd4#\xc7\x05\xe1iX\x05\xaap\x97\xe7~\x8b`\xf3J\xfe}\xf4\xee;\x90oTS\xc4W\xfb\xd1l\x05\xb6\xd8N\x9b\xff\xa4\xb9(*H\xdc\xbe\x91\xe3\xdeD\xce\xd6DJ\xab\xb0h\xc78F\xcfC\xabQln\xed0\x8c\x9c\x9a\xbd\x90\xc2\xc7\x00\xff\x8b#h\xa8z(k\xf95\xcb\xa7\x85s\x04\xabn\x05\x83\xed(\xbfoDR?\xca:5t\xa2\x04\xc7\xf0>V\x98J\xf4b-\x99)Q\x0c\xef\x96[a|\x17Q\x9d\xaeX~\x81\x8e\xf2\\\xe9(k\xf2$\xa6\xa3\x84\x94\x82\xcb\x0c\x0c\xe1\xe2y\xb05\xe5\xdc\xc0\xbf\xce7\xf9\xa0p;!boW*4\x8a7O\x193\x01c\xb2\x01\x1e\x06\xc7\xdb\x9bdhw\xb6v\x8c\x1f\x0f\x96\xec\xa3\xf9P'\xa0\xa3\xfeQ\x13\xd2\xc9\x94\xcdM\x04\xb5\xd3\xa3llA\xae\xa7\x9a\xdfc\xa9\x13\xc5F\xc2\xc9\xd4\xf1\x9bCx\xb5\x95\xb9\x95\xee\x0eK\xfaA\x08\x09\xd6(\xb3pY\x13|\\\xed\x8c\xec\xbeK\x94\xbd\xb7\x0b\x04\x7ft\xff\x12;\xd3o\x95\x00\x0f\xaaG\x8f\xc5\xea\xceU_\xbeTz\xa4\xd8\x07\xe1\x91<4\xffI\xdf\xb63Y\xd4\xcd\x031\xbf\x89\xc7.\xd8svx&\xf8[3\xa4\x1bF\xba|\x0a9\x8d\xa7\xc6C\xb02\xa8\xc3\xda\x13\xd4Sn\x9f2\x01\"92st\x90jMw\xe9\xed\x9b\x82\xc6\x9a\xd8R\xf6m\xdd\xfc\xc0\xd1;\xf8\xfbG\x16J\xeei\x00\xfa\x9b\xdb\xff\x00\x152\xbf4\xfdT\x08\x80\xe6\x80\x03m\xe9R\xb1\xb5&\xc7\xa1T\x0e\x81\xe4\xc3?\xe3\xd2dJ\xd8AN\xca9Ca]\x9b\xa4N\xb0J\xdf\xcdj\xe2U8\xa1\x0d\xf6\xad8oo{\xe6\xad\xbcQw\xf2u:9\xfaa\x9e<y\xa3h\xd3\x05\x80\xf9\x13CM\xa5\x1d\x80\xd2)[F\xe4J\x94\xf2\x13:\xcf\x83\xf9Jg\xdf\xee\xc1\xf0%\x9c}\xe9\xb6P\xc8K\xfd\xd8\xa4j\x8d\xbb;4?Y\x1a\xd5\xf8\x1f\xc0\x8c-w;\x13\x1f\xc0s,y}>C\xfe\x7f\x08\x8b[\x81|\xba\xab-CC\xed\\>]P\x9d\xbbO\x83\xca\x88KQ8\xfcM\xafm+\xb1\xf8#\x8a\xcds\xfa\xfdg\xbb\xd2>\x14q\xca7B\xd1\x16x\xce\x99\x17\xaf\xe47\x15k?\xee\x15-tE\xcf\x05\xa2\xbb\xb0:\xee\xd78\xed\xe4\xad\xae\x18\xe4B\\\x09\xa5\xbdY\xdf\xe8\xda\x9a\x01\xa4\x99\xb7\xf9M\xc6\x836\xdf\x1dX\xa5\xd2\xd3\x1f\x9bm\xe7:\xb4\x94\xa6\xcd\xaf\xd2uz\x09$j\x9a\xd3,3\xbc\x94z`\xb3\xe3h\xc7H@+\xab\xffs\xc5p\xcd\xbb?\xfc\xf6\xe6\x1bnI{\xcf\xb8V:\xac\xedrv\x19o\x8aW\x19\xb3w\xf6]\xa0o)\xb1\xe26~*\xcd\x91\xa3\x0c\x85\x11\xa2\x97=j\xd01]NDzadbV};\xecj\xc2|!\xdb\xe6\x1b/\x01B\xfa,\x04T\xaa\x1a\x86A\xed\xbc9\x96\x1f\xe2\xba\x839\xabl\xb62\x96\xee]\x08\xcd\xb6\xc5\x0e\xaa\xb3\x9b\x13&\xbe\x0c0\x87\xbf\xc2\x8b\xb0\xa3\xbe~t\xaa\xb2\x16\xba\x19!`i\x84\xbe\xdaQ\xa5\xf4\xbcO_\xdf\xd4\x06\xb5\x89\xb14A4OA\xdfCL\x99\xb7\xfd\xc3\xa2\x92Z\x0b\xaas\x93\xcd\xd8\x83q\xe9\x05\xd0\xf8\x8d\xf01Q\x01q\x8e]B\x99\x8e\xa6\x92\xd2\xa0w\x86g\xe1\xdeP\xea-3\xac\xca\xdc\x94\xfd\xa8o\xc4\xd34\x8a\xa3\xfbQ\x94\xf69\xbb\xcb\xef?\x8d\x89\x19\xb5v\xaa\x16\x82\xa9\xd36Z\x07\xa2\xef\xf6t\xa1f/\xf3483\x02(eo\xef\xb7\xe0\xaa+\xa0\xcf\xdaJD\x016\xcd\xb1\xf9\x1e\x9e\xc8\xc8\xad;\x09\xadA\x0e?\xd6\xfd\xe53\xff\x90\x1d\xe5\xb9y\x9b\xa0\xe9R\xe8@\x1c\xcdq\xd5\xa3\x89\xa4\xee\xe6+x,K\x14Y}\\\x10\xc2U\xbdi\xde\x8d\xfe\x85;\x98?(+\x1e\x90\xd5i\x92\"\x91\x00\x1c\x04\xf0\xb1\xcdQ\x94-\x97\xc6\x82\xa3\xb8\x19\x0a\xbeP\x19QMn\xef\x9f-d}8\xef\xa1G\xd4\xbf]\xb2\xc7\xb6:\xc5c\xb3\xb7\xf3\x9b\xbe\xddzm9\x05\x82\x8dRb\x0eI\xb3\xf9\xc0Sl%\xf5\x10\x12\xba\x8a\xdc\x02\xcb\xed\xb6\xab\x09\x13\xfeo\xc4e\\d\xe3\xb0\x04\xd9\xd0)9,\x1f\xaf\xd2K\xab\xc0\x80\xe5\x0alLO\x8b\xc4\xaf\xb4P\x1e\x05\xc1\x04\x99\x864\xcb!\xdd\xb4\xe1h\xa3B\xd2\xb42,\xfb*\xfd\x9aw\xf6{\xf1\xbe\x8e\xcd\xc6K\xadK.\x95\xf1\x0d\x12a\xe9\x0cq|\xd0\x1d\x19Z\x01\x0d\x8e\x92\x0eJ\xbe\xbc]\xa1\xf4\xb0\x15\xe5\x06\xb0:\xe5\xe4\xca\xda\xc0\xb0\x05\xd1\xbb\xe3~\xd5\x11\x9fs\xe6t\xaa\xdb1\xdc$\xea\x14\xaa8\x8f\xf7\xe4\xe1\\\xabL\xa2c\xa9\xb8\xbc\xe4<6\x1f\xa5\xf7u\xd5A\xebl\x01\xbc\xa2\xb9i\x83\xbe'$\xb3Zb\xe9\x92\xdd6\x07q\xfe\xa6\xc2\xbaQ7{~jb\xb5\xf0g\xd2\x97\xc7u\xd3W'.\xb2fE\x12\x8f\xa6\xa5'v\xac\x85k\x91\x00xl\x97\xec2\x9f\xc0Lp\xdea\xb4X!P\x95\xe9\x09,\x8aM\xc1\x9aKk\xcd>R\xf0N\\:1\xc8\xb3\xbb\x81\xb9o\xf6!z\xa2=gO\x93\x99y\xf8\xd5,\x8e1\x07v\xe4`\x06\xae&\xef\x82u\xc8{\xae6\xb2\x09\x9f\xdf\x96:m\x06\xaf\xe7\\\xd2\x95r\x0d\xd5\xd4\x89\xbf\x941\xb2\xbf\x13\x97G\xe1\x1d\xdd\xf9b,\x87D==\x0a\x19\xa8\x8c\xc2\xfc\x97v\xa9\xb5HD\xee\x91\xa0\x0d<W\xdfJti_:\xbbO\xbb\xa4\x99\xe7\xf4\x1c\x04\x01\x83F@\xdevBo\xd9\x84w)\xea\xcf\x9a6;i\xe5\x89\x96\xed\x1eS\xeaw;\x15\x1c\xf4\xd6\\5\x85\x9d\xad\x14\xe0sB`y\xd1#\xc0\xfc\x06\xc5s\xda\xf8\xb9\x09j\x19\xba\xc6\xd9\xc3e\xfe'\xa8\xa3\xc3\x9a\xae`\x1fw\xed\xacYK\x9f&\xab\x93\xf8<7\x82\xc2\xe5\x84\\,%\x16\x93\xf5Mu\xc1C\xf3\xa8\x99D\xa6=\xb3iU\x8b\xb8\x07\xd4\xb5\x97\xb0\x99\x84\x9d\xa7[\xd5\xe6.hf\xb6\xf3\xf4i\xdaG\xdf'\x01P\xadMJ\xef\xc6l\x11\x05\x1f\x84\xe1\x10e\x9d\xc5\x09\x14\x82h\xc9z\x91]X\\\x11\xa6\x0eY*_\x9a\xec\xd5\xf7\xc0\x9f\xbb\xcd.\xbeti9>2\xe1,\xc7IB\x8f\xcc\xb0]a\xd1\x82\x0f0\x173\x87\x01\x98\x0b\x8dr\xb3\xc9V\xf4\xef\xe6p2\xf3\xe0A\xf3\xc6l@-\xffQ\xbbB5\x03`D/AR\xc1Fy\xe3\xb6\xa6Z\xd67\xcf\xb0\x0f=\xa1\xce\xaev\xf6\x07\x8c=\x17\x97y-\xb5y\xc4\xde\xa1\x9c\x0d+\xd8T\xb7;\xfa[`K\xa4\x82'\xc2\xe4\xab\xe2\x9f\x859p\x15\x94\xe0R\xa4\xc1\x11\xba\x96M >aSX!z.C\xa5/\x8d\xdc*4\xd9\x95R.\x81ep\x8f\x1c\x0fc\x05\xed\x1d%S\"\xbc\xc3\xfc\x16)\xaf*\xd4Z\xa1\x01\x80\xff\x99B\xec\xc3\xa4y\x96\xa8'8\xd5\x04\xcf\xe9\xff\x9d2\xc7U\xed==\"m\x85\xc4\xe3\xfc\xea\xbb\x9bU~\xf5\xe0d\x96(H\xc6RT\xff\x97=0O\xd7\xeby\xbf\x86\x1c\xc9\x8b\x9bN;PF\x99\x99\xae\xf5\x89\x1a\x0cl/!\xc6\x05\xe8=\xc7E\xb7t\xbc\xa2\x16\xfd\x9dIvs\xeda\xa63\xc9,\x88\x92?\xaf\x0a\xf6\xb79\xc1\xbd\x15\xc7\xb1\xe6*;\xb6\x1b\x92\xca.(%\x06\xe1\xf3\x7fL\xb3\xee)\x16\xa5\xfej\xd7f\x98\xc87\xba\xbbr\xce\xb6\xad|28S\xb6t4\x03\xef\xa20\xd2\x87}\xf3\xd8?!F\xce\xe2\xce\xa5E\x14_#\xde\xeb\x84\xf8\xe6}\x88#\xf7.\xb66~\xd0\xb6\xb4mk\xbfp\x9a5M\xee\xad\x80-v4\xac\xf7\x0c\xe6\xa0\xc9\xdb\xac\xf3R\x91\xecp\xf8\xe3\x1e\x90\x83A\xbeF\xa3\x88\xed\x11W\xb4\xa9\xd8\xac\x8d\xc3\x08\x16i\xa7\x04\xcc\x9az\xa3\xdd\x16@\x1fG\xf5\"\x12 z\x9f[\xe3utPY\x07~\xd0f\x08\xa1\xa5[)8q@I\xed\xa1\xad\x11\x0d\xbeh=]:\x9f0\x18u\xbb8\xed\xdd\x0d\xc5\xa6\x97g'\xbd;~\xf6\xf4.T\xcc<\x19\xd7\xf6^V\xe0\xca\x1b8\x8f \x83\x81\xa3\xc3?(\xben\xa7%\xcb%Fn5\x11,\x92\x95H\x1d\x0f\x96P\xd8\xbe\xa1s\x0f\xe6x\x8cLR\xac\x16\x9f\xa5\xf9\x85\xe7bk\xb9i\x1e5\xb1N7\xce\x15m\x89D\\G\x1btm\xdf\xf0nb\x19\x0a?\xb2]\x91\x9b\xec\xdbi6\x9c);t\x9c\x0cX\xdb\xcb\x8b\xde\x0c\x8c\xd7\xf7\xc1f\x91V\xf2\x1bP\x1f\xd4C\x88\xd2,M\xed\x90\\n\x97z\xdc\x91v\xfc)\xd0\x91[\xbe\x0f\xcaG\xd0o\x9f!g\x894\xf5a\xc2\xc9\xd97\x01\xef\xe4\x12\x91\xcc(\xbf\xa6\x17Q9\xeaZ\xa9\xef\xd5y\x0da\xde\xce\xe5K\x99\xf8%\xc3ry\xd9\xdf\xa9\xfe3\xe5j\x88\xbd\xa0y\xd2\xf4[\x87\xdc+O\xfa\xf0\x91\xac\xb4\x7f\"\x17d\x96\xfe\xa2\xdc\xcb\xc6O\x15(\x17\xe1\xbd\xc3#a\x14\x8at\xa7\xady3\xe6N\x9d\xb0\xe5\xb9D\xe4\x18\xf7\xa3Ean\xf8)\x94\xad\xdb{y\x01\xd6\x06\xfc\xff\xc3I1\x17B\x82\xb5\x00\x1b\x1e:[\x0c\x8e\xf1\xaa\x80\xa5'\xce\xfd\x0c\xa2;\xd7\x1e\x00\xbb9X\xc2=\x12\xec\x8a*\x7fJ#\"\xb5*&\x82\x91\x11\xc1\x03u\xd9p}\xe7\x11\x0fM5B\x08\xc9\xb4\x8cpR\x82;*\xd6\x9ao\xf4\xbd\xd9\x03\xdd%\x83.4\x9b\xd4\xa3\xa0$\xff\xedr\xf0\xa6\x12\x1e\xbf\xa4\xb7syw\xfaM\x1d\x85\x01\x84\xb1\x02\xbc\x8a\x82+\xa0_\x1e\x07\xb0\x82W_\x06\xed\xd3\xa6\xf9\xa6\xd6@M\xa1\xc3\xe6\x1b\x10\x84\xca\x04\xeaIo\xb2\x09\xa4\x16\x97\xa5\xf6%\xca\x84\x0f$7\x8e\xd4\x00\xfd1\x97\xd6\xfd\xde\xc05\xeb\xadrAJ9\x0e\xb9\xcaj\x9a|\x03\xcfD\xd4\x8d\x9cJ\xf3\xe2\xa3\xcde,\xdf\xf8\\C)h\x16g\xd6\xf5d\xab;a,\x80\xc8o\xf4\xa7:\x10\xf5,1\xff\x9f\x9a\x0f\x03j\x89\x15\x1a\x08\x87+\xcd\xb9\x9e\x9a\x9b2^IK\x90\xf2\xb2\x16\x0eB\xfew\xf3\xcc\xe0\xd9\xa0|\xc0\xa2\xae\x11\x9f\x89\x82\x04\xc7\x8e\x96(\xfaVfY\xbc\xc8[Q\xd6\xe4d\x7f\xb3\xb4\xa1:a\xf8\x84QxR\xdb\xde\xba,n\x80\xa0;\xa0_\x1dg\xcd\x09\x14\xd6W\x97\x7f\xb66\xa8l\xb4\xe3\xac\xf0\xca\xe4\x91k[5\x00\x13F\xcauv\x09\xa5U\x82}Cz\x04d\x80h\x1f*\x0d]\x0e\xd4!T\x0f\xd4X\x8d41\x0aZ\x18.\x95\x8e\xe1>\xeb\xc1\xb5\xd3\x8b\xb29\xa5\x8aSS\xde\xbbb\x12zN\xcd\xa1`\xa3\x0a\xd8KU\xc7\x16^\x1a\xcb\xc6hk\xb5\xe0?\x97\x7f\x8c\xfe\xbd\x0a\xc4(\xeew\xd6P\xa3\xed\xea\xbdzL\x91\x8edW\xa4\xe9\xbf\xeaT[\x05\x0a\xf5}\xe2F\x08\x19\x89\xb6\x0aFbJ\xc5\xed\xa9t^\xadx;\xe1\xd6\xe0\xb2\xf9\xbe\xeb\xe9\xaalW\xcd\xab\xc4\xc1\xe9\x8f\x0d\xffi3\xc4O\xdc\xb4\xb4#\x12\xdd\x000\x16\xd5\x1c\xb0k\xc3$0CA\xcd\xb9v\xa9\xf7\xb2\x812M\xedv\x81\x10\xab\x04\xdd\xfa\x92qt\xc2\xd4\xec\xa09\x19\xf7\x1c\xac\x9d\xbaG\xad\xc5\xd8\x1d\xeeim\xf7\x0f\xd4u\x1f\xa2\x85<\x09I-K=\x88!\x03\x01L\"h\x99\xc2\xb9{\xa3\x13\x8dg\xda\x95-J\xa6\xbfDIy\xe7\xe4\xc4\x0e\xa0w\xe7\x17\x1f|,\xc0\xbce\xf1<\x084q\xd9\xde\xb7s\xdf\xe4\xfdU\x8b(\x9ds\x0cI\x848\x0eB\x97G~l\x8e\xf1\xcc\xc0%\xea,* \x82\xda%3\xb4HT\xc1\xefL\xddc\x87\xe3\x01zfI\x8b:%z\xb2\xae\x9b\x13\x00<\xd7\x97\xdd\xf2\xd3\\\xd6n$h\x0e\xed?\x0cT\xda\xd91\xf4\x84\xcf\xb6_[\x89)\xa3\xb4\x90;\x12xJ\x05g\xf9\xc9\xf8\x1d\xa0\x13Q\x98\xf1W\x9d\xe5J1\xf4\x93@\xf6\x81\x0eY1\x1a\xad\xd9\xf7M\x18\xd1\x951)\xd0Q\x90\xd6\x92T\x09\x0d\xfcm'd\x97\xc2\x9dP\xf0y\xed\x02\x0f\x8c9\xe5P{\xd3\xe6Wxy\xe9\xd3W\xf1\x8eg\xca\xdd\x8f_\xbf(\xe4\x93\xe5\xdeJd\x91\x9dJ\xe2\xdc\x15V\x04\xffE\xef\x8b\x93\xbf;\xd2G\xaa\x84\xa0\xe7G\xfa]\xde\xad?gc\xb2t\x9bt,x>\x11\x99\xb0\xf0<\xe8\x02\xf8\x9bN\xbd\xd2SG\xfb\x99)\xef.\x18\xaf/\x1a\x1e%\xed]\xcf\x88X\x86\xd5\xba\xedA<\xff\x8d\xf4\xde?\x81Qn\xd39\xc6\x15\xf25!gD\xd9h\xd1\xe5\x04H\x1f\xab\x81O?\x0eg\xbb\xfa\xc98l\x1bK\xff\x90\xefds\xe6?K\x8b\xb8\x87\xd68\x7f<x\x0c\x0e!\x86\x07\xce\xc5\x1d\xa5\xe9\xbe\xf6\xd2\x8f\x03\x0f\x0a\xf9{2\xce\\\x91\x14\xef\xd2\xa5q\xef\xd3Z\x11\xd6%\xc54\xbc\x88n\xffB\x9b\x95\x8f\xd6>\xfd\xdfg\xf3\xae\xef\x86F%\x07X\x12_\xd7\xd7\x89#E\x01\xa3R\x87v\\-oz%'\xea%\x0dB\x9b\xfah$\x8b\x0e\x88\xeb\xf3I\x09\xc7f\xc3D\xaf\xbc\x01\xb6s\xb3\xc2\xa8\x15\xad\x9b\x17\xc4\xd9\xffi^$\x06\xdcY\xce\x84m\x8c\x8a\xdb\x03\xc5\\\xd6Q\xba\xe9s\x02\xd0\"\x07]}\xe7\x92\xf1\xcc\xc2n\xbb\x03ex\xe9\xb4\xbd\xec\xc4K\xfcf\x02\xc2)\xae\x939Q8\xccE!\xec\x14>Eg;\x9d\xc6V\xfa6\xa9\xc1\x17\xa0\xdf\xbd4\x90;g\x06\x03\xd5\\\xbb0n}\xad\x8e\x83\xea\xa9\xac\x1c\x8b\x1b\x91\xe1\xed\xdf\xddnz\xa1\xabS\x0fI\xf9OG\xeb{\x04\xaaqs\xd5\xf7lv2a\xf0|l\xf6~\"\xc1\x16\xad\xda*\x1c\xdb\x8b\xbb\x1a\xd1\x93\xb9\xf1\xcd\xe5\xa9v\xb9&\x9f\x99\x8c\x9e6Th\xcf\xf0\x11\xaf3\xceb\x14[\xa9lo\x05\x86\xee\xc1v{3\x86\x19\xf9\xc0*V\x93j\xa1(\xd0\x85.\xac%Z\x04\xf4\xc2S\xb2\xdb\xbd\x1b\x8d\xc4\x1c\xe9\xf2C\x8a<\x80=|D\x120\x8f\xbaP\xb4\x84=\x7f\x91\x98r1\x14-\xde\xf4\x81h\xf1\xd6k\x02V\xde+\x05?\xfe\x92\x10\xa4\xe3|\x01\x87\xb9\x0a\x80x3\xd3bg\x17\xd0\x11\x16lT\xd8\xab\x15|\xfd.|\xcb\xe2\xb5\x14\x15\x14\x07\x11\xa97\xb7e\x80\xb0O\xe7jB\x87\xe3\x1a\x09,\x8c\xec\xb5\x9d\xa1\xef\x19> \xbf\xcc'y\xf3|\xa7\xffE\x9d7g\x9d\xec\x03\xf1q=\x92\xe7\x92W\xd5\xa3\x15\xc5\xb7\xe20\xcb*\x83\xda\x01\x99\x0f\x83\xfan{\xb6\x09Z\x164\x93\xdf\xbc\x18\x1d\xe7O*\xa5\x03r\\\x80<dJ\xcb,\xbda\x9e\x9f\x84\x07\x9a9-\x856\xff%\xe3t*8\x8c\x91\x86\x94\xfd\xf3C\xf3\xc9\x89\xe8\xd0K3\x15\x17\x95\xd2\x16!\xb3\x02\x17\x95\x9e\xedEi6\xd7\x0c\x95l+r<\xad'\x9cmt\xad\x11\x85E\xd0#\x8aR\xb7\xae\xb7\xaf\x18\xb4\xb1g{o\xfa\x17=xvQ\xc0\x9a\xc9\x9a\x14\xd7\x11\x9a$KW\xce\xdc\xe6\xa9\xce\xb8I\xa4\x07\x92NJ\x09\xdf\x0aw\x7f\x9eA$\xd7\xed\xdc\xba\x8dkN\x05#\x0d<\xd3\x8a\x88\xb8b\xce\x01\x90\xa3t#\xcf\x8f\x95\xa0R\xb3\xa1\x85Y\xda\xc1\xf5\xfc7\x08x\x81H\xc7\xd5&x\x88\xa5)\xe1y=\x96\x1cds\xf4\xe4\x81\xe5\xee\xaf@\xaf\x09\xa5\x93\xaf\xce\x09\x8e\xe1FZpS\x13\xed\xf7m:\x1bZ" +
+	"`?\xc1\xe6#(f]#h\x8a\x91\x9d\xb5\x9d\x151\x87\xb7W\xc3\x1e\x09\xca\xbd\xcdZ5{\xed&4\xbccA\xb2\xe6\x89\x9b\xb5h\xe1tu\xca\xd2+\xe6<\xc30Ke.\x12K|\x98\xb3\xb6\x02\x10\xbf\x06[\xd2\xabA\xb3\x82l\xb2\xee\x11y<\xe1\xa8\xcd\x87\xdcy\xc7X\x19\x95\x0a\xad\xd3\x06\x89\x80\xe6\xcdY\xc3I\xde[W\x076\xd3h:'\xcdA\xf4\xcc\x02D[\x92\xf2tt\xf1H\x95.7\xa1\xbd\x11\xc4\xccm\x0f\xac\xb5/\xb3y\xd26\xd9.\x94\x8d\xd2%\x1c\xe8\x10\xaeW>\x1a\x0a\xca\xb5d,\x9aW\xf2[\x84\x05\xac\x9dN\xdco-5\xb9\x03T8\xf0\x05>d\xda\xbb\x01\x8a\xcf?(\xcd\xaa\xbb\xde\xc4S\xe6`\x03\x7f(\xd2\xc2=\xaf\x12\x15'uQ\x04\xbf\xd7?\xdew_\xfaK\xe8\x19\xe5\xa8]\xc7y@\xa1\xa1\xd0$P\x9eQ`\xdbg\xb3:\x1b$u\xcb(\xf7\x0d\x90\xd3\xbf\xcb\xcfqw6l.\x8c6\xe7\x07\x90S\xfdx(\x8d+6\x93Qlr\x97\x10\x92W\xf1\x0fV\xa3q\xee\x82\xaf\xce\xd4\xacJ\x98\x8b\x96\xfc\x83d\xa0\xb1<\x06;\xd0l\x15\x16^;\xf1=\xeb\x02\x15\xb3\xed\xdbUy_Q\x1e\x1b^I!\xcd\x0f\xe8?\xc2\xea!:\x8a\x1e\xa6\xc6\xb4\xb9I\xddRv]\x9d_\x9a\x89\xd1\xf6Iz\xf3\xfb\x09\x8d\xe4\x93oo\x0f\xcd\x1a)\xa8^\x16\x83\xea\xbb\xb1-D\xe6}\x80s\x1b]\xc9\xbe\xb05\xdd\xf5\xc8\xaax\xe9\x0c\x96\x1a\x90\xdc\xe7\x9c\xae\x9e\x0d\xba@\x8f\x0f)[\x17\xc6\\\xd7\x1c\x17P\xef]\xc1\xc2ic\xfe\x17;\xc9\xddI\x1d\x8a\x0e\xc9\xaf\x06\xf1\xa5]\xb6\x157,\x8aG\xc4q\xfd\xc8Z\xb9\xed\x08\xe1\x92\xa5m\xd6\xf1*\xb3Xj\x0d~\x8c\x96[\x87\x99\"\xee\xeeF7\x95\xe1\xb4\x17\xda\xecW\x1e\x9a\x1b\xb2\x89\x00\xf0:\x11x\x9bP\x7f\x0fQ\x9d|\xf1-|\x1c4\xdb\xd5\xbc\xc2\xe31\x91[N\xda\xcaD\xb4\xe7\x10\xdd~\x946\xb8\xe8\x8bQG\x09\x9bd\x07u2\xd4U\x08IXA\xe2(\xbd2<\xdcO\xed\xbd\x9c{\xef\xf8.\xb6\xac\xfb\x8f\x18\xe8@\x00jO\xdf\xceH\xe8\xca\xd2PQ\xf5k\xcb\xec\x0b\x0e\x80\xc2!\xe3k\xf3\xbb\x83\x99\xdb\xb2[K\xb3\xacn\x97R\xfd\xdce\xb5w \x05a\x10\x16\xd2\xdb\xeb\xfb\xad\xfe\xd4\xfbu\\\x96\xe9\x12\x13G\xb2\xc6=H.hV\x1d&\xed:\xa8O\xe8.\x9c\x82A`\x8c\xfe\xce\xe4T\xdb:h\xbf\xab\xc4\\\x15+\xcf\xe0\xbe\xc6=4o2 \xd4\xf7\x95^\xaa\x18oFr\x0c\xd2\x0c\x9c\x9f\xc0\x1e\xdaQ\x89u\xa4'\x95W\x89e\x18\x02\xccz\xf3b{\x86q\xbd\x86Zu\xcd\xed0\x85>\xde/]\x84GO^\x9c\xd9\xa1\x92\xc7\xff\x11\x9a\x9f\x8b\xcf\x9cSV\xaa\x08\xf2\xcaW\xffx$\xe0\x8e<\x08\xad\xb1x\xbd\x1c\x80\xccY\xcf\xb6\x9c\x0a\xf4\xbc\x96U;C\xaf\xcdD$\xdbk\xdf\xd5T\x9e\"mMcW\x19Xie\xad\xee\x88\xd8\x98\xda8\x1d^\x1c\xcf\xeb\xec\xc1\x05\x11\xaf\x03\xeb\x80\xf0\xde\xc7\xb7\xa2\xc9\x90\x90\xa8\xa7\xb8\\\x83TS\xe8\xee\xd6\xbcRL\xa4\xe67)\x97\xd6J>\xef9K%\xf0\xad\xb2\xdfl8W\x7f\x04z\xe7w\xb3k\xbe\xffQ\xbf\xbfc\xb9\xbcgq\x1aX\xd9m[K\xba\x85\xbam:\xc1\x9c2\xe6\xf3\xae\xad\xf8Na\x11\x14V\xe3\xa5\x10\x8fn\x93!\xc8\xd3D\xa6Z\xe0\x13r\x18\xd4\xd2\xf4\xb9\xeb,\x82H\xdbl\xacs:9)-K\xff\x945\x1b\x0a\xbf\xd6:\x19h\xda\xd6\xd8=\xb5\xe0\xda\xaf\xef\x00\x02va\xce\xa2\xfb\x9e*\x0d\x9cI;;\x16:\x7f\x8f*\"\xb1\x87\x10\xe7\x08\xa0\xc6i\xed\x9c\xa8y\xec\x983%) \xe0\x07\xcb\x1c\x06\"\xb4*a\xc1u\x9aJ\x1ex\xcf\xb4\x06m\xe5`\x8bL\xe5)\xbc\xe3S\xa4\x1a\x0b\x98\xa3\x1a\xcc\xd1\xdbtA\x13\x16\xce\xc3\xe51\xc2\xbd\xb3!\x86\x1e\xe2Rb\x1b@\x09\xc5\xef\x08\xe9*Lfzk\xd6\xed\xeb\xf9\xfa\x9e\xb0Eq\x975Au\xbe\xba\xfa\xe3\xce\x90\xc5\xda\xf9\x8f\xddY\xf0\x94\x14\x1f\x93\x87_S\x8e\xd5\xc4h)V$\xfd\xfc\xca(\xd6\xe5\x99}\x0f\xce C\xd9\x850\x94\xf1\xab:6\xfba\x8e2t\x09\x0c\xb1\x94\x19#\xbck\xe3x\x08Ty\xcc\x9c\xe4h\xee\xc6\x0b\xb2\xeey\xfeu\xe6\xb3\xf0k\xbaq\xf6\"\xf3\xdd\x02P\x0dH\xdb.\xd2\xb4\xdb\x89\x88\xb0\xe85\xf3\xfc\xaf,\x85\x17\x1d\xcf74\xa2\x15\xa8\xc0\xe8\x05\xb3\xfaE\x1d\x18\x9d\xdf\xda\x05\xb2\xd6\xe8@\xf6\xacv5\"\xc0\xbc+\xcf\x18\xda\xb6\x88\x94\x94\xd5\x91E(\xda{\x19\xd0\x01\xd6\xb6\xca\x1c\xbe\x178B\xfbLt\xf6Q\xf5W\xd8\xebE\xc2\x18\x06z-\x0c\xa6f\xc3\xad%\xe5\x07\xd2\xb3i\\\xf3\x93\xbc\xa6\x13\x8bq\xfa!\x824\x09K\xfd\xceKN\xd8O=Kj\x89\xba\x1b\x03bkcG\x0d\xe3\xc5~\x08\xf7\xf0\xb9Y\x16\x99+4h\xb2\xbc\x04y\x11\x8fB\xbd\xca\xfe\x0c\xd5d\xb0\x1c\x08Y\x0f,\xce3n\xfa\x03\xa6\x10\xfe=\xcf(\xf7\x19Z~\xber:\x9f\xd9\x0f\x18`\xd2.\xa5\xf1\x8c\xa8\xa4\x02\x93\x19}\xe2\xf7\x12\x8c\xf5\x89\xa32\xa8q\x9f5\xe5\x8b\x8c!\xc0\x91\x8f\"\xb92\x17\x00\x086\x03\x09a\xa9G\xbc\xd9jN\xccq\x88\x11\xa9\xe7\x04dw\xac\xcbB\x8d\xc2\xb3\xb0\xb59\xe5\xcf\xad\x97\xa5\xce\x8e^3O\x8cI\xd1\x1e\xa1d\xdbs\xfc\x9a0\xdc\xea\x0b1\xf7o\xa4\x1c\x17\xca\xedj\x94\xdd\xae\xe6V\xe7G\x8c\xa0v\x102\xf3\xeew\xed\xbb4\x9e\xde\xef\x1a\x12>3;D\xdc\xbf\xd9\xda\xfe\xe0\xee\x10\x19x%:}i\xabI\xbb@\x84\x0f\xdb/&S\xa6\x03hi\x8db\xda\xfd\xf8]\xc6\x1b\x91\\\xb2\x0e\xdbA\xe2\xba\xaa\xad\xf1\x86\x0b\xef%\xe4\x8f`\xf3\x15\x8e\xf4\xb7s@\x0f\x02\xf4Zz*\xa5\xf4]j$\x91\xad`\xee4\x037\xfd\x8aL\x1d\xe3\xad\xd8x\xed\xe7h\x17\xbag\xa6\xcb\xfc\xc0\xde\xcb\xdf\xa6U\xf7\xd3\xd6\xf6\x93\xefo\xa2n\x18\x06\xcdU~\xd3\xb5\x12\xeb\xb6]\xa4\x97W\xe8\x94\xb4n\x11\x8eZ&\x11\x99\x0f\xf3O\xcf\xc6N\x9c\x94O-\xc7M\xbf\x1c\xe4\xacC\x81\x81\xa9D\x02F\xf8MN\xa4\xb8\xb7\x05\x13\xe2\xa6/\xb6\xb9\x86\xcc\x82\xb1\xf1S\x0bJ87O\xa0\x92f+\x9f/\x8b\xbd\xa1\xc1\x83bD`rQ\xcd$v\x1c\x1b|L\x8a\xfdx}O\x02'\xb5\xb00\x93F\xed\xcdh+g=\xe7g+\x03\"\xca\xb7\x8e\x9by\x1bu2*\x93\x95`\"\x96\x8du\x9c\xf2\xb9\x84\x8f\x85]c(}N|\xb4\x11:\xe6\xf7\xfe\x11\xf0\xb2\x07\x89\xf4\xb2\x1d6\xdd\xae\xe5\xb6\x93\xbdk\xef'\x04\x0a\x83\x0aS\xf3\x09\xb0\xc0\x84\xd6dr\x87\xbfI\xccf\xf6\x0c\x15\xf4\xfe\x0b_dvk+\x05\xf2\x03\xb2H[\xf2&\x99\x1cW\xba\x0f7\xc9j\xea*\x86\x88\xf0\x8e\x0eikv\xf1\x91\x08\x01\xa0\x0a0\x92Z\x9b\x07\x1c\xb6\xa66\x07\x9e\x11\xd0\"\x19k\x8a\x9e\xa1g\xbc\x9a\x04sE\xf5\x8a\xc2\xa3]\x86I\x88 \xb3\xf9\xee=Mo\xbdzEO\xdc\xc4\"\x1a\x86\xf4\x008f.a{\xc0(\x82\xde\x15\"\x7f3\xe9\x9aua-o\xe0\xf3\xb6f6\xaa\xf4\x1a\xdd\xccY\xa1\xca)\xad;\xfaE\xba7K\x82J\xb9\xafv=l>\xb7>\xcc\x1dX\x99\x89|\x03\x1ak\xe1\x87z g\xf6\x09(\x14\x99AA\xfb\xa4Hnn\xea\x01|\xe7\xc8FJ\x06\xed\xa3tS\xd715 {\xc2\xc8}\xd0\xc16+\xeea\xd2\xa7^\xef+{\x87\x81\x9d\x9f_(\xf2\x8b\xfc2\xa3\x19X\xb6Sy\xce9d\xcf\x0e\xdc&\x88\xf2!f\x83I\xab\x93\x82\x02\x82/,\xd2\x0f\xeb\xad\xa7\x1f4\xbb\xf8\x83_<\x15\xe4\xc1\xdae[\xc9\x0f\\\xff\x01?!r\x15x\xfd\xcf0\xe3\x98j\xf8c\x85\x82\xf4;\xb4P\xb9\x8b\xab\x9d[\x0d\x15\xd1\xb5\x1f3\xf82\x0a\x16\xb3\x18\x92m\xcf\xa9\x1c\x00g>\xf9\x84\x8d\xde\xa5\xace\xb6\xf3\x7f\x88u\x1f\xb5\xa9u[\xd1\x1a\xfeNv\xb5\xd9\xcbd\x9a\xd2ew\xb4U\xf39\xc8C~e.\x12x\x8e\x9c\x9eU\xb2\x16xN!Q7\xc9\x05d@wI\x19\xb0\xbf&\x10z\x14\x09]\x81M\xf1eXC0\xc0\xfc\xea\xe6\xa7\xceK\x8b\x0d\x9d\x0dI\x8b\xfe\x93\x91p\xd6\x86\xcf\xe7\x9b\x8a\x00\xc3\xa4y\xa4G\xfc\xcb\xda\xf5\xe5\x11\x96\x1abJ\xa4;\xe5L\xed\x8fn:h\xe4\xbe\xd2\x99\x7f\x94\x96\xdc\xad3\x8a.\xe5\xa3$3<\xf1\xd4\xaf6\x1f\xd5&8~\xa5y+3\x8c\xd6\x0f\xe2t\x8b\x0d\xfa\x9d=\x87C\xd3\xb4\x95\x8d:\xf8_\xda9\x19\xb9\x9dvV\xe82&\x8f\x9d\xcf+\x9d\x84|\x93\x1cc\xb5\x0bQ\x07\xa7\xf5$\xc4\xffAclQt&\x9d\x12\xc1\x1a\x02G\x93\xd8i\x199)\x86\x93\x193s\xac\xd4L\xa8\xbfK=w\xcc_\xa0\xca\x15!\x814HQ\x19v/\xc6\x7f\xa2\xfc\x03\xda\xb6L);\x1dH\x1c\x0f\x95UX(\x1d\xd5\xde\x8f\x10R\x86\x0bA\x04Z\x9a\xaa;N\xd9\xd6\xf0Sm\x95\x8e\x85S\xf0\xdc\xbc\xb7\x95\x0c\x10'$k\x90\xf2\xe2\xf3\xee\x8b\x1caut7\x05\x90\x03\xe6\x89_\xe7Rx\x0f1\xe2}\xb3\x89\xda\x82PZ\xa1\xef \x90Y\xb9Fp\x96e\x95\xb9\xf4&\x05\xfbc\xfaY\xbbD,S\x91\xf3ph\xfer,7\x1a\xd7\x85\xc0\xafg\x06\x93g,\x99\xa1R\x08a\x9c\x92\x9aZH\xee\x99\xf8-\xcfx%\xbc#c&\x92\xd0\x87\xd4<\x98\x14G\x06\x812*\x9b\xe6Q>x\x81\x12\xa6\x80\xa4{\x1c=w\x95\x08\xbc\xd7\x1cNq\xbe^\xba\x08\x85\xe9 \xbcz\xb00s\xa7P\x9e\xb1\x9b\xc4\xe4~\xea\xc8\\\x15\xe6\xa9\x9f\xec\xf9\x82\x08\\V\xb2\x08\xd8\x7f\xe5>\xf6?\x9d\xe1\xac\xc0\x1c\xbcV\xab5\xe6/}\x83\"\x96\x04\xfe]cV|\xdc\xeb\xbePwK\x1e'\xbb\xed\xaf\xed\xbd\xf4\x95{\xf7\xba\xc6F;\xa1\xd0\xe1\xc0\x8dl2W\xe9\xed\x7f\xcf\x0b\xa0\xb6\x93\xf5\x1f\xa0\xcf\xa9\xd5\x04\xed\xe8\xa6\x1du\x84\xd0\xf1_M\"\xc0\xc1\xc9\x91b?\xc5#\x89#\x81\xb6w\xe3u\xd3\xfaka\x0a\xdf\xf6\x98\xdc\xd0x\x9c\xb4\xab\xda\x05(\xed(o&\xbb\xe7V\xec\xbb+\xb1\xb1\x88^\xfe\x85\x9d\x82\xbd.:\xcd Od\xf1v\x9c|\x8b+H\xc3n|\xd2\xdd}\xd5\xad\xb92F\xd8\xbf\x9b\xed0;\xa4\x13c\xd4z\x98\xe8\xd5\x88\x12P)\x8b\xc5k\x09u\xc3\x1d\x08\xf4\xbd-\xf1\x85\x82>\xa9\x0f\xad_\xcf\x04\xeb(\xd3WR\xfc\xe2\xa1W\x0e\xbf\xb0Q\x91y\x9e\x9b+w\xd5\x82R5\xcf\xdf\xed0\xd3d\xe5\xbc\x1c\xa7\x8b\xf2\x06\xbe\xfb\x899\xe3\x8f\xb8M\x91\x99fMm\xfb\xde\xac\x04\xb3\x0e\xd8w\xb4S\xed\x90\xfc\x9dOr'\xef\x10\x00\xc9VJ\xf4\x05\xb7\xce\xec\x10\xb7\xac\xeak3\x99s\x19\xc99\xa2\xd1K\xf3\xcaN\x85uK]\x19*\xbc'\xf6\x9d\x8cz%\xa2\xa7\x07\xd4\x93\xb9\xdc\xfc\x04\xbc\xccAT\x84\x11\xbb\x99\xaa\xaf\x03\xe9\xefPI8\x86\xdcLo\"\x88RI\xe6\xe7\x1c\x0b\xde\xde\xc5yW\xf4\x0fU\x9bU\xf3\x9a\x92)[m\x87\xf6U_.\xb5\xcf\x85\x80\xb9o\x1c\xb81\x0e!)\xe7\x92\xb3\xd2m1\xd7j\xc4\x95\xe6\x84\xae0} \x19+\x08\xab\xb4\xf7\x8a>\xa7\xb6\x04\xbe1\xfb\xed`,\x09\x8b\xf9\x06\x0e6\x06\xc8O\x94\xec\xbf\x0a2\x80\xbe\x0f\xb2o\x7f#K\x97\x1aKVJ+f\x09G\x9a\xbb\xa4V\xbd,\x0c\x952\xb4\x929\xfa\x10\x9f\xffh\xe8\xa7-\xe7 \xc5\x98\xffL'r/}\xaa\x99\xb7\x09\x0fV\xe9}\x87\xac\x10\x0d\xa4hj\xebc\x95\xb6\xc3yA\xe55\xe1\xd3\xef{\xb3\x7f\xc1\xe3h\xab\x82\xafm\xc4\x9b\x88\x82\x82\xd2\xa76V\xb8Y\xbf\x10n\x8bI\xb3\x93\xf2 \x03\xa3\x9e|\xdb\xe5\xda\x8aa\xcc?\xc5\x02\xa1\xa2:\xfcK>\xe3\x1anp\xb9:x\x80\xb6\x0a\x07\xc4<\x94\xc6\xdac4\x9f\xde*\x19\x03\xbbA\xd9\xb9p\\\x8b\x9bBv\xbe\x14tU:\xce\x84\x02{\xc5o\x17\xe2\x01*E\xd8O\xef\"DMJiEb\x9d\xd1,jj\xd6X2\xd6\x93.\x00F\x80V\xe2\xb6Q\xd8@oz\xf5\x8c\x83\xb4\x96\x12\xd0{a-\xa1\xc9u<\x97\x84!\xa9\x14\x1a\x01/\xd6\xf3<\xb9\xbe3_\x02q@\xb3lRJC\xd3\xbc\xd9\x0cL\xd1\xdba)s\xfc\xb3\x03\x8eQ\x13\xb4\xb4\x95a\xe1G\xb3\xb1\xe0\xe3\xfb$\xaa\x1b:\xaa\xaa\xd0\x8e#Z\xe9\xdfs9\xe2\x0c\xe8=\x9fH\xb9|V\xde\xcc=\xe1\xf6\"\xcd\xb5\x1c\xe6\xeb\xbdF\x96\xedk+\xab\xfb\xac\xb3Y;L\x93\xc9\xb8\xf7\x05\xf7\x08b\x82\x06\x11 \xa6\xbb;\x8a@\xba(4\xa8n\xeb\x8c\x91\xfc\xd6\xd8UK\xba\x01\xc4q\xbf\xad\xc9_\xfb2\xca\xf1\xb41Qr\xfe\x01\x1c$\xea'\xe0Ny\xdc\xcd\xe0\xb7K\x115w\xb4\x06I\xb5i\xc3\x9c\xde\xaegS\x1b\xe8$2 \x0b2\x11\xd6\x02\x97\xd6\x7f\xa3yp\x1c\xbd\xe7\xc8\xe1\xb2\xe7\xcdc\x10\xfc4\x0d\xfa&8\xdc\xb95Y\xad\x10\x1bh\xd8Z\x09\xc6,\x87\xe1\x8d\x03W.\x91\xd6i\xc1\xea\xe5\xc5\x1fD?\xc9\x09Ay\xe9Mi\xea\xe8\x08\x14\xf77\xba\xa3\xb7+\x91i\xcbq\xbd\xd2\xf0\xc0w\xc8\xb3\xd6?\x85\x9f\xb7\x05T\xd0\xa5\xa1\x82<\xa1\xee\xa8z\xa1\x9c\xbcc\xe9\xd4x\xcf\xa5\xd9:$>\xd5zY\x96<\xb0\x1b\"U\xae\xab^\x13\x95|\xccG\xba\xc5\xe63v-\xf1\xb6\xbe\x80\x14\xf7\xff\x1e2\xe4LH\xa1\xae\xd2\x9b\x11m+\x865\xd3\xf9\xfc\xf6\x9a\x016\xcb\xda\xf5\x17\xbd\xd9\xc14\x97\x88c\xb6j\x97\x03>\x8e\x07)\x89w\xc3]D{\xa7\xa1+\xee\x98\xdf+kBC)\xfa\xe9\x08\xa8z\xc8}\x046\x7f&O\xd8\x8f@n\xfa\xc1\x97\xb65\xc8\x1e\x00\x94*-\xfb\xf4+\xa1\x8f\xdf\x8a\xd3)P\x8e\xc8\xd2\\L\x0dt\xbe0\xa0{\x9cQ%\xa0\xfb\xd5\xf0vib\xe2[A\xdf\x18\xef\xb4\xb4\x00\xc8\xb3\xccN\\\x13\xaa\x09\xab2]5\xd2m!P*c\x7fh\xd3\xfc\xa0D\xdea\x82\x96\xcb~\x06 \xbf\xa39qLy&\x81\x11\xd1\x82\xe0[\xe2&\xf1#hZ\xb3\xae\xb0\x99a\xd7A\xf6\xea\xe0\x0b\x7f\xa3\xaa\"l\xa4i*\xd3\xb9+\xb6n>i&\xcb\x83\xa6\xe3\x0b\x95\xae+\xe1\xdcY\xc29\x92\xcc3\xad\x87\xb6\xc69\x89Z\xd8\xbe\xb5\x01b\xbc\x9cShQ\xc8\\Y\xc6\xe3&\x1b\x1e\xc2S\xbf\xf8\x1f\x8bWG\x04\xdfLHJ^QM+q\x16\x14\x12hK\xa0\x83\xe8\xf5\xc9C\"\xbbQ\xb6\x03\xb7]\x06#\x9dst\x9e\x9d\x0fU\x8d~;+\x06w+5\xddN\xc2\xdf\xc2N\xb8(\xdc\x8b/\xa8\xcc\x8d0\x95\x9e\x93\xf8\xe9K\x9b\xf7\x0c\x95\xcb\x8a\x0e\xa4NX&\xe3 r\xa5\x1f\xf4D\xfb\xac*\xe5\xa5\x1f\x1dF\xfd\xa8\xf0'\xdfCK\x93\x90\xe5\xca\xf0%\xb3XY-\x9b\x051!\x88q\x90\xd0\xd48N\x17\x9a\xfd\x9ar\xcd\xc97r\x07\xa9c\x0c\xf8\xd2\xb5\xe6\xab\x1c\x89$}\x02\x97\x9d\x13\xff_\x08\xe3\x14\xcbR\x04\x89\xbe\x05R''HZ6\xbb\xf0\x84\xeb\x08\xb0\xc0\xc5Nk\xa0Q\xbc\xc4\x8cujcw\x19^\x834\xb2\xe9\xb2\xfbO\xb2l\xcaV\xd5~U']1\xadq\xd4lf\x9a\xb7\x0f~\x10\xaa\x16\x0a\x9e\x90^\x86\xd0\x1e\x0a\x92\x1aJ!\xac\xd2l\xb4S\x1c\xe6\x09\xcb\xbc\xe8\xfb5;\xf9\xc1\xd61\xca\x0fh\xce\xe4\xca\x9b\"\x1d\x13\xe7\xd0C\xbbT\x82\xec\xbe'\x98\x05Bys\x9a\xc5ec\xe9m\xbf\x19\x05\x8d\xabU'l\x0a\xfa\x05\xaa\xe8\x10\x1f\xab\x1c:\x05\xad\x82y\x91(,\xc3\x93\x97\xb0L\x9a\x11\xe1{b^\xd0\xa0\xb1\xed\xaf\xc5\x0e\xfd*y\xb3\xc2c\xb4\xf5r\x8aK.\xf5e\xca\x84\x7f\xa7\xc5\xcfr\x02]\xc9\x85\xf9?\xe4;;\xbb(\x92\x92w|u{\xe5t\x97\xd2\xc2\xe7ax\xa9I\xc8t\xf7\xe6\xd4SQ\xa1\x867\x85\xcb1W:qN\x81\x7f\xf5-$V\xc6$\xe8\x9d\x9b3#\xc4Hg\xb1Mx" +
+	"I\x84\x18K\xbe;\xbf\xa43\xd3\xa9N\x02\xbe\x80\x82j\xbb\xe0\x0aj\x8d\xceI\xfa\xac*\xfarg\x1dq5O\xa3H'\x90P\x0byr\xc0\xe9\x97\xa5\xd3\x99\xef\xc1\x19\xb5\x03\x0a\x9b\xbe\xcc\xaf\x88i\x1c\xc3\x92\x13\x1f\xc5\xad\xf2\xccS>P>\x0d\x08\x04-\x9b\xcf\x84O\xfc\x8d\xcc\x1c\xfb\xbb\xdc$\xe5\xf2\xf4\xae\x1c*\xb4@\x19\xb2\x0b\xb2\x17\xba\xcd\xba\xdbW\xd7\xfe7M\xd4_\xc3\xd8\x0d\x97&\x1c\x02E\x03r`t\xa5$\x0f\xc9\xc0F\xd2\x94\xdc\x87B\x04w\x81\x0by\x0e\xdc\x9cM\xe2X\x92m\x94\xc1:\x8c\x92\xb3l\xb3o\xfa\xcd\xd4Q\xd4sV\xcf?\xfdQ\xf6\xfe\xcfro!\x19\xf8I\xa4\xaf\x16|\xd3\xe0\x0e\xa5\xb5\xcd{\xa5\xb4zo\xd6\x13\x01\xe3\xd8\xa6\xc4\xed\xf8\x11|>\"\x0e\xea4r:f\x8c\x96\x10E\x09c\xb8\x8a-\x95k}z\xde;Z\xde\xe6\xfcl\x8e\x02\x1d\xcaq;,\x1fVu\x9a\xc5M\x93\x7f+g\\y\xa6-\xc2\x8b/5\xae\xd3\xe0\xaa\xd3\x16\xe8\xc9\x18\xa3\xa2x\x15\x8cuVFBfW\x9d\xf4\x0d \xd3\x9a\x9aZ\x13\xe1\x9bj\x91.\x04\xc6\xe1\x15\xab\xf5\xa6(=W7\x1fcR\x9fn\xc8\x82T=\x0b\x00\xe4\x02'\x0f\xf9\xe6\x07\xad\x15\x9fl\x97\xa6$\xfaD\xbc\xd6\xe40<3u\xf0\xcc\xa0\xe2\x8e\xf6-c\xb1\xcauj\x8c\\\x01\xb0T=\xaf@\xb1\xb6\x1c\x170'\xb9\xe4\xe8%\xf4\xa9\xb9\x07\xd7\xd1i)\xd3\x91oy$G3\xa5D\x9e\xfd}\x9aWe_\xab\x9c\x93D\x1c\xceIE\xd1\x88\xf7\xd7L\x18\xbfg\x0f\x9a\xe4\xa5\x95!aJ\xb7\xc96k\xd6\x00\xfbGum\x05\xc6\x9cZ\x12yv\xfa>\xd6\xe6\xd1M,Q\xa4\xe88\xa0q\x1c\x83Y\x86\x11/\xce\x82q\xe9/G\xecIS\x07\x97~St\xfcI\xc9\x94\xef\x93v\xd6\xbc\xa7G\xf1\x9f\xa5N\x15\xa9\xd9\xfc`\x0doFt\x1c\x9df\xa2\xc3_\x0ebf\x98\x9b\xb7\xf6\xf5\xa1\x966\xe3'\x8c~\x1c\x0d\xc6\x99\x0f\xa3\x0e}\xf3I$\xb7\xdeI2\xa0_}3vJ\xffh\xe7nL\x9e\xa9$\xf2#\x1b\x15\xfb\x94\xca\x09\x89\x13f\xa7p\xe6Jv\x08\xf1n\xb8\x04\xe1\x8a\x14\x87\x12\xca\xc6{\x04?T(=\xe4\xf8\xe2\xdc\xc4f\xe33\xba\xfavF\xcb\x8a\x1d\x18\x0d\xc68\x00v;+\xacR\xd5\xc4\xfe\x92\xc50\x8c\xc5\xef\xac\x96G\x92\xc7\xda\x02\x80o)\x1b\xf7\xf8l\x1d\x98\xb5\xe6I\x8e\xe3\x84O:\xa9\xeb2&\xbf\xa0\x7f\x84\xef\x1c\xa0\x8d\x1d7\x85\x16\xf2D\xf3p\xcd\xf2\xbb\xf2\xda\xa5=\xf5\xeed\xe9\xa8y\x07\x9f\xd1\xb8\xba\xea\xb2\xb4\xa1Kup\x87n\x1b\xe1\xf9\xe2\x82\x0d\xe1\xb9C\xd8:X\xed<\x8b\xb0\xf6\xb9Fku\xc9\xb2\xd5\xd0\x16\xeb\x83%\x02\x1c\xfcO`\x89\xdeL\x87\x12\x9a\x09X\xa0f\xd0\x1c\xbd\x96'\x94\x01J\x8d\x04\x17C\x8d\xb2\xa7\xb8em\xa5e\xdc!\xac\xb8\xe4@\x91\x15\xd1\x03]\x97(\xab\x1d\x1a\xaf\x13\xb7\xd3\xf2\xdf\xe9\xf0v\xca\x11\x8c\xed\x09\xba\xd8\xb6\xcd\x13\xea\x08\xa69\xde=\x81m\x17\xc3\xe2\xa2}?\xed\xe9\xe8E\xe9]\xb2\xd2=?\xb2^\xab\xe1\x06+\x87b\x80<\xadK\x99r\xfc\x81\xe8y\x8d\xe4R[in\x09\xdd\x9a\xfb\xd2\xf2\x9e\x9b\xfcQe\"\xcf\xf4@\x0fH\x94\xa28\xfb\x0b\xde\xc7D\x0aa\xc3\xb0\x86\x90(\xce\x84\x19y/\x91\xae\xdf\x95\xc8&\xa0\xa4\xb9\xe7u\"\xb2_i\x97\xdfZ\x91K\xc8\xb2\xe9Q\xb4\x80O\xbb\xe4\xb2\xb0=\x1b\x18\x01W~\x10\x19\xdf5P%4\x84\x89h\x10\x8bj\xd1.3\\>\xbb\x15\xca<1u\xdckt_\xe2F\x7f4\x1b\x7f\xc4\xec$-\x9b\x8b\x1b\x7f{\xc6\xedV\x83\x0e\xd2\x0b;\xa3r\x8e\xa28\xc8\xe6'\xd2?L\xcb\x11\xad\xc6\xa2\xa5\x07\xa9\xf9\x99\xb5M\x1dx\xf0\x8d\xc1\xb8d\x84\xa6\xfaz\xa8\xd1\xe6\x9b\xea\x1f\xfb\x91!\x0f\xa9\xcah/\xc8\xfe\x93\xb1\x0a\xa0\x18\xb7\x8e\xa3\x8b0\xa8yy\xdb?23e\xe2\xd2\xe2\xfas;\xe57!\xb3|\xeeb\xf1\x9a\xbe\xff\xda\xcd?\xf98\xa7[\x9a\x9b\x9f\xe8^]D|&\xf5\xcfMr[I\xabk\xebB\x05\xc5\xd4PG\xff\x03\x9f\xff(\xf6\xc0\xb4K3\x82\xa6 \xee\xcbmb\x838\x84\xb0\xde\x84T\xe9\xde\xd8\x9b\xdc\xd6\x93\xcf2\x9d,U\xb3\x93\x9de\xe0\x92g\xdd\xf7\xb5\xb14\xb1\x840\xe2\x85\xe3\xcf\xb9g\x12\xd2\x9d\xaav\xf4\x1d\xa7b\x03\xd4\x92\xa5=\xde'\x0f~7\x1b\x09\xa9\x8e2!\x8e\xd6s\xe4\xf8\x91C\xab\x06r\xd7\x9c\x80\xc3}Q\xdb\xd5s\xf3!\xdb'\xcf\x97m\xf3Q0\x89\x0e\x00\xb0hW\x044\xe7\xa2\xc5=\xcdR\x1a\xe3\xbf\xd2\xce\x10\x8a\x17L_g\xeb*%\xe8U\x83H\xd7$\x87(\x8c\x0f\x1a\x16w\xc4.\xfc\x92\xd1k\xc8p\xe7\x0c@\x1e#\xd7\x85-poy%4g*\x1as\xb0B\x85\xa5\xfa\x19\xe8\x09n\xf7\xad\x0b\x80A\x8a\xb0\x83\x8a\x9f\x91\xc5(4E(e%\xe2\xbd\xa75\xa2\xdd~\x81\x0b\xdaW!^\x8bm\xb7^vRl\xab\x14t\xd2\xeeM\xdb\xea\xb2\xf4\xa8Y\xeb\xf0\xce\xaa\xcd\xb3v!\xf1\x1e\x9ctU\x07\xc0\xa1?\xbd\xd9u\xbeF\xb9o\xeb\xf2\xba\x17\xf5\xd2\x83\x9edk\xefl\x9a\xfb\x12\xc4\x9b\\\xda\xb0/\x92\x7f\xf4JoU\xa8\xbd\x1dnyX1rZ\\g\xed\x821\xb8\x9cWYia$\xcb\xbbXM\xfd\x05\xb9\xc2%Y\x97\xfe\xdd\xdb\x04!m\x0dR\xbc\x12\xea\x95\xbf\x8dnZ\xbd\xce\x1e\xaf\xb4\x9b\x7f\xfb\x13\x01\xe4;\xcf\xe4\x0c:\xa7\x8c]\x88\x17T\xf3\xady\xa15\x0eu\xdb\xac\xc0\xfaA\xdb\xeb~P\xf2\x11\x1e\xbe\xc4*n\xc6\x03u\xb5\xc4\xfcK|(\xcc|Yq\x1dr0P\xb9\xf2m\xfa\x81V\x97#=\xc3?\x05`q\xf6\xfaN\x04n]\xb9\x1ew\xf8x\x0aY\x9bGf\xb9\x1a\x96\xc9\x1a\xff\xf0iF\x1f\xef\xf2\xea\x0f\x92\xbfS^\x949\xa5yG;\xeb\x0a\x90\xa7\xab@\x84\xf4\x94^\xe8(\xdci\x84\x06IZ\x04\\I\xf1_\x87!\x1d\x04\xae]C'\xad\xd8V\x13dc\x98\xcd\xb0$B\xafg\x7f\x11k!\xba\xaa\x95\xa4\x83B\xd0\xccB\xbb\xb6w\xacYR\x9f\xb9H\xc7\xc4h\xbb\xf4\x83\\\xea\xf2y\x1b\x0f\x00E\xff\x14\xe6\xe7NX\xb7\x8e\x08O\x06\x919JC\xb8m\xef\x1d\xc5\xc4w\xde\xa9\xb4\x9b\xce\xfa\xff\xb1\xa7\x8d\x13\xc0\x0c2i3\xc8364\xe1\x8b\x99\x8a\xe4 g\xfdX,\xb2\xde\xb8\x95[)\xc9\xc9Z\xf0\xd4\x92\x8f\xf2=|N\xcder\xaa\xea\xe8m\x18\xacv\xe9\x85\xd8\xb3s\xcf};\xd9}\xcc\x88sq\x82V}\xbb`*\xbb\x02\x85\xad\\\xd1\xd44\x9e\x98\xc8\xd4s\x8dc\xac\xfeP\x85\xee\xec\xcb(*+\xb4x\x00\x7f\xa1\xa6m-\xc6i\x18\x0d\xa03\xc6|\xa8^\xd0\x9a\x1f\x9a\xad\xf01p\xdb\xc9xi\xdd)\xe4\xa4\xdb\x9fI\xcf\xbb\xf4\x8d\x9d\xc4twa\xb3(\xe3US\xbb\xeb\xba\xb9|C\xeb\xe0X\\f=R\\\xf2]F\xd1&\xd8a\x1fb[\x1ah\x8a\xfc\x82\x8aC\xb0\xbc\"ml\xcb\xe12\xf3<\x83\x90p&\x04P\xab\x89y\xe7\xbcLh\x8f\xc3\x14C\x88A~_\xaaO\x17\x19-\xaf\xbb\xb3\xc8\x81\xdc\xf3\x00q\xc2\xd59F\xbb\xbfJ\xc3C\xb3\xb1}\xaf\xbc\x1c\xce<\x9a\x0c\x948\xfak\xeb\xbd\xb5R\x17\x8dQ\x81{\xf7\xd6-\xad\xb9\x8d;Y\xb6\x83\xcf\xdb\x1d\x19\x11\x83V\xf2\x09\x93\x13m\x07\xd10wY\x87\x87&\xdd\xf7\x10%\x91\x9c.\xd8\xd1\xeb(\xfc\xd1\xa1\x89\xa3\xf2W8${Z\xc2\xc1T\xe4\xb4.GJ\xb5\xb1\x03a\xe6\x8b8\xe0\xceIi\x07-\xc9\x13\xecZ4]\x91\xa7\xa2\xf4E\xffc\x87j\xee\x83\xc2\xcf\xa2n\x1dy\xe4B\xdf\xcd\xf2\xbe\x0e\xb8\xc3F\xd5\xa9;l\xa5Uwh\x1f\x0b\xbd\xf0\xde\x8ai)\x7f\xd3<M\xb0\x10\xda\xfb\xe6\x09\xa8\x123\xa3\xc3\xc9\xd77yIi\xc08{\x18K\x872@\xcf4@%\xe2\xdfP\xdeS[\xad6\xfd\x09\xe4\xcb\"b\xb9\x13\xe5\xca\xa5y\xe2\x87\xe6G\xe9%_v\x17\xa2\x93\xf5\x86\xfd{\xa3Xb/\xfe\xf8\xc2\xe1+\xcb\xbf\xe0\x8d\xbe\xf0H\x1d\xfa;&R[\xb1^\x10\x95K\x95f\x9f[{\xeaa%\xae\xf3\x1e\xa3B\xd9t{\xa5.\xfd\xaa\x0d\xfe\x1f\x82\xec$\xe9\xc5E\xf3\xc0\xfa\x1c\xa5\x11\xbb\xc9V\xe9#\x9d\xcf\xae\x94\x0b{-\xf0\x9a6\x0e\x0eX\x9a\x8e\xb7\x85d/\x0f\xce\xbc\xc7\x1b\xa1\x92\x01\xa2,\x86\xc55\x9b\xff\xe2\xe1\xee\xbcH1\"\x8c7\xf6OW\xc2\x95\xf8\xcc\x159yP\x08\xac\xa00p/.,G5\x1b`A\xfa\xddb\xaf\x88\x0c\x1a\xa5\x8eS\x84\x8a\xaa\x97q(\xe45\xcf2\xc3\x9c\x12\xe4\x14\xc1\xbc\xd1~E\xb9\x13\x04\x93\"\xa9\xa3t\xa4\xc37\xd9\x91\xce\xa8\xe3\xd9\xad3L\xf5\xa3\xff\xf4\x12\xb9\x10\x93\xd0\xa4\xcc\x98W\x89\x07\x07Z\x0dTu\xa7\xe9\xbdkW\xff\xfcM_t\xd4\x84S\x04\xcd\x98y\x11/\xb7\xa4{yK\xbc\xe8,e\xdfR\xa5\x0c\x0d\x9c\xc0\x92\x84\x83\xa2\x06\x86;\x1dm\xfe\x8b\xe9\xfd\x85\xf8?;\x1fU\xf6\x8fE+wq\xd3}X<\x98\xa7\xca\x1fr\xa9/\xc2\xc6\x1f\x1f\x84<<\x0eV\x87v\x9b\x8b\xbb\xbe\xe72\x84\xc8o^h*+B~N\xec\xbd\xe8\xa4\xd3\xf9g\xed2\xae\x92\xfe\xa7\xb8\xfc\x92\xaf\x12\xa9\xaa\xce\xf8\xa5\x1bM&\x84\xe9\x0a\xe79\xcb\xc7\x93\xb3\x11\xda8tDr\x9a\xf7\x8e\xc2\xd4\xb1T3O\x1c-\xf1\xca\xdaRh\x18\xbb\xbb\x1d\xfcO$1w\xef\x932\xd4U\xe9\xfe/\xf29\xab\x05tdG\xac{px<C#4cm\xb9-\xdf4\x8a\xe7\x8e\xdc\xc5\x0dsBn\xfd\x85\x94\xd6[a\xc6Xn\xd5\xd2E\x0b\x1ePI\x80)M.\x01HK\x19\x01Gy\xa2,4+Cjo\xda\xc5\xb0$\x88\xcbVB\x01\xfb\xe80ft\x1f\xe88?\xb8X\xedw;\x17Q\x8c\x8d\x05._Qj'\xcfl\x87\xde\xfdb\x14\xe4\x12\x95B8'\xb9bu?\x09\xce\xec \xa2\x0b3_\xb4\xb4\x9e\xd9v-\x87\xd3\x8f\xd4\xb1\xef\xa7\xa0\xa9\xff\xab\xf7p\xa2\xac\xcb\xd5\x97X!\x08\xfd&\xae\x09\xca\xb5\xc1\x97\xb1]Wga\xe0\xb7\xcc@\x04\xe4\xc5O\xc0\xa1B-x0k\x88f\x9a>\xe1\xb2\xa9\x0f\xf9xJ8\x8d\x9f\x07\xf5w\x05(\xf0X\xa66\xeeS\xe8\x95\xad\x91~8\xb1\xc4\xc4V[\xc3\x99jx\xd0\xc5\xbb\xcaw\xadf\xcdN\xcfNA}*q\xdf.e\x0b\xac}]\xb0\x9d\xa6y\x81\x9c\xf7{1B\xbd\xeaUd\xc2/\xc8\xa5\x0f\x89\x9a\xa9\xed\xfc^\xad\xef\x9e&\xfega\xf6]\xe5\xfb\x9cR3\x90#\xf9\xa9)\x0fp\xd1\x1b\xd3\xef\xdc\xd4\xe26\xe5\xb7\xd0\xdd\xf3\xb0\xd1<7\x9f\xd2\x80\xe5sj\xd2%[:\xddmA\xe66o.\x94\xfdQ3\x01C\xd9\x93\xab\xbb|C\xfa\x1b\xfeO\xd5|\xca\xd9yo~l\xabSL\xf2ug\x8b\xdb\xd0\x93\xad\xe1.QP\xe8\xc4\xde\xf4\xfc\xbai\xa49R:\xf7Y\x8f\xfc7\xf2\x13\xfe\xb6\xc7\x07\xf3\xfa\xbcO\xa7\xd1wj\xd1B+\xe7I/z''\x1a\x00\xbe\xe9\xfc\xcc\xb3\x87\x9a\x07\xa2\\s\xf1\xe4\xa1\xc0)\xd9\xd0l\xc2\x9f\xac2zm\xebh*<\xca*\xcf\x860\x1d\x04\xf0V\x0e\x11\xf4\x8e\x941\xd7D\xd1\xbf\xd1\x0a\x9c\xec\x05s4B\x98U\x82\xa40\xfd\xb6]4?1*\xac\xa6\xbfKy\xb9\xf23\xc7\xcc\xf3\xcfH\x9c\xb4R\x94\xba\x83=\xaf\x85`\x87l\xca\xaa\xf9\x8f\xf58\xde\x83\xe5\x15\x02\\\xdb\xa2\x88\xd1\xf5MH\xb0@\x1e\xdb\xd4M\x16\x0c\xff\xfe.\x99\xf3!su}\xdb3\xa3\xfcma\xaf\x08H\xe8i\xf3\xb3y*\x9d/\xe4\xa2L\xcd$g\xf6H\xcd\xe7\xaf\xe3j\xf9^\xc3\x91+\xd1\xed\xb2]\xb6+\xf8wB\xb51\xacM\xde\xe3t\xea\xd3\xa19U\xb5\xb7\\\x19\x9a\x00 %\x07\xc3\x89\xd9\xcf\x09\xc8p\x80\x1a\xcdR0\x0ai\xc4$\xf1)\x95\x9bc\xbbhW\xa5g\xbe\x08\xd3\xddx\x8b\x18\x16\x92R\x1b\xdfE\x90\xe8\x04i\xf9\xa9\xcb\xbc\xf1\xf8\x08v\x1a\x08\x0c\xcb\x09.\x08\xd0\x90'\x88\x9fhf\x1d\x14k\xf4F\xce\xd0|P|~\xa4\xc7\x99\xbb\xbe\x80\x9a\x19\x93\x91\xaf\x9c\x9dM\xce\xa6\xb0\x10-\xda\xfbQA\xf5\xd2}|\x9f\xe4 \x97a\xed\xf2P\xb3\xcft\x0ah\x0f\xa4L\xc3\xa86\xbe\xcf\x8dp\x81]Pu\x0b\xd5\x1e\x96\xc9#\x0f\xfc\x90\xfe\x89\x8d\xb0\x94\xf7\xbd\xed\x96T\xa0\xe1\xd3\xb9\xd7'\xaf1\x8a\x0dfL*\x1b\xd8F\xcf\x10\xe4{\xe5\xdb\xa9\xdb\x1e\xbaz\\\xe3J\xd6\xee\xe7t\x8d'\xf4\x80\xba8L\x99\xdb \x0a\xbb\xc3&_~o\xb7\xa7\xae\xa0!\xd3\xea\x14\xa2\xa7\x16^/\x83Lt\xbe9\xd6\xd2\x86\xd5\x7f\xc6\xab\xbc\xa8\x9a\xa5\x16\x07\xc0\xba8~+Q\xbaW\x17\xcfv\xd4\xa8\x15\xd6\xce\xf1\xee\x04>\x98P\x0eO2\x0e\xfaOV\xe0\x1e\x94\x83\xee\xe4X\x85\xe1\x1ah8\xa7>8,\xe7\\\x998\xad\xf3\x0b\xde\xa49\xca\x92)\x04\xc2a\x84\xa9`\xcf\xff6;_\x94\xd2\x8e\x01\x1fU\xc5\xf7\xc8)\xa5\xc1\xab\xdb\x99\xfa\x97\x0b!\xc9<*0(\xa3E\xb2Ei\xf8\x9a\xd7\x8c{5\x1d\xfc\x9cvB\x09\xa4\x9f\xda\xd9)\xd6\xc5i\"W\xc6\x89\x15\xc2\xc5vI\x1d\xb5\xba^K\xcb\xdfI\x1d\xcf\x02EW\x88\x99\xe9\x1c)\xcf4`v\xef\xce\xfaH-\x0d\xcf\x1f\xdf\xc4\xb1\xf99.\x17L\x01\xafd\x9f\x97,7xR\xae\xc9\x09\xfe\x1e\xbbkM\xba\x90\xc64yi\xda'x\x9b\xe6\xc9\xd8\x91c\x99rZa\xeaA\x8ch\xd2\xdd]\xe5\x85\xf1Y;\xb5il\xc5\x0e\xd4\x8f\x0b'\xb9\xeeg\x87\xe1a\xf6y_-Ha\xc7\xfe\x1bH\xf5\x13A\x1cw\xddU\xc7 \\\xa6\xd0\x9e\xb1\xf8t{O\xc9\x17\x8e\xa2\x05\xbc\x02\xf1\xa0jL\xe2v*r\x15\xacQ\xa1F\xa2\x8a\xa1\xecH\x0a\xfb.\xba\x8b\x8c\"\xc7\xa92\xeaI\x19\xe9\xda\xbb?\x05\x0c\xd1\xb5\xc7\x10\xa5\xdd\xa5\x9d\x0f`\x88\xd6t\x9d\x9ck\xd8\xd0u^P\x08\xea\x89\x90:dQ8v\xaf\xbe:D\xabS\x8d\xfc\xb7\x81Fm]\xc4\x810!+\xb8:L\xb5\xc5\xe92\x0f\x92\x190\xff\x8d\xc7\x7f\xadM\x85#2\xb9\xb2\xb9\x82\xf0C1\x86\xb3\x95\xfc\xa7=\xc3#\x83ZF.\xf9+eE;\xca\xa8L\xb1'2\x1c\x86v\xb4gJW\x0fzZ9\xcfuz\xfa#rN\xcf\x82S\xec\x14\xb8\x9b};\x05\x81\x119{$2\x80?\xf0\xf5N\xa0\\\xa3\x90\xa6\xfc\xc4\x8f4\x84\xd6(\x08\x16\x8e'\x18\x91\xca>}\x13\x12\xc7\x11\xa6\x1cQhj\xd6\xa2V\x13\xfa\xad\xd0\x0a\xd2\x03\x03\x16\xa9\x94\xda\xd7\xcf\x89\x8fSF\x09\xdc\xa8\x0f\x19\x01\x0f\xd8\xda\xa7C\x9d\x18\xb7\xd9\x88?\xbb\xde\x1c\x92B\xdd\xd8\x9f \xbf9\x00J{hg\x90Z\xf7\x90\x0fn\xeb\xc3\xdc8]D\xdc\xddC\xde\xad\x90\x94(\xf7}\x04\xa9\xfc\x86\xd2\xaa\x14\x15\xadJT\xb4\xd1\x9b-}\xd9\xc3\xdb\xa0\xc3K\x05U\xa2\x13\x1a\xb8\x0c\x7f\xcd\xa4\xb3*\xcd \x9f<\xd2~\xc2,\xcc\xcd\x1b:O\xc6\xb0U5\xa0!\x13#gZ\x89\xd4\x06T\xf5\xf0\x073}\x88\xe0\x92J\xed\xe9Z7=\xbe\xb2\x0a\xe1P\x00w\xa2x\xa0\xa7B9\xb1\xd9\xcb\x04\xc1\xbaz\x90\xf20\x8f\x06Tz\x96\xbb\xb5>\xb6\x7fC\xe1\xef\xac\xa3\x02\xfd\x92\xf2\x90\xde\xbed\xbd\xb5\xcf\xcd\xae\x9d'[\x8cf\xef\x8a7\xa7\xd3Fq\xa8-8\xa0\xc9\xe24t\xf2\x99\xe8\xe6k\xe7f\xd1\xd4\x8b\x9e`y\xa6;\xfb\x04.\x06[\xe6\x9b\xb0\x0f\xfacM\x1af\x87\x98\xdf\xf7\xa3:\x9077\x16.\xe0\x84\xdbT&\x7f}\x07\x0d\x0a=\xef\x04\"\x8d\xf2\xf5\xd1\x88\x19\x86\xda\x9d\x02u\x0a`S_;#\xef\x90S\xcc}\x04\x16.U\x88\xcfU7\xc1\xa8\x03\xebc7\xd2\xae\xa0\xb42\x0ax\xe3\x0e\xd3\x85!\xe8eJ.\xb8\x80U\x0f\x9f\xeb\xa6O\\\xe5Y\xc4\x88\xe8oG\xe0}\x1f4\x88\x07\xa7\xc9m\x9f(\xe5\xbe@<7" +
+	"\xd0\xe6h\xacf\xa3\xadz9\xe8\xad_y\xf0\x0d\xf7\xec\xe4\x04\x8f\xa0\xec\x1bG\xae\x8d\xc9\x93\xf5\xb3\x9e33\xf4;i\x8el\x9c\xb4\x9a~9\x88dK\x86\x84}#\xe9\xa3Xs\xef\xfa_\x0e\x0f\xad\xa2\xdf\x13&\x1a\\\x8e!\x19\xce\xcf\x03\x0eae\x1b\xbc\xc7\xeeO\xfdS\xf7\xc0Rc\xa7\xbc\x17[\x90\xc2\x83\x13(_x\x82\x941\x80\xda\x1c\xbb\xe6\x81\x83\x94I\xe1\x95RF;\xbcR\xfe~i-c\xf6B\x9e\x0c\x8ak\xb7R\x8f\xe2\xd9\xc4;\xbc\x17:P~\x9c\x0d}\xac\x1b\xb0y4>KM\x01\xcf\xa7k\x81\x19\x00\xeb\x9e_\x85\x1e\x90'#\xd8X'\xd7\xc5J\x17\xb3f\x93\xbe\xa3\x94\xbe\xc1G\xee\xe9\xd5/\\\xbb\xa0\xfel\x12\xa7\xefO\xe9\xed\xa7}\xdb\x94\x939&\x9cs^\x98}\x08\x88\x0c\x9c@\x15q*\x03\xb0DXN\xc6e\x89i\x9b\xd1\xb5\xdfs\x14HS\xf1\xb99\xf8\x94\x0b\xbb:\xf7\xedT\xec:ePTVg\xe7\x98\xcc\xadg\x8eaM\xa9\x94s\x8ab\xbe\x81\xf4\x927R\x1a\xed\x9a\x8a\x17\x92\x09\xb2\xae\xbc5=\xbf\xd2\xfc\x7f\x8b\x9c\x03Z[\x80`\x1c\x9b\x8av*'\xea\xb0\x9c\xd1\xeb\xdcYK0\xbf/M\xf7=[\xcf\x10\xeeS\x1b\xad*\xa1\xfb\xe9\x92\xc5\x9f,\xb1\xb2\x03\xf5\xb5\x1b\xd5\x18mj\xd2;k\xc6\x9b\xf2~YY\x82\xd3\x96\xefL\xca\xf7\xe8\xf9\xe4\xd2\x02\xeew\x8b\xfb\xcd\xda\xd3\xe8\xe1\x16V\x94\xb41\x1c\xca\x04\x05<\xe2\xc6\x03\x17\x0c_\xa3\xd8\x9d~2\x1a\xda\xfa\xc0\x82\x91\xd2\x92\x10\x12\x17 \xcd\x90)\xf1\xa4\x18fC\xfa(\xfdO3)\xcf\xd2\x0d\xefN\xb2fY8\xa9\xce\x12\x90\xaa]Ez\x13/o<\xf7\xd4 b\xc1qj\xfa\x1a\xaf\xc4\x13\x88\x18`h\x1869\xa6\x89u*%\x90\x03\xabI1\xc1\x18U\xb0T\x9c\xf6^\xd6/7~\xc3\xe7\xffd\x8e\x7f\x1a\x8d\xb1\xcb\x8cMz\xc5\xb4\xc2,s\x1a\x12\x7f6q\x1d\xa6\x13j\x97\x8c.\xacr\xea\xaa]\xd6&\x1co|\x0d8\xf4Z\xf8\x85\x16\xcdA\xd2j6\x8aFaS\xb2\xdc\xec5\x99|u\xa8\xe3\xb63\xad\x8e\xf54\xe5\xd6\xfc\xb3Q\x18\xe9\x94\xaaU\x1bv$X\xae\x0dj\xc5\xdc&\xae\xfdn\xd2\x85\xbf\xed\xad\xc4\x9c\xda\xc9\xe3\x91\xa7}VW2M\xd2\xb0\xd7Q\xeaY\x08\x02r\xef\xd0\xfcAlX\xfa$\x18l\xcf\x85\x84\xc0{\xca\x9f\x11\x15\xc4\xd8i\xfe\xe0\x951\xcf\x9c\x19\x7f\xe9\xe4v\xf0\x1f\xe9\xfd\x1c)0\\\xf4\xe0\xe2\xe1cj\xb3\x8dhz3\xce\xe8\xcd\xa9\x86M$\x9f\x9b\x03\x00@\x17\xd5.\x85\xb9p\x03l&\xc5l;\xe6\xf80\xbf\xc0\x8b\"\xcdU\xad\xda\xbeO\xf9\xc2f'+q\x15\xe8\x0e\x91\xeb{3\xdd\xe9<tF=\xf6\x97|\x9d\x09\x04\xa5\xb9\x06\xe2/\x91.x)\x8fU,>\x93\x99;4\x1f\xf2\"8\xef\xc3\x1bd\x9ffRF~\xfe\x93\x13J\x99\xa8\x0d\xd9\xda\x8f\x13\xd0\xc6,\xb7\xf8&=\xbbN\xb3\xe0\xb1]A\xdf\xbfN\xa7\x97\xb1\xc4]\xe0\xfdvH\xda\x99b\x93\xb7h\xa6\xe3s(@z\x09\x1b3\x0a)\x0f\xd0\xfc\xc8\xf8\x0d\x02\x19tae\xde\x05\x8780\xc8{]nNx8\x07*\xb4)\x97\xc89\xd7\xf2\xb0f3\xd2?\x07^k\x00>s\xd7\xf3=\xef\x97\x9bI;\xd7n#\x93.\xdf\x8b\xd4\x02\x98qp\xf8D\xba\xba\xf9~\x8by\xec\x82\xf2\xe7\x8a\x16M6\x8b{\xf4\x14\xcdZW\xd2\xed\x93V\xd97yp\xf9i\xc1GX7\x8f\x1d\x1fa-\xf0\xc7\xbcwy\xa4\x97\xb8w\xc35\xb1P\xcb\xa9*\xb5n=\xc2\x09|y1*N\xa0\x0d\xa4b\xee\x0e\xd4f\x93\xfe\x09\x12\xf3\x8c\"\x07\x9c\x8fk\x0b\xbbVf^\xa5!\xd8\xf6\xeeS\x92\xc6\x06\xed\xeer\x98W\xaaL|pT\\Qj\xcf]\x1c\xd9,Y\x03\xba\x8e\x98V\xc7i1i\xb1\xda\xf3\xfd\x0d&G\x1d@|\xcdrj\xcbl[\x11\x86\x0b\xa7\xa1\xba\x11\xc5&Z\x82o\xed\xd4\xa6\xa1W,\xf6\x9aze6\x93?\x8c\xcc\x1c\x12]O\xfc\xdc\xbc\xe0qiZ'\xe7o\xce\xf7!\x9ad*\x83w\x84\xc78\x82Q\xef\x9eZ&\xe9\x0e\xba\xffj\xbd\xff\xac\x9aqy;\xa0\xf5\xe3p\x09\xaez\xd5%E\xc2;\xad\x9b\xf5\xc4d\xdf]\x09\xbbVvB\xcda'wp>A\x17\x90\xf1l\x08\x1ev\x8f\xecii\xbc\xef\x86D\xeb\xffX\\ea\xa8\xc7\xef\xbd\"\xb7U\xd8\x86\xc62G\x0e*\x1f\x94\x8c\x0f.\x1d\x153\x04B\xd9h\xe2\xb2\xc8m\x95/q\xd6\xeb%\x86\x14&i\xde\xdb[\\I\x84\xf7\x83\xa9Fl\xd3\xe8\xa5\xcaHs\xa9n6`)\xa3dO\x11Ft\x15\xa5\x12G\x04n\xb2f\x1f\x0b\x0ce\x82@j\x04\x8cb\xc6\xd7\"Z\xa8\xe5\x8a\xeeU\xc7\x04o\x1d\xf9\x9d\xa4z\x85\xa7MO\xf9\x83EF\x84\xf2Biw~\x02\xd8\xa0p\xd9\x14+\xae\xcb\xaf\xe42dg\xe4\x8c\xb3\xe6\xbd\x9d\xda\xf9\xd2\x92hg\xee{d>\xee\x1d\xdew\xeb7\x9d\xa1\xba\xf2U\xc8\xd0\x05\xa2oa\xbf\xf7\x189\xe2$\x0e$\x96\x80l\x8c\xec\x19W\xde\xd1\x0e\x88\x90yW\x02\x12_,\xa1\xf3Y\xa6\xa5\xdc\xf9\xc9\xba\x13\x00\x8eI2h8\x9a\xee\xb9<\xe27\xe5;\xd3i\xb5\x95\x0c@DF\x04\xf9\xf94\xcc\xbbv5\xde$ \xd9WiY$h\xb6\xd7\x04I\xbb\xe6\x8fd\x92\x7f\xa1~\xf7\xd8\xae\xb0\x1c\xde\xda\x0af\x0e\xa5\xaavVD\x8b]\xddy\xd2yt:\x0b<B\xf2\x90\xed\"d\xa7\xda\x85\xbb'V\xa1\xaey.\x1b\xbeb%9\xf9\x05\xf2 o\xc2\x0cAw\xbe\xe3Jl\x7f\xc6\xf5\x89\xbb\xbd\x87\x9b\x13\\\x12h<A\xef\x98\xea\xf9)\xaa\x98/\xd1\xaf\xd7\xf2\x04\x7f\xae\xb6t\x9d\xab\xf2\xa6[\xa2\xad\xe5%\xcb\xcf\xc0\x7fIb\xa5\x9aO\x90\xaa\xcc\xc1\xf5\xeb5\x9b\xcev!\xb9\x06O\x04W\x121\xd1\xfecA\xc67\x8f2\x13\xe7=l2\x13CMV\xee\x00\xee[\x1fm\xc3\xe8]\x15\xfcJ\x99\xe3\xab\x12\x8a\xa8\xc4\xe5O\xef\xfe\x8d\x16\x9f\x93\xdd\x04f7\x8bu\x90\xe1\xfb:\xe9\xd4F\xdcA\xdc\x1f.\x95FL\xdaish>K\xb7~\xe1\xe8\xa7\xcdB\xf4\x88J\xca\xf0\xb6\x8bH\xf2\x864\xc4k'4s<\x18\x99\xf2\xc1\x14\xeb\xb1\x0a\xa9|MY\xff\x01_\xf9u\x0fAQ\x86x\x14\x17\xda\x04\xf0\x1e\xa4\xe2\xba+\xc4\x93\xcd\xc6\xe2I\xd7\xecRq\xe6\xa1\xad\xb4\x93\xca\x97\xcd\x87TX\xae\xafzj\x93'2\xef>!,\xcb\x05\xaa%\xc0K\x13\xc9j\xa6\xa3\xc5\xfd\xb7\x81\xd6\xf7\xeb\xeb\x1c\xe8\xde\x01\x18(\xcc\x89y\x94\xc9\xaa,\x99\xa0\x82\xf7}\xf3C\xb6\x98u\x16\x94rB=\xd1HL\x92q\xc6\x89\xb6B\xc7\xc1\x14HX\x81\xdf\x12\xa6\x87\x16\x10\xa5\xdb\x0e\xcd\xf6\x84\xee\x17\\\xc7\xe1F\x06\x01'\xe6\xb0q\xe2\x8c\xd0|i\x95\"\x1e\x15Rc\x8e\x89O\x1d\xe3!,\xc7uL\xc8\xb0\xae\x0c\xe3\x1b\x03\x9d\xf5\x930E7\x1b\xbe\xcbz\x18\x0c\xef\xe5w\x19\xd1S\xc9\xeb\x00a\xcf\xfbh\x8f\x0e\x8a\\3\xee\xb9.\x80q\x10$\xd3\xe4\xa8Y\x14H\x1f\xe1\x85^\x18\xaa\xdfJ\x01\xe0fB\xb3q\x00$\x05\xaaD\x98\x0a/\xb8\xe4\xc4\xa9\xc8\x86\xec\x15\xff\x02\x0e\xe8\xe6K\xdfbD9\x9e\x9c\xc1\x1f\x96{\x98\x0a\x97s\xa5\xbe\xa2$\xe4\x0e\xcd\xdelbsh^d\xa0\xa8\xfbg\xc2\xc3\x13<5Y\xb5\xf2\x97\x80\x02\x0a%.\xdar\xea^\xb2\xc0\x9b\xb3\x9eg\x00\xb0E$\x96g.X\xa5O\xdc:\xa6\x89\xad}\x9c.\xca\x9f\x1a#;\xd8v\xa8\x81\xd98\x83\xbd\xd0S\xbb\x80U\xedd\x0e\xa7\xaen\xe3\xd4F\xd6\xd4/\xdbE\x7f\xa4\xfd\xf2\xa9\x88Q\xb99\xefyR\xa1\xa7\xf6\xc4\xa2^t#W5\xee;\\\x8e\xc0\x0f\xb6\x1c\xa01\xb1\xd3\x1eA)\x1e|\x16\xc8\x92!\xfe\xc0\x99\x8f\xa9\xe4t\xa8\xc6?\x8f\xdeW[\xe9\xf3zRoD\x19\x12:\x8c5\x7fU\xa2\x926\x007q!\x01^\x8a\x13?\x83\x05\xcb\xb6\x98^\xb4\xad\x83\xa88\xbf(=ni\xc3\xf0\xede\x12\x01\x83M5c\xf1tz\x14q\xae\xeb\xca\xe0\xec\xd7\xa9I5\xd7bV\x92\xf0\xc0\xa8q<\xd4\xce\xd1a\x14\xa5\x932\x91$1\xa0.p\x9a\x08\x1bQg+\x10'\x86\x05\x01l\xfb\x90\xcd#e\xd5\xe9\x9f(\xdb\xa5\xc1l+/\xf9\xea\x89\xa8Y\x16\x80r\xe4=J\x89\xd5xs\\q\x18\x0e\xe4\xeej\x91x/\x8dCkKX\x0d\x8d\x08\"'\xab \xd1\xdd\xcc%\xa1]+#\xb3\x05^s%\xdc\x83\x90\x13U\x94\xc3 0{\x81v\xa9K=r\xcba\xc6\xbe\x9d\xcb\xa5\xe5~\x84\x0fd\x84\x08\xe4\xe6\xa6\x93\xa4\xa2t\xc6\xf6\xc4\xd6*\x83\xfck\x14\xa0\x04\x81 j\x9f\xf0z\xff`6S<\x0a\xd5V\x84\xc0>3\xe5_#S\xda\x80Q\xbf\xed\xf5\x12\xb1\x91:+\xc4\x9b)\xdd-\x15\x02v\xed\xaco\xdd\xf8%\x1dM\xa0'\x97\xab\"O\xb6~`\xd4\xf2'\x83$oz\xb4ECNS\x0d\xe8!\x02f\x85\x80\xa4\xea\xba\xc1\x13\x8b\xaa]\xc5\xfbD\x11\xdd\x93\x9c\xf3\xdb/'\xee0\xecj\x89\x88\xd6L\xd8\xd7{\x0e\xa5\xde\x10\xd5?\xb0\xe6\x00\xf3\xd6\x04\xf3\x8aD%\x82\\\x06\x17\xfd\xd2\x84U[\xc9g\x0f\xe0`v\x0aW\xdc\x81\xdaV^\xe8}\x0d\x14hs\xd0\x0f(\x87.\x1f$<\x7f\xcaF\xd2w\xe9l4\xc7\xed\xf5<\x9d\xd2\x05Z\x1c\"\x9f\xb0\xe6\x84tq\x80\x82\x0f\xbb\xc6X\xf6\x8e\xa7'[\x9e\xf0\xca\x9fYD|\x00\x8a\xb6\xff\x90-\x8b4,\x02R\x84\xf6\x18\xde\x96\xa3\xecq\x9c\xe9o\x03\xa6\xd7u\xaer\xfe\x7f'\xfc\xf9\\\x11\x10\xac\x82+\xe2\x9cXew}\xab\xe4\xb6KY\xb3\xe3I\xb0\xa3j\x04\x0f6\x0bl#\x09p\xafj\xac\xc7U\xe6[\xbb\xe4\xc2\xaf\x0b\x02\xb8\xa8\xef\x14\xee\x7f\xb8\x8d\xea?\xa4L\xe5\xb3Q\x96\x1b\x15\x8f\x82\xf3\xabh\x9f\xda\x9d\xd4!+\xb9\x9bC\xb3\xcet\x91\xac\xaa\x99\x93\xe2\xcfMVb\xea\xdat\xc3M\x18\x8af\xb0\xddu\xe8\x06<,D!~\x7f9\xe8^\xe4\xe0\xf7$\x03r\xf4\x7f<\x87\xaeB\x027\x93v\x09\x80\xb1\xf7\xf7\x90fqP\xba\x87\x98>\xb9\x17:[6\x1ds\xadd\x17\x1e\xf2\xa6\x83h\xcf,5{hJ\x9a\xee\xc1{\x15B\x8d\x81\x1e\xe8\xa1,\xf2m\x1f\xc00L\x18f\x81\xda\x94D\xee\x9bW\xa9\xab\xbbT\x11->\x93\x1a\xe3O\xfb\xf8Kj\xeaW,\xc5\x1c\xa1'7_\x10\x1d\x94\x8b[\x10\xae\x1f8\xc4$+f:\x02\xa5\xca\x04\xa1r\xf6\xbb\xaf~\x86\x80\x1bk8\x8fE\xe9+\xb1\x9a\xf3\xe0c\xf4\xa5\xaa*$\xab\xd3\xa7\xe8J\x1e\"\x1a\xbf\xfb\xd2\xe5\x0bc\x7f\xbd\xd7W\xa3?\x1e\xc07\x8f\xc8\xe7!YZ\xcc\xf6\x17\x0e;\xe1\"\xd1mq\x07\xfbWW\xae\x9c\x12W'\x1e^x\xf4\x84W\x9a{\xbc\x80\xf5HM\xdf]k=\xd4\x8epw\x16\x93.\x9c\xd8\x03\xa3\xae\xd7g\x90\xc5\x9ev\xeb\x15ix\xb8\xa6\xefvI\x84.\x95!\x159\xea\xd5\xb0\x1eh\x85:\xf9\xd8\xb0zFGL\xa7\xea\x93\xda\xe7\xce\xe1y\xb7\xeb!k]\xb0j\xe5p\xa3u\x15\xb8#\x15\xd3B\xb7PO\xf0\x10\xd4M\x00\x9e[\x0d\x0e\xf1\x0cd\x05\xbes_0\xbb\x0a!Mf\x8f\x8d\x91\xdf\xa3\xf9\x9f\x93{\xf8l-\x92\xf6\x95\xd1\xb8\xa7\xc9\x8eD\xc9V++\xf8\xc6\xcb\xbal\x11\xa8\x85\xb1r\x9a\x03[\xe31\x9c\x95\xd1\xd8\xa1\x9ee]m\x0e#4\xd8e\x1e[\xf4|\xd5\xec\xd1&\xdcZ\x16\x15Un\xb3\x9a\xc4OI\xeaZkm\xd5\x01t\xe9\x17\xc3\xd5\xb8S\xfb\xdb\xab\x9e\x12\xf4\xf8\xab\"+\xfd\x0a!\x98\xbdKP\xfdh\xb6\x9aXN\xffHg\x8c\x10nFh/@\xd6\xb8\xe7\xc5R\xb0\xd6\xda\xb2j6\xb9L\xddpw\xd5}\xa8wl&\xfd\x8b\xf6\x07\x07\xe9\xed=\x9a\xf8\x02\xd7x\xae\xc0\x82\x0e_n\x0f\x95\xa6\x97e6\xe2\x03-\xa0\xd7Q\xb1\x80N/\x931\x19\xfb\xbd\xc6\x92\xd9\x95\xc9b\xde\x0b\xb6\x9a\xc1'\x93~,\x14pWR\xe4dl\xf7\xe4O\xc9\x0fn\x82<7\x8e\x9d\x98L\xab\xe8\x96\xa90\xc7DK\xa9\x12\xf73u\x059UB\x10-\x9dy,\x9f\xa9\xfa\xa8\xe1\xb9\x94\xd7\xe1\xbf\x7f\x8a\xdb\x0e\x910\xed#k\xf1\x84U\xcaC\xe4z\x8f\xcd\x8b\x877\xba\xa4\xb8\x15\xa46\x94\x04-1\xd1\xde\x05\xdd\x03Z\xfb\xc2\"\xba\x0a\xd4\xa1\xc7\x8eZg&\x90\xfbgx\xb6\xb3/]t\xa1]\xae]\xf5\\\x90\xcf9\x97:K\x04\x09JW\xae\xd2Y\x94\xae{\xd6Wn\xdf\x8b\xff\xf1\xb7HX2\x10\x96\xff\x0eI\x0aIpK\xcc\xbe\x14\xb3\xd2\xa5\xff_\x8b\xea\xc5\x14\x9a\xa7\xff(\xf9u\xf6\xe5<\x0a{*o;\xf8\x0exn\xec\x9b7\x9e\x01\\\xa0pD9\xf3\xa0'\xdb\xf7\x06\xcd\x8d\xfa\x03\xdf\xe9\xec\xcb\xc5\x08\xe1\xc6\xcaT\xd4\xe6\x1de3:\xae]\x09\xb6\x00\x99(g)&d\xe2\x9e$\x09\xacH\x995QR,D\xcd\xee\xa5\xd9\xb8\xdf\x1eE\xa2n@4\xe0\xcbe\xc7\xe7\x1b\x02\x16\x04i\x9d\x0en\xb9\xa79\xe0\xd4\xa2\xee\xd9\x97\xab\xce*\xd0x\xa2\x0e\x15\xf2?\xbeE\xb8\x7f\xf3\x841\x9b\x84\xf0x P:\xfbr\x1d\x0c)\xe2\x94\xae\x89\xe1\xad\xf3\xc1\x8a!\x91[x\x8d\xf2\xff\x04<?\xb2.?SpeP\x0d\xfd\xb5s(\x16\xdcC\xd6<\xc9E\xf9\x0e\x88\xac\x05\x19\x07\x8b<\x9a\xdf\xcdO\x85S\x0e\xbc\x80\xf2\x06|\xf6\xe5\xc6t\x86\x98]Q\xe7-\x1b\xd9'\x0f\xd6\xef\xd5R\xf6\xb6\xca\xb4\x94\xb3^N?e<\xd2\xe6\x84&\xb5\xb3/\x19\x86\x92^\xaa_\xc9\x9f*^\x17\x92\xb1\xa1K\xb5\xe2\x8a8\xff\xa1iP\xfd\xa0;]D\xb2\xb08]\xeeJM\xac\x19\xe4ZGE\xcf\xfcL\xa3[\xec\xaa6\x0a\x08\x86\xbb\x99]\xf3\x89\x97\x80\x0d\xcf\xd1\xe0i\x1d\xb1}\xc3I\x1e\x11\xebX\xdc\xbd\xb5\x9c\xad\x9d\xdeN#\x14|\xa7\xb4d\x07\xb2\x08\xd6\x87c\xd5c\xa7gF \xbf\x0dE\x92\xe4?J\xd0\xcc\xbe'\xc5k_\xbb\x0ahp\x1b\x001\xf5\xbb\xc6P\x97\xd1\xd9\xd9\xf9\x095\x13\x15\xb5\xc2\x86\xd7\xecxWh\xbe\xbb\x15\xdb|w`f\xc3\xdb\xf1?\x7fK\xe9I%\x80q\xaeI;u\x92\xfeH\x0d\x07K\x99 \x1f/\xcdF\xce\xe4V\xca\x16\x15pt\x92U\x96\xba\x07\x0e\xb9/\xc9A\xba\xbb\xc7\xe2P\\\xf4I\xe7H\x0aK\x05\x92\xac\xbbf\x0a\xb3\xdbVr\x9f\x8a\x89\x0e\x098\xbc\x0d\xaf\xdc\xd9{u_;t\x1c\x11\x0e\xba\x18\xc3\xc0'\xcd(K\x09\x96\x06@\x0a\x12\xbd\x02\xca\x99k\x0fp-\x05\x83ZR\xed\xbd\xb7\xee\x06$\x0a\xbd\xbe\xbf\x0f>@\xb3S_\x88B(2\x18O\xba\xa2\xc9/\xe1\xa6J\x93P\xc3\x02\x8a0[\xda\x10\x04(\xdb\xfcL\xfc\x18\xda\xf3\xbf\xa5\xc4\xb1\xc4C\xc7\xb4Z:}C\xa7\xad\xc8\xebn\xa2\xe0\xdeR\xd7s\xc1\xdfe\xd0\xb4Y\x84\xa6\xb1\x7f!\xef\xc4<\x8e4L\x82\x0fR i\xdc\x0c\x0c\xfe\x87su\x9c\x0d\x97:\x1c\xfa\x9d\xec\x1dS\x1a\xee\xd2\x9bt1\x10\xaa\x1d\x1a%\xfe\xa1S\x12(-\xbanx\xb2\xb9\x9c\xb0\xf4\x0d|0&\x1f\xb4\x10]\"/\xc8\xba\x84\x9eJ\x05\x0e\xff\x97\xed\x94ggw\xa7\xec\x15\xae\x992\xc4\xf5T\xbdF\xc3\x1c~N9\xc7\xce\x8e\"E\x95\x8a*Q\xf4\x1e\xfe\x9d\x8d\xe3<\xcb?F\x91r%`E\x12\x06\xe4\xe8;\x079xj\x974\x8d\xb8\x03\x0dx\x8b\xc0*9\xc4\xeew\xe6\xe87\xde\x9b\x8d\xe0\xc1\xe6b\xadi\x1e\xa6\x96\xc5\xb4,\x15\x19\xd8l\xd8\xe1\xfb\x83r\xf5Y\xd0\x8dr\xd0\xf1\xe1\xc6,\x89J\xbb\x9e\xc4<h\x09\xc25+<\xe3E\x9c\x1fk\xe1\x09\x0b\xbdGk'\x8a1a\xc6\x89\xece\xc4\xc1\xb6=\xe8\x0f\x86\xfb\xb2kn\x1f\xad\xaf\x95eN\xbb\xb1v\x01\xa6\x99Mu\x06R;\xda\xd4R\xdep\xda\xd6\xee\xfe3\xc1\xbb\xe1\xec\xe1\x991w$C\xd0\x1cu&h.,\x8b?g\x9a@T\xa5\x90_\xe2B\xbb7\xcd\x8e" +
+	"\x91\xc7\xbb\xef\xdaZ\xb3\xa9t\xe4\xa4K\xca\xea\xe3q\xe8;\xcccn\x8e\xa7m<\x96\xef\x05\x94A\xa5\x97u\xddQ\xe9\xe1\xb6Xf\xc9\xe6?\xad)\xe2\x87\xe8@\x93+\"GjZ\x17\xd1\x0f\xb9\x9d*e\x16rts4\x83\x97\x03\x81(\xa4\x85S\xa9Y\xa5\xbf\\\xff\xdf\xb6\x87y\xbc\x87\xcd\x95\xbfR\xe5\xc9\x9f\xd20\"\x90\x84A\xa2\xb1\xdbB\xf5Du\x9c\xba\xd4\x0f\x9d\xc4M\xbb\x10J\x87\x11n\xcb\x9b\xa0\x8f\xfb\xc0\xd5\xf0\xbf\x06\xf8-\xb5-\xc3\xb3\xc8\xa5\xdf\x09V0\xf9F\xc8zym\x1c\xe6\xcd\x11y-\xdd\xeb}\xc90\x1c\xb0N{\x9f[\xb4\x13\xc6&\xa0\xc8I\xbb6|\xdbvIoW\"iO,-\xb6n\xe5\xc0!\xa6\x02\xba\xa5\xae\x97\xc3\xff\x80U\xd3\x99c\xa0\xac\xdf\x8c;Ed\x84\x8dR\x9d\xee\x10\xb9\xa29\xb0\x84\x13}\x13\xdf\xb0\xfe\xd7\xdd\x04\xbd\xaf\xee\xd6\x13\x14\x8f\xd2Q\xa3\xca\xbf\xb5\xb2\xb0\xa7\xa7\x92v\xd7\xe6\xc8=\xad:h\xda\xb8\x0e$\\\xf2\x95\xa9$\xb8\x19\xd1\xfa\xcd\x84\x0b\xbeN\xfa\x9fI7 \x1b\x13\xb8}o`\xfe\xe8\x12\xd3\x1d0\xa6R\xf68J\xd1c.u\xaam[\x17\xee\xef\xaa+(\xf7(\xe5\xc2#\xfa\xd7\x19\x98&\xad9BL\xe0\x9dvf7\x95^h\x95\x11\xa8\x19-2i\xde\x93\xed\x83{\x9d\xce\xff&\x9c+s\xe3\x85\xd8\xd3\xfc$\x1b3\x93z\xe7Q\x02\xbe)\x99\xb9\xfe\xb7x\x1d\xf7\xdc\x83\xe8q\x06\"B\x90f\x05kD\x9e\x0e\x1f\xab\xd0\x16\xa0\x1fP~u\xf0U\x9b\x93\\\xa8B\xe3}\xbbl\xa7\xc5\xb1\xcd\xb8\x0ax_\x81\x9d\xf6\x7f\x18\x87\xa7\xf0\xd9\xf1\xf1o\xcd\x9a\xed\xbf\xd4\x14\x0b/)\xf3Vjx\x9cb\x02!\xcd\xa2\x92\x90u\xc6\xa0\xa2\xd6E\xf8\xad\xf9k`\x11\x11\xac\xe2\xcc\xf8\xf5\xce\x9a\xcb\x15\x8dS\xc9\",\xcf4\xb3\xdeG\xac\xfa\x83\xc9\xdc0\xf4;-;?\x02G\xad\x7f\xc9\x0bu/\"\x88\x82\x85\xb4\xd6\x848\xefJ\xf7\x11\xaa\x10\x1as\x85\x9a\x87\x16\x15\xe7\xd11\x10\xe4[\xd5\xd6\x9cG\xe6\xf1S*\xf2\xac\x88\xa0%\x98\xe0<\xba\x06\xef\xb9+\xe9\x8c-\xd5\xb3\x8en\xd5\x1f\xb0\xc8Q\"{g\xeb\"\x03xH\x8c\xd6\x7f\xe1\xb3\x80\x9b\xde7\xc7\x9c\xf8\x97/F#\xb2\xa7@\xfa/\xa9\xcd\xcd\x8d\xfdhf\x9b\xa3\xfb\xe9\xb1y,\xae\xec\x90Wro\xbe\xc8,J\x9b\xdcN\x18\xa6\x16\x08a\xad\xd8\x12\x7f\xe8N\xe8\xbe\x96S\xb5\x0b\xe0QiIE\xbe\xcb)\x98O|\x09\xa4]x\xeb\xcb\x08\xaa4\x7f\x85\xbd\xd6L\x09\xfb\xa2\xbc\xdb\x05\x84\xc9\xb4\xf9\xc0\x1dQ:\x85\xfe,m]!\xcf4\x13\xcd\xae7\x09\xe1\xe6\xcd\xab\xc4\xbd\x13\xa2}\xf9\x86,\xf9\x14\x0a\\\xcb\xf6\x9e\x05C\xa5\xa1\xc4PI\x1d\x0f\x9e\xb7!V\xf5\xa1\xc0\x90!\xdc\xef\xc5}\xcf\x12Q\xe4\xb8\x12\xb1*\x9f\xa7\x9d\x01\x9e\xc1\xfb\x1f\x99=\xdf\xabf\xc0\x9c'\"D\xd8\xf6_\xe0*\xd6a7\xec`\x7f\xf5HAe\xea\xa4\xb5\xcca\xbe\xe2<\x7f\xb6\xf3x\xb08\xf4\xda\x1f\xa9\xbe\xec+#f\xd2?\x10B\xbc\xa2k\xf6\x19\xf5\xab*\xf0\xae-hem\xc5\x16\x11\xbd\xba \x9d\x8c\xbeg\x8c\x864';Q\xaa\x13h3i:\x95Yq\x97\"\xb8p\xf0\xdcjS\xea\"\xab\x82\xcc\xf4\x14\xe4\xdf\xf6\x9c\x0e\xe9\x96j\xedr:|\x97\x86oQ6J7a\x1a.&\xa2\x1d\x15\x05\x98\xe9f\xad\xd5\x823\xe7p\xab\xdaJ\xa7\x83\x85s/\xf4\xe56\x10\xa7?\x8bq\x9c\x88\x87\xda\x7fK\xb7YaI\x88;\x0f\xe4\x81\x88\x94Q\xf3\x88\xb8\x1b\x94\xf3\xa6\xb0\x1f\x89\xd1\x96\xc6)\xcd\xbd\xf7|\x16\xce\xf2\x1f\xfdomGJI\xe2\xb9\x8a\x987/\xd0\x14\xc6\x1f{H\xaf\xf7\xdf\xfb]\xdeu\xb7F\xc3P\xa7\xe2\xf3\xd6\x1cQ8e\xb5\xa1\xa3\xcd\xd9\x0cd\xe0\xab-l\x82\xf9p\xc4CC\xee\xeb\xb9\x17\xbf`z\x17\xea\xb0\x08\xa0:\xf7\x07\x93\xdd\x7f\xd2_&V\xe0\x12\x18\x9doSD\xf6\xab|\x07^4\x83\xe0\xa5J Ef\x98\xa1\x7f\xd4\x9c\xb5\xea6gU\xed\xdc\x8d\x9b\xb2\xecP\x89\xefQ:\x16\xf4S\x12\xdd*\x9a\xdc\xf3\xf3L\xfdD0\xd5c\x82\x12\xbb\xf6^JEa&P\xc4R\xba\xd8E\x169XC\xa3\xc6<\x8e\xf7KT\x959\x17\xaf\xb4\xc5\x95c\xa40(!\x0e5tX\xf3\xbb]2\xb4\xc6\x00\x91\xdb\xc0\x0b\xc0N\xfd\x04\xf3\x80?1\xb1\xb2{\xa5p\xc5\x81\xd3\x80\xf0[5\x07\xf9E\x15\x0f\x85\xd9\xc1\xec8\x14=\x90@\x1b+\x8a0v9\x96\x86\x11\x87\xc8\xf7\xfe\x1aP\x93\xae\xcb\xa5)|\xcc?\x17\xb7\xf7 \xc8O\xc8\xa5\xfe.\x1a\x18\x97\x952\x1f6\x83jL\x81\x0a\x9c\xa6\x95jx\xa8\xc8\xeb\xefr\x89\xb2\x0fitI\xd3vMO\xcb\xfb\x08'x\xfb\xef\xe8:F\xd8\x93\xe8\xd4n\xd1\x85p\xafP\x16\xee\xfd\x10\x87\xa4\xd9\x9a\x8c\x88\x92\xec\xf3\x1c\xdb\xa6\xd0\x98\xffQ\x1e\x91\x9b>!\xdc-\xa2\xea,\xdd\xae\x8a\xb3\x06\x1f\xda@\x833\xf3\x11\x0dXD\xef\x912[\xb3\xa1A\x88\xf4\xd8BD\x9d\x82d\x9e\xddRj'WNl\x10\xcf\x0f\x8ff\xd9\x812]\xf6P\x9b\xb0n\xba.8\xca*\xdc\xcb]\x17m\xc7\xc6\xa2f9\xe7\xe6w;o+\xce\xfd\x8a\x06\xc7\xb2\xbd\x87\xc1\xd4\xd1\x7fk>q\x1f\xda2L\x9fE\xb3Bgb\xa3\xf24p[\x17_z\x15\xa7j>\xa7\xefb\x9d\xf7@-\xb9\x99-L\xd4f\xfb\x0f\xb6\xe8\x8b\xb3\x9ez\x19\xa5\x82M\x83\x90N9\x03o\xec;\x1c\x9d\x07p\x19\xa5\xdbc\xc6\xc7\xa5\xeb\xa2ex\xf4\xc6\x96\x1b\x9fs\xe5\x8a\x1bI]\xf5\xdbX\xa4uq\xdew{\x07\x0f\xcex\x0di4)B'+\xd4\xbc\xcb\x06M\x13\x8cWT\xffu.\x06\x87a#>\xebD3\xd4R0\xf5\x9bM.T&e\xb0\x1d\xb6\xa6_69\xa6\x0e:-^K%H'E\xc4\x99]g\x1a\xf0\xa9KI\xe1\xb4\xd9s/\x88'\xa78\xe9.\x87FS4V6\xc8-\xa0\xf8\x11\x9e\xaa'3\xaf\x92E\xb4w\xa1idoH\xa4\xa9\xd7Ma\\\xab\xd6C\xf1\xb7\xdez\xf3F\x04\xa6\x85\x17t\xd5\x0fq\xdf\xa0\x0f\x9dF\x82\xc9\x91\x18\x8c\x9e\"\x83\xa0\xdb\xd2\xde\xa7\xa5\xa5 \x03_\xbaR\xd9R\x89\x90\xed\x01wt\xb5\xe2\xbc\xe9-7O\xa5e\xd9i\xb3o\x05\xa1\xe3\x09\xadC\x8b\"z.\xf1G;%J\x99\xbdvb\xe4~\xe3\x11\xaf}d\xd0n\xbaw\xb7h\xde\xfb\x13\x97O\xb2\x8a\xa0`\x06D\xf9\xa1\xbd\x0f\x07V\xf2\x15\xbf\xee\xd0\xe6\xf6Y\xbe\x91[\xef\xbc\xcah\xab\x0f\x9c\xba8\xdb\x95\"\x8a\xb5\xb2\x8a\x12\xc4\xaa\xff\x94w\x19\x86K\xb0\x15J:\xa1E>_\xa9\x8d\xc5\xa9?\xf6\x1e\xbd.\x86\x86\x18\xc1\xe8\x0f\xc5\x14\x8e\\s\x0d\x16`%`0\xffEH\x14-K\x81\x8c\xba\xafRx\xe6c\x12hk~\xe8\xdb\x13p\x82\xa1/u\xab\x14L\\\xff\xcd\x9du\xe12\x1f\x14\xc2.=\xe7v'E77I\x15W\"\xad\x09\x15\xb2W\xe2\xa2f#NXah2\xb4\xabqoN\x80_#\xe5\xe8vEY\x90\x8et\x9b.\xfe\xb9\xf8\x14\xa1\xd7K\x1at@\x122\xbc\x13\x18\xe7\xe6\x09\xbag\xfe\x0eNJ\xf2;\xb2\xcc\x1d\xce?\xb1\x1a\x8e\xef\xde\xd6\xca\xf0\xb1\xdb\xc8m$\xfaKI\xd0z\xde\xc4\xe6\xc1\xb6\x0c\xafX\x17H\xacE\xd9\x98v\xecJ\xd4\xb3\xb6TM\xe6w\xe6]\x8b'IJ\x0e{\xbf\x97W=\xa2\x12}`v\x9f\xf1\xe3n\x0d\xa4\xf9\xeb?\xcd\xa6B\xb6w\xcbD{\xcd\xae|o\xd7\xfd\x19\xcc\x1e\xd4$7\xec~\x97\x84\xb8\x0e\x13\x8d -\xfe\xbf\xd3\xc3Y\xe7\xe5\xbd\xb0\"\xe0\xa1\xb1 \x9a\x1d/\xeb\xb0k\xf3\xb2\xa9\x10\xceM\x14\x9b\xa4j\xfcQ\xc4{p\xd1\x98}\x87\x17\xd6\xc5\xa9\xd3\x900%\x12oB\x0f\xcdK\xe7\x0b\xb1\x95x\x02.\xd0B\xfb\xe9\xab\xfcB:07N\x00\xb8\xd2\xea\xfc\x0e \x8a\xc0\xdbN\xd4\x0c\xf2f\x06\xcc\xe2m)\xb1\x10\xd96f9\xd7\x86\xa3si\xa7\xbd\xa4\x1e#k\xfd.g\xbc\x94\xee\x14\xf5%\x96l6!C4I\xcf\x00\x0d@>N\x85\xf7\xf1\xf0\x90\xa3\xe1.qT\x87\xf6h\x82\x1c\xe6\xf4$\x9b\xe7\x02R\x13\xa3F\xe3\xca\xd3\xd0i\xce\x02\x9b\xab^\xdb\x8a\xf9\xc8 `\x12\xa2\xef\xf4 \xddQo\xc1\x88I\xa4qL\x17\xd3\x9e\xa3\x89A\xf3\xa7\xcd'\xff6+H\xe1L\xcb\x04\xce\x8e3\x0al\xb1\xda#\x9a]\x0e\xde\xe3\xaa\xad\xf8B\\\x90\xe2e7\xe3\xed\xab9\x14\x93\xcfW\xe7\x19\x02W\xb5\x8b\xb4\xfd+M\x88\xc7t\x02\xb1\x1ff\x0d\x9b]\xf3\xcb)\xe9\x00\x85\xfaC\xc0\x94\xf3\xd0s5F\x0e\x8amKC\xb0\xdam\x0f\x12\xaa\x94\x90-Y\x8cM\xcd%\xc9\xbb2DO\xefq.?\x11\xaa8{q\xe7\xe9\xf7\xfdw~9\x988\xb1\xb8-E\x9d\xebX\x13\xc4,\x8b\xdf\"\xcb>s\x12\x0a\x8eZ\xcfQ\x1d\xe2\x802\x0c\xe0\xcao \x92d\x8bX\x9fS)`\xef\x9b\xbd\x18\x08KLr(G#S;\xfc%\xbe\x0c\xa0\x88\xb4\xda\xda\x0a,\x17\xaf\xcd\xb6h\xbd\x1c\x97)\xd6\x0a\xe6_\xec{o^\x99\x8fa\x91\xab\x1eL\x03\xb1\x91\xc8~\xf4\xf3\xbb:D\xcf@Lru3\xdc7\xe0\xd5\xbdj\xf1\x96d\xc9\xbav.\xb6\x87ot\xa1\xef\xe8\xb6\xe4\xdd\x91\x82FZ\xcd\x9c\x9fYJ\xfef\x87--p\x14sqS\xf0\xca\xf2\xb7\xd3\xe7`7\xef\xa9y)\x0fsG\xa5~a\xb6\xc1Xl\xb0\x0f\xb6\x0b.}p\xe6\x88\x91\xa8\xbdE5\x9a\xceZ(\xc2\x0f|=\xf6]L\xaf\xdc\xa8JSt\xc0!\x0b\xca\x11\x15\x9f\xe0.#sLO \xa2\xdck\xc9\xa1\xbc\x81\xe1l\xe3\x88^\xbc\x0c\x8a\x80\x8a\xb7^QF\xedi\x98\xbf?\xda\x85r\xb4\xa4O\xda{\xe0\xfb\xb5\x96:\xf5\x1c\x13[\xa9Yp\xb1e\xf0N@\x0eN\xa0\xc8a\xf2Vv'\xee-\xcb1\x0bh>2\x84\x09H\xaa\xda~)<\xe7\xbb\xc8\xea\xcc\xcd\xa1\xf9O[{[\x9a\x96\xbb\xa9R\xe3\xde\xd0\xff\x01\xf4\x85\x9d\xcfH\x03M\xddb\x8eE\xc0\xc8\xc8\xdc=K\xf5Xr\xf6<\xbbzOT\xd3|R~\x9a\xb35C\xbe\xff\xf5\xd9\xc0\xb0dA\xdd\x06@\x10\xf6|\x9a\xbf\x81\xbc\x9f\xbaO\xb5\xce\x90\xa66\xf2\x0c\xcc\xd9\xbdn\xd6\x02#a\xb8,<\x82\xdd\x04\xc9\xdd\xfe\x9bs\xf1\x97\x9c\xe6\x90\x96<0\x82\x16\xf8\xc1\x0f\x985\x07t\"\x90]\x9f\x08\xca\x11\\N;3&\xfdK\x8e\x0cu\xca\x1c-)\x18a\xc8\xd7\xce\xb2h\x83\xbb\xee\xf5E~\xab\x9b\xe6\xa5]\x81\x1b\x85%\x97\x9236\xf3\xad\x1d\x98t\x12\xb2\xa76^\xa2'\x99\xd9\x1fT2\xab\x06\xbc\xed\xebX\xfax\xa3\x1a\xd5k\xf3*\x819<\xcf\x04\xee\xb0\x0b\xa7\xc5\x06N%$\x95\x92?\xbc\xf5\xbb!\x18\xc4\x04eN\x0e3\xfe\xf9\xceH\xc2UFP\xea@Q\xba\xf8\x91)\xaa\x00B\xb7=\xb8\xad\xf5\x93\xbc?\x98'\xcf\xef\xe6P~\xdc\xab\x98\xa1v\x8e\x8d\xcfk\xb9G\xb1\xc6\xe1n!yp\xd77bV\xf3F\x9c\xf8\xc9kr\xdf\x02\x84l\x1a-\xb0\x17d-\x89\xa1\xe6\xb9r\xc9\xba<\x15\x13\"\xd77q\xbd\x16\xb8\xdc\xe3=\x187B*\xd7g\xf1\x90\xd6\x04\xa7\xc2s\xbeL~~\xd0\x9e\xc6\xa9\x0e\x13\xd1\xec\xe7\\f?dg\xb0\x0as}\xdb\x95\xbc`\xc8[P\xb4\xd8\xca*\xd8\xb4\xcbf\xeb\xef\x00\xdc\xd6\xbc\x138\x90\xe1\x93\xf1\xe9\xffp\xc4lf\xb0\xc4[\x19x\xe5\x9eJm1\xe9\xb9;\xc4 \xb3@q!!\x91\x80=\xa6}\xb7\x97\xac\x022k\xbe\xfa\x982\x1b\xf8A\x9a\xf4\xf7\x02\x99\xe7\xb2\xe3\x94\xc1\xd9C\xcf\xc3\x97X*[10v\xc7~\xc4\xe1\x97n\x8f\xf4L\x1bg\x04n\xcav\xbd2\x98k\xb6\xde\xb7\x9e\x1f\x86\xd9\xc0\"\xef}\x1fqg[\x99\xe3\xebK\xf1\x09*\xc9\xe4tnc\xc0\x1d\x0d\x17\xdco\xf2FGcp\xe2\x04\xb5r\x9dS\xbdO\xd8\xce\xa7&\xd3\x89\xaeH\xb8Jh\x07c\x01\x0f\xee\xb6\xb3\x14w\xdd\xde\xb3\xc6\xc8\xd2\xfd\xc2\xfc_\xdf\x1a\x84\xfd\xbex\xdb\xe7\x19\x88\xc3\x9a\x14MA\x90\xc8\xbb1\x9b(\x10\xedt\x9d\xd8\x11\xc9\x84\xff\x84\xc2H9\xab\xe0\xc9M\xb9AO\x9b\xa7h\x06q\x87j\xbb\x84\xfd\x0c(\xf5\xbe.\x15\xd7kV\x87YH\x85q\xee\x09\xb1\xfc\xa7p\xd9\xcc\xf0~\x8b\xb7\x19\x8aAA\xc7\x8cH\x03\xd8\xad\xb2\xc9\xbbov\xcc\\\xef\xfa\xf2=\xcd\xef\xe0\xae\xed\x18M\xf5^\xd7\xcd\x0b\xcf\xe4\xd9\xa4\xf9\xd9\x1c\xdbi\xec\xb7n+\xf7)\xd2\x19\xcd\xae\xb3F\xc3F\xc4\xecb\xe5\x82\xe7\xcd\xf5\xf0\xdb\x91}\x9d\xdf\x8f-/\x0e-\xe7\xc2\xfc\xdcV\xfc\xb54\xf2\xda+\x00\x85\xc1\xd6%\x83(\x18\x9a\x9d\xe4\xde\x18\xc5\xa7t\xc9p\xa0\x93\xf1\xd5\x1b9=\x17\xcc\xf5\x8b*\x0e\x0e\xa3\x9b\x97\xe3\x91\xe3\x8d\xdf1\xeee\x9b#\xfc\x14\xbc\xb6=\xe8\x8b&N4^\xf6\xad\xdf\xcd\x9b\x09\xf90pA\xb3\xbd\xa8h=\xaa\xbeT\xcf\x17\x16\x05\xbe'\xfd\xffB\xae\xeb\xa6\x83\xcar\x17L\xebjE\x0fM\x16&=1\x85\xd7\xdfe\x86\xf8\xe4\x12\xe3\xe7}y\xe8^\x92|\x0b\xb2\xc7\xa5{\xb8\xfd\x92e}\x1a\x82\xb2\xac\x19\xad\xbd\x04\x0d\x05\xfd\x81t\xdb=\x0c\x07f\x0d\x7fM\x7f\xb8\xaf7\xc6\x0a)\x14Jh\x8d\x9be\xf5\xe0\xa1\xb9}{\x96\xdf\xd9\xa3r<qI\xbaymW\xfc\x9e\x94\xd4\xd9\x92K\xf2\x09\xbf\x0c:\x982\xa3\xc3\x14\x91U\xbb\xcc\xa9\xfe\xf5\x15;5 \xe5;\x0f\x02\x03\x11\xb5m&\xfe\xd50\x82\xaa\xbc\xeb|\xa9g*\xeb\x17\xc6\xe1\xa2\x07\xbc\xc9\xbd\x8d\xcb\x8c\xba\xc1cu\xe1q!\xf8[\xc3{e\xaf(\x18\xd9\x05aW\x15gm\xb0\xc6o\x00\x1b\x0cSPr\xaaw\xae\xa8t\xe3$&Wp\x8e\x1c\xca\xb4\x1f\xd1\x84\xee\xa9*=\x0f\xebD\x0emE\x9ei\x92L\xdb\x1a\x99\x82\xb0\xf1\x80O\xc6\x12\xbd\xfc\xb72\x98\xb1\x90jm\xa9=\xec\xe6.\x9b\x15\xc4\x9aG-\xdcm,\x9a\x7f\x03\xfd\xc77\x09\x85\x97\x88\xe6\xac\xd6S\xde\x19\x0b[\xc1\xd6(\xe8\xd8 \x96\x1d\xd1[\x0f\x98\xe2\xaaE\x0f\x10\xe9\xc1\xc7\x12V\x84\xc7m\x0c\x86\xfd\xc6\x05))bz`h\x82.(\\\xb4\x89(\xce\xde\xa3\xcbM\xb2\xbdZ\x8b2\xb6\xf6\x1c\xf6Vc\x95\x9cZ*>t\xb0\x16\x9a\x02X\xe8\xa7\xf0J\xd0\x1f\x93\xe6W\xbb*?\xc6\xdd`y\xd8%[\x8a\x95bG\x16\x81\x07\x98\x12I\xf7\xd7^\xf5L\xa3\xa5.G8w_z\xeb7\xe9\x1c\x93v)\xb0\xdb9\x00\xec$\xc8\xbe\x93\xddf\x1eQ\xd1\xb2\xbb\xd7\xac\x1f\xd9\xef\xd4\xdfe\x95\x16\xa1\x00\xc4%\x1e\xddn\xed$9\x8e\xe9\x94\xd8\x9c\xa6\xa66i2\xd0\xd2z\xc5\x18\xeftP\xb9c\xe6<\xbb\x81\xb4\xe96\x9f.\x1dN\xe2\xb9--\xd3Wa\x8bp+a\x96B>^\xc3FV\x12\x9aF\xbbJu\xf7\x03\x16\xc6h\x059\\\x0cC\x92V\xc5\x8eG\xf9\x95\x82\xb6\xa9^\xfa\xe0\xa0\x14\xe1\xf6\xab\xfc\xfd\xd1\xa1ux\x8b\xd8m\xe2\x93ik\xc4\x92\xa6\xdc\x7f\xda\x15\x1b5\xd7\x0e\xca\x86l\x05\x1b\xbct)\xcd-d;\x86\xbb\x02\xee.{S\xb8a\xde\xf2r\xfd\x8f\xd2lQ\xae\xcf3\x8f\xe0e\xef\x19\xa3\xa2\x99\xddP\x80\xccdiO\xbc\xbd\xab2\x88\xa2\xabO\xe8\xdepMn\x17\x97\xe4\x98\xff\x85\xeb\xef]\x9e\xd6\x18u\xeak\x83Z\xc6\xd8\x1d^\xe7\xfb g\x89\xf4A\x1dk,\xca_\xb5\x02\x03\xb4,\xf5_b\x1e\xefrA\x1d\xb4\x99y\x92\x1am\xc7\x95vqG\xe6\x9f\xf6\x809S\x06\xba}g\xa5E\xd1\xb6\x96\x11\xf1\x85\x9e\xaa]\x12$\xf10\xd0\xa2\xaf\xa2d\xdc)\xc8\xb1\xfb\"\x1d\x80a\x98I\x19\xfa\x03\xffX\x00\x9a\x8c#\x15\x914\x13\xa2\x93gp\x0b\x042aj9(\x0d\xcdm\xce\x8c%\x99Ra9TEa\xa7\x0c\x18\x1a\x9a\xff\x8f\x94\xd2\xef\xee\x06\xf4F\x03%\x97y\x14&=:PI\xbcOs\xcb\xe9FH\xea\x8c" +
+	"~+{\xab\xb0\x1f\x1f\x0d\x9e\xcc\xb9^\x8e\x07Q\xc0\x96D\x96\xed{=P\x8a\xd1M\xcf\x91(\xda*Wq\x91\xf4\x7fZHl\x9e\xe9\xe5}\xb3\xf2\xdcw1\x81.\x08GR\xb3\xd9\x05\x80/{$\xe9\x05Q\xdcP\xc8?\xde\x0f\x00n\x02\xeb\xa2\xb8\x0c}M\xe7\xa9D\x7f\x9cX\x91\xc9\x09\x08\xab\\q\xa1C;\xb7\xad\x04\xac\xdd\xf0k\x80\x13\xad)\xd8%\x0a\x15k*\xf9\x13\xd6\xda\xdd\xc4\x08D%\xb01\xca\x05\xeeU\xeaH\xb5\xfbU\xf2\xcc\x91\xa1\xab[9&\xd2\xc1\xdcl\xdf\x90\xe6+\xdc\xb0=R\x9a}\x03uiO\xd38\xb5\xe6%\x9e\xc0\x92\xe5\x88\xc6\\U\xe2\xd7\x9c%]\x0a\x01\xcc\xc2\x01\xf1\x1e\xc1PP\xb8\xeae\x8cA\x9d\x9f\xc5,9e\x0a\x81\x0dT'\x1c\x05\xb5\xdc\xcfh\xafu\xe0\x1eo\x17\x93v\xdeN\x8b\x05J\xc7\xc0(\xed9h\xc4s\xeaz\xaeu\x87\xbe\x93\x17\xdb\x0fox\x10\x9c\x93\xec\xd3d\xee_\x06:\x88}\x0c\xc4\x1e2\xbf^\x1b\xf69\xe3\xb3\xd7\x91\x1aU<\x0d|\xd2.\x92\xf3\x1c\x18\xd5\xd1\xb3\x9a\xfe,\xf6\xac\x1a\x05\"|$c\x05\x15\xb1\xcfJ\x03+\xef\xcad\x94\xbe\x08\x05M\xa4\x80\x09\xbdM;Q9\xc7)^d\xf0\xa4z\x11\xb5\x8aR\xb6s\xc9\x07J_\xdcc\xc1\xd4;^\xc4\x0cN\x94;z\xa6h\xe7Y\x0c\xec\xbbv>Bg\xe0\xa9\x0e\xab\xf2\xcd&\xdb.no\x15k\xc4\xcf\xd4\x98[X*g_\x86\x90#\xa2\xa1X\xe5_\x18\xd7\xc7\x9am\x187\x87\xf21\xe0(\x90\x05$\xfeYT\xd8\xb4\x14a\xbb\x02\xff\x1a\xb2|\xbb\xd0\xf3B\x18\x0eX\xe4\x95\xd0\xd1Q}m\"HMv\x12\x86r\x10gg\x9d\xacTW\x1fJ6e\xd9\xb9\x19\xf1\x9c\xeed\x1fY\xae\xb8W\x1f\x1a\x13u\xc0a\xb04X\xf7\xd8\xb6\xb2\xb4\x9au\xa6U,\xb8M\x8b%\x8b\xe0\x0bT4\xb7\x9e\x8a\xa6\x9d\xb5\xabv:@CS~L\xdd'dU\x0b%MF\xc0\"\xc3a\xae\xc5\x84^\xf2c\xb3\xf9\x1fP\xc2\xdc\x0b?\x14\x82\xaa\x17L\x8c\x04&\x880\x18\x97\x9d\\\xfd\x0f)\x18D\x8al\x88-&\xdd/\xaaZ\x0a\x8aF\xf4\xb4\x1f\xe5\x80\xd1Gt7\xe9\x1cWA\xddG:\xa5\xfd\x08u\xcb\xc2q:l\x95\xefG$\xe0\xc4!\xfc\x9bB\x96o\xd2\x0eE\xccP\xe2\xb4\x7f\xe7\xc6\x0b\x95\x96\\\xa3\xd5E\xa2\x01\xe0\xee\"\xb72wm\xbe\x140\x92\xd7A\xc5\xee\xc8\xa19\xd1\x8b\x1d'\xd6\xf7\xcfS\xf1o\x92P:\xb8\xab\xcd\x83\xc6\x14o\x11\x06\x08\x016\xf5\x07\xd1\xa4\x146\xdc\xd0\x94\x18\x91=\xc6\\\xf2\xd5\xef\xf9\xa0YG\x86x%yA\xa8'`\x92(\x18M\x90\x94G\x84\xb4C|'\xf0\xaa\xf0\x0e\x92+\x11\xd4\xf1}\x17\xfe\x07\xaa\xcf\xb8\xd3C\xf3\xda{\xda\xac\x82\xfd$DnkV\xae\xa2\xb9\xbd\x9d\x98\xa9\x10o\x0f\xe9\x8cE\xe0a\xeb\xf2B\x1d\xd1X3H?\x02\xf7w\x0d\x946j);\x85\xc3\xb5_\xc3\xb5\xa7}\xa2=\xe4\x8f/\xb3E=\x9b\xf8f\xddUJl\xc7\x84\x99\xd1\x1c\xa4~\x8a\xd2>mf\x7f\xca8n\x9f{\x0f\x00z\x83\xd2\xd1\xbd*G\x07x;F\x08I@\x00\xa0tH8\xe3\xdc3\x92\xd72Q_e\xdfZ\x131\xcdW\xa77O\xd5:\x91\xc8\xdb\x8f\x11}H\xd3c\"\x00sy\xe0%E\xc8\x8e\xcb\x8e\xbc\xca\x07}Ly;\xde\xdb2\xfa\xfc*\xc4\xe9H\x11\x0d\x12z\x00#\xdb|\x96\x1b\x19\x003k6\x8c\xf2+L\x82\xf1\xd0DRr]\xd5&\xd7(%\xa5\xe2{U\xd4\x86\x91xo>S\xfdj\x98:\xc4\x02&p\xfb\xb4Kg\x85;lb\xbe\x1dhn5#M{\x07\x80\x95\x134\xd8*!\x0df\xc1/qXy\xa3D\x95\xfdk\x87\x97u\xc0\xba\xe6L!\xe9\xa6\x8fP\x1d\xa1`\xbc\xea@\xb2\xb6\x8e\xdb\xde;{\x8c\x82\xc8U\xaax\xfd\xd6\xc4\x80a\xaa\x1eR\x1e\x922\x85G\xdf\xda\xa2\xb5#\xb0h\xf17\xe5\xed\xefGiDd0|\xb5\x17\xaf\xf43m$\xc0\x03\xbc\xd2\x8c\x01Jy\x82\x9b\xc5\xc0\xbf\x187=#=\\\x83\xcco\x9a\xb9;\xe9@\xfd\x99\xff\x84\xae'\xd4\xbbb\"(7^q\x92\xbd]M\xac\xf3o9\xe9!\xc3\xff\xf7R{\x13I\xeb\xd1\xd8\x95M\xdem\x18;\xda\x11l\xbb\xbao6)\xd9#OD\xdb@\x85\xcb/\x851\xbf<n\xfdC3\xb3\x9f\x11}v\xf3\x99~\x7f\xa0\xbd\xadV\xe2'J\x17\xd6x\x96\xf4\xec\xf6\xfal\x9dH\x03\xb2G/R\xb2a#\xf1,\xaa\x02\xdf\xfc\x01)\x17QZ\x16>t\x9a\x19\xcf~f\xa5k\xca\xfe\xa4\xf4\x8d\xc82\x1b\x8b)'Z\xee\xc9W\xf2D\xdd\xb3v\xe1[5B\xe9\xa7\xd9\xe2\x02\"\xec&\xb5\x0eF\xf8\xd3\x87\x1d\xfd\xba^\xe2\x08w\x81i\xb3\xe1[\xe7\xa4\xe6\x93t\xc4S\xfeE4\x94\x19P\x14\xe8\"\xf7\xe2\xc7L\xd9\xad\xfe\x05h]-\x82\x95\xaf\xed,\x1d\xfd\x09\x09M\x89\xdf`\xcbp\x09!\x02X\x88\xc3=G0\xdd|\x1a\xb9\x07\xb5b\x1c\x9b\x83\xc36\xd2]\x8ep\xc9\xe8\xde$\xfd\x1c\xbfR<h\xfdh\x8c\xa8\x03\xcc\x7fa\xc4B\x95!\xf4*\xc5\xe8\xa1\xa8\xb03~\xe7%Z\x0c\x8d7Yx\x94J\xfe\xf9\xf9Y\xdf\xa4Y\xa7\x91\x17\x87#\xcc\xd8\xdas|\xad]\x88\xefX\xd5\xef\xbd*\x83\xaa\xdev\xd3\xb2|\x83HT,\x89`J\xbb\xe6L\xf1\x87l\xa7\xcf4J\xb4\xb0\x95\x0d\xddo=\xa06\xd6M\x96\xcfa\xf3\xccMs\x9fU\xf9M\xab:4\x05\xdau\xe7\xed\x927\xc5e\xac\xc8\x0c\xe4L\x8c/'k\xf8\x82 @\xf3\x03\x89\xe1\x0d\xc4\xa7\xb8\x82`\xa0\xbb\xf0\x8bg\xba\xd2\xf7\x09\x0d\xe5\xbey\xcb\x8f\xc7T\xb1\xae\x7f\xc8\x13\xf7\xa5j\x8e\x80x\xfd \xf7d\xd9\xb9\xae@k\x00\xf4\xf7\x02\xf32\xc7\xa2\xa2L\x17}\xe0Xq\x16`\x92\x9b\xb6u8\x10\x92\xff\x7f\x81\xcd\x7f\xa5\x96N\xf5k)\xfd\xa1\xbf\xd2\xdb\x1cZ[\x17%\x02z\xf2\xaf\xb5Ew\x89\xfd\x8c\x82\x10\xcd\xa3\xfc.\x9d\xf42\x07)\xac=%H\xa8\xde\x8a\xe0\x95\x00\x17h\xcd\xb5\xcb\xf4\xe2\x01\x0d\xedi\xb4[\x09/\x11\x86\x9b\xa9m\x0f\"\xb3\"\xd1^y\xe7;\x8f\x9af\xe2L\x8b\x98\xa4\xfa\x04<\xdd\xe8\xd55\xdfh\x16\xf9F\xf7\xd7tzip\xa0\xb2\xc0\x18\xc9\xce}\x10\xcd\xec5\xe1\xbc1\x9c*\x95\xcd\x1a)\x07ap\xe6\x1c\xdaq\xe2\x83\xfb\xac\xb6\xb7\x10=\x9b:\xd8\xe3\x83\xa6\xa7\x88r\xa0]\x0dN\x9c\xc8\xf2\xbcSViF\xbe8<\xe8\xc2\x01c\x8d\x09\\\x12\xd0{\x11\x17\x9e\x030\xd9\xae\xcc\x1a\xc3\xa3\xc9o~\xde\xec\xca\xb7u\xdbG>-I\x83\x9c\xf1\xd9\xf5\x96\x99\xc1c;@\xefV\xa5$\x8ei)(\x17\x9c\xb4/\xb1\xc4ze\xbcZTb\xa9\xad\x8d\x90\x17\xe0\x0e\xbf\xb3<AY\xa8\xc7\xce\xf6Io\x11\x9e\xffoxyl-\x042$zq\xf8\xa22j\xf8U[I)\xc4\x91\xf3\x1f\xa4\xc8\xf49\xce=\xe42\x9f\xf79_\xe5T4\x90(\xfe\xc1\xfe\xff\x1f\x99U\x9d\xafD\x1e\x89s)\x19\x9fh\x84\xcb\xa5O\x91M\xb3N+)\x03SE\x88\x85X\x04\x0c\x8b\xfe\xe9\xb4N~\x88\xc8\xca\xeff\xc3\x7f`Y\xa5\xfb\x98\x89\x03\x0d\x1c\xdb{[I\x04\xcf\xa5\xeb\xf4\xd6\xbf\x09_\xce;\xf6\x16\xe5\xe2A\xb9\xec\x00\xfe\x81\x93\xe8\x91\xca\x0atA\xde!\xe4\x145\xd5C\xe6\xef'H\xb5\xd7\x80\xff,\x1c\x0d\xf0P\xea\xe8\xe2\xac\xa7\x97R\x12|\x8f\\\xa5\x04).V\xd7A\xf3\xcc;B\xabh\xf3\x8d\x01~\x88c\x81\x13OFq(<\xc3\x08\xa6\xf07w5U\x0c'~\x93-\xbb\x96\xde\xc4gJ\xac\xc8\xcf\xdf(@'\x0c\xd9\x03\xbd\xc6mrM\xd1e=\xa3\x1a\x9b\xbbb\x9er\xdb\xe0\xd1L\xae\x07\xef\x1co\x8d\x19\x92G\xdf\xday\xb0\x15\x90\x1fR\x8b\x09\xe3\xcf\xac\x00x\x97\x9e\xff(\x12\xdc\xa5\xb1e\xf6\xd8\x85\x10\x1b,\xf5v\xda)pgr\xf4\x96\x00W9\x95\xac\xfam@C\x1d\x9a\xe3\x18\xf7\x13\xa1/e2\xd9-\xa4,\x192{3Iu 5O\xfd\xe3s\xf5\x0c\x1c\x14\xbe\x02\xd8\x0b7\xa2\x08/n\xfa\x81\xa71F\xd0\xa4\xed\xdd\x90\x00\x85\xfb\"\xc5\xe74\xd5I\xa4Lu\x0e\xd2\xb4\xc2\xecA\xdd\x08\xb6A\xd8\xb1\x17\x8cf7\xd1\xfad6^a\xc9\x0f\xe3\x94K\x0cg\xc6\x98\xe85\xc5\xd4\x04NW\xbeE.\x93\xfeb\x9dhi\x97\xd9\xbb\xbe\x8bR\x18\x10X\x95\xfcy\x82\x07\x8f\xb8P\x89|\x18\xe5\xb0G\x9f\x82\xebe\xe6\"\xfa#\x16\xe93#\x84\x0c\xb9\xbf\x90\xf0{\x8cC\xc9!\x0b\xc6\x87\xdf\x89`E\xc4\x89&,vl\x15\x01be\xd8\xe0g;UM\x1a\xa2fG6\xf6q\x9cXI\x99^5\xbfA7\xaa\xd0]\xb8\x8d\xec\x954[\xf3\xacK;\xff\xc5]_\x83\x08e\xb8h64;\x99\x0f3\xec\xa1\x02\x82\xe8\x0a\x0boTw\xb9\xa3R!\xec!\x07\x9e'\x98\xb5\xf7\x12\xef\x879;P\x821j\xa5v*\xce\xad<!\x03\xf2d1\xfb\x19\xe0\x9e\xa0\xca{\xc9V\\\x83\xa2\x9b\x8dhQ\x05\xbd\xa5|\xe1\x07Xv\\\xcd\xe0\x15\x90\xa5A\x92%\x09\xa2\xf1k:\xdf\x7fp\xc9\xdf\x80t\xb84\x94R\x9a\xf0\xe4\xfb,\xce\xd6Ko\x81\xd7\xe4\x0f\xa5\x1d\x1f\xf6C\xa0G|\xc9\x1a\xb3^\x14\xbaM@p\xa1ZL\xed\xbd\xdb\xa2*PW<7\x1b\x1a\xab\xef\xaexyh\xab\xe6]\x8e\xaa\x85\xc0\xb8\x96\x96\x9a\x1dCY\xda\xc5\x9f\xcd\xc0\xcb\x10K\x91\xbduE\xdd)\x05\x10\xe9\x9d\x12\x10\xd9\xb9\xe2\x92_u\xa5\xbbL\xb7\x87^\xdb\x18ES\xbb\xa2=F\x88g\xb0\x02\xe8\xc9}\x99\x95\xdc9\xa7\xe8\"\xfb&\xac,\x7fv$]\x98Q\xca\xa4\xde=s\xc8\xb5\x9d\xf1D\xcc\x91+}3\x95\xe5\x84\xd8\x05D\xb1Bs\x8a\x0e\xf9\xc3\xd3c\x9563\xc1\x16\xbc\xd0\x00\xaf\xac\x97r\xc3t\xd1\x8a\x0a\x0b\x12\x94\x9eI}\xd1\xde\x138a\x8c\x95\x89\xf3e\xae\xb7y+\xd4,\xa7\xf8\xf8\xf8E\xb3\x01\x9a\xb2\xd9\xf58\x84\xfa.\x1c\xcb\\\xef\xdbZ\x17\xfd\xc1\xcb\x9bPA\xe6N\xc87U\xbaS2\xbc\x98<W|\xd7\x86\xaa\x89\xdf\xe1S\xbd\x0b\xb9\xb7\x08K\xd0\xc9~3\xe2\xf0\x94d\xc3e\x10mK\x17\x09Y#~D\xee\x85U\x09\xc4o\\\x97X6F\xb9\xab\x9d\xa0=\xe4>\x1006\x06\x13\xd6r\xa7\xe6\xe5\xa3\x04\x9b\x8b\x09\xab<\xed\xc7\xa9\x96\xa8\xad/\xc3:L\xddx\xb5\x0b\x88\x8a\xb2\xff\x97\x1a\x87\xda{\xfeCI}\xdb\x9536\xc2f\xb2\x11oy\xa7\xc5\xe5\xacSB/8\xb7\xe8f\x0f\xb1Y\xf0\x0c+/\xa0bBl\xdb\xa2\xb2\xa1\x90\x89W\xe4m\xee\x83\xfe\x86F#O\xbc\x967y\xd3j`\xbe\x18\xe5\x85\xfa\xdf\x10Ed\xfaei\x0fT\x86z\x8e\x82]\xe3\x0f\xb93S\x13\xf6\x11><\xc7\xdaP\x82=\xd7\xa6]*\xf24\xfb\xb6\x92\xd8\xb0\x13\xac\"y1Br%\x0e\xe1;\x89\xd2q\x84u\x12\x96\xb7\xe2\xce1\x1c\x1b\xc4\x06\x9dj\x00y1\x0e\xfb\xb1\xa0\xaa\x10W\xa3\x7f\x96^\xd9y\xd7.\xe8>\xb8\xb4\x9c\x16\xed}\xf4\xf6\xea\x12=\xd0\x943(\xde*\xd4\x9e\xc4\xcae\xa1\xfa\xc3\x1d\xac\xc4m{?JZE\xd8\xc2l\xcd\xe9\xce\xb9\xe4\x94)(\xb2\xc4'\xd0\xf3o\xda{\xdc\xa7\xc7g\xd9\xac;\xaa{\x8d6\xa1\x11\x1a\xaa?\xe5\x9c\xd4n\xa3NSQI\xddD{\x8c8\x05\xfbX\x9ekW\xffn\x97G[iZE1\xf3\x9a\x1cs\xf9\\\xe9\xc7\xc1\x17o\xb6\xe5\xf1\xf2\xc2STN\x96\xb7\xa3MK\xa0\xcb1\x9a\xaa\x9c\xe3\x07o\x9f\xfe\xf0-\x96\x82f\x95\xbe\xcc\x05\x00S\xf2gm\xa2\xc66\x91c[@h)\x85\xdc\xd1\x93lQ\xdb\xe0?U\xa1\xd2\xaf@\x18\xdfs\x88\x8bW\x14\xe1\xa4\xf6\xec\x11\xee+Sm\x885~\xce\x8e;\xf1\xf4\x03+\xd3j\xff\xa7\xcd\xdd\x0a\x9b\xbb\x0a\x9d\xee\x01\xf5\xaeM\x0d3C3\xfd\xdbl\x92\xc6#\xb2\xca\x84\xe8xj!FPu\x95\xd3\xcal\x81K\x8b\xb7\xa3\x8dW.}\xe1\x10\xd2\xf7\xe9V\x08\xa26\xa6\x8a\xac\x8a\xccoj\x96\xa0\xe1\xa1\x192?7\x9b\xad}!\xae\x02\xe3Jj\xb7\x8ff\xa9\xe9*\x95~G\xe9\xb4\x08Z<S#\x81\xca\x86\x8a\x9f\xc8\x0e\xe4dh\xab\\\xc2J\xb2\x99\xc2\xb83\xe4\xcb\xf4\xf0[q\xcaC\x9a\x09\x08\x88%\x18n~\x15$o\xcf\x0dlk\xe6ONF\xd7R\xe8\xc4\xf0a\xcb\x9c\xf6\xe0\x9f\xa8\x1e\x94\xa2\x07\xc7)%\x9c28u\xb3q'\xf7\x890vW!O\xd8\xec\xe4\xd3\xa9\x01\x1e\x9d\x82fNn\x93r\xc6\xdb1\xfe(\xbd\x91G\xda\x87_\x9b\x8d\x0e\x90\x8d\x8b\x02\xcf\xb8.\xf0\x97\xbc\x85{\xcc\x8a{\xeb\x12\xa1\xcc\xe6Tr\xf2+\xa9g}\x94\xee\xe4\xa2\xc8d51\xbc\\T\xffC\xa8\xf0,\xb4h\\\x9d\x93\x9a\x19\x9b\xa9\x0a\xe535G\xb4\xaf p6 \x8f'Yb  \x0a\x0a+g\xe4\x06\xc7\xb8\x8c\xfb\xbb\xbe\xcc\x9fL\xf0/N\xf1h\xd9l\x9b\xdfx\x9f\x072\x1d\x9b>d\xa7\xea6\x8fP?\x91Ax\xe4\xb3\xc0\x9e\xa8\xb2\x0c\xda\xee\x14E(\xca\xbeY\xff4E[RN9\x9a\x06\xb4G\x1c\xca=.F\xd8\xa1Bo\x8f.\xb6\x15\xde\xb1\x90~\xf9N-\x15\x01\xd3\xc8A\xfbu\x06\x8a\x99\xd7y\xca/\xa3Ew\xe9\x98Es\x04\xa9\xeav\x94\x84\x89\xbb\xd0\xa7\x13N\x19\x1dB\xbeE\xd2\xc9O\x12\x9e7\xcf\xe5s\xde\xe5\xf7\xf7\xc6lEh\xaa\xb1z\x91\x05\xe6,1\xeb\xcaai\xb7\xfb`^-\xf6\xf7\xc7\x98\x8c\xee\x85u\xf7\xc1\xc9&Kvgje\xf35\xd3` \x94`\x7fKM_\x0a{~L\x14\xb9\xd5\xae\x84.y\x84xh\xdal(A\xca\xed\x80|>\xde\xec\x0e\x84\xa3\xde\x84~\xe2\xa9\xa4\x0d\x88\xc7^\x98 \xd9$\xd0g\xcd\x0e\x9f\xce\x93s\xd6\x1c\x10\x16\xac\"\x9c\x11\x01\xb3\xa5\xf6\x89\xe0\xe80F7$\xd7\x11\x08\xea\xfd\x7f7\x8dn.:k\x80{sxC\xa6\xe1\xdez\x0b\xbfs\x85\xcf\xb4\xe6\xef\xe9\x18\x8f\x05\xac\x09\x06P\xba\xe2\xe5I\x03/\xed\xab\x00\xadlL\xddb\x87U\xaa\xe3@\x9f\xa4\x9b\xa2\xa8\x0bY\xd2\x19\xd2\x88[D\xde\xfcUi\xdc\xd3\xd8\x95\xee\xf5jp\xdc\x95u\xf2\x9f\x0e\xfeu\x8f=\x98`\x9eS\xee8\xccs\xa7\x05\xafKB\xaa\x05\xf4\x06\xee\x9b\xbdl\xd2;o\x05\xf1>^J7qs\xdaB\xa0\xc8\xa0\xd9\xf0Dv\x04\xa9\xcaO(;\xd8\xca\xa4L\xd7\x027Z\x0b\x0e2y\xa9\x95:\x07\xba\x09X\xf9\x90\xfe\x86AQ=7\xfc\x9aP\xd1\xc8%2\xf4x\xe5\x1c\x80\x8a\xb8>\xd2\x93+W\x95\x03\"j\xf7\xc7 ;\x91\x9c\x8d}\x01*`\xa5\xb5\xdbm\x18\xc7[J{\"\x91\xe0nM\xce#\x9a]\x1e\x1aw\x1f\x03\xd3!\x00\xff\x9bMnn\xda)\"PzF\x02KmD\xe1\xc97\xc1\xe4\x1b?7i\xee]\x0b\xc1\x91\x02~\x8eD\xb4\x87\xed`\xdd\xae\xdc\xf1T\x93={\xe1\xbc\xab\xddI\xf3\xbb\xe8%\xddF\x09S}\x0c^\xc5\xfa\x10\x13\x99\x1b\xb4\xe5z\xa2\x9e)\x9a\x85\x1d}m\xcdv\x06\xb3&\x12\xa3V\\\x10\x1b\xa1-\x9avD\x16k$\x85\xe4\xa4\x1c7#\x8f\xe3+ydO\x04`\"\xe8%\xf6E\xc6\xe8\x8b\xfc\x00\xf0\xbc\xb6\x01\xb0A\x91w\xb83\\\xae\xf5\x9e\x19\xd0S^\x95 \x00\xe0\x9a\xbd:\xa5\xb5*\x8c-\x7fj\xb9\xea\x89\xef\xd7U\xa5\xb9\xa5\xaa\xecH!oo\xe7C~\xe1\x19Rz|A\x1b\xb2.\x9f\x8d\xea\x10\xa5\x09O7\xe9\xd2~m-\x9eey\xb9\x9c\xbaX<\x15S/\x03\xd1\x8b\xa3\xde\x95\xa9\xc5\xac\x1dk\x080\x05$\xea\xa8\xc3p{\x99\xf5\x1a\xb5\x0bq\x8d\xa3\xbb-I\xc1\xe0\xbcZ:I\xccy*\xffm\xc7\xc8\x95\xa6\xe2\x7f\x0e\x1eo\xa5\x8eh\xb25\x16\xfcm\x9d\x18\x80\xdbs\x86\x99\xd5?\xf1\xb5\x08\xc7\xd6,\x8f\x8e*\x18'\xa3\xc5\xdf^\x89\xa0\x1f<}\x09ric\xf0\xe94\x1eq\x11i\x13\x9f\x99" +
+	"8\xa6\xdb*\xfb\x98\x9b2\x15CY\xee\xcf\xbb\xbd\xe9n\xc8\x0b\x0d\x1d8!3%@\xcb\x940'\xe9\x82\x8cs ;\x02\x9d(\xa3#\xa7\xab\xdaaF\xcf=\xe0\x15\xdc\xdevV\xb8t\x0a,\x18*\x0c\xfa-\xbf\xf0X=\x94\x19\x81\xb3\xa6Y\xfa\x07'u\xb5\xc3\xc0\x8b\xbe\xd6\xcd\xdb(}\x12=\xe3)\xf3\xe5\xee\xcb\xd8|!\x18\x7fy\xbe\xf0\xd7\x9a\x92\xaa8\x0d\x12*7\x1d4#DLw%\x82\x10\xb3\xd5\x82\xcf\xa6\x84\xd5\x1e\x9e\xfd\xa7\xa4\xa2a-\xc8(\x0e\xc62w\xe7\x7f>.\x17\x83\xe3\xe2Ed\xde`\x95y\\\xe2s\x9a\xe8\xe5\xc2\xd162\x19dL\xd4\x99\x84\xd20'\x90\xebC\x18Xi\xcc\xe6\xf5\x98n\x0a\x86X\xffX\x90o\xbc\xcf\xfc\x03\xa9\xcd\xc2\xa1\xf3U\xb7\x89\x9a\xfc,\xea\xc5!\x18\x0a\x09\xbe\x1d\x8c\xfd\xadD\xa3\x92;\xd2\xb2jf\x12\xe3\xd5\x86)\x11\xb1\xb4)<\x15\xa64\xa50\x84\xc1\x1e\xebx\x1f,(\xef\xee\xe3@\x19\xd5a\xa2 \xb2\xcf|\xe6W\xc4\xcd\xd6u\xba\xcf\xdd_\x02\xd0\xb5_\xc3,H$\x8bS\xd7\xe0\x9b>M\xa1\xc6\xb3\x94\xea\xfc\x09\xe8\x8e\x99=\x12\xee\x05\xff\xc6\xdd\xc6\x9a\\\x99\xdf\xb6\x01&\x12\xaeq\xb2\xa1){\x19,Cw\x0c.77^\x18ZA\x1dv9\x92o\xab\x98\x0b\xbc+\xf8\xc7=\x0e@]\xe4\xd4\xc0\xb7:\x0a\xcd\xbe\x9d\xf1\xf7=$\x0a\x9c\x9a\x1c&\xe4\xe1J\xf8,\x97\x07\xe6\xfe\x9co\xd0\x16Z`\x01\xd0\x9cW\xc1`zIn!|\x00\x1b9E^\xd3\x18\x8d\xe8\xcfQ\xb2\x1c\xa9\x90#e\xaf\xfa\xd0v\x17s0X\xa5\x97+\xf5\xbeD\xc0=\xc2m\x13\xaa\x1f\xec\xf7\xeb\x8a\xb0\xee\xd6\xdf\xa2\xb5\xec@\xc6\xb5\x0e+\xbf\xe0~\x05\xa3\x81\xa7;\x0f\x14\xdf\x04V*\x95cx\x9e\xb9*\x8bct\x0f5\xdbP\xce\xedC\x19\xdb\xe8\xe5C\x06\x14\xca(\x1f\x0dE\xe5\xb0f\x12\x9c\x19P|M\xdc\x9e\x92\x08G\xbcZ<\xe7e\xcfk\x10\x8afU\xd6\xd6\x09T\xc2\x87c>b\xfc0\xe8Z\xea\xacy\xff\xa7O?\xc8W\x19\xa1\x94)\xa8\xae\xf4\x11fM\\\xf3/\xc2y\x12B\xa4W\xb9m>\x9b\x9d\x1b\x00y\x14\x96\xd2\xe3\x16\xf2\xf9\x00\x9b\x18\x140\xc7\xf8gz\x89\xbe\xe4\x05\x07\x86t\xa9\x9a\x14KuRC\x1c\xaa\xd09\xe6\x99\x7f\x0c\xed\xf6\xf1uDb\xa3\x07>m+U\xf36<\x1b\xd5Z6\xcf<\x1d\x02>Z\x16\xe3V\xd4q\xa4(H\x0c\xca\xe3|19\x01\x9f\x8f\xf4\x17hs\x11j\xf9y\xbb\xb0\xc9P\x0b\x96v\x1f~\x02\x05\x1b\xde\xde\xf9\xfb\x9e\x9f\x82\xe3\xd3\x93!)\xfd\xe7\xa2\xdcJ\x9f\x13\xd1<\xa4w/e\x0e\xe1F\x14\xb6\xb3\x19\xcf7'\xb2I\x07\xe2\x17\x0f\xf4JT\xd0\x80\xab\x1eO\xcd^\x9a\x1c\x8c-\x978\xb4'<\xed\xffr\x8e\xfe\xdc3\xe3\x12\xda%\xf8\xe8Y\x83\x8dQ\x00\x0a\xbbY\xdefS s\xb9\x132\x17\xc4\xbc\xed\xa2L\xe5BU\xc1:%\xcc\xff}\xda\x15\xeb\xd7z\x92\xc2\x04E\xfcJ\x09\xf3\x8a\xbdb\x98\x08\x05uH M,hZ\xa2\xb66I:\xed\x853tjJ\x14\x82>\x18xg)e\xdc_\x12\x7f\xd7x\xa9\xef\xa8\xae\xc8\x83\xae\x9b7\x04\xcd[\x87\x8f_\x83,>\x15\x8e\x8e\xdcb\x80\x0b\xbc\xb7\xf7\x85\x1b\xba\x8al\x1dp4\xa7\xb2\xb8Y\xfeD\xf6,N\x87}s[)\x8e\\\xd0\x1d\xed\x90S\xddHE}\x8a\x86\x8a.\xc7\xb6lx\x83\xec)i\xca\xfe\x90\x81\xa1?4\xa0d6\xe7\xe4\xf7n\x1d\x9d7;\xcb\x13\xf2;\xeaaj\x94\xb6n6\xeee\xcb\xc6K\xfb\xa3\xcetI\xcf5\x9b4\xf3\xda\x15\x9aIjf\xb2\x88\x81\x9d\xe7\xe1\"/\xa2p\x07\xb7\xbd3`\x8d\x98\xd7\xf1\xb9\xd6\x9a\xe3?i\xac\xab\x9eD7#\xf0P\xfcjv\x85\x1b\xca\xcb[\x16i\xda\x0d\xb63\x06\xdd\xb2\x00\xd1J`)tQ\x16<jga\x0c\x1c\x88X\xcb\x0a\x83\xf4*a@\x02C;m{\xdf-6`\x9d$\xaa\x08l\xe8\x0a\xb8\x94C\x0ci\xeb#M4\xbc\x92\xaa\xad\xc7\xb9S\x98*\xde\x87\xf1\x1e\xe2K\xbai\x07\x01Qf\xea\xc7+\x11T\x017\x9a@of\x8csD\x17\xde\x08\xbfJ[7\x1b\x9bg\x06\x8c\xd3\x06D\x89\xf7\xb2\\\x9ai-S\x82\xb0t\x1d\x0fG{\xa2\x9ff|4\xcd\x06\x9e\x8cdw5\xbdZ4!\x81!e\xcb\x84\xb0m\xa5\xdb\xfc*#\x09C\xc5\x1d\xc7QQt\x8c\xac\xa4J~\x0e\x16\xa2\x86\x80\x8c\xfa\xa3\x16\x85\x89G\x01.\x049 \x9f\x08\xb9\xefS\xe9B\x9eQD\xfay{\xe5\xe6\x0e,\xd2\x8eW\xb2\xa4\xdc\xc9\x8b.6W\xe2Y\xf0ze\x08bq\xe0B\x91I\xab3Y\xde$\x88\xeeN\x83(\x85L\xbb\x86J\xb2\xfcB\xd3A;\x81\xe7\xe1\x86\x8c\xcfx`\x11d\x86\x89{\xfa\xa4\x1d\xbcj^$b\x90-\x97;F\x98\xf3\x0f\xe6i`\x96\xdc\xf5\x95\xf9\xbd\xdf\xc3L\xc6\xae\xc5v-\x1b\xc8\xcc\x17<\xb9\x949L\xb5`\x0f1fZyf.\x9a7tG\xab\x03f\x9b\xaa\x14MGH\x14\xfe\x81\xfd\x9c\xcb\x1e\xd2\xae\xd4\xca\x05%=\x9fM\x88\xc5\x0c\xd4\xc5\x81\xf8/mw\xe7\x0e'DoM\xe5\x0e\xfc\xfc\x15\xee4\x99\xb4\x09\xabR:ad9e\x00\xa6$\xde\x16\xc9\xb9o~j2\xf9\xb1y1 \xe73\xb4A\xe4\xbdt\xfaU\x08\xeb\xc7[\x07\x97\x1a+\x9eV\x8a\xa2j\x17\xf2\xcf\xc1\xb6} Z\x01\x1b\xe4_i}\xa8\xd9\xd8\xbe\x918\xaa\\\x95\x98\xd3gx_|<\xd54K\xfe`\xe8\xc3OA\x98k\x1bo\xb6}\x8f\x0f\xfe\x15\xb0\xc8\x0b\x8f\x1554o\xb9\x0d\xb3t%\xcf*7k\xe7\xe6\x15j(r/\x82E\xb5X\x81\x07\xe9'9\xb8\xd6ND9\xef\xcdA\xddvB\x10p?\xbd\x81\xb1^@\x937\xf7>\xf6\x87\x16\xb2P\xd8_\xfb\x8d\x97\x83\xe2\x87\xa17t\x93\xd5pd\xee\xf16\xcb/\x85B\xd1\xd5$O\xf0\xaa\x05.A\x16\xa6\xe5\x17u\x1b\xfd\xbe\x94\xf4\xd8M\xd0!l\x9ct\xb1N8Sg\x8b\xcc&\xb2rv\x18L\xcbb\xc48\xdc\x85\x07~\x07LL\xedvxD\xc0\x95\xda%\x8fiG@KI\x94\xf6C\x1b\xfc\xc5\x97>3[s\x80\xe3\x82\xa7\xd4d\xbb\xc1W\x91\x05S`\x9a\x98\x06\xc5\xeb\x9cu\xf5\xdd\xb1\x8aioO7\x0e\xde\x9d\xe5$\x80\xfd+\xf2\x99>\xe4\xd2\xdb\xa8y\x04\xa7A\x9d\x8f\xf4CF\x01\x17\xb7\xef\x8b\xf3\x01\xefC@\xcd\x9e\xd8\x13\x17v>H98\xba\xf0\xde\x12`\x07\xde\x0f\x89^\x88\xf7:X92|2\xe6\x87X7\xb2\xe4\x88\x8em\x8d\xd6J\xe2\xbbq\xf3\xdfI\xc3?I#\xc6\x8bU\x0c\xd2A\xb4\xea\xabv9\xdci\xecf\xbdQ\xe0.\x1d%\xacPm4{\xf6i\x01\x9cV<R\xdaz\xbe\xba\xcdh\xaa\xbb\xba5d\xcc\xb9(\x8f\xdb\x9b\x95\xee*W\x0a\xeb6\xd5:\x06\x87f\xa3\xccn+\xcd\xbcS\x8fa\xf3\x09r\x9e\x1drv\xc3=\xc6\xda\xa6'Zh\xc9$\xbe\xb2\xbf\x17\xc9\x88\xda\xa9\x94\xe6\xe7}YF\xf6\x88\xd0'#\x13\x83\xc2\xf8\xc2M8\xf1/f<1bB\xe1D1\x1f\x96\xa9\xf6T\xd6W,y\xa0\xb37+\x0c\x83\xf1\x13\x8d\xf0\xaaM>\xd2u\xac\xa8\xff,\xc7I\x89Z\xf4e\x01}\xfd\xd3\xe9\xa3\xc2Qr\x89w\xd1\x00\xe3\x0f9y\xc4\x9d\x1b\xedr\xb0\x99\xd8\x82\xf0'\x11h\xfe\x11\xe1\x03\xc6\xf0Z\x1b\x91\x86\xaa\x92P\x96\xab9\x96\xaesv\x92\xa3\xdf<\xc0\x8c\x90\x93\xd6Q\xc0\xf8\xa0\x90\xe6\x9eS\x05\xa5,\xcfyL\x14)\x880\xed,X\xa1\x82S\xdf)\xca\x03\x06\xda\x97Gy^X\x0a\x8eg\x85\xfe\xfd[q\x9f\xc31\xb6\xeb\xecu\x17\x96\xbd\x9e\xf7RB\x85\x07\xdef\xe1\x17\x17(\x8a\xd0\xa4\x09~}\xdb\x1c9@H\x93\x9ez\x1f\xb1\x02\x87#\x8d\xcb\xcb\xbe\xbb1\x0e\x1a\xd9\xa7\xa2\x82\xee\xa0 \xbfz(\xe5x\xf2\xf2\xaa\xef\xaa\x16\xe2\x10\xfb\xb6\x98?\x884\x98vzL1.D\x08sf\xfc\xe4\xee\xbe\xca\xc9\xad\xcb\xeb>\xb0\xfc\xa6\xf9\xcd) @\xf3\x96\xc2\xb6I\x9bW\xcd\xdd\x84nJ2\xfd\x02O\x1c\xe1\x88\xc0\"y\x94>~\x02\x91\x81\x14\x03/\xb5xS\x1d\xf4H\x16m\x03\xd6g-U\x1c\x90\x89\xb2E&\xf4KU\xcab\xfa3\x80D\x84\xb8S\xf4k=\xb3\xba\xfa\xab\x9d\xe6\xf2f\xcd\xbc\xa8r\x07J\xae\xc5\xad]x+\x9a\x05\xfdK\x0f,o\xf4\xae_V\xfb)\x94W\xb4\xc8(Z\xb8A\xc8\xa9)\x0b\xd6C\xf3F\xdb&\xe9z\xf8\xb4e;\xc3\x1d\xbb\xb6\x98\xb2I\xf1]\xaa\xd9%:\xac\xa4\xcb\x9ev\x1b6\xc6of\xc5\xee\xf9\xfd\x8e5\x80\x0e'EDU$L\x83\x14\x08\xa2\x88\xe4\x02V\x15*\x1a|\xcc s\x88zi\xe8\xb2\x0f\xba\xc9\x9f\xe8\x9f\xc2VT\x8b\xb3r/T\xbb\xc8\xc9?\x96.uQ\x1c\xd1\x9e\xf1d\x0b\xb1\x0aA\xc3\xc0s\\\x9e\xba\xd7\x802\xe0\xc8\xfa*\xd9^\xb3\xc1{B\x8cpD\xa6\xeb\x8f\x86\xf3\xaa\xcbpS\xf3\xb6-\x09\xab1'\xbc2\xfd=\xed\xe4_\xa1Y\xbd\x94v\xb8\xba>\xf5\xe9\xf7\x14\xb2\xbd\xfd\xa3]\xd6w\x1fR\xcf\xeb\xd1f\x8a\xe6\xdf\x06\xa6\xf8\xedp\x09Y4,3\xbe\x85L\x91\x0b\xf7\xe7\xe2\xeb\x17A\x80\x8b\xda\xb4\xb4B\xaa\xe84h\xdc\xa8\xf4\xfa\xa4.\x8d\xfe,3\xf5U\xa6\xda\xa2\xe7\x13\x16A)\x12?\x82\xf1'PA\xef\xba\" \x81\xb8\xd7\xf6\xcdg)\x96\xbf\x09\xf4\x1c\xf2W\xf5X3`\xc2\xf5$\xef9h\xceH\x8fx\xdaO\x9b\x9f\x06\xbe\xc9\xa7\x19\xfb\x10\x95us\xad\xf0\xb1\x83+\xd6Td\xaf@\xd4Y\x8ft\x08j/-\x12>\x1c\xd0\x0e!\x10\x83\x126k\xcaV\xaeS\xdf\x11\x14x\x1d\xec\x01g\xcb\x9a\x04;\x94e\x1b\xca\xbf\xd5AR\xc7\xb9.\xaa\xac\xe6\xa9<3\xcbZa\x84'\x84c+\x95t\\{\xa0\xf7$3\x12\xb49+\xf7\xf3\xa0\xc9\xb6/\xf5D\x86\x8c\xbd\x02bn\xbf;\xad\xa0a}\x81\xae[\x94\x07\xd4\xc6a\xd9V\xae\xb0\xe7\x9b\xdc|\xfac \xab|}\xd9\xed\xb3V\xbdBe?r\xf4\x0a31\xf0\xda\xc3\x0fa\x17[K\xc6\xd01\x0d\x9f\x04\x0d\x96\xc8q#g.g\xbe\xac\xc7\xef\x14\xfaw\x7fE\xdf2*Ej\xe6\x86\xfc\x04;\xe4\\\x06\x0e~ 5\xe8\xed\xc4yO\x0f;P\x8dt\xdd~F%\xd4U\xeaU)\xde\x0d\xe7i\xe7\xbc<Eh\xb9\x9c\xd6\xb0\xf6>^j4\x1f\xb9\x0a\x9f_H\x09\xdcL\x95\xa9[Q\x84K:\xb0\x00oC?\xa5\xbeu\x11\x04\x94\xc6o\x8d'6\x09\xff\x05\xae\xbf\x85\x1c\xf8S\x0f\x04\x02\xc5\x81t\x1e\xc1\x098\xd8*\xa8\xa7Js\x91|\xfa\x0c\xeb~D\x87\xba\xcaj\xbc\x8b\xba\xd9\xd7\x8eY(\xad\xf2\x9b/\x9d\xabQ\xdc\xec\xe9{m;\xf3\x8a\x89_Ec(Y\xe3\xd2\xd9\xcfr\xe5\xf6\x85iB\xcee\xd79\x08\xfa\xddi\xf4\x1a\x15\xce\x13\xa8\x80\x87k\x147\x11\x93LC\xf6\x98\x11vl@\xeb\xabJ_\xcd!\xa6?\"\xd7b\xf9\x05\xdd\xf4HU\x0fl\x92\x8e\xe9\xa3\xd9\xa0<\xb1)20\xb8\xbc\x90W\x9c\x10=\x0c:\xaefb\x1d\x01\xf3:\x15\xf4J\x092\xa9\xa5\xa0\x1ek\x13Tr\xf0\x95q\xd1\xb1\x08\x98\x13\x05;\xd8\x9b\xe7\xb37k\xeaK\xfe\x81k\x1e\x9ao\xa5Ke\xea\xa6}\xe9h\xc90\x19\xe1:\xc1\x9e&]x\x0e}\xd1m#\x85\x9c\xa6PK\xf3\xe6V\xb9\x16\x89@\xb7Q\xf9O\x95\x98pJF\xf7\xa8[\xb3S\xed\x06\x8e\xca\xeaZ\x15\xb9#\xc7v\x0a\x0c\xdb~\xac\xff\xb0\x0a\xc2\xe8AT\x0a\xdb\xb9\xd1@(\xf9\xc8\xbf\xc8\x8cE>\xf6~\xa4?\x91\xe1\xd1k\xeb\x9d\x7f\x01\x0b\xf44\x10o\x04\xb1P\xd4V+\xa5\xfc<\x85\xf2\xef\xc6',]+\x04{)k\xc0d\xb8\xe1\x9e\x1f-\x1a\xb2vU:\xf5]\xa0\x04\xdd\x80\xa6:E\x09\xdbI\x96K\xae(\xbb\xbc\xf7QZ\x98\"%1\x19\xdf\x80\x87\xbcb\xbb\xea\x9e[\x98^D\x1b\xb0t\xb2\x18\x1c\xf7j\xa6\xf8J\x8bI\xa7p\xfa\xdb\xb3\x0a%^\xd0\xb1\x969\x1d\x95Z\xecM\x10\xe35\x11\x00=\x7f\xa6\xcaR\"R\xba\xf5z\xd2\x9c\x87<\x181\xa4\x87\x1at6\xcb\xa3\xd3L\xf10\xee\xd4%\xfd*6\xe7uL\x9f\x7fjy\xbfGG\x06)\x89Wv\xe9\x8eN\xf4\xb7\xc4\x13\xe2\x1a\xc4\x94*\xee\xc9+\xa5\xed\xc4\xf8>t\xb7\xd9\xe4\xcc\x94\xce{\x1d\xa6N\xf2{\xee\xbb\xbciZ\xf2\x16\xacvU\x9e>7\x99w\xee5d1\xccS6n!\x9e\xf9\x87\xab\xcb7f\x95$\xd7\xfc8\x94\x0c\x80\x93\xa4\x8f\xdcv\xbeY\xbf\xd4\xe9\xf2\xc5E\x033\xb4Zv\\\xe0\x9a\x04\x00>\xb1g\x89\xfa\x06Y\xaew\x1eq\xeb\"L\xb7\x9es\x00\x96\x06 \xe8\xc8\xa7\xf5i\x1d\x1e'=\xf8\xddy\x1ec\xd4\xe0\xfdh\xebx\x8e\xacv6p\xc6\x8b\x00\xcafB\xad\xb0\xb3\x16\x83\x03\x89j\xfe\x06\x04\xb0\x13B\xee\xc7\xda\xa6\xe6\xd6?\xf6!l=\x95\xc5\xed\xc7\xf1f\xa5\x0a\xc4\xcc\xed\x82\xef\xc2\xf0X9$\xbelC\xef\xae\xc3\x10\xfc#\xca\xf1\xb7\xa0\xd5\xd8.\xe2h\xb4U\xe9\xd2\xbe\xfeg\xe5J\xc2y0\x96n+\xa9R\xe0\x90v\x82\x98\x10\x1a-gMx\x8a\xbe\xc8\xd7\xbe\xc7m?\xd69\xf4GN\xdc\x1c\x98\x947'\xcd\xa3\xc4\xea{\x15\xfa\xe7\x9a\x91\xc4\xef\xed\xacy\x1dFf\x9f\x7f\x11dv\xf3\xd9\xae\x06\x046\xd3\xb7\xff\xbe\x0c\xa6\x17r\xf6\x9a3\xb3\x9c\x84\xae\xd9Q\x02\xff\xeb0.[\xb6\x93\x17IR\xccEm*\xf5\xcf\x7f\x1d\x82d{\x11_%0sT\x87j|\xf2\xf5\xa9\xc5\xb2\xafC\x00\xeb\xdc^\xeb\x1etl\x0e\xcd;7\xa0\xb5U\xefI\xae\xdd\xea\x15\xbf\xa5`*\xb2/\x072\x0aZpy\x92gH\xb2?_\x07Q\xd3J\x92\xa6J\xb6\x1b\xce\xcf\x09S\x0d\xaa\x9bG\xc5\x05\xa5e\x05\xcf;\xa1\xe2\xbf\x0e!\xa2#\x875w4\xc8\x16\xb8B\xf7\xc2\xa0\xd2\xee \xba\xb92\xea\xa2\x0d\x82S\x08iJ\x0d\xe3\x04\xe9G\xf07Z\xaf\xe2\xd4\xbb\xd3+\xb9;R\x88V\x01g\xc0\x97\x97\xe9\xe5\xba_b\xbe\xd4b@\xad\xd9\x1eo\xe9>\xad-\xe1%\x93N\xf3 H}\x18P,\xeca)\xc3S\xaf\xd0\xccT5{\xa9,\x0e=\xfc\xb9\xb7\xd0\"\xa3\xbb\xd1d\x17a'\xd2\x83L\xda\xa5\xad\xd9\x07\xd4\xa2E\x08Mvz\x8at\xf7\xe9\x99>\x0bS< \x93\xf9J\x04\xce \x0d\x84\x99\x88\xd3\xef\xc4\xbci\xc8\xf84\"\xdbH\xb7\xe4\xef\x7f`\x96\xf1!.\xd9\xc6\xfd\x17\xeb\xf6\xbe]d\x09\xec\xb9\xf4\x15\x0e\xa2\x97m{\x93\x88\x15\xef\xbc\xf9\x0e\x92\xcc\x0d6\xe3g\x9f\x97M\x1d\xc5\xaf\xc2\xec\xcc<=\xdc\x1b\x06\x9d\x9e\xfeG\xbe\xce\xbcj\xe2;\xdf+\xfb\x83a!\x03*#\xe8M\xe7\xfa+\xc4\x13\\\x9ag7\x99_$\x99\xde\xad0v\xe4\xba\xff'\xc0\x8e'\xa0=?\xd2N_O\x18\xf82k\xd6\x19\xeac\xdd\xae\x9aw\xb9uY\x1c\x09\x0f\xb1\x10\xc4W\xed\xf5>\x9a\xbf\xb9\xcf\x82*\x1d_O\xc2'\xaf\xc9\xb2\xad\x9a\xcd\xe8C\x9c{:|\xfa\x99,6\xe9\xfb\xc5S=\xe1\xb5\x97_a\x07L\x0c\xfa\xc7 \xab\xf3\xcc\x92\xad,\x08\xb7\xe2\xc8\x90\x96\xc9J\xa5\xfc\x91\xe7\x1b\x86\x04\x13\x03z\xbb\x00\xb2c\x8f\xd5\xcbA\xcc\xb2\xd94O\xed\xcc\xd4\x91\xd3Km~:\xcaa\x90H12\x92\xa1\xcb\x03\xc3\xeb\x80\xc3\xf3v)b\xe2]\x1d^mY\xe9?\x8b\xad\xea\x0d\xeb\xb5\xf0=\x93\xaby\x94\xdc\xd1\x92x\xfb\x9b\xdf\xfd'\xb9*t\x9c$0\xed\x13\x03\xc4?P\x9a\x1c\x86\xe2\x82c\xe50\x91\xae\xbcE\xe9'Ql\xea\xa9\x9d#\xd4\xbd\xa7.:Y\x92\xccp8\x02_\x15\x9a\x04\xa1S\x98\xf7\xff\xe2.\\\xd1\xf5\xd2Ps\x87\x97d\xc0\xceU\x0fcI\xad\xe8M\xb6\xaa\x1e\x16\xef\xd9\x10\xd9\xdf~\"\xdd\x81\xaf\x85q\xb9\x88\xfa\x09\xd4w\xa5\xac1\xe5\xe1\x0c\x122`\x8e\xa4v\x9b\xd9\x04\xe2\xe4\xa7\xc8\xbd,\xd9x\x8f" +
+	"\xfd&\xd8q:\x1a0\x9d\xad\xf4j~\x94\x7f\xdbaR\xbcg?\x9d\xec\x7f\x0a`\x92\x0a\xef\x09F\xf2\xe2\xa6C\xdc\x9c\xde]t\x97\x85>\xbe\xbc\x06/n\xa3;\xc3Rg\x81\x9b\x13\x90\xe6\xb1\xdd\xf5\xe2.\x86\xd4\x9b\xf6\xbey\x11\xf4\xa9\xfa\x9eT\x80m+\x94s{\x0fA\x8d\x1b\xa9c2\xb6\xf6i\xb3\xd5M\xf3\x09\x0d\x7fk\x18C\xa15\x97\xd9\xac\x7f\x01\x1c\xc4\x01\xe8.\x0c\xccS\xb3\xe5\x85\xc6\xbbCb\x89\x03\xcb\xb4\xa8j\x80\xccl\x04\x9e\x98FmG(O\xc6\x8c\xb1g\xb2\x13\x17\x98]\xcc\xbd2\xb0\xd3\xd99\x04!\xe9\x8f\xb9\x88\x98=2^a\x96\x8f}\x9a\xd3\x10\xea\x95\xdb\x8a\x1b\x005- \x97\x9b\x91\xfcQ\xc5\xf2Q\xf5\xdb,\x10\xfb\x8d\xf4\xe0\xb4\xd9\xc9WS\x00J\xf1#\xd6^u\xf2\x80\xbc\x02W@\x8f\xee\xad<&\xa0{\xe9\x8f\x83x\xc2\xc8\xfc\xcd\x90\x97\x1fD\\s\xc4\xe0\x89\x94Q=\x8c\xa3Ts\xbfV\x1cy\xf3\x0c\x94\xfe6\x12Xc\x94\x84m`\x0az\xad\x92\x9fvy\x91_\xc1\xd6\xc2\x1fl)\x97>\xa1\xc9\xe45<%\xdd_\xb5ho1\xe7I-q\xaa\x12\x8d\x17c\xd4l\xcb\x0az\xe2\x0fl\x09\xdae\x06b$\xa9<Y\x07K\xd5\xe6\xc5\xb7\xe5g\xb9.\x90_\xd2\xc9\xf5\x82\x00\xfb\x1fE\xd1Be\x1b\xd3\x8b\xe97?\x97Y\xf7GzA0\x06\xf7*w\x14:\xc1B\xb0\xc1\xc9\xe8\x89p\x91\xb0\xde\xeb\xa4\x8b\x8f\xd1\x84\xf2\x8bi\xbe\xee\xb5K\xdffL\xce\xd1\xd6E\xf8\x7fu\x99\xd7\xad\xb4\xf2\x94\xc2\xae\xcb\xdb\x1c\xc6\xac\xe6\xde\xe9I*\xd5^d\xebvW\x83\xf89\xf2J%'\xee\xb2\xd3\xb4;\x0d\x1a\x87'\xf8\xacW}}\xb7\x96\xea\x7f\xe1\xc6\xbf\xe63S\x98\xe1\xea\xbc\xb0\x87\x95F\xe3\xea\xac\xe7\x0e\x95\xa7\xdex\x9a4\xe9]\xf6X\xaf:<\xaf,A\xcb\xc2\x07\xc6\x8f\xc3\x02\xa8\x99\xe6\xc8OS\xb6U\x00\x02%\xf2\xbf\x0e\xa2\x02m\x18\x82\xee\x8a\xcf\xaa,\x90s\x06W\xd9\xbc\x9d\xc91V\x8a~\x83B\x00\x8b\"\x13,\xa5G-\x88\xff3\x154\x85\xcco8O\xec\xc1mT\xb5\x8c\x85\x84\xde\x9b]\xff#\\\xfa\xc2\x91\xd1\x88\x1c\x1c\x07\x12\xe4\xaf\xa5\x9c\xbfT;\x89\x87\x10\x83:\xd1\xf7\xc2\xdb\xf5\xb2]\xf5_\xd2\xb5\x94H\x16#j\xdc\x80{\x9f\xbe\xd1\xd0\x85?\x97_\xb4K~\xfa\x19\x0aQ\x9b1\x7f&\x88,hZ\xfb`-\xd1\xa1\xf6\xa9b\x82S[a\xebf\xdb\x7f\xe6.\xa3\x1eW;\x96\x12\xa1\xdb\x8b\x92,\xac\xb2\x1dm\x09\xf1{8\xc1\xb1\xbb\xba\x0d\xa9\x9a\xa5\xd4\x1f\xdb\x99\x9eeb\x9c\"S\xf5\xe4\xfb\xa8\xc6f\x8eF_u8\x01cZiKEei\x8e]\xc0I\xbe\x15\xd22W\x1dK\xc3\x17Qf\x12Y\x13\xaf\xca\xc8);\x8c\xab\xbeqW\xfe\xcf\x82?y\xed\x03h6{~\xff\x15\x12*r\x8e\x9a\xbdX\x1f\x1a\xae\xaf\xc3D\xfdFb\xde.\x0c\xaf\x8c^\x1e\x91\xb6'\x87\x01\x92HU\x14\xeb\xe4\xe6\xa54_\xd8\x9b\xa0\xb7\xa2Z\xf1\x86M`*\x84\xd0.\x80S~\x1d!\xf0\xf7\x02\xe8\xcdF\x02\x1f\xd6|\xa8\x0d\xae\xb4\xa6\x0a\x85\x94B\xc8\xd3+d\x8b/z8\xf2\xb0\xccW\x90\xbe\x98\x88g\xdd\x1c\x94\x1f\x86u6\xac\x8c\xc5dw\x9a\x9c\x949\xce\x8f\x8e9\xee8Q\xd5}\xdaR\x11\xaf\x06'\x04O\x0f\x9e\xc5_\x871|\xd4S\x0c\xa8\"\xb7D\xf2D\xc3\xeb\xde\xd0\x12\xac\x00\\\x9a:\xda\x8b5t\xefC\x0b\x14\xef\xdco\x8c\x85\xfb:\xcc\xbc\xdf\xe955`\xbb#\xe5Dp\x91qu\xa6\xb5\xc7\xa11\xebx\xe1\xdf\xf3B\xb5\xa2\xc3\xb0/dCs\xc18)[\xdc\x13\xb6K+\xd2}\x08\xfe\xb8NQ8\x83\x1c\xdbZ\xc1\x10\xfaR&\"\x81;\x94n\xba\x0e\x1e\x19\xb5\x0c\xbc\xf1&*\xfa\xa2t\x99i\xf3]Gd\x18pg\xd2Y.Y\xc59\xe1\xb50\x98\xcc\x19\xa1\xf6u\x98m\x9f\x839W\xeepyE~\x17\xd3\x09\xb3\xf9\xd2\xa6\"\xf3<\xff\x0c\x13i\"S\xde\xb9\x016m\xf4K\xca\xd2\xfd(\xdb\x96\x9bN\xb2O\x0b1\xcd\x13\xb6,\x05Q\xbb\xe5\xf77w\xf0C\x891\xb4\x11T\x03c{\xd3\xc9\x08\xe2D;\x01w\x06\xae\xaa\xbf\x9b\x17Z\xdc\xba\xb1\xa7\x03\xe6\"\xadf\xfe\xcbK[\x8b\x175\xb3:\x0dx\xb1Y\x8e\xfeS(\x8a\x81\x8d\x9d\x89\x0d\x82\x9e\xb0\xd9\x04\xa8\xedN1\xa6k\xca\x8eo\x85}r\x0a\xef\xe9%M]\xd7\x07/\x11\xb8\x88E4ku\x8c\x19\x07i\x84\xb3\xd8\xa3\xfb\x17R.\x00 U}r\x92\xfeC\xd5\xacY\x94\xd9|4\xed\x13k\x867x_\xe5:i\x01D\xe0b\x00(\xd6\x17n\xeb\xbc\x17\xa2\x83\xa5\xa4\xbe\xd1\x17)r\xbb%\xfe\xbbAP\xa2\xc4?q\xae\xf0\x82\xa2~?n\xbf\xa2/\x9f\xa9\xe1\xccq~/D\x83\xf8@\xcdc.;\x144*y\x09X\xdd\x96\xd1f\x83C\x1d\xcb)+Ii\xa4U\xe2ZB\x08\x0f\xa3}\xc0\x98\xa0\x8e\xbeP\xd0.H\x09i2\x9f\xbf\x00\xe5\xcd0\x14\xb1r\xd7u\x1c\xe9\x1am1\xc2\xbfCa7\xed\x11\x14\xa7\xb7\x82=n\xac\x88k\x98\xc0\xd3d\xc8-\x0d/2\xe4\x05\xda\xbb*\xf2\x01\xfe\xa5\xa7ymg\xfdwr\xd3\xe3\xf3v\xf3x\xb6\x7f\xadE\xea\xfc\xe0\x10c\x98\xe1\x99\xfe\xa9\xee\xda\x9b.[~M\x1b\xfe&\xe6\x02&i\xf8\xa8\xd3\xdfU'\x0a\x19\x8c\x9b\xdb.}7\xf7\x15X\x96e\xdb<ry\x92\xbc\xdd\xdf)\xe4\xcc\xdamj\xe9H\xb5o\x87\x11\x85\x84\xf9l\xe7\xfe:\xc6\xea\x845S\x0cb\x1dj\xb0.g\x1c\xf8\x1d\xb8\xcc\x9b\xde1\xe0\xac\x1c\xe7TT\xff\xdb\xeb!\xa7\xf4\xb2ua\x11\xeaO\xd1\x01\x92\xb3(x\x82\xb7g\xddP\x90n\xec\x93\xde\xe8FE\xa4\xd1\xe7\xe1\x825~\x9d\x9f\xdc#\xe2\x12\xb7\x94\x8e\xf5w\xf3\xbb\xf9)\xc15/N-p\xc0\xf6\xd8\x11S\x1a\xacvU\xba\xdd\xf3\x0e\x1b\x1dt\xd2h\x09\xf1C\x9f\xd2\xd7\xc3\xee\"\xe3s\xbf\x8e1\xe5/\x84r\xf5Q\x88\x17t\x92\x8c\xc7p\xb7\x97\xdd\x0a\xa6 \xed\x85\x83\x16\xe9]\xda\x918g\xfd(\x0cK\xca\x01\xb3E\\\x99<\xec\xaf\xc3\xf0F/\x0c$\x96O\xc2\x08w\xdf\xae#k6\x91u`\xa6\xef\x0d\xf4&m=\x8cy\xe4Tu\xf0\x88\x035\x1a\xde\xaet\x8a\xbc\xc1/\xe7[\xac\xf5\x86D$\x86Z\x1f\xd8\xa7W:\x07\x89Z\x85\xe3\x9e\x1cz\xda\xdd\xe6\xf9\xbc\x07\xd0\x91\xf1\xc6\xac\xe0A\xff\xd4\x99\xd7\x9d\x9b\x93\x90\x1b\xe3<\x0b\xd4\xd1\x9c\xf8\\\xff@\xdc\xf4\xb9\x18<_\\\x8f\x90\x06\xc5T\xa770W\xa5\xfft\xfd\x7fd\xb0_\x05\x0a\xb2\xc85\x97y\xc0\xda)WL\x96ZLVj)\x89.Nx\x93\xd1\xee\xa2\x98kP\xdaGiA~\xe3\xb4\x01\xfc\x81JD9\xdcC3\xc9\x86Q\x8e2\xf7\x82\x17\x07\x9b\xa2\x05j\x98\x91\x7fm\xf0\x04))\xea\xdf4\xd5\x1c\x9b\x9e\xa5\xcf\xab\x00\x96\xd5G\x88\xaa\xbfK\x92\xa3\x1fF\xa0\xea\xbe\x99\xa5\x06<\x19\x90\xeb9\xf2SX\xbc=^l;\x84\xe8+\xd5\x8e\x94\x19\x963\x05h\xa3\xa3\xc2I\x84q\xda\xe2\xfdz\x98\xfe\xbf\xeb\xe0\xb2\xc6\xa1\x1a\xd9#\x19\xef)+UP\x9fE>\xa2\xcbaKv\x97'Z\xf1\xf09K\x12\xc9\xb3R\xd9J0Q\xb5\x08\x03\xff\xc4=\x0eC^\x95}\x0b\x83\xb2\xc4j\xa7\xd8\xb6\xd9\x0d\x0e\xc7e\xdf\xfd1;N\xfa\x9d\xba'\x10\xe8I/\x90\x9cp_\x19\xda\x82<\xd5\xb8\xae \xac\xa3:@;C\xb3\x91d\x996\xfe\x15\xcb\x0d\x0c\x9bO\x13f\xa8$\x7fwU\xc8\x88'?~+\x1b\xcd\xe0b\xbe\xebf\x0e\x88rcg\x1a\x10By\xf8\xa1\xec\xd7\\bi\xb6\xcdOfXk~j\xedc\x0d\x91^\xcc\xd77~p\x9a\\/\xec\xc7\xb6\x95Y\x9d7\x04n\\z-\xd7\xed\xeen:\x8f\xaa\x05<\x0b\x0df}T\xe1\xf1\x1d\xb00\xee\x9b\xb0\xe1\xd9/}\xaa\x93~b\xa9\xce\x8a\xdc\xaa_\xcd\x01\xd3\xfe\xab\xda,\x17\xfb\xfe\xa2\xe7\xe8,\x1e>\x1d%Q\xeeQ\xf2s\x8d|oih\xba\xe5\xeb%6\xbdA\xa4\xaf\x05\x8af?;=Ee\xdcc\x95R8\xb1\x0cSVptb\x00\xf2\xe6\xdae\xe2\xf4\xf3%qi\xb7\xdcj\x05\x82\x1d\xd9T\xb1\xb8\xd7\xc4\x9a1\x8c\xcer\x09\xc9\xb2(\x90\x97\x01XpC\xa2\x93\xfa\x0d@Q\xda\x15\x07\x18\xd5\x8b\x978\x1b \x14\xc7\x80\xd1\x83\x0d1\xb6\xe3\xe2c\\\xffF-Z\x10H\xa8\xf2F\x13\xeb\x0aqz\x07\xe9Q\xda\xfbQ\xae\xfeZ\x0b\xad;\x8e\x89L\xbf\x03\xb3\x1f\xb5z'f}l\xde\x8b\xa3d\x16\xb3\xd3\x1b\x1a\xba\x9f\xb8\xca\xdd\xbc\xe8'0\xc4?\xf2\xd8\xf0{\xe96\xf8QG\xd8\xfa\x0b\xb2T\xa1\x8fc\x8a\x86\xc3\x9aW\xdcJ\xca\xc0\xf5\xd0Rs\x14\xfb\xe9\x05\x81\xc7\x8a=\xd1\xe6\x88t\xe0\xb4\xf9(\xfe:\xf6\xe4 o\xef\xeaxGJ\x8e:9\xcfv\xd1\xec\x0a\xa7\xba\x8d3\x8757\xcc\x05\x90\xcc\x03\xb1~\xf0\x9a\xa7\xf3+\x88\xe5\xb9yO\xf0\x08\xc9\x95~\x08\xb8;4\xac\x98\x02(\xd8\xc3\xd4^\x90\xbd\x1c\xe1\xcbww\xa6x\x89\xa9\xa9\x1b\xf5\xf6\x1f\xad%\xb9\xb8\xea4Z\x1c\x90\xfb\xf2\x80\x87\x11\xe6\xfb\xda\xb3K\xe5\x8d\xf8\x8epG\xcc\xbaE\xa5H\x14\xa5x\x99\xb1\xabC\xb6\xf0\xec\xccCO\x8e\x9a\x81N\xc3\x0d\xee\xb89v\x1f\xb5\x0d\xc2\xe8\xd7)\xe7\xc0R5\xbf\x06\xaeu\xde\xcdS!4\xb5\xec[\xc8JU\xb0\xee\x9c\xc4\x9a\x98_\xcc\xe9\x15\xbe\x96>\xf9\xaa\xf9Y\xbc\xf6E`z\xd10A\x13\x08\xf4j\xd0L\x01\xa3q\x00\xb1\xca\x84\xe8\x18\x0f\x12\xc8Lc\xb6\x90\x06\xad\xa6\xee;\xa0V\xe0_\xec\x84\x92\x83q+*2\x9b\xbe\x1f\xda\x19\xce|\xadgG\xdbn(\xb5\xd1?\x16F\x18\x01\xb9\xfa\xacGp\x1b;C\x9c\xb7\xcea\xa0\xd0\x12Jt\xcd\xf9\xb1\x15\xbdW\x05|\xbe\xaa\x0c\xe8\xc0+\xbd\xea\xa6\xdb(\x02\x0d\xa09\xa6zq7\"\x91\x908\x88D\xf3+\x8d\xd1\xc7\xe6\xb5x\xbd\xeb\x8e\xd8\xb9VT02\xe0\xf5\x17\x89QUr\x9f\xcbML\xdd\xa7\xb2C<\xa4{\x02\xcfN\xa9\xe2}\xe6\xc9\xa83\x09;\x9c\xd9\x15\x10\x1d\x04\x0fd\xfe\x04Rx\x84G\xa7S\x98\xea\xe3\x82FYd\x9c*\xd3\x81v\x9e\xb3\xb3\xdb\xd8\x1f7\xf7d\xefi\xe16\xc7N\xfe\x98\xa6G\x01\xda}\x96\xb1\x1c\x02\xe4@\xbf\xf9\xd5.\x9au\xdf\xc9J\xa5\xea3\x0f\xf6\xa6P\xaaj\xded\xaa\xad:$u>P\xe17\xe41\x82\x9a\x1czO^\x83\xd4\x0a\x9e\x85\xd5\\b]\xa6\xff+d\xa7\xcf\xce\x83\xa4'\xb7\x0b\x1b\xaebJm\x81;\x93\xc4\xa4\x9b\xfe\xdb\xe4\xe8\xab\xe6\x13\xb1>^\xdc\x07\xf8\xa1]3\xae\xbc\xc1d\xea\xdb\xfb\xf0\"Q\x95\xd4E\xa0\xba\")qX\xb8\xdbL\x84\x8e\xb9\x8bD\xce|+}\x07\x0a\x8c\xaa\x85V\xad\xd0\x87p\xde\xd1\xa0\xdfP)\xfa\xd3\x8fB\xf3\xd8.(\xf9\xa4\xb2X\\\xfc\xa7?tF\x0bk\xb2\xf5\x82[sN\xad]\xfe\xd3\x88\x0bk\x09\x06d\xb2\xdc\x0c:r\xda)\xa5\x99~~\x99m\x19\xc1Wv\xcaU\xae\xa0\x02\x09\xe5\xe6=\xcd\x96\x9f\xe9\xfe\x9a\x8dsB\x8b\xcd\x98\x0di\x1b\x15n\xa3\x17\x12\x0fG\x91\xce\xca$\xddr\x0e\xd9 \x15\x0d=\x00\x83>;\xbf.1\xa4\x83\x03\xba\x9deH\x9d\xa9hjw\x02P+\xef\xd3\xb1\xa5\xc6\xa5\x9b\x9e\xbd\x90,\xf0\xabir\xa70\x9e\xcb\x96\xd8\xd3\xa4\x19&S\x8b\xa2#\x0c\xa8\xb7!K\xfb,\xd0\xa4wZ\xa7\x03\x85\xb3\xb3\xf3\xdb\x98\xf8\xa2\x8b\xa5\x09\xa3\xb1\xc9h\x0bh\x00\xcb\xb55\xe1\x8fU\xb3P%\x8f}\x90\xf0\xdf\xa1\xb9\xce|\xf7@;\x97i\x0c\xfd\xa3\xef\x1c\"\xaaP\x81\xf2\xeb\xa8\x80\xef\xab\xe74y\x04f\xf3\xad4\xf5.\xbe\x046\xde\x1e\xad~\x1f\xa2P`\xb7m\x9e\xd2{zj\x17\xc6\x9f!\x0e\xc0'|\x96(\xd3)_&\xd1\xf9\xca4\x9e\xb9\xfbd\xe9\xc5\xfbkd+p\x11d\xea\x9a\x03\xbc1\x1e2\xaeE\xd0-R\x83L)c\x7f\xe69\xb2\xc5\xcd^\xa0j\xc8ps\x03\x7f+\xb7Rs\x1c@\xff\xe1\x15\xbf\xb6+\xdb+\xfaE\xd6\x80\xaa\xde9M<D\x16T\xe7\x911;\xa5\xf0{v\x91+7+s\xe0\xac\x8f9Pi\xa9|\xbb\x16\x83\xd1\x10\xf7\xcd\xb8\xda\x94\x11\xfd\xa6\xfa\x93\xa2p!l\xb3e5\x04v7\xfbo\xef\xa2\x97\xdd\xc0f\xad\xe7\xf4\xe8\x01\xfe\xae\xb8R\x83$\xdeZ\x12\x99[\x01\xb3\xc5n\xda\x8f\xe6I\x99\xa2\x8bx\xd5\xb3\xd0h2g\xc8u\xb3\x09\x8b\x9c/\x13\x19fe\xe1\xb3L\x17?\x8b\xc7\x00H\xf8\x090\xb4U\x902\xd84\x99P\xecMjZg=\x9e\xf22\x19\x09l\x1e\xcf\xcdk\xe1i2\" \x90\x15\xd0\xbc\xdf6\x1f\xd2\"\xb8E\x94\x8a\xb5\xb3\xcb\xe9xR\x10\xdb<\x99\x05\x15\xa6n\xfa#\xdd\xb4V\x0f\xf3\xa2]\xa1g\xfdL{\xd6\xb9\xcc\x8e`\xa6\xf9k\xa0\x7f=M\xa9\x9dll\x0aI|\xa2L\x91\xca\x02\xf60Y/\xb0#\x83U\xfa\x7f\xd2\xff\xae\x93\xd4L>sf\xda<\xa5o8\xd0\x12\xbf^\x1bbE\xc9\x94s]\xc4\x08W\xe2Y\xf7\x89\x8ev\xc5\xc2J4\xd5\xb6\xc3H\xc3@\xd2\xf0K\xd4\xc1\xe0\xcf\xba\xeaP\xaf\x84\x1d\xa5\xf8wBc\x9e\x01Q\xd6RK\xd9I\xe2\xba\x9f\xbf\xbb\xc7GA\x1f\xb3u\x9bk\xf2>4\xc7Cq5\xb4\xc3\xaf\xdaJ\x0fHg0\xfcG\xff\x0b\xd3E@\xab\xc7\xaf\xceD)\xf5\x9b\xb4\xa6\x12\x13\x83\xb4\x0fl\xe1\x86bq\x08\xa7\x84\xd2Rm\xd2\x94\x12\xf1\xbd\xb5\x12\x8d\x89u\xd2\x9e\x0aZ\xe5\xde\xde\xf4\x8f\xccu\x98M\x82\x10\xeb\xa7\x92\xe0\xc6cg\x88\xb8\xde\xda\xae\x9c\x1fT\xb8\xd0M\xb7hn\x14A|\xb9\x99\xce\x02\xc0G\xe7\xd2t\xee\xba VB\xe2\xcf\xa9@5\x8e2\xc7f\xc8\xde\x8b\xb0\x90+\xed\xa8e\x19\xd4V\xab\x04\xefu\x94\xecG\xbb\xf0eN^t@E\xc8\x84\xfc\x04*>\x92;\xe2\xe6\\\x8ey\x88d\x00\x01\x0d\xb1+\xdbU\x1d{\xef4\xe0D$\xcd\x05K\xfc.\xf3\xd4\xbd\x1c\xc9(\x93\xafZ\xb8\xb8K\xaa\xcd\x0d\x8b\xf2\x1a%\xa2f\xe4h\"\xa8\xb24\x8f\xf4@\xf2\x02\xb5!\xbe/N5\x9fO\xc3Y\x93X\xd0\xc20u:\xc9L&\x85Y\xfa\x14\x1b\xde\xbc\x9b\xd4\xe51\x97\xcf\x87w]z\xd6\xf3>j\x83'\x8ag\xe9tk\x01\x10h\xdb\xb5#V\x00\x06R,\xf9\x10\x03\x81\xe6\x80\xe9hk\xa0x4\x90\xa9\x81\x0a\xd7t$\x83\x09\xc4\xd3rn9\xc7\x99G1\x85;K\x80\x0f\xab\xa9\xe5\x98d`\xd6\x97\xd6(%\x93\xc4\xd1 \x0e+\xa9q\xc5\xf1U\xb4\xb1\xb8a\x9f\x19\x91Dml\x07\xed\xa6\xb0t\x9e(\xc2\xdb\xcbZX\x14\xd7\x82\xcf`Y\x06\xf7^gs\xd1\"w\x09J\xe6`a(]\xc8\xa5\xec\xdb\x8a\xc1 \xd6_E\x0e\xd03%\xb8\xa5]z\xaedm\x9e[tn\xc9\xbc\xa5\x8c(\x12\xe0\xed\xbct\xe9\xdb\xb8\x1b,\x81\xd4\x10\x7f\xf8I\xb8\xd5\xbf\x0a\x86n\x93\xe2\x12\x9a\x1c\xcd\xbe]4\xbf\xb9\x08&s\xb4\xc2+Yq\xb2\xd3\xaa\x83\x15\xe2\x91\x85P?|\xb4\xd3\xd2\xb4\x89!\xdb\xead>)\xd3\xd4X\x02wg\xb2\xd8\xdc\x88/z\xbc\x93\xc83[\xb9#!\x11R\xb8\xb5s\x9f\xf5gaD#\xc8C\x15=\x0f\xae\x00\xce\x1e\x10\xb19\xf7\xa4^F=(Xkf\xdcSO\x8c\x9f\x95\xfd\xa3e\xf3^>\xf1y\x98V\x8e\xf2\x92\xa7\xa5\x9e]\xbd0\xe5\x13!\xe7w\x9f\xac\xac\xc6\x90\xaaF\xec\xe4I\x1d\xe9\xcfQ\xfc\xbf\xf2\x9b=\xbf\xe8\x909'\xf3a\xbfM\x85\x1b\x1aD~\xbcA\xf3r~Y\x1e\xb7 <h\xcf\xc9\xfa\xe1\xe9P1\xb3T[\x13\xd3\xc6\xae\x9f\xf3X\x91\xa1\x02\xba\xd5>\x84{Pk\xbb\xc3\x8c\xf0p^%\xe6\x18\xb4lh\x1d\x89_\x12\x9c\xf0a!\xb6\xaec\xe2.?S\x9f\x9a\xc5\x06\x1f\xfa\x1d\x14\xee\x9f8\x8d$\xa6pS\xd7\xff\x80x/\x93Y\xa2\xa1\xe2e\x87~\xadC\xd1" +
+	"\xfb9\xbf\xe9N\x94-\x0a5J*n\\\x97\x1c\x7fr\xb3\x91\x96\xaaJ\xe7\xbe\xfdw*w\xef4Y\xb9\xa5z\xce\x88\x95\xf4\x17\x09\xa3Q\xd5\x86\xefMx\xe9\xdf\x91\x08\x84\xb6\x12Z\x85\x97#\x84\x17\xd2n/\xbc\xb5\x9a\x18\x93\xf7\xbb\xca\xa6z;\xcd\x92\x84\x01\xb6\xde\xc9 \xe6\xd4\xc3\x83\xd4\x19\x9a^\x00_J\xbfD\xfd:\xb4\x84\xf6\x9ai\xf6\xa6\xe0W\xef\x04\xa5\x0d\xea\x01\xde\xa5;\xb2\x1fN\xb4\x96v\\\x8f\x1fyjks\xfaF\xdc\xfe>\xb1\xb7\x1e\x961<\x0d\xb0U\x8c\xe3Y\xa0\xf1F#\xcb\xf8c)O<r\x8a\xcb\xdae}\xb5QXv\xfbo\xed\xbc\xf7\xd6\x82\xa7\xd1\xbc\xb5\xf7\xc0\xf6,R\xfe\xa6\xad\xd2\xcb\xd35p\xf4\x1c4\xcc\x164dkB\xc6I\xc3\xc8\x17(g\x0bY\\ac\xabBZ\x92\x17\xe4\xa29\xe0\xd5\xd0x\xb6SL6\xb7U\x8eq\x99,2\x15\x99\xb9\xc6\xdf\xde\xee\xb9\xcaRz\xef\xcc\xe9\x8dN\x0c\x05\x05K\x9c3\xaa\x1c\xe7\x99\xa9^\xa8&y/\xf0UU\xbe[\xd2\x95+i\x18\xa4G\xb7Q\xe0\xe4#\xa0\x94\xdf\xa3\x92\x17E\xcde%\xaf\x8b\xeb.\x8dw*\x06\xcfJ\xeb\xeb\xc1\x11s\x0a\xf0D\xf0\x12H\xb1R\xfc\x893\xc9\xeb4\x9az\x91c\x9aY\x8e\xa8\xd9\xca\x97\xc1\x8a#\xa1\xcft\xb8\x85\x9b\xbf1\xe4\x92\xce\x17\xc7\x82/\x9d\x03T\xea~\xe3\x86\xe6\x99g%\xaf=\xf8C\xca\xe2\xa5\xab\xddv\x09\xfe\x05\xcb\x99\xfc|\xf7\xd0\x1c\xa4\xc6\xb0[Dh\xc6\xf5\xe3\xe6\x80\x02X.\xa6\xe7=<\xe1\x13\xf6\xeff\xf0y\xb0\x04\x05\x91\xa1sQh\xee*A\xc8P\xd6iE%H\xbf\xa9\xa8O7\x909\xfa\x12\xfcuND\xd5\xf2\"\x7f7;)Io\xe9=s\x12\xd0\xf9\xb7\x0c\xd7\x85\xe7\xb0\x0f}Kz\xfd_\xe5\xeb\x9fuQ\x04\xc8\xdd\xad\x9a\xd7\xe6\x1b@\xa9o\xed<[\xb1\xed\x94\x85\xa3\x02\x91\x7f\xf3\xa6j-\x8e\x084\xcbWl\xb1\xa5\xeb%J7v\xdeC\xf2\x9e^\xcc\xbc\xf9\x8f[\xb6\x05k\xd6\x11E\x93\x1c\xd7\xc2W/C\xfer\xc8\xb0^^\x04\xd7T)f\xc5\xdd\xde1\xf2\xd8\xbc\x83\xbd\xd3D\xec{W\x98\x14p\xe5\xa8\x9d5\x9f6\x0bA9\xa4\xect)cj\xe4.\xcdVr\xdf(\xd2\xb4\xabD\xe0$\xd1\xec\xdf\x00S\x86(:\xf40\xac\xac\x87\x81\xcb(] <\x9dv)\x02\xff\xb6\xb7\xa6\xe7\xa4T\xcb\xca\xe1\xb1\xb84\x15Sx \xfc\xa1\xff/>QL\xe9G%\xee\x97\xe6\xe0_=\xdcb\x17\xed\x01m\x86\xba\x8fv\xd1\xb1\xd0\x9a\xd7\x09\xa9\xf9\\\x85[\x08\xdc\x0d\xd0\xb3\x83l'\xbd\x98%\xcd\xfd\xefj\x0c\xb9\xfa\xaa\xb5F\xb1d\xcb\xff.5ry\x13(\xc1~6G\xdb\xc26h\xca\x90\xea\xeb[\xf39\xb1\xeas\xceslp\x92\xb9\xdc\xfe\x9bS&f\xe4\xb2\xaa\xa3\xfd\x90\x14\xb6\x11S\x91\x06S\xb3g\x8aR-Xi\x09\xbc\x9dB\xb6\x83>~\"\xf3Q\xca\x8cx\x95=17\x0a\xe4\x0d\x1c\x08\x8e\xd6M$*\xeeEg\xe8'\x0a*\x1a\xc5)\x06I\xa8\x8f\x07yi\xfc\x06\x00^\xa3\xca\x972\x82\xc4Q\xf0\xf8\x83\xb6\xc3\xb0\xcf\x1f4\xf1\xb2(Td\x88s\x0c\x1c\xb9>\xeb\xd0@M\xba&\xc5\x89zu64\x98\xb9X\xe8!!\x02\xba\xb253W\xd3=\x0e\xce\xc8\xabQ\xe9\xd0\xca\xda\x17\xb9yQ\x1b\x8eIm%\xb1\xd9I\"}\xc7\xae\xb8>-\xed\xa4\xdc(Rx\xde>\xf2\x08\xf2\xad9M&fN\xc4\xf2\xf8\x94\x14\x08\x17\x9e\xe9\xb2\xcb\xc6a\xe8\x11\xee\xde\xa2\x7f\xa8\x15\xfd\x8fk ?A\xc6\xf6\xaa\x8f\xc8!p\xb6\xf8\xd4\xdcZ/isn$\xd9x\xd5\xcf\xd9\xa0\x0d?\xe3\xa2Nyk\xf9\x0a\xablm\xcd\x9d_\x19\xb2\x11\x96\xc2{\x9a[\xe5\x91u\xf6\xab\xd9\xe19X\x0b\x94\xc93P{M\x17\x91^\xff-\xbc<\x0e\xd0*\xebi\xdb\xa7\xa9\\\xba\x90o#{\xd4\xe0\x8c$\xee7\xbe\xf4'\\\x03AuR\x0f\x97\xe4\xca\x88RoN:c\xd9)\xc9u\xf1j\xdf\xaak.\xa6\x9f\x9e\xde\x86o\xd8\xfc_\x7f\xe9\xb9\x0ev8I\xf1p\x1b\xd5\x0b\xba\xea\xe1\xeaY\xdfX\xb6\xfeO`\xa2\x91\"\xf9Z\x93i\xed\xcau\x88\x1em\xcbA}:\xe2nI]\xbaT\x04<\xef_\x03<\xd1i\xe0\x9a]a\xe8zdfO \x96\x990\xd6\x15\xb7\xa8\xef^\xfa\xe9^$K&\x09I\x03(\x99\xab\x9a\x1e\xb8\xf9,\xda\xf8@\x0e\xd3)\xc0D\xcfn\x9a\xd1\xdd\xb43o[r\xbd\xeca\x956\x96\xa2\x9aIiI\x85\xad\xfej+\xb9\x9e\xe2\x81\xe7\x88\xa3\x17\x99K8\xf2d]1`\xc6D\x0f\xeb\xcb:\x81h\x80\xf6\xf1\x98\xe6k(\x04\x11\x8e\xdc\x98&\xdbtb\x0a\xbcs)4\x8c\xdc\xc4\xa9\xd5\xc2\xeb\xdb\xbe9\x89\xee\xaa\xcaz\xb5\xb4Y\xcd\x07\xdaY\xf9w\xb8\"\xe3hc\x02vY\x82\xc8\x19\xf7^\xd2\x047\xca6\x06\xaa\xc9\xdb\x1d\x9b\xff\x8e\xfa\xa5b\xe9\xe6\xbd\xadbX\\\xc9h\x85\xea\x19\xb6\x81g*\x92\xf4?\x80\x97[\xf3%\xe2\x09[\xe8O7\xe9\xb3Me\x87\xe7\"w'K\xad\xcd\x19KU6\x817\xe7e\x15\xdc\xa2t\xf1\xb2\xd9\x088\xa6G\xc5Xz\x1cJW\xbc\xe8\xcb\xd8\x89`\xd078\xd4\xb5\x18:\xcbQ\x8b\x82WNv\xa1h\x82\xa3x=\xcf\xd8\x80\xb0\x03?\xd0\xcc[t\xc4G\x1f(\x1f\xa4\x0a\x02\xb9\xdc\xad\xb6\xda\x8e\xbb77\x19\xdc\x0b\xefJ}\xc4v5i\x9e\x04\x06_I\xb3\xc1$\x7f\xcd\x01\xe8Y\x07\x95L-\xa4\xef<\xbf\xc93\xbd\xf3o\x80\x03--\xf3\xb44n\xe8\x85\xf0s\xc3\x0a\x17\xddvO\xb0B\xf2\xda\xcf\xf2R\xd8\x05\x0bJ\xc8\x8e\x00\xc6\xb7\xb4\x94\xce\x1d\xe4\x93\xd2\xcb\xe3\xf2\xfc\x84\xf5\x9d\xbff\x8d\x13\xcc\xd9\xf5\xe8gb;\xcd\xd6\x84\xea-\xd8\xb8\xcd|q\xedWi\x05\xc7\x80\xefC%_\xdc\xf8k\x84\xa7\x09,b\x12\xad\x0c\xa2\xb3\x09C12\xf0k\x82\x9d\xde\xfbI\x0e\x8a\xb3\xc2x\xb9\xe0-Y\x93\xb4,\x012~c\x85\xbd\x8d\xb5\xea\xc9\xc4\x0a\xa5\xde'\x92T\xe5!n\xa7\x89'R\x01\x1f\xc4\x90\xef\x9fS\x83\x93v\x8e\x93\xe1\x1e\x87\xdd\xce\x1b/\x97NY\x07C\x12\xf9\xbb4\xeb\xe0\"\xa6\xde\xb5\xc0I-UGvO\x1e\x9b\x03%-\xae\xe4'\x9f\x8ca=\xadr\xefu\xe0pyY\xa2\xc4\x16\x06Y\x87\xf4\xae\xdf&F\x0f\xb8#\xf4\xc3\xd2y\xe6\x00\x9ap\x88\xb2b8%ka5\x9b\x81h\xf26B\x82\xc3+\xa5LZz\xa708\x1fD\xbb\xa6\xfcB\xaeV\xf7\xe7\xfb\x80\x8b@C\x0f\xf1\xe0\xd6p\x9b\xb7\xb3u4\xe0\xa4\xc9-q\xc4|\xc0[`\x09|WMdR\xa6tu\xb6\xe8*\xa1;\xf3\xea\xf4\xb4\xd3L\x9b_\xa5\xbb\x89\xfa 5\xedD\xd1\x8e\x18\xa0\x97\xd9\x89\x89\xce\x9a\x95*\x1d\xd4\x10\xd5\xe1'#j\xd8\xa4?J\xd7\xbd\x0c\xf8\xf4>\xd34\xa3,\xc5R\x99A\xb6jO\xf8\xaaa/P\xcbU\xb9\xfeZ2\x08\xac\xb8\xfc\x84-\xd0\xa12\x96B\x155C[*o\xeb\x03{\xd2\xedU\xa8\x90x\x91y\xfa@t\xd7\xd3YUv\xa1\x9d\x1a\xbe\xd7\x19\xf7\x81\xb0\xf0\xf6\xba\xb4qh\xf4\xde\xdd:\xda\xc5\xc9\x9b\xc7\xad\x8fo\xf5F\x97\xd6\xad,\xa4\x02\x0b\x82`\xfedp[\xf3Ki,A\x96\xc1<\xe5\xd6M4\xb5\xcdG\xed&w[\x0a\x13D\xa9\xf2\xbf\xf3%\xfa\x99\xd4\x95\xb9\x13\xe6\xd4\xc8\xf3\xf6vdV\x15\xbc\x03ar\xb7\x9a\xb4S\xcb\x95F\xd2\xd25}\x17\xdc+\x1a\x8b\x9aMa\x0d\x1d\x84@9q\x947\x07<\xe3\xd4\x9cg\x1e\x89g\xadj3B\xeeG\x09\"\xf9e\xf4\x813\xefS\x08\xe1\xe8j\xe4\xb9=\xd9\x97\xdcp\xb8\xea\xdf\x82k\xca \x1e\x9c\xe2y\xd71$_\xfd\xa8\x05\x84\xe6\xd0\xfc\x16\xc6\xc1\x03\x13\x99\xf6\xfa\x82\xcf\xa4nQ\xda\xbd\xef\x02\xd3\xbdf\xe0\xb0\x81 \x0c?\xa4\xfbf\x8c>\xc9\xe7GS\x0dt\x0a9\xb9\xcb\xac\xa0o2=[ijc\xc0\xf0\xb8\x9f\x7fw\x1e[*9kH}\xec\x12'/\xa4\x87\xe3\x09\xba$UP|\xd2 l\x00\xf2r\xe7\x8d\xf4\x8eY$\x07Hmg\xc6`\xca\x17\xda\x0c\xa4\x85\x1c\xff\xcb\xf4\xbf\xe9\xaf\xa6\x82\xa4\xd1\xe0\x1e\xa59\xe6\xc1M\xbe\x19\x9a\xfbJw\xe2\xcd+Ht\x17N\x13\xa1\x8c\xb2^\x06\xbc\xd3P\x0e\xcc\x09$\x06\xd1\xe4v\xca\x8am\x84\x1c\x7fl\x897\xa0j\x17\xfe\x0f5\xb1\x18\xe0\xf4:\xb7B\xa8P\x0a\xd5\xef\x82F\x8d\xb6J\xf8\xc2\xb0a\xb2\x82\xaa\xd1;\xe9t}\xd0\x13\x13\x80\x7fS\x04\xba\xe4\xc5\x1aK\x03o:]\x17\xae\xdbx\xdb\xce\xe5\xa8\x11\xbb\x9a1\xae\xcc\x1c\x1ci<\xa9\x91\x041\xb7N\xe8=K\x9c\xab\xe0ya\x00\xefz\x81\x83\x96\x0fr\xb0D\xb63t.u\xd0\xe6f\x05Fp\x18\x9e`e\xee:\xbc\x08\xdb\x9b\xfe\x09\x8f8|\x11\xeaw\xc5\xf3\x9e\x15U9\xb5\xe9o\xeeK\xa7^}o\xe286\x9d\xde\x18\x83\xbcKW<\xef\x80\xd6\xe4\x06A\x07\x97>X5\x1b\xff<\x18@\xb2\xc3\xbbv\xd5\xecb\x1apt\xec:xJ\x12T1\xdc\xc14Qq\xb3w\x0d\x08,\x14gw\"\xec%\x07\xfan|\x9e\xaa\xa8\x0fF\xa2]+s\xecL\xe1|\x84\xadE\x15\x8c?\xd3j\xc9\x80\xd3\xb3\xe6S?C\x7f\xe5\xbc\x9d\x8b^6\xf7\x8e\xa4\x8eo\xed:\xabHI,\xdd\xbe\x91\xef\xaf\x1c\xed\x9eS\x08\x09\xd5\xd31\x18\xf4\x97.;\x02n#\x83\x87\xbdRHu\xf0\x94\x84\xa1\xd1c\x9d\xc1tk\x08t\xd3\xad)aip\x17K#\x18Ue\xe9\x0e\xe4\xcd\xcc\x81-K\x8e\xe2\xc4\xc2\xfca7\xda\xf3\xc2t\x81\xa5\xa1\xd5\x85\xbd\xf6\xf1\x9d\xd8\x13\xc4\x84\xea\xbb\xe8-\xf1\x8e\x88Y\x9dsF=\xb0\xb7\x84]\xba\x13~R\xd0SNp{\x0a\x98)KyZ\x09\x08\xc4; \x91\x16\xaf\x803\xa3\x94\x05G1\xf8\x015\xa5=\xa2\xd25ow\"\x01\xbf\xc5\xf4\xe2%:2\xe1\xbd\xbef\x8fr(4Fcff.\xd1\xa5APwi\xce\x95\x1f90\xc0\x84\x9b7%\x1e\x05\xa1\xcdm\xcb\xd8\xa5\xb9\xaf\xb1v)B\xf1\xa4/QM\xee\xc9\xe3\xde\xc0c\x12%V|\xab \xb0\x0f4s\x96\x91\xb2:\x03\xfd\x17\xfa!\xcfC?\xe4S\xf3X\xee\x83\x84\xd3\xbf\x96\xfd\xc2JM\x94\xd6\xba\xb7\x96SM\xc2\xa7\xf29\xfd\xef{&d\xa9i\x04N\xb7\xfd\xeb\xcd\x91\xd2\xeb\x8f|\x8a\xd0\xd9\xbf\xd0\x9c\x97F\xd4C\xf3\x13ws\xb4\x05\xfa\x93\xe8\x93\xc8j\xffT\xe2\xb4\x17\xc4v\xcc\xe3C\xff\x91}\x1dtO~,\xde\xc8\x03<P\xf8\xc1\x85\x1d\x96=\xf0_\x90w7\x09\xec\x00x\xda\x84\x02\xa8\x9b\xfd`\xff\xa5%U\xf8\xa2\xe2\xd3>\x92\xc5s]\x8c8\xe7V4\x17y\xf2WB*\xe5\x80\xbd\x9f\x8209\x14.\xef\xb4a\xd2\x8f-$\x16\xbc\xfbB\x8d\x82(\x80\xaeei\xfe\xd4\xec\x0e\xe7\x06-\x15\xe5G\x00.us\x18\xea\xa2,O\xc2\xac\xeaC)\x04\xb2/\x8e\xb1\xbd96?\x8c\xff6W\x9a\xc1\x13P,\xb1e\xfe>n\xb1\xfbDJM?\xa2\x87\xbf\x0f\x1f\xb5\x0bj\xe7$'\xed\x99\xbfm+\x1ey\xff\xd9\x1c=\xc7F\xb7O3\xf4\xbe9\xd8kC\x9a\x89b\x03L!i\xf2\xd3\xeae`G\xe0\xbb\x08*8\x9fRg\xfb!\xa9\xc8-%\x01*e\xcb\xf7*:\xfa\xf8if\xb4\x15A\x86z\xbe:4{w\x0b\x07\xea\x01w\xb84z\x9e:\xf4d\xc4\x9d\x1b\x1fyt\x93\xad\x1f\xed\xb4A\x93X\xf3\xdd\xa2\x85>\x95\xef\x95\xf58r)f\x95\xc8\xcf\xb1x\x0f\xcd\x16\xff\x00Q\xe1N\xf2+\x98\xee\x94\x01\x00\xb4\xbcn\x1e\x87\xbab\x85=d\xab\x8e\x06&\xb2\x82\xb7\xd9:\xaf\x8d\x94\xb0\xd6\x94Jz\xba\xf6\xab}-\xc6\xd9\xe5Q\x93\xe3S\x98\xf07\x91x\xa8 \x04\xcc>\x04\xa6\xf9\xd4\x8b\xdc\xfa\xdb\xade\xe2\x87\xce.\xaa\x9bT\xbd\x17\xbf\xfd\x17M\xcd\\y\xd2\xf5/\xd5\xd9\xa2\x94S\xef\x1d\xb8\xc6X\x88\x9bNHQl\x0a\x94\x06Ks\x1d%\xbc\x10\xd8\x92tSy-Ia\xb6 \x9e\x82z\xc2\x0a\x9eu{_\xe6\xbb\x096\xe9\x09\xfc\x85\xc3\"\xdf2O\x94\x0e\x13u}\xaa\xea2\x87vx#\xcc\xa9\xb5%:QZ\xe6s\xee\xbd\xef7\xbe\xde\x15)\xbd\x11\x0aR\xed\x1a\xe2\x80=\x81\xadf*\x1e\xcc\x07H\x0b,\x03\xd7|\xb6\x0b\xdc\x9eg$\xe6\x03\x9b\x9d\xf7r\xe8U\xdc'C*\xa7G2\x8dN\x07\xa5\x00?\xe4\x9c\xc9R\xd6\x1b\x8c\x92\xad\x1e\xfa\x89\xf5\xb9\x95QZ\xceU\xe2\xe4\xdb8\xc4\x81P\x0c\xc6\xd4\x1fp\x0f\xab\xee\xbd\x08VL\xf6\x89\xd2\x8b\xb8\xe8\xde\xc9\\S\xf6\xd8\x0d\xef\x91\x03\xc5\xd6\xc1\xd7\xa3\x93~#_\x89\xbd\xec\xb9,\x08\xfb<\x11r\x16\xd6CT%\x0fk\x9br\xe7!\xe2\xfe\x1b\x1d9R*[h\xffX\x96\xe62I\x8aS\xb5(\xb2k\x0b\xa8j!X\xe4\xc2\xa0\x05\x917Mp\xd4y\xb6]\xb0\xf8+w\x8d\xce:\xce&\x15k\xc0.X6\x982\xec\xdbP\xe8\xd2f\x1e\xec\x84m\x8d\xec\xa2\xfc\x12\x84\xbfp\x0cY\x8a\x8a\xeeQb]~\xb6d\xd6~\x8du-\x17\xe8\x13\x8d\x18*H\xc6dF\x1a\xae\x91\xed\xb5\xa5\xc1\xbc)fZ\xc2{\xb7\xcf\xdb\x95\xc2M\xf8s,\xedo\xf8\xb6b[\xf2\xa1\xde\xa9\xb0\xd1\xa2\x9a\xe9O\xa5Z\xcb\xda\x1c\x13g\xc3\x87I\xcc\x0d\xb0\xe7\x86\xdf\xf5l\x8b\x09\xadVzzO\x8a%\x04\xda\x93\xce~\xf7f\xa4\x89\x83\xed\xcc\xd9\x97\xd2\xd8\\l\xb6\xaa\x1c\xad\xc9B\x0e\xd4\x123\xff.\xad%\xf9c\x8f\xac;*\x02?\xd8\x17\xcc\xec\xb7}e\x0d\xe0\xca\x08\xe8t\xe201\xdaUx\xd6)\x9b7}Rn\xf5\x1c[\xab\xe4O\x0e\xb7\x83\x8b\xe4[\xca\xd1j\xa99I\x17\xa7?U\xdc\xc3uiL\x0b\xb9[Zo\x96\x01\xa4\x875\xea\"\x06Z\xd5\xcd\x87~4m\xab\xf6>b\xe6\xd8\xddZ\x94\"?\xdf*\xfeA\xe1\xbfH\x0b\xc9mn\xc1\xfc*\x0d\x85\xf4\xe6\xc82\xee\x87\xf5\xf4+K\\\x12 \x08\x92\x16\x94d,\x85j\xae\x15|fl\x13\x0e\xf5\xe5\x85\xf2\\C\x0e\xffcB\xc5:\x80:\xf9\x17Q\xf8\xa7\x92h\xfbDO\xe5\xfc\xe2\xff\x1fv\x0f\xd6w\xa9\x82\x8e\x91r\xeam\\\xa2\x8fk\xb3\x15u\x13\xcd\x9b\xff\x08:q\x9b\x16L\x96\xb6\xa6\xa7n\x0e\x83\x1d\xeb\xcc\x01YeP\xb8\xcf\x18\x1e\xba\x16\xe7\xec\xe0\xc0:\xf5\x84\xde\xd4\xc1f\xf2\xdc4\xfb\xd5P\xd1T\xdf;v\x84\xae\x0b[ij\xe7Q\xeb'R,\x8a\xea\xb2\xbc\xc5xb*I\xb9)!\xda\xac\x9d\xf2\x80\xf1\x99\xa5-\xbc\xf2\x81\x1d\x1f\xd1l\x9b\x07~\xc7{\xf6P\x11\\\xbe\xc0e\x8e}5\xeb\"\xef\xd1yNHK\xd1\xc6Z C\xb1\xfdA\x04EUX-\xd6\xd2w\xd8uhk\x09\xe1\xe3\xa7\xact\x96j\x98\xa7\xb7J\xa9\xf5\xa9\xdf\xdc\xee\xe9\xa3\x85\xfb(\x9d\xae9Z*\xbc`\x05B\x14\x06\x16k\x8dwx\xb4\xb7\x928\x132\xae\xaa\x9d\x05\x06\xa3*\xecp?\xa1\xd2\xe0\xe8\xe5\\h\x9a\xf0\x9f@\xbd\x14\xd6\xf1\xad\xab\xde(\xa8\x9b\x97\x11\x81`\x8e\x13\x15}T\xf0y\xc5\x8c_>\xab&:\xed\xb5$\x19\x8e\xb42\x19\xc5\xe0\xd3\x88 \xd83\xec\xc5\x16\xafLRz*\x09\x9cN\xe9\xa5\xa24\x14M\xc6\x9f\xf7\x0b\x9d{\xea\x09O\xdb\xfb\xd2\xea\x8d\xdcH\xec\xb8\x81T\x87DW\x7f\xc8s\xce\xc9\xcd\x98\xd274O}\xa3'\x13~O\x05\x85\xe7\xe6\x17\x96\xc7\xae0\xd6\x17yt\xe7\xa8\xb9\x9b_\x80\x1d\x1dd\xc0\xdc\xb0RD\x81\xd8J\xfc\x1b\x15\x9a\x06\x001YA\xec\x86&\x0b\xad\xa3\xa8\xc4\xe9\xd8\xa6w\x9c%3.&h\xf9\xee(\x89TYF\xf8Q\"A>_\x85\xc0y\xd2V\xd9\xf1l\xe5\x18\xbb\xda\x1f\xff[\xdb~\xf3D!\x0c+\"\xcc\x81\x04\xe4\x8c\x16\xadI\x918Y\xb9\xf1\x91\xc5\xea\x87efE\x89\xe7\xd0\x02\xfaf\"\xd6\xa9\xcb\x1a1\xbe\x03\xe85$=\xbfK\xe3J\xbb\xb8|\x0b\xc0\x1b" +
+	"W\xf5\xc4z\xbc5O\xa5\xd7y>\xf6:\x13s~\x9a\x99\x07\xff:Q^\xd0\x8d\x8a\xf9$e\xbb \xee\xbb\xe1N\xffL\xd7\xdb-\xd4\xc1\\\xde\x82\xb6\xcaU\xb6a\x9d6q#\xaax&\x8a\x0c\xdf'\x19\xc3\x032\xd2\xb4\xb2\x06,\xc5?5\x06\xc3\xb5':\xf3ZJN\xacF\xed\xa50\x9e)\xf7P\x8f\xb0\x09x\xbf\xe5\xc1\xf7\xf1\xf9\xad\x14\x8ai9Ua\x86Q\x8b\xcf\xa9i\x12=\xb6]$K\xd5.\xb4\x9e\xa8\x80\xf0v\xe1\xc7F1l\x94kh\xefO!\"\x80U\xa4\x96\xab\x0c\xb2\xe3\x9b\xba\xfa\xe6g6\xbb\x8c\x1aa3\xf6F\xf9\x07\xc4\xbd\xc9\xf9!\x1a\xbf4^\xcd\x96\xdd;\xe3\xbc=\xcd\xb6\xa3\x07\xb49\xea\x98\xa5iJ]\x14*\x04$h\xdd\xf0|\x81\xe3\xec\xbd\x9d\x9d@\x81`\x19\x83ML\xfeQ\x8dw\x118\xd9\xf7db\xfe\xa3\x9f\xb53\xf7[k\x81\xc4\xd2\xb6\xa4wv\xd3\xf1\x86\x95\xe3\xca\x139\xf99\xc4\xd4\xde\xb3v\xd1\x99\xdb\x80=\xd4#\xb4\x0b=\xae:\x1b\x8ec1\xee\xcf~\xa30\x85-{\x1f$\xd0\xc6\xfeh\xc9\xf6;\xbd5\xd1\xed\xb0\x86\xdc\xf4\x86iS\xd2\x0e\x0b\xc6?\xfc\xf2+\xb97\xc0\xe7\xdc\x8e\xf6?\x15\xf6}G\xb9\x10\xf2\x0e1V\x9a\xd0\xef7\x8a\x0ck~\xbb\x00\xda0\x97\x8e\x03\xda\xf3\xe1:\xeb`\xd4\xb8{\xd51\xd1Ia>^\xa2}\xe7+\xd1\x1f\xb4\xb7s\x9a\x97\x17\xd1\x02\xf3>\xd9h\xfa\xc7\x07\xae\xcay\x06\xca\xddSR\x82\x8d\xad[\xb5\xd9\xfa\x94t\x80\xccVI\x8b\x85Dg\x96/\xcb\xde;=t\x0d\xc1\x88\xbay\x1dd\x96\x086\x943\x08\x19\x14\xd7\xe1\xc5\xd7\x08\x82]H\xa8\x86\x90\xaa\x12\xfa\xb9\xf5\xc50\x84\xcf\x1c0\xd09\xb4\x15X\xd4\x97\xcc\xb2\xa7\xd3Z\xfa\xaa\xf1\xf4si}\x06\xad3\xee\x85n\xe00\xccK\x81i\xd8\xd6\xd2\x85\x01\x91\x09\x86\x9a\xbc\x90\x8d\xf3<\xa7\xdc\xd5@\x8d&@\xb8 DJ\xfbq!<\xb8\x0c\x14\xd2\xac\xf4\x17\xe3\x1a\xf9\x1c\xed=\x83\x1c\x11@\xab2\xd9\xb1\xf0$2d\x97>Q\xadDz\xfe9\xf9\x02\x0c\xe0\x01mL:>\xdd.\x96\xcb\x8b\xf1\x91\xcc\xda\xba\x9dj\xdeu\x99\xfe\xee\x1f=O\xf88\x83\xba\xf6\x18\x03l[\xb5\x04\xe6s\\\x9dS|\xf2\xdcl(\x0a\xf9\xae\xb5\x08z\xeb\xcd;\xff\x8e2\xdf\xe4I\xa6\xd2\x0e7\x85\xdd7\x1f\xf8\xec\xbdT\x87\xbe\xee\xa1\xc5'\xb8\xd7\xc1\xe5\xa4l\xc6R\xf1b\xa6=\x06x\xd5\xe4\x09L\xb83m\xae\x82@\x0cz\"$\x8b\xe4\xa8\x1d\xaaL\xf6Bs\x0a\xb5\x93qaUL\x012Z\x95\xdf\x1f\xa0\x17\xb7.-G\x12\xbe/M\xeb\x9bL\x07\xcbq\xf7\xa8\xab\xeds\x85sN0\xb5s!\xa0\x9f+p\xcb\xaa<\"\xbd\x15\x82\x94\xd9\x84^=\xfd\xf0\x8d\xb9\xcc\x0d\x08Y\x82\xe4\x04\xea\x88\xe2\xd4q\xd3\xb6\x7f\xeed\xd3W\xb6\x93\xb9\x01\xd5?Js\xf7.2\xd2\x0c\xe1\x04\x8c\xb3\xc4\xb6t\xaa\x0a2\x03D\xa9\x97=@\xd1\xff<cf-\x1b\xa4\xc7\x0e\xe3Hn\xe3\x04\xa0\xfa~\x02\xe9\x1c\x19\xae\xb8\xca\xa3\x9b\xf0A\x07cA\xd6\xebQxH\x1c\xba2\xa3S\x7f,Bi\xae\xce\xff)1\xa2J\xaa\"[\xa3JB/\xacc \x88O\xa7C\xeb\x00(\x9c\x97@ex\xc7\x99\x8a\x14\xab4\xcfy\x9d\xaf\xa6S\xd4\xf9)h\x0aI\x87\x9b\xfdFGh\x15$\xec\x99\xb8\x1b\xdde\x85\xa1\xb8\x08Y\x97G\x9fG\xef\xbf\x05\x0f\x0b\x93\xf7EO\x16\x83\x90\x85+7\x90c\xf5\xd6K\xaa\xfe\xd0\xd3\x192I\xeb\x13\xc9\xb0{az*\xd5,\xafb@\xd8\xc3\xb4\x95f\x88\xdf\xbb\xf9\x00\xc6-(\xd0\xc1\xabvL\x8d\xf9\xcf\xc4B)\x82\xdf\xf2L/>\x87V\xe9\x91b,9\xb2!\xf3\xca\x14?\xf7\xa5Wt\x95\x09f$\xaf\xc7\xea\xb9\xdd$,\x83\x81\x0f*\xea\x00\x187\xc1\xe5\x03\xc9W\x80]\x08\xe3\x97\xa8\x97\xb9\xe3\xd4\xf6r\xc9\xebX\xbe\xd7k\xefy\x89R\x93\xd7\xd5\xb3.\x96Y\xd6\xf6\xf0\xcf\xcc\x0eM\x14\xcd\xd6\xc3\x14\x1eKww\xd3kmb\x02\xbb\xd8\xd7J\xbe\x89\x18\x9bv\x9e\x0d\xcd\xa2]\x95,\xebm\xffUWd\xc6\xfc\xc6\xde\xc9\x95c\xb9\xdf#(\x91\x0d\x1cy9\xfcl\xde\xd6\x12\x9b\xeb+\x15N]\xa8\x89\xa8zx\xdf$\xb5t\x9e\xf6\xfc\xbd\x16\xfb\xa1\xaf\xee\x82\xa9\xe0\xb66Y\xed\xf4\xa74p\xfc\x14\x0a\xd1\xce5\xbdM*e\xd7\xaf\x0b\xd9I\xf1\x94\x81E\xf9!\x86\x8a<\x18\xfe\x98\x87\x0c1\x93U\x1b}\xa0\xe1+\xf0=w7m+\xf4[\xf4;+\xd7g\xd9\xbdu\xf0S5\xcd\x91\xc7(\x9d\xe3\xc4\x85\xc4\x7f\x0a\xc7\xe9\xe7z$\xff2\xab\xa4\x9b0\xc3\xb6\xe8,\\\x9f\xff\x03\x88\x97&e\xb9\xfd+\xe7\x82\xd5\x9f(mwU:\xe9\xd1<\x1a\xa6>\x19$M)\xd7\xba\x05i\xd0\x97Puy\xd5\x0fH\x1f9\xaf8e\xd8~\x02\x96\x93\xbf\xe0O\x8a\x16\x9a\xe7\xf2\x8d]\xfa&\x92\\\xea&\xaf\xb0\xf7\xf9\xe4\x85u\x86>\xc5\xa5i;\x97*m\xd7W'\x0c\xcd\x1b2\xcd\x92C\xeb\xbf\x0f\x14!X\x90\x89\xcb\x98z\xd4\x96\x84F<\xd9q\xd5\xd6i+jg\xeeY\x9b\xbf\xf8\xca\xa9o\xed\x18\x08\xdcq\x19\x137yh^\xb0\xba\xc2\xe3sT\xac\xbc=T\xf7N\xaf\xa0\x8ct\xbe\xbe.r\xc9\xa6\xfaB\xcd\x99'\xf6\x85h\x1cw\xfe\x13:q\xed\x90\xda\x19\x95\xe8| Ct}\x13\xb2\xdbc\xe9e\xd0\xf2\xa5g\xa30le\x04\x99\xf4\xe9ks\xa4\x18\xc6\xbd\x1b\xc5Z\xa4\x95\xa6\xae\x00\x1c\x0ftg\x89\x95\xfc\xaa\xd0\xc2a\xff\xe0\xfa6\x87\xf23~Q\xd3\x02aK\xc9\xb4\x8e\x85>\xa3\xc4U\x13\xb8\xebz7\xefY\x11\x0c\x93\x1e\x9bI8\x1dt9\xddD\xf2\xa5v4;\x0b\x92*_\xbak\xc0\x1c\xb7\x909tM\x0f#\x027\x18\x9c\x17\x13YW\xb0\xa6\xff\xc6MqE\xdc\x94p\xacg!r=\x8d\xa3H\x88Q_\x01'\"\x9b>\x87o\xcd\x02l\xb2\xb1\xbf\xa9\x97az\xbe\xee\x80\xccgf\xbcIiW\xb89\xef\xb2\x03\xefb\x09n\xe5\xe9t\x97\xcac.8\x88#\x83-\xc1a\x0f\xa1\xe0~/*&\xba\xea\xb4Td`R|/\"\xc5\xfe\xc7\xb5%^\x0b7\xd3\xde\x0b\x1b\x14\x80\xcf\xdfx\x0e\x1a\xa9\xb3\x12\xc5\x05\xa2\\\x15S\xe9(\xcb\xe7\xae_@1\x96\x07\xf2b\x1c\xae\xff\x93Q\x92pR\xa6^\x92\xcah\x84\xb2D\xf7\xaeo\xeb\x0d\xdb\x88f=\x18 \xf3B\x9b\xf1VR\xa9\x15\x8b\x86\xc6\xebh6\x84\xa6\xd9\xd6\x1c\x7f\xfe\xb5\x83\x1c8\x8d\x0bhwT\x9c\x8dM\xb7\xfb3\xf9F\xa2\x08\x94\xfe\x7fk\x85\x90\xcfb\xb1\xd0\xb1\x00\x09z\xb8SW '\x04_\xfc\x93d\xef\xcdU\x9f\x85\xfah>9\x0e\xb2\xc4\xabS\x09\xa6h\xdf\xe9\x1df\xad\x16\x7f\xe4o9.\x9f\x7f\xa4\x02\xe0\x8a\xa0\xb8&\xd3|\x8f\xa5\x83on\xca\x13\xb1'\xe8\xdc\x00\x16b\xc2=N'\xb9\xb2\x0e\xf6\xd0\xd9^\xa1*\xe3\x83/\xa9\xfe\xbb\xaaV;\xe5\xca\xd4(s\xcf#\x13\xe8\x84\\\xa6\xcd\x02p\x00 \x89\xb7\xd2\xf9L\x86\xc2\xf7,#I\x11p\x03\x06\xa4U[H\xa0\xa2\xc7\x09\xbdw4a\xabO\x10\xd3\xd8t%8vj<\xa4a\xb0\xce\xa4\xb4\xdb*vO\xd2)+k\xdeb\xf9\x0c\xc6xZG\x07\x8f&'k1\x9a\xef\xa8\xa2Q\xaf\x98\xcd\x00\xccB\xb2r\xb4\xe1\xffQ1b\xd3\x1c\x1d\x82\xd1\x83\x14\xd0\xf2\xcf\x0dL<f\xcb\xb4\xf3w@\xdb\xee\xd3\xb0\x9b\x14z\xec.|\x8f\x9drm\xa4\xb9P\xee\xb5c=.\x9a6\x93\xce\xef\xfe\xf5\x86\xb9\x10t\xebt\xc1p\xee\xb4;:\xd5\xa0G\xd5\x01\xab\xfc\\V\xd8\xa1\x06\xa4Q6\xef\xf2\x99\xda\xb9\x7f\x97\xd2c\\\xa0\x02\x1d<\x0dv\xb4\xc7\xc8\x1f4\xa2\x91'n\xbcrn\xb2\xc4*N\"i\x87\x092:\x00\xb01E\x13\x0f\xa9R\x8e(\x86\x86\xee\xbe\xc8\xb0\x97\x91V\xa0\x1f\xa3\xf9Ms\xb09`Y\x88\xab\xe6\xf3.F\x17.N\xc5p\x8fOw|\xc8\x07\xdf\xc0\xaf\x11\x13\x0c\xf5z\xa6\x1a\xda\x94\xe9'\x06'\x02\x92zR\x19\x15v\xd2\xe1\x17p\x96I\xa2\xc4\x87T\xfa\x981\xd9\xb9\xe8J\xf1/\x94\xbe\x9a,\xee\xdb$\x03\x97\x8e0\x02\x9cw\x19\x9e3\xcd\xb2\\\x9d\xe1\x0dm&J\xd3\xf4Y\xeax\xb9\x88\xca\x80\x01\xeb)\xfd\x88\x9cR\xa6\xd7q@\xdd\xb6\x1e\x91\x86\xc3\xd9\xe2M\xea\xf9\xb4\xab\xb1\x9df\x87\xd87\xda\xe5\x98\x1f\xa2\xdf$<\x95\xe88O\xc1\x83\xea\xce\x83\xef\x80\xd3XH\x7f\xa2\xd7\xf6Ck\xd2\x1c\x89\xb9\xbf\x11\xc2O\xb1\xc3\x01V\xe6\xa24\xca\xdb9\xfd\x91b^\xee,\xf2\xb3\x1a\xc7+7&\xf2*\x9as\xa0\\\x8d\x08\xd4\xc5\x93\x14\xba\x1cv\xe8\xba+\x9d\xecfh\xee\x00\x01\x8f[L\x1d-\xc7\x09(\xfb\x93Z\x8a\xa9LJ?\xe4R%Z\xda\xfb\x091\xf8OOP\xa0k+\xdf\xa6RN 5[&\xc1JF\x86\x82xO\xf0\x98'u\x02'\xf5\x0a\xde\xf3V\x85\x89\xe1\xda\x8e\xe9\xd0M\xfd|P\x9c\xe4\x16\xdaJ\x88\xb5$\x94{\xd6\x14 \x98\x97\x9b\xad\x1c\xefd\x82\xc8\x12\xcd<\xb2\x82q[\xda\x18\xaez\x9e\xc3\xad\x05\x15\x9a;\xf0v\x9f\xc5w\xa2e\\5\x87\xb4\xbak\x11\x05\xdf\xa1\xf3TB\xb8\xbd\xd0v\xa7L\xdcold\x7f\xb7\xb3\xe2k:\xcf\xb9\x07\xdcSu\xb0v\xd49\x88\xa7Z2Y'\x1d\xbb\xe8\xeb~0\x0cT?,\xf1\x9f\x8d\xd1y\xc6\x8e\xa8\xcd\x0a\xcem\xd34\xdb\xd3\x90^\xd8Ew]\x84\xca\xa31\x812i\x92p\xdaO\x85^\xbbvd\x9a\xc1g\xcf\xb3\x0c\x86\xad{p\xd0\xd6\x8c\xa5xWV\x0e\xf3\xacR\xf7\x86C[\xa4\xb7S\x8a\xa0\xe1(\xe7<\xfcC\x0a\xc8\xd2\x0b\xa6]#\xbbv*\xfd\xaf\xe67#\xb8}\xf7\x8a\xf4L\xe7\xae\xf9a\x8b\xed\xe8\xba\x8e\xae\xbc\xd9\xf2Mp-\x19\x18m\xb3\xdak\xc1!\x8d7= T0\x1d\xdfv\xeeV\xee\xf7\\\xf4\xbb\xd9\xba\xba[\x89)\xe3\xfc\xba\x9f(C\xab\x15\xb5v\xde\x0a\xd4gD\xe5n\xdaK\x06,y30z\x869\xbb\x15\x86uI@\xd9\xaf\xadK\xfdQR\xc1F:L>\xeaA\xb0\x1eK\xb2\x12\xf4\xd9\x9bf\xd8t\xfd\xf9\xeet&so~\x00'\xe8\xc4}\x0d \xae\xdcJ\xb1cL\xbaXT\xe1\xa7\x92\x99\x9c\x1c\xf8\xcff\xcb\x8c|m\xed\xa7\xa8\xc3\xef9\xaeE&\xa0\x1dU\xf6\xd3!@\x12\xa7\xd2\xfe\x0d\xd7d\x97\xe6Z\xcc\x1cNe\x0c$\xf9J.\xc1~\x92\xa5\xa0 \x9f\xb7\x89\x9b\x94'\xdb\x1d&\xf6\x0a\xfa~\xf7:{t\xeb\xe3\x04H\xfaCX\xa7\x90\x06(\xb0\x15\xf2\xb1\xe3\xf2}\xca#\xa4\xde\x85\xf6\x81\xaa\xac\x8blp\xe9\xaa\xbb\xf2I\xcf\xa2\xe3\x11vG\xe3W`p\x13O\xbeA9\xe4\x8bs\xbf\x16\xbcy\x9dJ^\x91\x03u/\x16%\xeb\xe3\x7fgR\x83D^\xc7\xa4F\xe3yRu\x9a\xdd\x0e\xd8Dk\x1a\xaeD{\xb9\x07\xe8H\xd5\x93\xe6{\xd6u|\xa2\xa5^\x0b\x0b\xea\"\xdb4y\x14\xd1\x97\xf3.\x1d\xe2\x0b\xedn*\xcb\xee_v\xf4\x8e\x80\xbb\x063\x18\xddD\xb3\xf1\xb7a\xde\xed\x83\x10X=\xd3\xca<\x8c\xaa\xf3Q\"\xee\xc8\xdc \xe9u\x87\xf3,\xe8\x92L\xbf\xcb\xba\xfb\x07M\xaf:\xbf_\x7fIeF\xc2\xed\x8e\xa1\xe0W\xb0\x84\xa43a\xff\x9c\x9f\xc2\x06uq\x93\xef@\x06;z\xf2\xa9\xfa\xf0B\x97^n\xf0\x09\x9fU\xc2\xb6\xf2D\x8b\x0a\x14t\x04\x01=\x09\xac\xdd\xb7\xf7\xb1\x92\x7f\xfb5\x98>\x86\xf9\xbe4\xdb\xcee\xb9\"8B\xc9zq\xf7\xaf\xec\x89\x97_\xf2\xd3\xb06\xf0\xbd\x14\xd7\xe7h\xcc\xc5\xad\x81\xeb\xb5\xa8\x89u\x16\x8c\x8a!P95W\x930\x97\xe7\xb5\xea\xc8\x1d\xfcn~ \xf4\x98\xc2\xa4q\x15\xe7tk\x7fy\xfe\x87\xa6\xb2\x1a7\x96\x0e\xe6\xbb\x10\xed\xc4\xad\xe2\xa1\xb8\xf6I]<\xe2]/LS\x8e\x80\xeb\xe3\xfap\xb8Yg\x88\xfd?w<\x83\xd8\xb6d\xbe\xc6w\xb5\xd5\xed\xca\xbf\xef\x15\xab\xc5\xa4'6\xd1|\x85\xb1\x1f\x9b\x9fX\xb6\x1d%\x05j\xfdlk0%\xb9\xd7 H\xe6q\xd9\xd7\xcb\xab\xf2\x839\x151\x1a\x1c\x0a%\x9d\x9cX\x06(\xaa\xac\xf47\x04\xcd\xbc\x0cY:\xa0\xa4\x95\xc6\xb7\xa2\xfd\xe3\x1bFd\xe3\x05\xca;\\\xbd\xf7\x0a\x88\xa5i6*\xfe\x16\x94c\xd24\xfb\x8fh\xc3\x93a\xe6\xe48\x99f<\x8el_\xb2a\x06\x99\x88Qh\xed\xd4\xde\xae%X\xf4\xe6]\x1dg\xc5\\L\xc3Zk\x83*o\x8cN\xd9qP}\xaa\xae\xae\xd7V\x0b\xba\xba\xe0\xd5 \xcc\x1d\x12\x8akl\xe3\xccD\\5\x7f\xb7\x95\xf0V\x1b{!\x17\xfe+\xe2\x8f\xc1\xa6\xa0\x09\xafj@\x0d\xe1j\x80\xb6\x0e\xb3q\x12Z\x9a\\G\xb8JpyP\x9d\x02\xeaT\x0f\xba\x8b\x0dP4\xe9\x08\x9e\xf6\xbf%R.\xfbO\x0e\xaa:=\x81F9\xf1k\xc2{`\xba\xdfWA\x8em&\\)\xe2\xcc\xf5\xa1X\xbcr\xe8\xd2)\x11~\x9b7\xf5;]S\xf6\x80\x91 \xef*\xfa OdcE\xda\x92\xf7\xc7\x14\x1dX\xb1_\xc2\x0a\xa6\xde\xd8\x16\xf7J\x03\\\"\x19\x9b\x0c\xc3\xc4\xd1\xdf\xe6\x14\xdf\xa8b\x89\xee8\xb7\xaa\x0c\x8b\xa8u\xfa\xfds!\xf2\xefA\xcctP\x9bp 1o\xc6\xd1\x9dn\xd8\x14_\xdd\x0d\x9eN\xcf\xa2\x8f{hg\x85S]\x7f\xe9?U\xdd\xfc\x079S\xde\x9b\xa4\xf2\x86x\xcb#\x80\x1f\xb9\xceh\xca\xea\xba\xc0\xb5\xaeP&\xb6v\xb8BW\x078\x89\xb2}\xe5T\x0c\xdf\xc8\x14\xdd\x8f\x8b\xa1\x09\x8e\x9d\xec\xc4\x94\xb59\xcd\xffOf\x92\x8a\"\x1b\xd6\xe0\x17\x03\xaa6\x93e\xbc\"\x95g\x18\xee_C\x9ab\x17Q=6\x8c\xa5[_\x91\xf2Z\xe9J(c\xc1\xab\xc3\x83%\x94\xf8*\xea:\x0e\xc0\x9b.\xfb\xf9\xc4\xe1\xe2\xf1\x9e\xc1\xd1T\xed5:\x95$B9\xbf\x17`\x8e[\x17W\x94\xe1\xfa$V\xe4\xa3%\xd6\xe4T\xfb\x83#&\xb4>\x9cA)\xe7\xa0\x9a\xb6w\xdaH&\x8e\x9d\x83}f\xd2=\xc5\xd1\x8e\x13\xdbKA\xe5Vj\xfb\xcbv\xd6|\x94.{\xe3\x9cG\xf3<\xb4k>\xcd(z\x8d\xf4\x00+\xa0\x9dG$\xd1|\xe3\xd3>v\xa1rs\xff\x1a5#\x11\x15\xf6\xb0\xaa\xe4\x12N\xa2\xe4\xf0X\x8a\xcf\x01\xdcX_y\x81\xbe\xf9\x83\xf0*\xa8\x03*\xc26\xa7I\xa4\x06\x08\xdbb\x12d\xc6}\xd6\x90\x16\x80{\x9e\xb0\xa0R\x8c\xdd\xfc\xb0\xd6R\xfe|\xc6\xdd\xa1\xd5\xb8j\x1a\xe3\x82v(\xb48\xb5\xcf\x15\x98T\xach\xfc\xd6\xec\xa5\x01\xbf\xa8\xd7\xe9X\xa5\xc0\xd5\xb4\x0d\xd2\xbcA\x04\xde\x0f\x98S\xbe>E\xf4\xfa\xe6<\x8c\x1dE%kY\xfb\xcf  \xa1*\x1d\xac\xaa\xe2'\x9f\x8d\xe4\xfdO\x90\xedA|M1\x98\x02\xc8\xf2\xd6\xfc\xc4\x96\x8d\x9b\xcbx\xc2\xb0}\xe61\x86\xbcq\xa5\x12<\x9al\x03_\x8d\x89\xb5\x8b\xa1\xee\xcdU\xfe\xc6\x19#\xc1\xaf\x84\xe2\x80\x8e\x1cO\xbb\xf0\x82<\xf3v.=\xc8d\x80?)\xbd\xa5\x05~\x88J\x14\xa7\xdcu_e\x83Mh\x9f\xdddn/\x8dXLP\xc4\xb6m\x8d5\x13_\xf1\xa1\xb8u\xde\xdc\xf4\xdbphB\x04^U\xbe\x8f\xf4?\xf2\xb8\x1d\xd9\x0fs\x13G\x83N\xd3\x1c\xa1\xd9\xbcy+]\xff\xb6\x0cWF\x84\x1e\xe8P\xde\xa4\x99:\xef\xa8\xc4\x07\xed\xb4\xf9\x06\xff\x98\xd3\x14\xfa\xc6\x86\xd9\x03n\xee\x8aw\xf1\xd2\x1c\x92\x1d\xe9\xe9x\x96\xfeC\xa0\xce\x9e\x9a\xf7\xe2\xe9o\xbf\x94N\xaf\xcc\xb0\xc0\x8f\xb75\xbc\x12d\xb9\x01\xf3m6\xd6\xa8\xd9#\x99\x7f\xaan\xa6\xc9\x84\xb1\xd3\xe0Tp\xad\x1d\xc4\xa5V\xd9\xf0WcB^>\xcc\xf5\xf9\xc0eP\x09+\xe6\x03M\"\xc5rj\xd5\x98\\\x17\x16\x09\xe7\xfe\x96.Efb\xcfKK\xcbz\x0c\x9aFX.]K$cB*3\xb6\xe59\xf1\xae{\xa4\xc1\x16\x99\xd4\x80\xb7\xf2\xb8\xca\xd2\x0b6\xb0F\\5\"\xb2\xd5\xbc\x85MOR\x83\x82\xe3U!\xa5!e\xb8\xebx\xab\xd2\xa5\xd7\xdd\xa6\xf0\x01\xedr I\x16" +
+	"L\xc6\xa1y\x1f\xd3?\xbc\xbd\xf9\xc3[.\x07\xa9\xb7\xb7=7\xbcU\xbeM2\x04\x94\x99\xf5L\xc9XQ\xc9}\x94\xfd\x8b\xf7\x88G\xac\x0f-\x81\xfd\xd1{\xb8;\xe1\xa1\x98\xc8\xfc+\xb6\xd8\xa7\xe2{0\xa1\xaa\xc2\x8e\xef\\\x16-\xb1Q\xf3\xfe@\x82\xd6\xc9B\xf1^\x90\xeax/9\x13\x04\xc5\x1f\x06\x0c\x91l\xd9Q\x0eP52)\x080\xc3\x9e\xca(\xad4\x9f\xc1j\x1f\x152>Y\xda\x90=`\xfa\xc4\xa9\x99\x05\xb2\x0f\xde\x90>\x8a\x8d\x0bNQ\x8a\x05/&A\xd3\x9f\x9bi\x09\xe6*%c\x1c\xf8\xd5\x9d\x7f(RujRV\xf1{\x90-\x82\xbco\x11\x83i\xeb\xc1\xda\x82)G\xd1Ilzsf\xcb\x97RO\xcfR\x07\x15\xa8\x08\x9aY#\xcf\x9e\xeeq\xa6\xe4\xef\x9a\xb1\x1e\x03\xd18\xed\xa7\x8d\x10\x9al\x8di`\xd5\xd6\x19l\xea\x8d\x80\xed\xdf\xb5\xe6\x91u\xbe\x0c\xbf\xc7\x9b\xfe\xca*\x99w\xe2\xfb\x9bIh\xda.\x9aW3AN\x09rP\x89\xa9\xad\xe2\xc9\xb4rK\x1bjrxF\x15\x95\xb2\x90U\xd2\xe6)\xbf\xe6\xe5x\xaaf\xa7\x9b\"\xec\xe2\xa0\xc4V\x90Q\x92f\xbe\xb5h\xecZ\xaaP\x9b{h\xd6\xcd\xd8\xa6i\x8d\x9d\x13o\xc8\xfa\x91y\x03Q\xa1\xd5\x11\xb0\xd9\x01\xf8\xa5\x19\x9b\xd7\xe6Y\x9cm\x8e\x87\x85\xe1}\\\x9dIK\xf5\x90\x90\xf3\xe4\x13\x0cN0\xbd\xc27AoE:_4)\xab\xfd\xdd\xd1q\x7f\xb5\xf3\x13\x84\x9a\x18\xc7\xfa#\xcd\x16\x80\xb8*\x0a:\xe8\x02\x05\x10k\xbb\x18\xc4\xce{U\xa6\x8a3n\xedt\xe4\xe4\x1e\xf8\x00mm\xc9\xdf{M\xf8\xda)z\x8dIC\x99\x99\xa0\x96-P\xc8P\xff\x8f=\x88t\xdbd\xf2Z\xb3\x11e\xa2n\xab#XEN\xcf[0\xb3\xc9n\xb0\x14\xe8$\x90\xbc\xb3\xa7\x94I\xbf\xa9\xa6\xb1\x0b\x9aO\xef\xc9\xe4\xe5N\xa0\xd5P~\x0e\xd4P\x9c@\x92]CX\x9e\x96\x81g\x8c\xa9\xeb\xd5\x09T\xf9\x8eaM\xfe \x97\xc4\xfe.\xbd\xedo9\xa3.\xdb\xf4v6x\xb6\xbb\\rh&\\\x0fD\xbb\xab\xcc\xa8\";\x15\xb3\x19\xfa\x8c\x7fK\xcej\x9a$\xa5\xd2\xaf'Z\x1cj6\x16\x94\xf6\xa2OF$g\x9d.R;\x15_\x81%\x8eV\xc0\x92>\x8bx'\x1bFZ\xe8\xf4\xee\x16\x92|\xa8}\x89\xdf\xc0\x1f)\xc2|jg\xe3jIR !\xfe\x11W\x02\x96\xe4\x1d;\x1a\x1f\xcd\xd6/\xbc\x15\xfaLTJ-Q\xdd\xbd\x16/\x96\x05&\x13\xa1e\xdb6?\x9c\xfe\x8b\xb9\xebi\xf64\xfb\xc9\x00\xf1\xda\xd9\xd9E\xcf\xfc\xc7)\xf1>\x94,g\xddV\xe2\x03\xe1C\xa9+\xaeU\x0f\x05s\xa0p\xa1\xcb|^>\xb4K\x7f\xf3\x92`v\xd1\xed\xbc\xad0L3\xa8_\x9e\xe4\xde\x9e\x9dy\xdb1EQ\x05?42\xce \x81C\xd3\xf7\x87N>co\xac}\x9euxo4\x98t\xf4\xdc\x02\xb1\xda\x02X6u\x89\xbb\x92\xb9\x88\xa0{\x82\x94\x01\xaa\x9c\xb3\x80\xaafz\xcf\xd4C\x0cipGi\xc0\x99o\xc6\x97\xb3\x9bK\x1c\x7f\x0e\x82\xa1\x04\xa0\x0b&\x89\x10\xe9\x8c@\xe9*\xe6\x00\xfb\xa1\xef\xd8\x11^\xae\xd2@\xdd\xf6\xfaOj\x15\xd4\x1c\x9ch\x0a\x0a\xddG\x97\xa1\xfb\x880\x88\xe5\xbe\xa3d\x0b\x90a\x0b\x12\x06\x02fL\xe9H\xfb\xc0\x1f\xe3\"\xe1>\xb5\x83\xc0\xf0\xe8\xa2\xd9\xffI\x1f\xd3+$c\xb8\x96j\x1f0y\xae\xd0\xf2#\xfc1VR\xb6t\x9cL\"\xb7\x18\x07\xd3\x89d\xee#e'\x88t\xa1\xfe\\[C\x06\x16~F\xa0\xe1\x97l\x9e\xd5\xf4\x94\xdf\xd6J s\xb32\xaagM\xc5\xc9u\x99\xeaZ\xbbI\xc6\xa5\x9c/\xfa\x06\xca\xa9\xa3\xcc\x9a'Y\x80n\x18\xa5\xb9\x89b\xae\xce\xb06\xdf\xe3\x09\x94\xb6VO\x12O0\x81\xf4-6\x9c\xbc\x9d|\xf8\xf6/K\x15\xc8\x03\x8c\xa01l~\xf0\xcc\x13\xf2\xe8\x85\xd2sc\xad\xa7ma+bt\xd2d\xe8\xe7\x87H\x15\xa0\x81\x8b\xdehg2 \xdb\xa4\x8d\xc4\xd0\xb6\x92\xb8\x95\x12\x97*z\xdd\xf5ki\xcb(\x97\x81\xf3\xae\xb3\xd0\x06\xbd\x112g\xaf\xae%D0*z]9\xefic\xf4\xcdS4+\x0c\x90K\x8c\x8er\xec}K\x0f\x97\xfeS\x93c\xeaGb\xaf\xaae]\xff:\x0e\x95\x06d\xfcbl$7\xdaE\xa1\xcb\x89\xccm\x94\x9a\xdf\x97+\x17\xd9\xd3$W>\x95&\xbe+\xc3\xfc\x11\xb65\xe7\xc7\x95\x95\xeb\xda\xb76.aS\x8f\xb5\xd5\xd1\xdesh\xd6\xa6i\x1b\xcb\xa2\xe0\x0c\x91\xa5\xfd\xe7\xcf=r'w\xc3\xcf\xdd\xfcN\xaa\xf7\xfd\xac\xc0}\xcf=2\xcag_by\x85\xde\xb84*d\xd7\xe0N_\x11\xf8_u)|v,\x9b2\xc0\xcavVV\x1cF\xa1\xd0\x11\xbe?\xa4hh\xd2\x1c\xc9\xe7\xfc\xee(~\xd9\xa6\x0b\xda\x84\xf4\x17\x0f\xa1\xe7h>\xc9\x18\xddg\x9c\xb6\xc3s-\x9aC\xf3\xcd\x9cdN\xd2\x11\x04\xe0-H\x0f>\xc0\x10UVr\xd8I?1*\xc2+w\x16\x8c\x95\xcc\x93rR\xd6\xb9\xc12\xe4\x90Zx\x03\xf52\x1c\xffI\xf3K\x06\xd7\xf5\x10\x1b5\xc1\x7f\x10p`\xbaEAd\xa3\xa1u.\xb1\xa6\xd5\xa7HQmG{\x18\xed\xee\xdc\xab\xd1UV6\xc6\x0f\xa0C\xcbm\xb7\xe4\x07\xf5\xb8(\x1f\xedU\xd0\xcc\xdc\x0e\xe7Y\xcf.\xff\x9b\xe9;*R\x85n\xf9Y\x94\xa1N>\xc3\xcf@\xf9r\xca+w\x1bCz\xd3;\xce;.y$)\xa7'\"\xbc\xd0\xd2w%\xf5tM\xba\xb7\x95#ER\x1e\x95Y\x87G\xc5\xad\x0d\xa6\xb8\xaa%\xd8\xa7U\x91\x8d\xf6\xd0}_\xf7\x0cp\xdfdEnHo\xae6\xee\xd6\xa5\x92:/%X\xb7\xce%KF\xd3&\x8b\xcf\xb6\x84T\x842n\xf9\xce<GD:,\xd2F\xae\x85\xc1\xb5\x12\xafq\xce\x80\xce\x95m\xf1\xd1\xc7\x1bqb\xce\xe2\x1e\xb1\x8d\xee\xa2\xaf\x83\xba~\x91?!\xac<\xbb+\x99F\x90\x81+\xae\x0e\xdbO\xad\xcdN\xe4\x09\xbf\xab#\xdb\xcf5\x98\xb1\xfa\x89\x04\xcc\x09\xab\xa0\xea\xe1$\xaa\x9cX\xbe\x947\xcb\x11\xed\xf9\xbf\xba\xcb\x9c\xc0\xb3u~\xf6'\x04\"Z\xfb\xe2\x1a'u\x90\x7f\xfa\xea\x9b\xe3\x08\xd5\x00u\x87}\xbf|\xe4\xd4D\xae\xc1\x8d\xc5n\xc1\x94\xde\x96q8E\xe2\xec?\x1c\x1c2I\xaf\xed,P\xe0\xb3:\xd3S\xb3\xb7\xb9n\xbc\xc6\xeem\xa6\x1f\x9av]\xb8\xa9\xc8\x11SF\xc8\x9e\x9fw9<\x93\xd1\x9fO\xa4F-\xb3\xf4g;w\xf7X\x89t\xb0zt\x0cHT\x09{\x13<M\x11\xfbW/\xaf\xb4a\x05\x13\xf9H\x19'\x95\xb2\xb5O\x9d\x89YT\xa7\x02s\x9c\xa3N\xc2.w\xb3\x96y\x10\xd6Z[s`\x9f/\xf5\xb6\xfa#\xef\xbcG\xcc\xeb\x948\x88*lh\x0d\xd7\x0f\xc2\xe6\x0fG8\xe3\xd0..\xe5\x8e\x19(\x1bX\xd7'\xab\xb2\xdc\x12\xcf\x1a\xe6\xc4v\xae\x95\xd8\x05\xcf\x09D>\xc2\xa3\\u\xeb\x0a\x9d\xb8\xe3z\x90\xa2\xed\xfc*\xde\x02j\x185\xa8j2V\xd0\xbd\xc1\xa3\xadb+\x06\xcb^g\x81*rD\xc9\xf4<\x90\xbf2\xc7\xe2\x0f\x1e\x7f\xfb\xc0\xcdI\xfdH\xea\xd2\xf1GU\x8e[\x9f\xcb\x9f\xfe\x1c\xf8@\xcf`\x87\xfb\xc8t\xd1l\xd9$.\xb5w<+\x8a\xff\x03\xfa\xea\xf3\x9b\x7fbA\xc1\x9cZ\x09ETrD6B\x9b\"\x9f`\x9fT\xd2^\xea\xb6A6[\xb4\xa5M^\xc6\xca\xab+\xc9\xfd)\x13Z\xe6\xda\x172U#\xfd\xaf\x18\x9f\x05J\xc3\x99f\x1f,(>\x89\xcf'\x12v;\xaa\xe55\xff\x11\xc1\x8d\x991&S\xc457\xb7@\x92\xafRBp\x7f\xd2D\\\x8b\x11\xef\x1a\x97?\xebX8\x8f\\\xb6o~\xeb`\x1e\x86'\xf5\"\x86\xde\x17\xfb\xbe\x157\x80k\xcbs:\x06H\xfc\xecF3\x8e\xb0\x9dq\x06r\x02>U\xb2vE'\xe1\xe2K\x8e\xdd\x09i%2\xc4,$&\x1b\x1c#\xccL9\xed\x09|4\x18dG\x15$\xfa\xae6\xc8\xab\x8e\xd6\xa60\xa7\x86\"\xfa\xb2\xcc\x9ct\x96\xa5\x81\xfd\x0dcM\xd3\x0d\xa7\xd4k\xcf\x0d/\xfd\x0d/\xfd\xed.]\x95v+YI\xaa\x87\x92\x91]\x06\xad\xb1\xe6\x17I\xe99u\x1f\xe8l\xa3p5i\xb6\xcd3w\x90\xa8T\x12\xb5\xa9\x03\x91~\xf2\xa5\xa0\xad\\\xb5s\xf9\x9c\xe5\x16\xb6\\\xe2\x8e\x9f\xfc5\xe9=|\x8dZZ\xf3\xad\xe7\xb3\xc2O\x1eD\xfa\xc6}\x86N\xf8\xa1\xaf\xd6\xf8\xca\x9f\x89\xeb\xe2\xee\xd2\x03\xa4Or:\x02`\xa4m\x12\xedJj\xc8\xbcw\xd2<\xa4\xa1\xf6\xa9\\\x11\x11t'!U\xff\x88\x94EtLo/X\xba#\x03\x07\x94\xf1\xfb\x8d\x9d2\xa7\x8f-\xaf\xd6\xf6\xd6\xe5\xc41\xc4\xcb\xec}\x165\xc5E\xfeZ\x996~\x9bKkb\x1d<\xc2\xe9S\xab\xdf\x93\x838\x99#\xef\xe2<\xf2\xd6P\xa2\xb92\x82\x0a\xa2\xdd\x09\xbek\xe5\x89\xd0\xdd\xfdK\x85Vm\xec\x02\xbc\x18\xb2\x8e%\xe4\x14\x1b~\xcfT[i\x15\xe6\x14\xe2\x8c\x01l~\xe2\xaf*\xa4\xf3\x93O:d`/.2^~\x92\xf5h\xa7\xed4\xbea\xc1\xb9\xb1\x0d\\R*\xe3)l\x1c\xbf\xc9\xb4\x8d\xde\x9a\xf9\x89 \x12\xab,\x93\xa8\x8a\xfc\xa5\xd9,\xa0\x02\x00\xe5\x0d\xf3\xcd\xe6`\xe1\x12\x191u\x84~ \x83RsSJ\x87j\x94\x1a\x87\x8c\xc6Pj\xf2\x00\xf13V\x19\xf10\x97*\x9d.^\xf3\x1bK\x9f\xa5\xeb\x0f\x10S\xdb\x89\xff\x0b\xa8:?3\xad\x11\x14\x85\xcb\xed\xfe\x12\x9c\xf1\xf5W\x1a\x86e\x03,\xb9\xf7\x87t`\xb3\xe1COq\xe33\x99>\xe6\xfb\xb3z\x1a\x97]g\x8e\xe3U\x93m\xc6\xad\xfd\xc3\x91\x81xP\x8b\xefl\x14\x9d\x0e\x8c\xeb\x82\x91\xb9\xdfe\x9f\xd9\x15s\xba\x17=\xb4\xa1\xe00\x19\xe9\x80\xa0\xfb\xe2c\xd3\x8ff\xd4\xf3&\xb4}\xe6\x00|\xd0RT~C\xf9\xb3\xa5\xf0\xe3\x95\x92\x85\x8f\xf2j\xecO\xd6\x92d\xf8T\xd5\xce\x9a\xed\x840q5\\\xfa{.\xe6M\x94\xad\xc9\xb1z|\x9f0*\x81\xdd\x11\x9af\xefDsR\xbb\xd2\xceo2\x8e\xf4\x09s\xf3\xca\xefVl=\x05\x96:o+\xf1\xfc(\xa2|\xa3Qj\x08\xea\xc3\x83%\x02h\xf4\x97\x0a\xbd\x07\xdaZ\x19\xa9,\x80\x163\x9d\xad\x96u\x1a\xe1\xf4/\xaf\xfb\x1b==/\xa7>`\x82\\\xad\x1e=\xad\xe4\xbbMX\xfa\xad\x93\x05;\x80\"\xaa*\x89\x98\x89\xfa\xfa6\xbdE\xd1\x99\xc5\xf7[\x8e@\xf7me\xd1$Y\xe7aJ\x07\xd9\x04\xc5 \xcc\xc1\x0f!\xae\xf6F\xe3Q\xf6=U \xaf+\x04\xf9\x00\xed\xb2\x0eU\xe8Cz\xb1\xc6\x12\xa2\x04\x935\xd5L\xa6\xcc\xca\xac\xce'\xad\x989\xd0\xa0\x8eu\x875\xa5\xb2\xa6\x85A\xd6\x08\xd6\x93y\xed\xc4\xa0\x82\xd1\x14\xf1\x06iC\xa6\x91\x7f\x05T\xba]\xf4\xdax\xf1\xa090\x0b\x13\xca\xe9\xe4\x01\xcc0\xcc,Q\x10\x00\xb1\xceB\xdc\xc4,!\xe8\x04+xO;\xfe\xf7\x89\xf6\xf0\xf2\x94\xfd\xd5.\x9a\xbf\xf9\x89\x9a\xbfd\xeb\x0a\xb8L\xaen\xc9f\xb7\xf2J\xcf\xd4K\x1e2\xcd\xed\x92\x8e\xa1\xd73\x05m\xa0!4B\xea\xa99`V\x90\xb5/>\xf7e`\x99LO\xf5\x0b\xf4#\xaa\xa0\x025\x00j\x01\xaa\xe1\x93\xbf\xd3u\xc9\xa4>z\xe9\xfa\xd2\x87\x84\x1f\xa7\x81\xb2s\x0b\xbcZY\xff\xf8\x93\xd0fy\x80\xbc\xf4\xde\x86\xa8\x9dAK\xf0`\xf0\x8c\x85\xf3\x07\x99\xff\xd7\x0b,\xb0\x01\xc2u\x16\xca}\xbd\x80OQ\xd0\x1d;\x1b\xd7Y\xc8\x16\xfc\"t\xe1\x0et\xc9y\x8d\xdfd\x07\xa68h\x86\xb6\x95\xbd\x14!\x8c\x84\x96czZ\xe4O%\x19'\x09\xb9\x84\x93y\x84%D%\x06=\"i\xe8\xa6\x1f\xfa\xc0LeQ\xb2\x8b\x93\xc6\xef\x95\xa6\x94\xcc\x0ea\xc5\xa4\x06\xb8y;U\x08\xae~Lo7\xfdM<Uxb\xa5\x99A\x9dn\xdf\xde\xc3\x80\x19I\xe13\xb8GE\xc6\xa2\xac\x1b\x82\x1a*\x19\xec\\\xa9\x7f\xabR\xf1\xf4\xcc[\xdf\x8a\x1f\xb6\xad\xd4\x88;\xe5\x85\x9a\x7f\xaf;\xea\x9b\xf0\x92\x1c\xb0fj\xeeKh\xb6\xa3F\xf4\xd2'\x15a\x8e\xe3K\xe9o\xa3\xea!\xe5R\xee\xc4y\x8c\xc7\x06\xaf\x9e\xb1\x0bs\xdc\x8f\xd6\x09\xdaz\xf7\xf4&\xbf\x9b\xcbK\x95JW(\xa6\x11\x81\xce\xa1\xeb\xab\xf8\x14\x92\xa8\xe1\x03\x11\xae\xd5\xedr\xfcP\xc1\x15\x08/~GEM\xf6\x86^\xd1V\xe7F\x0ci\xb6^g=[\xd1\x93-\xb8\xafn\x05\xb0K\x94\xedV\x7f\xd6P\x1a5\x12}\xd9S \xb7\x11n\xb5\x0d|\xe4\xaaf\xbct\xcbOK\xf4\x1e\xe3\xeb\xe5\xaf#\xf0F\xd1\xa1R\x8c\xde\x9e\x02i\xba\xed\x8aN\xec\xa5z\x94Y\x9b\xb6\x92\xfbe\xc1\xa6\xaeD\x985vv\xeex\x98u\xfb\xaeG8\xd5\xa1y4\x15\xee*\xf1\xd2\x17\xd9.\x9a\x974r\xa3\xb2\x89\xcd\x9a)\x8b\xbb*xhbN\xde\x9c\xa1\x9b\xe8\xb3\xe0\x85i\xf3\x07$\x8ar\xecJ\xbb\x88C\xc4\x8dX=\xcc\xd8\xc3\xd0\xf1\xab\x88e\xf6MU\xa6\x9e\xef\xdf\xeb\xe4\xdfxs]\xbdbc\xe8\x0c\xd3\xb3\xad\xfeA\xa3D\x14t\xc40~6\xc7\xd2-V4\xee\xef\xfekZ\x10\xf7\xe5F\x8da\xcc\xf6\xd5EH\xe0\xdc\xa7\x87\xcb)\xf8I\xdc`\xdf!N\x17\xd6;G'\xd3\xcees\xc3K\xb1\xf8\xc0\x9d\xf8\x93u\xfbLo\xb8\xf9>\x92\xab\x1d\x91\xfa\xb9\x8am\xa1\xe9\x9dP\xb6,r!V\x9d\xcf\xcd\xccp\x12a\xa7/\xd9C\x8c\x8f\xb6\xa0Rv'\xdd\xc8D\x09u\x15\xb0\x81/\x98\x8b\x8fO'\xd7\x03x\x8a\xf9\x99\x8c\x8c\xcc'K\xe4V<\xb0p\x9b\xfe\xd9\xfc\x1e\xab^y\x99E\x98\xbe\x1e\xbd\x18_\xcdq\xc9\xb8a]T$\xbf \xf9\xb1\xecU\x9eN\xe7\xd1nx'l\xcbW\xf5\x99\x0e\xc5$\xd6\xd0\xb2vf,+\xeeQ\xda\x09F\xe7\xa7\xa5\xf3\xf1[Jd\xcf\xfb\xec\xfds\x9aJ\xb2f\x17\xdc\x1c`\xd2F\x1eRkm\x8a\xb4\xd4CH\xb3\xd0\xb0*\xdfI\xa6\xff\x040z\x1d\xd7\xd7\x1f\x0bc\x94\xe7l(\x89\x01\x91=F\x88\x14\x91u\xcb\"\x1br\x921f\x99\xda\xce\x0b\xaf\xdaE\x1a\x87fcC\xa0-T\xf3\xd3\x84\x88\x1c\x99\xd2I\xc5G%\xed{\xe1\x89\x1bgX\x7fR\xb4\xea\xc9\xc7o\xa1u!\x97z\xc2\xe7\xb2\x1c\x90\xb6k~`\xf33>sn^\x97\x97\xc5o\xfd\x91\x1e\x99s\xd8i\xa6\x8a\x1e\xf5\x8f\xf4PB\x0c\xa6~\xfc\xafv\x15\xf8\xbbur\xf9\xd36Gq\xb2\xe4V\\\xc1\xc0P\x8eY\xd9O\xd2,\xb6\xddZ!Px\x15\x99\x0d]wl\xfb5G\xba\xb3N#q\xeaKc\x15\xd4\xff\x01\x1a*0`=\x080l\xce\xd6\x8e\xa6\xfdTi\x92\xecj\xfc\xca\xa6\xd9\xa4\xe0\xbe\x01g\x8a,UV2E\xe3\x8f\xf7\x8f\xb5\x89sN.\xe8^\xd1\xfb\xf6\xaaMo\x8e\x17\x9c\x9b\x06M\x89\xf41\xaf+\xc8C\xdb\x8b^f\x15_\xcaK\x1e\xfd\x1c\\YY\x8b*\xbb[$\xee\x90\xe0\xa0\x05\xae|v\x13_\xea@`J?\x9bKI\xe9OPCF\x0a\xe6\xefY\xe5\x17Yx\x9du\x8e\xaa \xe0\xc2G\xd0W\xc5#X$\xf8g\xe1\x08\x81\xcb\x0a_\xae\xe3\xfc\xb5\x98\xd9\xc3\x15\xb8\xbcH\x9fX\xe1\xb3\xe7\xab5\x83\x91z\x7fg5\xcb\xbe_j\xd5\xb2\xf7K\xe4\xee\x16}_\x1e\x91\x93\x1a8i;\xef\xfb\x12\xdd\xb1\xe1\x97\xa1\x8e\x04\xfb\x84\x8e\xc4'\xa4\x93\xab\x09\xc9\xdd\xd7\xacrq\x02\xc4\xc0\x865\x16\x16\xa9\xf8\xfbNa\xc3.\xf0\xb1Cnw'^v%_\xf7\xa1B\xdc[\xdcQIH\xea\xb09\x9e8T\x12\x80\xa3\xf9\xfe\xc7\x06,l\xa8Ct.N\xa7V\x9d\xc4\xbc\x86\xda\xbf\x97\xc5X\xd7;\xf6<<\x818\x94\x98\"l4\x08c\xf8\xd8l\xd2\x17\xba\xa5Qj\x06\xe7\xd6\xba\xa3\xb3\x8b=z}\xd9\x01KBN\xf45a\xf7P=\xf5\xdcyd\x99\xd7\xd3\xce\x18U\x90\xd6\xe8\xbb\xb5\x92Nq\x806L\xbc7\xdfx\x9f\x958\x11\xa7\xd1\x16\xdc\x98\x81\xff\xf3\xa6\x99\x8b.u\x9d\xaa\xc2\xed\x9b\xa3Z`s\xc0\x9d6\xe8\xcc\x0eq\xbb\xa4\xb2\xf4\xde#\xd9k\xc2\xed\xb9\xd3\x8dF|\xfa\\\xc9\xc6y]U\x04|W\xab\xfe\xd4\xbc4\x87\xec\xa7\xcf\xf04d\xc3^*]\xa8.>J" +
+	"\xf6\xc9\xc0B\xdb^D\xd0\xed\xab\x0e&x\x98Mp\xadT\x08\x93\x90\x91:!y\x1c\xc6C)\xc7\x8d\xa5\xaf\x96$'\xb3#h\xc3\xaaKZ\x10\xa6!\xb9\x91\x1eR\x18j-'\xd5\xd3\x0a]\x97W\xa1\xeb29\xbc\xf7\xcdfH\xf1-\x938\x12\xe5\x83W\xc96\xe8)\x0c\xe1A\x01\xc7\xcaK\xec\xa5\xfb\x15R\x15\xbc\xdce:Qsh6\xff;\xcd\xb8\xc9\x9f$\xd0\xc5\x9a\x80\xc6\x06\xc45\xcd\xc6\xbe\xd0\xa4\xd3\x84\xf9\x93\x9d20_\xa2\xadB\xa6\x15/\x85\x80\x1a\xac\x8f\xb9\x93Te\xb3\xe9\xb9\xff\xa0T\x87\xfbp\x9b\xb5\xa8y7\x7f\xa7\x9b\xb0\xd6F\\\x12S\xc8\xc5p\xcc\xfbf_*\xbe\x067\xcbo%\xb6\xd5\xfc\x94\xa6\x9a\xb9\x10q\x1c\x9bM\xff/\x17\xb2mU\x9e\xcf\xfe\xbb\xfd\xb8yo\xab\xc2\x83^\xf6j)\xf4\xd4\xf3z\xc8\xb2\xb8c\x8a\xd9\xebQ\xe6\"6\x16de\xdc*c\xd2\x9b\xbf\x85\xe7\x9c\xdfDh\xf5\xe1jW:\xc5k\xf1v\xaf\xf2\xdb\x95bc\x89\xb6K\x1a\xadvLpA195V-\x82d/-_\xd3}\xf8@O\xfd`\xdf#\x92;X~A\x8a\xc4\xdb\xe0TY<\xc5ZE\xbe\x08\xd9K\xd07\xfc\x84Pr\xb8m1\xb2\xb8\xa5\x17)o+\x84\xe9\xa2>\xc1\x02\xb4,\x0eUiE\xc0\x07\xd8\x04\x92m\xef'\xd6\x8a\xb3\x16ccY]\xd3\xa8\x91\xd9H~\x82\xb7)\xe5\xfeG\xde\x1eha|ug\x9d\xa5\xeb\xb0\xdf\xb63\xb3e\x0aANDC\xa1\x88n\xfa\xba\xcc\xedD\x92_\xdcoa8C\x14H0^o\x04\x14\xeb\xbf[\xaf\xdc\xbdl~5\x87\x09EZS\x96'\x96\xac\xc0\xac\x03\xbb@\xcd\xbd2L/\xfb:\x92\x86[j\xdf@\xb3\x19\xec\x96\xec3\xfbx,\x8e\xcfQ\xc9\xc5M(\xa8j\xe1\xb4\xb8\x1fM~\x9a\xf5\xeb\x17\x02~\xdaY\xb2c\xad5\xb0\xb6\xd2\xfa0\x9d\xa0\xbd\x07\xf7O\xff\x8df|\x82^[\x85\xd7\x00\xe7Xa\x1dvB\xd22\xe7\xf4V:>?\x96x\x93K\x13\xe8\xec\xdc7\xb8qw\xbdd}\x17\xb0\x89\xbc\x92\x08N\x89:\xa2\xd7s\x86\xf7\x95b\xd1_bs\xa6\xe5\xcb]t\xb3\x03k\x89\xa3\xdd\x1c\xe4\x8b\xa6\xaf\x7f\xb6u{\x8f\xcf\xee\xa1\xb5A\x1b\x87\xa1\xb0\x97\x88\x93&\xcd\x0f\x1a\xab\xc2\x85/s\x7fq\xef\x13\xc9\xdc)\xa1g0Z\xf8\x8dJ\xe3\xe3hw\x88H\xb7m\x06\x1b\x07%u\xfd\xe2&\x95P\x06\xc3\xb3c5A\x0e|\x90.p\x09\xdf\x85\x90\xfb\x19\x8b\xb2\xf8\xcf\x8f\xcd\xab\xd4\x809+\xd7\x7f3\xbe`\x18\xe9\x90|Q\x92\xae\xa1\xdcK\xb5^\xd0\xd5A\xb7p\x08X\xa4b\x01z\x9f\xd2\xaa\x8b\x15\xc2J\xc4G\xdf\x0d\xf9\xbd\xc8r\xb9\x05\xa1\xf1\xa9\xd4i\xd28\xaa\xe7\xb9&\xa3\xc0!\xa7#\x02Z\xa5:\xbe\x0avpF\xf7\xa7\xcf\x86\xb3\xad\x18n\x16t\x9bbhv\xf4\xe5)\xb8\xb4\xbf\xe1\x94\x85\x86I\xf8[\xf64B\xce&\xfb\xa9\xbc6\xf4v\xa5w\x9c\x963\x8fK\xe4\x8b\xe6\xf0OZ-\xd8nq\xe3Y\x9f\x98\xc6\xc0*\xb8\x0bR\x1a{\xb50R\x1dc\xbc\xbfUs\x85\x15w\x97\xdb[B\x1cx\xbf\xaa\xf9\xe0\x85#\xe5\xae_1\x0bK\xfb\xd8\xbd\xeb\xbb\xc58n\x8b\xb36t\x1f\"\xe8\xf0\xc2&\x0c\xb3\x0c\\\x1c\xf8\xbav;\x93\xa3~\xac\x9d<T\xff\xa6]eyq\xdd\xb6\x83\xb4\x86E\x8c\x1a\x09\xbb(-Eo\xa0Zj\x97\xfa\x82d\xe9\x88f{\xff\xf3\x9e\x0dz\x90y\x02\x91\xa5\xb6\xc4\xfe\xe7~\x85\xe3\x0d\xebh\xcd3\xb2\xfe k\x9aOA~dG\xb0e\xca\xb4\xc1\xeaP\xd3\xfa\xdf\x09\xb2\x17\xff\x18~\x91\xe7YGZ:\xd2\x96\xb8\xbf5'\xf5\xa5|{\xe8\x1e\x03z6m\xb9\x0bAb\x04\xd4\xc1S\xfa\x0eF\xe9)\x0dQ\xe9^\xa2\xe4\xd9^\xb0\x0a( w\x82RNJ-\xe0\x84\x04Y\xe0\x15\xd6(\x1d\xd3\xfc(_\xd0E\xe0\xcd\x87\x00\xd26\x84k\x83\x03\xa6\xcf\xc8{\x83\x00/d\x1f2t\"\x9a\x93\xfa/s\xd51\xb6\xd4\xf7w\xcf\x10\x14\xc5\x1b\x092\xb4]\xc8\xdcwQo\x98%;\xfa\xa6x\xc1\xd0Z'\xde\xab{\x18#\x9br\xc1\xae.\x08\x03x\xba\xe4\xaf08\xd1\x02>\x12[faP{\xb7\x96c\xf338\xadrn\xe9l\xd4r\xff\x04\xa8\xf39\x95\xd8\x97\xb4\xde\x85\xbc\xcd\xb8\xab,q4\xe4Z\x9e\xc7\x92\x17\xdd\xce\x1c\x06\xdbN\x8f\x9bY\xcb\xae@.,\xa6\xdds;/\x9f\xfa\xae\x03\xf3\xa1ml\x09\xb8\xd1\xd2\xef\x04\x0a\x8co^$\x14\\2\xb3\x9c2\xc6\xfa\x1e\xe7y\x10\xcbFP^\xb8\x8d\x8b/\xfdf\xc9\x05B\x1b\xe5|U\xc1@\x1b\xd3\xc0b!}\xc1\xc0\xa6\xb6\xab\x81\x95\x1a\x1a\xb5f\xea~\xab\x1dn\xc1\x0a\x92v`\x1a\x10)\xc1\xbb\xed X\x8bY;E\x0d\x8c\xbd\x98\x15\xfc\x05\xbc\xb4\xe5\x80]\xbe8\xef\x1f\x80n}un\x9b\xa1\xb3\xc1\xab\xf8\xd8\x94N\x9fg\xcaC3\xd3\xc4^&\x17\x93\x0a9\xc8\xc2\xfe\x07\xed3\xc8\xce\xee\xd4\xfeP\xa2`W\x9c<\xb1\xdb\xc5\xda\xbb9/\xbc\xe5\xa6\x01\xd9&\x1f\xa1?\xab(*\x15{`\xdbC*\xb8\xe5\xf5h\xdd\x159\xfb\xc1\xbbXz^\x8a\x81\xa6\xf3A\x1a\xdb\x98\xf6\xd3e\xab\xdfYb\x9d\x17\x0a\xdd\xca\xcf\xb1\xc5xq\xf5\x0f\xa4\xb6\xfc\xde.\xa9\xf9\x1e\xa54\x0al\x8a\xcf\xef\x81\xfd\xf7\xa6\xc1\xf5\x82]\"\xe0\x93\x1cm\xb1\xcc \x1f\xe5\x059\xb6i\xda\xa0\xa00*\x18|\x12\x04,\xddF\xc8b\xd0u \xe0,\xd2\x8e\xfeR\xa6\xcc\xd5\xacYyV.\xd3\x91\xa9s}\x0f& \x96\x05\xa0\x81\x85\xd9;y\x92\x9e\xee\xbf\xe5\xdb\x80q\xd9H\xc4\xf5\x83\xde\xd6\x9esH~\xdb<P\xf3\x85W\x13\xe4\x1a\x10\xaf\xa0\xa1\xa8\xcc\xa3\xed\x85\x1b\xf8\xa7w\x81\xba\x1d\x1fz\x1c\x15YM!\xb0\xec\xee\\~\xe9S\x9e\xd8\x85\x12\x18\x1b\x04\x1aJ\x0d\xfa\xe8\xb2Ix\x9ev\xa1Ok\xfc\xa9\x10\xcbL-i[Y#\xfeA\x85\xc6%g\xb0\xf1\xdd\xdd\xa1\xe6<\x94\xbe<\xebF\xe6\x07Z\x913i\xd4\x13{\x0e\x13\"\xbc\x91\xe0&\xa3^o\xcb\x92\xbd\x00\xc1\x7fl~\x04\x02\x90\xb7\xe2.sy\x1e\xb5=\x01YFS\xac\xea\xf6\xa1\xe66oW\xb2>\xbc\xdb+\x14\xa7^]C$\x0d\xabv:\x89\x9c\x02OF\xe1\xa0\xf1\x00OY\xed\xfa\x1d\xb57\x1eoN\x83U\x0b\xb6\xc4\x98\xe3\x89\xfc\x92y\x0b`\xb8\xd7\xac\xb8\xbf\xb3\x8c\x9c\xaf\xa2\xcc\xca\x97\xbb\xcc5h\x08\xef\xc3O\x1ftyjI\xd2h\xdblm\x95OIA;\xee\xd3Y&r/T\\\x8b\xd3\xc6\xe1\xaa\x17w\xff\x10\xb2\x06\xc8\xf4\x91\xe44\x9c\xb4v.\x0d]\x82T\x9f\x1b\xd4\x1e\\!\x8a\x0e\x0e\xd2\x86\xe4\xca,\x1c`bB\xd0\xb1\xe2\xdd]\x07y\xcc\x148\x0a\xaff`[\xa5\xcf9\xb7X^\xdc7EQ\x13K\xf2\xb5\x8b\x00\x8a\x9fS\xa4\xf0fE\xae%\xb6\x807\xfa|\xe7tA'\x01>?\xbc^\x074^\xc4\xae\xa7O\x88\x7f\x18\x88!\x90\xad\xb1\xb9\x84S<\xe9\x10\xf6\x18\xc3hO\xab\x02\xfd\xa7\xf9L\xd7+\xdd\x96wZ\x97\xac\xc3\x82\xbe\xa4\xad\xf09.\xd0\x00\x06\xbf\xeb\xad\xbd\x97\xfcwd\xc8\x9d\xd8t4\xfa\xd3Mz5\xc36\xfe\xaa\xc7\xfc~\x98\xf8t`X\x9fKg\x9d`C\xd7\xc4X#k\xc9Ppo\xb6R\xa4\x9e0\xac\x82\xa9\x13\xfb\x1b[*XC\xe5n\xd5\xce\x1b\xec\x01\x83I\xfe+O\xe9\xce{\x91b\x89}\xccD\xd3hC\xe8~\xd5m$\x90/o\xabCA\xba\x07\x0c\xc7\xf9Dy\x95Gi\xfd}\x92k\xadL\xaeh\x0b\xcdJQ\xff\xa3/\xda\x05\x98y\xd7h$uS\\\xc7\xbf\xd9(\xc3>\xde\xf0\x891\xfc\xd5eq\xeas\xa5\xd2\xc5\x00\x1c\xf3b\xeek\x0c\xbf\x02\xb3\x00K\xe7|\xb4+|\x9d\xa5k\x06\x0b\x9bW!\xe2\x1e\xcda.lCF\x0dR\xb1\xaf\xca\x0f \x9fX\xc8&o7d`W\x13\x05\x1dnF\x90\xa9\x9d\xb4\x99\xdb\xb8\x176*\xd5\xf0\xf4\xbb\xc9\x04d\xa4N\xb8\x16[g@jl\x9c\x8fl\xfd\xd5\x8a\xbcK:\xd6\xdc\x88(G9\xbc\xbf\x04\x91\xce\x1d\\\xb2\x80+{r\xfci\x13\xab\xebBp\x9c\xfb\xe5\xd2\xffA\xd2\xd4G\x83d\x10\x18j\xb8\xb54\xb2A\xd5\xfbo\xe8\xaeS\x95w\xed>\xc3buj\x82MT\x98\x9a\xd1v\xc8\xcc\xd5\xd8\x7fi\xd3C.\xae\x8aj\xe6^@H\xd3*\x8e\x10};v\xf3\xd7_:\x9e\x9e\xb1\xde\xac\xe4\xba\x8f\xc6\xbf\xe7\xae\xe3J\xa3\x95\xe2\xa3\xf4k\xd6\xb1x\x84\xe7\xa6\x9a\xfc\x1c\xc1P\x08\x85\xd1E`R6\xa4\xd7\x838\x0d\xd9ic-\x18\xeb\xfc\x00\x1bh\xe6\xc6Z\xa2\xdfX\xf0\x14%NV\x81DKI\xe96\xceG6\xfb\x81\xd67\x82\xcc3\x19\xa5\xd0$l\x9a\xbd.\x02\xce\xdb0\xa0\x8e\xbf{og\xe51\xb9\x08\x91\xd2\x16\xbf\xe8 I$\xf6\xd6\x84v\xc4_\xd2,\\q\xe4d\x9e\xfes*\xfa\x0b\xae\x88\x92\x93\x14\xf9q\x0d\xc0\x97B\xda9v\xe4A\xb0Q\x87\xffB8\x00\xbc\x9a\x1ef\x8a\xbc\x8e\xba|\xda\xab\xc2i{\x05\x1f6\xd8\xec'\xd2[\xa6 )\xcd2<\xb8\xf0\xd44A\x96`!(\xde\xc4u\xd4\xa3\x10i\xdb\xbf\x10\xac\xf9\x84-\x15*\x8b\xe7\xb9\xe9}\x18\xab\x85.!V\xec\x14D\xdfT\xf8\xd3`;\xf7\xc9\xc3\x0e\x82\xeay\x18L\xe7(\xddDA\xf4\x98\xb7\xa2=\x07\x0e#4\xfe\xb2u\xa5\x9bqe\x15T\x0a\\T\xcb\xe4\xe3\xfe<\xcd&\xe6\x99\xe4\xca\xafJ\x96+\xdd\x95S\xac\xb3\x17I/\x93\x8d]7?\xca\xcfv\xe7\x12\xb2?\x9bMsl\xa7.\xb2\x97\xe9\xe0\xa8\xdfu\xf2}\xe7>,*\xc5\xaa\xd2b\xbbB\x0ejXP\xd5\xefv\x9aX\"|\xa8v\xe3p!!\xca\x9b\xc7\xe6\x13\xee\x82\x99xL6\x81\x9fL\xd6\xfb\xcd\xf8Hf\x99\x18\xd8\xa9P\x97\xb3\\\xc2Go\xb8GR\xc9\xc80w\xd8\"\xf3V;\x10\x8bm\xfb/u>8\xd1\x88\x96\xd0\xe2o\x02+\x99Qj\x17<\xc5\x05\xa2\x9aZ<\xecS\xa6\x80)<\xe3\xc5\xe023\x9f\xbfW\xe3{.\x8d\x90\x87\xc0\x9dLoN\xa2\x91#\x851\xc5\x99q\xf9\x076\x0b\xe2p>\xea2\xc2w6\xd3/\x1c\xc7\xed$T\x85h\x04\x13\\\xe1X\x9f\x19wL3,\xc5V\x1a\xa8\xab~\x95\xef\xc7\xe6\xd5^\xcco\xaa\xf5~\xef\xd1\x92A\xbdC\xe0\x09\xba\xd5*\xa8\xbb\x1a\xdc0L\xa35\xa2\x0e\xf4<\x98\xee\x7ffaoz\xdcU\xf6\xcf\xd7\x13Q\x91>P\xd1\xc0\xf2G\x80\x03\xffGh\x97\xac4\xce<K\x9aC2\x8c\xe1\xab\xbf\x83\x02,\xf5\xda\xc3R!n\x9f\xf6\x8c\x01X\xeagrf\xfe\x07\xe0\xd1\x1d\xca':\xd7}\x0a|\xa4\x0d\xf1\"\x13_C\x8d\xa0yn\x8e\x13\x01\xf2\x0b\x09k\xccM\x9e(.A\x1b\xb2\xc8\xd2.\xb3\xdaI\xe8\xb5R?\x8fs\xd0\x89\x83o.m\x16\xcbf\xd3\xde\x07\xa1\xf5\xe4\xd4\xfcl\x8e\x85\xeb_ea\x12\x17H\xfc5\xe1\xa3\xc5\x81^`cLu\x9b\x1f!}\xb6\x14[\xb1\x84UD\xfbwA\xe2\xbb\x00\xc3\x90\x8e\x09\x7f+\xe4To5\x01\x13.\xb6\x15B\x90]\xca\xc3\x12\x16gP\x93\x01k\\+\xf0\xb12\xe1:?\xe5\xa2\xbb\xa85#=\x94\xf3\x09\xd9\xa7\xc7!\"\xd2\xdb\xf8z#\x09\x84\xd4\x10\xdc\x03e\x09\xcc\xe1\xb9\x93w\xcfK\xbc>7/\xfdH\xe5\x9a\xd7\xb1\x15r\x96\x05C \x93\xda\xbb\xbc\xa5V\x8d@#B}\x0d\x7f\xa6?i\xa8>\xfe\x0deK49+\xca~\xc5^\xd3\x8e\xfc\x96V[\x995\x11j\xc6k\xa1P\x1b\xe0\xdd\xbf\x089\x0b\x82\xd6Qg\x90\xe8\x90I\x82\xa2\xf9l\xe7\x10\x8az31\x1f\xcd+b\xceXql\x9c\xe1_\xe5Li\x16,\x911\xca\xef\\\xfd\xc8\x11<\x9dH\x90\xcd\x85\x94U\xcbl\xb1|&*\xc8\x8b\xe6(\xed\xdci\xa5,\xcabZn]b\x056\x1b\xff\xb2\xe0\x9a+\xb0\xc8I\xf1\x07\xe9\xac\xc1\xb9\xe0\xd7\xe2\xd2r\xb5J=`<\x99o\xacDy\x1aRm\xc2\xa9\xf7v)\x13wj\xea\x01s\xf2\xea7\xd2\xca\xfb\xa3\xd9\xb9\xaf\x05\xe3\x82 {@\xb2\xcd\xad:\xf8\xe5t\x1a\xfc\xa3\x9d\x99\xc7o_\x8a\x90V\x10Q\x91t\xe50\xe4\xeb^\x90\xbf8\xfd\x8a\xf5\x0b\\*\xd8\xce:(}\xe8`USz\x97\x07\xbe!\xe9\xd8\xe1\xb4\xf3TT\x1c\xa6m%\x1eY-\x9e\x02\xf8#\\^\x01k\xa2\x0fz6\xc2\xb5\xce;q\xbb\xa4!\xff\x0e\xfc<\x07\x94\"m*\xbc\x99{\xc0\x00N\x9a\x0f\xe7\x17\xdd\xa1\xd3y[\x89p\xd6Is\xf4\xfc\xb2\xd7\xde\xfcqf+\x97i\xa08\xbf4\xbb\xce;\x0b\x9c\x13'O\xc2.\xa2<\xef\xc3\x8b\xf8\xfc\xba\xe7\xee\x8d\x81\x7f\x9b\xb5\x81\x8eI\x93\x9f\xdf\xe4\xa7\xe3\x0d\xe7\x8d\xd1\x9b\x8b\xbe\xca\x8b\xb1I\x02\x88\x82\xb8v\x18?\x94\x8d5\xebw\xdd\x1b\x7f>\xb1$\x9e0\xd2#]\x8e\xe7w\xd9%\x85\xf1\xc3@P\x16\xebS\x8f1\x92\xaak\x9d\xf9\x11t\xf9\x86\xf2r\xc9{\xfc\xd2\xb5S\\\x1cyl\xbe\xc1pl\xa5\xabh\xc0\x09=\xebN\xef\xb5\xa0d\xdc\xe3\x9f\xac\xa0\xe4P=S\x86\xa0\x7f\x85f\xd1\xc6w?\xa2\xc7\xe5\x07\x12n\xedr\xf0\x16\xfd\xe6\xbal^\xc1\x02\xb5\xef\x9em\xcb\x99\x89\xbf\x0cW\xf1\xabh\x12/.\xbb\xeb\x02@\xbd\x07\xb76\\O\x11\xf6l\"\xcf\x1b\xa2\xa2\xbcri\x92C[\xbb\xcc$x^\xbc \xc5\xc1:$!\xc9\xbeb\\p\xf3\xe2s\xbc\x05*\xe1^\xa1[p s\x9b\xd3\xb3t<m\x183\xe0z\xd0\xd2\xbe\x85\xe9\xf9\xd4l\xb8Im9\x8c}\x11\xa4\xd3L;\x09A\xf56< \x9e_\xfe\xd1P\x80\xf4\xc0\x92\xc7\xa5N\x04\xa2\x08\x87\x1f\xb7\xa0\x13V\xfd'\xbc\x0b`\x9c:\xa3\x0f]I\xcf\x13lc\xf38h\xd4.s\x05\xec%`f,f\xe2o\xd4\xc9\x89I\x87\xc4\xc2\xf4(\xe6\xc5\xe9{\xe9\xf7I\xde\x15\x1f\xad\x8dVN\x1f\xd4Z\x83#KoIn\x0d\xe6\xe2\x10l\xd3Fu\xcfO\xb8\x9b\xdc\xe7\xc5\x86)j\xfe3\xc7\xbd\xe5$\xda\x16,:R8\xe7Ew)q\xb0\xa7.\xbe\xb5\xa2\xf8.\xd7v\x81/\xfaO{\xd9\xb1$\xdc\xb6\xbd5\xd6\xce*\x99\x16%\xcd\xf2+!\xcd\xf8WD\xb3\x07k\xab\xc2 \xf6\x8dW\xe1&\xaez\xcc\x99=X\xc1\xaeA\xcej\xaaI\x92u;-J\x0e_^w\xaePg3\xef\x8d\xd2P%%\xde\xcb\x9b>C\xe0\xd8\xe2NtO\x0c\xf9@\xbf\xd0\x87\x84\xbeC\xd1\x0e]\xe6\xdb\x1e_z'\x12p\xc2\x07C\xee\xd7\xdf^\x1b\xc2\xc4\x00\xb9\x82\x9em\xfb\xc0\x15\xcd\xacQ\xf1I\xb4\xdd\x916\xf22K\xb44\xda{\xb0\x8bMTve\x16eW\xcc\xfa\x82Fr\x0a\xd7\x1dR\x93[%\xa2\x80\xa1\x1a%\x82\x0bk\x96{\xaa\x98\x9e\xd1\xa5\x004\x1d\xb9h\x97\xe0x<\x89\xaf\x0d\xcb\xfa\x13\x163(\xef\xb0-\xd0\x13'\xcd\xa5f#YS>x&\xff\xee#z\x1b!VK\xbf\x02\x92\xcb\x1e#\x8b\xd7\xd7\xd46J\x8bL\x1a\x84\xd4\x800s\xcaq\x9cB\xcdb\xdf\x18\x0d\xfe\xe9&u\x95%\xbd\xacU\x89\xd2\x83\x87\xe6\x05e\x08\x96\xa2.\xdd\xd8UL\x7fH\xd2\x8b\x0do\"g\xff\xd6\xfc:\x85\xb8J\xec\x8cP|\xe0\xfe_\x8ak\xe8\xea&\x0c\x89\xca\xc7\xbb\x9c\xd9\xa3\x11N\x1e\xdb\xbat\x9e\xdb\x80\xc3\\\xa6\xca\xb0\xcb\xeb\x0e\xe5\x95\xac\xf4n\xa0iL\x84\x03\xe05\x02\x85<)j\xba\xfe\x12#~\x9e\x0ex\x1c!*\xefO\xe1\xf5l\x97;\xa4w%\x9fF\x8b\x93y\x0c\xd9\xf5U(\xabM\xd3\x07\xed\xe0\xa44\xccl\x94\x9b$&@0\x91\xc8\x0ah\xb0\xfa&\x14\x9f\xfdg\x0a\xf3zt\x05m\x84\x13}\xcb\xbb\xc8\xccA\x81(\xff\xd2.i\x08\xeeY\xb0@r\x84\xe0P\x10l\xe1'\xc1y\xd9\xbc\xff\x1a\xa5\xab [\xf8\x0a\x82:\x05\x01\xaa&V\xe9\xc9\xfc\xc2H{\xf7c\x0e\xfd\x96\xc2\xa2\xe2\xbc\x87\x0c\xe8u\x04\x0ez\x98\xf4\x09\xf7r\xf3\xbfL\x80\x86\x0a\xee\x7f" +
+	"\x97\x01\xbd\xbe\x8b\x0c\x0dY\xf2}\x89\xba\xc9\x03\xed\xcfi\xa1\xf7\x9f\xc5\x97B\x9b\x97\x89E\x96\xeb4%\xc9\xbb\xe7\xce\x90\x95\xea\x0c)dj\xb8H\xe96\xaf%~C\x0b\x89\xd6:\x03h\x96@\x93r\xcc\x9a\xd6\xdc\x0c\xf9\xad\xe1\x9a$\xdf\xe4\xaa\x9d\x03\xf4\xc9\xfe\xb7z\x97\xda&\xdc\x7f\xa2\x8b\xbc\xf9\x1e\xb8\xae\xca\xf3D1\xb2\xabp\x86\xcb\x9e\x87$'\x04\x04\xec\xcc\xe7\xcb\xcd\xa0K\x06\xf0K)|\x88r\xfb\xe6\xaa\x1buO\x05\xa2\xacZ3>a0\xf4.\xae\xdd\x83\xe2\x17\xda\xa3\xfa\x1f\x9fEA?]\xe1$7Q\xbfK(l\xf6m\xc5\x996\xe5\x128\xc9\x0b\xbc\xb9-@\xb08[M\xeb`\xd0\x0e\xde\xf4P\xefO\xf8g\xe4\x9e-p\xaa\x8a\xe6Fi\x1f\xb8\xedAS\x11\xc7.r\xac|\xba\xf7\xe2t\xbc=\xeb\xbb\x8b\x1a Y\xba\x01\xa1G&,m\xff9z\xca\xec`K\xedu\xcaj\xf2\xc4\x08X\xb0\xfb\xffr\xf7\xe7\xf9i+\xdb\xf7\x00:\x15\x86\x10\xdc{x\x80\x04\x8afq\x0e\xc7\x81\x10w\x18;\x0e\x1eI\xd5l\xde\xa7v\xbfK*\x09'\xb9\xdf\xdf{\xef\x8f{O\x0cBM\xa9j\xd7n\xd6^+\xb6\x1c\xccj\x01\xb6\xef\x0a\xe7\xde\xe7\xd6\xfc\xa8I\x8c\xae\x11\xcf\x817\xbd\xcd\xf5\x80\xfa\xce\xea\xaa\xe4\x0a\xa0n\xa5\xf0\x00\xc5\x898W_k\xd0\xe6\xde\\v\xcf\xa7\x89Zt\x11\xc2w\xf4Q\xbe3\xb8\xf7X*`9]v\x19=4h\x19\xcb\xc8i\x99\xe8\x9b\xeb\xac'\x0b\xf2m/q\xa9/!\xb6\x84\x13\xee_D77y'\xadE\x94\x0a]\x89\xc8s\x94\xb2\x8e7\xb7\xbd\xc0\x955\xaaD\x18\x1e&\xa2z?\x14\xd3&\xb7_\xba;6\xeb_\xde\x93\xe6\xcb\x88\xee\xcd\xad]\x01\x88\x07\xe6\x13\xe0\xa65A\xf0G\xd8\xc4\xa6t\x8a\xb3\xbe\\\x0bUO\xdb\xb8\xfc\x9c\xbd\xbb\x95\xc9\xde\x0f\xe5\xc5\xfd\x02;\xdesk\x9f\xae\xc0\x9f\xf7\x9d\xda\xe4\x0a \x98\\H\xd9\x97k\xac+%9\xc5G.\x9c\xc9\xceu\xc8/c\xff\xe7\x96\xb9\xfd\xd8\xb7)\xfc\xbc/+\xc7\x9e\xaf\xdcKJ\x06&6\xb2\x92Q\xbf\xbd68MG\xe6\xc6\x09\x9f\x0aW\xeeO\x14C\x18J\x9f\xde\xdex\x0f\x02\xf8\x0e\x94\xcb\xf4\xc4m\xe1\xf6\xb6\x8b~\xfei4\x9e\xc2\xc67\x87\x16\x0a\x81_L\\\xc0\xea\x1dK\x0bg\x86\xdd\xb7\xa4\xcd?\xcd\xd79q/\"\x97t\xa9\x10\xedP\xfe\x00\x0b5\xf94bT\x9a\xc1lk\xac\xc9\x19\x93\xbf\xfer\xfe\xbftD\xa7_.\xfeb-\xfe\xd2\xc6v\xd0\x05\xeb\xdb\x18O\xa8\xb5M\xbf\\\xf5{\xa2d\x0e\x80NzeN)e\xa5\x81\x02\xfc\x17\xe7\xcc\xc3\xfe\xc48\"*\xff\xae\xcb\x15\xdf/6\xb3\xbc\xc1\xcb\x94\x08\xcc\x0bg\xe8\x99\xd4\xe3\xf0{\xfe\xb7\xf1\xad\xb4\x8b\xe0\xa4Z\xf5\xf4\xcb\x9f\xde\xf9t\xda\x9b\xfc\x8fK\x9cT\x13\x8e\xdf7\x14z/\x14\x89\xf2\\\xc6\x02t\x00\x11\x96K\xa0\xc6\x12\xcb\xe7\x14\x8b\xa7S\xbfJT\xdcH\x9b\xe4\xcc\xe8\x11v\x0c(\x16\x88\xec\xbe\xff\xac\x17\x9d\x17\xc7\xc9\xce\xc4\x96\xbfW\x8a\x9f\xc156\xbd\xf47\xb7\xb6\xe6\x8c{7\x86l\xebtz\xd5\x9f\x0b\xfc\xa6B\xba\xa82I\xd4\x08\xdc5\xcc\x1d\x8a\x99\xc1\xc1\x8e\xd2\xf2\xbc\xb9.\x01V\x94\x1e`\x18\xa72uk&\xb9\x14\xd0\xc1\x91.\x98~\xdb\xd8&.\xf8\x92\xdbX\xee\xe9\x00\x0d\xf3\xdb\xd3M\xa5\xa5\xc7\xb1\xf9\x83\xb8rg\\\xa9;\x0a\xa6z\xa1\xf5\xda\x87\"\x84\xe7\xcb@.a+dO\xe06\x97\x1c\xa5\xe9\xd9\xf4\x7fj\xca\x1dL\xe1\x0fM\xb9\x81#\xb0)\xc7\xf6\xee\x93l\xf8\xd9\xc5\x896|\x85n\xcf)6\xfc\xec\xf2\x0fl\xf8\xd9\xd5\x9fZBGY\xf2\x7fh\xc3\xcf\x0cE`2\xb2B\xfb\x8b\x95Q\x12k\xc3\xb9C~\xf1\x8f\xe23\xd8\x88y\xa7\xed\xe0(.\xb9\x89\xcbp\xcc\xd8\x96\x01K_\x04\xa3}\xe9\xa1\x8a\x0f\xef\x89]:\xd6\xee\x9f\xf7\xd4\x04Tx\xc6\xf3\xe9\x9f\xbe\x1d\xa7w\xeb\xcf@\xc14\xb4\x8e9\x96,\xad\xe9\xf4\x9e\xd1f\x87\xf4\xb7\xa6\xc7\x1a\xacXq1Z\xc1\xd2\xd3\x92\xea\xd3\xf3|\x86+\xc2\xce\x10}\xe7\x1d\x10\xe5l\xec\xb4\xbft\x0f\xacXJ\xcc\xcb\xe5\x9bt\"bR\x97-e\xe1\xf6\x0d\xa4\xca\xffU|\xe2\xeb\xbc8b)RM{\xdd\x10\\gj\x09%\xe0Eq\x0du\x0b\xd9\xf8\xe7\xf2\x1ey~\xebb3\xdc\x9dH~g\x05SZh8\xfe\x17\xd6\xf7\xe2\xcb\xdf\xb3\xbe\xa6\x96/\xd6\x97\x195N\xb1\xbf\x17g\xa7\xd9_\xce\xef\x9cf\x81/\xce\xff\xc0\x02\xbb*\xfbo\xad\xf1\x8b\xcb\xff7\x16\xf8\xe2\xcaY\xe0\x9dH\xd4\xc0\x0c\x82;\x89+\x98YK)\x88\x17\xcfu\xed\x15dO3\x0cN\xf4\xec%\xec\xd3\xd1\xe8.=\xc6j\x92\xb0\xbe\xc9\xab\xc1\xfb\xf8Y\x9cS\xce\xf8\xa7U\xc5-}\xbc\xc0E\xc5\xd0\x0b\x00\xa0p~\xe1\x81.\xff8\xc4\xb8\x9cv\xb3jFZ\x87\xe8\xf5\x19\xaa\xb6/&b\xa7\x97g\xbd$F\x09\x90\xcf\x9d\xdd]\xcd\x1c\x02o\xbf\x0dH\x81M/\xcf\xfb\xcc\xa8(\x0c\xe4\x10\xd9\x9a@V\xa5\xdb\xd4\x85`~\xae]u\xc8\x9c\x14~p\xe6\x80qr\xbc\xc7\xff\x06Vnz\xd9\x01g\xae\x12\xe8z\x82M\x17\x98\xe4[\x91\xb43B\x14iQ\xd1\xdf'\xc0\xa5\xa6\xaeo\x1d\xdfc\x1a\x0aDu\x00\xf4\x0cxp\xd2;\x80}\xed\xf9\xd3\xdb\x99\xab^\x1f\x89Ah\xc5c\xf4\x06\x0d\xe2Tf\xda \xcfRa4n\xb2*\xf8\xb3b\xac\xf8v\xfe'[\xc4\xe5_\xec{\xb8\xfa\xd2\xd9\"8\xd7R\xc5&\xbc\x9f\xb4O\\MO\xdb'\xf8\x94\xa7\xec\x12Wg\x7f\xb0K\\\x9d\xff\xa99\xb9\xba\xf8\x7f\xb3K\\]\xba]\x82\xb8\xda\x0c\xc7_Vh\x18;\xdd\x95s\xd5\x9f\x99\xc5L9V\xcc\xb8n\x1d\xda\x93[\x0f\x17\xcc\x03\xa4U\xbf9\xaf\xb1M\xd9\xab\xbf\xba\xfe\\\xaa\x01\xa7F1\xd5\x80\x18\x93\xd2S\xde\xf4\x80\xb3a\xcaP\xb6\xc7iu\xe11\xc5s\xdd\xfa\x12\x97\xe6\x12\xc8\xffk\x99\xa3f,\x97tm\xf6\xb4,\x89@\xa7\x1a\x85\x1eL\xaf\xa7Y\x85&I\xb7!\x81\x82\xd9\x86\x12\xd4g[\xde*\xb4\xd4\x8dI\x07!\x93\x1b/\xa5M\xaf\xbd\x80$\xf0\x919n-\xb4W\xef\xcc\xabr\xe0\x0e\xb5w\xd9n\xe32<\x0f\\\xe0\xe2\x7f\x9a\xcc\xb8\xbe\xfc{\xb6\xf2\xfa\xca\xdb\xcaI\xceI\xb7\x86\xbe\xab\xef'\xd9\xcc\xeb\xeb!\x9b\xf9\x9a\xa6\x04\\\xe1\x04Sy}\xf3\x07\xa6\xf2\xfa\xf6OM\xe5\xcd\x97\xff7\xa6\xf2f\xea\xfa\x19gz\xdf\x0b\xa2\xe2\xa0\xb1s\xcd\xa8\xd6\xa4\x1c\xca\xd3\xd2\xd5\xd2_\xbc\xbc\xd3<\x1c o{PG\xad,\x90<\xbd9\xef:\xa6\xdbtq\xbd_\xa2\x96ZC\xff\x03\x13~\xeb\xd1P\x08\x0e\x9b\xae\xdfY\x93SZz\x86\x8b\x91l\x06l\x00VN\xf1>\x19z\x9a\xc0\xc3\xf1\xf5\xcd\xe5\xdftfo\xae\xfa\x12\xe4C\xeel\xc3\xed\xc2\xbf\xb0\x11\xa5r\xbdeC\xf1\xfd\x8d\x07/\x1e\x12\x08\xd7&1\xe2\"\x1c\xd2R\xe8k8\xfe\x1d\xa7\xf9\xa6\xaf\xb5\x07\xb32\xa4jr\x92\x08\xee\xf4\xa6\x9c\x97P\xdf\xb3\x87\xd7\x02\x9a\xa3\xbf\xe7z(\x9f\xf0\x97o\xbf\xfcO-\xb4\xab\xfb\xff\xa1\x85\xbe=\xcb!\xb1\xe8Q\x10\x83\xf5\xe9\xb6\xc5\xd4\xfe\xa9\x11}+=\xf7R\x82\x1c2\xabZ\xe1'\xb3FP\xea\x85qq\xec\xfa\x18\xde$\xb5\xca\x1f\xb6\x8a\x899\xd2L\x1a\xaa\xf8\xdc^\xf5\x8c\x08\xbe\xc2\x97\xe43\x9c\xb0\xd4o\xfb\xfa\xc6\x9dL9\x85\x94\x040\x82Y\xcdjE\xcc\xc8tb7\xeb\xedMF\x9d\xa7L\x84F+o\xab\x1c\xdfkp\x88\xca\xaf\xc1+\x1d\xec\xc3\x9aO#\xaeKO+\xfd\xf0\x8c=\xfb\xf2\xa5\x83=\x86\x9d\x9e2\x83\x13'\xb7\xde!\xa1\xee=\xe3\xd4\xc9\x92d\xca|\xe9\x96E\xfco\xaf6\\\x82L|\x85%l\xce\x99\x83\x148N\x81m\xf8\x00\xc21\x89\xcfX\x06\xa4\\\xcc<\xfbrn\xcff\x84\xd3\x11\x10\x83iV\x98\xd0\xbf\x06Nb9Lga\xcb\xcf\xc2\xb2\xce\xa0T\x0a-\x10\xa5\x13\\\xba\x130\xeb*\xc3\xa0w\xc4\x04\x81\x04S\x03\xaf2\xc3\xf6\xde\xeb\xf6\xdb\xa9\x1c\xa3\xe0I\xf1\x86\xae\xb3|L\xf8\x88K\xa4l[\x96N\x88\xf7Y:\xe1MwO\x94\xfc\xb3\xc8\xdd\xee9\x9bS\xa9\x0e\x8fX\x93\xd2\x995\xc4\x81\x90\x92\x00\xd1\x0c\x8dJ\xd2a\xc3\x8d\xc1\xd3\x8e\xc7\x87&\xb6\x92NL\xe6\x99\x07\x0d\xb1\xc2I\xa6\xd9I\xbc\x8c\x8a\x97\x86q\xaa#\xbdg\xf3\xe9\x02\xdc\x0c\xed\xfe\x8d\x92\x9a\xb0\x934\xe5i5=\xefrl>\x85\xe3\x18\x07\xd6\xac\xcf\xa4\x0f\x96\x19\xce\xa6\x17\x19H\x1d\x04\"\x8f0j{m\xa4_\x0f\xb6\xb2\x9dM=\xd41\xb5\xaf\xff\x14\x11W\xeb\x17\x09\xb0\xe2\xd4*\xf8\x99cA \xb2\xb47T\xfe\x9c\xa1\x98\xd1\x1dC<\xf7\xd4\xb6{\x8a\x1fsf\xf5\x80zY\x1cO\xdc\xec\xcf\xa6]\xe8/\x8c\x1d\xb4&\xb0n7\xe6*z\x7f\xee\x13\xd9\xb2=+\x9d\xe0`\x1b\xdb\xd9\xd9\x17?\xe3&\xea\xae\x88\x82\xf2\x86\xca\xa0\x85'\xd0B\xbe\xf6\x81n\xb9#\xfd\xc8]\x18KY^'\xad\xab3\xcb\xf6cXt\xbe\x12eK2\x970D\xeb\xf0\xa3xc\xe7\x06`*\x0a\x8cf)5\xde\xc5c\x97\xbb\xf0\xca\xcfr\x1a,\xa1@B\x1e\xd0-\xd1\xa0\x8e\xa2\x1d\xcf\xce\xfa)\xa6U\x7fV(u\x1bd\x0eo\xb1U\xa1x>\xaf\xdfOg\xb1\xf4\\\x8e\xb1u\x08k\x7fvv\x9d\xe7j\xfa\xf5\xee\x1b\xa3,c\xd1\xd1\\\xc11\xacd\x12\x84\x0b\xd31<\x98\xa2KU\xee\x9a\xd4\xc7E\xf8\x91\xafu\xf0\xea\x89t;zH\xda8M\xaf\x1fX\xe1\xbc\xed\xaf@ku\xed\xd4V\x97\xe9\xa1\x86)\xad&\xbd\xfcS\"\x04\xd2\xa8\x1a\x83x\xb4\x7f\x9b\x01\x0b\x1a\x01\xbeB\xd1\x9d\xd5\x85\xaaQ[x\xde_\x11\x9a\xf3\xcc\xa2\xd7HJ\xff\xb1I\xfe\x9bM\xdc\xbe\xc4\x05\x7fCn\x97\xe4\xdd\xd2\xa74\xe7\x90\x16\xa39\x81%\xab\x87C/Wr\x96\xb3\x93\x0c\xfc\xc6\xa4\xe3Q\x8b\xbc\xe2\x15\xb3\"\xd9\x9cX\xc7U\xe1\xf2}\xecp\xee&\xd8\xd1j\xb8O\\\xe2K\xc5\x12O\xbaOM.<fb\x9a\x11\x8e,j\xf9\xc6\x00\x8a\x88\x1a2!\x19\x18\x8f'\xa4\x0b\x01\xf1\xfe\xa1V\xff\xfe\x04\x9c\xb4\x88U\x9a\xa4\xa5\x8d\xee_\xa0\xb5\xddaah\x8f\xd4\x9f\xa8+\xc2\xf9e\xaeQ=bh\xb5\x8e\xcd\xc4\x1c\x91\xa6\\\\\xe1\xb0\xcc\xd3l\xceP2o\xa9\x04[\x82\xea\x17\xba\xf2\xe1\x8a\x08\xfb\x16\xce\x18\x10~\xe3\xad^F\x1e\xef\x9a\x11l\xcc\x19AF\x82\x95\xed\x9cL\xc3iHk/|d\xfb\x19I\xc9\xda\x1a\xb2\xee\x06O/j\x8c\x93k\x8d\xb8\x0fn=\xd4QH\xef\x1e\xc9\x06\xe3*\xec\xc2\xcb$.Xv\x13\x86\x88\xd4\x81a+\x0dO\xd8\xbef\x14\x91S\xfdo;\xf1\xf7.M\x19qf*\x09\x1b\xd6\xec\x9e\xe1$k}\x9a\xb7\x19\xe1\xfcr\x1d\x16`~\xa9\x9b\x91\xa8>\x89\xd2\x93-\xb9\x949y\xf2\x9d \x86>u=\xd1\xa8\xc4\x8f\xdc#\xec\xd6\xdc\xc7\x16\x9c\x9c\xb0\xc3\x82\x8a#!\xa9Q'\x17Z\xc2\xb5\xc8\x8aL;Gc\xb3\xa9=\xd4\xf1\x0aqO\x9f8\xdf}\x8a\x84HO]\xce\x94\x9d\x17\xe68\xb3\x9e:M\x9c:\x89\xe8u\xa8(\x08,D\xad\xf7\x03y\x11\x07\xabJ4~\x12\xfe\xd3\x1e\x9bV:\xd8\xf8%\xd3N95i#\xae\xccz\xf3\xd8\x03k\xf5l5\xd9\xb6\x81\x05\xcf\x95\x81\xe1\xb4\xed\xa0zQxB\xf7\x085q\x0e,\xa9\xc3\x04\xcf\xb5Q\xeee\xf7\x02\xe8\xce'\x99z=\x8b\xe9.5\xf1\xceN\x06S\x81)\x07\xfa\x09\x98Yc\xa6\xb1\xc1j\xe2o\xce\xde6\x9b\xda\x96\xdc\x0f\xd1\xcb\xe5D\xf8\x912b\x14\xca\xbe3\xe2\x0b\xf5\xa8\xa9\xcf{g\x89\xcb\x9cR\xbcc\xc1\x11\xaf\x1c\x1fx\x8c\xe5\xd2E'\x0f\x98v\xae\xfc\xa3P\x97\x8d\x944\xa8\xc7\x89s\xe8\xa0\xa7\xc6\x7f\xd0\x1d\xcfd\x8f\x0f/\xb8h\x06\xa9\xdc(\xdb#l\xd0^5\x88\\[u\xbah\x05\xc0\xce\xc4\xa2b=c\x0b\x87\x86\x0d\xda\x05r\x1dY`\x85u,7(\xd7\xf5.\xc4h\xee\xd1cc\x1f\xfe\xf4\x9c\x91\x03T\xef\xd8\x8f\xfb\x891\xaa&3\xcc\x8e\xe4\x00\xe3k\x1a\xb6\xa5\xecp\xa9R\xb2@i\x94c\xac=\xed\\X\xf3\xa7\xb4\xf9\xf3\x1a\xfd\xca/\xe5\x89\xcb\xb9K\xf2\x86<\xd91\xf4\xef\xffT\xf5u\x1c/\x0euI'\x85-*\xaf\x92\xc5\xe4\x84FG+e\x1av,\xd9\xee\xe6\x09{!8wi\xe3I\xf5\x1e\xf6v@\xd5\x03\xc1\xceu\xb7\xef\x10I\x1f\xb6\xe3\x1cx\x989}\x86\xc4\xac\xd3b\x0d\xafi|\xd2z\x85\x81\xc7V\xc7\x04\x0cy\xe0\x0d\xbd!\x15U\\\x818,\x87\xf4\x0cc\xccXg6\x0fY's#\xfb\x0d\x8bJ\xcb\x08\x80\xe4\xdc;\xe6\x93jfA\x07\x84\xff;%i\x1b!\x0e\xc3\xc7O>\xcc]\xfan\x09\xff \xff|9\xc8\x91\xc7\xbb{:\xd7\x13\xc6#5(_\x7fgA\xfb\x057\xe2\x91\xc9\x09\xbb\xae\xc1\x91y\xcb\x9a\x0c\xe5b\xca\x99\xdfw\x9e\xc2N&<\xee\xa0\x9c\xee\xbag\xacY%2G\xfd]\x88\x0d:\xf8*\xda\xe0\x14\x1e;\xde>\xf4\xa0e\xd91xa\x95X)\\\xd0\xe0M\x15\xb3\x9d.\x0cw\xf7\xcc\xc4\xd3^\xbf\xffQ\xb5c\x92o\xfc\xcf)\x0a\xfe\xa8\xa7B\xea\xc1\xe2\x919&C\x04_/G)\x00u\xb8*&\xb8X\xa9tYl\x9c\xb7+\xb2\x1a\x03\xaf\xb1\xd3\xd9r\x88K\xda\xfb\x01\x14\xf0\x14\xe7\x02\x82^t\xb9/\x87\x12\x83&\xab\x85\x8c\xa6\x00\xd2\xc7\xc0\x18u\xad\x9f\x990\xbeB!\xe5;)g\xa0G\xb9\xc3\xa2\xffQ;c\xc4/a\xb26\x94\x83+\xa7S3\x92\xa4\xb0\xeb\xdbQ\xea\xf4\xaa\x94\x80\x96\xb9\xa0\xee\xd0pI,_&q9\xef\xa4\xd0p%\xfb-\x07\xbf\xfa\x93\xe8\xff|\x9aMW\x9a8\xca\xea\x8e\x96~\x87\x04\x86\xb0/\x0e\x84<\xe7g\x9f\x18\x9d\x94n\x93\xf3\xce\xb8\x87`/\x90_\x9a\x1dsP\xde8b\x19\x0d5I\xd8\x0b\xd8)A\xfe0\xdd\xa0c\xc8xP\x95\xf1\x06\xf6VRk\xa4OR\x0bB8\x88\xb5\x97\x95\x17k\x96lka\x8f\xe3\xa9\x13[\x9d<\xc2\xf4w\x1aU!\x97exTHK\x10Oe\x9c\x11)f\xac\x05k\xbb\xb2T\xabD\x18\x884i\x1c\x9c\xe3g\xcf\xa4\xa4z*/\xc6yN\x9dD\xf2V\x07.\x95\xcb\x82\xda\xc2\xd0\xb7\xd4t\x8b\x14\x8dD\x81/\x8b\xe8\x94@\xd0I\x83zZ=7\xdde\xf7\xdb\xc5\x1a\xdf97-l\xd0D\xd7Xv\xd8\x11\x1f\xb3\xae\x07V\x8d\xb6Gt\xb1{\xf2@\xc8r\x9b\xbc\x10X8\xcd\x08\x9bb\xd8\xf0\xb3\xea\xe0\xc0\x93,\xc5\x11\xc0\xf1\x92&t4\xaeH\xa6\xc6\x9b\x13\x87W\xf0;\xa2\x8d\x12\xd2\xa8\xce\xcb\xe4\xd6\x02\xe8\x91+\xdc`\x0e\xae\x18Z\x88\x94\xa7\xc9\\\x170\xf8q\xa57\xcbu\xec\xd3\xedA\x87R\xee\x88\xe4\xc6\xd6\xec\xa7G\x95t\x08\xe5\x84\xc8\x931\x97\xe5\x82\x05\xfe\x8e\x89\xb0\xb09\xf6\x8e%\x9fa\xfc'\x94\x97o\x87\xd0]\x17\x1d\xd6UCV\xb7\xc4\xe0\xee(\xfa\xfc\x99\x1c\xc0\xd8\xbdb\xa1\x0a\xe8\x06\xc8\\\x008p\xd6M\xcfx\xe1\x06\xb9\xb4\x01\x91K\x10@A\x9a4\xd7\xb6\xe9z\xf4\x1a\xc2Z\x1c\xff\xf4\xcc\xe9\xff@uYrZ\xf8\x1d\xe5L\xa8rH\x9fU\x1c\xbc\xf3\xc1\xfc\xd3\x99bN \xcb\xb2\xc4\xbd\xab\xb4e]t\x08h\x97\x92\xaew^ \xfc\xd3:\x05;\xf2\xa8Z\xba\xf9N\xc5\xd0gt\x07\xfd\xaf\x8b\xb3b\xaa\x84E\xbc\xc0@.\xc2\xb3\xde\xd0\xc2\xc4\x89\x94#C\xe7'\x0b\xa8\xa5jUK\x95y\xab\x81\x19\x97\x9f\x07W\xc4\x85E\x8d\x82\xc7\x196\xbc\xdfa\xf9B\xc87\xfa\xddZQW\x92\xe35F\xa4\xb0\xa7L\x1fnz\x84\x94\x04\x04\x1e\x8c\xb6\x9b\x96\x83\xb5}xps\x15\xde\x12v}o\xe1\x9fi\xdd\xf2\xce\xf2\x8a\x94&G\x0c6\xb0?\x97\xcc\x86`\x8eW\x13>k\x0d\x1b\xc2\x93\xde7v.\x86\xbd\xbe\x05\xc7\xdf\x8f\x09\xa5\x1dki\x90Gr\xe0O\xa1\xfe\xecv\x8a\xcf" +
+	"\xa5i/.\xfb\xd4\x88E\x10P\xd8\x1f\xa5=\x8c\xe8xMw\x97\x08\xecn\x986\xc5\x95\xa8\x0c\xa5(J\x1b Mn\\\x8e\xf3\x87\\\\\xf5Yq\xedC\xa8\x89\xee[!!\\\xe5@]\xa4\xefb\x97\xf0F\x0f\xcc8\xd3\x18\xaep\xa4\xee\xf1\xd3\xcc\xe7\xdf\xd1\x9bl>\xc1Z\xba\xc1\xc0\x9d\x12\xb7h\xa6\xb7\xda!\xbe\x90\xb9\xf1\x0eq\xf9\x9e+\xbb\xcb\xf0\xa47\xd1\x0f\xaeO\x1b\xf3\xae\\\x0fr\xcc\x898\xca\x96\x87\x12\x96\xf2w\xca\xdd\xa6\x7f\xec\xe3*\xbc\xff\x8d6\xa6\x8b\xdb\xbe\x81H\xb6}\x03uo\xab\xd4\x04#\x8c\xca\xfc\xf0,\x1e\xfa\xfe\x03_\xee\x92\x12?Kza\x04\x16\x86T\x04\xf8*ho\xe0W\x0b\xf1\xfb&\x96\xb6\x92\x11\x98\xcd\x18\x01j\x13k\xb5\xd6\xf0O\x16\x12Z\xd0[\xb4\x9f\x9e\x9a\xde\xbat}\xc7c)`\xba\x98h\xbal&\x99\xba\xbb&\x03N w5\x1b\x01\x17W\xa9\xeb\x0e\x7f\xfe\x88tIF\xb5\xc9\x94~\xcbpN\x965\xb1j\xd9#j\xb1\x8ei\xb1T\xfa\xf6T\x1ceL\xfb\xa5\xd7\x0di\xd2\x02\x9ad@y\xa99\xe4\x8d2\xe9-@\xee\xf6\xbbHO\xe0\x02\xdf\x0eP2]\xda\xc6\x9bo\x9a\x11\xf5\xa7\x06'x\x83\xa9\x9f\x15\xc6T\xf9\xf6\xb5\xa4\x1e\x88\xc2\xb2\x06\x01\xc2j\x9c\x95u\xe6\x00\xb5\xf7\xc8\x8a>\xb1\x85\x16Q<\xf7\x84\xea\xe8V\xc3\x1f\xff\xc6J\x1ak\x0c^lg\xd8\x8ce\x16\x86\x03\xbc\x91\xf0\x1eW'\xf2\xbe\x16{Z\x04\xfdP\xcb\x96\x9a6\x1c\x9e\xe2a\x93%,\xcb\xad+N\xf1\xf5\x7f\xd0}b:\xe9\xe6\xb2}\xd8r\x89*\x97\x09D\xc9\xd7Ex\x92o\xa0z\x0bb\xb2\xff\xfa\x88\x0c\xee\x9bl\xc8\x00\xaf\xeb\x17\xcb\x13@\x8c\xcf'\x08\xc9\xb2V\xf7$\xefr\xca\xe5\xba\xe9\x8d\xb1\x98\x13\xa3J\x91\xb1x>\x81\xfc/\xa2\xf7\xd0\xe31\xb3\x8fN\xcd\x01\x0a\x9e\xa4\xc1\\\xf1=\xec,0\xf1\xeeX\x08\xf0\x98\xedm\xf8\x9a\xd4\xa0\x84\xa7\xe2\x02\xb8\x9a\xe6\xc3\xa0\x0a\xab\xfc\xcc\x10E\x90\x8d\xe6\xaf\x91\x1e\xbb\xd2B\xdd*?\x84\xbf\xdccq/\xb6\xfa\x15Kfzek\x00\xe4\xdc\x87\x97\x91\x87\xf3@\xa8\x8a\xfb\xc2\xca6\xf3\xea,{\xc4\xd8b]\x967\xef\x9f8{%Ar\xa4\x05\x16[tB\xf9\xf56\x98zn\xf1)\xf0\x15=\x92\xf5\xfb\xc7\x8eX\xe1\xf6\x99^\x9a]\xf3\xd4\x1f\x88\xbb\xe3\x08_\xb0\xdc7\xa6\x12X.G\x9cXF\xf6\xa4\x85\xcd\xf3u\x8b\x15Y\x16\xf4\xfc=i\xfa\xd2{\xd8\x91\xf3kI\x0b\x8f'\xad\xba\x8b\xee\xbb\xd8\x86\xa7\xb0\xa6T5.\xc1\x1f\xbc\x11\xb6\\\xf8\x92\x87x\xe1\xfe\x99\xee\xb2kN{\x03\xa8\x9f\xb5c\xbf\x09V\x93p\xcf:9\x97\xe1>\x93\xabKg\xca\xdcL\xcc\x90 e{\x82&\x19'\xa3b\xd8\xc8\x12\xc6:s\x85X\xa7z9\xc6Wi\x18\x94\xb7\\\x83$1gj\x16\xe8\x85\x08\x19\xe3\x01\xdf\xbdR\xad\x87\xa9\xbd\xf9K\xf9\xe8X^t\xd7\x96\x19\xd2\xa5B$\x17\xc0\x1b(.<\x86B#\x997)\xc43\xd9\xed\x12\x8f\xfc\xa6\x9c\xdd\xb4\xeb\x8e\xb14c\xe4EH\xf2\xa5\"\x16\x186\xef/a\xc2\xefI\x1f\x19\xca:\x1c8\x04\x19\xef\xe3\xb8\xca\xf6\xba\x834\x13t\x02\x01\xcd\xfa6aS\xf6dO\xe8l\xb8\xfe\xd2m~{H\xfdtn\x077\x8dz<MQ\x93N:m\xd9o\x1f#\x89nr\xec\xe6.~\x95QC'\xe9\x88\x19\xab\xd3W\xd6\xf5\x99\x1f\xb8\xa3\xb2\xa2\x8b\x10h\x0a\xf5Q`\x98\x80^\xd2\xa0\xf2\xcc\x10\x9a\xe1\x86\x06\xd7g\xeb\xba\xa0\xc2sl$/\xa60i\xf6\xd9\x90ca\xd9\xbf\x0f\xbd\x0e\x8dZ\x99\xb11+\xbb\xe1\xf9\xdf \xe2\xdfg\xc0\xfc\xa1\xfa\xdbuG[\x13!FX\xa3\x0e\xdf\xcd\xc4\xeb\xb7\x9a8\xcd\x09\xd4\xdb\x81\x86\x0e\x11T\xdb\xc5\xe20\x82m\x9c\x877m$\xeaiK\x92\x91\x9cX6\xe8Z\x94\xa0!\x99\x11+\xacD\x0e3\\\x9b\x8e+\x81\x9d\xe2n\xf6\xfd\x14\xd8\xa9\xef\xeb\x95\xf7\x93\x1e\xe1'\x09Hi\xda\x1d\x08\x98\x19\x96\xd1\xd767\x00\x84t\xf0\x8c.\"_3r\x0b\xfb1\x88v\x8f\xc0in|\x85o!\xd8\xba\x83\xee\x845D\xab_'\xb1\xe9~\x99\x92F\xf8\x0f\xf0\x83\x00\xddC\xea\x7f\x8aay\x1a\x88 n\xa6\x85\xceR(E\x08\x8c\x0fu\x9c)\xb9G,\xb5\x1cB\xc3\xd8\xcbM\x19\x18\x1f\xc3ns\xc1I.\xa4s\x8e\xb4\x19g\xeb\x96\x87\x9a\xf3\xa8\xcc\xb9\x88+\xa5(#\xb4!\x05\x95'\xd8\x9b\xbe\x1b\xff\x83\x96NZ\xb4\x95\xe4\x10\xe2\xd2\xd9\x0c\xdb\xef\xe6\xef\xbc\xa2N\xa7\x01(\xb2\xebg&F\x97%C\xa5\x14\x1a\xb1\xc6Y>\x03/\x1b\x06\xac6\xc2\xa4\xa5!\x1bFHiCs\x86\x90B(lc\x92\xb1k\xa3\xdbl\xae\xd52\xf4\x93\xb4\xa9\xdf-\xecO\x10t\x18\xb2\xa9\x1b jz\xe5\xb6\x16\xcb9>\xfc\x1a;i\x98\x0cw\x01x\xb7\xd2U\xae\xb2\xf6\x07\xd9\xc39\x95\xa4\xf2\xdf\xb4r)\xff\xdd\x86\x03)\xacl\xc4\x9258'0VRDe91\x04\xfe\xa2I\x0d\x09\xe8|\x8c\xe5\x9c\xa6\x09\x12\x94?\x1a\xbb\xff\x86Z\xef\xb38\x0b \x947\xe4\xa7f_\xfa=\x82\x09\x99)\xf2a\x97u\xc1\xc5\xd4&\xaep\xb5\x0c\xd0\xc6\xdf8\xae\x94\xbd\xe8\xb70\x9d\xd5\x0e\x0b\x03\x0a-N\xa6\x87*\x95-\xf2\x14\xc10\xeeU\xff\x09\x0aioq\xe9\xc2\x15C\xcd\xbfK\x0b\xad\x83^\x84\xe4\x01JLiw\xf8`A\xdb4\x87S\xd4\x8c)(\x9f\x10\x9fe\x95\xe2%\x17uV\x1a#\xe0{\x80\x17\x16\xbe\x85gI\xdf\xf5\x83\xbf}j5\xbf\xb0d\xe7\x81\xe4\x1c^\"8\xc9+\xef\xf032}\x85\x83,\x9a\xf3w\x1a\x9b\x9e\xba\x0co\x1d5\xaa.\xe6=\xa2q\x10\x10\x85\xda!$`\xf0\xccod\x0b\x9b\xccw\xf3z\x1e\xc0\xb1\x95\x8a\xf5*\x03}\xe5'\xee\xe9\x00\x1c\xaa\x91\xddz\x05+\xe2\xdd\xafb\x93y,3>!\x16\x04\xbf\xfa\xfa&}\xda\xf6T8\xc1c\xb4\xbdz\x86y\xf8\xd4\xce\xf3[k\xec\xfbSd\x14HP\x8b\x03\x1b\xda\x15\x12\xf2\x1e\xa1 \xdc\xe8Lc\x01Z\xd4\xfbL\xbd^3\xb4\x8f\xfd\xad\x83\x85\xce\xa3\x1b\xd7y4\x0fo\xe1\xed\xffW\xc5\xf4\x97\xfc\xd2\x8f\x8c\xb9I\xf7\xc0 F#Xdt\x01\xb7\xe2~\x9dj\x8ds\x92\x1d=\xd7Q}l\x9fX\xa4\xdc\x98\xc1\xd9\xbcB\xf2N\xfaq\x93\xa6\xfe\x8cJ[qFN\x08m\xe3\x88\x18\x9d\x80J\xc6\xd2R\x9a\xe0O\xd3\xd8\xb6\x13N\x95\x8b\x9b\xd5\xa3\xa41\x02\x96\xbftZ\xabV\xc0t\xc9\x82\xd6\xe2\xaeJ\xa6Vn\xbe?\xfaMg\x82\x87g\xb7\xeb\x1b\xba2\xd9\xe7Le\xba\x0f?\x0a7g1\xf1\xe9v\x0ceB\xefE\xf7\xb1\xb5\xaf\x06\xf1O=\xd0k\x89\xa5\x0a}\xe5\xe1a\xa0\xfb\xfbzX7\x0f!\x8b\xb9x\xde\x1e\xc6\xab\xb5e^\x94\xca6p\x14Q\x83t\xc4\xd8\xad\x8d\xef\xb1}F%\xe69\x19@B*h\x1a\x90Sle@h\xe0A\xd2\xe6\xb7:Ym\xdbS\x87WP\xa2|\x8f+\xaa\x8a\x98a\xae$\x8a\x17\x85z\x06\xb26x\x7f\xea\x12\x9d\xfar\xf4u\xa4g\x0e\xef\xe1[\xe1.o\xf3\xbc\x94E!\xef\xdc\x15\xe7\xb8\xd9\x1c\x19\xee\xdbR\xf0\xcf1\x95\x08\xe7\xaf\xf2\x1c\x05\x0aR-\x05\xd2\xbd$\xce\xe6\x15\xbe\x88\xa7\xcf\x95;}_\x16\x12&\xa6\x1b\xfd\x0f\xdb\x12\xdc\x04B\xde\xcf\xf0!#\xb8H\x07\x81\x1b\x09\xf5\xb8'\x8ccW\xa6X\xbf\xa6P7\xbc\xbb\xe6\x14lI]a\x90\xb2\xfb\xac\xfa\xc5\xb4g\x98\xa9\xf5\x90\xcaSo<\x8b\xd1\x09f\"\x83#\x8b\x19n?5Dg\xae\x8bd\x1fk}\x96V;j\xf6\xa0\xaawty\xbe8\xd3\x1b\xc4\xa6p(\x97\x98\x96p4\xc9\xd8\xba\xb5\xc3\x14\xd4\x0c\xf7L\x12'\x8d\xb5\xde+\xb1s&\x9e\xb2\"_\xb4Y(\xfb\xf0\x84\x89I^\x80h\x11\xd0\xf7iq\x92\xedi\x0e\x8agdV2\xfe\xbd\xe3\x8ec\x98\x7f\x8d\xd0\xda\xc3\xed\xe0\xbe5\x98\xb6\x1ad\xd4\xf6\x95a\xd2@\xaf\xc2\x07t\x85P\x82\x1e\x8d|l\xa0\x1c\xc7\xc0\xa6\xb8r2U\xa6]JP\xa3Kp>\x05F\xf10@\x1d\xe2\x98\x17\xb0\xd5.\x07_\xd0\xeb\"*\x82\xd4\xc0WI\xa8\xae\xdb*\x85\xc8O\xaa\xb7C\xe2\xaf\xdce\x1cvi]\x87\x8d\xa6\xb4(\xda\x81\xedxG9\x1f\xf3\xc5\x91,(\xf6\xdb%\xe4K\xd8\x84\x1f6\xf2\xc8O\x90m\x93\x9d\xf3\xc0\x18\xbd\xc4\x95]\xd8\x90\x85\xfa\xaas\xba\x07\xcd\xc7\xbd5YO\xc2\x1d\xf7\x9c<\xc5f\x92\x0a^\xc3\x8a@\xd3\xab\x92\x8cD?njfd\xba\xf0o\xf9\x8b\x81e\x86\xabA\xfa*\xc7\xc8\xffzob\xabN\xb5o9\x02h\xac\x06\x05\xe1W\\q\x03\x7f5\x9a5\x9fzfY\xb6\xb0=\xf8\x9eGr\xbe\x10N\x11\xf6\xd8\xcd*{4\xbfOZ\x0f(U\xb5b\x07r\x8dm%\xe8\x92%\xa3\xf7h^ce\xdf\xb6&\xef>b3\xc68\xd5\x85&\x8a\x17\xb11\xfa\xf2\xc0\xeb\xcf\xb7\"\x9a{*\x05H;\xa9\x05\x84\xa9[\x12v\xd6\xd9\x93\xbd\x0f\xc1\x9b\xfc\xc3\x1d\xf7\xc4\x010\xc0?5g\xf5\xe8,\x06M!\x9b\x0b\xcb\x0d\xf4\xfb\x88\xac\xf4\xea\xee\xa2\xbb+\xbd\x9d\xda\x0eF\x813,}\xb1\x11i[\xfa\x9a\xbftxaG\xd8\x13\xfb\xc6\xc6B\xed\xc3\x9a\x81\xcc5\x97\xec8\xc5/\xde\x02\xa2\xfc\xb9CG\x09$\xcdc\xf0\x062\xdaU6\xa3\xd3hI\xae\x7f\x82\x9a%\xa4\x04\xabr\xb9\xb9\xe1\xae\x1b&\":\xeb\xee\x05\xbb4\x96P\x14\x8e\xc0\xf4\x8a\x08\x88'\xb4\xa6t]\xf2\x8e\x98\x87\xcf\xd6\xb2\xd3\x9d\xa8\xb4\x81i\x8f\x1a\xec#\x93\xcb\xc7\x86@\x9f\xd9\x85\xd1\xc2\xeb\xfeO\xd9\xd1:K\xcc\xa2\xc2$\xf9\x97\xf8\x1b\x83/\xe2\xb8\x83\x9cCb\xb8\x09\xcf\xb0\x01\x99\xee\x01b\xf7\x0d\xef\xc8\xe8+\xab\x08\x99\xd8I,\xe8\x80\xcd:\xc2+\xf6\x16\x9e\xd4\x0a\x1e\xb9\x18\xb3F\x1a\x10\x8e\xfe\x1e',}>\xdc\xdb6\x83\xd3\x7f\xd5el\x1a\x93p\x8e\xcav\xf3\xcb\xf8<\xce\xb2\x1b\xe3\xc1\xd4RwL\xbf\xbb\x94$\xd6,\xceK\xb7c\xa5\xf0\xa5\x8f|\x12\xee\x10\xf5\xc27\x96\x96\xc6+\xe3\xea\xbfrx\xf8\x80D\x9c\x93\xac\x83\x05E\x1f\xd3\x92\x07\xa7\x1cW\x12\xfa\x0cq\xc1\xe4\xa6x^\x18_\xcc\x97R\x82\xdb\xd36`\xb2\xff-\xf3\x09!\x95\xa16Xy\x08`\x994\xae\x8f\xec\xf4er\xd5\xd3\x90\xe3F\x81\xba&P!\x02G`\x8d\xd0\x1ez\xf0G\xd3\x18n\xbe\x17\x0a\x17\xd8\x15F8\x11\xbbMs\"Ob\xad\xe8:.\xe3\"9jt\x93\xf8R\xb6q\x89\xe9{DK\xb4&\xb6G\xdbK7\xccJ\xa9\xdc\x1e\xf3\x10\xee\xc3\xbd\xa0\xfc\xc1\x12\xd2>M`\x13\xce\xafaS\xc2b\xa2\x1c\xb6\xee\xa6\xc2k\x9c\xf3\xbbZL`\x04@\x99\x97B\xd7n\xee\xd9\xd2\x83\x0e\xed\x01\x1dv\xd0gEq:\x91\xab\x05\xb6\xb0\xd4\xbc\xaf\xaf\xd5I\x84z D\x8b\xcc\x11\xfd\x844A\xa6\xbb\xcd\x00c\x88\x0cc3a\xec-f\xca\x0bF\xae\xbb\x9f\x93\xe7\xa7[\x8e\xdc\x0er\x89l\xb0\xa3W\xf0\x80\xffbG<\xe7\x87!\x98\x121\xf0\xbd\xd2\xa9\x18\xb5h\xfe\xe9\x06\xfb\x84\x95]\xb6\x8f\x86\x85\x8b\x9b\x93\x13\xd8X\xee\xc1\\\xe6\xa6w\xff\x09&\x96\xf3/=\x0eN2\xad\x9e\x8aE+\x12\xef\xe1\x9bf\x8b\x9e\x94)\xda\x973\xb6\xa9f\xa4\xa0\x8a-\x02d\xec\x9d\"\xda\xce\x10mf\xbb>\xede\x8en\xe5\xc4\xee\x80\xf3i'\x17\xfd\x7f\xc8\xb7\x12\xd6\x1c\xfb6\x1d\xea\x15\xe3\x86\x0cJ\xa2\x9c\x1b\xd5\x0e$\xe3\xfc3\xe6\x93\xf4\xae~\xc6\x9ad\xffp\xd1\x1bZ=\xcd\x13\xa3\x06\xf2,\xf9\xdb\xb8H\x05\xc3e\x12\x99\x94\xf0\xeecN\xa9\x81N\xe9\xcf\x98S\xf2\x1eP\xbf\x8f\x8a#,\x19C\xf6\x88\x89;x\x93\x06{-\xd1fy\xe6{Y\xfeG6\xc7\xe8Y\xa2\xfb\xbe\x86\x16\xf3\x07\xbc\x98\x0a8\xf3\xc50a\xb7bI>\xc87\xfcCM\x07\x00\xc1a\x83\xead\xab\xd6\xa6r<\xde\xd1\xa9\x1d\xd9)\x1f\x90\xe6\xf0O\x98\x08a\x8b7\x8dF~\xa9\xb8\xd0\x05\xef\xd8\xc4a@\xdb\xb5\xf4BH5*\xb6|\xac\xaf^3\xca\x9b\x9b\x09,\xe1\xa2\x1a\xec\x0fZ\xe7\x9fl\x10\xe5\xe2\x1c\xad\x95\xec\xca[\xccmP\x0b\xb1b\"69V\x8c)q\xe2\x8cj\x9b\xbc\xd1\xa1\xde\xfd\x92\xcb\xe0\xcf\x12\xee \x12\xed\x9bX\x94At\xd9y\x17`\xa7\xf7\xdcCx\x9d\xd7\x0a\x19 c\xf6b-d\xedL~6\x07\xa0!\x94p\xa2l\xcfH\xd4\x87rT\xd67\x1am \x95\xfb6\xeb\xb3\xd4MjtF\xd1\xc7S\x10(\x91\x0b|`U\x0c\xa6=\x9c\xfa\x89\xd2\x11-\x0b=\x19\xda\x9e\xaf\xc2\x02\x82Yf'\x82\x8e\x1den\x9f[\xa3X\x938\xd2w>\\\xd7\xa6\xe6\xb4/\xff2\xad\xf5\xe3\xfc\x03\xe7N\x0e?m\xb4P\xbb\xddK\xab\xa0a\x80\xc9k\xf1[6\xa2R\x9ej\xe0\xea\x8d\xa0\xb2\xd5\x9e\x1a\xb8\xc9 \x15\x81iae\xa6s\xe98p\xb7\xb2\x0c[\xfe.\xd6y\x05\xe2Dj\xa6\x11G\xfab\xea\x02\xf6#\x13S\xbb2\x11\x07\x07K\x1b\xe6(\xf9\x9a\x12\xb3C\x80\xb0\xc1\x1f\x09\xf6\xa3\xdc\xa0r\xe1\xd9\xbd\xd0\xbc\xb8\xc7\xb5\xd5lpMy\xce,\xc3\xf7\xf2\xa5W\xae\xfb\xb7N\xa3\xc1e\xa1\x91\xce\xce.;\x95s\x09\x0dd/g\xa8\xb4L\xc6\\\xed\xd6\x1e\x9b5\x87\x89[@f~\xc3\xdc\x9dA<\xf6\x80\xe9\xa4.\x17\xdb\x11\x1c\xfbE\xae\xecC\x14\x0c\xa9u\xdd:\xa7\x1fl\xb6\xa8\xeb\xe8\xa7/\x94e\xde/b?\xdc\x09\xad\xe3\xb6\xe1\xf4\xc2xk\xe6B\xb7\x94W\xf8\x7f\xa2Fhx\x17\xdd\xc1.\x95\xc3\x8a\x08\xc4\xd6\xb9\x8b\xf0\x03\x0en\xc5\xac\xc3\xd8kj\x02\xb3\xcb\xb1q'\xb3[\x8c\xb9)\xfe\xcfp\xe1\xed\xe2\xea\xb4\x01F\xef\x9fU\xde7x?\xe6\x82\x13}\xbd\xb5\x03\xc4\xee\x88-Y\xdd#\xeb\x84\xf3t6\x82\xc8\xda\xc4\xbc\xf8\xdcT\xb9vo\xc6\x16\xa8R;kK\xc2\xd0\x90\xe6k\xd1\x88l9Bz\xc20s\x8d]\x0c\xb2H\x0d_:<\xd0\x92\xb4\x8f\x84\xe7)\x0d\xac\xe4:\xb8&z\xa0\x03\xc0\xcc\xc1\xca\xd7w\x84\xde\xcc'\x0bb\x177C\xde\x86\xdao\xb3\x1d/%B\xe3fw\x19\x8f\x06Cs\x90\xe7\x90\x15\xce\x1f\xaa\x93o\xb4\x03\x04n\xbf-\x1c\xeeJbVu4\xb91h\xbaw`\xadW\x1c`x\x19\x12<\xf7\"Vh\xe9g\xe5w\xeca\xf1\xa8\x93\xc7\xf7\xe6-X\xd6\xb7\xcb\x0e\xafz.q\xa53\x118\x8d?\x074w\"\x88w\xac\x93E\xf9y\x0c`h\xe4)g\xabU6\xce\xe2\xe0u\x1b\xae05\x16zA\xfa;Xmq^\xea\x04\x0f\x8d+\xdd\xc2\x9d?f1\xc0\x13\xcf\xa5\xc4\x89\xd3O\xa6\xa4\xbcZ#7E\xbcq\xb2\xcc@,(A\xf1\x89U\xdb+\xe0h\xcb\x80|j\xf7v\x99\xe5`\"L%c\x98\x1a\xd7\xfcz\xc7\xb4J;\xea\x12\x14cj'\xe6\xbf\\\x08\xdej)*[ll\x14h\x12gt\xff[\x8e\x9e\x86\x90e\x97\x17=t\xbd\x96\x93\x98r{h\xba\xf6\x9cN\xa3\xa5\xf8\x06\x11,\xb5\xeb&gf\x1f\xfeq\xb0#B\x13z\x84_\xc3\xc43\xd8\x13\xc5\xe3\x8c\xe0\x83r\x9b\xefeg\x08)\x1eQn\x81TrB\xd8\xde>\xfc\xc4\xcf\x8f\xd4K\xee\xcd\xd1\x92\xf8\x81\x88\xef\xccl%\x8e\xf5\x98Y\x0d\xb5\xa9\xb35\xec\x87\xd0\xdc\x98\xd6\x00c?7(\xfa6\xd2\xae\xeb\xd1\xed\xb4$x\xc5=\x84-\xa1\x1fyc\x93%\xd4\xdaC\x01T\x80\x89w\xb2-\x120\xd1b\"\xb0\xdfXsnn\x0c'\xbak\xd8P\x0b\x19\x0a\x10\x0c\xf2\x1egr\xbfi\x9c\x19,\x02\xd7\xcf\xd1K\x08i\xb0\x13\x1a\xb2\xe0[\x0eEZj\xef\x87\x8a\xdf\xce\xc3[a~\xc8\x8c\xaexc\xf9m<\xc0\xa5cB\x80W]\x96\xc9\xd8B\xab0\xd2i\xc0h\xefX\xf8sG%/!\xc4\\Y\xea\x09\xa3`\x0dn\xac\x042\xb0'j\x1b\xa5\xd0\xa3\x10\xff\xd68F\xccjy\xba\x8eo\xf2v\x84\xf7\x80\xbd\xe4\xaf\xa6f\x03=" +
+	"w\xca*\x16W\x99\xb7\xd0\x8e\xbbv\xb5\xb31p\xad\xd8\xba\xab\xd1S6\x16\x098\x1cS_u\x05\xe1\x98<Qb\x0d\x9c\x0dq\x16k0\xc2G6\x83-\x10\xa5\xfb\x10\x86(\x0e\x95+\xb1\x16\x16\xa1.\xff\x15\xd6\xac\xe6\xe8q\xcc\x94\xedm~Z\xcf1V\x03\xb4A\x0f\x03\xcd#\xf2a2Gs\x9c\xc1\xfaL'g\xc0\xdb\x02\x7fg\x94g&'<\x1a\xb6\x96\xc2\xb9\xfa\x833\xe3\xce=\x84\x9fE\x98\xee\xd5Y/\xd8\x9e\x9cj\xef{b>c9\xc1\xc6X\xecz\xca\x9a!\x05j\xfd2\xd4Ry^\xe0\x96Bv\xf1y\x1e\x9d\xf0\xd5\xc5\xeb]\x85\xff\xd2\x08\x86\x7f\xdc/\xd7\x081`k.\x7fPn\x14\xdf\xbd9d\x07)\xe6\xb5\x86f\x88\xa1\xcd@xw0\xa4d\xa9\x88\xbdf\xa4#86\xcc\xa4\xb4\xc1\x88\x9e\x84\x8da\xcc\xfe\xa1\xa6g\xfcF\xd9\xd0\xfa\xdd5\xf8\xba\x81\xdb\xec\x887\xe1\x02]\xf7$\x7f\x87)\xa0\xae.?\xf1\xca\x95\xba/m\xfd\xd4\x153\x0e\xa1\xbc\xbarJ\xc95J,\xf9\xd7J\xac\x02\xa8\xe5!]\x03\xbe\xa7%\x99\xd0tDy\x09zb\x09\xed\x15$\x07\xad\xc1\x8dF\xb43,'Q\xba\xb5\x9e\x8eL\x87 \x1e(\xc0\x99\xe6\xdcF:\xecX\x1aW:\x9e7\xdck\xff\x08\xb7\xb4\xcf\xf9\x11d4\xc0\x0a\xa5e\xdeC\xa9\x1f\x1b\xdb\xa9~Z\x83\xfam\xdf\xdd\xfd\x9d\xeeLk\xa5\x85${\x88$U\xa1\xf2\xd4\x00\xa0\xf9?.\xf94^\xe3\xa1\xb1?!\x9dIq\x10v\x83y\xbf\xeb\xa9\x8b\xcb\xc0-Y\xd3\x16\x82q\x882Cha\x93\xba\x10\xb4\xf5\x09\x94%j4\xdf\x94\xb8\x19\xb0\xb46wk\xbd4\x82[Po\xefO\x02\x07\xd8\x0d\x86\xbc\xd6\x93\x1a\x88?\xfd(\xdc\xa2\xf5?x\x18\x1e\xa6\x1d\xf7\x87QQ2\xae\xdc\xe3\x0d1\xe7\xd8\xd6e\x8ea\x84\xb1\xc1\x16Y\xec{\xc9\x9b\xcf*\xbd\x03\xdb\xa1F0\xe1\xe4\x917\x9e#\x90\xbf6U\xdbS\xbb\x9d\xff\xafv\xc8k\xdf\x9d\xf0Be\x96N\x93$p\x09!\xe56\xb6\xeb\xf0\xfeX\xe0\x85\xa0N0\xd1\xefYNl?\x08Y\x9a\x9f\x8e\x02\xb8\xab=\x87e\xa0\xe6\xa4F\xea\xb9\x16\xf9L{\x862U\x0a\xd6;e\x0f|\xf0\xdb8\xfc\xad)B\xc0\x9d\xbc\xe0\x9d\xe1Uj\x14 \x0e\x0f\xa2\xda\x89r2[\xda/\xdf0\xc3FU(\xf1)\xc2w\xca!Z\xe0\"\xd3$\xd1\xfcE\x92\xd3\xfe\xfcE\x0a\xcf\xf0\xc3\x19\xe1?G\xbb\xba78%q\xcaX\xba\xd5\xac\xa3\x88JS\xb9Id\xc1\xbc\xc3$%\xfc\xa90-Cc\xfd\xde5\xba\x9c\xe2\xf7\xf0\xdf^\x89\x8e*\x9a\xb2T?\xf8q\xd2\xa6\xfb\xd4\xd7294\x0a\xc3\x0d7\xd7\xbdR\xeb\x99\x93\xaf\x80b\x8a_1\x83\xd8\xda$\xa0\x85\x88\xeb\xcd /!\xc5<y\xd2C\xf5\xdb\xf7\xba\xc5s\xa4\xfcJX9z\xfb\xcc\xfdE\x9d\xfc\xc0\xa1\xa6pup\xd2\xd6\xb4B&\xe1\x19\xa6\xdaV]U\x86\xe7\x16\x96\xc7m\x07\xf0\xa8]\x0a4\xefPa\x95\xc3\x96I\xd7E\xc6\xf2\xa0!\x0e+\x04?\xf0\x7f\x95\x83\x92\xf5\xe6Yu\x8dj)\xf496\x93\xf0\x10~\x0d1\xd2;\xf5\xee\xce]\xd2\xf9\x9b\x8c\x16\xb4\x89-\x9b\xdd\x1f\xb8\x1e\xba\x81\x18)\x0f\x0e8n\xfc.H\x92\x7fe\xaa\xd5\xac\xab\xbc-\xda\xa8\x1b\x8f:= =\xb3h\x03lq\xf1\xc5\x95\x9d\xf9\x9eTMgU\x9ca\x12\xcb\x14\xe0\xf8\xab.\xdf\xbe;\x85k\xa3DM\xb6v\xbc\xdb\x9f!\xddv\xa0w\xca\x92W\xe3\xd9\xbb@mKm\x82tP~\xf5\xb4\xa9\xaf1\xbc3\x8a\xf3\x057\x04\x9fZ\xca\xb4]\xe0<@\xce\x96\xb5\xe0d\x99\xdf\xa3-\xda\xc2pO8\x8e\x93;\xe9\xad\xbf\x8f\xa4\xdf\x95M\x80\xdb)K\x86S\x9a\xef\x08C\x9f\x09\xa0\x99\xc5\xa4\x82<\xfd\x04\x97d\x18YL\xaf2E`\x02\xe7\xef\x8a9z\xa3\xa9\xfe;\x8d\xd6\x83=\xd2\x15'\xd2^\xb2\x96\xc5L/\x10\x7f\xb2Q}\xf4\xee\xf6~\"W\x81S\x93C\xe0\xc3O\x83rt}\xc8\x15\xf5\xe6)\x8e\x09LjxB\x1a\xab\x1c\xe4Q\x86x4j\xb7\x99\xeb\xa7b\xdcr\x91G\xa4TS\xdc!\xef\x92\x04\x7f\xa4\x0bwg\x1fAzr\xc9\xb8\xf4\x96\x15g\xcaAD\xa4\xebY\xcb\xc60\xe7\xb0\xa3;\xa8\x14\xd1D\x9c)\xcb\xacM\x18\xe9\xe3\x88\x8b(<wQ\xce\xb0\xa5c\xe6\x81\xc7\x9b\xef\xb4\xc9\xef\xd4f\x890\x98\x1c'8\xf8\x84\xb8m)\x1a\xb4\xcd\xf0+\x1c\xe8\x89f\x0b\xef\xe3\x8a\x98oN\x8e\xff\x0a\xcd\xde\xb7\xb6\xd9;\x8d%`\xd0\x9b\xb0\x1bh\xf9\x86\xc1\xf8\x1f\xb4|\xaf\x91\x02\xc2\xa9U\xe1\xccK\x8f\x93\xde\x88\xd8OW\xea\x95<\xb9\xeb!\x1c\xa4\xf8\xed\x02-\xf0p\xcf\xae\xea|$\xceBq\xc6|\xd9%\x9d\xe0\\\xc1\x9c+<\x9d\xa3\x87\xe1A\x8e0obFC\xdd\x1a1=\x1dkE#u\x80\x04-\xb5\x15\x08e'z\xa1i\x96\x8c\x89PR\x00\xc5\xccsh\x00\xa4\xdb\xc31RS\xb5\xcb\x06\xd7\xb0\xa7\xec\x1cO\xd2\x89z\xbdW=d\x17\xc9\xa8e\x8c\xf3\xf2\xa922t\\\xee\x0f\xe6\xf2_\x9e\x02\xcf\xbd\xce\xe4s?\xab)\x9bn\x04k\xeeK\x8d\x09\xf5\xc5\x08\x09\x1b\xf7?\xf2\x116\xdc\xc0\x1d\xe2\xf4=\xc6\xe9\xc1\xbdYZ\xe0;\xec\xfbg\xa5JR\xe4\"\xdeuZ%\x9c\x16\x81\x91\x84\xc8\x0a2\x01\xafH\x1a\xb8\x15\xf5\x14 \xb6\x990b^\xd5\x0c$q\xf1\x8e\xd2\xc2\x92\x1c\xc6\x8a\xe7=\"3\x95\x14\xf5\x1b\xa7C>E6\xf2\xe7\xafE\xc4\xc5<)\xc0p\xcf\xc0\xb4\xc3\xba\xa2\xd6(\xd6\xc6\xf6\xd8\x82s\x97\xa8\x1d|\xfbg\x91\xa5\xe1\xef9e\x83\xc6\xe6{F\x1c\xc5ik\xeb\x10\xa7\x02\xb3\xec\xf5\xe8Bg\x90\x1d\xbe!\x8aN\x1c\x94\xe8\xc8U\xbb\x86\xde\xa6\xdd -dT<:\xcau\x9e\xb2r\xa6\x1eg0b\x18\xed\x9b\xeb\x1e\x0d#\x89\x0dG\xa3M\xdf\x83\x1c\x93\xb1\xea\xbb\xa4@\x86\x1b4\x8b\xd0u\xa6\xc5\xd1%lU\x15\xf6umbK\xea.\xec\x0e1\x0b\\\xd8\xa4\xa3tk0\x87\xaf\xb2\xe2\x9b\"\xce\xab\x11\x01\xef\xe9y6\x8ap+h[\x7f\xe0\xa3\x8aL\x1a\x7f@/\xb7{H\xac8\xfa\xc6\x99\xda\x93\xbf\xe4\xdc\xf8\xc9\x09\xab\xe9Eg\xb3t\xea\x06p\x96\x07\x9365qz\x15\xb6TD%\xa3\x17\xab8\x9b0\xc8\x81\\\xc6\xc7X'{\xc9\x88\xd1Z\xc1\xf3\xcf\x9a\xfb\x7f\x8f\x0d\x02My\x9b\x1f\xa9\x93N/\x07\xe9\xb4\x13\x8e^\xd6\x1a\x04\xb8\xd4\xff\xe9\xb0\xa7\xe8]\x10i\xfb\xe8rpj\xca\xd8\x9c\x81\x02\xe9Er\xf5~\xb1\x07\xe0\xe4\x82\x83V\xd4\xb1\x14k\x89w\xaa>\xf6\xda\xddpW\xffu\x97\xb7i\xa7\x85\xba\xfeX\x1c\x0c\xd8\x0f\xae\xeb5\xd06\"b\xf5w@:\xb5\x99\xe4\x82\xd9\xe3\xa7e\xe7\x0c\xe5\xff\x1aC\x80-\xd8\x85\x19\xa7\x00\x05\x8f\xe2J\xd1&\xfcnt\x00y\xdc^\x9d`\xcc\xe4\xd4\x82\xc8\xf4&\xe7\xeb\xdcqG\x04%\x8e\xc3\xd3\x04?A4\x80\x97o1\xc6\xc2B\xce\x09-\xe0\x12/\xdb\xf4O\xce\"\x9e\xd2\xe9m\xd3z\x9e3\x16\xe9\x94\xbfec\xd3\xcf\xab\x8c\x0fE\xedo\x19\xfbd2\x19\xa5>\xcf/\x7f\xc6k[ |6\x97\x06$\xee7\xde\x08_Q\x1e\xd2\x11\x15\xdfur%_}|4\x0fO\xa5\xdb\xd7\x1d\xaaEE\xce.\x18\xa8\xc5@\xa5\xf3\x85\x99:\xb9d\x7f\xb9O\xba6\x0d+\xb8\xaf\x1e\xd9\xeb+gL\xba<\xad\xec\x7fi\xe3C\xbe\x8cjn\x05\x13\xbe\x1fJw\xefGz\xa9\xfbwM\xed|\xf1\xce\x07\xb8\xfd\xdf\xc8\x1c(\xd8\xed\x1bvqO|E\xaa\x15\x0d<<N\x08\x7f\x9eY\xf3\xaa\x1aW\xee\xfcMi\x86\x8d%\xe8YcjvO6\x947\x000\xfe\xdd&Rj\x1f\xf8\x1b\xbc\x8cg\x97]/EL\xee\x06\x89\x81\xc2\xae\xf4R\xbd\xc9\x05\xa9 D=\xb7R\x9b\x8a\xed@:\x9b\xd6|\xdf\xa6\xcf\xb8`bO\xe6b+\xfcA\x19g\x8c\x97\xdc\xd7\xa9\xb9\x8b0Ak\x989Z4\x15\xb9\xfbl\xa2\x12\x9d\xc1~\xbc[z\xc6\xd9\xeb*\xaeDH\xa3\xf1\xdd\xcb\x08\xbb\x82\xc6o\x9b\xa7i\xbbL#'\xca\xde\x9f\xd9LR\x13W\x0a\x12_!Sq\xf2\xf1\xe6\xac\x8e:\x8f\x0d\x02$\xc4%\xe0\x09BR\x1f+\x1e\x8d-\x17E\x1c\xf4\xd5\xd7\xe7\xa4\xeb[_\xa3\xa9F\xd1\x03ux\xda\x8d\x93|z\xb7\xf3\xb8\xac\xcb]I\x84\xc5\xea\xbb\xd4\xec,\xcfiF\x18}\x91\x14\xbe>\xe9\"\x1a\xf1\x8d\xcen?%\x00\x13\x1bd\x14\x0bG\xfdN\x94t\x1b\xf3+\x18/\xa94\xcb\xf4\xacQ8n\xf2\xdb\xd6\x04\xc4\x8f)\xb6\xebDe\xc6\xf9\xf2\xad\xe8\x03\xf0\xf3\xf3/\xbd]\xa4\x1b*\x8a,-I\x82\xc3z\x8f\x94\x0c\xcf\xa7]\x96\x0e\x16\xabs\x0ad\xde\x1f\xc5\xf48hB\xc4\xaf\xee\xd1\xef<\\\xb1\x91\x9e\x16\xed\x7fR\xc8\xe7]\xc6\xb6\xa41\xc5\x08D=WyU\xeb\xcb%\x08\x9c\xbe}\xb2\xab\xbe\xd1\xd1\xfef\xc9n\xfe\x09\x9a9N\xfa\xee\x04\xb5\x9c\xf3.\x1f\x09L\x9b\xbe\xd1\xd6\xd5w\xc0`p\xcb\x11\xdcO0/\xf4w2#\\85\xcfi\x89)r^\xff;\x15\xee\xbfG\x8e\x8cF*\xba\xdd\xc7\\\xfc\xc6c:2K\xbe\xd6\x0b\xe7\x1c\x1dIZ\xef\x94\xd8\x93\xacKW\xb4C\x12@>\xf0<\xc4\xf9x\xdb\xb1\x04\x9e\x98\x08\xe6\x1b\xb3\x11\x1a\xee\x93\x1d}\xad\xf9)\xaa\xb1\x8dV]\x9e\x18))\x0a}\xaf\xe0Do9\x0e\xc8%\xf4\x06\x828\xd1\x8aJ\x9f\xea\xbe\xcc\xb9\x7fy\xb9G\"a\xe8x\x88#k\xdf\x00\xfb8Z!w\x87\x1c\x0d\x89\x9e\xd3\x9d\x00\x8b\xa8P^\x99\x0bR17\xd68\x99\x7f\x94W\xad\x8d\x92\x8eZ\xe6c]Cj\xc5M\xa9\x16*\x1f\x1a\xc7 C\x7f\xffEiu'|V\x87\x83@\xaa\xc6\x9bp-\xfa\xa6)3P\xf2\xec\xeb\xef\x19\xc7\xb0NZ\xc5g\xb6\xb3\xb7T+\xcdVB\x1a\x1b\xd8gG\xa5fgqA{\x16\xb7\xebN\xa87\x17\x8a\xf33C]\xca\x0d\x07\xb2\x09\x08\x1b\xce\x038i[)\x9b\xba\x1f\xd0\xd4\xa1]\x0d\xd0+\xdf\xbb\xc7e\xa8\x80\xec\xe9\xb2S~\x80/\xeeP\xf1\x19z\xb3\x8a-\xb1\xb6K\"k\x11\x97n\x88\xd6\x9c\xf1\xc7\xa5\xfdk \xe3az\x89\x11\xcf\xe19j\xc1\x0f7\x01L\x8f\x1f\x0e7\x04\xc1\xd8\xc2\x9e\xc2\x14\xc8\x8cCOt\x91&\xb9\x9e\xce\xb0\x12\xd9+\xd4\x0c\x9c\xe0?\xc3\xae{\xaaX\xc5\xc6>\xebH[\x86v+g\xb5\x0f\x87^5\xcd\xd2\x8e\xe7\xca7\x094\x9f\xf6\xa1\x9d\xfe\xac\xc1\x15\xac\xd3@\x05\x94\xedMpza\x14\xb5\xdd\xc5\x82\x9d\x9ds!\xd4\xe4F\x8e\x83\x19=\xed-6\xf2m\x9a\xf7(\xeb.)\xa3\xf3\xa0(\xe3h[\xa7V\xf67\xe9\xb9\x0dH\xe8A\xa4\xf9\x1d\xb5\x9b\x09\x04\xd6q\xceV\xee-=\xb0Ml\xe8'\xa0\xe3\x89\xd0.\xf1\xc6\x1f4#\xe1\\\xdb\x06f\xf4\xc2\xc2O\xc3\x9a\xe9\x04\x9a\xc2\x9c\x80\xd6\xdae\x99\x0f\xe0\xaa[\xc4\xbe\x07\xbf\x96\x15\x10a\xe3\xef-\xfco\x89\x08\xb8\x9d c\xbf\xd4\xb7\xcc\x0b\xa6\x15h\xe4\x19\xc4R4}\x15]C`(Z|\x96\xe2\xa0\xc9?5e\xf0\x95V\xbb\xd2\x1f\xf4\xcd\x06\xc3\x96\x86\xbcI\xdf\xf1wB\xa32\xb3\x99\xf2\x85}k\x84\x7f\xeb\xb3S[$MR\x811V\x04\xe3\x90.\x8e\\\x13\xc5\x89\x86\xdd\xc3C\xe5\xec%:\xeec]\xba7E\xfc\x07\xb68\xf5`\x05\xcd\xdc\x16\x87\x15\x19\xa0\xb7\xb1E\x07\x02\\R\xec\xdc\xac\xc0\x19\xf2\xe4\xed\x03,P\xda6\xccBd\x8d\x81x\xd8\xad\xb6S\xb1\x1aA\xd6\x9d8`#\xb5\x85\xcb/\xbd\xdd\x80\x0e\xe3\x85\xb3Rv\\\xbe{-\xdd\x91B\x9d\xc9\x0f\x99\x84S\xa7\x1d\xba\xe9\x88\x0ch\x07~\xd8\x8e6\x02g\x0d\xe2N \xd7\xcd\xe4c\xefL\xee\xe9\xbc\xed3\xa5\x82\xfdi\xd02!I\xe1I\x99\xb3K\x0f\xe3\x87\x9b\xfcj+\xab=\x9cm\xe0\x9c+\xba\xdbo~\xe3z|\xa5\xc2\xb2\x04\xc7\x9b4E\xc1\xbd\xb4yR\xef%`\xa6S;T\xc0\xf7\xdb\x9f\xd0\xd4\x1c[/[\x86\x1cJ\xaey\xc9\xd2\"\x10siz\x1a\x18\xd5\x9cP\xc0\xfd\x88\xbb\x10D\xbf\xea#\xecXK\xe5D\xd5\xe5\x0b\x938\x1f\xbdO\x02\x14\xc4U\\\xc2\xf9c\x8d\xfa?\xe1;\x0f\xd80\xbe\xcbt*s\xf7@\xf8@b\x8f\xce\xf3Q\xcc\xdf\xc4\xb9k\xbdO\x11\xf2\x0e\xdd\xdd{\xad\x86J\xa9\xfe\x19~\xd0|V\xf1D{\x901\xc1\xca\xd0\xce\xb6P\xd9\x0ew\xda\xe4\xdb2U\xf6\x11\xe3.\xec\x92#\x0a\xe5\xa1.9\xd3k\xbc\x88\x15\x11\x8a\xa1\x86\xec\xb78\x17\x16;\xf4A\x7fx\xd2\x9f\x912\x97\x93\xfc\xfd]T\xc566\x90\xc2s\xdc6\xc0\xdf\xf6}\x02\x9e\xfe\x8c\x1d\x87\xbd\xf4i\xc3\xeb\x11\x9e'\x15\x91E\x0d\xed\x8a\xa2n\xcd\xe5\x00\xe33s~\x8aoX\x97\x9b\xc5o\xfbh\x09l\xb7=\xa7.\xf6\x9c\x8ciY\x8d\xed\x94F\xdb\x05u\xad\x90#\xcd\xc2`\x9c\x9cIw\xc6\xdd\xf9+\x97\x06h\xcd\xa7\xb1\xa1I $\xf2\x9f\x84H\xb9\\\x1f\xe2B\xa0\xd6O\x91\xfe\xa7\xa4\x8d\x0f\x90\x83\xa0\xb0\x1b\x10\xd0\x0de\x1ek\"\xf7VT\x89A\xb7\xa47\xf7\x15\x8d\xf9\xac\xdcX\xe7H\"\xfe\x12|:\x07z\x11\x03\x09\xe3-\x06\x86\xed\xfc\x84a\xa3\x0d{P\xe1\x19\xab.\xddx\xd710\xa1:|\xd8\x94\x86\xb0\xa0\xf33\xa0v<\xd0\\\xdda[\xd2\x8c\\\xa1D\x84>N\xbafl\xa8\xc7\xcb\x98\xf0\x12<\xbcB\x97\x9eNK\xf4\xa7\x9f\x0cZ\xaf, \x83@R{\x8a\x80\xcd~\x1dg\xe8_?9\x9e\x0a\xf4\xd2\x1f1!\xc1~\xbb\xb7\x1c\x98\x93\xe5:\x1f\x9b\xc5\xaf*\x96L\x17\xe5\xd5?\xd8\x19\xaav\xbf\xea\xa5\xd4\xf1\xde<m\xba;\xeaPo\x08\x07\x1f\x9b8\x1b\x1d\x95\xebr\x9d\xbfGv\xae\xcb\x0d\xd1\xc4\x96|\xefY\x07\xaa\x16\x1e\xe0\x94\xe4\xec\xcdr\xc7\xc6\x86\x1d\xe0U\x81\xd5\xe5\x0d\x06W-?\xfbh{/\xa7z~\x91\x0f\xc6+\x89\x19\x11;\xa0\x03\x94\x10d\xd5\x14\x11.4\xcdv\x8e\xd1\x19\xab\xc7\xe1@3E\x9c\x8d\x94\x9ci\xd0\xd1\x1cs3\xae\x0a\x98BBB\xca<\xe4\x1c\xfeRh\xcb\x11\xd2\x99\x16\xa9\x83P\x9a\xec\x95\x0an\xe6\xc2\x16\xfd\xc0\xeb\xf3/\x0ex\x9dF\xb8\xfe\x7f\x00\xb9\xfe\x80\xf9\xb0\x9b\x98\x9b\x18\x02K#\x19\x06X\x93\xafY\xcaO7 \xb4\x84xj\x05\xc3~\x0c@(.\xfc\x05\xb6\xa0\xdb\xc2\xa38\xb1\x0fHTp\x96\xbf\xaa\xb1W\x8d\x8d\xed\x93o\xb95\xd1rv\x8d\xe0\x9f\xc3\x96@\x96\x1e\xa3\"\xb0\xcb\x0e\x8fL'sV\x1e\xf7+\x97>\x7f\xea\xf6\x09>\"<n\xa3Q\xdf\x91wB\xcf\xe0\xe2\x96\xfa\x09D.\xd7\x16\x07\xc3\xc7M,\xc7W=\xdc)\xd7d\xc9w\xd9\x1a\xe0c\x92\x9a\x1b\x83+[\x13\xb7\x83\x9a\x85\xcd\x8f\xbb\xfc.\xb2\xec\x7f&\xcdG[\xa0\x0a\xf7\x9a\x90~kI3\xf3X\xaaa\xf1\xa4\x1f\xb1\xf5cA\x03\x0c\xeb~\xce\x12\x12[\x03\xeb\x1c\xb0\x8d\xbd\xf8\xad!\xf8Tl\xbb\xf0\xa9\x02\x91f\xa1;\xc1\xe6\xcaM\xcbf\xfaJ\x9b6S\x8a\x96.\xe2\x06\x0f\xf9\x0d\xb1\xee\xa1\xd3\x8c\x89\xa7\xec\x97\xac\xa7\xc1\xf5%\xa2\x1d6ee\x9b\xa0\xf9?\xc8\xf6+d\x1b\xc2\xa1\xc1A#Y\xf7\x7fl\xc3W\x13\xd6\xc2p\xa1\xa0\xbf\x02$\xca\xdc\"\xe5\xb7!@\x8e\xab\xf0\x12\xbe\x95np\xda\xef\x0f\xf7A\xe3,f\xcf\x04w\xe3\x08\xc6.K\x0d\x81H)\x82>@\xd8\x09\x1c\xaa\xdb\xf4\xe7)\xcd\xb8\x196\x99\xd2GR\xb4c\x1avn\xe4\xc7r)p\x86\x90\x85\xb4\xa3\x95\x1d8\xb2YO/N~\x1ec\xf0\xb1\x1b=\xceO\x03\x05\xd7Y^\xfa\xe40xfZ\x0a\xf6P\xd6\xffNm\xdee\x14\xf5\x95c\x81\xcf.m\\\xcc\xd8\xf4NB\x05\xabk'\x8eZ\xe1\x99\xcdF\x89i\xd1\xa4\x09\x11\x1c\x8d\xe1\x83\xd3\x8a\xebR\xab\xb0\xc8\xd6\x0el&y\x8b\xfc\x19W\x93Ga\xb5e\x82\x93\xa10\xf5\x08\xf1\xdd\xc6]\xf1W\xda\xb3JW\xbc5;\x1d\xb5\x8f\xd3\xae\xe6\x1a\x8a\xa1\xe5\xbbq\xc7\xf0" +
+	"\xa7v'\xc4c\xd2\x95s2\xe6<\xda3\xa5\x01b\xc1/a\x04;\x0d&*\xeb\x0a6\x08\xad\x81\xc1~\xa6\x85\x9f^#\xd9\x03\xee/a\x90iZW\xc8y\x80=<\xcd\x98\x90\xd0\xd0e=\xea\x94\x8d0\xb1\xfc\xbd\xc0K\xba'\x99@C)\xc2F\x8aw\x87#I\xe81\xd0\x8b\xb1\x8c\x86U\xf8T\xb8\xa5\xf8\x09'\x80o\xb3\xa7:1\x13\xb2\x01\x9d\xc2\x8a\xd9\xf0+\xe8N\xd8q\xc1h\x8dF\x80\x9ed\xe4\xaeGap\xe7\xe5\xdb\x8d\x10\xd0\xc5\x9a\xc1\x97e:\x17\xe1\xc8\x08\x9b\xf0\x9f\xbe-\xa1n9uh\x87hu\xce.\x06\xee\xd4H\x12Sp\x9d\xc6\xf9\x11;4&\x09\xfb\xa4\x8c\x10\xedxfEWX\x96Y\xe9.\xb3S\x1fm(k\xe2@\xba\xbd+\xc1\xef3|\x9fYM\x19B\x91\xb8\xf2\xab\xf2)=6\x8e\x0c&0\xac\xc4\x08\xfd4_\xc0#\xe6\xe2*3\xa1\x832\xee\xe6:\x98<\xae\x98\x1fc\x19v\xa5+\\\xf7pUa\xef\xda\x09N\x0a\xff\x06\x18qE\x14\x07\xbf\xa8T\x8dS\x10\x14kF\xf0\x9fb\xfe?\x8f\xef\xd5X\xe3'*\xa0\xb19Kw\x106=v\x0d\xa1l\x1e\xa9\x04\xc7A\xfdKt\x81\xd4\x94\x19\x97\x07g\xef$'g5\xdd\x8f;\xf8'\x0c\x88*\x9f\xb1Vo\xa3\xc4t\x8f\xd4\xb0\xbc\x88uv{\xaa\x9f\x99PP<\x8c\x9f\x9e\xf4\xbe\xa4*\xe4\xc4\xfde\xf9\x8c\xdfX\xb5\x07\xa4\x03\xb46\xban\x13\xcb~\xdc!\xf3\xa8\\\x92u\x08\x1c~\xee\xd4\x08\xe2\x1c\x0d\xf0}\\\xc1:\x97\\_x\x88K\x18-\xc8\xb2\xbd\xabP\x968\x18;,\xb3\xbc\xfb8\x99g\xa6\xc1X\x82F.\xbc\xfeN\xac\xf9l\x083\xf0\xb2\x84\xa8\xb7\x01\xcb\xef\x86\xe3\x06\xb5\x9b\xf4\xff[\xdc+\xd5\xd7w-`\x84\x1ei\xf9\xddX\xc4H\xceW\xd0x\xf9\xf9A\xc6\x02\x83\xc1\xad}\x1b\x17\xfb\xd0`\xd1$\x01\xe6\xddy\xd1+\x87$\xfcCxQ\xc5N\xee9\xc6U\xb0/z\x87\xe7=\x1c\xcfu8\xd8\xc1L\xab\xd8\xe4\x82%\xfa\xee\x1d5\xef\x1f\xa5\xff\xc4\xb9\x1e\xbe\x9a\x18\xdf\x03/\x86\xd1\xc7\\\xe3\xe4\xb0\xd5K\x0cg\x88\x15'{Z\x86x\xd6\x9f\x1d\x1e\xe9\xdcS\x04,2\x02\xf4\x94\xeaW\xcc\xb8l\xd4u\x90\xf3\x14&\x85\xed\xef\xf5\xa1\xeb\xe8\x13^u\xdeN\x1aT4q\xee\x1d\xf1\xb0\xf2\xcb6\xb117\xd3\xa5(\xc16\xc6\x8f\x90\xf9\xfbT=\\\xf2\xbdt\x9b#\x09\xf4O2\xf8h\xc9\xe9o\xf4\x03\x9d[\xad\xd9D\x8c \xca\xb1@\xfd\xb2E\x05\xe39\xadxX\xb1\x07\\\xaf\xe4\xe5\xe5XW\xba\x15m\x06\xede\xbcb\x95N5\xc5}`\x05\xe1\xeel\xc6a\xb2:\xa5M\xfb\xd2\x1e\x11\xa4U\xbav\xd8\x0a\xe3\xcc\x1a\x0dU\xac\xec$Q\x06\x1e<\xb4\x0bp\xe9\xbf\xc6K\\\x104n\xe8\xf9f\xfd\xcf\x97\xf2\xc8qVx>\xc5\xd4v\xda\xd5m\x08\x86F\x05\xf7v\xf9\x1b!Nag?A\x1f\xd6\xb8\x14YrD\xfd\x8b\x169\x06\xe9\x88g\x17qQ5S\xa2\xf0\xdfc6U\xfc+\x06\xcb%\xce:\xc5\x0a\xc2\xf5\xf6\xc6T\x1c\xc2\xd6\xacJ\xbf\xc1\xc5\x16f\xdd\x9cmfj\"\xd3\xb1\xd3\xc4\x89\x17\x16\x18\x14\":\xfb\xb3>\xd3S\x13\xa5r\xa0+\xb5\x0e\xef\x93\x17\xe7\xa7\xb9%\xc6?0\x17d^\x965z%\x84o\xa9X\xe9\xe5i\x925#\xc2_.\xa5\xf5\xc60\xdea\xbd\x9dZ\xd2H\xccaI\xdc\xd9igR\xb9\xaf\x1e\x8a_\x02dh\xb65\xd6X\x0f\x1bS\xd4\x11}\xd6\xdf\xcb\xa2u\x82\xd6>\xc6\xc4\xe1,\xe1\xc5\xd5H,\x07\xf3\xee\xc0^\xf3\x1e\xbe|\x98\xc4\x1a\xd3&\x14s\x98\x19\xbd0\xd6\x8dyV\x89)\x15\xf1,\xb6F\xadA\xe0_$S\xcdtq\xbat\x0bNq\x07\xbf\xe8\x92/\xa0\xcf\x81\xc5\xd3\xa6\x94\x9c\xa7\xb0p\x1ek=\xb3\xcf\x95Sr\x98\x13\\\x1b\xa2\x1fW\xf7cI\x9d\xc7a\xef\xablc\xca8]\x9b[xS\xa9~\xfc\x83\xd4#\x91\xb2\x81\x8c\x02n\xe6q\x95G\x92\xe1\x1f1\x0avWg\x82;\xb0m\x1c<Pi\x1a\x01\x9cOq>\x91\x84\xa0`i\xe0KG\xec\x82\xdc\x89\xe4C\x0d\x02X2\xc4\x016\xa6\xbc\x9e\xa2\x96#5\xa9\x8du\xea\xbdI\xc90\xa6\xde\xeepU\x1aR\xfd\xa7H\xe2\x90Q\xe5y\x14\xfe\xc5B\x91\xac\x03[\xb9\xe7\xd1=!/jy\x07\x18\x18H\xbf\xa1\xa3\xbeJ\x9c\xff\xa9\xd6\xfe\xcb\x1e0\x94]&0\x83\x1e\xc8\xcb\xc7~\x0c\xd4\x92\x9a\xb1\xac\xed/\x11t4\xd2\x13c\x90\x07\x83S\x9dy]\x12L\xa7u\x9e\xac\xf3ex\xe3\xcc\xd2\x13\xd5Ix\x9a4rC\xa8\xedZzm\xe7\xbd\x84\x03\xe4s\x93S\x85\x8c\xf2T\xb0%\x0e@.\xd8\x91KN\xb7t\xa7y\x11\x83/\xf7e\x87\x19\xef\x93C8y\xa3\x8c3\xe4P\xe1\x05\xc03#\xe7\xea\xdexC8\x81\xe8kf .\x1c\xc5_\xc3\xc1\xb8\xdd\xbc\x92TX\x99e^3J$_H\xdexq\x9e]\x9e^\x87\x9a+$\xc3\xd4\xd5&\x1c\x14oYk\x99\xd5p\xf9\x97\x92\xe2q`\xaa!\x84\xdd\xe5\xd5\xef\x10\xcb\xc2\x1fw\x9c\xcf\x9a\xe0s\xd3\x9dI\xa6J\xa1\xfd\x9e\xb5\xd0\xf1\xa1\xab:\x0a\x89\x9av\xbf\xa2\x1c\x09%\xb18\x15&\x92!\x03\xf3\xc8\xb65\x1aO\x88e\x1c\x93wSl'\x81\x9a\xd7\xde\xd0\xaee\xf6\xf7\x98l\xf0)j7\xb4\xf3\xfe\xb4Br&\x98iE\x02\xd6\xf1x\x0a\xfb8\xa5<-\x81\xd77^\x7f[\xed9\xf2\x08\xc1n1\x90\xbb\xc9\x96\xccgO\x8e\xe3P\xf7\x84baub%0\xf6\xd6\x11Q\x0a\xa7r\x15\x9b\x8ce\xf0\x84\x04\xd6\x95\xdb:\x10h\x8b7\x9bC[\x08u\xa1\xcc\xd0\xd0\xd9\x0c\x86\xb3{\xcd4\x99m0]\xc0NM\x19;Ex\xd5M\\\xfe?\xc0NQ\xeb\x9b\x03\x09\xd8\xa53\x90\xa6V\x0b\xde\xa3\xb6\xd9\x07\"+\xd0D\x9a\x01l9E\x987\x95\xc5\xc6\xaa\x93\xa5o8\xa6\xf6\x8e\xa1C\xf4t_E\x01-\xa5q7\xcf(\xabm\xb7\xd7\xce\xf7\xcf' \xaeF\x89\xf8\x92\xc6\x148\xe5\x84g\xc5Q\x07S\xfe\x03\xc3\xd4\x9fBC.\x19\x14C\x80\xe7\x93\xda\x8c\xa4|\xc6\xd4\xd5\x0a\xcb\x9d\x93\x01F\x9bX\xc7\xd5\x09\xac\x92\xe48\xee\xb2&\x9a\x1e%\xd0\xaf\xd4\xbf\x8cm|\x18\x88\xaf\xadVa2pqe\xbe D\xde3L\x16\x92m\xdb\xa3\xd7\x83\xae\xe6\xcaQX\x94)xnzi\x8b?\xd9\x1c\x80=\xe4\x14_\xd0\xfb:\x16.\xa8\x05\x89\xfe\x16\x80\x1e;\xc2@\xb4\xcf\xd5\x84\x0c<\xa8P\x11\xb6S\xa1f\x96\x81F\x88\xa5Q\xaf\xdf\xd5\xac\xc8\xdd\x05#\x1d\x0e\x84^C\x9e\xf16<!6\x1d\xa6\xbd\xd2\xdfyq\x0ai!h\\Rw\x10\x7f\x8e\xfayBC\x93\x9f2M\xd7\xfb\x09D\x96\x85\x81\x98\x1a\xb3\xf3\xdc\x03\xb0C\x15yx\xf2\xd6\xc8\x1a\xe9Q6\xa1\"\xed\xc4TP\xf2]s\xa7\xb3O;\xe0RW\xf4X,\xbc\xbe\xe6\x1a\x95*\xd2'\x18IOL\x12\x91\x0c\x03~\xec\xa8c\xe5P&\x8e\xe5\xc3%i\xe2\xfb\x0b\x88F\xf6\x01\xdf\xe9\x08:\xaa\xc3\xb3\xc3.\x16fq\xb5P\x0ae\x93_\x11\xd2\xd1\x9b\x09|\xd7\x8a[\xc4\x14\xac]QP\xfa\x8a\xc2\x96zHt`z\xf1[\xf4\xb0\xb1\xc6\x08\x81\xd4\xee\xf0~\x97\xcah\x87\x7fO\xa0\xc8\xff&Q1\xc4\x18a\x83\x88\x87\xee[i%\xaa\xa1+-\x98*\x96\xbc\xe4\x12e\xd9\xf42\x8b\x85\x1d_\xad\xf2\x90,'\x7f\xc8\\\x9bF\x08\xba\x82\xb7\xfcA\x97\x9d\xa9\xa6N9\x0a2\xa4v\xca{\x8a\x14\x95\xa5!:\xb6\x14\xb5\xfcu^\xdci\xd6\x92\x86\x13\x08\xb3g\xe20\xeeP\xa6\xed\x07\x87\x06\x0fhk\x88\xb8a\x1f\x9e\x98\x83v!+\xf5\x8d\xb6\x7f\x84\xe3\xf7\x16\xe7\xb3\xaf\xd0\x06bj\x93\x0b>e5\xdbw\xe4\x03|\x0c\xdb\xd2\x1b\xbf\xee}\xb0\x9e\xc6y\x02\xd0\xedy\xa1\xc8\xeb\x99\x10Rge\x1a\xe8\xb5_^\xfd\xd4#o\xb5\x9a\xce\x89\x0b\x9f\xaa\xa0L\xce\x8b\x0a\xb1%\xfd\xff\xfd(\xc6\x8dz\xec\xd3\x1d\xa5\x87}\xa2\x1c\xb1\x12 \xe0\x11)\xe5\xdc\x1d\xca4K\x1e\xc2\xa6;x\x92\x12\xe5\xd8\x02\x94\xd2\xf0%>\x96\xee\xcbw;\xccS\x1eY\xe0\x0a\xafJ\x82\xff\x11~J\x13\xd3\x1b\xc4\x8d\x0b\x0d;\x84_ \x8d\x13M\x03x\x13\x0dm\xf0d\x1e\x87\xb9#a\x03\xadi[\xed\xd4\xe5\xa8\x15M\x91\x1a\xe5\xee\xdd\xecK\x81p%P/H\xf3\x18\xc5YhfI\xa7g\x90\xfbw\xdc\x8f7\xe1\x9d5\xfb\x0b^%1\x1e\xe4\xd4\xcd]\x7f\x11\x83K\xf2\xe0\xf1/+{<NJ)e\xef9\xc1\x10\xc876d\xc5\x9a\xe1y\xb1\xde\xab\xa5\xec\xda\xa6\x9f\xb2\x08-\x1b\xb5{\x12\x7f5\xde&*\x09Z\xf5\xd9\xc6B\xb3\x0d\xfd\x8eP\x87\xd8>*\xcb\x83\x96\xdaZK\x8f\x97C\xf2\xc0o\xad\xb4j\xb2\xb2\x9dY\xb12[c\xcd\x8aL\xc8\xb7J<:P\x13\xd5\xa6\xe3=&}\xf0\xe9\x7f\xa0_\xd6\xe4g\xcc\x0bA\xe5\xe5\xdb\x81\xe4\x89;\xa0\xeb\xee\x05\xf3|\\J\xb1\x0c\x14l\xd8\x09\x0f\xbc$\xc3\xbeR\xc3n]\xe6\xbb\x1e\xd9\x97\x1a\xf8{\xc4cf:\x83aL\xde\x92\xc0\x04\x04\xeeg\x8d\\\xd8s\x80}\xe5\x9d\x19\x9d\xf2\x97\xf7\x81\xe0\xfb1\\\xdc\x9f\xb5\x97\xfe\xad\xd6\xc7r\x02\xe2\xccv\xe8Q{\xe0I]\xff\xfe\x1dh0A\xde\x9e\x9a\xb4\xa71\xa8\x9ch\x8b\xd6\xfd\xd4]P\xfd\xbd\xe7MuN\xa2\xd8\xae\xdc\x1a\xdb4\x12R\xff\xe3\x1c4X\xb3#\xfc\x8e\x0f\x84k,A\x13\x155\x9fc-S\xa6g\xc3\xd5\xd8\xe8\x14\xac\x9c\xe9\xb6\xa1\xb8\x85\xdbf\x123\xd9\xc2d\x9e\x16\xd4\x8d\xceL*]~^\xe9e\xde\x96\xe9ho{6\x86\x0eX\x03n\xe2\xde\xc9\x1a\xce\xa4\x10-PX\x7f\x9c|n,7\xfcr\xb4\xdf\x19\xf6\xd5\x96k|\xb4h\xba\x9dB\xeb\xac\x03\x99\xb9\xb4U\x95\xa3\xec\xe0(\xbc-<k\xfd\xb0w2r\xa7\x92$\x8fKTN\x19SE\xe9|Gu\xb9\x17\xe5\xfb\x9b\x0e\xbf\x96\x19\xa3\xdbq\x90,\x8a\x80\xa0\x03\xb0\xe5\xdaI\x89\xd8\x10\xde\x91\xf3\xfa\xec0\xc0f\x81e\xea-\xbc\xd0\x14Q\x14\xac\xe2\xf9Yg;M\xe4\x11Z\x12\xa3\xa8xC\xb1S\xf5\xbf\xe8-\xf6\xbcksp\x01\x9fN$\x9c\xc1'\x15\xed\xdbE\x9c\x95\x9e\xf4\xdc{\xc8k\xca\x01X,\x16\xc1\x06\x19,'\xacG\x8f\xe1\x15>\xa5\x84\xfafb\x05\xb1Z\xb6JO\xe88\xc3\x92\xe2\xbeC\xe2\"\xc6\x12)\xcd\xa9\xe4!\xbd\xf0\xb1\xf8\xcb\xb8\xc2\xa2\xdd7\xde\xe7\xfa\x13\xc1\x12\x81%\xa2\x88\xa5b\xc8K]\x87t\x97\xe6\xe8\xadO\xdb~\x84}i\xb8.\xfaY\xd2H0Q\xa2B_\xc5Q\xc4:\x9e\x1es\x89M\x89]f\x8d.\xbb\xe1\x7f\xdf\x85'\\\x1fd)%\x8d\xb2CTM\x9a\x9dOaS:\x1e\x8cB;\xe1\xddj\xc7D\x1f\x05\xd7\xc1\x99\x9bB\xba\xfc\xcc\xb5\x1a\x1f\x92k\x18\xbe\xff?I\x98\xa7\x81ms\x021\xd2\x0d\x8bm\xd8R^K\xb3\xc7\xa7p\x0b\x9f;\x8c\xa4\x13\xf2\xe3\xfe\xf3\xb0aG#\xb3\xb1NH\x05n\xf09\xec\x09D\xa1\xcew\xbaQ\x87b~\x16\xed9Eb8\xb0\xd3\xa0\xba\xf8h\x9d\xf47:]qF\x1e\x80\xe7'M \x98\x92\xc4\xb5\x9f>\x86\x1ey\xabMp\x0c\xfb\xf0:)\x10\xcd\xf73\xcc\xa7\xd6>\xdc\xdb\x8dx\xf1\x8e\xa8\x9eF\x0a\x05\xb0\x83m%\x98\xd46nO\x11k\xcc*-\x00n7\xc06-\x01H\xcf\x0c\x98J\xe4\"\xe7\xaaK\xe9\x84F\xb8\x8bu\x07\xfe\xd5H\xb5AD\x12p\xd2\xa9\x19\xd8\xf2[x\xc5:;[\xab\x13\x9b\xbf\xaf\xf3\xaa\xcd\xc4*\xd0*\x91\x09\xcc\x00\xd5\xd9\\\xbb9\xa1\xf9;\x1c\xbc\xf7\x92\x1a\x9d\xeb\x88\xe5\xda\xf9\x12;u\xe9\xb7\x07B\x05|\xd7\xb6\x87\xf0\xcfPM\xe3\xd6\x9f\x14\xda\x19\xe0F\xc1t=f\x8b\x17:\xf6V\xda\x0ffd\xaa\x09z \x93z\x1b\xde\xc8\x9d\xc3\x0f@24\x8d\xaf\x09m\xf8\x0d\x0eA\xa7\xa6\xfd\xbcla\xcd~\xc1O\xce\xc5tS\xf9k\xe6;\xae\xf0+\x1a\xf4{\xa8&=\x89\xf1\xa8\xfb\xbb\xc1\x87{\xc1\xa7^n\x9d\xa8\x87f\xdc)RK\xab\x01\x1b{\x9e!HP\xc4\xe5\x04\xcd(~\xd5\xdd\xfd3m\xc1\x10\xb1\x10\xe6\x09\x84\x11;H\x05\xe8^Io6]\xe0\x9f\xd8\x10$\x90\xd1\xba\xfc\xbcR\x14\xa7\x97\xb7\x87?bC\x05\xaa%\xcb\xb8\xe0\x9d-\x85\xd1\xc9#\x0f\x95az\xa8\xd6\x98\xf5 7)\xaa\xfaF\xaf\xca\x16\x0a\x0cW\x16\xe5\x89W\\V[\x10lM\xb9\xd5+J\xd0L\xd0\x18\xc1\xff[\x0a\xb3\x8c\xd6\x8a;\xf0H\x93\x10\x15\x8f\xc7\x1b\x97g\x86w\x8c\xd3\xbcK\xc0\xb6\"\x16\x82\xa6\x11\xda1B\x0e\x87g32p\xb0\xb6\x145\xf6|.\x1d\xf3\x88\xce\xdb'S\xc9\x97\x1d]\xa0\x12\x02\xc8\xd6\x03i-=\x0b}\x03\xe7~\x1f\xf9ot1pB\x08\xd7t\xd8R\xdco\xaa\xc2.\xdc7\x9c\xc9#\x99o\xd8v\xee\xd3\x92K3\xf9\xde\x0aLk\xde7\xfc\x84^\xd5\xa3\x0d\x9e\xe4\xd2\x85f\xc5:\xef\xe4\xa2\xdd|\x19g\xe5\x9er\x0bW\xb1\xf0\x16\xeb\x01\x02\xaf2nZ\x95\x83\xeaav\x00'\xd3\x04\xa9\xd3\x0bT\xe9\xc3\xaf\xd2rl\xcc\x9c\x86#!2\xe1t\xee\x0b\xe0\xa9\xc6\xa2\xaay\x03\xcdhc\xf5\x9f5\xce\xf7\x15\x89A^$~%\x12.\xb3XN~~\xdbT\xdd\xbd?f\x99G\xbb\xcfb&\xabL\xccd!\xb4\xfe$\xc6k\xe4F\xb9E\xd3\xf1\xce\xaf\xa9\x09\xb2q\x05\xd9&K\xe6\x16\x96,]\xd5\xa8?\xaaj\xd0\xca\xc8O\x8d7sW\xe8\x90\x82\x09\xf5\x9c|\xf6zJ\xdbcT\xd1\xfd\x01\xb8\xfa>\x81\xda?;\x1b\xe3\x7f\"\x82bC\xa5\xb1\xc7\xe7\xfb\x81\x1d\xe5G\x16E\xd6\xeaU\xd6\xc1\x86\x9b\x13W\x9d\x09\xad\xdc\x8cwWw+\xc9\xc2\xd8\xeeIz\xb5\x81n)\xe5\x11\xd42`y\x1a\xd6@\xf2\xdap\x92\xf9\xaf\xa4\x06\x17\x0e0s\xbe\xc56V#=\xd5z&\xd3}\x96\xa4QH\xadg\xc5\xaf\x891\xe6\xe4\x9b\xc0\x04\xb5\xbc\x86\x9eb\xc3\xda\xec\x11\x0c\xcfY&TMQ\x10\xd6\xa8\xb9\xd5t\xab\xdd\x7f\xfd\xbaB\xb3\xd8v\x96*\xb9\x81-\xf6\xd5\xc7\xd9h\x0a\xd7\xb2\xd3\x92\xb8+zU0(\xa4D\x92c\xdf\xddTO\x96\x14\xc6\x0bn\xe7U\x11\x08\x99\xec\xc0P\xceI\xa2\x9a\xb1\xd6\xf8q\xca6\xd8\xb8\x1bv8\x8a\xb1M\xfagJ\x10\xbf\xa4[Z2\x15\x05\xac\x8f\x17\x92\x06\x09\xbb\xb4.\xd9_\xc1\xa6\x06*\xb8\x0b\xb0J[7\xec#lYm\x7fL*\xc8\x06\xcc\xb1F\xdc\xb2\xc16\xa6\x00\xfa!\x17\x1c\x1c\x16\xbb9\xbb\xcd\xfc]\x9d:x\xb6\"\x96\xd3C\x1f\xb9\xf1\xba\x8b\xe5\xe4\x0e\xa0\x13\xfa\x88\x9d\x8d\xe1\x04\xfc2\xd1\x88\x10dQfR\xd8\xf9\x93\x16\xd2)\xe7.\x9d\xb2\x86\xde\xd7M\xd8\x96\x13*\xbd\xd9\xbaml)[\x97\xc9\xbd2>\xa1\xd3\xfeb\x18\xe0U*5\xafgp\x1f\x14\xe6\x87\xc2F\x0a\x00a\xf3\xf73:\x06\x0bA\xde#\x04}_s.\xfc\xbd\xa9\xaf\xe7w:\x90C\x0f\x9b\x9e\x8b{\xf1\x01\x8a\x8f\x8e\x9dzb\x87?\x1a>\x83Pr(#\xc3\x9c\x83\xc7L\xd4zO\x95\x000?\xdcE#T\x84M\x9c\x99\x16F\xfa\xb5\xd0\xa5H\xd8\x1b+\xdc\x1c\x80\xc3\x06\x8b\xd2\x92\xd2G\xb5\x885\x09\x9e\xed\x9c\xa2\xa4\x9c\xf0\x09\x1c\x8eM\xf8\x87,#\x06a\xfc\xa74\x12\xce)\xe8\xa9q\xf7\x98\x91\xb7\xb3\x90\xea\x13WX\xe0@[q`\xeb\xbfR\x8ec\xc2\xb8\x0c\x82@7\xa6Z\x0cA\xd9VY\x9e\x88\x1cb\x8e\x9d\xb7y\xd7'b\xae\xb8\xdapT\x02\x02.VS#\xd3\x1d\xc0\x1c6\x1d\x1d<\xca\xd07\xf83#\x01\xc0r\xc7\x0a\x08 \x97\xf3A\xf7\xac\xe4\x93\x87w\xe8\xcc\xab\x85R\x1f\xa3E3)\xb1\xe2F8i>{Fh\x16>0i\x8f\xc1\xc3\x03\xaf\xb7\x81$\x931\x02k\x82~\x0a\x9aE:\xa82\xb6\x0a6\xf82\xb1Vv\xc6X\x9cmlt\x9f\xc1\xec4\x09\xfb\xadb\xed.\x08\x9f\x96`\xff\xd6\x0d3\xf7\xc4V\x88\x94\xf9\xa4\x07" +
+	"_\x80\x1d;\xea?l\xb4-\xcdH\xaap'\xac\xc5T\xd1p\xd2\x96<\xd3Z\x15\x83)\xd2\xec\xc1\x01\x1e\xc7\xdaZi\x1c\x0b\xf1\xd8\xc6\x96\x07\xb5\x05\xa1\x12\xcb \x1e\x17\xf4\x0f\x923\x9et4|c#\x1e$\x049-\xfc\x93\x9a\xb4\xd1g\xe4\xf7c\xaf\xce\xbb\x0b]\x12\xdb?\xa0\x04\xdf\xfb,7\xae\xb9\x12\xca\xef\x1dy9\x8ar\xdf\xe0o\x99\x14G.\xa9\xdb-\x83=||7Uqb\xde\x96\xf7\xaa^1m\x0d\xfe\xbb\xa4\xb1v\xcd\xc1\xeb`\xb6@^\x85XH\x9a\xf5j\x13\xa5C\xa4s\xab\xe1\xa3\xc9\xf7e`\xee\xca\xf5#\x0e\x96c\xa9\x0a\x1d6\x83\xe9Bs\x9f\xe9\xbc\xca\xa8\x04\x1e\xf4\"*\xcf\xfc\x1ad\x8f\xc8\x1dc\xb5\x91\xf4\xe3\x17\x80R\xed&\xdd\x94\xe66V\xf2\x8d\xef\xb7x\x88K\\C\xd8\xe3\xfdK$@\xfc\xb6L\x9f\xf7?\x83\xc1\xf4\xa6C(\x95\x08@a\x03\xb3tD\xbf/\xd0\x03@\xeb\x13\xfe\x08\x1bJ\xf0c\xb6\xb3\xffJY\x9d\xd5o \xc6+i\xb4*\xf8\xac\xdb$?9\xad\x07\x06\xbc1\xd0[\xb2L\xb4Y\xb3v\xb2\x91\xef\xa3J_\xff\xcd\xf5Cq\xd3\xa8\xbf\x83\xcd\x99Q<\xc7a\xadh\xd9\xc0\xc2\xdcs\x19\x0a\x8a\x08\x1b\xe2\xf7\x8c\xb5\xcdb6\xcc\xb6\x9c\x8a,\x80\xb8\xef#\x10Q\xbb\xee\x0bQ\xe0\xfb\x90\x10\x14O\xf8\x86@i,2\xdfN\xc2V>\x86_8V\x07\x1a\x87\x08\x0d\x8c\xa5q\xb8\xc8\x93\xa5O\x90\x1b\xdcu\xdaDaO~\xc7r\x09#\x87\xc2!|3\xa9\x9b\xe4\xac\x93\xa2\x1e\xc4\xd1\xb2\xf0p\x99\xd9\x8e\xb6\xad\xd5rb\xa2\xd3\xfe;\xec\x8a\xfa\xd9\x10\xf6h\xb8\x98\xfdF\xc4\x0d\xfc\xd2)Ti\x1ec\x0d\xc5%\\o\xea\x02\xaf\x9c\xabQ\xc3\xd35\x05\x0fP\x93\x8b\x18\xb2\x90~>\x04(\xea\xc0\xd1\xd0s\x18\x19\xf6\xd0jf\xe3PJ\xe0\xafi\x07FU\xe2\x89\x02\xfd\x04\xfe\xb9\xe3\x94$\x8d\x99T:\x86S\x8cs\xe5\x8d0\xf6\x19+do\x9cDvd\x02\xaaI\xb1\xd1\xce\x84\xb4\xb8\xc2?\xcc\xbe\xdd\xf9V\x85\\\x14&\xf0\xc4j\xed\xab\xa2(5m\xf1\xb9\"u\xff3\xe9N\x07s\xa05\xd5xK\x1cv\xa4\x84\xda\xd2\x99\xdc\x8d\xa4e*\xfc\xae2.\"\xf9\x9d\x95\x97\xc6\xd0gq\x92\xb3\xdbd\xd2O{\x0bV\xc3\xf6\x15T\xf1\xccV\xa7\x0d\xe2\xf7\xca\xcf\xf9\x88$?{\xa8\xc8\xee:\xb1\xab\xc6IX-\x06\xb8\x0d\xe6\x82\xb5\xac$/8\x81ZL\xf7P>\xe7\xf4\x06\x9e\xe3*l\xdd\x0d\x1c\xa8\xec!1AE\x95Q\xc0pm\x90b\xc0<\xd2W\xfe5\xe0]]\x03\x94\xe5\xbcAk\xb4\x06x\xf9\xbb\x95!\xf3\xe2\x8d\x8d\xf7\xefJ\xf2\xf3a3\x9c\xd8MN\xc1\x01S\xa2\x13\x1fz\xcb}\xc1\x93s\x1e\xfaN\x887:\x87\xb7\xb1!\x1b\xa3(>n\x85\xe3\x89n\x0a\x84G\"\xe0d\x8f\x8c\x89\xee\x08\xf4\xbfU\xaf\xb6\xe9\x88\"\xcc\x04\xfdDr\xb6\xa8\xa9\x156'\xcd9\x93\xe8\xb5\xb6R\xb7\xa7\xd8Nx\xabt/\xe0\x80(\xd9\x07z\xcf\xccsD\xbfn1\x9b\xed6\xdc\x82Fi\xae1\xa7<\xd9c\x09\xe1\x8a\x8b)\xeb\xf8\xb5\xeb\x87\xe4\xafD\xcb\xb5\xdcR|\x92s\x00\xed\x1bOq^\xfc\x1ao\xe2\x05\xde\xebJ\x91\x8c\x9a]\xc2\xab\xec`\x8d\x94\xdcc\x93Y\xee\x8d\x89D\xb8\x1ao\xc6\x00\xb7{\xf73]\xc3\x99\\g\x9c\x87\x9fX\x07y0:p\xe6\xfbV2W\xfcd[\x15\xe7\x13>\xbb\xfeg\xb8(\xf0\xb6\xa8\xa3\xe9b\x8c\xad\x0b0`\x83y\x16\xf9\xb6\x9e\x14\x14v\xd8\xc3~\x82\x05\xee\xb0\x95\x07\x15\xd6\x81<\xfbsb\xdcl\xa1\xc9@\x9a\xd3A\xced\xb9C\xc4\xea\xff\xc2\x9b\xaf\xd8\xec\xf2\x0cC\xc3\x166&Q\x94\xed:\xe6\xd5\xd2.\x1e6Fj\xd6\x81\x9a\x1b\xd6\x86\x02\x18H\xff\xfd{\xf2g\x83/\xe3\x16vI\x07Y\x07\xad\xa3\xb5\xc8]\x1am\xd8\xa4\xff\x17\xe5\xc4\xf0\x1cg=n6\xe5\xf1\x8ea+\xae*\xcc\x13\xf4J\xd8QO\xde,i\x96\x99\xc3F\xd0\xcf\xe9=\x87=\xa3\x83!\x9fMw\x03!\x04\x0b\x0a\xc3\x81\x9c\x9b\xe1\x0f\xd2\xc1&\xa2{\x07\xb7\xac\x10\xd3\x959A\xfao\xf1f4\xe9J\xfe\x9d\xcd\xcf\xb9\xec6\x11.?\x8d\xe8!\xa0|1\xb3?\xcf\x84\xa2\x8b\xda\xe0\xd0\xc1[\xe0\x08\xc3\xf8B\"\x16 \xf5{\xc0N'O!\xd6\xe5\xe5\xda%\x83\xe9\xbb\xbd^\x8bX\x92\xf1\xc9;\x1cM\xd2\x80Z/\x84\x98).\x84\x00(l\xa4\xdb\xb0?#\xfb\xc5\x8d9\"\x94\xc2?n\xcck\xdd\x8b\xf5\xec[\x88\xae\x92\xbe\xe9wi\x10\x0d\xc0\xdd\x91J\xf7@\x00R\xc9\xdb\xd3Z%\x92\xbaQ\xde\xef\xf7=\x8b\xf3i\xa7O\x05\x8a\xfe\xe6\x0e\x0b\xc3\xbb\xebBY\xe2\x12Z\xec\xa9,|OA\x15\x84\xb9;\xbaU\x18\xed\x1dmf\xfc`\x804\xc0\x0c\xd8\xbe<\xc0g\xe3\x03\\A\xf1\x1ev\xf85J\x9c\x9a\xcc\xd7\x81\x0bPK\x06\x08\xb7x\xf0L\xb5;\x7f\x86\xa3\xa6\x1e0\xaeO\xa3\x8c\xe8\x08\x13h\xb0\x91!\xfa#\x06$\xce\xd3\x85(\xf5\x9c\xb5L\x8e\x89\xe9\xcf\xc3^\xfc-\x8e\x90\x17\x8cB\xa6m-\xb9+K\xf9'W\xce6q\x05{\x994\xf9P\x0f=\xc9\xedv\x99\x10\xb8\x1c\x9c\xf3 \x845t\x84.\xcb\x19\x08\x81\xc7\xec\x11\x0e\xe6\x10\xee\xe4p\x1f-\xa09y\x04\x07k\xc4I\xfb\x19\xb6\x8bw0\xf6\x04\xdfz\x8f\xab\xf2$\xbdp\xb5\x16L\xbbiS\xc0\xceP)\xbb\xa1\xb3P\xfb\xdc\xcb\xd3\x1e\xea\xbe-\x99[5a\x9b\x1c\xaa\xc3\\v\xd1\xd5\xcc\x0fd\xd3\xc4\xf8\x91\x01\xb1\xf6&\x93\xf1#{\x14sV\x9b\xa3\xe8#s\x94\xf3X\xfcG\xf9Q[WD\xe3\x8f\xecQ\x8fa\x83Z\xffq\x99}\xe4\xef~G\x8e\x91\xbb\x7f\xfa0\xbf\xea77\x1a\xfc\x91=\xea\x01\xb2\xb5\xbf\x94w\x17\x9b\xe4\xea\xec{\xfb\x93\xe7\xb0!{\xb1\xec\xf3\xcb\xb3\xc3\xa8\xb6\x9d\x0b\x0eq\x1e\xee#]\x94\x17\xc1)\xd6\xfe\xaa#\x16L\xf3\x86\xdf\xa2v\x0d\xe0\xdf\x86p\x17\xdd\xdb\xc2\"\x03\x9c\x83Bn\x90\xfbH{\"9\x1e\x95Ff8\xde\x8a\x80b\x12\xa4pz\xee)\x04F\x10&\xc4\xda\xe8\xbe\xb3\x19\xe4\x96\xe5,\xef\x8eMcee\xa9\x8f.o\xa1\x04\xe8\xfc\xa5I\xbf\xe1m\x1fx\xb3\x82\xd8\x1f\x88\x1b\xb8\x1d7+\x08\x13]\x0e\x1b^\xe2\x05\x9f\x08\xc2\x89s[[\xea\xe3\xc7_`\xa6\xc0t\xb66v\xd3\x07$$\xb5\xce?\xa2\xcc\xaair\xa1w\xf4\x02vN\xc0N\x09'\x87o\x07\xef\x161\x89+F\x8f\xb7\x92\x97[(\x0b\xfc\xd7\x1eo\xfa\x01\xec7\xd3\x96\x90\x07fk\x9b\xdf\x1c\x01p\xba\x19\x05]\xc5\xa5\xde\x87\xee\xfd\xd4\x0b\xae\x83\x019\xbbR\xa2\xef\xdcw\xbd\xd9\x82\xf9\xd7\x8e\xcbbn\x17\x8f\x9e\x09pC\x96\x11\x83\xcc\xf5\xc3YF\x88\xf9h\x89\xe6T\xb6\xacp\xfb\xc0>\xcc\xa9\xe8\xa7b\xc6\xfb\xfc\xb6\x97\x05\x08\xab\x0d\x0cv<\xc0w\xd9\x1e\x00\xc0\x9cW\x86\xf9KV\x8c>\x9b\xa0\xff\x90\x09\x88\x97\xaa\xea\xe05\xb6a\xdb{\x8b\xca\xb0k\x87:\xb9\x9a\x88\x03]S]\xca\xf8+\x14Gk2eA\x0f\xe2\x13\x1c\xb1\x01\xd3\xbcs\x19\xf7\xcc%\x1c\xd6\x09\xed\xbf\xe1\xe9o\x97\xc1\xf4r\xcfht\x87\x13%\x17\xb6\x98B\x18Y\xbaW\xb5\xc6oe\xbc\x82!\x90\xca\x04\x1b\xde\xb0\xecs\xb4\xb5\xf0\x9a\x90\x07\x8b\xb0c\x97\x05\xf1\x8c.[\x8c\xd0=\x9c-\xa6\x9d:=\xac\xcd\xabo\xfb\xb2\x1f\xe5\xd0]\x19jA(\xd7\xa4A\x91?\x9b\xbc\xcd%.$\xe9\x91\x95\xd0d%:\xc0cn\xf2\xc5\xe58\xe0\xe6\x8eQ\xebRy\x8c\x8b\x09\x9a@m\xca\"j\xd8\xf4\x07\xb5\xfa\xf4_\xcf\x06\xf5O\x10\xb1\xb4\xa6\xe2\xad\xf3/\x193Zl\x14\xfd\xdd\xb3I+\x9e\xdb\xee<p?=\xb6t\xcb\xdcP\xabN\xd2\x1f\x99gq\xc4\xca>\xdcE\x0fu\xc5\xe9\xc8#x\xea#3\xed\x93\xcb\xf2\xf7 9\x9f+I\xd3~\xb1\x8c\x8b\xf0\xa0v\xc1\xfa\x95X\xe1z\xa7\x9aL\xcdt69\xaf\xaa\xa8\\\xad0(1\x0dR\xbc\x87\xf3\xcf\xd2\x14Ej\xf2#w{Q6@\xea;\xb4e\x1dH\x05a\xf5\x89\x04\xc0\xe5\x97OL\xe7\x07ml\x81@\xd8%7\x18z\xb4K?!\x82uXSie\xd3-z\xd1\x13\xe47O\xa3)\xbdS\xeb8\xd3\xa0\xd3\x96\xf9Y:v\x83^N\xdd\xfd$\xfd#\xfb\xf4\x11\xa7X\xf73:\xd6\xdd\xb3 M`\xf38\xe2\xcf6\xe0-\xae1u\xa0~&>\xcc\x0a\xc8\xcb\x196\xa3\xe4\x8d<h\xa6\xe2\xe5\x808KF\xc1\xc3<\xe2\xae\x06\xf4\xdc\xbfO\x0c3\x8c\xa9|\xd9%\x89.\xe6\x91\x97\xca\xc48\x0d9\xca!s\x81\xc4\x8f-h\xcf\xb0\xe6$wC9G+\xbd\x05vU~\x1b\x9b\xa4\x84\xba\xd8Rd\xb4\xdb\x95\xdd\x98'\xf4.\xfc\x17\x1b\xee\x94W\xa0\x9f\x1c\xca\xdc*\xc4\x88M\xbajB\xaaSQ/\x00\xae\x01\x84e\xe9\x93<\xf3\x1c&\xc0:k2\xf9\xf2\xd8\xd2\xf8.@\xd1\x81\xaeM\x1a\xea\xd6\x00\x0bi\xbe\xe3\x0f\x16\xb1\xc2\x7fX\x18\\\xa7\xb1w\x0c\xec\xe5\xc0\x95eo\xc2P\x05\xf3mR\x1b\x9c\xcf\xcbd\xfa\xb5\x06G\x85\xc9}\xa8\xae+\x18L\x9cf\xa1\xa0\x08\xc7p\x1f\xb68\x07\x0e46J\x15ZX\xd6\xa7\xa4Z\xc3>\xce\x8b\xde\xdd\xe5\xf9g\x0c4o/O\xe2\x0ds\xc2\"3h\x95F\x80\xe1?DYTBA\x91f\xc3;\xc2\x9f\x99E\x17f\xcf/\x85\xa1-\xb9'\x91|G\x15\xe6\xe2\xb6\xabd\xc7\xf46\xb4l\xfa\x8e\xa1\x1a\x1d\xa2rz\xa6v\\#\xf8\x83\xfb\xb2&\x86n\xd2\xb0G\x99\x9eT|\x86\xd6>c\x9abp\x1ad\xc2\xce\xab\xda\x96\xf1\xc9\x1f\xe5\xa8!\xe8\x9e\x99\x9fE\xb73\xc8\x8f\x1c\xb9\x01\x8f\xcb\x95\xba\xd5\x81%H\xef\xf6\x01\x0a\xd5[\xbd\xe7\xb0\xc6\xbc\xd4DaJtc\xf2\xa7\xdd+\\!\x92'lzZ\"\x1f\xe8\xb8\xecvf\x93\x81\xa5\xeeL\xb1\x819\x0fJ\x8a\x10\x9e<\x8b\xb8\x15x\xf5`\xd2\xc1\xb4\xc5eF\x06\x80kK\x94\x8c\xbe\x09\x14\x02\xe3\xedF\xc1\xa9=\x88\xe5S\xe3\x09\xc3\x1d\xddg\xeaV\x92\xf6T\xc4\xac\xa6=\x19\xdd\xeb?\xb5(\x87\xee\xb1\xff\x9cp\xc0\x1a\x0f\x08;{\x00\x19#w\xad\x81\xbdh)\xb9\x0a\x9f\x84*\x83\xd13\xc3\xf2\x1aWE\xa0\x99r[g\xa7\xeb\x042\x18\xed4\xfd\xf1\xfb)^\xe3\x1b\xdb\"\x93\xb39(\x08y\xb8(|y\xdd\xb9O\x0d\xc3a!\x94k4\x95\xb4#\xf4\xa4\x0dL\x91\xc9\x95o\xf2\xa8H\xb1\x9d\xdc\xb4\xdd\x7f\x9f7\xbd\xb2\xf7\xf9M\x8e\xa4e\x85\xfe\x8cSO\xa9&y`\xb4\x85\x10v\x81\xf3\xad\x16~\x95\xcc\x91h\xe6'\x17|,\xe7vy\xfb[\x15<u$\xe1\x0f_#q\x8f\xd6\xe2\xf8\x1a\xa9B\xd9[\xef\x89\xae\xb3%S\xd7\xeb\x89 \x19A\x9ci\xfd\x84\xad|\\\xfe\x81\xfbe\x18\xb5\x91{K\xcb\xd6;\xa9K\xff\x0e\xc6\x92_er\xe9f\x1e\xda\x12k\x04\xd0\xa3o\xbb\x04\x84%\xba\x07t\x8e\xf4\xc2\x0cS\xcc&=H:\x05\xa1\xb4\x1d\xe8o\x08\xc6re\xf5\xee*D\xbeq\xa5\xb4\xa5\xda\x0ap\xb9\xcdxKx\xa4\x8d\x04\xeaC\x82\x98\xc1\xcau\xf1*\xe2p\xfdnJ\xae\xc2\xad\xe1\x1e\x16Y~\x92\x11|\xc5\x95\xf5\x87p\xdf]d\xf2\x83\xcb\x8ck&\xcdc\xa9-Q\xd8\xb0\x0a\x9b\xce\xce\xa6<BO\xc8\xa6D=\x9a\xfet,\x028\x81\x8aZ\xc9m\xbb*\xb0\xe2\xa0I\xea\x0d\xfd\xbd\xd5!\xe6\xbf\xfe\x93_\x0e\x93:\xcb\x86\xde\x81\x15~\x06:xe(PU\x90\xa1B\x82\x8e\xfb\xd8\xc4\x95\x89\xb0L\x83\xafktk\x99'v\xc71\xf4;Q\x158\xd94\xf7)[\xf0\xfe\xbb\xba\xee'\x9f\x10\xaa\x84\xbb\xce\x85@\x8eK\xf9\xbf\x89\xf4\x9b(\x1a\x01Y\xf7\x13o\x97\xafO\xcd8\xfd\xd7\x173\xaf\x97\xfc\x97%\x07L\xcd\xbc\xd3\x1dLv\xe4(Q&\xdd\xde`\xea\xe8\xea\xf67\xbc\x7f+R\xb9pG\xf2j\x97\x0e\x8b\x19\x8c\xc5\xda\xa9\xbc\xd5\x82\xa1\xe3\xd5\xdb\x14\\A\x98\x07pC}\xed\"_>\x99\xe5\x15F-==\xcf$\xb8\x1fv\xb2\x0e\x03\xe8\xf9k\x9bZN\x05\xe9\x07u\x9d\x05-\xb9`\xa8\x15\x14\xe5\xe4\x80g\"8X\xb8R\xd7\xbb\x95s\xe8+uA\x00\x00\xad\xa5Mx\x19+\xeb]\x9fY\xb3\x80GK\xd6\x82){6h\x10\xd5\xd7\x1a\xd6\xd5\x86\x1a\xfd\x96yj\xd7,\xd7I\x9b(\x10N\x1b\x8eu`\x17\x9aK;\x1c~\x8a\x07\xadz\xe8\xabI.\x8c\xde?h\xf9P\xb7\\\\\x15\xd7\xe8\xf5\xb9\xc3\xc0\x19D[\xd5\xddc\xfb\x12\x9f\xbe\x12,\xaaq\xd9Gt\x94\xc2\xe0\xb2\x18\xfb\xb42\xf8'<\x89B\xa7\xea\x85t\xaa\xa6\x12\xc3\xdd0\xe5W\xc6\xca)%\x85Qr\xcd\x02)oY\xef_\x1dZ\xcb\xc6\xb5\x105\x1e%5\xfb\xeb\x0d\xab>\xd9\xd3\xa6\x19\xdaw\xeb5\x97\xc4X\x0a\xccv>\x0cI\x9a\xcbO\x8fZ8\\e\xb2\x05t\x9f\xf8\xc7G\x99\xedoH\xe1\xc7`\x1d9\xb3\xb2\x16\xa6\x83\x1e'c\xcb\xc0\x98=\xc3\x85\xe4\x07\x94\xda\xeea\xbbb\x1aV\xd4\xf6=\xe2\x823\xac\xe2\xcf\x84*+L\x8b\xaa0-\x98u\xfbC(\xc0\x9e)\x17L\xdf\xe1F\xa8\xfd.\xb69q@H\xe0\xe2/\xe9\x12v\x05?<L\x82\x94c\xcb:\x0b\x97\xbd\xf1\x168N\xaah\xe9\x98\x1a\x80\x80\x06o9.{n\xc0*\x8eP\x95\xf4eH\xcc\xc3b4Z\xd1\xb8\x1bT\x872\xacd4\xbd{\x9b\xb8s\x8eRm\x16\xa6\xf4\xdc\x1b\xb80\xef\xbd\x0a\x1d%Z\xdd\xebS\xa7\xfa*V\x93\xb4\xd3\xdb\x82\xcc\xd7\x09wg\xb7\x96U_\xf4\xa4_\xb0\xde\xc3\xd4\xe9\xf8\x09\xee\x1f\xe9\xc0~\xbe\xafAJ\xb5\xb5*\x07\xe6U. m\xc1I\xccL.\xc8 \x15\xb6\xd9SU\x86m\xc9t\xfd\x19\xb0&O\xdf\xccOcI\x0d\xd6\xda*\xdc\xae\xa5\xeb\x99e$\x15w\x86\xff^6eU_\xe6\x9d\xf4\x11\xc6\xc6S\xca\xa8\xa5\xcb9\x95\xe32\xbc\x86\xf5\x04x,\x0eq6\xb1\x18d:l\x1d\x9b\xf4\xfd!u\x9e\xb9Z\x88H2\xf61\x02\xf6\x12v|\x17\x8a\xd8c\x0a)D\x11|\x8c\x15\xaeC\x0b\x13\x9e\xc2&6\xd8\x92Z\xb3\xe3\xfd\x93_\x0f\xb4\xf3lq\xbc\xd7\xe0\x84\xb5\x13\xd4\xe0\xe1\x11x\xc7\xa4\x08\x8a\xc40\xb4\xd3*\x11=\x0dvm\xb2b\x06!J\x93\xd5\xde\x86\x0f6\xa1\xa8Dm,,*\xc66\xfc\x03\xcab9F5\xc4\x02\x16;aG9\xe24\xe7%\x1bk\xf2\xd6\x80\xb6\x94p\xda\xc0\x80\x08\x0f\xbdG\"\xe8\xec\xd3u8\xb8\xc18Z$\xfd;\x1c\xe3\x18\xd6\x04\x1fL\xa3\xb0\xd5LMk*\xe8L\x1e\xa4>\xf0K\xf80\xbd\x97GE\x85-1\x86|F&\xc5\xdatB\x8f\xd1\xd7O\xcf\x9d\xb3\xc0\xc3g\xdc \xa7\xf6\x09\xc0\xe9\x0d\xb3\x976P=\xde$\xe0\x07v\xfd\xddc)\x81\xc3\x9d{T\xf5\xa6W\x8b\x0d_{T\x10\"\x94\x8e}\xaf\xff\xc6\xcaNO\xa4>\xa4\x9c\x08\x13[;\xc5\xd1\x96\xf9\xadK\\\xc9\x96\x9b\xce\xe2\xbd\xdaL*\xc0t\x95\xd5\xb0Fd\x1f\"\xcb\x91\xbe3\xad\xb9\xacfd\x17\xbb%\xaeI\xf9\x10\xf8\x81\\\x16\xd9\xb8w\xf8\xaa\xc3N\xa7\x02c\x1a\x9a\x0e\xfbWe\xd5\xcd\xc8E\xc9\x94\x15\xbbF\x93\x1e\xa5Dii\xbaQ\xb3\xfe*t\x99\x95DH)\x84\xd0\xfc\xa4\x9b\xc5\xe4&N<\xae\xad\xa1\x0a%\xc4s\xb0\xe5h\x9a\x89\x1c\x07\xcf\xcb\xfc\xaa\x8d\xf4L\x86\x8f\xa0\x82wV\xb2\x04\x9fm2\xe0(\x9a\xd6U\xa1\xbb\xd1\xde\xbc\xfe\x16\xfb\x83\xd0\xf0\xa6\xe3Y\xaa\xea\x09\x8f\xe4V\xb8{\x02\xe0\x0aa\x1ba\xa7\x9f)\xf9\xdfN\xe8\x15\x87\xc7X\x87w\\\xf9\xa8z\xc8\xbd\x1a5:>OH\xaa[\x15\x98D\xb4\xbf\x953\xae\xe4\x0a\xc9\xbdP\xc9I=\x81\x86}\x01\xa0\xa26\xa5C\x8c\xa3g'\x88\xb5\x18\x0a.\xcbs\xd5\xe0\x1fJ+E\xce\x13</4\x193\x19\x958\xf7t\x94\x15\x1aA\xd3\xed\x8f\xa3\x8d\x0d\xb5?\x9d\xc09|\x8d\x19\xb0\x88\x84\xbb\xcc\xe1\x8bv\xa0\xac\xa8\xacM" +
+	"\xa9\x18\x9ev\xb2N[\x88\x03\x9et\xa3\xc7\xadV);U=\xd3\x13\x8d\xcc,\xd9\x19\xe6)\x1e\xd0\xca !v\xedI\x12;\xde\xd5_\xa0v?\xeb\xd9\xb9\xa9\xdb\xcf6\x96\xa5E\x83l/\xbf0F\x99\xa9\x82\x8a\x1a)\xd3\x01\xd5\xdb\xc47F\xb3\xe7\x8b\x9e\xabnC\xfd!y\x03\x9c\x9c\xa8\xf0\x85.%\xde\xcc\xe4u\x9d\xab\x87\xc8\x08\xd2@Q\x10Q\x0bc\xc3Q\xdb\xa2\xb7\x90\xf6\x10g%9\x8fLR\x7f\xe3h\xef9\x16x\xd1\xa2woK7\xfaAX\x05\x84\xaf\xfe<\x00\xac\xc9\xd8Q\x8a\x96\x09\xacI0c\x0fO,\x07M|\x03$\xb4\xf4\xb5\xe3B.\x94o4\xfcb\xafqF\x11\xb1\xf2\xbf\xcbk\"d\xc5\xb3\xbc\xdb\x07\xa0A\xe3 b\x96\x86\x09~\x97\x95Xt\xc1\x09\xcd;\x8f\x8dXy=&'Z:]\x9a\xe1\xec\xc2\xbdK\x9eM\xb0\xa1d \xcerFn\x9e!h\xefD\x09(\x8dD\xcb$\xb3\x95\x17\xf24\xa2_'\x88\xf9\x99F\xcd9\x935@\x9d\xd0tM\xd7\xda$U\xbb\xce\xfc%\x1e'\xc7\x0c\xb3\xd7\x9ee\x8c\x0d-\xb2\xf2\xb2-_:+\x88,\xe2\xcceg\x8e\xd8\xf6\xbd\x148\x0e\x0a\xf5\x1b\x15\x8a\x1en\x88\x84\xc1U\xf1D\x1fn\xc3\x05\x0c\xb9''\x09A\xa9\xbe\x12\x09\xa7\x95\xed\xc8\xfe\x8c\xc2\xa5\xa3\xf3\xab{\x00\xae,4\x01u\x9d\xefV\x91,\x81K\xb5\x19V\xe0\xae\x06@C\xca<\xae\x9d\xe0\x14\x81\x9e\xdb\xd3)\x06I)\xe23\x14\x83\xe7_\xdc\xd0+\x85}\x0b\xa9\x94'\x01\x18\xcb\x0c\xdcAUq/\xbd\xfb\xa8\xb3#\xaa\x0fz\x1c\xb8\xe6\xa5\xc7r]\x83\xc3i9\x8c\x89\xd9\xe2\xf7\xcaE\x8b\xce\x0e\x9d\xa2\x13\xfa\x14H\xbb\xbd\xd2fM\xf5\x88\x99<\x9bcY&\x0eWG\x01v\xc7\x04`Gng\x80\x17\x94\x91\xc2\xd1\x04\x06\x01\x01*!\xd1\xa3\xf9\xef\x98\xb6\x19\xbfS\xa0\x0f\xd3\\3y\xe7\xe0fk\xba\x1c;\xe3\xdaw\x99\xe2\xed\xc1\x95\x1f\x88!\x0b\xfb-9[D;\x1bY6\xd2\x9a7\xdcO\x0b\\\xa2\x95NC\xcb@;\xde\xc8\xb8\xf9\x83\x91\xc3\xeb\xd6\x96m\x81\x82H\xablDmH\x14\xe66l\xc6 hZ\xe2\xf4\xcb \xd6K\xe5\xdf'\xfaP`\xfc\xe0\xaa\xcb\xc160\xc0w,C-\xec\x0dM\xde\x82\xeb}g\xdcb>xs \\+\xd4\x103\xd9\xd8<\x93\x87Y\xda&\x87+\x9e\xcc\xfal\xbb#;v\x8f!\x11\x8e\xcc\x97\x0c\x9c\\o\xce\x1b\xda\x0e \x1d\x8de!\x0e[wN\xb5\xdf#l\xff\xe7\x97\xbd\x85`\x9e\x94bO\xa51\x9e^\x05.\x1c\xab\x0d\xc3?\xd9\x92\xae}i\xd7=\xf7[\xa1\xf2\xf9\xb6H\x04{\xe0|,\xa5\xae+\xd5\xcf\xa6\xaaE\xcb\xa9\xa6?5\xfb\xb6e\x8e\x86\x8f\x1f\xd3I\x8b\xc9\xd3\x1aQ\x1f\x0c\xb1\x00\xe8K\xce\x13\xb9jK\"*\xc3\x06\x02\x9e*\x92\xd3\x97|\xbe\xeb\xc8\x9e\xf5\xa4\xd1\x85_\x1d\x12\xa9iY\xf6\xa8\xf41\x09\x83x\xae\x09\x163\xc3\xfc\xcc\xbf\x9c\xae\x1d\xee,\xcbpV\x07\xd6\xee\xfaL\xe6\xbf\x9b\xffM\xa9\xa6\x9f\xe5iw\xfbg\x93\xc0e\x92VnRX&\x955\x92\xe1\x9b\x90\x81B\xfd\x19\xcb\x1bS\xdaX\x1c*b\x11\xc2K\xa7`\xbd\xb4\xa7_|q.\x03\xf6\x07\x01?\x00i\x19\xde\xf3\xfe.\x0e\x9dvc\x1al (\xe0\xac\xc2\x7f\xb1\xe2\x14\xb28\xb7\xde\xb4 \x06\xb4\xff^\xa6~7ba\x83\xa4u\xb6A\x88\x14lp\x84\\1d\x93\xd4\x07\xa2;I\xef%]\xc2K\xa5\x0f\x15\xbb\xf5\xdf\xc0L3\xddc3\xc3\xdb\xe3\x825\xde\xee\xc9\xa7\x87?\xb0#\xe2\xa8.w\x9aN\xf8\xd72kl\xe5\xa2:e\x06N \xb1\xdf\xe5$\xf6\xacyU\xa6\xb1\xbf8\x1fW\xc9\x8f\xb5_1\x0c\x1d\x04RV\x17~\x19\xf1\x8c*l\xb4*\xc4-\xfb\x84;xc\x9bst!=\xbd\x1b\xc6\xe2\xfe'\xd0\xe7\xc1k\xdc\xc5\x19\x16\xc8\xb8\"4\xee\x15R\xb4Q1\xb7/\xb3\xdc\xc2\x82\xcdN\x7f`\xf1\xc7b\xcd\xb3\xb3\x03JeC\x83f(\x9en\x09>\x16\x0eF\xd9\xbe\xbbi\xb2\xc2\x12F\xae[my\xe1\xads\xb8\xf1\xae\xc1\xf7\xdd\x11\x8b\xb7\x9c\xed\xa2^\x05\x8d\xaal\x04_a&\xf5>\xc3\x8c'\x11\x03\xcb+\x84\xd1/b\x1bg\xf2\x02\x97qQ\xba\xbf\xee\xf6\xc8\x0f\x9e 1\xe4\xf4\x19EKn8a^\xe1\x89\xf6Y\xff\x00 hl\x88\x0a\x10\xfc\xc8\x1f\xc8\xe3\xb0\x83\x1b\xdd\xeb\\!\x12\xd0\x07\xda\xcc\x18c\xaa\x8dJ\xab\xd8\xf0\xab\xe6\xe1\x15\xd0\x8f\x0d\x04\xcc\xa2{-\xd6\x94.r\x92@\xdb\x1f\xa0\xd7_#\xdf(Yki\xad\xe0oL&\xf5\xd8+\xd2;\x80\xe6\x1e\xee0\xecnJ\xc4[db\xf0trl\xee\xa0\x1c\x7fl\xc2v\xb89\x10Nk(\xf7(\x94\xaf\x99\x04\xff)|\xcbU2\xf9\xcbR\xe9\xf4\xf2K\xcfM\x83t\x14\xd1\xec\xe9\xfc\xf9\xa9\xad\x8e\x94;m\x99z.lH\x04\xae\xc5_m-Ez+*\xf1{iJ\xb3\xea\xa9\x0c\xe1\x1c\xec\xd3\xea\x09\x0e1U\xc5yeJ\xc05\x98\x0a2\xa0Z\xac\\\xf1\xca\x19\xb8\xd6Y?\xa6\xf1_\xca\xdcP9.-\x984\x02\x86\xaa\x01\x8cu\xaa\x03d\x17\x1b\xa8X_\xfaX\xea\xc9\x13z\xe54\xa0\x0d\xcf\x1f[<\xe7\xaa\xd0\xa9W4\xd63\xbd\x94L\xf1\x82+\x01Z\xf6\xdb\xc5\x15\\..\xf0\xb3\x19\xa2p\xb7\x80^k\xa1zg\xb9\xdc\xb6lmV\x99\xef\xa1\xb9\xdd\xda\x8e}\x01\xc6u\xc90.\xf0B\xdf\xc3\xfe3@.\xfe\xcd\x98,\xf2=\xdc\xbd\x90\xf9\x1a_\x0a\xa3\x9f\x8dpx\x83\xa5H\xa1I\xf8n-b\xaa\xfc\xa4\xd2'\xe5\xab\xd3\xe9\xa0D\x0b\x9b\xafz\xb3\x88\xb3\xf0\x95\xd4\x1eq\xcb~\xce\xaf\xff\x01\"\x0co\x843\x83\x9a\xf7\xf6\xaa\xf0\xca\x88:\xe3\xe8\x03Y<\x08\x9d(F\x85?\xe6\xc3p\xf4&\x86O\x9d\x9b\x9b\\\x12\xf8\xb4<\xdfI\xfa\xaf\x1dj\xee]/57F\xe0(\xe9\x0d\xdf\xcf\xb9\xa3\xce\xb6F\x163\xcc9\x04\x84\xdd!C\xd8\x0c\x8f\x07aX\x9a \xd02:\xc3\"\xc2 x\x8b\x89;\xc5s\\\x08\xa0\x15>A\x11\xa1C\xac\xec2\xc32I\xdey\xbf\xe3\xcc\xd7\xb0\x04\xfc\xe5\xf8\xb0\xfe\x7f\x85\xa0m\xb7/\xcaJ\xf63$*<\xc5\x86K``\xf0\xe3\x8a>e\x80\x88U\x94\x9e\x00\x03\xe7\x16\xa7|k\x01\xda\xe4-\xbcA\xd5o\x146q\xdd\xc7F\xe2\xfc^\x87cc?\xc67\xb1\x9c\xae#V\xa4/\x9a\x0cTQ\xba\x1ai\x14v3\x8dK\x83\x0d}5\x1b\xce\x94\xa5]1\x89\xea\xa6\xdb\x10\xd8\xc2\xfe\xdf\x93LAH\\\xf6\x85\xcc\x14\xa4\x0b\x13.\x1f\x1b\xe9<\x10;@Y\xf0\x97\xdd\x88\xf0\x06\xcb\xbb\x19V\xea\xb4\xc4?Fv\x14\xd7\x8f\x80{P\x15#\xec\x90\xb0\x04\xc3\x0ej,C\xe54l\xaa\x95\xacq\x9a\xd1\x98k\xdf\x11\x010\xb2\xaf\xf0Fm\xf7\x15\xeak&\xc4\x08\x06\\\xcf\xb67\xe6\xb5T\xaa\xff\xe28W\x0du\xad0\xb0%\xbbI6\xd9\xd5L\x10\x9e!\xb9\x02\x11\x8dM\xc1\x9f,\xe1\x85\xc2\x1e\xdf\xad\xba+e\x92\xcc\x94\xc3\x8b\xce,\xea\x8bS;\x98\xaf\xd5\x80\xfe\x80\xbd\xa4a74\xe3\xf1G.\xd9C\xa9\x93mi,\xa6}/\xd4\x08\xf6\xd7\xcc\x0b\x09\x97\xfa\xc1\x1f\xc5ex\x87\x8a\x8f\x91\x81\xe5\xa6\x03\xea\x9dsJ\xd4\x00\xb7W\xd5\x91\xf0\x03c\x1d\x9a\xb7?\xc3Q\x82\x83-\xb7+9\x7fZT}c\xcd\x7f5\xaa\xf54\x0fo\x9cf\x8a5\xca\xf4\xf6A|M\xb3o\x91\xd1q\x0c\xc5V[AC+S\x1f6&\xcaF\xfc?\x85r;,\x07}\x0b\x1f\x13\xe4\x7f!\xe7\x96\x0e\x8e5L\x05\xb02\xd4}_\x8bt/a\x9d\x08)\x96\x86\x11\x1b\xca\xf7d\xf5\xa9\x00E\"\x82\xa8FJ\xc4\x85H\xa3o\x83r\xf6\xe7Us_\x85uQ\xca}\x8f\x00n\xe4VP\x87\x1ep\x8b\xdcj-ozD2\xf7\xdc\xf5RUD\xae\xc8\xc5\x07m\xa1\xa2\xfaCl\xfcq\xf2\xb9\x1c)e\x8b\x03\xdc)mS\xb5\xb2\x90\xcdqb,\xb8\x02\xb0'\x19V\x92X\x82\x18\xe8\xabiK\x97\xf9.%u\xd3\xacQ\xc5\x85_\x93)\xe4\xe2tk&h\xdd\x852\xa0\xff\xf0\x1e\xeb\xd2\x9c\xeat\x8d;\xa1#+\x15G$\x03RF\xe2\xe0L\xbf\xc6I\x81%!h\xbd\xe7\x9d\x91\x0e\xef\x17\xd8ky\x17E\xdf\x11[NL&]Q\xcf\xe8\x8cW\x99&|\x09\xeeVtu\xb8nG\xf3o\xce|\x01wy:@\xff)~\xec\x87\x16\xbf\xcbh\xef\xa9\xf7c`\x83\\+{;\x8f\x06\x12\xb7o\xc7\x81\xd9\xd3N\xc9\xa1\xdfv\x84-\xfb\xd9#\xc00\xb6w/$I\x9d\xfe\x81.\xc6\x0b\x98\xed4\xaf1\xe4\xd1IA\xb8\x18\x90\xd3\xe6\xa1\xd2\xe6%%\x00\xa9\x8d\x94\x1f\xc56\x963~\xc2\x18\x924\xd7\xbf\x9aT\x15K\xd3\xfb\xc2\x82p\xb6\x89\xae\xed\xd6\xcb\x95\xafQ\xce\xc4xQ\xc2\x85\xca{\xc6K\\`\xc3\x9dq\x0d\xe5\xd3\x8a\x11a}\xeeu\xfaG]F\x15\xdf\x8e\xd8c\xe8{\xc3qD\xf3\\:\x93\x87\x81\xf1\x93\xfc2\xbc\xa2\xf2)\xb2\x95\x8d*\xa6\x9as\xd8_\xa7\x0d\xa2\xf4\xfb\xb3\xd1\xa7A\x8f\xff\x1fwB*~u\xfc9\xb6\x1b]\xe7\xb1\x96\xda9\xfaz\xf60\xb1\xb1\xf3\xa2\xa7\xa0((J\xea\xe1\x99|\x92q\x10aT\xe0H3I\xba\"d\x04.\xa9\xe4\x9c\xdf\xf9\xbai\x0d;\xa4\xe3\xc0}\\\x9e\x1c\x08\x0e%\x99Es\x0a\xfe\xc0\x071\xeb\xc3\xd4\x10\x1a\xfa\xe8\x05\xb5o\xe9O/\x86FLm\xc3\x1a\xa6\xa4h\xfb\x18\x9e\x8d\x82{\x16\x05\x9d\x9e\xb3v\"\xa3,}E\x99i\xa5\xf2,7\xa2\xd1\x7ffh\xad\x90yd\"\xd2\xbb\xe4\xf0\xc4\x9a%ZY\x1f\x8c\x98C\xc8)\x942\x15\xb7@$Yo\x84\x92b\x8b\xe7\x03y||\x9a\xeeIR8\xcb\x9c\x0a\x18b=\x8d\x02\x98\xe2\xd2nH\xccg.\xd0yZX$\x83Z\xba\xba\x893\x8ca3\xcc\xa8\x95\xe9\x0c\xe0\xa2\xa7\xf6C\xf5\xe6\xa58KS\xc6b+\xea\xa9\x87\x13D8\xf7\xbbJ{\x83\x96\x9ak\xae\xec\xea\xf5h\x94c\xd8\x88Qs\x05\x03\x90\x9cK\xd6\xf9`V\x83\x0d\xeec\xc4rP2!\x06Z\x85\x95\xc5\x19G\xa7J\x19G[4$\xf7L\xca\x93=PNg\xd3@\x0da|\xcf\xa7\xdd\xaa9\xf6\xda<pH\xe9\xfb\xd0\xec\xea\xcc\xba\xd1j&a\x96\xf2Z\xdeI\xe2_\xe8\xd6\xbczm;\x1dj\x95Q\x90\x12\xadwRA\x10\xde\x00\xfbj\x15\xe9\xf3\x96\xc20W%\xe6\x0b/\x89/\x90K\xc6\x04\xec\xf2;\xaf|\x1dk\x90\xa2x\x0bO\xf6S\xec\x1aB\xcb\xac4\xc9D\xdaB\x9d3\x86\x9e\xb4\xb2m|\xf8\xca\xc6\xf0\xa7\x0asR\xe1v\xd0e\x81\xa1E6\x0d^\xf2@s&p\x87\xb8\x92GNX\xa3\x0dL\x07\xfc\x9b\xd2 M\xac\xa0T\xff\x1f\xe7\xc7\xd2\xc0\x1c\x0c<\x18x\xd6\xc2\xbetk\x17\xfd%\x8arGsX\xd3\xa5-\xb3\x032\xc8i}j\x89^\xdb)\x13\xf82O}1!#\xb7\x97\x09y'.q\x01#\xf1j\x91\xba\xbd\xe4\x99t\x9f|\xe3!*\x1c\x815\xd4\xed\x08Q\xf7`R\x8e\x8b\xf8\xf7q\x15\x97\x1a\xd80\x11\xb4\xa7,\xa4DN\xe3\x92\x99\x8fq\x16gFa\xd9\xf6[\xf9\x9c\x0f\xa0\xd1^ \xdaG\x94]\xb1\xfb\xd9:\xf5\x04c|K\x115HZ\x99\xb9%o\xcb\xedt\x98\xfa\xf6\x88 \x93\xdeV\xc5}{\xa8\xa8y/)A\xa1\xbaM-\xa4\xed\x16\x14\xe8\xca\xce!\x1f6\x16@\xb8aI~8\xdc`'\x94NT\x11\x9c\xea\xbd,\xcbV\xe7\xa6\x9f\xb3E\xbb\xb6\xd1\xe1\x1f)\xf1\x90Q\xd6T\"\xbc\xa1\x1d\xe7\xba\x9eJ\xaf\xe2\xb6\x9fl\xd8\xa6\x93\x1e\xa9`4\xeb\x02\xfeg\x9c'\x99\xe1p\x1c9\x1b\x07\x9f8\x0a\xea\x17\xc9\xecy\x0a\x1a:R\x00a&\xde\xb9S\xd9\xc6]\xd8|\xee\\\xec\x9c\x82\xfd\xdc\x8fQX3\xc2\x9b\xb4\xdcj\x03\xe3\x96\xd7>\x98\x83\xb0\x8e\x9b\x00\xc5\xa5o\x80\xb2y\x8d\xeb\x82\x95\xbe\xd9\x9a\x17+^\xb4\xd0s\x01\x07\xe6\xe8\x09\xda\x91p\xfa\x99&>i\xbd\xe3p\x94\xab\x95\x88\x85y\xea\x02\x8d-\x80\x18\xfei\x90*C\xe2\x9c\x8a\xa5\xca\x83 \xa6\xc2\x1f\x9e\xb7k\x0eT\xe9\xe56\xc4\x90E\xba\xa5;!\x08$j}\xd7Qb\xd9\xc5\x85W\xd0\x93\x87<(\x9f\xa7Pl\x8cqv\x83\xa25\xbbk\xa6\x82s\x8f\xaa\x939q+\xde\xaf\x82},\xdc\x83\xab.K\x84\xad=a\x83e\xda\x8c\xd2\xad\xc7\xaf\x1aI\xd6\xa6\x7f\xb2\xef\\\xfdRgq9\xf4P\xba\xb3+ \x19VCo\xeb\x8e\xa0\x931\xd3m)Xc\xcb\xca\x05\x9d\xa34i\x0cr(\xd8\x07\xba\xe2\x96}+\x86\x98\x8e:hC\xe8\x9a\xd1u\xf8\xe099\xd7\xae\x9f\x9c\xab\x9f\xc9\xcb\x1f\xfc\x10\x9b2\xf5\xf4\x85\x89\xbd\x1a\xee\x9d\xf7\x1d{\x99\x89\x93\xe7\x80\xcd\xce\xc6x`\xa3\xe7\xda\x1d[\x04E\x19\x11\xd4\xa4\xc5\xf8d:\x9f\xe6 \xe6\xe7{\xd0\xbc\x02\xcd\x11\xb6\xd5U\x8e\xc8<\xbd/+[\x12Jq;(\xb7uq\x99aQ\x80\x0a/\xa5\xa8\x9f\xb3<\x11w\x9b\xf0#\x08s U\x1e\xf6&a\xf7+\xaeD@\x09\xac'u\x17\xab:{\xba\xca\xa31\xe3\xf1+\x06'&\x7f\xdc\xd1\xe3\x84\xefEO\xbe\x97\xd8\xd3F\x82\x99pt\x01\x9aq%\xd0\x8c6\x1c\xca\xb0\x0c\xf8\xf6\xefc\x17\x1a\xd7Mr*x\x00\xa9/\xa4\xed\x8d\xd9\x01\xb1\xed\x7f\xb06\x1f\x8e\x9e\xe3\x03\xf9p\xe3|\x8c\x07\x05\xb2\x97/\xb6\xe5\xd8\xc0\x1d\xca\x0f\xaa\x0el%\xf9O\x04\xe5\xc9\xc4Y\xd3\xc7\x82\xda\xc4\xf7\x0b\xff\x09\x1fa\x03f\xa3\xbf\xf2q\x9du~cnK[a\xf8a-<\x97\xce\xdc!\xef\x18,6S>M;&\xb2\x16\x09\x8e\xad?T\x94f\x86j\xd0\x9ap\x85\x98\x05\xf4\x8f\xe4\x1ej(\xa4<\x9cP\x016W\xaeY\x86:\xa3\xfc\x80\x1emM\xfa(\xf3\x07\x7f\x91\x12II\x80\xd6\xc12\xedX$\xacc\xb1\xc8d\x12\xb8\x90\x03\xda(s.=<\xbdH\xce\xd8\xadY\xb7\x91_\xfc\xc9\xe3>u\xce\x86O\x9e\xd3\x84\xc7\xde`6\xc7\xe4\xbf\xa7\xccP\x9b\xadC\x97L\x9fQ\xf5\x85\x84\xe6\xfb\x80\x07%B\xa0iN\xaeF\x8d\x1dqI\xf4\xceJ\xb8\x80\x05\xd7%Cv\xc5\x02\xd7\x8c\x8a\xf87\xfc0\xea\x8a\x0e\x86\x99\\\x9d7~C4\xcb\xee\xd2)0\x8c)a\x88\x9dv\xfa\x06 \xe1[\x83\xb0\xf8\xa1\x8cM\x8a\x04\x91\xb6\x1a\xdb=%\x95$6'\x857t\xe1e\xf7\x0b\xf0c\xa0\xd1X\xf2\xe6\xcf9\xe0\x95\x00\xef\xc2Kx\xc1\xdfl\xd3\x90\xc4*\xfc\x98\xf8\x1e\xf8;\x97>bJ\xd4\x81\x1b\xbb\xec\xc1\xfc\x9b\x84\xbc\x89\x19`*\xd0\xe8p\x0f\xab\x1c(\xe2\x9e<\x91\xec\xael\xd2\xf2\xe6|\xc38y+N\xdem\xf1W\xe9\xec\xee\x03\x8f\xbe\x8bN\xed\xec(\xed\x0c\x86Q\x01 \x1f\x9b\xf0\xc1\xaf\xb78\x95n\xfan\x94nk\x87\\9\xf4\xce\xe2B\x1dc]\xe9\x00\xb0\xe4\xd6\xf9=\x808\x96ZN\xa5\x04\x88@\x1e\\;B\x99\x0dkz\xdbwW\xefP\xec\x97 \xff\xc8\xb8\x88o\x98\x9bZ\x90\xb4\xbb(\x83\xc0_\xb8\xfd\xe0\xc6\x87\x0dc\xcb\x91\x82\x15\xb9\x1f\xaa\xb9\xff\xa6\x04\x80L\xbe\xab\xad\x0a\x87p\x8c_\xa5-\xadez\xc9z\xa2\x8c\x06\xf9\"\x1b\xa3.p\xbf\x84\xdbPz\xf9\xb5\xc2A\xee\xb9\xa0C\x9b\xcb\x8a%MN*\x86U\xac\x00\x96\xb65\xec\xa3P\xc5H\x00B,\x19\x19\x8d\xd1?c\xf3JWPoe\x9d\x0a\xc1\xe0Q\xfaz~\xf87Vq%X\xfa\xd6j\xb6\x1a\x0b\xfe\x13\xd1\x12\x08\xd1\xe3\xd6_@\xdf\"\x1f\x00m8L\x02\xef\xf1*\xcf\xa5\xdbs\xd52\xb0\xb3$\xef\xff\x1b\x9d\xf2|\x0a\x04UC\xd2V\x90\x0e3\xc8\x85\xd6\xe5q2\x96\x81\xfc+\xcc\xc7J\x7f\x11P\x00\x9a\xe9T!j\x0bf\x15\xb6\x86\x0dvqk\x19j+\x112\x85\xad\x02\xe4\x17\x0f\xc3\x0a\x15`\x14Xl\x9c\xediYG\xcb\xa9T5_\xf9.;\xc8Yi\xa4x\x1a\xe0`\\\x88\xc7\x03\xa7mG\xba\xd4\xa9\xfb\x1c\x85\x1f\xc4\xf6" +
+	"\xbdA\x03\xda\xf7\x89\xe9\x0e\x91\xc0\x02'\xcec\xd8\xc0Jn\xc6\xda\xd4[\xccc\x1f\xc0k\xb0\x1c\xaa\x14tH\xa6q-\xa4;\x0c=&)\x05\xd3\xc5\xbf4\xa8x,D\xaf\xc2K\xf8V\xba\x8biVL\xd4P\x16W\x7f\\i\x97\xb6\x09JkK\x83\xd3\xd0\xbe\xa5AW\xeb\x9c\xb2\xa1\xe5|~\xd6\x153\xae\xb4\x07\x8a\x9b\x1e\x88/:lF{\x1d\xad\xcb\"tp9\xdd\x1c%X\xc3\x0ezz\x14\xa4\xa8\x94\xb3&a\xcc\xbc+\xa9]\xc7\xd4d\xe0\x850\xb6kL\xa0\xd5\xbb\xf8x\xba{#\xaa\x0c\xe4x\x0c\x8f\"\xff\x1f\xf3\xfb3\xd47\xb2\xbf4D\xcd\xb1\x1do5\xae\xf4F\xe5\xc7?\x08f\xa7{{\x0d\xa1\xd4*s\xfe\xd7Jd?\x84\x15\xd3\x8a\x826B\xaa\x9dg\xa0\xce\xc62\xf3\x93\xe4\x8e\xa9[\xee\x00\xae\xb4\xeb$\x96\x96\x19\xfdPC?\xf8l\xd3qw:0\"m\x99\xb6\xcb\xf0(\xf9N\x0f_\xb3\x8b:\xd6\xe1\xd7\xd0\xe5\xf2F_\x84\xb7\x85\x9d\x80\xd980\xa6\xe6Y\x83I\xdaq>\xa5'\x12.\x13\x8d\x9c\xe7\x80^\xc2\xd0\x09\xc9\x14YEh\xaf\xad\x84\xbe\x86\x980\x16t)\xa9\x05o0\xe4-\\\xec\xe2\x8bW-\xd1'$\xac\xd9Gl\xe5\xb1\xd6\x9c\xee\xde3\xa2wnq\x9ff\xfc\xb5J\x99\xe1\x09\x17\x0c4d\xb4c+\x8a\x88#\x9d\xb9\xf9\xcb^\xe3\xe6A\xcc\xd20\xea\xe9\x85\xd0\x1e\xaf\xf0\x99\x99\xe2\x8f\xeb\xf0Z\xbe\x92\xb5Z0\xbb\x85\xd3\x90\xad#E\x00J\x0d\xb8\x87*\xea)\xed\xae\x8e23\xa5\xcf\xe7|\x97\xb8\xf3\xc0\x9d?N4AX\xc9\xf4\x1cvN.\xacsR1[4\x97\x1d9Va\x99 \x05\xab\x15\x92?\x97\x9d\x1e]r5d\x89\x09Az/\xa3\xe8\xa2\xc3(jh\x86\x08\xa1\x0a7Xz\x9c\\pX\xa8\x8bu\x8f\xfaW)\x1eT\xf5:\xaf\x11\x99}\xf3H\xc6\x1ee\xca\x91\x00qL\x85\xb1\xf7\xd9\xcb\xc8\x0c\x07]\x1d\xef\x09\xee\xf2\xce\xa7\xc9\x0c\x92T\xec\xb4\xc7F\x81b\xb6\x94\x04\x07\x09\xb8!\x8b,O\xf6G\x9d\x16\xe4\xdf\xe7\xeb1\x1d\x9b\xc2\xa1\x9d'\xfe{\x9c-b\xedy\xe65\x00\xffI0\x9e\xb7\xe2|1\xdd\x9b3+\xa4\xf5\x0e\x9b\x08\\o\x17\x1b\xb8\x98\x12\xffp\x0e\x19\xcb\x13\x865\xa7t\x11c\x84`\xd7zT\xf3\x12\xdeb\xd3\xb3\xa5hM\xef\x1e2\xda?\xe4\xaa\xca\xd4\x83{T\xe9\x9ag}\xe9\x09j+j}\x86/\x1c\xc3\x0f\xfd\x1b\xdc\x809\xa3M\x18\xcfNx!\x8e\xd5$Y\xd10\xc7\xdex*\xe7\xf2\xfcO\xdd\xe7\xcf\xfb~\x97\x17e\xf2\x83\xb0\xc3\x8d\x9b\x9ak:a\x1dl\xd1}\x8c3<\x89\x87)\xc7.3\xcd\xfb\xac;\xea^\xf5b\xe8\x9e\xb0\xcf3ev!\x94\x83\xd2d\xe1\xd4W\xa6\xc8N\x0a\xb8N\x1a\x12N\xf5\x8b\xd6\xb1\x0d\x1fP\xc9\x92\xf0\xdf)\x8fT\x8a\x02.m\xc6\xdb\xc1k\xf8\xdehA\xb0\x0f\xa4]b\xfd\xe7\xd3\x14\xb7\x08\xf7\xceE;\x8c\x804\x93\x81\xfc\xfe\xa5\xf7jpOz\xd1\x0c\x07\x0e\xa2\x07\xa93\x1dS\xff-]}\xc9\x02\x1d\xf0\xb2Wh\xac$)\xd6\xe2.\x85Awx\";.3\xe8\xa8\xd2p\xbe\xc5\x8ekd\xbe\xd3\xaelR\xaf\xa6\xae`\x8c<\xcfxr\xb88\x9b\xd4\x13\xcb)Wg]6\xcc\xb5t\xa0\xe1=\xddc\xa6\xce\x88OO\x84\xff\xdb\xe9\xaf\xa4\xe7D.\xc3\xfe[7\x02\xd3\xc9\xfa*:\xa8\x87\xebc\x87f\xa4t\xaa\x0b\x93\x89B\xbd\xb6U\xf6\x86\xb7\xf9*\xd4\x9e\x87a\x91!=G\x8a\xac\xf0\xc7R\xb6\x01\xca-\xd6\x02j\xe0\xa4\xac\xcb\x9a\xd1\x9dC\x8f\x0c\xd6\xed1\x15\xe7Yg\x0d\x81\x0bW.\xfbo\xec*\xaf\x86.i\xbb\x86\x1e3\x06o\xc9\x1d\x8a\x94)'z\x00\x949\xb7-\x8f41\xb8\x89v\xa4\xceru]\xbe\x81t\xf9\xf0\xa8\x80Qar&\x9bM\xb7a\x9bTR\x86TZW~f\x0d\xacC\xbb\xe3U\x0e\xe7\x15D\xd5j\x12\x97i\xae\"\xb01k\x0a\xb7q\xe8\xe0\xe4\xbc\xedKZ\xd7\xf8l\xb4h\x93_\xe7\x04;4l\xe9\x92oH\x15Hj\xa9\x03\xab\xfa\xda\xc3cMeI\xf1$\xbc)\x97j\x8a\x19*iP/\xc8b\x10\xf6\xea\x1a\x0ab\xc8\x87$6\xce\xd7i\xbe\xe4_\x1d\x89SX\x8aw\xec\x06o\xb8+jP\x1b(6\x10\xc7V\x9aE\x91\x9dD\xf2'\xfbt\x1e\xf6h\x1a\xf9|$\x8fr\xed\xd8[\xb3\xed\x89\xe4w\xc2\x11\xdf(P\xc5\x84\xad\x9b/Cy\x8b\xeb\x0bW\xe0\xday\x1e\x8c\x0e8\x8b\xf3\xca\x14\xa2\x88gV^w\xd7\x97\xb9\xd2\xafw8\xe9\xc7\xd6\x87\xad\x94U\xfbi\xcc\xe3\xb9\xbe\xeaz\xb4\x80W\x8d\x0b\xdaq\x85@\x88\xf1\x8cF\xe3\x06\x19\xfe\x8cp+\xe6RJ\xf5\xd3\xeb\xeb\xde\x89\xd7\xd1_\xca\xe7 \xe7e\xa5\xe5\xd4:z\xcd\x04\\\xf6v\xb8\x10ym\xf3*R\x86$\x97\xf0\x07#{@{\x97/\xbcR\x0a?V\x95j\x957\xedW\xd8\xf0\x90\xa7lu\xac\x0b;\xeb\xf5mN%\x0b\xa7q\xaa`\xf8\xc4\xaf\x00\xd1b.\x0c,(\xaa\x90\xd1\x96\xd9\xd0+\xa5\xa8\xee\xbb\xde\xcd\x97.dXH\x1a=\xd9\xc2+\xd4\x96\xc9\xd1{\xc4j\xc6R\x00Z4\xc2Z\x9e\xa2\xd4\xd6\x8e\x05\x06\xfa\xa1\x09\xb9m\x01\xee\xec\xbe<^\x19\x93\xb7M\xe3\x0e\xecp\xba\xe1.\x99\xfdm.y\x11*\xa0\xc5\xaf2Fd\xee\x95\x1br`\x98\xce\xba\xa5\xfc\xb5t\x0e\xd3\x93\xb3\x8e\xbbQ\xf1]:f8@\x9a\x12`\xb8d\xe1n\xd4\xfa\xcczs\x9b[lt\x98p\xf7h\x1a\x0fSz\x1f,l\x15\xa0C\xd7\"\xce\xc5\xad\xe6\xc0\xd90\x00\"j\xfc\x91\x93\xf0\x96\xfe\xf1?\xc0\x15\xcd\xa8#j\x83U\xc1t\xa9\x17\xfc\xe7 )\xc9\x8c\xe1\x980\xa5\xe0gXG\xdd\x0c\x82\x8a\xee(\x1a\x8b+\xaeYS\xb48\x08)\xda\x8f\xe2\x9d\x95h\x8c\xbc,\x0cx\xf9\x87\xbc\x91\xc4\xf9\x18\xd5\x06\xb2OZm-\xee\x0f\xb2\xdc\x1eaK\x07Q\x08\xb8\x80\x9bY\xf4b\xf3\xaf\x1d\xef\xd0,\x19\xaa\x89i'\xd7\x90\x03.\xb4\x1f\xd6#\xca\x1a\x91y4\xbf*\x95\xcf\x9d6\\\xd2R\xb4\xdcI\xe6\xc2\xf7\xe9\xa2\xbdw\xacu\xb2'\xd0\xed\x16\x8eh/O\xd5@\xb3\xcb\xb0\x0a\x8f#\xf0|L\xbb\x05Y\x05\xdd8\xd6T\xb2^\xa1\xa8\x0c\x92\x1a \x8er\x98\x1d\x81\xdc\xe88S \x11\xfe\x07\x10\x95h\x9c\xc8\x8cL\x14\xea4w\x02\x9dfK\x93\xa3\xdeE\xb4)\xbc\xf4\xdf\xc3\x99)\x94&G\x0f\x9a\xe6H\x05\xf0\xc0\xc4\xd3c\xe8\x1b2j\x86\xc2\x87\xde\xed\xf3P\x0b\xffE\xf7\x0cB\xdb\xfb/7\x82\x1d\xc2n\xf8>\xfaYk\x7f\x8b\x95\xd0tx\xafU\x88KuN\x19\x0f\xb2g`z\xc3\x9b5\x9a\xf4;vjk\x02.\xfc\x18\x85\xaf\xc0\xf32\x08\xd0\x88V6e\xe8\x8b\xa7\xa6\xc3\x10\x85\xba\xed0\x92|B\xb2\x85\xd2\xef\x8d\xf2\x86\xecO\xe8i\xa0\xb1\x1cH{\xd9\xf4\xe7X\xc3\xf3@\xae\x0f_\x8b!\x0e@g\x7f\xb8\xfd\xb9\xf3\xa38Kay\xd6\x17\xa3d\xf5\xc3\xd0$\x85\x82\xc0\xa3\xbe\x1a6\xe9.\x8dh7\xab\xd5\xf41>\x0f\xd0\xb2*.\xa4\xd7w\x87-h\x05\x9fZh\xb0\xd3\xa4\xbb/o\x80\xae\x95,\xce\xf4,\xdc\xd7\x14\x0e\xe1\xdb\xc0\x942\xcd\xcb\xb5\xa1v`1\x0a\xb0\x8d\xfbQ$\x07\x8b\x01\xd4.\x95X\xe3\x0a>\x09\xabA\xfd\x09\xdbX\xb9\x9e\x8d\xa3%}\x87\xba8h\xa4\x97\x1f\xa7\x84\xe6\xc2\xc72I\xd3u\xd8)\x17\xb9\xd0\xac\xb7e\xf4G/\"kK\x98\x00\x9f\xa9\x1a\xa3\x8d\xffR\xd4h1\xa2G\x8b>\x95\x16O\xf4\xce\xc0\xe2\xf4m\xf8(]\xcdn5\x0f\xea\xe5/E\x08\xb8#\xf8\x9c\x16\x02$h\xe7\xe3\xe0\x08@\xd9\xc2\x0a\x17\xd2z:\x09\xd9\xcc\x04in\xc6\xb9\xe8g:ga\xfa\x84o\xc0\x0d\xd9\xc3(\xfd\x8a\xd8\xfbQt\x83\xdc\x874\xf2)\xf9\xa1\xcd\xcb\xb6#8\x85>:\xc4\xde\xd2Z\x0f\xa1\x9ccOP\xee\xfc1Jt_\xceB\x84\x1f\xbe\x97\x1d\xa995\x1c\xf4\xef\xf8\x9bWH\xd5\xc2&w_&\xfc\xbe\xf6{>5\x1aXI\x91\xbeRJ;\x02*\xd0\x15ffNW\xd5,\xacO\x82\x0b\xc4\xfa\xcf\xeb\x1e\x17\xb9\xef&\x98\xa5\x9c\x0c\xe4\x11\xca_\xedH\xe1\xbe\x87\x99\x9dH\xc9kXOM?.\xc1\xac\x13\xf4\xad\x99E\xb1\x9e\x08\xdal\xb4\xf2\x0e\x8b@\x9a\x987\xa4\xd1T@Bt:\x1f\x12zk\x8f\xceL\x8a\x7f\xb7\xe5\x9e\x09\xcb\xb4\x93\xec\xcd\xcc\x01\xde\xb7q\x91\xfa\xa1\x0dYV;R\xfcF\xd2\x05\xce&?\x93\x8a\x0b\xe7\xc2\xc6\x0b\xd8\x8e\x80\x03\x8cL\xe5@\x05Z\x13Gy\xe3\x12\xb6\xd5\xd6\x89YI\x89d\x8eh\x9f\xdd\x11\xa9Z\xd8\xe3C\x8f\x16\x84\x17\x04+[\x0a\xb3D\x9df\xe6\x09\xf5\xdd\xbf\xb2\xcd]fT\xef\xf8,\xdf\x84\xa8\x87]\x80\xd6l-,,1V\xa8\x159\x95uo\xd7\xcd\xdb@\xc5\xfc\xf2\xfc\xaf9\xd1\xe0\x15\x8e\x97S\xe9\xe9W\x0e\xf0\\\x87\xf7dxF+\xa2\xc83\x9b\x0eU\xe6D\\l\xd2\xf01\xec}]f \xf7I\xd8\xa9u\xa1\xea\xf2S\xd8\xa1\xdd\xde\x17=\xdd\xcb\xac\x06\xc2\xc47G$\xa4a\xfdk4}N\x09\x0eS\xb0\xedX\xads\xcd]\xdc*\xce\x91&Y\xe9\x87\xb7\xdd\xb0\xb7\x01\xb2\xf0,H\xf9\xfaY\xd1\x8f\xab/\xbe\xf6\xbeb\x04\xb2R;\xc4F\xa0e(\xfd?\\\xa4\xac\x88@\x8cO5\xc3\xd6B\xe5C\x84\xeaA\xb9,sf&,\xc1\xddP\xfb\x01!\xb8\x1cwcN\x93\xfb!_\xd0\xf1\x1a\xacAvZ\xa8\xb8j\xbd\x8bK6\x84\xa6\x9f~\xb8\x08y\xc7\x85\x0a\xb9\x8d\x1d\x0b\xbdS_\x96DC\xe5\xc9jj\x90\xb8<\x88\xe5\x00\x0cY\x9ae\xff\xc4\x86\x93\xaa\xc8\x0c\xfd^\xba\xb3\xab>\xaf\xad\x0a\xff*\xa1\xa0\x91\x04\x84BM\xf8\xe6?\x87\xcf\xb6\xe1}\xb4*\x88\x9c%w\xc4x\xca\xb6R\x98$^\x91\xfd\x88\x16\xc5C1]~u\xd3s\xc2\x9a\xd2\x9et\xfb\xa6um`\x14o;naX\xd3\xc4\xddpO\x1f\xa9#\xfc\xa4\x90b\xa4$\xe7[\xdc]\xb3T\x0b\xe2\x9c%Kv=\xedV\xd7\x7f\xe2f\xb0U\xb4\x97j\xe2ho\xf2H\xc5LK\xf5^\x9aH\xb2K\x08R\x1c\xab\x8a\x81\x8ep\xca\x19\x0c\xf7\x17\x8f\x9d\xf0\xe2oxr\xd7\x97\x9d\xc7K\x8f\x95\x12&\xf8x\xcb\x94\xd7\xa4\x9emH\xabp\x8c\x80\x1c\xa7cU.\x10\xad\xd2F\xb6F\xdb\xc6\x0d\x03\x90$\x80\xcb\xb6\xed\xfa\xba_\xedUP\xa1\x1af\xa7Q\x88\xf3S\x0aS;\x11\x80\xe5\xd5c\"+\x90\xf4,\x9d\xe5\xb6\x83\x97Q\xe9\x00\x1a\xafV\x0dm\xd9\x98\xdd|)GeD\xaf\"[\xda\xa1\x188\xdf\xf8F\xc9U\x0f1\xd1\x09\x12\xa979!\x12AY\xf9\xe7\x83#r\xd3\x01\xc4J\xa3\xdc\x11_O\x15\xee\xa0\x1df\x13W\xa5\"Q\xd6MHA\x18*\x85\x03\x9c\xa2B\xe6\xb1b\x95\xe9\xd2\x9d\x80\xbd\xa0*\xaca\xeb]MH]\xaf&\x94/\xcb\xd4\x0c\xa5\xa9nl\x02\xf4\x1b\xb4/nL\xc3R^q\xde!\xa7q\xe9d\xd7\xc6\xba\x81s\xf7K\xa9\xc3\xcd*\x18\x9c27\xe6\x1cdF\xe0?V\xbf\x15V]\xff^|\xd3\x91\x19f\x9f\x18\xf8\xa1\xfbG\xf6\xd6z\xea;t\xc7\x04};I~iz\x80\xc2\x1d\xdf\xdaL\xcb\x1e\x9b\x0fiS\xe1X\x9d@\x02s\x96\xe7'\xfc\xd6\x10\xcc\xe0\xf6l8C\xf7^\xcc\xd2\xdc\x9ew\xef\xc7\x04t?Q\xf3\x80\xd3!\xe5\xd4\xd7\xedE7|\xe1\xc4\x9a\xd0T\x97\xc3\xaf\xdb\xcb\xdcK^\xdb\xb98\xd1&\x8b\x87\xb0+\x9d\xe3j\x84M\xba9E\x7f\xf5\xf6\xba/\x87\xb7\x09[\xce\xdbU\xb8\x00\xa1:F\xbb^zI0\xc2\xd6f\x96\xfd\x83\xdb\x1b;VX\xe95\x8dO\x8b\xb4h\xf0\xbc\x99T5V\xbb\x0e\xe8\xc1\xf6\xdf\xfdmW\xb8\x91&\xf4s:K\xd8\xcat\xc3\x90\x01$\xd3W\xc5\xb0c\xfa\xe5\x8b+M\xc2\x19be;\xe2\x98\x8a\xaax\x06;\xe3\xb1\xd7\x1c\x1eh\x02\xd8%\xda0\x1e\xca]\xae_\xce:\xe2\xfe(\xb2\x84\x09\x86\x95\xa1\xf0w-\x81\x0c\x85\xa4\xc7_#\xb9\xedLv\x11\xb8\x0b\xc2\xba\xc3\xf7\xcf\xd0\x9d\xb1\x98X\x9f\xc4\x18\xc6}\xd6\xc1\x9a\x0c)&\x050c\xc8\x93\xeb?h\x14!:\xe4'\xb9\x87I\x0eqw\x038`1\xa6_\xce\xbb\x03\xb0\xc6\x949\x11k\x8b\xb6\x07\x00%K\xa7\xb9p\x8e\xc8\x86\x11T\xc4\xb8I\x0f5\xb6/M\xbf\xd8\x95\xca\xc2\xe5\x9c\x1c\xd9\x87\xff\xe84\xbf\xbf\x81O\xbf\\\x8dD\x80\xd4\xb6\xfd\xcfh\xdb\xf6\x17\x1b4\xcf\xec\x03o\xf1mO\x06\xbc\xac\xe9\x97\xbf\xe2\x1eM\xbf\x14\xfd\xa3\xf1\xc1\xd6\xea\xf2\xef\x0d\xe5\xf4\xaf8CS\xcbA\x9f/\xe1;\x94U\x88\xf3I\\\xa67\x116\xa7\x9b\x06W$\xdeR\xd6\xda\x8fq\xa3c%\xd7\xa1\xbe:\xd3\x04\xc5\xf0\xfa\x03\x02\xfd\xfb\xd8&\x07\xa6\x89\xad4\xb3\xdf_k\xd9\x87\x00\xa5M8\x10\xde\x07n\xb4D\xd3q\xd9\xef\xee\xd3Ze\x97\xcb0y\x9d\xec\xf6O\xa7>\x94x\xe3\xd7\xc0brRl\x94\xcd\xe2Ci\x81{O\xe8\x1a\x0c-\xbfZ\x1f\xef'\xd5\xea\xcag3\xeb\x85\xb7\x14w6W\xb0\x1cA\x85O\xa7\xb7\x83\xab\x8f\xe1\x9e\xa7\xac\xc0\xb3/\xa5\x15x\xf2k=\x9b\xfe\x8d\xd8dzv\x96g\x06z\x99SOY\x93gy\x90\x81\x8e\x16)\xda\x9e\xca\x814=\xbb\xe8\x0f\x13$6P\x07tWt\"\xa7\xa6\xeb\x7fmX\x02QN\x836)X\xaf\x0ds\xd7\x1d\xe8\xfeX\xa5\xa9x\x7fW\x0e\x15\xef\x05\x13\x08\xf5\x84\x09\x95oJW8\x94\xc3\x98\x9e\xd9\xb8C\xf8W&.M5\x82\\\x9d\x9e\xdd\xfc\x8d|\xc3\xf4\xec\xf6\xaf\xec0\xe7_\xca;\x0cy+\xe1P\xfc\xf1\xdf\x99\xd9\xe7g\x7fe\xa79\xcfg58\xe0\xef\x94\xf1?qN\x9fgs\xba\x90u\x01\xea\xb4r\x8djz~\xd9}\xc9\xf7\xa0\x96\xb0q*\xca\x83\xce\xdby\xc6\xa4\xfe\x94n\xfe\xb44\xc2\xd4\xf5\x7f\x9b\xea\xe9\x8eE\xd2m\xa8\x06e\x97my\xafu\xdd\xdd[\xdb\xc0\xbcV\x09\xa7\xa1\xf6\x84\xe9\xf9m\xfenZ\xc4-\x7f\xe6\xdd\\|\xf1y\x8d5\xf6Nk^\xf5\xd4.\xe6\xe9\xc5\xd4\x81[\xfd>\xe8* Oi1\x17o(\xc7\x87&3WsX\xf1@*\x93\xe0{\xc6\xba\x1c\xa5\\x']\xe4\xc0\x0d\xfb\xa0\xbd\xa5\xc3\xd0\xab\xd2\xfal\xac \x85\xf7\x83\xe6[J\x86/U\xb1J\xc5\xa4\xb11\xb24P\x97.I\xb2\x0d\xcf\xea\xbdq\x07=1\x8c\xacP\xda\xa5\xb8)\xdb\xa6\xe5\xf4p)\x02\xf2&K\xca\x0f\x84\\\x07\x1d\x9a\xd2s:d\x8f\xe6\x91\xcd\x13\xd6\xc9\xb0\xa3v-1\xe17\xc2\x82[|^\x1b^k\xe71d\x99v\xc4NgaT\xe5\xf7p;\x04\xc0\xacp\x1f\xe2\x88d)4\x94&\xf9Z\xa6s\xbb\xb4-}\xb5\xc3kv\x94*\xc1.\xa7\x94?\xc3\x02R(Ix\xb0\xfe\x93;b\x14\x8a\xc7\x0c\xec\x13\xe4\x9dy\xab3(\x92\xdes\xb9\x9c\xbf\xdd\xe1\xc8(\xd1i\x16aC{i\xffil\xd9\x17y\xe3\xbf\xfa\xa1\xb3\xcd\x9f\xdb\xf2\x0ea\x0a\xbb6\xd5\x85\xc5\x03`@]\x14\xf1\x12S\xd3\xe9\xba\xb6\xc2u\x03(\xd4\xcb+\xf7\xa2\xe8z\x06o5\x14Y\\^\xbbL\xcf\x8e\xb3<\xe4V\xabJ\xf1\xa9\xfd\xe6\xd3\xcb\x9bN\xee\xbf1hpUw\xb5DIK\xb07\x9b\x89\x847\xf0;\x96\xd04\xda\xcd'\xba?\xa6\xfa\xeb\xbb\x0aX\xd4O\xb4\xfcfE\xea\xb7\xbf\x82\xbd\x99^M\xb32c\x96\xe8\x1bHfO\xaf|\xd2T\x01\xb2b\xcb\xb6\xd8\x91P\x9e\x8cW\xe7\xd9S\xa0\xd5\x033\xfa\xa6\xdc\x08\xe5T\xe0\xf4*W:\xe7VN:\x1fe\x8a\xe6\xccvX\xc6\x11M\xaf2\x8d\x19\xfc9\x95\xdd\xa5\xc5\xa28\x1aW\xc3\xbf\x96M\xab\xf4\xfb\xeb\x0e\x17\x92\x06\xa4\xa3VFk\xaf\xe9\x98\x09\xb6\x15#\x0cg)\xd0q\xcf!>\xe4\xe3k\x05V\x08\xa0~o~\xb9\x12l\xae\xdb<\x03\x06\xab\xd9\x18\x8d\xcf\xb4\xaf\x06{\x80V#l\xb7Q\xfcK\xd5\xe70\x0f\xfaS\xd7g]\x08\xa2\xee@0\x99\x80\xb7\xa1" +
+	"xo\x9d0\xf2^F\xdd\x9cH2\x09\xa5\xd3\\\xe45\x11\xd5M`\xda\x9e\xe2>k\xeb\xae\x90U\x95\xaeB3\xc2\x83D\xac\xd7\xd6H\xcf\xc2\x96\x11\x85L$y\x00[p?\xc0\x17i\x0d5\xa1\x92\x88\xf1r\x0b8\xab\x07)\xcc\xc0\x9f\xffL\xc0\xdb\xde\x84\x07\x1d\xe7ryz\xea+\xad\x9f\x1d\x9e\xdb\xee\xf0\x9c>4\xa6\xaajl5\xb9.&\xeb\xf4\x82\xf4m\xe3\x96\xfbf\xeaXo}\xbcy\xc2\x8c\xbd\xc9\x88\x9e39\x93r\xe5\xaf\xd0pv#\x0dgo\x03\x02\xe2o\x93\x9cV\xfa\x99#8\xb0-\x7f\xbf\xdd\x0c\x01|%\xc1\xacvBc\x1e\x9b\xf0\x0a\xe6}>\xd8\x84\xd6%\xb4\xcb\xa9\xd6\xe9\xef}\xfa\xcf\x8c\x12\xfc\xaag\x12v4\xc3h\x83\x09o#j\xd5\xd0\xe9#}\xd9\x8e\x98\xbf6\xdd\xe1\xf8Ic\x98\x80\xa4\x03\xbc1-6\xb8\x84\xf6(q\xb6\xe9\x17\xe5\xd7%L]\xa3\x18!+\x1d&k\x92S\xf7\x89\xef\xe2!D\xd2?\xd8\xa3\xd4\x7f\x09_ Le\xd1J\xc9\xfd\xab\xb0\xd6'\xc2g\xde\xa4\x08\x94\x06\x11;\xc0\xd8\xfe\x1f}\xc9V\xfd\xb8\xecfH\xb2\xe8i\xb0Y\x0e\xbc\x0flsc93F\xaa\xb5zw\xdfM\xc6@\xe8A}\x07\xd3\x06%\xc3\x0a\x93\xe9\xc6r\x8f\xb2u\x13_GV\x82e\xd3\xe6\xfb\xa1\xc6\x0d+\xf0/t\xb2\xb1\xa5#*6\x1e\xaa\x0c\xc3vx\xb0\xf3n-\x02(L=\xf9\x02\xb1\x8f\x91\xa7\xb7\xa1\x96\xe5\x96\xa3\xb4\xedHc\x9e\xd1\x19!i\xd5\xdf\xe8\xb0D\xd4\xe0^I\xc1\x8c\xd2\x00x\xd7#=|\xda\x13\xbf\x99\xc4U\x1aX\xb8\x93X\xc7\x15@\xd8=\x97\xd2Cx)\x9d\xf1\xcc\x96\x84\xb0\x19\xcfX\x01\"\xf2%\xe4M\xc3\\yMZ\x91\x9c\x95}\xe6:\xda\x1a\xd2\x8e\xfd\xd3\xd3\xd5f~\xc4:6x\x1e\xba\x00\xa4\xe5\xb0\xf3\xc9\x91\x05\xadc\x8bA\xabb\xe1\xc7\x1f\xe9\"\xa3[r\xac\xa0\"\x89H\xea\xf8\xd2\xa02/\x9d\xee\xb2\xa3i\xc9\xe5\xdc\x15Hr\xb1\xeek\x89\xca\x89\xe2\xca\x95*\x10\xc7\xa6\xb0\xa6\\I\xa6\x87\xc4\x1a\xbd\x0e\xe9\xf7O\xc9\x10e#u-n\x0fH$8\xdc\x0dH6H\xcb\x17\xf8t\xda\x06\x02\x8f\x81\xcb\x98\x82\x1fV\xeb\x8a\x0d\xbcAa;C\x12\x0dQO\x82\xbe\xca\xfe\xcbw(\xff\xb1\xdfRr\x98,\xa3H\xe5x\xe19\\j\x82\xe9\x15\xf9t7\x00|\x02\x15\xea\xfe\xd1\xcc\xf8 \x91Y\x1bK{\xa0\xa7\x1e\xbe\xe3\xec\xa0\xfcm\xf1\x9e\x1dS\xf5kRw\xe4=\xb3\xa2\xf6\x0a[\xeeR\x89\x89\x1af\x82\xed\x1c|\x0c\xef\xa5kX\xaf~\xc5\x90\x95\x0d&\xce\xb7a\xcd\xbf\x7f\x83\xbd!<O\xa0\xcf\xad1\x9d\x84\xf0\\\xfb\xd2\xe9\xcf|\xa2\x94\xe4\xe8\xcc\xe6\xa3/5\xce\xe2,\xbc\x90\x9e\xaa\xf4-\xd0\xe1\x9c\xe5x\x8c3\xa4\xab\xa8Y\x16\xe4C\xfa\x09\x1c\x81\x0f|\x82\xd4=\x0f\x84\xc72{\x81\xe9\x02\xe23\xbf\x86w\xb4\x05\x98\x94Z\x1a\xe69\x07\x92\xa7\xf2\xdf\xb8\xbc+ok\xeb\xf0(\xe46\xa9\xdd\xe1\xc8\x9a\xe3\xc2?\x92\xf6zb\xf7j\xed\xdf\xa6AU\x81T\x0bDn22\xb8\x81\xadd\x19[c\xcb\x07^w\xa7\xa1\x80\"9\xec\x03]`\xa0\xf0\x1f~\x857\xb2\x8b\x0b\x1e\x08\xf8\x04\xdaC'\x1a\x1e3\x95\x95\x1a\xfeG\xcc*!\xb3QK\xe4>\xc5&\x10\xcbd\xdf!ZL\x8f\x870'\xad\xbe\x92d\x1c\xecwqN\xf9\x7f\xde\x91\xa0\x9e\xf6+.\xed\xc4\x82\x04v\xad\xa0\xf4\x0d5\xa3B@\x85\x94\xd9\x04I\x04\xa9\x09\xf8k\x13\x17\xe9Y\xb9\xe5\xb80\x9a\xb6\xc1\x02N$\x11\xa7h9J\x01heS\x01\x80\xad\xaf }\x18\x1b\xacIMb\x1d\xfe\xed\xbf\xcc\x95\xe7\xa96O\xd6\xc1\xde/0\x09\xdbR\xb3~\xae\x85\x94\xad\x06/\xff\xd3\xb3(T\x8c#\xaebC\xd0#PF\x04E\xa0%\xa6\x00X;F\xdbA\xbc\xb6\xeaO\xb2\xeb\x85_\xe4D\x05\xc9\xd6\xa1Y\xdfr\xd3\xc5\x81)\xbeN\xd8i\xce:\xb2\x09>-m\xc8\xc8G\xdc\xa54$\xd0B\xa0\x14\xc1\xbf\xe2\xb2\x1c\x0e5\xdc#\xd9\xf7\xb5S\xce\xdd\x0fv\xf4\xa2\xaf\xb7\xb4\x01\xc4gW\xc6\x82<\xee\x14\xe8?\xb1NG\xdb\x15\xae\xb3\x17\xc1\xce9\xbaqj>\xbf/\xdd\xac\xed\x10\xdb\xb1\xc8\x83\x90\xc4\xc7\x95U\x86eT\x0c\xda\x83'\x0d@\x88\x0e\x96t\x06\x8d\x90#\x93\x03H3e\xd17u-\xc6M\\\xa9<\xa8Y&\x07V\xcc\x12\xba3ew?\xc5{8\x9f\xfam\xec\x88#\xa8)V\xe3!\x98$\xa5\xf61\x02\xb8\xbe\xdfE\xb5\x1d\xc6\x19\xd8q\x0d|\xe7\x80\xcf{`\xff\x11\x9bvp%\xd1X\x11\x06\x9220\x90\x9eO.\xbe\xac\xe8b$\xa9uj\xe8\xd9\"\xee\xbf#nC3(o\xcc\xb1\xb9\xfdAw\xcdC\xb2\xba\xbavH'iL\xfc\x15\xb7\x196\xc0\xd9\x1bi\x08z\xda\xda\x1d<=\x1b\xe2:\x93}C\xa5#\xd6\xf5-\x19\xe4\xf3\x0e\x00\x17\x131V\x18\x01'\x09\xfd\xbb\xc6H_dU\x95\x8a\xaeG\x9b\x90`\x98\x0b\x11n\x1f\x8e\xdd\xce\xaf\\i\xf7\xd1\xed\x0e\x10\x0a$\xaf]\x99\xc9\x93\xbb\xc5\x04J\xfb\xf0\x88\x1e\xc7Cl\xc3\x0f$3-\\\xe5\xba\xc3w.\x1dM\x14\x84\xc0\x8a@{\xf7\x81)\x801\xdet)\xe8c\xd6~\xafR?bB\x80\xde\xa8\x7fB\xdfft\x16\xe1\x18\xde\x85\xfa#\xfd\x0cqi\xb0\xe0D\x17\x12]9\x98\xdbe\xd3~\xf1\xa5\x0b\xac\xf8\xfd}\xd5\x91\x95\xf7\x05\x1c\xeb\xd8\xc6\x06\xc0\xc6\x08\xc0\xd8\xa0\xe2#\xb5/\xacY#\x14\x00%\x1d>\xd2\xad\x08\x04\x0f\xe4\x0f.,C\xc7>.\x80\xbd\x8bh.\x17\x86\x0d\xf8\x03\xe9%pG\xdaC\x0c\x8b\x11H\xffY\xcf\x0b61\xae8\xfdF\xba\x03?\x94P\xf6\xc8\xbd\xde\x19\x1d9\x8eK\x1aP)W\x8c[eS\xfcoPp\x10I\xba\x16\x93\xf03|`\x15x&zV\xe4{\xb1\xd3\xf1\x10\x97H\xc0L7p\x8f\xef#\xdd<\x81\x8c\xa5k\xf4sf\xfc\xe2\xb2\xf4\xc2\xa5X\xe1\xc0\xac\xe0\xbdY\xc4&R_6\xb8\xd9\xb9\xa1h\xc1>\x88U\xdc\x86\xb7\xc2\xc6y\xd1a\xafZS\xf1F*\xfa[\x84\x93\x85\xb7\xf0}\"\xa3\x0f\x9f<\x95N\x9aclT\x8e\x97\xdf\xbckl&\xc1s\xa1\xae\x8bm\xe9\xc47}#vG.\xd4\x91\xe52\x90T\x8cB\x9bg\x81]\xc6\x19|\x8d\xc1n}\xd2\xf5:\xb6C4\xe0h\xb9;/\xa5\x1a[\xe2\x97_\\\xe6\xcaj\xa3\xd7i3\xc2\xac\x89S'\xd9\x16\xa7\x8f\xe9Eg2\xe7\xd6)\xa9\xb7\xaa,\xcc\x9fa\xf5\x95\xf9\x9b9Z\xfa>\xbc~-Z\xe1\x946\xfeu\xd8\x95\xcfu\x9e\x8d(7\xa1\x08-\xf0D\xb9~\x87\x1d\xeb\xcb\x8b~H;\x91\xea/\xf9\xe4\xda\x18\x10\x97\xc5]\xc24\xa7\xd3y\xf4\x15+\xcb\x0b\xd2}\xec\x07^\xf0\x95y\xc1$\x91\xde\x12\x9317\xe6\x86m\xf8F\xfd\xbe\xb1\x8e\xab\xd2\x0d]\xe77\x84\xc9R\xca\x03\xc7\x95t\xdd\xc2F\x1a\x0e\x05\xb7\xe7\xb2\xb3\x91J\xe7\xdc\x82,0\xd3,\x0d\xd3n\xcb]4\xe1\x9eE\xb4\x1f\xac\x1e\x1as\x85\xecI\x16G%\x15\xc8\x00\xbc\xa1\x872\x1cv\\})\xd1\xfd(\xcf@\xf7B\x8bX\x9b\xefA\x041\xfd\x13\xc6I\xfah\xed\xc0\xcf\xc3\xbet\x03\xd3A\x01=\xaa\x08=\x11\x12\xdePk\xa5l\x11\xc8\xc1\xd2\xfe\x9a~\xf63\x1c\xe3\x0c\x87\xad\x1dy\xee\xb3\xde\xa4'\xcf\xe0\xa5x\x85K\xb4\xbf\xa9\x83\x9a\x14@U\x9b\x9eW\xa61n\xaf\xe5\x07=w\xf8\xe2G\x86\xb0\xa1\x92'x\\\xd2\x86\x9f\xb2\xcc\x1f`\xad\xf8e\x8em,\xbe\x8b^\xf2\x0f\x18\xd1\xc3\xe56\x98\xc5[c\x9eopl.\xbd\xb2\xbaa\xf8\xe4w\x82\xb5\xb3\xc6`\xbf\xfb\xe9\x80x`\xc1\x9b\xc6\x99\x10W\xa5\xcb^\xfd!\x83dW\xb6\xe7#\xceJ\x17\xbbv\xcf\x88\xd8H\xcay\x0e02\xd8 ]\x906\xd42\x09a\xe18/\xb6'\xc2\xe2&4\x92\x89\xdc\x96\x88!\xd1\x09mSql@`\xe3:#\x9eh\x93\x1b\x0c\xbf\x86K\xf1\xdbYg/\xd4\xa8\x94o\xc7I\xaf\x0d\xc6\x9ejZ\xb81gp\xbft=\x02\xc6\x93(\xa7\xcc\x95\xf7\xf1\xde|\xe1\xcf=\x80\xe3EI\x02\x90\xf3\x08;\xda`\xe9l{x\xc8\x16\xd3\xa4G\xd3\xe0\x98v\xb2\xfbb[\xe0\xb5'\x96\xbd\xe7\x09j\x9f\x03m6\xa90\x0eh\xfdu{\xf7\xef\x90\x80\x08\xec\x9f2b\x89\x98\xb8\xeb\x9b\x19$\x1b\xcfc\xda\xd8\xfa\xd3\xcb\x12L\xdb\x82\x88>\xe1\xf3\xc4\xcad\xc0 %@\x9ejX\xeb=\x85\x1d\x1a\x9d\x9cv\x85\xc0\x0b\xed\x18#\x80\xa4\xa6\x89\xa1\xb3\x95\xaa\xba\\X\xe5\x07\xd1\xe6LX\xb4\x09\xdf\xeb\xf0\x9c\xc8zY1r V\x10\xa5\xdf\xe0\xd8|\x1f\xee\x0bk\xd7\xe1W\xee\x14x\xbe@6\xf1%('xT\xf2 o\xe3\xf5m_\xb6\x01\xf5\x00R\xa5..;\xe7\x1bA9\xdf|\xe9>*>\x96\xf1\xdf\xe9\xe4'\x10\x07d\x0f\x02\xfb\x1c\xa5-\x860x7g\xdd\xbbHw\xfd\x12v8V\xc2\x83\xe7W\xc6\x18\x8f@\x86\x91\xa4\xa720\xafA\x14\xdb\xcdE\xcf4P.7\xfegXc!q\x90L =\x03\x82%\x10+F\x8ec\x8dC\xdd\xc4\x1a\x87\x1b\xb2\xdf\xee6G\x1e\xf5\xcao)\xdc\xe4\xaaR\xae\xd2\x13Sc\x0b\xd1\xbdn\xa2fo\xd5l\xec\x02EKG\xa8\x07\x8a\x9a\x09\xd5g\xb8\x07\x9aX\x01\xb2\xbc\xe2u\xbaM\xae\x02%\xbf\x1c\xb2\xab\x80a\xbau\xa4\xd9/\xb1\x0e\xfb\x01,\x93\x97\x9d\x97,\xb2>\xbf\xe4\xc9\xdc\x86.\x1a\xfa\x7f\x1d\xe9t\xa7J\x0c/^\xf1z\xe1o\xd1VJf,\x1b\xa3W\xe7y\xecr}%\x1br\x9ea\xdcc\x95\xfd\xc6\xf4\x97\x09\xfc\x8b\xab\x01\x05\xb9\x04J\x82P#\xdd?\x13.\xa2\xc0}:\xd55@\xb4\xc2\x16\xde\xb8\x82\xa7\x0c$'\xd3\xa4\xa8\xc3\x14\x8f\xae\xa6*\xa8\x1aS\x85\x9b1]\x02\x80y\x88\xcd:;\x0d%]\xa1\xcb\xd2\x80\x8e\x19\x85\x8f\xe5\x93B\xa2 C\xda2\xd3\xbc\xdc95\x83\xc3\x00an\xe9\x89<z\x01\xee\xb3\x88\xa5\xe1\xc5\xdf\x18\x157\x01\x1a\"\x15M\xf3{>\xad\xd8\x9c\x13\xa9\xfbuj\x01#>\x11\x1aq\x97\x93\x01(\x1a\x91Fw$\x7fn\x8f\xad8Qs\xc0\xfa\xa6Q\x8dt\x92\x95\x0bt/X)\x13\xcc]\x85)\xce\x1ae\x04*\xd1\x8c\xf8\x8c\xab\xed\xc7@\x09_I\x09\xccj\xca\xfd@\xa5\xa4\xa30\xfc!\xc1{\x93\xac\xb4\xd6c_\xfa\xe4\x18DC\x05\xce\x02o\xaeEuY\x11<m\xed\x1dH7\x12\xa9\xe7bn\x80pp\xccFn\x0a\x00\xf4\xa1\xca}\xa5\x8b\x84\xdd\x04\xe5/\xc2\x0f\x8a\xb9\x9c%\x92.O\xc5js\\\x963\xa8\xe2\xdcg5\xa1\xe4\xe5\x0f\xa8\x98{\xdb\xaf\xa2L\xe2\x0a\x99{\xf8@G\xe4h\xd4\xdaY&\xe8'\xe6uyG^I\xe2Nsn\xa0\xbf$\x94\xe7\xf8#\xd1\xd9\xd2\xa4\xed\x0b\xfeb\x93\xfe\x81\xf9\xad\x17\xa8\xe3\xa5\x09\x17\xbf\xd2{5\xa4\x1d\"\xf9\x7f\xcf\xd9\x8c\x19\xd5_\xbe\xb1i)\xb2\xa0J\xaa\x97\xe4)\x90\x09A\xb2b\x89\xcc\xa1\xe9\x9d\x887]\xc9\xc8\x9a\x99\xe0\x8c|J\x12\xf2H\xa9<\xceC\xe0~\x0d\xbe\x9b\xa1\x82\xa1G\x82\xd3\x84G\xec\x99R2Cr\x80I\x11\xc5\xc5|\x94&\xd43\x19\x14\xac\x08vO\x8c!\x83\xbc\xef\x13\x19l >s\x95\xf4t\x06\x08\xd3w\xd8\x1b\x86\xf9\x99\x19V\\\x09 !\xe4\xd0\xe2zX\xc0[1\x11\xe0\xf0\x8db\x9f\xe7\xac$\xf8/U\x96r%\xd9{\xbc\xdb\x86\xc8:k\x96\xc5yf\xe3\xc3\xf8O\x08\xed@\xfa6\x83I\xd2#\xc5\x15K\xef\xef\xd2\xa1\x19\xae@\xfa\x15\xfc\xbe\xd8Q%\xd6\x04\xc6o\x95\x98\xa7_r\x05\x9a\xac\x11\xa3[\x9d7\xba\xab\xd6\x04\xc8\x91\xd4<\xae\x18LVrs\xa2\x15p\x0c.&\xa3\x9aM]k)\xa8<\x86\xef\xf6\xd9b\xa3\x0diT)!\xdb\x06&\x9d\x8a\x0b\xa3U\xb6\xe9\xb4\x9b\xfdiI\xdd\x05cE8\xe5\x9f\xb8=\x8e\x8b\x83O\xcc\xfe\xf8\x1a)\xaf\xe9\x0dI\xdf\xce\x80\x17;=\xef\xbfc\xf0\x9bf\x13\x0a\xf6\x00&\xb9\x93z\xb9\x10mS\x844\xc0Yq\xe1O\xafN\x06\x0dB\xe7\xd9\xe1\xb9c;\x8c\xeb\x9c\xa9\xe4\xa0\xe4\xe5_\xc37\x11\x0bRwN\x9eJ\xbcA\x10\x8ej=\x18%<\xc2\xa7\x8cv7r\xddT\xf4k\x0d\xeb8\xfb-m\xd8\xe0\xaa[[\x9c\x8b\x91\x03J\xe7\xd4\xd6\xb8St%\x1c\x96?m`(\x9e\x0c;f\x95\x03a\x84\x08e'\xdd)t \xdfJ\x0b\xc6uf\x86\x1d\xca\xaf\xbag\xf9\x05I\xddn\xf3QU\x8a\xdc\x97\xbeG\xf9s\x05\xd4`\x15\xa2\xe1)o\xd4\x0fV\x0e\x19\xa6\x0ca_\xbb\xa0&\xa4/|%\xde&\xfc\xa7\xdc\xec0P\xd5\xd9\xbaC\xd8q\xfa\xc9\x8a\xd6%\xe3Aq\xaae\xa5\x90\x10@\xb1\xcbt\xa6U2\xcdV~\x9d\xd8\x04\xf8X\xfc\xeat\xdbx\xeb\xb9w\x91\x9ctn\"]l\x0d\xfb\x9eq\xa4\xaf)M\xb2\x9b\xa4Q\x8f\xf3\xf0,\xf3e$o\x7f\xf6\xc5w\xc5M\xb2\x88\xc8\x9a]\xdbH\xf6\x01\xb527)\xc3F?&2\xb4\xef\x8a\xc7p\xd3R\xf1\xc6\xeaw\x8b<\xaf\x8dfPF;\xef\xcf\x83\xb9\xd3\xff\xc2\xcf\xa6\xb9,\xea\x0ew\xa7\xa5\x8ff\xd0\xe7\x15gW@l\xf4\x9fY80,\xb8\xa5i\x09\xd2\xbd9\xea\xeb\x07\x8b4\xa7\xf4\x19\xae\xa4\xe4\xb1\x1d\xd1\x02Q\xb0\x86\x16\xa4\xc2\x06\x13vu\xd2\xebL\xc1\xc1\x04\x0a\xa7{R\x95\x03 \xc8\x9e\xb19\xe9{x\xf5\xa9Z\x96\x16\x10Z\xb5;y\xb3R\x95\xf8\x1dT\xc1\xd9Yw\xb2\xa5\xac\xf2\x83\x19\xa97\x90\xbb'\"\xb1;\x15\xbf\x9d\xa1I3\x9c\x9e{\x15\xc2\x8d+\x9c\x13\x0c\xdf\x03\xbf\xe5\x00\xd3\x01\xb9$x.\xd5\x06ve^\x8e,\x9eS\x05\xbd\x0dT\x98OCo\xd8\xf3\xca<\xa4\x9d$\xfc\x98p\xee\x81x\xc6!D\xdd\xb2<\xe4\x0f\x0cu&i\xee\xb3\xb8&\xa5_\xa1Y\xd0{)\xf0\xf2_c#\x8fN\xf1\xd2\x04^}\x1b\x97\xf2+\xe9\x8a2#\xc0f.\x1f\x03\xde6\x10!\xc3\x1fKp/N\xb4\xc6\xd7d\xf7\xd1\xa1\xb5\xbb*Sxk\x15\xb9T\xb2=\xbb\xe8\xcc\x87d\x0d\xe3\x1c\xaf\x081#\x94\x01b\x13\xfe\xf1\x06\xa8\x7f\x13\x84\x87\xe5I`Y\xab'\xa6\x1d\xa8\xab\x87; ;k \xc2\xa6\x09\xe4Yz\xd8\\\xdb\xcb\x8c\xc0\xf7\xabXgw[\xb3t\xe9\x0f\xb13\xf0\xa2G\xf5j\xe0\x9c\xcfa\xc3{\xb0K\x91=\xa6\xf0\xdd\x98\x88t\x81M\xf8\xee\xbd\xd0\xd8\x98\x1b\xf1\xcd[k\xaeiT\x94\xb51:A'j\xe1\x08\x9b\xb3\x16|+\x81$\x8bgL\xd6\xd7(\xc02\xb8\x8dY\xd1\xad\x1c\xe8\x08\x19\x82S\xce\xd9c\x0c\x83\x90N$~c\x07\xf9\x19\x83f\xa8\xbe\xfb\xd3\x17\x9d\x0bE\xc5\xe2\x039\xef\x18E2\x11\xdc%4\xd3`\xc0\xe2\x02W\x04\xad\xec4\xa18\xaa\xe1\xa3\x95)\xb1\xc2\xcf\xf2\xd0\x88Y\x1c~(\x9a\xde\xb7\xa4RE\x1fH\xb2U\xc4e\xe4U\x9d\x7f\xf1\xc2'\x13\xe4\xd3w\xac\x19\xf0I\xe9\xf7\xd3\x0e\x1b9\xc9|\xf7\xfa\xa0\x80u\xd1\x88)\xecF\xaa\x9d\x0e;Kq-i\xcc\xd74Gp\xd1\x1d3\xe7\xac\xe1/\xb6\x921\x98O\x0cG1Q\x86\xc5\x96g\xa1)\x12@\xf5\xe6+\x8e\xebp\xe9\xce\xf1@\x191Q\xa3D\x83WV-\x9am\xb6\xa1\x98\xe2Hy\xda\x9d_t\\o\x93\xc9\x14\xe8=\x0f-\x9c\x13\x0dv\xe2\xc6\xb2$\x94C\xecX\x06\\\x0bI\xf18s;\xc0Qk$\xc9\xde\xae\x8d@\xa7\x02\xd5a\xa6\x02\x1f\x97\xd4S40u;\xdf\xb3Hz\xa8I)\xf3\xdd\x9e_9'\x8a5Sy\xe6/H\xe3\x05\x13\xe0x\x17\x10T\xa4\xd3!\xf643\xcb\x1az\xfd\xf96u~\x9d+n\xb7(h^>U\x1d~\xe2r\xed<\x8b\xe6\\\xa9\xf5{\x09\xdb\xfe\xa6_k\xc8Ww\xbea\xd2h\x1d+\x8a\xb4\x8c\xca\xe2\x18\xdaB\xc9/l\x06\x7f\x8ew9\x03M\xc3\x96\"WL\x08\x0a\x14\xaeW\xc7\xf9..%k/\xf0Y~\xc4\x9faO\xe6m\x85kx\x13^YV\xf5'" +
+	"\x02\xa4\xd7\xb1\xc9~\xbfU\xe8\xaa\xfe\xb4Fp1\xfe\x14\xbf\xdf\xc9}?`\x02\xcd\xcdaay\xde\x11nQ\x16_3\xc0\\}~\xdb\xcd\x10Q\x8fi\xd8\x98\xe9\xdf\x15v_\xa8\x80\x0d+x(+\x82\x94\xe3\xc6\x94\x9fx\x8a\xae\xc3\x0e\xee\xdf\xb89\xb8\xc9\xf3\xa4\x7fp\xdd\xd2Gu/JdY\x17\xd3\xc1\xfa\xd8\xb1\xaf>fvpW*\xe3\xe0e\x95f\x07\x84\xc3\x96\xda\x14\x97\x06\xcc{\xab\xd9(\xf9N\xd1\xc20\xe5\xd2]\xa6\xdb\x94\xdeQ\x1b\xab\x11\xa0\xb5V\xb1v6cF)\x13\xc30\xd0P\xe4G+a\x17[\x91\xc0\xab\x09\x83\xc2\xfa\xe7u\\\xf1\x9eNpv\xf9\xc5\x1c\xb2\xec\xe6\x09pJ1'[K-\xc1\"#\xbdg\xb8\xce\xd7I\xa7\x00\xd3\x13HQ\x0f\x8d\xb0]\xe2s\xcd\x06\x1c\xa0\x8bs\xdf\xb5>\xae\xba\x9eV\xdf\x11}\x14Z\xf1M\xc7\xe7\xa3b^\xcdZ\xf5\xaa\xfa\xac\x05\x87!f\xbe\x0b\x9fd\xb3~\xea+\xa5\xca\xbeO\xc2=\xc7\xd2b-\xc7;\xe1\xb5\xf6\xd0\xaf\x01]\xc6\x88[\xc9W\x97/y\xa0b\x09T\xd1\xd6\x09\x19!\x81\xe2\xa4'\x13\x8dl\x91\x8d6\x13k\xfe\xbb{\x8d\xdem\xc8\x0b\x94\"\x85-\x0az\x8eH\x93\xb9\x8c%\x06\x19\x9b\x84\x1b\x1e\x1b3\x82\x0dpi\xaf%\x01ax\x1b\xb0\xd1\x85\xef\xb2\x06wYS\xa1\x01x\xcc|\xf1C\x12\xde\x9d\xda\xf0\x08\xaa]\x09\x95l\x1a\xdd\xa8\x97\xd6\x19\x9b\x01<\x84\x99-\xd2\xed \x1d\xde\x15\xf8n;\xc7ZO*\x94'\x87\x8b\xc9\xa1P\x8e!\x8f]\xe0=\xbd\x9e\xb0\xb89{2\x0f\x80\xcf\xda\x9e\"\xe2\xe6\xce\x0f)\x89o\xf8\xb2hL\x0f\x94~\x8c\x0du)H\xa6\xc2\x8f9>\x1eL\x9c\xb8\x0a/\xe1[\xe9\xe2\xb7]\x0a\xa1\xe1\x12\x19n\x04\xddZ\x18\xf5\xd9\xdaz\x18\xec\x8a\xda\xe1Ff<YY\xc4\xf7\xb1\x9b\xcePH\xdc\xc6[#&\xd5p\xce\xef\xb3\x81\xcc\xe5\x17G\xf8\x8e\xb7\xa1i\xbdR@N\xf7\x08%\xd4\xaf\xea\xe3vft;\x98\x04\xb8\x9c\xbap\xfc\x0d\x937\xa3/\xb4_\xa6\x97\\M\xa1\x808\x00\xde\xbd=\xe5\x8c\x0a\xcf \x013\xf4\xc4\x87e\xf2\xc8\xd8\xd7f\x84\x85N\xc7l\xa5\xdbp`\x11_\x92\x8b\xac:\xf9\x9e6\x8d=4\x90\x9c\xb6\x8d\x89\xd9\x1c\xeek\xe8\x14\xe5|\xa1\x10\x10X{\xd6\xad\xa2)\xe6\xea#X\xc2\x8es \xd3\xd1]P\x0a\x92\x9d\xd6f~\xe8\x0f\x89\x81\xc23L\x85\xad\xb59T\xa5\xd6Z\xca\x07\xdc\xc4~\x0c\x92ty\xe1\xf9I\xe1\xe5\xda\x1a\x83\xa1\x8e^\xb3\xea\xb0\x8f\x1f\xe6\x02\xe0W\xb7p\x91g\x95\xc2\x0f\xf3\xf0\xcb\x01\xfa\xadK\xdf7E\xe5\x06\\\x19\xd2\x80\xa3\xae\xa1\xc0\xa6\xc4$\xca\x0d\x7f\xe2\xe6\xb6\xd0\xf4\xf6\xdd\x8a~+UC9\xf5\xe5\x98.\x97\x13\x8a\x9a+\x81\x9apa5U\x1c\xadG\x0e\xdbG\xd8\x87wv\xec\xa0ah\x9b\x13\x85\x0aH\xb62\xa9x\x92\"\x1f\xd3<\xc4\xa0EnHv4\xbd\x04\x16g\x96%\x11\xc4]Y\x8f\xf2\xa6\xff\"\xe4\xcb\x92\xf0\x87\x1b`\xb7\xa3\xb6\xd8n\x8b\xfa\xfe\xe4\xbfy\xf9\xc5r^\xc0\xf0hnq0!\xa3\x07i\x0ed\x8f\xa0\xb5\x0d\x96\\\xf8~8\x7f\xddXx4\xba\xc4\xf9\x93\x0fgY\xae\xbe85\xe1\xbe\xbc\x92y|n\xcc\x0f\xcf\xbc\xa8:\xb9\xa1p\x1f\x9b\xb8\x12U\xf1\xd8\xb0\xb33\x9e\x0f\xaa\xf9AJ\xf7:u\xf7\x0a\x9c\xdeYR\xb6[\xc9\xda\xc6\x1a\xbc\xb6\x17\x8e\xda\xd9\xd5&\xfb\xc5m\xd9hd+\xe3&>\x005\xc8\xa8\"\xa4\xca\x0c\xe1\xc0Q\xdf0\x91\x7f5\xaer\xe2\xbf)\x85[\xda\xeab\xb9?+du\x18i\xeb\xe8\xa7W\x08[\xd9\"z+/2\x10\\\x1coF\xb4&5wA\xf9^\xa3\xf8\xb5fnrD\x11\x10\xd2am\xe2\xb74\xbd\xe3\xaa,\xb7y\xd9\xa31H]\x0aJ\x8c\xd0X%\xc6\xae\x0b\xb2\x98p\xcefMYb\xb0;-ta\xbdcR\x0f\xe3\x1f\xc4\xee\xe2\xba\xb5+\xd7\"\x05\x86\xfbm\xb6>\xb5%\xa8V\\\x8d\xe6\x8c\x98\x9f.\x9e1\xe7\xde\xc0\x1d_j\xeaT\x19\x8cs\xfeb\xe5\xdf\xbe\xf5\xd8\xe7\x90\x09\x82tM\xc9\xfb\xee\xcc\xa4:m\xe0<M\xb6\xac\xf4\x15W\x19\x9a\xb3\x01&\xcd\x0d{\x9b\x92--\x8b\xad_\xd9L\x1a4\xa1\x98h\x03\xb8I\xd3\xa8\xbd\xa5\x087+dgS=\xfb\x92\x1e\xdb\xf0\xee\xe6\x0aK\xb8K\xeb%a\xdeS\x9a@}t\xa2Mk\xb1\xd8ITa\xe3\xadH\x0dM-\xd1>\xa2\xfb\xd9R\x85\x14\xf3\xc5\xe8<6\xa9\xb9.\xcb\xf4$[\xd9J6\x1b\xb5sp\x1ek\xac\xf8\xc4\xee_J\x84\xb4\x163\xec)g\xac+\xff+V'\xb48\xa5\x9bB\x00\x04\xda%\xde\xe3k4\xebuZ\x14b\xbf\xd6\x1aV\xe0\xfbF\xc5\xab\x13\x14G\xc3!\xb6q\xeeV\xa9\xb1K\xb4\x84y?@\xdc{\xd8\x08\x1f\x07\xbe \xdc\x0e\x19:\x82\x9d\xc7\xbb\x89\xb6\x1b\xf3 \x0b+\x13\x93\x08\x82\xeb\xb3\xe2\x82|\xcd\x1e\x13\xf8K\x988\xc2\xb6\x05N\xfcTVR\x01\x8e\x12\xb3\xa9ncl\x89\xe9\xb5\xe1\x9fb\xc9\xfb\x1fZj\xf8\x19\xb5Y\xfa\xaeP\x94\x99\xa1(\xec\xc1\xb2\xff\xe6\x10\x0f\xb3\xaf\x8et8\x99~\x13j\x08\xb0Da2Ff\x90\x1f\x0d@^\xfb\xaf\xb9\xe1T\xf6\xe4\xb09aO\xbe>\x1fz\xd5}\x90\x12'\xa0\x0b\x81\xe9Io\xbd\xf9\xbd\xf7\xee\xd8i\xe9\x9d\xbe'\x17\x8a\xb394Vx\xf4\xe3p\xcb\xd2E?\xd3_\xb7\\(\xc5\x1b(L1\x15\xc3\x91\xea'\xb0\x99\xed\xc3\x0f#\x94\x8d\xbb\xf72E\x07\xe9{\xb3e\xd0\x10Q\xf9z\xc9\xf1\xe0\x8c)\x1c\xb0\x0d\xc6\x89X\x8fK\xdb\x1a\x9f\xce7\x1e&#?\xd3\xbd4<\x08\x88\x10j\xbc\xafJ\xedG\xf98|ny\xb9\xe3\x86j.]+\x7f\xc8\xfa{\xf1E:f>p\x85\x0c\xf4\xcb<\xfcO\x9a^~\xb3\x0b\xe7X\xd4\x10\x91\xc5\xc4\x8f$\xe11\xec\x17\xc8w\xc9\xc3\xb7\x8b\x8d\xe3\xfb\xc2S\xcfq\xb1\x0b\xb5\xf1;\xc5K\xfd\x88yM\xec\x12\xbbElG:Fh\xbbOk\xf3\x89 t\x93\x1e\xe7\xb2\xc3K\xe7\xc9\xbeh\xa7\xfa\x08{\x00Z\x83\x95\xa8x\x0e\xc9\xfe\x8e\"\x7fOC\x8d!'1\xa0:\xf6Q\xc7JDsv\x15k\xde\x06\xc1}\xfd\x8e\x15\xb1\x83hy\xc4\x0a\xb6\x0eH\xf4S\x98\x83\x03\xc0\xee\xf5\x86\x90W\xef0\xd8\xe9\x85\x80\xe2\x8cd\x0fm\xd3\x13\x9cGU^3\xd1\xeb\"\xa9\xa9\xe5\x19e\x81&\xbf\x89\xec\x84%\xf7\xd8q\x8du\xf8\x11\x86\x17\xeb\x93q\xec\xd7\xfd\xa0\xca\x07\xed(0\xf6\xa11\x86\xc3R\x81\x16\xed\x85O\xf0s\xadM\xd8\x05\xe1\xf3X\xf5\xe2\xc4\x05\xf5\xd2\x00\x9c{\xe7\x02,\xadh\xa2\x80`\xcd\xb49Bg%\xa7\xdc\x09\x105O\xd1p\x93\xc0b\xa2H\\\x07\xe7)\xeb:\xdc\xd8v|\xbe\xcd5\xed7p\xa2\x8cTE\x8b^\xb0U\xf0\x04\xa1\x01}K\xf3\x87\xe6.\xab\x9d1>\x99[t\xf8\x03\x94E\xdc\xd0\x19\xba\xbc[\x85\xa6\xc0r\x03\x83><\xfa\xe4\x92%e\xf8q\x9cw\xbe\x02_(|\x0b\xcf\xd8Y\xfe\xe4J\xc8M\x0e\x95\x86\xe4\xde\xcb\xa8\xfe\xa1o\xd0/\x98\x97\x96\xa9\xcfq\xe6\xd4\xe2\xa0s\xdcK\xc0\xab\x9a)\xbav\xc2\xc8\x01i\xcd\x85\xa1k\x15\x1d;\xe3\xb5\xdc)\xf9\xdaL\x9b\x87\x0a\x8a\x88C\xf4\x1c`k\x88\xd3m\x03\xfe\xc0\x8e\xfd\xff5\xcc\xab\xb6\xf7[\x0c0w\x90\x96]\xa2\xf9\xa1\x04*\xfc\x19g\xc8QAw\xfc\x08\xc3r\xcf\xe5\x19\x9c\xd0\x83]\x05\xda\x89\xb5E[!\x1eY\x1a\x8d\xb6\xa7\x96\x87c\x80N\x15\x0b\x83aDH\xd6o\xc7\x8b\x9c\x16!M\xbb\xdayLG\x01\xb2\xa67T\xd8\x89L\xb7\x02\xcf\x1d\xd8\xec\xd2\xe1\x99\xd3N\xb6\xa4\xd4\xf1\xc6 `L\xb9\x9b\x1c\xbfis1\xa1el1\x99<\xdc\xe2\x80.\xe5\xd1\xc7\xec\xb69;\xef\xef\x98h5xO_X\xfa\xfc\xc1&\x08\x98\x01-\xc5\x95)#\x00\xbb\xd2\xce\xd0{\xb3\xd3\x86\xb1?~\xb6\xc7m\xb1\xa6\xbe=\xbc\xac`+l\xd1\xc1\xe9\xf7\xad&\xe8Z\"w\x89\xe9\xf0/\xd3\xcd\x9b\xae\x06<^\xfcA\x18\xf3\xc6\x14y\x1f\x11J\x90\xa7\x13\xa5w\x1cm\xba\xa3\xe2\x92\xe25\x9f\xfc\xf3\xfd\xa5S\xb7\xb5\xf1C\xed\xa0\xb4\xf3\xd5\x85\xc5\xd2Q\x07\xdb\xd9\x0e\xef\x9b\xe0r\xf6\xb7\xbey\x10\xb2G\xfc~\x06z\x86n2M\xb5g.\xce\x99a\xfe7i\xa7\x87\xefd\x99\xf0\xb9\xb0f\x0eS\x89l\xd3\x03\xd8\xbe{\xd4Y\xd8\x84_\x84\xc0\xe5r\xaa\xc7\xe5\xf2V\xcd\xc4L\xf8P\xaf4\xa9\x90\x8d/\x1c\x87Y\xba[\xce\x0c<\"c?G\xb2kl\xf47JL\x8f&\xe7Sl2t\xb4\xddZ,\x92\xa8\"g-\x10o\xa4\x071e\xba\xa4\xe7H-\x03L*\xe8\x1d\xdc\xf9\xda\x01/\x98\x03\xa6\xd0&\xe4\xd0\x95\xfca\xd7w \x15\xbe\xb4\xfb\xed;\xd2\x00H\x1e\xaci%\x98F\xde\xe7K\xeb'ly\xe8\x9e\x95Evi\xca\x96\xc6\xc1\xcd\x85~S\x1e\xea\xc9R\x7f\xc7\x06\x1d\x9f\x7f\x13\xbe\x1db\xeb\xee\x1d\x91?\xeb\x91\xcd\xba\x95\xacTFK\xf9v\xfa\x0a\xf5\x9c\xb0\x9a\x0f7\x1d\x90\xc3\xba\x15\xf7\x93a\\\x903F\xec\x95\xe4el\xe0\xb8\xa5\x0d}7q\x02L\x85\x07\xb8\xe3\x9e\xaf\x06\x84*z\x82\xa3\xc1\x8e\x02\x93qV5B\x15\x09\xd6D\xba8\x9e\xa7\xa8\xc0\x9d]\xe4\xc4\"\xaa\xc5\x80\xb9\x07as\x85{$\xafwf\x9d\x86\x13\xf4S,\xa56y\xbcF\xb4\xc5.\x9e\xda\x8bk\xd9\xebl\xc0!2\xe9#\xedR\xed\xba\xe0\x0c\x07\xa1:\x148-\x0d\xb1N\xcb\x85e\x16\xc9\xab\xb09-\xd9dm\xfb\xc70,\xbfQ\x12\x13U\xb405\x07|\x02'\xed\x8c!\xd9Q\xf8G\xf3&G\xaa\xa7n!\xa8\xc5\x9d\x99\x9d\xc2F\xbe\xfd\x15W\x90\xaa\xf6+\x09\xdd\xd7p(\x84\xaag\xd7\xdd\xfb\x96\xe9$\xe0\xc5g\xa4\x1c\xc6\xeeJT5Vw\x85\xc1\xfaB\x83d\x91Q\x98j9\x85\xda\xfb\xa6s#\x8c\x86'I^\x0e/\xfc\xd0\xc66e6ps#\xc7\x17\xd3\xe8;\x9e)X\xa5\xbe\x17v,\x11\xa4\x90vQ\xf5\x18M}\x93}\xccl\x91\xfb\xa27\xbb\x93q!\xd4\x16\xb8\xf8\xb5G.}\x16W\xeeP\xd6P$)+:\x19\x93\xe6\x1b\x9f1\x85^\x8b\xce\xc6]$\xd7\xa8\x11\x0d1\xb8\x08o\x1d\xe1\xbc\xaa\xa5,T\x91-\xf3\\`S^\xd1r\xe8\xd9:\xd8\xfdd\xbdwIa\xac\x1d\xbc\xf9NE\x9atQ\xbb7I\x80\x1cq\x94\xd9\xbd\xe5\x8f\xbf\x1a\x80(<\xbb=\xccq\xcf'\xbf\xb2\x84\xd8\xfe\xd2\x9dg<\xe2h:<\xc67_\x8aG\x83\x7f\xc0\xfe\x143\xbd\x87Dd\xce-\xc5\xe8\x0fX\xc7\xc9\x80\x95\x15q\x8c\xa8M\x07;\xe8\xf2C\xb5\xf3\xe6!\xd2\x10\xdd\x07v\xac\xca]\xa9\xe7\x96,\xf1\xc4'w\xfe\xf5\xb2t\xe2\xf3\xcc\xbfFa9~\xd7O\x9c\xb7\x9f8\x9d\xd4\xee\xf6\x1d\x8eDo\xf87\xf0\xfa]\xe2r\xb3\xda\xd3}\x86C\x9cY\x97\xa6w\x9f\xaf\x91\xb9\xa4\xa2D\xbd\x11\x9d2\xbb\x1a\xae\xe9\xb8\xca\x0a\x07(\x916\x89s\xfey\xc5\xc6\xfd\x18~\xe4\x8fN\xefy\xc2\xe9>'\x7fN\xf8i\xf7\x8b\xcc\x10\xd4\x88\x85\x19aT\xf7\xbe\xaa\xe4\x0ee\xb9w\x13\xa8B5\xa0\x0e\x86ip\xd3\x14\xc6\x9c\x918\xcbL\x98W?\xe9\xd9\xb6\xcd\x13\xbe\xabJEe\"A\xd8r )kw\x1eT\xfa\x09\xfb\xc1.\x92\xbe\xfd\x19\xe7\xbd::(\xcd\x88\xe8\x99d\xd6\x80$_s\x00\xc9\xef\xa8\xc2\xc7\xb8\xba\xd6\xb9\x01\x08\xf1a=\x95\xe3\xe4+\xbe\xa7\xd3KZ\xe4\x8e\xdf(\xbb\x08\xb4\x1a\xe1z\xbfD\xb4\x9b\xf7\x1b\x93r}\xe10^\x9f\x8eQ\xaf\x08)XQK\x12\xbc\x15\xba\x192\x9d\xde\x15\xb2g\xb0N\xd0\xae\xfc\xb87\x9f\xb3\xad\x1b\xd7\xde\x0e6\x1d\xb5\x8bO4\xe5\xbd{\x99\xf6\x17\x18\xde\x8d\xfe\xf6\xc7\x1a\xd6\xc6\x91\xf7f\x0f\xdf^\xc5v\x82\xac\xdcc,\xb7Y\xb3\x06\x8e3\xc35\xbf\x9b\x82\x02\x06\xee\xba\xaf\xfd\x10\xc5@\xf7CQ\xfb\xf0M\xc9\xec\xeb\x85\x1f\xb8\x13\xd2J'\xdfhf\x113\xad\x14\x0e]\x9d.\xb6\x98M\xcay\xacO`\xe7\x9f\xf6o\x12lwaQ`\x90\xbb\xf0UT\x90!\xd9`\xbc'\x09KK\x9f8\x98\xdc7\xbd\x1d\xfe\xc7i!p\x9f\xda\xd6\xd1\x1d\xd8\xcc\x9e\xdc^8\xd0]n\xd9\x88\xd4\xc2\xf2P\xbc\xf5S9\xb0.\x0c\"\x0a\xcd\xff\x9e\x13\xb6\xbeaJ\x1f\xbb\x8d+s_+\xcc]6N\xd7\xc75\xe9\xda\x08\xd94\x18\xacq\xf7\xdcg\xa2Vv8\xac:\xf2\x9e\xab\xa7\x9f\xa9\xaf\\\\8*U\xd3\x80\xcb\xae\x99\xa2\x1e\x19\xb9\x0a\xf9\xf2\x9f\x9c\xc0k;7\x96%\xa2(\x93\xa1b+%_\xe5\xc2\x86\x90hbV6\xa1\x95\x01\xa4k%\xb7R\x0c}\x1e@\xf4\xf4\x04\x0cs\xfb\xcb`\xa1\x87\xbe\xe7\xe4\x8d\xe9\x02\xfa\x10iST\x8e\x81\x7f\xaa\x174W\xf7\xe9\xc0\xdd/k\x8b\x8b\xc8o\x113(c\xb7x\xed\xdb[0U\xfc\x00\xa5\x97\xa6\xcb\xbf\x01\x7f\xd3\xab\x833\x13\xbf6&2\x0dFB\x10\x9c\x0c\x8aY\x1a\xa2\xec\x8a\xbby\xb7\xe1h\xb2\xfb\x02\xeb\xad\x01_/m\xaa\x9bI\x8a\x13\x88nk\\7\xe9\xe2&\xa7a\xdd\x9crg\x951nw\xbe\xa9\x11\xe9.3\x99\xac\xa1\x19w[\xdc\xd2\xfa\x86\xb4\"|\xc7w\x03h1)w\x89\x1b1\xa5<\x93Ai\x1d\xdd\xf6\xf0{\xbe\xeceu\xe7D\x1f\xc2rw\x16{\xbd'\xfe\x90\xbep\xb6\x8b\x00\xd2O\\\xb9\x80n0<Q\xb7\xd8\xef&J.\xf3\xae\xc3X\xc5%\x06\x8f\x13\x9b\xd3j\x0a\xbe\xe1(\xa3\x01\xb3\xf1\xdaU\xb6\xe6\xce\xa6aE\x06\xfa)\x0b\x06\xa50\x1e\x7f\xef\xd3\xd2\xf0n\xf6Z\x015r\xe6H6\xfc\xdd|j\xacKI\xe2\xe3\xb2'\xbf'\xea\x8f&\x1d\x83m-O\xf1k\x07\xa5\xb3\xa5\x9d\x05\x09\x9d@\xbc\xc2\xa3>\x86[\x11\xe4\xa4\xbf\xc3\xdcr\xe9Y\xafH\xff\xf3..;4:\x92\x19\xd7\xfa\xf9\x84\xf1#\x88\x99\x85b|V\x88_\x14T:\xa1\xf5\xbb\x7f<\xaf\x8au\xd8\xbf\x11\xdd\x98\x16\x17\xaa\x148\xe2U\x8e}\xb4\x1f\x87\xee\xff\x03w}V'<\x82tN'5\xfc\xd9\\\xbf\xf6 \xa0<\xe38\x0f\xa9\xab0\x9b\x9f03G\xde\x88\xe5\xbe<\xa5\x1f\xeb\xa6#o\xebI\xfe\xd6\xe0\xe8~\xe5\xdb\xa2g+/\xf91\xa1H\x11%\xa8\xc1\x03\xf8\xc72#\xb2\x076\xcc\x1bb\xfa\x1c\xee\xe2\xd2\xef\xcc\xe0q\xed\x900\x08\xae\xb4\xc7\x8e\xc9\x996-\x8f\x8f\xd7P\xbb\xd3\xd5\x97\xbe\xcc'\xe5\x047\xe2;2\xb1{\x0are\xdf\xed\xceo\xb3'\x8a,#mo\xe9)\xd0I2\xd1\x81lSO\x83\xad.\xda\xdd\xd0ii\x98(\xab\xa5!HE\xaa\x1d\xd0\xb6Zd\xcd\xa1\x8d'\xb9\xad\xd3\x0c@\x84e\x9e\xe6|F\xda\xd4\x9f9?\xee\xe9\x13\xf5u\xe0\xad_\xf5`\x0a:\xec\xae\xfd\x8d\x13'P\xbc\xfe\x07\xf5\xa0\xc2\x95\xcf-B\x8cs\x1d\xf0\x8bB\x91\xad\xafG\x02\xac\xd9Cx*]\xe4\xe23\xaf\x8c\xac\x03\x87\xb9\xba\x05\x0b\x10\xc1h\xcd\x01*\x1e~\x9a\x9e\x98\xb9=\xcc\xa7\x0d\x05\x9a\x1e\xd3\xf0\x8e\x8e\x1fb\x00J\x03\x0eHZV\x95t_\x17\x00\x98\xa5\xee\x0c\xb1\xa4\x0f\xb1\x1dzc\xbc\xc4F\x90\x10\x9f\x02\x85\x16z1,\x85e\\\xc6\x15\x92VvH7\x15\xf95\xc8\xb4\xe1\x8bhK\xc5%v\x8b\x15\xed\xc4\x90q\xf2\x05\xa1\xbd\x10Y\x87N{\x8e\xeb~pKN\xfd\xcc\x92U\xd4\xdf\x90>\x82\xf6\xcbY\xf8n>\x82\x05=\x97\x8f\x88\xb7\x8e0\xd0\x943\xc1\xaf\x92\x15\x81\x84\x1bW\xfd^9Q\xc4_\x9b\x1e\xec\xb4BA\x98\x8e\xd3*z_\x86\x15\xc2\xd3\xf2v\xafp\xcf\x9b\xc7\x81v*J\xfe-\x98\xae\xb8Lvpu\xd3?J\x80Ilc\x8b>*q-I\x93\x071I\xac\x09k\xeeh24\xbe\x06,\x13\xa5n\x16\xa3- \x8et\xb0\xdb\x8et\x9a/\x84\xf6\x0c\xcaT\x03\x94\xd5_\xfaR\xc9\xa6\x11o\x9b\xf7\x96l\xa5\xac#.?\xe6f\xf4o\xe1J\x19\xf172" +
+	"\xf5\x1a2f\x1f\xcc\xd47\xa3F/\x143e\x10\"Z\xb6*\xed\x15\xcc\xb5\xf5Pl\xf2\xb6\xd25\x1a~ .\x05\xe7`\x1e\x07\xb6 \xd2R\xdax\xb4-\x01\x7f-\xc4t\x98\x971E\x11C\x91=\xd4\xc2d\xb0\xff\xbd\x86\xc2Dy?\xf9\x1fG\x1e\xf4\x8a\x98\x0c\x968\xb7\x96ht\xdc\x12Q\xbe\x8fR\xf1\xe1Sj7\x06\xc2\x97?f\xfa=\xe1q;\x95\xec\xadi\xe7\xe3X\xa2{\x82\x9aO0\xa8l\xf3\x7f\xdc\x87r\xfd\xb9\x18)\xad\xfe\x9a\x05\xcaZ\xe6\xb1\xf2\xd5a\x95(\x83\xccp\\\x92q\xc3\xc3\xe1\x14\x15\x87\x90\xed /\xca\xf5\xcd0\xbe\x04\xff\x99!}\x8ct\x0a\x7f\xfc\x86J\x97F{\xa7\xc9\x8e\xa1y\x06\x87\xfa\xc3,\x8e\x87\x8b]\xbe\x8f\x9c]\xa0\xefR\xf1\xdd\xa6\xf5\xee\xe6\xf9\xcc5\xe7\x909u\xeb\xa0\x8b\xd3\x02\x16\xe4Iz\xeb8\x87\xf9\x8d\xa6>\xccb\x0b\xd9\xed\xe7\\+Q/\x80\x9df\x1f\x178\xdf\x8c\xc6Q#\xff\x84\x1e\xd52\x1f\xd5\xcd\x17\xf7\xb2\x84^\x7fE\xa7\x83%x\xec\xd9\xd4Lz\x12r\x82\xe1{\x16\xc4\x92\xe3\xa6\xc7\xd1\x1f_\x19\x02]af\xe9a\x80\xac\xed\xc6\xd7\x09\xfa0\xaf\xc9E\xee\xddivi\xe2\x16m\xe6\x8d'\x88\xfd\x9f`\xda\xca@6\x091tB7\x8ck;\x09\xce\xf6w\xd8Fn\xce\x0b\x10*\x06\xd3\x0f\xc2\xd3\xd00R\xdb\xb9\x94e3\x95C\xa9\x8d\xfc\x15\xce\x99\x9b\x8b\xee\x0d\xefQ\xc6\x97\x10\"\x1d\x84\xd7\xbf8\x8b\xb3\xb2\xce\x03\xb3\xfb!\xc4n\xa9OA\x99h\xae\xf7\xe06YPD\xca\x10h\x134?\x94\xea\x8b\xab\x0cE\x04\xeb\xb5x\xcf\xf0\x9f\x9d\xdf\x01\x99\x15\x0c\xf5D\x84n\xc2\x80\x94K\xf6\xa6\x03\xaa.\xb4\x95M\xb9\xad,\xb5$\xa7K\xfc?l+\xf3\xbf;\xe1$6\xea%O\x90\x9e\xc2Y\x88^\xeaVK\x1e3\xc8\x9c'7\x8a`Z\x8cdY\x05\x91\x91\x99GM\x85\x09\xd5\xd0\xcc\xb5)\x1f\x06\x14\x01/\xfb\xe5E\x99Ly)\x16`\x0d\x93\xf3\xbb\x00C$\xa0\xb3b#\xb8kO\xc2\xbfi\xeb\x8aK\xfcy:\xb1\x12\xe8\xc3*\xa6/\xe8\x8f\xd4]\x85\xea\xff\xd4r\x81\xb5\xe3;:N\xda-\x0bA\xc2\xa0\x08(Q\xd9S\x85Z\x901VlR\x8a\x9bw`o\xa4r\xba\x12\xc5\x1f\xc9t\"\x01al\xfc3\xdb,0u\xadY\x96\xee\xe1\x85\xdc\xdb\xf3\xc5\xa96d\xac\x9d\x90R\xd9\x82\x8a\xcf\xd6(\x9a\x1a?Y\xf9r?\xc3M)\x97|z\x86\xb22\x84\xa8LA\x8c$\xb6\x85k\xdefD\x16\x09\xb9\x1e\x17\x1a\xeb\xce8\xbb\xb7\xcdb\xf0|\x03\xe4\x9eoj\xdb\xd9\xa1o\xc2\xadG\x99\x02\x0d#HN\xcc\x06L-\x16\x1e\xcek\xaan{|\xc1\x02OTV\xfe4\x81)\xb9cS\xa8\xc8\x1e\xa5'Xcc6\xb34R\x10\xb7\x0f[\xaa\x96\x12\x1d\x02\x94W\xa4dy\x80\xdaG\xa9\xc5x\x9a\x11\x16o(+\xfd\xd5\x05\xc7\x9c\xee\x8a\x0d#@\x1e\xa9\x99\xca4rs\xdaWA\xb6\xa0\xed\xd1\x86\xcd\x04h\xa6\x8e\xf8\x08\xdc7#;\x1c\xa2\xa1\xd6R\xdb\x81\x16\x86oE\xd1\xc1\xa9\x8d;\xe7\xe1g\xd8\xc4\x15\xb5I\x85\xe3$\xc3\x10u\xde\xf6\x9a\x96,~\xb5@HJm\x1cc~\x1f/|7\xf6\xf9\xbd\\\xcd\x16\xb4\xe5Qy\x00@\x8a\x0fq\x16~\x99I\xb3KoP\xa3\xb3\x13;\x8aM\xbb\xd4\x8cyc\xa9\xa2\x8eo}\xce\xc5d,\xb8-H\xfa\x1a\xc0\xf2\xdf'\x0c\xa4\xd4O:\xe0\xc9\xc1~(\xc3\x0a\xcd\x0e\xd4\x86\xc2\x0f\xde\xdd\xa8H\xbcFmU\xd7\xf3\x08j\x10\xb4\xb4\x99\xbeJ5_r\xb1d\xbbQT\xb1\x11.\x10\xe5F\xb7J\x10\xda\xf4\x8e\x03c\x9c6\x8a\xcc0\xdc\xdd\x12\x10\xec\xa4\x86,p\xbd\xeb\xb0\x91Q\x9auj\xe8\xa7\xc4\xdc\xf6IjE'\x08\x0b \xb8\xc3\xb6\xf1d\xdfC+\x97\x19.\x1cX\"\xf3\xe8\xef\x16\xe9M\x86sx;\x94\x07\x9fz\xc6|\x13\xd7\x1a\x82\xd9}\x9a\xd8\xe9\xb3T\x89x3N<[\x9a\xb8$x\x14\x18tp'z[n\xff\x98x\x1f\xce\xf05cM\x19\xe4ps\xba7\xf4\x86\x05;<#\xa84\xcb\x91\xf3K\xc7t\x97\x08k#\x07\xad\x09\xf7\xa8&\xec\xfb\xc1\xedJ\xdbd\x8f\x90\xde\x1e\xae\x98\xcf\xc54SG\xbf\x13\xee\xad1\xea\xd9i\xdd\x89\x87\xc4\x80n;\xd4a\xf0\x8a_Q\xba\xeeH\xdc5\x8e\x13\xc0\xa1\xd1\xc4!l\x93\x89,\xfa\x82\xda\x12\x96\xc1|\xeb\x9c$\x04\x19s\xdc\xacC'S\xc2IR\x80\xe7\x0f\xacVG\xdf\xb0\x0e\xd2\xa6\x9fM\xfb\x9d\xd4\xca\x9d\x16\x96(\xde\x18\x0b\x9eK\xd2\xc8\x81KR\xfe~./&[\xc2\xf4u/\xb7\x98\xd6\x06\xd0$ ^\xed;3d\x9d@\xdb{v\x96\xd7\x93MV\xf4\x00\xb5]\xd6B\xa3\xfeP;\xce\x0b\x1dg\xabj\xa8\x83m?\xe5\xa1\xe1\xcf\xe2\x02\x19:\x97|\xc1uO\x1f\x06\x87\xcc\x8b\xf0\x83C\x0cq\xf7\x07\xdf\x90%\xa7\x91\x8c\xe8/n\xed\x91\xd15\x97\xdfjY2\x19\xb2\xa7\xb0\xd1\"\xdc\x1b\xb0`\xed\\0?\xcc\x11vv\x91\xed\xbe\xd8\x8c\"[\x81]\x0b\x86\x0ao\xe5\xfa\xa8\x11\xca\xb0#c\xc2H|\xdf\x89W\xd3\x11\xed\x98\x8e\x0a\x10\xb0\x84\x9f\xb4!\x92\xfd\xc7p\x1f\x85\x90\xc0U\x89\x8d\xf9\xba\xc6\xad\xb1w\x97fytZ\xc8\x8d\x93\xafR\xd6\xb1F\xcb\x04\xb1\x8e\xb5\x0f\xe0\xf3\x9c\x90\xa5\xbe\x110J\\\x86\xc3De3\xccN5\xf2\x06\xae\x06\xd1e\xa6\x9d\x90\xfc\x0b\x8aJ\xe9\xf9{\xd4S\x1a\x82\x9a\xd8z\xbb+]\xa5\xba\xc8v\\\xbdef6\xb9\x12\x95\x01\x9b\x7f\xb0m\xd8\xca\xc8\x8e\xf1\x92\xf9g\x0b\xba\x11\x9f\xb0c7y\x8f\xa6\xe8Z\xf4Yq\x8b\xc53j\xc5\x88\\c,7\xbc\xdb's\xa6m7\xbf\xf5\x19\x0f\xe3\xec\xf6\xc4\xd7h\xb8\x158\x89\xb5\xf1\xda\x91\x08\x9csj\xe1\xdd\x99\x06\xc3K\xaa58/\x90y\x0a\xa2\xa8\x0d;b\x15\x17\x09\xdd\xc9\xd2\xab\x98\x15c\xa4\xf32\x85E\x1fC\x84#\xb2\xe3h\x1f\xee\xa0!\xd8\x0d\xb9\xb4\xf9\xa2\xb3\x1e\xaez\xcf\xd0h\xc4\xfb|\xc5O\xf6\xa2Q\xf6\x9a\x99\xd1\x95\xd6\xe6^\x9b\xba!\xf9\xa6{\x03\x96VF\xd5k\x92c'\x8a\x94\xf0\xce\xff3\x1a\xc2C.\\\x0f\"\xbb\x9b\x91gZ\xdb]\\q\xde\x84Y<\xca\x87?\x157C\xd3\xad\x05#+\xefV\x08\x88\x07\xd2o\xe7\x99X\x89r\xfc\xcei\xc0Y\x1e\xff\x89Y\x8es<y\xb7E\xa1\xb1\xbc\xdfK\x8e\xf8OP\xf6\xf4\xbdXd\xdf,NL5R%\x94\x92\xa6\x0a\xc8\xa7\x02>s\xab\xef\x1eG\x98\x9fI\x8aA\xb2\x93r\xe6L\x92h \x94\x91\x96\x0c\x84\x18\x0b\xe2\x13a\xe4#\x95x\x87\xf5r\x18\xe2\x06Fx\xc3\x98\xe3\x8c^\xcan\xab\x03?x\x8e\xcd$<\x84_C\x82\xa5\xb6\x91\x89\xab\x84@\"\x02\xa5\x85\x85\xa5\xf3D\x8a\x04\xac\xe9\xbe\x84\x8f\xdf\x84\x1e\x0e\xf0\x81\xe5\xe27&\x89\xeb\xb5\xc9\xbd\xa8yQ\xa3\\&\xc1=\x05\xd1\xb12G\xaa\x88>\x1d\x8e9D{lo`I\xda\xf4j\x19\xee\xd2]\xc6\x95\x89\xd7\xfb\xe7\xe7M\x9e\x9c\xe8F\xcb\xf6\x1d[\x85+\xcf,\xc5\xa8\xd2\x811\xf1\xb9gpDF\xf5h\xba\x83\xbe\x86)`9\x91)\x8cOn\xc8+\xc2\x19eY\xf4\xa8\xbd\xa8\x84\x09o\xab+\xd0\xff\xdeQ\xd3w\xd8y\xc8\xe1\x04\x99\x80h\x1a\xc3\xef\x10\xe3\xa2:(\xdcG\xbe\xea\xa5\x19\xa6\xa4\xd1`SU\x17\xe4H\xea\x89\x1d\xd8\x0a'R\x84\xb3\xc5\xf4e\xe0\xae\xab\xed\xcain|\xed\x007\xc2#f;\xd6\x063D\xd5g\xaeK\x15x\xa8\xad\x16\x90\xd7\xfb\xe5\xc7\xdfv\x82\x82\xc9\x00\xae\xd7\xab\xf3L\x8a\xcc\xc9\x1b\x16\xdc\x9ct9\x15X\xcamb\xb07\x9c\x9a\x1bl\xb6\xe21\x14\xd1!G g\x12c\xecpOt\xff\xd0\xcc\xd8\xa4S+\"2/2\xad\xfd\x0d\x17\xe7\x19\x99\x9f\xa6]\x17\xae%\x9c\xc4\x1eT\xd3\xd1\xa4\xed\x04\xa3\x80cGK\x92\xf0\xa45\x16\x1d0\x06}F\xfe\xeb9K\xd7\x96l\xdd\xc5E.X\x82\x1e\x18Ia\x98\x92U\xf2J\x91\xeaxC\xdc\xab&\xedG-\x12\xdd\xa2WX\xeb\x0fa\x9fe\xd8\x1eI\xa57\x05\x969\xc5\xee\xbfr\xf3N3\xa8R\xe3kM\xc2\x8a\xb9wd\xe0\xe4\xa9QV\xc86\x16Z\xf22\xe2d\xa0\x92\xfa6\x1cK\xaf\xf4\xf2\xf4\xfe\x06S\x18\xdc\xb0\x86\x8c\x02\x87u\x08\x85\x97\xf6\x10\xb6H2X\xdb7\xdd\xe5\xf9\xb4\xb2\x86\x95k\xd9^b\xe1x\x1f\xdb\x91\xce\xafR\x93f\x9f\xd6\xd5\x1a\x19F\x0c\x15\x84\xee\xfd\xda\xd6\xf0\x8b%l\xa9E\xbf4\x80\xd7\x8e\xa0\x85\x14v\xf6\x9c\xe4\xc8Z\xc9\x0c\xa7\x11(\x01\xe3\x0d\xfd@d\xa1\x8c\xd4:.b\xad\xeaV\xf2\x1b@w\xc81\xd2lJ[\xf3O\xeaQF\xdb\x03\x17\xc7\x87\x96\xae\x1b\x9c\x1f\xad\x85\xb2\x89\x0e\xe67\x98\xd0\xa6\xd4\x0f\xbd\x1f\x1bx\x0d\x02_a\xaa\xec\xb8*\x8d\x86\xe5\xa3\"hx\x09M\xe1\x01\xb4\x02\xf8\x0c\xbf\xf8\x88:\x93\xf8\xc1\x1d,\xbc\x0d\xb7\x82\xd9}:\xd7\xcf1\x0e4\x97Q\x1e\xb9\xbf\xe1\x0e\xafp\x10\xf5+\xaay%\xfcD8L\xe2\x1cn\xeb}\xb8\xe9\xabQ\xea\xf8\xe78'\x0b\xa4|\x92\xb5\xe3\xe08\xb2\x02+\xa9\x90\x02`\xc3K\xfeQ\x1e!}\xa6\x04\x1bi\x84\xb2i5\x877X\xb3C\xfe\x8dxU\x80\xda\xd2\xca\x97\xb4\xe4o\xa5\xf31\xc4\x04g88\xe6\xaa\x0e\xb7\x0c[<Kao3d\xdf\x12\x14jRB\xdf\x99\xa6\xa1?\xd2E\xc3\xafa\x9d\x9e\xc6:@G\x1a/\xcbI\xaa\xefR\x90\x02\xb1\x89\xcb\xb0\x8e@\xb1t\x9f\xbe\x12N\x17\xd1Q\xf2\xef\x147\x16\x99\x05G0/?\x87\xdb\xcf\xa8\xd8\xabz\x8b\xe4\xb7\x9ai\x95\x11)@\xdcm\xe8\x0d\x8d\xd2\x15\xa4\x128\x9e#\xa8;\x1a\xbd97\xc2\x96\xec\xb5\xf6\xa3\xa5\xf7\x95\xee\x1cW\xb3\xf3\xb24\xf8\x05/x.\x01\xb1\x95\xdf\xa1\x948\xea\xd8\x0b\xaa\x9e\x9b\xa3\x0d\xef\xac)\x17\xa5\xc7\x91L\xa7\x1c\xd8\xf5\x1d\x07,\xc4\xa5\x15\x8f\xaeD\xae\x87\x83z2\xf6\xdf\x05\x948\xd7R\x9c-\xe7\x89\xdff\xaeJ\xf6\xe1}\x10\xa4s&\xdc\xcf0!b\x93\xfc\xf1\xffs\xa0\xce1\x1czY\xcf\xfazO>\xaf_1\xc0 ]\xa6l\xbd\xc8\xa4C\xdcD\xca\xc5\x14\xfapt\x8d\x06\xa9\xca\xc79\x10-_\x1a\xd8\xd2\x02\x8a\x9e\xddK\x81\xa6b\xd2\xcd\x93g$\xb4\x82\xa4\xdd|A\xf6\x04\xce\xe0\x0e?p\xaf2\x84N\xb3>\xae\xbf\x13\x15\xa5\xae\xba:\xc4Dm\xdf'\xfc(\xca*\xb1\x95\xed\x89q\xc7i\x1f\xfae\xfd\xbf\xdf\x93\xe3\xbc\xee#\x82&\x81\x14\x0b|Z\x14\x81^aG<\x9e\xb2\xbd\xa6#\x89\xfe\xc9\xd07cNp\xd7C\xfay\x1f\xebp\xeci\xd7\x9b\x10<}n^\xc6=\xe7\x98\xba_\x1d\xc2\xb1\x10\x9d\xdd\xe4\xd1@\xa6\xec\x91\xce+\xba\xe2D\xda\xcd\xc0(+v\xc7\xbdx9\xb7)\xdc\x82c$\xb6m\xc8\x8d!MR\xcf\xa5\xe1\x1d\xbc\xd26\xfb\xfd\x10\xbahP\"4\x9f)q\xa6\x0f\xc7sT/\x99>\x91\x06\x17\x17\xfc\x0fh\x0c\x95\x83\x7f\x8b-\xc29(\xc1\x80P.\xc9NGg\x9ba_\x7fV\xca\x85\xb9\xdd\xdfl\xd6NT.\x974\x80Y\xfe\x9c>]\x979u\xa7\x0e\xd0\x7f\x82\xe8\xa6C\x85\x8b{\xad\x8c\xceB\x93m\xf8`<v\xdc\x1f\xd2\xa6IoR\xd5\x86Pd!z\x98Y-\xf6\x13m\xc5\x06z\xf4Y\x95\xa7\xcf\x0b\x06vv\xd9\x93 C\xc9\xd3\x82U\x9eu\x19>\x99\xfe\x99&\xbc\x81\xd3\x16WD\xb0\xe6\xa8\x05Jv\xa9\xd1\x1f\xb7\xa65\xe9\x0e\xbc\x92M\\1!\xad\xa1gJ\x86\xe2@\xea\x83\x93\xb0\xb6\x98GO\xa6\xccu\x91dm\xe6\xaeLG\x1cV\xea\x88Z\x10dV\x1aX9\xd9[\xd7\x10\xbac\x17\xbc\xd25\xe2\x07\x1ey\x03\xf3#N\xe1\x8f\xe6(\xd1\xdc\xbd\xd37\xc2x\xa3C\xed\xa9\xb8r\xc2\x13m|d~bX_PN.G\xfb^*\x92\x08\x13\xf8\x9dd\x82\x18\x86Q\xefS\xe9\x81\xa9\xc3\x94\xb3CE]\xa5*\x817GG\xbdf^\xf5\xbdi]\xdf!}J\xfab\x85pF\xc9\xcb\xc4\x8a\x13\x8f;\x05\x80qM\xcd\x13\xbf\x99\xe6:\xb9@\x855\xd2X\xf1\xd8t\xad \x81\x8a\xa5\xfb\xb6\x92,V\xba\x1a\xfd)\x95*\xf3\xf9BT\x1b\x1f\xa0\x99\xa4\x17\xa4\x1ck?;a_\xf6G\x9e\xa8\xf11\xedwtb\xdb\xcbp\x931\xe8Mx\x13\x81\xfeyt\x08\xd0\xebI\x0b\x06'\xe9\x13\xec\xce?\xc3w\x89\xb2\xd4\xa5\xb3\xbe\xcfG\xf8\x09\xa3\xcc\x9b\xbb\x04\x9fT\x02\x1f'\xf2\xee\x82g^r\x91\x96\x0e\x954X\xed\x17f\xe1\xad9\xb9\xb1G\x83\x05\xcb\xab\x13\xae\x17\xa7\xec\x8d\xe1M\xc3z\xd0=N\x91\\4\xb7G\xe3\xda\xaa\xa6\xf7\x11\xee\xbe\x02j\x93S.t\xb4\xc5\xecSH\xa7\xd2D\xf3\x8e',\xef6=h\xd8\xfbw\xabOX9\xae\x99\xa1\x88v\xda\xed\xd7\xc2vt\x0c5M\x7fF\xcb;Vn6\xc5\xe7\x99k\x81\xb2\x9cJ?\xcb\xf4\xfb)\x86\x17n\xce\x87d\x8a\xb2\xe2q*\xd2=M\x98\xd0\xb2\xa5\x834\xa78t\x12\xa9J/\x01\xff\xber\x83\xaf\xdc\x98\xdd\x8a55g\xbf\x15\xa6\x8ac\x12\xaf%z\xe7\x84\xde?\x13\xdc\x84=\"\xa7o#\x16}\xb3g\x9aE\xb6\xaa\xdcc\xff\xd3\x97\x90&\x1f5\xef\x8e\x9b'_\x93\xe5\x84\xfe\x99e\x96\x93r\x99;\x19Of\xfc\xa3Gs9\xcd\x89\x08\xe4\xe2h\xd5Lo\xf1g{\x04\xd0\xe1\x07!>\xee\x8a\x13\x05\x16\x0bu\xe2)\xa9\x94Z\xc6PueK\xb4I\xb7\xacY\xa184\xfa\xbdmY\xc7N\x10\xc3\xbf)K\xe5\xb7\x1es.jOk\xc8\x9e\xd2\xae\"~8\xf4Hd\xe8\xe9\x9aTZ\xd5\xba\xcf\xe1\x89\xbf*!lr\x15\x80\xf0T1\x03\xd9j\xe2\xc6\xc7\x03\xbbz\x16\x8b,\xa1\xf6k\\\x94\x98\xff/\xfcB\xe0\xf4)u\x9a\xccx\x12\xe5\x12\x11\x0b\xa5\x84\x16\xe1\x91;\xb7'>*\xf0\xf2+q\x9a\xa2\x0f\xda\xc6:<dy\x16\xa9\xc15\x10m\xcd\x8d\x0d\xdd\xd2\xe2\xa1z\x92\xa9\xb9\xb6\xe8x=\xeb\xcc\x1aP8\xb0\xb5\x9a\x8c\xbf\x80\x9b\xc1E\xde\x8b\xf6J\xdf\xe0\x06\xb6~\x86!\xdf\xb2G\x92G\xf0\x92\xe2J\x96\xa5\x1a\x1d>\xae5\xbb\xf7o2\x05\xb5`\xb0M\xcb#\xae\x10\x89\xb0-\x94~\xf2\x89\x0d\xfe\xec:\xcf\x07h\x08d3x\x18\x0b\xd9\xe1\xaa\xc0#n\xfas]\x9c#`\x0faK1\x93\xb3\x04\x1aA\x99\xf3\x86G\\\xeeC\x15\xe6\xb3\x9b\x81W\xad>\x098\xec?\xba\xde\x94\xd5TG\x1d\x9b\xd7\xb0\xf7I\x8b<Q\xa0\xc4\x09\xfd\xa38\x04m8\x85\xda\x8a\xe3\x93m\xf8A\x8b\xde\x90\xea%\xffn\xcb\xad`\x92\x90@\x97\x94\xe2\xa9\xf0l\xc7u ?q\xde\xc9O\xb0\xf4\xff\xc2\x14\x92`S\x05\xc3\x95\xeb\xb3\x08R\x955;\xed\x92a \xf10j\xcd\xe6\xe6:\xaa\xff}\x93\xa9C\xb2\x9d\x93D|*\x89\xe4X\xc3{\xb0?\xc9#\x17\x96I\xb6]$\x10\xc1\x1c`P\xfb\x1c\xc4\xa9\xa9\xcd\\O \xc0^H\x02\x1c\x1b\x91f$([\xc5%n\x9f\x9b\xb8\xe0\xb2\xe8\xd7\x09J\"\x905\xa4;yf\xf5e\xba_\x1c\x93\x0a!Z\x9b\xe1M\xe0\xfc\xc2I\xb1\xc7\x16\x02\x1d\xc2\x18m{\x1c\x04x\xaf\xe3o\xf5\xd2Y9S\xd2\x1dgj\xa1b\xdf\xe9UL\x83\x1f\xe3\xa6t\xc8\xd4\xb5\x06:\xce\x195!H\x1e\x84A\xd6=\x0c\xdfZ\xf2\\\xc3\x08}-\xc1\x17\x84b@\x82\xad\x96\x93q%\x00\xd4u)\x10\xbc\x87\xe1\xd8\xe2 -P\x85\x8e\x13swX*kD\xcd-\x05{\xaf\xae\x82\xb7\xa1\xd8N\x95k\x92\x06\xe4@g\x95\xa5\xbb\xee\x10\x93bo.\x83\xb7M(\xb9\xb6\x08Y\xd8\xa3b\xc5\x04\xc9\xd4\x96\x07.6V\x8d\xb2DI\xcd\x00\x82\x17\xae\xfcR\xd5\x9d\xa3\xae\x11\xc4\x98\xbdM\xae\x82\x92\xe5\x92L\x0d\xa4h&r\xe6\xb0\x1bh=\xba\xf0M\xa0\x04\xa7\x16\x90&\xff\xc3r]\xcf\xb8\x929cL/\x89\x07\xce\xd0\xfb\xd3j_\x07\xa0H\xe1\xe8C\xd8v\xbc\xf51\xcej\x91\xe6\xa2" +
+	"\xe7\x91\xda\xf8/\xc608\x02\xed\xda\xb7\x87\x8d\xa9\xd3\x19\x9aji\xa5\xb6\xc4\xea=\x8a\x97F\x08;#\xb5k\xd1%8\x89\x01\xc7\xde\xf3\xe9\xb1\xcf\xc5ywd\x90\x93&68\xe3\xc9CfcI\xaf,\xbc(\xa3I]\xb0\x91\x0e(u\xaa\\\x03\xed:N\xf2\xc0\xe9\x00\xe7Q\x9e'e\xb0\xadh\xce3\xb7R\x83\xc8\x93=\xfe*3!bYc\x05\xaagBVj\x85\x94^r\xe5\xa4\xda\xe9\xabw\x0cRPk\xac\xafW\xc0\x98F\x8dpM\x0f\x89;\x16\x98$T4\x95\x8b\xee`GV:w\xfb\xf1\x8ay\xca\xc5\x0d\xf0\x8ce\x7f(?:/M\xa4\xabNkVW\x8b\xcbU\x91L'c\\\x15]\x91\x93g\xce\xd0\x8b4I7\x8d\x1c\xacA^\x18\xee\xa2m\x87n\xc8\xb1\x01\xd3\xdc\xd1\xeb\xaa\xd7\x94\xb9U\xb1\x86\xda\xdf\x9d\x93\x18\xedz_q\x19\x17%\xe4\xe7\xcdHSt\xce\x9c\xc6\x92\xba-a.\xd0+\x92\xb7{\xa4\xb2\x86\x85\xa5\xa3v\xf1\x07%R\xe0P\xde\x0d\x9eM\x9f_\x06k\x0a\x1b\xf6\xef\xbf\x13\x9d\x8cI\x08\xde!\xf3\xdf\x1bo%\xb2\x09l\x05\xae\x19k\xeb\xfbR7\xaa\xcc@s\xad\x05\x06\xe4# ,~\x1d\x92\xb3\x18\xaaIa\xfc\xe0#'X\xc3\xdbNu\x0a\x87$\x91\xf9p\xbd\xa4\xe7\x18\xc2\xdd\x16nS\xe1Z\xe4)B\xe9\xee\x9e\xb34\x0b\xb0+\x92\x00\xb7W\xe3B\xa1\xbf\xda\x11\x97\xa0A\xa5p`?\x10\xa5\\NK\xab\x93\xd3:kR\xa4\xa2=0\x17\xc8\xc6\xe2\x98p\x19\xa0\xa9&,\x99\xb0\x8f\xf0\x03\xc2ttB53\xc5\x95\x10U\x97,\x98<v=r\xc7E\x099ryv2\xac\x00%D\xb5;=-^\xe3&/X\xd5\x0f\xb2\xe7\x13\xd9\xacM\x01E\xd7\x89\x11\xd3\x95vP\x07\xfc]\xe2\x80\xa4\x7fPYM X*\x87\xef\x94J\xf5\xadc\x1c\xb2\xb3\xf5\xb8\xaf\xd8\x80\xbe\x09\xef\x0c\xd6+\x99^\x03\xd2\xe2_t\x8b\x0b\xbf\x09\xb3\xd1\xcd\xc1\xbba;\xd6i\xe8\xb8\x00\x05 \xd4\xb9\x03B\xbd\xa4\xa4s\x9c\xfd_\x03\xa1b\xe3^/\xd3\xbb\x95\xba\x922z\xfa\"\xce\xdb!VN\xe4\x06\xbdp\xbd;KI\xc9\x0b\xce\x85o\x92\xb3\xe7Nvh`u8n\xb4%\x00>\xb7\x10\x94\xd9^bP\x91yK@\x09Q\xc2\xe3\x9e\x18\xe4V\"$#\xeb\x84\x8c\xa9\xbc\xc7\xdamC2\xeb\xb7\x8e\x00\x81\xb6\xffa\x1e\x1f\xadi\xa9\xd6\xa6=\x9d\x92\xab\x7f\x9a\x81\xcc\xb1_\xacp\x11\x0b\x8d\xac\xd1\xf0\xdd\x86\xf7\xb4^qM\xfc\x8c_\x85]d\xec!n\xbb\xc8\\HmSa1\xa7Y\x1f\xd8\xa5,*\xd7\xb6/}\x86Q\xa5\xc3\xc4:\xc0\xf5\\'\xd3\x03\x8f\xec\x8f\xd1\x84\xf9\x01=\x05?\xc5\xa1)\xe8i\\S\xbc\xb1d\x95/\x8e0LXvjn\xc0e\x02\xa04/\xd3\xe6dp\x17\xe9\x16\x9e\xb0X\xd5\x86\x1dN\x03\xa6\x9d!\xd6\xe0f\x8c?\xa7)0%\x11\xa2\x06+O\xab\xbe\xb1\xe0{&\x02\xc4\xd8d\xb0\x1c\x06f\x10\xabc\xff\x8dX\x85 \xeerl\x0c\xec\xf8W\\\xa1\x90\x00\xb5\xd2\x0a]=f\xe1\x8e\xe8\xb3K\xf3?76\xd2\x99Z\xcb\xdd\x80\x91\x13\x07A\xf4\x12\xd3\x92w}[\x874p\\\xedg\x04xc\x09\xf5\x14\xf7\xb3\x8f\xcb\x12AQf\xd0\xcc\xa6\xa1\xbc6\xb0\xc4\xe6\x03m\xe7\xd3\xcb\x9cI*6\xe6\x06\\}\x01\x92\x0c\xff\xb8\xc8\xc5\xf8\x8d\x15{\x8eCr\xb7\x8a$p\x008)\xf1\xb1\xaf\x88\x0f\x10v\x9c\xa4\x98\x11\xdb\x820+\x16G\xe5\xba\x8b5g\x87\x0b\x89\x0c\x95\xd0\x83Lu\xac\xcc\xc6\x86\x86\x87\x14\xbc'\xe47\xc2>~\x80R\x98\xd6\x1e\x17E\xe0\xab\xd6\xf8\xc1v\x89F'dRMgs\x85@\xe8\xc6 \xc41\xe4|\x83\xc9-le\xe5\xd1\xbc\xed}y\xdd.o\x0d\\9O\xb1U@.?\xbaq\x86I\xb4\x85\xd8\x94\x94%\x88z0wP\x91AU\x17\x95b\xc1\x9e\x8cj\x94\xab%\xe3D\xf1\x08N\xfb\"6\x13\xea\x11\x95d\x9b\xc9\xb5\xa6\xa2\x9aP\xe1J/\xce\x9d<\xe2\x9d\xf4\"&R\xa1Q\xa2\x16\x9c\x8e\xd2\x19\xc5\\\x12;\xe2XpT\x14\xccNC\xee&\x0cJ\x0d\x09\xf21\x12\x95\xf4{\xd7\x85s\xc7.\xe9=>f\xba\x85\xad\xe8\xb4\xb2\x85\x12\x0c\xeb\x9d\xfb\x988Oih\x06w\x06'c\xbd\x90\x91\xcb\x82\xd9\x9cdx\x86=C\x16\xb0O\\\x8e2\xbe,[\x8c5`f\xdb\xf9\x9b\x8f5\x1fz\xac\x8b\\\xa3j\xad-\x82\xdf\x98\xb4lK\xfa\x86_\xc5\xcc\xc0\x8dy\xc3-w\xb8\x88u\xcf+\xfa]\x06\xb03\xa7\xd0\x03\x1e\xdf\xdc\xb4\x09\x09-\x14\xa6\xab\x86\xa9rc\x8d%\xed\x13\x08MP\x83\x8c\xc9\x01<v\xb2\x82\xba@K\x9a\xcb\xb1a\xdc.I\xe4m\xa5aIzf\x07\x1a\xb5\xcf\\{\x9c\xed\xf8g\\?\xfe\x93b\xcd\x03C G\xf8F\xc4\x9e\xad\x1c2%Y\xff9\xceFL\x93\xc1\\\x15\x9a\x15J\x16\x0e\x12\x1a\xdd\xfa\xb5X\xc3\xf3\xed&q\x15\xfe\x8b\x15>x#s\xc1\xf4\xd1\xe4\x98J\x82\x87Q\xc8=d\xa5\xcf=kt\x1f@\x13N\xa6s\x8e|\x02\xe0\xc5\xdf\x9b\x05r\xc2|;\x9f\x16\xcd,\x97\x15\xc1\x1b\xec\xe1\xaf\xd1j\xd0\xbd\xf5\x17\xc9\x00\xec\xa8\xd1\xbeL\x8e`I\xa2W\x84\xd3H\xce&\xab\x80?`\x0a\x8a\xdaD\x91\xf9\x83\x8eXf\xa8\xd4\x1a\xd0d\xaf\xbc\xb7\x8c\xb1\x81$\xd3#\x9c\xfa\x89\xaa\x9d\x7f\xd6P\xb3U\x8a\xab\x9f`^P\xd5\xb1%\xbf\xc3\x1c\x8e\xff\xc4a\x07-\x1e:\xb5\xbfo.\xae\x89\xbfr?\xc0U{\xees\xfd\x8d\x93A_\xe4\xe5\x87\x9eV\x85J#`\xcd\x829\xe9M\xdd/\x91o\xba\xa11K\xfdGX\xe5a\xa8\x05w\xa7\x1e\x93q\x0d\xf76\x91\xce\x02\xf6\xa5\xc7\xf0d!\xee2\xc2\xde\x8f\xa3\x954\xd0\xf6\x9e\xd3\x00\xe6\xce\xa2\x18#\xda\x02,g)4\xa9\x89\xe5a-\x85X\xe5\xd8\xc1\x19\xe1x\xfd<;DE\xa2\x01\xf3\xd2z\xbd\xeaVQ\x8e\xe1\x15N#\x82\xb0n\xe8\x97H\x9d5Q\xdc\xff;\xa7\x18g\xdc?\xbe\xa2\x9dt\xbc\xaejZ\xa5[D\x0b\xd1\x07\xbd\xa2\xc1-P#n\x0d\xbas\xdc\x1b\xd0\xe2\xa9\xe7\xcd\xeb\x91\x88oY\xc4u\xc7\x0b\xb4u<\xc8[\x95P@\xc4\xfc\x9c\xaa\x18\xa4\xd5\xa0\x16d\xb4>Jb\xdcD\x08\xce\xf12\x97P\xd2\x86F\xb2\xea\xcc\x06\x97Q\x05i\xcd$\xbcbL8Z8M)\x1bn\xb1\"\x82/\xae\x0b\xd7$\xfa+)B\xa6jlsL\xa8\x03\xc2\x0e\xec\x03\x17SW[ \x8d\x89\xe7\xb8\x0a[\xc40,\x94p\xd4i7\x90\xcfPx\x983\x9f\xed6\xaa\x15\xb2\x13#\x19\x93a\xa1\xbc\xcb98\xd9\xcf*\x17\x9b\xcf]\xd3\x8d\xb8\xbc\x8e\x07y\x9d\xb72R\xa6\x95w\x96\x13\xd3\xab\x17=\xa9:C\xb4\xb1\xc0|0z.\x0b\xb0:\xb1\x893x\x83\x03\x01\x88+.n\xf0P\xe9\xd7-\xba\xc0\xd4\x02qPl\xbe\xb1\xc8Y\xfdL#\xa9\x7f\xc1s\xf9\x8e\x96\xa3\xec<\xb9\x12^\x8f\xf8\x87\xd23xJ4\xae\xaa\x1a\x11\xf5&\xd6\x90\x00[)Y2i]\x89\x8br*\xb9\xf4\xc5uA\xe8\x80\xb7I\x7f+,\xac_\x1b\xdcs\x1b~B\xc6\xb4\xf0\xd8\x1d\x91BmtV<\xd3w\xa3\x15\xd6\xe6\xca\x89k\x80n\xae\x18\xc5\xf0Hd\x06\x86g\\\xac\xc1\xc9\xaeaWn\x96\x89\x0c\xd2{W.\x96\x03\xec\xcf\xfb\x09\xb7\x99\x88P\x0b\x12KP\xe0\xca<\x98\xcb\xf0\xc4\x0bAv\xf1!\xdb|\xf9\xa53\xfaq\x01`hZ\xb2K\xd3\xd7C\xcf\xe8^\x02,\xfe\xf0\xa3t\xfa\xa9c|\xdd\x84\xa7l\"a\xccGQ\x81\xdb\x05\xe0C\x826\xb0\xa67U\x00\x81\xa1\x1aiLT\xf7}-\x14K\x80\xc8\xd9\x0b\xca\x00(\xf3\xf0\x9d\xce\xb8\x0bg;\x00t\xb9<\xf3Q:\x11\xe5\x8b'\xb6\xb4\xee,Z<\xd4gO3G[\xbf\x92EJ\x0f\x0c\xd0\xea\xc2\xdc\xbf\xf4\"\x1f\xe2\xb1A\xf9\xdea\xa5\x0f\xe0\x1b~\x17\x99\xf8\x15\x97\xe3p\xad\xa0\x0f\xbb\xea\xe6b\xc8\xd2\x96\xf3z\x97\x17\xb9\xa5M\x98\x05\xf3N\x12V'q\x97\xa3n^\x1f\xf3\xa5V\x14\xe0\x0cEO\xe7\xf2\xb2\xbb\xd2\xff\x05\x8e\xc2\x86\xcb\xd0\xa5\x80u\x1d>`\xb3\x97U\xb7&O\xb8p%\xcb\x03m\xaa:\xe8\xa9V\xe4\xf2I\xf2-V\xec\xbe\x95\xcew\xdd\x8d\xa9\xa8\xf0\xb2\x81\xed\x14\xbb\xc60\x96\xf8A\x155R{\xe3}\xa3\xc6\x9dE|\xac19U\xcb\xc9K\x16~gg\"LN\x9ayr?\xf0\x8e,\x7f\x12\xa4D*Q\xffZI\x01h\x92\x12\x7feaW\xaf\x92\x0a\x97\xe13\x92xa\x87R\x83\xf2\xd1\xae\x88\xa6#\xdc+\\<&\x91Z\xa1\x8bA\xb5\xd5*\xdb\x0c9io\x19\xd43,lK\x04U\xcd\x88\xd2\xa9\xdd\xf9\x09\xb3^\xd9\xfe#6\x1c\x94yr\xddQ\xd8\xd2G $4\x0c'z\x1eWg\x19-\"\xcd\x0eS\xca\x13\xef~b\xf8\xbd0\xa7\xfa\x84\xaf\xbed[\xae\xce\x0by\x80\x89v\xb0\xf6k\x84\x13+\xaeKi1\xd9\x83p\xc2\xac\x95\xe5c!\xe1\xac\x9a\xc4\xb0\xe3.n\xb1\xf3\xac\x88\xbc\xe3vR\xc35\xb9\x06\xc1\xd0\xe3\xb8\xbci\x0f\x83\x82\x88^\x1aY\xd1\x8d8B\xabO\xbf\x14/rMO\xc1\xb3\xea!\xbc\x90\xd7\xafb-\x12\xfd\x0b?\x19\xa1\xb6\x08\xf6'\xbe\x9b\x01\xae\xe9\xde\xd6\x7f\x13j\xc0V9\xbe\xccf\xbex\x82b/\xf2\xce\x94T\x13\x800\xecT\x0fETr\xc3\x11\xcf3\xa8\xfaIO\xfb\xc89*\x058\xd9\x8cG\xc5o\x1d'\xa3\xeb\xe4\x19\x88x\xaflS\xe2F\xcb\x03\xf8\xebG\xf4\xae\x0d\xa5\xb7\x86e&\xa7\xc1\xac\xe0D\x1a;\xa6\x8f\xd9\x8f\xbb\xf3\xce\xa5g\x9c\x1ff4\xb52\x98V^\xd0\xf0\xd4\xf2M\xc6F\x8b\x8f\x8c\x1cx`\xd9\xe6A\x91KZ\xfb3O\xc8\x11\xde\xa0\xc47s\xbe@\xbaH\\\x90\xb2!\x89\x03\xf6\xb0\xd2Zgi\x86\xa4\x9c\xbb42\xaa\xd6-\x8a\"KC\xbf@\xe8G\xc8y\x8c\xeaf\xc2)\xe3\xc2\x8d\x05\xf3\xb6rkv:7g\xef;\xba\x8e\x83$\xd1\xd7\xa6\xbe\xea+\xd8T\xb5[\x85\xef\x82\xc0'\x87\xe9\x14\xb1\xbd\xeb\x1e\x1bS\x83\x1f\xd9\x07\x94\xecjG6#B\x98\xb4\xfe\xb0}L\x1dk^\x9d\xccr\x01\x15\xf0\xc3\xa8\xa6%c\xf1\xd1\xc2H\xa8-}Df\x1e\x8b\xfb>\x0c\xa1\xbe\xbe\xeex\xea\x12\x8ep\x1b8\xc1\x16`\xc5+\xd3&\xe5\xb9L\x14\xb8\xa0\xe2\xd9@``\x84(+\xd9n\x10\xe1h7\x9cyW\xfa\x1b\xcf^\xf1\xd7\x0b*\xde\x8c\xaa7\xae)\x7f\x08o\xb4\xd1\xe6\xfc\x8dM\x83\xf0\xd7#z\x8c\xd4\x18\x98\xe5\xc2\x84\x91t\xc9\xb9\x9d\x83(<\xc4%\xb6\xc9\xd0\x1a\xc6\x80\xba\xb1\xc1w\xa5H\xc9\x16\x8b\x9e\xe3\xc2\x8b\xac\xe8e\xc0\xb8&O\xd8\xa4s2\xceb\xc3\xa2\x0c\x1c\x92\xc3\xfe\xd8\x8e\x090\x1a@\x81\xc4\xf3e\xe6\x13\x13\xf2\xef1\x85Ii\xeb\xb78;A\xe70\xf1\x9c\xd0\x08\xa4m\x80a\xb0\xb0\xb1\xa6]\x02\xfe\x14\xa2\xb14\xd6\xe4J\xe0\xf6\xa0S\x9d:\xdb\x86X\xc1o.\x8a-q\x1e?\x87\xd0\xc5\xca\xa8\xb7\x99\x07\x96/\x8c\x029\xf1\x9e\x0e\x83wn<\xf6\xdc\x8a\xa0[8\xdf)\x80\xd7\xc6\x08#p\x1b\\#l|\xfd\xb4\x91\xa7\x0ar\xdc\\u<T{S\xd2\xe0\xd0#^.$y\xe4\x8e}\xf7\xfc!\xa4\xdc\xe1\x16\xfaj\\\xf3\xef\xe6\xfad\x08\x96d\xb5\x15he\xd4cE\xeczH\xbc\xff\xe6\xc6\xebUp#\xef\x86\xa6:\xc6FZ\xca\x9b\x1b\x1c\xb9\xe1R\xe8;\xf3\xad\x97\x09NP=\xb7+\xccQ\xc4\x8bHC\xcb\xfa\xd8\x058\xe6\x85\x83c\x92\xb2\xd9\xff\x8f\xc11\x0dk5\xc3'\xe1uV\xb0\xad\xf3\x9a\xc1\x89\xb54h\xa6\x1ai\xc2J\xf9F+\xf0M\xf9C$\xb9\xa1\xf2\x0f)\x09\x9f\x06\xc1\xacY\x05\x86[\xf4\xa5\xe9\xdc\xde\x10\xd9\xf9\x11\xa0\xa5#\xf64L8\xbf\xc5\xd8~m\xce\xba\xfd\x0d\x13\xe2\x89\xd4a\xc6\xcd\x8bI\xa4\x1b\xe7\xe5\x90U\xd4|j\xd3\xab\x88\xaf\xf1Z\x83\xf7\x88e\xf0oVL\xf9\x04(\xa6Il(\x97\x8fl\x18cz\x86i\xf1\xedS\xacb\xc2\xb2\x1d\xf3\x80\xbb=`\xcb\xabh\x10\"e}\xf7\x8f\xf0je\xa6\x0e\x12\x89\x9e\xd0\xb7\xa5xF+\xc1\x01\x99Y\xd1s\xf79Y\x9ejCV\xc70j\x91\xfd\xab\xec\xa6\xb9`\xea=r\xc8$\x09\x7f\x02\xec\xc0H\xed\xfd M\x0e#?O\xe8\x0f\xa3&\xa7\xd4\x00\xf7\xe5\xdb\xbd\xccL\x02x{\xaf\xd8?m\x9f>\xce\xc90l\xd4\x01\x05\x1f\xe7\xdb)Rq\xf8\xda\xe3\x12q\xc1\xdc\x19\x99 \xc2\xdf\x85P\x03F\xc6T\xcb\x06\x8bd\xd3\xebN\xa1%\xf9\xfd\\\xe9\x14\x81!\xda.l]\xa5w\xd4\x17\x98\\\x1c\xc3\xfeA\xa1\xe2\xc9\xa8\xafz\xe4\x9d\xf6W\xb6\x92\xb51\xf2\xeb\xf5)\xa8\xbf5S\x05A\x94V9`kv\x09B\xb7\x91;\x9fv\xb2\xf7\xb1\xd5cA{|\x96\xf4\x8awL\x12\xeb\xd2<\x9c\xf3H\x00\x92\xfa\x04i4\xa6*\x86\x15\xfe\x9f\xf6%W\x08\x19\xda\x80r\xd5K\x19Qh\x14:Y4e\x8f\xa8\xe5u\xbf\x1b\xc0\xe1\xe3(Fn\x06\x14){\xcb\xeb [\xdd\xc6\x85T\x92j\x1cF\xa7aVR\x1cJl\xc7\xc70\xc9R\xe4Bt\x0e\x1c\x0f\x98]\x1f\xc5\x94Q\xf6b\x8f\x99\x06\xda\xa7\xee\x15;{\x8cm\xe9$W\x0ew\xb1O\x07\xc3\xbe\x83-\xd2Cs\xfc\xccG\xa6\x08\x90\xb6\x11n\x9e\x00\x18\x87{\x09$\xb1\xe1\x84\x04:\x19\xb6\x981X\x108\xbb\xcd[\xeb\xb7\xc9\xcd\xc9\xc5\x8f\x8c\xc4lw\xbb\xb5\x8fPS~\xef$H\x17\xd8\x13#\x9f\xd8\x97Y\xb72\xefV\xb9\xb1.\x0e\x8f\x81r\x11\x86\x87\xad\x12\xbb \x06%XQ^\xc0\xc8\xff\x0c\xb4\x92\x9c\x9fu\xee\x1f3\xab\x88\xfdg\xb4I\xc5\xac:\xb5\xb5)#\xe5c\x0bz\xca\x01\xb52\x04B\x00Q\xcb|\x99Pc\xe4?\x83X\xba\x8b\xac\x0c\xa2k\xbc\xb2\xdd\xc7\x83@#\xfa\xa9im\x01\xc2T\xae\xe6\xa8\xa3\xf3u\xa2\x05\xea\x07\xe2\x99\xa0\x0e\x89Q\xe8\xd0\x0c|\x16Nj\x92#\xb5\xec\xf8q@\x00\xf0T\xc6\"]g\xa0:Wk\x9a\xb3{\xb4%\xba\xc3^\xbe\xe8\x15\x9afi\xbfa|t\xb7lh\xd2k#\x94\x0b\xaa\xef7\xcf\xe4\x8bO\x8e\x88+\xac\x09\x8c\x03\x83\xfc\xa5\xd4\x1b\xae\xc2&\x0bW\xb3\xbc\x94\xcd\xb2\x0f\xca\xd6\x7f\xc98O\xb4i\x9bh\xd0j\x94B\x09\xbbSj\x9d\x06\xe7\xd3\xba\xf0\xf3\x83%\xba\x1a^YK\xf3\xd7+\x125I\xb5Z8 \x06\x18 \xce2\xf0-\xc7)\x0f.R\xcc\x03\x16\xd7\xceT\x8e\x1a/\\\xfc7\x09\xafiv0\x8b\xd5\x0bN\xbeA\xf4Nxg\xf6\\\x9c\x0a?J\x172\xfb\x99\xd8\xc4\x0aG\xacr\xf9\x9fmx\x1f\x87\xd2,\xe8g\xcax\xd4\x7fU]Z@\xc1\xcc\xd5\xb0#d\xcb\x1b\xc3e\xcc:\xe7\x98?/\x8f\xd7\x8dw\xb8\xdfT\x97\x13p\xa8\xfb\xe4T\x98\xb6\x1a\xdd\x04\x86\xee\xf2\xd6\x10\xc4\xcd\x05PE\x98\xb5\xfcm\x0el\xda\x97_r\xcb\xf9\xec\xdbr\x19\xd0.5\xd51\x00\x89M\xcb\xef\x04\xf7\x873|N\xac\xc9Ecbz\x8ag\xd0'w\x8a\xcfb\xd0\x19-\xcc\x86\x83\xc9q\x1d\x92\xeb\x84\x15\x94;@Ml1\x85P\xa3.\"\x98\xc2Q\xc4\x85\xf1C\xb0i\xdc\xa0\x8a\x1e\x90\xa7\xee8\x0e\xa60\xe97\xa9\xd6 \xc7\xb2)\x89P\xd9k\x14/A\xc6\xe3\x99\xc0\xfa\xf6\x9c\xc2\xf5\xdd\xe1\xec\xdb\x85\xef\x9d\x8d\x0c\xc3\xbd\xaejvyF\x1b\x94\xc5\x0c\x11\x8e>\x13\xd2\xa1<L\xc36*\x0b\x90Q,pF\xfa\xc0\xbe\x07:\x02\xd0U&\xfc\xaaBO\xc4\xbdy# \x09\x97F\xd0\xbd\xae\xe6\xcfF\xd3u\x97.]\x97b\xc0T\xbf\xfa\xfe\x7f.$\xa1*^=\xbc\xc9\xbf#\x03\xe1\x8dz\xa95\xddu\xc6Z\xba\xaeJ\x12\x1ac\xc9<\xca\xcd\xab\x06\xb5\xf6\x19o\xc3\x8f\x8c\x18\xce^b\xcerF\x98\xe0*\xbcj\x17Z\x01&\x00\x0b\xb3C:\x7f\x03\x14\xceYg,Nr\xa0\x0c\x9c\xb1\xce\x9eR\xa7*%\x102\xf2!\x1c\x9e$\x11`\xbfn\xe9V\x02\xf4" +
+	"\x11\x84\x87\xde\x07\x1clW\xbf2S \xad29\x81\xde\xf7\x92w*\x05\xfc,\x9cG4\x83\xd6'`\xb3}\x02\"A\x0b\xd7&\xa0\x87R\xc7\x8d$\xc8\xaf\x9d\xa6{%\xdcmk\xb4i\x93\x81\x0d\xdeq\x1ec!\xae\xf34\xe0\x7fK\xc0\xd1\x8a\xc0N\x02\xe0\xec\x19\"\xb2\xc0\xee$\x92\x0b\x15f\xdb\x03\x82\xed0\xe2D\x95Z\xe2d\x1cM\xeb\xddv\xdbE9\x0ff\x89\xf5\x96a\xa3\x1e\x9a\xc86\xac\xb0W\xe7\x85\xab\x97%:\xa8\x81 o\x9a\x91\x157\x141R6\x00k\xda\xb1\xf1.dGt\x8bi\xef:Ndmo\xb7\xe9\xc1,\xe3\xd8;\x9a\xa0.\xbbv\xd9\x98\x9b\xf4*9\x14\xa2\x89W\x12M\x947/\xbcl\xdd\xd1\"u\xab\x97\x13\x13\xb2]boi\xb1^\xc4\xda\xa6\\\xa0\x05\x1c\xb1\xa8\xb6\xaf\x9c\x03P&\x81\xc3\xf1K.\xc4\x8e\x19B3\xc6R\xcf;\xf1:\xb0)Mm<\xec\xee\xeb7\xc8AP\xdc\xf0(`\xcc1vE15\xbb8\x1b\xba\xc7\x0bW\xc1.\xf0\x05\x0a#_\xd8\xd8Cxn\x12\x17\x00\x92\x0cm\xec\xd6\x07s\x0b\xe81M6g\xdbU\x8c\xe3\x99<\xd6\xa9\xfe\xa7Z\x80\xe8\x9f\x9bfyN\xa3\x0d\x14^\xa7WCB\x8a\xfd)\x9f\x8c\xdaF1{\xf4\x072&b\xfd7\xa3\x15\x94=\xe9\x1d\xf6K\x9a\x138\xff\xf2\x0c\xcdxN\xc6u\xc5\xfb\x9c\x8c\x05\x1b\xd6*|R#}\xa8\xd7\x7f\xf9K\xa2\x13N\x18\xb4\xeda\x18\xefeM\x83E\x9aO\x99\x19\x0b2>*\xb6q8+\x0f\xaf^!\xb1\xda\x9c\x02Y\x1d\xac\xc6w\xc6A0\xa5+\xa7\x8f\x9eKG\xf4\xf1\xf7\x0f%\x1e\xa6\x0e\xd4\xcb\xc6p\xc9\x86^j5\x8b\xdc\xdfS3\xdfO\"g\xdb\x1a\xadm\xc7\xcfy\xf6UFo{\x0c\"x\xf6%3\xf4\x00{C\x92O8\x95P(\xca\x06 \xc5\xd3\x13]\xba\xbc\x19\x1f\xb0<\x13O\xeey\x0f\xab\xf4A\xa1}Jo\x8d\x1e\x81Q\xe7g\xba\xdbORUK\xb8OD\xae\x1b\xc7\xff)\xed\x95|\x08\xb2b\xa6E=^\xa6h\x0c\xff\x89nXe\xf4\x8eOn\xc1\xce\xf5\xc2BNh\xe9&9\xbbq\x06.\xfd\x15\xab\x13\xea\x1d\xe0\xc3\x91\xf4!O5n\x90\x85\xd21\xa5\x8f\xb0\xec\xdf\xa0\xbe\xa1\xe3,s\xb9\xe2\xf4\x9c\x96X\x9d\xfd3BQ\xb0|#\xbc(9\x06\x1b@\x12!|B\x97\xa3U\x14\xc1\xe8\x15+\x1d\x1b\xa3c\xbd\x17i#\x19\x8a\x0em9\xc6W>7.\x1b\x1c\x1e\xac\xd3\xe7\x15\x1e(\xdcNW\xc0\xc34\xaa\xb1\xfcF\x1a\x8d\x87\xa7\xb8\xd2\xe0\xe3\xb3\xdc\x15\xbf\xca\x8b\xe5\xb2\xeb\xb8\xe6\x1et\xae\x1b\xe9L\xbd\x18R'\xc4rgZ\x94u\x88`\x9d\xffy2\xfb\xec\xcay\x19\x96\x88\x1f\xb0\x08\xb8\x0d\xa3\xd7\x8f\x90\x04\xfb\x09\xe5\\\x17\xbau\x17\xf6\x89\x1e\xe5\xb9<5\xec0\x00'\x18\xea\x8cc\xfe3P0\x860p\xb2\xde\xc1\xbe\x14\xc5\x0d[\xd8\x81\xef\xac\x86Lg\xb1\xf0y\xe3iR\xd9$P\x03\xb9f+\xfc\xc7\xa8_\xf5UF\x8f\x1c\x13M%\xf1\xad\x82\x99Q\x9d\xbb\x13q\xc0g\xb7\xd9\x0e!\x17 \xe5\xf6ex\x8fs\x87#W\xfb\x96\xe1s\x96\x10\xed\xa5\xff\x7f\xfc\xdcM\x9c[ \xc9Z\xc9\x80\x7fC\x12\x84@\xe4T\xf8\xdf\x1a@\x8b\xd2\xec\x0fd\xc0\x86\x8aXSW\xc0\xcfn\xd2FKk\xec\xcb\x9a\xc0J0\xe1h\xdbY\xec\xd2f\xa1a2B\xdd{i\xe6\x06\xcb<g\x1d\xcbBJ\xef\xb8\xd8\x9b\xcc\xfa\xaaN\x92\xce\xeb\xce\x12\xc4\x93 m\x0aW\xd42\x0eH\xf95^f\x89*\xc8wL\xc6\xb44\xb6V\xabq\xf0\xb8\xed\x00\x10\xcaU#\xb7H\xe0TY\x92T\xb1\x86N\xec8\xcd\x03\xa4\xa1\xea\xd3\xb6>\x95\xff\xce\x11'\xcc5$`|\x0b\x91g-T\x9f,}\xc2t\xf0\xac\x8d\x8b\x87\xe3Q\x86,l\x8d\x8c\xfb\xb1Vc\xb6\xefg\xbbMp\xc6r\xc9\xf2\xd2+\xd00a\x0e\xb7<\xa1\x1f\x80k\xaf\x9b\x82\xe2\xe4Gl\x12b\x81\x0dv\x99\x98af\x9f\xdf\x104\xddwT\xabL\x0d\x8ct\xb2\xca\x00l[v\xb5\x9e\x95\xca\xc3\xf6\xdai\xf9\xbc\x0b\x96\x1e,p\xfb\xcd`e!I\xad\x91\x10\x1d\xdb\x19\xfa\xebq.s[HN_\xb9\xe4tJO\xa6\xbdz\xf6\xffG\xc9\xe9?\xaf/\xf7\x15\x88;\xea\x85\xa5,\xd5QS\xc5\xcf\xf4Y\xac\x95\x84|0\xef\xddQ\x12\xf7\xea\x91\xcbR\x0a\x0e\xfdV\xb2\x88\xe1Y\x9f\xbf\xb1\xc0\x0ee\x89\xcd\x14\xc1\x0a\x93\x8e]7\xe2v\xf2\xef\x885\xf9W\xac\xc9\xde@\x16\x17J'\xdf{\x9c\xd3\x13\xfd\xbc\xcb\\\x9e\xc4\xeb\xa1SulH\x09E4\xfe\xbdb\xe0\xa0\xdb\xd7\x18\x8e\x14\xd4~\xff\xc6\xa2\x81\x1bj\xe6\x1eaUe?\xfaAu\xb7\xc8ET\xd3/x\x8a\xb4\x0br\xbe\xd4\xee\x0c\x0c\x18#\x8fZ\xe8\x9aFIX\x7f\xc7\x8b\xefp\xd8\x9bI\xac\x1d9\x1d\x0e\x87\x8c_\xaa\xec\xa9\xdc\x8c\x0bo\x117/\xbb\x07\xfc1\xcc\xfd\x95\xed\xd3\x85\xcd\xa5\x81\xb7+L\x00\xdd\x84\xc6\x0a\xe7 '\x8fD\xa8\x0a\xf8\xe8m\xe3\xea\"\xceX]\x96\xd5\xe8ep\x1cGM\xac\x9d\xb1=Q\xda\xfb\xd6\xadg\xc7^\xbd\xc5\xe95\xb1\xb6\xf2\x10+\xb1Bk\xeb\xd9\x00\x94\xfdH\"\xa0\x92:b*Q\\\x1c\x94F\xba\xd3\x94=\x91w\x7fe\xd8\xa8\xad]\x89i\x13.\xbe!\x10\xf0\x97\xb2]r}J\xb5\x91\xb43\xb9!\x98N\x8a\x0d\x88\x0d\xb7\x89\xa4\x18}%\x92\x0e\xfd\xd9\xcf#k?\xfc\xe0\x1e\x8f\x7f\xb9\xc8\x91\xfb\xa9Y\x1bq\x156\x12\xf6\xab\xf2\xe3Ims\xd3\x0e\x01\xda\xd0\xfa\xe8\xc6\x05UlxP\x10k\xc5\xef\x04eq\x99\x19\xe1N\xfb\xeb\x81j\x0f+Q\xcc\xec!>bJF{Q\x0c\\ywq\x86-zO\xa3\xd5\x8d\xd8\x18[\x0d3_M8\xb1\xc3\x00\x99\x10\xf7\x19I\xda\xad\xc9\xe20d\xe9x\x02\x8b\x80\xe5\xf5\x9cJ;\xb6\xa4\xe7nF\xcc\xf6L7T\\\xfb\xca\x95`\xd0\xf4+\xbd\x88\xf3\x0eP\x84Ni3\x0b\x06KO\xa6\x00\x12\xb4$\xdc+b=q\xd6\xd3\x8b\xbc2\xa5\xb1\x15\xf7\xff\x95\x9b\x8f\xa7NA\x8aJu \xc1\xf3`\xc0\x10\xd8\x1e\xfbhE4\x05o!\";=\xb5\x8b\xc1\xb0\xd4\xb2\xecZikO\xa1\x8bE<\xban\xda\xee\xc2\x03~\x03\x9bR:=\x06\x9d\xebX\xe3<\xd8\xb3\xb3\xdc\x84\x9f\xc9A\xf6\x9f\xe2O\xa8M\x0e\xc3[\x1c\xdf\x0a\xf2\x03\x0d7}\xed\xb5\x87O\x18s\x93\xa1$\x9an8\x81~\xb2G\xa9WF\xdc|\x97MhO\x04(\xe1%\xd9\x11R\xd7\x85sD nB\xa0\xf0\x8es&\xd4|L\x9f\xd2+UM*\x1d\x09j~&\xf9c_\xf2\x81\x0d\xe8\xa7\xa6%I\xcdHAc\xcf\xcc]( \xf8\x1d\x8a\xc0\xa9\xde8}\xe1\xa0\xb3C\xf6\xe5\xaao\x16\x99\xc9\xbd$\x84I\xa5e\xda\xe4\xa2\xa7\xd1\xea\x094\xd3\xe1ig\xc6\xbc\xc1\x11\x87\x95\xe2R\x93r\xe8\x9d\xa5&\x14\xa7\x85\xcd\xf7\xe1\xa7\xe6`\xdb\xe5\xf4\xba\x8f3\x1c\xdfi\xac\xf45P\xa2\x95BK\xdc\xe7v\xe8\x041s\xe8\x9a\x99\x99\x12\xbd 7\xad<\xa6w\xad4pM\x06Nm\xc9qS\x87\x0f$\x0f\x851\x9b'p\xcb \x08f*.$\"fln9\xaf\xcb\xf6V\x84O7PR\xdb\x8f74Mo\x86\xdf4\\0\xc3\x97\xf3\xb5YY\x80DBx\x18rX\xae\xba\x10\xf7\xb4/\xb2A\xe8\x05\xe8Z5\xa2\x91\xc6\x10Fg\x18\xd6\xcb\xbag\xf6a,>\xe3'P\x85q\xdb\x0a\x7fJ-\xe1\x8bC\x874F\xb5iR\x8c\xb1*\xdb\x8c\xb5G\xfd\x9bUZ\x9e\xb1\x9eh\xdd\xd3\xb0\xfcqb^\x92\x0c\x96\xf0\x918\xe9 S@\xfeJ\x9eq0\xa9M\x0f\xc2_\xe1g]\xe2\x03\xf1RN\xd4\x04\x8bM\xf7$\xe0\x04\xccO\xe9\x87\xd90\xbbdZD\x1bl\x98z\"\xeaC\x8aE\x95\x07*l}\xf4\xdf\xb7\xe2\xe8'J\x8d2a\xfd\x1f)\x90#\x1d\xcfJtG\x84\xee\xd7\xd71\\#\x11\xdc^\xc6\x09\x92S\xa9\x941#\xa6 \xe6(\x95\x90\xde\x86Z\xefw\xc9\xd8p9\xe5\xa8\x9czt\x00\xa2\xeb-1\xf3\x8e9\x0em\xacj\x16\xd8Hi\xe2\xdc\xdf\xd3\xc4k\xc9I\x0fD\xe6\x1f\x03\xe9N\x8a)\x04\xce\xa1\x04\xd1\xec1c\xe9\x01\x87m\xcf\xd9>AA\xec\x00\xe4S\x8d\x97\xacfNy\x0d\xe5\xa07\xb8\xfb\x09\x81\x0e+i5\xe9\x1d\xf3\xfe\xd1\xa5\x15\xa6\x1f\xa2ExW\xb9\x87\xca\xb2WmT\x9d^\xfd\x7f#\xfdI\xa8\xb3\x13\xc4\xbe5\xc1\xb8\x03\x19\xaa=\x95%P\xe9\x92A7\x94d\xe7\x97\xfbF\x80\xdb\x8d\xc2K(\x1c\xe2\xe7\xdd\xc0\xb0\x92\x85\xa4<\xfc\x92P&Y\xc80\x92\xf1>\xebB;bmz%\x04y\x803\x8b\xbfR\xae\x13\xc7\xb2e\xf2\xcb5\xc6\x890M`\x02\xee\xe9\xcf8K\x8d\x90T\xe2\xc4Y\xf3\x01\xed\x9f\xea2\x1cD\x1bW8v\xf8$\xfeS:\x97\xed\xb6\xa7\x91\x85\xc0\xc4\xf9\xa8\xf7\x80\x04D[\xd7\x93\xcd\xfdKi\xef\xb3\xeb\x1eZKS\x1e\xc5G~\xa0\xa5QgT\xd3\xe0\xfb\xbdA\xf3\x92\xf8\xc8\x8ah:\x99\xe7\xfb\xa6\x87\xc8\x8f\x9a)V^\xe2u\x1c\x1f\x87\xa2\xe7\xec\x893\xb9'\xae\xb6\x9f\xae\xa9\x16^\x0c\xcdF\x0c\xf2\xe8\xb3n\xc0@y\x92\xd1b\x1a\x06\x98vD\xc4w]Bt\xbe\xc9\xdd\x88\x96\xb6\xc2\xa3>\x05s|\xab\x19yH\xfct`H\x9c\x15\x86!\xdaI\xcc\xc5\xf2q?)y!\xdb\x02\xea\xa53\xc7\xc7H\x87\xbf\xa9\xcae\xbc\x9at\x0e\xd3\xa2O\x13\x7fi\x02W\x8b\xda\xf5\xb0#\x0a\x809\xb0\xc5\xc5\xa7\xa9`\xb3\xdb\xb5\x99\xd2\x97\xd9\xb0\xe9\x93w\xb8\xfd\xf9\x18\x1b\xd9\xf9\xd4qe\xd9\x07X\x1b\x99CH\x0e\x1eck&Q\xe3\x88\xae\xe2J\x00u^\xee\xe6\x19\x0e\xd9\xaa<\xf5}\xd8\x9b\xafO\xb0\xb8\x1d}h6\xa1\xf7a\xdbwc3b\xf4T\x1d\xeb~A\xf4\x16\xaa\xe5qe\x17I\x8e\x1e5\xae\xf2\xc9\xc4\xc8\xe7\xe7\xde\xd1\xa6\xd5-3\xb2G\\\xedTIr:\xd7\x11\x85\x0d\x8c\xac\xd36\xcd\x96\xe4\x1d\x1b5\x8a\xa5\xb2\"h#\x05&\x19+j6W\xac\x8b\xdd\xc3\x86\xd4\xca/\xfe\x86c\xcc\x83/\xd0\xab\x1e\xd1\x00\xcba\xb4u\x1c\xde3n\x1e\xe9p\x14t}\xd5\xc5\x90\xb6\x80'\xe2#\xd5Z\x8a\x06\xf1/\xd5\xdf\xe0\xaaO\xdd\x97'%OS<\x8c\x89\"\xb6\xd6\x1e\x04\x88\x13\x81Cg\x1b\xcbJ\xce\xadJ\xfd\x04\xce_\x95:4\xd6?\x99\x85V\x847g\xcc\xe1\xb7r\xcc\x9c\x99\xd3\xc0\xdc\xa9c\xad\"\xd7\\\x8d\x03\xc0X\x1d\x9e\x06\xeap\xbdo\x1c\xf6\xbb'\xd9E&*]l\xaaC?c\x95\xc5g\xff+z\x98O\x83%2\xc3V\xab\xcc\x84\xa6\x80a`2^\xa6\xb9\xe9&\xc2\xb7\x0f\xd6\xd0\xfa@.\x13\xa2\x19\x1e\\\x01q\xd5\xfb8\xe7\xbe\xa0M\xe5\xec\xd2j\x13\xb6P~\xb4-d\x08*I;\xf5\x8eH\xf7g}\x8fC7\x00t\xd8\\\\f@\xb4S}\xf81\xf08\x17\xb6]\x0a\xd6?\xd5\xbdv\xb1.<T\x9c\x99\x0b\x86=\xb6\xbf\xe59\xbeT\xe8{\xc4\x85/\x9cw\x90\xef\xe3G\x8a\x0d\xdc\x17\xf4\x91\x10]}\xad\x1a\x1bO\xb8u\xd6x\xb9'\xd6%\x85+\xecR:\x92\x86\xc5\xe6\x057\x94\x9b\xe4qAS\xe1t]%\xff/b\xe1\xca:,dz\x15l\xb3\xe9\xb7\x04t%\xd4\x82E\xb7j\xaeyG\x13\x17\xf7\xa3\xce\xdaB\xd88\x09\x1ew\x96\xd8\x00\xd7\x90j\xd9\x88\x00\xd6\x09\xb3\xd3\xc6\x1a\x0ecd\xb2\xcfG\xf0\xf5V\x12K\x0c\x16\x0e\x95\x9e\xbc\x08v\"}`\xee%\xb6!\xbdt\x18\x8b\xbb\xf1\x03\x13\xa4\xeb\xb4\xa9\x85\x0d\x02O\x06{z\x9a\xe2$L\xd6\xfd O!\xc8\xd1\xf1A\xbe\xed]\xc2\xbc\xaa\x986\xa3\xd4\xcf\x05\x1d[\x09A7~\xa1\xa9\x93\x82\xd0~\xa26\xec\xcd\x9c1.\xc5L\x99_q\xe7,\xcb5\x95Z\xf11\x06\xf6\xd8Y-f\xa4\xe7\xd5\x92\xb13\x0fa3X\xef\xea\x9a;v\xd6\x9a\\\x01\xdf\xdbv9\xdc^X\x88\x11\xe0\xb7\xe5\x01<+0\x01\xd3\xdc\xfe-\xf8\x9dt\x03\xf7\x12\xc7\xcb<_\x9b\xde\xbe\xc2\x1cl9'\xc8*\x09\xadr\x8e\x8a\xebB<\xa4\x93\\\\\xb21>Xz\x0b\xb1\x19\x1c\x09/\xb1\xb6v/\x9f$\xd4P\xc9\x00\xc5O\xe7\x88q6\x07d\xb5\x11L\xa4\xe3\xa79}\x03\xd5\xc3\x18\xdb5pW\x17n\x82\x1b\xdd\x12\x01^\xc3\x8c\xb6\x1cgP]\x87\xbf^h*\xa0tW\x83T\xd5\\T\xf2{\xa04A\x94n\xc4\xb4\xef\xa8\xb6k\xc6Fks\xfa5afm\x01\xce\xbcq\xd6\x08L\xf7DI\xd8\x7f\xb1\xc6\xd2t\x0e\xab\x90\x12\xbe\xe2\x1eb:\xfe\x1d\x1ef\xd5\xf3\x8b\xf0\x08k4M)l`\xa8`\x8b\xdasX\xd9\xfd\xc1\x11]\xdb\x1a\x885\xbfj\x13\x9d\xdc;\x0d=?\xf8\x8f\x08Du\xdc\xea\x05q\xa0\x7f\x09\x04\x0a\x14\xb2w*I\xaaI\x12\xc8\x1fz\xa9a#\xe3At\x02h\xbf\xe6\xe99\xd1\x8e\x1c\x87\xdeO\xa6z\xa4\xe9$\xcd'h\xea!\xf3%\x08\xc2\x97\x11\xef-&^\x81L\xec\xc0\x1a\x02\xab\xc6gW\x05\xf6\x84?J\xd6b\x19g\xbe\xb1\xd2\x1a\x93wDMd-\x08\xd8Q\xcb\xe3\xf7\xd99z\xdd\xef\x09ol]\xff\x05\xd5P\x11FC\xad.\xff\x86\x1d-L&S/]\xc1\xa6\xa5\xfe\x98H|fx\xe1\xd0\xae\x00\xa1\xbadQ\x1d\xa3\x9c\x95xb\xb8?\xab\xe1\x17n\xd6\xb7\xd3\xe3k\xc2\x99?\xe9\xe1\x15\xb4\x99\x8e\x0d\x95\xf2f\xb4\x8a\xe2\x12\xbb\xbd\x9a\xbc\x85\x9c\xad,}d\x82x\xdeFdv\xec\xa8yDz\xc9\x7f0\x02v\xbc 5W\xa6\xdd^\xc8\x87\xd1\xc6\xe3\x16 X\x8e3\xf1\x96{\xbe\xac\x0d\x01?\x0ee\xc6\xcd0\x0f;\xae\x8dco\x8d\xb9\x01\x16Z1\xb3\xb5&\x89\x84lFP\xae/}\xc6\x961\x11\x19\x1a9\x12S\xbb\xc6\xf6\x94\x99\xf2>\x946\x06\xd3m\xf5.,\xb4Z:EN\xfa\x99\x19\x01\x0c\xe6}{ \xb8\xe0\x90z\xcb\xbc\xca\xaa\x03\xa1)\xde\xc7Y\xb6\"\xc0\x94\x1a\x84\x12\x11\x17\xb6\"\xfbE%5Z\x8f\xddLU\xd8\xa1\xcd\xa35\x9f\x02\xdf\x01?\xf6\xec|\xa4\x9e_9\xcd\x1d\xf50\xdfb\xad^\xed\x11\xf0\xa4>E?X\xcf\x91\xa6\xa5\xf4`\xe1IJ\x8c^\x91q\xadMT<U\xd2\x87\xf38\x1b\xf0\x08\xcf.K\xc0\x1b\xe4\xc4\xd0T\x03\xe6\xbd\xe0\xeaFp5k\xeb\xdc\xa9\xae\x84\x0c\x02\x1a\xbe\\\x0f\x83\xc8\x11\xd5\xd9\x8f\xf5\xc8\x1c\xbc\x1a\xf0\xf3{\xb7\x8e\xdc\xfb\xcf}\x7f\xc0\xa4\xa0r\x85\x96\x8d\x8d\x12\x06\xcd\xdc\x1e\x03#\xe0\xb5\xe2\xbb\xb3\xb2X\xe8\xf7\x0bd3-\x15\xb6\xa9\x1d\xc1\x9fV\x94`\x90O26q\xa53\xc7\x0c\xfe`\x19\xa3;Bno\xe32Rj0\x7f\xf7\x10>\x191\x84/\xa7w\xf5\xc9(\xe9\xecv\xa43\xb3h\xcc\x98\xa6a)@\x7f\x9f\xd4\x02p\xee\xb2\x94\xc8\x19R\x9eYc\x9cB\x04\x83\xe4\x11C\xe0\xcc/b\x0b\x11zm\xa8\xf8\xc3\x8eB\xeb\xa5Bk u\xb3t\xe0\x8d\xbe\xd9\xa1C\xb4\x18+\x0b4\xd4\xd8\x0dAF\\\xe0\x80%o).\x0d\xf77\xd4$7,\x86k\xe4s\x1a)Wo9\x14k\xf8|\x18\x15\x10\xcf\xf2\x98\x0a\xeb]1\xe95\x9ep\x8cM\x1ai\x9b3V\xc6\xd9\xd3\x0d\x84$V\xf2\xac\x83m`>\xc4\xf6\xd4yx~\xfe\xa9\xac\x87h\xd8\x94\xb4m:\xcf\xd6\xa8\x1c\xd9\xbb\x02\x13F\xde\xfc\xc5_\x19\xf1\xf0\x83}`\xaa\xbe5\x9a\x13\xdd\xf0n\xbb\xd4\x9a\x91\xddk\x0b\xa3\xe5\xd4\xbd\xe0\xb9\xe2J\xe3ojH\xd0z\x1e\x15S*6\xdeK\xcd0\" \xbbQw\x0d.\xcc\xed\x98\"\xddIL\xa4\xa7,\x97+;h\x0a\xb4\xe8\xef\x1a\x02\xeah\x8e\xa9\x1a\xe9zw\xa4\xb1H\xe0\xc8\xea\x03\xe1?\x86\x09\xad!0\x91\xfc\x96t\x16\xac\xf1\xde`\x8f\x8d\xcd\xd0\xad^g\xec\x80\xfac\xe2\xdeIv$<\xc0\xae@l\x8c\x8f\x8a\xb0\x12\xb3\xa7\xd6\xaep\x9d\x1b\xd3?i$u\x0c\xc7\xa5m\"\xa9\xc2..S\x040\x92\xdb4\xb2\\K\xe4^z$\xac" +
+	"\xba\xfb\xdbQq\xe1g0Q\x0d\xa1\x86\xaf-|0\xcc\xdeN\x94t?,\x1f./I\x89\xa7\x09\xe1\x97\xe0E\x95\xc1\xc0@8nZ}Y\xac/=\xa8\x0a\x83\xfc\xf0\xef\xa8P\x8c\xb9\xb1\xadQ\x90\x82|\x18(\xc7\xdc1\x9ah\x17\x1e\xf0\x9fH\x07\x93\xfe&\xf9\x11\xdcd\xfe\x07\xb5\x96\xca\x95\x7f'vY\xc1RM5ES\x13\xe6\xf8\x893V\x01\x88d\x1a\xf2\x8b\x05]\xbbR&\xa8\xf4%\xed/\x0b\x11\xdb2h!\xdc\x1c\x07\xbb\xb0\x1aM\x0f;\xde\xa97\x12y\xc6\xae\xae\xde\x93\\\xe8l\xb6Z\x1e.]\x1b\x0e\xe0H-\xc3w\xefALlH\xc8{K\xba\xd0\x93\xc8\xe0\x84;\xbc}\x06\x94\x1c\xcd'6\x97\xba\x9a \x9dyx\x1a/\x16/<\xea\x94\x06\x92\xc0*\x8b\xe2p]\xbaW\xaaY\xcf\xb10\xf7\xd3Y\xce~T:\xbc\xa4\xb1\xca\x19?\x1b9\x919\x90m\x86\xe5\x19A\xed\xf5>\xa7\xaf\\\xa4\x1a2\xbf\xa8\xf4\x1c`\x8a\xec\x93\xf2\x87f\xa8I\"z\xe1\x8e\xc3\x8f\x04\x89\x04\xed\x1dKf1\x91w\xf1K9\xda(\x0d)\xb0\xdb'\xa4!\x09\xdf\xed\x01\xd0\x90\xfa\xd3\xd6\xdc\xf2\xa6\x01\x83\xd7Y\xa37S\xe5\xac[\xc8\xf2\x81\x0d\xc2o\x18\xc8\x8e\x10\xa3\xfd\xf1\x94\xe3\x155<\xe5n:\xca\x1c*\\\x94\xde/u\xe7p\xab\xf0\x163C\xe9\x0f\xea\x19\xea=\xab\xa6{*\xb7\xb7\x9a\xf7@\x0aI\x0d\xff\x91\x1c\xea\x17\xc6\x11AR/\xce\xcd\x9f\xa8V\x04\x7f&\xfb\x05/\x89\xca\xb8@\xa5>\\\x91\xc9\xdd)\x00\x04\xb3*!+q\xc8\x96%\xdf\xf8\"\x84\x9f\xe5q\x0e^\xa3V\xedf\x8c\x11X\x84\x1f~\x17\xa4\xcf8\xfc\xee\x10K\xc5\x86;\xd0j\x02U\xb2\xe4o%\xb2nt\xddp \x8f\xa4\xc5\xb0\xe7>\xec\xc7\x16\x9b)\xeb\x1c\x14\xd2.\x1bE\xad2\x9a\xae7\xf4\x98\xce\x9cf\xfdk\xf8@\x17\x81\xb6O-f\xc0\x0b`\xcc\xa9h\xc4\x9al\x1d~\xeaz\xfd\xb6\x80\x0e>\xc0N\xaf\xc8@\x02\xa5tc\xbf9x\x14\xe4a\xb0Dp\x8e\xf5sq\x00\xb7\xc2\xed\x11\xf1L\xfdq?\xc3\xde\xf4\xa5\xba\xefP%^p\xab\xf9:RP0w\xe5$\xeb\xb9-N}S\xc6J\xe7K\xe6\x86\xdd\x1a\x13\xc1\xc6%\xa6 \xf3\xa8\xc1Y{I\xdb}\xe5\xb2\x8d\x80\xeb\x89\x7f\xa0\xa1\xba\x0aYc?\x80\x14\x03\x9a\x1b\xc8L_\x1f\xa5\x03\x80\xce\x0aOv\xde\x01\x83a\x19\xe4?\x8e\xe4\xfa\xd7\xf6LM\xcb\x11rF\xe4\x1ee\xe5\xc9\x03h\x97\x16.}\xf1\x9b\xac)\xc61\"/\x95\xc2~\xddx:_\xe43\x80\xfa<1\xed\xf1P^g\x97=\xeb\xac\xb3\xc48\xd1\xb3\x81\xec\x12$v\x0d\x0dMM~}M\xc0u\xe6\x93N\xbfx\xc6\xbc\x1e\xa6\xaa0]\xb3`XS\xcb8\xd35[\xb7'v\x94\x17\xa43e \xa8\xb8\xfe\x97\xc2;\xc6\xa7]\x08o\x83iw4\xbd\xd4\xd4\xe3\x9dw\"\xc2\xa7\xe0k\x1c\xf06\x0c\x0a\xdc\xb6;\x13V5\xce\xb9I\x82 \xd8\"\xf02\\K\xa2\xdb\xd6\xe1lK\x16\xeb`\xa2\x1b\x870\x15\x80!\xd8\xa1p\x98\x00J\x05L;2;#\x0b\xeaR\xe8\xce\xb0\x84O\xcd*4\x93\xd3\xa3j\xd4o\x03D\x93\x8e\xba\xe7^\xb8\x03\x1b\xcan\x08\xc35c\x93\x94v\xe3L\x13\xd45E\xb0\x86xq\x12^;\x17\xf2\xe0\x98\xfd$\xb9\xfc\xc0}UU\xd8\xe4G\xc0W\xc5#P\x0c;\xfc4G\xd0\xf2y\xb7%4o\x00\x04\x9c\xf2;\xe5x>\xff.6vPV\xa7\x0fIG\x8er\x8f4\x81\xc6\x9d[v\xddl\xc4\xbcw\x1c\x05\xb8S\xcaW\xc3[\xfe\xce\x84\x90\x96\x85\xd3ob\xb3R\xa8\xe2\x1cI\xe2K\x90\xdfP\xe0\x88\x05\xfdm\xff\xa3\xd96-c;\x8cDj+FD\xf8P~\xd3St\x0a>\x099\xe9\xe6|\xff\xe5\xc3.\xbf\xfcLa\x9aLC#\xfd\xe1\xe2PY\xc6\x04\xa4-\x94\xb4+u\xfe\x03\x8e\xff\xfb\xa4\xa7\x06\xa8\x91*\xd2K\x9c\x1c\xab\xba\xfe)\x08\x8a1\xefXS\x08mA\xb5\xccC\x04\xfd\x08\xe9=\xd9Ly\xd8\x16G\xba\x88\xf7\xed\xbf\xa3\xb3<k\xcd6jQ\x06\xecUb`hESY\xc0eD@:\x9ep,\x15\x93\xcfq_C\xf9~\xce\xcd\x0e\xc7h\x0b\xcd[\x00\xec\xa2\xbfE\x91\x13{\xb0\xec\x9f&\xb1\xea\x9c`G\x9a\xa5\xfd\xdb\xc0YY\xd1\xd3\xce\x1b\xe1\xed`\xdf\x87?_\xf0\xe7+\x86\xf7\xbdS\xffM\xf8\xee\xf2\xd0\xdcy\xfa\x0b\x06\x94~\xa6k\xf4$\xefp;4\x84\x97&\x99Q\x8f\xa4x\xe5\xb6U\x88\xe7\x9eC\xc1d~\x0d\xb4\x08\xa6<\xe7Q\x1b\x06;\xa0/\x00\xfd\xd6Pb\x16\x9aN\xb2\xeb\xd0\xd0\x89UZ\xf58\xd3\xff))\xa5\xa9\xa5WL\x9aV\x09\xce\x93!\x05\xef\xe1\x1b}\x02\x86\xeb\x0d.\xcbN:\xfb\xf9G4\x09\xd2\xe9(b\xfc.\xd5Q\xb1\x1e\xa4!G\xcb\\\xb4*6\x13\xd0\xf3j\xa5\xacG\xbb\xc2\xb2H\x80&7r\xea\xb6a*\x8ai'`\x8e\xc9\xfe\x93wl\xbejq\xa4G\x96\x7f\x90\x02\x00\xfa\xf2\xe1{\xbe5p\xe1\x11\xf7\x87\x9d\x9a\x0c\x1f*\xbb\xed\xd8%\x86\xd6\xa0\xa7Rs\xbe\xbf\x8a5_\x15\x11P_3\x8e\x995v\x1e\xb3\xc1\xa2(\xa3`\xbd\xb6\xd6p\x85\xe3\xd0\xd8]\xe7[\xae6\x95\xe3\xb9\xf4y(\x00\xfa\x8f\xa8}\x15\x01]\x85\x1d\xf9\x0dC\xf3\x9f\x05e\x1c\xeaW\x051x\xdecB)\xec\xc3?P'b\xe0G\x9c3\x9f\x99H\xdfA?*\xbb{v_\xf7\xd3\x1f\xf72\x9c\x14\xae\xd3\xeb9K\xfc\x0e\xed\xdfg7\xa5\xd4&58\xd8q\xda\xdarXC\xb9;3\\\xd0W\xbdB\xe6S\x9d!t\\\\\xd0\xe4\x11\xb6\x1b\x1b9\x13\xe7\xcdp\xf7X\xad$h\x07\x8dm*I\xe8\x0bH\x15\x08Z\x11\x9b\x88\xafE\xefFjP\xb4\xb8\xe1kC\xe7\xbcc\x0c\xd1\x042yGl\xc1\xb8\x0fO\x9c\x9a\x01\x12u\xe1Ow:e\xe2wRe\x1a~k\xcb\xb6\xb3\xd8fUs\xa2\x94b\x1a\xcft)L\xb6\xd1K$\xbe\x19\xfe1-\xa4t\x8d\x0d\x82\\\xfa\x0e\xb8#\xfd6\x88~^\xc2\x1e s\\\xbay\x0d\xefi\x91\x01\x83M\xfa2\xael\xd6\xa4\x0a\xbf\xe4OX\xadJ{\xdb\xc49U\x91\xb1\xf7\x0a\x94\x93\xe0F\x8e\x13*\x00\xb4\xe1\xd7\xc4\x98\x1f\x19\xeb\x0e\xbd\xc1\xeftf\x18;.\x08\xc3\xa6\x98\x80*\x94Tn\x1d\xdb\xdc\x03\xfe8\xec\xcae\x95S\x8a\xb3\xb4p\xb1\xca\xc1\x9d#\xda\xb27)f\x1c7R\xe5sm\xe9\xce\x12\x93\x1e\xafh\xf1\xfe\xf5\xda\xcd\xe9\x0f\x08\x80\x04\x81\"\xbd\xd1\x03\x106\x05\xd2\x91o(\xe0\xc3t\xf0\xad\xa9B\xdf\x83eh\x08>\xc5\xcb\x7f\xce\x1a\xa4\xf0\xb5m\x81\x88\x15c]\xd6\x1c\x9feu\xf5\x85/\xee\xf2\x85^\x09\xef5\xaa\x02\x9d\xe5c?\x88\x94\xcb<\xda\xca4\xcawlN\x01\xca\xb9\x01\x8c\xf6\x83o\xb9\xb5\xb1Xe\xf7\xc2\xaa4\xa5\x87H\x07\xcaEz$S{\xa2P\xd1\xaap\xfc3\xf13\xbe\xa4,W\x16q\xb9\xccB\x01\xc5\xee\xbc!\xe2\xcbP\xef\xec\xd8\xda\xdc\xa9\xebZ\x80\xd1\xb9b\xac\x10\x9a\x95'\xee\xd5\xe7\x9bE\x90,NYe\xd1\xe1X\x86m\xb8\xc3m\x12\xed\xbf\xb6h\xb2\xff\xd88\xc9\xce-L\xd2\x0d*\x84\x83\x93)}\xfd\xda\x1b\xb6\xa1M\x9b+\x99\x1b\xb0\x09\xe3\x84y\x1b\xb3\xad\xc3M$s\x9e\xc9\xe8\x1a\x94\x04\xe4\x852l\x18G\xbd]\xce\x7f(\x10\xe1\x1d\x09{\xb4R\xcd\xa4hD\xc0\x99\xec9J\x01RR\xbc\xec\x15g\xd9]\xc4d\xe5\xbf\xa7\"\x1e\xbd[\x14\x98\x17j\xdd#\x0c\xef&.\xed\x1aR\xa75\xcd\xcf\xd7\"\xc7\xcfMA\xc6Km\xac\xca\xb86\x9d\xfa\xe7\x90\xf2\xfam\xa6\xe6\x0bX\xd2o\xf0\xa676\xd1\xe7\x934\x1b@\xc6j_\x16\xf7\xf5\x99}\x809\x81(y\x07\x90gd\xc4zR\x0dH\x97\x03\xa3\xd0\x7f\xa6)\xc1\xb0\xd1\x10\x17B\xd5\x8f\xd8\xearK/\x06X\x128U\xef\xbar\xe8\xc2q\xc6\x15\xc0\x81\x9b$\xff\xf7\x88\xb0\x86\x06Z\xa7\x0e\xe1\xdd\xac&\xcbt\xaeUS<j\x8c\xb8\xcf\x01d\xb2*Cx$\xa2\xfc\x9e\x9b\x03\xff\x85\x8a\xc3ua\xe7\xf4*6\xb8\x0d6\xe3:\xea\xa7m~\x80\xf6x\x14\xca\x1a\xce,0=\xccA\x9b\xae-\xd4C9\xf7L!\x9f9\xc1E\x9cK\xfe\xea\x9ch\xd99\xc5RwT\x18@\x91k\xc2\x92\x9e\x01\x06a\xbd,l\xf9\x10b\xd8!&\xe6\xd4/\x1d\xbe\x85\x03>\xf3F\xfd\x08^\xaaF\x98\xc0\xd9\x02Wv\xd9\x01'v\xef\"fb\xc3\xc2\x1b\xf8\x94\xfb\x81Z\x19$0\xb3\xd1\xec\x9eY\xfaL\xb0\xbdt\x08Y\xfc\x10\xd3N\xea\x88\x84\x03\xcabX)*x\x14\xdf\x80y\x0c?\xe9\xd6p\x9e\xa7,\xdb\x93j\x83s\xf7\x15\x82.k\x9c\xa1\x04`O#\x93\xd6;\xdaF\xeeJ=\xcd\xe3\x03\xc4\\\xba^y\x0b\x9c\x0e@\x079gI\x05/\x95\x9a\xb5\xa4\x0c\x8b\xb0g\xcf\xe9\x07\xc6rFD\x06Yx\x8f\xc9\xc4\xe3\x18\xcdI#\xa1\x9a\x10\xeat\x93\x1cAc\x11\xd6\xf0\x9c\xb5$\x8b\xbc\xa0\x05\x166\x0cIh\xd7&S5sD\xcc\x09\xed_\xa7\xd6\xf2/u\xf2ry&W`\xe7\x1d\x8f\xebE\x84-fX\x07\x1e@\x95w\xd3\xe6g&}_M\xb3S\x86)8\xf98\xc1(o\xac\x01\x9f\xf5a\xb3\xc2\xf1g\x9c\xa1\xe9\xe5\x09\x96\xb5\x7fM\x15j\xa5\x94\xa3eR\x1a\x14=\xc0q\x14\x8c/\xfd^+\xf1\x82\x91'`A\xacK \xbe^[\xc1tV\xa3bUD\xfb\xaa\x19@\x97\x1c\xc2D\x83\x95\xa4\xca\xbbn>\xa9Pf\xce\xd2\x9fz\x1a.\x7f\xb5\xfa\x0aD\xd0\x90\x92\xbb\x07M\xe1\xe0\xace^\xe1\xa5\xc9\xf0\x03\x8c?V\xdc\xefAUN}.\xe5\xb4n\x8dY\x02\xa8\x0c\xfc\x1d+7\x8d?E{=\xbd)\xb6\xc2\xe2hB\xb4\xbf\x1d\x05\x05\xd9\xd7\xf3\x86I\xd7n\xc2z(b\xbf\xfc\xc2\x11{\x9c\x0fD\xe9\xec[j\xbb\x09\xc1\xd2\xd3\xa2\xfe\xfbA3Qb\xa6D\x88\x09\x1b\x0c)um\xe8\xeax=\x98\x8a6\xc3\x0e,\x8d.O-\xb8\xe7\xed`\xf0\xba\xa6\x1a>$\xa7\xc9\x86\x11\xbd\xdc\x0e\xcd\xa2\x12\x13 \xb3\xd4\x04\x99N\xc3S\xcfy\xad\x89%\xccOm\xb8\xadvq\x99\x92\x94na\xa8?\x83\xf6\xdeQ\x1cM\x00?\xdc\x86_CA$\x17\xe7\x9f\xc3\xd6\xd8\xa3\x12\xc7\xf7\xcc\xd2\x87bL\x83e\xe0\xfb\xa1\x08\xd1\xb4\xc1\x9b>w\x8f\x10\xafY\xe4\x00\xd2[\x98\xb4\x86v\x98-:\xf8`\xf2\x14\xe7\"\x7f\x8a\x04\x92\xd1SL\xe7\xbf\x1f\x8b\xf3\xcc \x86'\x1c9\xcd}S\x9aX\xd4\xe0\xa0D\x82-\x88\x0aP\xde\x86\x9d?\xc4\xb4\xe3\x82\xd03\xe6\xe0\xfa\x07\xe7\xc6\x10\xd8\x81L\x95\xe9\xf8\xc3\x84\xc4\x02\xa7\xf6\x96\x08\xb1\x0b\x93\xd1\xd6}\xa1|@3\xa6+?\xcb\xff\xa1N\x0fi\xe7\x94\xe7\x08[[\x97\x07\xe26\x96\x12\x0fo\x859;\xfd\xe2\xc74.q\xe7\xdeb|\xcf\xe5\"\x18\xc0\xf7X\x85\xe3$.\xc3\xcf\xd2\xc9\xc4\x8e\xa0r!\xb7i\x11\xfb=\x8c;\xa9:\xe9\xfdnc\x85\xacw\xe2\xee1E\x0a\xdc\xfacq6\x18\xdf\x17\xa6?r\xdf\x0b\x8b\xea\x9c\xf5T\x9a\xf0@\xf8B\xcb\x96\xbbGU\xf0\x1f\xb0}<\x8dIt\x1e\xe9\xfe\xd3\xe4\x14\xde\x00\x0a\xb56\xe9\x8f*6\xe1}\x04\xee\x94\xdf\xc2:\xed7\xdabIm\x02G\x9c\x81\xf8L\x8e\"i\xc6\xe4d%\x03d\xbc\x985dOt\xa4q,\x95\x1fk\x8d\x99\x10\xe2w\xd4\xb6\xa7m8\xd0\xe4\xa3\x83\xef\xc3S\xe9j=\xe2\x97\x998\x87\xd2XS6\xa1R\xe9\x0b\xa8\xde4\xc80\xb6rPJR\xf2Y#\xc3\x9e\xa1U\xafQ\x98L\xb6\xef&\xecK\xf7v\xedJ~if\xc1\x9d}U\x1a\x01\xd6\x89X\x9a\xbalKR\x89\xb1\x91\xcf\xc2+z\x98\x938/\x8f\xfb\x8d\x93(\x89-\xc4\x7f\xbfLp[\x09DJx\x93\xf7p}\x10\xd2\xdd\x95\xcek3)\xd4\xa3\x0dEE\x016\xb3\xd2\xbb\x1a}-xl,\xdf)\x16\xe6\x1f\x0a\x17r\x8d\xd1\xe9M<\x1a\x1f\xee\x0d\x9dg:\xaf\xd1\xd9(O\xc4\xb3i\xcf\x8d[\x85B\xd1f\x86\x88\xfd\x1bNk\xaa^\x0d\xc3'p\xa2\xf1\x93\xc1M&\xff\xbe\xe2\xea?y\x01\x103\xbe29\"\xeae\x86\x0f\xc8D\xfd\x83E\xbd\xadma\xa0\xa7z(N\xf6\xb3\xf3\x8e\x82\x00\xd6\x03ah\xf9\xa2\xb5\xbc\xe1\xa1\xd7\xea\x1a~i\x1a\xd2\xa3\x08\xcc\x1cM\x00\x97\xd8\x8eqY:\x97\x13\xc0\xe5\x1aR\x85\xe9\x0e\x18\xe6T{\xe5]~;\\\x1d\x97\xa2%\x17C\x1d\x97\xc2\x1d\xef\x03\xffb\x02g\xa6w/\x85~G$\x07\xab\xa7<\xa2\xd7>\"\xb5\xe3\xa9lu\xd4\xc1\xfa\x0en\xf2\xce\xca\xf6Q\x16\xdc\x13\x0a\x0exgg7\xf9\xf5\xa4L\xbc\x83\xbe\xedF_\x1e\xb1&G(D\x19fx\xd1\xa7\x1b\x9a\xfb\xb7\x991\xa0\xd6&[\xa6\x91\xabX\x01\x8fm\xf8\x85A\x8al\x9a\x85+\x98\x16X\x11\x0d\x93\x13\xcb\x0cZ\xe15\xc1\xd4\xa5X\x8f\x9a\xe0\xf1\xb8\x15\xd7\xba!\xf5U\xb8\x8cGQ\xbd`\xeb\x94\xe3\x9a\xaf\xac@\xacP\xae\x94\xdf\x816\xa2\xda\x15\x03{\x81!\xf0[0a\xf1\x88\x0fl:?q\xc7B\x02\x04\x17\x87l\xa9/\xadE\xb1\xe5{\xaeS#d{\xc0/3\x1d\x9c\x1b\x02/\x10zw\xcb\xc1d\x8fM\xc4\xe3\xfa\x9f\xdd\xb6\x04)^\x9e\xa00\x9e\xd3&6\\\xee\x0f\x9b\xc1Wd\xb7`\x0a]\x0d\xa5n\xc6)\x0f\x0b\x8c\xe5D\x89p\xa8V$\xd5{\xba\x93t\x0fK\xf8\xa1\xe7Q@\x9a\xc4'?s\xd2v\xf2vBw\xa4W\"7.\xb2a\x1e\x92\x02\x1f1\xc3M\xc2\xda\xec\xd8H\x00+T\x81\xe5\x9d\xc24L\x96\x9c\x92O)\x86q\xfae\x87\xac+-!\xb5\xf0\xbb\x9a1\xc1D(\xf4\x11\x9b\xfe\xb9t\xdb\xc3\x91\xe9J]N\x0e\xa6\xc1\x0e\x99\xf4NT\xac\x0e\x89s\xc0\xf5\xc1.\xe4\xa3\xba'\x07\xca.r\xca\xed#\xec\x0bwr\xf1%\x97\xe1B\xf3\xde\x12\x1a\x1c.\xb9r$\x04\x0eO\xd1wJOV\xcf\xac@B\xd5\x89\xb5;\xd3\x14F\xd3.\xfd\x1f\xbd\xfayqs\xba8\xcbM]\x8bSA3ro\x90W\xfe\xde\xe7\x03\xd9uY\x0c#.\xce\xf3\xceO\xf4?\xe3\x92h_\xd3\xa508ab`\x15j\xe1\x1e\xb0\xbe\xf3^d\xb7\x9eJ\xeaq.\x0e8L9\xd3\x9c\x87\x08\x10\x93o \xc5\xb1\xa3\\\x90I\xe8\xfa.\xa6\xc6\x85\xbdg/\x97\x95\xcd0\xddxv\xaemn\x1b\xee\xe9\xad\xc7\x19\xe5\xb7\xc3\xd6\xe5\x83\x18\xc0\xf7H\xf2\x02\xd6\xd6\x172OS\x87\x15I\xce\x01j\"\x0e\xa1E\x9a\xfc\xd8\x09\x8b\xee\xfd\x0f\x90\x1b3\x86\xc7\x10G\xc7\x0e\xd7\xd3~\xa4\x11v\xc6 @\xb9\xc7\xf4\x86\x0a?\xbb\xf0?\xc3J\x1f\xb6\xf6S*f\xb8\x8fT\xf3\xd2~\xb7\xe4\xfc\xe3\x98\x8a\x1d\x8f\xc7\x13\xb7\xce6Pq=\x9e\x90f\xe1\x14\x04\xbc\x8e\x1dg\x14\xb6q1\xd6\x84\xc8\xd9S\xca\xfb\x13\x8b\x10\xa9\xf2\x0f\xe6BR'\xd2\x8c\x9aR%\xf2\x03\xf1\x87\x11=\xb3tnY\xf1\xc9\xd6\x8e\x96.-\xecC\x93c\x1f\xf0F\xbeC|\x12W\xa5\x93\xd8\xea\x1b\x12\x00P\xeb\x0e\x06\x96\xd6\x00=\x8d*b\xd1\xd1\x0c\x96\xc9\xf8\x9e\x91\x1b\xfe\x14\x1d+\xe5u\xc6_p\xc5d\xc5\xedJ\x02\xb7^\x1a\x11\x07C\xbc<\xaaW%\xb3\xc98\x7f\x1c\xbfH\xf2\xe9\x18\xdb\xd2\x894\xe2\xf8\x17Z\x0aZQA2\x9ci\xccw\xd7\xa7y\xc3\x9b\x9d\xc4\xba\x83\x9ac\xd7&O\xa4g\xe0V\xa5Ig\x1fd\xe9\xf6\x01%\x01\x13\xf17\xa2\xb9r\x80\xe4\xce\xde)\xd4~\x0cPg\xdb\xf0^R\xb2\xa6\x12\xc4I\xdc\x8a\x82d\xd3U\xf8\x00'\x1d\xe58\xcb\xa5iT2\x81\xea>e\x8d\xe4\xb3i~\x1e[\x1b\xb7\xf2\xfa\x18H\x17$\x0b\xce\xfa\xeeF\x0c\x0ad\x09\x95\xbb\xcd\x1b\xb7\xe1(|f\xb5z\xf9\x84\xce\x9d\xc3\x893\x11\x1af \x98\x94\x8d\x8f\xf0\x0d(@>\xae\xb4\xb2\xe2\x8a\xd9\x06\xb7\xe6\x14\xd5\xfe\x98\x84\x9f\x98\xe3\xbeK\x1e\x1dL\xdcV\xd9\x9e\x16C\xea\"\x97\x1e\x92\xfe$\xec \xca\xdf6\xb3" +
+	"<\xee\x14r\x18&D\xfa\xc4\x02)\xe0\x92\xcd\x98\x9c\x09\xc8C\x84\x8f\xac\x1fu\x85 h\x0c\x8f\xd0\x0e\x10jrL\xce\x03\xe9=\xb6\x8a]Z\x99\xb6C\x11\x10\x12\x86\xd5\x11]\x0e\x08\xfc\xee)\x0b\x09\xa7\x028\xc0LD\xe5\x1c\x90\xf2\x11}\x10T \xc4\x8e\xf2\xf0A\x033P\\<\xbbu\x17\x04k\x82;[\xb2\xfakV)m'\x9c\xd8\x9f\x85\xb7T\x95\x81\x102\xae8F\x93J\xfa1\xdc\xb3V[Q\"\xa2W\xee\x82S\xddF/\x8f{\xd8W\x13K\x8b\xbe\x90\x995\xf0X&bg\x96\x7f\xd2LN\xc4\x98\xbcp\x0d\xc6\xa1\xc6\xd4r\x09\xe4\xd9\xab\xc9\xd4%\x17\xc8:3\xc7\xa4\xe4\xcfM\x1c\xbc\xc4\xb38\x1f\xf4\x01]*i\xe7)\xa1\xa14B\x87@\xac\xc1\x0e\x09A\x99n!\xda\xa4f\x83}\x9c\x95\xceb\xf5\x0b\x08\xdcm\x82:M2i\x85(\xc7\xae\x8d\x89\x8f\xd7\\\xf5\x7f\xca*R\x9c/\xc1\xd8\xe2D\xadA\xab4N\xdcJ\xfe\xac\xdaO\xa7\x98\\m\x85\xe8\xa6\xe8\x064Cn2\xebm\xd4EA~L\xe7\x80Qb\xd6\x12\x09\xa5J\x90\x09pH\xeb\xc3.\xc7\x1d3So\xf1\xd90\xe7\xc7F/l\xd2\xdeG\x1d\xbd5rj\xf4ko\x7f\xc9\xde\xeb3\xdd&,a`\xe2\xc0E\xbe\x0c[\xfe\xce\x82\xffa\xbdQ\xbe!\xd6\xc5!\xba\xe8l\x93N\x9f\xcd.\x12/\x0aH\xaaY\xb4\xa2KV\xc3\xc4\xbfT\xecW\xed6\xaas\xa7MI\x838\xe1]\xbd'\xf0\xec\x0a\xe7pE+\xb2\x1e\x8c\x80\xf9\x19\xa8*\x86O\x017'\x14\x8e\xca\x9dm`\xca\x0f\x85\x17{a\xa3\x9e#\xd3\xfb\x1e\x99\xc2|\xaf^\x1e\xbf$C\x98\x08\x91\xcd1\xceU\xc7\xdb\xb2\xa0\x0e\x07\xc13\xabW\x06T\xa6JD\xcc\xfa\xe0}\x02L5+\x0c\x94\x1e\xc8&\xdc|\x85\xd1\xb4H\xf6\xbb\xac|9\x96\x10)\\\xa1\x18}\xf9B:\xe0\x86ZV\\ \x1e\x06X\x9eE\xa7\xf5\xe2\xa6\xbf~\x9e\x16\xec\xab\x9c\x18\x96\xc4Q\xdc\x18\x86*\xf5\x9f\xf16K'9p\x8f\xc9\xbbA9]\xa9S\x05]\xfa<\xe0\xb2\\\x9a\x15\xac\xd2\x1c\x0a:\xb0\xea\x83\x86H\x04\xc9\xa8FE\xb0/\xed\xc2\x15D\xe3\x917\xe6\xc1\xd2\xc7\xef_\xd4\x95\xac\xb5P\x97\x16\xb1'\xe3\xef\x17\x05\x15\x04;\xf3-\x18\x07\xf1d\xcbxi\xf1/\xb0$\xb1OC\xeb\xf1\x13[A\x87\xe5\xf3Q:\xd7En\xb95\xa5%2v\xa4\x90N(\xa5^\xfd\x8cK33\x994\xd96e`\xc6\x18\xd9\xed\xc2\xf1\x94\x1d\xf8\xd2\xae\xd4Z \x9c\xa6\x80\xd1\x91V\xad\x98\xbf\x86\xc4.\xfb\xcf{\xed-\xf2\x1ak\xd0\x13O\x8f\x8e\x91\x8d&\xda\xe8 \x06\xbc\"\xc9|\xe1\x02%\xc4\x98x\x83\xfb\xd4\xe1\x8fi\xceF\xcaJR\x10\x0e\xbb\xd2\x88\xd8]\xf6\x0e\x96b\x9f\xa07k\x8d\x85\x0d\xc7L\xfc\x89Q\x1cvz\x03\xedh\xea\xe0\xca.\xe5\x86\xb7\x93Bo/\xa5M\\Wu\x8f`q!\xd9x\xe6\x92\x8d\x09o\xbf\x1fM3\xe2Q\xff\x03\xe5$\xab\xb2~\xe0\xcb\x0ce\x14\xa1Y\x9aD\x83\xc2k\xe1\xf8\x8b\xec\xf6\xb7B#\x9e\xccV\xac\x0b?\xbb\xecl,\xf38\xe3\x9f!\x10\xf98\x92E\x84\xab\xa1\x99\x916\xde\xc2\xd5|\x09H=Nq\xcc\xcb\xf9\xc7\x0cMa\xc4\xe5\x00\xedw\x1c\xce\x1cR\xae\x1d\x87!\xc7P\x95\x19\x91\xbe8\xaf\xfa\xa0\x0a\xbe\xf7\x98\xdfA\xfa\xbc\xfd\x08/\x97No\xb8\x8f\xf0-<s\xb2\x05!z\xedH\"\xb1?\x1b\xf9\x18\x1b$\x81\xc7\xb2psb&\xb1\xedp\xe7\x90v\x1c\x17\x89\xd3\xf7c,MH\xeba\x95\xe7\x84!\xc2f\x10a\x07\xa5\x87\x1c\xcd\x1f\xae\xa1\xd6\xf4\x00\xb92\xa6\xd2\xaf'\x9d\x0e\xc4\xa1\xcc\x92I\"\xa6\xfd\xab\x12\xdaU.\xb01\x9e\xb4\x91\xd3\x99\x8f\xa1oA\x01\xc8\x8c\xa9\x17\xf9]\x12\x84\xeeH?\x0f\xea\xf9_\x7f&?\x0aNV;\xaa\x07AT\xd6\xad*Q\xf7\xe5HcC!\x92\xfb\xd4\x87y\xf7e\xf0\xbb\xae\x9e\x85p\xd4B\xef\xd8\x9e\xeb\xc0\xab\xb8dl\xfd\xf6S\x83b\xd2\x91\xa0\xf3\x1f\xbe\xe1\xb3p7t\xd8\x87=+\xa44\x98\xe5i\xc8[\xe4\x90\x8c\x9a\x03\xa1\x14\xd6\x9e\xa0\x97]h\x89&btQ\xb9 u\x1d\xba\x82\x81w\xaf1c(\x10\xc5\xfch\xd9\xd4\x99K\xcfl\xfb8\x14\xb9\xe8.+\xbc\xd4a\x1f\xbe\xe9\x08\"a\xfa>\xaeJ\x8fuf\x01\x18\x16\xe6\x0a\xf7\xcc\x93\x03\xc6\x8c\x9agb\xad\xa8\x82]\\\x8d\xa7Q[|\x84\xf0B\x86B3\x1d\x0b\x14\xc6\x9f0l\xed#6\x19@\x818g\x7f\x94\xf6A\xa7r\x99v?U\x07_!\xf2\xbd\xa21\xefQz\xac\x80\xe0\xf8?RV.=\xc8\xa5\x1b!\x8a\xbe\xb0\xfb\xc8\x96Q\xe0\x13l\xb4\x82\x0e\xb1\xf2t\xbd*\xa7\xbc\x1c\xaaB0\xd4+A\xec\x02\xdcl~J\xbe\xb4\xee\xe9\xdeY\x86\x8d.\xe3\xa5\x93\xd1\xa6-\xac\x94\xf0\xcfy?\xf9>M@\x1a\x97\xb4\x13\xac\xba\xb9\xc5m\xd8\x8fz4\xd6sd\xf7<s\xee\x89kXR\x98[\x88}M\xa3\xdd`Lr\xfe\xc5\x89\x09xR\xbaW(\x8c\xef\x91,o\x9fv\x05#<\xaet\xb3\xa5SO}\xdb\x0b\x9d\x82\x0bd\x14\xca\x09m\xb9\xc9\xc2\xf1\x91\xedHv\x14n9-\x85\xb4\xc0\x19]\xf3@\x0d'+\xde\x1fe\x9b;9\xb8:?\x1f\x18\x14\x82rI\xc4F\x9e\xf8\x7fC#\xe1\x85\xe3[\x0e\xff\xc0\xc2RE\x83y0\xb8\xcbE\x09f\x86\xfb\\\xce/\xfdh<RTB\xf3\x90S\x9d\x9c\xb3\xe2a\xa0J-\xc50#\xa9\xd4\xfc\xcc\xc3h\x1e;,T\xe9jF\x12\xaa,\xad\xb2$-\xa7\xf0\x98\"\xad\xf0\x8d\xe5\xb7\x16\xd9\x9d\x13\xfa\xe3\xbdL\x93p\xe3N\xad\"\xf0\x95T\x08\x05\xcc\xc2\xaa\xf1\xf2j\x98\xe7\x8c%\xdf?\x9fM\x95\x8b\xd2JW\xe7\xc3\xf0\x8emOH\xa1\xf2\x0c\xc7\x1a7\xcdp\xe4\x0b\xe1\x96\x12\x82\x84%\xe8Q\x8d\xb3\xa8\xcc\x80p1\xf5'6#n\xd0z3\xce\xf9\xa5 \xaa\xe4T^\x9cek\xc4Ph\xa6\xa2\x09\xf2\xcd\xcc\x0adO+S\xad\xe3\x9f\x0dC\x84\xeck\xbc\xe3\xf6\xc2\xd6I7\x02\x88\xaaC\x17'\xdb\xf2>.J\x97\xb9\xc8\x00\x1b\xaa8\xb9\x08\x87\xf4\x10=}!iC\x03i#\x8b\xf1\xc1\x84\xd0\x03\x87\x9ex\xc9z$e\x0aN\xce\xca(\x93@y\\\xd4\x13zbr\x9a\xb1mx\x83\x14v3\x962\xa5\xb0\x84{\x85\xc1\xf2j\xba\x08\xdf\xc0\xca6r\xf2\xd6!\xc9\xdfr<pq\x9d\x0f^\x7fv\xc3p\x86y\x9a'!t\xe8\xe7\xf3(\xf7`\xfb\xb4*+`c\xd1\xae\x96\x80\x17w\x1a\xd4\xeaAG\x96\xb6\xfc\x02\xd8\x0fs\xeal\xe7\xebS\x92\xafE,\xdf\x9d\xc3\xf2\x01\xa9Q3,\x99pyn\xd3(\xf0\x8bz,\x8d\x82G1-.8\x9f_\xff\x07I\x15p;@0\xf6\x0dR\x9e[\x87\x1f\xf8\xc0\xab\x0e\xca\x170\x95w\xaa\xfc\x9b\xe6\x8fU\xe1\xa7\x9e%&\x82\xe3D\xc9\xc2&\xdd/\xac\x8d\xf0\xd0\xfb[\xdd\x15q\x06\xad\x0cO-oz_\x07[\xef\x9e1\x9dKUh\xe1\x93\x1a\xa1\x9c_\xa1\x0c2\x96\xf7f\x8a9\x04\x7fx\x10\xb6\xf5\x03\xd5hE\x02\xe3\xa9\xf0\x8b[+|\xc50N\xb07s\xd4\xef\x89K\xc6\xf1\xa6\xd3\xc5\xaf\xc3I\x17\xac\xfb?\xa0\xa2>\xf8\xec\x98\x84]\x09b\xa34\xc4&\xf9Ba\x95h\x10\xcc\xc2\x7f\x047E\x8a\xc4u\xff\x09\xce\\^\"m\xb8\x1b43\x86\xe8\x93\xcc\xf7\xbe\xff\x0c\xe7]\xd0qBK\xaeX=\xf0\xfb\x84\xb9H\x86\xa1[T\xbc>\x84'\xdb\xe1\x83\x04\xeb_\x87S*\xb4\x87B\xe9O\x099\xeb4p\xfd?\xbd\xca;\xa4\x92~ \xa3\xad\xe9\x06\xf8\xb1\xe1\xabahU\xcd2\x98\x1f\x1cV\xbc\x11\xe9/\xb4\x0e\x86\x8f\xfe\x13\xf40\xfa\xaea\xbc\x99\x19v\xc31\xc5\x8a;~\xb7\xa5q\xbcu\xef\x91\xe4\x15\xc5\xe0X\x0f1\xf1\xe4~\x1d\x03O!X\xf9;I\xb3\xd2\xff\x7fw\xcc2\xc3\x12\x8akO\xef\x8ad\x11j\xf9\x885b0\xf0G\x8aX\xb1\xa9\x08\x19\xfd\x0e\xb8\xa3\xd2#\x9c\xe7\xe6\xaa\x95\x9a\xf0\xdax\x0d\x88h\x16\xb9\"\xcd\xbd5\xe1_\xd8,\xfe\xa3x\xa8WN\x9by&k\x94\xf8c\xaf\x94\xbd\x16\xe1\"w\xea\xa5\xbf\x0c\xf4\x18\x93\x13_e$\xb0+LV\x8f\xef\x007)-!N\x1c\xeb\xab\x92\xb6}\xe9@\xac\x95\x1b\xe0\xebp.af7((\xf9\x09\x09\x12kh\x0e\xe7\x0e\x1a\x8d%Y\xe9\xa0\x9f/\x81\x92(\xe4\x0a\xbd\xa2\xa4j\xd8y/\x88w\x9b\x9c\xdc\x16\xc2\x84gW#\xea\xe3\xc6O4\x8a_\x07S\x12\x1d\xfa\xb3<\x98\xe4\xbdD\xc6\xa2B\x1c\xbe2GXq\xc3\xb0\xa3\xe9\xf5}t\xa2\xdf\xe4\xdbr%\x01\xf3Di\x19\xea\x93&\x96>\xc5/\xf3\x0c\x1f\x14\xdf[\x86M\x1e\xd9QdX8\x0aJ\x9fN!\xe8\x94\xa30\xc0\x18\xf2\x13\xd8\"\x007\xa17\xd7\xa7^6\x9c\x01\x91\xc20\xf0\xfd\x9aU\xa0\xba\xc8\xe1\x8eW\xc5\x82<\x12\x8b\xf7\x8fK\xf2\x90\xac\xaa\x1c\xe2hz\x8fe)\xe3\xaf\xe3\x1a\x86\"F\xf59\x02\x90\x0a\x88\xaa_bk\xa7\x19&\x18\xc3?\x94\x83`\xfa\xae\xda\xea\xa4\xf5\xfd\x08\xc1\xbe\x96\xec\x00>\xf8:\x9c\xa4q\x1c\x85B\x83\x84I\x8e-\xaa\x05Q\xd32\xf1\x1c\xa1\x0b\x91\xfe\x9bZ12A\xe3:\x7f\x09\xd8\xfb\"\xa5i\xa1!\xcc\x07|A.2\x1a\xd5W\xc0E\xd6N\xfc\x80\x08?\xe4\x98\x1d\x85\xdb*\x89\x05\xa4\xd7\x844\xaf\x93!V\xf6H2(/)\xe2\xeb\x10Z\xf5\xbf|\x081\xbe\x8e\xe5\x9ff\x1d\xbdbY\x0c\xc3\xa9&TRzg\xb5\xda\x96=\xe7\x14f\x15\xa6\x9c\xc9%\xb1_31*\x996A\xd0\x94\xbd\xb2,q\xa4\x04\x16\xdb\xb0\xe6\xe9\xc6 \xcf\x878\x13p\xdb\x18\xe6\xae\x0b\xf6\x85\xd1@\xe9\x9f\xb1$\x10\xbf\x1f\xe2\xf4K\xff\xa0\x9a\x07`7\x8an\xc5\xb9m\x05_\xb3\xf8\xd9!\xdf\xe4d\xab\xc0\x0c\xc0\xb1?\xa0\xf9\x92\x9d\x0b\x8elI\xcf?\xce\xcaCj\x127?\xd0\xda\xdd\x03\x16a\xc5%\xa6\x17e\xc8\\\x17\x07\xe4\xe2,\xbf\xfe\x1dScoE\xcbh\xc0\xeb\xbd8\xf7\xbf\x97tvRS|\xc0\xc5\xb8N\xa7\xea\xff\xf5E\xe9\xd7\xd4\x8d\xc3,\x15\xfdm<\x97\x9d_[Z\x05\xc599\xa7od\x1b4)\x12\xe2\xb1{\x87R\x98J\x09\x97\x9c\x96\x8b\xeb|(\x9d\xd2\x14\x18\x98q\x9f\xf5\xe2\xa6\xf3P<\xa0i\xfb\xfcJ\xfc\x85\xa5{\xb8-\xfcz\xafM\xe0\xc7\xc2\x95/\xbft~\xcb-\xac\x84\xa4T!\x91\x92\xad\xb1H.A.J}\x02z}\xbf\x0ec\xb1>\x0b\xe5\xa3\x04\xe8/\x91E-\xc67\x97\xe7Y\x8e\xa4\x06\x8d\x99\x19\xfaI\xebb\xce\xc1\xe1\xaa>H\xba\x8d\xd7u)\x1a\xbb\xb4\xc9<\x80\x07\xa6\x10\xaa\xe2V\x8b-\x8a\x9ep|\xfbu\x184E\xfd\xd9\x0ajcO\x0f\xf9\x98\xc6\xde\x89\xc9\xa9\x87\x17\xed\x1b\xff\x17\xfe\xf8>\x08{R\xcb\xa6!\xc6S\xa6\xe1\xb6H\xe5\xe9\xd2\x99\\\x1chI!\xc8Y\xa0\xde\x8d\x1d\xf3N\x8dA\x96\x9a\xb0C\xc3\":\x1b\xacp\\\xca\xe4^M\xfd\xb4\xc6=\xfc\x00%\xe0R\x92\xf9\xca\xf5#\"W\xba(\x9d3\xe9!!\xd3\x86\xc9\xb9//\\\xf2n\x1e\xeb!\x0c\x14\x11\xaf\xee\x81@\x87\xa2\x7f\x96\xfeOS>5\xadN\xcc\x89\xfe\x070)\x9f\xecf\x00{\x8b\xde\xb7\xf8\x82yr{\xaf\x05\x92\xfdH\xc6O\xe9\xb1\x14\x08\xc0\xfe\x11\xc8\xa0s\xb0\xd90\xf9@\xad\xa7\x9f\x8f\x81\xb0JA+\x1b\xfd\xad\x108I\xca\xbc\x06\xdd\xd2\xfba\x9c\x16f\x90\xb5\xb5\xf9\x03\xfd\xbfZbF\xb3 \x07\x81[]h\xa6\x05\x08Qd\x92^\xba\xec\xc4\x84[\x80\x8e\xa2Ap\xd7#\x18w4\xb4\x90\x90\xa5\xbc9)\x1cw\xb4\x08A\x00 \xfc\xb0\xa5~b\xd2~b\xf4\xf5k\xef%\x0d(,\xae\x00@\xd9\x1a\xcfA\x9f\xc0\xeb\xb5\xf5\xf0\xc3\xd1\xb3\x87c8\xf4^\xe8\xf6\x13\xacR33\xb3hm.MkPl\\\xdd\x9b=\xbe\xd2\x8bw\xe9\xd0'\x1cV\xca|\xad\xb9J\xfdAb\xb8P\xd2\"\xac\xfa\x18\"M\x95\x8ca;l\x1c\xaa\x80\xb1\xe5\x82\x90\x83\x97\xd2\x7fR\xd7\xcc\x97\x1eBI\x15\xa4\x9d\xbd5\x0a\x92D\xd0.\xa4KVB\x82\x96\x18\xd3\xac\xf4_\xd0\xee\x9e\x0f\x10\x18R\xab\x09\x9d\xe4\x99\xdb\xa7+\x0c\xd3@M\xa3\xf0^M\x9a\x95\x15\xcb\xb2Z\x8c\xf8\xaf\x193\x05\x14\x0c\x07\xb3\xafj\x03\xa4!\xc7\xf5\xe2\xcc\x1cm\xc6SaUM\xaflN\x1d:\x0b\xe9\x16\xb8jEl\x04\x85\x05?\xbd\xee\xdc\x0f6\xa4\xcbk\xd2.\xfeJ\xbd\xd3\x13o\xee\xa6\xb3.\x98\x15\xed\xf7n\xf6v@\xae\xd6\xc1\x84\xa0Q\x06\x11\x08{\xf8\x1f\x85mB~\x93\xe2\xff\xe1\xbd@\xf3\xba\x10\xf7\xbfp\x9c\x8e\x1c\xbf\xd6\x16\xad\xc1)\xd8\x84\x97\x091\xe1\xc1\x8a\x98\x87c\xffy\xa7\x7f\xf6\xc6\xce\xce\xfe\x87o\xec\xec\xbc\xfb\xc6\xa4\xb1g;\xb8u\x9c]\xb8\xee\xce\x8d\xd2\xfd\x8aj0s\xfe\xa6e\x87Ev\x96\xb2\\I\x8f e\xad+\xcf\xd1\x84=*\xfd\xd7\xb5\x91?\xb1K\xd9HJ\x9bc1\x17\xaaK\x97\xba\x16\x1a\xac\xffl\x10\xac\xde\xbf\x93h\x9e\x16 \xbd\x86\x02\xf7\x03\x1fD\\\xdd\x85k\x93+\x9d\xee:\x9b\xc8\xdaU)\x93\x0c\x9b\x98\xb0\x1b\xff\xbb\xa0\xdd\x80\xdc\x0b\x1c\x8c\xad\x088\x84\xb7\xd4\xaa\xd9\x7f\xa1\x1bG\x15\xb9\x0d\xdf\x8cp\x97\x1aX-\x12\xa8\x1a\x0f\xb5\xe5@D\x06\xdd\xe3\xc9\xa1\xdd\x8f\xa4?Y\x02#l\xb4\xd4*\xba`i\xe9\xbfc\xb7\xdf\xb1\xe0!\x9d\x7f\xf9\xb3\xc5q>=\xd5B0\x05\x8c\xcc\xc6\xef\x13\xaeJ\xb2>\xe7*\xb94\x0a\xdbI#\xf0\xab\xff\xaa\xff\xcb%y~\xde\xe3\\\x10(\"\x11H\x8e\xad\xcb\xf3\x8bS\x87\xe4\x81\x90\x08R=H\x91 _\x85d\x8d\x0c\xc0\x16\xfc\xe9\xe1\xeeX\x11\x83\xa0\xb4\xbca\xab\xa5\x84\xf5\x92\xb2\xb5\x0c}-\x8cA\x0fm\xe4\x1a\x9f\xd82Q\xed\xa4\x04\xcd\xf5X%\xdb.\x0e\xcfu_\x1dv\x93,\x1eb\x1f\x8f\x98\x19o\xd3\xebJ\xe7~\x17\x0a'q\x04h\x86+\x89\xc6\x89\x93\xb5\x87w\x0aeMV\x94\xf7\xc5\x8a.\x9dl8\xb68\xf7x\xcb\xcf\xdd\xc9\xc5\x97\xce\x9d\xfc\xbd\x09|1-\x84=4\xbfL\xef\xef\xd0\x8b\xba\xc8\xa8[\xf9\xe5R\xc1\x97\xe6\x15g\x00\\\xff.)\x04\x84gQ;\x9d\x00\x97\xce\xae\xe0C:\xec\x16\x11*b\x12\xfe\xd1$6\x84M\x1dNO*;8\x19A\xf4G2\xef(\xd6\xb5\x1da|\xea\xe25\x99\x96\xe9\x9et\xa8\xb4\xd3t0\xc9\x98v\xa8\xb8\x0a/\x9ch\xbeg\x1a\xb4V\xceL\xb1O\xe1\x96\xae<\xd3\xb6\x0b\xefkj\xa9R\xf1 \xf9\x0e@\x1b+\xce\xfc\xf5;U\x17\xbd\xfd\x0e\xd2\xfa?9y\xd77\xe9G%\xf9\x82\xed\xa6\x91\xd3\x98\xf6\x13BdX\xf97\xb3H\xd2\xc2\x9a\x00u\xf1~0W\xa9`\xe9\x9e\x86U\xacp\x97\xc2\x92\xcb/yk!\x0c\x91P!X\xbf\xe8;\x8b7>p\x14\xbf-\xbc\xf4\xcbi\x9f]T6\xbf\xe4\x80\xc0\xf2\x10\xa5\xa2S\x16\xac\xcbr\x9ag%E\xc4&\xaeN\x98\xd8\x97\xe7\xf9I\xde\x08\xf5\x98\xfeF\xcd\x09\xb2h\xe1\xbd\xff\x0c\x17\x9f\xeb\x8d\x89\xab\xfe\xd3\\\xbaV9X\xf8G#J\x09\xbb\x12\xb7\xeb/:\x86\xa1\x90.\xbbt-\x83\x94\xbe\x1aL\x98\xe9Q\x7f\x9f\x11_\xeb\\l\xa7\x07)\xecM\xf1A\xca@\x83 6\x01\xa2q\xeb\xe0fX\xa5\xcc\xb1\x0cj\x1e\x10A\xe3\x87\xb0-\xfc\xfe\xaa\x17\x1c\x18\x1b\xd5\xdb\xc6e\x0e\x09\xa1qU/\xc7\x1a\x04\x93\x97\xdaP\x9a\xf0S\xaa\xae\xa8}=\xacv\xa55\xb8w\xe0k4\xc5\xea\xc1\x13\xf8\"G\x9a\xb7\xb5\xca\x95\xa4\x96\xa5\xa52\xe5\x1d\x92\x8f\xd5(\xbf\xec\x81\xdd\x97\xb1\x8b\xe4l\xecZ\x0d\xfe\xc9O\\QRq\xd3\xdf\xcae\x03\xd2V:\xe2\xa4M\xc8R\xbc\x1b6\xb75*\xd8\xf7\x9f\xf2,\xbb'`\x95\xe7\xd6c\xd0 \x9b\xf1{\xa5P}\xac=\xb1P\x9d`\x84\x0e\xba\xc6\x9b1\x9e\xb3\xbe\x99O\xdb\xf9\x1bk^\x12\xf9Eb\xb4N\x9b\xc7H" +
+	"\x12\xc7\x9e\xf2^O\x87\x0eh\xb8\xa7\xbf\x96\xa53]\xfd\x95\x07\xbc6\x1c\xfa\xdc\xdc\x02\xb0\xf19S\x93\x9b\xcf?\xd2\x1f\xa3\xd2#\x7ft?\x96\xce8\x0d\x826\x01\xb3T\"f\xf9J\x86\xc4\xe4^2}\xb4\xd4\xc7\xfe(@\x1d\x14\x93\xde\x8c5\xf8\xfd\xc1\xa3\x9cY\xba\xbeGy\xa3|C`\xb6\xb9\xeb\xac\x11~\xa4\xfb\xd2\xd9\xfe\xca|>\xf3u\x04.\xa4\xa7\xebK\x975\x94q\xff\x15\xbas\x19\x7f\x9a\xdc\x88\xe8(\xac\xde\xb3\xcb\xbfr\x97W\xb6Ctb\x05s\xc4\xc4\x8ct\xbd5\xe9\x15\xc79{n\xc4\xf1\xbd0\x96\xe4^=E\\\xd3\x0b\x14\x86\x1e#\x0c\xfb\xfd\xa7\xba\xed\xd07\xb2@y\x01\xdcdm\xf2B\xba\xbc)\xf1\x04\xbfI\x80\x9e\xe5H2\xe4\xf7\xef\xd8\xa4D\x0e\x00\xec\xd9\x87\x0f\x09U\xd6R\xf7\xc7\xe6\x9b\xd29\xce\xfe\xca\x9dx\xe9\xb4\x14\x96 ]m\x89\\c\xa9l<G\x824!\x9en\x891\xae$\xde\xd6\xd8\xcd\xe2z\xf7{_\x00L:\xfd1k\xf5\xe1'\x06!h\xda\xa6F\x9a\xe2\xfe`8.\xcdp8J3\xfa\xf9+<\xc2/\xbb\x85\x90\xd4\x05\xa6\xa0gr\xff\x18\x15\x96\xae\xf3W\xf6\x18\x93'\x991\xc6\x84*\xbdR\x03VA\x1f\xc8\xa3\xe1\xe8\xef)\xa2!,\xe3\xaat\xfe\xbf\xb2<m\x16\xc4\xd1)0\xe7\xc8Z \x8e\xcf\x00\xe6\xa3\xc4\xf3A|\xaf\x92\xe9\xbe\xf8+\x8b\xf1\xc2\xaa_\x1e\x93W\xeac`a@\xf3\xc2\xe5\xe1?\xcc^P*\x8b\xf8wZ\x99\xdc\x10vn\xc6\xd8\xc0\xfe\xe4\xbe\xcfsn\x07\xa1\x83\x10f\x87\x16\xcf \xcc\x0e\xd9s\x8d\xd0]c\xaf7\x0d\x00\xee\xf9\x18\x13\xc3\x1f\x13\x88\x88 \x19\xfb\xef(\x8f\xd7\x1f<\xa6\xcf3\x92B\xc0\x06\x81~\xf34\xc2R]\xbd\x87U\xb7\x19\xeb+\xfb\x93\x9b\xb9\xb1\x0d=\xc9\x98a\xda\x00\x92\xecc\xd4Z\x7fp]\xc7\xa1\xd5\xaaD.&\x7f\x97\x13.\x85\x96~\xfeW\xfc\xae\xcb\xb3\x9e\x9bP\xba0\x13\xa0 ~\xaet\x9a\xbf\xe2p]^\x0c\x11R \x17\x1a\xe6\xa0\xa1\xf1\xd7`\x90\xee`\xfb\xda\"?\x0f\x92\x08~\x95\xfd\x0eY\x97&\xc5`\xf6\xf2\xaf\xcc\xe9\xcb\xabl$\xa9\xeb\xea\x8d\xb5\xc8\x81\xcd\x08L\x11\xc3\xd1\xdf\xa1\x17w\x15\x97b\x1c\x0d\x9aa\xc4N^\xfe\x95\xb9\x7fi+\xc7kR\xdfE\x8aEj/\xdd\x9d\xe2o_\xfe\x95\x05a`]\xabXM<d\xf5\x0e2\x1a\x9b\xac\xbb@>\x1d\x06z\xfd\xc9M\x9d\xe5\x9d\x9c\xa8<O\xcbu\xc8<]\xfd\x95Eqe\x17\x05\xee\xebu\xf9\x92\x7fe*_]\x0d[\xa6\xb8\x0c?\xc3\x06\xc4y\x0a'\xf8+\x93\xf3\xea\xa6\xe76<\xb3\x0a\xe1\x00\x9a\xb4\xf9\x95\xce\xf2W\xe6\xe6\xf5\x97\xce\xeaf\xd9\xea\xb4\xb6\xcb\xb3\xf0\xfa\xaf\xcc\xc2\xeb3\x03<k\xb042G\xf5/\xb8\x01\x0d\x8a\x1ec[\xf0P\xae\xff\xcat\xbc\xd6\xe9\xf8\x17\xac\xd8\xf5_\x99\xaf\xd7WC\xfb\x06\xe4$\xc1\x03|L\x95u\xe0\xbf\x87\x0a\xc2>\xb6\x83o\xee\xafL\xe2k/F\xb9!]3\xccFK\x98\xdd\x9e\x12f\x17R\xe4W\x0eQJ\xd4m\xe1\xfb@\x92\x9c\xb0\x1f\x0d\x1c\xb8\x9bd\xbf\xfb\xfb R\x01\x0d\xd3\x15\x99\xc4h\x03\xe3\xf6}8\x87\x0e(\xc1\x9f\xc9\x01\xd9\xc3?\x8f\xbd?\xb8\xc8\x1e\x0e6\xab\x9d\xc3\xbf\xa5O6#\xdc{\xa9\xda\xbe\xcb\xb8\xe5\xd2g\x9ba\x0c\xa7\xc6a+A\x94\x02\x0c\xe4G\xef/\xaf]\xc9F\xba\x88@\xc4>\xdd\xe4$\xfc\x80y\xf6\xa3\xf7Q\x1d!\xbbS\xadD\xd7\x1dq,\x84\xb0\xef\xffz,\x87\x9e\xfd\x0c2'u\xf1\xac\xfc\xf5)I\xf3C\xfa\x1d\xf2QL\xf2\x0f\x86\xa5@TT[4Z\xf6e>\xbb3\xcf\xdb\xc8\xf5+\xca\xb1\x1fGE\xe7\xe1\x0d|w`\xe1\x06\xb9\xa0F\x84D\xb9\x91\xca\xfc\x14:3K?\xf5,\x04\x86v-\x15\x16\xd2\xd3\xf5\x8f\xeb\x80\xc5c6t\xd4\xa4\x07S<*\xbe\xd1C\x01\x86:g\xdc\x9e\xfb\x98\xfe\x18\x15\xdc\x003\xb9 \x14+v\xfa\xa0\x02\x0f\xb8\xe5H[&y\xfb\x12c\x9c\xc9jS:\xe1M\xb9\x12\x8f\x12\xb0\xd2\x97\xd0\xfd<\x1bg\xb8k\xac\xe3 Ll;\xdc\xc4K?\xf7<\xc4\x0f@\x0a\xc5\xc8\x84S\xb8\xdc\xce\x1cFv\x98'\xef\xdcy\xe25v\xb5\xfb\xcc\xb7\xd0\xe1,GE1\xd4y\xdev\x88\xb4\xdbaV\xc0\xcb|\xf8\x0f\x19\x0f\x92p\xce\xf5\x91\xcd\xf5w\xee\x1aB\x03<\xeb\x9c\x9a\x0d\x8dx\x86\xfes$[\x9d\x1b Q\x86\xed\xb3NmY&\xe3\xc6\xdb\x08 V\x80\xb9\x9e\x16\xde\x98~d\x03\x92\xcb\xd8\x90T<\xfe\xdc\x9a\xbf_h\x14\xa0\xcbdT\xd4Q%\xa5\x0f\xb0\xa0\xb6cp83\xbb\x17\xe9M$H\xd3\x88\xc2\x03F\xcf\xf3\xb4\xf4\xa9\xa7\x84?Cj\xc6\xfe\x9d\xd6\xb6\x8fRH\xae\x1c\xb5\xfd\xbf\xb8\xec \xe3\xa8\xbdvI4t\xf09\xb4\xc5\x8f\xe5U\x91\x06\\~\xb7F.\xbb\x92\x1c\xd8\xb5\x1a\xd7\xb4Y\x10\x9b7\xab\xc7\x0fgG\xab\xd3g\xd2\xf9\xedom\x1b\xae\xd73\xff\xe1\xd0\xa6q1-.\x06\x95D\xeeY\x0c\xfc\xe5H\xfa\x12\x16\x03r\x1aN\x08\xdc?.N\xc0\xd3\x1b\x9a\x85\x1e\x8a\x8c\x88&\x15I\x05\x80\x0dt\xe5\xf3\xdc[\xc0C\x0f\xd3a\x9d\xa4:\xb5\x1b\xd0\x15q\xe9Gg\x18\x89\xc3\xcaj|A{\xc6\x0f.\xa8\xff*\xdd\xddu\xe1\x94\x96\x17p\x9d\x92\xe4\xbc7S3\x0a\x01\x88dRbx\xf2\x8bm\xe2\xda\x08\x08Pg\xd4\x8fQ\xae\xab\xb06L!K\xdf\xa59*\x160\xa7\xce\xcal\xferL24/MF\x13R\xd2\x88bs$\xc7i*\x91r}\xcb\x8a4\xc72\xf7\xbc\xefi\x16\xdd:\xa7\x94\x93r\x11\x06o't\xce\xcdX\xc2\xb3\x0a\xff\xb2\xf0\x11\xe3b\x11\xfb\x02\xff~)\x92{\xd9d'.\xa8\xf0MN\xa2\xb7ED\x00c\x1d\xa4\xaf\xb0\x02\xb6:\xed\x14C\x8f\xb3\xaf\xb4\xfa./KgyU\x18\xc2\xaa\xf4\xe3\x9en\xd2\x17\x0e\xdaO\x90\xc63\xe9GBO*@\x8f\xe9\x0e\xd47\x00\x08\xd88\xa7\xbe\xa7\x06\xb2\xa40\xcdH\xda\x11Z\x12\x84\xf4\xf8\x91)+\xb1=\xab\x16\x84\xe78\xa3\xa5\xc9A\xe2o\x10i\xc8\x8d\x0c-O@\xd5wgZ\x0d\xba\xc46m\xd9\xf0\"Jt\x9c\x9a\x97\xd4~+\xc8\xa9\x91\x16\xb58\x7fL\x9e\xb6\x84\xcd\xf1\x1b\x8fk+Z\xe6\x1bdx.\xd3\xdf]u\x01\xf3x\xbcr/\xf96tbP\xf1ooH\x08\xe4\xea|\xf8\xed%,D\xd1b]]\xf4\xbf\xbf\x17F*\xf7\xbd\xbf\x97\xb0\xe1\xaf\x87\x13\xa0Ul&\xecV\x80\xfa\xb6\xba\x0e\x93\xf0\x00EgJ(\xfe\x8a\x15]\x1a\xba!J\xee\xfc\xd5U\x0eP\xad\x98U\xb3\xf4\x8bk\x07\xf1\xe5)%\x1a\x81\xa5\x9f\xdd\x0c\xb1e\xd5\x9a\x14G\xa6\x0f&\x03\x1fI\x7f\xda1&\xd2(&\x99r\xd4\xba/D^bG=\xd6\xf8\x83\xd8\x8ceG\xdd\xa8\xfb1_\x87{\xcao\xf8\xf9\xf5:0\xbf\xae\xa7CC\x91\x91\xde\x03en\xf1Lg\xa5\x81H\x0b\x00\xfe\x00\xf3O\xe9\xa9\xde\x11x\x0d\xdfJg?\xcf\xed\xa1yt\xb3\x8c\xd0\xcd\x86\x04>4\x91\x12a\xcd\x1c`\xe3#\xf9\xd6\x99\xd2Q\xfa\xfc\xe1\xb0kr\xed\xc8\xb3\xf0\xd1\xe6\xe1\xc043\x94\xf8\xeb\xa1\x9f,\xe5X\x1bQo\xa4\xf3\xc0K\xb7\xa6h2t;\xd7\xde\x8f\x9b\xd8\xa6\x87\xb2\xfbw\xed\xa35\xa3\xde1\x10\x0f_\xe7\xa9\x04\x88\xa7V\x8e/\xa8\x0e\xefqU\x9c57Yw\xa2I\xed\xf0\x1e\x1b\x8ex\x9e\xc2\x0b\xbc\xf13x\xdb\xcbw\xc0\xf3\x11K<\xc5\xe7\xb99s\xc4\x07\xf7\xe4:-\xadZ\xa7\x15\xd0\x1cr\x84n\xac\x17#\xab\x91\xb2\xa7\xc0n%z\xc2\xb4_\xf6\xbd\x96\x9b\x8b>6\x05\xe0G\x05DH\xd8\x0d\xca\x82\xdd\\\xba\xbe\xb9\x07\x06\x19\xc1\xd8\xde\x19g\x88\xf7B\xe4\x81=E\x84\xe2\xe6\xaa\xf7\xf9`\x96\xf1}~0:\x1e\xdc\x9c\xd2\x0b\xd4\x09\xbb\x83$2mM\xdd\xd3\x0d\xd3p\xdfx%\xcdZ\x7fo\xd0\xb16\xb5Y8\x8d\xadg\xed\xb1\xd7\xbdQt/\x95\xbf\xc3n2\x90\xe7\xbc\xfd\x92\xa9\x17\xf4\xdd\xca\xc9\xe1\xef\xad-q\x89\xf4)\xc6a\x9f;\xd1\xd9\x09\xb7\xb5\xf1\x8a-6\x04(\x9c\xf5\xdc\xf5M\xbe\xc8\x02.\xbb\xd6\xb7\x17n\x9d\xbd\xa5s'\x1f\xa9\xac\xd3q{\x99Q;r{\xed*6(\x16LwX\x9a\x1d\xb7W\xbfa\xe7\x0b\x15\xa1k\xd74\x81F\xe6i\xa0\x1e\x84\x97;\x85A\xce\x01\xdfU\xa7\x80\xbb\xbd\xac \xea\xff\xa4\x8e\xb4b\xce\xc6\x91{k\xe1l-vc\xd5\xa8\xe1I\xf7K`\x12\xdbo3\x87\x11\x1a&*\x91g]\xa3V\xba\x89\x86\xf9\x8f_\xb0\xd7\xcb\x9f[h\xc3]\xca\x85\xb7\xf6\x9a\x03J\xb1\x17\xddk>\xa3\xd0\x90\x00\xe0\x07\x80\x97\x98\xc9\xdc!\x85\x11j)b\xec,\xf4\xc4.\xb9q\xc4\x04\x1e\xb5Z\x8b\xbev\x15\xee\xbbD\x81\xcc\xce\xec\xf0\xd9a\xa3_\xb8\xd5\xe5\xb5\x9f\xf9\xa7G\xfc\xa9\xd1*A\x11O\xea\x9cJ\xf7\xdc\xd1\xf6\xc1'dn\xd3\x9a\xc3\x9e\x9a\xf7>\xd0\x81\x18\x91\xd5:}\xea4\x1d\x19C\xb2\xfa\xfc\xfaK\x1a^\x9eK\xbd\"8\xbe\x90~K\xa7\\zp\x98%\xfe8\xf9\\\x8e\xa4_\x9ay\xdbb\xdb\xa3\xe9\x0ey\x0bO\x85\xdb\xd1]K\xa5v\xc2V\x9e*\xfd\x0a\x15\x0b\xf1\x0f #TY\x88ZtJ\x99j\xf6@\x81\xf5L\xda\xc2\xb0O\xb7_\xd3G\xb7l\x7ff\xabP0\xe1\xfe\xb4\xc1\"\xa7\x90\x8f.\x8c\xfaT\xce~\xcaO\x81\x96\xe4\x80K\x94%\xb6\xe8\x87\xf2+\xeep\x10vr\xec\xe7\x03\xe8'\x18\x8b\x1f\x80}Z\x08\xb7\xe9B\xbd\xb3!Y\xc3\xdb\xfe\x85+\xeb\x82\xd7\x1d\xb3g+\x872\xecd\xf0\xcfX\xf1\xfa\xca\xb8'\x7fQ\xe4\xb7s2\x9bK\x04\x834^\x8e\x90\x9f,\x8d\xc5?X\xd7+\x9b\xd7\xe9\x97\xfe\xa1\x96f,\xfb\xfa\xc8\xbclaf\x9a\x81\xe7\x7f\xd4\x04\x89\xf91\xb6\xcaT\x9c0\xad\x033_\x08g\x0e\xd7\xf4lo\xd8+\xb4\x03\x7f\x07s\x88\x98-\x91\xb2\xf0\x1a6GPNK\x0b\xd6f\xd3\x99\x9f\xd4Or\xa1\xec\xd5\x13\xe2W\xd0I\xc9\xb9\xd8\xa7l\xdb\"F\xd3Q\xb3\xf4X\x9c\xdc\x96\x16=\x1d)\xf1\x00M\x979\x8c\x91\x0e/\xfc\x8d\x92\xbe\x07\x11\x94N\x9f\x84#v\xcb\xcbKX\x99\xa5U\x8a\xa4Lm\x9c\x05\xf9P\xc13\x13\xeeT\xe0\xdf\x7f\xc4\xb7\xbd\xc3WvL\x0f\x0f3\x8f\x1b\xe8ip[\xf0\xa3\xa9e\x19\x103\x88\xca\xa2\xc3\x90\x9eD\xf3gtu\xa9\xcd3\xf5\x0f\xb1\xf9\x82\xd4\xc5J\xb3\xd7l 1\x83\x17\x9e\x8c\x1dBi\xccJ\x08w\x99\x1d\xf6e,6\xee\xd1\xe5\xb3\x14U\xf0\xd88c\xccx\xf0\x87\xbc`{\xec_\xd8h\xd8\x03\xc9Ahv\xddJ\xf5\x1bGo\x81\xacU\xb8\x968\xb4\xc0i\x07\x9d\xedx\x00\x935\xd5\xa8\x8cG?\x86Y\x90\xf0L\x88\x04ou\x94\xd3Q\xfb\x09\xcdKt\xbb\xc9\x15\xa1\x86b\xe7\x7fa\xeb!\xb9.qe\x97\x1c\x1fG\xd4\x06\xc3\xc0\x87\x96)I\xf8F\xd6\xe8P,1`\xe3\x0d\xde2\xd6l\xa9Y\x1c\x83:~\xd8\x9dI\xcd(=n\xc3\x8aO\xe2\x11\xa4LM\xd8\xf2\x9f\xa9\xd9n'S\x0b\x83\x07$\xee&\x19lbB ik\xb3e|\xd0\x8b0\x1b\x091\xba\xed\x0b;\xeb\xf4\xd2i\x00V\x99v\xca\x7f\xbc\x91\xc8#=A\xf4\xa0\xe5Ug\x94\x9c\xc2*Tn\xcc\x12\xa1f\x94\x15\xae\x0a8\x01\x8f\xc76|\x0b\x07+u4\xdc\xf7\xc8\xef\xa3e\x86\xda\xbeY\x8bm?H\x1c\x87\x94\xc0\x0d\x13\xcb\x08\xcd\x8f\x0e\x1d\xe7\xbd\xbd\xcf\xf7\x10\xe7\xf2\xa8-\xb20\xb2N\x8e\xb3\xc4\x0d\x0b\\!\x9b\x8fds\x96\xaa\xe2\x069\xda\xaa\xa3\xce\x0er{\x13X\x81\x87\xb0\xcb\xee\xb3\xe1\xf2\xb7Q\xfdeU\xefQm\xc8\x0d\xa7\xb0d0\xe4i9\xa2p*V\xb8y\xd4\xf0,+\xacB>\xc9n>\xb1\x12\x03\xbc\xd5{\x89\xed\xe3\x80\x10\xd3\xf4\xa6\xaf-\xff\x8d\x13\x0cj\x9d\xe1#a\xc7\xc5\xcdBG\xb6\xb0\x81\xc8\xf611H\xcd\xcc7\xdb\x8fkR\x16$\x1d\xbb~&nM\xb2W[!\xf9'\xb2\xb9K\xe9@\x8f+ \x0c\xfc\x87I\x03TK\xd3\xde\"\xdf\xb6\xeeD\xb1)\xac\xab\xa6Le\xe2\x1d\x08W-/G\xfb\xa6o6\x9f2\xd2\xaf\xdaK.?A\x95X\x1e\xed\x06\x155[\x84\xec\xa5Y4\x03\\\xf5\xde\xd6\x9a\xe0\xf3\x05\xc0\x01\xf76(\xc3\x8bj,\x8a\x0f\x02\xaco\x0bV\x87\xfe\xa0\xf4\"\xd2\xa9\xd2Z\x83\x8b\xec'\xc4~\xb9\x8b+\x8c\x10a\x89\xb5\x10\x9da9\x1a\x93\x01oi\x18\xc3F\x8e\x81\x15\xed\x0a(\x8d\xde\x14\x8b\x10\xa8@\"K\xea\xc4\xc62\xaf;\x95\xc5\xd8\xf0\x945\"\x14\xc5\x11T\x1am\xc8\xa1\x8f\x19\xeb3\x9b\xe6 \xa8\xa1j\xd5w\xeeI\xa3\x02\x96jA\xa2SB\xac\x1e\xc533\xb1\xad5\x09i\xa4\x9f\xcanA\xff\xba \xd7e\x86\xc2\x00\xc6\xe4\xfc\x07\xad+\xabI\xae\x1c!\xc7\xa8\xcc\xb8\xfex\xc0\xdd9;\xcb\x05\xd2\xdf\x8c\xd9\xc5md\xeb\xf8t$\xf4\xaf\xc4\xfd\xb7\xfbr\xb8\xd3/z\x8d\xb8\xd66S\xe9Fr\xa6\xf4\xc8&\x13\xc1\x8f|(\xecd\x06\x1fWA\x82I`g\x07\"`2b@3\xa5\xdd\xe7*\x13\xbc\xe5\x07'g\x80b\xbd\x0b\x9fa\xe0]\xfc!=\x086TS\xa3\x03\xecH\x8f\xa5iv\xd1/f\xb0\x85\x98\x19\x8d\x1b\xec\xbd5#L\x94\xe3P\xc8g\xb0\x97\x81_6\xf2\xc2\x1a\xad\xfdLj\x9e\x84I\x04$\xd0\x8fX\xbb\xccE|\x1c+\x99\xf9\xe7\x96IG\x84\xea\x85\xab\x84\xcc\x9cw\x14\xab\xad\xaf\xba\xf1\xbc\x19\x13\xcaq6\xb1\xee>\xa1\xb0o\xb8\x13\xdb\x1c\xa5\xb8\xa7\xdb\xf4^\xbd\xf1@\xaf;\x1cai\xd1y\x11\x9cbiY\xb4M\x9c%\xe9\xfcR\xdb\x1a\x91\x82l`\xa1\x06'\xccUc\"2=\xa3\x89\xcc\xb3a3\xe9\x08\x11$k\xc2\xe5@ZEF\xb5\x98\xb7j\x10\xbdP*\x10\x19\xe3\x99z25\xaa\x81\xfc\x8e\x9bc\xb8\x10\x7f`\xdc1\xc3w-\xab\xf0\xdf\x94\xf8\x90\xa0\xce>Xc\x8f\xde\xa6\xb2B\x12c\x98\xa4\xdbC\xc3\xb1\xe7\xc7\xfc\x17\x9b\xedTw\xe0\x8e\x08\xed\xaa\x9eA`\xcf\xcd\xc5\xe8B\x01\x8d<\x04k\x16\x9e6\xd1\xca\x1ea\xf6\xb0!|\x87\xbbH\x83\x82\xbfa&vR\xa6\x94\xfa\xab\xf1!\xb9\xf8\x07?\xfb\xe0~\x0e\xde\x80tB\xccX\x9b\x8cO\x8cy\xfc=\x14\xfcZv\xf9f\xfa\xcc\x93\x81dX\xd7o\xf9\xf4\x0b\xbcqm\xfd\x1e\x03\xd5\x13\x81\xa2<\xa1\xf3\x03\x1b\xbf\x17\x99\x04 \xee5\x0b\xe4\xa3\x9c1\xe4\x8b\xb6\xc44\xf0;\xf8}\xf2\x0f\xd2PJ>\x8ecQ\x88O_\xb9\xad\x98\x0e\x85\xadU\x0e5\xbb\xd6\x07D\xe7\xfb\xc9i\xba\xc6\xc9;\xb3Z\x8f\xc4\xbd\xe5-\x11\xa5\xabbK\x80\x1d\x8b\xe2\xa34?\xb2Gd\x9bl\xa3~>}\xb5U\x09\xc0\xbf\xb7\x0d\x17*57\xaew\xa7\x86(\xf5)!A\x07\x04\x1d\xc1\xee\x00&;3^\x95EB\xf0\xa9D\xdf\x0a\xe5/\xa8\xd9{\x07\xb8\xf5\x05\xe9\x8f\xb9-\xb8\xf1\xbb1\\f\x07\xb5EJY\xc8\x1e.O\x7f \xe2<\xcd\xc4\xffu\xe6-\xb7\xa9r\xb6B0w\xaa\xe8\x05\\C\xc4\xbe\x0a\xb7J8a\xd1\xe2Y\x898P\xe73\x95\x10\x82S\xb4\xd4\x02\xc1\xab\xb3Q\xdci\xe5\xf9\x03k$c\xb7\xc1=~\xcd\x82\xf9{\xda\x14\xbc\x1e\x18d\xef\x1aY\x0d\xc3<\xf9\xc3#\xe0\x1f\x9c\\u\xc8&\x91\xdcn\x85\x83\xa1\xd9\x17L\xa5\xc2&\x94\xd6ar\xd7\xd8\x09v\xae[K$\x9f\x07\xc9\xb1!\xb4\x00P\xaav\xbd\xffn2\xf4\"\x13_\xe74\xc5=\xb8\x03\xe1\x8d\x8bX\xaf\xf0\xc0T\x1d\xda\xe1\xaa'\xa6\xf9\xe1\xbb\xce\xceK<'\xe0X\xa5\x7f\xe0\xd9\x0fa\xc7\xe7>\xc4\x05\x9bsr\xcc;\xb5\x83R\x11\x85\x14\x01\x1f\x10\xe2\xdfI\x903\xe3}kxv1QV\x00i\x16E\xe0\xa0\xd8\xdfQ\xdepw\xb3\x80\xe4\x18Q\xec\xd2\x8e\xb8\x0d;w\x1a\x9b\xeb\xb3\xa5\x10\xeb\x9e\xf5\x15)\x06\x1e'-\xaa\x12\xf0\xb1K%\x992(?\xd4\xad\xac\x90" +
+	"\xf1\x01\xac\x099\x09\x96M\xb9#C\x06\x82co\xe2l\xf0X/a\x14\xfc\xa4\xec\x96}\xd3\x8c*x\xcd\xd7Y\xd6,\xc5\xc3\xdd\x84\x90-\x99~Jq\xad6\xb91Y\xbe\xf7X\x9b\x95\xce?\x89\xadH\xaf\x07\xa7\xbbm\xaa}L\x935?0Y\x81\xa7pp\x07\x92\xca\xeb\x84\xaa\x0d\xa2\xa9\x0c\xdf\xa1\xa2\xb2;\x89\xfa\xfa\xae\x1c\xabz\xf3?T\xae\xab\xf4j\x0c\x88\x98\x08\xc8j\xbc\xd5\xbcNuJI\xfc\xa6\xf7}\xe8\xce\xc5\xf3y\x89\x8a!F8\xd8\x8ck\xc1$\xda\xdf\xb0\xe3\x02\xa9\xa1pO\xe6\xad\xa3<\x97b|\x9b\xae8\x82\x95\xdaM|\xf9\xb1a0\x15j\x8f4,\x1c\x0d\xff\xd8r\xa9\xad\xe6\xd4\xe2b\xa2B\xaa4\x8f\xdd&\xe3bo\xda\x85\x1a\xf1\x8b \xbd\xb5\xb4\xce\x1e_b\xc9\xbe\x010B\x92\xaa\x07\x91\xca\xa4\x8e\x07<?\xfb\xb2'e\x95o?\xe5\x88\x8aP\x16\x8e)\xc1\x83E\x0eO\xc6:l\xfed\xac{N\xb3U,\xc6\x0e\xd5\x9c'\xb80s\xbf\x98o\\!g\xb07\xbe\x16\xb1eZ3M\x13\xdaFp8\xdb\xd4\xb7IS\xfb]\"\xe3\xf0\x10^\xf8\x9b\xce@Q0\x0b\xd1\xf2O\x0d\xc42\xa5\xd1\xb0M\x03\x9a\x99\xfd<\x8d\x02\x0e|\xb2\xfe\xb8\xbelao\xac\xcf\xf6\x13\xaf\x94JW\xea\x13$\xe0\xd2C\xce\xdb\x19\xee\xd1.b!\xcb\x1c\xd2\xe2\x1f\xe6\xb6%Pp\xe7G\xfa+\xec\x8e\xc6\x93\x98?[d\x1c\xed\xbe\xcb2\x1e\xa1X\x85\xe0\xe8\x02\xcb\xc7Z\xb3\xfe\xad\x12\xef\xd9\xef\x0c\xa8]!~\x82\xa7b\xc7\x13\xa7\x99\xb7\xec\x04<\xc5\x96\xb6\x0e.\x1aa1\xe0\xbb$\xd8\x88H[Fv\x8dhWN+\xb15\x15=\xab,\xdbtJ\xa4f\x9a\xa6\xd7@\x84\x9c\xee\xd5\xb2\xc8}\xe6\xce\x91\xcc\"\x80\xecu\xc5\xb3\xea(uy\x0e\xf6\xdc\xb6\xder\x9d-\xe7~M\xc6\x0d\x12\xc8\x94\x98\x90\xcf_\xe2\x82?_\x87w\xda\x166\x93\xa2_'\x97\xa3\x17\xde7\x1b\x86\x09Q\x07\xf7-L\xf5Q\x1f\x9b\xa8\xe5\x87\x9f=Oj\xe3\x9a\xda#cm\xa6\x01v\xb1\x07\xac\x82\xfbv6\"\x0f\x8b\x0d\x1e\xd7\x0e\xf6\x8fL\x9d\xda\xa7\xf3h\xa9\x12Cn\x1f\xd0\xe8a\xb2\x8e\xc2C\xfc\xfa`\xe4\xb5N\xee@6E\xcb\x0dT#\x9f\xb9\xba\xe2\xf0\x04,\xbaJ\x18\xa8\x8d\x97\xd4\xcd\xac\xa1\xbd\xd9!\x1fU\x8b\x81\xb1\x827\xc3i\xd6\xa3\xd6\xb5\xa9\xee\xe2],.\xbex\x8d1|ub\xc2\x8b\xb5&-\xf4\xd6\x06\xd2\xc0@\x87\xb1\x02\xe1g\xfci3+(N`h\x9e\xfbb!\x93\x88\xba\x99\x08=\x83\xe3h\xd3*\x8c\x13\xe2wn\x93\xc4\x9b\x01\xfc\xb9V\x11\x89|2m\x0b\xcf\x9db\xaf\xd9@\xd3\x02\x870\x17\xbf\xcd\xea\xd1vp\x1f\x12]z\xfe{s\xa3\x85#\xf4\xaa\x8fpO\xfbQZ\x81\xdf\x08\xcc\xcc}\xda\xc0\xe0\xb9\x88_\xd6\x0a\x16\xe64\xed\"\x04\x15\xd0\x0a\xb2\xb008\x1b\xbeV\x85\xde\xcc\xe7\xf2\xdbg\xbe7w\x871\xf8\\\xd1\x9b\x03\xb0;\xdb4H\xa8\xd8662\x16\x84\xb8N\x7f\x17\xcdl\xacP#H\xc2\x0cla\xfd\x87\xd7]\x83\xfd\xde\xcb\xec\xb3;(\xcalt}6\xe9!\xd3[\xddsn\xc7\x0c9\x0e\xa1K?\xebk\xbfc\x0b\x03;\x03lS\x9c\x0c\x17\xf5Q\x8e\x11\x9e\xa0\xb2\xb3\xfa\x8b\x08\xa6\x94\x83n\x9d\xc5\x97\xcc\x93\x94\xf4\x8f\xdc\x14\xd73\xd7yz\xf5\xcdr\xae\x1dI\xeb\xe2\x0c\xcaF2\xbf\x0b\xf9\xc9\xdb\x0cI\xfe\x1a\xf6\x7f\x05G\xde\x1f\x17\xff\xdf#\xca=\xc2zM\xc8\xe6\x12:'.{S\xcf\xf8E\xda\xfc\xd8i\x17\x98\xb8\x0e\x1b\x8eG\xf8\x15\x97\x06\xdfd\xb4\x18q\xe9\xfe2H\x1c\xd9\xeb\xe3\x12I\x93P;\xe8M V~\x97I\xe61\xdd\x90\xb7\xfe\xb4\xee\x00\x06 \xd1f\xd8\xa1Qg\x12\xa8\xe78+\xad\x88=\xc2\x15\xf06\xb7J\x1b\xda\xf0\x0dms\xdc\x1f\xfd\xd9\x03'\x9cA\xcf\xdb\x8eV\x13\x18\x14\xde\x13u\"\x90R\xe5p2T\xfby`\x103\xb3\x98o!\xf7D\xdaEP\xb6\xad\xec\xb2\x194\xa3\x89U\xf6U\x17\xce {\xf5 \x07\x159\xdf[\xdd2_\x89N\xb1\xecd\x9a\xe2\x02w\x8b\xd0k\xf5\x1e9\xd7Dq\x99 \xc5\xcf \xf4\x9d\x12\xbf\xcc\xed\x8c\xd7\xa9\x08t\x82\xe6\x12\x94\x11\xb9\x19\x14\x0f\xdf\x84o\x98\xb1\xe1\x1ee\x98x\x95\xe6\x09:Q(A\x97_\xf8\xdds5k,\x19(:-3il\xb5);;HZ)\xe3\xdf4\xe1\x1eu\xa8\xf2\xde\xf9G\x06\xcf\xc34\xd9\x833\xb2\xd5\x0e2_\xe4\xa8\xc2\xfbDk\xc4G\xa6*(\x14{>\xd5Ar\x9d!.N{Jgg\xe6\x00\xcf\x8d3;\xd26\xf7\xc1\xe6\x8b\x8e\x0d[\x05'\xb7`\x11j\xfd\xa0\x06\xffi!\x082t\x1d\xc0@\x93\xdf\x9c\xbe\x9c8\xf8\x1eR^V\"[\xc7K\xf5\x03\x17\x0e\x95\xd6\xd8e\xcc\xa0\x15Y\x18\x8b\xde\xbc\x85\x0f\xc6\x15\x1fy$\x84*r\xa9QZ\xae\x90\xb1\xf9\xad5ae\xac+\x08S\xf7\xfa2\xb0\x18t\xcf\xf9l\x9b%\xb06\xbak\xeb\x9b<$h\xb1\xb0Li;TY\x1a\x80<\xb1\x84\xf2R\xd9GF\x1dG\x03\x9f\xdb\x0cp+\x14\x80z;0\xfe\xff\xc8\x90\x02\xdan\xaf\xac\x83\xe2\x04\xe5\xf5\x81V\x95\x9f\xe0+\x1a1\xc9\x9b\x99H\xb4\xc5\x9c\x82d.\xa9QE\x15\xf0\xee\x95\x88\xcb\xe4ab\x03\xf5\xd6\x1fY\x05\xd7\xe5=\xad\xa38\xe1\xcd\xea\x11\x86\xe2\x8d\xfa\xaba\xe9\xc6&\xce\xd3\xdcx\x98\xf0\xaf1\x86\xe8\x8cb\xd7\x1d\x1a&\xb1\xa3a\xa4\xbc\xae\xef%\xa0\x81\xb2\xb4/{I\xcdjE\x9c\xdeze\xbc\xd7\x1c@p`^<\x93\x185\xfe\xa9\xfa\xe8\xc7Xe\xdb7\xfc\xe4\x03\x8e(\x00\xd1\xe4\x9e\x80RerR\xa3\xc8\xf4\xd4,<c\xe6s\x02\x1c\xf7\xb82\x85?\xe1h\xa5\xf7\xa6\x0b\x12\xb73\xd0\xccI\x7f:\x989\xcd\xc2%fw`x\xa8\xab\x85$Q\xc1\xd5x\xd2\xba,\xdc\xef.VrRZ\x8f\xcb\x0e@\xacE\x80\xea\xc4\x96\x83GB\xdd3\x87e\xc8MJe\xba\xeahq\xd9J\xcf7sw\xf6\x07j0\xb2c\xf9s)U7\x98\xcb@\xab\xfd\x84\x9cKT\x11\xc9O5\xdc#Q\x19\xe2\x84dt'\x04\xc5\xf9\xde\xb3\xc8\xb3\x1cH\xc7%\x12qM\xca\x05\xc7y\\\x8d\xe6\xd1\x14\xe3A\xdd:\xf4~L\x92\xc3A\x12i\xeb\xac\xc1o\x16\x7f\x07n\x8d\x9b,\xd2:~B\xcd\xac5Y\x18\xdbO![\xcb\xce\x17P\xf8r\xb1\x91UG\xc7C\xbb\xc1\xc8\xe3\x0e#\xbf\xd5w\x13 \xef@\xd1v\x0eE|H\xc1=\x8b\x97\x99n(\xfd\x88\xf5\xef<\xc4\xfb\x84\xda\xf3\xf4\xaa\xff&L>\x05\x92\xb4\x80(@+\xa3JO\xb0\xa3\xcf\x10\xae\\q3'\xfc\x8d\x87\xa7CQ\xe3{/\xd0\x9c\xb8\x90\x8f\xd7$\x06\xa0\xb3\xe7D\xae\x85\xe9u\xce\xc9:\x81_$p\x83@\xed\xd6\xc2\xde\xdd\x91/\xec\x8d\xcbc\xc3o\xd3\x11\x92\xff\x1a\xbe\x93\xbf\x00\xd6\x02c\x04mD\xb4\x81M\xd8\x97\x7f\x8a5\xf6\x12|\x00\x16\xf0\x04\x0f\xe9\x93\x89\xeb[\x1bk\xc1\xf1\xa5N\x0e,\xb6\xbb\x1b\xcf\xf2tv\xef\xee\xdd}G`o_:|\x94\xd44X\xc8\xa1v(\xf1\x08\xc8\xdd\xfb\xa9\xbf\x8bo\xe1\xa5t\x17S\xc7p\xe3\xa1\xb1\xca\xe8!L&\xec\xb8\xdcc+K%\xcc5\xf0.6\xa9\xc1\x86A\xec\xc0n*\xdb\xdecx%T\xa3\xfa\x80;\xed[VfP a\xdf\x93\x08\x88\xc5\xc4b\x1d\x8b\xfc5`4*'2\xbb\x99\x10_\x10\xe5\\R\xa5\xa4)x$\xad\xc3\x89\x81\xd3\x9a\xee\x8d\x0dj\xd1\xf09O\xce\xcb\x9c\xff\xee\xed\xc0\xcd\xc8(\xc1\x0ai\xa8\x8e-\x8c\x146!A\x7f <7.{rN\xf7\xbcM\xe2\xafv\x80\x9e\xd9O0\xc7\xe5\x81\xa2i)1\x19\xec\x1e\xd7\xc9\xb0\x9aX\xf7\xe1\x88\x94l\"\xc4\x01\xfb\xf0M\xab\xf0\xda\xcf\x87[\xf8\xc1r@I;j\xab\xf9\xb7\xbf\xc9\xe2P\xce\x0e^}\xb1\xd9\xc1X\xc3l~\x00\xc6\xe22z\xf1\x04k(\xc8\x00-\xfd\x00\x08 \xb6\xd4\x90K=\xdd\x9c:\xc2\xb1\xd81\xab\x9b\x8dFp\x13Da\xfd\xf0\x9d\x01N\xc6\xd7d{x\xaf\xfd\x96\xbe\x93\xcd\xfae\x1d|G\xba6\xdf\xcarRB\x89ISIF\x17\xa3N,\x023\xc3\x93\xbc3\x0a\xc6\xf1\x89-P9\xd6\xd8\xca\xc5(\xcfC\xd8\x8f>3U\x06P\x8b\xce\x80u\xd6\xe11]\x8dZ(c\xad\xc7,\xe53\x0c\xa4\xb0\x04k\x99x\xa0\x1e=A\xb0+\xd8\x7f\x9e\x8et\xe7\xff\x03\x08(\x0c^\x0b\xfai3\x9a\x01\xbe\xc1\x90\xfb\xd6\xd3\x14\xa1\xa4\x0d8F\x07`\xb8\xdd\xf0\x18\xe1Ay\xd22\xad\x91\xa5&5\xe1Qb\x83'i\xb8:\x8e<W<}\x86\xd5_\xa9~\x08\x9b\x1f\x93[\xa4\xe7\x0c\x9bX\xbb\xd6\x14-\x1d.<\xf9\xa2\xc1M\xc1;x#tr7&\xf9$-\xc6\x85\xc3\x12+y\xbd\x0c\xe0\x01\xfa\x10\xd0\xb4\x88s\xdfii\xb0>i\x0aX\xacG\xc8\xe3\x05^\xaf\x80n\x19v\xfeo\x9e3\x92\xdbOA\x99)\x18\xe5\x854\x1cy<\x8f6\xc2>)9\xe3\xd2\x88Yi\xfe\x09&\x84\xe9\xadH\x82\x96\xb0\x8a\xfe\xd18\x90\x1b\x85\xcbP\xcf\x87\xf0d\xf6\x1dBUe\xa3\x02)lLC\xa6\x7f\xa6\xba\xe6\x07\xde\xf3\x84aw\xacJ\x8d\x7f+M\x92O\xd9SqU\xe2t\x02:L\x18\xd5\xc3Oz\xc7\x9f\xa7E\xbe\xed|:\x0c\xe4\xba\xb4z\xa6\xf8[\x9bUP\x99\x7f\x98\x05U\x7foe\x9e\xd6\xe8\xe4\x16 f\x1c\xdbb\x0a\xc9\xe1\xcaLQ\x9e\xa0\xac\x06\xd37S+\xd2\xae\xc2\xe3[4\xdd\x93\xf1Z\xe5\xdf\xed\xba\xb2\xbc\xd3\x07\xc7%CE\x1f\x18\xdel1o;\x89\xc7#X\x85V\xd2\xf6\x1cW\x8ba7\x0d\xbeO\xa4\xf9\xfd)\xe8\xceM\x1eW\x9bl\xac-C\xda\xbb\xa2*\x92\x09\xc1\x17Y9\xa5\xa5\xfc\x14\x1c'\xdb\xa7\xee\xad\x9c=\xb5\x1b\xe7V3\x16\x0d@\x862\xa3\xad\xb0}\xdb\x91\xad\xc2\x16\xf8\x83\x11^\x16\x01\xeb\x9d\xf8\xa4c\x8f\xa8i\x9c\xc2\xd3\x19f\x05\xd3\xa3\x98\x92`\x90{\xb8\xb7C@Y\xb2\x95\xddx\xe9\xd3\x15\xc3\x9b\xba\xf9_\x8eBG3\x97\x9f@j\xaa/\xd4\xe5\xff\x90,9\xfe9#5\xaa\x87t\x0a\x15\x0f\xc3\xa9.\xee\x86\xe6-\xe1\x10^\\\xc2DK?\xf5W\x12\x80(S\xee\xad\x94F\xda\xf71W\xd2\xe6\xf1\x10\xab\x89\x07\xe6H\xdeZR\x90x)\x02\x84)\x9e\xcas\xc3\xb0l\x11\xdc\xcc\x83\x85\x99\xa94\xea\x02\x12\xc6+\xca\x84P\xd5\x03W+\x7f\xbeNs#\xec\xe9\x92\xf4@\xc2\xe9\x8f\xbdt\xe1M\xf02\xe93\xb4\xa4\x0d\xe0O\xd6\xbc\x8f\xda\x1bd\xd7\xac\x8am\xe9\x10<\xb74k<\xf8i\x1e[\xfe\xa4\xdf\x97\x10\xa2\xa9\xb2}\x9eN=\x19\xa8\xed\xb8\xb5\xddV*j#\xdd\xc2\x9d\xb773\x19\x9a\x9a\xdb\xe9\x9al\x92>C\x9aqgOHo\xeeG\xacz\x1e~\x0c\xe7+i\xccm\\\xc9\xbc\x1b\xcf\xdev\x16\x11\xec\xb9m\xber&}\xbc9\xdb\xb0\x81\xbc\xdf\xd2\xf4=\xaf\x99\xcbRbG\x87\xac\x11\xe0\x98\x16U\x92\xbf?\xd7\xd9\x946Sa\xeb\xd1\xe4\xe8\x0e\x9dO\x0bJ\xb3?Y\xb3\"\xe26=?\xaa\xb8\xa1\x8b\xb9\xc3\xf2\x17\xba\x94\x19\xab\x0fY\xbcd\xef\xaa\xec\x84\x00\xca\x86'c~\xea#\xd8\xf3\x19\x96\x8a\xf1\xc1W\\r\x14\xa7\x88Wc)i\xf5)\xf0\xe9\xa7\"\xcb\xcf\xe1-\xc1\x05Io\xe2`\xea\x13\x16\x1f\xa0 :A\xcf\xcb\xfc\xee\xa3\xc0\x9cC\x01n?\x18\\O\x1d\xf4\xe6-\xce\xfe\xff\x0axs\xd2\xcbB\xfc\xafm\xb4\xd3w\xc8\xb0\xe0\x91 \x0c\xb36\xe0 \xec\x08?\xe5\x08\xbd\x18\xa2\xac\x13\xe2\xd4k\\t\xe9Y2\xb7D0\x81\xb6\\\x91\x05\xf8\xabIz\xb5\x98T\x15\xb0\xc8\x16\x0b\xeb\xf8\xc4{H\x00\xbd\x8d\x08\x97\xd9\\|\x1d\x1b\xf0:5\xfcB\xa8\x0d\xfe\xc5\xe3\x1a\xfeC-\xeet-\xe9\xfcr\x1c\xcek\xf8J\x12\x1ev\x95*\xe8\x9a\xf2\xfb\x90\x19\xff\xe0\xa6,\xf8\xc3A\x0f-CO\xce\xb5\x10W\x0a\x182\xd4\x01'\xe3G\xec\xc3\x97{\xd3[\x0c\x12\x97h\xae\x0f\xe0\xc1|\x8b\xad\xa9F\x0c>\xa8\xf0\xecp\xe3P\x86e\x97\xd9\xbf\xa3\xc2\x12\x11}\x13\xeb\x99i\xb7\x16\x0b\xec\xca\xc6-\x89\x02\xa2\xe7\xf4\xc0\x14G\x95MO\x99\xdc\x84i\xc63\xf16%\xa2\x0f\xa9\xb1s\x12k<jD\x80\x8e\xec\xd3/jf\xe3\x07\x991+\\\xd6[\x92c\xc8\x1b\xe6Y\xfc\xeaJ\xc1w\x92v=\xf5}\x8f\x14Tl=e\x9f.\xc9\xee\\\x1b\xde\xe3,|3\xd4h\xb6\x07\x0at\x9c\xd3\x1d\xac:\x93\xafF\x87c\x89\x1e\x9a\x81\x15\xec\xf517\x03\x99\x9e\xdb\"\x8a\x90D\xe6\x0dt\x03^\x1a<7 q\x9eb\xeb\x92\x8c\xe9)~\x11\x01\x9b\x19p:..2\x80\xac\xdc`=\x98q\xfe\x9c\xf7\x8f\xe5\xf65\xf6\x8c\x1b\x947v\x92\xa7].\xec\x88\xcbA\x83\x9dS\x0a\x89SC\x9f]\x02\x10\xfb.\xdatE\x18\xac\x8e\xbd\xcc^-\xc3n,\xd3(3\xd3\x88\xfb\xebZ`\xb2\x14\xec\xb0|\x86\xf3\xfc\x1c\x99Ax\x86Q\xdb*N\x1e\xe1\xc0\x8b\xdf\x81\x03\x83\x9d@X\xd6\x12\x05\xe6\xb4\xa2.\xe7J6Bys~\x87\xe0\x90\xc2U|\xa7\x84\xe3\x81\xdb\xc1\xea\x7f\x07\xa1\xc4d\x9bT_\xc6T\xdb\xab\x96D\x86\xa8P-1\xe0\x09SO\x93\xb8iX\x10\x01\xea\xb2~\xda\xbc>`\xe1+\xc4\xf0\x04T\x1cT\x02\x0b9e\x1f\xdd\xc1\\d\xf6\xcco\xff?\xc4\xbdir\xe3\xba\xf6'\xf8\xbdW\xa1\x1dtj\xf2\x10\xd1\xd1{\xe9\x0dtDUD-@\x12)\xd1\xdc\xc5\xbb\xbaN1e\xcb\xb2,\x0f\xa9\\\x09\xb8\x9b\x0a\x9c\x09\x07 \x01\xd0\x99\xe9W\x1f\xfe\xffwS\x16H\x8a$\x803\xfc\x86\x0a\x1b\x9b\x07o\xb4\xd7\xe1\x84\x8b\xb7\xcf\x05\xbd\xc8\x17\" \xd5\xbb\xc3\xb4\x15V\xcc\x82\x01\x0b\x8a\xae\xe3\xd2!N2\xf6\xc8\xc9*\x9a~6x\xb5#\xa9/\xd4i$\xf7\xd5\xc4\x0b'\x1f!DO\xf7i\xfe0\xa8L\xf6\xe4\xfe\x8f\xc0\xbb\x81\x1c\x13(\xa8\xe1{\xc2\xeb\x8fG\xa1\xaa1d\xf3\xe0\xc4\xac\x1cG\x1c\x80\x97vE\x7f\x81\x7f^\xa8\xa7\xd8+\x92\x84I7\xd6\xed\x9c\xa8\xbdK(X\xf5\xb0\xc0\x0b|g+\xe0\xf8.\xae\xa7\xb6\xd8r\xa9\x1fP85\xef\x03\xa7\xc2\x1c\x83\x0d\xe9\xfa%\xc1\xcd\xff\x05\xb52\x0da\x0e@0\xf0\"\x14\xaeL\xe0\x82.\x825q\xd5\xa7\xc4\xa2\x1a\xab <\xb8\xb9^\xe2\xdaK_\xc2Z2\xa4lp\x9b \x91S\x03t\xa0\xc25\xf0\x1eI\xc1~\x1d\x95?\x08{\xae<_\xb6\xe8b\x8a\xedC.\x98\xaem8~\xa1\x00\xc7\xf9\x81\xe8v\xa9\xd7k8\x86\x8ad\x08\xa9vZ\xb6\xecu\xf5)\xbf\x8b\xeb\x00CB\xa5\xb1\xb5\x18;\xb9\xce\x8d\x07k\x09{s\xdd\xc2T\xf3\xa9x\xd13\xb4\xe3\x1f\xe2\xdc\x938\xc2\x13r\xcf\x82}i\xca\xb60;\xf8y+Q\xd1\xb7\xe5\xc8\xa3v\xb4\x1b\x86\x84pB\x16+\xd7\x80\xf5\xc3\x908\x1a\xe7\xf6\xb7\xa8\xc4\x18f\xe2\xae(<X\x01\xc6\x96#\xbd\x0f\x90\xf4\x14\xd3`\xe9\x97u\x04_A\xb4L\xe6\\\x83\x9a?\x7f#\xceR\xe6\xfa\xc2\xfd6\xef\x8e\xe7/\xd7O\xd8p\xa4\xe6\xf7V2\xb8[\xae\x16\xd6\xae\xe4\xd3\x0e\x7f[\x80[b\xdf\xd4\x9d\xaf\xd2\x9a\xc6\x06\x8f\xfd<\x14\xfb_[<\xb2\x0a\x9d\xb7mEn x\xca5\\\x95np0\xbf\x19\xe3\xf9\x95\xe3`\xecF8\xf7\xcb\xbe\xfa\xc5\x93H\x88\xc9\x87m\xc5\x0d\x04\\\x1a\xe5\"\xda*\x1cG\x1b\xe9\x0e\xcc\x83r\x11\xc44@{(i\xec\xac\xd7|P\x19\xd5c\xbd\x04v\xad\xb1\xb5\xab\x91\x82[D\x04\xaa\xa8\xc5\x10~\xbd\xf3=w\xd8\xf0\x12\xa8\xa7\xe5!x\xff\xbe|\xd5_\xe01\xff!\xabz\x9a`'\xa9\xe5\xe3\x81$\xe0d\x82\x99\x0f\x1b8q$\xea\x97r\x16\xf8?\x03 \xbc\xb3\xfeiR3\xe2b\x8f\x0a\xb5\xc8\x8c~\xb4\xbf\x90\")\x8e\x96jU\xa3\xb3\xb8\x17\xbbpq\x85\xab`7X\xf9AV\xc1\xbaA1\xa75\xae\xc0\x9a\xc9\x8c\xd4\x9d" +
+	"7\xf8\xec\x87W\xe9\xdd(\xc4@[\xe1\xb2x\x80e\x8f\x0a\xc1X\xc7\xf3\x09\xd6[\xc6_\xfd\xdb.\xa2\xc9\xf8\xbcs\x03\x9c|\x86\xdc\x01\x99\x07\xb5_$\x87\x85\xa8;\x13\x8a\xb6b4\xff\x7f\xa0\xe6'lQwg\xd1>\x1d\x7f\xb6\xb4\xbb\xce\x8cg\x8b\x99\x06\xba\xca2c{\xdcM\xd2\xb0\x06D\x8d\xe0\xa7\xaa\xb4\x18T\xf8\xe1\x0f\xf6\x19\xd2\x87\x1d\x15\x81P\x18\xed\xb7\xab\x82\xd7*\xf4/\xc3\"\xac\xe6!\x04\xc6\x16\x9a\xf3\xe5D\xa7\x83\xfbM\x08\x91\xb6V\xab\xb7X_X\xe6\x1b,\xd8\xeb<\xe2NQ\xd0\xc2u(?\x934\xeb\xdf\xfbI\xdf1\x91y\x84\xeb\xdfy\x99.\x19\x9c!@\xa2\x16\xba\x04]\xd5\xc5\xbc\x04\xd6\xb5@GW\xbf\x8d\xf2\x04\x16\xb1\x0fH\xdb\xa1kD\xf6\xf7%\x83\x81\xdb\xdfh\xe8\xd8k\xe1\x18\xd7\xaf\x09\xf5A\xacp}\xed\x91\xb3W`7%\xca\xdc\xd7vO\xeb\x03\x8c}{\xb5\xc0s\x84UE_\xb4]n\x8f\x82\x1d\xd6\x88\x91\xb0\xd21\x9d]\xf0\xd3Gl\xbc\"\xc4\xc2\xd3\xddS\x15\x82\xb9\x9b\x8fm\xa1\x0a9XB8\xb6K,\x9a\xad\xb4\xb7\x9a\x0d\xeaQz;\x1b\xef\xf0\xa4\xb6\x86\x07\xfaNB\x11.\xc4\x13\xd0Up\x9c3\x1c\x98\x1e\x89Ff^=\x03\xe1\x91M*\x12\xf9\xf3\x8a\x86\xcc\x05\xc6nU\xad3\x85\xc1\x82\xfd\x00\x8f\xb1/.r\xa0\xf71\xf5f\x04b\xb7\x12`\x81\xfb\x8c&\xed\x1f\xe0\x08]H1\xc4\x9b\xcc4\xae\xe0]!\xaaY\x84{\x10\xc05\xd2\x9fVN\x8c+\x0db\xd3\xa5\xc6\x01\x92|\xfeR\xec\x0dQx\xda\xb6rw\xa2\xa0\x92u$\x17\x1d\xe4\xc65\xef\xf1\xe1`\xc1]\x9b\x18nS\\8g\x88\xf1\x17\x09>W\x91x\xd0\xe1AA]}\xcf\xbb>\xd1\xa3{[P\x0b\x15\xc3\x9b-\x95\x89i\x0b\xaf\x18\x95\xb9\xf0Q\x83\xaa\x07\xef@\x01\xeb\x88\x14-\xc3\xee\x86@\xee\xa0\xf4\x11\xb9\x9dl~\xb2vb9\x15'X\xe2G\x83\xe6\x9b(\xfb\x89\xdfY\x0b[n\x0fO\xeb_v\xfaf\xc9C\xb6\xa1\xf9\xb4\xac\xbd\xf6\x04\x1d\xe4\xd3\xe6\\\xbf|4\xcb\x8b\x83\x84\xfe\xa6d\xd9mWN\xe8\xd1\x11*\x8bN\xd9@\xb6H\xf5\x07Y\x87\xdd~J\xab\x8c\xf7\x938U\x19\xf1n\xc3ls\x88\x0eI\xe3\xe4\x8c\xe0\x17Wm%\xc5\x95\xbf\xa6\xb7\"\x05\xa8O7U\xfa\x09\xc1\x7f\xec8\x92\xa3\xa1\x0d\xb3\xfc\x19\x0f\xf3xH\xe8\x09\xc6\x9f\x1cLi\x98.=\xaa\xbf\xc3\xb4<\xc7\x9e\xc9kD}-\xfc\\+\x08\xdc;\xb8$kI:\x15\xc6@Ma\xed\x8aU\x85\xc3$\xc4K\xea\xae]\xe6\xa7#=\x9c\xa3\xbf\"\xbb8\xfb\\i\xcf\xb5\xb4<\\\x9d\xbdaA\x84\xeb\x18\x0b\xab\xb6\xd4yl\xa5\xb3jX\x1a\x16\xb2\"\x03\x14\xe5\xe0\xfe\xf9K\x97\x01>\x0f\xcd\x9awE2{Z\xb6\xfa\xba\x1d\xd6\xdc\xdbj\xd7\x08\xbcGc\xa2\xd4\xbc\xd4\x1e\xba}\x94J\xb8\x86\x1dK@;+1\xa8&\xf0\xef\xd6\xef^\x10\xbfc\xd9\xcc~^\x04\xf6d\x7f[r\xe0\xdaG\x17\xed?k\xeb\xa4\xe54SJ\xfc\xaf\xed\x0a\x9f\xe3!>ao\x14\x9a=_\xeb\xfe=*\xd9\xdc\x0b\xe7?\xb0\xf2\xfe\x19\x1aY\x80\xc4\xfch\x0bV\xa1\xc4?R\xcdl\x81\x7fa\xc41RC\xf6\x0e\xdd\x08\xfaJ\xa2\x07*8\xfb\xee\xbb\xe4\x9c\x82\x08S\xc8H\xf0\x8dLR\xa7\x93\xa4\xb1\x92\x92|\xdf+\x05#\x8d\xc9\xa4\xc9\xfajOM+\x15\xbbCE\xd5\xc5\x19.n@\xa4\xb1\xfb\x0d\xa2k\xfddO>z5\xe9\xd2\x8f\xb8\x89\x16\x98\xf8zH\xb6\xa3y`\x9f3\xde&!\xde4\xbb/\xc8d\x16\x9e\xfd<\x03\xa8\x0b\x06\x8do\x199\x0d\x1f\x98F\xc1L\xb1\xbe\x0e\xed\xbbv\xd9\xf7\x05x\xb8\xaf\x18\x90\xe2\x96ObxG2\xafs\x0bR\xa0!\xea\xdelO\xca\x80\xa8Zv5b\x14\xb1G<\xe9\x89z\xf2\xd4\x93\xa5w\xf7\xc5ic\x83&D\xa0J\x9b\xee\x03\xb75\x15\x16B\xe5/65\x02\xe5/\xef\x9c\xb4Yu\xf72t.7\xa0y\xd0\x11\xee\xc6\x15@\x92\x0fr\xbe{\x0c\xde\xa0\"L\x81\xcd\xa9\xf7\x17\xcc\xfcx\xca\x190 \xe8\xd6\xb3~\x87\x9f\xf4\x1e\xb8\x1e\xac\\\x15Q\xfbB.\xd4\xf4\xd6\xca\xea\xf8*8\xba\x0c\x9fN\x04\xd2\xdei=\xa0\xddi\xcfe\x00h\x1b\xb7\x85~1y\x02\xbfcY\x09[\xce\x9bv=\xf4\xc1\xb6ku\x17ks\x8e\xdc\xa2y\x98\xae\x05N\x96\x92\x04\xf8\xb0\xc5\xd85\xe4bmt\xd2U\x8e\xb2\xbd\x17\xa5\xdc%af\xae\xbd\xf5\x0f\xdf=\xfc\x83\x92\x1e\xab@\x19\xc83\xe7\"\xbdkY\x94\xf7\xb6\xf9\xa7K\xb3\x82?\xe7\xb6\xe2H?\x1b\xb8\xe7\xa4;\xd0.\xba\xe3\xa4p\xdb\xf3\x90(\xb0\x81\x82\x00\xfc\xd1\x85L\xcc\x10\xe9\x109`\xb1.\x1d\x83X\xb8\x86\xc1\x8a\xc3!\x16\x16\x0b\xf9\x95<C\x13\xf9\xdd\\\xe4\xf6\x02*+Y\xcd.\xb0\xe8\xdc.\x09Cq\xc6\xaem\x89\x8d\xa2\x0b?7*\x16\x1c\xf1\xc9R\xf5D\x16\x84\x85:L[j\xa1\xaeM\xb7\xfa\xbej\x0b\xad\xc0\xa1\x8az\xf8\x0d=\xda\xec\xf0\x0d\x0a\xa6?\xef\x1b\x17\xfb\xebS\xef\xb66\xfb^\x89\xe7@\xbb\xe6\x92%\x08\xf0\xed\x01\x05'\xb3e\xe4\xb4\x0f\x9c\xbd\x97\x0d_\xe1f+\xe3\xa7\x03\xfa\xfe\xf9\x0a\xc5\xb0p?\xeb\xaf9!c6sI\xa6\xc8T\x8a\xc1\x8a\x92_\x88\xe1\xf4\xcfS\x1f\xf9\xa1\xffl\x9e\xa2\xb7\xc2\xa5\x96\x9fR\\\x8e\xc4\xbb=\x02\x8b\xa4/\xd0\xc4\x0a\xea\xda\x00\xa4\x17\x09\x8d\x85\xf3\xfe\x8b\x1f+>\xe9Y\xc3>\x14\x8ej\xa5pT^h\xdeg\x82\x1e\xbb\xcaJ\x9b\x80\xa8\xaa5\x0e\x1fh\x9a\xfe\xe9\x02\xc5\x97U \xe8\xaes\x15T?r\x9a\xbf\xfd\xf7{\xd2\xc9V\x83B0\xeaU\\F\x9d\xf2\xb6\xeb\xfe\x90#\xc9\x03/\x88\xe8~\xc1O\x9f'\x1b+\"\xd1\xf2\x1c\xb3\xc9MT\xbe=\xab\xad\xa2\xdd\xa8\x1f\xbd3\x1f}\xf5\x83\xd2\xec\x929\xc3U\xd0\x028\x90c\xed\xe7\xb3\x06\xee[\xda#\x08\x1f\x10\xd8\x84^,\x83DU\x9b\x17\xd7J\x88\xc1\xcb\xc63\x0c\xdc\xbf\x1e2/\xd1%\xc9\xf7\x8b\x17\x7f\xe1\x03\xaa6:\xc0\xc1*\xc4\xc0{\x9e\xd2\x0a\x14\xc8\xe5\xed4(p\x1a\x900\xa05|\xf2\xf31\x9cwv+x\xf7\xe8{#2}|\x80\xe5K\xdcX@\x86k/\xa6R[\x87\x8b\x0f\x99Y`\xcd\xda.\xe8\xd8\xfcurh\xbd0\xb3\xe2=<8>\xfbF\xb0\xbf\xb2`l\xe9\xef+\xe6Q\xe0\xdfy\x98\xd0\xbe\xbd{3\xc0\xd7\x8a\xc3\x80=\xee\x14]\x082%go\xe6\x97v\xb3&\xd0\xb3D\x11\x04\xf4U\x91M?K]\x9e\xe9\x06C-\xd0\xd8j\x0b\xfb\x0fZ\xcb\xe2N\xff\x14\x09\xaf@\xea\xd3K\xfa~\xb6\x9b\xac\x86\x00q\xddO*a\xf8\xa0n\x94\x0b\x93\x1bs\xe4\xb0\x87^\x05\xaf\xaeR:\xc3\xf8\xb3,\xde>\x95rI\x180Z\x7fw\xe6;\xfa\x18\xc3f\x8b\x0b\xaa\xfd\x07$\x06\xb4#\x00\xb0\xab\x11[S{w\x11\xe9\x99v\xa9\x1a\xd0\x7f^\xca\"\xa8\xbd\xeaA\xbb\x90C\xf05\x9e\x0a\x95\xe2*s\xd4\x8b\x0f\x9a\x98\xd2\x16#`\xb1\x8bC\x84y\x8f\x04\x14\x1dm\xbe\xf9\x0a?\xf28\xd2\xfcK_(\x83\xabm\xae3T;6\xdbH\xe9\xb9G\x9b\xd8\xd7\xd9\xfb\xa0\xeb\xdd\x05\xebw\xec=\x90 \xa7\xbbR\x00\xb4\xfb\xe5\x8b\xba\x86\x81\x10\xc8\xcf\xa9\xa9\xc1L\xf3\xa0\x8c\xb2v\xc0\x9f\xcc\x0f\xe0<\x90[\xab~oWN\x98[gH\xf0\xb1\xcb\x91\xfe\x0a\xe9\xa3\xf6\xf4\x00\x95n2o\x06\xbc7E<\xd6IsE\xdb\x9f\xdb\x08\x1b\x88X\x1d\xee.\x09\xa5\xf8\xc8\xb3\x88J)$7\xac\xac\xc9\x9c*\xe9-\x05Z\x1f\xf7\x9a\xcf<P\x12\xf96_\xc5#\x02P@\xa5\xad\xfa %xM\x85\xf9\x89W\xc1\xbf\xb6-\x11\x85\x19\x18^\x0dm\xa2\xb80\xfc]\xc4\x07yf=\x84\xc2\xaaj\"\x98\x1d\x04\x06v\x0bC;`\xbcj\xf9\xach+\xf3!-V\x9c\x11\x07\\\xbb\x1a\x0e\x0f\x89Ye\xd7+\xe2\x86(L\x06j(\xd3h\xa7cH\x95\x8b_l\x92~q\"\x87\xfd^$\xb0\x1a!\xbe\x10_c\xbd(\x9dFb/\x0a+\xcdS\xd4\x9c\xc2\xc3\xbf\x0e\xb8Uz\x9a\xaa\xdb\xb2 \x97]OU\xd2\xde\x16< v\x14\x0b\xf2a\xb1\xd5\x03x\xc7\xe1\x06\xba>?\x01)+`\xa9\xady\x0a\xaacK\xda\xb0\xe9|\xebs\xa8|\xdd\xc3\x12\xfe\x11^&<K\xfb\x99h\xc49\x18\xcf\x1b\xfc\x19.\x88\x9cX\x17\xb0o<\x89%\xb2T\x84\xc0o\x82'\x1d2O\xf7\x14#sF\xe3\xf2_\xf2:\xdeH\xde\xa9\x93 \xde/\xb3\xf8\xde\xeb\x00\xdf[\xb4\xab\x04\xaew\x8f2u\xedJ\xa5\x1c\x0d:f\x04\x18\x99\xff:\"\xa6\x93\x03)\xdcT\xc53\x09\xf1\xe4E[\x9bF\x14^`)\x12\x95`H\xc5.\xb8\x03\xc7\x193\x03\xcc[\x87\xe4d\x80\xb2\xdd\xd9g%P\x14\x12}\xf7~\x86\xdc\x0b\xda]\xda\x05\x1caO\xb5o[\x81a\x0d\xc17\xf4\x13*\xe4P4\x19>\xda*\x13\xaf>\xe0\xcb\xdc=\xda\x0a\x8aX\x04\xa7\\c\x17x\xe4iM\xd17\x09\x1f\xf7]\xdd\xb8\x839\xe7\x02H\xe7\xaa\xfe\xa2\xfd\xb4=\xb5C6k\x80R\x1b4\xb6\xdb\x1a\x17YQ\xacb3\x95G\x86q\x15$[\xbbC\x85 \xce\x13\xf6\xb4\xbav03pRs\x89\\\xaeo\x02\xdf\xb7\x05485\xbdc~v\x03\xba\x0e\x01\xf4[\\\x9fd\x9d\xc0\x9a\x9b\xb4-\x11X\x87\x96\xa6\xed\x92\xef;\xf2[\"\x0f\xdc\xb5\xf5\xf6\x10\x0e[\xfa?:\xf9\xbb\xc5\x03\x9a|?G\xbc\xec\xf0e\xd4\xee\"\xe0\xf0\x8f#\x92\xa2!\xf0\xf5\x0bJ\xe9\xf1\x80\x10\xee\xf4\x9c\x0d\x0c\x14\x9c\x88P9\xd4_\xd8\x99\xffPT\x82G\x01X\x91\x98_\x92\x18\xf3\x9agQX\xc6\xf2\xa4\xd5\xee9\xe9\xdb;\xfb\xe7\x80{\xb72M6@\xb0\xe7\x94\xab%\x866_\xe2\x19\xb7QhN\xadQ\xf3Y\xc5\xbd|\xba@\x986\xfe\xea\xa9\xcd\x96\xbdTN\xd4\xbe\xb784\x08\x9e6\\d>\xe0kP|\xe2\xf0\x93\x84\xad\xd5\x13\xbb\xbb\xcb\xbb\xdd\xe8.p\xc1\xd0r\x84\x85\x93\xb5\xde\xa39%o\xa2ON>\xd3\x04\xa2\xa8t%nNP/\x90\xd9%T\x88\xcf\xa3\xf0|C>m)\xcf\xa6!E\xbb\xd4k=\xf4\xa2\xebv\x91K\xf6\x1d\xd6!\x80czn\xf9\x1a\x0c\xf9\xec\xec[QD\xebU&\xf7\x88B\xdc\x95\xda\xe0\xf9\xfd\xc2\xe0\x80+\xfa\x01Fr\x0d\x079\xa5-\xf5\xb6f\xdb\x96\xf4\xd6\xd3\x9e\xe0\x01%\xd1\xd2\x8d\x9e\xf9\x19\xea+\xe1\xc5;\x96\x16-1e\xec\xb4\xdaH\xb9\x1c\x0d?3)(\xa8\x8a\xf1\x01\xdc\x1d\x1a\xb3\xe3\x16\x8a\x13*iW\xf0\x88\x12\x0b\xcb\xf8\xa6\xb3\xc7\xe0\xaa\x91\x14V_\xf0\x81\xa9\xa9\xbbnW\xb1\xe3\xdf\xaa\xdf)\xcaw\xa70\x05\xb5\xef\x12.Y\x95}~D\xa1\xc4\xda=\xf9\xfdZ\xefW\x97\xa0S\xc3{\xa3\xe0\x02^@t\xcfRM\xbat\x1a\xc7\xeb*\xdf\xba-\x1bx<\xab4\x9ad\x0e\x1f\x81\xff\xe0\x9a\xdch\x0e\xa0\x15\xee*\x1d\x8d\xf1\x95t43\xfcE\xf5\x97\xfd\xb0\xff\xb2\xc6\xb9\xcb\xe2\xae\xf6J\x9b\xf7\xdb[\xe3\xe6\x02\xebl?\xbaid\x17\xc8\xb6\x1a\xd9K\x89n\x82N\x01\x1b\x94B\xe0\xec5\xccF\x98\x86+x\xe38z\xad`\x1b\x87^+Jy\x98\xad?\x02\x9a\x1e\xb5\x1bao\x1cT\xb7\x89s\xb3\xcc\x0b_\xc3\x16\x11\x08\x8a\xd8\x89\x0d8\xfdR\x02\x1doI\xc1mF\xa0\xcdd\xa1%\x1fXy\x14\x040,\xdb\xa2\xadU\xffno\xf1?qu\xf0Y\xea\xaa\x9em\xee\x16\x08#\x0a\x09s\xa0\x8f\xf2D\xc7~\xb5\xaf\xf4\x04\x1d[\xf7\"\xa0\xf2\xf3\xe7\x18\xc8\x93\xab\x0e\x09X\xbc\x9ai\xdd\x0f\x09\x95m\xe5\xbe\xb5\x0d\"\x8d8lp\xa2}\x07\x0a&xl\xdb\xaa\xdd\x8c\xa0)o\xe5\x1e\xa8\x80\xb4\xc7\xf7\xa8\xf2\xbd\x8a}\xec(f\xe6\x9di\xb0\xd2\xaau\x80\xdb\xb9\xc4nl@\x7f\xde\x0a'\xccM\xa0\x0d\xf2tt\xd9\x89{b\xa5\xb9\xe0\x8bW\x8dx\x8a\xe3\xa4*I\xbeT\xd8\xa32\xdfu\xe5\"\x8b=\x9f\xdcF\xde,YO\xfe\xa1sr\x1f@j\xfe\x9e\xfcmg'\xc0\xd5\xa3\xadF\xc3E\x14\xbee\xef\x14\xcam\xc0\x941\xff\xe2\xac\xfb\x85z\x03\xea\x96 ~\xcb\xb1\xbb\x91\xa0y\xc9f\xdd7^\xd6\xbdl\x8bd\x03KJ\xf5\xf0\xc5\x91s\xe6<\x9b\x0f\x9ee\x7f\xbd\xc7$\x93\x01*\x10k(o\xfc\xa0z)\xfe\xda\xc7(\xc2u\x1a\x0aE\xa2\xe81cJF\xe6\x17\xcd\xad\x04Jv\xe6\x1f\x03\x0f@\x0b,\xa5\xc6CD$\xf0e\xa1T\x16\x95\xc1\xdf\x060 \xb1jZk\x06\xd6\xc2\xec#\xc3\x02\xd8&\xde\"|\xbd\xb5\xfa\xba\xfd\xdb;\x068k\xad\x05\xd7V\xe1w\xc5\x9al\x8f\xd5\xc14\x85\x80\xcb\xf0\x1f\x94\xb8\xb7%%Gu\xd6\x1e\xccS8g\xc5\xc8\x05\x16t\xe2\xf8\xe5o\x1d\x9f\x1087\xc6#\x07\xfbQ\xbbH\xda-\x8f5a\xd3\xec \xf7$\xe1\x10\xfb\xae\xb5e\x1eE\x8e\x8d\xa1\x0b\xe6\xf9\xcc\x87\xc7\xfc\xde\xde\xc9\x87\xbcm\xb9\x8d\x13\x8a\xb0\xe3\x85\xba\x9ae.\x8b\x10\xcc\x1f\x06\xc4\x08\x0fC\x13\xae\xd8o\x9e\x87\xb9\xbd\xed\x0d\x16\xac\xb5\x06\xab\x9c\xe6\x0b\xb0D\xd1\x16ZZ\x1f\xb2\xf42\xb0#\xe7\xa1}\xc6D4\x90\x16\xb3\xf0\x8a\x0f\xbb~d\xd1\xc7\x9c\x84\x83\x13\x191\xad\xdb\xd2\xfb4b\xaeB=\xe1v\x09\xe7\xa7\xf7\xab\x86Y\xc7t\xd3\xb6$1\xa3\xac\x8d\x8e\x92@\xdd[JN\xde\xbaf\x01FE\x0b\xddOc\xf5%I\xa4\x1a\xaeQR\xa3\xee{\x94\x97:\xd1\"\x15\xb4'\x98=\x9b\xe1\xbc\x11\xf6 \x15\xdd\x12\xba\x9d\x85Z2?\xdd\x8bL\xd7`\x1e\xe7\xf4\x01\xe01\x1e\xcc\xc9<\xf4\xa9m@\xf8\x17-^\xab`\x13\xe54>\\iI\\\xe1\xf7h\xc1d\xf6\xd9\xd8\xd0\x13\xb1\xc5\x86\x98c\x12s\xff\xef\xa2\x85\x14\xcf\xe8?\x99\x89\x07{\x7f\xee\x11f\x03\x02\x96s!_\x18\x89\xc9T\x122\x85\xfd\xa40\xef\xf8Y&\xb0\xc3\xfc\x025\xc1\xc0O\xcc\xee\x1c\xe5\x80\xf0\x8b&o[\xd9=\x9a\xa9\xf2\x05\xfe\xcf\xd2\x05\xf2\x8e\xc1\xbbG\x9ej\xec\xd0\xbdE2\xe5J\xa3\xd5\xf7]\xa5)\xd3\xd0\x98z/7\x13\x91\x09X\xa1\xc4\x00\xe1\x13\x82t\x99]\xe2\x0eL\xc7=\x97\xc96\xbb\xb5\xea\x1e\xaaK\xb4s\xf3W\xec\x02'}0\x83\xb5\x02\xd0#\xb0`\xddA\x13l\xdc\x898\x15\x97\xa1'{\xc6\xa8m\xdfT\xcb\xc8:\x8e\x98\x04\xb8m\xa5\xfe\xe1\xfb\x05oYx6\xb04\xcfp\xdf\xa7>h\x84\xb8\xf5{ju:a\x82&\xf0\x1d\xc7\xe2\xcf%\x86\xd2\x99{9\xeeZ\xe34\x09fA9\x08}\xf4\x98X=\xa6W^\\\xc8o/VJ\x00r\xb4\x8a\xff<\xbf\x1c$cY'm\xc19\xe3\x91\xf0\x83\xbd\x07\xb9\x09\xef\x91\xf3\x84\x10m\xd6=\xbe\x03\xb1C\xdc\xf6]\x87\xeb\x8e\xf3z\xa5\x9c7\x7f\x11c\x0e\xf5\x1fz\x1cZ\xbc\x8b\x91\xabH\xdd\x8d\xd97\xb5E\x8b\x88\x86x\xf8p\x18\x12}\xac\xb3q\xf8+\xc8\x80\x10\xcd\x8e\xff\xc3|\xb4-\xeb\xa97\xf2\xb7\xefNvC\xbd\x9c\x99lz6\xe9\x10D\xdb\x95ym\x17\x12\xc6\xee\xb0xZsb\xea\xd5\xbe\xceP\x9a9\xb2\xb0?m\xe4D\xb5\xea?\xa1\xe2\x97\x93e\xda\x8f\x91\xabo\xb0l\x8a\xfd\x0f\xaa\xa6=\x03\xdeM\\\x9e\xf4\xb4K^R%\xba:\x89\xc99\x9by\xe8\xdf\x13\x0b'S\x05\x88\xea\x89\x8d\xce\xda\x09\xe16\xc2\x18\x8c^\xecGe\x8f\xe9\xd33RK\xc3l\xeeiJ\x94\xf6\xd1z\xfa)v\x0a\x92\x14\xb1n\xf6\xfa\x07\xb9\xf2^\x19\xd8j\xa4m\xc3)Y\xbbp\x17h\x0b\xfa\x8c\x89|HXV\xce\xfc\x99\xcd\x9c\xbd\x80*\xb6`\xd8\x09\xf6\xab#\x87\xba\xf1\x9a\x1co\xb4\x8e\xb2g\x81\x84lbK\x08\xef\xf0\x1bp\xa6\x1a'\xe4\xb9'$\x01\xc6[w\x9cZAF\xf43\x12\xf7\xcen\xb5\xc9\x9f\xa3g\xc1\x83CO\x8f\xcdH\x12`\x17Bn\x10\x0e\xd3\x98g^..\xe4-*g5\xcfm\x199\xeb\xfc\x9b\xf7\x83\x1f\xb1\xb5\xce\x143@\xf9=" +
+	"*\xff\xad!*\x1d\xf3\x8e?C\xcd-\xbe\x08|\x0b\xc4,\xc9@\x8d\xc4\x00\xbcy\xc6K\x9ak9\xd2\x91V\x94Mk(\x11\xe9\xe7\x15\xc9\xca\xc0|\x128\xc0\xc3\x9d\xe227\x1d\xb8\xe8\xb3\xfc\x8cTRn=8\xf0\x01\xba\xe9\x09\x85S\xef[_T4\xf1N\x91\xe4m\x11\xe4\x18\x08R\xe0\x08\x95\x1f>\xd3\xd1\x09\xbac<\xa09\x10\xd2\x1b\xe0q7\x0c\x011's\xe9=\xcc\xdc\x8b\xfcJ\xb3S\x87YH\x99\xd0\xa9\x12p\x98\xd6{\xb0+\x7f\x91Bt\xdd\xae\xf7P'h\xdcC\x85\xfc\x0di\xbd`j\xd7{X\xb7\xba\x9c\x81,\xb3\xd1\xfc\x9e\x92\x01\x15\xf6\xdf\x9c~?\xe0N\xde\xd6\x84\x7fF\x07\xc8\x03D\x15%\xb9Z\xa1\x16\xb2\x03\xfe\xb8\xc6\xba\xbd\xa8\x0b\xe0U\x9c\xa4\xd8\x16\x14\xca\xa0B\xb1\xb6Y\x0eb\xfa\xab\xc8\x15\xeb\xa2\x8c\x06a\x14j\xf1oP]\xb2w\xbc.\xcd<\xd2n\xaepL^\xaf\xcay\xfb\xffK~\x9f\x10\x146\xa2T\xf4a\xbe\x9b\x1f\xe9\xf2\xcd\x021\xa4\x18\x14\x9fEX\xc8I\x0e[\xf5:\xba\x0fO\xe6\x19\xeb\x7f\x88\xbe\xf8\x91f\x82p\x9e\x85o\xc3\x87\xf9\xe5D\xa9\xec\xcf\".\x96\x08!94\x17j1\xf7\x1f~\xa2]\xf6xyt\xe1\xa8\xd9\xe3\x02B\xbcY\x08\xa1\x88p\xf1\xcc\x98\xc1K\xec\xd8\xd3\xae\x18%\x80\xb4\xd4\xb5\x91%'|\xcc\x0ca\xcb\x95\xfd\x91\xe6\xf8W\xf8c\xcd>\xe8\x96\x88\xdf(\xb2\xd8\x8e\x18\x11\x8f\xe0?rGW\xd3\x17\xc32\xf3\xdd<c\x08t\xa1\x13\xc1\x19\x96\x91\xe9?\xbe\xf2\xb8\x97\xb6\x85\xbc\xe4\x0b\xe4\xae,\xbc\xc3\xf5\xc8;>Z\xde\xf5\x1f\xf2:\xa0s\x96\x9e*\xca\x16\x89\xac6\x08\xa5t\x10o\x0b\xe0\xf9\xcd\xabl\xf1\xaeK\xaaN\xac'v\xc6v\xd9\x8d\xd9#+@k\xbf\x81\xf5\xe5\x9dc\xfeb\xb9#v\xd4\xdb\xce\x1a\xc9\x94\x81\x0a\x8d\x1c(\x85a\xdf\x9c\x92Lt\x94L\x97\xfd\xb1\x16o=\x82g\x81\x82};7\xb0n7\xe9\x86-yT\xfc\x045\x9d=\x9bs\xb0F\xad\xd0\xdcb\x07\x1a{\xeb\x11\xf7G.\xa0\xd2Ls\xf9=\xf2\xeb'\x13\xfd\x8a\xb1^%m\xcb\x91!\xaa\x9f\x09U\xc6\x05N\xbfc\xe2\xd1\xa9n\xe3J\x0b\x03\xbd\xc2\xfbP\x91\xc7\x17\xa1W\x9d\x85\xe9\x19\xbak{\xbf\x0d\xc9\xeb\xbd\xb3\xd6\x8d\x9cs\xde\xd9\xaf\x12\x0f\xd6\xbcby7x\xb05\x07P(\xba\xc6\xb5\x9d\xca\xec\xdd\xf0\xc8S\xf1\xb1d%E\x00\xb4\xfbg\x9e\xa8\x8f\xcf\xa7\x0b\xd8\x88$(\xcf\xfde\xea\xc9\xde\x84R1\xa4\xc0t\xa1\x97\x0bo]\xff\xf9\xb5\xfd\x05M\xef5\xb6\xc0\x0b4\x88\x8f\x0ct\xa5&TD\x85\xbbi\x11\xdcm\xd5\xff\xfdq\x00L\x84\xc5\xf2\x80\xb8\x9f\xb7\xf8\xb0\x897\x8c\\$\xc8\x0e\xfa\x07\xb6\xaf/\x04\xa3\xb3\xd1\x03F\xae\xcf0\x13.\xcc\xa8<c\xc0\xd2\x7f\x82\xa9777\xce\xa4R\xb8\x15\x81\x9bWZTh:\xeb\x96\xba\x0f\xe6]\x96\xcd\x0f\x98q\xa5+\xdb\xc4\x8a\xa8\xd3\xb9W\x02\xad\xc1\x13\x9c\xce\xcf\x99\xfb\x06\x96o\xa8o\x1c\xa2=\x96\xe9UpE\xee\x1a\xeeE+\x7fd~\xc1m\xaa3\x15\x1f\xfeI[r3\xd1x\x16\x8aQ\xb2\x05\x1fnpQGA\x09\\\xa5Zy\xd3\xdb0\xd1(\xf2\x83f\xdf\xc2\xa7[8\xbdE\x8c\x8f\x009\x91+\xcdx\xb9M\xc3\x87x\x85\x97\xec\xd4\x07\xc8H\xc9\x05\xcd&\xe1\x03\xe1#\xc2\xbb\x07-\xbb\xee\x11\x9f\x13\x10\x0fUbA\xd6gMr\x18#\x81\xf2w\xf3\xafXU\xa4b\x7fd\xfa\x95^\x11\xedB\x0cExx;,\x02\xa7\xcb\x1c\x05E\x9dk\xa9\xe4\xf2l\xaa\xe3Ws\xe5\xcfz\xbb\xfe}\x98\x8bw\x0c\x8c\x88\xa2%\x12\x0d\xe5(\x1d%\x8d\x83v\x90\xe3\x14\xdd\x84\x03$\xec/\xb1c\xdd\x04\x9d\xeb\x0d\xb2g%6\xf5\xea\xbb\xe9\x82D\xe5\x02\xe95w\x87\xb7\xed\xda\xbb\xbcT\xafw\xfe\xad\xefZ\x0e@D\xff\xe1\xc9\x7f(D\xc7\x80\xd2\x02\x1d\x87a\xf6u\xf4\xb7\xcc'^'\xde\"*\xec\xe3\xc4\x89LX\x82\xce\x0a\xd9\x9f\xea_\x07\xc6\xf3\x90;\x9e\xccc\xd2!o\x0bV\xe5\x10wRk\xe3\xd9\xb9s,\xcd\xe5\x0bj\x00E\x88\x8f\xd1\xab\xf1\x11\xfepI\xd6\x05|\xea\xce3Cu\xdc\x0fN\xc1\xf81\xea}\x1cu\xa8\x9d\x94q\x00\xc5D\xe4\xe7q\x87\xe4\xfd\x81\xb6\xb2\x12\xc4$v\xc8\xbf}\x82]\x87\x88e \x0e\x01\x9e\xcb\x99\x92\x02W\xf0\xf0\x7f\xd0T\xb5\xc4\x15*\x09\xbb\xb8w6,'v\x8ch `=\xf6\x8eT9G\xbbFGnQ\xbc\xcd\x88N\xe0o\x86Zb\xd5\xae\x83\xd4g\xc5\x08\x8c\xde\xe5J\xcfOo\xbc\xa4s+|\xa9\xed\xe2<\x04@\xc1\xa6\xae\xb2/\xcb\x7fd\x14\x174\x9eE\x92&F\xb6QK\x87\xdaQh\xf4\xe9\\\xebd&\xa8\x94\xa9!\x00\xc3&\xa7\xe0G\x85WR \xb1Kvl\x94.w-\xc00\xb4\x80Q\x8e#ew\xbf*6|\x16x\xd6u\xfc\x86\xe0\xbd\x82\xd8\x0d\xd7\xfe\x9a\xdc\xca6\xe9\xb4y\xc5\x02\x7f\x04H\xb0\xaa\x11w\xe9Dy\x0dR\xdf\x17Z\xc1\x01\x18\x7f\x97\x86Q\x10VO\xf6\x90#\xcc\x09QA\xbd\xcb@\x1fHp\x13\xa5\xe4\xcc\xa5\xff\xfb\xb7]\xf4\xfe=\x0a$)h\xfb\x86\x90\x94\xbbL\xb6\xc9h\x963? 0\xfe\x02\xeenlt\xd7\x09C\x8fwF\x15\xf1#h\xfe\x81\xf0\x11K\x08\xf2sP\x06\xcf\x0bG5\xac\xd19\x84L\x06\x8ei\x80\xc7\xac_\xd5\x139\x1a\"\\\xb8\xc19\xb9Dx_\x11)\xea\xab\xcc\xb2\x80N\x13\xee;R\xa9#\xfc@&)\xf4)\x18@\x03$Mf\xad#v\xcc\xa3R\xab\xf0\xfe\xf83\xdf\x95\xfa,\x8e\x99M=R\x09\xa3\xb9\xef\x1d/p>\xbca9\x04C\xf7\xb2\x0a\xeaI<\xd2}\x86M\xf2g,\xb2\xff\x16\xbe0Rb\xd2.\xd1\xc8\xbf\xa1X\x0el\xb9B\x0f\x0e\xf6\xfc\xd1\xbf\x00\x1fm,\xaf\xd1\xf5\x14\xdc\xa5I\x19\x84\x04\x84\xa1\xb4\x99\x94\x90Gs\xff*\x83v\xe8\xbbEX\xa9\xc0\xde?~TA*{d\xa1\xe6]\xb4\xa5:\x9d\xc6\x0f\x0c\x05\x0e\xd4\xba\x93\x03/\x933f:K\x1c\xadg\x02\xb6u\xee\x81\xce}\x1e\x05\xde2\xcf\x08\x11\xc2\xdcS\xfc\x92\xae\xfc\xf0\xbd-b-/,\x9e\xaf\xd0\xd2\xfeC#uc\x98\x85\x0bGc5\x03&*\xf9\x8ckC#d\x87\xe6\x90\x0b[\xb0--\x05*\x89T\xe8\xef\xce\xbc\x8dQ-Gl5\x10\xb59\x81\xf1\xb8\xed\xd9\xd0\x10\x0bS\x90\xcf\x00M\xfbr@\xaf\xf9[<\xe3v\x14o\xc4i\x9eP\xce\x1d?\xb0\xe2\xcf'\xd3\xf0\xc9\xdbB\xd0Z\x91\x13\x8d\xd5\xcdu\x8e\xe8k\x04\xf3\x84@\x1e\x05x&oF\x9b\xff\xa3\xa9\xcb\x00\xd0\xc2\xc2\xf9\xb8\xd4\xe6\xc9\xd2\xd5\x19\xf3V\xc3t$\xc5\x081\xf9(\x9c\xd6z\x8d6\xcaJV\x9c\xc5\x1f\x8f\xd9\xc4\xda\xa9i\xb3B\xa8v[\xb0Mw;\xdf,F\xc0\xd5\x9eT\xdb\x890'\x96\x83C\x11\xda\xc2\xbc\xc7N:\xfb=\xc0\x84\x16\xc0\xae=\x9f;\x1fD\x11\xfe\xe2Hv&\xce\xe50\xf0\x902\x87b\xf0(\x0a\xb8\x89\xdc\xc4\xc1\x81\xb5\xc4\xc2\x1a^\x0d\xfb\xff\xecc\xe4\x8fWq\xe7\x8c\x98\x10\xfe\x17H0\xed\xa4\x0c\xbctr'\xb5\xa0\x81\x06\x10H\xa6!\xd6\x13\xe5 BYl\xd2x\x0b4\xb23\xa0\xf9P\xfd\xb7D\xf7\x93\xc0\xbf\x16L<\x18L\xaa]Cl\x0cW\xa2\xbb3\x0b\xb2j]8m\xf1!\xa5O\xf3\xe4\xb0o\xc3,\xe0\xe6\xc1\xed\x94e\xa4\xadG\x18;\x9b=?t\xb8\xb35\xd3\xf2IP%\x9dN:\x85\x8c\x17\xde&\x8f\xe6B\xb2\x9fBS\xb3G\x89\x1c\xe9:\xa8z\xd4\x8a\x13M\x97\xc7\xbbs`}D\xdd\xc9Ji\xb1\x9b\x0bE\x1d\xf6\xe4\xff\xd8;(\xcb\x1b;!\x10\x1d\x14\x84\x8bv\xce9}\x8b)\x9e2M`\x1f'Q\xb6\x86\x0fv9\xa5E\xac\xa5\xfb\xf7W\xa4R\xa2\xc3o{\x87o\x89y/^P\xcc\xe6\xf5\xfc\xa1\xd8\x117\xa7{\x08\xe1'\xf6H\x9d\x8f\x14w\xa6\x1b\x81;\xbb\x82;|c\x97\x95$t\xacR\x8fp\xea!\x93\x8f\xa9+\x9cx\\5\xfbe\x12[WN\xd4\x0a\xf9\x8f\xd3\xca'\xebI\x10\xd3\xfd\xd36\xf9\"\xab\xe4Y\xa3.\xa9\x90-\xdb\x0a/\x14\xed2~\xa4\x99G\\?j\xc7\x18XR\xaa\xf8\xd0\xd0\xc4\xc4\x9e\x9a\x0d\x9c\x01\xfe\xa9\xf46\x0a\x90\x95M\x09]\xe4\x9a\xd1\x0a\xa5\xba\xb4\xe1\x11\xe13\x90\x89K\xf4+\xfd-\x08\xc1\xce\xbc\x8f\xf6K%wZ\xd3\"\x9d\xdb\x98\xbdZ\x1eU{~\x8d\xc2a\xfd\x87\xf3\xe7\x13,\xc7\xb5h\xd9\x8bq\x83\xec\xfd\xf8\xf2\x9a\xa7\xd4\x15\xde\xf6]a\x94F\xde )$\x93\xcf\xc3\xaat\x16\xf4\x8d\xc3E\x9fM\xc3k\xdfc\xf4\x92&\xe3p\xaf[c\xe9P\xd6h\xdajA\x84\x8c$\xab\xd0;EN7\xf2\xd6\xdf\x91k\xa4V\x10\xe7\x1fUG\xe8\xc9\x9c\xa3\xaf\xe0\xc4\x03\x8d\x89\x87'\xfe'FN\xf1\xc5o2\x8dh6P\x9f\xc4\x9c\x90yX\xb4\x1b'\xf2\xe5\xfd\xd1V\xd4\xccN>Fs\xbc\x8b\xfb\x04\xf6\xda\xca<\xb0\x82h\x85\x80\xd8uT\xeeu\xd2\x99\x8d[K\x0c\xa3I\xe5\xfcH\xe5:^\x18\xc3\xa7\xf0\xfcr\xe7\x88\xee\xd8\x7f&\xe5\xa6\x8c\xdb\xd6\x93\xf9\x10E3B\xf6\xfcB\xf9t|!\xb6\xc4\xe6\xa80\xe0\xde18\x94\x1e\xe4\xa3\xa3\x8cmz\xa8\xe1nf\xbd\xc5\x1f\xc8U\xf8@\xbcWK\xdd{\x8f\xba\xc4\x9a\xa5!\xe5\xbc\xc6+\xa8\xe3\xe7\xf3xM\xedj\xc4\xda\x8a*1\xe0\xbb/\x02\\+\xf7p\xe1E\xad\x94\x082\xe25\xf1\xc5KlB\xaal\x82\xd9\x93\x8b\x10\xea\x11\xdb \xc1\xc1\xe9\x9fx/1\xf5\x03\x9d+\xec\xc6\xff\xcbD\xdc>\xd7=-\xea\xc8\x9b\xcc\x90\x97f\xef\xcf\x98HL\x1f\xda\xc7\xa7\x8c\xd6\x96Lx9PpE$\xf6vC{:&\xbek\xbc\x07\xb2\xde>b\xb1\xadC\xd1!\xcc)Q\xe1N\xe6\x1f\xfc\xf4\x825\xf6/\x08\xe8\xc5zRA\xc5OVs\xd1\x19\xff\x0b1\x19\xbd\xa4\xd6\x88\xac\xa7\xf4\x98\x93\x9aC\xa4U1\x0d\xdc\x017\xce\xfc\xf3\xce.\xf5G\xc7Pa\xc7\x12p\x8f\x1dI\xb1\xfd\x87\xbc\x93U[D\xce2\xeb\x9cE\xcc\x09\xb8A{\xe6\x1dU\x95\xce\x9cP\x9c\xf9\x88\xec+>h\x06\xd9Ed\xf2\xe5\xfc\xb5\\\x1bX\x0a\xd1\x02uk\xd7\x9d\xd3\x11\x86u\x97\xc5\x94\xaa\x1f\x8282iX\xab\xf3\xc0\xda\x0b\xe0\x925>\x93\xf0{\xf8\xaeA\x19\xff\xc8\xe8\x7fH\x8b\xb3\xc1\xbf$\xe6?9-&2$!\xb3\xdaR\xdd\x046\x19\xd3+b\xf4\xb5\xb8\xe9\xdcV\x02zt\x94\xb0Ps\x09\xe5\xab\x9cL\xe8w\x90\xc2\xb7AnG\xeb\xdc\xd6\xf5\x9atD\xefN\xca\xeaE\x00\x19<\x8e\x14U\xd3\xde\x1e\xfc\xacp\xaa\x13\xc2\x80\xe7\x89\x8dL(\xfc\xec\x11\xbf\xbc\x19Qi\xb1\xc10\xfe\xcd\x1csY\x80\xbb\x07p1\xe4Qb\x9d\x1b\xcc\xc9\xfe\xcf\xc9\xfe:&SwzA\xa86\x03a\x16|i\xc3Mf\xfa\x8f\xd7\xd8\x05\x8c\xbb\x17P\xba\x07\xc0\xa8{\x07\xc2E\x98&b\x87\xb0\x9c(d\xb6\x13\xa4\x97K\x14\xe4?fs\x8a\xf8T\xb2e?\xdbz\xf1$\x83\x03\xc5\x0d\xef\x8b\xf6\x1b\x1b\xfbss\xb9\x84\xf7\xa2y+-\x86g(r\xe2/y\x8b\x8el:\xd3\x14\x9c\x96\xe9\x0ee\x99!\x92\x93}(>\xbf\xc6\xb3\xd4]\x17\x8e\x9a\x7f\xd7\x19\xdcv\x06\x80\xe1\x9a/\x8c \x81\xa5_v'mo\xca\"\xa3O\xbf\x0b\x07\xec;\x8d*\x89\x15\"\xecf\x0e\x98\xd9\xe4\xb2\x1az\xaa\x15o\x85v\xbb+=\x9e+b\xecm8\xb3\x84\x0e#\xfc\xa2%\"i\xb8I\x03\xa9\xeb/\x90\x85\xcc\xa49\x05\xec\xb3{it`\xaf_\xb4\xef\xb5\\69\xe2\xd2\xe3\xac)a\xd8x\x96\x03ov\xfegr!-\xf4^B0O\xcb@\xe1^\x13\xee\x88\x92\x90\xed\xa0\x05r|\xdb\xdd\x09\x0a2\xc8GX\x1aT\xcd<\xff\x038\x09m\x19\x1c\xb0\xd6\xd8\xa8o+\xc7\xf4\xb3G2\x8d9\xe6\xf2(_Ff\x8b\x87\x1f\xb1a\xa4X\xf0r4\x90\xfe=\x93q\xf7\xb85E\xbd[\xd6\xcf\xa4\x94\xef\xdd\xcb\x03\xe2\xaf\xf0d\xd2\x7fL\xa5\xdb\x12\x88\x9e\xba\x9b\xce\xcb\x08\x16\xa5uD\x8f\xd4#\xd4\xd8?fr+\xf1\x15D\xc7\xbd\x8e\x02-\xdau\x98\x1d\xf7\x85\xbc\x97\xf1m\x141\xbe\\0\xbfr3b\x97D\x99yIo\x854w\x1d\x8b\xfd\xda\x91\xbe\xe2\xa8K\x97\xbbG \xee]\x87J36Y3\x17\x94\x1e\xdb\xa1\xd6\x13[HA#(\xb6\xec\xab\\\x8c\x1f\x12A\x7f1R\x16\xa3\xe6\x92\xd5\xac\xf9:`c\xf3\x1d\x84\xe8\xe5\xb3\x7f\x89\x9d\xee*i\xe0&\xeb6(\xed\xcb2\x04\x7f\xcd\xbc\xc0\xd7\xc1C\xa7'\x0d\x16L\xef\xe2\xa7\xee{7\xaa\xb5E\xf9\xa4a\xb3K/N\x90{.\xdaM*^p\x89\x95\x9d\xc5\x1e,\x8e\x92it\x12c^\x0b\xbfu\xaa\xe1]i\x07\x0aB\x87*\xcdl\x94\xed\xf5\x95k\xfd'\x1bI\x98\xa6\x01D\xed\xc5N\xa0OYS\xf0\x98/\xe8X\xb8C\xd4\xf8\xfe\x15d\x82\x1b\x05f\xb9\x98\xc1\x0a\xa7/0\xb7\xb7\x9b\x18\xc4\x89o\x0c\xaeL\x1eCKFT#\xccJe\xf9\xb5\xb3}/\xf5>L\xf3\xb0\xb6\xb9\x8f\x1ep\x1e6\x92\xce\xed\x9a\xf4I\xb9\xaeKb\x92XJ_\x0e\xc3\x9d\xe1t~\xb5\xa4)}\x9bP\xecp\x8b\x89`\x05\xa4*\x99\xee\xd0\xe1\x8b\x1f\xfe\xbaCYw!\x9c\xf3\x88!\x11\x87\x11D\x1a\xb1C\xe9]v'&\xf6\x1aE\x09\x8c\xa7\x06o\xa6\xd2ak\x127\xf26\xa8OPw7\xe8\xed\xba\x9aL\x8f\x1b\xea\x82\xb3T\x92\xa1\x8e\x9e\xcb7\xcfet .:\xbc\xf0*!\xb8\x0aR\x9cG.\xd0lX7*\x85\"\xf4\xa2x\xc7\xd4\x0e\x15\x91|k$\xc7\xeb\xc5^/\x95\xb5R/t \x98\xca\x9b\x10\x05\xef\x04\xfd\xa3*\xf5!\x8e\xe1\xd3\xf18!\xec\x81\x02k\xff\xdf/\xf3\x06\x97\xa4c0je\xe6Bi\x865B\xe5y\x91\xa2\x9cU\x1ee\xc4}\xbeb\xdf\x0c\x96p*r}\x81\x9e\x86\xae\xb6\x8er\xaeP\x16;`\xf3\x0bG\xe4\\)a\xa1\xce\xf8;O\"^\x0e\x93\xf3\x80\xbd\x98\xb7`h\xe0%,\x08s6b{&\xa7\xe5\xf8\x8f\xd5\xf8pQ\xbd\xd830\xeb^P&\xc8I\xaa\x9dD\x9b\xeagP\xd5\x86\xc5\x88\x9b\\s\xc1K\xfeK\x88\xe8?\xd0\x95\xc9Uu0H\x80@m\x01\xbf\xb6\xc8\x04\xd1x\xad\x1b\x98\xb1\x1a\x94Ny\x14\x89pI\xedIi\xa7lD\xb3,\x8b#T*Kz\x7f\x86\x94\x8c\xbb\xe5[P\xf5\xe6h\xe1\xa1-\xeck\xe7\xad\xbc\x95\xec:mE_\xd8\xe0\x1a\x0cjNB\xc6\xd7b\xae\xe6\xe2\xbb1A>u4'\xff\xc0\xfe\x0a\xac\x03\x88\x82\xed;\xde\x98\xa3\x84*%\xfau]\xf8d>\x0a\xd7j\xf4\xbbS\x02\xfc\xb4\x9b\xa9\xc3\x9b3\xa7!\x1a\xc1og'\"\xc8@I\x06\xff\\\xa3\xaf\x02g\x9f~\xe2_\x13\xec\x8c\x0f4\x02xH\xe1\x85\xd1\xfaX\x90\xcb\xee\xdbB\x1d\x8d\x1e\xa5\x1a\x92\xfbY\xed\x86\xf7=\xcf)\x15\xa1^?\xf0\x94{\xf7\xf9K\xbb\xe2\xcf\xb7\x03}\x8c+>\xe9\xd2\x1c\xe9\x14\xa1\xe52\xc5\x88\xa0\x86\xb6\xc7n\x84\xb8E\x07\x96H\x0eG\x84\xca\xef?=\xb9\x8b\xaa\xdbhV\xb6wy\xd3\xebH\x008\x0b\x02\xc0\xa3y0\xa7O\x06\x808\xe6k\x02@\xb4\xcb\xb4\xdbc\xa6D-t\\@\x1ad*\xcd\x8cWX\xa1\xd5\xc0&\xa7\xf8O\x97l\xab[\xf0\xda\xc1\xfe\x9eS\xdd\xc7W\xc3\xbe\x88k\xd40\xb1\xa0\x87\x87\xb4\x8e>\x8c\xe3\x9f\xc2qG\x9a\xb8\xef\xd8\xdfK\xea>\x8bC\x0at\xc9N9\x87H\x0c\xf4\x14k\x9a\xd5\xd0\xa2^\x08.8\x8a0r\x04\x9fZ\xc7\x8f!\xefT\xa8R\x83\xfdyT\xa0=D\x99\x14^Q\x126-BqP\xd7\x16\xc3\x81\x12=\xa6\xf3\x11\x0d\xc3!\xc0\x9b :\xc2G\xf7\xdb\xc0\x80\xce\x09\x0fz\x13\xbf\xd8y\xd8\x81\xff`\xcd}\xb2\xc9\xaf\xcd1\x1d&h\x02\x80\"qj\x09F\xe4\xb6\xc6.A\xabSl\x19]\x07\xa5\xb2\xf8\xef\xbd\x09\xe9\x85\x0bV" +
+	"R\x81M*CB\xb7\x0f\x11\x024\x88?\xa2C&\xdf\xfc\x9b3p\x94O\xb8m\xd7\xe6gN:\xfb\x81\x8bo\xd0\xe3\xb1\x98\x84S\xbaF\xf4@\xfb>\xd3 \x14\x94)\xdd%_0>\xec^\xe9\x84S\xc5\xe9lk\xbc\x88?\xeb_\x0c&\xf3N\xec\xe3\x86\xebv\x15(e\xa5+)\xf6Q\xbfqvun\x97\xfd\xdf\xbf\xceI\xbcDG\xdex\xc4Y\x9b\xbfA^\xeb!\x12\xd2\xe0\xfa\x82\x84#\x88\xa5\x12\xfbMS\x7f\xc9\xa1\xfdgO\x16\xc3\xe6\x12\x1b\x16R\\\x9d\x01K\xa8\x06\xca\x05;\xa9\x11i*Zg,;v+O\x0e\x98\x85?\"\x8e[H\xaa\x83\xe4v\xe9\xc7\x80\xc4\xe2\x97\x1d]t\xe6*\xc4\xa1\x84\x82H\xda\x9b\xea\x9e\xf9\x01\x1e\xb8\xe3DG\x18t\x12\xcfe\x91z\x17\x85k\xbe\x06\x0c\x13\xf1}\xdcH$\xc8\xc7\xd0\x92\xfa\xf6\xa2\xc8\x10\x86\xbb[\xcen\x99^h\xe7\xbb\xb5\x92=(\x08\x0a\xa9\xe0\xfa\xca\"U\xdc\xf2\xee\xfcA\xa3\xc5G\xa8\xe6\xc4\xfd\xe9\x95_\x00\x10\xa2s\xed\x8b8\xb5w\x99\xf7o\x12\xac\xc4\xa2p]\xf6\x7f\x7f\xda\x01Ha\x11\xcb<\xcb\x922\x84\xab\x80\xa2\xdeb\xafc\x9b\xe1\xfd\x03\xe7\xfeR\x8d\xa1\xe8=h\xbb\xc9\xfe\x1c9\xa7\xa6\x8c=s\x0c\x9a\xbc\x19\xbaq\xa3\xd20\xc9\x11\x18\xe2L\x911q[j\xb3G\x1b\xf14}~\x85\x00\x9b\x859K\xb1s\x05U\xa7=v\xe7\xea\x08\x9fsz\x1b\x16\xf7\x83\x09\x9e d\x7f\xeb\x8a\x87\x008\xa5\x86%\xc6\xda\xf9\x14C\xc8\x01\xeb\xd1\x00m\xcaY \xbf\x85\x0d\xac\x95\x92\x7f\xe8_lg\xd3>\xb1\xd2\x026?\xa9R\xe7\xb8h\x01\x11\x9e\xc7\xae\x81[2@\xb6A\x0b\xfc9\x07!\xe0)\xb8\x16\x19\xe0\xfa\xbf\x9b\xf7\xd81\xaez\x8e\xe1\x94\x09\x07p\xc6f\xd7aodk\x174\xd7r\x8d\x13\xbc\xb5p\x1f\xa7ok\xa8E\xa0.%y\x16\xfd`>!\xd0\x9f\xf3\xc4\xf7\x05#^\x89U\xc4\xa6z\xae`Q\x92\xbcO\x96\xf8\xaeh\x15\x07D\x94\xa2-\xc7\x1a=\x8b\xd2l\xf7\x05\xa2\x99\x89npn\xab\xd8\x90\x89/\x19\\\x13T!C\xf4\xf9\x17\xef\xf6\x9b9\xd8\xfb\x04\x7f7\xaf\xb1SL\xc3\xe0\x15\xce\xa4\xdb\xef\xae\xdaE\x9a\xb4}\x87\x99\xf5\xc7\xc0\xee\xde\xf6\x0d\x9a\x87\xcc\xb7W&\xbb\xd9\xc5\xbe\x8fl;\xbf\x0aB\x85\xb6v\xa2\x14\xb2Yl\xf5\xce\x13\xe4\xef[\xd4P\x83\xe7\x0e\xfb\xe7\x9d\xb8\xec\xf2\xc4\xfa\xa5\x9fa$%\x9f\x07^\x0b\xa4\xdd\x9aH\xcaC\xb9\x91>\xe9\xd7/\xc8\xcf\xab\xf0\xc4T\xa6Z\xb0q\\A\xae\xa2?2\\\x92\x1a\x14\x1e\x9a\x0e\x1bx\xcbx\x11$\x16\xdb\x9a\x88=^\xef\xe3\x9b\xe9\xf7\x19\xad\xd8D\x81{\x14\xf0\xb6\xc0\xff\x06]\"\x80\xd3\x90l\xdax\xd3#\xb8BXi,(\xf94\"\xbac\xff\xc5i\xb7f\"\xce\xa3\xfa\xd3z\x14yX\x89\x12\x01g\xfcv;g\xc3E \xd5g\xfa0\xe4\x93\x95\x8aZn;dn\x05Y\xcfD<\xaacRw@u\xea\xa7\"s\x7f\x8b\xcf\x85\x9d*2>r\x14r\xc1\xff\xab\xccK\xde\x17N\x8a\xc0^e\x83w7\x88K2Vo5\x1a\xaa\xa7~C\xc3\xa8\x8e#wa\x9fr~nM\xf7\xae\xd0\xbb\x89\xf3\x06\x02dt\xc6\xca\x09\xe9\x91\x8e\x0dZ\x1d\xde\x8dHn\x03\xe2\xb7~1\x81\xab0W;8\xfc\x07\x92\xbf\xd9\xb5\xc4\xe2<\x1f\x11\xd5\xbb\xc9\x1a/x\x0a:\x8f\xec\xf7\\q=\xb9\xff\x08=\xf5\xab%\x00\x1b\xb0\xc9A}:\x90\xcbL\x1e\xa7K\xf5\xf1.\xa7\xc1I\x96Q\xad\xd3\xdb\x13\xdc\x96\xc6\xedK\x17\xe0g\x17\xfd\xc2d\xba\x81G\x8e\xe2\xf9\xfdl\xe2\x15\xae\xb0j\xef^*{\xdd\xe6=6t\xda\xf7\x8ao\x18\xf9\xfe36L\xc7|=\xe7D\x81\x9b\xd8\xe0\xb9\x92\x87\xf8\xe9,\xf2\xd1\xa7}\xc4\xe7\xe7b\xfc\x8f4\x80\x9ewpZ\xc0@\xb2|\xcf\xa1\xd2\x09\x95Fs\x15\x8a\xa5'\xff\xb4k\x97\xe9\xc2D%WM\x1b]\xbb\x88\x0f\x0bI\xff{\x9b\xd6\xb3\x0c7(\x186\xee\x1fY\xae\x7fz\x11\x8a\x1ca\x1c\xb2\x9aAPAJ*o\x11!\x8e\xa9\xb7/\xb3\xe0\xf4\xb3i\xd2\xfa\x1d\xd3\xa0\xda\xf5\xdcn\xd8-\xf7\x8e\"\x1axY\x005\xfb\xd1\x7f\x88Y`+x7\xf2\x14\x05\xfa\x07\xcd\x83*\xdb\xf0\x91W\x83\"\x07\xcc?q\x85;\x9bc\xff\xa1\xae\x03\xaa\x18b1\xac\xd7\x88t\xeb\xda\x12k\xd35\xfc\xc7]\x9a0\xff\xe0T\x89`\x19\x02h\xe4\x82UM\x16\x10x\xdc\xa5y\xf1\x0bFM9\x1d0\x90\x89x\x94\x0dS\xe4\xac\xd9\xab\xdf<\xb4w\xc9\xa4\x16{\xf1\xe6,\x14\xea\x86\xa3#?2\xaa!\xe8\xab\x95\x15u{\x97\x0cU\xaf<\xbe\xc5\x8e\xef\x7f\"T\x85Xx\x8bY\x84B\x92\xaaGwP&m\x98\x03n\x90\x84\x82\x8f\x87\xbb\xd1\xdf\xb1\x84\xf4\x0cd\xb1\xd3W0,:\xda\x04\xe1\xa5\xc2\xd1\xe4m\x81\x00\xd0\xe6\x05\x8f#\"\xea\xb1b\x956}\x06\xf42\x0b\x00\xf1N\x9f\x842\xd1e`\xe9\x8b\x0f\x82kP\xc3\xc0\xa8\x92\xb1\x0a+n\x16\xde\xb3\x81\xef\xa2\xad\"g\x98u\xf1\x10\x9e\xddt\xd0\xd19\xdb'l\xff?\x86:\xbf\x98\xc55\x02\xcf\x98K\xf4\x87\xcc\xbb\x82?[\x14\xd9\xc22\xf8\xabk\x1b\x97\xb8hm\xcd\xd9i{\x01\xfb\xe1\xd1I\x86\xc6\x7f\x8f\xc2\x0f\xe2\x8d\x92\xa3\xf3.\xc5l)\x8d\xbd\xc5\xf0\x9d\x19\xdahV\xfe\x18\xfd1\xd7\x1ene\xc1\xd4\x0d,\x1f?\xb3e\x9b\xcbm\x1d\xb2bE@\x0a\x1b\x9dQFo>\xa2\xe7\xb9\xd1,^\xac\xcd\xb8\xd8B\xf4-\xc5r\xa8\xa1cb\xd9\x86\xd9\x9c{\xac\x0e\x13\xd19z.\x0fj\x81\x03\xa8\x1c$\x96?\xf6\x01\xa8\x9bFd\x07\xaa\x18H\xd1\xef\xd1\xbcpA\x16\xc13nn\xa6Z\x88\xf7REb\x1f6\xe5\x0f$7\x90\xa4/\xce\xd8\x03)\x11{\x9e\xa7S/\x88\xb3\xb7\xf1\x96\x03`gt\xe4$\xc8uw\xc7>[\xef,\xcd\x8d\x96\xcf\xa7!}\xc8\x17>\x17&\x07\xd8\xc0\xc5\xba;\xa9\xa3T\xe6\x90\xbatM\xa1\xb6\xbd\xa6\xef\x01`8`\x18\xeeP|\x86+\xffm\xe5}\xf9\x15\x03\x0dy\"/\xd0\xb5L\x9c]\x97\x9f\x8fN\x9a^\x89\xd8\xff\xc4yO\x9ca\xb8\x89\xd0y\xf5\x14\x94\xf7\xf0y\x1e\x89Eo\xb7\xb6?\x83{g\xfe\xa13\x0bX\x84\x81\x19@\x0d6\x8d\x93\x0fF&h\xea7i{U\xf9\xaa\x7f\xe4%\xa9\xbfl\x1c\x9c#z\xb8k\xef\xe2\xcf\xed\xd2\xbf\xe7\xb8~\x99]\xef\x0a\x06\x96\xf3\x8f)\xdc\xd6Mw\x11[\xa8n\x17j\\\x9c\xdd\xea\x9f\xa5 \xb45>\x18}\x83\x15\xb2\xab-\xed\xfe\xc6\xb8m\x850\xaf\xb9\xbdu\xc0\xda;\x0e,\xcc\xbf\xf6\xff\xb8\xf4\xf7\x10\x9d\x16\x93o\x1d\x9d5>,\x0a\xdf\xc9\xbfX.\x88\x005\xb8\xed\x88\x1f{\x86\x92\xc0\xfa\xdex\xa9\x04\xeb@\xc9\xc7\xa39\x8f\xcc\x93\x9d\xc4\xb0\x1f\x92\xad&\x81E\x0fN\xeb\xe2\x0c\xa4\xffS\xf8\xaa\x9d\xdd\xb2!\x8c\xacWt\x13@\xf0\xeb&\xfe\xdb'}\x0d\\\xe9\xd9w\x16K\xfc\x03n\xa2\x0c\xb1J\xad\x9d\x93iw\xed<`(\xf2\"\xfdI\x02\x16\x07@eV\xcf\xcd\xd1\xbc\x07x\xda\xa0\x85\xa6\x14\x88\xffA\xcd{j\xf5\xa4\xe2\x0eO\xce\xbc\x1f\x1cl\xff\x9f\xf3\xe7\x7f\x8b_\xf1U \xb7\xa8Qs\xf67\x9c\x1d\xb7\xaf\xad\xc8\xb1n\xe3\x84Y\x92\x18\xe6\xc9u\xf8\x18\xed\xbb\x0f\xcf\xac\x8e\x14\x09p\xdf\xe5B\x00K\xaciw\x80\x0c\xc2[\xe5\xad\xf8LCe\xac\x0a85G\x0e\xd8\xce\x8c8\x96I\xf6j\xbe\xe3\x1f\x95\xd3\x9c\x8dL\x1f\x9c,\xe0\x1e\xfe\x16\xbd\x84\xaf\x87^N\xbf\x85]\xf9=\x1em@\xa9\xdd\x07\xbb\xe9d\x0b\xd7\x10x[c\xb8\xe3\xa9\x06\xb2!s=\xe8%C\x07\x0e2\x05\xd6\x0a\xed0\xd2\xb7L.\xd5\xd2M=\x88\xe7H\xcet\x1d \xeeN\x14Y\xef\x13YS\x15~\xd7\x9e\xf7\xd4.\xbe\"\xedi\x17\xf2N\xbf\x91\xe9? \x94\x17Y\x968\x83(p\x99\xdc\x13\xe1<C\xfb^PI\x064\xdap\xd8\x02\xff3'\xd9K\x96\x1dKD@Qk'\x97\x19\xa0v>\xadRk\xf3\x1c\x19q\xdd\x89\x89=\x82\x93s+\x19\x84\xcd\xa3;\xf3\x88\x95\xfe\x0f\x8c\xfe3\xe5w7R\x11\xaa\x01\x83\xb8\xcf\xd1\x15\xd4P\x01\xc9\xaf\x019\xb0\xcf\xd1\x10\xd4P\x92!\xb2\xdd\x9d}\xff\xc0\x89\x16Aw\xb2\xcc\xa8\xbe\xd5?\xc4\xbd.Z\xc3\x0d\x84v\x8b,\xe0\xae\x07\x994\xe8 ^\xb8\xd9\x164\x08\xcb\xadE\xd6~z|\x15\x16\x89\xd6\xec%\x91\xb57\xbe\x0e1j{\x91\xd5rU\xe2\xbc\xa9\xb0\x00d\xd6Ru\x1d\x803\x18\xdf\xfe\xd1\xef\x9e|\xeb\xfcn%\xc2\xc4\xbe{\x04\xfb\x889g{\xb6\xc2\xba\x0cBh\".\x03\x94q\xb3\xdd\xb0\xadC\xf8\xf8\xb4\xae\xed\xd4\x83\xe2\xd9\xe0\x9eB\xe9\xb5\x839\xa4E\x1f'\xb3?\xbb{\xf3\xee\xddS\"P\x84\x8b_Q\xa3#\xc3\x87\xdc\xdaU\x9b\xa2EV\xf1\x8a\x8d\xba\x0ea\xb1A\x12\x01\xc9\xa8\xad\x06`\xc1\xa8\x04\xac\xc6\x9ek\x04L\xe0^9\x027\x09\xce\xeds\xf1\xcao\xdd\xa5[E\x1e\xd1\x16\xcf\xfc\xacK\x96z\xd8cN\x0dH[<bVl\xb7\xf7\x886\x7f%\x8dz@\x9f\x99\x87\x11(P6\xe9h\xa1@\xcd\x03!z\x88\x8cR\x87\xa5\xdc\x7f\xa4I\x07\x9d\x05\xb5\xe9\x12\xda\xd0\xb0K\x9eX\xc4}\x19i\xe5z>\xbf\xd4\xcdx$\xac\xf3~\x04\x1bt\x0eQ\xf6\x1bO\xc8\xd5\xe99\x8d\x81\xe1\\q\x80[\xbc\xcc\xab\xdcVNt\xf1\x99\x18\xd2\x84w\x82\x9a\xe3s\xdc\x16\xe5\xfa\xcf.\xff\xa6\xff\xf2\xdd\x86\xc1=Ky\xeb\xab\xec\xb2\xeeU\xeb\x9d;\xadv\x19F\x07Y\xf8\x8f\xbcz\xed\xef\xfc\xb0\xd9\xb8SVU\x1e\xcc\x18\xc6R\xa9\x06\x05\x80\xeb!n-\x0b\xf8\xf5+\xc4^\x96\\A\xb5\xb9\x7fV?v\xb0\x1b\x96\xaf\xb5Y\xc6\x81ua}Zm\x83\xe8f2\x12\x95\xf23\xa2\xf7sx\xb5\xdf\xb8\xcdW}?\xd0\xf6\xc2\xf6\xac\x0b\xe1\xaclP\xae\x0bW\xce\xc7\x84\xfa\xf3\xec\xcf\xdei\xdf\xaf\x85\x9a\xe7x\x1c\xcaI\xc0\x04\x96\xafOJ\x83\xab\xb8\x0a\xeb\x9fE\x0a\x9e5l\x03\xa1\xa0\xf3\x14n\x98\xf0W\x87\xa6A\x91\xfc\xe8\xc6\xcb\x8f\xd6\xa61\xf7\xf1\xcc\x08v\xf3.J\xf3\xaf\xb7\x82\x04\xda\x09\xd7\x935\xc9\x0a\xf5\xfeG\x9e\xac\x89\xdds\xf7v\xfd\xc9\x8a\xe2\xaeX\xc1\xaf\x1e&KK\x0d\xc8a\xa3\xae\x86D(%k\xadg\x04\xa7t\xb2\x81`\xf3\xe80\x0f\x93$\xd5\x0dR\xf6$E\xfc\xac\x0a\x94\x0am\xd6(\xb2\xc8\x0e\xabQ\x15\xcco\xbd\xc3u\xc5\xa8 $D\x96\xad\x1d\x0cgf&ap^\xf3\x8d\x02\xa2\x1badV\xb5Kw\xf9\x17\x92\xc5\x8a\xde\xbe\xf1\xb4\xffBDPt\x84\x85\xec\x98rg\xa8b\xc4%C\x8d\x1f\xbe\xa0L\x08 I\xb1\xfc\xf2\x90P\xde\x1c\xcf=\x9f\xd3'\xf4\xf3i\xd0y\xaa\xc1\x0bJ\xea\x93zz\xaa\x04\xbdF\xf7\x1eh@\xee\xf2\x18\xa4\xee\xb6\xee\xcc\x81\x88M\x9a\x93\x03\x12\xb7\xf8\x88\x8d\xea\x02\xd0\xeb{\x168Y$^T/\xf3Z\x00\xa4\xa8\xebU*d\xf6-n\x95\x11~\xb5WH\xc7&^H\x80W\xc7:\x9bS\xfcHA\xc8\xc0\xca42\x1a\x0aLo\xd1\x17o\xe2YF\xb1\xc12\xdcc\xf3O\xec\x9cS\x1dB\x9b\xc6<\xe0\x08\xa5\x7fs\x80\xc7\xd3\xe4*\xd2\x05#r\xab\xb0\xe3\x02\xcdd-x\xfa\x9a\xba\x09\xf3\x90$ \xc6E\xa3\xb6\xb2.\x81Q\xd9_\x95\x90\xa9\xe2\xfd\"\xfe\xfd\xeb\xaeM\x94'\xca\x85\xfb2\xa9\"\xb3\x91f>\xcd\xa2\xf4\x07\x7f\xb9\xe6\xff\xc1_\xa2\x87\xd0\xef\xa4\x96\x89A\xed\x18F\x1603(W\xb7]\xf0\x93`\xb5Uj?\xb0\xae\xb0X\x9d$.i:\x0e\x05G\xbc\x18\xb6\xb4\xf7\xd6\xbc\xf4\x0f\x9d(\x02\xc0\x91%\xec\x807\xdd\xae\xe3'\x9cz\xf7\x00eq\x1b$\x0f\xc4_\x19/\x83\xe2\xee\x1e\xc0RbO^%M\xec\x96\x85\x86\xf4\xfa\xb5\x15\xc9\x1fN\x8eROo\xda\xf3\xee\x95N\xb5\xc0\\\xcc\x07\xf9T'\xde\"\x97K9\xdbD-z\xddVz\xe7\xa7\x82[\x83&\xc1\xce\xea\x9dc'\xfai\xd8\xb1s\x12\x01\x1b\x90\xec\x8d_C\xcf\x82\xbb\x94\xb5\x9b\xdc\xde\x9c!\xb3P\xf0\xb0\x00\xcd'\x05\xe1c\x16\xb9\xf2T\x9d\xb6\x92_\x06W\xcb\xd2\xbd\xe8\x1f\x1e\xbb\xba[GJ\xee5\x9b\x00G\xa5!\xfd\x8f\xc4\x9a8\xfb\xd6\x01\xde`%\xda\xc5A\xae\xa9\xe0\xcc\xe1Fj\x87\xb2P\xcf\x91\xf4\x04\xfe3R@dd-\x1dq2W\x1e\x14\x9b\xf1<]3\xe0\x15\xee\x1e\xc1,\x88\x04\xe8\xb7A\x80n\xe7\xe8eP\x03C}w\x84*\xce_\xd2\xc0\xd0\x9d\x88\x0f\xd7\x89\xf8\x18\xd2\xc0\xa0\xe9s\xc0k$\xad\xa3|\x03\x03\x87\xb5K\x196\xb4\x81\x81L@\x8ex\xca\xacCD\x9f\\\x0e\xc4}\x16\x83\x96ld\xec\xb9\xf8\xec&\xf2\xce\x1c#\xc3n\xb4\xf4\x9b\x8dWC[\xf5\xe8\x09oU\xd5R\x1c]P\xc8\x9b\xbc^\x06q\x07(\xcc\xd2\xbe\xfc'\xe4\xf2\x07\x0a#h\xd5t\xca\xf9\xfbo9S^1\x7f\xf7')\x18Q\xc4\x9a1\xf0Wz\xcf{\\\x19\xf8x\x06\x9cI\x9f\x12\x10j\xdf\xab\xbf]+\x0a\xea\x0b\xc8{\x0c\xf7\xe6\x17\xb4\xfcF\x087\x10\xb1l\xf2|\x01F^B\xf3D\xf2s(&\x9f3V\x82\xf6\xa4\x081\xc7u\x85{\xbc\x0d\xdf\x14qp\x84b\xfb\x19\xfb\xea\x19\xb3\xc1\x9d\x8f5\xd6\xf2GD\xf0\xfa0\xbf\xfa\x0fr\xe3\xbbp\x8c\xe0\xb7\xd1SqpV+)\xde\xae2.\x84H\xd0\xb27C\x8d\x16\x01\x0e\xbb\xb2\xbf\xb6w\xe9\xb0|\x81@,^\xae\xfeA\xc8\x940n\xfb\x87k\x84\x9a\xc0\xb4A\x08j\x80\x86\x81H\x12\xd4\x00\x1a\xb9\xcbt@\xcc\x01\x00o\xd0\xfd\xd8E\xa0\xd0}\xe2\x05$\xf5+~&\x18\x1c\\\"\xb8\xec\xc9\xdc\xbb#\xa8\xb1\xe6\x1a\xf5$4\xe6\xb1#\xfcb\xeb]:\xe6\xbe\x97iOU@p\xc3\xc2\xc3\x14\xdc\xc5\x8f>.\x85\xf46[.@\xa2J\x1e\xe9\x8d\xecH\x8fY\xa7iD\x11\xdbd\xf5\xf2m_\x0e\xa1\xbd+\xe6C\x9c\xdb*>\xd4{\xff\xcc/\xf3a^\x09\x95\xc2\xc0\xba-\xe7\xd1\xef\xe6\x10=\xce\xf4[\xb0{\x89\xdf\x86\x85(\xd0\x8d2\xc7\xd4\x11\xf4\x12\x89gTR\xda\x88^a\xa9\xa3\x93\xdd\xbac\xc7\x99D\x8bA$i\xb9\xc9\x85\xe4%d\x1e\x17e\x1cS\x11\xbe\xdd\x9e\x16*<\xe9\xe8|\xe1\xf9<q\x9a\xfc\x81\x94\xba\xdc\x8cT1;\xc7Y\x8cy\xe9\xe2\xbe\xb1\xder\xc9\xf0\x13\xec\xbeiN\xe6\xc5%\xec\x10\xbb\xa8\xd7\xf5\x94\xa1%\xd8\x99$\xf6\xde\xf1\x05q\xaaKj\xffXY\x11\x8eVk\x90\xb4\x82\x7f\x1c\xa1\x10\xbf\x1b\xd2\xcf\xf0\xafv\xe3*\xed\xdb\xc8\xb2>\xfb\xd6\x91\xa4&)\x1f\x86\xf4\x92\xf4\xf1\x89\x0d\x09lJq7\xd2\x84Q)\xb1nb'\x19\xf7^&\xa2j\x87\\\xe4$da\xc2u\xac\xdc\xa9\xed\xf3%7:fg\x96\xc9\xb7w\xa6\xfb\xcc5\xf1\xa4\x99\x7f\xcd\x80\x94\xd8\xd8Y\x84*T\xdaC\xd8A\xb1\x81\xf3.8\xc5\x9d\x16Q\xe8n9\x8c\x1f\xe6\xaa\x87q\xee\xde}\x07\x89g\xeaE\x01\xe5\x1be,bUWp\xb3\x03\xa5\xd4`\xa1\xea\xcf\x1en\x9c):(\xf1\xa4J\xfb\xac\xd4#\xec\xa5\xbf^\xd6\x870\xe1\x11W9\xb7\xbf\x95\xfd\x93\xacK\x0fGz1nE\xcc\x93\x8a\x85\x1c\xbe\xac,s\xcbI6\x06\xf5\x07\x14\xbb\xa7\xf7\x10\x1aR\xe0\xa6\x18\xcb\xc2\xf5\x93\x93\xae\xbc\xa5d\xed\xf4\xaa\x80Vv\x97\xd1\"+=(\xe9\x96\xb3\xef\xd8o\xbc\x89\xac\x02x\x87W\xa9xEW\xa76m\x95\xda\xcb\xc7\xdf:\xbf\xc9\xce`\xf8U\xf6\xc7\xf5\x0f\x1a\xc7\xac\xb4\x93:\x02*\x03\x18\xaa#0\x9e\xfaZ\"\xa0\x95 \x86\xb0\xb9\x00\xbf\xb4\xb1\x04\x84\x82 \x19\xf11 \xb2\xc7\x96\x1bz\xc1\x9c\xe3\xb9\x90\x9e\xee'\\\x8d\x1bs\x8a}\xfbZe>\xfb\x1e*7\xad:(p\x94\xa1\xf8ba\xa6\xad\xa5\xe4\x88]\x9f4\xa17 W\xd1\x90\x91\xe0y6y%1\x8f%\x85\xc2\x90\xfd\xc3\xc6!\x17\xb1d\xd1\xf7\x05\xe0\xdf\x9a" +
+	"\xd8\xc0 .\x17\x08.\xbc\"[2\xdc\xd9\xa4\xc3s\xe6\xb6\x8b\xaa\xb0y\x97\xdb[\xc6\x86\xcf<\xcbH]\x11R\x0f\x89\xf7\x15\xe2\x0b\xf5\x1fI/*kf\xac4\xda\xd6\x02~\xcc[\xac\xac|\xe5\xdf\x82\xd7v\xa9\xc9r\x14\x8f\xbefK\xe44\x95\x1b.eKq\xff0\xa0$n\xa7?7\x9a\x96\xe6}@\x05\x1c*\xd5\xd0\xcb\xe1\xf6\xd4\xd3\x00\xac\xb2\xaa5\x03\xff-W\xd6\xa6\xf6\x80M\x09\x08\xca\xde\xe4\x82\xe7\xdf\x0c\x82\xa7\x99 \xd8\xd6a\x9e\xfau8f\xdd\x82\xec\"\xac.\xc2\x04\xb6\xfa\xa4\xed&\x1d:\xc3\xba\xcf\xc6\xafM\xec\xfb:Bf\xb3(B[cct\x93\x13\x9d\xeaW\xa9\xd1,\xebW\xc6\x8f\xff\x8a\x1f\xf1&\xc9\xc4/\x13\xab\xa9*\x14\xbf\xdb\x1f\x802q%\x91Lb\x8a8\x1e\xe6\xa7\xe7\x94Z\xda\xee\x97ibG\x19\xab\x9a\x1e\xe98b\xf9w\x1f\x1b1\xe93,\x17J\xdc\x0a\x7f&wO\x1fs\x0a57c\x8f\xf6\xbb\x87\xf2\x7f\x9d\x92\x8d\xd5\xb8b\x12\x13Az\xcb\xfeK\xca\xbf}\xa8k\x10\xb70G<g\xb2\x0a\xdc\x03\x9f\xe6\xc6kFVV\x8d\xbc\xa0\xed8tf\"`\xe8y\xff\x15[rRe^GX\xb5\xe9\x1dy\xd5\x7fN,[\x83D\xffcd\xe4u\xe4.A\xccq\x1e!\xd3\xbcw\xe4M\xff\xc8\xb2\xad@\x80\xf9h\xdf\x9f\xb3\xf9\x1e\xf9\xad\xb7~\xb0\x02\xce\xce\xa6!\xad\xcfO!\xda)\x9cZGoN\x0c\xcf.\xad\xf7\x15\xf4\x02\xf69\x88\x86\xf7\x1a`*l\xa3\xd7\xd8Yco\x10\xa4\xa7\x00l)b\x00\xfc\x99'm\x09\x1d\x1c\xcc\x89\x19\xf2~\x00*\xea>]\xdf]\xf4\xa91\x99'\x1b\x0d\xa4\x8b\xbbx\x99\x15\xbe:\xb8\x1eD\xc6\\G5\x0d1\x1b\xb4\xb7\xe7\x9c3]\xea\xaa\x1ab\x02t\xce\xca\xc0#s\x8b\xfaY\"\x8f\xbf2\xa7\xd8\x92\xf0Mu\xf3jd\x8a\xfe\x10\xed\xedg\xee \xd8G\xfa\x9a\x84\xa0\x8f\xd5\xf6l\x1bJ\x97\x91\x04\x8aY\xd4:gQ\xb4\xcf\x91\x17\xb3E\x08j,\\\xdc\x01\xb6@\x8f\x18K\xe0\xe3\x8a;\xa0O\x96\xbcCG\xb1\xd5!w\x02\xf2*\xcd\x1fFg\x1d\xd3\x98\xf7(|r2\xef=H\xc3O\xe1\x95\xf8\xbbhs\x18{\x0e~\x12Z\x93\x9b\xa9\xad\xfe\xc7\xee@H\xbdk+):P\xde\\\xc0\x93\x13ZV\xcev'\xa5Ry&\xccA\x99\x83M|\x96\xaa&]\xf6\xce\x85F6V\xdf\xbf\xf4\x9e,\x14vC\xb7V)[\xefh\xe4\x7fa\x83]!d\x18\x7f\xe9\xda\xec\xcd\x03\x9eu\xe8\x16\xcb\x9b\x16\x05\x1e\xc3\xb7X\x9b\x97\xa1\xce=\x98WF\xc6\xce\x93k\xabk\x99s\xc1}\x9fQo\x0b\xd4\xef;)\xf1/\xed\xb0J\xf8\x81\xf8\x0au\xdd\x01\x8a2\xc5\xc6u\x10\xe0?/@\xe6\\\xa5\xd9f\xe8\xafU#\xc4\x814']:z\xb2\x0f\xa9\xf7\x10\xb7\xfdBX\x8eg\xc1\xebNL\x8e\xd3\xdf\xab\x1d\x1f\x93\xdc\xdf\xb1E\xfb36x\x1c\x16&\x88,mC\xe9\xd8\x18]\xca\xd5vg\xac\xe0q\x89\xc2\xca\xc7\xd3\xa4\x1eI\x01\xb7\x919\xe99\xfc$\x1f\x81\x8c\xc9e<G\x0c\xe9\x1d\xbb\x061')\xb3\x0e\xd0=\x1d_u\x97\xe3\x92\xaa_\xf0\xe0\xac\x93\x9d\xf9\x1e\xbfo\xd7\xc3\xf9t\xf1\x8b\x08\xf4\x8e~\xfb8\xb7a\xe6)\x96\x09Bv;\x8f\\\x83@T\x7f\xb8i\x08\xda\xa8\x01R}\x93\\_C\xbb\xb3\x8fva\x9fWR\xb1\xa8bh\xbd?\xe0\xef\x17\xa3\x95Z\xb7\xacr\x80\xc6\x13\xafo\xd9\xba\xa1\x91{\xe6\xbd\xab\x8a\"\xc9\xbbk.\x91\x8c*Vu\xad@P\xb1]\xd3'Pv=G\xd98\xba\x88-v|\xeb\x91\xca*]'\xa1$g\xfd\x87\x1ch\xdd!\xac\xe2\x88\xfa\xab\x14j\x8c\xefF\x91\xd8p#Y\x90\xab\x05\x0b\xf2\xb1\x7fB\x9f\xcdc\xe4`7Y\xf1\xfc\xe8P\xb5\xf0\xd2\xb9\xed;\x8d\xbf,\xb3\xcc\xfe\x0e\x91u\xdc\xf3\xaa\xb55\x8d\xa9\xd8\xe30?o'\x9dN\x88\x13ov~k \xe3]\xa2\xa4\x94\xbd)\x0f\xb1\x05q\x1a\x862{\xa8\xe3\xdf9\xdb=\x1f\xe5\xca\xe8\x04\xe8\xd6}\xa0\xb5N\x96\x89;\xeb\xdd\xe3N\x9e\x02g\xa0m\xbf\xf3\xe0\xba5\x8a\x90\xc7v\x95yo{A\xb4\xa0\xc5\xc8\xd1{b\x96\xba\xf4\xd8V\xf1\xa3^u\xe4-\xf1(\xe4\xf1\xdcV^~:\x80\x81\\\xd3\xddcS\x09\xe1\xa7|\x86c9\xbe\xc9\xce\x9f\xee\x96J\x0fs\x00U\xb9w\x12]h\x95Xg\x94<\x87\xcc\xa3\x89V\x81x\x05\xf4\xcf\xc6\xe1\x97N\xf1\xacd\xf2g\xa1\x83\xcb\xeb\"*NT\xc7\xcf2\x92A\xa0\xf5\xd4.\x88EM\x95D_\xb6J\xcc\x02#\xd7\xa2\xc3\x10\x111\"H\xb1}\x9b\x96\xccS\xaa29\x9d\xfd\x12\xb6\x17H\x82\x0cP\xf1\x0a\xa7\xb3`\xa4\xa9\xd99i\xe8\xd2}\x90\xcd\x01\xe1\x9d \x11%\xba\xc6=Q\xfe\xca4.'*\x047\xe0a\xdfv\x1f6{i0\xbb/X\"\x02s7e\xd4\x01\xeeh\x87v\x159\xd7\xb4[\xeb\x82\x17\xb8\";sF\xa3\xfc\xb2\xf7\xd9\xbc\xc4\x8e\xd2[\xf8Z\xdbe\x8d\xf9\x02\xbe\xacg[\x86_\x00\xcej\x8c\xcb?\x9d\xf4K:Q\xe4\xa0\x10\x92\xa1\\Na\xde\xf1\x8f\xfd\xc7\x9dv'?\x1a+Vn\xe6\x93\x14U*\x91\x98\x86\xa2\"j/\xeb>\x11~X\xc4\xc1?e\x9f\xd1\xfc\x8f&\xaf\xeay\x00\x10\xf3\x01@\xb5\xfe\x05t\xb7\x9c \x91\x89D\xb5\xb3\xb0j\x80\xfd\xb6v\xf9\xf9\xaa\x01\x8c\xfc\x9a\x9a\x01 \xf3\x01\x7f\xe0T\x8b+`\xff|\xa2Z\xc0\xacDs\xccV\x0a\xb6\xec\"V\xea~\"0\x13\x9f\x06T\xe4?w\xb9\xc3J\x04\xe6\x1e\x15\xb6\xc1\xe5\x1d\x0a\xa0\x1e\xf0\xc7\xb6'\x0ex\xb1@}\x80\xa2\xd4&\xa3\x05\xafq\x16h\xf2S\xcbV\x8cv;d{\xd2{$\xadUc\x17\xe2\x05j[\xedPTW\xc8$b\xe4\x96\xe3e\xb65\xaf']\x0d\xe12\xad\xa3\x18\x83\x90\xf5\xfc(\x16\xd6\x1a\xa1\x19x?\x92`\xdc\xf7\xd3\xc8\x09\xff\xc14\xf1\x0b\xd1\xe1-\xa6\xe8w\xa3\xa4\xc2\xa9_RP\xf6lT\x91\x19\xf6\xfbg\xc1A\x94\xd8v\xc1\xe2\xbc\xfdn~\xe3y\xe0}\xe0\x0cM\xe5Z\xe8eH\x08\xcejK\xda\xdeV\xc2\xab\xad:\x0fo\x0bpr\x00Rz\x03\x9a\x02h,\xe0r_\xb3\x8f\x0dt\xef[\x81\x0a\xcf\xa6Atd\x830\xde\x8b\xfc+\x03\xdc\xd6\x9e-\"P\xe1\x93\xd4\xe3\x82\xefJ\xb6\xfe\xbb\xb7Ki\xc5=>\xea\x99\xac?3ae\x13Xn)\xff\xb0:\x19\x92O\xbavS`\x15L\x8eX\x8d\x14e\xcc%\x1b]\x02\x89\xff\x95\x84\xe5L\xc3d$\xd17\x1b\xc4\xaa\xec\xdft+V|p\xdd<\xe2p\x100M,O\xc5A\xb2\x1c\"\x1ap\xe3y\xa6\x90\xe4\xeac\x8e\x8f\xe4v\xbeg\xb8\x00o\xecW\xd1\x92\\P\x02\x11$\x98/f\xf6?2\xa1\xc6\x19E\xfe\x94\xc9\xcd\x8f [\xbe\xfd\x15\x14\xb8\x8f\x91\xb1s]\x1a`'<wW\x88\xeayL{\xd2\x9bg\xa9G2\x8c\xa1]F\x7f\xe1\xb5\x87\xf8+Csu\x09}\x9c\xd8\x15NM\xed\xd29\xa0\x1c\x03\xfbQ)1\x9f\xb6\xec\x13S#yAM\xe3?\xe3\x0b\xe0\x84^\x18M]Fl:o\xbbz\x0f\xb8\xe2;\x99\xb0\x04\xcf\xd2\x9d\x92\xee\x80f\xe7\xbe\xb6\x9b\xc8I\xc7\xdfb\x09\x0aaJ+\x07\xe1rw\xce7\xfc\xc3\x07\xfc@\x98t$VV.\x17\xf0%\x0e\x15c\xbc\xea\xfc\x09\xa7\xf1\xc9&\x8e\xe9\xd2\xd3}<I\x1a\xb4:\x88>\xc0\x1e\xb2\xb0Z\x12\xc2\x03\x82\xa6G\xac\x8a\xcfn\xd9\x8a\xc8\xb9\xb0\xb5N!\x02\xc4\x8ci\x83,\xbf\x01r\x06\xc7h\x85\xa5\x8c\xc4\x9eH'\x0e $kc\x8e\xe6;j\x83^bcg\x01\x00\x96:\xb4d\xe5\x8c\xa9Ul\xf6\xaa\x00\xe0\xb9\xadtW\x9be\xe2\xf2\xfe\xb2\x0b\xac\x99: \xa29\xc5\x06]g8e\x0c|\x88]\xed\x8d\xe7Vva\xad\xd4\x87\xb6\x8c\x8d\xb8\x1d\xb2CB\xb4\x05\x95+{\xcbsR\x0a\xbe\xc9dEy\x1c\x95\xfe,q\xe2#\x8d\x14m\x90wV\x8d\xdc^\x1f\xbf\xcf\x93\x89\xd7\xa5C\x94\x0f\x9e\xc8>\xe0\x9f\xc9\xbd\xcd7Y\xf8@\xa5\xcc\xc1{[[y\xc3\xbe`[\xeb/\xd8y'M+\xe4\xa0\xc1\x17\x0ey\x00R}\x93\xb3+\x18i\x97Hze\xd5\xb6\xc3\x95\x824\x037\x8e\x9c\xb5Q\x07\xb5b\x93[\x1e|\xc3\xd3B\x04\x86d\x7f\x9c|\xadrD\xc7\xb4\"\x86\xbd\xf3\xa6gSVbc\x7f\xef\xbd\x19\xa113\xd7$N\xa0\x08\x838\x19\x947L\xe6k\xc2&n\\I\xe3L\xa7\xcdx|\xb9\xe0\x99\xdd\x06\x01]\xc3b\x06k\x10\x90>\xe5\xf0Y\xb1(\x0c~G\x0e\xa1\xe56Q=\xf4\xcd\x1c\xfb\x87NcX\xa2\xe1\xf2\xa3\x84~!\x01\xfc\x97\x8c\xdc\xa8\xab\xd7\xd7N\x0a\x8e\xe4\xe1\xf2B8[\xfcb\xf2\xfb\xd7\xfaq8\x9fA\xcf\xb7c\x8b\x7f!)\xf3\xb6\xd2G\x8b\xac8\x9eD\xb1P>\xe3\xc5\xa43\xc2\xb9|\x09o=\xf4\xcb\x9a\xa5\xa3\x01\xde:\xc1\x1b\x83P0\xd4\x10]@\xf1\xff.SI\x92s\x09'\x93\xb1\xd1\x974OG4O7\x16\x18\xf2@\xb4\x00\x9b\xc7\xa7\xb9:P\xdc\x86\xc4\x92\xea\xe7\x84\x92\x8b\x10\xb5\xaf\x83\xe4\xcb\x83}h\xddld(QN\x88\x9b\xf4\x06=Kh\xcb\x7fj\x179b\x0f\xb0\x08\xcf\x80r\xc1T\x1f\xfe\x91\xa6\xf4,\x98G\x09+7\xb0\x9dx\xb6\xc6\xd9N*\xbe=;\xbaT\x86\xe7;\xf6\xfa\xf66Fl\xd7\xaa\xe6o\x19\xbf\xed\xb2\x7fd\xf7\xddr\xd5\x1f\x95\x14\xa5Y\xa4c\xdfN\xcbi\xa4T l-EH\xfa\x03\xbc\xe8w\xe9\x95\x07\xe0\x7f[x\x0b\x0e\x12\xecF\x89\xed\xba\x84\xd9U\xd6\xdf\xd0Z\x97\xe3\xfb\x93\x13\x1e8\xd4;\x99\xfb\x81t\xd2\xb0\x1e$loK \x80\xd7\x0d\xb8\x01\xcb\x0c\xb7\xdf\xf3N(\x94\x1c\x17\x00\x803\xbcq\x151\xd2\xf7\xef\xb1Q\x07\xdd\x0d\xc8`<\xe1\x83\xc8B\xe8i\x11\x02\xb6\x06\x08\x8f\x03\xfc\xadz\"b7\xfc+j\x0b]2AJpc\xea\x19h\x94\xe8]\xb0&\xd3\x87\x1f\xc9\xe8kO\x93\x02'\x04\x07[e[\xf7\x8e\xd3\xee\x81g2\xaa\xc4\x96;e\x7f\xeb\xc8\xc0+\x0d\x0f\xc1 \x0fv01?\xd2\xaa?\xef\x90\xac\\\x92A\x97Sb\"\x93\xfb\xae\x84\xaf\xed\xc5\xa7\x15\xddc\x8e\x8d\x97\x08\xb3O\x97*\xd1\xdf\x03E\x98\xad\xc9c\xe4\x87\x8f{\xbc\xc8-j\x19\xabG\x19\x9d\x13\xda*Po\xca\xd3\x9a\x02\xb1\xb6<\xc7Q\\\x86j&n\x9eb\xc3\xa6\xddag\x142V$\xc6\xbc\x02\xa18\xfa\xd8\x09\xf9\x1e\x1f5\xf7\xf6\xa0\x12S\x8b\x11\x98\x9d/\x80h\xf5#\x17Q\xbd\xc0}o\xe0q\x13\x97\xf9!\xc3~\xa4\x85w\xe7\xb5\xf7\xa4\xb5\x01I\x03f\x82+[\xb2\xa2h\xa4\x1f\xa9\xef\x11(T\xb8-\xb4\x04<\xc2\x09w\xc7\x9ch\x89:\x82\xe8\x00\xe2\x11\xda5L\xe2}\xd6\x94\xc7\xefb\xbe\xcb\x15\x9c\xcd\x879\xf4\x8f\x1f\xf7n\xe9>\x05\x1d\xff\x90\xd1\x0f\xb4\xb85\xdf\xf2\x09\x1e\xa4\x05\x93\x9f\xb2\xc6\xa72\xed\xf7l\x1aYa\x1d \xc7\x94\xac\xa1^\xd5h]\x12\xaa\x95\xa8*\xd6\x16\xb7\xf7\\\x95\x9b\xde\x022\x9a\xbdG\xe7!\xfb[\x06\x90$C\x9bZ\xea\xb3\xb9\xd1\x91\xed\xc8W\xde\x02\xc0tVs\x0b\xbf\xf5%\xe6\xdf\xf67\xb7\x05\xc8\x00\xf4K\x8f\xfb\x97\x02\xde\xfa\x17\xae@\xda>\xec)\x1fx\xfb\x1c\x13\x8c6\x93!\xf7\x06\x12:\x05?\xc1\x1e\x16\x87\xf0\x07\xf3\xd8{\x80P\x0c\xd7^\x9d\xcc(2]`o\"\xbb\x16\xa7K\xda\xe6\xde\x9c\xecATZzi\xcb\xc8\xa8\x1b/\xf0\xdcc}\x08\xa1\x98T^0\x0f\xac\x0b#QP\xd3\x16\x91\xc3\xe9U\x82Jj^\xc1\xf5\x11^5Uc}B\x0c\xbdH9\xe5\x90\x87\xaeh\xe784\xa2\xf8;\x14w\x08Z&\xd5\xc0\x9f\xa4w(\x98\x9f\x0f\xaa\xe6\xe7\x00=\x0bs\x8e\x1d@\xedUvu\xf4\x96\x1ez\xac\xe7\xb62\x1fR\xd1\xcevhUE\xb8\x91|\xf0-v\xfey\xa7c\xdf\x81\x060\xec\xc7\xfe\x09\xedC\xb5\xd6\x16\xfd\xf0\x13\xb1k\xd1\xc7\x02>w\x12\x7f'\xd8\xdb\xe4{\xeb|\x85\xa1tf\xcc\xdd33\xd5^\xe10O\xe6\xa5]\xc6\xce\xba\xb1?$\x07;W\xe8KNkW,\x95>\xa2\x10g\x1f\x033\xde\x04~\x02P\xf5\xb7\x91T\xa5<\xfe%@$\x9f\xe5(\xad\xcb\xdbO\xf7\xf6\xb6q\xc3\xc9U\xdf\x1eF\xf8\xb90\xf4s\xbb\xaao\x1f\xde\x82=\x03\xcb \x9b\xc7\xd8\x11\xc6\x11\x83\xec\xa3\x05=\xe8=\x1a>\xa1?\xec\xb2\xe6\xe2D\xfc \xdf6qLZ\xb1\x9af\xd4\xd5\xc9k'?B'\xca\xf7\x0b\x97N\x90\xfb4\xa1\x97\x04B\xd3h\xee\x9c\x159\x99\xccB)\xc0\xae\\\xa5\xb0v8'\xdd\xf7\xbb\x86\xcf\xbd\x08U.\x94\"\xff\x0bb\x96i\xc2c\xbf\xfc\xd0_\xbe\xef\x1aY\xc0\xe2\x0a\x92\xb3P\xb1N\x8e\xbe\xee\x80\x83T\xab\x8a\xd9\x0dLH(\xc1\xbf\xedG\x1a\xd7\xb8\xa3Bo\x1d@\x88\\b\x01Ax4\xc7\x98\xdczN\x7f\xe0\x84\xd6\xd6\xb4\x16\x93In[\xe7\x90\x8an $)\xf2\x10\xfa\xa3\x97\xdbo~\x8f\xbe-\xcc1\x11\xbd\x04\x08\x14\x0dX\xc6\xa1_\x82Q\xb3?cd\x0em\xd1\x7f\xcaT\x88CA\xce\xd1\x01_\"c\xdc\x0bN\xdc\xce\xb8\xc1\xf4\x02qOM\xa6g\xaf|\xd0\x15^\xa8\xb3z\xc7^\xd0\xab\xce\x8c#\xd0\xd2\x8a\xe0s\xb4\xfe\xd9\xeb\xc8\xb8\xa5u\xaf\x84\xc4\xa4\x12\xa7\xbf\x89\xf9\x10pS\xbc\x8e\xd2So\xbdy\x097\x0d\xee<\x84\x9a\xfb\x01l\xb5\x8e\xab6\x9c\xfc\x98\xa7\xaa-\x18\xe6\xb2\xb4K>\xbd{\xe6\x02b1?\x86\x90&z\x1bf\x89\xf1:\xdb\xb6\x13=\xfeM_i\xca\xdc\xcb\x8e\x88\xaf\x13\xf5|\x9e1\x0d>\x9b_\xb1\xe3h\x848[IS\xa8\x80\xc8\xe9L\xc6\x0d\xa7\xf7\\\xa9i\xb4\xd9\xc7G_\xf7y\xe9\xa7\x90\xd5 \x0f\x06^\x85\x0e\xc0\xc1\xd6\xa5}\x7fkK\xb2w\xfc\x91\xae>jJ\x93\x87\xa3A\xb9\xf8\xc8\x1a\xed\xc5\x14\xbdm\xeaP\xb3\x9d O\xfe\x19@\xbd\xa4\xff\x12]\xb4\xa1bX*N[X\x07\xf4\xbf\x92\xf2\x1d\xb7\xbe|\x07\xe8qd\xdb\xc9\xf0-{G\x95\xca\xdb\x17!\xa4|\x1c E\xa3\xc3\xcc'\x19\xf3Ac\x1f\x13csy\xe6\xaf\x84t\xde<\xdakg\xcd\xf4\xb8j\xe8Uo\x03\x93G~$\xa4:\xaf\xbd\x02\x184\xbeiTJj\xf4\xc6\xcb<\xa0\x88J\xa9$\x94;O\x82\x8aF1\xff\xc8QnS\xed\x88\x97v\x15\x1d\xe8-\xb6\xb8\xdbq\xb5o=2\xc7\x84\xb6\xa2\xc7\x0b~6\x0d!\x950\x9fm8\x7f\x8c\x0d\x9ex\xb04\xd8\x18\x80&>\xc2\x16P|\xe0\xb4\xa3\x83+\xbdSR\x9cP\x9a\xb6\xb0\xfe\xffP\x9fT\xe8\xa1\x17?\xfc,\xd4\xfd\xc5r\xf2\x02\x96~\xb6 o\x17\x10\xe0\x1e\x15\x96\xce\xf3\xa1nP>\x05\xfe\x91Q\x9a\xf5\xca\xa6\x14\xf8>\xb1\xe1\xfa\xd24z`d\xb9\xf0D\x09p\x9ed\xabN=\xb3\xe9+\xda\xc0\x91yo\xdb~\xc5 \x11G*\xb1\xb8\x9c\xc4\xb1\x8b\xad\xbb\xf9]\x16\x87\xa2]\xbb\x0b\xf0J\xcbu\x84=l;\xc8\x03\xdd\x8d\x10\x18\x18i\x89\xf9jYG{\xb9\x0d\x01\xe3\xce\x11\xd5\xc7k\x0f\xfb\xce\x1d\xdbM\xaec\x1b\xd3ol\xd0)\xb9\xcahM\xde\x86&%\xf9\x0b\xf5\xb4\x1c\xc3+5\x97\xe8\x95z}\xdeRwrH\x0a\x12\xc3\xd6v\xa3\xde\x89S\xa4\xd5\x1f\x02\xfe\\a\x16_\xcd\xd8\xb0i \x04\xc0>J\xc5g\x04\xae\xf5\x82\xc0>\x10\xa0\xa8U\xaa\x06\x9b\xdd\x03\x7f\xe6\xfa\xbd4\xb9\xdfx\xcf\x1bi(v\xa2\xef}\xd5\xed{\x8b \xf9>~\xdd\xd7\x81-Q\xa7\xd1\xbe\xcfwxU\xa3\xfd_,|\xf2Sk\xebT\x93;d\x8b\xc2\xf9\x0e\xe6H\xda>\x02IZc_\x0e\x16\x04\xf3\x92\xd1q\x97\xdbe\xb7\x1a\xf3\x8b6t\xe0\x1c\xb2\xde}F\x00}\xecg#\xcc9\xb1ix\xb2\xcd|\xeb\xc9\x12\x00\x0b\xed.\x1b\x90\xe1\xb7\xbejE\xf5\xd6o`\xb0\xa7WSbzXz\xcd\xcaU\x9c\xf0\xe3e\xe4~ipU\xd1\x03\x13(H\x0c9\xd7\xeb\x9f\xf7" +
+	"\xdfv\xbb\xb2\xd6\xed\xcaS\xf3W\x8f\"m\x85WC\x16\xb8\x1a\xa9\xd4\xa7]\x98\x7f\"\xc0\x9dk_\xd6\x0fF\"\xe5\x11\xc6E\x97\x90\x9b\xbeq\x17\x00\xa2\xe2H\xab\x0d\x9f]luE\x99\xafW\xa1\x86\x96\xed&\x87\xe5\xab\xd4\x11\xa0\x86\xfe\xbd-\xd4\x11\xd6\xb1#\x8c\xbbGh\xdc\xa4\x93\xfbnU3\x1f\x92\x13\xc0g0Z\xaa\xee!>\x01\\\x99\x00\xbe8\xa2\x8a\xa6M\xa7\xdb\xd5W\xcc\x08\xc8z.\xe4\x1b\x82\x0c>h&\xb7\xab\xd4\x9cP\xb01@s\xbd3[m\x95\xc9F\xa0\x03\xcc[\xc9\x8a\xa5\x86W\x99\xb0\xe2\x1e1\xa8\x17\xeeh\x9e\xd9\x1f\"g\x89\xff\xc9\x81\xd7]/\x12\xdb\x10\xfa\x85\xc6\xfd;\xec>\xe7\xf4\x03\x03\x9b\x8d\x1d\x1c\xa0I\xbe\"\xf3@s2#\xda\xe2\xbe\xc5{\xb0(\xbe~\xc9\xa2\xf9\x818\xfds\xa2X5\xf5\xcaN~i\x91\xd7\xb8\x98b\xc9'k\x86\xb0\x1d\x9e\xb1E\xbel\xab\xc8\xf5\xfb\xb6\xa16>2G.\x85m\xcd\xd9.\x00#8\xea\"\xaa\xabq\xf57x_\x8bA\xcc\xaf\xd8[\xe8I\x90p\x7f\xf6\x98\x93\xac\xf2\x8b\x8e\xb59\xabW\xa0\xb1\xb7\xcfY\x9e\x1c\xd0\x95+'\xdev\xeb\xe3\xf4m\x94\x90\"`\xef\xa0\x86\xb6\x1d\xa1\xe5h\x80\x9bu\xc8\xd6\xbf\xff\xb2\xea\x94\xc7C\xbcS\xe8\x9b\x83\xeb\xe3e\xb7\x95\xbe^j9&\xb3%\xf9]\xccI\xa4bO\x91y7\x15\\5\x10[\xe3\xad\xfa\x129\xc6U\x9fLIx\xa0\xa2\xad\xa3\xbe\x1a\xd7\xbeQ\x00i0\xa6\x93\xa0\x9b.F\xe4\xce{\xb6\xd8\xb9\xb9\xcbXg\x9d\xb0M4R12zf$wr\xe8\xdd\x1cU\x031\x8a\xf5\x1c\xf7\x9dKy\x99k\xb1\xa9M>q\x82J3*r\xd1\xc4\xc3\x85'\x83\x91\xed\x9e;\x9fx\xcc\xba\xb1\x9a\xbcQ[e\xc4\x1f\xf7W\xe9\x88\xf5@\xa1W\xba\x15h\xbf\x81\xe9\xdc\x19yQ\xfc\xb6\x02B2\x86\x87s\xaf\x9b|\x0d\xee\xa6\xfdMl\x11\xf6a\xbe\xe7\xf4\xf6a\xb1\xb5\x9149nE4\x00T\x1au\xb6I\x0fo\xe0/p\xa91T\xe5\xf8\xb6\xfb\xd3!9\xfe@\xda\x08\xa6\xf8\x8f\xd0\xc5\xa2\xe6\xc6)\xb31{d\x01\xec9\xa5t'\x9c\xe3!\x19\x0f\x13\x9c\x80\xec\x8f\xb8\x9a\x86\xc7\xf9o\xb1\x95z\xcf\x9d\xd8\xc0\xbb_\x97\xac\x854\x0b\x8av\x01\xe4\xcd4\xc6\x09\x9a\x00/\\S\xd4~\x97\x14\xb4\x90\x1f\xa6\xb2\xba\xbd\x07\x83K'(U2\x19\x0a\xa7_r)=\xdb\x13\x88\xa5\x8f%L\xde\xa1\xc9DAo\x0f\xa3\xc5\x12\x8f\xfb&\xe8\xe2\xd6\xd0\x09I\xf4q\x99\xff\xb2\x8e\xf1\xf6\xe8\x8e)\xf6\x0ai8U\xd2\x0c9a\x0b\x09\xbe\xdb.\xe8\x1f\xf4\x03\xc8\x96\xd9\x91\xef\xe5\xa2\xfe\xfe\xd6)\xa2\xc3e\xb0\xd0\xecQ\x8c\x0b\xb0\xe6f\x97v\xee\xe8\xe2f\xe1'7\xe8\xe2_\xa6\xe1\xd7\xa2B\x0b\xf7o\xe9\xeb\x12q\xc3\xcb\xbf\x8f\x1ed\x12\x83#<\x069\x04\xf4\xdc\xb8d\x8e\xad\xbc)\x94\x19\x10\xacZ\xb0\xfd\xb8+ \xd9\x8b'`\xb6\xd5\x9e/\xeb\x91\x1e\xa1}\x9e\xdc;\xa9\xf1\x06\xa8(\xd1\x1e\x19&\x03U\x88\xe4/\xd0r\x80\xbf\x88\xc9\x99\xb0\xe8\x12\xb7\xf1*J\x99\xfe\xcd\xf7\x91\x14\xbf\xa0\xf8\xcc\x98\x18\xf3\xa4~\xa1r\xba\x0e\x94\xf7_\x19(\xd3D!\xd6\xd7\x7f\xcc\x9a\xee\x9c\xf5\xa9\xad\xf3\xe7\xbd\x09\xcc\x86\xff\x15%;stK\x8f\xa3b_l\xde0\x9c\x91\x1d\xb9?\x1c\xba\x00le\xa4Y\xf1\xc4\xa2\xab\xe0O\x15\xa7\xe1\x1b\xe7\x04\xc8fc\xafmM\xd7\x97\\\xc3<\x1c\xady\xa1\xff\xfb\x91\x97K\xe2\xaf\xfa\x98,\xf8\x94\"\xa5\xdd\x17i'\x01\xf1,8\xaf\xd5T\\\xba\xf3&\x13\xce\xa53_\xecF\xd1\xb9\x0e\xa9\x82~\xed\xb1\x9a\x83\\\xb8\x86\xdd]\x93\xbbN\xc3\xd1s\x83\xfa\xa0\x95\xad\\\xc6O{\xd5sZ[\xd7\xc6]\xd6\xc9}\x82{\xe3\x1e\x9d\xfdq\xf5\xa1\xf5h\x8bX[L*s\xb9#\xd2\xc9`\x06!\xd0\x96n\xd4\xb3*E2\x1d~\x93\x93\x9b\xc8\x1c\xec\xb5s\xa8\xfe\xf7s\xfc\xcd\x87J]0\x0c\x1cb\xb1\xac\xbf;\x02'\x9c\xc3\x97\xbc\x92\x0b\x1109\xd1\xc9 \x0c:d\xdeC\x1a\x86\x19>^\xe3\xdb \x8b\xe5-@~I\xc6\x08\xcai\x97\x9c\xbf\xb2\xedK\x98\x0f\x1e\x03\xd8\xcb\xcb\x80RX'?d\x00.\xf8\"\xe4\xb5L\x02B6\xafP\x09!\x8f\x9b\xce`X\xbe\x89\x8e\xc0\xc5\xf0\x84\xee\xc4m\x0f[\x85s\"Z\xb8H\"\xdd\x89\xa1y\x12\xea\xb9\xea\xf0\xc1\xee\xc4\xc1X;!cc\xc7]\xa04\x91<:\x1a\xa8v\x15qB\x1c\xb1\xf90\x0er\x8c\x1dx\x91\xfeH\x96W\xf4\xf7\xfe\xfe\x04(\x9d\x0a\xbd\x9c$\xdfm\xee\xc1|%\x0f\xd0\xe3\xcd\xb4r\xe0b\\\x07I\xd1\xd9\x16awN\xf1\x83\xe8M\x19\xf0\xca^\x1dN\xf1\x88\xa8\x80\xb5\xe1\x13\x95d\x10\xf3\x9a5\x18t=\xe3\x07\x90\x81\x03O\xca\xca\xecl\xf55\"A\xa7\x97c\xd0\xd3 \xb5X+\xd9\xf9#M\x95\x8b\xf5\xab\x9fl\xb3'\x7f\xe6\xa0\x85\x02\x82-f\xd7V\x91\xb3\xe9)\xd1\xfb8\xed\x0c\xcfi\xd1Q\xac\x18P&c\xef\xfd$4Z\xb2\x90\xf4d~\xbd\xc5}\xd5U/\xdd\xa0\xbf\x9f\x0eQ\x02\xf6\x02-\xeb\xd2\xa9\xaa\xee\"\x04\xaa\xd0\xf4\x8e\x9auf\xef4\xfc\x93\xd92B\x02?1\xb4[\xe8i+o\xccH\x95\x17)\xffX\xa7\xc9\xa8\xa08W\x05&\xb9\xc9\xd8\x9d\x88\xe0{e\xa9\xca\xfc\xca\xf4Yo\xba\x87@\xdb\xdau`\x1a\xb6\xeeQ\xfb\x8d\xbdR\xd3 \xb4x\xcbs\xc8\xe8[\x7f\xff\xfd\xe9\xaf\xc7\xc2\xfc\x7f2\xe7\xf4+\xf4'\x0d\x87^\xcc\xe8\xa1]F\xfe\xf2\xe6^\xe7\xd8]\xf5\x1a\x98v\x973/\xc9\xaa\x88\x98\x0a\x80\x8c\x0d}\x7f\xd4\x164\xf0\x0b\xf52\x1e\x91\x19,\xa8\xee\xcafo\x163\x91\x9c\xaf>\xaa[\xb05[\xc8\xed\xfb\x07\xcf\xbas\xaf\x06\x14\xde\xb3t\xa9\xf0\x91|D\x92\xf5\xf9\x80\xdd31\xfc*\\s\xf5\x0d\x84\xd9\xbf4\xc7\xde\x91\xd7\x1dB\x9e-\x90b\x91Lk\xff\xb6\x0b\xf3=r\x88@\x9f\xc7\xd71\x0ea\xba\xb1M\x09\xf7\xc1\x12\xea5\xf6\x7fr\xc0\xde\xf1\xb7\xb9O\x10MC\xf5\x860\x1d\x03`2\x1e\xf1+|\x98\x05uq!&\xb3}\xab\x18\xb3n\xf6\xe6\x9d\x80\x87i\x100\x09G\xeb\x9b\x8d\x0e\x01\\`\xd8\x99&r\x98\x99g\xaaX\xf2\xe8\x06\x18\xa3;\xb2\xd3i\xd7l\xe7P\x0c\xd3}\x81\x0d\xcb\xae\x9f\xf6\xe6\x96\xc8vo\x8b\xf4C\x94>\" Bk\xf3\x9ad\xc9\xdc\xdb\x8bA\x97u\xfe\xfaW,\xd4t\x12\x06\xa2\x1e\xa4\xfcMI\xb8\x08\x8d\xd9B\xdf\"S\xf5\x0cJ\x85'\xd3\x8c\xfc\x8ex\x9dVw\xd6\x8a<\x1fH\x84\xe4R8j8\xd8\x8b\xf9\xc7\xd9\x82RPX\xb5\x8b\xf4\x9d\xbf\x0e\xecav\xa4\x96\x9a\x98B\xbd\x8d:\x0f\xfcx\xfc\x1a\xbb\x98vA\xb9\xa5:\xb1t\x81\x12$\xcai\x17\x0f|\xb0\xc5\xa4\xcf\xb2'5\x90\xd9\xb3\xc8y\x8b\x82\x05\xe6}\xfe#\xce#\x03\xab\xbdT\x8e\x06\\x$\xaa\xbb\x8a\x1d\x07g\xba\xf9Od\xdc\xb5\xb7\xfd\x96\x10/i\xa6\xa6\xd6\xc6\x8d\xbd\"^\xfb\xc3\xce\xe6L<\xce\xfd.\x82\xa3\xada\xfb\xf2\xc6~\xc5\x9b\x01\xe9z\xa1\x99\x9fks\xc8\xc6S\xe8\x90\xd7\xae\xb9\\\\9\x8b\x8b}.\x00\xf2\xa5\x09X\x80,9yl\x828\xea\xea\xa5{\x83\xff\xfe\xcd)\xf8q4\xd2\x07\xd9\xf3b\x94\x85\xed\x14\"t\x01o\xad\xd9\xf18q\xc1\xbaD\xc8z\xb3\xbe\xa3\xec\xe4\x184\x1eF\xdb\x83ga\x8e\xbf{\x1dW\x9e\x89rI}\x13q\x9an\xeb\x91\x86\xccD\x9e\xf6\xd8\xabO\x02\xe4k\x80C!\xd2\x91\xf1\xdb\x1ee\x02\xcb\x17{s\xf8\x92\xda\xb9^\xa0\x9do\x162W+\x09EU\xfb\x08#[(x\xec\xecEg*\x9a$~\xd7\xd8+\xe3\xf6\xc8J\x83\xe5\x17H\x7f\xf4\xa4\xe1)\xcdh\xd2\xd4\x8b\xf1x\x1c\xecH\xe7\x14g\x16\xbc\xa7\xf7\xf8\xeb0\xde!%b\xc2T\x98\xe6\xab\x0aa\x04S\xed\x80\xef\x19\x02\x0c\xd6I\xc9\xd5\xe7,bk\x0e\xf6\xfe,\xc2\xbfiAPx\x85J\xfb\xa0\x01\xb8\x01\x0fb\xe7\x02\x94\x83\xf9\xe8\x1d?\x0fu\x96 \xe6$\xcfyn\xf2\xd2=O>\xa4\xc0\xb5\x13\xad\xe4\xcd[n\xe5\xe3\x05\xb6-\x14;\xf6+\x12?7\xfa\xcd\xbe\xa2\x19d\x03T\x19\x89\xc7L\xff\xca3\x99+\xdd\xe7\xc7G\xbf\xc2\x19\xe4\xf1~cwp\x1a\xe4-I\xe8\x1c\xdc=\xe8\xf2T(Fpr\xe9\xc9\x97 \xe6\xd8<\x96\xca\xe7Q]\xd8i\xd0\x0b\x82!\xc0$8\xb7K!CU9}[o4+\x04\xec\xcc\x03\x83%k\xc7\x15>\xe6\xd2f9\x0ezz\x1c\xf9* \x9d\xcf<\x94Y\xb0\xc8\xef\xcc\xf9w\x1e\x0a\x0e\xfc\x8a\x87\xc2\xd1/\x9f\"],j7\xe9/\xcf\xba\x8f\x1b\xbe_!\xbf\x8e\x10\xa2\xe6)z\x80y\x0f\xaf\x85F\x9f\xe9\xb9Q\xcf$}!W\xfe\x858\x17\xf1\xb6\x0a\xc7\xf1\xa3\xfb\x7f\xfe\xef\xff\xf1\xbf\xfe\xe7\xff\xf7\xff\xff\xbf\xff\xd7\xff\x1e\x00:\x04\xcc\x1a\xfa\"\x0e\x00"
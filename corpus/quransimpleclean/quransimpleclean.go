@@ -0,0 +1,22 @@
+// Package quransimpleclean provides Alquran text (Simple Clean) from http://tanzil.net, stored gzip-compressed.
+// Original file name: "quran-simple-clean.xml".
+//
+// Run "go run gen.go quransimpleclean quran-simple-clean.xml" in corpus directory to regenerate it.
+package quransimpleclean
+
+import (
+	"sync"
+
+	"github.com/alpancs/quranize/corpus/internal/gzipped"
+)
+
+var (
+	once sync.Once
+	xml  string
+)
+
+// XML returns the corpus in Tanzil XML format, decompressed on first call.
+func XML() string {
+	once.Do(func() { xml = gzipped.Decompress(compressed) })
+	return xml
+}
@@ -0,0 +1,74 @@
+// Code generated by gen.go from "quran-simple-enhanced.xml"; DO NOT EDIT.
+
+package quransimpleenhanced
+
+// compressed is gzip-compressed "quran-simple-enhanced.xml" (1514821 bytes).
+const compressed = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe4\xfdks$W\x9a\x1e\x08~\xef_\x11[\xfa2c6\xdd\x9d\x81;d\xdd3\xa6\xed\xe9\x9d\x91\xadfZ+iwm?\xb2\xaa\xa8.\xaeX\xacZ\x92\xa5U\xef\xb7\x00\"\x02`$\xe0\xee\x88\x7fPD\x91\x08F\x02\x89\x8c\x042\x93\xc8_\xe2\xfeo\xd6\xe2\x9c\xf7~\xde\xe3\xee\x01\x80\xac\xd6Hf\xeaJ\"\"\xfcr.\xefy/\xcf\xfb<\x7f\xf17\xff\xd3\x7f\xf9\xed\xe7\x83\xff\xfc\xe9\x97_}\xf6\xbb/\xfe\xf6\x17\xc3\xbfz\xf1\x8b\xc1\xa7_\xfc\xeaw\xbf\xfe\xec\x8b\x7f\xfc\xdb_\xfc\xe1\xeb\xff\xf8\x97\x07\xbf\x18\xfcO\xff\xe3_\xfc\xcd\xff\xe9/\xff\xf2/\xfe\xe2_\x0c\xfe\xed\xbf\xf9\xfb\x7f\xf5\xef\xff~\xf0?\xff\xc3\xe0\x7f\xff\x87\xff0\xf8w\x7f\xff\xbf\xfd\xc3\xff\xe3\xef\x07\xff\xf0\xef\x06\x7f\xf7\xbf\xfe\xab\xff\xfd\x7f\xf9\xfb\xc1\x7f\xf8_\xff\xf5\xbf\x1f\xfc\xdd?\xfc\xdb\xff\xd7\xbf\xfb\xd7\xff\xcb\xff\xfa\x1f\x06\xff\xe7\x7f\xf3\x0f\x7f\xf7\x7f\xfd\x8b\x7f\xf1\xb7\xcf\xf0\xff\xfe\xe2_\xfc\xc5\xbf\x18\x0c\xfe\xc3'_\xfc\xff>\xfb|\xf0\x7f\xfb\xc3\x97\x9f|1\xf8\x0f\x9f\xfe\x97\xaf\x07\xff\xdd\xbf\xff\xec\xb7\xbf\xff\xfc\xd3\xc1\xdf\x7f\xf1\x9bO\xbe\xf8\xd5\xa7\xbf\xfe\x1f\xf0e\x06\xc3\xbfz\xf1W[\xff\xfd\xfaW\x7f\xf7\xbb\xdf\xff\xd3\x97\x9f\xfd\xe3o\xbe\x1e\xfcw\x7f\xf7\xdf\x0f\xb6^\xbc8\xf8\xcb\xad\x17\xc3\x17p\xb5\xbf\xfa\xe2\xd3\xaf\xd7\xdf\xfa7\x9f\xfd\xea\xd3/\xbe\xfa\xf4_\x0e\xfe\xee\xcbO?\xf9\xfa\xb3\xff\xfc\xe9\xe0\xef~\xf7\xdb\xdf\xfe\xee\x8b\xaf\x06\xff\xea\xeb\xaf\xbf\xfc\xec\x97\x7f\xf8z}\xd5\xed\xbfz\x01\x8f\xf2\x9b\xcf\xbe\x1a\xfc\xeaw\xbf\xff\xa7\xc1\xef\xfe\xe3\xe0\xff\x13\x1e\xe8\xeb\xf5\x03\xad\xff\xfa\xc9\x97\x9f\xfe\xc7?|\xfe\xf9?\x0d~\xff\xe5\xef~\xfd\x87\xf0T\xbf\xf9\xec\x1f\x7f\xf3\xf9?\xad\x7f\xf8\x9f?\xfd\xf2\xb3\xff\xf8\xd9\xa7\xbf\x1e|\xf2\xc5\xaf\x07\xbf\xfa\xdd\x17_\x7f\xf6\xc5\x1f~\xf7\x87\xaf>\xff\xa7\xc1o\x7f\xf7\xc5g_\xff\xee\xcbO\x7f=\xf8\xe5?\x0d>\x19\xfc\xe3\x97\xbf\xfb\xc3\xef\xd7W\xff\xea\xf7\x9f\xfe\xea\xb3O>\xff\xec\xab\xaf\xbfZ_\xe0\x93\xafq\x1c~\xff\xe5\xef\xfe\xdf\x9f\xfe\xea\xeb\xbf\x82'\xfa\xfb\x7f\xf7\xbf\xfd\xfb\xc1?\xfc_\x06\xff\xf7\x7f\xff\xf7\xff2\xfe\xe9/\x07\xff\xf6\xd3/\x7f\xfb\xd9Wa@>\xfbj\xf0\x8f_~\xf2\xc5\xd7\x9f\xfez\xf0\xf5\xef\xe2\xa3\xaf\x1f\xe1\xd7\x9f}\x15_\xef\xd3\xf5\xa3\xfd\xf2\x93\xaf?\xfb\xed\xfa\xc3\xcf>\x0d7\x1b\xac\x1f\xe0\xeb\xf5\xbb\xae\xdf\xee\x7f\x18\xfc\xf2\x0f_\xc7\xc9\xfe\xd7\xff\xfb\xff2\xf8\xd7\xffa\xf0\xaf\xff}X\x09\xff\xea\xdf\xfc\x9b\x7f\xf8\x7f\xfe\xfd\xff\xfcWx\xdb0:bT~\xf5\xc9\x17\x83_~:\xf8\xc3W\x9f\xfez\xf0\xd9\x17\x83O\xbe\xf8\xa7\xc1\xff\xf7\xd3_~\xf5\xd9\xd7\x9f\x0e~\xf7\xe5\xe0\x93\xdf\xff\xfe\xf3\xcf~\xf5\xc9z\x80\xff\x87x\xd3\xdf\x7f\xf9\xbb\xff\xfc\xd9\xaf\xd7_\xfe\xfa\xab\xc1W\xbf\xfb\xc3\x97\xbf\xfat\xf0\xdf\xf1|\xfd\xf7a\x98?\xff\xf4\x93/?\xff\xa7\xc1g_\xfcz\xfd\xe3\xf5(\x7f\xf2\xc5\xaf\xe3\xef?\x19|\xfe\xd9\x17\xffi\xfd\xad\xdf~\xf2\xebO\xd7\xef\xfb\x9b\xaf\xbf\xfe\xfd\xbf\xfc\xeb\xbf\xfe\x9a\xae\xb1\xfe\xe3\xa7_|\xf2\xcb\xcf\xc3c}\xf9\xd5\xfa\xbf\xff\xd3\xa7\x9f\xfe>^\xe0\xeb/?\xf9\xd5\x7fZ\xbf\xfb\xaf~\xf3\xc9\x17\xff\xf8\xe9W\xfa\xcd~E+\xea\x8b\xdf}\xfd\xd9\xaf>\x1d|\xf5\x9bO>\xff|\xfd\x86\x9f}\xf1\xab\xcf\xff\xf0kx\xcb\xcf?o\x19\xd2OaD\xd7s@?\xff\xf2S\\2\xebA\xf9\xf2w\xbf\xff\xf2\xb3O\xbe\xfe\xf4\xf3\x7f\xc2\xcb\xfd\xc7\xcf>\xc7\x8b\xfc\xfa\xd3/?\xfb\xcf\x9f\xfez\xf0\x1f\xbf\xfc\xddo\xd7\x83\xb8^J\x9f|\xf6\xc5g_\xfc\xe3\xe0\xab?\xfc\xf2\xab\xaf?\xf9\xe2\xeb\xcf>\xf9|\xf0\xfb\xdf}\x19\x16\xae\x9cFx\x99\x7f\xfb\xf9\xa7\x9f|\xf5\xe9\xe0W\xbf\xf9\xf4W\xffi\xf0\x87\xdf\xff\xfa\x93\xaf?\xfdj\xf0\xc9\xd7\xff2\x1d\xab\xbf\x86O\xffz\xfd\xc3g\xd9\xce\x7f\xf1\x97\x7f\xf9?\xfe\xc5\xdf\x84\x15\xf2?\xfe\xc5`0\x18\xfc\xcdW\x7f\xf8\xf2\x93\xf5\\~\xfa_\xfe\xf6\x17\xc3_\x0c\xbe\xf8\xe4\xb7\x9f\xfe\xed/\xea\xabf\xdc\x8c\xea\xabzY\xdf\xd4?\xfc\"~5|\xfd\x93\x7f\x92\xdf^\xbf\xd5\xdf\xfe\xa2^4U}\xd7\xcc\x9bIS\x0d\xc2O\xc7\xcdES4'\xf0\x9f\xf5j\xfd\x9f\xf5M\xf8F\xd1L\xed\x9f\xabf\xb6\xfe\xe9/\x06\x7f\xed\xdfh\x8bn\xb4\xbe\xf6\xbc\xbei\x8af\xd2\xcc\xeb\xdb\xa6\x1c4\xe3\xa6\x92\xb7[5E\xbdh.\xf0A\xe6\xf5CS\x84\x7f\xad\x7f\xb2\xbe\xd1\xb4)\xb27\xda\x967z\xeaS\xef\xe0\xc5\xd6?_\xff\xb2\xa9\x9a\xe3\xa6\x1a4\xb3\xa6hN\xc5h\xd5\xb7\xcd\x05<Z\xfej\xbb\xf4h\xdf\x85\xef^\x84k\x1e7\xc5`\xfdF\xf5C3\xaf\x17M\x19\x87\xe4\xb4)\xfco\xdd5\xf3z\x19\xbe\x1doWfo\xb7'F\xe2$\x0cu\x15\xaep\x15\x9f\xf8\xdd\xfa\x89\xc3X_\xd5\x1f\x9a\x02\xc6\xba\x994%\xde\xa39\x82\x01\xca\x8f\xf6>\xdd\xe3]z\xb1\xf5\x93\xbf\xc1\x09\x1b\xd4\x97a\x06\xc2l\x86\xa9_\xae\xff\xb8\xfe\x8fqS4\xb3f\xbe\x9e\xfc\xf5\x07\x83\xfac\xfcC\xbd\xa2%\x10\xc6\xffc3\xaf\xdf7es\xba^\xac\xfeO\x9b\xd3\xf8G|\xc9\xf70|c\x9a\x1e~\x97\xbf\xf9\xeb\xf5\xbeq\xb6\xd0\x96\xdcB\xf5\xa29\xaaW=6\xd0\xfa&\x93_\x0c~\xf9\xd9W\xbf\xfd\xec\xf3\xcf?\xf9\xcd\xcf\xb9\xa7\xde4\x05\xacM\x9a\xc7\xe3\xa6\x0a\xeb\xe4\xaa^\xc4-\x16\xc6d\x05#\xbbh\x8aA3\x0awX?Ms\x12\xd6\xdd\xcb\xe6\x9b\x01\x0c\x15.\x85esA\x0b\xa1\xf7\xb633\xdf\xcc\x9a\xb2\xfeS\x1c\x87f\xba\x9e\xc0\xf0\xe70<q\x83\x7f\xa4\xc7\xaa\xe2\x14\xce\x9a\x92V\x1f}\x1fW-\xccp\xfdCS\xc4oO\xc2\xec_\xd0+\xd6o\xc33\xcf\xe3r_\xbf\\\\\x1c\xeb\xabN\xc3k\x1f\xe1U{l\xfd\xd3\xa6\xd8\xec\xa5\xa2\xb1X/\xf8\xb2\x99\xd6o\xc3h\x16\x83\xb0\x9dq\xbd\x1e\xf3\xa3;_\x8d\xd7\x1c4G\xc1\x10\xceij\xc3\xa3\xf0\xa8}[\xbf\x86M\xf7\x03\xcc!\xbf\xe6is$\x9f\xab\x87M\xba\x0c\xdf\x1d7E\xfd=\xdc\x8ev\xd87j\x81L\xc2\x02\x99\x0e`\x9d\x06K\xad6\xa0s)x\xb8RX\x99f\x14\xdf\xac\xbe\xe9|\xc8=e8\xa7\xeb\xdbz\x16f}\xa7f\xd4\x14\xf5j}\xc5\xfajP\xdf5E\x9c\xbe\xfa\x8f\xcdY\xc6\xd8\\\x86\x07.\x9a\xe9z\x17\xd5+0}8\x98\xe1\xa3\xf5?\xe0\xf8\x99\x0f\xeae\x98\xab7a\xe8\xe7\xf4E\xd8a\xfe\xb2\xe8a?_7E\xb8\xf1\x04\xdf\x0dmE\xa9\xe6a\xbdp\xc7h\x03\xf5\xa8\xf3\xb7\xea;0\xb1\x0f-\xdf\xb9\x8c\x8b\xab~\x17\xc6g%\xbeY\x7fl\xaa\xfa~\xfd\xe7\xf0\x9b\x1f\x9a3\xb2\xab<.\x0fa\xe8\xa3}\x89C[\xff\x08\x1b\xf6,\xfb\xba\x07rS\xc1\x10\xc1\xebN\xe3\xfe\xad\xef\x9aj\x10\xcda<e\x8f`-\x95\x83\xfa\xdb\xf8w\xdc\xe8\xb8\x11\xd8\xa8\xea\xfd\x91\x1c\xd2b\xcbTr\xf7\xc5\x97\xc2\xad+\xa7\xaf\xcb\xea\x1d\xd2\xfb\xac\xa7\xfdux\x81\xdb\xa6\xaa\x1f\x94\xd1\xa2'\x84\x1d\xec\x9c\x8d\xf8jq\xdd\xcag\x9b5E\xfd:\x9c\xde\x85\xbc\xecw\xe82E\xebQ\x04\xbbV\x86\x89\xa7\x15i\xaer\x1f\x16DY\xaf\xf0*\xd9\xd7\x1a\xbe\xa0\xf7\x0ag\x84\xbb\xec\x06`rWMQ\xbf_/\x91Q4\xbca\x08\x0a\xbd\xe1y-7\x13\xfa\xc9\xcb\xfa\xaa{]]\xc2\xc9\x16\xd6\x95\xb4\xae\xcd\xf1\xfa\x7fx\xc8fM\xd1\x1c7\xf3\xb0/\x17\xddo8T\xe6\xfd\xbb\xa6\x8a7\x1d\xc0\xa93n\x8a\x81z.<@\x97\xd1p\xad\x97i}\x8b\xf7\x8e\x83\x04K\xec\x12\xac\xc8\xfb\xa6\x8a\x16\xfc\x0aF\xeej\xc0\xe6\x0b_b\x0a\xe7\xff4\x0eMY\xbf\xebm\x14\x87[\xc2t\x93\xd3\xc3w\xa0'\xf7M/\xbfA\xb4\x9d8\x11\xc7\xf1\xe8\xc1\xb5\xb5\xe9\xca\xd9\xdep\\\xc3\xca\xafx\x1a\x8f\xf9L\xc4=\x91\x18\x882\x19\xd7\xb8\x01\x94\xe1m\xb9T}\xd7\\\x84A\x08{2\x1c\x0e\xe5`\x83At\x7f\xdf:\x80\x0faV\x8bf\xd2=\x80;\xb9\x01\x1c\xa31\x04\xdf\xb6\xc3\x84\x98\x11\xd2\xb6\xd3\\{m\xb9\xc2\xf5O\x9b9\xbe?\x9c\x12\xf7M\xd1\xcc\xc0\xad\x8f\xb7\x12'Jnu\xaf\xef0\x81\xd3\xe6\x18'\xba{\xed\xdf\xd1\xd9;_\xbbB\xf5\xf7\xdd\xa3\xb5\xab\xdcNaffM\xe1]o\x90\x1c\x9b\xebo\x86\xbb\xdf\x86I\xa5\x09\x87=\xfd\xa1)\xd7qG\x1c\x03\xfd\xf68\xb1\x93x\xcf\xaeG\xddk\xf3\xb4\x9c\xf9\xbc\x0a\xdbn\x19\xcdes\xda\x94*\xa6\x89K5\xfc\xdf\x1f\x94/\x0d\x9e\xdaz\xf2\x9a\x11\x0d6\xc4\xd4k\xbbR\xd4\xcbAp\xeb\xab\xfa\x1a\x8e\xfe\"\x985\xef\xdc0Fv\x12\xbe\x16\x9f\xea\xb6\xf3t\x1c\xee\xab\xf0\xf9Uxjq\x1f\xdc\xa0\xf1\x83\xca\x8c\xc2 \xec\xf5p\xafp\x8a\x1f\x85{B\x14\xbc~\xea\x97\xd1\xf4\x16\xb0\xb3\xf8,\xac\xdf\xc3\xae\\\xbf\xd7|\x80Cp\x03\x17\x1a\xa3[\xf5\x066\xf0\xc2q\xb9\x16d\x94V\xd6\x87\xc2)9n\x8ad\xb9\xfc\x08\xe7d\xb8e\xbdl\xce\x95!(\xc1\xe1\xaa\xfaX\xd2\x03\x11Z\x97\xe1\xfd\xc2\xf9W6\xc7a\xcd\x05\x9f+\xdc\xbc\x99\xc1\xe9\xeb\xf8\xa2\xf1(\xba\x96.I\xfe\x8e\x87\xea@9\x85)Z;\x88\xcd,\xac\x97\xc5\xfa}\xc0\xfd\x97\xb6\x14\x96K\xfd\xc7\xa6\x92Qe2\x1a\xd1\x8f\xacW\x90\xec\xb8\xc5?,\xe2s6G\xcdY|\xe8kH\x13\x8c\xd9\xe1\xb9\x8cO\x12\xfc\x82\xaa~\x90o\x0bc\xffm\xfdFo\xd2\xe4Yc\xf0\x18\x03\x82\x87\x10\x05VaY\x80\xe7/r \xe1\xf5\xeb%\xfa\x94\x89\x0fS\xc6\xf0\xbc\xfe\x00N\x09\x05\xdd\xeb\xef\x8e\xa2\x8b\xd9\xb1;\xb6^\x08\xe7\xb1\x88?\x0d\xb9\x9fx-1(%{\x81\x1fB\x84S\xa6\xde{!\xb7U\x09\x0f;q\xb6\x848~\xd1\x83\xbb'\xcb/&\xcf\x1e\x11\x97\xc1\xc1\xc7c,\x93\xb9\x81\x13a\"\xfdX8W\x06\xf5==\x82\x1d\xcfqS\xe8\xcd\x18\x13'\x99\x18\xa6%p1\xe1!\xfb\xdd2\x84\xc2\xf1\xa9\xf0t\x9b\xd7\x7fl\xce\xe3\xb3\x07\xa3V\xafZB\x98\xad\xa1\x9c6\x18\x99\xb0?\xca\xe8\x0d\xa4\xdeJXm\x94\xdb\x8bg%\xe68\xf1\x80,\x13\xeb\x87g\xf2\x918Ds\xb9\x087_ lA|\xfb\x0bq\xa5`\xc50\xb9\xd3e\x19\xb6\xb6\xfc\xec\xce\xa0\xbe\xa6\x8b\xc3\xc2Ro#\x9d\xe1\x98o\x82\x14\xe1=9\xff\x8e\x0d)\xd0\xfa\xc6\xff|I\xb3\x1e2$\x85\xc8\x90dm\xd0\x84\x7f;\x8a\xbf\x0d\xbbg\x15LKAN\x80\xb9\xcc+\xba\xcc\x0a\x0cVHFW\xf5\xdb\xb0\x0d_F/L\x8e\"\x1e?120v+~]e\xb6\xe3K\xdc\xc2V\xa71\x88\x7f^\xca\xa9\xe9\xef1n%.w3\x8d\x8b\x9c/\x89&\x92\xd3\x7fd\xc8\xf1\xe0\x0c\xe3\xfd\x16\\\x8b9&\x8ay\xdb\xd4\x0fqq\x8942\x8c\xed\xbc^\xc2\xeb\xc2\xbe\x8d\xa7\xe6\xda1\xe1\xe3\x02\xfeQ\xbf\x82\xe5I&f=\x10\xf3\xfa\x01\xafp\x0f\xdb\xe8\x16\x17C\x1ck\xbe\x0e\xc5,iJ\xd5}u0\x13\xb7\xd1\xdcw\xd9\xe4\x1d\x11\xf8\xd2X\xa21\x8dS\x13\x82'5\xc9h\xe5\xa6\x99\xcfG\xb0\x9a.\xac\x07\x8fFb%]\xc0e\x98\xaa\xe6\x94\xbe|\x9b\xb7,d\x0f\xd6\x05\x15\xe9\xcf\xfd\x10\xcf\x88rm=\xeb[\xb8\xee\x1c\xd7\xe3\x86\x07\xd5\xaeZ`\x8bpb\xc4\xdaA5\xe8\x97\xcc\x80\x9c\x7fEc\xc2\xc7\xf1\xfa_\xe0\x97\xc2\x8e\xbb\xc4@\xc5D\x8a\xd7\x1c\xc0\x04\xcf\x8av\xdc*\x8eX4\x82\xe1\xaf7\xf1\xf0\x96\xc36\x87\xeb\xce\xe3\x1f\xebUS\xf2Q \xdc\xe42da\x8f\xd8\xe3\xad\xf8Gt\x8fW\xc2N\xacWyH\x85*;\xa1C\xa3\xf0\xf37\xfc0\xc2\x82\xf2\x1dq\xd3\xa5\xd6\xbc\xa4\xb4\xaa\xdajh\xc2\xca\xf0\xce\xf7\xe8\x1c5'~j\x85\x0ew:\xcf\xd7\xcf\x1b\xf7\xe0us\x16\xb7X\x19\\\x8c\x13\xca\xecP\xf2\xcf\xbf\xcak\x9a\xc0\xdbnC\x95M\xe3\xf29-=W\xact\xadc\xc4Y\x1c\xacK\xca\x0b\xd6\xef\xe3\xc4\xc7J\x06G\x11/\xe3\x00R\x8a\x10\x92f\xc1\xff\xf9\x01\xce\x03\x98\xecf\x04\x01\xc1\x11\x06\xf2tZ\xf0\x15\xba\x97\xf7\x08\"\xe4\xc4b\x8b\x95\x1cc\x0a\xae|\x1e\xadG\x9aVSK\x1e\xbd\xf5I\x92|7>\x0b'M\xe1I\xe2\x1bKWn\x85\x89:7\xf2\xe1\xe5j\x87v\xd6\x94\xeb\xccV\xf8A)V\xe1q\xf8Zt\x9eh\xf5\xcd \xf8\xbe\x8d\xb3\xd7\xfe\xe5IS\xb4\xdc@'<\xc3H\x82]\xbd\xebg\xd9\xf7\xdb\x0bTE3]\x8fZ\xac/\x86?\xd6\x0f\xf4\xf4\x85=jp\xe6\x16\x14\xc9\xc0\xdff\xeb\xb1\xaa\xaf\xc2\x03\x9dp1+T\xa3\xea\x0f\xb8\x1a\xc5\x9c`\x81A\xc4\x1f\xc9\\Tb\xe1\xaf'\xfa\x1d:AX)s\xd2y\xd9\x94dwq&n\xeap\xc1\xee0u\x8bS\xfa\xc7\x10\x0c\x8c\x9a\"\x1e\x84\xc7\xe1?D\xda0\x97\xb3\xb7G6\xd4\\\xc06-)\xd2\xaf/\xc9\x1c\xc4\x9a8|\xffU\xf8\xc7\x05T\xe5`\"\x96!Tv\xbf\xc1&\xc5\xfb\x86*\xd4\x85\xd1_6%\xc4\xd1E\x8f8z\x8b\xab\x021-T\xb4\xf9\xf6\xc2mf\xc3\xd52}\xd7\x88\x82\xa8\x1f\xc2\xb8\xc8\x07\x97\x09\x93\xe0\xb3\x89D^&V/\xa0FvA\x95R\xb0\xcbw\xe0\xee=\xc0\x7f\xc4\x9f\xd1\x94\x9c\xf3\xa9p\x0a\x13\x9b\x0b\xac\xc0\x19\xea\xaa\x0dm\xbfp2\x9f\"\xc9Xp\xd0T\xc6e;\xa6:5V\x84aE\x87z(\x1d2\x14\xa8\xc4\xa0\x7f\x1c\xb3%\xb9\xf1}M\x0f;\xc2##M6[g_\x9f\x8a\\\xbeR[\xd3|\x09,\xc3z\xca\xc2\x07\xc7\xb0\x01\x01J\xc2\xd1P\xf8\xa6J\x96Nc\xa5'\x1e\x19\xebR\xc1\x00s{\x80\xa9\xe1r\xf1\xfa\xbb\x10\xd9^D\xe7\x1c\x17\\\xa1\x86V\x0d\xd6\xa5>\xce\xd0T\xc9H\xa7\x7f|\xb2\xadK-\x1c\x8aN\xc2aZ\xdf\xe2?y2\"f\x82\xde\x9f\xbd\xb48tr\xc9?P9I\xd7\x90\xc4\xb2\xcf\xae\x90\xf5\x0c\xcb1\x88a\xd8Bd\x9e\xf1\xd8J\x9e\xaa\x8a.\xdd\x9f\xc2\x93\xe1\x9f\x9e!\x00\xd9\xa6H\xdb\xae\xba;\xc8\x1e\x06Gy}\x0d\xdc\x03\x10\xa7\xd1V\x18\xc4X\x84+\x19c\x99\x92\xa7\x98\xee\x82Q8\xf2\xcbS\x88oi8\x96<5r\x1f+w\xe6\x18\xff\x98\x7f\xaf\xed\xe4\xbd\xe2\xb1[_\xf1\x1a(\xf5\x14\xccE]\xd6L\xc0\xf7\xd2S\xf2R\xc0\xe1\"\xd1\x81\xeaw\x11\xb3\x0c\xa8\xd8\x7f\x09\xde\xd4\xd8\x86\xfe\xed;F Z\xd2\x1c\x05\x19\xd1J\x06p\xd2\x0aQ\x9e\xcb\xd9\x85\xf5\x12V\x82)\xdeQ\xc6\xbe4\xb9\x84P\x16\x8d\x7f\xe8\xdc\xab;9\x1b\\\x8a\xac@\x97\xe1\x8d\xc7\xd0\xb5Hw\xad\x7f!\xb6:\x9e;\xc1*\xdf\xca\xf2\x11yx\xdf\x05\xd7>\xe6\x0af\xeb\xefb\xcas\xbd\xaba\x00\xf1\xb0;\xa6li\x81\xee\x04\xec\x11\x9d\xe0\xd90\xee\xddVq\xaf\x1d\x83t\xed\x86\x07\x8a\xe3\x8f+'n\x9f\xf0\xb8o!\xc9z\x0d\xa7\x17\xcc:\x07\xb5?\xf0\xd3\x83Q\xb1\xd1\xe7\xfa\x05\xd7\x0b\x0bRG\xf5\x0d\xac\xb1W\xeb\xbb\xdf\xc7=\x03\x13]\x88\x00\x10LvpA\xe3\x09ZpHZ1\x1a\xed\xbe\xb9\x80)Y\xe1\xe3\x8c\xe2Q\xd7\x1c\xe3\xca\xe1\x87\x82e\xfd#\xc7\xf0}\xc0\x99{*\xbfr\x09\xe32\x16\xb5V\x82\xee\x85\xc7\x09\xef\xf7\x01\x8bP\xd1v\xf1\x80x\xc9=q\x19\x85\x0b\xe3E\x91\xe6\xb9\xcd\xdc\x02^rm1>p\xb8\x1d=\xfd\xf7\xc2\xab\x0c\xab\x9a?\x88\x9e\x0el\xcb\x0b\x09\x9a96i8g\xc3k\xd8eQ\xaf\xf8\x0a\x938\x09\xc1}9S>\xdd\x0d\x0c\xf8y~\xc0\xf7\xd5\x80/\xc9\xef\xbc\x88W\xe0\xe5\x9b\x09F\xc3\xb8\xc1\xec\x92\xe77\xa2'Z8\x95\x00S?\x1f(/\x18\xd2`\xe0p\x06l\xa2\x83yl9H\x0e\xc4A\xd21iv\xfbX\xd7\x99r|" +
+	"\x13Y\xab\xbf\x84\xdc\xd1\x0cw\xa6\xc9@\x82\xc9\x97\x888\xc8)`\xf6\x09\x0aT\xf8\x95\xa2\xbeZ_L%\x8b_Gk\xd0\x8cr\xb04\xb1y%\xb0/\xa6\xb6\xdeb\xfe\xa1}\xb3\x1dvA\x18\x93\xfc\x01\x1a\xcf7\xb0\x08DZ\xf7\xdbh\xf1\xc2\xd0L\x19\xb4h\xea\xd8\xb1J\x07^\x0a\xcd\xaeHtJ\x9c\xe2\xa33I;/l\x0df\x11F$l\xae\xef\"\xe8\x162\x1c\xdf\x13\xec$\xe4?\x82}\xe6\xf7\x9d\x86\x99\x9a\xc3\x16\x0bsn\x13\xb1\x0el\xb9\xd4S\xa6\x8a3\x974\xb1b\xec\x1et\x1e$\x8e\xdb\xa8\xa9\xd2O\xd5\xb5$\xfe\x1b\x17P\x18\xc49T\xca\xc0I\xcd\x8f\x93v\xbd5\xd4F#U\xb9\xb8\x12\xdf\x10\xc0H\x107\xc8\xeaG\xc5\xd6U#<\xecy\x83\x87\x06\x87Mq\xdb7cX{t\x12\x9f\x8b\xdc\x90\xbe\x88\xc0@d\xd6\xe2\x8c\xb2\xb1\xcd\x94R\xaf\xe0\xa6\x8e\xb9\xc4\x92\x19K]\x01k\x19\xc9-9\x92\xf2-\xc7\xcd\\\x94=\xael\x96O\xc1\x9c\x17\x04\xa4\x19\xc3\xab\xa6\xfe\x99w\x89\xa7\x17\x89vt\x91\xe8R\x80\xfbmB\xde\x80\xab\xebo\xeb\xa5\xfc\xce[\x0c\x10\xe4w\xe2\xa2<\xa6l+\xaf\x0ei\xdd\xaf\x9a\xe3\xb0\xdc\xbb\xbc\x84\x9d\x1d\x09D\x08oz\x09p\xf4\x95\x06Vr-\xc4\x8cs\x15n)\xc6x\x0ay\x8e\xb9\xcc\xcc\"b2\xd9\xc1f\x9c\x971\x0b/\xef\xac\x01\xf6pES\x0d|\x08\xb9\xe5\x8a\x7f\x9a\x7fc]b\xb9J\xbb<x\xf1\xd3D\x85 `%\x82\x083\x7f\\\xc3\xa7\xc3\x18\xa3\xf80U\x0b\xc8\xc0\xc6$\xbfv\xbfm\x04\x0d9\xc1\xfb~\xf3\xb7\xd7\x91k\x0d\xc0\x90\xf53\xf9\x99r<l\xc1\x0d\x8e\xdbS\xb4\x05\xa5Ir\x0d\xd6K\x13x+(r\xf4\x81\xc1\xec\xec\xff\xb3>\\\xc8\xff\x18\x85\xac\xc7\x05\xdbl\xfan:{\x9b4P\xed\x1c\x98\xb5\xa8\x0b\x97\x02U\xcd\x05qY\xff~\x1b\x1fo\x0a\x95\xd0;\xf4p\xa6\x03\x98\xa6\xf8\xd7sN\x12~\xaf\xeaU\xf1\x1ee\x08W\x16\x98^3\x9e\xdc=8.W\xf5\x03,_\xf3\xeb\xfaO\xb0f\xdf8\xbf\x8e^\xfa\xbc\x19\x9b\xdf\xe9>\x91p\xfau'\xc0w\x0e\xfd\x90y\x1a\x86\x03B\x98f\x0a\xe6O9\x92\x01\xfd:\x06\xccT\x08\x05\x1e\x84\x8d\x82\xe4\xe0\xfa\xfed\xdfy\x8a\xc3\x07\x0c\xa6\x99+\xdct\x15\xc7\xe0\x8dL\x11\x8a\x9dV/\xf0\x89\xb8\xc0.\xd0\x90\xa6\xf0\x86\xbf\x0c\xeb\xf9\xce\xff\x15\xc45\xdc\x86\x04)\x97\x82\xb2cg\x99\xbe\x11\xb9f{4\x10\xecf\x92\xc4\xd1\x8b\x15M?\x9c\x91\x9ehH\xd5M\xdc\xbd\\\xea\x9b\xd6\xd7\xe9$\x89\x1d\x17\xda\xce\xcc\xb5\xbf\x0d\x9b\xde\x9f6\xff\x0ci\xa6k\xa3\xd7c=\xed\x0e\xfd7<\xa5\xe5~++\xc5\xebUp\xc7\x0d\x1d+x\xcb\x07\xb6\xb7hI\xc6\x90\xce\xd6\x05\x83\xb0\xb9a\xa7\x90\x17\xc2k\xaa\xaa\xaf\xe3O\xbd\x92\x17\x838\xcc\xfb\xfe(R\x02\x9d\xef\xcb\xd8\"\x9d\xd6\xa5\xf2\xac\x80\xa2L\x134\x88x\x9c\x81j\x83k\xc3=\xddKS\xdd\xfet\xdb\xeel\x04\xa7H,\x1a3\x13\x9e\x83\xc0\x99>\xa8\x89\xcd!\xe78m\x7fX\x81\xb7\xed|\xd8\x9d\xae\x0a\x8az\xcc0TG\xdc#\x13\xa7s\x86p}\xfa\xbbN\x0c\xf3\x14c\x92T\xd7\xed\x8cW\x05>\x0b\x00\x8e_C\x19:\xd9\x99j\xa5\x8d\x00\x90|\xca\x11\xa8Hz,0\x92\xac\xbf\xa7\xeb\x80\x05\xb8\x0a\x87\xc6\x92zU\xaer\x8e\x9e\xb6T\xcd<\x06\xe4\xb1\xe5\xf4,\xc9\xf4\xae7\xd34\x96\x81[n\x9eI\x888\xf8\xf7gO\x8a\xec\xee\xb6\xa6mi\x82pr\xcd*\xa0\xb3Y6P\x14\\'\x8a\xa0qH\x19\xad?\xaaW\xbc\xce\x05\xc6\xf2\x1aV\xebJ`-\xeaK2/\xd1\x8f\x96\x13\x8f\x08 \x00\xe3\x16\x01\xb6\xf4d\x03\xba\xe7\x19\x94h'\x8a\x80?\xf3\x0e\xe3\xa4\x96O\x08\xe0^H\xca\x0d,\xca\xbe\x9a\xab\x1f\xe9z\x0e\xeanf\xc6\x0b:e'\x00\xb2-Rdd\x8fKp#\x88\x05`\x8ec\xc9\x9d1\xb22\x8d6\x08\xc0 \xc4\x81S\xfdb\x92k\xba=N\xbb\x09\x84\xd1\x10\xe9d\xd37\xa3;\x0er\xadm3B#\xf7:c\x0e\xfa\x945\x00\x8a\xf8Z\xbe\xbaM\x92\x83\xd5^\x01\x06\x01\x93\xe4\xcex\xb1\xbb\xd9\x92\xa6\x9f\xdb\x9c\xbe\x80D\xca\xe7P\xf9\x83E\xac\xc8\x97\xd1\xbd\x14?\x94\xb0\x9fx\xe3u.\xf4\x02=\xe40\xe7ko\x06\xfd\x96\x81JN\x87]\xfa\x01\x13$f\x06\xc3\xe8G\x84h\xd5\xcc\x04L\x1d\xb0\xf0\xa1\xc8\xdd\xa3ar\xf7P\xe5\xa3\x17\x90`* \x88J\xc1Vj\xc9\xc8\x83)\xe6v\x04U\x80\xfd}\xbe\x95o\xd4\xb5i\xbe\xe9\xf5(\xe1\x18\x88\x11\x0f\xa1\xceZ@\xc9\x1d\xbd\x8b1**{\xa0\xb1\xf7<\x1f\xb8\x12\xe8\x8f\xf5\xff\x86q\xfa\xa6\xcf\x81?r\xea\x1f\x8c\xae\x1b@\x04\xf1\x80\xed\x17\xb2xu\xc3\xf5\"<\x80\xa7\xcd\x88\xff\x88\xed7\xb8\x1d\xd6\x8b\x86,\xf0\x92\xc6<$\xf9\xb0\xe6T?\xc4\x19\x15\xd9\xbc\xf5v`\x14\xc9DB\x02Jh\x9d\x87\xa4\xc99\xb74P\xad\xcb6F\x08T\xea\x95\xf8\x9e\xb6u\x12\xcf\x99\x00\x8dm\x8e\xf2!ln\xddC\x91+\xf2\x08@H\xd7^\xd9\x1b>\xcf\xa9\x1e3\xf21'\xa6:!\x00\x90\x16\x0f\x93s\x1c\x92\x9b\xf0pP\xe5!t\xb6B\x15\xc8\xe6\x05@T\xbd\x86\x05s\xdd\xcc\xe5W55C\xec\x9c_\x04\xbf\xd8i\x11(e\x90\x11\x8e\x921ax\xc1\xbeU\x11\xa4O\xd5{\xfah\x04\x81\xb2\xfc#DK\xeb\xfd\xa0\xfe\xbc\x88kY^]\x97\xffq\x13\xd5K\xc8\xb2\xdd\xca,^bg\xc8\x95\xbb\xe4\xe8\xac\xf9\x86\xb2u\xeeW\xa5\xbf\x99)]\x85i[\xe9J\x95\xc0E\x1b\xc8\xd8z\xe2\x01\xba \x96\xc6iH\x12\x95\x88\x94\x0d\x1b\xd2\xa97\xc5\xc9\x08e\x1fL\x02\xfe\xa0\xe0\xe5\xcd\x04\xcc\xca1L-}\xbc\xc0\x0eX\xceP~\x0c7-\xbcv1\xb1\x8bT\xb4\x86\xf0\x0c\xdbv\xeb7y{\xa8BQ\x13p\xf7+\x820):\xb0Y\xe4\x05T\x08\xc8\xde\xc3\xab\x18&\x1aJ\xcb;o \x106a\x81\x81\xa1\xb9h\x0a\xe7E\xea\x07X`\xdd\x81\xdd\xdeV?\x02\x0c\x0fy\x9f~+\xae\xf9\xfa\xd6~\x0bm\x056\x94}c\x12\xcb\xd0z\xf7=_(\xdel\xae\xfa\xab3\xac\x0c=\x19\x19D\xa3@\x01\xc8)h\x0fP\xdd\x9e\x92\x9d\x03Z\x00\xb8\xafT\xc6k~\xce\xf8\xe7\xac\xc2\xeee2\x08\x1c\x17)\xdc?\x03\x88MYb\x05)\xcf\x07\xfe\xbe\xc4\xaf\xab\xb0\xeaCL\xadG{\xff:d\x02EU\xe6*I_\x88P\x1d\xfc\x8f\x0bl\xdf9\x85\x88\xdd\xe4\xb6'\x09\x8c\xe2\x19\xda\xcc\xf6v\xbc\xc8\x0d\xe1\xacc\xc2\x83,{\xe7\x80F\xdc\x7f\x88\xd3\x18\xd3\xe6\xeb\xff,\x13\x8b\x94M\xbc\xaf\x98\xa6\x09[\xa5\xd9\x08O\xcd\x03\xb1\x7f$\x81\xd4\x9d\xa7\xfd\xae\xad0z\xae\x8fI\xd1%-\xe3lS\xc8\x1d\x89\xee\x97\x03A\x89\x1ej8\xdf\x96\xbe#\xa8\xf6\x9a\xa9\xe7\x86\xc3x\x15o\x15\xf3\x8a\xfc\xb6\xdfw\xbf\xad\x06\x02q\x1b!s6aS\x1a\x98\xceqZ\x81\xae\x170W\x94.\xbf\xa5\xfd[\xe8\xb6\x02\x80\xf5\xce\x99\xb4\x81>mN!\xff\xf4#\xbc\xc8#\x19\xb0\xf6\xf6\x9f\x9e}ki\x8c\x99\x99*\xe8\xb1J\xba\xc5\x8d\xf6\x86r\xdc\x8cZ\x82x\xb5\x1d\xca\x8cY\xe0*X\x8a)\x19\xbc\xfa\xed\xfa\x9b\xba\xa3\xa9@\xb4\xe3\xfa\x1ao\x10{lv\xd2%\x9e\x0c\x97\x12=f\xe1x\xf55\xccu\xd5\x8c\xbb\xc7\xf7 \x07\x8d\xed\xed\xa4.0\x83\xc1]}S\x09\x8dmN\xa0L\xe7!\xa3\x99XBr\x12\xd9\xea\xaa\x19\xf23.\x90\x11z\xa3\x8at9\xe2\x1c\x8a\xa5\x0ap\x06\x1f\xb0E\xbd\x996gz\x8d'\xc6- (L\xb7\xa1@\x89\xfe\x09LVwVj\xef\xf0\xa7\x1f\xdc1\xe5\xf3K\xc7\xed~\xe20\x07\x7fkD\x95\xd9?F\xb8=\xb4\xe2\x04\xe0\x1c<\x93~\x02\xf0\xf4\xcb\x10\xf0\x19\xb0\xd2\x8f\xbd\xec\xf6\xfe\x8b\x9foYZ\xf30\x97\xc30P]\x80\xa6\xed]T*\x14&\x80`\xdf\x1d\xed\xf9\x86\xf9\xa3\x93\xd6l\xe8\xc0\xbd\x9fm'\xc16\x88\xbf<\x8bK\x1d\xdb\xc9\xfc\xces\x13\xb0\xc7\xfc\x88\x88\xd2o\xe0\xcb\xaf\xc0J\x07\x1b\xcd\x8d\xa1\xfa\xee\xf7q60\xf9'Z:\xec\x02\x88\x0en\xdc\xba\xd7\x94\xf8SP\x15\x8e&\x9a\x11d\xc9\xc9\x82\xa7\xc7\x18\xb3D\xe8\xc4\x91\xe2\xca\xc8\xcf\xcaV\xfe\x84Z\xda\xc8\x91\xeb\xf7/\xd9x\xddr\xc3r})\xbfm;[<\xc6\x0cN\x11\x9c\x0d$,\xf7\x09x\xf5\xfdm\xe9F\xb4\xa4\xb1`8\xe1\xb0b\xcc.%\x00\"\xeaQXW\xd5&f\xb7D\xc2\x13\x82\xd1R\xf8\xd9Jw\x96\x89\xc8\xb4\xd3U\xee\x0f\x19\xda\xf7\x9c\xe50\x95w\x98x\x93\xa4m\xe5\x06\x95\xd3\xe8#\xc1Ix\x9c\xed0\xaf\x06\xc4\x13\xb3\xfeG0 \xb7\xc1\x8c\xc2c\xcc\x81>\xf0\xa51:\x89\x1b\x90^\x19l\x0e\x81r\x97\x10\xfd\\Ccri\xd3\x8a\xb9\x0eo\xf7\xc6s\x09\x85R\x0cy\x17\x04\x8e>\x82\x13\x84\xa8WV!\xe3\xee\xde\x98\xb2\xbc\x1b\xdeP\xa6}\xe8\xab\xd1\x91\xbe\xa7\x0a\x83\x9f\xce`\xa0\xbc\xe9\x17\xad?\x82[P5cl\xbe\x9bH\x8c\xce\x035nt.\xb1]E\x08\x84E\xce\x0f\xf0\xc2\x0f\x06\xbe\xe5p_2\xdc\x8c\x83)\x0akd\x9bF\xc4oT\xcd,r\x00\x11BFG\xddw\xc9\x9d\x01\x9d\x8e\xf503Ri\xdbg\x04\xd6W\x90.\x9c\xb2yrK\x80X\x138\xa2\xe1:\x81)\xd6\xcf\xd5\x1f\x87\xb9\xbf\xf7g\xa4w\xb3}\x0c\x09^\xee\x9b\x81jg\xf0\xa3\x858\x8c\xb7\xc0\xe6!\x86\x91)4\xc9\xdd\x8d(\xa2<\xa1\xa9\x0a\xb4c\x08P\x02v\xfc\x1a\xe0\x81\"5\x011\xba\x93\xdcQ\xc1\xd0\xa3Q\x98\xfb\xfb\x86\x00\xab\x90t\x04m\xfd\xfdn\xb4\xe6uP\xce\x82o\x11\xd1\xa9^\xf7\xd6\xfa\xf3\xf8\xbb^\xec\xb1\x1e\x9f\xea\\f\xc7J\xd8J3y\xbf\xf6\x97r\xd1\xad\x09\xdd\xe8\x84\xa8\xb6f\\J\x8evo>\xb0+KtW\x18\xe4g\xfe\xd5T\xa1\x10\x8eV\x80\xec\x8dE\x9a\xda4\xf1\x83\xebP/:\xde\x07\xd3\xcd3D\xe53\x87\x94\xb2\x19\x0e\x95\x82\xe2F\x88\xefK\x8b2\xb5\xd5cp\x16=\x1c;\xda\xa8v\xf4\xba\xfb\xfa\x0a'k\x9a\x9c\x96\xe0C.qC\xa4/\x19.\xd8\xfb\xa28\xb0w\xbd\xa8U\x0f^\x98\xb69iC$ON,)p\xb4c:EJg\xd5!\x82\x7f\"y>\x1e\xb0\x17\x92RQ\xe8\x08\xa2\xc9J!n:K\xec\xa4\x01cC\x86J:O\xa9\xd2\x15&h\x94\xb1k\xf1\x97\xf0\x87KI\x1cd\x97\x92\xad7+~\x89\xc77\\\x1fHQ\x07\xbc\x01\xf6\xa4\x1f\x83\x83\xb8\xc0\xb6\xfe\x88\xe8\xf8^:j\x91e\x01\xa8\xfc\xe32\xa2\xd5\x1aA\x02U3\x0b?\xa1\\\xe8\xc8e\xfd\xfe\x99\xda\x81\x0e\xb6:\x1a\x9d\x9e\xceH\xd4\xf7\xcdtC\xe7\xf3\xbc\xdd\xa3\x0b\x08\xbd\x90\xebf\x8d1K\x9d\xc33\xedpYS$\xdb\x9c\x8aw\xc2\x84C\xcch\x02>$\x1e\x18\x84ry#\x02\xf0\xf5\xd6@\xec\xaa\xa8;\x81\x0f\xbcD\xffN\x7f4\x81\xa5|\x05\xfd\xe7S\xd9\xcdyj9\xd8$\xbf\xf8:\xbc^\x0f\x86|\x9cgl\x89\xe9Q\xbdH\xce\xc6\xc4\xec\xa3\x0b\x9c\xebM\x89\xdf\x08\xde\x02\xe4\x14;\x17\xd2\xce\xd3+Q&\x89\x02\xbc\x15\xb8Xn%oE\xa6/L\xe6\x01\x8c#\xa5\x81\xa3\x92\xf1\xad\x82\x10z%}=1\xca\x97\xa2\xbdye\xeb\xdfNC\xcf=Dd=v\xdf\xaeW\x93\xd2\x97\xf4\xa8 \x96~\xb9\xb9\xa5\xef\xc8\x1f\x1a\x15\x19\xa5\x0b#;J\xe4\xce,#\xc8\x10J*\xab\xf4\xf0\xa1z\xa7LK}\x07T}\x13\xcdQ\xf0\x10\x8c\x03\xee\xf5\xa9j1\x92\xdd\xb3\xe4\xb8\x83\x17\x1a\xf7)\xc2F#\x87:%\xb24\xf10\"$\x00X\x16\x03\xb6\x02\x09o}\\-\xf5\x7f_\x85\x91;1\xd1\x80\xc8\x85'\xfa#\"\x15\xe4\xb9\x8a\xbaK\xcf\xc3\x1d\xa8\x80I\x10._\x13\x1b\xfe\x1f\xc1\xff\x87!\x92\x09;\x93x\xd1\xa5:m{_\x03\x1ei\x96\xd0\xd7\xdc\x10E\xf7\x0fR\x8d\xa8l\xa6\xc8\x94\xc4\x08\x88Xl*\xd8\xec\xc2\x9a\x814'4\x8e\xac0\x93\xa3O\x01\xea2\xc0LO\x95i>\xf9\xb9\x12\x14\x07\xcf\xc5\xa9mF\xb1\xf0G1/\xbd2\xd2\xcdG\xaf\xc3,\xc7^'EZ`0\xef<h\xe5\xd3\x1b\x91\x0e\xf6\xf3\x05\xe4\xc7\xb5+4G\xf4\x1e3\xcbN\x98\xf4\x81\xe0\xe0\xc4\xd06@\x19\xa9\xc9\xd5\xde>\xb6\xa7\xd0\xed\xb1\x1f\x09\xc5!\xe6\xc0\xc9\xae\xaa\x1c3,\xaeK\xe6\x14\x8e\x09n\xa5\xda\xcf\x00\xd0n\xebw\xbc\x91\xc8\xdd\xe0\xdaD\x17\x00lCJ\x01y\x9d\x1c`a\x9e\xef\xb8\xde \x92\x1c|\x1e6'\x84\x9eVV\xbe\x14\xd0\x0c\x87\xb1D\xe5\xd0\x8b\xd4\xda\xcbn|}\xae%\xdf\xf5\xcf\x9c\xfcz9h\x8b\xd3t\xee\x18\xa6\xedc\xf8#\xe0eBl\x81e\x91\xb8\xc4\xb3\xaa\x1f\xd4\x8c\x15+t\x86\xbc\xc7\xf5}\x1e!\xa6sp\x98\xec\x00\xbc\x92\x98U\x81g\xe0\x05\x7f\xa6\xba\xf2\xf2\xe1\xbc\xed\x8a?\xcb\xf4\xc4\xab#-!\xd1O\xe9=\xa5\\\xc1(\xfc\xafj\xdak\x05&\xfb4\x90mo\xcf#\x8c\xfcY\xc4W\x90\\\x8cB?\xbc*!\x90~hA\xd0|\xf3\x18\xc2\x9d\xc3\x17J\x8d\xf0\xfb\x185\xd0Ibl\xf7\\>\\\xa6O@\x10\x86\xea\xf3\xbb\x85\xff\xc0ca,@\x03\xe2e}%\xaeY\x12gr\xe5\xfd\x0e'\x9a\x11G\x95\x1d$\xe1\x16P\xfd\xb5\xd4y%\x00X\xde\x8aY\xe8\xc2\\\xcaY\x90\x7f\x87]\x91e\x00\xb6\xe2;1\xc2\x80R\xd0\xb4\xa5+\xf3p\xf84\xd5\x97\xdeSa\xad\x94\xd5|iQ_\xf3+\xe1-\x88R\xc1\xa2D\xa0\x82B\x17\x00N\x15\x14\x9eY]\xfb2g\xe0pP\xbe\x0a\xf6X\x15\x0f\xbf\xb6\x18\x02\xe0\xaan\xc9\xberm\x8c\xc3N\x073\xbc\x89\xe0\xd5V\xde\xc9\xb8\xf6(\xbb\x85\x9b\xa1x0\x92\xf3\xfc\x9fY[\xea\xe1\xff\x11`\x9b\xaa^\xe6T\x8d\xa2u\xad\xe4\xc3!l\xfe]\xb2UB\x1cw/\x0b\xea\xdc\xec\xe0\xca\xd2\xb9\xf3\x95\xf7\x04p\x0f8\xb6\xdf\x07\xbf\x19r\xe0\x19\xd5$J\xd3t\xf9\xd45\xbfc)\xa7\xc4u\xe3\x1d\x89]\xdd\xcc\xe8\xad\xccf\xa7\x91C\xd9\x96\x85\xe6\x1c\xe1;L\xce\xe68\xf0en\x0b\xebn\\\x10Tq\x8e\xc4,<\x07a\xe5a\x0a[\x9dr\xc9^>\xc3\x9c2\xe2\xd0\xf6\xf5R[~b)E\xc6\xb3\xd6\xea\x85_Q\x94:q\x8f\xa0\xa7;\xdc\xb3/\x03\xac\x161\xa7iXF.\x99M`=d\xe9T\xc8sX\x86\x97\xe7\x83T\xfe0\xd5\xca\x85\xbd\x06\xf0If\xde@\x90\x05\xa5\xe9oE\xca\x83\xd4V\xa0\x92X\x90H_\xfc\x01b`\x07\xd4\xd0'\x1e\x87b\xcfS>\x02\xcb\xfa-\xbc\xe6\xdb\xe0\x92:\xba\x19I\xfc/\xe1\x00\xf2\x11\x0aw\xe6b7\x0e\xc8\xae\xa8\x051\xdb(\x19p\xb8\x9flSY\xf4\xb8b\x0f\x07\x19\xfa\xf8`\x0eS\xbc@)\x01\xeeB7\x885)\x91\xe1Is\x12\x1b\x14\xf7\xb4\xc0\xc1\xe1\xe9Ut;\x0am\x00\xe7\x8a\xdc\x10d\xa1\x8b\xe9\xf8\x92\xd6\xcd7\x92\xa8s#\x83w u\xbbz\x0d\xa0\x03O1\xcc\xa0Ky0\xaf8C\xc0\x7ft\xe6\xe0\x94\x18k\x8e\x19E\xad&\xc6\x13\xfa\x91\x14\x8c\x8c\xe5\xde(\x129\xcc\xbb9n\xe7\xa8\xd5\xf6\x15\xa0\xaf\xf3\x81\xe3\xef\x9c[uN\xe9\x842\x07\xffU'\xef}\xb7\x94\xe7\x8b\x04\xd4\x90\xa4<\x1e(?Ld\xac\xa6\x06\x8a-R\xa8\xd3TvAv\x06P{$\x08x\x00W\xaa>\x9d\xc7)\xe6\x0e_\x0c7\x89\xf2M\xee\xe6'\x8a\xf2\xa7<8\xd9\x81\xc9\x19\xfa\xf5\x80/\x15d\xd4\xa6\xe2\xf4\x7fz\xddU+\x0e\x04~\x84\x116\xc2u\xc7.SV+\x14\xa4e\x0a\xb6<\x8a(\xa1\xb6!a\xe8\x82>M\x13\xbbJQ\xc9\xd2D\xc5%\x8e\xc3\x00\xecD\xdcZ\x13\xb2C\x06\x14\x0b\x83^\xf8_\xb7\xea\x9cd8\xdcg\xf12*x\x94\xe12\xc8\xb3\xd0acM\xc5LCY\x9d\xef,Q9i)L1\xb3\x8a\xad\xff\xf1\x87" +
+	"\x8cp\x0c\x8bG\xde\xc3\xfdc\xfa\x02T\xbf\xa1\\\x03\xbb\x13\xe7\x86\xf1C\xc2`\x8an\x19\xdbQX\xae\x98#:\xb3\"Y\xc2\xd2\xcd\x19a\xb94\xd4\xf0\xa6cd\xaei}\xf1\x95`\x92.\xa4)\x14a\x81<>y\x80\xd77\x0eE:\xc3\xcb\\Yt\xa5\xa4\x0e\x7f\x0fc\xab\xfbC\x9b\x93\xfc\x10*\xc3\xdd\xe5\x0a\x9cv\x0f\x03m\xd4\xf7\xd8\xae\xa0\xb3\x8c\xbc\x95\xa1\x09\xff\xc1\xf9\x86\xd7S&\x909\xd1_\xafL\xb2\x0d\x92\xea\xb2\x8f\xe3\x84\xe4\x0c\x06~ED\x82Y\x89\xc6\xef\x9c\x9fT\x04yx\xe1\x90\x01\xeb\x9f\xdbcW\xd7\xef\xad\xedm\xcc\xb6\x93\xf3\xe4\x94\xce\xf9\xe6\xc2f\xadl{-\xf5\x87\xe1s\xe3@Fpd\xbd\xc0]\xd0\xe7\xe4\xf1h\x88\x9e\xe3\x15wzi\x16\xb6\x83f\x14\x93\xb8\xe2\x19Y\x11\x87\xcfT\xf6\xea\xab\xef\\\x11\x7f\x8f\xca)89\x8a\x0d\x93\x94Z!\xbce\x0cv\x85S+\x80m\"\xa0\xea\x93Z\x97{\xbd9\x89Y\xdd|\xe1\x18\x07\x8d\xa9R \x81\xd2\x1cK\x17 \xc9'\x17i\xce2\xe5\x0e\xe4\xb5r\xdeA\xae\xe7\x84]\x04k_\x86\xf0\xeb\x82\x8bf\xb2\xd3\x95\x0c\x9c\x97\xa5N/\x1a\x13V\xd2M}\xafFH\x91\xfcU-s\xb5'\x03\x108`\"\x85\xeb\xfa\x99\xc5,|\x0b \xf9s\xeeE\x08+v*9\x1f\xa6\xcc\xea\x1d_\xd2\x1b9\xe6\xeb\xa1\x0bM\x94^!}6\x16\x98>\xb1\x0b\xe7-x\xe0\xe7\x93\x1f\x1d\xbe\xd8\xb7\xb2\xef\x9b>\x0c\x1bp\xf6\xb3\xcaG\x8aT\xc83\xa1\xb7P#f\xa7Oi\xe7^\xc8\xa3\x01f\x0cs\x02\xe7-cq\xa0\xc6\x02\xc6\x01\x1ap$\x8aM\xf4\xc8\xde\xc5\xb7\xd0\xd6\x0b\x03\x04\x91\xbc\x15\xea\xf1wM\x19\xc2\xd8\xb4\x877\xa3\xd4'7\xcc\xd2\xd0\x1c\x09k\x81I\xd0\xc2 oD*S\x88\xd4\x84S\xfb=\xfa\x06\x03\xe03\x96\x05\x00j\xe8\x8e\xf1s\xdb\x06S\xf1m|6-\xcb\xa6\x0f\xacnc'\xf2N1\xbc\x17\xf8\x91\xf6\xd6#\xf3\xc2r\x06\xca\x88> \xb5\xef\xe0\\\xddQ\xf20s\xa4Jw\xc1*A\xfb=\x1e0Ga!\x16\xcc}g\xf0\x1a\xa2\xc4\x83\xdc\xb3\xcc\xf3\x0eS\xf1\x0e\xec\xde\x0dspiGZ\xca\x0b\xb8\xe8\x18X\xc8\x18\xbd\xfd,\x9a\xc6\xc3\xe1\x8b\x9f\x92\x1e\x9c\x87YHQI\xc7\xb32s\xd6~\xd6\x89\x9c,u\xf5\xe5\xd3\xe8\xf9\xe1\x13\xb5B\x17vdR\x93-\xa37\xecF\xbcC\xf6\x0ei\xdd2\xfa/\x89^\x93N\xbeA`\x7f\xcbu\xe8S\xcc>\xbc\xd3\x00;r\x9f\xbcn\x8d\xd2)<\x9e\x80\xb5\xa7\x82lI:\x03W\x9ay\xf8\xe95\x83\xe1p+\x8bP\xc70\xeaNH\x8dCv0<\x0dv$'\x19G\x83\x1bDB\xba3E\x1a\x93\x10\xc6\xe4\xc9b~v\xa2\x98\xe1p\xdb]F\x05S\x1a\xcd\xa1i0.\x82\x92\xb8}\xeb;\xf9\xad\x94\xc7G\xe6\x19\x94y\xc8\xdc'\xbd\x82\x7f'\xfb<\xad\xf7Q\xc3\xd2\x83Q?m\xc9\xa5'u]\xf5\xd6\xae&\xe1\xcb\x15\x92CP#\x91<\x1f\xf9F\x12h\x8a\x84\x82z\x9f\x1e\xb8\xca\x11\x1fsI8'ht\x84[\x0e]&\xdd\xebf\xc7t\x81\x89m\xf4\xa3\xeeA\x83\xa6\x1e\x94\xe3^\x7f1\xaa40\x98\x1e\xab]\x03\x97\xd2\x03\x89\xc5ch\xb6\xb2\x1d\xf0\x14\xcf\x95\x04\x94\x08W}\x88\x8bhD\xea\x97\x103.\xa4g\x9d\xc8\x92\x9a\xc1\xb2\x18\x92K\xcf\xb0r\xdb\xbc\x85\xcfb1b\xa4\x96\x8ci\xd0\xc8\x00?\x15\xf8\xd6\xd7U\xf6\xf3 6X\xed\xc7r>\x1c\xda\xaa\xaa4w\"\x93\x85\xd1\xe4QJ\xd6\xf6\x91+\xf5\xccx\x89kW\x1c\xc8\xd8\x9c \xb4\xca_aJ]Z\xde\xb2\xff\xc9J\x91}$\xde\xe8\xa9\xff9\x1c\xee\xb5\x1c\xa3\x06\x13\x92\xf8Nd\x80\xe1pL\xe5\x12\xb1\xd8\xc8R~2\xa9ei\x91z\x07A\xe8y\x9d\x0dR\x9c\xd242\x1dvn\xe0}y\x1c\x82\xbf\xf6\xf0\xe8\x98L\xe3\xaf\xa24\xe67\x03\xe1X\xa6\x8c\x82\xb2\x10\xc5\x94\x1c\x1c(\x86\xc3\x9e\x92\xb5\xcc\x04\xd5\xf2N>\xba\xf41\xa6\xdb\x10x\xcd\xa2\x80\x9eH\xc5O]\xa89\xbaG\xa8\x1f\x03^71\xf8\xcf01\xb7\xe19\x93\xc4|:\xc6h?eR\x8e\x16\x8f\xaaA\xb8g\x10\xf7\xc9\xe8D\xd6\x02\x15\xed\xa1\xa1\x94:\x1f\x80z\xc3Q\xbf\xc2\xda\xf00e\x19d\xb9\xf09p\xa20\xf6(\xc3c\x12\xd6\xf0\xbdU\xf7\x9e\xe2 \xca?\x8a\xa6 \x11\x96\x13`>\xed\xb2\xab\x84\xdbL\xbc\xe8-q\xee\xd6\x0b\x07\x9dR/i\xc3\x90s4\x15\xb4\xb6\xd6\xc92y<\xc7\xbd\x92A\x9e\xe0\xd3\"\x1d\xb8\x09\x9dDK\x0f\xf5\xc7\xc3M\x92qNK\xa8\x06\x1c\x8a/\xd2\x03~\x1f.S\x0dLA\x0e\xb0>\x97\x8cQWX`\x11\x11\xa7B\xd5\x12\xd3W8\xb0\x0f\x84\x17\xa8\x96U\xe7\xbb?A\xf2gk\xd8\xa22\x94@\xe5t,o]\xcd\xc4%\x8d&D\x88x\xc5\x90\x0am\xf0\x92K\x13\x89\xd72\xcb6\x1bixD\x02K\x9ek\xac\xf53\x0b\xb5\x0f\xb7\xb6\xfe\xdb\xd65\x1anm\xff\xf3\x176\xcaH\x13\x81J3W\xf5z\xa8 =\xaa\x89h\xb8\xb5\x93#\x17_PT\x82\xd2\xf2\xf5\x02W\x0c`\xc4'Z\xa4]t~\xb8\x12\xa2\x97\x84\x12\x84C\x1b\x8d`N\x97\\\x8a\xb8+\x0dx\x85\xba\xfb\x0e\xef\x843\xc9\x97c\xdc]\xa8UP=\x97W\xae\xfc\xb2\xaa\xa8\xc6\xbf\xaa\x1e\x7f\xf2\x187C\x19\x0e\xb7|\xb9\x8e\x94\x05S\xf7?E;\x0e\xa5E0]\x9a\xb0R\x0e\x03m\xac\x89\xees\xbe2d\x8f\xb2%e\x82\xa5\xcc0vU~\x92\x11!\x13\xeeL\xe0\xb382\x95\x10(\x92\x8d\x83\xd9k\xe1\x18\xb02\xf7\x03\xdb\x1f\xc8\xdc\x7f\x08\x97\xbe\x80\xc8\x8a\x91qd\x80\xe2B\x00\xb9\x83$\xd0\x93\x1d\xac\xd80\x9e~\x0cU\xf5c\xe8h\xaa\xd8\xff.cG\xeez\xce[\xa6u\xaf\x8b\x0043\x06\xa5H=\x0dB\x04\x8e\x0d\xa2sI7R/t\x88\x83=\x14bjW\xc1L\x05\x13#\x93\xf6\xb2\x1f\x05k\x05\xaf\xc8\xef_)5\x0fK\xe8\xac\x01\x18O\xe2wN\xf6!\xa67\x09\xbdC\xe7\xde\x04\x9c\xa8\x0aQ\x0ci\x87\xbc\xe9\xc7\x0e\\o\x1f\x00\x0e\x12\x7f\"W\x9fA\xa8&,\x14\xa7\x06\x93 \"i\xf4EZ\xa2\x9d\xad\x0c\xf7k,\x86\xcc\xd1p\xb7,\x00\xd2\x16!\xa1\xaf\x98\x85\xb1.\x97X\xf7m;G,)\xa2WAi\xe9\x05\x1e\xaex\xf1\x8b\x1e\xf2\xff\x14\x86\xca\xd8\x94\xd6i\x97@\xd1p\x8b+u\xf6\xb9\xe0\x85\xc5\xa2\x17M\xaa\x90\x07\x0e\x06\x9d\x00Q\xeccv\x98s\xa1S<i.$\x8a^]\x98\x8d(_\x16\xa2\x9e\x8a\x9f\xa5 \x95\x89\xaa9\xd6O\x0f\x8a\xfc^\x93Q\xc7\xa8>Q\xf8i\xb8u\xd85\xae\x0b0z\xaf$\x09I\x95b#uA-\x93\xad\xf5\x93\xdf2\x08\xe5yI!s\xed>\xf8\xa9$\x1a\x0cn\xf1D\xaa\xe2\xcf\"\x14=Xg\xf5\x12\xad\x03\x0c/\x1f\\\xb7\xb5e\x94\xb5\xbc\xe3\xee\xe1\xdd~\x91\xa6ViW\x87\xde\xba\x05\xc5\xab8zc\"\x7f\xc9\xfbHN\x99'\x84\xd7\xa3\x98\x0f\x95N\xa6NoaAo\x10k\x8d\xe1\\\x1c\xd9@\xa75\x97\x99h\xc2v\x00\xb9\xc6\xa6S\xc1p\xe4t:;\xdbC\x99U\xb0'\"\x17f\x8c\xdb\xc85\xa0\xaa\x99\xe8\xdf\x98\xfaP\x0c.\xc2\xe3\xc8St\xf3\xf8`[\xf7\xb6\x9d\x020\x06{\xd7DZ\xd97\xe1\x14NiH_\xe4\x9f\x83\xd4\xce \x89\xbd\xe2\x01\x96\xafR\xeay\xfe&\xd3dD\xae\x8c\xc1ZN\x08^<\xe5\x1b\x8d%M\xa0C)s\xd7[Xk\xb8\xbd\x9d 0le\xf0\x1e\x02\x96[\xc6*\x90\xc7{\x03\xdd\xa9+\xc9\x99\x87\x83U\xa4-K\xe5 \xb3\x88*;\xfa\x936V#\xafip\xe64\x97\xaa\xdf\xb2;q\"\xcf\x09\xf1\xc7u\xd6a!\x9b\x1e\x1e\xe9\xf4\xca\x0f\xeb\x1bfs\xe2[\x09\x97/\xca\xf5\xa8<\x1b!q%\xd0g\xa3Y\xa5 \xd0\x96\x92\xf9 =\x13\xd9\xc9\xd7\xd4,5\xc7\x9bJ\xa0\xae\xe0\x1c\x8b_a0\xb6jF\x17_4T\x07~\xa2Pv\xc7\xabk8H\xc9~=D\xc3\xed\xdd\xb6R\xbeQ@\xe8]\x8d7\"\x9e\xcc\xaf0\xe76\x09\x91$\xfc\xa1m\xe5\xd47\xb4\xd0G<\xedi\xa9L\xfb\x8d>\xf6\xb0e\x1c\xf6T3\xd5i\x8ei4\x13\x0ed\xe0\xee\xb2Kf\x9aH4d\xbe\xfc\xcd\xf3\xee\"\xd7.\x17\xb6\x9er'\xd5\xfa\x93\xa7<\xe5\xb0S\xc1\xc1NQyW\xfc\xc1\xff\x9d\xa3;d\x8d\x93\x04rR\x91\x9aR\xa4\x06\xfan\xb0\xee\x12\x88\xee9u\xcfg)\xf6\x95\x8e\xc7w\x8c\xcb\x94\xd8a\x80\xda\x10\x9a\x92\x1b\x9a\xe3\xd7\xe0\xf8Q\xe9O\xe1\xe9\x88\xdd#5\x95\xe2\xdd,\xc5\x1a\x89\xb09\xe5-[\xb1\xbd\x07\xd9Q\xc0\xa6\x8f\xa2\xf9\x11iY\xe8<\xcb\xd1\x9a$D\x00O\x8c\x92\xb69Jz\x07K\xfe#\xda\x03\x7f\x93\xc9\xbe\x839\xf4t\x96\xd9\xdc\xbb\xb9\xea\xcb\x96\x85\x10]\xa7\xb0\xbdo{,\x83\xc3\xb4EZ\xb2\x053\x9d\xef\x94\xe3\x93Q\xca\xacn\xb0<\xc2)\x9cj2\x86\x05dj\xf4\x19\xc1\x01\x1f\x19}0\xdf\x89\xd6\xa8\xe8\xe1\xd5\xdf<\xee\xb7G\x80\x05\xb4~\xd7=8;/<\x94\xaaC\x07*\x9c\xc0?\xaf\xc5+\x92c\xb4\xdfQ\xa7'\xdf\xb0/\\\x1aJd\x18\x8c\xca\xddT=A,\xc7\xdcg\x1e\xd3\xe1(m\x16\x977\x83\xbb\xd2\x04\xd8\x9f\x83P}\xb83\xfco\xd5\xb1\xdaa\xc8\xdf\x1d\xa9\xf1\x91\xf6\x07\xe5YY\x9f\xa9\xfec2c*\xbd=\x91;\xfaB\xd4\xf8\xe6\x14\x8f\x1f\xc5M\x04#hh)D\xca_\xbf\x99Iq\x14\xe4\xad\xa5\xad\xce\x8f@\xe2\xccT\xf5\xc0\xef\xac\x90\xae\xcf;Ly\xd7\x1f\xf0,/\x89)\x0a1\xba-U\xd2\x1d\x0d*\xb4X\x87\xb4\xe6\xa0x\x1be\xda\x0c\xb1\\\x1fd\xdf\xfcR\xa2A\xe2\xf09\xf1\x9e\xa9\xdb\xd92\x85\xc2\x94\x142\x03v\xc7K\xc4\xe5~\xbcG\xa5\x0a\x19\x02\x09\x1e7\xaf\x9a\xa2\x96\xc5\x0fi\xed\x92\xe2\xd7\xc2[\x0a:\x80\x8e4\xed\xd2\xae\x15\x03\x0d\xfeg\x1c@\xe9\xbdXa\x0cZ\xac5A\xaa\x9b\x93K\x9c\xc4\x86\xa7\xd7,\x00\xadt$\xe0a\x92\x06\x98z\x83\x0ef1\x98G\x8bF(3A\x87\xfd\x122\xfa\xbf\x07\x7f\xa8\xb0h\xff\xc2\x91\xc7\xf7p\xda\xe9\xb2\x19\x83#\x10H\xb0\".W'M[\x80f;;J\x9d\xe8\x16\x8f2\x11\xaf\x1d!*\xae^h\x18\x1cI\xa4xp\xb1\xd8UJ|\xe1\x02]W\xc1\xccR\x8aY/\xc2\x97\x0a\x94\xc2\xf2w#\xe1\xdaV\xf21\xe2U\x82\xcd\xf8 \xd5\xafI\xa8\x15`\x9c\x95V8\xc2\xc2^\xb8\x92\xd4%\xe7\xb9\x94\xb9\x1bu\x7f\xeas\x80b\x18<\x05\xefD|\x12Z \x1db\xa5}Z\xdf\xc7\xc2u\xc9\x89/H\x05\x18E\xc3\x95\x0f\xa3\x1e}\xe0o|\xec%\xf48\x80\xd2!\x07\x99+\xbb\x8f\x1c#bT\x8a\x8bD\xb4\xc4i7\x05\xac\x10\x8b7\xf2\xd1\xa8\xaa)\x82\x85\x8d\xb4\xaa\x96\x14\x0c<4\xe7\xe9\xafO\x1c\xad\xffDh\xa4\xf3J?4\x85\xfc\xd9\xf9S\x81M\xed=?\xfd\x91M\xb6\xc2\x103\x90\x8c\x11Q\xbf\xdd\x14\x0b\xb0\xb3\xf7\xbc(&\xa0\x03\xb7\x027\xc7\x9aK+\xf9\x16\xf22\xc1\x8d4Q\xa6\xde\xcayF\xea\xb9\xee\xcd\x9eyR^v\xa3\x16O\x92\xd3\x19\xee\xec\xab\xe1\xeb2\x00\xc7N\x8a\xfcX\xc4\xa5\x17~\xe6\x0c\xaa\x0cE\x0f\xe6\x98\xe1\xceA\x02\xdd\xc6\xcd\x19Vt\x85\x16<\xba\xfa\xb2mE\x9d\x16'R4\x92\xb5\xc8+-\x124\x17\x9dQB\xae\x80\xd6\x8cL\x84\xdb\xf4\xe5\xcc\xb4\xc5\xaa\xaa\x82\xb4\x89\xd7\"\xa5\xf1R\xd54\x7f\xc2\x96\xb4\x9dCO\xdbf\xa0N-\xea$\xb8\x06[\xf0\xd3\x1e\x98\xa6j6\x06\x88Z\x8f%\xf7\xb3\x05\x98\xbb/\xfe\xd9\x8d\xdaO\xebf\x8c\xa3x\x89\x12\x19\xe2-\xed\xe2\xc3\xdcH\xe2&\xdc\x0f#\xf0\x94u\xc9\x18\xe6\x1f\xa9\xf5Z\xf3\x02\xc8\x14\xa3\xb42\xa1\xa7\xe7\x9ey\xf0D[\xbe\xfa(\xc21\xd1r\xac\x8f\xff\xf5\xf6\xc4n\x8c\x04y\x99\xc7T\xb6\x88\x0cn\xa0\xe39\xdc\xe5\xd6\xc6c\xe5\x1d\xa4\x88\xf0\x01\xe5K\xcb\x0et\xc1q\x0fl\x81\xab\x9e\xa8x[5\x1c@\xbd{\x0a=8~*\xf4\xc0\xb9\x1c\xfbW\xaa\x0b\xdf\xc6\xc3\x9b\x89\xf7\x0cw\xb7TF\xdd\xe0oa\x81\x84\x19\xe0\x0f\xe6\x86\xe9\xa1\xbe\x8f\x7fb\xcc\xeeX\xae+\x87\x89\x07.\xdd\xf2X\xdbO\xa7\x10\x11\x07\xd9\x03|\x87\xeb\x03\x02[\x00$p4/\xa6\xf1\xb8\xb5\xf5\x08\xa8\xb9\x14T\x01\x88-:\x8f\xee\xdd\x9d\xc4Yw\x09O\x08\x904e@\xef\x12\x01\xbd\x10\x19\xde\xc9F|\xb7\x97nB.\xeb\x92\xe8\xd4E2\x9f\xc8}\xcf\x0cy\x95A&\xdf\x83,G\x0fl\xef\xae\xc3\xd5\xc9\xcc\x05\xe1oS\xb6\x16qV\xd4\xd1\xed\x09\xdcC_\xac\xeaH\x0a\x86\xbb~\x80?\xae\x7f\x10\xc6\xe8\x9d{\x0d5\x16\xac\x19\xc0\x1fR_8}\xe0\x83\x17O!0\xb8\x07\x9ch\xf5\xb8E\xd0\xea\x90\x0bQ\xc5KJ~C\xfaU\x86\x1d%\xa0\x06\x172\xa5+\xba\xd9d\x83\x8d\xd3\xcd\x9c\x0a4\x0b\xcc\xd5\x80\xa4N*V\xc2ly\xa1\xfd6\x91\x0c\x17\xcf\xf5\x0e\xfe\xc8\xcb\xd3\xe7\x97\x91\xf1\xb4b\x8eA,zw\x0f\xc3\x86\xc2\xd2\xc3\xdd\x83\xb4II\xccs\xb4\x996\x03\xbb\")Z.\xaf\xdeGC\x11\xc1\x1a\xb4Z\xe4<\x8cd\x1d\xe2\x06\xc5g=L6TD\xd6\x97\xc0\xb0\x14\x17\xa7r\x04\xae\xb1\x12U\xdf8c\xaf:Z?00p}\x85\x81\xe0U\x96uslg\xfa\x80_\x8e\xc6\x8f\x83\x82\xb4\xd7/\xf1\xd9\xef\x11\x12\xcd\xfa\xf3=\xba5w\x0f3\x13\xd1\"\xcb\x98\x12\x9a\xb9l\x9a>\xe6\xd5\x91\xffH:\xa1Zt\\\x93\x0e:\xc9$`\x08\x94\x19\x0dghN\xfc~\x9f\x82j\xbcS\x8br4\x19\xd3\xee\xef\x12\x91V\xe7N\xd8{\xa1&\xa0\xcd[\xc5\x17\x10T%\xd0Y\xd7\x8c\x05Y\x09ZP\x1a'\xd1\x13\x9c\x91\xc0[\x12\xb0-K\x8b\x10\xa7\x98\x9e\xed\xc9\x90\xd7\xbda\xcf\x85\x97pwQ\x14V/\xe5\x9fN|\xc2\x99\xb3A_{\xd9\xa5\x8a\xa1\xad\x91\xe1\xc5\xfdA~A\xc5)@U\x01.M\xe7\xc1\xb5\xc7\x156)/(\x90\x81\"\xad\xf0\xd3\x89\x15\xfd\xd8\xaf\xcfh/\xe1\xd7\xa7\x9e\xfac+K|\x82\xa7\x09\xa2\xdd\xa4\x1a\xbf\xc2\xdf\xc9\x8d\xa0\x81\x19\xb8\xbc\xe6\xe8\x91n\xba\xeav\x9cU'\x08\x0d\xb0\xb2\xf4\xd8\x9e\xed+`}\xc0.\xc3\x91>\x90f\x08\x9cQ+\x85h@\xe5\xb5\xd7\xd3\xc8\xec\xa9\xa6/oI\xf4'\xd0k\xc7\xa6\xae^\x00\x8ad\x85\xf9\xa0\x89\xc3(\x992\x9e\xa6i\xe3\x9cx\x87\xca\x93&e\x13\xd9\x83\x14\xfe&\xba\xc4n\x94\x06\x97\xa8\xbd\x8a\x81,t'\xab@\x8d\x12\x11\x1cz\x88\xaf\xc4\xec\xe1g\x13\xa6\xb5\x81\xac\xd5-,w%\xaa\x10\xe9\xf2`\x00i\x96\xa8\xf3\x00\xd6(MG|\xcd\xa4\x7f\x19`VE\xfd:|\xbc\xf2\xc8J\xd3\x11\xca,\x9fqS(\xba\xe9\xf8\xfc\xb6\x11|\x13!\xec\xe1\xde\xae\x93F\xcaT\xe1u\xad\x13\xda\xca\xe8(\xce\x92\xc0\xc1Z\xb9\x05\xc0\xc6K4G7\xa1\"p\xa1\x85\xc5\x91\x02\xf3&\x14\xe4.|\x02\xd5\x1e\x01\xa8\x14\xaf+\x07t\xb9<\x8f\xb9\xa15\xcb\xd4I\x93t\x90\xed\x00\xc2\xe4\x8eK\x98\x9f\x0a\x89\xcf\x95\x9cGa#\x84$!\xab\x9ah}\x07\xef\x16)\xf1\xbc'h\x89\xfb\xf7\xf6\x12\xf8>Q\xdaE\xe3y\x99\xd5\xda[B\x01\xb5\x92\xac\xd0y*l\x97F\x1a#\x8bK\xab\xd7\xa7G\x10\xf7fs\x84\xbes\xfd\x00epO\x91D\x03\x9e\x16\xad\x86\x7f\xbf?\x91\x85\xff\x0a)\xa3\xec@\x80\xd5\x80H\x87`\x16#\xf8$\x1d\xe44\xc7x,\xa9\\\xe4\xd7\xff\xa8\xc7[ x\x14\xd1\xc5\x0c\xb9\x12\xf5\xf8h\x12\x0d\x85\x8f\xe3\xfdH\\|+2<Y\x17\xd0\xe1S~\x8d'W}M\xc3\xe7\xdb\x99U\xeb\xf2<\xe8\x95\x96b\x9bU\xa2\x89\x17\x03Di<u\x1cJ\x1b[\xdf\xc47\x0b\x1f\xadw\\\xfd\x01n\xb36V)\x9f\x85A~\x84[\x05E\x9f\x0fM)\x1c\x02EA\x1e\xbb" +
+	"\x17\xafH5\xd9\x96\x1ad\x17\xbcT8\x98\xe0.\xeb\x10\xe9\x1a\xee9\x11[\xb7\xc0\x8e\xc0f\x9d\x9ac\x08\x98\x0e\xe7\x04]\x92\xb2K.S\xf0O#x>\xdc\x7f\xb1\x91\xfeX)7j\x95P\xd6o\xa2@\xc6\xf0\xfd\xa9\x9dtu=\x18-\xad\x81i\xa2\xffoI\xd7M\x02Y\xf51$\xb06\xf8\xf5?\xb9\x92\x0a\xceq\xdf\xceK\xb0QY`\x7fh\xfb\xe2\xeaW\x90\x00\xed\x15\x89\x1d\xab\x1fU\xe6G\xe8r\xc62\xfc\x91\xd6u\x93\xaf(\xb8\xa3M\xc9\xe66\xa6\xec\xc3h\x12\xcc\xb9\"L\xda\xcbA\x00\xeeN\xc2\x0e\x0a\x81qL\xfa\x87\xf4G\x18J\xd4\xd9-\xfa\x8dm\xcbPm==q\x9e\x9a\xac\xa9\xb6@\xb2\x81\x1b9m\xdf\xc6\xac\xabA\xf5\xb5\x15\x08,\xb7W\xd2\xca\xf5\x1du\xd7\x82\xcc\xaa\xdb^\xd52\x16\xdbY\x0b\xa4\xa4\x9e=\xeak\x991\xa4\xcc\x1b\xd3\x90\x92\xda\x16\xc1\xa4!\xb5!y\xf9\xd6\xde)\xb4\x98\xcc(j\x92\x0d\x1a'8\x0a\x1cg4\xe3X\xa1\x85|Z[\x9ap\xfd\x19\xb4\xa1\xc7^\xe4b ~\x09\xf1\xc9U\xfdQ\xf3\xdd\xc0:\xbd\x85xGh,\x80\x02\xb7\x97)\xccg\xf3>\x92\x98\xe7j#P\xdc\xfe\xce\xb3\xe7\xf3\xda#@\x9f0}\x06%\x8d\xa5\x16+\xc7\xd9\xa8_\xd1h\xbf\xf4d\xf3[h\x04\xf1\x88;Vj\x8ax\xe6/\xe8p\x9eJ\xba\xae$\xb3\xc6^I\x91\x10\xbb\x1c\xe7\xbb\x9d5\xa9d\x0f\xbaH}i\xb7\xf39!\"|$3\xfe\xfe\xee3\xa9h\x13m\x0f\xf8\x8a\x88\xe8\xaa\\\xca*?\x12\xe2o\x03t\x1a\xd8\xff\x85\xd0\xf6D\xd6_PGY\x8f\x82\x876n\xf5#\xf79\xccQ\xf0\xe8\x05q\x13;{\xcd\xd2\xf5\xe7\xd0y\x09EZ\x0fp\xe4\x95`\x01\x15\xea\xbc-\xb9i\xf8\xa2\xd3\xd8\x04\xe1H\x0c\x00[\xb0\xe2\xfb\x1c\xeaP\xd6I\x90a@\x05\xedB\x11\xaa\x97\xfd\x80\x1b\x04[\x1ek\x08\x09\xc3\xe6\xab<l\xbe\xca\xc9\xf7\xa8g\xf2\xc8K\x9e\xc2V\xd2;i\xeb\xd91\xa7\xbfO\xb1\xcdD`\x9f\x94\xfd\xc1\xa8Ri\x06\x96\\h\x1b\x84\x85y*\xe7\xff(\x16\xff\xeb\x85\xd9S\xc4;\x1f\xec\x89\xfe\x88x^\x85\x02\x053E\x98\xe4\x93\xa8`\x9b\x84\xd6\x15\x10\xe2\xcbK\x88\xc5I\x8d\x89jL.\x05\xb9P\xd1A)\x8e\x83\xe3\x12\x8a\x8b\xd2\xe1\xa9\xc40\x02\xe1\x1e5XH3\x8ee\xdbT\xebL\xc3\x0cl\xa5\xd8I\x92^\xc6ma\xd2r\xd1\xfa\xc1\x81+>\xba\x11\x9b\xda\\\xc2-(y\xd6 \x8a\x91\x09\xae\xb2\xde\xb5UIr\xd6\xe1\x9c\x1d<\x8f\xa3Z/\xe3Vo\xf5\xe1\x8e@\xd6\xf4j\xed\x87\xeb!n\x8e\xa0\x9c\xae\x98\xdenP\xf4H[\xd4\xf8\xc7\xca\x1e(\x8b\xb0\x00\xd4w\xf9\xcf&\x93Z6\xd3\xfa\x15\xb1<8\x7f\xd6\x85\xe0\x87P$\"\xd2)\xe5\xd8\x90>0\x1c\xd3\x12\xf9x\x86X\xce\xa5\xf0\xd9\x1f\x84V)\xc52sp|Fb\xdfP\xe4r\xe6\xc0\x01P\\\xea\x06\xd7e3m\xce\xa3\xbd\xa0\x83\x0c0h\xf0\xe8\xa3lI\xdf\x8a\x17\xeb\x92\xbe\xf1u\x1fD_\xee7\x86HR\xde]S\xb0?\xd2KI%\x19\x8f=\x02f\xb5\xb4*-\xbcz6\xe0\xa5\x1d\xf7\x8e\xce\xf7\xd0\xf6\xe4\x135\x83\xa1\xeb\xbf\xad\x0e^\x08\x04]\xeb\xe6\xd0vJRc\x88~\xee\xc2\x8b\x86$9\x06\x13\x9c\x02xSc\x01\xe0W\xa7\xc8k\x05\x89\xc7\x92\xb5BOE\xf1Pp.iL\xceQ\xb8`Ai\xa7\xa2}\x19\x93\xef\xf3\xd2\xc9\x02i;\xd5Y\xe7<\x18J\x80\x1c\x82 \xa4\xc4\x8a\x10\x96\x85\xe5\xc8J.(x-D,\x93P\x94C\xaf\xd2\x8a\xbc\xfa\x01QI\xb7\xaf,\x85h>P\xbb\x13I\xfc\xfe\xb0\x8b\x83\xad\xf4\xed\xa1S4T\x0d\x0bUH+\x9b\xd3\x80\xba\x02\x99\x89\x02(#\x98\x1b\x99_\xf5%W\xfa%2\xa0\xc8\x90\xde\xff\x19#\xd5\x83\xed\x9f\xeb\xa0\x0a\x1e\x01\x87f\xba\x8f\xc2\xbd\xc27})\xa3\x8feV\xe9\xc96fGu\x98c\x8af\xc2\xb0[\xdc\x09\xb1\x8b[\xb2p:*#\x13F\xe9\xe2\xaf\x83;T\xbf7\xabG\xbc\xf5\x1d\xa5\xf8\xe2e\x81\xc0\xee\xc2Q\xbf\xd3\x8f\x18\x94\xbbJ\xc4\xa43\x91d\xfb~\x8bZ\x9dGj\xaf5#\xe0\x9eDf\xef\x90P\x7f\xe0\xc9\x9b@\xdf<z\xbej\x00\xfa\xc1\xa8\x18T\xe0(\xf5Q\xdf\x1b'\xc0\x81\x1f`\xc25\x80\xe4W\x1d\xc2*\x1b\xa6\xc2\x0f8\x98\xbf\x07Ox\x05\xf4Z\xcd\x04\x8e\x94+\xa7\x04g\xe9W\x84&\x85\x896\xbem\xa6M9\x90\xc2\xd3Y\xfaQW\xf7\xd8\x02@\xdd\xb4\x00R\"3\x91\xbe\x9a\xab{b\x1a\xd5\xcb\xb5)eM\xe5\x04\xf2n`\xac\xe2\xc2\x88y\xdf\xb9\xa1\x1a0\xeb\xff\xe7\\\xf03)X\xe6\xf5\x87\xd8\x13\x7f\x06\x1d\xe0N\x0aJ^(\xfde\xfd\x90\xfe\xb2\x02\x86\xe59\xf0\x0f\xa9\xde\"\xf9J\xc9/\xc0c\xacW\xf27~+\x10\x95\xff\xc0\x8f\xed\xdb\xa9\xa0\xd3\xd4\x1d\x0b\x7f/S\x03Z\xcf\x14I\xe5\x01\x86\x0b\xfd\xef[n\xa2P\xfc\xd3\x92u8,\xc1\x15\xa0y#(,\xd6,#v\xed\x16\xf6\xe7\xa9\xe8&\x87A\x0bP>\xe5\xd4\xdd\x92A\x9ar\xa7\xf0\x1ck\x19(l\x15/\xed\x00\xf7\xe7)\xb98Bbf\xf6$Q\x1du\xabP\xa4\xeb\x95\xa3?Pp\xe1p\x1e\x8f\xb9f}\xac\x1b\xfct\x13\xbd9.\x054\xa6\x00]\x11\xc5m\xb0\x1e\x03\x88\xa3\xbf\x97'\xa2 \x82\x1e\xd3L\xdd\xa5\x06\xd3\xd2\xd75\xf3\xcc\x0f\xf8\x82B\xf5\xb6\xf0j\xdd\x12\xff\xeeP\xd9-\x85f\xe6\x95$w\xd7\x0a\xb5\".)d*0\xcb\x7f\xfe@\xc0e\x12#(\xb5(Q4\xbbTB\xb8\xc7}'\x07\x8b\xf9>\x97!}\xa6\xaa\x9aH\x95\x92J\x88\x9b\xf2\xb2P\x88W\xcav\xf7\xe4\xf1\xe7\x94\xed2*z\xc7\x09g=\x14\xbb\x15\x1ac\x01\xdb\xe0}Z&\x10?\xa9\x0c\x80\x03\xd4\x0b+\xfb\x93f\xc48\xb7\x84(\x18\x1b\xaa\x8c\xb2\x1d/\\M>\xe6\x83\xf0$sJ\xc7\x9c\xe8%\xaa\x18\xa8m\x15\xc2\xe4\xe8\xb8\xbbN\xb1\xc5\xdc\xe0NNO\x8c*io=\x92\xf3\x06\xfe\xb1\x8f\x04\x91\xa7uz\x10\xc9>,\xac\x8by\xa4\x0c\x19\x0b\xd4\xdf\x97=\xc84\xe1\xe8\xe2\x0d\x1e\xcb\xb2sD4\xaep\xc4\xa2\x91\x0c\x92\xd6\x8d\xe62L\xe2m3\xe7+k\xba\xcf$\x13\x05\x14\xe1\xd1\xc8\xc1\x91\x98>_K\xa3\xf4\xc0\xe9zI\x01\xc0J\x14\x14B\xac\xca]S\x9b:\x89\x87*\x82J\x98\x7f\x18\xea<U[\x8e\xeb\xa31\x03.\xd4\x03\x89\x0e\x0f\xbaFb$O\xd4\xac\x9ew\xc8\x1d\xa3\xd7\x94\xc3\x93:s6\xc5/\x042\xa7R\xd5G\xb9,\xebe>c\x05|\xa8\x95\xff\x08\xf5\xb8\xd3zE3\xbbAM\x81\x99\x07\x9a#vT{\xde\x1df;X\xb8S\xa3z\xe6\x0aQ8r\xbd\xbe\x7f\x14\xf4c\x81\x87\xb7s\xce\x0f_\xa4860+\xba\xb6\xd4\xde\xb9\xe6\xc7c\xf6r\x8a\xda\xc5\xee\xe3\x07\xc3\x85\xd9\xd2\xcd'<[\xc2\x85\xca\xa4\x11_\xaa3mt8\xd4\xea\x1f\xd0\xb5\xc7\x9aq\x0ck\xe3N\xe9W\x90\x92\x1a\xa9z\xf7\x89q@\xea\xd7P\xaa\xba\xe6\x8f\xd5fW\xd7\xe4\x1f\x14\xf0\x83D\xa6;\x16=\x97\xb2\xa3@#W\xedq\xc7)\xf3\xe4tJ\xa6F\xd4I\x95fj\xcf\x9en{\xee\xa77\xd0iY:\x940\xbe\xc5\xaf\x17\xe9\xd7\xf3\xd3\xe2\x13W\xbde\xca\xac\xab\x9c^|\xcb\x8a\xd8r\xb8+\xab(U\x1f\xdb\xa4}6\xc9gKd\x1dn\xb7mI\xb5$y\xc4=\xe6\x8a\x81Y/gYZ-\xe6\x8aNUT{\x0c\x02CV\xe2\x81y\xca\xd9\x84\x16V\xa9M9Q\x0ewd\x0b\xa6\x0a\xe6KoQr\x9dG}\xb7\x8d\x94@\xd6\x8fJn\x0a\x1a\xa8\xeaAg\xc1\xc3u\xe8I\x1bZ\x1b\xb9$1\x9a\xe3D\xedw\x97>\x07\xc7)>\x89\xc6\xa6\xe7\xf1\x0c\xb2yz\xd3\xb4\xfc\xe1n\"!=\x0d\x16\xe0h\x93\xf3\xc5\x1a/\xc2\xc2c\x978p\x9b\x80\xc5OZ\x85\xbe\x91=f'\xe0\xd2\xa9\x8a=s\xa5\x8a\x08:\x7f\x02e\x8f\x1e\xbeF\xf7\xd0\xec\xd9\xa1qb\x10\xe1\x0a\x19\xc1\x9a\x12d\x1b\xdd^\x00M\xbb{\x09\x0f\x16Y\x03\xe7\x9aac\"$Z\x89x\xea\x0e\xd3P\x13\x9b\x94+ \x97Z%\x07\xf8M\\\xb1<1\xab\xa6\x04\xc8\xb9\x0c\x7f\xd3\x08\x11:\xcd\xeb\x8f\xde}\x000{#^\xaf|r\x86z\xc1Bm\xc5\x9a\xb4)f\xa3T\xdd\x13J\xf14\x9aI\x1a\x99\x7f\xf0N\x04\x89\xe7\xe2.\\\x9e\x8f\x9dB\xf4I3\x0d)\x81\xf5\x12<\xa7\xa9\x12\x0d\xe4\xf1E\x92i\x02\xff\x96Tp`W\xcab\xb6\\\x14\x95\x1b\xa5\x90[\xfd\xb4\xb9\xe7G\"\xb6+\x08\xe9\xa2\x9b\x10\x9fZ\x0d\x0d8Na\xc5\xd4\xaf\xf0\x11m\x16T\xe3*t\x18@d\xa5\x0f8\xa2b\xdc\xa2\xe3\x84~$y\xc3\"@~\x00gi%%>\xaf \xc3\x19\xff\xa4\xc3_A\xe8\xa0_2f\x80\xb4\x9aRI\xe2\x80\x01\xee\x09\xbe\xc6\xe6\xc48\xcfm\xbes}>\x02\x87\xd3b\xa3\x1c\"\xad0!\xe4{\xc6D\xfd\x0a7\x04\x06\x9c\xb1\xbcA<\x01b\xb9\x8c\xe0\x95\xdf\xabr\x82\x0e\xb5\x84\xc9\x13\xee\xc5\x8a\x12\x86:\xc3=\x82\xddt\xda\x1c\xe9O`\xb4I\x10\xa2eiIB\xac\x11Qsk\x16\x1fY\xa79\xb7\x9cd\x8e\x18r\xc9\xbd\x81o\xa9\x82t+\xd3\x00\xe2\x80y-\x11\xc1\x84p\x8a\xc9hyz\x1dE\xff\x8a\xca\"&\x85Rm\x84eh\x99\xf8\x03\x01\xfa\x13\xb1\xb7\x8bO\x90\x04\x06g\x03\x19\x8a'i\xc7(\xe387\xdc?\x19\xb0\x89c\x19\xe1\xf7R\x99\xa4B\x04NA+\x84\xeb\x98\x8eReZ\x91p\x02\x9e{\xb02\x1d\xfe\xa2\xbexB\xaa\xe7\x976\x9c\x92\x1e\x8f\x84U\x10v\xa4v\xde\x93\xdf|\xd1\xc3\xcb\xe0\xf6\x1e\x9d\xf0\x8c\xbb\xa1~\x0f\xa3\x92!\x05\xbb\xa4\x11}\x9fvz\x96\xac\xe9\x8d\xd3\x8c\xc0\\\x7f\xa8\x9f=X\xdaz\xf1\xc2FkV\xe3z\xa6W\x8b\xd5\xeb2\x0ez\xc7b\x81\x80s\xfdS\x14\xcd@B*\xd1\xa6#y\xe4\xa9\xc7\x8f\xe2\xf4\x80\x82\xc2+P\x05\xb9O3\xad\xe4\xc6Nt\xdc\xbe\x95\\X\x1dJOR\x95\xb7S\xeaIY\xbf1\xc2\x87[\xa6d\xe8\x91\xcd\xc9\xfc\xc7\xb3\xbe\x0fv\x1cB\x90\xfb\xd2\x82N\x93\x17\xf2\x7fpD7\xb3\xd8\xf3\xbeh\xf1\xad\x17[-\x88y\x05\xc9\x17b\xc6\x0bv%\x1d^v\x0dE\x95\x89\xf7;rn\x1fd\xf8\x8b\xa5\xb3E\xeb\x83Z\xc9\xdb\xaeU?b\xf9^\xe5\xab\xf5\xc2\x90@v\x0fNty\x12\xcb\xa6\x07\x86\x94\xf5\xc5\x10Y\xb2\x9bK\xd18_lp\x19\xf2\xf22\x09\xdd\xa7:di8\x8a\xb7\x06!\x8c9x\xa5]\xc9\xda\xad\x17;\x9b\xf6\xdf\xc7\\\xe8u$\x0d'\x19\xfb\xe6Tx\xac\x89WD\xb8\xc8\xaa\xc5\x80\xcc\xa2p\x10\xc0-\xca\x1ee\xfe\x11\x95\xcd1\x9b\xee({\\\x92\x96\xe9\x85\xac\x0dB\xf2\xa5U\xd5}\xeb\xc5n\xae\xd4\xaf\xa5`\"J\xa3~@\x14o\xa6\xb9\x17i\xcaAp\x0fP%\x8a\x1c\x02\x06\x02\x02\x00\x81\xd3FgaN\xbe\xaa\x98#\xd0\xa8\xf3vukf{D\xf5\xbf\xdb\xd6E\xb2\xd7\xbf\xebU\xae\xf7*\x99\xc3\xb8\xa1\xa2-\\J\x077B@\xde\x12H4W\x92\x1a\x01>\xf5\x0ej D\x1b{B{c\"ic\\\xa5\xd5\x8a\xd4;\xca\x96\x97\xde\xdf\x9c\x99\xe2\x9e\x09O\xac\xae\"\x15\xcc\x81\x85u}\xaa#\x00\x08\xb8\xd8\xeb\xa5\x1d\xb1\xca\xb5\xd3\x86\x8d^\x06\xeb\x02n\xd2\xf2f\x07\xcf\xc2\xb0x\x1b\xf6Q\x99\x94u\xb0wC(\xf6c\xb6\\J<\xfc\xf3\xeea\xdfzq\x98\xe6\xec\x07\xd8nH\x82\xf1\xbd\xb9\xbf\xc3j7\x00\x85\x14\xb4V\x8a\xa4n\xdfX\\\xaa\x8e\x9e\x0d\x94\xdeh\xcb\xeb\x0d\xd9\xc9=\x89\xef\x03\xbd\xd0\xeb\x0a\xa6\xec\x87T\xd9vA\x1bw\xc9\x92\xd8\xd9\x06DX\x10?\x86ER\x04\xce\xe1\x94\x95\xf1#\xfa\x8e&g\xee6C\x95\xe8Y\x81\xd4CB\xeeX\xaf\xe0;>\xb0\x82\x8e\xc8U8\xc3\x0a\xe9\xe9\\b\xd9\xadU\x81yk8T\xfa2$\xad\x07|\xa9\xa8\x8a\xb4\xa2'\x07\xfb\x18c\xb8y\x86j]\xc5\x9dL\xaco\xd6\x08\x92\x03\xc9\xd3X\x02\xc2\xe7\x9a\xb9\xd7\x93\xf3\x9a\xf4]\xac)j\xfd9\xd3@[C\xf6l\xdf6%\xbf\xa3H;\xb75\xf6;\xbeE\xd9\xea[\xc4#\xe3\xb5j\xf6m%\x89\xd1\xf6.G\xfb#\x9d;Q\xbd:\x8d\xa5R\x85L\xe9\xd7\x8b\xeb4\xf1\x0a\xb1\xf52'\xe4\xb3\xb0\xbd\xe3\xd2oo\x89\xac\x86\xdb\x92vY\x02veL\xee)\xf4 E\x1a\xb3\xc9\xc0\xa2\x8a\xd94\xf3\x1emm\x8a\x13X\xc5D\xa7*[\xfd&\xeb\xc9\xac\xdf$\x1f\x98\xfeo\xac(\xb5\x0b\x17\xf8=\xb2c\x98\x88\x1b2\xd1EJ\x92\xe507r\xae;\xdfA\x9b\xca~\x99/k\x00t\x17\xbf\xa2P\xe9\x10\xed`=\xb7s\xd7\xd9S/ \xdf2\x8b\xb5\x8dl;FV\xac\xa7\xc7>\x1a7Un(\xd2q3\xf0<\x9e3\xeaD{\x13C\xbc\x9c\xaf\xf4s\xab`m\x0dwR\x09\xbe\xe8\xafVJ\x13Mf4\xad#E\xdc\xc6p\xe4KL\xb4\x11\x88\xc1SX\x15\xa8Z\x08R0\xe1\x82\xb6*\xd7/\x82\x97\x8a=\xb8\xfe\xfaQ\xdd\xa9e\xbe;\x15>z\x1b\xdep\x0e\xb8\xff\x0c\xb4\x93\xd27\x19q\xae~\xeck\xd2\x18\xc4\xffX\"\x0e\x189\xf5J\x97%nl\x94\xf4\xe5/\xd2\xa3T\xc1\xb5[\x96\xc4n\x0f\x0c\\\xdc\x13\x18V\x05\x82K\xaei\x87wd\x0c\x9c$\xc0\x00\x01S/{\xad*\x0b#(B=\xa1\xef\xee\xb1m\xdf\x95n\xfb\xae\xf2m\xdf\x8f\xa8\x98\xb4;(T\x94\xed\xd7\xf3\xb65\xdc\xeb\xdbB\xa9\xfa\x8b\x97\xf0\xf6F\xa7\x958\xf3O2\x90\xf2\x878Vk\xbb\xc3\xe6\x00\xb2\xb8p\x99\xa8s\x97\x90)\xb55\x07u\xdeBQ\x1fv\xdd\xe0\x1e\xf6\xb2\x7fy\xc7Y\x91\xca\x9b>p\xfe\x11\xc4[[\xc3\xfdM\x81\xa4\xbd\x10:r\xf6\xce\xd5\xd1C\xbbM~\xe5L}E*\xde\x01o\xec;p\xc6\xa1\xcdr\xda\x07\x84r\x0c\x09\xa1\x15\x04\xf3\x0e\x93o\x7f\xd9\x18\x02\xfc]\xadKh\xb2\x98\\\xc9#u\xce\x8d\x08\xc7\xc4lR&\x85+\x97\xcc\xc2C\x0c\xaa\xab\xf4\xc4\x0c63\x04\xd6\xf14vc<\x93#c}Kp\xfaOU\xd8\x1fIA\xc1\x06Y\xf9\xbfJjD|\x80\x98[\xb377S\xf6\xbe\x01\xfa\x19Q\x08\x13\xa5-\x92\xde\x8a\xf0\x1d\xb3\xb8\xb6Y1UX\x06\x86\x0f\x9eey\xafo\xe2\xda\x09\xcf\xb7\xf4E\x84O\xd2\xcet7\x02\xf2\xeb0\xa7y\xbe\xedw\xb0\xc4\x988[V,\xac\xc25\xe6\x8d$\x1ft\xf7\x9e>\xe8G\x04\xd5/\x14\x8b\x8fP_c\x84\x87\xdf\xbcNi8z*gx\x04\xec\x908\xc0\xe5\xaa\x88\x0b\x96-\xdbF\xf8\xc8\x8f\xadK\x0e\x1f\x83\xa5\x7fMi\x11kS\xa0\x00\x0f\x1c4d\xeeF\x82\xf9\xc9T:\xce<\x93\x07S\x83\xcb\xf9\xa1\x05\x86o\xba\xdd\xa5\x1f\x93\x9a\x8fA\xfc=\xf8\x01\x89\"\xc13xR\x95\xd4L\x1fE\xbf\xe81\x0d+^\xef\x91\xe8a\xe9h\xbc^\xdf\xba\x806\x8f\x1e\xf5\x9a-Y\x9c~\xdc\x8e\xef\xbfzR?\x0f\x97I\x98K\xec\xdd\x8f\x18\x0d\xd3\x8b\xa3=\x93S)\xa2_\x0a3\xf1A\x83\xafG|\x8a\x11\xe4\xb7\xdc\xc8\xe5`\xe0\xa4\xae\xb2\xa4Z{\xf5;\xeai0\x1c\xcc\x14\x1b\xba\x0dld\x85\xa7$i\xd9\xa9,\xfb\xb8$\xed\xd60'\x13\xb4>~*\x92S\x10\xeftO \x02\\~\x09\x8c^\xb4u^Hp\xd3%8\xe0?p\x9e\x9c\xbf\x19\xff\xac'\x95\xf0%\xf1\xcb\xf2\xd6?4\xe7fHa\xd0\x829^\xa8|x\"\x10\xde\xe7\xed\xe8lhy;q\x9c\xc3\x16\x04^\x1e\xe7\xfdz\xbf[\xc7{iQk\xe7\xfc\xb8\x05P\xa5J\xaf{\x14r\xd9\xd5NWH~\xads\x06\x1e\xcf\x99\xe2\xb8\xcb&Q<\x9b\xf7\xe4\xae\xbchV\xfb\x1b\xba-\xb5\xf6\xfb\xdb,\x84\xf6\xceb\xc9Ut\x8eqA\x18\x01\xba\x1aA\xff\xd6\xf6\xa5\xaf_\xae\xa2\x14\x87\xd7<)nt\xda\xde\x0dI\x8d\xc1\x89\xff\xfa\x81\x00\x8fsl\xb1" +
+	"\xb0\xa5\xe6\xb5\x07\xd8\x9c\xc0\xd0\x89oQc\x98\xb8A\x0b\xeajBl=\xbe\xfc\xe6&\xe0\xf4D]Eem\xb3`v\xf92}zg\xb6\xb68A\xcd]\xdc\x7f\x12\x87\x82\xa8\x8b\xa71\xea\xc8v\xcf\xf1\x97\x0d\xa6j\x0aSR\xdfC\xc5x)\x8d\xd3\x11\x15Y&\"\x91\xa9E\xc3\x9e\xb7eB\xe6\xe1J\xc2HQ\xde\xd7\x97 \x83Af\xb3\xd6=\xbc9%\xba\xd0\xd4,\x130\xe9\x91VR\xe9:T\x00\xc6\xe1QP\x12\x16\x85\xd7+3\xcc\x9a@^\xf8\xe9\x86\x87\x86\xff,\x0fj\xc1\x85\x9dO\xce\xfb\xd0\xa6\xcd)\x90\xb6\xb6v5M%\xe2(\xfe\x042*\xeb-Zfw\x93b\x84\x0c\xc6\xf7T\x88>\xe5\xc7\xc9\x8a\xf0e\xee\xa8\xa8\xaa\x19\xde\x15#E0{ \x99\xd4\xe6@\xd9p\xe4\xa6\xe7\xd0\xec\xa9\xa1\xa9|\xf2\x9c?\x11p[\xb2\x13I\xb3\xfa\xbd$O\x046\xb1h5\x02_\x1f\xc1g\xb0\xb4\x84\x00\x7f\x01\x13?WM\x7f#\xcc:\xbb\x90\xe8g\x82\x88n\xed'\x9aB\x04\x18~+\xb7\xf3U3\x06\x815\xdc\xc1E\xd73=r\xa9\x1e\x98\xa6S\xb4\x05\x1f\xe8<>b\x90\x01\x9e\xc88\xd8!\xdcgr5m\xd6\x04\x94\xde6[\xc4X\x0a\xe2\xed?j\xb6i(\xa9\xc5\x95Q2Xq*y]\x0d\xc7\xb3V\x92\xc6Z\xc5\x91\xe3\x87\xf3.\x0a\xcb\xe3\x86\xda.\xc4\xd3JLts!\xd7${\xbfO\xe5m]4%\xb8sQ\xa2\xbe4\xef(4\x9b\xa1\xdb\x1b\x0f\x92\x93D\xdcIu\x9d~';\xbaW\x08Wb\xcd\x17\x19|I\x8e}\xe3\x09@\xd3_\x99\x900Y\x05\x0d3\x1c9\x8a\xc8\xe8\xf6\x85\x9dRAje\xdcT\xd9\xcb\xdd\x92\x00\xb4\x90j4\x06\xe8\x91\xb1\xd1\xa1j\xdd\xd4\x9b\xacDd\xd5\x05\x05\xb0S\xd9\xc26\xc1\xcaUs\x8cIW\xfb\xba\xb2\xebj\x09\xceg,\xf6\xdc\xc0O\x1c&\xcc\x8e\xe5\xef\x9d\x84\x97\xb1\xf8W\xbf\x81\xc9U:!\x8c\x151M\xe10\xbc:q\x9f\xc5\xea\x10e\x1f\xfe]\x9efT\xd5\x04\xe433~\x14\x1e\xe3\x07/\xcc\xd7\xaa]}\xfe\xe4+\xf7\xd3\xaeT\xd8O\xf8\x8f\xfa\x0a\x1e$\xb6F,U?\xde\xa3\x09Mtg\xad\x04h*-\xaa\x02 f\x17M\xd1\xe3%\xbb\xb8tM\x07sg\x98\xb4\xfd\xc2\x83\xa9)\xa3/\x04\xfeuo\xa55\xcd\x0e\x9a\xa0\xd4\xf1\x8a\xca=\x14\x03\xe8AZ\x87\x9da\xfd\x09\x09\x02\x03\x1f\xea~\xa4^\xb3.\x17\xf5\x92\xec\xe25\xcc\x01Vn\xa3J\xd64\xd9\x08\x1b-\xc8\xe8{\xf6^:I\xc4\x8c\xaf8\xce\x09\xa4\xf5,|m\x0fs\x10d9\xa2d\x1f\xb2\x81k\x81\xa4\xe8\xf5G8fA\xf6195F\x94\x0b\x9aSX\xa3\xacN\x97\xb9\xbc\x03\xd3\x0b\x0e{\xf7Om\xfe'{\xe7\xf7\x84\xceX\x09)5\x97\xeb\xc3lTQO\x9e;\x94\xc11\xbe\x9bK}\xb5\"\xc5\xf0\xfa\xb0U\xf4\xcaO\x83\xc1\x16\x89_\xbbFN\x82\xbc\xc2\xfa\x95^2#\x8b\xed\x930\xd2\xfb\xc9\xc2\xcc\xeb\xf0\xfb\xba\x18\xc9\xd7S\x08f\x8eU\xde\x8a\xd4W\xb8x\xab\x00\xe9\x14Q\xc8\xc9O\xd6i\x8a\xdc\x82\xa0\x94(E\xc2\xfb\xba\xc6\xdb[\x7f\x86\x1dd\xcf\x91\xf7\x8ah$\xf5\x81\xd1\xa8\x8a\x0b\xbf%\x1a\x9fkuy\x9b\x1bZa/\x1d\x80zR\xdcV\xbb[\x97\x94;\x9a\xd3`N\x7flJ1\xbb\xad\xad\xf1\xcd\xdc\xfa\xd6\xe5S<k\xf1D\xecZ\x84\xf18&j\x92\x84\x9c\x0f\xf2h\x05#\x80\x7f6p\xc2\xf6\xb6\x13|)\x94\x0d\x07^\x984\xa9\xb0\x84@\x863\xde\xbc\xbe\x8d\xcf+\xd2\x85\xf8!CuT\x979\xff\x99\xdb\x8eL\xdc \xd7ZI\x94\x10\x0ac\xf5\x1ev\xa6P:r\xd8\xb3\xb9\xcf\xe74b\x0d\xc7\xdc\x94P\x85\x19:R!\x10\x00\x1c*\xa2\xd03\x11R\xdb\xba\x94'\xc21\xed\xd4QSJ\xc3|\xa6\xbd\xde\xe6\x14\xe0z\x0f\xecd\xf0e\xe0%W\x9a\xf9\x02A\xac\x10\x08\x9cR\xbf\x90\xe5\xef\xaa\xaf\x06\xc9\x10(J^\xe7\xf7Uf(OI\x95\xf0US\x99H\xcd\x9eN~,\x88^0\xf41\x8d\x99\xf1|\xaa\xed\x82\x00\xdd\xcfE\xdd\x95\x92n\xa1\xd8\xd5\x9c\x8a\\\xcaf\x9e\xf8\xa9\xf7\x84\xb7Q\x933\x09w\xeeH\xcf\x077\x818\x02\xf4&8v\x18\xc0[\x9e\xc8\xa3\xb6\xbf\xa3e31-\xbbNp\xd5\x1d\xfc\xfe4\xc7\xdbD\xdb\x9c\x89\xacp\x0c\xea\x05\xb5u\xaeZ\x0f\xb9\x9d\xc4\x049\xf98\xecZ\x1bA\xee~\xeeZr\xac\xb7\xbc\xd1\xcaX\xf6Lz\x89Q\xeec2Ii^\xafp\xf2z\xb0u\xa0\xfc\xf6R$\xf4p\x86\x1fq$\xf7XC*\xba\x04\xce\xe5\xa2\x19\xc3=d\x0a\xd7}\xb9\xeeu\x94d\x8a;V\xc1k\x89\xbbhY\x05\xbb^6\x7f\xd3\x17\x06\x96\x82\x0b\xc5\x8b =\x01N\x00\xac\x0f\xddz\x01\xde\xa1\xe38U\xbaa=8\x93S}\xe4\xba\x83y\xac%\xb6\xfa\xd2\xe8\x86\xfb\xc6R\xa3\xa1s\xb6g\x92\xc8\xafS\xc6dI\xedf\x0f\x04`\xe2\x9f\x01V\xe6\"\x9bjqdHEg\xac!\xc8\xc7\x05\xe1)\xba>\x00Jz\xc2\x8c^e\xf0PoeO\xcf\x14\x88-P\x08\xbc\x17\x09\x92\xf4\xf4\xcbd\xb3ln\xc0R\xa7J\xb5\xe6ff\x15\x92\xf47\xcd\\\xd8\x98G\xdd\xff\x91\xd5\x8b\xed\xbd\xa4\xb0\xd3\xf7h\xb1\xb9\x8c\xae\xb8A\xd0\"`\x8d\x03\x10\x9d\x17^8\x80\xf9F@\x84VL\xa6\xe1D\xe9\xc8D%\x9a*\x91\xc8\x09N\xd6\x94\x91Z\x8b\xa1\x04\"\xad\xf5w\x01\xce~\x0b\xd3A\x93\xe1\xfe\xe8\x08\x1aYW\xee\xcf&\xc4E\xfd\x80}$O\xd2k\xe9\xcf\x7f\xb6\xb5\xed\xd4e2\xd3\xe5\xd4\xccU\x1f\x84\xda\xd5\xfe\x84\x89\xca\xf0\\\x92\x10\xcdU<\xd11i#\x10\x8c}\x17\xa5\x08\x06\xf2\xd0\xb1\x97\xcb&x\x1f\xe2\x8f\xc5I\xcdZ\xf2\xfcY\xaa\xc4\x10\x8e\x14\xe1\xab\x1aKS\xfa\x96\xc6*O\xd0\x1d\xf0\xa9\x04\xf6\x01p\".\xd3\x90\x024\xad\xf7\x8c\xd2]\x17\xbc>\x85C\xb4\xdc\x8e\xb7z>\xbf\x8a1\xaa7\x04r\xfc\xd1W\x99f\x8d9\xd1\xb5\xac5\xdfH\x80\xae\xe2@ \x06,\x1fxX\x8e\x94\x92\x87\xa5\xe1#\x9d\x8az\xd9cC8]\xcc^\xee\x1eW&\x95t4\xb9]\x14\xea\x06\x96'TD\xedE2\xd7\x8f\xffF8\x1e\xf0\xc9D# R\x1b\x8at\xa3p\xcd\x0d#\xf6\x9d\x17\x7f\x1ew\xd9\xaaaI\xd8\x84\xfa6T\xc5\xf1\xed\x95=\xf5(\xf8\xc0\xcb\xa8\x8c\x0e\xb0\xed\x088Oj8X\x9fcT\xd1\xc6n\xe3\xe0\xb1\x8e\xb2\x14\x90\xf2r\xc2\xcfS)\xdc\xb1(\xca\xaa\xa58^\xe9\xf3\xeb\xecgS\x1b\xdb\xda\xd9R\x9d\xb8\x8f\xc6 \xcf]\xac^\x16\x81\xdc_`|kg[\xf56\xca\xedH\xab-\xaf\xa6$\xd6\x9a\x96\x17\xbf\xc5>h\xa0L\x17\x9a\xc3'\x12L9f\xca\x8b\x1b\xdanE\xaaNWqJ\xff\xca\x13\xe4\xb7B\xd1\xd4Q\xbb\x9eC\xa3\xe0p\x83\x90j~\x94v\x0e\xf1P\x08\x90\x9ct\xe7Y\x99\xe0\xbb\x8c\xdc,\xe2\xe1_\xc9\x17Lp\x96W\xc48\xd2S\xb7fkg\xe79x\xd97w\xd1\x1f\x07h\xd9\xe1P\x16\xf9\xbd\xf0\xa4I$\xfc\xcb\xe6\x88\xbd\xe5dZ\x8e(\xdd\xf4Rs{\xe1)\xb6\xbe\x02\xe5?+\x14\xdf\x12\xb9\xcd\xcb8\x9b\xb1\xc8\x88A\xdbqS\xd4\xaf\xc0\x81\x90m\xf1i\xff\xf1\x110\xfd\xbf\xc7\x12\x12\x04g\xf5]\xe48\xd1|\x15\x8a\xc9I\xd0TtN\xef\xdef\xbb\x93\x096\xbe\xd3\xbc\x10\x9dL\x16~\xcf9\x92\xf2~\x83\x19\xb8\xf9\x80\xf6\xa0\xf0d\xa6\x82\x01\xe0\\7\x0d\x10IN\xe4\x10\x00\xf7y\xca\x89\xd7h\x0f\xc3\xe8\xaf\x97\x9an\x14\x9b\xf7\\\xbf\xc2. \xf3\x09uI\xce\xac\xb3=\x1d<\xb2\x11T\x81!<v|\xe4\x13\x14\xc3cX\xf4\xa6\x0e^cj.T\xf4\xdf\xb4\\\xe3\x8c\xa2`($0\xe7\x11v\xcc\xf1\x94s\xbc(-1\xa5\xf50UU|I6\x97\x19\xb2h\xdcsC\xa69\xb5\xa0\x98\xa5\xb2\xfbV\x84?Il\xcfs~\x03\x9b\x1c:\xd17$\x8b\xdf\xda\xd9O\xed\xa7=_\x80\x87\x0f\x17x\xd9\xe7\xd8\xa0y\x91\xf4\x19\xfa@\xff \x16\x0a\xe8}\xa8\xdd\xa0\x17\x92\xc23+n~i\xd48\x9c\x1f\xc7\xc5\xac\xa7\x8a'\xbe\x99\xc2A8E[h0m\xf6J\xa6\xcbT0\x17p\x990\xbc\x06\x92\xa6E\xeb\x99\x94\xa5\xb1\xcbQm\xda\xfc@\x06&\xfd\x18H\x8d\xf0\xc8n\xe1\xb4\x7f+\xabmq\xf0!\x0e\xc3\xc0\\\xb0\xb8=H6+\xae\x8f_G\x13\xa9\xff\xac\xac?\xbe,\xb8\xc98D\xe4|8t\x14\xde\x85\x90\x13\xb5\xda\xec\x0c=x\xa6\x15\x8b\x84D\x85|\x07\xe6;\xf78\xa1\xa4u\x04\xa0\xc4\x15\xb0O.\x09\xc0\x19\xafpG~\xfc4!B\xcf*F/\xc8\xab6B\x88\x136\xbaB\x9b\xf8\xdb`b\xd41\x15\x15\xe0\xe3\xdfO\xc0\xe0Q\xf4\xb6\xa4\xbe\xce\x8a\x89\xb8\xdbx\xa9\xc4pyx\xce\xf0\x0b\x1c\xc6\x97=\x149\xd3\x16\xa5n\x03\xa5\x82}m\x92#sz\x11\xb7\x906'r\x17C\xd0G\xe5\xdd>\xfb\x0e\xf8{\x90\xc9\x85\xd4\x12\xc2e!\xf7\xce\xea\x95BX\xb3^\xc1\x19\xa1\xcd\xc5\xc8\x883\xc1\xa7\x17\xacO\xe8\xb3\xc4\x7f\x80\xb2\xef\xc4%\xaf\x92\xea\xe9\x17\xe4\xe0\xf0\xd1\"\xe8:?R\x8d2X\x92uH\x1d<\xb1f\x04\xb3\x97\xe4\xcd\xc2'\xfcF\x9a\xb1\x82{k6:\xc7\xec\x14Bj\x06LW|\x1d\xea'\x7f\x04-J\xe2\x9b!$&\xc2\xd4Q\x02\x82\xfc\x10\x8b\xdb\x80:\xd7\xb5=\x9aN!\xc4\xa3Ud\xdd.?\xdb\x12\xf0&\xe0\xd6)\xadC\xf2\x0f\x9d^\x98\xc4\xcf\x09\xb9$6\x1f\xeb\x9dX\x7f\x8f\xfd\x88r\xd4\xe3\x9fB\xb2d,\xfb&\xf8\x07/=\x17\xdf\xb6\xcc\xf5\xe8\xf7VZ'\xf5;\xb2\x84\xbd\xba\x9fv_\xd8\x82\x1e\xaf\x87\x05\xc4\xd3oeG\xd2\xa6\xd3A\xba\x1a\x86*\xf9R\x94 >6s}\x8e\xc2w\xdeA\x18\xb3\xe2\xdc\xd6+4\xd6\xc4W@\xe9\xe4+\xee\x14\xc7a\x9a\xd6\xef`gM=5\x1f\xe0P\xd30\xa1\x8d\x94\x97\xb6v\xb5\x84\xfb\x89\xec\xcf\x90\xd8\xa8\x8e)\x05\x0a\x0b0\x9f\xb7\x08\x98@,\xa8;\xe8\xdf\x82k\xebp\xf8'\x1eS\x91\x07\xdd),\x0efF\xc9\x96)2(\xedB`\x8b*\xee\xec[\x02A\x96\xae\xd6\x1ag\x18\x0a\x8e\xed\xdek\x08\x19\xff\x99B7\xa4\x90\x8d\xc0\xeb*\xa5\xbd-\xb3\xfaw\xc2u\xeb\x916\xc1\xf0\x1b\xb9\xeb\xbbf\x9e\xf9\x05\x15\x8c\x97sc\xc90@C\xf7\x9c\x80z\x85\x95S:\xcer\xc8\x9d\xaa\xb3\x06O|\xaf\xeb|\x15\x8f\xb5f\xdc\xe3\x1d\xb6\xfdw@\xd4V\xd4\xa8\x18\xe3Y\xf7\x1e*\x0c\xb4\x9d\x92Y4\xca\xd2j:\xcd\x11$h\xd5\x8f\xe5\xdas\xf5(\xe0\xa4D\xf1\x18\xe7\xae\xb2\x11\x04\xe8\xbci\x93H\x9b\xf2\x10\xcc-\xe4\x11\x902\x8a\x89r\x9b\x99|\x0a\x8f\xc6N\x94\xa7f\xa0\x8e!\x886\xb1\x09g\xbdSo\xb4\xe2\xf8mLO\xf7f\x02\xa0\xd6\x83#p\x17\xc8>du\xf9e\xcaD\xe6\xf7\x9f\x8f\xc2\xcfl\xb5\xaa\x85d/G\xa3\x1f\x13\xc8`\xab\x07\xad|\xfbL\xcaY<u\xd8t\xaf~\xdeR\x18,7]Pk\x92\xb7l\xa9\x9dg\xe0>v$\xc7\xa41\x86S9\x1c}\xb0\xf4\x8e\xf3\x8a\x1c\xb9\x08Jr\x86\x9e\x09\x94\x0aTBC h9&\xc7\xd88W\x92x\xea\x99\xfa\xe4\x1e\x83\xe3\xfaA|\xea\x9c\xac\xe8\x84=\xa99ewWI\xfd$\xa4\xe4\x94\x92<\xb1L\xc3\xdc\xad/y^/d\xea\x08\xfe\x10+\xa5\x03#\xdfK-U\xd0\xfd[i\xbd\xc21f\xd3\xec\x00\xcb5j\xf9\xac!9gj\xbb\xe9\x97-\xe9\xfb\xa4=y\x1ds\xf8#\xe4\"&\x06\xae4l\xf0\xf9\x1a2\x08\x1c\xdd#\xad\xc5\xecT\x8dE5[\xce1\xfbmy9\xb0\xa3\xbf\x99\x01e\x0bA\xfc$$_\x8b\xe7T\x82n\xbdJ_\x05\xef\xcc\xb6\x02\x04\xb9\xaap\x8e {\xde\xfa\x0f\x9c\x9a\xe8\x9a\x8ed\x02\x0a\xf3\x16E\xfd=:n\xa8\xae\x15K\xe1?*V\"M\x82G\xfe\x07\x9c\xda\x17\x92\xeex\x1d\xb9\xac\xff8i\xb5;\x12qDk\xff\x18\xd3\xebJ\x13C\xcbg\"\xc4f@,\xe9R\xf8\\\xf8\x02\xf7\xd0\xb1e\x9c\x0e(\x08_\x18\x895h\xbc\x0dU\xd9\x154\xccq'e\x88\x82\xa3;+(A(\x17\xe1\x82\xfb\xb9\x8fF\x92\xaba\xaf\xa5\xf5\x9d\x80> \xcc\x99\x81C\xbc\x82\xfd\xcd\x1a\xa4W\xd1\xdc\x92d\x03e\xb0\x8a\xbc\x94\xc9#jN\xbb\xfb9{u\x9aN}{\xa8=S9\xf6\xd2\x92|\x17lOcasB\xab\xd8\xa3\x84\x01=\xe9\\\x9c\x9f\xb2c3\x9a\x1a\x93\xf9\x7f\xc2\x87\xc0=d\xe7\xb9L\x1f\xfaT\xb4\x90\x98GW\x8fe\x1e\xd9\x7f\xad?\x1f\xcf\xdc\xee\xc1#\xeb\xd6(\xbdw\xcd}7\x0b\xb1c\xe3z\xe2}\xbb\xa2L%'F\x89\xf0\xbdw$\x98V\xcf\xb2\xb7.\xe5=g)\xbe\x0b\x8c\xf6\xbc\x99q\xeel\xd6\x94\xb03\x962\x0bD\xe9\x16\x14z3\xbf\xab/\xb3\xbf\xcb=[;\xcf\x81vw\xac\xae.t\x00:\xb4],\xb2q\xc4-\xb5\xf3\xb8\xc4\x84\xdc\xb9\xf3\xbb\x8f\xf1w\xf5\x02\x7f\x17\xf5C\xaaz\xe9\x8c\x9cum\xb3\xaa*\x92^:IZ\x14\x8fQ%\xde\xda=T\xeb\xf5\x14U\xa9\xcd#R\x87\xbb\x8c\xe9\xb0\xdaM\x0a\x02p\x90\xc1\xfax\x0dGfE\x9a\xa3\xe2\xb7`\x93\xd7\xbb\xfa\x9eG2Y!\x82kK\xac\x91\x13x\xb0\x13\x9bA)ehC\xc2\xfd\xf3z\xc9\xb7\x18\x09\xf6\xb1\xabz)\xf7\x86\xf2\xca\xaa\x90s\x07\x0c\xf4\x03\xcb\xa3\x0a\xd0\x86\xa8\xa1\xe94\xa7P` a\x98WP\x872\x95\x11\xf1Y)\xbdp+\xf8Ja\xae\xfc\xd2\xb9\x1a\xb1ES4ct\xde\xd5-\xb3o\x03\xc5+\x14\xe2\xd0\x92\xc3\x1f\x9a\x82\xbeZq\xe2\xe8\x9e\xda}\x16\xa2\xde\xa0\xd9\xb7\xeeD6u\xce\x198\xef.7\xe8\x9c!\x9f\x1b\x1f\x80S\xc5\x0cT\xc8\x9c\x0a\xa3\xd9|B\x1e\xff^\xac\\\xf3#\xbeW\x9c\xa9YtFc\xcd{\xba^\x91!\xd3\x09\x07\x00O9@B\xe7\xc0\x12.\x9a\xbenh\xd6\xd2d\xfa2\xf1\xa4\x18\x04\xafW\xbcr\xae\xdbuX\xe0\x9dr\x0d\xab\xe8\x1duaN\xf7^8\x0d\xab\x9b\x9e\x07\xd8b\x03\x95#=\xa6\xf5Rm\xde\x04BE\x96\x84\x87AUq\xeb\xa5\xf2\x06\x93\x15\xaf \xbe\xb2\xcf\xa2\xc2JM3\x01G \x0e\xa6\xd2\xf0\x9a\xc9\x0b\x86\xed\x10\xa3\xb9\xb9\xd76\x94\x14\x8e\xa3cCB\x18P\xf9B\"n\xd9D\xab\xd3z\xc2\x88\\\x89%>\xcf\xcd\xec55\x04'\xc93\xb8\xc5u\xe8\xf7^\xcf\xfdK\xbddA<\xe9A\xb7J\xaa\x03\x91\xe9\xed\xee`\x09\xced\xd78\xaf\xc9\xf9\xf3k\x03\xed\x0d5\xf4\xaaMP\xc0%XE\xfa\x00:8\x8b\x84\xa7\xb8\x1d\xae\x82\x90\xe0x\xe3\x0a\x99\x90/\xa4\xea\xf5\x14\x8a6E,5\x08\x1d\xe7\x01I1F{(\xe5\x01\xc5\x04\xae\xed\xc548\x01X\x0e\x8a\xd3Hf\xb9\xb4\xf7\xcd\x95\xf8%v\xacT\x1a\xcf?Ue\x7foKE*?\xc3\x9cH\x8b+R\x02\xcb\xb8g\x05\xa5\xa5'O\xc0\xf5\xc7\xb0Pm\xd3\x93`d4$\xb2\x97p\xdc\xac$0A\x90\x94+\x97;\xe1\x12}\x0d\xa7\xf2H\xfa9\x8cCR_\xd6t\x957\xa1\x05\x0b\xe2\xb9vom\x8f\x9b\xbf\xb9\xdb\xeb\xcc\x0353a\xb2\xa1\xe2\xcc\x91\x8f&r\xefp\x98\xa3\xfd\xa3\xd3P\x0c`\x86\xe1m\x0a\xc1k\xff\x1e\xa9\xbdg\xc8\x94\xca\xde\xebE\x00\xd7\x08\x86M\xf9rWB\xc5D#\xaec\xb9\xde\xe6v\xde\xa09.\x9c\x90\x87\xd7\xfe\x800D\xa2K\xfd{\x9d\x9b\xf6\xe0\xb8L\xf4\xf7,|\x06\xcdH\xd9\xb3R\x8b:\x93\x91{\xc7<\xd4\xc0'e\x17-\x82B\xa3e#\xe2\xf8\xa8;p\x15\x8e#aT\xa2\xed\x83o\x094L\xf8N\xb8\xdb88\xe5\xc4\xa4B!M\xac\xd9\xca<\xb0\x0c\x13\x9c\x8c\xdf&:\xb3\x1b\xc5N\x9b\x16|\xf7v\x8dj\xe33\x9e^O\x13q\\\x86G\x01\x0f\xa7^\x1a\xdc\x87\xd7$1\x81\xc0O\xaf\x12I\xf1{\xce\xa4r\x0b\xca\xa5\x9d\x0f\x92%\xc1i2\xbd(" +
+	"\xbe\xe5\xf3\xf3\x12\x18]8\xafF$\x15##\xa5\x8bh%\x85\xbf)\x83t\xd2\xa2\x99\xb7\xdc\x0e\xdb-\xce\x1e\xd9\x05\xdd\xb3gkO#\xa1g\xd0\x8f\x05\x02\xb0\x00`\xaco\xe1\x85\x0dC\x01a%ED\xa0\xc6\\\xd8g\xf8\xdbk83\xcf\x19'\xfb@8\xd9Es\x1e\xdf\xe7\x9a\xb5A'\xc8\x84\x1e\x0f\x1a\x8e\x84\xd9\xc2\x81/\x09\xa84+\xab\xad\xb2\x1c\xca\xa9\xb9j\xc6\xf5+\xca\x8e\xaf\x92\xa2\xa8\xb1\x14\xa2-x!\xa8S\xf8\xcd\xdf\x04{s\xa1\x80v\xeb\xa2>\x90\xe6\x93g\xe3\x19\"\x92\x0fX\x1f\x82\xef\xe0]t\xd1j*\xff\x8a=\xc1h\xaa@7\xe4\x9f='\xff\xde\xfeOWS\x84\xf6\xd5\xf0\xa2\xf5\x82\x9f?\xe1}5\x84\xc5\xaa\x1e\x19Uw\xe7\xb2\xd3\x0b\x0e\xe7\x1c%\x94\xae`\x99^\xcd\x19\x06\xd2\x8a\xe7\x14\xd4\x15\xa3y{eQ}\xc0\xeb\xae--]\xf8Nsp|\x0b\x9cZ\xbe,\x9e\xd01\xfa\x18O\\n\x92\xd6\xf5\xd0\xcd[\xc9\x8d\x9b4\x81\x82r\x9b\xb19\x90\x9e\xb8\xa3O[2k\xd6\xade\x0b\x9e\x93|\xd7\x8a\x99\xb3C\x14\x08P\x912\xf5\x89F`5\xee\x89b!Ov$n\x89\x0e\xa9\xf6<_\x9a\xd0\x15\x95\xee\x11\x86\xf32s\x84?2j\x91\x0a&\x12\x19\x9dC>e\xa3(\xfeD\xa0\xc93\x17\x19\x99\xde\x87X\xd5\xa2|(\xb8\xddIO\xcfK\xd3\x92\x11[</\xa4aH\x16&q?HK\x8ery\x1d\x82\xa8\xfb/\x12\xcf\xa5C\xe4\x8d%R8B\xe0\x8c\xf1\x94z\xe42\xbf\xab\xdf\xf4\x12Q\xb3\x05f^&\x13f8\xcc\xe4\xb8\x05YHx\x11:\x05Z\x14\x14\xf7\x87Z\x15\x88\xa3\x87[am\x00Hy\xab[7\xb1\x99\xfeA\xba\xa4\x98\xfcNEH\xb01\xfeD\x12\"\xe1\x82\xe2\x0fh\xe3\x95\x90]\x97\xa4p\xbd\xa5\xd8\x9aY\xd8\x8a\xb1\x9b\xb8B\xb9\xad\x02;\x89yv\xa0\x13\xe9\x82B\x95e\xc2-\xff\x13\xd1\xc7\xecoiZ\x0c\x82`' \xb8\xf5\x8d\x01\x07y\xe2\xd2\x96\xe7VR\xa7\x18\xa7\xd6\x01,\xdd31\xa7n\xd8\xc2\xc7\x9f\xb9\xa4\xa3q\xed\xba\xf8\xa7pr\x9e\xf8\xce\xfd\xc6\x0f\x1cX\xf6`-$\xc9H\xcdk\xe7\xd2\xd3\x95\xf5\x8f\xfd\x9b\xdd\xf7\xb7\xc5\xb4V\xeeZ\xaerj\xb3\xe1\xa8yG\x98\xb9\xbeM\xa2\x1c\xe2\x09\x91\xb5X\x8e\xa7Q\x7fO\xb9\xdc\x97\xed\x00\x1aL\xc8\xdcQ\xcdN\xf9u\xd7\x94\x03\xc7\xc6;`\x83\xc2\xd2\xf7\x1f\xad\xfe\x0f )\x1e`\x02\xcaf\x14CkL\xd7\x84<\x9e\xc4\xbd\xde1;\x12\xafw\xf3\x8e\x97z\xcb%\x90\xda8\xc7s\xa2\x910\xe7\xcbF\x8b\xfd\x19%/\xf7w\x9e+\x93h3$3!\xf9\xc7\xc3AAM\xa5\x19\x95$\xdb\x0c\x08\"\xcc$G\xca\xf8\xbf\xb2\xb4\xe0\xfen\xfb\xb8F/\xef\x98\xfa\xda\x15\x98\x07\\}\xb9\xc6$+Hj\xb5\x04\x89\x86\xfe\xb2\x0b8[\x02'x\xccl\x7f\x90\xc8*\xd7\x7fu\x8a\xe9w1\xdc\x94Q\x99 |\x939\\\xdbj@K\x97\xa1\xa0\x8cf\x8d\xf0\xf4\x84\xb8^\x0f\x0a\x86\xb27\x18\xbf\xf9\xa8\x0aqI\xb0\xe07X1\xe7\xd2\xbc\xba\xb0hO\"\xe4-7\xea\x9d\x8a:e\xbeI\x8d\xda\x81\xae\x82\xe1^\xff\xbe\x00\xa9!\xc9\xb3-\xe8\x09A\x05\xfcT\xd0.\xafpJL\xa14=uXw\"\xf2|\xe6\xa4$\x7f&\xa8\xcd\xfe\x9e\x0c\x85\xc3\xbbD\x90\xbc\x8b|I\xa6\x95\xf8Q\x17t\x18\xf8\x8e^[VQ*\x0fQj\x84@R\xf8\xbe\x90%{\xd5-\xe9\xbd\xbf\xffx\x99\xca\x07\x08\"\xc7\xa9\xebz\x05\x926\x09l=\xbcf3I~A\xdcA\xb2\xc1DP&\xd5o\xb1\xf7\x00\xbf\xf5_\x9d\xcd<x\x86DJ\x1f\x8e\xce7R$T\xe0e\x8f0TP\xde\x82\\\xa5\xcf\xd3\xa9\xb9\xef\xd02\xc9|\xaa\xaf\x80\x8dX\xa77\xf2u\x17\xb0\x88\xe2\xae9OR9&\x0b\xbd\x12r\xea\x82\x9f\xd6DZK\xdb\x84H\x09\xb2US\x82\xb8\xce]S\xa6\xae\x80p\xba\x05 ;\xfa\xaa\x022n\x19\xdf7\xf1f\x0f^8\x1co\x82\x97\x1az\x89\xd6\x07\x09\xd1W\xfc\x80A\xc24<\x8d\xa8\xbb\xb1\x81\x15\x9a\xa8\x05\xff\xc8P\x9d\xa1\xbe\xb3X^-a\x9f\xb3X$\xedN\xdf\x17\xd6\x0a\x00v}[\x80\x92K\x1b\xa2\xfa\xad\xb3\x87\x8a\x84(,e\x84\"\xa1\x0f\x8a~\xf9|\xe0\x95~\x0a\xadx\xac\xbd\xe5\xcd&{\xeb\x19,\x82\xe1K\x8fa\xeb\x8c#\xab\xb8\x89X\x0b\xff\\\x0d\x11sd\x9e\xe3N\xbf\x83\x04(k\x08\xa2\x86\x11\xcb\xfd\xe1\x12\x9fK\x89\xa3z1\x88NW\xc2e\xc7\x82\x9d\xa1\xb0\xa7\x80\xd2\xb1\x87\xc9S\xcf\xbe\x8c^N\xf2\xebT\\\x89\xbe\xe6S\xadyx\xbb\x91\xf7\xfcl\xe9\xe7\x00%\x9dCPYuI\x0c\x0dL\xd7\x9c\x1e\"Z\xd5U\x12\xd2ps\xaa\x11a \"\x9d\xd71\x063)g#k?r\xad\xc5\xa6\xcf\x1c&\x1f7\x93F\x04\xbe\x8f9\xd7f\xd6\x8c\xf4'\xad\xe1\xb0\x12(\x99\xa0O/z\xbbG\xeep\xcf\x0d(]k\xce\xa5\xcbF\xc8\x9dG\x8a\xe5\x8a\xf3i\xf7\x08\x11\x15\x9b\x80)\x81\x8c\xd6\x93fq\xf5J\x81\xa2\x86\x86\xddP\xd7PYTeD\xfe\x80\x0a\x82\x130\xd8\x97Z\xb7\x89\xaa\x19\xccm>G\xc5\x03\x99g,8\x88)A\xf2[\xf1\x10\xc3\xaf\xdf\xd3(\x93\x86\x93\xcce\x11&u\x19`g1\xe1[\xd5\xab\xb6\xefS\xde\x02\x11\xf4\x86\xe4Rm\xd7\xccC\x96\xd2T\xe1uoQ`\xcc!\x0a\xc6\xb4\x83.n`\x19\xd3\x1a\xa4px}\x14\xd9m\x8d.^\xc8O\\\xfb\x07\x89\x05O\x9c!d\xa7\xefb0\xa9\xbcJ\xc7\x05\xb9\x14D\xa0\x13f\x08\xbd\x17\xd9\x81[-POM\x9c\xf0<\x92X\x89\xf5\xf9\xaf\x08t\x90eg>\x96\x04\x1f\xb0\xa6\xb9\xb7\xff\x06\x08\xf3\xb98\x12F\x10\x11\x9b\xdc\x0fa\xfb\xab\x12\xaa\xfa\\\xe24~K1+\x9e\xa5o\x94\xcc\x9d\x8az\x93\xddk\x8e\xb7\x05\x1eo \x89\xe3\xb7r)%\x04}z\xd8~A\xb4\x0cV\xc6;\xe3\xa1\x1a\xc6\x8d\x11t)\x9f6Gp\xaem\xac\x93\x1a\x1e\x18\xcf\xab\xe8Ef47s\xd9\xf1'*\xc8\x1cl\xfb.\xa7\xf2\xea\x04@\xe6\x8e\x9a\x07\xce\x07\x167[\xd4\xd7b\xf2\xd4\xd0\xbfd\xde_\x00\x04\x04aj\xb0|\x91En\xfd\xb3\xf7\x98\x80\xb0\xda\x10l\x05\x17Bv\x86*\x88\xf8'\x81\x88\x9e\x93\xba\xe8-\xe1\x07\xe4qxE\xc5\x10\xba4\x01\xf5I\xff\xfc\xe4\x19\x8er\xb9\xeeu\xec\x9b\xda\x85\"\x11w\xe2\x1f\xceeO\x81^\x8a\xf5\xb7!\xda\x0f\xcd\x9e\x0c7.[\x17N{Y\xa5\xef\xf2\xd9\xf1\xb4RIV\xe7\x19[M\x9dP\xeeV\x87\xb9\x1dt\xfb\x82\x82\\\x17\xc8J\xcc\xac`\xddw\x81\x06M\xa4\x9c\x1d\x07r\xa6J\xce]\xe0X\xdc\xe9\xa1\xd0\xba\xbe\xc7\x06\x1cY\xcf\x08\xc0?\xe0\xdc-w\xc2\x0b\xad\x1c\x0c\xa0\xf5\"\xb9\x0c\xf6\xe0-\x00\x8e\x13>G\xe9N\xa9d\xa1\xd6kU\xf2\xf5\xd8\xddW\x02lJ>\x8e\x0fGt\xd8\xa9\x96\xe2F@\xcdWj\xd5\xae\x151IT\xaa|\xb3~\x86\x11\x8a\xb9\xc5$\x9e\xed*\x06(\xd5\xb9\xc9\x86\x9a\xebI\xf61\xd8\xda\xd0\xa1\xc9H))\xe4$\x00T\x81}\x09\xa8h\xc0\xe8\xc4\x8c\xa6\xa5\xacIH4\x8fu\xc6\x1a\x81c-M\xba\x07\xb6I\x97j\xb6q\xdf\x8e\x9c\x13\x87c\xe1D\x17\"#F\x84\xff\x9c\xe4\xc3f\x13\x86\x88\xafS\xa8\xa9\x7f\x94\x8e\x86H\xaeH\xf1h\x1a\xd5\xef$\x12\xe0\x0ecg\x15\xcf l\xe8\x03\x14+\x84?o\x07^\x0bK\"m\x9aO\x1b\x04\xea\xb5\x02s\xc1\x8a\x8c\xb8~\xe7t\xb0X\x1a\x18\x97XC\xf2)\xf4{\xcc\x12n\x16\xcd\xb1\xe0\xc0,z\x91a\x19\xd1;`\xc0\xc7\x82\xfe\x85D@\xb2\xf5\xd3\x84\xa6\xa7\xe0\x81\xcd\xe1I\xe6r\xfc\xa7L\xf3H\x14>\x82n\xf3\xa7\xe0N\xfa\x9b\xbf\xfe\xea\x0f_~\x02\xff^\xff\x13\xb7\xc5\xf6/\x06_|\xf2\xdbO\x831\x1c\xaf\xef3\xa9W\xeb\x07\xf8\x85\xbf\x8b\x86\xaa\xee5\xf9\xc5\xe0\x97\x9f}\xf5\xdb\xcf>\xff\xfc\x93\xdf\xfc\xed/\xa0\x98*\x9eI\x93\x10\x91\x02\xf7\\\xf0\xb1\x89?\xc7\xa3\xb6\x05D\xf3s\xf1Md\x9f\x80=\xc5i\xe8\xbd\xb9\xf0u\x18]A\x96\x1c\xb7\xd0$\x80k\xc1Ek\x8e\x84\xf2\xa5\xc7\xf5\x00\xc4t\xe2\xe0a \xc1[AQ\x03^\xda)ZV.,\xc4\x07\x08\x06\xa2\xbe\x86\x12\x7f>G\xb7#\x9a\x85\xcc^,\x11'B\xad\x1dnk`\xfal\xccK@r\x07)\x03aW\x0f|@\x12\xb6\xc8q\x8e\x0d+R\x0c\xa2b\x02\xed\x9e\x1c\x85\xdb~\xaa\xd5o\x10\xf5\xb5\xde\xb9\x15\xd5p\xf2e\xa5\xdd\\Q\xc9\x10\x8a_\xa1\x84\xf3zH\xa0K\xd7\xa4\xa8\xd8\xc59\xebp\x0e\xf1\x8a\xbe\xbb\xb9\xce\x93d\x9f\x97\x8f\xc6\x13_L\x040\xe0Es\x8a\xf8&+\x13`\x1e\xea&\xd3\xefi<\xbdM\xb7\xae\x9c\x98R\xee\xe7\xe3N\x96\x8e\xfd\xaewl\x17W\xcdlj\x9d\x94\xe4\x15y\x86\xe9\xe4\x9b\xf8kj\x7f>\x1bhU\xa0\x98\x93/[\xd4Zc\xda\x09\xf0\xdbQu\x00\xc7\x90z\xe2\xe3\x85\xb9\xd5\xfa\"\x97>\x87\xd9\x0a\x11yt\xd7\x16\xa2(X\xbf\x85\xd5\xf6\x11.\xc7\x11_\xa6\x11L>\x0b1\x86\x8a\x11\xf1\x00Wd\x04\xd6\xaf:\x07\xf2\x9e\x8a\x9b\x0a\x92\xef\x03\xef\x0f\xb4\xb6+\xc7S\xe07U+\xaf\xfd\x89\xc7\xb8\xe3\xa7\x17\xea\x15\x9b\xb0\xfa5\xbfp\x9e2x\xa6\xa5\xc1t\x99\xe2\xc2\xf8\x14\xec\xef[>\x9di$W\xe1R\xedT\x89\x92\xff\x0c0UF;\xb7y\x9co\x815R\xad\xa1\xc2\xd2\xd2\xdd*\xb6\x8b\x13>\xb6\xea%}\x19\x8aHE\xac<(>xN<\x87 w\xcaa\xc1\x0d\xc1\x7f_\x0e\x0c+\x9f\xf0\xaeX\x89\xb39a~\xe2\xec\x9b\x1ff\xdf\xdc\xde\xe2\x1a\x0d\x1bs-\x1a\xf9\x86J\x13\x06\x8c\x05qXx\xff\x85S\xb1\xeb8\"b\xb8>\x96Q\xca\x9c(p w\x92}\xb7\xe1\x8b~\x10\x87\xe4\x90\x8d3\xc2`\xfc)\x02\xab\x1f\xa8\x85Ed\x16d\xa5\xb8\xf4X\xa6\x12\x15\xd0\x0cq\x8et\x1bM\x95\xe7\xb4Mc\x7f.\x04\x96X\xc6]\x80`\xf2#4T\xea,\xb7\x94\xcd\xaf\"\xb7t(i\x04'\xbf~\x80w\x98\xe6\x89y\xbd\xb0Ev|\xc5\x1d\xcc\xf9l\xe3\xca\xc8H\xe0\x12\xb0\\o\xf2\x1a'\xe1\xe7o\x88\x03va \x1e\xf6\xdb\xd2\xf7)\xa55;\xea\xb4\x0e\xc3-\xd1\x0b[\"\xf3E\xd5\xbd\x8e@\xb81.\xa21\xbe:\x0f!\xf0\x15\x00\xd1\x85\x81\xc0\x05\xaf(jd\x17\x0c-\x8b?\\\x04\x145$\xe3\xaf\x04!\x19\xac\xaf\xfc\xab\xa8\xb6\xde\xfa\x16g\xe6\x8a[\xc3\x8c\xde\xce\x0f\xec~\x11\x8d\xf0R\xd4\xbc`$\x97L'[_\xf17\xd7\xbfNu\"6\xd0d1\xa5'\x19\xea\xc1\xb3\xcd\xc2\xe0\xd1\xe2T[\x0b\xe0v\x1a\xd3\x03\xa5\xb89s\x0b\xc5\xd5\x8do\x94\xa7\xbcG\x00\xfb-,\xbf\xf5\xfd \xe8\xd7z\xe2\xda\xd9\xebC\xa2\xfe \xa8/\x94\x80\x16\x82Z\x94\xa3\xb9\x10\xadg-\xeb\x96A\xb0o\x9b\x92Y5\xa16\xa5\xccw}\x13\xd2g\x17\x1a1y\xa2E\xdf&\x96*KJ\xa2\x8a \x8b\xe4V\xb4\xb5\x88\xf1f\xd8\xe5P(^\xd1\x02\x02\x05\xc6\xa2\x99\x86\xfc\xc4\x0a]#yK\xf0\x00\xe2\xb9)o\x18\x16\xc4{\xe8\xe4\x93\x1f \x8c\xa5\x19\xab\x1b\x81*\x1f\x86\x08?$\x9d\xd8\xcd\x94\x88y\x8d6\x02\x82\x92^%\x12\xf3R`\xab\xd4\x11\xb7\x14\xc7\x8b\x0d\x9b\xeb\x1b\xc4\x0f2a\x98\xa2K\xbc\x09\x8f<\xe7\x96\xc0\xe8\x08|\xdbn\xb5v\xb5\xd5\xc2\xdc\xd7\x9f\xc2\xdd\xc1\xd5\x0a\xb4\x81\xa2\x19KA\xb2)\xf7ik\xa3\xcd\xd8\xb1~\xa2\xe6E2'\xad@<\xd1\x7f\x1a\xdd\xf8\xa77\x94*X\xa7\xf6\xfcm\xc1Q\xca\xd2Q\xcc\xf2\x81\xbc\xa6\x95$\x12\x8d\x1aL\xdc\xc2~\xd6\x85Gs\x98\xa2\xa8\xc3W\xc3\x19\xb0\xfd\xf1\xb6m&\xf7:@\xd7\xa9\x13\xde\xe2\xcc\xc1\x1f\xb4\x9b\x0e}\xaa\x99\xac\x9e:f\x95\x0f{\xc4\x99I\x99k(6pA\x14;\xa1\xcf$o\xe4&\xe3p5G\xc9\xc7\"\xb1\"t$M-\x82\x90\xff\xfe\xc7\x84'\xe1\xb1\xc0/\x8a\x89\xbb\x04\x9c\xc2M\xf7\xfbql\x01e\xe7\x14BP\x1aU\x80\x0d\xd2\x03\xea\xe1=\x05\x0f\xe5:\x8a\xe0H\x05rG\xf4\xb3\x89\x15\xb7=\x02\xbd\xac\x0f\\A\xf9Y\xb2\x16\xc3\xc3\x8c\xf3.HD\x8b\x16P\x06\xe7\xfb\xd6\x8f\x1f\x9f\xbc\x99\xc0\x80P\xd9\xc1\xd0H\x17\xb9\x86!)\xa6\xe7\xa68\x8d\xeaF7\xf7\xb5\xed\xf7\xe1\xf9\x00\x83\xf1\x91\xd8\x91\x14(\xe3\xc4\xb4\x7feYO\xdb\x92\x84\xed\xcd6w\xa4\xe7\xabN2<\xec[\xfb=_\xf8\xca\xac\xcc8Y6\xa7\xf1\xbcD\x0e\x1d:\x98\xee\x98\xfd\x89X\xbf\xb1=\xad\x99\xb1\xdb\xe24\x0bTt\xfa\xc0\xa0\x17\xe4\xc9\xb5\xbb\xec}\xe6V\xb9\x06%X\xfe\x99\\\x84\x97M\xe1\xbd\x81\xc1\x93\xa5\xef\xc9\xf8\x12A1\xdb\x9c\x80\x01\xbam\x8a\xf8y\xf0T$B\xc5\xca\x89\xe9\xf9\xd4XH\x9b\xc6C*\xb3\xc8\x91\xfaS\x1cW[\xc3\x9ea\xb7^\xb6\x8a~\xb9m\xf1B?9\xb5\x0e\xbb\xedi\x0b;G\x8b\x98Y#\x065*E\x9cw^1\xd3\xe54I\x1f\xdb1\x99\x89\xcfL\x09\x93\x11\xac\xd6{\xc8,\xcfe\xc3\x99<M\xcf\x11L\xd5\xd9\xd4\xb1%(B\x92f\x99\xf4U\x80\x08\xac\xaa?`\xc1\xf5\x92!!6\x9fah\x9c]\x17\x96\xe9\x81\xd8\xab\x16\xd5G\x93\xf6\x18\xd0x\xbc\xebG\x83\xf34\xa5V\xbd\xd5\x9b)\xad\xf2\x85f\xad)2)\xe9Y(\xfa\xe8L\x88\xb8\xa5\xf9zZ\x97\xa9\xb0q\x04\xfc\xe8\xc27\xec\x92\x92\x0c\xda\xda\xc4\x86\xffF\xc8\xad\x07\xecYF,P\x0eu\x89\x0d\xa0\x91Q\xa2Cn\x8b\xc6x\xf3.8\xce\x9b\xb1\xb8\xbcRZb\x87\xb0t\xe0\x8c\x91\x8b\xe5\x0a|\x10A5v\x0b\x99-%\xb2\xf1\x91\xda\xde\xec\x12E\xd7\xdf\xa8M_q\xaaC\xf4\x18\x8c\x88\x9a\xa5\x9bqY\x9em\x9ayR\xa0\x15\xaf\xe1\xe5\x1f\xa4B\x07\x87M\x1b\xa5H\xc3L\x9e6e\xec\xae\x87\xe50\xff3u-\xec\x99x\xd2B\xa3p\xd1N\x04\xa6\xba\x188:\x8a\x82\x8e@\xf1t\xe27\x88\"\xa3^\xaa<\x0a\x12NE<\x92pN\xeco\x0d\xac:\xb9B\x19N\xb2\xb7q\x00'\xad_|\x03\x8b4\xf7E%\x9a\xc6\xef+\x02\xe92\xc9\xa4?\x1f\xa4k_(\xe9@\xa2\xa8\xbe\xd6\xa9\xd2\x98\xfd\xd0e\x1c\xdb\xb2\x8cok\xaf\xa0\xbf\xa7\xaf\xe1tC/5\xd1\xbaE\x1d\x14^\xcbm\xf8\xa4\xaa\x97\xed\xd7P\xdfL\xaeCw\xa0k\xc4\x1c\xcd\xdb\x90Rj\x9b9\xeb\x11\xb0\x8b\xdbr\xc8\x1eh:\x09,O-\x915k\xbdf\x06\xad\xd8\xb7<n\xc5\xe3\xb8\xe7F\xff\x015\xabfn CZ\x19\x1c\x8c$\xf1\xab\xb4\xeb9Y\xc2\xc2\x09\x19\xc2\xd4\xcbU\x9a\x03\xa4\xdbV\xae\x899\x9fDB\x18\x05\xa6\x09\x86\xf9\xc6\x94\xd9\xdb\xa0i\xa2|\x99o\xfe\xda\x08*w\x98\xa6\xcc<j\x95\x14x\xfa.J-E\xe5\x80\x0c\xf0\x94p\xab\x92\xcb\x88\x02\x06\xb7Y\xe9\xb4)\xcc\xf7\xca\x9f\x02\\\xfbSAN\xb7_\xa8\xbeib@\x14x\xf1\xee\xb3Lt\x1b\xc3Y\xe6\x13H\x10\xea\x00(8,\x13\x85\x7f\x99H\x98{\x1a\xd7\xf3Rq\xea\xb1\xee\x93`\xd7\xd2\x1e\x1b9\xbf\xfa\xcf%\xe3\xc9o)\x82\x07\x11\xb0[~\xb0'\xaey\xfd\x9d\xe0s\xff\x918Y7\x8e\xda\xb6\x87\xb9\xb5\x9f6z\x8a^ti\xd1D\x01w\xc4=\x10\x0a?!\xf5\x1b\xc2\x07\x0b+9\xee\xac\xfe$+I\xfbK\xe7%\xdb\xd8\x84>\x92LX\x88i5\xf4\xaee\x05o\xf9ItA\xfb\x92i\xc6Nq\xd5\x85\xec-\xeb\x11\xc7\xb7U\xc5i\xfc\x1fC'\xba\xbd\xdd\x8d\xd2\xd2\xb2\xd3k\xbb\xfam}+J\x900\xec7\xb4\x9aC\xbd\xb8MND|'\x12Y\x09\xec\xf3\x13e\x11\xb7e\xd0b\x99\x1d_\xea\xbe\x91\x137E\xf7\xde\xe7\xc1~\x9c\xe2\xce\xb6B\xc1\x19\x96{Li\xe8\x9e\xc0\xd2\x1d\x14A{\xff\x9dT\x0b6$h\x03\xd2,0\xe6~\x01\xac\xf4S\x12\xeaf\xb2\x10\xb4" +
+	"\x90\xc0\xa2\x0bE{\x90Zt$\x85\xb3`\x13:}L\xc2P\x8eU\x0b\xb2t/\xaf\xee|\x1a\x9a\x1a\xd7\x13\x84J\x81Z\xbaCc\xc4\xed \xf1\xaf\xc3 1\xa9t\xd9L\xebW\xac\x95\x9d&\xe2\xd5q+z!\xec\x03q\x17\xd4L\xd4\xe2\x09\xa7\x04\xc0\xb5c\x95=T\xb7\x96\xcfK\xed\xd7\x88\x15*%D^\x8aD\xa6?\xbd\x8c\x11M3\xab\xdf\xd0\xcfX3#RC\xe8m\xb1\x94\x80}\xd3c\xaa\x89r\x14V\xf9\xba\x13\xab\xbc\xbd/\xe73YYxW\x9a5\xf5\xb8G\x04\x91\x08R\xa97\xa4\xef!)\x0b \xa6\xa5\xe7\x9fbw \xb0\x0f\x8b\x9f\xd1IK$)\xf2\x11\xde\xd2,\xe1\xa8\\\xb17Rp\xa7j\x01*r\xc5\xc0i`p\xaf\xc2\x90\x8cp\xce\xadt*\xf9:\xe6vu\x95\x17\x06\xa5\x0auI\x00C\x1f\xb1\\\xc4\xcc[\x0a\xa5\xd1\xae\x01\x9a:T\xadq6\x0b\x97\x8d&\x0e\x00\xcfx\xce\xed\xdc\x89N\x80ea\x0f\x8f\x0c\xb0\xb6\x0f\x14`u\xaa\xd3\x09\xb7\xb0\xffrCoz\xf1x\x0c\x85\xa1\x90\xd0;QiNaw\xeei\xa2\x99k=4\xde]b\x0f\xa3KY? Z\"\xff\xf2Z\xed~J\x0d\x82K\x19\"\xb4\x15\xfbx\x8eeU\xef\x8c\x91\xccc\xb4\x90#\x9d~\xd1\x8b\xb5j!\x95E\xa6\xe2\xfa^x\xae\x05\xe7Bn`\x89~\xe3\xa3\xfc\xd14a\xd7\x9cP\xa9\xc8V\xd4\xef\x04\xf8\x86\\\x8e\x9b(\x82\x12\x9c:\xfc#\xd8\x838cN^\xd7\xf0\x14u\xb9\x16;/\x14`*YJV=\x8a\xbb\xb1K\\]\xa1\xd5\x0b\xeb\x8fg\xdc6v\x0bzK\xe13\xa6\xe9-r\xf4\xd5\x89\xd3\x80\xd4\x0e\xa1\x9b\xa7\x0a\x8e\xad\x11\x86\xd29\xdc\x94L7\xa39+vq~`\x86\x1d\x03#\xe4fH%G\x89*\x89\x9f}\x0b\x19\x94\x92\x1d\x0c\xd1:.\x9b\xd5&.\x0b\xe1+\xda\x0d\xafPzJ'\x94m\xb2b\x05U\xba\xb7R~\xe6M\x8cE\xea\x95lg\x8c[\xda\xe5\xd0\x85\x0c\xebE\xdc9\x96\xa6\xe2\x1e+P\xa6\xfd\x04B\x9e.\xdc\xc0\xceV\x87\\\x92\xd0%\xcfY\x83\xdc\xa9\xd1\xd3\xeb\xaf\xaf\xe2qr\xda\x14\x1a\x19\xc3\x7f\xf6\xbe/\xf3\x07S\x05\x11{\x84k\xbf\xb3m\xa8r\xd2\xb7\xb9\x02E\xe2\x12\xb6D\xd8\xd9\xb4\x10\xe5\xb3\xde\x05\x02\xa1\x12\x99Z\xa9W-\x8c\xd8\x03\xeb\xcf=\xe8\xc6\xd8\xf0Z\xf5C\xf7\xb3\xfa\xb5\x13\xcb\x16\xbc\xb0\xe3\xf1\x91\x92\xff\xd5\x80\xe2\xaa\x84\xd7h&4\xf5\xb9\xcb\xb2\x04O|\xfd\x15\xd9\x1fELMq\xd5\xcc An\xf8.\x8f\x042B\x93\xaa1\xfd\x8a\xe4?\x89\xf9/2\x16\xd6+}\xc4c\x15\x88\xc0\x08\xe9\xb9\xee*\xc4N\x9f\xd8\xea9\xb7\x84{\xd6U\x1dg\x18\x1e\xd5\xd0\x15X\x9a\x93;v\xa6\xd67M\x99\x91\x86\xcf\x8co}-\x18z\x9eR\x90\xb5\xf2\xfd\xc0\x9a\xcb\x85\xf3\xd6)\xd8\x93\x96\xc94\x13O\xd2\xde\x80\"\xf14\x88/\x99\xbd\xf3\x13E\xcc\xdeL\x9epb\xef\xa7\x07S\x12)v\x9f\xda\x84\xdf\xbf\x15\xe4!\xbc\x17@\xff\x17`\x99\x14mG$w\xdb9\\y\xe70t9\x94B\xdd\xc7\x03\x11K\xa7\xfe=\xf3\xc6L\xb8\xed\xd8\x03\x82h\x84\xa0\x10\xdd\x08\xdb\x94\xba\x9dx\x08\xf2C{`g\xde\x10\x98\x9c\xf8\xfd\\\x85F\xd0\x1a&{\xf8\xe8\xd9:7\x0f\xe5C*j@K\x02D8\xe5\x01\xe2\xc4\xea\x15\x99\x8c\x99V\x07%\x01\xbf\xdb\x08]\x8d\x00\xfc\xa5\x86\xcf~\xdb\xcc\x8c)\xd0\xcc\x08Z\x99\x85\xc3w\xb3\x00\xb2\x12\x16A\x05\x90\x04\xb4\xe3\xbe\xc1>\x11\x16\x9c\xf6\xb4\x02\x99\x19\xe3\xb5\xd4[\xa9\x9c\xe9\xc7\x08\x07\x17\x95\x15h\xcf\xe2\xf4c\xdao=0\xa5\x84\xe8\x1e\xd3\x9e/\xac\xe0\xd5\x02F\xfc]S\xf0e:\x14\x1c\xfb>N\x16\xe4,;\xf8R\xb6bM^\x1d\x03\x97\x02l\xa8m\x89[4e\xe4<Wd\xf6}\xe0\xfeF[\xb4\x95\\qs&\xce]+\xba\xf0\xc8N\xeb\x0b\x9fb\\\xedQj\xc1\xad`\xf2\x88\xea\x8dW\xb1R\x95M\xc5\xa81\xc6\x9e\xe4\xa8\xa5\xf0\xec{\xca\x96\x93%\x89V\x16\xd5\xcb\x84\xd5=\xef\xa4\xef\x0e{d\xd2\x85\x985\xb3\x95\xae\xffP\xda\x87{\x88\xdc=\xb2J\xc8\xa9\x1c\x00I\xc5\xe6\x893&m\xc4\xde\x9b\xae\xac\xf4\xeeV6\xd3J\x94'w(\xbc\xcc>\x89\xa9\xde\x0a\xfbN\xfc%\xea\xb4#\xf6f-\x8d\x81F\xd6\xaf\xd2\x8a\x0b\x88\x92\xfe)\xffV\x94\x9a\xd6\xff'\xd8\x88iS\xda\xbb\x94\xf6\xc2N7\xaa\x8b\xd7\x07\xfe1\x0a\xca\x05\xd8)\xba\x8b%\xc06\xfb\xf8\xa8\xbb\xdb\xf9\xa6\xca\xf4y\x94&\x0a4a\xcf\xa3\xf3|\xaa\xaah\x8a>&[^\x92\xa4\x9d\x8a\x00\xe4A\xe5xcm\x86\xbb$\xf2/\xb3cl\x09\xa5\xb6\x99\xbc\x8f\xffX\xf4\xe1.S\xd8\x18v\x8ac\x98\xd5]\xbe\xdam\x0b\x01\\\xef\xea\xca\xb8\xd9\xaaN\x00\x8d\xe5D\x0b\xbb\xbe=\xe5\xcdWTV{\xc0\xe4\x04\xc7c\x17|\\p\xeb\x08\xa7\xc3\xb4\x9f\xdd\xd5r\x8a\x99\xe1+@\x82\xbb\xca~f5(,\xf5i\xec~\xe8u7\xb1\x13\x1d\x8dG0&\x10\x16F\xb7Bp\xe8\x9a\x01\x80@%\xd8\xe7\x07k\xd3.\x15\xe2\xb1\xcc\xf2\x0b\x92\x10\x85\xad6\xcb~\xe1%G\x8b\xd8\x12\xdc\xb5\x13Uu\xa9\x93\" \x99\x94\x11\xb8$\x8a\xbc\xcb\xa7\xd7x\x09\xc4\x82\xd4d\xfa\xd4\x18\xed\x99@\xb3\xbb\xfb\xca\x1d\xee\x18\x82\xa7\xb2\xbd\x8f  \x94\x9b\x89\xc2\xfe\xf5H^C\xbaV72\xf4b\xbd\xaf\xafr\x82L\xf9\x97?\xf032SH\xa8\x8f\xe9\xa0M\xb5\x80\x0c\xc6L\xe9\xec\xc1#\xc75\xd1\x1c\x83\xa3\xed\xf4\xb7\xb4\xb8\x0e^\x7f\x8b\xd0\xc44\xc7p\x0b\xfbh\xa2\xa5\xc9\x95z\xac\\5G\xac\xd1@p\xa6\x15\xc3\xcbyw\xcb\xa3\xfc\xf1\x81\"k\xbd\xa7\xec\xc8\x13\xcfGc$\x1a\xb3&\x86{&\xa0\xecI\\6\x00\xf2\xebZ\x00B\xf4{$\xa5%\xb8\x1f\xa5\xc0\xbbS@\xd4\xab{\xc7[\"\xaa\xa5J\xb5\xb7,\xb1h\x85\x98\",\\\x82`\xfa\x00\xa3\xa1fJw0\xf4q\xfaC\xe9\x19\xab$\xab\xfc\x9d\xf9 \x95`\x02\xa6D{/\xf1\xc1\xb1\x03J\x9f\x922c\x01\xfaDs\xa6\xa179\x7f\xf6Y\x96\xe9\xcaM\xc9\xbd\x8e!\x0f_\xf5HC\xedmy\x1b\x88\xfd\xe5f,ja\xa2;\xf0]\xf8\xffe\x86\xb7{\"\x8b\xe2s\xd9\xeav\xdeM\xa2\xd2\x0a\xddy\xc6\xde8!\xce\xfd$D\x91\xc6\xb3h\x89\xea\x12\x00g}|D!\xaa\x8dK^\x12\xdb\x9f\x00\x04\xd9m\xabp\xf7\x86n\xab\xd0IVh\xde\x8e\xab\xfa\\{\x14S\x1f\x91\xa7\xd2.8\x81\xcd\x146x\x8c\xb8\x8a\x96\xe9U\xca\x0e\xcd\xb4)\xd7\xe1\x02 =\xa58UJ\xda!\xc9\xae$@\xd8\x90\xd9\x1a\x0a\x99\xf7Dd\x0d\x02\x18\xecbp\x90k\xa0\xc0^w_~M\xc8\x8e\xe1\xf0\xb6\"\x00\x12\xec\x1cO\x08\x82\x84du\xdf\xa5\x80\xd3LL\x86\xa2eP\xa6]\xda f.m)\xd5\x05\x9c\xfcE\x99\xcf1\x96\x9dm\x9dq\xda/\xa3\xdb(N\xaf\xfa\x01\xbc\xe8q\xf78I\x0a2\xc5\x94\x08\xd6\xfa\x04z\xf6\xc7\\6\xc2\xa1\x89\xe6v\xe9\xba\xd1*\xfbB\xe2ix@\x9d\x91\xba`\xf7\x80\xcb+\x12L\xbc\xf3\xf2Y\xf5V\x81dn\xd3\x02\xdcDLDH\x15Ot\xa3O\x16\x90X\xa2nc\xec,\x12p\x01\xb5\xd3\x91\xde\x83\xc5\xdb\xf4\xb7\xa2,\xa4\xba\xdf\x0dU\xf2G\xbcc\xe5\xce\xb1\x12\xac\xfc[\xa72#,M\xa7\x15>\xf0\x92Q\x82{\x08\xcfY\xc5\xfb\x81\xd9TwC\x8d\x9b\"\x0d\x11\xd2\x18\xf4\x84\xa1'\x94\xadJ[1\xcb\x1co\x90\x17t\xa4\xa7+\x8bB\xb4\xf6>\xe4\x87G\xd7\x05b^w9\x88\xc9r\xc8\xf9\x8f\x8c\x08Z3\x97f\xab\xca\x9a\xad0\xc4HA\x0f\x0dCq\xcdzr\x9d\xde\xf7\xbcf\x0a\xf2\xc2N\xdck\x04\xd2\xfc\xf5\x0c\xf4\xe8]\xdb\x7f\xf1$k\xfc\xd8>]\xb3\xbb\x85NG\xd1Cw\x8d5s\x1f\xf9\xd0c\x00\x99\xdf\x99\xce\x1br\xfa\x14\xa7\xc3\x82\x88iDG\x93\xa1o\xf7/\x92{\xd5\xfe\x16l_\xa1=d\xe1\xf2\x19\x16h\xd8_\x95\x16\xd7J\x18 \x15\xcbw+;\xb1\xb7]c\x9b~\xd1\xd2^v\xa5\x97P\xc8\x85b\xda\x83\x83~\xd6\xefQjk2\xbf\xd41\x90J\xd4@\xf3E\xcf\xf50\xa8\xb3\x9d\x09\xdcAn\xa2\x8a\x09Sr~y#\xeb\xf6\x08\xf6\xab\xe7(\x1f\xdc|3\x10\xff\xb4A\xcfe\xa2\xf1\x8dz$7XmV\xdcU\x03\xe9\xd2\x80\xc87/\xb3Ki{,\xcbB\x9b\x1c^\x8f\xd7a\xc1\xf4B\xf59\xdaW\xd2\xb2\xe7-DX\xcf\xa6\xb9\xce\xf2\xae\x06Br\x81\xb8SI\x98\xb8le\xe7r\x1e\x8ayo\xf50(\x04\xd3\x8f\x9d)\x1e\x16K\x15\x98\x86^'\x0a\xe5)\xd8\x8d\x07\xca\x81\x82\xd1%\x0b`\xb1\x89\x8cYW$\xc1\xcc\xc2\x17\xad\x00\"Q\xd5A1\x8e>\xb7\xe4\x1d\x81\xb7\x14]\x90\x9d\xf7\xb6\x0e5\x8a\x01aJ'\xe5\x01N\xb9i\x1e\xd9\x97\xee\x8a\xd7L\xdd\xf68\x97\xe4Cr\xd5\x9dI\xce\x08C\xbddlg\xd2\x8a\x18\xe1 \x15\xc1\"\xb4\xa5\xdb\xe0\xc8\xa0\xc0\x01A\xa4(\xf77\x15\x86\x01\xbbw\xc0\xa0\x91P\xb9(}1k\xd77\xdd\xa2\xf9N\xebQ,\xa0\\P\x11\xb2\xf5\xa1\xf7\xfbs\x83\x04\xf7f\xa9\xa9\x11\xcdkd}\x8e\x19\xd5\x94\xa6\x92q\xec\x15\xe5\x00\x11`\x0f\xc6f\x8c\x11wB\xb1)\x94B\xc7H\x9c\xafY\xb6\xf5\xeaqr\xf9\xae\xea\xc2\xc4J~\x8fsZp\xcd\xb4\xfe1\x1e\x9b\x89\xfc\x06\xad\x1b\xee\xad\xccUp\xcc\x93\x00x^g\xe8\xf1;Y\x02\xf1\xcb~\xd6\xf9 \x15\x16\x92X\x82\xb9b\"P|\x16/\xf1\x9d#\x87\xad\x02+\x8ec>J\x0a\xf4Hyn\xcf\x15$\xee\xb8;\xea,9q\xa4\x9fS\xeei\xf4\xb2M\xbfD\xdb/<c\xb0I\xbfE\xcbm;\x7f\xf6\xe75D\x87\xf9\xe8;L\x02\x03\xe3\xce\x1d\xf7\xa7\xc2@\xdc\xd7\xbbn\x03\x92\x95\x88-\xd2j\xec(1v\x81Xp\xcd\xa8B#\xe5\x12aru#z\x89\xb2{V\x00gf\xb9\x96|33*G\xa0.L\xd0\x00\x91Z\x90lE~=\x14\xd8+\xa4\x159\xcd-L&\x8b\xcd^+V V\xa4f\xd66\xcfZ\xa2\xd6(\x00\x02\x1b\x92\xc9\xb5J>\x00\xc8|*\xa2-\x0f\xa4k&\xd3\xa1r\xf2\xf2\xa2`\xf5\xe5\xb3(\x98\x90\x05\xadT\x19\xc2n\x19\xc6\xa5\xa9\x9b\xce!\x1a\xba\xa0}Ig\x9c\x18\xfb\xd8(\xf4\x8a\xce\x96\x967\x87\x0d\x19\x81$\xc8\xc2+\x00Q\\#\x96\xcb\xe0\x1c\x9be$\xeeR\x15\xfdT]\xabd\x92\\\x84\x96\x10\xdaH\xa2\xc8\xce4\x86\x0c1&Z*\xd9\x06^\xd4E_\xa9-\x02\xac.\xef`\xde\xa6n\xff\xd6%\xab,\xd6+X\x02KS\xd3\x82Q\x9e\xbb\x02v\x89\xc4#k\xf6\x10\xba\x93\x9dF{/\xf4\x15\xf9\x89\x00\xb5e3\xe7\xa2\x8c\x96\x0eL\x82\xe8\xdc\x0c\x8ds\xb0\x95\xd63\x07\x09E\x94^\xda\x96\xef#O$^\xca\x90'\x98Cn\x18\xc8?\x92f\xe5\x1aA3S\x04\xf8\x88\x08\xda\x0b\x1bc!\xf1c\"\xa0\xcd\xad\xb8L\x96G\xac@\xfd\x98/\x1c\xae\x8b\xfaCt\x94\xea\x07\xd5\x19\x0a\xd4k/=\xb5-\x0ao\x13\xed\xe9\xfcx\xa4\xc5\xb0~(\xb4\x8c\xe2\x9a\x13\xaet\x7f\xf9\x9b\x8eF\xfc\xefH\xb1\x08\x11G\x11\xee,?\x8c\xe9\x84\xe8\xefJ\"\x92#$\\\xb0\xe3|'\x93h\xc9S\x9e\xe2Y?\x88\x86\x14\xf1\x85\xa7D\x8dq\xa7\x9b\xb2M\xf6\x98\xf57<\xc19Y=x\x94\xc2\x9b\xe5O\x93(CZ\x92\x1b\xd6\xc0\x0ev\x13\xec\xdc\x94\x97}$\x99\xad\x06z\xbb\xf8\xb4\xa5\x15\xef#\xa1s\x09\x97+AE\xb3\xc0t\x11\xbd\x8ei\x08m\x0bT\x0c\x96\xe15\xa5e\xbaA\x16B`\xceJ\xdeP\xbc9K\xce\xbd#%\xf0\xee\x09\x1d\xe9\xe3\x19\x0bQ*\xa2\x0b\x8b\x85yu\xa5`0\x85\x96\x09N\x922\xb7g\x12v\xe7p\xb2.\xb0\xe94n<f%\xcd\xc1\x95\x92\xf7\x95ze\xc5c\x10L\x07\xfbm\x94\x8e\xd7\x01CP\x04A\xd3R\xf7oM\x11\xca[\xa4\x01\xe3X\xe02~h\xa1\xb0\xf6\x1d\xb4\xcanQ\xa8$]B\x1ex\x82\x1b\xba\xfd\xc5\xb8Z\xd5M\xda\xad\xf4@\xb0\x95_H\xd3\xcdS6]\x8e[u@-\x82\x9c2D\xd6}\xa8\xff\x0e4Xk\xdc\x02\xa0\xd3\x9a\xcd\x1e\x98H\x1f\xc8\xe8\xb8\xbc\x83\xdd~\xe3\xaa\x0e'\xc8\x8dG\xf2\xd9\x1c>V\x12\xa5\xdfv\x94>\xe5U\xfd6~\x1d$ON1\x00\x19q\xbb\xd2\x98i\xfa\xb4\x11\x03\x97f\x0ed\x0fZV\xa5\xcb\x81\x01)\x80+Y_\xcb\x8f\xc8\xf0\x91#\xc2\xe7[\xbd\x94\x7f\xa2'=\x06\xde\xc6\x98(=\xebe\xb4\xe5\xd9\xa4\xe8+Ce=6\x829\x8e\xcd\x1b\x92EP\xc5\xe9\xd3&8]#A(\xfc\x8d\xa8\xd7\xfb\xd9\xae~\xc9\x9fgF\xa9\x1enI\x8dR\xf4i\xa7\xda!\xbf\xa2\x82\xde*\xce\xd2\x0d\xc6\x96\xeb\xd7Z\x0ajw\xb1\xf9&\xa2\x8f<\xa1\xad\xd2\xcd>\xee\xef\xa7\x86\xf9\xac}[\x0a,D\x9fL\xd9\xe1\xb689\x91\x06\x8d[\xb8\xa4\x04\x85B\x18D\x13\xc4\xe9\x88^\x9dl^\xea\x9d\xe8\x80\x9aIS\xe4~[\x9a\x96n\xe4\"\xab$^R\xaa\xfe\x88\xe0\xbf\xb4\x8a\x91Y\x14\x0e:\xcb\x10\xa2\xcciW\xa1\xbb\xec7\x1fa[\x0f\x83y\xa5\x10\xacIx\xbcK\xc5\x03\xf2s\xb3\x93\x86Zz?\xad\xb4ra\xaf<[\xe7y\xd0#@3.D\xa7\x85s\x84@\xde\x8198r\x12\x12.w\x1bn\x871g\xd5r\x01F\x8a\x80\xf9\x09\xc0.\x87{y\xb0\x0b\xad\xb5\x05eH\xce\xa3l\xf1{(\xebzy?\x83u\x19\x00=\x1d*\xce\xfd\x00\x81\x0bD81\xd5\xf5R\x18}+\x08\xba\x19\xcf\xc1\xa1\xe4M\xa2\x8e\x07)\xab\xe8x\xa3BE\x1f\xd5\x0d:\xc1q1eI\x9cF\x08\xf0&\xe3\x82X\x01q\x90T-\x98]\xa3!T\xc5\xea\xb3v\xa0\xe7\xa8\x1e\x84;\x8846>@\xf8\xe9\x08\x8b\xabz\x9b\\Eq[\xe3Y\\\xf4q\x940\xbdz6 i\x80GPQ\x1c\x1e<\x1e\xe5\xfa,`\x9aT\xd1,\xceo\x00\x0d\x88\x89i\xd5\xf7\xcf\xbf\xde\xe13\xbd\x1e\x90\xa1^\xe8J\xc4\xb4K\xed\x8b*\x97B\x0d~i3Dh\xde\x1f\x9a\x0aB\xb7\x97*\xe3\xc6\xa6\xfe\x1e\xa8\xc5nU\xa1\x9f\xf5\xc6\x13)\xb9\x8f\xd8\xea\x15\xc9\xc7\x1ed\xa3\xccf#9|\xe1\xa2\xadf\x92\xee\xac\x13V#\x89g5\xdbdZ03M\x12\x8f\x90\xb8\x88 \x1b\x9e6\xd3\xb7\xeb:\xfd\xc7\xec\xe8\xf6\xe7\x9e\x1c\xbeP)r\xcd\xa2\xee\xef\x0d\x1f]U\xc2\x99\x9f\x88\x07\x1b\xfd\xba\x18\xb5;\xdb\x09\xec\xac\x9b\xf0.9y\"\x926\x0f\x92\xa9\xc4\xcd\xe2\x8d\x04\xb5\x13\x9c\x09@\xe9$Z_e\x1f\xefy\xae\x8f\xf7\xbce\x04\xb7\x9eauutBK\x84\x9c\x96\xef;\xd1\xb5d\xe0\xfa\xc7\x156\x95-\x82\x17\xa6\xab\xe4\xb1U\x95\xe1\x0b\x05\xea\xaa\xaf\xf4LH7\xf1F:\xa1zr\x80\x98?\xee\xa4\x97\xa9\xc2?e\x0cyP,/\x14\xfey\xdaTh\x0eZ\xfbZ\xd4r\xa4^U\xe3\x93^\xf2\x1e\x0b\x86\xea%\x136\x8c!\xcfQ\x98\x0e}\xada\x9c4\\\xbe\x83\xb8\xf9\x06\xeb (V(\x9f\x9a\x01:\x81{\x02\xa5\xcdDb\\=\xa58\xf6\xefa\xacB\xf8@M\xe0.\x89\x9b\x10\x00\xe3\xb7\x9a\x86\xd5\xf4&\xad\x88\x10\xc3\xa7e.C\x0e\x1er\x7fR\xc8\x84\xa3\\)\xd6\xab\x86\x16\x1e\x8b\xfa\xa7P\xdb\xe9^\x86;\xa6\x00:\xf7:\xd5D\x01\x14r!\xacS\x09\xed^\x8e\xd0\xa7U\x0d\xe0\xc2~\xbb\xd6\x0c(M\xc0\x87#\xf1;\x1cP\xd6*\xd1\xbe\x0fh\xa1\x01\x81b\xaf\xc4\x0a7\x06A\xdfg\xf7\x90\xed\xfap\xcc\xc2T\xc3\x89%5\xcd\xa6\xe5\xf7\xa5\xd6\xee\xca'\xdc:\xb5\xb7\xb2y\xde\x0d3$\x12\x16x\xd62({yZ\xf4\x05\xf4\x07\xbfG\xc0<\xb4\xcc6'\x98\xad^BUL\x10\x95\x9bo\xf5hu\xae\xaf\xd45\x88\xce\x96\xafc\xd2\xc9\xecq\x0b\x9b\xd2\xc79\x18\x85\xfb\xae\xafy\xa4\xf38F" +
+	"/\xb0\x15\xa5\x90z\x05-\xa3\xbbY\xc3\xf3\x95\x1a\xf2\xd6\x81X\xbf\x0b\xd6P\xb4 w\xea\xcd\x9e\xd8\xfeuD\x91Q\xa6\xbb\xfbE\x0e\x84\xbaGE\xfa&\xad~\x8dml\xf6\x85\xc0\xc8|\xd01_\xb5x\xc7P\xff$\xe9\xe4\x1fct\x80\x85\x9b\xc7\xc5\xbb\xc3\x17\x87\xc62T&\x18xf\x8d\x83\xbcB\xc4R\x97w5\x0c4f\xd8[d\x0aYd\xdc\xae\xdb\xd7\xb2\xac'\x0f\x83\x80\x82\x922'\xb0\xea\xd2\xa4\x84@\xe1nd\xff\xeb\xe5\xe3\xec\xff\xd2\x97+\xc9\xd4\xabI\x1fADl\x1c*\x96-\xdd2\"\xdf\xf0ZqMI\xf0\x7fZ\x9a-\xdbeUh\xbf\x1f\x07\x81\xde\x98\xaa\x7f\x02\xb2a(\xe4\xd1\xc7\x1c\x06\xbc\x07\xc2\"\x85\xb1\xb7\xdd;\xf5\x9b\x98\x0d\"\x00$\xe6\xfc\x85\x0c\xb6\xf8=\x91&\x8c\xd5\x85U\xb6\xff\x96\xca\xeb+\x03j\xe3\xf2\x18p\xe1\xf40\x93,j\x0e/T\x91X\x94S4,\x07\x82\xef\x00s\x80\xe5\x80\xb0\xb6S\xd1\xa7\xf0*\xbcgEG\xb2\x1e\x1b\xed\xa5w\xf2\xfe\xb6}U\xee\x14\xec\xf9\x8d\xb1>\x07\x04\x1f\x81\xb2}\xd1}\xab\xde\xc3@$\x8e\xc1\x9cB\x15\xb5\xec\x07Lw\x14\xc8\x9e_\x02qNL\xa3RC\xa8M\x021yv\xa1\x9a\xb2^QB\x88\xd2y\x05\x8a\xc7z\x9cjB\x81\x9eQ\xf9,\x97O\x9d\xdd/7\xecq2\xde\xb6\xec\x1a\x10\x0e8\x9f\x8dS}\x94V\x0es\xd4\x94\x87\xceW\xbc\xd2\x88Yf\x97\xed\x1e\x04\xd9\xbf\xb2\x81\xc9\x85WO){\x08\xcd\xf1g\x89\x1b\xc2K\xdca\xa4'\"\x1c}&\x8b\x03Q\x1c\xdf\x9e\x93\xfd\x04\xfa\xcf\xe10\x01\xddH\xa9\xbf\x07\xcc\xe0)\x96\x07%$d\xca\xb4\xc7pj\xacT\xab\xab\xaf\x99\xe43)\xf4\xee\x8e\x18\x0e\xf7\x1e[\x8c\xa6\xaai\x19\xe4r\x88J\\\xe3#\xe6D\x18J\xef\xa0K\xeb\x18\xa1\x89\xaea(\xdf\x9fx\xe8E\xb9_R\xf2\x03gf)?qC\xe0\x8c4Q\xf0\x0c\xbe\xf3Pw\x84\xbfJ(\xceU\xadW\xaeU\xe8a\xae\xa4\x88t\x1f\x15\xff\x84\x85g\x05\x1c\xbf\xe7\xe6=\xdeA\xfd\xfa\x0c\x07\x03B\xa0x\xe6\xdc\x80\xdf\xfa\x0a\xb8xH+\xb2\xfe\x91H\xfb\x14\xca)\xe9\x11\xc6\xe8\x0f\x12\xf6\xcd1\x98\xbd\x13\x93\x02\xe7\xeb\x15]\x8d(\x11\xc1\xde\xcc\xb3\xf7\x9c\x85\xc0\xb7w>\x8f5\xd1\x1f\x9f\xc1\x94L\x01\x0e\xbe\x1cUm\xaf\xe0x~\xe91gh\xac\xb2\x00\xb3\x8f\x93\xf6\xed\xb0\xfc\x16\xb0\x04i\x81C\xba\\\x14\xd4\xc1V\x86G\x12\xbdh\xc8\xf5\x0fR\x0e\x95\xc8<\xac\xf7k\xfd\x1e\x8e\x9a\xd2\xb0\x85\x8fh\xa7\x9e\x18\xd4\x9b\x80-\x800]\"D\x97\x84\xf0s\xd6\x13\x90\x0f\x15F\x9e\xe1\xa9\"\x97Vz\xecWYY\xb0Mh/\x86\xac\xef\xee\xf3^\x08\xe3\x01\xac\x17\x0e\x80\xc3\xb3]\xcd\xcc\xf9\x9e\xe2>\xea\x88r\x92\x88\x85D\xf0\xf8\x1c\x16d\xccc\xea(9\xf6\xbb\x0f\x0d\xfa\xd7\xfc\x9e\x0a\xb1\xc0|\xf3\x80\xc9\x1fl?\xb1\xa9\xe4R\xf9x\x08\x10\x91(\xa2\xc2\x12\xcb\xff\x18\xfb(K\x94vR\xf5 \xe9\x15\xfe\xe8\xd3\xd8\xf6\xa0\x0dz\xa3\xfc\xa8\xd8\x89K\x0b1\xdf\";\xdc2\x804,(0\xbb6=\x0f\xeb\xfaD\x87\x0e2aq.\xe5B\x90\xa5\xb3wJcn>\x10B\"\xdf\x0b\xc7\x90\xb2\xd87bX\xa4\xc0\x1f?\x1c\xa4\xd7+M\xdd\xe8\xc8^\x0a\x9b\xc2!\xa3,\x9a\x85!\xbf\x95\xdbT\x9f\xa1\x1d8#\xf6l\xd2\xda$\x8a}U\xcdl\xcd\x16\xdb2\xfe\x99\x8e\x95\x8f(@\x8f\\\xa4^\xf7r<\xd2\x97\x90\x9c?\x85Q-;\xb4I'\x08X\x08\xbdU\xb7\x0c\xc7\x08\xb6#n<r\xb1\x9fE\x90m\xb8\xb5\x95R\x86\x9ePb\xd0\xe1XX\x92\xfa\x15<\xbd\xd3\xd6\x14\xd6\xcc=\xdb\x9d.\xda\x12\x98\xe6B\xf2*\xba\x19)\xf0@\xe7J\xfe;\xa0Pd\xfc\xe3\xe6@Z\x86 !F\xa03\x00xn\x0a\xd1\xbfU\xba%\xf2\x05\xf5\x8b\x9d\xbbU\xd9K8\xb0\xc7\x1c\x87\xf5a]\xceWg\xea{Yz\xebx\xc1\x9dd\x8e\xa1\xa2*\x98\xef\xab\x96\x85Y_J\xc7\x1f\xe3\xf2\xaa\x99\xc9\x87\xe2\xf6\xc5\xf5\xafc\xf2\\\xd7\x90\x05\xb3s\x1c5+N\x13b\xcb1\xd4\xcc\xcf]\x8aC\x17t=\x81V\x0f\\R\xed\xa3\xb1\xebt\x87?\xc6\x8a\x89h2\x9e\x19I\xaf\xd9\x88@y\xc2C\x11<|q\xacB)f\xbdz:F\xeb\xb50\xffO\x1a+\xc8&\\\xf4\x82\xd9\x0f\xb7\xf6\x9c\xa8\x11R\xc2\xa2%\xc9l\x0a\x93\xd9B\x00\x9bPcS\xeeI\x05\xa0\xa7\xf0\xd8\xf5\xf7d\xddUA\xb7\xb4\xbcZ63\x1f~\x03}k.EY\xbb\xac[\xca>\xb8!s\xe9\x90e\xc7I\xd1\xfe\x08\x14\x03\x1f\x9a\"\xb4Y\x85A\x18\xf5@\xa7\xa4@s\xc1h\x82>k\xc5\xfd\xdeX\x86\x99a!\x19:\x8c\xe1\xc7\xafq)\xf4 \x90\x1c*%\xef\x84\xdb,u\xa7XkC\xc9!\x9f\xe9g\x06\xcfj\x91\xeb\xbe\x90_\xd6\xcc\xc2\xfa\x15\xf9\xf4\xa7?\x87n\x85\xbe\xb0\xcf\xe1\xd6\xcfXa\xd12\xbd\xa5\xe4\xd3q\xe8V\x927/7\xe0fyd\xe7\xc3p\xfb\xc5\xf3\x06\x9dJ:BR\xb2C\xbfx\xb8~\xfd\x9e\xeab\x8a\x0d\xee=\x02\x1f\x81\xd1\xe9\xa5%\xe3\xd8\xf0\xa4\xdc\xa8(\xbf=4p\x1a\xe7\xa6\x9c\x90\x11\xdbv\xa9\xe4Fe\xe5x\xcc\x05\xca\xfeP\xb0\xed-[\xa9}\xac\xaer~\\\xea\x15\xd8\xb5\x1e\x1bf[\xc3\x8cLB\xd3\xd0\x92N\xd4\x8a\xef\xa5@\x01\xbdf0\xb6?\x00\xca\x106\x91\\\x84\xeeV,\xfd\xb6\xd6\xb2u>6\xca>\x0a\x19e\x97\x91%\x9b3\xe3g\x86\x17\x8f\x11;\xcd\xd7\xfb\xecG\xb8dB\xe49\x11Uy\x13\xbf\xaa^S\xdcO\xf4m\x95\xa0N\xaaBN5\xdb\xe5\xb0\xb9!~\x91\xee\x91\xda\xd5;(\xb5\x1b\x1c\xe0\x87\x1d\xae\xd2\xce\xd1'^?\xc3=l~:\x13\xfaf\xf9\x00>%\x8eMW\x80\xfd\x8e\xdaL\xe76]\\)\xf1\xf44\xb7\x84\xc6XZu\xaa\x01\xf2\x0f\x8bNVd\xa9\x9bUR\xf6\xd3\xa47\xbe\x11\x07\x92\x19\xad'0\x93\x0cY]\xbag\xf7\xe4\x00BB\xbd\xb3\xcf2;[\x8e\x99\xd8\xd9\x80\xd7_\x86?\x12\xbb\xc0\x84}\xef\x1b >\xe1\x1dO\xe9\x9c9\xf1\xe6\x95\x83\x1e\x8d\x91\xc8\xf2\xfd\x80\x18\xa2K\xbai\xa91\xee1G\xd4\xbd\xb2\xb5z\xda-VGHA8\xed\xf6\x91\xb9\x95\x12h\x98!\xf2\xbb\x03\xc5LZ\xf89\xffa\x14\xe3m$:\"L\x187\x13\x1f+\xadx\x90<]`\xe9\x95v1`\x07/\xfay\x80Jj\x18\x03\x16H\x89\xc6\xdb\x9d1\xc0\xc5\x1a\x16\xd9\xf4\x81\x9b&\xb2\x0bT\xf5\x8f\xb8n\xf0\xc7\x9b\x9a\xe2\xc3\x1cn\xeeDP\x18\x9a\xcf\xc2\xbazk\xa9\x19e\x8c\xae\xf0\x04\xb8\x8f\xa8\xce\xf7\x1c\xd2S\xc3\x1d\x9b\xd0K\xe4\xf2T\x9a4\x1e\xcfg\x06:=a-\xa2\xb4\x8bZ\xffNr%2l[#\xa6\xe8\x9d\x85\"l\x19\x89\xbco\xe1\x8c\xad\xe0\xe7\x89\x1c\x96{\xa6`\xdc#lQ\xe1\xd2\x17u2g:\xcc\xe06\xe3d;\x19\x8a\x9fT2c\xb83L\xc2\x86\x19$\xaen\xc2X\xbf{\xca\xbb6\x13\xf0\xc7\x8ed/\xc9\x06N#\xeb\xe2b<\x86\x99\xe1\x0aX\x15R\xfe\xa1\xdbP|.m'\xa9\xf4\xc5\x0ayV]\xe8\xccj\xfcc\xd5\xe7\xa5\xaf\xa1V#\xd8`\xf4l*&\x0d\xb9r\xb0\xcc\x0dI\x99\xeeqh\xc9\xe7\xa5\x95\x19\xae@\xf0vO\xc4\xf5:Z9\x0b\xb5\x13\xb1U\x90\xee\x8a\xc2\xd4\xc4I4\xd1\x05\xf3\x94\x1fW\xda\xfb\x8e\x97\xdd\xf1j\xfa\xd1i\xc3\x09\x0bMPV\xe4YR\x18\xf5>\xcf0M\x12\x1d\x95p\x891\x82\x9dF\x8c\xe7\x9a\xd0a_\x88\xa0\xfe\x08t\x88\xa1W\x0as\x14\x85^\x9c\"\xf7{Iu3\x98\xfd\x842\x1a\x8d\xa8\xccw\xe8k<\xc4S\x85\x0c\x97\xc8\"'\xb0\xb5\xb4\x98\xe0T\xee\xef`\x95^\x87\x13\xc5\xa1\xefP\xdcE\xc7=\x97\xac\x8b\xcc\xd0dq\xa2\xbd\xf8\\\xad=\xacZ\x15\x0e\x9e\xacM\x0dR\xd6\xf2D\x00\xfe\xa7\xf0r\x17a\x88lw!\xe0]\xe3byE\x98\xa4\x05\xee\xd4\xa4\xf6?\x15dv\xdc\x12\xcd\xfd\x0c\xfd\xaf\x9d\xf2\xb2\xf4\xb86U\xc5\xf4lm<={\xb63\x8b\x8e\xccJ48\x0c\x0c1\xcf\xf9\x80P\x8d\x05\xf3\x15?\xe0:Y\xc1adX|\xa4\x9e:\x9c\xfe\\\x1aY\xbf\x9b\xcc\xb9H\x8d<\x06L$\x04\x9c\xed\xfd\x85\xbc\xe4B\xf6\xe5\xa1)\xb9i@|FA\x93\xc5\xb9\xf5\x0a%7\xb6\xe2\xfb\x1d[\xe2\x88\x818\xf4\xbe\x0eU|RsN\x84\x07\xafDL\x03\xc4\x9b\xf0\x89\x8c\x03\x95\x84\x8ab\x03 \x85\x1f\xf6\xe3E>T\xfe\xec\x15\xdc\xba\x0a\x06\x96h\xd4nIg\\|\xf7\x8aX\xdfH\xd90\x11\x05:J\x05\xea6\xf3\x18\x0e\x94Z\xce\xb7\xd1\x1c\xe40/\xbd6|D\xa6\x96\xa0\xfe\\\xe8\x1fUy*\xd8\x9f\"\x17!\xf4\x8e\x7f\x82f\xd3^\xe03\xb7{\xb4\xff17\"\xc0z&\x9f\xdf\x8f\x86j\xc8\xb2\xb7P\xf3\xaa\x1c\xb2E\x81k\xd3\x07-\xc93%\xf2\x94\x9bQ\x99\x0c\x85(\xec\x1d\x8c1Q\xf2\xe2\xde\xd1-|\xbdFYx$Q\xb5\xa8\xb0\xf2\xa1T}:\xb6L\xffi\xde_&e\x88\xa2#\x84L\\x\x02\xcfgN@*yP\x86\xfc\xf7i\xba\x938q\\\x12\xfdh\xfd=\x96V\"B.\xfc\xb0\xf9\xe6Q\xe1\xc9\xeeV\xd6\xef\x95\xac\x16\xb9:6T\xde\x839\xa2\x1a\x1e\x15\x8baS\x1b|Q\xe2d\xc4\xd1Q\xec3&\xdfw\x1f\xc7R\xf3S\x12\xa5M\xfd\x16\x1eb\xe9RH\xcb:\x13<o8\xf6\xd8\xb3\xe6\xc3\xd8mt\xbb$\xe5RQ\xab\xcd\x93\xe0\x88\x08\x05\xbe\xdaL\x93\xae\x9f\x9c\x1d\xec\xfdkc\x10U\x07\x03\xa1\x0fFM\xa1\xad\x87a\x8b\xae${\x1e\xfe\xb7-\xb3\x8a\x15\xf1\xc0\xbd\xaa\xf1j\xc7y\xd5I`\xfd\x17|\xff\xe7\xcei\xb4\x99\xe8\xccPh\x01\x8b\x85V\xd6\xef Qt\x9bp_b\xa2\xc70`\x1bSJ\xf4\x85~M\xa4\xb4\xfd\xa6\xc7f\xa5q\x03\x922\x84\xd8B\xfc\x8a\x9c,\xfc($\xe3'\xc0:D\xa0\xb1It\x00\xc7(\xe3\x08\xf0\xf8lF*\x93\xf0\xbd\xaa\x97\xe2Vb$|\xc7\xafm\x12_\xa3\x13\x18\xcb\x83F\xef}\x03\x12\x07\x16>\x96\x0bU\xaa4{j\xe5\x99\x9d)\x0a\x1a\xe1st\x9e\x0a\x86\xa7N\xc1\xb4\xc7\x03\x0f\x89\xd0\x03:\xf4>\xce\xfb\x07#\x03\xf32m:\x0e#\xf2!\x95\x8b\xa1\x0dq\x09\xde\xf5\x04kE\x965p\x04\x11\xaf\xc1\xf6\xc6\xd6\xfe\x96\xf6\x04\x8f\xc5\x92\xbb\x0bC]C\x8a\x8bP\xa6\xa4\"\x80\x14\xb8I\x19\x1eu\x96\x94d^\xddlu\xdee\xee\xca\x0a\x9d\x88\x9f\x16\x8c\xf6\xa4\xbcZe\xa5N\"\xdc\xd6\xd6\xc0\xe4\xf0)\xea6\xcb`\xb8\x00\xd4\xdf)s:\x1f[jr\xf5\xe9\xa9l\x1c*\xa4\xef\xde\x1b\xa1\xc0\x0f\xc2\xf9\x09\xf4\xb8\xa3\x97\x18&|J\xdf\xe1\xd1>M3J\xf8\xca\x8b\xa6\x8c\x8dk\xe1\x8a\x0aL\xbd\x80#\xe8m\x16\xf6\x11\x1e#\x07\x93(\x85\xf3\x8fM;I\xf9\xf5=\xc8\xddTkKjE\x0c\xdc\x93\xc2\xf3\x07E@#\xc1\xd3U\x02\xdeq\xd2\xa0\xfa\xf79^\x86~\xed\"\x8fE\xd0j9\xf4|\xcf\x88\xaf\x05i\xb2\x93\xa9\x94\x04`\xe8\xf8\x04\x04\x1e\x0d\xac+M\x09\x11\xce5k\x11E\x83\xa9\xbc0M\x0617\x11\x96':\x97\x02c\xf8\x80e!\x11\x0f\x93\x82\x83\xa8{\xb8\xe7\xbc3\xd2\xaa\x01\xa67\xa7\xe6M?l\xe9\xee\xde\xb3\xe2J\xfa\x11#\xf8l\x94\x89\xeaM\x95\xf0{\xf0>\x91\x18\xf4\xf7\xb0[\x17\x9d\x95:Nt\xea\xf4H\xfd1h\x8a\\0\x07\x9a\xff5\xd2\xa2\x92\xec\xe8\xf8?\x9aHsb\x0d\x16\xbf\x16\xe5&\x1f\x04\xad\xa0v\xe5D;\xe3\x0d4\xe7\xad\xf0\xd4t\xb7\xab*\xe6\xbaq\xfa\xbc\x99\xc5\x1f\x02\x84\x09\x80\xc8\x19V\xe0N\xd7cP/\x80\xc2&\xf8*-+l?)\x06~\x8fYk2\xe6\x89qn\xcd\x86\xf14bY\x92m\xb7\x03m9\xeb\xea\x9d\xd5t\x08g2\x88\xa6\xdf\xaa\xba\x0a\x13\x05w;b\x07\xb9\xd7\x8f\xa9\\~z/\x0b?\xd7\xda\xa2\xed4\x007\xd1\xd9\xeaS\x92`\xfd\xf6f\x04#=\xe1\xb2\xc3\x8d\x10Zh\x19\xba\x90\xf0\x8e\xa5\x97\xb1\xd3\xcd\xa2\xcf^@u\xfc\x88>\xf8G\xb4O\xf5\x8fx\xe99dP\xe6\xd4j\x1f\xf6\xdeH\xf5\x940H\xf4\x86\xb2!\x82|2\xb0+y\xe4\xcas\x16k\x93\xa8\x12\x91PT\xdf\xbb\xc7\xca*\xf0\xebwF\xba#\xdd\x17\xf3\x00\x87\x07*\x83Q\x9aH\xa2\xe1\xe7m\xf4\x9b\x8f\xd2\xb1\xe2\xeb\xf7\xc2K\xec\xb9\xe5nNj\xe6\xd2\x10B/\xf8#\xa1<\x16JYWw\xb3\x90\xd0\xdd\x1b\xc8'\xc9\x98\x0d\x89\x1d\xdf8\xe7\x8d~\xa2\xb2-L\xe1\xfd\xfc\xf3\xf6&\xec\x0d\xfb\x15\x86D\xcd\x81\x81\xf8\xebu\x08\x1e\xbb\x0b^\x1aK2\xc3K \xa3\x94\xfb\xf5#z(=\x95\xb4d8\x18\x0e,b$\xfff h|U%\x8b\x8d\x1f\xfb2\xc45S(=,\x0c\x13~\xec\x0fs\xda\xdb\xb2\x12\x1c\x92lS\xaa\xf5\x16\xb1 \xf3^x\x04\x85S2\xa3\x05\xb5\xd0\xec\x01w\xa0\xb0\xf2\xa1\x93M!M\x11\x86\xe28Tv.\xa4\x02\xb4L\x10\x8a\xeet<\x18[\xb8W\x84\x0e>\x8e`(3\xac\x88\xd4\x7f\x19\x9b\x84H\xff\xb2\x9bUS\x9d\xc9\xdd\x8dV-\x0f\xb7\xa3\xd0\xe5\x12\xdb\xe2Y\xa5\xb2o\xaeId,aNc\xb3\xf0HI\xb9\xa9\xe2\xb7\x82\xe1\xcf\xddP\x95\xc1\xeb\xd0\xfd\x9a\x01\xaf'\xd4j\x08\xe7\xce\xea\xc9\xcd\x12\xc1*C\xc9\xd2\"\xf0%\x15\x8b\x0ae\x0dMq.\xa9\xe2\x97\xa8-\x17N\x9b\xf7hm\x99G\xa4$|X\x0b\xd5\"\x8b\xd8\x83k\xa1\x11\"i\x03\xb7\xb4\xae%\xac\xa3\x85\x97\x84\xa9\xdfQtJ? \xc9W\x18r\x8e\xc5\x13\\\x0b\x9a\x1b\xd1fs\x84v\xaeU<NO}\xef^Oe\xdb\xaa$\xb1R\xd4\xb7\xb8eZ\x06\xd3m\xb1\xc9d\xf6\x1e\x17\x88\xa2+\xd6\x8a\x08h\xc5om\x9c\xe0\xdd\xdbw\xe0R\xce\x85M\xfc6\xa5\x9a\xea\x91\x8e\xe0H\x89GU|\x97D\x06\xcf\xad\xc2\x96LH\xc4m}|\xff\xf5\xdf\xd7\xcb\x11\x10\xb7p\x01G8\xf5\x14[\x04\x95v\xbfl\xd5d\xe6&s\xdc@\x8a\x89\xa7U\xa5\xf2\xad\"\x9b\x9c\xf4\xd0\xc3\xf3\xa69\xe7\xfa1\xc4\xa7\xae\x88d\x85\xa29\x92\x83\xce\xcf\xa8\x111\x8f\xd3Z\xaf\xf3u\xdc\x10\xb4i\xbcX_\x9a\xd12\xc7H\xaa)\xde\xb2\xbe\x0e\xda@\xf9\x1bE\xfc\x94#x\x90\xf84\x9cb\xe8\xbf\x88\x01\x00<\x96\x08\xd0\x930\\\xf1\xf5_A_h\xc1\xecK\x0fR\x89\xd6\x9a\x9c\xd2\x83\x9f=\x03i\xffp/\x07\x9c\x152\xa0E>I\x96\xbef\xef\x1dE\xa4*\xf5\x12k$\x10\xa1\xc4z\xcd\x9c\xa8\x98\xce2\x92\xdc\xe2 \x8e}+oi\xfd\xb6\xbe\xb3\x10\xf7\x07jh\xe6\xc9Q\x9en\x07\xf4\x01OQ!x-\xcf\xf8\x82\x02\xbfE\x08\x93+\x8fY\xc8\x0c\xa7(3\x17\xa2$\xa0z\xec+E\xf5\x86\xf0\xbc\x10Z\xea\xa69\x82\xbc\xbc&m\xe33\xdfM\xc9\x08\xfe\x98zT\xc7\xa8\x0eU^\xaf\xfd\xdd\x15\xb9\xee\x0f\xdd\xde\xb1\xac12:\xd2\x8dUEH\xf0\x1e\xa1\x19\x12\xe7\xff\xd8\x83k\x7f\xab\xc5\xb0\x88(\xffZ\x0b\x1a\x99z\x88_~\xacZyX=\x14\x97J\xf8Gp\xb7,\xbeh>s\xda\xcc\xc6\x01LS\x15\xacm\x1d\xcfL1n\xfd\xf9Z\xf7\xb7\xfb\x98`ud\x97\x167^&>\x16\x7fV\x08\xd7\xf0\x1a<\xa3\x07i\x9eUy6\xf2\xde\xde3\x0c\x97?z\x0b\x96\xd2@\xc5\xf8L|\xe9g\x8a)C\xba\xa0\x1a\x90\xd7\xc8\xa3\x1aM\xae\xa0@\x8d\x1d\xc3\xe3\xb6@m_i\xa9\x18X\xac0\x06O\xd9Ec\x88*\xe7i\xc7\xc1\x89lP9\x0d\x16X\xae\x0fX\xa4\x84\x9c\xeb\x8a\x8f\xfbC\x07hu\xb5\xc4\x18\xfbN\xfd\x06w\x8aV,m)\x9d\x904Zlx\x1f\x81\x89\xa0\xec\xdeLR\x1f\xab\xec\xd3\x12B\xfa+(i\xaa\x0d$?\x98\xfa\xd4?\x8fh\x11\xd9\xdf\xcb\x09\x09G\xf3\x0c\xf4r\x19]\xf8\x1eLs\xca\xab" +
+	"t\xfa\xa83l\x9e\x0e\x0d\xbe\x81F;\xa8\x16\xed\xf5\xd1\x19\x95T(\xb4?\x7f#\x12\xb9}\xf4\xe3\x9fJ9\xddWw?\xca\xd9\x92\xba\xd1iS\xd6W\xe9\xa0*\x0a\xe1\xc4\xeb~\xec\xe0>\x8f\xf8\xfcp\xff\xa0muu{\x80.!\x81\xdc\x95\xe1\xd0\x99\xe0\xc62\x13\xab\xea\x1e\xe3\x10\xd4\xfb\xd9\x96d\xb3\xb7]\x96\xf6p*\xa9W\x7f\x07y\x83I\xafQ\x8c\xbb\x15t\x81\xa6\xad\xe3(e\xdd/lJ6m?\x8a\x0f\xf8&\xae\x9c\x8e\x0cV\xaaUc\xbaC\x8cS\xa7\xc1v`\xdf#y\x85R,]\xa0\x96\xb6\xa1\x90\xfc\x00&\x13\xda\x023\x8aT\xfe;\x95\x81\xb0c\x1c\xacM\xe1B[u\xb3\xf2\"\x91~\xcf\x94 \xa2}X\xe2c+e[\xec:\xa9\x846NB\x8e\x10\xd0\xcc\x9cc\xcf+\x0b\xd7+{A\xc3\x92\xa5r\xf5-d0#z-NFm\xecN\x1d\xbcx\xda\x06E\\\xc7k[L}r\x9c\xe3@\x80\x13Rj\x0a\xeb\xf8\xdb\xf7\xd0\xdc~\xa6\xbe\xc9dc%\x08O_\xc8p\x8eV\xfe\"\xb6\xfe\xb13F\xbc/\xbd\xaa\x11>\xb7GL\xc6E\xce\xa1\xf2\x11R\xd9\x8f\xabg+\xec]\xcb\x02\x90\xb4\xda\x17\x12\xc6\x01\xec^\xb1P\x91\xd1\x0b\x1eg#w\xe5\xd2\xe6\x8b\x7f#\xcc\xb3E#m\xc5\x9e\xebK(\x1cM\x85\xdf\x04\xcccS\xce\xdd\x10k\x89\x935C\x0f{\xa9\x1a7\xca\xc7\x923\xe3#\x0a*++\xa9`\xc4\x14\x08~G:Q-\x00\"\xd6\x94\xcf\xb1@\xc3\x04\xe1\xfa\xa1\xb6\x8e\x19\x8a\x1d\xeb\x82ek,KY\xb5\xf0\xc6?\xb2\xcb\x14XN\x8d\x9a\xc0\x02|\xad\xb6\xa7\xdf\xee\x9f\x1f\xebHs\x9f\x00\x1d\x9d\xd2\xa2\x9b\xf2\xf9D\xbe\xddT\x99\xcc\"\xe6\xddd\xc1\xe5<=\xb0\xb0\xf4\xd8\xcc\xb8\xf3f\x11\xc94\xc2\xae[\xc01t\x9e\xb2\xcb\x9c\xb8\xd8z\xca\xbf\xc9\xd81\xae&I\xb1\x85\x86_p\x18\xa8f\xc7\x99\xf4\xe5R!\x94\x8akt\xa6\xaal\x0b\x13p8T\xdc\xa3\xbdl\x0a\xf1\x0d\x19\\<G\xa6\xef@\xc7\x94t\xc5\xd8\xfe\x0f\x81%\xc1\x1aTs,3.\xf5\x19!\xe2\x8a0\xd4\xf2\x1dCv\xd5\x8c\x03 *X\x0a\xc6\xf2\xbb\xe4\xe5\x92X;\x1a\xa6\xb6\x15\xbf\xeb\x88\xc6\xea\xbe\xc97\x84\x00>\xb2\x0c\x09\x98n\x95N\x80\x0c9uE\xfb\x94%\xf3A\x11E\x90\x04\xce\xfb\x1eQ\x02\xa1\xf0\x16P6o\xa3\x0c@\x86\xbbE\x0a\x12A\xf7v\xc5\x86?\xe9\xdcV\xf3;\xc2\x0e\x8b\x848M\x139gz\x1aR\x99\xdc%\xe6\xc4\xd9\xd3C\x92\x8a\xf6y\xda\x93\x05`83\x90r\x01\xa5\xd2L/\x1d-\x91\xca3\xa9\xb6lG\xfe\x13j\x02a\xe6\x08\xae=y\xa2*`\x0b\xd7\x87h\xfd\xc8]\xdf\xe9G\x12\xc2\x18\xb2\x01\xf4\xe5\x13XV\x8d\xfa\xa7<\xc1d\x014\x84N\x920_\xca\xbb\xb4M\xe2\xbe\xcf\x8fz\x09\xd9\x917.\xa8\x18\xa2\x90\xfa\xaa9\xca\x0eO\xf7\xf0\xf3\xaaa\x13Sr*\xc3U\xb1MT\xb6L\xa1\x89\xf3?SH|\xbd\xd1m\xfa+vI~\x8cgO\x1c\"S\xef2i\x82\xb9t\\\xd7\xaf\x1e\xf7{\x9c\xd9#\x0a\xdc\xc7\x98\xf0p\xda\xc2\xae0\xbe\xc1\xebvf\xe8\x0f\x14\x7f\xdffu\x9e\x84\xf37\x09\".\x11\xe0\xc4\xca\x17\x0e\xb3\xb4d\x04%\xd4\xdf\xadt\xe5\xd3\x86;G\x89`\xd4Q\xab\x12\x8dh\xeb\x0b\xb2\x91s\xd3\xa4Z\\\xabz\xb6\xd4\xcaA\x07\xa3 \x01\xd3\x9e)\xe2x>\xec\xc1\xe1\x0b'\xff\x85\xb6\xf75Q[T\x8f~p\x10\xa3\xab\x88\xac\xb4\xca\x89\x86\xf0AGTO\x00\xd5dl\xcd9\xe7\x8e\xa0\xa0\xa21\x93ct\x18\xd7\xd3\xdb\xf2\xd6\xc3vB\xb7BK[\xa6I\x90\xe0\xc8\xf1q.\xca\x07%$\xcco\xe9\x8fb\xb6\x82\xa7\x90\xa1\x16C\xdc\xe0\x88\x94\xb5WI\x12\xf7i\x13\x92\xf4\xbe\xe3\x8e~M]\x03\x80(\xd5\x9cOA\x90\xb5\x02\x1b\xb5\xf6\x09C\x96\xe1\x06\xd3C\xd2\x95\xacD\xb3\xba\x8d\xbb\x84\x0f\xd323\x1cs\xd9\xa7\xe5\x05\x8a\x87\x18){\xb0'\x94\xf8K\xc6\x0b\x82\xf3)\x16;c\xdbha\x15 \xe2\xfe\xf5\xfbo\x0d\xdf\xf7\x14\\\xf3U[!\xe3p[\xbc\xd3E\xfe\xad\xe0\x0f\x1c\xeb\xcfy\x9e\xa0\xfc\x14\xb74\xe5\xdc\xe5\x1f\xf3p\x8f\xf8\xa4\xa8Gf\x92b\xab\x94\x8e\x11\xd3gL\xcd\x9f\xae\x9chg7eN\x80\x1c\xf2\x05\xfc\xa4~\x90\xf7\xd0\x04\xf4a%\x8b\x9f\x12\xa6vd\x17\xf0\x83j\xf3b\x8d\xf7\x8e\x85\xb6\x93]h\xe1v\xdf\xca\xfbkr\xf2\xfa\x16|\xad\x94\x98A\xe6\x13\x8f\x93\xde\xd5\x12V\x1e]\xb7\x1f\xac\xd6\xac{}\xb9f\x8ce-\x0cdb)<\xae\x8b\x16'\xe1pW\x97\x1e\x93\xba\xb6-\xcd0+\xf5\x89\x85\xdc\xc1\xf2\x03\xf7@\xd5\xe3\xa3\\8w\x85\x12\xe3\xde\xb9i\xd2\xa4\xff\x94\x1c\x8e\xe7\x82\x01\xf22\xc4\xc4\xaf\xe2@s7T\x1e\xb8\xfd\x1eD\x8b<\x1b\x7f\x02-r\xbaQ\xc8\xc8\x0fj\xe5\xd4[\x9c\x99\xd4\xeb\x8bN\xeb\x9b6h\x0c\xb6\xc7HJ\x18\x95\x15K\x9ay\xe2e\xe5\x8e!\xbf-\xe9\xdd\xf2\xf6N\x82\xf901\xa3\xe5P\xd6<\x8d\xe7\xcf\xc1\xd3(\xd9?,\xd66K\xc4\xed;<\xf1\xcb\xf0\x9fL1\x02\x1e\xd5\xab\xe6B\xdc\xaam\xdb\xef\x19\xd7\x18\x81\xf2\xd14\x8b}F\xb0\xca1\xe6*zU\xc5t\xd1r\x81\x8eO{\xae\xeeP\xab2a`}\xa6C\x843\xa5m\xe8QM\xcc7\xc3\x92\xc3\x04\xae\xdd\xf2\x96gS\xc1\x04\x14n\xaaL\x8d4\x81\x95\xb0mU5\xe6\xcc\xa2\xfb\x99\xc8A\xa7\x01\x96]:\x00\xf0\xd6E\xc9RF\x19\xd8\xbcS\xe4\xdc\xf8X:\xb4a\xb5\xceY\xf4\x91\xfc#\xf5*\x88\xbeD\x11+W\x08\xe3\xd8.J)@RI\xe6{-\xf9Z\xc7\xd7\xa9\x9c\xfb\x1a\xa2\xe2\xd8\x8c<\xe3\xbe\x15\xd5\xee\x95\x0fs;E\x1f\xbb\xa2\xeaN\xb1jA\x0d+\x03\xc1\x16 bK\xbe\xfc\x0ek\x0b21v\x03-\"\xed\xc6i\xeb\xc530\xb1\x07:\x1e'C\xf4N\xb0o\x89?\xaf\xe8\xcf\x1f\xe4\x9f\x7f\x12\xbe\xf5\xbf\xf9\xeb\xaf\xfe\xf0\xe5'\xf0\xef\xf5?\xf1\xc5w~1\xf8\xe2\x93\xdf~\x0a\xd1\xd8\xb4\xbe['Z~\x91\xd9!\xbd\x86H\xe1\xcb\x92\x97a\xa3\xa4@\xb74\xae2\"\xb2b\xfc\xc0\xf2\xc6\x0dL\xa7DZ}\x8bY\x07@\x08\xe1\x15,5]\xfd\x16\xec\xe35\x86\xba\xac\xd2\xfaJmv\xa1\x8cC\x8a\xc8\x84+\xf7\x12\x85\xcd\x94V\xda\x1f{r\xe7\xdbW_\x12\xc3\xfb\x1f\xd3:\xf2\x89\x1f]\xde\xa0\xc3\xda\xb23\x0cQqbW\xd6\xb3\x02\xe5\xc7\xe0,\xfcb\xf0\xcb\xcf\xbe\xfa\xedg\x9f\x7f\xfe\xc9o\x02oU\x05\x89\\W\xb8\x83\xd4\x0e\xe6\xa2\xc1K\xfc\xb9K\xbaC\x13\xee\x7fk\xf3\x8f3<\x94\x9b\x091\xcd\xa8\xac\xb4+\xb8\x06Y\xd9\x02\xe4\x81.\x84\xcf\xe7B3\xd06\xfb\xa8\x8c6\x85\x85\xfc\xe3\xc8\xbe\xde|*]C\xc1\xd4T\xdd@\x18GKn\xc1K.;\x9c\xdb\xce!\x16\x8e\x82*\xec\x1b\xd5\xb23\x16\x14L!\x03q'\x8d\x91\xf4\xa6\x92i \xcc\xe41\xda\x1b\x91\xd4\xf8\xa0\xc3\x98cW\xa63\x04/\xb4\xdc+\xe6\xe1\x9a\xc6\x1bD\xda\xbe\x92e\x8a\x186\xb2\x00'\xad\x90\xa9\xf6\x1e/\xc9M\xa5*\xc9i\xcd\xc8K\xd5\x8a\x8eQ\xa8\x14\xae\xa4R3\x85\xfa\xac\xa2\xa6\x0b\xd6 \x92\x0d/\xe5=\x10\xb5\x82\xb4L\xeaN\xdb\x1e\xd1\x03Y \xe5\x98\x14\xf6>a_f\x1a\xe6k\xde\x8c\xe1Uy\x04\xd7\xf3\x16\x85\xe9\xa6\xb6\xe77F=&\xcd\xa9\x94\xd0e\xb1\x0f\xf3\xe9\xbcQ\xe27\xa2o\xbc^\xc2\xdf\xb3\xf7\x87G\xf6\xf7\xad\xabz\xd7\xef\xa0@\x1aR\x1e\x0d`J\x1bQ&\xf3\x8f0\x0bf\x93&\xa7\x0f\xe8\x88\x08u%\x07{UH\xa2\xf04\x07\x19<\xcc\x88\x16=\xd2\xc0UK\x90__\x852\xc8]\x02o\x95\xbdA\x98\xa2?\xe1{\"\xdcD\x0c\x9fTLn\x1b\xc2=#\xb0\xc244\xed\xf66C)\x87\x12^\xf5G\xf9\xfb)\x92n\x85\x0de\xf7g\x94\xaf\xbeK\x18\xe3d8\xbd\x02\xc5\xaa[ZFNK\x98\xef\xee\xf6?\x19\x94\x15\x87iI\xa8E_\xb2sP\x01\xc0q\x85^\xad\x14dC\x01S\xc5\x12\"\xfbA\x81\xc6e\x0a\x8d\xe2/\xb9\xb82Wm\x1c\x0f\xd0`2j\xe6\x99\xeb(\x9cPr\x1d~\xaby\x87\xac\xb6\xa15\xb8\x85@Z\xd2\xffm<\xc4H\xd3v\x1f\xbf\xcf\x05\xa7l3\x0c\xc4\xef\xcd7\xfc\xb4\xc2\xb5\xb8!\xad\x86E\xeb\xba\xdeW\xc0\xad\x8a\x04\x8f\xaeY\xb3qJ\xfd\xd3\x8b\x94\xfa\x83*\x86\xc7\xaa\x85\x80\xe2X\xc2\x10+\xdfK\xb6\xffi\xb2\xbc\xca;\xdb~\xa6'\x98\x88\xabR\x02E\xfb\xb4\x86-\xe7UH\xbf\x14\xfa\x09\xd9\xba@=r=\x93\xef[g\xe1\xc0)I\x17\xd8\xc6\xf1^\x03p\x8f\xc8\xa1\x04\xd2\xc5K\xb6y\xfc\x1d\x82 \xa13\x90qD\xf8\xa3\x09\xba\xcf\xd0\xfe1E@\xaak\x96\x93\x03\xec'\xb5\xbe\xb6J9'\xee\x8c\xfbL5\x98z \x97B\x053\x95\x88O\xba\xd2\xde@B\x8d\x09\xec^\x0e\xd6Ya%\xf9%\xbb(\xb2{T\xd3j\xb4\xc5\xa4\xe2\x9d\xec\x10\xeaQ\x0b\xf3s\x0b\x08\xba\xb6\xf1\x1a\xbe\xe8\xa9:?K\xcc\xf9\xd4=\xf1\xb3\xe7\xdb\x8fM\x09\xabG\xeb\xdbJ\x00@z\x03A7\x17=f\xdd\xd5:\x15\xc7\x85\"\xaf\x7fg\x05N\xee\xc2\"\xea\xf6\xeb\x872\xfa^\x8f\xed;\x01r\xf4\xb8\\\x08\xc2#5\xf3\x15#^\x05\xcaI\x98\xf1\x8f(]\xd4-\xe1\x86\x10\x85O\x89e\x80\x19!\x11\xab\x81\x86\xba\x95\xd2\xd1\x14\xe10(r\x02\xf8\x04\x1d\xfdzi.D[\x0ef<F\x00eD\xae\x0c$\xd6\x17\x0d\xa6\xc7\xc0\xc04&\xc6\xb1\x17\xb7(\xb4\xff\xfc\x0e\x08\x86\xd0\x8aC\xe6\xb0hN\xe1\x09\x89\x1c\x89\xcb\xfe\xea\x06\xe7\xa9;\xa3\xb4\xd3\x02q^\xc8\x8a(3\xad\xde%y\x0f2A\xec\xc2\xdc\xa2\x90\x11|\xd7\xf4\x98\x85\x19\x188zW\xf1\x87\xa7\xf1\x8d\xd6\xb3]\xbf\x82o\xc8W\x05^\x94fD\xa3P\xc2kQ\xbc\xbf\xce\x93\xe0\xa4\x94\x03\x0bt4\x8f\xadZ\xbfQ}7weg\x98l\xbb\xe6)\x1eS\xa4W'\xb7\x83\x94\xc8\xe6\xea\xd5-\xad\xb1\xf35\x0e\x7fA\xf2Z\x16\xce\x86\xc1\x8f\xfdXx\x8e\xb7d\xef\xc9\xcc\xcc\xd2\xc2\x9c\xe2\x06Pa\xc3\x94\x906\xe4\xde\xc6\xf8\xe7\xbd\xa5O-6\xe0\x8eJ\xf2;1\xe9\xf2\x12\x0e`\x12Pm50)\x89\xb5xj\xb1A\x9c=X_\x02\x98\xed\x14j{\xa5\xc9ot.Sx\xb1\xec*O\x97V\xee\x17Ix\x07\xcd+\x8b\x88\x0f\xc9\xee\xbe\xb9\xc0\x18l\xb4\xe8d\x8a.\xbf\xf0\x14\xde\x89g\xb1\xef\xc3i\xbf\xbcc<\x13,f\xc7hf\xbf\xef=\xf0+\xa0\xb6+\x9bi\xf7\x03\xe7H\xc1\xd3\x11\x85(\xfe\x9dMz\xb6\x8fh\xfaB@\xb4\x04\xe0\xe9\x19\xc2\x83\xa3\xad:\x16\x9c;*\xd3\x13\x0c\x10\xd0\xed\xd6\x97h\xaa\xc4\x94!z\xe5LW\xc5\x01b\xc5f\xed\x19N\x87Q\x8eQ\xa8\xbe\x84\xa1}\x05\xfe4.V\x050\x1d\xc9\x14\xe4}t\xef\x9bc\x84\x8a+yLi\xc9\xab\x8d\x97>\x06n\xed\xf6VR07\x13\x92\x99]Q\x17\x89\xba\xfc\xcb.R\xc7v\xce\xda\x9e\xf4\xa8\x0c\x0aR\x92l7Dn\x9bt\xd2V\x89`\xce\x87XU\xf3\\a\xd5v!\xd8:\x19\x000g\xf2\xb1g.\xfb\xf7\x92gT\x0b&^\x0b\xfd\xb3u\x94$\x01\x9b\xd8;\xd7R\xa5Nt\xb0\xb0-p\xbd\x88\xde\xf5\x1f\"\x81\xc4\x036\x98\x0b;+-\x83\xa9\\n5\x0c\xdc\xcf,\x86\x80n\xfb\xe8^\xd4Tn\x95\xd9\x86\x90\xe2P4\xd8L\xd5P+\xadU\xda\xc8*o\xf0\xbd\xc5\x851L\xa83\xc52%bx\xea\xe3y\xc8\xd2\xb3\xb3\xbdY?\x8f\xba0&u\x80\xb9 >\xd0\xa9<}\x0d\xe4Bpo;\xedF\x0aGV_\x89\xeb$\xad \xa5\x110o!\xd6\xb5\x87\x94\xc4\xfe\xb4:<{\x99\x19|\xa3\xd8\x9a\xc4\x1c\xc2\x9fq\x19M\xd2q\xfc\x96\xa1\xebRK@\xf4\x10\\\x81\x127\xf9\x9c\x10\x11\xd67\xfc\xf5KJ,T\xf5{\x98\x0a\x03<*\xda\xfb\x09\xc5y\xb8\xc4>O\x82\x02\x99\xd2\\\xeb\x18\xed\xe7\x89 \xae\x9a1L\xef)\xac\xb1\x1f\x1c\xc6@\xc3,\xeb\xc6\xefN\xdb&\x9d\x8b\x81\x1b\x03\x0atT\xb4\x85\xe3\xfb\\\xa1r\xa4\xce\xbd\xd6\x11Ay\xce\x98\xdd:\xc7AN\xa0\x09\xea\x0aY*D/\x8f\xe9wk?\xc1\x19O\x08\x03\xb0G\x91\xd9\xd8\xd3\xb1\x7f\xd4\x08'\xe8\xb5|\xca_\xe5\xf2X\xf1#\xe1\xcb\x91\xdbXp\xd1\xd0\x12\x82bw\x89d\x83\xc43\x91\x88\x8ed\xba$&\xdc\xa9\xc2?\xa1D]$\x85@\x14\xf2\x99\x87B\xa1-\xb6\xe4\xfa\x9cM\xf8\xa9\x93\xe1%7\\\xf6\x98\xbd\xc3g\xa3_\x87\x1e\xf4\x0a[\xeal\xa7;\x9a\x95\x18\xcf\xb7T\x05!\xc73oN\x14'\x83,\x8c\xbe\x97\xe5:4\xf0c\xc07\xbd\x09?\xd5\xdc8\x1e1~ltO\x057\xe1z\xaeN\x9csR.\x9a*9'\x05K\xa6\xeco$\xdc\xc7\x03\xc1\x9dV\xf6\xae}\xaa!\xe8pG\x88\xa9\xff\xf8(\x05^\xdf\xc5\xcd\xc13\x10\x1c\xf3\x15jv\xd5W\xf105T\"]'\x19\xf1\xa5&\xed\xfe\x06q\xd2\x02d\xf2\xeb\xff(\xbftK\xd4\xe4%\x13jU\xa0\xffA\x1b\x95Q2\xe7X\x08\x10FN}\x8a\x05i9\xe51J\xado\xe8\xa2b\x00C\xa3\x02 \x04T\x0dK\x16\xe7\xea\xd7\xa1!6\xe5\xd1\xb2\xa3\x8a\x1dQ\xfaG\xd4T\x16V\xeb\x09lsI6e\x19J4\xfbj\xeb\x00\x0f\xad\"%\xe9\x81\xb7<Hsj\x1a!B\x12\xe8}\x06Pm\xc0#\x0aT\x8dn\xc3k\xd8\x93\xbeZ\x96l\xf6K\x88\xd9[_o+'\xf2\xedB=\xb0\x09%\xf6\xcd&\xb9\xb5N\xd0G\xda\xd9z5\xd0\xd4\x0b\x911\xb7h\xc1\xc9$v\xe2%\x07k\xb1\xadFf\xe1\xc1\x1c\xf6\xf6\x12\xb78X\xbc\xa1\xca\x0a1\x0e\xb8h*\xccl\"\x04aiS\x8d\xd4\x99\x91~\x04S\xdb\x9c\xfa\x1f?0\xd3o\xfa\xe1kr\x8d\xba\xee)\x9b6^7U\xeb70\xc7\xc1\xdd\xcf\x99\x97\x93\x1e\x13EA\xa2\x0b\x8a\xc8W{\xbd\xb1]:\xe0\xb4\xbeG\xe8O\xfdC\xcb\x13\xc9\xea\xc3\xf7\xb6o9&f\xe1\xa3`!Z\x9e\x1f\x03\x9c\x1b\xd1k^\xb9\xb8\xc4\xf4~\xfe\x80\xdc\x12B\x11sd\xd4\x90&\xce\x98$\xcb\xe7\xe8\xb0\xf4\xbb\x14\xee\xe1k\xeaY\xba\xc9\xe1\x00AC\x14~\x12\xded\xcc\xf5\x01L\x8d'/h\x1c4\x89\x96\xa6\xe8&U\x91\x96\xde\x8bR\xdep\xd5\xee\xe5J\xe4\x8a\xd1c\xccG[\xac$\xd5v6\x8c\x94\xb6v\x92h\x92eS\xdf\xa9\xad\xa5{\xd4{\x99\xc4\x0d\xf0g\xa6c\xdb\x16\x11\xb2\xf3~\x19\x9e\x15n\x9d\x00\xf7\x0a\x8dZ\xfe\xa3\xeekO\xfd\xd1\x05\xe9q\x08w1\x83\x82\xc4\xe37\x8e\x94\"\xe5J\xb2\x87w\xa4\xb2{#\xf3[\x8eT\xf2\x84Q5fs(\x8fBn:\x00\xd6\x89?\x1b\x8e\x09\xf3}S\xb99\xddl\xb3\x8d\xb8W\x902\xe7\xf5U\xfd>~\xcf\x7ffO\xc1/y\x94\x9f\xbcT\xb4\xb5\xeb\xa5\xfctE\x02\xe7\x01\x12\xa5s\x9b.\x01\xfe\xa99w\x0a\x08M\xf9cd\xe2\xc8o\xa3\x8c\xae\x07~\x08kB\x8a\xe2\xf7\xdfE\xc2\xb2\x8f\xa8F\x0dWN\xc9\xa2\xdd\xdb\xf7$\xe0\xb6\x0d\xa3p\xffM{\xec\x84of\xa3\x1e\xc5:/\xfaW\xe4)q\xba\xd9\xdao\x8f\xa6\xbc\xc9:\xef\xd8\xc9\xf8-\xad\xb2Y\x02\x15\xeak\xadD\x87m\xc4\xb7\xc4Nd\xf1l\x97\xca\x98\\$8\xc4K\x05<\xc8G\x9b#\xd1A\xaeS\xabnE\xd4\xd5\xcaH\xd7\xad\x15H\xd4\xbc\x08\xba\x983\x8e\xce&\xc1}\xd6\xd1m3S\xbfE\x18\x85\xdd`\xf6\x8c\xb5\xad)i\xc7T\xbb\x88\xa9Q\xa2\xd3'c>O\xbe%\xa5\xe8Z\x98J\x89\xd9P\x05\xf7N}r\x16\xd70\xf6\x84\xd2\x87\xa1\x9c0\x8d\xe3\x91\xf7KZ\x98cd\xf6\xaf\xcdA\xea.A\x1dw\x8f\xca\xbe\x9f{\xe6\xfa\x86\x18(6\x8d}\x9e\xcf\x1bf'#\x88,\xc3\x92\xb0VP\xf9\x9e\x08\x8f\xbc\x92';\xf4\xe3" +
+	"\x8fE\x1c\xd8\xcc\xd8\x8c\xcb\x9aQ\xeb\x11r\xd0oY\xf0\xcbG.\xe1\x11p\x09\x17\xaex\xf2k\xe8\xff>\xe2\x1d\x126=\x18\x9b\xd0\x85\xf7\x1e\x01W\x1d\x88\xbd\xad\xc3g\xd5P\xec\xd39\xc2\xc6\x1fM\x13\xffIp{\x11)D\xe5\xa6\xd3T\xa4\x10\xb2\x12\x00\x86%\xb1A\x84\xf3K\xa7\xe3\xdc\x17\xee\x15o\x00\xc1\xb4y\x03d\x80*z\x08\xb7H\xe7C\x86\x03\xc0x\xd3\xbdj\xb6_dj\x8d\x82\xbd\xc6v`<\xc4b!qgS2@\x03\xff\x82\xb1\xbf#\x12\xff\"\xb6\xaa\xbe#\xae\xea\x13\x84\xf1JX\x9f|!s\xd3\\\x11dF\xb0\xe6\x8e>\x9e\xa1\x11q\xa3\xe2\xf0\x12z\xcd\x16R\x8e\x93\x03\xda\x95\xe0\xf1\\\x02\x8d\xd8\x89D\x1c\x8aB\xd2\x00|\x1e\x87\x19\xe2\xb8\xad\xbf]\xad\x90\xa9\xa9\xc6*\x9f\xfe\x96HQ)\x8d\xb9\xea3\xd1\xb94\x14\x98 l\xb2&\xc5X\xaa\x9d\xbd\x0f\x7f\xf7\xf47\xd1\x93\x16\x0e\x94\xcf\x9d\xab\x1c\xacG\x82\xca\xaf\x00\x03\xe3\x8a\xc4>\x02\"n\xaf7\xa7\xba\x07\x84<\x97\xb6\x95Cl\xbb\x0c\xb7\xecf\x1b\xd5a:\xb2\x11D~:\xb7\x13\x92&\xbc\xe4\xb9\xec\xb9\x11\xac+\x85\x88\x12g\x16\xdb\xfe\xac\x98}\xcf\x8c?P\xba\xb6;\xde\xb6\x91#\x01\x80q6\x8b\x1eB\xbf^\x8bf\x9eb\x0a\x0b\xf5\x9d0\xea\xed\x1d\xc9\xb6d\x161\x11\xd8\"\xd7\xa9'\xec\xef\xa6(\x04\x87X\xd7\x9e\xe3\xadv\xd2\xb5\xd5N\xb5f)\x9e.\x0c;\xd7\x8d\xf8:\x93 Q\xeb$V\x0d\x1fR\x84A\xe5\xc8)\xe5H\x96\xe0\xb4A,\xf2#\xfdQ\x90\x08H\x1am\xf9\x847M\x81?\xf2\xc4)\xbc\xec\xdf2Q0\x80\x93\xe6~\xfd\x9f\xf5[\x18'Um\xaa\xea\x1fiq\x89\x88\xed*\xb8\xc1\xd7\xc4\x94\x95Ae\xa4\xb2\xe4\xf0\xf3\xb5-\x08u\xc3E\xfas\x13,}\xf0\x92\xb7\xa6l\xb3\x08\x03\xd5\x0eH1I\xf7\x8d\xf3\x14\x17\x1b\xf6\xc2\xee\xf6l\x86\xbd\x8f>#r#\xb2\x03\x86\xebJ\x10/- d{%\x84J@\x9e\xd2\xd34<1\xa2E\xbd\xbf\xce\x08\x0f\x1d\x10\xe0\xdfEj\xf5\x86Y\xa9N\xc9G>j*o;\x92g\xb9)\x8e$\xc9\x16\xbd\xee;\x0b\xb6\xf3\xf0!B\x85\x18\xbe\xe4v\x93P\x8bgd\xec\xac\x98\xb1\x93\x0e\xf2\xb4\xc2\xca\x8e\xb2>\x0fT\xc6\x98\xa8\x0c\x95;\x18\xf5\xee\xc5\xbey\x9e\x16$u\x08]\x13\xb9^\xbf[\xc9\x1f\xe0\x1f\x02\xee\x96z\xd3\xa5\xa9\x03\x1e\xc8J\x86\x0b\xd7\xc8\xeb=\xe0\xb6O\xee\xd1\xbfk.\xc4\x8e\xd4\x1a\x04\x9b\xb4<\xe7\x17\x90P\x8abqc\xa7\xb1q\xc2\xd9}\xa6Y\x18AHw\xda\xb5\xc2\xf6\xbb8\x05Sn~\x0a\xf9/!Qc\xe8\x06\xa5\x14\x92\x0a\xbf\x88\xfa\x8b.\x912\x9a\x0e\x9eA\xdd,\x0fQ!\xc8*\x9cY+\xe5\xb7(\xc8\x8a\x813\xb6\x0e\xe2A\x92\x92H\x06\xb2\x0c\x07r%\x14\x03\xda\xf8\x0fV\x143\xfc1\x1dUE\xad`\xb9r\xac\x82[\xa2\x94Y(\xc2kA\xe6VY\xcd\x1a\x8b\xdc\x05\xa8|S\xc9l\x87\x11.\x1a\x0b~\x7f\x1a]\x19$\xc2+\xd9\xcf\xf3c{\x98\xca\x8c\x0aM\xf1\x14VF\xcd\x7f\x86a\xc6\x1f\x90\xbe\xa3\x90s\xa9&\x92k0\x0a\xfc\x15\xd9%\xdb\x8ex\x93\xdc\x9a\xbd\xc3\x83\x9dl\xb3\x9fgI\x0a\x90\xa1\xae\xc2\xe3Q\xcb\x9a\xc0\x99\xbd\x01\xf1\x0b\x81\x0d\xe2\x18:r\xc1\xc6\xee\xe2\x02\x00D/Q\xcb.\x1eO\xc1;\x90`\xe1\x191\x0dP!\x06\xb6\xc7-E\xd8J\x00o\xa3\\\xd8\xceP\xd2\x01jH\x89\xc8j_\x87\xed$B$\xea\xb8\x83\x08\x81\x0a\xe1\xe1\xb5\xc3Z\x91q\x02\x8c\x84\xbe\x0c\x84w:\xeaX\xbf\xf9\x9f\x88z\xe3\x8fx\xd4\xf6\x098v\xb6d\xd6,QN\x85?FpwOV7\x84v\xbdS\xe2OF\xddO7\xcc\x96\x90\xc9\xb9\x90M\x0a\x95\xd1\xbb\x00XBi\x0c\x91g\xce\xcdZ\x04\xdcc\x04\xd9\xb4\x8e\xc6\xf6\xb3\xe6\x10u\x00\x0b\xbf\x8e\xa7\x7f\xfc\x16KR\x1b\xed\xa2\xbb\x98\xe6\x899\xc0\x84\xd5a\xa9+S\x8a\xcbe\xe9\xe8\xd5\xa6\x95\xce\x12\xce\x1b\x9d\x8f|\x10\xc4W\x09-\xe3\xb9\xf3\x10\x1f)\xc7Q)\x8e\xc6\xac\xc6\x1bN\"\x81\xf1NM\x80yG\xabI\xf2Y^+j\x10\x98\xce\xb3\x1c+&'\x93?R\x96\xed\x03\x8b\x16c\x83 8\x7f\xb4v28\xcc\x11\x09v\xcf1\xa7'\x00\xf7\x13\xd51\x8b\xa5\xc7\xf8\xae47\xeb\xadP?\xf0n\x8c\xc5T$-b\xde\x0c\x12\x1c\xbc\x91\xae\xf3)\x96\xf5\xc2\x9eH\xcaE\xa9\x80J\xff\xf8 \xc6\xb4\x17\x0c9\x1b\xf5\xf0\xdevv\x94\xa0\xb9\x1a\x9b\x95\xe4\xb6\xfb\xa6\x07k\xbd\xcfc '\xd12\xf6e\x98\xef\xae\x9a1\xe75\x08q^8%\x16M\xba\x1e\xae\xf3\x1e\x01\xbb\x9a\x97\x86\x17\x7f\x9e\x1cvg\xb7\xa52\xe4\xd7\x90/\x09\x12\xe0\xe1\x9d\xdai6\x84@\xf8\x85Jk\xb7\xfc\x84\x87\xb8cb\xf7\x94Z[V\x97\x81\xa90\xe3\x14\xc6`\xa1\x00\xac\xddH\xcdI\x98?\xd6t\x81J\x06\xec\x0fLk\x84,\x87\x92\x09NL\x98G\xf5,\x0f\x9a\x99\xfe\xf3\x95\x90\xb2\x98\xbb\xc5l\xfc\xf4\\\xc2d\x1e\x98ox\xdc\x144\xc68k\xeb\xd7\xa9\xef\xe0;\x967V$]\x8c\"c8<et\xa9\xf4\xb2\xad\xae\xa4\x91\x1b\xca\xbc\xb7\xc9\xf2\xf8\xef\x1d\xff\xd4L\xd7\xf9\xa8z\xc5\xdf\x1ck\x970\xafT&\xed\xccQ\x0c\x1a\xc24jm:\xf8\x09\x16\xb7s\x9e(e\xa6AU\xd3\x12W\xb4\x05\x16\xf2\x98\xb2\xac\x95\xf9\xf5\xbc\xff\x98\xd3\xbc\x87\xaa\x86C\x0e.\xf0\xb5A\xb1\xc7d\xc7K \xf9\x8a\x89\x1e\x1a\xe6J\x04\xef,\x0d\xe8k\x08\xa1\xc1\x82\x98\xecC\x1c\xa4\x10\xef\xaa#\x02\x8f\x93p\xf3UH\xdb\\0\x81\x828j\x81\xea\x0cJQ\xab\xb41\xb4\x99\xc6\xd70\x13\x1b\x99h\x84&\x85\xe6&\x07\x14a}\xc3\xc3%\xed)CS\xe5\x12\xc4\x9e\xb5\x95\xd3\xc89\xa1ra4\x09\xadS~\xb0Yd\xc2\xac\xec\xbat\xcd\xaa3\x86\xcd1\xf9\x15\x8c\x83<X|DH\"\xbdh\x1aT9m6w\xad8S\xf3\x87hq\xd4\xb0v\xca7V\xc1\xb3\x7f(s\xf8\x94\xb3<<\xf7[\x10a\xd0\xe7*U\x99\x95\xe2a\x92\xe1TW\x80\x1e\xda\xdcX\x09\x0b\xf1\xa3t~\x19T\xbe\xeca\x14v_\x88\xdc\x13Y\xc6\x81\x8a\xe2\xa8Bm|\x8cl\x95\x98O\xba\xf5\xe8\x84E\xef\x9c\xcbT\xc4{\\'\xc3\xee\xf0\xcf\xecw\xb5\xa6|\xe6\xc1/\x96\xfb\x9b\xf99\x83\xfc\x01\xb6\x99f\x8fx\xbf\x1b.\x09/\x9d`\xf7\x92 F \x07\x9bu]l~\xa6oW\xc1\xee\x96\x18\xfb\xa49\xcdg\x83\xea:\x0fm\x9e\x8b-m\xbaM0\x08\x99\xca\x10D\xb2\xcd\xf4\xf5\xf1v\xb7\xd5\"JX\xc6\xfd\xb2\xf6Ta;quH\x1c\x1f{\x0e\xe2\x14\x17\x1d\x7fI\x8e\x16\xc4({<\xf1N\xfa\xc4,\xf4Z\xd6\xb7\xf9{8\x1a\xc1\x8fM\xf0*a\x94o%\x1a1\\\x95\xfa%\x99\xbc\x1c\x92\xb8\x13\x7fC\x15\xb2jp\x13\xcd>\x11\xac\xe9&\xadx\x13I\x82\x863\xb0\x91\xa9\xdfU\"\x16\x9a69^V(\x9f\x14)\x8fq\xe6\x17\x03\xf2l,T\xc5\x09L,\xe3\xfe\x06|Z\xbb{=\x09\xc6\xac\xc1\xb0\x9c\xe8R;&\x8f\x1drh\xc10sWH_\xef}|\xabXq\xb9&\xb0\xd5-2\x0c\x0d\x14\xa3\xb1\x9aK\xfb\x0b\x0a\xc2g\xd4\x99H\x05\x84\x19\x89\xac)J\xb7\xb9\x15\x0f\xea\x1b\xf9\xd7oA\xfaz#\x1c\xf9\xee~G\xc9\xc1\x98W\xd1\x02U5\xe3$\x07f\x8a\xfd\x15\xea\xe3JxR\xf9\x93\x8ant\xb2o \xd5\x16L\x8f\x8d\x8d\x92\xafJN%$\xa7\x08\xc9\x9e\x13H~\x09\xa6\x9a\xec\x8b\xfe\x08\xe7`\x98\x9b\x1f{\x06;\xbb}<_[?K;A\xd6v\x1b\xb2\xbdr\x99]\xe2\xaa\x97x~\xc3\x97\xadK\xe1\x0e\xaf$\x84\x0d\xa2U\xc24\x0d\xc9j\x13?R\x98Nx\xd4D:5\x12\x8f\xcc\x19\\\xe9\xbf\xf8\x14r\x0c\x13>\xa1\x18\xa9q\xac;7\xfam\xa2;\xd2\x8f\x7f\xc0\x1cA\xcf\x13\xf8\x19p\xaa\x10\xfe\xc7\xbbg\x8b\xf2\x99o\xa5\x99\xf8SA\xaf`T\xe2bPV\xb9\xe4\x19\xa2F3%-\xc1\x07-\xe7\x1b.\xa7h\xa8G\",E\xaai\xeb\xba\xa6\xe52\xf3\xd0UN\xfew\xb9Ia\xb2O\x1dN\xe3D\x15\x06\x1f\x87\x18\xe0\x09M\x80+/Ik\xa6{\xcf\xee\xbdxb\xf4\x15\x0cN\xfd\x80[C\xc6_\xd2N9U\xc9^*&E\x8b\x82\x89\x0f\xcf/\x06m\xb9VBb\xdc\x00\xe4B\xa4\xc8\xf5\xab\xe6\xe2\x06\xd1\x92\xbd~5\xd1\x1d\xa19\x9eC\xf8\xb9J\xd4\xf0}\xb8\xbd[T\x96\xd9\x80\xf7B\x82\x05F\xf4\xbdH4\xd3\xf6\x7f\xe8Qp\xdb\x1bf\x98w\xa33\xcc\xa5\xb1\x13^\xd2@\x95\x80iC=\\\x0a~\xb7\xde\x82.S\x01\xdcK\x0eq\xb2\xa3V\xa2\xa2\xb0\xd4\x1e\xff\x14\xc1\x0b\xac\xaa=\x00~T\xde\xc9S\x89\xf2/\x06\xf0\x1997\xf9!\xd9\xeaUO\xbd\x14\xd2,\xc1\xe1R.1\xa37\xc3\x09\xbb\x91\xf2<\x15\xc0\x99\x9dF\x8au3\xd9\xcc\x0d\x8b\xc8\xe5-\x8bC\xe2\xc0\x91\x82\xaa\xb7e\xb1MK\xf2M\xa3\xf2~\xeb\xe8mo\x18\x9a\xcelM\"\x09\x81\x0a\xce_\x83D{\"\xc3\x99\xd2\x1e\xcdS\xad\xb5\xe6\x94N\xd9y\xca\x9a?\xf6\xdd\xa9\x81\x11\xaa\xa6[\x1af\xe2\x05:F\xf5\xc7\xf6\xf1\xd9I\xb1\x1c\xdc\xe6~g3\xb5\xe1H\xd3[\xe3\\O\x1bu>} Y\x8b\xb4s\xcfG\xc1\xe4\x92\xfe\xf1\xb7\x83\xa8\xa3\xa9\xca\xba^6MVD\xa5>\xbd`\x1c\xfbHm\xa6y\xef\xc0\xfb\xb6\xb2=\xa5g'J,\xdcG\xf5\xc3\x0c&\xf0i,V{:T\x1d\xebnf\x94\xe0\xd4h5\xe7\xd07|f%\xf8\xa01\xed.\x06N\xb4\xf5\xde\xc3k\xad4\xacT\x0c<\x9b\x08\xbe\xbb\xa9\x09\xb7.c\xa8\x94\xad\x7f\"\xd2n\x92\x04^t\x14\x8b\xf2\xe5\x1d\xb0\xc3W\xbc4P\xb1\xbd\x0fdgo/!\xa8\xd2k\x11q\xbaK\xc9\x1d\xe0\xa3\x9d\xe0Ga\x95\xf7\xed1b\xdaN\x10\x8d,;D#m/;\xf7v\x1a\xa7\xd1)\x0a\x9dyJ\x15]{P\xdfAt\xa9D\x86\xbc\x07\xe8@\xb2\x0aO\x8f\xa9\xa5\xd5\xf7x\"\xc5I|\x05\xf2\x87\xb3z\xd9>\x89\x9e\x90<\xdf\xa7-e\x94@\xa0D\xb5\xe6\x11\x10\xa8\xbd\x94\xef\xec$\\:\xb9}3_;\x02\x15\x14X?\xc8\xa47\x98\x9f\xe6\xa8\xcf\x0d\x0f\xfdN\xb2vbO7\xda\xc9\x90\xc9)}\xdc$w\x1e\x0e6Th\xed\xa6\x9as\x89t0\xd5|A\x17V\x88\xe0\x8a\xea\xc4G\xe9\x17\xee)>\xbc\xe5\x16\xa4S\xb7uB\xfd\xf6\x06\xde}*u\x1b\xf8\xad\xd7\xd3\xd2\xcb\xcb\xd8\xe7P\xc5'#\x85t\xa9e\xcb0g`\xbb\x84H_\xfc\xe1\xfe\xf0\x19BhIYU\xdf\xc4\xb3\x9bt\x1bDg\xca\x14K}\xf1\x9b\xafH\xcfj\xc9\x10\xa5j\x90~\xf1Z'\x08\xf2/\xb3\xd5.c)Y\xcd5u\x03t_\x87X) w\xb3\xfd \xdam>\xce\xbb\xcd\x02\x95\xa9\xe9\xaf\xfa\xad\xfe\x0bv\xd9\xe9\x81\xe7\xc8\x83\\2\xb7\xc3\x83\xe6<\xee\x07U\xdc\xb7Miq\x19\xa7/\xa9g0\xae\xcb\xb36\xa2\xe2\xb1\xa9H\xd1@\x1eSK\xbe\xc7\xec3uZ\x7f%}\x93\xf0\x1d\xb8C\xee\x16\x00\x9fg\x03X\xbbh8\xb0O\xb4\x99\x89\xa4\x06\x99\xa5D\xb7\x07\xa0Zoa\xbe\x81\x0bx##\xbe\xbfc\xdc\xacy8\xebX\x86l,\x9cr\xa5\xd0\xec\xd6?\x9d0\xe3^\xb3\xefc\xb5\x03\xe99~\x80?B\x049m\xe60&\"\xc1\x87\x99\x18&\x90\x12\xc6\xff1\xcf\x1a\xde5\xfe\x16\x05\xb5\xe6\x86\xbc\xf6ct\xaa\xea\x05\x8ewR+\xf8\x13\xb1\x1f\x9e4\xd5\xa3O\xd1\xfd]'4\xd1\x0c\x0ac)\xf8\xc7\xefjeM\xda\xdfX\x13\x1c\xa1\xd7\xff\x1e\xb6\xf4\xc8RA\x09\x02/\xd5A\x8b\x97IZa\xf9\xfa\xa7q&DKgfi\xa4\x00/+-O\xbc!Q\xcdS\x87h\xcd<\x16\x82\xdf\x089\x0a\xc0\x94\xaf`\x15\xfd\x00\x7f\x8eAU|\x87f\xa2YUJ%\xf1\xc6T\x8f\xb8\xdd\xe5-\xb5\xfbt,\x05\xa4$\x16\xef\x11\x97\xe9[\xbb\xdd\xdfki\x9b1\xc7[\xb2;\x1e\xb7b\xba\x8a\xf1\x9b\xdf\xc5\xcb\xec\x8d\x9a\xc2^E\xe0\x90\xa8\x00\xc6})N\xc6N\xe5\xed1\x87T\xdf\xb6\xfd@\xfa\xed}Y&\xf6\xf7\x9f\x94\xb2\xcd\xe4\xf8\x88\x02W\xbc\xf9\xcc\xe1L\x11`<\xa0y\xef\x80\x95k\xe9I@\xa6\x1d\xf3w\x18\xdcL!`\x09\x04\xa3\x85\x17\xfc\x95R\x13lI}\xc92\x8dI\xecV\xcdQ&\x10\x13JZ\xccr\x90\xe0\x03\x8e\xc3\xb7\xc2\x19\xa2\xf6\xb3<v\x84\xb4\x83\x0a\xaa\xf4\xef^r\xeeX\x00+\xad\xbd!\x8c\xaa\x0c\x83\xeb\xa5\x1d\x87\xa9()\xaaQ\xe0\x8e\x064\xda\xd1\x80!\xe8\x9d\xca$\xb6p\x16<\xc5u\x96\xc9\xb2^\x1f\x816\xa0\x12\xba/\xfd\x13\xd3\xc6\xbfd\x92\xd5\x01Z\xba\xba\xeb,\x03,D\xe9\xbfI\xfa:\x9f\x00\xba\xda?P\xbb\x06\xc6Q\xb6,h\xa6DR\x0c\x88\x99\x80cA\x81\x9b\x12V\xcb\xb8\xde\x94\x83\xa4\xeaW\xb4Y\xd7\xccJ|\x8f.\xabV\xa3\xa6\xaaV\xf0\x8d\xeb\x85X\xb9\xa6\x0b\xe9l\xe0\x09\xa8\xc9c\x89\x0e\xabv\xb5\xfc\xd6{j>\x8fG\xde5\x1e4\xb4\xa0\x10\xcap\xd6S\xd1\x9f\x08\xfb\xa2#\xe0@\xb0\xe8\xfc\xa5J\x9a\xee\xd0!\xc08{\x00#\xf8\xc1\x89\xce\xd8\xf5\xe9\xd3\xd9?TH\xf5\xb4<\x80!=\xbc\x99\x9e\xb7s\xc16\x97\x89S'\xed\xd7LgE^S\xeb\x9eW\xe4+\xf8Ig\x86\x89\x9a\x02\xb8\x94\xdc\xe8\x87\xf4\xef\x1dJ\x1d\xbc\xd0\xe3\xe7\xe5R\xdc\xc4\x89\x0a\x0ae\x02<M\xbe\x80\x9bN\"\x12c\xcc\xc4\x8e:\xb2\xf0\xba\xbdM%\x1eo\xd0]\xed`E>PE6\xdf\xdf\xfc \x98i\xcf\x12J\xba\x05di\xdf&T\x16zO\x11\xfdYQ/\xf9\xaa\xdf\x03?\xdd\x0f\xfe\x09\xa8\xfa\x11\x1cQy~\xd8\x81O7F=oQ\xb1\x8c\x13\x95\xf48\x95d\xdb\xef_\xac\xc1\xc9:F\xc0R\x1b5R\xbff\x95\xe3\x1e\xe7\xc3\xc1\x96:\x1fT?@\x01\x0d\xd5pZ'\xf1$5\xe3k5\x02\x96L\xbd24\xb7\xc2\xcc\xf1<TnR\xc0V9$\xe1\xfb\x15\xf2,\xc7GE\x01\xe2\xbe\xc4\xef\x07\xdb9\x05Vjv\xd3\x1au\x13<\xb4\x93.7\xf8\x94)#O\xc9\"\xd7\xaf)r\x8d\x9f\xd5o\xd0\x8fpJ\xe38lP\xb4\xcc\xe23\x92\xd2\xb1-/\xf7\x03\x93\x88\xb57\xa6\xdc{tGNZ\x98\xf00m\x1ch\x12H\xccS\xe273Y\x7f\\S2[\xd9\x9bnw\x05\xe5\xdf\x09\xb0\xfa\xa1\x92\x0d:MD\x8d#\xbb\x09\x9d\xb0\xe3\xb1\xcd,\x07:Y\xf3\x98\xc4\x07\xfbr\xd0\x93\x15\x0f)[Iaax!\xd8I\xfd]\xf5{\xbc\xb2\xe5RU\xa4\x06w\xa9\xc5(\x0dH#F<\xd1\x84\xd6\x97\xf1\x86\xfd;\x8c\xd3+S\x00P\xcaK\xbe\x94\x1dS\xe2\x1bD\x91\xdf=\xf2\xbb\xdea\x19\x03\x8c\x11v\x98\xdd\xc33^\x91p\x93\xd7\xb2\xee\xc9}f\x00\xd7\xaa\x9f}\xd2\xff\xae\xd61\xc9\xde\xf7\x18\xda\xe6\xc7\xd9\xbbv\xe9\xe4\xb41^\x85+\x96\xcdQ\x8f\xaa\xd6\xc1^^\x8b\xba\xc4r\x89\xa0W\x06\x04\x91\xd2\xb8\x1b\x85?a\x0d@\x12X\x0bt\x96\x12\xd6\xd6\x12x\x1a\x90\xb6)\xc9N\xdb@\xf4\xd6E?\xd0\xa4(Z/KF\x8d'\x89\x0d\x89\x1etA\x89l\xc1\xcf\x8eUNG\xa7\xc2\xc1\xbd\xc5\xf9\xa2\xe6\xe8\x1f\x84\xd5X\x11\xa7V!\x8fA^\x9e\x82A\xbe\xbe\x8d>L\xb6\x16\xd4\xdb\xd1?80\xa0\xf54Cj\xb8\xb32X\xa5\x11l\x08\xa5_\x9c\xb1#+h\xe5Q\xedK\x84&:6\x1c\x81\xe1'\xcb\xf6\x06c\xe8N\xe1\xba2\x0f\xd7{\xb4\xc4\xed\xed!%\xb5#\x8c\x1f\xd1\x1e\xd2\xbb\xcf\xe5@\x95Y\x05\x0eX\xc9\x9bk\x84\x1d\x1bi\x1e!m\xaf\xb13^\x04\xf9\x0c\xa2=\xd5\x9a\xd3\x8a:\x92\xd9\xa4Si\x19\x89+Hr\x84\x16\xfa\x117u\x18\x98\x95\x81it\x16\x0b$\xce\x95\x03\x19`\xb2\x04\xca#%#G\x8d\xb7\x0a\x1c\xa1" +
+	"\xb2\x08a+\xbd\x82Y\x8e.&\x0b\xfd\x98\x0bm8$~\x87\xf8\x98\xce\xf6^\xa9\xe6C\xcd\xf02fZK\xd7%{\xa7\x92\xbf\xd2\xc6pF\xe0\xbc\x7f\xb5\xccS\xc59s\xe8 \"\x86\x87G\x95\x80\x06\xcb\x88:@\xf8\xe1\xca\xea=O\xfd\xcc\xb5A\xab\xcc\xddoI\x91\xfc\x89\x01w\xf0.\xb9W\xf9jk\xcd\x11\xccS\xd4\x1f\xc4\x15r\xb2\x03\xf1\x8f\xf8\x18\x85yX^\xa21*A\x0a\xaa\xb7\xee7)c\xdd:\x00\x94g\x16I\x1dy\x04\xf1\x03\x96R\x98n\x8c\xed\xe1\"\xc4g\xb2Pw(RBW\x15\xf0\xf7\xb5[\x87\xdc\x1by\x07\x11#A\xb2\xa0<\x12\x92\xc2+\xdd\xbb\xda\x86S\xbe\x84\xf9\x9d&#\x93~\x9c]\x13\xd43D0]\xe1i\xa4\xb0g\xe8+^\xc6\xad\x10S\xde\x971\xbcm\x8eC\xcd\xcd\xc4\xa1F\xb1\xd2j:\xf0Z\xf0f8,\x01\x88\xa0\xf5\xd3t\xcd/\x13\xa9\xb5\x96-T\xcd\xfa)Vrm\x05\xc2i>Jt\xde\xc4r\xd5\xe0\x16\x01\x944d\xb5}\x96]\x18\x18\x0a\xda6\xec\xd4=\xdc\xf2\xea\xbaJ\xc4\xbb2\xc1\xd3\xb9\\{bH\x8a\x81\xf9b\xca\xda\xf3\x1a2q\x97RH\x0b\x90\xf9\xcb\xa6\xc8^D\xfdnD\xc8|\xe8\xa7\x8a\x0dR+0<\x0b-\xe8\xc7W\x12\x89s\x98uNz1h\xb1\x00w\xf2\xac\xa5i\xa8\xcak\x0d\xbe\x83,\xfd\x85$B\xf3\xe5\xd1\xb9+\x81\x8f\x9d\x07\xcc\xe0D\x0dkGs\x9d\x1ch=Lg\xcf2.\xfd\x1e\xf2igc3z\x8e\x09hN\x9f\xf6\xb6\xa3\xac\xb8L4\xc6`\x09\xde\x89 \xa3\xc4\xa4\xf5\x1c#\x8c\xe8\x9aG5\x91\x82\x1b\xd0\xeb\x07\xf11\x02\xe4\xeb\x85UI\xce\xc2\xce\x9e[\xf1\xf5p;Gm\xcf\x9bw\xee\xed;\x18\xbc\xa5P\x87\x13\xaa\xd7\xd1\x19|K\xb2|\xa4;.\xdbf\xcb\x1e\x82\xd9\xf5G\xe8N]t\xe3\x15\xd9E\x14-\xeb\x92\xd4\x12\x0b\xab\xbe\x0a\xf7F\x98\x97\xc3\x9dg\xc0\xee\x89\x1c\xc1{B\x85\xa7}g\xdd\xae\xfd\x926\xd8\x85\xe9[\xd54n\xaa\xd6\xa6D^\xa4\x97\xf4M\x9f\x134\xc6\xd6\xe8\x07\xceQ\x0a&1\xcf\x89*\x17'\xd7Vaj]@U\x95)\x0f\x033uH|\x17\x1e\xc5J\xfd\x11\xf6H,\xf3\xab<6\x98\x94c\xa3D\x9f\xd2\xbb\x99\x8e\xb0R\x98\x04/\xc4\xcd:\xbc\x99y\xe9O\x80?QJ\xb9VJ\xb9/\x13\xf2!g\xfe\xc6\xb2q\x14\xa6-\xf6\xf8I\xecQ`\x90\x92u\xceIB\x9e\xe0%L\xb9\x0cQ\xa6\xb9\xf3\xc8j\x16fa\x95\x82\xb9\xae\x91j@\xde\xb5\xcf\xf2\xcf\x08\xbc)F\xabL#N;\x85\xbc\xfflR(\xf8q\xb7M\xe5\x9a\xe4\x88\xd3\xf5o\xa9\xbdB@?\xd0\x0d\x7f)i\xc3ns\x0f\x1f\xc4#\xef\xe0\xc8\x05\x1c\xc4\x93\xde\xb9\xdf\x93?\x12Dx\xc8\xa80\xf9\xee \xd0\xa5\x8a\x8f\\\xdbf\x06#\x1e%U\xe2\x10C\x97;:\x9f \xbex\xb8\xdf\x8f\xcc\x81r. \x0f\xee\x09\x97\x8f\x05\x8b\x1e`\\~\x94x\xbf\xf6\x8e2\x81\x08\xe2N\xa0\x04Fb\xbex,:(\xba \x95#S\x04\x03\x02\xcc\xca^4\x81\x93\xc1]\xe6R\x92\xb5\xf4\x81z\xeb\xb7\xa2\x0a\x00\xd8\xcf|\xcaK\x86\x8d\x99>\x88K\xda\x9c\x8a}A\xfb Z\xa17\xe4\\\x9a\x09\xbaw]\x96\xf5 \x9f_\xfas\xa0Te\xb9Y\x08!b\xe7<\xa2S\xa2-M\xe4#I\xe3lI\x91\xc6t\x13\xcd\xe2\xc3C\x99\xe5v&\xa5WI-\x9ct#\x88d\xdc\xf2~\x87\x1f\xfc(6\xd0\xe1\x8b\x8c\xc6\x93^\x80}\x9d2\x13,\xe4\xf6N$\xb0\x880R\x92f0\xa5w^\xa0\x0fVfz\xca \xc4\xd8\x896\x97z\x9d\x80\xc6'0\x95~\x93\x97N\xda\xc6lH\x89\xdc\x89W\x91m\x83\x1a\xdb6\xd7'\x88\xc0\xb5I\xb8\x0d\x01\x18\x1f\xf4IQ\xdaz\xdcf\xd1\xcf\x13L\xf8\xf0E\xae\x91\xbd\xd5%w\xe6r\xc4N\xa0fm\x92^\xa1Rh=S\xb5\x95\xa3\xa0\xd8\xc8\xed\xfe\xda>hX\xac\xec\xceNTE.\xb5<\x19p\x05\xb5j''dC\xe68\xf3i\xffiFDmE&\xa4([\xb1a\xdei\xf8b+3't\xa0\xc9\xe2\x9d\xee'9b\x0d^\xa7\x098A\x17\x8fH\xc7:\xc6G\xa8\x11\xdb\x81~\x92\x1d@\x02C?\xc7\x9c\xaan\xcb\xba\xe4\x0eW(\xe9\x98\xa4\"\xbfa\xd8\xe8\x06\xabCW\xb6\xf0\xd2\xb8\xd7\x05\xf1kB\xc2\x0b\xaf\x16\x1e)\x90\xdd\xa4\xafF\x0a\xd7\x91\x82Qe\xd6\xebw\x90xI\x9e%\xf9h\xd2{4d\x93Z\xd2R\x9a\x1d\xa6S\x86E\xf7X\xc0\xaa\xce\x07nb)c\xa7\x07Y\xbc\xd47u\xc8\xa9\xe3\x82\x8a\xce\x89\xfa\xc2\x88\x88\xb2I\x9d\xb2\x99\xba\x9b\x1fVL\xf8\x83<{A?\xe5\xf6qj\xceiVN+\x1cF\xa8\xd4\xcb\xe6\x1bZ\xc1\xf1\x1f1e\xaa\xd8\xc9{\xb0\x9c\x13\xcd\xb4\x90L\xf7&\xcd\x8ac\xb67(\xe6\xc3\xe14m\xf3\xd3h\x90\x0c_l+\xcfE\xb1\x99\x14Z\x1a\xbb\xcd\x92\x04u\x8d09Y\xc6\x02\x89L\xc0\xc3}mx`@oUl\x87\x87a\\\x08H^q\x9c\xb1\x1cW@\xa6\x1b\xb6\\\x94\xa4\x95\xe7V\xef\x8e\x0b3\x1f\xc9\xe7\xbc\xd8b\x81\xd2\xd5\x1fN\xd3\x03\x8a7\x90&(\xac=\xc8\x86/;&i'#\xce\x08R_\xba\x1e~\x85$\x9e\xf5G\xf2\x9d\x1dx\xb7Q\xe8\x10\xd6\x15\xed\xa6\xd7,@#\xa4\xbbA\x9co\x1f\xab\xfe\x00\xef\x1b\x98\xac^\x81\xcc\x92\x97r19\xae+\xe5\xe8\x9b\x1f\xfeD\x89\xe2\xe1\x8b\xdd4\xd8\xb5\x84=s\xdb\x1a\x82\x04L>\xff4\xb7G\xde\x84\xa5y\x11C\x98\xca\xb0\xa5\x15]\x1ckZ\xbd/\x9e\x85\xcdq\xfa\xf6v\xdd\x084Y\x850S8\x1dy\xdd\xd6\xaf1\x18\xec\x1c!\xab8\xa6\x89I\xaa\x14\x9f\xdb\x97\xea\xf0I\x0e\xee\xbe\xbfm \xc9\x13R7cFR\xe7\xbb`Y'k\xda\xca\x92\xfc,\xca\\\x80\xf8\xbd\x10\x95\xcb\xfa\x12\x03\xa3\xceW>P\xc9y\x9c\x86\xd7\x14X\xa6;,\xaf\x10\xd5\xef\xf7&V\x11\x958\xd3&Nm\xe5Y\x98\xbb\xbf\xc3\xdf;\xa4\xc0sA&T\xf5\x91H\x9a\xf05\xd3|2\xe8\xc6W\xcd\xac\xfe\xd01\xbe\x1c\xe8\x9fH#@\xa7\x8d\xd3Y\x83\x8b\x0d}R\x0e\x13t|\xaf\x02\xac\x9e%\x01\x89/\x93\x8b\xbaLv[r;\xa1\x16\x94\x07\x0fF\x1fp\xa0p\xb3|Z\xf8\xc5\xf7\xdeM\x03\xc3aN]Z\xcc\x11\x14\xf3O\xd7\x003\xc5m\xafu\xa9\xe6\x1a\xf6|\x121\x07\"d/\xfa\xd8$\x91\xbdH>{\x92)\x1a\x0e3o\xda\x1c\x87'\x8e\xad\xea\x96Z\\\x1f\xb8\x13-\x98\x14\x7f\x94\xe4\x0f\xc40T?i\xfdt8\xdc\xea\xf5N\xaf)\x13\x87xf\x9aC\xf3\xbev\xc2V\xe8\xa9(!\xe9\x15\\\x0bGHp\xea\x03ml\x01K\xa7\x18\xc0\x00\xb1\xf5~)\x89M\x1fE\xe2>\x1cn{DL\x9b\xb7&\x14\xedm\x09\xcd\x09u\xf5.\x07\xd4s\xdd\xd5\x97\xe4p\x1e\x12O\xd6\xa9\\C\xfa\xf3\xa4\xab!s\xba\xe9k\xac\xdf\xb4\xacW|\x0d\xd5`\xa7\xc0\xe4\xac\x90\x95\xe17L\x06\xe7Q\x94\xdb\x0f\x12-\xd1\x1c\xeb3%\xe1\xfe0*\\v\xa7l4\x97\xbd\xab=\xc3\xe1NR\x93\x14=\xbdTpP\xc9\xd0s\x11\xc4\xc6\xf3\xfa:M\xf0\xcb\xf9\xd3\xe5\xed\x09\x13\xa2U\xc0\xf6\x1d\xb7\xcd\x0f*\x0c\x9eP\x87\xd9z>G\xf2\xb3D\xff\xf6\xbc\xc33\xb5&a\x84\x94\x0a\xeb\x8bi\x02\xa2$\\\x81Y\x83\xb3\x1f\xc9\x8a\x9d\xe2\xffO\xc4\xea1\x1c\xee\xe6\xb8\xaa\xee\x01\xb0\xc3j\xbfi\x97%\xe7\xa1A$\xaa\x92\xdan\xaa*.\x01\x19q\xbd\x9f\x84\xdc2U-g\x04\x19\x8e\x08\x1a\xdbz\xe8\xe4\xe2\xb3Qh\xf4]\x91\x84\xeeD?\x96\xee\xf0Dv\xebw\x12\xdc\x95r\xbf?\xa5\x804\x1c\xee\xfd7\xa0\xf4BD\xb3\x17\xc0Q\xf18\xce\xd9\xe1\xd0\xd4[\xe3\x13\xde\x92\xa8\x8e@\xa5\xd1\xeby\x98\xbc\xef\x90>?\xb6m(|[zE\xfd\xdb{\xd3\x08\xc7\x99\x8cz\xd5\xeb\x1d\x0e\x8c\xe1\x93X%/^=R\xc4\x0c\xd0\xa6\xc1\xf0\xf9B\xd3l\xc5\xc6\xd0zA^\xb0\xa1fQ\xa9\x97\x11\x9a\xb9\xfa}\xc7C\x1f&1\xe4\xa5\"\x14\x9e&\x04\x81\xf8%\x82\xcd\x04\xa7:\xf7\xc5o\xb1\x14\xe6\xb0\x19\xc2\x09S\x02\xf1\xd7\x05\xa4\xc0`\xab|\x1b\xd3_\xb6\x91\x14\x88\xbe\x82\x13_mx\x9f\x8f\x14\x95\xad\xf8>\xaf\x19 \x95k\xb5gjh\x9e\x9e*\xdf@\x99v8\xe0\xe9\xc6\x8bw\xd0\xba\xa9^\xc7\x9e\xach\x04_C\xb5y\xf58\xb0\xefp\xeb\x85\x0a\x9a\x11\x01tb\x91\xd6r>U\xb03\xd1\x94\xf4\xfc\xe36zj\xb5\xb7>R\xe2\xb4\xc3jn\x0d[\xc8\x827+\xfd\xbb\xf4\xa7\xaaF\x001n\xb8l\x0c\x8f\xdfu<\xdd\xd6\x7f\xeb\xfa\x12\xa7t\xea\xbb=s!\xd5\xf7Ru\x85n\xd2vG\xf4C\xed\xb3\xb0\xad\x0d-\x17f\x19\xbb\x85P\xc1\x19Y\x15\xdb\xad\xe4|O\x01\x8e3*S}\x82xHW\xcc\x03\xf9]\xd2\xc1\xae\xd7$\xcb\x1b\xa5\x07\xdc\xa0\x97\xb6\xe6\x86\x9dS\xc3\xad\x9d>Y\x09\xa7H\x9d,W|\xe07\x10WhM\xdcH\xc7\xff\x8a=\xaev\x10\xbb\xb3\xdd\xf1\xc4~\xad+|\xa8\xe6\x85\xbb\xe1\x07\xad \x9c\x13`\xeb\xab\xe74\xdcr\xdcc\\\xc5JJ\xe1V*\xb9\x0b\x9ed[\x7f\x14\xde\xe4u\xdc\x7f\x18\x06W-\xe9\xc5\x12n\x06ct\x9a\xb4\xf2\x83k0\xa6A\xc8\xeb;\xdd u#\xb2@\xc8\xab\xbd\x8e\xf5\xb5$d\xcd^\xeduOJ\x80\xe1\x96%u\xae\x9c\x82\x07\xe7\x05\x01\x83\x1c\xfeNO\xa8\x08oZP\x1a\xdd9\xd2\xd6N\xf0~\xf9\xd1\xad}\xcb\xdbBX\xdb\x91\x94\x13\x13\xcc\xdd\x03\x1f\xec\x15\xa9\x8e\\\xbdA\xb8\x94TL\x16\x98\x08p\x0aM\xb6c\x09\x0b\xed\x9b,f\x99\xc7\xcd\x9a\xb4\xf8\x191\x99\\5\x13\xae\xf9\xd9\xe7\xe6\xc7\x8d\x93\xb3\xfe\xa1(<(M\xb5\xf5\x0b\xb1\x0f\x9b\xb0\xb75c\xfd\x15Y.\xfb\x88\xfd\xcbI\xa3r\xa0D\x88\xfa\xd3\xf6\xc7\x1bc\xf3|\xa4\x1d\xe5r$\xb6~\"\xb1\xf5\x08\x80P\xa3E%\xaf\xe6(\x8c\xd8\xbc\xfe\x00W\x93a(\xe7\x09,m\x0f\xe7H\xceMf\xb4\x0bK\xbe\x115\xf1p\xeb\xc0\xa1\xf3\x0d\x13;\x81\xbd~\x89\xf8\x91\xd7P\x8d\xa7\xd0W\x06\xfeRJi\x1a\x82\xc6H\xf7\xaa\xb2\xd8\xeb\xcbC\xce%*)\xbfd\xb0I\x17\x12\xe2D\xd5\xfe06}'\x10\x09W\x1e\x05~\x11\xf5\xccK\xc8\xa2\xbd\x14|\x1c\xe1\x18\xc3\x0fJW,'\x1a\xdd\xf7\xd4\xa6[i\xe7)&\x0c\x85\xdb\xbb\xfe>-@\xc1\x92\xc6\xa6[x\x82\xd4 \xed4\x89\xfd|M\x03\xc3\xad$\xe6C\xb7\xd1A\x96\xf2\xf0\xe3}\xb1\x04\xd3\x9c\x9a\x09\x18da\xad\x0c\xdaAq\x81w\x1az\xa7\x8a\xb7\x02r\xa3d\xed$\x08q\x86\xa51l\xc6xC\xccK'\xb2\x9b\x11-\x02gN\x8f\x04S\xaf\xe6\xb5\xc3\x85\xf5L3\xf6\x94z\xca\xf6\x8bt\x93\x92\x85\x06<\x14\xbe\x0c\x9c\x00\x1f=e\x8fr\xa0[\x0b(\x0eE\xd4\xe9\xb2W\x15E\xa1\xb87\xab\xa2l\x0f\x7f^\x17`l@\xa9\xd1u\xb5\xcc\x98\x9bKj\xfbrR\x1cV\xd4\xdf\x81_\x1e\x11\x0a\xa5\x15\xa20\xcb\xc8\x13\x03P\x80\x15\xad\x10eV\xdd\x9f\xd3\x93\xfaH\xae\xe4\x05/\x85I\x9f\x04\xd9\xf6\xd6\xcf\xee\x0d>\x03\xf5\xdbp{\xdb\xcbM\xde\xc7TD\xdc{o\xe2q\x15\x16\x86\x9a\xfb\xa4m\x90k\x08\xa5j\xd5\x0f\xaf\x16\x95@-\x0f@_\xa6#\x9d\xec=\"\x02\x9b\x8e\xd3`{'e\x8f\xe2\xdbY5\xb2WD\x88\xb2\xc8q\xa3\xb7\xb7\xf2\xa9+\xe4\xb8b}r\xd8\xce\xa5\xf9h\xc9\xc3\xe1\xf6\xee\xd3\x9b>-\x86\xf7H\xe0\\&\xba\xbf,\xf5\x13\xeb{\xb8\xd3-\x17\x89\x9c\xf0\x90\xceQ-\x93/z\x87\x1cA\x1bpt9\xd3\xe30\x0c\xcd\x01C8\x07`<=./y\xee\xf7IL\x00\x95\xd8F:\xc6\x0e\x7f\xf0\x1aBN9\\a\xd5\xae\xc2\xf8\x86KS\x1cR\xd6\xf9$\\\xc5\x02X\xad{b\xedj\x1c\x09M\xdf\x1d\x8f\x7f\xa6\x9e\xfc3zf\xdb{O_\x86\xe1?+\xa5\xa5\xd8\xaf\x05\x84\xd7\x82\x09\x10\xf5=c\xa6\xe9-\xb7\x1a\x8a\xa5\xf8\xf8\x8b\x9a\xeayz\xda\xa6r\xe4|F\xceso\xe9\xf4\xdf\x19\x87\xa7\x04\x92\xd2J\x8eL\xf0\xf1\xbdWh\x15\xe2\xfc\x09\xcab\xdb}\xdb\x10\xcd\x12\x10@\x93\xa4\x01\x84?{\xe4\xaf\xae\x82\xa6\xe7-3&\xa3\xed\x1bYY)\xc2aE\xcbQ9\xfc:\x95\xa9bZ\xdey\x91\xd6\x1b\x13iKJ\xe0\xd2\xbb\x93m\xb8\xcd\x8d}\x8bp\x8c_H\xa8T\x9e\xa0\x8dr\xcbSE4\xc0-1\x8al\xe0\xb2o4\xbe}\xd8\"\x99\x90\xd4\x9ct\x0a\xd4G\xcb\xfb\x84c\x99\xdcr\xb6\xe3\xfb\x12\\\x13\xb7\xc1\x1f\x8ev\xd3\xec\xba\xfe\xa0~K\xc9H\xcf\x80&\xdf\xd1G\\oI\xa2\xe1\x8e\x8a\x8fh\xd7\xe5LS\xdfT\x18\x96H\x06\xa6og\xfdPJ8X\xea\xb5;-\x82%\x18\xa7\"VY\x16Jt\x1a\xd4\xf2\xe6\xc4\xbb\xf76f\x1e\xd4\xf7F\x96\xe0!\xd4\xad\x15E\x9fF\x9f\x1aB\xb9\xd0\x9d\x11\xca\xbf\x1a</y\x0d\xcf\x15\xbf\xb0\x91\x96VM\x1c\xa8\xe2\x16\xc2\xc7\x80+S\x85\xa9\xec1\x09m\xafq\xf4\xca\xae-\xa6\xce\x8ate\xe3+8\x1a\xfd\xfd\xd7\xcd\xb0{\xbb\xb1\x88cP\x8f\x95\x82z\x8e\xba\xb4fM2\xd2N\xcb\x90ij\x95vji\xb2\xa6\xe6\xbe\xa9nJ3A\xa7}\x86|'\x8d\xa5r\xed\xb87.\xd2\x80\xdf;\x05ts\x96\x80x\xfd\x8bf\x02a\xf6\x83n\x8e\xea\xc11\xe1x\x8a\xa4hK\x0d\x8e.\x07Q/\xa0\xaf\xc8t!\x01i\x0b\xeb[{3R\x9f\x9e\x98\xfe\x07\xd2\xceV\xee\x8coU6.\xa1\xa5\xe1\x16]\xe3tu\x15\xba\xa4d~pb\xd6\x91\xec\x8b\xba\xca\xca^\xdbnU\xf3\xf5\xf5\xac\x84W\xbfb\x0eWl\x84\xfe\xa3~J\xa5\xa5bJ\xa3\xaa\xd7\xca{\xb1\xc7QT\x0fw\xb6U\xac[\xd6o\xe0\x88{\xa3\x83\x1e\x95\xd44\x18*#\x90\xe2 \xe2\xc5\xdb\xf4\xf8\xe2\xcf\xcd\xa0:\xdcy\x06\x82\xa3v\xca\xcf\x9f\xc5=\xe9\xa2\xb4\x15[\\\x95nZ\x99\xd4\x9fJ\xac7\xdc\xd9}\xc4n\x169\xaf[\xec\xcel\x8eU\xedam\x8ba\x0dT\x99\x06\x97\x956s\xee2a\xdb\xde+-\xb2\xb3\xd7\x97e\x95\x9c(\x11w\x03\xea\xa3\x19\x073.>`\x0e\xd0wRI:\x13\xa9\x82\xccZ3\xc6\xf38b\x1c\xe1\xce\xfcVzz{\xa9\xa8f\x0f\xa3S\x8b\xbe\x9d1\xfa\xb6\x85\\'\xb3R\x1f\x83\xd1\xdd\xd9O\x14d\x0c\xd0\xb8\xf4\x8a\xdc*\x14\xa9l7q\xa8{\x1f\x93\xde\x938\x00`{\xab?\xb6d\xda\xee!\xcd]\x89\xf7\xea\x17\xf0\xec\x1cx\x94U\xb2e\xcc\x1d^\xf0\xfa\xe6\x8c\xf3\xc6\x0cq\x84\xddT\xad\x0dT\x0ev|\x10\xc4\x92IRk\xc3\xc4b\xff\xd7=4\xc9c\xc870\xdb\xb6Qd\xd6Y)nL\x93\xa93N4\x8d\xf0\"\x0f\xf1\x9d\x10\x85\xb4I\x09\xd92\xab\xf4O\x1d\xef\xbe\xe8\x97\x9e0\xe9\x1a\xe9R\xfb\xa9\xa94\x01\xd3\xc1\x86[RM\xac\xe2\x12KR\x9a\xecw\x9fT\x8a\x07\xb1\xf7\x98X\x8bO\x8e\x00\xf8\xf7\xd0\x00\xd2L\xf5[f\xbe\xd5\xfe\"\xeeq\x9auG\xfa\x1f\xfa\xbb\xad\xb8J\x1d\xc8\xab\x83[J_)t\x1f\x09\xf6DN\xa1\xb9T\xa9\xfbIZ\xf9w7\x04_n\xb4\xbc\x14\xebt\xd7Z\xba\x04\xbd\x91\xdb\x84\xc0\x0cS\xefv\x84\xb3\x87\x89\x80\xda\xae\x7f\x06\x9a\xf3\x96@\xe3'b\xc8\xd9\xddN\xbai/\xc1a:\x86\xf7D\x1d\xd2\xb6|I0U\x94z\xa9\xd2\xd4\x0b\xbe\xa1O\x14\xc0V[V\xfc\xa2MW)\xd5;\xe2\xfc\xa6$\xc8\xfa\x1fw\\\x058\x06\xc6\xd5\x95p)\xe5~\xa1\xeb\xe9\xa87\xacPQ\x1d\xd6\x09\x0c<v\x98\xbc\xec\x92As\xf5\x92&\xbeTP\xc9\x90&;B\xc2\xb7\xf5R\x8c\xe7M4\"4\xe7*\xaf*\xe1x\xd2\x93^_k\xedDt\xf6\xdf\\\xcb^\x15\xb3\xab\xb9%9\xbe*q8\xc1\x01@R\x97\xe28Qcw\x9a\x0a\xf8" +
+	"\x9b)x\xaa\xf3\xbc\xab\x90\xa9 \xfd\x1e\xde\x12o7\"Z\x09C\xff\xf3\x01<\x81\x15Wd$\x01\xb1&pD\x9dA6Y\xf2j\x1ar\xca\x93\xb0\xe0\xb2\xe7]\xd8\xa9\x17\x0a\x16\x9d\xbf\xe8\\\x85K\x0f\xe2\xecO\xaa\xdd\xd8+&=\xdf\x18\x0ekq\xe1\x1c\xd121\xa3\x8d{\x88\xd0\x0dww\x159\x0a\x17\xb2\x00+\x1bj\xe9q\xe4\xd2[%\x16\x0a\x0b\x00\xf8\x8bX\xcdn\xc9\x93b\xe6v\xc9]Xr\xbd^\xa2\x84\x96\x88\x13\xd7\xff\xa9E\xd1\xf0X:\xa7\xeb5\xa7\xeazs\xc4ZF\x96\x95\x12\x97\xf8G\x98\xaeQs\x16\xcd\xfb\x18\x09\xa2\x162D\xc8\x92\x12\xa3\x0cx\xfa\xe6\x1a\xd2\x84\xd6\x9e\xabrO\x10\xd9\x1a\xeej&\x8a\xec\xfdsc!;\xa9',F\x8d\\\x1ci7q\xff\x88ew\xdf\xe6\xe4\xd3\xbbK~\x11\xc1\xffnTk'\xa4Ht\x83\x05\x87\x19\xb1\x0b\xd6\x8b\xf8u\xe7\xf9W\xaaQ1/\x9b)\xee\x8c\xae\xb5\xf8\x14\xf8\xaf\x10\x80z\"%\\\x8f1\x8c*C\xb0RQ\x17_\x92Nk)\xd6\xa10_\xbc\xe4(\x95\xee\x83\xbf\x07\xd3\x10#\xb6\x0b\x87*ub\xec\x8c\xe84PR\x82cdp\xd7!|l\xb8\x02\xab\x86\xcb=j\x91\x87g\xef\x1c\xb1\x19\xd6\xfa;\xcd\xba*\xb95c\xf0Q\xe8\xecq\xfa\xe3\x14\xcdL\xbf.\xff\xa0!1C\x00jo|\xda\xee\xa1\x87\xb4\x9b\xb0\xe8lgK\x88\xde\xc9\xd8j\xc6;\x9e\xb8\xa5iz\xb8\xaa\x1dW1P*\x9a(\xa4;\xa7\xdd\x83\xac\xa2\xb7\xe2\xeap\xef\x85=\x0c\x84\xc7r\x9e\xe4\xf1\xf5\x9a\x8e\xf6\x90c\xda\x1bB(N\xb4S\x91<\xdf\x07j\xc9[pc\xd3%\xe0\xf1\xc7\xa0[:w\xf6\x18\xf7z_X\xfb6\xed\"\xf1\xec\xab\x819\xdc\xd3\x1c\x92\xa8\xf8\xff\xc6\x9cV\xf5\x8a\xdf``+\x921\xd5\xceQ\xba\xa0:F\xa7U\x1bI\xcb>\x9d!FRn\xc9\x07H\x1b?62K\x85\xab\x1e_\xd3\xde\xdb2\xfdY\xc7\x8c1\xc7~\xf2\xf0\x1a\xb1F\xe9\xd0\x10\xb3\xd9\xaa|\xfd(7\xe7F\xd7\xca\x9c\xb8\x8aL\xaa\x0ch\x13\x08V,\xa9\x95\x96X\x96_\xf5a\xa0\xb6\x01\x81\xc8\xd8\xd8\xe6\xe7\x04\xf0\xd5\x8b\x08\xb2 _\x0e\xbf\xeb\xd5s1o\x0f\xf8J.j\x9d\xe6\x82\xd5\xc7\xe55\xf7\xb6\xf3dc\xa7D\x0c\x94\xa5\x1b\x93\xb4k\x1d?\xf9\x06(\x01\x02\x9b\x83b]F\xd3pa\x96\xbf\x0a\xaaT\x02\xba\xfd>\xd9N)\xaa\xad\xdd\x81W\x13\xc3\xc2Y36\x1f\xc6v\x86\xe6Hh\x17\xad\x9f\x03\xf2\x0f\x86\x1a\x04\xca\x01\xbc\xf3\xe3\x87\xd2M\xa2\xe7F\xbd\xc9\x05_\x19\xce\x0b.\xbcQ?\xa8$\xae\x8b\xb1\\|\xe1\x89\xealN\x82@h\x9e9\x05\x9e\xc3bP\xbfE\xef\xa9\xd3\xbe\xda\x88\x0fS3\"\x11\x09\xf4\xe6\xef\xc2\xff\x87{\xe6$\xc9\xf2\xc81{m\x81P\x9a\x0a\x8e\xdew\xcd\xbc\xe5\xda\xe0\x84\xd0\x8f\xd3\xda\xb1\x8a\x87\x97\xc2\xbawo\x0c\xae\x1c\xad\x9a\x0b\xfd\xb0\x18C\x0b\xbc\x92D\x07O\x82\x89z\xa3?\x18GAI%\x0cA\xde\x82/\xe8\x9eei\xaeo(\xe0\xfd\x01\xc2%\xd5\x05\xac\x9e\xf8't\xd6\xf6\xf6ZN\x16\x8b\x1c\xb8\x870\xedVs\xa4Y\x9e\x1eka\xf4\xe7\xb2\xb0R\xc9\xf4\x8d\xb6\xc5\x1e\xcb\xbf}\x08C\xe3\xf8\x0c*\xf9\xc3\xbd\x9e\xf8@_\xa1\xf8\x9d\x12\xd0\xec\xe79%\xf8F\xf8\xf1\xe31\x8e{\x07Oy\x87\x1f)w\xcb\x85\xe9\x9f\x13w\xf8\x81\xb0\xfaG\x1d\xafy\xe8\x97R\xe5\x05\x0a\x17\xdc\xf4\x98\x1e}\xb9\xfbT\xc1 \xbb\xc5!\x03\xdc\xc3'\xde\x7f\xd1\x0b?\xa0:\x1dh\xd9P\x86R\x8b\x0fi\x92\xa12C \x0a\x87\xf4\x0a\x8e\xf0\x0b\x8b\xc82\xc8g]Xsd\xd5~\xa6\x8e\x9b\xcd{l\x1eOZ\xb7?\xf4&\xe7\x04\x82M7~\x95\xc9\xc9\x8f\xe1\x8b:9\x89\xab\xce\xa7QH\xe5\xa7Z\x8e\x91TJ\x83\xa6W\x12\xa66\x13\xf1\x0d\x95\x0d*3\xd9\xa0\xb2#\x1bT\xe6t\x07\xb0\x02L\xdctR/+\xf8\x19\xd6\xa7\xb4\xf7A\xe7\xa29\xd5P\xbf9\xe1vz#\xf2\xfd\xbaTf\x94_\xe1\xa7\xf5+<\x96\xaf\"\x83~s\xd2k\x07\xf8\xe3\xed\xe4`N\x98~ \x12\x9a\x9f\xc5\x0c\xf8\x82\xfc\xd6iF?\xffTs\x80\xd1\x1b\xad/\xa1\xbd\xa6\x7f\xa6\xbd_\xfb6\x94\x9dj\x81\xad)\xa8\xd4\x17\x99\xc5\xea\x8eGp\x80\"\x04\x80\xa6\xc5\xeb\xf4Q[\xc0q0T\xbcY\x90YT\x8e\xc6$\xff\xccs\xc1\x95/\x99\xa5\x8a$\x15E?\x8d\xf55\x92K)@\xcc?\xd2b\xdc\x83\xf5<\xb1J\xee\x98\xc3\xeb\x0f\xd3\xdd\xdf6z3\x05\x81`\x9e\x9ft\x07\xa8\xfeK\xd2q\xea[\x94\x9d\x91\x1fK\x8cH\x03\xe61dvE\x13J\xb6\xbd\xc9\x95\x9a%\x91\x1cV\xb4\xd3\xa2\xce\xc9\x87\xd5\xfa\x15 is\xc1\xd4\xa9\xfdr9\x1d|I\"\xc7\xdc\x87\xc9\xeaYXj\xf6w\x9e\xcf\xcb\x08\x95\x8d`\xb4O\xc0\\\x9d\x89\x17q\xdd\x09K\xe8\x99IH\x94\x18Ar\x01~\x93\x82\xe7\xfe\xee\x13\xd6y6\xd7\x93E\xd7if\xc9Y\x86g\x0a-\xabV[\xf3\xa5\xdax\x91\x13\xbaE:\xca\xaa\xee\xa3L\x01\x0a\xa1_E\xc6\x15\x96\x83E\xc1\x96>\xbe\xce\x9eG\x06\xee\x11\xb1<\x86l\x85\x9d%\x8el\x0a\xa9\xd0#83\xd6\x19\xb2\xb3AsBU\xa2b\xa0\x14\x832'\xe0\xa9\x8c1Q\xace\x09\x84H\xf0\x09\xec\x9a\xc8N:\xe2\xc3\x12\x1b\x1581A\xd4A\x91[\xb8\x0a\xd8\x02\xe1\xbfL\xfd\xb6,>\x88\x8b\xfa\xca<\xa0\xd7\xe6P/a}\x86>\x10\xf8\x037\x94\xf2\x93\x97\xc2\xad\xa8_\x01\xf3F\x09\xf5\xe3)\xfa\xf3\xb4\xde\xed\xfb\xd8\x1b\x0be\xd5\xd7\x90\x1c\x0fd\xcbZ_\x8d\xec\xcdT\x90P\xbc\xc4\xc7\xaa\x9aq0\x8f\x08\x82\x8c\xcf\x80\x0d-\xb1l\xb1\xae\x80\x09\xe4-rX\xcd\xe4K*\x86\xf7X|iS\x8eW\x0a+\x95\x08\x9b):\xe8\xc7T\xa4\xe2\x823\xda\x18\x7f\xf3\xd7_\xfd\xe1\xcbO\xe0\xdf\xeb\x7f\xe2\x0e\xd9\xfd\xc5\xe0\x8bO~\xfb)4\xbbL\xd6\xeeC}[\xff\xf0\x8b\xcc~z\x86\xde\xd3K\x829\x19\x1b\x15i7\x8f EXeK<d\xaf\x0bN\xa3\x0ag\xc7a\x80L\xb0\x9d=)\x91\x14\xd1,1\xe4\x8f\xe3\xf9/\x13\xf63\x04\xd9\xf0\x99\xb0\xe4\xae\xab\xe8\xf3LP\xb8\xdaG[\xdaF\x16\xf9\x98\x84\x05\xfc\xc5\xe0\x97\x9f}\xf5\xdb\xcf>\xff\xfc\x93\xdf\x84J)f\x8d\x93\x03VD\xcc7$\xf2o\xfe\x0c\xe5\x87\xac\xf1\xdcz\xce\x1e\x00\x1e\xba\xf8\xc9z\xd1\xd6\x0f\xe8\xaf\x86\x01\xf6}\x04\xbc\x110H\x12\xbe\x97B\xc3xFP\x9c\xa5\xbd\xe2\x98W\x0b\x91\x98\xf3\xe9\x91\xf4\x99\xeb[\xf3\x9do\x11\xcf\")\xe5XZ/\xf7\x0c~K\xa48\x065\xcb\x8at-\x94\x82l\xec4\xc7\x12\x9f\xe1m\x87\xbe\xc3\x9b\xf8\xb4JU!\xdc\xe9j}\x16\x80\xde\xd7m\xaa\x08M\xfdM+\x92\x81Va\xdf}\xf8\xd3:*\x9d\x82\xd3\xc4\"\xef`\xa9DfP\xe9\xbe\xe9\xce\x8cx\xe4*9\x01=`U\xd2\x9b\xbf\x94\xfagH\xb0\xf3\x80Q\x1e\xdb\x11\xdbe\x05Q\xc7\x85\xc8\xa8\x10\xa1\xca\x1cx\x00L\xbc\xdc\xeb\xb2L\x93\xafR5\xb1\xd5\x92\xa7\xa7R\x84y9\x12\x97\xbc\x01\x08\x1b\xe2\x96Y4\xb8\xcay\x84i\x98\xecN\xe5z\xd9\x0d\xb0\xd1c\xc2\xc2m\xc6+\x0dKR\xbd\x04\x03J\xe3v\x9b\xb2\xa4F3B\x0f\xb6\xf6\x08\xa7\x10\xda\xact\xd8\x1dN\xc4\x13\xb4\xb8\xb1\xca\xa0\xf0X\xa9\x88s\x9a-/\x83\x09\x7f\x1d\xd7\x13\x81$\xd5W\xa4z\xd4\x1b\xef\x0b%uj\xde\xc2F6_\x9a\x82\x18\x1a\xc0\x88\xe8C~\x11L\x00\x15\x1a\x80W\xc6^U\xe7X\x01\xa2\xcb\x0b\xa5\x1a\xa68r\xeb7\xd1\xf5\xd7lf\x82K\xaf\x0cU\xa6E\xca3'\\\xdf\xc8\x1c6I\x8f\xd0\xcb\x10p\xceI\xa4\xbd\x92\xc9]\xf6]\xd7\x97j\x8ebBH\x97}\xc1\x82}\x1f\xbe\xd33\xcbN\x9dYI\x16\xd0Pu\xbc\x0e9\x80x+]\xabW\x1f\x09\x13\"\x1f\x8c\xa0\x10\x136y\xc6\x8d\xc2G(\x920\xad^\xc2\x0f'\xf0C\xf7\xc4_\xdf\x1a\xe99\x90\xbb\xf0\x94\xd4\xea\xd7\xeb\xc4\xdc\xb3\x94f\xe2N\x0c|a\xb8?YD~\xfdnk\xcb^\x7f\x80\xcd\xca\x82\x07\x938F\xe1\x7f\xdfAL\x95:!K\x94\xc7^_,\x88\x14D\x9fD\x98\xaa\xae\xe6\x0e\x85\x09?\xb3\x88\xf0\xb3\xac\xa5\xd9\xc9\xa2\xc1\x95\xfaE\x14\xc3Cc\xc0N\x9c\xa9\xa0\x00\x1c\xd6\xfd\xd2\xb1\x85\xeeZ\x0c\x90\xde\xaaR\xf6bn\xe4\xfc5!\xec)v\xc2\xad\xef\x18\x06\x94\xea\xb5\x95l\xf0\\\x0aJ\xb8\x8aIb5c\xa5\x08N\xac\xf0\x06?\xbe\xed\xd1<\x16\xc0a}\x0d\x94\x9b\xbc\x83\x85>\xcd2\x14\x9cf\x04\x07\x11F\xd0\xaa\xd6q\xf2\xf4\xd3\xea\x8e(\xef\x1f\xa4\xe6<\x06Tm\xa7\xd5\xae\xea\xafO\xb6\xf8cWB\xfd\x81\x0e\xf4\x8c\xd2m\x1f\x0e7\xba\xf9\x99[\x0dR\xf7\xa0\x8f\xd2\x1fe\x80H7\x01BPHt\xfd\xa4\xad\xf3=-\x11\xf5\xba\xcc\xf3R\xd7I\x87\x93p\x1d\xa8\x87\xa9(V\x9dL(\xed\x14|j\xd5\xf9\x98\x98\xb6;\xc2\x9d\xddh\xfc\xc2\x98\x85\xea\x15\xc3>\xf0\x02\x85\xe3\x03\x09Z\xcf\xfb\xf1\x00\xcd\x81\x8fo\xc2\x1e\x9c\xea%\xb9\x01\x04\xe1\x07\xdc\x84H\xd9DY\x1aJ\xa7\xa8B\x83\xe1#\xd3\x13\\\xbf&d\x1b\x011\xb2;\xe59\xa8\x9eT\xdb~\xc9\xd6Q\xa6\xdcr\x8d\xfb#P'\x8f\xde\x86 \xcc\x82YnN\x9c\x83\x16\x83\xe1f&>4\xb7\x82#n%;\x9dq\x91\x83\xfd\xa3v`\xf0\xeb\xcbH\x0e\xa0\x08\xcc\xf0\x96\xa4\x829n\xbd\xe51D\x18\x0b\xcbnFi\xa4R\x06\xee \xbc\x8a}H#HE\xc6\xed}aQ\x06\xe952:\xba\x96\x9b\xed\x8e\xbc)\xc9\x8c~\xcd\xfd\x0c\xdcJ&J\x87\x19\xe6\x0c\x10\x10\xfa>,\xdb\x8a\xaf\xc7n\x098\x91\xe2t\xd2l\xac\x05\xe7\xe7P,\x8aS\xfd\xe4\xe7R\xc6\x0c\xec\x01$\xa5\xd9#}\xa7\xb1\x1d\x1a\xc4\xcb\xe3\xe9\xce\xb6Z`U\xdb\x023\xa3\xa0Q\xef\x86\x960\x83\xefP,\x1f\x19\xe1\xe6*V\xc00n\xbdF\x0b\xc3p\x7fs3\xb8zY\x7f\x80\xc5R)\xd1cQ\xfe\x08\\\xd7q\xf0\xac\xf3\xe9\xaa\x82\x89f!v8\xf0\xcf\xf5\x12\xe0D\x8a\x18#k^\xf6\x8db\xa9\xf5&\xd4\xf3\xfc\xd0\xe1S\x1c\xebH'i\x03J(\xe2N\xb10\x1d\xbeq\xac\xdb\x13X)\x13|D\xde\x96\x82k\x09Q\xcbh\x06>4\x85\xfd\xf3\xe3=\x1c\x95H\x0dOV\xbf\xa1\x03\x99\xa9\xa2ac\xacZ\x1c\x9e\x83\x9f\x8b8\xd2\xd2W\xa4\x84\x91m\x14\xe4OO\x0d\x19\xc6\xc9\xb1\xcc\xdb'\xac\xd0W\xc9\x9f\xe8<5D\x93\xb0\x9f\xfct\xce\x13fXr.\x9eu\x934f\xe7W\xb7\x87D\xc9\xf2\xc2\xe5(x\xde\xa2\xb4)\xb9*\xacZ\x94G\xc4\x8dq\x0d\x0c\xe6\x0a\x83}\xd6\xa2\xf1\xda\xd1F\xed\x02\xed\xd6\x7f\x8c\xa5\x13\xe2\xf8\xb0\xfd}\xb03=\x1c9\xea\xbf\xdc(\xde\xd7\x18\xb3\xf5HV\x0f\x9f\xa34\xd1a\x02\x97=M \x1b/\x12\xb0\x94\x1b\xe5L\xf5\xf2/\xc2\x01\xb8>)\x0c\x9b\xd2,%\x0aN\xc1\x85\x10OFPB\x91\xff\x1edfM\xf8\xd8\xcd7\x9d\xc7\x02F'\x81D\x0e`\x01\\\x84\xbff\xa8y\xba\xf6\xd1p+!\x9d'g\xfc2/BbO\x9bX\x1a\x0aQ\xc6\x80\xc0\xf3+\xf4\x8b$\xb2\x1e\xba8\xa9Ji\x9bg\xc3\"\xc4O\x9b\x19\x8cc\xc8\xd4\xae\xb4\\\xccB\xf6\xf8\xaa\x96\x1c\x03\x96\x02\xf1,f\xe7SG\xfa\xf7\xb2\xa3\xecH&3T\xeb\xb8m\x10I\xa2\xb2\x96\xd6\x10\xc5\xe2\xc2\xce5\xa2\xcaf4\xef\xf5[\xf2^u\xac\xa7|1\xb4\xd2\xef\xcd\xcd\xe5B:\"'\x18\x81\x8a\xd05\xc2\x88\xb3`\x11p\x1dW\xac\xd1f\x17n}\x07\xbb:\x00\xd7\xc1\xa8\x1c;\xcas\x02\xb6\x90\x1ca\xcf/\x92%u\xa8\xd94\x16\x06\x05\xafZ\xe5'\xec\xc63+rJ\x0c{G\x19\xab?\xda$4\xe0\xaf[\x88C\x9f\xa7u{L:\x85\x17\xaa\xaf\x02\xc4\x8a\xd0H\xd0\x16\x92\xad\xd4*\xd9\x07\x81/\xe4\xe1_\"\xa9\x0f\x12i\x8c\x1c\xeeRb\xdf\x89O0\x800\xe3\x14\xa4B\"\xe4\x9e\xaa\x85S\x84s\xd6W\\\xa8\xb7\xcaK\x90\x82o\x8ea\xa5Y\xec\x8b)\x11\xbd\x85\xed\\\x0e@\xf6\x02L\\\xcc{\x09\xfb\xf8\x9a,\xcc\xd4E\xc38\x0a\xb5\xa6\xa5\xdb\xfb\x01\x84\x9d@\xe4\xe3h\x98\x9fb\xb5/|\xf3\xa6]\x7f\xdfaQ\x9a\x1b\x19\xa9\xae\x9c\xc4\xd0J\x83\xe5\x1b>\x0d\x8b\x86j\xec\x9eB\x86\xfb\xaa^\x91\xc3\xe8\x11\x19\xb8+r\xf4\\s\x8dL\x1d\x1f\xa3\x15\x93\x0dK\xa9j\x8c(\xca\x15\xd4\xcfT\x98\xa6\xbbz\x01\x97{\xcf\x9b\x96\x13\x11N{]\x86\x882a\x83\x09\xcf\xb5\x00\xcb\xa7\xc5\x153B\xff\x1a\x15\x13\xee\xf1\x8e\xb87;\xcf\xe2\xdd\xcdA\xe2Y\x04\xff\xdc \xadq\x82S\x8c\x8c\xca\xe0\xd8\x1e\\;\xb56a\xa3\xd9\xb0<)'\xf3\xc0\xb2\x8d.\xfc\x88\xcc\x8cQ\x9e\xce\xbdY\x1bk\xeb\x94\xaa+\x88%\xa6[\x9fY\x04`\x8b+\xaer\x80\x8c\x9b\xe3E\x9czCZO\x84\xb9*,\xa2\x80\x98\xcb\xca\xe0\xb0\xeb*\xa7\xa8 \x12'\xd5\xebxF\xd6\xd7\xb8\x00\xed\xfb\x87M\x18\xad\xff\x84ce\x93\x88\x9b\x12\x0f\x0b4)\x83\xbbLz\xbd}P\x82\xdfh|\xe0y\x0e\x1dx\xde\x82\x0d4\xb8m\x9cd}\x82o`\xd7\x12sK\xf1\xac\x0b\xfcniL\xa0\xca\x98t,\xe2\x87\x94\x9b\xcf*d\x12\x1e\xec\xfb\xe6%?\xdf\x1ci\x8c\x00\xc1\xad\x98\xd1N\xe8\xb2E\x86`\xa3\x85S\x03e\xb1\xe0l>1<\xfcY\x10\xbe\xc5z\x0f|\xc9\x97\x12vn\xf9\xd8\x1e\x9aI\x9b\x0c\x18\xf0`\xc7\x96\x96#\x95=\xb4\xf2\xd7yY\x95\xacl\xa0\xa4\xc9k\xd9\xe3\x07\x09S\xf9\xfaVR[\x8c\x82\xb9\xd3\xfaV>\xce\x14(\xa8\xf9 m\xa6\xd1\x0d\x08\x0b\x0b\xda\xbe\xe0P\x0bo\x92\xa3\xcfD\xb7`\xfd\xbd?a\x91C-Ct\xc5\x1c\x149g\xef\xc2q;\x05\xef\xaf\xe2\x0f\x0a,\xf4\xaa\xd8\x03c\xa93G\x1b\xf35\x87\x80\xa9\xfay\x87Py\xf2\x84\x83\x96/\xff\xdc+\x0e\xab\x05\x0a\xec\xcc\x9b\x0e\xa1\xefe~\xbd\x1c\xfe\xf9\xcff\xb9\xfa\x91\xc6|e\xfd^\xb7\xf37\x15N\x1c\xab\xd2\x82|D\x19\x94\xe3z\xa1C\xd9\xb4\x0c\xbc\xe1OT0\xe5\x00\xfd\xf92g\x1c8\xbcQ\x7f\xfa\xc96\xfbV\"\xdb\x86ynL\x1b\xa8\x06\xf1\xb0:9wTq#\xe6\x95\xcc)U\x83\xe7\xca\xdf\xd3\x13\x89\xd0\x8e\x9aI5\\\xd9\x10o\x99xP\xb9H\x10\x116\xc7d\xe8c\xd2\xa2\xbe\x92\xdf*Lw\xae\xe0\xd3\xe5\"\x98C\x0aH`\xdf1\x9e(\xad\xbe\xed\x96\xcd\x15\xda\x81\xcc\xb0\xbb\xf1>/lc\xd5-4\xa7\x8aq\xbe`\x04Q\x14\x95)H\x0f+\x87\x02\xb7\xb1\xe7\x8a\xe0\x90\x17\x0e<\xf12|\x82\x1c\x0f+\xdb\xe9\x1a;\x84 \xc4\xe4\x8c\xec&\xf5g\xa1e\xae}\x1e\x1c7\xb9R\x85\x1f\xa4Z\x8f\xc5\xe0\xbe\xc4\xdd\xbd`>jI\xa7\xac\"D`\xe2\x8f\xff\xcd3\x82]\x08\x9e\x9aE\xec\xb3\xb86\xba\xa9B/C\xaa\xb3\xf5\xfe:<\x10TQ\xb0\x15\xa6=|\xda\xdaNF\x0e\xdaM\xaf\x91\xde\x9d\xfb\x1b\xa6yJ\\\xd2W\xd54\xb0q\xc1{\xec\x129yTgQ\xa7\xdf,S\x1eC\x1c\x86\xfa\x0d\x0f\xc2k\x858&\xb2/5`\x0aC__\xf1\x1a\xe4\xd9n\xcd-'9e\xe9l;ev\x87X;?9;\x8fZ\xd6}\x16\xa5i\xb3\x9f\xa8\xb5C\xd5q\xbd?FT|=\x09\xbf\x16nR\xc1\xe8\xc3\x90\xb21\x0c\xa5\xc1\xbe\x08\x15\x01z\xcc\x13J\xd4M\x85bCh\xe1\xbc\xed^\xbb\xbb\xb9\xb5\xbb\x88\xa7\x9e\xcac+\xa9|\x0eNt\xa2k\x0a=Ww\x9ca\x8e\xf9\x9e\xf0\x9f#X\xe3a?6G\x11\xa3\xc3\xae\x93\xa8\xe6&t\xcdsc\xba\xc3\x9fFh\xe2\x98@?\xff\xb2{\xce\xcb\x9a\xa5|B\xabB\x91\x96AG\xb5KY\x86G\x05Q\\\x90\xe2G3\xe5\xecgA\x8cI>\xc7\x95r,\x0d\x94\xf6" +
+	"\x12\xfa\xc6R\xb5\x91\xa7\x8e\x88\x85\x05,\xb1\xf5\xc9{SHK\x85\xf9\x14Qb\x13\x8a%\xdf\xd6\xb7q\xa4|\xb2\x06\xed\xfc\xac(Y\x11s\xc84~W\x02B\x1b\xb6\xfc\x11Q+\x8e\x05-\x99\xf4\x11\x94\\\xa0\xe5n\x06\xcf\x13N\xa1\x18\xdf\x18\x90\x16\x09l*\xbfl,j\x1eK\xd8\xf7h\xec\x0a\xf9\xcd\xa4m\x9e\xaad\xf2\xb6\xa5[\xc82X@,\xd1u\xce\x9a\xe4\xcc\x87BRtu\xef\x9a\xa2\xfeP/\xf1\xb1\xc6\xe0\xd3\xc3\xf9r\xcb\xbd\x88U\xbdL^\x8eJUH\x8aC\x9c\xa6\xf5\x82\x1c\x89\x90~\x99\xa1\xf7\xeb\x92\xa5\x8dA\x19M^\xfdX\x8e\x94\x00\xf2\xc1\x81\x97\x94\xeb\xd1\x02=\xce\xe7;L(d\xe0\x96\x0a$\xc4\xb1\x09xL\x04\x9a\x10m h\xc2\xe4\x9f\xd00C\xef\xb5I@\xce\xd3\xfav\xe5+sh*\x96k,@P\xe8\x1e\xd97\xd7\xffB\x02\xe3\xd6\xd7\xde\xd6\x8c\x8d\x1f\x086\xf2`H\x13\xa5\x8d\xa6\xe0\xbf\x80\xbd_\x0c\xd8\\\xd3\x09-\xf8>\x19\xdbL\xaf\xb9\x88{\xf1I\xf0G\xa1\x0b=R\xd5Z\x8f\xe2\x1c%\x84\xa0\x12\x8b\xa5\xf5\x9b\xf0\x83\xb2\xcd\xb0\"\x10k\xc55\xf3\x98\x03\x9c\xc9\xf4\xfb);\xacD\xd5^\xff\x11\x1d~3<\xc2h\xcc\xa4\xa8\xdb\x1c\xd2:\xe4+<@\xc8\xf4\x16\x9b\x12H]\xee2YG\xd0\x83\x0aL\x9aodER\xbe\xbe\xd25\xa1gn\x8a\xb6\xa7\xee3w\xb8Ro\xfb-\xbc-\xa1M\"\xb6\xc05\xd2\xa4\xcau.\x02\xa4\x84\x09\xf4\x9b^\xe5|\x96]\x14IpII[\xc8\x05\xfe\x12m\x98lK\x12\x9f\x9fk\xb9Z\xc0F\xde\xc6\xd4B\xdb\xf9|\xdc\x14\xe2I\x12^\\G\xd6\xcaO\x82N\xc4*\x08\xc9\xbc\x99`\x9a\xc9\xdeI\x7f\xbb\xef\xbd\xc6n\xb2D\xb0\xc3\x87\x0c\x11\xe6oJy\x04\xe4H\xe3+EZ/\xe2\xc2LA\x87\xd9\xca[\x0a\xe6m\x1b\xb8\x00\xf0x\x98I\x8e\x96\xf2\xab\xd3a\x98\x94\xd9'ms\xd3\xb8\xac\x043\xbeRQ\x8d\x07\xa0Q|\xd3\x9c\x1b\xc7\xee\x99\x07\xe6\xd4\xefX[\xb4\x08\x89\x9c\x03r\xf7G\x14\x07\x8f\xadjqx\xd2w\x84\xc4[x\xaa\xc6\xcd\x11V\xb5c\x91H#\x88TG\xaaFZ'%\xa0\x90\xb3\x85x$t%\xa9\xe7h\xa6\xc8\xcc\xcf\xb1v\xe1\xbdl\"\xa6\xc6\xcb\xf0uP\x06\x9d7\xb3\xe6L\x8e\x96+\x0e\xae~\xd9\x06\xd0W\xcc'\xbd!r\xdb;j\x05m {\x95vZ\x98\x94h\x18{.V\xbb^7\xa3\x02$\xb3\x1d[\x84\xe7\xea\xf7z\x0e\xd1\xf3^@\xb3+\xdd3m\x81p'Z\xa5\xfc\x0e\x0d?W\xdf\xaf\x91W5QNPl\x85D\x9e\xee\xc3\xa7\x03\xd7\x07\xe0,;\x8d\xc8\xdecY\x09I\xa2\xdd\x13\x0b\x16\xa9\xd0\x0dKg\xc2G\xa0S\x90\xd8\xd3\x89\xa3pdz\xac]Jx\xde\x11U_\xd0\x02Ags\x01Z\xc2\x9c\xe8\x93\x0f\x9di\xea\xa1\x96\xb5\xb9/\xd6f\xbb`R&\xd7\x97S\xc7\x9b`\x16\x85\xaaZ\xaf\xf1\x0b\xf5\xb5\xc7\x87\xed\x1a\x1d\xf3V\x90\x07?\xea~\xaf\x03\x8b\xbe\x05\xc8Wx\x80X\xa4\xf4?\x80\x0e\xe6\x11 f\xe6\x00\x88\x17\xc6!\xc5\x86&g\xde\xcb\x04Dr\xc7q\xf9\x948\x09\xc7n\"\xde\xe1\x94\xce\xb0\xba\x9ei\xc6\xc2\x96\xe18\x94Q\x00xvi\xeb\x9a\x12\xbb\xb1B:\x9e\xd8`\xd1\xa5\x7f6\x83t\xc3iD#\xdb\x06\xca\x9f\xa0\xb9\xf6\x85\x10\xe02-8l\xea\xe7-f^n\xfd'U17*\xa0nV\x9c\xfd\xc9*k;\xbd \xe0\x09\x8f\xa8\xec{\xb8\x09R\xdbedV\xcb\xfa~wd\x0c\x1e\xfc\xc4\x9d\xd4[\x19l\x02\x91\xfb\x96;.\xd0\xc7\xbe\x8c&\x1bF\xee\xc4\x0a\xb8\x88E\"R\xe0V\xcf\xe6\xc46\xe7\xf4\xd4h\xb8c\xb61\xf9\xaeR%\xe0M\xec\x9an\xfd\xaej\x12\xa1\xdc\x1a\xdeS\xd2\xf1\x86\xb4\xe6\x12J\x85\xc5F\x08\xd1\x9c\xe8\x95\x07\x16\xf5\x14\xfc$N&\xf6\xcbB\xbb\x7f|8\x8e\xb9\x83\xd9x\xdd\x94 \xb6\x8f>}J\xa0\x85s\x02\xb5\x0c\xc1\xf6y\x15V\xda\x1b\xdb@!t~W\x922E\xf5\x8fW!4\x9b\x8a\x161#\xfc+\xf2\xf0\xda\"\xf4B\x0b9\xad\x19\xce:Q\xf9\xd4\xcc\xb3\x8a E\xf7\xc1e!\xc2\x19\x97=\xf1\xefu \xf0\xd3;\xfbB\x7f{\x93\x1dq\x89\xe5+\xdc\xe2\xe2\xbbQ\x97\x14\xf1\xe5\xb28I18m\x02Z1\x12N\xa3\x81%s\x11\xd4\xa1\xb4\x07\xc0\xfd2\x98a!5\xda\xfe\xe5\x91VE\x7f\x0f\xa4\x15\xf8tf\xfd\xf0\xb5\xa1c\x12\xd4\x18\xe6\xf5\xb2\xdf\xab\xe4Z\xd4\x1e\x81jF\x9e\x94\xfaCg\xbaJ\xa8~#5\xac\xcc\xfe\xc1\x8bh\"\x88c.dV\xcd\xb4\xbe\xb5zsK\x80\xaac\xb6\xea\x07JCr\x09\xbb\x04>\x93\x04\x97%\x12(\xaa\xc5f\x8c\x17\xcdiR\xa4\x8a|\x92\x96\xc7\xecl\x1e\xea\xc9F\x15\xd4\x9d\x9d\xbcBGB\x87\xe9\x8f\x85E\x09\x9c\x84\x9e\x8d\x97H\xd2$\xd0\xb3\x8e\xc4\x7f\x95\xf2{\xa2\\\x87:!\x12\"\x84\x029Z$\x1b|\xd5u\x0e\x92\xbb\xcdd]P\xc0W\xb74\xce\xd4\x8d\xc0fhy\x01di\x0d\xfb?\x98\xf4\x1fMv\xc0\xc8G\xf9\xc4\xd4\x92\xf30\xf1N\xd3\xde\xcf<\x19O\xe9e\x0b3\xbc<\x16\xa3r\x0f\xe1\xe4*\xd7\xf27[\xafe8\xdbH\xb3C\xb7=\x88\xb3O\xd3OjK\x91\x93gH\x8e\xc8\xe2q\x02\xb6\xf9\xe5\xbek\xadC.[m\x924\x9a\x80_\x9a\xaf\xf0OH7\x8b\x8d(\xffl\xe8\xc6D\xe5[2&\x1a*\x03\xd9\xa14\xd2\xdf\xc5?\x9ao\x96\x00\xd94\xdf\xc5?W:\xd8\xac\x84R\x99\xf9\xa3\xba\xee5\xe1\xd0n\xe2\x81_\x01J\xf5\x1dr\x97\x8a@\x0e\xb9\xe4\x0al\xdf\xe3\xf6\x0c\xe6\xd8\xa0.\xc7\xfa\x0a\x8esM\x10\xfe\xb3/\"S\x8a\xeb\\D{\x89\xf6\xe1\x08y\xc2\x9c\xa2\xc7\xb7\xb1\xf1\xc5\x8ae\xba\xd4\xf6\x95\x03\xca\x9e4%\x8dk\x05\xb2\x9f\xc4ed\xe1\xa9\\\x0bF\xfb\xa1\xdcE\xc7|W\xae\xce\x8fTc\x0f\x07\x03\xa4K\xc6\xd2\xdcWm\xc6\xfe\xb4)~\x9eG\xb7\xcf\x80\x1a{\x0f\xc1\x1a\x03Dc\x0cI\xac\xcdj\xf1;\xfb\xa6\x8fun\xce\xb0\xb9\xa7\x9bl\x06\xac\xdah\xb1\xd2\xc8\xfe9L\xa9D\x97t\xee\x82\x03\xab\x96\xd7\xc5\x9f]d\x88\x9a2\xf2\x1f\xcf\xb7t\xfc~\xa1I<W\xc3O\xe2\xb7_z\xe7o_O\xb7\xdf<\x983\x17\xfbz\x02\xba\x97\xd7\x12\xf5\x89\xea\xb6o\x02\xa1I$tZ\x99\x17\xa38\x16\xfc\xc2\xe7\x03\xaf\xc7Sw\xaf\xae\xe1\xd4!w\xf4\x80\x1b\x87\xf3\x0c\xd8&{\xad+\x8e\xa7\xf1g\xb2\xb1\xd5\xa0bS(1\xc6\xe6\x93\xe6B\xdc\x87$'\xf8O\x92\x98eL\xd4\x8f\x0b\x14.\x11\xd7\x93\xc4{W\x03\x8dI\xe6:\x0b4\x15\xd5\x0b\\\xe2\\yg\xf9\x8c\\\xbb\x93Q\x84Y\x01\x1f\xea\x83\xe4\x0fK\xd2\xf9\xa87\xa0\x9b\xfe\x92\xedk:\xe0\x84! \x0fo\x09\xceVwQt\xe70\xdd\x9a\x11\x0f\xfdg^\xc5\xcd\xa9I\x99\xccu\xd4\x1b#Sbi\x9frl\x0azU\x18\x1e\xbd\x17u\x8a\xd6GML\x90\x8c\xcf\x93P\x8cA\x9c~\x96\xb6\x17!\x91\xcc\x96\xc4\x16\x90\x85\x1ab\xf5\x12\xa6\xcbFUi\xfb\x94\xdb\x1d\xb9P\x10\x14\xeeo\xc9wu\xb6z\xd4\x14\"\x9e\x95\x8c\x8b\x90\xb6D\xb9\x07\x16\xa6]\xefHI\x91\xec!\x1fP_2\x9f\xbf\xe2\x9b\x0ac/3\x8e\x00\xdf9\xea\xc5A\xb1;|N\xd6\xebB\x91\xd5I\xc3\xa1\x1a\xba\x8a\xb6\x86.L\xec\xc0\x00\x0a&\x1f\\\x0fe\x92\x04R\xdf-\xe5w\x13n<\xb3\x9cMM_\x19B\x89\x88-\xd3\xb2^K\x86\x86S\xec\xb2\x83\xd5\xc2U\x8b\xc7 \xdcv\xb7\x14H\x0c%\x02\xbe\xf1\xe6\x09\xad\xbdL?*\x06\x04$z=\xebJ\xf4\xf3\x96\xf3R\xc9\xcd\x14\x8c\xef=\xcf \xe4\xdaxkKaL\xe4@\x8fA\x0d\x88^\x93\x97\x9f\xb1\x13\x98,\x07t\x17\xe7r\xb0\x1f\xebF2\xc0\x11\xb7\xe8J1\x13\x0c({%b\xaeh\x83\xe6\xc0R\x9ct\x9e\x08\x13\x0a<\xa0\x17\xa6\xe4\x8e\xa1f\x19l\x89\xc2\x0a\xf7\x05\x90\xed\xea\x8c\x9c\x1a\xe6\xbe2\x03'M\x11z\x19\xc7\xb0\x13\xaa\\V\x082\x85E\xca\xa8,\x0c\xcd5\xad^\x86\xc90y\xa4#`\xaf\x13\xdb\x96\xf0E\xf2KF\xa6\xeeKf\x9d\x82\x8c\xb1b\x81\xd0\xf0\xbcG\xb4\xf3\xec\xee<\x83m\x13\x96C\xb6'%\x8c&x\xf62)\xb4R\x96\x11\xd4\x06b\x1d\xbbt\x06\xd6\x96s\xae\xd7j-\xc9\x8fN\x95\xe2Xx\xa11\x9c>\xe7\x0eh\xde\xcd\x7f\xe2\x94T\xf5\xdb\x96\x9f\xbaZ\xd9\xb3\x806P\x90\x14U'l\x97\xd04tl\xb6\x09\x08=hj\xec\x13\xca_\xebQ\xb2\xc09T\x1eH\xbb\x7fgV\xb9y\xd0\xbbpKzSk\xebx\x96\x13\x1eI\xd6\xe0n\x16y\xa7D\xa1\xca,\x83\xb2E\xd5\x95\xe2i\xba\xa1HnE\x97@\x19JY\xdb\xa3Z\x12\x03\xd6\xa9\xc2-\xe1\x931\xa8\xa8z\x10o\xec\xee\x19~\x15\xff\xa0\xde\x00l\xd8=,\x16\x08q\x13\xcbki\xbfbec\x7f\xaf\xbb+\xffj\xfb?\x8boe~\x7fE\xdf+\xf8{\x0eO\xfcIS\x86\xda\xff\xa9\x0aTA\xf6\xce\xf3\x9e\x9f\x97e\x99s\xa7\xe1O\"\xd3\x99\xf3\x00\xfb\xb3\x1f>\xb1_n\xf7\xc0iY\xa6\xbe\xeb+\xcc\xa0\xf4\xe6\x15N\xa7D\x16\x81Z\xe7@Y6\xc2G\x98\xd3VR\xed)mk\xd0K\xef\xd3\xc0\xb9{(\xfa\xc2\x80\x04\xa1w\xb3\xfd\x09\xd2\x1d`\x0f\xae\xc8\x0f\xe39y!s]\xb2|\xd6\xccy\xcd_h\xac\xb59!D]/Jq$b\xd5\xdc:\xe7\x7f9\xab\x84.\xaa\x15 \xbaP\xbf\x02\xcf:\xc9\x8d\xf4\x09\x16\xf7^\xa4\xc3y\xc2\xa4\x10\xf9D\x07\x10D\\\x08\xa75!R\x0b\x1d\x1e\x11K\x10\xf5\xbe\xa8 \xebe`(5\x0a\xa5nA\xcfiN\x99\x8fQ\xf4!\x9aA\x97?_\xb5\xc8\xa7\xd4\x81T\xc4^A!\xcepE\xbd\xc60\x80\x84T\xb85\x16\xdaH\x0bA{\x1f\xb8\xb8\xa3\x91)\xdcr.\x8e\x15\x80\x0d\xb9H\xf8R\xb2\xe4\x92,\xb7\xd4\xf3\xa6\xb4K\xb5\x19\xc9\xdc\xde0c\x1b\x0c\x8cA\x92\xc0\xe5\xf0N\xc4~r;\x80\x86\xfa\xd7\x12\xd5\xce\x01\x8e\x82W\x9d\x9a\xad\x1f\x1b\x19^\x13W\xb3\xc3\xef\xe6\x84T\x12K\xdd\xc1\xa2\xd5\x1c\x93\xad\xeb\\\xf6\x8a\xd1R\x04\xa7\x9d\x9d\x10\x88\xad\xe9\xc4\x9am\xa8O\x04\xbb\x19\xa1\xd0\xb4P\x15\x1e\x05\x1c\xcaE\xd8\x8d\xb1B\x99\x1f\x8e\x0d\x88r\xf7\xb6e\x93\"\x1cih\x9c9W\xacv\xd0\x93j\xee\x0f\xd4\x06\xd4\x9c:/\xadF\xaf\xf8\xc9\x06\xa8?\xeb\xda\xde\xce\xc6L@\xb1\x84Q\xba\xb9f\x92\xea\x06\xa8\xfcYh\x94\x13zz-\xdd\x1e\xeb\x1fFC*w\x90hi\xba\x92;\xb3\xe0\x13\xf2\x16\xa70>\x03\xf0\xdb6\xa7\x10\xe7\xd2j\xc4\x9e\x10@4\x1b\xc8\x8d\xc3\xd2\xa3\xd5t\x8b\xde=ER^%{RrE\xc4\xaa\xbd\x16\x83 \xd4\xf3\x91\xba\xb0\xa6RB\x1f\x0d\x91\xb4\xb0\x94\xd6\xfagB'\x88\xa5\x1c\xa5k\x15\xb5\xb3\xb8\x0d >\xa8\x94\x01\xaf\x84X\xdb\x9c\x10m\xf5\x878I\x94`\xb9\xf2\xce\xce\xa768y\xf1\xe6\x98\xb3\xe8.\xda\x0bP\x13\xec\xdb\xe7\xb7\xd9nB4l\xda1\xba\x9d<\x8f\xbc\x9b\x9dq\xaa\x0b\x8c%?\xf3\xfa}e\xa9_eO=f[\xb3!\x0b\xe2|y-\xcbq|\x0d\xcdl\xabZ\x8c\x1f:)\xb4\xf7\xf6:FE\xa7\x99\x8f\x14\xa5E\x1e\xe5\xc5k9A\x04\xf4sb\x15CmN1qL\xa6\xbb\xd0\xdaJQf\x1b\x96\xbb\x19\xd1\x94\xe8w`\x9a\xdc\x0c?\xeeT\x97\x9e\xb8\x14)\xda;PW\x19K\x92g\x8c\xd7z\xc5\x14S\xce\xf5\x84\x02\xc7D\x87,=O\xd7\xfdG\xe1\xdf\xc1z_\x84v\xd8\xf9\xa0\xc7\x84\xb4\xd9\xca,\x12:\xd6\xe7\x1e\xf0\xa4\x18i\x9a\xb41\xf2\xd7G\xdfv\x85\x82$p\xf8\x99d\x86\x92g\x0eC\xb4\x1eo\x94\xf2\xcat\xf7\xdc\xb5\x83H7)Q\xf8I\xbf\xfc\xc4\x1c<!\x96\x1c+\xc9\x16-\x1d\xa3\x84\x7f%\x1f\x12taQR\xeb\xa7\xdd\xa0\xc7F\xf6=\xa7\x96\xfe_\xc5\x19\xfe\x14\x9a\x95\x8d\x16\xc5a\xbf\xeeA\xf7\x9c\xe9\x0dX\x05\xe5\x08\xf0T\x13\xa79\xad6r\x01\x16\xef[\xe4U\xe4\x8d|[e1\xf7\x95\xd5\xb4(\xa20\x10*Y\xd8\x01\x7fM\x85\x81\x0c\xaf\x8eL\x8c\x8b0\x09\x1bw\x8a\x1e\x05\xde\xfd\x17*\x06q\x04\x06r\xfc\xd3\xfdu\x05\xf0\x0c\xb9\xcb\xe3\x9a\xf0\x8dDk\xfdK\x04t\x8cm;\xba\x01\xf5\xc84\xaf\xd2-\x11\x0b\x17\xa1\x14T~\x84j\x9c\xa9)\xc5uz\x84\xf7\xb6\xfa\x1d$\xe0\xaf\xbe7SD.\x9d\x03\xae3\x04\x91\xfd\xbf\xd2\xcd\xe8R)F\xb2^\xc8n\x17Y\x1a%\xab\x16.\xf9.\xfc\x81(\xf2\x04\xf5\x80\x80\x8bu\xd2\xa4\xcd\xd5/;n\xd2}\x9c\xfb\xba\xedL\xef\xa9'n\xb3\x93~\x7f\xcb]\xc3\xff\x1c\x88\x8b\x1d\x05\x0c\xfb{<\xfbz\xec&\x10\x09Z\x90\xcc\xd2U\x96\x15HI\xb5.t_w\x02\\ \xa4\xcd}\xb8i\xd5\x1cKa@\xa3r\xa2\x04\x01\x89\xfc\xabkEI\xc9\xd1)x\x89\xe2D\xbd\x14-}\xa5mCV\xd2\xa6\xd0/\xe4\xa2\"4\xbfP3%S\x9e\xa7\xbd\xde\xdf\xfe\xa9i\xaf\xebWd\xe2_5eD\xff\x8fQ\xec+\xd4P\xe5\xdb\xd1\x0b\xa0u<i\xce\xf1?.\xb4\xdd<!nV@\xfc\x9de\xdc\xcd\x98NB\xbf\x91\xe1\x0b\x13Q\x0bHA\x1bcR\xb2+\xa0[v\x83\x96\xfe\x04\x0c\xf3\xa8\x96\xf6\xfd\x1d\x8b\xa9\x92e`jF\x96\x1d\x8b>\xd2P\x86\xe0K\xdd\x9e+*\xe3\x9e\x8dzd\xef\xf2\xfe\xae\xe0\xfb\xb9\x90\"\xfb\xfd\x8d\x87\x9eu}\xca\xa9\xcc.D\x05s\xb7\xa0\x86{O\xd1\x0e\x97\x9a\xa5<\xd6\x8c!H\xbb\x80\xb3\x0d\x8e\x18L\xe0\xd1\xb0_\x82\x8b\xcb\xac\x9d\"%\xff\xc0r\xcfW\xcdt\xdd\xc7\xb3~%\x93\xce\x9a\xfa\\\xca&\x0f$\x9af\xd4Q$\xaf+\x82\xf1o\xa8zO\xa2\xc2\x1d\xa7\xc6^\x12\x86\x800v\xa1\xad\xacj-\xc3\xbfTn\x86\xf1J\x05N\x8a\x9d^\xc1v\xdf\x83\xe9Te=A\x0c\xf9\x90\xcd\xfa\xc8\xa3\x88\x9a\xd8\x8d\xee\xb0\x84\x1e\xe4i\xb3\xf7\xf7\x9f\x18\x86]\x0dh/\x8d\x0d\xe0)\x95>W\xda\xb6)\xc4\xba\x05\x0b\xab\x00L\x02\x0f\xab\xc5\xf6R\xb5\xa0\x1c\xbfL\xe9\x94~R'\x86\xc6_\x7f\xe7\x19JD\xfb\x92\x9a\x10s\xca\x9b)\xcb\xf7q\x158@[?\x0d\xa4\x0f\xc2\xba\xc5\xe4\xaa\x02[\xf6m\xa4I*\xceBq<8\x85\xe0!^$=y\x94\x9b \xb6\x95\xf6\xed\xc9\x15gs\x9d\xb1\xe6\x90\xc4\x9c\x1f'V\xa58\x12\x90\x17\x10\x11:\x8c\x0bu\xaa\xf7\xaf[\x88\x84M\xd7\xb3\x1f0\x16x\xe3\x1aW\xae\xb7\xb5/\x93\x8e\xfb6\xc4\xc9\xddL\xf0\xa8\x18\xdb\x04f\x1a\x12\xd5\x97\xb4\xd8\xeb\xd7\xa8\xfc\xdc#p\x800I$\xb7\x0d\x83\x8e`\xb0B\xd7\xa8{A\x1c\x0c\xdd|l:S\x8e\xc6^k\xcc\xae\x1bf\xab\x0d\xf2\xb08\xbe.\x82\xa3\x05\x82\\\x98\xb6\x8c~Y\x9fMA\x06\x07*,\x12\xca\xc52\xab_\xdf\xe3\xd2p\xd2\x83\xb6\x14#Z\xc0\xbaqeYL\xb7\xd3\x85\x1c\xe3\x0e8\xb5\xe5\x99\xe8?\xb4\x10C\xd5,Q\xcd)\xbe\xcd\xa6O\xfb\x14\xad\xae<\x08'u\x84\xa1\x89\xff\x02\xcc\xad\xe2{_\x85\xbdgX~\x15\xfcD\xab\xd0\x81\x11\x04\xa76\xd4\xe1\x17\xbd\x1ax\x0f\xb6s(\x05V\x13\xd6\x1a\x14\x99}\xf0\x8d\x97C\xaf\x06\xc2\xea\x11\x98\x00\xdawxc,\xd1H\xac!\xfb\xa6K\x11mU\xe8?\x8c\x9f\x91\x1b\xfd\x00\xd7\x1e\xb9\xe4U\xca\xab\xf0\xe2\x1a\x11\x10C\x1e\xca\xa0.\xc2\x16CP\x83hjF\xc4r|\xc2{R\xff\xebSa;\xb0*q\xcc\x9e\xae\xbc\xc6\xa96^]P'_\x19$\xeb`M\xa1\\\x19_D\xf7\xd8h}H\x8a8\x16p\x84&\x03\xe0d|\x8d.o\xe7\x98\xec\xaaN\x85\xcb`\xfd ?\xd5G\xcf\xcdl\xd1\xe7W\xb1T\xa7\x93\xee\x99\x10\x1cg]\x0c\xc5\x1b+\x0a\x1e\xec\xfdW\xa76|\xf0\xacHVIqD\xe5\x1a\xa9\x97" +
+	"\x0f\xef3\xd0<\xaf.\x12\xb8CQ\xe4\xc1\x92\xff\xf5(\x88e$#\xf9R\x9d\x13|`\xe8\x13J]\xa2\x9d\xc8\x90\xff\xad\x14\x84w6\xc4\x0d\xbe\x10d\xcc\xd5@\xbd\xec+\xfb\xaef'\x91\xa5\">\xc4M\xd4\x8b\x0f\x0e\x95\xf7A\x9e\x19\x88\x86\x80\x0eev\x97\xf3\x9fB\x80y\x1a\xb3\x8f\xf4t\xba\xd7\xc4N/6\xbbf\xeeh\xe3\x96`#\x8b\xfa6\x0c\x92\xcaA\xd8[\x15Fa:\xfa\x00a\xa4\xb0Orma)\x0dR*\x85d&\x86\xbcC\x04\xbc\x9b\xb7<\x8d\xde{\xfd\x01\xbf\x0e\xdb\"^Y\x82gE\xa4\x9e\xe8\x11\x81s\x1c\xc2\xc3\xe6\x94\x9fR\x7f\x83\x0c\xe2\x0au\xb6\xc2\xba\x83x\x19\xf2\x93\xa3l\x17}\xf4\xcd\xc01}'\xb06N\xa2\xb3b\xb3\x10\xbfs\x9e\xd2^\x1bJ\x8b\xb2m\xb6\xa5\xdf\x02\xdb\x00\x9a^m\x9flx\xba\x1f\x0d\xf1#O\xaaV\xd8T\x8f\x94\xca~\xb5\x99\x17em\xdb\x99U\x83\xcf\xcb\xf2T\xed\x82\xfa/\x9en^Sbg\xc6\xdc\xc66\xb8RV1'q\x8c\xa0\x93\xaaL\xc8T\xeawtR\xe8\x8f\x02\x0f\xd9\x98\x17A\xe4\x08]_\xe8\xcc\xb4\xd9\x15P\xdd\xa9\x84C\x15\xee\xf9\x81Aq#\xe8\x95\x9fC\xa4\x8fm\x158\xf2Og\xad=\x1c\xe6\x1bp\xd2\x06e\xe7)\x95\x1b\x15\xfbj\xa3\x93$!n\xc7O\x82\xb8\xe9X\x9ag\xac\xca\xcf\x98\xc8y\xbf\x0b\xde\xdd\x85\x15\xab\x0b\xb8\xf1P\xe9\x09_\xf7\x82\xe3\x07jyw\xba\x16\x80\x8d\xc6\x112\xc4\xbc\x0b\x17\x1c\xdag`\xcb\xf6\xd9G\x822N\xfd9\xad=\xfe\xb7L R\xa4\xad\xf2\xb6\xc1\xc7\xb6\xaf\xcb\xbe\x8dNViy\x84@4$\xb3\xf4\x95`\xfc\x8a\xf3\x0a\xc3\xf7Q{\x0e\xa8z\x9bO\xd5\x1ej\xa0\xf0,\x03\xc2\xe8FJ0\xf4@\x0c\x9av\xdb)\xe5~\x8d\x8e\x7f}C\xfc\xe2\xf8\xc6\x1f c8\x11\xd6\x05L\xb1I\x89\xc4(\x81r\x82O}(Y\x07\xe8s\x83\x1e?0\x9d\xf5\x1aV\xa2\xa0UO\x16\x0b?\xdcy\x1e?9\xe1\xef\x90\x1ac\xbe;\xa5\x08d\x8d \xe5;Z\xfa\xb7\x10\xbc\xc7\x89\xa7nB\x83\x8bV=Z+t\xa5\x80y\x00>\xc2t\x17\xef\x9e\xc2\x97f\xb6-\x9d\xca\x01\x9cS\xd9\x00\x85<\xa4\x9e3\xbb\xdc\xa8\x8b\x91\xd5J`Y\x96\xc7U\"\x0fw\x9f\xb5WO\xe3E\xd4\x9a\x87q.D\xe6\x87,\xd2z\x88\x83\x11\x9b07\x94\x15\xf6\x90d\x04Rq\x12\xe2\x9dX\xf5\xad\x10W<\"\xc1\x95x\xd8\xe0!\x0d\xc4\xe9\xe5\xc0S\xed\xf0\x00\x85\xb0\x89\xb3\xf4\x84@\xc4x\xca9\x9c\xdbp\x87s\x87\\\xe1\x04>\x9d\xa12\x09\x1a\x82\x8f\x1ap\xf8\x00\xa7\xe5\x0f\xb2\xb1\xdf%F\x03\x83\x85^\x89\xeb\x83\xd3\x15\xc4\xd3\x95z!)\x0fW\x00\xc3g\x94\xf6=\xc2\x89[0\xe8\x83\x99\x06*\xd6\x19\x1dyPq\xcdOtG>\xad%\x03y \xd5\xea&\xf2\x13`y\xffHvs\x8c]n\x8a>L\xe4\xe1]b\xa5u\x09L\xeaW\xad\xe2\xe1\x87{\xa2P\x1f\xccd\xbc\xbaq\x8f\xdf\xd1\xf2V\xd2\x8a7\xd2\x9bQ3\x85O\x0f\x9d\x12X\x17\xd5\x1e\x1f\xab\x16CAt\xa6f\x1f\xae{\x03D\xadU4E\xae\xd6k\xe6\xf9VH\xa6\x05\x0c\x15%\x10\xb8&\x9b\xf2\xb1\xf1v\"\xed\x00\\\x98\x108vzP\x94~Q\x1dxv\x92\xbd\x8dS\x0c\x94j\x0d\xe0\x8d\xafD\xab\x03\xd6\xf3U'\x85j\x8a\x90%\x03\\\\\xe0(\x9f$\x05_yEv^y\xc7\xab?\x1f\x91\xcb\xf4}\xac\x01$j(\x95a\x83\xef\x12\xfe0g\x12\xdb7v\xb1[\xa8\xe1\xd3/;r\xd7\xf9\x9b\x93\x04\xb8C\xea\xde\x93/\xe0\x90rG\x9b\xe9\x9d\xdc\x13e\xfc\xad\x0cC`o\x13\x9d\xdb\xf3+\xa6\x1c\x1e\xa6@\x18\xed\xb6\xa6\xd5\x83DA\xc6:\xcf-\xc8w;\xb1\x98\xc4\x97P\x0e\x9eI\xaa\x9b\xf4\xeb_\x1c\xbe0}\xbb\xa6\x9f\xfa\x8e\xc8\xcdu\xbc\x16]\xfc\xfa\x95x\xf4\xfa\x03\x99\xaa\x8a\xc2h\xd5d\x12viT\x07.X\x16\xec\x15\x09\x8b\x97\xde\x0d(fn\xb5?\xec\xc3\xc0\x98\xab\xd5\xac$o\x9fK\x1df\xf8\xe2\x99\x99\xa4\xee\xe8a%2\x03\xc7\xee\x1e\xa1\xed\xdc#6\x95k\xc1\x9eL\xf1\x8a1\xbfy\xec\xf2\x82\xe7\xef\xc8\xf4\xd5\x8aDc\x1a\xceT\xa5m9'!\xcfoc\xe6 \xff@\xed>\x82\x96Y\xe9\xc0\xac\xdd\xf4\xb2,\xc3\x17Z\xdd\xfa\x16{p.\xc9\xaf4\xf2\xd5\x9c\xd0\xf1)\x1cd\xec\xe5\x92\xf50q\xf5F\xad\x02\xc3\x17\xdb\xc2\xa6P!,s\xecIN\xf0\x1bl+g\xd8\xe5\x98|-8e\x16\xe9\xa7\x00xf\x8d%\xfd\xdb\x1bJg:U\xfd^\xf5\x1b\xa9C\xb4\xe2\xe4nV\x11\xba\xbe2\xec\xfa\xbaY\x17\xb8\x09J\xb7Z\xdc\x9f\xf3a\xf8b'S&\x06_`\x9c(\x92-\xc9y\x1bS\xcf\x9fl\xd0\xec\xcb\x8b\xd8U`\xb6\xc5\xbf\x1b\xd8\x9e\x0b]\xac\xbcbZ\x82\xdb,9\xf5\xa0\xfe\xb6^\x98B*\xc4\x02\x0e\xca\xa4\xe0\xaf\xff\xa9e\x84\xe9X\x8e?IE\x01l\x83U?P\xd4\xf0\xc53\xc4\xa1\xbe\xef+!@\x85\x8c\xe3\xe9\xbdX\xea\xe0XB.\xa6\x0c\xb9+TN\xfeJ\xbcX+#\xca\xcf\xc9\x09J\xfeb\xbf\xd6\x82\xe1\x8b\xbdg\x18\xf0{4\x9f\xd0\x03Y\xca\xecp\xb6\xa0\x01(\xd1B*9\xdbT2\xf14/c1\x90\x9e\x80\xc5\xe1\xde)\xa2\xc9P\x8d\x81\xad\x10\xf3(}\xc3r\x8e\x8f\xbf%:\x09\xa5\xda\xaf@\x9e\x95m7\xd0Ib\x06\xc1\x16\xa0t\xe7\x89\x95$=\xd1\x97\x04\xbdY\x80&\xa7Jq\x10\x9b`\xfd\x837B\x15W\xbb\x16\xa1.&\xc0\xdf\x0a\x7fo\x14$\xb2\xe9\xef\x19\x8bjp}\xca%\xcb\x8bc\x10\x86\x7fE\xec\xb9\xe2\xb5\x19\xff+\x14\x04\xc2HP\xfa\xc4\xa8\x06\xe4\xac\xd3\x9bD1\x1c)\xbd\xc7R\xb3\x0c\xef]\xa6\xab\xb3\x18\xf8O\xcf\xed\x07\xf5\x1b\x19\x87'b\xe1\xafz1\x1a\x0e_\xec+\x00\xc8w\\\x1a*\xd7\x97\x88K_\x9c\x81\x16\x05\xa5\xb4#\x0a\x02\xbb\xd0C\xbeb\x16\xd4QS\xa4\xab\x96\xd1A\x13\xb9\x94\xb9\x95;]\x18\xfe\x81\xee=\x84\x87\x8cTee`\xfb\xa0\x06\xccM\x97\xd4\xfa\xc7f\xea\x96t\xfa\xd5\x97\xe2Y\xd8\x07\xb2_Ot\xe1iP\x1f\xb4\xe5\x96-{\xfd\x16\xc2\x86\xb4\xa5\xc3\x17\x1c\xd8\xaa\x8c\xdb%\x9f\xdd\xb4\x06\x8c4\x96 \xc3\xa1\xb4\x03\xaf\xe6J\xae!p\x07\xe6 \x1cV\x18uXE-\x88\x9fA\xdc\xb0b\x9c\xa4- \x9f\x99\xdcs\x9e\x0c\xb3Or\x88\x88\x04\x09\xa8\x95-Il\xd8\xa4-\xc1\xa3\xdd\x13r(\x0f>\xba\x14T\xfd\xe7\x0c\"K\xb3L\xaa\xd5D\xe4(\x99\xb04\x0e\x0d\x9eu\x97\x81\x1c\xb2\xd2\x16Q{x\xf0\xaa!e1\xc6G\x11X5Z\x98\xb4jbFt\xc0\x91+V\xa4I\"<\x16l\xd7K'?\x0a\xc3\x17\xb2<\\\xbf\x91O\x96z\xae3~\xc8\x04K_8X\xfa\xf5\x08 \x08`>h\xa6\x91\xfb\x1b\xf2\x9da.\xb5\xab\xc6tXr=#D-\xfe\xe8\x98\xbd\xb0\xb9\x04\\ \xba\x85@\xaa(GR\xc9\xd8\xf46\xcc[\xcc@6\xc70t\xc2v)E\x1cyV7\x13^\x84\x04Hk\"4E\x0aa\xc5\xc7\xa2I\xa1LjV\xd8@T\xc6o\xc2\xeb\x09\x9aO\xf8\xe81}\xfc\xfc,\xc0\x0c\x1f\x96\xdaQ\x82D\xfd\x00\x09\xfeid\x8e)P\xec\xa0\xfe\x1e\xad\x8bL\xe6@-}=\xbcp}\xe8\xd3@2fp\xb1_[\xed+\xfa\x1c\xfb\x96JDra'\xbewQ@\xf5\x84\x8d\xb3\x8a\x0e\xb0<b\x8eiZ\x12b\xa8\x05\x8c\xd5\xbb\xa6\xc8^Y\x8cP\x09\x92\xb1U}M\xb7\x10\x8e\x15\x94\xdfZ\xaf\x82Q/Bt\xfaw\xb1L\xcd\x9a\xbe\x86\x06\x87\xa5\xa3\x91\xe6\xaa\xafS\xdb\xaa\xd8\xb5\x1b70\xa2{\"\x94\x0fu\xa2\xf2.\xe4\xc1\xe6\xdc\x00\xcd\x95\xfd\x96\xac\xcb\xd0#\x8ccg\xbb\xbe\x81E\xb0\x944\xfc\xdfH\x80\xef)\xe9\xe2\xcch\xad\x16\x86<\xd1\xd0V\xcd\xb0FU\x19\xc0\xc2\xac\x07%]}\x15\xb2k'\x98#\xd2\xac\x93\x14}\x97 -\xd6G\x0bh8\xdc\xea4\xb3\xde\xcb\xc2u76\xbc\x84[QM\xa1\x1f\xb8\x8f\x8e\xa1\xcf\xc7J:\x99\xb3z\x95\xee\xb2\x9ai\x988\x15,b\x1b\x82\xa9\xb7\x8b\x02Ch\x1fS\xaf\xfa3\x10\x98\x0e\x87\xdb*\xd5\xc7\xd3\xddL5\xe4\x09^|\x0a@\x09j\xfc\xf4\xc4\x9ea\x0c\xa3y\xfe\x9e\xdbZ\xb4\xf4\xaa\xa0\xe0\x9cZx\xc0%\x17\xb3o\xa1\x1c\xb7\x1eC$\x112\xbf\x15\xc4\x08\xc6\xd7N\xfc\xf5\x0d\xd1\xfa\xc3\xe1N2>Efuy}\xbb\xca+\xc0\x0ch\xd2v\x80I\xb0\xe0\xd0<\xd3J2G\x88\xf4\x90\x1e`V\xb9t\x076\xe6\x02\xdcm\x1e]&,Q\x7flf\xf6)\x8e\xd9]]\x81T\xf0\x91\xa2Y\x95\x8e\x18K\xc3(&\xc3H\xf6\xd9\xc3!\x1d\xeez\x93\xe2\x08\x95L\x91\xfe`b\xf6+el\xbc\x04\x98\x04\xb9\x02\xabe3\x02\xcfL\xb8\xf7\xa5%vWd\xd1\x80O6b\xd1)\xee\x84\x83&\xf4\x7f\x9d\x1fp\xd6\xc7\xa3[\x9e\x8bDk\x8f\xf8j\xb8\xe7\x9e3\xcf\xe8\xcc^\xaa\xe9\xc6\x9e\xe2\xf8\x1f\xe3\xa4P,Z\xe6\x80\xafZ:\x0d\xd8\x98\x0eZ\x0e\xccx \xe4\xfd:\x1b\xb2\xc5\xab\xdd\x81\x93t# \xbf\x8aWLo\x1a\x06\xc13\xbb\x1bA\x0aE\xf3\x0e\x02\xf1\xf0'Q \"F\xdd\xe7\xa9\xad\x96\x83R\xb2T1\xd9\xbb\x07\x92o&\xe7\xc6\xaa\x9d\xdb\x825u\x8c\xdf\xd1\xe0a\xa8t\xd9\xff\x87\xc7t\xb2\xfc\x14!\xd4\xbe\xa9\xd6\xc8Q0\xf5\x03\xe9P\xc9b\xc1\x84\xe5\x15\xd0e\xa4\xbc\xd8\xa5\x06\x84=\x91\x1c\x85Z2\xe2\x8c\xf9\xc481\x8f\"\xec)\x95\xb6\x058$\x91FA\x14\xdaD\xb2\xfb\x9cJ\xddC\xf1~\xf4\x0cz*\xc8nF\x8b\xe9r1\xd3{\xa4s\xd8&+\xaf\xde\xaa\xcdc=\xd0\x88i\x14Mf\xfbe\xfaUS\x01\x10`0G\x8a.C\x94g\x18:R!\xec\xa2e\xad\xb2iD<S)\xfd\xc7\xe3NZ\x84\xe1\xf0\xb0\xfb\x9c\x11A\x80\xc8\x8eD\xa3\x19\x95F)7B\x9cc\xb7\xf2\x98C\xb2\x8d\x10u\xfb\x8a\xdf\xa6\xa3\xed\xecg\xe9h\x83\xe0\x10\x0f\x1d8\xa3\xdf\xa3!\xce\x96\xa2\x15 t\xfd\x83\xa4Dn\xa1t\x94\x92Z\x0f\x9e\x9c&\xa9A\xde2M[\x92\xb8\xac\xd2%\xa4\x12i\xb1\xbb\x00=\x19\x08\x8f2\x95\xeb\x8b\xa2/{*\x14X{l)0\xed\xc0pE\xef\xf27\x7f\xfd\xd5\x1f\xbe\xfc\x04\xfe\xbd\xfe'\x91\xe0\xfdb\xf0\xc5'\xbf\xfdt\x0d\xeei\xc6\xf5e3\xad\x1f\xea\xabf\xf2\x8b\xcc\x100\x14\x88\xd6\xf7\x04\x9d\x14=&\x16p\x06\xa41\xeb\xe7\xdbx\x88\x8a\x94\xeb\x9d\x12\xbe\xd1\xd3\x9f\xa4\x17\x986\x17\x00H(\x0c\x99Kg(Na\xaa\xa18\x9dq\x89F\x16\xf2~\xf9\xd9W\xbf\xfd\xec\xf3\xcf?\xf9\xcd\xdf\xfe\x02\xaa<\xdc\xa1jF\x84@Ls\x81*\x16\x7f\xeej{\xa4\xa0U\x13\x84\xf9\xe3\x9c\xd0B\xa2\x0a\xfb\xb9\x1c\x90\xf57\xeb\xf7\xd1\xd9\xba\x8c\xa3,\x13h\xfc'\xca1\xa0\x02\xffZ\xb9UK\xaf[\x00\x86\xa9\x82\xa2\x9b\xd1\x03`\xa8\xb5\xa7\xd4\xcbZ\xd9\xd5\xae\xe5\xd4R\xe0\xb3\x08.he\xb1\xa73\x09O\xad\xcc\x07-\x10\xb0X\xf1\xba\xeb%\xcb\xe2\xb5j\x13\xf7\xe5\x92\x8ft\xd5\xce\x84\xc1\xd1\xb9\xf3\xd7\xf8\xf2\xce\x12\xd6\xee\x8e\xa3`>\x95\xe1ll1]5\x15\x98\xe3V\xb9\x0eYYS\x94f^\x8f\xb0#x\xea\xca\xa4\xaa#8\xafZ\xc5\xef\x17\x17\xdd\x82\x99\xcb]r\x15r\xe5\x14\x86\xee$\x9c\x0a\x82\xa63O\x1d\xaaH\xc2\xc6\xb2=q\xc5\xcc\xd3\xc7R\x87\x12\xc8\x8d\xd6\xabB\xb5\xce'\xd4Yr\xc76G\x10\xf2Lq\x9a\xd7\x17\xb8\xe0SZ\x0ePn\x91\x8b\xe8a\x12s\xfe\xeb\x9f\xc4\x0b\x09\xda6\xb5\xe03\xfc\x99i\x02\xc0\xf3\x05\x07\x04\xf8\xc7\xf4\xaa 7J\xa5l3\xbeC\xd1\xcf\xfb\xa8\x94<[:\xcc2_\xdb\xa2\xd9\x19\xee^\xdf\xc7E\xa5'HV\xe6\xf9G<3/\xa3n\xedk,\xa0\xb7s\x0c\xf9\x8c2\x12\xb9\xe7\xa2\x86\x00\x8d\xc9e\x8a\x97\"\xb0\x8a\x02'\xd0 X\xdfa\xff\xec\x98\xa8\xf4\xb0\x81\x912\xa7\x8e\xe2\xc0x\xe3\x8c\xf5O\x94\xa0>p\x15\x18\xb8('T+,I\x88Y\x8a\x03\x80\xdc\x14\xcd1v\xafhNu\xa3{-\xbe.j\xccGM\xa9\xdd\xd1\xb9\xe8\xafPG\x9eh;\x0f\x8cq\xbd\x90\xf4\xee\x82H\xb7\x09\xf7/\xd9\x07\xcc|\x97\xc4W\xc6\x1a\xbd1\x06w\xfb.\x0bO\x93l\xcf\xcd,^X\xc3WZj\xb8\xc9\xeb\x80C\xca\x90\x85R\x18Z.\x0cB\xed\xf6<\x8b\xf0\x04\x1d\xbd\x1bI\x06\\9\xcb\x94\xb8\xa3\xfc\xc2\x8ax\xb5\xe7=\x13DY\x85\xc8\xfd\xee\xb0\xc3]Q\xd5\xa56\xda'\x1bd\x8e8&2\x14\xb0\x9b\x07\x18\x89*\x85\x1f\x95t\xeeV\xb4\xe1Z\x9e|\xcb\x83\x98W\x98\x9a\x9c0MM\x0fo\xcby;}U\x116\x1dC\x0a\x7fab\x1b\x99(\xf2=g\xd2\\4\xe0\x00\xdd\x978\x7f\x84T\xc7\x98\x880\xa0\x09\xb0P\xa2\xde\xcez{\x0d|\xca+\xdd\x13\x81\xb8F\xe9\xc28\xe0\xcdM\x08.\x86\xdb\xc9\xee:\x11N\xe7\x1d\x94\xc0\xad\xd2\x07\xf5\x197\xe3\x84\x84\x8c\x8e\xdbj\x90x\xdaO#{\x1c\xee\xa4\xfb\xe12jziVF^\x10\xe1s\x95\xa3\xd5\x92\x94/Y\xe7\xfb\x03\xf4\x9c?2\xda\xc6\xd7lf\x9a\xe4\xc2\x00e\xf13p\xee\xe9=l\xf2}=F\x90-,M\x1a\xf7X3g`\xf1\xa3\x18h\xa1\xed;Q\x952\x941|\x09\xcd\xf0%\xc8\x9d%\x8dr\xe7n\xdfM\xe7\xc5\xbc\x8f\x00%\x0d$H\xae\xa0v\xb5\xa5,Y\"l\x84j\x0d\x85\xdcl\xe7\x03\x95_\xc9w\xf4\x89z\x01\xc2|I\x079\xb2o\xcduvG\xdc$\x94\xff\xea7\xe8\xf9P~{\x05Q\xf5D\"\xbd{%\x85z\xf6\xc9\x0f\xf7m\x91\x03\xab9\x93\xe8>\x87\x99=v2Y\x8b\x10V\x95(\xad'\x98\x86\x8f\xa1\xe2]5#\x01w7\xb1\x1b\xad\xe0\xae\xdb\xae\xef\xc3\x85\xb0s\xb6CO\xcb(%\x03q\x90\x8f\xd8\xe7\xcd\x11\xd5BWX\x83\x00\xf9#\x9d\x99e\x858s\x05\x9dJM\xfb\x90Vmst\xe8Z\xa2X\xcf\xd7/K\xfd\x0c\xb1\x09\xd3\x81\xaa\xbeD3P%3\xaa\xb2\xd9\x1aoM\xf0\x07K\xd01\xe7\xea\xd3)\xe4\x7f\x14\xe9\xe3\x05JK\xbc\x11\xb1\x92\xe9\xe9Y?\x0cx\xc2o\xc2\x08\x17\x12\x93.\x19\xdc \x9ai\xc6\xd1\x0e\xc7\xbd\xfe\xbd\xc8l\x8b\xd3\x09\x09cNd\x9b\xc0@\x13\x0d\x0ar6a\xc5\xbf\x85P6\x0eW}IX\x9e\xb0\xccL\x17\x1b\xf5N\xc1}\x1c+K\xc9QZ\x12\xbd\xf8\xc8!N\x86\xd2\x0d\x04g\xa2\xe1\\VE\xa4\x01\xed:\x859\x0d\xec\xe2\xf0\xeb%O\xb2Q\xd2K\x91f\x98Q\\\x85\xcd\xae\x98\xc1\x8f\xf9\xbd\xddoa\xe2\x1cn$\xd2%\xe5\x9f\xd9M\xd9\x1a\xa6\x02\xcax\xce\xfd\xa8\x93e\x15\x9a\xfa\xb8\x9d\xb8#\xa9\x88\xb9\xc5L7\x92\xe8Cz\xa9\x00\xb52\xddT\xa4tt\x1aZnE\xcddo\xa1\x87(\xee|\xef\xadD0\x9f0\xacSHZ\xdc\x83g\x7f\x92k2\x91\xe1\xe4T#X\xc7\x1e\xb1\xa8\xc7e\x8a!>v\x02\x95\xf0)\xb6\x10\x1d\xe7\x97\x89\xd7\xbb\xf26\x8aY\xf6\x19\x01rPMTL\x17\x8b\xd7\xd7\xba\"\x8a%,\x95\xe3M\x80\xb9\x16\x96\xac2\x9d\xba\x17+\xdc\x8d\xab\xbb\x9b\xf8I[;b\x9b\xe7x\xe5\x8f\xc12/l\xa3\x93\x11}1\xd9&\xd1\xbd\xa4\x0aZ\xd9\xe0\xd4\xef\xd7\xcb?\xfb\xae\xd9\x81i\x00\xdcLu\xa0\x0b\x14\xac\x8e\x9eS&k\xc7o\xaa\xf0V\x95\xce\xc5R[\"\x9e\x05x\xe3\xf5\xdb\xac'\x16\x8c::g\x18\xb7|\x1b\x0f\xbc\x04\xca\x0e<\xbc\x12\xdf\x8bo\xa22\xaf%\x8e\xafH\x93\x8b\xc6ic\xcetk\xa8\x16\xf2\xca\x0a\xeaB\xf4pM\xae\x0b\x95\x84\xf0C\xb9{\x9f'\xa1vI*\x8d\x1f\x98<Ovx\\P\xb8\xd4\xba>\xf6\x12g\x8dU;\xe2Yl\xa8\xcd\x05\x93\x07~\xab\xbel\xfb\x16\xcfLIt\x81\xc7V8Cn\xf3\xf4$" +
+	":\xd5'\xe0}4C}\x96\xbf\x9fe\x95G\x0b\xa1\x91NA\xa7m\xe4\xec`\xad\x09cP\xbd\xbc1%6G\x80\x06\xa7\xa2\x85\xa34\xfdP:A\x93\x9eT\x8eEK1\x88^\x1c\xd8\x1f\x92\xb9\xc5\xa0\x0aT\x8fE\xc5k|\xa1N\x9b\x14\x1c;\xe1\x92\xe4\xa4\x0fx\x0aV@\x0d'z,\x1e\xb4p\xda\x98\xfb\x83\xa6p0$u}\xe3\xe4Iw%>$8\x07\x9d\x0b\xe5\xb0%\xd7,w\xa3\xf2\xcb/\xc8J\xd0\x84\x95\xb2Br\x0b\x0c\xc6\xc0\x07\xa0\x162\xf8\x01D\xb5L\x0a\xbd\xb1\xe9\xe6U\xe7\xb4m\xbfx\x9e\xb5\xed\x94\xb7\x8f4\x81\x90\x82\x9b\x09\x83\xe4\xd6\xef\\=b\xea\x17I\x163\xdf*\x18@d2\x92\\\xee&\xa3\xd0\xde_+\xb0\x93\x9d\x95\xe5aJ:\xc0\xbeq\xb6\xf2\xe1T1\xc3R=R\x92\x1c\xd2'\xe9:E\x8a\xe0\x00I\x97\x1d\x04=\xae\xea\x07L\xe8.X\x93\x13cO\xc7\xfaP3\xfaJ\x0aT\xc8\xe9fx\x09\x08h\x05\xd8\xf4TcNh\xadZa?\xe6\xcaS\xb4U@\xe1\xb5\xd20+\xbc\xe5\xdac\x82\xdd\xbb\xb2^\x81\xa2@\xb0z\xabo{\x11\xcaooyEs^\x973h\xcb)\xfd=\xa9\xb62\x98\xa1*\xd0\xb3\x89\xd4\xea\xbc9\x95\x7f\x88W!d\x93VZ\x84\x09\xe3L\xcbYN\x0b\xa0\x99\xd1\xc28\xd2c:J\xa8\xa2{\xf2&lo'k\xda`\xddK/\xee\x11\xc2\x8d%\x1eJf\xfdg\x94\xbaFY\xfb\x8b\xb1\xd4\xb1B\x17\x0b\xb7\xc8'\xac\xc8\xa8\xab\xa5\xe7\xa2\xded\x98\xfe\x07\xd4r\xe78\xedd\xcaS\xc1\xaf/\xf9@\xae\x97xX\xc5\xb2\xd4YWY\xea]\xf8\xa3p\xe5\xd2\xbd'o \"\x99\x98\xfay#y%\xc8j\\\x92`;\x0dq\x94\x82\x08\xa5G\xe9\x19\x8c9\xc4Y\x80\xcfQ![F\x05muU3\xc9\x8e\xa3\x1d\x0e\xb6RR\xd4\x95\x84K\xc2\xecK\xbf\x83\xc1\x02\x9d\x07\xd8\xae\x971\xe5\xbaV\xf8\xe7\"\\\xb2Hk\xe0\xeb\xefC.d\xfd|\xef\xad\x9c\xa6\xe8z\xe7&\x1bb]\x17\xed\xb5\xd8\x0d\xbf\xb6\xaf\xf1t\x87\xb2S\x81\"\x9b\xf9j\x1d\xd1\x12\xdc\x09\xad\xd0\x97\xf9\x12Y8\x1e\xb0\xdfN\xa0VT3\x99\x08T\xd4\xc1~OF\xd2\xeb\xc5\xbd\x86{<d\x8c0\x92\x9d\x95@YilLWm!.\x83`\xbc{\xcc\xec^\x1b\xb11w=]\x03\x8e\xd8\x0f\x8a\xe0\x9b\xdc\x89\xac\x05sm\x13 \x12\x93\xae_:$\x1dr:\x0e\xa2\xc4\x9ah\xea\xcc`\x05_\xcb{\xe6\xdfs\xbf?B!D\x01\x99\xee-H\x96\xce\xa4~\xb9\xa7\xaf\xee\xa5D\x93\x16\xee#\x0cC\xc3\xd1\x93\xe4!\xdcV2\xd1_\xe3XeM\xedS\xf4#\x9e\xc9\x0f\xda\x81s^sGEt\xf3.\x98\xfe\xbd\x85\xee\x0e\xd7\xef\x07jV\x0au\x8d\x195\xd4\xad\xb0\xber\x8d\xbe\x1065r\x06P\xb6\xc2\xac\x9f&\xb0K\x11\xb0\xe3\x15\xcd\xe9\xb1\x89\x81\xf2\x1eTF\xb7N\x10!P\x95\xc1Y\x8b\xbeK>\xdb\x88\x95q\xfb\xb0\x87\x8eGO\xc9\x8ew\xf0\x88gX\xb9(\xa1\xb9\xf7L\xbe\xae\x0f\xc1\x15,\xbb\xf7\xf2\xa80\xd4\xc6\xef\xe1\x10\x1d\xcb\xe4\x82\xf9\xed%\xd3\xfe\x93v\xbd\x96\x01\xfb&\x02\xdbW\x90\x159g\x94\xea\x9c\x08J\xdb\x0b\x8f;/\xfcJ\xf5*\xdc\x9f\xe3z\x97^&N\xb5bQ\xa3\xa8\xa5\xf4\x0a\xdc\xcd\xa9\xfc\xe5R\xf2\xd6\xba!@W\xcd<rf\xcc\x85\xadt:6\xb1\xb7\xd1\xf4\x04\xe4\x87d\x98&\x07\xbe#V\x9f+\xee\x13\xd2w&\xde\x85\xe3\xb0fC\xe4)1\xb1\xde\x83\x8e\xed\xce\x9c\xca3\x0f\xdb<\x9b)\xc1?\xa7\x1c/lX\xb9\xd9\xd9\xca\xba\x7f]\x8a\xe1\xdfHC\xd1\x09RJ\xa4\xcc\xdd\xaeq\x94\xb6G\x07\x01\xf6l\xfd\xbe\xb9 #\x03\x1fiBD\xab\xddG\xe2\x9e}N\xaf\x9dm\x85\xd4\xd5\xc7\x95lyWE\xa5\x81x`Jv8\xb7\x16\xfehs\xcc#t\x07.\xb5i\xcdU]\x1co\xb1\xe0\xc9b\xc0*8\xf6\x84\x10\xf2\xda\x89\x1b0n\xed\xec$#2\x91\xcakwF\x99,\xaa\xba\\\xc8Z\x1a\x15Z\xd1\xc9\xbbiA\xd7\xe1r[\x90\xb6\xe8\x02+/^\x93T&\x8f\x80\xaa\xf0\x9a\xdcP\x88\xa7\x9d2WM\xcbz4\xf9\x85\x91f\xdd;\x91\xed\xde\xb0\xcc{\xc0\x00w,\x1a|\x0d\xa4\x01\x91\x0a<\xeb+\x11E'\xc2^\xb6\xca\xf5#\xcd\x8b\xa9\x01\xb5\xf7\xa0\xd0\x89\xfa\x98\x06\xd6\x9d\xbd>\xc7\x82w$\xc4\xa1N!\x1fw\xa40\xe7\xc0\xad\x17\xcd\\h\xfc\xa9\x8f_\x83\x0d\x9c\x18\xc4\x86\xae\xbbh\x8a<-\xe8}&\x89\xd2J7\x8a\xe6\xb0\xc4\x80\x07:d\x95\xdf\x91r\xd6\xa8SIY[\xaew\x00B\x1eu\xaf\xa7\xfd\x9f\xed\x84\xb6;\x82\x8fl\xd1\x04\x12>\x91\x94\x0e\xa5\x00\x9e\x97\x1e\x9d\xb0X\xe1\x8f\xaa-\xef\xb8\xee\xf3\x94\"\xefJR\xbe\xba\x01y\xd2\xea\x1a3\x05\xf7`\xc8\xa4,d3\x11`\x92\x8a\x9b\xf6D-\x1fI:\x848\xca;8En\x84\xfeA}\x15Sb\xb1m\xe3\xacE\xbcu,\x0c\x8eH=\x86S\xa1\x1br\xb0\xf3|\x9e\xafR\xa0/\xadD\xb8Y<*)\xed\x16l\xef\"\xc7O\xc7\xf3\xeffY\x9eU/x\x99P\xf6\xc6\xe6+\xec\xfc\x82\x8c\xec\xf7\x9epT\xd5\xda\xaa\x9dh_\x14\xf6\xeb\xd9\x870\x04\x08\x0c\xb8\xb7\xbbP\x08l\xbb}\xd7\xd0\xda}C'\x9d\xc4@\xe1\xbew\x89T4\xfd\xf5\xa58\xfd\xbf\xb1:G\xef8BtR\xaeK\xf8\xd31\xb8`\x9d\xf36\xb4JB\xb4o\xe6\xca\x82\xbai\x0eA\xfd&\xc0UN\xd4g<Q'P4\xdc\x00\xaav\xa7I\x0b\xe4R\x88\x0e\xfd\x99\x9a\xea{\x12I}\xc0\x1cv\x8b\xef\xa9\xb2\xd8\xf9aJ\x1co\x1c\xf0\x0f\xf1D\"\xd6Ko\x98L\xd0\x80o\xefP1}\xc4\xfa%\x8b0\xf0\xb6\xbdg\xb9e%\xb3%3L\xd7P\xfc\x96\xb0\xea\xa4\x0d\x87\xb8GoP\xe5\x9cq\x0fb\xc4\xa5\x13\x97\xe4<\xd2\x9f\x1f\xb7\xf5R%\xd7\x1b5\x85\x19?\xd3,\xb7\xcc\x97\x8a7$J\xdc\xdd6\xe2\x91E\"u\x03E\xa7\x0b,\x10\x86\xfdW\xbf\xc7g\x8aS\xc4\x7f>\x17*&\xb2\xb6\xc0\xa2\xfbE\xc0)\x8d9\x00\x9a\xf8*\x01e\xfb\x90I\x08\xa6\xe0\xc0\x91\x9b\xc5\x83\xe5^Z\x9a \xc5\xf5\x18%\\z\xf4z\xed\xe6\xc8\xacMr\xdd_\xf7YMq\xe7\xc8\x02'\x1b\x9bN\x0a\x92\xc3;\xcb0\xcf\xa8\xce\x0bI=\x95d\x90\xfb6ch\xae\xd4\x81R\x8e\x81\xfaaa\x89l \xb2\xfa#\xb3\xcd]\xc3\xec_\x11\xed\xb9\xcc\x95\x09\xe0\xa4\xdf\x9a\x97wF\xcc\xe3=V[bw\xb7}/\xac\xa7*\x96\x83.\x94K&'\x8d\x8c/Q\xfc/I\x0c\x9e\x1a\x98\xe2\x7f\x1a\x9f\x0e{!{\xec\xd9\xbdv\x90?@+\xaaf\x96\xb6,\x08N\x13wmzy\x9f\x1e\xcc8)\xea\xd7x\x04\x97Ts\xe5\xcd!\x989o\x81\xc0\x19\x9a\xd8\x98\x9f\x0e\x19a\x95\x86~F\x9f\x1a\x14~\xfa\xea\xe6\xee\xee\xb7\x0f\xa3\xd8'\x86\x06P\xf6e\xeb\x84\xfe,\x15O\x9e;\xc2\xb7\xc4\x84\x84\xfe\x9dL\xa3\xe1\xa2\x89z\x1d\xaa6\xbf\xd00\xd7\x8ak\xe2\x98\xc0-\x93\xe2web\xc1\xf5\xcb\x86%\\ZYoKHa\x19\xb6\x88\xf2\xf5]\xaf\x8a\xd1\xee\x81\xeb\xf6\x8a\x0eM\x04I>z\x1c \xcb\xd7\xde\xc2\xb9\x09T\xdf\x9c\x17\x89#\x8dg\xc5\x86\x87\xac\x0e`4\xab\xc2\xfa\x95Id\x85\xe1\xc9V\xab.S\x979q\x00\x0f\xb2{\xa4\x87@\x91V\xa5\xd2\x0e\xb5\x94\xd22s\x03\x89\x1fb\x83F,Ps\x04;D?\x94\xff\xe0V\xf7\"\xad\x12\xfd\x98T :\x0aG+p\x9c\x16FtcF\xa9\xa9\xbb\xe4\xe1F\xc8\x91\xc4\xc5\x9ds\xdb\xd3\x9c/1\xec\xbd\xc8w\xc7h\xac\x85\xa6h\xc2\xbc\x85\xa5\xe3\x96\xcd|\xb9\xf9\x0b^\xc6\x8a\x9a\xe5\xd5Ul\xef\x9f8e\x93\x82\xea\xb1\xa2\x18\xc0MU\x11ox\xc2\x1d\xe2\x13\x85\xb4\xd5_\xb3\x12\x0c\xea\xb1~b\xe1\x85\xbd\xe13w0\xcdlv\xc6s\xc5\xea\x1b\xd8\xdb?b\xc2\xa9\x137\xd6_\x9dA\xaf\xa5%\x96\xce\x18\xd7\xa20$\x1e\x8c\x07\xcb\x9dU\xfd\xa1{\x00\xb7\x1c\xf4\xbfA|\xb6\xebpp%\xc2\xe6]\x18j(\x00d\xdc\x1a\x97\x9eqz\x0e/\x05@\xeeA]5\x92\x03\xf6\xe8\x92\xde\xdbN|\x01.V\xc6q\xbc\x86\xa0\xc6\xf2\xeed\x0dT\xa7QM}-.\xbda\xe5\xa5Dy\x92\x98*\x8em,T\xd8\x8f\xf6\xe5{-\x88q\x8dh\x1b\x19\x8f\x02\xc6S\x1c\x9b\xd3.\x80\xc8\x86\x01\xd1\x9em\x09v\xeb\xc2-C\xa9\xd8_\xf1P\xe1\xbaIp\xaaV`\xd8[\xa9\x886\xa9\x1a\xee\xa5\x0d\xb3\xaeq\x00\xf0E\xe9\x83/\xa4M\xcd\x89A&|\x9d\xb8\x82\x84\xb9\xa9\x1c\xc9\xfd\x94\x18\x85\xeb\x99\x91\x01\xc2\xfb\x95fv\x10\x09\xbd{\x12\xd2\xc5e\x15LY\x8c\x00\x80y\x81#\xfcc[\x1eT\x9f\x9f?\xb9|\xd0R\xfb4=$\xad\x93\xb8\xe7\x04n\xb2O\x8c\x08<1K\xcfhE\xa7\x13\x15D.TTs\x87V7\x99\xdbx\x83S\xea`\x1d\xb7y\x0a\x1cr\x84E-\x97\xf7\x94\xb0w\x0e\xca!\x0e#\xc05$UR\xaaW\xd9>R\x07\xb9\xac\x85\xa9\xb6\xb4\xa45\xcb\xe64\x12\xf2).\x02?qA!g\xe4\x99\x9a\xa7\x1c\x7f\xeaD\xb4w\x10\x8e\xea\x0d5*\xbfZ\xaf;\xa9\x0cD\xb9\x81\xefD\xdfg46\x91\xa4C0G\x14-\xe5f\x83\x9e;\x8a\x96\x19Y\xc9\x85 \xc7\xfa\x0ao`\xfe\xa8\xf5U5\xcc\xa6E\xcf\x0dC\x86\xbdC\x0f\x01\xdd*\xd0\xeeB\x8f\x1f\x9d\xcf\xe4\x1a\xbf\xd0\xf5\x0f/:~z\xcax_y\xcc\xa0\xa5_e\xb4p\x903\xa2`\x1c-\x05\xf7\xda\xady\xa0\xa6Q\x83\xf3\xc6?\xd5\x1fa'\xa5\xf0\xfc~\xc8r|\\\x82\x08\xcc-s,\x8b0B\xaaN&\xd9\xce\x92\xa2\xd2\x1d\xec\xfae&\xcf\xaf\x81t\xb9\x04L\xef\x84\xbfaEe\xbe\xc3\xb9\xea\xabS\xc2]\xb6\xb3\x0e\xa6bnI\xdbI\xfe3z$\x99\xe4g}I\xc3S\xc9\x96\x12gT\x04\xe6\xf2\x84\x8f/\xd0\\\x8ap~A\x8ewCu\x7f\x94Nl\x95f\xef*\xef\xf5\xef9\xd9\x1f\xba\xf5\xebf\x1a\x071zw=&\x91\xa9\xdc\xf0\x19\x88\xf9\xd5\x01\x83k\x05=\xf1\xb9\xeeL3\xee\x0a\xb4\x1d\xa0\x15\x98\xea\x9c\xfe\xad\xd4\xc5\x80\x9e}\xf6%\xad7wlk\xb1\x02\x97M\xb9>\x0aK\xa4\xd5\xa5\x16G\xb4\xbb9,\xf6\x0dC\xe3\xe2c\x88\xa8\x00%@ox5\xcc|\x7f\xda\xca\\\x08\xc4t\xa8\xa7\xce\xe5\xa1\xe5\xa0q\xd5\xf7\xf5\x9c\x19W\x91\xbf(h\xc7+\xa0\x92\xa3|\xdcT\x89Y\x80\xee\xf4\x93\xd0,\xfb[i\xa5\x92\xa9\xc6\xe3\x8a\xe7\xf6+#>W82R\x0c\xd9\xccQ\x91>Qc|\x7f\xbbO\xce\xe4\x89\xc4\xb2~7[\xd2\xbeo\xb4\xa4\xa8\x89\xbd\xb0L\xeeG\x14\xc4\x96:2%\x9fQ27\x89\xa44\xd2\x07\xdb\xfb\x96\xb0\xc5_\xebm\x10\xd3\xb3\xb1\xb7j@\x0b\xa1\xf2k\xf9\x8eH\xbaT+{f\xba\x95\xfd\x9dN\xde\xff\xef@zkE\xdd\x05\xf1>\xc0d\x02wA\xa9\xd4\xb7R\x94r\x99PD\xe16\x8f\xfb\x07:1,\x09I\xc2p\xb4\x82\xb8\xb5\xc0\xb6r\x1au\xacj\x86\xaf\xbe\xc7]\xc0\xdct}\xf2}\xfb\xddu\"T[\xf4\xc7\xa2\x90P\x8a\x88\xe5+\x1eKv5\x06\x07\xb7\xb5s8\xf4_\xf6\xe9\x1b\xde\xdf\xcb\xa2%\x99?\xdcit\xb0\xc9\xcb\x92\x03\x8aob\\x\x0a\x02\xc3\xe0\x9f\xf1z\x91\xdd\xa7\x8aP?y\x00\x01\xe9P\x17`\xba\xc8\xe0\xe4.dm\xe3\xdb0\xd7\xdd\xc5\x8a\xfd\xfd\xfc\x8b\xf3\x9b\x08\xdf~\x82\xebv\x81\xa2#\xf5\xc7\x9f\xe6\xd5\xbe\x97D\xae\x14\x1d\xa3@_\x98Ss\xf91i7\xb7s\x8b%1\xca{\x8aQ.\xbaG\xec\xa0\xef\x88\x81]\x9a\xc8\x04\x02\x8e\x98\xe9|m\x193\xf1w\xcb\xe3\xd4:\x9e\x08M\xa6\x1b\xcc\xac\xf0xe,\xc8s\xf2\x09\xed\x1f&mST\x85\xba\x06s\x1d3\x0bJKr\xc6*'|\x1a\xae\xdf\xa8\xfe\x80\xebn\xf3\xd3\xf0\x06)\x93\x9a\xd1fE\xd5\x0d\x88U\x0etd\x17\x9d\xb3kv\x1ae\x0e\x06\xff \xfa\xd2\xc1\x8b\xc0\x1f\xc5\xd1\xa5McH\x0f)\xf4\xa16O\xe9\xb2\xa6\x908\xe6\xbdk\xed00eV\xcbW\x83\xcd+\x90\xad\x97k\xd4\xd1\xe5\x0e\xf9\xe7\x07\xfbE\x19k)88\xebQ\xbe\xb4\xcb\x98Al\x14\x80\xf6q\xb4\x0e\x86\xf6\xb0\xa2\x14]fP4\xe9\x10\xc3\xa0-\xba\xcb\x07\xb8i\x86N\xf7Z\xae\xfc\xac%\xb2\xb6\x1dm2\xd0v\xf3\xab\xb1\xe6\xc1\x09\x9d\x97\"\x05\x85lp\\\xc4^a\xbe\x93\x04\x80\x8c\xc6\xad\xf0\x1d\xa1\x9a\xdcLqIL\xb3\xc5\xa8~Y\xb8\x83\xadV\x921%\xf6\x8d\xa3\xce\x96_\x11\xf6b\xd5eF\xea\xb0\x0a\x96\x15\x8b\x13\xc0\xd4\x98\xc6\xe7I\x1f\x86z[\x11\x01`\x9b\xc0\x06\xda\xf2\x07\xca\xc3\x0f!\xd6\x18\xfb|o\x02\xde\xe5Bk\x0c\xa7\xe4j\xd4\xb4\xe2\xb9P\x1a+O\xb6<\xae\xa6i\xb4{\xac\x9cr\x1b\xfeP@\x9dm\xc9\x8c\xeb\xd4\xf7|O\xb4\xf2\xb2\x11S*\xfa\x80\x09=\xe6\x14\x82$Km\xe1\xbcV>3\x8c\xa9`w\xd3,\x90!\x84f\"dU\x04>\x82~\x00\xd1P\xf22V\xfc\xad\xb42\xa6\x02\x02\xe46\xf7\x9d\x1c\xa1\xcb\x04\xf3~%d\xefg0W\xb0\xffn\xe1\xc0Y_\xfd\x16\x9e\x11\xf6\x9fV*f\x009\xea+2\xf88\xe2\x81\xefb\x19\x12\xef\x1b\xff\x84\x014K\xcd\x08\x18\xa7\xe7o\x87\x833*\xf4\xc8c\xeb\x06\xd2L\xdd~\xef\x81\xee[\x7f\x0b\xb7Y\xd1\xbb_\xf1D\xdc\xc4l <\xa3\x124\xa3\xfc\xda\x183\x86\xf5\x9d\x9c\xac\xb3Dq\xef\x1dg\x85\xeb\x9b\xee\xc7LU\xd7\xb0\x9b\xfa*>\x08\x0b\xd2^\xd1C\xdcAf\x9a\x87\x1dR\x10\x05Z\x97\xf5\xb0\x7f`\x9f@\xad\xadQH4]\xe4\xf8\xc1\x1e\x91\xad8\xd8\xf7\x08\xcc\xd6\x06`A\x08w\xc5\xa8f\x17\xe2U\xbd\xb4_\xf9.\x10\xa1\x02\xbe\xcc\xd3\xc8\xbe&(^J\xe08\xe7\xd5v\xeb~,SHO\xecG=\xf0\x85\xc9[rLF\x8e[\xc2\xc8\xac?R\x8a|\x7f\x06\xb7 9\xf2\x13nADU\xaeo\xb2>H7a\xb0\xdb\x00\x8cqp($6\xfa\xe4\x8c7\xe3\xdd\x94\xedc\x88\x19(\x8cvPL/\x03\xdaHr\x9e\xb8j\x8dH\x1e\xe7\x81\x97\x95\"\xca)zfr\xb3\x88\xdf\xf3A%4\x08f\xd0Ib\x99\xea\x008\x1e\x9c\xac>\x15\xf8\xc3\x17\x9b\x0d+-\xf8o\x1c\xed\x9d\x02\x9e\xa4\xaco\xed\x90\x1f\x09\x88\xe5\x09\xf5}\x0e,\x91]t%\xc6\x1e\xf2\x98\xf2\x06\x97$\x02\xf2\xd2\x90r)2\xd8\x0b\xec\xcd4\x85\xa8jc\xdbs8\xf4Z\xad\xe2\xfc\x89~\x0c#\xf4'\x10\x920\xd5TxLS`\xba\x9fTJQ\xe4\xf1\xed\x11b\x0a\xb9\xd3\xf3\\]NCS\x9a\xb9{u\xbb\x1dlJU \x8d\x84)\x11\x07o\xdc\xd4L\xc3\x08\x8b\xe0e\x1c\xf2\x8bTzs\xa9:\xf8e\xea\x1d*\xd7Lix\x17K\xd6\xb1\x02t+\xbe+T\x7f\x13\xde\xb9\xe3\x80\xab\x88\x99I|\xa8\x90\xe3G%\x83\xb9T.VaD\xea\x98\xf3\x9cxq\x0d\x1eA\x7f\x12\xab6K\x09-0(o\xc0\xdf\xb4\x8a=\xd0\x1eW\xbf\x97\x07\x12:\xf1\x0fX\xe5j_\xb1[&S\x8ei\x105\xcfg\xae\xe8\x09\x02LKx\xad\x15\xaa\xa5\xc4S\xeb\x1d\x91\x09\x1d%|-\x127K\xad:\xbcs\x05\xcc]PS\x0fHi\xb5\xd0l\xd6\xe4\xd1\xd1\xca\xbda@\x98\x9c\xfe\xcd\xba%R\x96\xae\xaa\xed\xfb\x9a\x7f\xdc\xb2\xe9\xbc\xc3e\xa0\xbd\x8b\x19f$\x9a\x11\x04T\x1d\xf3\xb5\xfdg#HV\xb9\x946\xceq\x13S\xc6\xafz\x94\x0dl~\xce\xc4\xfc\x19-\xdcB)\xf5\xc47\xab_\xc5W\xedg\x07;\xd8\x0e\xab\x96\xa6Z\xdaj\x1f9)\xabpx\x024YY\xa6\xa11\xf9\x9b\xc7\x92\x94/\xd8\xb5\x88\x89\x1cd\x14\x95 w\x12\xce\x81\xfaZ\x19\x16\xe2:5\xe0MY\xfb\x09f\xee\x1a:`%\xcd\xaa$E\xa4\x9a\"\x16\x8c\xdbY\x12-\x8f[" +
+	"~\x01\xa5\x94#I\x81\xcc\\\xff\x81[\x83\x0d\xe1\xb6@\xeaCN\xb6\x0f\xc1\xdfa\x9e\xab-\xe0\x84\xbfG\x9b\x8eW\x8a\x91H\x09\xb3{\xcbu\x85\x09\xf7!\xc7\xab\xa0\xe93\x988\xa9\xccQ\xaf\x18f\x8eJ\xf3:C\xc5\xe9\xde\xd7\xe2\xd7\xf6\xd2D\x7f\x09\xe7\xa9&E<v\xa8n\xa7\xb4\xb4\x89\xd8K \xf3J\xc8W\xe8\x16\x19\x9f\xf1\xfd-\xfc|\"\x89\x0aT\x1f\x948\x88\xd4M\x04_\xf8\x1f\xb1\xdf7\x0c\xfe\x80*\xc0E\xfd\x01;C\x07\xf9f\x89\x84\xe8\xfa8\x09\x12\x9e@7~\xb8\x9b\x12\x8eY\xc7,\x8c\xd7\x15Pu*\xe0\xefB\x03o\xc3\xaf\x9b\xd38\xf2\xb3\xa6\x94[W\xc3\x7f|\x0d\x96\xf8IE6\xa4\x99\xb8\xd7\xb0\xdf\xd4\xd7\xa1;TR\xbb\xd6%43]m\xdfD\x83\xf1\xa7\x98\xd1\xeaQv8Tu\xbct\x88\xd6v\xf5\x1dTP\x82\xbc\x82/\xc2\xaajz\x85\x14!z)\x0elS\xdf\x116V\x15\xcan N^\x88\x04\xad\x8a\x81\x09uwk\xe9\xaf\xa5\xfer\xe5)\x15\xe5EU\x0f\xf7{\x01\x0d\xe4\x9b\x8b\x0e\xf8r B\xc6`\xe6\x19\xb1\xb1\xa4\xe2M\xc1\x0a\xe1\"\xd6\xebj`\xe9n\xba\xa1\xb0\x12\xbb\x0e\x1deE\x83\xa6\xadD\x88y\xbe)\x8d\xdb\xe1A\xaf\xb1\x92r\x8a\x89\x0e-\xac\xd8\x88w;\xd7\x02\x1e\xd4\xd9\x13\xf7\x8f\x0foU\x1f\x9d\x86\xd5PD\xf0\xda\xb3\x0c\xc7\x06\xb0\xe2\xc3\xc3\x0d\x86\xe3-\x9f/\xbc\xe7S\x9eH\xfc\xe7K\xc9%5\x87\xd4\xb4\xc9\x18\x08P\xf0\xfa\x85\xf2,B-\x97\x92\xf2\x00\xe10[\x87\"\x18G\xc5e-\xcd\x98\xfe:\x1aR\x82\xab\x97xTBW\xc0B\xd6\x11\xf9\xb5!<|\x8d}L\x13T(\xc6\xb8G\xe4C\xe2\xed0mw6\xe0\xfa\x1ds'\x82i\x12\xea\xe5Z\x17\x17\x81mS\xee\xddB\xd3\xf4Vh\xe1[\xbaI\xc0\xce\xc1q\x15?\xc2j\xe7\x92\x12 \x12;JNS\xf8\xde\x922o\xf1\x9b\xe7\xa9\xbe\x9fL\x1c\xbe\"c\xa82\x07\x941y\x05\x99\xdc\x95\xc8\xfa7S\x1a\xafJA\xd2\xc0\xb8\xe8c\x04`\xb0\xbc\x07\xa8\x17\xdf\xec\x82\x8dt\xe9^\xe8\x92.[J\xc1\xe9.|K\xeba\x08\xb6Q\xa9{\xae\xb4\xacO,!\xd2\x0a\xfdYU\x1dYP\x01\x9b\xa6qA$\x90\xeb\xb7]O\x84\x98\xa7J\x16T\xcfC\x890$\x1end\xa9\x8c]@\xce#}\x83\x9d\xed\xa2y\xf1]/2\xa3\xe1\x0bE\xae\x07G\xd9\xc3\xa3\xa4\xe3\x95\x03`\xb1hc\x13:\x91>\x92\xab\xc8\"\xb2\x16\xef\xc8 /\xe4\xfe\x12\xd3\x91-Ok3\xb8P\xfd\x0b\xb6\x8e\xdd\xa706|\xb1\xe5$\xc3]\x87\xc8\xe11\x10\xacv0\x1aE\xa6\x11\x16J\xd5\xe8\x03\xb5\x9a\xd1+\xd1!\xef\x03!{\xa8\xaa\xc9n\x90\xb6\xd7\xdfV\xed \x04I\xb8\x15\x90\x84\x13U\x99UDb\xa5#w(~\x9a\xfb\x99\xd7\xf82\x06\xd2\xd3\x08\x0e\xd9T\x82\xed\xc5N\xca\xba\x7f\xad\xa3\x98\x01\xf0\xf30\xa9+5\x0e\xab\x06\xfac\xa9T\xa5r'\xf8\x0a+f\xa0\xaa\x12\xfe\x0a\x9dry\xa0\x94p\xfc\x89\xc9?\x17Y\x0cL\xa6\xcb\x07\xda9\xd7\xe3\xf1c\x9b\xd6\xe1\x8b\x1e\x00\xc1\xee\xd6(\x89\xee3<*X\xcd\x067\x89\xbf;\x8d\xa9>\xe6*@2\xe9'\xf9\x85\xc3\x17{\x0cXP\xcc\x0e\xf3\x81&%t\x12N\x92W\xc7\xceu\xb1\xc1&&\xf6\x0f\xa7\x9d\xa8z\xa4Z\xe6\x8b\x8c\x96L\x9e\x96\xdb\xc5\xc8\x08\xa4\x86\xe8\xce~09\x83\"\xc3\x16)\x17\x95\x0b\xcb\xaa\x97\xeeo7i<\x1b\xbe8\xc8\x914\xc5C\xf1BV>:i\x9a\xba\x9b\x19\x00=\xed]]\xd4U\xb0\xcf\xe4\x94Ib\xb2\xa7w\xb2\x8d\x14\xa7\xa8\xa0\xbb\x10F\x99\xb2\xd1!\xf0\x00{P\xe8\x8e\x92^t\xcd\x98\xd4\x90}\xecJT/m`?q\x1a\xd8\x9fP3\x1d\xbe8L0\xfeG1\xe2\xe6\xc2F\x8a\xab\"b\xc3[DhI|\x86\xe6\x18\x03\xa4\xa9\xaba2Wd\xe6\x98\x16\xd0~$\xa4hT\xb93\xa3\xf6\x98\xd8\xbb\x92y@\x0a\x17\xe3\xc7,r\xa0\x15\x15\xce\x10\x9d\xe5\x13\xc4\x07yQ\x96\xc7\xca3\x1b\xf5\xf3\xf5/\x8e \x01VE\xbe\xc0K8\x8c\xbe\x87`wi=\xdb\xe4$\xe6\x8f9\x95\xa9\x12\xf39%\xb3\xaaGZ3\xac\xc7X\x98*\x93\xc2\xd4\x87\xa6\xac?\"HE\xaf\x04\xb9.Oz\x8c\xcb\xd0\xb7\xa1<!S\xce}\xbe\xb5Q\xbb\xa98X\xb2\x03\x9b\xa0g\x08\x10\xe1\xa1-\x80\xcdJ\x08 \x0c5\xd6V[\x89{\xb3~\xef\x11\x00\x06\xc6\x1c\x0a{;\x1a\x883\xd2I\xeb\x81#\xcd\x14D\xfaP\xf6\xcf\x04\xb7]\x0fC2\xdcj\xf5N\xd2\xb3\xcb\x9aU0t\x11\x8ds\x8eV<\xc2)^b\xd3\xa1\xee\xf2*D\xe21v\xe5\xca\xa0\x07#\xc3\x8a\x8bQ\x04\xc4\x8e\x9d\xe6\xa5\x0b\xc5Q\x9d\xe8o)\x95\x11\x11e\x06\xe3>\x8eu\x0f\x0c\xcdW\xbaM\xd4\x9e\xfa2\xda(\x94\xc0\x12\x17\xb9)O\x9b\x14\x7f\x95\xbd\xdaD\xdaq\x98\x88\xc0\xc7\x0a\xef;\xe8\xf8\xf9\xc6)\xd1Y\xab\xf3\x83_0h\xb5z-\xd5T\xe1\x85\"\x92<\x8c\xe7\x89\xd6o/\x08\x15\xb2\x12\x9adR\xdb\x97 \xa4\xc9\x17;\x86dG\xa0Z\x8a0\xe0]*\xf3J\xfc\x05A\x9b$\xe3\xd2?\x97g\xbc\xd86\xcc\xd1Dp\xc3\x15h'\xfc\x82\xf6\xe3T\x84\xb5\xab\xee0\xf2\x95\xca\xc0\xe6\x14G\x8e\xb3mq\xa3\x8du\xe9\x9b\x89\x98\xc9\x0eO{\xb8k\xd0\xc0\x98]Y\xe2\xe9\x87\x12FF}><\xf0\xbb\xb0\xb6\xe7(\xde\x83\xbd\xbd\xb7aX\x18I\xb5\x89H\x92\xafEN\x09\x1a\x99\xb51\xb5\x86\x96@x\xb8\xe7\xc8\x1f\xad\xdf\x08\x18\xd2\xe7\x89\x15'\x18_^\x14e\x06\xfcf\xe3\xe8Fs$1\xd5l\x82\x89\x13.\xf5S\x15\x1d\x9f\xab\xd3I\x15v\x99\xa4S\x80\xacD\xc2\x17\xe8\x1a\x82\xcd\xad\xdf\xe1u[\x86g\xdf+\xe9i\x88\xb5\xa6\xf5\xb1\xccW\xf8:4\x1e\xfeH8\x93\x9b\xa3r\xdb\x986p8\xd4\x8dO\xc7\xc4\xd3~%0\x1d,%\x80\xc2\x83\x80\xd4MI\xc9\x13P\\\xdab\xe0\x8b]o\xa8\x09:\x1cz\x9c\x12\x9a\xee\xf9\x12\x1d+\x8c\x0e/\x9b\xf9\xf3\xbf\xa1n\xda\x91fS\x16\xdfD\x11\xfe\x06\x9d[\x8d\xfcW\xfd\x1f\x0e\xe7t\xd0\x10\x9b\x07_w\x15\x9e\xd2p\xfa\xdbg\x12\xab2\x05\x9dq\xcc#7#\x1d\x9e\x9a\xe0\x92 \xcc])\xe9G\xec\x03\xbdr\xeb\x87\xfe+w\xcb\xa3\xdd\x88\xf3\x1a\x18\xca\x90\x02MzkP\x96\xa8(\xdd\x0e^\x9d\xea\xae\x17\xc5\xf94w\x10\xcc\xdd\x9d\xd4\x0aL/\x0f\xb5\xe5\x99\x87\x8ai\x8d\xa07\xf5&\xb6\x86\xb9<H\xebJ\xd7A\xd9\x9b\xf0U\xe4,\xd9h\xd9{B\x8d#\x80\xf1\x1f5g\xe6;y\xda\x04\xd8(\xe8.'\xa2;\xdf\x10,\x8c\xbc7\x03\xac\xc7`\xc5J\\+\x88\x87\"yg\xad=\xc4o\x9cX\x9a\xf8Be\xe7M:\xae{r\xb6\x94\xab'\xf0f\xc7\xb2B\x87\xf5<\xd9\xe1E]\x193\x8d}\xf4\xf5\xcd\xb9z\xa2\xc0\xae\xb38\xc9\x91#K\xe9\xbe\xd0\xd9,\x81\xaf\xcd1?`\\(\xaf 881\xfd\xfc\xae\x90\x96\"\xaa^\x84\xfe\x05`l\xac\xafU\x81\x13\xb3)N\x02\xac\xe4L/\xe1\xa1=\xcc\xf8\xf3h\xe9\x0c\xb7\xb67\x0e\x1dG\xba\xe1\xb1BL\xf0\x9c\x14\x11\xc1%b\xe1\x18(\xafjB\xe4\x13\x8eDa\x9a\xd6\xd7$\xfb\x95\xea\xdcN\xa4\xe0\x83\xf8\xb2\xe3\xfa\xa0\xfd\xf6\x05\xfd\x1f'S>\xdc\xea&\x08\xcf\xe6\xd7\x1c\xd5\xc1)\x96\xeb\xf9\xc0/\x0cy\xd64~\x8a\x89\x0f\x1f\x85\x19\xe9\x06 K/\xb8\"\x13\xeb\xd5E\x85\x8b\xdc,\xaf\x80\xd7BB\xc0\x07a\x15\xdf\xc1[P&\x8c\x8d\xfc\xbb6\x95H\xd1\x15 r\x9b\xefb\xe0\x17v\xc8YG\xb6\xd02\xff\xdcS%\xf6\xb6\x9b\xf9'Y--s\xac\xa5\x90\x9812\xbc\xff\xad?\x8c\xf1+\xa2\xa7\x07>\xa3\x05\x06\xb4\xae\x03\xc0g\xcf1\xdbC;\x9c\x1a\xbf\x90\x09\xbe2\xf2zx\xff\xb9\xb8!\xfb-\xe2v<g\xce\xedB\xac?\x03\xfc\xc0K\xf6\xc3\xd6?{\x89\x83\xc7q\xe8D\xd6\xca#z\xf0\"\\\xb1l\x97N\xb5V$]Lf\x0c\x11\xbaQ\xd5\xd7h>\xdb\x89\xd1\x1e\x97\xf0\xdd\xda\xcb\x00\xefU\x0bX\x12\xac\x1ahS\x8c\x19_r\x97\xc9S\xe1\\o\xe0\x98\xed\xd7\xfe=\xdc\x92\x94\x83\xa2\xecq\xab\xb4\xaeaVx=\xc9\xfd\xe5(\x92\xa8\xba\xb3d\x1f{\xfe\xba\xc7\x96\xae\\Y\xe6\x1e\xe0\x1c*U\x96\x1b\x94sc\x10\xff\x92\xb5\xd4\x01t\x8b$E\x094\x850wRcX\x84\xeb\x0a\x1e\\\xe5\xf3\x9aVm_\xbba\x7f\xa2Qj\xbb\x8e\x88\x07p\x85\xd0\x13M\x88\x1a\xbd\xd0YR\xc6\x8f\xa9\xe4(\xfa\xeea\x82?\xf2\x9acZ\xe7B.C\x9d\x10\x03\xfa\x86\xc8\xca_\x0c\xc4\x97\xe5kJ\xe7h\x85\x95\xd2W\x18\x10q\x98FP\x0c\x0eX\xf4\xe1\xed\x06r\xd9D\xf9s\xf7j\x0f\xb7\x0e;k\xf7\xb0\xe0/l\x92\xbaK\xec\x1c\xbf\xf7\xb2\xbe\xea\xd8\x1fi\xc8\x94\x7f\xe0m\x0e\xeaz/rN\xbd\x8b\xf5vi\x88\x06H4\x8e\xe6\xce\x93L\xa7O\xb5\xb6\x80\xedAP\xa1\xba0r\xa8\x980\x13mD\xac(_r\xa8rD+@\x98\x00~6a\x9e\x8d\xf7\xb4\x1e\x86\x10\xd7\xde\xba\xed\xcb\xda\xef\xe3\xe6\xf9\xe7\xa1\x8a\xe4{'\xca\xf1\x19\x8f\xd3\xc3\xf0\x9b\x05\xb2IO\xe8p{\xe8\xf6\x1b\xa3_`X\x85\"\\Ld\x8c\xa9\x00\x82\"x\xa2\x9bT6w%l\x13T\xa4>!\xc2\xb40\xf4\x1f\xf1\xe1\xfb\xd8\xfem+-\xc5e\xa8\xf3\x94\xd3!\xe5\xeaay\x9a\x14\xa2\xa1K<\x98\xa6\xa1g;w \x80\x1b\x9cZ\xdb*R\xb27c\x0e\xb7)\x9e\x9b1\x99v\xea+\xf0\xe8\\\xae\x90N\x8e\xb9\x88\x93H\x91\xa1Bw%\xca\x0eH\xdcf$\x08\xa5\xa5\xba\x8em\xdc0=\xe5\xc7\x16\x90\x92\xc1~'\x8d\xfa?h\xda\xe5\xf3A\xa8.\xf5*\x18l\xef8\xb9b\xc1\xdb\xd3\x9c\x8a\xba#yy\xdfF\xd0\xab\x83\xa0\x11NI\x89\x92\"\xb1\xa7\xa0\xe39\x1c\x82p\x8fA\xeaJ\xaf\x0e\xbd\xd7\x91y\x0e\xb84\x95Q\xb5\x923\xd1\x01\x8b6v\xd4\x8b\xed\x193\xe3\xaan# \xa2p\xd5#\x82|\x0a\x8bE\x1a\x11i^\x8aU\x02\xe6@O\xf1(q\xcb\xe1\xf6\xde&\xf8a\x9b`&\x1dP\xaf\x9b&\xb8d\xaf\x12\x04-\xa0\xa6)6\x9a\x924\xe0\x82\x92F6\xc6\x16\xe7\x87~\xa0`\x19\xde\xd2\xeeO\x9c`\xf93\x03~\xfe^\x8bx\x99\xe3\xbe\xc8\xfd\x84n0\xd2t\xaf\xefPj\"/\xb3p\x9a\xbb\x8d\xc5f\x09\xd0\xa8\x7f\xe1\xfcc\x91\xaau1\x901\xdf\x0d\xd7H;W\xc4~{\x1a)\x11\x8b\xaeT:\xfe<\xf5\x9b}\xe0_t\x05\x96\xd8\xbdt)5(\xea[\xf9J\xfam\xff$B\x09\xca\x93\xae\xa8c\xfdD7\x8eW>+\x93\x8b\xf5s\x88\xab7\x02\x1e\xfe\x0c\x10\x84m\x1do\xb9\xdbD\x12^\x8b\xadv\xc6h\x9b\xb81\xcf\"\xedx\xcc\xe5\x9c\x0dt\xe1\xf0C\xfceV\xc6h\x92agj\xdb\x8f\xce#\xd57t(M\xa0\xde\xac\x1a5\xa5\x14\x91\xf3s\xfd\xcco\xd2L\"\xe6\xff\x8b\x8e\xfc?DWq&*j\x1b}\xe9\x14\x00c\x96L\x90\x1aI\xc0g\xbb\"m\xdfI>l\x9bd-\x11\xb5\x88:O\x00\xf4\xd4+ grE\x98\xf7N\x82\x06+(\x19b\x8f\xecT\xe6kKU\xe7;\xb3\xcer\xfd\x96\xcak\xd7\xea\xa7\x9a\xb8\xa5\xa4t<j\x19\x90\xf8\xb9\xed\x8au\xfbU\xca\xec\xf8\xe3\x1a{\xd7\xcc\xd5\xd5\xcc\xd9\xf9\xc8\xc0s\xe7E\x0a\xa2\x7f\x0d\x04\x82\xabLu\xed\x08r\xabJ`S\x9a8\xf1\x90wa\x85\x14\xd0\xd1\xd4\x81\xb5\xe5m|!r\xb0\xd2u~\xabZw\xbc$]\xcbJ\xff\xc6\x91\x10T\"\x80\x17\xa9\xc3nW\xfc\x86U\xfb\x9d\xe1\xe6\xbd\x8d\x03\xbf\xeb\x0c!\xe8\xeb\x07\xb9\xe7\x8f\x9c\x1e\xb1\x96o\xda6\xb9\xc2\xf4\xac\x05\x0b\xfa\x80\xf9\xc4\xd7\xf1E\xc1\x89\x7f\x89\xa9|\xacWR\xa9\xeb\xb1-o\xb6\x95m\x93\xa6\xb7\xb4j:\xdd\xbc\xd9-x\xee\xf1\x12\xcc\xb6Cyj\xe6M\xb8\xa1.\x12\xcbj\x85\xcd3Q\x09\x8a1hy\xcf6a\x0bn&\xea\xf7\xddkj\xcb!2+Z\xac\xe2\x0dn&P@}9\x00\x05\xaf8\xdd\xf7\x98V\xcf\xe1-\xe4\xc6\xcbu\x8e\x9fZ\xbeP\x05\xfc\x89\x94\x06h\xd0eG\xb2\xa3\x89\xe2\x86\x05Z\xd1\x08q\x9fg\x96>\xbb\xcd\xd2m\x0b)\xb1B\x80\xd1gBnVY\xfb\xc4\xe3\x03\xa6\xe2Kd\xe5\xbc\x0a\x0bj*\xe8<\x9d\x19\xa1\x0d\xf96\xf3\x13\x82\xab\x7f\x0b\x02/\xc8\x02\xc8\xdf\xb1P\x94\xc8\x96)N\xc2\xb2\x99\x86\xb7\x9a\x19jQ\x9e\xc4+l0%\x84\xf32\xcb\x8d\xb5\xa2nE\xc5\xd0\xe9\xdd\xc4\xb4\x1f\x9c\"3t\x9cz\x0b9I\xc8\x19xS\x1d\xf5X\xf8;m\x0b\xff;\x90\\\x1b\xf7\x9e\x99zAm\xe0\xff\x07\x9c\x19\xb8\xc9<\x19\xf3{p>o\xd9\xeb'6\xb1S*\xa3]\xe5\xb6:uZ\xbcQ1\xe6OL7\x84\x99oYP\xb4\xd8\x88b\x03\xd8\x93a\xf4\xe0xW\x92\x1eZ\xbe\xf2Gi\x9d\x0fwv]EZ\xae\x84T\xb2Z\xd9\xd9\xd9v\x81g\xa2\\y/M\x96\xf7\x03qsR\x05O\x07>e\xb6=@s\xfakw\x96H\x9f\xea[\xba-,\xe4;dO\x03\xe6W\xfa*\xb0;\xc2\xf8\xbd^OA\xfd\x96c\xeaQ\x82N\x02,\x0a(\x17\xd1e\x18\xafD\x8e\xc8\x09\xad\x84fl'\xdeDE\x02U\x83\xcb\xb5\xd2H\xbd\xf5e4\xc6;\xc2\xce>jE\xd7x\xb0\xd6\xb7\xc9\xb3'\x95\xa0G\xea\x81\x0fw\xf6\x8cno[\x91\x19e7\xd8\x89\xa15A`\x18\xd1_\xf2\x86\xd9A\"\xaf\xd1y\xa7a\x14I\xae\x98:.\xc8\xabHn\xe5\xa7\x1f\xee\xc3b\x05\x16\x13p\xdd\xb35\xb7\x1bk\x07m\xd4\\\xca\x1c\xeb\xa9T\xa3.\xe0Qg\xf2s\xee\x15\xbd\x12\xfelQ\x7f@s\xf1\x00&+X\x88\x04W\xf46\xc5\xccs\xa13\xb62\xa5\x9c\xb0\xb23\xb0Pg\\g\x88\xbc\xa3\x14!\xf8\xd4dmA\x04c\xb3B\xbf\xad\x9c\x80\x9d\x87\xcc\xfeJ\xe5\xf41\x0e@\x86x\xfa\x93\xf4P\x85\x90\x13k0\x96\x94\xd5\x95\xad\xafV\xccacY\xf9\xe1\x0eS\xd3B\x04,dqr8\x1d\x8fB\xf6QM\xb4\xf3T\xe4J\xd11N]\xc1\xe8d\x8bM\x1c\xde\xcc\xa4\x88k\x14\"\xd3WK\xa9\xb1+\xd5\xb0\xcb\x1a\xbeo \xb7N\xedy8K\x85U\x94\x82\xfe\xac\xb9\xc0V$\x0a\xa8&\x0c\x1f1!&\x13\xcd\xb1'.z\xe8\xa6\xae\xb7\xbf)\xcc\xdfj\xa9\xaa_.7\x03\xfb\xef\x1c\xa6\x85\x13l\xdb70\xb3\xb9 \x84\xff\x81\xbd\xa9\x05\xe5\x90>\xc2\x07p\x81d\x81\x11\x8b\xe4mB\xfd\xb6\xde\x00\xe0\xf8wn\x80\xdd\x17\x9e\x18l\x01\xc0Mh\x0b4.[\x1bI\x1b\"\xba\xe0\x17i\xef\x8eG4+|X\xe3\xdc\xd5\xdf\xf1\xda\x96Ee+\x11\xc1w\x9c3\xcd\xdcIF\xc5Aw\xf3\x1b\x18\xfb\x1f3\xbb\xc3XA\xcc'9\x1a\xa0\x19N\xcf'4\xa3\x09\xcb\xef\x00\x93fJ\xd5\xb0s\x91\xee:\xda}\x9a\x0a\xe6\xb4\x99\xa3\xadZ\"G\xfe\xc4\xedJH\x19J|\xf4\x83\xe9\xb10\x08i\xd5\xe3\xeb\xe8\x89\xd0\xd8\xd0\xd0V\x82\xb4\x1c\x12-\x91\xf8^\xe8`\x9c\xa6\xd2\xa6K\x91ep\xf2\x86\xa9e\x8a\xea\xaa\xf0\x9d\xe6hm\x9f\xa6T\xa7\x9eF0u\x8c\x8b\xde6e\x18\xce\x04J\x0e\xf4\xed\xb9\xc5\x18\x9ek%W\x15\x09v\x08\x1e\xb1{Q\x08\xfe\x11\xf6\xfdJH\x9d:\xcc\x1e\x8b\xe8fP*\xacu,(\x94\x01f\x11\xb1\x05\x96\xacE+&\xdeA$)hq\"r\x9e\xb06%\xc1\x1e\xaf\x01#\xb7zl\x15G\x8ez.\xf4\xadL\xdfC2\xe6q\xcc$\x9f\xf4\x0c\xdf\x9c\xb5\x92\x92\xf7\xe3\xd1!\xfd\"\x84\xe5\xc3YX:R\xbf\xa0:Q\x7f\xc4\xaf\xdf\x83\x9e<\xe7\xb2hi\x8e\xf4\x9a8\x16\xbc\x84\x8a\xd55\xb2\x04bv\x93'\xa09\x8aaK\xfd\x01G\xf5*\xa2\xb9\x8e\x09\xcd5\x82E\x0c\x13\xff2\xf1\x8cO!?\xf8" +
+	"\xa0I\x8b9\xb7\x89V\x84\x8eP\xc1)tk\x81(x\x90\x89R\xad\xb8\x0ch\x9dS\x0f;x\xc8\x18\x15{\x8d\xafz\x9c\x9ek\x95m\xa264\xdc\xddv\x94(/\xf4Y\xa6\xf0\xb3\x10ig \xb3#\x16\xa6\x14^M\xcf<'\xe2Z\xb1w\xbf@\x87\x0a3\xae\x17\xa8,\xb9\x10\xc3\x94o(|\xb6\x11\xed+\x93<\xdc\xdd\x11\x99R\xf2AL\xff\xb0\xa6o\xf79\xe0\x97h\x0b\x9d\x0c\x85\x83\xf8\xe4][\x10=Z\xd8\x14\xef`@D\"\xa8\x8d\x03K0\xc7#\x0a\x8a\x03\xa0\x97\x03\xb8\xb1\x11\x93\x1e`\xf2\x900\x7f\xd5\xc0?\xea7\x82s\xef\xee\xfe\x04<\xea\xed\xebSk\xaab\x14\xea.\x8a\x12\x13\x8a}\xf0\x13\xbb{\xaaOj\x9a\xf0?\xeb\xb2\x87\xc8c!I\xb7\xab\x15P:\x89\xab\xef\x83Y,\xc4\x8a\xab\xdc\xe0(\xad\xc6\x1e##\x0c.\xb7\xc0\x17\x84\x1b\xff\x8e\xf5\x84\x98\x7fF\xe2\xef\xba\x9d\xf5}\xd3,6\xf7\x86\xc1\xe1#q\xc6B\xfai\x12\x8eo\x86\x87\xca\x1f\xe2:h\x90\xb1\xe5F\x89\xdb+q\x10\x97\x12\x8d\xfb\xb7b\x91<\xd3\xb4\xafTjZ\xf6\xd4\xd9\x06Y\xe0$\x0c6.\xbc\xdbX\x13{D\x82\x01\x95\xe20a00\xe65ZLNL\xf4\x0e\x8e\xc3\x91\x06\x0a'\x0c\xe3S\xbc\xf0z2\x05\x95\xa4l\xef\xa9\xbaz\x0d\x92\x9b\xb5\xac)\xc6\xbe`\xb0\xbe\xbe\x84$\xf5tBj\xde\x83\x04\x99\x9e\xf9\xb44\x0e\xdf<\xae]\x85\xb8\x9e\xa5\x1c$-_\x14\x0d\x00\x03=\x81\xa63%\xe9\x9d\xa0\xcblp\x11O\x9f\xdc\xf5\x9aX\x92\xae\xe4\xee=KM\x19g|\x02+g\xc9\xf8T\xa5J\xc6/\x9fh\xe6\x8fX\x8ez\xa6x\xad\x88\xbd}F\xfa2R\xc9#\xb0\xaa\xd5?\"\xe0\\YL<S\xcdw:\xd6\xcea\x8e\xaf\xdcl\x00\xe9{\x10\x9f\x9f\x0b\x0b\xd3\x8b9\xb6\xa5\x86\x01\x17\x94\x17D\x01\xe8\xd8\x1e\xa5I\xf9GUw\x91\xa9\xdb\x09`\x14<\x8a\x1d\xe3d\xb2\x17\xf2H\xc6\xb3\xb0H\x1e\xfaa\xa8\xf78\x1d\x03\x00W\xad]\xc3\x81\x15\x99\x9e\x1f\x04\x93\x09c_c\x80]\xf2\xdb\xbe\xa2\xaa\x91\x8e\x15\xfd{@s\xd4\x0c^T\xdf\x05\xd3\x18\xd8\xb6\xfeM\x92L\xa7.Ly\xb3\x13Mb\x1a.\xf1c\x7fB\xc6='o\xc1s;e\xc5\\\xc1\xa6l\xb4J7\x964\xe3e\xfa\x12\xa9\x9bg\xba\xf6\x84\xaf\xfdC^\x06\xbc]\x86V\xf7y?A\x86v\xb8\xb7\xd52@V\xebeFr\x89\xe1\xa89\xe6?M\xb8\xb3<\x963\xe8\xcf\xdc\xc9\xbd\xfe\xaeIj>I\xcc\x7f\xb8'ia\xa9\xfd)b\xe6\xa5j'\xc7\x85\xa6\xb3\xa3\\\xdf\x05\xdb\xd5$\xb0QTc.ds%!_\xfaVR\xf7v\xd2\xe1\xbd\xec\xc9\xcc\xf4Q/\xc6\x94\x93\x09?)\xdb\x09v\x15Y\x11\xb5K\xf1o\xd4\xa9tn\xce\xec\x0c\x13,_s\xed\xc1\x10\xc7.\xc8U\xaf\x980\xb9\xaa\xdf\xe2k^R\x82\x1eh\xbe\xdb\xf9$U\xaf\x85\xe4\x93<n\xe7\x93<v\xac\xabD\x13HL#\xa6\xea\x192\xd6mQv7\xd5IP=}\xc2\xb5\x19)\x08\x94R\xab\x0fc4J\x1b\x17\x0b\xfd\xf2\xa7\xb1\x9ce\x08\xde\x1c\x09W\x84\"\x90\xa7\xd0\x9c\xeak\xc9:\xfe\xb7\xd1\x93O;/\xd2\x82\xf1\x1d\xee7\xcd\x01\x85\x11\xe9\"O\xe6\xd1\xb7\xd6\xfc7\x7f\xfd\xd5\x1f\xbe\xfc\x04\xfe\xbd\xfe'\x09\x86\xffb\xf0\xc5'\xbf\xfd4J\x03\xd7\x97\xf5C\xbdZ\xc7D\xbf\xc8L\x9b\x12\x12\x9e\xd4\xef~1\xf8\xe5g_\xfd\xf6\xb3\xcf?\xff\xe47\x81\x81\x1c\x05B+\xaf\xbf_\xf7\x195S\xfb\xe7.m\x0d\xb6\xb1I\x08\xad\xc2\xab\x84\x0cX\x9d\x9d\x04\xda\x8d\xd3%\xda\xd0+j\xe4\x84\x89?\xd3\xac\x18\xe5\xc0\x93 \x93B_\x19\xc9\xc0\x0d\x99\x93\xb63\xec\xc7V\xe9\xaf\xe5\xa5u\x9fT6\xb8kMh%\xac\xbb:\xf1\xc7\x0d\xc6\x7f\x04lp3\x96\xe9\x9a\x89,\xe4m\x92\xd4\xdbI\x14\xc4\xc5\xbb\xf8t\x1e'\xe0\xca\x1c\x1b5\xe7fD\x1b\xf8\x8f\xe4\x10\xa9\xd26u0\x17\x14\x83h\x10\xcb\x09\xcb\xf2\xa1\xc5\xe9\xf6%\x13\x9e\x06\xedl\x80\xc6\x08\xd6T\xa4#\x1c\xd0X\xea\x911t\xb7\x8f\xec\xc4\x84\xb6\x15UE\x18*\x91\xf0\xe3\x068&\xa5\xedC\x85`\x16\xdc,\xf2\xc1G8\xacV\xf1\xb4L\x97\xa9\xeal\xea\xb8n\xdcC\xf1RE\x8f|\xcd\xbe\xf7\xd0\xd4/L\x97\xf6Y\xae\x13p\xba:\x02q\x0c?\xe2\x82\xa8\x17\x9d\x8f\xa3\x9a\\DA\xed-\xd6\xb2D\xac\x1c.\xf9\xa69\xc7\x83\x80E\xe2\x05\xebG\xfd*\xbe\x0e\xc1e\xc2\xdf\xc9w\x08\x8fSJm\xa7D\x8b=\xc9\x17\xa8\xd6\xbb.\xad\x1a_t\xf0u8\x8d.\x1e\xf9H\xde\x02\xe2\x96\x04W\xf3\xae+\x06\xcc\xd5\x89g\x94\xa8\xea\xd5S\xf8\"+\x1f\x07\xad\x96\x82\x15\xdc\xfa\x039\xdf$C\x13ul\xfd+\xd1c\x1f\x83\x02(I\xb6\x1b\xdc\xfb\xfe\xac2\xc3\xec\xcb\xb5\xc9\xdc\x09\xbf\xf3\x1dz\xf8\xf5\xaa\xed{\xe8\xba\xf3\xdbV\xd9L\x15\x11b\\\xa32\x06\xfc\xa2\xfe\x16\x10\x88\x05\xb5\xab\x86\x91\xbcu\xb9\x8f!(\x8c\xe3\xb4\xfe\xaebVS\xed;VE)\xb6\xfd\xf4\xa2\xb9S\x8d4W\x92\x00\x892M\x0f\xe8\xea%\x8c\x87\xfc\x92\x02\x8e\x0b\x99\x03\xe6\x009\x86\x19\x97,\x1fS\x9bsJ\xdc\x159\x7f\xeb\x9ba\xa4>\x11\xd2Y\xa1\xbcpnEY\xe6\xc4\xa1\xc4jJq ZH\xff\xb7\xbd\x81\x18\xc1\x117\x07\x91\xefyR8\x1f\xe9\xdeA\xd3Q,\xd8\x03\xc0O\x09\x7f\x8b\xee3\xaas\x88\x9d\x12\xeeG\xc0\xa1k\xcb\x16g\"},\xa7\xad\xa3\xc4>m\xe2;\xce+\xc6\xa7\x83\xbc\xdd\x1c\xc6X\x04c\x92@b\xfd\x1fQ\x14\xe8!t\xa5t\xa7\xf9\xbc\x1b\xb6\xbc\x10X\xf2i8\xe1{\xbc\xd0^n\xce\xd8\xa4B\xa4=\x876\x7f\xb5\x92\xc6B\x1a\xe0!\xaeb8Z5\xfd\x8fL\xf9\x14\xcd\xb1>\xd5m\xea\xa7\xe5q\xf7\x9d\x02%u\xe2W\x90%\xe6\xd2\x1e\xbb\xc0\x0b\x0b\x90O5V\x05\x1e\x96L\xdf<,.\xf9\x0d\xae\x1c\xb4\xa8\x1b\xd0\xf7b\x1ea\"\\\xc7*q{\xd0\x12 \x1e<\xcb\xbc\x1e\x11h\xc0\x80\xda9\xb3\x07\xde\xca\xd1;\xc3\xee\xc4Il9\xad\xff\x08\x98]q\xac\xdc\x82\xbc\xfd\xa9\xe6'e&\x00\x02\x0c\x1c\xbb\xd9a\\(\x88\xe8\x11\x98\xb4(\x19!h\xbd\xf0\xe7\x8f\xc5\xd5\x1dZ\x06\xa7\x18\x8e\xdf\"\x95\\8Y\xb8\x1e\xc0\xa2'\xe0\x92\x85\xdd\xba6\xcab\xa1\x8a\xd6<\x14\xf0\x87\xcc\x8f\x94\xbd\xd34u\xf5\xbd\x92\x96-2\xc8\xa8\x15\x12\x9d\xa6\xcd\xae\xb1Ij}\xefz%\xee\xac\x19\xb3\xf9\xfe\x8fjS\x10,\xad\x08|\xba\x03\xef\xf4N\xefc\x02t\xa6\xad[%7\xae/hc%?\xe6A\xc0\x86\xdc\x99\xae\xeb\x89/V\x00\x8a\x08s\xf1\xad(\xde\xd20\xa6\xa7-i\xbb\xc3\xd2I\x88W\xe8\x8f\xb8\x89\xbbG\xbc\xcaV\xdf\xec\x0c`\x96\xca\x18\x9a\xd3f\xee~]\xdal\x8f\xa6*?a\xc3\xb4\x99\x1atY\x12\x90=2}\x08\xd7RT\xc6\x12\x09\xc5\xab`\xa8o\xba\x1faK\xae\x99\xb0\xb3\xc6\x0akWp\xcf/K \x9cs\xadD1n\\\xc5w\xc8\xadx\xe0Wg5\x15y\x0f\xb9Bd\x93@X\xbf\x1f@\xea\xe8H\xd3\x87\xbf\x03\x90\x01\xf4\x1c&1\xa0\\\x82\x94F<j*\xcf\x14T\xac}r\x05\xd5\x8f\x13o\xed\x99\xf6\x05\xaaL^\xd2\xda/\x92\xf59\x08\xab~\xdc\xcc\xe5'\xfe\x1a\x15\x1a=\xdch\xd4\x1c\xa7\x8b\xa1\xc8\xed\xe0\xc2\xae\x91\xc7\xf6[n9n\xa0\x1c\x0br\\\x7f\xe4q\x90\xb5\x02\x0e\xeeR\x90\x07\xd3G\x81\xe0\xc2\x1cx\xa9\xe6\x8a'\x8eE\xb6\x11\xe3\xa2B\xaci\xb7\xde@\xdc\x90\x10yv\xee\x06\xd7+\x94N\xaf@\xebC\xd1[\xb1\x8d\xe9\xc4\xb3\x19\xf7S\x93\xfen\x09+[Un\x0b\xc8\x98\xae\xb5me\xa9\xe0\xa6\xd3\xb1\xdf\xda\xf5\x9dD\xf6\xba\xeb%\xe4ug\x82I\x1bz\x8d\xf5\xb7\x00<\xa6[\xc5\x8d\x1f\"z\x10\x0a\xe8Ah\x1d\xfe=\xc3H\xc7\xc2\x9d\x03\x19.RT\xeb \xae|\xec4\xcd\xcd\x15\x00\x0d\"\x016\xb2\x18\xad\xaf\xafO(\xa3\xd4^53l\xb2Fy\x15\xbc\x1ap\x1b!^k\x0eb\xe1\xba\xb4&C;\xf5\x09\xfb\x1bm\x00\x9aq\x0avC@\xc7f\xac\x9e\x82\xd4\xb3u\x84\xc7\x96\x01\xa6\xe2\x1d\xa6\x1a\x17|\x0fB\xf3~\x89\x15P`5\xaf\xc0\x18\xc4\xe2\xc5\x8b\x8cm\x9e\xc1TW\xb1\xb2\xe2x\x1arR\x0a\xf3\x01R\xdb63\xf5Q\xd6-\xb1\x05\x9a\x99P/&\xde@\xc1bz$0\x9f\xa5\x88\xb6\xad#)\xa3\x84\x15\xef0\x87\x11\xc4\x15\x03l\xa3u\xcf\xa5\xf3\xa9\xc2\xfcdb[M\xa8*\xc1\xcb#K\xcd5\x92\xa0\xfb\x08\xd9\xd4\x19mJ\x9c\xdd\xe6:\x0fU\x1f\xd7\x1f\xf5\x91\xe0p#\x03'\x84\x16\xc2\xceh\xc9\xb5\xf4\x0e\xd7\x97\x94\xa0V\x82\x09#\"\x8c%d\xe9%\xb8\xef\x027\xa8\xa9+\xd3\x86h\xf6\xd9p\x11\xf4\xd7\xd2\xdc:t+\xe7\xf8\xe2\x06\xa4\x91A\xaf\xb3s\x01\x9248\x19%\xb5\x88)\x9e\x06\xa4\xf2\x15\xf5\xf4p\xc3;(?\xdfr7\"\x0b=\x12\xe6\x15\xd8\x03\x01\xfc;\x95\x08\x04\xe2\x91\x93\xadA\xb2\xeb\xe2V2\x05r\xdf\xc2\xfa\x81o;\xc7j[EB\xb0\xf1A\x03\x08\xc0-\x00\x83L>g\x12\x11\xcf\x9f,\x05\x89D\x9c\xc6f\x8c\x80<e2\xe0\xbbP\x8c{\x1dv\x9f@\x97o\xb6\x85;E31:\xc6\xf6\x9dEF\xee\x89\xb5\xb4\x98\x00\xa7s,\x87\xbd\x8e\x8c@\x0eB\xefH\xfc\x88\xf5R\xcea\xff\x05\xa5\xb9L\xe2\x0a\xbb\xb7\x0d'\x7f6\x02\x97\xed\x14$\xc4\xa5\x13\xd3\xe4\xc3C\xf1\x83\x83m1\x0dB\xf6\xb4\x0c\xdb\xe7\x81\x0e6I]\xb3\xfei\xfdA\x00\xcc\x16\xe4>\xe8$Bd<\x7f\x1bM\x81\xea*\xa5\xd0\xber\xb4\xc6\xe0\x85\xa4J\x82Gb[\xf9$\xb6\x09\x8f\xd7K\xc3\xc7C\xc6)\xfe\x80\xf8J}\xe2d$>\xaf\x04\xf4\xa8\x07\xe5y\x7f\xeb\xba\xbd\xdd\x86\x8bk\xef\xdb\x8b\xc3\xf8S\xb5\x9c9\x927\xe2\xcf\xdc\xc3\xeeQg$Zm\x84\xe8\xca7\x10\xe6\xcf,\xea\x9e \xc1\xb8*\x0e\x14\xb5\x1dF\x8egv\x05_\xd6W\xe6\x9eI\xb7\xc1OsXn\xefd\xf9\x7fSu_&3?\xa3v\xd9DrC~\xadtP\x98\xcc\x99{\x19\x82\xceJ\xa2\xbe\xef ^\x13\xd4M\xe9\x0fC\x0a\xf1\xb6W\x19Sp\xcb\xb6\xda\xe4\xef\xa4\x12\x91\x81\x7f\x1b\xd9\x9d\x9f\x8a\xa3\xdb\x90u`\x98\x84\xe7o}\x09\xbcx\x91bDA|\xea\xd7\xb0\x9fG\xcd\x99\xbe\x99\x93x\xd7Q\xd1\x0d\x80j\xbb\x9dZC2\xfb\xf4\x9ee\xc5\xfe\xbf\x90JY\xa6\xeb\xc1)\x9b\xc3`D~ V9\x99\x0a\xbe\xdd\x94\x8a\xd0\x98\xdb\x1e'\xfa~*>\xf2\x932\xffh\x14~\xbeq\x04aIv\\`\xb9b\xfc@# \xe9y\xb3\xca\x08\x0abV\x19\x12\x86nq\x1d\xde\x16\xa5\x84\x1c,!\x82\x0d\x18\x89$\x8c\xb3\xf0\x9dKn\x11\x1a\xf8 Lp\xc66\xd3J7w\xd1\x0c\x88\xb0\xdc.\xfe\x19q\xcaog*Y\xb7\xc1b\x962|\x04o\xa8\x04\xc4\xf4\xf9 \x812dz>\xaa\x96\x84B\xab\x8c\x81'\xd6\xb5\x8ak\xb9\xe4\x1aYl\xba(\xd4C\xa8\xf3\xe4L6'N\xc57\x08W{\xc2\xce\x84\xb7\x0e\xaf\x04\xbd\xb6`GQ\xbb=Q)9\x12\x8aIs\x85,\x96M\xf3\xe3\xa6\x12\xbb\xebJ.r\x9d\xd1\x0d\xf7\xf9\x13\x16\xc58\xdc\x15+L\xf0S\xab\xec\x09\xd0\xfba\x93\xd5\xcb\xb80\x03I\x04\xc2\xf6\xcd\xcc\xa8\xc1\xe6uaI\xfb\xf9\"gF\xe5z\xa0q\x18\x1bx\x09>\x99\xad\x98U;R8\x84\xde\xe8\xe6\x98\xba\x0b\x9f\xd6A\xd1\xce\xac\x7f\\\xbf\x0f\x0f\x0e\xec-!\x94\xe2F\x9f\xb4}\xadh\x81t[l{\x17N\xf3E\xcf\x0e\xa4\x9f\xe8(\x14A\x1c\x14E#\x8c\xb84\xd8\x03h\x04hN\xd5\xf1\x98\x0aC\x19\xffJ\x99\x97\\\x8a\xb1HY\x06\xc2d_\xeb\xaf\x03O?Y\x8bX\xad\x13\x0d\x1b\xaf)t\xc7\x84\x8b\x17\xd1$\xbd\x8e\x9br.\x09V\xda\xb1\x03\x92\xc8T\xe2\x91j\xecL$\xeb%r^l\xe2\x8f\x14\xc7\xdc7\xe7\xbd\xdfc\xc3\x8a\xb5\xe6Au%\xafu\x18\x8ap\x0c\xa9\xcd!\xd1?\x00z\x94R\x89\xcf\xc2\xd6\xb0\x81\xb3\x96d\xae\x9f\xee\xb0\xedh\xf5\xc4\x18}EC7U\xe5x\x02\xc2#\xd4n\x85S\xaa\x08Y>\xc2\xcb\x9e#\\e\x0eE\x10t9\xa0\x08$\xcczi\xc8ia)a\x9f\x8buE8\xf0\x9cD\xf6\xfb\x81G\xdfDs\xad[\xce(i/Z\xed3\xd0]l!7\x84\xce\x05\xb5o\xa3i\xb9\x940\x01y#\x93K\xd6\xa8I\xe5\x13\x1a\xd5C\x8e\xfe\xa7y\xc6\x16\x98/\xcaZ*\xdc\x81\xac\x08\xbb\x8b\xa7\x84uW\xafb\xf0\xaf\x04T\x8b\xfa\xaa\xe3\x04\xd8@v\xc6\xf0\xb2R\x11\xbc\xf9\xa6\xf7:O\xbe\xe7\x1c\xef\x12\xdd~\x8b\xd55]\x05\x90U\xffX?\xa5\x9c>\xd7\xe0\xa7\xe4B\x85q&i\x0e\xea\xbd\xa6\xeb\x06\x83\xae5j\xc4\x95]\xee%uU\xbd\xae\x09\x01\x8a\x07~}\x89'\"Sb\xff\x09\xfeT\xad\x8b\xeb\x12\xaf\xa6O2\xf6[p\x1f\xff\xd0\"\x05\xfc\xcd\xa3\xcc\xeb\xae\xea\xf2q\xfb\xe9KH\xdd\xc9n\xfa\x0eyxJ7\xc7\xa6<\xf8-.\xc9\x87\xa6\x82\xe1W\x0dz\x93\x16v.\x19Ct\xaeT\x1d\xaf\xa7\xa3\x0b+\xa3\xaa\xaf\xa9\xad\xc8#\xe1\x9c\xa30)\xb8q\xa3\xd8~\x09?\x8bY\x90\x19}C2\x0e\x90SJt\xf8w\xa2\x87\\\xf5a\xf3>\x12l`\xce\x1e\xf1\xf6\x12L\x03I+\x9ee+\xdbByM\xbb:^\xdb0\xf0\xc8\x02\x02\xafs\xb4\xf7sZ\xb4\xef\xa0\xa0:bw9.\x88wx0\xd0=\xc9\xd4\x1d\xe9d\x9a\x1c\x88\xaa%\x16\x00\xbb\x99\x04)\x0aq9\xb7\x10\xfc\x09v\x0b\xfa,\x97\x9bn\xa5\x83G\x18\xc8\x8e\x15\xf6R\xc8\x83\xe95&w\x8c\xbb\xb8\\\xdd\x0d\x82\xf7N9=#\xb0\x97\xd7p\x1a?\x18\x82\xb5\x96\x03D\xfb\xef\xbd\xb6\xe7\xa1\"Wq\xe2H\x9f\x0e4$?\xefH\xa12\xc9\xae&\xe9\x1f\x97\xd4:aJu\x92\x0b\xae\xef\xdf\x99q\xf4\x9a\xed\x0c\xe7\xe5r\xa3\xbc\xe3\xee\x8b\x8d\x17\x94=I\xfb[\x12<r\x82\x7fX\xbf\xc7\x91\xc8\x85\xa3\x9a\xf8\x9e\xe3\x7f\xe4\xe2\xddPX \xd7\xcb\xd6Nb)\xcd\xb9\xb5\xdd\x1be\x9dv\x87-\xa7\xa0_\xa2uh5\xc6\xc4o\xa9x\x11\x1f(\xc9\xf9<\xda\x8f#j*\x93\xd5\xb2\xf0(\xd3XFP\x998\x81\x97%\x01\xa96\xd5KJ\x9c\xe7\\\xeb\xbe-W\xd7\xb0\xf4\xba\x83\x97\x94@P\xfa\xd7\x01V\x9dP3\xab\x9c\xed\xb9\xab{\xccT\xc6c\xb4v\xa2\xd3\xaf\x0f\x7fXR/\xdc\x04\x0b#\xe8\xea6 \xfc\x81\xf2Ps\x0a\xae\x95#Tb\xe9u\xecOJ\xfeI+\xc72/\x09\xd9\xfc\xa3\xc8q\x9e3\xce!\xef\x1b\x9c\xda\x84U\xb9D\x90\x10.\xcb\x11\xec\x86{@\xd7<(\xd1z\xc3\xff=U\x94\xd6\xcd\x08\xbe\xc2\x01\x8e%~7\xe1\xa5\x8a\x19\xf5/\xcb\x81'\x9b\x94\xefQ\xc4\xf0\x02s\xa1\x16\xf6.\"\x8d'Im\xedz:\x96\x8ao \xa7\x9f$\xdf\\t\x82\x15I\xb6L\x08\xb9h\x1dD\x00\xa2\x16\"\xcdU\xd5K}\xa8 %\xebz\xf3\xc8\x1eB\xce\xfa\x9d\xbaU\xa2\x98\xc5\x8c\xaa5\xd0E\xf51\xfe\x07\xe6\xc2\xc6\x08)4\x02\xd1\x94s(\xd8\x8d\x1d\x03I#\xeag\x81\xd6\xdf+6\xc9\x0c\xb7\x99D\x17C\xbcks\x04C\xb1\x92\x7f^\xdf\x0c\xf1P\x13\x16f_\x9f\x11\xd0\xa9\x064\x12a+0\x11\x92h\xab\x1fs\xd1\xfc\x84\x0f\x00\xecD:jJ3\xe0p\x85\x12\x1bp8\xfd\x9f\xd2\xc4R\xc8\xfa\x18\x8e\x98]\x11\x12r\xbd\xa9\xbeJW\x16x4\xef\xe1D+\xb1\xd4\x10\xdb\x0bc\x8b\xf1\x0c\xec\xe9\xa6H\x9b\x87\xde\xf2[\xbb{>\xfdl\x09\xc9;Ef\x9dAS\x0b\xc5\xdah\x90\xa9\xe0\x1c@\x1a\"LJ`l\xc2!|){\x11&\xc4\xa6e7'\x1e3\xcb\x14\xec\x83z\x0c\xa1^y\x96\xd1\xa2\x8c\x10\xae>\xe4\x12\xbb\xfb}\x88WH\x82\xf2N" +
+	"\x80gHk7\x0eA\x11\xb5\xfa\xee\x89\xefL\x86\xd5\x18X\x86?\xd8w\x84%\x9f\xabe]\x82g8\xa6N\xf2\xb0\x8fndq\xe8\x15z+\x10\x11\x85\x93\xe7H\x1d\xf3\x08\xed\x0dsvKjj\x80{Z\xa2\x87\xe0\xa2\xc1\x17Jq\x90\x1c\xda\x82~\"\xf0V\xc9\x8f\xf0\xd4\x14\xd8\x91\xf5\xe8\xbd\"\x13\xbab\x03\xea\xe1\x96$\xff\xbex\x82\xe6\x14,\xe47\xcf\xc6\x9f\xbb\xeb\xf0\x12\x88!+\x07\x0e\\\xac\xe4F\x9a\xd8GX\xa2\x16T|\xa9\x92\xe3\xaa8\xa7\xd0\x0b\xa5(U\x1d\xa6\xf6x\x8e\xaeW\xd4+\x03\xae\x95\xb7\xd2)\xf7\xd8\xc9\xb1\xde\xcd/\xf1\xe4L\x06\xf3\x1d\x98\xa2\x98\xc1\xef\x0d\x02\xeb\xdf@\xcfL|\xdaMEcr\xa7\x96V3\x95Z9\xa2K\xf8H;\xdcZ5\xb8hQ\x81\x0ecv+\xe3T\x0e\x8e$\x0e\xcb\xa7X\x8f\x87\xcd\xb9t\x84\x84L\x10)E_B\xb0s\x15G\xd1\xc5\xf0\xe8:\xa3\xf0O\xa1\xa9\xe5G\xa4v\xcbS\x8d\xbc\xf0;i$M\xc0\xa5\xf6K\xd5\x98%\x1a,\xc0\x11\x1f!\xf8\xec\x98\xbc\x178\xdcs\xdb\xda\xd4\xf2xC\xe7\xf1\xbc\x89\xc1\xb1!m*{S\"\xf6\x12\x05i\x1c\xea\x15\xb9\xe0\xe3\x1cE\xebc\xcep\xa6\xa8[\x0f!\xec\xf2\x8b\xd8\xa2'\xf1\\U}G\x0f\x99Pr\xce\x040\xaf~'\xcb\xad\xaa\xf5\xe5R\x17\xd4M\x82\xe0\x99\xd0z\xcc[\x07\x1e\x7f\x0c\x14\xae\x81\x08p\xa9\xb3\xe6\x96\x0e\xb7\x99\x0f\x04[S\x17\x15\xaetG\xb1+i,u\x02\x8f\x8d~33D\xd9$Pe9\xb9ub\xe0\x89d\xcd\x82)oD\xc5W*\xbdK\xf6\x95\xf5\x0c^[\x91\xa3\xac\x82\x86\xd0\xf5\xd0>S3\x02V\x8fc\x81\x14\x0d\xbe\xf9J\x12\x87<\x0d\x18\x90\xa0\xe4SD\x11o?\"\x1c\xef\xb1'vm\xa2\x9a\xf3\x02(\xf6%L\xdf\x89\xea\xe2\xc1S\xe7\xcfi\xa3/Ae\xd7\x12y\xf5`{\xdf\xdb\xebgk3\x13\x07-\x10\x8a*\xec\xd1\xf6\x18\xc4\x82\x81\xb7\x8b\xc4\xaa\xdc_\xff\x08Ay\xe9\x92_\x84U\x01 \xc2\xce\xd9\xdf\x7f\x8a9\xb7\xcf\xfcs\x9b\xf3\x83g6\xe7\x0e\x05\xd1T\xb4\x84\x9f\x91\xc4eG\xff\xef\xde\xe1\x7f}f9,\x19\xe1\xef\x81\xc9a26\x87\x83\xb2l\xc6\xb8\x02T\xbf\x0d\x87\x8d\x95YE\xe8\xf9\x9d\x13\xd1\x84\x14\xbfIZ{9\xee\x87`\xf4\x0e0\xf2\xa8/\xee=\xf5\xb7T4):\xfaA\xe5\x01\xb3\x01\xf3\xd7~\xea\xa2q\xd6\x0bS\xb3\xf5R\xae\xa8\x0asg`\x05\xd3N\xbaS(\x82\xdcJU\xe1)\xb8\x93+\x05\x7f%\x88\xdcL]\xb3t\xd5\xdbF\"+:\xb5\xd8\x07p7b.@\x9e3\\\xd4\xca\xb1\x05\xf5\xd3\xad\xddw\xddE\x89bh\x8e\xb0\xdc\x988\xd4]+_jeR\x11\xe1}\xf8\xde\x19v\x19\x96\xf55=\xeeX4\xbc\x03\xc4\x9e\x1c\xfb;\x11\xe2\x9e3:\x8dU\xbd\x15zD\x17\xb0@M\x9b\x87\xfe\xd8\xa43\xb1\xeb\xa3h\xc6v\xe6K\xd5qI\x9c\x1f\xba\x1b\x04\xc2\xf4V\x0au\xe2]}H\xcfP\xc3\x8b\xc4\xd7\xe8\x9c>Mr\xf1\x14\x8f\xc9\xad2\xd2\x13^hN\x93\x0f\x0a\xa4\x05\xd2o\x8b\x16Y\xfe\x8d\xf0\x95yy\xfb\x0d\x18J\xf7\xb7s~\xd3+j\xf1\xbfm\x0a\xcfw\x8a\x95~06\x7fn\xff)S\xcbx\xb4`\x86d\x93\x81%r\xe4Ct\xf4i\xf2-\xe5D\x9b\x11Y=\xb1\xe5\xb0\x9cs\xcc\xca\x86\xb4y)_\xe9\xc0m\xd8-\x84v\xc9\x0b\x03\x03#\xad\x8bs.\xad\xc4N\xa4\xb2~#\x9fP\xc6\xf6g\x98\xad\xeeR\xb4\xdd\xdf\xd1\xe9\xa5\x9f\xe6\x90\x15\xbe:\x81{NA\xaf\xbd\x0fm\x06{\xcd\x01_\xf4&i\xa5\xb8C!ZI\xe1\x7f\x84\xb2\x94\x90\xfc$\xdd\xa2i\x88\xd5*Aq\xc1\x9d\x04\xa41\x19s\xa7\x91N\x02(g\x1fs\xa2\xeb9\x0e#\xf2\x8a`C\xedD!\"\x13\xde\xb9\xdbw\x1f\x1d)\xb4\xa0\xb9s1\x83\xdfX\x8a\xd7)\x83p\xf3\x83\xe8\xd7\x0d\xdf\x9f\xb0\x9c\xcb\x84Qu\x9a\x83\x0c[\xef\xd3\x14\x83\x92\xc8L\xecS\xcc\x0eq&/g\x05\xbc\xe6\x1a\x19\xc1hJ=\xc3\x8b+r\xc7\x9b\x14\x94\xf7\xb3A\\\xefyH\x9eQ\xff\x9c\x864\x9e\xf8\xe2I7\xc1\xdf\xedku\xe3\x07\"\xa8\xb1\"\x88d1\x0b\x86\\.iIk\xf2\xbd\x096\xac:j])\xba\x18\x0e\x175\xbf\xeb5\x0b\xfe\xea\xb38\x88\x9b\xd3\x14\x1fX\x1f\x03 %)\x14\x04\xd6\xda5\x14\xbe\x7f\x10\x99\x9c\xfa\x1d\xcc\xe9\x8d\xa9j\xdd\x12U\x0d\x0e\x0c\x1ct\xafz\xc1\xd7\xf6\x0f\xd5\xd3auv\x8c5\x1bU\xc1\xb6\xa3\xde\x12J\xab\x14y\xc4\x15\xc4\x94\xd12\x1f\xbb\xfe`\xc9*(FxG\xb0s'\x15\x99\xe9\xf7\x11\x15Fi\xa47cA;\xb0\xb4\xc0\xeb\xc1\xff@\x89\xfdp\xb0\xd9\xd6\xa4$C\x82F\xe9\x12^_\x1e\x19\x09!\x0a\xb7\x85\x83(\x11#\xa8RW\x1a\x07\xf8F\x96\xbf\xe4Z\xdd(\xdfp0L\x81\x05\x0aN\x9ay\x0b \x12\xb8f\xebt/4\x7f\x83\xcbC\xd6\xd4\xf4,N\xc3/\xef\x18L\xb6@Ek\x13\x82\xf0\xa0\x9eI9\x99U/)\x8a\x83-\xcbe\xad\x03\xcd\xf5\xa3S\xefP\x91\xc9qu\x10\xb1s\xc1P\x88jgi\xed%\x93\x94\x93\xf9\x8c\xcc\xff\xd0)uF\x8d\xa5\xa1\xc2|\xd2\xb3\xf0w\xb0\xdd?\xb6\x91\x04\xfb\xa9\xe2\xec\x15\x98\xe1\x02Wr\xfc!\x8d\x9fh\xb8\xe4\x85\xf7Q\xc44\x9d\x0bo'\x91\xf8\x9c@x\xb8\xca\xb1\x00U\x1c\x81\xc6\xef\xb1\x83EX+\x80\xc2\xcd\xe26S3\xfe\x00\xe7P\xf4\xd7~\xd0(\x84\xfe\xddV\x07\xb9\xecr3\x81\xe6\x05\x80\xa8\xbaq\xd2}S\xd6\x0f\x10\xaf,\xfe\x0f\x17)\x8d\x9e,\xb5k\x9d\xdf\x05\xb0\xf6\xdd9>E}\x87\x83|\x8f\xa0IVWH\xa8x\x9f\x1f%\x92H\xb9j\xd6n\x93\x903>\x06\xcd\xd3&\x11\xfa\xc1^\x9b\x00\xf3\x83\\#\x0bMs\xa1\xd5\xb9\xa25'/\xe8Ti\xb4>\xb2\x11\xc4q\xd6\xb5\x8a\xca\xb2\x7f\x97H[Pi\x15\x8b\x12\xc6|\xac\x90\xbdB\x84\x96I\x0aK\\&\x1c\x0a\x1b\xdb\x8b\x0d\xe2\xac\x83\xa4m\xa2\x99\xaa\xfbh\x8d\xd4\x1f<\xca\x0d\xd3\xfa\xe8\xf9\xf3:\xf6\x88~\x93\x1e\x7f\xe7>\xcc\xbc\x99B^9x}'\x11\xff\xb6]\x1d)6\xe0Y\xbd\\ \x9d?2aE\x10%\xde3\x12\x1c\x1d\x19\xb1\xfb\x98\xe3\x83\x9f9\x8c-\x0c\x98\xa7\x90\xa4\xed3\x94Z\x93V>\x9bM4\xd3*r\x9d\x85|\x02\xe5BX4\xec\x98\xc2_l,\x0c\x8f#t\xab*A+5\xa5\x03F\xd2\xdc\x1b\x1d\xf1R\xca\xcb\xc8P\xa3c&\x0e\xd5L\xdc\xa64!\xb3\xf4d\xef\xa4\x0b\xf9\x0e\x0f\xb2h\xdd\xe6\\\x04p^\x8f\xcf-m\xbd\xdf`\xdd\xeb:\xbeX\xa6\xdf3K\xc4\xe4I\x18L\x95k8m.\xc4$X\"U\xcf\x99\x8cp$7\x07T\xa6\xfd\x8d\xc0\xdc]\xc5\xbaB\xf2)Z\xfa\xc2Hu2\x10\xfee\xeb\xa8S\x14x\xac\x91\xf5\x0e\xc1/O\xe9M\xe4{\xf2\xb6\xb7\xfc\xb3\xdeE\x1d\x0d\xb2\x82\xb7\xdd\xb1\x0c$\x95\xdd'\x90;|\xe13:<w9\x9c:\xc1%\xad\x11\xad\xc1e\xde\xf3sB.X\xadB\x9aUR\x16w\xea\xf7\x0c\xff9\xe7\x1d\x0e\xb7\xda:P\x9drG:b\xcd1\xae\x10svq\xaf\x9b\xcc\x92\xe2\xf6{\xd2\xedD9\xc5\xf4\xf0l\xc2&}\xb8\xfd\xcf'\xe9\x92\x01\x0ct\xa4]FM\xa1\\\xa5\xfa\xdb\xfa\x8e\x9f=\x81;\x9eoF\x07t\xb8\xe3\xc4\xeb9\xb0%\xd8~+\x1a\xc7q\xf7\x14f\xb6\x0a\xe1\xcbyj\x80a_(\x8a\xf2\x13%\xb0+,\x14\xcb\xb4IV\x11&\xff\x84\xbd\x04\x1f\xb5\xf03\xcbow\xf7\xdb\x1e\xee:\xb2(\xc0\x87z\x91\xf4\xb7J\x8f2'7\xecj\x1d\x1b\xb2\x93\xf0\xec\xeb\xb9\x06\x04\xdbE\x9ar\x95*Y\xf1>\x1e9\xaf;>\xec\x0a\xd5w\xe9G\xdah%\xad`\xb8\xb0\x05s\x9e\xc7\xbdw\x0f\xa1P\x0f{\x99\x84SF\xe5_-\x0c\xee\x1e.\x91\xf4\xcca$\x91\xb4v`\x8b\xb0\xd8\x05gf>\xb9\x01.(\xcce\x04\xb3{*V\x86\xdf&\xa3E&\x93\xa4\xa9\xb9\xeb\x1a\xec6\xf9\xed\xfelB\x87\xfb\x0a64\xa2TO\xd5L\xf5\xf0\x96\xce\xf0\x0aO)\xd1\xc4\xef%\xf8\xa8\xd7\xc8\x94\x02\xa0n\xc4\xe5\xe1A\x82w\xfa)\x1f\xfc}\xc8]c\x8f\xa2\xb2\x1a\x94\xd3\xed1\xda\x87-\xa3\xcd1\x06j\x99&\xae\xb7VT\x05\xfa\xeb\xb0\xbe[\x7fgS\x87\xba\xc5\xde=.\xbb\xb5\xf9^\xd8\xf1\xd7\x1ao'\x88\xc5\xf2\xea\x8a@\xd1^\xb1\x14\xd7\xc0\xebg\xf3\xaa\xce<\xaf\x95B\xc6\xa0\xbfq\x8a\x0b\xe9\x9e\xcc\x16\xf8L\xf1\xf8\xb5\xbb(\x14\x9e\xe3\xe0/l%k\x1a\xfb\xd6\xe0\x01Js\x90b\x97\xba\xfa\xd9(c\xf3\xeez\xb38\x0c_p\xca\x9fig\x1c\xe3\xa6\xa45\x93\xe6\x80B\x97#\x9ai\xbd\xa0\xdd\xa5\xa5\xb0s9F\x97U1a\x07\xd1)\xc8\xe8\xb9x\xecj\xa2j\xece2\xacA3\xb6\xd0k\x88M\xdaNfv\xbaL\xc8\x96\x9b\xbd\xc7\xf4\xa9\x0f_\xb8\xf5\x0b\x87\"g\x0cm\x87,-\xe6\xb1<=\xd0\x969W\xd2+\xe9\x05\xb3:e\x02\xb3q\xd7\x0b(7|\xb1\x9ds`\xa2L\x9e\xe1\xbd\x93\x1bQ\xbe\xc2z\x18\xc1\xd3l\xc1\x91\xc3\xc8\x8f@\xb3\xfe\xc1J\x96`\xb4\xf7\xbdh\xc4\x11b5b\x99H\xc9\xc1\xa7\xd6\x156\xc8\x13\x0e_\xecd\xe2\xd5f\"\xc6\x80\xc9\x01\xec\x8b\xda\xe6\x9e\x9f\x08\xa2<|\xc1~\xe9\x0d\xc9\x07\x1cI\x92\x8co\xb4\xd1\xe4\xee\xbf\xf80m\xb2\x08\xceY\"X\xf8\x13\xb6\x00\x0es\xe2\xe2\xb0\x15\x8b\xf3\x9e\x15\x0b\x99:\x1d\x08E\xbaB\xb1)\x7f\x07\xf2\x09+2u\xb3f\xdc:T{Y\xa1G\x896\x10o\xa3\x88p\x7f\xe0n\xc6y\x8c\xd9x}>/\xa8u\xf8b?)\x1d\xe21\x0e}\x13\xef\xb8~\xa8y\xb1\x89<~\xbd\xcb\xa1X\x157\xc7\x06\xfaR\xc3\x17\x07\x19^;\xd1t\xdazs\xc88\xd5\xef\xe1\x94!\x9a9\xac\x16\xfd\xd8\xd3\xf2\x1e>\xba[\xcd7?\xa2+XRm\x8cc\x14\x06H\x80\x15\xef\x9f.\xfc\xddP\x08\x17\x93\x94\x0dkLNc._&\xa8\xd3\xaa\xa0\x88\x15\xd4^\x80\xc8\xe2\x8d\x06(NzJ\x0e\x0f\xdb@\xe4+\xa0\xdd=\x91\xedd\xaf\x93\xa2\xb4\xde\x89#\xcd\x8a\x09t}\x9cm\xbb\x01V\xca^3+4}g\x0a\xd5p\x0c\xdbN\x16\xcc\xe4\xd4\x9c\xeb\xa9i\x11\xcb\x1dj\x08\xed\xb5\xca\xefB\xc8{C\x0aoe\xe6\xbc\xca\x92\xe4\x88\xc43\xacCdl|iM\x82\x04j\xdf\xe0\xf9 \xea\xf4\xd0G\xdd=h\x9e\x0c\x9a\xe6\xbeSM=\x96\x8c,\x01k\x1fQ\xd4\xdf\xe7\xee\xbb\x99%\x85\xc7\xa0<\x1a\x15\xc5\xbd\x906\x90\xea\x12\xfc\xb4\xe6\x8b\xe2\xe9O\x994E\x8f\\\xb8\x1b_\xac\xfd\xc9\xf7r\xa2\xc2\xf1\x02\x1c\x85k\xe9D\xfe\x06\xc1\xe3\xeeh\xd1\x88\xed\xf4\xd0\xccQ~\xc1\x16\xc5+C\xb9\xbb^`\xcd\x09\xfa\xbb\x9a\xb9\x04W\xa8(,\xde\x85\xec\xfa\x9c\xd6}\x8f\x0e\xdf\xe1p?\x81s\x9c\x12\xcb\xd1\xdc\xf1\xd3\xd4\xbc)^*j\x8e\xe1C\xe7\xb8)\xf2v\x7f)\x06l\x04\x91\xaa\x8c`G\xa8i\xd11_\x07V0\x95\xdb8\x12\xc5~\xa8p\x04p\xce\xd8id\x11k\xb4?\xef\xe6p\xa8\x81y\x1f\x9b\x126\xa9H?#\xf5\x19\xc4$P\xd0\x86\"\xb8Hf\xbf\xdbD\xffz\xeb\x85\x99=\xbde2\xb6\x0bLd_=\xf5\xec\xe1\x80I4\x81U]=\xc9Ae\x1dSq!\xb5\xe2 \xc9\x82\xe4\x84\x1d\xf3\xb2\x95\x91@w\x1c\xf0\x1c\xaa\x96#\xcbB,\xf7o\xd7\x8b\xd9\xa1\x06\xcf:\x0c\"\x0bs\x86\xc4\x17\xc7BP~\"\xdb\x81\x93\x93\x93\xb5\x87b\xde\xa4^*?A\xc6\xc2R\x99\xc2f\xc6Q\xa1\x1fP>\xc5\xa6\x1d\xdeC\xa1\xac\x09\xce\x14t\xc8\\\xc4\xfa\xa6\xcc\xbd\xb2\xa0\xb7\xd3\x8c\xbe\x02Ag\x1f\xf9\xf4:Z\x9b\x10s+Z\"\xbc\xebz\x93\xc4\xe3\x1e\x9c|U\x89\xdbP\x9ez+wRi$6\x9b\xc0\x84L+\xb62!\x9e\xa5G\xbao\xb8\xb5\xe7\x15Mb\xbb\xc2\x11P57\x93\xf4\xf6\x17\x9a\x07\xd9\xdb\x81J\x812yRub\xe9\xb4N\xael|\x09\x84W\xab\xd0\xcc\xea\xf2\xdfc\x12\xcd\xf6^\xb0\xb6\x06\x0f\xd4\xfal\xebI\xd79\xdc\xda\xef_\x08\xee\xef\xd5#\xc4\x17\xdaz\x1c\x03\xc3\xd7 *\xa4\xd8\xae\xda\x1f\x88F\xf4\xbao\xb82\x00\xcdy\x94\xa2\\\xe2\x9f\xc5\x8b\xddAY\xe2\x08*;@\xf7s)\xb3\x94)Vn*\x94\x8b\xa2\xee\xeb\xfa\xb1\x9a\xa9\xc0\xeb\xea_\xa8\xb5-\xb8\xe3\x13\xf5\x93\x93~Y\xdf-\x0f\xcc\xa3\x07\xd6\x85[\xb3\xab\x83;5\xafau\xeac\x9a\x0c\x13\xa5I\x15k\xc2r\xe8\xf0\xc1\x0c3\xeb\xec[\\\x87\x94\xfe\xcc+\xb75s'\x87\x04\xb7\x8c\xc1\x17\x96\x94\xba\x97\xfaa>\x04[\xff\x83r\xe4n\xd6\xb3\x92B\xe8F\x1fJ b\xd2\x86\xe8\x09Z\x82\xa4OX\xac\xc9\x0786\xbeq\x99\xb69t\xa5\xfc\xfb1\xfe\x0au\x93\xf5\x11\xa0\x84\xb6@^\xb2\x19\xc9/\xe5\xb7\x15t\xc5Qz\xaf\xd0\xe9\xbd\x0d\x19\xd3\x87\xdb/\xf2\xbc\x96N\xb5\xf9\xdb\x9c\x07Q\xd0\x8a\x0d\xfe\x96\x80\x84\xd2\x06m\x8e\x02\xafrZ\x19t\x89\xa8\x9eM,x\xb8\xad\xb1%\x1dbI\xa5_kN\x08`e@\xab\xda,9G\x1d\xbc\x94wag\x88\xa2\xc1\x9d)n\x9fa~\x1e\xab\xde\"\\Z`\xc5O\x1ag\xd8\xad\x13\xd2\xe7!\xe6\x8d\xb1%'\x81\xd5M\xf8IMh\xcd:\x96\x99>>\"\x8e(\xda\x93\xeb2b\xe8\xe9Imo\xb9'\x9b(\xf5\x9d`\x13\xb8\xde\xd3.\xb7\x99\xecY=\x07^T\xa2~\xbe\xc9j\xea\x8e4\xf5,\x86\xcc\xf0\xea$M\xb3\x09\xcey\xb8\x9dt288\x90\xbc&\xeb\x07@/\x8f\x14\xae\x9cI\x91a\x8b\xdcZ\x02H\xf0\x10\x15H\x1d\x04^G\xf0\x8b\x88\xc0\xa3\x0foY\x02\x92]\xa6s,\\H\xaeZ\xfah\xd4*\x87C\xea*Y\x16\x9cx\xed\x0d:\x16\x86\x89\x02b\xa1\x14\x10|\xbe\x025\xa0\xd8\xee3\xaf\xdf:\x9b\xd8\xcb\xc5\x10{\xa2\xdc\xe3\x8aXR\x89\x16\xc1\x16\xac\xe2F\xa2=\x15\xbe\xc6\x00\xbb\x18a\xdf3w\xaaV6K\x1e\xb5`\xc0./\xbeB&\xd3\x8eE\xcfA\xf8\x9e\xe8\xdf\xc4\xefIX\xee\x93\xca\x01B\xaa\xd1\xe6}\xe4\x8b\xc9\xe5=\xf5p{\xfcz2\x9e`M\xca\xf3\x81\x84\x1a\xc4T\xdfG\x0a\x0du\x16\x85O\x84\x887\xe7\x9ay\xcb{\xec\xa9\xdd\x09$\x0e@\xa0\xaa\xabz\xc2f\xd3NNh\xa4\x8c,\xce@\xb0^\x83\x9e\x12\x92\xab:lQ\xfd9\x12\xf4\xae\xb2\x12\x8c\x1f\xb1<\xdb\xa3\xc1s\xb8\xed\xe7\xbbV\xaa\xae\x99@!\xf2D\x0f*iT\x92W\xf3\x1e\xbc\x83\x91\xec^G\xf7r\x15=\xe1l\xd80\x09C\x0d\xdf\x0c\xeb\xd6\xc0!\x97(\x0f\xce\x0c\xb3\x1ab'\xd9P\x97\xb4X\x970\xf2\xd8\xa2\x1d[\x0dV\xa2\xb6&Rf\xe1]\xa4\x08\x01,\xd6\xeem\xa4\xacC\x8c\x0c\xb5\x95\xaco\xe9\x91V\x89d\x8c\xa1\x94y\x07_x\xf0s\xee\xa5\x0d\xd8J\xaaI\xb4\xa7\xf6\xc2\xd0\xde\xf7\xd81\x07i]\x00\xe8\x82$\xd1h\xafaaPb\xcc\xd2\x0a\xfaR\xd3S\xed\x812\xe1\xc1\xd7[],+S\xba\xa5\xf1\x8a\x94\xce\xd6}\xece\xfeI\x86C\xb3\x80\x8b\x864\x84\xd8\xca\x06\xb6\x13\xeex\xc1\xf868w\xb6|\xaa\xa3\x01\xd9*\x8a\x1a \xcdIO\xdf\xfd\xd0\xe1\xd6v\xc4* \x10\x83\xf3Kd\xe0\x94\x1d\xc5}Sq\x928\xaa\xba\x8f\xd5\xf7\x9f\x96(\xdey\x91\xa9-\xa4\xbc\xe7\xa2\xb2~I\xfdW\xebGT\xc9F1\x1f\xb27\x07\x94\x08/,\x83\x87\xcb\xe2\xdd;=+\xe4\xeaXG\x86`D\xba]5\xcd\xea\x85u\x91\xa9\x9b\xcd\x08\xf1@\xbe3?4r\xa2\x14\x9e\x80\"\xc4\xf4*]\"X$L\xd2$\x1b\x85\xde@Q\x86*H2q\xa2~\x05\x07\x9d\xea \x1c\x10I\xef\xfa\x07}X\xd2\xea\x1f\xbb\xab\xc5ZW\x0f\xd2\x1f\x0fVgKl\xddW\xf4\x10\xaf\xf8\x90\x1as\xfb&\xa1o\xc1\xe4\xccA\x97\x95" +
+	"\x0fs2g\x0f\x00\xc7]\xa1\xdfK\xa7\xc8\x00V\xca\x11\xf2\x0b[*a:\xcf\x162\xff\xea?H[\xb2\x08\xe2o\xda\x95&\xeb?\xa8\xaf wP\x82\xeb%h\xb4T\xa2if5\xaaC\x95,iX\xc5\xee\x8f\xf5\xcb\xcfu\xcfd\xf1H`\x90\x91\xfc\xf3\x93\xaf\xe9\x9b\x9b\x11\xb6\xae\x10\xeb\xd8\xda\x8e#%\xd4\"\xf9\x19pR*<\xa3.E\x0aE\x98\x12\x82\x08\xf2E\xd6\xff\x07\x13K+0~8\xa6\"H\x0e\x05\x1f\xe7\x8a\xdf\x08E\x1b\xd8%\x15\x95\x04\xe1wB;\x1d*\xcc\x06\x18\xcf\xf8\x91\xa4z\xa1\x1e)\xa9\xc9.\xc9\xb3\xbe\xb0\xf9\xab\x13\x91\xaaS\x0f\xa7\xb8\x8f\xd6\xdf\x82p\xe6B2\xe7\x8b\xe7\x14\x1b\xf0\x1d\xac\xf8#\xeegMJ\xc4#$U1i\xdf\xb2^@\xb0~\x05\xe6\xaf\x88\x19\x94\x05\xf6\x84&\x93\xa4\xb9\"\xc3?O\xc1\xe6\xab\x16\xf9\x0d\xc2\xf7\x9d\x9d\x16\x0e\xce\xa4dO\x88\xb5\x98\x96\xfd\x10_\x8fh\xea\x8e\x1d\xc0\x98,v#\xe3\x80\xedp\xc1\x1d\x1b\x81\x1d,\x9e\x86s\x0ctXsJ_~[/\xcdma\xaf\x94\x98)\x12\xe9\xb6\xfb\xf8\x04\xcdq\xbcy\xe7\x88(\xd6\x80\xf5\xc5\xb1\x1fZ\xd6T\x92\xca\x1dU\xc4O\x11\x8e\xe2\xf1\xd0\xcb\x96\xbf\xb8L\x82\x17\xf8\xd0T\xf5\x8f\xd2^/!p~'\xdb\xa5\xc7t\xaaxW\x13\xe3$\x0c;@\xf9.$\xad+\x03\x85\x94C&\x1ac\x99\x82L\xc4j\x97\xe0\xc7\xde\x81\xe7{\xc2L(\x97\x08n\xe23\xef\x96T?TC`O@*\xeb\x05\xd6wl\xca\x91QD\xf0\x10\xc9\x00r\x96\x09\xdbf\\z!\x97\x90N\xfe6f\x81Jxi\xd5\xc0\xef\x82\x04$O\xc4\xb1\xa3O/\xbb<M\x9e\x8e$6\\\xe0\xb3\x08\xe3\xdc\x0b;\xa7\x14\xe8|\xdc3\xcc^\xc8\xddkR\xb5\x93\xa6\xd4Wx\xb9\xe9\xcd\"&)\xe6s7\xb8\x87\x82g\xff\xb3K\x11\x18\xb9\xc4'3<J%\xafj\xe0\x8ag\x06\xbb\xbf\xbe\xc4\x07)\xc4\xf8\x80)`F\xd8\x03\xab!JU\x95\x98O\xd2\x98EZX\xcf\x82n\xd91\xaa\x14JkM5\x09\xdbZ\xaa\x0f\x0f\x17I\xeb\x9b\x88\x94\x89\x0bHj\xcf\x87D\x19\xae\x96\xebx\xf0#\xc1\xb7@\xee\xbfnJx\xa6\x15\xd3 \x8a\xde\xd2\x95\x12\xed\xf4\xc5m\x84\xa6\xf4D\x0e\xb3\xd6\x1f\xc7\xbe\x95\xe8\x8dR)C/\xebD\x86\x8e\x93\x11f}\xfe\xb8\x81j\xe5p\xe70\xefFF\x9d\x95\xb0p$\x0de3K\x1e\x974\xd4.3\x83\xc2)\x82P\xfeBi,\xd1;\xa8\xcb?\x98\xd95\xfd\xbcL\x8e?\xcfi\xfeR\xf8\xf51\xc2\xbc\xe2\xd1\xa3!\x92\x14\x03\x9e\xca\xcc\xaf!.\xda\xa4\x91w\xb8\xfb\"?\x8c+\xc8\xed<$>]\x8b$\x08p\xfc2l\xba@\xfe\xde\x0a\xa5\x86\x8e$\xc1d\xac\xb1\xdeqa\x0ae\xbdF\x0a\x054U*\xder\xf3\xcc\xd0.\x14\x01\x90;\xb6\xfc\xdd\x13L \xac\x92\xa0\x93\x9cE\x01\x0e\xf7\x1d\x95B\x83|\xdf\xe0\xb3\xaf\xa8\xf9\xad\x1a\xd8\xd8,\x8a\x09\x96\xf1\xec\x91\xacTT\x91PCq\x85\xeeS\xacnC\x7f\x89-\xe4\x9b\xfck6\xb7J\xd1\xc81Tz\xd8\xcc5G0D\x0e\xdd\xb2d\x18Z\x1f\x97\xeb\x01\x8f\xb6w!\x9d\x07\x16\x80\xbd\xe5\x88\xcd\x84\x8eZ\xb8V\xb2\x1f?\x93p\xedp\xd7\xe3\xaeVh;\xbb\x97D\x8c&Bi\x9e\xdb\xa8\xa8Z\xa5\xdd\xd9\x898\x94\x8e5b\xf1\xe6Rmt\xc5L\x10\x9c\xdd^\xef\xb4\xe5i\xe3\xf5U\xf7\xc4T\x10\x9e\x14\x05\x96\x96S\x95\xd9\xf9@\xb1qg\xe9By\xa3\xbc\xa1C4\xe40\x95S(\x05@\xab\x8c\x00\xe8)i}(\xf5\xa3\x02\x8ek\x05\xf0+\x05\xd3J\xf7\x98mw\xf8&\xa4\xb4!\x95r\x93\x9er\x80\x87\x89\xe6.\xd4\xe9T}u\xe6\xe4\xc6W\xb3\xa4F\x19e\xc3\xa2\xed2c\xc8y\x8e\x00\x90s\x86\xb3q\xd3\x9d\x18\xdb\xddi;\x0f)FC\xfa+\xdb\x8b\xc6X\xfa\xb8\"\xca\x816vmf\x11\x96\xc2\xfa\xdd\x83=zM\x02j\x98M\xce+\x96\x9ea\xc8f\xe7L\x82\x09*\x8f=u\x96@\xc1\xdb\x17\xc9\xaeq\xd8^\xc3\x02\xbbB\xf2~\x1e\x8d\x04\xeb\x06^\xcd\\\xa4\xee\x84\x96\x83\x08<3I*/\xe9\xb1\x11\x99\x89k\xdf\xb8\xc5\xff^v\\I\x8c\xebq\xfc\xa3K\xe5H\xbd\xa1\x18\xdc\xa0\xee\xe5\x8c\x01\x81\x12\xbeT\xa6\xec\xb3D\x94;\x96\xc0\xea\x125S5j\xecB\"Q\xe6\x02\xf3\xae\xbc\xf3Q<p\x80\x96\xb1\xe4\x94\xcf{\xf8\x96%\xe4\xa7D\xdc\xbdfeXJ\xff4\xf3Ea\xbd)*\x99I\x9fl\x04\xc0s\xdf#C/\xdb\xfav\xdd\xd4?\x1c|\xf52p{\xc6\xc0\xc5i\x0di0\xf5@\xb0\x11%\xf6\xc8\xb5\xc5\x06\xc8\xf4Rnc?\x16S\xd0\xc8\x13E^\xd5\x96(\xd5e\x09dv{\x07J\x91\xa5\xc2\xecp\xd7\x84\x9dJ{\xf8\xce\x14\x81\x14\x96p]\xce(L\x90\x12ViI\xb2\xaa\xa4\xfc\x91I\x86(\x89\"t\xcfCN\xc2\xd2\xc7\xbeE\xe3\x1e\xce\xbf\"+\xb7 \xfa\xc1]\xf1\xe9MX\xb5\x87,\x92\x99\xcf\xe7p\xf2>\xa1\xbb\xe5nZ\xd5q\x83,3\xc2\xe4\x97`6f\x8e/\x82^\xae`^D\xf21\x1a\xee\xd07\xfe\xb2\xbe\x92@\x18\x07\"\x01#~JE\xe2\x1f4\xa84\xac\xc0\xfa\x1aB\xcbJ\x92:\x94\x8ca\x13\xdd\xf6\xe0n\x86m\xd7\x9c6#\xb8\x1cxC\x90\x06\x91\xc8\xb7\x82i}\xe7\x00_\x8f\xed\x08\x15\xcf\xfe\x0dY!Q\xe0u5(\xa5\xfe0\xfc\x14E\x1e'\x96\x81@\xa2\x93\xe0\x00%\x02\x82(5\x09\x0f^\xbf\x87\xb2\x7fB\xb1\x84\xbc\x9e\x1a\x88\xa7\xf9T>\"\xed\xa7\xa6`_\xa2\x92\xb4\xa4\xc3u\x9eo\xce\"\xee\xad\xcc\x7f\x9a\xa8\xf1\x01\xb5UH.\xa2\xd4\x04M\xfa\xcf\x86r\xcc\xf0\xa4F\xbf(Y\x85\x91n88\x91\xe3D\xd7\xe4\x12\xd7\xf9z4\xa3\x81\xd2\xb0N\xf47{\xaa\x0b\x0dw%\xae\xbb$\x85t8\xd7\xe1\x00\x11\xe8\x14N\xef\xb75\xb5\x97\x99\xe6q#`y\x8deiT\xe95\xfbq\xcc;\x105\xef\xca\x8d\xe5\xb1)E*\xea\xe9\xf6\xa0\xa6\xb2z\\\xdb\x84\xaf\x87\xe5\x0e\x0f\xb9\xc4\"\xd5\xb7I\"\xd7\x83\xb2\xcb\x01\xe1\xf3\xcb\xd8\xa6\xca\xb7M\x95+\x10,,j\xee\xae\x0a\xfesE\xda\xbff5VB<\xb9E\xdb6\xf8\x1cK\x82y\xb7/\xa4C\xd3\xf1\x926k\x98\xce\xc2\x12\\H\x84\x08\x83\x87C\xd6=KDH[r&\xc2\x8e\x1ey\xce\xbd\x94v\xf0\x03\x80\x8c5\xdcm\xbd\xc8\x08-\xb6DMe.\x99\x039\x17H9\x11\x94\xe4%\xa2\xf9c\xd5\xfd\xa5\xf4\x9e6\xe8\xb9\x8c\xb0\x0b\xd5;q\x173\x0c\xf1\x01\x07\x09\x1a\x0a\xd2|\xe1'\xef\x01\xfa\xb4\x18D\xcf\xda4k\xf2\x88\"\xda\x96\xf94\x16\xf0\xc7;\xcd\">oN\x92\x11\xa9\xaf\x9c\xf1x\xa0\xf7{)\xc9\xc8\xa8C{\x12S\xeb%\xba\xbe\x92W\x1d\xcbct\xc5\x855\xfd?\xd2\x12\xed\x813\x86\xba\xc5\x04\x0a\x8b*\xbf\xf1\xb6\xef%\xb8\xf7\xcap\xa3a\xdc\xd8|\x83\xef\xa3\xa3\xea\x0f\xde\xd19\xe1D\xe4[\x05\xb1T\xe8\x13\xf8\x96\xa2?\xd1\xb5\x06\xc5\x1c&2\xce\xe1\xe5\x9aQ\x88[MC\xc1\x8f\xd4\x91\xd5\xbdG|\x08\x10\xd4\xf0\x08/ \xf6\xc9\x1dXt\xc1\xc5\xa8=vX\xbb\xcc\x07(\xaa\xb7\xe3Lo#l\x95u\x02CF\x84\x8a\x81\x11\x8e\x1a$>\xae\xea\x0flNX%\xfe\xb5\xb8\x8b\x00\xe8Eq\x81P\x96\x8f\xcc\xb3P{\x88\xd69\x09\x92$\x8fx\x00\x8d\xcd8\xcd\xc2\x1f\xde\x11=\x06\xf1><F2~\xb8\xa7\x95\xd0$\xb1\xa0\x9f@\xb3l9)\xc2\x17M\x06\x142\x124\x9a8i\xdc\xa9N\x08Y:\xf6\x90P\xcc{\xcb\xd8\xf8\x16\xba\xbcvb\xbb\x8d\x96\xb0I\xa1\x05+\x8a\xd9\x9e\x9c\x8b\xacWh\xd5\xe5PFv\x8b\xf7\x14U:\xe0\xcfJ\x10\x1c\xf3A\xe5\xd4\xa1\xe3P\x00\x0cD\xfc\xca\xb4z\xd1\xb8\xde\x803\x82c$\xb7:\xa7\xd3\xef\xf8\x92\xfc\xd3{H\xe1\x17\xe0pQ\x0c\x81?3\xcce\xe1\x0c\xe6\x00Q\xa6\xc4\xd3\x07\xf3\x13\xa2\x90\x99!\x09\x8e\xf6y\x06\xf0U\xd9COw\xb8\xb7\x933U\x086Y\xa2z\x87p4\x08\xb0\xa8\x92rq\xccP\x7f\xe8G~c#s\x9c\xd0R\xeb\xa4\x92\x94\xbeZ\xff,|\x03\xf2\x06\xa0\xe6\xafV \xa5\x13^F\x9a[\xe8\x05\xb7J\xc7\x8a\x84|\x8e\x8a\xaa!\xd9\xe1\xb7-[E\x9e\\\xa7Y_\xed\xe2\xe1^K\x7f\xc4T\x88@\xe0\xf9\xf5\xa6)\x9b\xe3\xf0\xca+\x1bHyh\xd2\x1c\x8c\x03\x8f\x04\xae~\xcbm\x8b\xfazL\xb7\xe9t\xf4\xf5\xb0\x96\x04\x88\xc4\xd98\x1f\xd4\x0b\x08\xb1f\xc17y\xc6E\xbb\x97\x1dHW{K\x09{\x82,\x9e\xe9@AR;\x8bW\xc2M\x19\x9f\x89\xaa\xbcq\xed\xdc\xe2\xfa\xe1\x0a\xeb\xf7=\x0e\xa6}w\xcb\x81A\xc0\xce\xc5\xa9&B?fp(&\x9e\x8b\x00e\x85\x92\x82{x\xf0\xb2\x16\xf6IX\xeb\xaa\x99\xa1o\x17\xec\xb5\xec\xf1e\xa0\xf1IO\x88qK\x85\x03\xc9\xa0V\x10\xa7\x96|\x05D},\x146HC\x10\x1e[\x02\xd9;\xe8\x19\xab\xb4*-\x9a`\xc4\xda\xbeR\x11\x92\xe9\x94\x81h)\x96\xc7\xa6\x88\xcf\x94\xa9?%\x80%mV\xcd\xb6\x996\x9d\xea\x98=S\xc2j\xb3^\xc0[\xd5\x87ZsO\x13\xb8p]\xbe\xc8s\x1c\x82\xbb\x07`\xb63\x0c\xea\x81\xbbT\xea\xe7TT]\xf3\xf0td\xb8\xc2\xd2z\xaf\xb9C\xc4\xac\xddR;\x0e\x1d\xcb\xc2\xc9\x8dW\x89\x15\xd0\x12\x1cT\xa070Y|\x81\xed\xba\xb4\x0e\x00?\x05\x1e\x85!\xaa\x83R\xaa\xf7\xf0\xb2\xf9V\xe9\x91\xa0\xa1\xcdjA\xad\xf7\xcb+\x00\xc3\x96\xde`U\x09\x19\xa1}\xe9ve\x84vBB\xe8C\xe24\x0c\x1fO\xb6\x17\x84\xdaE#\xfe\xdc+\x1b\x94\x8e\x84P\xcfl;\xa2\x82\x8drh0b=\xb2\x16\xfb/\xba\xd0j1CU@c\xfa\xb1\x93A1\xc3N\xc1\xf0\x11\xd8P^\xcf\xaa)\xf6\x07\x85\xda\xc0\xaan<\x8a\xea\xf7l\x10\x99\xecL\xc922\x18\xbf\xf3`\xd9\xcf\x84\x9d\x98\x8092\xad{\x02\xd0]d\xe8-H\x90\x80\x0cr\xf0\xeb\xc6:H\xfc\x11\xbe\xa1\x82\xe8\x0b!\x8b\x0e\xe4\x0f\x0f\xcdY\xf4\x02\x84i`\xbcl\x82SVM29\\n*\xa5\xe4\xac\xcf|v\xae\xbf\xf7\xb6\xbf\x95\xed\xeb\xe1\xaa\xb89\xb0\xd9*bK\xc1\xb7\xd0\xd6\xc7\x1f\xfeH\x1e\x89\x92\x9ex\x03Q\x06Va\x976\x95\x83\xc2`'\xcc\x1e\x99\xb4/\xc9t\x86\xb86X\"T\xa75\xa4T\xaa\xefL\xb8\xcet4}\x83r\x88\x95%\xd6\x9d\xa2Z\x974>:\xa4\xca\xf9\x1ez\x8bH\x8e?\xc2-K\x8b\xbf\x11V\x95\x95\xbf)\xa6p\x9e3\xa5D\xb8\xe4TZs,e\x00\x84Z\xff\xc4\xaf\x9f\xeb7\xb0\x93\xa9\x15$\xddC\xf3\x92X\xf57-\xb9\x83\xe1X\x84^\x83~\xd6q'A\xef\x9b\x10\x94{\xfd+\xd17\xa1h\x94\xba\xc2\xa4\x0d\x1c\x8d\xfd\xdd\x04K\x1bG\xd6\xafAM!\xbds\xe9\xd4\x81\xac19\x894f3\x07\x90\x11\xd2\xb7w\xf0\x12\xaf\xb5\x18s!%\x05\x96\xd4\xb6\x05&\x91\x1a&\xd67\x8aD\x1a\xb1\xebv\xa4\x84+\\A\xc9\xe6\xb4\xc7\xfa\xcd\x089@NO\x84,a\xa9\x8e\xac\x87ka\xf2\x9a\xb6\x83\xbc\x13\xe8\x14+HXJ\xf7&\xd9\x0elS\x98\x8b\x1eY\xfc\x80Z\x91\x9a\x09\x84)\xe4\x1c\x1d\xab?J\xf6pH\xfd\xa0)\x18P\xcb\xe1\x04\xe8R\xed\xec\x93~@x\xb5W2e\x00\xa6=\x96z\x8f1\xc4S_\x1f@\xa0E\xab\\<j\x0e\x90\xf8x\x88;64\xcd\x83\xfc\xe0;\x9d3{\x17\xff\xff\xa0=\xcbP\xc0r\xea\xc9h\xc3\xea\xd5\xdc\xa4)_\xf2e\x8b\x9a\xc1S\x90\xfc\xb9\x1c\xfa\xd3\xf2\x91Bs\x9a\x83T\x92I\xb0)\xa5\x91T\xe0#\x93(*qT\x98\xa4\x8b\x05\x8dvlu\xc3\xbd\xdeQ'\xdeL\xf3a\xff0\xcf\xa2\xf4\x86P\xe7\x8a\xf9\xfe:\xbcG\xc1\xa7\xd2\x00\xa4\x1f\xabf\x864r\x89@\xf25\x16\x94S\xb0\xc4\x1d8C2\xa5\xcdT\xfeg&\x86\x18\x09o\xf0T*^*;\xa2\x94\xea\x99\xb6\xf5\xcct\xee,bcV\xbd\xeaw\xb1o\xe3A\x9f\\\xc8\xd1\x82\x90\x17\xf2\xe6\xecX\xd6\xb3\x9b)\xf5sO\xa4:\xb3~\x0dB\xf5\xf7\x00\x0b(W\xa4s\x11$\xf2\xdb\x92x\x8d\x1f\x93\xde\x12`i\x1e\xdf\x04q\xf6\xccE!\xda\x0c*,,\x11j;aO\xbct\xe5$\xd6\xf5\x93\xa0\xb6\x00\x05\xd0\xb6\xad\xe6y:i\x0e\x86I\x11|\x02)\xb19w\x03\x1cI\xcf\xf3g\xaf\x82k\xfd\xed'\xd9LJ\xe5\xdc\x91ub\xca\xd2\x93\x84 @\x16\xf5\x1eI\xabu\xb0\x9d\xf0\xe1N\xc0\xf6\xcd\xb4y\x10)=$\x90\x13\xf0\xc9z\xd9\xcd{\xcfB\xd8\xae\xd0\x8dw\xac\x89Pp\x11 L\xc8T\xbeH\xb4?\x06d\xecbD(\xb1\xa4`\xfd\x15De\x8as\xc4\xfc\x16\xbd\xd8\xfb\x0fv[\xdf\xc2J\xee\x92x'\x13K\x9c\xc6\xb2\xd4\xe6\xb0\x9b\xd3\xb4\x11\xe6(\x15\xd4\xc4`R!\x1d\xf90\x9e+zB\x96o\x12y\x0e)jz\xc4\xa2\xa6\xb1\xc2\xcb\xdcK\xa5.ebW\"\xc0mn\xa8\xf8\xf2\x0a\xf3\xffqS\x15\xe8\x80m\x84h\x14z\xd0\xee9\xed\x1f\xfcc\xe6\xdb\x8bd\x943Q\x85\xb5\x94\xa3e\x92\x01\xfe\x00\x99\xc1\x19\xf5\xcc+\x84\xf9\x03\xa3\xc0;\x1f\x7f_\x11\xf2s\x05\x95\xc9;\xbcb\x0c\x12X\xfc\x80\xb9\xbd\x19\xab\xba\x0e\xb0\x079\xfath\xe9\x11u\x96\x86\xb0\xac\x90ZJ=j`\xf4S\x1a\x89\xdc\xecGl\x00\x82$i\xdcT\x90D\x9f\x0b,\xbf\x8f\x00\x0b\x1ef|&\x00\xc1\xd8B\xed\xa6\xa83],5\xe4.\xc4\xebk\x14\xf4\xf2\x83n\xf2Z\xc7\xa0\x12\x18\x1f\xb3\xb9@\xb1\x0a\x11\xfb=v\x847\xe6Lt\xd8\xee\x1fi\xe5\x0d(\xd1\xa8\xd5L8\xa7\x10'wJ58\xe8\xdd\x88\xff)j\xdcc\x14s\x8b\xb0\xd5\x97\xc9\xfcOP\x1e:#\xfb\xeb(1\xc7\xfc\xd3\xa5~5\xdd5\x1d\x82m\x18\x05\xc5+\xf8\x8a\xa9\xc1\xbd\x9eG\xeaA\x176\x945\x1d\x01BR\xd8\"h\xc9\x87\x88\xa4\x8dh;F\x90\x09\xe9\x9e\xff\x147\x8fa\xa6\xda\xcc\xf6q\xb4\xa0\"\x19\x91\xdb\x10\xe7\x81a\x12B\xb9\x878\x9f\x94)\x8dN\x9eH\x9e\x0a\x12\x8f\x04=\x04\x8e\x1d\xc6 ,?V\x08\xb4Ra[\x18\xfd\xee\x96%p\xb5!\xab\x84\x00(\xc1!I\x88\x10X\x98/\xf1\xc0\x8b[r\xc4\\!\x13\x12\xd8\xa06D\xa9\xee\xa7[j^A\x08\x13\xee0\x80\xfa\xc4\x03\xa6)\xf4\xfaTe\xcb\x13(\x07\xc8&\xde\x18\x970\x89F!\x89\xbd\xb1\xf2'\x9a\xaf;{s7e\xd9\x10\x92\xcf\xc9\xdb~\x0b`\x16~\xf0\xe4\xb1\xe4tKJ\x0e@\xb4`\xc3\xc2\x1f)\x00h&\xb9k\x93\xe0'Sc}\xe3*\xc6Md\x0c\x18s\xa9\xbd\xf4*X\xeb\x19\x8e@\xe7\xd7\x039C` \x91\xec\xe8(\xbeW\x9c\xaa\xef\x1d\xb5L\x10\x11\x82\x0d\xd4\xfd<[6x\x97\x08\x9f%\x01\xda\x1e\x14\xceG\x8bs\xbeS\xdc\xefp\x8d\x16\xc8a3\x0d\xc9\xa2\x1e\xa9\x85\xc3m[\x9b\xc0\xfc\x99\x8a\x0e-* \xdaJ\xe8\xde)\"\xaf\x92\xe1\xc1`\x98\xb3\x02\xe8\x9d\x12\x8b\x97\x11N\x14A\xf4-m5\xa3\xde\xa0Z\xb1\xb9\xe5\x0a\xfe\xfe\x0e\x83\xf3z\xd9\xe3\xc5wzv\xc7\xea\xb1\x98\x8a\xfa\x0b\x86\x89UR\x1a\x95\x94\xe6g\xf4\xc0P\x8e\x1d\x0bvo/\x12\xa7\x0f\x90\xeb\x93\x16\xca56;1E\xb4\x96\xc6\x10G\xa3\x18\x92\xbeRg\x87:VIR4\xac*\x01\xb1\xf2\xfa\x9d\xee3\xc9\x94\x82\xb5\x8e\xe4E \x10\x04\xbeE\xa84lz\x11\x9d,\xea\xcc\x11e.\xa4\x12E\x9d\xf9!\xf0\xe3\xaa\x81\x9c\xae\xfa\xca1\x81<'\xdc\xff\xdb\x1c\x03\xb0\x0cW\x8ci\x90\x0fq ?~\xd52I{\xce\xbaU\x1d\x87\xec\x9cH\x93\xaa=\x00\xc0\xc9^\xe8\xe2\x8f\x81R(\xc6\xc3\x99'\xce\x9e\xc2W\xba\x17Y\x17\xfbK\x9f\x0d\xc7\xb5\xd9.S\xca\xd6\xb3Hay\xcfeC\x0f\x9e\xdf\x86\xaa\xb5(\xa8f\x81\x90\xcd<\xe8\x8f\xf2A}" +
+	"&3\x87Q\xdd\xd96-/I^%\x94\xde\xa5\x04Q3\x8a\x88\xfc\x94\xf0J$\xd0\xa2\xf4\xf8\x9c\xba\xe7p'\x87\x94\xd5\xfb\x14\x14\x0cd\xb0'\xbd*\xb5[/^$\x930\x91)\xe9\xd0x\x10\xe2\x82\xa9\xe9\x9fW.\x95\xaa\xc3U\xb8O\xd6\x01\xc5\x99!(\xaf\x1f\xb0\x8e\x9f\xb6\x02\x19(\x1ap\x92\xc4\xa5\xd9[\xcepK\x08\x12\xf7\"p\x90\xd2h\x82\xca\x9a\x03\x17Z\x84\xc4\xd8\x1f\x91U\x1e\x83\x9b\x19\x08Tt\xb1\xe9\xb6D|\x8c\x82\x89MV\xd7\xd6\x8b\x14\"\xf1\x1a\xc9\x01R\x94\xf5$l\x99\x0b\xd9&\xea@\xf1\x989\xcbJ\x1c]!\xa9i\xff\xe7\xdbv \x1cB\xf1\xc7\xc0\xc5I\xb5^\x09\x96:\x0a\x0f\xa7\xd8F\x89\xc4\xc0\x91\xfb\x8e\xe3\x84\xce$\xc2\xa5u\xb8\x84\xce\xdb\xfaF7\x9afg&b\x09M\xd01S(\x89\x05\xb6-\xa1\xae\x83\xfb\x1beQ\xfb\x913<!\xb2\xddz\xb1\x93\x9b\x85\xa8\xce\x1d\x90\x8bZ\xb0{\x8d4\xc0\x82:\xef\xddf\x12\xc7\x8a'B*m\x06\xcb\x0fN$|\xeec\x80J\xc9\xd1\xd2\xf9\xf0\x16\x9a@\xe8#9\xa6\x05j\xfa\xa9T\xca\x1dr\xb9-\xa1ow\x156\xd8\x83\xa4\xcc\x8c\xc1\xae 5\xbc\xb5\x1a\xef\xd7P\xdeX1GXB\xa03F*>\xdcC%\\\xc7T\x05\xbf\xe5\x18\xd1\xd2\xf5\xf8\xbc\x90\xa6\xdc\xd5m\xcf\xf7z\x1a?7)y\xec4S\xb0\xa8\x84\xf2\x13\x1f8\xcf\xcdN{\xa1Z7\x81z\x1e.\xce ]\x9d\x9a\"\x04\xe7]\xf4\x93\x9d\xb6\xcd\xbf\xf9\xeb\xaf\xfe\xf0\xe5'\xf0\xef\xf5?\xf1u\x0f~1\xf8\xe2\x93\xdf~\x1a\x1b\xf0\xeb\xcbf\xda\x8c\xd6\xff\xfaE\xe60\xde4;L\x15\xcc\x11O\x1a\xfb\xb3\xce\xe7e\xa2\xec\x04So\x1a\xfe+\xdcX\x02\x0c\x97fG\x1c~`\xf8\xde\x1b\xf81\xcb\x14\xaf\x9f9\x15\xb4\x13~]\xe6\xf2\xb2\xdd\xb7`\x8a,\x1d\x12\xe1\xc9\xe40\xb6\xfe\xf2\xb3\xaf~\xfb\xd9\xe7\x9f\x7f\xf2\x9b\xbf\xfd\x05\x88}\x0a\xf0\x86.\xb4\x12\x8c\x1c[\x14\xcd\x9f\xe3\xc1\x9e\xf7\xe1\x1d^&\xb4\xe6\x1e\xa9\xac\xda\xc5f\xf1\xb3\x09\x84\x9e\x8f*e8/1cWqV]\x16\xef\xcbD\xb9\x8ce\x8eK\xca\xdce\x1b\xf9Ea\xb2\x8c\xe3\xfeVl\"\xcd*\x80\x89!\xecQ=\xe5\xb4\x92\xee\xa0Q\x85\x13\x0c;\x8e\x91|\xb7\xc3\xd4n\xb7\xb3Y\x94\x10\x0bO\xd4\xc8&\xc8[]B\xf6{GeN(\xec\x9e\xaaGFH\xd65{\xd0\x09x+\x01k\xd1\x9a\xaf\x91\x99`W$`\xbbl\xce2&R\x12r\x81$\x06v]\x0a\x80\xee\xfaP}\x1b\xce\x87\xb3\x01RXt\xf8\xad|\xce\x1dk7\xe55\xf4\x16_\xa3\x89\xcac^g\xe0H\x1d\xb7\xd6\xe0\xf9`hF\xf4hG>\xb8\xc5gj&\xad\x1b\x90\x0d\xe9\xae\xcd\xed)\x89\xf1k\xca\xafh\xeb\x9bR\x9a\x1d\xa1^\x0b\x176\x85\xfc\x14\xca\xf3\x17\x0c\x01\xb8\x94\xe8\x1d\xd6?\x01@\xbf\x0d\xf5\xbe\xe1\xd7\xc4\xbcY\x95\xe4-\x93P1\xfb\x96~\xa3\x11\x14\x92\"\xde\xe3X\xaeT\xb65\xe1\xcb7\xf0\x86\xdf\x086\x13\x0a8\x0a\x01\xac\xac4\xe6\xbb\xb0i-)u)]~\xf13\xd3M\xfb\x86\xe9\xd78\x969\x8d\x81p,\x8c.Er\xbdLog\xa5\xe3\xcd\xeb\x09%\xf9\x9b\xb0\xdd/R!d\x12M\xcfqA\x10w\xe2\x07\xa41\xbc\xc5\xee\x00\x89\xe2?\xde\x80\xd9\x89s\x10 \xa6\xd9\xf6x\xf4\x9e\x0c\xf5-t{6\xfe\xd1\x16\xde\x0a\xd8&j[\xb1\xe2V\xd7\xbaR\x92\"\xdc\xbc\x86!\xf5G@\x00\xd0,\xcbn0\x951\xe5\x94(\xa5\xab\xc6F\xa4\x96\xb8Q\xc2sM\x9a\x0a\x16\x10w\x05P[\xf2(\x95\x0d\x14\xf2\xa7Wh\x9e\xb1\xd5-\xfa\xfd\xb7a5w&\xbc^xR\xb4\x09\x07~\xba\x89dy\xba$0\xf97L:\x19\xf3]\xe11\xa1\xcat\xc1)\xcb\xca\x9e\xf1\x1e\xcd\x01\xd7\x8b\xdf!\xa5\x98\xa9\xca\xb2Dg8@\xaaA6\xd1\x91T\xc4\x1e\xa8\x13\xff\xed\xfa\x0c\xba\x81\xdauG\xe3\xdd0Y \xd0p\x15K\x80\\\xc3/\xf9\xf1c\x8b\xefzM\x14\x98\xeb\x15td\xaa\xeb\x8eV>%@\xab\x14\x1e\xae+\xa2\xb9\x8ey\xfa\xe7\xcb\x01!\x1e\xca\xf5\x86nN\xa0\xf3\xb6\x10KM\xc7\x12\x914>\x84\x1b\xe8\xa8\xd3\xca]I\x15\xb3l^\x8a\x13\xbe(\x06\x129\x8a\xb5\xbc\x11/\x80*\xb5q\xaf(\xa0Y\xcau#\xe2\x81#dem&\xad\xda\xaa\xee\x9c\x85\xdc\x03Q\x13\xf11\xef\x1fY\xe9>\xb3[\x18\xa5\xe6\x84\x19\x90o\x90\x05*ZF\xa9;+\xe4.DM\xc4x\xe5\xe0\xcc\xd8\xf7G\xb7\xa3X\x1c\xd0\x06#\xe0&\x00\x1e\x18\x91(\xc3&)C|\x0b\xbe\xe4\x11\x83\xe3\x93\x1f'|\x12\x82\xa2n\x81\xd5\xe4f\xda\x9c\xb7\xa89\xd1$\xf5 \x87\x8f@\x8c(\xea\xbfA\xc4eq\xd1x\x95P}\xea{\x8d\x91r\xec\xcc\x8fT\x93\xbe\xdf\x0b\xdc\xc2\xf3\xed\x0c\x81XM\xb1\xe1\xf2HS\x88]JU\xc4*w*k6\x01\x83\xc4Y\xb5=\xd3\xae\x0a\xe5s\xb4_\xed+\xdad#\x8f\x90-\xab\x0d\x95\xaf\x97\xf1[\x08gGJX\x05C\xc0Sf*\xb7\x08fd:&\xa9\xbc\x96\x1e`{\x0e\xc2*\xa1\xcb\xeb\xa8\x0f\xfb\xbeB\xfe\xf1Ms\x1e\xaa?\xe1(\\y\x87$\x89\x9015\xdeH\xb2\xa9\x1eai+\xa8@j\x82\x08\xf8\x194?\xbf\x95\xf0$@K\xa3\x98n$\x81d\xf0\xfd\x82\xf1J _\x01\x9c\xb7\xa9;a\xa0\\\x13\xa8\x93p\xd3K\x02\xd9/\x09h\x8c\xbc\xe6\xc2`\x02\xf1\xe5\xaa)[\xda\x06\x1c\xcc\x07\xf5\xafI\xden\xcb\xc9/Aff\xd3\x1e\x81?>\xb6\x12\xe9\xcc\xaf\xd4L\xe0\xb8Zr\x86b\x9e~\xd2}3\xfa\xc97\xf2\xbc+Q\x93[p\x88\xe7\x82;\xc5\xa0%5\xc4^J\xde\xd2\x97\x8e\xcc\xb9x\x8aG\x15o\x86\x07yc\xa3\xed\x8a\xb9\x1d\x81\x1d\"\xcf\x9c\x04O?&,\x18\x1a\x11?\xe5o\x0336\xe7\xe3\xf4\xea\xbe6\x15\xedRj\xcb,cW\x81\x11\xa4\x06\x9eZ\"\xca\xd0\xfd4I\x0b\xb7\x09\xf4\xc4\x13\xc6T\x1fq\xcd\x83De}\x0b_e\xd5\xac\x08\xb9%H\x9ev\xa6h\xeb.\x85\x07\xec\x81{T\x84\xf3*\x1a\x99z\x89\xcf\xd56Y\x8a\x86~35\xa8\xad\x17\xcf`\xfc\x1f\x9b\x1d\xb5I{\xae\xebK\xfe\x13\xe15[\xb4\xcd\xd2CL\xe4\xdfu\xe8\xa0\xa0t(\x1eg\xfa\xd8o\x8a0\x0d\xc5wT\xc3\x91D\xc9\xaej\xf8\x06\xcf\xe8\x12\xe6\xdf\x93\x18\x99\xd0\xb5>\x85\xa3\xfe\xa2\xa98:*\xdcd\xf1\xbb0\x9f!\xef\xcc!vl-\xcc\x9d\xcf\x06\xb6\xdb\x8f\xa9`k\xdbo\xf5\x94\xf4\x7f)\xe8]Q[\xf1\xfe\xe4\xc4\xa2\xea\xe4y\xb0V\x9f\xee\x92\xfd\xd68\xc1\x8d\x00\x9c\xe1Bs\x89\x97\x02\x0a\xd0\xf9\xaa;\xcf\xb0q\xae\xf2\xb0\xaa*\x05^WN\xe9C\xa6\xcao\x09\xd1xlx\xad0uG\xbc\xa6\xda\xe6]id\xb6bAH\xb6\xf2,x*D\xf2\xca%\x13\xe5\x1a\xacB\x1bG\xc5\xd2\xbd\xd0\x1eK\xefc\x89\x19\xac\x96H0\xab7\x01\xdc\xd5\xa7atk\xd7\x130\xa2b\x90\xe2a\xff\x01\xc3e\xf4.\x89\xc1\xbbh\xa9\xf1e\x08\xf6\x14-\xe0\x15 \x91\xa8\xfe\xb9\xc9\xc8>%\xae\xd8\xda\xcb\x15v\xb5\x83\x9e\x00\x16an\xd6o9\xe6\xa6\xa2\x16\x89\xeb<\xd3\xd0\x12\xa8$\xae\xc4\xd7E\xb7\x0e}\xfe\x01%\xfb\xd3,\x0a\xd2\x0a\x03\xc5\xeeiB\x8e):Jnunc*\xf4\xe2\x14\xff\xee[\x17c\x8e\xa64Cr\xddB\xb9q/G\xb6}M\xee?\xddD\x88S\x0a\x94\xb0\xa6-G\xac\xc7\x86N\xa8-\xf3\xf3Kt\x98\x99\x89\xc9q\x0f\xf5Y\xdb\xbf\x81c\xcb\x0a\x8a\xe5\xf7\x81(\xcf\xac\xef\x84/b\xf2\x85\x18.\xc5\x01\x11\xd9\xff\xb9\xdd\xdcg\x1d\x0e\x93\xe6m\x97\xd45g}u{\xb7\x0e\x9f'dFO\xb3\xbdr=\x93\xf2C\x89\xd3:\x02H\xdb\x91)j\x92\xee\xd9\x08R\x81\xf3$\xcdw\x97\xb2m\xa5\xf2\xc9Y\"T\x9a(>\xccc\xfeB\xba\xe8\xef\x11\xcf\xc1\x9ck?v\x16\xa7\xb7_dK?\xeb;\xe3\xf6\xa3\x0aG\xaf\x04\x03\x85n\xaf\x14\xa9\xe51Z\xa9S\xbc\x83\x8eK\xed\xe7%\x94\x10c\x87\xeb)c\x1b\xcd\xc3i\x9d\x05\xf3\xdcNi\\\xff\xc9\xd1\xd8\x80\xcd\xda\xaf\xd9b{\x98A)\xad\xdf\x0c\x08I\x94\xea\x7f\xb6\x9a>Ep\x93\xf4}9>s\x9c`\x8ac\xa6Z\xfabLmusA?\x03\xe4c\x9a\x11M4\xc2\xaa\xbf\xc9\x1e0\xe0\x85\xf8\x809\x08q&\x91Dn\xe70m\xb5\xf3\x87:\xfbQK\x99\x0d$A\x0a?\xac\xa1o\x90U\xaf\xb4\x9e\x81\xc5\xda\x02-`\xfd\x01\xb6\x9b\x9a\x9d)v\x1dU\x10\x14\xaflu!G\xab\x1bGd\xbd\x07\x81C\xb9\x12<<\x96\xf5\xf2R\xa4\x16\xf2\xa9\xdc\xedm\xa7\xa2$\xc6\xc2\xaep\xdc{\x92\x075e\x84B\xed\x9aQ\"j\xd8}\x87I\xeb\xd55\x1b\x09\x97\xfa\xd0\xb4u\xc29v\xbc\xf7M\x1a\x0b\x14g\x84\xf74\xee\xde\xd7\x0f\xf7N\x03K\x1d%\x0fDwU\"!\x039Gd\x86\xf0\xc6L\x9c\xbb\xa7\x9c\xbd\x82\xec\xcaI\xd2:\xa8\xbf\xf3'\xad\xf9wa\xa1B\xae\xbeL{\xaf\xa8\x1b)\xf7\xf70\xb6w;\x96\xe0;\xca\xb5-5\xe7\xae\x8a\x94\xe7\x12\xeb\x91\xe6s\xa9\xa5\x82U\x9a\x83\x9b\x09\x9d\xd9q\x03\x9a\xcc\xbd\x94\xaeS\x19yE![\x1a\xe7j=4p\x98w\xbezO\\bz\x06:\x18\xa1\xd4\xf1*4/\xb2Y\x94\xccK\xab\xc4Q\x93\xb2,i\xb9;\xf7D'I\x8b\xd3\x09D\x84\x7f.\xdd\xc0\xd2G\xcb'~O\x94\x98c\xa9\x9f6h\xe1\xb7P\x03#\x0b\x82\x0em\xcdl\xa3Xt{\xdf\xe0 0w\xfb6\xd3\x13\x83\x129@\x97iz\x02t\x88\xc2\xe0\x0d\xf6\x09\x9b\xc2\xbf\x0e!}\xde\xcb\xa6FxM\xfe\x0c*\x0aP\xc6\x05\xff\xae\xe0\x82\x80\xd4giF\xe9\xcd\x952z:z\xcf\xccD\xb4\xedv\x86W=\xe7x\x8a\x18$\x95!\xd6|\xaa\xb2IK\x90/+\x9f\x87\xa8c\x0d\xdd\xaa\xc9\x19\xcb\\v3\x81\xd2\xcc2^\x04\x19\xf6\x96\x8f\xf4]\x0e\x13z\xe0\xe8\xc1\xebzJ\xd84Kh\x96\xf2r\x9e\x85\x1f?\x19\x82\x0d\xca;\xc6\xc7*\x85\x8a\x07\x9f\xf0f\xf3\x7f\x173\xd0\xeb\xdf\xe2xcf\xb7\xa3\xc2\xba\x90i+\x87~\x07{\x03\xa0c=\x0fs|\x91\xe9\x8aJR\xcdP2\xdf \x0f6\x91\xf1(\x07ESp\x86'\x1a\xc3sJ.w\xb8u\xf2-\x84\xc0t\x15\xd4v\x86\x1b\xc7\xd5\xb1\xdf(\x98P\x89@N\x19WF^\xad\x19g4\xa4\x0f&\xdc\xa3\xd6\x96\x94\xc4\x8f\xb0\xd5_7C,h\x18\xe0\x03L\x0e]5\x13\xfb\xd1\x04\xfd|\xc0\xedL\x19\xa0\xb0@\xf0\x9e\xd4O\xa0\xb3\xc8oE\x85\x08<\xfe!\xab\x9f$\xf2\x11Y\xa9\x98\x18:\xd5\x0bp\x05\xa6\xb4X-W\xa8\x0d\xcf+\xef[P\x0f\x15t\x85\xd7\x10\x0a<(\xb8\x8d\xd7\xa3>\x96\"4\xa1\x16\xa0&\xf5\x88\x92\x8a\xad\x1b%\xc5\xcf\xd4\x97\xdeP\xcd\xb1\x04\xd6\x9c\"F&\xab\x8e{\xd2\xe7\xa7\xb00\xde\xb1\x9c\x8eJdA\xb7D\xc9\xd9\xfd\x91f|8vK\x01K\xaa\x8f\xac\x07\xf06\x18@\xe9l\xa2\\\xaa\x91\xe66\xed[t\xf2\x01\xc06\xd1\xdfA\xccUL\x1a\xbc\xcf\xb4\xbd\xb2l\xf7K\xed\xa26\x13\xb8\xfb\x03l\x1d\x01\x16\x98\x91\xec)\x13<RL\x0c\xcfP\x90'\x86\xd8\xdd\xaa\x99\x0a\x86\x0c\xc8\xd5\x87Ii\xbe\xe1K\x10\xd2\xa0\xeb\x02y\x13\x8d\xa4\xf8\x9bW\xa5w\xb6}x\xdd\x0a\xea\x12\xf9PI\x10SM1\xd2!\x85NJb'\xf5T\xc8RG#b(\x1d\x15\x17\xe1\x18\x13\xd3\x81\x0bel\xe5\x86\xb0\x8a\x14\xee\xbd\xb6\x0b\xf5\x83\xfc\x8aM\x8c\xc3\x94W}@\x06wt\x1aM\x14\xdf\xb6\xdc\xe50\xaeq?\x19\x84q\xa8\xef\x81\xd7\xd1\x06\xf5\xd9\xd9\xc9%\xd6x\xfc\x13\xaa\x03\x16#S\xa6j\xe6\xbc\xbc\xee\x8b\xd7\\\xcc\xe9\x04\xc56\x88\x82\xfc7\x9d\xd1\xf5\xaeG)\x81\xf1\xf3o;\\\xee>\xd1<6\xda]\xa3`\xa5\x94\xec\x8b\xc3\xderh\xff\xc4\x08\xab\xb9\xc4\x07\xbdD_&\xe69K\xee!\xccT\x86\xcc\xc6\xf6\xb7E\xa6\xf9p\x03\x9d\xc9\x9d=\xc3\xdb\xf7<\x98\x01\xb9\x1b\xd9\xe9&d\xc9=\xba\x8d\xb2\x7f\xfd\x0d\xe9l\x17\x83\xb8\xee\xc3\xf3\x9b\x9a\xe8;\xd9\xa7\xd7\x0a\xcfQ\x10\x0c$#\x80\xdfv\xb9\xf1;\xfbO\x02%\xd4\xafa\x94\xae\x8d\x00\xdd-\xa5nV\xb2\x19\xb8\x08\x00^\xe6q\xc1\x8eUIy\xa5\xe8\xbb\xf0\x18\xf1\xd3R]9\x00\xc7g\xe9\xf4\xedA*m=+\x1f\xda\xce\x91\x03\xd7\x96\xd5oak\x15\x96\xc7\xa6\x85\xea\x9a\x0c\xcd$M\x81PpEzh\xcc\xe0>\x06\xfc\xb4\xa8\x8b\xa0iP^\x9e\x0a\xe8\x93\xf1\xd5\"\xaa\x98\xdc=s*=\x0e1\xd5\x0a\xe7\x8eN\x03Hj~O^5\x92\x084\xc7\xc0\xd4l\xbc\xd8\xe6(\xbe\x06\xd5\xde\xcd;\xeb\xe7[\x90\x12\xce\x1fe\xdf\xfe\xb1%\xdd$\x03\xbe\"\xe7\xc3\xd43WR\xd5)\xf9\x19\x95\x92s\x95N\xb9\xa8\x9eP:\xdf9\xf4\xfc\x11A\xaf\xaf%\x94\xb1}\xf8\xa83\xc7\xe4!\xba%\x03(\xcb\xb0\x84\xd5\x14\xf1=A\xff\xffO\xe1'\x94\xbf\xbf\x85k\x96\x09\xdaQ\x94\xd6Uw\xe2\xbcE3\xa5#\xf4~T\xcb\xc4\xee\x0b\x1f\xf7\xb3\xa4\xe9WcK\xcf;\x12$.}p\xee\x19\xa0>\xf4<+\xc8:NM\x19-\xe3\xfa\xe5R\x05\x0a\x05\x1b\xd6\x1f\x9b|\xae\x83\xad\xc6\x9c{l\xf8\xcb/\xb0\xdda\x16\xf6\x8e\x9b\xe2\x88\xe4\x1d'\xacY'\x08k\xfb\xe26!c\x1aq\xba\x8b cGE\x89\xc8v\x80\xbe\xd6\x1c\xc2\xd7\xb8eZ\x1e~KvR\xd6\xb7@0\x11\x9a\x13\xbe\xc5\xe6\xebP\xadB\xb6\xac\xd6=\x91\xe8MTJ;PO\xb8a\x1cv\x17\xb2\xd0/\xc9\xc5\x0d\xe00\xa3<\xa9Rp\xf9\xae\x0dd\xdc\x9c2\xa1\xe6\x13\x0c\xccn\x9f\xb6\x07w*\x09\x87~\x8c\x15.\xe2\x13\xc1#|\x1a\xfe\xe3\x818.^\x0e$O8\xe4qM\xe6B\xf1_\xe8\xfc\xe0\xcc\xdc#%4\xf6EY\xda\xd7\xe5\xe3\x02\xc5\xdd\x9d\x9fs\xe9u\xd0]\xbb]\xcb\xb0\x00!N\x8f\x15\x9cD\xf5\xcc_yd\x82>BS\x9d`\x04\xff\x16%\x8d\xfc\x97\xa3T\xcfYR\xdb\xfb\x11\xbd\x928\xda\xad\x8e\xe7\xee\xeeO\x804\xed2\xe2\x84\xc5\xb6,P\xfd\x19Xv\xf7ZZ\xeb!_vBI\x9f\"m\\\xd2U\xa3\xa2\x99\x86\x04\xd4{\xed\xd9\x9e0\xf1\xb3\x8cHE\x9aMPyR\xbe\xc5\xabh\xf6\x95h\xdaU\xad\x12\x8a<*\x9c\xa3H\x06:J:\xa5\x92Fo\xec\xc6\x1b@2#>g\x85\xfd\"\xda\x0b\x91\xc4\xf4\xf3t~2\x82\x1eo\xc05\xed'\xe7\xb1{\x90%\xc6R\xb0A\xcd3j\x0c\xd5k\x8ch\x18\xc4\xc9\xb2\xe5\xe0\xfc\x1b\x14i\")%\xd9\x1f\xcf\x07\x1d\xe9-\x02\xcdV\xb1uPW\xab\xbe\xef\x85\xaf\xdf=\xcc1oF1\xe8\xa2\x1du\x9a\x1e\x8a\xf8\x93\xa3$*u\xf7\xd5\x03 \x05\xdev\x93\x0a\xbcH\xe2\xf3\x07\xeaW>Up\x07.\x8a\x09\x04\xf3\x07\x99\x10\xe3\x12\x83\xa3z&Y\x9c*\xee\xf56\xe4\xc7\xcdX\xe4]\xa0S\xd5p\"Vq\xbf#9\x90\xdb\xe5\xa4\xbf\xa1\x1d\xa9o!z6g\x84\xe6\xf8\x93C\xea\x83 \xb3\x09K\x0bh\xf0\x9a\x95\x96\xba:.\"xS\x9b\x09[\xbc=\xda\xc6N\xb6\x11#\x94,\xb7\xa9\x83\xec\xe4w+A\xd3\xa6\x0f\xfc\"U\x89\x8f\x82\x061#s\xa3\x80\xecB2\x7f\xc2t=\xd7p\xe2\xddp\xc1\xb5\x10Y\x9a\xde\x01\x8d\xde\x01\x1a{E`\xa8D\xb9\x95=\x90|\xdeno\xcbyG\xcd\xcd jnD\xe1\x0b@|B\x07\x9a\x80\xeb\x86\x84\xb5\x0b\x06/\x8e\xfd\xe7\x97\x84\xd9\x16\xfa\\\xb4\x00\x9f\xa9\xe6\xb2a;\xd0\x9e\x15\xd7(8+\xae\xb1\xfe^\\\x8b\x13yJ\xe5#(\x80C\xb7\x9d\x946t!\xe4\x09\xda@\xd2a\xd3\x93\xcc\xebe\xdf\xa7\xe9N\xbd\xe7_\xd1\xd1\x11\xa1|\xfc\xe6!\xf2^\xbf~\x91\xa9(d\x86#\x87WK\xe9\xae\x16\xca" +
+	"\x04\x04{\xa05\xcdR\x82\xc9\xcd\x17\xc4\xee\xe3\x1f;\xda\xf7\xf7M\xd5z\xebdg\xcfU\xa3l\xa5p\x1b\x86\xd6\xedX\x80\xba\x80\x9e\x82\x8e\x88w\"\x09\x8b\x7f$@\xf3\xd8t\xb6\x87\xf3\\\x93\xc2\xe8-\xef\xdf\x1a\xfe\x13~\x8d\xfa=\xc9\x1d\x04\xc7\x87\xc7\x0b\xd4u\xe4\xfb\xfd\xf1\xc29\xea\x94\xbe\xcaO\xafv\xa6#Sa\x81\xdc\xf5\xb4+\x9c\xb2\xb3\xae\xbc\xea\x0e\xafKv\xe4FZ\xf2\xe2=\x9c\x88\xdc\xe4\xbd\xf9\x10\xab\x99m\x19\xf5'\xcc+\xe39A\xf7\xb9cV\xf9\xea\xe1\xb1 \xd7\xe5\xd1r;\xc9\xcb\xa7T\x0f\x84\x1a{\x02\x80D]\x0c\xda\x96\xe79\xf1\x9c\xb1l\x85\xb8#\x96\x9745\xf0*\xb8gUw'\x90:!S\xf5\xeb,f \xf5\xa0\x12\x1e$-\xca\\\xe4p\x11\x8f\xb0\xd0\x92\xbe\xe8B\x11\xa3*V\xea\xb3\x96\xbez\xe1\x9eS\x0e\x07\x99ouqS\x93\x92\x85\x9a\x14\xe3Td\xb6\xb1wS\x8a\x168\x87H\x95\xb7\x02\x9f\xa5\x09 \x08!\x01c\x18\xc9\xf5\xde\\\xfb\xf3\x04:\xa4jQ\x07\xc9bK^\xf9\x91*\xf8\xfb/\x1eu\xfehh d\xcaG\xd2\x8fR\x99U\x87(\xd4l\x05\x05\xf1C\x1f\xb9\xcaA\x14|\xd6\x1b\xdb\xdb\x8a*\xea\xcb\x96\xefL\xe0\x05\xc8\x0d*\xc3\xe2\x7f\x93\x01\xc0l\xdc\xb0\xf3\xd8i\x19\xf6\xf4\x8cu\xd0\xbedj:\xd1\xe3\xffZ'\xb1\x92\xdaj*Q,\x9a%\xd6\xd7K\x88\x16\xb2\xafk\xa0\x14\xfd\x0c\xc3\xfeVO\x1aX\xd3\xbaw\x8a1M}-\x8es\x92\xae\x814\x95=\xe5%\x12\xdbM\xb0&\xb9\xd7^\xb1a\x86\xe8>4Y\xcav\xe8)\x90.\xaf\xf8\x94\xb3\xe8]\x01\x1d.u\xff\x83\xed+(\x0d\xcc8\xfb\x10\xc9\xb8\x89\xf4wI\x83\x98d\xa4yy\x8b\x84\x03\xe5Y \xfd\x96\xca\xee\xc9\xc8:9\xe4\xbc\xbb\x9d*4+\xa6<\x9a)\x8fT\x02]\xd1x\xd9J\x09]\x8b\x88\xbc\xecd@\xcb&\xeee\xa8\xa2\xb6x\x12\xc3\xe0\xab\xcf@\xfd\xe3\x08{$\xb3\xa5yJt<\x0av\xbb\xbf\xddC_\xd2\xfa\xb8\x8f[Rz\xa8\x96\x04\xec)\x18\x06\xad\x08\xa1\x13\xa8s\xd6\x8b\x81L\x0a\xf6\x99\xddb\xba}\xd1\xfd\xfa;\x1d\xaf\xff$C\xf1\xb3o\xf7'\xf2\xc1b\x10\xff\\\xd4\xaeV^\xbdst'V\xa7\xbe\xdct\xc4]\xe27g\xa0\xd2\x03\x99\xbe\xc5m\xb1\xb4\xc8n\x94\x8eo\xdb\xea\x87~\x8d\xca\xf6l@E\x82]\xd3M\xb8\x11\xd13q@\xc3\x99\x02]\x969\xfcP1\x87/\xd7nO\xfdC\x17o8\x00I\x10\xbd\xf2C\xab_\x9d\x80\xc1*~\xbdq\x0eB\x90\x14\x83\\O/\xacgV\xe7\xea$\xccQU\x9a;\x02\x8f\x9d\xb6g\xb6\xf0?\xe2\x0c\xc6\xc8\xa5\xbe\x04:\x81\x93\xe07\x9dwSF\xb0\x9b\xf8Q\xf4\x077\x13\x95\xe8\xa7G0\xc3\xd7J\xf7\x14\xf2\x96\xe2\xb7\x9b1<%YC)k\xf4\xd4IUp)\x07;\x7f\x136\xed\x85\xa6\x8d<\x86\xb1^)z_g\x13\xb8`\xa6\xf6\xb5\x91>xih\x03c\x08\xbc\xd0\x08\xd9G\x91P\x89F\x15\x03\xb8\xfd\x09W\xca\x02\x99N\xe3\xf0\xf5\xcdQ=\xaa\x93XK\xa0\xa9\xce\xd2\xe7\xdb\xcdV}E\x90QC\xf7\x81t\xe1r\xe4\\\xf0\xa3\x12\xca\xec'\x12\x1b\x9a\xd5\x91\x8b\xa2\xc2\x94\xed\xba\x04\x97t\xc2\xcd\x94\xd9\x9a\xa5\xadA\x8b\x8dA\xf23\xc2\xc1m\xc7\xb3x\x95L\xd5\xb9\xdbY\xc9\xb4\xe5il\xcc\xbf\x0a\xd2\xfc\xe1\xf5\xd7\x0b[\x9a?6nJ\x05~\x05\x0eE\\\xc5\x9ap\xa1\xbe\xea\x98I%!\x8e\x076\xad\x88\x84K\x10\x94\xa2\x92?_\x85\x02,\xabk\xe9\x0f\x9b#jW\xb1\xc5OM}JdG\xef\xc2<\x9f\x1b#\x107\x83\xf0..\x09Y9\x11o\xeb\x12\xff\x83Z\xe7iS\xe2\xb7\xde\xc2\xce\xa6o\x8d\xe2\x90#3&\xd7\x87\xa3[\xe8J\xa1?Sbf/M\x00\xe8%\xdf\xdf\x9c&T\xda+\x91&\x00z\x96\x0a\xaa\xc0e\x12\xb0)\xce\xafh\x99\xc60\xc0iz\x8e\xcd@}I\x09\x85\xaa\xfe\x08\xd7&JL\xe81AR\x93N\xb2\xda|2~\x83\x8e\xf6}%Z0\x8b\x99e\x9d\xac-\x99\x0263\x96l8\xce:\xa4\x9c\xad$\x83{\x0aod\x8e\xbd\xf6\xfa\x9e|\x05#\xc1\x08\x8e\xab\xc1l\x14\x9d\xcb\xd2ZJBM\xc3n\xd6\x9f\xd2&\x1e\xf83fz\x82\x7f\x84v\x83\xd6\xc3BBCV\xd0\x14\xb7P~e*\x1cn\xc5X\xdfP\xaa\x10\x14\xc4Kp@Y\x16B\xd1\xce\x8f\x04\xf9\xabI5-\x09m\x97\xd0x\x97)o\x07\xb3:\x18\x85\xfd\xe0\xb5\xf5\x00?1\x06\xfb*,j\x01\x0f\xef\x81\x04\xad_\xc5\xd5\x83\xecGI\xb7\xd1\x08\xa2\xdc\x0e\x0a\x03\x07N@DI(-\xec\x92j\xf8\x9d\x0c}\xf8\xf8s\xb3\xae \xe4:\xe2>\xef\xbd\x00N\xfd0\xd5\x89\xe7\x1f`\xcco\xbb\x9f}h\xfd\x80\x9f\xed\xa4K\x15\xf9:\x93n\xa7\x90\x08\x88\x0b\x80\x98\xf6\xb1\xd69\x93\x8co\x89.\xdcF\x82\xf5.T\x84d\x1e\xa3\x9e\x04\x97/\xe6L\xbd\xa6St*QQIsn6(\xa8v\xf0\x12\x84!\xc0f\x01M,n\xe9\x01\xe0I\xc2\x9a\xc0\x05#$?\xca\x90E[\xf9\xbbA\xca\x96\xaa7\x19X\xee\x90\x1czP\xd2/\xb4\x8f\xea\xb6U\x03F\xc8\x94y\x1f\"h\x85\xf9#lt\xbf\xd1g\x96\xa0Bz\xe6X\xcbE\xd1\xa0\x90\x15\x1e\xf8\x12d\xaa\xe19\xa2\x95\xff\xa8\xa3\x0aI\xeePX\xf8(\x04\x06\x11?t\xcf\x88_cN\x93\x0eGJ\xbb\x95X\xdcM\xae\xb2\xb9\x0eY'y}\x96m\"!>\x9a\xb7\xa2\xf2\xf3M\x0e\xc4\xb4\xf6\xd6,|%\x8b;\x97\x08\x90\x940\x06\xbf_\xdf\x83\xe4+t\xb1Eg\xb7\xd0\xf9\xf4\xcd\x87C%\"\x8d\xa6\x86\x08\xbc\x7f\xec\xc0'\xcd\x80\x1b\xfa\xb4^8\xc3$\xb2\xff\xa2\xe1G\xd0\xaa=W\x95\x8b\xc9\xf1Y\xdc<\x82\x8f*\x95\xc9\xe0e\x16\x88\xe4Hc\xc4\x94n\\\x0f\xb8j\x97\x84\x8e\x8e\xa5\x9b\x80u[\xfd\x85:\xdbEX\xf4\x11m\xaf\xdb 3\xfa\xe8\xf2\xd8L\xbd_\xf9i+\xbb;q\xc2\xd7\xd7\xf2\xd4M\xd9\xfc\xb8n\xd0]g\xe9C\xa7\xef\xc3=Z\x02\x03\x81Q|\x80o\xa9j\xda\x1d\x95\xbd<l\xff=\xa5<n]\xe0\x96S\x9a\x14\xcc\x0f\xfaHq*\x8a7q\x80\xea\x0f\xa2\xfdI5dj\xa7S\x9c\xf7R\xe2b@_\x82\x9a\xf28<o\xe7\xa8\x1ed\xc5\x10\xedp\xf5\x18*fY\xc0:D\x91c\x1aI:E+\x0bq\xa9R_\xaa\xe8\xf4\xa3\xbe\xc9\xf8P\xc9\xb6\x81S\xc2\x89\xf5D\x90\x04u\xcb\xfa.^\xd9\x99<\x07T\xa4\xf6\xad\x0c\xff\x833\xb3\xc4f\xaa\xbe\xb2\x01\x97\x90\x1ax\x00\"\xe9%;\xe6\xd0\x81\x05dl*)|\x05ia\x0av'\x827q\xc3\xf8\xf4\xb8)~\x8a\x89\x95\xb6\xae\xe8Y\xdas$eO%\xcc\xaa-\xe4Oh\x19\xf1R\xe4d\xce\xac\x1a/\xad\xb8\x1fYd\xbf\x99l ,\xf0g)\x80>\x1eN\x81\xa6g}nG\xc7E\xaaY\xae-|\xc7\x18wG\\#\x12\xb5~\xbb\x89hA\xd4\x1d\xe1\xd4<\xdbD!o%:\xcf\xb4\xfa\xf5\xb9'C\x86\xba\xf7\x14S\x9d\xd3\xa8\x93a]b\xdf\xa8\xed8\x8aS\x86\xc2\x18\xe8n\x80\x03}\xd4M\xa2\xbc%\xd4\xfc\xd9Z\xeb\xfei\xb8\x07d\xeb0\x95\xde\xda\xbf\xfcdr\xe7\xed\xe7\xa5\xedN\x9d\x93\xfa[V\xedI\x0a?*\xc4-l\xf8`x;\x07\x0e,\x05\x0e\xd3\x0b\x9dM\xe7\x13\xd8A\\\x1b]\xdc*\xdb`\x9e\xd4\xf5+\xadt\xd2\xb9\xfa\x8d\x15\xd9@eA\xcb\xcfK\xcf\x87\x86\xf3O\x0eg8\xf0\x84\xb9\x1f\xb7$\x14\xf0\xd7o\xf1\xe3\xfa\xda~\xfc\x00DA3\x10jI\x7f\xad\x8c\xcf\xd9\x80J\x1d\xc1\x9c\x08\xda)\xe8O\xe7\xe6\x17M\xf1{6\xf0\"\xc38\x02\x08N\x91\xdd3\x865m\x0a\x10\x18\xc8\x05\xca\xd0\x92\xb6\x16.\x19\xafkh\xf3\x0a.i=\x9f\xc0\xd3e\x1a\x98\xb8\x8d\x1b\xc6$>C,\xc7E\xb2c\x93\xea\xbf\x04\xb8\xa4\xc7\xf7#,\xben\x86y\xc2\xa1G\xc6::\x1a\x9d\x87\xde\xaeL\xec1\xf3#\xa3l\x8a\x9cf\xadd\xb7:%f\xeb\xa9e\xe4\xd1\xe4^\xfc\xac7\x91\x9c\x02p_\xe7Z\xef\x01J\xbe\xd1\x82.e\xd29^z\x9e\xae`\xa3\xd6E\x0aG\x09\x87|Z+]\xaf\xb1\xb0\xd4\x1d\xc1\xee\xe3T\xba\x0d2\xd4**\x8a'W\x89\xcf\xab\xca\xb7NA\x1cc\xf7[b\xec\xe9\x9c(\x0evuI\x88\x18\xff\xd2\xf5uGa4BYM\x80\x9e\xdb\x09\xd6\xda\xb6\xc4\x92\xf6\x11\xae\xa9y\xfcV\x02\xab&\x92\\\xe3\x14\xca\x0b\xaceN\xbd\xec=Y\x81#\xdf\x85(r]\x07\xcbG\x80\xbb\xcd\x01![\xfb\xce\xf8\xb6g:R\x9cVeJo\x8fI\x85<\xb2\xba)\xe4+\x9e(\xf0\xe0+\xebs|N\xc9\xcb)x\x98w\x11\x95({\x85\xc3\xb1\xb1\x92\xec\x04^\xe8Rx\xa1K!\x07\x94\xaav\x13\xe9\x0a\x0b\xdazU\xca}\xddT\x8a\x1a\xb1~\x88{\xae\x19\x83\x1fL\x0c\xcf\xa7\\\xaa\x94Rh9K\x17'Z\xcaC\x88\xc2\xcb\x80&\xb4\xe8P\xf6\xdd<\xc5%d;\x9c|x\x9b\x08\x96\x8bx\xf4c?\xf8\x11}\xda#\x0c\xd4\xdd\xe6\xa8\x1d9\x91\x8cX\x05\x1e\x87+\xa6\x09tT$\x1cP\x92\xba~!\xc8\x84\xe0-D>'\x11\xb8\x9f\xe4\xdb\xd4\xa2\xa3\xa7Eo\xe7\x0a\x8cX8\x0dD\x0f\x81vK\xfe\x04\xa0Hs\x0e\xe0\x1fxo\xdfZb\x05\xe8\xfd\xfa\xd8\x8f)\xfaE\xca\x90\x0c,\x8e\x95d\xe9:A\xce\xe6A\x80|\xbc\x15\xe0,\xe2\x99-\xdd\xfc\xa1sIDr\x83\xfb&\x8d\xbe\x80\x0b\xb6\\X\xd9<rH\xc6:\xb4k\xa9\xde\xce\x02\x99\x05\xfc\xb9\xfe>\xad\x8e\x8c\xfb\x1e\x0b^\xe7\x05\xee\x9b\"!VK\x04\xea\xe5\xbc\xff)Z\x88\xe6\xb8{\xd2\x86\xa2\x14\x8c\xa1S\xc1\xa1S\xf4V\xb3\xb4M+H1-\x92\x02\x8f\x82`^aS\x13U\xddZ\x13\xc5\xce\x14\x16<\x85\x8c\x02\x02\xae\xf7\xc2\xf0\x08G\xe5w\xc35\x0f\x09r\x0c\xe7\x1e\x80\xad\xf1V\x7fk\xfd\x0c\xa2\xfb\xea&Xz\xf6\x04\xf4\x17M&\x8f\xf1\x86w\x80F\xbc\x91\xa32\x00\x86 !Y\x91\x1cK}\x94Sf^\xb7\xdf%+0\x7f B:\x12\xd2\xc4\x02L.i\x93\x07&43\x05Lh\xd5\xac\x17\xcf\x80\x90\xbbB\xdc]\x99GV\xde\x8c\xa1C\xe1y=\x9d\x83\xc2\x99\x83\x96&\xfe\xb0\x08\xef\xd0\xc3K\x19.\xc5\xc9q\x12\x16\x04\xb1\"Sm\xd71\xd5\xa8\xb0\xf2#\x94\xa0V\x8e\x7f\x9a\x94\xc7\x09\xff\xadN\xaf\xecXL6[\";\xcf\xe9C9\xc4\xa4n\xcf\x9e\xb4\x0d\x95&\x93\xd6\x96\xa1B\xba\xb4\xb0\x9e\xb8]2\xa3\x89\x91b\x91y\x9e\x98\xc3\x84k\xc1O\xe7\xcc\xd4\x03\x03\xe9\xf5\xe0[q\xda0\xfc8\xba\xfb\xc4h*\x0c\xd6(d\xf7.D\xa7\xacp52\xa2\x1c\xfd\x92\xd0#\x03\x13\x9e\xcb\xd3\xe9q\x92B\x8ai\x80\"[\xd2\xad\x9cx\x82U\xe2\x81\x9b)\xcd\xba'\xe11\xa2\x08\x17\x89\xc6\xa1\x12\xbb\xbeDpB\x16\xc4\xdc\xa5N\x94k\xc1\xdfe>\xfa\x11\xfep\xaa\x81O\xc2\xa1\xe6*]x\xbc\xb7\xc2\x9dn\xc6\x09\x9bZN\x0a^]\xc9\xd5\x8d\x99\xf6\xca%\xfb\xba1\xc8\xf2\xc3U\x910\xab\xfcj\xed\xa4_\xeb\xfc\x81`\xc7\x07\xba\xa9H%\x166\xdc\xaay)f\xa9\xb5]E\xcd< [c/\xb5U\xb6\xa2ndy@\x8b|\x82\xea\x07\xa54\x97\xee\xc0\x08q\x03\xa2\x91\xcfL\xb0i\xf5.D\x82h\x86\xee\xb9Q\xa9/\x80>\xa7b \"+Y\x09\x0d$\x9cp\x9d\xfb\xcd\x85$\xd9&\x04<\x99\x08\xeeu,\xcb\x96.DF'~\xd4\x8fO\x1f\xa1\x7fae\xa7\xfb\xc2&\xb7\xf7\xd3u(c\xe4)\x80kb_F9\x88\xad\x0a\x98\xe9L\xfb\xf6Ty\x19\x1d\xe0\xf0\xac\xa5\xa0\x8a\xea\xa9\x8f\x14\xb2\x03c\xb6\xdat\x8a\x86\xb8Y\x92\x97{\xb1\x9a\xf3m\xe4\xf3/9\xafG\xbe\x90\xd9|\xfd\x02\xbdQS\xd8\x07\xad\xaf\xfa\xfd6\x18\x09\xe2\xf97@\xd9\xe0\x86\xc4\x01\xb7\xe5wS9{ZFu\xa3\xce\xa2gH\xc4$\xcd\xc2\xccj_D\xb8(\xc0\xe5\x13\x1e\xe7);~\xbd\x0b\x8fk\x83x\x81\x19P]\xad6\x8dMn\x8b\xd8:'B\xcc\xee\xd2\xc7\xb8\x01\xa7\xe2\xaaEuC\xd7\xdb\x15Q\x86\xc2X\x87\xffY\xc2\x8e/\x07\x1b\xdcAn;s}\x1d{(]\xe1\x1e\"Fi#\xaf\x19\xfc\x14Yv\xec\xd1e\xbcdf\x96\xe8q\x88\x1d\xcb\xca\x7f\xa8\xdb2\xd6\xd8\xfd\x97H\x8f1\x13\xc0&%\x00\x11\x9fm\x9d\xb0\x06\x97\xf2\x94\x08\xa8m\x1a\xfc'\xd3jy\xd12f\xba\xa3\xc5\x08P\xc92\xc4\x89\x13\xb6\xab\x9a\x01\x82\x1e\xaf\xf3P\xad\xc4~B\x7f\xf9\x94*4\xe8\x1c0?\x0e\xde\x03\xccN\xba\xa8>\xb2\x1b\x97\xe1\xe3n\xc6MU\xbf\xa3x\x18\x0d\xbc\x9c\x9f\x9b\x80\x1c\x04\xb8F'o?\xb8/,\x80\xf4\xd8\x1a\x80$P7|m\x14\xd9\xc9\x94\xfey\x9f\x84>\x03_\xa8\xbd\xa5\x82\x9a~\xd1\x9b9;\xf8N(\xd7\x80\x01\xa5\xb9Z\x9a\xe3:\x91\xa5\xb6\xa8\x971n\xe3\xeby\x1c\xf1\xce\x8eH\xf9\x98]\x0fI\xe8x^\xd0\xcezE\xa8\x1f\xa1\xddq-\x10j\xad\x94\x1a\x0e\x97\xb6\xe3\x80\xf7\xb0\xf4\xec/&\xdc-I[gF\x90j3QmVi\xd2a\xfa\x95eX\xc2.\x08\xc8\xcb\x08\x02\x9f;Z\x1b\xf4\x9d\xab\xfa]\x92UR<rJ\xe6\x98\xd5\x8f\xf0\xd3\xfa\x16\xabt)\x06\xb7\x94\xf1\xc4\x11\xf89%?\x0a\x0a\x14\x8d\xc34\xb7e\xd6\xc7,bk\x18G\x85\xee\xb0P\xc3\xa0?'\xcd\xfb\x91\xe3cNS'3V\xe4\x99\xb7\x90\xc8\xd8\xce\x80\x1cF>N\xcdzc\xd6\x8b\xee\xa9e\xa4\xfb\x03L\xd1U\x96w\x0d\xc0\x9e\xc8\xb5\xb6\xbe\x05J\xe7*\x87.)\x9f/\x89\x1dD\xe9cd$\xb4\xa1e\x86\xd9\x9e\xa83\x88\xdf^;u\xf0\xb2\x9d\x9a';^\xff\x0bN\xebe3\x8fo\xd4\x94\xb9'\xdb\xac\"\xd3\xa7\x0c#\x93\x85\xbd-I\x1fr\x9enx\xb6\xe6\xea\xec\x1bC\xed\xec\xb6\x83V7\x19\xcd\xc7\x94\xb9zC\x1c\xaf\xea\x15<\xca\x15\xd5\xf3s\xfdd#\x87\xd5z\x05Fu\xa1\x8b\x1c\x8c\x19\xb9\x853v3\x01\"G\x1e\x8c\xa7\x1b\x89\x88\x11\"}\x8d\xa3tI\x84\xc2\x96\x15y\x00\xa6P\x06\xd4\xc2X\xa6\xe9T\x05\x82\x9fR\xdel\xed2\x99\xae\x8fW\x04\x8c\xf9\x90\x0a\xd3`\xe8\xe2\xf5,\x9b\x10\xfd\x08\x1c\xfe\xaa\xbe\xddD\x96\x88\x06*\x91\x1a\x1aY\x06\xb5`\xb4\xde\xf2X\xa6-\x8bE<\xd4\x8bza\xceo\x1c\xde\xf5\xad\x80&R\xb8\x00\xc8L\xa7\xccxt\xe3?\x9a\xe4F)Ep\x98\xea\xa7\x10\xc0q~\xac;\xae\x80\xc4\x1b\xa6aq\xff\x1d\xbc!Bv\xe7\xc0\x01\x0bUPV\x02}r\xd9\x14\xe7\xbd\x92W\xa2\x8b\xeb\x02u?\x16\xba{\xb6\xf0\x94\xcbL\xa5\xb6\xbe\xe6\x0a\xbc\xae3\x94\x98\xf7,\xa0\xa1\xb5\x902k~\x8dT\xe9\xed\xa1\x1aU\xe7^=4\xe2\xde\x82\xda\x8dx\xebS\x9d\x9e\xa0o\xff&~\x06i\x17\x1b\xba\x01\xa4A\x8b\x0d\xc1\xe7:\xa9\xa4\x07;r;\x86\x91\xfe\x90\xd2\x82]\x00j\xc6f\x9d\xc1E 9+H;\xca\xc6\x88\xfe\xb9\x90]\x1d\xf8A\x0f\xce;\x8a\x86\x89[\xba z\xab\xb0\x8c\xee\xc0\xae\x9f\xb8\x14#\xfa\x0a\xe1i\xdf\x81\xd7\xc8\xe4\xaa<\xca\xf1\xcd1\xa4\x94\xf4\x95\x98\x04\xe6\x05Q\xb0\x0f\xe6/\xd3\x14Z*\xa8\xa9\x94\x0d\\\xf5\xd2\x98c\xa9\x1dI\x00I\x07;\xbd\x17>\xaaQ{\xa7\x8e\x9a\xa5DO\xe9D\x17\xfc\x94\x0a|F\x07x\xaa\x80Xy\x05&)|\xab\x98\xce\xabV\x9a\xad>:=\x08\x94\x05\xa1P\x0e,%\xba\x92\x8evo(\xd6\xff\x11B\xe8[\x99\xac\xba\x09\xae\x0d\x86\xf3\x9aP\x17\x89\xdc\xd7O:\xc0\xd8X\xdfs\xa0\xb90/\xbdi9n\xe9\x15\xd4E%\xc6\xb2sq\x02\xf2\xbc\x97x^\xa5\x1d\x86\"\xce\xcf Ni;'\xa1\x03\x82\x0c\xe5\x0f\xab\xfa]\xf7\xccl'3C\xbe\"\x93\xfe\xd7\x1f\xe0\xe8{\xe0\x0c\x96(\xc3\xce\x11\x06\xa0\xd6" +
+	"3r$,dW\xa9+\xc6\xa62\xff2\x02\xb5=\xb3\x9b\xa0]ww\x8c\x91\xc6A\x83\x8e\x00\x8d\xbd\x00k\x13\xbc\x94\x85~\\a\x9a\xa61\xf8\xa1\xe2Ei\xd9odm_\x9b\x09\x97\x93\xc8e7\xaa\xda;\xfe\x04\xd2X\x95Ym\xcb\x95z\"{\xd6\x95\x80\xc0\x16\xd9\x96|\xe9\xb5\xfdR\xfd\x8f\xcd]E\xd2#\x85\x1d\x90\xf4\x89N\x0a\x1b\xcd\x98\x06;\xd1\xe3M\xc6\xad\xbe\x15\xdf\xf2\xa2\x8e\x84\xabY\x99\xce*I\xe1J\x89hS}\xee\x9f\x91\xc6\x98\xf4mXFP-\x94\xc1Xi{\xbcM\x09\xac\x0f\xb2cw\xcf\xf4\xfa\xf6L[\xe4R\x03NC\xeb\x84\xcf\x15\xaf\xb7\"Q2\xc8p\xcf\x00\xff;hJm :D\x19\xa5\x19\x10\xee\xdc*\xfc!\xaa\x92\xd7\x97\xa81\x16\xbe<\x89n*\xf4*\xc4f!\xf9)\x86D\xb1\x96+L\x98\x15\xe0\xb74Xf\xb2f\x90\xd4\x99\xa0\xb8\xc8\x06zC\xad\xee#\x88\x92\x84\xc2\xd8\xb1\xa65\x8f\x1b\x1e2 Tm\x1ei\xba#\x8di\xb4E\xe7\x98\x8e\xb3\xee\xa2\xcc\xfa\x0aX$\x0e\x85\xbd\x88(U\xa9\xf1\xb8\x03-\xa2\x0f\xdd\xe3q\x98\x0d}\xcdb\xd2O<\x01A\xb2\x18\xbegunZ\x90\xa7Gd^\xa8\xa1\x03\xd5,\xa6n\x8e\xeb\xae)\x141\xf6\xcc\xff^\x0eD\x9cy\x16u\xae\xb7\xc8<\xaf\x08^\xba\xd8@0\xc9\xadb;\xabG\xb07\x8d\xa0@\xbf\"\x99\xcf\x04g\x88\xfd;3-\xff?\x17\x18\xeeq\xda\x8b=\xd3\xc5\x03\xe9K\x0a\xa1\x93`O[X}\xe4.\x88\x15n\xd29\x94OB\xb5\x1a\x0ew\xd5\xcf{\x00\x9d\x00CY\x09\x84\x87\xf8\x09\xfa\xfe\xcd\xac~\x8f\x89\x95\xf6n\xa4\xe7\xa0B\xd0\xdaF\xc6I)\xdb\x12\x92\x80\xda1R\xbf\xc8K_P\xd4#\xe3\xa9x\x01\x02\x0c^\x02_]\xe0\xce\x14\x1e#\xfe\xb5\x94u\x86s[g\xb0I\xbc\x9c\x17\x94|O\xd1\x07d\x9aStC\xe9\x19\xab\x89\xf6 \xe0MY\xe8\xbbGP\xee\xe3\xc2\xeb\x83f\xb3e\x14\x13.{)L\xeem)pA\xffB\xc4\xb1\xc0p\x19\x9a\xad\x16\xb2\xfd\x9cmri\\\xcce5\x89\x8b\xe2\x9ez\xac\xc2\x13\x0c\x92\xe0\x03h\xa1\x13\x05\x9bKOv\x03\x0e\xa1\xe8\x99\xef\x96n\x1f)\x16Z\xfe;\"\xf7\xdc\x1c\x8aj\x0e~)AU@y\xa5A[Q\xa1\x04:\x0a\xa4\xdeW\xfdc\xb7\xde\xd7\x8e]\x0f\xeb\xcb\x0b\xf6\xca\xac\x06\xb4\x0cp\"\xd0\x0f#2\x97\x98&\x0e\x8cl\xef\x8c?\x8b1\xe5\xf7\x0a\xeb\xaf\x8b\xe49\"\x99\xf8gY\x1ekN\"{\x0ec\x8d\xda\xea\xe0%\xd4\xfb\xd7\xf5\xe9\xb3\x81Vg\x14\xe3\xd0u$\xeeZ?#\xca$\xc6\x93\xe0\x92\xf00\xc6\x1b\xd6\x06q\xca}\xa8*\xbe8\xc6\xcf\xb0\xe7\xfcux\xb1\xf7\xb0\xb3\x9a)\\>\x0a\x1b\x97\xcax\x16\xb9`Pp\x80\xb5v\xb4:u[w\x9c{\xaa-\x19=\xc1\xf0\xbfo$\xda\x850\x1b\x1c\xd5\xce\x19\x13$\x1a\xbaL\xe7vRp\x8e#\x165\x97JB \x7f \x09\xcb\x91%\x078\xe6P\xdc\x00n\xd2\x9f\xbd\xcc\xf2a:6*\xb6\xe1\xf7\xc9\x8c\xb3\xaaQ_\xe9\xf5\xf4+\xe8q\xa5\xdc\xea\x0e\xd5\xda{$|\xbb\x94\xcc8\xfa\x08\xc0[@!G\x90QA\xe0IR\xf6\x0f$\xc7\x06?\x8b7\x89\xd7\x1c\xc9\x9f\x1eA\xc3\xea{mH8aU\xa8\xcc\x08\x98\x0d\x97\xa3\x05>\xd7?\xb2[>\x1d&:~]\x8a\x8a~\xe4\x8d{*\xda\x82\xfaX\xe1R=\xb5<@\xd7T*\x0f9\x02>/\xe8\xdc\xd8\xe0\x08q\xf9%\x18\xe9\"#\x94$#\xf2\xc0\xb4\xb0\xb9@\xa8\xcd-\xe9M\x8d!\xa4\x9c\x8e{\xabY\x1f\xfb{\x0f\x88\x98\xa1N\x9axcR\x9e\xf5\xa5\xc7\xe8\xe9\xe4\x8a^J\x8eG\x99\x1eR)\xc4h\x1d\xa3\xc8\x94(\xe7-\x02\xe9\x02d\x98\xc2X(=m\xef\x97s\x89\x86\xd4\xbf\x95/\x9d\x12\xc8\xf2%\xfc\xc2wn\x14[\x9f\x12I\xa5\xdfK0\x7f2M\xb0\xe8(\x96~*\xeb\x95a\xb8t\x85\xd3\x12\xc4g/:\x1ax\xd0\xbb^\x890\xd6\xe3J\xddG$g\xa0\x91\x9aB\x9d\xf7\xb2)\xfb\x0e\xbfph\x18X\x00k\xf9\x06\xd9i\x1e\x98V\"\xc2\xc1\xd0e\xbd\xc5\x10K\xfe:\xe4O\x16H\xe1\x08;}\"\x1a\xee\x83\xee\xce\x0d\xc7\xb7\x93x\xf6\x82q/\xd2@z\x0e\x85\xc4*N{0ND\xe0\xb8\x94R\xe3+\xc8@\x9a\xf6M\xea\x1ab,\xb6H/\xa5\xdcn~2U\xf6\x1b\x14\x89\xb1\x92\xc0\x03m\x0d<\x98\xd3\xccv/\xb4\xaf\x82a\xa2\xc1\x92\xd3\x85\xc9~!\x7f@\xf7U\x93\xeaq`gO\xde\xeeC\xdb9\xebK\xc93\x9d\xaf\x0b\xc8\xf8\xda\xd6\x10\\R\xb6\xd8%\x09\x12\xc5\xcdi:\xe5\xd9\x90\xca\xb3*\x88\xab\x03\x8d\x19\xa1<\xed\"~\xf3\x0d\xf6\x9b#9\xf7\xb76\xf3\x04r\x89\x86\xfcz\xa9\x06\x097\x15\x10\xc7G\xe7r\x86V\x85\"\x97%wy\xb1<\x08eWcp\xd7\xed\x1fx\xb4:\x85\x16I$R\x84\xf5S\x98\xa7$\xa5\xf29slY\xea\xadV]I\xadibH\xe9u\xb7ip\xde\x9a\xd3\x10\x95l\x18\x00\xefo?N\xedW\"\xe6\xaaA\xceG\xcb{xz\xf2Ai5\xf4\xb2\xb6\x10\xbb\"W\xff\xa9\xc8M\xcfS\xf7\xaf\xe4\xaa\xdf\xf7\xb1\xf1V:\xe6(fV\xf6Q\xf3\xea\x9f$\x9aP\x13\x84\xc8~\x93e\xa6\xe0\xce|\xee\x82\xbbMw\x13\xc2\xb6]\xf54\x8c\x08C\x9d~\x9cR}\xf8\x1c\xc7\xb2?F\x08\xa3$\x8f>\xa1\xd0\xbb9\x8aC\x9b\xf6\xb3\xe3\xee\x0a\xff\x00.\x90G\x94\x0er\xd9}-\x18\xccd2\xc4\xc2\xde\x1c\xc3~\x96\"\x9a\x86\xbc\xee\xd4^$\xadFm\xc0c\xdc\xdd|\xf2X\xd7M\xce\x8b\xe3\x07Z\xbd\xba\x89\x94?D\xc5_Yx\x9e\x8a\xf5~\xdeS_-S;\xc3\xd8\x86\xb9+\xedyE\xb9\x9f@\x98\x09\x05$\x0d\x07JfwL\x82t\x17\x18\xaf4G\xb8\x95\xe9U\x08\xeb\x17\xb6\x1f~([\x9f\xe0\x02\xd1\xa2\xdft\xa6\x1b\xf6\xf7l\xd5\x9c\xf3_\xaa\xf4\xc5\x99\x83\xe4\xd9\xc3\x16|-{7\x172\xa3\xd4\x0erBhI\xf4UD: \xff\xc8\xfb\xf2\x91)n\x88\x9e\xb4J\x1aT$\xb2\xf3\xb23\x8b(\x0ac\x0a\xd8K\x85\xd2#ML.\xad\x07\xf6\x0c\x85\xdf\x8f\xb2Z\xae\x8a\xa6\xea\x96\xfb\x94\xc0D\xe7\xe5\x15\xc2\xe9\xd7\x0fK\xbf\x7f\xf0\x98\x14\xf7 U\x07Q_\x1f@\x84dM\x09\x107\xd9s\xa8]r\x8d\x89\xf1\xa3>\x027?\x95\xb1St=\x18\xf9\x17<l\xe1VM\xaa\xda\xa7V\x81\xe7\x03\x18\xba\x8b\xa6\x02\xde\xce\xa9\xddAy\xf7\xac\xab<\x9e\xa9\xee\x08d\x8d\xc6\x16\xe8\xd3\xe3\x1aH\x1an\xb5\xc9+T\xbd{%\x81\x09\x1a?\x14\xfc3\xb0\xa3~\xc9\xd8\xe0 \x9f\xa1\x8at \x98n\x09v\x9f\x880\xdb\xc8F\xb5\xabu\xffJ\xe8lt\x7f\xf9\x0e\xa0\xd1jrYm\xe0%\xd3\xfbM\x07F2\xda\xc7<\xd2\x95\xfbH!\xf5\xc5ae\xd8\xf2~>\xb6\xc6\x03-e\x12!\xa6j\xfd\xbf\x165s\xe9\xee5\x13H\xde>@\xc7&YP\x06\xab\x8f\x12]%\x17\x1e\xcd}\x01\xd2 \xfd\x04\x8d(}K\xf2\xd6)\x1dg;r-T+\"\x05\xb8\xeeBI\xd9\xd2M\xca\xca\xbcd\xc9}\xeb\x0a#4w\x8f\x82\x11\xcc}7 \xee`\xcb\x1c\xed\xd1\xf1z\x1f\x1f\x98u\x0b\x12\xc5\xa0S\xf1m\xec5F\x1f]\xb1\xd0(\xae\xc4\x97\x83\x1e\x87\xd8]\xafC\xec`\xdb\xd3\xd9\x89[\xf8\x9ap\xa9E\x86\x01i}\x84\xb7\x15y\xe6\xa9L\x93l\x17\x82\xe2=\xb7\x9a\xd9v\x98\x0a;\x8b\x15\xc8\x9ahjWa\xf1\xaa.\x14H\xafk\x1egv\xe9\\U\x97\xe4\xd7\xec4\\\x18\x1eh\xae{\xe5[\xe8\xd7\x8f\x0by\xe8[\x06\x0ag\x04Y\x846aKO\x0dg\x0e\xe2nh\x9f\xd2\x9d\xa4:z\x85\x08\xfc\x02\x1b\xc2\xb5\xa6\x02h\xe9\xa53G%\xda\xf5\x98\xe7\x86\x15\xf7\xed\x91\x15I\xf9\x86\x92\xae\xc4\x94\xeb!\x15\x9ff\xc9\xe36 \x86@\x8d\xe7\xdb\xa4\xbet\xb0\x9b\x1b\xb7\x0dQ\xad\xcf\x07g\x95V\xba?\xa2\xf5\xcf\x04^=H\xb4\x1aQ\xba\xf3r\xfd4!\xcd7\xc6\x12\x84A&\\J\xcd\x83\xaa\x99v\xac\x84\xf4\xc4\xe2\x0d\x93\xae\x12\xd7\xee\x148\x93Z8\x9b\xbc\xd6\x10se\x9c9}t\x01h\x81:\x81\xa7,\x03?i.\xd4>\xde\xa87\xee\x80\xcb\xd3\x12-\xc9\xfePF\x0b\xc3\x1a\x0eq\x80cnz\x8d\xe5\xc4n\x07\xb3]\xbd\x80M7L\x0aWi\x83\x13Rw\xa1\x1d\x13\x9d|\"rU\x0ez\x0b\xae\x13\xfa\xdfS\xf3yb3\xadS\xcfJ\xa8(\xe7\"\x03D\xd0\xcb\xdejx\xc0\x92\xd0\xa7\xe1\xe8@k\x94<P\x99\xb5\xd5\x8b\xfesd\xb0\x1d\xa0\xd4\xe3\xf3\xc4\x87\x8aY5\xe9\x17\x04IA4\x8bI\xbc\xa6\x08\xfb\x1eh\x96\x16\x92\xc7\xf0Ol\x10\xd2~\xcc\xb0]Uu!\xa5\xc0X\xffZ\x13&wWS\x10|\x8c\x1dI\xe5\x06D\xa92\x0a}T\x1cy8\x14;\x11\xf9\xcd\xefR\xb9\x86\xc0\xe8W2M\x00\xa1\x87\xf1dt\xf8\xc6\x99\xfc\xff\x9b\xb6\xaf\xf6\x0d\xda\xc5\x19\x99\xb6\xb2\xdcP\x9b\xf0\x99\xc2\xae#\xfb\xcc\x0dG\x16U\x0fOb\x92}\xa9\xc0\x0bX\xdf\xe9\xfc\xc4\x84\xf1f\x18\xe6\x9c?'3\xf8\xe1\x96\xe7\x8c\xb4\x8e\xa3\x1c\x04\x91,[\xc2q\x86N6\xeew@v\xab\x06\x8f\x12\x81sR}\x11'E]\x95/Pf\xc5\xf1\x06\xd9|$\xeeH\x98Y\xe1\x1fa\xc5{\x16\x01wr#G\x93\xb7\xfe\xda\xfa\x00\x8d\x0b\xe0-\xa9\xb1\xc2\xea\xbfp\xd6\x12\x0f\x88\xb7\x92\xf2S\xb0\xdd\x0e\xbc\xd7 \xf2\xb2k\x95\xe7\xd8\x12N\xc9\x19Q\xae\x97(l\xa8\xd2\xf4O\xe0\x0a \x01K\xd1\xa6\x0a\xb8\x99g\xb0\x01\xdb\xcc\xa1.|%0E\x99\xbe3\xc2\xf7\xbc\xe0I8\xe9\xc1#BS5=\x19L\xb6b#!q55z\x8d\x85\xc1f+\xda\xab\x05\xae\xee\xb6v\x12I}E\x1c\xb2\x1a\xd1\xcd\xc5u\x8f~8\x12*\x17\xfa92\xa8o\xa1;\xb1\x0c\xa1F\xa1\xb9bE \xff Y\x058UL\xbc\x18d\xdf\xea{x\x16\xa6J\xac\x88/\xd0 \x8c\x8f\x13\x84q\x86\x9b\xa8\x9f4\xe1!\x05i\x8f \xf6\xf1\x16\xceU3\x85\xb4L\xa1\xf5'\xdd\xc5\x13\xed\xa7)\xa1\x90\xce\xb3\xcdw\xb0\xdf\xd1\xf2M'\"\x06\x80\xf4<\x8a4lT\x84\xfe\xa9\xf2D\x87{\x1d\xe5\xe9\xa4a\xc1j\x01eGI\xe4\x9c{\x7f\xdd\xad\xa4h\x91kpC,\xe2\xe6(a\x92\xd9(\x99{h0\xc3\x89oY\xda\x8c\xa3\xa8\xabS\xd2$\xa9\x97!D\xec:\x16%\xa2\xb3\x9d\x9chIU\xe9\x86RF\xb7\xec.up\xcbu\x0b\xc9<Go\xd3\xa1\xd7\x1a\xd9\xe6\x90+\x150\xa1d\xe6\x1f\xdd\xd8\x11\x1a.a\xd9\x17[9\x01J\xe9K\x9c\x92B\xde*\xdbCq+\xbfC\xf4u\xf1\xf4\x0f\x0b\xf5\x8f\x99q\xbb\x83\xf7\x9e\xd5\x0f\xcd\x99\x19\xc6\xfc\xb8\x1d>v\xdcz\xf5c\xf5\x96\x0a\xe9=\x0fG1][/8\xccl\xd7-|G\x9d\xa1Rf?Q\xba\x96J\xdc\xd2B\x02\xe0\xa5$\x92\xe5\x1f\x98\x05OV\xa6\x9a\x19(5a\xb5\xfc\xa4U\x10K5\x9d-\x93deRX}\xa4[?|\xf1\xc2B\x1a\xc1\x8f\x0c\xd3)\xe3\x1b\x9aqJ\x1eg\xc2[@\xde\xa1V\xec\xca\xc1\xb8\x81Ix\x97\x10\xd6\xdb\x10\"e\xe4\x13pR\xa4\xeb\xb8#\x11Gx\xef\xf7\x19\xa9\xb4\xc4~\x9f\x12u`z\x18\xb2\x11\xe4\xf4\xc6F\xf9\x0c\x91\xc8(\x9e!\x91a\xa4 \x9f3\xaf1|\x91v~\x0a\xc4\xcb\x0d\xa7\x8f3\xd2x\x19K\x90\xef^Q\x8e'\xd2\x12\xca\xb8\x9dF!\xfar\x13\"=\x13\x13%b\x9b\xc8j\x84G\x98&E\x95\xa7\x94j+Q\xc4-\xde\xb7\x80SH\xe7\xbaK]\x89\x88+n)\xb9^\x95\xf4W\xb7\x8b+$\x03\xe4t\x9d\xb7L\x97\xc6\x9b~k\xe0\x01\x91\xd0\\\x08.\x8a\xe8\xec\x8d\xa0\xf5W\xf5\xdbh\xdc>\xc8\xf1\x9d\x10yA\xdc\xa9\x90\x1c$\x07\x01\xefK\xe0\xdb\xe8c\xbf\x84\xdf\xa3tr\xae\x84\xc08\xb6\xdcQ\xf7\x13\x98\xbbm!\xbeZF\x96]\xb5\x14\xfd\xe4\xac\xa0\x84\x84\xfe\xafe\x00\x94\x14\xcdI\"J\x0b\xc5\x8d(\xa8t\xdc\\\x10\x15\xbe.\x8f\xf0\xd1\xa3\xea_\xb9\xf7\x7f\x87\xa7\x0fh\x1f\x14H\xca[D\x08\xad\x83\xf5{\x8e\xe3\x7f\xf8b\xe7Y@ED\x1b\xc5\xed_\x05\x90\x975\xe3h\xe3\x83\xe9Z(5,`9\x82\xb3\xfcV\x16\xd9\xb0\xf1\"\xceb\xd9\x81\xcc\xe9~0\xf5\x1c\x17\xec;\xb3+p\xd3\xc3\x8c\xee\x1a\xe5-jNU\x01%v\x0b=[P\xdd\xaf\x1b\xe1\x0e\xd6\xe5\x7f\xed!\xf7\xf0\xc5^\xab\xf9\x83\xc6\x88@\xdb\xc2\xaa\xb1c-\x93:\xf0\x18k\x94(\x94c\xf3%\x8aV|W\xa9;\xe6p\xdb\xcf\x12\xc5\x0c_\xec[w-\xe71I\xf50\xa3\x98\x18\x0c\xf4{\x08 \xaed\x1c\x98\x86\x86\xc4\x1d\x18\xdd\xb8\x18\x1eB\x0b\xcf\xbc\x07n\x0e\x86+\xcc\xc7;\xd9.7\x06\x01\x09\xf61\xc2\xa38\x04t\xf9\xc6\xfa\x1c\xbf\xde\xd8\xc9\xc4\xa8\xf6jA\x7fz\xcb\x0c~\x0a\x92\x97\x92\xbf}\x93eE\xbe\x07_\xee\xf6\x99X\x91\x87/\x0e\x9c\x8ei\xc23\xb0\xbc\x8a\xf5\x0a\x89`GNw\xa8\xc4\x94\xc1\xad\xaf\xdc\xc2\x0ar\xef\xa2B\x90>\x11O\x89\xa1O\x84i\xe7\x19\xfa\x06z\xceS\x94\x1f\xc2'\x15\xff\x84t\x12\xeaC\xa3\xa8\x07\x8aw\x1b%\xb5\x02I\xaf\xc3Yk\xe4[\x93\xc9\x10WJ\xb1\x9fxXw%U\x86/T}\x13\x9b\xd3x\xe1\xdcAp\x18R{\x02\xd9\xa05\xe6`\x88\x97\xde\xe0\xe64\xa5\xad,\xbd\"W\x8f\x06L\xa1\xd17~\x9a{\xa6\xd9\xbe\x8e\xa1r3j\xce\x07\x14\x8b\x10\x04H\x06(\x85\xc0u\xf7\x92\x9dz\"\xa2qCJ\xd9\xe1\xf0\x85\xc7\xac\xfd\x16.Q\xa6\xe3RfXm\x06H\x93\x89)\xbf\x95\xe0\x04}\xd9\x0fG\xaed\xf8`\xf6\x8b\xb8\x02\xd7\x13\xd1\xc6?\xf4<\x87\xc4p\xe8\xe9]\x19\x0f\xfajm\xb5(F\xf8\xa6'\x0e\xba\x8d.\xde\xba\xcf\x85U\xee\x9c\xda\x18\xbad1T\xf848\x7f9\x19\xa7\xfe\x1am\xe4f.\x95\xbc\x9d\xe8g\x9c\x83\x98\xa7\xfc\x08z\x89^\xca\xf8\x92J\x87h\xealk\x0b\xfb\xae+b\xec\x13\x89\x8e0\x01\xf55=,\x7fDI\xa3o\x99NT\x1a\x19\x94\x17\x06\xc96\x80\x96\xcc\xd9\x0d\xce\x19\x12\x8dl\\\x04\xf9\xfcJ\x83\xd9\xe0\xfc\x0e\x99\xb1\xe3\xfcF\xa8\xafD\x09L\xe0\xbb\xaaT;\xfb\xd9z\xf1\x86\xc3-\x99\xd1\x8e\xe3\x0b\x09\xcf\x85\xcdF=`\xb6\xa1\xbe\xb5\x1f\xdd`\x1b\x98\xf9\xa8\xbe\x13\x17\xbc\xd1\x1fEG?\xf49\xd6\x0f\xfe\xaf\xae\xbd{}\xcbB\xa7\xfdzq\xa5\xa8\"\x83\x95zt\xe3\xaa\x97\x1b5U\xfd#\xfd\x18\xa1;\x94\x80\xf7\x99\xc7\xa4za\xd5\xba\xd9[\xa6\x88\xa2\xe8\xb4g\x1b\xe0\x0d\xbaW\xb2\xea\x89\xa1\x92\xa7~\x8a\xf7W\xf8\x89\xac>\\\xa2\xe6\xa9\xba\xc0\xb1\xb80SI[\xb2\x7fZ\x87\x1d\xde.\xa7\x1f\xa1i^u\xc6\xd7\xf6\xd7KSG\xa1d\xd52\xc4.\xc9\xacl\x8d\x87\x01\xaa`\x80(\x93\x98m\xfb\x87\x08\x08&\xe5\xc4JW\xa1\x00\xf5\x84\x0cZ\x84\x05\x02.X46\x0b\x82\xc68$q\x11\x0f\xd26\xb2\xfc\xc0\x99A\xd3\x18gM\xb1F\xd0\x0d\xe8\x02\x86\x8a\xb6\xc2\x1f\xa9\\I\xcb\x08\x14\xec\xd2\x86\x8f\xe0P\xed\x91\x08\x19\xeevM\x88O\xfc\xfa\x1e_#\x11\xdcJ\xbaW\xdf\xa0\x8a\xe6\xad\xae\xaa&r\xe2\x8aAA\xac\xc5\x81\x8dJ/$\x1bj[>\x0d\xebQ\x8e<W\xdfL\xd1p\xaf\xdb\xeb\x90\xa1[\x89M\xbe\x1b\x8b\\V\xac\x8e:c\xae\xfbY\x18.\x00\xc0'\x8d\xa4\xc7\x86\x02)\xaf{\xfdL\xfd\xa4\xc3\xe1\xbe\xc1\xb5\x05`\xc6\x80\x0e\xb4E{-\xf4\xaa\xcd\x88>\xaa\xc6\xd2\xbb\xbe\xa2\xfc\xac+P\x0d\xad\xf8\xcc\x0d!1\xb7\xec\xe6\x0d'\x04\xbd\xb7\x087\x8a\xc4\x0a\x1f\x99\xa4\x0c\xb3&\xccw\xd9\x1c\xf9\xfd\x1a\x1a7B\xe3\xd7\x9e6\xe5fM\xfal\xfd\xd4\x7f\x84\xb3x\x93\xc4\xf10!{\x12\x13\x15\xa5\x0f\xe3\xfa\xa8_\xc1" +
+	"\xb8x\xc3\xfd\x1a\xb7\x18'\xe5\xe5\xe6\x968\x90\xf7\xa8\xa5\xd8\xae1\xa56\x86\xeeL\x8d\x15\xc4\x92\xd5gN\x9b\xa2\xfb\xb2\xf36 ~\x90\xc0\x98r\x17\xfd%kN\x10\x09:\x13&\x17Y\x1f5\xa1\xdd\xd7\xbev\xff\xb9&\xa2\x14\xdd\x12\xdfb\x81\x9e5\xe1;<|\x06\xad\xef+i\xa8}\xd0n\x1b\x92\x0e\xbb\xbe\xd7\x1b\xaf\x07`d\xb8\xf5\xa2\xddy\xebY\x91S\xe1\x8a\xac\x10\x9e\xf4\xae\x10\xda\x1c\x8fi;d\x9f$-\xc6\xa7\x8e\xadE\xdb|\x940\xe8E\xa29M\x8b\xfd\x81Qu1\xd1\x09\x9f\xf6k\xfa\x1cs\xbe\x98\xc0\xd3\xf4i\xd8-\x11)u\xe6\x9c E\xbd0\x7f\x9f@/\xf9\x04>\x97\x8a\xbf\xdd\x0d\x8cb\x00>\x04\xa4\xbf\xe21?\x0d\\\xdbX\x9f\x83\xf6\xd7\xf5\x13\xffH\x96\xc4\xa5.\x11\x97\x95\xe4\x18\xaa\x0fY{o\xe78\x9a\xb3\xb0\"^\xda\xd4\xea\xfa6\xc1q]h\xe7\x85\x82KQ\xfa8\xd3\xa5\xc7\xb3A\x1f\xb8U\x09\x8dp\x0f\x08~\x8a5\xf9\xa2\x15B\xdd\xb2a\x86\x0e\xf4\xd9\xc5\x80+\x19\x87P&|G\xc3\xbc2\x02Y\xb8\xf1\x16\xd9O1\x87\xf1\x81\xcef>\xe0a7\xf6\x1d[i)\xaf#o\xa7 Lt\xdb\xbc`\x84\x0a\x05|O!{\x9b\xd4m\xb6\xb6z\xb8\xd0\xd9\x12\x16<\xbf\xed\xc6M\x14\xbdG\x1c\xff\x89\xfd\x06\x1e\x06\xb3\xaf\xb1\xb7\x0b\xd7\x9b\xc3\xac\xf9\xee\x87m*=c\x81k\xac\x91\xc4\xfcPA-\xcd\xb6\x03NR\x9acx\xd0L\x13\xfeSL\x84*@f\x0a+V$4\xc9\xa1X0\xd3\x82&;\xebOj;\xdc\xda~\xfa\xe1\x96Sww\xb9\x1b\x94\xa0Yz\x82\x18\xf3T\xe9ne\x85\xae7rg\x1f\xa1\x95\xf7\xc7?\x87\xea\xfbpk'\xd3\x87\xc8\xf8\xc8\x8evD(@\xf4\x12\x03\xe39\xa2\xd7\x7fKEZ \xe9\x8bQ\xe6\x1b\x89*c.\xdd\x97R\x12x\"\xcba\xed3=j\x8a\xf4N\xb4\x80\xed\x0d\x12\x95\x0b7_\xd91\xb0*$\xefz\xde\x96\xdc=cy\x8a\xfa}s\xd6\xfa.\x0c\x85~i4\x15\xf8\x93*e\xec\xca5\x04o\xd2\xd0:\xdc\xdaSe\xa9\xc4\xeb\xe1r\xb7\xe7\xab\xcc\xa0\xb8\xbb\xc4YS\xc3\"\xa2\x7f\x14Z8\xd7\x18\xa7\x1f\x12\x05\x94v\xec\xd3\xd8\xa9\x8f\x8bC\x0c?W\xab\xe0\x0d4\xb5_\xf44Q\xfbO\xdeZa\x90\xea\x1f\xe1t\xf0Y\x19\xc5$+&FT\x1a\xc3\xe1\x0f\xa9ce\xbb\xe6\xba\x9d]\x0c\xcfz+\x90*9\xf9\xbb\xfc\x874\xa3$\x17\xae_[\xc9h\xe3H\xcc\xf6\x06-\xb2\xc3-O\xa9q.t\x125\xfb\x00\xba\xe7g\xf6\xf5\x1d\xa5i\xcb\xb3h\xcb-\xb2\xfdm\xdaT\xc1\xd8\x8alX\xac\xca\xbd\xb3\xbf<\xb6\xcc\x07\x99\"\xd6c\x93\x00[\x87\x1e9\x85K\xa4F4\x11\xf8\xc8w\xa0\xe5:\xf3\xbbd\xa5-9I\x94\xb1(h\xcdv\xb4\xa1\x8e\xde\x9c\xb3_\xf4#\xc4\x9e\xabJ\xcc*\xdaY\xaf8\xc3i\xe9\xbf\xf9\xeb\xaf\xfe\xf0\xe5'\xf0\xef\xf5?\xb9B\xfe\x8b\xc1\x17\x9f\xfc\xf6\xd3\xe0\x1b\x9c6\xd3\xfa\xee\x17\x991S\xc5\x9c\xd5\x00\\\x01\xe0-`\x1az\x11\x83T\x14\xf4W\xa2\x98C\x85\xcf\xea\x17\x83_~\xf6\xd5o?\xfb\xfc\xf3O~\xf3\xb7\xbfX\x7fk=\xb6\x8cW\x12Y\x06\x19\xcb\xcf\xe1\xb4\xaa\xbc\x10?\x9f\x88\xdfR\x167S\xe6\x08\xcd\x1aqM_\x87\xb1~i)\x01\x9bSx\x8b\x99\x85\x9bP\xe3\xc3uX-\xbe\xf7\xa9.\x85.c%\x93\x84W\xa1\xfe\x9f\xab\xf0\xf4(\xba\xa4\xf8f\xd8\xf0@[\x13\xc5\xd2\xe7!?'\x11\xf5\xb8\xb84\x87q\xb8\xab\x16\xc9\xd6\x87\x9c\x0c\xe4\xc0\x1b!5\xf3\x98v\xbc\x09_G\xf6p\x92\xd7\xceo\xcfm/\xff\x8c\x8f\x97\x17YL\xe9\xab\xd1\xdam\x9c\x98\x96\x15\xea\x0a2\xab?4\x15;ex\xae\xaas\x00]\x1f\x00\x88$-\xbfj\xbbB\x1b\x01\x8c\xb9\xd0\xe8\x90\xfa\xa3\x03\x16%\x0c\x16\xea\x9e\x9aX\x1f\xd677\xf2\x9fI\xf6\x16\x8b\x11\xc1d:\x92\xff\xe9\x18\x92\x85!\x03\x0f\x15\xa9\x87H)/(\xef\x08P#\x10\xdao68\xf3w\xd4,'g\xc6\x0a`O\x0f\xe2Y\xd6\x9b\x92\xa0\xaf/\x99\x89o\xee\xf5\x84\xa8B\xbfI%\x93\xf8\xf2\xadd\xf7\x9e\xc3\x9a\x99\xc75c\xd0\xdf\x0f\xc8\xa5\xa2)\xacE\x14\xdec\x8b\xc2\x03\x17\xd4\xe2|\xaa\xd5\xb60?\x82\xabS\x91\xff\x04\xfb\xb8\xce\xfd\xe4\x8a\xb0ig\xbf\xaan\xde\xa3s\xc3d\xfe\x15\xa7\xfd&\x08W\xa7\x87\xccR\x00\xf4\xe8\xfe\x0b\x8eB\x0f]B:\x87\x15\xec@l\xe3kN\xd2\x0f\x04^u\x82\xdc\x02\xefE\x17\xf3K\x8d\xca(`!\x15H\xc0.\x00\x92G$\xbc\xbeR\xea@\xe1J\xe0fr\xb7a\x12`B\x01\xb5\xbe\x95\xc6\xc5\xa7\x81\x0e\xf6\xef\x8e\xb2\xdf0l\x89y\x92\xbbP%,\xe5&\xe7\xe5@\xab\xbb\xc2x \xd2\x8eV\x04\xc6\x0ep\x86\x19\xdb\xb9q\xd4\xa2 \xdc\xdf\x86\xdd\xd4^Q\x90\x12#\xa1\xe3x)\xa8\xe3\xd4\x11>\x83\x1c\xb4\x06L\x10&M\x91\xc5\xb6\x8d\x8b\xcc\xc3lVe\x1c+\xa5\x9csL\xf9$\xc3a\x0a\xady\x02\xcb\\\x854O@\x11\xed\x99J\x82\x1d\x91\xfb-T\x97\x936\xfc\xcd\x94D\xaf\xea\x0f\x90w\xbe\xd4\x15\x1e\xd3\xb1\xe0<\xacd\xff@\xce$Z?\xf0\x88Q1\xaf\x19\xa1\xf5j'\x97\x11.WB[\xe35\x1e\x97\xda\x11Z\xe9:\xd8\x13\xfb\x8c\xfbM\xd9\x13\xed\xb5FE\xeaj\xe5\x02\x12n\xb2\xedMI\x1f\xd1\xb7\xfb\xd0\xe9\xd8\xca\xa1\xe9C\xcb\xd2\xb8\xf3\x00?t\x83W\x98\x19\xf4\x16\x8c\x85!\x89U\x8dmw\xc1\xbbCP\xd3\xd4%\xf8\xc3\x13\x15\x89\xc3n\x08z\xb2l\xca\xecu\x0b\"\x03?\xe3\x8e%p\x9a\xfc'\x83!Q\x81\xc7$\xccKi\x89[\xd8\xf3Uh4\xd2\xb9\xed\xc2P\xb9\xd2\xa4\xd4yp\xad\x8d\xb2\x01\x96\x98\xc0\x83\xce\xb7\x18\xd6Hp\xd0\x03Tc\x13@\xa6\xe5\x8b\x8a\xe6nm\xc4\xb8}\xd2K.CA\xa5\xb02-\xd1\x1a)\xdd\xb8\xa7Y5X\x89kf\xb0\x8f\x02\xb9\xcb\xeb]\x95\x1f\xfa\xa43\xdarEw\xbc\xb3\x11\xb4y\xa7\x0a\x13@?\xb4\x1e`Z=\x82\xdb{\x1c!\xed\xcd\x94\xabX\x94*\x93$kZ\xe5\xfc\x88\xaa\x0a\xacC\xae\x10T\xc1x\xddC\xdaf*s\"\x00{z\x0f\xcf\xc4\xceH\xfc\xcf\xf8KY\x9f\xe71\xbb\x8d(\x0e'\x0e\xc5\xabQ\xe6\xef\x8e/}l\x10\x9f\x81dY\xa1\x90\x18\x96w\x07Vh\xa4\x99x\x9f\\A\xdaN6\x0d%\xa5\xb8j\x1d\x1dI\xc1w\x82P[]\xb6\xcc\xa8H\xc9\xd1\x0f\x05\\\xe9\xc0i\xda\xaf\xa7j\xde8E0\x01q\x9c\xd92X}\x95N\x02\xb2p\xc7X\xa2\x85 x3%:F\x1f\xcaH\x95}j\x18^\x1a5\xd6\x8c\xc2\x12Y7c\xbf\x0c95\x9ec\x1a\xf6\xd1\x8fa\xce\xe2B\x0e\x16h\x84x\x90\x0d\x16\xccnf\xcf\xaf\x8f\x8d0}c\x1ds+K'\xb2S\xb8\x9c\xd2y<O\xf2\x1dO2{a\x04\xe7\x14\xc4)\x90\xf8\xf9\xa0\xfe(\xed5\xa7M\xc8\xa2@/\xd0\x05\xec\xc5\x13\xa9\x07\xc9\x84\x09\x02O\x02kM\xa5\x98Ju\x15\xd5kE\xe9\xbb#\xa6`\x9b\x0a\xe0\xc4\xcctX!\xba\xac\x8a\xc5x\x93y\xa0B\xfai}C\x00(\x98\x07%\x86\x89O\x08m\xf2#\x06|B*\xf9\x1d\x8c\xcbRf#\xc2\xafd@Z\x98\x16\xa6\x9e\xed\xd6\x14\xc3h\xe2\xdfS\x0c\x8e\x05\x07\xe0X\x9bbh\xdc\x82\xd3\x1d}\x15'i-\xd5\xf6\xe2t\x06+-k\x0a\xb2\x03\x87\xed^3\x86\xf1}\x05ook\x9f\x0f\xe1\x1f%\x06\xb1\xa4\xe4`\xd4\xc7\xdc\xbcL\xd4V\xe8\xe3\xb3\x0f\x950\x83n\x96\xfa\x91\x83\xc5\x81\xa2\x16\x08\x9e\xcbHu\xa1\xd8\xe4\x17\xbb[`\xfa*\x99]%\x88\xf7\x1bXf\x00\xd4\xb1\xea\xa66\x8d# \x1c\x82\xf3\xd27\x95\x9do\xae\x89e\xe0@\x13I/q\xe2\xb4\xa2N\x0bf\x1d\x07\xb2n\xf06R=F\x82\xe3\x8c\xc2\xdd\xcc72:\xdbE\xfd\xa7\xa6l\xc6\xb4o\xeb{\x88\xffc\x13\xc5\x9f\xd8\xc8\xe5\xf9R\x94\xb4l\xaa\xde\xebJx\x19\xa9\x1d\x87;\xa8g\x84\x8e\xbf\xce\x1e,N$A\xdd\xd1K|Qi\xf1U\xbf/\xb7\x12tN\xf9a\x0f\x1c\x0b;\xe8e\xd2\x18\x06'\xaa\xc4\"\xdc\x10\xd6\xfe%\xe6N_S\x93\x12\x17\x08e\xb0 PDB>\xe9\x0c\xa1b\x05\xa1<q\xf5\xad`\x97\\H\x02Y\xe5\xf0\x8b\xbe^\x1bTI9\xe6\xb8\x18fM!\x9eQpo\xe5\x8b)/\xec^I\x17\xaa~9]\xc0u\xea_q\xab\xff \x12\x93\xfa=O\x14g\xbb\xc7x\x9d4\xb9'\x91\x1e6D\x86E\xf4\xa3\xec\xa5\x92\xe7\x13\xf1\xff\xe6\xe0+\x93\xa6T\xd7\xe8r\xc24\xeeL\xf3WG/\xf0H\xfb\xb9\xb2\x12dD\xeb_:\xea\xbf!\xd5\xff\x9e\xca\xf8\xf1\x14\xe3`(\x81p\x88\x12\x8d\x06\xa1\x1cC{*\xee\xcc4\xff#\xa8\x10R\xa0\x19\x82\xc9\x1f\xe0\xd4\x84\xeb\xbd48^\xf6\xaf)D\xc4T\xce2\xec{\xc3\xd2\x8aJ\xa8\xeb\xf9\xe8\x91R\x16\xe0\xb4LN9X\x09&:Y\xe9j\x87\xb0I\x0b\xd6\x84\xe0\xc4\xe2\"\xceG\xac\xc9e@\xdf\x86\x17A]5l/(\x90r\x1c\xb2\x92\x8d\xf8\x8cgY\xff3\xae\xb0\xa0\x1e\x9a\xe8\xf5AC\x03\xcb\x7f$\xd9\xbe$\xca)\xb8\x1b\xf6\x06\xea\xee\xf5U\x00\xbd\x8e0\xbbB?I\xc9\xa5\xa3\xffs\xcd\x8e\xa4\xc4\xdfMDe\xf5<\x8f3\xb7\x0dVe\xcc\x02\xad_\xce\x80\xfc!:oN\x9b\xd2Uc*A\xb1\xa9\xaa\xdf\xe1.\x14\x8dI\x1a\xafg5\xbd\xc2\xa3\xd4\xd7q\xdc\x01KS\x086d\x8d\xad\xea)\xdcu/\x1a\x0f{\x18\x86\xed\xbcp\x17=\x9eLj\x89\x15\xa6\xd06\x0b\x90^:\xb5\x1aG\xf6`]\x8463\x0e=\xbcbB\x97n\xa28\x0a\x95\xf9]@\x8f\xdf\xccc\xee\xfd\xc6\x05\x90`vb\x89\xfd)\x83\xfe\xe9n\x19\xd0\xca\xfc\xd6\x94\x83\x12\xaf\x86\x08\x19\xae\x9f\x98\xdadk'K\xf1\xdbL@\xf2W\x14jz\xbc.\x0bD\xc7f.\xc9\xc5(D\x0a\xcdz\x14NX\x8c\xf1\x12\x8fD\xed:\x96<\x96P\x8e4\xe8W\xddi\x97\x10\xfb\x8c\x9b2]&\xb6\x8d\x89\xb2\xab\x93\xa6\xcc\x9aO\x8a\x10\xdf\x84\xcf+\xb7A\xe6m\xdc\xfd@|P\xa8\xe2\x06\xc8\xd4R\xb7\"\xb7\xcd\xfcH\x1bW$\x9aJA\xcd\xe6\x00\xb0\"J[B\x1e\xb4\xf7\"\x19\xb1\xeb+\xf1\x07(\xe3\x97\x9c[TXz\x8a|\xa6\xa2\x1aF\xa8\xcd4I\x03\x17\xbe\xa1\xb6\xb5\x98\x85\xf4\x13\x84\xd4r\xab\xfb\x98\xe9\xb1\xee8\x14SY\xa8G\x97\x12\x11;\xdd\xb7\xfeo@\x9f\x0e\x1b\x0b\xa67OUj\xf3V\xf5\xc1\xc1\x0a'\xadP\x8e\x9c\x98\x9bBR\x80\xde3\xe1\xb5\xb8\xe6;\xe2\xd0\xf9\x80)\xd3\x92\xd0\x1cG\x9d\x89\x05\x86s\xcavWYU\xd28|.#\xf9\xcc4o\xa9\xa6}\x8b>q\xda\x18\x03z+a\x8b\x81ZQsbWn\x0c\xc8\xd5\xef\xeb7\xf4\x80\xa0\x90\x92(0\xb7\xf5<\x13\xbdC5\xc8\x94k$\x03b\xe7\"\xe8\xa2A\x0aK\xf4\xce(!\xd4w\xb4\xbd\xd7\xd6[*+\x13Eq\xa9\xb9\xec\xa2\xb3\x04\xf6=$Y a\xa2dl\xc4\xb0J\xcd89^l\xfb\xf2=R\xb6\x09\x95\xd4F\xc3\xbe\x0d;\xe6X\x92\x99\x09\x90k\xfd1\xe2\x83\x9a\x19[-\x9e\xddR\xcen\x05\x0d%2\x1d\xa4\x9f`\xc6b3\xa5PG\x7fY_m2\xedS\x89\xd4\x7f\x86)O\xf2,\x94\xe2F2\xc7uFCQ\xf1%\xc0\x1fq\xf8\x83\x9e/i\xbc\x8cs\xfb/\xe6\x05\x84h\x9atW\x05\x046\x9e\xacK\xd9-\x19\x9f\x07\xbe\x1d2,\x0a\x1e\xf5\x96\xce\x9a1\x07rn\x08.E~\x8a\x81s]\xdb\xfem\xbc\x10\xd9\xa9?\xd54\xf3*Q\x95\x1f}\x85|E\xc0\x10R\x93\xe8\xc5[\xdf\xc7\x7f\xd0I\xa3\xdeI`\x16\xe4\x9f\x8f\xb5\xe2c|\x01\xc5L`\xc9\x00\xc3\x11\xaf\xea6\x0ae\xd0\xe5Do\xbfP\xf1\x1e\xb6\xb6\xc1\x9a^2\xa5Xs\xca\xc1J)\xb3\xdd\xe7\x03\xe5v\xa3\xf8t3\xe2-\x18\x0e4$\xdb3\xb5\xb64\x01\xc8y\x0f\x13@IG\x1b\x9ax\xe3O\x0d\xa5\xed@\xd7\x0dS\xf9@\xb0V]\xb3\xbd=\xd4\x19o(\x1e\xe0a\xb8\x8a\xbc.\xe137\xd9\x91\xfa\x8f>\xc0\x86[6\xe0\xd2\xeb\xa9\xc4r\x9c\xbeR\xf0\xff\xecu\x16D*G\xdd\x8a\xf4\x98\xe8\xe5U!\xeaT\x0e\xf3\x85\xcbY\x8cF\x7f\xc9\xde\x80w\x0d\xf5\xcd\xe4:t\x87*y\x9cn\xd0\xa6\xe0\x84L\xf2b)\xa6\xa9P\xd9X\x9b\xba\xef\x0bI\xdaVJ\x91\x1b\x03=\x93\x15Zb\xb3Rd\xbb+\x12\xa2\x0b\xbb\xa0\x13\xd6\xbdPh\x87w\x89\xa6y\xa4\x0e\xbdoP;p\xce\xad\x13\xed\xaf\xc8\xe1rR\xc3\xc6\xe7\x80\x1d+S\xa9\xa6\x94t\x9c\x12\xe7\xa5\x0dFq\xfe\x8e\xf2i\x0b\xd9\xa4\x9a\xf48\xe6\xdf`'\xdd\x8f\xd4{B\x18_y&|oBe\xdc\xbaO\x03\xaff\xb2x\xcfpew\x8a\xff\x14\x8b\xbe=2\xf2\xdb\xbb\xcf4@\xc2\xfd\xb7\xb6ZY\xe1\x96\x91\x10\x97 \x0dQ\xfe\xa5\xe6\x13\xdc\xe4\x9e>\xe1b\x18L\xc9\xe1Q\x18\xab\xaa\x9a\x7f\xa2B\xe1\x85\xbc\x97b\xab[_\x0d\x1eg\xfd\x0c\xbc\x95\x8d\x9cFR\x92\xbf\xa1Bf\xe7T\xedy\xc5\x93YZ+\xbe\x84\xe4\xee+8\xb2L7\x96@hL\x13\xb4\xb6 \x11\x14\x9d\x0d\xb8\xf1H\xc5\xc8\xb5\xdeG\x9a\x02\xe7{\xef\xd2\xa9\xa4\xb9\x07q\xc6C\xf7\xa1W\xeeg{\xbf\xbd\xaa$\xcb\xfd)\x81\x9cZ\x10\xa6\xa8\xda]}<%\x9ea\x82\x0c\xbe\xc3E\xc9\x90Z\xc3\x92\x88yg\x08\xba\xb9(\xa3xcc\x86U5\x83\xc8\x06\x1f<\xb3VT~\xd1\xac\xa1i\xe9\xea1h\xbbm-\x8d\x8f\x09P\xef\x94\x15C\x87\xa912)# \x9d^J\xa0\xad\xec'\xc4\xee\x1d\x11(V\xdc\xb1?\xa7\xb6m\xd1\x8bD\x8d\xaf\x1f\x14\xd3^\x0f\xa6\"\xe5*\xb3\xaf\xffn\x032\x90m\x86\xb7.\xd0\\\xcaz:\xbf\xa64\x03\x13\x04*b\xfe\x9d\xbf\x16\xec:\xa8\xf0\xeb\xa5es\x7f\x02\x8f\x0aY\xa7\x08\xeb\x8d\xab\xa5\xf41g\xb6\xd8\xef\x1c\xc2\x13\x17\xde zR\x08Zd\xec\x98\xd8j\xb0\xb6\x8e\xa8^R>\x86\x95t\xe7\x85#\x9d\xe2\xf5o\xa7J)RL\xd8\xff\xd5X\x96\xae\xb3?W\xfe\x1a\xc4\xb3\xb2\xf0\xae[%\x11\xc5\xd3C\x0bv'\xa9M\xc6\x95\xa8\x96N\xb8\xa7\x15\xccFP\x0ed\xc4\x89\xbeK\x9e/\xe2\xf3\xd2\x0d\xb0\x07Pc[/\xbf\xef5\xcaB\x85d\"\xf3.\x89\xe0\xc9+\x8dW\xf8#W\x8f'F\x85\xadg\xde~g\xab\xe7TS\x9f\xfb\xfa;\x92cR\xeb\xc9\x15\xe4'\xd0[\x83^\x1a\xb4:\xd6\x0f\xb8&\xdfAg\xecE\x07\xf7\xa1\xc5^\xf4\xc3\xf5\xecl\xf7}1\xdaVe\xdf\x97Iy\x80\x01\xac\x14j\x17=_\xa6\x84 \xb0O\xff\xfc\xceN?\x86:\x1c&N}\x95N\x81];\x08\xfa\x08\xe5+\xab\x9fd\xc8s\xcd\xbd\xba\xdeb7\x9f\x08\x9be\x12ay00\xd5\xa6_\xe9\x14\xc5\x05a\xe8\x89\x01\xeee\x92.L\x1arf\x12i\xa3\xc3\xb3Lf\x8b\xf0\xbb\xaf\xc3\xde\xa8\x98\xa7\xc7\x95\xb6M\x8e%\x89\xa1\xf4\xfd\x1a\x1fa;\x01Gw)\x99\xad\xf2c\x9eH\x89\xf3y6E\xc1t\x0a_\x8e5\x91\x80}\x1f\xd4\x9b\xa9\xb4\x12\xbb,\xebP;\xe8\x08\x12\xb2\xf2\xd2\xd4{\xdeQ\xba\xf4\xa8\xf3L8/3ug2\xbc}\x9c\x0b\xbb\xb3o\xf0\xd9\x8aTR\xe2\x9e\xce-\xa7\xd7\x19\xbd\x16\xfa\xb8B]9Q\xbc\xc0\xb5\xd3\x06]jk\x82t\xe3\xf1\x1f{7\xb5\xed\x1ct\xe3\x98&0\x8b\xdf8\x8e;\xb7\x9f\x96\xcf\xe1\xc5\xed\x1c\xba\x00UQM\xc4\xa9`|\xb5\x00\xec\"\x04H\x9a1fM\x9ac\xea\xdc\xc1\xee\xb6\xa0_\xdb\xe7\x9e{H\x94V\xb2\x9c\xf3l\x9b\x890\xd0R\xbe\xf6\xb5.\xb5\x1a\xc3u\xea\xfe\xb09\x82\x03\xa8{\xcaw_$\x19\x06\x92\x90\xb8d\xf0\xb1\xc8a3LR!\xe5%\x1e\xb9D\x82\xca\x82\xca\xa4\x1d\x15^\xb0:0^j\x10b\xa7O\xac\xbejj" +
+	"\xdc\xc7\xc0Zw\x872b2\xa0\x09M\xb2\"\x95\xc9\xa9\x11\xce\x12\x86\xd7\xdfB=\x98\xe8_\xd0\xf2\xcb\x95/\xbe\xbf\xf4_\xad\xeb\xb1\xb7\x9c\xc6\x85\xe6\x88bP\xbf\xccc\xdb<\xa2p\x0a\xe7\xf14\x86\x9c\xb2[a\x19\xac\xd7\x0f'\x9b\xea%\x8c\xf2[I\xc6c{q\xf3X\x91\x0d:\x13w\xb7\xad\x11\xa2e\x1d\x9a\x90\xd81f\xdf\x1f\xf3\x1bgB\x91I\x01#g:l\x10\x80~\x05\x9e\x16\xd79\x95uH3\xf1\x13P\xa6]\x9fHo;\xcd\xd8\xee\x8e\xdb\x12\xa7\xe9)\xa4UQ\xb5\x181\x89\x84zm\x87\x0bS\xd71z\x01\xf1w:\x80\xba\x84:\xf2\x8ak8\xec\xd6\xdd\x02|\x00d\x0b\x14\x10kE\\\xd3%\x17\xed\xf5B\x82m\xf0g:\xc4vw\xad\xb2\x97\xcesY\x10l2\x9c\x9b5Sgn\xd2\x87\x89\xe1\xcc\xf5\xaeu~\xf0\xa9\xaa\xdf\xbb{\x09\xfc\xb3\x1f\xc9t\xca^\xbbd\xf9\xa9\xfa\xa1\xfb\xc6\xfb\xbdX\xf6\x148*ebZ\xaf\\\x9d\xcfF\x02\xf7\x1f[\xe1\xd1\xc7\xba\\\x0d\x12\x8a1\"&\x85&3\xed!\xe2\x04\xae\xbd\x95X\x8b\xebj/BQ4\xfa\x98.\xb6\xb9\xd6\xc0\xeeAz\xee.\xe09\xdfK\x92\xdaD\xe6\xa0r%T\x9b\x11|\xa8\xd2J#&\x12\x04\xb1\xab\x82@\xb1\xb4\x1e\x94\x14\x8f\xc9\x17 \xa7\x07\x80\xc0;'\xfd\xb0\xa771\xb0\xc8\xce\x8c\x8a\xb2\xcbq\xbe\x9e\xe8\xb5\xe1\x8d\xa4=\x16\x1df\x12\x8d\xe8,\xdcP\xee\x93U\x8c\xeb\x1bQ\x90{\xc9x\xeey<\xd6\x0d\x9e\xfb\x8d'KOy\xd7|\x87\x0f\xa5\x8c\xfb\x15\xa9\xf7^8\xf9r\x06\x0egy&\x93;\xb4=\x12\xf3\x17\xbdAR\xd5Y\xda\\y\x84\xc0+X\xefU\x17Mm\xf0/\x06b\x11\x9fg\xb8\xdfc\x83\xf7c\xcd\xdf}o\xf4\xfb\xde\xd0\x1b\xcc\xa5\xee\x14\x04\x1bp\x0f\xb9Z\x04k\x8boCKex9\xe9\x82rVVv3Jt\x99\x9bo\xd38(\xf8\xec<e\xbc\xd5H\x11\xdbX\x17\x1a\x9dN@\x14\x05C\x99 \xb6\x10\xb6@x\xab\xfa=\xdfST\x1fL\x85\xea!\x02\x1fX\xe8\xcfk\xaa\x81W\xe5:\x00\x02w\"\x8f\x12\x91)\x9e\xb7\xb9&n\xbb\x93\x0b\xab\x90-\x83\xef\x80\xf7Z\xf0\xdcj;\xa7\xbf\x1f\xd6\xcf\x02\xbe\xaf\x07\x95H!\xb9Vsn\x99\xb8\xf2\xc0\xc6\xbd\xadv\xd7\x02\x03\x1cbCrbH.\x0d\xbd\x86/\x8f\x1c\xa2\xc0\xa4/.!\xbb}+\xd9.\xf3O\xbc\xadd\xf4;\xc9=\xbc>\xf6\xbe\x90\x8b\xbd\x1dE\xabh\xa0>\x0bjE\x83\x0a\xacX\"=\xd9\\\xf4\x8f\x90\xf3\"\x1a&\xb9\xdf\x16T\xe2\x1b\xb3s\xcd\x00\x08\xc1\xfc\xc1\x93\xf2\xd3\x88>\xed\xed\xfa\x14\xdb8\x85 \x83\xc4\xbc\x96c[\x10\x9eJ\xfe\x86\x87p\xec]\xb0_.\x1d\xd9\x94\x0dL\xbe\x05\xed1\"\x10\xa7\xf7h\xc6\xdd\xef\xb1\xb7\xb1O\xddL{\xfa\xd4\xe9\x97=\xa3\x91\xaf\xa7\xfb\xc7!\x97%\xfb5\xaa\x1a\x18\xc5\x1f\xa9K\xdb\xb9\xa7\xaem\x08\x84\x8f*\xccS\xfd(\x826\x9c\"?u\x1a\xae\x02\xee\xa7s\x7f\xedo\xc4\x0e&\xdc\x942E\xc1\x0a6\xaf;8S\xa6\x9ae\xfa$OQ\x05\xcdF\\\xa10P\x02\x9cK\xb5\xa7\xfa\x92N\xdd\xf5\xf66\xf7\xa4\x0fM\x0c\xfeBz\x02\x9b&\x12\xaf\x127#\x9c\x9b\x99\xfeZm\x05\xd6'\x108\xf6\x17\xe8\x99\x8a\x86\xf8\xfe\x8b\xbd\xbd\xd7W\xb4\xffC\xb7r\xe2\xff\xdeA\x86\xe6\x03\xabib\x83\xdb\x1b\xd5}\x91\xe2\x00\xb2\x1e\xa1\xeedNY\xde\xda'\xc2q\xfb\xdb\x99\xc0\x9e\xc3a\xf5\xbb\xdd\xbb\xa8\xca\xb4J\x08vZ\x9d%\xac\xf6O\xe8\xadr\x0a\x14\xcd\xb4)\xe1\xfd\x8f\xacH\xe7\xdc\xc9\xc3A\xe7\xdc-\xd40\x8a\xe7\xa3\x18\xdc\xd7\x15\xee+tm3.\x086@]2m\xe0Ms\xce\xae\xa6\x80o\x9b>\x98\x8a\xbb\xa9\x95\x00\x80\x04x\x10\x06\xe88\xdc\xa6\x8c\x1e[\xe2\xecb\xec\x7f\x84m-\x98\xcf\x8b$\x9b\xf3\xc8\xa1\x0b\xfc`\x96)\xc1\xd5\x91l\x0f\xd6\x1c\xe2a\xa8\xf2B,\\\xb1P\x81@\xba\xda\xac\x839P\x9acg]\x8c5w\x89\xd4\xebW\x9d\xb8~\x04\xf0Q\xb5\xdc\xeb\x82\xd8\x11\xb0}\x1b9\x85\x0b\xe3/\x07\xb6\x03\xee\xfen\xa6-\x04h\xfb[\xdd<\xd1\xb2` pv!\xd9x\xc9#\x9a!\x14\x8f\x89\xfcsSj \xf2\xb7\xe4\xd4\xcd\xce#$9KP\xb0\x8c\xb3\xa8\xf9\x86\x8fm8\xa6H\x85\xee\xa8p\xdd\x95\xca\xd9\xdf\xb6=\x04\x0a,B\xb0\xe6)\xd0\x9f\x09\xdb\xc1\xa7\xd1D\x88\x14\x82$j\xd9\xa7Q\xda\xb6\xc4\xe5y\x8b0\xeb\xfb\x11\x16\xabi\xb2\xefU\x96\xf6Z\xe0\x9f\x08>\"\x02\x817$\x95\xd6:\xd8.\x83\x13@DCX:\xcd\xf1\xff\"\xbb\x1d\xb6\xdb\x1b\x9d\x03k\xbah\x05S6\x12f\xb3\x07\x03\xd6hc\x06,k\xdf\xd3\xb1W*~J\x1a\xde%\xcf\xfa\x10\x9f-\xfa\xc8\xbcM\xb4L\x84\\\xf0\x0f\xbdQ\x03\xfb\xbbO\x9a\x03\xdc\xf6\xebg\xb8\xa3.?&k\xb4\xd0\xa1oH\xc9\x06\x9a\xdf\xe7\\l\x9b\xc0:\xff\x1e\xa7\xb7m\x91R\x1d\x89\xf3\x03\xf9\xc8W\x8bZ\xc6\x07\xde\x80il\x7f/\xdf\xcb\x9e\xe3\x13\x90\xcd\x06\x13\xeb\x06\x0a\xca\x09\xe5\xea\xb6\xd1\x8dW\xf5\x8dL\xeb\xf6!\x1b\xdf\xdfO|\xea\xe8\xed\xd3de\x9dK\x07\x15\x9e{o\x99=\xd5\xcf)\xde\xc1\x88RYF#\x92.\xbe\xe9\x05\x92\xda\xcf\x06\x0b\x97\xa0\xb2\xf3\xbd\xe6=\x80\xf0\x08Lo%>\xd2\x1c;h\x89o5\x93\xa1fs\xa9\x17\xf4\xeeS\xd5c)O!\x0e\xd8\x04\xa6\xe2\x98D_9\xa5T\xf6\x8c\x96\xa1\x7f\x10\xf9\xd0\xd4\xd5\xb1\x8e\xd9\xbfd\xb1\xaf\xe9\x81\xc4\xf2H\xb7g\xc9\x84o\xe0T\x80g&\xc1\x13\x92\x0f\xdf\xe8\xb1\xe6sp\x07/zl,\xb9@0\xf1\xff\x03V\x98\xae8<\x16x?\xbd\xc0\x11\x87\xce\xb2\x84\xa6\x0e\x0c\xbf\xa1\xaf\xb53\xff\x0e\xdb\x89-\x04\xb7\xa1\xdc\xe1f\xe3\xe1c\xf0Z\xd5\xc8\x02|e\xdcMe\x1b\xba\xff\x0e\x1a\xc1\x16\xb1\xf0J\xd0\xe4~:t\xef\xe4^\xe4\x14\xb6t\xa06\x00\xdd\x1el\x99\x8a\x7fD`\x1fq\xb9\xc3\xc8\x1a\xb0\x89!\x89_\x93\xde\xd3\x18mBZ\xae\xc2'\xc5\xe3\xa0#\x07\xdb\x96\x0b\xae\xbe\xe2\x1c*\xd8?e)\x95\xab\xfa\x06x\xcf\xb0cU\x15/\x93\xb8Iz\xb6\x9c(>\x17\xbf\xe8{&\x0a\x90\xb1\x8c\xbb\xab\xa4\x13\xd7$p\xd2\x8b\x8f\x05\xd7XW\x96\xdfAWd\xbclM\xa8\x9a\x1f\xfd\x9d\x9c\xf5Q{\xa45\xdcT\xa8\x94\x14\xd0\xd3\x16(f\x85i\xe41\xac\xee!x\x13z\x06\x12\x07\xbbj\x81\x99cj\x83\x90\x15\xcf!\x16\x09\x99&\xd9\x9dkJ\xfe\xce\x053q\xbd\x84\xff\\\xc7\x93\xa2\xb8\xad\xc6s\xf3\x96\x81\x03\xdd\x1c5%\"h\xc2\xac\xa7U\xedc'0\xb3\x8fa4X:\x1fc?\x11Vk\xd5\xaf\xd1K\x0b~R\xbf\xa6\xf4(\xec*\x92\x83\x8f\x82\xbb\xdf\xa6\x99\x90\xe4\xdc\xad\xb0\xcfs\x10|\xfc\xc8\x15\xba\xe4\xc2\xf4\x95\x98\x1f\x0a\x01\xf8{\xecAA<\x15\xfb\xab\x89w\xefR\xf0tX\x92v\x88\x0b\x7fh\x91\xd5\xd9\x14\xccpp\xd0go&\xcbQ\xd8\x88\xa8\x9a\x84\x92k\xeb\xff\xeda\xe0.\xf1Lx+$|\xc5\x08L\x88\x06}\x8c<2\x9b\x15\x9d\xbc\x0d\xa4\xd5\xef\xb5\x9c\xaf\xd2\xb2\x8d\xef\x94\xbc3*\x12\x04\xccSB\x08%\x1fY\xab\xed\xd5W\xa1|v\x1b\xf0(\xf3|H\xa7B\xd7l[\xaeU\xe8\x16B{YX\x17[zr\xd3Z\x96\xc4\xa1\x17G\x08V\xeb\xf5\xc9\x1b\x85\x85\x8bz9\x00\xf1\x11$\xae\xa7\x04\x91\x17\xbd\x1dI\xde\xc6S\xa7=\x1c\xea41\x9c#Os\xa1\x1b\xf6\xf2l\xc6\xfd\xf3\xde\x87\x0a\xaa\x11\xddO`\xac\x99K\xd3\xb6\xc0\xcaAX\xf2D\x0c\x18\x933\xe2\x99$\x9b^\xc19\xc7%\x85\xf2\x9a\xbd2\xf1\xb9\xe1)\xb0\x94s+\x80\xb8@E&T\x82\xc2L4\xa7\xcdK\xbb\x16,\xf3\xd4-e7\x95\x0b\x06\x05\xf9\xf5\xea\x93.\xab<WM\xa7x\xb7 \x9c\xadg\x91s\xa5\x11\x8c0\xa0H\x85\xe4\x0e\xa8i\x8bzZ\x8a\xef\x90Q\xc3Y\xbc\xaf\xe1k.L\x92\x06\xd5\xce\xa7\x1e\xbd\xc2\x86\x0e\xf3\xa1\xce\xc3^\x11\xc6K0\xc7\x94\xe2\x94\x9d\xb1\xd6wd\xc0\x8e\xcb\xf1XT\x01u\x1cZ1\xd3\xf1k\x0a\x81\x0b\xa9mG\x86V8\x8d\xc7!\xfb\x133\xf1\x1e\x0b\x8f\x15\x95\xcb\xa9\xaa$\x9c'\x9d\xfb\xb0\x85?\x9f\xcf\xe5KMF\xd3\xb1!\xa1\xc2\xc9?6Zq\x04\x07|+s\xa9>\x03R\xfdA\x104-\xbc4a\x86\x16W\xef\xcbl\xde\x88\x9bS\xcbAN$\xae`\xe1\xed\xf7I\xaf\xb3\x82\x97t\xa3\xc04u\xaeI\x9c=\x89I\xf7p\xc7\xa90\xc8}\xc3X\xad\xe0\xee\x9e'\x0d\x8e\x0e\x11`\xda\xb8'N\x148\xce\xb2U\xca#X\x1b\x7f\xd4\x94%\xba\xdf;\xa7\xbdP\x0c\xf4b\x94\x19\xb0\"\x97\xf5s\xb0W\x9a\x04\xa5\x9dt\x13\xac\xc9\x84\xaa\xab\x9d\xd6\xc4\x03\xa9\xf4\xb8\xcf\xc6\x15\x03\xb7\x12\xb7l\xab\xc4\xd4\xaf\xd1`\xf4y\x8f\xbd\x9e\x9aF\x9a\x1c\xc5\xaf{v0\xa6<\x8e\xef\xe4p\xdf\xef\x04\xf0\x957\x04;\x13\x19\xdd\xf3\x9f\xd8m;<H2U\x9a\x91\x1b\x88\xb6\x96X\xfa]\x81\xb3\x1c\xb8\xf3\xf4q\x8dE/\x8a\xa3Ox3\xb2\xceS)\xff\x8c~\x80R\xfa\x88Q\xcf\xfa\xabQ\x0bT\xa5\xcf\x0c\x84\xad]V&\xedT\x12\x0b-\"\x8agd\xde6\x05\xa7Mp^\xea\x07\xbc\xb9\xab\xb4|\xd3\x093<<\xf4\x97\xc9\xbd\xee\x1c\xe4\xfew\xc0\xd6P\"\xaa\x13QE+\xab\x85R\xceo\xd4\x06\x12\xeb\x8a=A\xd5\x09l\x17'\xc3l\xa9=u\x83\xb8r\xf8\xe2E\x07w\x88n\xfb;\x17\x11\xb9\xbc\x8f'\x1b(\xb4@\xdd\xf6\xda\x99\xca\x91\x94\xac\xae\x8bb\xe8\x9d\xb4I\x8fjL\x1f\xbe\xb0\x1ce\xd5@\x94WeB\x1a\xeb$\x8f\x96\x01T\x18gI\x1f\x93\x90\x9c\x96\x1a\x09VB2\x93\xd0\xc2\x1a\xc5\x95\xe3p\xe8|u\xe5\xd6*\x15rKR\x9fN\xaa\xe0\xb1,\xac0\xad{\xbac\x8d\x1c\xb3\xeem\x04\x1b\x8a)\xe5\xe7g\xcc\x1f\xbe\xd8\xf6\xda\xff\x8c\x93\xefR\xc9\xfb4\xa2\x9e\xd9t\xf9\xc3^\xdar\xd6\x94\x9a\xc3\x03\x1d\xf7#\x13F\xc3\x17\x0e\xf3\xd7fT\xdb9R\xf9\xc43\x0c\xa8\xcf\xf8@\xeb/H_\xeaRsE\xba\xabd\xf9H\xe1\x13\xdd\xda0\xcf\xdcLTd\x12R~\xd3-/\x9am\x9f\x0cn\xd9d\xa6v\x93\x9c)\xdd\xe4H\xe6\xa7\xae\x81,\xf6\x98J\xc1\x82m\x1e\xba\xde\xe2\xfdF\xb6%\xbb\xafS+\xb4L\xba\x9f{/\xe3\xd5\"2\xf81\xe25B\x9a\xc6\xf4\x1d\x18i\x1b\xc5g0\x95\xa5\x04Jn\x166\xfd\x19O$'p\xde0\xd9>|\xb1\xef\x91\xd8\xccX&\x18 \xbf)o\xc2\xfa`\x14Zy\xbcU\xe2\x99\x8b\x9dtBT\xc0\x95\xf3\xd7\xb7]_.\xc6\xcd\x94\x85\xe0\x8e3u\x17\x08\xc2o\x19S.\xda\xe10\xa4,\x81\xed{\xa1H\x81\\Nk\x85\xa2 \x82UQ\x1ct\x10\xbe#\xe8\xff+==\xfd\xb2e\xd0\x0f\x1eo\xd1\xfc\x00\xb1\x15\x1a\x92iwd\x91\xaa\xb0\x9a\x99\x09\x04i\xefR\xfe}d\xea#\xecOJ\xa6p\xa2\xa1\xfa\x82\xa75{I\xce\x90{\xf4\xf0Iwu\xa6\xcb)N\xf0)\x98\xd1Y3n\x933{q\x98 [9\x19<\xef\xd2e\x9b\xd3\x8e\x0e\xbc\xd4s\xa8I\xcd\x9d\x80\x8bI\x01}d\xb9\xd3X)\xe71\xeaa8\x1b\xf9o\xfe\xfa\xab?|\xf9\x09\xfc{\xfdO)\xaa\xfa\xc5'\xbf\xfd4 \xffO\xeb\xdb_dF@\xf6\xfa\xd4+\xdd\xe6t\x86\xd2\"s\xb8;D\xbf\xc2\xc9\x8b;Z8\x18\xa3\xb0\xd9@.OJ.\xc1\x96\xbfE\xc4\xea\x0d\xcd\xd0\xda\x01\\\xbfwL\xb7\xaf7\xf8/\x06\xbf\xfc\xec\xab\xdf~\xf6\xf9\xe7\x9f\xfc&\xd0\xb1U\xc8\xf5\xe4a\xbci\xbf\xcd\xe52\xb6\xdb0\x8fP\xb5\x0dZ\x17\x19Rh\x1bp\xda\xc3\x98\x97\xf4\x14\xb7D\xe2\xceA\x0b\xe0\x14\xcflb\x96\x87\x92\x1a\xfc)OR\xe7\x1c\xac\x8a9\xef# \x8f\xc8\xc9\x94\xf9\xbc\x94\x03g\x09%\xa0\x04\xf2;'\xd3IAj\xd8\x12\xbe\xe8\x08\xd5\x03\"9\xbfAI\x0a\xfe\x12\xa1\x0c\x10\x03r\xec\x92F\xbf?\x06\x1f\x18k\x16\x03tmd{\xa8\xf8\x0f\xdeA>\xb2\x18\xfctiq<\xfdD\x17(\xdd\xa2\x96\x08\xd8sZ\xad92\x1a\x95\\\xca\xf7P\xb8\"+\xca*p\xd4(\xc1PH\xbb\xf5GT\xde\xbc\xed\\@\xedD\x07\xbae0\xa22)rz':\xdd\xc57\xb1o\x11W\xe0k\x80\x1d\x9f\x0a! j\x88\xc6;\xde\xe8\xc6+\xb1z\xeb{\xbe\xe3+\xc2\xaf-\xec\xb3=h!E\x0a\x1dc\xda\xef\x82\xae\xa1\x1b\xc1J\xf8]%\xdfK\xbd\xbd\xc7a\x0a\xab\x00\xb3\x91\xb6\xe9\xbf[\xef_>\x049\x90\xf1\xb5\xba\xfb_{\x82\xd7\xeb\x15d\xa3\x8e\xc8g\x90\"\x8cr\xb0\xa4.GA\x8a\xc9\xe2\x8c\xe5/\x9c\x02\x931\xa7\xe2h\xfd\x9d=\xb53V'6s\xedi\xaf\xb98\xb3q\xbe\x82s\xffw\xe1\x11Qu\xc3\x84\xfa\xe7\x1a\xcc\x1b\xb7[\xbd\x82\xb5x\xa2\xc1\xd0\xcc|\x8e\x8d\xc7\xb8\x01\x88#\xdf\xb6\xfa\xcf\x04I\xf8<\x112\x91`8\x15\xe3|O}\xe2\x14\x02(\xf7_\xd8\xe1\x05\xf1\xa9\xbeJ\xc2M%q\xa75\xc8@\x89F\xb3v\x15--X\xc7$\x94\xa6R\x18s\xc5\xebeH\xf3\x1e\x01(>H\xc20)9V\xbf\x06y\x9cL\xba6\xd7\"\xa5\x0e$I\xc1\x05\xff\x8c\x83\x14\x8b\xe0\xf0\xc1\xb8\xb90\xa3Sr\xa4\xa9\xfar\x91\xfd\xd6\x18\xba\x94\x92\x10\xe9^gR\xe79\x9a\xf1;\xbcn\xc4\xf9\xaco8j^\xa6/8'\"\x8a\xfa*\xee\x8b\x85F\xc9;%6\xfc\x8a\xb2\xb7\xc1\x87\x7f+\xe9\x91\xf2\xf9\xe5\xf6)q\x14\x18S\xe9x8\x0d\x04)\x90\x14e4z$\xf5[0\\R\xb8I\x9f'\x0e\\p\x16\x1e,\xe2\x87\xcep\xbdF;}\xd6\x12\x05l\xf0r\xec\xc0=\x083P\x18f\xffn)\xc9\x96\x8d\xf7\x06\x8c\xedB\x9b<\xad\xd5\x83y\xd4<3\x95\x904\x04F\xc1\xd7\xddC14.\xcbE\xae\xdb'\xb0\xf2\xd9\x86\x080e\x95\xe8\xaaE\x08\xd9\xfa_\xe1Y\xe8(M\xe5s\x129M\xe1\xce\xb2\x88\x93h\xef:\xd3\x9eX\xcb{m\xd9\x1a\x15\x98t\xca\xa4,\x91\xb9\xb39\x8e\xc7>SV\xf6\x8f\x02\xdf\x13 \xe0\xa89\xe3=W\xbf\x83\x14\xd2J\x90\x0fc~E\xb4D@\xe2h\x03\xcd\xd7\xf5\xd5\xc2\xe7gB\xf7\xfcZ/\xbb\x07\xd1t\x0c1\xc9\x99\xa3=\xc85\x1b/<1I\x9f\x1e\xfe\xa8\x8c\xc0[&f\xfb\xa7c#\x7f \xc5\xa2\xa8\x87\x0c\xf0\x0b\x9f\x98|b\xa8\xe2E\xd3\xf9?_\xce\xf2a\x0a}\xd0\x94\xb6\xf4t\x08\x9c\x13\xabL\xe9/]i\x08\x9bV\xec\x98\xb8k\xd1P\x9cg\xba)\x15Cw\x1e\xc5\xc5y@Y\xc6q\xda'\x04\x02\xab\xb38\xb4+\xfb\xb6M\xf7\xf0\x0c\xa9j\xeb[\x95i\xa1\xbam\x91\xaf\xdb\x0a\xf0\xe8\x92\x1d\xe3\x18R\xc4\xcc{\x95\xc4\xd2\xec\xfb\xd1\xb9\xc1\xe6\xce\xaa\x815\xa7\xd9O\x0c\xdb\xf3k\xa2\x83m\x1d\x09\xc9\xc5a\xadn\xa6\x0e\xc9.I\xfa\x98>\x9fJzd\xb0\xeeY\xc9>\xcb\"\xca\xae\xa1Y]/x8O\x0d\x1aH\x8ac\x05\x0cl\x15\xc9q[\x84\x9d6\xe0)g\x99~\xabC\xa2\x03\x01\xf4\x19u\xd3\xe6\x0f\xaaX\x94\xa8lc\xe4E\x1cP\xc41\x1cLW\x158\x86mV\x88X\xc5\x93\x8a\xa2\x8e\xb1\xcaA\xda22\xb14e\x89{\x95N\xbc_kMH\xf7\xe9\xa4b\xc2\x80\xb9\xed.U\x98\x11\xe4\x1aBY\x8bQS\xa4\xaba\"x\xf9\xa4\xf4\x8d)\xef\xe0\x82\xc3\xb2\x15aZ\xcc\xe0\xd9(\xbe7L\xaa\x9bN,\x05\">\x0e\xc93<\xb0\xf9\x00v1\x7f\xd4\x11z\x85t\xf2\xd1\xacj\x09\x93\x96\x06v\xe6\xba\xc8\x08\x14b\x0ed})\xc1\xf3%/\xb9\x92+\x99SQ\xeaP\x96\x93}\x8f\x19\xfa`H\xc3?\xff\x14\xeb\xc9\x92\x88\xdc\x05~\x09\xd8W\xeb\x03\xc8\x80j,\xc2\x82\x1flI\xb4\xb2\x83\xb3i\x19\xec\xb0\x85\xfa*" +
+	",\xbdw\xaca\xc7#ga\xfcYp\x88\x12\x99\xc6\xca\xceC,W\xd4\xd7\xb4y\xd3\x8eqmh5\x97\xfd\x15'|\xdbu\x0b_\xb4\x1c\x02R\xcc\xc4E\xe0$\xdc\xb5=\xfbI\x15\xf0\xc6\xaa}\xb6\x17R9\x04s\x08\xd2\xa0\xa5\x02\x98\xadC\x1a\xd7\xa3\x10S\x14\xd3\x83<\x1b\x8ap\xe3\x80\xedJ 7\x1d\xe5\xbd\xec\x956\x11a\xd8\x1anv,\x0ba\x00\xe5\xa3%*\x0a?\x870\xe2\xd6\x96\xa4n\xc3V\xdaU\x1c\x1aW\xef\xad\xc5u\xb0\xd3v\x09(\xe0\xbb~\x8f\xb2\xdd\x13\xc5\x99\x92\xd7m\x12\xb1\x8an\xae\xd8\\\xb1t\xa4,]\xeb\xf5\xe7\x94\x07\xde\x91N\xb0QM\xc7\x14AT\xb6\x02\xb0N\x85\x0b\x03'\x02}\xaaol\x96\xf5\x1d\x14qT\xfauA\xda\xda+\xf1\x81\xe1\x92\x134\xe1r\xf7\x81\xe6Q\\\x95\x95\xd4y\x97\xf0B\x09\xccx\xf3h\xb9l\x8d\xb2F\xf3u\xa7\xdb\x1b\x91\xbd\xa8\x83\xf7\xc3`\xb9Tte\x82\xe9\xbe9\xd0-M\x9f'\x83\xa8GW&\x9f\xa3\xf4\xa5@\xc1\xe7}T\xa9GZ\xb0\x97S\xd0c)\x91\xd8\x91i\x16\x8e\xb1\x1axI[\xb0\x82:y\x0a\xdd\x8c\x00\x0f\xd9a\x82\xb0?\x81y\xcb\\\xeaJK\x0a:\xd7w\x1e_*\x99\xc4\x8b\xd5o\xc0\xc8\x08\xc9\xa5+@\x99\x15\xb2`}\x89\x88\xe2\xf4\x91\xd6+/\xd1\x86J\x88\xc5\x08<Dt\xba\xa4g6\x0d\xaef\xcca\x97J\xc20> \xad\xcb^\xda\xd3<\xa9n\x0bu\xbb$\x84\xc8{<*\xcc\x026\xafo\xeb%\x9a\x8b\x88f\xd4Q\x8f\xe4\x8abV\x14\xd1\xa9]\xc27f\x1e\xb2_\xcbmA\x1d\xe6-\xe4\x1eX\xe2QD\xaaF\xf8z,\xcbf\x98_<\xd9Da\x1a=G3\xb8\x12\x8d\xc8\x1d)\x19\xea/\xde'\x92\xc1\xe8\xa9LY\x0e\x16\x07\"\xfb\xf8\x9e\\pj=\x84m\xf1\x99Y2bu\xa3lN\xb31\x89\x8c\xdb\xc8\xb6\xad4\xecQs\xf2`\x1b;\x9c>\x9d:\x94/:'b\"U\xbf\xde\x01\xe0\xdaS\xd4L\x12\x7f\xf5\x07x\xda\xdb`a\x8c\xfc\xd1\x13\xce\xb9\xed\xa1\x07c\xbc\xb4\x1a\xef\xca\x8e\xe0\xd6\x88\x15W\x88\xde\xbfG\x1d=\x1f\xa7*\x95\xe50x\x15\xa88\x92\xb0\xcc>D\x82\x80\xa6\xf4t\xeb\x83W>\xe2\xf6-\xd4\xaaW\xbc\x16\x1e\x82-\x8d\xbd\xadR\x1d]h\x0dB1\xce\x04\x0e\"\xd5\xcd\x80\xac\x97\xf6h-}\x15AC\xd8)}t^\xc0\xea\xdd%\x8esL\x8e\xd2#\xb1\x9c\xdb[\x89\x85\x16q\x8epjR\x10\xe1-\xc3M%\x0b\x96N\x88\x84\x8f\x07!\x18\xbc\x85\xeb\x9bo\xcfEc\xa6\x18\x10p\\*\xc0\x80\x15\x0e\x07\x86\x81\xf0\xa2#\xdeW\xc2s\xdb9\x9b|\xa8!\x97a\x8fSJ\x1c9\xc1\xdf\xa1b2\x05\x9e\xcf\xa7\xf2\xb2\xbd\x93\xa1zV\x98a\xf2#K\x08\x19D\xbdOx\x18`E\x0c\xa6{\x1a\xea\x097\x89\x04AB\xa7\x99\xaa7\xab\xfc\xb8PX\xfeH\xda\xa4|=\x86oXU\xf4\xf9\xb3(\x83l\xef>G\x99\x08\x06-\xc9\xa9\xa1wB\xf2\xdb|z\x92\xcc\xd35\xf6\xfbJ.\xd1\x0d$47a\x0d\xd2\xba\xd01~\x8c\xf3n8\xee\x04\xb9j\xda5\x9f\x0a\xaa\x16\xae\x1b}\xec\xb4\xc5`\x83N4\x0e\xa2M\xcc\x1cK\x0b\x18\xc5\xef\x91\x9b\xa2\x9dr\xf6\xb1\x0a\xd0\x11^\x8bZ\x81)\xb9%T\xa3\xb4\xc9\xaf\xa4o\x7f\xda\x14\xa4_3uh Jh\x1b\xfd\x00\xd4(\xd4\xf8A)\x8b.\x9d,\xdf\x8f\x11\xd4\x99\x9d\xaf\x9c(\xe9\x89\x97.\xbd\x97&\xa8\xd1X\x9a\xb7\x09b\x0f|/\x10\xc2\xaeV\"\xa9\xbbHYc\xa2/\xacSX\xdb\xca\x02e\xaf\x93\x88\x8dE\x14\x04\xe6\x1bC\x1a\xf1\x13\xfc\xba\x8cI\xe4\xb1\xa1\xae\xdeS\xc79\xdc\xf4\x8e\x88\xb0\x8a\x0cE\xb5j\x04P\xf0\x1c\x1d\x08\x9f\xc5\xed\xf4\x1ab\x80\x99N\xed\xde\xe4\xb0\xeb\xe9U\xc2\xc2 J\x92\xb3\x1e\xa2\xc9Y\xae\x0d-\x12H\x0c\xc0S\xa9\x7f\x80\xfc\xeb\xe1@]\x12\xdd<\xb6,\xa0ud\xb2\x99\x1bX\x0a\xe3\xa4\xf88\xe1\xd3z\x1c\xda=P\xdb\xad\xbe\x86\xf7\x85\xa28\x10z\xd2\x9f\xc8Z\x9e\xc0\xe8\xe7\x8c\x0e$\xd2\x01\xef\x97\x8c\xa4\xa6t\x1a+&\\c\xa9N]\xbe5\x09\x88H\xdb\x87\xc7\x9dx\x05-\xf6\xac\xf2\x1a\xeb\xe3\x0cI\xa9\xd3Bf\x1b\x8a=<\x15\x1f0\x06\x8b\x09i)\xfa\xc0ck\xc0\xb4\x93jG9\x1b\x18\x0c\xfcY_\xd1\xe6\x13<k\x962>\x1cX\xae r\xdb\xe3\x0a8\x97\x89\xc3k\xec\x9ei\xc6\xb8M\xa6\xe4c\x8a\xa3\xebl\x10\xb6\xc5\x9c)\xf45\x16S\xdc\x064g\xaa\x08\x02\x9f\xe1\xb0\x96\xb0\xd2.\xcc,Hfd\xcf\xe4\x03\xb7\x8a\xf8\xdac\xf8\xc1v\\\x9f\xf3.4W\x9f\xb2\xab6f:\x8eES\xf0\x1b<\xc4bAl\xeft\xa2F\x854\xd5L\x94\x10\xa1\xd7Wp\x01\xfa\x85\xc2\x1c\xaa2\xca\x04\xd10N@j7\"/\x9d\x09l\xa7\xfa\x86\xee\xef\x10\x810\xf0O,\x09\xa8\xe9\x1e[\x0c\xa0\xea\xd0\xe3#\xb2s\xb0-\xa1\x1f1\xafp\xdb\x14T\xa1J\\W\xb1`Hk\x08;\xa5\x84\xe9\x8eL\xe3\xf4i\x89!\xe7\xfa\xb7\x15\xfe\xf64l\xae(\x8f\x94\xccM\xe9\xe8\x17R\x9a\x1c\xad2\x02\x898\xd7mRN\xdfp\x11 \xa2P\xab\x98\x09\x1b\x98\x97]\xafy\x80\x1aR\xc4\xf8,\x94{FN;\xb7_9\x0dWp\xd9^,~2J\x9a\x19T\xfaUjU\x9e`q/a\x07\x12\x8a\x88\x19\xf6\x93S\xcd6pI\xadV\x85\xe9\x84\xcbvP\xb5\xe8[\xa7\x09N\x0e\x9d}\xf4)\x81x\x9d\xd7;\xce4\x1bLP\xfc\xf7\xa3l\xfdz'\x0aP\xe7\x89\xdf\xcd9\xbf9WJ\xae\x1c\xdc\xce\xb1\x04t\x08\xb8\xd6\x99S\x15\x88\x12\x89\x0a\xa1\xd8A\xb5r\x8dx\x968\x7f}\x05\xb1\xdb\xd7\x8ax\x9e\xf5`\xbf\xc1\xac\x0a?\x16\xb3@\xe3(\xc4\x17\xcd\x8c\x82<\xba\xf4\x18\x88\xd0T\xd4V\x18t\x8a\xed-\xac\x1c\xb1\x82\xe57\xa1\xb5\\_\xea\x93\xe4\xd1\\4;2\xb3n\x0c\x9b\\x\xb1E3\xe6Z\xe7\xe8W\xc0\xea\x88\xdd\x0c\xf8\x18\x17\x1aB\xc5\xca\x12K)\xb2\xa7\x10\xac\x8a[0\x98uyI@\xa5\xc8\x93\x95~\xc9_?\x1b`o\x83\xea\x1d\xf3ENf`y\xef8\x05j_\xc0\xf8\xcf\xaa\x02u\xd6C\x09\xbc^\xc2\x94\x1f'{sJ$\xdbU\x9a\xba\xacx\xd8\x85\x1f\x97\x00\x7f\x8d\x80\xb1\x13f\x9c$\x08[vD\x84\xcd.\xc5\x03*\x08\x98\xa28\x1f\xa5\xdd\xa6\x8e\xe8\x97\x96M`\xa2\xf5\xf5mH\x9d\xadSp\xf4E\xd2\x14>&\x08R8\x1a\xcee=\xf1D%\x83\xf0t\xea\xa8\x12]\xa5\xa5L]\xbd\x94\x9b:\x15\x00a@\xe9\xb92\xa0\x12\x14\xa6q\xa4\x89\xa2\x96F\xfe\xf6\x17\x03\x7f\x96\xba\x8c\xa8\xc0<\xa12\xc3\xfdRay@aA\x1a\xa8Q*/y\xb4\xa1\x8ax\xae\x04\xf2\x93v\x9fB\xf4S\x11\xac\xca\x08?\xe6\xa5\x7f*$\xa1d\xc5x|\x81\xb7\xc4-P2W\xcb)k\xe2\xa8B?~\xb24\x12\xc1\x9aB\xd4i:\x9dl$\xfe\xb0\xbb\xdd\x91\xb5\xc7MU:\xa4\xee\x85e\x0e5\x15\xd4\x0c\xbf\x7f\xf8\xbal|\x00\x02\xcd1(\x83\x89,\xbe\xe1\xecQ*\x9ay\xe5\x80\xe21\x9a\x01\xbb\xbamv:\x00\x87\xc9\xd4\x8d\xb8\xfcN2$\xa2j\xcf\xfd\x1be\xe6\x8d\xb0c\xeb\x94\xdbgM\xc1\x006O\x09\x10\xc7\xca\xa3`a\x9a\xdd\xf5\x7f\xb2\x0d\xbb\x94\xbd9\xdd\xb9a&)\xe9/U\x9ep\x91P];\xce\xa4\xf8`\xe6pu%\x82R\x1b\xc9:\xed\xa6\xc4y\xe16\xbe\x14Vk\xdb\xaa\xa9\xe8\xaf4C\x85\x80\xabeZg\xfd>\x02\x00\x98\xbd\xc1>\x9c)E\xca3\x92\xc0n\xcb\xa6\xc8G~\x07H\xb5uzXt\xb0kj\xe1\xf3>\xb2\xe6\xa3\xeeFu\x8dI\xc2\x08\xf6c*\x81\x85=\x19\xc2D\xc2h/$*\xc9\x82\x19\xc8\x0a\xca\x86m`\xda4\xd3aj\xe7|\xbc\x16YK\xc8t6\xa7\x94\xd1\xa1\xbe\xa0\xef\xad\xe8cn\xc4sME\xc1\xf5\x8e\"\xc5?\xb65\xb9'\x0d\xa4\xae\xde\x87\x931u\xf4\xb6\x8c;\xd3\x8f\x1cK\xa5\x19-S\xfd\x0f\xbe\x8f\x9e\x13\xfb2\x1e\x8ft\x86\xc1\xe7\x19\xc3\x88\x9c\xf7\x11Y?\x85\xe5O\x95\x13\xf4\xe5\xce\xe2\xe0\xdcH3\x96\xb0q\xfaH\x0c\xe21\x8e\xcbX\x91\x8a\x9dp\xe6C\x02\xa9R)<5\xeb\xd7p\x9fx6A\xb42\x05\xb3v\xdeS\x08=\xec\x8e%x\xca\xba\xbd\x04]j.\xfa\xa6\x9d>\x1aj\xfe\x92vN\x0f\xc9s_\xea\x19G\xfa%\x94\xc7t/\xf5J&U,\xee\xdd\xcd\xf9\x087\\{\xa1\xb8b\xcf{\x8a\x9cK\xd7\xfe\x15\x15\xb5n\x9b\xc2s\xefUj\xe2\xcf\xed\xe2\xb3\xbd\x8f.\xfe=\xc1\x15\\\x1a\xbdTs\x84\x9dW\xec\xcbZ\xe9\xde8\x0e\xfd4\xa7\xbcvv-\x0fN\x97o\x9b3\x82@\xc9\x10\x9b\xf5\xceXC\x0c\xfe\xd0Cf\xdcw\x1f\xd5\xa4\x09\xe0G\xc2\x93\x9c\x0a\x94F\x07\x98&z\x81Z\xc0\x8ev\xec\x14{A\x9a)}@\x0e\x9cX\x05\xba\x7f\x9e\xff^\xb2\xec\xd5\x9fT\x19K\x91\xeb\xd0Z\xe9$v^ZQgo*\xa0\xc8\x12G\xf8\xbc\x87,\xfa?C\xe0\xa3n\x8cOP\x90#\xdd\xc8\xb5\x19P-\xe5\xa9\x17\xddZ\xa2D\xfbV9\x9d\x14}\x0a\xafa\x80\xfeF}\x07\x80\xe8\xf3>\xca\xf0\x99\xb8SY\xf08\xb9\xcd\x91\xdf\x15\xa4\xb1/\x82\x8d~\x04kx%\x80\x9c\xf5\x12P\xb8\xc7\xdc],\xd0\x85\xd1v\xb4@\xe2\x96*\xa3u\xaa\xeb\x7f\x14o\x8c\x9a\x82\x0a\xce\xaf\x9b\xb2~#\x9f\xd0\x14\x8c\x95M\xe8#\x1c\x8f\x18\x96#\xf3j6S\xbf4\x1c\xc4\xea\x84\x04G{%\xe2^\x00\xe3\x17\xa1\xc2\x1b\x13K\x09\x97\x8a\x96\x95\xe4\x0c\xbeIp7\x13\x00\x95\xbd\x01Cy\xdeCI\xbeU\xbe\xad\xa7;\x97\x1e_?\xa1KG\xc9F\xc5\x15\xcd>D\x15\x903o\x9as\xf7@\x80\xec\xaaf\x1c\x84=\x80\x9a\xe2\"\xe3\x177\xe0\xdb6M\xfeD\xccH\xcb\x9dwa\\X\x10h\x06U\x95\x1f\xa4\xd0\xf1;R#\xb1\x0b\x09;\xb7V\x12\x01y\x0d\x0b\xa9OzD\xe8\xb6\x1f\x9b\xbernv\x8fl\xc4\"\x98\xe2\xb3;\xe3\x91ig\xe7Y|2u\xc9>\xf2\xe7I/\x8a\xa2v\x97\xce\xb4DM\x82\xc0#\xd6_f\xd1!\x95\x1a\xb3\x98U\xc0\x86\x15\xe1\x14\xa8\x82\x81q\xe1\xd4gg\xd2\xca\x87\xbf.\xe2+R\x81\xe8Z2\xa0\x84ji\xc0W\x8f1Z\x8b>\xfb\x9b6\x1e\xa8\x16uF<F\xbf\x19\xd0iz\xab\xf0\xbas%P\xf6.\xa64\x9d3~\x1a\xb3\"\x9a\xbaL\x0az]G\xac\x90:IQ\x179\x12\xd0\xc2\x91\xa1GR\xde\xfduD\xb8\x0d\x14\xd0\xc9F\xe8\x8eb\x98I \xaf/\xfc\xa1m\xbc\x8c\x1a;\xc6O\xe0\x85\x95\x02\xa4\xb5\xb6.`\x9f\xce\xb0'$\xd6\xf4\x8f\x05\xe1>\xc9y\x09R%yj\xa1\xdc\x09\x13\x0e\xc9V\xf5S\xeap\xf9#\xb8\x0c\xf6\xcb\xccf\x1e-}\xd1G,\x9c\x16d|\xcc\x99d4\x9b\xc3\xdfq]`E(fG\x0d0\xf3\x01\xf4\x8dN\x03K\x0a\xc9\x06\xbfa\xd8\xe6\x03\xd5\x9f)\xd9\xf0\xdaf*\xacP.g%\xce\xf0\x16]\xe7\xf3\xfev\x9b\x9a,6u]S#\xf1\xb47|C\xcb\xe0\xb9x\xfd\xa4\x00h\x88\xcd\x92v\x17\x00j\x0d\x94du\xa4\x0c\xb3E\xec\x1bJ-\xde2=\xc35\xfe\xa1\x87,w\xba\xef\x15\x05\x12\xc9\xfed\xcd\x1euO\x01\xa5@\xc9\x12\xbbJ#c\xe0\x1b\xc7YS\xc2woU\x93\x16\x9c_\x1bo\xd0]\xaf\x1d5\xf7\xf0a9\xddh\xd2\xc1K\xd2\x10Z\x7f\x13\xc3\xc0f\xda\xbd\xc6\xf6Lu*s[\xee\\X/\xa8u\xa7\x18\x0f\xb3\xe5r\xe3\x99v\x8e(\xe5v%\x8a'\x89\xa8'8\xe0\x86\x01\xcd\x16x\xad\x9f\x18\xec\xe7mgNc\x7f\xbfG\xda/w\xa6\xd2\xbc\xbe\x04\xda\xbafF\xa7\x9a\xceu\xbdwx\xd7\xd6\xd7|\x03\x0f\xfa\xc0\xc5&>U\xc5\xa0\x0a\xf7\xef\x0c#\xab\xcey=HU\xe3\xea?\x8ai\xa1\x122\x82\xf7\xd7\x1b\xb8^\xa1W\xaf\xd5\xd1\xe9Tt\x19G\xca~\xc4*yj\xb2\xaa+#\xe4\x91D,\xd0b\xc7\xb5\x11\xbd^&\xe4\x08\x0a\x88'\x88\x15v\xe8\x8b\x96\\#r\x12L\x09\xd6{\x0d$D\xe6\x01tS\xdf\xc3\xd0p\xb8\xe7\xa1z\xf0\xbe\xf5\x0a\x0e\x961c\xa2\xee\xbb\x8d\xdea\xe6\x10\xd0.\xe0\x03I\x09\xcd\xb1+\x06\x8f\x1em\x9b\xcc\xb8i\x84\x03\xe2\xa4\xce\xedN$\x01\x98e\x86Ju*\xbb?\xfa\xe8j\x1b\x91\xecS\xee\x9a\xbc0 \x9c\\\xd1\x95\xe8\xcb<\xdcd$N\x9b7\xd3\xe6<\x1c\xd2\xd1\x0bl\xb5\x06\x07\xc3\x8e\x82*n\xf8\xd28l\xc26x\x12N\x88\x14\x7f'I\x03\\\xb10\x04\x01\xac\x9a\x8a\xfa\x134\x1al\x11\xdb\x9a\xd6\x8b\xbb~\x90!\xa4J\xc5D\x87\xa7\xb2\xd2\x01\xd4\xa9\x05\x1a\xbc\xc9\x02\xbd\xa4l\xfeY\xd2\xb1\xac}\xc6\xc2\x03\xa75\x13\xc9\x9b\xcf\x10p\xca\xf8\xbdK\xa8y\xf9\x0a\x96\xe0G\xf7\xd7\x01\x85\xee\"\xf8\x89e\xba\xd5\xbc\xef\xc4\xb1*zd\xea\x0e\xb6\x1e\x99/\x08\x9fj\x81c\x12\xaf\xc6\xa0\x03\xe1\xa2$4X\xd8\xfe\xdfI\x90GO\x08J5\x9b\xfd\xda\x09\x87\x87Y\x99^\xe5p\xfa\x90\x0a#qf\x17\xcd42\xf6\xb4\xaf\xfamQ\x1eF\xa2\xedS\x82L\xac\xefC\xdd\x9e\x85w^\xc3\x04\x13\x04\xbcG'\"\xabD>t\xef\xca\x9dL\xdd!\xdc\xf7\x16\xd0\xcb\xd3L\xe5\xe1>\x04\xdc\x88\xcf\xfa\xb3\xd7\x1eL\xd2o\xda\x1c\x85\x1d#\xee\x83\x1dVPSb\x95Q\xfep\x16\x9bm5\x17\xb5\xd7\xcd\xec\xc8`\x9c>7\xafy\\3\x01\xef\xd6\xea\xe2\x1e\xecv\xa2\x82\xc0\x9e\xb3.\xe5c\xc6\x83\xf2\x19\xc1L\xde\x85\x9d\x95d[5\x11^*\x96\x01k\xe9\x1e\xef*\xe40\xd3\x02\xf6C\xecsU9$\xb7x\xb4\x99\xfc*\xab\x99Cw\x0bJ\xfa;\xf1\x9a\x98\xe33\xccq\x1d\xe7j\x08\xdci\x96 l6\x93\xccP\xb5\xf5\xf3>z\xe8~\xa1I\xef\xd1\x92\x8e\x8a8\xc8\x00b(8\xcb>\x81R\x84\xe4E\x9d\xc2\xd00c\xea\x06\xf5\"\xf6$\xe4\xd5D\xefa\xa1Z\xb3\xb5|\xf6T\x9er\xe1\xbfX\x1a\xc6s\xa0\x04\xf6\x9c\xb1\xe9c\x8e\xb2(2\xad\xef\xbb\xdd\xa9\x7f^\xd4\x1a,T[d\xaaLH8\xef\xc8?\xe8\xa5\x97\xb6\x12\xa3\xa07Q\x19\x01n)\xd1>\xd4\xeb\x17\x8eOe\xcc,\x07\xe0<\xb9c\xc2\xd6\x12\xb8\xa9Cwe\\\x947\xbc\xc2|FW#|\x87\x96-\x12'\x09gU\x88\x05fx\xe9D\x03\xb4\x87\x94\xf2\x9b\xa5/E\xf0_\xf6\xd1Oo\xa7\x0d!\xa1\xc2\x15\xa96+\x1bs\x0f\xae\xd6\x15\xbe\x9c\xe8\xfeF\x87P\x9f\x96@\xa7S\xfa\xfe\xa1\xd1\xe64]\xcb\xa7\x9a\xa6\xa3\x10\xf0\x85\xcc\x17ls\x04\xcf\x8d\xf8Z)R5|\xa6\x0b{\xd7\xcfa1z\xed?\x15\xd65\x0b\x8aZ\xc9\xfe\xbd\xb8<0\x13r\xd6C{\xbc\xa7\x91\x16\x06om(\xd7\xbf\x0a[*\xa7\xcf-+\xec\x92\x9c\xe3\xd4\xb6\xf9b\xc0\xca\xd0L\x053\xa8\xdf\xe3$\x18\x857\xc9\x81\x1ds\xf6\xeb\xa3_H\x97\xc6\xe8\x1b\x1b1\xe8\xe2\xa7\x1e\xa1\xb5\xc3\x93D\x04\xd1X4;\xef\xa3\x9c\xde\xcf,\xc3\xc3\x8a>\x96\xf5m.\\\x87,\x13\xf2\x9d0<%\xd6fc\x99\x0e-=\x02NT\xf2]\xac\xbb\x1f\x9b\x0a\xf2?\xd1\xcdh\x85\xdd%\x04\x1d6\xd1\xb3\xbe!\xf9\x84g}\x15\xd5\xb3\xde8_]#\xd9\xa1\"\xccr\xc1\x95\xf1z\x04N\x10h\x18\xc6\xd8k\xf2SwxO87\x1ak\xb1\x8a3\xeb\x0c\xe7j\xc5\x98P\xbd\xd5}\x99O\xc8\"E+\xdb\x9a\xff;\xdcyND\xa3\x17G\xfdtEpYffI\xef\x7f\xbe\xa5f\xa1m\xfe\xe4R\xb3\xdb\xa9i#]\xc9u\xb0 \x8a\x1c\xc8T\xcbrr\xd9C\xc7\xbc7\xb5\xa1\xa2\x9068O\x89\xbc\xba\x03\xde\xb3\x0f\xb037P\xf8a\xedr\xe9\xd0Ak]\xfd\x00\xfbVF*\xc4\x92I\x14m\xdd\xa8\xd1\xb6\xeb\xe9/\x97\xfe\x97i!\xdfw{\x03\x07\xb2\xbe\xd2\x1cA]b\xa2\xb3\xf0D\xa1\xd3" +
+	"\x03*\x8a+z\xfd\xcd\xc8\xe3cre\x826\xbb\xc0R^\xec\xfb\xb8\xe3\xeb\x9e\xc2k1\x97=\xe5\xdfV\xdd\x8b\xe7\xf0Q\xa0\xd9\xa7\xe0c\xed+D\x95\xecf\x94\xbcB\xbd\xa2\x14\xc2m\xab\x94&\xb9i\x1a\x7f\xd4\xd1\xed\x1726+\xecp. \x81s\xe1WG\x158\xa0\xa0\xe4\x13\x14\xd9\xcf\xb8G\xfe]g\x19@Jv\x8b\xa5\xcaV\xd0\xaa\xc1k\xbe;\xc7^f\x98\x80Gj\x1f\xa0\xd9\xe2\xbes\x85t\x90}2\xa5^\x84\xd8\x01\x05*\xc3\x0aT\xd9K\x15\x17Y\xb4X \x04-j\xeb1\xd6\x92\xa2\x04\x92\xba[\xf4\x86\xe2;$pCX\xca\x1d9c)$\x8e\x04\x14r!\x11\xef\xef\x9b\xf4\x99$!K\x02\xa9RKLqk\xfc(2\xa9\x11\xa1!]4\xbe_!E\xa5\xa8D,\x8b g\xbdT\xc0\xf9\xe2xX\xca\x17\x0c\xbbY #\xe9\xa8B\xfcZL*\x166\x7f\xe8+A\xa8+\xcbz'\xa1\x03\xe6\xb0t\x1f8\xc7u\xd2\x94\x8e\xce\xediSt^\xee\x1eV\xf1i\xc7`\xec8\x9b\xae\x99\x86\xdcY\x94\x15\xabt\xef\xe8\x98\xc3\x98*U\xb1\xd4\x92a\xe7\xbd\xf4\xb0sz`\x03\xcdKR\xd0\xee(\x07BV\xf7\xcc\xc93\xc4\xc57\xd7\x0d`\x09\x88\xe9^$\x1d\xcf\xf0`\xe7\xf8\xf7\xac\x97(\xf6\x88*\x1cyY\xa8{Q\x85]\xff@fP\xf9$\xab\x06y\xf1\x93\xf5\xbe\x8e\x7f`\xf9\xa1{\xda8G\xad\xcf\xca\x0e\x87\xe4\xc8\xd6\x8c\xf5\xa6\x92uK'\xd3\xd2i1\xa5 \xac\xf4%\x06\xcb\x94<%:\xd8d\xcc$!^\xd1\x06\xf3\x8c\xa0]\xac\xaf\x9cIo\xf1\xca\xf0\xf1\xf5\x92\x94>\xed1[w\xc1?\xad\xa88bg\xcc\x12\x92\xff\xb3\x1d\xd6\x07\xc8\xd7\xad?}\xa9O\x01bWz\x03|\x12\xfd\xf4\xa07\xd7\"\xc1\x81N\x92\xd4\x0e\xde!\x93\xcf\xd6H\x0d\xa6\xe9\xef\x95\xfe6\xda\x0a\x1a\xd2\xe1ff&B\xb2H\x9e\xa2\xcd\x94\xbc\x18U\xde\xb5\xc3\x1aK^\xcdi\xfd\xaemPS}=A-X/\xc1\xbf\xf2\xc2\x12\xa4cb\xed\x9b\x02\xa3\x82\xd7\x90\x0c\x19\xa3\xbc\xfcHG\xec:\x87\x04&U\x1c\xb5\x82gL\xaaT\xa7\x021cK\xec\x93R\x1f\xcdsH\xa7\xd6.\x15L[\xf7\xec?\x19\x0e\xd3\x96-8\xce\xb1oN\xf1\xc7^H.\x14\x9e\xe6\x0a\xc2N\xf9\x98v3\xe5U\xaa\xf4;\xca\xdcQ\x0e$\xf4\xba\x9f>\x9f\x16\xe8\xe34k8\xb4,!`\xa4\x1a\xa9\x88\x8bV\xea\xcc\x8b\x8521\x14%b;\x07\xc4\xc5GnX\xcc\xbeV&%\xa6T\x0e\xda^k\xdb\xa3b\x06\"\x99\xe6X\xa4V\xacDtG~$\x8c\x92\xec41\xea\xffVy+_\xe6~\x82\x06K\xb6\x87\xfc]/*\x8d\xe1p'9\xac\xe2tW2\xff3fA6$\xb6\x88\xcd3\x95x\xd5x\xfa\xb0\xe4\xc4\xdbhm1\xab\xdc\x8a\xabI\xf9S\xb8\x96E\x88\xb6Y\xe8\xd1\x89<\x00\x0bL\x1c\xe4\x11p:xx\x13\xfe1g\x0e\xff\xaa\x19\xd7o\xe2\x14\x01,\xbdS\x9ah\xb8\x9b2\xa2\x12\x05\x8c\xe6\xd7L0\x0eB\xaf\xea=\xea\xdbH\x8a\x11E\xc7v\x03`\xf5i\x8fgJ;s\xb4\xcd\xcd\xb0\xbe\x1d5%\xad\x8f\xcac\xbc\x91 &b\xf8\x1d\x98\xd2\xf9\x0f@\xa2\x87\x119't\x1eX;\x0bUN\xee\x10\xdf\xdbWj[\x11B\xda\xd2\x87\xcc)\\;\x02\x0cV\xab\xd7\x88H\xf4\xdb\xe2l\xed\xa0\x14^\xb1\xb0\xba9\xed\x0c`s3\xea\x91\xe1p\xdf\x8b\x8bx\xfa\xcc\xf9\x00\xa3Rj,\x9b\x0dv\xeb\x05\xd0\x89\x8d\x11\\\xa29@\x05\xa2\xac\x84Rl\x15\xd3\xb9\x1d\x0f\x9b6\xf7\x9fB\x84\xe3\xfb\x86\xeb\xaf\x08dW\xe1\xc2CX\x0f\xfa%\xce\xd8\x0d\xa4a\xf1O\xb2x\xfa\x96\xabj\\\"y\x0dGVtI\xbaG\xfd\xd0\xd5\xda\xf5\xf9\x17\xed;\xc5\xe7\xa9\xb4\x99\x11Z\xe9z\xf9-\xc9#X\xe2Q\xca\xce\x90\x8br$h\x04\xfe\x1b\x0c\xcbu\xb8\xb4\x10\x06u8\xe1*\x110\x90w/\xf5\xf3\xd0\xf2\xc0\x09\xdd9R[/LnF\xb8;\xc4\x0d\x13\xf3v\xf9\x9c\x9d\x9f\x02\x0c\xc3\x8cx\xcfJ\xe1__\xe1\xa0hR\x8df\x04\xf9\x82+\xa2%4\x98l\xaer\xa6,\x03\x09{\xa1\xc2I\xfe(e\x8e\xbdC\x03Lt\x7f2\x9d\xe1\x96%\xac-\x9b1SF\xa6\xb2\x9fY]\xc3g\xa8\xde\x09@\xe5\xa4\x17\xf9\xd4pkK\x1fz\xb1\xa0\xcb\x1c5\xcaw\xc3\xf0\xc1|\xa7\xe3\x0e\xd6C\xab$j\xe3\xa3\x042\xe5b\xd8\xca\x8fa+\x1f\xccA\xacV\xd7\x8a\xd8[\x97)p\x89\x0bNz\x89dd\xc1\xd0\x14F\x92BMd\xce\xd4x\xfak\x1b\xfdQ\x80Y\x81hcb\xa4\xa62\x92\xaa\x7f\xf3\xd7_\xfd\xe1\xcbO\xe0\xdf\xeb\x7fJ\xb9\xed/>\xf9\xed\xa7!\xe1\xb5\x8e\xe6F\xbf\xc8\x0c\xbf\xd4[\xacW\x03\xcdL\xc2\x15\xa9\xd2\x8b\x05+\xe9\xb5`5\xaa\xfa\xc5\xe0\x97\x9f}\xf5\xdb\xcf>\xff\xfc\x93\xdf\x04\xb0]\x96uY`\xb3\xa0\x82\xd9L\xed\x9fc\xba5O\x83\xb4\x95fW\x19z\xf0V\x16\xdcH\x89 \xcc\xfd\xb7\x00\x93\x0a\xbba\x85!\x92\x0a\xe1\x94@9l\xa4\xcd\xa8\xdbxe+j\xaei\xbb\xbd$\x05\xd7;\x04\xe2R\xdbq\xf0\xeb\xdfA\xfdF\x96\xd7NI;y\xee\xb1,\x1c\x1b\xba\x08\xe9*\xc4\xb1\x90\xbdB\xa9\x1a\x88\xa3\x87;6\x1a)\xcd\\\xad\xe3N\xaeM9ok\xbf\xde\x00\x89c\x0e\xa2DY\xc9\x0a\xb8\x98\xc8\xe7\x12t\xc3\x0bLq)\x8c\x82A\xe7I0>d\xab\xa2\"\x19T\x7fO\x89:\\\x16\xe3cSf\xb0\x0aw\x1a({\x04;z\xd5\x14\xc9\xbdd\xf6!<Kt}\xaf{\xe1Tw\xf3X\x17C\xb5-\x93cG\x81\xc5:\xb8q\xb1\x7f\xe2O\x84\xf0=6\xf2\xb2\xdf\x05\x0c\xf3\x9ch\x880\xf5\x09r\"D!\xc1\xc1*\x0c\xd1\x8c\xca\xe8&\xd0\x81Q\x9a\x89\x1a5\xf3]\xc6\xef\xc6!\x88\xdb\xfb\x01\x11T1\x13\xdeW\x8fo\xaf\xb5<D\x88\xba%\xad\x93\xe3\x8c\xd76kJ\xda\xda\x95e\xfd$T\xec\xa9\xd6\x92\x9d\xd3\xfa\x01\xcd\xb85\x96\x81\xaf\xb7\x84\xb8$\xec\xee\x8ar7K+\xd7\x9f\xa3z\x0d\x85?\xa7Q\xd7d]\x84#\xc9\xe2\xb5\x05\xf3\xc5\x86\xcd\xd0\x9c\xca\x1b9\xdde\xf9NH0\x01\xa6\x7f8\x9f2\x87\xbbSM\xec\x06\x97P+1,\x07;c\xf0\x1d\x89\x0a\xc7\x92\xceKC \x9eO\xae\xdfp\x92\xf0AEi{p\x15\xa0<\xdcm\x91\x0e\xf2\x16I\xec\x06c\x99\x18]C\x14D\x97\xa4\xb2~!\x1b\xe1i~\x14\xc5\x8fC@\x86\xc7D\xfd\x10\xf730\xc8\xa6\xc5\xc9\x85\xe0\xf9\x91y\xce\xf7\x18)q\xa1\xac\x17VDx\x02G\x94\xdbeY]1\x0fp\xe4\xac\xb7F\xc8\xe3\xad\x10\x07\xc4#\x10=\xb0\x97\x18\xae\xbd\x86\xa5\xa7\x1b\x07OI\x0b\xae\x94\xa3sl#\xa7D\x0d9\xec\xd4\x05\xd5\xff\xe0H2ly\x12\xa3\xda\xe9\xa5\xbb\x8dtGb\xf9X\xb5xK}\xd05f\xb8T\xf0\xe4\x85\xc1\xc2I\xfb\x88\x80=>\xce\x88\x8a>\x06A\xba\xe5\x0c\x9a\xd60[*\xb86uJ\x8c\xfb\x9b*\xafu\x81\xdb7\x1fzm\x92a\x17\xae5Y\x98*\xed\xa9\x0e-h>(Td\x02{%\xb3\xf1u\xf1\x84u\x96\x90Z\xb2O\xfabK\x0aY)b\x88\x13\xc4\xe1\xb2`\xc5z^\xe0\xd0kf\xf0u\xf0\x01\x19m\x83\xf4/\x8b\xa4\x0ea\x9f\x14\x8c\xea\x08\x123\x1dO\xda*\xa6F:\xb73\xd2\xfb\x8f2{S\x89\x18\x07\xe9\xc29\xf4\xf23\xa9 \x03\\M\xc7\xd2\xa5\x02jB\x1c\xc4\xd0\x80\xfa\x0d\xe4YcH\xe6hn\xea\x8e\x00\xe9\x13v\xbe\xf0NKkn\xfd\xbd\xcc\"\x1c\xc3Jiy\xac\xbe\xc6\xb4\xbeJ\xd5\xff\x14m|\xe7c\xef\xf6\xa1Q\xc8\x0d=ga$\x8e\x88\xfd\x19V\xeb\xda\xd4\\Hr\x95\x96\xd8\x80\x90dK\xc0\xac.`(U\x91\x8a\xfbg\x81\x03\x83[\xf29\xae`\xe9|\xb6\x8d\xe11\xef\xc3\x8b\x94}\x06s\xcf\xed\xb3\xe7\xb1\x01\xebr\xc2\xab\xad\xa2\xcc\xe3\xcb\x01\x8a\xfc\xf7\xe0\xf1\x1d\xeeoh\xca\xf4\x92\xe1\x99\x9dK\xa4\xef\x9dpA\x8fD\x8a\x00\xa8?\xe8\xbb\xe6@\xe5\x8eP\xf4\x1b\xc1$'\"\x90\xdd\xeb>E\xdf\xa7\x14\xd0\x84\xfe\xd2\xba<\xb1\xd3\xe3\x18\x1b\xff\xe9\x14\xed)\xff8<h\x9b<a\xdd\x8f\x88\xb0\xe4\x1dzp\x0b\xe8R\x9e\xa0\x8aQDvkbh\xd4Q\x16M\xb5DP#=\x0b\x05\xc6+\xf5\x9f'\xc4\xf5\x1eF\xf5]\x9c\xc3\xa8\xd6D\x1c\xcd\xf1\xc0\xc7p\xcf\xa0\xa3b\xbe\x83Y+!\xf3o\x93q\x92\x13i\xd4\xbd\x1c\x0f\xdd\xb1\x8b/W\xdf%\xc7\xf9\x19-\x0a\x81-\x16\xb2\xfa(\x01\xac\xa1\x88\xe1\xfb\xb7\x00\xcf\xfff\x80z\xa3\xb8q\x07~\xd0)\xa9Zx\xb7\xd7\x1fI%\x98\xc0\x97\x97P\xe8Y1\x9e!N\xed{X\xd12\xc1\xafG\xd6\x84\x12]\x85\xed|FH\xf7\x0bA\x94\xdf\x9c\xb2\xb3\x84\x14aE\xd8\x08a\x8fGJ\xc4\xf3\x01\xe8\xd7bX4\xe1*6\xe3\xcc\x04\xa5\xbb\xa9\xfa\x88\x9a\x90j\xda~KL2}2\x00[y\x853\xa54\x10Y\xcf\xad\xd4f\xb816\x85\xbd\x8b\xf3\x163(\x96s\xa0\"\xfd\x03\xc8rC\xa9\x0aU\xff\xb1+\x13g\x071!\x97\x82\xc7\x92\x88\x0eM\xe7'\xa4\xa0/@\xc6\xf0\x8d\x94\x16\x1b\xb3K\xe5F\xf2\x13J\xa6\x0a\x1f\xaa\xb2\x1ea\xbe/\x18\xb3\xc8S\x13\xe7\x17r\x806\x8f\xf3\xedz\xfdH\xe1\xc5\x02hdD\x9c'O\xc9T*\xbb\x18\x18E\xdf\xa4$\xa6;\x05\x93~\x9a\xfc\xda\xd9\xca\xb7\xa9D\xe3\x19\x14\xea\xb0\x1f\xbb\xaco\xf9\x85\x0c\x12(\xfe\xf1\x06x?&4aB\xbf'?\x87S\xf0^\xde\x8aY\xda\xb0\xb6\xad\xeb\x02\xb0\xc4Oc\x02O\xd3I\x09h4u\x07\x09r\x16`\xcd\x92\x09\x13\xd5\x93|'\xa6\x09\xd8\xbc/\x08\x97\xa4\x96\xc5\x02\xf7\x04\xcbj\x1b\x96\xb4\x13\xba\x9f\x90\x7f\xe2/Qx\x11:\x97rt\xaf4#\xaaK\xccf\x85\xcd.\x9d9\xfc!\\MZ\xbf(q\x0f;D\x12\x9d\xebj'\x8b kNh\x91-Q\x80\x96V=}\x86\x7f\xa7\x90H\xb4\x17^\xcaz\xeb%\xd6\xaeCn\x9a\xc9\x8c\x0d\xf3\xf9 ]s\xd8=\xfe\x8eJ\xf5\x85\x09F04\x8e\x1c\xb0*\x9f;\x82\xd1\x15E\xec\x84\x8c\x85\xa8xAX\xb1\xbe\x85;^\xc9\x15\xfe\x1a^\xec]\xf7\x0a\xdf\xcdt\xb6\",\xeeJ\x90\xbf\xd9EwK#\xae\xbc)m\xe4n\x9b\x12F\xf2\\' FZyo\x06r-\x85\x98!\xd0\x9d3O \xa9\xa5\x10\xb6G\xdd\x03oY\x8do\"e\xc2V0ZE\x86\x85\x07Yy_rl\"\xeb8a\x05\x87\xc1\xb9\x16\xd4$\xe1\x9cy\x9c2\xd5\x96\xab0G}\x01\xa9\xa9\x99\xb2>Bb:f\x0a \x1d\xdf\xf2^\x1c\xf7g\x8a\xcfD\xbe\xfb\x89Q`\xc7\x95\xae&\xb4\xe4\xba\xd5-\xf2\xfa@F7\xccmL\xf2\xa1'{+\x01\x95\x18\x8d\x9d:\x00 \xd9=\xd9\xb9N\xf7S\xdc\xe3\xc6\xcf\xab\xd7\xe2\x88\x0f\x8dz\xd1\xf9\xbc\x1b\xaa\xd0o\x1d\xf4\xe13\xf5\xb6M\xc7S\xbb\x9a\xf6b\xbf\x892I\xc5Q\xd4@#E\xf1\x1b\x85\xfc\xc6C\xac\x97w\xad\xdbC\xd1\xc6 \xf3\xd0\xbd\xb8\x04\xb3\xed\xf3\x90J\x8an\xda\x148\xc2*\xcd\xb6Qq\xda\xb0\xb2\x8bI(w\x7f\xdf-\xb9\xff\"\xe3\xdd6S\xa8\x14\x9fr|\xa3\x9c\x87\x82}\xe7\x98\xceL\x88W=.fR\xba\x8f{\x1a(JG\x18dw{\x05x\xce\xd5\xf7\xe0\x1e\x8c\xb8\xf8r\x03\xd9\x96\x97\x09\x0cB\xb7\xdd\x9f(\xc6\xb8G&\xe8\xb7\x87\xf9e\x1d\xe5ScC\xfdR\xc8\xc0\x93g\x7f\xa2k\x07\x1c2\xc2\xf7MFH\xea\x7f^\x12\x0b\x07\x19Dn\x1e\x99\xe2nAQ\xbb\xd8\xb1\xfbRr\xe4/1\xa90\xe6\x8bJt\x97\x05d\xe3\x83\xde\x85\x85x\x01\x03\x93r:\x82{\x16\x82\xb7U\x04\xdc%\xd8\x0bn\xb8\xca\x18\x02\x01$g\xaf|\xc1\xeca\x1c\xb7\x1c\x05\xd4\xf2\x05\xf7\\\xfa\x94\xc8<rR\xd8{\x001\xc5=\x97F\x95\xf8\xf4\xd5\xc0\x92\xe2\xc6\xc0Ui\xd2YbP\x85X\x8b\xde\xe6Y4\xcc\xabN[\xb2\x9de\xfa\x1d)\xc7\xaa\x94\x88V\x0e>\x9bq\xcc*@\xd6\xf0\x82)j\x9c6\x00\xdaB\xe2d\x0d\xcbEty\xfa\x9bod\x05B\x82\xad\x9eH<\xf9\xf7\xd1\xf4\xea\xf6pA6\xc4.\xca\xb7Du$\x04c\x95c!'oL%\xf1\xe8\x1c;0f:\x97>\xf6B\x10oow\x08\xb0\xa2\x7f\x1a\x19\xbc\xa7-\xd5\xc4\x19\x1fR\xaa\xe9E\xb5\x85\xc2\x8c\xb8\xac\xa3\xa9\xe8*\xbb\xcdt|@\x95A\x9eY\x86\x14\xf4\x1dB\xb3\xda\xedGV\x9eu\x13\xfd\xda\xed\x1d\xbfC!^$z\xc7c\x1b:\x09\xfc\xd6;\xc6\xae\xeb\xb8 \xf7\x82\xe6\x8c\x97*\x00\x04C#,7\x9d<L>\x95\x7f\x13r\xfc%A\xcd\x02\xec\xeb\x95h\xcd\x1bp\x93\x80(w\x0e$\x98,\xba\xc2%\x07\x0bR.\x0aW1,r\xac\x10I\xe6h\xb0\xdb\xdf\x0c\xea\x9b\xee#Hg\xe7oc\xa5DE\xb6:\xd2\xc2\x95\\\xd0\x91\x1b\x1f.\xc2\xce\xc5.\xb8\x14\xc2WB\xce<C\xc7\xc75.\xd4o\x8f\xad\xca\xafu~\xd7I\x9f\xc5F\xd9\"~\xbd\xfb\xea7\x901\x1f\xc3\"jN#\xf6\x07\x87\x1eC\x80\xa0 \xb2\x08\x09\x8f\x97\xa9\x82\x09\x0c\xc8\x07\x82\xc9\xacD@\x19\x17\x97,\xbc(\x8a\x9c$Oe2\x05Xx\x10\xfc>\x8e\xc2{\xff\xb4\xcb\xf6~\x86R\x16M\x0c\xd3\xb7\x1c\xf3<}\xa0DxH\xde.\x01Y\xf6\x96&`*\x1f|,\x1f|\xd1\\\xd0UKy\xcd\xfc\xdb+\x19$S\xb1\x84g+\xe4\x95\xe4i6a\xe8\xc5D\xd7\x9d/()L\x002J\xbeX\x89E,\xe7\xac\x9fy\x00+\xe4\x02\x95~\x8f$\xa1\xe3\x98\xcd\xb3\x8b\xe9M\x99\xca\xb8\x8a\xc6\x9f\xda\xfenN\xea\x1fSE\xb9\xbb\xb8\xb6}`\x12\x0e\xb2'\xc2}\x17jo\xfc\x9e\xc6a\x13\xe4\x10\xf1\x10\x19PS\xd2\xe0\xc0e|E\x1a(u9\xfd\xb1rx\x0d\x1c\xfe\x09\x94^\x98s\xa27\xc3\x0c\xc7\xf50+\x99\xa9\x92\xb3O\xcd\xe8\xdeG\xdb\xd0g\xce\x0e-\xa1\xfc;r\x9f\x17\xa2\x0bK\x99\xdb\x8aT\x19\xc26\\P\x82\x04\x82\x8d%\xc9\xac\\\xc4:\xa5h\x16$\xe2K\xc8c\x9b\xf1*\x99\xf1\x84\xbd\xf8RJ\xe3\x9cp\xe7[\x1e\xfe\xc9Q\xa0n\xf33}\xb8\xbe\xc4j\x92#\x02\x841u\x1f\xdf\xa1\xa7/\xc8\x19\x85\x12\x94\x06,@\xa4\xc2m\xbc\xa9\xc4'\x83\x89\x8bd4D^s\x82\xec\xc1\x96\xb0\x07\xec\x87\xe8@\xc0\xac\xba\xc3s \x97\xe6\xa5\x84\x99^\xa2\x99r\xc7+\xc9\x9d}G}Z\x90\xcb\x97\xfb\x82\x04\x17\xe3y\xa0&p\x86\xc0\xc8\x9f\xb3r\xb13|\xf4:W3E^\x840\xf6#v\x82\x90PQ\xe9\x0ay\x8eC\xda\xb9/]\x07\xb8dI\x14\x96\x0b\x99U\xe8u\xf2s\xc2[%\x1bD\x9fs\xda\x96\x90j\x8dc\xac\x15\xee{G|\xe4t\x0c\xc6\xa37?\xea[\xb9l\xccX\xb7\xa7\xc4\xee8\xb1\x04\x84c<\x05x\xf3y\x0a\x91\x8b\x83W\xbf!s'r\xa99\x0e\xec\x11A\x8a\xeex\xed\xfa0\xe3Ruv&%\x02\x08\xfaI2Id\x94\xec2\x8a5\xeay\xec\x0d\xec\xe7)\xedl\xb7\xd5i\xb1\\\x1d\xbc\x0e\xdf\xd5\\\x9f,w\x00?x\x80\x08\x00\x18\xd6\xe3\xe1|\x1e\x1feBv\xc4\xc0\xb2J\x9d-\xc1+\x85j$\x90\x9b7#\xc96\xb2\xe07,C\xa6\xaf$R\xe0\xf3\xe8\xc3\xbe\x97\xcc\xd6\xc8\x0f\xbb\"8\x00\xb4s\xe0O\x04T&\xaa\xa1\xa1\x85\x95#\x10\xae\x13\xbf3\x02k|\xaa\x92\x05\x16\xb1\xce\xa5\xac\x04\xab\x18V%\xb4\x8c\xd1\x0f\xb41\xec>Rwv\xdat\x80\xdec'\xc3:6\xe3(`L\xfaz\x9db\xee-ee\xbeN\xc5\x0c\x98\x86\xcd=\xff\xdc\xbb\xfd\x80\x01P}u\x9aaE\x1a\xf8\x96\xb2u+)\xd7~k\xbb1\xcf\x15s\xf5\xa5\xc1\x88\x95\x92\xcf5\xe3\xack\x98\x0a\x03\x01[\xac\xd2^K\x9e;Yh!\x0bS\xd1av\xa4\x17[e\xe4:x\x1bT?\xdb\x86\xab\x9e" +
+	"o\xc3\xe9\xce$\xa5\x8ay\x1d\x96S\x99\x10\x19$g3\xfeZ@\x836<\xab\xbd\x18\x11$H\x13\xcd\x1bcw\x84e\x8dh\x9bK\xee\xba\x11\\i7X\xd0\x82<\xe1Dd&\x85\xe0/\xcf*\x0f\xaf\x17h\xb6\xb5\x92;\xf1z\xd7\xfb\x1f8\xf9\x1b\x1d\x80z(u\xe3\x81\xa9\xf5s\x0f\xd9\xf0\xa8\x03n\x96\xdd\x9c\x0b\xfeW\x03\xae\xfeR\xfeu\x92f\xe47}\xfd\x98\"x'c\xd3\xfc\xeb\x1f>\xc7\xeb?H\x85C\xd1\xd7UJ\xeb\xeb\x91\x91\xe9\xaf\xab\x0cP\xc7\x83\xef\xbe\xd8\xe0\xc4\xbe\xaa\xbf\x87\xe1\xc5\xd3Jv \xe7(`\xd9[\x89\xde\xdd\x1dS3\xcb\xdb\x89\x0d;7:;\x89/\x14\x13\x9dX\xb9?\x11\x1c\xdf\x0b<\xbdx\xa0d\x09MFn,\xeb\xb4Q\x19\xa3\x95\xb3XV;O\x94\xc8z\x8f\x1a\xfc\xee\xd0\xb1!\xf8b\xaf\x81 f\x01\x07\x0c?Lh\xccq\xea\x08S\\\x84\x06V\x9b\xaf\xeamP\x9a\x91jP\xbe\xa2L\xa5\x82?P\x18\xb6\x85\xaa\x9e\xe5J\xcc\xe2\xd2\xa3\xbd\x83\x9c\xca\xbb\xa6H[\xd8\xe5\xe9\xbcqy%!MJ\xfb:7\xac\xbd\xefn\xf94\xa0\xc8[\xc3\x87\x8c\x88#\xa8\x97\x81T\x93^\x87)\x15\x88M\xea0\x85n\xf0J\xcaL\xb62\xae\xac\xff\x1b\x96\xb6\xca\xf4\xeb\xe2\xf5\xf7\xbd\\\xfe\xddm\x8f4\xf42\xe4~!\x9b\xb2v\x8a$c\xa0J\x1f\xcah\x19>Wl\x0f\x1a\xe0\x8b/.0K\x95O\xcb\xb6\xf2\x88*\xec\x9d\xd5\xdfc!{}\xd1\x15\xab\xa7\xddt\xef\xda\x9d\xe7\xb1\xa0$\xcd\xb5\xa4\xe6\xb0(\x07\x01k\xb02#\x98X\\E\xd1\x98`\x97\x8dP\x08<\x9c\xa2~D\x84\x93\"\x8e\xc1\xee\xc5\xa9H\x7fu\xb4\xa5\xeez\x0d\xbb!\x1c\x9e\x0b%+\x82\x0f\x81\x99\x7fMh\xa9\xefuf(a\xc3\xa1\xa0Q\xaa\xc3\x9c\xf5\xb6\xb2\x1d]\xb3O\xc5\xda\xce \xe3\xb1 \xbd\xccK]\xda@g.\xec\xbaW@\xca,$]\x9a\xa9\xd48K)\xcd\x97J\x12\x86\xcc\x98\xbc\x82`\x86\x99>3\xbf\xd2n\"h)\xaeYf\xa8W\x13\xf1\xa0\xaa\x93\xd5\xdd\xc1\x92\xcf\xa82w\xd4\xed\xd7d\xc4)u\xb3\xf7\x0f(L\xa9fVV\xf1L\xa3\x06\x1dm\x00\xf2\xc6\xea\xa9\xe6D>1\x0e(P\x80\x00\xabV\xe7\xa3\x1f\xb6\xf2\xeaC2\xf9\xad\xae\x88\\\x13\xdd\xd8[\xd9\x88\xa4\xf6\x9fgz\xe0h9W\x9aN\x16\x9eg\x9aP%\x99\xfcR\xf4\x12L\xed\x01\x06DYr\xaf\xe0q\xc3*[\xe2\xb0~-2\x82\xbc8C\xa2\xb9O5|O\x89vc\xba\x84Q\x10\x18\xd2\xb4{\xb0hI\xe9!U\x17\x0d\xe6\xe8\x10\xe4h\xdb]W\xd8\xd9\xd6\x1a\xcd\xef\xe5zF\x01\xcal\xc1Y\x1a\xb3\xabZ\xbe2\xed\x95\xb2\x83\xb5k\xc1\xed\xb5f<A\xc9\x913\xa7@T\xa2[\xf1\xea+\xdb\xd4\xb2L\xb8t\xa3<\xd3\x0d\xa5wh\x95\xb6G\xe0L2\x06\xe0#\xd1\x9f\x18\xfe\xb9~\xaa#\xcak\xa6\xd4\x85\x1a`\xda}O\x919\xe8\x1a\xb9\xedvX\xe5\xb5\xecbt\xdb\xde\x93\x9d\xda\xd9;\\\x02\x9dB\xa1\x9b\xe5\x93\xedUzi\xa6y\xd2\xcd\xcb\xbd\xae\xdc\xb2\x07e\\\x80\x0a=_\xfb\xee\xde\x8e\x0f2\x8e7\x12'\x80\x92\xd2J$\x89\x1c\x12Z\xf2L\xa6\xf5\xd2\xfb\xfd7\xf8\xa2\xba\x1b\xc9\xf0\xd1\x8e\xd2\x96\x16U\xfd\x93\xef\xfaR6w\x9eJ\x10\xe1\x0dpd\x8b\x90\xf7\x0a\\\xd1N\x0b\xb6\x9b5\xfdTI\xb9\x91T|\xd8\x15\xa9\x0f\xa0\xfa\x1a\x0a>\x1d\xdb2$\x99\x195\x9f \x966[\x98\x02\xbe\xb0\x0eKR\xaa1\xfb %\x07i\xd9\xc7\x98*&\x888\x84\xf1|\xe0]]\x98o\x05Oi\x14\xe0\xc3\xa5\xb3\xc0\xf1[oc\xd0\x13\xe9\xfa\xb5\xcd\x17:\\\xab\xa4|\xce_\x84\xac\xd4]'\xe7\xea\xde^N\x94XH\xc3\xe1\x1e-t\xd7=\xade\x05LZ\xc6l?\x1fe,n\xfb*D\xa5y\x8aQ\xd1\xdd\xcc\xc7\xa1\xc4+rH\x92k<\xb8\x01tsa\xe4\x93\x13#\xf8-\x09\xd3\xe1;\x89G\x14\x8b\xd6DMI\x0f\xa4h E\xae(!\xef\x05!\xca\xb8m\x06\xf6sg\xdc\x8c[\xc1\xa6X\x1eV\x9c\x0c\xb7!\"\x16,\x16\"\x8fw\x15\xfbqe\xe1\xff\x9c\xcb\x09\xde\xef\xd8\xa5\xe2\xc6\xa9\xf3\x1c\x08A\xf4U\x8a\xc0\xfa\xa3i\xbf\xe8V\xed\xf2 !\x8f\xad\xbfo\"\x1a\xe8\xb9\xcd\x18w&\xbfS\xd4i\xf2\xc3^>\xccA\xdei\xb6\xde\xbc\x16#\xc7(L\x02\x0b\xd8B^\x92?w\xa2!\x10\x12\xa93\xf3&e\xde\x9c<vZh\xcb\xdd@\x19\x19Z\x84GT\xcb\xc2<\x80\xcb\x8ft\x14Jv\x02d\xef\xe7\xb4\"\xf7\xfc@4/\x9ek~\x7f\x05\x02S\x94q?\x1f\x06b\xef\xb0\xff\xbc\xe6\xc9R\x82~;R}Y\x19K\xa1\xe8<\xc8\xd1\x8a\xe8\x82\x1f\x92\x1b\x1d\x0b\x18\xbd\xd4\xfd]F\x0cv}'\xda\x0e\xe4\x92\x11Gi\xff&\xee\xfd\x17\xad\x0a\xe2\x9b\x84\x85\x09\xf3l,\xfd\xc3\xcb\xcf\x10\xb47\x92j\x8f\x80\x13\xd3^\x94\xc4\xea^\x86\x15u\xc1\xad\x98\x91\x91\xf4\x0d\xac\xfe3\xaeT\xa2\x94\x0f\xbb\xadt\xd6\x1aA[\x0e\xa3\xa1\xe0\xb7\x92\xd8\xac\xfcP\xe5\xc2+A\xe9=\x87\xe6_?\xc0\x17;\x1d\xa3\x8c%\xc9PV\xf5m\xf7#l\xe5\x1ea\xaa\xaf3\x88\xe2\xdc\xe8\x16\xa5YC\xd1\xbe\x0d,\xbc\"\x99A'u\xf4/\xa3\x1fk\xbc\x17\x1be\xd3o\xde\xe2\xd7\xda\xa9\xc9\xb63o\x12\x9d\xcf\xc4\xad\xe0\xd6\x06Q\"\x90\xaa\xd0\xb0b%\xac\x17\x9b\xd3\x85xug?;\xed)\xc1\xcc!\x17I\x97\xaf\xbd\x9f\xa5\xd6\x115Wn\xe1\xfc\x93\xd4\xe0IQ\x18\x9b4\x0f\xee\xef\xe6F4\xbd\x1d\xccys\x1a\x9ay\xf4\xc0\x88\x8d\xc9\xa7+\x85#\xce\xb5z\xf4\xa4\xfbj\xfa\xf9W\xd1B<\x88\xd7\xbf\x94}\xad\xa7\xb8\xbf\x99b\x81\x9d\xbe\x05\xa7\x82\x00\xf9\x11S\xfay;s%\x93\xe4\x18ek\xb8a\xfb\xc5\xd2a8\x0e\x03\xab\x16\xa3>?\\tnE\xf5\xdd\xd7\x81\x87\xbfH\x0e\x13\xa5\xff8\x95\x0e\x0eo\xee\x8c\x8f]\xc8\xb6k\xed\x12\x03\xb6}\x0e9\xc8\x92\x19:\xae\x19\x14\x01\x8c\xf0\x99\xdc\xb0\x84\xedS\x1bZ5@\\\x9b\xf4\x08zf\xd5\xf7s\xd4A\xb8_\xb0\xe3\"nN\x88\x15\xc8TD\x82\x94\xf0\x07\x1cE%\xc1\x95\x0d\xd7%\xbb\x0a\xad\x02\xc3H\x98\xbaM\xba\x17\x0b\x12?\xb1[\xe1V\xf7\xe4{\x11\x8a!\xf7\xba\x87\xdb\xa3\xf2\x17\xad\x12\xa5a\xca\xefBR&cL\x14\x18i\x8f~\xec8\xfb\x07\xdddM\x0e\x02M\x95VK\xd5\x88;\x96\x0f\x18\xf1'\xe4\x9b\xbe\x0e\xff\x09|\xb0Bzy\x14\x9d\xa1X\x83\x16|\xb2\xba\x80\x04\xbb\xa69Qw|\xee>\x8d\xfd\xc3\\\xfd<O/\xa1\xc4\xfd\xd5^v\xf8\xefEnEV\xc8D\x16\x86*\xe1\x80\xe4L\xdf8%V\xfbq\x03\xd2\x89\x83\x16'\x90\x0cc\x98\xb0KDZ\x18\xc8\x1b1\xf2\xd7\xef\xf0\xd3x\x0a\x08\xaeg1zr\xc2K\x15\x16\xd1\xd6I\xf5\x8d\xc9\x92Yf\xcec\xb9\x9bn\xb9\xb2\x0d\xda\x84\x19%\xea\x0d\xd2\x1a\xa7\x98\x064\xa6\x82Q\xcf\x90\x91\xfb \xc9\x1e=^U\x1c^\x19\xb0\xc3\x89[\xa4>I\xa1\xd33t2\xbcAwx\xcc\xc0\xb4\x05\xfds\xfd^\x03d+\x84\xc1I\xcd\xfd\x98\x8b\x0dt\xb8\xa7\x95\x12HN\x1e\xf7:\xb6\x0f\x04\xc7\xb9Nwg\x13\xd5*\xc7s\xc4\x02(\xbd\xd8\xe1\x06!\xcd1\x87\xdcr\xa1\xed\xbdLo0e\x84!\xe6cq\xc1<\x00%\xe7\x16\x12\xef\xb2\xc4J\xa8dn\xe7xm9\xb4$\x97:kq\x04\xa72\x86O\x96\x87G=\x91R\xa7c\xa2~t\xda\x93_\x9b\xa0e\xdeL3\xd7\xb1\x89zEV\xd7i]\xdcn\xdc\x9f\x97aN\xd2j\xa5\xe7\xa6\xd7\xd5\xa6\x93\x96\x14\xe0R'\xea\xcbTLK5\xae\xda\x1eU\xb1\xa1\x88\xb99\xdf\xb6s\xa0\xf5\xa20o5\xc6D\xad\x15\xb4\xcd\xa4\x1f\xc1\x8fiF\xfc\xab<\xedk\xdcL\xb3\x90\xe6\xb9\x90\xca\xb9\x9a\xa9\xca\x9c\xa6\x81\x9a\xe1m\xf8\x86\x89\x15\x82\x95\xef\xe6\xda8\xd8\xed\x1f\x09\x8a\xa6\x0b\x00\xbb#\xed*\xf4x\xd9\x17\xb3\xe9m\xee2\x87\xcfV\xe1u_JJ5\xf35\xf3\xbeq[\xa0\xa3\xdd\xb5\xc3\xf7\xda\x98e\x15\xdf\xf8=ri\xc6@\xfb\x15\xa2\xb5\xa2\xd22\x0d\xb1l3\xff\xc6;\xa8\x12?P?\x7f\xca\x860\xd6\xfa\x17\xfd\x12h\x07\xfb\xb6\x89Hg\xba\xa1\x15E2\xb2\x8aR\x13\xe8\xb8\x19O\"G\xe4,\x83,S\x1b\xf7]\x12@Y\x01\x1fk\xd5A\xfe%\x01l\xe2z\x1b\\k\xac\x8b\xa5\xdc\x95Z*\x12\xa0\x9e\xbd\xa3\x07-\xa4:\xdd\x08\x92'8\xedB`\x8fO>\xa7 \xb6\xfeq0\x11e\x18\x9b\x12\x1d\xd8\xa4\xa3\xda\xd2QRA\xe2-\x12\x12\xc4?\x0a]\xf8\xf5\x18\x0d\xcc\xbd<x\xc7\x92h\x98\"pg\xee5\x97\xb6\xc8\xc4\xcdr\xb4y\xf2\xc2}\xf2=\x07\x87\xad\xc5\xe7\x96d\x151:\x8e\xe93p\x8a:6\x01\xa3sM\xc8(9\x1e\xbaV\xd9\xe1\x8b\xb6\xd6\x1c\x8d\xeace\xacz\x19\x07O\x05\xc26\x86u\xa3e\xe0\xa8\xd3\xe2\xe1\x04\x93\xbe\x05\x08\x817[e\xfb\xd4\xaa|\x96\xc4rU\xdc\x14\xfd\xe7\xd7\x0f<\x1c>6\xdd\xf9-\xd6>\x18`\xd9:2\x89\xba\x8ct\xdb6\xe1\x8f:\xdc\xca\xf3\x13\x84\xa7~\x05y\x97YTGO\xc2,6\x7f\x89\x148Sb\x95N`\xa2\x85\x0b\x9e\x15\x0aq\xb8-B\x14\x97\xae]3&\xcf\x14\xaf\xd7\xc8U\x16\x90n\x15\x0b-T2,\x93\xde\xa5VT\x15]\xb0>\x8cN\x10\xf8\x89\xe2a\x7fI\xb9\xc3\x9dv\xf0\xc7;r\xbc\xab\xb4lb\xf6\xb6\xacYJ\xb53\x84\x95\xc3\xa3A\x05\"\xae\x8d\x9b\xb4[ CK\xb9\x8c\x1f\xc7+r#z\xfe\xcd6q\x1cS\x8b\xe6\x91\x84\x19\x81G$Bk\xd3\xc5:\xdc\xcb\x1f\xd7\x97Ie\x859\x1e\xef5\xe6\x04ox\xd5\xb6\xa4$U\xd3\x8a\x88\xc2.\x9a\xc2YSz\xe6\x14\xe8_J\xe3\x15\xa9v\x9dd|\xf9i\xbd\xc8\xc3\x8d\x99\xe1=V\xbd\xb9r\x18\xde\x00\xf7\xcei\xbd\xe0?\xaa\xf8U\xd5v6\xb2\x88\x07\x9e7\x7fG\x9eK\xa1\xd1\xf6\xd2\xc8\xe9\x90\xd6o\x1cpcH\xd9>Pz\x8am\xf9\x18\xf2\xf0\xf0\x11\x8e\xe4\xa6En)\x83D\x1e\xba\xc2\x07{\x80\x15\xe2\xcb\x8eo?\xc8\x97%\x02|\xaf\x97\xfc\xe3\x8b\x17\x96\xc6\x18=,\xffI-\xed\xc8\x1c\x90\xd7\xf3\xfa\x1e\xde\x03:D/\xa4,Qx\xa6\xbbP6\xbb\xd0\xf4\xda~\xf4\x8d\xaa\x11\xd2\xf9\xb1\xed\xbb\xa5\xd7\x97\xed&\x1c\xc9+\x10\xa5vt\xe9\xd5\xa2\xc2\xb6%\xf5t\xb7\x9cM\x17\x9aw\x0bZ\x86\x9cn\x975\xb0\xa9h3T\x85}\xea\xe9\xb7Xx\x02o9<d\xb1\x96\x17\xde\xddo]T\xa9\xf3\xb7\xc8\xa2\x9de%`\x89z\x8a\x96\xf9OE*\xc2\x96\xef\xd4i\xc6\xa1\x17n=%#n(\x98H*\x19\xacv\xfdty\x9f\xe1\x0b\xce\xa1\xd2\xc3\x0d\xa47\xb8d\xe2koj\xa2g\x8a\x02\x92\x12/\xc5}\xac\xfa\x87\x9b2\xb4\x8f\xc8b\xae,G[/\xa9P\x19I\x9c\xd2H](\x9a\x02\xe4L\x89\xbdi3\x9b\xd04} \x98\x1d\x1bIvDP\x8e\x80\xe8K\x91\x8d\xc4\xe9\x08\x05C\xe9l\x89\x1e\xbc\xdebV\x99&\xbcV!^\xdb^\x17O\xab\x1b\x15\xdf%\x1av*\x03M\xac\xe7\xd4XE)J\xb5\x8d]\xc9\x1b\xe8\x8d\xcc\xf4\x96\xcc\xe27zr&\x0d_d\xfa\xf4$j\xabl\xa1u:E\x08\xea\x0a\xc2\xb4\x8c\x8cJ\xf7L\xecx\x0f\x82\\-\x97p\xd4\x9d\xe4\x10\xe0j\xc6 \xd4;W|\xa0\xb0\xc3U\xbe\x87)QD\x03\xd2\x9c\xa8&\x8a>\x8a\xe4/v\x93\xc6\xb1K\xa2\x06\x9a\xaa\x9e\x99o\xa1\x0ap\x9e\xf0\xab\xf4\xde|0\xbf%\x1ek,\"\xcf\xa3\x91\x08\x0c\x89l3\xc9\x983\x07r\xf7\x1a\xd9s\xa6\xc6\xb2\xb4%\x8b\xc6\x12\xb3ern\xfc\xa0\x98\\b\"\xb3\xee'\xdb\x97\x12e\x14\xde\x11q\x9c\x0aNty\x80\xc6\xe6#\xb44W09\x9a\x85\\\xb2\xa6Wn\x99\xf8T\xb7\x10\xc9\xabK\x8d9J\xa2\xc53o\xed\\\xd6KDp>\x83\x1a\xd4\x0b\xe9\xdb\x86\xf3\xc3\xc0\xec\xef\xa8f'\x8a\x8e\xc4\x82\xda\x92\x94\x96~\x96\x8cR\x12\xce\x13\\\x1d\x80iQ\x9cy\x85<\xe0\xef\x00Tq\xc3\x92\x05n\xc1\x96c0I\xd1\xa228\xbcX\xba7\xeaa\xc6\xd4\x09\x8e\xe7\xb9\xba\x95\x16\xf3=N\xcc\xc7\x8a\xa8L\xc6\xac\xf0Ng\x81\xd38\x91#\xb8\xd7:\xc3\xd4\x9d4\xd2H\x14n)\x90*:.\x12\x0eUj\xa7\xebf\x07\xfe\x01\xa6aGX\xdd\xb9\"\xfbQ_5G \xd7\xf0C\xc2\x9f%\x19\xe6\xac\xc01\x9fAc\xa4e}b\xcb\xe7\x15\xa5\x00\xc3\xee\xc2E`\x10\xb5\xfd\xc5\xa5\x87\xacY\xa9\xcbI\xb2Y\xccAI\x14\xa9\xec>\xe4\xca\x91\xe1\xcb\x82\x1a4.\x08\x94ZK\xc0\xff\x090\x1f\x11`\xc4/\xb3\x16G)d\xcc\x9a)D)\x15\xc6\x13\x9d]\xbdQ\xaf=\xe6\x14\xc0\xceD\xd2\xb4R\x921LUu\x1a\x12{\x0af\x7f-U\x8e:\xc6v(4j[\x15j\x85*7\xbb;\x0f\x82&\xf3\x07Z\x13\xd0!:6\xeb\xdb\xeak$\xf0;\x80\x19EW\xf7\xa5Pt\xa1\x86\xd4o\x0cJ\x9d\x19\x9do\x81\x13)U\x8f\x92\xf1\x08pG\xebx\x99\xaa\x9a\xef\xc0\xbej\xf8\x9c\x04\xf2\xa3\xa9_\x07\x9e\xf00\xaa\x8b;\x1c\x07c\x84]39\xaa\xcb\x89\xdaC\xdd~\x1b\xd5\xed\xe3R\xae\x1f\xea\xdb>\xfa\xf6\xcdds\x85{\xf2X\xc4\xd0]\x8a>\xdd\xc2q\x8c\x15\xbf\x1f\xb3\xb9$\xdc\x1dOl-p\xc7\xee\xcf\xa2\xbd\xef\x10\x84\x8a\xe1R9\x8f\xbco\x18\x1e\xf7#YR8\xa2\xa3\xee\xc3y\xd2|\x8d\xf9\x05\x0f,\x8b\xa9\xa1\xeeT\xca]\xc8*\\\x88\xb1\xee'\xf9N\x1b\xe1\x8c\x8bvBH#z\xeb\x85\xd4w@J\xd2\x97\x90\xcb\xaaoqm\xc8sEDB\xb8\xcb\x8b\xc8o\xc64?)\xc3\xb7\x08\xa5\x99\x8em\xfd \xcd\x11\x87x\x92Y\xa8D,[\xd9\x9c6Gz\x01\xe5xh\x95\x9b\xa1R\x0b<\xd50[\x17>j\x8c\x1220/\x1a\xd1\x03\x93\x0b\xcb<\x1e\x0e\x0c\xe6\x07/_\x94&Xs\x85L\xd2z\xfa^\xd1\xdaZ\xf1\xca\xca\xdf\xf4-\x9c\xc6\xd7\x9c\xb0\x19\x04\xf2\xa99(\xed\xc7?a\xd7S\xf4\xa8\xe5f\xa2.J\xda\xa7\xf4\xa4Z\xf9\x05\xdc\x1a\xc3\x8aS\x88\xc9<\xcf\xd8H\xb4\xc4\xd0=\xd6\x87\xe1PMV\xde\xa1\x8a*\xd2E\xa0 \xe3\xae<\xf0\xfeN\xc1\xc3\x01U\xf5SJ\xbc]\xf0\x1f\xb5\xe3\x87\x8cR\xb2S\xf0-\xdc\xef\x01\xae\xd1L\xb1\xc6\x1a\x11P\x91r\x0cU\xcc\xa6x\xa7\x8f\x12\xf3g\xbfsN\x8aP\xcd\x11z\xf0\x15\xf1\xff\xba-\x8a\xd0p\x11AD\x95\xec\x1c\x09k\xd3Q\xd3\xe7O\xcf\x13\x9f\xb4\x84UW=\xdb\x0c\xf7\xf7\xfbv\x1d1&t\x1d\xebk\x94\xa1\x06{\x0b\x7f8cR\xf2\xb1A\xb6\x0awQ\x97 H5\x07\xd1\xd1\x97>\xf5\x02\xd0\xdfc\xad\xe8|P_\x93\xbbr\x1bC\xa9\xe8\xf5D\xa9\x8e\xe3\xc4jD\x0f\xe4\x98\xbaAE'\xb7\xe2s\xe5\x8e\xef\xcc\x15\xe3\xe2\xfe\x88\x15<\xc4\xcd\xab\xc2\xd1\x14c\x82\xce+^\x0a\xe6\xad\xabHN#\x8f\xe2\x95baW6\xe55\xe5\xea\xba[\xa7t\x12b&\xea4q:\xc5\x8aP\x1c\xe8B\xe2=h5\x878D\xf3\xe23R\x16\x92\xea\xf1;*\xed\xfeZ\xe2\x1b\xb3\x91P)E\x98^\x09\x0eKK\xc8!y\x95i\xf9\xc7\xce\xcbf\x02s3\xa2\xc0\xe9\x9cc%\xe9\xe0\xc8M\xf8#$\x8d'v\xc2\xdaoy/V`)\xeb\xc7G\xe8\xd7\xe5q\xf5vBD\xfbu\x9fu\x9bV\x91\x95\xaf\x984\x14\x7f\x9b$h\xb4\xe3h\xca\xc4\x89\xb83\x90\xc6\xbc\x09\xa3z\xc6\xb9k>\x16\xb5\xb19\x81\xc4y^\xe4\xe3 \xe3\xd6\xd9fV%r\xac\x842\xf0\xde%\xbe\xfe+\x8eQ\xc4o\x02mB\xfd\x1e\x07v.\x91\x14\xf5\x15\x13\x90\x8b\x9f\x18\x1a\x83\xd3\xa6\x907S\xbd\xc5\xa6-a\x01\x81\xdf\x11]a\xd52\x06\xccp)\x09z\xcbA\x8e\x87\x8e\x1d" +
+	"G\x1c_A\xc08\xd7\x8d\x05\x16\xd7EwhI\xf2\xd0\xe3\xdc\x91\x93\xf4Gy\xfe\xaa\x06\x02:\x8e\xa9W\xc8\x06\xc6c\x91\xbe\x07(@x\xf0\x95l\xb3\xe4/\xc8T\xb3\xd2\xbe~\x1d\xf6\xf3\xb9M\x87\xce \xffC.v0\x97\xe1\x1c\xa2ojW\xa9\xe5\xddu$\x8e\x04S\xf5\x03DQ\xa8\xc5\xb9T;h\xa1}\xb74\xb8\xe5\xcc\xfak\x92\xc4\xac\x98ST\x90j\x10\x13Oi\x0a(\x13\x0cSr\x98\x8e6\x14\xd7G2\xde+E\xe8\xa9]\x03\xdbga~\xc7\xd4(W\x0c\xccAPzj0%\xcaMHr:z\x06\xea\x19\xa4:\xa7HW\x91\x8f}\xab\x1b\xaa\xc4\x96uu\x8c\xb4\x94\x0371\x82\x1e^~!P\xb8\x99\x8d>b\xa2\x88\x1b8JY\xde]\xa1r\xcfkBFP\xf9\xed\x03<\xf2\x03\xfdi}\xadfZ\xdf\x03\xa1#\x1f\xb9\xe4\x0f\x14\x1coT\\e\xcf?\xfe\xb69XJ\xc8]_HU\xde\x15\x12\xb3\xc6\x83k\x11>\x8bK\xedVlK\xc3\xaf~\x85^KHr\xca\xa5\x1d\xcb\xc6\xac\xf6M\xf7\x16d\xdc\x82\xe6\x93Z\xb3\xc3\x1bI\x95\x03I\xd0\xc7\x05\x97\xa4\x7f\xb3L\xeav%\xa4\x06o\xa5+\xa3\x1cjG\xf9\xe6\x14ET\xed!\xdeL\xe2\x88t\x19\xcc\x9d\xc4h\xd4\xb7pz\x9d\xca\\0gc\xbcL\x8f\xcc\x8d\x09]\xb5\x8c*\x89,q\xb04\x19\x0c\x1c\xfer\xac)\x0b\xb2l\x18pd\x80O\xb4\xaek\xa3\xabq\x912Dq\x86\x83\x82\xa0\x8a\x9bv\x01\xa6Q\x7f\x8c\xe3\xaex-Hz\x10\xc6|\x81\xf6t\xfd\xebp@\xdb\xc2\xc5m\xb4\xbd0\xdb\x0e\x90\x1c\xc7M\xbf\x8e'}\x99\x9f>\xcb\x0c\xa58y\x85\x9cYX\x19\xb8\x0e\x1f[|\xac?@\x0e\xe0A\x0b\x9aG\xf9i\xfcSh\x19\xa1\xb8\"\xd5g\xfaH\xba5\x17\xfaV\xdf\xc6\xae\xa4\xf6\xf5\xba\x97\x96\xb9&R\x15\x1b\xe4\xff6~7\xb8\\\xa2\xe1C\xb7\xa1\x0a\xe3\x15\xc9\xf5\x87\xce\x15\x09\x0bw\xd6:\x01\xe3q\x91E\x8c\x8d\xd8\x02@\xd0P\xc9\x96\x95q\xfe\x88\x9a\x92f#\xcdA\xbc\xd6z\xc9\x84A\x00\xf8 \x17\x01\xe3?\xc4n\x8bH\x11\xe9L\"\xe1\xc87r|d\xb5\x8f\x98p\xc4%\xeb\xa5{\xc9@\x16P\x82\xee\xa5\x0a\x7f\xd0\x9d!8\x1c^R\xe4\x9d(8\x90K9(\xf0\xaf\xb0i8\x8c Fd\xcd\x11\xfe\xe4\x980qs\x8c[\xb9i\xe5\x1e\x0f\xa3\xf5\xd3\x0bFc\xf8v\xe9p}\xc4:\x8e\xbb*8r\x0d\xbf\xed\xca\xf6\x0bp\xd1\xd3\xd4\xa0\x86\xba\xe0\xcdZK)\xbc\xab\x90\x16\x8e\x15\x9f\xc2\x80\xdc)\x1dS\\\xa2\xc8jN\x94\xca\x10\xfd\x16\xa0\x8bK\x90\xe3\xab\xfa\x06&}b\xd9b\x98fp\xfdh\x80\x16{\x19\x93\x96\x17\x18R63\x9d\x9b\x14\xc2\x9c\x90f\xe5|\x80K\xb2J\x8bW\xe6\x18\xc2\xb5\xd7\x8fU\xd5\x1fy\x95\xdc@\x0dc&\xeb\xd5\xa7`6\xa8E\xfb\\>.\xba\xc7!\xb79\xce\xb2d\xcc@\x02%:\xec\xa5/\xfeE\xa7fa7V\x80`a\x9e5\x15Q\xaa\xdfR\xf5\xfc\x96e\x94\x0a\x89y_\xef\x9a\xb2\x19\xf1\xd4Z1&\x01~\x0b\x96\xa4`\x01NN#\x90\xf3\xc2\xb8\xa1W\"\x1d\xe4X\xcaG\x8e\x04F\x02\xaf\xba=\xc0\x03\xe1\x94\xb8\x05b+h\xcaF#\xc9\x84\x95\xb2\xcf\xf1\x0e\xb2\xd5\xdfd}\x18SoO\x9c\x13\xcbt\x19\xd6\x92hk\xb7\x9e|\xd1Z\xa8O:RqO\xc0\xda3\xa4\x7f\xdc\xeeFE\xcd[.\x92\x0b\xcap\x9b\xa3S\xa4\x15\x14\xa7\x88\xa1A\xe9\x94\x85tb.\xd1\\\xf1O!\xfe\xa5\x1cK\x89\x90\xcd\xd0<FEsJDA\x02\"?\xd5\x87\x16\xc1#\x9deE\x87q)n\xeb%\x8c\x1e_\\<\xf6\xc2ws,&\x99%b\xf4~#\xcb\x0d<\xf8es\x9a-]\xe7\x0b\x85/TJ)\xf1\xaf#o\xb2\x92\xc4|`\xde~7J\x18K\x1b\x10U\xd3\xe8\xf7<S3\xd8\x96G-\xdbrk\xa8\xdb\xdd\xfd\x00\xe0\x9d\x0e_\x14\x11\xab(\xd4&\xba\x80\x95\xa4\x92lN\xb1\xaf\x85\x9b\xc0^\x87\xa2\x92\xe0\x94V\xcat\x14\xbf\xc3w\xe3\x1e\x11\xda:q\xc9\x17\xee\x92\xcf\xbf\xf2V\xc7+C\xcf\xba\xc8\xc6{\xa7\x90n+\xca\xe6\xea\x11\x83Nl\x19\x1cn\x8e\xa1\xdbR\x95\xfaF\xd2\xfb\x91\x07i\xb8E\x94\x94\x15\x9d\xdf\xb8\xf9+\xf6\x12\x05\x1b#\xca\xf9JL\x1a\x06t\xeb\xcb\xfd1UbMs\xe6\x99\x84{\xd1m\x93\x1e\x9a\x92\x98\x0c\xbea\x00qW\xd2k\x8b\xfb\xc1t\xc9-:t\xc0\x18q\xce\xb1\xe9k\xa9[$\xc1\x92\x10E\xc0\xb2\xabo\x14\x0cX\x8a\xbb\xdaI\x0b\x9d\xdd\xa7@\xc9h>~\x03HM\xd2\x97\xac\x97\xf6+\xad\x09\x0a\xf7\xa9\xb3\xb4t\xb6\xcaKT\xa4\xf9\xe1\xdbQ\xf9\xd21\x0b\x13\xa7\xa4+\x92e\x83W\xfd\\2\xa6\x14@B\x1d\xde\xe7\xf1s\xba\xdb\xc3\xce\xa4\xc6\x8c\x0da\x96R\xd3(\x83K\xb3\xa7\xb2>a\xe3@\xbd\xf7\xd9-\x99\xa4\xb3\xf3\xb2<\x09\xda\xbee\xe3H_\x8b\xaav?H\xc0L\xe6\xd0\xef7\x11{m\x05\x8eEx\x8b\xb2\xfe SrU\xd8\x0dG\x10\xc3\xb6$\xbep\x90o1\xb1{J\xe5\xa1\x8a\xc8\xac\xb5\xa5\x81\xf0\x99\xec\x8c\xd7`\x80\xb3\x94\xfe\xa8\xcc\xfcH\x8d\xbcA+&\x0a2\x18/\xe4\xd90\xb6\xfe9\x96\xc58\x89\xd1\xd6Q\x1f;\xa6\xc7\xd1'j\x9f9\x16\x0aJ\xba\xca\xc8\x93b\xe4\xeez\xdf\xe5\x07\xec\xa0\xc5\xe5q\xe4?\xc2\x1c|\x80\x04\xde\xf7\xf0:\x94\xa7\x89}\x83*\xeb\xc4 \x7f\x1f\xc0=\xe6\xaa@\xdbW\xfd\xdb2p\x97o\x9e\x7f\xd5\xc3\x0d_\xf5\x01\xa2\x83q\xe2\x10P\x8b\x0b!w>\xc0\xedCp36N\x91\x08~\xe2\xdc\xd6\xdf\xb6\x9e\x0b\xdb\xe4\x87&\xd1\x9e\x8f\x98F\xd2[B\xfd\xb1*\xe6&5tZ\xf1\x93\xf0\xe6\x84\x07]\xc6_\xc5\x81pO@\x07MwI\xb4\x1f\x09\xf3@\xd2\x9d\xa3\xfag\x8eC\xcdC\xc0\xee\xc9\x109@@\x99i\x83\x87K\xba\xd2\xc4]O\x92\x84\xefI\xe6\x9dZ)\xb4\xbd]\xc7X\xcc\xfc\xbc\x0e\x93fr\x1b\xbf\x1eA\xcd\xe3[\xa0*\x0c\x07\x07\x16\xd2\xe2\xdc\xf19\x17\xad\xe6\xb5\xc8\x85\x97\x03\xce\xb0\x1c\x85\xd3\xe1\"\x06)\xee/\xf9\xa4\x93\xbf#\x17&\xba\x11\xfa7\xc4\x16\xbf$\xf4\x11\xf6BW\x06\xb6i5\xa1m\xb0\xed\x82\xec\x05\x00\xbc\x1at\xefS<\xea\xc7\xc82cm\xa6G\x8fp\x02\x81\xfb7i2\xc6\xcf\x08\x88\x82\xc9[-e\xd8q\x9a\xacW\x0c\x17\xa5\x84Y\x14\x9e\\3EG\x87\xfa\xce\xeb\x15L\xda\x0f\xc8\xd0Lm.7\x84\"h\x8e 58CYR\x91\x03\x87\x8b0\xd2\xdb%\xc2[J\xfc\"\x15\xf3\x1eS&\x06i\xb4(~+\xe3\xda\x07\xac\xdf\xe67\xc5V\xb2)\x80:\x80SMe8\xf2\xde\xc6\x88\x06!W\xd8\x15\xc0r\xdei\xc7\x88H\xee\xcdq\xc7\xc6m\xec\xa5\xb6\xd2\xf93T\xda\xb2\xe8p\"\x1d\xef|kG\x1f,\xcf\xf6\xb6\x9f\x86\x91f\x0d.\xf3}S\xe9\x08\xe1\x1b\x19s\x08:\xd5\xf3\x84\xe4\xfc\x8e\xfa\xa9\xe7\x16\xe2\xda7\xeb\xcf\x9e\x0c\xc2\x84\x15\xa1\xc5%\x93OZ\xf9e\x06'\xc8\xc7\xf2\xd9\xe7\xdb\xfd\xf1\x02=\x8b\x1f\x91\xaf'\x12j\xdb^i\x01\"\xa9\x04_\xde[\xb4\xa7T\xea\xe9^\x06@\x98:Wt\x9ba\x08\xdf\x81H\x8a\xa0\x01\x98\xaa@\x9c\x9b\xbed\x9c\x0b\xee\xde\x1cn\x9f\x96\xbdF\x096\x81\xc3\xe1\x1e\xd0\xa8mYW\xbe0\xbd\x88\xd4Qwf\x06\xba\xaf\x93\x0fHn\xbaN\xae\xdb(\xf2\xb2\xa9&\x82,\xde\"\x1b5B\x10z\xd4\xf6\xb6\x1c\xb1F\xbf\xe7U\\\xd4|@bj\xe2\x07y\xa8,\xb1%\xae\\oS!\xb5\x09\x88'\xa5i7\x80L4\xc1\xe7E\xf3\xf7\x0d\x98RE\x0ef\xe1\xe0\x90\x9bd}n\"\x92\xe0M-\xea\xb7\x82lL\xf5\x81\xa4A}k\xffV\x9c\xb2\x8b\xa6\xf0~\x98\xa9\x87\xeb\xcaN\xde\x9f\xde\xde\xeb\xca\xceQ\xbb\xbd\xca\x82\xb9\x0d,\x90V\x18\x11\x9f\xab\xf4\xfd\xfa\xa6\x9cO\x8d&,\xcb\xd9\xc7s\x9b\xfa\x96`\x0b\xb2\xfa\x9f\x05Y\x97^\xd0&\x1fc\x12~\x17\xcf\x12\x9ckJZ\xaf\xf7D\x9a\x08)T\xb9\xf8R\xb5O\x16\x89\xbe\x8f!\x0a\xe1V\xd1\xbc\x03\xfam\xfb!\xb3\xdf*\xb1\xa9*\x9ab\xc2\x06B\x84\xe6%\xd88p\xb1\x0591\xbe\x18\xab\x84\xaa\xfeShL\xc7\x9e\xcbS\xdd\x84\xa7T\xfd%\xc9\x7f\xfc\x8e\xc3A-\xc8\xd0\x15\x87\x8e\xf3]\xd7\xa0\x10k\xc2\xb9\x9c\x93>\xa6\xe6 \xe3\xac\xccs\xbd\xac\xc2QQNZ\xea\xaa\xe8#y\xce\xc4\xf7F\xf9F&<q\xf0\x93T'%\x91]\x92\xfc0\x81(\xf9}\x9e#q\x8d\xc7,\xb7\xcd'\xac\xbfH\x97@g\x9e\xa6a\x93\xe0^\xddj$w~>\x99\xb3}(Y2\xd7\x03\x10\xedBrZ\xba,#\x04!{\x05\xf4uB\x00I!n1^\xf6\x1b\xeb\xf2\xbd+/\x9c\x06\x07.:N\x09\xe3WH\x85_afl\xc4\x0c\x81@LL\xca\x19\x8fq\xcdT\x10\xd9\"\xbaJ^Z3\xf3iBf\xb6\x91\\\x02\x8f\x8b\xbe\xfe\xc8\xa3\x92\xb0\x04\xa6\xb5\x9a\xfci\xb03T>\xec\xa9m\x9e^\xe9vb\xc55Ok\xce\xed\xc6\x9aR\xba\xf9\x1d\x1d\x8a\x9ap\xe2\x03\x9c\x1aW\x00\x8e-|\x8e\x7fC\xea\xcd\xdc\xa5\x09Nw\x00\xe9\x1d4|\x95\x03\xfb\xbb\xa3\xf0\xeb!S\xca\xcd\x8fU\xaa\xedI\x14\x8e\xc72\xb9\xbdA\x0b\xf8(\x01\xa0\x89\x80\xfd8\x17\x83\xb7a\xe5\x9bcH\x94/4V^\x05\x19\x08\x9dN.D{\xa9lF\xecL\x88\xbc\xf4\x13\xcaN;\xdb\xcf\x90\xe5Ex\xc1\x00\x08=\xd0pk\xfc\xd61\xd3?;T\x1a\xc0\x8c\x1e\xa0\x9f/\x91\xc67\xcf\xa7\xa4\x89\x19\xc5(({\xc4g[\x87E\xca\xf7\x1d\xefp\xdfq\xbd\xa8W\xe1$\x9f5\x93>\xbd\xc7\xf5\xca\x98\xe7\x16\xcf q\xc0\xc6\xe1\x87%\x10`\x05z\x864\xc7\xa2\xceg\x07\xad\x96\xc2E\x19\xb4V9\xe7NZ\xd0\x95\xbf\x7fG\xdd\x81\x1f$E#3\xf7V2\xfc\x99\xc0dV\xff\x0c\x1a\x94\xab\xe4\x80\x18\x9b\x03\xaf\x17\x924\xfd\xb2'\xdct*\xf53\x89\x8b\xc7\x0d\x10\xd8\xf6\xca \xf0\\\x03\xa1\xcf\xbb\x1bts\x10\x06\xd8\x97\xc1\x96.$\xcfN\x1a\xa5\x16~\x94\x9a6T\xeb\xc8T0\xdbR\xf8\xceU\xcc\xa9akqq\x1d3\x92T5\x12\xc8\xeb\xdd\x1b=\xb9\x97\x18+\x98\xfa\xa1\x071\x96\xf2`m\x83\xb7\xb31\x89\x0a,B\xe1\xecY/.\xec\xda;\x84 \xc86\xac\x8a=\xb9\xa8\xc4S\xe8\xd4I\xa1\x93`\xba\x86\xe58i\xbdJZ\x1dp}M\x14'Es6!\x8a\xdb\xdd\xd4\x8d\x07T\xc8\x1d\x1f\x05\xa2\xb9]\x88\x10\xb2S\xc26p.\xc7\xd4\x89Q6\xb6\x81\xe0\xf1\x83\x10'\x00\xc0\xa9\xe2\xc6\x8a~W\xae\xc1z\\'\xb0p\xe5\xdf!\xf2'6\xa7\xc5c\xf0\x18\x9e\xee\xbc_\x0b)\x9a\xf0\x81V\x05\xe21FZ\x00\xb5\x14\x81u\xf8X\xb2\xea\x08\xcc\x03Y\x84\x9c4\xaa$k\x95\x1c\xe0\xd7,\x84c(\xc3\xc6\x00y\x85\xdep\xc6?\x81\xa9*\x9bSfj\xe5\xeb'\x80'\xcddE\xb1\xc9\x1b\xd9Z\x83F\x08\x95h\xa7\"\x046\x08+\xb6\x91\xf3fF\xbfl\xa6\xb8\x93\xd3_%S\x8d\x81w3N\xda\xf4,jO\xe4\x0e\xbb\x05)\xf6\xddi\x06\x8e.\xa9FI\x07w\xa9E%\xbfg\x01\xd6c\x0br\x19\x13\xe8'Z\xca\xc2\xaaR\xaaD\x84t\xfc\xf8\"b\x03\xe8i\xc1z\xa1<\xc7\xce\xba\xdbO-}\xa9\xb2\x14\xdc\xf7\xae\xeb\x9a\xb29\xd6\xfa\x84\x1b!V\xbb\xf9\xd8?\x0ai\x89\xa8\x97\xdeL:\xdfN\x03CU$\x87\xd1\xda\xb1$PZ\xa0\xa2H\xbf\xb0EjN\xe1\x0eg*\xc7s\x8aHc\x9e;\xfe\xe7+R\xb2\xba\x95xn\xf7f\x12k\xc41\x92_n\xd0\x84\xdcJ\x8d\x82\x8f/\xceG\x85J\xb8\x12k\xbf\xa3\x9c\xae\xe9\xb5Q\x07&\xbad#\xd1\x8e(\x16\xc2\x8c;\x00L\xbb>\x16J\x19\x0dl\x98\xbf<m?\xa9\xc6\x0f\x1b@J\x8dr&St\xd8)\x1d\x05j\xe1\xcbQ)\xdc\xc3\xf9C\xa5\x97\x0932\x98F4\xaf\xf6\x0f\x19\xb9\x92J\x98\xe7=\xda\x8ay\x9b\xd5Kw\xf4\xb9S\xc8i\xdb\xa3G>\xd34\xac\xd5#\x99 \xc5K\x0a\x03V\x19\x8a\xfeB\xb0W\x0b\x0b+\x89\xb6+k\xe0\xa3J\xefk,\xfb\xabsK\xaa\x80\xb5q\xf7\xb8\xab\"U\xbd0\xde`\x01\xca\x89I\x17\xc6T\xb2m@\xb3\xa38\xb0\x98\xcbKy\xd4\xe8\x8c\xfb\x02\xdd2Y^2\xcc\xf3O\x8a\x88MQ\xfb\xe3\x02\x86Yg.c\x1a\x03Tz=\xef\xd3\xa9\xad\x97\x93N\xb5\xbak+\x9as\xd8\x0f7\x80\xe8\xe9=\x86\xf6\x94\x92|Z\xa9\xc2JdL\x88\xbe\xb4\x16\xdcw\xdcc\x11\x93a\xa7\xf9m\xda\x15\xa9\xd3\xc0\x85\xf2[\xa5\xde\"$\x80\x05\xf4\xd4\x19\xf0\xcd\xd2\xc12\xc5\x98*/\xf5\x96\xa0\xcf\xb3\xb0\xb5\xf4a\x9b\xca\xa3x\xeb\xb1LC\xda\x1b\x17\x83\x96'V\x89\xbb\x13\xe4^\xc4kG\x8e\xcdR\xdc\x8e\xe6|\xaa\x14U\x0a\xe1\x89\x88\x19^_\xe3\xdb\xfa\x0d\x9c\x0bq\xb3\xf2\x0ex\xae\xc1\xdcT\xcf\xdf\xf4\x86K\x9e\xfa^97\x89\xda\xd0\xc7\xf2z>e\xd6H\xbau\x09{\x13\xd8`9\x8f\xa7p\x1d \xaa\x88NB\xa9\xc3\x1c\xc0\xf2a\xe9w\xaaEI\x10\xaa\xf6\x8dC\x1c*E\x07JE\x15\xbb~n!1\"\x15x6\x14m\x1e\xa6B#S@\x0dF+\xa1\xc6\xa4)\xddTy\x8b\xf3c\x83<\x91\x85\xc5v\x8d\"\x1a\x17\xec\x82\xa0\x0c\xa6\xf8\xfcTd)\xaa\x9e}\xd1\xdc0FmS7\x12f\xf9\x9aK\xc0\x9cd\x06B(\x11d\x12\x8bmk\x82Dt(\xe3QK,\x8f\xd4@\x90k\xa42l]0\x9cxx\xc5\xe6\xf7w\xbd\x92\\C-\xf0\x86\xcdr+*\xc5\x9f$\xdc\xa1\x88\xb3\xb9\x05\x19>\xa0\x9b\xfd(\xbf\xac\x0bt.*\x8fe\x999bW\x82\xbf\xe7\xd9\x8e\xfaf\x82\xfej\xbd\xe4\xaf\xe5\x86P#IB\x94\x11\x9c\x9f\xfa\xc7\xe6\xacOo\xa1\x03\xd5x\x1c\xd5\x96l\x15\x93]\xef\xc8\x83\xba\xc2\xb7]\xcf\xd8 \xb0M\x0b\xed\x97\x14\x18\x89u\xd4\xf5*\x15B\xb4\x82i$\x06&Q\x11\xfa|\xc0\x87\x88A\xd5K\x16\x06\xe5\x00\xddI\xd5$a\xc7\x15\xcb\x82\xda\xaf2\xf3\x15\xf5%\x05w\x18G\x1b\xb47{\xb7\xfc)\xbd\xe0\x159s\x9eO\"Z\xbe7\x17.\xb0}K\xc0e!\x85\xc8\xef\xe3\xe2\x1e@b$\xd8\xde\x100&\xd9\x0f!\x05U\xb5P\xba\xf5\xe8\xf3\x13[A\x0dx\xfet\xc5\xae?\xca<\x9e\xf7\xed\xd8[\xc0\x1ez\x9b\x81\xdby\xa1\xbd9cA7\xf0\x81\xb8\x10\xfe\x98\x832\x0a\xa3{L\xc42~\x80\xa8E\xce$\xeb&\xc27\xe8i\x88z@\x04\x11\xe8x\x7f\x8c\xde\xb0\xca\xa4s\xf3F\x1fBw<\xbe\xe460a\x0ca\xa6S\x97\xab\x05\xe8;\x138yJ\xd0I~*\xaf\xf8\x0d\xf1\xd5z\x9e\xeb\x07\xf5\xe7x\x05\xd1\x84%e\xc7\x95\x8f\xa9O\x90\x1b\xd0E;\xef\xd9\xf0h'\xdfSp\xd1\x8adG\xa8\xbb\xa7\x00P\x0c\xc1\xce'\x8a0\xdfrk\x92i\x08\xcb\xf1ih\xf8g\x01\xeeS*Aj\xcc\x82C\xd2 \xba\xb1\xb6\x02\x9abS\xe2\xc3gT\xc7\xabX\x9di\x0fE \x1c\x8dD:t.\xeag\xd3M\xec\x0b\x9dc\x04-\x15\xa5\x95:V\xa9^\xf4\x8d\xe4\x9f\xe9\xd6\xc0\x1a\xa2\xdcWC\xa6O\x1a\x1d\xef\xc0\xe7}\xddT\xde\xd8\xa8\x8d\xe6\xfe\x08\x04c\x95\x96\x9c\xc9z\x9a\x94O\x01\xb8\xb3h\x19dlQ\xb9\xb2If\xd5\xf8~m\xd2O\xaa\x9c\x83K!\xe9s\xd6\xa3\x9b\x14\xb9.\xa1\xfb\xb2\xca\x05\x19O\xed\xda\xd1M\xab\xe7\xcf\x01\xf2\x94\xa4\x9a46\x8av\xb3O\x01{\x89\xe6\x02\\\x942C\xe1\xa9\xdaF\xfb\xf4\x99\xbag\xbe\x82\xaf\x03\x91MA\xd4a\x16i\xf5\x8aa\x12\xc7\xa4\xcf\x1a\x11g\xeb-\x09\x1e\xe4\x02\xfe\xb4\xfeN}\x0f\xfb\x8cd+\xec\xf7\xce\x89\xc7T\xa2c_\xe1IA\xcc\xbe\xb4\xac\x1f\xe8[\x99*x\xfd\xc7^=\xae\xf52&\x15X\xd2\x1d1\xba" +
+	"\xd4\x9e\x1c\xff\xf3\x02\xe9Lf\xd1\xe2\xb4\xceb\xc1\xecl\x8f\xe2\xe6\xc03=\xe1Sg\x02m\xd92\x95\x12!t\x04\xf2[\xa9\xa6\x0c:\xe2fN\xcf\xe3\x8a^P\xb7\xee\x0f\xcdyfN\xd3\xef\x05\x88b01\xf5+\xf2\xb5\xd1\xc4\x8c\xc0\xa3>\xf2\xfa\x914\xaa\xf3$Q\x05Yu2cn\xc9\x08\xac\xac_\xe1\xec0\x83~\x8e\x08\xde\xb3,\xec\xbc\xea\xb6\x02b\xf2\xa6uZ=\x12T\xdf\xd2\x08K\xa1i\xb6\xb8\xdef\x97\xe3\x8f\x91\xb5JD[\x1d\x00\xca>-\xa3~\x04a*\xd7f`\x17\x18y\xb1S'K\xb8\xcb\xb4\x84\x0b \xb2\xb9\xd6{-\xc2\xd0\x8e\xa9\x8a\xa2j\xect\x10\xdd2\xc79\xd7dN\xbb{\xaf\x0f\x15\xb1\x81L\x15\x10\xd1\xc6\x1c\x9dQ\xc3e\xe0\xd1\xb1\xc85[\xf6\xe9\xfb\xec\xd3\x9f\x13}\x03\xa4\x99}\x19\xbfq\xd1Tz\xad\x88\xce\x14+C\xa4\x9b\x93\x81Q\x0a\x80\xdb\x0fRw\x9e=\x80\xf0\x0d\xd2 r\x0b\x13\x86\x19\xaaO7\xa4PT\xadL\x06{\xd6\xef\xe4\x9f5%\xc8\x8dw\xd2w\x84\xefN\xc3\x86\xfb\xc9\xe8;<\x94w\x16f-\x92\x0bg\x03UHhf\xc8H?rE\xfc_\x0b\xae\xbf\xb3\x1e\x1dv\x1d\x02\x18O\x0b\xf0\x99\x1a\xe5\x91\xbchF:S\xd4!\xd5e\\\xe5\x84\x15q\x1f\xd0>0\x81\x8c\x96\xd0\x10\xa1.\x1e\xc3\xcd\x88\x94\x1f\x09\xca(\xbc\xc1\x84'b\x01^!\xa1\x12\x05\xd9.\xcdT\xf7m\x13'\xb2\xe8\xd1'\xd8\xe7\xd2\xfd\x14B\xb8\x05I\x93\x11\xf7yv\xad,\xa18\x06\x8b>\xaf\xa3R\xde\xb1[(\x81\xe1\xd8<&x\xbd\xc4\xea\xc4\xf1\xcb\x89\xa2yG\xad\x01\xd9\x9f\xd7y\xd0\x8c\xb9\xc8y\x13\x04\x85\xe2u\xb5\x082\xce[\xf8\x03\x01\xe7\xe2\xf8\xd4?\xa2\xbd\x8e\x9b\x99\xa8P\xc3P\x9c\xf5ia\xcb\x80\xa3\xc2\xdf\x16\x04t?a\x81Tr<\xd1\xdf\x82\x06\x12%\x9a\xaba\xff\xb7hA+h3\xa7\x86\x92\x90\xc3\x01\xf4\x91\x820O9\xd6T\xe5\xc2\xa4\xddiN\x9e\xfc\x14\x02\x93\xa2G\x0b\x99|\x0b%\x0f+\xf4\x86\xa0c\x92`yax\xebWR\xb6\xdbt\x13*\xff\x99\xd9\xcd \xb5UIe<u\xcc\xc9\x86\xcb\x0b\x1a\x07\x89\xd8.\x80-\xd5\xfdu\xc8 J\xe9\xe9\xb3\x81\xc1\x02\x9f\xf5h\xd3\x12 \xacD\x89\x9b\xb4\"b}\x08r\x04\x14\xb0\xdan\xa0%\xc9\xb46\xa7\x9c\x09W\x1aFp\x04\xb0\x0c\x8a\xa4\xa6\xe7\xc3h\xa9E\xde'a\xa7@ac}w^\x8e\x0570U\xbd\x8f\xe7\x11.CZ\xc4\x08~\x09\x16\xea\xd6\x9c\xaf\xde<\xaf'\x17\xba\xcc|\x19@\xa2~\x0a/\xfa6<\xd9\xdcoGu\xcf\x9a\xb6@\xec\xbe\xb7\xf6+w\x92%#fW\xd2\xd2\xef\xd1\xc0\x9a)\x9cJ2\xa36\x8d\x12\xa3a\xe8\xa7\xa6\xf7vF|\xf8\x9e\xf6TG6\xb6\x1dD>~t@\xbe\xad\xb9Y\xe6\x82M\xbc\x1cx}-\xec\xb5\x002\xb8\xa0\xbe\x9df\x96\xbcU\xec\xa0\x88\xd9\xdah`\x00\xce\x8f,\xe23>\xc1\xe0\xc3\x98&\x8a\xaeP\x9b\xe4\xf5\x9d\xc8\xdaK\x92#\"\x9f\xee\xd3A\x96\xb5\xe1B\x8e\x99\xf6O\xe1\xed\x1d\xa9\x16\x90\xdd\xff\xc9\xd6D\xf5\xbc#\xfa`\x1c\x83\xa6^\x8f?\xcc\xaf\xe0+\x01\x80\xe2\x94*v^\x9e\x8a\x1c\x19`\x95\xf9\xc3\x14\xe9\xa1\x88\xc7 \xc2\x1b\x88\xae\x9f\x89\xdf\x7fU\xf6\xed\xbf\x1a+y\x10\xe4I\xc8\x14\xc1>R\x9f\xd9\x98\xfaa%\x85.\x95\"\x9b\xb1\x1f\x98S\x85\xc4\xa1\xb4\xd4\xb8/\x055\xa8t\x09r\x09i\xdc\xd7\x11s%\x03\x04\xb1;\x17\xc1\x89\xe9\x0a;E;U\x84C\xce\x030\xeeA\x82*K\x94\xfb\xae\x1fp-\x95\x91\x94P\xf3c\x0b\x88\xe5J\x94YK\xdf\x0e\xd7\x1f\xa0\x802\xb2\xc4\x0a\xd6\xea\xab\x14\xe8\x89,\xde\xe4_k\xc7\xa4\x93\x0b\xd2\x9f\xa9\x9c\xae$\xbb\xb8t\xa1].2\xcd}@\xfc\xbd\xad-h\xe0\x18\x16|\xfe%{\xe6RL}B\x97d\x01\x7f\x92\xfc\xe5\x1c\xa4o\x81\x09h\xae5\x04\x96p\x8c\x90\xa8\x196f\x87\xa9LEV\x8bA\xd2\xc0\x09\xd7\x88+WT:\x8e\xe2n\x01]|\xed\xb5\xcb\x0a\x82\xee\xd0V\xdf\x03a\xbb.\xa6\xfd\x9d]\x1b\xf9\xb0\xd7#\xa1\xb2\xe1Nw\x10FT\x0cD\xeb\x9a\x0c]\xb7Q+qI=|0W\xbaL\xaar\xe7(g&\xfb\xbf\x17\x96r\x92\xf3\xeb\xaa\xc7\xdb\x89\x0a\xfb\xf15\xef\xecuu\x93&,&\xe6\x15\x85\x9f\x97\xf8\x00\x19\xe2\x93\xef\x08_\x8f\xd1\x8f\xfbU\x8c\xa2\xdf\xc2\xde\xe0\xee1\x99\xffV\x0cV\xf9\xf7\xe4\x8c\xeehC\xab\x1d\x0e\x14\xe6(R\x8cG\x15\xd2\xc2\xd2\x06\xe0\xe6F\xf7b\xaa\xfa\x7f6\x00\xdd\xb0\xf50\x04s\x81\xf0\xa9\x96\xc5|\xa0\xfa\xda\xd1\xe8\x84\xb0SeG]\x9e.\xf6\xda\x8b\x9c#\x96\xcd\xdd\x10_t;\x12!\xa5\xca\xaf|\xaa\xfc\x16\xe7@\xc9}\x0b\x01\xe06\x9dce\x84\x83\xe9\x7fC\xc0^\xb0x\x17\x14\x8a\xfa\xc4\x9d\xef\xe2N\x8c\xc9\xc3|\xfb\x97\x12\xaa\"\xf9\xc0\xe8\x07\x96\xcd\x89c\xcb>`\x1f)A\xa8@\x1b,\xd2\"\x07J\xf3\xb2\xbe\xc6\x8c\x81\xe6:\xe9C\xa7\xb2;T\xb4\xeb\xa8\xd6\xfaV\xe6@E\xca\x9e\xabR\xba\x1f\xdb\x01\x1a\x01\xc6)\xbf\xa0\x9f\xd0\xca\xbe+\xd5\x8e(\xdb \xe9\x05\xce|\xf5<A\xc3\x11u\xe14\xce\xab9\xd1\xdfQ\x11\x90\xd5\xfd\xb6X6f\xfb;\xd3\x1a\x9bg\xfa\x92o \xc6\xba`\xec\xd3\x06\xec\xe1\xf9\xf6\xeb]%\xfb|S_\xd7\xab\x9e\xad\xd7\x8f\x10}V\x94\x81\x1e\x0c\xfe\xcf\xd2\xc8\xbc\x82\xa7\x90ags\x0a\xc6m3*V>e\xe8\x1c\x8b(\xcd~\xc0Z\xee7~Cb9\xa5\xeaf\"R\xa6SY\xb6\xcd\x94C\xe2\xc7e\x98\x8c\x93T\xe6 \x1e\xdb\x05jz\x16\x004\x02\xf7 Y\xc7\x1b\xa8\xe3\x0a@\xc8\x09\\\xe2\xd86\xfb\x82\x91\x9c\xfb\x94-\x82\x95\x18,\x84l\xf3\x07\xcb\xf1@<\x9fmY*\xcb\xb8u\xc5j4\x0bTe\x11\x14\x1d\x92\x90T\xf8\xb1\x96\x01\xdc\xaaR]F\xf4G\x8fl\xca\x9e\x8b\x9b\xa2\xdaP\xc1]V\x82X+\xa1_)\x83\xecG\x8e\xea\xf7\xaa\x19\x07\x8bQ1\x9d\x86N\xd4\xc0\xb4\x16\x98F\x0d\xcf\xdc\xa7QR\xb0W\x0a\x0dI\x8e?\xd8\x93l\xe1+g0e\x80\xf4!\xb5\x85Hf\x01\x08&\xf8<G\x9d\xfbF\"u1\xa9\x14\x9e\xe4\xad\x91\xf9v\x9f\xa6p\xdaC\\\x04\x99\xc1\x801D\xb1~\xc3)\xdd\xf5\xfb\xfc\x88\x87T\xebSs\x1eI\xa1\x1c\xa7\xa2]\x87^B\xb9\xe9vv\x8bL\xf7\x1b\x89\x80\xdc\x105\xc2\x8f\xdd\x8d\x13/\x1e\xd1o\x9e#\xb4\x84\xee;<\x15e\xc0\x1f\xb9\xde\xe0|k}\xa0\xa1c[\xbc\xa87\xe9\x09\xce\x10\x09\xd8\x09\\H\x95J\xd8\xcf\x82\xc4s\x83\xe6\x9dD\xeff\x1a\xafH\x1dU'\x02\xa6-\x99\xa0\xabV_\xb3O\x93\xcbX\xe6eL\xdf\x91\xf1R\\\x96\xe50X\xc8<X>j\x9ev\\\xc6`\xd2\xd5\xba\xe1\x05\x9c\xaa\x12\x90\xcf\xe2\x94F\xd2b\xec\x88\xaaW\x17\\\xfa\xa7\xa23\x9c\\\xf5\x8a\x9c\xdc\xd6\xc7\xde\x95c\x98mP\xe5z^IT\x06,\x8a\xa5\xb3Wl\x04\xa1\xb8\xa5\xb6\xf4\x91\xac\xa0O`\xa1\x02]X\x8f\xe3c\xb8\x97\xdf\x9f\x0e%[>\xc1=\x08\xb5\xb0pC\xc9\xce\xf6V\xe4\x0f.\xa0~Wp\xe0\x05\x7f\\7\xd7\xf70qC\xdda\x7f\x13\xc1C\xf5\x8f\x02\xad -\x88#\x8e&\x80\xb3qS_\x83gw\xde\xa3q#\xa1\xe5\x077\x91N\xed\x95\x87 \x08Y3.\xf3/\xa9_\x01\xa2\xed{\xd2+Z\xb0\xc6?:\xb1g=\xba\x19r\xa0\x84\x09\xe0\xc4o\xed\xf0\x9cr\x11\xb99\x8a\x03\xa3\xe6\x17\xbe\x07\xc4e\x10\xb60]rL'Bp\xe5\xfd\xb0e\xfc\xffH\x81Zs\x0a%\xa8\xd3f\xda\xbba!\xc7\x12x\xecADQ\xbd\xaa\xbejfQ\xc8S1\x0d\x8ci\xafH\x8e\x05\"\xea\x85\xd0\xf8m/\x8f\xc1\xf4;\x08F\xbc\xa4\x90\xa4\x9d\x03\xca?\xe1&\x7f\x8dL\xdb@(Y&J\xa0\x893\"\x19\xa9.\x94J1h\xe8\x9d{\x1en_(~\xe6\x94\x96\xedB\x10f\x16\x18\xb7\x80\xb0I}#\xd6\xbd\xa5\xd1z\x04@\xe6\xce.WL\x1c\x0a\x04\x84\x03\x1eO&\xf65M\xec\xb4{b\xb7\xd3\x89\x95>\x91\xf1\xae\x02x\xa2\x99q\xcdg\x1a\x9e\xa0jf\x94\x82R?Q\xf9\xa6U\xe8\x97\xee\x04\xb0\xee\xe4\x0d\xf6\x03\x01X5\x91\xa1T\x04\xc76-\x99{\x9a\xb0B\xb9\xec\xd1\xde\xe8\xba\"d\xe9\x1c\xd4]\x7fPee@\xf5~!}!\xf0r\xdb\xaetFuI\x0a\xa43~\xee\x0e\xf0}\xcb\x11\xc8\xb80\xfd\xe6)\x04\x06\xea\x8fQsgN\x8a\xb2\xe7\xaa\x87\xf7\x06V\xe8w\xbc'\xefD\xc4t\xdeS\xdd\x85\xd9\x9d\xeb+\x1c\xba\xe4I\x05\x92#\xa9I\x88V\xfe\xab\x94\x06\"\x1a\x88\xaa\x07\x0c6\x0b\xd9\x91\x047\x84\xe9n\x8f\xde\x10\xdb!\x15N\xcf$\xa9\x80f\xfa\xff\xa9\x06\xfaP\xa6\xda\xa5&\xfb\x1d9\xaf3\xe6\xc1\xa7-\xdd\x8c\x00\xc2\xb7\xd4%I\xc9E\x16)^\xa8\xc5\xe6H&W\xc8B\xdd\xa1\xa0\x17wQ\xf4A\xcd\xa2\xba\xea5b\x9cZ\xe5\xbd\xa8\xdbX\xf3\xeb^\xc7_0}I\x0f\x04\xab>x\x00\xaa\x15w]}\xc7\x9cNX\xc7\x03\x14\xa8\xd4\x1c\x8ew\x94\x07A\xff\x01\xd8J\x09-(\xeba\x9f\xa5L\xf8\x92\x15\x11B\xbd|\xbe\xc7\xda\xf6\x1e\x8bj\x8a\x97p\xa3)\xe2~/\x95^v\xdc/b\xe1\x9f\xab\xdd\x0d\xf1d\xf9S\x9b\x9c\xed\x1d\xef%b\x85\xe15\xc5CsY\xd6*\x12\xd4ts\x8c\xd6\xe0\xba\x1b\xff\x95=\x16\\*_\xab=\xa6\xe0\xf7\xa2\x9c\"Z\x0f\xe2\xbcU}x\xdd\x1d[VIg\xe6G*V+\xa8\x95\xb93\x90\x07\xc6\xc2y\xf7\xd9\xbe\xbd\xef\xdc\xdf\x19P\xc3\x09\x1e\xe9Rz\xa6\x89\xb6m8\xe3\x0eWtJ`\xb9\xc9^{\xe5C\xf6A5\xf9#i\xfb\xf2>\xc2\x0dg\xb0\xbe\xa7\x0c3\xba\x0c\xc9IA'\x95\x08\xder\x11\xbc\x0d\x9eu\x09\x9d\xba\xa7\x8a\x9a:c\xff\xbaFq\xe7\x85\x19E\xf6\xe7\x09\xc1\xaf\xe6jn%\x00\xb8.\x0b]\x05\xed\xf7\x1bz+C\xd4\x9d$\xd9\xab\xea\xea\xbd\xc0\"0\xfb\x7f]\x1bqgK\xbd\x1cmB\xd5\x9c0\xc0\x1d\x19\xac<\x9bTO\xcd<\xe9[=\x1bdciI\x9e\xef\xaev\xc0!\x9dv\x8f\xd9v\xce\x9e8\x8d-\x90\xc0\x0eQ\xa9\xc7D\xbe\xc9\xda\xd8Q) \xeeW$K\xf0\x03H'\xac\xff\x00\xce\xff\xc2\xe5\x9b\\\xf0gd\xd2\xa5&\xf2zg\xcc\x99\xc0\x10q)]5\x0c\xc6\x94$\xd8n\xa5\x00b\x8b\xcd\xb6i\x14\xb0\x04e3\xeb<Mv\x84\xa4\xa3Q\x16=\x91\xdcV\xdc\xd4y\xce8\xed\xce\x11W\xde1D\xc7\x85\xe8]7L\xc0\x91\xaa\x0b8EM\xb6\xb7\x99\x0f\xea\x8f\xb06c\xb4\x1e\xb6\xe9)\xac\xdc\x97\x86\xb8\xe2.F$\x1ce\x97\x04+\xbcB\x8a\xd9\xae\xa7?H\xd3\xae\x05\xc0y.r\xfd\xaf@\xa6T\xc4\xa4\x91\xa15\x99\xe8\xe5\xa2\xda\xae\xb9\xcd\xa4\xf3\xb9\xd8\x86KE\xa3yj\x0a\xa8 -\xfeC\xc4J\x12\x0f^ze\xd5\xb2\x07\\\x81SO\x19\xfeJ\x87\"7\x91\xe9\x91\xdd\xd8\xbd\xf0\x08\xb8\x9c\xe8\xe5U\xa3w3\x8dd\xc6\x11\x04U\xe5:\x15\x8a\x1e\x18\x02\x8a\xa2B\xce\xe6\xb5\xe8}K\xcaq\x8a\x1d\x03\xbe#7\xceKRSc\xb1\x18\xc3G\xa1\xda\x83\xae{1`\xed\xa6>-\x87.\xe8>\x9f\xc6\xa8\xd4\xa8\xc7 .;z\xb4\x88\xf0D\xe9\x97\xc0\xf3#6\xbd\x0c\xda\xf3\xf6d\xd7\xf3N1\xd6\x08w\x91\xd4\xa7L] \xf7.\x84#\xe8\x143\xc0\xa6H\xb1\xd3P\xd3^4\x95F,\xf1\xfbt\x0c\xdfnf\xf8\xf4\xf3\x0aQK\xbfph\xb8\x19 \x8c\xf0\x14\xc0b\x8bF\xfd\xa1s\x9f\xefz^\xaf\x96\xec\xe2\xb4\xc2\x07\x11x(\xc8D\x8c\xe4UC\x7fJ+\x1a\x09|\xa0\xfc\xdc9d9o\x18\x1d\xc7\xd7 \x80\xb1\x10\x14\x17~\xad{n\x94\x0e:o}\x90\x9b-\xb9\xa8-\x89h\x8a\\\x15\xd5\x19:\x1e\xfa\xd7\xe3v\x0f}'\xf3[\x8c'\xd7\xcf\xf4\xa19\xd7OE\x10\x8bi\xd8\xdb\xa9P\xdd\x06\xae\xcc^\xc6\xcd\xbd\x82n?,i\x90p\x14RK\x19\x1c\xaf\xe8\x8b\xc3\xe7K]:\xc8\xbaw>\xd3\xd0\x82!\x19\x89AT\xb5\xde =f!\xecy\x09\x06\x15\x8d%l\xbegT\xb8g\x0cj\xfb=\xb6[LC4\xa5\xd7p\xf8h\xeb\xe0#\x08L\xed\x12 \xca\xbd\x1e$\x81\x8d\x1b\x15\xb3\xbcY\xf2\x92\xe3\xf5;r\x11\x8e\xba\xef\xbd+g\x952\"\xaa\xc3T\xb0\x0db\x89\xa3\xaa?@\xcb\x96/\xa7\x17;4\xab\x01\xe1@\x19\x00>gy\xb1\x056l*\xa4\xaf\xf4\xc6\xc6D\xe3W\x09*\x07eu\xd6\xc3A@;\xd8!\xefq&A\x0ax\x9d\x03\x18 \xf1\x064Wu\x8eL\x8a\xf0y\x9f\x17\x16>\x89\x1dm\x82\xcf\xcb\x92!i\xa2\xb3[\xb1\xf3 \xa2\xfc\x13\x9e\xc7T\x89\x01U\xfa\xf5\xbb<\xd0\xfa&*\xcf\x9b\xee-\xbb\x9f\x14\xf1`\x97\xf2\xb4JL\x0d\xa7\xd8\xc2\xa9\xa2\x90Q\x8bP\x06\xe8\x85\x8c:h\xdd\xbb\xde\xcbJo\xceaB\x1a\x85)e\x8d\xbe|\xfec\xcfTg\xa5\xa8\xa2\xa5\xec\xd2\xeax(\"\xf3\xb6\xf3\x1e\xfb/rv#\x91\xa1\x9ar\xa35G\xe9S\xad\x0asE\x86\xb9}2\xf7\xb3\xa9\x083\x96\x0b\x8c\x03\xb0\x09\xcb\x80\xb4\x14'\xd5C\xafPi\x7fK\x85J\x0f\xb4\xa8\xc1\x9d\xd4\xfd\xb3T\xfa\x8b\xb3\x09\xbd\x13s\x80Z\x88\xde\x04\xd9\xbbt\xd2\xb9\xae\xf6\xb7\x13S\xf5\x1a\x82\x8d\xa5\xc9\xf3@\xab\xda,\x1a\xcb\x98{\x9f\x08\xf9\xc3\xee\xca\xf2\xfe\x8e\xbaYZ\xff\xa6\xb9C\x02u\xca6`\xafVa\xeb\xfe\x13\xc8\xc4\xacZ 41u\\\xc1^]Yv\x87\xb5\xcb\x13\x9c\x8b\xf5]\xdb\xdaW\xf6\xbdT\xc3&\xea\x1b\x86\xb17\xf8\xe9}\x9c\xa7\xfd=?\xfb#\xbc\x10L9\x10\xfe] \xee\xbb\xe2\x8f\xfd\xfd\xcd\xdf+\x8c\xa0~\xab\xa4\xd5/\x7f\xc3\x83\xf4}\x94\xe02\xf4\x00\xd47^\xd0\x0b\x99\xf3hN\xa1[\xbf7A\xef\xbe\xae\x89\xc5v\x8f\x02\xb8\x14L=\x7f\xee4\xcb\x88\x0d\xa9\x86\xfe;ta\xa4{\xdc\xcdb~\x90\x87\x14\x02d\xee\x029\xbc\xb2c\x12\x97\xf6\x9c\x1b\xe2\xacc\xd8\x89\x11\x1d\xa6\x0c\x0e\x89\xde\xeb|\xe0h\xe5\xa0j\xb6\xc4\x0c>H\"\x0cT\xe5\x0bOW\xbf\xef~\x94\xadD\xde\xf4J\xd2\xc3\x84K\x877^jfV\xe1\x80\xcb>\xa4\x08\xe5\x8a9\xbdz\x193^}3q\x07O\xb7\x8d\xfd\xdd\x8a\x03e\x1bm9a\xca]\xdeS\xdd\xe5\xee9\xcd3\xad\xfa\xd7q\x04\x1c\xecz\x00u\x1f,\xb09\x95\x0c^O\xea\xe7\xf1\xf6\xe9\x87.\xb6\xd4\x85X\xc0|\x80ZY4KH\xc4\x13\xe4\xf6\xe1$\xc6>\xa2\x9b\xa6\x92\xf34\x8a\xb7\xe0\x05\x83\xbeB[\x83\xde\xc1\x9e\x97h\xce\xa0<\xe0\xca\xaf\xb9&\x1b\xd0\xf4\xec\xa2\xf4H\xdd\x1d\xecgmC\xb2G\x8f\xb1\x01(\xa6\xe5=\x16\x8d9\x12\xc41\xdf\x85\xe4\x92\xe1\xee\x13\x95v\xfc\xb13\xefw`S\xbe\xd0s\x11)[d\x8e\x13\x9f\x97k\x9f\"\xbb0\xe1\xe4\xf6RUC\x05\x0c\xd9\x17\xceM\xab\x09^?\xf8[\xc1\xfa\x91c\x92\x00\x1a\x86\xfa= F\xe3\xfd\x01\x89\xa3\xa0\x1f\x9b\x9cv\x07\x87&\xe81\x8a\xd4^\xa2y\xca-\x0d\x94h\x86\x1b\xe3\xa1\x92_8\x87/$\xecZ\xc3\xc8\xde&\x0e\x97fX\x80\x1eq\xe8\x03\xe9s\xa2\x1e\x0e[\xa4\x00\x13\xf63\x7f\xbd=\xf4:\x1c\x0e\xb7\x94\x8d<\xa5\x0e\xdc\x0b\xe1\x9f \xb0\x9cX\xed\x9eT\x1b=\xa43\xc0\x17@a\x8b+\x09\x03:,\xee\xa1\xf6\x83\x83\x99\xc2foUM6\xc15y\xbd\xe2@\x9d\xdb\xf8G\xf9\xe4\xc7\xdd/\xb8\xeb\xb7Z\xa0b\xaa11)\x04\x8f\xbb\x01:o\xb5\xd7\xa5\x19\xc9\x94\x19.r\xc5\xca\xdf\xc5p \x9e\xe9\xe1h^!\xc4\xe0\x09\xedZ\x87y\xab\xdbL\xf5\x95d\xf3\xe21\xde).\xe3`\xec\xa3\xc8\xc0\\U\x08d#\xdd\x11\xf7)\xb6?\xd2A\x02\x8dB\xb5\xb9\xb8^\x04\xcf\x8a\xca" +
+	"[\x13a\x80\x9bZ\xdf\x10\x08th\xd3\x00\xcc\x195\xb7\xe7`}\x83f\x1c\x00\x19F\x8f\x86V\xd2L\xd4f\xcb\x1e=\x99{\xb2'\xb3\x99\xd67\xcd\xb8\xb3'\x13s\x7f\xdf\x0c4\x8d\xb6\xab\xb7\xa2\x9a\xe0\x80\xb2GT\x95\x00\xd6\x16\x8107\x18\xb00i\xd9\x92`\xdacv\xd9\x04\xaf\x88\xdc\x9a4\xed\x7f\x8e\xd6N\xa0O\xec\xdf\x07\x06\xce\x99\x04\xffi\xfdnE\x9a\xf7$\xa9#\xa5\xc0Il\x1fI\xbf\xf0\x09k\x7f\x0bsPx4\xe2\x8c\xe6R\xd9\xabv\x00\x15-\xa0\x9fB1\xe1Q\x0b\xa5\xab\xc34y\xd2<\xb8\x17\xb8F>\x80\x83\xfcC\xa4\x85\x92 Q\xf6e_\x13\xacg\xb2IW\xc7\xae\xdf\xd4\xb1\x9eYx\xf5\x89\xd1\xa3\x90U\x15\xb7\x0d\",\x91\x11\xc2D\xa8\xacG=|\x0f\x0c\x9dW\x80\x81\xa5m\x11\xde\xa0\x0d5\xff0\xd7\xb4\x08\xc6Q\x92\x91\xbc\x1e\xd6WcAPh\xf3\x11\xdf\xc1\xb2\x00'a\x8b\x9ez\x9cK\xb2\x01\xb0u/\x83k?\xe7,\xa6\xcb\xe9\xca\xc4\x81\xa8w\x92%\x86\xa1\x00\xba\xfe\x08\xaf\\9\xd1\xda}S\xe1\x82\x96\xf3\x1b\x19X\xaaA.P\xe2tf\xf8s  jF\x9b\xf0\xeb\x1d8k\xeb\xb5\xc7b9\x87\xb2\xbc\xac\x05'J\xe1+I\x15\xba\x82\xf4\xd2B\x00z\xa0\xfd\x8b\x13\xf9/\xb9\xc3<\x02\xdfJ\xec\x96.4\x8c`\x13\xcfC\xbdS\x9e\xd1\xed\x08\x12A\xb7M)\xcc\x91P\xba\xf6v\xc05Z\xf4\xa8h'\xdb\x10\xc9*\x17JoC\xeall\xe64\x8b>Y\x15\x0f\x0b\x8e\xda\xc7\x12\xcc\x8e]A1l>c\x0e\x903;\x08\xf0\xf95J\xfaqa1l\xd6;f5\xec\xdbq\x1b\x0eP\xa0\x0b/\x85\xcd22Do\x09\xbe\xf1 \x96_\xfc3\xa1\xef\x85\x85\xe0\xe8\xef\xb5f\xb4\xe3\x93\x85\"\xe3z\x01\x1f:]jY\x8e\xdd\xc7\xa4xU\xe9\x1fi\x15F\x1bP\xd5k\x19\xbfg\xa2\xa4\xa5\x0f\xfaq\xe4\xaa\xcb`\xff\xeb\x04Su\xea\x91\xc2X\x9d\xf9|\xc0\x8f\xcc\xfd'C\xf8\x10\x1e\xef\x11\x82},t\x84\xa8\x05\xef\xc4\xcc\xb0\xf1\x97\xd0\xd8Q\x00\x89\xd2K\xd6Pd``i\xb9\x93\x9e\xb0R4;K\xe7\xab\xea\x8e\xb0\x9c\xf9\xd0\xeb'ar\x16\xd6<!\x06\x91&\x01\x18\x00\xe6\x004\x83BR\xa4[|)\x98\x15)\x18`mC\xe7j\xe1\xd8\x1a\x13m\xc7KT\x9c\x89Ov\x87\xef.\x8f\x94\x84PI\x91WO\xa8g\xf55\xd6\xb4\x13\x02\xf1\x8a\x0a\xc9\x91\xc7H>W\xf4\xe8\xde\x03\xd2@\xfcH\x12\x9f*\xa1\xa8\xfe\xc4\xa7F\x1cP\xb6\xe0~\xd3\xb2\x02\x9d>\\\xa1;K\x8a\xd6\x10+\x1f[.AI\xb0M\x13\xc4*\x9dL\x1a\x9ey\xc1\x90\xad\x88l\x86\x9b5\x93\x0b\x86\xf8\x89\x86\x0c\xa3w?\x85\x16\xec\x18\xba)v\x9aM\xee\xba/\xc3VAv\x9c\xba\x1a\xc7\xb6\xfd\xf7*\xfd\x12]D8$\x9b(\x8c\x0c\xbdB]\x17%\xf7\x0fO\xa3\xe4vt\xcf\x1fA\xc0\xec\xb4\x94\x0b\xda\xad\x99\xcf\xc5\x8b\xae\x00\x06\xb9t*\xcb\xcf\x05#oW{\xeb\x8b\xe4\x19\x9c\xa4\x97\xd2\xd8\xc6\x9dKK\xa5\xca\x0c\xa5\x9dj\xf89\xb5g\x7fO\xfbC.Ej\xd3\xe8\x81b\xdaR\x19\x94\xf5\x15(\xd6=\x93\xfcu%\"\x86@\x08%\xf6\xc5\x1b>\x92\xfb\xf8\x92\xbc\xe4\x18\xdc(\x94\xa47\xe9/\xda\xdaJz\x7fN$v\xb2\x8d=l\xe4\xcfF'Q\x88\xaf\xeb\"\xd9J\x14\xeb\\\x84\xcd\xe17\xcf\xc4l\xb0\xd4\xb7\x14\xf7\xeb\x03\x04\xda\xb2\xbc&\xa8\xde\xd3LpP\xb3R\xd4\xde\x92of\xedK>|\x9e,y\xdb\xac,\x87\xee&\xe4s.t\xd1\"}\xd1\xce\xc6\xea\x1d\xa71\x17\xe4\xf1\xc8/\x1e\xeb\x01]O\xf2\x9b\xb4\xdaazx\x19\xe5hpF\xd0\xaf\xfa\xc1T]6\xa2z\xd9\xdaM\xf8\xffD\xa0.!Ms\xe0FPP\xbdcR\xed\x1d\xa3_\xa5\xc8%\xd1\xab\x8dW\xbe\"\xb0\xb2\x8cv\xd2\x1b\xb8\xf4\xa9V_\x86K\xb3\x08e\x17\xbc\xf2\x0fT\xf9:Gw\x91\xb191t\x14\xbb\xfdm\xbf\xa5\xac\xb1\xda\x86\xed\xd4\x97\xa3v\xf8\x94\xa8\x867\x128\xcfo\x12m\xa5\x85\x14kj\xa6z\xe1X\xbc9e\xb8#k&4D\x03s\xa5WB\x94\x91x\xa8\x99\x8fDs\xba\xd0\xc8\xd2\x15GxZ\xc2X\xe4\xb8\x89'\xb2\x01\x16\xa1\x97\xcd\xb8\xc5\xbc\xf6h\xbe__$\xee\x9a\xbeklFp>\xa6Sf=W\xc9\x9c|\xc9\x95\xa3\x01\x10\x1d\xa00\xf2\xf7y5 \x83\xa6[\xff#\xe6&B\x8e\x89\x8c\xd3H\xdf\xbeU\xbf<rA\xc6\x18\x9b\x9bxx5\x17\x8e\"\xc8k\x10\xa1\x99\xe92\x05\x8e\x8f\xa2f]_\xf6\x8f\x8e\xd2lL!]\x01wz\xa7\xd18h+\xa1.\x85\x00\xfc\x88\x88\x87L\x03\xa6\xd7\x1ao\x81Q\x9a 9\xdd9b\xf5\x97\x1a\xf0\x01\x1d\x85\x03Fi\x97\x9a\x95M\xb2\xa5\xa3\x0bs\x07\xa3sN\x89G\x94e\xcf\x12\xf22\xc3\x85\x01\x85\x9bu\xb1I\xb5\xd5\xb0 \x98\x8e=4\xfd\xb2{\xd1\xed\xaa\xec\x96\x84$G[*\x87\x01\xe8\"\\\\\xf3\xe6.aK,\xa0\xebf\x03\x82\x84S\xaa\x9a\x09\x95\xc1D9\x18\x0b\x1d\xf1,\xc0\xc6\xc1G\x1f\x90\xaf\xe9<\x10\x1ae\xce~#\"g\x83\xe2\xc7\x16_\x91\x1b\xb3\x12z\xa1\xa2\x16\x7f)<\xa61\xe6#\xe5\x99\xac}0\xf1p\xfc#\x19\x94\x0c\x92+x\xcd\xa1}(\"t\xdfh9\x00\xbd\xddy\xa4mE\x87\xfe\xb5,4\xc8*\xc4\x93dI\x9d6q\xc9\x0fe\xb5\xff\xd0`&\xdc{\x8a\x95\xd8W\xb3\xdclx\xb6~\x1a\xfb%\xf5Ee\xccd+\xe7FA\xd5\xd1\x1d\x0e\xb7Jw>\xe3\xbf\x90\xdf\xafx>\xc3#\x983Pq;<\xfc\x9a\xdd@EB\x9e\xec\xf1\xd2\xd4\xcc\xfb\x0d\x188~\xa98\x9d\xae|\x1b\x84@\xb2>\x98\xff~s/LD\xcb\xcc\xcfo\x9e\x9f\x17\x9b\xa8\xb0)\x0cp;\xa1\xff,\x00\xd3\xe6Z\x8a\xa3\x0f\xf9\x07\xba\x88\xef\xd0\xd2KM@\\k\xdf\xd3\xde\xc6\x17\xb1b\xc4\xc8\xd8\x87\xca2\x0b\xddy\xfd\x130ij>\x91N\x9fG\x8aC\x1b\x1afQ\xed\xf1\xf5C\xd7o[/\xc8\xa8\x09\x12<NJ\xe4\xa5\x85\xa6\xcd\x85\xe0\x04\x93@\xbco\xa1\xca\xff'b\x81\x94\x9fJ\xf9\xa9G\xdc\xf6Y\x97\xb0\xd2\xc8\xd7\x8e\x9d\xd2\xd8p\x9aB\x9b\xb9bM\xdf\x10\xb8\xb7\xdd\xc2\xde\x05,\x12\xf5+\x1e\x9e\x11\xea\x84S\xd1\xc7p4k\xae\xd7\x97\xbc\xa3\xaa\x81\xc2\xefd\x1byH\xfa7\xfc\xa8^\x88o\xd6\x1f\x9a\x0b|\xcb\xe0aS?k\x95`\x94\xd7\x7f \xad\xff46kN\xdb~G\xa8\x09\xe0\x14u\x19\x9d\xdf\xb3\x8c\x12D\xceD\xdc\x0d\xc1\xbc\xf4D\xbc\xd2\x08v\x03\x90m\x86M\xcc\xaa \xa2?\xe1\x81x\x01\x17\x8a\x15v=\xcf\x84\xdb\x17\xa7V\x1f\x9d\xb8\xef\xb4;\xb0j\xaau\x95W.\xc0\xf5\xd8@e\xf6Dv\x1c\xb5'P\xaf8\x07}K\xea*\xcd\xd4\xc4\xff\xd2p\xdb\xb4\x8abX{\xd7\xcfY=H\xca\x03,3c\xc4\x98\x05\xc2\xe9\x9a\x1e\xb4\xe0 rB\x90eO\x9fHd\x0c=\x83\x16_\x14\xef\x0a2\xfa4\xa0\xac\x1f\xf2\xd2c'\xa0\xa5\xf7\xb2\xbe2G\x15k(\x1e\x83\xbc\xcb\xca\x95+\x1ckE\xa9~H\x00\xc18\x04\xda\x0a\xa4]SY\x99\xfeD\xff\x153\xc3\xa2\xc8\x97\x86\xce9Q\x86\xa2\x17\xa7\xbf\x06a\xc9\xcc\xd5T\xec\x9c\x10k\xf4\xd9\x04\x86\x80H\xa9A\xb0Nw)\x94\x08\x13\x16R\x11\xd5\xd4\xabh2\x05s\xa0\xd2\xba$\xe7\x91\x87q\x80\x00I\x14\x81\"0L\x1f\x12\xa3Ln\xff\x04\xccg\xe1Z\x9fT\xa1oAk\x91%R\x7f\x8c\xe7\x0c\xed\x19\x08kJ\xd0\xbf\x06o\xc5N\x05\xdf\xa6+\xc2z))\xa508\xc9\xc5X\x97\x94\xbf-8\x1a\xf1\x94\x1d6\xd4Gh\x0d\x1e\xde\x91\xd0\x8dp\xbd\xc4\x99\xacH\x1a\x94\x94>\x00_/z\xf9\xea;\xdb\x19\x95\x86\x0dH\xdd\xb53\xb0\xc2\x86C8y\xc3$1k\xa2/h9\x02\xc3\x81\xe8u\xb1\xdd@-\"\xa5\xb8\xafr\xcd\xa8\x8f\x02!1\xe1\x93(x(\xe3c\xd0\x8e\x01\xc9R\x02av\x95\xcaG\xbb\x9d\xdd\xc7\xde\x8bP\xd5\xde\xb1v\x89\xee\x8c\x90\xc8\x14j\x0f9\x95\xd06\xb1\xcfM\xa1,\x82p\x0a\xaa\xab\xe1\x7fU\x13Z\xe2lj\x0d/\x91Y\xd3!\x87V\x17\x7f\x0dt\x1c\xa3\xd4W\xe7\x90\xc3\xd3\x97*\xf2\x91\xe0\xcf\x97i\x16,Y\xc9\xc3\xbcn\xca\xfa\x0d=\x0c\x9b,l\xc5\x1c\x13\xc4Tn\x0dM\x0bE,P\x80\xd0f|^\x0fn\xad\xeeG\x92F&B?\xa2\xc5-\x9b\x91\xc0\xca\xfe4\xe8\xc6\x9d\x03\xf3\x9c\xa2\xfd=j2bN/\xed\xa0J1\xc0c\xa3\x85lB'\xb9\x07b=\xf62\xe6\x8eY\x94\x1e\xf0@\xa6\xd1d&Z\xec\xab\x14\x8b\x85\xc9\x89\x10'\xdd\x05\xc0\xd5E8\x99m\xfa\x90\xfd\x11\x1a\xfb[\x81z\xe9\\g\x87&hR\x97\x9d):\xd2\xd2\xb2\xa7\xb5\xa3\xb9\xd3/\xa7\x80*\x88P\xe3\x0e\xc2\xc8K \xd0\xfcL\x8dz]\xb9\xbf6\xaf\x08\x0bZ\xb1h4b\xdd\x01~&\x97\xa6u\xe9su\xa9\xf5u\x9a\x91\xdf\x8b\xa3\xea\xe3}\xcc\xa5! 3\x99\x8bq\x82\xe4_R\x03\xe0\xeb\xe0\xd6\x09\xae\"\x00\xa7\x92\xba}}\x85\xd10\xff)q {\xc9\x89\xe1nF<\xc2\x8c\xe9aW\x90\x81]t6\x0c\xec&z\xb42\xad\xd2o\xc5\xb5\x90~RrV\xd0\xbf\x96\xf8&\xef\x82\xa3M\x11\xfe\x08\x803Ja\x90w\x05\x0frs\xb4\x09Y\x9a\xea\xe7\xad\x12\x89\xff\xf5\xb8K\x18\xd0\xb9\xcc\x08\xa4\x0f\xc1\xf5GY\xdd\x9f\x10\x7f\x99D\x84\x86\x00?\xaa!\x97<]*V\xc3\xec\xfae\xbfe\xb9\xe3\xd4A\xe5s\x1c\xc7L:\x1d\xbe\xe2\x09\x88sD\x82M\xf0w#\xc2\x10\x1ea\xb3\x04\xb1\xeb\xb0\x18C\xe2\xb2n\xd2\xef\xb1\x9b`\x0c\x9a\xe3Xr\xd6\xe2{\x13L\xbc\xf9\xfd\xfc(?\xe3\xe9\xa3\xd9&\xba\x0d\xbd\xc8]\x9d\xc8\xca\xf4\xf6\x8d\xe9\x19[\xc3d\xe4\x87\x8c2\xb9\xa2\xe5w\xc2\xbev\x14g9J\xde\x11\xca\xeb\x09\x80j\x09\x98\x14t\xb3E\x93\xb2\xc8\xe0\xda\xe4\x7f0\x8a\xfd\xf8\xa7v\xf7\xfb\x8e\x80U\xec\xd4\\3m\xddf\x0e\xfc\x85\x9d\xb4e/\xfe\x97\xdd\x83\x0c\xca&8\xf4H\x08XHR\xa8R\x82D\xd0\\\xadG\xeaUt\x96P}\xa8@F\xc6\x98\xd3;a\xa0\xf5\x9c$\x04\x15F\xed\x14\x93l?v:H\xcc&'\xa3=\x00\xd3|\x93pJ\x1fI\x1akS\x9d\xe6`\xdb\xbc\xb1l:ofq\x15\x04w\xfcX\xfaC\x9c\x99\x8b\xa4\xb1Ti\xc1Z`\xc9\xcc\xa7\xea\x0cX\x829A\x11\xc8nT\xcd\x0d\x17\xb3\xba\x1a\x98^(\x03\xf1\\@7\xe0\x0f`I\xf4;\x12\xbf\xf9\xa3L)\xd9\x15\x9d\xfcP\xf41p\"\xce#=E}^\xc1u\xc2\xa2\x18e\x1fZ=\xa5\xb7\x15_\x18]\xcb7i\xc60\xd1\x13\x0f\x83\xf1#\x80\xb2'i\x99\x87\x10\xdc\x0e_\xb4.a\xa4\x0e\xa2\xac{\xe5E\xe3\xb3\xda\xe1\x88 \x8c\x16\xebe\x84]\xab-\xac\xb9\xc9\xe0\x97e\x9b\xdb\x99j5\xe2b|\xb0\xf9\"\xfdC\xa1\xc4\xb2\x01\x0dao\xcb(\xb6\x81\xd2\xbe\x16}3K8#F\x98\xef\x1b\xc3v\x9d*\xdd\xf9R\xe2sbzR\xc1&\xd3\xcc\xeaz\xd1\x95\xa03\x10\xd6j;\xee2\xbd\x00k\x93H\xd1)\xeb\x91\x97\xe1pYEZ\xd2\xde,\x8b\x99\xf3\xadK\x97P/\xaa\xf5\xb3\x16\xcc[\x94S,\xd4\x02h\xce\x9bB\xe8'\xb5\xc9\xca\x01\xedt\xec\x94TQ?\xefz\x91\x90\xbeHg\xcb\x02\xb0\xd4uT\x12\xe3l\xa0\x98\x91\xcfz2D\xf6b\xc9\x90\xa9+\xa3\xe2k\xd2\x7f\xf9LV6o\x0fR\x14K\xb8\xd7\xc8\xa1\xc0\x04+\xb9\x8e\xa1\xb1z\xb1R\xac\xb5\xb96\x1c\xc7\xce\xf7a\xafL\x91\xf3\x8fn\xdc\xc3\xca\xfb\x0d\xa7\xa2e\x9b\\\xda3\x8d\xc9p\xea\x83Q\x08\x99giM\xba\xeb-\x11\xb3\x97!`\xebh\xbcR\xad\xce\xe0y>\x08Z\xed\xf0pSD\x82\x03\xd0FEU\xe4\xd6\xc1\xa5\x17\xa1g\xdb+\x87/D\x14\x0cA\xc8:{\x07\xb9\x88[\xdc\xe0c$\x14C\xfew\x86\xb5\xbd\x8b\x7f\xb8\xa3N\xd1\x8f*US\xdf\x93\x1d\xebS\xd4\xd1<\x99\xf8\x94\xaf\xd2~\xc4\xb4\xe1\xb1jkx\xac\xdc\x14\x81(\xeac7\xd6\x1d%]\xa9Sh\x05\xc8\xce\xa3\xf0'Q\x0by\xf9lk\xaa\x7fW\xdf^Z\"=\x05\x0f\xe7\x1b+:%\xed5\x8f\xd7M\xb4\xcf\xaa\xa8/\xc7\x85Qf\xbd\xc9\xd1x\xae\xe0'0\xeb\xf1\xb0\x138s\x15o \x1d\xcd}\xf7K\x1f:a7 \x18\xf8q{5\xb2r\xb1\x044d\xa13\x10\x9b\xc1\x0a\x8ds\x0aT\xb2e\xf8*\x14df\xd4\xd0\x17\x9aA\xc5N\xd2{l\xfd\x9a\xb6\xb98:_\xba\xcaz\x96k\xa4\x14&<t\xcc\x8f\xc0,f4\xf5\xff<\x1d\xba\xfb/\xda\xcc\xbeH*s\xd6C\x03\xc8\x13\x8c\x9f\xd2\xe0\x13\x1c\xc3\x04\xee\x88'\xd3\x8a\xe5\xe4\x8d\xab\xbb\xb6`\xf0\xe6\x1c\x14\x94@nX\x0dpD\x9a\x99qfU9[\x9e$\xba\x8dA\xb7J\xf5\x06&\x83o\x15a%g}\xa8e\xd3\xf1\x84\xc6L\xc0\xac\xf0C\xbe\x97\xc3+\x0a\x10\xfc\xf9\xb9\x0a\"\x83h%\x98\xb4J\xf1\xc9;U\xa8Q\xc0{\\\xc8N\x14\xd6\x06\xbdE\"2\xb6\x91\x9c\x9f2l.\x90\xa6\x83\xb3\x8f\xa5~\x15Z\xa3L\xdd<\x85Z\xadP\x85\x0e\xf7\x02%.\x17a\"d\"1m\x82\xc3\xa8\xe1\xa6WW\xe5\xfeV\xdb\xda\xbe\x16P1>\xcf\x95\x88\x8a\xc6\xcd+Z\x84\x84\x8eNK\xbb\xb6]R\xfd\xb6\xe2\x14a\xc1\xa4r\x03!\x0e\x1cNq\x0a\xc2D\xd2+\xb9|!\x10Z\x04\xa0U\x15\xc6\x91\xe8\\E\xce\xf0\x0fP\xac\xc9\xe4\x05\xb0\xdbU\xcd\xcd\xd2\x99\x1b\xd5\xfa\xaa\x12\x93}\xa9\x881.T\x08\xb5\x9e\xed\x91IBq\xfd,`*\x0c\x82\xda\xf8\x03-\xda\xda\xddYz\xaf\xf1\xd2\x89\xafcoY\xb7\xc3\xa9\xa9\x92em\xe4=\x9c\xb5\x0b\x86\x8489D\x84\xfb\xbe\xf2\xc8\xc2;[\x03\xb9\x86\xff40\x81 L~Od}>\xee4\xa6\x87^2\xfe\x14AYpXLH\xaa\xedX\xb6y\x13\x93\xda\xfa\xebU\x04\xaf\x08\xbb\xa9\x0a\x9d\xac\x02\x02\x85\xd8\xb7\x19\x89Q\x09\xe1\xc8\xba\x8b:x\x8dlZ\xa3(\x0f\x908\xa2\xb1\xbbRZ\x87\xe6\x84;)$8\x1c\x17JsJK^\xb1\xd5D\xd6\x17;\xe7\xa4\xad\xa0\xf1h~\xaag\x83\xe9\xd3Q\xcf\x06S\x88z\xe4%\x85\xcdS\xb0<:\x89\xcc0\x9bE\x0c\xad\xe3\xfa\x1b?bfe\x069<\xd9\x99=\xb6\x00G\xa6@a\xf1\xd1d\xb3+\xccP,\xcf\x9c\xc4z\x93\x18\xbdKT\x11\x04\xf5e(\xb1\x9dg\xa7Q\xe99\x19%\x9a\xf5\xd5\xc0\x9d)e\xde\x15[J\xc6\xb20~j\xda\xcc\xa4J\xde\xb9m(\xde\x84\xf4\xdb\xcb\xdbb\xf1p\xa1S\xbd\x9b\x94,yr\x15\xe5\x9ea\xef5\x18j\x98\xbd\xf8\x93\x1bU\x13\x09P\xb0\x15\x1e`\xa7\x11Q\x0c\xc3\x82\xf8\xd2\xb8<\xcbng.\xac\x89\x9c\xa6{O\x07\xef\xa0#O\"\xe4\xc9\xec\x09m\x82\x0c\x01\xdc\x06\xd8\xe0R\xb8\x19\xad\xb6\xd7;x2\xee\x87\x9dJ\xcdH\x04S\xb8@\xf1\xd5z\x95|\x182\x94\xdf\x87\x9a\xb9 ^~2\x95\x08S\xb2c\x0d\xa4\x03\xe9\xa2\x9c\x9bp\x1a\xe6(|\xce\x95\xdc\xdf)\x07\x94~\xaaj\xe0\xd5yp\x1d%P\x7f1\xdb\xcfB\x09\xb4I\x92\xee\xe0\xc5\x13<Z\x1d\xf6\xf3B\xc3\x94\x09\x9ep=<\xd9\x01\x18\xf9\xf5\xa5n-\x0d\x9c\xcc}\xa54\xec\x9aW\xa7\x0aa\xa3\xa1\xc8Qm\xc9?R\"H\x13\xc3\xe8o}\x17\xc7\xb5\xbeB\xf7\xd4\x0bC\xe7\xc4\xa6\x0f\xa37\xa2@_\xe5bPK\xc7~\x08(8\xfd!p\xf0\x15\x01\x95\"r)\xd0\xe2\x0d\xac\xdcr\x19\xdft\xcb\x03\x0c\x1f?\xcdT\xa2R\x800\x09\xeb\xea;\xc9\xd9\xec\x11\xb8\x1b\xe0@u\xae\x1aXb\\\xf4\x84\x06Vqb\x1d\xdb\xca\x0b\xf6\xdc\x13mX\xd7\x8fc\xb0|\x09%\x8fc\x09O7=\x90eX\x1e\x93x\xc4\xeb\xa0\xa6\xd0\x05^n#\x9c\xb7\xd8" +
+	"\xbc\x12rQ}\x8a`\x07[V\x9a\x1d)\x90N\xe9\xd2\xd1\xee\x19\xc9\xe4I[E\xe2)\xbdGB\xed@\xa4\xf4d\xf3\x80\x19\xa2\x16<\x0f\xb8\xc3\xc7\xb2\x90h\xf7W\xea\xa6\x96^\xeb|\xe7@\xee\xd8\xa8\x91l\x01\x88]r{\x88\x93\\4\x0dS\xf7\xf1]\x02\x07U\xd8\xad\xe2\x9dt\xd1<,\xa6\xa9\xf2\xc5\xdb:&d<\xa8\xf8x$\xcd\xf0R\xc0\xcc\xfa\xea5\xc8z/\xb0\xc1\xc1\x09i\x1eItc\x1a.\x04\xae\x9d\x15\x92\x05\x19\xe8\x1e \x9f\x17\xeb\xaaJ\x80\xc2H\x8b\xc9\xd7\"\x05\xef\xce\x97\xd9{\xdc\xcbx-\x8e\x86h\xe2\x8f2\xebdz\xd9\x19\x139\xc5\x87O$\x9f\xf4\xd5\xb8k1K\\!\xf9\x10\x88\xd4)\xcdXp9US.\xcc\x1d\xf93\xb9+\xb8\x13\xc6W\xed\x1b\xd3\xb3\xbe\xe9\xa7\xfb\xb1\x9feps=.\x93~\xc3m\x16\x1c\xc27\xcd\xb9\xf4\xab0\xf9\x8aac\xfc\xe9F\xfa%\xfd\x91V\x07m\xe4\x19\xeeF\x04b\xf6\x0b\xa5X3P\xeaI)\xf9\xf6\xdb\xe0\xf7&\xf82\xb9}(-\x10A\xb5\xae|o\xd5\xa6v8\x03\x14\xc0]\xb8W\xe7\x8b\x1fn`\xfa:{E\x13\xd3\x97\x90\xdc\xb4dD\x15\xab\x8d\x12xD\xa4h\xd1r\x87o\xbc\xfd'\xd4\xaf/\x1eS\x8d_\xc6:+\xf1\xfd\x88Rf.\xee\xebS^\x8fI\xd0\x92\x8c\xcf7Z\x15\x84=\x80N:\xfd\x179\xddr\x93\x99\xeb\x99#`\xbao\xe4\xdf\x08\xaf-\x14tf\xf5\x92F\x970\x07J\x8dc\xc1`\xacY\xc3*{\xdf$\xd0\xff\xa8\xa93\xa7^\xf2J#\xdeY/\xb4\xd2L\xccQ\xcdh\x86\x19\xfc\x07\x00Wu\xfbV\x9b\xd1\x16\x1e\x0e\xbd\xd2*A*@4\x93zb=q\x09<\x86\x1e`\xbb\x17\xf5m\x84\xecy\x023\xcd4\x0c\xe1{}\xb2\xda\x8a\x88-F\x81\xc8t\xac\\\x02\xf9=\xbaH\x0c\xe0\x11*y(\x00\x9d\xcdi(?\xf5\x18\xc5\x021)\xb7A\x02x\xa2u\"\x1f\xfa\xc9\x9a$\xf8w\xa9\xec|jzC\xe3\xfdAN\xb1\x99\xc2+\xbf\xc7r\xd2G(\x8f\x08\xc9?\xafO2\xc2\xd7\xc9\x88]\x02,\x9b\x83\xb0,X \x9d\x1e^o,[\xfe\xd2jW\xc9\xda\x0f\xba\xec\xc7\xf2\xa2lT\xcf\x06\xd1\xdc\xc1\xcd\xe4\x0e\x13a\xa82\xc2\xd6\xcf\xc7\x0egJ}\xbb$\x1aL.qJ\x0b\xc1 \x8f\\\xb8J?\xd6/\x97\x0aE\xf3gg\xfa\x8d{\xa8\xea\xe4\x88\xc8\xbd\xe6\x10\x11T\xcai\xe0\x01|\xa9\xfb:4`Q\"-E\xd7\xbb/N\x81\x16\xd0k\x9aO\xbe\xf8x<\xf9\xe34\x83\xf4\xe6\xf2\x9ae\x9e\xb6\xb4\xb1/\xa0~\x0b\xc3T`J\xb4@f.a\xc5^\x01\xe0\xe2T\xc0\xb3\x08\x90\x19\x9eH)\xc5j\xd64v\x85\xc0'\xd3f\xb6\x97g\x96\x884X8\xe0C/H\xf9\xe1nn\x80\xef\xa5+\xda\xe7\xf8x\x85I\xfe0\xc6\xd0\xd0\xe8\x19b\x8e\xe6\xabf\x1a\x87\xd3\xa9\xa5\xa2\x06\x07\x13\\\x99\xe3\xd1m\xff\xddT|\x80\x031\xfbP\xda`L\xa1\x12\xad\x18#\xf2o\x00\xe9\xa2#\x89y\x9eJ\xfa)MX\xda\xd1\xfd-\xba\xd4\x15W\xa5f\x1d\x93F\xeb)2]\xfbrHX<n\x82\xdd\xc6\xef\x08:w\xa3\x0a\xc8\x03pV\x8aP\x18\xa2r\x85j\x93\xd05+-(wFa\xf8\xfa\xd1\x012)\x86\x0a\xd9\xc6\x0bH)\xaf\xcd\x9c\xe2\xca2\x96\xcf\x1d\xefD\x11\xed\xe7\x19\xd3\x03\x9b\xf7bVW\xa4\xb3\x9f\xd7Ky I\xa18\xd1 \xbf\x04\xff\xf1\x0dj`y\xac\x0a\x0a\xc4\xa6\x80\xc9J7\xe9\xbaS7\x89\xc5\xaf\x1c\xe6\xdbp\xe1;\xc9'\x01\x04A|\xbb\xb3\x84!\xd2\xac\xf4oa\x8d\xfd\xa4,\x07\xc3\x17y\xa6\x8d\xe4\x91\x93&\x97\x1c\x81u\x92\xb5\xd4\x92X\xce\xafN\xb4N\x86\x91\xac\xeb~\x8ba\xbei\xa9\x00\xd5I\x11/\x0a\xac\xdc\x04\x0c'9\xe4\xf8\x99h\xd95E<\xe9\x17k\x017\xab\x9fe\x93L\xc9\x08#\xa4\"nx\x91\xe28\xff\xc9J\xf8\xc3\x17[\x82d7L\xf0@E\xd5\xf0\xa6+\xa0\xa6(\xe5\xbe\x8b\xcdK\x0c1^\x01v\x18\x89\xe3|\xc1Vd\xacy\x85\xdf\xe5\xed\xdc\xb9\xe6m\xf8\xfd,\x91\xf6\xf0\xc5\xf6\xa3$\xfd\x14\x8e)a\x1ct\\\xf5\x92b\xd7X\x02\x00\xdf\xbc\x00\xf0(\xc2=)6\xf7\xa8{J\x92t\xbe\xd5\xf7R\xbd\xae\x97$L\x87-\xf8\x17\xcc/^\xa0I\x1d\x8b{\x81\x1f\xb4\"O\xeab\x13)\xaf\xe1\x8b\x9d\\\xb6b\xe3\xd62\xa1\"\xdd\xc2m\xcf\xae\xb7\xce\x83:\xd4\x81Ok\x03\x19\xbe\xd8\xcd\xdaD\x93\x89\x14\x19\x13\xd5=\xf4\xec\xc3\x10\x93\x16q\xc1\x15\xc8\x1b0p\xcb$=\x93\xbd\xc3\x17{\xda\x99\x91\x99\xd1\"\x7f\x80\xeap\x1bz\xe4\x15!\x97\xc1oLX\xba\xafD\x82\x1a\xa2\x93\x03Wx\x8eD\xf9\xd1\xf2\x7f\xe0Dr\x18\xfd3iX\xcc\x1d\x93p\x0e\xa1\xc4\x04\xd0\xbeQ\x86)\x1c'\xb1\xb1\xaa\x92\x82\x9c\x98\xaau\xd5\x80\xeb\x8f\x90\x89X\xc8\x0e\xee\"\x1bx\x9c<)\xf0\x18\xbe ?SU(\xd1\x09S\xd6h=\xf9\xe4\xfe\xdcP\x8f\x80\xc8:I\xb7\xb0\xc8\x90@Y\xb2j\xdb\xe8\xa9\x9a\xd4\xfa\x91\xca%\x9d\xb6\xc5c\x88\xb0\x87/$\xf3\x89\xdd\x00\xde\x19\xf2\x01\"\x84\x874i ^Si4\x98t\xf5\x1d\x81a\x1cvv\x09\x87I>\xee\xd8\xa0\xa0:7\xea\xa7\xf1\xf4\xe2\xb0\x9f\xb0\x83G\xf3\x95\xce\xa0y\x92\xd7\xd8\\\xd0G\xf3\x85\xe5\xdb\x0c5BF\xf3\xdc-\x80:\xecgy\x9e\xb3PGX\xa2\x8d\xd4u\xd7\xfa\x9a3\xb0\xb6\x86\xa3B\xc3\xfc\x03\xa67\x16B\x93\x8f\x16\x98QRpb\xd5+\xe6^\xae\xb8\x941\xc9\x19\xcb;\xe7\x83\x901\xba&\xd9\xd31UW\x91\xa5.~\xd1dTJn\xb0h\xbe\xc9^\x9b\xa1\x1f\"\\\x0di\xd5\x0c\xedL\x09l\xba\xbd<J#\xe5\xd6\x1f\x15\x0a!\x1e\xebc\xb1#\x1e\x1e.\xf8\x83\x153\xd3MH\xa1T\x9e\x0e\xac\x8a\xc1\xa3\x0c\x83$\x00\xbb%\xff)\xcc\xb0\x04\x13\x1b\x0c\x00\xb1s]E\x0a\xef\xd84Cgc|4\xb6\xc5\xd8\x83Ry\x02\xc2\xeb\xef\xc4\x13@\xc8\x99\xa6\xb9L\x84\xe3\xdfId\xcdz\x05>\xe8\x82\xc9k*\\t\x94\x0d#\x96i\xda\xab\x97q8\xcc\xbb\xc3\xdc2.A\xf2L!{\xe6\xaa\xed\xc3\x88E\xa97\xd2\xcd,yD^\x87\x0f\xbb\xb8\xc7\xe4\xdaT\xd2\x05\xddo\xa4\xd1\xf2\x89\xde\x9bC\x14Rd\x93\xe97\x82H\x16U\xe1(\xb3B\x08\xa7+\x0dp\xb4\x1aX\xcb\x8cdy\x9a\xa6\x93\xb4C\xe1\xfeK\xaf\xf1\xa1\xe5\xdd\xf3\xee\xab\"U.r\xac\xe8\xc4R5\x03\xb7\xe2\x07)rqK?\xc7\xc5\x82\xf5P>[\xaa\xd0\x11\x1c\xf1\xb2\x16\xfc\xbb\xde\x88\x94E\x1e[Y\x99$WX\xd1V\x12\xfa\xd6\xa1\xe7\xe1\x03\x93\xef(f\xa3\x05\xc0\x9d\xceUQ\xee\x01,\xeby\x07C\xee\xe3\xad\xff^\xae\xd8%\"E\x08\xc2D9\xad\x9d\xa2\xe08OQ \xe2:\xb5B\x93\xa8\x0f&\x1d\xa9\xf0!\xe4L\xc8k\x1c70\xa1\xed\x90\xf7\xef\x08\xfb\\\xc8\xc6fw\x10\xc7\xd1\xfaR\x90W\xed^\xff*KKX\xcb3\x9dw?{\xb6p\xd1H\xe2\xb5\xa7\xc7N`\x19\xc2\x80gH\xce)\xff\xf9N\x18r\x07\xe8\x90\x12\x8f\x96\x19\xbe\xfd\x84\xea\xe9g\xa0\xd9\x1f\x0e\x0f\x9f\xee7&T\xfbn\xcd\x08\xe5Y\x88\xec\xfb\\y\x8c\x88\x02\xc9\xfb\x9d*\xd0\"g\xff\x1d\xf4\x87\xdc\xfc\xb9\\\xca-\x17\x13\xf2\x9d`\x0c\xb8\x82\x92\xf2\xc4\x10\x92\x9b2\xe8\x11|Ra[\xb7\xa5[\x0c6$v\x19\x8e4\xbb\xb5\xe8\xdc+\x13\"%\x9d\xa8\xed\x8c\xe6\x84\x84\xe0=\xb4\x04WJ?\xc6\xfaT`\x1b\x90t>\x1aw\xce\xf6\xb02\xf5\x89i\x17~b3\xccpK;\xb5\x0ee\xda\xe6\xb4\xca\xb6R\xd0\x16\xaf\x8dm\xdb\xfd;p\x09\xa2\x15\xec\x1e\xe9mo\xefIN\x81Y\x0b\x13o\xc2\x1f\x10+\x9c\xf3\x01lE\x92F\xc9-E\x7f9\x19o\xb5x\xf2r\xda\xc9\xbb:\xd7!\xfbZ1E2\x8a\xa2\xcfI\xa5\xa7\xa5\xfa\xd2\x8bz\xa5\xd5\x94\x15\x96\xa2K\xd7\xd6O6\xc3\\\x8c8\xeb\x95x\xfb\xe2\xa1f\x1b#/\x86\xacO\x08z8\x0ff'\x99Z\xfb*\x9f\xf1_\xffi\xae\x14\x08\x853y\xda\xcc\x01\xde%\x1a\xb8\xe7f\x9fT\xdc-\x80a\xf0\xdc\xb2\xcb\x09\x90\x90B\xd3\x08\x91\xa96C-[\xc4\xdcZN3\x1d$\x10U3\x0a\x9a\xa06{5^\xd4B\xf0\xb7{Q{\xd42s\x96\x98\xa05\xcc\xd8\x0c\xa9>\xa1\xe8\x1e\xab\xc0\x87:\xe6\xc4\x0a\x84\x83GM\xc5WI\xe1B1\xb6\x96\xd5\xf6\xb9j\xfcmA!Tl\xaaz\xeaz\x0e\xb74\xee\xf8j}\xec\xc2o\xb5|\xd0;x\xf7\x95&A\xb9 Xi&\x1f\x8b\xae\xf6MT\xc0\xa4\xe1\xcc3\x833L\x8d\xac\xf4{\xf8\xee\x11\xb3bI\xca\x93\xf5\xef\xfb*e\x0bu\xbfl\x1c\x02<DE\xc6:\x09)\xb7h\\/\xda\x0a\xabp\xfc\xddPta\x1f\xf1o\xfe\xfa\xab?|\xf9\x09\xfc{\xfdO)?\xfd\xc5'\xbf\xfd4b\xa9\xeb\xef\xea\xbbz\xb5\xce\x07\xfc\"\xf3j\xf4b\x09I\xa5y\x11\xd4W\xc5R\x1ebh\xa0\xd1\xbb\xa2\xf2\x0f\x88\xfe;\xfd\xf8`k\xee\xa0\x0d\xe9V\x1b\x95\x15w\x9a\x19\xd8z\xf6g\xd84\xfa\xce9\x16\x06\xd4\xfb%:\x9c\xe21wJ\xa8Dh\xfe\x98\x86e@\xec\xea\x02\xdd'j<\xf2\xec\x15>\x81\xe4<\xa4\xa6D\xa0\x08\xd0\xbd\xaf c\xf3\x8b\xc1/?\xfb\xea\xb7\x9f}\xfe\xf9'\xbf\x01\x8a\xfe; \xb6t\",\xf2<\xe7\"\x9a\x16\x7f\xeeB=\xb4:E\x03\xe8$\xb9\xd3\xaa\x97\x12\x84mz\xc1\x947%*\xbdc\x84\xadD\x17\"\x0c\x12\xae\x15R\x0c\x1d\xa3\xa32\x96\xd4\x8b)\xf8-\xed\xb7\x98\x0d\x00$A\x10\xac\xbc\xf0\x8a\xa8\xc4\xc4t\x03&g~\x80M\xca\xec\xf8\x13+\x0a\x1170\x1c\xd47\x1a\xce\x09\"#J@\x889\x0e\xd6\xde1\xb4\xb1\xacZ\x9fo'\xe1\xd2~\xef\xbbv\xdf\x0c\xfa\x8c\xa7rI\xd5\xdc1M\xaejH\xd0=\xae.\x1f:D\xb8b\xadT\x12&\x89\xc7\xa6\x80HB\xb2\x0e\x19\x12\x8e\xf1\xe8\xed\x18\x8b\xdd\x0c\x92\x88s\x98R\xdf\xa7\x1c\x88\x82\xcd\x95d\x1fH\x85\xb5\\<5\x10\xb7EO\x85\x19(\xf0'xq\xb2\x1d\x97\x98\xe8\xbf\xa7nrJG]C\xf6\x97\x945\xb9'\xb3\xd0\x14\xdf\xa8\xdf\x0c+\x18\xd7\x8f\x11.\x8aG\x13@\xb5I\xec+O\xc2\xe5\xc5\x0a+\xc4_\xf2\x92\xb6\x9d\xe3\x90\xb0Yq<\x90;U\xa9\xeb_]Q\x92`+I\xf9HhzJ\xc4t\x8a\xfc&\xb5\x1f\x0a\x83-\xe5\x97\xa0\x0a\xd2\xbdv\x8c\xea\x16\\\x8b}JN\xcf\xfa\x7fn\xc6M\xa5\xb2&\xba\xcdX_\xf5\x8eJ\x15\xec\xc3I\\{\xcf\xe5\x9b\x89\x1b+\xe0\x9b\x88\xf9\x11*\x90\x95\xb1\xa2\xb0\xbe\x87z4\xfa\x85\xaf\x85i\xceJh\xa0\x82\x9d\xc2\xd8a\xcc\xf0+i\xf4Bn\x7fAr[A\xaf-K\xdd\x92\xa5V\xbb\x0d\xba\xce\xa2Y\x9f\xf7\xb2\x01\xecY=\xc4\x81\xb6\xd4y\x0a\xac\x0f\x8c\x02s2\xdf\xfe\x9c=\xacO&\x8dB.\xe5\xb6p\x17\xa5'\x19Lx%\xa7\x04\x1eS\x06\xefz\xbc\xa3\x83<T\xd9`\x17'i\xc0\xeb\x9cjsB\xa9\xe6(\xeeC\xe2\xfe\xc1\xf6\x01b\xc3V\xad\xc4\x8cga\x07\xd5\x07\x06&\xb0P/\xb5\xa1\x85o,\x85\xae\x82\xa7nt<\x0c_$=@OA-\xe5\x14\xb1\xa8q\xd7\xd8\xcdl\x83\xa0\xca3\xb7\xbe\xc00\xe1\xc3\xa2x\x9d\xb11S\x89&\x83\x07\x05\xce\xc6Ulq\xbb\x8d\xb84.\xeb\xc9\x17z-\xaa1\xe6\x8c\xc9\xdd\xe4\x01\x98}\xbaN\x19S'vv\x0c;\xac3\xe8k\xa5\xe3`*\xb8PV\x0c\xd4\\\x1a\x92\xd3`Bn\x18u*\xe1\x9eEz\x83*\xbby\xcd\x8f\xf4\xed\x01\xa1\x1a\xb1 \x153\xb7Ri\x05\x82\xeb\x02\xb40\xaf\x90\xdf\xcfF2\x16B\xa9\xcdr\x8a\xd8\xc7JV<EE\x94P\xd9C\x92r2q\xa6\xc8\xfb\xa6:waz\x1dG\xc1\xfa\\$~9U\x8a\xde\xf5p\x97M%Y\xdcK\xaf\x9as.w\xbd\xb5\xf5\x89\x08\xe1\x09^)\"\xd0\xc9\xc1|h\xca\xb0\x0f\x8fd\xc2b\x0a\xc4\x9bQ`\x8d)\xdd\xfa\xe6\xd6\xa4\x9b\x8b<\xa0\xf0]A\x03V4\xd3Hg\xdaef8'yE\x14D\x97\xcd\\\xdf\xa7 \xa1\xe5f\x84\xdey%0\x1b\x95ldU\xaf\x914\xbb\xde\x90\xa8\xe9\xa2\xfd\xc1vE]\xec\x82\xa3?NM\x15\x822>\x85=\xaa\x14\x16\x06\xba\x12\x96R)F5N\xa1e/)\xda\xae<\xa6|\x93\xbdy\x0b'O\\\xde\xf0\x9f\xa4\x0a\x1fp\x1e\xa8L\x81\xeb\x81\xfa*d\xcb\x1a\x03\x13K\xb0\xc5ZO<\x0c\xe8\x12\x115\xbao\xba\xd0\xe8\x87\xd6\xe1\xce\x12\x19$\x92\x94J\x8e\xb2\x04a\xbf\xb8\x02\x12\x88\x0c\xf8\xc9\xf1\x8f\x1cv\xd7Kxa\xa5\x01?\x0a\x7f\x88~\xd0\x91M\x17\xe37XN\xd7\x9b\x85\x84k\x00P\x1c\xb7\xd4\xc8\xb6\x12\x9b\x97&\xeb\x16\x0e\xcd\xceCy\xdf\x18\x8c\x82\xfd\xb3\x13\xb0|\xc7Fh\xd1\xf6\x82@X\xef\xe3\xc98\xf8\xa6\x1b\xf0\x86\xf3\x92\xd9o\xc0\xc4\x04`\xa0\x0e\xf2\xe2\"_\x1f\x91\xd2\xe1\x18\xa8|L\xeb\xcb\x1e\xd8\x1d\xa8j!3L\x1bI\xf7\xfe\x81\xd8@\xc7\"\xc6\x8a\xd2i\xe2\xbcb\x9b\xa7\xa6w\xc2\x04\x14\xa2GqL\x89\xee\xb8\xe2\xe4]\x0d\xce\xee\xc1\xbf\x8b\xe7\xdb\xceD\xc1\xf4J>\xc0\x1b\xc8\x81\x9fF\xff\x06\xd1h\xb7QH\xa1\xdb\xa0\x1eZZ~\x8e\xa4\x90\xaa\xb6\xf0\xa2!\xc1\x11\xf4@B\x0d\xb8H\xef\xe0h\x9d)\"\x9a\xf6\x06,\x0f\xe1\xc9\xf3'.YjB\x0b\x00\x06u\xbe)W{\xe9\xe4\x14B\xa1\x93\x10\x80]4e\x96\x1c\xc1\xf9\xf3DV\x0f>\xd0\xdf\xcd\xc2\xf7\x8bs\xea7e\xf2\x9b\x09\x04\xc2?\xf6y\xb3\xa18\x14QR{n\xe4\xb4%\x05\xb2 \x8b`\"\xe4\x93.\"d81\x92\x95P\xa6Z\xb5\xc1\x85ZQ\xeae\x09?O\xbf\x87\xee\xcf\xfb\x1e\xee\x8f(\x1a\xab\xa4$\xcb\x99\xccMb\xdf\xa0\xc1\xe2\x02\x0dF\xe5\xdb`gV80(\xa9\x12C\xcf\xa2ug\xad\x0f\xbf7=\x8e\xa8\xadm7{\x98\x92\xcd'Y\xd6D)^Ub,\x82\x0d\x89\x82\xc1j\x9fR\xb0wk\x84\xfa\x14Q\x05u\xc6*fy\x80\xb9\x04D\x05%\x0c\xb9/U\x91\x7f,`\x00[hFQ\xae\xb8J\xd2\x80\x86f7\x02\xc6\x07J/\x96\xf2|\xa3\xe6\xc2\xc0\xcd\x96\xcc\x96\x01\x82\x81t\xdd\xd3\xfc\xc5\xc41K\xa1\xed\xaaGd\xb8\xb5c*h\xaf#Ft\x1dq\xc8\xe8\x93\xd2\x12S\xe8\x8a\x17\xa2\xbb\x02\xfd\xcag\xac\xae\x17P\x81\x16\x9e_\x06/\x83\xa8\x8c\x88\xac\xb4\xf2\xa5D\xc2\x88k\xb13j\x19\x99\xc5\xc2\xde\xc7\x1e\x07\xa8\xa8U\xf3\xaduV'\xdb\x9e7B^\x89\x12\xca\xf9\x8a\xb8[\xf0\xadin\x0a\xd5\xd3+\x98\xd3\x8b|.\x1f\xb3<\x9c\x0b#~\xba\xa9E\x18\xb6\xbe\xed^Rm\x89@\xa54\xc9\xb3@\xfe@\xf0\xe4t\xb3e8\xc5BY\xe8\xd8\x04\x89a;M-BB\x8an\xf0\x82\x8e\x19\xa07\x90\x01\x82~\xf2E05=fn?W\xf2D::\xbe\xb4\xa4j\x92\xa0\xb4\xef\x02\xc1.k)\x98V^d,g\xf5\xd9\xe6\xd8\xff\xa6T#\x0a3\xab\xbai1*\xeb1A\x89V\x9e\x94\x01+I\x05\xe3\xbd\xb4U\x92/\xad\x1c\xc4)X\xcfl\xfd\x11S2\x03C2t\x9eM\x17\x14(76\xe7\xe4.\x9a.\xc7\xc4\xcc\x13\x033\xa1\x11\xb9\xeb\xf3\xbe\x879F\x04u\xc0\xcd < /\xe1#\xf1\x95\x8c1\x8a\x11e)\x19\xd3\x1fKX\xf1\x15/\xb1;\xc0\xfd\x9fp\x1c7\x16K\xa8^@\x92\xfa\x03\xc2\xee\xbd\xe3\x12\x9eA\x1d\x957=_}\xdb\x05\xe2\x19h\xc9L=m\x99*2\x14\xca\xf7\xceP\x8c(\x82\xeb|\xc10\xd6\xcb\x9f/8\xd9\x1e\xe6\xf1\xcb\xb1T\xa1\xd4\xe7\x89:[\x11B\xd4\xaf\xc1\xdd\x9d\x09\x80X3\xc1\xefF\xf0\x04k\xd5L\xa1Q%\xac\xe0\xb7a\x0d\x94\x0a\xa2\xaa\\\x09m\xac\x99\x86d\xc9\xf5w\xa6\xf6\x84Q" +
+	"Z\xfb\x80\x1f\x98\x0b\xb9o\xaex{\xabm0V\xd8\xd4\x00\x86\x18Z\xbe\xfd\x82>?\x0cp\x15\xdc\x04]\x1c\x81\x0adqG\x852j}\xbe\xed\xde\x93\xc5\xd9\xcb\x98\xa9\x11\xf9\xee\x98\xfa\xb7M\x01\x0e\x93q\x82\xb1Q\xfd\xdd\x9c\xf4\x09\xe7\xd12\xfc\x00\xb6\xfe\x8f\xcd\\o\xbbQ\x86\xca\x8a#\xccJ\x09\xbfU\xa8\x0c\xa2\xb9\x08^j\x0f\x8d\x983\x03C\xe9 8d\xba\x09\x11VI\xd56?\xe1%\xeaw}\xcc\xc1N\xdf\xf5\xc1*w\"\x9b\x17G^\x82S\xcc\xf8\xf6\xc2\xb8\xa9<\x95\xf1\x8d\x91\x10\xb3\xaco\xf9U\xb3\xd4g\x94j`\x06\x1b{J\x8bO\x0b;ha\xe4\xbf\xef\x11pl\xef\xb6\xd3\xb0QI\x99\xf2\xffZ\x80\xb9\x12\xa4g` \xde\xca&Az\x97\xf5a\x02\x07\x02\x89(]I\xa6\x00\x05\x036\xf0o\x0dj;m\x8at\xe8\xa1\xa5/\xb2\xf9w\xbdtKcHxw)Q\xa2\x99C\x8e\x95\xec,k\x15\x9d\xd9\xf9\xc9S\xb83O\xbe\xfcs3\x02y\xd1+\x9cO\xec\x1b\xec\xc8q\x08\xffe\xc3\xa9\xdf\xcf\x8d\xc2$\xd0\x06V\xdd \x92\x02Xu\x14\xd7)\x8c\xd4\x94Y\xc7V\x91\xf0;\xbdP!\x08p\xc8\xb5\xe0\x0d\x93\x12Z\x87n\xe2\x1e\xafv\x90\xa4mJ\xbd\xa2L\x96(\x1a\xb60l\xd0\x96L\\Ii\x8e\x05z\xe6\xa3w\xd7\xfa\x18\x87n\xd7\xb8\xeew3\xa2r\x09\xf0[\x07\xfeV+\xce\x83\xf8f\xdd\xc0\xc7\xe69J4\xd9\xcd7\xb4&\x9ctc\xc6\xa1\xeb\x9bU\xdcy\xa14\x06F\xa2\xe3c\xa4\xbd\x0d\x83\x1e\x18h\x99\xa4\xa4\xeeF\x08\xb4P\xe5u\x10\xee0b\xb8\xbdX\xf2b*Y\x0a}>_\xdbO\x16\xef\xab\x9dz\xc52\xd0:\x04\xc3|\xdb\xe7;\xf2\x09F|6\x8f\xf0Dj\x81\x1a\x08\x08\xca\x1bx\xfc\x0bK{-*@o1\xf3l\xe5\x8d\x89:\xb9\xec\x15\x8a\xed\x18V\x9b\x01\x93\x0f%\xa7\xd5\x03\xba6\xdf\x02\x96\x1b\x8aH\xc7\xea\xd1\\\x82\x97\xfa\x8d\xd2\x1a\x12e^\x8f#YR\xaa\x02\xd9J4u\xbd\xfd\xbc\x9d\xed<\xa0\x96\xcf\xf4%A\xc0\xc7L\xce:\xd1rH\xe6]\x1e\x0d\xb2\xdb\xd9\x11J\xc3%\xbc\xc8E\xec\xcc\x13\xf5\x81\x9cBLi{1\x1eT\xa2BX\xebR9\x96\xd4\xe3\x80\x8e?\x01tDp,\xeb\xd9z\x19\xcd\x92G\x0d\xbb\x98%\x94*\xcb\x1c\x18\xae,s\x9ea#\x1c)\x99iR\xac\x88\x03w#S\xd4\xf9\xc0\xedF\xc3=\xfa\xe7\x83vr<\xef\xfd\xa8\xd2<\x94\x83f{,\x18r7\x13y\xa1g\xc2\xc6\xdc\xc46=.\xb3\xc7\x15z\x07\x1e]\xe7\xdb\xefu\xa1G:\xd9= \xb9/i\x03\x04\x10\xccL0\xee.<\x82\xbe\x8d\x83\xddL\xc5\x05!\x17:\x97\x92\xb2<-\x82\xf4\x0f\xa6\xc5\xe4\x0bF\x1e\xe5\xf2\xb7\xcc\xd1|\x13\xddm~\x14)\x03!^\xb7\xbe\x84\x83o\x91\x12\x93\xf46\xa0\xec\x9f\xe9\xf8\xbc\x83\xf3\xac oz\x12\x12\x12r\x1dH\xee\xe4y\xeb\x97\xad\x1f\"Fr.\x84\x04\x80\xb7\xb09\x8d\xaf-\xaf\xcc&.Z\x98\x1f\x19S\xd6L\xf4\x8d\xa6\x12)\xbe\xf0\x1eD\x90\x03\\\xb3<,\xaf\xd7^\x0e\xc6Aw\xc9KpePs\xb8\xacb8\xaa}P%\x8bk\x81\xb1E=\xa4\x0578u\x0e\x93\xba\xd0\x95\xca\xfe\xe8\xa8\x8c1\x15\xd4\xe0u\x85N\xd9)\x9c\xfd#\xb0\xff/\xc5\x15\xe8g\xb0\xb4\xe8@hN\xebW\xfc\xd4\xaf\x09\x8d\xf3\x12\xd1\xb0\xb7L\x1a\x9fG\x83\xbfHy\xeeLj\x9f-\xd2J\x9a\x83S\x00\xf4\xf7\xbb\xcdP\xf9\x91\xa7\x90\x04\x13O\xec5\x0b\x1dC\xfdl%\xb0M\xef\xa8\x9e\xb6\x92X0\xc4ox\xc78g\x12c\xaf\xe3\x0c0\xf9p(\x94\xcc\x8e{\xc1\x94r#\xa8\xea\xae,yu\x1e\xb6\xcb\xcf\xb0\xbe\xfa|]\xab\x89\xdc\xe6\xfe\xee]\xbf\x16@\x8fU\xfb\xf8i\xcbk\xd4K\xe4\xfd\x87\x02<N\x9a\x04\xf2\x0ac}\xac\xdc_@0\xb4\x03\xa0v\xb7\\~\x1e\x09\xa4lN\xf5\xc0KI\xaak\xb8\x85:\xe9<\x0f\"|\xfbG\xd8\x16\xc6\xf2\x90\xdcz\xe8\xcc\xd3\xa4\x1f\xe4\xad\x18~\xe0\xfc\x0b\xd9\xfa-9\xbe65<K\xdc@\xa9[\xb2I7\xa5_G\x89\xa3\x18X?\x8a\xa84\xe4\xf5\xba\xf6\xba\x8eSG\xd3\xd8\xc1\x0a!\x90\xc2{\xd5\xb4\x85\xadc\xb6\xf3\x88\x12\xa2)\x13R\xe6\xfe\x92U\xe9\xb8\xf2i\xf6T\xf6W\x1a}6\xb7\xb2d\x02*\x16gB\xb6;\xb4tZ\xf4jk\xda\xd5\xaedZ\xee\xcfu\xc6\xca\x9e\xf7\xcd\x15 e\x88\xd9\x09\xf7\x90\xc2\xf6\x0b\xca\x06\xcf\x12\x1e\x11\x8b\x00I\xda\xd2\x80!\xe0\x144\xe9\x8aAX\xa5\x8b\x1eg\xf8\xee\x9e9CB\x99\x9bl\x85\xaf\xfd\x13\xae\xfe\x00vai\xc4\xf1n\x85\x8c|eOn)L\xdc\x9c\xf2v\xb8Gwa=\xea\xef\x01\xb1u\x01\x1b\x01E\xd4\xd3\xf6\xd5\xfa\xa6g\x86rw\x7f3\x8e=c1\xa7\xb2\xf6E\xa0g}48\x94\xc5\xd4Cp\x8a\x00V\x86\xb8]B\x92\xacT\xa8\x7f\xa5\xe8\x89\xa7\x89\xa8~Nm\xedt)\x9d\xe7\x19de\xae\x84\x84\x99\x16\xbb\xb2\xd2\x8d\xfa\xd3$9g\xd2\x0a7\x88\xba\xe9ZTi\xd5X\x04\xb1\x06\xf0y\x9e\xe4C\xd83\xc7\xc6r\xb1dN8\x1e\x8c\xb9\xcdB\x82\xa0\xab<\x08\x9a\x8cU\x02\x8b\xe5\xcb\xa6\x9d\x0b\xf7\xdaI\x92C\xa2s\x90mm\x85\x13\xaa\xdd\xf6\x18\xbb\x04\x0b\xc8\x14\xa3\x05\xec\x86\x04P\x0b\x16\xb4j\xc6&>\x95\x84\xabr\x94\xf1\xd7\x92\xc2\x8c\"\x9aB\xeb\xb9\x9cj\xa6\xed\x8c\x05zE\x00\xfe[m\xd8bC\xff\x0f\x888L:\x0aF\xc1\x950:m\x0b\xad\x0cC O\xf9\xa6e\xbf7\x85\xa4ys\xcaT\x1d\xf9.\xc1\x17N\xfeaN\x9e\x9an\x12l#\xfa!<\xd6\x1a\xb0!\xa6\x04\x86d\xfd\x06\xf2\xcd2\x9d\"\xc1\x06\xc6\x14\xc4\xac)R\x7f\x06\x01\xa2<\x0f)i\xc0za\xd6K\xf8\x9c[8\x9cga\x87\x05i8\xb99\x04\xb3\xba\xc2 \x86\x0f\xbb\x02|\xe8\\\x88\x8b(\x92u(\x0a\xbd\xfey\xd2\xf5\xe6\x01%%Q\x1f\xed\x9b\xd3\xdb\x1b\xf6\x9c\xd8\xaa-\x85}\x05m\x82\x8c\x0a\\\xff\xa2\xfe\x16\xa0\xe2\x05\x85\x11\x10\xc2e\x90\x83`\xb8\xd6O}\x87\xde\xfd\x155{\x13k\x00\xdcG\x00*8\xf2\xc2*x\xa8\xdft\xbb\x84{2w\xacn\x86\x92\x83@\x84\x87\x04\"*\xfd-\"\xabc\xc9\xe6\x15\xef\xcf^\x1aIF~/[AQ\x9dx\x0e\x88A\xc5V\xd0\xcft\x87\x8b\xa2\xcb\x0eW\x01\xd1\xde\x8bh\x88u\xe3\xfa\xd2\xab\xec\xf7\x8e9\xf6\xb6\xbd\xc1\xbaZ/\x9802\x0b\xb1x\xb9\xcc\x16\xe5QDII\x93EJS\x91\x96z\xd6\x7f\xaa\xdf\x92\xe8\"\xf9\xd7\xfc\xe7?2\x95?\xd7\x93;\xd6\xbc\x85Lbfj\x14u1\xd6\x88\x9d\x81\xa4\x1c\xe4\xac\x0a\xb5\xf8\x99\x97\x89\x86\xec]|\x08P\x1a\x16db\xd7q]\x87\x11J\xbdw\xa5\x1f\xaf\x99\xc8V\x10|\xaa?\xdeS\xaa\xefX\x0f\xa6\xae\xa1\xaa\x96j\xeb\x9c+\x10\x0f|\xf8\x00\xaa\x86'i4B\x0am\xb7F\xa1\xd5\x05\xd6\xe9\x8d\xfd\x91x1;\xa6e\xd7\xc3Y%\xc1\xac_)\xf7B\"Gx\xa3\xbb\x11\xa4w\x1c\xb5\xb7\xd7\x12M\xbal\xfe\xebu\x15S\x09n;\xfd(>m\xea5\xd5\x0b\xd8\xe1+(\xb1\xf9m\x86\x93\xe8Dr\xb7a%\xa8Fs82]\xeb\xbc\xe9Q:\xdc\xdb\xcf\x95\"\xa27wa\xdeLD-e\xcb{\xc9\xa61a?t\xa8\xd1\x815\x1f\x91\xb7$\\\xe6k\x0fB\xa6\x08\xcf\x17\xdc\x1f{Ij\xc7a\x10\x15\xde\xa4\xbb\x1d\xb67zt\xef\xc0\xab@\xc7\x19Tt\x03\x98;x\x0d\xce\xdd(\x99\xb6k\xb8\xbb\x90\x1f\xb0o\x14\x9d\xfb\x99\xf5\x86]b\x8b\x1b`aB~\xdbT\xf4\x18\x13b\xf2\xa87\xb4\x02\xbd\xb6\x8f\x16\xfa\xa7\x17\xce\x8d\x01g9\x958\x99\x12\x9eS\xc9\\\xd5\x088j\x8a~\x03\xd0\x1c\xe1\x00 y\x9d&\x1e\xc2\xe3t\xbdU\xf8\xb2\x1f\x19{\"\x90\x02\x12\x04?2TZ\x9b\x8e\xabzX!\xa6J\xef\xbe\x80\x8c\x7f+\xd3\xc5\x8bl\xd5_;0|\x07\xa2\x8d\x11\xbe\\\xacO\x19\xd2\x9b\xcc9\xc4+\xd1\x00\x93nD\xa3\xb9\xa0bVWK\x06\xff\x03\x1c6\x17t(`\xda\xc9K)yMC\xe1=_\x81c\xac\xd9\xbc\x127\x12Ga\x936\xa0}\x9f\x08\xde\xea@\x0b\x14\xf3%\xa6\xed#C\xcc\xfaD\xfa\x0e\xd7\x0e\xd2`\xaa\xb8\x80%5\xa2\xcc\xde,&$d\x831\x09A\xceH\x13F\xe6\x9d<\xa0\xd7L\xe4X\xfe(sP\xe6\xba6\xdb\xe43\xc6c\x0a\xbd\xc7\x80m\xa5-~\x069+\x90'\x95\x92\xbci&\xbc\xbb\xa9x\xd0\xc2\xaci\x7f\x87\xee\x19\xd5\xd4\xfa\xd7\xc9\xf6\xb7\xbdL\xce\xb1\xa2\xf8\x85\xfd\x8ad\xc8\xa2T\xce>\xcb4\xad\xd2\xf4e\xeaDO@\x8b\xd2X\xc36E'\x8ch\xb2\x19<\xe2\xa0Z$\x82)\x96D\xa8\x0c\xd7cPv<)O\xb4o\xd1\x94\x93\x14TA\xc1\xf7\x15\xbe\x8e2G\x15\xf0\xaep\xa3\x03D6\xa5\xa7\x8c>g\x08\xc8\xf7\xae\xd2b\xfe\xa1\x95\xdb\xa9\xc7\x03\x18\xfc\x9b#\xf5\xa4kC\x10\x96\xd1H\xc2\xe3Xi\xa5B\xads\xfb\xad\xb0\xee&\x9c\x8ei=\x032\xce\xed\x94=\x9b>\xd0\xfe\xfd\xbd\xfe\xebT\xc4AU\xc0\xb5\xcb\xd5j\xf1\x80*\xc1\x8f\xbc>\x82\x8a\xa19\x95?\x93\x8d\xfd\xce\xb2#\xde\x85`\xd5U\x01\x18\xbb\xf3F2\xb5\xb4q\xe8\xba\xbf/\xc1T\x04\x02\xe12*.<\xbf\xec\xa2\xd2\xaa\xe2\xbd\x06\x90\x88\x8a\x81F%H\x812\x13Z\x89\xdb\x0b\"\xc0M2\xf6\xfb\xdaw<\x0a\xcb\xbf\x12l:\xf1\xd6\xf1\xedb\xa7eI\x92\xc1\x95\x0c\xda&\xd1\x19R\x1e\xf1G\xa2\x0c\xa8r\x94)\x16[\xc4*\xe0\xd7\xe8\xcb\xab.\x8d\x8e/\xdb\\\xfa=D\xa0\xa7\x1d\xd5\xf7}\x9b\x0fNt\x99\xe8\xa1G\x94\xfa\xc0p\xe0\x16t<\xe2\x918\x15\xda<\"\x17\xc8\xbb/-BKb\x88\x0c|\x16\xd1\x13\xb6\xe9\xa8\x99\xf4x\xbb\x83\x17N}W7]^\x12qW\x05+\x15\xb4\x89K\xf8s\x81\xca\xa41\xac?\x12}\xceb\xa7\xaa\x1f\xc6?\xafG\xc9\xfb\xe1\x15\xa3|1[:\xc3\x9d\x00{\xf2\x16\xf5\xde3}\x1bq\xe9\xf7\xb1\\\x07\xc3t\x04\x94*\xc9\xc0\xb4\xa3\x94\xd8\x15\x10&Z\xe2\xc0\x17\xd4 8NK\xda\xe6s\x8d\xd8~K\x9e\xc5Q\xfb\xc3j/f\x9a\xeaA\xba\xf4\x15\x94\x11\x86H\x01\x9d\x98\xfa>\xc6z\xe1E\xcf\xd8G\xe6\xc6@\xa6\xdc\xcd2qi'M\xe4\x91=\x94\x14\xfeHG\xd8\xaf\xa9E\xa9c\xae\xb6[\x08O\x80\xd3]\x05\x16\x89\xe8XR\xe7\xd7\x91x\x81I\xf3\xb0z1s#c\xdfJQs\xa7)\x89\x13\x95\xb0\xc2t\x84\xa2\xe0(b7C}\xd7\xfe\xae;>\xb8h\x1cU\x14\x0d\xd7\x99zU\xe2\xbd\x07<\xb7\xf0\xc8W\xfd;\x9a\xd7\xe3\xa9\xb9\xac\xb19\xc7Rv\xb7\xbe\xc6n\xc2(&5\xcb3nj\x1c\xb7\xc0\xaaRi\x98\x91\xf8\xa4T\x9a\xf6\xf1\x9cYi\xe6\x89Y\xa2\x0c\x03\xb1\x8c\xa9\x9a\xcb\x1e$\\\xeb\xd5#}\x80\x83\xb4\x19\xe7{\x04\x0f\xaf;2T\x15k\x0a\x14\x0b'\xc4Cy!\xcbU\x9b{\xeb\xbd\x9d=\xd1\xf3\x93x}}\x93+\x07\xba\xe5Z\xa1\x1a\xd9\x86\xbclk.\x16G8g\x14]\xeeZ\xf5\x9a\xbd\x0bO\x07\x07.j?N\x89\x12~@\xe0>\xabc\xb2\xb9P\x00\xf2kx\xe4\xd2\x02d\xf1\xcc\xbe$\xbc\xb1\xc7\x92\xde\xdd\xdb@\xa6\x94.\xc4yTq)\x8d'7m\x08\x02\xb3\xa2\xf4|+\x05f\x09\x85\xdf\x93>\xc3x\xb8a#\xc78-q\xf6\xec\xed\x98xbi \xe9v\xce\xfa^\x0b\x1ev!]<H\xaa\xbcz\x13\x1f\xf7\xc4*\x1f\xbeh\xc1\xa7\xc6\x9d\x8b\xa5\x7f>\x11e\xa1@vNR\xf4|\x1d\xde\xb4\xe0\xad\x7f\xd5\x1e\xefD\xc0\xdb\x02d\xdaZ\x9f\xd7\xc1\x88.5\x86\x91\x1e\xed\x1a\xedL<\xdfqo\xc2\xdf^\xc3\xbe?\xa7b\x0d\x10\x94\x9ds\xf7\xd4\x08\x1c\xdc\x90\xf2\x10O\xce\x11X\xfc@\x91\x0c\x0b\xee.\x18\x8c\x1e<\xa4[\xdekE!\xe6\xa3\xd0w]$@\xb1\xe0\xb3IF\x10\x0d\x96\xf2\xe3\xdc\xe3\xc8b\x08\xc9X5\x86B\xf2\xaf\xc8I\x00\xb4v^AP\xd7mN\x0f\xb7\x93\xb7\xb5@Ta\xbe\xb1f,\xa6\xb0~K\xbe\xf5z\x92\xce\xcd\x8b\xac\x92\xa6\xb7t\xe8*\xd9\xc1\xe8\xaf\xf0\xc8\xa6{Dx9o\xbdk\xbf4?\xe6\x8a\x14q\x0a\x8a\x03\xd1\x1b\xfb\x13\xea\xf5\x92K\xee\x10\xfe\xab\xa3\xfe\x04\xfb\x02I\xee.\xe9}F]\xec\x97\xf1\x98\xba\xe8\xc9\xb4w\xb8\xd3\x01\x0a\xb2v\xa7P%\x05\xd5\xc3\x02\xa7\xf6\xfa\xd8O\xb5\x0f\xa9J\x17\xa9\xeaE\x07\xa5\xc6\x0d9\x80y\xe6\x14O;\xec\x1f\xff\xe2\xbb\xfd\x9a\xdeZ\x1bk\x93=qFX\xc4\xc8w\x89Qy\xaa\xbc);\x82\x94\x86\xbc\xcb\x9b\x1e\xcb\xdc6\x9b\x9f,\xf0\x09\xb5`m:\x1a{\x9eO\xae\xaa\xbc\xda6\xd4\xf7\xf1\x1f\x04_\x13\xa0e\xf4NM\x87Tgs\xd7s\xb3r\x1c\xee{\x99\xf1\x99\xec\xd4\xd7\xabI\xe5\xc1}\x01\x9a\x81\xbeTI\x95\xe2\xb1\xe4\"\x9fJ\xdf\xd4\xa1`\x86\xac.r\xd2\xffQ\xe4\xc3\x0c\xc8\x15\x83\xb6\x9b\xb8\xa2\x82\x81\xdaL\xfeH\xd6n$\x93\xb9h\xed\x8aza\xf3f\xc6}'\x8b(8.[Q\xde\x81\xff-\x921\x97\x04\x908\x11\xe5T\x05A)E\xad\x06\xcf\xad\xd7\xb0\xa9\x97\xeb\x1f\xbc\x85$\x89\xa98\xdd\xc1\x11\xdd9\xc9\x07\xbe\xde\xb7\xc6\xbd\x9ch\xae|\xa3\xfd\xcc\xf5Evm\x1d\xb9\x91\xff\x8a\x1bz\x0e\x0f\xcd\x01\xac\xe5\xe9\xea\x15\xb7\xc6e\xa5\xcam\xdc\xc6\x85\xbebcL;b\xd3\x80\xac\xe7,\x17p\xbc\x06\x0a\x94#\xd8!\x10!\xe8B\x9a\xc08\xfat\xe7\xa4\x99,\xe3\xb8\xe0d,\xc8\xc4k\xd5\xe1\x05\xa5V\xda\x9a\xe16v\xbe\x87/^\xe4O\x9d\xf8\xde\\\xe0^\xba\xa0\xf6\xfa5-\xec\xef\x9b\x8a}\x05I3\xa7\x9c\x07\xb7(\x03\xa9\xeb\x18a,\xb3\x04D\"V\x8c\x8b6\xa4\xb5{b9\x8ePd\xbbsPZz\xe9\xdber\x96\xc0T\xf2\xc0\xa4\xe6\x91\x8e\x12\x8e\x1eb\x16\x0a\x7f\x84}\xc7Y\x9ay/]\x97\xbcO3gJ\x9e+\xb5\xf8\x06 O\x10\x9e\x0c\xe8\xd4E\xde\x14\x01L\xf5\xa5jv\"\x1e,\xf3\x9a\xcdd\x83\xd6\xc9\xe1\x0b\x0f\x91\xa9\x07\xf5\x01\x0c\xe5\x84\xa1x\xec\x82\xad\x17W\xfcMJ\x89\x9a&C\x161]\xb0qK\x0bq\xab\x10W\xb9V\x05\xec1D\xde\x10CD=\xef\xd5\xa12|\xb1\xadTo\x0c)\xae0C\xb6\xaeW\xf8\xf0\x0a\xf3\x8et\xd1\x88n1\xe8\x0c\xe5I\xc4\xe3\xf4A2\x04Oz\xe0Q\x86/v\xbc\x1c\xbf\x8a\xc0\xad\xaejE\xc9\x92\xce\x85\x7f\x05\xbc\x87%\xfb\xf7\x8e!\x7f\x82\xe2J}\xad\x12\x87\x0a\x98\x05\xe2\x91\xdd\xd0\xfa\xe1\x0b\xdd\x90\xe5\xf1l\xa9\xa5m'\xc1\xfd\x05\xf8\xe4\x05))\xf4h+Sg\xc2\xc4\xc8~\xa0\x07\x00\x99\xd1>\x9a\x1b{vr)\x9b\x84\x14^\xde\xb2BT\x03\xf3\xe7\x17H\x95\xa3j\x06*\x97\xc4\x9f\xad\x1f\x1c\x12O\xe7\xfc\xc0os\xea\x06 V\xdeE\xe8\xfeb?\x090\xeaou\xe8\xb8\x10\xe0\x94\x18\x86\x09\xfaL/\xd0\xccK\x90PB\\\xadZ\xce\x80{\xea\xcf\xaa\xe3\x9e\x809\xc8\x8f\xf7M\xa6ip\x06\x82i+\xd1\xae*9L\xd7\x07\x07\x0b\x08\xc7H\x1f+\xa8\xed\x03v`\xcb\x0b)\x11Ik\xda@2\xea\x0d|\xb5\xab2\xfd>z\x9f\xbd\xe5\xaf\x86/\x0e\xed\x83n2 X\x0a\x96\xa9 \xbc\x8c\xd3\xb7\xf1:h\xdcvg\x0d\x87\xc3\x17\xbd\xba\x0f\x85w\x1bW\x9d\xfb\xb5D\xf9Ot\xd9\x89h\xe8\xca n\x05\xbd\x00U\xcf`\x10\xa2\xcc \xb1\x83\xa3\xf1\x89\xf9\xbf)k/h~(dDf\xb0\xfc\x98B\x94\x94\xfd\xb3\xa4\x8e\xbd*FZ\xba\xf5I1\xf0T\x03\xcd\\\xa2B\xa9\xbe\x15\xb1\xe1\xd0\xab8KaIh\xf7.\x85\x86\x12\x0b\xd8sh\xa1c\x13\xa9J(\x90s\xb7y\xa5m\xaa\xa6\xc7a\xbf'h\xd7q$\xa3\xe7\x8cN0v\xd0W\xd7\xe7Z\x8a^" +
+	"\xf1,I\xc8(.h\xf4\x92Q\xa7k\xceF\xf38\xa3\xc8\x95\x97\x12=\x90R\xa2\xcdqs\xd2\x8c:eD7\x1dv\xe3\xff\xc9\xf3\xc2\x8a\x8bf\x05*\xf5\xd4\x19\xb4C\xc1\x94m1l\x0b\xef\xfeg\x91\xe0<\xa2\xf8`\"5\xdd\x03I\xc3z8b\x9a\x9f\xa5\x07\xfd\x96M\xaa0H\xec\xc6\\\xb6\xcdj\x1f\xa0\xf8\xe7#\xfd%\xc4\xa3{ix\xa2\x8d\x0bs\xfeJ\xb2y\x13\x82\x1cs\xb5\xb7\x1b\xa8n\x9a\x01w\x06\xc0$\\,G\x9cM\xe1)\xf3\xd0C\xeer\"\xd3.'F\xd2Y(@\xa0\xeb\xa0\xa8\xea\xa1IoA\x8e3\xf9\x05q\xe3\x87\xa2X\xbd\x84?`\xbb]\x04\xd83\xe5b\x19\x95\x97T\xc9\x7f$2k|Y\xc1\x86\xe0R\xac\xc9\x8cD\x18\xc4\x0eV\x8f=\x19\xff\x84wz \xa6$,\xc6\x04F\xfc\xaa~X\x9b;\xc5\x85\xab\xa1\x95\xdf\xacc\xf5W\x09\x87\x92\xa2\xee@\xc3\xe0\xb8sx6\xc9\xfa\xa9\xe0\x91y\x85\xeb\x8bJY\xbd\xd5(/r}\xb0V1\xf1\x1b/\x86\x03\xefv\xaaam'4\xfb\xa8q\x04\x13\xa1\xb7\x9b\xd3\x86\xaf\xb9A\x1e\xa8\x09\xa0\x97.\xa3\xd6\xcd\x87E\x0b\x01W\\\xa1\x8a\x15\xe6\xca\xd5$zG\x89\xd5[\xcc!\x86\xb4r\xfd\xb6\xa7p\"u\x9b\x80x\x16\xba\x11*u\x08|\x90\xf5\x0d\x9d\x0e\x82\xa0\xf6$*,po\xf0\x0a\xeac`\xbf\x13\x95\xfe6\x0d\xe8\x07\x18\x82EO\x01CN\xe7`&\xf4\x1bFU\x06O\x09\xf2_e\xda\xe9\xa4\x9f~\x94\xa6\x83\xb9W\x1b\xa5\xf0\xc4\xa3f\x81~\x09\xaa$.$\xe6:\x9d;\x85u\x8d\x0d\xc2\xfb\xad\xa0\x1ev\xdbW\x0d\x11(\xb1\xe0\xc9=|\x99K\xdefH\x09xT@$]\x92\x8d\xd4\xb7\xdd\x0f\xb4\xe5\xa9\xe8&b\xc2\x0a\xef\x01\x15J\xc4wI\xe6\x8b\x81\x90\xf0{\x1b\xd3\xbc\xac\x95\x82\xbb\xf0\x1d\xea\x1aU\x82C\x18\xd8\x858\xbb\xdfL\xba\x1f~;C\xff\xd6L\xa3\x9d\x0e'u\xe9`}\xa6pb^\xaa\xb3'\xcd^\xa8\x9a\x19M\x80X\xabg1\x9a.\x94AMXC\x98\xf6\xd9\xa9\xb8\xb0\x86x\x0fy>\xc1|S\xd4\x1f\xf0Z}h\x04E\x83|\xac\x8c\x88@)\xb3\x99J\xb9\xb8\x1f\x9bp\x94\x85\x7f\x11\xa3e+o\x86j\x17\x0c?\xb5W\xe8L\x9b\xc8\xb1\x87\xdd\xf7!\xfc\xff\x9e\x82\x82i\x9a\x95Hi'\xfc\xeeIgI}\x95{rI\xcd\xfaRT\x18Z\x00X\x99\x96j\x8b@6\x89\xf5\xf3\xa4\xa5\xaa\x90\x9dL\x82>\x19]u\xee\xb4Q)\x95o\xac\xb3\xdf\xd3\x7fqu\x02\xd77\"!Y\xc8\xf8A\x8d\x03\xb8)3\xcd\xd9B\x96)\xf5\xa8L\xba\x00r\xbbs`F\xba\xea:+f\xa8\x81\x19\xe2w\xdd\x99hI\x92-\xa8\x90\x19yDQ8\x0a\xa5%G\x84\xbe\x82=$\xe4\xa7\xeb\x07\x81]\xd7_dPN\x9e\xed\x83P\x84\xf3\xeb%\x83\x0ee\xbd\x1c\xc0\xe2}K{u\x85\xf6p:\x90\x03\xc5v\xe2\x0dlh%\xe49Q\x125\xf2v\x1f\x85=\xc2\xdbA\x06\xb4RHAs\xe1\x18\x9a\x91\xd0@5PN\x1c\x1cs\xd8n\xd1\x9c\x02\xaf\x10\x8fos\x92\xf0\x95{\xceJ\xb2\xbe\xff\xfc`\x04\x9d\xcb\x10Lp@\xb4\xdcu\xe8\x1d$\xeb\x02\\\xdb\xf5.\xb2n0\xa4\xfc\xea\x1f9is\xc2\xcb?j\x97\x9c\xd6\xb7H0\x10%mb$R\xd5\x8b\xfc\xf4\xf9\xeb\xa2\xcf\x14\x1fS\xbf\x94@\x07@:\xbc\xfe\xb0>P!(\x0d\xc6\x84,\xe3\xc0h\xb1\xbd\x03O\x1a\x0e\x18H ~\xa06\xa2\x07\x0f\x9aG\xa7\xf1\x98j\xf1\x17\xcc\x94\xe2\xd2|\xe0\xa3\xacl\xd6+\xa4\xd8\xea\xefs?]\x8f.\x98\xb6\x0dt\"\xa3\xf5\xe55\xdd\xe5\x89\xcd\x88\xee\x11\x0b\xa4c\xf6CR.CY\x98<\xa2\xd8y,\x13js\x8d\xce\xc0\xd1J\xc8\x1f\x1d\x00+~\x07\xddeF\xc9h\x04\xa4\xa4\xcb\x13_:\x1f\xe4\xfd\x90^bi\xeec\x8e(\xe1\xfa\x80\x0dz\xf00\x10\xe5\xaa\xbe\xf7S\xc8X\x1e\xc9z\x98j\xe0MN\x9b\x09\xc6\xca\x10\xab\x12A\xde\x1c\xce\x1e$\xd1U\x81)U\xb4\xde\xc2\x81\xf5\xcd\x00HZ\x04lE\\\x87 \xa3\xfc\xb0\xeb\x07\x05\x89\xa6\xc406\xa7\xe2\xa7K\xd8\x12\x1f0\x89\x90tA\xdf\xc7\"\xcdz\xc0E\xbf\x80\x1e\xf5\x9bNw|\xcb\x91\x9b24\x9a\xf1\xc4\xff\x912\xea\xac\x0e\x9e\xf2\x19H\x9a\xbc\x89b9\xd5\xd4\x10\xcc!{j\xf8\x15\xe2\xaedz-\xe9\x8c\xb3\x85.\xd7C\x02\xb9<\x05\xa6|)q\x90\xb7=\xa5B\xfdm\x0c\xcb\x160\xe5y\xa4\xa1\xdc\xd7\x89\x86:G\xf9\xa2\x94\x94JU`\xf8\xc220\x89\xe6J4\xad\xd8h\x99\x07\xe6\x14\x12YJki\x0aR\xd6\x85f\xceN\xcd\x8dp\x812\xc8\x0d\xca-\xc6\x08\xd8Y\x0e\xe4\x14\xc7\x0c\xaf\xa4\x0aS\xec5'\xad\x16\x82\x06\xf7H\x0b\x1b\x99\xea\xe5\xc7x\xe3za\x9f\xe4\x1b\xed\xce\xa9sdjJ%J6\xcf\xc1\x91\"\x15X\xd7A\xcf\xba\xacY*e\xe8a\x88\x0a\x1c\x10\x8e\xaeX\xbb\xb1~\x10\x03\x93\x1e\xbcm\xec\xc6\xebp\x07\xdd\xcbx\xdd;\x82\x8d\xddu]\x15\x1a1\xc9\xf2sh\xfd\x91VY5h+\xafr\x97\xea\x0f\xb8\x8e_\x11\xad\xc4\xb4\xf3\xad\xd2\xa6\xd3f\xe6\xaf\x0c\xa0\x86R\x16b\xa0\xc5[\xc5O2z\x1f\xb2\x8f\xeb\xcch\xb0\x82\xa0hl\x18\xa6\x9c\xbds\x91\x09\xb9\x1akb0\xc0/\x9eh\x04\xc3\x98\xab\x92\x92\xf9ki\xae\xed\x9d\xe6}mx\x8bF[\x9c%Q\xe3\xa8R\xf1\x0a\x82\x10\x8dDr\xf4\xcc\xd45?v?\xc6\x8e\xdf\x06v\x99\x8a\x10\xba\x95\x08\xe0v;^\x1f\xbdr\x1dp'>\xc4/a\xf7\xdeA\xff^1\xd0\x0d\xbb>\x897\x84\x0d\xe1\xdf\xd3\xa49p\x0cHWf}\x15\x91\x89\xcc\xb4\xf7K\x18n\xed&\xf3!\x13ex\xce\xb9\x91\x9c2\x0eq\x81\xad\x1d\xbe\x18I\x99t!\x0c\xda\xdbx\xa6\x08\x8a\x05\x01\xf5\xeb)\xfb\xaa\xaa\xcf<-=]7v'\xe3\xaf\xd8b<\x0a\xedv\x09\xa6\xe4\x1d\xea\xbf\xaa~[F\x04\x04\xc2\x0b\xa5iv\xd2N\x82\x0c)e\x19\xca\x9d\xbb~\x15\x84\xff8U\xf5\x0d\x01\xbc\x05%L\x8f\x8d\xb9o\xa8\xff\"@\xa6\x1c\xe8V\xd4\x1b\xec*J8W\xa8\x09N\xf2\xd8Z\x0a\xb9\xb1\x86P\xddR\xb7\x0dn^\xce\xda\xea\xac\x88\x17\xefN2\x03WBb\xa8\xd7k\x1f\x98\xd7~\x17O\x8f0\x99\xcd\xd4\x14\xc5\xb4|W'\xffs\xcdU\x83$\x09\x1cm\x94\xa2EaI\xa4{\x98\xf1\x0a\xc9\xc9\xd8\x03\xa5\xcd\x04\xb0\x11]\x1c\x15J\xe4\xb7\x98\x88\x99\xa5\x14\xe9\xcap/\xe5\x1dt],C\xe2\xb2\xfec\x98;\xe13%\x18\x95\x0fb\xcds\x16\xf1#\xe4\xa64\x81\x08\xe9\x86\x9dp\xf6(\x0c\xed\xb1*\x82(-1\x88\\\x83\xcd\xe3^\x85\x04\xc3\xccnU\x89\xd9\x97X\x15\xfb\xb0\x89\xaa\xaf\x83x\x01b\x85\x89\xd7%\\\x1aZ\xaa\x818MD\xd4%\xca\xa52\xe3\xe3\x7f\xb9\x08\xbbz\x84\xc1\x9e\xaa\x81^rB\x82\x0b\x00\x00\x0a\xc9\xd0\x19\xc4\xef\xc0\xe1\xefp\x0f+\xaeHB\xcfV F+\x19k\x0c\x96\x164\xcb\xc9\xd2F\x16\xb8xJ\xa8\x06\xe3\xf8\x8e\xe7X\x13\x14i\xb00\xd4p\x08\x03\xf5\x0b\xd3\xa2s\xa3K|\xd0\xfa{LRJ\x0e\x83h\x80J\xa3%\x1b+\xf6\x938\x86\xe0\xdet\xe5I}\x91c\xc7\xb9\xd7u\x1a\x08\xa1\x0a\x08\x11\x05\xe6,\x83\xeeH\xca\xbf\xc0^\xfd\x1e\x05k$\xd0\xa30\xdb\x8a\x8b\xcfE\xdf\xe2\xf3\xf6\xb0\x85\xdf^\x03L\xb8\xfd\x16\xd4\xca\x1eh\x9d\x9d\xb3\xc6\xe1J\x92\x9eP\xdep\x99P\xdb'm\xb7e\\$7R\xcbIcP\x92\xeah\x9c\xd2\xe6\x14\x11\xe6p\xd37P\xc2_\xe0Y)\xc9\x93\xeedX\x83\x1dZ\xc0\xe5\xf8:\xe6Xa;\xf0\xa1\xbc\xfe\xcdtm\x17\xeb;\xb8$\x81\xaa\x91i4\x02u\xcf1\xc9\xba\x04/\xb0\x02\xbcu\xf2\x1e9X\x02\xf7\x1bV\x83f\x1a\x8d'5\x80\xd5\xaf``\xd4\xba\xbe\x81\xb7\x9a>reo\x99\xb3/\x8e@$\xe3\xb5>\x0a\xf6\xb3\x8b\xc08\xaa@\x89\x02\xac\xaf\\,\x1c\x10\\\x0a\x08\xdc \xbe'\xbe\x88\x9e\xe6\x07\xbc\x12Mi}\x03\xef62\x19K\xc2\x99O\xa9\xbd\xe8|\x90SEK\xb3\x09\xd4w\xbd\xea\xf4E\x85\xce4\xab\xef\x0at\x8b\xffb\xb1\xe3%\xccS\xd8p\xc70z'\xea\xf4\xa4\x0e!\xa8\xb7\xa5\xd1VB\xe7\x86\x1c\x8f\xd7\xc8\x9a\x8d/i{\xd9K&\xc5/ ?\xb6\x81\x9c\xb3\x92\xa0\x81\x93\xfa\x15x\xca+\x0cKUcJ\x05} 7\x86\x86\x86\x8a\x1f\xb8\xedi'\x97\xe4\xc9K\xc9\x02I\x96\x00\xcb\x83\x9c\xb1+X\x92\xe4n?\x00\xa6\xbdD\xef\xad\xf35u\x93\xc1m,\xc5\x86w03I\xee\x05>?\x04\xd1a\x96\x98w\x88\x9dFxe1(\xa2\xd5\x80z_\xe0}%Y\xe9-S\xaaW\x9b@\xf0\x8c\xaa\xb2\x7f77K\xc7\xd9z\x85U\x11T0\x91\xcf\xed\x96\xd8\x07\xcb\x8c\x8e\x89h\xef\x01O\x1d\xcfLV\x90\x96F\x96\x19\xf0bQ\xfc\x88\xc2\xd0\x9e\xca\xc9N\x97\x96Zxe:qm\x0bOs\xd2\xfa\xcc6\xa2CR\x06?\xe0J\xa3\xb9\x92\xc46\x13&\xac\x88-\xe3#\x8c\x93\xf9K\xf5\x1d\xa8\x1e\x90\xaf\xae\xb4\xf6\xfaH-#\xc2O\xb8\x12\xaa\xd2(\xc2\xe5\x84r\x88\xc8(u\\i\x00'\xb3\xbe\x01\xe5\xf6a\x1b\xe5%a\xb5x\xbf\xc1p\xeb=w,T\xcfTK[>=3f\xa5\xb7Sb3\xf4\x15\xe5\x8d\x00\xf3t@e\xf0)\x03n\xc9\x0e\x1dI\x1e\xee\xac\x15\xea\x09Te\xa9\xe5\xb0\x14D*\xc8\x8e\xb5\xa0d@R\x8f\xf8x\xfe~\xd2#(\xfa\x07ZY\x9e\xc17\x81N\x85z!\xd2\xe0\x9d\xe4\x04\xc0\xb1B\xa1\xf3M\xa4\xc83h\xc4\xb7\xb4g\xfa\xc9/\xeb\"\x8c\xbc6\xdc\xfbO\\\xe8B\xa5\xe1\x95\xack\x91M\xb5\xd2\x8f\xd2P|\xe8emv\xb6\x8cD\x7f\x19\x13!\x14-\x89\x93\xb0\xd2\x0d\xc7\xef\x88\xd59\x9a\x1d]\xfe\xc6\x16\xf5\x0bY\x88V-[\x9c\x96\xc4\x03\xd6\xb8\xc5\xa7\xc8\xd7\x00&6\xda4\x86\x8f)\xb0?\xea\x0d\xdck\xb1\x1c-\x15\x8a\x01\xfd\x98K\xd7H\xfe0fBri%\xe6\x8f\xb6\x12;I>\x98< \xa7Ic\x14\xdcj\xc1\xc71\xad\xdf\xe1;\xb1\xfa\xb3\xcd\x0b\x0d\x1c\xfa\x1b\xc1@\xce\x14\x9b\x91\x82\xa5\x80d^?\xc1\xe7\xf5-\x9b)\xf9\x01,S\xca6\x95`\xa7^\x97\x86jM$[\xcd[\xfb,zY\xa7\"Z\x09\xff\xa1\xbe\xf1\x10\xd6U\x17\x16`g\xf7\x11\xfe\xfeF)\xa0ca\x18\xce[z3'\x9d\xf6\xe4*@\xe8\xb9\xb2\\\x18R\xd2\x85\x92\xce\xce\xf5\xe7\xaa\xcdwB\x895(\x1b-c\xd3\x1a,\x1f\xa2ADY\x98\xe8A\x94nW\xbcjz\x11\x0c\xea\xd8#\xa4\x0a\x1712D\xf9\x1d`F\xe8\xa7\xea,0\x00Wqo*\xaex)1-\xf3v\xe5\x86y;\xbebd\x02 |S\x99OY\x94\x03\xbb\x06\xab\x10*'\x92p=\xd6\xefe\xaf\x8c\xc5\xce~\xd29)X\xe4K\xa8bF\x04\\\xc9\xc1\x18\xf0\xceP\xbf\xaf\x81\xba\x99\xaeg\x96\x8b\xae\xdf\x0aO8\xbc\xf6\xbd\x8c\xaft\xc1\x19\x0dW3\xa5t\xd7-f\x91'\x8f+\x95\xed\xe8\xd4t4\xde 1k\xf2\x08f\xc0\xdf\xc1\x99\xe2\xe0[\xb11[\xe0&\xa7f\xef:L\xff=%q\xc3\x92\x1cG\xee\x17\xa3\xf6\x88\xbf\x8dOCp]\xea#c\xa60\xb6@\xe1H\x7f\xe8\xacc\x1ba\xe6\xd3\x983\xe34\xbd\xeef+Y\xc3v\xa5a6%e\xb1D\x96t\x12\xcf\xb9p\xe4\x1e\xc9?\x0b\xedb\xee\x94\xca\x96\x9c\xc5^\x03\xf2e\x01u\x18H\x08[\xc25\xa8\x0b*\xb2\xfa#\x97\x19\xc4<\x1f\xa1\xf7\xd0\x84pF\xf6,~\x9aT>\x11<\x1f\xd6v!\xdcY\xa5M&;PTn\xf3\x06\xce\x14\xa7\xa2L\xa8\x9c1f\x02\x1dq\xd5\xee\x1d\xb1\xfb\xe2\x9f\xb1$\x9b\xf2$\x14u\x04\xb2o\xc6\x83\x09\xaf\x1e\\9\xac\xc6d\xe9`1\xf8\xa7E+\x91 \xca\xeb\x89\xf8\xc8\x8c\xaaY_j\xaa\x99\x81\xc9Z\xa1\x15\xf8\xea\x1a\xfc\xa3r\xef\xad\xa5\x06H\\t\x99w\x16\x0d\xa7\xad\x15\xd6\xc5=\x84'\x90v\x90=\xe3H\x99T<A{\xb7\xbe2WBg\xbb\xc4\x0cN\x82*g\xb2\xbe`\x1f\xe4\x94\xa8F\xd0\xf7\xb0dT\xcf\xcc\xfb\xd8\x8d\xd1O\x92;9\xe7\x8c\xa7>\xd5\xb5\xf4\x08C\x07o\x05\x12\xd0E?9^8\xc8\xea[\xf8\xe0\xd4\xa8\xd1\xe9\xfe_G\xfe[\xd5\xb5S\x02\xa7|\xeaUZ\xfbE0\xb3\xfd\xe5\xbd\x89\x07#c\xc7e[-\x950V\xb8\xc7\x7f\x84@\xd9\xe2\xdf\xe4s\x95\xb0|\x8e\x00\xeet\xea%l\xa1'\x9a\x15\x8aD\x95\x95\x0c\xfc;x\xaaQO)\xee\x9e\xb4\xb5=9j\x1dv\xdb\x0e\xda\xda^dP&A[h\xcf\x17,h\xb1\x91\xf0\xf6\xcf\xcbU\xa98\xbe\x97T\xa1\xae\x18<j\x8a\xf8\x89\xca\xa8\xc8\xde\x0a\xdeU\xfe\xb2\x94\xd5(=\x95\\An\xc2\x14\xaa\xce\xb5\xe8\xc7Rr8\xb2\x8d\xae\xff\xd15\xc8^n8\xd5\xc7\xe5]\xc4\\8.\x05~\xc2\x83\xa3\xd8\xf5'\xa2\xfd[}0\x0b\xfb\x13\xab\xc9\xe3D\x0f\x90\x04\xbeS\xe2\xbeT\x92@\x08\xac\xdcBW\xe0\xfb\x84uFG\xd9\x85F\x11\xc8\xae+\xd5\x84\x9a\xf2\xb0\xe3\xeb\xc4/\x87%\xf4v\xfd\x1f=U\xc4\xb9\xc0\xdf\xd5Q\x15\xd4I\x9bc\xa6\x160\xac\x85\xd6?\xa0pW\xaa\x02X\x0b\x84|?wp(\xc0\x9b\x81q\xc1ss\x89\xab\xef\x96\x82\xf5\xaen\xeb\x1e\xad\x81\x97*'\x0d*t\x84w\x1b\xc5\x83\x17\x92\x1f\x94\xab\x1f\xa1\xba\x9a\xd3\xa4zJ\x00\xb8\x97\x1a\x88\xa0xZNr\xba\x86r\xfbs\xae\x90\xc0wK\xe3\xf6m\x06\xd56J\xe6\xc6\xd1e\x98*\xd2\x0a\x96q\xbaO\x1d\x1e\x0cR\xb2\xe5<\xe8\x9f\xa8o\xff\x8db\xbf\x94\xf9\xac;\x810\xa6V\xadk\x0e\xda\xc6\xed\x16\xa5\x808Nd\xde\xbc\x90\xecl\xc0\xc1\x9c=\xfe\xb2\xd0\xacx\x81\xd8\x14\xd2_\xd2<lH\x14\x00U\xc7\x1ba'OH\x81\xc8\xe9ca\xdd\xcbD6<\xe3\xa5\x90\x802+\xc9k\xf0n\xcf\xd84\xaf\x0e.J\x82\x82\x10p\x0c\xea&K\xc9\xfaU\xf0\xe2\x9bCKyi\xe8\xab/\x99\xac\xa0\xfe\x18\xaf\x8a\x08iq\x80J}A\xdd7\xcd<\x95\x13\x92\xf3+\xd6\xf7(\xe1t\xe9\xa9\x94muF\xe3J\x0a\xa7*\xf9A\xea\xb1$\xb90c\x03\xa4\xa5\x82\xb2Ds\x8a\xd1\x0c~g\x94\x98qCi\xc4I\xe3\x8c\xb0\xea\x1d\xed\xcf\x9eJ\xd8\xc9\xeb]S\xac\xf1\x96\xcd)\x17\xc0\xcd\\*\xd2\x00\x81\x00\xfc#g\x02\xa4\xbf\x07\xff\x81i=\xdeVwt:\xe2\xc5\x84\xfb\x07\x02h\x9do\xb5\xddS\xdf{\x90\xf2*8b\x1e\xdf\xc8D!j%a\xb3\x92FnKD\xb4\xa4\xc8\x8a3l\x8e\xddBj\xdf43>\x91d\xfb\xae/\xb5|)\xcfZBkk\x10\xf7SVO?~\x8a\xbd\x1do\x9cM_iB1\x8c\xec#\xf5G\x1c\xc2+,H\xd7\xb7\xf8\xe6\xad\x0c-\xb4\x1a\xdf\x85\xff\xdfO^z}'\x91\xf0\xc1\xbb\x00C\x94\xae\xda[\x01j\xc1\x8b!V\x08\xd7\x9a=u\x95f\xceyc\x05\xf2d\xb6\x9a\x89S9\xd0L\x1b4\x1a\x98\xf5\xe9\xa7M\xed\xb2\xbb*c\x8c\xe4\xfca\x01!\xe84F\x81\x96!\x87b\x80\x92\x1e\xbd\"&+\x95-\x0c?\xc7\xcfa\x83\xad\xa0\x98v\xdbW^\x9a\x1f\x9d\xbd3\xc6\xed\xe5\xcb\x9e\x13,\xcab\x85v\x81NT\x0fAf\x0c\x08\x9b\x19J7.\xa9\xc4Rq\xdf\xb7\xaa\\\xca\x82^\xa8\x99\xae\xb1\x0f\xc27\x7f\xdd\x94}\x9e\xe1\xd0{\xf3;\xb0\xad\xe8x0\xe9\xa9\xc6\xd0\xda\x82\xcd;j_J;_\xd0\x8b~'\xb5\xc8\x15\x8a\xb8\xa7\x8a0?\xa70\x80\xd5\xc0\xa0\x97Umu\x946\xe0\\\x92S\x12^\xed\x81\xe0\xc1\x1ca\x1b7\xa0\x0c\xf6\xe96V\xa4\x07F\x94\x91\xbb\xda\x11Y\xddS\xb0w\x14\xad*V\xcb\xe3\xdb\x99{\x8b\x9e\xfd\x15  \x172C!2t#\xd5\xce\x1a\xccoDQ\x12\x1a\xce\x1cI\xfc\x8d9\xc9\x15^!!\xaa\x12" +
+	"\x99\xd6\xde\xe0Iz\xa8\x8a\x02Ha\xa1t /\xdc90[\x99\xa3S\x96\xc7E\x9b\xcfO\xbdE\xf7\xb736\x8d9V9h\xa0\xc5\xb4p\xbc\xad\xf5\xb7\x13 \xff\x0a\xda\x0b\x8f\xe8\xb7>QQX\xa0e}\xd7\xfd\xbc;\x8f\\X\xc2!\x02\x0f\x0a\xc8UdC\xf3\x11w$\xa3\x18\x8b\x9a#\xfe<\xae\x01\x81\xd7#\x9c\xca1e\xd0C\xb0\xba\xb6\x15,\x13\\\xc9\x9f\x9c\xb7\x14\xd8\x92\xf5E\\\xd7\x9d#\xb4\xdb\x7f\x85)E\xce\x9f|\xa9\xede\xcf\xa0h\x90/\x05-\x0b\xb7\x9b\x18\x9b\xc5\xbc\xd5\xb4EuO\xa3\xc2\xef\xd1\xaa;byR\x0c*$9\x82\xf5\x0ahE\xbe\xe9~\xad\xfd\xc7\x9a\xbaKB\xf9\xe2\x7f\xa2\xf1\x89\xcf\xbb\"&\xa7s\xac<\xc5Y@\xe1G\xfb31 \x98pP\x02\x0e\xc8\x07\x02\xa5\xee\x98F0a\x91q\xe14\xfc\x1c@\x99L91\xd3\xfd?t\x97\"\x821\xa3v<=\x10\xd1;%\xbb\x8b\x12\x15\xa8\x1a\x18\xe7f\x9c8\xda>q\x86\xe1\xb1\xec\xa1\xf2+\xef-\xe2\x1f\xe2\xd4X\xef\x8f\x0e\xf9\x1aA\x84\x8bj\xf3\x0b\xe0\xdd)It\x10S\xbb$B\\y\xee\x8d\xdab\xf5\xc3\xfa\xfd.\xec\x1b\xf6\xdcd\x96\x92OD:\xe9\x01\x1a\x0b\xda\x9c\xa9\x8f9<l\xa3\xbb#a\xcdV\xda\xd1|\x98\xa3\x08\xf4\x09\xf9\xabb\xab\x07(\x02),\xbb\xe1\xa5^\xe9L<fQb\x0ct\xc6\x19\xef\xd7a\xbfJm\x19\x0cw\xf9\x8d\xcfc\xec\xfc\xae\x13\xf0\xa4\xa5\x82\x93\xc1\x0c\xe1\x15\x9f v \x07b\xff\x89\xf8C\x89\x8bq\xdb\xc2\x08\x96\xf7\xbd\x88\xd7\xe5\x8e\xe5STu\x1c|\x88\xee\x8b\xe2\x1d\x80\xf1\x0b\xbc\x11\xfd\xa5\x80\xd5<5s\xe7\x11\x90\xe7Ww\x03\x88\x0c\xa5\xf8c\x0bV\xbb\xc432\xc2\x8a^2\x0d\x84\xecI\x0eA\xcdMg<v\xb0\xd55E\xd2^%S\x14\\@9\x89bFf\x908\x9c\xe9?\x1b\x96\xe8\x84\\E/^\xd1\xc7\xc4\xbaV\x01\xd4v\xa6\xf2\xa3\xde\xc2\x87\xf5\xa3\xeds<\xda\x00I\xf5RZz\xa1P\x91\xa2#\x84\xb86&\xf9\xb8>^B\x06\xdf<\x89\xacj\xb1\xaa5\x81}\xa6\xf5[\xf5\x9b\x0d4Pe\x05i\xc4\xc9\xd3p\xeas\x0b\xa7\xe3 \xaa6}kV\xcb\xbcY}\xaaI\xd52\xcc\x9d\x9a\xbe\x03\x04\xfac\xae\x99p_\xbc\x8e\x84\xc6_\x01n\x00\x90\xc5:|3\x0e\xb1X\x9f\x10\xec`\xc7\xe5\xbc\x0d\x0b\xfa\xb8\xb9\xe0\xb6\xbcA&ae\x01\x0fnz&-\x04kw\xed\x0e\xe2\xd5E_\xd9\xe4\x11\x8f\x08\xb5\xe9\xf6\xbd\x0aC\x1fs.\x97H)\xc7\x05\xf3\x91`\xac\x85'\xe2/\xbd!\xa1\xe8\x09?+\x11\x13F\x11\xf6\x0c\x1b\x1b#\xc1zE\x94\x06\x06\x0eU\x08\xcc\xa3\x88[\x05%\x95\x05&\x81\x93h\xca\xf4\xe9\xda\x828\xd4\xd6\x97K(\xd3\\\xc0\xbbR\xdd+\xfd\xaaa\x8cI\x98AX\x91\xa0\x9fX\xb2\x0dC&r%NeY\x85\xe1S\xcd)\xe4\x89\x9a\xa9y\xf4\x12\xbb\xc7\xa8=f\x06\x8d3\xb7-\x8dEX\xealf\xfe\xe5d\x0dk\xa3h\xeb\xe0 {\x06q\xc1\x16\xbb{\x0b\xdb\xd9[\xf8&\x9d6\xa4\x10\xac\x0b\xc4+9I\x08\xec\xdf\x95\xf0\x9e\xb1\x82\xb4\xfb\xcc\xc0a8\xba\x83n\x96C\x16\xe3\xfe\xd8]\xcaZ\xc3\xfdw\xe9\x07 \xc1z\xd8l\x97~\x80\x03\xef\xc1\xe4\x17\x8f$\xaf\x9b !\x9ff\xd4\x09\xb2\\\x1b\xe1Nw\x91t\xb7K\x92p(\x1a?5\x03\x17I\x08\xdeJ\x80\xef\x07\xad\x80\xc4\xa7[}\x0b\xdb}\x83,\xe8\xe1\xd6s\xce\xe0\xf6F3\xa8ED\xb8\x83\xf0\xb6\xb9\x10vK\x19G\xb7\x06,\x0a\x13\xd2N\x8e\x05\x1f\x128\x96\xb7\x0a;k@\x02\xa8\x1f\x00\x98\xc6\x9e:\xb8\x86Qp#k<E;\x15\xc3\x94@A\xb0~Uv\x85\xcc\x9f'\xe0\xbf\xdfaY\xbcKu6\xb2\x82\xc6\x8a\x83^\xc5J\x04\xff5\xb1\xd6\xbdt\x08\xc4\xa6\xdc\x8e\x0f\x9f\xa8\xa9\x93%\x16\x97P\xed\x0e\xe6\xb4\xb7\xc0\xae\xe9l5\x9e\x09\xe4\xb5IHB5\x08\xc9\x0e\x00\x01a\x89\xce8\x03R\x01\x13\xc8\xcd|\xe7\x9c)x\x90j\x7fV\x906\xff\x96\x18\"MzJ\xe7\x06\xafI<\xd1[@>\xadTw\x0c)\x88T\xf9t\x11\xd2\x16\x84\xbf'{\xea\x1d\xec\xa9\xa2\x19\xd1G\x95\xe1\x94\x8b\xc8\xd4\xd7\x12t\xed\xddH(g\xc6\x13D\xd2}\xc8+\x9aW\xbb\xa4\xb0s\x1dW\xcd\xd3,\x0d\x88\x98\xcf\xfbK\xf4\x8e8\xcfu\x05\xce|l=\x96\xb0K\x87\xb8Qi\xe9\xf1\xaf\x97Ma\xae\xa0^\xb2G\x1f\xd4aG\x06\xc9\x04Bg\x99@h\xd6[!O-{;3@\x11z\xa1~\xdbC\xd6k\xc6L\x8c\xfd\x04bU\xef\xcb\xb1\x02\xe4\"]\xaa\xab@\x1ct\x1c\xdf4\xe7\xf1\x8f\x130q\xec3\xf3\xef\xcf\x99\xe4w\xd4T\xeb\x0d\xae\x8a?\xef\x00jKi\xa5k\x98\xdb\x87T%\xf8\x1a\\\x83\x87\xde\xb2\xab\xe0\x95\x01\x00\x8e\xde\xce\xca\x16\x17\x99w\x1b\x03\x15\xd5\x1c\x86~\xa4\xa0\x8bp\xe5\xf5K\xf6T<\xcd\xa0\x1aE\x8e\x0e\x8b\x8dQ*G\xd2\x0b\xc2\x98}\x0c;\x8d\xba\xe9\x1cZ\xa4\x99^,\xbc\x1d\xf0|\xb3Y\x7fI\x81\xd8k|\xb7T*rD\xa4#\x1cmuB7\xc5\xfe6\xcd\x0b\xa8\xa5\xcc \x82\x99\xdb\x93\x90i_\xe8\xc3\x81\xe4\xcd\xff\xb8e\xa2\xd7\xa3\xb8\xb6\xef\x9d\xe2x\xdb\xa9\xae\xba8\xbd\xd3T\xb2\xa1\xff\xba\x84\x9c\xcc]S\x88\xbb\xe3K\xc8\xa6\xf2>\x1a\xa1\xce<\x84\xed\xcc\x09TZhv\x91m\xc6\xef%\xed\x83\xa2\x05?\xa5\xe7\xb7z\x163\xa8I\xdf!B;~\xf3]\xbcx\xe7\x02\xdcma)\xea\x8d\x1c\xce\x83g%_o%X0\x08W{\x13\xbe\x1d7\xa2\xda\xb5\xd4\x93\x99\xb4I\x00\xae\x9adoJ\xd3\x18\xd1K\xdd\x1d@e]!\xba\xd0\x12\xed\x94I\xf7U\xdc\x0dzT\x0d\xde&P\xe9\xf5\x8f@W\"\xfc\xa9\x1f>Z\xe8\x87\xfe\xa4\xf4Zi}\xa4\x8d\xdf\x8aU\x83VP\xa6<\xc50\xb5\xaf} \x1f\xa3~M\x8fs\xeb3BI\x83\xbd\x80\xa2\x84\x90\xef\x98Jz\x86\x0a\xd6j_\xe5\xceD\x8c<m\xe9\xe0jOL4\x10A\xa7\x90\xcfK\x98\x19S\xc6\x17(\x8aW\xf5m\xe6\xd2\xac\xc8Z\xc8`\x05\x14\xc8oq\xf3\xca\xdbdhJ\xc2\x8bx\x12\xc3,$\xcf\x88\xe3>\"E\x89\xa8\xa8Bm(\x12\x06r\x99\xee\x91\xf7(8\x87p\xdbR\xd2\x8bG\xbeL\xf4\xcc\xc1\x8b\xbe\xd0fr\xa2a\x94'\xe2\x0a\xe2\x8fg\xb8\x13o\x10z\xcd\xd4\x82bB\x15\xa5\xf9\xe9@\x98\xb4?\xea\xb6\xec\x13\xc3\"/*\x82s\xc3\x1f\xe7d\xb7\xb2$\xa4s\xa6<\xc6#=\xd1\xado\xa1$\xcd\x8bd\x1e\xa2Hf3\xa9W\xcd\xac\x99tId6\xc7\xcd\xc9\xda\xe1\xa9\xdf\xfdyd(\x85\x97f\xe0\x8f\xcb\x94\x15U@,\x0b]_[\x11\x0e\xa5\x87\x90#\xa3\xcb\xb1+P%P)D\xaa\x08u\xfc2\x06\xe1#\x96\x0a\xe9!\xee(bGz\x0d\xc3\xcd|J\x87L!\x1b\x0c~\x8c\x8dE\x98\xcd\xe4/\xd7W\xf5=xn\x0f\x82t\x02\x86=\xeat\x0a\xde\xb3E*\x10[_\x12_H\x98\xe3[H\x11_\xb1\xaf \x1e\xf6\x9e`D\x17=\xc5$\xad\x82\xfd\xda\x87\x05jl\xd13UP\xab)\xf5\xd8 \xb5\xa7\xd4`7\x11\x1e\xca\xc8LX\xd6\x1b\x8e\xd5\xf8\x0a\xebG\x95\xcc6\x91\xe0\x10\x89\xfc\xb3\xe2sV\x05\xa6\x87Vd4\x1f\x91%Yt\x03\x8b?+\x11\x1cb\xe9|\x88\xfd\x18\xa1\xed\x87\xfa\xabD~\xc6\x10N\x0d F\xeb~\xb4}\xf5hW\xee\xc6\xd0a@8\x9feW\x9a\x80q\xc8:1\xa2q\x90u\x9d<\xdaf\x16\xf3\xcb\xe3\xae\x84\xb2\xa34^b\\\xd5\xfdf\x07\x1d\xfb\x89O\x88oX\xb1\xf8t\xfdv8\xef\xeau\xce\x9e\xb0\xa6T\xe6ZC\xabJ\xdb\xcbN\xb0N@\xc0W\xf5\xb2\xc7\xcbz\xf0\xdd4\x83\xee\x0cD\x9c<\xa6\xc5\xe1d\xd4E\xfc;K\x96\x9d\x99\x17\x11\x1c\x16\x04F\xf3f\xcc\xa5\x1d2\xc0\xbd\x1e\xe2\x93\x99i\xbc\xb2K'\xf6\xb4\x01$\xec\xa5\xce\xc4A\x88,\x8a\xfec\x96\x1f/\xc19\x02`\xb9\xd3\x11k\xe8\xd8\xc7\x18\xd2\x85n^(\xc8u\xcf\x95Q\x90\x04\xe4m\xc8i\xfb\xe5\x17\xa5\xa8+\xda\xdf+l4B\xe6\x0a\x04\x90\x9d6\xf3\xd4-\x9a\xebn\xc1)\xd61.\xa4l\xca\x82\xcay\x82\x98E\xf2e\xf7S\xa2\xc4\x80V\xefx\x80\x1ee$\xb0\x9d,t\xb6\x9e\xce%> b'xe\x8f\xa7\xd4\xed\xed7\xe8s\x1a.6\xaf\x03\x02\xdb\x90\x0c2\xc7\x80Yz\x1d|F\x0fr\x01}\xe7(\xb6\xc1\xc7\x9c\xaac\xe5d\xd6\xa1K\xe6B\x12N?\xa0\xc4V\xd7\x93\xe83\xf8\xae)\x84\xcdK\x93\xd32\xb4nN\x9b\x12\x9fq\xe03)L\xa0\xeb\xa8t?/\xa5\xac\xd3\x00r$\x17\x9b\xe8\x16\x0a\x95\x08G[U\xf1\xbaL\x18,\xdaL\xb8\xeb*,\xc7\xc8J\xb6\x80\x04\xf5Rp\x0aI\xe8h%\xc8\x06h\xd2I\xb9rEY\x86\x8b\xbe\xb2\x80N\x83\x9d\xb8yZ\xd9\x0b\xb8\x02\x80\x18\xa1\x93\xc6\xe0&l&OZ\xc7\x04\xfd\xe0\x8ab\xa6)\x83\x8b\x96qD\x80\x02Y\xf4\xeb\x98P\xece}\xb5\x81\x99s\x0e\xe0\xf0r\xda\xcd\xbbD\x11\xa2\x00J\xc4\xfc\x9d\x13&\x08F\xc8x\x05f\x09\xd9d\xdf\x1d\xb6\xb7\xf8\xa4\x91\xe8\x8a\x88\xad\xc7:Z>\x86\xe6xX E\xbd\xc0\x81[\x7f\x92@\xe7\x15\xec\xbd\x9f\x08\x97\x1d\xb8\xc7\xb9,\xca^\xa0 \xd0\x9dlS\x10\xa1\xb6\xe7\xf2\xc7\xaf|\xec\xf3\xe8\xc3n?\xa4\xca\xfa!\xd5F~\x08\xca\x18\x8bJ,\xb4\x80\xf2\xd9\x8f%\x07E\xca\x81\xb1\x82\xdb.w\x91\x83\x19r\xd7J\xfcr\xf4{\xfa\xb8\xd8[\xaa\xbb\x150O\x05\xce*\x09V\xbaVH\xea\xe9k\x93\x03\x9d\x87=n\xbf\x9d\xa0\xc6$i\x87(\xcdO\xc0\x0a]\xad)\x1e\x14D\xe8:\xc6\xbd\xf5\x03\x1e\xdeS\xecBm\xc6\x90\x800k\xb5\x85\xe1r\x12\xcc\xf2\x85MXI\x809\xc2s\x81\x02h\x0a\x0bv&\x87\x7fZ\xdf\xf5y{\xd5\x09\xc3q;3p\xb9l\xfad\x04\x84\x7f\x18?\x7f\xcb/\"\x1aRDX\xadW\xb4\xb8.\xd8\x85;\x11\\m\xa0\x1b\x14\x93\xbd\x17\xdc\x81\xc7\xe8GP\xd6\xec1I\xeb\xe1\xac\xef(<\xf9`\xcb\xcf\xc71t,C.~\x81\x1a\xf7\xf0\xc2]\xcf\xbb\xa7F\xfa\x98\xf3\xd4\x9c\x7fX\xa1\x8f\xc6\xa1\xc4\x8a\xfbH\xd0\xcb\xa3cM!\xef\xb0\xae:c\xac\x88\xf6\x88\x85!\xb3\x090\xec[\x8aV\x9c\x9b\x18e\xc3\xf4\x1bb\xa4F\x1aQ/W\xf5N\xc3j\x88\xdb\x829\xe7\x93\x10b\xae\xcb\x11\xc8\xef\xd3cD\xf7\x1d\xbc\xa7\xb5\x0b\x1c&(PW|\xe8\x0a\xab'\x03\x1f\x9ac|\xa22\xdb\xc8W%\x8dV\xa3\xbe\xcb\xf8\xc0f\x18.\x03\xba\x02zWH\xa1\x02\xe1;\x09k\xac\xc0x\x1f'!7\xba#@\x03\xea\xd1\xce\xb2\x1e\xc0\x84we\xef\x03\xed0\x99\x82{xb\xe1\xcc\x18\xf4\x86\x1ah\xd9\xce\xdbL\xcd\x0a)\x07N\x8a\xd9\xc2\xe7Y\xe5\xb8o\x88\xb3\xfd\xa2\xcd\xc9\xc1\xdd\x06\x09\xd12I\xd3~\x1b\xbdf\xd8\xf5\x85\x1f\xae\x19Z\x0f2\x8a\xd3\xbe\xcf\xa8\xf46\xbd+!\xfb\xd1\x15\xc0)H\x82\x16\x11=\x0eY\xdc\xa9\x88\x80\xdf\xf1K\xb0_\xf9\x0e]\\#\x0b\x15l+\x07v\xd4\x7f\xb4\x1e \xa4p\xeb\x17\xa0\x18\xcd\x8f\xf6\xc8NT\xf7,\xd5\xd9\\\x10\xa6\x10\xac\xc5\xc4x}\xd3\xac\xdb:\xe6\xe56'\xee\xcc\xb1\x1e\x97\x1f\xe7\xe1\xc1mc9\xf0\x90\xb2\xb1^\xd8\xf4\x1b\xc7z\xac\x8f\xa1+\xaf\x0f\xc8\x09\x19H1Py4\x8d\xf0\x04\x80\xb1H\xb9\xa0\x8d\xe4\x80$\x19\x9d\x11o_\xc1\xb6\xa9\x8f\xbeEb\xbd,!u\x06\xaaQ\x189\xba\xb5\x01>\x8f\xc2P\x8b\xf8\xd0\xf1z\xc4\xce\x81\x9eRl\x1f4\xf2\xa6\xea\x00\x95q\x8d%7\x1cs\xcbM\xd9L\xe3q\xa6\xe2\x8a\x9e:\x18\xb6\xb0\x8c/\\\xa4\xd5EG\x12\x9a\x94&\x83F \x04\xbd\xe1\xb1\xd8%\x04\xc6\xf0HLv\x86R@(\xa1\x898\x80\xb3>j\x16\x09\xf9u3\xc2\xe7\x9e\x13I\xeb[&\x7f\x8b\x13\xe3\xf0\xe8\xcc\xa9\x154|0f\x81\x92N\xcc\x04\\\x14\xbc\x1b\xe2\xdf\xd4\x09\x96\x88H\xaa\x7f\x84\x97;\xef!O\x91\xe8!j\xed\xdcSjye=\xc5$g\x03\xbcx\xb0\xea%m\xce1q,$L\xa4\x8a\x06R{<x\xa0\xbd'{3f1\xa7\x05\x8c\xd1yO\x95\x8b\xb8\x81\x80'n\xde\x86\xf8\x00\x18\x15\xf3\xe4(6[f`\xa2yG\x11;\x83\xc3!\xa8X\x01*\xd3\x9c\x9bT\x0cXX\xacU\\\x89\xadvc\xe7\x85\xdf{4\x8dk0\xda\xb4\xa9(\x0d9d\xda\x9a\xf3.\xd5\x9b\xa0\xdd9V\xacB$Uq-5\xa0{hFl\x98tC\x1a\xdf\xb8uAEa\xa2S.\xd8\xf3\x87\xfe\xde;\xd0\xf3]\x7f\xf5\x88\xfbL\xfa\xb9\x15\xac#\xe1R\x81\xa1X\xd6B\xd9zI=\xb7\x84\xacN|J\x94z\x04\xbb$\xdb\xe7\x04\x000\xfc\xf5A\x13;Pv\xf3\x1dP\xb7\xd8\xcf>\x1a\xd6\x91x\xb4\xf5\xab\x8a\x08M\x07\xe7\xf1ul\xa3\xa2Td\xc2\xe2\xb2\xa6, #\xbdO\xda}m\x04\xec\x0b\xcd\x0d\x16!\x09s\x89%\x04\x9f\x15\xd9\xd2\xd9vo\x94C\x14\"\x10\xfe4)=\xce8IU\x8e\xb0J.\xba\xfcw\x92\xb3\xdb\x8b\x02\xfb\xe6\xd6wv\xdb\x9e?M\x84\xbe\x06\xbc\xa4&\x09\x88]\xe6\x17\xa2\x7f\x92\xba\xb0\xce\x12\xed\x98\xf4i)\xc5{\xccQV|\xb3\xf4\xfd\xab\xfeE\xed\x9d\xbd6z\xb3\xab\x80\xad]\xa0\xf4\xc0\x94\x09\x09\xb8\xb4\x1dL\x148\xc03\xc5\xfam\xccE\xf4T@&K\xb4\"\xc5\xacR\xf8\xbf'\xb8\x1e.\x05@gB\xf9\xd2c\xaa\x9a7'@M\xbe\x92d+=_y?\xc3\x92\x94\xab\x98\x08\xea\x03\x97\xfeVt\x9d(\xd8y\xde8.\x18\x97\xde\x0bT\x924\xde\x11\x82\xf7-d\x07d3\x07\x06=\xcbDh\xb7\x97\xe4K})\x7fg\xde\xc9Hq+r_^\x99\x06[\xf2\xc7\xa4\xa7\xa5o\xc8\xb3s\x98\xe7\x14\xbc\x12\x83P$\x94\xda\xc2W6>i\xef\x81@\x04\x89t\x9e\xc8s\xbf#\x87\xfeH\x94\xcd\x1c\x88\x07\xb5\x0b\xbf\xf4ya{\xc7\xda\x9a\xd5\xbf\xe5\x01U\x0b\x9f\xb2$\x82V1O\xe8\xa9\x1c\xa2\x8aX\x05\xe9L\x9f\x07\x19O\xf8]\x8f\xc7\x1e>\xbe\xd4\xc7$s\xf9\xcd\xb4\xfe\x1a\xa5G\xdfIb\x08znY\xfc\xd9\xd0\x131\x1c\xf3\x8c\x18s[\xc3\xe3\xe9\xdcL\xa3\x90cx\xad\xfa\x83\xe8\xe6\x10n\xeaL\xdav\x99F\x153\xca\x1dI\xd7}\x1eu\xbb\xe7\xfa0b\xcc\xe9\xda\x10\xa7\x18\x05p*\xbd\xd7{\xc9\xee<\xc5\xe3D\x9f\xec\x0a\xba\xdd\xc6]\x1e'k/Kq)h\x0a\xaa\x9euY\xec\xe64?\xc1\xc5\x02f\xf0\xd2r\x16\x95\x8fIb\x99\xe7v\x15\x84NDq\xbcWAkw\xef)ss\x8b\x9d5\xf5]\xd7\xac<!\x0e\xd0\xac\xe0\xeb\xb7\x05\x1a\x0d\xb3\xf7L\x1d\xbf\xb7i:\xd8\xaci\x02\xe9|\x10\xf9\x9b\xd5\xb8\"\x9c\x8eu\xec\xcc\xbb+\x9d\x9c\xc8g\xaetI~\x88\x89\\\x12\\\x89\xe7\x1a\x11\xa0G$\x01\x16\"\xe7R%\xe7\x01\x13\xd5\xa7\xf5\x0d\xe7\xd4\xf37\xc9ExJ\xcaY`3I%\xca<\x0fd@T\x94\xca8\xc7\xa5\xee4e\xdep\"4[6sV\xe1+R\xd0\xd3\xb7Vr\xcb\xf1\xeb_\x83%\x054\xd4]`\xd2\xbf\xe0*\xd2)\x94\"\xfa\x94\xf1w\x0f-\xcc\x8brM\"\xa5\x84&\x86\x9f\xf35L\xc9\x08|\xe7\xfa=\x182\xdb\x81A\x06\xa0\xf0d\xfb\xc5\xb7\xef1\x1bG_\xab\x1c\x0a\x89\x19+\xb4\x90<8p\xf2]\xf4d\xe0\xb6\xea\x05\xd4--J\x06\x9b\xb2<8\xfb\x0b\xe8\xf3\x03\x95\x95`\xd8J\xb4\xa1\x7f`\x8c.E\xddB\x11\x00\x7f\xd6/\xe4f\x0an\xd3\xccR\x19\xb1v\xdc\xfc\xa2o\xe7\x01\xd9L\xbceW\xea\x0e=m\xea\x81\xc9>b\xb2\xab6_F\xf4\xb0\x92m\x13\x0a\x17\x17}\x09\xb8%Q\x80\xccm\xa8\xf6z\xd9V\xf31\x9c\x95/\x13\x01'\x19\x16)4\xb9h\x12\xaf\xea\xb7\xe0" +
+	"\xf5\xeat\x1bC\x8f\x9e\x00Gd\xfem\x87\xdd^-\x932\x99\xb5):^\x06\x92\xed\x112\xa7%\xbc\xdeh\xa4\xbd\x1d_1\x84\xa5\xcb\xde\xc2N/\x1d}\xdd\x8c\xa0\xd5\xb1\xe1[\xb6\x9a@TD\x13\xbc\\W*\xfcc\x13T8\x9f\xaaK%\x0c\x1di\xfd\xd6@\x8d\x99x\xfe\xa2'g\xb6\xe8\x09/\x1f+C5iS\xa5O\x0a\x10s\xc9`\xfe\x0e\xf2#\x0bL\x98\x8f\x93\x0e\x9c%\xbaQDh\x0d9)V\x1f\xc1\x09\xe9\x8d_\xdf\xdbK4\xaa\xb8r\xd3\xa2\x15$\xceE\xa6\x8c \xc4\xcd\xd8\x98}\xaa\xc6\x83\x10v\xbf\xc2\x1c\x13e\x13c\xbd\xc8\x88\xb2;\xd8\xfa\xa0\xdcX\x9c\xaa!\xe6\xd0\xff.\x00\xb6\xb7\x09?HX\xd8\x9d]\x03\xd9wR\xfa2\xcd\xb6\xe8jc\xee\x0c\xa5r\xc0\xcf\x13\x8cK\xf1b\xb157j\xf6\xd9\x8b\xd570\x13$\x85\xaf\xfb\xa8\x03\x00\xe3U\x9f\xb5\xe2Q\x0f\xe1\xdb\x04\x1a\xba\x0aroR*]\xb1\x91\x01g-\xb1\x7f\x84{\x96ZQ\xf3\x9e\xf9\xa3\x04+\xcaU.G\xda\xafua\xffE\xcb\xb3S\x1d\x84\x9a\x82\xc7\xa2\xa9\xd6q\xb4\xd5\x03\xe3\xd8F*\x10\xc2u\xbd\x8b\xcaF\xddO6Lk\x9c\xec\x96\x1f{RU`2V1\x89\x057\xd4\x11XNR\xf4\x06\xf0\x80\x93\xc7`\x0c\xf7=\xe6\"\xea\x92\xbf\xc6\xe4Y:^\xe85F\xb7/\xbe\xc1\x05\x8c}DD\x95^\xb9\xcf\xed\xb2\xed\xbd\\\xf7\xb7\x1d\xcd\x96\xc78\xf2Z\xf1\xee\x02\x8f\xe4z\xd9\x9c\x1bR\x97\xee\x8a\xec\xd8\xa9\xdb\x9a\x96h\xde\x17\xdc\xc0\x8c(\xa8#\xc9g\xa4\x88wp&\xb5+D\x95\xe6HJ\x06\xd9\x9a\xdb>\xa3\xb7c3\x08\xbc\xe0\x13\x91\x1e\x85\xb1\xb4)>A\xc6t>\xb0\xe2\xb9\xfcd\xe1\x0f\xaf\xa0\xef\x85\x9e\x7f\x05\xcd\xc1\xb3\xfe<\xdb\xc0\xe2\xd0\x06y\x8a\\\xc8T'f\x85\x13*+O\xa2\x93\xcb\xfa-'\xb9\x80\xae\xc4\xeed\xc8\xa0\xb6\x90\xe9\xa3\xb5\x10\x87f\xec.fEN* \x19\xf6T-\xec\x942\x06\x92\xbf\x12<\x0c\x88J0\xfa\x9ai\xab&@p\x18\x13\x03\x1e\xfa\x1e\"SZO\xc7\x86\xce\x16B\xc5\xf0<\xb1\x82\xb4\xae\x80L\xeb\xdb\xbel\xe3\x98\x87~\xcbD\xd5&G\xe1Y\x0f!\xe7E\x03\x18\xb5\xbf^\"\xeb\xde\x9fW\xf9\x1a&b\xd5\x83vk\x7f?\xa1bI\x94z\xd4\x18H+\x92\x8a\xc8I\xd7\xd9\xa8\x06\\\x92\xda\xd2L\x9e\xca\xc4HBD\x98\x00\x05\xea\xc7\xd6\x1d\x96\xc0\x07\xda?\x0fZ\x0d-\xc6\x90\x04\xd5\x12J\x19$\xce#\x869{\xa8ci\xb7\x1f\xbd6\xa1\x1d)$\x8c\xfca\x01`\x82\xf0\x1a\x0f\x99\x04)\xf3\xf5\xa6\x00\x97\xc3\xe4\xa0\x9b\xc2\xdb\x80\x95\xda\xf2=i\xab%\xc6\xa29i\x7f&\xad\x1b)zhV\x9d\xa3r`\xcb\x19)\xe9GgqI\x14NE\x83\x83\x82l\xa5:\xb1\xeby\x0d\x81dO\x9a\xe8d\xce\xe2\xf5\xd7\xa7])r\xf9\x0e\x13\xc1\xd2R\xbe\x98'\xd3>\x90:\xd5\xdfC\xb6\xb7\x1f\xc7\xb0VjX\xe2\x0eTQE\xa6)\xaa\xc5y\xd7Nm\x8e\xa8j}\xb7?\x01\x1a\xdf8\xc8\x9d\x83\xbcc\x8b\x92\x12\xbfp-\xe9!\x92\x01J\xb0|Q\xb9\xd5n\x0f)$}\xd1\x97N\xd8\x08\xfd\xcb\x00H\x09\x96\xa2\xabh\xe4J[\xc2\x00\x10\x9a\xec\xdc\x1b{\xc9\x86\x8c\xf5\x95#\xfd\x00\xa9~\xbcnS\xb1\x11TsJ\x0c3=ixe\xfek=\x96\x90\xdd\x90IFX>#u\xa4;\xb9\xcf\x9f\xd4\xc6jJ]\x83:Oo\x9b\xf1\x8f\xfa\x1d1\xccl\x9b\x97b\x81\x85\x97\xca\xfdt\xb3]\xbe\x10y:AN\x9aO\xf5\x94\x08\xa0\x8d\x93\xf0\x01N\xf8\xb9\xa8\x88\xc8\x14ND\x89\xdc\x03\xf0\xd7\x81\xd1\xf1\xf7\x02\x1cw%\xbfu\x8d\x06.\x9e\x01'}\xe8;\x87\xcaL\x05{N\x92\xdf\x14j\xe46K\xf7t\x1cnyY\xc3\xe0IpcC\xfe.-\x18\xe4\x9e\xf7\xdf\xd6\xb8E\xce$p?C\xa2H\xd53Y\xa7\xb6\x11\xa89\xce\xda\xb6\x0a+\xe7\xf5!\xa85\xcb\x17#\x9dwZ\xcf\x94s\xf6\x17\x9b\x1a\xd5C[\xde\x85\x81\xe1\xab\xc0;\xa5\xdd\xd4y\xa2\xb2~\xfe\x85`S\xfd)y\xbe(nI\xd8s\xa5\xe2\xadol\xca\xee\xf1\xd38m\x0f\xc0^\xdf\xc9\xfd\x1e\xe7-\xe6w$\xe8\x842z\x15\xb8\x9aLXRX\xe9j\xf7l\x03\x93\xc0\xaa\xd4\x85\xdbuE\x14^=\xde\xed\xe0\xa7\x0a\xdc)\xe9\x1c\x15\x00\xefp+\x92!\x14?\x96\xec\xd9\xb7\x98\xec;\x15R\x10\x8cf\xb5\xd5\x9a\xf5\xd3\xd5o\xfb1Jm\xbd@F\xa9\xfaCs\xd2\xc5&\x15\xbe\xf3g\xe1\x91\xd2B\xa8o}\xe1\xed\x99\xa7\x8c\x1c\x85\xffi\x8dE\x18\xffza|\xd3\x8fIJ\xf7\x96\xbe\x01\xb4\xc4J\xbb\xf6\x13\xb6\xd5\xaf\x83O\xd6v\xf9\x1du\x90Nc(\x8f\x11\xe5\x04\xaeG\xf5\x7f\xce\xba\x179dy\x8b\xfd\xa6\xe0\xabl\xc6\xad\x0f\xb5+)4\xfd\xea\xa7\xf6\xba\x89\x09\xf6>\xdc\x86\x9a<N[o\xb3'l\xbc*}\xf5;\x8a\xd2/o^E2\x00\xca\x1b\x913\x88\xd4\x0a\xf5\xaa\xf5\x15\xf6\x9dt3\x92\x8d\x03i\xf3\\T\x86EwSe\xdb}\x88oI\xa5\xccq\x08\xa2\x8bSp\xde\xe85\x14\xfb\xbe\xe9fS2I!\x8ca\x98I\xcf\xba\xc2\x98\xc2R\xb9:\x18\\\xc6i\xfdQ\xb1\xba\xb0pC\x0f\xc6\xa3SA\xebNM\xb2W\x9cT\x8f\xa4\xe1\xaf\x8c\xf8l\x0f\xc6!\x96e\xc64\xd07\x86\xe5{dS;\x95a\x0b\xc1~\xd5P\x0f{%\x14\xef\x19\xf7\xfd-\xb6\xb7s\xaf;]?\x0e\"\xeb\xdd\xce\xd8}pDo\xb00\x0c'G\x11\xc4\x13\x95\xf68*\xb9&\x9bnJ}\x85\x95\xc8\xdf\xf5\xa40\xd2\xe0\xdaK!\xc8MD\xad\xa7q\x16\x10\xbcq\xd5s*\xb6\x12\x8f\x86\x1a\x0c\xa6VZKt% \xa6\xf3\x01\xd9\xc5p\x17\x90\x1d\xd7*\x8ebO\x9dbq_\xb8\x06\xe0/^\xc4\xc2uP\x14kN\xdb\xc7g;\xedYB\xe8\xf1k\xea+\x14\xb4Y#\xa0\xa7\x08\x1fQ\xf3\x163u*\xb2\xcb\x1e\xa4B\xe2\x05\xa7\xc9\x98m\xb8\x83\xc5O\x9dB\xb9\x10\xfd\xbb\x04\xff\x09\x8f\xec\x14\xa64\x0e\x1bJ\xb0k\xf7`%\xb2M\x1b6\x97N;\"2\xc9\x03\x9e\x9cbH\xa8o[\xdcJ\xf4\x0a\xaf\x81\xd0\xe1\x1b\x15\xc2H\xedQ\xc1\xdd\x8b\xfeQ\xfd\xd0>\x09{N\x8e'<\xde;\x92\x11\x9b\xaan\x0e\xb1}\xf1\xbc\x17Y\x08\xd5Q&\xeb\x98\x0e\xe8\x0b\x98R\x84\x9a\xde\x88\x18\xe9#\xf9\xe57}h\x89\xd4A&\x903\x11|XP\xf6E\xc0\xb1\xfa\xee\xe8\x8c0@\xb8.\xf6\xd5\xac\xff>c\xe5\x95\x02\xbc\xe5\xc8TYf\xbb\xdc\xa2\xe5-\x83\x9b\xaf\x06J\x1a\xbb\x8f\x94W\x16}\xd7\xf1\xeez\x89L\x02\xf5X\x14\xbd*\x15L\xa2\xed\xf5\x0e\xf3\xaa\xb21\xb2;\x91:U\xbd\x86L\xd0\x01\x8dX\xfe\x15\xae/\xa4L\xb9\xa0\xc5\xe3\x89X\x0e\xd8\x1a\xfd\x16\xb0K\xe2\x03\xdcmr\xc0e\xfa2\x92\x9c\xce1\xc5_B\x8b6\xd7\xc0A\xfdx\x053*yg\x18\xe1\xd7\xf6P[&y\xfe\x1el3u\xa7\xddJ\x85^\x91\x10\x9c\"\xad/K\xe2\xbd\x06]\xb2k!lR\xbf'\x1a_\x86{II\xe2\x98\x90\x8cB\x01L1\xd4sa\x08\x12\x9e\xc8[\x04\x82\xb0F<|\xe0\xd4\x8f(\x15\x8d\x0aF\xad\xf7\xe1\x13\xe0\x8a\xa6k!y*\xbe\x19\x10\xeb\xf6\x83Dzj\xdf1\x00\x9e>\xb6\xdfj\xb7\x93\x99\x11)_n\x98K\xb5~\x07\xd8\xcdv\xf3\xbf\xb5\xe7\xa8\x09^\x08\x00\x16\x1ei\x93\xeeK\xed\x9b\x95s\x03<\xd2\x90j/\x03\xc8\xe2\xd6*2F\x8fK\xa4\x13f=)N\xb4\x90\x92\x94b\x1a\xb7_\xe5\xd0<\xa7\xc3k\x09\x04\x84q'i\xd9P\xcdS7\xeb\xc3\x0db\x9b3 \x14h\xfd\xb5P\xdf\xa8\xefE%\x9e3*\xb1\x0e\xd25+\xdb\x89*\xa9d\xb9V:\x8b\xfd\xa6YP\\\x00\xe5J\xf0<\x15\xc9%l\xb6\xe3\x00g\xa0A\xecA?AE\x09\x86\xb6mz-\xc7\x9f\xc1+\x10\x89\xdd\x827\xfd\x02;L\xbb\xae\xebua\xca\x04g,4G\xe5\xf9\xd0\x8c\x18\xfd\x09\xec;\xdc\xe4$\xdaV;IQ\x05!\xa8\x9b\xabo6u\x02\x1d&\x17\x9b\xd9M\xc1l@\xc1\x98d8\x9d\xa5d\x87\xdf0\xd9O\xc5w\xee\xe9AokahH+\xad\x0d\xc4\x9b\xa6\x92\x0c!\"\xa9\x10\x1d\xb0\xabz\x81c\x0dnr\xfb\xcf\x08\xcdr\xa1\x01.\xa5p\x15*\xfbM\xd1\x03\x84n\xf0Mx\xed\xca\xea;\xcfY\xb1\xf1\x168U\xcf\x8c\x1dq>+\xb8h\"\xfc\x0c@?\x94\x99I\xbd!^\x1a\xc3\xa4'=,\xd0\x9c\x9fC8\xa6\x8b\xad\x0fR@\xbc']B\\\x0bK\xb0\x0d\xf7\xa4\xadU\xbf\xb6q\xd5RW\xf1U\xaa\xe0\x96\xf2\xf3\xc7\"\xcb\x0f\xcf%\x92oX\xfef>\xad\x11\xd3\xf6\x8a\xae\"\xeb\x80\x98\x95\x88\x96\x09\x9e\x096\x84\x1c\x802\xe3`1\xf5\x9cPB\\\x92(p!\xa3\x16JM \xf6O\xec\x13\xe9n(x\x08,\xc4S\xa8\xa9-y/\xe3H\x96D\x0a\"\xa9\xcf\xc2\x82|\x05\x0f\x01\x124\x1a\x1d\xa8N&\xc4g\xcc\x01\xf9\xa2 \xb3\xa2\x9c\x98p!\x87\x13z\x05\x1aY}-V\xc2[\x01XnL\x0a\xf0B\x016I\x18\xc1\xd6e\xb8\x95s\xb1\xb8\xd1]$\xd6\"o\x99\x83\x0e\x9a\xd99\x86\x81\x93i\xc8~\xb1\xf2\xce\xb6\xfbD\xc6*>\x93\xdb\xb7\xa3!\x0cG\xd4\x9d#\xbbD\x95p$\x9a\x16\x04h:\x99-\x91\xb0\x8c\xb9p\x8c\xf7\xa8\x0f\xf2\x14\xdd\xfc\x1eYp\xc71\x95\xa9\xa2B\x9e\x18S\xf1\x87f\x0a\x15\xf3\x94\x82a\x04\x11\xc3\x07\x8b_\xe1\xb6WJ\xb2\xc1o\x82\x84_\xc7X\xba\x87\xb7\x0e\xab\xc2\xc3$\x8f\x8b\xb4\x05\xe1Y\x8eY`\xfc\xd2!$AN\x86\xb6\x07\xb1L\x83s\xca\xa9p\x1eA\xcd\xb5V\x06\x93\xfd\xb0j\xac\x05Y\x08\xc3q*\x82\x81B\xf6\x83\x92\xeaS\xa2?\xc8w\x0d\x8e\x19),%\x8a\x05\x17\x90E'H\xdb\xcc\xbb\xf0\x87\xe6\x9c\xbd}W\x7f\xbd\x9d'\xed\x1b\x07\xe5\xb1P\xb0;H\xa4\xb6\x0e\xfc\x81O\x01d\x1c\xb8\x1b\x0c\xe8S&\x1f\xc6<\xf9\x80T\xe7<;\x86a+\x18\xb1\xba$\xb8\xe1E\xfb\xf3z\x09\x0e!\x95#[u\xc4\xba\xdc\xc4f\xef\xb62\xfe\x972*f &1[|\xd0\xd9\xbc\xc2\x08\xad\xbf\x16^\x8dU\xc8\xc6\xfe\xd3o\xfa\x10\x12\xa4o\x8f^\xfb\x95h\xd0\x81J\xa1\x02\xf3\xf5\xcbw\xecn9\xb7\x12\x14=%\xe7\xb6\x1ch,\xb2\xd9\x0dT\x03\xf6\xb9\x96\x01\x0e\x8d\x051\xe5\xe9j@1\xa8\xa4c\xc2\xb6}\x91<\xcd\x8b\x0b\x11\x07#\x04l\xb9q\"aO\x03\xc9E\xdf\x1c\xeb\xdf\xdb.\xc1\xc8\x92W\x0a\xe8\xac\xaa\xe6\x1a\x7fG\x16\x01\x03\xbf\x08\xfd\xf3%\x1b*\xee\x88R\xeaW2\xc0\xa5(]\xab\x06L\xc9\x10\xc46\x84\xf5\xfaC\xd4y\x1f\xb6\x83c\xeci\x95\xdd$s\x81\x1e\x92\xad\xe4\x11$\xa2[Q\xa6q5\x93\x07!\xfa\xf3\xc6\xa1\xffvF\xcf\x069\x8eK\xc9\x8b\x8b\xd5\xa0\xb0\xc0\xda\x1eY\x92\x1e\xaa\x0aT\xda\xcd\xa5(f\xe4\xd4\x99T\xa1\xfa\x9e\xbej\xf8f\x9c\x95\xaa\xbe\x16\xdf\x85\xd5\xbd\xda,\xae\xd4\x14\x06*\x94\xa5\xe3\xd2HN\xa4\x999\xb62\"\x0f\xebYW }j{\x9e\xfd\x1c\x99\x92p\x89\xf1\xb6\x18N\xf1p\xd0GR\xb4\x00K\xd7b\x01\xaf\x0f_\x10\x0d\xd9<w\xbf{\x90\x14\xfd\xa6\xdaY\xb0%5\xbe\xdb\xb9\x16q\x13\xea\x8fWy\x99\xe9\x8c\x946\x06\x9ea?<\x84\x82\xa6\x968\x9f\x12\xadK\x15b6\xe2B!R=\xe1G\x08\xa7=a\x83\xb8\xeb,\xf4\xed\x1ef\x94\xba\xc5\xc3)\xc5:\x84\x0dA5\xfe-S90\x93\x07\xf9\x90%\xe0p\x85\x0e63\xd9\x97\x835r\xb1\xbei}\xbe=]<0\xc7\xbc\x17\x13\x94V8\x98\xc3\xd6\xfa6=5\xa9F\xf3M\x8f\xcey\xe3\xe5J`\x12-\xbf\x81\xe0\xd3\x14\x06_\x84I#I\xccj\xca;\x86\x8a)\xee\xc5JHe\x84Q\x8d\x0cL\x15\x91\xa2M\x10\xcb\xce\x8e\xd3y*v\x14\xfe^H\xafO<J\xeb\x00l%\x93\x10\x96v\xfd6z\xe9\xdc[\x06\xcc\xd600\xae\xb4:\x19\x94;\xcdU\xc1\x8b\xe3:\x9eMm\x0f\xb4\x9dQ\xec\x87\x03d.\x98W\xc3\xabb\xdb0\xe8#\xae\xf8\x83\x19V2\xea[\xfa\xa3\\\xc0\xc2F\xe1A\x90 \xd1\xd0N\x89\xa9\x90v\xea\x84\x8b\xe0X\x0b0A.\xaa\xc7R'\x1e\xd0\x14Jgc\xfdO\xb0>\xadC\xb3\xe3\xa8% \x9d\xaa\xa6\xf4\x86\x8a\x13\x1cCbK\\\x11$\xfa\x14\xd4\x1d\x9b\x11\xf4\xa0\xe9Eu)8Eo2\xe4\xa9\xbc\xda\x88@\xee\xa1\xfb-v3\x13\xec\xd8{\xd3\xd4F\xb2\xa1\xa5D\xa3:}m\xf1\x8bd\xf2e\x11\x01zH\xc7\xb2\x95M%\x14\xbf\xe9\xd3\xf5.\x0e\xc2\x85\xc8\xd9\xa1_\x1d\xdf\xc7\xe8\xc3\xdfH\xb4\xb6\x05\xf4V\xc4\xd9Xj\xf1\xe4\xd7X&\x95D\x0b\x82\xd2\x1d\xbar\xd2E9\xd7z\xcc\x1b\xa0\x04\xf6\x12n\xffpb\\\x87\x1f\x93\xfb\xa6RR'\xb1\xb3t\xfd\x06#\x99l\xed>\xb9\xf7\x0el\x13&\xbb\x12i\x09\xd7`c\xf8\x80\x14^{\x8f%\x98\xd2\xf6\xc2z2\xc0<\x07Q\x80\x89\xcd\xe6\x08\x9e\x09~\x11\xb6\xd2T\x1a\xcdD\xb8\xc7~Cl\xd6r\xa0L\xd8\xb9\xe5\x86\x81\xad\xb8\x9e\xe3r`s\xec1\x13\x05%\x87H\xc6\xdb}\xf2\xed\xdb\xd2\xbd\xdeS\xe2\x1e7\x90M\xfe!>\xa5\xa1\x1d2F\xfa[&\xb6D\xeb\xc7e\xd7\xa4\xaa\x07\xcet\xf7*\xd9w\xeb\xfep3\xea\xf0PI>\xa36\x1c\xe1\xedo\xc2?M\xc4f\xb1\x89\xf13\xe0\xb3\x02\x11Ia\xaeE\x10\xc99\xc2\x89\x89 \xa1$\x1c\xb7d\xc1\x19\xe9K\x80l}\x08\x1f3\xef\x81\xe1=)T\xbc\xa1\x88C\xe9\xc9\xd5Y\xf5:\xda\x85p\xee\x8b\xd5s\x19*\x1b\x05\x04S\x0b\xe1\x06k\x06\xe9\xebP\x98\x89M\xc5\x8eB\x8bh\xff0\xe4!\xfa\xf1E\x0e$\xe5d \xffE\xb5\x05/:\xcd\xee\xfeV\xee\xc0\x18\xb3\x99\x0f\x95\xc3u\xb5\x93\xca\x9f&\xb9\x844\x95\xc82,B\x12)\xd2bZ\xf5E/\x88b\xe3/\xe0L\xd7\xe03\xa4D\x18h\xe0\xf6\x92\xf4-\xeb\xf7\xcd\xb9\\n\x0c\xde\xc2\x9fF\xed\xf4x'.\xb2)\x85\xfe\xc2U\xe8\xefAh\xa0\xf3\xa2m;UDs\xcd\x0cx\x94\xa2;.\xe8/b8\x0d\xf4\xbc3\x924\xd1`\xe3Kh\xa9]A\x06\x85C\xc5T\xbe/\xc9\x84\xd0\x91\xc6\xb3 Z\xbeU'\xf5\x06\xcb\xc9\x03\"\x9e\x88\xf6 \xd1F\x9b\xe8'\xeb>\xbfT\xcb\x80M\x90\xd7\xf07\x96\xdd{\xa4G\xa1\x92\x0e\x86\xae\x86\x04*\xfb\x90\x18\x10\xdd\xbaz\x07\x1c[\x89\xd5{\xa9\xf2\xb8\x8a\xe7\xacED?\xc3x\x96\xb4\xd7\xdcRW\xfb\xb5\x11\xd5\xef\x01\xc9\xe7\xae\xffT\x98_q\x99\x01\xf8<:\xd6mRY\xe4\x19p\xca&\x9e\x98\xdd\xd2\xfc\xa7F\xa56\xbc\xd0[\x02\x85K\x1a\xb9\xb7X\x17j{\xb7\xfd\xd6\xccN;r@\xfb\xc5\x97\xc2u\x85zD\x95v=\x93\xb5\x0a\x984@\x0c\xceM\xf3\x8c\x8cN^&}\x06,\xe2\x0fu\xfc\x08\xdeVY\x0dU\x0f*\x07P\x0eE]\x9c\x14\x8f\xd7U%\xdb?p\xc4\xa5\xe6X\xc1P|lI\x86\x00\xe4\xc6J\x01\xed\xa6|\xceGbg\xb3]K:\xc0\x09\x7f$#\x9e\xfc\xaa\x99\xf7a\x17`\xde\x0b\xf0\x11\xfc\xe75\"Y\xa5\xb4\x9e=\xd2\xfe\x82-`\xd6\xbfV\xa5V\xdd\xb4\xbe\x96k\xceq.$\x1a\xa3\xd2\x99\xd0S\xc2;\xdf&)U\xc5\x1b\\\xf4\xe4\x0d.\x88_\x81\xf8\xe52-O&\x82FO*\xa9\x8eue\x1c\x04\xf7\x81Jp\x83E\xf9\x80N\x01%\xceyi\xac`\xe3g\xd2\xc9b\xd1c\xc1\x15\xbal\x15\xec\xa6\x00S\x0f\xb0v\xef=#\xdes\xbd\x96\xa4F\x9d0\xf67\x90S\xf4z\xf4\x93\xdf\x8e\x84\xe1APv\xdb\x00me\x95\xf0\x81e1&\x11\xa2a=\xd3\xedaO\xe1\xd8T\x89\x0b\xc5\xe6\xf2M\x0f\x1e\x06\xe3\x86\x08\x1a\x03\x09o\xd7b\xd6\x9b\xe6\x9d\x05i\x82\xc2\x8c\x13\xef\x02\xd5\x9d#]\xbc" +
+	"H\x07\"q\xd1J\xa3\x9e\xae\xe3$\xc6\x03Ok)*\x08+u\xf6A\x91\xaa\xed!w3\xa5A\xbf\xaek16\xdaD\x1dg8b\x8feA\xe1\xbd\xc4N\xe8\x98(\xd6\x85*\xd2\xe7+\xfb\xf0.h@S\xe1\xe4\x882\xc2\xe4\xb0\xf2\x91\xe2\x87\x90\xa4wM\xd5\x8ct\x00\xcb\x13/.2Pd\x1e\xd0\xb5\x16\xe9\xe2\x8f\xb9\xf94\x11\x8db\xc2S\"w\x8a\xe0s\xd2\x89\x1b\x91\xe7<\xf6w\xbcp\x99\xb0\xf6XR\"\x96\xb5,@\xf5\x8c\xfe\xc8\xf6\xa0\xaf59\xcb\"\x0d\x84\x02\xd6%\x8buBb\x99\xce\x08YNhC\x02\x1d\xec\xe7\xc2\xb8\x89\xa4g\xa7{H\x06S\xbe\x03Wp\x88\x1f\xfaX\xd3\x19\xb1\x18\xd4\x05\xe6\xdd\x10\xe76N@1\xe0\xcci\xc0\xf2\x00\x9aS\xb1\xf0aZ\x1a'h\xb5\x8f\xc0;\x1c\x89b\x9c)\xb5\x09\xd7Q\xe5\x18\x1f\xb5\x1fR\xaf\x88K\xc1\x85C\xabsM('\xcc\xdd\xa9r\x14FQ%\x95r\xcft\xd7 L\x8f\x10:\xe3>,\xb3\xd8\xc5\x07zs\x12\xd6/\xd2\xaa\x16=x<p\x7f\xc88h%qaR\xa2\x03>\x8d\\\x18\x0fN\x86\x94`7\x12\xf4\xa5\xa3,I\xa5b\x86\xf1\xbdPH\x94\xbf\xc2\xdc\xe7CO\xf6\x90\xc4\xdfw\xcbMX\xdc\xe0\x14Y\x86i\xd43T6\xab\x00\xa8\xc8\x0a\xf3c\x8c\x12\xb0\xaax\x1c\xe3\x1ew\xb0$\xa4\xc2J\xf11e\x9b]$-\xf2jGm\xd6\xe1p\xd8#\xc9S\x10\x99\xfb\x8d\xc3\xd54\x00\xd4\xc11\xc1\x9a\xa7\xcc]\xc9\xc2\xe4b\xb5\xb8\xa0\xa5^\xe7\xfe\xa1\x07\x81\xc1iq\xa6\x90\xfb\xe7\x08\x17W\x0c\xd2\x1e^T\xa3\x96\xeb\x0f0\xc0\xa7\xfdHO\x12]h9Y\x82c%\x0a\x0e\x84\x8e6\xbck\xef\xa9\xda\xc9\xbf;)^\x16\x8c/\xd6\xb0A\x01\x03gb\x0cHs8\xd8SLK]\"\xf6\xd5t(\xbf\xc6\xe0,:LT!\x12\x88@\xc4C#g\xe9\x12\xab63|\xd2\x0d\xe0}\x92\xd4+B\xa8\x16\xda\xbe\xb4\x8e\xdcn\x07F\xeb5D\x0a\x0b\x84\xfe\xce\x88\xe8\xac\xf71q\x98\xabR\x85\x1eOT\x91\x16\xbd\xa3\xda\xbd\x89\xb2p%W\xb0\x17\"\x82\xa6\xee\xed\xf7p\x19\xf8C\xecPS\x10\xfb\xb9\xf2p\xa5EA0f\xc5\x87C\x94\x95\x0f\x97\x94\x89\xb0\xe4\x0c\xbd\x93\xf5\xbb\xa8\xe5>N\x8bQ\xb3>\x0c.z\x02\x0c\\\xdaK\xea\x1d[\xfdc\x95\x94\xadrko\xcc4\xd7w\x80\x8e(\xac\x05\xe6\xeb\xe7\xa0#\xf1\xc2\xa5\xf0\x8fD;\xc4U3\xad\x7f\x8cs\xab\x00\xfe|({\xda\x1f\x83\xfaG\x01\xd1o7\xa6\xf2A\x02\xae\x03\xb5\xd1\x8f$o\xf4\xc0'x\x8e\xbe\xb6\xfc^\xb6\xd5\x04\xc5\xf4G=\xb9j\xd2Tz\xea\xa1tp\x85n\xc2\x12\x11q\x7f\x15\x02O|\x18'\xe3 [\xdfA\xd1P\xaaU\x1e\x86\xed(\x14n.2\x8d-\xbc\xc1\x80VK\xa2\x05Ez'6\x98\x15,\xe6E\x9f|[/\xd9\x02\xaa\xd6\x0b\x98\xe8\x90\xf0!\x1f\x9a\xc1\xfem/5|\xf1B\x00\xef.d\x8a\xf2\x01l\xea{\xddW^f\xc9A\x84H|_*\xaa\xd3\xa8\xd9\xd0\xf9\x90\xdc'\xd8\x9a\x01\xaep\xc2\xa9\xf7V9j\xbd\x9e)\xc4a\x13t<[\x9ei\xcb\xe7;\x9c\x85I\x88\x1a-j\xdf\xd4\xefD\"\x8d2f\x19j\xc4\x94\x99P\xdee}\xca\xd5o\xd6K\xf7m\xf8\xe9\xbc9\xeaxX\xad\xf0\xa9\x9b\x01\x96\x92\x833\x85\x08\xc5\xa9\xc65\xc6\xfd9\x0e_9\x88it\xcf'\xfb#SrY\xb3\xbc\xec.\x7f\xaa\xa0P\x0e\x8e\x89\xfe\x94\x1d\xa3W\x11\x86\xe1\xe7\xce\xe3\x01\xb8\xc1\x0b\x8aIh\x7f\xc1]\xa7\xe1x\x0e\xae\x1e<\xbb\xc8hqfU\xf2\x03V\xdc'1\xc67D\xdb\x8c\x87\xae\x86Z\xf73\xc6\xc3\x17*M\x03\x10\xa8\x88\x80;ast\x15C%D\x1c\xcd\xe1\x7f\xdb\xaf\x9c2_2\xa2\xcd\xe0\xac\x1f@\xbd\xfe:)9\\\x92^z\xfb\xcd\x0e\xbc\x1dH{C0\x12\xca\xc0\xa7\x90\x15/Ttc\x87\x97\x9f\x8a\xad\x07\xd1@\x87\xf2\xf8\x03\xea\xad2~\xe5\x1d\xe1\xb7\x97M\x99\xa7J\xb4,\xad\xb2\xb5\xc6\x9e_q\x04\xee:F\xe0\xb0}\x04\xe4\x1c\x80Qz\xc0X1e\x1d-]\xd6Q8\x0d\xde@\xcd\xa5\x93#\x1du0\xde\x8b\x81u\xda\xb8Z\xc8\x80t}DZ\x83\x1e\xf23\x8a/\xd8\x11\xa09I\xb3\xfd\x00\x8d\xad\x9a\x19p\xf1L\x15\xa7_?\xef`8\xd4\xcd\x81\xd8\x8a\xc4+\xa59\x0dv\x9d\xd4\xe8!\xf7O\xac\x1a*k5\x8bgE\xcc\\\xb5CG\x95\xf6Z\xb1\xf6\x11\xcb^\xcf\xbb\x95\xabB?\xc0tB[\x95\xa8\xf0g\xaa\xcc\xa8]~\xda\x14\xb6l}6\xb0y!QtTO\xaaf\xe4$\x06,\x9d\xef\xb0\x9d\xa8\x1f&\x09<\xc3\xc2\x87K\x91)\xf7P\xa9\x0fL))&\xc4\xa5\xfc\x8e\x02Q\x91\xe0\x14\xfe\x86\xc6\xa3\xc0\xd4G\x00+\x00]\x1fLf}.,\x13\x9cX\x02\xe98g\xa4\xf6-\xeaE\xa9\xfcVO\xbfn\xb8cQ\xc2\x10\x1e8\xd0fU\x92\xc3\xac\x9a\x88\x98\"\xcf\xad\xad\x8f)\x8eiI#\xc7C[9\xb90\x05\xe8\x05\x0c\x8d\x8a}\xc8i>%4EIV8\x9e\x86*\xed\x1dr\xbf\x82<\xaa\xf7\x86\xdd\xcd\x97\xf6c\x06\xbf\xe2\x0a\xa9\x8a\xd2\x84\x10\xa2\x97\xea3\xf9S\x9d\x88@\x15U#8\xf1\x00]@]\xcf\xbc\xe7H\x9d\x84d\x86\xc1aVb>1-rL\xc9\xf1\xe0\x8c\\\xa3\x101\xfcB\xbe\x16\x08J\xac\xe7\x97\xbe\xa3==\xd0i\x8c\xc1\xfc\xfa\xbb\x83\x1e})\xc3\xe1~\xd2\xee+\x1e{\x864\xbd\xb7\xe8\xfd\x89\x80[\xe4\xb4]\xaa\x81c\x1e\xe9\xaa~\x0bA\xf9\xb5h\x19\x95\xd6^\xf7\xba\\\xa8\xaeW\xb3\xa5\x95\xb4\x1b\xcb\x9f\xf7\xa0\xe7\x1c\x0e\x9d\xa8\x973\x07i\xe2\xef\x1a5B\xb2\x08#\xdax\x1d\xc4Q\xc3C\x871\xeeB\xf4\xbe\xe1\xb5~\x04\x97\xe1\xb2\x05\xd6T/!}\xd4A\x17\xa7\xa9\xa5 \x12\x9b\xc3\xff\x16)\x10:#\x9a_:\x05>\xb1&\xf2\x8c\x0b\x9a\x05\xe2\x1e\x96\xefJ\x95\x84@\xe4Q\xe8\xf0N\xe2\x1f\x9a\xe3\xa4\xa1q\xd1\x89J\x1en\x0d\x13\xdc\xf51\xaf4\xd3s6\x8a\xfb\x03\xa4\x0d\x14\xa6\x07S\x86a\xd1~\x0bI5\xc9\x04\xba\x1e\x9d0?G\xf2$\x9d\x07\x08\xfa\x88[%R\xaa\x7f~\x10r\xcd\x9a#\x19o\xa8\xc5}*r\xcc\xdf\xa8\x9dhPu\x80\xcd\xe9\x80!\x0c\xb7<!)\xad\xc1\x0aG\xb2\xa8\xc9*\xa69\xd3v\x8ck\x87T\xa5;X\xe8\xb6\xb6\xbd\xd4jD&`\x9fG2S\xd0\xae\x14k\"/\xb1\x13\xf7!\x92\xbb\xcb&\xa2J|p\x9e\xd8\xa5\x91\xe9q0j\x1f\xca\xf2$D'B\xf5\x86\xfa\xa1\xbd\xdep\xf8\"\x92\xdcYo\x8b\x9br-N\xb1\x87\xfd\xda\xdaI]\xc4|v\xc8\xf0Kda\x96\x10\xf2\xacG\xf7\x1e#\xf0\xf7\xe1\xd2\x02|fR#\x94d\xea\x91\xbf\xb9\x14.l\xdb\xcbu\x10\x8e![f,G\xdd\x13\xc5\xa6\xa4\xc0\xe4\xfbh'\x9dX\x98\xca\xfe\xd4K\xc3-\xaf\x0a\xe0\xfa\xb4P%\x02\x80I*`\xa6=\x10\xc1\x90\xe7{\xc9i\x9bP\xbcV'\x9d\xea~\xab\xf7\x0d\x9e\xce<\x96\xe6M8\x09\x05\x9b\xf5\x93&*\x8c\"~HxN\x12\xa5\xf1S\x96'\"\xb4:9\xaf\xdf\x82\x00\x83X\x19\x0cp\xdf\x00\x80<\xdc:\xf0J\xdd\x9cU<\xc1\x13E\xe5\x1b\x1fO\xc9n\xc9\xc1\xb1\xe5\x9f$\xcb\xee\x8d\x84n\xdcUwT\x00\x98\xca\xa6\xa2\x9f\xb1\x8f{\xa8y\xe7\x9a1\xac\xaa1I\x17\xe2\xbe\x8a\x1c\x92\"\xdd\x1d\xcf\xc4I\x86@c\xac\xd5\xed\xc1\xc9K5\xf0bD\x02\x08\x99\x12\xe4Q/:\xe8uucM\xe4\xf5A}\xd6\xb4)\xc1MDR\xe2\x19\xd9\xe1 (\xba!\x8d\xb9TUW\xb5\xbc|  \xe3\x83\xf6\x8db\x1eH\xa4\x01\xe8'\x1fqm\x18\x1aW\xc9\xff8e$\x1b\x87|3I\xf3\xad\x1fZ`\x02\xa0\xa4\xba\xfe\x12/\x80\x82j\xe5IxK\x84\x98\xdd\xf8\xb6\xe1\xf6\xd0.\x13LSM4\xad\xadd\xb32\x8c\xcbzR&\x94\xed{\xd8\x90eA\x90\xbd\xd4o\xe3N\x05\x9bQd\xea\x86N3\x87\xe6|\x82\xae\xfeT\x87\xbab\xb5C\xd0\xaa\xb6\xab\xe2\xb1M\x12\xc3\x84\x031V\x12V\xa9\x01b\x8aj\xc3\xa9\\\xe5D\x8a=\x92\\\xc6\xd5\xa8\xdc6\x80\x1e\xf1\xfd^\xc6\xef\xc8$\x7f3\x855\xf26$\x00J\xc5\x90CL\x14G\xe0]\xfd\xc0\x19UL\x99t\xc1\x83\x87\xdb\xdb-jF\xd6&y\x1al\xbd\x88}pa\xa5@\x03\xd1\xc7\xc1\x8d\xd7`\xd8\xe3\x0b\xa5b\x02\xa1:\x14\x90o\xa3\xcd\xb8]\x86\x86Xr\xac\xc8\xa3d\x7fmr\x04qgx\xd2\xae\xae\xb4A\x16\x92fa\x9c\x8ch\xc2\x85e\x86\xd8j\xa7\xd5K\xb9\x85E\xeb\x84$~R\xb4s\x0a\x12\xa3\xc93\x8e\xdd4L\xa5;\x8a\x83k:&\xb09Ps\x01[w\xcb\xc8:r\xa3XD\xa6#f)\x85\x9f\xebw\xe8\xf9\xcb?\x07 \x07w\x18\xdf9MyF\xaa\x13\xcb\x0a\xf5\x8d\xf2i\xc2*\x81\x8e\xf95jKR\xd0\x9cB\xea\xb2\x12H\xf3\xf8\x0d\x1f\x86\x9d\xd7Z\x19\x92\xd6\xca\xfa\xea\x97\xa1`=[\x9f\"\x9d\xba+W\x08\xd7\x81W/`\xeb\x0a\xa2\x89Xa\x85\x1a\xed\xc2\xb4U\x1b\x839\x00\xa0\xfa\x1c\xb4[\xcfq\xcc\x1f \x7f\xf3\x1eG\xee\xcf\xaa\xf6bLH\xdc\xf5l38,j\xb1$\xf8\x0bL\xfd\x16\xf5+l<\xa4\x1c\x91\xa4\xf4\x87\x9edN\x8c\xaa*7\x9a\xd1\x05\x8fO\x97\x86L\xdc\xabH\xf6\x1d\xc22X\xf4\xd1\xc1(\x1f\xc3\x19\xe1\x0aF\xfdH\xe6\x92\xe1\xaaqo%\xb8\xd5\x0b&\xc2\x8a\x11\xd8\x99f~\xe1h\x9c rl\x7fU\xbd\xcf4\xf72\xab\xd3\x92\x99\x7fJ\x80RU\xf5\xaas\xdcvZ\xa3GhDO\xf4R\x94\xccJ\x91\x13\x93\x09\xeeZF\xd1L\x15[\xd4\x0f\x01\xc2)A\xe8cX\xcae\x0f9\x1d$E\xb0\xb6\x1d\x85\x80?\x82bsI:gDcw\x8e\x94\x0a\x96\xc5\x84\xd5\xb4h\x82\x85\x00q(\xbe2\x86\x19\x82P\xdaH\xfeI|,;DJA\x01(\x09\x05\x08q\xd65\x8b{f's\xafK\xbd\xc4\xb1\xe8\x12\xcd\x9aI\x99{\xef\x88-D\x07\x81f\x8a\x10R\x0c\x1d\x0f\xba\x9fk\x91I%I\xd5C\x1f'\xf2\x1bL\x9f2f\xe7\x8cX\x013`l\x16\x14!\x08\x83q*\xe2Z|\x03n,47\x0a=?\xdd\xf2/R\xd9\xfa\x04\xecV\x0c\x92\x03\xc0\x8cp\xca\xa1\xc1F6\x0b\x9f\x17\xa6\x9a\xfb\xc6\x9a\xa9lt+\x98\x07M\xb5\xf5a\xe8\x09/\xee\xa0\x90\xba\xa5\x85d\x0a\xf4\x1d\x1c\xc7G\xe6\xc9K]H\x0c'\x01\xf5s$}\x7f'\x9a\xe1L\xf8;\xf7\xdc\xf1\xcab\x16fiJ\xbc\x11\xf4\xa36\xa3\xce\xd7\x11\xe5\xf9\xa4\x1b6Q<\xd35=J\x8b0\x89\xe0K]N\x15gej\xda\xed\xc29B\x85\xc1\xf6\xe7\x1d\xba2y\xcd\x11\xa4\xb9'\x12F\xeenl\x9a\xfch\x14\xd6\xe7W\x9c|Hc\xbc\x94\xc7\xc9=\xac/A\xbc\xfe@\\W\xa6\x05\xe0e\xacl\xbc&DR\xeb{l\xb5\x8b$\xdd\x80\xa8\x01a\x80\x11\x81-\x09jY\xd2\xc3\x95{B\xb0\xfdz\xe8\xa5o\xd5\xadK4\x96X\x1f\xf1{M;x-x\x8f\xd2\x10>\x18\xf4%-D\x86`\x99\xe8\xd9Iu)b/\x99\x91\x10g{\x02\xc0\xea!|\x94\xe1;\x92\x9cb\xd3\x94\xc06\x1a<\xf8\x0f\xb5\\:'yWM2\x8c\xcb[\xb8BQ/\x07!\x14\x12\":\xb7\xb0\x19N\xb5\xf9\x93-\x14mf\xf2\x86\xb2\xd3\xd1P\x82e\x9b\xf4\xb2l\xba\x1a\xad\xd16\xc2\xb4y\xfeM\xe1\xfb7E\x1fa\xbc1\xf9\x0e\x8b\xeeG\xdcW\xd6\xeaT5\xe2\x19\xd6[\xb4\x9dF\xc5v\x0c\xb6\xf3T\x9d$J\x11Y\x0ck\x1b$W\x1e\x87\xf07\x02`U\xcd\xb8\xfbe\x0eRo-\"\x8fQ\x19\xa0\x14H\x0c\x82nT\x8e(\xe2\x02#H\xc6\x19\xce`H&\xa1\xb4W*\xa8\xaf\xb4\x1b\xc1\x7f{K\xc1\xc2\x11\xa4\xa9\x1cFV\xb1O`\\&\xb2\xdc\x1dk\x97\x9d\x9b1M(\x9f<\x832\xb0\xaeh\x11\xed\xad\xd40#\xd8f$\xf6\xc3\x94\xb0\x12\x9e\xc7_\xa7\xaa\xf5I\xc1\x0d\xae\x14b\x90\xbb^1\xc6\x96D\xc2i\xed\x11\xe9\xb8\xa8\xa4\xae\xd8X:]\xab\xc9L\xc0\xbc\xf6y\x08-?=Ie\xc8\x89Gj\x0cYh\xd5UR\xb8\xf2\x97\xd2\x0d\x0e\xa7f\xaf\xf1\xd8J6\xb3\xac\x09\x8c8\xfan\x0a\xfbDg6\x96NT\xeb(5s\x8b\xa5g\xcc\xd6\xc4\xb8\xf0\x86\xeedS\x0aR\xa5(\x95\x1c}\x10'u3\xeb\xbd\xee\xb7\xec\xe1J\\\x93\x97\xc8,g\xaf\x0c\xfd\x8d\xf1S\x9b\x14\xd8\xe4\x00\xe4\xc2o\xfb\x9cc\xb5\xe6\x16\xae\x18\x17\xbe]\x09\x94:;\xa1:%\xb8(!~\x8b\x7f\x06D\xb4h\xa9|c\xfb\x18x\xd3O\xc0S\x17d\x82\xde\xd7d\xearfh\xff\x8e#6\x1c\x8b\xcb22\xb1\x91\xbb\xdcjlU\xa1\xd6.IG\xbd\xb4T\xb7\xdcUw@\x97f7UO\xbf\xee\xd12I\xa3f\xda\x12\xdci\xbe\xc1'\x8a7\xc2\x12\xe8+\xc2e\xd2\x0czy\x15m\xf0b\xd6\x94\x1f\xa4\x9bS'\x1c\x94i\xc6\x8c-\xb1[uG\x9d[\xfb\xe9&\x8c\x96<\x0a\xa3\xc2\xef\x9b\x13u\xf2j)\xddS\xc9\xc0Z\xa9\x8eM\x14m\x17\x90\xdb\xce':\xf8\xf9\xd1\xd1\x11,2\xa2\x9c\xe3\xd4.\x0b\x0c\x86\x10\xa2\x02H\x09@v\x8a\x11\xd0\xfcw\xf1\xba3yfN\x9a\x12n&\x86\xb7\xa7\xc8\x99\x14\xd8!\x9b3\xb1\x15>\xd5\x8b\xca\x0b]r*hs\x16^<\x0fl\x08\xdf\xf1\xa8\xc3:\xf0\x10a\x81\xff\xc8\x80\xea\x1e\xd1\x01\xd7\xa9\x93\xb2\x13\xb6\xdc{\x09\xd7\xf5\xdd\xa1\xa1V\xe4m\xac\x06j?\xc7\xa9\xd0\xd10Um\x96\\\xec\x1bq\"6Io\x10\x9c-\x09I\x12$\xb5\xc8\x84\xe6\xfa\xf6\x109\x7f>H\x09\x086I\xae\xe9b\xb4\xf7lY\x1do\xa09\x80\xd2\x06\xe9\x8cbW\xe9\x04\xb9\x8c\xa9\x14M\xdb+{\x1b\x86\x0bB\xa2\xae\xbe\x96\xb6\xaeT28\x19t\xb9(\xf9t\xbe\xfcV\xc7\xcb\xbb\xd1[}\x07\xb3;\xe2Rz\xb4\xd4\xc1\xb3\xa9\x7fd(\xd7\x89\x94\xc2JT)I\x1fu\x93\xd3\xd3\xd4we:\\v\xcb&\x92\xf4}\x1de\xf8@\x010\xf8\xcf`\xe0'\xb0\xe3dEp\xc4lM1\x1c:\x97'FQ\xdft\xbf\xdaN\xafD'\xa3\x0f\xefQ\xc8\xca\xad\xd82\xc0K _\x0b\xda/\x18\x92\x86\xdf\xc6\x13X\xb85\\L\x90\xf9\xce\xce7\xd85\xe4\\\xa9\x0a\xf2\x1b\xc2\xa4\x1fa\xe7\x11m\xfa\xe6\x14\x88\x1c\xa9P\xbb\x80R\xcf\xa9\xa4\xf4\xa6\xe9\x89m\xcd\xd2Z\xbd\x162\xaba\x17-a\xd4(U\xe7\x94\x9e\x97PL\xb8\x96\xa7\\\x1f\x9d\xc4S\x15!\x07c\xf8\xad\xd3\xbb\x9d\xb1\xc40\xfe3\x93vP-\x82\xb6\xb39\xe0&N\x99\x98H8\xcb\xea\x96\x03\"7?\xc6\x06S\xf6\xcc\x97\x86\xb0V\xba(od\x0e\xdf\xaf\x97*\x90[(\xfa\xf4\x09\xe0X\xf2\x11Vc\xc5{s\x1e\xc7\x82$RK\xd15\xfd@\xc0\xae\xf3`y\xa0\xf4C\xc2\xf5\xd6\xa8\xcc\xbcV;\xd1\xb4\xc2y\xe3\xaa\x87V$\xb6p\xa6\x98\xaf\x09r6\x0f\xec$\xc8\x04~\x99)\x84D\xf2\xb4\xe0\x99\x1du\x9f\xfd\x87i\xec\xebV\x18\xbbV\xdc\x0d\x7f\xc6\x0e^x\xb2\x11\xf4\x9cqrc\xa0z\xd6\xf0\xfc*\xad\xc8\xbf\x96\xd2\x8a\xbf\x0c\xcd]'\xd8\xef\xac\x1d\xcf\xb1HBs\x0e\xe0\x1d>e{\xdd\xf5E\x92\x03S\x90\x9b\x99\x00\xdd\xd4\x1fa\xd6\x7f@1\x9e%\x98\x93\xb8\x03J\x097ps5\x82\x18'\x0e\xcb\x0a\xb5\xfb\xd36\x09\xfb>?\xf6{\x9fa\xa6\x0bIp\xf4\x97\xe18\x7f\x1b\xa4[\x0a$X)C\xc47n\xb5\xfc\xe1\x9do\x00N\xc5\xa8/\xb3F\xea;\xb0;+\x15\xd6\xa3\xd3<\xe0\x1c\x83\xa9\x82-d\x08sGD8\xf8\xa8\x9d\xaf\xbe\x95\xa2k\x8cF\xe6\xb8)\xea?a)\x88\x9f\xdf\xa0\x18\xfds\xdc\xd2\x13\xa7FL\xd7\xa5\xd9G\x19h\x0b\xb8\xf2q\x1a\xfd\xdd\x95\x9dm\x93N\xb1|\xae&Q\xb5\x14e\xa9\x07\\\xa5n|\xa2" +
+	"x\xf7[Wn3\x05@\xd1\x8a\\\xd4f\x14\x16Q\xcb\xe6\x94]\x09\xe4W#*\xa9\x1b[\xc2\xe2\x8e<\xd2\x1eL\xb39\x81i\x1ek\xf4\xc3\xb7D\x94Qd*\x1b\x19>>e\xa7\"\x85\xf1\x04\x8f\xc0>TeSN\x9e\x08\xcb\xe2\xea\x8cEe\xa1\xc8\xfdQ\xb27+\xf8t$\x90\xb6\xf2\xe1\x00\xa5\x90N\xad\xaf\xc0\xb5\xeb\x1c\xdd\x14\x9c\x96\x92\xab\\\x06S\xf4\x066\xb7\xd9Fs\xd1b\xe9\xe6\xab\xb9;\x9d\xf9+@8\x98 \xb0\xf5\x03\xce\x91\xaa0\x0a\x95\xe4)\xb1\x0bt\xbcP\xa2\xa2U\x7f\xcf\xeei\xacn\xc6H\x93,-z\x957\xb0u,\x05.\x02\x1aS\xec\xf589\xd3\xc3\xb8\xfd\xf4%\xbe\x9d\xfdD\x06\xfc\xbd\x18er\x82\xa3\xa2\x0f\xd9hjw\x09;\xfc\x03\"\xebf\x92O.K\xb32\xd2B\x97?\xca\xa4\x11;\xe6g\x1c^\x7f\xcf\xa0zr](\xcf>\x01\xf8\x95\x00>I\xd1\xe6\xf3$\xcb\xb3\x82\x02\xf8\x18\"uMd3\xb0\xe8upY\x9ao\xe2'S\xe4\x84\x84 \xbbG\xd5o\xe7 \xdf\xdakht\x0c\xf7\xe1\xa98@t\xe2\xb7\x19A\xba\xfa\x88G\xe2\x94y\x0eP\xf2O\xe7\xa2)P'\xa1E\x021w\xbe\xc3\xa1\xaf\x83Hd0\xc4\xcaM\xa6K\xf5\xe8\xa9\x8d^\x7f\x8c\xef\xbc\xfe\x8b\x9b\x8f+\xb4D\xc9\x03\xae\x9c\xcd\xf2q\xbb/L\\\xeed\xf1\x097\xbb@\xef\xb19n\xce\xa2E\xf4z\xf5)T\xbd\xcc\x08\x87DI\x8b\xe3^\x01\xc8\xee\xb0\xff\xc2\xc0\x16g\x02\xb2E,]\x18\xe70(\\/\xf4\xba\xc0O\x9bB\x1b\x09A(\xd1\xdfZ\xb0p\xa7l\xf6\x16<\x94\x15\xb4\x07(\x80\x84\xe5\xd4\x95\xe4\xe4R\xa6\x02\x16\xe3\x04\xa0}\xebk\x8ce\x1c\xb1d\xc1\xedd\xe4\xa9\x13\x1b\x19\xc7:\xc7>'\x13Fy'Q\x90\x97G\xffT\xcaA\xf3m\xc3\xea\xee\x06)\xec\xee\xe4\xf4\xe2\xd2n=\xc1\xc9K\xff\xa9<\x91?\xc9\xfeOH\x0a\xbeG\xe3\xca\xcexI6\xea\xbc\x8f\xe2\xa5\x83\xb8Lu\x1a\xfd\xc2\xbed\x17f\xe1\xc1\xca\x94){\xc3%v\xf3zY\x9e\x18\xaf\xa4R~D\x0d\xbeM\xa4\xe5\x84Od\xee`/\x8c>\x1f\xa7\xd7=c\x16\xd3CW@\xe4\xd0\xf9\xea\xea\\\x8e\xb9mU\xe6\x05u\x9eHU\x1c\x92\xbaBIg\xed\x15\xc7\x07d]!\x85\xfeRRh\xa7\x92#t\x00-O\x88/\xe8|PM*,Tq%\xfa\xb1\x04\xdf\xe7M\xcc\x13\xa9\x1c\x92TJ\xd2\xe4\xc2\x1e\x88\xeaD\x13aq\xf1\xd0\x12\xf9v\xed\xff\xc3\x166\xe9\x08\xe5\xa07Q\xf9\x94\x88\x00\xe6\xc4U\x958d\\\xc3W\x11\xdff\xd5\x95\xbd\x17\xb9\x0d\x19\x9b\x08+\x19\xb1\xc4d\xc2\xcb\xe6\x9b$\xc9\xa6\xd3\x00t%>\xb02\xe7J\xb9\x89\xe2\xa5h&\xb9\x94\xe2\x80\xf2\x8c\x91\xac\xf5\x0f@\xa32\xd5RQ\xb1\x1f\xa3\x8d\xa5\xe6\x1e\x92%\xddi\xdf\xbd\xad6{&\xcc\x13O\xf3<\xfe\xa1\xc7T\x83W\xbe\xf9\xd0m\xb7\xe9\xff\xc9\x15\x17\x97\x90\xd6\x10;I\xe1\x08\x1e\x12:a\xca\xd3i\x12\xe0m\xfb\xd0\xcb\x832\x82\x91\xcc\xa0\x9f\x80&s1|J\x07\xae$\xf8\x9c3\xae\xf4vK\xe7\x83\xee:\xb0\xe6\xf0\xce\xc7!FI\xc5TWMY\xff\x11|m\xf1\xbc\x09\xefN\xbc\xff\x1c\xcf\xb2\\\x82`\xa3Q\xdd\xcb\xe8!\x0bn$\x0dc(RlK\"\x07;\xb1\xc9\x17\xa6X\xe3\x81N#*M\xcfPB\x07\xcdq\x87L\x0d+<\xd6\x97\x90\xab=\x1f\x18\x98ksJ\xc3\xa7\xc8b6|\xb1\xa7\xe1\xad\xf7\x0e\x92\xb1\xa6\xcc3s\xd0\xca\xd6\xa5\xf5^\xd1\x04\xc6~\x99\"\xd3N\xb0\x09\x82r\xef0']9\xe3\x84O\x88\xfd\x06$B\x8a\xd8!\x08c\xad\x9c<\xb5\xa0\xcb\xb5\x9e\x09\x1d\xfaH;\xb2x`,\x0e\x18\xcb.\x14(I\xcb,\x87\xefU\xca\xf8\xafc\x15\xb3\x87\x83\xb1?L2\x14\xd7\x94p\xa5\xeb\xe3j[?\xdf\x07\xe9d\x08\x94\xa9\xeb\xec-yD1\xfc\xf3\x0b\xe0H\x90\xc7\xbce\xdd\x87\xf8\xbe\xaafS\x14\xbaP\x1a\xa5|\x0c\xdf5s\x91\x1f'\xae\xce\x07\xd2\x84]`\xea4f\xef\xc6\xa2\x8aH\xd5\xcd\x97~\x85Vi\xe3t>\xf7v{\x15^\x0a\xd4\xc4\xb4\xd8\x04\xd2-/%\x03\x85d/TH#!~\xde![f\xfa~*\xac0\x0d\x92\xea\xaa\xf2\xee\xbfCn\"bg\xf1{\xcb\xbfCJ\x12\xcc\x19\xa2n9\xd0;\xd07\xed):V\x11@\xef\xf0o\xdf6G\x8b\xf5j\"\xfe\xb8,n\"@L\xf2J(B\xb9A\xfb\xa6\x98X\xfa\x0e\xd9K\x92\xec\x02\x09\xa6\x19\x88\xbc8`\xc1\xc4\x98\xc3~\xf9>\xe4\xde\x0a\xe3\xfb\xcaJ,\x0d\xd8\x91$tAn\xa95n\x86|\x98>uG-V\x08\x9c[s\x02\x8d\x897\x87px\xc5E\x96\x16_\xbd\x85V\xb2\xf3\x89\xf6\x8cy\x8a\xf8\xc6\x97\xdc\xe22G;~\x8b\xed%.I\x9f\xd0\xc0\xbe\xf6mD3j\x9bj\xdbX\xe5\xcd\xff1X\xc0\x85\x06\x07\xff\xd8\xd9c\xbc\x9f\xa6\x89c\x81\xb4c\xb5\xe9lpZ\x0c\x0e-zP\xbc\xd1\xed\x95W\xd9\xe9z\xc4\xf2\xba\x84\xea\xeb\xca\x01\x81\xcd\x07B\x08\x1da\xbc\xad3\xae\xd2\xb9\xc0vu\x0ab\x81D\xbdR\x92\xf9\x9a0R[\xd2C\x87eI<\x7f\xc8\xc8\xab\xd5\x01\xc2d\xbdj*\xf1\xcb)\xb8\x89\xf7\xd8\x14*\xda\xd5>\xc6\xa9\xf1\xfa{'l\xc78\x09\x08K\x1c-\x0c\xbb\xc1\xf5=\x0cP/\x9bv(\x83\x84\xf0\xffO\x10\xa9\xa4\xbbN\xfdQIO\xaf$\xf7\xd9\xcb\x0aB\xe1\xf8B\xaeK\xa4\xf7*\x06\xc9<9\x14\xd7\xc5 \xe9l\x98\xd3#rS&\x1e8\xce\x80n\xe2\xfd\x1d\xbcH(z\xc7\xc9\xb8\x95\xa8\xfa=\x87,8\x00%\x16\x10\xba\xa4^w\x94u\x8b\x8d\xfb\xef\xe2\x162\x19)\xd9\x8e\xa7e\xba\x14\x9b\xa1LB\xdecf\xb5GV\xfb\xc0f\xb5\xab\xdcv\xc0:x\xd5\xccH\x90'\xd0\xbdT$\x02oTZ]\xcc\xf2s{zn\xae\x1c\x17h\x95\xa85\xf4\xcf\xe9\x1cX\x92c\x9b\x1e\x04\xcb\xf7\x01\xaeT)I\xe1\x8fa\xca\xdfq\xe4$\xf9\xc0}\xdc66e\x14(\xe3%|2\x05\x06v,\x83)rGP\xd5\x8f\xdd\xef\xb8mOhd\x8f^\x8f|\xe6L4|\x8eT\xf5\x16Tb\x17Hu\x87\x03\xf6~}\xd5\x18\xaej\x02\x85\x82;\x17n\x841\x0c_\x05\xc1\xfb>s\xb5cX\xb8\xfa\x1c\xce\xc7\xd10\x1b\xf59\x150A\xb8\x8b\xa1\xf69f\xf4\x1d\xdf/9\xcda\xd1\xd4\xaf\xf8\x8f\x0c\x88\x91\xc2\xb6\xca\xed\xb1\xaa:\xd8]\xa6\xdcp\xae\x88\xad\xe7C\x07;=}\xdb\x83\xdd\x04\x85\x88E{$\xb6\x1f\x0b\xe9\x9a\xfa\x16\xb6\xf0\x8c\xe1\xac\x0a\xb2\x16\xeb8\x00\xaa1<7\xa9\xbb\xd63]}\xb0\xd7\xcf\x81T5\x95>Nd3\xc9=YOG\xf2@g\xfc\xc5PL\x09\x09\x87\x8e\xc0 \xfaM\x10L\x02\xcd\xf9G\x90i\xae\xb8\x91\xbd\x08\xec&\\\x17\xa0NPj/m\x8e\x80\xda{\xe5\xf2\x9a\xa2\xc7\x89\x1bU68\xfe\x18\x1ek\x8c\x1e\xcczd\xf4Mz\xf4\xee\xc4\xed\x9a\xb6\xcd\x1c\xcb\xa0\x02B\x13\xe4\xb0|JV\xfd\xe0`\xc3]\xbdAt\xb5\x9e\x80\xb8\x04\x069\x06\xca2p&\xb0\x8fg\xe8\xe9;\x9f^\xf3(\xbf\x85\x9b\xa0\xa4W\x91\x8d9\xac}U\xbc\xa2\x94U{C\x9e\x13\xd5\xbfL\x92I\xcc\x19\x07\xde\x8a$\"@\xdd\xeaW\x9d\xafr\xf8\xe2\x11\x13\xd1\x92?\xd1b\xf7\xf4\xb4\xa1\x106\x86\x8f\xccO\x04-7\xe7`:\xc2\x0ct\x0f\xe3k>X\xda\xcb\xd6\xa4\x04\xb64\xf3\xcf$\xd1\xd8\xc7\xf0^4\xd2+z\xd5\x97\x92)\xd5\xcf@D\x11\x86\xfb\xf0D\x9d\x03\xaf\xbbK\xd2\xda:8\x8e\x82vfD\x85\xb5B\x0a\x94b$\xf2:\xe3F\x89~@\xec\xf9\x9b\xb6\xb4\xdc\x08\xef\x8bE\x00\xe1\x8fD\xb9\xf3R\xe0W6\xca\xc4\x1dny\xe4\xe7\x0a\x81@r\x83\xc1\xed=\x96\x12\xcc\"\xbbu\x8a>D}+\xfft\xa9gS\x97\xa57nN<\xdc\xb6\xd5\xdf\x90.@J\x98D\x18S@lR\xc5$qv\xe6X\xee\xaez\x16M\x0fw,!\xc4O\xaf\xd2\x11\xb1\xe0\xb0\x99\x98n\xf5\x8e\xea\x88\x9e&\xa92\x0d\xc7x\xd7\x1e0\xc6C\xed\xc4\xdc`\xea\xbb\x99\xac\x87\x8fs\xea\x8f\xe0[\x9a\xa6e\xed\xabM\xab\xd6\xac\xcd\xa8\x91\xa7\x02\xea\x08\xaa\xad\xf0\xf9\x9c\xe9\xe0\"\x9f\xff5;\x92\xee\x9f-\xaf\x94\x88{n \xdb\xb1\x80>\"\x90\x7f\xea.\xc2\x1c\xee\x1b\x9bci\xf9\xbc&\x85D\xf0#!\x7f\x80` \xc4\xa6\x011\xfe\x0e!\xcb\x97\xa4C\x89\xf5\x93>\xcd\x08y\xb0\xa5\x01\xe7p\xb4\x9fg\x05\x8c\xce\xb6\xae&\x17\xcd\xd8^c#\xb0\xa6\xab[xlX\x97\x9eRj\xbb\x81\xbc\xde\xa2)\xbd&\xd2<\xf4J\x9c\xfd\xdd\xb5\xf9\xc3\xc3V\xe2\x84\xb4\xba\xea\x919\x14\xe2\xa4\x84{\x9a\xf0]6\xc15'\xe2\xa4\xec\xd99&\xc5\x08\xc7\x1e{.\xb2\xe1\xc4?\x10C\xae\xff\xc5\x0c\x96\xb9\xf3\x19\x86\xe9\x94\x0fr]\x13\x8a\xba\xda\xa1\xf1\x16d\x13\xeb\x9d\x158\x18\x9ai\xb4 \xc4\xed\x8a\xe2,Re\x91\x9a\"\x17p\xec\xf6\x19\xbd\xadL\xb3\xba~u\x14\xb7\x878PN\xa2\x1eG\x0a\xa5\xaf\x02\xda\x11i\xe4\x97\x94\xad\x02\xf0\x81\x10\xd7\xdbl\xb6\xb7\xbd\xe7\xbd\x01b\xe0)]\xb7d\x04np\xc1%\xcb\xe1%Q\xb3\x04\xab2\xc0\xd3{\xfd\xff\x01Zp\xe1TD]e\x9cR\x1a\x0f\x81\xac6\xb2\xa4\x17\x0cX3\xc0\xc1%\xea\xb7\xf4z\xfb\x1d_?r\x1a[\x06\"\x9dk\x86:\xe98|G\xca\x86\x85\xefT\xd0\xdaF\xf2\x05c\x80%Ej\xcbJ\xb1'\xa2\x12\xc9\xa5U\xd8\x87\xa2\x1d\x13\x03\x1c5\xe7\xc0\x12H\xd2V,\xc8\x04\xc7\x87*\x80\x9b\xaa\xa2\x8b\x99\xdf\x8c\xf8\xe8E^\xab\xa99\x86\x19_x\xed\xda\xb1\xb4\x08\xc9\xdb\x95H\x0714\xafr\xa9\x0bu\x8b\xbc\xed\xfa\x88>D\x90\xe7\x12r\x86\xc44!\x92f\xda\x19\xeb^\x15{\x9e\xd7<BM\x10Z\x9dc0>q\x0d\x7f\x94@s.@\x9coV*\x95\xea\x8d]\xa4$.\xb1\xa4\xc9\x80=.n\x90\xb2\x8e\xd9\xbe\x96f\x06\x11\x8eQ\x0e\xbbP\x1d<y\x85\xe1\xb9\xa1~\x901\xc6Y>1\xcf\xaa\x02\xf3\x9e0))\xd1\xc8\xfd\xd6\xc1:\x9dR\xf9\xe1\xb4\x99\xc3\x8e\xe07\xfe\xb6~C\xf7=\x96\xddi\x90v\xbf\xa3&\x91?bJ\xf3;\xd9ut\xcb\x19\xfcK\xd8-\xe1?\xeb\x05\xb8k\x8cO\x8d[\xfal \xd9\xb960dL\x08\xe9\x89\x02\xa7\x0c\xb8\xe0\xe6@A'S\xc1\x1c\xcb\x10\xde#9AN.\x80\xb0u>\xe4\xd0\xa6J\xbd\xa1J\x91\x90\xb6\x81\xfc\xcc\xc5\\MH\xac\xe8A\xc6|\xdfp\xb7\xedy/!FW\x88&,\xccy\xd2\xbb%P\xe0\xe4\x95-@\x93\x00\x9d\x0e\x87@\xc7p~\xb2.\xe0T\x0aW\x7f#\xc79CX\x95\xe7\x0f\xdfR\xfc\xe17\xf5u\x17o8\xc2;\xb9vq\xa2\x93\xb11_U\x12M\x10\xa0s\x14\xf5\xfc\xb1ex\x894ua\xac\xe2\xff\xb2\xa4C\xd2\xe8\"JL1\xea\xa4\x12\xfd\xd9\x9f\x89Z\xdc\xe8\xf1\xa8.E\x1c\x9e%@\x8fO\x90\xf3K\x10.\x80\xf6CHO?`\xc8\xfc`YC\xe37P\xd4v\xce\xfe\xd3{\xf0\xb3\xc4%\xe3\xd9\x13\xb3\xce k\x12[\xba\xf8?Tt\xc2\xc2\xbff\x1ec\x02\x1a\xb2\x04+n\xbc\x9a\xc8\xd6S\x18i\xe7kc\xc0\xe0\xb3>\x08\xf5\xf9\xa5\xdclQ\xef\x07L\\\x0f\x16t[&\xd4Hi\xca\xbf\x94@\xcdr\x8bz\xe7\xecr\xc8\xbb\xaf\xdf\xe1\xa3\xa0\xa3\x10E\xf4s\xb6oJ\xa4\xd8\xa5\xbbAm\xbcs4\xd2+x\xa5\xf3\x1e\x04\xe5\xc7\x90\x9fp\x85\xd4,\x03\xd8\x84\xc9kNY\x9f\x90\xc9 \xcd\xd7g\x81\xe8\x154\xc6Tm\x145\x91\x10*!\x8c\xa2\xe9\xcc\xe4\xfd\xf8\x00a]\xd5\xcdW\xde\xdfd\xe4P\xa3Xh\x82\xbe\xb8s\x87\xb2\x10\x9d\xc4WH\x03%\xdd\xd9\x94\xe84\xa9\xfaGR\x11\xd4\xd4\x90@\xe9\xe8\x85\x82W\xfd\x01b\x9b\x1f\xbc/\xc9s\xff(\xf7%tL\x90\xa8]H%\xbc\xc6Y\xa4_\x87\xcd)We3\xf1\xbf7\x86\xf1(\xb5\x82\xc9@P}\xce\x07\x04\xc2:\x0a5\xad\x0b\xb3\x19\xe6\xb24\x1c\x99e\xf1l\x09\x83\xc6D\xd5\x06\xdbN\x84\x1f\xa9\x92\xd3\xc0@\xce\x85\x9c\xa7p\x96>P53\x04'\xe2'\xe3\xa6\xa2\x88a\x1c\xeax\xa2S\xe1\x9e\xf4\x87\xcc\x11\xbf~w1\xc1d\x08p\xab\x8c 5\xd8\xf1\xf5\x15\x09\x90\xd9\x17^\x0f\xd2\x1b\xe6\xd1\xd7\x9d\xeb\x10\xd1\x1ds\xa6,e*,\xfc\xe8F\xda\x1c\xaf\xc3\xd7\xd8i\x13\xea\x9c\x08B`\xc1\xa4\xc1yA\x9f\xf9;\x15\x0c2\xbc^\x85T&y\x8b\xad\xdd\xd2\x97\x11\x7f\x88\xb7X\xb0\xf4\x1d\xbe\xa9\xcc\x96ru\x09\x04\x09B\xc2r\xfd\x9f\xdd\xfc\xffZ\x11\x0f1/\"\x00D\xa3^\x0c4\xdd\x94UH\xf6\x0c$t\xd8\xcb\"$\xd2\x1c\xc9\xd2\x99\xf8\x99t\xc428\x18\xa4\x09\x89y\xb0~\xc2\x01.\xf1\x1byAE\xc4\x81\xa1\xfe\xc7\xd9@\xc5v`&\x1dm\xda\x96\xa1\x02\x19\xd5p\x83W.k/H\xdcQ\x80\xde[\x00\xe0\xe7;\xa2\x99\x11\x03\x159\xc5_%\x99=\xda\xaaX\xcf]\xb3r\xf5\x12\x05\x000b\xcc\x19<4\x15\x9c\x07$\xb7T\xa9S\x96\xe56\xef\xa8\xf1w\x9c:\xa3\x12&\x83\xaf\x9dh\x96\xd5\xafA\xa7k\x86\x99\xd4ih\x1c\\_\xf3h3\xd5M\xc7\x0bC@e\xbcZ\xd5CQ \xd9\x85xR\x1c\x11\xed\xceD\x16Yn)\x15\xd1\xb1\x0e\xc1\x12E\xdfs\x11`U\xec\xdbL\xf8\xacc\xdd\x1c\x90p\xef++\xd0c=\xaa\x02\x81}B\x19\xda\xc7Q[/\x81s\xe9u\x90(\x14\x0cr4\x14R-.\x00\xd9P\xcf\x99\x86b\x91\xd6\xeb\xec\xa5\x90T#\x8dv\xc3\xd2<\x82\xe5\xbe\xd0\x0f\x0aU\xe59RC\x85\x87\x01j\xeb\xc2_p\xa2\x11XjsZp\x9c\xb1\xb0\xafI\xa0\x82\x02U\x8eb\xb1\xa9\xb9\xec\xa3\x9c\xa0\x00\x01\x8fi\xf1\x12\x1d['\xb2\xae\x97\xeb\x03;i\xca\x96w\x0b\x90:\xee\x1e/\xb5\xdf\x09\xa9\xd6>\xf2\x0b\xe6\xbd\xc6T*\x0eq\x1c?/\xe4\x82\xe6p\xc2\x96\xca\x11E\xfeh2<1&\xf9\x1e\xf7\x8d:\xbb\xc4\x1a\xc8|3l\xa4{l\xa6\xec!\xb6`\xeb-\xea\x1c)\x015Vi\x86\x02Q\x8fA\xe9\x1eA*\xf0\x00;s,$\xaa2\xe5q\xa1\x84\x1dUP\x0d\x0e\x16\xd7\x0a2\xb83w%;M\\\xbb\x89\x15\xd0\xb6\xf71d\xdd\x9c\xd6\xa4\xd0\xae\xec\xa3\x12\x11gY\x16\xf3f!w\x11\x13\xede\x8aA\x0b\xcb\x13\xba\xed\xc0\x84\x18*\xf4\x96\xf3\"\xb3}+%\xe0\x04r\xa7e\xec\xe8\\\x00r`\x01el\x03\x1dNd\xaf\xb4\x9f>\x07\x8e\x9e\x18\x01?0S\xdb\x1c^\xe4GP\xb8\xa4tm\x18\xc2\x98\x13\x09\x11/L\x80h\x9b+)\xd0\xc5=\xfd1\x8c\xf8\x8fm#\xbe\xd7*u\x9c'/\x91\xba\xba:\x8a\x82?v;P\x1cH+\xe7\xa6\xc72\xd9\xefY\xc9tvN\xfa-X\xd8\xb7\xe6[\x0a\x0d\xfa=\x7f[q\xb2\x11,\x80\x8e\x0d\x8e\x05\xae\x91~'wc\x88\xca\xe6\x80 \xb7=\xcd\xd9\xbd\xf5\x0em\x85\x0b\xd1\xe9\xe7\xd5d\xef\xd2\xc3A\x0f\xffq\xd2\x99z\x1ajii\xa5#\xbaR\xe5\x0c\xefM\xc3\xb9x\x0d>\xc5\xe3\xc41\xd2/;R\x19\x09\x1dn\xe9\xd3\xe1\x96z\xe6\x91<r\xa2\xbe\xafZ@Js\xfd\xfa\xda\\\x08\x1c?D\xa9]0\xb9N\x11a\x8fB\x160\xe3\x83\xa5_\x174\xfeiJ\x8c\x8f/V\x14\xd7\x04\xe3\xe5\xfa\x8b\xa9\xc7P\x0e\x84l\x8f\xe9\x09\xd3\xe4\xf3U\x8c\xc6\x1fsB\x88\x9cI\x1f\xd1\x14*\x1a6St\xd1\xde\x89\xa6\x90\xf5\x1b\x84\xfc\xc9\x92\xf4\xb0\x88\\\x82\xa0\xd8\x82\xfd\x90\xc0u=\xd0=G!\xb7u\x01\x09\xe8eB{\xf8\x0a7\\(J\xe1\x0c\x0e\x04\xb3\xe89R\x0e\"\xc9\x0c\x8e\xe5\x08\xb6\xefQS\xf9\xcc\x02\x0a\xcf4\xe9$\x8a0R+\xef\xe0 _\x01\xaf\xbf\xf2\x06G\xd8\xd6R\xd6\x1f\xd0s\xd4\xd4\xde\xb8\xcaQ=\xb3\xcd" +
+	"Jo\x0d=}\x1bN\x1b\x1dQ\xfe\xe5Aq\xe2\xde\x88\xc4\xf6y\x1f\xc9\x14J+\xeb\xc2\xae4\xea\xe8- \xbb\xd8\x0a7\xb0&\x05\xd5\x1c\x87\x08\xc2\x0e\x88<\x09\x118\xf5\xfa|\x80\xdc\xf8H\x10D<6nd\x85\x94\xff\xa3y\x8e\x905\x12\xf5a\xadp\x93\x92L\xde\x81a\xafDU5\x06\x1e'\xe0xa\xfbs\x80}\xc6\xff\x15\x8c\x14`\x8f\x15\x9aG\xdd\x8e\xe7\xa45\xdd\xb4\xb5c\x99\xd9yq\x19\xcf\x0f;\xfb.\xc2\xcd\xab\xeeRp\xeeb\xef\x12Ed\xb9\xed[\xa3x\x96aiw\x91R\xdb\x86\xb5\x9bw\x90.6\xc4\xc9\x1d\xc9\x19\xed\x02\xdd\xc1\x91x\xab\x1e\x9f`\xb0\x09Q\x96O\x0f\xe0J-K\x98\xc0\xcb\x81\x12X\x07\xd6\xb6D\x9b^\x0a\x84\xdd\x1a\x1f\x01\x1c\xcf\xe0\xda\x8b\xdf\xad\xdf\x14f\x04\x15\xabo\x9bs\xcc\xb4\x94\x9cN\x8b>\xc1\xfa-\x8e0\xe30\xf1\xf8:\x01\xdd\x8b\xfa\xb5\xe7=Ee\xa8\x17\xa3^ \x94I\xe0\x9b\xd6\x97\xcb\x92\xfa5\x13\x01\xf8\x97\x05\xa0\xe0\xcf\xb9\x9d6K\xa0\x01\\E\xdc\xd0 \xce\xb7\x9bb\xff\x00-\xba\xa0\xa6/.\xdb\xccH$;\xee\x08\x80\xa4\x8dRW\x1a\xa8'\xc3\xc7\x93\xe4c\xe2\x91\x81B\x1a\xbb}h\xc3[7B\x92'\x06@\x94v\x0c\xd5\xdaRP\x84\xd0\xafSI)\xecH\xd8_8\x8a\xb3\xdc|k\xfd\xe7\xf7t\xd8\xad\x9asy1z\xdb$\xed\x0f\x0c(\x17\\\xde\x8e\xe7\xc6y\x1f\x09\x9dp\x89\x94\xe8\x8aH\xc9\xa8\x0d\xf3A\xb4\x7f\xd29\x9fP\x7f\x9d\x92J7\xc7\x15b\xd7\x8f\x18\x8f3\x93\xb9\xc7\x09\xf1>\x8c\xc1[\xa6\xa3$\x05d\xac\xa0\x0f\xe9(\x85\x8es\xd1\x83#\x18u\xc4\x90\xb8m\x85\xdd\x93\xa5daS\xe7;.\x83\x0f\xe1g\x15\xf9\x85\xca>|\x1f\xa3{\x01\xd0\x8cQ\xd4\xfa){\xa8\xf6\xe4\x82}\x94\x0e]\x9f\x99a~_\xa5\xdaD\xf3\x88\x01\x03\xbd\x91(t\x15\x9d\x8bz\xe5\x7f\x1b\xda9\xa2]\xa0\xdf\x89e\xacv;\x9b\xcae\xa7'\xb2\xedg\xb0\xad\xe5\x8c\xb0\x92\x0bl\xf9\xbf\x81U#\x9a\xf9\x92\x05#\xc4Md\xa6WD\x1c\xac]h|u\x9c\xc22\xc0N\xc6\\aK\x85\x1a\x93\xf5Q\x1a\xc6@\x91\x9eZR\xeeO\x05N\xa6\xf3\xe6\x9a\xb8++\xe6\x9a\xa0\xee\xf3\xf0\xf1]\x0a\xff\"n\x1a*\x8a\x80ys\xaf\xa7\xa4\xd4\x09\xec\xd9UGb\xb1\x9f\xf5\x04PO\x15 k\xc7\xf8\xd6\xcc\xef0c\xa8\x9a\xb4\xf7\xe4=\xca\xfc\xba\x9cm{4x\x93{\xac*\x7f,\xc8\x15\x86\xc9\x0cO\x92*#\xf2\xb2\xd71?\x16\x964f\xf4\x14qB\x89\xd8\xde\x98W\x009Sl\xef\xd7\xe0\xa5H\x0dPJ\x0c8\x9d\x8b\xa0\xbeq\xd3ib\xb7\xb7\x1e\xb3!\xee\x11\x8c\x16\xec\xca\xca\xb5\xa0\x85\x02\x19\xca\xb67\xd4\x9b:\x15\xa5l\xc0S\xc6^\xf8\xaa\x8f\x9e\x90\x826\x18\x7f[\x1f\x08\x8fF,L`\x08\xc7\x12\xb6\x920*<\xde\x18Y\xc6#E\xa5 \x9b\xeb\xce\x07\xbe\x0eW\xec3Z\x8f\x83\x80\x15ov\xd6\xfd\xb4\x07\xd7\xe3\x10\xbdcAv\xa0!\xbcx\xdeE=\xa5\xe8\xa6U\xb2\xc8\xf4\x1a\xd8Y\x97\xdd:-\xbb-\xb4\xdd\x125\xf1\x06\xa8\x12\x93e^ba\xad\x8a\x92\xcf\x91\xf2\x99\x97q\xa9\xce\xb6|G\xbe7\x07I\x01\xd1\xe4,\xc2p\x1c\xc1DP\xee\xd0#\xf2\xd2r\xbe<\xb7IG\x7f\xa4\x1d\xed\xc7\x1fnd\x95p#\x94\xc8\x0c=\xc87\xb1\x8e\x1d\xd2\x15kO\xfc\"\xb9\xbb\xdb\xf5i;\x02*\xdd\x8dW\xbf@\xbf\xbc\xc3H,\x02\x85\x92\xee\xbaSj\xc5\x8es\x1e\x9c\xf6\xa9\x98sj\x03~\xbc\xd7F\xdc\xf5\xe0\xd0\xaay\x0f\xe5\xe9%\x9f=I\x91\xc3a\xfbIF\xbfU\x83=z\xd9\xc7M\xb9\x91TT3\xc6\x03\x03\xed\xef\xd8\xad\xf0\x97\xd0_1\xe1\xd1\x12\xaaD\xb7\x08&\xa8\xff\x94|\x1e\xd1\xa4\xfa\x0e\xe2$E\xc41\x1e-\x12\xdc\xd5\xcc;\x06\xaa\xc8\x0cQ\x05\xc8~p\xd8\xc4\xa2\xca\xd6\x19\x04\x17\xea-\xc3\x0dq\xfffm\x17\xe8M\xf7`;\xd8>\xe8\x9dPS\xc7\xe0\x83@:\xb7\xe7\xd6Z\xae+\x94\x1bo\x9a\x0a\xd3\xbd\x02\x0b\xfb\x9aB\xfa\xe8\x8a@N&\xfa{\xe7=\x84\xb3\x82/\xfc\x861\x8c\x95'\xab\x00\x94\xcd\xd0?\x96\xf0)\xea\x96bHn\x98S\xa4W\xd1\x88\xe5x\\.\xdc\xae<\x9b\x90\xc1r+f\x1a\x13\xa9\xb5\x9a1\xe9N\xb7v@PX\x1e9\xf78D\x06V\x0dM7\xe1O]\x85m\xdck\xa7\x84^\xaco\xa9\xc6QzXz\x8d\x18GH\xe3{-o\xc1\x7f>\x17QQH\xc9U\x04\x16\x12\xc6\x97\x12\xe9\xa7\x94\xa0+B\xd7F\xf8\xc3;z\xc8\xb8\x06\xce\x18\xe1qG]\xfb\xb7\x98\xa1}C\x04\x7f\xa59=\xf0dH\xdeK\x95\xa1$#\xb0)\xcc;\xeb\xa7T\xeaX\xf8\xd5\xd2\x10ix\xfb\x0a\x02\xa5\xc8Y\x02\xed\x0dAZ\xa6~\xdb\xb6\xc4\x86\x1d\x9e\x0c)\xf3\xc4\xa4\x13NWB\xe6\x93V\x14\xa9%\xe9J\x1dP\xc6\xd1($S\xd3iS\xd6W\x1c\xe51[\xa8\xc0~M\xa4\xce\x1d\x85\xf5\xcd\x04\x96\xc8\x0a\xf2\x05,-\xd9\x9cP\xf7\x956bsR\x15)\xea\x15\xfe\xc0\xc4\x86\x0f\xd0X\x1f\xd7\xe0\x0f2\x98\x86\x96\xa4\xd68tg+\xedH\x8a\x06\x08Y\x1a!\xd2%%7\xdd\xf2\x88<\x8e\xe4!\"\x9e\x99\xcd\x93h\x05,Y\x08\xfc\x9c\x12p\xa8\x8a}\x0a\x19\x96Ig\xb1jg\xdbjx\xd3\xf5\xf3\x99U\xfbM\"a=\xef\xa1\x1c&ye\xea\x1b.\xc7N\xe2X\xe0\x99MM\xe84r\x85Q\xf6\x19\xd1\"\x99\x13\x1cp\xc9Y\xf3\xb9\x12\xb2\xa4e\xcea\x1b\x90(\x87 (\xd5\x0eD\\\xf6H)$\x073\x0fz\x11\xadkaW\xb1|\x1c\x0b\x1a7\xdaeDa\xda\x9b\x03\x03\x8a&\x00,\x95\xb4\x07\x00\xef\x1d\xc9\xcf_c\x05\x89\xe0\xbf\xe2\xb8Z\x9bL\xd8Z\xf7J\xac\x89p_+\x8e{\x1f(\xd36\x16\x1d\x07\xcd\x11L\"}\x13\xd1a\xad],{\x0a\x1d1\xb2\x92\xd7wD\xd6\x7f\xda\xaa\xd3\x8c\xb9\x12b\xf5\xb6\xe9\\\x19a\x9d\x0d\x04\x0bte\xdc\x00I0rJ\x9d\x9aa\xbe\xcf\xb2\x1d\xf7\xfc;?\x85a\x98\xd6'\x16\x8d\xaf\xa95\x8c\xdf\xea\xfeL\x87\x8c\x09\xef\xf0HGwXXk]\xa4\xfbV\x105\xab\xaaZ0\x8c>\xc5RT\xfc\xfc\x90\x06z\x0d\xb1\xf8(\x13\x0a?H\xad%\xeda\x09\x1c\x0f\xb4\xa0;\xc9\xd0c\xdc\x94\x97$u\x0f\xa5\xb1\xa9&\x10\x11\x0d\xb0a\xfade\xaf\xaf\xd8\xd8\x86\x9b\xd71GE\xcf\xfd\xdbn\x9c\x0a\xe9\x8e\x8a\xeeh>\x13\xdfub3w\x0eS\x85\xce\xcd\xfa\xae\x0c\xde@2\x82\x1d\x0b\x11\xbf\xfa\x0dz\xbcV@\xe9\xac\x8f\xd8\x98\x0fHyb]?%|\xfc\x18\x97\x0e\xa0\x1e\xcf\xb0W%B\xe8\x8f\xd6\x7f`r\xb8I\xdb\x93\xfb\x8c`\x86[\xe4Av\x86\x87\xdd\x9a\xf24C\xdc\x8e\x9e\xe9[\x15\x02Xv\x91\xf4\x14\xe5\x86\xec\x1e\xad\xa9\xbb[\xbd\xd9\x01\x1c\xca\xed\xf8\x0c\x92\xa2,r6\x9f\xc6\x8c)G\xea \xf9]\xa1\xb2\xbf\x0a?D\xfel\x89\xa9ddd\xba$\xba\x0a\xb4\x82D\xf1\xca\x0d\x94\xa5\xa8\xc2\x95\xc0\xfb\x1boU\x000#\x9e\x15\xc4\xb6\xb4\xdeV\xa5\xe7\x88C\xb0\xca\xc8\xc1\xfc-\xc5F\xa5~\xa0c\x89R\xe2+\x1bm|Q\x92\x1f\xdb\xe6 Xe1V;\xee^t\xdbN\xd5\xf3\x9a\x10f\xc5\xa6/\x95*\xab\xc7\xcb\xda\xb5\x0c\xe3\xad3\xf1RI7\x02\x1d\xdeS\xd2VT\xba\xef\xc8\x1b\xa9Zs\xa0:\xfa\xf1\x19*\xe3~\x1ea\x89\x1e\xd5\x0a\x8e\x00\x98[?t\xfa\"\xbbib\xdd\xf6\xdfe\x82\xf1\xe6\x94%\x9a\xf0@\xc4&\xa3\xc2\xb6\xe0\xa6mej\xd3\x88#-\xaeSI\xe4f\xc5B\xa2\xd7#\xf3\xd7\x92\xc5\xb8\xf7\x88\xdax\xf2\x84\xc0!=S>\x0eRG\x94I\xe6P\xbb\xe9\x02{\xec&,0\x88\x88|\xab;(\xba\xc0;T\xda\xaa\xd4\x81\xcc4b(\x05!\x08\xdf\xb4\xc8\xb8V\xee5\x8dte\xaa>N\xeeb3s\xafc\xf0/\xaa\xed\x0a`\x00=\xc6gOE\xec1\x88\x05\x0e y\xc1p&\xd4od\xdb\x13\xe5'4O~\x0e=\xfd\xd3\x1c\xb8#B7\x09<\x9d\xca\x05=t\x9fR\xfb\x1dg\xab\x0f\xe5\xea\xad\x8c\x00q\xa4=\\\x9bqn*\xd1\xa3iN\xba=\x9a\x83\xaeg?\x81\xa3>Y\xcb\xedx3y\x00\x1dE6\x00%\xcf\x16W&\xe2\x10\x04\xdd)r%\xbe\x8dyQ\x95\xfeL\xcf.v\x85^\"b0:\xd9/;S\xa3\xcd\xd8\x87:\x18&\xf1\xb7\xbd4Kv5\xdd\x9d\x86\x80\x16)\x83t)\x18\xa9_J\x8a\xc8\xf7\x8ar\xa3\xec\xf1\x0e\xde\xd9<\xee<\x9b\xf7\xba\xf0#\xb8\xa8\xae\xc0\xa3\xa2\xeeH\xa4&'t2D\x9dGM\xc5_3K`\xbd\xc4c\xcf\x8b\xcb@\x9dIFz\xbdB=\xc6\"$\xe8c\xda\xf1\xa3\xc8\xd7\x9f\xf5P;\xdc\xa4'{\x06;2\xe4\xbb\xe5\xa7\xd0,\x9f\xa2\xd8\x08nKDC\xc9\x15\xf4\xf7\x0a\xa7\x83x&\xf0\xa9\xf9\xa7C\x01\xc9f\x16\xf9\x81\x16\"\xf8:\xeb!\xa8\xf8\x134\xa7\xab~\x02E\x91\x9cvE\xc6ea.\xbc \xdd\x84qz\xf1\x8e\x87\xe2=rA\xfe\x8e\x96^\xec!\xe8\xb8i\xb7\x8c\xea\xd1\xea\x84\xd6LX\xd1\x1b}\xa8w\xe0C\xddh]\xb5h'\xca\x01\xe1\x04\xb0\xe3\x12\x0e\xfe\xf6-\x12\x85'F\xebU\xf1Z\x0c\xc0Y\x1f\x81\xc84\x1e\xe9\xdd\xef\xd3\x9e+\xdb\xc0R3\x0f\xfb\xd4L\xa8B`\x97}t$7\x9dPUfM\x10\x00=\xde\x91t\xddq\x01&\xd0}\xf5\xfb\x05\x00\xf8W\x08~\xb5\xb2(dG&\xac\xf4\xe238\xb2\x18p\xcc\x8b>\x98\x16\xf0\xf4\x81u\x1c\x0cx\xebP\x81\x9aJQ\x16\x7f\xa40\x11\x97\xa8\xbe\xae\x9a\"v\xce\xc4%\xa8Y\xaa\xce\xfa\xe8i\x9e\xda\xd5 `\xea\x97\xcc\x10/\xaa\xd5&\x1a\x865\xa2x\xee\x9cx9\xf0g\xb8\x1c_q\xac\xc2\x1f\xa0\x0dBp\xd4\xf4:q\xf6Md\xfc4\xb8\xd4\x89\xcck]\xe1:@\xbd\xcb\x91\x0c\\\xa0V\xbavk\x82\x01F~\xe1\"]\xb9\xb8\xd0`\xe5\xa2\xcdV\xe1\x95\xcex*\xc6b\xe5\x9d\xac\xbf,\xe9,6\x8d\xc3\xf6\x0e\x9c\xd2\x15\xb1m`\xdd\\\x94\xad\xca\xd4)-Y\x98\x98\xe8\x01\x17\x89t\xa9\x16\xd7\xe9!\xebi\xee\xd1\x1e\xce\x1co\xd4\x0c\x1a\xa7\x04\x1e\xd0\xa5\x93\x8a\xdb\x10\x11\x99K8\xe5\xba\x95\xf1Y\xfd3\xb5\x81<@\xf3\xaeVP\x87&1s\"\xc43.#\xca.\xb6\x96rFG\xc8y+\x09Or\xdf\xb6\xc6\xccD\x98w\xdd\xbc5\xc3\xa4\xda\xf04\x8d^\x89F\x8b\x84C!\x03#<\xe4\xa8\x99\xc59.*\xcf\x8b\x8b \x89\x88H\x0e\xf3\x058\xa9sf~X\xe5\xb3R\x92\x80\x81\xbc\xc2\xf3\x9e\x02\xa7:!\x9a\x05g\x93\xca\x1c\xc7\xb3\x04\xd3\xf0\x9a\xd2q\xe5\xd5+\xd5#4hN\xa9\xa3\xf7$/1\xa8\xa2k\xa7\x9c\x1e\x97ks\x0c\xf6\x82&\x13+J\x1f4\xec\xc6\xb9\xc9,V\xbd\xad\xecV\xcb\xebV\xcc+_2\xad+\xc6\xf2S4\x9c\xf5\xf7x\x0c\xc5{\xdf\x83/w.P\x85o\x04\xa8v\xa2B\x05Q\x9e\x03\xf2\xd4BVG\xc6:\x8c\xea\x91\x96\xc8\xf0o\xf4\xa8\xdc\xecooN\x94\xf7\x1e\x9a\xbb\x16\x18\x1e\xbc\x0aozf\xc42\x11B\xc3Y\x02/\x14\xd4o\xb7\xfe]W\x98\xe1\x823\xb9\x195L6\xb5\x8d\xbe\x89\xa4t\xc1\x0a)\x18\xcdi\xb8\x10\xf5\x0bL\x84|\x87\x92\xb6a\xc0\x05b\x81\xcbP\xa9Pi\xbd7\xcd\x85\xb8O\x99\xb4v\xa1?&\x8b\xa1\xcd4\x04\xdfo\xf8\xc8\x9f\xc8\x9c\"\xf0jR\xad\x13\x9b\xbe\xa0\x07\xb3\xb4\xbd\x81\x1fp\x99\xd7\x8b\xb6\xe9\xde\x91\x8c \xcc\x98Tdq\x85\xdc\xff\x0e_5\xea~\xcf\x0c&\xda\xdfm;\xa1\xdfQ\x03\x037\xcc\xaa\x15\x9fp\xb5C\xd7w4\xd9\xc2Pg\x18\x00\xf2\xaf\xf4\xb8\xd8\\h\xd0fN^\xe9h\xe0\xe6\x13|\x98\x89\x80\xc4k*M\x17i&\xde=H\x81]r\x0eN\xa9\x0b?*\xfb\xe8\xcb\xb6\x19\x88\xf6\xd4\xfe\xda\xde\xc5\xc8\xfdA\xd1\x82HV\x0a\xf9gu\x82\xfb\xec\xbd\x84\x91&\xea\x03Y6y-\xfbq\xb28\xe3\x12U\xecz0\xae\x1bm\xd9k!\xc3\x9abJ\xb4p\x07\xec\x9f\x80|\xa0\x8a\x88M\x96\xa0%\x8aV\xc4\xd5\x0e\xe1\xa0\xa2\xc8\xf6T)J\x1e\xa1U)\xc8\x00V\xe1\xf1\xe9\xa4\x1aC\xd4R\xa08\x8b\x8d\x9f|\xb0\x16=\xf9\x1dS\x05\x9bn1$\x8f\xcex2Jf\xf9\x94w\xb5b\xaf\xaf\xc0\x05\xa0\xf3\x9b\xb3\xbcX\x15.5\x83J\xa20\xa0V\x8c\x84\xf6\xc0\x8a\xb9\x87\x95|\xcbQ\xb7qJU8\x8c\xf80\x0c\x7f\xfa\xc0\x12\xc5w\\P\xe2\x15a\xea\xdfI,\xbe\xd3\x0c&\x84\x99\x04DVK\xc5NA\x8c}\xd2J\xc6\x95|K{\x96e\x0f\xfe\xeem\xc9\xdf\xddL\xea?5\x93f\xba\x1e\xdaN\x1eo8J\xd0\xbbB%\xb8\xc2W\xdf\xa3\xbd\xf9\xe7 \xd8\xf6\xcb+z\xc3\xbd\xa3i_J\xadf\xa9\xfe\xd6\xd9\xc7\xd0]\xd2\xe1\xa2\x91q\x83\x02\xe0\xe4\x14\x09z\xc1\x1f\x8f\xcd\xb1\xed\x88\xa4\xbe\xf7\x84\xc8$\x91\xdf\x97\xda\x1c]7\xdb\xdd\xe8f#\xc2\x11^\xcb\x11\x95z\xb7\x9d\xc1\xbejR\xa0\x94\x98\xd8\xdb\x97\xc1%\x99S\xeb\xa8\xb8\x91.v\x0d\xd0\xb7\x00\x11\x919\x9d\xd4\xc4\x8f\xa3q\x9e\x8e`\xa1\xc0\xcd\x97\x03\x91\xaf\xe8V\xba\xd8O\xa5\xdd\xc2\xf4\xd7\x0b0\x1a\x1fiW\xd7+6b&(w\xab\x91Z\xed\x86\xb0\xbe\x9dB\xd2\x9b\xcc%A\xae\xaf(r448\x0f\x82\xbc\x0c?\x807\xe9!\xbc\xb6\xd1\xc6!\x80\x81\xc6\xef\xf3}\x91R\xa5\xf72\x1b\xca\xa4L\xc7\x00+!\xcen-\x8a6\x13$\x95eN\xa5\x17\x1c\x81g\x11\x08y\x8aY\x88\x93\\\xefZ\x7f\x15\xa6\xad\xac\xb2NJp\x9eM\xb6B,w\x87\"b\x892\xdb\x80$\xb6\xcf{\xf0Y\xcabe\x86\xebd\xaax\xd3_\x0a\x98\x11n\x16\xc6\x197\xc7\xdd\xf7\xde\xf1\xee\x9d\x19\x00\xcb\xda^X\x86v\xa8\x1ae\xc7O\x7f\x1bZ\xcb\x99\xbb\xbd\xe3\xe7\xe6\xdb\xe1\xee\x15\xe0C'$D\x1cL\xda\x1d\x17\xb0\xe5\xdd\xf9\xdb\xe0F\xdf0\x82V\x83J\x9bi}/IT\x98tv\xcc\x85\xfeo\xa1e\xbd\xe0\x96t\xa9\xf9^\xb8\x99\xdf\xd8\x96&EIx\xdd\xf6\xa8\xf13\x07\xa6|b#\xd2'\x89\xd0\xad\xe5D\x8e\xd4H@\xb4\xec\xde({=n\xd8\x9fYp)\xb4\xddz\xd8\x8c\xfdMv\xa9`G\xe3\xe7\x02\x84H\xb8\xe3\xa0\xfe@\xdb\xe4\xfb0\xdc\x85JX*\xbdD\xdd\x18#\xe6>\xf2#D\x83\xdaG\xc4\xeb \xa5\"7\xac\xf1\xbd\x8b\xb2!\x9c\xe1\x14\xc79\xb9\xef\xc1u<\xd6\xcdH\xbdK\x9d\xc6\x8dxC\xc5\xff\x05\x90\x19q^\xea\x08\xe3\xbd\x1e\xad\xacC-\x01\xe5\xec*Y\xbc\xa4\xfb$\xbcb\x96 \xef5\xc0}\x98D\xb4~\xc0+\x86G6\x91\xb1:$`\x8d \x11\x14\xc9\xb6bo\x1a#\x9cM\x8b1\x02\xe1\xb8\x0d\xb9\xf5\xe5\x05\xb1\xde)P\x17^\xc3\xda\x0b\xe5\xf1\xa5\xa6\x9a\xa3\xa3\xe4\x03)\xb6\xd5w\xe0\x92M\xd9\x07Z\x82\x1e@\x90\xb6\xa3\x80\x0a\xe0\xfa'\xe1\xbb\x15v\xf4\xc5@\xf7\xa3&\xf9\xfe\xb69\xee\xb5h\xb7<\xdd\xa8\x8bD\x03\xc3.0\xcbY\x10S\x15\x1cr\xaf_\x1d\x8e\x91;\xb2\x0f\xaa}\xdd\x96\xb0-\xdb\xa0\xe9_\xeeGX\xf1\xd3\xcc\xb0\xaeH\xa4\xad\xef\x86\xef))\xbb\x835\xbc\xe9]\xee\xdb\xda\xce[\xc3\x0c\x1e\x1d\x9b\xdf^jD\xaa\xe8K\x13\xd8o\x96\xe1\x82\xdc\x98\xfc\xda\xc0\xcbc\x994\xe9$\xc7I\xa0i*\xceU\xf0@G\xa3\xa8\x11\x83\xdc\x05)^\xb5\x0f\x8b\xd6\x8a>\xd2\xbd\xf2\x9cG\xbd\xec[\x81\xe0\x84\x8e\x1e#\xd1\x88\xfeF\xb2\x97\\\xb0\xb2d,\x9c \xa9'\x80\xd4JyH\x0a\x06c\xc5;\x89\x94O\xefY\x882\x97\xf5!1[bDM\\\x0dxa\x83\xfcI\xf3\xc9B\xe3\x163\xc2lL\xb9\x7f\xeb\x8d\xda\x93\xdf2\x0b\x96\xf6\xb0\xa4\x90f\xa7\x85\xb1\x14\x84s\x91\x84K\x04\x16#\xa7\xe8\x99:%\xf0\xf4\xc2" +
+	"\x9e>!\xf4\x1c3?\x06\x15\xaeq\xcd\xdd\x0au\x82c/#PGXAh\xa7\x90\xa5|\x0b\xadm\xa6\xfdh\xe8\xf0\xa8<\x05(\x91\x11\x15u4\xac\xc2\xd6|\x07_{h\xaa\xd4\xd20z\x0e\x94\xcc\xa3T\x07\x8b\xe4\x83\x04\xf9\xfa#\x05\xf8\x15e\xd6k\x0d\xe2\x99\xc0\xe6\x15\xd7\x18\x11`P\xd0GL\x89\x9a\xaa\x14\xf54\xd8\x14sW\xc8\x9fp(\xe7R\xdb\x06G\"\xbc\xed\xab\xf0O\xfe\x13yV\xdc\x13Z\xa4T^\xccO\x19\xacD\x8e\x01Y\xf0k\xe9*\x96\xda\x80Xz~\x8d\x80\xc0zA\xcb@\x9e\x8b\xa6\x92\xf0#\x01\x1d,3\x84F\xe6~\x8c'f\x1f\xf3w`\x956q\xca\xb8tyJ\x9dvB[[\xf3R!\x19_\xe1\xe2\x18\xf4\xb9\x95\xc2[\x10\xf66GB\xee\xca\xe4/y\x1c\"@\xe8\x9a\x18-\x12\x07\xb8\x99\x9bs'\x07\"\xe8\xc1\xb4\x07\x1d\xb8e3V}$\x8c\x8a\xac\xe0\xb4\x84\x02\\I\x06\xf3e}\x85s!\x83\xba\x97J\xfc'\x0e\xa8\x85mc\xdd\x1f.\xdfI?\xf2\"\xaf}\x9b\xc4n\x8aY_\x16te\xec\x82+\x0c3j}\x1eb\xe8\x85y\xae\xcf\x9ejmq\xb6\xe9\x88XrT\x84\xdcy\xf7-k\xff<gf\xa4\xaa\x88\xc1\x12c\xd1\x84fKoVi!\xffy:/\xdb\x09\xb3@\xdey\xc99&O\xec\xf6\x80\xcc\x03\x86\x9b\xbe\xd0\x05FZK\xb0K\xa3\x94\xf1J\x05\x037\x00^\xba\"V\xb3TU\xe3Y\xfc)\xe5\xb2\x0f4=\x97\xeb\xd0+\x93\xce|\xa6\xf7V\x1dF]E|\xda5\xa1;I\xb3\xd6\xf7\x92\xb1\x1a\x04=@XY\xbc$/axMO\xdaU\xfd\xa9~#\xe1\x18\xaf\xb1\xa6\xd7\x87eJ\xa3\x8fg\x10\xa6\xdd\x8a\xa1\x15\xddy\xe6\x9eh\x04\xc2\x1a\x17\x87b\xaa\x0aO\"\x90\x82\xfeVg\xec\xec]X\xbdqN~_o\xee\xb4\x13\x19~\xc5\x03\xce\xf9\xd3X\xa2-\xfb\xeb=o\xef{\xfe*4\x93\xa8u\xcbK\x7f\xa9U\x89\x93\x0d0%G`\x89Kq*\xb1\xc3*9\x85\x9fL%dt\x81\xd8\xa2\x18\xdenFs\xd5\xcf\xe3\x8e\xa0\x04!\x96\x98\x878F\xfbR\x89\x09\xf7\x1e\x9e\xb1S\xf1-di\xb4\xf3\x1d\x0e\x7f6\x9f|\xe7\x85w+\xa9$\xdc\x1c\xd1\xd1:\xe6\xb4\xd3L\xf7L\xe0y>%\xa4D\xb7\xff\xc2\x14Dt\x1c\xbe\x06#\xb9\xb4X\xadw\xd4\xa3|\xc3M\x98\x8eV\xf6\x082PIM\x01\xabye\xfd\x8a\xf2\x8c\xe1\xdb\x0b(\xbe\xdeJ:\xccg\xf0\xcev\xb6\x9e\xc9\xd5`>\xbd\x8d\x9c\x0dA\xe6#P\xd7wq\xca\xd6\x97U\x1a\x03\x0a\x07\xcfl\xa7\x8a\xacb\x92\xa0\xe1\xd6/\x01\x07hg\xd5z'3\x1c\x8e\x0b$\xc0_\xc8)\x81\xca\xa0\xb0;\x05s\x9c\xc4\xd9P\xe4\xc6\xaf\xf3\xd2\xd2\x11\x10{\x86\xdd%R\x049\xdcj!S\xae\x19\x8a2\xfc\xd3KI\xf2\x98Y|\xb1.\x82\xfbc\xb6^\x88-K\xf0Ht\x08K(\xa2\x0b\xb1\xe8d\x1e\xea\x1et\xcd\xa7t*6\xe4U\x1c\x1a\x12\xb0\x90\xf0]\x07\x83{\x0a\x83=N\x95\xad\x99\xf8\xa3\x17'\x90\xc8\xe4a}\xf4A\x0af\xc0\xa2$\xef\xe3\x84\xd1\x0d\xb0@\x9bc\x98\xc7\xc4A\xbc\xe2F\xe6#K2\x83\x9b\xbcc\x83\xed\xbb\x099\xa9\xb5\xa2'k@J\x1c\xf7p\xccP`/Z=\xc50\x8a'C\xa0\x029\xef,\xc4\x0b\xcc\xb0\xb7\x9bP\xd9\x8cR7Y\xde`\x94\x0eR\x82\xda,E\x16\xa2s\xa8\x0e\xf3)\xddH\x007\xf3\xd7!u\x0frWB\x02\x0dT\xa2_(9\xdc=\x1a\xbb/\x12h\xe0\x83\xb6BW\x90\xeb\x00\x1b\xb3\"\x01<\"\xa5\x83-%\xb9=\xd6\x16'\"\xc80\x1d\xa1\xcc\x80e\x83\x8egz\x84\x18\x04\xdb\xcb*\xfc\xb6\xd8}J\x80\xe7\xae-$\x18hZ\xd0\x9fQR\x02\xb6*\x91q:\xd3m\xb4\\d\xb7a\x0c89\xb1\x0fT\xb8\xd4\xfc\xffR\xe6~\x8c\x9b\xe2w\x01\x19\xfe\x93\x9e|52\xa5p\x02!\xe2\x89\xee\xf0Zj\xaf_\x9e\x10\x8aNZ\xfc\x89)\x8d\x04\xff\xa5\xa1\xc2\xd7\x11p\xd5\x87\xa0\x05\x92\xb8a~?@>JX\x8d\x09\x9cr|\xc6$\x0c\x0d\xeb\x8e\xfdpj@<\x85\xa7a`\xb5\x08]\xfa\\\xd4d\x9bQ\xdf\xc2\xa5\x94\xd4\xd7\xaa\x17\x90vWU\x1f\xea7\xb0\x1flT\xfc\x91\x9f_\x83\xea6LJ\xef\xa6\x91\x1b \x0a86\x1d$\xdc\xfa\xc1ZE-\xf3\x0b\xb4\x98J\xe0\x8bI-\xc3\x0a='n\xdd\x1e>9\xd3o\xaco\x03\x84\xa1+,\xbd\x8d\x9b\"\x19\x8c\x04\xd4\x0c\x1b'\xdcpl\xfa\x0b\xeec\x88\xd3\xc3\x93\xda\xed)\xfchh\xd8\x11\xdaxO\x94(U\"\xff\x86\xa7\xf4=HTw'vv\xfb\x81\xca\x12g\xc1U\x9e\xdb\xcc\xb9\xd9=\xdc\xe0\xd6\xce\xdd\x04%1\x8b9t\xc2\x13_t\xdd\x14^\x821'\xe4\xa1\x02\xd5i<\xdc/(\xb7\xec2\xf4 ?|`\x82s\xa8\x89\xdd\xbeP\x83\xc2\xbb\xee\x05a\x15\x8c\x0c\x0e\x12nf\x97:\xbeS\xc7\x1a\xa7\xce\xe1\x09\xeb\x02b\xf9-:,=\x16\xd7\xde\x96\xc7\x084\x0dF\xb8\x007y\x14\xe1b\x91j\xf8NV\x80/\xd0\x8f\x8a\xcd\xaf\x0f:\x98\x91\xf6p\x9a\x8a\xbd\x9f!\x0f\xef\x87\xf8\xa4\x99p\xd3\xbe%.\xa8\x1f\xb9e\xa4\xeb\x1d\xe9\\\x88f\xa1E\x0f\xc0\xb1\xb1\x9a\xd7H\xa1\xf0\x95\xdc \x86\x1f\x0f\x84}\x1dKMF\xd9\xa6\x95\xc8Y\xdb\x19$\xe0C\x9f\xd6\xdd\x1d!\xc7\"N\x00Q\xbe\x11\xdc\x86\xc2\x03\x14\x19X\xca\x86\xe7)'\xc5\xf5\x94;\x18\xda3.\xd1\xfdo\x7f\xd2]\xc9*\x83\xee\x89\xfa=C\xf4\x14\x08\xcc\xa2\xd2h>.\x0c\xfbg\x19\x926\xbd\x1ef/\xc1\xdc3\xedlD2\xcb\xc0k\xd6\xda\x1ez\xac\x99lU\xf6\xd2\xc0\xaa\x1f\x88\x9bmA-\x90 *3\x0d\xdb\xe3]\xf7\xa3\xef\x8b\xb4Ci\xe20E\xbe+:sY\x16\xec%\x8c|s\x02=F=\x06\xeb\xa0\x9dD\xf6\x96\x0ab\xc9<*\x89!\xf0\xbdD&A\xf3C\xeaFc\xa2\xf9b\xa0\xc0\x1f-y\xd2fP\x81\xbdC\xf5\x1e\xb4\xed\xe8\x86\xa2e\x97\xfb\xab0\xad\xa1\xe9\xbb\xec\xc6-\x07\xd4\xa3\xdb'YcZ\xd6\xf1\x09$)\xbc\x99C\x8dS S\x96\x8ce\xd6\x8c\xd6\x97\xb0J^\xc14\xe9.\x06\xfd\xfdc\xc1p\xdf\xf9.\xa9\x02+t\x91.\x89c\xfb\xc1\xa3\xd4\xa1J\xfb\xa9\x99\xdd9\x92\x0dF\xda\xf8\xdb\xe8\xeb\xe6\x89YJ\xbf\x19\xbfL\xf5\x99\x9b\x13v\xe8h\x08/!\\0\xa1d\x1c\xca7a\x9f\x1a\xa1\x81\x91a+\x8b}\xcd\xe6\x8b\xcc\xe7\xdc\xb7\xf1\x84\x9b\xd3y\xaf`N\xf1\x12b8\xba\xedkx\xcfk\x82,\xc3\x9f\xa2gR&|\x16J\x92\xe5\xf4\x19\xf9\xc2\xf6\xb7\xfdH\x91\x8b\xcd\xba}\xd9\xf3\xb1\x9eHm\xb8\xbf\xe3?\x82\xeb\xb6\x8f[2}r\xff\xe8\xd2\xa5\x02\xf2\x86t9?q%RF\xc1\x02t\xd7\xf7\xf6w\x9d]\x03\x9e\xe5M\\g\xb60\x8a\x99\xb5{Y7\x15\x0d\xb3j\xdd\x05\xaf#\xb6\xa4_pQa\x0c\x90\x09\xdd\x9b\xf9!\x02E\xe2\x81\xa7\xe5\x98g\xec\xd3\xf41\x05{\xed\xc8B\xe5\x86\xa4\x06K\xfb\x1cB\x0b\\\xe5\x1bU\xd6l\xec\x87\x1e&\x9b\xbe\x0c\x808\xe4\x95\xeav\xd9\xb9\xbb7\xe7U\x11P\xec\x86&\"\xe5P\x18\xa8\x1e\xfb\xfa\x0d}M\x0a\xb5\xdeK!j\xdf\xd1\x1a\xb1\xc2,\xa01\xc2{\xdfm\xd8\x9d\xdbF4D5\x93\"\x158$\x9b;7\xb2G\x09\xb7\xba\xfd0\xc4\x0c\xdfS\xd6\xa7\xd0\xa5.\xaaT\xcb\xf2xO}\xa3\xfd\xc3^o\xf6\x06,\xe2e\x1b\xda8\x85\xb3+0\x17\x80k!\xa7\xdc\xd9\x03\xf6\xa2\xcf\x83i^&\xc9|\x05dN*\xbc`\x8d\xf9*\x1a\xaf\x10\x94\xe5x\xf4<\x8a>\x0fZ\x92\xf0\xf3\xe7\xdfi\xe8\x85P2\x1b/\xf2\xeb\x14\x8e' \x14\xe5\xacu\xdes+)\x9br\x0a/E\x10\xcceV_\xf5_\xf4\xc6\x0f\xa8F\x06\xecoQ\x95\xf1\xceg\xd6:\x88\x14Y\x9cF]w\x94aK\x0a\xd9\xb1\x1fzA\xf2ZS\x0bh\xf1z\xc3e\xf1\xdd\x07\xbe\x90\xb0yl\x1e\x13\xb8\xb2\x8d\xdc\xe6\x83\x1d\xcdb\x8f\xf5\xb3\x89nl\xe9\xf2\xbb\xe8\xf9\xa6\x83\x14\xe2\xa18 \xbaF\x99S\x97\xb1\xb5!u\x9b\x0dp0a\xad\x91\xdb\xe0\x0d\x0a\xf0\xf4\xd9\xdc{)\xa1\x7f|M\x81\x0a,\xdb\x18\x04\xf9#XY\x157\xa8\xf2\x8f\xe1,\\\x05\xab\xa3\xf4+\x7f\xec\xec\xd0>\xd8\x7f\xd6\xe1\xe9\x0b@;8\xc8\x0e\x0d\xcaD\xdd\x0a\x00<\x1d2\x80Y\x11\x9cqH\x12\xf3G\xac\xc9\xa0\x0d\x0dF\xf3\x9a\x96\xb2&\xb1.!\x12Z1\xc3\xbb\xb0\xe1\xcf\xb2\xee\x0e\x1f=\xb0\xdc\xb8\xc4t\xd8\xd1\xbd\xbd\xe9\xb5\xee\x0e_$\x06\xb8=jq\x03\xc0\xb4\xf9\x9az\xb7\xaf\x00o\xd3\xf9 C\x8by\xc0(\x0f\x80%\x0e\xd5\x7f\x18y\xce\x08\x9e\x9bV9\xd1\x04\x8b-\xcf\x129\xa1\x10\x93\x09X\x0d{\xcc\x16\x11\xabHE\x1a\xf5+Q\x11\xe3f\xbf\x82\x9f\xe9\x81W<\xe3\x0dJ\xb7AZ\xca\xa5\xad\xfd\xafz\x01s(d\xef\x8d\x8e\xe5D\x16!\xde\xf5\"\xb4;\xe48\xf0A\x02b$_\xe8,\xe6{\xe8\xc8\x07\x91\x01$R)\x98\x89`\x89jU2w5i.\x1e\x93\x9d?\xdc6g\x81FC\x7f\xa7\xf1\x8e\xd0\xa3\x02\xeb\x1e\xe5\x90\xe1\xae\xbd\x11s\x87\x9c\xe2d\x80\x965S\xac\xc4\xe0\x81\xe7\x9f\x8c5:\xdc\xf5\xe3\xcb\x949\x01\xfb\x0b\xf9\xdd\x8f\x85K4\x15\x08I\xb5\x097i\x88<\x14<\xf5\xc1\xa7\x18\xc5U\xa9\xf9\xde\x96H\x1b\x13\x9e\xc2o\x1a\xae\xefD\xe1\xf9{\xf4\xd1\x15B\xb0\x85er\x93\x15\xbd\xdf\x07I\x0f\x8e\xd6\x1b\xbc\xcd\xb1@\xd9\xae\xdf$Z\x8d\xb7\xfa0Eq\x0d\xf2sZ\xab\xc4\x87I\x17\xadwS\xb1\xd0do\xd5M\xb4\x0c4E-\xb79\xecL\xcb\xebF\x98\xfa\x06\xca%'\x96+(\xac\xdbxHf\xa0\x8a\x90\x06\xe2\xeaQ\xd5\xc2\xca\xf0Be\xe1\x1f\xa8\xcc3S\xf5\x8b\xe8aZ\xa0\x81\xa2\xf0$(\xe71>\x1e\xd6\x8c\x0a\xdb\x8f\x82\x96^\xcb\xa7\xd1\xb1~D]`c\xad-\xcbg\x87h\xb6\x16\x91u\xa4\xd6_\x9f=g\xaa+q&6{3\x03+\xdd\xb3S\xfc\xc50\xd3\x06\xd3L\x9br=\x00\xeb3$\x15\x00\x83f[i\x96.I\x02\xe7\x0a\xb9\xe4S\xa0\x81/+a\xe49\x96\xe4\xc8\xff\xb1W\xec4|\xb1\x95r\xa3\x0c\xeaW\xb8\xf3\xa0\xc4\x01\x8b\x0b\x13}3\xa4k\xe9I\x8b\xb2\xfe\xe7\x06La\xc3\x17\xdbV\x00\x89j\xe6Q\xd3\xf9Q\x0f\xe55G\xbd\x0e\xe3%D\x81\xc3T4\xa3XjM\xea}\xd7\xe1o\x02\xe9\xb0!\x05\xc8\x0b>\xa0\x96LC\xb7\x1e\x12\xcd\xf8i6\xb7\xe5\xbc\xf4\xd9H\x9acz\x94>c\x9c\xa7\x03\x87\xa8\xf8Y*[J\xc9o\xe9hn\xb6?\xe4^.\xb4g>;H\xa9}\x84\x8d@\xaa\xde\xf2\xe9\xe0;Q\x8ch\x0e<fe.\x11`Q\x88\xef\x09]\xd0\xc9\xbc\xf0b?\xf1@\xe8\x09/\xa9\x01\xbfZ;!\xba\x11m\x9e\xaa\x16\xce\x07\xa0\x8c77\x0d\x92\xf4\xa0J\xaa\xae{0\x0f<xy\xcc\x17\xdd\x85}\"s\xbd\x8e\xb2\xf7RU\xfb'=\x8e\x90\xc3\x14\x1e\xc3\x04\x08W\x88^@\x08\xf5\x91\xa8\x83\xc7\xb7G\x8c\xdb-\xa6\xc2\x9c\xbaW2\xce\xdf\xb2Z\x19\x0e\xda\x95P\x94\x9bK\xd0&\xf1\x09Q;\xa1\xfc\xa0\xa5\xe3\x8e\x0a\x1f7\xbd\xbc\xc2\xe1\xd0h\xe8\xa9X\x08zst\xc9\xe3.\x1c @\x90\xdf\\\xb0\xd2\xcbR\xea\xb0M\x89y\x06w\xa0*/\xcd\x92F\x19\xb7i-f\xbd\xe7 p\xd6\xcd\xab4L\xe6\x15\xcdc\xfd\x16\x06\x8a\x85[K\xb7T/B\xadw\xb0m\x8d\x11V\xe1\xa7\xb9\xd4\x08\x0f\xf9\xfam\x8f\xc7\xddr\x16~X\xc9\x84\x15Y\xc4\xa4\xa4\xa1\x19w\xe5\x01\x80ox\xfd\xff\x07=\xe5\xde\x87\xc3\xed\x8c\x1d\x937\xc7\x95g\x846I\xfeT\x80\xde\xb5h\x95\xc0YGS^Y:\xb2\x1e\x96k\xb8\xe3Y\x07\xc8\x86\x8c\x9b\x0b~N\x1a$\x0d\xeeI\xf2\xf5\xe1\x93\xf8\xe8ig\xd7\xd3\x12,\xc3\xe1\xae\x05\x1a\x80\x08R\x05\x1d\xa8IKY\x12\xd6\xcf\xa9\x08\xc7\xc1{\x18\xe3W\xa6\xe56\xe9H3\xc7\x8a0\x8f\x92%\xb6\xf3\x15\xf6,\x0aU\xc7\xde\x0e\x915:\xdc\xacw\xa1\xaa\xe4\xf8 \x9c\xd2\xb0-\xe9Tc\xe8\x91DT\x12\x9b-F\xde0&\xb1r:)'`\xbe&Iz\xf0\x83Zv+J\xb2\x94\x80mUj\x13\xd4\xcaE]\x06\x89\x04\xeaM\xa8z\x01\x9dt\xfc\x81\xabX\xabi\x98\x99\xc9\x022\x0f\xc2i\x95\xc3\x82\xea\xd5\xdd\x93|\xd0\x19\xcd\x9a\xb3)9\x90\x9ez\x14\xe5\xd9Gw\x14\xfb\xe8t\x1d\x98t\xf1\x8e\xc6J\"q\xdax\xdcR\xd2q\x18\x91\xe6d\xfa\xa1\xf7[S\x03\x90\x9d\xe7.}>\xacl\x97\x9b8\x93\xaf\xffs1\xa0F\xeeO(\x9a\xcf\xa0q\x8eRr\xeaC4\xe8\xd7\xcd\x9c\x03\x0b\x89-/4L\xfe<=\xd3\xa9\xdeuE\xc9\x1ah#\x9bC\xca\xef\xdc\xb8v\xd8@V\x86\xb2\xbbf\xc8\x82\x0bB}\x14\xc9\xf8\xce\xe8\x94\xbc%\xa2do\x87{\xd9\xf4V<\x05\xff\x9ek\xc4*R7\xa8\x8b\x0a_e\x8e\xf0m\xa0#6\x02\x81\x85\x18\x9c\xfa\x03z\x10\xfc68\x88\x19\x12\xdd\xceN\xd1\xfct\x0b\x9b\x82\xf0\xbd\x1bN>\x87/\x0e\xea\xb7\xfc\xfdD\xcbr\xa2r\xaf\xb2U\"\xb7\xb2\xbc\x1b\xca\xdaZr\xdb\xf3\xec\xed@/\xf6\x06 \"Ut\xe0\xdaDH6\x1d\xba\x9dn$w8\x9e\xd85\x90\xf7\xb9\x89\xb8\x02&GU\xbaF\x0ePP\xb0C\xa3k\x07\xd0\xb3\x88\xdfI\x09\xad\xbd\x1d\xc9\xee\xf3+\xc1\xcf\x8a\x8fl\xb6\xdbK\xbb\xdd\x9a\xa3xW\xe5\x0a\xf2%\xefM\x86\xfe%\x92\x8b\x17\xd8\x9ax\xda+\xf32\"i\xa4\xa3\xfe\x04\xc3z\x81dD&\xae\x88\xd2\xc1i\x9a\x1d$\xf2\x8a(\x8a\x0d,\x98\x14j\xb6\x08\xa0i\x1d\xc3\x0dD\xb3\x1e\xb1\x9a,\x93q\x91\xd0\xef\xd0\"\x8a\xd6l`Z\xde\x92ES\xa65v\xca,\x19\xaec;\xd9\xa5L\xf4jNe\xb1XK\xbbJ\xe8\xa0\xac\\3\xac\x1c\x1c\xddU\x062\xb1\xe1nG\x1b\xd0)\x93y\x864\xd8\x04\xbc\xae\x1f\x88j\x8c\xf6 \xb5\xef.3\xd5/\xbf\x0a\xab\x89oy\xdf\xcbRd',!\x118\xba\x05\xcc\xfe%\x96\x809\x01\xe3\x00\xcc\x94\x8a\x9c8^\x0a;#\xc5\xb3\xcd\xc8\x86ox\xd8cF\xb4\xc2\xdfG\xc0\xb9-:gC\xa31\xdc\xf9\xd8p\xfd\x0c_x\x80F<\xb1F\xe0/\x8e\x9b\xb2\xf5\xc9\x94*\xc1\x8a\xfd4\xcc<tI\x85.\x11\xd8\x02M&=\xd5\xdd\x9d\xdc\x87\x9fZ\xc6\xaa\x8d\x91\"\x88\x9c\xce#\xe4c| \xee\x06\xe3\x84\x1c\xebV\x16D\x10\x8a\xbe\xbb\x13\xb0\x00\xc1.\x8a\x88\x9b\x09y\xd7\xb7\x1bx\xe0\xdd\xb3\x84\xb1t\xac\xd5\xf8\xaeP\x05\xaf\xfe\xdeq/\x05\x16\xef\x0a\x8a:\x05<X\xb2S\xeb\xefb\xd6`\xfd\xe7Aj\x90q\x1e\xd0\x81\xfff\x00\xa8Xj\xbct\x08\xd1\xc6B\x9f_\xc8?+\xd8\xcbY\x1f\"nJQ\x88\xd0y\xed\xff*jB\x9d\x9c\x0bIW9\xed\x19\xad\x05-/$\xbf\x80Ht\xa1/\x1bO\x05-|\x00S\xf5R4\xa0\xcb\xc4\x91\x81r\xc5\x05uf{\xfb\xcfzp\x80\xa7\xa3`\x96nb\x9d7u\x9cpT;|1\xac\xd1\xeakT\xd9z\x8e\x08\xe8\x1d\x91\x8f\x89\x09\xd9{\xe2V\x86;?\x8fq2J3\x8a\xa5\x87\x8d\xb8\xe1\xc4B\xa8\xe1\x9dC7L\xf0\xeeK\xe2\xf5\x9c\xbb\xb2\x89\x8f\xdb4\xca9\x8c{dI\xb9\xb4\x0b#\xdfMK{\x0c\xfa\xacS\x80\x19$R2^\x1b\x0b\xbc\x018c\xcd\x09\xfe\xcet\xff(\x85\xc0\xe3\x0e\x85@\xc7~\xe2\xc3\x02eS\xb4p\xb4\xdd\xc8jfWY\xdf\x91\xdbkYP}\x0c\x0eb\xc3d\xfe\x9eb;\x92\xe2QT\x14\x02]\x82\x8a=h`'\xdc+D\x06$\x81'a(A\xdf\x88I\xb4h\xe8\xd7\xdf\xe8\xbfn\xf6\x8d~WT\x848\xce\x08\xdb\x0b?+\x82-n\x05|_Qs \x1d\xa9\x08\x89\x9cl\x03\xda\xc3\xfe\xa1\xe8\xd0z\x8b\xa5\xca<\x95" +
+	"\x1e\xef\xad~\x9d\xb9j\xae\xa6\xcd\xcc?\xf1\xa4\xeb{8\x1e\x87\xfd\x1c\x0f\x00\x8cW1\xbf\xab\xfa\xf3\xd1\x81\x8d\x1al\x85\x8e\x11o@\x85\xf1\x07m\x9d\xda\x85\xc9t\xd0j,\xcb\xa5z\xcd\xc7Y\xbct\xf4R\x1d8\x99\x1fM\x9d\xa6\xfeU\x85\xad\x7f\x16~\xe9#\x95\xa2\xb7\x86O\x97\x99K\xd0\xbc\xc1\x1a\x13#\xc6kbFwaUL1T\x0dl)@^m\xbe\xd9\x95\x121 \xf2\x1e\xd6S\x1a\xb3\xe0\xe4\\C%hN<\xd5\x95\xf1\xc3H\x1b\xb5\x1a\xfc\xb4\x93<\xa1\xecZs\x1c\xfd\xda\x09\xfb\xf5\xaa\xcc\xcd\xa1\xfe\xb9\x97\xe4!^\x9f\x9c\x1eq\x097a\xdc$\x8e\xfa=\xa5\"<+d\xf4\x18\xfbq\xbdl\xb9<\x00\xec\xce\x85k\x0c\xc8[+\x9bSie\xdec\xf7\xc9D\xc78\xf0t\x00w'w\x92\x00u\x82\xc6\xa1\xbe\xe2\xab+\xd4f\x89\xee(rf\xb1\xb4\xe7\x1d\xa9/\xcc\x1c\xd7\x1c\xe2\xf5k\xd5\x12\x8d\xd6\xea\x8e\x14\xfd\xc6iz[\xa5\x9d\x1f\xc2;\x0aj+\xfc\xe0\x1d\xa3|\xb8\xbc-j\x86\x19[\x1d~\xcb\xa5\xa1\xa2\xe5\xe0\x99\xbb\xf3\xfb\xc8\xec\x19s\xfew\x9e3\x9b\xe5d\x09\x91LB&\xfa#7L\xaa\x06\xcd8\xf6\xc4\xb0\x95z\xecA\xd2zX\xf5sg\x04\xf3\xbfB3\xe8\xc4P\x99QIN=bC5\x92W/\xa5x\xeb\x1a\x08)\x18\xc2o\x01\xf20J\xb3\x8d\x84\xdd\x99\x1c\xdf\xc7\x19\x06\x186\xc0\xef\xf8\xd9R\x07\x0eKAI\xfb\xbd\x90\xc1\x152\xe3\xfadn\xd3]W\xedq\xaa7\x9c\x17\x0fF\xbde\x0f\x86}\x91%[ \x05 \x04\xe6\xd4\xe6.?Lm\x87\xfa\xd8\xf4ux\x17\xe6\xa3\xc8e\xf1\xc2\xfb\x9a\x0f\xed}\xcd\xc7\xfa\xbe\xee\x85\x859\xe6\xe0\x19\xc6\x0b\x1b]\xff\xa8\xb9\x8aU\x88\xe1\xb4\xae\x18:\x06a\xa5H\xea$$\x8f\xde\x06\xcb|6PX\x82\xb3>\xa2\x04\xcf\xe2\xc3\xdc\xc6\x8a&\xa3\x06\xd7\xe3\x15Vr\xbd\x8c\x11\xc3G!s\xab>\xe5PUc\xae\x96\x9aq\xb3\x99bw-\x1a\x9f%@G\x05H\xce\x81\xfe\x9fP\x14S@\xbf\xafP:\x9fw\xa5\xea6\xabx\xf7f\xf1\xe7\xa2\x06]\xf0:\xd1\xe7\xc5Gf\xaf\x85t\xd0r#\x8f?\x91\x03I\x87\xfa\x9bxH8\xc7\xd9w\xd8\xc9\x18O\xdf\xa4\xddX\x83\xd9\x19\xea\x97\xfc\x99L\x07\x16y\xc0!\xeb:@\x9f\x8b\x1fO\x88\x03P\x13n}\xc7\xbf\xd7.e\xb0\xf0S\x08\xc9\xceT\xe4\xb6\xd9z\x06\xd7g\xce\x88\xbeX\xf6\xb7\xd2EP\xcd{p\x96Z\x9f\xe8k\xc2\x9e\xcc\"\xaa1\x88\x04(\x7fJ\xe8\xff\x1e\x81\xd8\xf6\x0b\xbf\x8f4[XFg\xa9\x0cb6\xb2*\x89\x0e\xb6\xec=7\xdd\xff\xd0\xaf\x11V\xcf\x8f\xbc\x94X>U8\x0d\xaa\xe8\xed,\xa7\x93\x84?=\x89\xef\xf8`X\xa1\xbc\x8cj\x92AU\x95\xceA\x1a:\xd0\xa2\xec@\xd1\x11\x04^e\xe8Ny\xcf\x9c\x9a\xadC5\xd5\xe76\x0f\xd6\x1c}e;X\xe2\x17\xa2\xefrA\xae\xcd\xfag\x84\xda/\xea\xa5\xfaY\"\xa3\x02P\xe7\xb0fW\x9aO\xbf\xb0\xde\xf6{\xa8[ _\xe8z\x80\xc3\xa6\x994\xa5y!i\x94\xafi'-\xd2\x97~\xea+D\xae\xd9\x12&u,\x08\x19\xa7LE}\x1a\x19X\x17\xaa}%\xf7\xf9\x1fc\x1a\xbe\xcf%aJ\x9bi\xeb\x85\xf5\xb76\xb8\xfcwax1W;\xcd|\x8bx\x1d\x1f\xf1\x03\xa0\x031R\xb3\xe6\x07p\x10\xb7\xbc\xe2\x86B\xc4\xea\xd7\x95\x10\x18\x02\x8e\xdf\x07^\x04lt+/6\x0do\xbc\xa2\x1a_ej\xa7\x01R\x13K.\x18;\x8b[~\x00f\xbd9\xc7\x9f)G\x0d\xd7T~\x84M\xb1r\xfc\x0e\xe0k\x96\xec\x97\xd8\\RQ\xd3P\x95\xb4\x14\xb9\x1bJG#j\xc3\x84W\x0fK\x07\x0f\x89B6s\x86\xb9\x1fY-{\xb5\x91\xec\xfe\x83\xc0\x7f!\x05\x8c\\9\x80\xf5(RJ\xe3e\xe2?v\xd4\xeb\xb2~\xd5&\xbdK\xdb[\xa9\xf6&\xc1\xafN\xb5p\x19\x09\x95\x9a\xec\x90\xcc\x88\xbc\xe3\xe6\xd3\xfa\xc6\x8cZ\xda#\x91\xf8O\x13\xa1\xbe&O&S<\xa03O\x10\x1d\xff\x11\xb3K\x1f\xa3\x9b\x9b\x0b\xf9`\x06e~Gc\xf7\xc4WOI1d\x83\x94\xd3\xb6\x15!\x9c+\xde\xfb\x07XO\xa3\xfc\xfe\xc0\xa5|-\xbb\xb4\x82\xc9\x80\xd2\xe0M\xda]\xa1^}f\xe3\xd9\x0d^\xdf\xc9\x9a\xb0\xec\xb8\xc9\x9c\x18rm\x15\x8a\xf5\xb0[\xa6\x09\x0b\xb6\xb9\xe2\x14\x9f\x0fdw\x11\xf6Yz\x85\xc2\\!:p\xa7\xd2\x05\x85V*\x93\xf9\xbahZY\xf3\xff6\xbee\xa21\xc8]Fs\xa6\x94\x13\x1cJ\\WQ\xb0}\x05=\x0c\xf6\xe9#\x1fc\xdfI\xdff\x15\xbdhF\xb1\xdd\x04\x9f+&\x91\x98\xf1\xbf^\xdaY\x02[\x0ah\xe6b\x13\xd1!\x95\x0a\x97\xefv\x02\xdf\xbc\x10u\xf2L\xf6\xc3C\xd8}G}>X%\x15\xa7\xde#\x93\x7f\xa9\x96\x90\xa2\xe5J\xf0\xda\xaa\x1d\x82\xa6D)\x86\x91\xa2Y\x8a\xde\x86\x91\x09\xf8I\xa3\xa8\x95m\xd7\xc4\x8c\xbd\xcbu\xa3- \xb5D#\x87O\x80aj\x0f\x19IK:\xc1\xbd\xbb*q$\xf6\xff\x94\xd4\x06[\xc8\\\\\xd6\xc1j \xde^\\\xa9\"m\x9b\xf5\x1b\x02\xd5U\\ci\xf8V\x11|\x08\xe2E<\xe3\xf4\x07\\\xd3{K\x04(\xd7\x10\x11\x12\x88X\x7f\x00mz\xb2\x19\x8b\xda\xb3O\xe1\x99B\xea\xf4\x96\x80\xc1\xeb\x19>\xc3d\xe1\x11\xe4Bq\xf1jE\xe2sO\xeb.~\xa0z\xc8D\xe0JP\xca\x08zB5\xdf\x0b\x07\xcf\xfe\x91\xd0)\xea\x1b3\xb2\x8b\xeb\x073\xbdj\xe4\xa1\xbc_\xff\x88\xca&\xb8\xe0\x06\xbaGv\x02X\x82;\x94\xf5\x9a\xc2{\x9c\x0d\x84\x04\xe5\x99\xb4O4\xc1\xe7,\x90p\xcb\x8e\xa2J\xedWv5d\xcb:\xc6I+\xfd\xce!\xa2\xb3\x7f\xc5\x1dp1U\x89\xcd\xc5w\x99b\xf5B#\xc3$\xe7N\xdf\x03\\v:\xa1\xc62\xe5-\xa0\xe8\xf5Fn{\x0f\x11\x10;\x81F\x00\xb4\xa4\xe2\xfc\x1b\xeaT*\xf4\xde\x08\xafDz\x1dL\x9f\x1d\xdan\xe2\x8e BV\xf5;F\xea}D\xf1>f\xc6\xc1\xda\x02\x11\x1e\xf4\x91\xefZ\x09\x07\xffL\xac\xe3%(\\\x9e\xe83w\x89_W\x09UA<\x13&\x80<(\xcd(\xea\xd5\xa8\x82\x95>\"\x91lv,\x9b1\x9d]\xf4\xbdY\xbd\xe4\xc3\x93\xda\x0b\xc8\x02A\x0a\xe15\x92\xcb\xa13e\xba\x16\x97,\xac@\xdd\x83\xa5\x82!Q\xdd\x8e\xf9\xb5\xdb\x08\x03\xcb\x1e*c\xb0\x16#\xc7\xcb\xdbv\xbf\xcd\xb0\x9cP\x8c\x02\x9c7\x15#\xedp\x8f\xbd\x05\xea\xc5\"\xf1|\xfa\xb8\xbe3b\xa1(Q\xf1\xc9\xd9\xd0\xe8\xbcp<)\x1b\xd9\xce\xfb\xa8\x94e\xfcN+\xcf\x98p\x90\x8bR\xd41\xd4LWZ\x93\xff\x08\x81#dL\x15\xa2\x0a\x8dM$\xccY_\xa9\xfe6\"\x93&$\xf3\xd5\xcdq\"\xf1\xd0s\xe9\xb6\xcf\x09\xf0\x1a\x06\xe7{.\x90\xa3f\xcc\xad\x93m#\xf4\x16n\xf4\x82\xcaX\xa8\xa6\xa4\x86X\x06*I\xb9\x1c\x84\xb4\x1fdU\x8b\x7f\xdaG\xd8\x8d\xb3\x11\xc7\x90m\x1ccKRt\x89\xc8@\xc6\xb1]\xe1\xb9W\x062\xd6x\x98\x9ds\x16\xef\x9e\xde\x82\x9c\x9dk\xc9&\x0f/\x1c\x8fJ:G\xba\xbf\x16^\xea\x86\xab\xa3\xf6I\xcf,\x01\x16i\x15\xc5\xab\x14\x86\x00+!\x99G\xb1\xc5\x02[E\x9d\x89o\x8e\xc1\xf5\x84\xcdc\x9a\x0ba\x03q\xc5\x82\xb6\xfe\x83\xec\x826\x15s\xe5\xb3Q\xe9B\xa89\xc9\xa0\x8e\x0f\xf2\xf3\x1e\xd2y\x09\x91F,+\xe5k\x9amG\x92\xe4D|\x8cgi\xcb\x85\xd0/\xfa\x0e\xec\xf6\x05/9<\xdc\xcf\x06\xec\xebshX\x84\xdfP\xb1^\xec\x0f\xa9a7\xabo\xe4G\xedx7\x9d\xf2nF4c\x9d\xaaY\x16|\xa2(\xf4&\xa4\x17]>v\xc0\xf2\x99%\x06\x93\xbcCZ\xc1\x1er\x7f\x8fZ\x11oc\xfd\xaa\x99\xe9]\x18\x8eU\xd1\xd7\x16\x0bhR{\xc3\xd2\x06\x99\xaf\xcb\xbd\x01\xd6z\x05\x84,Hn*E\xed)\xa0\x0e#s\x0b\xdb\x1a\x0e\xff\xb0\x9a\xeak\xda*1U\x80\x9c\xb3\xf2\x1c\x04\xed\xf7\"\xb8\x11\x95T8/\x92Y\x8a\xf9\xc7\xf0i\xa0\xb1\xaf\x17\xac\x0e\xe4\xc8\xb6C\xfd\x1a\xa8\x02G\xa4\x84\xb9\x9e\x9f\x85j\x11ow\x9e\xdf\x092\x7fZ\xaf\x98\xd0p~g\xa2\x88;\xc6\xdd.D`r\x82\xae\xd2\x1b\xc8\xc9.\xb4tIRj\xe9\xa1\xd7\x18\x06\x13]\xaa\xbc\xb7/\xa9~\x8b\x1c\xd5\xaf\x94\xcb\xc8\x8b\x7f?p3\x86\x08\x9f\xbd,\xf7\x06\xef\xb3\x9b8+\xe2\x05\x14\xf1\"w<C?\xc0\x82\x839\x9bx\xaa\xff\x88\xcb`\xe24\xab\xc0<\x06\xb7\xe4>>\xb7ak\xfc\xc0yNA*\xb6\xd1e\x90\xdag,\x05\x05\x1fw)\xdd_qN\x1b\xaf\x19\x83\x03\x99\xe6!\x85\x80\x95jA\xf4\xeb\x90|\xaf\\x\x07\xc7\x01\x94*{\xa8D\xc2\xe5\xdb3F\x1b$\x87\x1c\x1fZ\x04\xcd\x19\x0f\xfa\xf9\xa4\x02v\xf6m\xe3`\xda\xae\xa0\xc9\x86r-\xec\xf0g#\x7f*D\xce?h}Sc\xb7\x93\x16\xa9\x1cg\x92\x8dL\xda[r\xb9a$i\xad!3\xb5i#\xf7\x81e\xbfD\xb7\xef\x16\xab\x89\xad\x15\x1c\xc2\xd3\xd2\x18\xc5?c`wCi\xc6\x0cK\x9e%\xde\xef\x1a\xa5MD7\xb4t\xa6-\xa4\x98\x8e`\x8f\x9f%\xe9z\xb2\x0c\xf2\xeb\xdf\xad\xcf\x8b\x18\xb6t\xeb\xe0\xbd0,8a[\xc8\x90Z\x09=`\xf6\xfa\x0c\x05v\x88\x12R\xf7s+\xa1\x99$\xd2\xd74\x97\xebG\x1c9i\x1b\x0f\xff\x98Ln\x89\x19&\"*nmg7L\xac\x9d\xed\xecN\xa7\xa6C&\xac\x84\x87Z\xd6\xfc\xe0\xcf\xb1\x9ay\xac\xa5\xe9\x01\xf16\xec\x172\x94e\xc6\x98\xf4$\x0a\xd8\x8c\xa2QK~\x8a\xf2F\xfd!<S\x95x\xb6v(8Z \x97\xf2>=\xa5\xe8s\xcc\xd3\xcf\x99\xf3\xa7/\xf9A?\x96\xb0\xdd\xed\xa4p{\x04\xd9\xa1\x89\xed\xe15\x15\xe7x$\x15iE\xce(\x1c~/*Q(c\x8b!\x15}\xcb:\xd8\xe8\xa0\x09|\x0f\xe5\x0e\x8f\x00KL\x0f\xf8\x01\x90\\\xa2\x97\x18\xb5\xaabCI\xfc\xe01\xe8$\x1f\x02\xd7G\xa4Tr\xc6\x83\xbe\xc0\x83,\x86\x9bE\xe2\x7f\xcb\x1c\x9d\xb2`f[\x88\xa18\xe4\xd2B\x19\xb3DI\xbf\x1b8p+\xf6\x98\x13l\x9e\xe8z\x96_\x17\xb5\xd3S\xf9H\xa5|\x8bh\xe8\x96V\x15Y\xcb\xb9\x9bRf\xe2-\xa4\xb4\x1c\xc8 \x12\x19\x0e7\xc4B\xefj7\xfc\xc1\xcb\xa0\x95}\x10\xb7\x0et\xc1&Q\x1d,\x83\xc0\xf7\x17\xaa\xa0\x1f\x9d\xdc\xc0\xcbcY\x00\xf3z,\xc7\x8e\x02\x10\\\xa9\xe0\x03J\xbf\x87W?\x94g\x15<Y\xd9L\x00V\xbb~M\xc9iA\x0f\x96\x83\xbd\xd3\xfd\xe4A\xfb>\x85\x0b\xca\xbbA\xfb\x0f\xacE9\x029\xbf\xee5\x9c`#\xc3\x15\xd2D:\xcb\x97\xa2\x1b`}\xc63\x04\"!'r\x08\xdeA\x94\xc1M\xbc\x82\xd9\xe7\xd4r!\x9f\xccp\x04md\xb3{\x12\xd6\xec96;z\xf5v\xd9Q\xa5\xa3P \x06\xfc\x8e\xaeqlh\x89\xf2\xc0!\xc1\xe3\xd6_J\xd8g|(Z:qln\x7f}\xbb0\x09\xd7\xe1\xe8\xb3=M~\xf2\xab\x99\x00\xa4\xfdTh\x89\xe5\x08\x88\x91\xb6\xf2\xfb\xf8|\x9b\xe5\xc7\x84P\xf1S`\xfe\x95\xb2\x1a\x97\x01K\xce\x86\xa8\xcc\xec\xf8\xfe \x9aL!E\x95&@b\x871\"\xe8\xf3S\xba\xdc\x9aG(\xf7\xafG\xf3\x15\xcb\xd9_\xe8\x90\xd8\x9a%\x99\x83\xfd\x81\xd3\x91#\xc8\xe9a7\xa3@\x88\xa1\xa5\x11:\xc1\xd0\xc4\x82u\x0e\xd3\x88X\xc8\xda\xcd\x09\xcc\xa1\xe8\x9d\xf2\x10(\xdeC\x85\x8c\x11w_\xaa\xd7-S\x10\xe2\xb9\xd7\xde\xd0\x0e\x90\x97\xc5P\xb7\xcd\xca\x00\xe8\xf9\x91\x15B\xf7\x03\xf3\xbf\xc8\xe8&\xb9\xa3\xaet\x1cwH}\x00\xbf\xb50{\xff|:\xc8w\x0fs\xc19z\x11\x1f\x95\x02W\xfd\x01\xd6\xd8\x95L\xdb\x1e\xdb\xf0V,\xf5\x91\x83\xcf\xe3\x8dI,\x86\xceQ})ZA6=\xaa\x9f4\xe4\x86\xe5\xfc\xe49\x07\xdcQ(G}\x91\xe8\xa7W\x02 \x83/n\xf1\xb8\xfc$D\xc4.1\x8d\x88\xc2\xcd`\x1aaJ2[J\x00\xc6\xdc\xae\x13\xc0\x96P8im\x88\xd8N\xa6\xf3\xa4\x04\x08]!\xf1\xcd\xcc\x86E\x87\x12!w\xe4\x1dSh\xe7\x9c\xf1_\xa6-Jw\x1b<K\x8f3\xcb\xb0\xe7\xfau\xbe\x91\xbb\x04\x83\xa2o\x06\xa0\xd1T\xc4\xe2\xaac\xa6\xec\xef\xe0\xcbU\xff_\xc2y\xb1~\xa3\xf7=\x7f\xa7)\x86\x8euj\x017_s\x0c\xfbX\x13\xfa\xb9\x0dq\x02\xa4/>\xd7\xcd\x0b\x9d_\xbe\xc4\x14'\x14$z\xdd!\xed\x1d\xe8q\x1f\xdb=\xd0\xeb'\x8c\x8c\x98\xf4\xf9\x89\x10J\xea\x7f\x0f~\x93q\x9f\x9f\xa0\xd2D\xf6\x1ei\x87\x83\xe6\x8c!\xe6\xf9\x8a\xaa\xb5|\xb3w\x98\xebo\x8e\xc4\xb5\xbbO\xe3\x96v5\xbb\xac|x>\xb5\x9f\xdcS\"r\xc9\xc5H\x95s+\xa084gm\x09\xdd\xff\x86B\xd0\x1d\xdd\x97\xcenX\x92\xf7t\x81\x95.\xadD\x10P$)\xbaj\xe2 \x18_F\xe7\x82\xbbp\xd7\x7f\xfa)\x1c\x83\x96N\xd0\xfe\x92\x9d{[\xd94ik\xbfD\xb7\x8f\x9e\xab\x868\x19Q\xed\x8f\xd8Vv\xa9\xf7f\xe7r\x02(\x99x\xc2@J\xf4<\x81\x85\x88\"l|6\x83\xf5\xcf\xf8+\xf1\x96\xdd<\x08\xfe\x8f\x91I\xa9\x9f\x18M\x97\xcct\xf7(\x9a\x0d\xe3yW\xeb\xefCq\xb7\x12\x8ek\xac\xfcaG\xb3\x92/\xe7\x1f+9\xcf\xf5\x0fb\xfc\xb7\xd4\x1dy2z\xa2\x07H\xe47\\T^1\xf8\x09\xc8`\x0d\xcf]*\x03\x07\x0b\"\xa6\xf4Ii\xcbM\xc0\xc9\x1c\xfd\xb1D\xcb\xc9\x1fW2\"\xa8\xd2 \xe2%*\xde\xdeJ\xd9lRM\xebL\xbfI\xa1'\xf8\xe6X\xb7\xefO5\xaf\xe3)\xd0\xdf\x09\x7fpN\xe8\xbd\xa8{\\e\x17$\xe9\x1b\xd9 Id\xb2I\xca^\xc1u\x11\x88\xa1\xf3w\xa4\xc0K\xfc\xf1\xa7q\x18\xbc\x1f\xb42J\xe5\xa7|\xc7\xe2o\x94\xcc\xd8 \x05\x0e\x15\x86Vd3\xe4Pv.%#\x9f\xa0?O\x92\xd0X]F:\x910\x1a\x89\x94\x84S.\x1c\x09|\xcd\x02\xa4\xf9\x1cj\x10\x17\xd3\xfat\x9cw^Y`W)\x0b\x8c\xeaUsT_5\xd3Nu\x01\x8c\x19\xe845\xcbr@H\xa2B\xd6\xebb\xff\xf1\x1c \xceS\xe3\xa9?\x000\xe5\xd6\x94\xdfD\x8e\x93X;X\x81c\xc2\xdb`\x8a\xb7\x8f\x88\xc1?\x9f\x86\x80\x1c\x08\x01\x14|*\xecM\xa7\xb4P\x98\x08(;Y\x8eU\x11Re\xc9\xb0\xb1;c\xfdb\xc7\x9a\xf6\x0d\xaa\x8cc\xe0\xbemN\xc9\xa1K\xd1>r\xf9\x85e~\x04Y\xf1\x82xa\x89E\xfdV\xceM\x179?\x0d\x09\xcb/\xe9\xa0\x07\xb3\xe3`\xd0\xbe%\x9e\x0e\x82?Q\xec-\xe08\xb8E\x9d,\xb9\x10\xf0+\xe1'B&\xd9\xf6\xdb\xae\xbfJ\xf1\x00\xaf\xce\x1cQ\xec{\xc9\xff+\xae4%`\xa5\xfd$s\x0f\x96\x8f4?P=n/\xcdM\xca\xfa\x1e\x8e\xe4\xb6\xc1\xdf1L\x05J\xec\xbd\x0b\xa7\xde)^.\xe9oC.o\x8e\x10F\x84F\xb3~'\xd8\x07\x17\xa3 u\xdf\xceX\x8d\x86Fh$\xa1M\x86\"\xf7GX\xd7\x13\x1a\xbd\xfam\x8f\x81\xd9u\x07F\x00\xf4\xfb\x8b\xb2K*f0\xa3\x12\x96M\xe2\xaep\x0ay\x1a~\xc0\xed[RW!\xce7p\xf7\x93\xb6S\x0f\xe6}Y\xf4%\x0c\x98\xe7\xd2\xf8\x1eP0bU\xb4\x92\x8f?\x99\xf3\xaas\xca\x9b|\x19\xb5M\xa4An{\xc9\xfd\x96I\xa3n\x8aJ\xadW\xcf\x99$\x16C\x08\x91\xb9\xdf\x1f\x17\xeaD\x00 \x04J0-\xe3\xdcQ\"\xe1\x08\x0b\xaa\x82b\xf6\xb2)\xc3\x0cT,\xe9eK\xe0\x10i\x9e\xa1GQ\x18\xcb@\xd1Wz\x16vq\xee+\x17\x8f\x12\xa0\xdf8\xcfq\x0c+E\xb8\x85\xf5R<\x8a@\xc7\xaf\xb7_\x9c\xd8\xe0F&C\xe9\x11\x84h\xb3\xe3c\x89\x06\x02Q`\x190\xf9s\xb2<1\xf1V\xca\xde\xd5\x02\xb4\xda\xbb\xf7\xfe\xa18\xd9\xa7k\x03\x12\x83\xa3c\x18\x92P\xb3~Oz\xff\x82n\xf5X%\xe9mS\xdf\x08*\xccc&\xc2\x19)\xe3O1\x99\x84'L\xa3\x98\x1ds\x1d\xb6=9\xf3\xf7" +
+	"w;l8\xa0\xf7\x1cY]3\xb8B\xbc\x90i4\xa7J\xb7b\xdc\xe1i\x87\\\xf2\x92+`\x11\x11J\xb3w\x13\xde\xb2\xd2\xaa\x0e\x97R\xea\x81\xab\x99\x0a\x1b\xcf\x05\xa9\x88\xba\xa9\xdf\xf5\x98L\xa1\x0a@\xbc\xfb\xa8t\xca\xd3G8\xd0;x\x87\x07Is\x8b9\xe1%\xf7\xa83\xf11\x16\xda\xe9\x8aE\xfeja\"\x1fzl\xd2\xe1\x96!\xf5\x16*O\xa1>\xea\xf7\xf8\x93\x1d=\xc7\xb066\xc6\x9dKT\x9a\xe2\x07\xa6\xa8\xfb#\x16z\xeb\x1f\xe5I\x09\xd8\xc5\xae\xc7\xdd\xce\xd5\xaf.\xc9\xb4h\xee'\xc1rE\x0f\x8d\x92\xae\x90w;\xe2\xf5\x86\xec\x17\x17\x88\x8c\x88\xdb\xe2\x16\xd6+\xe0\x8aN0\xdf\xc9\xcb\xf2U\xc8\xe4\xf5X%;~.\x02\x84\xf2D\x05Y\x85\x83\xf6\xfaZ\xfdK\xfcA]'\xf9\xd1q\xe85\xef1\xcc\xbb\xee)\xaew\xa2\xac\xc4\xa0\x19\xd2f\x99\x9c\x95\xc8X6\xe7\xf4\xa9\xd0\x9c?\x8d\xdc\x9e\x0c<\xd2]\xf9t\x06\xd1\xc9\xbd^\x95\x06{sMJ\xef\x7fD\xffT\xe2\x10Z_\xd5B\xd7}ae\x0fZ\x0fO\xa6\xf4\x9f\xa5\x13\xeb\x04\xa2\xac:x\xcc \xb3y}\xcb+0\x1a\xe7\xef\xc1Gh}\xf2\x14\x9b.\xb2\x07\xc8\x81\x1ci\xb9N\x0c'B\x16\x0f\x94\xc6@I\xbe{d\x81\xf0qm\x98\x0c\xc7%5\xc4\x16\x0a!\x97J\xfa\xaeG\xf8O\xa04\x0e\xc93\\M<\xc3\xfa<\x136\x0fO\xab^t\xee\xc6\xbd\xce\x90\xde\xa7\x00\xe1Yx\xb7E\xb0^\x14z\xfbD\xfb\x1c9;c\x89\xd7\x17 \xd0S\x88\xc3ft:j\xc6g\\\x15K\x02r.\x0djL0\x82\xf1\x07:\xb9=E\xb7\x13\xc7\xee\x0d\xacA(\xd6\x9e\x0a\xe3\x18\xbfe\x05\xb0{\xd9\xb6C%$O91s\x0aJ\xa1\x86\x89\x14C3\x1c\xa3#\xc5\x1d\x98\xf7Y\xdeEw\xbf\x19y\x110tv\xbdLXX\"Q\xd6\x98\xa1\xf1*g:\x0d \xfc\x0a\xd0\xc6Ff\x86L\xe9\xa2\x87}1\x8c\xf2\x9c\x0e\\?3\xe4o\xe9\xa0glC!\x97\x8a2\x8c\xfc3\x89J\xd7\xb1\xb0\xc1L\"\xbcRU\xe3\xe8\xba]\x01\x87\x98\x90\xf6\xa0\x03\x9b\xb0\x1f\xd4KI\xd1b\x95\x94\xd6\xa0\x19\x9d\x17\x06\xad\xbb%L\xe0BJ\xad\xea\xe5*\x95l\xb1q\xa5\x9f\xe5\xdf\x1an\x90\x93\xc8\xc3-\xc6H\xac\x10\xf6\x1f\xeb\x01\xb7\xc4_\x8e.\xbd\xd4\xf6\xe5\x02\xba\x90Q\x03\x86;\xea\xcb\xb4\x9a\xe7\x82\xa8\xa7\x12u\x9f\xb0\xc7\xa5\xb26\xce\xa2\x9fL\"\x18\xf0\x92\xbc\x9d\xf5V\x03\x1a\x96\x8b\x0d\x97\xfe\x96\xcb\x94\x0d\x83Hr1m/\xafu\x80#2\x15\xcbu)C\xb5\xe6\xf5\xc1\xa0`\xa2\x08\x94\xfd\x1e\xae\x9b\xd8\xb5'C\x8fx\x80^\xf7\xb0{[\xdb\xc9B\xba\x8d\xe3i)\x92\xbe\xc9SLL$%W\x1c\x0a\x90\xf5N\xb6\x14I\xd6\x90\xdd\x7fI\xfd\x84\xf5\xab>\x0f\xac\xaa#a\x87a\x8b1\xb9l\xd2\x8f\xab\xfc\xf1V\x1e`\xd2Z\xa7\x93\x92\x86k\x03\xba\xdb\x8fz\x84\x9a[\xbbY7\x078\xd7Q~\xe8\xc8I\x7f3\xa5\x06q\xcf3\xa8#<\xd9\xfai\xa83\xb9\xe8\xd8\x89KHP\xf4xlC3\xae\x92\xf4\xfep:b\xde\x95[>0\x06\xd0\x12\xaf$\x80!\xa9WM\x87\xc6C\x14K\xef\xde\xc5\x1dn\xe6Cp\xf4.2\x0dZ\xf2i\x88\xe6\x81\xf2=\xda\x8dD\xb6\xb41q2\x11\x01\xa8\xce\xd6\xcf#W\xba\xd4\x14O\x0b\xb5}S\x19[\x16\x8a\x0c\xaf9\x07\xceSB\xea\xebG\xe2\x9e\xfe\xcb\xb4b2Bw\x16\x83\xcd\xd7Z\x19\xbf?O\xb4l\xa4%'\x98\x86\xe5A\xd6\x93\xa4OW\x19\x14>iL]\x8b\x93\x0a\xc2/u\x96\xfa\x1a,\x82\x10?\xb8\x171)\x1doM\x05\x94\x8eW\xdb~\xd1r\xdc\x9a\xe9\xa3\xb5\xc0\x01\x93\xaa\xde\xb2s\xea\xac\x8eT\x1dO\xeb\x83|\xcb\xd5\x8e\x93\x9e\x16\xde\x10-[\x1c\x8f\xe3\xf5\x185E(\xcf\"y\x0bt\xda\x883\xd5\x15\x9e\xce\x9c`P\x1f\x89\xb8\xe3\xca\xc6\x94'\x1c[\xb1\xdb;\xed\xe9\x13i\xee\xd2\x0d\xeb4\xc6\xeb\xb1\x865\xa93\xa4\xd3RF,\x19\x14'\x84\xc45$:r8* K\xab_\xe1PD\x89\xd9\x05\xa3b\x02QF\x18\x97\x82C\xef\x15\xee\\s\xb6B\xf1(f\x0f\xbb\xb6l\xc2Mj\xe4p\x14\x0a\x08c\x9eWp\xc0k\xd7\xfd:\xc2i\xe0It\x176\x9d\x0b\x95w\x9e\xc6\xb30\x14\xbe\xfa\xd8t\xe6x\xcc\xab\x87\xcd\xa1\x8a\x9b\xc0\xe2\xbf\x899\x9b\xb0o\x88s\x92hE\x89L;$\xfd\x18K\xe6\"\xa0\xee\xc1K8\xb3iE\xe56P\xec\xdf\xdf\xa8o\xef\xe6I,\xbf\x05\x1aQ\xda\xaa\x11#x\xa7OL\xc5\xc2\xeaG6\x1a\x94vI\x8d\xd2\xe4\x9e]\x99\xa0\xa5~\xdbgn\xf6L\x14]\x8a[\x06\x10\xcf\x9c}\xbf\xa4\xf3x.mcn\xd7z9i\x89\x80\x9fr\xf3\xfc-\xa1ZWr\x8b0\xca\xf0\x16\xcdp\xd7k%53v#b\xd2\xe1Fb\xf6.8\x07c\x1e\x16O\x8b(\xa2=F\x16\xfe\x8f\x10\x1b\x1c%\x8f\xe9\xcf\x1e\x7f\x9cr \xc2`\xa8\xdag&-o\x1d\x1e\xe5^\xc9\x84\x81\x02.\xb5\x0e\xd4A\xd2\x93y%s\xbc\xaf\xa8\x1d\xf9\xd6\xe8\xaa\x93\x13o\xceT\xb4\x19`j\xe3\x8a|)\x88\x04\xe3\x13+\x8b\xda7S\xacY\xe7\xe8\xc4\xc3\x0c;\x1cNs\x19\xa4\xb63R&WYb\xa0\xcb+\x10\x8bs\xf3^a\xe8\xce\x8bVB[\x1d\xea\x16\xc9f\x0a\xb7\x9da]E'\xaf\x01\xc2>\x0f-\xef\xf14\x01\x0c\xf6\x87\xf8\xee2\x1e9m\xe6\x9c\xdd\x1cy \x9aS-\xdd\xb4R\x92\xaa\xa2Kg\x9e\xc0c\xdb\xbaAz\x024\x86\xb9\xca\x0a\x14\x83\x90\xf0U\xd2\x87+\xbfW\x1dt\xf2\\[\xcf8\xb4\xdf\xd3N0\xde\x99\xa8\x16\xa2\x9a\xf0+\xa7\x11Y\x02^[_\xc6\x00\x9a\x07D\xd6T\x0c\xa8\xc9\xf6=<b\x89\x8b\x8a \x8d\x0c\xf8\x11\xd60I\xe9\x14\xcd4&\x1f\x17zm\xa6\xda\xe9aD\xef\xa8E\xb7\x18\xe4\xb4\xafn\xac\xb4\x9aM\x92\x18Qz\x88\x08\xe7\x83\xc7\x9e\x93\x9a\xa7\x8c\xa6z\xc6\x98^\x0c0\x94\x8b]\xf0\xb9s\"%tO\xd9\x85\x82ENe\x81\xc2V\xf2}\x0c\xe4q\x9f\x87\xd6\xe0N\x01\xde'&JE\xb0v\x09i\xb0W\x8c\x1c\xa3}\x07\xc0=\x85\xb1\x14p\x05\x07E\xaf\xe0H\xa5t|.d\xdd@\xd4\x9a)\xb5Z\x19I\xf6\xc9Sfn\xb7\x83aN8\x06&B\x90\xf8\x82f\x82\xa0:\x0e\xdf\xe1]4\xf5\xb1(\xdf7cu\xa0\x0ar\xcc\xa8\xb08\xb5Du^V\x98\x03\xcdI\xa6--\x94]{E\xcdL?%n\x0a\xc9\xf4\x02%npaJ\xd0\x89N\xbb#0{\xd633b\xc8\xa1\x94<\x9d0h\x0e\xd8!\x01\xbe\x13Q\xdb\x98\xaacw\xa2\xac\x8b\xfe\x09g\xbe\xee\xa8\xe9\x84\xd1z\xeaN.\xdd[\xff\xd3\xe0\xa0\xd7\xab\xe92\x86T3\x01o\xf2\xa6)d\xea6q:dJh`TTE_\x88\xc7\"\xd6I!H\x84\xb3\x1f\xf0\x0d\xba^Z'_bHy\x13N\xff\xf0>\x1cP\xe26\xe6\x90t\x82\xd3(\xe6#\xfc\xfe\x8eH\xca\x05\xaf(\xfa\xb7\x02\xfb:W\xf5Da4d(\x14\xbf\x13\x17g\\\xe4}\xfd\xb4\xdd\x16'\xe8\x1dA\x1eFz\xabx|DT\xac|\x03\x01\xdb\x85..\x14\xa8_\xdb|c-\xafa\x12\xb8\xb3|&ac\x8c\xfa`&\x87\x9eJ3\xb5\xa3\x88\x13[x\x13s\x0ek\xd6kW&g\xb4\x97w\x8e\xc5\xdc> 7\xc1\x824\xd2\x9a\xae\x8a\x05\xc9\xcf\xc1\xd2\xa6]\x8f7\x11\x1c+\x11\xfbk\xc4/a\x08\xd0\xb7\xf8\xa2\xc9\x8cr;\x18zI\xc3S\x08>\x19 \x1e\x16\x0c\x86\xc2_\x8b.\xc8<\xb4c\x00\xee\x1e\x19\x81\xe1V\xf8U\xa8p\xcc\xb1-\x90\xc5\x05\xce\x1c\x83\x95\xae6I\xa8\xb9\xde\xfd\xf5k\xde\x0dO\xaa\xd8\xec\xee\xf42o\x0a\x99\x9e\x94bU\xabO\xccdP\x03\xa2=\x1d\xa1\x02_\xd4\x0b~\x83wajUu:[\xd4T<\x8c\xado\x96TK\x1e\x85\xf1`2d8\x10C\xad0zI')\x09\x034\x89G\x0d\x08\xf3\x0d\x9d\\6;\xa1\xcc\xa0c\xc2\xda\xffQ\xd0P\xb4\xbe\xf3^\xef\x12\xbb\x13\x9c`\xfb\xe6=\xc0\x8dU\xce\xb4\x97\x09\xd8O\xc0R\xf2\x19\xee\xc0\x11\x1c{\xd4\x11\x02\x81+$j\xae\xb1\xa1\xd1<ha`\x94\x97M\xe1\x05b9\xe7\xefd\x93\xb2\xc8\xaeNC \xbf\xd61\xa6K\xd3`\x19\xba\x04.R\xb1\x1f\xddw\x10{uK\x8a\x8a\x88\xfbz\x1e3Q7\xe0\x13\xde\x0a\x0f\xc0\xe4\xbc\xf1\x10\x8ea\xe7\x14\x99\x0e-\xb8\xe8\x04\xeb\x13\xbd\x0c\xe6a\xa6\xcfF\x99\x81M\x11\xe8\x9af\xd37q,4^\xc1<\x92\xf4y8\xe5\x83\x1bp\xae\x1cj\xae\xef\x9fj\x10\xff72P\\E\xc0\xb8\xdf\x85T\x0e\x88h\x09\x17\xe8\\\x0cn\xdfa\xdb{\x91\xc9\x1bX\xd5Vn\xf7\x0cw\xbcF\x8b\xc4\x994\xa7\xd0i\xa0\xf5\xa7\xaa/\xd9y%r\x92\xc4-\x08?\x8b\x89\xa3KB\xd2\x94\x92 \xf1-\x15\x08N\x04\x0c\xa8\x97C\xb2\xb7A\xff\x9a\x8e\x07\xfc\xbe\x06V_DMM\xd7\xc7\xd7\xa2%wDu\xcb_o\x8e\xe0\x92+\x89q\x9d\xf6\x99\xd7\xad\x8d\x83\x9b\x8d\x88\xa7#U\xf8\xbc\x19\xc9\xb6\xaaJ'4V8#\xca\xd4i\xc7\xd3*X\x8a\xdf\xdc\x83\xc5\xed\x9c\xbdm\x93qe\x1bR\xb6u\xf09M\xb9\x062\xe5\xb3~P\xe3\xdd\x09\xe5whzy\xff\xc4bBH$.RJY\xf2\x1a%b.\x817\xc2\xf9\xdc\x91q\xde\xdb\xe9R\x0e\x99\x911X\xea\x964u\xb8\xc4\xa3\xed\x0e\x84*T\xbf\xe0\x11F\x84]\x8f\xb2\xdb\xe3QR\xf8\x8e\x05C\xd5W\x82\xd1}\x8e\x99\xbd\x0b\xe9\xbfR\xa6\xdc\xa9T}\xa7\xf4a\xf9\xcbB\xfeJ4\x11\xd5\xab>/\xb6\xe7P,\xd0\xc6\x85\x13\x1d\xd3\xc7y\x18\xcd\xfa\xc3\xe6\xa8\xcf\xfd\xf6;\x06\xd2\xe8qD\xff\x8e\xd1\xf3\"7\x1d\x9f\x06\xd9\xf1O\xdd\x16\xd0\xa3\xf0\xbce\xbd\x92\xdf\x10\xc3\x94/4\x10\x17R\xd7\x0b\x1dt\xbc\x90tE\x09\x05\xaf\xda\x97\x92\xe6\\\xcea\xbe\x0c\xb4\xe4\xd0\xee\x976d\xc2\xeb\x19\xe0$\xcc\xe2H\x12\xe0\x89\xda\xc0\x0d\x85\xd5\x13'\x95\xad\x0a\xbf\xb9*\xaf|\xa7\xb7a\xf8\xac\xb26\x18E\xa1\xa0a$\xaa\xc3\xa7cTd\xb9\x8c\x89\xf5\xee\xd1>\x94z\x03\xf5{l.Y\xdf'\xa9\xe2\xc8=\xa2\xb1M\\F\xe1\xed\xcf\xed.\xb2\x81\xb6\xbeU\xca\x93\x03\x10\xed\xa2jp\xbe\x15\xef\x85\xdaX\xd6[6E\\d'),Gi!\x81wa5\xbc\x13D\xa5/9\xb7ak\xd8\x09_g\xca\xdfpGL0\xdf+\xfdb\xc2i\x93|\x04k\"\x9a\xa8I\\\xed)m\x8bC\x87\xb2\xd9\x0e\x91\x1a\x13w\x10LK\xe5L\xca\xf3\xb6Qa\xb3\xd0\xfc\xa2\xfd1\xb76\xd8\xea\xf7Pe\xb9U[3j\x19\x861*\xec\xc1J|\x8b\x1eE\xcd\xba\x8e\xdc\x9c6\x95\xfdV@\x04\xf41\xf4\xfb\xdb\x1b\x18\xde7\xc1C\xb9@t\xb3S\x8b\x1f8G\xacn\xd6\x7f\xddT\xe29\x9d\xeaS\xe8^\x9bH,\xe6C\xf8\x03\xa8\x93v\xed\xaf\x9dg9\x91\x01\xb20\x97\xad\x13:\xe8}\x8b`\xf2\x90\xf3\x12~\xf9\x1b\xa5-i0\x0aG\xf0a\x04\x0bc\xa5\x1e\x181PB\x82;\xfbL\xf1\xdeW\x00\x1d\xb0nr\xd7d\xcb\"\x8ck\x19\xa2\x0c]R\xc6\xfb(\xb4\x0c\x7f\x90\xc0\xa0+YRT\xeas%\xc4\xa0\x17\xe2j:\x00p\x08\xacN\x89#\xa6\xc7\xcb\xb0\x8b\xe2v4\xa9{\xdd@%v\xfa\xac^\xcb~6\x95\"2Y\xc1\x0a3Y\xc9\xa4\x99\xf3r#d\xa6\xa8\xd421\xcf\x9f\xac@\xb2\xdb\xa2\xa2\x94\x90\xd3\xba\xadnF\xae\xea\xb7\xce{\xe5iQ\xf6$-J}_?\xd4\xab\xb5\xef\xd7I\x8b\xf2\xa1\xbek&\x7f&\xda\x91%l\x95\xe3\xc8\xef\x85\xb6\xa9\xf4\xc0R\x95G@^\xf5\xe0\xe4\xb0Df\xd0\xc8q\x15\xec\xdb\x03J\xaa\x92\xb7\x15\xbfq\x89?\xa8\xaf\xcc\xdc0\x09\xf2\x06\xca%\x164\x10\xee\x88\xacX\x83f\x0a\xeb\x1d1\x8ds\x87|6a\xd3M\xa2z\x81-\x1a\x85.\xa4\x82\xdc\xc69\x1b\xb0)\xb4\xd5\x94\xba\x8b\xe7D\x86\x86\xefc\x85\xa1K!\xc4I\x8e\x0ax\"\xc9\x8e\xaa\x86k\xc4\x11\x9f'\xef\x93\xae\xaa0\xca7P\x0c{\x95\xa4-5R\x05A\x16D\\#\xc4W\xba\xded\xafW{\x99\xe4\x00t^\x93\x09)\x16,\xe75q\x1fu!%\xbc\xee\xa8\xf3.\xe8\x8aB\x87f\xdb\xe3\xee\x1b\xfe\x03C\xb2\xb3b\xd8\x91\xc5\xf0y\xbc\xf6\xea\xb1\x97\xa6\x9a\xa5E\xd1$\x87\xd3[\xd2z\x04b\xe2U\xa7\xe6\xd1A\x1a\xa9\xe6\xc5\xc14R.m\x9d\xb4%@-\xbc\xd3\x7fo:\xba;\xe1\xc1\x84\xa3q,3\x8d\xa7\x1a2\x13\x81\x97om>'\x0eE\xd9\x83\xe6@\xf8\x90s\x14z\xbe\xd5\x09\xf6\x12[\xf6\x04\x9a\xf4\x92\xf13\x91'\xafJa\x9a.\x1bE\xd7x0\xdb\x80\xba\x122\xeca\xb7:\xcf\x81p\x99Mku\x0fn\x00\x09\x04w\x10\xf9\x84\x05 \xa1 \x91\xb0+i\x83Vq\x83\xb6\x1e\x08\xba\xc1\x1f\xeaJ\x91\x92\xb4D\x86R\xe2z0Q\xfa4T\xfac?\x1e\x1c\xce\xd4\xa7\x10IS\x0aQ\x15\x02\x0b\xce{\xcfBv\xfb\xf4\xf0\x9fr\x8d\xcf\x16v.0\xd51\xc5Ji\x91\x1d\xa34\xc3P\xf5\xeb\xcd\xc7YY\x8f1\x9b\xdc\x11\xb0\xfd)\xa0\xb0\x0f\xf2\xe5\xad$\x01\xcd\xc7r\x93\xa2\xe5\x9bH\x1a\x94>\xfd\xf4#e}\xd1Z%\xcb\x93\x13O\x09O\x1f\xc2\x0f\xb9/D.\xbf\xab\x1c_[\x8f\x96y\x84Y\x0c\x92\x15A\x83\xc0\xed\xab\xb0\x80\xc2\xfe\xbf#\xe6\xfa\xef\x1f\xd3y\xce\xbb\x91\x1b\x8d\x0d<k\x84\xaf\xc1\x99\xb6H\xae\xa1\xda\x9b\xd7\xd3\xf9*\xce\x8c\xf9\x89\xe8$,\xa1\xce\x11\xaf\x1c^\xb1\xdb\xd4\x0e\x95\xadE\xb9\xf5f\xcc7#\xec\"@\xfa\x92\xdcW\xfa\xa3S\x05\x05\xb4\xc5t\x07\x1c\xd1\xa7\xa1\xfaHs\xde\xf0\x0dID\"Z\xec\x14B\xa3\x9f \xf4\xafal\xdby\xff\xa1\\\xe0\xa8z\x02\xed\"\xa5\xc3\xbeYH\xb8O\x05\xe4d&\x10A\xdc\xc4\x02\xbbYf:\xa6\x09tB\xc7\x9a]Lb\x0c\xa6\x82\xfc\xa6\xa3]\xbcO\xbb\xee)\xcc$\xcc\xcd`}}\xe2\xc3\x0e\xac\x9dK\x88\xea\xa6R\xb2\xc3\x18?\xdab\x0f\x04\xe1\xbe\x8d\x87\xcf\x93v\xd5\xd6\xb6\xbf\x00\xace)U\xbd\x90N\xe9\xc7\xd8\x8d\xad\x9d\xb6\x93\xf0\xd1\xa4\x8b\xadUaF.\x97\xc8\xab\x01\x16\xb99\x0d\xb9\x8a\xee\x9d\xbc\xe5\x1e\x15cYf\xbb\xe1X\x99q\xbb\xe3\x84u\xa1\xa7\xf1\xdf\xdak\x1d'-\xac\"\x86/\xa5\x8do\xe3\xb9\xeb\xd3\xaa*no\x9cEV\x9f;\xce\xe84]\xd2\xb6q\xf8\xd2\xcc\xc6\x0e`\x0d\xca\xcb\x9f\xf5\xe92\xcd\xae \xd8_\xa8\xbft\xa4VO3\xa1&\x97\xaa^\xc0G\x1b\xaf\xa0\xcd\xb9\xc1\xb7\x0e\x9d\x07\x17Zv\x95\xd7\x9c[$\xb5\x15\xd6\x86 @\xc8\xa5\xa2`\xc6\x02\xdcq\x06ku'\xda\x1a\xba\xd6\xc1\xf6\x8b\xdc\xb9\xab\xe0\x82\xd7\xc4^]r\xb3\x9db\x1dEm\xdax\xc3\xf3>\xfd\xa1\xe6L\xbaD\xaf\x9f\x80\x15fR\xcc\xdb\xa2BZH\xbc\x1du\xbf\xe9\x96\xf1\xb6\x0a\xc9\xaf\xf7\x10Pf\xd4\x92\xa6\xb9\xc1\x99\x0f\xf2\x15D\xe1\x0bp\x96\xcf\xec\x8b\x9f\xf5m~\x9c\x02\x8c\xe2!\x11\xf5\xcf\xdd\x1bVn\xfd\x9e\x83q\xdd\x8f\xf5c/\xa7`{'g\xe9d3\xffw \x97\xa1-\x1e\xdb\x07\x09]\x1c\x13\xf5\xe6Mx\x82\xde\xd2!\xa2\xf5p\x06\xce\xd7,\"\x098$\x02u\xc7\xa0\x89bU\x98\x84K\xaa\xb8\xc3\xab\x00\xf0\xb8\x11L\xdb\xcc\x90\x03\x0f\x9d\xa0[\xda\xb7\xf6\xf6^\x8e\x18\xe9\x92\xfa\x88\xd6w\x9as\x97\xd9k\xcd\xa8z\x15\xd2\xa5\x80\xafMx~\x0b\x10\xec&C\x11\xfb\xd3\xee\xfb\xcd\xe8\xbej\xcb\xe7^Z\xdc\xa92\xcb\x11E\xf2Q\xa0\xcc\xb0U\x9f\xf7i\xb5\x1b\x01\x8fc<\xe7d\xee\x8e\xf4U~`(Q\x0cH\xe9\x88\xe7\x8a\xe7\xb9P\xdc\x8c\xc3\xd9\xfe\x00\x87\xa6)R \xa6lSbs\xc2\x1a\xb9\x85\xf1\x08\xea\xeb\x8d\x8ei\xd1\x11\xa7\xd3\xaeS\xe6MR" +
+	"\xd4\x97\xfep\x14\x03\xd5]\xc5iW\x83U\xf9H\xd5\x84Ew\x0evh\xf1\xd4\xec=_kj~gv\xf42\x06\xcaz?!R\x7f\xcf\xdb~\xacXt\x04\x04\xf3\xa5=E\xe1Q\x9a)\xe4@\xa7\x8f|K/\xb3\x12.\xfa\x80\x81\xa1Jsq\xa1C\x044\xd8*k]~\xa60\xec\xf3$\xdb\x99\xe3]%\xeatB\xcb\xd0,Z~{\xfc\x0d}\xad\xfd\x01v\xb2'\x18dGo\x10\x8f\x95\xe8e\x06\xa8\xd6;\xacx\xa9\x8f\xacE\x8b\x0b\xb9\x8a\xc4\xf1\x11\x81\xe0\xae\x0c\x95\x0e\xc1u\xd12\xd3\x9d\xaf\xb7\xdbr@\xeb\\a\xcf\xd3z).12\xaa\xff\x97\xa0\x96u\xdc\xfd\\6MSJ\xa0Fn\x83\xc1\x89x-\x0b\x83}\xda\xa3\xccd8\x8a\xf9OJ\xebp\xb7\x92\xb8\x8e\x1a\\\x0a\xb0\xfb\xe4\xf7v<\x97\x17\x1f\x9a\x8b\x83\xe8@H\xd66\x11\xf4~KbvZj\xce\xa0'\xe2g\x8c\xb5-\xb3a\x09\x9b\xe9\x89\x09^\x80*\xfb\x06\xe9\xfc\xb0\x13E\x96.\xc9\xed\xd7-\xa7`\xf0\xa2\xd1\xf8\x10.\xf3\xa0;'gH\xaf/^\x82\xfc\x01l6\x1e\xbb~L\xc4x\xc6\xd4\xce9\xa7\xae.\x03\xa3n|\x99\x8auM\x95\x9aU\x08\xab&\xc8,\xdbS\x02?\xb5\xff\x053\xbd\xce\xb9-r*\xb9\xf2\x12\x98\xbc`\x85\x98\xae\xaf\xd8s\xa7{Z\xf3xT|\xa0\x97QY_\xa1\x89\xa3N!\x111O\xb9\xe0\x17\xc9xfD\x83\xca\xdc\x93\xf2hR\x01s\xbb\x9c}Oiw\xb8\"\xe2\xfb}R\xb3\xa4\xe61\xe7\xee\x87US\xb1\x01\x96\xd4\x9f\xf6\x80Co\x06}\x8f\xa2\x87/\xb3\xbbm\x0c\x99\xeeH\xf4\xf3B\xcf\xe9\xa8\xee\xee\xa4\xf3\xee\x91\x9a\x86\x7f\xde\xc3\xd3\xbci*\xca\xa5\xe1\x1a\x0b\xae_\xe7\xeb\xee\xfa\x850]&\x16\xce\xccG|\xb3\xfa\xc7\xee\x8b\xef\xf9\x17\xc7k]k)\xc5\x1b\x08=\xaa\x1e6uw?\x99\xa6\xd7$~\xa8(9D\x1d\xdar}\x13xi\x06\xf7;\xef\xd9\xbe\x02;d\xbdH\xdf\xc2u\x80\x7f\x0e\x9b+z\xd6F\xb9I$%!\x92[e%[\x0e%y\xc3#s\x9e{/\x92\xe1[B\xc4N\xe2\xf2\xbc\x7fD\x06\xa9\xab\xa4\xde\xe2n\x93\x82F\xfd\xc7\xb8\xb1\x99\x18pB\x07\xb4\xea\xd2\x00\xcfZ\xf3\x09j\xe3`\xd7\xd4\xfaS(\xe9\x15=\xdc\x96\xbd\xad\xae\xe2\x97\xdc\x84|~\x14&\x9d\x0e\xe0\x80P\xcd\xbf\xedD\xa9\xec%6fs{\x18\xf2d\xef1\x7f7\x88\x8f\"|\xbec\xb7\x01\x13jy\x80\xce\xa6^\xc4\x91\xe6\xff\xc3H\xb8$s\xd7\x1c\xe1\x9a&\x82\xd7\xf0\xc8\xb7\xda\xc1\xfa\xb1\x13\x05dz\x03\x10\xa87\x96\xcd\xc2\x03\xe0\xa4\xb9\xe0W@(u\xe7\xfa\xdb\xb5\x97o\xa6\xf5\xb5\x1a\xd7\xd4\x8f\x03\xd83\x06\xda\x82\x7f\xa9\xbf\xd3\xb0\xe7Q\x06\xf8DB\x1b\xbf\xd3\xfe?G\x00\x85\xc1\xcf\xff\\\x08\x8a\xbd\xc3Dui\x1e\x0b\xb9\x9en7\xd0\xe4\x85\x85\x10\x8d\xe4\x02\x8d$\x04@\x936\xc0\xcd\x0b\xa3e0\x1f\x98l \xf4\xa6\x13\xc8\\3QU\xb2\xf7\x15\xfde\xdd:\xdb\x07Zm\x9c\xd0\xa9\xbe\x0e\x99H8\x1b&\xac\xc97\x15p\xb0R\xe1\xbd\x1e\x88[c\xd49\xd1\xfb\xae\x89\xc4\xe4\x91\xcfyb\xf3\x0bs#J\xd0~\xc3mO\xe8Ev\x0c\x9b{\xc8o\x89\xae\xe1\x8e\xbb\xec\xe4\xa2I\xa2T\xc1\xa2(St)j\xf4\xa9\xa4\x143\x0d\x12#)\x05\xd9\xfe\x1c\xbb\xb9\xda\xc2(!\xd0\xa1\x8d\x0a7\xf6\xca0}\xd7\xd7\x9eF.\xd0u\x14\x03\xfc\x9f\x9a\xd2\xab\x9f\x1d\x81\xf4\xfd\xa4\xfb6\xdaMK\xddK\xc5\\y\x86]{3G\x15g!\xcf\x83M\xa2\xf9\xfd\x83\x1e\x8d\xb8\x02\xd3C\xd6j\x83\x13}\xff0\x8fj\x1f\xc8\x0b\xaey\x1c\xe6\xe1<\x99`\xe3$\xf7\xae0\xb7G\xdb\xbd\x0e\xb2\x1d\xb2 \xe4]\xbfG\xec\x80}\x9b\xb5'\xd7\x8c\xba\xef0l{\x9bYLkC\xf3^|\x05q\xe853\xc5r\xd2z\x9f\x96\xc6\x8ch\xd7z\x06\xba8\x9b@\xe6\xff=\x03Gl\xf3\x10\xb4\xd5t<\xd7\xb6\x97\xff\x11\x1d\x079\x08\x05\xa4\xe3\xc29v\x04\xc2\xb73V\xd5y\xc7\x90<\xa6\xe6\xca?\x85\xe9\x94\xb0zBP\xf8dPZ\xe8\xaf\x89\xb4\x1fG@\x81\xdf\xcc\xa4\xb7\xd1'\xfc<\xd8\xcd\xdd\x95\xde\x07a\xa1Xt\x7f\x85\x1d\xe6\x9a\xe3[\x90\xb3<t\xfa\x85\x07{\xe6\xb6F\xdeW\x1c\xb5-\x1al\x8fG\xe1\x1c\xec;\xac\xab\xeb\x05\x1e\xc2\xca\xb7\xe8\x12\x0dRQU\xaeXu\x19\xc3\x83\x03\x9f\xbf\xdec\xc5`\xd97-\xc2\xbf \xc9\xe5\xd6;\x1d\xb64\xb1I\x9e\xc2o\x12M\xe4EX\xba\x05\x04\x0e\xe7\x03\x01\xf6i\x0bd\x0f_\x18\xc7\xbb\x04\xbf\xbe\x0ao\xc4@U#\xe8\xd3\xd67\x93\xbf\x99\xb6O\x0bl\xe8\xa9\xdf&\xb7b\x97\x92n\x05\xf9\xf6\xd3\xee\xdbl\xe5\xabi\x85\"\\c\xfe\xa9\xc93\x9c\xcd\x87\x1c\x1ev\x12\xabH?\xac\x99\xae[\xb38y\xe2{H\xe1\xb9\x02[L\x8f4\xcb\xa1\xaa\xa64\xc7q\xbd7\xc7\x98\xc1L\x10\xe3Z\xac[\x0dw\xe7\xbd\xb4\xe5\xb9\xa6\x14\xcb-T\xd5!)\xbe\xb6\xf0wMa#\xb4\xce\xcb\xe7\xaa\xd3ZQU\xbd\x0d4\x94\xe2\x80\xf5px\x0e\xf7\x15s\x83\xd3 lK\x7fc\x94*CQ\xa2\xf8\xab\x0d\xd0\"\x87\x07i\x9c\x12\xa9\xc2b\xe66\xea\xe6R\xd9\xffI\xa5\x91\xc3\xc3\x1c7\xce{S\xf5\xd5\x1e\x9cGB\xde\x09\xfd}\xa1\xb2T\x82DH\x81.\x81\xc2&\x9c\x16\xdd\x81\xfa\xf0\xc5\xd0\xb3\xf4\xef\x90\x0f)\x1c\x9d\xc0\x19\xd3e\xf4\x86/\x12\xb6.\"\x8epj\xc1\xc7TG\xc5n\x1d\xda\xa5\xa7\xe9\xf1\xb5q\x96}\xf8b\xfb\x9fc\xa2`\xf8b\xe7\xcf\xd4k\xb1kr\xabX\x04XB \x0f'p)\x09\xa3\x1f\x85t\x1d\xbe\xd8\xeb\xc8\x14\xe8\xe3\xa2~\xcd9\xd6f\xce\xb7?K\xe0\x92\xbd[+^\xa4I\"\xea]T]\x8d\x08W\x84{M:!Y\xc3\x17\x1a\xd5r%\x1f\x0a\xb76;\x10\x94\xd6\xd3\xbaZ-\xfd\x07/\xb2&\xe5\xa9TW\xe6\xaf\x90\xb8Uv\xc9c\x0b{T'\xc0\xf0\xc5O;J\xc3a\x0b\xb8\xaa\x99\xaa-\xc9e\xe0\x81\xd2&_P;Fa;\xc3\xde\xf4JV\x0c\xdd\x16\x1e9m\x0f\xe4\xcea\xe4\xe37\xc3\x11\x17\xee\xa4\xe7\x8d\xadm\x9bG \xc7\x1d\xd6\x05|\xf2\\w~EO/J\xfe\x84\xbcT\x9fV\x1d\xd3\xab#\xd1*\x0c>\xf8\x00\xc0\x80J\xe4\xc775\xe6\xdc\x96\xa3\x96\xb2w\xb8*f\xb9\x0d\xc0\x96\xc3\xe1^[\xa5;T/\x19\x164G\xb5\xa2%\xfa\x9e3\xbc{\xb4^q\xd5)V\xe6\x82+'\x09\x00\x97y\xc5\xfb\xec\xae\xfd\x9e\xadc\x89\x98K\xa2\x16\xd8\xbe\xcb\x8c\xad#\xe9w`\x93#\x06KH\xdb\xf8\x94\xa6#(\xa7\xddh\xf2\xbfk\x80\x03\xe0\x8fm\xe1!\xda\xa7':\x01Z4\xd1\xab\x80\x10\xdc\xd2/|\xe8b\xf6\x88T\xa6*\x8b)\xbf\xe9\x1eN\xee\xad\xe9Q\x15a\x81\xa1RV\xae\xaez\x04\x84\xc3\xad\xe1?K\xd7\xc7\xb4\xc0\xfcl\xae\x8fhiI]\x1f\x92\x808{\xa4\xb7\xb3\xb5\xf3$o\xe7\x04\x82\xba'x;[\xbb?\x99\xb7\xb3\xb5\xf7\xd3\x9e\xe3F\xfe\xec\xbfRogK\x89\xf1\x03I\xa1\xe4\x93\xb2\x88\xea\x15\x8c\xcf\xb9$,\x10y\x91>\xf9\xb3\xe1\xd6\xa1\xe5\x10\xf5\x04#\x06\xa0\xd5\x0cp\x82\xf5\x8b\x0d\x12:\x08\x91\x98a\xc6\xa8\xce\x07\xd8\xce\xa5\xde\xc3\x1b|\x00\xc3\xb8\xc4X\xdb\xfc\x09\xf5\xd2\x16\x84,\xef\x1c\xe7\xed\xe1O\xbb\x1a\xb7\xb7\x92rjr}\x9d\x92g\x82\x7f_w7\xc5\x04\xb6\xdc}\xdb\x08/\xa4WN\xe8\xcb\xcf\xf9\xdc\xeda\x80\xb7wLN\xe9\xd1\x00\xa0\xe1\xb6osl\x0f\xb6\xdc\xb0r\x17K\x95\x0d\x89\xefW\xb8\x89\xb6\xdb\xef\xe5U\xa7\x81\xf1\xae\xfecs\xa6\x1f`*!|\x05\xdc\x0a\x1a\xaaH\x13[J=\xc4\xd4\x94#\x1fw\x0a\x8cmU\xfdc\x8fQ\xdfO|WI\xf8\xadL\xd2khR?z\\\x8b\xdeP\xcb\x1b\xe1Y\xaeP\xd6q\x81\x960\x0b\xfdp\xec\xc3\xedC\x93\xf9\xd4^$A\xaa\xa3\xf8\x0cJ,'\x92V\xff\x9c\xbc\x91\x9d\x17\x7f\x1eoD\xf4`8\xde\x88\xd4\x9f*\x1f\xe9\x91\xecl=\xc5#Q\xactO\xf0Jv\xb6\x7f2\xaf\xc4t5<\xfb9\xb0\xb3\xfb\x7f\x04\xafdg\xcfx%e\xbd\x848\xe3\xd8\xca\x8e\xc3;\xc6L\x7fX\x0a\x08\xf1\xe8\xbd\x9d$\xb6\x01\xc9\x8b\x1f}\xbch\x85\x94\xf5.\x03\xc2\xb6\x07\xb8P3%_\xe5|\x00\xcc$s \xd0\xa77y\xdf\xd9N8\xdcI\x9c\xa8p\xbe\xae\x93)\xcb\\&:th`\xcfLd\x92\x8e\xef\x84\xea$#rhN\xba\x07n\xf7'N\x95\xed\x0es\xb5\\q\x09<\x17'\x08\xe4g\xc3\xc3\xf4\xb4\xdd\xaf\xb2\xd5!|\x89\x12\x9aR-!\xd1\xbdWM\xfcT\x0d.\x03\xaa+Z\xa5N\xdb\xb3\xbb\x9ds\x0f\xc8\xcc\xdb\xd6*?\xd5\xc0\xad\x8f=\xdcS\xc6\xaa\xa7\x17\xb7\x8c\xb4\xa8i\x01\x09\xaa\xc06\x12\x9eR\xa8C\xca\xeef:\x17\xcf\x9f\xb9\xc7y\xb8\xbb\x9bA\xb4\x85\xb9;Ay\xdd\xab\xe6\x08\x11\xf5cI\xaf\x8c\x98\xfb\x85(\xcd\xb3i\xe4O\xcb'4r\x0e\x8d\x06\x05\x1fG\xd0\xbc~\x01'\x18\xe5\x1c\xc3\x99\x12\xbb\xccG\x82\xa9\xecuS\xd6o\xe4\xf3e\xc9u\xfb\xbb\xa2\x06{\xff@\x0c\x8d\xfcH\x84*\x7fG\xf8c\x01_\xbc\x02=\xfb\xee\x99:pP\xfe\xe1\xf9mO\xa8~\xad\x7fVn\xd7\xee\x9f\x89kL`\xfc\xbb\xea_\xd1X|xt\xfdko\xf8\xa4\x8c\x10\xde\xfe\x09\xbe\xd7\xde\xd6O\xe6{\xedm\xff\xb4\xc7\xd5\xde\xce\xff\x11|\xaf\xbd\xdd$#\xa4\xf4\xaaYS\xbe\x04.H\x17\xb4\xb5\xf1m\xf7\x92\x94\xd0\x1b%3\x8d\xe3\xa9\xb4\x97\x92U!\xe8d\x12\xe6\x04M$L\xa9\x89\xb4y^o\xab3N\xb4v\xaf\xde\xfd\xe7(\xc4\xe06\xda\xa8\x10S\x8a\xbe\xa9\xee\xd1>h#\xc4!@\"3\x9fWvL\x0b\xa9\x84{\xd5\xcb\xb6\x1cz`L\xaf\x9e\"bq\x82)N\x04\x9b\xeb&\xf5\xc6}\xe5\xa3\xd2\xed\xbc2\x8a\xb8\xed\xa3ZG\x86\xfbC\x17\xad\x17\x96\xcf54\x99\xbd\x14\xba>\x02V\x05\x80\x9e\xee[ly\x85\x18G\x8c{\xd3\xe6\x94\xa1\xe1'G\x97\xfaC\x9b\x86m\xc58\xf6\x0fZ\xe7\xec\x8eEAY\xf5Xf\x05\x9ai\xfd\xa6\xe7\x83\xed\xfc\xb3,\x09\xed\xef\xfey\xbc\x01\x06\xfcko\xc0k\xb1\xe3\xd0\x04\xd2\x98\x02\xd0\xbb\xb1o\xb0\xbf\xdf\xdf7\xb8\x8fY\xbaf\x06\xee\xf5\xa3\xdd\x81\xfd\x83\x9f\xcc\x1d\xd8?\xfci\xdd\x01\x0d\xef\xff\xaf\xd5\x1d\xe0\x16\x02\xd2\xfa\x1e\xc9\x11\x9a\x93j\xf0X\xeb\xa3hUg\xe4\x04q\x0f\xad\xbb~\xb6\xcft\x19D,\xb7\xd1j\x08}\x17a\x80?\xb0\x96\xa8\xce\x08,\x09\x02<i\x9d\xbe\xedL\xe0\x16\x80\xe2\xafq\xc9\xe180#\x0f\xec\xc4{\xd4Q\xa8\xff(v\x86\xbd\xd6C\xb4D@i\xd2\x92Q\x98\xa8\x14D\xf7X\xedlT\x0f\x12N\x95\xe6r\x90Y#\\^?4\xc5\xa32\xfc\x07\xbb\x7f\x8e\xd4\xc6\xc1^\x16\xe8\xf3\xa8\xf4\x06\x19{\xa4\x81\xff\x91\xd8.\xc9\xdc{,\xa6\xf5\x1b\x18\xc4\xae\xe7M\x1b\xe2\xa1\x9dh\xddD\xe3\x95\x85\x02\x8a\xfb\xae)\x9a\x11k\xb9\xb5\x11\xcd?o\x12\xe6\xa0\x95\xc0\x90*k\xa2\x9a\xe8V\x1a{\xbas\x07\xfd\xab9&\xc3\xd0\x962\xa9\x98i\xbb\xa4E^\xb5\xf4\xa7<G!\xf0\xf0\xc5?K\xbf\xc6\xf4d\xfcl~\xcd\xe1V\x9e\xc0\x82\xe3\xa0i\xbc\xfesP>\x8b\xee\x0c\xa0'D\xb9\xe1Jke *N\xd8<\xbc|\xdb\xfb\xb0\xdb\xca\x87\xb1\xe8\xc8\xa1\xb9\xadt\x80\xe7Y\xbc\xe0,\xf7:#\xb9\xf7\x02\x0c6w\x95\xc1\xca\\Q\xd9\xf4b\x93\xde\x84\xe1\xe1^\xc7\xfc\xe0\xd2}\x1b\xae\x17\x08\x0e\x1fuL\x1c\xb6\xeb#`e{\xac\xdbOy\x7f\xc8\xf6B\xb6:\xf19\x83\xdf6\x16\xe6\xb0|\x1a\x8f\xf7\xe1\x81+8Nj Ra\xf8\xc4\x88\x1a#L\xee\xbd\x1a\xa7\xf0\xa7\xeb\x9e+\xf80\x11\xbeXQ\x18[\xb6\x04k-\x92\x16\x9b\xa1\x14\xb7^\xbc\xc8\xf2\x9e \xd5\x8e\xae\xa6\xa3\x85\x03\x1d\x1f\x12\xe8\xf5\xfaJ\xba\xef>T|\x85Pm\xe1\xc7W \xa6\x13$\xf0\x89\xae\xd07Rm\xa3\xe4\x80Y[v\x9e\x18\xea\x9dk{\x1e\xddF\xa2UF\x0a\xd6p\x8f\xb0\xc9\xa54\xe8\x92\xa6GH\x98\xf5\x843o\xbd\xd8\xb6w\x96\xd2[PUl\xc6\xb86\x11R\x01\xc6\xe5\xc7\xa6\xe8u\x93\x1d\xb53G\xc4\x03\xc9#F,YB\x1a\x05\x96t/R\xe1\x17\xbb\xc9-t\xeb<sKN\x80W\xfeB)\xe3\x90A\xb8\xeb\xb9\x80]\xbe\x8fk\xed\xb8g\xf7\x0d\x14O\x1f\xa4\xce\\\xcb\xbd\xf6\x9d*\xdbz)4\xd3h\x12\x84\x06N\xd7M'\xe2\xdd\xbbo|\x90\xf3\x81\x13\xc4\x0b5\x05\x1fA\xe2G\xd5\xee\xc6\xb2\xd9\xedD\xf0\xa1\xf5d{\xda\xe2\x1e\x11!&$\x09\\\xa8\xe9\x12\xee\x14\xd6f_\xf1\x93\xad\xa1\x1bs/\x89\x81\xf8\x02\xa2\xafe37\x07\xfd}\xf8l\x06\xd2\xba]\xa7\xfb\xd6p\x98QO\x02!\x9e\x8f\x9c\xa6P\xa1\xdf$\xdd\x1d:\xa1\xd0\xf6r[\xaeT,;\xb7\xac\xe5\x85>\x7f`(`J\xf2\x87\xd0\x02\xad\x14\xf9Zn\xb7m{\xe2\xea+E\xff\xf1\x18\xfd\xd4\x91\xcdg\xbb\x01\xde&\x00\xaf\xad\xe1\x8eC\x15\xf4\x06:\xce\xc3\xa5\xee\xa1\xa5\xc2\xc8R0\x07\xc6\xaa\xe7\x9dlK\xfdk\xe8m\x7f\x0f\x88P(\x8a\xde\xb0\x8b\\\x09\x8d5\xa7y\xe7i=\x02[FS\xe5;\x16\xf8\x08\xb8\xd9\x10\x92\x1cK%\x95\xb1\x84\xb2Ac\x0fD\xd1\x01t\x08\xb1\xa3\xa2\xfa\xea\x1f\x9dm\x0d\xf7\x13H\xca)Jrk]\xb4o\xbc\x90aS!\xbaa\x8e\x10\x04Ov\xa4\xcf\xbaa\x05\xc7%\x9d\x8c\x93\xd6\xfd\x9d\x82k\x8e\x90\x81g=fB\x91E\xec\xb8\xde\x14\xa8[[/\xfc\xbd<P!\x15\xedc\xb0\x10b\xd0\xc8\x1diy\x09n\xa6\x90\x1c\xd1%\x90)]\x80XY\x92\x81\xfcF\x8a\xe4*\xc3Y>\xcaVnI\xc5\xc0\xe4\x82\xe2\xae\x02f\x1e\x0f\xff\x0b\x98\xc2s\x14(\xee\x0a\xaa\xb7D\xc3\xc4\xccR\x0d\xa7\xa6\x91\xd3\xbai\xe8\x8c\x0ar\x98\xb8\xe9\\\xfc[\x89 j\x18\xa8\x92\xa2\xae\x18o\xb0\xd2\x16\x92h\xfb\xb4\xd8\xa7=\xee\xa8\x9d%\x89\xffe\x01|}L\xc0\x92\x15C\x0d\xa6\xec\xb69G\x9e\x1cXW\x9dw\xdfK\xed\xae\xbe\x97'\x00+\x9a\xbf\xf1\xc4\xec\xcf\xb1\xb4\xb5\xb5\xefW\xd8\x1c5]\xe4\x12fn\x00\xa1`\xccYP\xc3\xe5\xa2\xd4Y\xde@\x86i\x95)\x09\x1c\xe3\x9a\xc4\xcaW\x9cw\xe4e\xe0\x1fb\x7f;wF1\x9d\xd8\x8fa&\xa0\x8d\x1e/\x82\x1a\x85*k\xe6\xbc\xe4\x8f4\xed\x02\x097\xc3\x0a1\xf3\xd4\x16\x81\x02$\xfa'-\xd4\xb5yy\xd2})O\xdaL\x9bI3\xee#M:\xe8\xd4\x08=\x82\"\xd4\xb7\xe1\x90\x84\xe3\x82eC\xbd\x0c\xc5\x9fC\xebt\xbd\xa8\xd7J[\xe0\xa9\xae\xc7\x0e\xd84\x83\xe5\x92\xe4'\xfd\xcf\xef\xed.\xcc\xe1\x91\xde\x8bb\xc1\xc6\xfd\x13R\xf1(\x03\xbc\xbe\xb1\x85i\xbc\xc5t\x9d\xfc\xee\x097ehJ\xa1\x98\xf5\x94\xfb\x97%\x85:\xb9\xb1\xd3\xf3l\xd0\xaa\xd0\x9d\x8b\xd3\xddg\xaa\xdf\xe2\xba\xd6|\x1b\x0a\x8c\x84:T\x86\x0f~dL\x12~\xaf9\xe9|\xa96\x15\xe7\xcc\xcb\xc9\x004\x02\xf9\\h[\xe5\xb1\x95\x0c\xda\xe6C\xe6\x1f\xa1j\xd6'h\xcfe\xed(\xb1\xbcT\x02\xd2\xdfx\xfb\xb2\xa0\xa00\xbe\xf1mSb\xe3v\xd8\xad\xf1P\xee+\xae\xd1^FV\xa4\x9e@\x17EX\x90\x93D\\2<\x1e\x84R%\x82\x12\xa35\x0e\x03\xf4-$_,\"H\"._\x93\xae\xf693\xec\x98_\x82\x04P\xd5\x9c\xb0a:\x82\xdf\xdd5\xe782\x99\xce\xb4w\xb1\x1e\xd9\xe3\x84;\xe8\xa1r\x01\xcfN\xa4:P\xff\x8di\xcfh\xd5I\xda\x0f<9\xb9\xbe\xa6\xdc\xb5&\xba\x87S\xcd\xaf\x82\x0f\xa3H\x8eu\xc3\xc5\x06ci\x9f\xc6D\xa3\xf4\\\xaer\xbc\xc0\xacB&\xf0-c\xfe$W\x81h\xe6j\x99\xf6\xd6teE\x86j`\xe8x\xd3\xb9Y\x0f\xc0\xb7B" +
+	"n}\xbdb\xd7\xff\x1b\x0c0T\x8e\xa4\x83\xc4\x13\x1a\xfeD\xf8\xe3\x0b\xa2\x09\x0eAv\x04\x05IyG2\xcc\x80\xd6\x8dD\xf9\xf3\x817v2d\x7f\x1d\xfd\xac\x04\xe3%\xd8\x91\xb0\xf3-\xfc\xf5\x96\xfc\x08\x17,\xd2M\xaf\xd0\xc2[&\xdd\x96B2\x0e\xd6\x0bj\x1c\x1c\xc7\xb0-T\xec\x9bi\xdc\xcf\xec=\x15\x03\x03\x93V\xaf\xb5v\xa0\x01\xa4\x10\xe4\x99\xf4a\x7f\xd6G\xd6\x16\x16@\x98\x84\xd7\xa8\xff\x89\xbaU2\xf4\xbb&\x90\x0b\x9c\x0aK\"0\x0f\x99\xecT\xc8\xaa\x10\xc8\x0e!~f\xde(\\|\x09\xe5\x88\x87\xa6\x92\xbe\xd3\xb9\xa2\x94v\x95S=\xfeZ'id\x13\x8b\xfc\x13\xd1\x0cr\xd7\xaf\x0e\xbc\xdd\xc3r\xd5KLn6s\xf9F\xa5\xa0t\x0e\xa6\xe6\x1d\x9d\x7f/\x13\xf1\x1e\xd1\x80\xc8\xc2W\x9b\xb0e$\xad\xa4\xf5\x0d\x1cj\xa2\x14B\xd6\xef\x8a@#3\x10W\x99\xd2\x8b0\x94`\xbd\xde\xc2\x0c\xd2\xdb\xfd\x08\xe1\xa7`\x95|\xc0\x92Gs!\x068\xe4\xdf\xc3\xe6\x1a\x10\x96f\xd4\x14<9\x05\xf2\xfc\x1eA\xf3\xce\x0f\x1aI\xb7\x01,d7)W\xa1Zz8\xefT\xb3\xe9-\x87\xa1\xeb\x7f\xd3Y@%\xa5\x89|\xbe\xca\xbc,\x9f\xe9\xf5\x95\xb4\xc3\x13 \x86\x00\x97Y:\xeb2\x813\x0a=P\x17\xa4\x00h@M*\xf4:WH_\xad%q\xf2h\xde\x16\xe585\xa7\xc0\xc8\xfa\x0am\x8f\x1d\x84\xd2\x1f0\xe9\xda\xccx\xbd\xcc\xa4:\xaa\x86\x0fQ\x912&\xe2DZ>\x0aY\xaf7c1\x10\xf4\xedh@\x90\xaf14sY\xd9_\x95\\\x91R\x86y\x95;S\xdc\x87)\x99S\x1a\x08\x86t\xd1]\x0a\xd7Y\xc1\x9b\x10:1\xe5knM)\xde\xc0\xf5\xc3x\xcdl\xd1\xe8K\xacRx\x9f\xfaN\xfc\xd1\x8e\x93\x09\x08\".\xb7O\x1dE\xe4\x1be\x1dQ\xd2\x07\\R\xdasnV,\xa6Y$\x9fj\x98\xdf\xb1RJ\x88M\x1dS\xfa\x0c\x1a\x97:\xd7N3\xa1\xa9\x81S\xabd\x939ADnp\x83$\xa3\xa4t\x01n\x82\x97Z\xb1\x06\x13}+\xb3\xe2SzG\xa6\xa4yZ=\xd3\x90\xdf,\xc9\xd3\xbe@\xaf\xe1=J06\xc7\x0a\xf5\xc4\x87\xd8X\xd9\xf1\x0c\xdd\x10\xb8\xfbk\x9f\x11\x8a\x88\xc4\x8f@(\xcb\xfb\xc8\x00\x10\x17\xac\x14<\xce\x88\\K\xaa\x83f\x82UK\xad\x81L\xf9(\xb9:\xc2n\xb8\xd5\xdfQO\xc2\xa9\xf8B7;\xbfd]\x0e\xf04\x14\xaa]\xfa1\x92\xcbx\xc5\xb9\x91\xf0\xec\xd2\xb3\xd1v\xf4\xd8\xa0\xc3zR\x1fo\xbdH\xd2\xe8#\x8c5\xeb[\xbc\xa6\xdc\x9fT\xac\x10\x11a\x81\x9a}3\xce \x00:RD\xac1C\x83\xff+\x89\x11\x8e\xb3-*,\x82\xb3\xd8D\xdf\x1b\xc5\xaa\xb0N%\xc5\xaa\x10yA\xa1\xfe\xcb\xd8k\x08\xb4\x94 \xd7Nq&\\k\xfdul\xb0+l\xc0\x83\xdfK\x00\x05\xa2\x8a\x83f\x14\x80\xaf\x01\xef\xd3\x1b\xdc\xb3\xb5e\xf99\xe1\x80-\xa4\x8fZ\xa0\x0b\x1e_\xe3\xdc\xceTx\x8d\xb5a\xfc\x10\x13|\x8c\xf2\x93\xa9\xe9\xb2\xbeA(#\x81N\xa2\x1d\xd1\xa2\xbb\x983\xbd\x83\xbd\xff]s\x1e\x7f1\x15\x7f\x80\x14s\xe7\xfbe\x1a\xfaI\x18 \xd8\xf2p\xacA\x1bq}\x09\xde'\xb4j\xce\xa9\x07\xe6DK\x9d\xf1q\x0b\xa6\xbb\xfd\xb8%\xbfK\x088\xac\xf7\xec}s\xa6\x81\x83g}4\xc7\xcd\xf3\x1bs\x87\xc4\xd4\x92\x04\x17\xd4\x92e\x17sp\xc4\xa0\xa63\x89\xae\xe4\xa0\x9b\xaf\xf8\x14 s\x98\x94S\xd9/S'\xe25Yve\xea\x80\xbf\xb5\xa3\xb2\x0dKz\x9c\x9c\xe6|\xd8P\x04\xde\x03\x97a\xcb(\x17\x99\xf1B3\x94w[\xb5\x84/% ^\x03j\xfc\x8f^\xd1p#ex[\x14`\x88C\x09\xd5h\x9aW\x05\x80(\xe1W}R\xb9\\\xd819\x164\xbd\\\xde7\xf0iv\x19]U\xfd\xb8\xd07\x14\x1a\xf2\xb5\xdb\xef\xe2\xa2\xa3\xd8\x89\xe4Sb\x88~\x94\x90\xe3d\xd0\xce\x1b\xa2\xb3\x05]\xd6\xfa'1\x06D\xd1&U\xb8\x00\xbd\x08r\xa8\xb5\xc8gTOV\xfa\xf1(u#\xf3!K\xc2?^\xa0\x0b1\x15\x11t\x1a?J\xd1g(\x82G\xf4\xfe\xc3\xa3\xf4\xdc\xa3Ek\xf3=\xc9\x1fY\x9b\xc2\x81%T2\xda\xa1\xfc\xba\x171\xbe\xe5\xe1:\xd3Bkg=$\xdcm\xe1\x9c\xce\x0b/\x9ap0\xab?u\xedh{\xe8\x9a\x15\xc1\xad\x05\x09\x07\xc73\x94\x1d\xb7\xd0\x02\x19Sa\xfdpP\xdb[\xcf0\x97\x97P\x9aU\xcf1\x92\xfca\xa4\xdao\xc9\xf2!\x9eYG\x13\xf5\x03\x1e\xa4\xfc\x9b\x97Q\xbdWDP@\xde\x1a\x9e\xe6\xb6\xb3\xb5j{\xbbMS\x89\xe8\xa2.\xb9\xf4<\x80:\xf4\x05\x80\xd9\xf8\xf0\x86\xcfA\\j\x1e\x92\xf9\xca[;7\xf6\x18\xde\xa1\xd4\xbb\xf7\x18\x8e\"\xb1\x1dyd|\x15\xf6\xc7H\xd7\x87I\xb4\x15\xb6p\xc1\xf8\x1e\xd1O\x17\xf1\xe8-\xe4~\xc7\"\xc5'`}/y\x9a\xefh\xe8E\xf2\xeb\x9a\xa2\x14\xae/+\xb9\xe4b`\x0a4\xe4\x9b\xbf\x11\xfdB/\xb1\xb0\xfb$\x85\xa5m\xbf\xe3\x92\xf6GLOW\x144\xa7\xe6\x95\x93{\xb7(\x0e\x1d\x97\xc3\x88\xb0[?R\xe6\xf1\x8c<\xd8\xc4\x94>.)\xbe\xbd\xd7K\xc2<c\xc0\x8c\x9f\xbd\x04M\xfa[\x96\xc8\x8a&\x8a\xbc\xee\xa8=\xc0|\xfb1\xc3\x17.\xc7Z\xce\xb2\x82\xf2\x9a<\xfd\x14\x08\x86\xbfm\xe9\x9a\xf7\x06w\x89\x8d\xe3\xd8\xec>\x02\xf7\xba\x9b\x09Gp\xcf]\x89\xb1\xcf\x9c\x9a4\xa2S'DR%o\x99\xaf\xa0\xba]\xd8\x18\x15\xbc\x96tem:\x18\xee!\xbd<}\x8f\xb4\xc4\x98\xd9\x0c\x9a\xb4l\x0d\x18\xed\x91\x17\xd9\xf6\x9a\xad65\xea3\xe6H\xc0#\x81GLd\x07\x84\xcb\x06Wt\x14\xad\xe5\xef\x1f\x7f^\xb9\xc2\xda\x0fM\x05\xeb%jT\x9d\xa5\xec\x03\"\x05(K\x83\\\xbb\x15\xd8\x7f\xef\xe1\x15\x1c\x90\xcb\xdc\x13h\x96\x8cL\x95B\xc6U\xf97I\xc7\xee\x98\xe2\xae\xb8\xfe{\xf7\"\xef\xbc\xf0\xde?\x91\xf9\xae\x9ai}\x8b\xbb\x95S\xee\xce\xb8hW\xf4qcCFo\x89!\x99=\xf2\x8a\x01\xd1\x03\x8c0j\xcf\x96F\xcb\x81\xed\xc0\x0d\x9e\xd4\xcb\xfa\xca\xbeZJ\xe6$\xf3\xd8\x9c\x86\xae\x12*\xf8\x18\x86/\xd0\xb9\xe2\xc6\x9eK\x88\xe89\x95Wrl\x80\xf8\xbff\x04\x9fpU<\x06\xef\xc7\x12\xbdl{U\x9b\x99\xb9.DYx\xaar\x8a\x03.\xb9\xbe\\3r/\xa9_\xa8\xfe\x88/\x10\xd6RO\x07yg\xe8\x99\x88)\xc0p+\xc6\xa4\xb9Y\x88\x82\xc1\x05\x85\x08,(\xaf\xcdQ\xb5\xf4\x03M\xb3\xb7\x09\xb1r\x08\xa0Mb\xf4\x9d\xad\x9e\xe5\xc5\x81\x90w\x02\xd7\x84\x1c\x0f\xfd\xaaet\xda\x8c\x83l+\xf5\x02\x91\x9b)\xb0P4\x8b\xfb\xb1P=\x14\x8b4\x15\xadd\x836\xb3\x94;\x9a\xa5CfK\x8a\xfaJx\xe2\xe07\xd4\xcb\x01\xb7\xc8\x90\xd4hgzG\x85J\xf6\x8a\"\xcbu$y\xd1\x8eI\xbd\xa7\xdb\xb9\xdd\x91\xce\xad\x91\xe3\x8a\x83*\xaa\x18\x94/y\x87\x1c'\xf5\x0d\xec\x9d\xd4\xd6\\r\x999\x06\x19w\x90\xc8\xc6?6\xe3P\x9aO<\xd3{\xd8\x96K\xca9\x0d\xa0hrDF\x9e\x0eA\xc3\x98\x036\xf9\x1d=\x1d\xd6\xa3A!!\xa6\xbb\xcf\x92*\x05\xb1GGc`\xfc|G\x16!\x0c\x05\xc3'\x18\xf6D\xd6\x86)t`Y\x89\xefQ\xbfF\xc6\xc74\xc9\xad'\xc1zvZj\xcdZ\x8c\xdf\x11\xcaVd\xb2\x85\xe4fv\xb9^_r\x1d~\xfd\xacZ\xa2\xcd\x01\xee\x8e\xb4\xdc\xa6\xca\xa7\xac@<\x8aD\xcc7\xd7\x0e\xdb\xd9\xcb{i\x12\x96A\x0d\"14\xcc\xb6\xcb\xb1\xf0\xe4\x1ddT#v\x1f\xf0\x81\xea\xec\xa6J;\xa6\xc8\xe2w\xc6\x0c\xed\xaa\xaf\xf4\xedX\xa0QBG\xe5pe9\xd7K\xdc\x8b}\x06%G\x8d\x15\xf2\x04\xf8Z\x8alh!]\xaf\x05V&\xa5\xce\xc5\xb1\x89\x8c>P=g\xa5;\x1b\xc0\xbfH\xec\\/\xfa\xaf%\x80,\x96\xbd4\x1b5\x01\xac,>)A\x8e\x09\x05J8I\x02\xef\x03\x18\x8f\x15\x9c\x91\x1fb\xa9\xe3\xe7\"!\xdd9\xccM\xd5\x12\xdd\xe2\xfa\x0e']\xb2\xaf\xf0\xc0R\x9c\xb4\xa0\xf5\xba\xd4\xa7\xaaK\xb8\xc5\xb9O\xb8\x007\x10\x08!\xb6\x0a^-zFZ\x9d;\xba0\x95\xd0zn&t\x97\xb8\x9c\x12H\xa9N\x0ebw\xc2;\xc1\x82\xd1\x09\x14N\xfa\x0eU\xe3\x00\xfca.\xc1|\xfc\x1d\xf5\xa0\xe6[O+\xa3\xef\x1a\xd5\x81\xc7A\x8d\xc4sU\x9c\xa6\xbe\x14Xl\x8f\x80L\xf7=\xear\x94\x00m\xf7\xa7W\xdb\xdd2\x98\x00\xd1X y\x8c\x05\x06\xeb\xb5\x10\x08\x85t\x17\x17%\xd3\xe6\x89\xcdh8\"BGy@\xb3\x8dd\x13v\xb7\x9d\xc6\xc5k\x0d\xc2\xea\xd5\xd8\xe2Ir\xf5e\xd8\xda\xdd1\xc7t\xa4\x1e|\x09\xe3\xe1\x89\xe5\xa7\xc8\xc2<Qd\\`\x11\xa9Q\xdfC\xcaP\xbc\xeeR\x1e&\x8c\xfc\xeb|jMR\xf9\xbd\x80\xb5\x8b\xfd\xe2?\x12H\xcc^\xd3;\x81\xcd\x88\xe4\x90aj\xd1K\xb3\x0e\xf24\xfc\xf2\x8e\xa9tz\x1e\x1fq\x99\x9f\xf4\xca\x12\xee\xeeyZ\x99\xc7\x12\x17\x85\x9e\xe3\xc2\xaad\x89\xe6\xdb\x0b\x01N\x1c8Js\xb2L\x19\xff\xf8-N0R\xc8*g\x95\x92\xc6*\xa5\xe7\xf4!c\x83\xe1\xfa\x888\xc3\xb5\x18\x18\x00\x9b\x13\xb0\x10\x9dC\xb0\xdf[\xfa*=BL_\x98\xac\xe7/e\x82\xe161WEJQ\xb6\x119\xe3\xee\xc1?O\x06\xc5]y\x9c\x971q\xd2\x09\xc8\xa46\xb41d\xc1\xceT\xf3n\x06eiL\xd5\x15u>\x8c\xe2\xcf\xbe\xcdg\x9ca\xbc.\xd8a\xb9\x87#\xe7\xb8\xbb\xc9\xe5\x85\xd1\x9f\xb9@\xd4\x94d\x8b\xdd\xb4\xe4\xae\x0c\xd5[\x99\x1av\xb9P\x13\xa2-\xfa\xe7\xcb\x81X\xc7\x18\x8a\x0a\x1f\x97\x98In\xd1}\x8d\xcf\xfb\x06\x9e/\x02o\x82'x\x8d\x15\x8b\x84\x1b\xc2\xb2\xe1R\x82\xb3\x0c7\x05Q\x00\xc4\"]\x87\xb7,d\x8e\x9a\xab\xfag\xc2\xc5\xcez\xcc|\xbeKc\x87G`X\x16\xdd\xc6no\xe8M\xdc@\xd4\x9d\x8a\x817/G\xd4R\xcb\xbe\x92\xfeQD\xbb1\xc4\x84\xad!\x06\xf2\xb9\x9f\xca\x1f\xac\xc8\xd6\xde!\xfc,\xb9\x93P\x0e\x94rw\x11\x97\x8e\x1f\xc1\x04\xc7\xfe\xf0\xb7\xcd\xcbG\x0ez\xbeUy\xac\xd9q\xfby \x82l[\x0e\x7f\xd8}\x81EX\xb0\x88\xae/W\xbf\x8f\xdb9f4\x92B\xe3\x832\xcd@\xd6\x14\xeb\x07\xa3\xa6\xe4M\x12\xfb\x1c\xa8s1\x9c\x8eT\x03<f\xe7\xad\x04Z)*\x8d\xa5A\xce\x06\x83\x88}\xaf\xcd\xac\x193d\x94\xc2\xe17HT\xd0\xa7\xa9n;7nq\x97\xder\xe7\x98h\xee\xfb\x11\xaa\xb4\x132;b\xb9\xac,\x8e\x99\xd7\x90\xce\x1e\xcfT\xa5S\xb0\xacU\xd0Nq\x03\x0e1\xd8Ol\x9d\xa15J},3\xcc1I\x08\xe8\xc9f\x83\x0a\x09\x0b\xdcg\xdf$E\xc5\x87'X\xf5\x9d\xec(\x07W\xf1\x16\x8bZ\x04\xb2BBj\x15H\xc6f\xa9X\xcc/\x13\xcdL,r\xbc\x8d\xd2U}M\xbb\x0f\xd1\xdal5\x96hG\xb1\x09\x06b\xe90\xbf\xf1\xcfZ\xfd\xdf\x107\xaa\xfaa?\xb6\xc6\xbd]\xed\x0a\x0c4\xda\xcd\"\xcbt\xe7\xe0fp5v\xa0\xa0A\xaap\x94\xe4\xc7*\x1f\xd0LZ\x82\\\xd1\xdb\x8e\xe7\xdd\x0cb\x86\x87^\xaa\x83\xcc0\x0f\xe6\xb4\x8aYf\xec\x86\x04,7\xe7\xf1\xcb^\xed\xb9\xce\x81\x08\xdf\xbe\x07\x8br\xee\xf6\x9d\xca\x1f9_x\xe8g\xc0\xb5\x08e\xaex\x18\x87\x8b\xebN\"\x0c`\x13\xaei\x97br\x8f\x11\xcca\x96\xbf\x05\x9f\xefO\xac\xb2\xa4\xc2/\x86\xfaJ6\xfa\xce\x978\x90\xc0j\xca\x127\xa7q\xe3\xcaT\x8e(\x07*\x1a3\xff\xe1\xd22L9\xe8\x16\xb2\xbb$\xe7\xfb\x03d\xe6\x1f%h\xb7g\xbdn\xe0$\x9b\xd1\xf8\xb7\xa4\xeet\xb6\x06\xbe\xfe\x98\xe6\xb0\x0d\xd8\xf4\xf6_\xe4\x08\x8fo\x82},\x88[(E_8\xb4\xcfl:\xdeS\xef\xdc\xb9\xc5\x954\xb3\x18\x81`\xf1\xb4c\xa5\xeck\xd6\xad,\xbf\xc82\x1cEr\x86Y\xfc\x90\x94\x89\x9fnM\xf7\xb7\xd2)~\x88\xabg}\x12r\x19\x9d\x0f\xbbx\xe0\xde\x06\x8fH\x05\x13\x82\x0e\xb2LJ\xff\xcbG\x91\xe9\x19F\xffV&\xd7\xa8\xba*\xab\xeb\xe7\x09Y\x13\xf7\xa8UR/\x89\xae\xaa}\xd3l\xee\xb2\xef\\\xef\xf4\x7f\xfa6X4=a\xa8\xcfaMhe\x90\xfc|\xf4l\x82\x94\xde\xf7\xd4\xf6T{/u\x97\x10\xd0\xcc;M[}\x0ai\x9c\x98\xbe\xa7\x9d\"%\xf7\xbc{\x1e\xe5p\xb2M\x0c\xf7\x03l\xb3P}M(L;\xa8S\x93Ua\xd7\xb695Y\x07\x05Jna&F\xdd3\xb1\xdf\xc5Nk\x08\\\xa4\xdfK\xd2`\x8f!qq\x14\x0a\xd25:\x03L\xfa{\"]\xd3b\xf3\xeb?\xc5\xca\xcc\xb1H\xe5Q\x05\xa0\x95b\xb97\x1f\xd8~\xd2T\xd7\xc1\xce\xe6\xe2\x01\x8e\x9b\"\xf9b\xcc$\x1d\x89R\xb1j\x0d\xcd#y\x0f<4\xf7\xb1\xe8\xf2Z\x02:bb\x81\x97\xb1mo\xc9\xdc\x91-?\x88+\x17A\xf5\xeb?\xdc\x121\x17D\x96\xc2\x0db&\x06 \xe5\xd7l\x0c]\xeb\xe1`\xd8\xc17O\x80B\x04\xd1\\\x91\x00\xe4\xa4\x9973\xe0\x9bh\xa6\xf1\xe4\x1c\x0bL\x80(\xbf\xc4\xd3+}]\x87n\xc1\x92\xedHU\xbe\xe0\xc2\x0b\xe5>M\x1b\xce\xb8\x94N\xde\x92\xad\x8c\xa06\xf9\xa5\x0fJ2\x08\x8a\xd1\xa5\xefO\x88\x04uL\x9dO\x1d\xca\x9f[\xb0\x7f\x17&k\x9fI\x14@_\x195V\x97\xaa\x0e%\xf1\xd4R\xca\xc1\xe1I\xf6F\x8e\xcb\xac\xbd\x09\x93\x8c\xbe\x043\xc8\x17\xe4\xdb\x06\x19\x95\x15\xe3u4A\x1e,h\x060\x9f\x06\xcf@4\xc3\xca|\x0eQ\xe5W1\xa7\xd3\xb5\x046\xed\x8df\xc1\x89lo4\xe2\xb4\x04\x12\xfdJ\x9e\x11\xcc\xf9)\xa4\xd7\xf5S\xce4\x11\x0a\xb6\x136\xb3\xfaCJ\xdf`X\x09d\x82Y\x85<\xb2\x08\xb5\x09\xdd\xe5\xc1\xae\xa5\x08\xd8l\xa1\xb7\x16#\xb3}m\xc8\x01\xd0\xf9t{.9\xa0 \xbd\x9e\x93a\x92H\xba\x07\x8d\xc9\xe1s\x804^\x10gy\x17}9\xda\x1c\xf2\x04G_F`\xc8\xd6w\xb5\x0c\x1f/7*\xbb\x82\x18\xaf[xu\xe8\xc4\xf2C\xb3\x9f\xdfz\xb3\xf0B\xa3\xa8m\xa8\xfc\xb5w\x90\xb9$\x0e\x81Q<\x8a\xc3\x84o\x94-I\xbf\xdc\xea\xf31\x12U\xe6AM\xea\x04M\xc3\x99\xe2 \x08\x9f\x83\xa5|\xdd\xef\xf8:Ht\xf8d\x97\xb5\x12\x10.8<\xbc\x03\x09\x0eEm\x14\xfb\x13\x14\xe6\x1d:\x0fbSm\x19\xe5\x15\x06\x0c\x92\x13#G2VU\xf0\xdb\xb1\xa1\xde\xeb\xd4\x92\xa1\x12\xc2\xff\x8e\xb0+\x15\x07\xa6\xc8\xf1^P)*\xfa+\xb1\x18\xa5\x15J6hR\x11\x02%\x13\xaee\xc0\xbc1\xbd]\x02\xd0\x1aQ_\xb0\xdf\x89\xc1\xa9!\xc1\xe1\x87\x9f\x0d`-\x16QdZ,\xe8\x10}\xbci\xce#l\xa0\xcf\xe68L\xa1,\xeeK\xf8X\xb4(\xcaR\xc2\xb1\xbc\xc44\xce5\xe9\x84z)5\xc5HFL\xb5K\xc8^\xbc\x15\xc4F\xc9\xc6x\xb2\x11?\x1c:\x1e(9m\xb1\xc7&\x82\xe0\xcbAB\xc6\x00 C\xe9\xefk\xfda\xce\xe5\x87\xff\xbd\x95\x02lb\xd1\xde\xe0\xfa\x97\xed\xda\xa7bE\xd0*.\xd3v\xf2\xf6\xc7\xec`\xda\xee\x8f<>\xdcJ\xd1*\x8c\x0c'\xc4}&\x86DZ\x01\x80R0\xf0\x1b8\xac|\\\xbd\x81\x9bw!\xeb\xc1_\xbe\xc82^kw\xdc+\xf8o\xa4\xb6r\xb8m\xb2\xaf}\x8b\xeb\xc0\xef\x8a]\x80\xc7)#\x16\xbc\x1b\xc4ia\xad\xadd ,\x17\xc9\x84\xc1\xcf\x0b\xd2\xa1\x0a\xdb\xf4#\"\xb29\x9f\xd3\x93\xda;\xcf\x04{\xa0\x98`\x8f\xeaw\xf5\xbb>L\xb0\xcd\xe4\xcfC\xdc\xdaI@k:e6\x89^\xb5\x80\x10m\x02\xed\xea\x09.\x0b0t\x91\xbc\xa22d}\xa7a]\xa7\\n\xfa\xd0\x80 \xdb\xc0\xb06\xf6\x80r\x92\x9a\xe9\xdd\x1f(\xb4nG\x9a\xea\x9a\xb8F\xe5\xa0\xda:\xa4\xe9^Z\x05\x84\xf7\xe1\xa6P$&\x08o3B\xd7A\x9e\x80\x1c\x9a\xd4o`\xc1WQ\x9d\xe92\xfc'8\xae\xa9\xe8\x9e\xbc\xe1M\x8c\xb3\x9bY\xe4\xf3\xb9K~\x91W\xfe\xf2\xecE\x7f\x026\x1d1L\xd1\x02" +
+	"\xc4\x844\xe4\x8b\xd1PA1&I\xb6$\x18\x9b\xf8V\xa5\x1c\xc6\xae\xfa\xc2)I\xe1\xce\xe9\xf2\x0a\x96\xf9=%\xe9\xc9y\xf3\xbf_\xca\xdc\xfa\x15p\xa4u\xd6F\xec0\x00\xc4\x14\xe3wK\x0b\xd2\xfe\x1a%i`\xe6\xc9\x10O\xc0!U|\x00\xb2\x15\x14\xef'3\xb8\xdd:,\xd1\xf9}\xd3\x0b4\xb0\x9f\x9c\x9f\xa7\xb0\xa7gn\x0b\x04F\xf8\xc6P\xe8\x93\x17\x86\x02\xe0\xb3tf\x88\xa8\xfb5tQ.1\xaf\xa4Z\x16--\x05]\x83\x87\x1cj4\x17\x1aR\x8e\xac\xa2p\xbe\xcc\x06\xf9\xa2\x11\xe6\x879\xce\\A\xfa\xeb\x02\xddB\xa7\x99\x1em\xca\x15\x84\xf1\xf8M\xcf\x8b\xe9\xafb{`\x00\xd1cjGD\x88b\x19Qjef1\xc5[(\xb7jl5\x06o%\xc7\xe3)\x842q,\x9c\x80\xf79\x16\xac^\x98q^\x02\xfb\xc2\xf7\x1b\xd0\xefj\xd6F\xc0\xa7h\xfcdnT\x8e \x96\x8b\xdfA\xe9\xdfHB5\x16lKP2\x92\xf9]$k)\xc5\x1cg\xaah1.\x7f\xe0^\xd6K\xa9\xb7\x16\xdb\xdc^\xc7\x95\xcf\x81qd\xb3}&@}\xca\x11\x1c\x10\x8e\xc0\xdb5hF\xe1\x14\x8e\x8b\xbb\xccn\xe1\xb8\xf6\x82\xad\xfc(V\x04Lc}\x1b\x9d\x09\xa2\xe7\x06\xb0\x0b\xf6es\x7f\x85h\xe8\x89\xa3\x04s\xb0\x08\x8by\xee\xd0fJ\xf9\xc1\x13\xe2U\xeb\xa1\xc8\xb3\x01q\xe60\xbb\xa0\xe6\xc8\xe8]Bu\x87\x9a\x8fcqI\x99\xb0E\x18\xdb\x12d{\xa4\\\xd3\x03\x86\xa4%t\x98\x9c?\xcb\xd4n\x19\x96J\x0e\xc9r\xd8]\xd9\xca\x13M\xda{N\x07%\xc8\x03\xcd\x96\x16\x87C\xc8\xb2D\xb31V\xbd\xf9\xf2\xe8\x17M+L*\xbc\xc4p\x1f\xda\x97\xd9\x93\xe7\xc2\x97\xd4\xf3Uc\x14\xbf\x12^\xad~\x07\xe5\xa7\xd3\x0dH~\xa1\x94]0@C\x9bry\x86\xc5y\x05\x04\x03\xf7\x11\xad\xd8\xc3\x89\xf6\xa24\xd1oz\x9a\x90\xc8t%\x98t\x80\x14\x92\x13\xf9\xa2\xd4\x9f\xa6\x8c\xd0\x8f>{t\x19\xbb?\xc4r\xb8\x93\xb4AN\x84\xd8p\xb8\xe8G\xd6\x8c.\x99\xfc\xcc\xd2\x0f\x9f\x02\x84Z\xf3\xf4b\x9f+V\x0e\x05\xd9pB\xc9\x9b\xf0\xaf\xa0K\xf7V7\xa6\x95\x90\xd2\xeb\xb5\xdd\x95K+\xf8f\x8a\\\xa3\x9b\xf6h\x91C\xb2B\xdan$=\xd5\xac\xbe)\xc9\x0c\x94<\x80\x00\x8f\x92\xc7\x02\xb9\x1b,\xc4X@p\xa9\x0c\xbb\xa4\xfe!\xea\xf2L\x88\x06b\xc0R?\xc0WE=V\x7f\xd1\x1e\xfa\"r\xbf\x12=\x96\xeb\xffz%\xb9\xeaE\x09<{\xc3\xac\xeb\xdfyo\x9a\xef\xb7L\x8f\xa5\x0f\xa0\xb0\xff\xde\xb3\xee\xa0r\x0b[\x18\x18\xe0\x9e\x907\xa8rL\x7f\x89z\xb2zP\xa2\xeb~\x0fy\x91M\xf8\xbb\xf7Z\x05\xa77\xec\x95\x1e\x01\xfb\x0a\xf6\xc0\xce\xc1\xc5\x8b\x9f|\x94T\x0d\xaa\xc5$\xa3\xd8\x05\x88Gd\x9f\xdfT\x04y\xbf\xe3\xd5DZ\xba\x0f\xc9,\x93\xe2$\xc9\xc0\xfaG\xcc\xc7#\x0e^\x03 \xfa\xe3\xc6\x86\x07\x89`\xb9\xf1\x89\xda\xbb^_\x8b\x80\xff%\xb7\xaf\x85A\x07\xa6\xd8\x05\x1c\x9f2\xae\xd1\xfb\x81\xf7Z3\x856|\xc1\xf2\xa6\xc9\xbb\xee\xc0\x14\xe0/\xc2\xe3\xae\x82\x10H\x99\x10\x90\x8c\xbd\xfd\xe3\x81\x15>*\xe2\x97\xfe\xab\xf90Ola\"=\xf0\x0d\xa5K\x1c\\\xc3\x0f\xb1\xb5\x8e_\xd5\xa0kN\x9b\"\xd9}R=\xd9\x90\x1a\xb82\x1c\xe0\xfe\xa7\xe9\x0a\xe3\xc1c\xbc\xb7\x1e\x17y\xe9%8\x9e\xcb\xa6\x90[\xf0e}\x95\x9b!\x89p\x10wu\xba\xdb\x8c\xfbZ_\xc3~\x11=\x1e\xed)\x84\x89\x84\xbcz\xef\xd7\xee\x1bsc\xf5\x86t\xc8\xa2\xa8\xc2\xc7\xd5Yr\xee\x1d\x85r)\xd7\xe0\x9cMD\xcb\xf9\x81\xfc\xfc\xb6\xd9t\x08\xe4\x90#\xaa\x90\x84N\x05\xd4\x15\x94\x8e\xf2\xb1\x0c\x83u\xec\x86\x0c@Q\x1a\x9350\xac\xe8\x88\xa3}\x895\xa0w\xfd\x06R76\x83\xbch}\xcd\x97% u_\xf3\xe2\x1a\\\xf0\x90.\xd0\x15J\xe6_\xb29\xac\xffT\xff\xc8\xfc\xd8}\x04k\xb7\xf2\xde\xe1\x92\xfc\x1b$b\xa0\\\xf6\x91\xee\x0d\xbc\x85$\x8d\xe5\x89\x13Q\xb4\xe2\x15\xba\xf4\xbaUp\xe7\xd6wp_\xae5[\xd2\xdd\x1e<\xcb\xc9\xeb\x10\xe8\xee\x16\x9f:\xf7\x02\xd2\xa7K#.\x89{I1?\x0a\xa2\x0a{B\x08@\x92\x94\xc35?\x88\xee\xf3\x05\x08R\xe9\xb5\x8eR'\xd7\x12\xf1\xb2\xb1\xb2\x1e\xfe\x982\x96\x87\x95\xf7!\x16\x09!\x08\xd3\x966\\\x87\xc1CSz\\\xaa\x09\x0a\xa5\xf2`f\xd6SE J\xe8c~`eILI\x90\xb0\x1f\x12\"\xccB{\x0cPHQ\x95\xbb\x0f\xc34t\xa26G\xf19\xc6\xea\xd0\xc8R\xd5\xa2\x8da\xd75\xec\x8bh\xb9\xab\x847<\xe3\xbb\xc1\x1ebK\x0fM\x98B\x06|,\xe5\x0e+n\xf9\x8c\xc5sb(\xef|\xe1]\xf5\xc2\x89^M\xb8Y\x08\x8an\x05\xb5\x01\xed\xd2\x094\xb9\xcd\xb4\xc3\x0e\xfeE\x05\xe5\x86x\xcd\xf3A\x0b\x9b\xe7%N\x0f6i\xcd\x01y%m.Gl`H\x91G\x01\x016 \x8dS oQ\x18.\xa1\xa3\xd2.-V2d\xef\x9d\xae?\x98\xacG\xfcF\xf8\xff\xd6c\xb2\xaaS`F\x09\xb3\xf9\xecft\xaf\x85*\xd5\x9d7\xc1\x99\x08\x1d\xb9\xd5@\xc4k\x97\x80tY\x89\xf9\xc7Iz-\xb5\x0a\xb88i~K\xd4y\xfa\x15\xa3\x7f\xa8\x1aJ&\xdd\x0a\xbanX`\xb9\x96\x13\xdf\x85\xbb\xf1\x8f\xc3(\x14\xcc\x0e\x0bC\x12\x97)\x16\xcd\x96\xbc\x99\xb0'M\x18=\x93\x8a\x9a\x0e\xc4\xcb\xae\xefM\xe7\xc7+\xf4\xa8\x98h\xf4\x06\xf8\x16\xae\xb5\\\x97@\x0fLL\x14#\x9a\x17\x01;\xc0\xf2>\x81\xca\x87\x99\x81\x04Z#3\x04\xeb\xab\x95\xf1\xacOk\xb3\xeb\x9d\x02\x14\x0d\x15(\x1br\xc1\xa2\x0du%\x97\xf0\xa6\xb2\x18\x1e\x8d\xa7J\xcd\x08\x888Qz\xf1\x9fx\xdf\xc7\x09\xc3!`\xff\xf6Z\xbc%\x1dO\xef\xc9\x1e\x94\x03\xa9\xe9\xfe\x00\xca\x19\xa7\xaa\xa7\xc8rR;\x8d\x9d\xac\x16\x0d\x07\x18\xb7\xe3\x90\x94k3[;\xb5}h\xc7\xadY\x12\x19\x0a\xed\xbe&\xafIl\x05\x802\x0c!E\"\x1c\x89Z\x91|\xec\xa3\x02_E\xb5\xff@EE(?)'iHP\xd2\xcb_Q#\xd3+K(\xd3%Ui\xd4#\xe3\xd7\x1f\xad\\y\x1d\xd5D\x9aS\x9bG+\x1c\xb8\xd5\xf89T+\xb7_d'\x11\xbd'f\xd8\xd0\xb2\x95\x9c\xfc\x12%+]S&\xcd\xa49\xacx\xc6\x84\xe8\xc0n\x11v9\xf2g\x190\x87h\xf2\x0c\x1f\xea\xfd\x1b\x12X`\xb6\xe1\xc7\"\xca\xce\xc5O\xecM\xbb\x92\x94\xae\x08B_\xfe\xbc\xeda\x16o\xf5_\xb9.%\x05\xb5\x0bTVL\xcb\xcaZ\xac\xd2\x0b\x18\xe7\x84h\xec\xcf\x86\x1fOi\xa0\x19\xf8Y\x15\x1dO\xa1<\x14V#CL\xd4At\x8d\x0b\x89\xcej\xbdFW0I\xe1\xd9\xe29\xfa\x86\xceY\xa4\xc1\xd2\xbd\xe3\xf1C\xdadX\x1e\xbc\xc0\x1f\xf4\xd1\x91\xc4\xdc\xc0\xf7?\x8b\x8e\xa4\xc3\xed\xdf\x12!\xe8\xd4Ri\x90\x1b\xe0y\x8a\\\x13e(Y\xeeT\xecs\x93\xcdh\x85~\xed\xd8\xddY\xbf\xc6\x8c[\xb44\xc4\x85K)8d\xda\x7f\x17\xf9\xcf\xf1a9\xd8\x89'X3\xd5\x8f\xcad\x0fst<\x90.-\x8cLh\x90\xae\xff\x18\x7f\x14\x03\xc5\xc8n\x14\xbb\x04\x0c\xfe\x02M\x96;\x02\xa6K\xa4k\x0cv[\x94Y\xa8\x96\x05\x99\xff\xf7\xe1?\x0b\xc6&\xd2\x90\xb1#\xa7\x11F\xaa|\x091\x8dV\xb8z)\x82\x19\xf4\xe9\xdfi\xe2L\xbd\xc9\xe8J~\xf7\x8bb\xf1\x92\xf0Jt\xf1\x97T^\x7f\xd0O&\xc8\xa22\xd2\xfa\x8f\x12\x03P\xddXl?3OOT\x8b\xf1\x0f\xb18l\x042&\xb6\x8f^'TSAU$\xbe\\\xdb{\x84)\x8a\x10sB\xbay\x85l\xbey\xa3\x10\x83\xd8\x99\xff=?\xeb\xe6\xfd\xf4\xdb9\xaa\x03}\xb6\xd8D\x97j\x0b\x16$\xa2.,\x1f\xb5\xe3\xe2Y\x97D\x1c\x16nlH\xaf\xc7\xb2\xd2\x956\xe4\x0b\xaa\x89\xa48&\x1b\xc8F\xcc\xd3\xe9\xc5\xc2\x1bH\x04\x98qJ-|\xb9\x89v\x00N;6\x9bRE\xcda\xc4\x9aK\x91\xa8su,bi\x0d\xdc\xd50L\xb7\xa2\xec6\xe3\x15z%\xd5Uu)4\xf8\xe9X9\x86\x03\x86L\x07c\x8e\x04#s\xce\xcf\xbe\x0cY:X\x83\xb2\x87Re\xb1N<\xbcn*\xa6}\x19$\xad\"r\xd3\xd5,\xddHmj[a\xb3D\x89\xeb\x98H\x83\x0aCO\xee\xa8q\xe6\xbb\x8c\x00\"\xce\x0eK\x023>\x0d\xefS\xc0\xfb\x9c\xaa\x861\x0d\x96\x1d+\x1c\xa3X\xcf\xa0\xdd\xd1\xa7\x87p\xe7ERI|\x0d\xb1\x8ca\xfdK\x80p,\xe1\xb2\xb0\xbfHA\xa4\x12\xd1\xf8D\x09\xe6\x0d\x13U;\xc3\xa4\x00\xa4\xfa\xedZA\xb8:%8\xd5\xfe\x9b/\xed/[\xdb(\x0f\x05DQ\xd0\xfa^\xc9\xc9\xa2\xe2\xe9\x06|\xfc\xa7L\xba\x8e$\x16\xfe\xe0'\x9d1\xd1?h\xa6\x98\x1e\xc5ht\xdeL%\xf0\xb8\xdfK\x9cx\xc4M\xf1W\x10l\xac\x97\xcb\xcd\x86\x0c\xfb\xed\x1a\xd9NZB\xf5\x13px\xcb\xda\xf5LU,A\xf2\xc7\xba\xe3\x12\xfbg4\x95*)V!\xf9B\x8c\xfd\x80\xef\x9a\xf8\xdb\x13\x9e\x8eV\xea\x83\x97\xd6*\xaa\x06\xe0bcn\xb4\x9d\x1d\x8f\x13\x83\xe5\xf7@\x84\xc7&^\xc0}ZA\xe45#g\x9f\x19h\xdf\xe7\xa0\xd2\x99\xec\x10\x16p\x06\xfe\x83x\x09\x80+\xe0\x8e~\x04\xb9\xfd\xb1\x048GG\xf2>&H\xe1\x81\xd5\x94\x92\x87\x0f\xdc\xa8\xf5\x15^\xc8\xeb\x88/5I\x80V)1\xc3\xfbJp\x1e\xcb\xda\xb7\xc4\x0f&E7@D\xb9\x0d,\xd4\x94\x9f\xba\x9c\xee\xcb\xa7\xf2\x16\x1c\xc1t\x8e\xeb\xde\xa3\x16\x8f\xca\xda\xf1\xa2\x99\"dBnY\xf5\xc8\x03\xddgd\xd8\x7fm\xb8\x0c\xa0Cn\x10+\x92P\xd7M{\xe9V\xcd)\xe33\x94x\xbd\x96\x0b9N\x89\xf7\x9f\xb6-\xf7\x93\x15\x9b\xc2\x87\x97!z\x8c\xbc\x8f:\xf8\xe0?+e4\xcc\x8b\xdeR\x16z\x09\x87\xd7z\xc8a#)\x8d.\xc3\xd9$\x1b\xd7\x0a\xe1<\x98\x87\x93j\x11\xb6\xfaG\x06a\x15\xd2\xab\xf1\xd2\xb8\xbf\x042\x1c$\xad\xf4:\x96\xb1\xe7\xb3A\xa0w\x0ez\xc7vMi\xbd\xae\xd2\x0d=\xa6\xbav,$|\xd4@\x095\xe2p\x99\xfaU\xfct\xd0L2_1i9\x93\xceS\xbaH\xcc\xaf\xd0}1\x17\x00\xad\x9f^\x04\x9b\x04\x19\\F\x7f\x13\x92\xc5\xabT\xbd\xdd2\xb5\xb3LD\xc4N\x1b\x19\x9a\x8d\x94\x0e\x80\x8fq$Y\xc3\xf9\xfa\x09\xe1\x92\x9d\xa3\x84\xe1Q%~N\xa8\xe2frT\xc2Z\xf0*\x95U\xc7'Q\x94\xedj\x97\x1a.8n.\xc4\x1cS\xe5\x92(_yu\x09D\x0f\x07\xd2\x922E\xd6S\xe5\x1b(\xd6\xc6\x13\xca\xe5'my\x13Y\xa6\xc3\xfe]\xd8\x03\xc8\xe3\x9c\xe6^\x06\xcd\x09\xa2T\x18\xe5'C\x19\xe9\xf3\x18\x97\xd0c5r%O\xae\x0cD&-V\x17\x8f\x89\x01v\x87Y\x07\x134\x9d.dH0\xf0\x9a\xed\x8ex\xe3?\xeb)\xb1\xbb\xa5\xa4\x9f\xad\xf2@\x0a=\x97\x0f\xe5z\xbeF\x03\x8b\x1cu\xeed\xd9\xacsuw;G4\xb4\xbe\xce\x12\xd6\xe87\xbe\x0bc\xcd\x08J)\\\x18.n\x83\\\xf6\x0d\xb4\xf6\x0f\x84S\x9as\x84\xfc\xe1\xd8\xac\x0b\x7fWr\xe2B0\x10\xe2\xc4c$D\xfd\x13\xb5\x0b\xcci\x03\":D74b\xc3\x96(\xf2\x0b\xe3\xfe\x8ej\x8bBh\x02C\xd0USD\"\x1f\xa7EY\xf1Zd\x98\"\xc8'\xaft\xad\xeam\x0c\xd6l\xfc\x88\xa4,\xbd\xa4Xv3\x0bD\xe4+\xad*T\xc0\x7f\xa3\xa9\x86f\xfb\x90\xb2f\x81f0\xd8\xdei$\xa05\x89\xba}\x99\xba\x9d\"\xc0\xd7\xdf\xa4\x8f2\xb4\xfb\x9c\xc3\x9e+\xd0\xd8\x82\x9a\x0c\xd8H\x81\x93|\xd2\xcb\xeb\xde\xdd\xebda\x93fP\xdb\xec\x1b\x08\xfb\xe1)\xdc\x96\x1ac\\g\xce\xe5\xb0]K\x82\xd9\xd4\x09\x9a$r\xd95\xd34\x11}\xa5%rn\x05z\xe8\xf24\xa3Z\xb2J\x10\x0b\x0d\xaai0>\x05`\xfe.\x10\xf74\x918\x17\xc8\xbb\xe9\xe6E\xe1n\x99>\xe8\x811\xe8\xd0\x92V@\xa8A\xe5O\xaa\xfb\\\xc7\x99\x90iK\x82l!(gE\xf4\x07\x92LL\x11\xe3DX\xe1\xdbp\xca\x0821|\x1e\xc0\xc8\\d\x02\xc0\xe7\xee\x9f\xdaM\xd4\xb4h\xfb8\xc9\x13ieY\xe5\xfa|\x00-\x91\x15u\x13r\x11-\xac\xb9%U\xbf\x13m\xa1\xf0\xcd;\xa8\xb1W\xd0\xa2\xc6o\xc4<d\x91\x0e\x8b\x04\xcal\xdaGS\xf7\x09\xd9aCJ\x9f\x08D*M\xf3\xcd{\xedw\x0f\xfd\x90\x9a\x12\x9b\x9a7\x04\xf7\x13#\x91d>\x0aZ\xb6\x142\xb5\x10\x1c\xdc\x85H7p\xe7\x9f\x1f\x9d\xcd\x1e\x9fr\x98\xf8\x89\x06\xf1\xdc\xca]\xa3'W#\xaf\xd5c\xa8\xf0\xf1\xe3\x06\xb5\x96\xbd\x17>\xd7#\x85E\xd1i\x17\x0bB\xee4\xd2\xf7Y\x82\x7f\xad\\\x8d\xf8\xe2?dS\xa5\xa7H\x04\x0a!n\xe9P\xc2\xe4u\xef\x14\xc3\x14\xe3\x86\xe7\x00\xf4\x85Z\xb5Be<\x84O\x1f\xa1\xeaA\xdc?\xd8\x98h;FE\xb3f\xb0l\xecD\xbc\x94Lx\xa7t\"I\xaa\x82c\xbe\xfaD\xd8\xed\x07}\x079\xcc\xc5\x06\xc3,\x85\x04\xe8\x19\xfa%\xa4\x9d\x14\xc2\x0d\xa0F\xba\xa9\x85\xf6\xb6\xba(\xea\x88@\xb4%\xbfB\x99\x98\xf8 \xf7\xb0\x13\x8e\x05Go\x8e*>\xa1\xd4z\xcb\xe9\xc7\xde\xea\x18\xed\x84\xf4\xe4Q\xb7\xe6<5\x89b\x92+:i\x8a\xc0\xf8>fQ.\xe7N\x97\xec\xe3\xc9\x8c\x91\xfbg\x85j\xc1\x9d\xf4\xb1)\xf4\xb7\xe2\xa0,\xc8\x8d\xbeL\x13\xd33y\x82\xa4$\x0d\xdf\x91J>@u\xe8hd\xed\xd2\x9e\xfa\x18\xe0\xcb\x10\xe7\xf2\x15\xd7\x89\xea+;\xef\x7f\x14^h8\xed@\xba\x05\xf9\x02\xb5\x9e~\x9f$\x81\x0c\xe8\xb1c\xa29mJ)\x8e\x0c\x98\x80\x18\x1aB\xde\xca+&:\x1c+\x1b\xc8D\xef\xed>\xcb\xb6\x893V\xbf\xe1Ur-yI5\xc3~\x7f\xf2\x91=\x85\xf9\x80\xce\xcd\x0a\x0f\xc2\xb6M \xb4\xa3\xc0=\xf68\xfer\x9c\xa1\xc0\x1f\x8f\x93?\xdeDVbDJf\x9a\x8dR\x04\xfaA|!\xfe\x1d\xff\x13_-\xacF\xab\xd4\x8b/\xdf\xca\x93\x9f\xe1Y\xea\x09\xb0\xdeS^\xa3uq\x80^<\x0c\xc4\x11M\xb7\x1b\x81H\xf1\xdf\xabz%\xbelt\xb7L6\xf75\xcc\xeb\x0ak\xc5w\xc0\xe3qC?3G2\xd1m*5\x9d\xa7\xc8\xe7\x1cv\x8d\xc1\x9f\x831_{L\xaauzl\xe10\x02}a\xa9\x1f\xa5?`\x934D\xbag\x90\x0f\\;\x15T\xfbZ\xccE\x91.\xd2%\x91[\xa1d\xd4\x87\x0a\xae\x96\x1b\xa1\x1c\xf6\x87\xa9j\xc4\xa50\x9c323L\xf3\x9d\xe8\xa19@|N\x0f\x94\x1emo}\x071\xd1\x84\x08qd\x09uf:pr>Ma\xd1=g\x12\xddS&\xab\x9a\x9bI\x19\xcc\x1e\x82\xea\xf7x\xf5\x18u:\x0e\xe7\x1dI\xc3v\x0e\xe9\xd6\xcf4\xa4\x96\xce\xf8\x9f\xd9\xa0\xd2C\x07\xba\xc7\xa5\xe5h\x16\x82\xd1\xa4\xf3\xa5\x07}\x13\x0dV-/\xa2s\xa2\x89\xa2X\"\xb0\xd7\xb2R\xf3\xe4\xd1H\xfa\xd2\xc1<M\xdf\xe3\xcc\x18|o\xc2l\xb9\xef\x8d\x00t['\xc4cUK\xfe\xeb\xf2\xdb\xf7-;!R\x0a\xdb\xecJ\x96\x86\x1e\xd5\xb7\x9b\x19l\x06I\xc3*=\xf6^zg\xe4$Q0\xae\xf0g6/_\xb8\x12\xa6L\x09+\xd3\xb8\x1d\xac~\x02\xf4\xda9h\xae\xa4\xca\x91\x02\x81\x17)\x81\xa4\xd2\xedu\xd8\xc1\x16\xc0(\xf1M^p\xc9e\x02J\xe1\x86\xb8K\xde\x0a\xe4\x91x\xd2\x09),.\xa1I\x90\x0e`\x92\xbb\x0f\xee\x90H\xf2?@7\xf2\x82[e\xe2\xc2\xd5Y\x97\x08\xed\x8d\xdf\xf0\xa5\xa4Y\x8e\x17\x86\xa2T\xc7?2o\x03`\xa9W\xbd.\xe8\x01\xa8\xae\x1b p\xe9\xe36\x1a\x15\x99+\x99ZG#\x03C\x18\x06\x1f\xfan\xec\xf1\xa1\xc1\xbe\x85\xa7\x1bW$\x9aY\xd3\xf5\xf4\xc7l\x1fC0\xd2&\x107\x0ftI,\xec\xb0?%\xd11|R\xa4Ng\xe9\xc4\xaa\xfa\xa9\xc2\x00|l*9\x94wDD\xd2A`\xbf\xf9LmF\xa6\xba\x7f\xd0\xd6\x16\x9bM\xc7\x99\xeeEA\x94u.\x11\x173\x1f\x10\x91)\x83'" +
+	"\xafI\x98A\x9d\xa8.\xda\x0a\x81\xa6%\x9a\xcf\x1b\xe1$\xe8\x9a\xc8=`ot\xe7\xcb@\xed\xbe\x97\xb4F\xa4rl8\x92\xd7/\x81\x84\xbc\xa7\xb2\x8f\"d\xb3\xc3OH\xbab:\xa8\xdf\x901Yh\xa6\x84\x944\xa8\xd3l\x1ef\x09<,_\xa1\x147\xc7\x15'\x13\x9f\x95\xa5\xac\xf2\x0e\xc6\x99l\x0e\xd6\xa0J\x99\x05\xccm\xb2\x19W\xe2\xbd\x86\xfa\x1eH\x9b#\xd3\x16\xe45\x00D\x0d\xb6\xf5\x03\x05HAX\x8e\xeb\x7fF\xb3s\xdeCE\xa9[\x05\x92\x9eK\xd4@\xe7b\x0f\x90*\xef\\\x97+_\x09\xd5\xf9\xb2=\x9d\x8c\x0a;\xe0\xd5n\x94\x1a\xd0\xab\x10\xc0\x11\x17\xa2}4\xd1\x10\xc5\xd6khJ\xec\xd4gH\x99c\xee\xe21c\x15\xb9\xa1\xe7\xba\x02\xf9\x8c\x95\xa6\xf4Wr\xd4\xaeP\xbf\xa4\x8e\x02\xaf\x13\xcb\xdf\xe7(\xe5\xf2N:\x95\xf2\xcb\xb4L+/O\xe0\xdc\xcc\xa5\xb1\x8f]\xbc\xbdd?\xb6Z\x89M\xbd\x85\xb4$\xf4\x8e\xd2\xca*\xf8\xa9\xba\x08\xb8R\xf1\xc6S>\x8aZl+\xd6\x9c\xee\x9ar\xad\xfb#\xd9P\xde\x82\x000r\x878\x85e\x89\xae3\x1a\xf6BU\x12\xb9V\xca\x0c\xd5*\x81\x93.:\xe2G\xd1\x10\xc1\x8b\x0d\xa8\x94DI+y\xeb\xce\xbe\xa0f\xbe\x11\xd4\x8d\xf5\xa64%\xbevVJ\xcfY)\x07\xb65O\x90\xc7\xa6\xb6V\xf5~\x18\x93\xfb@\xdd\x95\x17}\x98\xb9\xc6\\\x93E\xb7\x03\xcd\x84j\xa9\x96m\x19\xf0P\xf1D\x8au\xcan\x9c\x1c\xcbW\xfd\xa4\xb2-\x8fPS\x11\xa3\x89j(>\x11<\x1bV6\xed\xc9eAw\xdc\x97Q\xb1a\xd0\xa3\xb4\xb0\xac\xebGO9 \xce\xd8\xf7.\x0f\x87\xab\x1b2\x16\xccw$m\xa1\x90\xff\x900\xadoc\xb7c\xd9\x8c50\xcbi\x03\xec\xdb\x02(\xf1\x89\x9cq\x14\xaa\xb6\xa8\xcd\xb7\x81\x04\xe7A\x17\xc2}\x09{\xe0::\x03\xa27w\xcc\xe5\xd9\xa4w<E\xe0Y\xae\xbcU\x7f\x98\xfb(U\xf3\x0d\xb3\x8f\xd3\xdaB\x1d)-R\xe7\x9e\xdbw\x04\x87\xa1\x89\xb7$\x1fLiNN\x0d( qD\x16\x92\xf6\x17\x83\xce\xc8\x82\x13\x9cF\xc5\xbad\x03\x1f\xae\x9b\xc95&ZJ\x9d\x08)\x19\x1d\xaf\xee\xcbI\xfb\xcd\xe4\xb7\xc4}\xe8\xc9p\xc9\xbb`\xd6h\x8a\"<\x08\x9d\xeaM\xb2\xe99\x99\xa1\x13\xf4\xd3\x9a\xe3\xe6\xcc\xc1Q\\G3\xa7\xd4V\x9f+\x7f\x9e\x97\xa29\x94R4\xf5C3m\x8e\xeb\xc5\xda\xb9\xed\x14\xa4Y?\xd9\x9fK\x90&\x86\xe4E\x08r\x17)q\x9d\xea\xc7_\xafUH\xfd\xc9\xf4\xd7\xc0k\xa3\xd0`\xd6\x0c#:\xf5s\xf7\xa8\xd5\xe4\x1b\xe1H?v*\x1cq\xeb\x06\xf8q\xae\xae.\xeb\x80:\xe7N\xa5\x17\x07\x8e\x03\x18\x03\x118\xb4]q\xb3\xe6[\x09\xb4-\x18\x80\xa7f-\x092\x9d#\xb3\xf5\x1c\xe6\xd9\x0c\x01\xf7B\"\\\x01\xb2*y\x12Ys\x04\xf3\xe7]\xe89\xe3\xd5\xc8\x88a\xa6\x0e\x1aT\x14\xf2\xc8\xae*\xa3\xd05P\xdcK\xf6\xabcHM\x9e\xa7\xfa\xc5\xa4\x89\x18\x89\xae7\xd4/\xde\xcb\x0a\xd3\x05\xfb\x04\x19)WI\xac\xa4\xefU\xac\xc7e\x95\xc4:\x92V\x1f\x91\xe2,\xaa,>\x08+\xb0!\xb5\x8f\xc92:@\xfb\xf8vbm\xfb\xde\x9df\x1d\xc3U\x05\x99\x89\xe0,5\xa3\xb0\xe4V\xbc\x13T\x16\x1c\xd1\xc6fa&\xc2\xfe\x9a)>\xed\x00w\xd3\x8c\x17\xec\xf9=\xcd\xb7\xb42\xab\xf1\xbd%\x16'\xceA\x98?\"Q\x81\x18\xeb\x94F\xe8V\x9e97\x80\xd8\x9cr@\xf1]S%+\x0a2\xbc\xd8\xf9'Nr\xb8\xfc\x8cv&&\x87\xee\xb8\xc4U(\xd5\x0a\x8cJ\xce\x06\xb6\xe8\xf6\x81\x9b~d\x8a^\xb1[\xe2f\xad\xc2\x11\xa9j$\x97PXZ\xc0,\x96\xb2$\xf8,\"\x89?\xcf\x82\xado\x0191NV\x98\x15b\xed\xcf\xb57|\x91\x96)]\xba\xd8\x89s\xb2z-\"\x19\xd3\xc8h\xa1\xf5\xfb\xbe\x11\x8aT\xf0\xdc\xe27\xba\x1c:\x0akk\xce\xf2\x08\xc9\xa3\x1d\xc7\xaf\x13\x84\xcaO\x0aa+\x88\x8al\xa0\xa0\xa0\x83R\xb7\xb5\xd5\xf6f\x82\xbdh\xebh\x91<8s\x9d/\xc7\x9d`\xcfr\xa4\xfeQ\xba\x1db\x9d\x8e\x90\xb7\x83q'\x8f\xb2\xb1\xc3\xb4\xcb\xea\xd1\xceF\xba\xba\xbb]\x8d\x09\x16{\xa1m\xa5\xf3y\xb7\xfa\xe7p\xc1\xb0\x8b\xde\x1c/\x0fc\x1e[\xb4\xd0\x89\x16\x98;\xc1\xeb\\\xe8^\x959!\xcf\xe36\x9e#\xa3\xf1\xfa\xc9f`\x1aS<\x10\xb7w\xc5\x1d\x0e\xbf\xd6n\xa1w\xb1\x93\x16\xa8\xb4\xc796n\x0a\xe5\xd0m ^#g\x90_\xb0\x94\xde\xcd+\xa4\xd9N\x11\x8e\xf6c\xd1\xf8\xfd\xe0\xfd\xdc9NM\x85\x05n\xdc\x0fX\xac1aO*^;\xe24\xb2n%\xbb\xaeqmL\x81F\xc3B]\x9cJM\xb8`X\x08\xaf\x14\xee\x84\x8d\x05\x94\x14\x07\"\xa7vn\xd2\x16\xaf9g,\x12]a8\x12B\xb77\x06\x87\x87\xb4\x00H\xf2\"\xdb\x8a\xc23l\x82\xf5\x1f\xee&\x98\xc8)\x92\x05Z,;\xa6\xcfo\x08$)\xbc\xdf\x91f\xee\xcf\xf1\xc0\xc4*s3\x93$\x1d\xd5\xe6fp/\xe9\x86[P\xcb\xf5\x09\x16\xb4s\xf5\xf9*\x99\xd6\xd8\x07\xcc\x00e\xcbzI\x89\x14L\xbc\x92\xa4\x1b\xb3\xdb\xc2\xe0'5#q\x92\xf8\x8d\xcf\xcb\xcd\x94\x97\xf6\xf3\xca\xceK\x0fh\xdd]{!\xd5\xc6W\x92\x81\x0e\x81\x9b\x02R*\xf9yF!Z3\xc2\x7f\x99\x82\xcac\x1eJ\xf6*O\xa8\x01F\xa9\x13\xd2\xb8\xaetg\xd7\xc8\x80\x1c\xa8\xdf1\xd76\xe2\x14ZN\xb19\\<\xb7P\xafR\xd0)\x01\x18\x1f<\x15F9<\xb0+\x1b\x18,,\x87!\xc3\x90\xa2e\xa3\xcf\x8b\xb83#\x84\xa9\x88\x8ey\x86\x8b#9\xe6,\x1dY\xf4\xa7\xb0\xbf\xa9J\xcb\x94\xa4\x02\x1e\xe5\x86JOi\xb8\xec\xa1\x12\xe3\x83\x12V\\\x81S\xfcU3V\x0f\xac\xbf\xc7\x9b\x8emg\xf3k\xa2\x94-T\xc3K\x84\xf6b\x05\xc7\xf2\xa0+\xbaj=\x1c\x16\xabx\xd7\xcd\xc0\xff\"Ep\xe2\xaf:\xd5l5\x87\x17|]\x8e\xc3z\xe8\xeb[\xd07i\x7fi3B@\x8au/\xc7/\xbc\x7f}\x0fO\xf1C\x0e\xe0\x93O'\x88\xd1\xcaua\xc2j\xed\x1c6\xf6r\xe3d\x89\x95\xdf\xd6X;#Z<U\x11q\xbe\xean\xd3\xe6\x08\xca\xa1\xdd^\x97Q8\x99$D\x9b\xe8\xfd\x97\xf5\x03\xc4\xb7o\xd9Y\xecY\x1d\xc4/Q~\x09{\x81\xc4=\x1d\x9a\xc26\xa3\x1a\xad.\xdc&&}\xce\xd5]\x19\xa8\xb5j\xce{*\xa2\xf4\xf1\xe5-\xbbg&\xd4\xc3d\x1d\xb1\xf2\xbat\x00\xe1?\xa2Y\x12\xcav)Fw\x86~Kr\x8dD\xdc\x16c\xd03t\xe1\xa2\x0f\xd1WM\xc4i@\xbd\x16\xd0\x92\"\xf5$=\xc9'\xdb\xb8}\xe5\x89\xc8\x12!:jyV\xc2\x1f\xd1N\x1c8om\x14\xff\x8a\\\xcfH\xf863m\x0ec\x0e\x94\xe6\x90Dp\x9f\xa0\xd8\xbe\xb5\x9b\x8b\x0f\x13\xe7\xe6\x8a\xa4p\xd7\xb35\xaf\x97}\x17\xbd\xef\xdeL\x9a\x0b\x98\xa0[@\x94\x19y\x82cW\xa8{\xc3\xe6\xca^\xa1\x8fe$\xe2z\xdf{\x95\xf4\x12\x7f>'\x83G\x99\xe6i\xf6\x87\xf8\xa7\x97\xb2\x8f\xf6\x92\x94\x11\xd2V\x00\xec\x09j73J\xdcj\xb5\x89\x9a\xc9H\xb7V\x8d\xb9\xc4\xb6^\xf8\x1f\x90\xa0=\xa3\x0a\x02\x80\xda\xc8\x8dZ\x05G\xdb\xafk\xce\xba4\x0d\x1f\xb0\xd9\xb8~\xab{m\x8e;\xb3\xf6[\xfb6}\xdb\x9c\x80o\xa5\xf9m\xc23\x84\xa8\xf8\x060\x1d\x05\x1fV\x0f\x08)\xe6\x96\xb3$h\x12[\xb1\x84\xfd>\x83\x8e`\x01\xdd\x9aB\xa1\x9e\x01\x92\xa2\xe3 !\xb1\xc9\xe1\xac-\xab\x8a:\x85\\\x8c\xae\x19`\xb5WL\x13\xd2\xd8\xe4)\x1f\xaf.r\x8a\xe9\xdd\xfa\x03E \xfd\xe2<I\x04\"\x8a\x88KI\x8e%\xcb\xdc# \xae\x0fR\x84qP\x99&z=\x96\xcd\x11^k \xe5\xa7\x15C\xc6M02\xa9b\xc5f1\xef\x96r\x96\xeb\xef7y\x13\x88n\xaeyt \xba\x0b\x07\xcc\x07\x19\x99x\xd2h\xfc}sm\x98o\xd5\xffq\x9c\xf4\x926\xd3\xe6\x18\x8b\xf6?\xddYY\x7f\x0f\xadC\x0cxl\xcf.\xdbp\xbc\xf0\xc5ozR\x92m\xbf\xe8`\xf8\xbf\"`$\xca\xdf\xd8\xf0\"\x15\x8c\xda\x18<\xbe=\xcc+\xef)\xfd\xaf\xf5\xa3\x95@=_\x8a\x16o?\x8f\xc2P\xb1\x05U\x8b\x94\"\xa3C`g\xa8+\x08\xf6\x03\x7fJys\xe3\xfb\x0b\x82\x93\xca\xaa\x88\xc1o\x89\xd4\xc4\xb4\x9e\xff\xb8\x01]\xd9\xf6V+\xca^\xcb.+cc\xdeY\x13\x99\xb4P\xb3\xdb+\x86\x13[(1\xce4DR\xf3x\xa8\xe4\x02\x85\xdfV\xca\xef\xa4)yP\x88\x11FY\x9c\x8f\x02T\xbc\x81h\xc5\xa9+\xe2\xdacMi;\x1dc\xdf?\x8a\x0a\"\xe7\x9f\xdf\xd3\xf1\xa8>\xab\xdf@|$\x00\xfd)QU\"\xcd\x96\x15Z\x9f;\xab\x14'\x814\xe9\xec\xe0\x1f7E\xe7\xe0\x1f\xab\x86\xa8\xa7\x0f\xfeN\x9a\xe7\xa3\xe7\x05\xd0\x97\xc0\x9f\xba\x82\xfa\xbd\xb6\xd8*\x1c\x0c\xeb\xe1\x91\xb4H\x16\xda\x80\xa1\xa7.\xbe\xbaI\xfa;\x99,\xec#\xb3\xa1\x92\xf4\x03p\xf3W\x0e\x03\x12\xcbDq\x06\xd9\xe8C\x88\xa4\xb2\x09K6\xa1z\xd9N\xf2\xcb\x12\x99\xa9(\x86Yt\x84\xe2\xc9\xfb\x98\xb3\x00\xbd\x9d\x972S\xa7\xd5k\xb5\xb9\xef\x99\x82&p\x0b~c\xeeD\x19\xec{%[\xe1!FB\x98X\xcb\xe7\"6<y\x14\xb9\x82\xcaF\xda\xe0\x14\xab\x1a\xa8C\xc9\x95\x8d\x15\xe8UE\xc7\xeb\x07\xf9\x1b\xc6\xed\xeb\xc4\x99jc\x88\xe3\x7f\x0d\xb2\xf4\xbd\xce\x01\x9do}\xb0p\xecW\x98\x94_\xdf\x88\xad\x0f-\xd3\x05\x89\xd5\xe9\xb4\xb4\x08\x93&\x90De\xae-c\xf9\xde\xa6\xd7P\xa3\x92\xca\xd0\x97)\xd1\x9e\x02\x15\x14\xa4\x96\xa3\xd3\x1c\x0c\xf03\xda\xb7\x03R\xd6\x17\x1b\x1a8\x1c\xa9c\xae_\x9c\xa7\xe5\x1d\xd2\x9e\xd1\xd3X,\xf2U\x99\xb4>\x06/^.\xe0\xf5Q\x91\xe1\xc4\xb4\x16\x8e\xa9X\xb4\xc9*Ntfc\x9d\xfe\x14Ql\xbe\x02\x90['\xfc\xee\x85\xd9%1M\xfa\x12\x8eT-\x0c\x11_\xe6\x0dp\xa4\x88\x02\xa4\xa2\xda\xe5\x9e\x8dly3\xd5:\xbd\xa1\xc0}!\xd3\x02m\x975\x9bV\xf8\xc8\xeb\xcb\xd67\xb8a\xdb\xaf\xd4\xd2\x99\xe4\xb5 u?\xd6GpF\x8f4\x17z\x1ac$\\\x1c1\x0d\xf3#\x91\x94\xeaz\xb8`S\xb7\x13\x1e|\x9f\x90\xac\xb9\xb3\xcc\xb4|\xb5N\x8e\xe6\xa1\x041\x06;3\xce\x005t\xe6K'=;\xb3^\xf4\xa2\x00\x9c\xc1.\xdaxC\x09B\x810m\x81\x1d~\xf6\xc6\xe0\xe3-\xa8[\xf0e\x92\x04\xe0\xbb\x9eH\xc8\\8\xd6\x9a\x19]\x18\x92\xecx\x9d\xb2\xed!\x88U)\x87\xb6\xebW6ee\x8f\x16<d\x86=\xc6\x91(q\x15\xb6\xa5\xea#\x97<\x12B\x98'\xe5\x9d\xb4\x8eG\xd2\xf3$\xda\xd1^\xa1\x93\x1cC\x94\xf7\x11\xe2\x17FV\xb59%\xba\x1a\x13Mh\x89\x1eS\xa6QQd1z-{vk_\xb3iw{\xcd\x85\xebI\xd5\xf7J6IIK\xa1\xbb\x99@\xe8\xa7\x7f>[c\x116%\xc6\xe7.\xa1+\xe4~4\x0d\xb37\xc8n\xe1\xb0\xa8\x19f\x03N\xd4U\x1c\x88\x1cEgF\x1a\xdd17\xf7\xea\x15\x9d~\xbe$p,Q/Lu\xf7\xfbM\xd0\xfa\x96\xf5%'}#\xeaSo\xc2\x10\xba4.\xf5\xa5>X=\x99\xdd\x16\x9e\xa6\xe0\xe9\x85\x15\xdbCYi\xcfo+\x01\\t}\x0bK\xf7\xd4\xe8\xd2\xf8\x83\xad\x976\xae\xa7\x0b\xae%\x9d\xe0$j\\p\xe9l\x0ac\xc1\x7f\xa4\xf0YZoILw\xda\xd5\xf9\xa0\x1e\xe8\x0d\xb3\x91R\x03\x90+X\x85\xe5\xaf\xcc\xb7$\x04\x80>8Q\xf4\xce\xea\xcf\xea\xfb1/z\x8b\xd9z\x99\x07\x91M\xc2\x92\x07|C9\x8f\x18D\xf0\x86\xd5z\xf99bB?\xe5=\xca@|7\"\x7fw\x8b[\xba\xb7\x1a\xa7\xd6\xe3p\xe4z\xb4\xbaLr\x01\xb2\xbf\xd7\xe0X\xdd\xc2\x97<}\xc4\xd4 o\xa6\x15q\xd0\xa3s\x8f\x09m\xdby\x1e\x06VQ\"\x91\xc0-\x01\xbd\x86\xa8Y\xc0\xf7\xc4\xf9`\xf9\xd6\xfa\x8dT\xb6\xbbB\xd5y\x01;#\x90\xc9:*\xea\x13\xd3\xb3(\x06@V$\xeb\x04\x8f\xeb\x99\xa78y\xd6\x86\xe0}\x14\x13\xc1\x13'yC\xf5\xc4\xdd\x17-\xfc\xe0\x9e\xde\x8a\xb0\x17I\xec \xc7\xca\x87^\x03s\x05\x00^\xd2Jn\xccP\xd0e\xca\x16l\x96)2I\xa0\x05\x03\x16Pu\xe8\xcc6\xac\x9e\xf5\x90\xabh\x13\x88\xa94\xb0\xd3\x08a\xbd\xcd\x07Yy;\xd4C\xcf\xa1\x8f\xdb\x92\xf4\xbd\x9e\xaaSy}\xedf\x9c&\xc16\xd2\xa4H\xe9\xe7\x10\xd6!\x03m\xdd\xa6\xda\xae\xeeO\x092\xc3\xa7\x95s\xbc}\x0cL\x977(\xfe\xdc\x8e\x1dW\xb9\x82\xa8\xd3\xae\x92!)\x82%qu\x12dI\xc2\x98\x09\xbeu\x1f\x99\x92m\xcb\xb2F\xe9\x17\x00\x11\xb1\x99c\xc5\xe1\x94\x8a\x96\xfd4\xab7\x15\x9b\xdbh\x9b\xdcAF\xffe\\-\xa8\x15\xef\x0a9\xc9\x1b\x94\xba[\x809\xfbT\x7f\xcb\x00\xe8\xbe\xe2\x1b\xe0\"u\x99\\\xef\xc3\x0d\xfa0\xd1\xb1R\xc8S\xc7Gz\xcd\xd7\xe1\xb9\x84\xf6\x0fV\xab\xcb\xe8\xb9\xd7\x1f\x84B\x98s\xc8v\xb2\xd5\xef\xca\xa7\x96\xdcyq\x80\xee\x1d\xdf\xc3\x8e8\x93\xfc\xa1\xc0\xac\x06\xe0O\x10*\x1a}Sl\xc4\xa4|q\x82\xd87-A!\xb3\xc0\x9d\xa7\xcf\xd1`\xc5\x0a\x1c\x08\x8d\xd1\xe4$\x19\xbe?\xbbMM\x87&lr\xb40qw=\xc0\x04Q\xab$rP\xcf\x94\xd4\x93B!W}\xf44\xb8w\\tV\x9e\x0f`f\xbe\x8f&\x96\x84SahNq\x02\x04\x023\xf5\xce7\x84\x06k\xcd\x88\x9f\xb0_m\x01\xfa\xd5\xa1uR\xed\xe8\x14\xc1p\x8d\xdf\x88\xc5\xa4\x8f\xe1\x8d\xd6\xc3\xf4\x12}\xbek\x16HN\x97\xa8\xd2e\x863v\xceb\xf31_\x88}\x8e\x1a?\x09?]\xcf#.I\x89_)uvb\xd2O2\xe6\xb0M(\xca\xd3\x0e\x92\xa5\xb7\x95t\x80\x94Z\x15\x1c(\x17\xfdh\xaa_$A\xd0%{\xa4\x0a\xf1\x16\x8d\x03\xf2T\xb2\x87\xb8\xd4\x1d@\x12!_\xc8!7\x11\xf9*\x94\x01c\xf5\xeeDG\x80\xb8\x9dd\x04\xf8\x9cM\xd0\x09n\x01{\xfe\xee\xe0\xf5A\"\xd1O)\xab<\xd2f\x89\xa3\xf0\xe7\xbb\x10\x9b@\xd9Q\x16]&\xd8\xf9J?n\x8e\xe0\xda\xab\xd6\xfeB3\xba#\xc5\x81\xf4\x0d\xbbb#\xc9\xb5\xd1C7\xc1\x9b\xb6\x9f\x994\xca\"\xe6\x0d\x0a\\\xea\x16Z\x11\x9e\x87^\xd0\xda\xbd\xed\xc7-\x06D$\xbe\xc5\xfc\x97\x01\xf4$0j\xfc\xe7K\x9a\xa0\xb0kf\xddu\x89\x8c\xfe1\x1a~\x89\xf2\xe8\xb3<\xa2\x7f]\xb9t\xdf\x9a\xfd\x95bW\xcb|\x98\x15$\xea)n\xe2\xea\x1b\xa7\xf8\x01\x05\x80-3\x00X\x19\xad\xc2\x92\x997\xa7\x98(\x8a\x8c\xc4U\xbd\x80?\x98\xb5\xd4\x83ct\x8c\xf1CBw\x08\xfbt*\x08\xce\x9eX8\xd8\xdb\xb5R\x93\xd8\x12\x1d\x11\x0a\xaa\x1d\x87\x8b\x87\xcd(\x86L\xebo\x0c\x84\x16E\xe9\xd4\xf5'M\x09]VU\xc4\xdekX,\x0d\x8a8\xff4\x1a\x87\x00,\x0a\x86`T\xd4\xe7$\xebA\xc4\xb5\xdc\xd9\x0art\x9b\xf0\xf0\xb3o\x07\x15\xa6VQU?\xcf6\x97\xad\x08\x05r\xef\x91\xd6\x0d\x8dk\xb0\xd0\xeb\xc9\xc4\xfe\x9f\x8dfp\xbfo\x97\x91\x0e\xf2S$n\xabV\xd9)4\xc0Z\xbd\xb42\xa5\xc2a\xbb{#\x8at*\xd50\x82\x1eS7<\xf5\xd3\x90\xc8\xec(=\"B\xa9\x9b\xde%=W\x1b\x88Nh\x95\xbc\x1fu\xcc\xae\xb5ME\xc7\xaeJu\xa4\xcdT1\xcf\x8b\xb5h\xeap\xb0-m^\x89\xa7E\x86x\x00G\x05w\xed\xe3\x0eu\xc2\xbd\x09t7\x9fr0\xbc9\xf5\xd7^'\xa3\x84d\xe1\x90VC\x82\xc6\xa7RG0%%\xb9\x03\x9c\xb7\xeelogy\xd1\xecZ\xac\xdat\xe7\xd6\xb7\xb2\xd4T\xdb/\x145\xd5\xaa9m&\xff\xacI\xa9>\xc6s5\xec\x9f\xa5\xbcL\xd9\x9c\xb6\xfa\xa3\xdb\xc2\xe8\x13\xd9\x1e\xc8{5\xdf\xa4.A%r\x1b\xbeP^\x94>\x80\xd6q\xde\xebwp\x9c~\x04\xfb\xdf\xddz\xb6c\x1f-\xcaN\xcc\xa3\x9e\xa3\x9a\xd1\xc4y\xd0\x15\xe2\x95H+(Ek\x91N" +
+	"\xe07\xe0\x1e\xbbT\xa1g\xa6(\xcdK__\xbc7\xab\x13\x91\x88F\x82\x0f\xdf\x80\x11\xcbl/\xa5\xcd\\\xdd\xdd\xac\xa9\x9e,M\xcc\xc5\x97\xebY\xa6\x03\x9d\x0f\x80\xb1\xc6\xf6\xc2%\xe8\x0f\x1dz\x93\x0e}\xca\xa3\x84'\xf7UF&\xf9\xdd\x80t\xca+dALB\xfe\x9e2~>\xf0,m\x0b\xa1\xaf}DK\xbb\x09y\x92\x7f\xa4G\x8a}%\x10-\x1c4\x89\xdf\x81\xad\xc8\xba\x09\xcf\x0bK\x90\xdd\xa12\x15\x9eP\"\x99\"\xaf:\xe2\x90{!fo\xcf\xdd\xec\xad>\x02\xc2\xa9\xf5JrY\x9aY\x94\x0b\x09$V\x98'\xadrS\x19DL\xd2\xb3\xb0\xd8\xd1\xdb\xbdI\x1b4l\xf8l#\xb4\x84y\xa5\xcc\xb9\x9b\xb2\xf8\xa5h/\"\xa4/\x9c\xca\xbdOJ\x1f\xe17\xf4\xb8\x99\xa5\x01\xe99X\xa2\x98\x99\xb1[_\x0bJ{?\xa1\xce1\xc2\xe2\xf3\xb6\x92\x98|\xc1b\xd3\x8e\x91\xfc\xe7\x8f\x9fc\x02,\xd9o\xd9\xb2\x10\x8a\xac\x84\"\xd2\x11\x1a\x82\xe1\xf5\x7f^*-7\x03j\xee\xdb\xd6l^_\xb4\x89\x89\x12Fp\xf9\xde\x02\xd1\xd9iO\x12\xa8\\6(\x92\x02<\x86\x09!\x93\xb0~\x0c\x93E^\xeb\x13:\xcf\xc0\x09\x13f5\x0a\x99\x86]\x8b$\x0f\xcd8\xb6z?N\x90a\xe8\xb5\xb0\xccY\x95O\xcbQ+\x9a&+ND.\xdb}S\x92\xd6\x9f\xf05\xd2Yn\xb9\xc8Fa\xc5p\xe7\xa9#\xe9\xbblxLF'\xa89z\x0cY\xd0\xa4\x05\xf5\xf4\xc4r\xc4\xc8!\xca\xc3X\xfd=\x0b\x0e\x94\xa4\xd5\xde\x83\x19j\xcf\xea\x01\xb4\xbd@R\x02\x16\xa0\xa46#\xa0\x09\xc7\xd4\xb9\xeaz@#\x9f\xa3@\x1d\x89\xa6~\xa9ew\xfbs\x06A.\xa5C\xb4\x91\xbbq\x07X\xfa\x03\x12\x18\xca3\xe8o@wF\x15\xbb36 \xb8\xe9)t\xf8\x88\xea\xffC8\xb6c\xe1\x82\x80\xca\xe6\xa9\x7f\x8c\x96\xad\xcf\x08\x1e*F\x92\xd7\xd1\x00\xad\xeb\xaa\xda+\xf6\xa9\xb2\xe3'\x15=s\xe6\x1a\xea\x9b\xc9u\xe8\x0e\xe2\x1a1[\xedzN\x85f\x0d\xf7\xb2\xd3.\xc4nI\x8fV\xc46\xa3\xf6\xa6d\x8f\x86\xd2(\x9c\x13(\xd4Vk\x12\xde\x92x\xc0 x\xcc\x9cEB\xb7V\x92\xacD\xe7,\x90\x00,\xe1\x930\xf1=&uk\xf8\x84\x87\xf7z}\x9a\xb9vn*E\"\x19\x0a2\xa7\xc0[\xc0\x04\xf3\xae\xf4\x9f9|\x95\x8fG\xf2\x8e\x09\xb6\xc6c\xb5x\xc9\xfa\xc5\x12/\xf4<T\x1fn\xa4\xb5\x19qNn\xcc\xd9g)\x1f\x0d\x00\x02\x8d\xdb*\x86\xca1\x14\xc7\xf2\xd1\x1d\xd8\xe9\xa5a\xc2\xc2/P%\xa12\xfcm\x1b\x8f\x99\x85\xc8w6\xfao\xf7\x1b\"8]cu[\xf1\x84X\xf1\xc9*'>\x89\x1f\x00YZ\x05\x1d\xaa\x7fjJ\xb9\xa0\\q\xc9\xe7Z@\xfd\xe5P\xb7vz\x8e\x0bI\xb4\x18.\x02p\x12\xc0\x0f~M\x15\x05:\x1e>P\xa6Tf\xf4A2\xf2\x02j\xe9\x1b\xd5\x14\xad\x95n\xa9)v\x99\xeb\xe7\x1a\xf0\xfe5AC\xce\xd3a\x1f\xb5/\xea\x0eO\xe9oW\xe6\xcf\x85\xb4$\\\xd6\xb7\xff\xb7\xe8z\xf3\xbe\xbc\x85\xd7:5\" \xaa)F\xa9\xf89\x87\x09\xc2\x9b\xe2\xe1W\xf69\xfc\xf6\\WF\xf5\xfb?\xc6va\xf5\xfcl\xa0k\xc4`\x8f\xeae\xf7\x93\xed\xe7\xf5\xa8\xb9\x7f\xe0\xe9\xe1\xa2\xbe\xfa%Uz\xa1-\xc2B\x87\x1d\x8f/m~\x9b+\x12\x85o\x9e\xe6\x06>'\xe3\x0f\xe7\x1c\xdf\x93\xf0x\xea\x06\xe0\xdb\xa8\xac[\xd6/hN\xd6_VD\xa1I\xe7p\xb8NA\xf9\xb7%^mF\xdd\xbf\xd3\xd4^\x9b\xd8\xf3\x8fl5\xe2ob\x8a\xef\xadl\x9c\xb4\xec\xebrgHy\xe5;\"k\xf9#x]\x80\xd2\x1aI\xc97\x0es\xa3h\xfc\xc8\x9c\xb5&\x0bt,\x7f\xa1\xec\xda\x14\xc2\xedwd\x81\x13\x00y\xe5b\x9d72u\xb69\xa0\x92\xcc\xd2\xa2v\xd6\xd1\xd8#\xf7\x80jS\x0e\x16\x8e\xc5\xaf+-\xd0\x89)7(cp\xf9o\xa0\xeb\xadB\xf67-)H\xaa\xae\x93'Qum\xbfH\xe2\xfc#\xd9\xb0\x8e\xba8\xc7Tb\x12\xf8\x84\xd8]\x0cE\xa8\x11\xc9\xa1\xd5\x1f \xa8X\xfa\x04\xac\xb2\xd1j\x14\xcfb\xaf\xee\x914$\x14\x86\x01d\x81CG,P\xc2\x9e\xa5\xe5F\x15\xfbh\xa0E)Yyb\x92t\xf2\xf3\x16k\xb6e\x8fp\x19\x87\x945^\xd2\x0c]\x86\xa4Xu\x11\xcex\xadz\xbd\x829\x1d(\xd5\xb7\xf6H\x15(\xc1\xb9\xa3\xae\xa3\xf7\x94\xcdH\xc53\x1f>\xd5)f\x93r\x8b\xe1>\xb9oB\xf5\xe9&\xc2\xe1bX\xc9\xa8\x94\xf0\xa2\xb7V\x84\x9a\x85\x95;\xe7g;\xa1\xa1~C\x06;\x1eV\xce\xfa}\x0f<ng\x12\x174\xaf\xaf\xb8\xeab\xb8\x0d:\xe7=\x1f)\xc7\x7f6G\xfa\x92,\xa9\xeb\xc6\x85\xf8\x120\x10\xcd\xac9\xb2\xd2\x7fd\xd2\\\xcc\xeb&8\"\xe6\xb9y\x1a\x8eh\xd4\x1f?\xb4!\xe363\xd5\xb0fRg\x03\x90(\xf0\x97\xeb\xef\x10u\x07\xd1\xc1\xa0gBa\xf41\x1aw\xc5\x1c\xe5\x10N,d\xa0\xb3\xc1H\xefeV+.\x14v\x05\x9cUk\x17\x8a\xde&\xa6\xae\xa1\xa9\xab\x81g\"+\xce\x83\x8d\x15\xf8\xbaG\x98\xbe\x80\xc9d\x7f\x07\x0c\x81l\x94r`l\xfc\"\x1f\xba\x07\xe5\x11\xe0\xb0gVn\xf5\x80\xb6??\x13\xab \xc2\x19\x01;d5\xc0\x05\xa2E,\x17\xb1(\x86\x15j\xf6@\xe8T\xa8 \xa1E6\x9d\xb2\x0c9\x8aA{\x14;\xd2\xcb\xbd\xd4\xaf\xa5\x7f\xe2\xf6\x12v\xb6l!\xef\x11\xaa\xd2v\x8d\xdb\xa1\xc7\x04Mf*\xa1\xa9]\x01\x915'\x00\x09z\xbf0Z\x9c`mhd+\xc7\xaf\x18i\x89I\xbaLGKe\xf7s\xbe\xa5\xd8!\xa8W,7\x1d\xebQ\xdf\xb1F\xecR3\xea\x1ckf\xcfi\x95\xb7q\x92\xccQ\xeb\x9b\x0eI\x8e}\xdc\xcf\x9bY\xf8'J\xebg\xbe\xc1y\x1d\x13\xce\x11\xf3\x9bWF<6\x90u\x84N\x81\x041\xfdR\x09K\xe4\xf4c\x92J\x0eo\xc5%\xa5ID\xa7)\x05\x94\x9b\x9d\x1c\xccU\x03\"\xa9\xc2\xcb\x9d+\x19cK\x01\xcb\x90c\x11\xa4/\xe2Q\x12\xc3 s\xcc\x01\xad\xaac\xf5]\xa7\xba\x0ao\xf2\x06\x9c\x14\xd5x\xf8\x80y5\xb36l\x09\x12\xf0\xe8\x85,\x04\xb3\xf5gm\xb2\xbe\xfc2?\x05\xd3\xff3\x00\\Ju\x11\xb7y\x00\x81E\xfd=\xfa\x9d\xed\xa7\x05\x8ai|U9\x8f\xae\x15%E\xb7\xa9,m\x9f\x0d\xb4\xf4\xf3\x8a!;c\x97\xf8\xdc\xe0\x09\xdd\x02y\xacL\xdf\xb2;\xd9\x93\xdbF\xe8R\xa2<\xf4\x001\x03:b;\x8e\x87\x0dN\x82Vg\x16k\x15\xda\xcf\xb0HN^\xe4\xb8\xa9T&E\xf7\x9e5\x938\x01\xf5m\xf7\xc3\xefZ?\\*#\xfe\x14\xf5\xfdIWa\xc1j\xc5\x870n\x11#\xba\xcd{pw\xf66\xcaf\x83w\x11\x0bEc\xc5\xa3\x0c?\xb8\xc1\xde\x8dX\x84\x04AJt\xc8\xb8\xafA\xda\xa7c\xc7\x1b\xd0\x9a\x05R\x0cA\xf7OJ\x8b\x8b\xbd%\x99\xd4\xb9\xba\x80\x15\xc2\xc9\x0d\xbch\xce)\xacrQ,\xaa\x0a\xcd\x9bMh\\\xfa\x08pM<}\x9aB\xa2\xd7:\xa4\xb98\x0a\xbcf8W\x7f\xc0[\xb0\xbf\xb1\x80\x11w\x8d|\xae\x16\xecX\x9c\x1dN\x00\xaa\xbcD\xc1N\xf2K\x1b N\xa5\xde\x86lX\xdd\x94|\xea\xa0\x9fOd\xd6s\x99Y\xcf1\x8c.\x09<Z\xc6\xa6;\x94\xfc\"\xbbcB\x9e\x84,>\xa9\x8b\x19\x01\x1f/\x02\x0a\x83\x09~\x10r\x19W\xc0\x16\x887\x96\x0d%WT\xa7\xbd\xc5(k\xa6\x0b8J\x97\xaf\"a\xfb*#q\x19i3\xaf\xa8\xdbD\x94Y7i\x9d\xcc\x85\xa5\x8aL\xb3\x1fDa\xe7\xd0\x91\x83Jy:[\x0eL._\x16.\x9f\x8b4G)\xab\x0f\xb2 0\x10\xaf\xb3\x85Z\xe7\x8e\xd9\xad\xd1\x1di\xdf\x12@\xb6\xd2\xb9\x05\x0fD\x99\x88?=\x09\xf7\x92S{\x12\x94\x0f\xfa\xea\xe2\x1a\x05`\xad\xb9\xae\xf4l\xa2G\xbb\x99\x16\xe8\xbc\xd1\x84\x80\x8c\xbd\x01\xe4\xa3\x06\x06F\xa0\xdfz\x17G.\xecuA\xaa\xf4#Xy\xb5\x88\xc4\xd8U\x8fi\x16\x13\x9c1Z\xb3\xfaX(\xf6/\x81\x13l\x12=\xeb\x96Qn\xf9A\xf0*\xa4\xbcUlB T\xa6J\x85\xc2\xc5.(\x99\x13\x06\xe6\x16@k=\xf8;\xb6\xdb\xe4\x9e\x0a\x91\xfb\x8a\xad>Xl\x0c{\xbf\x99\xa1\xbc\xe5t\x00\x15\x1c\x08\xcb\x94(\xb5L\x98\xa9\x97U\xdd\xb4-\x0cb\x1e\x04qdr\xc9\x1bp\xb11\xd3\xca\xa6\xc1\xb6\xf0o\xde\xc7\xe5\xd4\x8c\x0c\x9eK)\x05O\x9cf)\xf9C\x03\xb1\xdf\xe4:\xea\xa7\xe7\xf2\xb2\x04\xfa\xb8'\xa6\xeexy<CP\xf4P\xb1\x80v\xf6\x171\xd7\x81\x88nh\xf7\x97}\x18b\x1e\x8b\x96\x0e\x09\xc4\xbb\xa6\xa2o\xe5\xd0\xd2R\xdb\x1b\x84L\xc1[\xe7\x02e\x81\xbc\xc6\x0f0tia6i\xab\xde\x84\xd1`w\xaf\xbf0p?J5\xa1\x96>\x93\x0c\xd2\xd2\xfb\x14o<7\xb5\xed\x81e\x83t\xc4B\xc6\xc8\xdd`U:h\xcd\xbdb\xe0r\x81\xb6\x87\xbf^\xba_O\xea\x89\xb2\x18.\xd9w\x0c\x8b{\xcf\xf2\xc5\xae&fwc\xdd1\xe7\x85b(Y\xb0a\xee\xa6\xb2\x8c\xef\xf2\x06\xe2\x9f\xa5\xe4\"`#\xae\xfb\xcc%o\xd3\xb2\x97\xe2\xde\xeeA>\xa6`<\x86P_\xaa\xd2T)\xb0x\x16~Z\xfb\xdbf\xaa\x88\x0c\xf10\x17\x10\x95sG\x92\xbc\x02\xe1\x19U\xe9GVY\x1c[\xc5\x02Q\xe4\xd5RS\xd6\xb1\xef\x14\x80\x84\xd6\x82=\x156aBd\x96\x9btS\xcfB\xd5\x04Wh*\xad)\xdc\x9d#\xd4\xa5\xe3\xfdb^\xe6QE\xef=\xe3@\x12\xb6|e \x98-\x8d\x98\x14v\x9d\xa9%(}\xf0u<0\x0a?`\x07\xa5\xf5\x1d\";\x96WJ\xc9\xf7K\x0f\xb1_\xba\x197G\xcd\xa4\xbej\xa6\xff\xac\xfb\xa5\x15\x9d\xb4\"ht\x09sS\xf8T\xd5\xa3\xa3\xfa$\xb8^\xd4\xb5\x98\x14\x165\x0ds\xbeM=\xdb\x18\xedMc<\x1f\x09\xfe`T\x9e,\x00\x02\xce2\xab\xa2\xf5\x16W\x8a\xfcn\x92b\xc8\xf4\xb7\xb6.\xa1|/tZC\x91{P\x8ce\x8e\x89S\"\x9b\x9f\xb7\xf4\xe5%\xd4<\xec\x8b\x0a\xa0\x91\x12\"\xd8b\xe6\x86\x91]\x0e\xe0.\xbd\x92)\xfe\xf7h\xee\xc8\x93\xbe\xd3\xd5\xc3\x84\x8a\xb2\x0dn\x85\x09\x07\xa4\xb7\xaf@d\x1d\x0f\xaa\xc0\x82u\x8al\x14\x9b\xa8\x87F7\xbb9\xe9$ \xdd\xcf\x15\xe3\x97\xad\x04\xa1j[\xeaF\xa3\x0bT\xa8)\x8d\xbe\x05\xb5r#\x95\x18u\xbd\xc9\xcc\x04\x1e\xe4R\\\x8bK\xde\\\x93,\xeb7\xf1\xce\x1a\xd6y\xd4\xcc\xf16\xa3\xa6P9\xd19\xb1\xfd\xaa\xc1:\xd7R\xab\xe7=\x9a\xbd\xdb\x95\xc6\x9f\x81\xfe\x8ef\x95y\xed\x84\x01\x9cB!\xa0\xcb\xd6\x1dJ\x8e\xfb\x0e\xfa\xba>\xa7\xd8K\xd9R\xff<hV\xd1>\xdb\x93?\xcd\xdbR\x05\xe8!\x9d\x0f(/wJ\x1d\xee\x82\x80\x9c\xf0\xc4\x1a\xcfk\x81\xd9+B\x96\xde\x09\xaau\x84\x96O\xa0\xfc,8\xc6\xc8\xb4-\x82\x8b\x96\x0a\xb7%\xce\\J\x9a\x87Oh\xe1<\x1bs\x875S\xac\\*\xe1\xce\xfc\xa3\xbc\x8d\xc3U_cx\xb5\xea\xdc\x0a\xdc\xfa+\xfdX\xdb+\x93\xd6\xe1\xb95\x87!\xc0\xfa\xd7<\xff~)\xd1H]\x08\x98l\x8e\x8d\x9afZ\xe6<\xce-E\xf3y\xdf\x06b\xe5\xf1\x1b\x18\xd8\x00\x82\xf5\xb9\x08\xfch;\xac\x97\x0a\xba\x17\xb8\xa2\xc2\x83\x8b\x8a\xc6\xdcR\xa5p\x1d\x8e\x0e.\xfe\xea\xc8\xe1\xa46\xdf\x82\x0ey\xa6\x0c\xad\x92\xcb&\xc5\xc1<\xa7\xdeG\x02\xd4\x06\xd8\xe0\x8d\xd8\x0dg={\x9f3\x1a\xa9z\xd4\xe2S\x13RG<\xb3B\xab=4\xd5:\xa9\xcb=\xe7\x01\xfd\x85\x87\xc2\x85I\xf5q9y\xee\x92(\xeb\xd7\x16\xa7\x06\xe5\x0c\x7f\x84z\xd7\xba\xca\x1b\x8c\xde\x8f\x9d\x1c\x82\xa6g\xfa\x14\x8b\xb9b\xcd\x88\x84\xc14&;\x98\xcd\xffT\xd8m-\x9b\x84X|\xd6A\xba\x84t\x94j\x89\x98\x03\xd4O:k\xfc\xc99\xcb`aUW\x97P\x90\xb4\x14\x9f\xb7j_\xb73\xae7\xa6O~\xac!\xa3\x17\xaa3\x95\xc4\xde\xcb>]\xdf\x02\xd7'\x99\xac\xd6\xff:vt\x08\xa7v\x01\xc0\xe8\xced\x06\x8a\xd9\xb9\xc6\x82\xb69\x8e7;mg\x12\xea\x14\xae\xfa\x01X\xce\x98J\x05f\x19\x95)\x16\xfa\xc36%c\xccaD#)\x92\x83\xdc\x03\x10n\xa6\xbd\xceB\xa3\xf3\xe3\xb2\x8a\xc5#3\xdc.\xab\xc2\x05\\@\xa1LL\x0fFt\xf1\x16\x00\x95\x94M~\xcfB\xd0<L\x08\x9a\xf56V\xb5x\xae\x9d\x104<\x1c\x0e\xebd\x9aL\xe0\xdd\xc0\x13\xc3\xf9*z`^\xc3\xcb\xdeb2eD\xd0\xd7RtL\xbd\xa3\"^\xbc\x04\x13\xc2u\xb6\x02y_5^\x86\x04\x91H\xd0\xaa\xcdt\xb4\x12\xaa\xd5\x1f\xa0\xbd\xe1,\xbe\xd5\xa2\xb3\xbc3\xdc\xef\x18\xeaN\x99\x1f\xf0TbX\xbc\x92\xc6t\x9e,`\xc2[M\x11\x9egD\x7fR]\x1f\x9bi\xe1\xbd,\xea\x1d\\ e\xb3\xe2\x95\xd2Du4\xf6=Od\xd3c\x09\xd9\xc3USm\xc0\x13\x80\x1b\xff]x\xd7\x0b|\xce\xd7\x84\x16f\x94Q*jE\xbf\x8f@\x89{N\x08\xfa\xd2\x97\x98\x97xi\x0a\x86\xceRH\xc0)\xe4\xe31\xb7g\xcc\x9a\xf0\xa2\x7f\x0d\xef\x7f\xde\x87q\xe0\x14\x10_Q\x81\xf1\x96Wwx\xce{\xa8V\x1e3\xce\xf6cx\x8f\x80\xac$\xf7\xed\x9d\xa8\x83\xda\xa9\xbb$\xdd\xb5\x95n\x99|G\x9dL\x18!\x89\xeb\x18\xd2\x86\xb8\x01\xaa\x1eT\x01\xc4\xcbH\x16\xab\x0f\xca\xda\x904\xa7}w}\xf5\"\xd2\x03\xc1k\x1e\xbf\x84\\a\xd40(\xac\xbc\x07X\xe9H\xb5Y \xfb\x91\xa6O\x13\xed\xf7L\xe09\x15\x7f\xee\x9331ZV\xea\xb17\xcawp\x1a^d\xdf\xf0\xafF\xb6G\xf6\x9d\xb4-R\xcd\xa0 \x0b\xaeG\xb2\x05K\x8aI\xa8\x03Ui\x0d\xa8(\xcc\xe3HVR\xe3\xa4\xe13m\x0a}\xcd\x81t\x07\xae\x99\xb7\xd1\x15\xc2\xfc\xb6^P\xa7\xde\x94\x1fC\xe8gX\x12,W\x8e\xd5H\x04z\x1c\xbc\xa9\x16}\\\xa3\xf5C\xf7\xd6\xd9J)X\xa9\x8e\x02A\x97A\\\x0a\xb9p\x8fqU\xb9\xf4:\xcdz\xc6j\xc5\xb1\x96M\xd9$ CO\xa55\xc2q\x04\xdd\xd7\x15\xe3rJ\xf4\x0c$A\x9d\xfb<9Pk\xdf\xc3bk\xdb\x1b \x1b`\xc92\xc0\x0dP\xddO\xb1\x96\xa9a\x98\x9e\x96\x9au\xd5d\xeb\x11i\xb9\xb3\xabvb]\xb54\x19\x95\x0f\xf8\x14Q:\xe9\xc0\x0a\x8e\xb1\x08\x7f\xa8o\xbb\x87\x86\x03\xa1)8\xc3\xcb\xe6\xc2\xbe\xc2\x11\xdd\x0f\x80v\xec\xb1\x92z\xe4\x07\xc0\x90\x94\x9d\xcb\xfb\x1c\xc9N\xd7\x0b\xfb\xc76\xfb\xb1\xfbg\xa3\xfd\xff\xe7@\xcd\xde\xaf \xb6\xa5\x99\xb6\xf9\x86OUI\xca\xaf?\x9bh\x94\xf9\x07\xe7\xd8\xbfmk\x9bO\xb1\xa0\xa7JO\xab\xfb8fU\xd5`\xceUXp\x84\x02Ga\xa3\xb8M\x06%B\xa1K\x08\xfcND\x9b\x85\xc5;A\x807'dE}\x09\x7f\xb8\x09f\xee|\xa0X\xd7\xd7O]\xdfB\xbf\x02t\xf0\xc1~g\x0f)\x9b\xeb0;\x9er\xb9g\x03\x95\xc5=\xeb\xc1I`\xf9KC2P\xe5H\xd1\x05f\xecA\xa9\x99m\xa8\x9a|\x1c\xcfB\xd2\xbaQj\x8f0\xf6\xf9\x17\xb9\x13* g\xf1v\xef:\xa3\xa3\xad\xc3V\xe7\xb0\xbd\xf9.n`\xc1\xa4%\xb9o4\x91\x9aG\x7f\xe3]A\x7f\xafp\xbc.\xc5\xac\xf3h\xfd\x10A\xf51SZ9\xd2\xb4\xf6\xe1~\xcd\x8e\x8f<\x80\xdc\xe4@\xdf\x00\x96I\x01t\xa7\xde\xa2\xa9\xf2w\xb7\x86\x04+\x1a\xa5}\xeaM\xa5\x96\x1db\xfc\xb2c$\xf2h-\xd3Q\xb0\xe8L\x89m\x0f7[\xae\x12\xb2_$\xb2HI\xc7\x94j\x8e\x92l\xfe\x1e8\x16+\xa4+\xea\x9e\xb6\x94dI\x87\xc3\xe3\x9aKE\xe5\xe2\x1d\xa5x\xae\x82M\\\xdb\xe6\xe6\x18|\x91\xf3>\x04\x00\xa6\xe8\xf9\x91\xd8\xfa\xccQ\x1f\xeb#g\xe8\x84\xc7RC\x19F|\xfc\xcfQY#\xa7\xcf\x1f\xd1yQ\xdeX\x88\xe6>A\x06T\xf2\x1b\xbc\xa6\x18(\xce\x07\xb8\xbe\x9d\xf3\xb1msR(4U\xca\xa4\x98\x94\xae\xd0\xf4\x12\x9a\xb4\xc0\xa8\x03\xbf\x8e\xc2v\x10\xd8\x0b\xe0\x99Tv\x1d\xe8N\xa3\x81\xca_\x9fa\xb1\x9f" +
+	"\xce\xaf[\xdb\xabrE\x08op\xab\xd7\xbf\xe2\x9d\x1ec\xe7\xb7\xcd\xb9\xbcR\xcd\x15\xd9\x13\x09\xd6\xfe\xdef{\xfaB\x8cFZ\xe5\xf6#\x10<\x14\x0a\xceWn\xa0\xa0cAK\xee\x15\xddb\x0a\xf9k\x80 k\xb5b;\xdd\x9a\xf8\xdcF\x8c\xf8/\x82\xe6xH\xd4*\xc7\x9a\xc6\x0f\x16\x86\xfa\x15#m\xba\x94?\x857x\x83Rnr\xf3\xd4K82\xc0\x9a\xb2\xe7rF\xbe\x1ac+\xa2c~\x17\x95\x16\xa2\xdd\xb9\xe5\xfaz\xf7\x15\xe9\xb8\x8bL\x9c\xc2Q=\xc7\xec\"`U7\x08\xef\xfc\x038\x0f+\xdbR2\x1cw\xf5u}[\xff\xf0\xcf\x1cXV\x80\xa6\xf0L,\x07\xabv?fa%@dK\xe2)t\x04\x04\xbeIk\xfc\x8d\x85\x94t\xd1\x0dD#6\x0f\x13\x07\x12\xe0K\xe8\xd7D\xeb?p\x94\xa3\x13\xbf&}JJLG\xcc\x0e\"ee\x0b\x1b\xb1\x85Q\xa2}\xc9GN\xc2\xe3\x84z\xc7\xe7\x99\xd6\x1d:\xc5\xfd\xb6\xe6\xe6\x04^\xec\xb6\xbf\xd8H\xff\x1e\x80\x9fD%\x01\xed\xc1]\x18D\x92\x9b\xc4\x8d\x18\xcd\x82n1\xe0\xbc\xd5\xa9\xab?\x14W\xcd\x0a\x8b\x01\xa2 i\x9b\x18\xb4\xe7\x09\x13,\x1a</\x8c\xd2\xf9}\x8c\x0bC\x04tNrN\xf2\x84X\x02\xec\xb6/k\xea\xaeb\x1f\xbe\xc5\x85%e.\xb9\x05\xb4K\xf6.u_TR@\x91\x1cPU)\x86F\x09\xe1\xd4\x88\x88\x04\x99w\x84\xd3\xa5\xe19\"\xad\x09\x8a!\x08]& \xe3\xb9\x83\xcc\xab(\x16N\xa4\x8e\xe5\x03\xc5\xed=\xd1\x88:<\x91\xcc\xab\xc91\xb4\x10k\x12\"6\xec\xe2\x91\xb9\xcc\xa7\x08\xc7\xec\xfb\xa8\xd4\x01\xaa\x0d\xe2\xfb\xcb\xaa\x91&y\x10{\x8b\xe9\x1d\x16Px\xbf\x84\x00\x8e\x09\x1b=T\x03\x1b\xcf\x0f\x9d\xb0\x9b\x03GWA\xf7\xd9L\x010\xa8\xfb\xea\x91\xea\x08,\xb1*\xfe\xf2A\xfcG\xfck\xd1\x9ct>\xca\xa1\xf7(w\xa0N\x8b6\x19\xe8&\x0b\xa8\xabe\x8f\x8c\xb29\x0d\xd3\x85\xee\xa3~\xa7\xb1\xf1\xd3h\xd7\x84\xc9\xb7\x86k\x11jq\x94\x1cP\x1f\x86c\xe3{\xca\x95\x14irU\xaf\xef\xfem\xd5\xc36\x15\xff\xfaRGT\x00\xba\x92\x80\xb6<\xf0\xeeR\xa2\x8f\xd0\x0c\x8e\xa4i_/\xae\xf3\x01\x94Sb\xce\xef\xdc\x1c\x87\x93\xbeJ2\x9b\xa8\xc8\x08\xc0\x1b\x89\xd0KQ\xdd\x91\xd4\xa5\xc5\x95\x05\x93\xe9*2\xeb]\xd8\x9c6e\xb0\xc2\x15RjW.a\x8c0\x99+q\xac\x13\xe8\xe2\x09\xc2\x18\x94\x11\x15\x9d\xcdq\x1e\xab\x8e..d\xed\xac\"9\x7fl\x96\x8f2&\x92\x08B2\x01\xa5\xb3A\x7f\x92\xf5/Z\xe0+^=\x90\xe7\x82\xbeD\xb1\xa8\xe26\xc0\xba\x88\xeal\xe7d\xd3<\xe5\xaf\xd0+n\xb2\x01:~\xb8\xed\x8f\xe0}l\xc6\x11bz)P\x90\xad\xac\xceq\x9e\xc0\xc1z%\xd9\xf1\xb9-k\xce\x01_!yKN\x91\\e\x02/\x83\xb8v<\x90\xe9\xdfy\xa9\xf9\x89\x95\xd4\xae\x84\xa4\xb6f\xeeF\xc9&\xa0\x1e\x00\xdc\xc7\x06\x02\"\xa3\xb0\xf4\xa5\xd2\xbb\x04\x13MI\x98I\xf4\x9c\xf1vV\x84+\x9a\xc4\x16\xcd\x8e\x9aS\xba\x9c%\x98=M\x9fB\xd6\x8f\x0af\"\x0e[>\x16\"\x9f\x0d\xf4\xb4\x9b\x86\xbd\x9c\x88\xe9\xd5W\xeb\xa1\xcf\x85\xed}\x83FES\x18\x9eP\x82\x9e\x0e\xa4\xabpjB\x86\x89\x03Q\xa8BD\x0f\x83\xaf \xaaO\xbee\x95\xb2kN\xd3\x93\xe8\x07\xe8\x8f\x0c#\x87\xf5\x1a\xccv\xb0P\xd7$V\xb0\x90\x05\xc1\x04e\x148k\xeb+0\x0e\x95\x97\xe0\xb5\xb4\x97X\xdc\xeb&gW\xbaX)\x9f\xb1\xea\xbc\x99\x86\xf3\xechC\xad\x94L\xcb\xa5\x9fe\x88\xdd\x11\xaf\xc3c\x03?\x91\xc7\xc5{\x84\xc9\x1c\x8aa\xc2\xa5\xe3C\xc2\x19\xfb\x96\xd0\xe5y\x1e\xc8f\xb6\xd9\xb2\xd7\x9ay#U'g\x87]\xae\x7fl\x1aq\xbe8B[\xd4\x1c9\xf9<\x8e\xbc\xfa\x0b\xab\xfc\x1crB\xdd-\x1eq\xcd^\x02\x8b\xf97\x83\xf5\xfd\xd6N?\xd6\xc5\x9fk6\xb4pJ\xd7\xbb\x13\x85\xe9\x91\xe04\x95OJ\xab\xdd\xa8\x0d\xaf\x14\x1cM\x02\xf9V\xc8\xd8&4\xd9\x12\xfe\x14I\xfa;gy:I\x03\x7f\x9a4:\x9c\x12\xd3\xf08i\x96\xea6\xfa\xfc\xe4\xa9\xb3&\xcc\xbe\x00\x09/\x83\xb5-\x82(S\x1f\x01\xd5\xad\x84h#L2\x934M\x1dE0\xddt\xf3\xc6\xf6@\x1ay\xd8[\xd57\xd8\xf2#\xb0\xc8\x80\xc2|\x16&:\x0f,\xd4\xa5\xd7\xac\x8f\xac\xc2\x91\xb5S\x87\x8dr\x8d/)IPD\xfa\x95x\x06\xcc\x13\xb6\x95\x0c\x7f4;\xb6\xa2\xe3\xa4$6\xa6>T\x15[\xdb\x1bt\x8aL\xc0A\xe6\xfc\x87JC\x166\x9d\xaf\xc1\xc9\xeb\xc7\\O\x06\xf7w\x93]'\x0aV\xd6z\xb3\x81\xa68\x99\x14\x12o\x0cK\x17P\x16a\xd0\xea;\x18\xd4x\xb5\xb0\x9bzj\xa3\xa4wLu+\xc3\xbc}O\x07(2_\x00\xf5<-_\xcb.\xc6\xae5\xdb\xabw\x90\x8fXy\x1cX\xed\x12i\x9b\xf4\xc4n\xedz\xe5\x0aY~\x125^\xe2\xd5|\x87\x05\x884\xb18\x1fh\x9e\x0d\xf4\xea\x81\x10\x8b\xf4\xd3\xe3\xd4g\xcc\xbeH6\xa0\xf1\\B\xbc?\xda\x00\xda\xe3\xb2\x0f\xc3d\x98\xdeD8\x91\xe7,6\x10\xc3\x946.5M\xf4$_\x96\x13\x0f\x15Q\x06\xd6\xf7\xf0'-\x1aq\x87\xf1&\xe6B\x1f\xa5\x8ad\xf2\xa1\x9b\x8a\x00=N\xcc\x1fB\x118|\xe4Q\xcf\\A-9Q\x0c\xcc\xc2`\xad3\x81\x04\xfa\xd32nt&\xbd\x85_Gg\xb5^\xc2I\x1dO\xe2R\xedF|\xd095\xfdh\xfe\x0cE\xefX\xaa\xfd\xab\x87\xb1\x84\xa8\xbd\x97\xf4\xd9AB7c\x0b \x008\x806s\xc3\x99\x11\x1b7\xe6Q\xec\xfb;\xeel\x14A\xd9;\x82-\x1fuK^\x1d\xbaY\x1e\xbb5\xf9\xaeJQ\xa1\x9d\xb1\xc4\xa7\xd4`\x8a\x98n\xb6\x92@a\xd6'M\x9f\xeaf\xc0\xc9\x18\x04\xda\xe5\xc9(\x0b\xe5\xf1\xa8[\xf7\xb4\x19\x8a\x0b\xa3\x02 \xbe\xd5\x9f\x81b[\x95\x0a/\xeb\x9b\xfa\xed\xfa\x98\xeb*\x16\xf6\xc0\x03,\x04t\x860\x01U\x02\xc18\xf5\xba\xb6\xaa\x16\x02O\xcd$\x0e=\xee\x10\xbc\xc9`;\x03\xf2\xd1L\xb9Te\x0d\xdbP\x82\xea^\xd6W\x7f\x9eZ\xa8\xdbW&\xf2\x0f!M\x8dY@\xd1qPdK\x8b\xfdFc3\x1cX\x18\x9e\xae\x0a*\x12M\xc3\x81\x7f\x81\x99>SiK\xe5\x901\x8b\x90\x02\x18\xe0\x1b\x94,\xef\xaeL\xb2\x1f\xa2\xc4\x11S\xb9j\x92\xb4\x1c\xdb\xc2\xa9`\x06\x8d\xcd\x96x\x90]S\x1a\xa2J\xa8\xd3\xd5\xdd\xac\xbc\xa3\x01}@p\x1c\x1d9\xd4\x1e\xe5F\x11\x0du\x03\x03A\xbd9%\xd5K\xd0yR\xb0\xce\xdc\xe3\xdc\x02G\xd6\x0c\x0f9-FI\\I\xfa#E;\x8e\xc8pD\xd7\x08\xd8\x09\x14:(\xf6\xac\x0c\xfa'\xd1\xa5\xe6\xf3\xc5\xd6\xcc\x8b\xb4\xc3X\x08 9Z\x01}hS\xae\x9cn\x8c\xb0\x08\xb0\xe1C\xa91+\x09\xb3H\xa3VD%\x87<\x8b\xfe\x88\xf98\x99L#\xe5\xe6\x8a,\xfb\x0b-\x07%\x9a\xc8C\xbe\xe8T\xcbx\x99VGI\x99\x8dSN\xa3\xac\xe8\xe7O\xb9\xbd%\xd5J:\xe6\xc4\x07X\xd5\xfa&\xa2\x97\xd8\xbb\x0dK\xe35\x80\xfd/I\x16\x80\x1c\x1b\xab\xecn*\xb5\x18\x14\x10\"ZrD\x198rVZ>Tg\x11\xbc\xf62\xa6\xf6\xa4\x8dm\xb3\x0a{\xaa\xda\x9a\x1cT\x97\xb4\x98\x95\xf9\xc9p\xee\x8a6\xc6\x0c\xc566\x8a\xc9\xad_\xea\xaep\xde\xb7\x89[G\xfeV\x1e\xe7$\x98\xec\x8d\xebg^\x83\xbfw\xde\x8f\xd0\xce\x0b\xc5\x9d\x11\xd0g\xf1\x09\xe5rWV\x8d\xe8B\xf4p\x0d@\x16\x96\xd9|O5y\xb3x\x034PV\x1b!\xed\xc7\x16\x0bF\x05\x18\xb2\xaci\xde\x19\xc2\x96y\xfd\x81\x97\xd3F\x8c<s\xdc\x11\xc5\xfa?\\Rh\xbd\xc8\xd4\xd2\x99E\x92[V&`\x9b]a\xe7\x11\xfdg\x04\xf7\xc4\xe3\xff\x9c\xfa\xa5\x82O\xbf\"#;#\xbaC\x95\xc7\x80d\xe8\xfa\x8ewp\xee\"\x85\x03\xf5-ApW\xf0\xaau\xdfj\xae\x13_\xfc\x0a/\x91 \x12\xdbz\xda\xc6\xf2@\xb4\xac\\\xc0\x1c\xdf\xc5\x15N\xc73&ju\x8c@\xfcN\xd0\xeb\x1a>I6\xdc\x83d\xf7\xaf\xb2\xae\xa4\xca\xbb\xbd\xc4\xf59\xeeaH\x0e{y\xc2\xad\x19\xea\x00;\x14\xb5\xfd\x18\x862\x98\xfb\x87\xf4P\xf1\xed5-Ed6\x0f\xc6\xd5\xdas\x02\xbf2\xe3KB\x18\xec*T)\x16\xe1\xd3\xa6P\xd7\xe3\xdc~\xd2\xadke\xc4}c\xde\xedv\xca\x0e\x91\xb6)\x11\x94E\xc9\x88\xac/\xe6(\x167\xa7\xb0\x80\x8e\xd3\xdd\x07[\xfb.\xc6\x95\x82AV}\x99o\xf5\x16Z\xab\x05\x0a?\x05\x830:\xa7\x197\x85\xf9\xb6>\x0d\xa5\x1b4\x15v\xb5`\xfd\x96\x1f\xc1\x0dT\xd98'4\x89\x98x\xca\xa0\xb5\x8d\xa1\xe4\x0f\xc2\xd8\x8a\xf5\x0fA+\xa0D\xbb\xec\x1f\x0eJ\xff\xe7-\x94h\xdf\xca2L\xfc\x8fy\x1c3,\x9d\xdc\x0b\xecH\xeb<o\xb9\x86\xd8u\"\x93\x08Q=\x9b[H\x09G\xa3\x9c\x09\x95\x1d+ \x8f-\xaazXi\xaao\x19#\x91\xaao\x86\xdf\xdd\xe1\xe3\xc9&\xd2\x0blq(\x09\x93\xdd\xfa\xfa]\xb4=\xf5rP\x7f\x10\x01\xc5\x08$\xd4\xac@\x1f\x8c\x99T%\x1d#\xaa\xef\x15\xa4%\x16\x82\xc4`=\x94G\x98\xf8\x94\x88\xa8y\x8a\xf2\x10\x89^I\xaay\x19y`cKt\xbb|\xa0\xaeT\xe1\xf1Yd\xc0\xb8\"\xc2]4%\x84\xc9\xcb\xa6\x90\x86\xed\x94(Cts\xc5\x09\xae\xe5\x18l\xcb/b#\x9b\xaf%\xa6\xe6\x0fN\x968\x0c\xad\xf3\xb7\xe3\x83SnC\xb2\xb0B\xee\xa06\"\xfef\xce\x91\xc8\x07\xb8%\xc1\x07\x14\x14\xae\x0c+`\xac\x98\x91\xc3\x93\"\x0b\xd9\x0f\x94\x82\x85@]\x99m6\xcc\xcd\x18R\x0a\x05\xb3A/4e\xbe\xa9\xf0\xf60\xd8\xbb\xd9\xb2\x8c-\x0f<\x086!\xf1.\xaa\x95'\x154R\xdc\xac\xc4fou\xbfo\xa9O\x0c\x8d\xabM\x12A\xcd\xc3q\x91\xe3\xd2\xfe\x1e\x96c\xeb\xcb\xee\xe9\x14&\x9e\x9aS\x9d\xa2\xb4\xdd jYq.\x15\xc9\xfcW\x892\x9b4\xca\x02\xd6\x16\x1d\xeaJ\x92\x8e/e\x87b4#\xaaR\x1f/\xac\xe52\x9duo\xd0\x8b}\x80\x13\x94\xc3\x9d`q1\xf5\x98\x18]\xfc\x0ej|\xc7\xee{&\xcd\xb3s]\xbf\xb6\xa0\xbd`\x82\xd7\xc8\x09\xa6r\xe8\xf8EB@k\x9ao\xae\x83#\x8a\x83\xe3A:\xe8\xe3\xcaMx!J\xfc\xa5\xe9\xec\x99\xf6u|\x0e\xc4\xc8\xc2\xfe\xb1}3\x1e>?\x9e\xdb\x0f\x80\x97\x95>\xb6\xe7\xe8\xf0\xc2\xb9\x02\xa3B\xdf\x1e7U\x92\xaaP\xd9\x93\xe8\xcf%\x0cWsMPJ#z\x99P\xeb\xc2\x16\xbd\xc4\xbc\xc5\xe3V\xa0\xc2\x92\x044\xe0\x0d\xab\x06x\xae\xb5\x95\x80\xb9\xe6\x82\x10K\xe1\xc7\x04 h\xe5^\x92\\\xa2\xa9\"\x0a\xf5\xb6T\xa4\x97\xba>\x91\xaf\xa1\xb4\xb8\x9fRk\xa6;\xda=\x1f\xa1\x9d\xce\xe1\x84Mcy\xb6\x0c\xf6\x15\x91\xe8v\x91y\xc9;\xb2\xd4\xecSS\xc6oL,!pzbO\xf8U\xc4\x03\xb7\x0c\xfb7\xf2n\xc4R\x93!\xd7%\x9ex\xe5t\"\xdc\x14\x91\xf3\x8b\x88\x0aK\xd6\xfe%\xc7\x19\xe1\xa3\x8cj\xb4\xc9#\xb4d\xad\xfb\x1ex\x02\xd43ct?CR\xe4\xa9t\x83\xee1\xf9`\xec\xb1\xbd\x81Sz!\xb1N\xe4\xa8\x08\xf62\xffr%\x16\xcf\x0al\x9c\xc0\x92\xbda^\x10j\x89W\xd2\xc4%@\xf1\x07\x82\",x<0\x9a\xa7\x97{Pdx\"\xcf\x9a\xe4'OS\x17 \xde4at:\x82\xe4Y\x92F\xda\xd06\x08\xd0\xcf\x18jU\xc6\x13I]\xde0\x08\xd2\xadO\x0bC\x97\xc0\xc1s\x0a~gH\xddqG\x0bi\xd7\xa6\x00:\x06\xf5P\x02PW\xcd`/\xa7eP&\x18G m\xa1\xb9\xbbL\xfd\xe7U\x9f\x95\x9bb\xd2\x15\xb0\xf2R\xec\xe0L4\x98_\xdb\xbaQA\x14\x93%Q\xd3c\x02,\"|\xbc\x95\xf4\xb5\xbd~\x06\xb7\x86\xc7\xbc\x156BGm\xbaVL)\x92%\xb5\xc0t\xa7\x92\x04:\xc9\xf1\xe1\xbc\xac+\x00\x0a\xe2\xa8\x9d\x0d\xc4+j\xc2\xacN\xaf9=)2\xed\xe9\x05\xfa\xd6\xef\x11\xf5H&V\x0e\x99\xff\xbbYS\xe8\xea\xb4m\xe8\x8b\xa9:\xde\xc1\xa8\x81v\xdbg\xe3\xee8\xa9\xc4D\x99R{>\xb9\xcc\xe2\"\xd2\x00\xb8i\xc5p\xdc<\x08\x18a1\xe8U\x84\x1e\x90&P\xc1\xfe&\xb4\xa8\xc4\xfcB\x91\xc9\xbb\xf5\xaa\xda>\xa1\x16\xa2\xc9\x95\x84 jk/g\x063!\xf9\xe5~\x94C'\x0f.\xca'\xc1\x8f\xf8\xa8\x7f)\x12\x85X\xfc\xcdz\xac\xce\x86 h\x16\x11)\x16\x1d\x89G(\\\xb2\xe7\xad\x9a\xf8Z\xc7m/\x81\xe6\x1aV\xbaT\x12\x07\xb6\xe1J\x88N\xda`\xfe\x04\xc2\xb1\\\xa1\x82X\x1ag\xc0\xc5)\x95\xee\xa9\xe1\xebM\x1c\xbf\xb6D\x96\xecP,\xc3\xd1\x8d\xa8FJ\xc7 (\x09H)Jq\x8a\x93y\xbb\x84>\xf9\x95\xf4\x0b\xd4%zr>\xe1\xae\xa8W\xa0\xa3\xa3\xea\xd0\xd0\x03\xackc\xb7T\x16Z\xd9\x8f\xac(z\xfa1\\\xd2\xcb\\\x7f\x08\x8ef\x9c\xa3\x1e\xb9\xeb\x0d\xb5\xfdZ\x87\xe4\xe0Q\xd0\x1a\x99K\xa8\x92R\xa3\x84\x7fH\xfc\x15X\x15\xd0lG`\xb2\xc7?Q\xe4\xf9'\xc0\xc0FzE\xe2\xf3\x1f%\xd2\xe23\xbc\xfa\xa5\xa1\x97\x8ba\x96`\xd2\x88\xce\x120\xa5\xd47\xea\x0b\xf0A,J\xbf\x84X\xac\x99\xf49 2\x12\x99}\x06\xc3x\\\xd2U\x10\x1e\x04\x8e\x10\xa6\x90\x1c\x9d\x97N\x06\xf7L\xd1JS/jid9:,5K\xa6\xec\xc7\x1eG\xc0\xf6\x0b\xb3\xea\x9aiTT\x95Q\xae)`*\xbd\x16\x8c4\xd2'Z@\xe69\xf2\x82\xddK4tiTv\xc3\x07Q\xc4\x05\x98B\x9a\x11\x1e\x1d'\x92\x8a]V\xeb\xcaA\xfd\x1e\x96\xd1H\"s~\xda\x08n{\xe83mb[Q\x19a\x0dv4\x1c\xf1\x00\x1d8\xf0\x9f\x97]m\x91\xe1\x92(?Tq\xd3\x05.\x00\xda\x8a\x13\xd8J\x85@\xb8W\xb2D\x8a$\x0f\xb2!\x12\xaf\xb7\xde\x0ao\xc3\x91J\xb5\xf5U\x9f\x05\xb5\xf5\x98\x055\xa6\x12\x01\x0d\xd81\xc5\xf1Q?\xc4\xba\xc6\xeb\xaf\xf1\x85)\xf9WI\xb0!fbJ\x86\x1f\xab\x86\xad\xd7\xf1h\xc1\xa6T\x89\xf7`TS\xc5z\xc6\x1f\x08\x85\\$\xc9J:n\x11,\xc6\xe2\xc0\\1:\x05\x1f\x1d*\xae\x12<%\xfa\xbe\x0d\xb0\xa1\x0f;\x13\x9e\xfe+\xbcp|\x1cU\x0b\xa9\x8c\xb9\x95#\xb1\xa0\xa5r\x8dc!\xff\x1c8\x86\x19\xe5\x8c`\x03\xcc[**\x04\xca\xd8H\xe69\x10\xfdoW\xd5\x0a%\x08\xe9\xd1\xb9zZ\xeb\xeb\x11\xb7\x7f\x7fC\xed\xb7rRE\xc7\xc3\x03\x824{\xf0\xc8\xd9M\x9fj\x1a\x80xV]c^R\x16\xd2\x94\xa2\xf8\x9c\xe9\xa5g\x01\xe6\xba\xfe\x1e\xb4\xd3h\x19\xf5\x0f\xf1\x8e\xdd\xa6h\xc7 F\x19L0W*\x9e3%\x18\xd5\xb94<\xe1{/m\xed\xea\xa7T\xfd\xc2\x16E\x91\xff\x12\x9b^{aKws\x9cTsT\xb6\x022\x0f\x1f\xa2$\xbe\x90\x92\xce\xf4\x029\x89/8W8\x82w\xac\xc4Z\xce|l\x7f\x9d\x89J3\x1fg\x7f\xbdp\xc1\xd2\xe6\xe3\xf4\xc9\xc3\x0c\\\x85\xec\xec\x83\xf3\xe4\xea\xe3\xcc\xc8-)\xdbr\xe1\xbeA\xe6k\xd97\xf9\xde\x9dG\xf3\xb1\xf3&7\x84\xf4\xf9Q\x16\xf6\xb1\xbc~mc\x82\xf4W\xc9#\xbd!\x1a\x099\xb8\x1d\xd9\xb4\xdc\xcf\xa5\x18\x85p\xfaR\x13\xa4\xb9\x0e\xd7\x19~*\x07\x12s\xfcc=\xbf=G\xb1Z\xef\xd0\xca\xac\xf6s\xc3\xaa\xa7w\x02\x16\xcb\x99\xe0\x1d\x93E=\xc1\x10\xdcE\xf6R7\x9c\xb2Z\xb0\xe5\x8a\x87\x85YagO\x94:V\xa0\xccxE\x93\xca\x11\x0e,\x14\x18\xfb\xba\xfb##+\x1b\x7f\xa2%\xac\xe8(g\x11\xab\xd6\x99\xc8\xe0\x0a\x97\x0a\xce\xc2\x1e\xa4\xe0\x07\xc2\xae N\xfe:\xc1\xa9T\xe13?\x0a\x0e`\xfaM\x1a\xb8;Te\xd2\xb5\xb1BJ\x93\x15RX\xa2\xb5\xcd#\x84X\xd4\xfdN.\x93\x94\xc0:\xe6c\xcb\xbc\x0eI\xd8\xder?\x1d\xba\xcb\xaf\xb9\xf2\xa5\x890\x0a\x0fl\x8en%\xf0\xb1\x09D*^\xa8\xbe\xe2\xc9\x96\x99n\xb9\xa6\xdf\xc2\xa9~k\xa1+\x85\xe0#\xc0\xb5\xfc" +
+	"\x96(\x89\xae\xd1\xd9^\xbf*~\x1b\xe2\x93\xf5\xf5T\xdb\xd2\xb1.\x9d\x8c\xf39v2H7\xb0l\xaf\x09\xb8\x9d\xf6\x1eT\x1e\xfe\xff{\xd3\xb1g\xb71\xc9\xe2\xdb\x06\x04\xf3\xae6\xfe\xe2=\xe8\xc2'\x00\x04\xdc\x0d\x9f\xd8N\x99\xac\xd3V\x1e\xb3\x04T\xc8\xca$-0@\xe7\xa6osD\x9ez\xd6\x14G\xf4\x00r\xafx\x90\x7f\xaa\x8b\x99\x14\xe0k.l\xb9\x1d\x98\xe5F\x03w\x04\x10\xfa\x95\x08\x1b\x80G\xad\x1b?\xb6}\xd8\xa1\x83\x0b\xe8\xc4\x8b\x90\xd1\x15$\x1c\x10n5c>\xc0R\xaf\xb0\x99\x89\x0d\xc9Z\x8feR\xe37\xdf\x19\x88h\xef\xa5\xad\xe29\xc6\xa4\xbdK\x08\xcalU3\xab\x17\xad\x83\xb1\xf3\xa2mYA\xfa\x85\xbb\x16\xce\xb07\x86bn\x1b\x9f\x0e(\"`\xe4\xa4)n\x1e\xf3\xf7\xc4\xf9\xe2\x0d&8]Ki\xe13p\xf2n\x90m&Ci\xbb\xe0\xf2C5\xfcI \xcffn\xc3o\x8f\xb9&\xd0\xb7@\xb9\xa3A\xa1wD\x81x\x83)\xf7\x01\xb4\x98\xccS\xff\xe9]\x9f~2%P\xcd\xdc\x9e\x06\x85\xf1\x0e\xf7\x0e\xa8\xf4\xa5\x90\x92S\xa1\x11\x88\xe5oR\xc5\xe7\xc0\x93\xfb\x86\x0b\x1f\xf9DL\xd7\xa5\xf0\x82m\x9f2\xb6\x0c\xac\x9c\xd4XWw\x0b\x00\x9fz\xac\x8c\x1d\xc5\x05tCi\x01\xd5\xd3\"\x0b\xd5\xe1?d\x9ee\xca\x06\xb6\xb0z\x0dIj\xb4\xd0$\x05\xd7z\xb5\xf4IS\xed\xec>\xae\x91UrU\xf8\x18\xfa\xe8\x1e\xddS\xf3\x9e\"\x0f.\x95\xec1}0\x15\x94\xad\xadO\xad\x0bO\xb7\x90-]?\xddK\xd3?\xe3\xa8?\x81c\xa9d;\xa1\x80\x13\xfd\x02\xf6[\x9bi\x9f\xa7\xd1~\xab|\xb3\xaa\xc3K\xd1\x92\x00\x0eb.\xd3N\xf7\x9eSe\xc7=\xf3\x05;Y\x99\xb8\xe7\xe94\xa6\x99x\xc0\xe5\xf8FsK\xfd9[`\x9f\xa3IE\xf8\x82\xd3\xf8d\xa1 \xb1L\x84\xe1\x9d\xbc\x88\xc4\"\x7f\xc0`\x89j\x8a\x13\xb4\xcc*\xf3\xa4x)\x8c\xbes\x11\x1a=\xcd\xafF\x1c\xbeJ|NRS\xb7\xe9\xad\x87\xb0;Q\x86\x12\xf3\xee\x90\x12'\xa0\xb0\xa8k\x19m\xa4S\xeb\x04\xcb\xd2\x95\xfe\xf8\xf1\xc5\xab\xdd\x17\xcfd\xa8n \x018\xd6\xd9\xfe\xe3\\sr\xa1\x1b\x93\x97\xf1@#\xb2\x9cz\x19\x7f\x88,PX\x86\xe5\xe1\xc09\x99\x90\xd7\x03-\x9f\xb3\x86Y\xb8K\xee[\x97\xe4\xd6\xcdH\x17\x0c2Q-\x05\x9e\x8b\xa6\x90\xab\x0e\xfbL\xab\xceop\xc3\xb4\xff\xbd\xd7\xaa\xff\xa5\xed\x1b\xf2J\xce\xc8Qgd\xa1\xd3\xb3\x0f*~\x06\x87?\x1ey\xc1C\x99`\xadG\xe4Y^RE\xf7\x94\xb1\x9aql9\x96V\xc1e\x1a\x10e\xb1\xd2\xd9\xb6\xd8\xa9fn\x9b\x86\\\xd6\x0d\xdd\x87\x87\xaa~\x07O\x09\xd3v\xeci\xb4\xb4\x1f\x10\x9cby \xc5$%\xb3\xa6\xc2\xb5\xd6\xee\xb5l\x10\x9cd\x84\xbc\xc5\x8ap^\x8f\x0cDD\xed\x1dA;\xaeU\x1d\x9d\xffd=\xce\xbbC\xe1\x8e!\xf1,\x92\xf4`\xaa\x03AIe6\xa6_\xc2\xd4\x9cj\xbd!A6\xe1]\x8c\xd2ka\x8e\xa1_\xac\xa8?\x0a\xb3!\x89\xc5X\x02\x96\xa4\xdcT\x99N\xb6\xa2{#\xaa\xca\xbb\x86dM\x1c\x1dGX\xdf\xf2a\xd5IQLH\x00\x16\xf2\xd3\x99\x00\xa4\xccD\xd5\x10\xd6\x8f\xb6\x8f\xf2\xd2\xa4\xc5bG9%Bh\x91\xbb0h\x9e\x9e\xed\xf2.\xcdI\x9f\x88owKcby`bh^\x8a3$\xad\xc9z\x1aj*\x0d &H\x81\x00Y\xe0;9\xb3\xed.>\x1f$\xa2q\xe1N\xd7\xf1\x92\xb8\xebb\xd5G\xcf\xb6\x86\x09opX=\x15\x12\x14\x17bs\xd4#;\xb1\xbb\xfdt\x07N\x96yo\x892\x97P{\xa2W\xceO\x9dy\xed\xfb\x08\xbd\x0f\xdb/AF\xcb8\xe4\x03A\x81&\xa8\xfa8C\x11\x82\xb8\xaf\x7fL\xc9\x02\xa6\x9a\xbb]\x91,\xb3;\xba^N\xd0\xd3\xbe\xd4m\x88\xf65m\x8b\xc3\x07h\x86\x9f4s\xf3S\x80\xa9\xde\x0bf^[\xf3\x10\xad\x8c\x80mPm07\x84\xf7zed\xf0\x12\xe6\x14\x05\xf6\xc6\xf1\xd4Jt\xb2\xefqdz)o@\xd7y\x96\xed\xd6\xd1\x09L\xc3\xbd\x9a\xfe>\x11+Q\xadaE\xe4\"\x96\xaa\x9b\xae\x0b\x0f\xca$H\xc3\x8b<\xb0\xb2\x19 u\xf9O\xc9\x09\xf9c\xcc\xdeA\x02-j\x85\xda\x91\x03\x08\xc0\x1c!\x95HB\x9d\xb5\x8d)\x082\xf5S30\x7f6R8CB\xd6\xaa5y\x97Z9r\x9a\x04#\xbf\xf6\xbb[\x95'/\xc9NZ\xd1\xf1\x96\xa5\x95'\xa8\xe9]*\xdc52Pq0\x16\x94n\xbeJ\x14\xb2.\x99\xb2\x1e+>0\xef\x1d08\x93\x9ezr\xcerw79\xc1\xda\\\x8a\x93\x84\xa9\xd0\xb2\x01\xa5\xf0\x19\xcd\x95\x94\xff\x9e\xdf\x1f\xd7u=\x846=\xf6\xa7@\xe3\xc1\x12;\xfeO\x05J+\xfb\x06\x1b\xfa\xc8\xd2\xc7\xe1\xba\xa0_8\xdf\x84\xae\xad\xf5h\xbdG\xd9\x90\x0e\x0a\x81\xdd\xbdne\xb3l\xa2\x96\\5\xce\xf6\xe6\xeat\xf60}B\x1eF\xdc\xca\x13*\xc7\x0c\x04\xf5\x13\x89V\x8a\xbe\x8d(\xbb\xfb\xb9Qq\x8aDd\x0c7A\xcb\"\x81\xf1\xd4\x96\xf1\xc5Qe\x88\xad\x12\xd8q\x0a\xae\xad\xba\xf3\xc4\x96\xe7\x05\xf2\x9c'=\xea\xf3\xbb\x07\x1ag\xd5{8\x1e\x0d'J\xc4\xfa\xb9$~\x0c\x87\xf7\x9d\xec\xfe3r\xec7$\xc7^hO\x0fe\xbdT\xa7\xd2\xda\xb8\xbc\xd2\x1ac\xfdp\x0b\xbb\x87?\x19b=M\xb4\xd0\x9f\x15\x9e\xb4+\x8d\x10\xc5\xb0\xe6\xe2O\xadY\xc1\x01\xa8\xe8\x02L*\xb8\xe7\xd2\x18\xf6\x886\xa1Kh=m!\x13\xa3TC\x85\x80D\xfd\x06\x9e\xa1G\xf1\xee)tg/\xcc\xf9\xf7\xbd%\xa4\xe3\xe6\xac\xe6D\x8d\xe7O\xc5\x9a\xa2\x17?&'F~siX\xf8\xb7x\x9f\xb8\xd5\x91\x8f\xfd#\x0a0\x0a\xd5K\x11:\x1a\x85\x1e9\xfe\xb16{*{W\x04S\x98Ki\xd1\xb7\x8dto\xa8\xeb\xc9\x00[\x8fw\xa1Ex\xc9\x93\x8f[{\xfd\xa4G8\x10,\x15Q\x91\xc3\x89\x8el\xcc@\xab^9\xc8\xa8\xf7x:\x0a\xe8\x7fj \xc3\x98C\xfbH\x8f\x00\xe1\x91\xbcm\xda-\xbbI\xdf\xdf\x9e\x8e\x8de\x84q\xdc\x14\x8e0\xad\x94\x1eIUI\x91\x92\"\xc1\x09KE\xd3\xe6\x84\xff\xe4\xfa\xd4Z\xb3\xb7d\x91PGr\xd1{\x90\x82\x19\xe5O#I\xce\x11V4;R\x05{=\xb4Z\xe5\xf1\xdbY\xf6j;M\xef\x88M\xae\xbd\xf8\xb6\xc7`\xdd\xd7JVW\x9a\x0d\xd9\xc1\xc0\xe1\x8d\xd5\xffU\x14\x1b\xcfA\x9a\xb1\xb7'\xd7\x0f\xb7P/\xc3F\x849\x8a\xcd\xf2\xa7X\xe6\x08#p\x922p*Q\x0a\x9f,{\xc65\x97\x19\xa5\xd0\xe9\xbd?p\x17\x82\x0fw\xc9|\xcb\x82H\xda\xdew\xbfM\x1c.\x11\xf9J\x0aH\xe6\xe6w$\x83\xc8\xaf\x02\xe7X\x09}\x03P\xc2\x91B`\x97\x80&\x13>s}%7\x02Q\xb8\xb6\xbd\x099d\xe9c\x7fK1\xce$\"4\x9d\x0e\xa1I\x9b^\x87p1\x136\xee1\x0d\xc1F%\xe8\xbd\xc3g\xcd\xe2\xb1uhN\xf11\xbc\xdf\xd7o\xe2\xb2\xb6\x9a\x16a\"\xb8\xe1\xe3R\xf6\xd7*\xbceeX\x16h\xb5\xa4\x14I-\xe60\xd6\x1e\x9a\x93vj\xcd\x17\xcf\x82-2\xca\xe3\xe96\x92\xfb\x12\xdeK\xf4\xdf\x84E\xdd\x87\x81n\x7f\xa8\xf4V\xdf\x85+\x84\x8e\xc54yd\x98FT^j\xa6@\xdd\xf6\xc7o\x84\x84V\x91\xe2\x87\xc0\xd9T0\x86\xcd\x00\xcc\x90\x9c{\x8b.\xeai(xl\x04#\xdf\xdfJ\x8f\x1d\xfc\xbd\xa9\xceI\x19ZX:\xf1\xc4\xd31\xf3&\xca\xc0\xea\xcf\xd7\xc0r{EM[x\xa4\xc8\x16T*d\xde@\xb80&\xf4\x11E\x98\x97\xa0\xba9b\xb50+r\x14\xbew\xc3\x09\x02\xdd\xa7\xa8\xc5UKC\xd9\xaf\x92\x1a?RVt\xc2\xc8\x80\x93\x1e\x80\xd8\xfd\xed\x84\xf7\xc0\xe5'\xf0:\xe8\xf3H\x16\xff+\x99~\x8f{\xf0n\x8e3\xd7\x10_\x90W\xb0\xe4\x07\xd9\x0a\xca\xd3\x9br\x9e'\xaa\xca\xab'\xec\x90z\xc2]\xbd\xa8\xbf\xeb%\xb1>\x17*}\xa5\xed\x0c\xb5\x90B!\xa1k$Z[\xf6I\xabF\xfe{\x91\x9e\x16\xcbB?\x94\xfae\x9aj\xf1\xd4\xcdQ7A\xf4e\xf0\xf1X\xfe\x99\xa4\x14r\xe5\xd4\x08?\x8c\xa2,\x1d\xc34\xd1\x90e\x94\xbav\xec\x81\xbc\xf8\x14\xf9N;\xb4\xb6\xed-\xa4\x9av\xaa\x9a&\x87\xdd\x8c\x86\x92\xae\xc6u]\xf6\x93h\xa0\xe3\xbd\xe8\xc5\xf5!+\x8a\x97\x14y&\xae\x1c\xc64\xa5\x08\xae\x16\xb4\xb7\xe9|ZHMT}I\x8e\xebE\x16\x0f\xb5\xba+W\xab{\xac\x87\xf2mpFK\xa5\xac\x12f$\xa4\xbc\xc8\xab\x89\x0c\x84+\x02\x83\xf5\xdcf\xe3\x9e\xdb\x0c]\xe8w\xf1i\xeb\x15-\x0b\x9dR2\xdf\x17\xdaoe\xca5j\x09\xdc\xcfm\xee\xee\xbc\x874F\x0bc\xce\xb3\x8a+\xe6x\xdcZ\xda\xdb\xcep\x89`\x1f\xfa\x99\x86\xf7\x9e\xf5\x10\xc3\xf7\xb3U\x10\xbe\x92s\x8c\xa5\x9eT}\x0c\xe8\x11\x81z\xf9\xadL\xe0\xe4_\xc7\xa6\x9b\xcf\x9c\xde\x80\xf5X\x9fk\xb2\xf1\xb3n\xcd\x02\x01A\xa1\xe3\xd1\xbc\xda%\x14\xf3yF\xa0\xcbg,)\xc2T\x17YI\xc6\xaa\xe8/)\xe3\x9d\x00G\x0a'#%:\x04 \xe0\x1d\x01\x8e?\xf8\xf2\xf9\x95>\x90\xa23^\xf5#\xe5\xdf\xd4\x90\x9d\xa0<\xf9\x14(\xb2b\\\x9a@HA\xd5\x9b\xb4af\xe0\x1f\x85\xf1\xa8\xbf\x97?\xe1byX=o\xa1\xc7U\x00\x0d\x84\xc0\xf4$\xfc\xad\x08\xdf*\xa2\x80:{\x8a\xc7\"\xec\xec\xd6Z\xef!\xd7\x8f\xda\xf3BG<\x8fB\x8e\x82\x99\x95\xa4\xc5\x97\x9a\x9aR\x83\xfa\x0c\xedz\xe5\x8e\xfa\xd8UN\xb6\x8c\xe5\x89\x9b\xa1\xac\xaa\x1f\xa3\xd7\xef\x99E\x80o?\x87\xcay\xd7\xca94\x03\x93\x17\x8a\xe3\xe5+8\xcbtP1\x93\x9d\x89\x89\xf6\x0eN\xdb(\x07tw\xfd\x02\xe7DA\xe0g\x1c\xfa\xfbxZ\xae\xff3:'wM\xb5\x9e\xe1A\xc2\xf5\xa4.#y\xc8\xa6\x80\x8d:j\xaa5\xa2\"\x83\xa1\x8c\x12\xf2\x05\x0amt<{\x7f\xc1?B\x89\xaaJ\xff\x03e\x81\xcfu?\xc2\xa29\xefA\xfe\xdf-\xf1\x09:\xee\xa7 aP\xb08;%\xaaD\xa7\xc1\x0ca\x03\x1c^\x92RL\x8c\xb4H\x00\xf1\xc1\xb2\x02\x05\x1avF<\x9d\x12|F(\x0f\xa6~8\xed\xea\xa2\x07O\x7f\x8ci\xc3\xe2\xd7\xbc1w\xb8_\xea\x8fp\xae\x9d3M\xd8-tj\xccS_g\xfd\xd7\xf5\x13\xc0[hE\x06\x94\xf3tu\xfa\x81Wb\x13=\x87\xb3\x9e,\xffT<\x18\x1b\xb0\x81p\x86\xa3JE\x11\x99\xf4c\xb6X\xe4\x8f\xee\xe1HZ\xb1g\x81\xb0\x88\x9b\x96o]RY\xdd\xb0\xe4\x80S9S\xdcY\xb8\x8f\xb8\xdf*\xa3\xdd\xafY\x94\xc4 i\xdb\xd2\xcc\x80\xd9\x91Z\xb3M'\x8f\x15\xde\xb5\xed\xedo\xc3\xf4\xcf\x1dyWE\xc6j\xd4[Q\xee\xf8Hx\xcc\x8c\xd5\xd5:\xc5\xb0h\xb0\x1cP\xf5\xd1-p\xa5\xb0\xd3`w\xe6\xe0\x94\xd1Y\xbc\xc1|{\xb0\x0bLK\x17\x7f\x1b[\x06\x89\x05\x10J\x8cq\xc5\x9cs\xcat\x0e\x02n\xf2`i\x8ep\xf5\xd4+Bk\x82\x94\xb9\x10)u\xf6\xc5\xb7\xe1~\x89q\x09b\xa9\xc7\x9a\xe5P\x96\x13\xcf\x14\x13\xe0\x03\x9a\x198N`}\xdf\x93K\xd0\x1e\xd7\x09q\x81l\xf7\xfa,\x8b\x98O\xf9\xa1\xb9\x1d\xff6\xd1\x9d\x16\xde\x83\xf8E\xc5\\\xec\x04\xb9\xba\x85\x11\xbe\x80\x80\xd0;\xd9|\x09TD\x1c2\xec\xc6\xbeV\xc83\xac\x90\xc3\xad j\xb1\x02\x98\xe9*\xbb\xf5\xb8\x9f!\xbe\x8e\xc75<\xb3*mr\x0fs\xc0I\xa3\x03\xea\x08\x15i#\xb4\xb9/J/\x0bt\xdb\xfah$\x8c\xb3\xac\xc4\x15\xe0A\x8a\xfa;\x8e^Q\x9d`\xfdU-\xc8\x8b\x87\xef\x99\xd4\xb8G`H\x05\xa6Ac\x9f\xe3\xd1\xb1\x06\xc6R.\xfb\x1c]Y\xa9\x02\x8f!N\x0c\xd9\x94y\xd2\xf0\xd4\x90`U\xd2GcnV\x8ee\xf3[|\xc8\x0f0\xa5qg\x88\xd00^\xfaL'\xf3\xce\xfaH0h\xf9\xd5\xa2~\x8fO\xb1\xa98\xd2\x1dw\x80\xc8\xd9\x06\xc1rB\xb2\x10\xb8](|\x83C\xad\xe7@_=\xfd(L\xd0\x1b\x81\xe8\x0b\x7f\x8eq\xdc1\xc4'\xeb-\xb1\xfe}\xfd\x01\xe7\xed2J\xba4c\xfc\x83\xe2\xa8\xa4\xf0\xf4.\xf2\x1f\x06\xc3\xa7l\xd4y\x0fU\x07\x8d\x8e\xb9\x86\xa6\x8e\x99\xf3\xc6\x02r\xab\xe31\xe8g\xc2\x98\x0c\x90\x1a14t\x18\x01\xe6p\x858\xefE\x1f}\x04_\xfc\xdc\xd7\xfe>\xb5\xd5\x03)\x89\xad\x02\xf0%\xba>\xc8H*\xc4\xb6U\x12/\xfev-\xe9\xae\xf4=\xb9\x1d\x9d\x9c3\xea\x13\xb3\xd7\xe03\x97\xfcJb.4\x92\xe3\xa2\xe2\xccZ\x90\xc2\x0d\x9d\xc5\xc1\xc4\xca\xc3\xb7:\xc3\xdeCF\x81\xaaIm\x95\xe4\x05\xb6)Gg\xdc\x86N(\xb0\x05\xa79W\x92\xa14\"d2\xa5\xc8\xa2\x11\xcat%\xf4\xb9\x06w\xc3'j\xfdJ\x02\xbf1\xf2\xb6\xbf\xea\x1b\xa5\xf7\x934Oc\x9cw8J\xe1\xb5\x01\xb9\x8a\xc7\xfby\x1f\x01\x81$\xcayGV\xe6\xa8\x8dR\x9a$\xdfg\x11\x16\xf3\xa3d\xfb\xc7\x92 \x8a\x0f\x17\xdc\xe9\x9ad 5[pO\xea\xf2>\xfc\xfbyP\xbe\xc7k\xa4tV\xee@l\xec\x03\xf9z\xfa\xa9\xc7\xb0\xc2\xf8l/\x06\xb2\x98\xca\xcf[\xb6\xa4&t\xff\x1a\xa5\xc2lI\x00V\xc6=4~\x9f\xebS\xab\xb4\x94\xa4YP\xf3\x0d\xe6\x7f\xea\x1f\x9b\xb3>\x0c\xfd1\xdd\x1e\"B\x12\xb9\xcd\x94\xd0\xdfR\x1e\xd7\xea\x08\xb5\x89\xd6p\x96}\xfdCj=`\x91b\x95^/~\xca\xf4\xfaD\xc5d\x02\x98\x03\x8b\xbe\x10\xaeIl\xeb\x997\xc70\x17\xe6\xc7\x19\x85\xcbi\xdc#H\xc9x\xde\x87\xc7_\xd3k\x1a\xfdyp\xe6G\xaaF\xc2 \x0ag\xb3\x8dQ!\x829\x06*\xb3+nP\xa7\x09\x84\xc11\x099\xc2$\xe4\xfaN\xe4\xday\xac\xe0\xf3\x04\xe61\x01ZI\x05\x01)\x06j\x09\x97\xde/\xdb\x05\x9c\xd9M\"\x10W)\x0etY4\xec\xe6\xfc7RR\xd4\xa1\xf9\xb6)\x9b#|\xc2\xb6\x94U\x0f\x8c\x01\xef\xa7\x94\xc8\xce\xe2\xa4b\xdf\xcbw\xf0^\x91\x10D\xc1\x87h\xbb\x9f\x848\xf3\xa5P\xbb\xa5\xb5.\xd9\xe46\xa8\xeb\x08B\x7f)1\x86\x84\x17\x19E\x16\xd9xO\x14\xc2\x13W\x9bi\xaa\xafb~\xef\xa6{\xfa\xb0\xe8\xd3\x1cB!\x0ari:\x17\x02\x8f#\x16\xdf\xd4\xea\xdd\xad\x7fO\x19\xee\x9b\xcc\xef\xd9\xaa\xeb67\xb3<\x81\xc8\xfc\x02\x13D\xe9\xaa\x9d\xb4-\xd0T\xeb\x0c\x96\x14#\x81\x8bL\x01\xa7\x10\x0f\x97\xe8k\xaf\x93\x09\xe0a\"C\xc2\xfa\x9f<\xd1\x10<\x99\x02\x8d\x05\x81\xa8\x8aK\xe9\x96\xf1\xfb0\xd5\xb3\x0dm#\xc3Q\xa6\x0c\xf1\xa6\x17:\xfd+\xb1\xba\xa0j}o\x884-M\x8em\x0e\xc5\xee\xee\x90ky%\xf5n\xe4\xa5M\x85XG\xf8=9\xe4}\x84'\xb4>\xc6\xad]HM\xbb9\x94KAD0a\xa0\x9f\x10\xcd\xb7\xa6~\xedC\xdb.7\xba6v3(]\xde\xb2\xde\xd3<t\xe1J\xab\xa0\xa59_+9\x07U4\x87t\xf2C\xaa\x81'\xaf\x02$tU\x8f!5L\xea\x9bC\x10H\x11[b\x7fX\x94R\x8d\xff\x11\xb4\x1a|\xcb\x1c\xf1cm\x0e\xe8\x86\x1d\x09X\xddo.Ji\xf1\xcc\x15\x82\xbb\x0cU\x90K\x04\x14\x87\xd7\x7f\x1a\xc9\x91&\x04\xa5\xca\xe7\xcar\x14I\xabfD\xdfE\x91U\x8a\xbd'\x84\xe6\x85\xab\x94k\xcc\xd0\x15\xcch\xb9\xae\x84\x84\xc7\x1b\xf1\xd0W\xf9\xef+\xd0\x82\xd2\x15S\xed\xb0\xb4\xec\x09\xf18\xe5N\xfcM\xc2\x86\xed-%}\x98]A\xed\x0fXm2\x00\xf1\x0d\x00\x9f\x14\x02\x05\x90_b&}]A\x16t'\xf1\xccWst\x0b&\xd2(x\xb3\xb2\x1e\xd8sa.\xd0#\xb8\x86\xac\xfd\xa4{\x986\xc0\xfb<\xe3\xdc\xf3\xd3O\xe0S\xc3f9\x93\x12\xa4d\xaac\x00\xb5~\xb5\x81\xa2\xc3]\xdb(\xe8\x1a\x82\x9d#0\xf4T\x16]\xdf\x07\xd8\x9e\x8b,}\xd6\x14|\x1d\xf0\xc7\x84#\x1d.E\x9a\x85/%&\x14\x09\x9e\x84\x93;\x05bHPE&9\xddT\x1d\xed\xb4)}!\x0b*Q$Y)vE?\xe2\xfei\xc6\"\xe7p)!\xf9\xcc\xba\xdb\x1b\xf50\x835\xf4" +
+	"V\xd2pz\xb4\x18\x99\xecx?GO\xf3\xd4\xb7\xfa\x0bRFa\xfd)\xe4\xa9E\x8a\xb2\x99\xf2\xeb\xc5\xc4\x89m\xd3\xc21\x8aL\xe3\xd8\x88\xc7\x92>\xdac\x17\xd5\xcaK8#\xee\xc2\x05\x13\xcfKu\xcat\xbe\xf3nK\xb3\x856\x1e\xdaca\x1el\xda\x12r\x09\xb2!\xd5\xc4\x82Er\xd9\xf8b\x8c\x08.(~(\xfap\x82\xa7\xedP\x03\x8d\xd6\x9b\x85\xff\x98\x07\x89\xe9\x0fZ\x0c!\xe6\xfe\x0b\x11\xb6&\xa5<v\xa2\x82\xbb\xc0\x9ap?\x8f;\xa7)\xb7\xc5\x92T\x03_&\xd4\xad\"\xc6\xa3Y(\xa5\x06\xaaI\x08C/\x11\x8c\xcbBC\x8b0\xdc\x9f\xa2\xd0`\x89q\x07\xb8\x13j\x1b\xa2$C\xfcga\x11xEZ\x96\x87RF;P\x0d\xd2\xf40/\x04\xaa\xc1\xae\x99J\xed\x10\x0b\xf8kN\xc5\xa5t\x9e\x06\xc5\xecG\x0c\x01\xfcVC\x7f\xfa0=g\xfb\xad\xa3\xc3\xf9 \xec\xd63\xa1\xf8\xdaPG\xa8\xc5\xf4^\x04rE\x0f\x9a\xe5\x9fz3M\xfc\xda\xb1dd\xd6\x1bm\xacE\x1b\x0b\x99[\x97Q''\xc0T\xb9h$\x82e\xa4\xaa\x0d\x8f7\x92\xfa\x99\xf8\x0d!\xf2'^\x0c\xcb:\xdd\xb1\xce\xce\x8b$\xf4\x92,\xb2aF\xeea\xb5\xc9e\x0d\xd8\xc1HCc\x93\x04\x09\xaf\xfe\xdcaz\xf8\x01\xf9'O\x9a\xa2\xfeSS\xc2\xc7\x04qJ\x12<\xfe1\xb9\xde\xf7\xdc\xbc\xd8\x87^\xd9$\xd3\xee\x80m\xe5\x06.\xcf\xac\x8d\xb1q[\xf7C\x96\xbc\xecu\x16\x17\x92\xe5\xd2\xa3\xec~\\[<Olr)\xebz\"\x1d\xef\x80\xfd\xfap7\x8f\\]\xdbL\xc2Y\x07B\"\xcfV\xa9\x08(>80\xcd\x9bvQ\xd8\xcc\x17*\xcb\xd0\"\xbb \x9bvDe*2?\x1c\x09r\x0e\xe1\xde%\x1d\xa2\xe6\x98P\xae=\xc5\xaf1i\x078L<>:\xc6q\xdbQ\x92\xc0+\x09\x09 \xbf6\xc4\x96\xb3\xd4e\xd1\x0b\xd1Vs\xee'\x89U\xcaC\xd5i\x197{\x96H.\x89n\xacwa\xd1Y\xb0\xbf\xcb\xc9n\xd6)s\xf4\xfc\xc9\x9e\xd8\x9b=\xe9w\x11<\xd9\x1c#\"\xa7\x14h\xd9\x97\xcd7\xfa\x9a\xbd\xf3\x14\x95\xcd5\xaa\xe8\x80\xe3=\x15\xc7C]\xc9\x7f\xd0\xbb\xd8j\x181j:7|\xd6\x83\xa3[Z}\x83\x8c\xb4\x85\x8f\xf5p\x02\xd9\xd39\xa6B\x82!\x87\xaeB\xdd7\xd7\xee\xda+h\xb9\xaa8,`a\x0a\xbc\xb9u\xf3\xfb\x10yc\xbb\x17z\xbb\xfe\x0c\xa5\x1c\xc3.b\x17\x1aU\xa2\x00\x02X\xd3\xb0\xea\xeeI\xe5\xb1u\x0c\xa1.\xad\x1e\x88\x0a\xe4%\xd4)A\x05\xa5 \x1a\xd1\x91Q\xc9\x9abB\xb6\x15X\xb7\xd3\xe6\xb6\xcbIy\x08\xdaF\xd2a%\x00d\x05h\x9bsC\x9b\x19\x1b\x13m\xf4\xcf\xd2v\xaf\x88\x00e})\x0c\xbbA1\xfb\x1by\xee\xae/\xb8\xfe\xff\xf0\xc2\x17r\x97L8\x17\xf7\x8e\x1e\x88X\xdcx=*\xd8\xf9\xb9\xda*\xe0l\xc8\x8d\xa2\x12\xc6g:G\x9aM\xf2i\xe3\x1d\x0b\xf3=P\xf6;\xfbnE\xca\xe3\xcc;\xf6\xd4\x7f!\x97c\xbd\xab\x84V\xd1|\x1f\xea\x08\xf2\xad;\x98\xc5i\xa46d\xd3:\xeb\xc3\xb0\xde\xc7\xd1\x0d\xfe\xe5\x9b\xe8-\xfa\xd5\x18\xa6\xc8\xa8\xaftg\x1b\x92U.\xdav\x83\xe3w_k:i\xbeY\x990v\x00\xfd\xc8\xba\xa9C\xb6\x13\x84v\x95f\xec\xfc\x00\x1b\x0d\xca>\x1c\xdez\x80\xa8\xd0\x87k\xc3\xb0\xe3\xc9\xbd\xfb\x9e8W\xe5\xccI\xed\xa4\x99,H\x06'\xe3\x84\xe8\xccI\x09\x13\xbb\xff+\xf1\x0a\xcdi\xec\"\x94&:\x99:\xd3\xc2\x1c\x8c;z\xd8g\x9a\xa6\xe4\xac\x073qG\x1a=Z\xcf\x80^~\x90,Wc&c'|**\x07+\xb2\x1c\x89\x15&\x8bz\xae\x9f\xf3\xbc\x0f\xf1m\xeaGP\x10.RHF_+\x96\xe1\x13\xb12(\xd0O\x11 \xbc\x8e[\xb2\x8f\xaa\xbaX\xce\xfbP\xc4\x9e\x1a|h\"\xd0\x8ef\xdb\xa7\xec1{3m\xd0Q\x8d\x96O|j\xe5\x8d\xc4\xe5\x87\xcc\xbf=\x90x\x1a \xced\xcb\xa7\xec\x85M\x18\xfe\xf0\x80\x19\x12\x90\x9dX\xff\x04|\xdd\x07\x1d\xb4$\x03\xa3W\xbd\x1b\xe5\xa5(\x9f\xe8\x92\xa5\xab,\xdfF\xbe\x8bm\xe4\xcdhmi\xea\xd5\xd3\x1b\xc9!\xf3\xf2A\xe8ql\x86z\xa8\xafi\x84\xc6\x1a\x19\xeeD\xc9\xec\xd3@\xb7\x18\xca\x1a\xcf\xe8\xb4j\xa6\xd0\x11J\xf9\\\xeb=\x04ga\x0c\xf0}b\xb0(\x09@XPK\x03\x04\x10:[\xc3\xbdqU\xa6\x83\xa0E~{C%\xf7\xb3?S?\xfbD\x90\"\x09\xa4C\xe5\xca\xd7\x9aL\xa9\xea\xe4\xbc!\xc5\xd6seY#\xd6Q\x88\xf1\x8d=\x17\xbf\x99\xa9\xaf\xcd\xed%D\x1e\xbd\xb0\xf0\xaa\x94\xd86\x03\x0ez,>a{\x03ZB\xa0\x06KU\xa9\x9a)\xe4@\xa1\xaa\xef\x91\xe7\xa6\xd2N'\\\xee\x02W\x8d\x05$\x8e4\xffu\xe9J\x90o\x84\\jk\x14\x14\xac\xb38\x85\xc69\xc6\x01G\xc4;\x1d[D\xfc?\x92j\x06=\x9a\xc9\x85\xba\xfe\xcc\xc9\\@~U\x9dQ%}\x03\xb8d\xd9\x90\x9c\xb9&Y\xf0C\xe6\xd5\x8eX\xf5\x80Aw\xa0L=\xe9\xec\xa0\xd9\xdd|\xf9|\xa7\xe8r\xb9\xaa\x9bj\xd2\x05\xa7\xf3,\x11\"\x8f\xd9\xf2\x0bCx\xa0\xd6\xfd\x0cD\xb0\xcb<3\xaa\xac\x85\xb8Wt\x95\xf2\xe8l\xafW\x9dc\x93c\xcd\x05D\xe3L o\x8bT\x8d\x87z\xf2\xce4\xcc\x98\x1c7\xc2\xf7\xd275\xd1\xb5\xb4\xea\x04l\x8dl\xe1o\xa3\x9f.%\xd4\x12\xa2,\xa5\"\xf0\x0e\x13\xaa\x9b7\xb2\xed\xb7\xe9'\xbaH\x95\x0c/\x80\x8a-\xcfr\xe4\x9aO\xa4^\xe8\xc9\xb3\xc01\xe5\x99f6;\xeb\xddY\x8e\x8d\x87\xc1\x82Q\xda\xd0\x88g\x9e\xc6\x93\xf8\x01\xbe=\xc63\x00\xe5\\\xbee8\xeb]x\xeb\x97\x82\xc0\xdf?\xbcC\x18\xc6\xf1QG\xc9\x91i\x092_4{\xf3\x0d\x00\xba\x17Z_\xc7\xc0\xb7U&\xf5\x86\xb0\x02\xd4.\xd8\xe5y\x00\x07\xc4@\x87fa\x99\x02`\xbd\xcb\x0e\x1f&U3\x03\xfa\xd3\xd2\xc1\"C\xa7[h\x89\x19\x1d\x10\x90%\xc9/\x02\x08\xecF\xd2(\x8f\xc2\xab\x96\xba\x83\xc2b\x91\xd6\xff\xe4nn\xec\xe5Z\x82\xebA\xf2T3\xdd\x9bH\x90G\xa7\x7f]\x82gL\xef!\xa5\x8bU;\x12i!\xd6\xf7\x9dVn(5A\x8b\x98\x06UJ\x0d\"\x85M\x04\x1b\xb1;$\xe2?Fp\xe7\xc4\xca\xca\xef\x95N\x95\xca\xa4Hi\x09\xa0M\x94mOc\xd1\xde_\x7f\x10\xe3\xba\x10\x0d\xe8,\x0fMH/c&Jr<\x10\xa3\xae:\xd8\x9d\xd2k3\x91\xbe\x92\xf5Lf@\x89\x91\x9a\x9f^\xf6O\x03\x85\x9c\x02\xad(<bN\xb5u.\x87m\xdb\xe25\xf7\xd0$\xa9\xc6%\xa52C\x1aP\x14\x10E\x8a\xba\xac?\xc0\xc0\xfd\xa0\xbf$\xe0<\x86\x0eP\xb2]\x1b\x04\x07\xc9rL4w\xd4\x1ciZ^q\xa6UX\xa8\xf7\xe8\xe6\xa84\xe0\x02\x84\xfc\xc7p=\x8f\x9e\x96K,+\x93\x89\xd0\x9f\x9d\x1b\xff\xa2l\xa6\xcdQ\xac\xda\xa8\xf2s\x09\xce\xbf\xd3\x03\xecS\x16\xf9\"\x16E[\x12sF\xca\xbe\x1b\xb0\x07L\x12\xf9\x13\x94\xb5\xe2\x14\xdf\x0d\xb4\x82\xc6&XY}\x89\xabv\x1e\xd6\xacHq/\x9b\xb3h\x11C0\\\x7f\xc4\x15\x8d\xabF\x15\xc3\x0bf\x85\xc1\x8a\xd0\x19\x0a\xe8\xc5\xc5p&\x08\x038\x0a=O:\xa3E\xb7<,\x96\x97(j\xb32\x9c\xb8\x0a\xe0\xcb\x82\xb2x\x09\x10r\x9a\x13\x17\xc7\xfa{4\x1e^\x89Gf\xea\x10&_\xa2I\x12\x8d7h\x94\xd7o\x06\xadS\xb1.\x8b\x0c\xcfKS[i\xa6\x92w\xa3\xd2\"*\x94\x0f\xe6\x9d\xb4\x844\x8e\xb2C\xfd\xa8\x07 5\xb1\x1e\x87\x14\x8aX\xa4\xb4\xbe\x02\x8d\x08NDr\x05\xfd=}\x0d\x07\xe8x\x17f\xe3B`\x9a\xc0\x81\x8e!\xb5\xe26<\x82\xd5\xbb\x92*bg\xd4*\x11\xa7<\x8emt\xe1\x0a\xd93r\x07\x9b8t\x99h\x95\x98\x84\xf1\xd5k\xeb\x19\xa7\xd4\x91q\xb2\xb3'\x04\xe9L=\xa8\x86\xb0\x04\x89 S4\x99&\xb2\x18\xf9\x05>\x8df\xd2Y\x09\x1c:*5\xf2I\x14\x8b/\xdb\x02\xec5iNYRJAp\xa5r\xf7)v|Odb\x1a\xc5\x19h\xa7]\x93\x018\xb5\xaa\xa4\x09\x86\x85\xa6\x19\\/\xc8\xcaT(&\x88PV\x95\x8f\xe5F\xb6\xca\xc3\xa8\xb9\xfcT\xa21o\x0cg_\xbd\xe8\x1e\xd5G\x84\xc3\x12\xe1]J;q$h\xa1\xcb\x16\xb9b\xe7\xa8\xb6\xf9\xa1\x8f1\x8f\xa6\x9a\xc7\x14cX\xd9\x83\x05\x80\xb3\x15\x82Ki\x16\x92\x12\xc1\xffZ[\xaad\xe6\x90\x9f0\xce\xc4#\x08\xb9\x86.\xf8\xb0\xdf\xe9\x07\xa7:\xe7\xc6\xce{6\xbdK\x7f\xe1\xad\x00\\F\x18\x96\x0c\x05c,\xbdBy\xd7xjP\x8a6\xddZ1\xe1\x07\xfd\x9e\xcd\x18.$'\xf7&\xc6E`\xd7\x0bf\x90\x0cc}#8\x8bd\x03\xa6\xcc\xbe\x9e\xe9-(\xfcq\xeeN\x8c)\x9f\x05\xa3'U\x09-\x80Z\xea7\xf0\xe69P\xe1\xebX\xe0g047\xb3\x9f\xe8\x04\x8a\xa9\x86`\xe9\xe7\x08k\x96&*\x8f/\x1c\xa3\x03f\xe8\x81\xd9\x80r8Rs\xbb\xe9\x8e\xe4\x9b\xd0\xc9L\xd5\xbf\x13\xa1\xd6\x96Y;\xac\xa8\xf1\xae\xb3\xc3rx\xb8\x91\xff$x\xaeq\xa5\xb0c\xd5}\xb7\xb4\xad\xbd\xbe\xe2F\x1a\xadq\xaf%$\xb5\xc6}\xd9\xb7\xbd\xdc\xdc\x81R\x07\xfa3H\x11u\xe7\xc5\xb6\xb66\x1e\xad\x1b\xaaA\xfd\xd1\xbd\xb1\x02#\x87\xd7\xeeH\x80@\xfd\x01\xb7d.\x052\xd1]8\x05#\xc5\xf9\x02\x14\xa8Ou\x89%\xec2\x8c\xb9\xaa\x1e}\xd0\x0a\xb2\x80\xf7\xcbC4z\xf4\xfb\xf6V\xe0w\x81\x05\xbd\xdb\x0a\xf1dP \x0d\x88\xed\x18z2\x16\x14D\xaa[\x1d\xcd\\\xcf\xb7\xdb}J\x0a\xfd\x09\x80'\xc6E\xd4KI\x0d\xc6I\xf82\xb5{)&\x90Xn\xc2WB\x82#,\x93ze>\x8a\x1e\x0b\x85\x7fFm\xa8+\xed\xca\x0d\xc32\xce\x06\x81\xbb\x10\x9f-3\xa6=\xc9\xc9>\x1ds\xc5\x0d\xbeD\xe87\x11H\x86\x01WT\xecf\x8d\x9f\x80\xe0q\xd1E\x0f\x8d\xff|\xc9\xa928\x91\xa1\xa6\xaaSe\xaf8Z@\xb8f\x0c\x04^\x83\x1d\x8a0m\xa2\xf4\x1c\x0bU\xc1R\xd7\xf9,m\x9d\xe0\xd6_\x07>\xd0\xf0\x06\x19\xcb\x19k;\xdd\xc4U$\xd1\x89\xfa\xe6g\xed\xb7\xae?\xd2\xf3\xa3\x06\x17\xa5\x8e\xdb`HI[\xb2x\x01U\x07\x85\xc3\x09\xe0\xa6H\xfev\x91\xd4\xd2\x9a)$\xde\xae\xd6\x17\x8bnN\xaf7)\x9d\xfc\xa3{\xa8\xa3\xbb\xf1Mb\xd0[ \xfd\x94\xd6+a\xd5\xf5\xabn\x93\xc7\xd8\x9b-\x8b{\x9e;\xd5\x00\x0b\x00\x17\x0b\x04\x06os_\x15d\x03oI\xf5&\x88\x94\x81j\xb1{\xeb\xd2\xf8\xacg\\\xc1\xbb\xd1\xb5\x8eY\x1eJ|\x88\x16[|\x87\xb5a\x03\x03\xb9\xd2\x82\xf6$Y\xdd\xc5;\xb5-\xd5\xd7*H\x1c\xac\x87\x1e\x12\xed\x92\xa3\x08\x14\x86\xea\x95\xf83\x85\x1c\x08y(\x12\x80\xae\xcd\x99X\x98\x96\x9aj\xcd2t\xd6\xb35\xdb)\x1e4\xa7T\x95t\xe1\xbd\xc7\xd6\xb0%\x96?\xc7\x1f\x0d\xe1\x04r\x19U\xc8.\x84\xaa\x96\x09\xfbn\xca\x90\x99\xdf\x06\x90\x125\x1b\xaa\x19\xc3\x11B\x15\xaf\xbe\x14\xa5\xdb[\x99\x13)\x8c\xcf\x8a\x01.\xf5\x957\x0a\xb9\xee\xdbw\xb1\x8c*D\x8b\x0aU\xbe\xd4\xaf\xc0\xeaJ)\xc7\xe7\x8f\xa2\x09\xfd\x0c\x07\xd1\x8b\x1a<\xe6\x9b\x12:\x81\xc2H\xc8\x04\xbd\xb9\x09\xf1\xcc\x06\x8ev\xe1)p\xe3\x8f\x12\xb6T\xec\xa5&D\x916\xd3#\xe4x\x8f\xd9\x8bM\xd8b\xb8\xf9Y\xf0\xe9\x81O\xfd@m\xdb\x02\xe0\xfeZ&Z\xf1h\x8a\xb1j\xc1\xa4\x91\xa9\x84\x99\xae0\xdf\x09\x95\xc1B\xf1\xfd\xc7r\"%\xd5#\xda\"\xfe\xafhO\xc1I\xbeK4\xc7\xe0v\x10\x9ct\xae\xd2\x9d60\xe3\xa6\x8a$@?\x845Qb\x89\xb8\xb0Q\xd3\xdb\xf0\xe7\xc2\xc5\x02\x17\x82J\xf7\x91&j\xd7/\xc2\x13\xdd[3\x16\xf7\x81v\xee\x95\"'\xa3\xe3G\xb0z9\x16U\xf7\x1e\x15\xc1E+]r\xbf\xa8<W\xc42\x81\xc6ct\xfcr\x1c\xe4,#\xb2\xf9\xacO{n\xa6:\xd7\x0a>\x88w\xaeWXy<\xc1y3-Vq\xdf\xbf\xcfw\x0a\x81a\xc2\xf6\xa7\xa5#\x94\x1f\xbb\x02\xe3QWD\xa4\xb7RS3\x94\xb7\xa2\xcd2W\xc1\xa5\x8e\xfd\xb7\xdc3\x15\xde\x7f\xa0H \xcf\xfb\xf6\xfc\xd2\xa8`\x89\x15\xea'\xf5k\xdc\xf8f\xb3\xd9\xb5+|\xf1J\xf8\xe2D/G<\xdc\x09]\xf6GI\xdd\xa8W\xaf\xa6\xc2\x98Zj\xe8x\xb4p\xb8\xd1LE\xa1\x0eS\xc2\x02\xe9!\x92God\xd7ER\xa9\x99\x0e\x92/\x09Z\x02\x99\x0d\x97\x15\x0f\x8am\xcb\xb8mt\x17\x1c\"B\xa8s\xc4\xb0\xa2\xd8\x085^\xf2]gJ\x9a\xfb\x83;<\xde+\xae\x91\xcbd\xdd#\xa0\xb0\xc6\xa9J!\x1b\xf5\x1b\xba\x06\xb9\xb1\xcc\"]\xf5i\x12V\xa7\x9d\xb0gn!\xf9\xb5\x82\xe1\x8e\x92\xcee\xf9\xf4\x02\x99#\x0cD\xf8Ea\xf7w\\\x80\xa3X\x7f\xe7\xf2%\x1b2\x81\xbem\x13\x105W1-\xef\x1e\xc9M\xda\xf0\x1e\xbd\x8f\x97\xf5\xd5s>\x83M\xdb\x80\xf7\xd2.\xe0\xb8\xf3\"\xc7\xe6U\x90\xf8\x03\xf18%\x04]z\xe3l^-K\x9c$C$\xa6y\xfa\x04\xa6A\xc1\xde\x0aoi`\x96Q\x1f\x14\xa2\xbct\xd6\x83\xab\x91.\x92\xef\x84\x93^/\xc2\x86<\x1aq\x93\\Z\x0a\xe6\x0f,\x0a`\xf7\xb2\xac\xddDn\xd9\xb2\x8dz)\xa5L\x92\x7fz\x99\xf4X~$,p\xc7\xd2\x18v\x1b#\x83\x96.7\xb6@\x85l\xca\xab\xdfB\xfdY\xc1>\xa3\xaew\xa4r %\xad+\xdd3\x06\xcf\x10\x96&\x8c~\xea\xb5\xdeH\xa8\x96\x0b\xd7n\x113\xbc\x11\x86\x91\x8e\xbaQ\x9faT\x09tH\x0e\xcc\xa1\xd4\xca\x0d,v'\\\x13\x9eO\x88jO(\xba'\x87E\x8cP\xdap\xeb\xb7\x09j\xfc\xa8h}\x87\xfbE\xd2{\x18\xb8\xef\xa0#\xf7V # a\x1c\x02&\x87\xb5\xbe\xfb9\xe8\xabo\xa9\xa1\xd25c\xe1\x01{\x0d2\xc7AX\xab\xae\x88\xb2\x09l`\x0f\xbaU\x80g\xb9\xa8\xaf*\xb1\xd57\xc0\x11\\\xca\x0e\x12\xc9\x04e\xae\xe0\xc1\x98`\xd8\x15XT\xb2\x18\x15\xcdt\xdd\xd7*\xab\xe4\xa6a\xbad\x16u\xf1j\xcd)d\x9b\x84 u\x1f\xe9\xf2e?\xe9\xf2\xbeJ\xe8\xb9\xcb\xdd4\xf3\xe6\x94/\xd7\xa3\xb5;u\x10%Z\xcaS&H\x1c\x053\x9a\xe0\x8a{\x99r\xf0\xb1\x8e\x88\x96\xbf|T\xc3\xb5n\xa7\x82\xfe,\x92\x1d\xb7\xd9\x86x\xac1{\xa4\xcb\x15\xed5\xc3D\xb8\xdb\x1c(`tC\x8aa7y\x0en\xe2\x16#\xf9`\x8c\xa4j,j\x9d\xe7]\xb7{\x12\x88\xab\x11j\xf5\xc6A\xf6\xe8\x1cxaI\xf8A=B\xc3Y\x10\xe9e\x01\x09?\x92BN\xa5\xd2\x1eV\xe5\xe3\xdc\x10:\xf1s\xbe\x86`\xc5\xe7.\xec@1a\x89\xbc~c\xec\xa8\xfce\xa9\xc9\xe1\xf3'\xb3\x98\x16?9\xa82\x81\xfd4a\xf7\xa8\x99oV\xdfu5\xf2\x85\xef\xfcyz\xc9$\xc6L\xb2c:\xcdVUw\xb3\x95\x12\xae#\x0e\xbc\x84\x10\x9e\xab\xb5\x9d\xbc?te\xb1\xf8\xa4z\xa0X\x1e\xc8=\x1a\x9f\xf6\xbc\xbb\xb7\xa7^B\x11\x8e\xfaNsB\x84\xbd\x07vO\xe6\xfa\x15\xde\xa4`\x9d\xf1\x09s\xe5#\xe7\x9c\xf8\x9ab2\x91+\x98\xfe#Jy\x05\xb3\xd3M\xbc\xb7\xef*\xc7\x08V\xee\x84\x1aT\xab/k\xa2\x9fJ\xe7\x7fR8\xdd&\x8c?\x07~=?\xe5@\xccR\x1d\xd2\xd3$\xfc\x88\"\xc0P\xf4\x08\xca5\x8b9\xcc#\x86\xfb\x1ai\xbe\xd8\xd10\x81z\xe6&\x9d\x17\xce;p\xdf#K\xb8\xf8\xa2\x81\xa0@\x7f!\x91\xe1\xdcI\x08i\xf0*\xf3u,\x10\x7f\x84\xb2b\x86\x92\xc4\x9b\xb9E3\x87\xbd\xd5\x09\xa5UP\x9d\xf5\x03\xc0\xd1\xb8Fi\xf9\xed0\x97X\xc2\x03\x19\x00\xee\xd7\x9e\x88\xc8d \x8b\xe8\xbc+\xe6O\\iC'@\xf3Aa\xc2\x9e*\xfd\x0bpt\xdf\x80\x84\x87\x90\xf2{\x8dp\x12+\xc9\x8c\xb69\xdf>?\x82\xcb\xdfS\xe2\xee\x84Y\x1bm\x8b\xd6\xb9m\xd1:\xd7R\xb8\xe7=`\xf0j\xa3)\xaa\xc8\xf5\xff\x09\xff1\x13\xce\x8c\x94\x00\xc3\x96\x83)\xd8\x83" +
+	"%\x88*\xc30\xb2d\x0d\xc5j\xe1q\xbf\xad_A\\a*\x9a\x9c\xbaU\x9e\x17\xc2\xa2\xeaw\xf1\x8f\xbcv\xc9\x0a|\x87nK3\xd9\x84\x17\x7f\xa8y\xb1\xae\xea\xf7\x90\xb6]\xa8\xd4\x07w\xa5\x17\xdc\xc8\xae;\x05\xa5\xcd\x14\x04\xa5\x95\xcb\xe0{\"\x0b\x7f\xfa\xf0\xeb\\\xb5\x0a\xf0\x14/\xdc\x9bV\xa9\x1c\x84\x0e\xfa\xf0\x0d28\x1e\x1b\x91L\x02@2\x10\xda\x93\xde*lI\x9c\xc8p\xae\xbd\xc2~qSFRf\\\x97\x82\xd9\xa6n2\x00\xbb\x196<\x0b`\xf3\xf2z\x0b\x88!(\x8c&\xd8\xb3\xd44P\x17zk;\xe1\xe46\xf6#\x85\x14\xdc\xe6=\x0b\xd2\"\xbf\xe9\xc5\xe06\xdc\xcb\xbcv\"{\x90H\xdbwL\x01\x1b\xd8\x8d\xa6\xc1\xc5*K+?u\x08\xca\x18(\x16s\xd4\x1fU\x07\x01m\xd8\xb2\x0fv\xb9m\x9d-\x9b\x82\xb4\xe1\xa4^\x16SG\xe9\xe4O|B\x02j\x859\x03\xe6\x01\xe2\x94\x178\x90I\"@O\x11\x8d,\xe9\xa9/q\xbe\xf2B\xf6\x0eQ\xc7[?\xc5\xef\xe1a\xee\xfd?P\xbb\xd1JS\x04\x84\xdd\xab;\xcd\xbe\xc7\x8as\x19\x8a:\x15\x1c\xbd\x8aV\xdc\xac]\xe1\xa8\x9eI\xf7z%9az\xc2xEh\xa6R\x8c\xcd\x11\xd8\xf8o\xc4Y\x03~\xd0\x94\x09E\x14m \xf3\xbd~\xa3H\x9egt\x02\xc1S\xab\xd3\xbb\xd2\xd2G\x9bF [\x82n\xc5\xb9&\xd6T\xc6\xb2\xd4&5V\xd4d\\k\x01P\xe5j2OS\xe7\x08\xbb@c\x12v\x1bK\xae7\xc1\x90h\x8a\x8c\xa3\xb8o\xd6\x83\x8c%\x04\x89\x1c'$\x8d\xe1Q\xe8z\xb6m\x99\x86\x0a\xff_\xf5\xf7g\xfa\x80\x82lj\xd5\x9c@FG\xc0_\xc3\x11}+\x90\"\x8ea\x0e3\xf2>|\xf7\xc2H[\x94\xf5G\xfc-B\x14\x88\xa9\x87\xb5\x97\x962\x0d\x81\xe6\xfd{\xa7\xec\x14{\x1e\x91\xa9\xa0M\xb2\xd3A+\xa3\xf7R\xbfa8S\xf1lj?\xfa6@\x17P/qx\x1c\x09\xce\x11\xa4\x82D\xfbS\xfb+\xc9s\x89cf\x81\x96\x112\xab\x05Q\xf4\x15\xdef\x1dS\xc5L\x05\xc6D\x84\xbc\x81*\x0b\x9dN\x92\xa5\xfa\xa3\xacq\x02\x07\x91\"\xa1\xd3QZ\xc1U4\x9b\xad@o\xbf\x87\xc0BV\x14\x87|\x8bDF\xf4\x1b\xfd\xf6\x82\x9b+)\xb9\xe0\xdf\xafC\xc0\"\xcb3m\xb40\x9c\xe1\xd3\xf2\x1a\x90\xf8\xe8a\x00\x0fm\xa7\x14\xe5\xcb\"[\x8b:\xf5\xc1m\xafoDnVqK\xbe\xb4\xc9;\xdex\xaf\x11f\xdb\xc3\x06\x0aT\xe5\x8cqP\xf1\x94\x8a\xb7\xb1\xfdSs\x9d\xd8S\xecP\xd0\xcd\x851\xb8\xa8{Ecs\x07\xd5\xb6sW\xc7h\x9a\xb0\xab\xc9\xa6\x8f\x93\x08W\xa9\xbf\xef~\xa7\xa1\x0b'\x07Xj\x8c\xc4\xae\x90\xd8l>\x10\x15\x11\xa55*\xd9TO<\xfa J\xf6Qm\xf9R\xca?\xead\xac\x0c\xe2eIGH\xd1t\xbd\xd6\x96\xd7\xd9 \xbaX\xd1!\xe3\xfc\x8f$\x15\x1c\xa3\xb8\x86\x86An\xc4\xc2\xaeC?V>VZ\xd6\x8e\"\xb5\xaaW\x81\xb9\x8a5\x0e\x97\xaeB\x02\xd8}\x90\xbeQ\x87\xaco\xc0$\xbdt\xd0\x9b\xb44u\xcfwO\x88_6\x8d\xd6\x9c\xf003\x0a2U\xd7x\x8d\xaa\xbf\xfc>\x94{c\xe0b\x94]mQ\xaa5\x165\xac\x97Y\xe7Q\xb3\xbdk@\xd3f \xe8\xc2\xb2\xdb!\xa17\x90\xd2\x08\xd8\xdc\x92M\xba!}\xcdX\xb1\x0a\xf6\xec-gT^J\xd4\x9e\x80y\x04\xe1\x84\\r\x92\x13B\xb4y\x17r \x85\xcc^\xd9L\xc3\xc1\xbe\xf4\x17\xae\xce\x1bJ\xd1\xdc\x84\xeb\x98/\xfb\x1cB\x1a\x1d\xdbKv\xc2\x971\x07u\x17\xef\xb4\xf6!L\xf3g\xa6\xad>=>\xd0a\xfa\x11\x8a\x8b\x93G\x08L\xa8\xf6\xfb\x12\x8b\xb5\xb2\xb9\x1e;\xe4\xa0\xd0P(\x7f\x13\xf7\x95\xea\xf8\x90jh3\xee:\xf5j\x0cFV\xb0\x17\\,\xc3\x10\xe0\xc8Gc\xa9\x13\x80\x8bp\xf6\x17F2\x14\xb5\xe2\xa8(\x06;VuET2\xfdu\xdb\xf9\xb8;\xb2\xff\x81\x1a.\xf5XC\xc1y\x11\x92\x9e0\xda4\xa0\x84z)\x81x\\\xb0\xf9\x98\x177\x0d\x94z\xad)\x8cz\xd9\xc2\xed \x8e\xa6\x11\xc5Jp\xca\x9e\xf3\xe1\xbe\xe8U\x17\xd8\x19\xf6\xd9\x1csq\x06\xc3\xb9\x80\xd8Q\xf4\x18\xdf@|\x83\x92\x13\xcb\x9c\xec\x8a`\xe3P\xfd\xbe\xff\x7f\xf6\xfe<\xadm\xa4\xdd\x1f\x87\xb7\xe2%\x84\x19\x96g<\xe06 \x09\xef\xa0\xc3\xe9\xc6q \xc4\x18B\x9c\x95H\xbby/U\xddsUIe \xe9\xe7{\xbd\xbf?\xcey:\xd8\xd6X\xc3=|\x86\xe7fA\xd7[\xe5\x82px\xadZ\x08\xb8v\xc0@\x91\xf5\xb5@\xd9\x01`d\xc5\x9b\xcc\x0bxC\"r\xfe\xd4gun$\x9c\xcb\xde\x00/\xdf\x0eo\xeb&n{\x07\x06\x02\xcd\xa3\x84\xff\xc6r6\xdf\xfb/R\xa7v\xca\xe5\x80%5/m\x15\x886\xa6\x15\xe4\x9dJ\xed\x09(\xf2\xdd\xd9\xde\xe1Q\xc2\xdbA$dFp\x18&\xf7y\xa0\xb2\xae\x0d\xd8\xc5\x8e\xa8\xea\\\x13\x89'd5HcekTY\xfcz\xf1\x90\xb5y\x1c\x1e\xc7r\xa6UGP\xce6\x15_\x9a\xeb\xc8_\x01\x90\xd8\x87.5\xb1;#\xd3\x85]\x90\x1bc\xf5\x0fx/E\x8e\xf0{\xce{\x11\x1br\xd5\xcbf+RR-os\xa1\xe8\x94\x15\x84\xcbr\x82V\xf5\x16\xfa\x08\xaa\xc4\x85`\x13\xa5\xa8\xab\xaf\xab=\x08\x1c\xc0\xbdO\x16\x83\xeb\xab\x93\xbf\xb3\x16rx\xfa?\xe5\xcf*4\xe8y-\xec\x01\xa9e\xa4\xcf\xc2\xa7\xb5t\x8b\x95Z\xd7\x14\xa3\xe1\x9b\xb3R\xb8\xc9\x8a\x87\x84>\xbd\x89\x82!\xe0q\xda\xf7s\xce\xf7\xdc\x85\xb8\x9c\xf4Er(\xc5\x16\xac\xf1<\x12\xbc\x17u4\xed\xbb@\xb5\x91\x02\xde\xb1\xf2\xcb;o\x7f\xee~\x01p\xdf\x15*\x06h\xe8{\xf7\xe2\xbd\xect\xa5\xae=\xee\xc5\xdaLA\xdb\xb8\xf7\xba\xf7SM,!\xda\x0a\xbaS\x9c%\x16\\\x01\xf2\xbdH\x85\" M6\x0e\xc2\xa6\xd6\xd7\x06\x07\xdd\x0c\x0e\xf2\xd8\xd1\xd8\x9a\x01i\xe9Qe%;\xb6\x89\x8e\x0e\xfeT\xa1\xe8\xe3+\x04B\x8a\xbe\xd3|\xaa^Q\x92\xe1\x1bn\x82Hy\xd5Q:v\xbf\xcbt\xd9T\x0b\xcenv\xeaGG1 z\xaa\x7f\xad\xaa\xb4\xd5 v\xd7\xa4k\xef\xa9icT\xeb\xbckFn>\xf4^\xd0\xb1\xa2\xb6\xe02\x15\xe4\xbbe\x10\xca\xfe\x84\xb4\xdd\xef\x01a1\xef\x96\x04\x02\xbc*d\x85f\x9f>\x16\xc9\xa9\xb7\x1dilT\x94^\xa9\xee\x96\x95rC\xbc\x00)\x12\xdfd\xf5I\x8eNE\xc1\x00w\xbb\x09\x98h\x00\x10\x8bPb\xa2\x12\xb9\xf4j\x80\x1a\x8f\xd6\x81u\xb3\xb9\xe2\x04\x16\xf3;\xcf \xa8\xefb\xaf=.\xef\xe5\xd7\x03\xb6\x1b\xee\xbb\xc5\xe3Oqu\x0c\xac$] \x16-\xda\xa6\x9es\x9c:\x85\x96\x86\x9b\xe2\x13&Bh\x0bs\xe5Z\xa7\xd3L-ImQ\xb9]\x0a\xd5\xb9\xc6Z\xc7{\x01u\x01@D\xd6L\xaf\xbd\x15\x19zH0\xe3U\x0a\xcc\xd8q\xe2\xfd\x00\x0eL\x18?\xef\x18\x03\xf19l\x1d|\xa7\xf7\xe0\xae4\xc6\xf2\xe3\xc8\xed4,\x81#+b\xb4\x18\x199m|\xf2\xeeJ\xfb\xd7\xa7\xe3\x03\xb1\x1c\xf88TP8\x98\x89Zv\xb9\xe3A\x8e\xd1\xcc\xc4\xcb\xea<\xe9\xa1\xe8\xe0\xbe\xc0\xda=\xe3\x1ald\xfc+d\xb49qD\x8f/}jMP\xd6gi\xa6P\"^!`\xc7\xc0.\x87\xb8@\xb6\x0fI\x15\xed\xd0\x14\x90\x14\x11J\xe9\x81\x1d\x167\xe17\xbe\xa2y\x812\xc2\xb7\x02\xe6\xa0U~\xd2\x96\xcf\xed\xcd;=\x8d\xfe\\\xfe\xf88\x8eT\x9f\xaa\xe4\xa1\xfd{;9\x01I\x11i\x90\xd1\x82\xe1st\x0dA\x15\x82\x8bK\x91\xe6R(\xc8\x93+br\xb0#\xea\xf1\xf8$\xf7\x86\xe0f\xdc\xeb\xb5b$|c\xc0\x82\xe1\x18I\xdfZ()\xf9\x0a\x85\x03J\x18K\xc8Mo\xa2\x0c\xc9\xfc\xd8\xfa8h\\\xb2\xb6\xb1f\x16_\x00V\x10p\x807\x80S\xbf\xe84b\x89\xd5\xd6wX4\xce\xd2\xf0#(\"\xf23\xe6e\xbf\x82\xfc\xbd0\x82\xc7\xd1Bc\xd9g#\xe7\xd6\xaa\x11*\x1ay\x09\x05\xc6\xcb\xef\xb0S\x9c\xc8r\xa8\xaf\xe6)\x90x 6\x0e\x12+\xe2\x15#\xa3*\x0b\xbf\x9d\xe4\xc6\xa6\xafp\xaf\x9bI\xc4\xadH]\xb3\xcc)\x15N\xa4?\x9d\xe8\xcc\xd8\x85K:\xfa\x0b\x01\xa6\x806*\xea\xc4\xfe\x0dk\x05\xd9\xf4=\xea*\xe7w\x1aLc\xdb\xcb3\x91aa;xk\xdc\x13Q\xebYwV\xf8\x9b;v\xf3N\x0e\x82\xc5&\x1e\xa0RM\xbf\x19\xfa\xb9\xce\xe3\x1dm*\xddj\xfd\xce\x0e\xd7\xc9\xa1\x81\xcf\"\xac\xa7\xb0~k\x1d\xa4e\x8d\xf2\xf1\x9f\x88\x99\xac\xab\xb1P\xc3\xed\xbd\xb0#\xd3_\xe8,Z\x80>\xc7\"\x04$\x9b!EQ\x92\x07[\xd0\x0c\xcf\xcf)O\x8eS\x15\x95\x070\xf8\x99z\x96\x8c\x98\xba\xd6\x00\xd6h@P\xd6)\xe4\xdbQ\xdc\x8a\xee0\x90w_\x80Nh\xef\x05\x9f\xf4N|\\\xdd\xdd\xd5\xf9\x86J3%\xb9\xdf\xe4J \x89v\x11\xc9\xfcX\xa2\x8f\xae\xe1P]\x9a\xec\xb4\xc6\xea\xde\x9e\x7f].@\x18\x98*\x8b\x80y\x93\x0c\xe4\x06\xc1\xfc\xbc\x85\x05\x05W\xd6\xd6\x0cQ\xf3\xe0\xb0\xeaW\xac\x02\x07\x17\x1c\x93\x81D]Q\xfdIh_\xa9O%cW\xa3\xca5\x85F\x89\xea\x9f!\xc7\xd5_9\xa4\x8fD\xcf\xa3\xe7\x8d\x90Yp>S\xba\xc6\xdb,\xd0\xea\xe9\xa7\x94$\x8f\x00[\x89\x9c+\xc00\xb9\x8c\xcd}y\xadm?\xbe\xd1\xc4\xabX\xc5F\xfa\xc2\xd4\xdf\xf9~\xa7\xc6\xbc\x1e\x1b\xca+\x10'\xc9\x81\x18\x9d\xc6\xf7\xc19\xc8\xb3\xf7u\xf5w\xe4\xf6\xfb\xb6$T\x97\x1d\xbb\xc3\xa6\x03S\xd2\x0bt+\xc5\xb9\x16\xf3\x16\xe2\xc4\xf8\xab\x98\xa5\xda7\xe1\xaaz\xae\xda\xd1\xf4r\xd3\x88\xec\xd3\xfd4\x8d\x06\xa5\x05P2\xc4\xbc\x116\x04\xb6U2qoT\xae\x97~q.\x01cZ2\xe5\x9f\xe9\xab<P\xb5\xbc~<\x86_P\x0a\x09\xb4c\xa5\x15'm\x94\xf6\x03|\x03d6M\x1a=A\xd2\xa8O_\xda-\xbd\x1d5\xbd\xf4Q\x18Rd\xea2$XO\xe5\x0b\xadC\x1f>\xfeG\x1cS\xa8\xa8\x02\xab\xe4\xce\xe5$B\x0e\xeeI\x02\xa3\xfb9\xa6t\xb8\x95\xd0\xf8\x10}?\x0e\xd4;i\xd4\xb1B\xa9\xf0\xc9\xd3|\x09Y\x9b\xbe\xca \x96r\xad\xae|\x83\xd2\x90\xb5 \xd7N\xa8T\xd4_\xabSP\x93\x1fB\xbev\x91\xdb\xc5\xc2\x0d4&<\xb8@I\xb9\x19Xi\x11\xf7\x9es\xc3\xe9I\xe0\xf7\xafE\xee\x017:\xf2%\xa7\x0c\xae*\xfa\xc1\xb6\x95\xf5\x9f\xba\x10\x1a\x9b\x8a\xaf\xec\xed\x0b\x19\x06\xc0\xc6\xaf\xfb\xc9\xa7\x16\xba\xcfX\xc4\xadm\xd2i\xb6B\xfb\x87\xf6\x03+\x7f.\x16_\xd4Q\xfb\x8e\x0a(J\x0dA\xdc\xc8=\xdc@\xd5\xe9H\xccH\xdcG\x17\x0c\x90\xda\xc7 \xc8\x11\x0c\xe5\x04\x06\xdd\x8b;\xc1U\x06\xaf3\xe8M\xb0\x93\xe5+\x88M\xf1\x8e\"\x829\xae\xb2\xb4i\x1dv\xb0P\xca\x1f\xc4\x02\xdc%\x01\x9f\xec\xbc\xef\x92\xf6\xf4\xc4\xa7\xda\xe8\x90\xcc\xbe*\x09\x87\x15l\x0bT\x90(e$C[\xce\x84\xf4\xcd\x85\x96\x96H^\xf5\xd4\x88\x86\xb4\x0a)\xfc\x8a\xfdi\xaaK\xd7O=\xefS\x103\x89\x8a\xb3\x05\x89\x90\xa5p\xb6\xa6\x8c\xe6[V\xe2\xb1w\x90@#(\x16\x10\xf3\x9dp\xec\x7f\xcf\xb7\xd29L\x9d\x01\xd5\xb0\xa0@\xf0Y\x8b\xebbV\xe6\xef%\x8bi|\xd4\xa5[)j6TP7\xa2/\x15X:\xed\x90>\x08o\x90[uw.\xdcZ\xd1\x08!\xb0\xd8T\xf2M\xd6R\xe2j\xd6\x14*\x1d\xc0\xd1\xa7\x06\x17U\x0c\x97\xa8\x01V\x7f\xed\x7f0A\xb2\xa6\x84\x03\xa6\xd6F\x003\x80\xde\xe3\x86F\xfe\x90~\x16\xaa}'\x8b\xe2\x8f\xc2\xe2\xa9\xf7\xf8gj>\xdb8\x923%\x0e\x0b\x84A\xa5l\x10_%\x1b\xc41\xa0E\xae\xedDv\x8f\x9e\x87\x9c\xa8\xea\x0bcV?\xca\xaa\x1c3\x8a\x8eC\xc1\xc2\xea\xdb\x16U\\)\x92\xe9|\x15\xc5\x9f\x81k@\x1e\xa1\xcc=\xe0\x05\xc8\xd7\x89\xfe\xa0A\x0d\xfd\xa4\xd2\x80\xa0z\x07\x18f[\x0dK\xb4\x14\x82\x16Y\x0e\xbb\xac\xb7\xd6\x04a\xa2\x17\xea\x92\xd6Or/\x97\xcd7\xc9]\xca\x09o\xad\xa0.r#\xc3\x0a\x8en\x14o\\\xe6\xa3`I9\xa6\x10\x13^*FZ\x0b\xc1\xb3Xa\x7f\xcf\xd9\x1f\xf6\x8f\x83m\xc7P t\x9fJ\xa0\x9e\xebM6\xc0z\xff$\xaa\xe6\xb6D\x8eu\xa0\xc5\x17\xaa\x00\xc2\xe7\xd7,`\xba\xc1 4\xe7\xe1\xf5\xf3\x87\xf9QF`\x17\xb7@\x94ug\x91m\xa9\xa9\x06KL\xfa\xa7z\x92\xc9\xeb\x9eF@I\x0e,\x8b\xa5\xd6E_\xf9\xfe\xe0S\x8c\x1d:\xd2\x8e\xcc\xd3\x90\xc3m\xad/\xbd\xf7\x87\x8c\xady\xb4\xe8\xa7e\x84m\xfc/|\x7f%\x93\xef\xe4&\xab\x90\xa4\x09\xb9\xe5\xba\xbf\xc1\x90\xacH\xac\xd6\x8cq9u\xd0\x90\xf3]\x08JB=\x85\xd6|\xf4\x93\x89\x15f\xd5\xee\xff\x0b\xb5\xbe\xfb\xee\xfb \xb1\x05\xaa\xfd\x0b\xa6_{\x0f\xf5w\xadI\x06\x1d\x91\xef,\xa4?q\x9e\xb6\x0b\xd0\xeb\xca\xe9\x80\x1c\xc4\xddt\xa2\x92\xcb.\xd6\xde\xa2\xfe0\xfb\xb8h<H\xefmG\xc0IR\x1dT\xc1q\xfb!\xb4\xbd\xe6\xf6\x16.+\x02P\x10<\xacvb\xf6$A\xa5\xcc\xb3\x97#pE\x99\xb8{\x19\xb2\xf9\xb1\xa2\x81\xdb\xfe\x09r\xf6-\x94\xfd`\x9b\xf0\x9c1\x7f\x8a\x1c=k\xce \x84\x18Z\xcc\xffH\x80\xfdn\xba\xe0~\xbd\xaf\xf34\xaa\xfa%^\x8e\x99\x83\xf1\xa1+B\xd4]\xa9/B\xc1\xe7\x0f`\xeb\x0e\xe3\xb9\xb1b\x95\x16I\xbb\xb5\x12\xea\xb6\xd8\xcf\xc8\xa3\x8c\xc4\xbc\x8fU\x96\xbf\xf6N}\xcet\x81\xaa\x16h\xc2\xd2U\xa7?Tk\x1e\xd7g\xa4[-\xf3\xaa\x04\x07z\x07\x06\xc7\x90\x0a\xff\x82hXI!\xc2m?{(\xaa\x00\xb7\xf1\xf1)\xcf\x97\x92(Yw\x00j\xea}\xc8\xd2\xd3\xb2\x84]n(\x9b\xe7\xc4|\xc5\xbe\x88\x0f\x096\x8a+9\x11\xf7\xd1\x89M\xd71\xd7\x1c\x9a\x19~\x9e\xc2l\xa1\x9e\x8c\x07\x06`/\x04;\xb8;\x90\x10\"6d\xf5/\x98\x1dccL\xa0b/\xd5\xae.\x81\xb6\xde\xaf\xf9\xa1\x91\xf7\xac\xf1-\x15;\xb1\xfe\xb4f\xdf\x0d2-\x07\xe7q'\xd2\xd0\x97\x9d\x0bX\xfdH\xe1y.X\xa2\x97\x1f1N\x0b H\xfb\x1b\xb9\xcaD\xc1\xff\x07A\xab@\xb9\x8bn\xe39\xad\xa4c\xbc#\xe3\xbf\"\xc5\x1ft\xb0\xe7\xferNBa\x9dw\xbf/\xe6\x84\xd8\xe7L\xc9\xa2S\xdbQ\x9a\xf6\xf4\x8d\xd8\xa3\x83\xff\xa8\xd0\x82 \x93\x1d`\xe1\xe2m\\Ddsp\x19zE1\xe1\x1c\x0c\xf0\x91)m\xe2\x8f\xdb[\x81B\xea\xdaq\x06%\xbcJ\x8a\xed\xed\x98\xb3\x1e\x1dGn\xc7\x07*z\xd7\"\xfc~U?\xfa>\x0b\xc64>Bx\xecMy\x8e\xa2X9\\s\xa6\xee\xf9 S\xae4\xd2\x1c\x98\xe3\xbaG\x1b\x93\xe4\x10 \x8c\xde!v*\x87\x98t\xa3\x90\xeaw\x88y\x04\x01\xeaU\xffa\xcf\xd2\x85r\x10\xcc\xe3\xb4\\j\x81\xcfd{ }%%DI7\xe8m\x94\x8b\xb4\xa6\xe1\xae\x0aT\xe3\xa0\x11\x0c\xbb\xbe\xc3\xa1\xc8\xc0\xecgo\x13X@\x9d\xc1\xc2\x03y\x9f\xea\x94C?I\x08\xeb\xe7\x83,Iuv\xf34\x0b\x00\xb8\xaf\x96\x09E\xb0f{\xa9+D\x96\xb4\xc8\x9a\xb1\xa8\xf3\x83\xa6\x0f`\x0aX\xa3\xda\xf7#\xbd\xe1\x98\x8b\x96\xaa\x0c\xdcr\x8fT)\xb3\x06x\xfd\"\xa8S\xd2\xfd$\x07\xba,S&wn}KW\x03a\xef\xefl\xe4\x94j\xaa\xaa\x1f\xe6.\x17\x02\xbd\xfc\xeao\xd2-j\xc2\x9bGm\xbe\xde2\xf4o\x97\xc1m$\x12\xb3\x80\xf6\xeck\xef\xaaq|\xdc\x97\x19\x8f\xddz8\x92\xe3\xc6\x8aj\xf0$w\xeb\xf0\xbf\xa9\xef\x91B]\xbbj\xe7c\x7f\xa5\xcb\x9b\x98m\xe3\x98\x99\xc5\x9c/j\xec\x1bRn\xce-UG\x13\xe5x'\xbdl\x8e\xe3\xd3\x9e\x8b\x98(\xd4\xafyl\x81\x00n\xe6(8\xebH\xdb\xc9\xfar(z>\xf5\x92\x02\x1e\xfa\xde\x0f\xf2\xaa\xea\x85\x9f\xaa\x10,\x1ae\xb1\xa8\xa8\xe2\xe4\x95 I\x9e\xc3\xbf9\xd9K\xf3&\x04g\"\xd0\xd2\xc1{fI\xe4\xb2\xc3K \x13\x7f\x1a\xd26B\x89O6k\xe2" +
+	"\xac\x0ca\xc3\xbdg\xb3\xe0\x09\xec\x8d,v\xd6\xf5\x07\x9f^\x10\xf1\xed=\xf1\xe1\x9f\xca\x99O\x8e\x92\x0f\xb4\x99\xe2\x99\xea;|\x98\xbe\xce\xf4\x00=$\x14\x00\xc3\x8dJ\xf3\x9d\xc0~6\x1f\xed\x89@\xc2{\x92\x9f\x10 \xc8\x19\x81\xf3\x16\x8cq\x0a\\2\x0b\xf0\xb1\xafb{s\x95\x81\xe0LH\x00\xa5T$l\x03\xa0^\xe2\xeb\xef{\xee:\xf3c\xbf\x80E\xac\xc6\x0c\x8d\x19Q\xc7\xfc\x0cm\xc3\xde\xf3\x9c%\xf8rb]\xa0\xb7\x1a+\x96\xdeQ\xc2\xd2}\xa2\xd3O\xf9uQaEg\x82\xd3MV\xa9\xfet/\xbe\xb4\xf6\xd8|\x8as\xe5\xb7\x05NS6\xa5 \xa5\xa1\xa0\x0f\xfcnrf\xf9iD\xb4CP\xce\x06\xe0\xb6\xbee\x8e\x0bmH\xf5\x12V\xec;h\xfcN\xba\xce\x13\xd1C\x16\xc5N\xf6\xe6#\x15\xeds\x08[Z\xb9*Q\xd6\xec\xdacO\x8f\x82\x93\xc8\xdco\xec\x9dh ('\xbd\xa9\x88\x86\x9fp\xe3Z\xed\xd4\xe4<\xd5\xf0\x03\xef\xed\xd6.\x0a.\xa45B\x99\x8f\\\x85\x0e\xcccJ\xff\xeerNy\xa2\xf6\\\xa8\xab\xfe\x84\xe8\x0e%f\xb5\x8e\xe4\x9b&\xd7\xa9:\xcf\xd4-j\xa0\xdd\xe3\xff\xc1\xfa}r\x0a\xbb\xab\x00\x01\xa2\xae\xf5\xefT\x81\x0bd\x92\xab\xc417Y\xd4\xca3]\x0bZ\x11\x06\xf8\x86c\x1d\xe1g\xe9\x9b0\x8f\xd4X\xe8{\x16g\xba\xfb\xb5\x86\xa6\x99\x06\x9b\xe9\x9e\x81b\x87\x89\x9b\xbb\x95<\xf3]H&g\xfb\xd9\xfde\x17\xbd\xf7\xf7\xd2\xce\x0eRw\x15u\x05\xf8A;\xdd\xa5t\xf7\xcam\xac\x9e\x1dv\x94\xebD\xb3W\xed?h\x1e8d\xd2D\xfa\x04G\xb1\x8a\xc9mS\xc4\xe7\xb4\xf4\xcdi\xa6\xae\xedR\xd5\xab\xfe\xb3\x84\x9e\xaeBNE\x00\xcb\xa3\xda<\xbb\xb51\xceNR\xbd\xe8;\x17\xe9N\x8dkl\xfbR\x04\xce\xb3\x99*\x9b\x09\x14\x93\xf4\xb8\x07\xb3\xed\xe6\xe5\x17g\xa7\xf6\x1d\x12V\xdc\xcaj\x8e\x88#F\xd7\x10\xcb\xc0\x031\xc7\x0d$(\xfdq\xf9\xd9Y\x14\x0cd\x17\x90\xef\xb8cy$cT,\x04\xd6\x999\xb9\xc5\xf5\x0d\xe6\xbdO\x9f\x04\x80\x18\x0e3\x00\x8b\xe5\x05\x95re\xacH\x88oo,\xdbww{\x9f\xf4\x9a\x83\xde\x13V'\xce\xcdC\xaf\xf7\x806\x0b\xf5C\xd6\xee\xb9\xf7i\xdf\x92\x9e\x98\xf3\x87Z\xf0\xbfp\xa2l\x855\x95G\xde\x02\xcf7&\x19\x0c\xe3\x10\x10\xca\xe6\x85\xc0\xa8\xf1\xd6P\xc6\xc0b\x0a7!tN\xa5\x875\xe9\xb8\x8d\xb0\xe6\xaa\xf2\xa3`#_'\xf4\xc7!, '7\xee\x99/\x06\xb2S\xe9C6\xc8\x1c7\xb0\x92\xdc\x03#e\xca\x16\x17\xdeh@@\xae\xc5\x9a\x10\x1d\x04\x99\xfb\xce\xde\xa7\x83\xf4+\xbae9F\x095_I\x8a\x1e*I\xf9\x09\xbe\xcc\x18\xd7\x87Ajt\xa7\xe5ZX\x98sJ\x8f3\x1e\x1dv\xd8\x05|\xd2k\xf5#\xea5a\x9f{A\xc6\x7f\x9e/\xe7\xde\xc4<\x82\x1f\xf9\x1d\xa1\xfe\xde\xa7\xe3\x9dk\xb4\xc2<\xe1\xef\x9d\xcd\x13>\xe9\x02\xfc\xd0*\xe4\xf0D\xff\x0e\x0f\xfa\x018G\xf5\xcf\x8ci\xfe\x87\xd2\xbe\xbdO\x19y\xdf\xce\x89\xc4\x1e\xe3\xdc\x7f\xcf\xcb\xde\xfb\x83\x89\xdd\xde\x9e\xe62w.\xea\xffx\xce\x0f\x90\xa1\xce\xf1~\x97RE\xc0\x10\xf2v\xdcb\xf6\x0e\x82\xab\xb9\xeb\x1d\x1b\xb3\xa6\xb0\xef\xd3\\%\xf1\xa9c%\x0c\x8c\x8c\x95\xf0\x93\x7fU\x80\x1b\xfe)\x8a\xeeWX\x8dg\xe9&J\xda\xb2\xe0\xe1\x1a\xfd\xaekM\xb0\xe1(\xeb\x0f\x96{\xf0\xa5q\xc1\xcd\xf3\x0f\xa7\xdf\xbe\xea(\xa7\xb8$Vl\x91\x90F\\~\xdf_h\xda\xdb\x0b\xcb]/v\xc8q\xaeb@\xbf&D\xfbE\xe3k\x991\xbe\x8c\xd8p\xd4mZL&\xe9:\xad\x0a{,\x1b\xd2\x7fN\xb5\xda\x81\x80J\xfa\xac\x81\xf0HxVJ?\xbb\xcez\xb6\xe3\x1a\xcb.NoYi\x19\x07\x9f^i\xdf1\x84\xf7\xf7\xfe\\]mo\x7f?\xd9\xb3\xd0f\xd7\x1f\xb7\x0a\xef\xa7\x8a`\xee?\xb1\x13\xea\x9dW\xdf\xef\x8b\xb9\xb7\x7f\xd8W\xa6\x0ajR\xb6L \x84a{\xceu\xa4\x0aR\xa1[=\xc2^\x04\x93\xbe^Ad\xb3\xd60\xc9\x07\xb8\xc5\xa9&\x90\xdd\xc1\x15\xf7\xdf\xf7\xb1\xe2\x97\xb3\x8b\xab(\xff\xc9$\x99\xff\xcc\x8d\xa9\x7f\xc9\x04\xe5M\xbd\x9b\xbd}]-\x8b\xf8\xa9\x0d:\xda\x97;*\x13\xee\xed\x9f\xfe\xa9\x1e\xca\xde\xfe\xd9\x1f\x8a\xea\x0e>\xe5Eu\xe4\xed\xed&N\xffa\xff\xe4\x1as\xb0\xff\x07c\xbc\x83\xd4\xea\x02\xe5\x9bW\xe9(\xf4\xbe\x95\xe5 \xb2\xb2\xec\xd4\xcdB9UH\xf1\xfbOx\x94\x1a\xe0\x85/\xf9\xd6O\x11S\xfa_\xbb\xa4\xbc\x07\xc7\xb1\xe6\xc7#e\xbe\xeb\xb7\xb6>\xf6\x0eN\xe2/F\x17QW\xe4\xb9\xc7:-\xe1\xee\x8d\x18\xb2\x17\xa8!gD\xdb\xc6\xc5\x00\xeb\xa7R\xf8>\x90\xdf\xdaQ\xb3o\xef\xe0,~\x8b\x03\xf2\xf1(?jW;\xfc\x14\x8c=,\xabx\xa0z\xc0\xc3~\x97\xd6\xfd\xde\xe1\x9e\x91\x8a\xf0\x03\xbb@\xf1\xd3BB)#\xb7\xf6\x08I\xca\x8f\x8c[\xdb\x0f\x15f\x11\xb4\xcc*\xd9\x08\xd6'\xdd\x09)\xe1\xd1\x9e2\xc77\xf00,\xf00\xf6\xce`vznp\x83u\xc8\x9c\xc1\xc8Xmw\xce1\x0c\xc8\xafBHv\x09dq\xf2}\xe3\x17*H/\x15+\xf3y\xf9\xe3~>\xf2Q\xd0XZ\x02\xa3\xd9d\xc0\x9c\xe5\xac\xb5O\x16>\xe5\xcc\xee\xd0\x9e\x16\xae\xf7\x8f\x15\xea\x7f\xc9\xed\xd2\x80\xb6.\x05\x90|\x0e5\xaa\xd7~#\xdb\xc3\x80Y\x17\xe2EX\xd3\x8e\"\xf7\xca\x85>\xa0\xf5\xcb\xb8\x1d\xef\xb9\xcb\xba\xeeO\x99=\xc3\xbdC]\xb8\xd7J\xf2\xd4G\\QY\xd7\xe8 \xeed|\xb0wx\xb6\x8b\x0c\xc0\xd8\x18\xde\xa9\x8a\xda\x94\x00\xe8F5\xa1\xd4\xdf\xea\xa7\xa6k\x81\xdbIBG@\x0bF`\xf8\xe9\xc5\x12`5\xf5\xb2\xe3\xda\x17\x12a\x8b\xbe\xcf\x90\xf1>\x8e\x02\xfb0\xcdu4\xea\xe7\xf0\x0a|k\xb9R\x1e_1\x96S\xc7y\x03,S\x18\x91\x96\x03\xb3=\xaa\x88x\x045\xf6,s\\\x03\x1a\x7fqs\xc6\xefo\x7f\xc5^s\x15\x11\x8f&U\xe1\x9eS\x1dv\xb6G%t\xaa^\x01\x88\xd7\x7f\xda\x7f\x17G\x01.Y\xec\xce\xdf\xddin\xf2\x12\x84\xa3\xe3`\x18\xaa\xeb\x0c\x98\x9c\xbbH!\x1c\x9d\x98\xee\x1cpb\xd9`\x97\xcb+\x95!I\xbe\xcb=a\xef\xe84\x81e\xa2\xb3+e\x9c\x01\x96\x1b\xf1\x8f\xdc\x85\xac\x84\xc89(\xbc\x15\x827\xa0\xd1w\xfe\x1c\x15\xac]U\xec(\xe5\xe0\x83R\xbb\xa3\xb3>\xd70\x91\xd1mE\x9f\xa6\x99CB\xd7O\xcd\xd9;\xfec\xbc\xb5\xbd\xe3\xbd\x04|\xb7\xa3m\x9d\x09\x8a\xd9;\xb6P\x05+B\x11\xec\xb5K\xf7|`\x11\xc8\x99\xee\x06\xe5\x1d\xa8\xdfp\x88\xf0\x82%\x8b\xddZ\xdd{\xc7\x871n\xf6$\xf4\xec\xbeQ=!\x7f\x09\xe7\x94%\xef\xc4\xf4\xdb;>\x8a\xc7\xef\xd2\xed\x9a\xe8\x07VF\xac\xff\xbd\x1c\xefv\xf4H`\xd9\x7f\x8e\x93\xb8\x83\xa4**\xef\xbco\x1d\x9f\xaapu\xa6L\xb8\x06\x82\xc46\xb5\x0a\xc0\x916\xc9\xae5\xa4\xe33urEn\xfa\x0d3\xd3\x80\xaa\xad7\x10C, \x0dj\x9fF\xfb\xdf\xbbZ\x01\xeeu\xe1\xaa7\x9c\xc9\xb5A\xb2{\x83b\xad-%'\xba\xa7t\xb2CNy\xb2\xdfE;\x0f\xa3??\xdd\xeb\x17P\xd7\xea\xbf\xe3d\xe1\x17\xb5c\x8b@\x0e9\xde\xfb\xe8?\xd5a\x1a\x91\xa6\xb0h~ET\xd6~\x191\xb6\xc6PC\xe2\x8d\xe2\xe8\xdaf\xcd\x8e\x91]d\xd4\xf7N\x8em\xec\x03\x96\xea\x82\x17\x8e\x8fL\xaaFma\x1f\xce\x98\xdb''v'\xf2\x87\xf6\xfb\xff\x13\xd39+`g>D vL\xdc\xf4+\xfe\x12\xbe\xf9&(\xfc^\x88\x94\xfe\xd8\x97w\xd6\xf5\xf2\xde\xff\xd2N?u\xc5*&\xd9\x8a\x80D\xab\xfa\x89\x82\xb07\xc72\xa7ju\xe9\xa8\x1d\xbfi\xa58\xdd\x0f\x8d5\x81\x99\xe3M\xc1\xd8B\xcd/\xc5;aa\xd3\xb2\x9f\xa7$\xfb\xf9\xd2'\xf5Y\xbf\x0c\x94\xe9'K\xd0\x83\xf2%\xb8`}onh\xb7\xaa\xfe\x1b\xd9O@\xafTy\xe6tP\x1f\x92\xe3\x04\xd4U\x9fQ\x18\xb7\x95!\xce\x90\x07\xed\xb3\xc7\x06\xf1Ef\xf6T\xd6\xf4\xdb}\xe6K\xc2^\x9bZ@\x96@\xed\xea\x86\xb2\xf9\x95\x97\xe8\xa7;\x9b\x081\xa6\xeb~\xf9Q\xb2\xe7\xba\xc7-@X\x9e\xdek\x1e\x95\xa5\xfdD\x99\xe0\x16\xb7\x18\xea\xff\x13VU\x88\xe6mH[\xcd\x1d\x96[Zw\x9d\xe2\x8c\xba1(\x15\x9e\xb1\x8e.\xd0\xeaJ`\x95\xfa\x84\xa8\xe8v\xa9\x8a\x15\x16\x19%Dw\xddD/\xeb\xfb\xde\x8b\xb3\xa0\xda)\xb0\x18\x8d\xe6\x89\xfbS\xcb\xcdU\xde\xc3\xd2\xa5\xd1\x0d\xe1\x89\x97Ec\xb9\xb3;\xaa\xd3\xaf\xa5\x87d\x14\xb8\x1d\x8a\x07u\xde\xa0[\x9b\x09\x04\x9b!\x97:\xa1\xb7\xd8\xde\x03W\xdb0\xb0\x13\xac\\\xd5\xf0\xc2T\x83\xed\xbf\xa8\x87\x07\x7f\xecUX\xbc#\x0b\x9b1L\xd2\xab~\xcdU7\\nA`\xa2\xf2\x03&\xc8\xdf\xec:\x86/H\xa6\xfe\x15\xdf\xa7\x122[\x08\xeb2_J\x11\xa2\x1d\"\x92\xc7\xa2\xb4\x14\xe1\xba\x91\x8a\x98^.\x9c\xdf/G(\x19*\xadT\x15\x0c\x051\\\x7f\xe9\x89\xea\x81S$\xc1\xb3\x7fl5\x88\x970c\xce\xd1P5\xbc\xe9\xbd\xb6\xbdO\xf1Z\x91Z_\xfc\x82\xf1\x9b\x94\x8b%/|M-\x10\xbd\x0b(\xe4v\xbd\xec\xbf+\xde\x1f\x8d_\x03)\x9cL\x859\x08\xac\xd2\x17\xe0\xc20\xe3\x9c:p\x85\xf4N\x0d\xbd\xe7\xdf7p\xc2\x1b\x82\x02/\xe2\x1cR\x01\xce(\x15o\x0d\xb7\x83;\x7f\x0f\x1e\xba\xe9B\xb8z\x0b?qc\xe5\xbbTT\xba\x15\xaa\x03\xed\x0f\xab\\i\xd8\xaf\xa4\xe1\xf8\x88\xe5pua\xd8\\\xbef\x85Gi$\xa8\xe0\x13s\xd8f\xa0\xbd`u\x8b\x12\x0f\xb5\xcc\xd0\x98\x95\xd5D\xef\xcb\x1d:\xf9\xd23\x97\x00__\xf8\x04T\xb7\x92\x97\xabX+(Wz6\xe6\xe1\xea\x97\xc5\xffC\xa4\xbcW\xe4\xd8\xd9\xe6r\xa2\xcb=2Jam\xa6\xae\xe0\xc7\x80\xf1\x0c\xaf\x83\x811S\xd9X\xbfi/\x13W=\x12\xd9\xab\xeaW\xfd\x07\x1a\xbb\xbaAG\xd1q\xc5\xb6\xea9Z\xb5r\xd7\x0c\xcc\xb0\xf0\xf9\x06\xae\xbd3h\x10\xa0>\xb1\xffe{e\xb2@\xf3\x08\x8b\x94\x1f\xcf\xc5@\xb9\xfbJ\x83!k\x01\xc8\xa5\x9b\x9e\x90b\xef4\xae\x03\xe1\xad\xbe\xa2H\x82{,d\xb0\xfb\xc6\x96\x15\xe9t!l\x81h*\xd9\xa6|\x06\xa0\xabZt\xffq\xb2q\x88x\xee\x92z\xdf;\x0bl\x02^\xc9\x14\x11\x1d\xa7P\xf4\xd5U<`T\xf2lk\xc6\xbb?)\xad\xaa\x0bR\xdc\xed\xffI\x83V\x0cM\xb9~\x1f\x81s\x8a\xa1\xe6\xc2\xf8\x09\x04\x93\x04k\x07K?\x86\x93U\xf5k\xef\x90\xdc\xdf\xb3\x90N\x92\xf4\xf1\xcb(\x03\x85\x96\x10\x1e\xd6\x0cW{\xc1Y@(\x85\x15\xa5\xd77\xb2\xaeF\x81\x16\xa8P\xfb\xcb\xcaR\xe2\xa5C?\x92\x0d\x7f\x18f\xda!\xdf>\x90\xf6\xff\x9c\xf4\xf8Vk^H%.:\x98\xec:}s\xbf]\x0c\xc4-\x82*\xfd\x00\x1c\xd3\x8c\xc6U\x191\xebS\x0aXCX\xec\x16\xcc \xa5\xb7+\"\xd4\xa8F\xa1t\xce}\xf6\x8d\xf1\x16:0 K\xc4\x85,\x0d\x8anr(\x90d\x00\xb9U\x86\xbep<R\x07#\x1am\x1c\xb7\x02\xb4\xfa\x16\xc1\xe6\xfc\x07\xe5:\xb5\x85>\x81\xb0\xc9\xf6R\xd7\xe6\xd3\xa4\xd4\xb5\xa5|z\x1f\xca\x0a\x9a\xfe\xc2\x12\x0c\x83\x88'X\xca\xdd\xf5\x1aw\xbe\xacI\x12\x95\xbfR5]V\x82.\xfc6\xef\xcb\x0b-\xb8\x0d[$\xea\xf6V\x18\x0e\xf0\x0b\x03\xe5\x05p\x06aB\x80x\x03\xd6\x9a4\x0c\xd6\xbe\xc1\x1e\xfcJ/\x1e\x1b\x02n\x97\xf8\xc5\xf0\x93\\\xf9\xb6PK\xb4\xd3:\x1f\x1f:k\x1aI48\x13(d\x04\x0d\xfc\xf4\xf6\x88\xe3 b%\x04\xebO\xeeI\xe8\xe9.m\xb7Dx\x0d\xe4\xd3\x1bZ@\x8dO\xe6/\xd0\x14_\x87\xa4yw\xbeoh\x105\x80\xede\xe4\xde\xcf\xa5\xd0\xf9n\xa6\xfd+\x99\x167\xfbE\x05\x1fk\x0e8\xd0\xd0\xcc\x00\xde\x08_\xb2\xed\x98\xf6\x13\x90zZ@\xf3\x1f\\CJ\xb4\x11\xf5[\xdb\xe7N\x0b\x06\xc6\x13#\x15,x\xc6=\x8aO#\xb6\x0ak_\xe40B_7IR\xd7\xaa(\xd51\xdbWV\xe5,\x90\x88\xb2\xf1UtJ\x0a/\xfcB\xe8I\x91\x85\x8b6~\xe0\x98\xf6\x97\xbd\xf5u\xa6\x0d\xd1 \xc6\x91\xa2 \xbf\x8d\xc8,pO\x0d\x8f\xaa\xa0\x8d}\xc7\xed\xb2+\x81x\xc1\x03\x91\xae\x94%\xad\xef\xdf\x0bC\xda\x99\xd2l\xc9\x8cH\xb589\x1c;\x01\xde\x09\xfco\x12\x16^\xfd\xc9/\xa4\xb2\xaf\xeeq]\xfa\x9e\"\x0f}\xd6[\xc8-\x99\x16\xc2\x97\x80XM\xfd?T8\xbf)k\x8cf(\xa9s\xd5\x00!\xd4$\x0b\xfd\xf1+\xe4\x08\x1f\xb3\x8b\x15\x81\xa9\xc5\x06\xa1\x1dS\xac\xe72\x17\xc2*\xfe\\=(F\x95\xd6\xf79\x0fFj\x912\x10\xe6\x0a\x16fh4E!y\x90.#\xe9\x8cYr\xf4\"\xd9N~\xa9\x83\xca\xcflwE\xbb%\x06\x13\x01|\xa8\xe0d\xdcT\x0c\xc6Y\xb5\x15#'\x8f\x9c\x9f\xa5\\\xc7\xab0\xf5\x82\xd4\xdb\xdd\xe6\x84!\xa6\xa1\xc8\x92H\xe4\xca7\xe7e\x07{a\xd8\xbc\xf5\xfa'njF\xc1!\xd1\x1c\xcb\x00 *\x81\x16\xe4\x84\xae\x99\xf7\xd6f\xb5\xa6|RH\x00\xcb!Kx\x08\xee<\x0fnD\xdch\xd3>\x9f\xaeU\xb4\xb6\x8a\x1a\xa6Q\x1dP\x9d\xc3\x15\x05\xc3kt\xda\x95\xfbIU\xdf\xf7\x8f\x80\x03!KP\x82\xf1\xd7\x0cbM\xf1do\xb0\xd2\xe2\xe1x\x15:\x14\x16\xc0\xe5\x94\x0a\x1f\xf5\x06\x8er\x1e\x16\x13\x19+\xda\x95\xd7\x1et\x900M\x10\x94\x1c\x8d3%b!\xf2NU\x98\x18Q\x17\x11\x06\x09y\xd6\xbb\x8d\x06Z\x17ZT)#@:\x88J\x8b\xc8\x96\"Dj\xd0\xc0u\x13| ' \xfd\x8dSif\x81$\xcc\xae+\x18r\xedu\xcb\x928F\xbe\x8fB\x80\x80\xe3\x04\x84Z\x15\xf1zs\x99\xa3\x9e?$K\xf4\x9bD\x1c\x88\x85\xbb\xaa\x99\xb7W8\x00e\x04Q\xa7\xaf\x84\xcdd\x85=\xd8\xd23\xc8\xea\xbf\x9bK4\xcb^\xb4\xaf\x03\x95/{_\xc4IP!\x89\xa9V\x16\\\x12\x01\x85i\xdc:\xc9\x08\xf2\x97X\xae\xba\xba\x7f\x86\xb5a\xc8&\xfe}\x9ec\x14\xce\xc8\xb5\xc8\x86\xf7\x02\xb1|w\xd5W08D.\xbb\xa5t\x89\x0d\xa3\xfc\xc1\xc0R\xbd\xf4(\xe1[\x09\x8e\x9a\xc0D\x18\xe1J\xf2K\xaeM2\xf8\xba\xceP\xd2\xff\xd3\xd1\xfe\xa1\xf1\xcc\xec-)\xde\xc2\xca\xe6\xfd\xbd\x8b\x81\xa6D\xf9\x96\xf0_6\x8bR2#\xb0\x00\xf2\x8b,\xb40*\xc3rA\xb3lJ\x0c \xd1\x0e\xf8\xde\xffP\xa5\x87\xd3\x1an\xeb\x07\xbe\xa5\xb5[\xe9\x17\x9cf\xf18p\x01\xc8/\xb2\xf7)\\,I\x11IE}\x08\x17\x93\xaf{\xb7\xe2\xc3\x83\xfexA<&%\x83\x08$\x7f\xe3hKhG\xe5{ [e\xcc\xa3\xc0\x95o\xd6\x14b\x87t\xc3\xc5\xaf}>\x12\xd2\xb3(3\x12:\xd4M\xfboM\xe9\x03\x0di\x1f\xebV\xcb\x1fnZ,\x10\xd1\xef\xe7\xe7\x0f?G\xea\xe5\x00v\xea\x8bH\x1a\x07%\xe0\xf6\x01\xe8\xbc\x93 \xd0\x8f:\x9a|\x11,\xb8K\x9f\x1e\xdd\xfc\x9e\x10\xeb\xf0\xa8{\xea\x08\xc8\xa2\xa8\xc9\xc5d9(\xc7\x8fi<\xcc\x89\x9bFS.\xf1\xd2\xe6\xb4[\x99\xf8\xc1\x83\x9a\xfaB\xf7\xc3\x84\x07\xea-Y,\xfb\xd5U\x9a=\xba\x07Mt\xe5\xfa\x05`*f\xa4\x91\x03\\\xef%\xa4\xb8\x83\"+\x0e\x97\xc1\xb8\xe8>\x93 \x0c\xfc\x1d\xefg\xde\x7f9\xa7]o\xf8\x1f\x82\x0d\x81Y\x0dz\xf2\xd0\xc3\x07\xac^Qo\xf1\xcf\xdf\x8d\x10C3D\xa1p\x14\xf4\x87\x06C\xb4\xf7\x9ay\xd5\xd1\xbd\x8d\xdf\xc8U\xa4\xa4T\xa5s\xc0\xf1\xee\x9b\x8a\xa0\x01\x05V'[\x9a\xb2hT\x02\xc1\xe9\x0dDa$\x0e^\xc4\x84\xcb\x96d\xa7\xd7\x15d1\xf1G\xdc\xd3\xc8I_\xeb\xc8\x81\xad=,\x83_}\xce\xc8y`\x06\xf9a\xae\xaa\xb0\x0a\xa0\xc5\xfb\xc2u\x8e\xb7\xc4;-B\xd0[\xb6\x7f/b\xaa\x90\xde\xef\xb0\xaf\xd0\xcc\xe0\x05\xd1c\x80,\xfamm\xce\xa3\xc3~\x89'\xe1\xd8Sr'\xcc\x82" +
+	"\xfd\xabf\xea\x97\xf2\x82\xa2\xbb\xeb\x1c\x1b\x09q\xae\xd8\xa0\x87')\xbc\xd9\x08{\x04\x15\xdf\x9c\xe1~\xac\x86\xfb\x85\xac@#\x98sA\xa4\xd3)\xb7\xb4\x11\xe7\xfb\xaf4\xd0Gy\x8a\xbe|Z\xf0\x90\x12&\x03\x02\xab\xe3w6\xa9\xa9~E\x01\xba\x8f\xc5z\x80JG\xf1\x00\xbd\x14\x8a\xb6\xbe\xfd2\x16\xda\x1a\xca\xfc\xf2*\xc3:\xc2\xde\xc9\xccU\x17\x90\x9d\x85\x8ae\xed\xe0\xd3\xac\x8f@\xa1\x93t\xdf\x1f\x88\xd8\x84\x88\xfe\xa8\x15%q\xb1dQ(\xa7\x10\xc8\\\xa2\xa8\x91\xa1\xf1D\xe9\xbf\xc0\x91\x02\xda)CA\xbf\x83\xb6\xff\x84?\xe3{\x95\x1e\xac\x89!uN+\xd2:\xcf\xc8\xa2\x071\xe1\xcb\x98\xfa\xb2\x94\x91\xa2y\x93\xae\xfb\xfa\x08\xb2\xb0*~ww\xfe\x03\"\xb4a k\x90\xf5\x0e\xf6\xbb\xfcj'\x9ayC\x0d^\xf7\xaf5f+P\xf6\x81*\xb4b\x9f@\xb7\x0e\xeb<\x864\xaa6h\x02 t_\xef\x81Q\x8f\xb9\x81\x1e\xd3\xf7\x90\x13\xbcq\xf1\x15$\xfc\xa0G\xc6(\xba'X\xbb\xa0\x8e\xa5!\xf3v\xdf\xac_\xfa\x07@l\xbd\xd6\xdd\xa8\xb1\x00\x0e\x91\xc5\x86\xdf\xa6\xe0\xa4\x9c\xc4T;\xbc\xc3\xa3\xc0d9pB\xe62\xd2\x80\x88\x06\x15\x054\x81\x92\x11Ck\xaf;m\x10\xb9\x80C\x1d\xe6R\xcc\x1b\xaa\xeat>\xb8\xe3@\xa8\xf4\xf7\xa0\x05#\x90G\xc9\x9cq\x0c\xb5\xde\x8b=\x09\x9e5+*0\xbe\xe3Jk\x1f^\xe5\x98pD\x18\x88J\x0cz\x0b9\xdf\x8f~{\x913\xcb4\xb6\x1e^\x02\xcb\xe1\xf3\x02\xbfw^k?\xce\x02\x85\xd5\x07\xa6\xa0*\x94\xf5\xbe#\xd3\xff\x1bl0/Y~\xbe'\x9f,\x1eo\x0e\xb0\xc5\x82\xd5z\x94:\xebX\xe4T\xc9\xa1=\xc5\x96\xd1n\x1e\xed'{]\xfaW<,q\x1aWI\x12~eJ\xf2R\x8e\x0a\x0a!>>\x92\xc0\x83A\x86N\xc3\xc9~\x82\x83\xc4@!\x97\xc4\xae\xfc2;@!\xbdfH\xef\xa6\x14\xb6\x1f\x14{\x80\x88\x8c'\x1d00d\x1b\x087o\x10S\xc1=\xb3\\\x8b\x90\x0d\x95\x1b\x8a.\x01\x83\x92k\xa2\xa5B\xcd\x0b\xfd\x9f\xdeq\x95\xf0\x08\x81\xea\x81\x1f\xfd\xb0\xbb\x1b\xffV\xdf\xdf\x82d2%c\"\xa9\x0f;\xb8\x88\x84\xfa\xbe\xf6\x82\xca\x01/\xc2\x05y\xe4s\xbd\x1cAamPW\xba'IdG\xddkv/Z\x94\x91h\x12\xdd6E\xe4\x8e\xdd\xbfy[$\x92}p\xebDG\xea\xbd\xed\xe3\x84\xcay3\xe5\x8b%\x97-\xc59\xb17Bp#\x99\xc4\xb8\x15\xfa\x9a\xd0%\xea\xeb\"\xe3\xc9\x99S'\xb1\x17\xe4s$\xf6\xbeZ\xc4,\xa1lOc\xedI7=\xab\xbda\xed\xfd\xc3q\x82\xf6\xce\x86X\x01{W+n\xa3D\xd4m\xa8B\xd4'\x9d|r\xd6\xdd#\"\x19\xf6\x16\x91\\AS\xa5\xeb\xcc;\xea\xea\x9c~J=n\xfd0#5)wIY\xee!{f\xfeG\x1f\x17\xf4\x9c\xfc\xa8QRO\x92\x05_e\xd8\x9f\xd8;\x01\xd0\x0c\xd0\xceV2\xf2\xbb\xf5\x95q\xb0\xf4.\"\xdeW;\xe8\x9c\x9d\x1ed\xe80\xc8\xa7\xb9\x08\x89\xc9\xbbp\xbeO\x0f\x93SE\x81\x89\xcaA3\x0b\xfe\x08\xd3\x9f\x80\xd8\x19v)\xf0\xc4\xdc\xa2D\xffML\xe8\xa0P\xe0\xefq$\xb5}]~\x8b\xeb\xa6\x7f-\xd1\x07\xb2\xfb\xa3?\x0eC\xc0\x89\x12<\xafo\x01`;J\xc9Ip\xf0uK]\xc9k\x15\xc2\xaax\xddN\x07X\xc3\x0b\xc0v\x0c\xfb\xaf\xf9\xc4$'\"l\xd5\x03H\xd5:\xfd\xe1\xdf\xe1\xcb\x82\x1d\x89\x95\xe1\xfd\xfb7)\x02fr\x87\xc0\xc6q\x11\xe3)\xa7Y\xafg\xc4z\xf5^\x8c\x93z\xdd\xcb~]\x01\xaa\xc6\x17\x9f\xcb\x98\xc6oe\x9e\xbeUN\x88\xf2\xa7\xdc\xb8\x1f1\xb7\xf5?\xe1\xcb\xda6\x84\xc5\x0f\x85\xd8\xa1\xb8\xc4q\x14/8\x84\xb2=\xea\xad\x98{)\x06bui;\x1b\x0c$\xe0\xde\xfcc\x86{\xdfAD\x83\xca\xd2\xa6\xf5\xb1\xca@\x07\xb6M\xaeyQ2\x98\xb2;UA\x90\x8866C\xe2\x9a\x1c\x08\xdf!b\xe7o\xb6h!T2\xea\xcf\x18a\xb2\x1b\xbe\xf4\xb9h\xc7\x0b\x9d\x06\x03;7CC7\xab-\x88R<\xf7\xb9\x16\x8e\x8a\xa5\xc2\xc4f\xc4k\xd7\x7ff[\x1b\xc8\xe8\x9a\xb1\xa4\xd3w\x9d|\xcc@\x91\x0b\xee\xa7\x05\x827J\xa1\xeb\x0a!\x85\x18\xd9]e\xd0\x9c\xc95i\xa0\x1c^\x02\x990i\x02\x81/\xda\xe5M\x03!.&Q.F\x00\x8c7\x92\xfa\x8e\x9eI3vo\xb0\x94$#2\xd7(\x07\xa1\xa0\x01[\xe2\xcd\"\x97\xf8\x01\x15\x14\xda5\xa5\xbb\xcf\xce%\x94\xd4d\x9f\xfb\xbc\xd7\xe5\x98\x15[TJU\xd0\"\x90K\x10\xe6\xb2\xf5\x1a\xce\x98:\x92\xfenx,y&\xb8v\x09\xfdQ\x10\x88\x09\xd4n\x95\xaeA\x01\x15\xe3B\xf1\x90\xe6\x0a\x0fDh\xa6{wrl\xb0\x01\x0b\xfa\xa6\xb9l\xfe\xf2\xc3I\x18\xdd\xcf\xe2<\xda\xdd\x8bJ\xb1\xf7\xe7\xa70\xd7\x08\xa8\x83\xd3\xde\xe0u\xc8\xea\xb8V\xa81\xc9\x9e7\xe9K\xfdD\xdd\x81Br<\xe4&Q\x08\xd1\xb7x\xbd\xb6= \xfb.\x0f\x80\xfc\x09\x0c\x9ff\x0e\xb4\xaa\xa0\x93q\x1dL\xa3f\xa4\x96\x9f\x81\xb2\xd0\x05\xf2\xe7D\x8c\x10\xc5\x80\xffFx;]O\x11\x004\xf9%\xb6r\xfc\x09\x8ef\x138\xe6\xb5\xbf\x831\x88'^\xcb\xca\xad\x08\x9b\xc7\xd2\x9e\x98\x8bBZ\xfeG\xe6-\xc8~\x96\x02\x8a\xfek&\xf0\xe2\xd5q(\xa3]\x8f\xba\xf4=\xf9\xb5{\xfd\xbd\x15\x10SX\xc3|\xbf\xfd\xa9\x82{\xa7\x17r7p\xa7P5\xbf\xc2R\xa4\x92]\xe3\xa5\x1eW\x0fD\xc5h\xad%\x0e\x95\xdc\xef\x87\xfe6DC\xd1]_\xfd\xec?\xe7\xeb\x93\x07\xa6>M\xe4\x0a\xea\x154H\xd6\xb4\xcb\xc3?\x89\xfc\xe4:\xa4\x84]\x86\xc4\xde=Xc{\x1c\x98n\x8d$b\x88\x9d\x90\xd5\x90\xf5\xab\xda\x14\x7fS\xff\x8b\x1f+\x9e\x81\x91.\x8c\x9a\xac\x05`\x9a\xad0\xc9\xbaBw\x1d\\\xc2\x09Z\x0czw\x9d\x1d\x82\xa0\xec \xbb\xd7\x1b\x09\x0ev\x97\xe0k}\xfe\x9a~\x80\xd0\xf4\x95\xe7\xb2\xb8\x19\x1f\x10}&h6\x85M)k\x93g\x1e5\x9c\xbe\xfe\x06\x9b\x01\xe9\xa6Z3\xffK[ \x02\xc6\x06\x95\xb0mu[\x81\x11\xc2\xeb\x08\xf5Z\xca\x81\x11\x84,\x82\x08\xd3/\x8c\x8f0\xa6)\\\xa84\x07&\xceS\xf1g\xa5\xb6\xcc\xca\xea\xd8r\xe7r\x08[\xd1\x08\xfc\xca\x88\xca\xa5\xb5LT\x0a;\x08H\xf4;\xb4\x8d\xac\xb2\xc4M\x10\xa7\x9d\xe3z^\xaf\xda\xf7\xff\xb9\x99R\xdc\x1b\xd9\x98U\x85\xf8RX\xc7@\x91w\xe2\xff\x08\x11*\xda9\xc4\x94B\x04z\xcb\xcf:\xf7.5JOV\xae\xf4C&\xfa\xad\x94\xfb\xf2U\x97$\xe7(\xd0\xa4Ke\x0cr\xe1\x0b\x7f\x14\xb4\xdd\xa2$\x8a\xf2\x9d$\x8a\xbdOa\x01b\x1e\x96\x7f\xaa\x0c\x16\x0d&@\xacJ\x121\xbe\x18Gx@\xc6~C)n@\xff\xba\xd2\xf9\x99\xb0}\xf4\xac\x02\xffK\xc1Q\xe5P\xb4\xb4\x09\x10\xac\xec\x1b\xe0v~i\xae\xa2\xcf\xba\x04\x1a\xf7\x0dC\xe7\xb4\x9d\x9dr\x0f\xb9\x87\x9d@\xc0\xf1\xde\x80?\xde\xdbKw_\x81\x95\xd1>\xc7\x0aj\xed\x98\x94\xe0\xe4\xe5\xa4\xb1\xf8MI\xb4q\xf4\xb2\x97\x83\x81/]R3R\x8f\x89\x17h\xe3<\x04\xfd\x87~W\x81\xde\xe7\x03m\xf0f\xc8\x88R\x00P\xbfpW\xcb\xf0R$\x0eB\xa9I\xe4\xdb\xce\x1d\x9a\x0b\x0b\xaa\x18\xe6\x05\x95}o\x84\\9\x9by\x8e\x0e\x88\xad\xa2(\xd0E\xfd\x0cH\x94\x95N\x03LY\"|\xa6\xda\x09\xc7M\x99\xb5(x\x843\xf9\x9b\xdfQ\x99\x11\xe6\x07\xc3\x10\x92\x1f\xc5\x1e\x16\xf8\x04_)\xf0\x1f\x85\xccI@A\xfa\xf4@\xa9\xc4\x17\x96\xa9hS\xa9o~x\xf9\xac\xde*\xbdfx\x15\x1e\xcb\x1a\xb2\x81\x9c\x104\xca]\x1f^?d\x03\x05\xf3\xa6\xe3\x94Fe\x1c\x07\x08\xebz\x95:\x90\xbe\xc9\xb9\x1b9\x90\xfb\xfa\x91n\x12\x0aTM55\xfc\xf6\xb3\xf8\xf2Nz\xc4\xd2y)G7%]\x0d\xbb\xa7\xb6[\xc1\xfaqw\x0a\xeb\xd7\xfeq\xc5mI\xdc\x09y\x0c\x079%BN\xb5\x83\xf2_\x1d\x14^\xa1\x9d_\"0\x08\x88\xc7\xe4HX\x85X\xf1*Gj%\x11\x01`\x9c0\x81\x10\x7f&K\xee\xe7lz\x811\xbffI\xa7\\\xa9\xb0\x0a\x15\xd5/2W\x01Fp\xd6t\xd8\x88\xde\x87\xc7\x92}\x8c\xf7D\x15g\x81\x86<V\xee\xb4\xda\x91\xe9dH\xd9\xdd/\xfc\xe6\x94\xba\x19\x99\xc6H\xee\x97\xd7\xe8=w\xd9@I\xa8\xb8\x1d\xf1kB\x19f\x0cU\xf7i\"\xf2\xe1\x99B\x86\xeb*\x8d\xb1\x12\xbe\x8b6\x0f.!\xeb\xbeJ.!nb\x06_D\x8e\x0a\xdaJ~A\xc0\x97\xa88E\x17\x12\xa31De\x1bNp\xa9?\x12N\x1f\x88\x82p\x8b\x8a.3\x8c\x93\x9d\x936X\x91\xa1l\x83\xcb7g\xb0kZ\x05\xa2E\x04SOR&\xc3\x9a\x04_\x0d\xe8?/Y[v\x0c=.\\\x00i!\x81+\xefbls\xba\x89\xcf\x03p\x8ar\x9d}\x82_l\xb9xT\x9a\xa27A\x06\xa9F\xdbL\x01`\xa3\xcfQxF\x8a;\x032F\x89\xaa\x02\x81\xc3\x0bIe\xdcX\x96'\x15#\xd1\x94\xe0\x02\xa9\xbb\xaf\xb0\x87ZE\xcb!y\x87k|\xe1G\xf3\x94\x84\x86\x90Z\x16\x06\x84\xd4\x7f\x88\x14\xb5_ \x0d_[\x93g$~\x8c\xa9r\xd8\x0ca\xc6\xcdt\xe9\x17\xb2\x8a\xb5\"\x96\xae\x89\xc1\xce\x0a\xe3q\xd9\x82\x05d\x9e\x1b\xaaFR\xb0\xe4\xb9@\x16\x8djH\xd0zr\xc5\x96]x\x03?0\xaa\x91U\xe3e/\xf6D\x88\x03\xe9g\x07\x9a\xd2\x14s'|\x0e\x1fH\xd3\xe2\xab_\x85\xb9k'F\xf3\x8aZ\x13~\xd4\xd3GN\xad\x8f\x8b\xb5\x03PN\\\xb8oo\xdd\xe6zcDL\xbd\x04\xf9\x98\xa5L\x12;\xe97\x7f\x14\x04\xec\xc7VY1\xf2i\xb2A\xf7N\x9d\xc3\xea\xd0\x06o\xcfX\xfft\xbdA\x1br\"\xdbR\x7f\xcd4\xafh\xb1\xc0\xedI\xf7z\xb0a\xef?q\x0cI\x18\xe9U\xb0y\x0f9Q\x1d[o?\xa2,\\g\xa8'\x99y\xc8\xb1Hs\xce\xd7\xeckZn\x8b\xf6\xb7\xefUN\xfen\x8a\xf8\x06\x9d\x17\xc2\xc3k \xb6\x96\xbb\xd7\xfa'\x0bnp>80r\xa7\xe9J\xa8{c\x9b,\xcdz!\x03\x14\xca4\xda\xb1\x98\x92fFN?\x8b\xb3\x99\xa0S>\x19\xf1\x92\x14\x1b\\\xd6\x81\x9e\xddo\xca\x0c\xdd\xf3\xfdc\xe3\x7f\x03'\x82N_\xbc\xd1\xc0\x1a[O\xbe\x80b6\xbe\x07\"\x97\x7fI\xd5[\xa8`\xaeo-.\xd2{\xab\xf0\x81\xfeV\x9b\x198,H\x8f\x8c\xdd\xcc\x1c\x8cH\x8e\xd2\xfd\xfaA\xd6\xaaR\x07\xc4H\x17\xe9\xea\x12\xc7IF\xb8\x1c\xdf;v\xfa*?\xf4\xbeb[oL\xbc\x97\xb1\x8e\xfc\xa2\xa5\xba\xbe\x9b:\x955\x04\xba\x8aKd\xc4\xaf\x8d\x1d\xba(0\x15$\xb1\xbe\xa5\xf9\xda\x7fyy&\xbb\xac$#\x1em\xb0K\x8b\xe7r\xe9\xa3\xe1\x1bw\x15%\xfcA\xe0\x01\xeag\x08pG\xbc\xf6M\xe4\xfe\xe26\xa0\x8d\xae\x9e\xae\xd5\xd1(\xa6\x9b\x88\x12\xba\xf8\xceuW\xe1\x16\xf7\xaa\xe0\x9a\xfbe\xf5\x15\x97h\xe4\xa6\xaf\x7f\xb3\x17\x86U\xb4\xdbp>\xf8\x14BmX\x0dx\xde\xdc@\xd5<F\xc6\x85-\x98\xbe\xd5{\xae\xbd\x98\xe1h\xc4\x10)\xaf\x0eCl\xc9\xa0\xd7\x85\x91\xfdnX~\xa3N3\x91\xe5\xcd\x9f\xfc@\x07\x0a\x96\x07\x0b8\x0c\xcch\xc7\xff\x82\xb9\xbdj\xadG\xd5\x17\xaf\xc3\xf8\xe8oR\xa8g*\xa1{*;5s\x84\x06\xc0\xea\x19\x85\x11:\xb6|3s\xed~\x11K\xee\x02\xfb6\xee\xbajg\x12\x17\x97\xba\x0f\xf7\xa3\x17\xe2\x86\x9d\xc37\x93ah\x04\xd6\x99\xbbL\x1c\x1c\xa6Je!\xae\x85\xc5\xd8\"\x83\x87\xea_2\xc8r\xb7\xfa\x84\xbf\x7f\x9b/\xf2\x81\x04\x84V\x04 \x19\xe2\xda\x11l\x96\x81\xf3\x09@2\xb1\x1a5\xb0\xef\xc3J\x88Q\xe7\x89\x1c\x9d# ]\xdb6\xd01\xba-?$7N\xd5\xfe\xcdP\xbd\x09\x86r\xac\x84\x88C\xf5ZK\x90\x10'$(D\xfa\xd3\xb3\xdef2\x94\xb2\xa5\xe7?\x17\xfc\x1e\x04\x02{\xfe\xa4\x14\xaa\xe7\x9f\x103#\xec\xdb^\x0fr\x9e\xaa\xc2\xf7\\\x0bs\x94\xa9+\xdb\xa0\x99Z\xaeH\x0f\xcd`h\x17c:\xbe\xb2;\xc4T\xbb\x80\xbe\x05\xd4\x15\xb12\x8b\xd4kJ\xd1I\xb8%\x89\xbf\x82\xb41Vzm\x88\xd8\xf6\x07\x83$!\xfdh1{J\xc8\xc6>vD\x1f\xc8\xe0\xe0\x82\xee\x00\xa2\x91ga\xcdW2bA\xe36\xa3gE9\x1b\xd9XN\x9cj\xe2\x97\x00\x90+\xa2\x93\xc9\xdf\xae\x82\x9e\xcc\x03\x99\x0e\xc4o1(\xa6\xce\x85\x97\x93\x8bAC\x99A\xfe0\xc7\xbb\xea\xe0,\xd5+\x16\xda\xfc\xed\xab\xd2\x0b\x92\x11-\x9bHNT\xdc\x83\x84\x9arw\xb0\x9c^\x85.Q\x01\x0c\xbc\xfb\xda\x85\xb2\x13\xcfx0\x0d\xa5\xc0TK}R\xb5\xef\x89\xbeA\x0b\xfa\x03\xcc\xf8\xc8s\xb7GA\xca|\x1f\x9f\xe7po\x07\xbc\xb5\xa5\xf8D\xf1\xd6\x91\xbc'\x09\xc1\x96\x10\xf1\x0b\x88\xd8\x1e\xa9Q\xe2\x8e4%\xc4`\xb0fC\xe1\xea&\xe4\xe0\x08\xe8\xc2\x8f\xd43\xa3\xac\xd2\xd8gF\x9d\xe8\xb1\"\x01\xa0\xf8q\xa6\xf0\x94^\x9e\xe4\xd4\x90\x1d\xfef\xa1\x1b\x96\x81\x7f\xd2L\xd5\xd6UL\xb6b\xf1\xed\x09\xc3sJ\xef\xb41\x94\xf0e\x1c\xd7\xcc\x00k\xe6jE(\x07\xf6\xa8\xe7\xee\xf9\xb9\xcb\x0c\x9f\x9d\xa0A\xfa\xeb+xwFy\xc0\xb1\xa4\xa1+\xe0\x9a(y\xf5AV3J\xc6\xac\x00z\x8d1\xef\xb9h\xa7\xd3\x93\x1f\xee\x90\x18\x1f\x1e\x18tQ\x95\x09\xb6\x0fv\x8dgP\xb9\xd9R\xe0\xacw\xaa\x19\x9b\xd2\xc7\\<a$\x03\x8f\xa8\x92\xe0\x064I\xfaW\x99\xf7\xca\x00\xed<k\x8d=4@\x02\xa5\x9d\xcb \x98g\xa1\xc0\xb2\xc5f\xd9=2j\xb0\x0b1\xd6\xa8\xba\xb7\xbb\xe7\xc4\xd0\x8d\xb4\xde\xad`\x98\xddg\x91j\x0f\x8f\x12\xf8A\xf7\x1aG\xf1P\x1c\x14\xf58\xfat;\xb5\xdf)o\xb1\xc4\x8d\x9e6\xa2\xb0;\xe8D]\xb5R\xf0\x0b?h\xf8E\xf2\x02\xa9KY\xbdW\x9b\x13\xe6\x8a\x03\xe8b\x0c*\xa0/]\xbd;\xc7\xf6\xf0\xf0\xb8\x1bq\x82\xef\x0b\x06\xc9\xab\xb8\xd4\x1d\xdf\xfdV\x14d\x0b\x09G\x9f\xfbhd`\xc4)1\x04\x17%@\xea\xd2\xa6\x09%\xa6\xfb.\xdb\"\x16\x9a)\x01b=\x0c\x93L\x85\xb3\x98\xcdn\x1c\xaa\xc6\xae\x02\xba\x14\x9dn\x1f\x06\xb32\xa2%h\xdcW\xc6\x90v\xf16\x0c=f\xd1A\x80x\xdf\x17\xe2\xab\xf7\x16\xe2\xdd\x83\x7f4^\xb0\x01|\x85\x17!\x99\x0f\xe1 \xa6\xe5Pd\xa6\x0f\xa4\xde\x98S\x927:o\xb1k\x02\xb7^(w\xfdK\xc1\xb3\x1c#\x1b\x018\x81\x95\x03\x95\xfcX|\x88\xf3\x0f3\xae\xf8!\x8b\x89\x09\x96\\U\xfdo\xffM\x9c\xa5\x0c^\xdf\x06\x90\xc6\x90\xaf\x1f\xf5,\xf5\x17\xd3\xd8\xe7\x1b\x8a\xd8\xad\xee\xb3\x08\xc0<0\xa7\x1d\xb4\xa8\x01!\xcbjF\xe4\x83\x12\x9c\x8a:\x0a\xf5\x0a\xae\x06\x01\x99\x02Qq\xd3\x14\xb6\x80Z\xbc\xb3\x80z\xa4\xd9\xe0\x8fJsCFI\xbb\xb5u$\x15\xf7\x97\xbf\xa1\xe6/S\x17J\x8e#\x98\x12\xb9\xed\xa8\xa3\xbd\xa0\x9dC\x02\xc1\xef\x18\xfawYK\x98(\x9e\x18\xcb\xb0\x0d\xc4\x91/\x80\xc8/\xdf~-\x8a\"\x88\x8c\x86\x12\xbd\x93!\xcb\xea|D\x1a>0\x13\x18\x92p\xbc(u\x97(\xb5q\xe9\xde\x8d\xe3\x07\x09\x85g\x90\xc0cI\x8cD\xa7v\x0e=\xeeGd\x9f}Hp\x1c\x09Ir4\x04;\xa1\xdb\\-\x88\xc0\xae7\x92\x0b\xa43u\x95\x0a\x89\x89\xc1d\x19\xb2\xa3x5\x11\xb8b\x1eS+\xb1\xc3\xba\xc3\xbc\x1a\x96\x17/\xd9m\xb3\x84\xa9\xb5D\xde\xbe\xdcU\x0d\xdf\xc5\xe2@\x7f\xd1-\xae\xe5\xdbf\x1at\x99\xa1\x9d(P\x888\x19,\x0a1\xec\x81\xe0\x8f\x04\xca\xd9\xa8\xe1DV!\x05\x88\xe4\"E\xa1\x09d\xb6\xbb,\xb6\x08~I\xa5s\x8c\xcfI\xb3\x8e\xac\x8e\xae\x01%\xe2J(\x01$r\xc7\x10\x8e\xa9\x9a\x0e\xae\x911\x12vc>\xfb\x80G\xe0%\xe5}\xd0A\xae\x86Q\xe5?\xe4\x8ae\xb6\xdb\x8ft\xe5|:`\x7fPw\xcf\xa2<r5\xd0\xd4\x84\x058\x1c\x14a%\x0c\xe9" +
+	"s\xfe\xa1\xbc\x0aa%?\xc4\xa7L\xc31\x04\x06\xe1<9\xf5?\xb3\x0a\xbc\xa8'\x09iL\xef\xeazbV\xd7Ep\x8b\x91(\xd9\xce^\x06\x9eN\xb9\x1e\".2\xaa?\xa1\x94n3\xa4/S\x17(\xaa5\x88\x99k\xfe\x8a\x0d\x94\xf8\xbd\xa0i\xc1\x88^\xc8\x17\xc4\x10j*C\xec.\xf2\xfbP,\xb3\x09\xb1S{\x91\x8c_\xa0\xa6\x9e\x8b\xa1F\x9eg\xc3\xb6*\xa0\xab%\xdb\x98 \xbb\xb44u\xa9\xa4@S3\x13\xef\xe4}\xb2T\xc7\xda\x83%\xafgl^Mh\x0a\x04\xbd\xdcpG\x0a\xe6A\xe9\x09]n\xb9\x97N\xc1\xd4\xa6{d\xc4\xecG\xf4n\x85^\x8a_\xc2r\x9e\xd1\x9eyF%x\xff\xdcp\xceh :\xfdhc\xe0\x83Bv\xff\xc4\x1d\x03\x1bES\xd6Q\xaa\xee.\xfci\xc6\x01\xcd8R\x96\x00?\xdc\x8c`\xe48UkU\xaau\xa5\x84\xd2\x08c\xf1kt\x0f\x09\x91\x9b]\xdf\xc7\x12\xf0U\x86\xc4\xa7\xc1\xaaPz\xe0\x9d\xbc\xde*\x12\x99c\x87\xe5vP1\x85s\xf0\xa0\x06E&\xe97\xbdo\xe20\xe4Ha\x17N!\x86\xccU\xac\xfc>\xdb\x9e\x96\xce\"XU\x92\xba$\xcc'.\xb4\xa3,\xca\xe9\xf7\x17:\x8f\x8f\xd2\x10\xae[V\x11\x0c\xa3\x09A\xf8\x1e'\x17\x91HbGQ\xf8\xbf\xb2u\x88\x84\x90\x11\xae\x8dc\xea\xf0\xf8E\xf3\x95\xb7:\xdf\xdeG5&\xa1\xfb\xb1\x92\xbb\x037\xf9\x8c\x0dd\xc0\xa0\xcaQ1\xf5;D\xa8\x89c\xf9^\x0b\xb1\xaa\xdb\xba\x8d\xaf\xcb\x01\xae*\xe3\xec1\x07:x9\xc6@\x96/H\xd0:\xf0=\x92\x13Nt\xfe\xc4\xea\xd5\xf0\xba\x1c\xce\x0c\xeb\x0e;\xd4\xb0R3\x18\xd2\xd2W\xd2KC\x19\xfc+\xd26$\xac)#\\c\xba+3\x92\x9f\xc2\xda\xc2_8,n\xb8\xaa\xfcL\xec\xeb\xdeI\xa0;\xf9S\xd7\x9epF\x0e\xb2\xa2\xe8\xa9\xf9\x9e\x96?\x00c\xe1\x02\xad\xc2#d\x97\x8ee,\xfc\xb2-W*\x1d\x09\x81\xce\x0f\xa8\xda\x96\xb7\x10^<\xadn\xa2\xdddkbBc\x9f\xdf\xe9\x9513\xcf\x97\xa8\xc5d\x0b\xa7\xf5\xb9\xdf\x18\x07\xb1\xe1\xb7\xc4,\xdb:\x95q\x10\xe5\"\x8c\x1f`X\x1c\xe9\xb1\x12\xe1\xbf\x1d\xc4\x12\x87\x05u\xb6%\x19s\xcd\x15\x11\xdc\xcd\xc8\x92\x83e\xcf[\x01\\z{B\xbf\xf0\x05zK\x1d\x96\x9ba\x01\xad\x94\x80\xb9l\x09]\xbcm\x8f\x8b\x82~\xdd\x82\xf7\xe1Rf:\xd7\x02\xc5\xc1\xe0'\xf8\x85\xda\xd1ou}F\x09Z\xcc\xe1x\xdb,\x08\xd3\x89\x0a\xa7\x80\x93q\x9e\xe8\xc2\x04{\xb1\xd86\xc2\xb8\xcfIcMHRW\xfb\xd5\xf1\xa0\xa5\x10]\x10\x14\x87.0\xac\xe0'\xb0\xb9(\x93\x0c9\xac\x8cg\xf1\x85\x90\xa0\x81x\x0b\x17\xc0\x82IC\xa2\xd2\x85\xf90!'\xd6.\xe4-5\xe9t\xa4\xe1\xbe\x0b~\xb8\x16@\x00GQAJ\xbc\x94\xe1\xc3W\x10\xfcf\xb8\x97`\xf7\x9f\xd3b!\xa52\xf9;\x0c\xb9\x8e+\xf5\xa3\x83\x96\xa8\x18K\xd6 \x8e\xc6\x1c\xa2\xa0\xc9\x1cvT\xdc\x95\xba\x9c\xc8Rp\x155\xf4t\x165\x11\xf9\xa6\xa3\xaa\x92\x1c\x06\x076\xa1)\x17\x0a\x91\x85\x88[xK\x06rr\x90?e\xf2\xa8\x8d6\xfcZ\x08\x83\x99/(2\xf7\xb6\x99\xe41\x19\xbfq6m0\xd9q;M|&\xbc\x82A\xd4J\x88\xf3\xd8w\x8f\xc7\x8b\xbc\xd9\x0cI\xe9\x88EB\x06\x00\xdd\x00N=\xb4\xd7\x1d\x07\xb9\x0e\x82\xd5)\x89\xbe\xfe]\xae\x01\xf0>5\xd4P\x06\xf8M!\xc2Y\x92\xf9\xd6mX\xb6\xb1/[\x91Y\xa6\xbaV>t_\x15\xc6_\x0c{-\xb5\x1a\xb4\xdf<v\x10\xc2\x9e15R\xce\x8fP\x0c\xdc\x87\xa7n\xe6\xdf\x98\x1c\x01\xd7\x96\x994JX\x00\xdf\xc2\xf5\xe2\xfdV\xba\xa1\xe9\xf6\xa0\xb1\x02\xfc\xb6\xaa\x18\x8a\xf9}\x9b\xba\\xz\xc7\x864\xe8\xec\xd5YM\xaa\x9f\x1e~\"\xf5\xd3_\xed\x13\xcb\xd0>}h&\xff\x910\xe9oS]\x95rYU\x86\xa4\xe8/\xb9\xb1p\xcb\x02\x0b\xb8<\xd9\xdb\x7f\xd2@\x83%q\x068\x0a\xe5-..\xa5\xe2\x9f\x0a\xb40\xe8(\xd0\xc8\xcdW\x85\x0b!<4u\xa0\xcf\xd7%0xh\xfd \xe6\xae\x0a\x87\xcd\xa6\xb1\xa8`w\x15=\x02#\x90\x1cSq\xd1\x1c\xfe\xe5\x97\x100\x94$\xd2i\xe1\x8eT\xaaN\xa5\xcev\xfc\x0b\xe8\x13yH\x90\x03\x11c\x84e\x05m\x12\x06\xc1N\x89|\xe3\x07\xd2V\xa4\x85\x16*j\x8a\x0f\xa8=n\xd5\xcaqA\xb9\xa5\x09\xc2Y\xca\xd0\xcb5\"\xf6xC\xfc\xec\x0b\xae\x05V\x144zkH2,B\xb52\x0fc\x1e\xcb\x82\xd5\x9d\xe0t\xbf\xc2\x98\x85C\xb9zC;O\xeb\x1f\xe2\x17rN\x89b\x006\xb3\xc1W\x07\xf3z\xdaU\x9b\x11\x0c\xc4!\x1b\x9d\x139\xe4\xbc\x97R~\xac\xf6S\xff\xae\x04m\xa4#l42@\xa3\x08u\xa8/\x99\xb8\x8d+\xafK\x0d\xb52_~\xe2\xa4OV\xe4\x01B\xe9\xb1\x91\x14\x11\xbbU!\xb2|\xbd\xb5\x11\xf2\xf5\x9d{Y\x1a6\xa7\x11\xd2\x88h\x13\xad\\ie\xd7\xa7%\x16\x18]A\xe0Zo\xb1P',\x8fea8\xe8=_\x0e\xc8\xd6\xcf/r\xc2\xfa4\xb0\xb1\x0e.k\xa5\x04gLc\x94\xadM\x94P_\xc1\xd5A\xa5\x0c\xa4;P\xa2f\xda\xbf\xe5\x9d\x06\xb6F\xea\xc1\xdcB\xc5\xed\x1b\xdc$\x0d\xc7N\xdbG\x0b2FK\xdfG\x7f\x83\x9a0\x0f\xa5\xa0\x17X\x85\x1fT\x04\xf5\xb9^R\xc8%GK \xd9j>\xfeN\xf4\x0a.\xcaE\xcd\xe1\xa2^\xdfq\xa1\\)\x98^\xf6\x0b\x16\x9a\xd7\xa4\x1a\x01\x1a\xdd\xa2\x11\xef~\xbb\xa9:\xbf.J\x97\xed\xa3\xa9\xbf{\xd1XQ\xe9^\x93\x8b\x9e\xf0\x1c\x99\x99U\xcc\x80\x19\xc8\x91\xefIG\xd0?{oz\xefS\xccV,g\xf3\xc5\xa6\xd5\x1d\xa2\x11q&\x17\xd0LY\x90)\xa6U\xbbT\xf4oA\x9b\xc2_Y\xaf=\x12\x0b\x93\xb4\x10O\xc6\\\xc1\xd6\xb3\x15\xd7\x10f\xa2\xff\xc0s \x9a\xef\x90J\xf4B\xb8\xb6[g.\xdb\x80\x0f\x10\xe9\x98\xdd\xc2\x1f\xef\\\xde\x0e\xb9\x14D\xfb\x15\xcf\x88\x07O\xa6\x072T\xdf\xaf\xb8\xd4\x8f\xc9\xce\x90\x89 ^\xc8\x95\xa11\x950 $\xee\x91|F\xdf\xf0\x01x\x8a8\xc12\xe4\x02v\x9d\xa1\xff\xa75\x8d\xd9\x02_h\xce\x8a<\xff\xb1)\xc1\x0d\xa3\x1f\xdb-F\x9fH\xc0\x05\xe6a.\x84\xbc\xd0\xed\x9d\xa1\xe8js\xa2\xa5\x1e\x96\x86\xb2\xfd\x81\x9b\xe6\xb1\xccZ\xc6\xfd\x9cGA\xa9\xc5\xc7\xc5U\x8e6\xa1\x9a\xaf\x9c\xae\x03!\xa3=z\xac\xe4&\xf7\xcf\x92\x94[ \xa2N*h\x07\xf2G\xc2\xc7\xf5\xd2 \xef\x12\x02\xa2\x91\x92?\\\x02\xc0\x9crd\x0f\xb9@\xb2\x8dw\x88\x8c\xde\xa1\x84\xe3G5(\x07!\x1b\xc37\x94\xa2@\x85\xde\x19}$wP\x9f\x11\xb4W\xca\xa7E\x8d\x01\xda*\xbfK\xed3[\x10\xe0>\x14\xe7\xae\xe09\xa7\x15u\xdd\xd2\xbef9>\xc5\x83\x0b\xa1\x85\xd2H\xcfH_c\xf4M\xe5\xd7\"l\x8e\xc124\x06\xc0s\x95\xa3l(\x8f\xc1\x15\x09\x88V\xeb'|\xb4\x0a\x9a\xfb\x8d\xfd#\xd2\xf0\x8c\xc0\x1b\x87\xe34\x12W\x92\x05\x00#s\xce\xde\xdd|y\xb1I\xab\xd4\xeb\xab\xb8\xdfA\x8e\x92a\xe4|nA\xf1\xe4m\x05F0M\x10\xad\xce\x8d\xc8W\xc89\xfcS\x035G\xd5\xc6TgJ\x0b\xa8o\xd0\xac\x8a\xc6k\xb6\xef\xf3\xdei\x88]\x84\xc1\xb3PH\x0f\xd3a\xad?\xbb\xb8j(l\x08\xdb\x9f\xf1\xb3\x95\x1c\x1b\xb0\xbe\x10;\xf0\x03\xeei\xf5=TD\xfc\\u!\xcaD1\xea\xea\xbbn\xe5\x1f]\xa5\x9b\xa0\x10\xab\x92m\x7f\x16\x13\x1aP\xb4\xaf\xb0g\x96\x19\x12\x85\x16;\x0c\x15]\xc0\x09@\xfdP{V\xc2\xea(\x17W7T\xfc\xb4\xd0}[\x92T/s\x84\x07\xb1Z\xa0\xc9\x8a.T\xfa\xc1,\xe3X\xb10\x955\xf60\xab\x8d\xde\x1f\x9eJ\xe5\x85*\xbfJ\x0eT\xb5\xed\xd1\xb6d\xc6\xed2\xab\xc6d\x94\x02\x03\xa4\xf7\x06\x8f \xa5\xa2c\x10\x1d\xd0\xd7\x94\xadd\x98\xa4\x89\x9a\x03\xac\x9b\xf5R6\x96\xc2\xe8X\xe2\xfc\xbbH\x04\xe6\xab\xaaL\xf0\x8c\xf0\xb4p\xa5\x04L\xe3\x8dL`?{\x955\xe8\xa8t\xdf\xb3\xa8\xb8\xa4\xb5\xa0\xc2\xd0Q%\xfa1\xb7\xd7,b\x90{\x1a3x\x92\xd79b\x80\xaad\x13\x04\x92\xfaI\x16\xf5j\x00\x09\xb8bo\xdb>h\x19\x16\xd7\x97\x98\xa35S\xda\xe5\xa9\x04ek\x8dY\x8f\xd0\x80\xce\xcfIb\xc8Qxd9\xb7\x8c\x96s3\x14\xfdb\xe8\xa8\x02h\xbd\x92\x12\x0e\xb8\xf2\x8d_\x87\x0d\xe4L\x17Q\xdcF\xe4\x97HW\x07\xc8\xd7\x19<\x8c\x19\"B\x01EeeX\xbc\x04\x88\xce\x1d\xff\xf1\x1c\x061-H\xc3\xb0\x0f\xb6\xa1\x0d}\x0d\x9eNP\x81u\x94\xfa\x1cE\xb7!\xad\x187\xba\xd1\xd8\xdds\x91\xd1\x97S\xa6\xa1'\x17t\xea \xe5\x0d\xdb\xc0\xc6\xd7\xa0\xb3\xcc\xa5i\x7f\x0a\xb5\x0bI\"|oJ\xdb\xfd\xdf\x91\xc2\x8cX\xd2\xc4P\x8bu\xbbM\xb0\x1f\x93\x9d\xcc\x11\x9c3]\xd7p\x04\x94\x03\xf4d\x80]f\x8e\xb6\x8d\xf8\xd4\x16z\xc4\xc20_\xc8-\xcbZ\x83tJ\xbd\x13[\xa0\x04\xde\xe0\x0d\x92\x11\xa8+\xab\x0b\x0d\x0c\xfef\xb0\xcc\x05\xc6\xd5\xc9\xd5\x95K0\xf5\xa6_\xf0\xf6$\xf5\xc4\xd4d5\xb2\x17%\xd6\xc9\xb9\xaa\x8fJ\xf5$\x85\x16\xa7O(\x99\xba\x10&@\x8ap!\xfb\x99\x81p\xda\xda43\xce\xdc?M\xdc\xa8\xd0d#\x0bm>\xad\xb4\xecm\xa7\xc6\x18\x94\xf9\xc2\xe5dN\x10MX\x88u\x91\x87\xd5\xb9H{\x94\xe6f3\xd5\xd7A2\xbc\xd3\x81\x92\xb2Q\"T\x89:E\x04\xa5/\xeb\x1f\x1d\xdb\xcc$A~\x09J\x1b\xee\x80\xda\xd6\x8e\x0a\xd61\xbdl\xf8\x0e\x1bu\xdbC\x01\x8d\xf0\xd1\xed;\x97\xf8\xee_\xdc\xaf\x16|\xf5\xbe\x01\xf5#\x08y\x90Z\xe7\xcc\xe4B\xa9\x96\xb7\x1b\xf7MH\x9a\xbf\xf2R\xd7\xd9\xcb\xbc\x8e\xdc\x8d\xfa\x0cWJ\xe2\xe6Y\xb1\xdc\xeb'\xe2\x96\xc5B\xd9\x13Tr\xf6\xee\xf8U>\xb6\x94\x1e\xab\x030#]x\xdd\x95\x18\xfdM\x8e\xa2\xa9e\xe5F\x17UA\xb7\xb2\x85$[\xc4\xc1-i\xcd\xcb+\xff\x95\xdfL\xfc\x00\xba\x9d!\xbb\xf3\xcf\xbd\x9d\xd3\x83O\xa9\xb5@\x8f+\xda\x0a\x8b\xb8\x8aP\xd7b\x1f`v`!AN}\xcc\xa59h\xbfV\x19\x1a\x89\x92\xa8\xef9\xd9\xb7\xd0\xacW\x17k\xfb\xbd\xa8\x85\x0e\xff\xfcJ\xa4\xd4\xc7\x0e7\xa0\xbe^\xf0\x84{\xe5*;\x84\xa1\xe0w~.3\x08o)\x88;3\xbc\x0d\x0e\xf6-\x9f\xe7}\xef%V^\x90\xa5\xf2\xee\x8b9\x88\xd6\xa8@\xe4\x12:\xfd\x9c\xd7\xfa\xde\xc0R\xcfb\xa1S0\xcaJ]\xb0Qw\x07\x82\x95\xd7\x7fT\x9b\xee0\x1d\xf6\x9b\x1d\xc7?Y\x1fE\x94\xcd\x90N\xa4\xfd\xc8r\xb2\x9f\x09:9\xfa\x81\xb22\xa8\x89g(\x16S\xb3 \x12S\xf3\x1e\xe8\x97\xb7\x04\xdc\x0f\x1a\x12\x05\xfa\x92\x95\xfa\x84c\xae\x88\xb2\x11|Li66O\x06\x0a\xfep\x89\xa9k\xe0\x9b\"T\xa8\x82\xe3\xf6nQ>\x80\x01\x1e]\xcf6\xc5r\x93\xf1\xca\x8c\x01\xcdp:\xd4\x0b\x9d\x09\xcc\xa5\xc2\x94.\x10\xa4\x86\x0cz\xe9.\xbf\x18\x88\xae\x1d\xe8\x0b\x93\x12g\x84kj>\xedNi\"O\xddq/\xb0_\x9e\x14\xaeSd/\x80\xf2,\xeae:\x9a\xad\xef\xe1\x9f>\xa3\xbc\xceP\xbd\xcc\xc9\\p\xd5\xd3\xe9k{\xdd\xe8\xc3\x81LQ V\xb7Q\xb20\xfe\xdd\x92\x97\xfa\x9c\xb3\xc2\xb13\xec/\xe5^\xb4\x11\x9e\x09E\x86\\e\xf8\xa3N\xb6\x07\xd60<Jl\x8c$\x06\xd1\x0a\xc4\xff\x84\xc5O'cj\x9dG\xd7|\xb7\xbdL\x91L\x18\x89R\xa3 \x9c\xb6\x85@+\x8f\x7fv\xb1 \x9f\xd4lJI\xb5\x12\x14\xa2\x17\xb7\xc4\xb3=\xa2\xea\xbe\xb3Mb2-\x8e\x9f5\x96\x10\x03\x02\xb6\xc7\xad\xed\xe0\xf4c\"\x9d\xbb\x88\xfdO\xa5\xe3\xb4\x91\x10\xddxs`\xd6\x197\x87\x9a}\xd6y\xcf\xc8\xc3\x97\x09y\xf8\x09\xae<\xf56\x90\xacN\x184\xa2r\x0c\x90a\xd6\xca\xb5\xba^\xc3\x1f\xablqG\xd8\xc3\x91\x8eb\xa5J\x90\xd5-\xa92\xdc\xe4\xb1\x91\xb4\x14j\x92J\x85\x91\xd3\xbc\x10\x12\xfc\xc1x\x08\x7f\x87y\xb1\x86\xb5\x19\xab\x0fe3\xad\xbf\xd2\x9c\xd3;\x8f\xca\x93\x87q\xbb\xa69\x01\x7f\xca\x10\x16&Q\xda\xc5\x80\xe4\x00\x9f\xa4\x9e\xb3\xe6 \xbc\xd5\xb7\xf0p\xaf/l\x94\xbd\x1eX\x16\xb911J\xb0\x0a\xa6 \"\x8f~\x04\x84\xf8V=\x0d\xac/E\x7f\xdc3p\x14\x948zX\xb24d\xf4\x88\xa0y\x99\x8d\xf2V\xb1\xff\x0am\xf9\x81A!\xf1\xcf\xc7\xda\x16D@\xd4\xae\x8c\xe3Y\xee\x03K\xa0\x99\xb5wx\x95!\xb4\x08S\xa3\xbe\x87\x0cr\xa2\xec\x99\xb8\xe5\xd5\xf3*\xa8Pa\xee\x9b<\x0e};m\x86BQ\xa2w\x96\xb4\x9d\xb0\xbd\x8f\x88L\xdf\xadd\xe1\xba\x1bp\x87\xa3\x87\x99\xb6\x8d\xd3\xbf\xd6\x1e\x95\x10\x06\x0a\x8f\xb5\xf7c>\x0f5\x80bC\x00\x0daam\x84V\x84\xf8r\xe8d\xed\xd6\x88!\xd9\xe2\xffhJ\x0dB\x98w\xdc|G\x0bZv\xed\xaedR\x91\x97U\x1e\xea\xc2<o\x96\x17\x91pp\x13C\xd2Mho\x81e4\xa9\xee\xd6UF\x94\x11F\x840\x96\xa1\xb5h^,\xe4\xdf[\xa0\xd6\xfc\x88\xf3\xeb\x84\xaf\xdb#\xf4\xf5.\x99\xaaS8\xcd\xe9\xb9\xf8\xa3\xcdv\xf1\x8dkt\xa2T\xfe\xd4\xf8O\xffl\xdc\x83(\x12\x0fB\xf7\x1fw{\x18'\x11\x01Oay\xf2\x00\x8d\xff\x9b \xa71\x93\x82\xbb\xb3<\xa0\xdd\xed\xfdh\x7f\xeb\xaew\xc8\xc0\x98(:7\xa6q\"\x88\x9eSVf+\xf9\x1fr\xceP\xa4\xb7\xa5*+\xa3\xe6\x84l\x10\xa6~^\x05N\xbde:\xee\x94&\xc9R\xed\xfe\x11\xf3\xcf\x1c\x05\xc4T8\xb9\xfb\x9d\x8f];Ro\xf4\xc9\xb9\xce\x05\x07\x0f\xa4\x85\xb5_)u\xee4\xf7\xcf\xb2\x82\xe4\xee\x812\xf6\xbcrb\xf3}AaMC\xb24\x00\xb4\x88\xff4:\x16\x0c\xc1\xb1`a^\"O\xbd\xd8+\xcc\x9d#F\x8f\xf0Nu\x09\x15\x9c\x01^aI\xfa\x1b\xd0\xd1\x8e0{\xcb\xccVC\x9aU\xab?\x89@\xf6d\x9d\xd1C6\x8d\xe3\xc6\x07\xb7\x14\x8f\xe2\x9a\xe7\xbe\x12$K\xeb\xf29(\xe4{\x7f=\xe2M\xfeVr\x05n\xe6\xc1\x0a\x0c\x19\xfc3.\xee\xed\xc3\xca\x90-\xd4\x87\x15\x8d\x92)\xb4w\xfc\xd1\xd3P(_\xf1\x02\xf4\x9f\xa6\xd2\xe0\xa0\x0a\xa1\x0a\xedo`\xd6\x94\xf6{\x0b\xbb'\xd6\x8f<\xefr\x94\x07\x832\xe5g\x01^\x0eq\x09wHO@q\xf9\x90\x9d*\xa0\xcd2\x9ed\x1b\xe7\xfa_\x95\xffZ\xd9\xfb\x1c!?\x7f\x01\x97x\x01\x1f\xedsyd}\xb9_\xa80\xadZg]\x16\xac\x04K\xb8\xb2\xc0\x00KZ\xa9\xbf#\xcf\x90\xd5q$\xc5g\xd1I\xee\x19i\xd1k\x19\x8f(\xe5\x96\x7f\xa8a\xd8;:\x8e3Y\x06\x7f\xbe\x06\xea/\x88N\xf0\"\xd0y\x86v2\xe6\x0d\xd4\xdd\xb7\x0b|\xa9\xf4-A$KJ\xfa\x7f\xb1 \xf5P\xbd\xb1-\x12\x18\xc2\xb4\xb1K\xb7\xf2}\x18:\xd6\xf3k\xc6\xbeK\x83\xd8\xcd\xb7\x88\x80\xc7\x99\x1c\xec(\xb3 2\x8c\xf6\xd9\xeb\x97\xfe\x8d\xfc\xe8m\x1a\xc0\xa71\xd9\xa2\xc0\xfd\xcdB9'\xbc\x0eE\x9en\xee&\x03\xa1\xbcr\x7f\"\x08(\x17\x85\xe4S\x86\xbbT9\xa6\x97\x91)\xb1\xfa\xed\x979\xe1\xb3\x0a9\xf7\xae\xce\x82BqP\xcfH\x93I\xa0L-jm\xfa\xa4\x1c\xa7B\xbd\x86\xf9\xb6\xb4e\xa3w\xbe\xe5]Do\x8f?u!M\x96\xcd\x0d\x86In\x98\xab\xea\x04\x15\x9epZ\xde\xfb\xa9m2\x82\xbe5\xab0\x91\xb7\xa46\xa24\xb4\xf1\xdd\xf7\xcf\x13S\xdbh\xc5.\x12\xcc>\xee \xde\xc9\x1a\x83\x9dr\x82\x030i(X-c\x14\xc4\x0b\xe0\x7f\\\xf8\xcd\xc5\xab\xb7/ \x85\x0a\xa5\xf7q~L\x85\xf6\xff\x1aK\x98\xed\xc2\xf9\xe2n\xe0\xb2;\xe9\x18\xa3\x8d\x87_:}\x8b\xf5: \x17|\xe8\xd2\xf2l\x8b,9\xe2\x86\x8a\xf5\x14\xa9^\xd8Q\xb8\xc8\x16@\xa4i\xd6O\xe0g\xf7{\xec\xb1\"\xf1i(\x95\xe8rT\x11\xa3=Rq\xa4\x0c\x00t\x9f\xbe!9\xe0y\x9b\xe9" +
+	"|\xfd\xc2w\x0de\xa3k\"\x1b\x09\x97\"y0\xf4\xa9b\xc0\x1c:\xef \x0f\x0e\x8a\x80\xc6^\xcb\\r\xc8t>v\xd4~\xf1\xefH\x7f\x13\xfe\x00\xa5\xf7\x14\x93\xebU\xb0H\x97\x92\x87\xb4\xeb\x90#\xd3x\xa4\xf1\x14l\x12\x15\xfba\x8e`H\x87~\xa3\xd1\xda~\x80\x9b(w\x90\xa4\xb0\xb9(\x1b\xdd\xef\xc2\x18\xfbh\x91\x94\xb4.#Kk\xaa6\xa9\xf7\xf3\x07\xdb\x04,\x98/\xac\x80f\xbc\xa2\xf3\x06\x17\xbc\x14\xc4Y\xb9\xe0\xc5\xaa\x04e\x14\x908\xe7\x12\xf2\x04\x8a\x86\xf66J!c\x0e\x1b\xc4\xfb\x9e\xe8I\x1fiR:\x16\x06Z\xe2\xdez\x08\xbaSJ\x8d\xd0\xdf\x9e\xefU;\xe5\xc7!*e\xd8/a\x09\x08\xce\x11|iN\xfa\x85\x95C\x90J\x8d\xa8f\xe8\x9e\xc6\xa5\xf6\xe7\xa0}S4\xfe\x19#\xfe\x8c]l>R\xf8S\xe3\xa5\xd2\xfe\x08\xd0>\xdf\x94\x89\x0c\xaa\xbc\x0d\xd8\x0bw.\x9c\xfd\xd0\xdb,\x06\x0d\x82\xfd\xb3\xe3J\xef\xc9\x1a9b\x06&m\xc0aH\xaa\xa2j\xbe\xe5\xd5\xf1i\xdf\x18p\xf7\xe4\xf1\xf08J\x1b\xef\x98\x86S\xcd\xcaJ\x0a\xbf4n\x7f\x10\x8e6fOg\xbb*\x17\xb8WO\xb9NM/%C\x8aR\x89\xf6!s{\xd0#[\xfb\x9e\x8cX\xc6\x03\x00\xf1\x15\xd6\x0e\x99\xea\x8fiA\xee\x1b)\xc9M\xf5\x02+\xb5\xe4m{*\x0d@\xb5T\x15\xeexF\x8az\xd6\xe7q\xc7,\xf0DU\x14\x05i\x91L\\\xb0*\xc92E\xb7\xa2Fv\xa7$;L\x940\xc68\xd2\xff|\x0e}\xc1\x07|4\xb9z\x82AI\x12y\x8d\xcai-Y\x0d\xc7\xf3\xdeg%\x7f,\xfe'\x8f\xcd\xcab\xba\x1ax+\xea\xfc\x8c\xa1)\x85}B\xfb\x1f;H\xdc\x9eH\xbd\xa8\x9e\xed\xccT\xae\x7f0\xc6\x13\x05F\xa7\xb8\xd9\xb9\xaa6\x04\xcd\xfeUMY\xfe\xd8\x7f\x8b6\xd3\xe8\xf2g\x1d\xfbv\x03\x0b\xbeEO\xf2\xa8C:!\xf9\xa8=\xc9\xd8\xcb\x84\xc4\xfai\xa6L\x13\x94\xc5\xa2L\xd1\xee\x13N\xec\x09\xd3\xb7$\xfa\xa2\xffO\xeab\x9e|l\x9d\x16\xf7\x96\x09\xb7\x04\x11\xa7_H!\x1bf[\x84[\xddT\xb0-.,_\x89\xc4Axs\xd7\x87\xa6K\x18\xeb\"\xfc|'\x8f\xc6\x93\xd3]\xd9\x87b%W\xc0\xa7P\x81]Q]E\xd8r\xee\xf3\xac\xfa\x85\x8fiMnE\xd0\x13\xfe\xde\xac\x04\xe7\xae\xf2\xe06\xc1\xe4\x81B~\xab\xd6P\xbbNy\xd0\xc8\x94\xf7\x0br\xa2\xc7\xbc4U\xd8\x15\x8f\xaer:T\x91&\xfe\x1c\xaa\x94\x91\xb5QiH&8\xe0\xdf@%k\x8d\x00\x95)N/i\x13\x8c\xa5\x99\xd7\xac\xf8\xec\xe4\xec\xe3\x12\xf2\xf6\xd5\x91\xdc\xa7\x88\x94\xd7$\xb3\xa2=\xc94\xe6N\xfe\x09\xfb\xa5PO\xe9\xbb\x89\xd3O\xc1\x90\x1e)\xa0;\x9e\x89-z\x87\x1e\x8e\xd9\x13(G\x80\x1e\x88\x80 \xa4a\xa4\xd9\xa0J\x03q;\xe4P\xbexH<&\xd0\xbay\xc86\xfe?\x0dLHz\x95h\x86d\xd9~cLh\"\xde\x11\xa5\xcbDr\x94XN\xb5\x8b\xdb\xf0\x7f\x8b\xdb\x9fO\xe6\x0f\x8a\x8d!\x8b\x9f\xd5\xe8\x89\xe9\xffNb\xff\x9ft$<=\xc8\xa0S\xd7+q\xc3o\xa3\xdf\xbb\xcc\xfaA\xa4\x16x\x93D;H\xa8\x0e,\x04b\xb2\xfa\x0f\x9c>O\x0f\xd3\x0fh-\xfa\xdaw\x92\x0bX$\x18\xe5\xd8P\x12\x9bH\x04\x9b\xf8`\x94\x9d\xa5V\x96\xcc\xae&!\xeeIbQe\x00\xdf\x17$\x9d\xc6X\xf5\x0bAUU\x00\x06\xddje\x86n\xa9\x0c\x0avxN\xf5\x86\xeea\x95\xd0\xf05>\xe9\x8f\xdc\x95,\xa4\xfbzLR)\x7f\xcf\xec\x12\x9bJ\x8b)\xef\xa1\x98r3\xac_\x9aq\xbd\xfa\x7fFL\xf9\xcaN\xb7\xf7\x9eH\xf6\x1c\xb8fp\xe5\xad\x13^\x90\xae\xef^\x98\xd8kJT\xd6\xf0\xf1\xeb\xe7f\x8a\x84%$\xc2\x0b\xecd\xc2o>\xbfzp(}w<\x0eB\xf4\x0b\x9a)\xee\x15\xb2\x98\x84\xb5]\x0f\x00\x0d\x9aPF\xed6\xe6s\xeb\xdfnN`~\xd4\xa1\x1e\xafU\x99\xb8\x9c\x82%\x0e\xee\x93\xb9\x98\xd5\x92\xf9\x82\xf2\xb3,\xdc\x13\x8e{\x86RK\x83\xfa\xb3\x0f`\x9b\xa9\x92\x17i\xce\x11+A!\x9b\x04\x0f\xca\xaf\xaa?\x83ZoU\xdf\xcb\x81\x01\xc2\x87\x10\xe3\xc8\x9cL\xe4\x08$\x1d\xdf[hLW\xef\xe5\xc6\xca\xeb\xa8\xdf\xb9\x09\xf9\x81L\x90\xd220\x1f4\x9fj\xce\xcdHuln\x83\x94!\x82\xff\x82\x81>^\xc9\xec\xca\xa0H\xa0d3\xb1N&\xf2\xddDQBR\xed\xb9\x99\xc1/\xc6,\xc1\x86\x19A\xfe\x96\xd0\xa9T\xac\xdb\xfa@\xd6\xa5E\xe4\x09SU\x07\xc1+\x84wM\x87\xf5\xbe\x11\x01\xcaS\xfc;\xcdC!\xbd\x17\xc9a\xabg\xe0mp5\x10u\x18 \x97N\x04x\xf8:C\x99V\xda\xb7\x81\x1a\xd9\x8dv[I\xe8\x9a\xf2\xa3\x8c\xf7Q\xa2\x1d\xd2!\xa9= DQ\x0b\x97\xae\xe0\xde\x94K\x8fh\xc6\xb9\xe1\x0e\xa0\x85G\xbfH+\x16\xe0\xbb\xba\x8d{*}\xd3\x99\xa6\xc25\xaf\x9b\x82&B\xfbg\x0e\xe9A5\x17\xea\xbbr\x11\x12-\xd2\x10\xd6r\x8e\xb1{\xbd\xb6\x9f\xa3\xa2\x0d\xc1\xa7\x0a.YQayM\xccW?\x989\xa5r\xeb\xd6\xf5\x00\xaa\xdd\xd4p\x86II\xd0\x9c\x7f\xb3l1\xf6\xf6\"U^\xb1x\xcc\xf4\x12\xf5W\\;\x14f#\xb1\xf1J(\xcf!\x05-\xb4Z\x11\xf0\x9f*\"\x96\xe3\x8av\x88&\xba\x1b\xd4\xaf\x10\xeamY\x86E\xa8{.\x9a\x0b6\x82\xbb\xc3\xf1\x0d_4\xb8R\x90\xf8\xfb\xd7%\xe9\xbdOg_\xc5\xb0\xd0\x1d\x02` ,\x0d\x1b\xa6\x9d\x0f\xa0\xd3%\xa1q\xd7\xfd3\x04\xee\x06\xb6\x03\xfc\xba\xc0{l\xc4\xc3\xbe\x96\xe5\x1e\xf9$\xeb'\x1c \x1c\xbaG\x81\x0a1\xf02\x05\xff\xe8\x1d_5\xf3\xf6z0{p\x1d\xcb\xfagdz\xae\xd8\xcf\x1c\xa0o\xef\xf7\xc7\xd8S\x19$\xac\xc3\xa2\x1dN\x9c\x19\x88\xa5\xd4\x8a\xe7\x94*Y\x04\x986\xcc\x17\x88\x0a*\xe8\xd6^Z\xed\x8f\xe0\x1b {\x9e\x12\xe4\xc8P\xb7e\x09\xeaX\xe2+\x0c\xc5)\xfd\x1dDb\x1f\xea\xae\xf8\x87l\xd5=\x0c\x9er(!\xa9\xb7\xd8d\xc5PM\xd8q\xcap\xe0&\xd4\x08)l\xaa\xc9\xd6\xc7\xc2\xd7\x8fVg\x98^\xc8\xf8v\x19A\x81\xa2U\xa1\xb5\xce\xa5\xe9\xa8\x9a\x14\xfe\x16\xe2\xf6\x8d\x14\x97\x10\xa9\xe8\xdb$|\xa94%\xcd\xe7\xdc\xfb\x0d\x82&\xcb\x8eyo\xef\xc4>\xcb\x89\x84x`\x9d\x07\xdf>\\\x9d)\xfa\xc4%-\xd7\x9c\x04'\xdd\xb5;\xb6t\x8e\x91\x94\xe5^pE\x9d\x1a\x93=\xc0.n&\xec\x82\xe7\x12J\xc3\xf0\xdab\xfd\x03#m\x85\xe5$\xbf|\xb9\xd5\x0a\xb5\x16^\xc8UL&7rG\x85\x07\xcf\xd4\xe5\x15\xe2\xeb\xda[(\xfd\xd3S\x0f-n\xcd\xf0\x0d\x84\xb4\xe7ME\xe7\xda\x8dF\x02\xf8\x08iP\x1f\x0bpAF\x87d\x86\x13~\x8e\xd9N\xfe{'\x81\xec/\xcd\x14\xb9\xf4\x95Dx\xab\x1f\xe1\xd9O\xb5f\xc8\xd0H\x12\xe2\"!\xec\xcaP\xaa\xe1\xaf\x88\xdf\x9e z\x18IM]7\xb4+v\xca\xd0\x8f\x8e\x8a\x0dd#\xbc\xfc\xe6Z\xe7\x9e5b\xf5\xfe\xdc*\xe0\xc8\xca\x1d\"\x97A~z\xbd\x17q\x964SG\x04\x0c$\xa4%\xef\xa1\x8f\x9a\xa2\x158,\xc5\xf8\xf0\xa6\x14\xe1\x93\xd8\xa7\xac\x0a\x04K\x15'E~&)k\xbf\xfa\xd9_\x9ao\xa3Fg\xfc\x06B\xa2\xad\xa16a3\x19F\xca\xda~|O\xb5\x8fGUzN\x0f\x0eQW\xe8\xbd\xe7\xbd\xae\xb2\xcb\x18paxz\xe3\xbc4i\x0aq\xe3\xee\x81\xf1\x9ac\xdb\xb4\x11\xb2\xe0\xd4\xc5\xcc\xe7\xda\xb4/\xdd\x83S?I\x9a\xe4ho\xd6\x00a\xc7-\xe7\x1b\xb1\xf7\xd7k.\x1e\xcdL\x9b\x99\xdc\x17\xf2\x9b\xcc\xfb\xfb1\xbeF\x88G\xa0\xd5g\xa5\xa1\xf6\xb7R?\x7f\x81\x8bX\xc2\xcfQ\x8e\xab\x91\xa1\xcc\x09\xac\x82\x18^\xb1o\xe9Qf\xbf\xe1\x0blNM\x10\xc8\x8b+ml\xd2\xad\x96\xa8$\xc8\xdd\x80\xfd*K\x8d\x91r\xdd\x0e#X\x1b\x80jD\xf1\x02/Ys'\xf4\xb827e\xa5I\xe5\xb0\xa9\xd7\xb8,\x85P\xe9\x17\x08\xc7\x1e(\x16\x0c\x9b:\xbb8\xa5\xef\x1f\x86\x19\x86\x7f\x9a/\x92\x9c!m@\x14{\x9f\xa1$\x97\x9e\xf9g}\x8f\xe4!\x99\xce\xb5\xa8W\xb8\xad\xc0\xa1Y4'zS\xed3\x85\xdf\x15\xf5\xb2\xff\xb6\x82B/\x048?x\xad0\xe6s\xed\xd4+\x84\xf8\xb0\xbb_\x99J\x8a\xe0\xdf\xb4\x095\xb9\xbb3GA\xc3S\xf2!R\xcf\x8am\xdf\"\xab\xbb\xd4\xf4\x01O2\x09\xc1+\xa1Dv\x9dj\xe2\xa4:\xb3\x91\x11t\x8f\x98lC`l\xa6^\x8cSq\xee\xa2\xbd\xdd\x9d\xc6\xe0\xf1\x0e\x0c\xf7@j|\xcc\xf3Y\xf5\x01\xfcg\x95\xb0K\xa6\xc7\xc7\xad\x10u\x83\xbf(\x83\x90\xfc\x9c\x0e\x88\xaeGC\xe6\x84G\xfb'A\x0fP\x07\xd2\xf9VR*\xa4\xbb\x84\xa8\x80d\xd2/E\xe4\x0cQ\xd8\x02ZRQ\xab\xbd\x0dT\xdfn\x83\xb3\xbfw\xcf?\x8d\xab\xd3\xdf\xfbY\x85!\x97\x8d\xc2\xa2-:\xbd\xe4\xa8y\xab\xca\x89V\xfd\x0a\xe0t@\xd9\x95g\xbe\xec\x08n>&k\xdb?{\xc7\xa0\x8e\xbad\xb9\x99dc\x98\xfa;\xac\x11X\x1e!\xd0\xa7\xbf%\xd6\xd3\xcd\x9e\xdd4l\xb6\xb0L\xf9\x87M\xb3\xcco=\x05\xeb\x91?R\x0bi*4\xaa$\x91*r\x118\xf8\x86T\x08\xebz\x9a\x07\xb9\xc6k\x09\xb7\xb12\x11\xfcEK\xbd\xe7p?p\x0c \x8c\x92\xa1\x94\x1b\xfd\x9d\x0bt\xac\xceSFjP$\xfc\xca\xd9\xcfX?\xe7'\xdbY\xc18\xebY\xec\xcc\xa2\xefc|\xa5Ms?\xc4!7304\xec\x1b\xceB\xd1w\xea\xaf\xad\x99\xe2=\x81\x81.\xcc\x98\xff\xd3<\xaa7$\xfd=\xeaT\xdd\xd83|z\xcf\x84F\xe1\xb0\x1e\x9c\xf1\xa2\x91g\xcf\xe1\x1eA\x08\xa2\xf79\xed\x8b\xe7T\xd6O\x01\xa2\xb3Y\x0c@N\xcc#\xd9\x84\xe1&X\xf3\xe4\xc8\xf9*\x09=M\xa1+\x07\"@\xb0\x91\xaf\x87\x8f\x83\xber\x9f\x8eW\xb74\x9fY\xd5dc\x19\x04\xcb\x83\xf8\x8d\xa9O\xbd\xf3=\xd0\xf1\x15{|\xc8E\x7fp\x1f\x14\x81H\x87.\xfdK\x01C$7\x03\xecf\xa1;~0_\xa8\x91\x13<a\x03A5\xb1\xbe\x08\x07YQ\xc2\xd8\x96\xa3\x93(\xb6H\xbe\xa0\x82\xbb\xf6\x00\xa4\xd6\x10X\xa2H\xb2C\x8et\xae\xa6\xf2\x97\x90\xa6\xde@\x91\xaa\x88\x16\xbeM\x8d\xe6\xc5_\xbc\xd2\x0c\xdb\xe5\x90`\x03\xf7\xe0\xd4Oo\x80\x94\\\xff\xec\x1f\xee\xc7V'\x8b\x02n\x80->\xb9\x18L\xc1\xb7\xe5\xa8\xab\x9f\xb1\x02E\xa2\x16\xb0\xbb=\xb9h\xef\xea7\xcbL\xc8\x1eO\x99\xa1O\xab\xdb\x18\x16N\x1a\xf0\xcb\xcb\x14w\x9c?\x80'0qC\x97\xff\x0c9\x84/I\x946\x84n\xb3tj\x8b\x80\xb7\x98<\x8eR_\xf0\x9f\xab\x03*$Ex\xac\x10z\x96(\xc6\xc3&\xff\x0f[s\x88\xbd\xeb\x1f\xb6\xbc\xf5\xb3)\xe8\xe9t\xaf/\xa7a\xda\xeb.\xa9\xab\xef\x11\xd5\xb1\x10\xd2\xceZ\x13&\xe9\x14\xcd\x1d\x7f=\xcc\xe6\xe8C\x1f\x7f\xabU\xaa\xac\xed_\xdam\x16>\xeb\xe0,s\xb0\xdd\xaaiE\xc6\xa9\x7f\xc5\x90\x0f\x90\xec={\x85d\xd5\xd3\xc2\x05R6\xc2\x12-\x0b\xa5\xe5!z\xb4\x1709\x9f\xd8\x85\x9cM\\\xfc\x1f\x921\xe1@\xba\xc5\xd2\xf2\x84E\"\xc1\x88\xe4\x1373\x18\x00\x7f\xd99\x1f\xd7\xd9\xd6e\xc1sJ\xc5\xd6\xcdU\x86\xdcn\xbf\xc0\x0f\x99\xc8<v\x91\x19e\xd3\xd18L\x92daX.%\xfc\xb8\xf0,\xc5\xb9\xfcW\x92>W\x7f\x83;v \x1d7\xee\x14{V0=|\x81\xbf\x02\xcca\xc4'\x110Y\xa8\x13\xee\xe3B\x9de\x8a@\xec\x19HL\xab@j\x84\xe6\x93\x8c\xd9\x82d\xd5\xe8pf\xa8\xf1\xeeh\xf3\x8c3\xba\xfe\x0e\xcb\xc0\x02\xe5\xf7\xd2NZ\xaaNF\xf7\x82\xf5P\x81\xf3T@\x82\xab\x0cI^iVd\xcc\xde\xa4\x12\xd2\x1d\xd2X\xa2-w\xd0[1\x10\xc6\xb1\xcc\xe9\xc2\x9e\xfb \x8d\x85%]D\xd8\xfeEH\xd3\xe9\xec \xa4uE\x14Pr\xc27 c\x84\x98\x95\x0b\xd5\xc44q\xb32\xb0\x83*Z&\x1b\x19\x7fQ)\x8b\xa8\xb5W&\x0a8(j\xfd\xfese^w\x8d\x19a_ t\x18\x04\xc3\x1e}\xc0 .\xd1v\x8c@}\xb1\xc6r\x8f\x0fZ\xc2}\x8b\x04\xb8\x81\xa5\x82{\xc1\xc5\xee\x04\xb1\x93\\Q\x1e\xa1\xa0\xc6Wd]\xc1qT\\\x9e\xd4\xb4\x07\x8d\xea\xdc38\xac\xdey?\xdc\x84+L\x97J\xd2 \x86\xc5U\x15[\x85\xc6\xc5\x8b\x8d\xb7\xde|\xbat\xe9)\xf3\x11\xa1\xf1\xa8\x01\xbc\xf2s\x1f\xb1\xf4\x9aG\xbc\xf5N\x8a\xa3\xf7\xe9\x08\xca\x05\x864D\xbe\x91\xf9\x97{\xbcV\xac\xc8\x0e\x90\x11\x8c\xe1\x09$1h\xde\xdb\x9c\xc3x\x89[w\xf97cYy*EJ\xac\x8d\x14\xfe$\x0dV\x00\xa5\xe1n\x190\x10\x9d\xe2\xeb\xc7zi\xc9\x11\xa6\xc7\xb2\x15\xbb\x183!Bg\xe6\x1b\xddQ\x88S\xd5p\xdb\x92R4\x80B\xfd)\xeay\x13\xc6a`s\x7f\x09\x83$Go8\x84<\xcfA\xff\xc3C\\\x04\x1d(*\x94l\xfdz\x0b\xd0\xf5(\xeb{\xff\xb4\xdd{\xfeJ\x99\x00AG\xc4\xe2\x8fJ\xf0\x13*\x1a\x86OaE4\x09\xb9/E\x05\xffE\xea\xf2\x03\x15@\x032)\xdf\x96\xf2\x88\xd7\xe8\x00\x9a\x9d\xca\x0fU\xd2\xf9\x9d\xf2\xc8\x1a\xe7\xa9W\xd1\x0ciH^M\xd2\x9f\x1esR\xb1Y\xde\x88\x7fP_\xbbo\x9ak\x17j\xaa\xf0\xee\xc2[\x8bK\xe3\x04\x82&\xa2\x93)\"\xaf\x88s-\x9e\xcc\x95\xad\xea\x97\xae\xab?\x8b\x07$\"k\x19\x98\"4\\\xbc\x14\xf0!)\xddJ\xb49\x09j&;\x8d\xfe\xba6\xaa\xc3\xef\x13X\xbf\xd5\x13\xf1\xd13\xd4\xea\x8d\xdf\x90p\x9fym\xaerd\x89q\xf0\xfd+\x06v\xfd\x1d\xf0\xcc\xbc\x1b\x83\x10\xc0\xa2\x99(\xace0\x10\x84\x19\x93\xd3m\x80\xd5\x12\x16\x8e\x09\xde\x10\xa2~\xd0\x10@\xb8/\xfaC\x0eD\xbf\xcb\x87\x16\xd6\xba\x8e-h\x12\x0a\x86\xe74\xba'\x12\xdaF7\xebW\xf2\xd2\xa9\xffmQ\xe2HT\x12\xa5\xdf\xa6\x88\x9a\xe0j4)\xd1\xdf\x87Oq|}pJh'\xb8)?1\x97X\xbb\xdb1\x1ag*\xa4f$4\xb3t\x1b\xce\x8ct\x0bo\xbb\xf6\x05\\w;\xf5\xcf,qf!\xf4\xce\xe90A\xbf\x82\x84\xf8\xaf\xf8|\xa8\x06\x01W\x8a\xc0V\xb7l[|\x8f_g\x94\xa8>=\x0f\xa7\xf4\xfc\xc0\x80U\xce\xbckd\x8d\xb57\xfe\xa3\xeb\xc6\xf7\xe3\x14\x8c5\x11\xa2m\x0e\xa7d\x04B\x13\xe1\xa0)\xc8}\x1b\xf1\xd6Ct\xac\xda\x88I\x0d\xc5\x9f\x81\xb7z)\xa2\x06\x01\x05D\xe9\xad\xffvn,\xc6\x9a\xceX&\xf6O\x8a\xf7\x92H\xbe\xae[\x1dPc:7D1\xd9\xbb\xe0\xf0T\xc1\xc7\xc8<`)\x00\x0b1i\x9a\xa9Q`!\xc8A\x19\x87\xd5\x027\xa6\x0a\xf1*Ep\xc0\xfe\xfa\x88\xda\xef\xae2\x84\xa6Q\x04J\x89_\x9a\xee\xef\x00\xc5\xd6HN\x82\xf7(\"4\xe2\xd6\xc1w`\xa0\xe1\xe1)\x06\xa0\x08\x1d\xbd\x13w\x8a\x12v?\xb9e\xa4\xa9\xac\xfbHe\x85\x097\xab\xd7\xcd_\xff\xc3t\xd6m\xbdi\xce\xb3\xd8\xa8\xa1\xf0\x8f\xe3\xf4\x05N6\xd4\x0b\xe9\x16\xf1Je\xe01\x05!U\x14Q\x83z\xd4[y?\x94\xfa\xceda\xa8\xa7e\x87\xcas\xf8e\xab7\xa0rF\xdb\x130D\xae\x9f\xbd\x97{\xa4\x88\xc6#\x94\xcdO_fI\xcb\x82[X^a\x89\xd7\x99\xa7fu)\xc1\x9e\xaefv\xb2\xba\xdd%\x95\xae2k\x09\x08\xd3\\K2\xb9\x83\xb2d\xf2\x01G\xa7n\x80\x104o@6_\xcb\xd8\xdc(3\xf8\xaf\x09Qk4\xa2\xfb\x86\x1b\xa8\x88\xf0H\xb5\x8b\x0b\xec\xbaq\xfewd\x8c?\xe0.P\xff\x84\x0a`Pw0\xe1\x9dk\x92\xc4J\x14\xee\xcd\x00\xc2\xdaW\xc0\xae\xfb\xf9\xa9q?B\xc9\xd3\x12\xd5\xe4`\xaa\xef@:\xf7\x0a(\xe8\xcc\x80x\xb6\x1bY(.\x8d\x153\x17\x13g\x81\xbf\x9c9\\\xa4\xf2\x0c\x85\xaav\xf2\x91\xaaq\\\xa0\x9bE6\\\x90xe\x06\xa4\x86\\\xc0\xbe\x9d\xf8:\x04\xdb[\xa8@W\xfd\xdc[]\xf1{N\x0d\x14w\x03\x82\xd4" +
+	")7}x\x90\x14\xc9\x13AZ\x07\xf7\x80\xbeu\x13[X!\x0d\x84S7\x9d\x9fq\x872\xbdY\xc9p3\xb4\xe10N\\\xd1\x9cRv\xbb\xafU\xa9y**\xf7\x19\xdc_\xc8,\xab\x0f\x98\x93\xd4\xf9\x1b\xc7\xdb\xbf \xa4\xc6\xab\xbaZ\xf7\x8d\xf4f\xb4\xd1\x14/.\xbe\xbb\xcd\xa49\xbfd\x7f\x0d\x05\xbe\x02Jh\xd6\xb0XX9\x9b\xb3\x0eA\xd3\xce\x97o\xa0\xf6\x9bvC\xcb\x95]\x86\xac\xb0\xb0X\xf8\x02\x16\x9f\x1b\xc8\x10W\xd2\x9f_}\xd7U\x85\xa0\xccWf0|\x9b!\xb6=x\x0f\xd8\xcd\xcd!\xae\xd4\x15\x14\xba8jW\xf8\xee'\xa2<\xdf[1\xda\"\xa5\xf5U%~:w\xc3\xdag\x84!\xf4*b\x1e\xe8\x1d\xa9\x90\xed\x09#\x9f\xdf\xf3U\x18\xb6\xbc\xcfFco?\x1afQ\xdd\x8c\x9c\xd7w~\x0bdt\xbdq\x12\xc5b3\xf7\xb4\xb3s\x13G\x98\x15lF\x15\x14\x17\xa8\xf8\xa1\x90\x9f\x01(x\xc7U\x06\x85\x17\xa8Gn\xe0t\x18\xaa\x0bAn\xf6\xed\xf3\"\xea7\x94\xe4\xfb\xf7\x8a\xa6\xf9\x97Q3\xa7\xfc}\x9aW\x07q\xa2yDn\xf6\x1f\xf2r\xf1\xf5-\x7f\xf3t\x04c\x80\xec\xb7\xb9\x0d\xab\x08\xd3\xbc0J\x1c\xfa\xae\x8b\xa0\xb2\xbb\xa2=\x95x`\x06\xa4-\xad\xb9C\x16]T\x9cc\x107\xe1\xbc\xb0\xba\"\xb4\xca\x98-\x00\xe1\xc0DH\x08\x7f\xd23\xf0\xd0\x9b\xb8\xf3\x97Y+\xdbal\xa1\x8f?\xb5\xc0\x9d\xd7V\x1f-\xfd\xcc8b-t\x93`\x09\xd1\xfb\xdc\x07x\xa9\x0e\xce\x875\x8d\xe4~\xc3\x12\xdb\xd7Q\x81mlo\xee\xd4a\x8aB\xe0nQv\xb0\xfe\x8a\x8f1\xda@\x8b;\xeb\x7fx\xc3j\xcf*\x8aU:\x01W^\x00F\xd7\xa69\xc7\x0b\x1a\xc9\xbcA*\xd6\xc7& \xdbk/\xc8X\x9bD7\xcc\xbb\xa6\x9a\x1f\xb4o}\x85X\xabZ\x0bZ\xbc\x9dX\x13,\x08\xf2\xa3\xbd\x8e\x0b\xeb\xa3\xb50=\xec\xb1}\xc7\xfd\x96\x0fBN)\xe2\x031\xd6\x08\xfc-5\xae\xc6\xea\xa7\xda\x00'\xfc\xe6H\x83\xb6\\\xb1\xfb\x9e\x8c\xa5\xd5\x15G\xe5\x9d\x927\x82\xab\x10\xd2^\x92\xc7\x8a\xaef\x0bP\xba\xe8\xdd\xc2\xfb\x12_\x08r\xbb|K90\xecZtp\x98\xba\xee\x82\x9b\x05\x12/\xc7\x8fM:\xf7\xd5\x8f\x94\xd3\xfc\xc0\xe5$\x8a\xca\x93\x19s\xbc\x85\xff\x0b\xda\x88K\x0c\x83\xc6)\x8e\xfb\x95\xa6\xdd\\eP\xc9\xb3\x88\xa7jR\xd8\xf5%-[{G\xfd\xcd\xb9\xe7\xb8\xf0>\xcaH]\xb7\"\xa0hia\x98M\x1d\xcd \\\x90\xaer\xd8\xdf\x96\xe7w\x0fi$\x04\x11\x09\x90q7JC\xfc6\xd3N\x0cv|7\x00\xcfM\xd3S7\xde\xa3\xfav\xba\xb4M\xd7\x1cT\xcb\xe3\xb5\xa7pn\xf8\x97 \xcb[AM@\xf4\xd8\xc5N\xa1\\@s;\x11{)\xbdaw\xa8WweC\xb4\xa1\x8eh:\xce!\xc6~j\xca\xf6\xe1u\x851&\x0f>G`~s\x93\xa8\xd0f\xf0\xe2\xf1t\xa2#Dj\xbb>Sx\x80\x0b\xfc\xda\x14\x09\x9a\x88\x07yW\x1e\xf1Ei\x07<T\xfe\xb9\xc5l\xecr\xd2\x90\xbb2%\xc5\xb6J,t1\x9cGpI\xd1\x9b\x10\x16,l\x8c|\x9d\xc1\xb2Ga$\xd3\xff\xd1\xf0\x85\xbfA\xc3\x1fR\x928\xbb\xb5'-Q\x02,\xb7\xcd\xc2c\x1fD\xc2`\xc6_o$X\xb2Rs\xe1\x94\xcc\xc78!\xde\x12\xc6\xa5|`\xbbV\xf5\xdb\xac\xacn_\xa9~\x0aht\xea\x94zA\x12\x7f\xae4hc#5\xbc\xe5\x0ckf$\xbf\xbcm\xae\xe8\x11+\x97\x8d\x0fV\xe6\xe3\x12_\xfb\xc2\xea\x1f\xf4IP\xf9\xb4R~\x03\xc5\xd4\xe0E\x83]\xfe:\xb6g\x1d\xcb\x9a\x15\x86\xcd\xed\x94\x87\xc4\xb27\x86a\x06\xbf:~\xcc(\xc7\xebV\xde\x88\xea\x90\xdc\xb6\xe5\x82\x19\xdb\xc9?\xe4\xd1\xbb\x10ZX\xda\xdd\x0a\xbc\x8c\x0c(\xc3\xba\x19+(^F\xc9=X{DJ\xdb\x17\xeb\x87AU\xfb\xa5\xa9\xb6\x0f\xa8Z\xb2\x82\xa0`\x88mh\xe6^]\xe2z\x95X}Y\xe2\x1d\xe1\x1e\xdd\xc6\x86\xaa]s\x85\xa9\x13\xfc3C\xd5\x80\x97BeQ\xc4\x8a\x9aC\xba\x9f\x98\x02\x8f\x88Z}\x17\xa4Boz\xa3\x96\xf0\xec\xff\x19\x8d\xcf\xbf\xc1\x9e\xa0^\xd8_\xe8Q\xbaP\xc6\xc3\xed\x0f\xda/\xb6\x0f(\xd1\xf1\x94\x98m\xfa\x91oE\x9f\xcb}W\x88\x09\xc0\x19h\xa1\x15D\x8f(\xc7\x01\x96?\xf7\xc3\xfa;\x0dK\x1a\xb1B\x88?W\x82!\xedL\xe5\xc7\xd4\x12y\xb5\xed9\\\xbe\xd9\xcc\xe0f\xbf$\x8dA5\x9c\xcf\xc5qC\x04Z\x8b\xde\xb7\xa5\xff\x89\x1en\xa0\x1b\xa2\x8a'F\xac3W\xc1`\xae=R}\xf5f\xf0\x9b\\p\xe7\x14^\xb1r\x0f7Tx\xbd\xd4\xbd\x99\xb8 \xf7\xe0\x03\x12\x9e\xfd\x93x\xefj\x09\xbb\xdbk\xbc\x95\x1f\xa9\xdb\xc6^\xf5\x18h?V\xab!\xd9\x7f\xd7\x9d-\"q\x8b\xa4\xe4\x1cx\x98k\xac\xc7\x84\xfbV\xac.\x1c\xb9:\xd7c\xc2\xad\xe8*\x97m\xb2\x7f\x9a9Q\x82\xab\xe7\x86\xf6\x1c\x02\xd3\x8e\xdc\x9a$\x90^\xc5h\x03\"\xa5\x8f\x0cK\xdb\xce\x13Q[g\xc3\xcb\x1aqu\xba\xf9\xef\xe7R\xd0\xdeg\xb8-+\xa1K'Rs\xa3\x9a\xba\x0a\x95\x8b5\x84\xa5\x12h\x8a\xb5\xe4d\xdbX\x19\x9e#\xb6N\x8f\x9b\xccN\xddA\xb4Sw+dH\x03\xebF\xf7\x1f%\xa5\x05p\xd5CkN\x85\x11%P7\xb46\x8d\xa2\xc1\xc7\x16P-\xa3t\x9d\xa3\x0e\x10A#\x08-\xb1\x12k\x02~\xc9\xeaq\xe3\xb2\x19\x93y\x04\x1dFh\x93\x8fi'\x1f\xec\xef\xc0\xcd\xf5\x01\xf1Lj\xc4\x89\xfbB\xd1\xa65\xabvJ\x17\xf51\xb6\xf92\xd8\xff6\xee\xb8\x05\x0d:\xb0\x99\xae4\xe8\x8b\x04l\x11\xf5\xfc\xd3\x9f\x0eiCB\xf2\xb9\xfd\xb1\xd6\x00\xfb\xcb\x0bW]\xf8\x0b7\x11\x03\xdd\xa1\x0a\xd8\x9d\x01:=\x1f\xe2+\x88.\xda\x0b\xd2\xa7\xdc\x83\xba\xd6A\xddu\x86&\x00\xcb\x1e{\xa8\x99_\xc5\x17\x925\x1c\x9d\x04z\xdd\xc3\xd1\xbe\xebX?\x0a\xf6z\x0e \x8a\xf7\xbaR2Y\xe7\xdd\xb8\xfb\x03\xa5\x1b*K\xfd\xb3z\x85\xc6\x9f\xf2\xc8A\xb7\x1f\xb7\x01W\x9c*w\x95\xf1\xb0&4v\x92*\x1e\xa9\x147A\xfei\x0e%\xcc\xd8<\x85\xd9$A\xe0$\x98\xa0\x97&}\xd2_\xf0\xa6\xee\x1f@\xad\xe9\xa5\x8eH\x14\xfd_D3\x11\x9a\xdbu\xdd':\x0e\x1b\xd2\xaa\xf1\xd0T\xed;\x88\xe2\xb5\xef\xb0:-d\xd3\x84\x00e\x04C\x97C~O\xe2\xd88|\x05\xe1U\xd6jL\x14\xd4oC\xe5\x1c\x8a\\\xfd\xf2F~\x05\xd2\xa7M\x95\xa7\xdc\x0a\x00=\xeeD#QVL\x84%x \xbc\xea\xc3%U\xff\xcdd\xc5\xc7\x1e\x88\xc6\xee\x8b]\xd9\xf4F\xb9\x1dZ\xea\xd7\xe3ay\xe0\xe5\xdf\xeb\xa2\x12\x8a\xf5\x0b\xc9\xac\x8d\x912\xb9\x0e\xff\x14\xf8m\xb0\x13$#\xe7\xb6\xd0\x1f\x16\xcaB/\xb0\x13=\x08\x8f|\xa8&\x94\x16\xe3\xdd#\x90!\x0a\xfa\x0fn\xcc\x12\xe1$Z!\xcb\xe0b\x0bl:\xe5v\xf8T\xc1\x1cx)m&\x7f\x02\x9b}\xa2\xfd\xd8\x02f\xe6$!\xa0,\x16\xc6\x0d\xc6\xf5\x9d\xa8If^\x87\xb6(\\\xe8\xc7\xe3\x84\x0f3\xb2\xc4\xfc$\xc5\x1ci\x02BU\xb7\x8d\xa8\x1a\xb4+g\xfd\x8b\xbe\xf7\x1e\x9d\xee\xd8Sz\x7f\xcd\xf4\xf0 \xfe&\xa5\xc2\x8c\xbf\x1d(\xfa\x08\xc7g\xd8\xd5m\xa0\xa1\x0c\xc8\xeb'X[\xe9\x9e\xcbf\xd2[\xa68<\x0cE\x95\xd0\xbbv\x01[PX\xd6\x19FJ\xf9\xf1\x98\x93\xc6\x90\x01\x05\xb0'H\x7f\xf18a\xf8U*\x0d\xe9\x9a\xe5\xb7\xa3\xb5\xae\xe6Bz\x00\x8d\xd1\xd6\xbc \xfe\xe7\xf5\xee\x03^\x85Bx3f\x15\xd6F\x05\xa1\x14\xc5\x05ib\x92\x86\x88f\x02\xc0\xa0w\x9a\x0a\x18CZ\xdfB\xf1uol\xb1v\xbb\xe1\xb5;\xae\x9f\x0a\x9c\x02tj\xf1\x99\x00\xc3V&\xa3\xea\xaa\xd1\xe3\xb0+\x9a\x14#\x06@`\x11\x8837\x17\x04<\x95\xa1K\x86^F\xb2\x11\x99\x1aN\xe4\xe6&9m>\x9f$dV\x16[:\xe5\x02\x1bc\xd0%\xb0\xb5\xfe=\xbe\xc8\xf7\xd8\\d\xa7y\x1f2cs\x07\xbbh\xe4\x87\xd5\xbdXxd3vAhI\x1a\xe3\x8aw~5\xd0\"\x16k6\xc1\xd4w\x10\x86\xd6\x1dI\xb3\xfb\x8f\x02\xd3\xd5\xfa\x9f\xe6Z\x9e\xd3-\xf8\xf5w\x9c)\x1d\x87Aq\xa0\xbet\xe9\xf0tG\x0b\x93.\xe7\xfbf\x14\xae\x1e\xcc\x86#\xa6\x84h)D\xcc\x8b#=\x11\xa8>\x17\xf5]\xbb\x83\x9aV\"\x1cL\xc6\x7f\x8a\xe7\x1b'k\x16\x9a\xf0\x1d\xf2\x80\xd9\xb83\x048\xfc\xe3\x07\xe6\xca\x17x\xdc\xa0\xb9\x90J\xdc:\xd6\x93\xc9.\xb9-A\xee\xb6H\xea\x11\x0fC\xd4[\xe4\x1eF\xb2\xfd\x92K\xf1\xae\xf4PD\xcf\xdd7c|\xbfA\x14{\xce\x05z[g\xf3xJ\x97\xe4w`~\xe1\x86\xdb\x7f~e\xe4u\xe6oy\x0f\xc2ZE\x91A\x14W\xb5\x8a\xfa\x09D\xe1\xbd\xe2=\xbd\xd3\xef\xee\xa0\x88\xab\x9d\xd2\xa5%\xafW\x1a\x89%\xf0\x19\xeec\\\xce/#\xecF\xdd\xea\xc9\xacT\x1e\xed\xf5\xae\xfe\x950\xfe\x93\x0e\xe0%\xb4\xa0n\xe0\x09_D:\\rj\xa2_+bK\xe9Ar\xa0U\xaf\x99\xd9)\xbd\x0f\xaf\x07\xfa\xb1\xb3\x83\x0et\x9c\xd1\xa1\x1c[y\x92\xb3\x08\xf3QZ\x90\xabE\xb5\xbb3\xb0\x0d\xf5\x1aG\xbd\xa1\xf1\xd1\xfe\x87\x10\xae\xea\xb5\x04\x84\x9b\xcdw\x025<]`BY\xbd\x82q\xd0k$\xd5\x16q\x9ck \xaf\x09\xeb\x86\xf0\xaa\x0d$\xfd\x13RES\x98H\x97\xbe${\xa3A\xd1\x9a\xc6\x0d_\x90\x93^\x12\xc5E+\xc6\xc0\x1e\xa7Z\xa9i\x15G^\xff5\x80\xe4\xdc7W\x05\xa2X\x9bGf\x11\xc0\xe5\x91\xaa\xa4\xe5.4v~\x0f\xf9\xd4\x86\x85\xf1\xa4}%\xf1\x9f\xb1\xc4\xa9\xcc 6\x1f(b\xf3S\xfd\xad^7\xc3\xff]b\xb3j\x81\xf2\xb0\xae\xb4\xb9?@\x9d\xfa\xf9\xcf:P\xc8\xd5\xe4J2!\x93b\xf3[\x88\xfe\xc7\xf9&\xbc1]9\xe5\x17p\xc3w\xad\x1f\x85\x1b9\xca\xd2H\xc2Ew]\xdd\x02\xbf\xfa\xa9\xefJ\xbb\xf8gI\x15x\x05rV\x12z^\x81\xc95\xe4\x1fx3\x08TA\x85s1\xad\x80~\x06{\xb4J_\xad\xe9\xd8\xae\xc2\xda\x92C\x85\xa2\x06\x9e\xb7\xe4\x14\xd3NIr\x81\xc9v\x85\xd5\xb8]#\xdd\x17F\xe1\xe2\xe4z\x03\xfd`\xb7\xf6\xc0=\xee\x02\xfa\x1e#\xa5()\xdd\xfa\x95g\x9a\xaf\x81\xbc:\xf5\xf2K\xd9%\xf8\xc1\xc5\x88\xaf\x02\x06\xb2\xe3\xe3;\x8bk\xf3\xf8\xfc\xee\x96H\x84\x1a\xa2&\xe5\x0b\xd9\xdau\x97\x18\xb5\x08\xb5x\xa8\x0b\x95\x90\xb4\xedP.\xc8\xd3\x0cff\xa7\xd9L\x12\xc4D\xf5@\xf9\xba'\xb4\x15\xe2\xebH\xfd\xde:-l\xdc\x1aI\xda\xe6]\x0b\x17\x09\xb8f8\xdd\xed\xa5\xeb\xf0\x03\xa5\xbfokC\x81\x0f\xebD\x1b=(\x8c\xc7\x90\x9c\x19\x9fC\xe3\xff\x0b_!-\xc07B\x886\xa1n\xcd%N-c\xffY\x82\x1a\x9a7\xd3\x9a\xe5\xfa\xa9\x86w\x9ar\x1a\x96\xdcOiM%3\xc7>b\xaa\x00RbZf\xc7\xb5\xa1\x9dJ\x9a\xdc\x9a\xe0\x96\xbd7x 2\xc2\xca(\xb7\xb3\x91\x097\x99}64\xe36\xb3\xb0fX\xb9G\xd3\x9e\xba\xa4rZ3\xf5l$H\xa8\x8d\xfcriq\x19\xc6\xc9W)\x10Y\x0c%\x163e\x09\x95\x07\xfd\xc2\x03\xb0\"u?\xbf\xd2|c\xafcf\x0fIU.k\xd6\x85\xab\xb5\x88\x03K\xd0\x9d\xac<\xf9\xaf\xfbI'\xb6{\x13\xf8\xf1\xd3\x99*\x11\xe4f\x0a8\xc4,\x97\xbf\xa3\x84\x8b\xb4F\x8f'#\xf3\x0b\xcf\x9dn\xf3\xe0\xb6\xcb|\x19-\xb0\x065\x881l\xc6\x0c\x03\x85\x9d\x1d\xa3\xb4\xab\x0c\xe6RJ\x10\xc0\x82\xb0m\xbd\xa1\xbd\xfaf\xcah\x06\xd9*\x1b\xb2G\x97\xe4\xe6,\xd1\x04a\x17\x8fI1T\x97\x0e+~\xc3R\x18\x05y\xce\x04\xc6|\xa0\x15W\xd4K-h\xab\x03e\xb1\xb5\xbagN\x9a\x92\xb8h\xf8\x1d\x1aG\x1f\xb9\x02=bd\xaa\x90\xe8#!W\x93\xc3\x09\"a)Ej\xf5\x18k\xd7\x91\x0b|B<\xc1t.55\xacF\x17;\x8c\xbe\xd0Re\x1d\xe3y\x84\\gzF\x06C\xbaC\x04'\x8e\xf5\x10=2\x1e\xf9e2\x83\xb1\x05\x1e\x0c\xa0\xc0\xe6\x91\xdd\xb4\x88_#\x0b\x81`\xec\x8c$\xe4\x12\x05\xb6\xfcY5M\x8e\x80\xc2\x12G\x9e\xebU\x19\xf7m\x8c\xaa\x92\x04wY\xca\x16\xab{$\x8f\xba]/P\x97\xdc;@\xe81\xed\xb0jM\x81\x97r\xcde^K\xdc\xd6\xd3\xb9\x1d\x11\xf5K\xff\x0d\x87\x14\x1a\xab\xa3+\x1d\xcf8c\x92\x05\x97\xc0bM\xb8\x85\xaa\xa2\x06\x17\x17\xc0X\xa1\x19\xf5_!\xf7\x98!H\x09\x14H\xe5\x0e@c\xfa\x91\xa3\x9c\xcf\xf5\x92x\xbb\xa1\xf0\xa0\xd4\x8b\xb9\x8eT\xc2\xc5W?\xd7_\x01\xc3\xa5\x9c\xe4\xca\x1d\">\xed\xab\x18DU\x9d\xcd\x80\xa4P\x18,\x11\xcd9D,\xa1,\xdc\x94g\xad/R\xaa1\xc3\xfdA\xf7\x92V>\xaa\xf3\xdb\x8ft8\xf9o\x1f\xf2y\xfeC>\x14r\x8cxkJ\xed\x0f\xe4\xc4Y]_\xeci\xb7\x94$@\x92&6K\xba\xf1\x94-\xab\xdb\xf0\xe5\xb3\x18\x89T=5h\x7f\x97\xff\xf9\xbe\xf6?\x07\xf4\x088\x97\xe8A%\x1a.\xf4]\xdfl\x06\xe2\xfc\xdco\xff\xb2\xf4]o\x89\xa1\xb5\xd4d\xb6\x89{\xa2\x85/i\xe4\xb8W\x1eGB\x83\xc5\xc0\xd8-E\x948\x04%~\xa9E\xbb\xb92\x12\xf0>\xa6dZD\xe5\xf4\xab\x94]j\xa6\xbd\xca\xbe\x95\x9c\x8e\xb9\x0b\x0d@\x95\xae\x00\xe3M\xfa\x93\xa9\xa8o\xac\\Fg]N\xa3\xe9\x8d&V\x84qx\xe9\xef\xfc\x8e:\xeb\xd0\x9c\xc3\x169\xbc\xcf*f\x06\xa9\xf0wk\x006ow\xb0\x06\xe45|B*\x0b(\xa9\xdb\\\x80\xa2\xcf\x98\xb3\xda\xf6\x8e\xe4l\xc2\x0b\xd0V\xd2I\xe5\x0ef/S\xf3\x04\x1b!;\xc4\xd4\x07\x9f\"8\x9e\x9b\x1e\xd1\x10qj=\xf3Ef\xd4N\xf2\xfaA\x02B\x83Ua\xe79\x7f\xb0\x97\x11\xc20(\xbc$z\x85RR\x8e8\x87j\xf8\xd6\x9a,\xb4\xaf#\xb9\xb6\xdc\x88V\xd2\xaf1\xd3\x08k_E%r\xd45\xe7\xb06\x0a\x80\x98\xe1p\x04\xbeD\xd2\xbf\xcf]\xdaF-\x80!\xf4\x12\xe33\xcf<\x7ffj\xc8\xdb=\xfe`Cu\xa3]\x9c\xb7\xfdg\xfdC\x1f\x1c\xe5)\x0b\xf9\x8d\xeb\x01\x94s\x10\xa1*\x90\xe6\xe4\x18\xef\xb2\x83\xef\xfa\x87\xa5,E\xfa?]bn\xff\x0d\x9b_\"\xeb1O\xb3\xb4OS\x83\x98\xf5\xa2i\xc59\xfa\x86\xeaA\x8c8%\x0c\xb6\x91\x829J\x81\x01\xcb\x1c\xb9?\xbf\x02\x07}\x0a\xdb\xf9\x06\xe1_\xe0\x05Ar\x1bK-\xb1r0'`\xb90\xa3w9t34\xf1\xf1\xd0Iu\xc8\xf0\x07\x1e\x11\x04:\xed\xd5\xc5@b\x04\x89\xbc@\x95\xef\xddL\x04\x93\x17\x8a\xc0\xf3\x99\x0c\xe7g~ylO\x84l\xda\xc4%\xad\x10\x0b\x93QE\xd7\xf4\x81\xfa\x89\xc6\x1d=\xa6\x193K\xb1\xc4WF\xa1\x8f\x02\x1b\xf4V\x90~Dx\xa0\xec\xf24\x1b\xb3\xf6\x13\xdc\xf5y\xbf\x97\xe3q\x1ca\xe9\xe7\xdf3\x130\\X0\x12\xa0\xd4\xd8p\x9b\xba1\x85\x10\x98\x1f\x92aL\xfc\x85W\x09\xa3\x18\xca\xe2\x01~\x95uM\xba\xb7\xba\x93t+\x8d\x05\x16\xbc[z\x09\x0fx\xa60\xeb\x11\"*a\xcd\x04\x81\x13\xcc%\x05\x8d@\x8bk\xbf%\xb7b\x98\xbf\x0e\x0fD\xa5\xe9\xde\xe4(2\xf5\x99sF\xee\xab\xa5Jw\x01\x8d\x05\x02\xaf\xcb\xf6\x16\x18\x83\xcd*C\xa8\xbfv\xce\x87 \xba\x9aK;6v\xbf\xf4/\xa7\xcc\xf5\xb2\x1b\xf3\xd25%\xa7\x15\xdd\xfd\xd0\x80J\xf7\x1c\x06\x1e$\x093J\x14\x85\xa5\x01^l\xa3\x93\xe8\xc9\x8a\xf4\x1d\x88\x1a?\xda\x01\xe4\x8fmQ)\xdf\xbb\x82*\xdc\xc4G\x94\x82z\x8dEq\x04\xb8X@\x03^\x9e\xbb\xe8\xf6\x8eS\xfa&Q\x89\x19\x13\x05^\xe7\xe0\xf2\x87\xd6\x9ds\x0a\xf5\xfa\x0b\x18\x1fS\xc5B7A~\x90s\xc15PvVe\xf8\x1a\x1e\xee\x87x+V\xe0WV\x86&\x07A\x83\xaa\xc7\xb0\xc03L\xa5\xe5\x86\" s\xf1,z\xc7\x81\xceC#e \xe3G\xfb\x9d\x9b\xf6\x02\xa4\x14\xc0\x7f4\xdaE\xc6\xa7\xef\x84" +
+	"\xb6\x1cvB\x0a\xc6M!\x96n\x84\xad\xb2\xa4\xc1\x18\xa6=\xe6\x9f\xf8A\xbd\x81?\x0e\xc5\x88\xcf,M\x1a\xe8\xf9\x9d\xf8\xe9\xc2\xf8>\xecP8\x9aH\x7f\x13p\x84\x93\x9d\xedH\xd44\x89a\x94c\x95\xde\xcfP\x0e\x840\x0c\x91\xf1\xcb\xac\xb5\xdc\x80\xae\x95\xd1W\xf2\x1e\x85\xddW\xdc\xd2^2\x01\x86\xa0\xab\xb7\x95\xeed\xb8p`3\x9d\x8a\x99\x11\xe3g\x92\x83\xc6\x94R\xc6\xcar\xd5\xbc#\xe6F\x0f\xf3\xe7\xc4\xa8\x1c\xa6\xf3\xcc\xae\x1b\x84\xbd.\x80\xa4\xcb\x00\xee\x07y\x06\xf4\xbe\x88\xd3\x18H\xc3\x98}\x88\xb3 \xb9\xf3KPe\x94\xe6\xd2\xd2mV\xbb]\x91x\xc8\x85fj}\x83%V\x96\xd1E\x0b\xca\xecN\x1fR\xb68<\xebJ\xa5\xe74K\xbc(@{\xc1Z\xdf\xec\x81\x04uX\x9a\x92;\xa4\xc6T\xd1z\xf7\\03\x19bS\xbb\xe4\x99\xb6f~\x9ct\xf4)9\xce\xdas\xb8\xa8r\xa8Mzh\xaf\xd2*g\xcc\xc0\x09\x06\x1e\xd1\x00\xdb1\xe6UD\xbb/J\xd7-\xd890:UKY\xf3Vu\xbeHD'\xbeB(A\x96\x87\x1e#\xfa\x93Q\xdb\xed7\xdb~'\xf1\xb3\xa02\xa2\xb8\xef\xb7\xf4X\xd0\x84\x1a\x08\x12\x02P\x0a\xa0v\x1f\xee\xaeX\xf6p(\xd5F}\x8f=\x8f(y\xb4\x1f\xf6knyHErsh\x15\x15q\xe7vN\x14&hT\xd3\xe6\x07J9`\xae\xcc7@0\xaa/V=:\x08\xc6\x98\xca\xedKI\xeeI\xa8:\xf9\xa6,\xa6i|G>7\xf4\xb1\x96\x00>\xf3\x1a9\xc0\xecZ\xa8\x08\xa5l=&\"\x9a\xc9\xc3\x1f\x1c\x1d\xca[\xe3h\x06\x98W7\xa0\"\x89q7\xb1q\xdc=\xbdb\xde\xea\x95\x97\xa3&?\xc6\xcb\xce`\xfc\xf0\x9cUF&zt\x94\xdeQ>\xd7\x1b\xea\xf1\x10\xad#\xbfAp\xeb\x89\xbf\xed\xcb\x89\xb0\x91\x91G\x0b/\xa2\xf7:\x15s?\x8e)e2\x88\x97\x8bT\x80:n\xf5\x0b\xb7HL\xf2{\xcf~\x92\xae\xef\xfe\xf0\x1b\x9d_\xe3\xda\xa5n\xc1\x0d^\xa8p\xb6\xff\x1d\x00\x0e\xc4r\xc8\xef\xafT}]\xd6\xcbzqk\xfdM\xff\xec?\xed\xd8\x90\xdc#\xe7\x98kEz\xbd\x11\xfbj\xe5\x01\x16\xe0*pl\xc6\xfd\x85\xa1\xf1GwJ\x15\xfd\x8b\x10\x94z\xe5N\xde\xde_FJstf\x10\xc6\xe2\"\xf5\x15l\x81\xfc\xfb\x887\xd4a-\xa70N\x09\xb0rl\x041\xa8\xc5\xbf\xcc\x0d\xf6\x9e\xbc\x0df3\xee\xb8\x93\xe3OQE(\xc0\x80\x10\x9a\x7f\xdc\x11`K\x18[\xb8n]vi\xc8H@\xcf\xb0\xf7\xa9\x1f\xef\xf5e:\x8c>\xb8\xa2\xd9\x15j\xb8\xaa-\x0dK\x0b\xbeh)\xb2a\xa9i\xabD\xb61\x99\x90[\x95\xcc\xe1\xaeR9\\\xba\x96u\xbco\xdf\x02\x86mX\xbb\xd2\x05\x0fi\x0c\xa8\xaaj\xe5 \xeeU.\xb8\xe1>\xe8\xba\xd9\xa5\xabt|\x90\xd1U\x1ahO\x01\x0d\xb9\x9a3\xba\x0dE\x14)\xf4\xa1d)\xd1\xe4f\xe0\xd1\x88 \x85$ V\xb9@\\\x9dB\xdc\xb3j\\\x04\x88\xc0\x15\xe9\xff\x15`\xa8\xac\x09\xfa\x94R\xe1\x00`\xa1~cc5\xa3-\x13l8\xfc\x10I?\xd0\xc3\x94\x89}\xcc\x1bK\x9a\xb1\xa4\xc4\xd0\x870\xc4e\x17\xf8\xa2)?t\x90\x9a\x16\xbd\xf1\xaaQp\xd8\x07\x87\\'NR\xe8]\xaf\xb8\x8e\x08\xfb\xf4\xbdN\xc1R\x94\x9c\xed\x9f4\xf2\xa4O\x90U<\xa8$c6\xdb\xc5\xfd\x987ud\xe2GY\xecA\xc7<\x10\xe5\x86^P\xbdR\xd4\x80Bv\xb6~\xc1z\x83M U\xb0f\x12\x9b\xcb\xc22\xe2\xecc-b\xbe\x80\x14\x94\xae\x939\x98\x96F\x1c\xb6\xde\xfcET\xba\x99\xa7\x17\x8d\xb8ji~\xc7\xe1X\xcb\x91\xdf\x19\x18*>\x84\xfa\x1b\\\xf0\xd0z\xabu\x94\x8d\xc5\xe2)\x05\xce\x90\xa5\x03\x83\x92\xc5\xcb\xd3\x17y\x16\xc7\xf0GU\x08\xe2\xe5\x0d\xc2L\xddi\xe5\xf3\x0d\xa9\x86\xf4RN\x04\x91\x00\\\xbfJ\x83}T\x06g\x85\xbdiZ\x96$8\xbd\x94\x04\x00\xe7~\x85\xc5\x8d\x9egr\xb2'^\\\x09;\xce\xb0)\xf5\xdba;;\xb7\xdc<\xc0\xb7\xae\x13\x19\x11^\xa2[\xdag\xcaQB\x91\x1a$\xd0\xfd\x99\xa8\"\xb8F\xab4\xd7\x8b8\x94\x8c\x14\x1f\x03\xbaG\xf1,\xb6n\xe8\x94D\x88\xb4\x8fN\x9e\xdc\xcbJ\xb8\x89\xd1_\xb18\xd97\xba\x1b\x15\x0az\xc6\xde\x99P\x1e]\xc9:\xb2\xb73\xc1\x96\x87\xc0\xb6)\xed\xb4\xd2\x8e\xf0-%\x8f\xbd\x97y\xa0\x9c\x9f\xa2L\x12\xc2Pci\xf4J\xeb\xb0\xe9T\x97\xe5B`\xed\x83\x0ei\xef\x95$\xf7B\xbf\xb0`\x95\xa2[$\xc3\xfb~\xb5W\xc0\xda>;\xbe\xb8#\xf9D@\x1f\xc9=\x82\x15\xb0\x06J[`Z\x98\xf5[y\xb4\x95X\xbe\xae7\xfd\xe7\x8e\xe9v\x88\x1d/h\x7f\x18\xf5T\xb3\xce\xc8\xf2\xd7\x8e\x92N''\x89\xda\x16\x08\xba\xce\xc5tD\x079(L\x01\xe2\xe5\x07\x80ghI05\xc4\x12\xfd'\x83*\xb5\"\xc4\xc0\xc8\xcb(\xc7\x9d\x9c\xaa\xa1l\xc3IR\xa7\x08\x02J\xe9\xd2\xc1O\xb3\xb9\x91\x85\xdf\xaf>\xdc\x92.1\x95\xfd\xf9H\x80@{/\xf6,Z\x15C\xbc E7\x82\x16\xae%\xad\xb9-W\xc2\x8fr\x12\xd6\xd3OQ\xd1\xed\xce\xde6\xf2J\xa1i\xb8\x91N6^<\xe7\xc6\x0a\xc0M\xa1\x942\xd3\x95oluA\xf0\x8a\xed\x9bR\xb2W\x1f\xadu*\xe0\\\xa0\xea\xd8{\x83{\xa1\xady\xe8^\x9e\xe4``\xe0\xf0\xe8=A\x0bY\xa9\x94<GURv\xeb\x0e\xc1\x1e;\xaem_\xb8\x8f\x074%\xdd\x87\xd9\xd1\xeb0\xda\xc4Y;\xe8F\x85\xc6\xfa72\x9b\xac2\xf2\xecS\xdd\x03\xfd\xee\x0f\xa9[\x13m,2cq\x18\x94\xea\xc0K@\xd5\x1dk\x84\x0e\x88IJ\xa4d\xf8\xa6\x96-\xab\xae<\x13ah\xe7\xb5\x1ff\x096\xc7y\xfc^\xac\x02\x1bo\x17X\\N\xf3\xf8\x83\xaf\xaa\xf3\x19\xaf\xeb\xddh\xa4\xa7\xa1~\xd7\x12\xd6\x99x\xb3\\Q\xd4\xde!\xea\xa2\xb5\x99\x8d\xd0c\xc1\xba\x9e\xd8\xeaA\xe3\x05\xf6\x9d\x8b\x1a\xe7D\x8d\xaeV\x00\xda\xbc\xcf\x02\xe1\x9d\x1e'\xaa$n\x87\xc4\xcd(%\xd7\xc76\x82R\x8f\xcc\xb8\xccrUe\xa8\xd3:\xe3\xccW\xa0$+\xf5\xbe\x92\x1b\x8b\x9a6\x81\x9bQ\xfafO>\x842\x1d\xa0\xa1B\"t(\xc6\xc5{\x00\xccZ\xe7\xfb4\xccj\xc2\x9e\xda\xc2/\xe0\xa9\x98\xdd~\xa7\x96-\x8e\xf9b\xa0\xea\xf3\xa8\xe2V\xd4\x89*}Eg\xa6n\x81\xf4\xc0\x07\xc6\x80-\x12.y\x1b\x1cg\xfe\x0a\x86\x06\x18\xd1\xf3N\xd3\xb2\x1c\x87J\x96\xe3\xb1\xfeV\xdf5\xd3\xff?\x95\xe5\x90\xe6j\xaa\xd6=\xe4\x85\xcd\x17\x87\x04U\xa8\xd4\xab!\xf7\xf0\xa7\xdc\x1e.U\xb4D\x96\xe3\xbd\xd8\x061\\D2\xe4\xb3\x01\xec\"\x95\x0a\x00\xca\xc1\xda\xb5\x16\xde\xb8\xce\x14\xde\x10\x91\x9e\x80'\xe0\"\xab0!\xc9\x1b\x14\\\x9cl1\x8dP\xfa\x8c\xd2\xf4\xa8\xbb\xe74n\xb3\x9dr!\xce\xdb\xeaN\xd4\xd5\xbc5\x16\xea\xdf\xb8$rw*\x05\xb6\xdb\x1b9\xcfj\xa8\x9e\x9a\x1cQD\x00E\x80!\x89\x9a\x8c#\xd5\x13\x1e\xd6J;^J\xd5\x7f\xfe3\xd3\xb4\xfe\xb5\x9e\xddo\x94\xe1\x88\xb4\xc2B\xff\xd3 \xa0\xeb\xe6L\x7f2\xcb\xec\x9a+\xcb\xf52\x90\xccT\x05\xd2X$\x06\xc8\xf6G\x87\xb3\xf1\xd1\xf2\x0e\x10\xc8\xbd=Uf\xfc\xe5BbRYR\x1a\xbd\xa2B\xfe&\xed\\\xd6\x8b\x10s\xa6\xe0F\xf5\xc8\x9d\x1b\x1c\x99\xb6\"\xbbJ\xa2S\xf4\xb4\xce\xdf\xe9\x84\x9f\xb5\xda\xc1\xc7&\xb8\xd6\xfa=\x90\x98\xc9\xc49\xe00\xbd\x91\x8e\xc4\xfa\x07F2\x02b\x9a\x1b*,\xc8}x\x10\xc30y\x7f\x0a\xfc\xd1\x04\xaf\xc2\xe7\x9b\xf8h\xae2\xd4\x11\xec\"\x0a\xd2\xb6\xe8\xad\x11\xc5h\x9d\xd3@\x18[\xb9>\xd5[s\x134GL\xe5X\x8eN\x9a\x14\xedo\\z\xf8\xea\xc4\xe6\x95r\xb9\x97\xe6\x11\xdcv\x17\xe0S\xdd\xe0\xafp\xe0\xec\x04\xd2\xdd;I\x82\x18\xa1\x06\xc6\xe3V`3\x8bX+\xbdH#\x163\xc6\xd6\x88\x00\xe6;(\x18\x10\xa0\x14\xb0\x09Z\x81\xef\x06\xba\x93\xec\x0d\x97TH\xa7\x88c\x14\xbf<\x94\xaa\xe8\x1b\xf8Z\xb9\xc0]\"\x07\xb0T\xab\x1dG\xe4V:.\xcc\xf1\xdd\xc9\x1cf\x00\xe1\x9f\x0bU\xeb\xd7\xdd\xd7H-!\xa0\xcf\xb4m\xca\xfa;\xb0\x1d\xddy\x92M\xc8J\x99+c\xef\xcbgu%\x8d\xbc*\x83\xd7\xcfW1\xa0i\x0em\x0a\xb5\x06\"!j.\xdb\x9e+Pl\x1a\xf7\x9fp_mT\x8f\xc8n\x920\xc7\x0b\x8c\xea2S\x13\xff\xc8\xef\xb3\xebq\xfb\x07V\xb0\x0b\xa5\xd0b\xfeU*\x08\x8e\xad?x\x01\x08S\xc8\xc9\xa6\xf7\x0f\x0dJ{\x05T\xa7\x11W\x93\xd8\x18\xc7\x17\xaa\xc0Y<T,\xf5o\xea\xde-:\x8f\xfc@\x18u\xb5\x03\xff{\xc4/\x9ek\xaf3\x81\xe7\xd6\xde\x96\xd7X\x90\x00\xf6X\xf7\x90?\x0e\x18^k\xa8.1\xd9\x8d\xac2\xae\xf5jt\x9d\xeb\xab6\xe5VL\xfd\x05\x8f\"\xea\xf3\xc9&K/\xf9\xfcT>%\xcd;\x9b\x91\xf6\xbc{\xe6m\xfb\x88\x92\xa9\"\x04\xe79\xc8Y\x91\x91\x0e\xed\x9f\xc5\xacj\x96\x90w\xad\"\xaa\x02\xa6\x0a$\"\xbah\xe8^\x0ebr\xb5\xbc\xed\xbb.|\xce\x95F\xc8\xcf\xb4\x87\xf9\xca\xf4\x8dnI\xf4\xe3\xa6\x8c\x8a\xb7\x0d\x0c8\xfd\xa5\x97W\xe5\xd0\x9d'\xcc\xb5\xb4\xbb\xa4M\xb3\x02\x0d\x03\x1f\x87\xa8TQ\xf5\xcb\xf2%\x15\x0f\xf6\x13\xcf\xab\x1a0\xba\x835\xd84on\x8c\x9e\x9cB\xef\xc5\xecb\xbbQ\x0a4\xc56.\xed\x12\xde\xb2\xec\xbck\x95V\xa9\x16\xa7\x94\x13\xb2x\xf4\xb1\xa6dl\x17\x08\xabj\xb9\x14S\x89\x1cT\xa4!Qd\x9c\xc1;\x10\x08I\x96\x81\x9d\x89\xae\x0a\x93\x1d\x98?\x8e\xb6n\xa4\xa5\xb7\x03\x19tH\xd9\x99\x049\x88$t\x9a\xca\xa8\x9b\xc5\xa0~\xa1\x9d+\x83\x81z\x12%\xd0'\x90\xa0\x02\xa0\x8axt\xcf~\xbfN\x19-\xc7\xf8D\x92[\x1c\x0aej\xb5\xa2n\xc0s\xb4A\x9di\xee\x84K)Wk\xa5h\xfd\x8e2\x97\xfdU\xfb1\x84J9\"'\x82\xbe\x99\xbaH[W\x19\x0b)\x887uXc(\xa8\xfcr\xb9`l\x8a\x09\x1b1\xe9P\xe6\xe5\xfe\x95\x9d\xc3\xd3-\xdf\x8a\x09?\xdc\x8b\xa7sc.W\xffbf.L\xec6\x94CWc\xb2~\x10\x7fG\xc2\xf4\xbf\x0c\x1f\x1f\x07\xa4\x95\xd2\x19t\xe4\xc0w\x8c\x01\x92\xedepIx\x12\xf6\x04\xbaJ\x7fQ\xb1TSa.3\xe8\x96|\x0ew\xeb.Q\\\xa3J\x86\xd3\xae\x06\x80\x99/\xd7M\xb2l\x83\\V\x84r\x99\x0a\xff\xf3\xb5\xb7\xf8}\xa8\"R\xb5\xd3\xc3\xe8\x81\xc2\x12\xe7\"\xcaR\xb2\xf4f\xae\x9d!\xc1\xe1\xb1:\xc7/\xa8\x81\xcf\xb5\xf1\xd3\xa4\xffZy\x11\xfd\xe6\x127\x82\x7f\xca\x04\x09\x93\xa3\x0bV\xf3w{\xf5\x86L\xee\xfeV~\x99\x19\x1d\x82\xc3\xd3X\x81\xe7\x05\x16f\xe1\x1cB:!k\xd8Y6\xac\xb5\x16\x85q\xeev\xffg\xc2@\xde\x91d\x02\xc2\xad\xe0o\xa7%~U\xb8_\xe68H\x98\xe8\xe0{T\xc0_\xc9b\x05\x98\xf3\\\xb2Y\x1c\x1eep\x978\x0cM\x1e\xa3\xca\x16\xd79^\x07C\xd2,~k\xaeut\xa0VE\xcc'7A\x0fv\x03ic\x1b\xbb\xb2\xe2\x1aq\x95\x96\x94D^s\x9e\x0b7\xe7\x9e\xeax\x17\x1eT4oB\xeb\x0f\xac&\x1a\xde\xe6\x03\xfa\x9f\x0eh3\xb8\xce!2E\xda\xce\x0aW\xb8T\xde\xab\x01\xdc\xee\xdaCM\xb3\x88^\xc7!x\xad\x80u\xe0<qv\xe6\x9aA\x98\x99\xc0\xf6\xd2\xe7\xa2\xf6\x18\x06\xa0\\\xb2\x8d:\xd1\x15\xbb\xad+G\x8a_\x0c\xe6Q2\xe7\x89\xf4\xa7\xd4[\xd5[\x14.AF\xcd\xbb\xfe\xd9?\xd5c\x16I7Z\x02~\x03\xf8@1r\x0680I+W8\xf0&\xd8\xbe+\x18\x99#8\\>\x09)\xd1p\xb1\xf1+\xce\x1e\xfd\xbd\xfc\xa6\xf5\x91jZ\xdf;]\xd4y\xfd\xe5\x7f\xb7m\xed\xab\xd6~\x95\x17\xe0-\xd3\xc3N\x19uE\xf6\x89*\x06\xec\xd9\xa9\xe1\x9di!\x12\xebo&\xcc\x9bq#\xe0\x12i~\x83[\xa0\x9bX\xc6\xb6R]H9\xd0\x97\x8e\x1e}#\x18\x1d\xd6\x9a]\\ 19\xce\x05\x1f\x03\xb6\x8d\xf3\xacv\x96\x95\x91\xf8FQ\x0ch\xcc\xc976\xc7\xa8\x0c\x9f\xde\x94\xe5\xd7\xeb5|\xa0\xdd\xcf=\xe4 \x88v{\xd5\xebi\xf9\xa9\x1c\xb2\xfe\\\xe8\xd6C\xa3W+\xd6\x87\xe9\x9a2C\x15K\xacb\x9dy\xff\x17\x94=\x1b\x1a\xdfp\xa4\xed\xe7<\xf2]\x0cJ\x8e\xc5\xe4\x11\xd0u>Ii\xe7I3\xf5u@\x8a.\x03R\xe3(\x09\x90B\x91!\x94&\xa8\x06 \xa7\xdd\xce\xb7\xaf\xcd\xb5~R\x11\x8b\xc1\xc0\xee}Gp\xd0\x891\x0cW\x1c$\xde\x9b\x81\x0a\x8f\xb0\xe4\xeb\x81J\"\xae3\xf0\x02s\x0b\xa1\x15\x17\x1e\xb1\xfe)\xe1\x89\x16\x98\"*\xdc\x1c\xc7\xda\xeev_\x00\x8d[j\xe5b/\\Q!\xb0dd\x1aQ\xbc\xf3\xf0u-dUz\x09:\xe0\x15\xc2?\xb17!\xbd)3\x19Vg)A\xf5-+~\x0e\xe2&\xfeP\x13\xd1\xf9\xb0U\x8a\xc7\xeb\xbep2\x91%6)v3\xcb%\x19\xaf\xde\xf6\xde'Q\x1f\xb9\xb1\x96g\xff\xca\x12\x93e%\x94F\xb0@\x96\x064\x18m\xa0\xa5.\xa4M~Gq\x00\xad\xc1\xd4\xe3\xd1\xfd\x93\xa4gg\xca\x08t\xd6\x14]\x8fM\x85RW9\x90\x0c\x91*]8\xba\xe2%\xa2\xc3#\x05\xbc\x11\x19\x0e\xc7\x09WQg\xf9\xbe\xb7,\xd6\xf0{\x17\x17^\xbf\x01\xeea\xaa$\xfd\x86\x13\xa3\xd0\x91\x1c\xdbkd\xc5A\xaa\x1c1?\x90\xd2\x14\xb7+e\x1fw!y\xb1\x98:\xad\\aA\x8d\x00\x11S\xab\x1f%\xfd\xad\x9e\xa5\xc1G\xae\xb7\x08\xa8HE\x9f\x81\x18\xdb\x1fd\xa9v/\xf0g\x1aGgJXt\x10\xab\xda\x18\x0b\xae\x82\x8dt\xe5\x07cv\x90\xbew\xa8y\x09Iz\xab&\x15Rmi(\\\x8a\x9bq\xe4\x872\xdf\xc3\xa6\xbb`r\xcc\xfdc\x0e\xabz\x15\xb1v`\x02\xb8\xff\x0e\xba\x84\x8ao\xa6J\xdf\xc8\xcf\xd8\xec\xeaN\xa2L\xee'\xe8\xfc\xf8BT\xad\x07\xd6\x86\x04\x89\xaff(\x0bF3i\x94\x7f\x0b\x83\x0cV\xaaaS\xc4\x0c\xacy\xbf\x8c\xdb\xae(Jd> }\xafC\xf9\xcb\xf4\xaf\xb2\x9a\x8d\x91\xf4\xa4\x90\xe9\xc0\x83w\x94h&\xf2\xcfShi\x95P\xc1\xf8\x82\x1f\xae\xa1\xb0q\xde\xddC\xab_!~\xbe!\xcc\x01\xcd:\xbfN\xdc\xc4\xf5M\xde\xd5\xed3\x0e+\xf1n_D: \"\xe1,\x97@eSl\x19\xea\x0a\x17t\xa3$\xe2\x84\x01<m\xa1I\xbdlEv\x00F7\x1a\xa1\x86\xcd\x17\x99\x87[%-I\x9ak\xe6\xf1_\xe79\x8d\xc3\xb6\x10\x9f\xaa\xbce\x90\xe7F\xa0y\x90\x83\xb4\x12\xd3(\x94'\xb1\xb2\x86\xd4s\x04\x12E\xce\x9b3J\x1b\x0b\x91\x0e\x09\xc5\x0e\xfd\x1d\xee\xe2\xcd\x94\xd7I\xc7:\x99\xdfV\xda;\x0bSxM\xa9\x98\xca N\xa0\xb9\xa6R@'\xac-\x84\x01\\\x9eA|\x94\xb3\x1f\x8d\xdbJ\xad\xc9M\xeb\x85Htg\xc21\xb2|\x0b\x99_ \xc88\xa4s\xa7}\xc1\xa5\xcd\xcb\xe6\xf9\x9d\x8ba\xb7\xcc\x8d\x91\xc1\x9f\x06\xa3\xbf\xbf\x80\x10\xb3\x8cy\x00\xeb\xffe|\x988\x8e<\xd6\xe6\x0b\xa8\xfc\xaa\x8e,\xfb{C\xf4y+\x1dp\xd9\xfb9h\x1a\xf7m\xf9\xa0v\x0a[\xa28\xef\x0b\x8f\x84\xfa\x81\xcf+\xdb6h\x83\xc7\xf5\x07Mh\x99P\x0dC\xb597Z\x86\xec\x8eH\xa1\x18\xa9f;\xde\xb8+\x09\xbc\xf1tp\xbc[K\xbb\xab\x91\xdc\xee\x8f%\xc4-\xedb#\xb8\x18\"^\xb8\x0e\xc2\x97\x0dd\xb5\xab\x80\xba-\xf2\xb1\x9f\xd9\xab\xc3\xfeA \x13\xbc\x86$\x1be\x99E\x05S'\xac\x8a:@R-\xcc\x9ce\xf5\x86\x1fMa\x1e\xa4\xfcg\x02\x8b\x83\xef\x03\xbaMzi\x06S\x01\xf7\x03\xe5B\xe2\x93\x92*\xa6B\xa5\x8f\x80S\x1c\xb3\x8e\xfa\x97C,\xe3\x8bE\x13}\xa9Y\x7f!m\xee\xa3\x1bo,\x1b\xd5ZM;\xd5\xc75\xc01\xc4\x93k\xa5O\xd5\xfa`\x85v\x8d\xbc\x09u\xda\xa78P\xdao2\x09\xfaA)\xb9\xf3\xe4\xa2\xee1\xb0wB\xf8\x04\x9e\xc5?\xb0\xb5A\xc7" +
+	"\xfd\x16\x0f\xae\x9f@$\xda\x01\xa3i\x8a8\xdd\xf5*\xf0\xea\x0a\x9fh2\xbc3\xc6\xf5\xed2\xec\xd1{\xca\xddB?8X\x86\xf5kv{\xd0\x9f\x801\xed\x1f\xeb<\xcf\x0e\xe7\xd2\xf0\x808\xe5\xd0\xb5<\xa6\x05%\xbe\xa1]+F\xfaQ\x00_Sh\x88&\xe3\xc51A\x9e\xddZ\xb54\xaaW\xfd\x80\x1d\xcb\xa4\xa9\xde\x18b\xed[\xe6A%s\xd4\x0f 3[\xda\x114D\x13T\xe5\x84V\x12\x05\xce\x1b\x94\x02\x91\xccG\xc7\xd8\xc81\xed;\x0dH\xddk\xbd\x81\x15\xd6\x80\x0c\xd2\x90\xaf\xd2\x01I1\x0f\xd5\x97W.\xa0\xf2!\xc8_jT\xa8\x9e\x97n\x01\x9b\x07\xc4\x1b+17>BqF\xe0\x889VT\x97\x85\xeb\x02Ho\xbd\xba\x89WF\xd4\x9f\xe2\xb1A\x07;r*,n\xa7.\xfc\xfb&L\xfd\xdfu[\x07\x9f\x02\xeb\xedI\xd4R\xeb#b\xbe\xf6\x14\xcd\x1c\xde\xf17\xf8\x8d\"\xd2,\x9b\x1b\xf1',ui\xa3\x1c\xd8\xb2w\xeb\x97+\x8eo\x99\xe9\xe9\xd4\xf78\xc2\xda\xae\xd8\xf3\xe9m\xc0\xbb4m\x81y\xe7\xb6c\x84\xf1tK\xa4\x80\x16\xb8\x08\x90s\xcc\xeaw\x00z\xee'vJ\xe2\xc3\x17\xaa\"9K7\xb9h\x80_\xd1\"\x17\xaeX]\x8b9\x9c\xb6\x14\x16}\xda\x0d\xe2Q\xca@'\x84\xedJ\xda#\xfd\x9c\xa2\x90\xc1l\xc2?\xa1\xd3qi\"\x9f\x18.\x99^\xca\x1cv\xa8\xe9\xae\x18\xf1%\xd0w\xeeL\xbd\x7f\x13\xa4i\xca\xe7T\xcd6w\xa0\x07\x10%!\xe9\xcf\xaa\x99D\"\x91\x8f\xf1\xea?8\x8c*$\x14\xb1\xe5\xb8\x99\xc2\x8aE\x12F\x90O\xca\x07\xbb\xa1\x90\x81\x14\x8a\xce9\xb1\x9b+w\x07\xf8N\xc4\xf2\xfa\x16+(\xbaK\x81\x1b|,\x12\xd5\xfe\xe5\x03\xfa\xeeK\x16\xcf^@\xe1y)R\xd6\x96V\xf2TeP<\x81\xbbZ\xa9\xa6!\xe8\xee\xf0\x97[\x00n\xa0k=\x0a\xcd\xf0\xd0\x9c\xa9L\x04\xfdC\x0c6\x92p\xdf\xc0m^J)\x17\x1dx^\x1cO\xc4i\xeb/\xadk\xd8\xbe\x09\xa0\x14\x8cr\xe1\xed\x8e\xf3Y\xf6\x81\xbcP\xbf\xf8P6\xdd#$\x9c^h\x84\xce\x92\x1baH\x1fz3v&\xa2\xcd\x13\xc3\x7fj\xc1\x9e\x0cH\xd4\xb1\x82D\xdd\xd6\x0f\xcdy}\xd7\x0c\xff?HT/$\xea\xcf\xb2\x1f\xf2(\x0a\xe8##\xdd\x966\x10\xc1\xec\xd4\xa1\x96\xc2[\xf5\xad\xd0\x1eQdv7\xbc\xbd\x86V&^\x8btin\x83R\x93\xda\xd7\xc1]\xa3S\xf0(Vn\xa1\xaa\x0aG\x03\xf5w\xfb\x9ed+Y\xab\xfe\xd2\xca@|\x1d\xb5-?\x03\x92d\xe4\xa5tz\xa6\xb2L\xdd\xd9\x07\xb7R#\xf5:n\xec-\xf77\xf6\\\xf0\x88\x1d\xbf\x97\x99\x86D\xb4h\xf2\xaez\xc0Q\xe8\xab\x87\xd7\x80\xf5\xbcR\x186\xa3\xeb\x1e\xbd\xb3\xfe\xf7\xe5\x0f:\xd0\xa5\x1c\xdc=\xee\xdd\xec\\6%\xbd\x827\x94\x08f\x81W\x9e\xbb\x1cV:\xe0\xe2\xce/\x84\xe4\xed\x820\xb3U\xa4\x87\xa6\x841\x12q\xcf\xb4=-5\xb2n9~\xc6rx(\xbf\x1b\x10\xab\x0be4\xa9\xack{e2\xffD%LHdf,:1e\xcaN\xff\xdfE\xda\xdf7\x97&y\x1aU\x95\xb4d\xc8\x81{\xac\xdcT\xb9C\x9bG\xa9\xd3\xd8T\xe6k\xb4\xae!\x8f\xc5h\xf6S\xc08cm\xc7yv\xc3\x8d\x82\x81[]\xacR\xe6F\xed\xd9\xdd:\xc5\x8b\xb4*\x92\xc1+\x00\xb9{>\x8f+*\xb7\x8d-\xbd!%|\x19\x8d\x92\xac\x08Q~Q\xdbz\xbd+\xb1\xea,\xd83\x82j\x07\xea\xeb,,b\xa6\xe0S\x91\xd3\x9a\x85\xcf\x86Y#\x09dmQ)sIw\x8c\xafn)\xb1\x16\xff\xb0\x95\x96n\xe9\x96!\x13\x16\x8f?\xab\x1ftekN\x09\xb4\xc2\xf6\x86\x9b~3\xc5\xe9\xb3\xdb\x10\xdf\xfb\x14<\xc9\xf8\xee\x1bW\x1c\x9d\x04Z^1\x14\xab\x98\xcc\xa1\x8d\xfe\xac)\xb4\xd4\xe03\xcc\xa1\x8a\xa5\x16\xb0\x97\xd2\x8b0\xe1\x1eN{iN$`,\xcc\xe3\xa8>\x85\x00\x93\xce\xcaI\x87y\x01\x97\x82\x03\xffK\xa5\x14\xf3\x06\x89\xe2\xbd\xb8m\xf8N\xabdwU\x8e\\P\xf8E\x0a>2\xcd\x15X7\xfd|>\x97\xeeDV\xe8R\xec\x12\x8b\x01\xd5\xb6\xe8\xe9\x90\x17\x9a\xaa.\xa0\xd8`lE\x95+\xf7?^[\xca\x87U\xe7\x88\x9e\xce\xc40\xc2\xcc\x89Q\xa4Mq\xd6\xb8\x16\xfe\x83\xcb$\xd6\xc8\xc2\x06\xfe\xa54$\x0b\xaa\xbd4\x07_@\xb6\xa7j\xce\xa9\xe7O\xb4\x19\xb4v\x86dS\x18\x80\xa3\xa18\x07\xd7\xd1\x11\x10\xfaF\xcc\x80\xc9\xf0\xcc\xf2W\xd2:\xf9\x01\xb0f\xfd\xd5\xa8\xbd\x83\x145O_\x82\xedIq\x9d\x96\x13\x1e\xd9\xd2\x150\x9b;\xe1\xcf\xe1\x9f\xb5\xe0T\xf69%Pd\x13\xaf5\xecb\x88 \x84\xbcBHu}\x0bL\x85\x07\x1a+\x11\xa9\xfbJ\xcb\xb1k\xbebs\xc11\xca\x13\x05q\xdd\xe8\xc3\x1d\x8a\xf4{:\x1c\x9f\xb9\xeb5b \xfe\x8a\xdd\x8b\xa4\xd1\x07\xf5\xc0\x99\xb8n\x9e\xd4\xedwI@\x9b\xc6\xea\x83\xb0\xe5\\!\xf8\x14\xbb4\x10\xccx(m\xfb\x19\xce\x9cf\xe6\x13\x83z+~\x16\xf9\x1e\x1c~\x81\x85\x7f\x12(&\xfcd\x89c\x08^9\x0b\xa8cl\x82kX\xd2y\x1a53~\xe1\xbb}&\x13\"<e\xf8\x9d5\xec\x0f[\x9ey\x1b\xd8\x89\xddJ \x96iQ7\xba\xa5>\x83\xc7{M\xd9x\x90B\x03\x06\x1f\x17\x83f\x0a_\x04cu\xad\x84\xbcR\xbfF\x9b\xad\xf6{rb\x88\x8a\xbb\x12&\x97o\xf8F*\xeaLE\x8a\x81\xef6\x04\xac\x92\xec\xd5\x8f\x10\xc2\x01\x82\x03\xedh\x19\x18\xba\xf8wwg7\xb0\xb2\xd1=(1.4^\\\xc94\x0e\x895\x81v\x97\xc9\xccwt\x1daLkl\xa6G\x16\xb7)\xbc\x89sB6\x8ecN\x09d\x0b\xb4\xc1\xf8\xa2\xa3 \x8f\x07\xf59\x0bJ5Q\x12\x1a\xd6\xf7\x95\x9f\x10\x0e\x02\xb5&U\xf15)h\xbd\xb8\x85\xc1\x85\xc5\xcd9\xfe\x88\xee8\xb2\x0c\xe5K\x93\x1b\xc8\xab90\xcf\x0f\xf7\xa2\x12K\xce\xad\x8b\xb1o\x10\xa0\x8e\xe9\x03E\xe5\xber\\\xf9D\xd8\xcd\xa6pF\xc9\xeata\xa4\x1f\xbf\xd1\xca%\x0a\x03\x8c\xfb/\xa3r*sY*\x08J\x11\xbf\x80\xcb\x05\x17\x14\xf3\xceBy_\xa4\x9aQ\xd0\xbc{\x8blh\x03&\x81\xf4Q\x11\x93\xc4\x8d\xf8\x15\x1c\xdc\xbb\xd6o\x93VU\x82\x84\x19\xf3\x86.\xbaH\xebg\x89Xy,\xca\xdf~7)<\xce+\xf2\xea\x16i\xd1\x9b\x08\x06\xf7\x1e\x97\x0eY]\xc5m\xb0\xca\x10\xc1\xf1\xdb:XB\xf4>\xa6\xb3\x00\xed\x81h\x86kO']\xe3\xb4\xf7\x84F\x7f\xbd\x13i\xf3\x1e\xac\x16c\x08\x04g@\xd2\x13\x96[f\xe9\xf6S,a\xdf\xf26\x0c\xe0'\xc3 \xe4\xdc\x86|\xc4\xd7ns/\x07\xd9\xb5\xe3q(U\xbb\xc6\x12-\x9b\xf7\x8bD\xec5D\xf0\x1bO\x1f\x0dj\xd3*\xc2a\x87k\x16x\xdfsOJd\xa7\x1d\xdd\xb0(\x90$*\x09@\xae)U\x9f\xbdQHl\xd4U\x99h-\xba\xfd\xe2/J\xdeT\xbbBT\xfe;O\x0cx\xd22G\xc7P\xe3\x1f\xee\xea\xef\x14\xbd\xc0H\xfcF\x03\xd9\x15!\xaf\x07\x94\x18\xfaE\x1a\xfc6\"\xce\xbc\xa2o\x01\x9b)\xec\x1aC\x81\xe1L\xac\xe3\x9e%\xe2\x83\x8d\xa8!\x1f\xd6\x9fu\xd2\xcaK\xb1?\xa2#$1:\xd3\x0a\x9a\xb2\xf9`\x0c\xd7(V}\x1d\xdf\xd0c\x09\xfd\xc4Fq1\x09m\xf2'\xf9\x87\xd79`\xe5\x88\x1d-\x9bO\x0a\xbb\xf2\x8a\xbc\xac\x86~%7F\xe3\x03\xe5bK\xd6oC\xa1m=\xb5\xbe]b\x8f.\x13\xf8C\xc3\x1b@0P.\x14\xf1 \xb8\xcfb\x10(W\xc4\xb8,d\xea\x11eZ\xa3\xd1\xa6O\xd0\x7f\x91I\xa7\xeat\x09\x11z\x16\x8f\x8dC\x0a\xe9\xd5\xaf5\xba\xc10\xd0\x80\x13\x09\xc6\x8c;\x80~\x03\x1b\xe95+d\x12v\xda\xaf\xac\xf5\x0f\x89\xf3\xe1\x94\xdb-3\xfe\xf9\xc1\x0f!s5\xad\x03=\x9e\xac\xa28\x9e\x82\x08\xc6\x13\x90x^\xeb\x06\xc08\xe6u|\xa7\x10\x01\xf7\xe4\xfb\xa15\x85\xdc\xc6[?PcM\xb0\xf0w\x174g\x9c/\xe0\x09\xfd3\xac\xfc\xd2!\x90\x8aXS\xff\x1b\xa0\xf6\x8a\xcf\xa3X\xb9bZ\xf8\xb0|)\xc8\x15r\xe3]\xcb\xf2\xae\xa8\xffnH\x1bg\xda\x94:\x12Q8\xb6\xa9\xe43\xa6\x0a\x8e)g\xb9L\xd9X\xad,:AH:n\xd5\xd6\x0fN\x9b\x0fL l\x97?\x18E\x0c\xe4fq\xc7\xe5\x00\xe74\xe1\xfa=f\x9b\xde\xa3\xdf\x8f;\xf9\x81\xdb\xc6\xfe\x85\xec@B\xf4q\x0d\xf4$&j\\\xa9\xf4\x8d\xcfU\x066t\xf8{y\xe2\xe4\xb7\xd4U\x10\x1bE\x94\xb1\xd5\xa8\xc2\xbd1V\xff\xb9\x87\xf4\xee\x91+\x1c\x015<\xb2\x8e\xfdQ\xb0\xd7~ZW=\xae>I\x13\xff\x81\"\x7f\x0b\xb4\x92<\xc0R(\xf8\x03#\xc3\xbb\x17\x99\xba\x96|(]\"D\x9e\xfcZ\xe5\xe8G\x9f\x06+-\\.v[^\x94\xb2c\x99\xa0[\xbdA\xa7\x00\xee\xdb\xaf\"Ty\xe3\xad\xf8\x8b\xaf\x1d\xfa\x15\x95\xfb\xed\x92\x9c\xa7\x0d\x7f\xd4\x1a\"\x0a\xeae\xc0\xa2J\x10\xa1\x87\xd9zn\xfb1%\x8c\xc5\x80\x1e\x96xsA\xa5gJ\xb9BT\xfeW\xa6rr\xb4N\xe4\xfb\xd4C\xa7\x1d\x18\x10?\x9b\xcd\xf2\x01\x0a\x92k\xd6\x12\x0c\xa3&\xa7>Y\x11\xbd\"8\x8a;\xcb\x0f\xa4\x96\x07\x9e\x0f\x02\x91p.P\x8abF\xe6\xbb\xf6\x1c|JDw\x98\xd2\xf0\x19\x8a\x98\x9dN\xbd\xa1'E3Q\xb6+.\x19\xca\xe3e\xe6\x8b\x14y\x98\x9b$\xed?T{C4.B\xb8R$\x027\xed3~^\xf1$F|\xfeJB\x86\xe7\x12\xcb\xa4\xbd\xbe\xafs`\xdc\xe9\xc7w+\x81&~L<\xa2\xc8,\xcbW\x04z=R\xc7_/\xaf,x\xb0\x88\x02L\xdd\x0a\xe1\x7f\xba\xb42Us\xb7\xa1#\xc0H\xfe\xccf\x0f\xd9\x9a5\x07\xfb!\x88\xc7\xb8\x90\xdd\xa3\xd4Z\xf7\x8d\x0f9\xa3i\x87\x06C\xa0\xb7\xb0\xdc>y\x0d\xa9D\x0e\xdb\xcc\xcc\xef\xa5\xd9_\xcc\xc6.\xc1\x07\x0e\x0bSx\xce\x1f\xb8EK\xe8[\xbf\x83\xc4\x81&@\xce\x04p\x1fv\x13\x9a\xebl\xa6`SA#\x9bbI\xa4o\x80\x02\xaak\xf0N\xcf\xfe\x99+\x95\xb3\x0b\xee\xd9-\x092\xfd\xe8\x86\x90\xd62\xbfe\x9a7\xf3\xb7\x8a\x88@\"0#\xf1wZ5W\x1cQ\x0aK\xc9h\x87\xbb\xb7-F!\x17@\xfe\xdbKNz\xe8)\xa2w\x04Giv\x89\xc0\xfd\x15\x93\x82)\xad+X\xf7\x1ej\xc9\xca\xa4CRw\xadf\xe4\x05s2\xb6b\x06\xa0\xb31 \xd4\x95\x14\xc8\xde-\xe1\x1e\xfbP\xf0$2nAO~\xf32\x99{\x04C#\xd8\xac:\xb3\xabP\x1d\xc6TZi\xe6\xc4%!\xe4DSl\xadHo\xc2`\x0fP\x83\xe8k\xb4b\xb3\x11\xfc\x8fK\x8b\xf2'a\xbdk\xa9\xb1\xff\x0bK\xf0>Y_H\xcak\xe19|\x11\x89Q%\xea\xce*\xdd\xae\xa4\x1c)\xb9\xa5\xd1\xd8'\x88\xc6n&\xf5C3\xa9\x1f{q\xd8\x19\xb3\x83\"\xebG\x13C\"\xf6\x03\x0c.\xa3xZ\xe2nwT\xa1\xffcK\xc7\x8f%\xa8\xcd\x04\x86Hl\xf1\xc4V)\x9d\x8ew\x15\x01#\x95\xd0\xdb\xbeq\x8f\xfa:\xe9\xa7{\xae\x04(\xa5\x0a\xacDr\x82X\xdf\xba)\xc2\x88\xbf\xbb\x7f\xa8{\xb6.]\x1c@\xa2\xa1^Z\xaf\xe6\xa7\xca)\x14\xa5%oU\xbe\xb3v>\xbc .\xa9_5\xd6\x8d\xea\xc4\xc1\xed+\xe984\xb7\xa5\xfa\x1e\xb1\xbaA\xb7\x0a\xfd\x00H\xfb2\xd4)\x88(\x80P\xb5\xe8k\xf6\xc3=\xb4b\xbc\xb0\x13A\xc0\xc04\xa8\xbc\x8d\x0f\xf4\x8d\x08\xbc \x056\xcf\xb9o\x9c\xc2Ix@\xf9\xc2\x05\x15\xb8\x09A\xb2\xc4\xdcC\xc4N\xc8\x87\xec^\xd8W*C\xe0\xcdp\xda4\x114:\x91^\x94\x1c\xe6K\xf94\x01{\xd6\xd7\xbabP\x89x\xbdp\xb7\xa5D_\xa0\x95*\x94\x0d\xad@6#\xe2\xe6\xee\x96(\xa2\x0c^\xb2\xcb\x8cA\xba\xd4O?A|\xd2\xf5 _\xcdc \x17\xb6*a\xc6\xb3xM!yaQM\x9b\x00oU\xb2\xdc\xbd\x90\x8d\xeb\\\xaf)H\x87P\xef\x07nT\x9dKw\xaf\xbb+\"\xf8\x94\xfc\x85N[\x18\x1dR\xee\xb2\xd2\x1c\x9b(0\xc6\x0a\x8e\"\xb1\x0aD\xd4\x81\xcf5\xbc\xf5\xac\x93\x9e\x98\xbc\x90D\xe6J\xc9)\xef^}|%\x14\x98H/\x18\xaa\x85M\xa2f.\xbe\xb1\x08\xd3\xbd\xaf\xb8,y\x17\xaa[(\xd9?\"\x8b\xa1\x19\xf5\xdc\xcci\xcff\x18_00b\xdbp:\xaf;\xca;\xec\xfc\xbd>\xad\xe9MD\x07\x89^\x88Ei\x9b\xf4\xa8\x1c\x0b\xc5b0\x98|\xd3\xa52>:\xe0nC\x11\x0a@\x15j&Fr\\Dn\xb4n_<$\x9a\x11\xd4C\x86\xf0>\xb4\x19\xd69\xac\x08_\x12+~\x97\xf9\xcf#\x85\x1b\xeb\xf0\xf1t\x01)I\x16\xd9\xd2E\xc2-\x0dfE\x06\x96\xb97\\\x10\xf3b\"J\xc3\x7f\xe5\xca\x0c\x98\xc3\xc5o@\xa8\xc8\x8a3\xe4\x0d\x84\xfd\x14\x1aV\xcdh\xb5J\xfd\x06\x89\x84\xc0\xb1B\x8b\xaaRT\xb3\x82\xa1\xbf2j\x18\xf0\x9c\x16\x94\xe0\x94\xd9\xb4?\x12\x08\x9d\xe0&,\xf9w\x90\xaa\xbbn\xb2\xcf\xff\xe9#\x91j\xae\xf47\xccE\x09/\x1b\xads\x8eW:\xf1!I3\xf3|E\xb56ej\xb6R\xce9\xc7\x84OQ\xec\xd6\x00\x12q\xfc9RO\xba\x85\xb0\xe0\xd1\x07\xe7\xe7B\x8d\xf22q\x80\x95\xc5F\xad$\x18\x80\x00j\xc0\xd1.\xfa\xcc\xba\x04\"\xda\x7f\x02,\xf4\"s\xf4\x1e\x06\xcb\x18\xd4\xfb\xf4\x9a#\x04\xb0\x04aK\x92\x09\x83\x80\x99\xdeo\xfb\xd9SS\xf2\xef\xe8\xd2\xbc\x07\xca\xcc\xc7T[\xf8\xb6\x14\xe7\x8dG\xec\x81\xf0\xa1\xbf\xcd<\x11\xd6\x1b\x1f\x83\xcaQf\xe0\xda\xfa\xc543WZ\xaa\x18\x1f\xa0\xb5\x02\xe3&#v6\x09\xbe\x0a\xc6\xb0\xbe\xd1\xa7 :\x9f\x01\x81\x7f\xad@\xb8\xd1\x83\x8c\xf1\xb1\xf8\xaf\x1be\xf3\x15\xb8*\xcd\xd1\xa2c\x00\x96Ph\xe5T\xf6\x9d\x01\xb1/h\xefO\xcb\x00j\xee\xa0\xa0\xaf\x93J\xc7f\xff\x92W\x89\xfe\xc3o\x81\xe8!J\xaf/\x90\xc1\"\xebX\xa3\xd6\xe4\x13\xc6-N\x94\x14\xef\x9a\xb1\xb3i\xf0\x8b\xca:\x14R\xe6:;\xe8,\xa6\x86\xae\x92\x9b`\x19\xb1\x19\x10b\x08\xa1\xaa\xf8\xbc\xaa~\x08*\xd0\xa5\xe4\x0d\xcbd\x85\xcc\x9dH\xf3\xf7\xdc\xc5\"7\x98\xb6\xdcR\xdf\xd9\xb0\x17sUyU\xfa1\xd0t[\xd3!\x0b[n\xc9\xdc\xef\x1b!\x15\x15\x1dZ\x12\xbd\xdc\x01l3*J?\xbaE\x019]\xf4DdN\xa1\xa9\xd8\x12\xd5\xd3v\xef\xaaf\x98\x12\xbb\x8fm\xaf\xaf\xb4\x8a\x14\x1db\x82@v\xc3V\x8b\xaa\x8e\xec\xb2\x16u\xbd\xa7\x93\xbeZ\xd4\x9d`GQ#\xe1\x09\xd9\xbb\x92\xc6\xaadD\x9d\x98\xb0\xd8\x1a\x10\xcc\xd3\xcc\xe4\x9f3\x90\xbc\xb6\x94\xa9\x82SvkU\xda}Z7\xa7P\xa6\xd8\x88\x09\x9bK\x15?7\x1d\xeb\x95\x84d\x84N\xfc\xc4\x84\x80\xe9\xfcJ\xf9\x16\xc6\xf11\xb7\xff\x85\x1a\xb2t0$\x98\xf8o|wce\xc1\xcc\xdc\xee\x87cM\x87\x98;\xbb\x18\xe8\xe4\x04\x83I)t\xd9\x01\x0a,\x0d\xea\xd4v\x03\xdd}4S\xe8\xfc\x15:\x18\x8f\x1a\xe0\x88\x1e\x91\xf9B\xd0DR2}\x9a\x10\x0c\x9b\x1c\x0ad\xe2n\x13x\xe4|5\xfa9\x8b] \xc3\x0cW\xcf\x88\x88\x03\xa8\x85(\xaa\xfaTx\x03\xa4aX\xdeu\xb1Lw\xb1\xa3?\xf0\x9b\x90\xd7\x81-X\x8ew\x06\x0d\x9a\x11FV\x11?\xb1s\xec\x99\xfb\xbb\xb1z\xa8\x91\xdb\xf3G\x89\xae8\x03N\xd0<~\xaec\x0ej\x9c\x04XF\xcb.\x9d\x9bg\xcf@\xb4\xa9\x82\x0c/\xc2\x9b![\x1e\x9ee;&EB\x81\xf8U,\x0bW,\xb9:C\xcb\x99\x09\xecy\x0b\xb8\xb5a\xe4\xcdmA\xd2}\x12\x93\xd0f\x96|{\xd0\x01\xf4,\x0a\xddL\xd3\x14\xdd\x0e\x82k^\xea\xa0\xbd\xf4i\xad\xa4\x90&\xc2\x8evs\x89\xa1 \xea\x1b\xb4V\x96(\xf9Oh\xe2\xee\xee\xb8\xfb\x09F\x0e\x95\xda\x95\xdc\xf7\x1e\xb2kBJ\xd37c?%0\xd5\xd4\"\x9d\"\xb5\x96\x17,9\x84\xf5\"\xe4\x96\xfd\x15B\xe92^\xc3a\xac\x08#R\xd2\xfa\x11\xc2\xbaBN\x9c\x18\x12\x88\x95\xb2\x13" +
+	"\xc1\xc05U\xda\x08\xf9\xdfW\xe5\xd8?\xca#\xca\xb2\x87\x9dm\xb6q'\xde\x95\x0f\x96\x18_\x1aVK\\\xc8\x7fE\x92\x0b7MA/\xcdTH!\x88\xc0\x82J\xfdL\xa3\xf3\x15\xe1^(\xb9}\xe3/h\x10PI\xf0\xd9\xed\xb4B\x1c\xefT\xeb\xcb\x08'S\xf5\xc0\xf6\xc3\xfa\x89\xae\xde\xc6~\x9e\x97W\x02\x0bJ\x8a\xcb\xe2\xd4\xe3\xd2{\x15\x15\xf0\xcf\xd8L\x8d0A\xd6\xd8VV\x90\xaa\x1e\xa8\x85W\x0a\xe2\xff\xc8\x08\xa7\x94\xcb\xf9\x98\xe4\x10FB\xecU\xf6\xad\xb8ZS\xfa\xd8\xbe\xb9\xe0k\xe4\xd9*\x07a\xc6-\x9c\xe6\xbf\xe32\xde\x9d\x930\xdd\x8d\xef9\xf9\x8am\x84Mg\xdf?\xc0\xcf1<\x99~p\x05\x98\x11\x8d\xd9*\xfb;l\xf9\xda\xb2l*\xe5\xf5\xaa\xfa\x1e\x0f/\xc6\xf1-\xbc\xd0_\xf2f\xbb[\xb6\x9f\x02\x8c\xee\xacY\xf8\xf9\xc2\xbd\xae\xf6\xefHW`\xdex3\xe2\x13p\x0dj\xcb`NV\xac\x1e@o\x9f0\xe1!BzE\x11@\xd5\x0c\xcd\xc0\xa0y\x08]\xe9\x05#c%M0s\x1e\x86\xaf\xb9oc\xd4\xca\xae\xee'S\xdd\xb3Sz\x8d\x0b\x9d;7S8\xe1X\x85/\x80\xc3g=\x13]\xb9WQ\xb6\xa2\xc2,u\xd9z\x16^\x0dW\x12y\xa2\xf6\xde\xe3~\xdeF\xf5a \x11\x14wq\xad\x0c\xd9$\x86J\xcc\x06C\x90N\xa7\x9a]78ns:\x90X\xbdV\xa7\x16\xcbI\xcc\xa6\xc4\x87u%\xac\x1aU\x9b\x8a\xbee\xd588\xf8\x80N\xe2mS\x88\xfd*r\x03\xb4`\x87\xdf\x0a\x9f\xb0Bg\x19Y\xef\xfa.v\x93\xbd\xe3\xe9\xf0\x0f\x8f'\xa9\x15\xcf~\x1fxD\xd92,\xd1\xce\xd2\x97#U\xf3[U\x08cM\xfe\xfe\xb7{d\xc9\x01\xb8G_\x08\xf4/\x89\xa0\x93\xec\x9dF\x85sA\x05\xd6\x8aJ\x94S5\xd2c\xa1\x84\xbc\x08\x0a\x17Y\x0a\xbdt\xbeI\xe0\xc5\xad\xaf\xfcm\xd3\xe7oy\xed\xc7\xe1k\xd7\xb4\xbb\x1c\x95\xd91\xf0\x04\x97\x98.\x8e\xa9\xc4v%T\x1f\xfc`U\x8e\xa7\xf2\xd1\x8a\xf2<\x82\x96\xff\x0f\xcaP\xe2\x0e!\xd8Q7\xae}*}\xd8q\x0b\xafB>\x9c\xf6?\xe8Ag<\x9c\x13\xfdpR\x07g/YR=\x7f \"\xa6 \x05/}4\xa4\x19\xe1:HX\xc4\xa2\x82\xde\xeb<Uz\xf5w\x035\xec@<\xef\xff\x9a\xb2\x19\x937\xb0\x92\xdf\xa71\x08v5\xcd\xd4]\xfb\xf9\xce\x98\x14\xb1\x13\x9a\xf2\xb5\xb8\xf5\x81\x84\xa8\xeb\xcf\x16\x83\xb4u\xb9<\x02,0\xf0%k\xb9\x173\xbbt\x8f\x13\xeam7\xaa\x95b&g3\x84\xf4|\xcd\xe1\x94\x1a\xc0\x85\xa9\x0a\xf8Z\xaf\xd2\x91X\xfaM\xcf\xdf\x90\xe1\x88r\xf3\xa8\x88\xdaf\xf0(&\xc5@^\xd3\x0d\x06m\xa4Zhi\xb0\xe9\xa9\x94\xfem\x86\xf5\xaa~\xe8\x85\x9b*\x87\x02\xc4\x92<hv\xdf\x08\x11a+\xb7N\x08^*6\x95.\xeb\xbb\xff\x083\xcaP-\xb9A\xd0e\xc7\x96\xda\xbb\x01\xa9\xc3<\xe2\xe8\xa3`&R\xd1U}pe\x1e\x8a\xf3z\x85\xf0\xb7P\x0d\xe8\"\xa4\x8f\xd3\x0f%\x04\x0b.\x17:\xc4\xae\x9e\x1e\xe5\xffRk\xaa\x1f\xeeIgA\xc0R\xfc\x91L\xc9\xd4\xfb\x92\x0bz^I\xb9\xeb4\x87b-\x12\x88XA\x9b\x08\xc1>\xb0s\x8e0\xcap\xed\x88h\xe65\x88\x16\xc9E\x85\xcb\xbf\xf3'RXU\x14\x7f\xccf\xa6\xfc\x0c\xa1\xbb\xa4?\xadb8\x1a\x1a\xa7\xf7D\xedy|\xbb\xd5\x8b(gF;[\xf4>\x07J\xae\xba\xeb\xc9\x1f\x05\x83_\"X\x8a\x9eG\xd7\xd7\x80\xf8\x0dh\x95~\x955\x9cI\x84\x93\xae\xde\x88\x93\x96\xcf[\x156\xd2B\x02l,B\xe3\xffg\xc6[\x08\xb1\x8e[h\xc2WRId\x82\x84z\xd8j\xe3\xefA}%\x1cV\xedW\xa0\xd5V\xf1\xecI\x7fAr\x8dI\xca\x12\x04\x0e\x98\x95l\x96\xe5\x9f\x0a\x8d\x05C}\xe6x@UB6\xf8QX*2\xe2\xcd\xfc\x94\x0c\x0e~\xc8'\x93\x87k\xb3ulU\x9d\x86\xe5\xdb\xb4\x17\xad\xbbyA9\"\xb7\x1a\xfd>\xf0\x028\xc0\xae\xb7\xdc\xe5\x04\xf5\x07\x16\x08\xb9\x12\xe7/\x10\xa7\xd1\xdd\x9dN\xbd\x09MR\xa5~\xab0J)\x95\xe1\xfe\xa5\x94B\xfb\x9e\xf1\xf0\xce\xf4B\x15\xcd\x0a\"#\x91XY\x98\x08\x8b\x90o\x05\xf3\xec\x09\xae\x89\x98\xc0\xf0!4\xb1\xe2\x1fBd{\xe3\x81\xcd\xf0\xa1+\xb1b\xb7\x19\x15B\xa15\xe2c\xe0\xae[\xdc\xfb\x14>\xebh:=\x87\x87y\xd7\xcc%\xf7\x99\xe9\xdd&\x10\x8e~;\x06G\xa6\xb1\x17Y\xd3<c\x11\x9b\x9d\x06\xeb-\x00eX%s\xf0\xabtl\xeec\xfe\xfa+\x0cN\xec)\xf4\xdaa\x83\x16(\xa9RO\x84\x10I{\x1f~Y\xd6\xcb@\x19\xdc\xebPTz\xfe\x8f`\x0b\xc4k\xba\xd7!L\xce\x12.\xa0\xae\x81\xaa\xad\x09!\xfd\x0a\xfb\x8d\xb2\x81\xa1uQ)L\xd2\x0fQ\xdc\x12\x08\x95\xf5/X\xcfVL\xa6\xb7\x89*\x99\x8d\xc1\x00\x04h!\x8b\xf8j\xe7\xa8\x90\x9b,\xbc\xca\x02\xbds,\xec\x8fIKV\x08\xc6\x08\xad\x09E\xacL\xd7\xc4\x9b\x05\xfey\x11\xd8j\x0a%\xf4A\x87VDR\x09]\xcbp\xaf\x11\\c\xb4\xba=I\xe6\x06qF\xc2Q!\xf1\x0b\x1a\xa7[VA\x1f\x1bIe\xb3\x02\x07\x12IF\xdd\xd5G6\xcb\x8c\xa5P@\x91\xf1\xbc\xb0\xe1\xca\x04>\xd2Yh\xbf\xe2a\x1e\x95\xe6\x03\xf1\xf2X\xa6\x83\xba\x00\x90\xf0\x97\x04\xad\xca\xe5\x00Z\xbc\x85\xd8\x00\x0c2T\xc5S\xf1\xa1\xa1j\xe3\x91\xdb\xeb\x0c1\xfaM\xcf\x80\xd4\xdf\xf3\xa4\x0f\x92\x14y\xb64\xa2'\xb4\xcb\xfe\xa3\x96EQ}\xc5z\xa1\x10\xe9J\xc3\xf17(H\xdbw\x1b\x87\xbf\xd1\x83R\xa7\xcb@\x85\xe3\x19,[L\xb1\xe0\xb6\x1ct|9=\x9b\xa4w\xc0\xa5o\x02\xcal\xbf\xf3i\x1cu\xac\xd4=\x0b\xb4l\xc9\xde9\x13G\xb2\xf7\xd1@\x10\xe2}\x16T\x93\xab\xd0F\x8bd\xd7\xeao^\xb4\x8eJ\x81 \x8e'V\xe9\xa9\xf5\x83\xe7y\xe1\xf5\xa4\xbc\x15\x03]\x9f \xf3/I\x99c,\xd9&c\xc4\x8a\xc4dg\x98\xa3=\x0d\xbd\xe8\xc5ei~\xa4i\xde\xc7*%\x86\xa7Rv\xeb\xbf\xf3\xba\xb6\"Y\xdd\x92o3P\x15\x0b\xcdS\x84rMs\xee/#\x86i\xc4\xe5\xb0?63\x06\xb8\x84\x8b\x8e\x0c\x16\xd3\xe4\xeb\xda\xcd7\x10L\xda\xaaj\\;\xe6\x9aA\xb0\x84X\xb8E/\xf0g\x12Wx\x04\xabTN\xff\xf8\xd1\x06\xa6\xfad\xcb\x06\xf3V\xe0\xdcU\x05^w\x98\"\x85\xf62\xda\xaa\xbe\xd7z\xdc\x1e\x85A\x9bB_\x81T3Gb\xb0\xaa\xa8CR9\x08\x96\x99\x84\xd4\"m\xf8\xe3\x9c\x85\xe3D\xe5 \x1c\xdbX\x85\x89\x85\x85<=\x10\x9c\xfaJ\xf5\xe1R\xbf\x83/W\xf9\xbf\x04,\xbc7s\x89\xfdn\xc2\x0b\xc3\xab\x9b\xcdQ\xa9b\xb5U\x09cg.\x09A<\xfd{hHr+P/<\xf6B)\xaf}\xdb\xcb<5/\x93P\xc9\x1e\x9a\x11Q\xba\xc1\x1eB_E\x91U\xdd:\x135~6\x85\xc0d\xb5O\x16\xd2G\x08\x13\xb0\xb67\x11\xe2\x17\xdd\xc14a\xa6\xfb\x0b\xa6\xc9\x82\x89\xf0\x13[\x1a\x0c\x88,\xdd\x9f\xd3\xb0[v?\xee3\x1bI\xd9}\xd1g\x8c>\x98\x81\xe4y\x1el\x93S\xa6\xf3\xfe\xa6z\x87F/Ce\xa8H\xadqy7R\xaf\xban\x04\xde\xb1w\x80g\xe4\x9d13\xad\xac\x9b\xceM\x98\x8eGL\xcba\xc4\x9a\xa6gE\xbe\xc33\x04\x1e\x90\xfb\xb6\xde\xd7\xe2E\xdfH\xbf\x81L\xe4\xde\xdds\xd8\xb7\x1e\xcb\xa4\xe8\x8e\xd8\x0e\xc9z\x00I#\x03\xa6\x9c\xb3\x13\xa9\xd4#\x04\"\xe5k\"=\xcb\x1dY\xbb\xe8\x1cu\xde\xe9~\x1c\x99\x85\xfbv\xd1\x8c\xed\xd0\xeb\xf7\xff\xd1\xdbh\xa9\x96\\\x0d\xecK\xf4\x10\xdb\x8dG\x84\x94B\x82\\\x18\x1f+\x1d\xc4\x8c;=\x101w)\xa9\xfaa?O\x92\xf22\xe5\xdfK\x09s@\x87@\x97\xb5\xbb\xc1.O\x19\xb6\x10e\xf7\xb5/\x0c\xdc\xd7\x99T\xa2[\xd51C\x8d\xe3\xa0\xcer\xedwG\xfa\xbb\x0b\x09\xb4\xf3\x88F\xd0\xb6&\x91V\xf7LcX*\xed\xf4\xf1\x13\xd0\xcdk{\x92\xd8N\xf0\xae\x9a\xc62w\x80\x1c\xa9\x16`\xf6x7@\"\xfb\xc8*\x19\x1em@\x8d\xeeQ\xc9\xa4\xfb\xe5\x8a\xd17\x88&s\x03\xcf-\xaa\x80\xa9Y\xc0\x829\xe4@\x83\xc1\x08\xed=\xd7\xbf\xf0\xcb\x0f\"\xc3.\x95\xc4\x08{\x99W\x00\x0c\x1c3#%\xac\xb5@\x89\xbc\xa2\x96C\xe4\xbb\xe4%\xc0u\x09z\x1b,\xf2\x7f\x11\x90\x10\xda\xf1S\xd4\xff\xaaOa{/\xe1\x9d-\xc3\x82\x9bf\x1a\xb2\xd2\x86\xa3}\x84j\xf4\xd2w\xb4\xab\xc5\xa8\xd8\x04IS\xffD\xa9\x00\x17NwSO\x84\x14\x1c\xcb|pK\xac\xd6\xa5\x16\x8f\xed\x1baV\xdf\xe5\xed!\xe7\xbe\xc2M\xb9\x08Qh \x17\xb9\x9a>\xc9\xc8\xaf\xd4v\xd8h\xb9\xf3\x05\xed8\xcc\x9f\x88\x14\xed\xbb5\xcdX|\\\xa5\x82H\x0b\xa9\xe7hr\x15n\x90\xa9\xc6K$yI\xb6\x97\xb1\xce\x0c\xbc %\x0b\x82a1\xdd\x0f\xc1\xc1\\!\xbc\xf9K\xaf_\x02\xe7(\xdcI\xf4\xc6\xafh\xf8Y\xf1\xc0\xb2\xa9R\x81@\xbd\xcd\x1d\x19'\xa9d\x84YN\xe1\x99\xc3\\M\x88\xf2\xfd\x1f!\xed\xe2:\x86c\x81M\xfc\x06\x83I\xe9g\xe8\x15\xb3\x88\xad\x98\x05\x91\x9e\x9e\x99\xc9i.\xf2\xb3}\xa9(\x856\x06\x1d*\xfc\xa0\x1d\xe2\xf5\xdf$/k\x08\x88\xbe\x98\xf1\x02\xcd8\xa3\xe7\xe1n\x84\x1c\x16d\x01#\x96;\x8d\x93\x8b$F\xe3rRF\xd4\x9aM\xed\xfaM\xf9\xd0\xfei\x0e\xe4E\xb4X\x8b\xe8\xfb\xa6W+\xe1\xcf3\x0c?\xc5\x06\xa8_}E.0\x17>^\x0ff.B6\xf1(<\xc4\x03WO\xe8\xc4\xc5\xfa0\xc2)6G1\x1ay\x99\xac\x93w5\x08\xfa\x13\xb1\x02{T\x1a\xc7\xbfC\xaaD=\xbbq|C\xbbF\xb8\x0ai\xd4p\xe5G\xe4\x03\x96\xa0\xfc\x8fB\xff\xf5\xc5@;\xff\xfa?\xad\x9b\x12\"2\xe8\x10m\x1c\x08\xf5\x86\x9b\"s2\xb9)\xea_0\xb8\x0a\xd4l[\xf88\xb4\xa3\xcf\x05\xd5\x1a\xcd|\x813E(\x18C\x16\xb9@\xdeO\xe0\x99$L\xc4\xd1I\xc6\xd5\x0c\xe0'\xf5c0\xf8',\xb2aO%\x96\xe3\x19\xd5<\xbfH\x99\x86\x8e\x1f\x92E\xd3=AG\xdb\x13\xd6O0%\xb6@\xd3SV_n\x11z\x85\xda\x121\x80>\xc3\x8f\xc4\x9ft\xd7s\xec\xdbK\xe6#\xd8?\xe4 \xd9\x80v+):\xb3\xbe1*\x02:(A\x09C\xd6\xb1a\x19^\xe6\x8d\xc2~*]\xfe\xa6\x8c\x8f=v\xf0\x0bQF\xbfCU(\xaa\"at4\x04\x98!\xa3=\x9e\x06\x96\x9eI`i\xfdP\xdf\xd7\xeb\xf6*\xfa\xc0\xa5o\xa7Q\x08\xd2\x03\xe14+Z\xf0S\x12\xe5\xd5`\x07$\xc9\x9d\xc1\xa1\xa7\xec\x80Bu\xe8\x0d\xc6e\xf5\x96\xfdM\xc9\xc1\xe4?A\xc1~\xc0\x83v\xb3\x146`\x11u\xbd\xe0RE\xe5'\xcc9\x18`\xf2\"h\xe9\xa2\x9a\xb5$b\xbbU\x19\x06\xf3\x1c]\x021\x9b\xa3\xe2\x8f\x8d\xdc\x8f\xd0*\xb4R\xc4O\xbe\xa8\xb1\x11c\x94yS'\xa3\xb0\x0csz\xd1\x84\x16\x17\xfe\xecY!9J\xce\xd9\xb8 \xb7\x95\xd4? \x19\x9e\x0a\xf7Z\xf1\xe0E&C8F5\xb0#Z\xbf9\xd2+w\xe4\xa4\xf6\x00<-\x9b\xda\xc9\xacE9\xd3U\xb1\xd8\x9d\xa0x\xf1\x05\xe9\xca.HWz9\xba\xea\x87\x1b\xf7>O\x80\xd1\x88\xd6\xe3\x88cBZ\x11\x80\x1e\x81\x81\x96\xdb/\x850Q{\x89\xcd\x08P\xf8\xca:F\x14\xdd\xb6 \xdb\xde\xef\x01u\x14-\x1aF\xd8\xd4B\xd8\x1b&a \x9f\xea\xf7\xcf\xd2\xef\x9f\xc6\xd6\x9b.1WF!J\xa4\x90\x8d\xfa+\xdb\xa6\xbf\xca@\xc4\xbe[\x88\x93\x84a\xd4\x8aC\xfa\xdb\xbej\x01\xf4\xca\xa2\xfe\xc7[\x15\x14\x01\xebP\xda\x82\x0cT\x8d\xc4\x7f\x12\xe2K*\xc4\x8f\xbak\x059-.\xb0x~\xe1C\xa8\x03\xc0M\x1e\xf2G\xe2\x05dJVi\xfd\xbeNF\x81(Hvx\xcc@\xf0EOH\xe7\x1a\xc1V8\x96\xbd\xe3$\xa3^uB\xae#\x86.\x8a^\xcf\x00\xdd\xca\x8d\xd1\x98\x9ant\x0b} \xed\x87e(\x97\xa28t\x1a\xb0_ 0\x89\xdf\xb1\xaa@u\xe0\x91\x80\x83~\xa3U\x7fb\xe7\x84\xc0n\xc1A\x1d1\x86(\xa0\x94\x1f\xb4k\xb2[\xae\xe7<\xe7\xc2\xd5W\xd5H)\xdc|\x96u\xfb~@\xefN\xa9\x86\xe2\xa2P0\xd8\xc1B\xb8\xd2-\xb7\xab\x0c`\xaf@\x04\xbc\x92\x92\x01N\xc5;\xact\x07\xe6\xcf\x91\x06\xec\x1d\x00r\xdcO\xa5N\xb2\xf5\xab\x0ec@\xe9\xf3+ \x10K\xe8\xf3\xadPu\xea\x81\xa4x\xf9\x07\xb1~\xbej^\xa1\xfa\xd5\x9d\xd2o\xb6\x12\x91\xd8\x90\xf8E\xc6\xa6j\xd1\xf6\xc3\xd3\x17[\x0c\x14o\"M@5\x84\x17n\xa4\x19j\xec\xfa\x8e\x8fD\xd4\x92\xb6P!\x1fK\xda\xea9\xc8\xda\xbc\xaa58\xa9\x95\xfa\xe0\x82\xac\x1b\x85\xbb\x12\xc7\xe8u\xdb\xfd\xd4CD\xed@0\xb6\xaf\xa5\x99a \x91\xf3\x18\xc8\xc8\x93<\x9d5}\xbf;\x070\xaed\x82\xe3Y\xa2\x84O\x86\x1d\xef\xde\xc7\xe5C\xc4>\xf4Z\x7f\x0a y\xa5a5\xda\x8atclc\x02\xd2\xa1\x89\x11L\xe7\xc0Po#\xcd\x0d\xb2\xe1\xe0O:O\x0d\xe3\xab\xf3\xb4l\xb3-Bp\xa4]\xd7Ox\xe9\xee\xc8\xb08G\xa9\xc2l\xa0\xea\x96\x84'\xcb<`\x9a\xafP\xd8_\x82\xf9\xea\x06\xa7A\xe5\x0b\x0d\x86BJ\x1bB){wE|\xf7\xaa\x06IHj\x81\xfc~\x1c\xd7\xdd\xdb\xc7O.\x09\xe4\x8c\xc0\x0fH\x14\xeb;\x17\xb1\xaf`[Y\x0ax\"\xc5\x0b\x91-\xc9_\xa9\x1f\x19je\xe1<-\xf1\xc5\xfa+\x0e\xeb y,@\xd2p\xa3\x1d\xb7i\x86\xa0z\xe1r\x00A\x86?Q\xc9\xa2\xfd\xf8\xa7K~\x18jeC\xdc\xc1\xa3\x88\x90\xc4\x10\x16z\xc8\x05\x8b\x9fLx\xbd!\xcf\xcc\x09\x01\xdfHM\x94\xb5p\x16\xda\xa1\xe1\xbdu\x89\x15JA\xa1\xb7jn\xe0\xbe\x97'|\xc1\x18\x95\xd2\x18\xd5\xb1A\x16\xb3\x8a\xacM\x1a\xde8\x09\xe8\x96\xcd\xb4\xfe\xca\xd5\xef\xc0Y\x94\x1f\xfc\xb3+\xdb\xf9\xa0\x8e\xd0\x0c\xe7\x10\xfc\xfb\x90c,a\xba\xe8p\x8bY\x8c\xde\xd30\xb3[\xc3T\x1cER\xeb\x88o\xce\x0a\xd7\x07\xfeA\xfaU\x98\xa8J\xb2\x04\xaer \xdf\x04\xcb\xe5\x9aJ\x88E-y\x8e\xb2\xb7!\xa3\x81i\xab\x8a\x091H\xb7\x0b\x81\xe4\x15zN\xd8s\x11\xa4\x14m\xa48W\x9d\xa8*\xbe\xe4\xe5D\xe6i\xc8j&\xda\x91\xa7>\x0c\x81\x98\xdf\x9e\xad\xf9Tr|E\xe8'\xb1\xd7\xfa\xc6\xc4x\xef\xe8\x8d1O\xff\xaa\x9c\xcd^0\xb8!m\xaf\x8a\x8e\x92<>\xa8\xc7\\\xd4\x8f\xf2\\\x96\xb3\x14\xba\x14\xd1^,>\xca\x11\x96\xe8\xdd\xf3\xb4\xe5x\xef\x9eg\xc0\xdf8\x04\x80@H\x96\x97\x09f\x9d\xbbYj\xc8\x05\xa2O=\xea\xac\x85V\x87\xec`g\x84_\x8eII\xbe\xb1}}\x95\x03\x8b\xf6\x8c-\x1fm\xd1\xc3\xb0\x82\x05\x1a\x8a\xb4\xb1\x0dX\xb9\xee`\xc0%\x8e\xa9Q~7\x18\xcdo\x10Z\xc3\xcb0\xf2\x03\xaaA\xe4)\xf3!\x13\x86\xfc\xb7\xac\x08^@\xa27R\xf5\xcaHDf\x8d\xeeE\x19.\xd7\xdd~\xef4\xcbfB\x8f\x12\x82\xdc,\xdf`*\x99\x1a\x16\x8c\xdb\xba\xea\x07z\xf5\xf7}\x8e>a\xdf\xa79\xef\xed\xf5\x9c\x8bKU\x02\x9e\x0a\xcfu\x0f\xdd\xe5\xea\xbfi\x940I\xc5\xa3w9\x90\xc5\"\xe1\xbd\x16\x0b\xb7\x9e\xbfW\xd1\xdc\x88j\x04R\x7fA\xd2\xbfpbI\xabJ\x9e\xb6Wt9\xae6y\x95\xd1T\xb8\xd5fc\x13\xd8\xf6\xa6\x12\xfdRr\x80\xb6\xa2\xe26\xe0\x9f\x946\xc2\x1a\xca\xf1[?l\x90\xa0\xf6\x98S\x8eg\xd8\x0b\xe36\xaco\xf8\x0aX\x8cm\x05\xb5\x0c\x17\xb92n\xc8\xc5\xf1X\xcc\xfc\x18jUC\xe8\xd0^\xf5\x97\xde5\xf5\x08qeA.\xa8\xf16\x14\xee\xd8\x81\x81\xaf\xddv\xc2o\xa51\x83\xff\xb1\xc7\x96\xdcw\x98\xd6\x1ew\x1a\x16\x19\xff\xa1\xb8\x1a\xd9\x84z\x1a\xb27\xa7\xa1W$R\xea\xbd)\xa4f\xa4@S\xc9\xe2\xea\x8d\xf9P\xe2q\xb4\xd9\x03\xf9\x9bv\xdeh\xa8\xafo\xbcr'\xb0r?\xc6\xaeL\xa4" +
+	"\xe9|\xe9F\xf2\xdb\xdb\x8eb\xab`\xae\xec.\xdc\xd8^\xc5\x7f\x181\xadx\xf2\xcf\xb1\xbd\xa1\x014\xbf\xfa^$\xef\x04+RL6\xcdx!n\xef\x1bfj/\xdf\x12\xb0\xf9\x9a\x96\x1eX\x16\xae\xf3\xca\xb1JSW\xccD\x91\x93\x07#\x06\xff\xf3\x92u\x9dX\x9f\xf2RI\xfa\x9bgHn\xd9\x01\xbb\xa8\x99A\x0e\xbd\x14\x08\xb5\xfa\x81\xf6\xa8\xc7\xa6\xca(\x12\x1aO\xa1o~\x07\x05O:\xe8\x06\xd1\x19\xc7l\x03;\x00\x9a\xa7_\xd4\xe0\xd7?z\x975\xae\xd5y\xcfE\xe7\xa1\xabL\xd2\xb1\xde\xbf\xc4\x00\x81\xc6\x1781\xcb\xa1\xc9O\x07\xd6\x9e\xfa\x11\x87\xc2Dp\xe2.Cz&=\xb0ob^\x959\x15\x1e\xb9\xb8A\xc1\x98A\xf7Z\xb6\x99,n!\x9fx\xe0\xe4\xdcKB=p\xa6)i\xe7\xe4\xee\xfe\x95\x82\xc0\xc7\xae\x8b;\x08\xe89\xed\x93\x83\xca\x0e(\x18\x08*%+\xb1Q\xc1\x17RI\x9f?\xbe6\xd799t\xfaF\xfc\xa2\x83\xc1-#\x9c\xe4#\x81z\x9c\xaf\x1e]\xf3\xbap\xa5\xb7\x8f\x88\xa92\xc0\x92\x18\xaeJ\x9bY\xcf\xa0?\xb2\xbb\x80{&sB\xeb\xaamJ\xd8\x86\xcb{B)p\x1er\x83\xa8X\xef\x12(\xa8\xd6S\xc8\x1f\xf2\xdaowDM\xcd5\xb6\x19K\x18lP\x14\xd20\xb1\x8dj\xe9\x191^\xa9\xd4V\x82~\xcf\xa2\x99y^\x08O+!=\xc2\xceI\xcd\x14\xe6\xe2\x14*y\xed\x15\x80\xcbw\x19t\xcc\x85M\xce\x03M\x93J\xba\xa2\xae\xfb\xdf\xdcI\x00\x12':\xe4\x18\x87\x81FN\xcb\x8f*j\x1cV\x01\x07bN\x18\xf0\xa9Db\xe37\xbc6\x0f\xd74\xfc\\\xef\x0f\xdf8eQ\xf5\x1c\xa8jWm\xbc!);\x00\xc4\xb96D\xec1!\xaf\xfc\xa3l\x1f\xb2\xcf\x97|\x80\xb6\xc5\xfeX\xf7\xb5\xe8n\xa2\xf2\x84A\xc0\xfaB\x09L\x19'\x8ch\xf0\x16\x82\x1e\x857\xbb\xb6\x80E\x80\x90\xbf\xd02\xd7$e\xda\x94\xeeY}c9\x0c\xaaS\xf8\x12\x91\xbd\x8ay\xe8*\x9e\xb74\xec\xefu<\"Z\x9aJ9\x1f\xae\x05\xdb\x03\xb7\xc4\x0d\x95K\xcfa\xf5U\x88\xdf\xab\x1c\xd2\x9b\xc6\xba\xcb'\x0a\x8f\xc0\xd7\xc8\x16\x8c\x9e\xe0\x15Fd?T\x09\x7f\x86\xafO\xb9\x1b;mF\xbe\xd8V\xd5\xaf\x14t\x13p{IF\xbe\xa5\xd8'\x95\xff<\x94\xee{\xefJ\xedK\"\x81cD\xb8{\xc9na\x11\x97>\xb1\xa3\xff\xa6)r\x07\xbb\xb1\xc5\x80\x15\x1c\xca\xee\xf8\x08#\x12f\xf8\x8e\x0bi%\x0ef\xf7[T<\xed\\\xaa\xf7\x8d\xd3\x1e\x05k[\xe1\x18\x07\x1b\x0bR\x81\xdcR\xc5\x0a'\x18\x92\x12Z\xfe:\x877c \xf2\x06\xa8\x1fq\xfd\xaa\xa4?\x93\xcbV?\xa3\xb9\x19\x07\xa2\xea\xc9)\x12\x12\xb7\xba\xbfs;\x8f\xb9\xda\x8f\xfd\xb3\xed$\xac\xc3\xc7\xc6\x04\xec)7\x9c\xa0\x0aG\x84W\x90\xa2E\xd5\x03E*\x1ba\xaeA[ \\\xfb\x0fld\xf9\xd5V\xe6\xe2\xd79\xbc\x04q\xbd\x8af\xe1\xdf\xe0\x8b\xc0\xd0\x9a\xe1\xdb\xcc\xe4\xac\x96\xd2\xa8\xee\xf2#p\x19\xb1\xec\xe6\xaeb\x925\xa0d\xce\x1e\x91\xfb\x15\x9a\x07\x94\xd1+\x95v\xc2\x1c\x19\xfd$;\xac;I^\xc3\xe1\xb0\xec\xbdBa\xbb \xd7\x93\xf6\x1cZ\x19\xac\x8a\xcd\xd1\x0b.]\xfa\xc7V\x80A\xc3\x82+y+}$rr\x9bH\xff\xc9\xa7\xdeW~\x10\xf2\xad\x9f|\xe4\x02\xe0\x9b*f\xa0I\x9e\xf7\xda&\x93z\x0bB\xb38w\xec\xb1=A\xb8B\x82\xd0\xfb\x16{\x08,\xdd*\x12\xde[\xd5\xb4\xbc\xd6U\x9d\xeb\x1c\xad~\\\xcd|\xc8\x09vZE\xbcnX\xc8`\x81+\xb0\xd5@\xef\xab\xee[\xa03\xb3p\x97\x95\x9d\x8b\x0b}\xfd;E\xd4\"\xbd _\xebdQ\x9f\xeb\xde(\x81\x8f\xd1=z\xa50\xac\x81\xe1\xde\x19\x02f\xcc\x19\x95\xda\xe8\x8f\xba\xbe2\x11C\xf2*Ca\x9e\x88FT\x94\x0f<r#9i\xc4\x93w\xda\\s\xf6b\x0d}5\xfd\x01\xb9\xd5\xf537\xda\xa7\x18zsgKm\x11K\xbf\xa4a\x1a\x1f\x9f\x8b>,|\xe9z\xe3'\x91\xf2?\x9eI\xbd\xd9q\xbc\xfc\x13\xb8\xfarg\x93\x87\xe0\x95\x16\xb9\xa3U\xd2\x14\x01\xddJ7\xd0D\xf7\xbc\x18\xef\xe0t\xd7Tn\x97\x8e\x85^\xb7\x93\xd8=h\x13z4)P\xff\x11\x8b@\xab:\x1f\xc8\xfd\xcfF\xef\xc0d\xc1[z\x8aV\xf7l\xb5\xde\x91\x08\xf1]\x1b\xbb\xb1IZZ\x91\xe4eQYt1\x00\x94\xb0\x7f!\x8f\x90\x19\xf9\xcf\xd9\x82u\x89\xb5\xacW\x9a\xdd[\x15\xaa\xb8_oDq\x82\x7f\x82\x0b\x18\xd6\x86\x96\x1d\xcb\xc2\xe1\xa7\x88\xf9\xac\x0a\xb9\xe6\x90\xf4\xa2\xbe\x17\xdf\x89\xb2#\xb6J\x14\x11\xaeY\xfa\"\xf6\x0c\xaeY\xfb\xdc.\x06z\xc3\x95(\xfe*\x90Rv\x7fT\xd3\x94&\xcf\xb5\xa6s\xa6\xdf\xfc\xe1~|\xab\x07\x84\x1dD\xa6\xb2;\\\xbf\xe0\xc3\xaa\x1f\x90\xbf\xdc\x9fy\xc6Ws*\xe9u<\xb1\x83\xb8F\xbf\xacn\xb4\xff\xcf\xfdc\x8eTA\x9f\x96zv\xd2JT\xd2(v\x9bj\xd8\x01w\x0c\xd3y\xef\xe1a\xf4Q\x01!\x06\xd6X\x0f\x81\x0b[;1\x09o\x94\x9b\xd9FzQ\x0fd*{\xa5\xbb\xbcS\x89\x80\xec\x83\xd2\x1c\xf2V8mn\xc4\x13S\x9e,\x13\xad\x87\x91\x9c\xdd*\xdclo;\"\xbcA\xe4\x01(\x1a\xfa\xdc\xcc\xcd\xa8\xef\xa0\xf4\x01\xab\x8a\xa48\xc9.\xa9\x14J bt\xaax\x98\xee\xd9\xee)\xae\xdeww!\xf3,\xb6\x1e\xac\xdbP\xdb\xbc\xf3\x93\x88\x191^\xe7\xd0\x19\xf9\xffgn\x0fC\xdaZ\xd0>\x13\xb7p\xd8y*(\xf6\xe5\x19$\xf0\xe1\xee#w\x8c*{=\x87;\x0c\x0fG\xe4\xf7\x0d\x97\xe7\x98V4\xe9=d\x07^(\x11S\x17\x0a\xb1p\x95\xa7Zo\xe0\x0a\x82<^\x90*\x91\xc3\xe5\xb7K\xf4U~S/h0\xd5\xdf\xf9\x090\xe3\xca\x0boW\xbb\xc8\x98K<G\xc1\xc82.PB\x81\xe2\x1b\xe5_U3\xcc\xea\x9c\xa1*\xac\xafK\xe9uk \xd5\xacK\xf0\xfb\xc9\x81\xb8\xbb\x19\xbeR\xb6\x0cP\xc9\xb8\x81h#\x1f<\x1e#\xbb\xd9\xe6\xf3/\x1a]\xbe\xf6\x06E\xf6\x8b]\x11\xc2l}\xa4DJ\xe7\xe4$\x10\xd9\xd8\xc4\xe8\xa92\x91\xa6\xbc\xeb^XA\xa3\xbf\"\xbe\xfds\xa0\xe5\xac\x10\x00\xd7}K\x87\xc2\xe1\x120\xda\x94\x04P\x07s\x15\xaa\xb6\x85xh\x85\x0e\x12M\xbd\x95\xf0\x02\x02Zy\x06\x17p/i=\x1bO\xcbeU0h\xb0n\x9b\xb2\x99\xc3)s\xda4\xae\x94VY\xd1\x05Wb[Y5\x04\xbf\x95\x95\xca\xb9\xdd\xfa?\x1a\x15W\x15\xa4\x9a\x0a|\x09\xa2\xb0\x82\xf5\x93\x83O\x0b\xcf \xc5_\x13\x14(\x8ah\xc5\xce~\x01\xcb\xfc\xb6\xff\x0d\xa9TH\xf3\x04\xea\x0dn>~P^\xb0\xb4o\x11Qf\xef=\x95\xee\xddk\x10I\x04eIq\xe6\x15U\xb7\xc8\xdf\xdf\xe3\xa0\x8do\x88g>\xfb\x0b\xe9\xdcc?\xc5.$\x04\x9e\x81\xec\xa0\x90\xaf\xa2\x82\x12(-\xf4\xbf\xdd\xfd\xbd\xd8\xb94vT\x81\xfa\x86\xa1\x0d\xe2K\xd6\xe3\xdd\xdf\x8f\x9d)\xbaE\xad\xa9\xdb_\x86N\xfa\xf1\x8d7\xa7\xb30\x84m\x94\x12\xc0\xf8\xe9\x130?=\xe5\x90j@#\xc0\x8d\xfd\xfa+L8]\x155\x8c\xa5\xc2)_\xe7 g\xf7\xa5\xb3S!\x91\xb3d\x17\xc2\xfd\x15\xe7\x05\xf1\x83\xb0E\xfe\x8a\xdd\x0bZ\x93H\xd5\xdc\xb8\xab\x0a\xf4}\xefH9\x0a\xe5\xb7\x1e}|\xcc\x80\xf5\xc05_\xc2\xf1\xe0;\xb2\xe0F\xa2?\x00\xd7\x93\x9f]E(U\xa5\xefH\xe5\x0c\xb7c\x15\x0b\xfa,\xe7W\x87\xfe>^\xae)=:\x84\xc9=\xf6\\7\xe2Q]\xe7\x9aR\x9fS\x98\x01\xb4\xfd\x04W\\<\x87[\xcd\xb3a\x8eK\xefmk~\xea-\xe1\xa6\\5!\"\xc7\xe6\x092C\xc4\xc2\x04\x0e\xe2R \xaa\x19\xc2D$/\x9aBX\xbb\x80z])\xab\xa9\x12\x02}\x9d\xd3\x8d\x18\x0a&\xe4\x92D+\xa9\x90\xbf\xf6\x1fs\x1b\x07\x96\x91\x17\xa1\xa3\x07\xa9\xde\x0b\x89:\xae\xf0\xaaA\xebB\xd6X%\xbb\x83\xa0\xa8\xed\xcd?a\xd2{\xde\x8b\xe0>\xf8\x144}\x04#\x8b\xb7\xdeJ\xbdb\xde\xd4G\x91\xd5E\xa9Z-\x14\x17\xb8\x14}5z\xb8eN\x7f\x82\xcf\x8e>!\xc0\x18r\xe5\xda\xa5%X\x05\xac#\x1f\x14\xb1\xa8V\xaf\xf0\xc6~\xe2\xc1X\xef\x06<h%pr)\x0dwH+P,\xbb\x7f\xe1\x12\xfd\x89\xb1;\xad<[B\xc2\xd9\x11\xd00\x11\x1501`W\xf7-\x00\xdcy \x01_\xc4\x03!\x01\xdc\xaa\x87\x88\x8a\xa4l\x0cm\xc8\xef\xbb\xf76\x8f\xec$\x12\xf2N\xb2\x81\x10kw*\xe4e\x0f?<\xa7\xed\xc0\xc3\x8b\xba9\x8f\x09\x94\xa8\xedt\xb9\x7f\xacb\x82\x07\xf8@\xa0R\xdf{9:\x19^\x91`k\xfc:\"\x1a\xce\x11}\x18+\x93\x17iq+}\x06\x9c\x9fEn\xb1\x7f\x88\x02\xf0\xa8\xe4\xf7\x17\x0b\xa2'l\xc3\xd4\x8e\xe2h\xf2\x16\xc6W`\xab\xcb\xb1o<\x94\xa3\x99\xe23f\xef\xd0\xec\xea\xbc\xb6\x13\x00\x05;\x0f\x03Y\xa0\x99\xa3Y`\x11\xf3\xf2\x00\xe0}\xea\xa1\x00\x19aA\x89\xe4UN\xfd\x9c\xc7x\xfb\xe4\xe5\xa3\x80\x11\xc7~p\xac]F2%\xea\x17\xa1|\x1a`\xbdH/\x09Wc0{\xe9\x15\xc8\x89\x06\xd5\x08\xb6\xbc\xee{\xa1\xb1%I\xc0>\xdbX\xefA\x0d\xbd\xe6\xbcw\x94\x89\x1a\xbb \xa2\x80_\x0a\xa94*\xfa\xd2-yD\x87\xea\xc6\x1a}&\x81$\xed\xd7\xfd\xf3\xf6k\x8c\xbff\x8c\x9a&YUv\xf9\xd4$\xaeUz\x03\x9cC\xe2i=\x07W\xb0\xf2\xac\x00q\x15\x93\xeey\xe8\x1d\xee\x87\xba\x92\xb8\xa5!\xbf`d\xd4\xc2\x88F\xf0*n\x9d\x04`\x90\xd2\xb3\x09\x89t[@O\x7f\x81\xf1\xabrYI:\xe8\\\xf1\x0e\x8f\"\x0b\xb0\xd0\xeb{\x85sIi\xde\x81\x80\xee\xd8\x96\x9e\xc9\xf7'\x080\x83\x8c\xafo\x01><\xb6\xa0.\x09\xe3 \x94s\xd4\x81$\xaf\xc0!\x04\x83\x94(Q\xef\x95\xf5\xd5I;\x88\x19\xc4\xbd\x9c#7`f\xcc\xa8\x04!o#}+\xd2\x97s\xda\xc7\xc5\xc0\x14\xe6\xd9\\\x0c,j\xa4-#\xbbIh\"\xd9{\xf6\xb3\xb0)\x99\x14\xe7\x8f\xf5\x9f\x99\x9c\x81O\xab\xea\xd0\xeb\xe0&LN\x1aw\xa4V|\xd8\xdan\xe2\xf4\x1b\x8bZ\x03\x97\xd5\xa8\xf1\xdd\x82\x0d\x97\xbf\x13O\xcf\xec\x86\x1dD\xa6=\xdb\xa0\x17rzp\xdb4iv\x84\xd5\xfd\xeeK\xb7\xf4\x84\x00\x9a\x8b\x0b?>\xd5@\x19\xd6LV+\x84j5g\xafC\xcf(S\x0exgTp\xa4\xb9x+\xa2\x1d\xbd\xf0\x92m\xd9\x1f\x83\x18\xfb\xe2\x0a\x95\x93~\xf5\x8f\xcb\xc3t<\x146Z\xe5r,:\x97\xd0\xef\xf4\xcf\xa23C>R\xaau\xa6\x8d\xa9\xdd\xf0\x06\xd48\x94\xe8\x16\xa8\xd4\x83\xa6\xe4\xdb\xe2\xfb\xa3\xe3\xc0\x84F\x0bN\xd4+:t}O\xd7#\xd64\xa2\x1b\x04\xe8\xf5\x0aY\xb9m\x1a\xcaj\xd1\xe9k9\xb1\xf1\xcc\xad\xf4s\x8bJ\xb2\xd2\xd8\xc4\xea\xe2\xb5i)\xcb\x03H+\xb8WO4j&\xfd\x97\x95\xc3?\x96\xb9=\xcc\x15G\x12s\xfd\xbdr\xe0{$\x0c\xbf,\x9b\x19#pxy_\xc1\xfbJg\xfeG:f\xe7\x0bb\x0bC1\xb1\x01\xb2)\xe4\xed\xbe\x93\xba\xc1R\xd4\xf7\xa9\xc6\xa9>\xaf\x06\x01\xc1G\xc7AV6)\xd6\xa8I?\xd7\xe3O\xb6\x8a\x0b}\x85\xabd\xb6\x16w\"\x90\xcd2\xa3\"L\xbf\x1f\xf86N\xa2\xc0\x9cn\xf7\xef\xabv\xffk3\xab\xd7\xd9\x8d\xfeWf*\xfcG\xfd|\x8f\x14dV/\xb1\x166.c\xf4\x17w\x9d\xd5\xc9G\x03\x0aW\x1b\xc7\xe34\xd3\xfa\xb9\xf7(\x87\x91@\x88k\x02j\xfco\xb1\xd3\xe1\x9fY\x8e\xda\xa7\x08\xf4\xce\xb1F\xce\x07\xf4\x9a\xbb\xfe\xc5W\x99]{\xbaBh\xedD\x0cS\xfa\xae0\x06Y\xb4\xf5\x84\xa0@\x94\x8b\x07\x88p\x8b\xc6\xdaU\x12\xc4\x19\x86\xee@y\xddy\x8d;\xa2\x97P\xc6\xda)\xee<\xa8a\x9e\xe7\xdeM\xd5\x1a\xc4\x16\xc9=\x05\xfc\xe0\xc6h\xa01\xef?\xf0^z\xe9\x10\xf7\xe2\xac\xf3\xbf+\xa4:4H\xd84\xaf\xb7U\xba\xbf\x0bB\xe0\x1b<\x97V\x1e\x19\x19_\x00\x8e\xefmU\x1e\xa4\x10s\xa5{\x9f[) \xc2A2@\xf6\xd6\xc8\x11\xd6\xa0y\xfae\xa6\x17\xae4V3\x08\x812p\xa2\x8at\xed\xa9\x95'\x1f\xf1\x0e\x1d{dfn@N\xd2\x85\xc1\x027p+a\xcf1\x0d\xe9]\xba\x97\xa2m\x7f\x072\x87\xfe\xb9_H;p\x86\xac\x0a%\x8b\x99:i\x11~iC\x01\xeb\xdf\x16i\x17\xea[\x89\x1e\xa8K6\x9eD\x91\xcfr\xebJm\xd41\xc9*\xda\xef\x9d|\x1c&\x02\xa8\xa4}\xfd\x9f\xbdS\x83\xdb\x1a\xc1\xde<\xa7\xda\xe5\x0e\xd8\x08wb.=v|3^\xb6\xbd\xcf\xda8\x05Z\x80:sX\xee\xbe\x03\xd8\xa4@\xbb_\x101\xec_\xd27\xfe\x90\xd7%\xc0\x02\xfcvFN1\x91\x9e\x9fvah\x07\x9e\xd4\xc4x\xc1}\x0f\xba\x80\xd7\xac=1\x03KMU,\x1d\x08\xe1e \xbd\xf56D\x83\x80'\xc3\x80\xe1\x8e\xdc\x93\xb1\x00'\xda\x96\xdf\xc1'\x02=\x97VM)\xafNK\x0b]\x13N\x1f:\xf6V\x14 uL\xad\xcd-3\xb8\xb1\x94^0I\x06\x8c,x\x81az,\xeb)\x821J-\xce\xf6\xdd]\x9b\x11\x82`p\x1f\x0c\xd0\xe4\xb8\xca\x84[P\xa5\xd2\x0az\xe0\x13S\x13\x8fG\x81\x10T\xa4J\x9da\xb1,\xe89e\x031\x90\x90\xed\xb7\xa3'\x05\xef\xd6\xfd\xa8\x11Y4\xb3^\xba\xff\x0f\x8f\xec\x995W\xe6\x17\xb3\xa0s\xee51;\x0b\xf6\xc6\x97p\xeej\xa5~F\x94\x89\x06\xe1/\x8a\x11\xfc\x18\xbb\x0a\xd4\xdcG$\xc2\xa6\xfe<\x86\x9c\xdb\xff\xef\x15\xa3\xe6sJ\x1d\xfbG\xc1[\x15\x9dr)\xa7YF\x81|\xd2\x92\x01_\x03\xa9\xc4f-7\xc79-]\xa5xd}\xcb\x09a\x84\xc8\x0bjM\x96\xedpr\xdf\xe8/\xe1\x1a\x8c\x05i/&\x0dG\xe7Z\x93\x897\x08\xee\xb9\x98\xbd\x80B\xd9^\xc0\xd6i\x9c\x1f\xa0\x9eB\xccus\xeag#\x0c\xff\x8b\xa0\xee\x1d\xe0\x00\x96\x80s(cY^\x99\x0b\xb0\x08\xcbG\x89\xe2\x94T-_\x85\xdc\x19P\xbfCp\xd1T.\x1awa\x05\xef:\x03AAQ[\xdc\xd8\xfe\x19\xbb%>\xda\x9b\xe2:M\x17Vx]+*\xf7\xad\x8d\xb4\x1b\xe5\xc9}\x15\x07\x05\x9b(\xd9M_\x9d\x88u\xd1U\xd1\x16j\xbe\xb6pk\xc2(>V\xe5\x99\x1f\x99X\x0a\x0ela\x81\x03TF\xa9\xf9y\x0fB\xd2O\xee\x8d\xb1\x10\xf9\x9d\xc5\xd0\x83\x83\xcc\xd7\xb7\xa26\x10x5\x93Z\x9e\xd4,$\x00\xb8\x92\xe0\xcc\x01[\xf8:\xd3\x82\x9c\xafWRSL\"\xe5\xaeu1\xab\x12M\x03\xdbg\xdaI\x7f\xf0\xe0(|K \xca\xca\xa0gR9QV\x9f\xb6\xf7*_L)\x11\xebw|\xb4L8\x86\xbc\x16,\xd6\x0aS\xab\x9d\xb8\x83\xb1\x97Eh\xd3\xdeK:\x09/\x89\xa5\xe5\x04&\xcd{\x95y\x1c\xedT\xf2\xfb+\xd6\xc0\x8c<\x9f\xf6?\xeb\x17x\x90\xafY\x89\x1d\xc3/\xf8\xa0\xaa\xa1\xbcA\xb9\x077'd\xfd\x12\x08sA\xe4R\x0d\"\x03\x11\x14\x1c\xecOE\xcc\xfa!\xe8\x8c\xe0&\xc4\xceAf\xb7J\xfe@\x8d\xafe\x16\x9e\xff\xf0S|-\x92\\\x05\xb5\x16\xb1\xe3\x19\x0b\xf5q@\xcc\xf6D\x00E\xa5;\xff\x0a\xcc\xda\xfe\xb8\x84!\x17\xe9\x91U\x0e\x02\xb6yi\x94\x03\xe7 q\xb3\x02NL\xcfI\xf7c\xeb\x9e\xe8\"43\xaeE\xfbv1\xb7\xaa3*\xd6\xc1\xe0\x06\x10`NS\xf7\xa0gXs\xff\xf1J.D\x81Ad\xbd\x81:\xc9C\xdc\xc2\xb5\xc2\\G\xac\xdd>\xfb\xad\x9aQ\xffe\x1eF\xecK@\x0b\x19{w\xed=\xb7C\x0b\x9c\xa3\xcdv\x1a\xa2\xc77T\x19}%\xabH+\xac\xbd!3\xfb%\x85\xe0\xed\x12\x07\x97<\xc9\xe33\x0e\x09B#2S\x894i\x9f\x06l\xc2\xb4\xfa\x8b\x82]\xc2\xab[\xad$s0;\xda\xd2MdB.\xc4y\x84,w\xcb\xc4\xf6\x08\xady\xa4M\xc9\xe3TL`-\xc9-\x03<1m\xcafJR@\xb3]\xb0\x17;w\xa5\x02\xffh\x9eg\xba\x9b\x1d\xb1>\xd2NbE\xd2Il\x9c\xe5&ba\x1b" +
+	"\x8a\xb1N>(#l\xde\xe8-L\xb7\xe4(\x9c&R\xec\x1c$.8e\xd9\x91\xd8N('\x8a\xbb\xfc\xc8.w\x02\x82\xec\xceN\xff\xc7\x81N,;\xdd\xdd&\x9a\xa7N\xb2\xd8\xb3\x07\xb2\x9d\xe6\xccL'\xb9\xed4H\x0c\xee\xf1\xd1\x0by\xde\x0b4'\xfd\x8f\xdal\x90h\xfd`\xb0\xc0\x0b\x01 \x96\x09\xbe\xcb/\xbe\xe6\xde\xee\x1b\xff\x0c\xa0c\xc04\x09\xe4\xec.z\x0fj}\xfe\x16\"\x1dU\xed\xfb\x19)n^a\xef\xf4\xa1\xf3\xc8\x1c%3Dh\x82\xf1\xca\xb3P\x86*u\x1b\xbc\xeb\x98\xc7\x9ac\xe8\x85\x98\x0d'\xc0\xf8\xd3\xe6\xd1h\xf9\xa6%\xfb\xacl\x86Mi$\x1fi\xed\xf8+\x83H\xcb&\x00RWyH\xbe\xe2\x04\xae\xcd\xe8\xc9\x0d\x95\xcb y\xe7\x14\x94\xcfa\xaf\xcc\x0f\x00\xa9^\x82\xd5\xc0\xe6\xaf\x9c\xd6\x9c\xe4\x94<\x108\x19\xabK\xac\x98[\x91\xe5\x8a\xe8\x82\xf4\x0c\x0a\xd1\xa8\x13e\xcf\xefB\xdb\x13|~\xfe\x0f=%\xe9\x04@\x0b\xe9:\xf8~\xd07Z\xa1\xda\x09oZ\xd6\xc7[\xcc\xa4z\xdd}\xfc\x83\xb4dK{u\x9f\x19\xb4\xf5D\x8a|\x97\x03\xedp\x96C\xa0\x15\xb4\x81\x0d\xac\xb4k\x0b\x0e\x01\x98fs\xd1}\xd0\xa3\xf0\xa0\xd8\x96\xd3RU\xa2\xa7}\x8b\xfe\xa29\x9d2\xa5\xc0\xf9\xcb)\x02)\x95\x1cy\xf9\x85z\xd8\xf4\xe5\x1cZ\xea\x84`\x88H\xf1\xe2b\xd9\x0b\"\x9c\xc5r\xfa\xeaN\xd0y\xf0S%\xdf\xa4\xde\xe4-\x8e\x0cH\xe2?Ka\x06\x85\x01\x16\x1e\x86H\xc3\xeb:\xe7Y0B\xf1ts\xb6'\x12\x9b\x93;\xa71?,An\xfa\xaf\\r\xe9\x84\xd2\xc2/\x18P\x7f\x15\x1e\xd7_\xe1\xdd\xc4\xfc\xf82\xba=\xd8*Qi\xa6\x17\xb5@\x1a\x1f\xc7i\x17R\xb5\x84\x1c\x892\x1a\x1c@\xeb\x07{\x0c?\xe8\x80XX\x91\x80L\xebS\x07\xaa\xd3O\xdd\xc7=\x88m{ d\xa6P\xef\xb7B\x9f\x06z\xb7\x1bL\x85\xe4;c\xbd1\xd3\x89n\xdf\xdcg\x08\x94\xfe\x0f\xf7~\x96\x09\x83\xaf?aq?b=\xa2\x04\xd3#\x15\x04\x99Qa7M\xd6,\xf4\x0di\xef\xaf\xc2pn}\xc1~\xa6\xfd\x19\x99',\xde$\xcd\x97\xa8\x83\x83\x80\x88J\xb8|\xc9\x81\x89\x031t\xbd\xa2\xc3Xr[E\x14\x8d+e|@M\x82\xaec\x1f\x99jbeB\xc1V$\xc6\x11\xf7\xd7\x02\xaf/\xc6\xed\xac'\x02\xd8\x8f\x88\xb3\xd9Z\x08\xcc\x99\xeb\x1c\xe7\x1a\x01g\x10\xa9\xdd3,!w\xd0:M\xe6sj\x04\xe0@b\xfb\xbc\xcaz\xad\x01\xea/\x18\x8eR>M\xc9\xdb\x0d\xa8\xa5\x06%\xc4\xaegs\x92i-=\xee(\x14\xcb8M\xbe)\xff\xac@I\xa6\x00:\xf1L\xd7\xe5!\xb5E\xdb#\xbf\xf6\xadh\x9eW\xc0\x11+vZ\xa8Nc\xc19\x8b\xedQ\xb3\x82\xf7\x94-5\x17?b\x0a\x9bg\xa9?\x8fV\x06\x0c\x0fP*j\xa9\xd1\xb3\x03\xa3\x17\xec\xd2\xaa\xfa\x87\xbf\xc1\x82\x07\xfbt\x10\xf2\xc9\xfc\x17\x84D_\x15\x9a\x9fq\xb5\xcd\xaf6\xf6A\xc0\x85\xcf\xf5\xf6\xf6\x084r\xf0\x08\xce\xe9I\x05 y\xd0\xd5p\xad\x91\x8bx\x93G\xbcC\xed\xb6H\x0d$\xf6\xd5\x9aI#|\xab\x86\xd5>\x86\x1f\x06Zn\xfc\xbb\x98m\x97q4\xbf\x8c]\x90\x92pgZiy\x0er1\xfc`s-\xc4\x80{P\x13\x0b\x87\xda\x82\xd0\xad\xe8XswGZ\x08O\x8490->9\x87}\x80Di\x1a\xb3\xa2\xf1\xd4\xd3\xeeL\xe9\xa0\x13\x00\x88\x97\xa1\x8c;\xa9\xf4\xcb\x0e\x8a\\4Y\x08\xf7\xcdJ\xfb_#d\xac\xad\x1b<\xc7\x96\x01\x91VO\x9a\xa2k\x08\x92G\x8b\xa6\x06H\xed\x14X?\xbb\x87\x97\x04\xab\x11\xbd\xd1\xc1~\x0b\xa8\xbc\xc5gJ`\xa6e~\xbf\xd2\xad\x84\x8a2\x93+\x1aOK\x87\xd2\x87\x9e\xee\xc0-\xcb\x13v iG\xa1r\xaf\x97\x9b\xe6\x134\xc3\xbb-ls'\x16\xc1\xe3:\x87\xc9AO\xa8o\x87h\xb0@\xe64Cgb\xe1}e\x1e\xbdR\xec\x99\xd1\xabY\xf4-\x05\xaa\xb3\xa9{+r\xc3\x8a4Y*n\xb2\xccp\x1etg\x10\x91\xd6\xa5Z\xf3\x81\xc7{\xe3C\x12\xb9\x00\xb0 D\xd9\xce\xd9\xf6\x9f\x8aL\x9d\xcb\x16\xef\x94N\xe1\xf7\x02L\xd5\x9ewrj\xf2!\xb6\xe6k\x97$\xca\x82\xea;\xf8'\x8c\xed\x99'\xaa\xf8\xe5 /\xf5:8\xb3\xaf\x9f\xed\x8d\xe7~uK\x87\xca6\x14$\xa3\x82mw\x99\xf0SxN\xbf\xcel\xa8\x96\x0d\xc3d\x03=\x89!\x82\x95\xbb\xef\xe6p/R-s\xbf\x03\xe4+\x92\xdf\x19\xb8\xf9\xc4\x04N\xe9\xc4\xe2N\xd9u\xa6\xfd\xd4=\x88\xf2\x96\xa9\xaf\xefVm9<\x88\x9f\xc1\xc0|n\x9d7\x12\x0a~\xb0cV;\x18\x9bQ\xf7\x19\x0es\xcf@*\x88E\xe0\x98\xd4%\xaex\xd4q|\x81,\x808\xcb\x17\",/4(\x00\x146\x7f\xea\x0b\xa7E\x8bk\xc2^\xe7\x8e\xa4E\x00VQ\xf0\xc7\xdd\xa3g\xe7><\x89\xdf\\\x84\xf9\xce\xfd\x85g\xd8\xbcv\xae\x90\x1c\x9e\xc6\xcf\x16\x19\x0c\xbf\xf0\xd2\x07\x12\xf1\xd7w7gY\xc7'D\xb40\xb0A[\xe3\xae\xea\xfd\xa7\xae\x81\xa0E\xc6\x0b\xd6=\xb8\xe4\xb0<'O\xd6\x1c[\xa3\x03`0knvt\xef\xb9G\xfb\xbf\x95\x8c\xae\xe0\x0e?)>\x10\x13\x0c](\xfe\xca\xe0\xb1*\xd98\x97\xe2\xba\x15f(\xb3\xd2[Y\x90\xc9\xe5\xa9\xfe\"\xdd\xa6;\xae\x1da\x8f\xc9\x7f\xd2u0\xdd\xfaf\"\xfc\x8dW\xa3\x1bK\x097\xb5V\xae\x00\xf1\x8b\xf5\xf6\xce\xb3\x1c\xc7|\x0c\"tg\x11D\xfa%\xc0\x03\xb8\xab\x9dF\x99B$\xb9\x0c\xc1\xb86|\xa6?~\xe9\xe2Z\xea\x8a\xb1\xd8k\x8d;#s[m\x9f\xd1\x0f\xa9\xfa\x99\xcev\x95\xc1\xec\xc4\x00\x92j\x07V9s\xa1po\xd5@\x10/s\x98F\xc7!-\x8b\xb7'%\xcc,@\xd7K\x02Rt\x1ez/\\F8\xd6\xdf\xa0\xa8p\x06\xd8\xe5\xd8\xa8\x12#\xf3\x8e\xfc\xb2m\xe6:\x83\xca\x98`\x1b\xd7w\x19-\xe9C\xd5\x92>o&\xfd\x0cO\x07\xfdX\x08\xb8'\x0f.R\x8b\xdc\xeaJ\xe2\x94\xa5\xbc\xa5\x09\x8b\xe7(\x96\xff\x1d=4\x0e\xcf\x91\xc2Is\xf0(\xf2u\x1e\x9d\x81\x87\x10\x1cA\xdbB\x95,\x06\xc7\xb5Wx\x95\xd9\xef\x0eMd#\x04\x12\x91\xdf]\xa0\x9e\xa7\xf6\x90\x9d\xc1\xa0\x06:F`\x1f\xcb\x17x\xde{\x81\x87\xe9v`\xaa(\xaec\xd6i\xbd\xa4\xd5T\xe56X{q63\x8e\xcfq\xef\xdeG\x8e\xed\xee\x83/\xa7qCdI\x9d\x9e_\x9cQ+\x8e\xd9/\x11\xb8\xa9e\xb6\xec\xef\xc4gI#\x04\x92\xff\x00\x88\xe7j\x1d@\xbeU,\xae84S\x90\xd1+;\xb9\xc5\xbc\xd2\x7fs\x185\xaf\x0ft)B\x88\xfa\x85\xda\xc1\xf2\xf2 \xa0C\xf4\x0a\xab`\xa87V\xdf\xc3\xeb\xb8s\xebl\x82\xfe\x01/\x8bl7\xd1\xd2\xda\x0b\xf8W\x9d\xefQ\x93yKW\xc3{\xf5\x0a\x95X\xc52~\xc4\xf2\xe9\xe99\x98\xe9\x8c-\xde\x0e\x98\x08nz.\xf2\xec\xc3\xdcO\x87\xd1\x99-@\x06\x85,\x09\x1b\xe5\xc5@=\x843\xec\xf6\xa7\xa5\xe3\x12\xaf\xf3\x94\xc3\x87`\x84\xbce\xb0\xc1R\xc7\xbb\x0a\xf9\xff\xcbG\x8dN\x16\xe1\x0a\xc1\x9eR7i\x91\xcbU\x86\xe9\x03\x8e\x09*\xd8\x9d\xc5\x99*\x14\x7fQE\xc4\xcf\x90\x09\x15\xbd/h}\xbd\xce\x91 \x9f\xc1e\xdc\x13BR\xf9\x87*d\xb8\x14\xc0V8\xd7\x05\x01\xff\xffR\x02E\xae=$\xe9Ij\xc9\x15\x16h\xed\x1e\xdd\xf3\xbat\xec\xfc@\xf4;%\x92'N\xf5]\x1b\x01\x8c\xe9\x99\x92\xf3\xee\xa3okv?\xa9C\xe52\x8d\xa2\x94\xf3.,\x9f\xaaS\x90\xda`(\xcc8\x02S\xcbu\x16\x1dZ\xef3\x03\xd8\xfa\x94\xe0aD\xe9p\x08u\x0bk\xb6T\xfa\xa5\xc3Ao\xd7y\xa6\xb30]\xc9\xd9\\\xdc\x8av\x00\x14\xee(\x18\xb1\xe7\xa0<\xec>\x0aH\xfc\xd8\xb0Tn\x1eX\xf0R\x928\xd5\x07\xdc\xfai\xc7J'\x9d\x95\x7f\xcf\x839\x8bR\xce\xf2\x05\x05\x1d\x9a\xc5K\x09^\"\xcbe\x0d[ A\xf5A\x0c\x85\x04S\xc8\xf2\x86]M\xc3\xa8\xed:\x03{\x02*\xd8O\xb2\xe7\x00\xf7Po\xf0\x19Ev\xcf[\x91\xbc\xb4k9@\xaa\xbe)\xcb\x89f\x8a\xd6\xbb\x9d/p\x7f\xef\xf7\x8e]#x\xfe\xbf4v\x05\x1772v\x95\x9587\xc1T\x8e\x9dC\xa5\x8dh\x7f\xdf\x92v4\x8d2\x8c~\x05\x96\xd4w\xb5\x80,\xe1?\xd1\x9do\xcd\x94\xac\xef\x14\xea\xc7g@Q\x17O\x80Q\xb7IJ\xf7\xba\xbe\xaf\xbb\x1d\xb7\x1eX\x83R\x89\xa1\xc4V\xc8\x12\x96X\x0a\xac~2\xeb\\h\x90\xcdp\xbc}'\xeb\xc5+t\xd8\xeb\x0e\xb5\x84\xb1\xeb\x06\xba\x9c\x01x\xdd\x17v\x1e\xf9Q+\x0c\xb0>\xad2\x8d\xf2'/w\x82j \x8b\x97\xe5\x95I\xc8jJ\x02W\xd0J\x94\xce\x1f$\xba[(\xc54\x17\x8as\xc2\xb5\x94Y\x03\x03\xee_a\\t\xc5U\x06\x821%\xe4\xfa\xbf\xe2\x98\xb2\xe8\xab\x83\x94\"\x02%\x0b}\xf3\x94\xfd3<?\xe9X\xe9\xd6M_@\xe9\xce\xd74x\x82|\xd6(\xe1\x96\x80q\xf3\xc4Vd\xea\xff\x8aZ\xc0\x05PG\x8a\xce@\xe2\xe0\xd3\xef]\x0c\x0f\xf6\xa2\xc3e\xa7\xfd\xeaEY\xbe]\xea\xb5B\x044\xa64;\x82\x12\xdc3V;$Y\x10\xdf\xc9\xcf\xa6\xea\xbe\xfe\xff\xdd\xc5\xfc\xe0\xa0'\xe5\xe2\xec\xca\xaf\xc3\xaf\xa0.\xb1\xc3\xaa~p\xf8\xfe\xd7\xf7\x00S\xb6B\xe2\x8eB,|F-_\xbcB\xe2p\xdc\x18\x11VA\x98\xf4$\xae\xce\x87\xa3\xdd\xe7\x98\xce.%\xdd%Y\x10\x17\xe9P\x1ds*Q$\xa8\xa4\xde\xeed\xd8\xc9\xca\xf5cU\xd5 _\xeb\xf9.\x09I\xd2AU\x08e\xd0\x0c\x17UtV\xb7\xdc\xe5e\x9etc\xd5\xef0\xfdAM\x82-\xa3\x90\xc0[\x84\xdd2^\xe1z6:G\xe5\xb4\xc7\xacP\xda\x00\xea\x1d\x0bJ\x87G\xea\x0b\xa1\x03\x1e\xe43%\xb7\x13\x14\xe0\xe7S_\xed^\xe1;\xb0\x9a\x0a\x1fs_\x87\x9f\x92\xf7\xf5_/4\x87{\x19EM\x9a\xc9Q\x15\xfa\xfc\"\xe2\xe1~tU\x13\xc0,\x01\xbc\xe79\x0b\xa2\xc5R\x96+\xaa\x12\xcf\xe9\xc47\x8fi\xf5\xaf\xec\x09\x00\xe2\x94J\x9c\x13N\xae\xe7\xd9hH\x8f\xcf\xddI\x0e\x8d\xa1Ql\xe9\x7f\xa5\x96\x1f\xeaU\x01\xf4\x94/\x90\xd1/#^\x1c\xd6\xf8\xb8\x15\x0a\x0a\xdb\xee\x8e\xbb\xdc=\xca\x0e\xf3\x14\x1b\x94\xc5i\xfb\x92\xc1\xcftkk\x97/=\xb1*\x83\x07 V\xf5\xe5\xcb'DQ\xb1C\xfa\x04\xca\xbf\xd07(\xa9x\xac\x0c`\x1f\xc5m\xe6\xd0a!\xe6N5^P\xa3p\x0b\xfd&\x1dgF~A\xd4\xa9\x0b\xdd\x9b\x9f\xa0@K\x06\xd2 \xae\xaf\x16\x18\x9b\x0c\xde\x99\xcb\x08\xb0A \x16\x88\x0ejK\xa3b\x10Z\xfeIV\xd4\x8cj\xe1\x17F?K,\x81l\x08\xedq?}\x11\xe8\xe1YZD\x07z\x07\xddB\xe4\xcc\x8a8\x87\x82m\xd7S9\xfa\x14\x133\xe6\x02d\xa9mh\"\xd9)\xcc\xbd\x11\xe3\x91]\xedP\x80F\x99\x80T\xed %\xaec\x83\xd0\xaf\x1d\xd23\x80\xeb\xb0\xd0\x0c\xec\xb0\xefZ\xe2\x8f\xac\x1e\xac\xc8g\xf8\xd9SV1\xa6\x18a\xf7%\xe8\xe8 u\xaa\x17WD\xf7\xad\xfekl\xcb\x01\xdc9\xd2\x8ds\x19`g\xff\xeb\xf0c\x15\x0d\xdd\xe6\xb2\xceS\xeb\x1er\xb0x\xee7CO\xaax\xf1\xa0u'B-8}\xa4\x197oFt\xa3\xc9M(\xdd\xa8>RR\xc4\xeb\xfa\xa1\x994\xd3\x8cVu\xd80\xfe\x8f\xfa\xcd\x9a\x18\x1c\x8dr\xfa\x9b\xc3\x01(-\x00=\x16\x19\xe4\xe7\x08EY\x0a\x13\xf7\x1d\xe8H=^\xe9&/\xa9C\xb2\xc3O\x02\x8b\xf5\x06\x16\x91\xbbN\xf5\x97\xe3N\xfa;\x9f\xc5\x9f\x1c:\x90%K\xd9\xdc\x83j\xec]\xa7 V\x86e\xc6\x1a\x9aE[\xed\xb16\x83\x9a\xc7VN; \xe2\xf5<\xbc\x0e\xe4,\xe2\xa9|\x0a\xa4\xed{\xf4\xe1\xab\x8cf%c\xeb\xfc\x10ey$h\x0c=I#%|e\x15\x88cW\x06\x02\xe31\x80\x1b)\xf2\xba\xdb}\x1b5\xe4\xb0\xd9&\x1f*>k\xc4\xf5\xdeb\xc6\xd8-`\xba\xa7\x17)\xa5d+\x84 \xaf\x06fX}CT\xdcw\x92\x13\xe2\x0b$xA\xcf\xc9\xf7#\xf7\xf7\xc0pD)\x81_oI\xa4\x949\x10~E\xa1r\xd0]\xa7\x10\xfe\xde\xc1\x9bpk\xa5\xf4\x1b\x95\"\xc5}\xc3j\xefp\x87\x15\x88\x0a\xc5/~\xdf\xae_8\xc4\x1bI\xc2G\xfd\x8d\xc3\xb1\xcc\x86`\xec\xf4\xf7p\xe2\x1b>u3\xe1#\xd7\xf7\x0a\x06\xc9!`n\x13\xf0\x0f<^\x8e\xa05\x82\x15\x86\x1e\xca\x0f\x9dK?\x1ai\xfcjI.\xee\xbeE\x9d>W\xa8\xf8\xf7\xdf\xe9\x99\"\xb9{/?\xc5o\xf7\x08*q\x9f($\xbe\xa2\xc5\xac\xef$\xa2+\x17\x16\xb5\xb1_\xe1\x16\x9e'7\xa2\xae\x94\xf8\x9b\x07c\xfc\xca;\xd3\xde\x9f}|\xfb\xdaJ]\xc0x\xac\x11\xaa\"MI}\xd6\xd2Z8\xaf\xc5\x14*3]\x87\xff\xc0\x9d\x86x3M\x8c\xf0w\xa1\x1d\x0aJ\xe0f\xb5\x9a\xda\xa5\xa1\xe6IK\x03^\x86$\xfb\xa9g\x85\xdf?\xfa\xc3O\xe0\xd8\xe8q\xdf`^?\x0d\xfd\xeb\xc4F\x97\xe9\xe3\x8b4B\x02\x9aKG\xd9P\x9fP\xed_\xf7\"o\xaf\xac\x1d\xce\x88HFy\x0a\xb3\x7f\xe8i*\xbf\x07+\xec\x07\xcf5\x93\xfa\x19c\xf9qR/j\x12\xd7\xa2!:$\x1a\xbb\x93\x1c\xc9\x13\x8f\xfd3\xcfF\xb4\xb26\x90\x10\x0eae\xf9\x05\xc6\xe0\x09\x1f]\xa1\xaf\x01T\xbc\xdes\xed\xff\xe1{\xd3\"\xdd\xe4_\xb6\x80\x8e}a\xed\x9d\xaa\x98\xbd\x13\x11\x9e+\xeb>\xb8%U\x0cl}\xb03U\xe9g\x8dRHE\x81\xebW\xb5z\xed8\xd8p\xa2\xabS0\\]~\x12a\xd4G\xa593\xc5h\xff\xc0\x0b;\x12/Ly3\xc7\x8c$\xdc)\x9e\xfd\x1c\xab\xef\xea\x9f\xcdU<\xd4\xe3v\xc5\x03@r\xae\x0co\xb6Pu\xdf\xde\xab\xfc\xc3\x11\xe2\xc1\x89u\xbebJ\x84@\xd7\x1b$\xbe4\xadQ\x8aZ\xec \xbe\x06\x8c\xaf\xeb\x1e\xc2\xb6\xe8Jk\xd0q\xe8\xbe\xaa?\xbc\x88\xeb\x0eU\xda\xec\x86\x04 \xc42/\x14\x18<\x16]J$\xfb\xf1 2^\xce)\xb2\xbd{\x7f\xfb\xdd\x8bn\x15\xd3\x12\x0a\xaf\xe8\x1f\xab\xb6+\xf5\x90\x8d\xf0\x8f\x90\xedKT\x1c\x81\x86\x92AG\xb1\xef \xb6\xfcx9j\xcea\xe8to\xf4\x87\x7fx\xc1\xe7\xbe\x95\xf6\xf3\xb1\xf6@\xa1\xa3\xcf\xdc\x9cI)\x12u=\xe1\x1c\x02\xabr`\xe0\x17#\xb3\x13\xaa\xc7\x8b?\x0f\x00\xf8\x0b\x863\x83\xfas\xe7b}\xf8\x87c\xd4\xc3\xe3@N\x83\x02T4\x16/\xb0X\xecGJ\x11h\x8aqUz\xd5\x7f\xc2\x93?|\x83\xa7J3\x05\xb7fj\xad\x0c\xa9W\xd3\xf9Z\xce\xfe\xecU+\x8fY`\xd8H=\x91\xb9\xb8\xeaj\xa0\x81\xde\xfd\x07\xff\xc39\xef\xd1~\xf2fBk\xdf\x88\xeb\x8bt\xf3\xed;\xd5\x1fNr\xb9\x8f\x93cu\xe4\x82\xba\xb5c\xfa\\#\xa2FH\xca+V&\x8a\x96`\xa8\x8aj}h\x11\x0a\x93N(\x9f\xa9Y\xc8\xec\xf6\xc7\xde\xc1}\xf4\x87\xd7\x9c\xa3\xe3\xc8x\x98\xfa\xd4\xeb\x9c\x10YkU\xcaun\x94h\x88X)\xe9\x0dWwo&\xa0Js\x81\x06'*0\x88\xb2|v\x8c\x16\x8e\xfe\xf0\xcau\xa4\xd1\xfc\x1az\xae:\x86s!\xe7\xbdzk}\xe8\xe8\x0f/q\x82\xc6\x8b\xedaEC)\xa5\xde\x10\xc8 \xc5TA\x12\xdf*sH\xbe\x7f\xe6F\xf7#f\xe6\xcc\xb9\x96K\xfb.\x1b\xe9\xf1\x1f^\xea\x8e\xf5R\xf7\x88U\xabf\x92{\xc1\x7fx\x999>\xdee\x0fu\xff\xf9\x03\xf2\xcdoy7\xf4\x87\x17\x84\xe3\xd3\xe4\x0d%Zd\x82\x89r\x85\xd9b\x89\xad\x95NW\xaf\xe3?\xbc\x1a\x9c|J\xee\x09\x02\xa0\xe6w\x84+'$_ozo\xe2\xe4\x0f\xcf\xf4\x93}Ak\xf6^\x84d\xa6v\xee\xdah\xfe\x8fx\x1b\xba\xbe\xfd\x8d\x82\xb7\xael\xec\xe4\x0fO\xfb\x93C\xa5\"\xf1\x87\xf7\xdc\x93?\xbcf\x9c\x1c\xefd]\xb9\xa6Bk\xfb\xbf\x0e\x01V:1\x0a,X!5\x18\xa0f\x95\xbb\xf2k=~;\xe8\xe1\x7fx\x8199U\xd4Q\xb2K\x00q)" +
+	"@\xd9\xc4Z\x0bb\x18\xbf\xa1\xb5\x90\x06\x0d\x1d+u\x8bY\x1b\xe5\xd4\xdb\xfaK/l\x88\xcbk\xc4\xc4\xde*\x0d\x15eV\x0d:*\xff\x89\x88\xc5\xd8\xca\xa0\xcd\x04.k\xc5\xb0a\xaf\xc4\xf2\x1d\xde\xe3\x97\x1c!\x0aJ\xe0\xab\xfa\x07l\x0ap\x81\xfe\x8f\xdb\x9e\x03\x1dF\x1e't+o\xd4\xc3\x14\xb5m\x18\x1c\xf7=\x9e\xcd\x1a\x12\xb0t\x85>sL\xe3\xac\xbd\x04\xe8f\xd7Q\x8f\xe3\xee\x03\xbeVzA#\xfao\xe4Y\x94 i\xf1\xb5\xe7\xb8'\x01,\xd0(@>\xe1\x80\xaa\xef\x1b\xc7#\xf9\x8a\x93\x00\xe4\xca/\xfb\xe1D\x04\x0c\x7fA\x00\x89\xe0\xbf5\x13\x08XP\x1c\xfd\x8b\xf62\xc8\xfeQ>\xee(yH\xd4\x18k&;\\\x87\xfd\xd1\x0e0#\x02:/\xa5\xc7\xdc \xfdQ\x06\xbe(\x00\xb7+\xf1\xbcs@Lg\xf9\x9e\x9b\xe2\x86\xc5h*u\xb6m\xbf\x87\xf3\x81\xd2\x17\x1c\xb3\xachT\x92\x05]\x0b{m\xe0\x0f\x03\xa91\xff\xa3q\xf4\xd0\xa8Q\xdd{X\xeb\x9a\x920t\x06Syx\x96mQ'\x07\xb9\xe3\xa6h\xf7\x1e,z\xe7~\xbf.\xc8i\xa4\xcay,'\xf1\xf2n\x9c.\xd6\xcc\x00\xcd\xf6\xc8\x06\xbb\xfe\x1a\xbf\x11\x12\xb0_\x19\x8a\x01;\xb4\xa1\x8f\x84z\xc6\xb5\xd4W\x84\x0ec3o\xef\x8aa\xc7\xb7\x92\xed.\xd0R\xfd\xae\xd7\x02\xbd\xa3\xda,/\xee\xd2o\x94\xed\xb1\xd0\xc516\xc6`\xae\xe5E\xc8vp\x03G\xe5\x0e]\xde\x8by8\xaf\x00(6\x87\xaaO\xefI\x02\xc8:\xec\xcd\x95\xf3\xd6\x80\xf4\xe1\xdd~\xd1\xfbF\xd1\x03c|z\xf2W\xb9\xfcvD\xf5\x7f\xa5H)\x8a\xf5\xa9\xb4\xd1\xde\"\xc3%V8\x11hU\x0f\xeb\xde-\xf8\xa8\xf3\xdd\xfc\xdd\x8fd\xf4\x82\xbfG*r\xca7\x9b\xfd\xb1/{L\xb1;U\xdc\x95\x95\x0b\x0f\xe2s!!\x0c\xedf\x1fe\xc6\xfe\x90\x89\xaeIohsj\xe9Ls\xf6?\xf5\xf5<@\x8d\xdbI\xd8M\x07\x17\xd2\xfa\x1b.\xa4\xf5c\x17JH\xef\xe6\xaf\xe44\x7fM\x8c\xfb\x8c\xa3\x1c\xe8\xad\xf8'<o:\xc6\x04l\xf9z\x8e\xb2\x17\xb2ZX\x01\xc8\x8f\x9b\x11h\x13]\xe7\x92\x9d#\xab\xc8\xc8\x05r\x9e\x8f\xd1\xbd\xc5\x08D\x89\x94\x16\x06TG{%[\xeb7\x0f\xdf\x98\xe0\x0c\xac\xb7}\xe784\xd7\xcb\x85\x7fa\xed8\xcc;\xd6Q\x92\xe9LR\xe6\x92G\xa8Rq4\xbd\xb8\xccEB\xdc\x13\x91&rL!\xfb\xeb\xb6\xa6\xae\xc9$\x9cu\xb7p\xfbK\xd66[QAg\xd9}\x94S\xf5\xb2\xaa`\x96U\xbb\xcd\xb2\x83\xb3\xdf\x12`\x19\xc5\xe9\xbeC\xe7\x06X\x87{\x19\x0b\x92W\xc9\xf53\xcb'\xdd\x9d\x0b\x92\xfdz^\xe3\x1f\x16$\xf6\x9e\xbf\x1e\x08Y+\xe8n\xe7JM\x9b\xa5\x84$\x1c\x1f\x9a\x05\x1f?\xa7)/ Q\x08\x16\x7f4\xf3vD\x95\x97IV\xdf=CYWT\xb6\x06\xd6\x89\xa3\xc4\x91\xdd\x0c\xfb\xdfn\xe0\xbb\xa37\xe5\x12\x1aa7:&\x1b\xcb\xae\xdf\x83\x9b\xe5_9o\xde\xc2\xf3\xed\xce3\x0eO\xbaO\x1dqJ\xbf%\x90\x0ev)I-\xe5\x8e\x95*\xa7L\x88)\xe5\x04\xc7rXU\xff\x94;\xf3\xad\xf4\x82\x810\x01\xfdL@!\xf3\xeb\x0e\xae\xa70g\xb5\x87\x95\x95\x87\xa3I\xdd{\xdc\xb3\xd0p\xdf\xd2\xf5b\xab\x84\xacz\xe5\xcet\xd1\xf1\xc7Gp\x8f\xb3!\xb4\xd6!\xbf\xb7ysN\x89\xa6\xc6\xadbJ\x00\xca\x7f\x9d\xa3\xff(\xa6j\xaf\xac`c\xd8Qx\xa8\xd85\x09\x18\xd1p\xf9\x0a\x91\xd3\xf3\xc4\x8fTa\xac\xfe\xec\xb6\xf8\xb1\xd1\xb8\xf4\x18\\\xcf\x19\x13+H\xfd\x04 \xba\xde\xbbU%t^\x08\xeb\x7f\xcd\x89(\xf7!\x04:[z\x14\xb9\xfa\xd6\xcf\xb42-\xc3i\xac\x95\x99\xb4\xbb\xd1\xa4w\x12\x1f\x1d\xf5\x9f\xe9\x19 \x98\xc2\xb0\xf2\xa2\xff\xc0\x09\xbd\xebV\xe0\xb5T^\xea\x8a\xbf-\xa0\x87\xdd\x9b\xb0h\xa5+6\x7fH\xa0\xb6\x16)\xee\\\x82\xd4\x86\x89kN\xf9\xe7\xe8\xb4\xdf\xcePX\xdd\xb1J\x7f\xef\x81\xcf\x8c4\x97\x92\x8d^1A\x8d/\x92\x85:\xdb\xef\xe8\xa7\x80\xed \x18\x96\x19w&z\xe9\xf2H@\xdb6\xea\x1c\x12\xb36\x0a\xed\xdcg>\x1dV\x16I\xea\xf20\x8d\xf4LP\xcf\xaf\xef[\xdd\xb8\xe7n\xb55\xc9,aIor\xcc\x9e\x10\x9c \x17\xda\x86x\x0aT\x91v\xd2\x16r\x94\x90\xe6\xa9\x02T\xef`\xa1}\x9c\x96U\x82kw\xd5 \xed~\xd9e\xbe\x80\x82\xef\x891\xec\xa6W3\xca,t\x1c\x1f\xec8\x86\x913Sf\xec\xa3\xc7\x87}\xe3\x18\xbc_\xeam\xe68\xf6\x96\x1b\xb0*m\xfb/@U\x16\x9a\x19\x1eR8\xf7\x8dS)\xca\xc0\xd1\x93_Ep\xe1\x9e\xceO\"\x9f\xf1m@{\xe0&\xab\xe8s|\x1c\xcd\xbep4\x01Y/kd\x9d\x18\xe9\x0f\xf3\xc0\x9a\x09\xbf\xadf\x96s\xc0\xd3|\x7fV)\x1d\x17qr\x02\x86b\x96\xfe~\xe7j'\x0dB\xb5\xddhiw9T\x0cg\x07\xe8\xc4Z\xd8L\xa8\xde\xd8\x1f\xb4\x9d|\xea\x1bC\xa9\xf1s\xebZ[\xdc\xc5I\xcd\xd7g0\xd0\xed\x9f\xad'{\xf9/\x88\xb9\x0e\x85\x056\xd7+\xa84\xf6\x9eo\xbf\xff\xd5@\xbd\x80\xff\xcc\xc1T\x81\xa6ABw\xa4\xe3}<\xcb\x90)\x07\xb0`\x95{\x82\x97 \x96\xc4\x05\x08\x83\xb0\xae\x9e\x1b\xc0+\x10\x18!Y\xcdJtm\x16\xcd\xacw':\xd1\xd1\xd9\x86t\x17\x1f\xc0\xb5\x8b\xfa\xdcU\xdc^)3\xc1:\xb1\xa6\xad\xf0P\xddu:0\xbf\xb2j\x93\xedh\xa5l\x7f\x0fkA\x16fA9k\xa2\x07\xaa\xdf\xbf|\xea\x8eDY7-\xe2\x1b\xe3@\xdd\xe0U\x068!~J\x96\xc5\xb8\xd2I\xf8U\x06\xfe\x007\xf2QS\xc1+_r\xddQ\xd6\xc1;\xc0\x13\xd1VGA\x1a@B\xb2UC\x09\xdd\xb2\xf4\xean&oO>\x8dh\x08\x9b\xd6\x9ar\x17\x96\x03\xe9\x0e\x1e~\xdf\xb0=\x0dW\x12\xa46t9\xab\x88\x15\x80Uw\x16\x00\xae\xea\xbd\xaf\xfd\xc0h\xe5\xde_\xb6,\x0b\xac\xbd%\\;\xc8t\x9ehR\xc7\xd5\x8e\xa9\xe0\xe9\x81\x9d@r\x1d\x16\x88\x04\xbf\x97z#!\x81\x1fx \x81\\?\x81:\xcet\xd8\xe5\xfa\xf2\x80\xef\x86\x18J\xf0\x96\x7ffm\x01\xa7G\x81\xce+-\x81\xc8\xc1\x81}\x97\x93{\x93\x16\x8e\x0cz\xaa\x9d\x16d\xa2\x87\x99\x907\x8b\xa8r.\xe9\xb8\xe7\xc9\"\"_<\x85_\x8c\xc1\xf3q\xca#&y}\x03\xf7DN\x0e\xc0\"W*\x80\xec8\xed\x0b\x08\xcdV\x199\xc6i\x00\xa5\xe08\x98\x8e\xae\x15.\xd2m\xff\xde\x93i|$\x0a_>0\xceR+\x81\\I\xd0\xbe\xdf\xd9A(|\xdb[\x9b<\x0b\xcd\xcdzol1xo\xa1\xfcl\xcfn\x94\xd4B\xa3]d\xf4\xa1'\xdc\xdf\xfd6\x93\x85&B\x0a\xd8\x02U\xdf\x8b=;0J\xc7X\xf3\xd0\xe2\x88ye\xef\xb3\xc3`\x05}q3\x0d]\xbb!\x0ez\xe8?R\xa4>\xad\\Q\xc6\xd2j\x19\xcd\xb1U\x07\xf2\xbc\xff\x0d\x1c\xff\x9e\xd8(\x8d\xef;Q\xa2`\x0f\xf5c3\xaf\x1f{\xd1}\x1b)\xa6:x\x8f\x07t\xc2\xee\x97\x9f$\xdf\x16\xeap\x96b\xabmFp\xaf\xff%z\x102\xab\x12\xe4\xd3\xcb\xb7\xdc\xf3\xdc\xebN7sV\x86\x9d\xbb5\xb8\xbdg\xa4\x94\xf0S\xe1\x1a\x8e`ni=\xbes\x0aD\xd6Y\xa6Y\xe6\x89\xab2}\xac\x9e\xce\x7f\xf4\xe6\xf1\xde\x12W}\x80Je\xad\x1aV3\x0dn\x02\xfd\xa1#WO\x85\x9e\xeex\xf50~\xf5\"\xb3\x0e,Hwx-\xc5@\xe2\x00V\xd0M\xac\xb8\x08\xef\x17\xe3\xeb\x81\xac\xd9\x93\xb6\x817a\x8c4\x8c\xb6p\x82g\xff\xdeU\x06@\x93\xc7K\xf6T^\x97&m\x90.J\x84\x1dj6\xd4\x1f\xe2\xafr6\xafW\xf2\xc05\xc8\x9eb+O\xa1\xf0%\xf2\xdd\x02\x10%h\\,p\xef \x19O\x8e5\xe8\x1d\x8c\xd9HI\xb8\xb8\xafb\x10\x99\x01\xe8i\xf6\x0d\xf3#\x9d\x8a|\xc8\x94\xc5\\O\xbc\\\xb1\xca\xac \xff\xf2Yv)\xcb\x91\x13,&d\xe0h\xe7P\xbe\xf4#A\x9cd\xee\x07M\xa8\xcd\xea_\x87\x17\xe9a\xb9\\u\x04\xfd=}\x0cy\xf4*\xba\xea\xc0\xa4\xf4\xfb\xd1w\x85\xb2\xac_ \xc3\xf17\x98!\x1cX\x7f\x86\xe1g\xdc\xf5\x8d1\xe3\xda\xeb\xda\xfa\x1b\xe1?\xa31{%\x15f\x19]&J\x1c\xcai\x9eeB\xbf\xc1\xd4\x1b\x1a\xa1O\xd62\x0f=\xfb\xdd%\x17\xcdT\x9eQ\xa7\x08t]\x05_\xd7\xd8Z\xd6\xdc\xa3\xe2\xb3\xd41\xcd1z\x13\x13RU\xe5ES\xe6\xff\xfc\xd6\xc7)f\xf2\xc1\xf2\xae\xb7!\x1b8\xe9\xb8\xd7\xcc\xe4\x09\xaa\xf0\xe8\xf8\xd2,\xffa\xc1\xc0\xffG\x96\xd5v\xd1\x124N\xa1\xb3\xa0\xd2\xd60\x09\xc17\xc6'\xed\x0d \xfb6\x05r\x8b\x7f\x82\xf2\x95v\x01c39?\xd2\x84\xa8\xf856onP_\xdc\xfd\x91\xe2Z\xf4yn\xe7\x9a\x1aqb\x81m7K\xdf\xc2\x9b\xf0\xdc1\xeb\x88\xab<\xf9)4\xd05%\xbd\xce\x88M\x94\x86@\xfb\"\xfe\x06\x1c\xed\xd5\xc0\x844W\x99\xb0\xef\xd8\x00\xb3r\x9b\xa5\x9dz\x8cQ\xf1\xe3Y\xa3*y\xd0\x85\xf1\"\x82\xc6Z\xb4\xfe\x9b\xd6b\x0d\x83\x84\xed\x17:Q4;\xd9\xecf1PS\x94\xfa\xda\x02XB\xb9\xd3\x10\xb0R\x0fx\xf5\xd8\xef/\xb0O\x16\x00\xd9\xd1\x91\xea'l\xea <\xed\xab\xbc\xcad\xc2\x8eM\x02z\xd8\xe5\x83\x8d\x83\xdc\x91\x1f\x9b2\xbc\x18\xe1\xad\x8aa\x95\xc0}\xb4\xf3\xd0\x0c\x1f\x11I\x97h\xd6\x80*f;o\xc4\xdf\xb2\x961f\x00\xe0\xcb\x18\xc8\xfa\x99\x99\xa4\xe7\xec\x1bk\x07~sN\xef\xbf\xbd\xc9\xfa\x01Rd\xb6\x19t\xf3\xf1\x07z]6C\xb8\x15\x0e\xd9\x95*^\xb0$\xf7WK\xf7\xf6\xa3\"\xea\xe8\xeb+\x8d\x0d#\xeb\xd7 4\xf4\x86/H8\x89\x1b\xce[w0Xo\xc9\x89t\xa0UN\xb0\xa7\xf1(\x98\xa9\x0bTCA\xde\x1a4\xa1\x98\xf6<p\x98\x8agb\x85\x99~\xb4\xba/\xcd\xab\xb82\xaa\x174F\xb1\xe5\xb92\xca/0\xac\x17\x14x\x94\x03\xee\xb1\xd7\x8f\xe1\x0e\xec~\xaa\xd0]:Gi\x86P\xf4\x119\xa1\xcc\x7f\xb3\xc4_\xad\xd5lh\x87:A\x04\x13\xafv\x91\xf7\xa7\xbe\x82\xc2\x09\xbc\xd2\xa5\xe3\x87\xfa\xce\x962\xa1\xe1O\x12\xdf\x90\xcc\xe2\x03\x96\xbb\x03/\xfd\x88\xbfv)\x88*\xf2\xe1\x12@\xfe\x10\x0a\xd3!\xcc8'\xee<\xf0\x0b\x1cl\xd4\xc7\xfd\xe1.\xb1\xf28\x96@\x14\x945\xd1f\x1e\x14\xa4\xd6\x0e\xb20S\xe9\xa0\x10\x89Od\xe6\xec\x80\xfdS&\x98\xa2\x979\x00\x1b\xb0\x82\x02C1\x10\xd0l\xa5\xcc\xa1\xe5`Pp\xc7\x14\x12\x15\xb01\xfb\x16q\x1b\x82\xf6Q\xc8\x17\xa1\xdc\xed\x96\xd2\"A\x14\x8fUm\x9e\\|l\xb3\xd0\xe1\x967\x06{!\x8c\xc3o\xa0\xe2\xa7s(\xb4H\xab\x97\xf6\xa3_Ta]\x91\x00\x9c\x92j\xe6\xe5a\xee\xcbW\x0fh\xb4\xd7.g\xc2\xd1E\xb8\"\xc4\xf6x>\x13[\x94T\x91J\x8c\x17\xec\xf6c\xbf3%\xda\xd3P\xabpy\x12\xd4\x18\xa5\xc6e\xe3\xf3!\xa8\xec\xcf%M\x7f,\x85_\xa2\x9br3\xa2\xfe\xb1\xf0\xb2\xb8%\"\xeb(\xdeJ.\x07\x80\xf6*\xc8P\xa4\x19\x8b\x1f\x88\x15\xfa_ \xa1\x0a\xb4\x00&\xb6e\x06[K\x8f\xd19\x81\xd2\xab\xbcU\x88\x85\x17]\x0c\xfb\xec\x87\xf5\x00p\x97\x9e\x1d Pgc\x97\xfb\xb1\x91Pd\x08H\xec\x92\x87#\x14Q\x98\xdd9IF\xf2+\xf4\xa3f\xa6\x0c\xdb\xe2\x91\x12H\xaeHAu\xd9\xb3\x8c\x85v%\xb9T\xb9\x1f\x141\xb2Y0)\x1e\xe1g\xd8\xcbE'\xb5\xe8\xd3AD/\xd2!d\xe9\xda\xda\x19n\xf2\x98\x93\x9c1\xdf\xe9\x961\xbd\xbcXz`\xea\x8a1\x87f\x0e)y\x88\x8a\xbd\x9b38\xcc|\x0a\x0d\xc11\x8b\x88p`r\x9f\x0b\xab\x84\x91\x042m\xc1:s\xbc\x0b_/j\xd5\xf1\x02\xbc\xbf\x1b\xd9\xa4\xb2\xd1\x95\xfe\x92J'd\x13\xf0\x87\x1cI\xd9\xc1\xa6\x0c4\xbd\x9a`Ts\xa1#\xff\xcf\x086\xcf\x02u\xfc\xee\x09\x9d\xac\xa4\x94 ]\x0a\xdbe\x98\xbf4\x17\xe2\x95\xba'W\x11\xa84\x0c\x7f\x1c\x9b\xc1\xc3\x91\x1f\xa5\x817\xda\x97h\xad:\xcaSc\x0f\xc3\xcc \x13\xef\xca\x12a\xdf\xda<\x8b\xfb\xc4\xc7-\xc2R)\\\x8cJ\xa6\xbaEY\xfa\xe9]\xd3U\xaa\x8c\xbbs\xc1\xb5}\xc1\x87\xffH\x1e\xeds\x9aq\xed\xb3u\xd1\x94\x18W\x8bf\x06\x7f\x00\xec\xc3T\xecn\x88\xb8s\xba:\xa5\x1bt\x11\x0c*\xc7\x16@.\xfa\x0a\xdf\x0d\xca\xcf\xed\x97\xe9M\xd8\x9c\x9cw\xb86\xa4\xf7\xaff\xe2\x96B\xf0\xda\xc2F\xf6\xd7\xe6Z{7\x0b\x80\xb4\xb6J\x03\xcb\xb1%\x86\xd5>\xaa\xe4\x9a{3\xf7\x03\xbc\x99cU\x9aC\xa4;*\xf5\x82]\x84\x8fJ@#@\x1d\x81v\x9c\x08\x90q\xc6\xee\xc1\xba\x0b\x02\x1d\x01\xed\x1b\xe8vO\xdf{y\xc4\xf7\x04\xb6\x02\xe8\xde\x1e\xa5%\x05S\xb5\xcd|\xf1QO\xe5\x91v\x193\x9a\xa7)1\\%\x87`%\x85`9R\xfd^:\x85I\xff|\x16\xe0E\xd8{\xbd\x8e\x03q\xf4\xd0\xe3\xfc\xf2\x0b\xb4\x80P\xd3Bjp\xf0\x9f\xabt\xc7\"R#jg9x\xb9\xdd\xe0\xf6\x9d\x13\x18\xe5\xae\xa3*Q\xf5\x09\xd3\x82\xd3H\xf1k\x0cPW\\hv'D24\xae\xa1\xb12\x8c\xd4\x90\x17'\xd9\x91{$h\xd4\x8a\xa8fC&_y\xf5A\xe8\x12\xdfcw3j\xacz\xbf2\x87\xa9t\x95\x97\x06d\x1ah\x16V\xe4\x04^\x9d\x04\x19\xdd\x1f%\x86\xa5\xb9\xb1\xac0\xdb\x83S/\xa3\xa87\x18\xa5\xe5p\xc7\xc7\xd8q\xc7\x0e\x8c$Po\xd8~\x95O\xa9\x14\xc2`}\x0d\xc5\x98V\xacdT?\x88\xb1\x87o\xe8\xb3\x7f:\xea\xb7ft\xc8\x0c\xc8;A\xdf\xb4\x7f\x15\xba\xfa\x13\xa8P\xaf\xd8N\xc6\x9b\xc8@\xda\x9e\xc3i\x8fl\xbf\xe8\xf8\xf1MU\x04\xc9\x1f\xe1\x16b\xb0\xb5&'Io\x7fe\x90WB[\x86\xd3\x08\x9c\x1c\xd8V\x85\xdb\x19vU\xc6mC\xfb\x97o\xf4c^kh>m|\x9f\xc9\xb7\xd7=\x8d\x88\xd31/\x0d\xe8\xd2\xabn\xcf\xf6\x0fd/\x0bq\xdb\xf0;j\xa3^\xe8\xb2\x9c\xc9pd\xa8\xab\xbc\xa4\x06\x88ta\x10\x9e}\xf6e\x97{U\xf4\xa2\x02\xa0\xe3\xa3n\x9b\xd5K\x9a\x0d\xc9\xad\x90\xaben\x1b\x82\x85\x98\xafJ4\x0at\x87<\xa8`\x8b\xe1\xd1L\xeb\x17\x18fX\xdfU\xef\xe2B\xdd,\x05\"K\xf6&\x88\x0d\xa3s\xec%47^h\x82\x91(96\xfcQ\x07\xc7\xc8\xa8\x81\x0d\xe7\x81K\xf6\xffx\x7f{\x0ac\xfc\xaa^\xd0^\xa9\xc1\xdb\xb4\xe6z\x13\xdd\x1b\xb8b\x94\xb0\xaad\xd8\x80|\x9f\xd2#<\xea/x\xd4\xe8\xe8\x1a\xa2f&\xe6\xae\xb018\xb3VoAx\xfd;R^\xd6\x82\x90\x91\xda\xb9['\x95\xff\xb7Z\xdf?\xfb\xb6b\xbd\xc6\xdb\xc6\xf6$o\xd7S\xa5K\x16\x1em\xe9A\xe0\xcd\xbc\xde\xc0\x9e\xd1\xbe\x07V\x9aX\x13\xa2\x0e\x1e\xdbg!\xf8Kd\x06i\x7fqO\xc5\xd9\xee\x97\xa7\xea\x09U\xb47t\x07@\x94%\x91\x80\xc8k\xe6\x16b\x02\xf2\xc1_\xabJ*\xff\xd1\xbd\xb9\xa5\xaa\xf4\xf9/\xf8;\x85\x97\xca\xc7\xa7\xa5\x08B0(-j\xd6@\xd4\xdf]\x83\xbb\xe1\xe0U\xfd\x0b\x82\x1c@/\xcb 7,%\x0d9\xe6]\xc3\x83\x9b11\x14+Hp(\x0f\xe9\x9ek\xe9\xb6a\xf8\x8e\x06\xd9\x8d\xfdE,S-~g\xadg\xff\xd4\x18\xaeDY\xbf}]\x05\xb20\x8dW4\xdc\xb8\x8d\xb7\xf1\x0d\xd0B\xc6\xab#&\xe4W\xcd\x10V\xe59O\x0e\x11\xe0\xe3\xc0[\x09\xc0F3\x87'\xe8\x06\xcb\xc0\xd4\x85T\xcf\x01:\x12\x0b\xb0&A\x0c\x83>\x14'\x19\x8bA@Q\xb4\xb1\xf3/_\x9bA\xc5\xa0\xdc\xc6\xf8\xfe\x99\xd1\xbf\xf8W\xb4\xc1\xc3\xdd\x89\xfdy\xa3\xbbu\xa5\xdb\xe8\xb07/\xc0\xc65\xa62\xa0@}\x14\x16\x07\xb1\xbfIOLEP&\x0b\xf0 \xe7h;\xfb\x9f\xa7'it\xea\xa9R\x9f\x9c\xd4\xf7\xae\xaa0\xee\xd7\x9f\xe4\xddv\x03\x8d\xadm$t8\xe7rE\x8c\xf1\x05\xed\x8eG\xa8R\x8e\x04R\x8a\x05\xf1+i;\xb1\x12j" +
+	"V#\xff\xcd$B,\xf2\xfc\xac\x8c\x13\xf6R\xdb\xef\xcd\xdc\xb6^Hu\xd14(d\xa3L\xd9-F\xee?\xf1\xee\x8d\xa6\x0d\xa5\xe9\xeb)\xee\xbf\x86\xcf\xf8\xac\x0fD[]\x9d\xce\xef\xee,%\xa6Tr ^\xf0/G\xc4@$ \xb1\x88}\x8b+\x8d!-\x09\xfeQ\xff\xcb\x08\xdd\xb1\x1fd\xaaU\x18R\xc0\xb8\xe4\x19u\xd3\xf7\xbdc_\x04\xbe\xac\xef\xe8\x86\xa5\xc31\x0e\xd3\x0aK|\xbcNv\xc3\x83\xa8\x02?\x84`\xef\xca\xac\x83\x19\xc0\xe0D\xcd\xb5\xfb\xed%^W\xfbdu\xd9\x0dz\xd4\x8f\xfc\xfb1\x05\xab\xa6\x97J\x85\xbd\x07\xc4\x1c\xf8\xee\xe3\x1a\xa2\xeb\xa5-5E\x8b\x07\x98GN`G\xbe\xb18\x03A\x97\x9a\xc1\x8a\x15\xdd\x81~\x17D\xe6\xd0\x8ac\x10\xd3\x88[{\xf7\xbe\x89\xe9\x1f\xc9\x8b\xd0\xad\x06W\xf6\x0bk\x01\x0a\xe2\x8c\xbc\x0f\xd5[\xfd\xf1\x8e\xcf\xab\xe3\xda\xd8\xdb\xad=\xc9\x82\xd2t\xe7\xce\xbc\xe5\xebDP5\x8d\xa8\x09\xacE#\xf8\xd3e\x80\xff\xe8D \xe6\xc3FW@Z\x1dy\xaaFI\"je\x07\\m\x01=\xc8\xa1T\x93\x09*\xbf\xb7Y\xb8\xf5\xa3TG+2\xc7\x1e`\x132\xd2.&\x94\x93w\x0a\x9b\xc9\xc8\xe5x\x15uG\xa9\x14\x7f'?\x8b\x9f8\x1c\x0f\x0aQ\xa4\x92\xd9\xb0@\xd0\x0f\x98\xdc\xf1\xb1R\xc2\xd9'\xf2x\x9c\xc4\xf5\x80\x9e\x91W\x06S\xe5\x141\x85\xef\xc5\xbe)ad\xd0\x7f\xb8\x01\xbe5#^\xa4\x9d\xc1\x04\xbb\x99XP} \xe3\xe6\x8b\xf0\\DF\xdcP\x12\x17[T\xfa\x89\x1e0\xdf\xa1\xc2\x92\x81\xb8\x968\x01\xec\x93t\xb7\x90\xe3\x14\x85,~O\xf8e[5\x9eDz\xfej\x13q\x19\x03\xb2)\xac\xae\xf3\xb5\xde\xa9\xb9\xe8\xb7\x16\x0b]\x19\x95\xbf\xffF\xa6\xfb\x9d\x07\xda`\x10\xe8\xdeT\xec@\xb7\"\x0c\xf8\x0b/]/\\\xfa\xdb\xcd\x08tU\xd6\xd6Y2$P\\t\x13(\xa4j\x98\xdeYw\x1f\xb4\x81\x94\x10\xb4\xe8qo\x81^W?\x16\xf9\xed\x84\x9e\xd3\x9eQj\xa2i\xb3j\x01\xa6\xcc?\x8c\xad\x88Wc\xe3(;\x08\x89\x1cu\xc1S\x18\xb7\xc7)\xe8V\xf8'8\xd55\xed\x7f\xc0\xa7a\xa2\xdbS[`\x83s\xed\x83\xa6\xcc\xd6\x93\x89\xe1\xf0*\xea\xa6\x0d\x8d\xcb\x84\xdf\x85\xa1\x03\x80\xbfGHC\x9c\xa3\xdc@3\xc2w4\x91\x02*\x0b\xdaf`\x99\x1e\xc9@'\xb6jE\xc3\xd8h\xc7G5\xfc\x99\xe2\x0c\xe6\x8b\x92\x8a>5\x81\xb6\x0d\xa9\x00\x10\x09'E\x04\xc6B\xc1n\"\x06\x85\xee\xf9y\xc6'\xbd\x18Y\x15z3\xee\xea\xec\x03J4\xe2\xcd\xe9\x114\x97\xb0=m\xb1\x1a\x8e5\xdd^\xf8\x9d#\xad\xe7\xd4K\x90J\xe4\x13S\x09\x0a\xa7\x1dm\x0e\x1d\x95)\xcb\xe0\xb3\x94\xfc\xf6\xc9\xb8\xad\xf59K\x1b\x82y\x0d\xbcp\xd9Z\xb8\\\x194\x1b\xee\x19k\xc3d\xf3[QO\xc5]\xc4SS\xd2\xb7\xbb_7\xee\x01d6\xe1\x1e\xd8\x0f(VKx\x0c-\x9c\xff\xfa\x108\xb0\x1e\xf6\x03g\x11\xad\x96\xce\x04\xe5\xe9\xafh-\x15\x0d\xcb\xb0\x996B\xed\xc48\\=_\xd3\xffC\xa6\x82\x002k\xf6\x09\x82X6Pz\xd0\xa4\x13\x9e\xbb\xf7\x04)\xdf0\x87k\x08\xfb\xfc\x83\xdc\xea\xf8\x8f\xe1:\x17\x14\x13\xa3W\x08\xd6\xc1e\xfd\xc4\x97S\x84\x7f\x9c\x93\xdapQo\xc3\x93\xbd\x8dc\x96\x89\x86lO\x88\xcfF\x13Q8\x14\xffmd\x8f\xfd\x8f\x1d\x1a\xb1\x15\xb2\x8c-X\x05C5\x1fAkW\xc0\xb1)\x8a\xf2%P\xf7\x07\x7f\xf0{\x03\xe7\x05\x01\x8cG8\x96k\x91(`\x01;c]\x05\xd5l\xd4\x0b~\x85f\xc5\x1a\xb1I\xff\x08\xf1\x10\x8eo|J/\x86Q:\xcezc%{\xef\xc0\x8aS\xb5\xc5JxR1\x0f\xf6\xf2\xc3\x9f \x8c96\xe0^\x0cT\x94\xe7\xe6\xe4\xd6\xb0\x99D32\x95\x1e\xb1\x9d9\xd4\x1bnIoa\"\xa6\x83\x87\x81B\x02\xf1E\xb6\xef\xc4w\x9e\xe05\xaa\xef\xf8\x17\xe93\xc3tC%\x92\x85G\xa6\x97\x9a<\xfd\xb3-\x83\xbc\xf0\x96hY\x83)`;\xa7Z#\xa8\x03\xffr5\xfc\xaa\xf7\xd9\xc7j\xb1\\\xbb\x8d\x01^\x82\xee\x1aE\xd3\x0fPp\x18\xa6E\x97\x01\xdb\xd9^\x97\xe0(S\x05*_kR\xf8\xa7\xdc\x12K\xee&\xbcY\x8dX\x95\xc5\x89Ko\x9f\xcf \x87KU\x9a\xe7\x1fm\xa8\xe7\xf9!\x1e\x10\x02\xf8\x8f-a`<0\xc6T\xf2\xbd\x0a\x19&\x97\x84\xb3\xbb\xc4\x12b\x81%&\xcar\xfa\x98\x9c\xc3\xa6H?\x96\x1dj6\x0cm\x87\xa3\xfb\xb5\xc75\xf7|{ZY\xa1\x88EJ\xe2P\x93\xa9\xb9\x02\xa4\x96vd\x06\xc8K\x1b|\xe5c\x83\x99\xf4Q\x0dd\xe3W\x90\xd9$\xdd-\x1f\xf6\xfe\xa1\xb5\xac\xd8\xfc\x925C%\xf6\x90\xf8\xea(2ea\xb0H\xb1r;\x03\x12MM\\\xbc\xe2\xf3&\x84>\xb1\x0d[\xef#<\x13s\x04\xcb\xd2\x0f0h\xbew\x11?\"\x81\x7f\xc9\x1b\xcb\xd4Of\xbe3f\xc2\x84\xe3)2\x80\x1e\xbcb[\xbdL\x9f\xad\x8a?\x16\xf9\xdb\xae\x14E?4?\xee\\\xc1}\xbd\x83]\xd0o,NG\x9eJX\"\xfc\x0e\x9bS\x1f\xe1\x9fq\xc9\x02\x9d\x12]\xc2\xdda\x7f\xf9A\xdd~G\xb8j\x08T\x90\x02\x0b\xcd?\x1e\x1b\xb6\x1f\xba'\x88\x00\xd0\xee\xc1\xd8\x90\xcf\x17tP\xc4\xb8*p\xbc\x18\x80$\x88}s\x10v{m-\xf8\xac\xd8!\xcca\xb5WS\x96\xfcL\xb6\x7f\xa6\x9c9\x83\xff`0Q\xe2;m\xac\xf8M\x94/b\xdf\xd9\xbap\x16\x95\x04V\xfa;\xc1`\x93\x03%\x0a\xbe\xb2<\xb0\x7f\x10\x7f\xe7/\x80\xa2B@O=j\"\xec\x1a\xc0|\xd7\x06\x0cD\x92,\xf5#\x18\xce\x8em\x01\x8b\x19\xd6\xd7\x7f\x86a\xedyO\xb0\xcfFb<\x1b\xa4i\xa2\x94\xf8F\xd6Z\xa7\x97\xc6\x9c\x15N\xfc\xc2n\x06\x93\xa6\x04\x14R\xe5S{\xb5N\xa4q%gF\xf5\xec\xb9^\xffA\xcd\xb3\xfe\x9e\xc8\xffK\x0ah)\xe5\xad[\xd2\xa3r\xe5\x86\\N-K\x19\x02\x8c\xaa\x8a\xc3\xa8\xd8\xc6\x1d\x1a\x14\x8c\xf8D~\xaa2\x06\x11\x8f\x0c\xe4\x0e\xe8\xad9\x9d\x8ei\x84}\x1d\x88jqJ\xfa\x13fj\xc0\xe8\xbaP\x02\xf0\xed\x83\x05#Y1\xcd\x1f\x1c\xab\x02\xf9\xad}\x11)\x86\x1d\xfe\xe6S\xf1\x1e?\xba\x07\"X\x95\x03M\x08~\x80\x10h\x83\xd1\x93\xb4\xe7\xfd\xee\xdfE\xc6j\xe8\x06IYoa\x8e*\xdd\x9b\xa5\xdc\xa0J\xbf\xd3\xf0b<\x90R\x141\xa5\x0a\xf5Q\x8f|\xc6\x10XL,\x92\xb0\x96\xa9\x94\x88\xdd\xcd4[b_\xb6\x93\xedt\x10 \xb8\xb9\x81\x81\xc3\xa3#\xdc\xe8\xe2\x14\x93C\xb3O\x07\x01\xa3Cd\xc5\xc2d\x0f\x09*\xa0e\x95f\x13\xd4\xc2|%\xc3\x81X\x95\xbd\xf0-\x9a\x00\xff\x19\x04\x9dnv.\x910\xc4\xb0\x14G\xa9\x82#t\xd7\xc5d\x1a^\xca\xfa'Y\x92\xf6#2$\xf4\xe8\x15\xda#+\x83D\x13A\xa9\xef\x1c\xdfJA\xef54x\xa5\xf4>\x1d\xd2c\xa1\x10\x90\xad\xbd8pQ\x18k\xe4rF\xc5\xdf\x8fq\x98\x86Or/_(\xe4q\xafKHD\x03\x8a\xe4\xae\x85uA\x14\xa0\x10C\xdbL\"8hy\xdc\x19\xcc\x86\xa1\xac?&\xbcy\x16Tn\xf5\xcc%\x91\xe6{%\x14\xe9\xdf\xaa\x856\xa2\xb4\xf8\x0d\xa6\x17\xad\xa5\xd0 d\x8ah\x06W\x1e\x00\xf6\xc3t9O\xb4L\xd2\x07\xbd\x87\xe8\xbez\x0e\xc8R\xf2G\xa9\xa2\x00\xa8hO\x90t \xe6\xfahng\x90RR\xd4r\xf2H\x02\xfd\x91W\x84GC\xc99\xf5'\x05\xf1\x82C*Q\x91B\x1a\xde &\x1c\xe1\xba\x1d\x18\x8e~\xf1\xf6\xab\\\xb9\x16\xdb\x81\xa83!\x834l\xb7\xc4ix\xd1\xe6CI\xa4:_\x8dS\x8b\x1c\xb6\xb2/t\x8d\\F\xd1\xd4C\"O\\\x0c:\xfa:\xa7\xbfee\x94\xf6\x8f\x15\xac((\xd5\x8d\x0f\x8c7j\x7fc.\x97]+\x0d\xe8H\xafJ\x88\xe5i\x95\xb3DL\xc7\xa2\xd4\x82~\xce\x1f\xcfI\xf0\xa6}\xa7\x02\x98\x800\xf4\x18\xba\xb6\x87rM\xe4oM/{o\xa9#\x10X`\x91\xf5\xbe\xf2L\xaf\x0c\x04\xc4#@\xed\xfa[\x0c\x15`>\x03\xa5\x9e\xa6_\x98\xdav\xa1\xff\x14\xb9\xd4V\x0e\xe8\xed\x9b\x06\x841\xf1\x96B/\xdc\xf7R8\x92\x17)#J\xbf\xad\x1f\xe8\xf8BFO\xac\x90\xb2\xffI\xa9\xf6\xffyk\xe3\x04\xbf\"\x81X\x89\x164\x18\x18\xe3/\xe7\x1b\xd1\xa6_\xa4\x9a\x10\x073\xcd\xac9\xf7\xf3\xaf}j7 e\x00\x9d\xe7J\x86\xf7\x9dc\xa47\xd5\xed7\xf1\xb7\xc3D`\x85\xa2\xfa\x82zb\x85f\x94\xa4?E\xd0\x9d\x90\x8d3\x95\x81j\x15Tq\xaa\x0e*\xd6\x06F\xf1\xb9\x10E\xd30\x171d\xab\xc0\xcd\x9am2\x16\x89\x84\x06\x0e\xf9\x0bv;eg\xd3\xdd\x90\x0e\xee\\\xda4\xc2\x93y\x8b\x00\xe7\xde;\xb1o\x1e\xe7\xc0\xbbC\xf4\xbd%^\x84JP>0I\xc7\x11\x0d_\x97K>\x07|4V8\xd3\xc6\xcal\xa0#-^t\xfbF\xb8'\x0b\xafY\xa9\x95\x02G\x99|N\x97\xf4OfM\x0c\xe0y\x01&<\xb8(Z\xf5\xad\x9fJ\x8a\xbf\xf3\x0c\xf5\xb7G\xf4\x13\x89\x10AvjbhG\xce\xc4\xd3d\x95\xe1\x88\x16\xb8X\xa6\x15\xa6\x94\x1e\xe6\xbf\xe1\x93\x08\x0eh\xb7\xbf\xe4Q\xa6\xa0J\x07#\xc6R`Jj:\x97\x81\x8f\xab\xab\x8a.i\x8f\x92(M\xba\x90\xb2\x99\x8a\x83w>\xb9\x03\xf5\xe4B3.\x08\x8bn\x90D\x82\xb4Xh\x8b\xa66\xa2\xae\xeeaF\x9a,\xea\xfbW\x03\xcd\x04\x1c\x02\xdb\xe7b\x87\xde\xbf|2\xa4l\xc53\x9e'\x84m\x08Fe8|\xf8~\xe9\x93\x9e\x1b\xe8\xf0\xbc\xd0j\xa7\xb2]\x8c\x13f:\x16\xbc\xf7O\xcc\xc9@\x0a-\x80\x98j\xa9.\x1d\xb0L\x00U\xb8\xb1\xa9x\x91\xba\x09(_\xc5T\xd8\xdc1I\xba\xf0]/f\x17\xb5\xb2#\xd9\x8c\xd2\xd2G;\x12-\xceQ\x18\x0ca\x06\xdf\xa12\"R\x82%\x8b\xd8\xb1\"c$\x8cI4\xe93\xf9*{\xc7\x9d7\x15\xef_\x12\xc0\xe7\x9c\xae\x11S\x08\xbf.\xd6\x1b\xfe\xf2]3\xf2\x99\x85\x8f\x1e\x86\xb4\x03\xde\x10V\x026\xa1B\x1e\x90\x16X7W\x97\xf4\xc0\xfe\x964\xecQ\xf0E\x14#\xbfk\xcf\x18$K\xb4\xc1\xbf\xc5'L\xa0\x19\x86\xc2\xd7\x1b(-w(W*\x1aTE\xa4\x80\x1c4\x08$\xb8@\xf5t\x04\x89\xcb\xba1\xe81\x7f\xefe\x18Q\xa0M\xfav\x8c\xddh\xe9\xbd\xb3?DK'=Z\x94\xc5]\xc8\xa0\xf9J\xa0\x8c\xc8]\x17\x88\xe6P\xf7\xff%4)\xde\x913\x7f\x18L\xeb\xccV\x92\xe1\x18y\x1a\x97L\x12\x0a\xaf>\x8dH\xd0\xea\xb1]\x9dP\x93g\xec$T\xf0)\xdc\x84\xac\x00{\x16Z\x06\x11v)\xc5=\x82+U\x83\xdc\xef\xc5\xef\xeb_\xd7[\xec\xbd\xaf\xbd\xc0\xc14.\xcfc\xdd\xff\x94\xeb\xa2N3A\xe1\xce\x97.\xc7`l(\x9dH\x058\xf0\xce9{n\xb9`Q\x82s\x15\x801\xe5G\\\x19u\x91\xe7\x1c\x1fS\xa4\xb8\xa1h\x8fJ\xcd\x8fL\x9e\xc1\x12\xed\x87\xe8\xe6\\ Y$P\xe8\xd1\xea\x9e\x0a\x81\xa7<~s@'\x89~b\x0a$=\x90}\xe3\xb1\x9c\xcb!\x81k+\xd6\xb8r\x10\x11\xffQJ\x96H\x06\x7f\x94\xe3\x08\xaf'\xe8\x9a\x96\xb1\xaei\x99\xa3#\xf6\xe1\xb7I\xa5T\xa41\xcb1\x89\xd8\xbe\x8d\xb6W\x183\x1b7\x0a\xfd7\x85\xbdf\x1e|\x14\xefK\xdfS\xaf\xfc\x8e\x05\xa2\x17HB\x06\xc7\x93\x1bp\x8bj/\xaa^\xe2\x8c\x8eF\xd5[\x19\x0a\xccI\xb0\xbe\xe2bo\x86rY\xe7#7v\xef\x03e_\xb5\xf6\x14:-:\xeb+l\xfe\x16\xb8\xd8\xc7\xa9\xccF8>\xa7\x8c\x16\xa0\x0dA&o\xe2H\xff\x95\x8bZ\x1fv\xe2\xf8\x93\xc4N4\x93fR\xaf\xea\x87f\x9a\xa1\xc9\xf1\xe6\xa8A\xf3\x9f<\xd4\xb5b\xa8\xabG\xedz}\x03\xd4fP\x7f4\x1e&3\xe1\x00\x08\xcd\x94\x95\xf2*\x9dFk\x97\xa2\xfeD\x8e\xc1\x8f\xbc\xdfHZtP3\x91\xd1\xc3\xbd\xd6\xe1\x0f\x05\x1f\xa4*\xf6\xdcf\xf9\xf4\xbd\x90\xf2\x805\x0d\xb4&\x1b\x85\x80\xfe\x1c\xe6|\xc4\xca'a\x0e\xfa\x8d\xd8$\xa2{G\x92(Xj\x89\xd9\xc2\xe0[\x8aIB\xa1\xc6\x16p\xa3\x84\x1e\xcb\x06\x18f\xefyG\x02\xeb\x87\xea\xb3\xc4l\x96o\x09\xe0+\x80\x9a\xd52\xfa\xcd$\xfc\xfd4\xf8\x06t\x0c\x05\xa5a!\x91\x1a\x92\xae@\x03\xa7\xbdg\xff*\x06 &)\xfb}\xb6\x01\xf6\x1fI\xb9\xc0`\x98\xbb,p\x01\x1aj\xca)*\"\xe0n\x9d\x84Ps\xdc\xdd\xde%C\x89\x97\xbeXP\xbf\xc2\x0f\xd5;\xb6nr\xd8)\xd2\xf8\xb4[\xff\xb0\xdb\xe1\xe2\xde\xb4\x81\x9f\xe0\x83l\x8fO\x0ew3\x05\x80\xe7\xb0\xcfoW\x98\x99\xf6m6\xaa\xe0\xe4\xa7\x1c8\xf9\x04fx\xf5\x9av<o\x9d\x9a\x03^W\x0f\xb8\x97\xa9M\xa3\xef\x05]\xae\x92\xaa\xcf\xbf\xc9v\xef0\xd0c\xe2\x84\x18\xf35\xf5X\xfc\xee8\x83f\x8a\xd0Y\xf7\x7f\xc0\xb5\xa4S\x1a1Ze\xa1\xc2g\xa4.A\xef\xbc\x12\x15\xa0@0\x88\xf8\x97\xae\x18\\\x7fv\xdbz\xbcS,ZR\xf0\xf8\xdc\xcf\x1e\xadF\x0e\xfd%\x98\xb0b\x03\x91\xb2\x84\xd64\x02$\xd7\xf5\x8b\x15@\xe6\xf0}\xab\xb8\xed\x91B\x89/\xa1k\xe7/Z\x81\xffF\x7f$\xae\x9fk[\x90]$Y\x1e\xfc\xb4\x0f\xbd\xdf\xb5\x1aW\xf2\x0d\x03\xb0\x0f\x16\xc1\x0b\xccCn9\xf1\x94\xd2\xd3\xd8F\x80\xa2\xbb]\xbd\xdbgI\xe12\x7fi\x92\xe8\x10\xc3[S\xe4\x05V\xb3.\xac\x1a\xe5\x1cs-\xcb\xb4]\xc8w\xa4\xd77\x01F_\xf6|o\x17\xba:\xd3\x9cl\xf7*\xd15\x13\xba\x94\xf5\x0a\xfe\xe9\xca\xe2\xf1\x1eYT\x81?\xdd\x12\xec\xed\xa1a\xad\xfe-\xa1k\x1a\xd44n\x8a\xc8B\xa4\x17!\x16\xb2\xcd]\x93\xc6\xd0\x84.\x8cA\xf6\x9c\xdc\xd6I\x14\xcf\x80\x05\"\x1e[\x06\xbc\xf7\xbf\xa2\x80\xcc\xea1[\xb8\xfd\xbf\x824J@fy \x15\x1d\x9bN\x80\xc71I\xc0\x16\x13q\x0e\xc0\x02mM\xff\x07\x1d\xe8]\xc6\xf64\x8d\xbb\xfaH\x81\xc8\xd3\x80\xce\xe1\xdbe\x88\xc3\xd7+o\x00\xae\x8f\xf7\x05\xa46G\xd0\xd6\x19%\xe0\xa1\x12;\xa5\x8e`R\x08\x9dwX\xbcM\x986\x14PY\xbf\xd1\xfd\xbc\x19\xe0\x9a\xcf}\xac\x15\x0f\xd9\xc2]4\xe1Q\x83z\xe5\x9cd\x8b\x03g{~\x06\"\x0f\x1f\xf0.\x8c\xc7b\xd6\xe37\xf0\xf7\xfc\xa7\xae\xdc\xceD\x02)J\x89\xd0\xbe\x87\x9e\xdb}\xfc\x95ij\xafzi\xc9\xf5D\xcb\xd5\xf6\xe1\x96t\xf7`\x07D\xca\x87(\xa6\x98,:U\xc2\"'>\x8bMS\\\xf4\xbb\xf6\xae\xa1\xe9\x08!\x8c\x94\x93\x0c\x17\xba m\xb4\x88\x147\xee\xa7v\x91^\xb0\x0e\xd2\xc4 \x84\xf1d\xd1\xeb\xbf\x0ed`\x94\xc3\x9f\xbcV\x83\x12\xb1^*\xec\x95*~\xf3\x00;\xfa\xd5\xc0\x8e\x03\xceFt}\x17\x7fa\x19\xa4x\x13\x82Vo\x18\x11QwS>\x0d\x90\x93\xdb\x8b}\xb0\x04\xcd\xd8(\x9f6#\x02D\xe9\xa7\x80\x03\x85\x84\x9f\xa3\x8f\xdb\xed\xef\xf7\xb0\xe8\xdb[\xe0\x07^\x82R\x18T\x1c\x85J\xb8\xab\x04N$\x00\x06\xb2vT\xb4\xc3Ph\x03\xe5\xf6\xb1\xf4\x8f3\x8f\xc3\x94\x19\x106\x9b\xf3S\xffI(c\xf9\xe0\xd2\xc6E\xb8\xe8UL\xf1\xa5\x19\x94\x9b\x1f\x1a#p\x19\x87eY\xbf\x0a\xf4M\xe8\x8a!\xe2\xeb+\xd5\xe3\xb8u1 \xc0\x96\xf4\xba\xd7?\xea!\x07\xf7\x86\xd3\xda\x18q\x18h@\xd8e\xe8;\xb4\x05\x96\x90\xb6\x06\x97\xa2Y<\xae,2f\xa6\x7fb\xc8\xef\xaa\x84$\x00,B\x89tG\xb5\x9e<I\x96\xa9(4\xddp\xd2\x1c\xac\xbcE\xcf\xaa;w\xa5}/\xc9^\xd5[\x1aZ\x11\x08\xa6B\\\xc8\x0a\xff\"\xc5w5T}\x0b,\xdd\xc0\x11\xce\xe1\x08\xf6\xf3'\xc2}G>\xa5\xc98\x96\xfe\xba\xb2\x1a\x93X,\xd0\xf1\xc4\xd7-\xf9\xd2\x97\xc20\xe1\xae\x996\xd7\xfe4C\xf8\x13\x81\xa5\xc92\xb5\xcb\xd9\x97\xcfJ\xa5\xa4{\xf6\"J\\\x14\x0b}\xe1;\x80\x00\x86d\xc0\xd6\x80Z\xbcF\xe2\x89|q\xfan\x05\xa1>\xc8\xf1\x12;gg\xb3\xfd\xdd\x926\x1f\xd5]\xf8`" +
+	"M\x14\xac3=\xe2\xce\xf9\xaf4\xd95n\x98\x9a\xb3e\xc5\x18\xfc/\x13\x1ei\xa5\x86e\xaa\x0e\xb9bz,\x03\x9b\xaft\xabgO\xd1d_\x9a\xe1\xffG\x92}#I\xf6=\x83\xb3\"W\xf1\x98x\xb8\xa9\xe1h\x01\xa5\xccb\xb5\xef\xc7\x96\xbe\xe00\xa1\xc5\xef\xd2\xe7\x1dd\xe4\x18aoO\x83\xeb\xaa\xef\xdewU\x87)\xf5\x89\xee\x841\xd4\xa6\x88\x81!\x13\xcd(w;/`\x05s\x89P\x1c\xcb\xe9\x05\x9dJ\xe0_z?\xbe\x09\xe0\x1b\x16\x9e\xbaW\xbf\xe4\x88>\x0b=9\x8d\x8e\x9b@\x8f\xbe\xdd\x14c\xe5h$\xb6\xca\xbf\xab\x11\xe2\xf6``\x1dM\xb1\xda#Z\x93\x03h\x9f\x06\x02I\x0c@k\x7f\xa0X,!m?\xd6\x91\x09\x10{\x805\xfb%\x05\x91\xf1O1'\x06Fp\x16\xa1\xddg\xcc\xe3\xach.pO\xb4B\xf9\x93\x0f`?\xc6^P\xdc\x15\xa8\x8c\xb8\x02\xcd\xb9,?\xe5\x96\xc5\x86\x92\xf6\x7fYBP\x01\x13w|\xf0l\xd1\xc7\xde\xb6\x82\xc90\x09\x9a\x03R\xb1\xed\xc6\x96\xba\xfd\xe0p\xbb\xde\x1a\xd4\xcfh-\xf6a\xdc3 \x1e.cv1\xd7&\xf6\x89\x92J\xdaw\x05Kn\xc9\xd94\xac\xb0l\xb2\xacF\xd2\xbdV\x06\xe9uS3}\x1d\x01\xd2\xda\xb8\\q\xc1.=e\xbd\xec\xd5\xa7:\xb1\xa4X\xdee\x7fjYl\xe8\xfb\xe0F\xc1\xb1\x9d\x1b/IU\xce@\xd3\xac\xd0\x9b\x1b\xe9\x85l\xa9\xfe#\x0f\xb4\xc0\xb1Ep\x9e\xea\xdd3gGh\xa8D\x86\x96\x00\xc15b\xcas\xd7\xd3\xf5\x91\xe2\xbf\xf8j\xd0\xf0'\xdc\\\x10\xa1=\xc4$\xab\xfd\xb3\xa6\x02\x06\xfa\x07e\xbd\x82WP\xf2\xa1+Ic\x15t.\xffI!\x1f>\xa6\xec\xf9\x14\xbc.\xed\x0b\xb6x+\x061Z \x0d\xe2\xf6\x9f\xf5#sb\x1f\xa9\x90\x18\xc2A\xf0I\xfet?\xab\xb0fa\x07\x97.G\x12\x139\xefQLv\xc2W}D]\xaf\xfdb{j\x94R\xf7\x97[jz\xec\x18{\x8d\x15,\x08\xe8,\xeb\xd24\xf4X3\x90\x1a\x03x\xbf\xd6\x80\xf7\xeb\x1c2\xd6j'\xf9\xa5\xa4\xe3\x04\xb8\x09\xb5\x7f\xaa\x1f\xbb\xe3\x91\xe4d\xd0\xc4W+4\x1asZ\x0d\xcaA\x1d\x82\xa5\x09\x90\xcfj7AE\x1d\xf3&\x0d\xba\\\x902\xa5\x1d\xdf(\xcaIy\xa4\x85f\xb0|\xac<\x12e\x1aL?wo\xf7\x15\xf7\x95\x80\x0f\xa4\xaf\xa1B\xf4\xd9\xc2\xa5\xf4\x1aqO\x11\x88\xdbR\x9f\x9a2f\x83U\xe6\xe4\xb6\xfe\x0dc\x1b\x87z\xee\x86\x87\x0b7\xdbL!\xff[\xf7ZL\x83\xa3N\xbb\xc4\\i\xbe\xc9\x15C\x0cp\xd3\xaf:\xf5Xr\x98Jo_ 4\x98\xc8\xbfH\xd2p)\xa2\xe8\x09c!\xb4K\xf4\x86,\x95\x07B`\xad\xa1\x12&\x11%\x14\x06\x88K\xe9\xb1\xfc\x12\x17s\x978\x03\xad\x0cS?\x8a\xdd%\x9a\xb3\x94Q\x89 |dE\xbdj\x070\xbbU\x0d\x8d\xddxV\\\x8a\xbe\x86\xc8\xbaI\x1etht\xd0\xf2\x0d\x16\xe5\xb2\xae1\x9c\xb4\xcf\x0f\x051`)\x9d\x91\xa5\xf3\x94\x1d\x81\xe9\x82\xc6\xbe*h\xdc7\x93z\x9b\x85[\x8d\xa0t\x7f_a#@rWq$w\x15\xabzT\xb1\xaaG\xf5?&\x0d\xb6\x84Z\xfc\xd7\x88\xecc\x09\xa3T\xce6\xb5\xabj\xe6\x83\xa5\x1d,|\xdc\x9f\xb0#ec\"e$Y\xd6O\x80\xce\xb1\x82T\xe8TA\x09U\x99k\xc5\\?@\xf1z\"\x0dvi\x8bU\xca\xbf\x01\x09\xd0G\xeb\xf8h~\x10R\x7f\xdc\\\xdb\xbc\xe5:\xd3\xc1\xed3\xa0u5\xf3P>\xc0\xb1\x06\xd9\x17\"\xf6\xe4\x96\x97~:o\xc7\x97\xe7\x09M\xfd\x8f\xfb\xe8k\xd1(\xcf\x88\x8c\xcb\xf9?\xd0\xb8\x1eKA\x8e0\xe1\x0eH\xcfJ\xbd\x8d\xedzp\xaf\x8frL\xfd\xe0\x9bp\xf3\xcb\xfc|@\x04\x85!|\x87\x12z4C\x09\xefh\xa1\xaaN\x09\xa1\x00\xd20\xe3\x11#']\x8e\x07\xe6oLS\x8f\x05\x1c\xd5uv\x06;\x84$\x04j\x17 \x97\xa9/syz\x8aU\xd0\xef'\x1e\xc46\x92\x18.T\xb1\xae\x86\xec\xc7\xe7?hfMI1n3\x81\x01\xb5\xa2\xdaR\x10\xd1\xbf\x08\xb9\x99\xbeGv\x12\xe1.6\xf3\xc8%,\xb8ye\xa0\xa2K\xed\xe7\xa8\x09\x9b]b\x80\xddMg\x84r\xbf\xb5R\x81C\xc0\xd6\x99\xcd#4[\x97/fk\x1a\x82\\J\x0d$\x8fJ\x13\xfe\xd1\xc1\x03\xa7A\xc1S\xbd};\xf5\x1a\x1eO\xc0o\xf8K\xb3\xd8\xaa\xddYlq#\xbb\x91\xf5\x04K%\x7fyL\xd7\xb3\x8f\xb6\xfb(\x9d)W\xfbU\x8c\xca\x8d\x83\x03M\x99\x98\xe2\xf1\xbd\xdf\xa9\xdd\xa3\xc3\x87\x00}\xcb-\x1b\x18\x89g\xcc2\xde\xf1\x95\xea\xbb\x04d\xc9\x02\xa4;\xe0\x7f\x91m\x7f\x92ds\xedQS\xa2\xa8/-\xbc\xe6\xd9i\xa51G9]\xc7\\u\x82\xae\x9bp\x89\xffeB\x98>[\xea\xbb\xfa\xbbO\xe8\xf5s\x14\xbd\x1di\xa5N\xa5l\xc93U\x06\xad;(\"\xedE\xaa\xfb\x84E\xbe\xe5\xf1`\x0bL\x97\xb4vcw{\xd1\xcc\xdc\xa5\xb5\x8f\x0e\xee\xf8&\x0e}T\xc6\xd0k\"\xc5\x8c\x18U\xe8\xd5 /\xe1\x95\xf9=\x89\xec\xf9R\x9d75\xac\x12\x09~{\x91\x15\x13\x12T\x8d_\xdf`\x952)\x81s(\x8e\xeb\x9d\x0b\xf0\xcew\xc8\xb9\x0e\x0c_p\xea\xea\xac\xe7\xed\xeb\xeam'g\xa0NH\xf8H\x10\xd6\x83\x16\xc04\xad\xcb\x03\xbe\xb2Ew\xeb%%\xf6\xa3`\x80}\x07M\x19\xc2t\xc8\x06\xa5nT\xe1\x84\xa3jB\xff\x8d\xc9\xf7\x7f\xe2D\xf2\x07\xdcV\x0ev\xd2\xc15[\x1a\xef\xf2!Mb\xe8dfKtd\x965\x91\xa8\x023tZe\xd2\xb6\xbbx\xd0a\xdfR(\x14\x09h\xadm\x9fV}\x0f\x83\x8c|P\xee}\xbe\x00,\xb6\xd0\x04=P!C\xa4\x0d\xdb\xdc/t\xaf[I\xe0D\xfa\xf0~_-\x9d\xa4\x1e\xfb\xcc\x00%\x03\xa4\xf8}e*\xeeLB\xed\x90\xc2\xf7\xfc\xe7\x80\x94\xf9\xc2.\xa5\x06\xbfs\x11cN\xf9R\x15\xee\xa1\xee<><X(=!\x02\x8e\x17I\xa3\x16\xbe\xbf\xbf(\xa9\x05\xf1\x84\xbe\x17y\x94x\x91\xd6D\xefB\x87\x17P\x84\x84\x1dO\x99v\xa7\x0b\xf6\xdd\xeb\xe3\x98\xb4\xd6\xfdA\xdb\x11\x0e\xea\x82\x1b\xdb\xd1O\x8d20\xeb,U\x0c\xcc\xeeN\xfc\xa6\x17H\xcbd\xd5\xef\xbc\xbcOqj\xaf\xe2\xaf;B%s\xaf\xb5\xfd\xb7y\x9a\xb7\x98\x9e+\xb1\xbeN\\\x1c}\xdd\xcf\x0c\xf1\xa5\x1e\xcf\xa9n\x8b\xff\xdf\x84\x888Q\xa5\xbcI\x12l\x13\xd5\x19$\xe8\xf4\x94wg]\xf5\xe5*\xba\x88uT\x07-X\xea%\xef\x10\xa8\x1d:\x02\x13\xd6\xe22^\xaa\x9f(\xdc\x9aj\x0d\x8c]\xf4\x14\"\xa2\xd2\xdd{\xf3\xeek\xb3v}\x8a>\xd6\x7f}\x91`\x0d\xce\xa5\x05\xe2jM6\xc3\xfc\xc0G\xa1V^\x91\x0e\x9f \x05\x15\xa1\x12\x9f\xaf\xe5\xdd\x08`a\xd0K#\x83\x9e\"\xfa\xd4Id\xd8\x1d\x879\xa6\xed\xf7\x12}R8DR\xf2\xff=\xef`\x87\xb4\xea\xec#A\xa5%\xf4\xf8\x0dKG{\x97\xedF\xff\xc6\xa8\xa8'_M\x88\x10\x0c\xc2\x12k\xaf,\xd4N\x0eRF|\x16[\xd3\xbc\x04`\xb6H\x08\x9f\xb5\x9f\xa0\x08\xd5F\x9e\x16w\xac\xa4@\x9eb\x1a2\xd2\xba\x90\x92\x9f\x11V\x10\xd6tJrB\xa3\xd9%4\xdf\xaaA\xc4\xea\xa1\xfeF0\xbf\x95\xea\x9c\x09\x00;\x98:\\\xeb6\xea\xb5la\xddP\xf5\xeb\\\x1a\x08\xb9\x0a\x80\xcd\xe3\xe0\xebw\x90\xdd\xf66Y\xf7l\x9d\x0e\xdd\x14\xfe\x0f.?\xbe\xc5\xb0\xae\xdae\x8a_%\xef\xae\x949\xed\x07\xb9Z\xa63\xc7C\xd5\xad[\xd5\xbf\\\xe1a\xfa\xbf\xd6\xaf3\xe2?P\xedsU\x0e\xa5\xbf\xa8\x19\xb7\x8b\xfa\x11?\x97JU;\xba\x07\xfcO\xb5\xf5\xbe\xc1\xe5\x9fk:uaE\x12$\xd6\xeaJ\x96(\x14\xa6\x91\x97\xff\xab\xdf\xaaQ\xc1\xa9\x9d\xb8\xfe\x9d\x03\x04e\xf7/\x00[3w\x0d\x10\x1bS\x09H\xf5\xb6!E\xf1\xd7\xec\x1d@\xcc7-\xfd\xffBu\x893\xe8\xff\x1a\x82\xb1\xd5\xa8\xc5\xf7\xcbL%\x0e\x1a\xd5\xec\x11S\x0a\xb3J7;\xa7\xea\x1b\x19\xb5\xff\xef\xaa\xa9\xe4\xd6K\xa1\xd4\xdb\x9f2)\x95m\xb5\x97\xd0s\x9fB#\xe3v'el\xd3\xc8\x1d\"\xfe\xec\xbf\x10n=\xce\xac[(]\x16D\x89\xdf\xca\xe6*&\x81h\x92Rf\x82qQ\xdd\x88\xabq\xd4\x1f\xf2\x06\xfeW2ua\xe0:\x8c\xca\"\xae,ax:\xfe\xcf7\x01-i\x1a\x13*HQ\xff\xa7l\xe4(E\x112\x80\xc1\xa2\x09\x98\xe7s\xa6\x18o\xb8SKS\xd7\x99,\x08\x83n$\x01D)VA\x81\xc0\x95\xf95\x84\xc6F={%\xda?E\xfd\x7f\xfc=\xb1\x92n\xe1\xc6\xc7\x86\x8a\xaa\x87O\x12\xc9\x0c\xa5\x84\xbe%\xf7Tu->g\xe9\xc6$\x80\x95\xd8\x1e\x85i\x9f\xe0L>\x85\xb2+\xbf\xc9\xf2\xfe,m\xda{O\x15/\x11\xefC;8l]\xf9%ak5\xc5\xc5\x97K\x81!\x00\xf94\xcfP\xa9\x02K-\xdaKS\x8f\xd7\x94C\xb1I\x0c\xd1'\xda\x90\xc28\xbe\x81\xa2\x86\xf6~\xdc\xe0\xec\xaf\xff\x8dcl$\xa4\x93\x1c\x8a\xff\xb8\xdb\xa5iN\x7f(`\xb3\xcft#\xba\x96e\xc0&X\xaf\xee-^\xd4\xfd\x0f\x056$F\x7f\xec\x14\\\x88\xdcC\xe8\xe2\xbd0&Jd{%\x1co\x89\x05W\xa9\x00\x95\xe5t\x96=\xaaE=\xcc\xed\xc8\xb4E\xa5\xf6\x00\xd4\xd7\x8a\x84\xdd:\xfe\xc8\x01?#5\x97\xfc)\x92\x86x\xf1o\x85\xea\x8e\x84+\xb0\xf2\x1es\xcbe\xb7\x12(io\xb0\xa9\x9cNK\xea\x0d\xffR\xc9\x0cA\xcdr\xa0\xcaq4O\x86~-\x8av&6\x96\xa1\x90C\xb0rZ\x09`\xf2\x0e\x16\x0a\xef\x82+\xb0pG\xafN\x01\xbepU\xd7\xa9T]\x87Z\x0b\xdc\xfc\xd6)\x83\xec5\x04r<<@\xdcj>41S\xbbT\xb8\x83<\xd8\x0f\xb8\x0e-\x1aS\x1fO\xd7>J\xea\xf4\xf4\x14\xc5:\xaaaF\xa3\xec*\x91@@\x81\x99!J\xf7\xd0AW\xab{\x96\x07\xc2\xb0_\xbb\x01\xef\x8a\xe3Qe&\x89wu'\xfa`\xe2\x8d\x84kB\xa2\x92&\xda\xf4\xcc\xfbK\x90A\xfeW\xac\xa9N\xf4\x18\x18\x90\x86\x947_\x8b?\xces\xca9I~\xd0\xe7\xcc\x18\x9b\x80Va\xfb\x9e\x87\x10`\xa8\xfe\x15E9\xc9\x0eWl\xd8<\xc3\xe70\xce\x1f\xf2\xb6\x81S\xa5\x96\xf6v\xed\xf1\x8f\x94m>R\xc5\xb4\xd7f\xdc&\xa4\xef\x92l\xee\xd2\x08y\xa5\xdd\x89EmDhR\xc9\xfe\xbco\x81\xfb\xef\xc3\xc8\x96b\x13c\xd0\xd9\xf1\xean\xab@\xe2\x82j(/\x1a\x99\xc5\xbf!\xf4u\xcf\x9c\x15\xaa\x84#\xdd_\xafW\xaa]\x12(6A\x0c\xc2~\xc7]:\x1cl\xcc\xb4\x90(\xbf\x1b\xe5,\x1cW\x11\xa1\x0d\xa0\xaa\x9f!\x82\"8\xb8\xcc\xbd\xbf\xa0\xfb\x85\x92\xfc\x7f\xa0\x02\xc9c\x1c\xe1b\x84\xc1`;\xba\xb1\xbf\x8d:T\xb3\x84\xf1O\xaa\xab\xa8%E\x07\x04+\xffm\x0c\xf5\x95\x95@\x82\xef\xefB\xb96\xa9\x95\x8c\xe2\xc2\x84\xf0\\K\xb9\xac\xef\xfe\xa3\xfa\xa8\x86\xe2Q\\\xf5K\xe8\xb9\xa0\x05\xb4\x1cFC\xb6\xb7d\xa5)\xf1\x05\xcaQ\xb5T\x0b\xa3\xd3\x10\xcc\x1dN\xa2\xc4Oi\xfa<\x03\x9d\xf4Q\xcaH\xf9\x05s\xc1\x0c7b\x1fX\xc9[w\x09\xe7\xb8\x18q\x0c\x1b\xaei\x81&H\x80\x99\x84)\xe4\xf7\xe4R\xe9\x1c\x85\x02\x9b\xc6\xac Q:\xb02\x9b\x95\xd5[\x89\x8d~\xbfNT\x91\xb1(\xe4-\x07\x9a\x80,\x15\xff\xdc\xf0\xcb\xb2\x16G\x9d\xd0'\xbf\x0d\xf6\xb8\xb9\xe3*b\xbc\xdc\x93\xc2~\xa49;\xe8\x89\xae\xa9\xaa\xff\x00\x18\x86%2\xf4\xd3a\xe0\x922\xcb_\x10U\xc9B&+\xdf@\x97\xbah\xc6\xf6 \xe5\x80\xde~\xba\xddA\xd39\x0b\xcfD\x87G>\x9b\x94\xcfYH\xc4\xb6Jt\xdd\x0c\xf75\xed\x09\x1b\xd9\xe1N\xf5o\xa0\x86\xef\xc6\x06\xf6\x04CA1\xb9e\x95r*~\xa54\xeb+4\xc5\xc5\x14\xf4\xaei\xa9\xdb0t\x10oU;\xe5}\x10\x035/\xbe%\xaa\xf5\x0f\x04\xbf\xc3\xfe\xa9h\xe8\x09)<\x1a=?\x18U\x80\xd5\xd0ED\xe3\xef\xfdS\x86S&=r\xe6\x00\xf4\xe9~\xf1G\xf1\xf26\x1f\xad\x8c\xab\xd6@AP\xa9P\x1b=\x93\x1d\xee\xee\x9du\xb1vM\xfe\x89e\xffq\x98\xa1t=\x80c\xd9\xcdp\xc3{\x14\x11\xb0\x8c\xaf&\xee\xcaFBS\x8e\xbb\xed3\x1f\x9e\xa8\xe4\xd4\x08\"B\xbc-EaE\xc0\x06_\xf07\x1eB\x7f\x10o\xc5#I\xc1\xe1q8\xca\xf1\\\xc9qx-\x9c\xaf\x9aa\xff\x19\x02\xd8P\xd6\x00W\xba\x9d\xa2\xd5G?\xb4\xc2\xd1Z\xf32C`\x12B\xbc\xf6\xec\x95\xf4\x0a1*\x8c\x9d\xfb\xb7J\xf9}F\x89P\x05qa\x1bh\x13\xf8[\xa8\xbcc\xaa\x18l\x82[\xde\xdf\xef@\x19\x0az\xf8\x0bb\xd4m\xe02\xbe\x88X\x81\xff\xaa\xc6>\xcc.Oj\x05x\x82y}\x03\x17p?\x811\xcb\x05\xab\xc3,\xcc\xe9\xb5\xe8>\x9a\xb4_\x84hK\xe9\x969\xa2\xa4c\x18\xf9b\x08\x8d\xe0\xa2\xd5\xc4\x9e\xc7\xfds\x03\x9b\xf9=\x8b]\x0d\xc2\"\xa3\x88\\\xb7\xb4\xc8]\xe7\xaex\xa7*t\x18\x09\xeeu%@$\x94/\x83\x1f\x98\xaf`\x82\xc2\x1d/Pj\xdd\x95\xbe/\xa2,\xcc\x1d?Q\x13x\xa0\x81F\"\xf5\xf0\x1b\xb7-m\xa0\xf6\xeb\x1e\x9ct\xf6$\xeb\xdd\xad\xa8y\x9b\x03\xc8>\xa7\xfb\xfe\x14\xb4@\xfa\x1e\xcd\x99\x0a\xc0\xbf\x93\xd6\xe7J\xfa%D[\xae\x85,\xc5G\xdd\x1a\xbf4e\xecG\xf5\xb7\xac\x97\xc6\x8d\x01\x82\xd6=\xc6\x94\x85\xd3\x0d\xdf\xf8\x93\xcc\xa9C\xcdu\xeb@cX\x84\xc7\xd5\xb2\xa92\xea\x9d\x1c\xd1\xa9}4\x18\xe6\xb1-U\xc0\xd5:\x1f\xd6\x9e\xf0*\xb8\xb1\xc4\xef\x0e\x96\xf7\x88\xe1\xf7i\xc2\xe9$\xc8\x95}\xef\x09\x16\x02\x03\x8b\xccy\"3\xea\xfd\x1ar\xafFp\xe1\x05i6\xceO7\xb6\xc7h\xf9\xe9/\xd9`8e{\xf375\xf6\xfe'{sj\xaci\x94L|\xda\xc8\xdd\xa2s\x84\xed'\xba\x15\x1d\x80&\xa4\xa0\x00\xd3o\x13 d\xaeC\xae\x95\x85\xcaLX\x19X\xc6\x01\x98\xadM\x01q+9\xd7:\x94\xb5\xce\xc9*\xe02\x00We*\x1b$n\xbb\"\xcdd\xfd9r<\xfb\xaa\xdaG\xf2\x8au\x87tO\x0d\xb1\xbe\xf2e\xa5+\x98\xc7\x06\x0e\xf8P\xaf\x9by3\xf9\xd0\x1a\xa6\x16S|\x00\xd7\x83J\xa1\x9a\xe0\x16c\xb5*a\"#\xb2\xc2\xb6\xf4C:\xbb\xd2\xd8\xac\x88\xf7\x8a\x8a7\xb9S\xfc'\xa5.|\xef\xa4\x12\xf3#\xf9H\x04\x03\xe5\x81b\xa9/B\xdfX\x88\xe7w\x94\xe4\x11\xc9\xeb\x039\xf5\xcd\x88\x1c\x05U\xebw\x94\xa38\x88JAB\x92\xe5\x07E\x91\xae\x82\xe3\xea\x8d\xc1\xde\x8f\xa6\x8a\xbdi\"4\xf8i\xf6\x956u-\xc5\xc1\x98\x9d[\x8c\xdf\x96\x12\x02\x83B\x84\xde.\xa2\x88E\xbda\\\xbd\xc5\xbbh\xcf'\xafT\xf4\xc7o)\xe1\x80\xf7\x0a\xb0s\xfe\xf2u\xdf\xe5\xe2\\[\xca\x8a\xd0\x1d\xc0\x91\x16\x81\x8c\xd2\x92 \xd1\x85\xd4m\xe4_\xd9\xc3O\x11\xfa\xdd\xf1\xc6%p\xa7\xcc\xd5\x9dE9h\x10(\x8bP+R\xe5\xef\x17@\xe3\xac<\x03L:\xd3\x8f\xd8\xda\xdbdn\xd2\xf0\xa3\x88\xe4\xb1\xb9N?fv\xd0\xab\xbc';-\xcf\x0b+\x09\x99J\xb1@\xd9)\xef%+\xa9\x13\xaa`AG\xd0\x87\xc7\xe9\xa2\xfcO\xff\xa0z\xa0SG1\x8f!\xccId)R\xb7\x97\x0a]3Pp\xbaG\x82\x1d\x94\xe1\x04\xbc\x8c\xf4Oeu[\xf8ql\x00\xa4:\xe1\xcd\xde\x82\x94\xe9\x83s\\\xc5\xea\x15\xfd\x11\xfe\xf3_\xc8&\xe0\x8f[\x0c\xbc\xebG\xfe#\x95\x1b)f\xbc\xf65C\x96\xa3\xe3p\x03\"&\x90\xaf\xcc\xad\x15\xbd\x83\xd5r\xaeC\x0b\xf4\x17\x0f\x9a\x01\x17\xa4t\xa2\xc0\xb4w\xf8\x9cg\x94\xb1<\xc66\xe46\xb7,\x8d\x98\x92\xe2\xac\x07K\x1a\xa6\xe5\xc1\xc0\xbc\x1a\xb8\xdd\xd7\xff\xf9g\xfb\xcfgz\xe0\x8f$\xcb\xa05\xf2\xa5\xcdj\xa4\xa7\x7f\x0b\xc1\xdf\xda:\xa5\xcccJ\xd7\x03\xd6p\xc7\xf1\x92\xe3\x88}\xf2\x86\xb7\x15\xe2\xcb\xc6\xda\x85p\xe5\xbfnD,\xb4\x03Y\x00\xccXA1\xf0\x89u^\x06\x1f\"\x17r\xfa\xfe\x01" +
+	"I+\xb4\x91_\x08\xd6h\xe8\x87,X\xcf\xd1\x8f4\xf7\xb2!\x13\x8a\xaf;\xc6u&\xa8\xf7\xe2\xbe\xc8_\x8cU|;\x04.\x8b\xdf*p)\xdf\xad(~\xb9,\xf7\x89\xcb\x01&\x09\xd2QM\xd2X\xd3\xbc\x0d\xe5\xb3\x09\x7f]KZ82\x8d\xb7\x14\x1fu\xb9\\\x08\xa3\xb8*\x12$\xd2WbD\xc9\xd0a\x10\x82\xa7f\"K\xedS\xa94\xb3\xd6\xe6\x99i\xf7BcV\xb8;m\xe7]\xbc\xc3 \xe2\x94\xc2\xb2U\xdc\x1e\xc2\xec\xdf\x09\xb2$\xdf\xf5\xd8k\x0c'\x08\xf1H\xe1\xb8\x15\xe2\xccb\x04_\xe0(n&M\xc9o\xf0m\xe0R.\x9d\xfd \xe8{\x98b\xb0Z\x19\xc3\xa0\xfa\x97G\xf0\xfdZSh]\xe0Px\xe0.\\\xf0\x0d\x1f\xcf\xbd\xb6\xdf\x90f\xb6\xb4\xd0>\xa0t\xd4\x162\xc0G\x1a\xe6\x95h\xc5li7\x7fd$\xa6\x0c\xc8\xc4\x8f\x86\xa0\xa9!\xce\x05\x8f\xbc\xd0)\x03\xd8\xfd\xfd\"k\x1b\x0a&Y\xea\xaf\x08\x9cG\xba-uXK\xa0\xbe\x83e\xab\xc4\x8dEc\x8d\xd9i\x97U\xa8\xdbO\xfc:\xb7\x1b\x87\xf1\xfd\xaf\xdbn\x15\x91W\x09\x93\x1b\xe5\xa0\x08\x8ec\x84\xff}\xd2\xc5\x14Q\x12\x8fEEn\x89/\x1c\x09\xcfK0\xd1\xd0\xdcA^\xe4I_\x7f\xea\x96\xf9\x1b\x0c\xb8\x85\x9cRx}\xa2\xe0Xh\xf2t\xfc(\xd2!Z# v\x94N\xd3\xe8\xe6@5\x97\x1eJ\x81\xa3\xbf\xa2\x07~\xa7=>W\xac\x92\xee\x81[\x05\x1bB\x0f\xc9\xcf\x08\x93\xc7f\xe8?\x87Y \xadiY\xf7\x91\x18\xf1~\xf6Vr!\x7f\x91\xbc[N\xa0G\xe4\xc4G\x95\xd7X\x07\xa4\x195%0l\xc4\xb366\xd6\xe1\x93\xa6\x1c _)\xe9\xc4(%\x8d\x9bQ\xafB\x12\x9as\xde\x01c0&\xb6Z\x81\xe9C\xa5\x89\x83\x86\xa5\xfa\xff\x18\x0b\xb5\xb3V\x1b\xea\xf8\xa9\\\xa2\x80\x06\xc1\x176' \xc2\x1e\xber\xe93o\xc3@Q\x8c.\x07b.\xd2\x92\x99\xa3\x8b*\xeby\x19\x85\xa8\xf7\xd5\xa6[4\xb1\x1f'\xe8\x10\xa2\x1c\xca\xbd\xa6x3\x94\x87^4\xe7\xf6\xe5\xf0;\x13\x91\xa8\xefB537K\xae\x19\x85\x86\xfe\x94\x95\xe0\x17\xb8\xa9\xb8\x86\xe2N\xfbGuzZ\xf4\xd0\xfd\x7f\xdd\\g\xd4k\x84\x88b\xffyGX\xf7BWH\x7f;^I\xc4\xb3D\\\xa5d\x90\xf0\xdb\x16G\xc3\x96\x86\x83`\xfd\x1by\xf9\x0fYd\xb8\xa3\x80\xb9O\xa5\xa4'\x18}\x05\xdb\xcf\x1b\x82\xae'\xe8\xe3^+\xec\x89\x182\x80D\x9c\xf6:\x1ep&0\x1e\xcbt_\xd7\x88V@U:\xd8[\x01\xcb7\x87\xd2~`u\x8b\xd9%\xeb\x7f\xa7\x9b\x99\x92]\x0d\xa2\xec]\x8c\xddc\xf3\x80r\x02;\xf4\xa6Y*9qu\x15e\x18\xac\x16\xef\x0cVOb\xc2u\xb7\xb0\xd0\x9e+mE\x89\x19\x98\xa0\x8f+?\xb5\x02\xfa\xbd\xbel\x86svF\x7f(p\xea\xf5\xad\x1f\xa7r\xa7\x802\x91C\xff\xa2~\xab\x1b_n]\xb2\xbd+\x84\xa9\xff\x94\x1e\"\x82\xae\x81\xb7\xe5\xafG\xdd\x16q\xe8\xee\x9b+w\x8fD\xb6\x16\x12^\x85\x1b\xdfSB\xc8Q\xc6\xd3O\xca\xfe\xbeC*e\xc8\xc7K\xa9\xaf&\xd0\x89n*q>(\xceA4d\x8bX\xe02\xd6\x98\xff4\xe1#`3/\x16\xbf\xba\xd5S\xb5\xc3$\xd2H\xd6T\x14\xf0$\xae\x08>\x12\x15\xee\xebLz\xa2y.\xd2\x11\xa6\xa4\xe5\xc6\xdd\x86`\xa8\x08\xb0\xf1\xd4\xfd\xc5\xbf\xff1w\xc7\xf4\xcf\x87\x1c\xe8\x85\x86\x84\xd9\x0b\x80H\x0c\x86@\xf2`c\xa5\xa1\x9c\xf2\xdf\xa1\x8b`\x1c\x046\x1e?\xae\xcc\xb1\xde}Q\xfb)\xa3\xbaP\x1d\xcb\x15[\x9ee\x8e\xc1\xd5\x89\x80E\xaf\xd8!c-~\xa6\xf4\xc2\x98\xeb$\xd9Lj0\\\xe5\x9b\x8b\x14R\xa9Az\xcc\x8dC\x15\xeb\xf6Q\xb5'\xd4\x0e\xe5K\x0c0\xb5\xfa\xf1\x07j70c\x0f\xd7\x89\x88\xb6\x93h*\xc9\x18)\x8c\xcb\xfc\x9b\xf2{\xd9p\xd7v\x113\xd9\x92r(\x12\xf3,\x9eb9\x88\x89\x87|\xa7\x8a\xe9X`{\xda\x87^?\xc3\x03\x16n\xad$\x92T\xc1S\x97\xc9\x8a\xf4a\xa4\xb4\x08q\x11\xa2o\x18U\x14\x99\xbaW\xf1\xdc\xbb\xaf\xeci\xe4\xe9-B\x1f$\x94\xb4\x80\x146\",R\xffmt\x9c\xbe\x01\xe9a(Q\x03\x89\x87e\xb5\x8e\xd5\x968\xe9\xbf\xf4\x13y\xe9R\x91\xeb\xcd\xd7_\x82\xdbW\x85F3\x11T\x92_g^\xdc+\xa37\xbc!\xa6\x8d5dlFp\x80!v=\xbf\xf7\xafI\xa7\xc9XR\xed_\xf1\x85*\x10/\xd1\xab\xa8\xbe A\xc3\x98bG\xbd\xfb\xda\xce\xd4S\x9f\xd9}~\x1dU\xe4\xf6\\\xb5\x1bj\xd2U\x14Z\x90\x9c\x1eK\xdaS\xbd\x93\xd08h\xe4\xbc\x94Xyn\xc7(\xaaM\xe0\xa1/\"n\x9b\x00\x95\xc1\"\xd7\x05t\xc9\xd3!\xda\xffd\x86\xe5\x0d,c\xd2\xc0P/1\xbc\xf48!]\xd1\xcf\x92t\x1a\xef\x1c\xe4\x15\xb7\x8d\xac\x9a1\x1a\x88%\xe7\xccuH\xf9\xe4\xc5C\x94_\xa4\xf1\xdd\x15\x93\xec\xef\xed~\xd3\x9a/SZZ\x86@\xb5n\xb0$\xc2\xb80z]LEp53\xb5\xc4:`\xee\x0ax\xd8\xd7X\\+\x9ba\xff\xfd\xec\xab\xfb\x19j\x11>\xbf\x9a\xc3\"^\xc2\xf2}C\xbd'\xa9\xf1\xe2w\xd7\x8a9\x0b\x17PH\xfek \x9f\x94=.\x08\x9c\xfa\xd6\x809n\xfd\x02q\xc3\x9d/^k5\xd5\xf3^O\xbc\xfd\x83\xd0>\xa3\xc3\x00\xb1\x99\xb6\xb3\x80\x14\xee\xa8\x99\xd3\xb9-\xd2\x1a\xebk\x19Ftj\xe9\x8b\x85AG\x03\x9e\xf5\xa2\xfe\x17Z\xbb_\x00\x93\x06q\xc78R\xf2x\x96:\xf4\x9du\xcf\xfd\xc3\xfc\xfb\xfe.\xe4m%?\xbdCL?\xd8\x86W\xc0v|\xce\xea\x13\xef\x1fY2Y\xdc%z\xe5\xc7\x96\x99V\x0b\xa8&/\x84\xee\xf8\xbb\xfcB\xf6\x8f;\x0c6\x88\x14\x8f\x04]\xacDv\x88\xdd3R\xc6\x8a\x05\xc4s\xb5\\W\xd7\xfd\x13\x99Th\xc3Y\xe1\x08\xe0\x97\x8b'\x7f+`zv9\x80\xb2\xce\xbf\x8c./\x99\x16<\xc8V~\xe1\xceJ\xc7r'^\x86\x88\xb1WX\xd3\xf6\x95\x83\xeew\x121=\xb9\x0dt\x98c\x8b\xe8\x05\xc5\xff\x06j\xa5\xd5\xb4p\x8d\x9bp\xee\xd4\x14\x9c+\xd6k(\xedN\xb4\xb2\x97\xc01\xdfc\xc0\x1d\xdbg\xace\xd5\x1b==\xf7\xcf\xba\xe7ql\x97\xff\xcc\x1e72\xc5Qb%b\xe2\x1a\xca\xa5\xb8\xd9\xaeHo\xc2{\x9e\xbf\x9a\xf7\x1a\x7f}z\xe3\xfb\x166{\x18'9\x9d0\xdaa\x7f\x91[\xd5e\xf0\x12%_\xdd\xfa\xca\xd4\x7f\xe3\xf5\xe3\xf0\x90\xd7\x9f\xee\x88\x9c\xaa\x8e\xc8y3\xce\x80\xfbN\xc5\xc6\x00{@A\x9e\xc8\x1c\xfcy \xc2\xab\xde\x01\xfe\x13\x0c\xad\\\xd2|\xebl\xe9c.\x84\xd2x-\x08.g\x8e$\xa3\xdb\xab\x08\xf8;\xb8\xce\xc7\xb32v\\\xb8U(\xce\xe1\x80*\x11\xd8\xd8m&Y\xe7:\x8c\x9f\x8bO#B\x92o\x90\xae\x9e#\x1e\xeeg\xef4>R\xc5\xa0\x8d\x90\xbf\xf3\xf1M\xc9\x18\x1b\xf1\xc7l\x85x\xc6\x9a\xcc\xe1a\x07:\xc2C\x98?\xb3NM\xa4\x93X}H*L\x08\xd8&\x96\xd0\xd9Kd\x89\xcb%\xae\x027\x02\x80\xab\xdc0hA\xea>\x9ah\xfb]P\xad>\xdf-k\xa8\xc9\x9f\xaf\xaa\xc5\x02\xd1\xacO0\xabz\xd9{\xdc35D\xd8\xee\x83\xaa\x90\xee$\x8f\xc4\xe1$\xcdf\xf5\xc7\x9dd\xa8\xc3\xab_(+\x08B\xf4\xbbm\x07W+\xa8-L\xb3\xac\xa2\x9b\x0b\x0e\"\xea':\x86o\x9d\xc0\"\x08s\x1b\xe4%\xbb=\xa8\xe5\x12!\x9a@\xf5\x96\xf9JP\xb0\xe2\xecx\x02\x84b\xff~\xfd\xdeX\x7f\xed?\xd7\x81\x00\xdf\x96\xa0Z~\x83\xc9k\x1c\xd5\xfbDAA\xe7\x91u\xcdN)8`\x01g\xc2{\x1c\xf0\xf4r|\x85\x8fb\xdd\x96\x95\xbb\xfa\x85pM\xd3{4s\xc3J\xdc\x9d\xcf\x15-\x10\x00\xb8\xaf& \x81`\xe6\x86\"\x8d\xceK\xd3\x86\x13S\xe8\x03N\x10\xc5l\x84\x18\xdaW\xe8+H\xaf\xd0y\xab\xb2\xd5\x9382!Swj\xe8\xb1\xdcr3I|+\xac\xcd\x17q\x98\x8a\xe2@\xb8\x9a\xcaF0\x8d\xe0\x19\xa3\xed\xb4\xbf\xd5)\xab\x7f\xf8q\xf9\x02\x95\x98\x0c9\xf3\xd3\x94\xed \xa4\xab\x8eG\x95\xb1\x04hN\xe8+\xcc\xee\"\x86\x1e\xf6\x9f\x82\xb7\xb14K^C\xd5\xecF\x90s\xf8\xe9N\xe9W\xfd\xbe\x02\xfb\xda\xae\xcdD\\>\x95\xf0\xb3\x03\xd9\x83\xeb\xfe8B7G\x90H\xe6\x04W\xa0\xa0\xb6\xe3\xc375\x0c\x1f\xd9\xd4\xbf|\x8e\x18\x187?@>\xfb5\x10\xc8H\xe4\x91\xeb,\xb0\xd1\xfe\x81\xba\xad\xbbf\x0a\xa0\xffB\xc9([\xfb_\x84\xc9\x0d\x91\xbc\x91\x99\xd9+A^\x1ck\x02\xeb\xa6\x06s\x0c5\x1d\x94|a\x00\x91&CO6\xaa\xb2x\xc7\x1f\xe1\xf7\x17{\xdc\x8fD5\x00\xf2\xfc\x0e\x89yO\xbe\xab\x16E+s\x08\x93\xddS\xd8\xdc\xe3\xea}\xc8'\"\xb6\xf2\xa0\x0f\xe5m\x0e\xe2+\xa8k0\xd9-\x9d\x95K\xb7{\\\x1b7\xcfKm\xd23\x96u~\x97\x13\x05uR\xe3\xfa\xb0\xb2\x1e\x02}\x17\x95l8o \x10}\xe0m\x8fCy\x0b(\xb6\x1dT \x06\xe5\x01\xf4\x0e\x82\xe5\x05\x8a\xdf\xd0\x84f\xdeV\xa9\x80\x19!]\xed\x9a\xe6\x93\x7f\x1e\x90:\xf5\xba\xa4\xed%\x1e\x82\xdft10_D\xb1\xd4\xc1\xad\xbf\x02\x1a\xb9\xf7\xb6\xf7\xbb\x89CS#\xac\xa6\xa9AS$\xf2Gy@\xb49\xc8\xcb\x94\x0d\x8a\xe0U\xae\xf1\xaa\xa5\x19_\xea\xcay\x91\x1bY\x8dmF\x8e1`\x856C\xfdg\xa3f\x05\x1b\xf6-Ydy\xb0\x92\xec\xf0G\x9d\xf0\xf2\xbcq\x0e\x0e#y\x8d\xf2\xed!\xa9\x1c\xc4\xe1\x1as'\x83\xc9\xd1\x9c\x07\xe5x\xbc\xed\xdd\xfd\x0e\x8e\x82z\xfb4\xa6\xc0\xb104.\xe5Y\xcd_\xb8\xa7\xf0\xa5w\xd0\x1d\x9b\xfc]\xf5\xc9U\xaf\x0cA\xe1\xf0i\xef\x03\x0e\xdb\x93\xf6\xd8\xec8\x7f%a\xb0BT\xaf\xac7\xfd':\x8d\xbfI[\xb9f\x837!\x90\xfe\x8d\xa0C\xfd\xc9\xf5\xc1Y\xf7\x1d\x05\xdc\x0e\xe3\xcc6\xa5\xe0\x95\xc4\xc6\x80\xd9\xc5\x131\"\xef\x06C\xd0\xb7\xd19\x8a\x8d\xde\xa8\xb9\xbb\xfc\xb7u\xf8I\x85\xfaca\xff\xa7\xd8\x1b\x04\xf2\xb0)\x14\x0d\xf2tpp\xb8\x17\x7f~t\xa2g\xef\x1d\xed\x07\xb3\xf7\x15\x97z\xc9\xb7X\xaf@\x98\x8c\xfe\xfe\xbfr6R\xe4&\x97\x88\x9d:\x00\x87\xfb)\x09|/\xe2\xf2\xecA;\xaa\x98q\xe7\x0a@\x8a\x1be!\xf9\xb2#\xbcin\xb0\xe0\xde^\xd4@T'T\x92 $t\xbb/Yy\xc0\xd4w\x8e\"\xb8\x15\xee\xbf\xaa\xf3Tj;E\xef\xf8X4\xe7\xf2\x83\xef\x82\xe1~5hf\xb6\x13\xaf\x96\xdf7\xdc\xf0,\xf0:E\x91\xd9\xbe[=\x0cTr\xd6H\x16\x09\x14\x82eI\x87\xa4\x18u\xffH\xf1\xd7\xab\x81\xc8w\x17R\xe3\xd3]\xfb\x85j>w\x89\x1b\xe6oG\x87G\x06\xc7T\x82\x92\x17\x09\xe6E\x1c\x14qy\xf6\x17\x8e\xfd\xb1\xbe\x18\xfd\xf08\x98\x86\xe4\xf7x+\xd4\xf4p\xee\x8bR*{\xb6\xd2\xed\x0b@\x97\x9b\x8b\x13\xae\xf0\x7f\xa5\xc2u\xef\xdd\x87{\x85V\xba\x8e\xcb\x0e\x97\xc6Dv\x0e\xa1\x82/\xa4\xf6\x9e\xf4\xd4\xc0\xf1^\xc8\x88\xd3\x1bZ\xb8py\x84k\xf1:L\x9e\x99\x95\x8a\xe6g#\x02*?\xf8\x02\"\x0f/\x10\xd4\xe5\x0a\x84\xc8o\xff\xd2\x85\xcff\x027\xf2\x13R\x89\x8e\x97\xc9\xf1\xfa\x18mK\xa5\xf1\xde\x14wSA\xad@Z\xa3,\xcc\xa7\x0a\x05\xb4gN\xc1\xdc\xf7\xbb\xb6\x88\xda\"\xd5\xdf!\x89\x82\xbbho\xb4\x99\xf4\xde\xc5\xd1'\xf3*\xeeI\x8e\x92\xf4\xcf4\x83\xbe\x19\xea\x16\xbc\xd4a|\x93\xf3\xdc\xd1^X\xee\xc7\x11E`\xe3L\xaa\xb2[\x0b\x9f`\xfa\x92\x9b\xb7\xb2,R+1\xef[:\x9f\xb5\xa0jw[\xdfa\x0c.\x08<\x10'\x8ej`\x0fE\x06\xb2\xcf\xd2\xf1BB\x8e\x94\xf08\xd0\xc0\"\x96\xc3\xba\x92\xe5\\\xb6\xe7\x9d\xec\xc0\x1d:S\xe28\x0f\xed\x8eZ\x7f\xe9e\x0f\xf1\x12\xde\xee\xc0`\xd2\xf9\xdf\xb6\xc1\x12\x97\x94\xd3\xd7\x92\x02=\x8f\xc4<\x1bI5\xfe\x9d\x0e~h\xb2\xb3\x1b\x98X\x8eA\xf6\x95\xca\x9f\xe8\xe3\xe7\xdf\x9c\x03a\xf1D\x87\x1a\xcd\x9a\x02\x8a*\xb3\xb1u+\x07\xb49\xd9\x10v:TY\x18\x19\xef$\x0f\x99\xa3\xe4\xb9\xfb\xb4\xc7\x81Y'\x9d\x96o\xa8\xfb\x94k \x97\\\x0f\x80\x1a\xb3\x80\xff%\x91\x8b\x15]\xc8uF\xc3l#,7\x0b\xa1\x0b\x18z5K\xe2\x13|\xaaZ\x09_qT\x10\x1dV(\x0e\xdd@np\xed\xe5\xcd7\x92\xf6\x02\xb5T\xa0&E\xdc\x945\x8d\x03o{\x8b\xbc\xb9\xa8m9\x12e\xee\xa1CT\xfa\xbd\xec\x1bh\x9aB\xedr\x96\xf1\xac\xd4\x16\x1c%S\x8d\xc3\x88\x83\x08`\xfd\xc7\xd7\x0d\xba{)\xd6o\x19\xa9\xa5Q\xf3D\xf4\xa81\xda!]\x19 \xaeT\xcd\x88\x95\x8ay_\xfc\x86\xdd\x97\xfa\xdf\x9eq\xbb\xa7\xf7\xbe-\x19-.\xb0\x0c\xb3\x89\xd8\xbbjb\xc0-\x14\x8c\xbf\xdb\xb7\xf4\x0d,V\\\x02\xb0\x86\xd9\xb4\xa4\x07w\x99\xa3^hk\xa6<\xaf^}\x89W\x9b4\xfb\xc4\x0d\xb4h%3+\x8e#\xbb\xa7=\xb0o\x82\x8b>\"z\xfep}\x04\xa7W(\xd2\xf5\xdd\x17\xae\xa1\xa0t\xc9V1\x80\xefa\xc6NY\x7foJ\xe1\xd3\x89\xdfh\xc3\xa3\x1c\x02\x82\x81s{\xa4e\xd5\xbe\x18y\xcf\x9e%\x80:\x88\xa8\xce\xfaMy\xbe\xb8\xe8\xf1\xb1\xef\xcc\x1a\xa0\xf0\xe0n\xba\x02\x9ew\x15\xe2\xf4\xe4 \x06!%\xff/X\x89\xbd\x17\xcd\x0d\x8b\x00<\"\x06\x88\x9f\x9b\xbc\xb2\xcb\x1cR\x01Z\x14\xc3r\xe3\x90\x8d\xdf1G>g9]\x09\xe3sS\xbb\xde\xf6\xecj{z\xc1\xbf\xf38M\xd8\x0eW\x8a\x06#\xc6%\xac3\xee\xbd\x0e\x92W\xe6e(\xfa\xdf\xfd\x89\xb9\x04\xa1*\xd5\x0eBU\x95\xbeeB8\x15+D1\x19c\x0f\xcfB\x82\xb8\xdaU\x19\x82\xd4#\x86\x1c\x8f\xdeJt\xdb\xc8\xf1{\x89\x1f\x0dlNAG-t\xc8\xf7;\xc0P\x90`y&~#\x9d\x8e\xde\xab8K\xc6\x0fRf\x0d\xd2)\xff\x1ee)Q\x00\xd9\xe7\"\xf8d\xd5_\x1d.{\xc8\xa2\xc7\x87A\x92y\x0e\xf7\xf87\x86\xf4\xf2\xf0\xb8f,r\x00\xf0\xb8t\xba\xb9\xff\xd3]<p\xe2X\xd4\x92`\xd4c* )\xf1\xe1\x8c\x13\xee\x99]T\xa3\xef\xdc*F>+\xb4\x0b\xfc\xc8\xd8>\xf7\xa5\x19\x08\x92\x81\xa8\x9b\x8eQQ3\xce9\x94B]\x03B`\xc8\x84\xc9\xc7\xeca\xba/\xe3Y\xc1\x12\x9aA\xc9k\x01\xf3\x05\xfe|\x81(\x0c\xe06K\x8bL\x14\x9b\x1f\x0b4T\x14\xea,\xa2\xacJY\xc97\xe3\x8c\x9dk\xff(\x19\x9b\xee8\xa4\xebg\xbcx%dm\x87\xf4\x9c\xf7\xc2\xb92{\x97\x1dD\x7f\xbab\xe7\xe1}\x1c\x90s(\xdcxd\xf8N \xa2\x9dqd\xab\x15\xa6n\xe0\x02[k,XQ)\x9d\x0a1\xa2\xb3\x9a\xaeb\x0c\x08\x8f\xdd\xad\x9e\x96\xe2\x15w_\xfb\x99BO\x8d\xa5\xa6\x12\x1e\x0e\xf4\xc7\x17\x80\xdf\x98f\x1c\x96[\xa1\xf57\x88Of\\\xff\xf8\x05\xf0&\xf8cF?S\x89\x0cP\xfc\x85\xe9\xad\xf3\xed.\xf2\x0e\xb9\x1f9$N\x9b\x0d\x94\x00\xfc\xff\xc2R\xf1\x9dR\x8bR\x90\xc6!\xeb\xa1\xaa\xc5w\xe2vl\xa56\xf8\x06D-F\xfd\x17v\x10\x06\xae\xc6\x19Z\xe9\x87\xf5\x18\xefD\x8cS\xab\x8c\x9e\xa2\x85\xfb<8\x1f\x89\x1b\x13\x12\xbcb\xb2-\x96\x95\xf6?\x15\xd8\"\xa7iHP\x889\xd0\xfd\xad\x97\xbf\xea`\\\x10\xaej\x8a\xd3\x94q|W9\xadB\xbe;u\x0fW\x81\xbd\x00\xd4\xc7\x7f\xc1=\x8d\xfb1\xdf'\xba\x9e\x89\x0f\xf0\x16:Yc\xa9\xa0)\xb9m&\xe7\xcao\x1aJH\xe8-8\xf8m\xd0\xe8N\xd9)\xef\x02\xc4=8\x8b\x15\xd0\xc6o9\xd4\xe1\xa7\xd4\x88\x06b$\xd4|K\x9d-^\xa3HH\x1f\xb2\xf1p\xaf\xb3\xd6\xb7l*q\x12\xcf\x09\xf4\xb9\x91+\x8ct\xd2\x93v\xb11=\xdc\x8f\x0d/{\xf6fD\xd1\xf9\xb4\xef\xec>\x1f\x1eevwE\xf3l\x05\x9e\x00\xfe\xb0\x89\xd2\xb8\\\x1aRu\xce\x8e\xae\xd5\x8c!\x8c\x03 \xae10\xb3H6\x8e\xb7H\xb4\xe6\xd0\xe4\\T[\xc6M\x95\xd3b\x1a\xab" +
+	"\x9a\x81\xd0|\xa8\xd8\xef\x87K\x7f*\x8c\xcei+i\xc3vw\x81\xafl7\x13\x9c\x893\xbfU\xff\x13\xd4t'\xa5\x027\x0a\xfar\xa8\xe2\xfe\x08\xb1*\x9f\xf3\x01\xd5\xab\xeb\xa7\xfes\x9e&\x98\x008\x09#u\x06Y\x0a\x8f\xa1Ir\x1a:\xead\x18\x0eM-&\x9e\xc5\xec\xc3\xc7\xb0\x1b\x9a\xfd\xe8S\xdf}\xa2\xa5\x1a\xdec\x80\x00\x8e\xf1\x9dvj\xb9\x04\xe7s\x09\xbd\xda\xc7\xce{\x87\xe2\xd1~\xc0\xad@\xfc\xdb\x02\x066\xf7\x02\x0c\x1f\xa5k\xbfO\xb6-N>i\xc9\xb3z\xdb\xd6\xb4\xea\xfb\xde\xc6\x85\x90\x94\x11\x92\xc9n\xd1q\xd7\xa9\x09b\xaa:r\xfd\x1f\xf57xXG\xde\xf5 \x11\x85<\xd2W\xb7]\x11\x86\"\x0a\xc4\x85\x1d\xbf\x8b\xcc\x0cU\x1a\xa11q\xdf\xb5:\xc9\xc5\x1d\xed\xa7\xdan\xfe\xa0S\x0c\x9clmY\x92\xcf\xb8\xc2@\x0a\x86\x8cn\x88\xb2\xaeU\xb89\xf1\xeeN\xd4\x85\x85\x10\xe6\x9b0\xc9\x9c\xb3x\xec\x18\xf1V\x88A\xf8\x92\xcd\"\xd2=lW\xcd_2\x0f\xea\x9e\xd6\xf1q\xa6\x19\x9b\x9a\x92\x81\xc6\x01\xfaw\xe0\xceD\x8e>\xfd\xad\x11\xd2y\\S\x83\x17\xac\x9e\xbc\xab~\x9eu\x94B.c\x17~\xe6C\xf9h\xf1O\xa1\xe4\xb0\x07\x94\xd9<\x08\x8f\x1f\x96Q\xe9\xf8\xeei\\\xb8GT\xedF\xec!\xe0\xcf-\xe2\xfeTt\xae\xff\x99\xe7?\x04X\xd1\x82\xb0\xf8\xd8\x906\xc5C`0\x0d\xa2@B\x09\xbc\x9cS\xb9\xa4`\xe8I\x84\xd2Z)8\x1b\x17\x12\x97\xfe\xa9A\x06|\x91W\xf3\x9f\x19h\x85va\x83p\xa6\xf7x\xba\xd1:DE\x0b\xac\x9as\xce'\xf4=1\x8e\x9d\xf5\x1f\xfe\xd0\x86\xd2Zz\xc5\xf0\xe6yFB\xb6+#'g\x0cW\xdf\xf7\x9f\xf3H\xb6w9'\x92s\x97\x92\x8f\x9f\x9d\xc6x\xa2\xa4N\xba_.\xd4\x87\x0e\x80\xd6\xd0\x9bu\x1f\xebD\xad\xb8\x8f\x98\xe6k\xaf\x0c\xf7\xf7%\x8a\x1a\xce\x84\xdf\xeb\xb8\xb9\xe9>\xfe\xa9m\xed\xe1n@-1\xd7C\xdbv\x1f\xe5,\xa5~\xb5\xf0\x7f\xf0\x9b\xb2_\x9c\xbf\x81}\xdc9\x0b?z\x8b\xdf\xaei\xa8\xab\xc1\x92)\xb6QR\xe5\xfe\xa1\xfa}\xc6\x0d\x8c\xfa)\xe7\xe8{\x11\x83\x97\xc4\x19\x14\xc5\x8e\xe4\xa52\xce\xa1\x15\xc2t\xd2\x0d\x9c\x9c\x82|\xc7\xf2\xc801$M\x0c\xca\xffB\xbb\xf2J\xa2;\x1fw!+\x85\xae\xba\xe6\xc4\xac\xee\xd6\x1e\x9d\xbe%\xce\x87q\xe8\x15\x93\xbfQdu\x92\xc7\x86\xc1\xa9\xb3\x11N\xb8cm\xecl\xf9#Uf\x096.\xd6V\x92\\\xb0\x80 q[D\xe9'?\xd2\xbb\xabr\x8a\xb3\x89s\x86\xb0H\xbd\x17\xd8\xe65\xc2\x04\x9f\xbd\x14\x1c_eF\xf1V\xceV#\xc9\x19\x81\xe6\xff2\xc3\xdeU\x93&\xbd\x04\xf0\xfd\xb3\xac[\x06\xb4\xa0\xd3{u\xef\x0eM\x91\x84^V{\x83\xf5\xcf\xde\x1b<\xf8\x14\x9fj\xa6\x8d\x18\xd8/IQ\xbb\xc5\x80\xb3b*D\xd7+\xfc\x82\xc4\xa73\xceS\xdb\xf2\xd0\x9f\x83'G\x83\xbe\x97W\xb0g\xf3t$\xe0\xb1\x97\x18\x89\x04\xadY\x02V\xc2\xca\x09\xac\xe3\xdds\xff\xc5?\x1b<(\xa2{z\x1f\xee\xfe.\xaf\x93\xdcQ\xee\xc8\x14\xa7\xd2n\x0e[R3\xe2\x0f\xa8v\xbd\x03e\xa6s:A\xd3G\xba\xcf\x9bK9\xdfa-<8\xcc}\x04\xfd\x8b\xb0[cv\x1a\xdc\x92\xe3\x12\xbc\xd4\xa0\xbf(\xfd\x89\x10\xee\x98s\x8b\xc7a\x81\xc8\xd7-s\xc0\x9b\xe0\x93\x8a\xd3f@:\x03 \x14\xd07\xeaO$\x85K\xea\x8d\xa9\x1a\x1a\x8f\x1f\xfc\x86\xdb\xfd+y\xb5\xdb\xac\xd2\x94\xe0\xbe\xdc\x02\x8c\xe0U\xc8\x9c\x920@\xc9\xb6\x01m\x1c~\xad\x99a\x811\x0cPF\xa1.!{\xbe\x05*\xa6\xf6U\x95E\x01<\x1e\x96\"[\xcdW\x08\x15\xdb\x1b\xafnb,\x8dr\xb1\xf4\x9frk\xfb\xca\x0b\x816\xe1g\x82$\x9d\x87;\xf4/\xfc\xd0\xefiA}NrY{/tO\x0d\x17^\xe1\xd1\xb5\xbf\x04\x1b\xd1\x1bTd\xec\xa0\xf7i\xbfwT\x94Bv*u26\x1e9\xdf\xccv\xa3\xc0H\x92\x85\xaa\x05|sS\xe7\x87\xe42\xcf\x9b\x82\x16fr42\xce\xe5\x0cw`\xf6\x1e\xe6\xf2z\x81W\x8ay>Z-\xea\xc7\x1d\xca\xf9\x96\xbeS\x803.J]\x09I&\xe5wzO\x15\x1b`\x84\x98\xced\x02\x1c)\xb8\\S\x17\x85\x95\xbev7\x07\xc4CU\xff\xe8\xbf\xf8\xc3\xdfK\xe4\x89P4\xc5S*#\xda]\x96\xea\x93|\x0f\xe9\x1a\xe9\x1e\xd6H\x9bi[H\xeb\xad\x8d\xaa\x95\x02\xd3\xe6\x8dV\xa4\x9aJ\xdb+\xf1\xe4\x85E\x08K\x0f\x0aU\xea\xefH\xf38\x17\xc3n\x14W\x0fU\xfa\xae\xac\x0d\x95\x10\x85\xbf\xd22^\xff\xa5\xa1)8M\xce\x89\x09\xcfQ\xbf\x04&i\x06\xe1\x1be\xe8\x0ebZ\x0b[\xbf\xd6\xb0\xde\x82\xf1\xa1\x9bY\xdfo\xed\xd9\xa9io\x19\xb5\xddf\x0eX\x0eeve\xb5B=\xc8\xd3\xdb\xadE\x0c\xcd\xfe\x0f@\xdb\xee\x08Z\x0aB\x84\xe0\xf7\xee\x1d\x0b\xf5I\x0f\xe9\xb9l\xfe\xf2_e\x1fG\xf8jX\xc9\xe6d]\x02\x93\x154\x81\xe1\xe3\xa5U6\x0f\xcd\xea\xf26\xc7\xa3\xc8\x10\x91\xdb\xa0\x1c\x19n\xf9\xdb\x92\xfbH\xa9\xe7\xd5\x9c\x8a\xfd\xd22dJ\xb1j\xae\x8b\xe3\xd0J\xe8>9\x88\xa9\x08L\x1e\x1d\xf0\x10\xc3\xdcy(\xd4Z\xaf3\xcex\x12\xed6\x916\xa1r\x0507\xae3\x83\x95\x1ae\x85\xa1\xe71)\x89-&\xd9\xbc\xa2\xde\x8a\xefb\xd0\xfb\xd9\x8f\x03\xed\x067C\xe0\x0b\x9e\x0f\x95\xd7}\x9d\x10(\xc1\x88\x0eS\xb6\x95\x00\xe3\xbf\xd1\xc888\x94$\xd3\xc3\xac\x84\x8f*\xfa\xa8\xefQ\x9e\xc6\xf0D]#G>\x9e*k|\x9c\xf5\x9eB\xaa\x83M\xfd\xf0\x1c\xc7\x1e\x066,\xd7\xcd\"\xfc\x96\x9b\x85\xf8\x8d\xbek\xd2P}\x14%t\x07\xd5\xaf\x8a\x85\x85\xeb;\x9e]\x85\x95\xf8\x8d@\x93\xc0N\xe7&\xe7jt\x81\x9f\x95\xbb\xab\x84\xbdJR\xdb\xe51\xf7\xfe\xf7\x8dF\xab\xbb\x9b\xfa\x11\xe6+\xab%\x06\xb5\xb4\x98\"\x16#\x9f\x09\xc6o\xd5L\xc2t\xb0\xff'\xd6;\xb2\xf3\x86t\xc71\xd4?\x18Ko\xa1\x85\x0c\x18\xc9\xbf\x85Z\x10\xcdy\xce\x19\x0f\x03\xf3\x8aG#\xf1\xafn\xc5\xa5s\xb3\x9c\x03\x1fYO\x01\x19\x11\xe2\xc2\xa1\x05\x1d\x02\xaf)\xe1\x9e\xb9\xb3\x15S.P_\xea&\x0b>\x12\x18\xd1\x0a\xad\x06\xd8C\xc9\xd4\x12\xb7\x98\xe0\x08\xd0\x8f\x98\xa0\xd5\x0dG\xea\xf7\xdd\x17\x16\x964\xc5\x03`'\xefU\x10D\xe8D\x01\xdb9\xe0\xe9\xed\x1fP\xe7\x89c\xeb\xa7\x9b\xc4\xd8D-C\xed\x0a\xc1\x15(!\x83\xf1tt\xb9\xa8\xc0\x07\x19\xf7~\xd6u\xef;Y>,\x09\xe4\xfb\x9a\xd9\x09\x01\xe7\x92\x15\xa1\xafK\xe2\xe1\x09dP\xc1\xaaC%\xbbI\xa0\x7f\xf2}n_Dy\xae\xfbl\xe1\xca\x00\xb9\"\x85OA\xc3\x12\xa2\x02\x18\xadB\xc3\x9b.\x995\xb7\xa2Q\x0c\x03\x89K\x8ap\xa9\xe5\xfb\x18E4~#\x05\xbfufs\x86:\x8e#\xb1\xb7\x13\x93|\x8d9k\xe9\xb7\xa2\xfe\x03\x1f\xf4\xb8\xec\x08\x8c\x9d;\x1dD\xbc\x9f\xa1s\"\xa6\x0dF\xd7\xc9\x1fQ\xef\x19\xa78~\xd5c\x19E\xcem\xfa\xe4\xbf\xdc\xf5|\x95{\xc9\x16j\x1b\x05{In\x9aE\xdf\xcd&V\xe5[\xaf\x10\x86\x88h\xcc\xfa\xbfB~d/wE\xaf\xbd\xcb\x98\xd2\xbci)\x8e\xdcy\x8dG\x06\x1d3\x91c\xe5\x95\x84\xbcM\xab\xec\xd6\xdb\xa8B9\x8b\xec\x01\\a]\x987\xc3\x07\xca\xdc<\x08\xcc\xef\xdd\xbd)\x83\xaf.\x1b\x06\x99\xec\xdc\x82}C\xc6\xc0;\x8e\x0e\xbc\xf4\xf4\x0d\xc7\xd5\"\"M\xa9\x16j]\xed\x89\x02\x99\xc0\xed6k\xaaD\xb4,}e\xe5\x1f\xf6\xb9\x8e\x14\xcfZHx%\xc6\x96\xb2\xd2-\xac\xcc\x04\x94\xd5\xf8\x0d\xd8,\x08\x01\x8d4\xd5s\x03I\xd1V\xd3\xf8V\x9bK\xe32\xe8\xfe\x93\xda\xa4\xfc\xa4\xf8C\xbf$\xb6\x9fp\x19\x99\xbdd\x81\xe22Q\xf8`1\x99*\xc9\xb3\x85\x8f\xb5\xb9s\xe4\x0b0\xf2\xdf?!\xa5\x15\xa9|l\xe9\xca\xd6\xbe\x12-\xb8o\xa6}\xb5-\xa9g\x8eF\xafs\xbe\x10|\x9c\xba\xbc(r\x0c\x86:L\xa9\x83q\x15\x89N\xee1_\x1a@\xc2\x92\x14C\x14Z\x13\x0b\x16\x94\xf5Y\xc5gx;\xed\x19\xdd\xee\x0b\xf8\xac\xff\xa4\xb05\x17]\xb7y 0\xb4\xc6\x8e2\xe9)uI\xdf7cYj\x7f\x86U\x92d:\x02M<\x86\x13wM\xa7\xc0\x91L\xbe\xc2\x15\xd1%\xe0\xaa\xef\x19ve\xcfGj\x0f\x10t\x00\xc3{``PH\xce\xc5u\x82\x03\x8b\xae\xab<\xec\xb8J\x91\x8f\x1af\x99\x1b&\x18\x91\x12\x7f\xc4,\xb6\xda\x86\xdbA\xbf\xbb#\xc3\xa3\xf8\xb5\x80nd3\x95\x7f\x90\x02\xa7\xb0\xb6\xd2\xf5\x05\xd0\x1dKy\xf6o\xba\xe3\x82\x01/]\xf5@\x10\x8f\xf3\x1e\x1e\x84\xe8\xfe\x02b\xf3\x13\xaf\xb3\x1ap\xec\x02$\xaf)\x8fB>\xc6u\xef\x1c\xaf\x9f`\xff\x80j*u\xa5\xa1%\x90_ \x8b\xb46~\xc2\x9f8\x14\xc2!\xaa\xb8\xad\xaa\x9b8\x16\x06?\x98P\xd6_\xf1\xf2u\xbe\x951\xb9N\x93C\x85\x14n6\xcc\x98\x88\x95A\x80>]\xc0\xcc\xd3\xbe\xb1@\xc4\xad\xc8\x12\x05\x84j\xdb\xa3^\x82\x97\xa9_z\xfcu\xfa\xf1\xf5\xecC\xf8\x9eAs\x96\xbet\xebU\xe9\x1a\x95[_9\xb7)Q3\xc1\x95\xdc\xc5\x0b\x8fT\x8cP\x9eCU\xe0j\xc1[\x87r\x15\xfeL\xd3\x1c\xc2\xbb\x85\x80m?S\xb1n\xe9o\xdf\xaf\xd7/\xbd\xafi\xefS\xfaf\xc7t\x9b\xc8\x17\xc5j\xde3\x94-\xaf|\xd8\xba\x86\xe7\\H\x1b\x81\x0e4\xa5t\xc1\x83$\x98~\xac\x00\x1aK\x163\xc4?=\xf7\xdf\xd2^\xfa\x96&r'\x0d\xb5\xa7p>C\x8a&\xbe\xfb(\x99\x8f\xdc\x92\x0b\x05d\xd7T\xfe>\xf7\x9eP\xee\xcd\xf7]\xf2\xfe\xdb\x17V\xd8\x14\xb7\x00r~\xb23\xb6\xe8\xf1\x83j\xc6\x89\xc3\xf8E\xf2\x02\xeei\x99[\x9fKL\xf7d\xf4\xe2/\xcbMK\xb0\x1bK\x05\x02\xc6\xc2\x87\"\xca\x88\xfbr\xa0\xd0\x88\xb2\x06\x80\x81\xf7\xee\x8f6-\xcc[}\xf7\x0e\xf3\xc7W\xa0\x03\x9b\x1ea\x92 \xdd\x16\xc6^\xf9\xbbt\xe38g6\xd4EJ\x82\xaf@V\xd4\xcfS\xa5R\x931\x83\xd24\xf8\xbe\xcb4\x0d\xe8\xb1\xdf\x13\x8d\xe9\xb5\xbb\xae\xd7\xfeA\x15\xd9\xbd\x07\xa4\x9eW\xc90\xfb\x1c\x88\xad\xa1\\<\xabS\xad\xc1\xd9\xda+\xb3\x12\xb5n\xe3o\x87\xf9{\xda\xff\xb6\xdd\x8b.\x07$\x90~\x9e[\xa8\x1c\xe3\xf6\x0f\x00\xfcJ\x03\xf9\x85\xd0\xad\xd6\xc0\x04\xca\x8b\xb3\x8f$\xc9RVn\xb3\xe8L\xda2\xa08\xb7 \xc3\x07jt_\xa2^\xd4\xb6\xff\xc5'vm\x09\xba\xd9P\xfe\xfa\x18)\xaf\x0fuNGhr\x8c\x92\xb7\x91\xfej^@\xb1w\xd6\x15\xcb\xc9E\x86F\x03`N\x97\xe86\xa7\xcf\xdb\xcc\xe5\x05\x8a\x90\x90K'sI%\xc1\xf5\xdfZ|\x80\x83\x7f\xdf\xf5\xef\x7f\xca0\xaa\xbb\x95\x97$_\xf5\\-T\xb7*\x0b*y\x8d\xcc|\x96\xfb{\xe9k\xc1~1\\\x0e\xa9\x9b\x8e\xf01S\x19\xfb\x07\xac.\x91e4c\x91\xd9\xdf\xef\xbf\x06\xeb\xd6F\xea\x18\x93\x04\xe9\x8co\xffJln\xac\x8f\x0a\x83vb\xaa`\xee\xc7\x10R.h\xe5|\xcc\xac\xbb\xea\xaa\xc4\x12\x1fD\xfdKc\xbf\xe2N\x83k,\xcak\xe4\xa6Y\x16<\x13\xee\xc5\xe6\xec\x05\xc3rI{\x8cU'\x8c\xf6\xf4\x05\xca\xbfy7\xcb\xd8\xba\xfcM\x94\x8a4\xea^\xd8\xe5\xe7\x8cu\x86EI8\x99DR\x08\x0b\x08\xce\xa0c\x80%r\xb3\x8b\x83\x0b\x0dM\xe5\x07\xb4\x1b\x87|\xb3/\\j\x9b\x91KA\x81hK\x7f\xae\xde\x1b:J\x0dV-\xa8\x02\x01\xb2\xa2\xc8]i\xa6}p\x7f\x14\x0e\xeb~\xa9h\x80\xeb\x85\x00\xbe\xdf{\xc9\xc7\xca\xa5\x80\xf4\x9a\x071\xdbw\x15-\x95\xf5O\xbf\xb4y;\"\xb1\x99\x8a\xdf\xec\xb4\xdc\x9cD\xa7\x8a\x04\x9b\xafa\xde\xfd\x10\xa2T\x98\xc5\x08\xa1\x84aH?\xd6\xfb_9\xe0\x98\x10\xf7tZ\xea\xc5\x9a\xcd\xe1\x17\xf5t=\xb9\xe1\x02\xb7\xd8\xfe\xdc\x89\xcb\xb1D\xaa\x91\x03\xb4\xa0P]\xb5)@\xac\x10Z\"w\x83\xd8\x0a\x10\xd5\x0f\x84\xc1\x0b\x8c\xf8W\xe9\xc9\x07\xc9\xda\xa3Q\xab\x14?q D\xaf\x14I\x0ed\xca3:>\x0f\xd2\xa5\xd4\x03C\xa4~j&\xcd\xb8\xb7\x9c:\xe7}\x05\x85\xeaM\xa8\xfc\x04\xab\x91G\xd2\x96\xff\x19*\xaf\xb4'\x01\x1b\x11S\xcd7\xb2\x16\x19\xb5F\xb7\xbf\xb5\xf4_\xd4\x00C>\x88;\xdb\xb4=u\xfb\xa9\x96^\xc8>\xcf\xa11=_\x0c\xb0\xbf\x19\x09\\p\xefX\xc1\xd3\x162M\\P.\x18U\xb1\xca\xb8\x80\xa38,t\x03\x1c6\xb2I\xb0(\x97b \xe4C.Q\x83\xf6\xfcm,h\\\xfa\x9fI\xd6\xb3\x08_e%\x8c\xfco)d\xb9\xc1Z\xe4\xab\xb3w6\xdb\xc6\x02\x8di\x06Y\x97v\x926\xbc\xd0i\xb9\xe0\x7f\xfa \x1fQ\x1d\x80\x98}%\x97\xed\xf1\x0eU\xb7;\xd0\xba(\xeb5\xa5K~a\x0a5\xd2\xa9\x9b\xb2B3\xd9A\xcc)\xba\x00ZP\xff\xa5\x9cEzU\xa5F\xef/\xd2\xd8\xfd\x05!\xf71\xaa\xc4\x8b\xb9\x08\xe6\xa0\xd0\xd1+D\x0d\xde\x89\xb7s\x1b\x7f\x94q\xcd\xa6\x1afX\xfa\xbc\x11r\xb8\x121\xbfFYJ\x87B\xd2\xad\xc4\x0b\xedw\x9a\xcb\xf37\x15\xad\xd0C\xa1\xdb\x15s@\xe5\x01=\xde\x94\xdd+\xc5\x9b\x17$\x1bA\x97\x9d\xbb\xf8\xec\xed'\xc6;\xedL\xd2\x1a\x89H\xf8\x0a/\xf4\x90\xc3\x98\xd7\xd5&\xa9lu\xe9\x85\xce\xddY~\xb9\x95\x14%\x10I1[\xe5\xc5\x12\x02\x9eY\xf4\xb1b\x06\x048\x1b\xc6p7]b\xae3Q0QZ\xb0\x11\xb5\x82\xaf,\xb8\xa0\xadB\xc7\xb9h\xb3\x0c\x98\xbe\x9a\xf1#Yv\xc5\x18\x8cj\xeb\xed\x08\xaf\xa0\xb6\x1e\x02\x16\x8d\x0dd\xcf\xd9\xfe\x8a\xc9<\x17\xc1\x89s\x0aER\x99\xf9\xaf\xb8z\xb4\x08\x096\xd4\x8f2\xea\xcc\xa2\x14$\xf5\x99i\xa4.s\x1e\xbdV@\x0a\x1c\x9a$\x9c\x9e\x8d\x00$\xd7M\xe8\\\xf2\xa0\xbb\xc4\x92f\xc4sj\xe6g.it\x16\xbe3\xd0'\xd4!`nQ5\x0eo\xae\xe9\x0d-+\xd7\xc1\xe6\xa2\xac\xe8@\x0ag\x7f\x02S5C\xacf\xf4=\xad\x18\xc1\x1f\xdc_\x84\x05} \xde\xc4E\xe1\xfa\x99ZGa'6\xea\xa0v\xc1;m\xff\xeb\x8c\xa8\xc5\x86\xfe\xca\xf3\xa4\xe8\x13\x15e\xe1\x8d\xe3\xa7\xd8\xd9\x12Y\xcfW/\x9f\xe7\x02\xa0y,\x08\xad\x102\xa5\xe3H\x10\xa0\xe7\x1f_p,\xc3N\xa3\xdaD\x04\x03#\xc3\xb7\x84R\x1dG\x07\xba\x07\xe8\xf2\xf4sd\xbc\xb1k\xad\x8e\x94\xe9\x87:\xba\xa1\x12Z\xa5\x92$\xd1(.!aQ\x1a\x9a+f\x8eG\x97\x9b! \\\x8d$0\x9blA\xa6x\x83\xf8U\x83-\x921o\x15\xbdD,BpI0\xa2\xe4ExK\xe4L\x7f\xae\xbf\x01\x86n\xc6\x1d\xbc\x1f\x14\xe0\x88*G\xaaI3\xa7\xa8\x0b\xb2F\xc1\x84\xf3\xf7\xfd\x03\x86E\xac\xc0\x149\x7f\x09\xf5\xfb\x15\"\xca\xd4\x15\xc8\x09\x11\x1e\xf2\x8d\x8fy\xa1\x18C\x10<O\xa4]\xca\x0bJ\xea\xd5w.^\x87\x8b_\xc9\xef<\xe1L\x92\xdfA\xdb\xc952\xf6\"\x04\xa6sz\x9e\x97\xc8q\xf4\x19\x09\xae\xe8|\x03b`K\xbb\xe1\x0a\xa6\xf2\xd0\xb5s\xaa\x88\x86\x1dS>\xaf\xfdZE(<\xac\xc9\x93+\xa1y\xa6$\xe1hX\xa3pwnQ\xfd)j\x0a\xf5\xbd\xfeN\x07\xa5A?\x07\xab\x9d\">\xf1t\x06\x0f\xe0&\xdc\xdeC\xa05\x9a.\x08\x1c\x9a\x82\xc0c\xfd\xb5^\x7f@A\xe0\xd1]\xc9W\\h\xfe\xd3\x82\x00\xbb8051\x13^d6\x0a\xec\x95-\x9b\x9b\x9e\xc3\xe8n\xa3\xe6\x13\xe1\xa1\xeaW\x0c\xdc;\x0fud\xa1\xe4\x00\xc3j\x87\xd3\x13\x1eJ\xa5.y\xc0\x1a\xd1\xf1i\x1f\x92K\xf4\xa7\xecU\x86\\\xa6\xaf\xf8\x06\xb3D\xc7\xc6\xaa\xa7Kw\xaar\xb2<\xbb\x12\xeb\xf5p\xee\x8f\x1c&\xe0Ds^g\x89\x8d\x0d\x8d\x85j\xdc\xa5\x80\xff\xe8e\x18\xbd\x8a\\;\xcb2\xd2\xd0,\xddF\xa3\xfe1\x17\xe7\xb8\xce\xf1\x0dIy\xf2i\xba\x0bp\xb2f8qv\x8340QAs\xbb\x18q/\x12\x9b\x09Y\x7f?\xee\x92\x07j\xd2\x12" +
+	"\x82}\xfa\x8f\x12j\x81]\xb8\xd1\xf0\xe8\x82Hq\xad4\xc0/r\x1e\xc0Q\x9c;\xa2\x85\x1f\x14\xdf\xd9\xebH\xb4[DN\xaa\x93\x14\x11\xb3\x12\xdaU\xfd\xb9\x99\xd3\x86/\xa0\x80\xd3\x9c\xdb81\x1a\x98X\xd2\xa8\x90\xb7\x8e\xcd\xe5\x8c'}\x9a\x12H\x1e\x0a\xfd\xdfB\xdaW\xc2\x9f2M(\xdaK\x82\xa8\xa6\xd0\x14\xaa\xcc\xc3\xed\x7f\x8a\xbd\xb4w\x1f5&\xeb\xee^\xc4\xcf\xbe_\xc6\xd4\xdb\xa1\xa9\xedB\x18\xa2\xeb\xd5\x9b\xbeC\x1d\xc4\x0e\x15\x95v\x8bQP\xb3t\xbb\x86\x8a\x07@\x1d2i\xe2\xa9z\xa5\x1b/\xf5\xeaB\x0e\xc4\xf4\xb4\x99K\xe76\xb1oK\x1a\xe9\x13h\xd1m\x1fZ/\x09\xbf\x96\xa3\xdbE\x83\xfe\x85T\xd11kl\xce\xfb\x1e\xcc\xc9nfq|\xcc2\xaf\xe7$5\xca\xa1\xaa\x9e\xe0\xef\xe4Hg\x8dQ\xd7\xdaA\x96\xad6r\xd6s;\x087\xae9S\xc4V\xc0\xe0\xddbE~K\x87\xcc\xd2\xa7\x12\x0fQl'\x12\xf3\xfd\x82f{>O\x14\x1b\xbb\xaf\x80\x07\xddG+${9\xe89\x8bGr\x82\xf7\x92\xd5\x12\x11<\xf7\x15\xfcD\xca1f8RV\x0a\x01:\x87\xd7\x9a\xc8\xd2\xc9\x1aD\xc4\xfa\x0b\xedG\xc2t\xab'\x12IyL\x1c\xed\xb3\x10\x1a\x84y4'a\xad\x18\xa9\x0b{\xb6\xe2i\xbf\xed\x02\xa34\x11\x85\x94\x83'c\x01\xe4Q}@\xf7\xa51\xe9F\x08\x09\xbb\x02\x1c\x91\xae\xe4Ye@%1\x05\xae{\"\xaa\x97\xea\xd1\x098\xb46)v\xbf\xfc\xea\xae\x98\xb4gL\x8c(\xd8D\xf6`\x12\xf0\xf1\xcc\xf57x\x87\x82N\xd1\xf1\xc5IT\x89i\xe0*\xd4S\xd8\xa4\x83\x0eP\xa2\xa1\xa2l\x06\x14\xf3%\xb0\x14\x05\x0f\xc0\x1d\xd6\x8a\xfdh$#\xde\x0c\xb3\x8d\xab]\xe4\xd9t\xa5\x8c\\|\xedV\xb7\x83\xf6\x9aw\x82\x83~\x9c\x16\x04A\x04\xe1\xb0\xef\xa0G\xd1\xd0\x87Z\xf7\xff\xc0N\xf8\xa8c\xfd\xb2^\xf6=\x00-\x05\xfb]2\x1ew\\\xbcO\x94m_PT\xb5NaBlg%\xe35.\x99\xa1\x06q\xfb9T\xd1\xfbB%a\xf7\xc1\x92h\x04\xa3\xdc\x80(1\x0e\xca\x11\x89\xc6\x03\x09`Mm\x91i\x8f9\xd5\xc1Y\x1c\xbe\x95\xd8F\xb2\xcd\x15>\xa5\x8d\xb8@\x19\x01\x1f\xc8\x06\x9fl\x8e\xef\xf6^J\xafN\x8b?\xef \x14&\x82\x0e\xe8H\x07\xc6\x8f9A\xce\xe1A@\xe0\x16\xbcST*\x12\xc8\x1c\x89&\xcdW\xa4\x8d\x18r\xe8cO\x9dW\x99\xd7\xf3\x9e\xc8F\xb36\xe9\xc95v\x0f\x98\x1e\xac\x9ff\x80\xachg\xf3\xaf+?\xf6\x9e\xe5\xb8\xeb,Q\x07\xfc7\xa9\xc0\x0a\xa3v\x85\xc0\xbb\xc5J\xbaF\xdfK\xbb\x862\xd3\x88]\xd6|\xa7\xd0x\x13d\x8dg\x88nP\x96Z\xa9'\xa3\xc5\xc0\x0e&\x1bC\xe5\xce\xa3\xf5/\xa9\xb0\xb7\x82\x90@6\x89<\xb2S\xe0\xaaw\xb0\xd7HH\xc7y\x0b\xcfR*om\xc8\xbem\xc8\xed\xa9\x1cG\x8d!b\xf4\xdd\x0a&e\xcd6\xa0(\xb2\xee\xb3\x16\xe0$q\x09\xd0\x0d\x10\x9a\x01\x8d\x8a\xb7\xcbK\xa2}\xae\x13\x17-\xdd}\x0f\xb9R\xe3y\x85\xcf\x14YuY\x8d\x1e\x1d\xc4\x0b\x17K\xa1\x0d\x13\x905\xb03\x0a\x0c(\x07\xeaX\xf7\x18\xc7\x1d\x1d\xe6THb\xcd\xc4\xf4!\x8fB\x8d\\\xd5n\xfcNj\x0eE\xa7\xe7\xd9\xd1q$\x99i\xe6t)\xa5\x88A\x83m\xc9\xc6{A(\xca}\x84[\xf7\x17N{\xa9\xd7\xbc\xf0b\xf0\xdc8\xb9P\xc91\x82o\xc4\x18\xce1U9R\xa5\xff\xf3f\xde\x0e\xb2~7\xf8N3/\xbb\xeaa\x86\x8b\xd0~\x7fi\xffI/`\xd6\xa3U\xca-O\x1f\xb3\xa8\xcb\x9957\xfa\x16\xb2t\xfa\xc0v\x16\x82\x9d2\xae|_\"\x9cE\x10\xc3\\\xaf\x887\xac\xad\x13\x00\x86\xf3w\x0e\xd5C\xb3\xa6\xa0Z#i\xa6R\x92\x97RD\xdd\xc0\xddB\xde\xe0\xab\xb3\xb0\xf3t\xcf\x92\xbe\xe5,q\xf7\x94%\x0e\xa9\x81Q\x0c\x84<t\xdf-\x1f\xab\xae\x94\xb2\xf6\x90\xce\xa2S]\xea/;\x86go\xa3Cw'0\xd8g\x9d\xa7\x05\xd8\x81t\x17q4\xea\x0f\x14i\x9aaL\xb7\xa9\xccv`/\x81\xa7\x17\x91n*\xe3YZ\x99\x83\xab34\xf5\xc4\x9b\x8c\xda\x1a\xdf2\x88L\xacW\xb4\x81\x969\xf89\x95j2\x0f\xbf~\xea\x09s\xf7\xac\xb7\x82\x81\x92$\xfb?\x9a\x05H\x99R\xcf\x05KM\xdc\x12\xe4\xaan|%r\xb7g&\x92%\x9d\xa0\x09/\x96\xde\x1a\xbb]\x0b\xaa\xe4\xfa\xc3k\x81\xc8\xd9\x00h\xb3D\x0f\x97\xde\xfdV\xf3\x0f\xd9}\x0fM\x97\xce\x19\x84\xb9\x85B\x0d\x1c\xb6s~\x8b\xde\x89\xa8\x9d\"G\xd2\x152\x04\xb0iL\xb0\xfc)vZQS\xd2\x93dI-\xa5\xea\xb6}9Ix!X\xd7\xc0{\xe2\x843rG#\xb1\xbb\xef.\xd9\xf3\x84\x14\xae\xbe\xc5s1\xa8\x86e\xb2\x1c%w\xb0\xcb\xf9:\x85\x1f\x93\xc6\x88\xb0D\xf6\x1d}\xffSG\xb8\xd8,\xf0\xd5U@\x11Wq\xe2\x96\x1c\x17\xc6}&\xc3{\xd69\x0a\x0b\xe6o\x89<\x95\xc4\x17\x8aV_\xf8\xd6F85\xa9(\xf0##\x02\x0d\xc8f\x01\x92\xad`U\xa8\x9f9\x07\xd4\xf9t\xc6\xf5\x82\xd4\xce&\xe7\xe0G\xcaR\xe8'$\xb9\xa5L-\x00\x18\xa8&\x11\xeb#5\xe79\xa7I\xf7B\xc5\x86\x0ad\x1b\xc6\xd7\xc2\x90_\xe3y\xda\xbb\xcd\xed\xe1\x9c\xa3\x07\x95\xa0\x9d\xe1\x81\xaes\x9a63\xf7<\x0a!;\xact\x83\x90\x86D\x07-w1=\xf1\xdb\xcb\x10-\xb1\x84\xac\x86?\x16\x85\xa7\xe2\x8fU\xbe\xc7\xc9\xce[\x1e\xd4\xb8:o\xc3\xf8\x8f\xc0\xc2\xfcB{\xd5\xb9\x16\xd7\xa2\x82Q\x97Y\xd4A\x10\xab7#\xc2\xd6\xba\xf2J\xc1\xbd\x87\\\x1f\xab\x83h\xff\xf4;\x94q\x97\x06d\xca\xb9\x95\xa0i\xa3 \xd4k\xdf\xa9\x02\x16\x0f\x1cVrG\x0a\xfdYN\xe5Y\xf7}\xdf{\xdc\xe37g(P\\XA\xe3`\xe4\xb5@[\xa8\xd7e\xd7\x09O\xa2\xc2\xa9\x88\xc8\xa4\x12 `\\/Y\xee\xce\xfd\x07bCnP\xe3\x7f\x82\xc0\xdb\x1c\xd3\x0e~tR6h\xcb\xba\xac\xfe\x8cC\xd6Qk\xce%wu\xd6\xf7\xca5\xacH\xe9zZ\x0f^\x80A\xce \x0aA\x92\xa3\xfb\xe8;!$\xd7\xaa\xb9\xe62\xd5i\xfd\xb5\xf3\"\x0e?\x05O\x18\x1dB\xb5\x11\x01\x98=S.x\x1d\xcb\x02\xbd\xed\xcc\xa2\x99c\x06\xc8!\xfb\x0c\xcbJ\x19\xc5\x85c\xa5\xd9v\xd7\x8e\xa6\xf6\xff\xf7\x16\x17.0R\x80\x92g\xf3\x97\xba\xc8\xd8\xf0\xac\x06P\x07\x98\x86\x08iX\x8e.@\x85!\x18~P\x11j\xe6L\x1b\x9b\x88b$)\xe3\xfe75\x8b\x1e7\x97\x94\x93\x1eV#}B\xcfs\xeb\x9aH\xcbP\x8d\xba\xe7\x80\x06\x90\xca\x02,aF\xb4\xe2Vl\xac\xb1\xa2\xcc\x12\xb2\x08\x95\xea\xd6\xdcZ\xfch\xd5<\xe4F\xb8\xa4\x1d\xdc\xfd\x90\x98\xd9\xee>\xa0\x1aH\xc0Z\xf9\x0dh\xd7\x8b\x17\xd9[9\x09\x187[\xf2\xe2\xc4\x07\x8f-\xd38\xaeoC\x10\x85\x0d\xc2\x8f0m\xfb\x054bE\xa0\xda\x90\xbd\xebzW\x92\xa6D\x9e/\x85\xccx\x81E\xc7\x05l\xfd\x16\x7f>\x02\xb3\x8dM\xe8\xa5\x0b\x1ag\xf7\x04\xeb\xa5\xbb\xec\x7f\x8a\x8a\xbd\x0e\xaf\x93\xa8/\xe2jd\xec\xa8t9cz\x1f%\x0c\xc5\x1b\xd9\xedgx\x0aV\x8e\xfa\x1f\xe0\x89\xca\xcfg\xb2V\xad*\x80\xed\xec\xf7\xeb\x05\xe20lm\xdb2{F\x92\xba\x03u\x02\xc4\x06P\x09\xa1~\xcd\xb8\xc6S\xab\x01/\xfbc\"\xbf\x01y\x9c\xad\x12M\xc1e\xf2\x01t\x88I\"C\xb7\xd3.\x85\x95\x10\xb0B'\x1a\xbb\xbe\xc9\xb8\xd2\x08\xf5\x87\xad\x91\xf4e\x8f\xa4\x91\xdf\x0c\xca\x9b\x17\xe1\xea\xc9\xe2e\xaa\x0fbZ\xd8\xf7\xa4\xc3\xf7\xb7\xd6ft\xe0\xd1Q\xc60\xdd\xfb\x14_\x87\xa6\xf2U\x1b=\x03%\x1d\xa9_\xbf\xb0b\x02y\xaasXg_IQ\"\xdbl\x00\xf4\xf3\xfcN\xad\xbd\x9a\x04~\x05GY\xd1c6$\xd4\xc2\xce\xfd]2AtJ\x94\x16\x06Q\xb9\x88n\x9d\xf3\x08C\xa4\xf0\x13\x1f^\x96\xb0\xc0'[8bH\xc7\xb3K\x04'g>(m)\x80\xc6\xff#\xef\x7f\x1c\x15?Ih\x153\x84\xac\x1ah|\x14s\x19\xf5\x81\x9e\xa9\x94j\xb5s\x9e\x08a\x9cy\x17\x1a\xac\x82\xc4?_\x89n\x9f\x89e\xfd\xa3@dE\xbbG)4\xeb\x9d\xa6%\xc2F\xa0#y\xee\xa6 \xaer\x17\x12[\xb8\xc8\xa3\x05\x1fEV\"\x9c\x1a\xe1\xf5\xf9%\xf4\xb3\xb8\x8bk\x11\xca{_.E\xea\xbd\xf5@\xd5f\x06\x90\x0d\xb9\x0f\xe1-\x14\xf0\xe9\x9a\x0a\x90Yt\xd2\xc8\x0f\x05\xf1J]\x89\x84\x02\xfbB\x0a>(\xb0\x94\xcfx\x99'\xc1\x83Bu\xb2\xe88\x12{\xaf\xd9;\xa2\xbbo\xfb\x07\xe7T\x9d\xc9_=Mm\xc1j,\xaf\x84\xab\x92\x9b\x1a@\xf2\xcd&Vja/@\xc8\xc3p\x8b\x0f\xdf\x80\xe7J]\xf8oT\x07zT\x8dT\xab:4\x87 Ut\xf4\xddVU/\xcd\x07\xcd\xd8w\xc1\xe1\x7f9\xaew\xc9T\xff\xe2\xb6\xff)\xe1\xfbl/\xc5\xeb]L\x98U*?SV\x8e\x82\xb2VB\xe8Ho\x7f\x991\xca\xf6\xf7\"\xc2@\xca&M\x92\x8c\\\x8c^:\xe2Z\xe9\xf1W\xdf\x9a\x12\x16\xfb+\x8a\x92)0\x81\xd0\xb19\xc7\x0f\x1f\x10y\xc0\x1eK>\xbf\x985U\xd7t\x82pVm^qU\xd0gZ\x84\x97,\x96q\x91\xf3n\xf6\xd3\x94\xe3\xc2\xcc\xa7\xb11\xbb\xb1a\x03\x7f\x15\x82\xf0E;}51\xf4\xd9f\x82y\xc5^\x15S\xa0M$\xfb\xbekt\xb2\x96TI\xca\xb94\xd3\xfa)cjjh\xbf\xd1\xc3 \xcd\xd4\x11&\xb0\xa1\xaa\x88l\xb4\xbcb\x8fab\x01'\x9f\xdd\x88\x9b\xb0\"\xc3\x0c\xf5\x03r2\x85\xfd\xa37\x89\x9e\xd4K\x7f\xe9:h\xb9\xc5\xf4\xb3\xef\xc9X$G\xa0\xf0FT\xed!1*\xee\xbd\x93\x83 ?\xcd\x08\xc0x\xe3\x01\xa0\xacf\xc8vm\xd9\xea/\xfb'\xa9\x01\xfd\x7f\xe8\xe3Y\xff\x0d\x99Pv\xf3\x84\xe3{\xdd\x1a\xd1\x86\xd0\xcakT\x06\xb4\xb9*>\xa2F>mn\xc4HOy\xcf\xa2\xbe\xd53\x09W)*\x00X\x85\xe9\xef\x8a\xe5\xd7K\x03\xc9\xce\x94\xdf\xbd\x0d\xa5\xa0\xfdm{\x0f\xee\xe9\x14\xda\xbfs\x89\xdbz\xdf\xad\xfd\xafK\x1b\x1c|\x8aq,V\x12[\xfefPR\x9ar\x1c\x16Ry\x7fk\xf7\xe3f\xc4\x7f\xc8i\x1f\xcc\xb5OJ\x0a#\x0f\xd8\xd65\xd7\xd5\x94\xe6b\x97\xfb\xc4-\x89\xa8\xde\x04n\x82\xd9\xca2\xe9J\xe7\x89aP\xaf\xebM3n\xdfwo\xadSs(Xi\x85\xe4\xe4\xb6\xfe\xcf\xcd\xf0\xbf+A6C\xbcHXc^@\x8e\x82.\xb1\xa8_\xf8\x12\xb3H\xd5\x92\xe0\xf3Lfb+\xb4\xf8z\xee\xf5\x13:\x0c\xaf\xce\xff\x17\xc2p\xf0p \xc8\xd2\xa3\xff{\x149\xdcD\xaa\x9e\xf1\xed2s\"\xb7\x0aVB1I\xef\x92S\xf9\xf7]\x84\xc8\x9aIZ\x92\x12 \x17\xd8\x88\xddv4aS\xa8\x03z7\xd8Xv\xb5\x87W\x80\x15m|f\x96\x09F\xd2G\x0d\xf5i\x86~\xb3\xaf\xef{\x8e\xba\xf7\xa9\xe3\xb0\xa1\xec\xd2\x14\xa4\x1e\x86}\x87\xdd\xeb\xba\xda5\x80\xe1JD\x92\x95\xae~R\xd5\xab\xbe\xc3\xeeK>\x06A\xd0*\xb9\xc5\xbaB|\xe9+\x9a\xcd\xb8\xef\x80\x07\xf2\x801\x84\xe5\x10\xe6\xe0\xb8\x0b\xcfr\xb8\x13\xab1\x86\x94\xcb:\x8d-\x1a,\xbc5G\x14JAD\x97\x94\x04ZF\xae\xaf\x9b\x8aS\x97a\xa0\xf2q%\x0a>\xd0\xca\x1b\xf7\x1f\xfa$\xce\xfa\xf5\x8dO\x8f\xb8)uY\x8e\x91&\xbd\x07?U\x18uE1\x9b\x92\xec\x12\xa0\x17\xb1$\xbd+[$\xc8\xcc\x7f\xcf;\xd8\xff\x94z\x07Nw\xab\xbd\x87\xe6\xbc\x1957\x9cLqW\xd7\xad\x01\xd7Z\x12m\xda\x85\xc5\xd8K4Z\x0d6\x0a\x98\x84\x055B\xe8\x1c\xa3\xfes\xc4\xc0\x82P *\xc4\xa1\x80\xa0\xe7W\xc7\xeb\x8c|\x90\x08\xd6\x8f$\xd9\xf0\xffc\xee[\x93\x13\xd9\x95u\xff\xdfQ0\x83k\xc0\xf8\x11q\xe3\xce\xe5L\xe0D\x9c\x13q\x06\xc0\xd34mC\xc1\x0c\xf6\xf2^\x1b\x1a\x1b\xd3\x18\xdb,z$U\xb39\x81\x94\x99\xca\x94T\xa5,\xaf\xeef\xff\xd8\xb1c\xb9mU\x95J%\xe5\xe3{ f\xa6\x18\xd9\xf4\xca\xa6:\\\xa5\x7f\xcb\x9b\x1dZ@\xd0/{\xd7\x9d\xf2w\xed\x04\xcd\xb2\x98\x7f\xa5\x99\xf8\xae\xc2\xb5\xb3%\xbei\xe8A\xf3l]\xba\xde\xa6F\xbb.\x13\xb2p\xb3\xcf\xcaa;\xaa\x18\xee\xb1\xef\xfd\x06\x9d\x95,\x7fq,\xb0q\xa9\xfd@?\xb4\x1f\x80\x13Nq\xb37\xbf\xe7-\xba\x8cfY\x8c\xf2\x03\xec>=ix\xc7\x952\x99\x15:S\xab_{\xd7M-\xd2\xf6\x85\xf6\xbaP\xe1\x9e\x17\x0f6\xd4\x9a4\x80\x95{\x9a\xc3'\xebx5\x83n\xcb\x83&\xbb\x18p?\x16\xacz?x\x9ch\xe3\xe0X\xa9Q\x7f\xba\x9e\x86\x13\x1b\xb0DQi\xd2n\xdbvO\xc7\xca\xd7\xce\x95\xbc\xf1\xb3\x7f\xb7\x9b\xbc\x867\xdb\x0f\xcd\xcf\x9e\xb1\xe5\x021\xc5\xbd\xa1\xff\x98C\xa5J\xb7\xa6\xfd{\xf6\x10\x87h\xe2\xc5:\x1eg\xb8725\xcb$s2\x8b\x1a\"\xad\xf7N\xffi\xb4\x1f\xa7\xc81\x10.\x1e\xa4J\x0f)\xbcf\xabm_\xff\x9ei\xbaIMS\x18\x8e!\x0ay\xea\x0cz\x845\xec'\xc2 \x0f\xd9\xe4D.c\xa5UTW\xb1\x06\x0e]<m\x9dS\x83\x86x\xfb+'\x95\x11q}\xe8\xc4i,\"Zy\x9a\x04\xa2\xe1FZ\xb43@\x0fT\xc5\x12\x97\x12\xcb\xd8\xa5\xc4\xacoa\x0fv\x9f\x19r\xfa\xdc\x1b\xeba$h\xc5m\x8fmm\xf7Q{\x05\x89\xf9\x00p\x8d\xdd\xdc\x00\xe1$\xa9\xd83n*\xb7v\xf2\xc2\x1azs\x1c3\x13\x0dmA\xad\xcc\x89%\x9ay\xdf\x00\xd3`T\x97\xe7\xfb\xcb\x16\xcaU\xf9\xd4\"\x0et\x0dA \xfc\x93'\xb3\xdc\x10&\xb5\x14\x13\xf0\x00>9\xaf\xbfg\xa7\xb9\xbc)I\x809^\xdam\x9c30|(\xfa\xfc\xe9\xa5\xb0\x88\xfb7-?\xf8W>`\xe7B\xeca+\x91\x8e\x83\x01\xc6\xe9\xb3xB\x9c\xbc\x95\xc1ub\xa9\xa1\xae\x89\xa2\x1ex#\xea\x81\xa3|\x95\xff\x99\xaa\x04\x9a\xd0\x94I\x8d\x95\xea\x0c\x9c\x05\x84(\xa4\x0bF\xe4\xcf\xfe\xa7\x8b\xf7\x8f\x06\x1e\x9f\x1a\xb1\xcd\xe5\x8a\x9d\x08L#j\xaf5\x04\x17~\xc4$v\xd3\x8b*N+(\xb5\xa0Q\xc0\xec$|\xf8\xef\x8cy\xf5\xf9\xfciH&\x91\xdb\x1a\xf6\xa2ai\xcc\xc1\xc7\xf3\xb5b\xb8\x80\x9d\xe8us\xb8\xa0\xc8I\xa6\xc8^5\x7f\xae\xe1\xd1\x19I\xcaFn\x07pW\xd8\x83\xa0J*\x8dj^$\x86\x8f\xa9\x1d\xa3\x0d\xd82\xdfW\x0f\xde\xd4\x0c\xeek&3\xb6\xdb[\x1d8\x15\xd0n]~\x89\xbe\xaa6\xeddS\x83\xae\xaf_\xb1=\xb6U\xbc\xdcf;\xf50{\xaa\xcb?;\xe8\xc2]1w?\xaa\xe5\xb4\x98\xbf\x8a\xe1c\x9a\"`\x95\xc5\xbb\x01C\xe7\xe4\xf7\x04\x08P\xc5\xe5;\x81\x9b\x9f\xddJ\xa0\xd6\xcc\x12\xd9\x0d4\xde\xe8\x01G\xc5T\xb7\xd2\xae\xfc\xf9\x13b1Dy\xec\x02`J)\xd5\xe8bSiW\x10\x06\xfc\x1eh\x08\x1c\x12\x93\xb7}\x13\xb5C\x00\xb6\xaaeA\xcc\xb8\xc0\xaa\x95P\xb2\xd2\xaaN\xcf\xfb\x11$\xee\x99\x11VW\xb9\x01x\xb5\xc9\xae\x95h\x04\xb1\x93\xac\xb4Q0\xf5\xa0ad\xc94v\xbd;-\x98go\x02\xf89\xac\xb4r\xfb\x86\xc8U\xf7\x12\xb3\xa2\xc1\xea\xb8W\x1aqn\xf3\xc6?\xbdI\xb3\xef\xbf+>\xe1V\xcb[\xe6\x88\x08>9\xd8\x09E\xf8?Rw\xdb\xf6\xcb%&\xb6x\xaa\xf0\x92\xdbP\x99r\xa5E\xa2\x08\xa9\x10\xeb\xc1\xdd\xf3D\xcc\xf1\x0a+8\xfe\xb6\x01\xce\xf2M\xfb\x02:q\x99\xa8\x0d\xa9A1\xbc\xf3\x0fR>_\x16=m\x892\x00\x13eEW3\xc2u\\K\x95\xd2hi\xc3*D\xfb\x9e\xdd'\xb7A\x96sj\x1en<\xdd\"\xc7\xa3\xb3\xd7\x00\xd0\xa6\xaf3k\x10\x0d\xdf\x8b\xb9\xe6\x12\xb7\xde%\xe2Fe\xdc\xcfL\xb2<\x84\xb2\xa0\x12\xef\xd0\x95k\x08\x8f\xc7\x01W\xd0 U^wZ\x8a\xb5\xe0\xb5\xfe\x15\xd5Di\x8f\x93\x95W\x0f\x86$\xa2\xf4Z=tK\xc8=m\xa5\x095\xd3\x91/F\x9a\x1bm\xfb/\x1b\xb6G\xe0W \xa7j" +
+	"\xa1\xfa\x84\xda\x97\xc1\xd2q\x98U\x938\xdd)\xd6{\xbb\x13\xdf\x00\xf6NL9\xb6\x05\x00\xfbwQ\x8c\xfd-\xa0\xc6\xcal\x97~\xad\xd8\x8b\x12\xc8\xfe>j\x9b\x1e\xe8\x17\xd5\xdf\x19#9\xb2!\x83\xe3\x8c:\x17\x99W\x96\x93\xd6\xdeCN\xbb_0\x07b\xd1\x9d\x96\xb9\x1d\xdb,\xb8\xf7\xab`\xfd \x19\xf1\xc5\x18@+\x9e\xaa4\\\x10v(\xd4./\xc6'`\xa1\xe7\x0a\x16\x88\xb5Z\xe5\xe9\xa2\x8bq\x97X\x17kX\xb8\x03\x98\xb4(\x82Iz\x87~\x87P\xd2\xe9}\xc7fh\xd6`\xa4\x0a\x93\x0c\xbd\xd3\xf7\x91\x98\x85[.\xb9^\xce\xb4\xa0f\xf0\x06\xb8\x16\xb3\xbf\x8f\x05S\x9d\xdf\x97\x17\xa5\xd45\xe3w\xc0z\x8en+\xf4v\xbf\x86\xf0>\xfd*K\xceS,\xc9\xffe\xb9!\x9c*\xbe3\x1b\xfc\xac\x11\x95+\xb1Q\xcd\x04\xb76\xa7\x8f\x11\x93\x93\x91\x92\xae\xf6\xcf\x96\x04\xaa\x04\x95\xb6\xac\x98\xb0\xa2\xa5E\xa8\xc6v\xb3\xf2\xf2\xcc\xadW\x9eY\xe6\xaf\xf9\xb1\x16\\\x0b#\xfdW+\xb4\x03\x1ft\xfe\x03&\xbawF\xb0V\x0c]u\x08\xd1U\x87z`-\x8c\x8e\xccsl\xdc\x13\x0b\xb7C=X\x8b\x0fW\xf4\x82\xe1>\x07\xd6\xca\xb7\xf8\xe1\xb0\x04\x92\xa0\xa0J\xb8V\xda$\x0e\xde\x89\x85\xdaZV\xad\xc2\x19\x03ACPd\xe4v)4\xe0V1\xe0\x8dggl\xc5\x92\xcb\x0a\xa7c\xefF\xef\x15L>\xe4\x91\xbeC\x05\x83n\xf5\x85\xd6\xd311X\x99j\x14K\x17\xc5>\x85\xbe\xbb\x10\xf2[\xa9\xe3\xb8\xbf\xd2\x86\xed\x10\xd5Z]\xcc'\xe3Q\xd6\xb6\xb9v\xa7\x10V3\x9b\xde\x88\\(S\xca\x88\xcdV\x89\x82*x\x0e,H\xa1\x19\x1d\xe8\xfa$\x19y\xc2\x09\xc3\x8cj\x84Y\x9a\xed\xb0\x17\xc7\xd9\x96$\xf2\xfcJ&C\xf6\x1a\xb0\x046P\x15\xaa\xbc\xc6e\x99\x0e\x91\xe3\xf3\xf1\x8f\x17\x8c\xa6\x13o\xa1S\xca\xeb\x07\xb8\x99\xa8\xc2\x83g\xdd\x89DY\xa5w\xd0\x14\xd6\xb1F%\x1a\xe2cw\xcaH\xc6\x09\xc7R\x8d\xf1kc\xb5'wjY\xdd?Kd\xaaR\xb5`E\x9b%\x18\x96\x9a\x0f'\xed\x8e\xe8\x09Z\x1el\x02Zu\xa9\x1b\xdf+\xc3L\xa4\x9dQ\x08\x9f\xb8\x1a8\x13s0\x13\xfd\x8a&\x1dU\xd7\xb8\x0dJv\xa4Z\x1e\x19=b\xf0\xb3\x06\xb7\xc5\xb7\xca\xeb\xb4d\x0aGDL\xa6\xa3\x03\xd9\xe87\x17b\xf5A\xc4{W=t\xd3\xf7m\xf4%c\xc8`\xb2\xa6oG`\xb6\x81:\x83\xc7\xea\xc1\xe4W\xbbq\xae\x09\x88\xfc\x82%[5F\xa9I\xc7#\x8e\xc0\x97z_\xa2\x12\x1fY\x8b\xe2\x8b\x86\x88#\xdd4]4\xcd\xd5JGT\x16\x1b\xc4\x95\xb62_\xbfd\x9c\xba\x81\xa8\x154l\xfc\xa2\x8f<(\xa6\x90\xcc2\xfe\xcf\x00<\xdf\x885\xa0X\x86B\x93\x06\x02\xe55oh8Wi\xb2\x99\xef9\x08\xf7\xb0\xacT\xc9\xe42\xef\x8a\xa9\xaa0sz\x10\xf4?Ea\x0dsvpe\xad=\x93 M\x0c\x1c|\xc9\xc6\x96\xf9`\x9f\x89s\x94\xa6\x9c@\x8cf\xa9;[\xcdn\x9c\x14\xb3\xe1\xb8\xad\xbe^\xfb\"\xd2v\xe2\xfd+\xdeX\x95\xaf\xf3\xf4\x13\xdd5\xe4a\xce\xef\x93%\xb6\xe4\xe3\x80\xcc\xa6)7\x09\xdc!\x81!u\xad\x96\xaa\x8d\x86l\x8d\xf4xm!\xdd\xbe\x86\x82\x8c\xcb|\x05P\x879\x16.H\xda!\xc3_\xd1\xd4j<8\xff3\xce\x87+\xaf\x1fH\xcav\xce\x14\xbe\xd5\xfb,\xab\xe8\xf8\x19\"*\xf1\x91'T\xb9\xea\xa5\x93\xb1O\xec\xa2\x12\xdfu\xaa[Z\xd9\xc7\xfc\x95=\x93yE.S\x02MY\xb6%$\x1e\xe9\xda\xdf\x01\x1d2\xc7\x0e\xa28\xa6\xdb\xb2a\xfa\x07\x18\"MyU`B\x16\x98\xa4M43\xabwR\xbd\x86n\xe3\xf1_\xec\xd9\xa7,\x1ctY\xfa#JH+\x81X\x919\x00[9\x13\x7fC\x89\xb3\xe7\xb4G\xbc\xb2\x05\xf4\xd2\x8a;'\xbb\xc0\xa5\x95\xa7\x0d\xdf\x0f\xe1\xae\x98\xa6n\xb0\x99\x9c\x04\xd2\x94\xf8\xf4$\xb4\xca\xb4\x84m:R\xf4\xfd[\xa7\\\xf6\x08g_\xa89\xcc\xb8]\xc9\xdd\xe2\xb2-}(\x98\x8f\xade\x94\x83\"\x093pI\x8fy\xa9\x10\x04\x1cBI\x05\xf6\xcd\xd4\x90\x9drb\x92\xb8\xd9\x19\x19nJb\xdf\x0bJP\xa5.t\x95\xd0\xf4\x0f\xf7 &\xdf\xc1\x8a\xc9\xee\x97Q\x9d\xf9I\xa2\x8fYS\xe0\x0d\x04\xe7@\x89\x039[\xa5Gbi\xad\xe9\xe6\x82jM\xc7|\x95\xef50 \xe9\x10\x17\xa8\x1c\x9e\x05\x00\x04\xc9\xc43g\x0d\xfb1\xe6\xb0zs\x8c\x0b\xe8\xcf\x80|t\xba\x15\x16\xe0M\xa5g\xf5\x94\x0c\x84\xab\x93\x98@~\x11{\x8dsq$a\xbaB\xee\x17\xeey\xf2\xef\xf0E\xa4\x0eAI\x87\xf06J\xa4\xaf\x93\xb5o\xb5\\\xe1Up\xf8\xc0\xe7\xc3\xfd+\x99\xb4\xe6\x17\xa5\x13\xeb\xb0D\\\xe0\x91ki\xebg\xf7&q>\xf0\xf5\xd1\xd7gI\xb2{H\x16V\xba8\xbeyQ2w\xb0G/h\xfe`2\x13Yp3\xedA!dt4.\x14\xcd\xd6Or\xa1h\xb6}\x8f\"\xe7\xed\xf1\x80\xce\x1eTc\x025\xfb\x07M\xc1\x87\x0c\xd0A\xb8\xe1\xc8\xe5{f\xe4R\x9c\xb64ir\x0f\x80\x8c\xb4P\xb6\xce\x97\xa8\xab\x19\x85o\xfaP\xce\xb5\xa4\xc9\x151'\x12#\\\xf3B)\x99\x80&\x82Q\x14$\xeaj^\xc6\x0d\x9b\xbd\x8c\x9aQ\x88-\x15\xado\xa6,\xaa\x94\xe6\xae\x10p\xf4\x06k0n\xd9\\q\xdfQ\xa7\xd4\xa8\xce\xa24\x84\xaf\x1e\xb4YR6\x81tl\xcd\xee\x95|\xdfW\x9a\x81[%b\xe5N\x0e\x82>#\xfb\xf1\xbfiFm\x97\xfa\x0a\x08D~\xd1\xc3\xa6\xec\x90\x0bK(.p\xe9\x89#/D\xff\xac\\\x87\x9f\xc5g\x07\xa6\xedW\xadT\xefq\xf3\xa8\xb6\x8d\xaak+O.\xd4\xe5\xc8\xf8;s-\xe8\x85\xbd\x847X\x83\x81\x18\xa9\xc8\xfd\xdf\xa0\x15\xaad\xea\xb9M\x1c\xac\xe1\xa2\\\xbd\x8d\xe6\xa6\xe5qu\x04d\xcb\xca\xc1\x09z ~W\x07\xdd\x92\xbf\xc2w\x8d\xe87\x01\x1c|A\xe9\x17m\xb1$\xc4|\xe4?luW\x0dIAv\x89c\x96HQ\x9c\xd4<\xb5[\xde)\xf0\x8b\x0a\x14\xed\x1a\x05\x8awH\xa9^\xb0@\xa1\xa9{\x04\xddj[|\x9f\x97v\xab\xdd\xa6\x9d\xbf\xc0\xea\xca4E\x0f;\xb33\xc0\x90\x90\xfe\xc8#m\x9ew:\xdb\xc71\x04uK\x90\xf4\xf1\xf4L\x9c+\xfa\x9d\xde\xfc\x11\xc1W\x99\xc2/,\xae\x01\xffF\x86\x13\xf7\x1e3\xb2\xfa>njy(\x90\xf7\x9a\xcau\x8d\x010>p\xc2\x00K\xe2\xfb\xb8\x99\xfa\xd5\x9bbL\x8fw\xa6\xb8\xe9\x985\xf2\x0f\x12\xb6L\\\xad):\xc1\xd6\x89}\x0aN\xec\x04\x9bXkF\xe2Y\x19\x94\xd6\x11[\xd3\xf0T\x09\x98S\xb1\xa8\xf3!\x81~\xe7}]\xe5ylS\x88y\xaf\x8b~1.&\xf9.\x99\xcfz\x9a\x1a\x9e\x19\x91\x85'9 \xee\xe2\x8c\xa0\x89\x94\x02\xca\xb2\x18\x15}\xd8\xaew\x09\xc1\x8ev\x1d\xb9\x92\x00\x8e\xbcH\xe7\xb7\xf1q\x8f@-^\xda\xc2\xeb\xd1D\x8e\x1a\x81\x91N\xe5\xb8\xc5\x18\xb2\x8c\xf1\x89{m\xa5\x85\xdf\x14w{U}\xb7\x0eJ\xb2\x83Y \x81\xe7\xeaq\xaf\x93\xef\x0d\xb2\x98\xd3\x1a\xcb_i\x8d\xa5Tfn\xaag\x01\xf5\xef\xff\x81^\xcc\xf97\xbc\xef\x7f)\xe6\xf86\x92\x18\xcd\xad\xa0\x90\x0dw\x1e\x1a<U\xf9\xbc\x1e\x0e\x98\x1e\xdb\xb4\x10\xd4p4\xaf\xabZ\x0d'\x80\xe4\xf7a\xd8\x83^\x0d\xa7\xe4c\xe0e{3\x9dG\xd52h&\xbf2W\x0e\xb6\xfa=\x86\xa44\xd0\x08\x03]zv\xf8\x99\xc3\xb29{\xac\xfb\x86S\xa1Gp\xf3\x87bwhvb\xce)\xa5\x8e\x84\x0b\xe3`;Bl\x82\x06\x14\xe1&`\xec<\xf4\xa9\xc5\xa3\x19\xebZ\xe7\xceM\x15S(\xf3\x9cR\x19M\xc6\x9c\xf6\xe7^C\xff\xa0[\xccS\xc3\xde\xc6\x01\xf5\x0d\xca\x92\xc7\xe8\x95\xb9\x03\xf2\xc7\xd8\x92\xb9\xfb\x08x\xacVq\xb9`\xe8O\xf2\xa9\x1f\x93\xe2\xa9M-\xb0\xb9\xc9\xb0JG\x087\xdf \x81\xd4\x08\xd04=m\xeb\x03\x04\xe3\x0fBf\xf6\x91YZ?\xe8l\xad\xa6\\\x8a\x1b#N\xd49%>\xf8\xb3\xd5nLP\xdb[\xed\xc0\xa6\xc7\xd6\x1f@\xe7\xf5\x0f\x0fq\x93?\xc2\x96\xdc\x93\xf6\xd7\xab\xa4\x1br+\xa6Y\xe5\xecR\x03]\xef\x1f\x90\x9d\xad\xaco\xddI\x98vJ\xd4\xf2\x07\xa5\x12ip\x19@)\xc2:b\xc5\x9d\x03$\xee\x0f\x88#\x7fN\xaf\xa6\xb0\xd8K\xd6\x88XT\xbc\xe3~\x0dz\"\xc9\x82\xddr\x89\xd9\xff=\xf7\x96G\x11\xe1\xa9BQ\x8ae\xd3u=\xe6\x9d{\xa2\x9d\x9b\xa92\xd5\xfe\xc9r\x96\x84\x91\xe1\x9fg\xd9\xe3\x97\x07\xc2-\x11\x08\xffY\x8c\x8an~\xc8\x97\xf5C\xe1\x008\xb2\x84v\xc4\xa1\x98\xfe\xfb\x85\xc3\x0bG&1%Yp\x8a2\xb5\xf6\xfc\x89\xdf\xf1\xe7Bb\x19\x0c\x16]u0X\x1d\x12\x9b\x88j\x05\xfcE\xb3%Y\x96\xe9\x93b\xe4N\xcd\x18 \x06|\x0f\xbc:\x95\x01\xf3\xc4-\xec\x09@\x18K\xdd~\\\xf9\xfa\x07\x95\xfe\xd1\xec\xc9\x97N\xa6W\x99\xef\x92\xeb\xe1\xba\x84\xac/\x0a\xd0\xfd\xc0++_\xba\x1fZq\xca)\xba\x94\xa9\xb4(\xcd%Xx\xfc\x0eo\x8f5$\xfc\xc2\xef\x8e\xba{\xab\xc4\x85n\xd3\xe6\x941]+\x87}\xd5X\xf8\x87\xd1y\xccY\x93\x19\xa2L\x19\xac\xf9\xaf\xb4\xb8_3\xda\x1cqF\x02\x86O\xabPP\x94P\x87@\xc9\xa0\xc16`.K\x988\x96\x9a\xedZ\x1eE\x83b\x0a/]h\xe5?h\xb9\xe5\xfe5h\xeb\x88\\A\xe4\x1a\x9a\x1eV1!\xc2u\x00)\xf0\xe9\xd9\xba\xa5qU\x16h %\x87A\xd9\xcc\xa2\xfb\x81\xd5a\xcd\x1b\xbd\xfe{\x0a\x9f\xbaG\x88z\xeb\xfd\xa2k\xdd\xc6\xab\xbb\x03\xb70\x19\xd3\xcd\xdb\x97\x91\xfa\x8f?z\x08|\xde\xa4.\x96\xad\x96\xce\x12\x1a4p\xe2*\"\x85\xb6\xa7\x0a}(\xbaE\xf7\xf4\xc0\x1a\x9a\x91S\xc4\x11\xe27\xf9\x01R\xa2\xcc.\xe93j\xc2\x88C!\xf0\xebX\x9a\x80s-i\x11^\xb8\x8e\x14\xa5}\x91\xc9Hq\x8c}\xe8j}\xb2\x92\x93\x9f\xb0\xc9\xb6\x98\xcb\x02\xd2q\x03\x8e(\xe3\xa7\"\xfe\xddT\xba_l\xfa\xadP\x82\xbb\xf4\xc5\\`\x89K\xcb\\\xbf:\xeb\xa3\x97\xf04\xb3\xf2\x1d\xa7Iz\xd2K\xd3xR\xc0\xb6<\x9d\xff\x95\xdc\xdd\xae\xb4\x8cN\xf6~,\x9c\xd2\x05\x13\x9a\x10\xba\xbcj\x17GVH\x16x\xc9v\x9e\x89\xed|o\x8az\xf3dzwSk[\xf4\x86\xbdW\x05\x13\xee\xce)\"\xb4L@;\xa3\xf7\xca`\xe1\xd7\xc9%7+>\\p<\x89\x87=\x81\xdc\xb4n\xe7n\x95\xe0\xbd\xe4u\x98\x12\x0b\xcf\xe8\xa8\x8d\x05\xeey\xce~\x11t\x04\x9e\xd2\x87x;\x96x\xad\xad\x883\xeeB\x9eGQ\x83\xe9 \xcc\x8a\x91\x8b\xea\x19\xea\xdbr\xc1\xd1\xc6o\xf6)}\xe9\xcbD\x14\xba\x0b\x8dj\xbf4\x04\xe1/\x03\xc7-ar\xc9\x85#,gg\x91\xef\xf9\xfb\xd4D<\xa5\xb0'\x1e\xa2H\xda<\xb9;\x95\xaeY\xbb\x006\xa8`\xa1\xb9\xa3\xabJ\x93zn\xf3\x04MT;7\xb1\xaarVS\xe9{\x02t03$\xf7\x15\xf2\x12\xa1\x9f\xa4\x8d+\x15\xc1\xd5;c\x10L\xcb\xbd\xf1\x08\x9f\x93\x05\xb2\xd6\x14\x0cOn\x91r\xf4d\xa5\xea\xe2g\xec\x94\xad\xa6\x08\xcb\xdf N\xde\"\xc1Or\xf9vN\xe4\xa4\xfa\xdeZ\xbf6]a\x9eP\x1a\x17B\xc1\xb2W+\x7f\xa3j\xe7i\x15\xd8\xa6\x0f\xdc#\xefj\xb3\x0fU\xda>N\xf9q\x7fL\x87\x80\x1d\xe1\xdc\x12\x18\xdd\x09\xcb\xcc\x0d(SQ\xe6\xfeB\xd8\x19]\x95\xd4\xd0\xca\xd6\x90\xe3\x92\xa1*\x9a\x99Z\x82k\x94)\xc6pW\xd8L\xb0\xcc\x05K\xf0\xe4\xca\xaf\xee\x1f\xb3|\x9f\x9ev?\xcb\x92\x86}\xce4\xd4j8\"x\xa2\xf2qo>c\x9a\xfb\xc9\x05\x7f[\xb6\xe0\xbdh\xfe\xd1Q\x89\x81\x94\x1b\x1e3B\xc4\xcd\xfb\xfb?Pw\x83N\xa4\xfc\x03X0\xfd\x1a\x82\xe1~t?$s]T/\x12\x07\x10@\xae\xedz\x99\xe6\xaf\xe9+U\xb4=G \xed\xca\xd4\x92<\x86-ZM\xdca\xd7.\xf6G\xa4\x87|\x97\x16]n\xa5l\x07\xc7\x1c\xc1\xe3\xf1\xce\xab\xcd\xc3\x06\xe6\x0dL\xe1]%\xe7\xa5\x1d?\x12\x90\xca\x92\x85y\x9dp*\xadS\xd2j\xc7\xec|\xfc\x02Kb}yz\x82}\xd3rbyC\xcd\xf5\xd7\xf9e\xdb6C\x80\x11%\xfe\x89@\x0a\xab\xb2G\x981I\xfe \xd4+/\xcf\x95\xd7'.\xfdNF\xfeV\xf4\xf2e\xd1\xfb\x19\x9d\x0c\xc2}\x9e\xbb\x8f\xc1\xf4\x81\xf25\x9a\x1fr\xae\x1a\x11`7`\xf0\xf3\xb7\xfa\x18\x0e\xfb\x0a\xa9\xcb69b\xa8\x95\x89^\x00\xa0\x08\x18\xe0_\x91\x18\xc4\\S\xf5\xe8\x9e\x9f=!\x9fmZ\x08\x91l\x14B4\x9e'\x9b\xe2\xbeJk\xa0\x0fQ\xe9\x06=m\xd1P\x15\\\xaf\x12@\xc5\x14\xd3\xd3\x16\x8bN\xdf\x96\xb5E\xf7\xc3o\xeam\x17\x13V\xf7\xa8\x06hK\xaf\xad=\x95\xb8\xa6\xe8\xe8h\xcb,p\xc4{\x92cX\x1b\xaba\xc7N\x0aKx\x16A\xb2]~x\xd9uf\xbd\x17\xeb\x98\xa7\x17\xe3\xcfO\xa24\xa2\xb4\x06\x1dw\x10\xc4\xde\xa9\x94]b\xd3i\x17\xc7\xd1&\x83O\xac\xfd\xa7\xd7\xde\x15e\xff/\xc8Q\xae\xe3L\xee#Q\x98V+\xebp}~\xfe/K.d\xe6p\xe4@\"#\xcc\xbf\xa1=\xbe\x81\xc1\xa7:\x02\x0f\x1d|,R\xc4\xaf1x2\x97\x01\xaf\xd0\x8b5\xf5\x18Wu\x80P\x00\x1e\xb5l\x97\xbf\x01]\xe2\x05)fV\\\x03\xb6\x04\x19\x8f\x8dD%l\x09\x85\xdd\x92\x83\xbaou@\xc0\xdf\x05XA\xcfp\xae\x0f\xe6?\xa7(<qtLt\xfb\xd3\x07\xa5\xcc\x0a>\xee\xc0\xaf\x9c,S\"\xf7\x15Ix\xe0\x180\x03\xb0\xf94\x16\x11\xceb\x9e\xc6\xc2\xf0\x859\xed\xd6H\xdaW\\7\xdd+k:\xf43\x85J\xf5J3\xadv\xc9Z\xba\xb3\x05~\xd15\x15\xae\x1a\x13\xa0\xd1\xe9sx0(\x98\x9ae\x9e\x01B\x9ct\x97\xb8X\xdf\x83\xf4(}\xa8\xad\x81\x9b\x0e\xa6A,\xc7,\x0a\x0a\xf3\x193\x03,\xa4IV\x8e/,\x968\xde7\x08\x91\x85\x01\xec\xe9W\"\x10\xb3\xf20\xb5#\xc2\xd4U\xbe+\xc6\xf9\xb3V\xa9\xcf\x8bM-\xda\x16n\x9a\x90'\x10\xb4\x9f\xc0[g\x16\xed[\xc4\xbc\x1d\x11w|\x04\xdcq\xa6\x8cM\xdfH\xa4k\x8b~WC\xaa\x9b\xd9\xa1\x1e\x14A\xa9\xa0OZ\x97m\x90aC\x10\x10 \xfcLig\x9b\xbc\xc9\x8ehD\x8cX\x8a\x16\xbc\x1b`i\xa5F\x8c\x88\x81\x89r\xb5\xa4l\x98\x07\xc2\xb9\xbcW2\xa9\x83\xf1\xbe\x88\xc6v\x90~\xf9\xd6\x8dn\x83\xa5/\xee\xcdV\x8b\x12\xb7\x11k]\x15#{\xb8\xb8\xaaL`\xcc\x1e\x87\xc7y\x1b}\xbet\xb7)W\xb6}\x1c\xd0O~u?$\xf1\xec\xca\xf7q[\xe6\xf2A\xee\xe9h\xad\xdb\x87\x05T_!\xd7\xdf\x8b\xd9{a\x14(A\xc2}C\x9b\xc9\xca\x09o^\xe8\xcacLC\xc0m\x8f\xa5o\xdb3zv\xbf@\x0b\x9e\xf5\x1f\xb8X\x05\xb8\xfc\xae|\x8d\xeb\xa8}\xf5,\xaa0?N\xfc\x09\xbdZ\xc0\x84\xf5t\xa2\x8a\x95\xb3\xf3S\xcf\x13\xe9\xadpo\x0b\xab\xe8\xa54\x11\xe5\xd6\x8d\xa9.\xcb\x0a)\x92\x15\xc9\x93c\xd6\x88\xc9\xf8\x82\xb9\xc6\xa9b\xc8qr+\xec\xf4)r\x07>'+\x83\xe44\xf2\x05A\x06; y\xb1\xe4j,\xcd(\x98N\xc2\x0c\x0d\xed\xf3\x7f9u\xdd\x19d,\xdb\xaa;\xbf\x8cJ/0,u\xd7A'iGv\x0a\x9d\x9a$\xe2;\xef\xcaqD\xbc;\xdd,j\xbaz@\x89\x9a>\xd2\xaa\xb9g\xeao\x18\xcd\xee\xd2\xc3]\xd7T\xa5\xa4\xae\xe2\x0c\xd6\xf4V\x85\x90\x8a\xea?\x9a9|\xa2\xed{\xab\xa36\xd4\x8as!\xc7\\C\x13\xf8;\x88:?h\x0d2\xe4\xe6\x0a\x1fX1\x16uO\xd6\x80\x9e\xd9`'?\xa8\xbaw\xd4\xe7vk\x98\xe7\x04\xd4\x15|N~\x1d\xad\x96\x87\x1bE\x9c^\xbeq\x0d\xa7\x0d\x89Z\xfc\xa5\x8a7\xafD\xbci\xa0\xdd\xe9\x92hi\xbcI\xffpp\x81N\xd1;g\xa0\x99n\xee\x86\xb7;S:z\x8d\xe0\xc51\x98\xdf\x13\xd9j\x98\x84K\x81\xa8\"\xa3I\x82\x80p\x0c\x1f\xe1\x09\xe6" +
+	"\xa1\xb8\x0dS\x07\xc0\xce\xc3\xbdNEZ\xaf\xd0\xe0\\\x1b\x1b\x86\x97eml5\xb6_\xec\xb7\xe9\x93\xe2N\xd8\xa0]\xdb5\xbf\xf2\xa0\x08L'\xa4\x84h\x9a\xbc8(s\x0d\xc8\xb8\x85\x0f\xc0\xec\xdd\x92\\\x17s\xfeEW\x1a\xdc\xde\x94\x15\x9cXwdGVfP\xd2Bb\x10ZYk\xa0T\x9e\xfe\xb69\xdbX\xfb%\xdf{7]y2W\x15A\xe8\x1dH6\x177\x9a\xb0\xe1\x99u\xa4z\xd0\xf2&\x15Y'\x91\x85\xcc\\i\x99\x93a \xec\x0fk\x83\xa9mj\xd8\xb6\x8e/g}\\\xa1\x0ez\xaf\x0d\"\"\xdc)'\xf0\x87\x04LM\xe0\x10\xc1\xfaL\x02[\xd9)7\x9e\xada\xd7\xf5\xc8GR\x8fq\xed/\xa7\xe2\x0e\x00yY\xcc\xc1\x80w\xd2O\x0f`\x8e\xcc\x01O\xd3vv\x96\"\xd7.?\x9f\xae\xc5\xf9\xf4\x98\x1f\x8bA\xf1%\xd9\xb2\xdb\x936\xfe\x86\xd4\xc9|\xdd\xc6\xbeD\xbe0\xdd\xe0\xf3\x03\x8c\x05\xeb\xc4c\x9chU\xe7\xbc\x11\xa5f\x92\x19\x13\x00E*\x89\xb9\xc8\x88\xa1\x86\xae\xd4\x1d9\xea\x14\xe5H\x9e\xe1\xe827\xa3\x0b\x93?\xc1\x8e\xf2\xd5yp\x8du\xcarf\xb6FPY\xb7e\xda\x19\xc7\xc98\xcemBz\xfd:ZR\xc4\xd0\xa1\x9c~\xe7m5\x13\xbfv\xea(\xcb\xb6\x054mH\xe0\xa8\x99\xd8\xa2[yo\xb2XrzZT\xb02\xe7\x04&_\x19\x9c\xf63\xe8\xb7\xe8\xc4\xe9\xbaL\xd9\xd6\x04\xf5\x0d\x8cTP\xd7\xd4\xbc0\xb7\x1bk\x95\x04Y\xc5\x01]E\x03\xc1\xc2Z\xda\xd6\xcdf\xd0\xccZ\x0b\x8b\xc2\x95\xdf\x9b}\x84\xba`\xafz\xdc\xb2o\xd3\xef\x97\xc9\xc2^M\x15wv<\xc9\x9a\x88\xf36\xb2}\xc5Y\xd8\x14g\xbff>\x8eI\xf5\x95.\x85\x7f\xc3\x16\xb2\xc0\xa2k\x9f\xe4\x14G\xe2\xcc\xebe\xf4e\xb3\x9d)\xfb\x95l\xbawh\xab\xf8\xceE>\xd3\xa8XNY\xcb\xffI\x94F\xaa?\xfem\xc9\xe1f\xccM\x96\xab\xbc\x830\xfa\x04\x89\xc5N\x03)\xb9\x90\"\xd1$\xc7\xd9r\x1c\xab/h\xc1\x1cr\xd3\xba\xf2,\x7f~\xe7#\xfc\xc9\x14\xe4\x97\x80\xca\x1a\xba-'4\xa0(?\x8e\x85\xd7s\xfe\xc38\xa4L\xf2o\xc9\x84\xb1V\xdd\xe1\x07Z\xaf\x801\xc2\xf9\xd2\xc7\xb4H\x92\xde&F cAFl`\xff\xa6A\xfb\xc7;h\xe5TJ\"\x09\x1c\xac\xdb\x88 \x80\xdf\xd9\xee\xe9\x86.2I\x18\xbbt\xd8x\x0e\xe0\xfa\x85i\x869\x94\xe6C\xc3T-pP\x0d\xef\x86\xda\xd1\xa7E\xe6\xd5:\xb9\xe8\xdf}\xe0yf\xd2\xbb\x0f\xb2\xe9:\xaa\xd2D\xfa{8\xf1\xb0\x01\xe0\xed\x98L\xe8\x8b.\xf5\x0c]L\x1d\xb5&\xb96\xe8\xa5\x1e\xa1`\xa7r$2\x0f\x90\x01\x08cQL\\y\xd7f\x81\x1f4\xf9\xf7\xbaL\x10\xa9\xa0\xa4\x0e\x03\xdc\xa9%R\xaa\xaa\x95E\x9b\xc1\xcc\x12\xd2\x17\xfc\x0c#N\x86h\xc9\xaav\x14\xe2#\xb0\xe5v\x8fzy;\xcdS\xb7\xe3\xc3\xedm\xcb\xd3n\xdbq\xe9\xd7\xfb:\xde\xce}f\xc3\x8b#\x16c\x8b\x82\xd3\x8c\xd8\xf1\xe26L\x9d\xa1\xf8d\xa3\xcbw\xbc\xc3\xa4\x97\x95\x97\xeb\xbd2\xc7X\xe0+\xd8\x11W\xe6\xd4\xb4\xb4\xbb\xc4\x88\xc2-\x84\x85\xcc1\xe0\xa7\x80YQ\x11)_A\xa2\x88\xc9\xe6i\x14QB\xaa\xd6\x0c\x0a\xc4\xa9\x82\xa2\x08\xaf:\x8ak\xec\xec\xb1\x97\x1fS\xd7\xb8\xad\xb8\x86'[\xe6]\xa3\x18\x153\xda\xaa\xab\xae\xd1\xba\xa8\xbe\x86\xe8\xf3\x89\xa7\xd8\x1b\xf6+\x1aE/\x94.@\xd1\x90\xbd\\\xac^\xfc\xfa\xbd\xd6y\x99\x98\xab\x11\xe0\x0b\xa2Bf\xe8{\x9b\x1f\x12\xf5\xa4V\xbb4\xc5\x82H\x94\x0b\xc27\xc8\xe9\x15t\xfe\xb4\x9a\xb4\xb6E\xc4\xb10\x11\xaf\x1f\xd6\xb7\xf9\x1e\xe1\x1f\x99\xecf\x95\xban'\xd6\x1f\xc37\xef\xec\xed\xf3?aK#\x84`\xa5\xa0h\xab\x9a!&_\xc5\x08+\xc3\x0eY\x09WP\x84x\xd2/\xb2\x9b?\xa7\xc5^\\\xc8\x0c\xc9\xbcI\xba\xcfX\xf4\x1f`\"`\x94\xaf\xc0\xe4`Q\xb9\x0eC\x13\xc872\xf08\x8a\xee|1\x06s\xc3]\xc5\xfd\\\xea\x15\xc5\x08\x0d\xb6\xd3\xe0H(\x94\x8e\xd2\x80zf\xa8\xa9\x09\xa7 \x92\x80b\xcd\xc6\xd0|\xab\xe7\xe0\xca\xe7y\xdb(m\x8d5#\xb1\x07v]\xc9FZrM\x1d\x86kY\x89\xba\x11\xc5\x15 \xdeL#\x98%\x8c\x9f\x96\x95}\xc8\x9b ]_\xbb.\x19'\xbdC]\x0d\xec\xe7\x9f\xcc\x0ff\xce|\x8aI\x9a\xad D_\xea\x00 a\xab3\x0a\x16xF0\x82\x8f\x0b\x80ZZ\xd4\xbcO\xcb\xa8\x8eZ\xf0\x09\x9d6`\xd5\xe4\xeb\xf4\xd0U,jr\x08:\x8d\xf5\xb9\x89\x93N\x01\xb4\xc3>\x05\xbd]I\x01\xeb\x12/y\xab\x12f\xc1zC\xbe\x0awJ\xe2\xefx\x0b\x18P\xd6\xf9\x01\xfe\xc0\xa1\x11k\xa1\x94\xdd\xb8S\x87\x85\xe0X)\xfb\xb2\xde\xd3S\xd5)\xc5\xf1W\x10\x0e8\x89\xec\xf4\x8b&\x06\\c8\x17\xf3\x8d\x14/bGe\xff\x99\x13\xfd>\x16s\xfe\xe3nhn\xec\xaa=\x93F0\x96^.F>\xa4\xeei\x989\x00T\xa1\x02\xce\xfb\xce\x10\x18\x17E\xaf\xce\x13\xdc\xc1\xb4&\xee\xff\xba\x82\xe9\xde\xe0\xf9\xd3\x0c\xe6$\xe3\x8e\xdfKF\xe0Li\xc7\xc9\x00\x99U\xb4\x01V\xf4\xe1(o\xa2i)T\xf6\x1bl\x19Z\x82i\x0d\x85\x1a$\xe5<\x9a?\x9cq0 v[\xd1\xa9\xfa\x09\xbbB}\x14\x8c\xe7}\xedJ5\xfa\x0b\xefz3\x90r\x9c\xfb\xd36t\xba\x05\x1bSx5\x03\xdb\xc47y\x95r\x1b\x14Xs\xa6\x03\xd7\xc7C%\xc6~:\xad\xb8\xa2\x8fWe\xff\xa1\xd4\xabt\xba\xf0\xfe^\x14\xba\xcb[ !\xf7\x94\xe7\xff\xa1\x85w[\xc0\xc9?P\x0d%\xa8l\xad\xac\x86\xb0\x8f\xaf\x8b\xfdnM/\xbc\xb1p\xb6\xfa\xe2\x19\xd9k\x1b\x09\xad\xcb\xb8\xedr\x95\x91:\xd7\xac[\xd8\xa2:@\xf1H\x09#+&J\xe7\xd0\x0a\x01\x08\xac1\x1d\xa5\xfa\x87H,\x1c\xaa\xde\x16A\xef\x15\xb1\xbfW\xc32\xb9<\x14\x0d \xf60\xd8\x93:c_\x97\x11\xda\xcc\xe7\xc6O^nh\xc7\x05\x08\xf2\x83\x9b\x03'A\xaca\x7f/\xc1n<\xb3\xe8\xbdR\x1a\\\x16\x16\xbf\xbe\xb2R\x0e\xfcx\x9e(7\xb5dwki\xa0\x03X\x91\x984\x98\x94D\xbe\xc2\x03\xb9\x98h9\xdb\xfep\xac\xe4&\x17Ui\xe6u{!\xb1\xff\xa7\xeeE2\xf3\xaa`\x11\xf9R\x1e\x0b\xa48\xe5\xdbs&g\xdc\xb5j\x03\x19\xff\xbd\xe6\x865\x89\x1bm\x96\x1e\x0d\x805\xcc\xb6\x1a\xa1+\xa9\xf2\xeb\x9a\xf1\x9e\xf7\x8b\xdc\xda\x88\xee\x06P\xdaie\xf6#]l\xa0\x87\xe7h\x91\x11>\x9by\xc3\x16[\x14\x8bl4\x9f\xfbU\xc9\x9e\xc9\x08zS\xd02C\x066?\xb1g\xf0TU\xc7\xccu\x8d\xe7\"\xdc\xf7\xae\xce\x9eu\x13MS\xcd\x9byFjB\xc3\x87\xcbC]\x04\xfe_\x99\xcd\x99\x85\x84\xaf\x97D\xf4\xde\xa0\xfc\xb4V\x0c\xe7\xa5g\x88\xb8\xa0*\x0d\x9d{\x0eM\x98o5\xe36cd\xc2\xa5Y\"\x10\x06:)\x01;'=X\x94\xdft*\x99Z\xf0d0\xba\xd2\xd1\xcdF\x0a6\xdaf\x7f\xadJ\xb0\xc8\xa2\xf2O#\xc9\xbf`M$\xfc\x04\x85V\x1c4\xee\x91t\xfaCs5)\x8b\x89!\xf9W\xab0M%\xca\x1e\xa9\x95h=\xde\xe8\xe6\x83\xb8\xdb\x1b;_k\xc7\x8e\xea:\xf5\xb9#\xabZ\xb7\x84\x99p\x9av$\xe6\xf7DK}'\x0d\xbf]\x91\xa5\x7f\x9f\xe1\x816d\xd2\xa7\x92\xdb\x8c\x0b\x0aF\xf9X\xc5\x10\xbe\x98!$\xa9\xdf\xf49\x8c\x02`n\x9e\xe5\x0f\x16!\x8e<!\xd3\xaa\xfb2\x85\xc7G\xc5\xa3\xbb\\'Z\xa1\xa0\xee.Y\x00\xfd\x13*\x07[\xaf\x9dS\x1e`H[\x9b\xb7b\x98\xb6h\xf5\xaa\xa1\xd6\xcb\x06\xde{\xe0\x0f{f6a\x19K\x99\xd7\x08*m\xa7\x831\x99\x09f0\xea\xb3K\x15S\xa6\xbe5k\xc1?\x94f\xc1\x1d\x15\x1e\xdf\xfe\xa7\xde\xa9\xff*\x01\xc3\xe5c\x1e\x94\x86\xf6\xd7A\xcb\x93\x00\xeel4\xbd\xe9\xffMP\x0dCO\xed!\xbe/TU\x07\x05\x00OK\xc4\x84rc\xc5\x95n\xf5\xa0)\x02L%\x07\xf5\x82\x02\x1egB\xd3\x09\xc7\xdc\x92'erLQ\xc4\xb0\x98:\xecO6DU\x1a4\x06l\xfdV7\x0b\x92\xf4\x95\x7f\xb7_\xabu-<\xda\xc4\xb3\xc1au\xe9\x01e$\xe0T'%>\x84;\x98\xcc\xfcm\x04Vs\x8f\xab\xba\xb1\x7f\x1d\xc3\xdf\xf6\x10\xfb\x95\xba\xa5K/8q\x93\x08\xd2\xb9\xae\xe7\xd1\xc3\xd3\xc1\xad5\x1b\xd7\x0d!|\x1b*\x0a\xcd\x8c\xef\x8e\xa6HL\xdc\xcbW\x99O\xdc|'^\"\x98\x92\x85\xbdu\xc8\"'R\xb5\xa7\xf7\xad\xb0\x80(\x06\xc5\xa4\x18h\x0f\x0d\xdd>w.\xc8\xb5j\x97G(\xe9\xa0\x0e\xf4\x1a74\x0e\xe2\xa6\xda\x96\x83H\x8a\x0dvV\x8c\xf2\xa7\xcaKD\xfb\x0b\x0e'4\x95\x1a\xfbo\xe6\xd3\x9f\xd7\xc0`\x97\xe9\xda\x98\x0b\xe4\x07[\xaaC\xba\xf2\xdc>\x96\xca\xe6{,\xec\xbc{\xa2\xc3e\xaa\xb5\xfb\xa4g\xf8u\xa0\x81\x13\xe2\x9d\x81\xd4Q\x07\xef\x9c\xf6\xf8^\x81]\xe6\x80\xdeW\x0d\xa7s\x99K\x8am\xb9\xfe\x144/j\xceA~\xb4\xe3\xa6\x10\xc8\xcd2\xafz\x87\x90\x13\xf6\xe2T\x16\x98\x11\xd6\x9d\x7f-;\x85q{\x9cD\x1cB\x18\xcc\x7f\x02\x87$\xc1T\x08\xfd\xd1`X3\x86\x1cN\x00{\x83\xef0\x09\xb3\xbd\x8c\xf8\xf7~\x87\x08i]\xcc\xdcw(\x00\x99\x18\x85\xfe\xa5\xc7T\xb3m\x1c`\x9e\xb8o7B\x89\x135\xae\xfd\xaa\x0c\xd7.\x17(O\xed\xd2Pm\x09\x9f\xde\x03\xe2&\x01\xc2_\xd7\xc1N\x07 |\x9b\x01\xad]j\xbftd7lH\xa8@\xec15`\xf4\xfd\x85b\x14\xf7=\x13t\xba\x91\xf9\xf4\x8e\x90\xeb\x19p\xe5\xda<\xf8\"\x7f\xad\xbcj\xeb\xa2D\xad\x06\xda\x9a\xe8\x85\xf1\x0f|\x15\xcf\xae\x83\xc9\xd1\xf4q\x0a\x93J\xcf\xa8\x18\xc0\xab\x12zb\xe0e\xad\x81\x83\xdf\x0a\xd3\x87\xfc#\xdf\xa4\xb9Y\x94\xab|\x00\xcc\xfd\xfc\xe7\x7f\xb9\xd7\xdf\x1eN\x7f\xcd\xb9\xcf4\xb6\xb6\xa0-\x0c\xceDa?\x0f\x7f\xb9\x87\x9dY]\xee\x18\xdd\xbf|b\xc2\xc0\xa1\x0d\xa4H\xa8js\xeb\xa4\x16\xc8\x0c\xa2\x81\xd34\xc7\x9e\xae\xeb\xf4\xbd\xf2\x8f\xcaK\xc5\xd1Ff\x0d>\x9b\xfa\xfd\xc2\x8e\xe8\x97\xdf\xcc%\xfeHP\xc1\xae\x83\xae\xc03\x84\xe8\xe6\xa6\x9d0,\x8e\xa8 \xc3\xddT\x8fy$\xb1\x80\x81\xbb\xcf\xc7\x9a\x81B\x1c\xdd!\xe6 d\xb1\xf5\x80o\xba\xd3\x9b\xa8\xfa\xd7\xc0,\x13\xee?\xbc\xc6Hs\x8df\xf95Vf-\xf2\xad2\x0b\x94B\xba\xb4\xdf\xce-2K\xb1\x05I]\xd7\xb7|\x97o\x92\xf4\xd0\xa0m\x80\x9a\xd3\x1b\x88\x9e\xed\x0b}we\xef\xa2\x7f.\xe7\x19Zk\x1f\xb0\xc3\x8f\x1cH~\x84\x1b\x8a\x81\xb4\xb8\xfbT\xa9\x17H\"\xa7\xd5\xce<]\x84+SV\x8f'\xebZ;b\x05\x8a\xe8\xad\x8f\x00\xb4\xbeb\xc4\x8e\xef\x9a\x8f1\x9c\xa5\x1a\xb0v\x06F\xb6Y\x83\x85\xfa_5e\xad\x9f;\xecu\x89\xcf?\xf9\x90\xff\x00\xac\xb4\xf9\xc1\xb2\x18\x01\x12\xad\x86/s\x890l\x17\x82Khg(>\x95\x8e\xe7j\xae4g\x82\xdf&\x17\x14'\xa9\xf0J\xd4\x9e5\xe0q\x82\xbe\xf2\xfcw\x1e\xe6\x07\xa8\xf6e\x0d+ \xeb$\xa9\x94y{U\x03\xba!\xcc\xb3RH\xa9\x9f\xd4I&\xa7\xe5=\xce\x8e+^\xa6\xf4\x10;1\xc4\xf8\x0e\x1a\x06[\xd9\x80|\x04JO\x17\x01\xbd{R\xe7H;\xbc\\\xfd.\xdd\xc5@\x0e\xec3\xda\x8b\x95_p\xa9u\x0f\xe2\x9am1d\xcb\x85Z5\x06Aa\xcb\x1a\x09q>\x05aEy\x87\x09\x7f\x18N\xcf\xce\x84\xe9\x16\xd6\xb0p\xbd\x95\x0a?\xc7b\xa0\x90<_R_\xf3\xb1X\xd8{Z\xba\xcf8\xa2\xa1\x10W.8\xcf\x89\x19\x94\xddb\x9f\x97\xe06\x0e\x12\xf2\xb2\xed\x92\x89\xc1s\x8f#\x87%[\x04\xdc$\x145=o\x16\x8f\x94t\x0f%\"\xbcG\xb1K\xa6\xf6r\x9dK0@d2\xe8\xb8\xa6\x10\xdc\xc9\x15h\xc4\xd0K\xac|\xca.\x88\xfc\x8c\x83=\xbdT\x02\x0c\x8f\xdc\x8a\x09<\xb7\xeb\x14\xe3n\xaax9_b\xab\xda\\kF\x98\x12E\xf8Nf\x09\x8f\x0e{\xd8\x88\xc8\x07`4\xad\xd3I8B\xc3}k\x0bI,?U\xb1\xe8\x9b\xd5wG\x02T\x11\x17\xae|\xa9\xad\xbe\xb5Bl\xc6#\xb5c\xdd\x02\xa6\xcaqJ\xca\xa3\xd9V\xdf\xf5gKV\x97\xe5\xf9'-~\xda\x8f\xf9\x82\xc6}k\xea\xb0K_\xea\x9a\x8d\x11\xb0!\xc0BYl\x9c\x93\x94\x1a\xb9\xa39?\xda\x15\x00\x93\xc9Y\xe3\x8e*\x9f\x06\xd7\x8f\xbc?z\x80\xb7\x7fZ\xa0\x086\xc1f\xe4\xc1j\\+\xf1'\xc2\x86\x08\x84\xe9\xb1\x0c\xba\xb5\xd7;-&M\xb9o\x0f\x8f\x8e\xa3\xb8\x80\x13\xfaW\x8e\x85\xae\xd2\x1d\x0f&\x1f\xfc\xf1\x0e\x90\x93\x92D\xff\x92)s\xc3\x16\xef`U\xbb\xd3\x0c)\x0e]![T\xf4\xf2\xad\xce1\x1d\xdf(\x125_a:G\x9c\xe1@\xa4\xa6\x09\xd4\x84\xbfQ\x88\x80\x81f\x8c~7\xffwR\xdd\xf3\xee\x7f\x16\xbd\xffd\xc8\x9e\x1e\xc4\xab\x8d\x0d\xc1$w\xd1 \xd0@\x17\x95s\xef\x12\xe48!\xbb`\xde\xc8+8 \x0a\x18\xd2\x80Y\xe5\xd8\xbb\xa2t\x09}\x9c6>g\xde|\xd0\xa0\xe6lg~'\xed\x06\xe9\xce\x85\xde\xaf\xb3\xf1\xd5$\x01{\xbc9\x0b\x93\xbb\xb3\x9fO\x03\x18\xc6s\xa7\xf2|\x80\x19=\xbae%X\x9d\xc9\xc5\x7f\xe3\xe1\x98'\xc5H!\x11\xc27`\xf3\x94#\xad\xfe\xe7\x90\xbf\xd3;,\xe3\xa2\x0a\x8f\xf3\xe1\xc8|ab[\xf6\xc9\x08\x80gY\xd1\xc5\x88\xd0\x89\xf8c1IH{A\xb3\x0fHGf<g\x9c\xc0B\x03<\xe4Y\xc2`\x0e\x9e`k?DJL\xa0\x15\x03\x96\x92N;\xe6\xab\x9d\xdd981\xdf\x91\x18a\x15\xcc>\xae\xc2pz\xa3 pxo\xeb\xde\x13\x12\xb2U\x89\x9d\xf0meM+`\xce\xc3\xfc\xc0\xd1\x0d\x94\x93\xb8`\xb5\xe7-\x19S\x1cqI^F8Wb\xe7\x98\x89J\xbfpUu\xdd\x99\x8c\x01\x13P\x98\xae\xd1Q\x95aHb\xc4\xfb\xb7\xfcyX$2$rk\x96\xbf\xd3$Hj\xcdV.\xe9\x19 \xb1x\x9b\x09\xec@\xed*\xf33r\xd8\xdc\xbe\xb1\xdf\x85\x1e\x1c\xfb\xcdW\xc28\xb8\xdf\x94\x0a\xd4[\xb8\x09\xbea\x8b\xe5\x90\xd5\xaa\xe2\xfd\xf6=\x829b\x02`$\xe4I\xd9\xf0\x09\x88\x08\xf2/\x8b;\xf7\xde%\xc0\xd5\x156\xde\xd0\xf6\x8f\xad1\x10A\x99'\xa6\xe8\xfa\xb7h\x96W\xde\xfd\x0b\xab\xc4\xd4\xbe\x7f\x17\x02>\x83\x9c\xc9\xd2X\xfb1Awh\xc4\x06\xc7d(\x9f\x0e\xa4\xab-\x8a\x17\xfd}-u\xcd{\x9d:\x9a\x04&\xac\x1fx\xdcF\x94\xfc\xb9\x97\x01\x95\x14>\xb8\xdd \xfc\x86G\xf6\x1f\xd8\x0f\xc6\xae\xeb\x17\xd3\xc6\x80\x8b\x08\xd7\"\xc5\x89-4\x1f\xf2W\xf8\xdf\xb7:\xd6x\xaf\xa0\xb9\xfb\x0a\xd4\xef2\x9e\xe4+\x80S^Y\xc3\xfe\xbc\xe0aO\xc6\xb2\xf4\xce\xad\x13\xf7\xa2\xe8\xf9w\xae*-\xd3_UH-3\xc1^\x1d\x9e8J\xdc\\\x03,\x1b\xbbh3\xa7\xd4\xb9\x82u<\xd5\"\x8b\xfd\xf4W\xd2\xd9IK`\xc3y\x9c:pq)\x8f\xf4\xdd\xd6W\xdd\xe6\xc1\x8d\xf9\x11\xe3\x02\xfb\xf5\xdaQ\x932\x10\xc3'\x02\x02\"\"\x042\xa4\xb2\x9c\x15T\x82Q\x84\xf2H\xb1=\x13\x8d`/\xf4;\x19\xd2b\xe6\x0c\xdb\x1f\xf9\xd9\xed\x12\x89o`|\xf2\xc9K\xe3\xb9Qz\xe1\xd2=\xa0y!\xe9\x87G\x93\xb2ON\x93\xac\x97\x7f!\xd1\x8f\xcc\xb1%l\xb3}\x85\x16\x86g\xfa\xd0\xbb\xfcP\xb7M\"v\x8b\x942n\x8a\xaf\xaa/Z\x0cg\xc5\xce\xf2\x1d\x0dgB\xe8Ur\xb8\x00G\x06\xd2\x17\x0bi0\x07\x068\x8b\xfcX9\x9a\xe4&\x03\x0a+?\xf8\xa3\x01#>5\xdaU\xb9Ox\xa4\x9a\x9b\xf9\x12\x9f\xd0\xf9%\xfb\x08\xa5\xf3P\x85\xdc\xbaw\xf2M\x95\x0677\x15\xc3CHc\xd5\x02h\xcfw_oV\xc7\xbb\xe4\xb6B3Mj\xff\xb2Cs\x05p\x1a\xabf:\x95\xf6\xa7\xdcB\x0e]\x1d\xb5DC\xf3X\xef \xd7\x1d\x1b\xd7\xfc#f\x13\xbb\xda\x1e8\"\xc0\x802\xa90\xa6.#\xc6\xbf" +
+	"0\x93\x99{\xcd\xae\xd4\xf4*i\xcb|\x97\x1f\x15\xa1\x89+\x05\x99\x18=?\x9e3\x17\x17t\xc9\xd8M\xd5A\x95'\xd8\x985\x86\x8f\x06\x12\xae\x003v9\x1a?\x83\xa1U\x80u\xa1\x1d*\xa0r\xd2\x1b\x17\x1b\xcc\x94\x04\xa6uxY\xa9\xc2\xc7/\x0d\xb6\x0b\xce\xf6\x81\xde\x91\xd5u|\xd3$\x91%\xb0wK\x97\xe9\x81\xcb.\xda\x12Cq\xf8\x15\x93\xd7\xca\xf3\\\x86\x12\xe4\xfc\xe2$\x06\x8aI\xfe\x86\x87\xf6\x0e&\xf7C\xa1\xc7y\xa3\xb0\xa2}\x011\x90O\xde\xbb\x0fF\xb3\xd8\x81\x19\x1a1Y\xd3\xb2Z\"\xa4\x9a\xc5\x8b\x05\xc9\xd3KUlF\x8c\x0e\x19H\xdd\xaa\xf6\x95\x96\x07\x86\xe9\x9f\x84j\x14UzF63\x0f\xe0*AP \xec\x83\xe6\xcd,\xdf\x9dk\xbb\x91\xe5\xcaW\xb0\x1bZ\x8b\xaa\x15\xb0\x97\xa1\xeaQ)i\xd8\x8e\xb7y904_\xcbS.\xe1\x03zYBX\xfe)\x83\x977\xdcl_2:\x9a\xfd(\x07\xdc\x80\x7f\x01\x1a\x09)l\xc7U\xc4\xdb\xf6t\xa9\x99\x0c\x9b\x9e\xd9;\x9c*\xaa7\x9e\x1c|0n\xa8\x96[\xf3\xbeo\xca/e\x91ov\xa9\xe3c\xc4\x93\xcd#[\xa8\xf6\x17\xf3c\xb0P+>\xc3\xb6\x97t\xbc\xd7Q\x9b\xcc\x8f\x90/\x9eMmR\x89u\xe8\"4\x06!\x85\x1a\xac\xc9/\x06S\xa5\x99\xfb\xa7\xdf6\x96\xf8Y]]\x00\xcd\x9b\x17\xc0\xdd\xe2\xae\x18\xe6\xaf\xe9\xb0\x0e\xfdc\xb8\x17\x9e\xeb\x88A\x86o\xab\x86\xdf0\xe8\x14?\xfa\xf7pwy.\xa6\x84=\x95*+\xf0\x16\x81B\xb0M\x94\x0d\xdaB\xa3\"\xd4Y)\xe6\xfc\x02Lb\x05d0\x93\x17\xb8,\xdfK'\x86\xb2\xb8\x82\xe2>\\J\xa8@n\xa0o\x94*\xeaw>\xa39\"\x07\x9f)v\xe7\x91+\xe2\xc6<`\xe1,\xe8\x91\xa2\x82\"\xba\xf3\xa4F\xcd&}\x80NIfa\x0b>\x98\x06\xe9\xe3f\x0b\xdd\xaak\xe1<s\x8d8\xc11s\xc22Y\x88\xaa0\x8f gC`;\xd9\xb1\xe0?\xe74\xda\x83\xe6\xc3\xeeH\x01a\x0da\xf8g\xe8\xcd\xfa\"\x1c\xacy}\xfaN\x07g;\"\"\xec\x16\xf4O^p3.\x87ke\x8e\x96\xf9\x87\xedU\x99'\xd0\xaa\x17c\xd9z\x8f\xd3\x15e\xa2\x1fD\x99\x92\xda\x16(\x1c?P\xc5oh\xe5?\x84\x0e\x08j\x80\x83\xde0\x94\x9b\xf3o\xb8\xa6L\xbfeo\xfem\x9e|\xaaJ\x8f(;S}\xfbC\xd0\xcb\x87\x8b8U\xcaq1\xd0-ZB\xc9\x16\xbd|wJ\xce\x14x\x85\x0c4\xbc\x07\x88\x95FkO;\xafog;m\xbc\xdb\xe2\xe2\x03\x99\x19\x7f\xc04\x13\xc0\x81\x7f\x1d\x9ax\xbe\x13/\xae\xab\x82\xe3p\xf0W\xd0\xae\xa6\x8aQ#\x8e\xb2\xb7\xc8\xbe\xac>R\xf6\x11p\xa4G'K\xcb\x013\xce\xd5\xc3>\x18FM\xfe/b\xe2l\x93\x0e\xd5\x9a\x91 \xaf\xa1q\xfa\x18\xa79\x15J\x90\xa8\x07@/\xc1\x9b\x9f\xad\x98\x1exJ{\x06Y[S\xdat\x1dl\xa5\xb0m\xbbL\xc7\xe2t\xde\x7f\xd8\xf1~\x9e\x9e\xed\xa5_3\xf7cK\xac\xf0#\xd06E\x85\xe8T(fK[\xfd\x11\xa2w! w\x1f\xe9\x1e\xc1~\x89<\xf7Js-\xec\x8a\x99o;5\xe2\xb5\xef\xa4\x06\x14\x8a\xd3\x1c\xc7\xf4v\xf3c0d\xc5\xe7\"`aE\xffT\x1b\xfc\x04*\x92\xc4(\x9c\x84^\x9f\x9b\xae\xd9\xc4\xe4\x09]\x1a\xce\xf6}\xd0\x8a\xf1\xfb#L\xd3wDN\xf4\x0be>\x08\xa9\xe6\x1b\xfc\xb9\xc1\xe6:\xf0\xc5XZZ\xae\x08\xb4\xaa\xea\xffI\xe3\x87\xfe\xe9\xec\xc8w\x9a\x0d\x0dJ\xa5\x8b\x86/!+)\xff\xc2-\x94\xd6\xcdY^\xd0@.'8\xa8\x1a\x0c_&\x0f\xb0\x1a\x14\xb2\x814Zq_<\x14\xdah\xbc\x06\xcft\xd8\xd5\xf4Tp\xba\x0ee\x04t\x0dr:\x82\x9f\x1b<\x8a\x15\xe5\xael\x81w~\xd3\xb3\x88\x9a\x19\x09f8d\x18\xfd\x08\xee\x03p-\xdb`G/_\xcfM\xd1\xcf>\x11/u\xbb\x0d\xd3wC\\\xdc\x88\xcaL\xb3\xa8\xcc\x94k\x86\xacmfzF4K4\xb0p\xed\x9bi\x83\xb0\xf9/B\xb5\x1ds\x0e\x9a\xe2\xe0V!q\xb5O\x9c?\xd7\xe8\x94s;\xde\x05\xa6\x07(\x96\x15\xd2\xc0C\x99\x1dp\xb5\x88z\xab2\xe2\xca\xda\xe9e\xe5+\x9d\xc1p\xb3\xd9\xf4\xa2\xb8}Zu9_\xc3\x0dC\x87e\x02\x092eQ\x04\xd1\xbf3\xff\xf9@\xf53\xfbW\xe7mB\x0aI\x82Ji\x1fV\x8f)\xfa\xf4\x1e5\x04s\xb4v-s'D\x8f\x97\xa9\x98&\xb5\x82\xe2\x90Hx\xa8R>k\xc0\x92\xc2Z\xa7Kp\xe0\x90=\xd87\xa3J6\xa1Hg{\xfe\x19w\xda_a|\x88\xf9\x05\\r\xef\xa9=W,8\xd9y\xfa3\x7f)\x06\xa7\x92\xaa\xfe\x94\x15G=\xc3\x12\x0a\xed\xe4\xb3\x959\xa5\x9d\x16$\x91\xb6\x904Sr:\x08D`a\x96t\x0a\xd0\xbf \xf4t[,tk&\xc2.\xe0\x0a\xd1\xe6'\xa6q\xecj!\xbe\x0au\xc5\x1bm{\x15/\x05\xc5\x96\xde&\x1c\x99\xa7\x1c\xf5;fy\xb4\x1f2\xfe\x05\x90T\xb3\xb3\xed\x1d&\x99F|\x99C\xc6\x0b\xba\xaf\x12\xc6\xe0\x0d\x05JG\xfb\";\xb1p\x85L\xe0\xd8iC\xab)\x02\xfe\xe8\xdc\xbb`\x8e\x86$\x8eR\xce\x0a\xd6V \xb1Zn\xbe\x93\xb8\xda\x06\x9f%\xdf\xcag\xc97\xb4Mh\xd2\xa5\xe6\xa5\x17\xbe,\xf3\xfd\xcf\\T.$8\xdf\x92\xc2\x0aB\xec\x96\x94\xed1wc\xaa?\xbf,_\xd0\xccqkOQ\xce^\xe2\xc24\x97\xe8T(\x96\xb1\xd13\x8b\xa5\x81\xf5\xf7\xce\xb1X\xfa\xc7\xb9\x92\x8f\xc3\x9b\xcd\xce\x8a\x83\x05\xa9\xb11q\x09\xfe\xbf\xff\xfb_\xff\xf3\xdf\xff\xf1\x9f\xff\xff\xff\xfc\xef\x00\xc5\x8b\xf3\x9dE\x1d\x17\x00"
@@ -0,0 +1,22 @@
+// Package quransimpleenhanced provides Alquran text (Simple Enhanced) from http://tanzil.net, stored gzip-compressed.
+// Original file name: "quran-simple-enhanced.xml".
+//
+// Run "go run gen.go quransimpleenhanced quran-simple-enhanced.xml" in corpus directory to regenerate it.
+package quransimpleenhanced
+
+import (
+	"sync"
+
+	"github.com/alpancs/quranize/corpus/internal/gzipped"
+)
+
+var (
+	once sync.Once
+	xml  string
+)
+
+// XML returns the corpus in Tanzil XML format, decompressed on first call.
+func XML() string {
+	once.Do(func() { xml = gzipped.Decompress(compressed) })
+	return xml
+}
@@ -0,0 +1,14 @@
+package quransimpleenhanced
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXML(t *testing.T) {
+	assert.True(t, strings.HasPrefix(XML(), "\n<?xml"))
+	assert.True(t, strings.HasSuffix(strings.TrimSpace(XML()), "</quran>"))
+	assert.Len(t, XML(), 1514821)
+}
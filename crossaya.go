@@ -0,0 +1,130 @@
+package quranize
+
+import (
+	"strings"
+)
+
+// WithCrossAya returns Option to also index phrases of up to maxWords words continuing from an aya into the next ayas
+// of the sura, so Encode and Locate find phrases remembered across aya boundaries,
+// e.g. "الرحمن الرحيم الحمد لله" is located at {1 1 2}. LocateRanges returns where they end.
+func WithCrossAya(maxWords int) Option {
+	return func(q *Quranize) {
+		q.crossAya = maxWords
+	}
+}
+
+// WithCrossSura returns Option to let phrases indexed WithCrossAya continue from the last aya of a sura into the next sura,
+// indexing the whole mushaf as continuous text.
+func WithCrossSura() Option {
+	return func(q *Quranize) {
+		q.crossSura = true
+	}
+}
+
+// LocateRanges is like Locate, but returns ranges from the first word to the last word of every location of s,
+// the last word is in a following aya if s crosses aya boundaries (see WithCrossAya).
+func (q Quranize) LocateRanges(s string) []Range {
+	ranges := []Range{}
+	words := strings.Count(s, " ")
+	for _, l := range q.Locate(s) {
+		ranges = append(ranges, Range{l, q.advance(l, words)})
+	}
+	return ranges
+}
+
+// advance returns location of the word following l by n words in continuous text of index.
+func (q Quranize) advance(l Location, n int) Location {
+	sura, aya, word := l.GetSura(), l.GetAya(), l.GetWordIndex()
+	for {
+		words := q.ayaWords(sura, aya)
+		if word+n < words {
+			return NewLocation(sura, aya, word+n)
+		}
+		nextSura, nextAya, ok := q.nextAya(sura, aya)
+		if !ok {
+			return NewLocation(sura, aya, words-1)
+		}
+		n -= words - word
+		sura, aya, word = nextSura, nextAya, 0
+	}
+}
+
+// nextAya returns sura and aya numbers following aya number in sura number in continuous text of index,
+// or false if the text does not continue.
+func (q Quranize) nextAya(sura, aya int) (int, int, bool) {
+	if q.crossAya <= 1 || sura < 1 || sura > len(q.q.Suras) {
+		return 0, 0, false
+	}
+	if aya < len(q.q.Suras[sura-1].Ayas) {
+		return sura, aya + 1, true
+	}
+	if !q.crossSura || sura == len(q.q.Suras) {
+		return 0, 0, false
+	}
+	if q.bismillah && q.q.text(sura+1, 0) != "" {
+		return sura + 1, 0, true
+	}
+	return sura + 1, 1, true
+}
+
+// following returns harfs and harakat marks of up to n words following aya number in sura number,
+// each word preceded by a space.
+func (q Quranize) following(sura, aya, n int) ([]rune, []uint8) {
+	harfs, marks := []rune{}, []uint8{}
+	for ok := true; n > 0; {
+		if sura, aya, ok = q.nextAya(sura, aya); !ok {
+			break
+		}
+		ayaHarfs := []rune(q.q.text(sura, aya))
+		ayaMarks := q.ayaMarks(ayaHarfs, sura, aya)
+		for start := 0; start < len(ayaHarfs) && n > 0; n-- {
+			end := start
+			for end < len(ayaHarfs) && ayaHarfs[end] != ' ' {
+				end++
+			}
+			harfs = append(append(harfs, ' '), ayaHarfs[start:end]...)
+			marks = append(append(marks, 0), ayaMarks[start:end]...)
+			start = end + 1
+		}
+	}
+	return harfs, marks
+}
+
+// indexCrossing indexes phrases of up to maxWords words starting from the last words of aya number in sura number
+// and continuing into following ayas, locating them at their first words.
+func (n *node) indexCrossing(q Quranize, harfs []rune, marks []uint8, sura, aya int) {
+	words := strings.Count(string(harfs), " ") + 1
+	tail, tailMarks := q.following(sura, aya, q.crossAya-1)
+	if len(tail) == 0 {
+		return
+	}
+	stream := append(append([]rune{}, harfs...), tail...)
+	streamMarks := append(append([]uint8{}, marks...), tailMarks...)
+
+	ends := []int{}
+	for i, harf := range tail {
+		if i > 0 && harf == ' ' {
+			ends = append(ends, len(harfs)+i)
+		}
+	}
+	ends = append(ends, len(stream))
+
+	wordIndex := 0
+	for i := range harfs {
+		if i > 0 && harfs[i-1] != ' ' {
+			continue
+		}
+		if remaining := words - wordIndex; remaining < q.crossAya {
+			end := ends[minInt(q.crossAya-remaining, len(ends))-1]
+			n.extendTree(stream[i:end], streamMarks[i:end], NewLocation(sura, aya, wordIndex), len(harfs)-i)
+		}
+		wordIndex++
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
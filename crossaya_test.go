@@ -0,0 +1,61 @@
+package quranize
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var crossAyaTest = NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), WithCrossAya(4))
+
+func TestLocateCrossAya(t *testing.T) {
+	expected := []Range{{NewLocation(1, 1, 2), NewLocation(1, 2, 1)}}
+	assert.Equal(t, expected, crossAyaTest.LocateRanges("الرحمن الرحيم الحمد لله"))
+	assert.Equal(t, zeroLocs, quranizeTest.Locate("الرحمن الرحيم الحمد لله"))
+
+	// at most 4 words, and not across suras
+	assert.Equal(t, zeroLocs, crossAyaTest.Locate("الرحمن الرحيم الحمد لله رب"))
+	assert.Equal(t, zeroLocs, crossAyaTest.Locate("ولا الضالين الم"))
+
+	// within an aya, locations do not change
+	assert.Equal(t, quranizeTest.Locate("بسم الله"), crossAyaTest.Locate("بسم الله"))
+	assert.Equal(t, []Range{{NewLocation(1, 2, 0), NewLocation(1, 2, 3)}}, crossAyaTest.LocateRanges("الحمد لله رب العالمين")[:1])
+}
+
+func TestLocateCrossSura(t *testing.T) {
+	q := NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), WithCrossAya(3), WithCrossSura())
+	assert.Equal(t, []Range{{NewLocation(1, 7, 7), NewLocation(2, 1, 0)}}, q.LocateRanges("ولا الضالين الم"))
+
+	q = NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), WithCrossAya(3), WithCrossSura(), WithBismillah())
+	assert.Equal(t, []Range{{NewLocation(1, 7, 8), NewLocation(2, 0, 1)}}, q.LocateRanges("الضالين بسم الله"))
+	assert.Equal(t, zeroLocs, q.Locate("ولا الضالين الم"))
+}
+
+func TestEncodeCrossAya(t *testing.T) {
+	assert.Contains(t, crossAyaTest.Encode("arrohmanirrohim alhamdulillah"), "الرحمن الرحيم الحمد لله")
+	assert.NotContains(t, quranizeTest.Encode("arrohmanirrohim alhamdulillah"), "الرحمن الرحيم الحمد لله")
+
+	q := NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), WithCrossAya(4), WithHarakat(NewQuranSimpleEnhanced()))
+	assert.Contains(t, q.Encode("arrohmanirrohim alhamdulillah"), "الرحمن الرحيم الحمد لله")
+	assert.Contains(t, crossAyaTest.Encode("arrohmanirrohim alhumdulillah"), "الرحمن الرحيم الحمد لله")
+	assert.NotContains(t, q.Encode("arrohmanirrohim alhumdulillah"), "الرحمن الرحيم الحمد لله")
+}
+
+func TestSearchCrossAya(t *testing.T) {
+	results := crossAyaTest.Search("arrohmanirrohim alhamdulillah", SearchOptions{Limit: 1})
+	assert.Equal(t, 1, results[0].Aya)
+	assert.Equal(t, 2, results[0].WordStart)
+	assert.Equal(t, 3, results[0].WordEnd)
+	assert.Equal(t, NewLocation(1, 2, 1), results[0].End)
+}
+
+func TestWriteIndexCrossAya(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, crossAyaTest.WriteIndex(buffer))
+	_, err := LoadQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), bytes.NewReader(buffer.Bytes()))
+	assert.Equal(t, ErrStaleIndex, err)
+	q, err := LoadQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), bytes.NewReader(buffer.Bytes()), WithCrossAya(4))
+	assert.NoError(t, err)
+	assert.Equal(t, crossAyaTest.LocateRanges("الرحمن الرحيم الحمد لله"), q.LocateRanges("الرحمن الرحيم الحمد لله"))
+}
@@ -0,0 +1,237 @@
+package quranize
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Edition is a Quran text or translation along with information from its header comment.
+type Edition struct {
+	Info  EditionInfo
+	Quran Quran
+}
+
+// EditionInfo is information of an edition, as written in header comment of Tanzil files, e.g.
+//  #  Name: Bahasa Indonesia
+//  #  Translator: Indonesian Ministry of Religious Affairs
+//  #  Language: Indonesian
+//  #  ID: id.indonesian
+//  #  Last Update: June 4, 2010
+//  #  Source: Tanzil.net
+type EditionInfo struct {
+	ID         string
+	Name       string
+	Translator string
+	Language   string
+	LastUpdate string
+	Source     string
+}
+
+// ErrUnknownEdition is returned by LookupEdition if no edition is registered with the ID.
+var ErrUnknownEdition = errors.New("unknown edition")
+
+var headerPattern = regexp.MustCompile(`^#\s*(Name|Translator|Language|ID|Last Update|Source):\s*(.*?)\s*$`)
+
+// ReadXML returns Edition from r in Tanzil XML format (e.g. "id.indonesian.xml").
+func ReadXML(r io.Reader) (Edition, error) {
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return Edition{}, err
+	}
+	q, err := ParseQuran(string(raw))
+	if err != nil {
+		return Edition{}, err
+	}
+
+	header := ""
+	if start := strings.Index(string(raw), "<!--"); start >= 0 {
+		if end := strings.Index(string(raw[start:]), "-->"); end >= 0 {
+			header = string(raw[start : start+end])
+		}
+	}
+	return Edition{parseHeader(strings.Split(header, "\n")), q}, nil
+}
+
+// ReadText returns Edition from r in Tanzil text format (e.g. "id.indonesian.txt"):
+// a "sura|aya|text" line for every aya, and header comment lines starting with "#".
+// Sura names are empty, and sura and aya numbers must be contiguous starting from 1.
+func ReadText(r io.Reader) (Edition, error) {
+	q, header := Quran{}, []string{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimPrefix(strings.TrimRight(scanner.Text(), "\r"), "\ufeff")
+		if strings.HasPrefix(line, "#") {
+			header = append(header, line)
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, "|", 3)
+		if len(fields) != 3 {
+			return Edition{}, fmt.Errorf("invalid line %d: expected sura|aya|text", n)
+		}
+		sura, suraErr := strconv.Atoi(fields[0])
+		aya, ayaErr := strconv.Atoi(fields[1])
+		if suraErr != nil || ayaErr != nil {
+			return Edition{}, fmt.Errorf("invalid line %d: invalid sura or aya number", n)
+		}
+		if sura == len(q.Suras)+1 {
+			q.Suras = append(q.Suras, Sura{Index: sura})
+		}
+		if sura != len(q.Suras) || aya != len(q.Suras[sura-1].Ayas)+1 {
+			return Edition{}, fmt.Errorf("invalid line %d: sura %d aya %d is out of order", n, sura, aya)
+		}
+		q.Suras[sura-1].Ayas = append(q.Suras[sura-1].Ayas, Aya{Index: aya, Text: fields[2]})
+	}
+	if err := scanner.Err(); err != nil {
+		return Edition{}, err
+	}
+	return Edition{parseHeader(header), q}, nil
+}
+
+// LoadEditionFile returns Edition from file path, read by ReadXML if its extension is ".xml", or ReadText otherwise.
+func LoadEditionFile(path string) (Edition, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Edition{}, err
+	}
+	defer f.Close()
+	if strings.EqualFold(filepath.Ext(path), ".xml") {
+		return ReadXML(f)
+	}
+	return ReadText(f)
+}
+
+func parseHeader(lines []string) EditionInfo {
+	info := EditionInfo{}
+	for _, line := range lines {
+		m := headerPattern.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		switch m[1] {
+		case "Name":
+			info.Name = m[2]
+		case "Translator":
+			info.Translator = m[2]
+		case "Language":
+			info.Language = m[2]
+		case "ID":
+			info.ID = m[2]
+		case "Last Update":
+			info.LastUpdate = m[2]
+		case "Source":
+			info.Source = m[2]
+		}
+	}
+	return info
+}
+
+// registry stores editions by ID, loading built-in editions on first lookup.
+var registry = struct {
+	sync.Mutex
+	editions map[string]*registryEntry
+}{editions: make(map[string]*registryEntry)}
+
+type registryEntry struct {
+	once    sync.Once
+	load    func() (Edition, error)
+	edition Edition
+	err     error
+}
+
+// RegisterArabicEditions registers built-in arabic editions "quran-simple-clean" and "quran-simple-enhanced",
+// loaded on first lookup.
+// They are not registered by default, so binaries not using them do not contain them.
+func RegisterArabicEditions() {
+	registerBuiltins(map[string]builtinEdition{
+		"quran-simple-clean":    {EditionInfo{Name: "Simple Clean", Language: "Arabic", Source: "Tanzil.net"}, LoadQuranSimpleClean},
+		"quran-simple-enhanced": {EditionInfo{Name: "Simple Enhanced", Language: "Arabic", Source: "Tanzil.net"}, LoadQuranSimpleEnhanced},
+	})
+}
+
+// RegisterTranslations registers built-in translations "id.indonesian" and "id.muntakhab", loaded on first lookup.
+// They are not registered by default, so binaries not calling it do not contain them.
+func RegisterTranslations() {
+	registerBuiltins(map[string]builtinEdition{
+		"id.indonesian": {EditionInfo{Name: "Bahasa Indonesia", Translator: "Indonesian Ministry of Religious Affairs", Language: "Indonesian", LastUpdate: "June 4, 2010", Source: "Tanzil.net"}, LoadIDIndonesian},
+		"id.muntakhab":  {EditionInfo{Name: "Quraish Shihab", Translator: "Muhammad Quraish Shihab et al.", Language: "Indonesian", LastUpdate: "April 28, 2011", Source: "Tanzil.net"}, LoadIDMuntakhab},
+	})
+}
+
+type builtinEdition struct {
+	info EditionInfo
+	load func() (Quran, error)
+}
+
+func registerBuiltins(builtins map[string]builtinEdition) {
+	registry.Lock()
+	defer registry.Unlock()
+	for id, builtin := range builtins {
+		if _, ok := registry.editions[id]; ok {
+			continue
+		}
+		id, builtin := id, builtin
+		builtin.info.ID = id
+		registry.editions[id] = &registryEntry{load: func() (Edition, error) {
+			q, err := builtin.load()
+			return Edition{builtin.info, q}, err
+		}}
+	}
+}
+
+// RegisterEdition registers edition e by its ID, replacing registered edition with the same ID.
+//
+// Built-in editions are registered by RegisterArabicEditions and RegisterTranslations.
+func RegisterEdition(e Edition) error {
+	if e.Info.ID == "" {
+		return errors.New("edition ID is required")
+	}
+	entry := &registryEntry{edition: e}
+	entry.once.Do(func() {})
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.editions[e.Info.ID] = entry
+	return nil
+}
+
+// LookupEdition returns registered edition by ID, or ErrUnknownEdition.
+func LookupEdition(id string) (Edition, error) {
+	registry.Lock()
+	entry, ok := registry.editions[id]
+	registry.Unlock()
+	if !ok {
+		return Edition{}, ErrUnknownEdition
+	}
+
+	entry.once.Do(func() {
+		entry.edition, entry.err = entry.load()
+	})
+	return entry.edition, entry.err
+}
+
+// EditionIDs returns sorted IDs of registered editions.
+func EditionIDs() []string {
+	registry.Lock()
+	defer registry.Unlock()
+	ids := []string{}
+	for id := range registry.editions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
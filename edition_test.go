@@ -0,0 +1,109 @@
+package quranize
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alpancs/quranize/corpus/idindonesian"
+	"github.com/stretchr/testify/assert"
+)
+
+const textEditionTest = "\ufeff1|1|In the name of Allah, the Entirely Merciful, the Especially Merciful.\n" +
+	"1|2|[All] praise is [due] to Allah, Lord of the worlds -\n" +
+	"2|1|Alif, Lam, Meem.\n" +
+	"\n" +
+	"#  Name: Saheeh International\n" +
+	"#  Translator: Saheeh International\n" +
+	"#  Language: English\n" +
+	"#  ID: en.sahih\n" +
+	"#  Last Update: August 14, 2010\n" +
+	"#  Source: Tanzil.net\n"
+
+func TestReadXML(t *testing.T) {
+	e, err := ReadXML(strings.NewReader(idindonesian.XML()))
+	assert.NoError(t, err)
+	expected := EditionInfo{"id.indonesian", "Bahasa Indonesia", "Indonesian Ministry of Religious Affairs", "Indonesian", "June 4, 2010", "Tanzil.net"}
+	assert.Equal(t, expected, e.Info)
+	assert.NoError(t, e.Quran.Validate())
+
+	_, err = ReadXML(strings.NewReader("<quran"))
+	assert.Error(t, err)
+}
+
+func TestReadText(t *testing.T) {
+	e, err := ReadText(strings.NewReader(textEditionTest))
+	assert.NoError(t, err)
+	assert.Equal(t, EditionInfo{"en.sahih", "Saheeh International", "Saheeh International", "English", "August 14, 2010", "Tanzil.net"}, e.Info)
+	assert.Len(t, e.Quran.Suras, 2)
+	text, err := e.Quran.GetAya(1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "[All] praise is [due] to Allah, Lord of the worlds -", text)
+	assert.Equal(t, 2, e.Quran.Suras[1].Index)
+}
+
+func TestReadTextInvalid(t *testing.T) {
+	for _, raw := range []string{"1|1", "x|1|text", "1|2|text", "1|1|a\n3|1|b", "1|1|a\n1|1|b"} {
+		_, err := ReadText(strings.NewReader(raw))
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestLoadEditionFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "quranize")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	txt, xml := filepath.Join(dir, "en.sahih.txt"), filepath.Join(dir, "id.indonesian.XML")
+	assert.NoError(t, ioutil.WriteFile(txt, []byte(textEditionTest), 0644))
+	assert.NoError(t, ioutil.WriteFile(xml, []byte(idindonesian.XML()), 0644))
+
+	e, err := LoadEditionFile(txt)
+	assert.NoError(t, err)
+	assert.Equal(t, "en.sahih", e.Info.ID)
+	e, err = LoadEditionFile(xml)
+	assert.NoError(t, err)
+	assert.Equal(t, "id.indonesian", e.Info.ID)
+
+	_, err = LoadEditionFile(filepath.Join(dir, "missing.txt"))
+	assert.Error(t, err)
+}
+
+func TestEditionRegistry(t *testing.T) {
+	assert.Equal(t, []string{}, EditionIDs())
+	_, err := LookupEdition("quran-simple-clean")
+	assert.Equal(t, ErrUnknownEdition, err)
+
+	RegisterArabicEditions()
+	assert.Equal(t, []string{"quran-simple-clean", "quran-simple-enhanced"}, EditionIDs())
+	e, err := LookupEdition("quran-simple-clean")
+	assert.NoError(t, err)
+	assert.Equal(t, "Simple Clean", e.Info.Name)
+	_, err = LookupEdition("id.indonesian")
+	assert.Equal(t, ErrUnknownEdition, err)
+
+	RegisterTranslations()
+	e, err = LookupEdition("id.indonesian")
+	assert.NoError(t, err)
+	assert.Equal(t, "Bahasa Indonesia", e.Info.Name)
+	assert.Len(t, e.Quran.Suras, 114)
+
+	_, err = LookupEdition("en.sahih")
+	assert.Equal(t, ErrUnknownEdition, err)
+
+	e, _ = ReadText(strings.NewReader(textEditionTest))
+	assert.NoError(t, RegisterEdition(e))
+	defer func() {
+		registry.Lock()
+		delete(registry.editions, "en.sahih")
+		registry.Unlock()
+	}()
+	actual, err := LookupEdition("en.sahih")
+	assert.NoError(t, err)
+	assert.Equal(t, e, actual)
+	assert.Equal(t, []string{"en.sahih", "id.indonesian", "id.muntakhab", "quran-simple-clean", "quran-simple-enhanced"}, EditionIDs())
+
+	assert.Error(t, RegisterEdition(Edition{}))
+}
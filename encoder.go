@@ -0,0 +1,147 @@
+package quranize
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// workPerContextCheck is the amount of work done between checks of context cancellation.
+const workPerContextCheck = 1024
+
+// EncodeOptions limits work done by EncodeContext. Zero value of each field means no limit.
+type EncodeOptions struct {
+	MaxCandidates  int // maximum number of results
+	MaxInputLength int // maximum length of input, spaces excluded
+	MaxWork        int // maximum number of combinations checked by dynamic programming
+}
+
+// LimitError is returned by EncodeContext when a limit of EncodeOptions is exceeded.
+type LimitError struct {
+	Limit string // name of the exceeded EncodeOptions field
+	Value int    // value of the exceeded limit
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("quranize: %s %d exceeded", e.Limit, e.Value)
+}
+
+// encoder holds state of dynamic programming in a single encoding.
+type encoder struct {
+	q       Quranize
+	memo    map[string][]encoding
+	ctx     context.Context
+	maxWork int
+	work    int
+	err     error
+}
+
+func newEncoder(q Quranize) *encoder {
+	return &encoder{q: q, memo: make(map[string][]encoding), ctx: context.Background()}
+}
+
+// EncodeContext returns arabic encodings of given string using Transliteration t, sorted best-first,
+// honoring cancellation and deadline of ctx and limits of opts.
+//
+// It returns ctx.Err() if ctx is done, or *LimitError if a limit is exceeded.
+// When MaxCandidates is exceeded, the best MaxCandidates results are returned along with the error.
+func (q Quranize) EncodeContext(ctx context.Context, s string, opts EncodeOptions) ([]EncodeResult, error) {
+	s = normalizeInput(s)
+	if opts.MaxInputLength > 0 && len(s) > opts.MaxInputLength {
+		return nil, &LimitError{"MaxInputLength", opts.MaxInputLength}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e := newEncoder(q)
+	e.ctx, e.maxWork = ctx, opts.MaxWork
+	results := q.encode(e, s)
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	sortResults(results)
+	if opts.MaxCandidates > 0 && len(results) > opts.MaxCandidates {
+		return results[:opts.MaxCandidates], &LimitError{"MaxCandidates", opts.MaxCandidates}
+	}
+	return results, nil
+}
+
+// spend records work done, returning false if encoding must stop.
+func (e *encoder) spend(work int) bool {
+	if e.err != nil {
+		return false
+	}
+	before := e.work
+	e.work += work
+	if e.maxWork > 0 && e.work > e.maxWork {
+		e.err = &LimitError{"MaxWork", e.maxWork}
+		return false
+	}
+	if e.work/workPerContextCheck != before/workPerContextCheck {
+		e.err = e.ctx.Err()
+	}
+	return e.err == nil
+}
+
+// Encoder encodes input typed incrementally, e.g. keystroke by keystroke in a search box,
+// or partial hypotheses of speech recognition.
+//
+// Dynamic programming states (encodings of every input prefix along with their index nodes) are kept between inputs,
+// so encoding input sharing a prefix with previous input only computes states of the new characters.
+// Encoder is not safe for concurrent use.
+type Encoder struct {
+	e     *encoder
+	input []rune
+}
+
+// NewEncoder returns Encoder of Quranize q with empty input.
+func (q Quranize) NewEncoder() *Encoder {
+	return &Encoder{e: newEncoder(q)}
+}
+
+// Append appends s to input.
+func (enc *Encoder) Append(s string) {
+	enc.input = append(enc.input, []rune(s)...)
+}
+
+// Delete deletes the last n characters of input.
+func (enc *Encoder) Delete(n int) {
+	if n > len(enc.input) {
+		n = len(enc.input)
+	}
+	if n > 0 {
+		enc.input = enc.input[:len(enc.input)-n]
+	}
+}
+
+// Set replaces input with s.
+func (enc *Encoder) Set(s string) {
+	enc.input = []rune(s)
+}
+
+// Input returns current input.
+func (enc *Encoder) Input() string {
+	return string(enc.input)
+}
+
+// Results returns arabic encodings of current input sorted best-first, like EncodeRanked.
+func (enc *Encoder) Results() []EncodeResult {
+	s := normalizeInput(string(enc.input))
+	enc.prune(s)
+	results := enc.e.q.encode(enc.e, s)
+	sortResults(results)
+	return results
+}
+
+// prune forgets states of strings that are prefixes of neither s nor its variants,
+// keeping memory bounded by length of input.
+func (enc *Encoder) prune(s string) {
+	consecutive := removeConsecutiveChars(s)
+	for key := range enc.e.memo {
+		if !strings.HasPrefix(s, key) && !strings.HasPrefix(consecutive, key) {
+			delete(enc.e.memo, key)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package quranize
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeContextAlquran(t *testing.T) {
+	actual, err := quranizeTest.EncodeContext(context.Background(), "bismillah", EncodeOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, quranizeTest.EncodeRanked("bismillah"), actual)
+}
+
+func TestEncodeContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	actual, err := quranizeTest.EncodeContext(ctx, "bismillah", EncodeOptions{})
+	assert.Nil(t, actual)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestEncodeContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	time.Sleep(time.Millisecond)
+	_, err := quranizeTest.EncodeContext(ctx, "wa'tasimu bihablillahi jami'aw wala tafarraqu", EncodeOptions{})
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestEncodeContextMaxInputLength(t *testing.T) {
+	actual, err := quranizeTest.EncodeContext(context.Background(), "bismil lah", EncodeOptions{MaxInputLength: 8})
+	assert.Nil(t, actual)
+	assert.Equal(t, &LimitError{"MaxInputLength", 8}, err)
+
+	_, err = quranizeTest.EncodeContext(context.Background(), "bismil lah", EncodeOptions{MaxInputLength: 9})
+	assert.NoError(t, err)
+}
+
+func TestEncodeContextMaxCandidates(t *testing.T) {
+	expected := quranizeTest.EncodeRanked("bismillah")[:1]
+	actual, err := quranizeTest.EncodeContext(context.Background(), "bismillah", EncodeOptions{MaxCandidates: 1})
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, &LimitError{"MaxCandidates", 1}, err)
+}
+
+func TestEncodeContextMaxWork(t *testing.T) {
+	actual, err := quranizeTest.EncodeContext(context.Background(), "bismillah", EncodeOptions{MaxWork: 10})
+	assert.Nil(t, actual)
+	assert.Equal(t, &LimitError{"MaxWork", 10}, err)
+	assert.EqualError(t, err, "quranize: MaxWork 10 exceeded")
+}
+
+func TestEncoder(t *testing.T) {
+	enc := quranizeTest.NewEncoder()
+	assert.Equal(t, []EncodeResult{}, enc.Results())
+	for _, c := range "alhamdu lillah" {
+		enc.Append(string(c))
+		assert.Equal(t, quranizeTest.EncodeRanked(enc.Input()), enc.Results(), enc.Input())
+	}
+
+	enc.Delete(6)
+	assert.Equal(t, "alhamdu ", enc.Input())
+	assert.Equal(t, quranizeTest.EncodeRanked("alhamdu"), enc.Results())
+	for key := range enc.e.memo {
+		assert.True(t, len(key) <= len("alhamdu"), key)
+	}
+
+	enc.Set("bismillah")
+	assert.Equal(t, quranizeTest.EncodeRanked("bismillah"), enc.Results())
+	enc.Delete(100)
+	assert.Equal(t, "", enc.Input())
+	assert.Equal(t, []EncodeResult{}, enc.Results())
+}
+
+func TestEncoderReusesStates(t *testing.T) {
+	enc := quranizeTest.NewEncoder()
+	enc.Set("wa'tasimu bihablillahi jami'a")
+	enc.Results()
+	before := enc.e.work
+	enc.Append("w")
+	enc.Results()
+	appended := enc.e.work - before
+
+	e := newEncoder(quranizeTest)
+	quranizeTest.encode(e, normalizeInput(enc.Input()))
+	assert.True(t, appended < e.work/2, "%d work appending, %d work from scratch", appended, e.work)
+}
+
+func BenchmarkEncoderAppend(b *testing.B) {
+	input := "wa'tasimu bihablillahi jami'aw wala tafarraqu"
+	for i := 0; i < b.N; i++ {
+		enc := quranizeTest.NewEncoder()
+		for _, c := range input {
+			enc.Append(string(c))
+			enc.Results()
+		}
+	}
+}
+
+func BenchmarkEncodeEveryKeystroke(b *testing.B) {
+	input := "wa'tasimu bihablillahi jami'aw wala tafarraqu"
+	for i := 0; i < b.N; i++ {
+		for j := range input {
+			quranizeTest.EncodeRanked(input[:j+1])
+		}
+	}
+}
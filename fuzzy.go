@@ -0,0 +1,282 @@
+package quranize
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// Edit costs of fuzzy matching, in half edits.
+const (
+	editCost      = 2
+	closeEditCost = 1
+)
+
+// closeGroups lists groups of letters that sound alike,
+// substituting a letter with another letter in the same group is cheaper.
+var closeGroups = []string{"aeiou", "szc", "td", "kqg", "fpv", "bp", "hx", "jz"}
+
+// Limits of EncodeFuzzy.
+const (
+	fuzzyMaxWork       = 1 << 18
+	fuzzyMaxCandidates = 100
+)
+
+// softLetters are letters frequently added or dropped when typing from memory,
+// e.g. "h" in "sh", "kh", "th", "y" in "sy", or an apostrophe of 'ain.
+const softLetters = "hy'"
+
+type fuzzyKey struct {
+	n     int32
+	pos   int
+	freed int
+}
+
+type distanceKey struct {
+	alphabet string
+	pos      int
+}
+
+type fuzzer struct {
+	q         Quranize
+	e         *encoder
+	input     string
+	budget    int
+	seen      map[fuzzyKey]int
+	distances map[distanceKey][]int
+	results   map[string]fuzzyEncoding
+}
+
+type fuzzyEncoding struct {
+	encoding
+	edits int
+}
+
+// EncodeFuzzy returns arabic encodings of given string using Transliteration t, sorted best-first,
+// tolerating up to maxEdits insertions, deletions, or substitutions of letters.
+//
+// Substituting phonetically close letters (e.g. "s" and "z", or "o" and "u"), doubling a letter,
+// and adding or dropping "h", "y", or an apostrophe count as half an edit.
+// Edits of each result is the edit cost in half edits.
+//
+// The search is bounded, so only the best results found within a fixed amount of work are returned,
+// use EncodeFuzzyContext for other limits.
+func (q Quranize) EncodeFuzzy(s string, maxEdits int) []EncodeResult {
+	results, _ := q.EncodeFuzzyContext(context.Background(), s, maxEdits,
+		EncodeOptions{MaxWork: fuzzyMaxWork, MaxCandidates: fuzzyMaxCandidates})
+	if results == nil {
+		return []EncodeResult{}
+	}
+	return results
+}
+
+// EncodeFuzzyContext is like EncodeFuzzy, honoring cancellation and deadline of ctx and limits of opts,
+// where MaxWork is the maximum number of visited search states.
+//
+// It returns ctx.Err() if ctx is done, or *LimitError if a limit is exceeded.
+// When MaxWork or MaxCandidates is exceeded, the best results found are returned along with the error.
+func (q Quranize) EncodeFuzzyContext(ctx context.Context, s string, maxEdits int, opts EncodeOptions) ([]EncodeResult, error) {
+	s = normalizeInput(s)
+	if opts.MaxInputLength > 0 && len(s) > opts.MaxInputLength {
+		return nil, &LimitError{"MaxInputLength", opts.MaxInputLength}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	results := []EncodeResult{}
+	if s == "" || q.index == nil {
+		return results, nil
+	}
+
+	e := newEncoder(q)
+	e.ctx, e.maxWork = ctx, opts.MaxWork
+	start := encoding{}
+	if q.hasHarakat() {
+		start.marks = []uint8{}
+	}
+	// edits are increased one by one, so results of fewer edits are complete when a limit is exceeded
+	found := map[string]fuzzyEncoding{}
+	for edits := 0; edits <= maxEdits; edits++ {
+		f := fuzzer{
+			q:         q,
+			e:         e,
+			input:     s,
+			budget:    edits * editCost,
+			seen:      make(map[fuzzyKey]int),
+			distances: make(map[distanceKey][]int),
+			results:   make(map[string]fuzzyEncoding),
+		}
+		f.walk(0, 0, start, 0, 0)
+		if _, ok := e.err.(*LimitError); e.err != nil && !ok {
+			return nil, e.err
+		}
+		if e.err != nil {
+			break
+		}
+		found = f.results
+	}
+
+	for _, e := range found {
+		result := EncodeResult{
+			Text:        e.text,
+			Cost:        e.cost,
+			Edits:       e.edits,
+			Insertions:  e.insertions,
+			Occurrences: len(q.Locate(e.text)),
+		}
+		result.Score = result.score()
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Text < results[j].Text
+	})
+	if opts.MaxCandidates > 0 && len(results) > opts.MaxCandidates {
+		return results[:opts.MaxCandidates], &LimitError{"MaxCandidates", opts.MaxCandidates}
+	}
+	return results, e.err
+}
+
+// walk visits trie node n having consumed input up to pos, with e.text as the path from root.
+// freed is the number of consecutive harfs inserted without consuming input.
+func (f *fuzzer) walk(n int32, pos int, e encoding, edits, freed int) {
+	key := fuzzyKey{n, pos, freed}
+	if seenEdits, ok := f.seen[key]; (ok && seenEdits <= edits) || !f.e.spend(1) {
+		return
+	}
+	f.seen[key] = edits
+
+	if pos == len(f.input) && !strings.HasSuffix(e.text, " ") {
+		r, ok := f.results[e.text]
+		better := !ok || edits < r.edits || (edits == r.edits && e.penalty() < r.penalty())
+		if locations := f.q.index.locations(n); better && len(locations) > 0 && f.q.matchLocations(e, locations) {
+			f.results[e.text] = fuzzyEncoding{e, edits}
+		}
+	}
+
+	for _, alphabet := range f.q.t.alphabets[""] {
+		if strings.HasPrefix(f.input[pos:], alphabet) {
+			f.walk(n, pos+len(alphabet), e, edits, freed)
+		}
+	}
+
+	keys, targets := f.q.index.edges(n)
+	for j, key := range keys {
+		harf := string(key)
+		next := encoding{text: e.text + harf, cost: e.cost, insertions: e.insertions + 1, marks: appendMark(e.marks, 0)}
+		if key == ' ' {
+			if e.text != "" {
+				next.insertions = e.insertions
+				f.walk(targets[j], pos, next, edits, 0)
+			}
+			continue
+		}
+		if freed < 2 && isFreeHarf(key, e.text) {
+			f.walk(targets[j], pos, next, edits, freed+1)
+		}
+		for i, alphabet := range f.q.t.alphabets[harf] {
+			mark := alphabetMarks(alphabet)
+			if e.marks != nil && !satisfy(mark, f.q.index.marks[targets[j]]) {
+				continue
+			}
+			next := encoding{text: e.text + harf, cost: e.cost + f.q.t.alphabetCosts[harf][i], insertions: e.insertions, marks: appendMark(e.marks, mark)}
+			f.match(targets[j], pos, next, edits, alphabet)
+		}
+	}
+}
+
+// match consumes input matching alphabet approximately, then continues walking from n.
+func (f *fuzzer) match(n int32, pos int, e encoding, edits int, alphabet string) {
+	for width, d := range f.distance(alphabet, pos) {
+		if edits+d <= f.budget {
+			f.walk(n, pos+width, e, edits+d, 0)
+		}
+	}
+}
+
+// distance returns edit distances of alphabet against every prefix of input starting at pos.
+func (f *fuzzer) distance(alphabet string, pos int) []int {
+	key := distanceKey{alphabet, pos}
+	if d, ok := f.distances[key]; ok {
+		return d
+	}
+	end := pos + len(alphabet) + f.budget/closeEditCost
+	if end > len(f.input) {
+		end = len(f.input)
+	}
+	d := editDistances(alphabet, f.input[pos:end])
+	f.distances[key] = d
+	return d
+}
+
+// appendMark returns copy of required harakat marks with mark appended, or nil if marks are not recorded.
+func appendMark(marks []uint8, mark uint8) []uint8 {
+	if marks == nil {
+		return nil
+	}
+	return append(append(make([]uint8, 0, len(marks)+1), marks...), mark)
+}
+
+// isFreeHarf reports whether harf can be inserted without consuming input,
+// as combine does in exact encoding.
+func isFreeHarf(harf rune, text string) bool {
+	switch harf {
+	case 'ا', 'ى':
+		return true
+	case 'ل':
+		return strings.HasSuffix(text, "ا")
+	}
+	return false
+}
+
+// editDistances returns weighted Levenshtein distances in half edits of a against every prefix of b.
+func editDistances(a, b string) []int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := 1; j <= len(b); j++ {
+		prev[j] = prev[j-1] + indelCost(b, j-1)
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = prev[0] + indelCost(a, i-1)
+		for j := 1; j <= len(b); j++ {
+			curr[j] = min3(
+				prev[j-1]+substitutionCost(a[i-1], b[j-1]),
+				prev[j]+indelCost(a, i-1),
+				curr[j-1]+indelCost(b, j-1),
+			)
+		}
+		prev, curr = curr, prev
+	}
+	return prev
+}
+
+func indelCost(s string, i int) int {
+	if strings.IndexByte(softLetters, s[i]) >= 0 || (i > 0 && s[i-1] == s[i]) {
+		return closeEditCost
+	}
+	return editCost
+}
+
+func substitutionCost(a, b byte) int {
+	if a == b {
+		return 0
+	}
+	for _, group := range closeGroups {
+		if strings.IndexByte(group, a) >= 0 && strings.IndexByte(group, b) >= 0 {
+			return closeEditCost
+		}
+	}
+	return editCost
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,92 @@
+package quranize
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeFuzzyEmptyString(t *testing.T) {
+	input := ""
+	expected := []EncodeResult{}
+	actual := quranizeTest.EncodeFuzzy(input, 1)
+	assert.Equal(t, expected, actual)
+}
+
+func TestEncodeFuzzyExact(t *testing.T) {
+	results := quranizeTest.EncodeFuzzy("bismillah", 0)
+	assert.NotEmpty(t, results)
+	assert.Equal(t, "بسم الله", results[0].Text)
+	assert.Equal(t, 0, results[0].Edits)
+}
+
+func TestEncodeFuzzyTypo(t *testing.T) {
+	testCases := map[string]string{
+		"kahvi":                     "الكهف",
+		"alhamdulilah robil alamin": "الحمد لله رب العالمين",
+		"ihdinas sirotol mustakim":  "اهدنا الصراط المستقيم",
+		"subhanaladzi asro":         "سبحان الذي أسرى",
+	}
+	for input, expected := range testCases {
+		results := quranizeTest.EncodeFuzzy(input, 1)
+		if assert.NotEmptyf(t, results, "input = %#v", input) {
+			assert.Equalf(t, expected, results[0].Text, "input = %#v", input)
+		}
+	}
+}
+
+func TestEncodeFuzzyNoTolerance(t *testing.T) {
+	input := "kahvi"
+	expected := []EncodeResult{}
+	actual := quranizeTest.EncodeFuzzy(input, 0)
+	assert.Equal(t, expected, actual)
+}
+
+func TestEncodeFuzzyBounded(t *testing.T) {
+	start := time.Now()
+	results := quranizeTest.EncodeFuzzy("qulhuwallahuahad", 5)
+	assert.True(t, time.Since(start) < 5*time.Second)
+	if assert.NotEmpty(t, results) {
+		assert.Equal(t, "قل هو الله أحد", results[0].Text)
+	}
+	assert.True(t, len(results) <= fuzzyMaxCandidates)
+}
+
+func TestEncodeFuzzyContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err := quranizeTest.EncodeFuzzyContext(ctx, "kahvi", 1, EncodeOptions{})
+	assert.Nil(t, results)
+	assert.Equal(t, context.Canceled, err)
+
+	results, err = quranizeTest.EncodeFuzzyContext(context.Background(), "kahvi", 1, EncodeOptions{MaxCandidates: 2})
+	assert.Len(t, results, 2)
+	assert.Equal(t, &LimitError{"MaxCandidates", 2}, err)
+
+	exact, err := quranizeTest.EncodeFuzzyContext(context.Background(), "bismillah", 0, EncodeOptions{})
+	assert.NoError(t, err)
+	results, err = quranizeTest.EncodeFuzzyContext(context.Background(), "bismillah", 3, EncodeOptions{MaxWork: 2000})
+	assert.Equal(t, &LimitError{"MaxWork", 2000}, err)
+	assert.Equal(t, exact, results)
+}
+
+func TestEncodeFuzzyWithHarakat(t *testing.T) {
+	q := NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), WithHarakat(NewQuranSimpleEnhanced()))
+	texts := func(results []EncodeResult) []string {
+		texts := []string{}
+		for _, result := range results {
+			texts = append(texts, result.Text)
+		}
+		return texts
+	}
+	assert.NotContains(t, texts(q.EncodeFuzzy("rubba", 0)), "رب")
+	assert.Contains(t, texts(q.EncodeFuzzy("rabbi", 0)), "رب")
+}
+
+func TestEditDistances(t *testing.T) {
+	assert.Equal(t, []int{4, 2, 0, 2}, editDistances("sa", "sas"))
+	assert.Equal(t, []int{4, 3, 2}, editDistances("sa", "zo"))
+	assert.Equal(t, []int{5, 3, 1, 3}, editDistances("sya", "sax"))
+}
@@ -0,0 +1,155 @@
+package quranize
+
+import (
+	"strings"
+)
+
+// Harakat marks recorded in index nodes.
+const (
+	markFatha uint8 = 1 << iota
+	markKasra
+	markDamma
+	markShadda
+	markSukun
+	markBare
+)
+
+var vowelMarks = map[byte]uint8{
+	'a': markFatha,
+	'i': markKasra,
+	'u': markDamma,
+	'o': markFatha | markDamma,
+	'e': markFatha | markKasra,
+}
+
+// WithHarakat returns Option to use harakat of enhanced (e.g. NewQuranSimpleEnhanced) in encoding.
+// Encode then only accepts alphabet vowels and doubled consonants consistent with harakat of each harf,
+// e.g. "rabbi" matches رَبِّ but "rubba" does not.
+//
+// Ayas of enhanced whose letters differ from the indexed Quran (harakat removed) are indexed without harakat.
+func WithHarakat(enhanced Quran) Option {
+	return func(q *Quranize) {
+		q.harakat = enhanced
+	}
+}
+
+func (q Quranize) hasHarakat() bool {
+	return len(q.harakat.Suras) > 0
+}
+
+// ayaMarks returns harakat marks of every harf of aya number in sura number (aya 0 is bismillah).
+func (q Quranize) ayaMarks(harfs []rune, sura, aya int) []uint8 {
+	marks := make([]uint8, len(harfs))
+	letters := parseLetters(q.harakat.text(sura, aya))
+	if len(letters) != len(harfs) {
+		return marks
+	}
+	for i, lt := range letters {
+		if lt.harf != harfs[i] {
+			return make([]uint8, len(harfs))
+		}
+		if lt.harf != ' ' {
+			marks[i] = lt.marks()
+		}
+	}
+	return marks
+}
+
+func (lt letter) marks() uint8 {
+	marks := vowelMarks[lt.vowel]
+	if lt.shadda {
+		marks |= markShadda
+	}
+	if lt.sukun {
+		marks |= markSukun
+	}
+	if marks == 0 {
+		marks = markBare
+	}
+	return marks
+}
+
+// alphabetMarks returns harakat required by alphabet of a consonant,
+// i.e. the vowel it ends with and shadda if the consonant is doubled.
+func alphabetMarks(alphabet string) uint8 {
+	if len(alphabet) < 2 || isVowel(alphabet[0]) {
+		return 0
+	}
+	marks := vowelMarks[alphabet[len(alphabet)-1]]
+	if alphabet[0] == alphabet[1] {
+		marks |= markShadda
+	}
+	return marks
+}
+
+// satisfy reports whether harakat marks of a harf satisfy required marks.
+func satisfy(required, marks uint8) bool {
+	if required == 0 || marks == 0 {
+		return true
+	}
+	if required&markShadda != 0 && marks&markShadda == 0 {
+		return false
+	}
+	vowels := required &^ markShadda
+	return vowels == 0 || marks&vowels != 0
+}
+
+// mergeMarks returns required marks satisfied by either a or b.
+func mergeMarks(a, b []uint8) []uint8 {
+	if a == nil || b == nil {
+		return a
+	}
+	merged := make([]uint8, len(a))
+	for i := range a {
+		if a[i] != 0 && b[i] != 0 {
+			merged[i] = a[i] | b[i]
+		}
+	}
+	return merged
+}
+
+// matchNode reports whether the last harf of e may satisfy harakat marks recorded in its index node.
+func (e encoding) matchNode(marks uint8) bool {
+	return len(e.marks) == 0 || satisfy(e.marks[len(e.marks)-1], marks)
+}
+
+// matchLocations reports whether harakat of e are satisfied in at least one of locations.
+func (q Quranize) matchLocations(e encoding, locations []Location) bool {
+	if e.marks == nil {
+		return true
+	}
+	for _, location := range locations {
+		if q.matchLocation(e.marks, location) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q Quranize) matchLocation(required []uint8, location Location) bool {
+	sura, aya := location.GetSura(), location.GetAya()
+	harfs := []rune(q.q.text(sura, aya))
+	marks := q.ayaMarks(harfs, sura, aya)
+
+	start, wordIndex := 0, 0
+	for start < len(harfs) && wordIndex < location.GetWordIndex() {
+		if harfs[start] == ' ' {
+			wordIndex++
+		}
+		start++
+	}
+	if len(marks)-start < len(required) && q.crossAya > 1 {
+		_, tailMarks := q.following(sura, aya, q.crossAya-1)
+		marks = append(marks[:len(marks):len(marks)], tailMarks...)
+	}
+	for i, r := range required {
+		if start+i >= len(marks) || !satisfy(r, marks[start+i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) >= 0
+}
@@ -0,0 +1,40 @@
+package quranize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWithHarakat(t *testing.T) {
+	q := NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), WithHarakat(NewQuranSimpleEnhanced()))
+	testCases := map[string][]string{
+		"bismillah":                   {"بسم الله"},
+		"maaliki yau middin":          {"مالك يوم الدين"},
+		"kataba":                      {"كتب"},
+		"kutiba 'alaikumus":           {"كتب عليكم"},
+		"bismillaahirrahmaanirrahiim": {"بسم الله الرحمن الرحيم"},
+	}
+	for input, expected := range testCases {
+		actual := q.Encode(input)
+		assert.ElementsMatchf(t, expected, actual, "input = %#v\nexpected = %#v\nactual = %#v", input, expected, actual)
+	}
+	assert.NotContains(t, q.Encode("rubba"), "رب")
+	assert.Contains(t, q.Encode("rabbi"), "رب")
+}
+
+func TestAlphabetMarks(t *testing.T) {
+	assert.Equal(t, uint8(0), alphabetMarks("a"))
+	assert.Equal(t, uint8(0), alphabetMarks("b"))
+	assert.Equal(t, markKasra, alphabetMarks("bi"))
+	assert.Equal(t, markFatha|markDamma, alphabetMarks("ro"))
+	assert.Equal(t, markShadda|markFatha, alphabetMarks("bba"))
+}
+
+func TestSatisfy(t *testing.T) {
+	assert.True(t, satisfy(0, markKasra))
+	assert.True(t, satisfy(markKasra, 0))
+	assert.True(t, satisfy(markKasra, markKasra|markShadda))
+	assert.False(t, satisfy(markKasra, markFatha))
+	assert.False(t, satisfy(markShadda|markFatha, markFatha))
+}
@@ -0,0 +1,223 @@
+package quranize
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	indexMagic   = "QRZI"
+	indexVersion = 2
+)
+
+// Errors returned by LoadQuranize.
+var (
+	ErrInvalidIndex = errors.New("quranize: invalid index")
+	ErrStaleIndex   = errors.New("quranize: stale index")
+)
+
+// WriteIndex writes index of Quranize q to w in compact binary format, so it can be loaded by LoadQuranize.
+//
+// The format is a header (magic, version, fingerprint of indexed Quran, number of nodes, edges, and locations),
+// the flat arrays of index in fixed-width little-endian integers, and a CRC-32 checksum of everything before it.
+func (q Quranize) WriteIndex(w io.Writer) error {
+	t := q.index
+	if t == nil {
+		return ErrInvalidIndex
+	}
+
+	buffer := bytes.NewBufferString(indexMagic)
+	writeUvarint(buffer, indexVersion)
+	writeUvarint(buffer, uint64(q.fingerprint()))
+	writeUvarint(buffer, uint64(t.size()))
+	writeUvarint(buffer, uint64(len(t.keys)))
+	writeUvarint(buffer, uint64(len(t.pool)))
+
+	raw := make([]byte, 4)
+	for _, x := range t.edgeStarts {
+		binary.LittleEndian.PutUint32(raw, x)
+		buffer.Write(raw)
+	}
+	for _, x := range t.locationStarts {
+		binary.LittleEndian.PutUint32(raw, x)
+		buffer.Write(raw)
+	}
+	buffer.Write(t.marks)
+	for _, key := range t.keys {
+		binary.LittleEndian.PutUint32(raw, uint32(key))
+		buffer.Write(raw)
+	}
+	for _, target := range t.targets {
+		binary.LittleEndian.PutUint32(raw, uint32(target))
+		buffer.Write(raw)
+	}
+	for _, l := range t.pool {
+		raw[0] = l.sura
+		binary.LittleEndian.PutUint16(raw[1:], l.aya)
+		raw[3] = l.wordIndex
+		buffer.Write(raw)
+	}
+
+	binary.LittleEndian.PutUint32(raw, crc32.ChecksumIEEE(buffer.Bytes()))
+	buffer.Write(raw)
+	_, err := buffer.WriteTo(w)
+	return err
+}
+
+// LoadQuranize returns new Quranize using Transliteration t, Quran q, and options,
+// with index read from r (written by WriteIndex) instead of building it.
+//
+// It returns ErrInvalidIndex if r is corrupted,
+// ErrStaleIndex if r was written by other version or for other Quran and options,
+// or *QuranError if Quran q and enhanced Quran of WithHarakat have different shapes.
+//
+// The whole of r is read into memory and copied into the index arrays, so r is not memory-mapped
+// and loading needs about twice the index size temporarily.
+func LoadQuranize(t Transliteration, q Quran, r io.Reader, options ...Option) (Quranize, error) {
+	quranize := newQuranize(t, q, options)
+	if quranize.hasHarakat() {
+		if err := q.ValidateShape(quranize.harakat); err != nil {
+			return Quranize{}, err
+		}
+	}
+
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return Quranize{}, err
+	}
+	if len(raw) < len(indexMagic)+4 || string(raw[:len(indexMagic)]) != indexMagic {
+		return Quranize{}, ErrInvalidIndex
+	}
+	body, checksum := raw[:len(raw)-4], raw[len(raw)-4:]
+	if crc32.ChecksumIEEE(body) != binary.LittleEndian.Uint32(checksum) {
+		return Quranize{}, ErrInvalidIndex
+	}
+
+	d := decoder{raw: body[len(indexMagic):]}
+	version, fingerprint := d.uvarint(), d.uvarint()
+	if d.err == nil && (version != indexVersion || fingerprint != uint64(quranize.fingerprint())) {
+		return Quranize{}, ErrStaleIndex
+	}
+
+	nodes, edges, locations := d.uvarint(), d.uvarint(), d.uvarint()
+	size := uint64(len(d.raw))
+	if d.err != nil || nodes == 0 || nodes > size || edges > size || locations > size ||
+		size != 9*nodes+8+8*edges+4*locations {
+		return Quranize{}, ErrInvalidIndex
+	}
+	index := &trie{
+		edgeStarts:     d.uint32s(nodes + 1),
+		locationStarts: d.uint32s(nodes + 1),
+		marks:          d.bytes(nodes),
+		keys:           make([]rune, edges),
+		targets:        make([]int32, edges),
+		pool:           make([]Location, locations),
+	}
+	for i, key := range d.uint32s(edges) {
+		index.keys[i] = rune(key)
+	}
+	for i, target := range d.uint32s(edges) {
+		index.targets[i] = int32(target)
+	}
+	for i := range index.pool {
+		raw := d.bytes(4)
+		index.pool[i] = Location{raw[0], binary.LittleEndian.Uint16(raw[1:]), raw[3]}
+	}
+	if !index.valid() {
+		return Quranize{}, ErrInvalidIndex
+	}
+
+	quranize.index = index
+	return quranize, nil
+}
+
+// fingerprint returns CRC-32 checksum of texts indexed by Quranize q.
+func (q Quranize) fingerprint() uint32 {
+	hash := crc32.NewIEEE()
+	for _, quran := range []Quran{q.q, q.harakat} {
+		for _, sura := range quran.Suras {
+			for a, aya := range sura.Ayas {
+				if q.bismillah && a == 0 {
+					io.WriteString(hash, aya.Bismillah)
+					io.WriteString(hash, "\n")
+				}
+				io.WriteString(hash, aya.Text)
+				io.WriteString(hash, "\n")
+			}
+		}
+		io.WriteString(hash, "\x00")
+	}
+	if q.crossAya > 1 {
+		fmt.Fprintf(hash, "cross %d %t", q.crossAya, q.crossSura)
+	}
+	return hash.Sum32()
+}
+
+// valid reports whether offsets and targets of trie t are in range,
+// and whether every edge targets a node numbered after its source, as in depth-first order.
+func (t *trie) valid() bool {
+	for _, starts := range [][]uint32{t.edgeStarts, t.locationStarts} {
+		for i := 1; i < len(starts); i++ {
+			if starts[i] < starts[i-1] {
+				return false
+			}
+		}
+	}
+	if t.edgeStarts[0] != 0 || int(t.edgeStarts[t.size()]) != len(t.keys) ||
+		t.locationStarts[0] != 0 || int(t.locationStarts[t.size()]) != len(t.pool) {
+		return false
+	}
+	for n := 0; n < t.size(); n++ {
+		for _, target := range t.targets[t.edgeStarts[n]:t.edgeStarts[n+1]] {
+			if int(target) <= n || int(target) >= t.size() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// decoder reads fixed-width and variable-width integers written by WriteIndex.
+type decoder struct {
+	raw []byte
+	err error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	x, n := binary.Uvarint(d.raw)
+	if n <= 0 {
+		d.err = ErrInvalidIndex
+		return 0
+	}
+	d.raw = d.raw[n:]
+	return x
+}
+
+// bytes returns next n bytes, the caller must ensure they are available.
+func (d *decoder) bytes(n uint64) []byte {
+	raw := d.raw[:n:n]
+	d.raw = d.raw[n:]
+	return raw
+}
+
+func (d *decoder) uint32s(n uint64) []uint32 {
+	raw := d.bytes(4 * n)
+	xs := make([]uint32, n)
+	for i := range xs {
+		xs[i] = binary.LittleEndian.Uint32(raw[4*i:])
+	}
+	return xs
+}
+
+func writeUvarint(buffer *bytes.Buffer, x uint64) {
+	raw := make([]byte, binary.MaxVarintLen64)
+	buffer.Write(raw[:binary.PutUvarint(raw, x)])
+}
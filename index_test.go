@@ -0,0 +1,69 @@
+package quranize
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteIndexAndLoadQuranize(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	assert.NoError(t, quranizeTest.WriteIndex(buffer))
+
+	q, err := LoadQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, quranizeTest.Encode("bismillah"), q.Encode("bismillah"))
+	assert.Equal(t, quranizeTest.Locate("الحمد لله رب العالمين"), q.Locate("الحمد لله رب العالمين"))
+	assert.Equal(t, zeroLocs, q.Locate("alfan"))
+}
+
+func TestWriteIndexBeforeBuildIndex(t *testing.T) {
+	assert.Equal(t, ErrInvalidIndex, Quranize{}.WriteIndex(bytes.NewBuffer(nil)))
+}
+
+func TestLoadQuranizeInvalidIndex(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	assert.NoError(t, quranizeTest.WriteIndex(buffer))
+	raw := buffer.Bytes()
+	raw[len(raw)/2]++
+
+	_, err := LoadQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), bytes.NewReader(raw))
+	assert.Equal(t, ErrInvalidIndex, err)
+
+	_, err = LoadQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), strings.NewReader("QRZ"))
+	assert.Equal(t, ErrInvalidIndex, err)
+}
+
+func TestTrieValid(t *testing.T) {
+	index := &trie{
+		edgeStarts:     []uint32{0, 1, 2, 2},
+		locationStarts: []uint32{0, 0, 0, 0},
+		marks:          make([]uint8, 3),
+		keys:           []rune{'a', 'b'},
+		targets:        []int32{1, 2},
+	}
+	assert.True(t, index.valid())
+
+	index.targets = []int32{2, 1}
+	assert.False(t, index.valid())
+}
+
+func TestLoadQuranizeStaleIndex(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	assert.NoError(t, quranizeTest.WriteIndex(buffer))
+
+	_, err := LoadQuranize(NewDefaultTransliteration(), NewQuranSimpleEnhanced(), buffer)
+	assert.Equal(t, ErrStaleIndex, err)
+}
+
+func TestLoadQuranizeShapeMismatch(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	assert.NoError(t, quranizeTest.WriteIndex(buffer))
+	enhanced := NewQuranSimpleEnhanced()
+	enhanced.Suras = enhanced.Suras[:1]
+
+	_, err := LoadQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), buffer, WithHarakat(enhanced))
+	assert.EqualError(t, err, "invalid quran: 114 suras, other has 1")
+}
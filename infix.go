@@ -0,0 +1,89 @@
+package quranize
+
+import (
+	"bytes"
+	"index/suffixarray"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ayaSeparator separates ayas in text of substringIndex, so no match spans two ayas.
+const ayaSeparator = "\n"
+
+// Occurrence is an occurrence of a substring in Quran,
+// located at the word containing its first harf.
+type Occurrence struct {
+	Location
+	Offset     int // offset of the first harf from the start of aya, in runes
+	WordOffset int // offset of the first harf from the start of its word, in runes
+}
+
+// substringIndex is a suffix array of every aya of Quran concatenated, built on first use.
+type substringIndex struct {
+	once   sync.Once
+	text   []byte // shared with array
+	array  *suffixarray.Index
+	starts []int      // byte offsets of every aya in text
+	ayas   []Location // locations of every aya, word index 0
+}
+
+// LocateSubstring returns occurrences of s anywhere in Quran, sorted by location,
+// including s starting or ending in the middle of a word, e.g. "حمد" in "الحمد" or "فحمدوا".
+//
+// Suffix array of Quran is built on first call.
+func (q Quranize) LocateSubstring(s string) []Occurrence {
+	occurrences := []Occurrence{}
+	index := q.substringIndex()
+	if index == nil || s == "" || strings.Contains(s, ayaSeparator) {
+		return occurrences
+	}
+
+	offsets := index.array.Lookup([]byte(s), -1)
+	sort.Ints(offsets)
+	for _, offset := range offsets {
+		occurrences = append(occurrences, index.occurrence(offset))
+	}
+	return occurrences
+}
+
+func (q Quranize) substringIndex() *substringIndex {
+	if q.substrings == nil {
+		return nil
+	}
+	index := q.substrings
+	index.once.Do(func() {
+		b := &bytes.Buffer{}
+		add := func(text string, sura, aya int) {
+			index.starts = append(index.starts, b.Len())
+			index.ayas = append(index.ayas, NewLocation(sura, aya, 0))
+			b.WriteString(text)
+			b.WriteString(ayaSeparator)
+		}
+		for s, sura := range q.q.Suras {
+			if q.bismillah && len(sura.Ayas) > 0 && sura.Ayas[0].Bismillah != "" {
+				add(sura.Ayas[0].Bismillah, s+1, 0)
+			}
+			for a, aya := range sura.Ayas {
+				add(aya.Text, s+1, a+1)
+			}
+		}
+		index.text = b.Bytes()
+		index.array = suffixarray.New(index.text)
+	})
+	return index
+}
+
+// occurrence returns occurrence at byte offset of text.
+func (index *substringIndex) occurrence(offset int) Occurrence {
+	i := sort.SearchInts(index.starts, offset+1) - 1
+	prefix := index.text[index.starts[i]:offset]
+	word := bytes.LastIndexByte(prefix, ' ') + 1
+	l := index.ayas[i]
+	return Occurrence{
+		Location:   NewLocation(l.GetSura(), l.GetAya(), bytes.Count(prefix, []byte(" "))),
+		Offset:     utf8.RuneCount(prefix),
+		WordOffset: utf8.RuneCount(prefix[word:]),
+	}
+}
@@ -0,0 +1,40 @@
+package quranize
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocateSubstring(t *testing.T) {
+	occurrences := quranizeTest.LocateSubstring("حمد")
+	assert.Contains(t, occurrences, Occurrence{NewLocation(1, 2, 0), 2, 2})
+	assert.True(t, sort.SliceIsSorted(occurrences, func(i, j int) bool {
+		return occurrences[i].Location.before(occurrences[j].Location)
+	}))
+
+	words := []Location{}
+	for _, o := range quranizeTest.LocateSubstring("الحمد") {
+		if o.WordOffset == 0 {
+			words = append(words, o.Location)
+		}
+	}
+	assert.Equal(t, quranizeTest.Locate("الحمد"), words)
+
+	assert.Equal(t, []Occurrence{{NewLocation(1, 7, 2), 12, 1}}, quranizeTest.LocateSubstring("نعمت عليهم غير"))
+}
+
+func TestLocateSubstringNotFound(t *testing.T) {
+	for _, s := range []string{"", "alfan", "الرحيم\nالحمد", "الرحيم الحمد"} {
+		assert.Equal(t, []Occurrence{}, quranizeTest.LocateSubstring(s), s)
+	}
+	assert.Equal(t, []Occurrence{}, Quranize{}.LocateSubstring("حمد"))
+}
+
+func TestLocateSubstringWithBismillah(t *testing.T) {
+	q := NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), WithBismillah())
+	occurrences := q.LocateSubstring("سم الله")
+	assert.Equal(t, Occurrence{NewLocation(1, 1, 0), 1, 1}, occurrences[0])
+	assert.Equal(t, Occurrence{NewLocation(2, 0, 0), 1, 1}, occurrences[1])
+}
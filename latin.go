@@ -0,0 +1,430 @@
+package quranize
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// LatinStyle is a style of latin transliteration used by Latinizer.
+type LatinStyle int
+
+// Available latin transliteration styles.
+const (
+	// LatinIndonesian is indonesian popular spelling without diacritics, read as connected speech pausing at the end,
+	// e.g. "bismillahir-rahmanir-rahim".
+	LatinIndonesian LatinStyle = iota
+	// LatinALALC is academic ALA-LC romanization, word by word with full vowels,
+	// e.g. "bismi Allāhi al-raḥmāni al-raḥīmi".
+	LatinALALC
+)
+
+// Latinizer transliterates arabic into alphabet (the reverse of Transliteration).
+type Latinizer struct {
+	style      LatinStyle
+	consonants map[rune]string
+	longVowels map[byte]string
+	hamza      string
+}
+
+type letter struct {
+	harf   rune
+	vowel  byte
+	tanwin bool
+	shadda bool
+	sukun  bool
+}
+
+// latinWord is a latinized word.
+type latinWord struct {
+	text  string
+	wasla bool // text starts with a vowel elided in connected speech, e.g. "al-" or "allāh"
+}
+
+const (
+	fathatan = 'ً'
+	dammatan = 'ٌ'
+	kasratan = 'ٍ'
+	fatha    = 'َ'
+	damma    = 'ُ'
+	kasra    = 'ِ'
+	shadda   = 'ّ'
+	sukun    = 'ْ'
+)
+
+var latinConsonants = map[LatinStyle]map[rune]string{
+	LatinIndonesian: {
+		'ب': "b", 'ت': "t", 'ث': "ts", 'ج': "j", 'ح': "h", 'خ': "kh", 'د': "d", 'ذ': "dz", 'ر': "r",
+		'ز': "z", 'س': "s", 'ش': "sy", 'ص': "sh", 'ض': "dh", 'ط': "th", 'ظ': "zh", 'ع': "'", 'غ': "gh",
+		'ف': "f", 'ق': "q", 'ك': "k", 'ل': "l", 'م': "m", 'ن': "n", 'ه': "h", 'و': "w", 'ي': "y",
+		'ة': "t",
+	},
+	LatinALALC: {
+		'ب': "b", 'ت': "t", 'ث': "th", 'ج': "j", 'ح': "ḥ", 'خ': "kh", 'د': "d", 'ذ': "dh", 'ر': "r",
+		'ز': "z", 'س': "s", 'ش': "sh", 'ص': "ṣ", 'ض': "ḍ", 'ط': "ṭ", 'ظ': "ẓ", 'ع': "ʻ", 'غ': "gh",
+		'ف': "f", 'ق': "q", 'ك': "k", 'ل': "l", 'م': "m", 'ن': "n", 'ه': "h", 'و': "w", 'ي': "y",
+		'ة': "t",
+	},
+}
+
+// divineNames are the name of Allah with its proclitics, in indonesian and ALA-LC style,
+// followed by the case vowel of its last letter.
+var divineNames = map[string][2]string{
+	"الله":  {"allah", "Allāh"},
+	"اللهم": {"allahumma", "Allāhumma"},
+	"لله":   {"lillah", "lillāh"},
+	"ولله":  {"walillah", "wa-lillāh"},
+	"فلله":  {"falillah", "fa-lillāh"},
+	"بالله": {"billah", "billāh"},
+	"تالله": {"tallah", "tallāh"},
+	"والله": {"wallah", "wa-Allāh"},
+	"فالله": {"fallah", "fa-Allāh"},
+}
+
+// muqattaat are the disconnected letters opening some suras, e.g. "الم" of 2:1, read by letter names.
+var muqattaat = map[string]bool{
+	"الم": true, "المص": true, "الر": true, "المر": true, "كهيعص": true, "طه": true, "طسم": true,
+	"طس": true, "يس": true, "ص": true, "حم": true, "عسق": true, "ق": true, "ن": true,
+}
+
+// letterNames are names of letters of muqattaat, in indonesian and ALA-LC style.
+var letterNames = map[rune][2]string{
+	'ا': {"alif", "alif"}, 'ل': {"lam", "lām"}, 'م': {"mim", "mīm"}, 'ص': {"shad", "ṣād"}, 'ر': {"ra", "rā"},
+	'ك': {"kaf", "kāf"}, 'ه': {"ha", "hā"}, 'ي': {"ya", "yā"}, 'ع': {"'ain", "ʻayn"}, 'ط': {"tha", "ṭā"},
+	'س': {"sin", "sīn"}, 'ح': {"ha", "ḥā"}, 'ق': {"qaf", "qāf"}, 'ن': {"nun", "nūn"},
+}
+
+// hiddenAlifs are words written without the alif of their long ā (superscript alif in uthmani script),
+// mapped to the index of the letter followed by the long ā.
+var hiddenAlifs = map[string]int{
+	"رحمن": 2, "الرحمن": 4, "إله": 1, "إلهكم": 1, "إلهنا": 1, "إلهين": 1, "ذلك": 0, "ذلكم": 0, "ذلكما": 0,
+	"هذا": 0, "هذه": 0, "هذان": 0, "هؤلاء": 0, "لكن": 0, "أولئك": 2,
+}
+
+// vocalizations are harakat of common words doubling a letter in their spelling, used for words without harakat.
+var vocalizations = map[string]string{
+	"الذي":   "الَّذِي",
+	"الذين":  "الَّذِينَ",
+	"التي":   "الَّتِي",
+	"اللذين": "اللَّذَيْنِ",
+	"اللاتي": "اللَّاتِي",
+}
+
+var plainLongVowels = map[byte]string{'a': "a", 'i': "i", 'u': "u"}
+
+// NewLatinizer returns new Latinizer using given style.
+func NewLatinizer(style LatinStyle) Latinizer {
+	l := Latinizer{style: style, consonants: latinConsonants[style]}
+	switch style {
+	case LatinALALC:
+		l.longVowels = map[byte]string{'a': "ā", 'i': "ī", 'u': "ū"}
+		l.hamza = "ʼ"
+	default:
+		l.style = LatinIndonesian
+		l.consonants = latinConsonants[LatinIndonesian]
+		l.longVowels = plainLongVowels
+		l.hamza = "'"
+	}
+	return l
+}
+
+// Latinize returns latin transliteration of arabic text s.
+//
+// Vowels are taken from harakat (as in quran-simple-enhanced).
+// Words without harakat (as in quran-simple-clean) are rendered by consonants and long vowels only.
+// In LatinIndonesian style, words are joined where the vowel of the following word is elided,
+// and the last word is read in pause, e.g. "rabbil-'alamin".
+// Disconnected letters opening some suras are read by their names, e.g. "alif lam mim".
+func (l Latinizer) Latinize(s string) string {
+	words := strings.Fields(s)
+	out := []string{}
+	for i, word := range words {
+		pause := l.style == LatinIndonesian && i == len(words)-1
+		w := l.latinizeWord(parseLetters(word), pause)
+		if last := len(out) - 1; l.style == LatinIndonesian && w.wasla && last >= 0 && endsWithVowel(out[last]) {
+			_, size := utf8.DecodeRuneInString(w.text)
+			out[last] += w.text[size:]
+			continue
+		}
+		out = append(out, w.text)
+	}
+	return strings.Join(out, " ")
+}
+
+func endsWithVowel(s string) bool {
+	return strings.HasSuffix(s, "a") || strings.HasSuffix(s, "i") || strings.HasSuffix(s, "u")
+}
+
+func parseLetters(word string) []letter {
+	letters := []letter{}
+	for _, harf := range word {
+		if len(letters) == 0 || !isHaraka(harf) {
+			letters = append(letters, letter{harf: harf})
+			continue
+		}
+		lt := &letters[len(letters)-1]
+		switch harf {
+		case fatha, fathatan:
+			lt.vowel = 'a'
+		case kasra, kasratan:
+			lt.vowel = 'i'
+		case damma, dammatan:
+			lt.vowel = 'u'
+		case shadda:
+			lt.shadda = true
+		case sukun:
+			lt.sukun = true
+		}
+		lt.tanwin = lt.tanwin || harf == fathatan || harf == kasratan || harf == dammatan
+	}
+	return letters
+}
+
+func isHaraka(harf rune) bool {
+	return fathatan <= harf && harf <= sukun
+}
+
+func isVocalized(letters []letter) bool {
+	for _, lt := range letters {
+		if lt.vowel != 0 || lt.shadda || lt.sukun {
+			return true
+		}
+	}
+	return false
+}
+
+func (lt letter) bare() bool {
+	return lt.vowel == 0 && !lt.shadda && !lt.sukun
+}
+
+func bareWord(letters []letter) string {
+	harfs := []rune{}
+	for _, lt := range letters {
+		harfs = append(harfs, lt.harf)
+	}
+	return string(harfs)
+}
+
+// withHiddenAlif returns letters with the alif of a long ā written explicitly, if it is hidden in the spelling.
+func withHiddenAlif(letters []letter, word string) []letter {
+	offset := 0
+	i, ok := hiddenAlifs[word]
+	if !ok && len(letters) > 1 && (letters[0].harf == 'و' || letters[0].harf == 'ف') {
+		offset = 1
+		i, ok = hiddenAlifs[string([]rune(word)[1:])]
+	}
+	if i += offset; !ok || i+1 >= len(letters) || (letters[i].vowel != 'a' && isVocalized(letters)) {
+		return letters
+	}
+	return append(append(append([]letter{}, letters[:i+1]...), letter{harf: 'ا'}), letters[i+1:]...)
+}
+
+// pause returns letters read in pause: the last short vowel is dropped, tanwin of fatha becomes a long ā,
+// and ة becomes h.
+func pause(letters []letter) []letter {
+	letters = append([]letter{}, letters...)
+	k := len(letters) - 1
+	if k > 0 && (letters[k].harf == 'ا' || letters[k].harf == 'ى') && letters[k].bare() && letters[k-1].tanwin {
+		k--
+	}
+	switch lt := &letters[k]; {
+	case lt.harf == 'ة':
+		lt.vowel, lt.tanwin = 0, false
+	case lt.tanwin && lt.vowel == 'a' && k < len(letters)-1:
+		lt.tanwin = false
+	case lt.tanwin && lt.vowel == 'a':
+		lt.tanwin = false
+		letters = append(letters, letter{harf: 'ا'})
+	case lt.vowel != 0:
+		lt.vowel, lt.tanwin = 0, false
+	}
+	return letters
+}
+
+func (l Latinizer) latinizeWord(letters []letter, pausal bool) latinWord {
+	word := bareWord(letters)
+	if names, ok := divineNames[word]; ok {
+		text := names[0]
+		if l.style == LatinALALC {
+			text = names[1]
+		}
+		if last := letters[len(letters)-1]; last.harf == 'ه' && last.vowel != 0 && !pausal {
+			text += string(last.vowel)
+		}
+		return latinWord{text, (word == "الله" || word == "اللهم") && isVocalized(letters)}
+	}
+	if muqattaat[word] {
+		names := []string{}
+		for _, lt := range letters {
+			names = append(names, letterNames[lt.harf][int(l.style)])
+		}
+		return latinWord{text: strings.Join(names, " ")}
+	}
+	// words without harakat are rendered by consonants and plain long vowels, and never joined
+	longVowels, joinable := l.longVowels, isVocalized(letters)
+	if !joinable {
+		longVowels = plainLongVowels
+		if vocalized, ok := vocalizations[word]; ok {
+			letters = parseLetters(vocalized)
+		}
+	} else if pausal {
+		letters = pause(letters)
+	}
+	letters = withHiddenAlif(letters, word)
+
+	vocalized := isVocalized(letters)
+	w := latinWord{}
+	out := ""
+	prev := byte(0)
+	start := 0 // start of the stem, whose hamza is not written
+	noShadda := -1
+
+	// article, possibly preceded by a proclitic
+	isArticle := func(i int) bool {
+		return i+2 < len(letters) && letters[i].harf == 'ا' && letters[i].bare() &&
+			letters[i+1].harf == 'ل' && letters[i+1].vowel == 0 && !letters[i+1].shadda
+	}
+	proclitic := ""
+	switch {
+	case isArticle(0):
+		start = 2
+		w.wasla = joinable
+	case len(letters) > 1 && strings.ContainsRune("وفبك", letters[0].harf) && isArticle(1):
+		proclitic = l.consonants[letters[0].harf] + string(vowelOf(letters[0]))
+		start = 3
+	case len(letters) > 3 && letters[0].harf == 'ل' && letters[1].harf == 'ل' && letters[1].vowel == 0 &&
+		!letters[1].shadda && (letters[0].vowel == 'i' || !vocalized):
+		proclitic = "li"
+		start = 2
+	}
+	if start > 0 {
+		sun := ""
+		if letters[start].shadda {
+			sun = l.consonants[letters[start].harf]
+		}
+		switch {
+		case l.style == LatinALALC && proclitic == "li":
+			out = "lil-"
+		case l.style == LatinALALC && proclitic != "":
+			out = proclitic + "-al-"
+		case l.style == LatinALALC:
+			out = "al-"
+		case sun != "":
+			// the vowel of the article is elided after a proclitic, and its l assimilates to the sun letter
+			out = proclitic + sun + "-"
+			if proclitic == "" {
+				out = "a" + sun + "-"
+			}
+		default:
+			out = proclitic + "l-"
+			if proclitic == "" {
+				out = "al-"
+			}
+		}
+		noShadda = start
+	}
+
+	lengthen := func() {
+		out = out[:len(out)-1] + longVowels[prev]
+		prev = 0
+	}
+
+	for i := start; i < len(letters); i++ {
+		lt := letters[i]
+		bare := lt.bare()
+		switch {
+		case lt.harf == 'ا' || lt.harf == 'ى':
+			wasla := lt.harf == 'ا' && bare && i+1 < len(letters) && letters[i+1].sukun
+			switch {
+			case wasla && i == 0:
+				out += "i"
+				w.wasla = joinable
+			case wasla && l.style == LatinALALC:
+				out += "-i"
+			case wasla:
+			case prev == 'a' && bare:
+				lengthen()
+				continue
+			case i == 0 && lt.harf == 'ا' && bare && len(letters) > 1 && letters[1].harf == 'ل':
+				out += "a"
+				w.wasla = joinable
+			case i == 0 && lt.harf == 'ا' && bare:
+				out += "i"
+			case lt.vowel != 0:
+				out += string(lt.vowel)
+			case !vocalized && i > start:
+				out += longVowels['a']
+			}
+			prev = 0
+			continue
+		case lt.harf == 'آ':
+			if i > start {
+				out += l.hamza
+			}
+			out += longVowels['a']
+			prev = 0
+			continue
+		case (lt.harf == 'و' || lt.harf == 'ي') && (bare || (lt.sukun && l.style == LatinIndonesian && prev == 'a')):
+			long := byte('u')
+			if lt.harf == 'ي' {
+				long = 'i'
+			}
+			switch {
+			case prev == long && bare:
+				lengthen()
+			case lt.sukun:
+				out += string(long)
+			case vocalized || i == start:
+				out += l.consonants[lt.harf]
+			default:
+				out += longVowels[long]
+			}
+			prev = 0
+			continue
+		case lt.harf == 'ة' && lt.vowel == 0:
+			out += "h"
+			prev = 0
+			continue
+		case strings.ContainsRune("ءأإؤئ", lt.harf):
+			if i > start {
+				out += l.hamza
+			}
+			if !vocalized && i == start {
+				lt.vowel = 'a'
+				if lt.harf == 'إ' {
+					lt.vowel = 'i'
+				}
+			}
+		default:
+			consonant, ok := l.consonants[lt.harf]
+			if !ok {
+				consonant = string(lt.harf)
+			}
+			if lt.shadda && i > 0 && i != noShadda {
+				consonant += consonant
+			}
+			out += consonant
+		}
+
+		prev = 0
+		switch {
+		case lt.tanwin:
+			out += string(lt.vowel) + "n"
+		case lt.vowel != 0:
+			out += string(lt.vowel)
+			prev = lt.vowel
+		}
+	}
+	w.text = out
+	return w
+}
+
+// vowelOf returns vowel of proclitic lt, which is the usual one if lt has no harakat.
+func vowelOf(lt letter) byte {
+	switch {
+	case lt.vowel != 0:
+		return lt.vowel
+	case lt.harf == 'ب':
+		return 'i'
+	}
+	return 'a'
+}
@@ -0,0 +1,71 @@
+package quranize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatinizeEmptyString(t *testing.T) {
+	input := ""
+	expected := ""
+	actual := NewLatinizer(LatinIndonesian).Latinize(input)
+	assert.Equal(t, expected, actual)
+}
+
+func TestLatinizeIndonesian(t *testing.T) {
+	testCases := map[string]string{
+		"بِسْمِ اللَّهِ الرَّحْمَنِ الرَّحِيمِ": "bismillahir-rahmanir-rahim",
+		"الْحَمْدُ لِلَّهِ رَبِّ الْعَالَمِينَ": "al-hamdu lillahi rabbil-'alamin",
+		"الْعَالَمِينَ":                           "al-'alamin",
+		"قُلْ هُوَ اللَّهُ أَحَدٌ":                "qul huwallahu ahad",
+		"صِرَاطَ الَّذِينَ أَنْعَمْتَ عَلَيْهِمْ": "shirathalladzina an'amta 'alaihim",
+		"فِي السَّمَاوَاتِ وَالْأَرْضَ":           "fis-samawati wal-ardh",
+		"هُدًى لِّلْمُتَّقِينَ":                   "hudan lil-muttaqin",
+		"ص وَالْقُرْآنِ ذِي الذِّكْرِ":            "shad wal-qur'ani dzidz-dzikr",
+	}
+	latinizer := NewLatinizer(LatinIndonesian)
+	for input, expected := range testCases {
+		actual := latinizer.Latinize(input)
+		assert.Equalf(t, expected, actual, "input = %#v", input)
+	}
+}
+
+func TestLatinizeALALC(t *testing.T) {
+	testCases := map[string]string{
+		"بِسْمِ اللَّهِ الرَّحْمَنِ الرَّحِيمِ": "bismi Allāhi al-raḥmāni al-raḥīmi",
+		"الْحَمْدُ لِلَّهِ رَبِّ الْعَالَمِينَ": "al-ḥamdu lillāhi rabbi al-ʻālamīna",
+		"مَالِكِ يَوْمِ الدِّينِ":               "māliki yawmi al-dīni",
+		"قُلْ هُوَ اللَّهُ أَحَدٌ":              "qul huwa Allāhu aḥadun",
+		"فِي السَّمَاوَاتِ وَالْأَرْضَ":         "fī al-samāwāti wa-al-arḍa",
+		"وَاخْتِلَافِ اللَّيْلِ":                "wa-ikhtilāfi al-layli",
+		"هُدًى لِّلْمُتَّقِينَ":                 "hudan lil-muttaqīna",
+		"كهيعص": "kāf hā yā ʻayn ṣād",
+	}
+	latinizer := NewLatinizer(LatinALALC)
+	for input, expected := range testCases {
+		actual := latinizer.Latinize(input)
+		assert.Equalf(t, expected, actual, "input = %#v", input)
+	}
+}
+
+func TestLatinizeWithoutHarakat(t *testing.T) {
+	testCases := map[string]string{
+		"الحمد لله رب العالمين": "al-hmd lillah rb al-'almin",
+		"الذي":     "alladzi",
+		"بسم الله": "bsm allah",
+		"الر تلك":  "alif lam ra tlk",
+	}
+	latinizer := NewLatinizer(LatinIndonesian)
+	for input, expected := range testCases {
+		actual := latinizer.Latinize(input)
+		assert.Equalf(t, expected, actual, "input = %#v", input)
+	}
+}
+
+func TestLatinizeMuqattaat(t *testing.T) {
+	aya, err := NewQuranSimpleEnhanced().GetAya(2, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "alif lam mim", NewLatinizer(LatinIndonesian).Latinize(aya))
+	assert.Equal(t, "alif lām mīm", NewLatinizer(LatinALALC).Latinize(aya))
+}
@@ -4,6 +4,16 @@ import (
 	"fmt"
 )
 
+// suraAyas is number of ayas of every sura, used by Location navigation.
+var suraAyas = [...]int{
+	7, 286, 200, 176, 120, 165, 206, 75, 129, 109, 123, 111, 43, 52, 99, 128, 111, 110, 98,
+	135, 112, 78, 118, 64, 77, 227, 93, 88, 69, 60, 34, 30, 73, 54, 45, 83, 182, 88,
+	75, 85, 54, 53, 89, 59, 37, 35, 38, 29, 18, 45, 60, 49, 62, 55, 78, 96, 29,
+	22, 24, 13, 14, 11, 11, 18, 12, 12, 30, 52, 52, 44, 28, 28, 20, 56, 40, 31,
+	50, 40, 46, 42, 29, 19, 36, 25, 22, 17, 19, 26, 30, 20, 15, 21, 11, 8, 8,
+	19, 5, 8, 8, 11, 11, 8, 3, 9, 5, 4, 7, 3, 6, 3, 5, 4, 5, 6,
+}
+
 // Location represents a location in Quran.
 type Location struct {
 	sura      uint8
@@ -35,3 +45,76 @@ func (l Location) GetWordIndex() int {
 func (l Location) String() string {
 	return fmt.Sprintf("{%d %d %d}", l.GetSura(), l.GetAya(), l.GetWordIndex())
 }
+
+// before reports whether location l comes before location o in Quran.
+func (l Location) before(o Location) bool {
+	if l.sura != o.sura {
+		return l.sura < o.sura
+	}
+	if l.aya != o.aya {
+		return l.aya < o.aya
+	}
+	return l.wordIndex < o.wordIndex
+}
+
+// NewLocationFromOrdinal returns location of aya given its global ordinal (1 to 6236, 1 is 1:1 and 6236 is 114:6).
+func NewLocationFromOrdinal(ordinal int) (Location, error) {
+	aya := ordinal
+	for s := 0; aya >= 1 && s < len(suraAyas); s++ {
+		if aya <= suraAyas[s] {
+			return NewLocation(s+1, aya, 0), nil
+		}
+		aya -= suraAyas[s]
+	}
+	return Location{}, &LocationError{Ordinal: ordinal, Err: ErrInvalidAya}
+}
+
+// Ordinal returns global ordinal of aya of this location (1 to 6236).
+func (l Location) Ordinal() (int, error) {
+	if err := l.validate(); err != nil {
+		return 0, err
+	}
+	ordinal := int(l.aya)
+	for s := 0; s < int(l.sura)-1; s++ {
+		ordinal += suraAyas[s]
+	}
+	return ordinal, nil
+}
+
+// Next returns location of the aya after aya of this location, continuing to the next sura.
+func (l Location) Next() (Location, error) {
+	if err := l.validate(); err != nil {
+		return Location{}, err
+	}
+	if int(l.aya) < suraAyas[l.sura-1] {
+		return Location{l.sura, l.aya + 1, 0}, nil
+	}
+	if int(l.sura) == len(suraAyas) {
+		return Location{}, &LocationError{Sura: int(l.sura) + 1, Err: ErrInvalidSura}
+	}
+	return Location{l.sura + 1, 1, 0}, nil
+}
+
+// Prev returns location of the aya before aya of this location, continuing to the previous sura.
+func (l Location) Prev() (Location, error) {
+	if err := l.validate(); err != nil {
+		return Location{}, err
+	}
+	if l.aya > 1 {
+		return Location{l.sura, l.aya - 1, 0}, nil
+	}
+	if l.sura == 1 {
+		return Location{}, &LocationError{Sura: 0, Err: ErrInvalidSura}
+	}
+	return Location{l.sura - 1, uint16(suraAyas[l.sura-2]), 0}, nil
+}
+
+func (l Location) validate() error {
+	if !(1 <= int(l.sura) && int(l.sura) <= len(suraAyas)) {
+		return &LocationError{Sura: int(l.sura), Err: ErrInvalidSura}
+	}
+	if !(1 <= int(l.aya) && int(l.aya) <= suraAyas[l.sura-1]) {
+		return &LocationError{Sura: int(l.sura), Aya: int(l.aya), Err: ErrInvalidAya}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package quranize
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -23,3 +25,67 @@ func TestLocationGetWordIndex(t *testing.T) {
 	actual := NewLocation(2, 286, 0).GetWordIndex()
 	assert.Equal(t, expected, actual)
 }
+
+func TestSuraAyas(t *testing.T) {
+	q := NewQuranSimpleClean()
+	for s, sura := range q.Suras {
+		assert.Equal(t, len(sura.Ayas), suraAyas[s])
+	}
+}
+
+func TestLocationOrdinal(t *testing.T) {
+	testCases := map[Location]int{
+		NewLocation(1, 1, 0):   1,
+		NewLocation(1, 7, 3):   7,
+		NewLocation(2, 1, 0):   8,
+		NewLocation(2, 255, 0): 262,
+		NewLocation(114, 6, 0): 6236,
+	}
+	for input, expected := range testCases {
+		actual, err := input.Ordinal()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		l, err := NewLocationFromOrdinal(expected)
+		assert.NoError(t, err)
+		assert.Equal(t, NewLocation(input.GetSura(), input.GetAya(), 0), l)
+	}
+
+	_, err := NewLocation(1, 8, 0).Ordinal()
+	assert.True(t, errors.Is(err, ErrInvalidAya))
+	_, err = NewLocation(115, 1, 0).Ordinal()
+	assert.True(t, errors.Is(err, ErrInvalidSura))
+	for _, ordinal := range []int{0, 6237} {
+		_, err = NewLocationFromOrdinal(ordinal)
+		assert.True(t, errors.Is(err, ErrInvalidAya))
+		assert.EqualError(t, err, fmt.Sprintf("invalid aya ordinal %d", ordinal))
+	}
+}
+
+func TestLocationNextPrev(t *testing.T) {
+	testCases := [][2]Location{
+		{NewLocation(1, 1, 0), NewLocation(1, 2, 0)},
+		{NewLocation(1, 7, 0), NewLocation(2, 1, 0)},
+		{NewLocation(2, 286, 0), NewLocation(3, 1, 0)},
+		{NewLocation(114, 5, 0), NewLocation(114, 6, 0)},
+	}
+	for _, tc := range testCases {
+		next, err := tc[0].Next()
+		assert.NoError(t, err)
+		assert.Equal(t, tc[1], next)
+		prev, err := tc[1].Prev()
+		assert.NoError(t, err)
+		assert.Equal(t, tc[0], prev)
+	}
+
+	next, err := NewLocation(2, 5, 3).Next()
+	assert.NoError(t, err)
+	assert.Equal(t, NewLocation(2, 6, 0), next)
+
+	_, err = NewLocation(114, 6, 0).Next()
+	assert.True(t, errors.Is(err, ErrInvalidSura))
+	_, err = NewLocation(1, 1, 0).Prev()
+	assert.True(t, errors.Is(err, ErrInvalidSura))
+	_, err = NewLocation(1, 0, 0).Next()
+	assert.True(t, errors.Is(err, ErrInvalidAya))
+}
@@ -0,0 +1,212 @@
+package quranize
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	"github.com/alpancs/quranize/corpus/qurandata"
+)
+
+// Metadata stores structural information of Quran in Tanzil quran-data format:
+// sura information, divisions (juz, hizb, rub, manzil, ruku, page), and sajda positions.
+type Metadata struct {
+	Suras  []SuraInfo
+	Sajdas []Sajda
+	starts map[Division][]Location
+}
+
+// SuraInfo is metadata of a sura.
+type SuraInfo struct {
+	Number      int            // sura number, starting from 1
+	Ayas        int            // number of ayas
+	Start       int            // number of ayas before this sura
+	Name        string         // arabic name, e.g. "الفاتحة"
+	LatinName   string         // latin transliterated name, e.g. "Al-Faatiha"
+	EnglishName string         // english name, e.g. "The Opening"
+	Type        RevelationType // place of revelation
+	Order       int            // chronological revelation order, starting from 1
+	Rukus       int            // number of rukus
+}
+
+// RevelationType is a place of revelation of a sura.
+type RevelationType string
+
+// Available revelation types.
+const (
+	Meccan  RevelationType = "Meccan"
+	Medinan RevelationType = "Medinan"
+)
+
+// Sajda is a prostration position in Quran.
+type Sajda struct {
+	Location Location
+	Type     SajdaType
+}
+
+// SajdaType is a kind of sajda.
+type SajdaType string
+
+// Available sajda types.
+const (
+	SajdaRecommended SajdaType = "recommended"
+	SajdaObligatory  SajdaType = "obligatory"
+)
+
+// Division is a way of dividing Quran into numbered parts.
+type Division int
+
+// Available divisions.
+const (
+	DivisionJuz    Division = iota + 1 // 30 parts
+	DivisionHizb                       // 60 halves of juz
+	DivisionRub                        // 240 quarters of hizb
+	DivisionManzil                     // 7 stations
+	DivisionRuku                       // sections
+	DivisionPage                       // pages of Madinah mushaf
+)
+
+type metadataXML struct {
+	Suras []struct {
+		Index int    `xml:"index,attr"`
+		Ayas  int    `xml:"ayas,attr"`
+		Start int    `xml:"start,attr"`
+		Name  string `xml:"name,attr"`
+		TName string `xml:"tname,attr"`
+		EName string `xml:"ename,attr"`
+		Type  string `xml:"type,attr"`
+		Order int    `xml:"order,attr"`
+		Rukus int    `xml:"rukus,attr"`
+	} `xml:"suras>sura"`
+	Juzs     []divisionXML `xml:"juzs>juz"`
+	Quarters []divisionXML `xml:"hizbs>quarter"`
+	Manzils  []divisionXML `xml:"manzils>manzil"`
+	Rukus    []divisionXML `xml:"rukus>ruku"`
+	Pages    []divisionXML `xml:"pages>page"`
+	Sajdas   []struct {
+		divisionXML
+		Type string `xml:"type,attr"`
+	} `xml:"sajdas>sajda"`
+}
+
+type divisionXML struct {
+	Sura int `xml:"sura,attr"`
+	Aya  int `xml:"aya,attr"`
+}
+
+// NewMetadata returns new Metadata using corpus:
+//  qurandata.XML()
+// See https://github.com/alpancs/quranize/tree/master/corpus/qurandata.
+func NewMetadata() Metadata {
+	m, _ := ParseMetadata(qurandata.XML())
+	return m
+}
+
+// ParseMetadata returns Metadata from given raw Tanzil quran-data XML.
+func ParseMetadata(raw string) (Metadata, error) {
+	var x metadataXML
+	if err := xml.Unmarshal([]byte(raw), &x); err != nil {
+		return Metadata{}, err
+	}
+
+	m := Metadata{starts: make(map[Division][]Location)}
+	for i, s := range x.Suras {
+		if s.Index != i+1 || s.Ayas <= 0 {
+			return Metadata{}, fmt.Errorf("invalid sura metadata %d", s.Index)
+		}
+		m.Suras = append(m.Suras, SuraInfo{
+			s.Index, s.Ayas, s.Start, s.Name, s.TName, s.EName, RevelationType(s.Type), s.Order, s.Rukus,
+		})
+	}
+
+	hizbs := []divisionXML{}
+	for i := 0; i < len(x.Quarters); i += 4 {
+		hizbs = append(hizbs, x.Quarters[i])
+	}
+	for d, divisions := range map[Division][]divisionXML{
+		DivisionJuz: x.Juzs, DivisionHizb: hizbs, DivisionRub: x.Quarters,
+		DivisionManzil: x.Manzils, DivisionRuku: x.Rukus, DivisionPage: x.Pages,
+	} {
+		for i, division := range divisions {
+			l := NewLocation(division.Sura, division.Aya, 0)
+			if !m.valid(l) || (i > 0 && !m.starts[d][i-1].before(l)) {
+				return Metadata{}, fmt.Errorf("invalid division start %v", l)
+			}
+			m.starts[d] = append(m.starts[d], l)
+		}
+	}
+
+	for _, s := range x.Sajdas {
+		l := NewLocation(s.Sura, s.Aya, 0)
+		if !m.valid(l) {
+			return Metadata{}, fmt.Errorf("invalid sajda %v", l)
+		}
+		m.Sajdas = append(m.Sajdas, Sajda{l, SajdaType(s.Type)})
+	}
+	return m, nil
+}
+
+// Sura returns metadata of sura number (starting from 1).
+func (m Metadata) Sura(sura int) (SuraInfo, error) {
+	if !(1 <= sura && sura <= len(m.Suras)) {
+		return SuraInfo{}, &LocationError{Sura: sura, Err: ErrInvalidSura}
+	}
+	return m.Suras[sura-1], nil
+}
+
+// Count returns number of parts of division d, zero if m has no such division.
+func (m Metadata) Count(d Division) int {
+	return len(m.starts[d])
+}
+
+// Find returns number (starting from 1) of the part of division d containing aya of location l,
+// or zero if m has no such division or l is out of Quran.
+func (m Metadata) Find(d Division, l Location) int {
+	l.wordIndex = 0
+	if !m.valid(l) {
+		return 0
+	}
+	starts := m.starts[d]
+	return sort.Search(len(starts), func(i int) bool { return l.before(starts[i]) })
+}
+
+// Ayas returns locations of every aya in part number n (starting from 1) of division d, e.g. ayas of juz 30:
+//  m.Ayas(DivisionJuz, 30)
+func (m Metadata) Ayas(d Division, n int) ([]Location, error) {
+	starts := m.starts[d]
+	if !(1 <= n && n <= len(starts)) {
+		return nil, fmt.Errorf("invalid division number %d", n)
+	}
+
+	locations := []Location{}
+	end := Location{}
+	if n < len(starts) {
+		end = starts[n]
+	}
+	for l := starts[n-1]; l != end && m.valid(l); l = m.next(l) {
+		locations = append(locations, l)
+	}
+	return locations, nil
+}
+
+// Sajda returns sajda at aya of location l, if any.
+func (m Metadata) Sajda(l Location) (Sajda, bool) {
+	for _, sajda := range m.Sajdas {
+		if sajda.Location.sura == l.sura && sajda.Location.aya == l.aya {
+			return sajda, true
+		}
+	}
+	return Sajda{}, false
+}
+
+func (m Metadata) valid(l Location) bool {
+	return 1 <= l.sura && int(l.sura) <= len(m.Suras) && 1 <= l.aya && int(l.aya) <= m.Suras[l.sura-1].Ayas
+}
+
+// next returns location of the aya after aya of location l.
+func (m Metadata) next(l Location) Location {
+	if int(l.aya) < m.Suras[l.sura-1].Ayas {
+		return Location{l.sura, l.aya + 1, 0}
+	}
+	return Location{l.sura + 1, 1, 0}
+}
@@ -0,0 +1,165 @@
+package quranize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var metadataTest = NewMetadata()
+
+func TestNewMetadata(t *testing.T) {
+	assert.Len(t, metadataTest.Suras, 114)
+	assert.Equal(t, 30, metadataTest.Count(DivisionJuz))
+	assert.Equal(t, 60, metadataTest.Count(DivisionHizb))
+	assert.Equal(t, 240, metadataTest.Count(DivisionRub))
+	assert.Equal(t, 7, metadataTest.Count(DivisionManzil))
+	assert.Equal(t, 604, metadataTest.Count(DivisionPage))
+	assert.Len(t, metadataTest.Sajdas, 15)
+
+	quran := NewQuranSimpleClean()
+	total, rukus := 0, 0
+	for i, sura := range metadataTest.Suras {
+		assert.Equal(t, i+1, sura.Number)
+		assert.Equal(t, len(quran.Suras[i].Ayas), sura.Ayas)
+		assert.Equal(t, quran.Suras[i].Name, sura.Name)
+		assert.Equal(t, total, sura.Start)
+		assert.Equal(t, rukus+1, metadataTest.Find(DivisionRuku, NewLocation(sura.Number, 1, 0)))
+		total += sura.Ayas
+		rukus += sura.Rukus
+	}
+	assert.Equal(t, 6236, total)
+	assert.Equal(t, rukus, metadataTest.Count(DivisionRuku))
+
+	for juz := 1; juz <= 30; juz++ {
+		juzAyas, _ := metadataTest.Ayas(DivisionJuz, juz)
+		hizbAyas, _ := metadataTest.Ayas(DivisionHizb, 2*juz-1)
+		rubAyas, _ := metadataTest.Ayas(DivisionRub, 8*juz-7)
+		assert.Equal(t, juzAyas[0], hizbAyas[0])
+		assert.Equal(t, juzAyas[0], rubAyas[0])
+	}
+}
+
+func TestMetadataSura(t *testing.T) {
+	expected := SuraInfo{1, 7, 0, "الفاتحة", "Al-Faatiha", "The Opening", Meccan, 5, 1}
+	actual, err := metadataTest.Sura(1)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	actual, err = metadataTest.Sura(2)
+	assert.NoError(t, err)
+	assert.Equal(t, Medinan, actual.Type)
+	assert.Equal(t, 87, actual.Order)
+	assert.Equal(t, 40, actual.Rukus)
+
+	_, err = metadataTest.Sura(115)
+	assert.Error(t, err)
+}
+
+func TestMetadataFind(t *testing.T) {
+	testCases := map[Location]int{
+		NewLocation(1, 1, 0):   1,
+		NewLocation(2, 141, 3): 1,
+		NewLocation(2, 142, 0): 2,
+		NewLocation(18, 74, 0): 15,
+		NewLocation(18, 75, 0): 16,
+		NewLocation(114, 6, 0): 30,
+		NewLocation(114, 7, 0): 0,
+		NewLocation(0, 1, 0):   0,
+	}
+	for input, expected := range testCases {
+		assert.Equal(t, expected, metadataTest.Find(DivisionJuz, input), input.String())
+	}
+
+	testCases = map[Location]int{
+		NewLocation(1, 7, 0):   1,
+		NewLocation(2, 1, 0):   2,
+		NewLocation(2, 5, 0):   2,
+		NewLocation(2, 6, 0):   3,
+		NewLocation(2, 142, 0): 22,
+		NewLocation(3, 1, 0):   50,
+		NewLocation(36, 1, 0):  440,
+		NewLocation(114, 6, 0): 604,
+	}
+	for input, expected := range testCases {
+		assert.Equal(t, expected, metadataTest.Find(DivisionPage, input), input.String())
+	}
+	assert.Equal(t, 1, metadataTest.Find(DivisionHizb, NewLocation(2, 74, 0)))
+	assert.Equal(t, 2, metadataTest.Find(DivisionHizb, NewLocation(2, 75, 0)))
+	assert.Equal(t, 5, metadataTest.Find(DivisionRub, NewLocation(2, 75, 0)))
+	assert.Equal(t, 240, metadataTest.Find(DivisionRub, NewLocation(114, 1, 0)))
+	assert.Equal(t, 2, metadataTest.Find(DivisionRuku, NewLocation(2, 7, 0)))
+	assert.Equal(t, 3, metadataTest.Find(DivisionRuku, NewLocation(2, 8, 0)))
+}
+
+func TestMetadataAyas(t *testing.T) {
+	ayas, err := metadataTest.Ayas(DivisionJuz, 30)
+	assert.NoError(t, err)
+	assert.Len(t, ayas, 564)
+	assert.Equal(t, NewLocation(78, 1, 0), ayas[0])
+	assert.Equal(t, NewLocation(114, 6, 0), ayas[len(ayas)-1])
+
+	ayas, err = metadataTest.Ayas(DivisionJuz, 1)
+	assert.NoError(t, err)
+	assert.Len(t, ayas, 148)
+	assert.Equal(t, NewLocation(2, 141, 0), ayas[len(ayas)-1])
+
+	ayas, err = metadataTest.Ayas(DivisionPage, 1)
+	assert.NoError(t, err)
+	assert.Len(t, ayas, 7)
+
+	ayas, err = metadataTest.Ayas(DivisionPage, 604)
+	assert.NoError(t, err)
+	assert.Equal(t, NewLocation(112, 1, 0), ayas[0])
+	assert.Equal(t, NewLocation(114, 6, 0), ayas[len(ayas)-1])
+
+	_, err = metadataTest.Ayas(DivisionJuz, 31)
+	assert.Error(t, err)
+	_, err = metadataTest.Ayas(DivisionPage, 605)
+	assert.Error(t, err)
+}
+
+func TestMetadataSajda(t *testing.T) {
+	sajda, ok := metadataTest.Sajda(NewLocation(32, 15, 2))
+	assert.True(t, ok)
+	assert.Equal(t, Sajda{NewLocation(32, 15, 0), SajdaObligatory}, sajda)
+
+	_, ok = metadataTest.Sajda(NewLocation(32, 14, 0))
+	assert.False(t, ok)
+}
+
+func TestParseMetadata(t *testing.T) {
+	raw := `<quran type="metadata">
+		<suras><sura index="1" ayas="7" start="0" name="الفاتحة" /><sura index="2" ayas="286" start="7" name="البقرة" /></suras>
+		<hizbs>
+			<quarter index="1" sura="1" aya="1" /><quarter index="2" sura="2" aya="26" />
+			<quarter index="3" sura="2" aya="44" /><quarter index="4" sura="2" aya="60" />
+			<quarter index="5" sura="2" aya="75" />
+		</hizbs>
+		<pages><page index="1" sura="1" aya="1" /><page index="2" sura="2" aya="1" /></pages>
+	</quran>`
+	m, err := ParseMetadata(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, m.Count(DivisionRub))
+	assert.Equal(t, 2, m.Count(DivisionHizb))
+	assert.Equal(t, 2, m.Find(DivisionHizb, NewLocation(2, 75, 0)))
+	assert.Equal(t, 4, m.Find(DivisionRub, NewLocation(2, 74, 0)))
+	assert.Equal(t, 2, m.Find(DivisionPage, NewLocation(2, 1, 0)))
+
+	ayas, err := m.Ayas(DivisionPage, 1)
+	assert.NoError(t, err)
+	assert.Len(t, ayas, 7)
+}
+
+func TestParseMetadataInvalid(t *testing.T) {
+	for _, raw := range []string{
+		`<quran`,
+		`<quran><suras><sura index="2" ayas="7" /></suras></quran>`,
+		`<quran><suras><sura index="1" ayas="7" /></suras><juzs><juz sura="1" aya="8" /></juzs></quran>`,
+		`<quran><suras><sura index="1" ayas="7" /></suras><pages><page sura="1" aya="2" /><page sura="1" aya="1" /></pages></quran>`,
+		`<quran><suras><sura index="1" ayas="7" /></suras><sajdas><sajda sura="2" aya="1" /></sajdas></quran>`,
+	} {
+		_, err := ParseMetadata(raw)
+		assert.Error(t, err, raw)
+	}
+}
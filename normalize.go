@@ -0,0 +1,170 @@
+package quranize
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Normalizer folds differences of arabic text copied from websites or documents,
+// so the same verse is found regardless of them.
+// Whitespaces are always collapsed into a single space, and invisible formatting characters are always removed.
+type Normalizer struct {
+	Harakat           bool // remove harakat, superscript alif, and quranic annotation signs
+	Tatweel           bool // remove tatweel (ـ)
+	Alif              bool // fold أ, إ, آ, and ٱ into ا
+	AlifMaqsura       bool // fold ى (and farsi ی) into ي
+	TaMarbuta         bool // fold ة into ه
+	PresentationForms bool // replace presentation forms (e.g. ﺑ or ﻻ) with their letters
+}
+
+// DefaultNormalizer folds every difference.
+var DefaultNormalizer = Normalizer{
+	Harakat:           true,
+	Tatweel:           true,
+	Alif:              true,
+	AlifMaqsura:       true,
+	TaMarbuta:         true,
+	PresentationForms: true,
+}
+
+// Normalize returns s normalized by n.
+func (n Normalizer) Normalize(s string) string {
+	var b strings.Builder
+	space := false
+	write := func(r rune) {
+		r = n.fold(r)
+		switch {
+		case r < 0:
+		case r == ' ':
+			space = b.Len() > 0
+		default:
+			if space {
+				b.WriteByte(' ')
+				space = false
+			}
+			b.WriteRune(r)
+		}
+	}
+	for _, r := range s {
+		if form, ok := presentationForms[r]; ok && n.PresentationForms {
+			for _, r := range form {
+				write(r)
+			}
+			continue
+		}
+		write(r)
+	}
+	return b.String()
+}
+
+// fold returns folded rune r, ' ' for whitespaces, or -1 if r is removed.
+func (n Normalizer) fold(r rune) rune {
+	switch {
+	case unicode.IsSpace(r):
+		return ' '
+	case isFormat(r):
+		return -1
+	case n.Harakat && isHarakat(r):
+		return -1
+	case n.Tatweel && r == 'ـ':
+		return -1
+	case n.Alif && (r == 'أ' || r == 'إ' || r == 'آ' || r == 'ٱ'):
+		return 'ا'
+	case n.AlifMaqsura && (r == 'ى' || r == 'ی'):
+		return 'ي'
+	case n.TaMarbuta && r == 'ة':
+		return 'ه'
+	}
+	return r
+}
+
+func isHarakat(r rune) bool {
+	return (r >= 0x0610 && r <= 0x061A) || (r >= 0x064B && r <= 0x065F) || r == 0x0670 ||
+		(r >= 0x06D6 && r <= 0x06ED && r != 0x06E5 && r != 0x06E6)
+}
+
+func isFormat(r rune) bool {
+	return r == 0x00AD || r == 0x061C || r == 0xFEFF ||
+		(r >= 0x200B && r <= 0x200F) || (r >= 0x202A && r <= 0x202E) || (r >= 0x2066 && r <= 0x2069)
+}
+
+// normalizedIndex is index of Quran normalized by normalizer of Quranize, built on first use.
+type normalizedIndex struct {
+	once  sync.Once
+	index *trie
+}
+
+// WithNormalizer returns Option to use Normalizer n in LocateNormalized instead of DefaultNormalizer.
+func WithNormalizer(n Normalizer) Option {
+	return func(q *Quranize) {
+		q.normalizer = n
+	}
+}
+
+// LocateNormalized is like Locate, but both s and the indexed Quran are normalized,
+// e.g. "الْحَمْدُ لِلَّهِ" and "ﺍﻟﺤﻤﺪ ﻟﻠﻪ" are located as "الحمد لله".
+//
+// Index of normalized Quran is built on first call.
+func (q Quranize) LocateNormalized(s string) []Location {
+	index := q.normalizedIndex()
+	if index == nil {
+		return zeroLocs
+	}
+	n := index.find(q.normalizer.Normalize(s))
+	if n < 0 {
+		return zeroLocs
+	}
+	return index.locations(n)
+}
+
+func (q Quranize) normalizedIndex() *trie {
+	if q.normalized == nil {
+		return nil
+	}
+	q.normalized.once.Do(func() {
+		root := &node{}
+		for s, sura := range q.q.Suras {
+			if q.bismillah && len(sura.Ayas) > 0 && sura.Ayas[0].Bismillah != "" {
+				root.indexWords(q.normalizeWords(sura.Ayas[0].Bismillah), s+1, 0)
+			}
+			for a, aya := range sura.Ayas {
+				root.indexWords(q.normalizeWords(aya.Text), s+1, a+1)
+			}
+		}
+		q.normalized.index = newTrie(root)
+	})
+	return q.normalized.index
+}
+
+// normalizeWords returns normalized words of text, a word is empty if it is removed entirely.
+func (q Quranize) normalizeWords(text string) []string {
+	words := strings.Split(text, " ")
+	for i, word := range words {
+		words[i] = q.normalizer.Normalize(word)
+	}
+	return words
+}
+
+// indexWords indexes every suffix of words starting from a non-empty word,
+// located at its word index in the original text.
+func (n *node) indexWords(words []string, sura, aya int) {
+	harfs, starts := []rune{}, []int{}
+	for _, word := range words {
+		starts = append(starts, -1)
+		if word == "" {
+			continue
+		}
+		if len(harfs) > 0 {
+			harfs = append(harfs, ' ')
+		}
+		starts[len(starts)-1] = len(harfs)
+		harfs = append(harfs, []rune(word)...)
+	}
+	marks := make([]uint8, len(harfs))
+	for wordIndex, start := range starts {
+		if start >= 0 {
+			n.buildTree(harfs[start:], marks[start:], NewLocation(sura, aya, wordIndex))
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package quranize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct{ input, expected string }{
+		{"الْحَمْدُ لِلَّهِ", "الحمد لله"},
+		{"الحمــــد", "الحمد"},
+		{"ﺍﻟﺤﻤﺪ ﻟﻠﻪ", "الحمد لله"},
+		{"ﻻ ﷲ", "لا الله"},
+		{"أَإِآٱ", "اااا"},
+		{"علىٰ", "علي"},
+		{"الصلاة", "الصلاه"},
+		{" بسم  الله‏\n", "بسم الله"},
+		{"ذَٰلِكَ ۚ", "ذلك"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, DefaultNormalizer.Normalize(tc.input), tc.input)
+	}
+
+	assert.Equal(t, "الحمد", Normalizer{}.Normalize("الحمد"))
+	assert.Equal(t, "أَ", Normalizer{}.Normalize(" أَ‎"))
+	assert.Equal(t, "أ", Normalizer{Harakat: true}.Normalize("أَ"))
+}
+
+func TestLocateNormalized(t *testing.T) {
+	enhanced, _ := NewQuranSimpleEnhanced().GetAya(1, 2)
+	expected := quranizeTest.Locate("الحمد لله رب العالمين")
+	assert.Equal(t, expected, quranizeTest.LocateNormalized(enhanced))
+	assert.Equal(t, expected, quranizeTest.LocateNormalized("ﺍﻟﺤﻤﺪ ﻟﻠﻪ  رب العــالمين"))
+
+	assert.Equal(t, quranizeTest.Locate("الصلاة"), quranizeTest.LocateNormalized("الصلاه"))
+	folded := quranizeTest.LocateNormalized("ان الله")
+	for _, l := range append(quranizeTest.Locate("إن الله"), quranizeTest.Locate("أن الله")...) {
+		assert.Contains(t, folded, l)
+	}
+	assert.Equal(t, zeroLocs, quranizeTest.LocateNormalized(""))
+	assert.Equal(t, zeroLocs, quranizeTest.LocateNormalized("alfan"))
+	assert.Equal(t, zeroLocs, Quranize{}.LocateNormalized("الحمد لله"))
+
+	q := NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), WithNormalizer(Normalizer{Harakat: true}))
+	assert.Equal(t, expected, q.LocateNormalized(enhanced))
+	assert.Equal(t, zeroLocs, q.LocateNormalized("الصلاه"))
+}
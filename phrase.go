@@ -0,0 +1,183 @@
+package quranize
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Tokens of phrase queries.
+const (
+	gapToken      = "..."
+	ellipsisToken = "…"
+	wildcardToken = "*"
+)
+
+// PhraseResult is an occurrence of a phrase query in Quran.
+type PhraseResult struct {
+	Range     Range      // from the first word to the last word of the occurrence, including wildcard words at its edges
+	Texts     []string   // arabic of every part of the query
+	Locations []Location // location of the first word of every part of the query
+	Score     float64    // sum of ranking scores of every part, see EncodeResult
+}
+
+// phrasePart is a part of a phrase query, preceded by a gap of min to max words.
+type phrasePart struct {
+	text     string
+	min, max int
+}
+
+// phraseCandidate is an occurrence of a part of a phrase query.
+type phraseCandidate struct {
+	text  string
+	score float64
+	start Location
+	end   Location
+}
+
+type ayaKey struct {
+	sura, aya int
+}
+
+// QueryPhrase returns occurrences of phrase query s within an aya, sorted best-first, then by location.
+//
+// Parts of s are alphabet (encoded like Encode) or arabic (located like LocateNormalized), separated by gaps:
+// "..." or "…" is a gap of zero to maxGap words, and "*" is exactly one word, e.g.
+//  q.QueryPhrase("bismillah … rohim", 2)
+//  q.QueryPhrase("innallaha * 'alim", 0)
+func (q Quranize) QueryPhrase(s string, maxGap int) []PhraseResult {
+	results := []PhraseResult{}
+	parts, last := parsePhrase(s, maxGap)
+	if len(parts) == 0 {
+		return results
+	}
+
+	candidates := make([]map[ayaKey][]phraseCandidate, len(parts))
+	for i, part := range parts {
+		if candidates[i] = q.phraseCandidates(part.text); len(candidates[i]) == 0 {
+			return results
+		}
+	}
+
+	for _, first := range candidates[0] {
+		for _, c := range first {
+			if c.start.GetWordIndex() < parts[0].min {
+				continue
+			}
+			q.joinPhrase(parts, candidates, last, []phraseCandidate{c}, &results)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		a, b := results[i].Range, results[j].Range
+		if a.Start != b.Start {
+			return a.Start.before(b.Start)
+		}
+		return a.End.before(b.End)
+	})
+	return results
+}
+
+// parsePhrase returns parts of phrase query s, and the minimum number of words following the last part.
+func parsePhrase(s string, maxGap int) ([]phrasePart, int) {
+	parts, words := []phrasePart{}, []string{}
+	min, max := 0, 0
+	flush := func() {
+		if len(words) > 0 {
+			parts = append(parts, phrasePart{strings.Join(words, " "), min, max})
+			words, min, max = words[:0], 0, 0
+		}
+	}
+	for _, token := range strings.Fields(strings.Replace(s, ellipsisToken, " "+gapToken+" ", -1)) {
+		switch token {
+		case gapToken:
+			flush()
+			max += maxGap
+		case wildcardToken:
+			flush()
+			min++
+			max++
+		default:
+			words = append(words, token)
+		}
+	}
+	flush()
+	return parts, min
+}
+
+// phraseCandidates returns occurrences of a part of phrase query grouped by aya of their first words.
+func (q Quranize) phraseCandidates(part string) map[ayaKey][]phraseCandidate {
+	type encoded struct {
+		text  string
+		score float64
+		ls    []Location
+	}
+	encodeds := []encoded{}
+	if isArabic(part) {
+		text := q.normalizer.Normalize(part)
+		encodeds = append(encodeds, encoded{text, 0, q.LocateNormalized(text)})
+	} else {
+		for _, result := range q.EncodeRanked(part) {
+			encodeds = append(encodeds, encoded{result.Text, result.Score, q.Locate(result.Text)})
+		}
+	}
+
+	candidates := make(map[ayaKey][]phraseCandidate)
+	for _, e := range encodeds {
+		words := strings.Count(e.text, " ")
+		for _, l := range e.ls {
+			c := phraseCandidate{e.text, e.score, l, q.advance(l, words)}
+			key := ayaKey{c.start.GetSura(), c.start.GetAya()}
+			candidates[key] = append(candidates[key], c)
+		}
+	}
+	return candidates
+}
+
+// joinPhrase appends results of every way the remaining parts follow the matched parts.
+func (q Quranize) joinPhrase(parts []phrasePart, candidates []map[ayaKey][]phraseCandidate, last int,
+	matched []phraseCandidate, results *[]PhraseResult) {
+	prev := matched[len(matched)-1]
+	sura, aya := prev.end.GetSura(), prev.end.GetAya()
+	if len(matched) == len(parts) {
+		if end := prev.end.GetWordIndex() + last; end < q.ayaWords(sura, aya) {
+			*results = append(*results, newPhraseResult(matched, parts[0].min, NewLocation(sura, aya, end)))
+		}
+		return
+	}
+
+	part := parts[len(matched)]
+	for _, c := range candidates[len(matched)][ayaKey{sura, aya}] {
+		gap := c.start.GetWordIndex() - prev.end.GetWordIndex() - 1
+		if gap >= part.min && gap <= part.max {
+			q.joinPhrase(parts, candidates, last, append(matched[:len(matched):len(matched)], c), results)
+		}
+	}
+}
+
+func newPhraseResult(matched []phraseCandidate, leading int, end Location) PhraseResult {
+	first := matched[0].start
+	r := PhraseResult{Range: Range{NewLocation(first.GetSura(), first.GetAya(), first.GetWordIndex()-leading), end}}
+	for _, c := range matched {
+		r.Texts = append(r.Texts, c.text)
+		r.Locations = append(r.Locations, c.start)
+		r.Score += c.score
+	}
+	return r
+}
+
+// ayaWords returns number of words of aya number in sura number.
+func (q Quranize) ayaWords(sura, aya int) int {
+	return strings.Count(q.q.text(sura, aya), " ") + 1
+}
+
+func isArabic(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Arabic, r) {
+			return true
+		}
+	}
+	return false
+}
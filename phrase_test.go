@@ -0,0 +1,70 @@
+package quranize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePhrase(t *testing.T) {
+	parts, last := parsePhrase(" * innallaha … * 'alim ... wa  hakim * *", 3)
+	expected := []phrasePart{{"innallaha", 1, 1}, {"'alim", 1, 4}, {"wa hakim", 0, 3}}
+	assert.Equal(t, expected, parts)
+	assert.Equal(t, 2, last)
+
+	parts, last = parsePhrase("* …", 3)
+	assert.Empty(t, parts)
+	assert.Equal(t, 1, last)
+}
+
+func TestQueryPhraseGap(t *testing.T) {
+	results := quranizeTest.QueryPhrase("bismillah … rohim", 2)
+	assert.Len(t, results, 2)
+	first := results[0]
+	assert.Equal(t, Range{NewLocation(1, 1, 0), NewLocation(1, 1, 3)}, first.Range)
+	assert.Equal(t, []string{"بسم الله", "الرحيم"}, first.Texts)
+	assert.Equal(t, []Location{NewLocation(1, 1, 0), NewLocation(1, 1, 3)}, first.Locations)
+	assert.Equal(t, Range{NewLocation(27, 30, 4), NewLocation(27, 30, 7)}, results[1].Range)
+
+	assert.Empty(t, quranizeTest.QueryPhrase("bismillah … rohim", 0))
+	assert.Len(t, quranizeTest.QueryPhrase("bismillah … … rohim", 1), 2)
+}
+
+func TestQueryPhraseWildcard(t *testing.T) {
+	results := quranizeTest.QueryPhrase("innallaha * 'alim", 0)
+	assert.NotEmpty(t, results)
+	for _, r := range results {
+		assert.Equal(t, r.Locations[0].GetWordIndex()+3, r.Range.End.GetWordIndex())
+		assert.Equal(t, r.Range.End, r.Locations[1])
+	}
+	found := false
+	for _, r := range results {
+		if r.Range == (Range{NewLocation(2, 115, 8), NewLocation(2, 115, 11)}) {
+			found = true
+			assert.Equal(t, []string{"إن الله", "عليم"}, r.Texts)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestQueryPhraseEdges(t *testing.T) {
+	for _, r := range quranizeTest.QueryPhrase("* alhamdulillah", 0) {
+		assert.Equal(t, r.Locations[0].GetWordIndex()-1, r.Range.Start.GetWordIndex())
+	}
+	results := quranizeTest.QueryPhrase("bismillah * *", 0)
+	assert.Len(t, results, len(quranizeTest.QueryPhrase("bismillah", 0)))
+	assert.Equal(t, Range{NewLocation(1, 1, 0), NewLocation(1, 1, 3)}, results[0].Range)
+	assert.Empty(t, quranizeTest.QueryPhrase("bismillahirrohmanirrohim *", 0))
+}
+
+func TestQueryPhraseArabic(t *testing.T) {
+	results := quranizeTest.QueryPhrase("بِسْمِ … الرَّحِيمِ", 2)
+	assert.Len(t, results, 2)
+	assert.Equal(t, []string{"بسم", "الرحيم"}, results[0].Texts)
+}
+
+func TestQueryPhraseNotFound(t *testing.T) {
+	for _, s := range []string{"", "*", "…", "alfan … rohim", "bismillah … alfan"} {
+		assert.Equal(t, []PhraseResult{}, quranizeTest.QueryPhrase(s, 2), s)
+	}
+}
@@ -0,0 +1,190 @@
+package quranize
+
+// presentationForms maps arabic presentation forms (U+FB50 to U+FDFF and U+FE70 to U+FEFF)
+// into their nominal letters, following their Unicode compatibility decompositions,
+// e.g. isolated, initial, medial, and final forms of ب into ب, ligature ﻻ into لا, and ligature ﷲ into الله.
+var presentationForms = map[rune]string{
+	0xFB50: "\u0671", 0xFB51: "\u0671", 0xFB52: "\u067B", 0xFB53: "\u067B",
+	0xFB54: "\u067B", 0xFB55: "\u067B", 0xFB56: "\u067E", 0xFB57: "\u067E",
+	0xFB58: "\u067E", 0xFB59: "\u067E", 0xFB5A: "\u0680", 0xFB5B: "\u0680",
+	0xFB5C: "\u0680", 0xFB5D: "\u0680", 0xFB5E: "\u067A", 0xFB5F: "\u067A",
+	0xFB60: "\u067A", 0xFB61: "\u067A", 0xFB62: "\u067F", 0xFB63: "\u067F",
+	0xFB64: "\u067F", 0xFB65: "\u067F", 0xFB66: "\u0679", 0xFB67: "\u0679",
+	0xFB68: "\u0679", 0xFB69: "\u0679", 0xFB6A: "\u06A4", 0xFB6B: "\u06A4",
+	0xFB6C: "\u06A4", 0xFB6D: "\u06A4", 0xFB6E: "\u06A6", 0xFB6F: "\u06A6",
+	0xFB70: "\u06A6", 0xFB71: "\u06A6", 0xFB72: "\u0684", 0xFB73: "\u0684",
+	0xFB74: "\u0684", 0xFB75: "\u0684", 0xFB76: "\u0683", 0xFB77: "\u0683",
+	0xFB78: "\u0683", 0xFB79: "\u0683", 0xFB7A: "\u0686", 0xFB7B: "\u0686",
+	0xFB7C: "\u0686", 0xFB7D: "\u0686", 0xFB7E: "\u0687", 0xFB7F: "\u0687",
+	0xFB80: "\u0687", 0xFB81: "\u0687", 0xFB82: "\u068D", 0xFB83: "\u068D",
+	0xFB84: "\u068C", 0xFB85: "\u068C", 0xFB86: "\u068E", 0xFB87: "\u068E",
+	0xFB88: "\u0688", 0xFB89: "\u0688", 0xFB8A: "\u0698", 0xFB8B: "\u0698",
+	0xFB8C: "\u0691", 0xFB8D: "\u0691", 0xFB8E: "\u06A9", 0xFB8F: "\u06A9",
+	0xFB90: "\u06A9", 0xFB91: "\u06A9", 0xFB92: "\u06AF", 0xFB93: "\u06AF",
+	0xFB94: "\u06AF", 0xFB95: "\u06AF", 0xFB96: "\u06B3", 0xFB97: "\u06B3",
+	0xFB98: "\u06B3", 0xFB99: "\u06B3", 0xFB9A: "\u06B1", 0xFB9B: "\u06B1",
+	0xFB9C: "\u06B1", 0xFB9D: "\u06B1", 0xFB9E: "\u06BA", 0xFB9F: "\u06BA",
+	0xFBA0: "\u06BB", 0xFBA1: "\u06BB", 0xFBA2: "\u06BB", 0xFBA3: "\u06BB",
+	0xFBA4: "\u06C0", 0xFBA5: "\u06C0", 0xFBA6: "\u06C1", 0xFBA7: "\u06C1",
+	0xFBA8: "\u06C1", 0xFBA9: "\u06C1", 0xFBAA: "\u06BE", 0xFBAB: "\u06BE",
+	0xFBAC: "\u06BE", 0xFBAD: "\u06BE", 0xFBAE: "\u06D2", 0xFBAF: "\u06D2",
+	0xFBB0: "\u06D3", 0xFBB1: "\u06D3", 0xFBD3: "\u06AD", 0xFBD4: "\u06AD",
+	0xFBD5: "\u06AD", 0xFBD6: "\u06AD", 0xFBD7: "\u06C7", 0xFBD8: "\u06C7",
+	0xFBD9: "\u06C6", 0xFBDA: "\u06C6", 0xFBDB: "\u06C8", 0xFBDC: "\u06C8",
+	0xFBDD: "\u0677", 0xFBDE: "\u06CB", 0xFBDF: "\u06CB", 0xFBE0: "\u06C5",
+	0xFBE1: "\u06C5", 0xFBE2: "\u06C9", 0xFBE3: "\u06C9", 0xFBE4: "\u06D0",
+	0xFBE5: "\u06D0", 0xFBE6: "\u06D0", 0xFBE7: "\u06D0", 0xFBE8: "\u0649",
+	0xFBE9: "\u0649", 0xFBEA: "\u0626\u0627", 0xFBEB: "\u0626\u0627", 0xFBEC: "\u0626\u06D5",
+	0xFBED: "\u0626\u06D5", 0xFBEE: "\u0626\u0648", 0xFBEF: "\u0626\u0648", 0xFBF0: "\u0626\u06C7",
+	0xFBF1: "\u0626\u06C7", 0xFBF2: "\u0626\u06C6", 0xFBF3: "\u0626\u06C6", 0xFBF4: "\u0626\u06C8",
+	0xFBF5: "\u0626\u06C8", 0xFBF6: "\u0626\u06D0", 0xFBF7: "\u0626\u06D0", 0xFBF8: "\u0626\u06D0",
+	0xFBF9: "\u0626\u0649", 0xFBFA: "\u0626\u0649", 0xFBFB: "\u0626\u0649", 0xFBFC: "\u06CC",
+	0xFBFD: "\u06CC", 0xFBFE: "\u06CC", 0xFBFF: "\u06CC", 0xFC00: "\u0626\u062C",
+	0xFC01: "\u0626\u062D", 0xFC02: "\u0626\u0645", 0xFC03: "\u0626\u0649", 0xFC04: "\u0626\u064A",
+	0xFC05: "\u0628\u062C", 0xFC06: "\u0628\u062D", 0xFC07: "\u0628\u062E", 0xFC08: "\u0628\u0645",
+	0xFC09: "\u0628\u0649", 0xFC0A: "\u0628\u064A", 0xFC0B: "\u062A\u062C", 0xFC0C: "\u062A\u062D",
+	0xFC0D: "\u062A\u062E", 0xFC0E: "\u062A\u0645", 0xFC0F: "\u062A\u0649", 0xFC10: "\u062A\u064A",
+	0xFC11: "\u062B\u062C", 0xFC12: "\u062B\u0645", 0xFC13: "\u062B\u0649", 0xFC14: "\u062B\u064A",
+	0xFC15: "\u062C\u062D", 0xFC16: "\u062C\u0645", 0xFC17: "\u062D\u062C", 0xFC18: "\u062D\u0645",
+	0xFC19: "\u062E\u062C", 0xFC1A: "\u062E\u062D", 0xFC1B: "\u062E\u0645", 0xFC1C: "\u0633\u062C",
+	0xFC1D: "\u0633\u062D", 0xFC1E: "\u0633\u062E", 0xFC1F: "\u0633\u0645", 0xFC20: "\u0635\u062D",
+	0xFC21: "\u0635\u0645", 0xFC22: "\u0636\u062C", 0xFC23: "\u0636\u062D", 0xFC24: "\u0636\u062E",
+	0xFC25: "\u0636\u0645", 0xFC26: "\u0637\u062D", 0xFC27: "\u0637\u0645", 0xFC28: "\u0638\u0645",
+	0xFC29: "\u0639\u062C", 0xFC2A: "\u0639\u0645", 0xFC2B: "\u063A\u062C", 0xFC2C: "\u063A\u0645",
+	0xFC2D: "\u0641\u062C", 0xFC2E: "\u0641\u062D", 0xFC2F: "\u0641\u062E", 0xFC30: "\u0641\u0645",
+	0xFC31: "\u0641\u0649", 0xFC32: "\u0641\u064A", 0xFC33: "\u0642\u062D", 0xFC34: "\u0642\u0645",
+	0xFC35: "\u0642\u0649", 0xFC36: "\u0642\u064A", 0xFC37: "\u0643\u0627", 0xFC38: "\u0643\u062C",
+	0xFC39: "\u0643\u062D", 0xFC3A: "\u0643\u062E", 0xFC3B: "\u0643\u0644", 0xFC3C: "\u0643\u0645",
+	0xFC3D: "\u0643\u0649", 0xFC3E: "\u0643\u064A", 0xFC3F: "\u0644\u062C", 0xFC40: "\u0644\u062D",
+	0xFC41: "\u0644\u062E", 0xFC42: "\u0644\u0645", 0xFC43: "\u0644\u0649", 0xFC44: "\u0644\u064A",
+	0xFC45: "\u0645\u062C", 0xFC46: "\u0645\u062D", 0xFC47: "\u0645\u062E", 0xFC48: "\u0645\u0645",
+	0xFC49: "\u0645\u0649", 0xFC4A: "\u0645\u064A", 0xFC4B: "\u0646\u062C", 0xFC4C: "\u0646\u062D",
+	0xFC4D: "\u0646\u062E", 0xFC4E: "\u0646\u0645", 0xFC4F: "\u0646\u0649", 0xFC50: "\u0646\u064A",
+	0xFC51: "\u0647\u062C", 0xFC52: "\u0647\u0645", 0xFC53: "\u0647\u0649", 0xFC54: "\u0647\u064A",
+	0xFC55: "\u064A\u062C", 0xFC56: "\u064A\u062D", 0xFC57: "\u064A\u062E", 0xFC58: "\u064A\u0645",
+	0xFC59: "\u064A\u0649", 0xFC5A: "\u064A\u064A", 0xFC5B: "\u0630\u0670", 0xFC5C: "\u0631\u0670",
+	0xFC5D: "\u0649\u0670", 0xFC5E: "\u064C\u0651", 0xFC5F: "\u064D\u0651", 0xFC60: "\u064E\u0651",
+	0xFC61: "\u064F\u0651", 0xFC62: "\u0650\u0651", 0xFC63: "\u0651\u0670", 0xFC64: "\u0626\u0631",
+	0xFC65: "\u0626\u0632", 0xFC66: "\u0626\u0645", 0xFC67: "\u0626\u0646", 0xFC68: "\u0626\u0649",
+	0xFC69: "\u0626\u064A", 0xFC6A: "\u0628\u0631", 0xFC6B: "\u0628\u0632", 0xFC6C: "\u0628\u0645",
+	0xFC6D: "\u0628\u0646", 0xFC6E: "\u0628\u0649", 0xFC6F: "\u0628\u064A", 0xFC70: "\u062A\u0631",
+	0xFC71: "\u062A\u0632", 0xFC72: "\u062A\u0645", 0xFC73: "\u062A\u0646", 0xFC74: "\u062A\u0649",
+	0xFC75: "\u062A\u064A", 0xFC76: "\u062B\u0631", 0xFC77: "\u062B\u0632", 0xFC78: "\u062B\u0645",
+	0xFC79: "\u062B\u0646", 0xFC7A: "\u062B\u0649", 0xFC7B: "\u062B\u064A", 0xFC7C: "\u0641\u0649",
+	0xFC7D: "\u0641\u064A", 0xFC7E: "\u0642\u0649", 0xFC7F: "\u0642\u064A", 0xFC80: "\u0643\u0627",
+	0xFC81: "\u0643\u0644", 0xFC82: "\u0643\u0645", 0xFC83: "\u0643\u0649", 0xFC84: "\u0643\u064A",
+	0xFC85: "\u0644\u0645", 0xFC86: "\u0644\u0649", 0xFC87: "\u0644\u064A", 0xFC88: "\u0645\u0627",
+	0xFC89: "\u0645\u0645", 0xFC8A: "\u0646\u0631", 0xFC8B: "\u0646\u0632", 0xFC8C: "\u0646\u0645",
+	0xFC8D: "\u0646\u0646", 0xFC8E: "\u0646\u0649", 0xFC8F: "\u0646\u064A", 0xFC90: "\u0649\u0670",
+	0xFC91: "\u064A\u0631", 0xFC92: "\u064A\u0632", 0xFC93: "\u064A\u0645", 0xFC94: "\u064A\u0646",
+	0xFC95: "\u064A\u0649", 0xFC96: "\u064A\u064A", 0xFC97: "\u0626\u062C", 0xFC98: "\u0626\u062D",
+	0xFC99: "\u0626\u062E", 0xFC9A: "\u0626\u0645", 0xFC9B: "\u0626\u0647", 0xFC9C: "\u0628\u062C",
+	0xFC9D: "\u0628\u062D", 0xFC9E: "\u0628\u062E", 0xFC9F: "\u0628\u0645", 0xFCA0: "\u0628\u0647",
+	0xFCA1: "\u062A\u062C", 0xFCA2: "\u062A\u062D", 0xFCA3: "\u062A\u062E", 0xFCA4: "\u062A\u0645",
+	0xFCA5: "\u062A\u0647", 0xFCA6: "\u062B\u0645", 0xFCA7: "\u062C\u062D", 0xFCA8: "\u062C\u0645",
+	0xFCA9: "\u062D\u062C", 0xFCAA: "\u062D\u0645", 0xFCAB: "\u062E\u062C", 0xFCAC: "\u062E\u0645",
+	0xFCAD: "\u0633\u062C", 0xFCAE: "\u0633\u062D", 0xFCAF: "\u0633\u062E", 0xFCB0: "\u0633\u0645",
+	0xFCB1: "\u0635\u062D", 0xFCB2: "\u0635\u062E", 0xFCB3: "\u0635\u0645", 0xFCB4: "\u0636\u062C",
+	0xFCB5: "\u0636\u062D", 0xFCB6: "\u0636\u062E", 0xFCB7: "\u0636\u0645", 0xFCB8: "\u0637\u062D",
+	0xFCB9: "\u0638\u0645", 0xFCBA: "\u0639\u062C", 0xFCBB: "\u0639\u0645", 0xFCBC: "\u063A\u062C",
+	0xFCBD: "\u063A\u0645", 0xFCBE: "\u0641\u062C", 0xFCBF: "\u0641\u062D", 0xFCC0: "\u0641\u062E",
+	0xFCC1: "\u0641\u0645", 0xFCC2: "\u0642\u062D", 0xFCC3: "\u0642\u0645", 0xFCC4: "\u0643\u062C",
+	0xFCC5: "\u0643\u062D", 0xFCC6: "\u0643\u062E", 0xFCC7: "\u0643\u0644", 0xFCC8: "\u0643\u0645",
+	0xFCC9: "\u0644\u062C", 0xFCCA: "\u0644\u062D", 0xFCCB: "\u0644\u062E", 0xFCCC: "\u0644\u0645",
+	0xFCCD: "\u0644\u0647", 0xFCCE: "\u0645\u062C", 0xFCCF: "\u0645\u062D", 0xFCD0: "\u0645\u062E",
+	0xFCD1: "\u0645\u0645", 0xFCD2: "\u0646\u062C", 0xFCD3: "\u0646\u062D", 0xFCD4: "\u0646\u062E",
+	0xFCD5: "\u0646\u0645", 0xFCD6: "\u0646\u0647", 0xFCD7: "\u0647\u062C", 0xFCD8: "\u0647\u0645",
+	0xFCD9: "\u0647\u0670", 0xFCDA: "\u064A\u062C", 0xFCDB: "\u064A\u062D", 0xFCDC: "\u064A\u062E",
+	0xFCDD: "\u064A\u0645", 0xFCDE: "\u064A\u0647", 0xFCDF: "\u0626\u0645", 0xFCE0: "\u0626\u0647",
+	0xFCE1: "\u0628\u0645", 0xFCE2: "\u0628\u0647", 0xFCE3: "\u062A\u0645", 0xFCE4: "\u062A\u0647",
+	0xFCE5: "\u062B\u0645", 0xFCE6: "\u062B\u0647", 0xFCE7: "\u0633\u0645", 0xFCE8: "\u0633\u0647",
+	0xFCE9: "\u0634\u0645", 0xFCEA: "\u0634\u0647", 0xFCEB: "\u0643\u0644", 0xFCEC: "\u0643\u0645",
+	0xFCED: "\u0644\u0645", 0xFCEE: "\u0646\u0645", 0xFCEF: "\u0646\u0647", 0xFCF0: "\u064A\u0645",
+	0xFCF1: "\u064A\u0647", 0xFCF2: "\u064E\u0651", 0xFCF3: "\u064F\u0651", 0xFCF4: "\u0650\u0651",
+	0xFCF5: "\u0637\u0649", 0xFCF6: "\u0637\u064A", 0xFCF7: "\u0639\u0649", 0xFCF8: "\u0639\u064A",
+	0xFCF9: "\u063A\u0649", 0xFCFA: "\u063A\u064A", 0xFCFB: "\u0633\u0649", 0xFCFC: "\u0633\u064A",
+	0xFCFD: "\u0634\u0649", 0xFCFE: "\u0634\u064A", 0xFCFF: "\u062D\u0649", 0xFD00: "\u062D\u064A",
+	0xFD01: "\u062C\u0649", 0xFD02: "\u062C\u064A", 0xFD03: "\u062E\u0649", 0xFD04: "\u062E\u064A",
+	0xFD05: "\u0635\u0649", 0xFD06: "\u0635\u064A", 0xFD07: "\u0636\u0649", 0xFD08: "\u0636\u064A",
+	0xFD09: "\u0634\u062C", 0xFD0A: "\u0634\u062D", 0xFD0B: "\u0634\u062E", 0xFD0C: "\u0634\u0645",
+	0xFD0D: "\u0634\u0631", 0xFD0E: "\u0633\u0631", 0xFD0F: "\u0635\u0631", 0xFD10: "\u0636\u0631",
+	0xFD11: "\u0637\u0649", 0xFD12: "\u0637\u064A", 0xFD13: "\u0639\u0649", 0xFD14: "\u0639\u064A",
+	0xFD15: "\u063A\u0649", 0xFD16: "\u063A\u064A", 0xFD17: "\u0633\u0649", 0xFD18: "\u0633\u064A",
+	0xFD19: "\u0634\u0649", 0xFD1A: "\u0634\u064A", 0xFD1B: "\u062D\u0649", 0xFD1C: "\u062D\u064A",
+	0xFD1D: "\u062C\u0649", 0xFD1E: "\u062C\u064A", 0xFD1F: "\u062E\u0649", 0xFD20: "\u062E\u064A",
+	0xFD21: "\u0635\u0649", 0xFD22: "\u0635\u064A", 0xFD23: "\u0636\u0649", 0xFD24: "\u0636\u064A",
+	0xFD25: "\u0634\u062C", 0xFD26: "\u0634\u062D", 0xFD27: "\u0634\u062E", 0xFD28: "\u0634\u0645",
+	0xFD29: "\u0634\u0631", 0xFD2A: "\u0633\u0631", 0xFD2B: "\u0635\u0631", 0xFD2C: "\u0636\u0631",
+	0xFD2D: "\u0634\u062C", 0xFD2E: "\u0634\u062D", 0xFD2F: "\u0634\u062E", 0xFD30: "\u0634\u0645",
+	0xFD31: "\u0633\u0647", 0xFD32: "\u0634\u0647", 0xFD33: "\u0637\u0645", 0xFD34: "\u0633\u062C",
+	0xFD35: "\u0633\u062D", 0xFD36: "\u0633\u062E", 0xFD37: "\u0634\u062C", 0xFD38: "\u0634\u062D",
+	0xFD39: "\u0634\u062E", 0xFD3A: "\u0637\u0645", 0xFD3B: "\u0638\u0645", 0xFD3C: "\u0627\u064B",
+	0xFD3D: "\u0627\u064B", 0xFD50: "\u062A\u062C\u0645", 0xFD51: "\u062A\u062D\u062C", 0xFD52: "\u062A\u062D\u062C",
+	0xFD53: "\u062A\u062D\u0645", 0xFD54: "\u062A\u062E\u0645", 0xFD55: "\u062A\u0645\u062C", 0xFD56: "\u062A\u0645\u062D",
+	0xFD57: "\u062A\u0645\u062E", 0xFD58: "\u062C\u0645\u062D", 0xFD59: "\u062C\u0645\u062D", 0xFD5A: "\u062D\u0645\u064A",
+	0xFD5B: "\u062D\u0645\u0649", 0xFD5C: "\u0633\u062D\u062C", 0xFD5D: "\u0633\u062C\u062D", 0xFD5E: "\u0633\u062C\u0649",
+	0xFD5F: "\u0633\u0645\u062D", 0xFD60: "\u0633\u0645\u062D", 0xFD61: "\u0633\u0645\u062C", 0xFD62: "\u0633\u0645\u0645",
+	0xFD63: "\u0633\u0645\u0645", 0xFD64: "\u0635\u062D\u062D", 0xFD65: "\u0635\u062D\u062D", 0xFD66: "\u0635\u0645\u0645",
+	0xFD67: "\u0634\u062D\u0645", 0xFD68: "\u0634\u062D\u0645", 0xFD69: "\u0634\u062C\u064A", 0xFD6A: "\u0634\u0645\u062E",
+	0xFD6B: "\u0634\u0645\u062E", 0xFD6C: "\u0634\u0645\u0645", 0xFD6D: "\u0634\u0645\u0645", 0xFD6E: "\u0636\u062D\u0649",
+	0xFD6F: "\u0636\u062E\u0645", 0xFD70: "\u0636\u062E\u0645", 0xFD71: "\u0637\u0645\u062D", 0xFD72: "\u0637\u0645\u062D",
+	0xFD73: "\u0637\u0645\u0645", 0xFD74: "\u0637\u0645\u064A", 0xFD75: "\u0639\u062C\u0645", 0xFD76: "\u0639\u0645\u0645",
+	0xFD77: "\u0639\u0645\u0645", 0xFD78: "\u0639\u0645\u0649", 0xFD79: "\u063A\u0645\u0645", 0xFD7A: "\u063A\u0645\u064A",
+	0xFD7B: "\u063A\u0645\u0649", 0xFD7C: "\u0641\u062E\u0645", 0xFD7D: "\u0641\u062E\u0645", 0xFD7E: "\u0642\u0645\u062D",
+	0xFD7F: "\u0642\u0645\u0645", 0xFD80: "\u0644\u062D\u0645", 0xFD81: "\u0644\u062D\u064A", 0xFD82: "\u0644\u062D\u0649",
+	0xFD83: "\u0644\u062C\u062C", 0xFD84: "\u0644\u062C\u062C", 0xFD85: "\u0644\u062E\u0645", 0xFD86: "\u0644\u062E\u0645",
+	0xFD87: "\u0644\u0645\u062D", 0xFD88: "\u0644\u0645\u062D", 0xFD89: "\u0645\u062D\u062C", 0xFD8A: "\u0645\u062D\u0645",
+	0xFD8B: "\u0645\u062D\u064A", 0xFD8C: "\u0645\u062C\u062D", 0xFD8D: "\u0645\u062C\u0645", 0xFD8E: "\u0645\u062E\u062C",
+	0xFD8F: "\u0645\u062E\u0645", 0xFD92: "\u0645\u062C\u062E", 0xFD93: "\u0647\u0645\u062C", 0xFD94: "\u0647\u0645\u0645",
+	0xFD95: "\u0646\u062D\u0645", 0xFD96: "\u0646\u062D\u0649", 0xFD97: "\u0646\u062C\u0645", 0xFD98: "\u0646\u062C\u0645",
+	0xFD99: "\u0646\u062C\u0649", 0xFD9A: "\u0646\u0645\u064A", 0xFD9B: "\u0646\u0645\u0649", 0xFD9C: "\u064A\u0645\u0645",
+	0xFD9D: "\u064A\u0645\u0645", 0xFD9E: "\u0628\u062E\u064A", 0xFD9F: "\u062A\u062C\u064A", 0xFDA0: "\u062A\u062C\u0649",
+	0xFDA1: "\u062A\u062E\u064A", 0xFDA2: "\u062A\u062E\u0649", 0xFDA3: "\u062A\u0645\u064A", 0xFDA4: "\u062A\u0645\u0649",
+	0xFDA5: "\u062C\u0645\u064A", 0xFDA6: "\u062C\u062D\u0649", 0xFDA7: "\u062C\u0645\u0649", 0xFDA8: "\u0633\u062E\u0649",
+	0xFDA9: "\u0635\u062D\u064A", 0xFDAA: "\u0634\u062D\u064A", 0xFDAB: "\u0636\u062D\u064A", 0xFDAC: "\u0644\u062C\u064A",
+	0xFDAD: "\u0644\u0645\u064A", 0xFDAE: "\u064A\u062D\u064A", 0xFDAF: "\u064A\u062C\u064A", 0xFDB0: "\u064A\u0645\u064A",
+	0xFDB1: "\u0645\u0645\u064A", 0xFDB2: "\u0642\u0645\u064A", 0xFDB3: "\u0646\u062D\u064A", 0xFDB4: "\u0642\u0645\u062D",
+	0xFDB5: "\u0644\u062D\u0645", 0xFDB6: "\u0639\u0645\u064A", 0xFDB7: "\u0643\u0645\u064A", 0xFDB8: "\u0646\u062C\u062D",
+	0xFDB9: "\u0645\u062E\u064A", 0xFDBA: "\u0644\u062C\u0645", 0xFDBB: "\u0643\u0645\u0645", 0xFDBC: "\u0644\u062C\u0645",
+	0xFDBD: "\u0646\u062C\u062D", 0xFDBE: "\u062C\u062D\u064A", 0xFDBF: "\u062D\u062C\u064A", 0xFDC0: "\u0645\u062C\u064A",
+	0xFDC1: "\u0641\u0645\u064A", 0xFDC2: "\u0628\u062D\u064A", 0xFDC3: "\u0643\u0645\u0645", 0xFDC4: "\u0639\u062C\u0645",
+	0xFDC5: "\u0635\u0645\u0645", 0xFDC6: "\u0633\u062E\u064A", 0xFDC7: "\u0646\u062C\u064A", 0xFDF0: "\u0635\u0644\u06D2",
+	0xFDF1: "\u0642\u0644\u06D2", 0xFDF2: "\u0627\u0644\u0644\u0647", 0xFDF3: "\u0627\u0643\u0628\u0631", 0xFDF4: "\u0645\u062D\u0645\u062F",
+	0xFDF5: "\u0635\u0644\u0639\u0645", 0xFDF6: "\u0631\u0633\u0648\u0644", 0xFDF7: "\u0639\u0644\u064A\u0647", 0xFDF8: "\u0648\u0633\u0644\u0645",
+	0xFDF9: "\u0635\u0644\u0649", 0xFDFA: "\u0635\u0644\u0649\u0020\u0627\u0644\u0644\u0647\u0020\u0639\u0644\u064A\u0647\u0020\u0648\u0633\u0644\u0645", 0xFDFB: "\u062C\u0644\u0020\u062C\u0644\u0627\u0644\u0647", 0xFDFC: "\u0631\u06CC\u0627\u0644",
+	0xFE70: "\u064B", 0xFE71: "\u064B", 0xFE72: "\u064C", 0xFE74: "\u064D",
+	0xFE76: "\u064E", 0xFE77: "\u064E", 0xFE78: "\u064F", 0xFE79: "\u064F",
+	0xFE7A: "\u0650", 0xFE7B: "\u0650", 0xFE7C: "\u0651", 0xFE7D: "\u0651",
+	0xFE7E: "\u0652", 0xFE7F: "\u0652", 0xFE80: "\u0621", 0xFE81: "\u0622",
+	0xFE82: "\u0622", 0xFE83: "\u0623", 0xFE84: "\u0623", 0xFE85: "\u0624",
+	0xFE86: "\u0624", 0xFE87: "\u0625", 0xFE88: "\u0625", 0xFE89: "\u0626",
+	0xFE8A: "\u0626", 0xFE8B: "\u0626", 0xFE8C: "\u0626", 0xFE8D: "\u0627",
+	0xFE8E: "\u0627", 0xFE8F: "\u0628", 0xFE90: "\u0628", 0xFE91: "\u0628",
+	0xFE92: "\u0628", 0xFE93: "\u0629", 0xFE94: "\u0629", 0xFE95: "\u062A",
+	0xFE96: "\u062A", 0xFE97: "\u062A", 0xFE98: "\u062A", 0xFE99: "\u062B",
+	0xFE9A: "\u062B", 0xFE9B: "\u062B", 0xFE9C: "\u062B", 0xFE9D: "\u062C",
+	0xFE9E: "\u062C", 0xFE9F: "\u062C", 0xFEA0: "\u062C", 0xFEA1: "\u062D",
+	0xFEA2: "\u062D", 0xFEA3: "\u062D", 0xFEA4: "\u062D", 0xFEA5: "\u062E",
+	0xFEA6: "\u062E", 0xFEA7: "\u062E", 0xFEA8: "\u062E", 0xFEA9: "\u062F",
+	0xFEAA: "\u062F", 0xFEAB: "\u0630", 0xFEAC: "\u0630", 0xFEAD: "\u0631",
+	0xFEAE: "\u0631", 0xFEAF: "\u0632", 0xFEB0: "\u0632", 0xFEB1: "\u0633",
+	0xFEB2: "\u0633", 0xFEB3: "\u0633", 0xFEB4: "\u0633", 0xFEB5: "\u0634",
+	0xFEB6: "\u0634", 0xFEB7: "\u0634", 0xFEB8: "\u0634", 0xFEB9: "\u0635",
+	0xFEBA: "\u0635", 0xFEBB: "\u0635", 0xFEBC: "\u0635", 0xFEBD: "\u0636",
+	0xFEBE: "\u0636", 0xFEBF: "\u0636", 0xFEC0: "\u0636", 0xFEC1: "\u0637",
+	0xFEC2: "\u0637", 0xFEC3: "\u0637", 0xFEC4: "\u0637", 0xFEC5: "\u0638",
+	0xFEC6: "\u0638", 0xFEC7: "\u0638", 0xFEC8: "\u0638", 0xFEC9: "\u0639",
+	0xFECA: "\u0639", 0xFECB: "\u0639", 0xFECC: "\u0639", 0xFECD: "\u063A",
+	0xFECE: "\u063A", 0xFECF: "\u063A", 0xFED0: "\u063A", 0xFED1: "\u0641",
+	0xFED2: "\u0641", 0xFED3: "\u0641", 0xFED4: "\u0641", 0xFED5: "\u0642",
+	0xFED6: "\u0642", 0xFED7: "\u0642", 0xFED8: "\u0642", 0xFED9: "\u0643",
+	0xFEDA: "\u0643", 0xFEDB: "\u0643", 0xFEDC: "\u0643", 0xFEDD: "\u0644",
+	0xFEDE: "\u0644", 0xFEDF: "\u0644", 0xFEE0: "\u0644", 0xFEE1: "\u0645",
+	0xFEE2: "\u0645", 0xFEE3: "\u0645", 0xFEE4: "\u0645", 0xFEE5: "\u0646",
+	0xFEE6: "\u0646", 0xFEE7: "\u0646", 0xFEE8: "\u0646", 0xFEE9: "\u0647",
+	0xFEEA: "\u0647", 0xFEEB: "\u0647", 0xFEEC: "\u0647", 0xFEED: "\u0648",
+	0xFEEE: "\u0648", 0xFEEF: "\u0649", 0xFEF0: "\u0649", 0xFEF1: "\u064A",
+	0xFEF2: "\u064A", 0xFEF3: "\u064A", 0xFEF4: "\u064A", 0xFEF5: "\u0644\u0622",
+	0xFEF6: "\u0644\u0622", 0xFEF7: "\u0644\u0623", 0xFEF8: "\u0644\u0623", 0xFEF9: "\u0644\u0625",
+	0xFEFA: "\u0644\u0625", 0xFEFB: "\u0644\u0627", 0xFEFC: "\u0644\u0627",
+}
@@ -2,64 +2,189 @@ package quranize
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"strings"
 
-	"github.com/alpancs/quranize/corpus"
+	"github.com/alpancs/quranize/corpus/idindonesian"
+	"github.com/alpancs/quranize/corpus/idmuntakhab"
+	"github.com/alpancs/quranize/corpus/quransimpleclean"
+	"github.com/alpancs/quranize/corpus/quransimpleenhanced"
 )
 
+// Errors wrapped by LocationError, e.g.
+//  errors.Is(err, ErrInvalidAya)
+var (
+	ErrInvalidSura  = errors.New("invalid sura number")
+	ErrInvalidAya   = errors.New("invalid aya number")
+	ErrInvalidRange = errors.New("invalid range")
+)
+
+// LocationError is returned when a sura number, aya number, or aya ordinal is out of Quran,
+// or when a range ends before it starts.
+type LocationError struct {
+	Sura    int
+	Aya     int
+	Ordinal int   // set instead of Sura and Aya by NewLocationFromOrdinal
+	Err     error // ErrInvalidSura, ErrInvalidAya, or ErrInvalidRange (Sura and Aya are the end of the range)
+}
+
+func (e *LocationError) Error() string {
+	switch {
+	case e.Err == ErrInvalidRange:
+		return fmt.Sprintf("invalid range ending at sura number %d and aya number %d", e.Sura, e.Aya)
+	case e.Err == ErrInvalidSura:
+		return fmt.Sprintf("invalid sura number %d", e.Sura)
+	case e.Sura == 0:
+		return fmt.Sprintf("invalid aya ordinal %d", e.Ordinal)
+	}
+	return fmt.Sprintf("invalid sura number %d and aya number %d", e.Sura, e.Aya)
+}
+
+// Unwrap returns ErrInvalidSura, ErrInvalidAya, or ErrInvalidRange.
+func (e *LocationError) Unwrap() error {
+	return e.Err
+}
+
+// ErrInvalidQuran is wrapped by QuranError.
+var ErrInvalidQuran = errors.New("invalid quran")
+
+// QuranError is returned by Validate and ValidateShape when Quran does not have the canonical shape.
+type QuranError struct {
+	Sura   int // sura number, 0 if the error is not about a sura
+	Aya    int // aya number, 0 if the error is not about an aya
+	Reason string
+}
+
+func (e *QuranError) Error() string {
+	switch {
+	case e.Aya != 0:
+		return fmt.Sprintf("invalid quran: sura %d aya %d: %s", e.Sura, e.Aya, e.Reason)
+	case e.Sura != 0:
+		return fmt.Sprintf("invalid quran: sura %d: %s", e.Sura, e.Reason)
+	}
+	return "invalid quran: " + e.Reason
+}
+
+// Unwrap returns ErrInvalidQuran.
+func (e *QuranError) Unwrap() error {
+	return ErrInvalidQuran
+}
+
+// Verse is an aya along with its location.
+type Verse struct {
+	Location Location
+	Text     string
+}
+
 // Quran stores information of every sura and aya.
 // It has suffix-tree index.
 type Quran struct {
-	Suras []struct {
-		Name string `xml:"name,attr"`
-		Ayas []struct {
-			Text string `xml:"text,attr"`
-		} `xml:"aya"`
-	} `xml:"sura"`
+	Suras []Sura `xml:"sura"`
+}
+
+// Sura is a sura of Quran along with attributes of its XML element.
+type Sura struct {
+	Index int        `xml:"index,attr"`
+	Name  string     `xml:"name,attr"`
+	Ayas  []Aya      `xml:"aya"`
+	Attrs []xml.Attr `xml:",any,attr"` // other attributes
+}
+
+// Aya is an aya of Quran along with attributes of its XML element.
+type Aya struct {
+	Index     int        `xml:"index,attr"`
+	Text      string     `xml:"text,attr"`
+	Bismillah string     `xml:"bismillah,attr"` // bismillah preceding the sura, only set on the first aya
+	Attrs     []xml.Attr `xml:",any,attr"`      // other attributes
 }
 
 // NewQuranSimpleClean returns new Quran instance using corpus:
-//  corpus.QuranSimpleCleanXML
-// See https://github.com/alpancs/quranize/blob/master/corpus/quran_simple_clean.go#L4.
+//  quransimpleclean.XML()
+// See https://github.com/alpancs/quranize/tree/master/corpus/quransimpleclean.
 func NewQuranSimpleClean() Quran {
-	q, _ := ParseQuran(corpus.QuranSimpleCleanXML)
+	q, _ := LoadQuranSimpleClean()
 	return q
 }
 
+// LoadQuranSimpleClean is like NewQuranSimpleClean but returns error if the corpus is invalid.
+func LoadQuranSimpleClean() (Quran, error) {
+	return loadQuran(quransimpleclean.XML())
+}
+
 // NewQuranSimpleEnhanced returns new Quran instance using corpus:
-//  corpus.QuranSimpleEnhancedXML
-// See https://github.com/alpancs/quranize/blob/master/corpus/quran_simple_enhanced.go#L4.
+//  quransimpleenhanced.XML()
+// See https://github.com/alpancs/quranize/tree/master/corpus/quransimpleenhanced.
 func NewQuranSimpleEnhanced() Quran {
-	q, _ := ParseQuran(corpus.QuranSimpleEnhancedXML)
+	q, _ := LoadQuranSimpleEnhanced()
 	return q
 }
 
+// LoadQuranSimpleEnhanced is like NewQuranSimpleEnhanced but returns error if the corpus is invalid.
+func LoadQuranSimpleEnhanced() (Quran, error) {
+	return loadQuran(quransimpleenhanced.XML())
+}
+
 // NewIDIndonesian returns new Quran instance using corpus:
-//  corpus.IDIndonesianXML
-// See https://github.com/alpancs/quranize/blob/master/corpus/id_indonesian.go#L4.
+//  idindonesian.XML()
+// See https://github.com/alpancs/quranize/tree/master/corpus/idindonesian.
 func NewIDIndonesian() Quran {
-	q, _ := ParseQuran(corpus.IDIndonesianXML)
+	q, _ := LoadIDIndonesian()
 	return q
 }
 
+// LoadIDIndonesian is like NewIDIndonesian but returns error if the corpus is invalid.
+func LoadIDIndonesian() (Quran, error) {
+	return loadQuran(idindonesian.XML())
+}
+
 // NewIDMuntakhab returns new Quran instance using corpus:
-//  corpus.IDMuntakhabXML
-// See https://github.com/alpancs/quranize/blob/master/corpus/id_muntakhab.go#L4.
+//  idmuntakhab.XML()
+// See https://github.com/alpancs/quranize/tree/master/corpus/idmuntakhab.
 func NewIDMuntakhab() Quran {
-	q, _ := ParseQuran(corpus.IDMuntakhabXML)
+	q, _ := LoadIDMuntakhab()
 	return q
 }
 
+// LoadIDMuntakhab is like NewIDMuntakhab but returns error if the corpus is invalid.
+func LoadIDMuntakhab() (Quran, error) {
+	return loadQuran(idmuntakhab.XML())
+}
+
+func loadQuran(raw string) (Quran, error) {
+	q, err := ParseQuran(raw)
+	if err != nil {
+		return Quran{}, err
+	}
+	if err := q.Validate(); err != nil {
+		return Quran{}, err
+	}
+	return q, nil
+}
+
 // ParseQuran returns Quran from given raw.
+// Indexes of suras and ayas must be contiguous starting from 1.
 func ParseQuran(raw string) (q Quran, err error) {
-	err = xml.Unmarshal([]byte(raw), &q)
+	if err = xml.Unmarshal([]byte(raw), &q); err != nil {
+		return
+	}
+	for s, sura := range q.Suras {
+		if sura.Index != s+1 {
+			return Quran{}, fmt.Errorf("invalid sura index %d, expected %d", sura.Index, s+1)
+		}
+		for a, aya := range sura.Ayas {
+			if aya.Index != a+1 {
+				return Quran{}, fmt.Errorf("invalid aya index %d of sura %d, expected %d", aya.Index, sura.Index, a+1)
+			}
+		}
+	}
 	return
 }
 
 // GetSuraName returns sura name from sura number in Quran q (number starting from 1).
 func (q Quran) GetSuraName(sura int) (string, error) {
 	if !(1 <= sura && sura <= len(q.Suras)) {
-		return "", fmt.Errorf("invalid sura number %d", sura)
+		return "", &LocationError{Sura: sura, Err: ErrInvalidSura}
 	}
 	return q.Suras[sura-1].Name, nil
 }
@@ -67,11 +192,96 @@ func (q Quran) GetSuraName(sura int) (string, error) {
 // GetAya returns aya text from sura number and aya number in Quran q (number starting from 1).
 func (q Quran) GetAya(sura int, aya int) (string, error) {
 	if !(1 <= sura && sura <= len(q.Suras)) {
-		return "", fmt.Errorf("invalid sura number %d", sura)
+		return "", &LocationError{Sura: sura, Err: ErrInvalidSura}
 	}
 	ayas := q.Suras[sura-1].Ayas
 	if !(1 <= aya && aya <= len(ayas)) {
-		return "", fmt.Errorf("invalid sura number %d and aya number %d", sura, aya)
+		return "", &LocationError{Sura: sura, Aya: aya, Err: ErrInvalidAya}
 	}
 	return ayas[aya-1].Text, nil
 }
+
+// GetAyas returns every aya in range r, across sura boundaries, e.g. 2:284 to 3:5.
+// The end of r must not precede its start.
+func (q Quran) GetAyas(r Range) ([]Verse, error) {
+	start, end := r.Start.GetSura(), r.End.GetSura()
+	for _, l := range []Location{r.Start, r.End} {
+		if _, err := q.GetAya(l.GetSura(), l.GetAya()); err != nil {
+			return nil, err
+		}
+	}
+	if end < start || (end == start && r.End.GetAya() < r.Start.GetAya()) {
+		return nil, &LocationError{Sura: end, Aya: r.End.GetAya(), Err: ErrInvalidRange}
+	}
+
+	verses := []Verse{}
+	for s := start; s <= end; s++ {
+		first, last := 1, len(q.Suras[s-1].Ayas)
+		if s == start {
+			first = r.Start.GetAya()
+		}
+		if s == end {
+			last = r.End.GetAya()
+		}
+		for a := first; a <= last; a++ {
+			verses = append(verses, Verse{NewLocation(s, a, 0), q.Suras[s-1].Ayas[a-1].Text})
+		}
+	}
+	return verses, nil
+}
+
+// GetBismillah returns bismillah preceding sura number in Quran q (number starting from 1),
+// empty if the sura has no bismillah (e.g. Al-Fatihah, whose bismillah is its first aya, and At-Taubah).
+func (q Quran) GetBismillah(sura int) (string, error) {
+	if !(1 <= sura && sura <= len(q.Suras)) {
+		return "", &LocationError{Sura: sura, Err: ErrInvalidSura}
+	}
+	if len(q.Suras[sura-1].Ayas) == 0 {
+		return "", nil
+	}
+	return q.Suras[sura-1].Ayas[0].Bismillah, nil
+}
+
+// text returns aya text from sura number and aya number, or bismillah of the sura if aya number is 0.
+// It returns empty string if not found.
+func (q Quran) text(sura, aya int) string {
+	if aya == 0 {
+		bismillah, _ := q.GetBismillah(sura)
+		return bismillah
+	}
+	text, _ := q.GetAya(sura, aya)
+	return text
+}
+
+// Validate returns *QuranError if Quran q does not have 114 suras, 6236 ayas with the canonical number of ayas
+// of every sura, or if any aya text is empty.
+func (q Quran) Validate() error {
+	if len(q.Suras) != len(suraAyas) {
+		return &QuranError{Reason: fmt.Sprintf("%d suras, expected %d", len(q.Suras), len(suraAyas))}
+	}
+	for s, sura := range q.Suras {
+		if len(sura.Ayas) != suraAyas[s] {
+			return &QuranError{Sura: s + 1, Reason: fmt.Sprintf("%d ayas, expected %d", len(sura.Ayas), suraAyas[s])}
+		}
+		for a, aya := range sura.Ayas {
+			if strings.TrimSpace(aya.Text) == "" {
+				return &QuranError{Sura: s + 1, Aya: a + 1, Reason: "empty text"}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateShape returns *QuranError if Quran q and other do not have the same number of suras
+// and the same number of ayas of every sura, e.g. before using an edition along with its translation.
+func (q Quran) ValidateShape(other Quran) error {
+	if len(q.Suras) != len(other.Suras) {
+		return &QuranError{Reason: fmt.Sprintf("%d suras, other has %d", len(q.Suras), len(other.Suras))}
+	}
+	for s, sura := range q.Suras {
+		if len(sura.Ayas) != len(other.Suras[s].Ayas) {
+			return &QuranError{Sura: s + 1, Reason: fmt.Sprintf("%d ayas, other has %d", len(sura.Ayas), len(other.Suras[s].Ayas))}
+		}
+	}
+	return nil
+}
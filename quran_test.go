@@ -1,6 +1,8 @@
 package quranize
 
 import (
+	"encoding/xml"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -37,11 +39,39 @@ func TestGetAyaFound(t *testing.T) {
 func TestGetAyaSuraNotFound(t *testing.T) {
 	_, err := NewQuranSimpleClean().GetAya(0, 0)
 	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInvalidSura))
+	assert.EqualError(t, err, "invalid sura number 0")
 }
 
 func TestGetAyaAyaNotFound(t *testing.T) {
 	_, err := NewQuranSimpleClean().GetAya(1, 0)
 	assert.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInvalidAya))
+	assert.EqualError(t, err, "invalid sura number 1 and aya number 0")
+}
+
+func TestGetAyas(t *testing.T) {
+	q := NewQuranSimpleClean()
+	verses, err := q.GetAyas(Range{NewLocation(2, 284, 0), NewLocation(3, 5, 0)})
+	assert.NoError(t, err)
+	assert.Len(t, verses, 8)
+	assert.Equal(t, NewLocation(2, 284, 0), verses[0].Location)
+	assert.Equal(t, NewLocation(3, 1, 0), verses[3].Location)
+	assert.Equal(t, "الم", verses[3].Text)
+	assert.Equal(t, NewLocation(3, 5, 0), verses[7].Location)
+
+	verses, err = q.GetAyas(Range{NewLocation(1, 1, 0), NewLocation(1, 1, 0)})
+	assert.NoError(t, err)
+	assert.Len(t, verses, 1)
+
+	_, err = q.GetAyas(Range{NewLocation(2, 284, 0), NewLocation(3, 201, 0)})
+	assert.True(t, errors.Is(err, ErrInvalidAya))
+
+	_, err = q.GetAyas(Range{NewLocation(3, 5, 0), NewLocation(2, 284, 0)})
+	assert.Equal(t, &LocationError{Sura: 2, Aya: 284, Err: ErrInvalidRange}, err)
+	assert.EqualError(t, err, "invalid range ending at sura number 2 and aya number 284")
+	_, err = q.GetAyas(Range{NewLocation(2, 5, 0), NewLocation(2, 4, 0)})
+	assert.True(t, errors.Is(err, ErrInvalidRange))
 }
 
 func TestGetSuraNameFound(t *testing.T) {
@@ -54,3 +84,79 @@ func TestGetSuraNameNotFound(t *testing.T) {
 	_, err := NewQuranSimpleClean().GetSuraName(0)
 	assert.Error(t, err)
 }
+
+func TestParseQuranAttributes(t *testing.T) {
+	raw := `<quran>
+		<sura index="1" name="الفاتحة" type="Meccan"><aya index="1" text="الحمد لله" bismillah="بسم الله" page="1" /></sura>
+	</quran>`
+	q, err := ParseQuran(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, q.Suras[0].Index)
+	assert.Equal(t, []xml.Attr{{Name: xml.Name{Local: "type"}, Value: "Meccan"}}, q.Suras[0].Attrs)
+	assert.Equal(t, Aya{1, "الحمد لله", "بسم الله", []xml.Attr{{Name: xml.Name{Local: "page"}, Value: "1"}}}, q.Suras[0].Ayas[0])
+}
+
+func TestParseQuranInvalidIndex(t *testing.T) {
+	for _, raw := range []string{
+		`<quran><sura index="2"><aya index="1" text="" /></sura></quran>`,
+		`<quran><sura index="1"><aya index="1" text="" /><aya index="3" text="" /></sura></quran>`,
+		`<quran><sura name="الفاتحة"><aya text="" /></sura></quran>`,
+	} {
+		_, err := ParseQuran(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestGetBismillah(t *testing.T) {
+	q := NewQuranSimpleClean()
+	testCases := map[int]string{1: "", 2: "بسم الله الرحمن الرحيم", 9: "", 114: "بسم الله الرحمن الرحيم"}
+	for sura, expected := range testCases {
+		actual, err := q.GetBismillah(sura)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+	_, err := q.GetBismillah(115)
+	assert.Error(t, err)
+}
+
+func TestLoadCorpus(t *testing.T) {
+	for _, load := range []func() (Quran, error){LoadQuranSimpleClean, LoadQuranSimpleEnhanced, LoadIDIndonesian, LoadIDMuntakhab} {
+		q, err := load()
+		assert.NoError(t, err)
+		assert.NoError(t, q.ValidateShape(NewQuranSimpleClean()))
+	}
+}
+
+func TestValidate(t *testing.T) {
+	q := NewQuranSimpleClean()
+	q.Suras = q.Suras[:113]
+	err := q.Validate()
+	assert.True(t, errors.Is(err, ErrInvalidQuran))
+	assert.EqualError(t, err, "invalid quran: 113 suras, expected 114")
+
+	q = NewQuranSimpleClean()
+	q.Suras[1].Ayas = q.Suras[1].Ayas[:285]
+	assert.EqualError(t, q.Validate(), "invalid quran: sura 2: 285 ayas, expected 286")
+
+	q = NewQuranSimpleClean()
+	q.Suras[1].Ayas[254].Text = " "
+	assert.EqualError(t, q.Validate(), "invalid quran: sura 2 aya 255: empty text")
+
+	_, err = loadQuran(`<quran><sura index="1" name="الفاتحة"><aya index="1" text="بسم الله الرحمن الرحيم" /></sura></quran>`)
+	assert.True(t, errors.Is(err, ErrInvalidQuran))
+	_, err = loadQuran(`<quran`)
+	assert.Error(t, err)
+}
+
+func TestValidateShape(t *testing.T) {
+	q, other := NewQuranSimpleClean(), NewIDIndonesian()
+	assert.NoError(t, q.ValidateShape(other))
+
+	other.Suras[112].Ayas = other.Suras[112].Ayas[:4]
+	err := q.ValidateShape(other)
+	assert.True(t, errors.Is(err, ErrInvalidQuran))
+	assert.EqualError(t, err, "invalid quran: sura 113: 5 ayas, other has 4")
+
+	other.Suras = other.Suras[:1]
+	assert.EqualError(t, q.ValidateShape(other), "invalid quran: 114 suras, other has 1")
+}
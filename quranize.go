@@ -3,30 +3,34 @@
 //
 // This package can transform alphabet into arabic using fast and efficient algorithm:
 // suffix-tree for indexing and dynamic programming for parsing.
+// Suffixes in the suffix-tree start at word boundaries,
+// substrings starting in the middle of a word are located by a suffix array (see LocateSubstring).
 package quranize
 
 import (
 	"bytes"
+	"math"
+	"sort"
 	"strings"
 	"sync"
 )
 
 // Quranize encodes arabic into alphabet.
 type Quranize struct {
-	t    Transliteration
-	q    Quran
-	root *node
+	t          Transliteration
+	q          Quran
+	harakat    Quran
+	bismillah  bool
+	crossAya   int
+	crossSura  bool
+	normalizer Normalizer
+	index      *trie
+	normalized *normalizedIndex
+	substrings *substringIndex
 }
 
-type node struct {
-	locations []Location
-	children  []child
-}
-
-type child struct {
-	key   rune
-	value *node
-}
+// Option configures Quranize built by NewQuranize.
+type Option func(*Quranize)
 
 var (
 	q        Quranize
@@ -38,7 +42,7 @@ var (
 //
 // Mapping: https://github.com/alpancs/quranize/blob/master/corpus/arabic_to_alphabet_clean.go#L3
 //
-// Quran: https://github.com/alpancs/quranize/blob/master/corpus/quran_simple_clean.go#L4
+// Quran: https://github.com/alpancs/quranize/tree/master/corpus/quransimpleclean
 func NewDefaultQuranize() Quranize {
 	once.Do(func() {
 		q = NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean())
@@ -46,30 +50,128 @@ func NewDefaultQuranize() Quranize {
 	return q
 }
 
-// NewQuranize return new Quranize using Transliteration t and Quran q.
-func NewQuranize(t Transliteration, q Quran) Quranize {
-	quranize := Quranize{t: t, q: q}
+// NewQuranize return new Quranize using Transliteration t, Quran q, and options.
+func NewQuranize(t Transliteration, q Quran, options ...Option) Quranize {
+	quranize := newQuranize(t, q, options)
 	quranize.buildIndex()
 	return quranize
 }
 
+// newQuranize returns Quranize configured by options, without index.
+func newQuranize(t Transliteration, q Quran, options []Option) Quranize {
+	quranize := Quranize{t: t, q: q, normalizer: DefaultNormalizer, normalized: &normalizedIndex{}, substrings: &substringIndex{}}
+	for _, option := range options {
+		option(&quranize)
+	}
+	return quranize
+}
+
+// WithBismillah returns Option to also index bismillah preceding every sura as aya 0 of the sura,
+// e.g. "بسم الله الرحمن الرحيم" is also located at {2 0 0}.
+func WithBismillah() Option {
+	return func(q *Quranize) {
+		q.bismillah = true
+	}
+}
+
+// EncodeResult is an arabic encoding of an alphabet string along with its ranking details.
+type EncodeResult struct {
+	Text        string  // arabic encoding
+	Score       float64 // ranking score, the higher the better
+	Cost        int     // transliteration cost, sum of costs of alphabets used (see NewTransliteration)
+	Variant     int     // input variant used: 0 raw, 1 last non-vowel trimmed, 2 consecutive chars removed
+	Edits       int     // typo tolerance cost of fuzzy encoding, in half edits
+	Insertions  int     // implicit insertions (ا, ال, or ى) made while combining, spaces are not counted as input has none
+	Occurrences int     // number of locations of Text in Quran
+}
+
+type encoding struct {
+	text       string
+	cost       int
+	insertions int
+	marks      []uint8 // required harakat of every harf of text, only recorded WithHarakat
+	node       int32   // index node of text, only recorded by encoder
+}
+
+const (
+	costPenalty      = 1.0
+	variantPenalty   = 2.0
+	insertionPenalty = 0.5
+	editPenalty      = 6.0
+)
+
 // Encode returns arabic encodings of given string using Transliteration t.
 func (q Quranize) Encode(s string) []string {
-	s = strings.ToLower(strings.Replace(s, " ", "", -1))
-	memo := make(map[string][]string)
-	dirtyResults := q.quranize(s, memo)
-	dirtyResults = append(dirtyResults, q.quranize(trimLastNonVowel(s), memo)...)
-	dirtyResults = append(dirtyResults, q.quranize(removeConsecutiveChars(s), memo)...)
-
 	results := []string{}
-	for _, result := range dirtyResults {
-		if len(q.Locate(result)) > 0 {
-			results = appendUniq(results, result)
+	for _, result := range q.encode(newEncoder(q), normalizeInput(s)) {
+		results = append(results, result.Text)
+	}
+	return results
+}
+
+// EncodeRanked returns arabic encodings of given string using Transliteration t, sorted best-first.
+//
+// Score is derived from transliteration edit cost, input variant, typo edits, number of implicit insertions,
+// and number of occurrences in Quran.
+func (q Quranize) EncodeRanked(s string) []EncodeResult {
+	results := q.encode(newEncoder(q), normalizeInput(s))
+	sortResults(results)
+	return results
+}
+
+func sortResults(results []EncodeResult) {
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+}
+
+func normalizeInput(s string) string {
+	return strings.ToLower(strings.Replace(s, " ", "", -1))
+}
+
+func (q Quranize) encode(e *encoder, s string) []EncodeResult {
+	variants := [][]encoding{
+		e.quranize(s),
+		e.quranize(trimLastNonVowel(s)),
+		e.quranize(removeConsecutiveChars(s)),
+	}
+
+	results := []EncodeResult{}
+	indexes := make(map[string]int)
+	for variant, encodings := range variants {
+		for _, e := range encodings {
+			locations := q.Locate(e.text)
+			occurrences := len(locations)
+			if occurrences == 0 || !q.matchLocations(e, locations) {
+				continue
+			}
+			result := EncodeResult{
+				Text:        e.text,
+				Cost:        e.cost,
+				Variant:     variant,
+				Insertions:  e.insertions,
+				Occurrences: occurrences,
+			}
+			result.Score = result.score()
+			if i, ok := indexes[e.text]; ok {
+				if result.Score > results[i].Score {
+					results[i] = result
+				}
+				continue
+			}
+			indexes[e.text] = len(results)
+			results = append(results, result)
 		}
 	}
 	return results
 }
 
+func (r EncodeResult) score() float64 {
+	return math.Log2(1+float64(r.Occurrences)) -
+		costPenalty*float64(r.Cost) -
+		variantPenalty*float64(r.Variant) -
+		editPenalty*float64(r.Edits) -
+		insertionPenalty*float64(r.Insertions)
+}
+
 func trimLastNonVowel(s string) string {
 	if len(s) == 0 {
 		return s
@@ -93,83 +195,125 @@ func removeConsecutiveChars(s string) string {
 
 // Locate returns locations of s (quran kalima), matching the whole word.
 func (q Quranize) Locate(s string) []Location {
-	n := q.root
-	if n == nil {
+	n := q.find(s)
+	if n < 0 {
 		return zeroLocs
 	}
-
-	for _, harf := range []rune(s) {
-		n = n.getChild(harf)
-		if n == nil {
-			return zeroLocs
-		}
-	}
-	return n.locations
+	return q.index.locations(n)
 }
 
-func (q Quranize) quranize(s string, memo map[string][]string) []string {
+func (e *encoder) quranize(s string) []encoding {
 	if s == "" {
+		if e.q.hasHarakat() {
+			return []encoding{{marks: []uint8{}}}
+		}
 		return base
 	}
 
-	if cache, ok := memo[s]; ok {
+	if cache, ok := e.memo[s]; ok {
 		return cache
 	}
 
-	kalimas := []string{}
+	kalimas := []encoding{}
 	l := len(s)
-	for width := 1; width <= q.t.alphabetMaxLen && width <= l; width++ {
-		if tails, ok := q.t.hijaiyas[s[l-width:]]; ok {
-			heads := q.quranize(s[:l-width], memo)
-			for _, combination := range combine(heads, tails) {
-				if q.exists(combination) {
+	for width := 1; width <= e.q.t.alphabetMaxLen && width <= l && e.err == nil; width++ {
+		alphabet := s[l-width:]
+		if tails, ok := e.q.t.hijaiyas[alphabet]; ok {
+			heads := e.quranize(s[:l-width])
+			if !e.spend(len(heads) * len(tails)) {
+				break
+			}
+			for _, combination := range combine(e.q.index, heads, tails, e.q.t.costs[alphabet], alphabetMarks(alphabet)) {
+				if n := combination.node; n >= 0 && combination.matchNode(e.q.index.marks[n]) {
 					kalimas = appendUniq(kalimas, combination)
 				}
 			}
 		}
 	}
 
-	memo[s] = kalimas
+	e.memo[s] = kalimas
 	return kalimas
 }
 
-func combine(heads, tails []string) []string {
-	combinations := []string{}
+type combiner struct {
+	index *trie
+	head  encoding
+	tail  string
+	cost  int
+	mark  uint8
+}
+
+// combine returns combinations of heads and tails, each walked in index from the node of its head.
+func combine(index *trie, heads []encoding, tails []string, costs []int, mark uint8) []encoding {
+	combinations := []encoding{}
 	for _, head := range heads {
-		for _, tail := range tails {
-			combinations = append(combinations, head+tail)
-			combinations = append(combinations, head+" "+tail)
-			combinations = append(combinations, head+"ا"+tail)
-			combinations = append(combinations, head+"ال"+tail)
-			combinations = append(combinations, head+" ال"+tail)
-			combinations = append(combinations, head+tail+"ى")
+		for i, tail := range tails {
+			c := combiner{index, head, tail, head.cost + costs[i], mark}
+			combinations = append(combinations,
+				c.join("", "", 0),
+				c.join(" ", "", 0),
+				c.join("ا", "", 1),
+				c.join("ال", "", 1),
+				c.join(" ال", "", 1),
+				c.join("", "ى", 1),
+			)
 			if tail == "و" {
-				combinations = append(combinations, head+tail+"ا")
+				combinations = append(combinations, c.join("", "ا", 1))
 			}
 		}
 	}
 	return combinations
 }
 
-func appendUniq(results []string, newResult string) []string {
-	for _, result := range results {
-		if result == newResult {
+// join returns encoding of head, infix, tail, and suffix.
+func (c combiner) join(infix, suffix string, insertions int) encoding {
+	e := encoding{
+		text:       c.head.text + infix + c.tail + suffix,
+		cost:       c.cost,
+		insertions: c.head.insertions + insertions,
+		node:       c.index.walk(c.head.node, infix+c.tail+suffix),
+	}
+	if c.head.marks != nil {
+		tail := []rune(c.tail)
+		e.marks = make([]uint8, len(c.head.marks), len(c.head.marks)+len(infix)+len(c.tail)+len(suffix))
+		copy(e.marks, c.head.marks)
+		e.marks = append(e.marks, make([]uint8, len([]rune(infix))+len(tail)+len([]rune(suffix)))...)
+		if len(tail) == 1 {
+			e.marks[len(e.marks)-len([]rune(suffix))-1] = c.mark
+		}
+	}
+	return e
+}
+
+func appendUniq(results []encoding, newResult encoding) []encoding {
+	for i, result := range results {
+		if result.text == newResult.text {
+			marks := mergeMarks(result.marks, newResult.marks)
+			if newResult.penalty() < result.penalty() {
+				results[i] = newResult
+			}
+			results[i].marks = marks
 			return results
 		}
 	}
 	return append(results, newResult)
 }
 
+func (e encoding) penalty() float64 {
+	return costPenalty*float64(e.cost) + insertionPenalty*float64(e.insertions)
+}
+
 // exists returns existence of s.
 func (q Quranize) exists(s string) bool {
-	n := q.root
-	for _, harf := range []rune(s) {
-		n = n.getChild(harf)
-		if n == nil {
-			return false
-		}
+	return q.find(s) >= 0
+}
+
+// find returns the index node reached by walking s from root, or -1 if s does not exist.
+func (q Quranize) find(s string) int32 {
+	if q.index == nil {
+		return -1
 	}
-	return true
+	return q.index.find(s)
 }
 
 // buildIndex build index for Quranize q.
@@ -178,44 +322,22 @@ func (q Quranize) exists(s string) bool {
 //   q.Locate
 // won't work.
 func (q *Quranize) buildIndex() {
-	q.root = &node{locations: zeroLocs}
-	for s, sura := range q.q.Suras {
-		for a, aya := range sura.Ayas {
-			q.indexAya([]rune(aya.Text), s+1, a+1)
-		}
-	}
-}
-
-func (q *Quranize) indexAya(harfs []rune, sura, aya int) {
-	wordIndex := 0
-	for i := range harfs {
-		if i == 0 || harfs[i-1] == ' ' {
-			q.buildTree(harfs[i:], NewLocation(sura, aya, wordIndex))
-			wordIndex++
+	root := &node{}
+	index := func(text string, sura, aya int) {
+		harfs := []rune(text)
+		marks := q.ayaMarks(harfs, sura, aya)
+		root.indexAya(harfs, marks, sura, aya)
+		if q.crossAya > 1 {
+			root.indexCrossing(*q, harfs, marks, sura, aya)
 		}
 	}
-}
-
-func (q *Quranize) buildTree(harfs []rune, location Location) {
-	n := q.root
-	for i, harf := range harfs {
-		c := n.getChild(harf)
-		if c == nil {
-			c = &node{}
-			n.children = append(n.children, child{harf, c})
-		}
-		n = c
-		if i == len(harfs)-1 || harfs[i+1] == ' ' {
-			n.locations = append(n.locations, location)
+	for s, sura := range q.q.Suras {
+		if q.bismillah && len(sura.Ayas) > 0 && sura.Ayas[0].Bismillah != "" {
+			index(sura.Ayas[0].Bismillah, s+1, 0)
 		}
-	}
-}
-
-func (n *node) getChild(key rune) *node {
-	for _, c := range n.children {
-		if c.key == key {
-			return c.value
+		for a, aya := range sura.Ayas {
+			index(aya.Text, s+1, a+1)
 		}
 	}
-	return nil
+	q.index = newTrie(root)
 }
@@ -65,6 +65,49 @@ func TestEncodeAlquran(t *testing.T) {
 	}
 }
 
+func TestEncodeRankedEmptyString(t *testing.T) {
+	input := ""
+	expected := []EncodeResult{}
+	actual := quranizeTest.EncodeRanked(input)
+	assert.Equal(t, expected, actual)
+}
+
+func TestEncodeRankedAlquran(t *testing.T) {
+	testCases := map[string][]string{
+		"bismillah":          {"بسم الله", "بشماله"},
+		"shummun bukmun":     {"صم بكم", "صم وبكم", "الصم البكم"},
+		"maaliki yau middin": {"مالك يوم الدين", "الملك يومئذ"},
+	}
+	for input, expected := range testCases {
+		actual := []string{}
+		for _, result := range quranizeTest.EncodeRanked(input) {
+			actual = append(actual, result.Text)
+		}
+		assert.Equalf(t, expected, actual, "input = %#v", input)
+	}
+}
+
+func TestEncodeRankedCost(t *testing.T) {
+	results := quranizeTest.EncodeRanked("kullu")
+	if assert.NotEmpty(t, results) {
+		assert.Equal(t, "كل", results[0].Text)
+		assert.Equal(t, 0, results[0].Cost)
+	}
+
+	results = quranizeTest.EncodeRanked("alhamdulillah hirobbil 'alamin")
+	if assert.NotEmpty(t, results) {
+		assert.True(t, results[0].Score > 0)
+	}
+}
+
+func TestEncodeRankedScore(t *testing.T) {
+	results := quranizeTest.EncodeRanked("alhamdulillah")
+	assert.Len(t, results, 1)
+	assert.Equal(t, 0, results[0].Variant)
+	assert.Equal(t, len(quranizeTest.Locate(results[0].Text)), results[0].Occurrences)
+	assert.Equal(t, results[0].score(), results[0].Score)
+}
+
 func ExampleQuranize_Encode() {
 	quranize := NewDefaultQuranize()
 	fmt.Println(quranize.Encode("alhamdulillah hirobbil 'alamin"))
@@ -99,15 +142,33 @@ func TestLocateAlquran(t *testing.T) {
 }
 
 func TestLocateAlquranBeforeBuildIndex(t *testing.T) {
-	root := quranizeTest.root
-	defer func() { quranizeTest.root = root }()
-	quranizeTest.root = nil
+	index := quranizeTest.index
+	defer func() { quranizeTest.index = index }()
+	quranizeTest.index = nil
 	input := "بسم الله الرحمن الرحيم"
 	expected := zeroLocs
 	actual := quranizeTest.Locate(input)
 	assert.Equal(t, expected, actual)
 }
 
+func TestLocateWithBismillah(t *testing.T) {
+	q := NewQuranize(NewDefaultTransliteration(), NewQuranSimpleClean(), WithBismillah())
+	locations := q.Locate("بسم الله الرحمن الرحيم")
+	assert.Len(t, locations, 114)
+	assert.Equal(t, NewLocation(1, 1, 0), locations[0])
+	assert.Equal(t, NewLocation(2, 0, 0), locations[1])
+	assert.Contains(t, locations, NewLocation(27, 30, 4))
+	assert.NotContains(t, locations, NewLocation(9, 0, 0))
+	assert.Len(t, quranizeTest.Locate("بسم الله الرحمن الرحيم"), 2)
+
+	enhanced := NewQuranSimpleEnhanced()
+	results := q.Search("bismillahirrohmanirrohim", SearchOptions{Edition: enhanced, Limit: 2})
+	expected, _ := enhanced.GetBismillah(2)
+	assert.Equal(t, 0, results[1].Aya)
+	assert.Equal(t, "البقرة", results[1].SuraName)
+	assert.Equal(t, expected, results[1].AyaText)
+}
+
 func TestRemoveConsecutiveChars(t *testing.T) {
 	input := "bismillaahirrahmaanirrahiim"
 	expected := "bismilahirahmanirahim"
@@ -0,0 +1,23 @@
+package quranize
+
+import (
+	"fmt"
+)
+
+// Range is an inclusive range of ayas in Quran, from aya of Start to aya of End.
+type Range struct {
+	Start Location
+	End   Location
+}
+
+// Contains reports whether aya of location l is in range r (word indexes are ignored).
+func (r Range) Contains(l Location) bool {
+	l.wordIndex = 0
+	start, end := r.Start, r.End
+	start.wordIndex, end.wordIndex = 0, 0
+	return !l.before(start) && !end.before(l)
+}
+
+func (r Range) String() string {
+	return fmt.Sprintf("{%v %v}", r.Start, r.End)
+}
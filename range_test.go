@@ -0,0 +1,26 @@
+package quranize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeContains(t *testing.T) {
+	r := Range{NewLocation(2, 255, 0), NewLocation(3, 2, 0)}
+	testCases := map[Location]bool{
+		NewLocation(2, 254, 9): false,
+		NewLocation(2, 255, 0): true,
+		NewLocation(2, 286, 3): true,
+		NewLocation(3, 2, 7):   true,
+		NewLocation(3, 3, 0):   false,
+	}
+	for input, expected := range testCases {
+		assert.Equal(t, expected, r.Contains(input), input.String())
+	}
+}
+
+func TestRangeString(t *testing.T) {
+	r := Range{NewLocation(36, 1, 0), NewLocation(36, 12, 0)}
+	assert.Equal(t, "{{36 1 0} {36 12 0}}", r.String())
+}
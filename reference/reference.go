@@ -0,0 +1,327 @@
+// Package reference parses and formats verse references of Quran, e.g. "2:255", "QS. Al-Baqarah: 255",
+// "al baqoroh 255-257", or "Yasin 1-12".
+package reference
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/alpancs/quranize"
+)
+
+// Style is a citation style used by Format.
+type Style int
+
+// Available citation styles.
+const (
+	// Numeric is sura and aya numbers, e.g. "2:255-257".
+	Numeric Style = iota
+	// Indonesian is indonesian citation, e.g. "QS. Al-Baqarah: 255-257".
+	Indonesian
+	// Latin is Tanzil latin name followed by numbers, e.g. "Al-Baqara 2:255-257".
+	Latin
+	// Arabic is arabic name followed by arabic-indic numbers, e.g. "البقرة ٢٥٥-٢٥٧".
+	Arabic
+)
+
+// Catalog parses and formats references using sura names and aya counts of a Quran.
+type Catalog struct {
+	suras []sura
+	keys  []nameKey
+}
+
+type sura struct {
+	ayas                      int
+	arabic, latin, indonesian string
+}
+
+type nameKey struct {
+	key  string
+	sura int
+}
+
+var (
+	catalog Catalog
+	once    sync.Once
+
+	prefixPattern  = regexp.MustCompile(`^(q\.?\s*s\.?|al-?quran|surah|surat|sura)\b[\s.:]*`)
+	crossPattern   = regexp.MustCompile(`^(\d+)\s*:\s*(\d+)\s*-\s*(\d+)\s*:\s*(\d+)$`)
+	ayaPattern     = regexp.MustCompile(`^(.*?)[\s:,]*(?:(\d+)\s*:\s*)?(?:\b(?:ayat|ayah|verses?)\s*)?(\d+)(?:\s*-\s*(\d+))?$`)
+	articlePattern = regexp.MustCompile(`^a[a-z]{1,2}[-\s]+`)
+	nameFolds      = strings.NewReplacer(
+		"ee", "i", "oo", "u", "sy", "s", "sh", "s", "ts", "s", "th", "t", "dz", "z", "dh", "d", "zh", "z",
+		"kh", "k", "gh", "g", "q", "k", "o", "a", "e", "i", "–", "-",
+	)
+	arabicDigits = strings.NewReplacer("٠", "0", "١", "1", "٢", "2", "٣", "3", "٤", "4", "٥", "5", "٦", "6", "٧", "7", "٨", "8", "٩", "9")
+	latinDigits  = strings.NewReplacer("0", "٠", "1", "١", "2", "٢", "3", "٣", "4", "٤", "5", "٥", "6", "٦", "7", "٧", "8", "٨", "9", "٩")
+)
+
+// NewCatalog returns new Catalog using arabic sura names (Quran.GetSuraName) and aya counts of Quran q,
+// along with latin and indonesian sura names.
+func NewCatalog(q quranize.Quran) Catalog {
+	metadata := quranize.NewMetadata()
+	c := Catalog{}
+	for i, s := range q.Suras {
+		number := i + 1
+		arabic, _ := q.GetSuraName(number)
+		info, _ := metadata.Sura(number)
+		indonesian := ""
+		if i < len(indonesianNames) {
+			indonesian = indonesianNames[i]
+		}
+		c.suras = append(c.suras, sura{len(s.Ayas), arabic, info.LatinName, indonesian})
+		for _, name := range append([]string{arabic, info.LatinName, indonesian}, aliases[number]...) {
+			c.addName(name, number)
+		}
+	}
+	return c
+}
+
+func (c *Catalog) addName(name string, sura int) {
+	if name == "" {
+		return
+	}
+	name = strings.ToLower(name)
+	c.keys = append(c.keys, nameKey{normalizeName(name), sura})
+	if stripped := articlePattern.ReplaceAllString(name, ""); stripped != name {
+		c.keys = append(c.keys, nameKey{normalizeName(stripped), sura})
+	}
+}
+
+// Parse returns range of ayas referred by s using catalog of NewQuranSimpleClean.
+func Parse(s string) (quranize.Range, error) {
+	return defaultCatalog().Parse(s)
+}
+
+// Format returns reference of range r in given style using catalog of NewQuranSimpleClean.
+func Format(r quranize.Range, style Style) string {
+	return defaultCatalog().Format(r, style)
+}
+
+func defaultCatalog() Catalog {
+	once.Do(func() {
+		catalog = NewCatalog(quranize.NewQuranSimpleClean())
+	})
+	return catalog
+}
+
+// Parse returns range of ayas referred by s.
+//
+// Accepted forms are sura and aya numbers (e.g. "2:255", "2:255-257", "2:285-3:5"),
+// or sura name or number optionally followed by aya numbers (e.g. "QS. Al-Baqarah: 255", "Yasin 1-12", "Al-Mulk"),
+// or two of them joined by " - " (e.g. "Al-Baqarah 285 - Ali 'Imran 5").
+// Sura names may be arabic, latin, or indonesian, and are matched approximately.
+// A sura without aya numbers refers to the whole sura.
+func (c Catalog) Parse(s string) (quranize.Range, error) {
+	r, err := c.parse(s)
+	if err == nil {
+		return r, nil
+	}
+	if parts := strings.Split(s, " - "); len(parts) == 2 {
+		start, startErr := c.parse(parts[0])
+		end, endErr := c.parse(parts[1])
+		if startErr == nil && endErr == nil {
+			return c.newRange(start.Start.GetSura(), start.Start.GetAya(), end.End.GetSura(), end.End.GetAya(), s)
+		}
+	}
+	return quranize.Range{}, err
+}
+
+func (c Catalog) parse(s string) (quranize.Range, error) {
+	input := strings.ToLower(strings.TrimSpace(arabicDigits.Replace(strings.Replace(s, "–", "-", -1))))
+	input = prefixPattern.ReplaceAllString(input, "")
+
+	if m := crossPattern.FindStringSubmatch(input); m != nil {
+		return c.newRange(atoi(m[1]), atoi(m[2]), atoi(m[3]), atoi(m[4]), s)
+	}
+
+	name, number, start, end := input, "", "", ""
+	if m := ayaPattern.FindStringSubmatch(input); m != nil {
+		name, number, start, end = m[1], m[2], m[3], m[4]
+	}
+	if name == "" && number == "" && end == "" {
+		name, start = start, ""
+	}
+
+	sura := atoi(number)
+	if name != "" {
+		found, err := c.findSura(name)
+		if err != nil {
+			return quranize.Range{}, err
+		}
+		if sura != 0 && sura != found {
+			return quranize.Range{}, fmt.Errorf("invalid reference %q", s)
+		}
+		sura = found
+	}
+	if !(1 <= sura && sura <= len(c.suras)) {
+		return quranize.Range{}, fmt.Errorf("invalid reference %q", s)
+	}
+	if start == "" {
+		return c.newRange(sura, 1, sura, c.ayas(sura), s)
+	}
+	if end == "" {
+		end = start
+	}
+	return c.newRange(sura, atoi(start), sura, atoi(end), s)
+}
+
+func (c Catalog) newRange(startSura, startAya, endSura, endAya int, s string) (quranize.Range, error) {
+	if !c.valid(startSura, startAya) || !c.valid(endSura, endAya) ||
+		startSura > endSura || (startSura == endSura && startAya > endAya) {
+		return quranize.Range{}, fmt.Errorf("invalid reference %q", s)
+	}
+	return quranize.Range{
+		Start: quranize.NewLocation(startSura, startAya, 0),
+		End:   quranize.NewLocation(endSura, endAya, 0),
+	}, nil
+}
+
+func (c Catalog) valid(sura, aya int) bool {
+	return 1 <= sura && sura <= len(c.suras) && 1 <= aya && aya <= c.ayas(sura)
+}
+
+func (c Catalog) ayas(sura int) int {
+	return c.suras[sura-1].ayas
+}
+
+// findSura returns sura number of name, either a number or the most similar sura name.
+func (c Catalog) findSura(name string) (int, error) {
+	name = strings.TrimSpace(name)
+	if number, err := strconv.Atoi(name); err == nil {
+		if !(1 <= number && number <= len(c.suras)) {
+			return 0, fmt.Errorf("invalid sura number %d", number)
+		}
+		return number, nil
+	}
+
+	inputs := []string{normalizeName(name)}
+	if stripped := articlePattern.ReplaceAllString(name, ""); stripped != name {
+		inputs = append(inputs, normalizeName(stripped))
+	}
+	best, bestDistance := 0, 0
+	for _, input := range inputs {
+		if input == "" {
+			continue
+		}
+		for _, k := range c.keys {
+			d := distance(input, k.key)
+			if d <= len([]rune(k.key))/4 && (best == 0 || d < bestDistance) {
+				best, bestDistance = k.sura, d
+			}
+		}
+	}
+	if best == 0 {
+		return 0, fmt.Errorf("unknown sura name %q", name)
+	}
+	return best, nil
+}
+
+// Format returns reference of range r in given style.
+// A range covering a whole sura is formatted without aya numbers,
+// and a range across suras in named styles is formatted as two references joined by " - ".
+func (c Catalog) Format(r quranize.Range, style Style) string {
+	startSura, startAya := r.Start.GetSura(), r.Start.GetAya()
+	endSura, endAya := r.End.GetSura(), r.End.GetAya()
+	if !c.valid(startSura, startAya) || !c.valid(endSura, endAya) {
+		return r.String()
+	}
+
+	if startSura != endSura {
+		if style == Numeric {
+			return fmt.Sprintf("%d:%d-%d:%d", startSura, startAya, endSura, endAya)
+		}
+		return c.format(startSura, startAya, startAya, style) + " - " + c.format(endSura, endAya, endAya, style)
+	}
+	return c.format(startSura, startAya, endAya, style)
+}
+
+func (c Catalog) format(number, startAya, endAya int, style Style) string {
+	s := c.suras[number-1]
+	ayas := strconv.Itoa(startAya)
+	switch {
+	case startAya == 1 && endAya == s.ayas:
+		ayas = ""
+	case startAya != endAya:
+		ayas += fmt.Sprintf("-%d", endAya)
+	}
+
+	switch style {
+	case Indonesian:
+		if ayas == "" {
+			return "QS. " + s.indonesian
+		}
+		return "QS. " + s.indonesian + ": " + ayas
+	case Latin:
+		if ayas == "" {
+			return s.latin
+		}
+		return fmt.Sprintf("%s %d:%s", s.latin, number, ayas)
+	case Arabic:
+		if ayas == "" {
+			return s.arabic
+		}
+		return s.arabic + " " + latinDigits.Replace(ayas)
+	}
+	if ayas == "" {
+		return strconv.Itoa(number)
+	}
+	return fmt.Sprintf("%d:%s", number, ayas)
+}
+
+// normalizeName returns spelling-insensitive form of a sura name,
+// e.g. "al-baqarah", "al baqoroh", and "Al-Baqara" are all "albakara".
+func normalizeName(name string) string {
+	name = nameFolds.Replace(strings.ToLower(name))
+	letters := []rune{}
+	for _, r := range name {
+		if unicode.IsLetter(r) && (len(letters) == 0 || letters[len(letters)-1] != r) {
+			letters = append(letters, r)
+		}
+	}
+	if n := len(letters); n > 1 && letters[n-1] == 'h' && strings.ContainsRune("aiu", letters[n-2]) {
+		letters = letters[:n-1]
+	}
+	return string(letters)
+}
+
+// distance returns Levenshtein distance between a and b.
+func distance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	prev := make([]int, len(y)+1)
+	curr := make([]int, len(y)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		curr[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j-1]+cost, prev[j]+1, curr[j-1]+1)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(y)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -0,0 +1,96 @@
+package reference
+
+import (
+	"testing"
+
+	"github.com/alpancs/quranize"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRange(startSura, startAya, endSura, endAya int) quranize.Range {
+	return quranize.Range{
+		Start: quranize.NewLocation(startSura, startAya, 0),
+		End:   quranize.NewLocation(endSura, endAya, 0),
+	}
+}
+
+func TestNames(t *testing.T) {
+	assert.Len(t, indonesianNames, 114)
+}
+
+func TestParse(t *testing.T) {
+	testCases := map[string]quranize.Range{
+		"2:255":                         newRange(2, 255, 2, 255),
+		"2 : 255 - 257":                 newRange(2, 255, 2, 257),
+		"2:285-3:5":                     newRange(2, 285, 3, 5),
+		"114":                           newRange(114, 1, 114, 6),
+		"QS. Al-Baqarah: 255":           newRange(2, 255, 2, 255),
+		"QS 2:255":                      newRange(2, 255, 2, 255),
+		"al baqoroh 255-257":            newRange(2, 255, 2, 257),
+		"Yasin 1-12":                    newRange(36, 1, 36, 12),
+		"surah yaseen ayat 1":           newRange(36, 1, 36, 1),
+		"Al-Mulk":                       newRange(67, 1, 67, 30),
+		"al ikhlas 1–4":                 newRange(112, 1, 112, 4),
+		"Aal-i-Imraan 7":                newRange(3, 7, 3, 7),
+		"ali imran 7":                   newRange(3, 7, 3, 7),
+		"Al-Kahfi 10":                   newRange(18, 10, 18, 10),
+		"An-Nas":                        newRange(114, 1, 114, 6),
+		"An-Nasr":                       newRange(110, 1, 110, 3),
+		"البقرة ٢٥٥":                    newRange(2, 255, 2, 255),
+		"Surat Al-Fatihah, 1-7":         newRange(1, 1, 1, 7),
+		"Al-Baqara 2:255":               newRange(2, 255, 2, 255),
+		"Al-Baqarah 285 - Ali 'Imran 5": newRange(2, 285, 3, 5),
+	}
+	for input, expected := range testCases {
+		actual, err := Parse(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, actual, input)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, input := range []string{"", "0:1", "115", "2:0", "2:287", "2:257-255", "3:1-2:5", "Al-Baqara 3:1", "quranize 1", "al baqoroh 300"} {
+		_, err := Parse(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		input    quranize.Range
+		style    Style
+		expected string
+	}{
+		{newRange(2, 255, 2, 255), Numeric, "2:255"},
+		{newRange(2, 255, 2, 257), Numeric, "2:255-257"},
+		{newRange(2, 285, 3, 5), Numeric, "2:285-3:5"},
+		{newRange(112, 1, 112, 4), Numeric, "112"},
+		{newRange(2, 255, 2, 257), Indonesian, "QS. Al-Baqarah: 255-257"},
+		{newRange(36, 1, 36, 83), Indonesian, "QS. Yasin"},
+		{newRange(2, 255, 2, 257), Latin, "Al-Baqara 2:255-257"},
+		{newRange(1, 1, 1, 7), Latin, "Al-Faatiha"},
+		{newRange(2, 285, 3, 5), Latin, "Al-Baqara 2:285 - Aal-i-Imraan 3:5"},
+		{newRange(2, 285, 3, 5), Indonesian, "QS. Al-Baqarah: 285 - QS. Ali 'Imran: 5"},
+		{newRange(2, 255, 2, 257), Arabic, "البقرة ٢٥٥-٢٥٧"},
+		{newRange(0, 1, 0, 1), Numeric, "{{0 1 0} {0 1 0}}"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, Format(tc.input, tc.style))
+	}
+}
+
+func TestFormatParse(t *testing.T) {
+	for _, style := range []Style{Numeric, Indonesian, Latin, Arabic} {
+		for _, r := range []quranize.Range{newRange(2, 255, 2, 257), newRange(36, 1, 36, 83), newRange(1, 1, 114, 6)} {
+			actual, err := Parse(Format(r, style))
+			assert.NoError(t, err, Format(r, style))
+			assert.Equal(t, r, actual, Format(r, style))
+		}
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	for _, input := range []string{"al-baqarah", "al baqoroh", "Al-Baqara", "Al-Baqaroh"} {
+		assert.Equal(t, "albakara", normalizeName(input), input)
+	}
+}
@@ -0,0 +1,60 @@
+package quranize
+
+import (
+	"strings"
+)
+
+// SearchOptions configures Search.
+type SearchOptions struct {
+	Edition     Quran // Quran of AyaText, e.g. NewQuranSimpleEnhanced, defaults to the indexed Quran
+	Translation Quran // optional Quran of Translation, e.g. NewIDIndonesian
+	Limit       int   // maximum number of results, zero means no limit
+}
+
+// SearchResult is an occurrence of an arabic encoding in Quran along with its verse context.
+type SearchResult struct {
+	Text        string   // matched arabic phrase
+	Score       float64  // ranking score of the encoding, see EncodeResult
+	Sura        int      // sura number, starting from 1
+	SuraName    string   // sura name
+	Aya         int      // aya number, starting from 1, or 0 for bismillah indexed WithBismillah
+	WordStart   int      // word index of the first matched word
+	WordEnd     int      // word index of the last matched word in the aya
+	End         Location // location of the last matched word, in a following aya if the phrase crosses aya boundaries
+	AyaText     string   // aya in SearchOptions.Edition
+	Translation string   // aya in SearchOptions.Translation, empty if not set
+}
+
+// Search encodes s and locates every encoding in Quran, sorted best-first by encoding, then by location.
+func (q Quranize) Search(s string, opts SearchOptions) []SearchResult {
+	edition := opts.Edition
+	if len(edition.Suras) == 0 {
+		edition = q.q
+	}
+
+	results := []SearchResult{}
+	for _, encoded := range q.EncodeRanked(s) {
+		for _, r := range q.LocateRanges(encoded.Text) {
+			if opts.Limit > 0 && len(results) >= opts.Limit {
+				return results
+			}
+			result := SearchResult{
+				Text:      encoded.Text,
+				Score:     encoded.Score,
+				Sura:      r.Start.GetSura(),
+				Aya:       r.Start.GetAya(),
+				WordStart: r.Start.GetWordIndex(),
+				WordEnd:   r.End.GetWordIndex(),
+				End:       r.End,
+			}
+			if r.End.GetSura() != result.Sura || r.End.GetAya() != result.Aya {
+				result.WordEnd = strings.Count(q.q.text(result.Sura, result.Aya), " ")
+			}
+			result.SuraName, _ = q.q.GetSuraName(result.Sura)
+			result.AyaText = edition.text(result.Sura, result.Aya)
+			result.Translation = opts.Translation.text(result.Sura, result.Aya)
+			results = append(results, result)
+		}
+	}
+	return results
+}
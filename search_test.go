@@ -0,0 +1,39 @@
+package quranize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchEmptyString(t *testing.T) {
+	assert.Equal(t, []SearchResult{}, quranizeTest.Search("", SearchOptions{}))
+}
+
+func TestSearchAlquran(t *testing.T) {
+	results := quranizeTest.Search("alhamdulillah hirobbil 'alamin", SearchOptions{})
+	assert.Len(t, results, 4)
+	first := results[0]
+	assert.Equal(t, "الحمد لله رب العالمين", first.Text)
+	assert.Equal(t, 1, first.Sura)
+	assert.Equal(t, "الفاتحة", first.SuraName)
+	assert.Equal(t, 2, first.Aya)
+	assert.Equal(t, 0, first.WordStart)
+	assert.Equal(t, 3, first.WordEnd)
+	assert.Equal(t, NewLocation(1, 2, 3), first.End)
+	assert.Equal(t, "الحمد لله رب العالمين", first.AyaText)
+	assert.Equal(t, "", first.Translation)
+	assert.Equal(t, 10, results[1].Sura)
+	assert.Equal(t, 10, results[1].WordStart)
+	assert.Equal(t, 13, results[1].WordEnd)
+}
+
+func TestSearchEditionAndTranslation(t *testing.T) {
+	enhanced := NewQuranSimpleEnhanced()
+	opts := SearchOptions{Edition: enhanced, Translation: NewIDIndonesian(), Limit: 1}
+	results := quranizeTest.Search("alhamdulillah hirobbil 'alamin", opts)
+	assert.Len(t, results, 1)
+	expected, _ := enhanced.GetAya(1, 2)
+	assert.Equal(t, expected, results[0].AyaText)
+	assert.Contains(t, results[0].Translation, "Segala puji bagi Allah")
+}
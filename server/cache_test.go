@@ -0,0 +1,39 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheEviction(t *testing.T) {
+	c := newCache(2)
+	c.add("a", []byte("1"))
+	c.add("b", []byte("2"))
+	_, ok := c.get("a")
+	assert.True(t, ok)
+	c.add("c", []byte("3"))
+
+	_, ok = c.get("b")
+	assert.False(t, ok)
+	value, ok := c.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), value)
+	assert.Equal(t, 2, c.len())
+}
+
+func TestCacheUpdate(t *testing.T) {
+	c := newCache(1)
+	c.add("a", []byte("1"))
+	c.add("a", []byte("2"))
+	value, _ := c.get("a")
+	assert.Equal(t, []byte("2"), value)
+	assert.Equal(t, 1, c.len())
+}
+
+func TestCacheDisabled(t *testing.T) {
+	c := newCache(0)
+	c.add("a", []byte("1"))
+	_, ok := c.get("a")
+	assert.False(t, ok)
+}
@@ -0,0 +1,266 @@
+// Package server provides an HTTP JSON API of quranize, embeddable in other servers as an http.Handler.
+//
+// Endpoints (all GET):
+//  /v1/encode?q=alhamdulillah              arabic encodings of alphabet along with locations
+//  /v1/locate?q=الحمد لله                  locations of arabic
+//  /v1/ayas/1/2?translation=id.indonesian  aya text, optionally with translation
+//  /v1/translations/id.indonesian/1/2      translation of an aya
+//
+// Errors are returned with non-2xx status and body:
+//  {"error": {"code": "invalid_query", "message": "..."}}
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/alpancs/quranize"
+)
+
+// Default limits of Config.
+const (
+	DefaultMaxInputLength = 100
+	DefaultMaxResults     = 50
+	DefaultTimeout        = 5 * time.Second
+	DefaultCacheSize      = 1024
+)
+
+// statusClientClosedRequest is the nonstandard status of requests canceled by the client.
+const statusClientClosedRequest = 499
+
+// Config configures Handler. Zero value of each limit means its default,
+// zero Quranize means quranize.NewDefaultQuranize() and zero Quran means quranize.NewQuranSimpleClean().
+type Config struct {
+	Quranize       quranize.Quranize
+	Quran          quranize.Quran            // edition of aya text, e.g. quranize.NewQuranSimpleEnhanced()
+	Translations   map[string]quranize.Quran // translations by name, e.g. "id.indonesian"
+	MaxInputLength int                       // maximum number of characters of q
+	MaxResults     int                       // maximum number of encodings
+	Timeout        time.Duration             // maximum duration of encoding
+	CacheSize      int                       // maximum number of cached responses, negative disables cache
+}
+
+// Handler serves the HTTP JSON API.
+type Handler struct {
+	config Config
+	mux    *http.ServeMux
+	cache  *cache
+}
+
+// Error is an error response body.
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type apiError struct {
+	status int
+	Error
+}
+
+// Location is a location in JSON responses.
+type Location struct {
+	Sura int `json:"sura"`
+	Aya  int `json:"aya"`
+	Word int `json:"word"`
+}
+
+// Encoding is an arabic encoding in response of /v1/encode.
+type Encoding struct {
+	Text      string     `json:"text"`
+	Score     float64    `json:"score"`
+	Locations []Location `json:"locations"`
+}
+
+// Aya is response of /v1/ayas and /v1/translations.
+type Aya struct {
+	Sura        int    `json:"sura"`
+	Aya         int    `json:"aya"`
+	Text        string `json:"text"`
+	Translation string `json:"translation,omitempty"`
+}
+
+// NewHandler returns new Handler using config c.
+func NewHandler(c Config) *Handler {
+	if reflect.DeepEqual(c.Quranize, quranize.Quranize{}) {
+		c.Quranize = quranize.NewDefaultQuranize()
+	}
+	if len(c.Quran.Suras) == 0 {
+		c.Quran = quranize.NewQuranSimpleClean()
+	}
+	if c.MaxInputLength == 0 {
+		c.MaxInputLength = DefaultMaxInputLength
+	}
+	if c.MaxResults == 0 {
+		c.MaxResults = DefaultMaxResults
+	}
+	if c.Timeout == 0 {
+		c.Timeout = DefaultTimeout
+	}
+	if c.CacheSize == 0 {
+		c.CacheSize = DefaultCacheSize
+	}
+
+	h := &Handler{config: c, mux: http.NewServeMux(), cache: newCache(c.CacheSize)}
+	h.mux.Handle("/v1/encode", h.endpoint(h.encode))
+	h.mux.Handle("/v1/locate", h.endpoint(h.locate))
+	h.mux.Handle("/v1/ayas/", h.endpoint(h.aya))
+	h.mux.Handle("/v1/translations/", h.endpoint(h.translation))
+	h.mux.Handle("/", h.endpoint(func(r *http.Request) (interface{}, *apiError) {
+		return nil, notFound("unknown endpoint %s", r.URL.Path)
+	}))
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// endpoint returns http.Handler serving JSON of result of f, cached by path and query.
+func (h *Handler) endpoint(f func(*http.Request) (interface{}, *apiError)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			writeJSON(w, http.StatusMethodNotAllowed, map[string]Error{
+				"error": {"method_not_allowed", fmt.Sprintf("method %s is not allowed", r.Method)},
+			})
+			return
+		}
+
+		key := r.URL.Path + "?" + r.URL.Query().Encode()
+		if body, ok := h.cache.get(key); ok {
+			w.Header().Set("X-Cache", "HIT")
+			writeBody(w, http.StatusOK, body)
+			return
+		}
+
+		result, err := f(r)
+		if err != nil {
+			writeJSON(w, err.status, map[string]Error{"error": err.Error})
+			return
+		}
+		body, _ := json.Marshal(result)
+		h.cache.add(key, body)
+		w.Header().Set("X-Cache", "MISS")
+		writeBody(w, http.StatusOK, body)
+	})
+}
+
+func (h *Handler) encode(r *http.Request) (interface{}, *apiError) {
+	input, err := h.query(r)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), h.config.Timeout)
+	defer cancel()
+	results, encodeErr := h.config.Quranize.EncodeContext(ctx, input, quranize.EncodeOptions{MaxCandidates: h.config.MaxResults})
+	switch encodeErr {
+	case context.Canceled:
+		return nil, &apiError{statusClientClosedRequest, Error{"canceled", "request was canceled"}}
+	case context.DeadlineExceeded:
+		return nil, &apiError{http.StatusServiceUnavailable, Error{"timeout", "encoding took too long"}}
+	}
+
+	encodings := []Encoding{}
+	for _, result := range results {
+		encodings = append(encodings, Encoding{result.Text, result.Score, newLocations(h.config.Quranize.Locate(result.Text))})
+	}
+	return map[string][]Encoding{"encodings": encodings}, nil
+}
+
+func (h *Handler) locate(r *http.Request) (interface{}, *apiError) {
+	input, err := h.query(r)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]Location{"locations": newLocations(h.config.Quranize.Locate(input))}, nil
+}
+
+func (h *Handler) aya(r *http.Request) (interface{}, *apiError) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/ayas/"), "/")
+	if len(parts) != 2 {
+		return nil, notFound("unknown endpoint %s", r.URL.Path)
+	}
+	aya, err := getAya(h.config.Quran, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	if name := r.URL.Query().Get("translation"); name != "" {
+		translation, ok := h.config.Translations[name]
+		if !ok {
+			return nil, notFound("unknown translation %q", name)
+		}
+		aya.Translation, _ = translation.GetAya(aya.Sura, aya.Aya)
+	}
+	return aya, nil
+}
+
+func (h *Handler) translation(r *http.Request) (interface{}, *apiError) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/translations/"), "/")
+	if len(parts) != 3 {
+		return nil, notFound("unknown endpoint %s", r.URL.Path)
+	}
+	translation, ok := h.config.Translations[parts[0]]
+	if !ok {
+		return nil, notFound("unknown translation %q", parts[0])
+	}
+	return getAya(translation, parts[1], parts[2])
+}
+
+// query returns non-empty query parameter q within MaxInputLength.
+func (h *Handler) query(r *http.Request) (string, *apiError) {
+	input := strings.TrimSpace(r.URL.Query().Get("q"))
+	if input == "" {
+		return "", &apiError{http.StatusBadRequest, Error{"invalid_query", "query parameter q is required"}}
+	}
+	if utf8.RuneCountInString(input) > h.config.MaxInputLength {
+		message := fmt.Sprintf("query parameter q is longer than %d characters", h.config.MaxInputLength)
+		return "", &apiError{http.StatusBadRequest, Error{"query_too_long", message}}
+	}
+	return input, nil
+}
+
+func getAya(q quranize.Quran, sura, aya string) (Aya, *apiError) {
+	s, sErr := strconv.Atoi(sura)
+	a, aErr := strconv.Atoi(aya)
+	if sErr != nil || aErr != nil {
+		return Aya{}, notFound("invalid aya %s/%s", sura, aya)
+	}
+	text, err := q.GetAya(s, a)
+	if err != nil {
+		return Aya{}, notFound("%v", err)
+	}
+	return Aya{Sura: s, Aya: a, Text: text}, nil
+}
+
+func notFound(format string, a ...interface{}) *apiError {
+	return &apiError{http.StatusNotFound, Error{"not_found", fmt.Sprintf(format, a...)}}
+}
+
+func newLocations(locations []quranize.Location) []Location {
+	ls := []Location{}
+	for _, l := range locations {
+		ls = append(ls, Location{l.GetSura(), l.GetAya(), l.GetWordIndex()})
+	}
+	return ls
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	body, _ := json.Marshal(v)
+	writeBody(w, status, body)
+}
+
+func writeBody(w http.ResponseWriter, status int, body []byte) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(body)
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alpancs/quranize"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	configTest  Config
+	handlerTest *Handler
+)
+
+func TestMain(m *testing.M) {
+	configTest = Config{
+		Quranize:     quranize.NewDefaultQuranize(),
+		Quran:        quranize.NewQuranSimpleClean(),
+		Translations: map[string]quranize.Quran{"id.indonesian": quranize.NewIDIndonesian()},
+	}
+	handlerTest = NewHandler(configTest)
+	os.Exit(m.Run())
+}
+
+func get(h http.Handler, target string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+func assertError(t *testing.T, w *httptest.ResponseRecorder, status int, code string) {
+	var body map[string]Error
+	assert.Equal(t, status, w.Code)
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, code, body["error"].Code)
+	assert.NotEmpty(t, body["error"].Message)
+}
+
+func TestEncode(t *testing.T) {
+	w := get(handlerTest, "/v1/encode?q="+url.QueryEscape("alhamdulillah hirobbil 'alamin"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+	var body map[string][]Encoding
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Len(t, body["encodings"], 1)
+	assert.Equal(t, "الحمد لله رب العالمين", body["encodings"][0].Text)
+	assert.Equal(t, Location{1, 2, 0}, body["encodings"][0].Locations[0])
+}
+
+func TestEncodeInvalidQuery(t *testing.T) {
+	assertError(t, get(handlerTest, "/v1/encode"), http.StatusBadRequest, "invalid_query")
+	assertError(t, get(handlerTest, "/v1/encode?q="+strings.Repeat("a", 101)), http.StatusBadRequest, "query_too_long")
+}
+
+func TestEncodeTimeout(t *testing.T) {
+	c := configTest
+	c.Timeout = time.Nanosecond
+	assertError(t, get(NewHandler(c), "/v1/encode?q=bismillah"), http.StatusServiceUnavailable, "timeout")
+}
+
+func TestEncodeCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w := httptest.NewRecorder()
+	handlerTest.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/encode?q=bismillah", nil).WithContext(ctx))
+	assertError(t, w, statusClientClosedRequest, "canceled")
+}
+
+func TestZeroConfig(t *testing.T) {
+	h := NewHandler(Config{})
+	var body map[string][]Encoding
+	assert.NoError(t, json.Unmarshal(get(h, "/v1/encode?q=bismillah").Body.Bytes(), &body))
+	assert.NotEmpty(t, body["encodings"])
+	assert.Equal(t, http.StatusOK, get(h, "/v1/ayas/1/1").Code)
+}
+
+func TestEncodeMaxResults(t *testing.T) {
+	c := configTest
+	c.MaxResults = 1
+	var body map[string][]Encoding
+	w := get(NewHandler(c), "/v1/encode?q=bismillah")
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Len(t, body["encodings"], 1)
+}
+
+func TestLocate(t *testing.T) {
+	w := get(handlerTest, "/v1/locate?q="+url.QueryEscape("بسم الله"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"locations":[{"sura":1,"aya":1,"word":0},{"sura":11,"aya":41,"word":3},{"sura":27,"aya":30,"word":4}]}`, w.Body.String())
+
+	w = get(handlerTest, "/v1/locate?q=alfan")
+	assert.JSONEq(t, `{"locations":[]}`, w.Body.String())
+}
+
+func TestAya(t *testing.T) {
+	w := get(handlerTest, "/v1/ayas/1/2")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"sura":1,"aya":2,"text":"الحمد لله رب العالمين"}`, w.Body.String())
+
+	var body Aya
+	w = get(handlerTest, "/v1/ayas/1/2?translation=id.indonesian")
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "Segala puji bagi Allah, Tuhan semesta alam.", body.Translation)
+
+	assertError(t, get(handlerTest, "/v1/ayas/1/8"), http.StatusNotFound, "not_found")
+	assertError(t, get(handlerTest, "/v1/ayas/x/1"), http.StatusNotFound, "not_found")
+	assertError(t, get(handlerTest, "/v1/ayas/1"), http.StatusNotFound, "not_found")
+	assertError(t, get(handlerTest, "/v1/ayas/1/2?translation=en"), http.StatusNotFound, "not_found")
+}
+
+func TestTranslation(t *testing.T) {
+	w := get(handlerTest, "/v1/translations/id.indonesian/1/2")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"sura":1,"aya":2,"text":"Segala puji bagi Allah, Tuhan semesta alam."}`, w.Body.String())
+
+	assertError(t, get(handlerTest, "/v1/translations/en/1/2"), http.StatusNotFound, "not_found")
+	assertError(t, get(handlerTest, "/v1/translations/id.indonesian/115/1"), http.StatusNotFound, "not_found")
+}
+
+func TestUnknownEndpoint(t *testing.T) {
+	assertError(t, get(handlerTest, "/v2/encode?q=bismillah"), http.StatusNotFound, "not_found")
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	w := httptest.NewRecorder()
+	handlerTest.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/locate?q=x", nil))
+	assertError(t, w, http.StatusMethodNotAllowed, "method_not_allowed")
+	assert.Equal(t, "GET, HEAD", w.Header().Get("Allow"))
+}
+
+func TestCache(t *testing.T) {
+	h := NewHandler(configTest)
+	first := get(h, "/v1/locate?q="+url.QueryEscape("الحمد لله"))
+	second := get(h, "/v1/locate?q="+url.QueryEscape("الحمد لله"))
+	assert.Equal(t, "MISS", first.Header().Get("X-Cache"))
+	assert.Equal(t, "HIT", second.Header().Get("X-Cache"))
+	assert.Equal(t, first.Body.String(), second.Body.String())
+
+	get(h, "/v1/ayas/1/8")
+	assert.Equal(t, 1, h.cache.len())
+
+	c := configTest
+	c.CacheSize = -1
+	h = NewHandler(c)
+	get(h, "/v1/ayas/1/2")
+	assert.Equal(t, "MISS", get(h, "/v1/ayas/1/2").Header().Get("X-Cache"))
+}
+
+func TestServer(t *testing.T) {
+	s := httptest.NewServer(http.StripPrefix("/quranize", handlerTest))
+	defer s.Close()
+	resp, err := http.Get(s.URL + "/quranize/v1/ayas/112/1")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	var body Aya
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "قل هو الله أحد", body.Text)
+}
@@ -0,0 +1,275 @@
+package quranize
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// BM25 parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// TextIndex is an inverted index for full-text search in translation Quran (e.g. NewIDIndonesian).
+type TextIndex struct {
+	docs      []Location
+	lengths   []int
+	avgLength float64
+	postings  map[string][]posting
+}
+
+type posting struct {
+	doc       int
+	positions []int
+}
+
+// TextResult is a search hit of TextIndex, located by sura and aya (word index is always 0).
+type TextResult struct {
+	Location Location
+	Score    float64
+}
+
+type token struct {
+	term     string
+	position int
+}
+
+var indonesianStopwords = makeSet(strings.Fields(`
+	ada adalah agar akan aku antara apa apabila atas atau bagi bahwa bahwasanya
+	begitu bila dalam dan dari demikian dengan di dia ia ialah ini itu juga
+	kalau karena ke kepada kami kamu kita lagi lalu maka mana mereka nya oleh
+	pada para pun saja sebagai sedang sesungguhnya setelah supaya telah tentang
+	terhadap untuk yaitu yakni yang
+`))
+
+var latinFolds = strings.NewReplacer("â", "a", "á", "a", "à", "a", "î", "i", "í", "i", "û", "u", "ú", "u", "ê", "e", "é", "e", "ô", "o")
+
+// NewTextIndex returns new TextIndex of every aya of translation Quran q.
+func NewTextIndex(q Quran) TextIndex {
+	idx := TextIndex{postings: make(map[string][]posting)}
+	total := 0
+	for s, sura := range q.Suras {
+		for a, aya := range sura.Ayas {
+			doc := len(idx.docs)
+			tokens := tokenize(aya.Text)
+			idx.docs = append(idx.docs, NewLocation(s+1, a+1, 0))
+			idx.lengths = append(idx.lengths, len(tokens))
+			total += len(tokens)
+			idx.addDocument(doc, tokens)
+		}
+	}
+	if len(idx.docs) > 0 {
+		idx.avgLength = float64(total) / float64(len(idx.docs))
+	}
+	return idx
+}
+
+func (idx *TextIndex) addDocument(doc int, tokens []token) {
+	for _, t := range tokens {
+		postings := idx.postings[t.term]
+		if n := len(postings); n > 0 && postings[n-1].doc == doc {
+			postings[n-1].positions = append(postings[n-1].positions, t.position)
+			continue
+		}
+		idx.postings[t.term] = append(postings, posting{doc, []int{t.position}})
+	}
+}
+
+// Search returns at most limit ayas matching query, sorted by BM25 score.
+//
+// Words in query are stemmed and stopwords are ignored.
+// An aya matches if it contains any of the words, and all phrases written in double quotes, e.g.
+//  "maha penyayang" ampun
+func (idx TextIndex) Search(query string, limit int) []TextResult {
+	results := []TextResult{}
+	terms, phrases := parseTextQuery(query)
+	if len(terms) == 0 || limit <= 0 {
+		return results
+	}
+
+	scores := make(map[int]float64)
+	for _, term := range terms {
+		postings := idx.postings[term]
+		idf := math.Log(1 + (float64(len(idx.docs))-float64(len(postings))+0.5)/(float64(len(postings))+0.5))
+		for _, p := range postings {
+			tf := float64(len(p.positions))
+			norm := bm25K1 * (1 - bm25B + bm25B*float64(idx.lengths[p.doc])/idx.avgLength)
+			scores[p.doc] += idf * tf * (bm25K1 + 1) / (tf + norm)
+		}
+	}
+
+	for doc, score := range scores {
+		if idx.matchPhrases(doc, phrases) {
+			results = append(results, TextResult{idx.docs[doc], score})
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		a, b := results[i].Location, results[j].Location
+		return a.sura < b.sura || (a.sura == b.sura && a.aya < b.aya)
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+func parseTextQuery(query string) (terms []string, phrases [][]token) {
+	seen := make(map[string]bool)
+	for i, part := range strings.Split(query, `"`) {
+		tokens := tokenize(part)
+		for _, t := range tokens {
+			if !seen[t.term] {
+				seen[t.term] = true
+				terms = append(terms, t.term)
+			}
+		}
+		if i%2 == 1 && len(tokens) > 1 {
+			phrases = append(phrases, tokens)
+		}
+	}
+	return terms, phrases
+}
+
+// matchPhrases reports whether doc contains every phrase.
+func (idx TextIndex) matchPhrases(doc int, phrases [][]token) bool {
+	for _, phrase := range phrases {
+		if !idx.matchPhrase(doc, phrase) {
+			return false
+		}
+	}
+	return true
+}
+
+func (idx TextIndex) matchPhrase(doc int, phrase []token) bool {
+	starts := idx.positions(phrase[0].term, doc)
+	for _, start := range starts {
+		matched := true
+		for _, t := range phrase[1:] {
+			if !containsInt(idx.positions(t.term, doc), start+t.position-phrase[0].position) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (idx TextIndex) positions(term string, doc int) []int {
+	postings := idx.postings[term]
+	i := sort.Search(len(postings), func(i int) bool { return postings[i].doc >= doc })
+	if i < len(postings) && postings[i].doc == doc {
+		return postings[i].positions
+	}
+	return nil
+}
+
+func containsInt(sorted []int, x int) bool {
+	i := sort.SearchInts(sorted, x)
+	return i < len(sorted) && sorted[i] == x
+}
+
+// tokenize returns stemmed terms of s with their word positions, skipping stopwords.
+func tokenize(s string) []token {
+	tokens := []token{}
+	words := strings.FieldsFunc(latinFolds.Replace(strings.ToLower(s)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for position, word := range words {
+		if indonesianStopwords[word] {
+			continue
+		}
+		tokens = append(tokens, token{stemIndonesian(word), position})
+	}
+	return tokens
+}
+
+// stemIndonesian returns stem of an indonesian word using rule-based (non-dictionary) Tala stemmer.
+func stemIndonesian(word string) string {
+	word = trimSuffixes(word, "kah", "lah", "pun")
+	word = trimSuffixes(word, "ku", "mu", "nya")
+
+	stem, ok := trimFirstOrderPrefix(word)
+	if ok {
+		if trimmed := trimSuffixes(stem, "kan", "an", "i"); trimmed != stem {
+			stem = trimSecondOrderPrefix(trimmed)
+		} else {
+			stem = trimSecondOrderPrefix(stem)
+		}
+		return stem
+	}
+
+	stem = trimSecondOrderPrefix(word)
+	if stem != word {
+		return trimSuffixes(stem, "kan", "an", "i")
+	}
+	return trimSuffixes(word, "kan", "an", "i")
+}
+
+// trimSuffixes removes the first matching suffix as long as the stem keeps more than one syllable.
+func trimSuffixes(word string, suffixes ...string) string {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(word, suffix) && syllables(word[:len(word)-len(suffix)]) > 1 {
+			return word[:len(word)-len(suffix)]
+		}
+	}
+	return word
+}
+
+// firstOrderPrefixes lists prefixes along with the initial letter dropped when the stem starts with a vowel,
+// e.g. "menulis" from "tulis" and "memukul" from "pukul".
+var firstOrderPrefixes = []struct{ prefix, replacement string }{
+	{"meng", ""}, {"meny", "s"}, {"men", "t"}, {"mem", "p"}, {"me", ""},
+	{"peng", ""}, {"peny", "s"}, {"pen", "t"}, {"pem", "p"},
+	{"di", ""}, {"ter", ""}, {"ke", ""},
+}
+
+func trimFirstOrderPrefix(word string) (string, bool) {
+	for _, p := range firstOrderPrefixes {
+		if strings.HasPrefix(word, p.prefix) && len(word) > len(p.prefix) {
+			stem := word[len(p.prefix):]
+			if isVowel(stem[0]) {
+				stem = p.replacement + stem
+			}
+			if syllables(stem) > 1 {
+				return stem, true
+			}
+		}
+	}
+	return word, false
+}
+
+func trimSecondOrderPrefix(word string) string {
+	for _, prefix := range []string{"ber", "bel", "be", "per", "pel", "pe"} {
+		if strings.HasPrefix(word, prefix) && syllables(word[len(prefix):]) > 1 {
+			return word[len(prefix):]
+		}
+	}
+	return word
+}
+
+func syllables(word string) int {
+	n := 0
+	for i := 0; i < len(word); i++ {
+		if isVowel(word[i]) {
+			n++
+		}
+	}
+	return n
+}
+
+func makeSet(words []string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
@@ -0,0 +1,43 @@
+package quranize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemIndonesian(t *testing.T) {
+	testCases := map[string]string{
+		"menyebut":    "sebut",
+		"pertolongan": "tolong",
+		"penyayang":   "sayang",
+		"dikurangi":   "kurang",
+		"pembalasan":  "balas",
+		"kebenaran":   "benar",
+		"menulis":     "tulis",
+		"kitab":       "kitab",
+	}
+	for input, expected := range testCases {
+		actual := stemIndonesian(input)
+		assert.Equalf(t, expected, actual, "input = %#v", input)
+	}
+}
+
+func TestTextIndexSearch(t *testing.T) {
+	idx := NewTextIndex(NewIDIndonesian())
+
+	results := idx.Search("tunjukilah kami jalan yang lurus", 3)
+	assert.Len(t, results, 3)
+	assert.Equal(t, NewLocation(1, 6, 0), results[0].Location)
+
+	locations := []Location{}
+	for _, result := range idx.Search(`"maha pemurah lagi maha penyayang"`, 10) {
+		locations = append(locations, result.Location)
+	}
+	assert.Contains(t, locations, NewLocation(1, 1, 0))
+	assert.Contains(t, locations, NewLocation(1, 3, 0))
+
+	assert.Empty(t, idx.Search("", 10))
+	assert.Empty(t, idx.Search("yang dan", 10))
+	assert.Empty(t, idx.Search(`"lurus tunjukilah"`, 10))
+}
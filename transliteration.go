@@ -9,11 +9,21 @@ import (
 // Transliteration helps Quranize to encode arabic into alphabet.
 type Transliteration struct {
 	hijaiyas       map[string][]string
+	costs          map[string][]int
+	alphabets      map[string][]string
+	alphabetCosts  map[string][]int
 	alphabetMaxLen int
 }
 
+// Costs of alphabets of an arabic, see spellingCosts.
+const (
+	fallbackCost = 2
+	vowelCost    = 1
+	sharedCost   = 1
+)
+
 var (
-	base = []string{""}
+	base = []encoding{{}}
 )
 
 // NewDefaultTransliteration returns new Transliteration using default mapping.
@@ -24,15 +34,33 @@ func NewDefaultTransliteration() Transliteration {
 }
 
 // NewTransliteration returns new Transliteration.
+//
+// Each line of raw is an arabic followed by its alphabets, separated by " ".
+// Consonant spellings listed earlier are preferred when ranking encodings, see spellingCosts.
 func NewTransliteration(raw string) Transliteration {
 	hijaiyas := make(map[string][]string)
+	costs := make(map[string][]int)
+	alphabets := make(map[string][]string)
+	alphabetCosts := make(map[string][]int)
 	alphabetMaxLen := 0
 
-	for _, line := range strings.Split(strings.TrimSpace(raw), "\n") {
+	lines := strings.Split(strings.TrimSpace(raw), "\n")
+	sole := make(map[string]bool)
+	for _, line := range lines {
+		if stems := consonantStems(strings.Split(line, " ")[1:]); len(stems) == 1 {
+			sole[stems[0]] = true
+		}
+	}
+
+	for _, line := range lines {
 		components := strings.Split(line, " ")
 		arabic := components[0]
-		for _, alphabet := range components[1:] {
+		for i, cost := range spellingCosts(components[1:], sole) {
+			alphabet := components[1+i]
 			hijaiyas[alphabet] = append(hijaiyas[alphabet], arabic)
+			costs[alphabet] = append(costs[alphabet], cost)
+			alphabets[arabic] = append(alphabets[arabic], alphabet)
+			alphabetCosts[arabic] = append(alphabetCosts[arabic], cost)
 
 			length := len(alphabet)
 			ending := alphabet[length-1]
@@ -42,6 +70,9 @@ func NewTransliteration(raw string) Transliteration {
 				alphabet += alphabet
 			}
 			hijaiyas[alphabet] = append(hijaiyas[alphabet], arabic)
+			costs[alphabet] = append(costs[alphabet], cost)
+			alphabets[arabic] = append(alphabets[arabic], alphabet)
+			alphabetCosts[arabic] = append(alphabetCosts[arabic], cost)
 
 			length = len(alphabet)
 			if length > alphabetMaxLen {
@@ -50,5 +81,68 @@ func NewTransliteration(raw string) Transliteration {
 		}
 	}
 
-	return Transliteration{hijaiyas, alphabetMaxLen}
+	return Transliteration{hijaiyas, costs, alphabets, alphabetCosts, alphabetMaxLen}
+}
+
+// spellingCosts returns costs of alphabets of an arabic.
+//
+// Alphabets sharing a consonant spelling (e.g. "b", "ba", "bi", and "bu") form a group,
+// costing fallbackCost for every group listed before it, except shared groups.
+// Vowels listed along with their group are free, while a vowel listed apart from its group
+// (e.g. "sa" of ص, listed after "sh") costs vowelCost.
+// Alphabets without consonant cost as much as the group listed before them, if any,
+// and only the first of them is free of vowelCost (e.g. "a", "i", and "u" of ا cost 0, 1, and 1,
+// while "a" of ع, listed after its fallback "k", costs as much as "k").
+// A group is shared if its consonant spelling is the sole spelling of other arabic
+// (e.g. "k" of ق, the sole spelling of ك) while the arabic has other spellings, costing sharedCost more.
+func spellingCosts(alphabets []string, sole map[string]bool) []int {
+	costs := make([]int, len(alphabets))
+	stems := consonantStems(alphabets)
+	shared := func(stem string) bool { return sole[stem] && len(stems) > 1 }
+	previous, vowels, groupCost := "", 0, 0
+	seen := make(map[string]bool)
+	for i, alphabet := range alphabets {
+		stem := strings.TrimRight(alphabet, "aeiou")
+		if stem == "" {
+			costs[i] = groupCost
+			if vowels > 0 {
+				costs[i] += vowelCost
+			}
+			previous, vowels = stem, vowels+1
+			continue
+		}
+
+		if seen[stem] && stem != previous {
+			costs[i] = vowelCost
+		}
+		for _, other := range stems {
+			if other == stem {
+				break
+			}
+			if !shared(other) {
+				costs[i] += fallbackCost
+			}
+		}
+		if shared(stem) {
+			costs[i] += sharedCost
+		}
+		if stem != previous {
+			groupCost = costs[i]
+		}
+		previous, seen[stem] = stem, true
+	}
+	return costs
+}
+
+// consonantStems returns distinct consonant spellings of alphabets, i.e. alphabets without trailing vowels.
+func consonantStems(alphabets []string) []string {
+	stems := []string{}
+	seen := make(map[string]bool)
+	for _, alphabet := range alphabets {
+		if stem := strings.TrimRight(alphabet, "aeiou"); stem != "" && !seen[stem] {
+			stems = append(stems, stem)
+			seen[stem] = true
+		}
+	}
+	return stems
 }
@@ -8,7 +8,15 @@ import (
 
 func TestNewTransliterationEmpty(t *testing.T) {
 	input := ""
-	expected := Transliteration{make(map[string][]string), 0}
+	expected := Transliteration{make(map[string][]string), make(map[string][]int), make(map[string][]string), make(map[string][]int), 0}
 	actual := NewTransliteration(input)
 	assert.Equal(t, expected, actual)
 }
+
+func TestSpellingCosts(t *testing.T) {
+	sole := map[string]bool{"b": true, "s": true}
+	assert.Equal(t, []int{0, 0, 0, 0}, spellingCosts([]string{"b", "ba", "bi", "bu"}, sole))
+	assert.Equal(t, []int{0, 1, 1}, spellingCosts([]string{"a", "i", "u"}, sole))
+	assert.Equal(t, []int{0, 0, 2, 2, 3}, spellingCosts([]string{"'", "'a", "g", "a", "i"}, sole))
+	assert.Equal(t, []int{1, 1, 1, 1, 0, 0, 0, 0, 2, 1}, spellingCosts([]string{"s", "so", "si", "su", "sh", "sho", "shi", "shu", "sa", "sha"}, sole))
+}
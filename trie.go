@@ -0,0 +1,208 @@
+package quranize
+
+import (
+	"sync"
+)
+
+// linearSearchMax is the maximum number of edges of a node searched linearly.
+const linearSearchMax = 8
+
+// node is a pointer-based trie node, only used while building index.
+type node struct {
+	locations []Location
+	children  []child
+	marks     uint8
+}
+
+type child struct {
+	key   rune
+	value *node
+}
+
+// trie is a flat, array-based trie used as index of Quranize.
+//
+// Nodes are numbered in depth-first order, starting from root 0, so a path of single-child nodes is contiguous.
+// Edges of node n are edgeStarts[n] until edgeStarts[n+1], sorted by key,
+// and its locations are locationStarts[n] until locationStarts[n+1] of the pooled locations.
+//
+// It is flattened from a pointer-based trie built first, so peak memory while building index
+// is that of both tries, only steady-state memory is reduced.
+type trie struct {
+	edgeStarts     []uint32
+	locationStarts []uint32
+	marks          []uint8
+	keys           []rune
+	targets        []int32
+	pool           []Location
+
+	bestOnce sync.Once
+	best     []uint32 // maximum number of locations of every node and its descendants, see bestCount
+}
+
+func (n *node) indexAya(harfs []rune, marks []uint8, sura, aya int) {
+	wordIndex := 0
+	for i := range harfs {
+		if i == 0 || harfs[i-1] == ' ' {
+			n.buildTree(harfs[i:], marks[i:], NewLocation(sura, aya, wordIndex))
+			wordIndex++
+		}
+	}
+}
+
+func (n *node) buildTree(harfs []rune, marks []uint8, location Location) {
+	n.extendTree(harfs, marks, location, 0)
+}
+
+// extendTree is like buildTree, but only appends location to word ends from index from of harfs.
+func (n *node) extendTree(harfs []rune, marks []uint8, location Location, from int) {
+	for i, harf := range harfs {
+		c := n.getChild(harf)
+		if c == nil {
+			c = &node{}
+			n.children = append(n.children, child{harf, c})
+		}
+		n = c
+		n.marks |= marks[i]
+		if i >= from && (i == len(harfs)-1 || harfs[i+1] == ' ') {
+			n.locations = append(n.locations, location)
+		}
+	}
+}
+
+func (n *node) getChild(key rune) *node {
+	for _, c := range n.children {
+		if c.key == key {
+			return c.value
+		}
+	}
+	return nil
+}
+
+// newTrie returns flat trie of the pointer-based trie rooted at root.
+func newTrie(root *node) *trie {
+	type item struct {
+		n    *node
+		edge int
+	}
+
+	nodes, locations := root.count()
+	t := &trie{
+		edgeStarts:     make([]uint32, 0, nodes+1),
+		locationStarts: make([]uint32, 0, nodes+1),
+		marks:          make([]uint8, 0, nodes),
+		keys:           make([]rune, 0, nodes-1),
+		targets:        make([]int32, 0, nodes-1),
+		pool:           make([]Location, 0, locations),
+	}
+	stack := []item{{root, -1}}
+	for len(stack) > 0 {
+		it := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if it.edge >= 0 {
+			t.targets[it.edge] = int32(t.size())
+		}
+		t.edgeStarts = append(t.edgeStarts, uint32(len(t.keys)))
+		t.locationStarts = append(t.locationStarts, uint32(len(t.pool)))
+		t.marks = append(t.marks, it.n.marks)
+		t.pool = append(t.pool, it.n.locations...)
+
+		children := it.n.children
+		sortChildren(children)
+		for i := len(children) - 1; i >= 0; i-- {
+			stack = append(stack, item{children[i].value, len(t.keys) + i})
+		}
+		for _, c := range children {
+			t.keys = append(t.keys, c.key)
+			t.targets = append(t.targets, -1)
+		}
+	}
+	t.edgeStarts = append(t.edgeStarts, uint32(len(t.keys)))
+	t.locationStarts = append(t.locationStarts, uint32(len(t.pool)))
+	return t
+}
+
+// count returns number of nodes and locations in the trie rooted at n.
+func (n *node) count() (nodes, locations int) {
+	nodes, locations = 1, len(n.locations)
+	for _, c := range n.children {
+		childNodes, childLocations := c.value.count()
+		nodes += childNodes
+		locations += childLocations
+	}
+	return
+}
+
+// sortChildren sorts children by key using insertion sort, as most nodes have very few children.
+func sortChildren(children []child) {
+	for i := 1; i < len(children); i++ {
+		for j := i; j > 0 && children[j].key < children[j-1].key; j-- {
+			children[j], children[j-1] = children[j-1], children[j]
+		}
+	}
+}
+
+// size returns number of nodes in trie t.
+func (t *trie) size() int {
+	return len(t.marks)
+}
+
+// child returns child of node n having key, or -1 if there is none.
+// Few edges are scanned linearly, many edges are binary searched.
+func (t *trie) child(n int32, key rune) int32 {
+	start, end := int(t.edgeStarts[n]), int(t.edgeStarts[n+1])
+	if end-start <= linearSearchMax {
+		for i := start; i < end; i++ {
+			if t.keys[i] == key {
+				return t.targets[i]
+			}
+		}
+		return -1
+	}
+
+	i, j := start, end
+	for i < j {
+		mid := int(uint(i+j) >> 1)
+		if t.keys[mid] < key {
+			i = mid + 1
+		} else {
+			j = mid
+		}
+	}
+	if i < end && t.keys[i] == key {
+		return t.targets[i]
+	}
+	return -1
+}
+
+// find returns the node reached by walking s from root, or -1 if s does not exist.
+func (t *trie) find(s string) int32 {
+	return t.walk(0, s)
+}
+
+// walk returns the node reached by walking s from node n, or -1 if there is no such node.
+func (t *trie) walk(n int32, s string) int32 {
+	if t == nil || n < 0 {
+		return -1
+	}
+	for _, harf := range s {
+		if n = t.child(n, harf); n < 0 {
+			return -1
+		}
+	}
+	return n
+}
+
+// edges returns keys and targets of every edge of node n.
+func (t *trie) edges(n int32) ([]rune, []int32) {
+	start, end := t.edgeStarts[n], t.edgeStarts[n+1]
+	return t.keys[start:end:end], t.targets[start:end:end]
+}
+
+// locations returns locations of node n.
+func (t *trie) locations(n int32) []Location {
+	start, end := t.locationStarts[n], t.locationStarts[n+1]
+	if start == end {
+		return zeroLocs
+	}
+	return t.pool[start:end:end]
+}
@@ -0,0 +1,167 @@
+package quranize
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func buildPointerTrie(q Quran) *node {
+	root := &node{}
+	for s, sura := range q.Suras {
+		for a, aya := range sura.Ayas {
+			harfs := []rune(aya.Text)
+			root.indexAya(harfs, make([]uint8, len(harfs)), s+1, a+1)
+		}
+	}
+	return root
+}
+
+func (n *node) locate(s string) []Location {
+	for _, harf := range s {
+		if n = n.getChild(harf); n == nil {
+			return zeroLocs
+		}
+	}
+	return n.locations
+}
+
+func TestNewTrie(t *testing.T) {
+	root := buildPointerTrie(NewQuranSimpleClean())
+	inputs := []string{"بسم الله الرحمن الرحيم", "الحمد لله", "الم", "alfan"}
+	expected := [][]Location{}
+	for _, input := range inputs {
+		expected = append(expected, root.locate(input))
+	}
+	index := newTrie(root)
+	q := Quranize{index: index}
+	for i, input := range inputs {
+		assert.Equal(t, expected[i], q.Locate(input))
+	}
+	assert.True(t, index.valid())
+	assert.Equal(t, int32(-1), index.child(0, 'x'))
+}
+
+func heapAlloc() uint64 {
+	var stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
+
+func BenchmarkBuildPointerTrie(b *testing.B) {
+	quran := NewQuranSimpleClean()
+	for i := 0; i < b.N; i++ {
+		before := heapAlloc()
+		root := buildPointerTrie(quran)
+		b.Logf("memory: %d MB", (heapAlloc()-before)>>20)
+		runtime.KeepAlive(root)
+	}
+}
+
+func BenchmarkBuildTrie(b *testing.B) {
+	quran := NewQuranSimpleClean()
+	for i := 0; i < b.N; i++ {
+		before := heapAlloc()
+		root := buildPointerTrie(quran)
+		index := newTrie(root)
+		b.Logf("peak memory: %d MB", (heapAlloc()-before)>>20)
+		runtime.KeepAlive(root)
+		b.Logf("memory: %d MB", (heapAlloc()-before)>>20)
+		runtime.KeepAlive(index)
+	}
+}
+
+func ayaTexts(q Quran) []string {
+	texts := []string{}
+	for _, sura := range q.Suras {
+		for _, aya := range sura.Ayas {
+			texts = append(texts, aya.Text)
+		}
+	}
+	return texts
+}
+
+func BenchmarkLocatePointerTrie(b *testing.B) {
+	root := buildPointerTrie(NewQuranSimpleClean())
+	texts := ayaTexts(NewQuranSimpleClean())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		root.locate(texts[i%len(texts)])
+	}
+}
+
+func BenchmarkLocateTrie(b *testing.B) {
+	texts := ayaTexts(NewQuranSimpleClean())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		quranizeTest.Locate(texts[i%len(texts)])
+	}
+}
+
+// encode returns arabic encodings of s found in the trie rooted at n, as Encode did before flat trie.
+func (n *node) encode(t Transliteration, s string, memo map[string][]string) []string {
+	if s == "" {
+		return []string{""}
+	}
+	if cache, ok := memo[s]; ok {
+		return cache
+	}
+
+	kalimas := []string{}
+	for width := 1; width <= t.alphabetMaxLen && width <= len(s); width++ {
+		tails, ok := t.hijaiyas[s[len(s)-width:]]
+		if !ok {
+			continue
+		}
+		for _, head := range n.encode(t, s[:len(s)-width], memo) {
+			for _, tail := range tails {
+				combinations := []string{head + tail, head + " " + tail, head + "ا" + tail, head + "ال" + tail, head + " ال" + tail, head + tail + "ى"}
+				if tail == "و" {
+					combinations = append(combinations, head+tail+"ا")
+				}
+				for _, combination := range combinations {
+					if n.exists(combination) {
+						kalimas = appendUniqString(kalimas, combination)
+					}
+				}
+			}
+		}
+	}
+	memo[s] = kalimas
+	return kalimas
+}
+
+func (n *node) exists(s string) bool {
+	for _, harf := range s {
+		if n = n.getChild(harf); n == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func appendUniqString(results []string, newResult string) []string {
+	for _, result := range results {
+		if result == newResult {
+			return results
+		}
+	}
+	return append(results, newResult)
+}
+
+func BenchmarkEncodePointerTrie(b *testing.B) {
+	root := buildPointerTrie(NewQuranSimpleClean())
+	t := NewDefaultTransliteration()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		root.encode(t, "bismillaahirrahmaanirrahiim", make(map[string][]string))
+	}
+}
+
+func BenchmarkEncodeTrie(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		quranizeTest.Encode("bismillaahirrahmaanirrahiim")
+	}
+}